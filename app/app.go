@@ -105,6 +105,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 	icq "github.com/cosmos/ibc-apps/modules/async-icq/v8"
 	icqkeeper "github.com/cosmos/ibc-apps/modules/async-icq/v8/keeper"
@@ -133,6 +134,11 @@ import (
 	piohandlers "github.com/provenance-io/provenance/internal/handlers"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/internal/querycache"
+	"github.com/provenance-io/provenance/internal/statestream"
+	"github.com/provenance-io/provenance/x/assetview"
+	assetviewkeeper "github.com/provenance-io/provenance/x/assetview/keeper"
+	assetviewmodule "github.com/provenance-io/provenance/x/assetview/module"
 	"github.com/provenance-io/provenance/x/attribute"
 	attributekeeper "github.com/provenance-io/provenance/x/attribute/keeper"
 	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
@@ -271,15 +277,17 @@ type App struct {
 	NameKeeper      namekeeper.Keeper
 	HoldKeeper      holdkeeper.Keeper
 	ExchangeKeeper  exchangekeeper.Keeper
+	AssetViewKeeper assetviewkeeper.Keeper
 	WasmKeeper      *wasmkeeper.Keeper
 	ContractKeeper  *wasmkeeper.PermissionedKeeper
 
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
-	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
-	ScopedICQKeeper      capabilitykeeper.ScopedKeeper
-	ScopedOracleKeeper   capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper       capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper  capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper   capabilitykeeper.ScopedKeeper
+	ScopedICQKeeper       capabilitykeeper.ScopedKeeper
+	ScopedOracleKeeper    capabilitykeeper.ScopedKeeper
+	ScopedAttributeKeeper capabilitykeeper.ScopedKeeper
 
 	TransferStack       *ibchooks.IBCMiddleware
 	Ics20WasmHooks      *ibchooks.WasmHooks
@@ -297,6 +305,9 @@ type App struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// queryCache is the optional cache of expensive query responses (nil if disabled).
+	queryCache *querycache.Cache
 }
 
 func init() {
@@ -398,6 +409,10 @@ func New(
 		app.Logger().Error("failed to register streaming plugin", "error", err)
 		os.Exit(1)
 	}
+	if err := statestream.RegisterFromAppOpts(app.BaseApp, appOpts, app.keys); err != nil {
+		app.Logger().Error("failed to register state publisher", "error", err)
+		os.Exit(1)
+	}
 
 	// set the BaseApp's parameter store
 
@@ -419,6 +434,7 @@ func New(
 	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	app.ScopedICQKeeper = app.CapabilityKeeper.ScopeToModule(icqtypes.ModuleName)
 	scopedOracleKeeper := app.CapabilityKeeper.ScopeToModule(oracletypes.ModuleName)
+	scopedAttributeKeeper := app.CapabilityKeeper.ScopeToModule(attributetypes.ModuleName)
 
 	// capability keeper must be sealed after scope to module registrations are completed.
 	app.CapabilityKeeper.Seal()
@@ -462,6 +478,8 @@ func New(
 		appCodec, legacyAmino, runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), app.StakingKeeper, govAuthority,
 	)
 
+	app.queryCache = querycache.NewCacheFromAppOpts(appOpts)
+
 	invCheckPeriod := cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod))
 	app.CrisisKeeper = crisiskeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[crisistypes.StoreKey]), invCheckPeriod,
 		app.BankKeeper, authtypes.FeeCollectorName, govAuthority, app.AccountKeeper.AddressCodec())
@@ -486,7 +504,6 @@ func New(
 	)
 
 	pioMsgFeesRouter := app.MsgServiceRouter().(*piohandlers.PioMsgServiceRouter)
-	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
 
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	restrictHooks := piohandlers.NewStakingRestrictionHooks(app.StakingKeeper, *piohandlers.DefaultRestrictionOptions)
@@ -515,7 +532,7 @@ func New(
 	// Setup the ICS4Wrapper used by the hooks middleware
 	wasmHooks := ibchooks.NewWasmHooks(&hooksKeeper, nil, addrPrefix) // The contract keeper needs to be set later
 	app.Ics20WasmHooks = &wasmHooks
-	markerHooks := ibchooks.NewMarkerHooks(nil)
+	markerHooks := ibchooks.NewMarkerHooks(nil, app.BankKeeper)
 	app.Ics20MarkerHooks = &markerHooks
 	ibcHooks := ibchooks.NewIbcHooks(appCodec, &hooksKeeper, app.IBCKeeper, app.Ics20WasmHooks, app.Ics20MarkerHooks, nil)
 	app.IbcHooks = &ibcHooks
@@ -525,7 +542,7 @@ func New(
 		app.IbcHooks,
 	)
 
-	rateLimitingKeeper := ibcratelimitkeeper.NewKeeper(appCodec, keys[ibcratelimit.StoreKey], nil)
+	rateLimitingKeeper := ibcratelimitkeeper.NewKeeper(appCodec, keys[ibcratelimit.StoreKey], nil, app.BankKeeper)
 	app.RateLimitingKeeper = &rateLimitingKeeper
 
 	// Create Transfer Keepers
@@ -538,7 +555,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
-		app.BankKeeper,
+		NewTransferBankKeeper(app.BankKeeper),
 		app.ScopedTransferKeeper,
 		govAuthority,
 	)
@@ -548,10 +565,11 @@ func New(
 	hooksTransferModule := ibchooks.NewIBCMiddleware(app.RateLimitMiddleware, &app.HooksICS4Wrapper)
 	app.TransferStack = &hooksTransferModule
 
-	app.NameKeeper = namekeeper.NewKeeper(appCodec, keys[nametypes.StoreKey])
+	app.NameKeeper = namekeeper.NewKeeper(appCodec, keys[nametypes.StoreKey], app.BankKeeper, app.DistrKeeper)
 
 	app.AttributeKeeper = attributekeeper.NewKeeper(
 		appCodec, keys[attributetypes.StoreKey], app.AccountKeeper, &app.NameKeeper,
+		app.IBCKeeper.PortKeeper, scopedAttributeKeeper,
 	)
 
 	markerReqAttrBypassAddrs := []sdk.AccAddress{
@@ -566,9 +584,13 @@ func New(
 	app.MarkerKeeper = markerkeeper.NewKeeper(
 		appCodec, keys[markertypes.StoreKey], app.AccountKeeper,
 		app.BankKeeper, app.AuthzKeeper, app.FeeGrantKeeper,
-		app.AttributeKeeper, app.NameKeeper, app.TransferKeeper,
-		markerReqAttrBypassAddrs, NewGroupCheckerFunc(app.GroupKeeper),
+		&app.AttributeKeeper, app.NameKeeper, app.TransferKeeper,
+		markerReqAttrBypassAddrs, NewGroupCheckerFunc(app.GroupKeeper), app.MsgServiceRouter(),
 	)
+	app.AttributeKeeper.SetHooks(attributetypes.NewMultiAttributeHooks(app.MarkerKeeper))
+	app.MsgFeesKeeper.SetMarkerKeeper(app.MarkerKeeper)
+	app.MsgFeesKeeper.SetAttributeKeeper(&app.AttributeKeeper)
+	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
 
 	app.MetadataKeeper = metadatakeeper.NewKeeper(
 		appCodec, keys[metadatatypes.StoreKey], app.AccountKeeper, app.AuthzKeeper, app.AttributeKeeper, app.MarkerKeeper, app.BankKeeper,
@@ -580,14 +602,19 @@ func New(
 
 	app.ExchangeKeeper = exchangekeeper.NewKeeper(
 		appCodec, keys[exchange.StoreKey], authtypes.FeeCollectorName,
-		app.AccountKeeper, app.AttributeKeeper, app.BankKeeper, app.HoldKeeper, app.MarkerKeeper,
+		app.AccountKeeper, app.AttributeKeeper, app.AuthzKeeper, app.BankKeeper, app.HoldKeeper, app.MarkerKeeper,
 		app.MetadataKeeper,
 	)
+	app.AttributeKeeper.SetDependencyReporter(attributetypes.NewMultiAttributeDependencyReporter(app.MarkerKeeper, app.ExchangeKeeper))
+
+	app.AssetViewKeeper = assetviewkeeper.NewKeeper(
+		app.MarkerKeeper, app.BankKeeper, app.MetadataKeeper, app.HoldKeeper,
+	)
 
 	pioMessageRouter := MessageRouterFunc(func(msg sdk.Msg) baseapp.MsgServiceHandler {
 		return pioMsgFeesRouter.Handler(msg)
 	})
-	app.TriggerKeeper = triggerkeeper.NewKeeper(appCodec, keys[triggertypes.StoreKey], app.MsgServiceRouter())
+	app.TriggerKeeper = triggerkeeper.NewKeeper(appCodec, keys[triggertypes.StoreKey], app.MsgServiceRouter(), app.MarkerKeeper, app.AttributeKeeper, app.HoldKeeper, app.BankKeeper, app.AuthzKeeper)
 	icaHostKeeper := icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], nil,
 		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.PortKeeper,
@@ -650,6 +677,8 @@ func New(
 	app.IBCHooksKeeper.ContractKeeper = app.ContractKeeper
 	app.Ics20MarkerHooks.MarkerKeeper = &app.MarkerKeeper
 	app.RateLimitingKeeper.PermissionedKeeper = app.ContractKeeper
+	// The marker keeper has a reference to the attribute keeper, so it gets this too.
+	app.AttributeKeeper.SetWasmKeeper(app.WasmKeeper)
 
 	app.IbcHooks.SendPacketPreProcessors = []ibchookstypes.PreSendPacketDataProcessingFn{app.Ics20MarkerHooks.SetupMarkerMemoFn, app.Ics20WasmHooks.GetWasmSendPacketPreProcessor}
 
@@ -663,9 +692,13 @@ func New(
 		app.IBCKeeper.PortKeeper,
 		scopedOracleKeeper,
 		wasmkeeper.Querier(app.WasmKeeper),
+		app.MarkerKeeper,
 	)
 	oracleModule := oraclemodule.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper, app.IBCKeeper.ChannelKeeper)
 
+	app.ScopedAttributeKeeper = scopedAttributeKeeper
+	attributeModule := attribute.NewAppModule(appCodec, app.AttributeKeeper, app.AccountKeeper, app.BankKeeper, app.NameKeeper)
+
 	unsanctionableAddrs := make([]sdk.AccAddress, 0, len(maccPerms)+1)
 	for mName := range maccPerms {
 		unsanctionableAddrs = append(unsanctionableAddrs, authtypes.NewModuleAddress(mName))
@@ -695,7 +728,8 @@ func New(
 		AddRoute(wasmtypes.ModuleName, wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper)).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		AddRoute(icqtypes.ModuleName, icqIBCModule).
-		AddRoute(oracletypes.ModuleName, oracleModule)
+		AddRoute(oracletypes.ModuleName, oracleModule).
+		AddRoute(attributetypes.ModuleName, attributeModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// Create evidence Keeper for to register the IBC light client misbehavior evidence route
@@ -710,7 +744,7 @@ func New(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.QuarantineKeeper = quarantinekeeper.NewKeeper(appCodec, keys[quarantine.StoreKey], app.BankKeeper, authtypes.NewModuleAddress(quarantine.ModuleName))
+	app.QuarantineKeeper = quarantinekeeper.NewKeeper(appCodec, keys[quarantine.StoreKey], app.BankKeeper, app.AttributeKeeper, authtypes.NewModuleAddress(quarantine.ModuleName))
 
 	/****  Module Options ****/
 
@@ -744,13 +778,14 @@ func New(
 		metadata.NewAppModule(appCodec, app.MetadataKeeper, app.AccountKeeper),
 		marker.NewAppModule(appCodec, app.MarkerKeeper, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.GovKeeper, app.AttributeKeeper, app.interfaceRegistry),
 		name.NewAppModule(appCodec, app.NameKeeper, app.AccountKeeper, app.BankKeeper),
-		attribute.NewAppModule(appCodec, app.AttributeKeeper, app.AccountKeeper, app.BankKeeper, app.NameKeeper),
+		attributeModule,
 		msgfeesmodule.NewAppModule(appCodec, app.MsgFeesKeeper, app.interfaceRegistry),
 		wasm.NewAppModule(appCodec, app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), nil),
 		triggermodule.NewAppModule(appCodec, app.TriggerKeeper, app.AccountKeeper, app.BankKeeper),
 		oracleModule,
 		holdmodule.NewAppModule(appCodec, app.HoldKeeper),
 		exchangemodule.NewAppModule(appCodec, app.ExchangeKeeper),
+		assetviewmodule.NewAppModule(app.AssetViewKeeper),
 		quarantinemodule.NewAppModule(appCodec, app.QuarantineKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		sanctionmodule.NewAppModule(appCodec, app.SanctionKeeper, app.AccountKeeper, app.BankKeeper, app.GovKeeper, app.interfaceRegistry),
 
@@ -802,8 +837,11 @@ func New(
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		markertypes.ModuleName,
+		nametypes.ModuleName,
 		attributetypes.ModuleName,
 		authz.ModuleName,
+		msgfeestypes.ModuleName,
+		oracletypes.ModuleName,
 		triggertypes.ModuleName,
 	)
 
@@ -814,6 +852,11 @@ func New(
 		feegrant.ModuleName,
 		group.ModuleName,
 		triggertypes.ModuleName,
+		ibcratelimit.ModuleName,
+		quarantine.ModuleName,
+		sanction.ModuleName,
+		exchange.ModuleName,
+		metadatatypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -891,6 +934,7 @@ func New(
 		sanction.ModuleName,
 		hold.ModuleName,
 		exchange.ModuleName,
+		assetview.ModuleName,
 		consensusparamtypes.ModuleName,
 		circuittypes.ModuleName,
 
@@ -1246,6 +1290,15 @@ func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	cmtservice.RegisterTendermintService(clientCtx, app.BaseApp.GRPCQueryRouter(), app.interfaceRegistry, app.Query)
 }
 
+// RegisterGRPCServer registers gRPC services directly with the gRPC server.
+// If the query cache is enabled, the cached queries are wrapped so that their responses are cached.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	if app.queryCache != nil {
+		server = querycache.NewServer(server, app.queryCache, app.LastBlockHeight)
+	}
+	app.BaseApp.RegisterGRPCServer(server)
+}
+
 // RegisterNodeService registers the node query server.
 func (app *App) RegisterNodeService(clientCtx client.Context, cfg serverconfig.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
@@ -21,6 +21,10 @@ const (
 	DefaultWeightMsgAddFinalizeActivateMarker int = 10
 	DefaultWeightMsgAddMarkerProposal         int = 40
 	DefaultWeightMsgUpdateDenySendList        int = 10
+	DefaultWeightMsgSetDenomMetadata          int = 10
+	DefaultWeightMsgAddNetAssetValues         int = 10
+	DefaultWeightMsgGrantAllowance            int = 5
+	DefaultWeightMsgTransfer                  int = 10
 	// Trigger
 	DefaultWeightSubmitCreateTrigger  int = 95
 	DefaultWeightSubmitDestroyTrigger int = 5
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// TransferBankKeeper is the bank keeper used by the ibc transfer module.
+// A channel's escrow account can't acknowledge a transfer, so the coins it sends (e.g. to and from the escrow
+// accounts) are never held for a marker receipt. Otherwise, refunds and later unescrows would fail.
+type TransferBankKeeper struct {
+	bankkeeper.BaseKeeper
+}
+
+// NewTransferBankKeeper creates a bank keeper for use by the ibc transfer module.
+func NewTransferBankKeeper(bk bankkeeper.BaseKeeper) TransferBankKeeper {
+	return TransferBankKeeper{BaseKeeper: bk}
+}
+
+// SendCoins transfers amt coins from fromAddr to toAddr without holding any of them for a marker receipt.
+func (k TransferBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.BaseKeeper.SendCoins(markertypes.WithReceiptBypass(ctx), fromAddr, toAddr, amt)
+}
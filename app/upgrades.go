@@ -63,6 +63,16 @@ var upgrades = map[string]appUpgrade{
 			return vm, nil
 		},
 	},
+	"yellow-rc1": { // Upgrade for v1.23.0-rc1.
+		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+			return runModuleMigrations(ctx, app, vm)
+		},
+	},
+	"yellow": { // Upgrade for v1.23.0.
+		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+			return runModuleMigrations(ctx, app, vm)
+		},
+	},
 }
 
 // InstallCustomUpgradeHandlers sets upgrade handlers for all entries in the upgrades map.
@@ -603,3 +603,25 @@ func (s *UpgradeTestSuite) TestXenon() {
 	}
 	s.AssertUpgradeHandlerLogs("xenon", expInLog, nil)
 }
+
+func (s *UpgradeTestSuite) TestYellowRC1() {
+	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+	}
+	expNotInLog := []string{
+		"INF Pruning expired consensus states for IBC.",
+		"INF Removing inactive validator delegations.",
+	}
+	s.AssertUpgradeHandlerLogs("yellow-rc1", expInLog, expNotInLog)
+}
+
+func (s *UpgradeTestSuite) TestYellow() {
+	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+	}
+	expNotInLog := []string{
+		"INF Pruning expired consensus states for IBC.",
+		"INF Removing inactive validator delegations.",
+	}
+	s.AssertUpgradeHandlerLogs("yellow", expInLog, expNotInLog)
+}
@@ -49,6 +49,8 @@ import (
 	"github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/cmd/provenanced/config"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/internal/querycache"
+	"github.com/provenance-io/provenance/internal/statestream"
 )
 
 // NewRootCmd creates a new root command for provenanced. It is called once in the main function.
@@ -199,6 +201,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	querycache.AddFlags(startCmd)
+	statestream.AddFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -155,6 +155,15 @@ func (g *FeeGasMeter) FeeConsumedByMsg() map[string]sdk.Coins {
 	return consumedByMsg
 }
 
+// FeeCallsByMsg returns the number of msg fee calls by composite msg type and recipient key.
+func (g *FeeGasMeter) FeeCallsByMsg() map[string]uint64 {
+	callsByMsg := make(map[string]uint64, len(g.feeCalls))
+	for key, count := range g.feeCalls {
+		callsByMsg[key] = count
+	}
+	return callsByMsg
+}
+
 func (g *FeeGasMeter) IsSimulate() bool {
 	return g.simulate
 }
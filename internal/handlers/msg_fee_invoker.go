@@ -95,8 +95,13 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, _ bool) (sdk.Coins, sdk.Events,
 		// the uncharged fees have now been charged.
 		chargedFees = chargedFees.Add(unchargedFees...)
 
-		// If there were msg based fees, add some events for them.
+		// If there were msg based fees, add some events for them and add them to the fee accruals.
 		if !consumedFees.IsZero() {
+			err = afd.msgFeeKeeper.AccrueMsgFees(ctx, feeGasMeter.FeeConsumedByMsg(), feeGasMeter.FeeCallsByMsg())
+			if err != nil {
+				return nil, nil, err
+			}
+
 			// Add event with fee breakdown between additional fees and the rest.
 			nonMsgFees := baseFeeConsumed.Add(chargedFees...).Sub(consumedFees...)
 			eventsToReturn = append(eventsToReturn, sdk.NewEvent(sdk.EventTypeTx,
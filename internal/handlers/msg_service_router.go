@@ -14,12 +14,14 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/protocompat"
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
 )
 
@@ -164,6 +166,12 @@ func (msr *PioMsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc,
 
 		// original sdk implementation of msg service router
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		// A multi-send applies the marker send restriction once per output, so give
+		// it a cache to reuse its marker and attribute lookups between those outputs.
+		if _, isMultiSend := req.(*banktypes.MsgMultiSend); isMultiSend {
+			ctx = markertypes.WithSendRestrictionCache(ctx)
+		}
 		interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 			goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 			return handler(goCtx, req)
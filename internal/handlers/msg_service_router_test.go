@@ -543,6 +543,21 @@ func TestMsgServiceMsgFeeWithRecipient(t *testing.T) {
 	expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), addr2.String(), sdk.NewCoins(sdk.NewInt64Coin("hotdog", 600)))...)
 
 	assertEventsContains(t, blockRes.TxResults[0].Events, expEvents)
+
+	// Check that the fees were added to the fee accruals of both recipients.
+	for _, tc := range []struct {
+		recipient sdk.AccAddress
+		total     string
+	}{
+		{recipient: addr2, total: "600hotdog"},
+		{recipient: feeModuleAccount.GetAddress(), total: "200hotdog"},
+	} {
+		accruals, err := app.MsgFeesKeeper.FeeAccruals(ctx, &msgfeestypes.QueryFeeAccrualsRequest{Recipient: tc.recipient.String()})
+		if assert.NoError(t, err, "FeeAccruals(%s)", tc.recipient) {
+			assert.Equal(t, 1, int(accruals.Count), "FeeAccruals(%s) count", tc.recipient)
+			assert.Equal(t, tc.total, accruals.Total.String(), "FeeAccruals(%s) total", tc.recipient)
+		}
+	}
 }
 
 func TestMsgServiceAuthz(tt *testing.T) {
@@ -0,0 +1,40 @@
+package provutils
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/dbadapter"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PaginateSlice returns the entries on the page defined by the provided PageRequest, and the PageResponse for it.
+// Entries are paged in the order of the keys from keyOf, which must be unique for each entry.
+// If keyOf is nil, entries are paged in the order they're in, keyed by their (big-endian uint32) index.
+// The keys are what's used for the request's Key and the response's NextKey.
+//
+// This allows lists that aren't stored one entry per key (e.g. those built in memory, or stored
+// together under a single key) to be paginated the same way as the ones that are.
+func PaginateSlice[S ~[]E, E any](entries S, keyOf func(E) []byte, pageReq *query.PageRequest) (S, *query.PageResponse, error) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i, entry := range entries {
+		var key []byte
+		if keyOf != nil {
+			key = keyOf(entry)
+		} else {
+			key = binary.BigEndian.AppendUint32(nil, uint32(i))
+		}
+		store.Set(key, binary.BigEndian.AppendUint64(nil, uint64(i)))
+	}
+
+	var rv S
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		rv = append(rv, entries[binary.BigEndian.Uint64(value)])
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rv, pageRes, nil
+}
@@ -0,0 +1,105 @@
+package provutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestPaginateSlice(t *testing.T) {
+	entries := []string{"cc", "aa", "dd", "bb"}
+	byValue := func(e string) []byte { return []byte(e) }
+
+	tests := []struct {
+		name       string
+		entries    []string
+		keyOf      func(string) []byte
+		pageReq    *query.PageRequest
+		expEntries []string
+		expPageRes *query.PageResponse
+		expErr     string
+	}{
+		{
+			name:       "nil entries",
+			entries:    nil,
+			keyOf:      byValue,
+			expEntries: nil,
+			expPageRes: &query.PageResponse{},
+		},
+		{
+			name:       "nil page request",
+			entries:    entries,
+			keyOf:      byValue,
+			expEntries: []string{"aa", "bb", "cc", "dd"},
+			expPageRes: &query.PageResponse{Total: 4},
+		},
+		{
+			name:       "nil key func",
+			entries:    entries,
+			expEntries: []string{"cc", "aa", "dd", "bb"},
+			expPageRes: &query.PageResponse{Total: 4},
+		},
+		{
+			name:       "limit",
+			entries:    entries,
+			keyOf:      byValue,
+			pageReq:    &query.PageRequest{Limit: 2, CountTotal: true},
+			expEntries: []string{"aa", "bb"},
+			expPageRes: &query.PageResponse{NextKey: []byte("cc"), Total: 4},
+		},
+		{
+			name:       "key",
+			entries:    entries,
+			keyOf:      byValue,
+			pageReq:    &query.PageRequest{Key: []byte("cc"), Limit: 2},
+			expEntries: []string{"cc", "dd"},
+			expPageRes: &query.PageResponse{},
+		},
+		{
+			name:       "offset",
+			entries:    entries,
+			keyOf:      byValue,
+			pageReq:    &query.PageRequest{Offset: 1, Limit: 2},
+			expEntries: []string{"bb", "cc"},
+			expPageRes: &query.PageResponse{NextKey: []byte("dd")},
+		},
+		{
+			name:       "reverse",
+			entries:    entries,
+			keyOf:      byValue,
+			pageReq:    &query.PageRequest{Limit: 3, Reverse: true},
+			expEntries: []string{"dd", "cc", "bb"},
+			expPageRes: &query.PageResponse{NextKey: []byte("aa")},
+		},
+		{
+			name:       "reverse by index",
+			entries:    entries,
+			pageReq:    &query.PageRequest{Limit: 2, Reverse: true},
+			expEntries: []string{"bb", "dd"},
+			expPageRes: &query.PageResponse{NextKey: []byte{0, 0, 0, 1}},
+		},
+		{
+			name:    "key and offset",
+			entries: entries,
+			keyOf:   byValue,
+			pageReq: &query.PageRequest{Key: []byte("bb"), Offset: 1},
+			expErr:  "invalid request, either offset or key is expected, got both",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			page, pageRes, err := PaginateSlice(tc.entries, tc.keyOf, tc.pageReq)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "PaginateSlice error")
+				return
+			}
+			require.NoError(t, err, "PaginateSlice error")
+			assert.Equal(t, tc.expEntries, page, "PaginateSlice entries")
+			assert.Equal(t, tc.expPageRes, pageRes, "PaginateSlice page response")
+		})
+	}
+}
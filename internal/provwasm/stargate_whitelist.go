@@ -237,6 +237,10 @@ func init() {
 	// hold
 	setWhitelistedQuery("/provenance.hold.v1.Query/GetHolds", &hold.GetHoldsResponse{})
 	setWhitelistedQuery("/provenance.hold.v1.Query/GetAllHolds", &hold.GetAllHoldsResponse{})
+	setWhitelistedQuery("/provenance.hold.v1.Query/GetEscrow", &hold.GetEscrowResponse{})
+	setWhitelistedQuery("/provenance.hold.v1.Query/GetAllEscrows", &hold.GetAllEscrowsResponse{})
+	setWhitelistedQuery("/provenance.hold.v1.Query/GetScopeLock", &hold.GetScopeLockResponse{})
+	setWhitelistedQuery("/provenance.hold.v1.Query/GetAllScopeLocks", &hold.GetAllScopeLocksResponse{})
 
 	// ibcratelimit
 	setWhitelistedQuery("/provenance.ibcratelimit.v1.Query/Params", &ibcratelimit.ParamsResponse{})
@@ -255,6 +259,7 @@ func init() {
 	setWhitelistedQuery("/provenance.marker.v1.Query/DenomMetadata", &markertypes.QueryDenomMetadataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/AccountData", &markertypes.QueryAccountDataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/NetAssetValues", &markertypes.QueryNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/CanSend", &markertypes.QueryCanSendResponse{})
 
 	// metadata
 	setWhitelistedQuery("/provenance.metadata.v1.Query/Params", &metadatatypes.QueryParamsResponse{})
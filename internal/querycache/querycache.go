@@ -0,0 +1,229 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+const (
+	// FlagEnabled is the app.toml key (and flag) that turns on the query cache.
+	FlagEnabled = "query-cache.enabled"
+	// FlagMaxEntries is the app.toml key (and flag) with the maximum number of responses to cache for a block.
+	FlagMaxEntries = "query-cache.max-entries"
+
+	// DefaultMaxEntries is the default maximum number of responses to cache for a block.
+	DefaultMaxEntries = 1000
+)
+
+// DefaultMethods are the full names of the queries that are cached by default.
+// These are read-only queries that can be expensive because they iterate over a lot of state.
+var DefaultMethods = []string{
+	"/provenance.marker.v1.Query/AllMarkers",
+	"/provenance.marker.v1.Query/Holding",
+	"/provenance.metadata.v1.Query/Ownership",
+	"/provenance.metadata.v1.Query/ValueOwnership",
+}
+
+// AddFlags adds the query cache flags to the provided (start) command.
+func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagEnabled, false, "Cache the responses of expensive queries for the latest block height")
+	cmd.Flags().Int(FlagMaxEntries, DefaultMaxEntries, "The maximum number of query responses to cache for a block height")
+}
+
+// NewCacheFromAppOpts creates a new Cache using the provided app options.
+// Returns nil if the cache is not enabled.
+func NewCacheFromAppOpts(appOpts servertypes.AppOptions) *Cache {
+	if !cast.ToBool(appOpts.Get(FlagEnabled)) {
+		return nil
+	}
+	maxEntries := DefaultMaxEntries
+	if val := appOpts.Get(FlagMaxEntries); val != nil {
+		maxEntries = cast.ToInt(val)
+	}
+	return NewCache(maxEntries, DefaultMethods...)
+}
+
+// Cache holds query responses for a single block height.
+// All entries are dropped as soon as a newer height is seen.
+type Cache struct {
+	mtx        sync.Mutex
+	maxEntries int
+	methods    map[string]bool
+	height     int64
+	entries    map[string]interface{}
+}
+
+// NewCache creates a new Cache that holds up to maxEntries responses for the provided methods.
+func NewCache(maxEntries int, methods ...string) *Cache {
+	rv := &Cache{
+		maxEntries: maxEntries,
+		methods:    make(map[string]bool, len(methods)),
+		entries:    make(map[string]interface{}),
+	}
+	for _, method := range methods {
+		rv.methods[method] = true
+	}
+	return rv
+}
+
+// IsCached returns true if responses for the provided method are cached.
+func (c *Cache) IsCached(method string) bool {
+	return c.methods[method]
+}
+
+// Len returns the number of responses currently cached.
+func (c *Cache) Len() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return len(c.entries)
+}
+
+// Get returns the response cached for the provided key at the provided height.
+// If the height is newer than the cached entries, they are all dropped.
+func (c *Cache) Get(height int64, key string) (interface{}, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.setHeight(height)
+	if height != c.height {
+		return nil, false
+	}
+	resp, found := c.entries[key]
+	return resp, found
+}
+
+// Set caches a response for the provided key at the provided height.
+// Nothing is cached if the height is older than the cached entries, or if the cache is full.
+func (c *Cache) Set(height int64, key string, resp interface{}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.setHeight(height)
+	if height != c.height || len(c.entries) >= c.maxEntries {
+		return
+	}
+	c.entries[key] = resp
+}
+
+// setHeight drops all cached entries if the provided height is newer than the one they're for.
+// The mutex must be locked before calling this.
+func (c *Cache) setHeight(height int64) {
+	if height > c.height {
+		c.height = height
+		clear(c.entries)
+	}
+}
+
+// Server is a gRPC server wrapper that uses a Cache for the responses of some queries.
+type Server struct {
+	server     gogogrpc.Server
+	cache      *Cache
+	lastHeight func() int64
+}
+
+var _ gogogrpc.Server = (*Server)(nil)
+
+// NewServer wraps the provided server so that queries are cached in the provided cache.
+// The lastHeight func should return the latest committed block height.
+func NewServer(server gogogrpc.Server, cache *Cache, lastHeight func() int64) *Server {
+	return &Server{server: server, cache: cache, lastHeight: lastHeight}
+}
+
+// RegisterService wraps the handlers of the cached methods, then registers the service with the underlying server.
+func (s *Server) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	newDesc := *sd
+	newDesc.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		newDesc.Methods[i] = method
+		fullMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		if s.cache.IsCached(fullMethod) {
+			newDesc.Methods[i].Handler = s.wrapHandler(fullMethod, method.Handler)
+		}
+	}
+	s.server.RegisterService(&newDesc, ss)
+}
+
+// errDecoded is used to stop a handler as soon as its request has been decoded.
+var errDecoded = errors.New("request decoded")
+
+// wrapHandler returns a handler that uses the cache before invoking the provided handler.
+func (s *Server) wrapHandler(fullMethod string, handler grpc.MethodHandler) grpc.MethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		// The request type isn't known here, so let the handler create and decode it, then stop.
+		var req proto.Message
+		_, err := handler(srv, ctx, func(in interface{}) error {
+			if err := dec(in); err != nil {
+				return err
+			}
+			var ok bool
+			if req, ok = in.(proto.Message); !ok {
+				return fmt.Errorf("unexpected request type %T", in)
+			}
+			return errDecoded
+		}, interceptor)
+		if !errors.Is(err, errDecoded) {
+			return nil, err
+		}
+
+		reqBz, err := proto.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		decReq := func(in interface{}) error {
+			msg, ok := in.(proto.Message)
+			if !ok {
+				return fmt.Errorf("unexpected request type %T", in)
+			}
+			return proto.Unmarshal(reqBz, msg)
+		}
+
+		// Only requests for the latest height are cached.
+		height := s.lastHeight()
+		if reqHeight := getRequestHeight(ctx); reqHeight != 0 && reqHeight != height {
+			return handler(srv, ctx, decReq, interceptor)
+		}
+
+		key := fullMethod + string(reqBz)
+		if resp, found := s.cache.Get(height, key); found {
+			// The handler would have set this header too, so we do the same here.
+			_ = grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10)))
+			return resp, nil
+		}
+
+		resp, err := handler(srv, ctx, decReq, interceptor)
+		// If a new block was committed while handling the query, we don't know which height the response is for.
+		if err == nil && s.lastHeight() == height {
+			s.cache.Set(height, key, resp)
+		}
+		return resp, err
+	}
+}
+
+// getRequestHeight gets the height requested in the gRPC headers, or 0 if there isn't one.
+func getRequestHeight(ctx context.Context) int64 {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0
+	}
+	heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heightHeaders) != 1 {
+		return 0
+	}
+	// If it's invalid, the handler will return an error about it.
+	height, err := strconv.ParseInt(heightHeaders[0], 10, 64)
+	if err != nil {
+		return -1
+	}
+	return height
+}
@@ -0,0 +1,188 @@
+package querycache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// mockServer is a gogogrpc.Server that just records the service registered with it.
+type mockServer struct {
+	desc *grpc.ServiceDesc
+}
+
+func (s *mockServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.desc = sd
+}
+
+// handler returns the handler registered for the given method.
+func (s *mockServer) handler(t *testing.T, method string) grpc.MethodHandler {
+	t.Helper()
+	require.NotNil(t, s.desc, "registered service desc")
+	for _, m := range s.desc.Methods {
+		if m.MethodName == method {
+			return m.Handler
+		}
+	}
+	t.Fatalf("method %q not registered", method)
+	return nil
+}
+
+// mockQueryService counts the number of times each query is actually run.
+type mockQueryService struct {
+	calls int
+	err   error
+}
+
+// newHandler creates a handler that works like a generated one, with a response that includes the number of calls.
+func (q *mockQueryService) newHandler() grpc.MethodHandler {
+	return func(_ interface{}, _ context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := new(banktypes.QueryBalanceRequest)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		q.calls++
+		if q.err != nil {
+			return nil, q.err
+		}
+		coin := sdk.NewInt64Coin(in.Denom, int64(q.calls))
+		return &banktypes.QueryBalanceResponse{Balance: &coin}, nil
+	}
+}
+
+// decoder returns a dec func for a handler that decodes the provided request.
+func decoder(t *testing.T, req proto.Message) func(interface{}) error {
+	bz, err := proto.Marshal(req)
+	require.NoError(t, err, "Marshal(%T)", req)
+	return func(in interface{}) error {
+		return proto.Unmarshal(bz, in.(proto.Message))
+	}
+}
+
+func TestCache(t *testing.T) {
+	cache := NewCache(2, "/a/b")
+	assert.True(t, cache.IsCached("/a/b"), "IsCached(/a/b)")
+	assert.False(t, cache.IsCached("/a/c"), "IsCached(/a/c)")
+
+	cache.Set(5, "one", 1)
+	cache.Set(5, "two", 2)
+	cache.Set(5, "three", 3)
+	assert.Equal(t, 2, cache.Len(), "Len after filling")
+	resp, found := cache.Get(5, "one")
+	assert.True(t, found, "Get(5, one) found")
+	assert.Equal(t, 1, resp, "Get(5, one) response")
+	_, found = cache.Get(5, "three")
+	assert.False(t, found, "Get(5, three) found")
+
+	cache.Set(4, "four", 4)
+	_, found = cache.Get(4, "one")
+	assert.False(t, found, "Get(4, one) found")
+	assert.Equal(t, 2, cache.Len(), "Len after older height")
+
+	_, found = cache.Get(6, "one")
+	assert.False(t, found, "Get(6, one) found")
+	assert.Equal(t, 0, cache.Len(), "Len after newer height")
+}
+
+func TestNewCacheFromAppOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    mapAppOpts
+		expNil  bool
+		expSize int
+	}{
+		{name: "nothing set", opts: mapAppOpts{}, expNil: true},
+		{name: "disabled", opts: mapAppOpts{FlagEnabled: false, FlagMaxEntries: 5}, expNil: true},
+		{name: "enabled", opts: mapAppOpts{FlagEnabled: true}, expSize: DefaultMaxEntries},
+		{name: "enabled with max entries", opts: mapAppOpts{FlagEnabled: "true", FlagMaxEntries: "5"}, expSize: 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cache := NewCacheFromAppOpts(tc.opts)
+			if tc.expNil {
+				assert.Nil(t, cache, "NewCacheFromAppOpts")
+				return
+			}
+			require.NotNil(t, cache, "NewCacheFromAppOpts")
+			assert.Equal(t, tc.expSize, cache.maxEntries, "maxEntries")
+			for _, method := range DefaultMethods {
+				assert.True(t, cache.IsCached(method), "IsCached(%q)", method)
+			}
+		})
+	}
+}
+
+// mapAppOpts is a simple servertypes.AppOptions.
+type mapAppOpts map[string]interface{}
+
+func (m mapAppOpts) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestServer(t *testing.T) {
+	cachedSvc := &mockQueryService{}
+	otherSvc := &mockQueryService{}
+	desc := &grpc.ServiceDesc{
+		ServiceName: "test.Query",
+		Methods: []grpc.MethodDesc{
+			{MethodName: "Cached", Handler: cachedSvc.newHandler()},
+			{MethodName: "Other", Handler: otherSvc.newHandler()},
+		},
+	}
+
+	height := int64(10)
+	lastHeight := func() int64 { return height }
+	inner := &mockServer{}
+	server := NewServer(inner, NewCache(10, "/test.Query/Cached"), lastHeight)
+	server.RegisterService(desc, nil)
+
+	cached := inner.handler(t, "Cached")
+	other := inner.handler(t, "Other")
+	ctx := context.Background()
+	reqA := &banktypes.QueryBalanceRequest{Address: "addr", Denom: "acoin"}
+	reqB := &banktypes.QueryBalanceRequest{Address: "addr", Denom: "bcoin"}
+
+	call := func(handler grpc.MethodHandler, ctx context.Context, req proto.Message) string {
+		t.Helper()
+		resp, err := handler(nil, ctx, decoder(t, req), nil)
+		require.NoError(t, err, "handler error")
+		return resp.(*banktypes.QueryBalanceResponse).Balance.String()
+	}
+
+	assert.Equal(t, "1acoin", call(cached, ctx, reqA), "first cached query A")
+	assert.Equal(t, "1acoin", call(cached, ctx, reqA), "second cached query A")
+	assert.Equal(t, "2bcoin", call(cached, ctx, reqB), "first cached query B")
+	assert.Equal(t, 2, cachedSvc.calls, "cached query calls")
+
+	assert.Equal(t, "1acoin", call(other, ctx, reqA), "first other query")
+	assert.Equal(t, "2acoin", call(other, ctx, reqA), "second other query")
+	assert.Equal(t, 2, otherSvc.calls, "other query calls")
+
+	oldCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "9"))
+	assert.Equal(t, "3acoin", call(cached, oldCtx, reqA), "cached query A at older height")
+	curCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "10"))
+	assert.Equal(t, "1acoin", call(cached, curCtx, reqA), "cached query A at current height")
+
+	height++
+	assert.Equal(t, "4acoin", call(cached, ctx, reqA), "cached query A after new block")
+	assert.Equal(t, "4acoin", call(cached, ctx, reqA), "cached query A again after new block")
+	assert.Equal(t, 4, cachedSvc.calls, "cached query calls at end")
+
+	cachedSvc.err = errors.New("injected error")
+	_, err := cached(nil, ctx, decoder(t, reqB), nil)
+	assert.EqualError(t, err, "injected error", "error from cached query")
+	_, err = cached(nil, ctx, decoder(t, reqB), nil)
+	assert.EqualError(t, err, "injected error", "second error from cached query")
+	assert.Equal(t, 6, cachedSvc.calls, "cached query calls after errors")
+}
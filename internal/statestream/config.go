@@ -0,0 +1,148 @@
+package statestream
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
+)
+
+const (
+	// FlagEnabled is the app.toml key (and flag) that turns on the state publisher.
+	FlagEnabled = "streaming.publisher.enabled"
+	// FlagBackend is the app.toml key (and flag) with the type of broker to publish to, either "kafka" or "nats".
+	FlagBackend = "streaming.publisher.backend"
+	// FlagEndpoint is the app.toml key (and flag) with the broker endpoint.
+	// For kafka, this is the url of a Kafka REST proxy. For nats, this is the host:port of a NATS server.
+	FlagEndpoint = "streaming.publisher.endpoint"
+	// FlagTopicPrefix is the app.toml key (and flag) with the prefix used for all topics (or subjects) published to.
+	FlagTopicPrefix = "streaming.publisher.topic-prefix"
+	// FlagStores is the app.toml key (and flag) with the names of the stores whose changes are published.
+	FlagStores = "streaming.publisher.stores"
+	// FlagEvents is the app.toml key (and flag) that controls whether the typed events of those stores' modules are published.
+	FlagEvents = "streaming.publisher.events"
+	// FlagTimeout is the app.toml key (and flag) with the maximum amount of time to spend publishing a block.
+	FlagTimeout = "streaming.publisher.timeout"
+	// FlagStopNodeOnErr is the app.toml key (and flag) that controls whether the node halts when publishing fails.
+	FlagStopNodeOnErr = "streaming.publisher.stop-node-on-err"
+
+	// BackendKafka is the backend that publishes to Kafka through a Kafka REST proxy.
+	BackendKafka = "kafka"
+	// BackendNATS is the backend that publishes to a NATS server.
+	BackendNATS = "nats"
+
+	// DefaultTopicPrefix is the default prefix for all topics published to.
+	DefaultTopicPrefix = "provenance"
+	// DefaultTimeout is the default maximum amount of time to spend publishing a block.
+	DefaultTimeout = 5 * time.Second
+)
+
+// DefaultStores are the names of the stores whose changes are published by default.
+var DefaultStores = []string{
+	markertypes.StoreKey,
+	attributetypes.StoreKey,
+	nametypes.StoreKey,
+	metadatatypes.StoreKey,
+}
+
+// AddFlags adds the state publisher flags to the provided (start) command.
+func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagEnabled, false, "Publish state changes and typed events to a message broker after each block")
+	cmd.Flags().String(FlagBackend, "", fmt.Sprintf("The type of message broker to publish to, either %q or %q", BackendKafka, BackendNATS))
+	cmd.Flags().String(FlagEndpoint, "", "The url of the Kafka REST proxy, or the host:port of the NATS server")
+	cmd.Flags().String(FlagTopicPrefix, DefaultTopicPrefix, "The prefix of the topics (or subjects) to publish to")
+	cmd.Flags().StringSlice(FlagStores, DefaultStores, "The names of the stores whose changes are published")
+	cmd.Flags().Bool(FlagEvents, true, "Also publish the typed events of the modules with published stores")
+	cmd.Flags().Duration(FlagTimeout, DefaultTimeout, "The maximum amount of time to spend publishing a block")
+	cmd.Flags().Bool(FlagStopNodeOnErr, false, "Halt the node if a block cannot be published")
+}
+
+// Config contains the state publisher settings.
+type Config struct {
+	// Enabled is whether the state publisher is turned on.
+	Enabled bool
+	// Backend is the type of message broker to publish to.
+	Backend string
+	// Endpoint is the url (kafka) or host:port (nats) of the message broker.
+	Endpoint string
+	// TopicPrefix is the prefix of all topics (or subjects) published to.
+	TopicPrefix string
+	// Stores are the names of the stores whose changes are published.
+	Stores []string
+	// Events is whether the typed events of the stores' modules are published.
+	Events bool
+	// Timeout is the maximum amount of time to spend publishing a block.
+	Timeout time.Duration
+	// StopNodeOnErr is whether the node should halt when a block cannot be published.
+	StopNodeOnErr bool
+}
+
+// DefaultConfig returns a new disabled Config with the default settings.
+func DefaultConfig() Config {
+	return Config{
+		TopicPrefix: DefaultTopicPrefix,
+		Stores:      append([]string{}, DefaultStores...),
+		Events:      true,
+		Timeout:     DefaultTimeout,
+	}
+}
+
+// ConfigFromAppOpts creates a new Config using the provided app options.
+func ConfigFromAppOpts(appOpts servertypes.AppOptions) Config {
+	rv := DefaultConfig()
+	rv.Enabled = cast.ToBool(appOpts.Get(FlagEnabled))
+	rv.Backend = strings.ToLower(strings.TrimSpace(cast.ToString(appOpts.Get(FlagBackend))))
+	rv.Endpoint = strings.TrimSpace(cast.ToString(appOpts.Get(FlagEndpoint)))
+	if val := appOpts.Get(FlagTopicPrefix); val != nil {
+		rv.TopicPrefix = strings.TrimSpace(cast.ToString(val))
+	}
+	if val := appOpts.Get(FlagStores); val != nil {
+		rv.Stores = cast.ToStringSlice(val)
+	}
+	if val := appOpts.Get(FlagEvents); val != nil {
+		rv.Events = cast.ToBool(val)
+	}
+	if val := appOpts.Get(FlagTimeout); val != nil {
+		rv.Timeout = cast.ToDuration(val)
+	}
+	rv.StopNodeOnErr = cast.ToBool(appOpts.Get(FlagStopNodeOnErr))
+	return rv
+}
+
+// Validate returns an error if this Config is enabled but cannot be used.
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	var errs []error
+	switch c.Backend {
+	case BackendKafka, BackendNATS:
+	case "":
+		errs = append(errs, fmt.Errorf("%s is required", FlagBackend))
+	default:
+		errs = append(errs, fmt.Errorf("invalid %s %q: must be %q or %q", FlagBackend, c.Backend, BackendKafka, BackendNATS))
+	}
+	if len(c.Endpoint) == 0 {
+		errs = append(errs, fmt.Errorf("%s is required", FlagEndpoint))
+	}
+	if len(c.TopicPrefix) == 0 {
+		errs = append(errs, fmt.Errorf("%s cannot be empty", FlagTopicPrefix))
+	}
+	if len(c.Stores) == 0 {
+		errs = append(errs, fmt.Errorf("%s cannot be empty", FlagStores))
+	}
+	if c.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("invalid %s %s: must be positive", FlagTimeout, c.Timeout))
+	}
+	return errors.Join(errs...)
+}
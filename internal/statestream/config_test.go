@@ -0,0 +1,125 @@
+package statestream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mapAppOpts is a map of app options that satisfies the AppOptions interface.
+type mapAppOpts map[string]interface{}
+
+func (m mapAppOpts) Get(key string) interface{} {
+	return m[key]
+}
+
+func TestConfigFromAppOpts(t *testing.T) {
+	tests := []struct {
+		name    string
+		appOpts mapAppOpts
+		exp     Config
+	}{
+		{
+			name:    "nothing set",
+			appOpts: mapAppOpts{},
+			exp:     DefaultConfig(),
+		},
+		{
+			name: "everything set",
+			appOpts: mapAppOpts{
+				FlagEnabled:       true,
+				FlagBackend:       " NATS ",
+				FlagEndpoint:      "localhost:4222",
+				FlagTopicPrefix:   "pio",
+				FlagStores:        []string{"marker"},
+				FlagEvents:        false,
+				FlagTimeout:       "2s",
+				FlagStopNodeOnErr: true,
+			},
+			exp: Config{
+				Enabled:       true,
+				Backend:       BackendNATS,
+				Endpoint:      "localhost:4222",
+				TopicPrefix:   "pio",
+				Stores:        []string{"marker"},
+				Events:        false,
+				Timeout:       2 * time.Second,
+				StopNodeOnErr: true,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := ConfigFromAppOpts(tc.appOpts)
+			assert.Equal(t, tc.exp, actual, "ConfigFromAppOpts")
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	enabled := func(modify func(cfg *Config)) Config {
+		rv := DefaultConfig()
+		rv.Enabled = true
+		rv.Backend = BackendKafka
+		rv.Endpoint = "http://localhost:8082"
+		if modify != nil {
+			modify(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		cfg    Config
+		expErr string
+	}{
+		{
+			name: "disabled",
+			cfg:  DefaultConfig(),
+		},
+		{
+			name: "enabled kafka",
+			cfg:  enabled(nil),
+		},
+		{
+			name: "enabled nats",
+			cfg:  enabled(func(cfg *Config) { cfg.Backend = BackendNATS }),
+		},
+		{
+			name:   "no backend",
+			cfg:    enabled(func(cfg *Config) { cfg.Backend = "" }),
+			expErr: FlagBackend + " is required",
+		},
+		{
+			name:   "unknown backend",
+			cfg:    enabled(func(cfg *Config) { cfg.Backend = "redis" }),
+			expErr: `invalid ` + FlagBackend + ` "redis": must be "kafka" or "nats"`,
+		},
+		{
+			name: "everything wrong",
+			cfg: enabled(func(cfg *Config) {
+				cfg.Endpoint = ""
+				cfg.TopicPrefix = ""
+				cfg.Stores = nil
+				cfg.Timeout = 0
+			}),
+			expErr: FlagEndpoint + " is required\n" +
+				FlagTopicPrefix + " cannot be empty\n" +
+				FlagStores + " cannot be empty\n" +
+				"invalid " + FlagTimeout + " 0s: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
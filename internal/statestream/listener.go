@@ -0,0 +1,234 @@
+package statestream
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EventsTopic is the suffix of the topic that typed events are published to.
+const EventsTopic = "events"
+
+// KVChange is the message published for a change to a key in a store.
+type KVChange struct {
+	// Height is the height of the block that made the change.
+	Height int64 `json:"height"`
+	// Store is the name of the store that was changed.
+	Store string `json:"store"`
+	// Key is the key that was changed.
+	Key []byte `json:"key"`
+	// Value is the new value of the key. It is empty when the key was deleted.
+	Value []byte `json:"value,omitempty"`
+	// Delete is true if the key was deleted.
+	Delete bool `json:"delete,omitempty"`
+}
+
+// Event is the message published for a typed event.
+type Event struct {
+	// Height is the height of the block that emitted the event.
+	Height int64 `json:"height"`
+	// TxHash is the hash of the tx that emitted the event. It is empty for events emitted outside of a tx.
+	TxHash string `json:"tx_hash,omitempty"`
+	// Type is the full proto name of the event.
+	Type string `json:"type"`
+	// Event is the decoded event as proto JSON.
+	Event json.RawMessage `json:"event"`
+}
+
+// ignoredEventAttributes are attributes the SDK adds to events that aren't part of the typed event.
+var ignoredEventAttributes = map[string]bool{
+	"mode":      true,
+	"msg_index": true,
+}
+
+// Listener is an ABCIListener that publishes the changes made to some stores, and the
+// typed events of those stores' modules, after each block is committed.
+type Listener struct {
+	publisher     Publisher
+	topicPrefix   string
+	stores        map[string]bool
+	eventPrefixes []string
+	timeout       time.Duration
+
+	height int64
+	events []Message
+}
+
+var _ storetypes.ABCIListener = (*Listener)(nil)
+
+// NewListener creates a new Listener that publishes using the provided publisher.
+func NewListener(cfg Config, publisher Publisher) *Listener {
+	rv := &Listener{
+		publisher:   publisher,
+		topicPrefix: cfg.TopicPrefix,
+		stores:      make(map[string]bool, len(cfg.Stores)),
+		timeout:     cfg.Timeout,
+	}
+	for _, store := range cfg.Stores {
+		rv.stores[store] = true
+		if cfg.Events {
+			rv.eventPrefixes = append(rv.eventPrefixes, "provenance."+store+".")
+		}
+	}
+	return rv
+}
+
+// topic returns the full name of the topic with the provided suffix.
+func (l *Listener) topic(suffix string) string {
+	return l.topicPrefix + "." + suffix
+}
+
+// isPublishedEvent returns true if events of the provided type should be published.
+func (l *Listener) isPublishedEvent(eventType string) bool {
+	for _, prefix := range l.eventPrefixes {
+		if strings.HasPrefix(eventType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListenFinalizeBlock decodes the typed events to publish and holds onto them until the block is committed.
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.height = req.Height
+	l.events = nil
+
+	if len(l.eventPrefixes) == 0 {
+		return nil
+	}
+	if err := l.addEvents(res.Events, ""); err != nil {
+		return err
+	}
+	for i, txRes := range res.TxResults {
+		var txHash string
+		if i < len(req.Txs) {
+			txHash = fmt.Sprintf("%X", cmttypes.Tx(req.Txs[i]).Hash())
+		}
+		if err := l.addEvents(txRes.Events, txHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addEvents decodes the provided events that should be published and adds them to the ones to publish at commit.
+func (l *Listener) addEvents(events []abci.Event, txHash string) error {
+	for _, event := range events {
+		if !l.isPublishedEvent(event.Type) {
+			continue
+		}
+		value, err := l.encodeEvent(event, txHash)
+		if err != nil {
+			return fmt.Errorf("could not decode %s event at height %d: %w", event.Type, l.height, err)
+		}
+		l.events = append(l.events, Message{Topic: l.topic(EventsTopic), Key: event.Type, Value: value})
+	}
+	return nil
+}
+
+// encodeEvent decodes the provided typed event and encodes it as the JSON of an Event.
+func (l *Listener) encodeEvent(event abci.Event, txHash string) ([]byte, error) {
+	typed := abci.Event{Type: event.Type}
+	for _, attr := range event.Attributes {
+		if !ignoredEventAttributes[attr.Key] {
+			typed.Attributes = append(typed.Attributes, attr)
+		}
+	}
+	msg, err := sdk.ParseTypedEvent(typed)
+	if err != nil {
+		return nil, err
+	}
+	eventJSON, err := codec.ProtoMarshalJSON(msg, nil)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(Event{Height: l.height, TxHash: txHash, Type: event.Type, Event: eventJSON})
+}
+
+// ListenCommit publishes the block's typed events followed by its changes to the published stores.
+func (l *Listener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	msgs := l.events
+	l.events = nil
+	for _, pair := range changeSet {
+		if pair == nil || !l.stores[pair.StoreKey] {
+			continue
+		}
+		value, err := json.Marshal(KVChange{
+			Height: l.height,
+			Store:  pair.StoreKey,
+			Key:    pair.Key,
+			Value:  pair.Value,
+			Delete: pair.Delete,
+		})
+		if err != nil {
+			return fmt.Errorf("could not encode %s change at height %d: %w", pair.StoreKey, l.height, err)
+		}
+		msgs = append(msgs, Message{Topic: l.topic(pair.StoreKey), Key: hex.EncodeToString(pair.Key), Value: value})
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+	if err := l.publisher.Publish(ctx, msgs); err != nil {
+		return fmt.Errorf("could not publish block %d: %w", l.height, err)
+	}
+	return nil
+}
+
+// RegisterFromAppOpts creates a Listener using the provided app options and registers it with the provided app.
+// Nothing is registered if the state publisher is not enabled.
+func RegisterFromAppOpts(app *baseapp.BaseApp, appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	cfg := ConfigFromAppOpts(appOpts)
+	if !cfg.Enabled {
+		return nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	// The BaseApp only holds one streaming manager, so the publisher can't be used along with a streaming plugin.
+	for service := range cast.ToStringMap(appOpts.Get(baseapp.StreamingTomlKey)) {
+		pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, service, baseapp.StreamingABCIPluginTomlKey)
+		if plugin := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey))); len(plugin) > 0 {
+			return fmt.Errorf("the state publisher cannot be used with the %q streaming plugin", plugin)
+		}
+	}
+
+	storeKeys := make([]storetypes.StoreKey, 0, len(cfg.Stores))
+	for _, store := range cfg.Stores {
+		key, found := keys[store]
+		if !found {
+			return fmt.Errorf("invalid %s: unknown store %q", FlagStores, store)
+		}
+		storeKeys = append(storeKeys, key)
+	}
+
+	publisher, err := NewPublisher(cfg)
+	if err != nil {
+		return err
+	}
+
+	app.CommitMultiStore().AddListeners(storeKeys)
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{NewListener(cfg, publisher)},
+		StopNodeOnErr: cfg.StopNodeOnErr,
+	})
+	return nil
+}
@@ -0,0 +1,104 @@
+package statestream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// mockPublisher is a Publisher that records what it was asked to publish.
+type mockPublisher struct {
+	published [][]Message
+	err       error
+}
+
+func (p *mockPublisher) Publish(_ context.Context, msgs []Message) error {
+	p.published = append(p.published, msgs)
+	return p.err
+}
+
+func TestListener(t *testing.T) {
+	markerEvent, err := sdk.TypedEventToEvent(&markertypes.EventMarkerMint{Amount: "5", Denom: "banana", Administrator: "admin"})
+	require.NoError(t, err, "TypedEventToEvent EventMarkerMint")
+	markerEvent.Attributes = append(markerEvent.Attributes, abci.EventAttribute{Key: "msg_index", Value: "0"})
+	blockEvent, err := sdk.TypedEventToEvent(&markertypes.EventMarkerBurn{Amount: "3", Denom: "banana", Administrator: "admin"})
+	require.NoError(t, err, "TypedEventToEvent EventMarkerBurn")
+	blockEvent.Attributes = append(blockEvent.Attributes, abci.EventAttribute{Key: "mode", Value: "EndBlock"})
+	otherEvent := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "5banana"}}}
+
+	req := abci.RequestFinalizeBlock{Height: 12, Txs: [][]byte{[]byte("tx1")}}
+	res := abci.ResponseFinalizeBlock{
+		Events:    []abci.Event{abci.Event(blockEvent), otherEvent},
+		TxResults: []*abci.ExecTxResult{{Events: []abci.Event{otherEvent, abci.Event(markerEvent)}}},
+	}
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "marker", Key: []byte{0x01, 0x02}, Value: []byte("value")},
+		{StoreKey: "bank", Key: []byte{0x03}, Value: []byte("ignored")},
+		{StoreKey: "marker", Key: []byte{0x04}, Delete: true},
+	}
+
+	cfg := DefaultConfig()
+	cfg.Stores = []string{"marker"}
+	publisher := &mockPublisher{}
+	listener := NewListener(cfg, publisher)
+
+	require.NoError(t, listener.ListenFinalizeBlock(context.Background(), req, res), "ListenFinalizeBlock")
+	assert.Empty(t, publisher.published, "published after ListenFinalizeBlock")
+	require.NoError(t, listener.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet), "ListenCommit")
+	require.Len(t, publisher.published, 1, "published after ListenCommit")
+	msgs := publisher.published[0]
+	require.Len(t, msgs, 4, "published messages")
+
+	var events []Event
+	for i, msg := range msgs[:2] {
+		assert.Equal(t, "provenance.events", msg.Topic, "[%d] topic", i)
+		var event Event
+		require.NoError(t, json.Unmarshal(msg.Value, &event), "[%d] Unmarshal Event", i)
+		events = append(events, event)
+	}
+	assert.Equal(t, "provenance.marker.v1.EventMarkerBurn", events[0].Type, "[0] type")
+	assert.Equal(t, int64(12), events[0].Height, "[0] height")
+	assert.Empty(t, events[0].TxHash, "[0] tx hash")
+	assert.JSONEq(t, `{"amount":"3","denom":"banana","administrator":"admin"}`, string(events[0].Event), "[0] event")
+	assert.Equal(t, "provenance.marker.v1.EventMarkerMint", events[1].Type, "[1] type")
+	assert.Len(t, events[1].TxHash, 64, "[1] tx hash")
+	assert.JSONEq(t, `{"amount":"5","denom":"banana","administrator":"admin"}`, string(events[1].Event), "[1] event")
+
+	expChanges := []KVChange{
+		{Height: 12, Store: "marker", Key: []byte{0x01, 0x02}, Value: []byte("value")},
+		{Height: 12, Store: "marker", Key: []byte{0x04}, Delete: true},
+	}
+	for i, msg := range msgs[2:] {
+		assert.Equal(t, "provenance.marker", msg.Topic, "[%d] topic", i+2)
+		var change KVChange
+		require.NoError(t, json.Unmarshal(msg.Value, &change), "[%d] Unmarshal KVChange", i+2)
+		assert.Equal(t, expChanges[i], change, "[%d] change", i+2)
+	}
+	assert.Equal(t, "0102", msgs[2].Key, "[2] key")
+
+	t.Run("nothing to publish", func(t *testing.T) {
+		publisher.published = nil
+		require.NoError(t, listener.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 13}, abci.ResponseFinalizeBlock{}), "ListenFinalizeBlock")
+		require.NoError(t, listener.ListenCommit(context.Background(), abci.ResponseCommit{}, nil), "ListenCommit")
+		assert.Empty(t, publisher.published, "published")
+	})
+
+	t.Run("publish error", func(t *testing.T) {
+		publisher.err = errors.New("broker down")
+		require.NoError(t, listener.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 14}, abci.ResponseFinalizeBlock{}), "ListenFinalizeBlock")
+		err = listener.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet)
+		assert.EqualError(t, err, "could not publish block 14: broker down", "ListenCommit")
+	})
+}
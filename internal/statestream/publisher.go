@@ -0,0 +1,283 @@
+package statestream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is a single message to publish.
+type Message struct {
+	// Topic is the topic (or subject) to publish the message to.
+	Topic string
+	// Key is used by the broker to keep related messages in order.
+	Key string
+	// Value is the JSON content of the message.
+	Value []byte
+}
+
+// Publisher publishes messages to a message broker.
+type Publisher interface {
+	// Publish publishes all of the provided messages, in order, returning once the broker has accepted them.
+	Publish(ctx context.Context, msgs []Message) error
+}
+
+// NewPublisher creates the Publisher for the config's backend.
+func NewPublisher(cfg Config) (Publisher, error) {
+	switch cfg.Backend {
+	case BackendKafka:
+		return NewKafkaPublisher(cfg.Endpoint)
+	case BackendNATS:
+		return NewNATSPublisher(cfg.Endpoint), nil
+	default:
+		return nil, fmt.Errorf("unknown state publisher backend %q", cfg.Backend)
+	}
+}
+
+// groupByTopic splits the provided messages by topic, maintaining their order.
+// The topics are returned in the order they are first seen.
+func groupByTopic(msgs []Message) ([]string, map[string][]Message) {
+	var topics []string
+	byTopic := make(map[string][]Message)
+	for _, msg := range msgs {
+		if _, seen := byTopic[msg.Topic]; !seen {
+			topics = append(topics, msg.Topic)
+		}
+		byTopic[msg.Topic] = append(byTopic[msg.Topic], msg)
+	}
+	return topics, byTopic
+}
+
+// KafkaContentType is the content type of the requests made to the Kafka REST proxy.
+const KafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaPublisher publishes messages to Kafka through a Kafka REST proxy.
+type KafkaPublisher struct {
+	endpoint string
+	client   *http.Client
+}
+
+var _ Publisher = (*KafkaPublisher)(nil)
+
+// NewKafkaPublisher creates a new KafkaPublisher that uses the REST proxy at the provided url.
+func NewKafkaPublisher(endpoint string) (*KafkaPublisher, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kafka endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid kafka endpoint %q: scheme must be http or https", endpoint)
+	}
+	return &KafkaPublisher{endpoint: strings.TrimSuffix(endpoint, "/"), client: &http.Client{}}, nil
+}
+
+// kafkaRecord is a record in a Kafka REST proxy produce request.
+type kafkaRecord struct {
+	Key   string          `json:"key,omitempty"`
+	Value json.RawMessage `json:"value"`
+}
+
+// kafkaProduceRequest is the body of a Kafka REST proxy produce request.
+type kafkaProduceRequest struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+// kafkaProduceResponse is the body of a Kafka REST proxy produce response.
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+// Publish produces the provided messages to their Kafka topics, one request per topic.
+func (p *KafkaPublisher) Publish(ctx context.Context, msgs []Message) error {
+	topics, byTopic := groupByTopic(msgs)
+	for _, topic := range topics {
+		if err := p.produce(ctx, topic, byTopic[topic]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// produce sends the provided messages to a single Kafka topic.
+func (p *KafkaPublisher) produce(ctx context.Context, topic string, msgs []Message) error {
+	body := kafkaProduceRequest{Records: make([]kafkaRecord, len(msgs))}
+	for i, msg := range msgs {
+		body.Records[i] = kafkaRecord{Key: msg.Key, Value: msg.Value}
+	}
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/topics/"+url.PathEscape(topic), bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", KafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not produce to kafka topic %s: %w", topic, err)
+	}
+	defer resp.Body.Close()
+	respBz, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("could not read kafka produce response for topic %s: %w", topic, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("could not produce to kafka topic %s: %s: %s", topic, resp.Status, strings.TrimSpace(string(respBz)))
+	}
+
+	var produceResp kafkaProduceResponse
+	if err = json.Unmarshal(respBz, &produceResp); err != nil {
+		return fmt.Errorf("could not decode kafka produce response for topic %s: %w", topic, err)
+	}
+	for i, offset := range produceResp.Offsets {
+		if offset.ErrorCode != nil || len(offset.Error) > 0 {
+			return fmt.Errorf("could not produce record %d to kafka topic %s: %s", i, topic, offset.Error)
+		}
+	}
+	return nil
+}
+
+// NATSPublisher publishes messages to a NATS server using the NATS client protocol.
+// A single connection is kept open and re-established as needed.
+type NATSPublisher struct {
+	address string
+
+	mtx    sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+var _ Publisher = (*NATSPublisher)(nil)
+
+// NewNATSPublisher creates a new NATSPublisher for the server at the provided address (host:port or nats://host:port).
+func NewNATSPublisher(address string) *NATSPublisher {
+	return &NATSPublisher{address: strings.TrimPrefix(address, "nats://")}
+}
+
+// Publish publishes the provided messages to their NATS subjects, then waits for the server to process them.
+func (p *NATSPublisher) Publish(ctx context.Context, msgs []Message) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	err := p.publish(ctx, msgs)
+	if err != nil {
+		p.close()
+		return fmt.Errorf("could not publish to nats server %s: %w", p.address, err)
+	}
+	return nil
+}
+
+// publish writes the provided messages to the connection, then waits for the server to process them.
+// The mutex must be locked before calling this.
+func (p *NATSPublisher) publish(ctx context.Context, msgs []Message) error {
+	if p.conn == nil {
+		if err := p.connect(ctx); err != nil {
+			return err
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := p.conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	} else if err := p.conn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, msg := range msgs {
+		fmt.Fprintf(&buf, "PUB %s %d\r\n", msg.Topic, len(msg.Value))
+		buf.Write(msg.Value)
+		buf.WriteString("\r\n")
+	}
+	// The server responds to a PING once everything before it has been processed.
+	buf.WriteString("PING\r\n")
+	if _, err := p.conn.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return p.waitForPong()
+}
+
+// connect opens a connection to the server and completes the protocol handshake.
+// The mutex must be locked before calling this.
+func (p *NATSPublisher) connect(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", p.address)
+	if err != nil {
+		return err
+	}
+	p.conn = conn
+	p.reader = bufio.NewReader(conn)
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	line, err := p.readLine()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected greeting %q", line)
+	}
+	_, err = io.WriteString(conn, `CONNECT {"verbose":false,"pedantic":false,"name":"provenanced"}`+"\r\n")
+	return err
+}
+
+// waitForPong reads from the connection until a PONG is received.
+// The mutex must be locked before calling this.
+func (p *NATSPublisher) waitForPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err = io.WriteString(p.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+// readLine reads a single protocol line from the connection.
+// The mutex must be locked before calling this.
+func (p *NATSPublisher) readLine() (string, error) {
+	line, err := p.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// close closes the connection (if open) so that the next publish reconnects.
+// The mutex must be locked before calling this.
+func (p *NATSPublisher) close() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+	}
+	p.conn = nil
+	p.reader = nil
+}
@@ -0,0 +1,117 @@
+package statestream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKafkaPublisher(t *testing.T) {
+	type produced struct {
+		path        string
+		contentType string
+		body        kafkaProduceRequest
+	}
+	var requests []produced
+	respBody := `{"offsets":[{"partition":0,"offset":1}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := produced{path: r.URL.Path, contentType: r.Header.Get("Content-Type")}
+		bz, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(bz, &req.body)
+		requests = append(requests, req)
+		_, _ = io.WriteString(w, respBody)
+	}))
+	defer server.Close()
+
+	publisher, err := NewKafkaPublisher(server.URL + "/")
+	require.NoError(t, err, "NewKafkaPublisher")
+
+	msgs := []Message{
+		{Topic: "pio.events", Key: "type", Value: []byte(`{"a":1}`)},
+		{Topic: "pio.marker", Key: "01", Value: []byte(`{"b":2}`)},
+		{Topic: "pio.events", Key: "type", Value: []byte(`{"c":3}`)},
+	}
+	require.NoError(t, publisher.Publish(context.Background(), msgs), "Publish")
+	require.Len(t, requests, 2, "requests")
+	assert.Equal(t, "/topics/pio.events", requests[0].path, "[0] path")
+	assert.Equal(t, KafkaContentType, requests[0].contentType, "[0] content type")
+	if assert.Len(t, requests[0].body.Records, 2, "[0] records") {
+		assert.JSONEq(t, `{"a":1}`, string(requests[0].body.Records[0].Value), "[0] records[0]")
+		assert.JSONEq(t, `{"c":3}`, string(requests[0].body.Records[1].Value), "[0] records[1]")
+	}
+	assert.Equal(t, "/topics/pio.marker", requests[1].path, "[1] path")
+	if assert.Len(t, requests[1].body.Records, 1, "[1] records") {
+		assert.Equal(t, "01", requests[1].body.Records[0].Key, "[1] records[0] key")
+	}
+
+	t.Run("record error", func(t *testing.T) {
+		respBody = `{"offsets":[{"error_code":40403,"error":"topic not found"}]}`
+		err = publisher.Publish(context.Background(), msgs[:1])
+		assert.EqualError(t, err, "could not produce record 0 to kafka topic pio.events: topic not found", "Publish")
+	})
+
+	t.Run("bad endpoint", func(t *testing.T) {
+		_, err = NewKafkaPublisher("localhost:8082")
+		assert.ErrorContains(t, err, "invalid kafka endpoint", "NewKafkaPublisher")
+	})
+}
+
+func TestNATSPublisher(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "Listen")
+	defer listener.Close()
+
+	received := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.WriteString(conn, `INFO {"server_id":"test"}`+"\r\n")
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			switch {
+			case line == "PING":
+				_, _ = io.WriteString(conn, "PONG\r\n")
+			case strings.HasPrefix(line, "PUB "):
+				var subject string
+				var size int
+				_, _ = fmt.Sscanf(line, "PUB %s %d", &subject, &size)
+				payload := make([]byte, size+2)
+				if _, err = io.ReadFull(reader, payload); err != nil {
+					return
+				}
+				received <- subject + " " + string(payload[:size])
+			}
+		}
+	}()
+
+	publisher := NewNATSPublisher("nats://" + listener.Addr().String())
+	msgs := []Message{
+		{Topic: "pio.events", Value: []byte(`{"a":1}`)},
+		{Topic: "pio.marker", Value: []byte(`{"b":2}`)},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, publisher.Publish(ctx, msgs), "Publish")
+	require.Len(t, received, 2, "received")
+	assert.Equal(t, `pio.events {"a":1}`, <-received, "received[0]")
+	assert.Equal(t, `pio.marker {"b":2}`, <-received, "received[1]")
+}
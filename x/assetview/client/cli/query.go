@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/provenance-io/provenance/x/assetview"
+)
+
+// exampleQueryCmdBase is the base command that gets a user to one of the query commands in here.
+var exampleQueryCmdBase = fmt.Sprintf("%s query %s", version.AppName, assetview.ModuleName)
+
+var exampleQueryAddr1 = sdk.AccAddress("exampleQueryAddr1___")
+
+// QueryCmd returns the top-level command for assetview CLI queries.
+func QueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        assetview.ModuleName,
+		Aliases:                    []string{"av"},
+		Short:                      "Querying commands for the assetview module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		QueryCmdDenomView(),
+		QueryCmdAddressView(),
+	)
+
+	return cmd
+}
+
+// QueryCmdDenomView returns the command for looking up everything about a denom.
+func QueryCmdDenomView() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom <denom>",
+		Short: "Get the marker, supply, metadata, net asset values, and scopes of a denom",
+		Long: `Get the marker, supply, metadata, net asset values, and scopes of a denom.
+The pagination flags only apply to the list of scopes owned by the denom's marker.`,
+		Example: fmt.Sprintf("$ %s denom nhash", exampleQueryCmdBase),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := assetview.QueryDenomViewRequest{Denom: args[0]}
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *assetview.QueryDenomViewResponse
+			queryClient := assetview.NewQueryClient(clientCtx)
+			res, err = queryClient.DenomView(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
+
+// QueryCmdAddressView returns the command for looking up everything about an address.
+func QueryCmdAddressView() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address <address>",
+		Aliases: []string{"addr"},
+		Short:   "Get the balances, holds, marker, and scopes of an address",
+		Long: `Get the balances, holds, marker, and scopes of an address.
+The pagination flags only apply to the list of scopes owned by the address.`,
+		Example: fmt.Sprintf("$ %s address %s", exampleQueryCmdBase, exampleQueryAddr1),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+			}
+
+			req := assetview.QueryAddressViewRequest{Address: args[0]}
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *assetview.QueryAddressViewResponse
+			queryClient := assetview.NewQueryClient(clientCtx)
+			res, err = queryClient.AddressView(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scopes")
+
+	return cmd
+}
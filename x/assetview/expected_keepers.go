@@ -0,0 +1,36 @@
+package assetview
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// MarkerKeeper defines the marker functionality needed by the assetview module.
+type MarkerKeeper interface {
+	GetMarker(ctx sdk.Context, address sdk.AccAddress) (markertypes.MarkerAccountI, error)
+	IterateNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress, handler func(state markertypes.NetAssetValue) (stop bool)) error
+}
+
+// BankKeeper defines the bank functionality needed by the assetview module.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// MetadataKeeper defines the metadata functionality needed by the assetview module.
+type MetadataKeeper interface {
+	GetScopeWithValueOwner(ctx sdk.Context, id metadatatypes.MetadataAddress) (scope metadatatypes.Scope, found bool)
+	ValueOwnership(ctx context.Context, req *metadatatypes.ValueOwnershipRequest) (*metadatatypes.ValueOwnershipResponse, error)
+}
+
+// HoldKeeper defines the hold functionality needed by the assetview module.
+type HoldKeeper interface {
+	GetHoldCoins(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/assetview"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+var _ assetview.QueryServer = Keeper{}
+
+// DenomView returns everything known about a denom.
+func (k Keeper) DenomView(goCtx context.Context, req *assetview.QueryDenomViewRequest) (*assetview.QueryDenomViewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &assetview.QueryDenomViewResponse{
+		Denom:  req.Denom,
+		Supply: k.bankKeeper.GetSupply(ctx, req.Denom),
+	}
+
+	if md, found := k.bankKeeper.GetDenomMetaData(ctx, req.Denom); found {
+		resp.Metadata = &md
+	}
+
+	if scopeID, err := metadatatypes.MetadataAddressFromDenom(req.Denom); err == nil && scopeID.IsScopeAddress() {
+		if scope, found := k.metadataKeeper.GetScopeWithValueOwner(ctx, scopeID); found {
+			resp.Scope = &scope
+		}
+	}
+
+	markerAddr, err := markertypes.MarkerAddress(req.Denom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom: %v", err)
+	}
+	marker := k.getMarker(ctx, markerAddr)
+	if marker == nil {
+		return resp, nil
+	}
+
+	resp.Marker, err = codectypes.NewAnyWithValue(marker)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not encode marker: %v", err)
+	}
+	resp.NetAssetValues, err = k.getNetAssetValues(ctx, markerAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Escrow = k.bankKeeper.GetAllBalances(ctx, markerAddr)
+	resp.ScopeIds, resp.Pagination, err = k.getScopeIDs(ctx, markerAddr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// AddressView returns everything known about an address.
+func (k Keeper) AddressView(goCtx context.Context, req *assetview.QueryAddressViewRequest) (*assetview.QueryAddressViewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Address) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &assetview.QueryAddressViewResponse{
+		Address:   req.Address,
+		Balances:  withoutScopeDenoms(k.bankKeeper.GetAllBalances(ctx, addr)),
+		Spendable: withoutScopeDenoms(k.bankKeeper.SpendableCoins(ctx, addr)),
+	}
+
+	resp.Holds, err = k.holdKeeper.GetHoldCoins(ctx, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not get holds: %v", err)
+	}
+
+	if marker := k.getMarker(ctx, addr); marker != nil {
+		resp.Marker, err = codectypes.NewAnyWithValue(marker)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not encode marker: %v", err)
+		}
+	}
+
+	resp.ScopeIds, resp.Pagination, err = k.getScopeIDs(ctx, addr, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
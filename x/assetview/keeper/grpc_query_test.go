@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/assetview"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+type QueryTestSuite struct {
+	suite.Suite
+
+	app *app.App
+	ctx sdk.Context
+
+	bondDenom  string
+	markerAddr sdk.AccAddress
+	addr1      sdk.AccAddress
+	addr2      sdk.AccAddress
+
+	scope1 metadatatypes.MetadataAddress
+	scope2 metadatatypes.MetadataAddress
+	scope3 metadatatypes.MetadataAddress
+}
+
+func (s *QueryTestSuite) SetupTest() {
+	s.app = app.Setup(s.T())
+	s.ctx = s.app.BaseApp.NewContext(false)
+
+	var err error
+	s.bondDenom, err = s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(err, "BondDenom")
+
+	addrs := app.AddTestAddrsIncremental(s.app, s.ctx, 2, sdkmath.NewInt(1_000_000))
+	s.addr1 = addrs[0]
+	s.addr2 = addrs[1]
+
+	s.markerAddr = markertypes.MustGetMarkerAddress("viewcoin")
+	marker := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(s.markerAddr),
+		sdk.NewInt64Coin("viewcoin", 1000),
+		s.addr1,
+		[]markertypes.AccessGrant{{Address: s.addr1.String(), Permissions: markertypes.AccessList{
+			markertypes.Access_Mint, markertypes.Access_Burn, markertypes.Access_Withdraw, markertypes.Access_Admin,
+		}}},
+		markertypes.StatusProposed,
+		markertypes.MarkerType_Coin,
+		true, true, false, nil,
+	)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, marker, markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, 5), 1), "test"), "SetNetAssetValue")
+	s.Require().NoError(s.app.MarkerKeeper.AddFinalizeAndActivateMarker(s.ctx, marker), "AddFinalizeAndActivateMarker")
+	s.app.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base:       "viewcoin",
+		Display:    "viewcoin",
+		Name:       "viewcoin",
+		Symbol:     "VIEW",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "viewcoin", Exponent: 0}},
+	})
+
+	s.scope1 = metadatatypes.ScopeMetadataAddress(uuid.New())
+	s.scope2 = metadatatypes.ScopeMetadataAddress(uuid.New())
+	s.scope3 = metadatatypes.ScopeMetadataAddress(uuid.New())
+	specID := metadatatypes.ScopeSpecMetadataAddress(uuid.New())
+	for _, entry := range []struct {
+		id    metadatatypes.MetadataAddress
+		owner sdk.AccAddress
+	}{
+		{id: s.scope1, owner: s.addr1},
+		{id: s.scope2, owner: s.addr1},
+		{id: s.scope3, owner: s.markerAddr},
+	} {
+		scope := metadatatypes.Scope{
+			ScopeId:           entry.id,
+			SpecificationId:   specID,
+			Owners:            []metadatatypes.Party{{Address: entry.owner.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}},
+			ValueOwnerAddress: entry.owner.String(),
+		}
+		s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope(%s)", entry.id)
+	}
+
+	s.Require().NoError(s.app.HoldKeeper.AddHold(s.ctx, s.addr1, sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 100)), "test"), "AddHold")
+}
+
+func TestQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryTestSuite))
+}
+
+func (s *QueryTestSuite) TestDenomView() {
+	tests := []struct {
+		name      string
+		req       *assetview.QueryDenomViewRequest
+		expErr    string
+		expSupply sdk.Coin
+		expMarker bool
+		expMeta   bool
+		expNAVs   int
+		expEscrow sdk.Coins
+		expScopes []string
+		expScope  metadatatypes.MetadataAddress
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = empty request",
+		},
+		{
+			name:   "invalid denom",
+			req:    &assetview.QueryDenomViewRequest{Denom: "x"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid denom: invalid denom: x",
+		},
+		{
+			name:      "marker denom",
+			req:       &assetview.QueryDenomViewRequest{Denom: "viewcoin"},
+			expSupply: sdk.NewInt64Coin("viewcoin", 1000),
+			expMarker: true,
+			expMeta:   true,
+			expNAVs:   1,
+			expEscrow: sdk.NewCoins(sdk.NewInt64Coin("viewcoin", 1000), s.scope3.Coin()),
+			expScopes: []string{s.scope3.String()},
+		},
+		{
+			name:      "denom without a marker",
+			req:       &assetview.QueryDenomViewRequest{Denom: s.bondDenom},
+			expSupply: s.app.BankKeeper.GetSupply(s.ctx, s.bondDenom),
+		},
+		{
+			name:      "scope denom",
+			req:       &assetview.QueryDenomViewRequest{Denom: s.scope1.Denom()},
+			expSupply: s.scope1.Coin(),
+			expScope:  s.scope1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.AssetViewKeeper.DenomView(s.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "DenomView error")
+				s.Assert().Nil(resp, "DenomView response")
+				return
+			}
+			s.Require().NoError(err, "DenomView error")
+			s.Require().NotNil(resp, "DenomView response")
+			s.Assert().Equal(tc.req.Denom, resp.Denom, "Denom")
+			s.Assert().Equal(tc.expSupply.String(), resp.Supply.String(), "Supply")
+			s.Assert().Equal(tc.expMarker, resp.Marker != nil, "has Marker")
+			s.Assert().Equal(tc.expMeta, resp.Metadata != nil, "has Metadata")
+			s.Assert().Len(resp.NetAssetValues, tc.expNAVs, "NetAssetValues")
+			s.Assert().Equal(tc.expEscrow.String(), resp.Escrow.String(), "Escrow")
+			s.Assert().Equal(tc.expScopes, resp.ScopeIds, "ScopeIds")
+			if tc.expScope.Empty() {
+				s.Assert().Nil(resp.Scope, "Scope")
+			} else if s.Assert().NotNil(resp.Scope, "Scope") {
+				s.Assert().Equal(tc.expScope, resp.Scope.ScopeId, "Scope.ScopeId")
+			}
+		})
+	}
+}
+
+func (s *QueryTestSuite) TestAddressView() {
+	holdCoins := sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 100))
+	bal := sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, 1_000_000))
+
+	tests := []struct {
+		name         string
+		req          *assetview.QueryAddressViewRequest
+		expErr       string
+		expBalances  sdk.Coins
+		expSpendable sdk.Coins
+		expHolds     sdk.Coins
+		expMarker    bool
+		expScopes    int
+		expNextKey   bool
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = empty request",
+		},
+		{
+			name:   "empty address",
+			req:    &assetview.QueryAddressViewRequest{},
+			expErr: "rpc error: code = InvalidArgument desc = address cannot be empty",
+		},
+		{
+			name:   "invalid address",
+			req:    &assetview.QueryAddressViewRequest{Address: "notanaddress"},
+			expErr: "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:         "account with holds and scopes",
+			req:          &assetview.QueryAddressViewRequest{Address: s.addr1.String()},
+			expBalances:  bal,
+			expSpendable: bal.Sub(holdCoins...),
+			expHolds:     holdCoins,
+			expScopes:    2,
+		},
+		{
+			name:         "account with scopes paginated",
+			req:          &assetview.QueryAddressViewRequest{Address: s.addr1.String(), Pagination: &query.PageRequest{Limit: 1}},
+			expBalances:  bal,
+			expSpendable: bal.Sub(holdCoins...),
+			expHolds:     holdCoins,
+			expScopes:    1,
+			expNextKey:   true,
+		},
+		{
+			name:         "account without holds or scopes",
+			req:          &assetview.QueryAddressViewRequest{Address: s.addr2.String()},
+			expBalances:  bal,
+			expSpendable: bal,
+		},
+		{
+			name:         "marker account",
+			req:          &assetview.QueryAddressViewRequest{Address: s.markerAddr.String()},
+			expBalances:  sdk.NewCoins(sdk.NewInt64Coin("viewcoin", 1000)),
+			expSpendable: sdk.NewCoins(sdk.NewInt64Coin("viewcoin", 1000)),
+			expMarker:    true,
+			expScopes:    1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.AssetViewKeeper.AddressView(s.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "AddressView error")
+				s.Assert().Nil(resp, "AddressView response")
+				return
+			}
+			s.Require().NoError(err, "AddressView error")
+			s.Require().NotNil(resp, "AddressView response")
+			s.Assert().Equal(tc.req.Address, resp.Address, "Address")
+			s.Assert().Equal(tc.expBalances.String(), resp.Balances.String(), "Balances")
+			s.Assert().Equal(tc.expSpendable.String(), resp.Spendable.String(), "Spendable")
+			s.Assert().Equal(tc.expHolds.String(), resp.Holds.String(), "Holds")
+			s.Assert().Equal(tc.expMarker, resp.Marker != nil, "has Marker")
+			s.Assert().Len(resp.ScopeIds, tc.expScopes, "ScopeIds")
+			s.Assert().Equal(tc.expNextKey, resp.Pagination != nil && len(resp.Pagination.NextKey) > 0, "has Pagination.NextKey")
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/assetview"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// Keeper brings together the state of several modules to provide a unified view of an asset.
+// It does not have any state of its own.
+type Keeper struct {
+	markerKeeper   assetview.MarkerKeeper
+	bankKeeper     assetview.BankKeeper
+	metadataKeeper assetview.MetadataKeeper
+	holdKeeper     assetview.HoldKeeper
+}
+
+// NewKeeper creates a new assetview Keeper.
+func NewKeeper(
+	markerKeeper assetview.MarkerKeeper,
+	bankKeeper assetview.BankKeeper,
+	metadataKeeper assetview.MetadataKeeper,
+	holdKeeper assetview.HoldKeeper,
+) Keeper {
+	return Keeper{
+		markerKeeper:   markerKeeper,
+		bankKeeper:     bankKeeper,
+		metadataKeeper: metadataKeeper,
+		holdKeeper:     holdKeeper,
+	}
+}
+
+// getMarker returns the marker account at the provided address, or nil if there isn't one.
+func (k Keeper) getMarker(ctx sdk.Context, addr sdk.AccAddress) markertypes.MarkerAccountI {
+	marker, err := k.markerKeeper.GetMarker(ctx, addr)
+	if err != nil {
+		return nil
+	}
+	return marker
+}
+
+// getNetAssetValues returns all the net asset values recorded for the marker at the provided address.
+func (k Keeper) getNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress) ([]markertypes.NetAssetValue, error) {
+	var rv []markertypes.NetAssetValue
+	err := k.markerKeeper.IterateNetAssetValues(ctx, markerAddr, func(nav markertypes.NetAssetValue) bool {
+		rv = append(rv, nav)
+		return false
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get net asset values: %w", err)
+	}
+	return rv, nil
+}
+
+// getScopeIDs returns the bech32 ids of the scopes that have the provided address as their value owner.
+func (k Keeper) getScopeIDs(ctx sdk.Context, addr sdk.AccAddress, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	resp, err := k.metadataKeeper.ValueOwnership(ctx, &metadatatypes.ValueOwnershipRequest{
+		Address:    addr.String(),
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get scopes owned by %s: %w", addr, err)
+	}
+	rv := make([]string, 0, len(resp.ScopeUuids))
+	for _, scopeUUID := range resp.ScopeUuids {
+		id, err := uuid.Parse(scopeUUID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid scope uuid %q owned by %s: %w", scopeUUID, addr, err)
+		}
+		rv = append(rv, metadatatypes.ScopeMetadataAddress(id).String())
+	}
+	return rv, resp.Pagination, nil
+}
+
+// withoutScopeDenoms returns the provided coins without any scope value owner denoms.
+func withoutScopeDenoms(coins sdk.Coins) sdk.Coins {
+	var rv sdk.Coins
+	for _, coin := range coins {
+		if !strings.HasPrefix(coin.Denom, metadatatypes.DenomPrefix) {
+			rv = append(rv, coin)
+		}
+	}
+	return rv
+}
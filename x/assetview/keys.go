@@ -0,0 +1,6 @@
+package assetview
+
+const (
+	// ModuleName is the name of the assetview module.
+	ModuleName = "assetview"
+)
@@ -0,0 +1,74 @@
+package module
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/provenance-io/provenance/x/assetview"
+	"github.com/provenance-io/provenance/x/assetview/client/cli"
+	"github.com/provenance-io/provenance/x/assetview/keeper"
+)
+
+var (
+	_ module.AppModuleBasic = (*AppModule)(nil)
+	_ module.HasServices    = (*AppModule)(nil)
+
+	_ appmodule.AppModule = (*AppModule)(nil)
+)
+
+// AppModule is the assetview module. It only provides queries and does not have any state of its own.
+type AppModule struct {
+	AppModuleBasic
+	keeper keeper.Keeper
+}
+
+func NewAppModule(assetviewKeeper keeper.Keeper) AppModule {
+	return AppModule{keeper: assetviewKeeper}
+}
+
+// IsOnePerModuleType is a dummy function that satisfies the OnePerModuleType interface (needed by AppModule).
+func (AppModule) IsOnePerModuleType() {}
+
+// IsAppModule is a dummy function that satisfies the AppModule interface.
+func (AppModule) IsAppModule() {}
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string {
+	return assetview.ModuleName
+}
+
+// GetQueryCmd returns the cli query commands for the assetview module.
+func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.QueryCmd()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the assetview module.
+func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux *runtime.ServeMux) {
+	if err := assetview.RegisterQueryHandlerClient(context.Background(), mux, assetview.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterInterfaces registers the assetview module's interface types, of which there are none.
+func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+// RegisterLegacyAminoCodec registers the assetview module's types for the given codec, of which there are none.
+func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterServices registers a gRPC query service to respond to the assetview-specific gRPC queries.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	assetview.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
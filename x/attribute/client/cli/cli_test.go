@@ -192,6 +192,12 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			attributetypes.AttributeType_String,
 			[]byte("more accountdata set at genesis"),
 			nil),
+		attributetypes.NewAttribute(
+			"example.attribute.kyc",
+			s.account2Str,
+			attributetypes.AttributeType_Hashed,
+			attributetypes.HashAttributeValue([]byte("salt"), []byte("some private value")),
+			nil),
 	)
 	s.accAttrCount = 500
 	for i := 0; i < s.accAttrCount; i++ {
@@ -361,7 +367,7 @@ func (s *IntegrationTestSuite) TestGetAccountAttributeCmd() {
 		{
 			name:           "should get attribute by name with json output",
 			args:           []string{s.account1Addr.String(), "example.attribute", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null,"origin":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			name: "should get attribute by name with text output",
@@ -372,6 +378,7 @@ attributes:
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: example.attribute
+  origin: null
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
   next_key: null
@@ -414,7 +421,7 @@ func (s *IntegrationTestSuite) TestScanAccountAttributesCmd() {
 		{
 			name:           "should get attribute by suffix with json output",
 			args:           []string{s.account1Addr.String(), "attribute", fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
+			expectedOutput: fmt.Sprintf(`{"account":"%s","attributes":[{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%s","expiration_date":null,"origin":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String(), s.account1Addr.String()),
 		},
 		{
 			name: "should get attribute by suffix with text output",
@@ -425,6 +432,7 @@ attributes:
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: example.attribute
+  origin: null
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 pagination:
   next_key: null
@@ -467,7 +475,7 @@ func (s *IntegrationTestSuite) TestListAccountAttributesCmd() {
 		{
 			name:           "should list all attributes for account with json output",
 			args:           []string{s.account1Addr.String(), fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			expectedOutput: fmt.Sprintf(`{"account":"%[1]s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%[1]s","expiration_date":null},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null},{"name":"accountdata","value":"YWNjb3VudGRhdGEgc2V0IGF0IGdlbmVzaXM=","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String()),
+			expectedOutput: fmt.Sprintf(`{"account":"%[1]s","attributes":[{"name":"example.attribute.count","value":"Mg==","attribute_type":"ATTRIBUTE_TYPE_INT","address":"%[1]s","expiration_date":null,"origin":null},{"name":"example.attribute","value":"ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null,"origin":null},{"name":"accountdata","value":"YWNjb3VudGRhdGEgc2V0IGF0IGdlbmVzaXM=","attribute_type":"ATTRIBUTE_TYPE_STRING","address":"%[1]s","expiration_date":null,"origin":null}],"pagination":{"next_key":null,"total":"0"}}`, s.account1Addr.String()),
 		},
 		{
 			name: "should list all attributes for account text output",
@@ -478,16 +486,19 @@ attributes:
   attribute_type: ATTRIBUTE_TYPE_INT
   expiration_date: null
   name: example.attribute.count
+  origin: null
   value: Mg==
 - address: %[1]s
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: example.attribute
+  origin: null
   value: ZXhhbXBsZSBhdHRyaWJ1dGUgdmFsdWUgc3RyaW5n
 - address: %[1]s
   attribute_type: ATTRIBUTE_TYPE_STRING
   expiration_date: null
   name: accountdata
+  origin: null
   value: YWNjb3VudGRhdGEgc2V0IGF0IGdlbmVzaXM=
 pagination:
   next_key: null
@@ -563,6 +574,58 @@ func (s *IntegrationTestSuite) TestAttributeAccountsCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestVerifyHashedAttributeCmd() {
+	saltB64 := base64.StdEncoding.EncodeToString([]byte("salt"))
+	asJSON := fmt.Sprintf("--%s=json", cmtcli.OutputFlag)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      string
+		expectedOutput string
+	}{
+		{
+			name:           "matching preimage",
+			args:           []string{s.account2Str, "example.attribute.kyc", saltB64, "some private value", asJSON},
+			expectedOutput: `{"verified":true}`,
+		},
+		{
+			name:           "wrong preimage",
+			args:           []string{s.account2Str, "example.attribute.kyc", saltB64, "some other value", asJSON},
+			expectedOutput: `{"verified":false}`,
+		},
+		{
+			name:           "not a hashed attribute",
+			args:           []string{s.account1Str, "example.attribute", saltB64, "example attribute value string", asJSON},
+			expectedOutput: `{"verified":false}`,
+		},
+		{
+			name:      "invalid salt",
+			args:      []string{s.account2Str, "example.attribute.kyc", "not base64!", "some private value", asJSON},
+			expectErr: `invalid salt "not base64!": illegal base64 data at input byte 3`,
+		},
+		{
+			name:      "invalid address",
+			args:      []string{"invalid", "example.attribute.kyc", saltB64, "some private value", asJSON},
+			expectErr: `failed to verify hashed attribute "example.attribute.kyc" for "invalid"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.VerifyHashedAttributeCmd()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if len(tc.expectErr) > 0 {
+				s.Require().ErrorContains(err, tc.expectErr, "VerifyHashedAttributeCmd error")
+				return
+			}
+			s.Require().NoError(err, "VerifyHashedAttributeCmd error")
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()), "VerifyHashedAttributeCmd output")
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestAttributeTxCommands() {
 	testCases := []struct {
 		name         string
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -31,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 		ScanAccountAttributesCmd(),
 		GetAttributeAccountsCmd(),
 		GetAccountDataCmd(),
+		VerifyHashedAttributeCmd(),
+		GetAttributeMirrorCmd(),
+		GetAttributeDependentsCmd(),
 	)
 
 	return queryCmd
@@ -273,3 +277,116 @@ func GetAccountDataCmd() *cobra.Command {
 
 	return cmd
 }
+
+// VerifyHashedAttributeCmd checks a preimage against the hashed attributes on an account
+func VerifyHashedAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "verify-hashed <address> <name> <salt> <preimage>",
+		Short:   "Check that a preimage matches a hashed account attribute",
+		Aliases: []string{"verify", "vh"},
+		Long: `Check that a preimage matches a hashed account attribute.
+The salt must be base64 encoded. The preimage is used as provided.
+The result is true if the account has an unexpired hashed attribute with the given name
+whose value is the SHA-256 hash of the salt followed by the preimage.`,
+		Example: fmt.Sprintf(`$ %[1]s query attribute verify-hashed pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk "kyc.pb" "c2FsdA==" "some private value"`, version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			salt, err := base64.StdEncoding.DecodeString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("invalid salt %q: %w", args[2], err)
+			}
+			req := &types.QueryVerifyHashedAttributeRequest{
+				Account:  strings.TrimSpace(args[0]),
+				Name:     strings.ToLower(strings.TrimSpace(args[1])),
+				Salt:     salt,
+				Preimage: []byte(args[3]),
+			}
+
+			response, err := queryClient.VerifyHashedAttribute(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to verify hashed attribute %q for %q: %w", req.Name, req.Account, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeMirrorCmd returns the command handler for querying a smart contract's attribute mirror.
+func GetAttributeMirrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mirror <contract>",
+		Short:   "Look up whether a smart contract inherits the attributes of its admin",
+		Example: fmt.Sprintf(`$ %[1]s query attribute mirror pb14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s96lrg8`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAttributeMirrorRequest{Contract: strings.TrimSpace(args[0])}
+
+			response, err := queryClient.AttributeMirror(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query attribute mirror for %q: %w", req.Contract, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeDependentsCmd returns the command handler for looking up the things that require an attribute name.
+func GetAttributeDependentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dependents <name>",
+		Aliases: []string{"impact"},
+		Short:   "Look up the markers and exchange markets that require an attribute name",
+		Long: strings.TrimSpace(`Look up the markers and exchange markets that require an attribute name.
+These are the things that would be affected if the name were unbound and its attributes deleted.`),
+		Example: fmt.Sprintf(`$ %[1]s query attribute dependents kyc.provenance.io`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttributeDependentsRequest{Name: strings.TrimSpace(args[0]), Pagination: pageReq}
+
+			response, err := queryClient.AttributeDependents(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query attribute dependents for %q: %w", req.Name, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dependents")
+
+	return cmd
+}
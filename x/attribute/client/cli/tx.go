@@ -2,6 +2,7 @@ package cli
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -37,6 +38,11 @@ func NewTxCmd() *cobra.Command {
 		NewSetAccountDataCmd(),
 		NewUpdateAccountAttributeExpirationCmd(),
 		NewUpdateParamsCmd(),
+		NewSetAttributeMirrorCmd(),
+		NewSetRefreshOracleCmd(),
+		NewRefreshAttributesCmd(),
+		NewPatchAccountAttributeCmd(),
+		NewSetAttestationSourceCmd(),
 	)
 	return txCmd
 }
@@ -160,7 +166,7 @@ func NewUpdateAccountAttributeCmd() *cobra.Command {
 
 func encodeAttributeValue(value string, attrType types.AttributeType) ([]byte, error) {
 	var encodedValue []byte
-	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto {
+	if attrType == types.AttributeType_Bytes || attrType == types.AttributeType_Proto || attrType == types.AttributeType_Hashed {
 		var err error
 		if encodedValue, err = base64.StdEncoding.DecodeString(value); err != nil {
 			return nil, err
@@ -322,11 +328,14 @@ $ %[1]s tx attribute account-data --%s
 // NewUpdateParamsCmd creates a command to update the attribute module's params via governance proposal.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-params <max-value-length>",
-		Short:   "Update the attribute module's params via governance proposal",
-		Long:    "Submit an update params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(1),
-		Example: fmt.Sprintf(`%[1]s tx attribute update-params 100 --deposit 50000nhash`, version.AppName),
+		Use:   "update-params <max-value-length>",
+		Short: "Update the attribute module's params via governance proposal",
+		Long: strings.TrimSpace(`Submit an update params via governance proposal along with an initial deposit.
+The value size fee flags define the msg fee surcharges for writing attribute values based on their size.
+Values at least --medium-min-length long are medium, values at least --large-min-length long are large, and the rest are small.`),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s tx attribute update-params 100 --deposit 50000nhash
+%[1]s tx attribute update-params 10000 --medium-min-length 1000 --large-min-length 5000 --medium-fee 100000000nhash --large-fee 1000000000nhash --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -341,13 +350,187 @@ func NewUpdateParamsCmd() *cobra.Command {
 			}
 			maxValueLength32 := uint32(maxValueLength) //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 			msg := types.NewMsgUpdateParamsRequest(authority, maxValueLength32)
+			msg.Params.ValueSizeFees, err = ReadValueSizeFeesFlags(flagSet)
+			if err != nil {
+				return err
+			}
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	AddValueSizeFeesFlagsToCmd(cmd)
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewSetAttributeMirrorCmd creates a command for a smart contract's admin to set whether the contract inherits
+// the attributes of its admin for required attribute checks.
+func NewSetAttributeMirrorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mirror <contract> {true|false}",
+		Short: "Set whether a smart contract inherits the attributes of its admin for required attribute checks",
+		Long: strings.TrimSpace(`Set whether a smart contract inherits the attributes of its admin for required attribute checks.
+This must be signed by the contract's admin. The attributes of whoever is the contract's admin at the time of a check are used.`),
+		Example: fmt.Sprintf(`$ %s tx attribute set-mirror pb14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s96lrg8 true --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid enabled value %q: %w", args[1], err)
+			}
+
+			msg := &types.MsgSetAttributeMirrorRequest{
+				Contract: strings.TrimSpace(args[0]),
+				Enabled:  enabled,
+				Admin:    clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetRefreshOracleCmd creates a command for a name owner to set whether an address is allowed to
+// extend the expiration of existing attributes with that name.
+func NewSetRefreshOracleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-refresh-oracle <name> <oracle> {true|false}",
+		Short: "Set whether an address is allowed to extend the expiration of attributes with a name",
+		Long: strings.TrimSpace(`Set whether an address is allowed to extend the expiration of existing attributes with a name.
+This must be signed by the address that the name resolves to. A refresh oracle can only extend expiration dates,
+it cannot change attribute values.`),
+		Example: fmt.Sprintf(`$ %s tx attribute set-refresh-oracle "kyc.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx true --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid enabled value %q: %w", args[2], err)
+			}
+
+			msg := &types.MsgSetRefreshOracleRequest{
+				Name:    strings.ToLower(strings.TrimSpace(args[0])),
+				Oracle:  strings.TrimSpace(args[1]),
+				Enabled: enabled,
+				Owner:   clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRefreshAttributesCmd creates a command for a refresh oracle to extend the expiration of existing attributes.
+func NewRefreshAttributesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh <name> <expiration-date> <account> [<account> ...]",
+		Short: "Extend the expiration of existing attributes without changing their values",
+		Long: strings.TrimSpace(`Extend the expiration of all attributes with a name on each of the provided accounts.
+The attribute values are not changed. This must be signed by a refresh oracle of the name, or by the address that the
+name resolves to. Each account must already have the attribute with an expiration date that is not after the new one.`),
+		Example: fmt.Sprintf(`$ %s tx attribute refresh "kyc.pb" 2050-01-15T00:00:00Z tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx --from oracle`, version.AppName),
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expirationDate, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[1], time.RFC3339, err)
+			}
+
+			msg := types.NewMsgRefreshAttributesRequest(args[0], args[2:], expirationDate, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPatchAccountAttributeCmd creates a command for applying a JSON patch to a JSON account attribute.
+func NewPatchAccountAttributeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch <name> <address> <value-hash> <patch>",
+		Short: "Update a json account attribute by applying an RFC 6902 JSON patch to its value",
+		Long: strings.TrimSpace(`Update a json account attribute by applying an RFC 6902 JSON patch to its value.
+The value hash is the hex encoded SHA-256 hash of the attribute's current value, and identifies which attribute with
+the name is patched. The patch is a JSON array of add, remove, replace, move, copy, and test operations.
+The patched value must still be valid JSON and within the max value length.`),
+		Example: fmt.Sprintf(`$ %s tx attribute patch "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx \
+	5f7ac15d1a3d1e4bd2ab86cd2eef7bfb3c6e9a2c54a7a4b3b2e6e9b6b5a4a3a2 '[{"op":"replace","path":"/status","value":"active"}]'`, version.AppName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valueHash, err := hex.DecodeString(strings.TrimSpace(args[2]))
+			if err != nil {
+				return fmt.Errorf("invalid value hash %q: %w", args[2], err)
+			}
+
+			msg := types.NewMsgPatchAttributeRequest(args[1], clientCtx.GetFromAddress(), args[0], valueHash, []byte(args[3]))
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAttestationSourceCmd creates a command for a name owner to set whether an issuer on another chain is allowed to
+// attest to attributes with that name over an IBC channel.
+func NewSetAttestationSourceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-attestation-source <name> <channel-id> <issuer> {true|false}",
+		Short: "Set whether an issuer on another chain is allowed to attest to attributes with a name",
+		Long: strings.TrimSpace(`Set whether an issuer on another chain is allowed to attest to attributes with a name.
+Attestations received from an approved issuer on the given IBC channel are stored as attributes here, along with
+where they came from. This must be signed by the address that the name resolves to.`),
+		Example: fmt.Sprintf(`$ %s tx attribute set-attestation-source "kyc.pb" channel-3 kyc-issuer-1 true --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid enabled value %q: %w", args[3], err)
+			}
+
+			msg := types.NewMsgSetAttestationSourceRequest(args[0], strings.TrimSpace(args[1]), strings.TrimSpace(args[2]),
+				enabled, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,6 +7,10 @@ import (
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
 const (
@@ -25,6 +29,17 @@ const (
 
 	// AccountDataFlagsUse is a use string for the mutually exclusive account data flags.
 	AccountDataFlagsUse = "{" + flagValueUse + "|" + flagFileUse + "|" + flagDeleteUse + "}"
+
+	// FlagMediumMinLength is a flag name for the length at which a value is considered medium.
+	FlagMediumMinLength = "medium-min-length"
+	// FlagLargeMinLength is a flag name for the length at which a value is considered large.
+	FlagLargeMinLength = "large-min-length"
+	// FlagSmallFee is a flag name for the surcharge for writing a small value.
+	FlagSmallFee = "small-fee"
+	// FlagMediumFee is a flag name for the surcharge for writing a medium value.
+	FlagMediumFee = "medium-fee"
+	// FlagLargeFee is a flag name for the surcharge for writing a large value.
+	FlagLargeFee = "large-fee"
 )
 
 // AddAccountDataFlagsToCmd adds flags to a command for providing account data.
@@ -87,3 +102,49 @@ func ReadAccountDataFlags(flagSet *flag.FlagSet) (string, error) {
 	}
 	return string(bz), nil
 }
+
+// AddValueSizeFeesFlagsToCmd adds flags to a command for providing the value size fees.
+// See also: ReadValueSizeFeesFlags
+func AddValueSizeFeesFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMediumMinLength, 0, "The length (in bytes) at which a value is considered medium (0 = no medium values)")
+	cmd.Flags().Uint32(FlagLargeMinLength, 0, "The length (in bytes) at which a value is considered large (0 = no large values)")
+	cmd.Flags().String(FlagSmallFee, "", "The surcharge for writing a small value")
+	cmd.Flags().String(FlagMediumFee, "", "The surcharge for writing a medium value")
+	cmd.Flags().String(FlagLargeFee, "", "The surcharge for writing a large value")
+}
+
+// ReadValueSizeFeesFlags parses the value size fees flags.
+// See also: AddValueSizeFeesFlagsToCmd
+func ReadValueSizeFeesFlags(flagSet *flag.FlagSet) (types.ValueSizeFees, error) {
+	var rv types.ValueSizeFees
+	var err error
+	rv.MediumMinLength, err = flagSet.GetUint32(FlagMediumMinLength)
+	if err != nil {
+		return rv, err
+	}
+	rv.LargeMinLength, err = flagSet.GetUint32(FlagLargeMinLength)
+	if err != nil {
+		return rv, err
+	}
+	for _, entry := range []struct {
+		flag string
+		fee  *sdk.Coins
+	}{
+		{flag: FlagSmallFee, fee: &rv.SmallFee},
+		{flag: FlagMediumFee, fee: &rv.MediumFee},
+		{flag: FlagLargeFee, fee: &rv.LargeFee},
+	} {
+		str, err := flagSet.GetString(entry.flag)
+		if err != nil {
+			return rv, err
+		}
+		if len(str) == 0 {
+			continue
+		}
+		*entry.fee, err = sdk.ParseCoinsNormalized(str)
+		if err != nil {
+			return rv, fmt.Errorf("invalid --%s %q: %w", entry.flag, str, err)
+		}
+	}
+	return rv, nil
+}
@@ -12,7 +12,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/provenance-io/provenance/x/attribute/client/cli"
+	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
 func TestAccountDataFlagsUse(t *testing.T) {
@@ -301,3 +304,67 @@ func TestReadAccountDataFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestReadValueSizeFeesFlags(t *testing.T) {
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		expFees types.ValueSizeFees
+		expErr  string
+	}{
+		{
+			name:    "no flags",
+			args:    []string{},
+			expFees: types.ValueSizeFees{},
+		},
+		{
+			name: "all flags",
+			args: []string{
+				"--" + cli.FlagMediumMinLength, "10", "--" + cli.FlagLargeMinLength, "20",
+				"--" + cli.FlagSmallFee, "1nhash", "--" + cli.FlagMediumFee, "10nhash", "--" + cli.FlagLargeFee, "100nhash,5stake",
+			},
+			expFees: types.NewValueSizeFees(10, 20, coins("1nhash"), coins("10nhash"), coins("100nhash,5stake")),
+		},
+		{
+			name:   "invalid medium min length",
+			args:   []string{"--" + cli.FlagMediumMinLength, "ten"},
+			expErr: `invalid argument "ten" for "--medium-min-length" flag: strconv.ParseUint: parsing "ten": invalid syntax`,
+		},
+		{
+			name:   "invalid large fee",
+			args:   []string{"--" + cli.FlagLargeFee, "nhash"},
+			expErr: `invalid --large-fee "nhash": invalid decimal coin expression: nhash`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use: "dummy",
+				Run: func(cmd *cobra.Command, args []string) {
+					panic("this dummy command should not be executed")
+				},
+			}
+			cli.AddValueSizeFeesFlagsToCmd(cmd)
+			err := cmd.ParseFlags(tc.args)
+			if err == nil {
+				var fees types.ValueSizeFees
+				fees, err = cli.ReadValueSizeFeesFlags(cmd.Flags())
+				if len(tc.expErr) == 0 {
+					assert.Equal(t, tc.expFees, fees, "ReadValueSizeFeesFlags")
+				}
+			}
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ParseFlags or ReadValueSizeFeesFlags")
+			} else {
+				assert.NoError(t, err, "ParseFlags or ReadValueSizeFeesFlags")
+			}
+		})
+	}
+}
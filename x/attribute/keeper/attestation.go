@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// BindPort stores the provided portID and binds to it.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// IsBound checks if the attribute module is already bound to the desired port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// GetPort returns the portID that attribute attestations are received on.
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(types.PortStoreKey))
+}
+
+// SetPort sets the portID that attribute attestations are received on.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	ctx.KVStore(k.storeKey).Set(types.PortStoreKey, []byte(portID))
+}
+
+// InitPort sets the provided port (or the default one if it's empty) and binds to it if needed.
+func (k Keeper) InitPort(ctx sdk.Context, portID string) error {
+	if len(portID) == 0 {
+		portID = types.PortID
+	}
+	k.SetPort(ctx, portID)
+	if k.IsBound(ctx, portID) {
+		return nil
+	}
+	if err := k.BindPort(ctx, portID); err != nil {
+		return fmt.Errorf("could not claim port capability: %w", err)
+	}
+	return nil
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability wraps the scopedKeeper's ClaimCapability function.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// SetAttestationSource sets whether an issuer on the other end of an IBC channel is allowed to attest
+// to attributes with the provided name.
+func (k Keeper) SetAttestationSource(ctx sdk.Context, source types.AttestationSource, enabled bool) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.AttestationSourceKey(source.Name, source.ChannelId, source.Issuer)
+	if !enabled {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&source)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// IsAttestationSource returns true if the issuer on the other end of the channel is allowed to attest
+// to attributes with the provided name.
+func (k Keeper) IsAttestationSource(ctx sdk.Context, name, channelID, issuer string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.AttestationSourceKey(name, channelID, issuer))
+}
+
+// IterateAttestationSources iterates over all of the approved attestation sources.
+func (k Keeper) IterateAttestationSources(ctx sdk.Context, cb func(source types.AttestationSource) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttestationSourceKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var source types.AttestationSource
+		if err := k.cdc.Unmarshal(it.Value(), &source); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal attestation source", "key", it.Key(), "error", err)
+			continue
+		}
+		if cb(source) {
+			break
+		}
+	}
+}
+
+// ImportAttestations stores each of the provided attestations as a local attribute, recording where it came from.
+// The issuer must be an approved attestation source for the name of every attestation on the channel they came in on.
+// Name ownership is not checked since the approval of the source was granted by the name's owner.
+// If an account already has an attribute with the same name and value, it is replaced.
+func (k Keeper) ImportAttestations(ctx sdk.Context, channelID string, sequence uint64, data types.AttributeAttestationPacketData) error {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "keeper_method", "import_attestations")
+
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	origin := &types.AttestationOrigin{ChannelId: channelID, Issuer: data.Issuer, Sequence: sequence}
+	store := ctx.KVStore(k.storeKey)
+	maxLength := k.GetMaxValueLength(ctx)
+	for _, attestation := range data.Attestations {
+		attr := attestation.ToAttribute(origin)
+
+		normalizedName, err := k.nameKeeper.Normalize(ctx, attr.Name)
+		if err != nil {
+			return fmt.Errorf("unable to normalize attribute name %q: %w", attr.Name, err)
+		}
+		attr.Name = normalizedName
+
+		if !k.IsAttestationSource(ctx, attr.Name, channelID, data.Issuer) {
+			return fmt.Errorf("issuer %q on %s is not an attestation source for %q", data.Issuer, channelID, attr.Name)
+		}
+		if err = k.ValidateExpirationDate(ctx, attr); err != nil {
+			return err
+		}
+		if int(maxLength) < len(attr.Value) {
+			return fmt.Errorf("attribute value length of %v exceeds max length %v", len(attr.Value), maxLength)
+		}
+		if err = k.nameKeeper.ValidateNameNotFrozen(ctx, attr.Name); err != nil {
+			return err
+		}
+
+		key := types.AddrAttributeKey(attr.GetAddressBytes(), attr)
+		if existing := store.Get(key); existing != nil {
+			var prev types.Attribute
+			if err = k.cdc.Unmarshal(existing, &prev); err != nil {
+				return err
+			}
+			k.deleteAttributeExpireLookup(store, prev)
+		} else {
+			k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
+		}
+
+		bz, err := k.cdc.Marshal(&attr)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+		k.addAttributeExpireLookup(store, attr)
+
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeAdd(attr, k.modAddr.String())); err != nil {
+			return err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeAttestationImported(attr)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -21,6 +21,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	// The wasm module's genesis is imported first, so each mirror is recorded as enabled by the contract's current admin.
+	for _, contract := range data.MirroredContracts {
+		contractAddr := sdk.MustAccAddressFromBech32(contract)
+		admin, err := k.GetContractAdmin(ctx, contractAddr)
+		if err != nil {
+			panic(fmt.Errorf("could not import attribute mirror for contract %s: %w", contract, err))
+		}
+		k.SetAttributeMirror(ctx, contractAddr, admin)
+	}
+	for _, oracle := range data.RefreshOracles {
+		k.SetRefreshOracle(ctx, oracle.Name, sdk.MustAccAddressFromBech32(oracle.Oracle), true)
+	}
+	for _, source := range data.AttestationSources {
+		if err := k.SetAttestationSource(ctx, source, true); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.InitPort(ctx, data.PortId); err != nil {
+		panic(err)
+	}
 
 	if err := EnsureModuleAccountAndAccountDataNameRecord(ctx.WithLogger(log.NewNopLogger()), k.authKeeper, k.nameKeeper); err != nil {
 		panic(err)
@@ -97,5 +117,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, attrs)
+	genState := types.NewGenesisState(params, attrs)
+	// Only the mirrors that are still in effect are exported since they're imported using the contract's current admin.
+	k.IterateAttributeMirrors(ctx, func(contract, _ sdk.AccAddress) bool {
+		if len(k.GetAttributeMirrorSource(ctx, contract)) > 0 {
+			genState.MirroredContracts = append(genState.MirroredContracts, contract.String())
+		}
+		return false
+	})
+	k.IterateRefreshOracles(ctx, func(name string, oracle sdk.AccAddress) bool {
+		genState.RefreshOracles = append(genState.RefreshOracles, types.RefreshOracle{Name: name, Oracle: oracle.String()})
+		return false
+	})
+	k.IterateAttestationSources(ctx, func(source types.AttestationSource) bool {
+		genState.AttestationSources = append(genState.AttestationSources, source)
+		return false
+	})
+	genState.PortId = k.GetPort(ctx)
+	return genState
 }
@@ -27,6 +27,18 @@ type Keeper struct {
 	authKeeper types.AccountKeeper
 	// The keeper used for ensuring names resolve to owners.
 	nameKeeper types.NameKeeper
+	// The keeper used to look up smart contract admins for attribute mirrors. This is a pointer so that
+	// copies of this keeper made before the wasm keeper is set still get it.
+	wasmKeeper *types.WasmKeeper
+	// The keepers used to bind the port that attribute attestations are received on.
+	portKeeper   types.PortKeeper
+	scopedKeeper types.ScopedKeeper
+	// The hooks called when attributes are deleted. This is a pointer so that
+	// copies of this keeper made before the hooks are set still get them.
+	hooks *types.AttributeHooks
+	// The reporters of the things in other modules that require attributes. This is a pointer so that
+	// copies of this keeper made before the reporter is set still get it.
+	dependencyReporter *types.AttributeDependencyReporter
 
 	// Key to access the key-value store from sdk.Context.
 	storeKey storetypes.StoreKey
@@ -48,19 +60,68 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	authKeeper types.AccountKeeper, nameKeeper types.NameKeeper,
+	portKeeper types.PortKeeper, scopedKeeper types.ScopedKeeper,
 ) Keeper {
 	keeper := Keeper{
-		storeKey:   key,
-		authKeeper: authKeeper,
-		nameKeeper: nameKeeper,
-		cdc:        cdc,
-		modAddr:    authtypes.NewModuleAddress(types.ModuleName),
-		authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		storeKey:           key,
+		authKeeper:         authKeeper,
+		nameKeeper:         nameKeeper,
+		portKeeper:         portKeeper,
+		scopedKeeper:       scopedKeeper,
+		cdc:                cdc,
+		modAddr:            authtypes.NewModuleAddress(types.ModuleName),
+		authority:          authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		wasmKeeper:         new(types.WasmKeeper),
+		hooks:              new(types.AttributeHooks),
+		dependencyReporter: new(types.AttributeDependencyReporter),
 	}
 	nameKeeper.SetAttributeKeeper(keeper)
 	return keeper
 }
 
+// SetWasmKeeper sets the wasm keeper used to look up smart contract admins for attribute mirrors.
+// This is needed because the wasm keeper is created after the attribute keeper.
+func (k *Keeper) SetWasmKeeper(wk types.WasmKeeper) {
+	if k.wasmKeeper == nil {
+		k.wasmKeeper = new(types.WasmKeeper)
+	}
+	*k.wasmKeeper = wk
+}
+
+// SetHooks sets the hooks that are called when attributes are deleted.
+// This is needed because the modules that react to attribute changes are created after the attribute keeper.
+func (k *Keeper) SetHooks(hooks types.AttributeHooks) {
+	if *k.hooks != nil {
+		panic("cannot set attribute hooks twice")
+	}
+	*k.hooks = hooks
+}
+
+// afterAttributeDeleted calls the AfterAttributeDeleted hook (if there is one).
+func (k Keeper) afterAttributeDeleted(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	if k.hooks == nil || *k.hooks == nil {
+		return nil
+	}
+	return (*k.hooks).AfterAttributeDeleted(ctx, addr, name)
+}
+
+// SetDependencyReporter sets the reporter used to find the things in other modules that require an attribute.
+// This is needed because the modules with those things are created after the attribute keeper.
+func (k *Keeper) SetDependencyReporter(reporter types.AttributeDependencyReporter) {
+	if *k.dependencyReporter != nil {
+		panic("cannot set attribute dependency reporter twice")
+	}
+	*k.dependencyReporter = reporter
+}
+
+// GetAttributeDependents returns the things in other modules that require the provided attribute name.
+func (k Keeper) GetAttributeDependents(ctx sdk.Context, name string) []types.AttributeDependent {
+	if k.dependencyReporter == nil || *k.dependencyReporter == nil {
+		return nil
+	}
+	return (*k.dependencyReporter).GetAttributeDependents(ctx, name)
+}
+
 // GetAuthority is signer of the proposal
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -112,6 +173,28 @@ func (k Keeper) GetAttributes(ctx sdk.Context, addr string, name string) ([]type
 	return k.prefixScan(ctx, types.AddrStrAttributesNameKeyPrefix(addr, name), pred)
 }
 
+// VerifyHashedAttributeValue returns true if the address has an unexpired hashed attribute with the given name
+// whose value is the hash of the provided salt and preimage.
+func (k Keeper) VerifyHashedAttributeValue(ctx sdk.Context, addr string, name string, salt, preimage []byte) (bool, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "keeper_method", "verify_hashed")
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	pred := func(s string) bool { return strings.EqualFold(s, name) }
+	attrs, err := k.prefixScan(ctx, types.AddrStrAttributesNameKeyPrefix(addr, name), pred)
+	if err != nil {
+		return false, err
+	}
+	for _, attr := range attrs {
+		if attr.ExpirationDate != nil && ctx.BlockTime().UTC().After(attr.ExpirationDate.UTC()) {
+			continue
+		}
+		if attr.MatchesPreimage(salt, preimage) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // IterateRecords iterates over all the stored attribute records and passes them to a callback function.
 func (k Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init an attribute record iterator
@@ -166,6 +249,9 @@ func (k Keeper) SetAttribute(
 	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", attr.Name, owner.String())
 	}
+	if err = k.nameKeeper.ValidateNameNotFrozen(ctx, attr.Name); err != nil {
+		return err
+	}
 	// Store the sanitized account attribute
 	bz, err := k.cdc.Marshal(&attr)
 	if err != nil {
@@ -254,6 +340,9 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
 	}
+	if err = k.nameKeeper.ValidateNameNotFrozen(ctx, updateAttribute.Name); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	addrBz := originalAttribute.GetAddressBytes()
@@ -295,6 +384,43 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 	return nil
 }
 
+// PatchAttribute applies an RFC 6902 JSON patch to the value of the JSON attribute on the account with the provided
+// name and value hash. The patched value is revalidated and stored the same way as an UpdateAttribute, keeping the
+// attribute's expiration. Returns the updated attribute.
+func (k Keeper) PatchAttribute(ctx sdk.Context, account, name string, valueHash, patch []byte, owner sdk.AccAddress,
+) (*types.Attribute, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "keeper_method", "patch")
+
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to normalize attribute name %q: %w", name, err)
+	}
+
+	attrKey := append(types.AddrStrAttributesNameKeyPrefix(account, normalizedName), valueHash...)
+	bz := ctx.KVStore(k.storeKey).Get(attrKey)
+	if bz == nil {
+		return nil, fmt.Errorf("no attribute found with name %q and value hash %X on account %s", normalizedName, valueHash, account)
+	}
+	var original types.Attribute
+	if err = k.cdc.Unmarshal(bz, &original); err != nil {
+		return nil, err
+	}
+	if original.AttributeType != types.AttributeType_JSON {
+		return nil, fmt.Errorf("attribute %q has type %s: only %s attributes can be patched",
+			normalizedName, original.AttributeType, types.AttributeType_JSON)
+	}
+
+	value, err := types.ApplyJSONPatch(original.Value, patch)
+	if err != nil {
+		return nil, err
+	}
+	updated := types.NewAttribute(original.Name, original.Address, original.AttributeType, value, original.ExpirationDate)
+	if err = k.UpdateAttribute(ctx, original, updated, owner); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
 // UpdateAttributeExpiration updates the expiration date on an attribute.
 func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types.Attribute, owner sdk.AccAddress,
 ) error {
@@ -318,6 +444,9 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
 		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
 	}
+	if err = k.nameKeeper.ValidateNameNotFrozen(ctx, updateAttribute.Name); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	attrKey := types.AddrAttributeKey(updateAttribute.GetAddressBytes(), updateAttribute)
@@ -388,6 +517,9 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		}
 		// else name does not exist (anymore) so we can't enforce permission check on delete here, proceed.
 	}
+	if err := k.nameKeeper.ValidateNameNotFrozen(ctx, name); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	iter := storetypes.KVStorePrefixIterator(store, types.AddrStrAttributesNameKeyPrefix(addr, name))
@@ -436,7 +568,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		return fmt.Errorf("no keys deleted with name %q", name)
 	}
 
-	return nil
+	return k.afterAttributeDeleted(ctx, attrToDelete[0].GetAddressBytes(), name)
 }
 
 // PurgeAttribute removes attributes under the given account from the state store.
@@ -451,6 +583,15 @@ func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddres
 		}
 		// else name does not exist (anymore) so we can't enforce permission check on delete here, proceed.
 	}
+	if err := k.nameKeeper.ValidateNameNotFrozen(ctx, name); err != nil {
+		return err
+	}
+
+	if dependents := k.GetAttributeDependents(ctx, name); len(dependents) > 0 {
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventAttributeDependentsWarning(name, dependents)); err != nil {
+			return err
+		}
+	}
 
 	accts, err := k.AccountsByAttribute(ctx, name)
 	if err != nil {
@@ -463,6 +604,11 @@ func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddres
 			store.Delete(key)
 			k.DecAttrNameAddressLookup(ctx, name, acct)
 		}
+		if len(attrToDelete) > 0 {
+			if err = k.afterAttributeDeleted(ctx, acct, name); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -555,6 +701,13 @@ func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 				if err = ctx.EventManager().EmitTypedEvent(deleteExpirationEvent); err != nil {
 					ctx.Logger().Error(fmt.Sprintf("failed to emit typed event %v", err))
 				}
+				// A failed hook shouldn't stop the expiration, so it's run in its own cache context.
+				cacheCtx, writeCache := ctx.CacheContext()
+				if err = k.afterAttributeDeleted(cacheCtx, attribute.GetAddressBytes(), attribute.Name); err != nil {
+					ctx.Logger().Error(fmt.Sprintf("attribute deleted hook failed for %q on %s: %v", attribute.Name, attribute.Address, err))
+				} else {
+					writeCache()
+				}
 				count++
 			} else {
 				ctx.Logger().Error(fmt.Sprintf("unable to unmarshal attribute to delete key: %v error: %v", attrKey, err))
@@ -9,16 +9,22 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/attribute/keeper"
 	"github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
@@ -1038,6 +1044,92 @@ func (s *KeeperTestSuite) TestPurgeAttributes() {
 	}
 }
 
+func (s *KeeperTestSuite) TestWasmKeeperNotSet() {
+	contract := sdk.AccAddress("contract____________")
+
+	var k keeper.Keeper
+	_, err := k.GetContractAdmin(s.ctx, contract)
+	s.Assert().EqualError(err, "wasm keeper not set", "GetContractAdmin without a wasm keeper")
+
+	s.Require().NotPanics(func() {
+		k.SetWasmKeeper(newMockWasmKeeper().WithContract(contract, s.user1))
+	}, "SetWasmKeeper on a keeper not made with NewKeeper")
+	admin, err := k.GetContractAdmin(s.ctx, contract)
+	s.Require().NoError(err, "GetContractAdmin after SetWasmKeeper")
+	s.Assert().Equal(s.user1Addr, admin, "GetContractAdmin after SetWasmKeeper")
+}
+
+func (s *KeeperTestSuite) TestAttributeDependents() {
+	marker := markertypes.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(markertypes.MustGetMarkerAddress("depcoin")),
+		sdk.NewInt64Coin("depcoin", 100), s.user1Addr, nil, markertypes.StatusProposed, markertypes.MarkerType_RestrictedCoin,
+		false, false, false, []string{"example.attribute", "other.attribute"},
+	)
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+	marketID, err := s.app.ExchangeKeeper.CreateMarket(s.ctx, exchange.Market{
+		ReqAttrCreateAsk: []string{"*.attribute"},
+		ReqAttrCreateBid: []string{"*.attribute", "example.attribute"},
+	})
+	s.Require().NoError(err, "CreateMarket")
+
+	expMarker := types.NewAttributeDependent(markertypes.ModuleName, "marker", "depcoin", []string{"example.attribute"})
+	expMarket := types.NewAttributeDependent(exchange.ModuleName, "market", fmt.Sprintf("%d", marketID), []string{"*.attribute", "example.attribute"})
+
+	s.Run("query", func() {
+		_, err := s.app.AttributeKeeper.AttributeDependents(s.ctx, nil)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = invalid request", "AttributeDependents(nil)")
+
+		resp, err := s.app.AttributeKeeper.AttributeDependents(s.ctx, &types.QueryAttributeDependentsRequest{Name: " Example.Attribute "})
+		s.Require().NoError(err, "AttributeDependents")
+		s.Assert().Contains(resp.Dependents, expMarker, "AttributeDependents marker")
+		s.Assert().Contains(resp.Dependents, expMarket, "AttributeDependents market")
+
+		resp, err = s.app.AttributeKeeper.AttributeDependents(s.ctx, &types.QueryAttributeDependentsRequest{
+			Name: "example.attribute", Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err, "AttributeDependents first page")
+		s.Assert().Equal([]types.AttributeDependent{expMarket}, resp.Dependents, "AttributeDependents first page")
+		s.Require().NotNil(resp.Pagination, "AttributeDependents first page pagination")
+		s.Assert().Equal(2, int(resp.Pagination.Total), "AttributeDependents first page total")
+
+		resp, err = s.app.AttributeKeeper.AttributeDependents(s.ctx, &types.QueryAttributeDependentsRequest{
+			Name: "example.attribute", Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+		})
+		s.Require().NoError(err, "AttributeDependents second page")
+		s.Assert().Equal([]types.AttributeDependent{expMarker}, resp.Dependents, "AttributeDependents second page")
+		s.Assert().Empty(resp.Pagination.NextKey, "AttributeDependents second page next key")
+
+		resp, err = s.app.AttributeKeeper.AttributeDependents(s.ctx, &types.QueryAttributeDependentsRequest{
+			Name: "example.attribute", Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+		})
+		s.Require().NoError(err, "AttributeDependents reversed")
+		s.Assert().Equal([]types.AttributeDependent{expMarker}, resp.Dependents, "AttributeDependents reversed")
+
+		resp, err = s.app.AttributeKeeper.AttributeDependents(s.ctx, &types.QueryAttributeDependentsRequest{Name: "unused.name"})
+		s.Require().NoError(err, "AttributeDependents unused name")
+		s.Assert().Empty(resp.Dependents, "AttributeDependents unused name")
+	})
+
+	s.Run("purge emits warning", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.app.AttributeKeeper.PurgeAttribute(ctx, "example.attribute", s.user1Addr), "PurgeAttribute")
+
+		var warning *types.EventAttributeDependentsWarning
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != "provenance.attribute.v1.EventAttributeDependentsWarning" {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			s.Require().NoError(err, "ParseTypedEvent")
+			warning = msg.(*types.EventAttributeDependentsWarning)
+		}
+		s.Require().NotNil(warning, "EventAttributeDependentsWarning")
+		s.Assert().Equal("example.attribute", warning.Name, "warning name")
+		s.Assert().Contains(warning.Dependents, expMarker, "warning marker")
+		s.Assert().Contains(warning.Dependents, expMarket, "warning market")
+	})
+}
+
 func (s *KeeperTestSuite) TestDeleteExpiredAttributes() {
 	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
 	past := s.startBlockTime.Add(-2 * time.Hour)
@@ -1102,6 +1194,27 @@ func (s *KeeperTestSuite) TestDeleteExpiredAttributes() {
 	s.Assert().NotNil(store.Get(types.AttributeNameAddrKeyPrefix(attr5.Name, attr5.GetAddressBytes())), "store.Get attr5 AttributeNameAddrKeyPrefix")
 }
 
+func (s *KeeperTestSuite) TestFrozenNameAttributes() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "one.frozen.testing", s.user1Addr, false), "SetNameRecord one.frozen.testing")
+	attr := types.NewAttribute("one.frozen.testing", s.user1, types.AttributeType_String, []byte("test1"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute before freeze")
+
+	freeze := nametypes.NewNameFreeze("frozen.testing", s.ctx.BlockHeight()+10, "test")
+	s.Require().NoError(s.app.NameKeeper.SetNameFreeze(s.ctx, freeze), "SetNameFreeze")
+	expErr := fmt.Sprintf(`"one.frozen.testing" is under "frozen.testing" which is frozen until height %d: name is frozen`, freeze.ExpirationHeight)
+
+	updated := types.NewAttribute("one.frozen.testing", s.user1, types.AttributeType_String, []byte("test2"), nil)
+	err := s.app.AttributeKeeper.SetAttribute(s.ctx, updated, s.user1Addr)
+	s.Assert().EqualError(err, expErr, "SetAttribute while frozen")
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx, attr, updated, s.user1Addr)
+	s.Assert().EqualError(err, expErr, "UpdateAttribute while frozen")
+	err = s.app.AttributeKeeper.DeleteAttribute(s.ctx, s.user1, attr.Name, nil, s.user1Addr)
+	s.Assert().EqualError(err, expErr, "DeleteAttribute while frozen")
+
+	s.ctx = s.ctx.WithBlockHeight(freeze.ExpirationHeight)
+	s.Assert().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, updated, s.user1Addr), "SetAttribute after the freeze expired")
+}
+
 func (s *KeeperTestSuite) TestGetAccountData() {
 	params := s.app.AttributeKeeper.GetParams(s.ctx)
 	if params.MaxValueLength < 100 {
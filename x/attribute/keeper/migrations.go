@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,9 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2to3 migrates the attribute store from version 2 to 3.
+// It binds the port that attribute attestations are received on.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.InitPort(ctx, types.PortID)
+}
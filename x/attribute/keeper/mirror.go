@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// SetAttributeMirror enables a smart contract to inherit the attributes of its admin for required attribute checks.
+// The provided admin is recorded, and the attributes are only inherited while it is still the contract's admin.
+func (k Keeper) SetAttributeMirror(ctx sdk.Context, contract, admin sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.AttributeMirrorKey(contract), admin)
+}
+
+// DeleteAttributeMirror stops a smart contract from inheriting the attributes of its admin.
+func (k Keeper) DeleteAttributeMirror(ctx sdk.Context, contract sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.AttributeMirrorKey(contract))
+}
+
+// GetAttributeMirrorAdmin returns the admin that enabled a smart contract's attribute mirror.
+// Returns nil if the contract doesn't have an attribute mirror.
+func (k Keeper) GetAttributeMirrorAdmin(ctx sdk.Context, contract sdk.AccAddress) sdk.AccAddress {
+	admin := ctx.KVStore(k.storeKey).Get(types.AttributeMirrorKey(contract))
+	if len(admin) == 0 {
+		return nil
+	}
+	return admin
+}
+
+// HasAttributeMirror returns true if the smart contract has an attribute mirror, even if the admin that enabled
+// it is no longer the contract's admin.
+func (k Keeper) HasAttributeMirror(ctx sdk.Context, contract sdk.AccAddress) bool {
+	return len(k.GetAttributeMirrorAdmin(ctx, contract)) > 0
+}
+
+// IterateAttributeMirrors iterates over all of the smart contracts with an attribute mirror, and the admin that enabled each one.
+func (k Keeper) IterateAttributeMirrors(ctx sdk.Context, cb func(contract, admin sdk.AccAddress) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttributeMirrorKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		// The key is [prefix][length][contract address].
+		if cb(sdk.AccAddress(it.Key()[2:]), sdk.AccAddress(it.Value())) {
+			break
+		}
+	}
+}
+
+// GetContractAdmin returns the current admin of a smart contract.
+// An error is returned if the contract doesn't exist, or if it doesn't have an admin.
+func (k Keeper) GetContractAdmin(ctx sdk.Context, contract sdk.AccAddress) (sdk.AccAddress, error) {
+	if k.wasmKeeper == nil || *k.wasmKeeper == nil {
+		return nil, errors.New("wasm keeper not set")
+	}
+	info := (*k.wasmKeeper).GetContractInfo(ctx, contract)
+	if info == nil {
+		return nil, fmt.Errorf("contract %s not found", contract)
+	}
+	if len(info.Admin) == 0 {
+		return nil, fmt.Errorf("contract %s does not have an admin", contract)
+	}
+	return sdk.AccAddressFromBech32(info.Admin)
+}
+
+// GetAttributeMirrorSource returns the address whose attributes are currently inherited by the provided account.
+// Returns nil if the account is not a smart contract with an attribute mirror, or if the admin that enabled the
+// mirror is no longer the contract's admin.
+func (k Keeper) GetAttributeMirrorSource(ctx sdk.Context, addr sdk.AccAddress) sdk.AccAddress {
+	enabledBy := k.GetAttributeMirrorAdmin(ctx, addr)
+	if len(enabledBy) == 0 {
+		return nil
+	}
+	admin, err := k.GetContractAdmin(ctx, addr)
+	if err != nil || !admin.Equals(enabledBy) {
+		return nil
+	}
+	return admin
+}
+
+// GetAllAttributesAddrWithMirror gets all attributes on an account, for use in required attribute checks.
+// If the account is a smart contract with an attribute mirror, the attributes of the contract's admin are included too.
+func (k Keeper) GetAllAttributesAddrWithMirror(ctx sdk.Context, addr []byte) ([]types.Attribute, error) {
+	attrs, err := k.GetAllAttributesAddr(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	source := k.GetAttributeMirrorSource(ctx, addr)
+	if len(source) == 0 {
+		return attrs, nil
+	}
+	sourceAttrs, err := k.GetAllAttributesAddr(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attributes of %s mirrored by %s: %w", source, sdk.AccAddress(addr), err)
+	}
+	return append(attrs, sourceAttrs...), nil
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"context"
 	"errors"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/attribute/types"
@@ -91,3 +94,35 @@ func (k *mockNameKeeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk
 func (k *mockNameKeeper) IterateRecords(ctx sdk.Context, prefix []byte, handle func(nametypes.NameRecord) error) error {
 	return k.Parent.IterateRecords(ctx, prefix, handle)
 }
+
+// ValidateNameNotFrozen calls the parent's ValidateNameNotFrozen function.
+func (k *mockNameKeeper) ValidateNameNotFrozen(ctx sdk.Context, name string) error {
+	return k.Parent.ValidateNameNotFrozen(ctx, name)
+}
+
+// mockWasmKeeper is a mocked wasm keeper that has the contract admins it was given.
+type mockWasmKeeper struct {
+	Admins map[string]string
+}
+
+var _ types.WasmKeeper = (*mockWasmKeeper)(nil)
+
+// newMockWasmKeeper creates a mocked wasm keeper without any contracts.
+func newMockWasmKeeper() *mockWasmKeeper {
+	return &mockWasmKeeper{Admins: make(map[string]string)}
+}
+
+// WithContract adds a contract with the provided admin (which can be empty).
+func (k *mockWasmKeeper) WithContract(contract sdk.AccAddress, admin string) *mockWasmKeeper {
+	k.Admins[string(contract)] = admin
+	return k
+}
+
+// GetContractInfo returns info with the admin of the contract, or nil if it doesn't exist.
+func (k *mockWasmKeeper) GetContractInfo(_ context.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	admin, found := k.Admins[string(contract)]
+	if !found {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin}
+}
@@ -49,6 +49,10 @@ func (k msgServer) AddAttribute(goCtx context.Context, msg *types.MsgAddAttribut
 		return nil, err
 	}
 
+	if err = k.ConsumeValueSizeFee(ctx, msg, attrib.Name, msg.Account, len(msg.Value)); err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, types.EventTelemetryKeyAdd},
@@ -100,6 +104,10 @@ func (k msgServer) UpdateAttribute(goCtx context.Context, msg *types.MsgUpdateAt
 		return nil, err
 	}
 
+	if err = k.ConsumeValueSizeFee(ctx, msg, msg.Name, msg.Account, len(msg.UpdateValue)); err != nil {
+		return nil, err
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, types.EventTelemetryKeyUpdate},
@@ -254,6 +262,10 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 		return nil, err
 	}
 
+	if err = k.ConsumeValueSizeFee(ctx, msg, types.AccountDataName, msg.Account, len(msg.Value)); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgSetAccountDataResponse{}, nil
 }
 
@@ -272,3 +284,146 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetAttributeMirror defines a method for a smart contract's admin to set whether the contract inherits
+// the attributes of its admin for required attribute checks.
+func (k msgServer) SetAttributeMirror(goCtx context.Context, msg *types.MsgSetAttributeMirrorRequest) (*types.MsgSetAttributeMirrorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, err
+	}
+	admin, err := k.GetContractAdmin(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if admin.String() != msg.Admin {
+		return nil, fmt.Errorf("%s is not the admin of contract %s", msg.Admin, msg.Contract)
+	}
+	// A mirror enabled by a previous admin is not in effect, so the current admin can enable it again.
+	enabledBy := k.GetAttributeMirrorAdmin(ctx, contract)
+	if (msg.Enabled && admin.Equals(enabledBy)) || (!msg.Enabled && len(enabledBy) == 0) {
+		return nil, fmt.Errorf("contract %s attribute mirror is already %t", msg.Contract, msg.Enabled)
+	}
+
+	if msg.Enabled {
+		k.Keeper.SetAttributeMirror(ctx, contract, admin)
+	} else {
+		k.DeleteAttributeMirror(ctx, contract)
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeMirrorUpdated(msg.Contract, msg.Enabled, msg.Admin)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeMirrorResponse{}, nil
+}
+
+// SetRefreshOracle defines a method for a name owner to set whether an address is allowed to extend
+// the expiration of existing attributes with that name.
+func (k msgServer) SetRefreshOracle(goCtx context.Context, msg *types.MsgSetRefreshOracleRequest) (*types.MsgSetRefreshOracleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	name, err := k.nameKeeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to normalize attribute name %q: %w", msg.Name, err)
+	}
+	oracle, err := sdk.AccAddressFromBech32(msg.Oracle)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+		return nil, fmt.Errorf("%q does not resolve to address %q", name, msg.Owner)
+	}
+	if k.IsRefreshOracle(ctx, name, oracle) == msg.Enabled {
+		return nil, fmt.Errorf("%s refresh oracle for %q is already %t", msg.Oracle, name, msg.Enabled)
+	}
+
+	k.Keeper.SetRefreshOracle(ctx, name, oracle, msg.Enabled)
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeRefreshOracleUpdated(name, msg.Oracle, msg.Enabled, msg.Owner)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRefreshOracleResponse{}, nil
+}
+
+// SetAttestationSource defines a method for a name owner to set whether an issuer on another chain is allowed
+// to attest to attributes with that name over an IBC channel.
+func (k msgServer) SetAttestationSource(goCtx context.Context, msg *types.MsgSetAttestationSourceRequest) (*types.MsgSetAttestationSourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	source := msg.GetSource()
+	name, err := k.nameKeeper.Normalize(ctx, source.Name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to normalize attribute name %q: %w", source.Name, err)
+	}
+	source.Name = name
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
+		return nil, fmt.Errorf("%q does not resolve to address %q", name, msg.Owner)
+	}
+	if k.IsAttestationSource(ctx, name, source.ChannelId, source.Issuer) == msg.Enabled {
+		return nil, fmt.Errorf("issuer %q on %s attestation source for %q is already %t", source.Issuer, source.ChannelId, name, msg.Enabled)
+	}
+
+	if err = k.Keeper.SetAttestationSource(ctx, source, msg.Enabled); err != nil {
+		return nil, err
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeAttestationSourceUpdated(source, msg.Enabled, msg.Owner)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttestationSourceResponse{}, nil
+}
+
+// RefreshAttributes defines a method for a refresh oracle to extend the expiration of existing attributes
+// without changing their values.
+func (k msgServer) RefreshAttributes(goCtx context.Context, msg *types.MsgRefreshAttributesRequest) (*types.MsgRefreshAttributesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	oracle, err := sdk.AccAddressFromBech32(msg.Oracle)
+	if err != nil {
+		return nil, err
+	}
+	count, err := k.Keeper.RefreshAttributes(ctx, msg.Name, msg.Accounts, msg.ExpirationDate, oracle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefreshAttributesResponse{Count: count}, nil
+}
+
+func (k msgServer) PatchAttribute(goCtx context.Context, msg *types.MsgPatchAttributeRequest) (*types.MsgPatchAttributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := k.Keeper.PatchAttribute(ctx, msg.Account, msg.Name, msg.ValueHash, msg.Patch, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.ConsumeValueSizeFee(ctx, msg, msg.Name, msg.Account, len(updated.Value)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttributeUpdated,
+			sdk.NewAttribute(types.AttributeKeyNameAttribute, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, msg.Account),
+		),
+	)
+
+	return &types.MsgPatchAttributeResponse{ValueHash: updated.Hash()}, nil
+}
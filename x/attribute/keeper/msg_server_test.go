@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +20,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/x/attribute/keeper"
 	"github.com/provenance-io/provenance/x/attribute/types"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -371,3 +375,468 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestSetAttributeMirror() {
+	contract := sdk.AccAddress("contract____________")
+	noAdminContract := sdk.AccAddress("no_admin_contract___")
+	unknownContract := sdk.AccAddress("unknown_contract____")
+	otherAddr := sdk.AccAddress("other_______________")
+	newAdmin := sdk.AccAddress("new_admin___________")
+
+	attrKeeper := s.app.AttributeKeeper
+	wasmKeeper := newMockWasmKeeper().
+		WithContract(contract, s.owner1).
+		WithContract(noAdminContract, "")
+	attrKeeper.SetWasmKeeper(wasmKeeper)
+	msgServer := keeper.NewMsgServerImpl(attrKeeper)
+
+	attr := types.NewAttribute("example.name", s.owner1, types.AttributeType_String, []byte("kyc"), nil)
+	s.Require().NoError(attrKeeper.SetAttribute(s.ctx, attr, s.owner1Addr), "SetAttribute")
+
+	tests := []struct {
+		name     string
+		msg      *types.MsgSetAttributeMirrorRequest
+		errorMsg string
+	}{
+		{
+			name:     "unknown contract",
+			msg:      types.NewMsgSetAttributeMirrorRequest(unknownContract, true, s.owner1Addr),
+			errorMsg: "contract " + unknownContract.String() + " not found",
+		},
+		{
+			name:     "contract without an admin",
+			msg:      types.NewMsgSetAttributeMirrorRequest(noAdminContract, true, s.owner1Addr),
+			errorMsg: "contract " + noAdminContract.String() + " does not have an admin",
+		},
+		{
+			name:     "signer is not the admin",
+			msg:      types.NewMsgSetAttributeMirrorRequest(contract, true, otherAddr),
+			errorMsg: otherAddr.String() + " is not the admin of contract " + contract.String(),
+		},
+		{
+			name:     "disable when not enabled",
+			msg:      types.NewMsgSetAttributeMirrorRequest(contract, false, s.owner1Addr),
+			errorMsg: "contract " + contract.String() + " attribute mirror is already false",
+		},
+		{
+			name: "enable",
+			msg:  types.NewMsgSetAttributeMirrorRequest(contract, true, s.owner1Addr),
+		},
+		{
+			name:     "enable again",
+			msg:      types.NewMsgSetAttributeMirrorRequest(contract, true, s.owner1Addr),
+			errorMsg: "contract " + contract.String() + " attribute mirror is already true",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := msgServer.SetAttributeMirror(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				s.Assert().EqualError(err, tc.errorMsg, "SetAttributeMirror error")
+				return
+			}
+			s.Require().NoError(err, "SetAttributeMirror error")
+			expEvent := types.NewEventAttributeMirrorUpdated(tc.msg.Contract, tc.msg.Enabled, tc.msg.Admin)
+			s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+		})
+	}
+
+	s.Run("contract inherits the admin's attributes", func() {
+		attrs, err := attrKeeper.GetAllAttributesAddrWithMirror(s.ctx, contract)
+		s.Require().NoError(err, "GetAllAttributesAddrWithMirror(contract)")
+		s.Assert().Equal([]types.Attribute{attr}, attrs, "GetAllAttributesAddrWithMirror(contract)")
+
+		attrs, err = attrKeeper.GetAllAttributesAddr(s.ctx, contract)
+		s.Require().NoError(err, "GetAllAttributesAddr(contract)")
+		s.Assert().Empty(attrs, "GetAllAttributesAddr(contract)")
+
+		resp, err := attrKeeper.AttributeMirror(s.ctx, &types.QueryAttributeMirrorRequest{Contract: contract.String()})
+		s.Require().NoError(err, "AttributeMirror(contract)")
+		s.Assert().Equal(&types.QueryAttributeMirrorResponse{Enabled: true, Source: s.owner1}, resp, "AttributeMirror(contract)")
+
+		genState := attrKeeper.ExportGenesis(s.ctx)
+		s.Assert().Equal([]string{contract.String()}, genState.MirroredContracts, "exported MirroredContracts")
+	})
+
+	s.Run("new admin's attributes are not inherited", func() {
+		s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, newAdmin))
+		attr2 := types.NewAttribute("example.name", newAdmin.String(), types.AttributeType_String, []byte("kyc"), nil)
+		s.Require().NoError(attrKeeper.SetAttribute(s.ctx, attr2, s.owner1Addr), "SetAttribute(newAdmin)")
+		wasmKeeper.WithContract(contract, newAdmin.String())
+
+		attrs, err := attrKeeper.GetAllAttributesAddrWithMirror(s.ctx, contract)
+		s.Require().NoError(err, "GetAllAttributesAddrWithMirror(contract)")
+		s.Assert().Empty(attrs, "GetAllAttributesAddrWithMirror(contract) after changing the admin")
+
+		resp, err := attrKeeper.AttributeMirror(s.ctx, &types.QueryAttributeMirrorRequest{Contract: contract.String()})
+		s.Require().NoError(err, "AttributeMirror(contract)")
+		s.Assert().Equal(&types.QueryAttributeMirrorResponse{Enabled: true}, resp, "AttributeMirror(contract) after changing the admin")
+
+		genState := attrKeeper.ExportGenesis(s.ctx)
+		s.Assert().Empty(genState.MirroredContracts, "exported MirroredContracts after changing the admin")
+
+		_, err = msgServer.SetAttributeMirror(s.ctx, types.NewMsgSetAttributeMirrorRequest(contract, true, newAdmin))
+		s.Require().NoError(err, "SetAttributeMirror(true) by the new admin")
+		attrs, err = attrKeeper.GetAllAttributesAddrWithMirror(s.ctx, contract)
+		s.Require().NoError(err, "GetAllAttributesAddrWithMirror(contract)")
+		s.Assert().Equal([]types.Attribute{attr2}, attrs, "GetAllAttributesAddrWithMirror(contract) after the new admin enables it")
+
+		wasmKeeper.WithContract(contract, s.owner1)
+		attrs, err = attrKeeper.GetAllAttributesAddrWithMirror(s.ctx, contract)
+		s.Require().NoError(err, "GetAllAttributesAddrWithMirror(contract)")
+		s.Assert().Empty(attrs, "GetAllAttributesAddrWithMirror(contract) after changing the admin back")
+	})
+
+	s.Run("disable", func() {
+		_, err := msgServer.SetAttributeMirror(s.ctx, types.NewMsgSetAttributeMirrorRequest(contract, false, s.owner1Addr))
+		s.Require().NoError(err, "SetAttributeMirror(false)")
+		attrs, err := attrKeeper.GetAllAttributesAddrWithMirror(s.ctx, contract)
+		s.Require().NoError(err, "GetAllAttributesAddrWithMirror(contract)")
+		s.Assert().Empty(attrs, "GetAllAttributesAddrWithMirror(contract) after disabling")
+	})
+}
+
+func (s *MsgServerTestSuite) TestRefreshAttributes() {
+	oracle := sdk.AccAddress("oracle______________")
+	otherAddr := sdk.AccAddress("other_______________")
+	account1 := sdk.AccAddress("account1____________").String()
+	account2 := sdk.AccAddress("account2____________").String()
+	noAttrAccount := sdk.AccAddress("no_attr_account_____").String()
+
+	attrKeeper := s.app.AttributeKeeper
+	blockTime := s.ctx.BlockTime().UTC().Truncate(time.Second)
+	origExpiration := blockTime.Add(24 * time.Hour)
+	newExpiration := blockTime.Add(48 * time.Hour)
+	for _, account := range []string{account1, account2} {
+		attr := types.NewAttribute("example.name", account, types.AttributeType_String, []byte("verified"), &origExpiration)
+		s.Require().NoError(attrKeeper.SetAttribute(s.ctx, attr, s.owner1Addr), "SetAttribute(%s)", account)
+	}
+	noExpAttr := types.NewAttribute("name", account1, types.AttributeType_String, []byte("forever"), nil)
+	s.Require().NoError(attrKeeper.SetAttribute(s.ctx, noExpAttr, s.owner1Addr), "SetAttribute(name)")
+
+	s.Run("set refresh oracle", func() {
+		_, err := s.msgServer.SetRefreshOracle(s.ctx, types.NewMsgSetRefreshOracleRequest("example.name", oracle, true, otherAddr))
+		s.Assert().EqualError(err, `"example.name" does not resolve to address "`+otherAddr.String()+`"`, "SetRefreshOracle by non-owner")
+		_, err = s.msgServer.SetRefreshOracle(s.ctx, types.NewMsgSetRefreshOracleRequest("example.name", oracle, false, s.owner1Addr))
+		s.Assert().EqualError(err, oracle.String()+` refresh oracle for "example.name" is already false`, "SetRefreshOracle(false) when not set")
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgSetRefreshOracleRequest("example.name", oracle, true, s.owner1Addr)
+		_, err = s.msgServer.SetRefreshOracle(s.ctx, msg)
+		s.Require().NoError(err, "SetRefreshOracle(true)")
+		expEvent := types.NewEventAttributeRefreshOracleUpdated("example.name", oracle.String(), true, s.owner1)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+		s.Assert().True(attrKeeper.IsRefreshOracle(s.ctx, "example.name", oracle), "IsRefreshOracle")
+
+		genState := attrKeeper.ExportGenesis(s.ctx)
+		s.Assert().Equal([]types.RefreshOracle{{Name: "example.name", Oracle: oracle.String()}}, genState.RefreshOracles, "exported RefreshOracles")
+	})
+
+	tests := []struct {
+		name     string
+		msg      *types.MsgRefreshAttributesRequest
+		errorMsg string
+		expCount uint64
+	}{
+		{
+			name:     "not an oracle",
+			msg:      types.NewMsgRefreshAttributesRequest("example.name", []string{account1}, newExpiration, otherAddr),
+			errorMsg: otherAddr.String() + ` is not a refresh oracle for "example.name"`,
+		},
+		{
+			name:     "oracle of a different name",
+			msg:      types.NewMsgRefreshAttributesRequest("name", []string{account1}, newExpiration, oracle),
+			errorMsg: oracle.String() + ` is not a refresh oracle for "name"`,
+		},
+		{
+			name:     "expiration in the past",
+			msg:      types.NewMsgRefreshAttributesRequest("example.name", []string{account1}, blockTime.Add(-time.Hour), oracle),
+			errorMsg: fmt.Sprintf("attribute expiration date %v is before block time of %v", blockTime.Add(-time.Hour), s.ctx.BlockTime().UTC()),
+		},
+		{
+			name:     "account without the attribute",
+			msg:      types.NewMsgRefreshAttributesRequest("example.name", []string{account1, noAttrAccount}, newExpiration, oracle),
+			errorMsg: `no attributes with name "example.name" found on account ` + noAttrAccount,
+		},
+		{
+			name:     "shorter expiration",
+			msg:      types.NewMsgRefreshAttributesRequest("example.name", []string{account1}, blockTime.Add(time.Hour), oracle),
+			errorMsg: fmt.Sprintf(`attribute "example.name" on account %s expires at %v which is after %v`, account1, origExpiration, blockTime.Add(time.Hour)),
+		},
+		{
+			name:     "attribute without an expiration",
+			msg:      types.NewMsgRefreshAttributesRequest("name", []string{account1}, newExpiration, s.owner1Addr),
+			errorMsg: `attribute "name" on account ` + account1 + " does not expire",
+		},
+		{
+			name:     "refreshed by oracle",
+			msg:      types.NewMsgRefreshAttributesRequest("example.name", []string{account1, account2}, newExpiration, oracle),
+			expCount: 2,
+		},
+		{
+			name:     "refreshed by owner",
+			msg:      types.NewMsgRefreshAttributesRequest("example.name", []string{account2}, newExpiration.Add(time.Hour), s.owner1Addr),
+			expCount: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			resp, err := s.msgServer.RefreshAttributes(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				s.Assert().EqualError(err, tc.errorMsg, "RefreshAttributes error")
+				return
+			}
+			s.Require().NoError(err, "RefreshAttributes error")
+			s.Assert().Equal(tc.expCount, resp.Count, "RefreshAttributes count")
+			for _, account := range tc.msg.Accounts {
+				attrs, err := attrKeeper.GetAttributes(s.ctx, account, "example.name")
+				s.Require().NoError(err, "GetAttributes(%s)", account)
+				s.Require().Len(attrs, 1, "GetAttributes(%s)", account)
+				s.Assert().Equal([]byte("verified"), attrs[0].Value, "value of %s attribute", account)
+				s.Assert().Equal(tc.msg.ExpirationDate, attrs[0].ExpirationDate.UTC(), "expiration of %s attribute", account)
+			}
+		})
+	}
+
+	s.Run("remove refresh oracle", func() {
+		_, err := s.msgServer.SetRefreshOracle(s.ctx, types.NewMsgSetRefreshOracleRequest("example.name", oracle, false, s.owner1Addr))
+		s.Require().NoError(err, "SetRefreshOracle(false)")
+		s.Assert().False(attrKeeper.IsRefreshOracle(s.ctx, "example.name", oracle), "IsRefreshOracle")
+	})
+}
+
+func (s *MsgServerTestSuite) TestAttestations() {
+	otherAddr := sdk.AccAddress("other_______________")
+	account := sdk.AccAddress("account_____________").String()
+	attrKeeper := s.app.AttributeKeeper
+	source := types.AttestationSource{Name: "example.name", ChannelId: "channel-3", Issuer: "kyc-issuer"}
+	expiration := s.ctx.BlockTime().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	packet := func(issuer string, value string) types.AttributeAttestationPacketData {
+		return types.AttributeAttestationPacketData{
+			Issuer: issuer,
+			Attestations: []types.AttributeAttestation{{
+				Account:        account,
+				Name:           "example.name",
+				Value:          []byte(value),
+				AttributeType:  types.AttributeType_String,
+				ExpirationDate: &expiration,
+			}},
+		}
+	}
+
+	s.Run("set attestation source", func() {
+		_, err := s.msgServer.SetAttestationSource(s.ctx, types.NewMsgSetAttestationSourceRequest("example.name", "channel-3", "kyc-issuer", true, otherAddr))
+		s.Assert().EqualError(err, `"example.name" does not resolve to address "`+otherAddr.String()+`"`, "SetAttestationSource by non-owner")
+		_, err = s.msgServer.SetAttestationSource(s.ctx, types.NewMsgSetAttestationSourceRequest("example.name", "channel-3", "kyc-issuer", false, s.owner1Addr))
+		s.Assert().EqualError(err, `issuer "kyc-issuer" on channel-3 attestation source for "example.name" is already false`, "SetAttestationSource(false) when not set")
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err = s.msgServer.SetAttestationSource(s.ctx, types.NewMsgSetAttestationSourceRequest("example.name", "channel-3", "kyc-issuer", true, s.owner1Addr))
+		s.Require().NoError(err, "SetAttestationSource(true)")
+		expEvent := types.NewEventAttributeAttestationSourceUpdated(source, true, s.owner1)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+		s.Assert().True(attrKeeper.IsAttestationSource(s.ctx, "example.name", "channel-3", "kyc-issuer"), "IsAttestationSource")
+
+		genState := attrKeeper.ExportGenesis(s.ctx)
+		s.Assert().Equal([]types.AttestationSource{source}, genState.AttestationSources, "exported AttestationSources")
+	})
+
+	s.Run("unapproved issuer", func() {
+		err := attrKeeper.ImportAttestations(s.ctx, "channel-3", 1, packet("other-issuer", "verified"))
+		s.Assert().EqualError(err, `issuer "other-issuer" on channel-3 is not an attestation source for "example.name"`, "ImportAttestations")
+	})
+
+	s.Run("unapproved channel", func() {
+		err := attrKeeper.ImportAttestations(s.ctx, "channel-4", 1, packet("kyc-issuer", "verified"))
+		s.Assert().EqualError(err, `issuer "kyc-issuer" on channel-4 is not an attestation source for "example.name"`, "ImportAttestations")
+	})
+
+	s.Run("import attestation", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(attrKeeper.ImportAttestations(s.ctx, "channel-3", 5, packet("kyc-issuer", "verified")), "ImportAttestations")
+		attrs, err := attrKeeper.GetAttributes(s.ctx, account, "example.name")
+		s.Require().NoError(err, "GetAttributes")
+		s.Require().Len(attrs, 1, "GetAttributes")
+		expOrigin := &types.AttestationOrigin{ChannelId: "channel-3", Issuer: "kyc-issuer", Sequence: 5}
+		s.Assert().Equal(expOrigin, attrs[0].Origin, "attribute origin")
+		s.Assert().Equal([]byte("verified"), attrs[0].Value, "attribute value")
+		expEvent := types.NewEventAttributeAttestationImported(attrs[0])
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+		accounts, err := attrKeeper.AccountsByAttribute(s.ctx, "example.name")
+		s.Require().NoError(err, "AccountsByAttribute")
+		s.Assert().Len(accounts, 1, "AccountsByAttribute")
+	})
+
+	s.Run("re-attestation replaces the attribute", func() {
+		s.Require().NoError(attrKeeper.ImportAttestations(s.ctx, "channel-3", 6, packet("kyc-issuer", "verified")), "ImportAttestations")
+		attrs, err := attrKeeper.GetAttributes(s.ctx, account, "example.name")
+		s.Require().NoError(err, "GetAttributes")
+		s.Require().Len(attrs, 1, "GetAttributes")
+		s.Assert().Equal(uint64(6), attrs[0].Origin.Sequence, "attribute origin sequence")
+
+		s.Require().NoError(attrKeeper.DeleteAttribute(s.ctx, account, "example.name", nil, s.owner1Addr), "DeleteAttribute")
+		accounts, err := attrKeeper.AccountsByAttribute(s.ctx, "example.name")
+		s.Require().NoError(err, "AccountsByAttribute")
+		s.Assert().Empty(accounts, "AccountsByAttribute after delete")
+	})
+
+	s.Run("remove attestation source", func() {
+		_, err := s.msgServer.SetAttestationSource(s.ctx, types.NewMsgSetAttestationSourceRequest("example.name", "channel-3", "kyc-issuer", false, s.owner1Addr))
+		s.Require().NoError(err, "SetAttestationSource(false)")
+		s.Assert().False(attrKeeper.IsAttestationSource(s.ctx, "example.name", "channel-3", "kyc-issuer"), "IsAttestationSource")
+		err = attrKeeper.ImportAttestations(s.ctx, "channel-3", 7, packet("kyc-issuer", "verified"))
+		s.Assert().EqualError(err, `issuer "kyc-issuer" on channel-3 is not an attestation source for "example.name"`, "ImportAttestations")
+	})
+}
+
+func (s *MsgServerTestSuite) TestPatchAttribute() {
+	otherAddr := sdk.AccAddress("other_______________")
+	attrKeeper := s.app.AttributeKeeper
+	params := attrKeeper.GetParams(s.ctx)
+	params.MaxValueLength = 40
+	attrKeeper.SetParams(s.ctx, params)
+
+	expiration := s.ctx.BlockTime().UTC().Add(24 * time.Hour).Truncate(time.Second)
+	jsonAttr := types.NewAttribute("example.name", s.owner1, types.AttributeType_JSON, []byte(`{"status":"pending","tags":["a"]}`), &expiration)
+	s.Require().NoError(attrKeeper.SetAttribute(s.ctx, jsonAttr, s.owner1Addr), "SetAttribute(json)")
+	stringAttr := types.NewAttribute("name", s.owner1, types.AttributeType_String, []byte(`{}`), nil)
+	s.Require().NoError(attrKeeper.SetAttribute(s.ctx, stringAttr, s.owner1Addr), "SetAttribute(string)")
+
+	patch := []byte(`[{"op":"replace","path":"/status","value":"active"},{"op":"add","path":"/tags/-","value":"b"}]`)
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgPatchAttributeRequest
+		expErr string
+	}{
+		{
+			name:   "unknown value hash",
+			msg:    types.NewMsgPatchAttributeRequest(s.owner1, s.owner1Addr, "example.name", stringAttr.Hash(), patch),
+			expErr: fmt.Sprintf("no attribute found with name \"example.name\" and value hash %X on account %s", stringAttr.Hash(), s.owner1),
+		},
+		{
+			name:   "not a json attribute",
+			msg:    types.NewMsgPatchAttributeRequest(s.owner1, s.owner1Addr, "name", stringAttr.Hash(), patch),
+			expErr: `attribute "name" has type ATTRIBUTE_TYPE_STRING: only ATTRIBUTE_TYPE_JSON attributes can be patched`,
+		},
+		{
+			name:   "test operation fails",
+			msg:    types.NewMsgPatchAttributeRequest(s.owner1, s.owner1Addr, "example.name", jsonAttr.Hash(), []byte(`[{"op":"test","path":"/status","value":"active"}]`)),
+			expErr: `could not apply json patch operation 0 (test "/status"): test failed: value does not match`,
+		},
+		{
+			name:   "too long",
+			msg:    types.NewMsgPatchAttributeRequest(s.owner1, s.owner1Addr, "example.name", jsonAttr.Hash(), []byte(`[{"op":"add","path":"/note","value":"this is far too long"}]`)),
+			expErr: "update attribute value length of 63 exceeds max length 40",
+		},
+		{
+			name:   "not the owner",
+			msg:    types.NewMsgPatchAttributeRequest(s.owner1, otherAddr, "example.name", jsonAttr.Hash(), patch),
+			expErr: fmt.Sprintf("no account found for owner address %q", otherAddr.String()),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.PatchAttribute(s.ctx, tc.msg)
+			s.Assert().EqualError(err, tc.expErr, "PatchAttribute")
+		})
+	}
+
+	s.Run("patched", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := s.msgServer.PatchAttribute(s.ctx, types.NewMsgPatchAttributeRequest(s.owner1, s.owner1Addr, "example.name", jsonAttr.Hash(), patch))
+		s.Require().NoError(err, "PatchAttribute")
+
+		expAttr := types.NewAttribute("example.name", s.owner1, types.AttributeType_JSON, []byte(`{"status":"active","tags":["a","b"]}`), &expiration)
+		s.Assert().Equal(expAttr.Hash(), resp.ValueHash, "response value hash")
+		attrs, err := attrKeeper.GetAttributes(s.ctx, s.owner1, "example.name")
+		s.Require().NoError(err, "GetAttributes")
+		s.Assert().Equal([]types.Attribute{expAttr}, attrs, "attributes after patch")
+
+		expEvent := types.NewEventAttributeUpdate(jsonAttr, expAttr, s.owner1)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+	})
+}
+
+func (s *MsgServerTestSuite) TestValueSizeFees() {
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	params.ValueSizeFees = types.NewValueSizeFees(10, 20,
+		sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)),
+		sdk.NewCoins(sdk.NewInt64Coin("nhash", 10)),
+		sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)),
+	)
+	s.app.AttributeKeeper.SetParams(s.ctx, params)
+
+	tests := []struct {
+		name         string
+		msg          sdk.Msg
+		attrName     string
+		expSizeClass string
+		expLength    int
+		expFee       sdk.Coins
+	}{
+		{
+			name:         "add small value",
+			msg:          types.NewMsgAddAttributeRequest(s.owner1, s.owner1Addr, "example.name", types.AttributeType_String, []byte("small")),
+			attrName:     "example.name",
+			expSizeClass: types.ValueSizeClassSmall,
+			expLength:    5,
+			expFee:       params.ValueSizeFees.SmallFee,
+		},
+		{
+			name:         "add medium value",
+			msg:          types.NewMsgAddAttributeRequest(s.owner1, s.owner1Addr, "example.name", types.AttributeType_String, []byte("medium value")),
+			attrName:     "example.name",
+			expSizeClass: types.ValueSizeClassMedium,
+			expLength:    12,
+			expFee:       params.ValueSizeFees.MediumFee,
+		},
+		{
+			name: "update to large value",
+			msg: types.NewMsgUpdateAttributeRequest(s.owner1, s.owner1Addr, "example.name",
+				[]byte("small"), []byte("this is a large value"), types.AttributeType_String, types.AttributeType_String),
+			attrName:     "example.name",
+			expSizeClass: types.ValueSizeClassLarge,
+			expLength:    21,
+			expFee:       params.ValueSizeFees.LargeFee,
+		},
+		{
+			name:         "set account data",
+			msg:          &types.MsgSetAccountDataRequest{Value: "some medium data", Account: s.owner1},
+			attrName:     types.AccountDataName,
+			expSizeClass: types.ValueSizeClassMedium,
+			expLength:    16,
+			expFee:       params.ValueSizeFees.MediumFee,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			gm := antewrapper.NewFeeGasMeterWrapper(log.NewNopLogger(), storetypes.NewInfiniteGasMeter(), false).(*antewrapper.FeeGasMeter)
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(gm)
+
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgAddAttributeRequest:
+				_, err = s.msgServer.AddAttribute(s.ctx, msg)
+			case *types.MsgUpdateAttributeRequest:
+				_, err = s.msgServer.UpdateAttribute(s.ctx, msg)
+			case *types.MsgSetAccountDataRequest:
+				_, err = s.msgServer.SetAccountData(s.ctx, msg)
+			default:
+				s.FailNow("unexpected msg type", "%T", tc.msg)
+			}
+			s.Require().NoError(err, "msg server error")
+
+			s.Assert().Equal(tc.expFee.String(), gm.FeeConsumedForType(sdk.MsgTypeURL(tc.msg), "").String(), "fee consumed")
+			expEvent := types.NewEventAttributeValueSizeFee(tc.attrName, s.owner1, tc.expSizeClass, tc.expLength, tc.expFee)
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent)
+			s.Assert().True(result, "Expected typed event was not found: %v", expEvent)
+		})
+	}
+}
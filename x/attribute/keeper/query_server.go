@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/attribute/types"
 )
 
@@ -135,6 +136,25 @@ func (k Keeper) Scan(c context.Context, req *types.QueryScanRequest) (*types.Que
 	return &types.QueryScanResponse{Account: req.Account, Attributes: attributes, Pagination: pageRes}, nil
 }
 
+// VerifyHashedAttribute checks a preimage against the hashed attributes with a specific name on an account
+func (k Keeper) VerifyHashedAttribute(c context.Context, req *types.QueryVerifyHashedAttributeRequest) (*types.QueryVerifyHashedAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	if err := types.ValidateAttributeAddress(req.Account); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	verified, err := k.VerifyHashedAttributeValue(ctx, req.Account, req.Name, req.Salt, req.Preimage)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryVerifyHashedAttributeResponse{Verified: verified}, nil
+}
+
 // AttributeAccounts queries for all accounts that have a specific attribute
 func (k Keeper) AttributeAccounts(c context.Context, req *types.QueryAttributeAccountsRequest) (*types.QueryAttributeAccountsResponse, error) {
 	if req == nil {
@@ -184,3 +204,44 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 	}
 	return resp, nil
 }
+
+// AttributeMirror returns whether a smart contract inherits the attributes of its admin for required attribute checks.
+func (k Keeper) AttributeMirror(c context.Context, req *types.QueryAttributeMirrorRequest) (*types.QueryAttributeMirrorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryAttributeMirrorResponse{Enabled: k.HasAttributeMirror(ctx, contract)}
+	if source := k.GetAttributeMirrorSource(ctx, contract); len(source) > 0 {
+		resp.Source = source.String()
+	}
+	return resp, nil
+}
+
+// AttributeDependents returns the things (e.g. markers and exchange markets) that require an attribute name.
+func (k Keeper) AttributeDependents(c context.Context, req *types.QueryAttributeDependentsRequest) (*types.QueryAttributeDependentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.nameKeeper.Normalize(ctx, req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name %q: %v", req.Name, err)
+	}
+
+	dependents, pageRes, err := provutils.PaginateSlice(k.GetAttributeDependents(ctx, name), attributeDependentKey, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAttributeDependentsResponse{Dependents: dependents, Pagination: pageRes}, nil
+}
+
+// attributeDependentKey returns the key used to paginate attribute dependents: [module][0][kind][0][id].
+func attributeDependentKey(dependent types.AttributeDependent) []byte {
+	return []byte(dependent.Module + "\x00" + dependent.Kind + "\x00" + dependent.Id)
+}
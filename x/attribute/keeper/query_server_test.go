@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
@@ -198,3 +199,113 @@ func (s *QueryServerTestSuite) TestAccountData() {
 		})
 	}
 }
+
+func (s *QueryServerTestSuite) TestVerifyHashedAttribute() {
+	name := "kyc.example.attribute"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner1Addr, false), "SetNameRecord(%q)", name)
+
+	now := time.Now().UTC()
+	ctx := s.ctx.WithBlockTime(now)
+	expiration := now.Add(time.Hour)
+	salt := []byte("some salt")
+	preimage := []byte("some private value")
+	addrWithHash := sdk.AccAddress("addrWithHash________").String()
+	addrWithExpired := sdk.AccAddress("addrWithExpired_____").String()
+	addrWithBytes := sdk.AccAddress("addrWithBytes_______").String()
+
+	attrs := []types.Attribute{
+		types.NewAttribute(name, addrWithHash, types.AttributeType_Hashed, types.HashAttributeValue([]byte("other salt"), preimage), nil),
+		types.NewAttribute(name, addrWithHash, types.AttributeType_Hashed, types.HashAttributeValue(salt, preimage), nil),
+		types.NewAttribute(name, addrWithExpired, types.AttributeType_Hashed, types.HashAttributeValue(salt, preimage), &expiration),
+		types.NewAttribute(name, addrWithBytes, types.AttributeType_Bytes, types.HashAttributeValue(salt, preimage), nil),
+	}
+	for i, attr := range attrs {
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(ctx, attr, s.owner1Addr), "SetAttribute attrs[%d]", i)
+	}
+
+	req := func(account, name string, salt, preimage []byte) *types.QueryVerifyHashedAttributeRequest {
+		return &types.QueryVerifyHashedAttributeRequest{Account: account, Name: name, Salt: salt, Preimage: preimage}
+	}
+
+	tests := []struct {
+		name   string
+		ctx    sdk.Context
+		req    *types.QueryVerifyHashedAttributeRequest
+		exp    bool
+		expErr string
+	}{
+		{
+			name:   "nil request",
+			req:    nil,
+			expErr: "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			name:   "empty name",
+			req:    req(addrWithHash, "", salt, preimage),
+			expErr: "rpc error: code = InvalidArgument desc = empty attribute name",
+		},
+		{
+			name:   "invalid account",
+			req:    req("invalid", name, salt, preimage),
+			expErr: "rpc error: code = InvalidArgument desc = invalid account address: must be either an account address or scope metadata address: \"invalid\"",
+		},
+		{
+			name: "matching preimage",
+			req:  req(addrWithHash, name, salt, preimage),
+			exp:  true,
+		},
+		{
+			name: "matching preimage with different name casing",
+			req:  req(addrWithHash, "KYC.example.attribute", salt, preimage),
+			exp:  true,
+		},
+		{
+			name: "wrong preimage",
+			req:  req(addrWithHash, name, salt, []byte("some other value")),
+			exp:  false,
+		},
+		{
+			name: "wrong salt",
+			req:  req(addrWithHash, name, []byte("wrong salt"), preimage),
+			exp:  false,
+		},
+		{
+			name: "other attribute name",
+			req:  req(addrWithHash, "other.example.attribute", salt, preimage),
+			exp:  false,
+		},
+		{
+			name: "not yet expired",
+			req:  req(addrWithExpired, name, salt, preimage),
+			exp:  true,
+		},
+		{
+			name: "expired",
+			ctx:  ctx.WithBlockTime(expiration.Add(time.Second)),
+			req:  req(addrWithExpired, name, salt, preimage),
+			exp:  false,
+		},
+		{
+			name: "matching value is not a hashed attribute",
+			req:  req(addrWithBytes, name, salt, preimage),
+			exp:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			if tc.ctx.Context() == nil {
+				tc.ctx = ctx
+			}
+			actual, err := s.app.AttributeKeeper.VerifyHashedAttribute(tc.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "VerifyHashedAttribute error")
+				s.Assert().Nil(actual, "VerifyHashedAttribute response")
+				return
+			}
+			s.Require().NoError(err, "VerifyHashedAttribute error")
+			s.Require().NotNil(actual, "VerifyHashedAttribute response")
+			s.Assert().Equal(tc.exp, actual.Verified, "VerifyHashedAttribute response Verified")
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// SetRefreshOracle sets whether an address is allowed to extend the expiration of attributes with the provided name.
+func (k Keeper) SetRefreshOracle(ctx sdk.Context, name string, oracle sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.RefreshOracleKey(name, oracle)
+	if enabled {
+		store.Set(key, []byte(name))
+	} else {
+		store.Delete(key)
+	}
+}
+
+// IsRefreshOracle returns true if the address is allowed to extend the expiration of attributes with the provided name.
+func (k Keeper) IsRefreshOracle(ctx sdk.Context, name string, oracle sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RefreshOracleKey(name, oracle))
+}
+
+// IterateRefreshOracles iterates over all of the attribute names and the oracles allowed to refresh them.
+func (k Keeper) IterateRefreshOracles(ctx sdk.Context, cb func(name string, oracle sdk.AccAddress) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RefreshOracleKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		// The key is [prefix][name hash (32 bytes)][length][oracle address].
+		if cb(string(it.Value()), sdk.AccAddress(it.Key()[34:])) {
+			break
+		}
+	}
+}
+
+// RefreshAttributes extends the expiration date of all attributes with the provided name on each of the accounts.
+// The values of the attributes are not changed. The oracle must either be a refresh oracle for the name,
+// or be the address the name resolves to. Only existing attributes that already have an expiration date can be
+// refreshed, and the new expiration date cannot be before the current one. Returns the number of attributes updated.
+func (k Keeper) RefreshAttributes(ctx sdk.Context, name string, accounts []string, expirationDate time.Time, oracle sdk.AccAddress) (uint64, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "keeper_method", "refresh")
+
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return 0, fmt.Errorf("unable to normalize attribute name %q: %w", name, err)
+	}
+	name = normalizedName
+
+	if !k.IsRefreshOracle(ctx, name, oracle) && !k.nameKeeper.ResolvesTo(ctx, name, oracle) {
+		return 0, fmt.Errorf("%s is not a refresh oracle for %q", oracle, name)
+	}
+	if expirationDate.Unix() < ctx.BlockTime().Unix() {
+		return 0, fmt.Errorf("attribute expiration date %v is before block time of %v", expirationDate.UTC(), ctx.BlockTime().UTC())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var count uint64
+	for _, account := range accounts {
+		acctAddr := types.GetAttributeAddressBytes(account)
+		if len(acctAddr) == 0 {
+			return 0, fmt.Errorf("invalid account address %q", account)
+		}
+		attrKeys := k.getAddrAttributesKeysByName(store, acctAddr, name)
+		if len(attrKeys) == 0 {
+			return 0, fmt.Errorf("no attributes with name %q found on account %s", name, account)
+		}
+		for _, attrKey := range attrKeys {
+			attr := types.Attribute{}
+			if err = k.cdc.Unmarshal(store.Get(attrKey), &attr); err != nil {
+				return 0, err
+			}
+			if attr.ExpirationDate == nil {
+				return 0, fmt.Errorf("attribute %q on account %s does not expire", name, account)
+			}
+			if expirationDate.Before(*attr.ExpirationDate) {
+				return 0, fmt.Errorf("attribute %q on account %s expires at %v which is after %v",
+					name, account, attr.ExpirationDate.UTC(), expirationDate.UTC())
+			}
+
+			k.deleteAttributeExpireLookup(store, attr)
+
+			originalExpiration := attr.ExpirationDate
+			newExpiration := expirationDate
+			attr.ExpirationDate = &newExpiration
+			bz, err := k.cdc.Marshal(&attr)
+			if err != nil {
+				return 0, err
+			}
+			store.Set(attrKey, bz)
+
+			k.addAttributeExpireLookup(store, attr)
+
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventAttributeExpirationUpdate(attr, originalExpiration, oracle.String())); err != nil {
+				return 0, err
+			}
+			count++
+		}
+	}
+
+	return count, nil
+}
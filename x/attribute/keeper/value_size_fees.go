@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/antewrapper"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetValueSizeFee returns the size class of a value with the provided length and the msg fee surcharge for writing it.
+func (k Keeper) GetValueSizeFee(ctx sdk.Context, valueLength int) (string, sdk.Coins) {
+	return k.GetParams(ctx).ValueSizeFees.GetFee(valueLength)
+}
+
+// ConsumeValueSizeFee consumes the size class surcharge for writing an attribute value as an additional fee of the provided msg.
+// Nothing is consumed if the value's size class doesn't have a fee, or if the value is empty.
+func (k Keeper) ConsumeValueSizeFee(ctx sdk.Context, msg sdk.Msg, name, account string, valueLength int) error {
+	if valueLength == 0 {
+		return nil
+	}
+	sizeClass, fee := k.GetValueSizeFee(ctx, valueLength)
+	if fee.IsZero() {
+		return nil
+	}
+	antewrapper.ConsumeMsgFee(ctx, fee, msg, "")
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeValueSizeFee(name, account, sizeClass, valueLength, fee))
+}
@@ -81,7 +81,7 @@ func SimulateMsgAddAttribute(simState module.SimulationState, _ keeper.Keeper, a
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgAddAttributeRequest{}), "no name records available to create under"), nil, nil
 		}
 
-		t := types.AttributeType(r.Intn(9)) //nolint:gosec // G115: r.Intn(9) will always fit in an int32 (implicit cast here).
+		t := types.AttributeType(r.Intn(9) + 1) //nolint:gosec // G115: r.Intn(9) + 1 will always fit in an int32 (implicit cast here).
 		msg := types.NewMsgAddAttributeRequest(
 			randomRecord.GetAddress(),
 			simAccount.Address,
@@ -107,7 +107,7 @@ func SimulateMsgUpdateAttribute(simState module.SimulationState, k keeper.Keeper
 			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgUpdateAttributeRequest{}), "no attributes available to delete"), nil, nil
 		}
 
-		t := types.AttributeType(r.Intn(9)) //nolint:gosec // G115: r.Intn(9) will always fit in an int32 (implicit cast here).
+		t := types.AttributeType(r.Intn(9) + 1) //nolint:gosec // G115: r.Intn(9) + 1 will always fit in an int32 (implicit cast here).
 		msg := types.NewMsgUpdateAttributeRequest(
 			randomAttribute.GetAddress(),
 			simAccount.Address,
@@ -194,6 +194,8 @@ func getRandomValueOfType(r *rand.Rand, t types.AttributeType) []byte {
 		return []byte(fmt.Sprintf("%d", r.Int31()))
 	case types.AttributeType_Bytes:
 		return []byte(simtypes.RandStringOfLength(r, int(r.Int31n(20))))
+	case types.AttributeType_Hashed:
+		return types.HashAttributeValue([]byte(simtypes.RandStringOfLength(r, 8)), []byte(simtypes.RandStringOfLength(r, 20)))
 	case types.AttributeType_String:
 		return []byte(simtypes.RandStringOfLength(r, int(r.Int31n(20))))
 	case types.AttributeType_UUID:
@@ -146,8 +146,8 @@ func (s *SimTestSuite) TestSimulateMsgAddAttribute() {
 	s.Assert().Equal("cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Account, "msg.Account")
 	s.Assert().Equal("cosmos1tnh2q55v8wyygtt9srz5safamzdengsnqeycj3", msg.Owner, "msg.Owner")
 	s.Assert().Equal(name, msg.Name, "msg.Name")
-	s.Assert().Equal(types.AttributeType_Uri, msg.AttributeType, "msg.AttributeType")
-	s.Assert().Equal([]byte("http://www.example.com/"), msg.Value, "msg.Value")
+	s.Assert().Equal(types.AttributeType_Int, msg.AttributeType, "msg.AttributeType")
+	s.Assert().Equal([]byte("646203300"), msg.Value, "msg.Value")
 	s.Assert().Equal(sdk.MsgTypeURL(&msg), operationMsg.Name, "operationMsg.Name")
 	s.Assert().Equal(types.RouterKey, operationMsg.Route, "operationMsg.Route")
 	s.Assert().Len(futureOperations, 0, "futureOperations")
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// ValidateBasic returns an error if this AttestationSource is invalid.
+func (s AttestationSource) ValidateBasic() error {
+	if len(strings.TrimSpace(s.Name)) == 0 {
+		return errors.New("invalid name: empty")
+	}
+	if err := host.ChannelIdentifierValidator(s.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id: %w", err)
+	}
+	if len(strings.TrimSpace(s.Issuer)) == 0 {
+		return errors.New("invalid issuer: empty")
+	}
+	return nil
+}
+
+// ValidateBasic returns an error if this AttributeAttestation is invalid.
+func (a AttributeAttestation) ValidateBasic() error {
+	return a.ToAttribute(nil).ValidateBasic()
+}
+
+// ToAttribute converts this AttributeAttestation into an Attribute with the provided origin.
+func (a AttributeAttestation) ToAttribute(origin *AttestationOrigin) Attribute {
+	return Attribute{
+		Name:           a.Name,
+		Value:          a.Value,
+		AttributeType:  a.AttributeType,
+		Address:        a.Account,
+		ExpirationDate: a.ExpirationDate,
+		Origin:         origin,
+	}
+}
+
+// ValidateBasic returns an error if this AttributeAttestationPacketData is invalid.
+func (p AttributeAttestationPacketData) ValidateBasic() error {
+	if len(strings.TrimSpace(p.Issuer)) == 0 {
+		return errors.New("invalid issuer: empty")
+	}
+	if len(p.Attestations) == 0 {
+		return errors.New("invalid attestations: empty")
+	}
+	for i, attestation := range p.Attestations {
+		if err := attestation.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid attestations[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -21,7 +22,7 @@ import (
 // NewAttribute creates a new instance of an Attribute
 func NewAttribute(name string, address string, attrType AttributeType, value []byte, expirationDate *time.Time) Attribute {
 	// Ensure string type values are trimmed.
-	if attrType != AttributeType_Bytes && attrType != AttributeType_Proto {
+	if attrType != AttributeType_Bytes && attrType != AttributeType_Proto && attrType != AttributeType_Hashed {
 		trimmed := strings.TrimSpace(string(value))
 		value = []byte(trimmed)
 	}
@@ -46,6 +47,23 @@ func (a Attribute) Hash() []byte {
 	return sum[:]
 }
 
+// MatchesPreimage returns true if this is a hashed attribute whose value is the hash of the provided salt and preimage.
+func (a Attribute) MatchesPreimage(salt, preimage []byte) bool {
+	if a.AttributeType != AttributeType_Hashed {
+		return false
+	}
+	return subtle.ConstantTimeCompare(a.Value, HashAttributeValue(salt, preimage)) == 1
+}
+
+// HashAttributeValue returns the SHA256 hash of the salt followed by the preimage.
+// This is the value to store in an attribute with type AttributeType_Hashed.
+func HashAttributeValue(salt, preimage []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write(salt)
+	hasher.Write(preimage)
+	return hasher.Sum(nil)
+}
+
 // ValidateBasic ensures an attribute is valid.
 func (a Attribute) ValidateBasic() error {
 	if strings.TrimSpace(a.Name) == "" {
@@ -130,6 +148,8 @@ func isValidValueForType(attrType AttributeType, value []byte) bool {
 		return true // Treat proto as just a special tag for bytes
 	case AttributeType_Bytes:
 		return true
+	case AttributeType_Hashed:
+		return len(value) == sha256.Size
 	default:
 		return false
 	}
@@ -191,7 +211,8 @@ func ValidAttributeType(attributeType AttributeType) bool {
 		attributeType == AttributeType_Int ||
 		attributeType == AttributeType_Float ||
 		attributeType == AttributeType_Proto ||
-		attributeType == AttributeType_Bytes {
+		attributeType == AttributeType_Bytes ||
+		attributeType == AttributeType_Hashed {
 		return true
 	}
 	return false
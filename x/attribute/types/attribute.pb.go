@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -49,6 +52,9 @@ const (
 	AttributeType_Proto AttributeType = 7
 	// ATTRIBUTE_TYPE_BYTES defines an attribute value that contains an untyped array of bytes
 	AttributeType_Bytes AttributeType = 8
+	// ATTRIBUTE_TYPE_HASHED defines an attribute value that contains the SHA-256 hash of a salt followed by a preimage.
+	// The preimage (and salt) are kept off-chain and can be checked using the VerifyHashedAttribute query.
+	AttributeType_Hashed AttributeType = 9
 )
 
 var AttributeType_name = map[int32]string{
@@ -61,6 +67,7 @@ var AttributeType_name = map[int32]string{
 	6: "ATTRIBUTE_TYPE_FLOAT",
 	7: "ATTRIBUTE_TYPE_PROTO",
 	8: "ATTRIBUTE_TYPE_BYTES",
+	9: "ATTRIBUTE_TYPE_HASHED",
 }
 
 var AttributeType_value = map[string]int32{
@@ -73,6 +80,7 @@ var AttributeType_value = map[string]int32{
 	"ATTRIBUTE_TYPE_FLOAT":       6,
 	"ATTRIBUTE_TYPE_PROTO":       7,
 	"ATTRIBUTE_TYPE_BYTES":       8,
+	"ATTRIBUTE_TYPE_HASHED":      9,
 }
 
 func (x AttributeType) String() string {
@@ -87,6 +95,8 @@ func (AttributeType) EnumDescriptor() ([]byte, []int) {
 type Params struct {
 	// maximum length of data to allow in an attribute value
 	MaxValueLength uint32 `protobuf:"varint,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// value_size_fees are the msg fee surcharges for writing attribute values, based on the size of the value.
+	ValueSizeFees ValueSizeFees `protobuf:"bytes,2,opt,name=value_size_fees,json=valueSizeFees,proto3" json:"value_size_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -129,6 +139,97 @@ func (m *Params) GetMaxValueLength() uint32 {
 	return 0
 }
 
+func (m *Params) GetValueSizeFees() ValueSizeFees {
+	if m != nil {
+		return m.ValueSizeFees
+	}
+	return ValueSizeFees{}
+}
+
+// ValueSizeFees defines the msg fee surcharges for writing attribute values of each size class.
+// A value is large if its length is at least large_min_length, medium if its length is at least medium_min_length,
+// and small otherwise. A min length of zero means that size class is not used.
+type ValueSizeFees struct {
+	// medium_min_length is the length (in bytes) at which a value is considered medium.
+	MediumMinLength uint32 `protobuf:"varint,1,opt,name=medium_min_length,json=mediumMinLength,proto3" json:"medium_min_length,omitempty"`
+	// large_min_length is the length (in bytes) at which a value is considered large.
+	LargeMinLength uint32 `protobuf:"varint,2,opt,name=large_min_length,json=largeMinLength,proto3" json:"large_min_length,omitempty"`
+	// small_fee is the surcharge for writing a small value.
+	SmallFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=small_fee,json=smallFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"small_fee"`
+	// medium_fee is the surcharge for writing a medium value.
+	MediumFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=medium_fee,json=mediumFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"medium_fee"`
+	// large_fee is the surcharge for writing a large value.
+	LargeFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=large_fee,json=largeFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"large_fee"`
+}
+
+func (m *ValueSizeFees) Reset()         { *m = ValueSizeFees{} }
+func (m *ValueSizeFees) String() string { return proto.CompactTextString(m) }
+func (*ValueSizeFees) ProtoMessage()    {}
+func (*ValueSizeFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{1}
+}
+func (m *ValueSizeFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueSizeFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValueSizeFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValueSizeFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueSizeFees.Merge(m, src)
+}
+func (m *ValueSizeFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueSizeFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueSizeFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueSizeFees proto.InternalMessageInfo
+
+func (m *ValueSizeFees) GetMediumMinLength() uint32 {
+	if m != nil {
+		return m.MediumMinLength
+	}
+	return 0
+}
+
+func (m *ValueSizeFees) GetLargeMinLength() uint32 {
+	if m != nil {
+		return m.LargeMinLength
+	}
+	return 0
+}
+
+func (m *ValueSizeFees) GetSmallFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SmallFee
+	}
+	return nil
+}
+
+func (m *ValueSizeFees) GetMediumFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MediumFee
+	}
+	return nil
+}
+
+func (m *ValueSizeFees) GetLargeFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.LargeFee
+	}
+	return nil
+}
+
 // Attribute holds a typed key/value structure for data associated with an account
 type Attribute struct {
 	// The attribute name.
@@ -141,12 +242,14 @@ type Attribute struct {
 	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
 	// Time that an attribute will expire.
 	ExpirationDate *time.Time `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date,omitempty"`
+	// Where the attribute came from if it was imported from an attestation on another chain.
+	Origin *AttestationOrigin `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
 }
 
 func (m *Attribute) Reset()      { *m = Attribute{} }
 func (*Attribute) ProtoMessage() {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{1}
+	return fileDescriptor_14fe7eb43c711f5e, []int{2}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,28 +313,35 @@ func (m *Attribute) GetExpirationDate() *time.Time {
 	return nil
 }
 
-// EventAttributeAdd event emitted when attribute is added
-type EventAttributeAdd struct {
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value      string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Account    string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
-	Owner      string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
-	Expiration string `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+func (m *Attribute) GetOrigin() *AttestationOrigin {
+	if m != nil {
+		return m.Origin
+	}
+	return nil
 }
 
-func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
-func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeAdd) ProtoMessage()    {}
-func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+// AttestationOrigin identifies the attestation on another chain that an attribute was imported from.
+type AttestationOrigin struct {
+	// channel_id is the IBC channel (on this chain) that the attestation arrived on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// issuer is the address (on the counterparty chain) of the account that made the attestation.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// sequence is the sequence number of the IBC packet that carried the attestation.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
-func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
+
+func (m *AttestationOrigin) Reset()         { *m = AttestationOrigin{} }
+func (m *AttestationOrigin) String() string { return proto.CompactTextString(m) }
+func (*AttestationOrigin) ProtoMessage()    {}
+func (*AttestationOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+}
+func (m *AttestationOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttestationOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeAdd.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttestationOrigin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -241,83 +351,130 @@ func (m *EventAttributeAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeAdd) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeAdd.Merge(m, src)
+func (m *AttestationOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationOrigin.Merge(m, src)
 }
-func (m *EventAttributeAdd) XXX_Size() int {
+func (m *AttestationOrigin) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeAdd) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeAdd.DiscardUnknown(m)
+func (m *AttestationOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationOrigin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttributeAdd proto.InternalMessageInfo
+var xxx_messageInfo_AttestationOrigin proto.InternalMessageInfo
 
-func (m *EventAttributeAdd) GetName() string {
+func (m *AttestationOrigin) GetChannelId() string {
 	if m != nil {
-		return m.Name
+		return m.ChannelId
 	}
 	return ""
 }
 
-func (m *EventAttributeAdd) GetValue() string {
+func (m *AttestationOrigin) GetIssuer() string {
 	if m != nil {
-		return m.Value
+		return m.Issuer
 	}
 	return ""
 }
 
-func (m *EventAttributeAdd) GetType() string {
+func (m *AttestationOrigin) GetSequence() uint64 {
 	if m != nil {
-		return m.Type
+		return m.Sequence
 	}
-	return ""
+	return 0
 }
 
-func (m *EventAttributeAdd) GetAccount() string {
+// AttestationSource is an issuer on another chain that the owner of a name has allowed to attest attributes with
+// that name over IBC.
+type AttestationSource struct {
+	// name is the attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// channel_id is the IBC channel (on this chain) that the issuer's attestations arrive on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// issuer is the address (on the counterparty chain) of the account that makes the attestations.
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+}
+
+func (m *AttestationSource) Reset()         { *m = AttestationSource{} }
+func (m *AttestationSource) String() string { return proto.CompactTextString(m) }
+func (*AttestationSource) ProtoMessage()    {}
+func (*AttestationSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+}
+func (m *AttestationSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationSource.Merge(m, src)
+}
+func (m *AttestationSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationSource proto.InternalMessageInfo
+
+func (m *AttestationSource) GetName() string {
 	if m != nil {
-		return m.Account
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventAttributeAdd) GetOwner() string {
+func (m *AttestationSource) GetChannelId() string {
 	if m != nil {
-		return m.Owner
+		return m.ChannelId
 	}
 	return ""
 }
 
-func (m *EventAttributeAdd) GetExpiration() string {
+func (m *AttestationSource) GetIssuer() string {
 	if m != nil {
-		return m.Expiration
+		return m.Issuer
 	}
 	return ""
 }
 
-// EventAttributeUpdate event emitted when attribute is updated
-type EventAttributeUpdate struct {
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	OriginalValue string `protobuf:"bytes,2,opt,name=original_value,json=originalValue,proto3" json:"original_value,omitempty"`
-	OriginalType  string `protobuf:"bytes,3,opt,name=original_type,json=originalType,proto3" json:"original_type,omitempty"`
-	UpdateValue   string `protobuf:"bytes,4,opt,name=update_value,json=updateValue,proto3" json:"update_value,omitempty"`
-	UpdateType    string `protobuf:"bytes,5,opt,name=update_type,json=updateType,proto3" json:"update_type,omitempty"`
-	Account       string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
-	Owner         string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+// AttributeAttestation is a claim by an issuer on another chain that an account on this chain should have an attribute.
+type AttributeAttestation struct {
+	// account is the bech32 address (on this chain) that the attribute is for.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// name is the attribute name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// value is the attribute value.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// attribute_type is the attribute value type.
+	AttributeType AttributeType `protobuf:"varint,4,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty"`
+	// expiration_date is when the attribute will expire.
+	ExpirationDate *time.Time `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date,omitempty"`
 }
 
-func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
-func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeUpdate) ProtoMessage()    {}
-func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+func (m *AttributeAttestation) Reset()         { *m = AttributeAttestation{} }
+func (m *AttributeAttestation) String() string { return proto.CompactTextString(m) }
+func (*AttributeAttestation) ProtoMessage()    {}
+func (*AttributeAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{5}
 }
-func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
+func (m *AttributeAttestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttributeAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttributeAttestation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -327,89 +484,73 @@ func (m *EventAttributeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeUpdate.Merge(m, src)
+func (m *AttributeAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeAttestation.Merge(m, src)
 }
-func (m *EventAttributeUpdate) XXX_Size() int {
+func (m *AttributeAttestation) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeUpdate.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventAttributeUpdate proto.InternalMessageInfo
-
-func (m *EventAttributeUpdate) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *AttributeAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeAttestation.DiscardUnknown(m)
 }
 
-func (m *EventAttributeUpdate) GetOriginalValue() string {
-	if m != nil {
-		return m.OriginalValue
-	}
-	return ""
-}
+var xxx_messageInfo_AttributeAttestation proto.InternalMessageInfo
 
-func (m *EventAttributeUpdate) GetOriginalType() string {
+func (m *AttributeAttestation) GetAccount() string {
 	if m != nil {
-		return m.OriginalType
+		return m.Account
 	}
 	return ""
 }
 
-func (m *EventAttributeUpdate) GetUpdateValue() string {
+func (m *AttributeAttestation) GetName() string {
 	if m != nil {
-		return m.UpdateValue
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventAttributeUpdate) GetUpdateType() string {
+func (m *AttributeAttestation) GetValue() []byte {
 	if m != nil {
-		return m.UpdateType
+		return m.Value
 	}
-	return ""
+	return nil
 }
 
-func (m *EventAttributeUpdate) GetAccount() string {
+func (m *AttributeAttestation) GetAttributeType() AttributeType {
 	if m != nil {
-		return m.Account
+		return m.AttributeType
 	}
-	return ""
+	return AttributeType_Unspecified
 }
 
-func (m *EventAttributeUpdate) GetOwner() string {
+func (m *AttributeAttestation) GetExpirationDate() *time.Time {
 	if m != nil {
-		return m.Owner
+		return m.ExpirationDate
 	}
-	return ""
+	return nil
 }
 
-// EventAttributeExpirationUpdate event emitted when attribute expiration is updated
-type EventAttributeExpirationUpdate struct {
-	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value              string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Account            string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
-	Owner              string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	OriginalExpiration string `protobuf:"bytes,5,opt,name=original_expiration,json=originalExpiration,proto3" json:"original_expiration,omitempty"`
-	UpdatedExpiration  string `protobuf:"bytes,6,opt,name=updated_expiration,json=updatedExpiration,proto3" json:"updated_expiration,omitempty"`
+// AttributeAttestationPacketData is the data of the IBC packets that carry attribute attestations to this chain.
+type AttributeAttestationPacketData struct {
+	// issuer is the address (on the counterparty chain) of the account that made the attestations.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// attestations are the attributes being attested.
+	Attestations []AttributeAttestation `protobuf:"bytes,2,rep,name=attestations,proto3" json:"attestations"`
 }
 
-func (m *EventAttributeExpirationUpdate) Reset()         { *m = EventAttributeExpirationUpdate{} }
-func (m *EventAttributeExpirationUpdate) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeExpirationUpdate) ProtoMessage()    {}
-func (*EventAttributeExpirationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+func (m *AttributeAttestationPacketData) Reset()         { *m = AttributeAttestationPacketData{} }
+func (m *AttributeAttestationPacketData) String() string { return proto.CompactTextString(m) }
+func (*AttributeAttestationPacketData) ProtoMessage()    {}
+func (*AttributeAttestationPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{6}
 }
-func (m *EventAttributeExpirationUpdate) XXX_Unmarshal(b []byte) error {
+func (m *AttributeAttestationPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeExpirationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttributeAttestationPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeExpirationUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttributeAttestationPacketData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -419,79 +560,111 @@ func (m *EventAttributeExpirationUpdate) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeExpirationUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeExpirationUpdate.Merge(m, src)
+func (m *AttributeAttestationPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeAttestationPacketData.Merge(m, src)
 }
-func (m *EventAttributeExpirationUpdate) XXX_Size() int {
+func (m *AttributeAttestationPacketData) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeExpirationUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeExpirationUpdate.DiscardUnknown(m)
+func (m *AttributeAttestationPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeAttestationPacketData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttributeExpirationUpdate proto.InternalMessageInfo
+var xxx_messageInfo_AttributeAttestationPacketData proto.InternalMessageInfo
 
-func (m *EventAttributeExpirationUpdate) GetName() string {
+func (m *AttributeAttestationPacketData) GetIssuer() string {
 	if m != nil {
-		return m.Name
+		return m.Issuer
 	}
 	return ""
 }
 
-func (m *EventAttributeExpirationUpdate) GetValue() string {
+func (m *AttributeAttestationPacketData) GetAttestations() []AttributeAttestation {
 	if m != nil {
-		return m.Value
+		return m.Attestations
 	}
-	return ""
+	return nil
 }
 
-func (m *EventAttributeExpirationUpdate) GetAccount() string {
-	if m != nil {
-		return m.Account
-	}
-	return ""
+// RefreshOracle is an address that the owner of a name has allowed to extend the expiration of attributes with that name.
+type RefreshOracle struct {
+	// name is the attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// oracle is the bech32 address allowed to extend the expiration of attributes with the name.
+	Oracle string `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle,omitempty"`
 }
 
-func (m *EventAttributeExpirationUpdate) GetOwner() string {
-	if m != nil {
-		return m.Owner
+func (m *RefreshOracle) Reset()         { *m = RefreshOracle{} }
+func (m *RefreshOracle) String() string { return proto.CompactTextString(m) }
+func (*RefreshOracle) ProtoMessage()    {}
+func (*RefreshOracle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{7}
+}
+func (m *RefreshOracle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshOracle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshOracle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *RefreshOracle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshOracle.Merge(m, src)
+}
+func (m *RefreshOracle) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshOracle) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshOracle.DiscardUnknown(m)
 }
 
-func (m *EventAttributeExpirationUpdate) GetOriginalExpiration() string {
+var xxx_messageInfo_RefreshOracle proto.InternalMessageInfo
+
+func (m *RefreshOracle) GetName() string {
 	if m != nil {
-		return m.OriginalExpiration
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventAttributeExpirationUpdate) GetUpdatedExpiration() string {
+func (m *RefreshOracle) GetOracle() string {
 	if m != nil {
-		return m.UpdatedExpiration
+		return m.Oracle
 	}
 	return ""
 }
 
-// EventAttributeDelete event emitted when attribute is deleted
-type EventAttributeDelete struct {
-	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	Owner   string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+// AttributeDependent is something in another module (e.g. a marker or exchange market) that requires an attribute.
+type AttributeDependent struct {
+	// module is the name of the module that the dependent is in, e.g. "marker" or "exchange".
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// kind is the type of the dependent, e.g. "marker" or "market".
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// id identifies the dependent, e.g. a marker's denom or a market's id.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// required_attributes are the dependent's required attributes that are satisfied by the attribute name.
+	RequiredAttributes []string `protobuf:"bytes,4,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
 }
 
-func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
-func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeDelete) ProtoMessage()    {}
-func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+func (m *AttributeDependent) Reset()         { *m = AttributeDependent{} }
+func (m *AttributeDependent) String() string { return proto.CompactTextString(m) }
+func (*AttributeDependent) ProtoMessage()    {}
+func (*AttributeDependent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{8}
 }
-func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
+func (m *AttributeDependent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttributeDependent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeDelete.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttributeDependent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -501,60 +674,68 @@ func (m *EventAttributeDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeDelete) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeDelete.Merge(m, src)
+func (m *AttributeDependent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeDependent.Merge(m, src)
 }
-func (m *EventAttributeDelete) XXX_Size() int {
+func (m *AttributeDependent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeDelete) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeDelete.DiscardUnknown(m)
+func (m *AttributeDependent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeDependent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttributeDelete proto.InternalMessageInfo
+var xxx_messageInfo_AttributeDependent proto.InternalMessageInfo
 
-func (m *EventAttributeDelete) GetName() string {
+func (m *AttributeDependent) GetModule() string {
 	if m != nil {
-		return m.Name
+		return m.Module
 	}
 	return ""
 }
 
-func (m *EventAttributeDelete) GetAccount() string {
+func (m *AttributeDependent) GetKind() string {
 	if m != nil {
-		return m.Account
+		return m.Kind
 	}
 	return ""
 }
 
-func (m *EventAttributeDelete) GetOwner() string {
+func (m *AttributeDependent) GetId() string {
 	if m != nil {
-		return m.Owner
+		return m.Id
 	}
 	return ""
 }
 
-// EventAttributeDistinctDelete event emitted when attribute is deleted with matching value
-type EventAttributeDistinctDelete struct {
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
-	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
-	Owner         string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+func (m *AttributeDependent) GetRequiredAttributes() []string {
+	if m != nil {
+		return m.RequiredAttributes
+	}
+	return nil
 }
 
-func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDistinctDelete{} }
-func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeDistinctDelete) ProtoMessage()    {}
-func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{6}
+// EventAttributeAdd event emitted when attribute is added
+type EventAttributeAdd struct {
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value      string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Type       string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Account    string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Owner      string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Expiration string `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
 }
-func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
+
+func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
+func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeAdd) ProtoMessage()    {}
+func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{9}
+}
+func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeDistinctDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAttributeAdd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeDistinctDelete.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAttributeAdd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -564,74 +745,83 @@ func (m *EventAttributeDistinctDelete) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeDistinctDelete) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeDistinctDelete.Merge(m, src)
+func (m *EventAttributeAdd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeAdd.Merge(m, src)
 }
-func (m *EventAttributeDistinctDelete) XXX_Size() int {
+func (m *EventAttributeAdd) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeDistinctDelete) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeDistinctDelete.DiscardUnknown(m)
+func (m *EventAttributeAdd) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeAdd.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttributeDistinctDelete proto.InternalMessageInfo
+var xxx_messageInfo_EventAttributeAdd proto.InternalMessageInfo
 
-func (m *EventAttributeDistinctDelete) GetName() string {
+func (m *EventAttributeAdd) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *EventAttributeDistinctDelete) GetValue() string {
+func (m *EventAttributeAdd) GetValue() string {
 	if m != nil {
 		return m.Value
 	}
 	return ""
 }
 
-func (m *EventAttributeDistinctDelete) GetAttributeType() string {
+func (m *EventAttributeAdd) GetType() string {
 	if m != nil {
-		return m.AttributeType
+		return m.Type
 	}
 	return ""
 }
 
-func (m *EventAttributeDistinctDelete) GetAccount() string {
+func (m *EventAttributeAdd) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-func (m *EventAttributeDistinctDelete) GetOwner() string {
+func (m *EventAttributeAdd) GetOwner() string {
 	if m != nil {
 		return m.Owner
 	}
 	return ""
 }
 
-// EventAttributeExpired event emitted when attribute has expired and been deleted in BeginBlocker
-type EventAttributeExpired struct {
+func (m *EventAttributeAdd) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
+	}
+	return ""
+}
+
+// EventAttributeUpdate event emitted when attribute is updated
+type EventAttributeUpdate struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ValueHash     string `protobuf:"bytes,2,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
-	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
-	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
-	Expiration    string `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	OriginalValue string `protobuf:"bytes,2,opt,name=original_value,json=originalValue,proto3" json:"original_value,omitempty"`
+	OriginalType  string `protobuf:"bytes,3,opt,name=original_type,json=originalType,proto3" json:"original_type,omitempty"`
+	UpdateValue   string `protobuf:"bytes,4,opt,name=update_value,json=updateValue,proto3" json:"update_value,omitempty"`
+	UpdateType    string `protobuf:"bytes,5,opt,name=update_type,json=updateType,proto3" json:"update_type,omitempty"`
+	Account       string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
+	Owner         string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventAttributeExpired) Reset()         { *m = EventAttributeExpired{} }
-func (m *EventAttributeExpired) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeExpired) ProtoMessage()    {}
-func (*EventAttributeExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{7}
+func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
+func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeUpdate) ProtoMessage()    {}
+func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{10}
 }
-func (m *EventAttributeExpired) XXX_Unmarshal(b []byte) error {
+func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAttributeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeExpired.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAttributeUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -641,70 +831,89 @@ func (m *EventAttributeExpired) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeExpired) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeExpired.Merge(m, src)
+func (m *EventAttributeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeUpdate.Merge(m, src)
 }
-func (m *EventAttributeExpired) XXX_Size() int {
+func (m *EventAttributeUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeExpired) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeExpired.DiscardUnknown(m)
+func (m *EventAttributeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttributeExpired proto.InternalMessageInfo
+var xxx_messageInfo_EventAttributeUpdate proto.InternalMessageInfo
 
-func (m *EventAttributeExpired) GetName() string {
+func (m *EventAttributeUpdate) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *EventAttributeExpired) GetValueHash() string {
+func (m *EventAttributeUpdate) GetOriginalValue() string {
 	if m != nil {
-		return m.ValueHash
+		return m.OriginalValue
 	}
 	return ""
 }
 
-func (m *EventAttributeExpired) GetAttributeType() string {
+func (m *EventAttributeUpdate) GetOriginalType() string {
 	if m != nil {
-		return m.AttributeType
+		return m.OriginalType
 	}
 	return ""
 }
 
-func (m *EventAttributeExpired) GetAccount() string {
+func (m *EventAttributeUpdate) GetUpdateValue() string {
+	if m != nil {
+		return m.UpdateValue
+	}
+	return ""
+}
+
+func (m *EventAttributeUpdate) GetUpdateType() string {
+	if m != nil {
+		return m.UpdateType
+	}
+	return ""
+}
+
+func (m *EventAttributeUpdate) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-func (m *EventAttributeExpired) GetExpiration() string {
+func (m *EventAttributeUpdate) GetOwner() string {
 	if m != nil {
-		return m.Expiration
+		return m.Owner
 	}
 	return ""
 }
 
-// EventAccountDataUpdated event emitted when accountdata is set, updated, or deleted.
-type EventAccountDataUpdated struct {
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+// EventAttributeExpirationUpdate event emitted when attribute expiration is updated
+type EventAttributeExpirationUpdate struct {
+	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value              string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Account            string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Owner              string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	OriginalExpiration string `protobuf:"bytes,5,opt,name=original_expiration,json=originalExpiration,proto3" json:"original_expiration,omitempty"`
+	UpdatedExpiration  string `protobuf:"bytes,6,opt,name=updated_expiration,json=updatedExpiration,proto3" json:"updated_expiration,omitempty"`
 }
 
-func (m *EventAccountDataUpdated) Reset()         { *m = EventAccountDataUpdated{} }
-func (m *EventAccountDataUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventAccountDataUpdated) ProtoMessage()    {}
-func (*EventAccountDataUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{8}
+func (m *EventAttributeExpirationUpdate) Reset()         { *m = EventAttributeExpirationUpdate{} }
+func (m *EventAttributeExpirationUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeExpirationUpdate) ProtoMessage()    {}
+func (*EventAttributeExpirationUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{11}
 }
-func (m *EventAccountDataUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventAttributeExpirationUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAccountDataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAttributeExpirationUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAccountDataUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAttributeExpirationUpdate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -714,42 +923,79 @@ func (m *EventAccountDataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventAccountDataUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAccountDataUpdated.Merge(m, src)
+func (m *EventAttributeExpirationUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeExpirationUpdate.Merge(m, src)
 }
-func (m *EventAccountDataUpdated) XXX_Size() int {
+func (m *EventAttributeExpirationUpdate) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAccountDataUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAccountDataUpdated.DiscardUnknown(m)
+func (m *EventAttributeExpirationUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeExpirationUpdate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAccountDataUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventAttributeExpirationUpdate proto.InternalMessageInfo
 
-func (m *EventAccountDataUpdated) GetAccount() string {
+func (m *EventAttributeExpirationUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeExpirationUpdate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *EventAttributeExpirationUpdate) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-// EventAttributeParamsUpdated event emitted when attribute params are updated.
-type EventAttributeParamsUpdated struct {
-	MaxValueLength string `protobuf:"bytes,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+func (m *EventAttributeExpirationUpdate) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *EventAttributeParamsUpdated) Reset()         { *m = EventAttributeParamsUpdated{} }
-func (m *EventAttributeParamsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventAttributeParamsUpdated) ProtoMessage()    {}
-func (*EventAttributeParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{9}
+func (m *EventAttributeExpirationUpdate) GetOriginalExpiration() string {
+	if m != nil {
+		return m.OriginalExpiration
+	}
+	return ""
 }
-func (m *EventAttributeParamsUpdated) XXX_Unmarshal(b []byte) error {
+
+func (m *EventAttributeExpirationUpdate) GetUpdatedExpiration() string {
+	if m != nil {
+		return m.UpdatedExpiration
+	}
+	return ""
+}
+
+// EventAttributeDelete event emitted when attribute is deleted
+type EventAttributeDelete struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Owner   string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
+func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeDelete) ProtoMessage()    {}
+func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{12}
+}
+func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttributeParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventAttributeDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttributeParamsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventAttributeDelete.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -759,877 +1005,4914 @@ func (m *EventAttributeParamsUpdated) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventAttributeParamsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttributeParamsUpdated.Merge(m, src)
+func (m *EventAttributeDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeDelete.Merge(m, src)
 }
-func (m *EventAttributeParamsUpdated) XXX_Size() int {
+func (m *EventAttributeDelete) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttributeParamsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttributeParamsUpdated.DiscardUnknown(m)
+func (m *EventAttributeDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeDelete.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttributeParamsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventAttributeDelete proto.InternalMessageInfo
 
-func (m *EventAttributeParamsUpdated) GetMaxValueLength() string {
+func (m *EventAttributeDelete) GetName() string {
 	if m != nil {
-		return m.MaxValueLength
+		return m.Name
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
-	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
-	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
-	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
-	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
-	proto.RegisterType((*EventAttributeExpirationUpdate)(nil), "provenance.attribute.v1.EventAttributeExpirationUpdate")
-	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
-	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
-	proto.RegisterType((*EventAttributeExpired)(nil), "provenance.attribute.v1.EventAttributeExpired")
-	proto.RegisterType((*EventAccountDataUpdated)(nil), "provenance.attribute.v1.EventAccountDataUpdated")
-	proto.RegisterType((*EventAttributeParamsUpdated)(nil), "provenance.attribute.v1.EventAttributeParamsUpdated")
+func (m *EventAttributeDelete) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("provenance/attribute/v1/attribute.proto", fileDescriptor_14fe7eb43c711f5e)
+func (m *EventAttributeDelete) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0xd6, 0x5a, 0x3f, 0x36, 0xc7, 0xb6, 0xc2, 0x6c, 0x1c, 0x44, 0x60, 0x5b, 0x89, 0x51, 0xe0,
-	0x56, 0x28, 0x10, 0x11, 0x71, 0xd0, 0x4b, 0x6f, 0x56, 0x25, 0xa7, 0x2a, 0x12, 0x5b, 0xa0, 0xa8,
-	0x02, 0xc9, 0x85, 0x58, 0x4b, 0x1b, 0x89, 0x80, 0xf8, 0x03, 0x72, 0xa5, 0xda, 0xaf, 0xa0, 0x53,
-	0x8e, 0xbd, 0x08, 0x6d, 0xcf, 0x7d, 0x91, 0x1c, 0x73, 0x2c, 0x7a, 0x48, 0x0b, 0xfb, 0xd6, 0x6b,
-	0x5f, 0xa0, 0xd0, 0xae, 0xf9, 0x23, 0x9a, 0x72, 0x51, 0xf4, 0xb6, 0x33, 0xfb, 0xed, 0xcc, 0xf7,
-	0xcd, 0xec, 0xce, 0xc2, 0x17, 0x9e, 0xef, 0xce, 0xa9, 0x43, 0x9c, 0x21, 0xd5, 0x08, 0x63, 0xbe,
-	0x75, 0x3e, 0x63, 0x54, 0x9b, 0x3f, 0x8b, 0x8d, 0xa6, 0xe7, 0xbb, 0xcc, 0xc5, 0x8f, 0x62, 0x60,
-	0x33, 0xde, 0x9b, 0x3f, 0x53, 0x0e, 0xc6, 0xee, 0xd8, 0xe5, 0x18, 0x6d, 0xb5, 0x12, 0x70, 0xa5,
-	0x36, 0x76, 0xdd, 0xf1, 0x94, 0x6a, 0xdc, 0x3a, 0x9f, 0xbd, 0xd5, 0x98, 0x65, 0xd3, 0x80, 0x11,
-	0xdb, 0x13, 0x80, 0xfa, 0x11, 0x94, 0x7a, 0xc4, 0x27, 0x76, 0x80, 0x1b, 0x20, 0xdb, 0xe4, 0xc2,
-	0x9c, 0x93, 0xe9, 0x8c, 0x9a, 0x53, 0xea, 0x8c, 0xd9, 0xa4, 0x82, 0x54, 0xd4, 0xd8, 0xd7, 0xcb,
-	0x36, 0xb9, 0xf8, 0x7e, 0xe5, 0x7e, 0xc9, 0xbd, 0xf5, 0xbf, 0x11, 0x48, 0xc7, 0x61, 0x6e, 0x8c,
-	0xa1, 0xe0, 0x10, 0x9b, 0x72, 0xac, 0xa4, 0xf3, 0x35, 0x3e, 0x80, 0x22, 0x8f, 0x53, 0xd9, 0x52,
-	0x51, 0x63, 0x4f, 0x17, 0x06, 0x7e, 0x05, 0xe5, 0x88, 0xb2, 0xc9, 0x2e, 0x3d, 0x5a, 0xc9, 0xab,
-	0xa8, 0x51, 0x3e, 0xfa, 0xbc, 0xb9, 0x41, 0x54, 0x33, 0xca, 0x62, 0x5c, 0x7a, 0x54, 0xdf, 0x27,
-	0x49, 0x13, 0x57, 0x60, 0x9b, 0x8c, 0x46, 0x3e, 0x0d, 0x82, 0x4a, 0x81, 0xe7, 0x0e, 0x4d, 0xfc,
-	0x0a, 0xee, 0xd1, 0x0b, 0xcf, 0xf2, 0x09, 0xb3, 0x5c, 0xc7, 0x1c, 0x11, 0x46, 0x2b, 0x45, 0x15,
-	0x35, 0x76, 0x8f, 0x94, 0xa6, 0xa8, 0x47, 0x33, 0xac, 0x47, 0xd3, 0x08, 0xeb, 0xd1, 0xda, 0x79,
-	0xff, 0xb1, 0x86, 0xde, 0xfd, 0x51, 0x43, 0x7a, 0x39, 0x3e, 0xdc, 0x26, 0x8c, 0x7e, 0x5d, 0xf8,
-	0xf1, 0xe7, 0x5a, 0xae, 0xfe, 0x0b, 0x82, 0xfb, 0x9d, 0x39, 0x75, 0x58, 0x44, 0xea, 0x78, 0x34,
-	0xfa, 0x77, 0xf5, 0x52, 0xa8, 0x1e, 0x43, 0x21, 0xd2, 0x2c, 0xe9, 0x7c, 0xcd, 0x25, 0x0c, 0x87,
-	0xee, 0xcc, 0x61, 0x91, 0x04, 0x61, 0xae, 0x62, 0xb8, 0x3f, 0x38, 0xd4, 0xe7, 0xc4, 0x25, 0x5d,
-	0x18, 0xb8, 0x0a, 0x10, 0x73, 0xab, 0x94, 0xf8, 0x56, 0xc2, 0x53, 0xff, 0x0b, 0xc1, 0xc1, 0x3a,
-	0xc7, 0x81, 0xb7, 0x92, 0x9f, 0x49, 0xf3, 0x10, 0xca, 0xae, 0x6f, 0x8d, 0x2d, 0x87, 0x4c, 0xcd,
-	0x24, 0xdf, 0xfd, 0xd0, 0xcb, 0x7b, 0x8e, 0x9f, 0x40, 0xe4, 0x30, 0x13, 0x02, 0xf6, 0x42, 0x27,
-	0xef, 0xc5, 0x63, 0xd8, 0x9b, 0xf1, 0x4c, 0x37, 0x91, 0x84, 0x9a, 0x5d, 0xe1, 0x13, 0x71, 0x6a,
-	0x70, 0x63, 0x8a, 0x28, 0x42, 0x17, 0x08, 0x97, 0x91, 0x2a, 0x46, 0x69, 0x43, 0x31, 0xb6, 0x13,
-	0xc5, 0xa8, 0xff, 0x8e, 0xa0, 0xba, 0x2e, 0xb6, 0x13, 0x55, 0xe2, 0x0e, 0xd9, 0xd9, 0xdd, 0x49,
-	0x24, 0xcf, 0x6f, 0x48, 0x5e, 0x48, 0x76, 0x42, 0x83, 0x07, 0x51, 0x55, 0x12, 0x2d, 0x11, 0xaa,
-	0x70, 0xb8, 0x15, 0x13, 0xc2, 0x4f, 0x01, 0x0b, 0xad, 0x23, 0xf3, 0x56, 0x0b, 0xef, 0xdf, 0xec,
-	0xc4, 0xf0, 0xfa, 0x9b, 0x74, 0x23, 0xdb, 0x74, 0x4a, 0x37, 0x28, 0x4a, 0x70, 0xdf, 0xda, 0xc0,
-	0x3d, 0x9f, 0x2c, 0xdc, 0x4f, 0x08, 0x3e, 0x4d, 0x05, 0xb7, 0x02, 0x66, 0x39, 0x43, 0x76, 0x47,
-	0x92, 0xec, 0xb2, 0x1d, 0x66, 0x3e, 0x69, 0x29, 0xeb, 0xa9, 0xfe, 0x87, 0x7b, 0x5e, 0xff, 0x15,
-	0xc1, 0xc3, 0x8c, 0xd6, 0xd2, 0xec, 0xf7, 0xf6, 0x19, 0x80, 0x98, 0x5a, 0x13, 0x12, 0x4c, 0x6e,
-	0xf8, 0x49, 0xdc, 0xf3, 0x2d, 0x09, 0x26, 0xff, 0x9f, 0xe3, 0xfa, 0xab, 0x2b, 0xde, 0x7a, 0x75,
-	0xcf, 0xe1, 0x91, 0x20, 0x2b, 0xf0, 0x6d, 0xc2, 0x88, 0xb8, 0x7f, 0xa3, 0x64, 0x50, 0xb4, 0x16,
-	0xb4, 0xfe, 0x02, 0x3e, 0x59, 0x57, 0x28, 0xc6, 0x70, 0x78, 0x70, 0xd3, 0x34, 0x96, 0xd2, 0xd3,
-	0xf8, 0xcb, 0x8f, 0x5b, 0xb0, 0xbf, 0x36, 0x27, 0xb1, 0x06, 0xca, 0xb1, 0x61, 0xe8, 0xdd, 0xd6,
-	0xc0, 0xe8, 0x98, 0xc6, 0xeb, 0x5e, 0xc7, 0x1c, 0x9c, 0xf6, 0x7b, 0x9d, 0x6f, 0xba, 0x27, 0xdd,
-	0x4e, 0x5b, 0xce, 0x29, 0xf7, 0x16, 0x4b, 0x75, 0x77, 0xe0, 0x04, 0x1e, 0x1d, 0x5a, 0x6f, 0x2d,
-	0x3a, 0xc2, 0x8f, 0xe1, 0x41, 0xfa, 0xc0, 0xa0, 0xdb, 0x96, 0x91, 0xb2, 0xb3, 0x58, 0xaa, 0x85,
-	0xd5, 0x3a, 0x03, 0xf2, 0x5d, 0xff, 0xec, 0x54, 0xde, 0x12, 0x90, 0xd5, 0x1a, 0x1f, 0xc2, 0xc3,
-	0x14, 0xa4, 0x6f, 0xe8, 0xdd, 0xd3, 0x17, 0x72, 0x5e, 0x81, 0xc5, 0x52, 0x2d, 0xf5, 0x99, 0x6f,
-	0x39, 0x63, 0x5c, 0x03, 0x9c, 0x4e, 0xa6, 0x77, 0xe5, 0x82, 0xb2, 0xbd, 0x58, 0xaa, 0xf9, 0x81,
-	0x6f, 0x65, 0x00, 0xba, 0xa7, 0x86, 0x5c, 0x14, 0x80, 0xae, 0xc3, 0xf0, 0x13, 0x38, 0x48, 0x01,
-	0x4e, 0x5e, 0x9e, 0x1d, 0x1b, 0x72, 0x49, 0x91, 0x16, 0x4b, 0xb5, 0x78, 0x32, 0x75, 0x49, 0x16,
-	0xa8, 0xa7, 0x9f, 0x19, 0x67, 0xf2, 0xb6, 0x00, 0xf5, 0xf8, 0x6f, 0x7a, 0x1b, 0xd4, 0x7a, 0x6d,
-	0x74, 0xfa, 0xf2, 0x8e, 0x00, 0xb5, 0x2e, 0x19, 0x0d, 0x5a, 0xf6, 0xfb, 0xab, 0x2a, 0xfa, 0x70,
-	0x55, 0x45, 0x7f, 0x5e, 0x55, 0xd1, 0xbb, 0xeb, 0x6a, 0xee, 0xc3, 0x75, 0x35, 0xf7, 0xdb, 0x75,
-	0x35, 0x07, 0x8a, 0xe5, 0x6e, 0xfa, 0xba, 0x7a, 0xe8, 0xcd, 0x57, 0x63, 0x8b, 0x4d, 0x66, 0xe7,
-	0xcd, 0xa1, 0x6b, 0x6b, 0x31, 0xea, 0xa9, 0xe5, 0x26, 0x2c, 0xed, 0x22, 0xf1, 0xdd, 0xaf, 0xee,
-	0x66, 0x70, 0x5e, 0xe2, 0x7f, 0xd3, 0xf3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x36, 0x7d, 0x76,
-	0x18, 0x13, 0x08, 0x00, 0x00,
+// EventAttributeDistinctDelete event emitted when attribute is deleted with matching value
+type EventAttributeDistinctDelete struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
+	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Owner         string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDistinctDelete{} }
+func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeDistinctDelete) ProtoMessage()    {}
+func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{13}
+}
+func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeDistinctDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeDistinctDelete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventAttributeDistinctDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeDistinctDelete.Merge(m, src)
+}
+func (m *EventAttributeDistinctDelete) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeDistinctDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeDistinctDelete.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventAttributeDistinctDelete proto.InternalMessageInfo
+
+func (m *EventAttributeDistinctDelete) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxValueLength != 0 {
-		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
-		i--
-		dAtA[i] = 0x8
+func (m *EventAttributeDistinctDelete) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Attribute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeDistinctDelete) GetAttributeType() string {
+	if m != nil {
+		return m.AttributeType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAttributeDistinctDelete) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
-		if err1 != nil {
-			return 0, err1
+func (m *EventAttributeDistinctDelete) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventAttributeExpired event emitted when attribute has expired and been deleted in BeginBlocker
+type EventAttributeExpired struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ValueHash     string `protobuf:"bytes,2,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
+	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	Expiration    string `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *EventAttributeExpired) Reset()         { *m = EventAttributeExpired{} }
+func (m *EventAttributeExpired) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeExpired) ProtoMessage()    {}
+func (*EventAttributeExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{14}
+}
+func (m *EventAttributeExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintAttribute(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventAttributeExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeExpired.Merge(m, src)
+}
+func (m *EventAttributeExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeExpired proto.InternalMessageInfo
+
+func (m *EventAttributeExpired) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.AttributeType != 0 {
-		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventAttributeExpired) GetValueHash() string {
+	if m != nil {
+		return m.ValueHash
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventAttributeExpired) GetAttributeType() string {
+	if m != nil {
+		return m.AttributeType
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAttributeExpired) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeExpired) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAccountDataUpdated event emitted when accountdata is set, updated, or deleted.
+type EventAccountDataUpdated struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Expiration) > 0 {
-		i -= len(m.Expiration)
-		copy(dAtA[i:], m.Expiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
-		i--
-		dAtA[i] = 0x32
+func (m *EventAccountDataUpdated) Reset()         { *m = EventAccountDataUpdated{} }
+func (m *EventAccountDataUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAccountDataUpdated) ProtoMessage()    {}
+func (*EventAccountDataUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{15}
+}
+func (m *EventAccountDataUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAccountDataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAccountDataUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAccountDataUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAccountDataUpdated.Merge(m, src)
+}
+func (m *EventAccountDataUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAccountDataUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAccountDataUpdated.DiscardUnknown(m)
 }
 
-func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventAccountDataUpdated proto.InternalMessageInfo
+
+func (m *EventAccountDataUpdated) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttributeParamsUpdated event emitted when attribute params are updated.
+type EventAttributeParamsUpdated struct {
+	MaxValueLength string `protobuf:"bytes,1,opt,name=max_value_length,json=maxValueLength,proto3" json:"max_value_length,omitempty"`
+	// the medium size class min length
+	MediumMinLength string `protobuf:"bytes,2,opt,name=medium_min_length,json=mediumMinLength,proto3" json:"medium_min_length,omitempty"`
+	// the large size class min length
+	LargeMinLength string `protobuf:"bytes,3,opt,name=large_min_length,json=largeMinLength,proto3" json:"large_min_length,omitempty"`
+	// the surcharge for writing a small value
+	SmallFee string `protobuf:"bytes,4,opt,name=small_fee,json=smallFee,proto3" json:"small_fee,omitempty"`
+	// the surcharge for writing a medium value
+	MediumFee string `protobuf:"bytes,5,opt,name=medium_fee,json=mediumFee,proto3" json:"medium_fee,omitempty"`
+	// the surcharge for writing a large value
+	LargeFee string `protobuf:"bytes,6,opt,name=large_fee,json=largeFee,proto3" json:"large_fee,omitempty"`
 }
 
-func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x32
+func (m *EventAttributeParamsUpdated) Reset()         { *m = EventAttributeParamsUpdated{} }
+func (m *EventAttributeParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeParamsUpdated) ProtoMessage()    {}
+func (*EventAttributeParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{16}
+}
+func (m *EventAttributeParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.UpdateType) > 0 {
-		i -= len(m.UpdateType)
-		copy(dAtA[i:], m.UpdateType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateType)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EventAttributeParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeParamsUpdated.Merge(m, src)
+}
+func (m *EventAttributeParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeParamsUpdated proto.InternalMessageInfo
+
+func (m *EventAttributeParamsUpdated) GetMaxValueLength() string {
+	if m != nil {
+		return m.MaxValueLength
 	}
-	if len(m.UpdateValue) > 0 {
-		i -= len(m.UpdateValue)
-		copy(dAtA[i:], m.UpdateValue)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateValue)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetMediumMinLength() string {
+	if m != nil {
+		return m.MediumMinLength
 	}
-	if len(m.OriginalType) > 0 {
-		i -= len(m.OriginalType)
-		copy(dAtA[i:], m.OriginalType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalType)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetLargeMinLength() string {
+	if m != nil {
+		return m.LargeMinLength
 	}
-	if len(m.OriginalValue) > 0 {
-		i -= len(m.OriginalValue)
-		copy(dAtA[i:], m.OriginalValue)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalValue)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetSmallFee() string {
+	if m != nil {
+		return m.SmallFee
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAttributeParamsUpdated) GetMediumFee() string {
+	if m != nil {
+		return m.MediumFee
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventAttributeExpirationUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeParamsUpdated) GetLargeFee() string {
+	if m != nil {
+		return m.LargeFee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeExpirationUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttributeValueSizeFee event emitted when a size class surcharge is charged for writing an attribute value.
+type EventAttributeValueSizeFee struct {
+	// the attribute name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the account the attribute is on
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// the size class of the value, i.e. small, medium, or large
+	SizeClass string `protobuf:"bytes,3,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	// the length (in bytes) of the value
+	ValueLength string `protobuf:"bytes,4,opt,name=value_length,json=valueLength,proto3" json:"value_length,omitempty"`
+	// the surcharge charged
+	Fee string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *EventAttributeExpirationUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedExpiration) > 0 {
-		i -= len(m.UpdatedExpiration)
-		copy(dAtA[i:], m.UpdatedExpiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdatedExpiration)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OriginalExpiration) > 0 {
-		i -= len(m.OriginalExpiration)
-		copy(dAtA[i:], m.OriginalExpiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalExpiration)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventAttributeValueSizeFee) Reset()         { *m = EventAttributeValueSizeFee{} }
+func (m *EventAttributeValueSizeFee) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeValueSizeFee) ProtoMessage()    {}
+func (*EventAttributeValueSizeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{17}
+}
+func (m *EventAttributeValueSizeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeValueSizeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeValueSizeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventAttributeValueSizeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeValueSizeFee.Merge(m, src)
+}
+func (m *EventAttributeValueSizeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeValueSizeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeValueSizeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeValueSizeFee proto.InternalMessageInfo
+
+func (m *EventAttributeValueSizeFee) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventAttributeDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeValueSizeFee) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAttributeValueSizeFee) GetSizeClass() string {
+	if m != nil {
+		return m.SizeClass
+	}
+	return ""
 }
 
-func (m *EventAttributeDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventAttributeValueSizeFee) GetValueLength() string {
+	if m != nil {
+		return m.ValueLength
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventAttributeDistinctDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeValueSizeFee) GetFee() string {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeDistinctDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttributeMirrorUpdated event emitted when a smart contract's attribute mirror is enabled or disabled.
+type EventAttributeMirrorUpdated struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Enabled  bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Admin    string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
-func (m *EventAttributeDistinctDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AttributeType) > 0 {
-		i -= len(m.AttributeType)
-		copy(dAtA[i:], m.AttributeType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventAttributeMirrorUpdated) Reset()         { *m = EventAttributeMirrorUpdated{} }
+func (m *EventAttributeMirrorUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeMirrorUpdated) ProtoMessage()    {}
+func (*EventAttributeMirrorUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{18}
+}
+func (m *EventAttributeMirrorUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeMirrorUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeMirrorUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventAttributeMirrorUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeMirrorUpdated.Merge(m, src)
+}
+func (m *EventAttributeMirrorUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeMirrorUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeMirrorUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeMirrorUpdated proto.InternalMessageInfo
+
+func (m *EventAttributeMirrorUpdated) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAttributeMirrorUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventAttributeExpired) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeMirrorUpdated) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeExpired) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttributeRefreshOracleUpdated event emitted when a refresh oracle for an attribute name is added or removed.
+type EventAttributeRefreshOracleUpdated struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Oracle  string `protobuf:"bytes,2,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Owner   string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventAttributeExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Expiration) > 0 {
-		i -= len(m.Expiration)
-		copy(dAtA[i:], m.Expiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventAttributeRefreshOracleUpdated) Reset()         { *m = EventAttributeRefreshOracleUpdated{} }
+func (m *EventAttributeRefreshOracleUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeRefreshOracleUpdated) ProtoMessage()    {}
+func (*EventAttributeRefreshOracleUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{19}
+}
+func (m *EventAttributeRefreshOracleUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeRefreshOracleUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeRefreshOracleUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AttributeType) > 0 {
-		i -= len(m.AttributeType)
-		copy(dAtA[i:], m.AttributeType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventAttributeRefreshOracleUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeRefreshOracleUpdated.Merge(m, src)
+}
+func (m *EventAttributeRefreshOracleUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeRefreshOracleUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeRefreshOracleUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeRefreshOracleUpdated proto.InternalMessageInfo
+
+func (m *EventAttributeRefreshOracleUpdated) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.ValueHash) > 0 {
-		i -= len(m.ValueHash)
-		copy(dAtA[i:], m.ValueHash)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ValueHash)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventAttributeRefreshOracleUpdated) GetOracle() string {
+	if m != nil {
+		return m.Oracle
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventAttributeRefreshOracleUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *EventAccountDataUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeRefreshOracleUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAccountDataUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttributeAttestationSourceUpdated event emitted when an attestation source for an attribute name is added or
+// removed.
+type EventAttributeAttestationSourceUpdated struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Issuer    string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Enabled   bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Owner     string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *EventAccountDataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventAttributeAttestationSourceUpdated) Reset() {
+	*m = EventAttributeAttestationSourceUpdated{}
+}
+func (m *EventAttributeAttestationSourceUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeAttestationSourceUpdated) ProtoMessage()    {}
+func (*EventAttributeAttestationSourceUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{20}
+}
+func (m *EventAttributeAttestationSourceUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeAttestationSourceUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeAttestationSourceUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAttributeAttestationSourceUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeAttestationSourceUpdated.Merge(m, src)
+}
+func (m *EventAttributeAttestationSourceUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeAttestationSourceUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeAttestationSourceUpdated.DiscardUnknown(m)
 }
 
-func (m *EventAttributeParamsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventAttributeAttestationSourceUpdated proto.InternalMessageInfo
+
+func (m *EventAttributeAttestationSourceUpdated) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventAttributeParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventAttributeAttestationSourceUpdated) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (m *EventAttributeParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MaxValueLength) > 0 {
-		i -= len(m.MaxValueLength)
-		copy(dAtA[i:], m.MaxValueLength)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxValueLength)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventAttributeAttestationSourceUpdated) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAttribute(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventAttributeAttestationSourceUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxValueLength != 0 {
-		n += 1 + sovAttribute(uint64(m.MaxValueLength))
+
+func (m *EventAttributeAttestationSourceUpdated) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return ""
 }
 
-func (m *Attribute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovAttribute(uint64(m.AttributeType))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+// EventAttributeAttestationImported event emitted when an attribute is imported from an attestation on another chain.
+type EventAttributeAttestationImported struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Account   string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Issuer    string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Sequence  string `protobuf:"bytes,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *EventAttributeAttestationImported) Reset()         { *m = EventAttributeAttestationImported{} }
+func (m *EventAttributeAttestationImported) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeAttestationImported) ProtoMessage()    {}
+func (*EventAttributeAttestationImported) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{21}
+}
+func (m *EventAttributeAttestationImported) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeAttestationImported) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeAttestationImported.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExpirationDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
-		n += 1 + l + sovAttribute(uint64(l))
+}
+func (m *EventAttributeAttestationImported) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeAttestationImported.Merge(m, src)
+}
+func (m *EventAttributeAttestationImported) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeAttestationImported) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeAttestationImported.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeAttestationImported proto.InternalMessageInfo
+
+func (m *EventAttributeAttestationImported) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return n
+	return ""
 }
 
-func (m *EventAttributeAdd) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventAttributeAttestationImported) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return ""
+}
+
+func (m *EventAttributeAttestationImported) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return ""
+}
+
+func (m *EventAttributeAttestationImported) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return ""
+}
+
+func (m *EventAttributeAttestationImported) GetSequence() string {
+	if m != nil {
+		return m.Sequence
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return ""
+}
+
+// EventAttributeDependentsWarning event emitted when an attribute name that other things depend on is about to be removed.
+type EventAttributeDependentsWarning struct {
+	// the attribute name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the things that require the attribute
+	Dependents []AttributeDependent `protobuf:"bytes,2,rep,name=dependents,proto3" json:"dependents"`
+}
+
+func (m *EventAttributeDependentsWarning) Reset()         { *m = EventAttributeDependentsWarning{} }
+func (m *EventAttributeDependentsWarning) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeDependentsWarning) ProtoMessage()    {}
+func (*EventAttributeDependentsWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{22}
+}
+func (m *EventAttributeDependentsWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeDependentsWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeDependentsWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+}
+func (m *EventAttributeDependentsWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeDependentsWarning.Merge(m, src)
+}
+func (m *EventAttributeDependentsWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeDependentsWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeDependentsWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeDependentsWarning proto.InternalMessageInfo
+
+func (m *EventAttributeDependentsWarning) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	l = len(m.Expiration)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	return ""
+}
+
+func (m *EventAttributeDependentsWarning) GetDependents() []AttributeDependent {
+	if m != nil {
+		return m.Dependents
 	}
-	return n
+	return nil
 }
 
-func (m *EventAttributeUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
+	proto.RegisterType((*ValueSizeFees)(nil), "provenance.attribute.v1.ValueSizeFees")
+	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
+	proto.RegisterType((*AttestationOrigin)(nil), "provenance.attribute.v1.AttestationOrigin")
+	proto.RegisterType((*AttestationSource)(nil), "provenance.attribute.v1.AttestationSource")
+	proto.RegisterType((*AttributeAttestation)(nil), "provenance.attribute.v1.AttributeAttestation")
+	proto.RegisterType((*AttributeAttestationPacketData)(nil), "provenance.attribute.v1.AttributeAttestationPacketData")
+	proto.RegisterType((*RefreshOracle)(nil), "provenance.attribute.v1.RefreshOracle")
+	proto.RegisterType((*AttributeDependent)(nil), "provenance.attribute.v1.AttributeDependent")
+	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
+	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
+	proto.RegisterType((*EventAttributeExpirationUpdate)(nil), "provenance.attribute.v1.EventAttributeExpirationUpdate")
+	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
+	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
+	proto.RegisterType((*EventAttributeExpired)(nil), "provenance.attribute.v1.EventAttributeExpired")
+	proto.RegisterType((*EventAccountDataUpdated)(nil), "provenance.attribute.v1.EventAccountDataUpdated")
+	proto.RegisterType((*EventAttributeParamsUpdated)(nil), "provenance.attribute.v1.EventAttributeParamsUpdated")
+	proto.RegisterType((*EventAttributeValueSizeFee)(nil), "provenance.attribute.v1.EventAttributeValueSizeFee")
+	proto.RegisterType((*EventAttributeMirrorUpdated)(nil), "provenance.attribute.v1.EventAttributeMirrorUpdated")
+	proto.RegisterType((*EventAttributeRefreshOracleUpdated)(nil), "provenance.attribute.v1.EventAttributeRefreshOracleUpdated")
+	proto.RegisterType((*EventAttributeAttestationSourceUpdated)(nil), "provenance.attribute.v1.EventAttributeAttestationSourceUpdated")
+	proto.RegisterType((*EventAttributeAttestationImported)(nil), "provenance.attribute.v1.EventAttributeAttestationImported")
+	proto.RegisterType((*EventAttributeDependentsWarning)(nil), "provenance.attribute.v1.EventAttributeDependentsWarning")
+}
+
+func init() {
+	proto.RegisterFile("provenance/attribute/v1/attribute.proto", fileDescriptor_14fe7eb43c711f5e)
+}
+
+var fileDescriptor_14fe7eb43c711f5e = []byte{
+	// 1584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0x59, 0x36, 0x9f, 0xbf, 0xe4, 0x89, 0x93, 0x78, 0x95, 0x8d, 0xa4, 0x30, 0x48,
+	0xd6, 0xf0, 0xc2, 0x12, 0x92, 0x60, 0x2f, 0xbb, 0x27, 0x2b, 0x96, 0x37, 0x5a, 0xc4, 0x1f, 0x4b,
+	0xc9, 0x1b, 0x24, 0x87, 0x15, 0xc6, 0xe4, 0x58, 0x1e, 0x84, 0x1f, 0x0a, 0x49, 0x79, 0xed, 0x1c,
+	0x82, 0xec, 0xa1, 0x40, 0xe0, 0x53, 0x7a, 0x2b, 0x0a, 0xb8, 0x1f, 0x68, 0x0f, 0x45, 0x7b, 0xc9,
+	0xad, 0xff, 0x42, 0x8e, 0x39, 0x16, 0x3d, 0x24, 0x45, 0x72, 0x48, 0xd1, 0x3f, 0xa0, 0xe8, 0xb1,
+	0x98, 0x19, 0x92, 0x22, 0x65, 0xd2, 0x69, 0x9a, 0x22, 0x17, 0x7b, 0xde, 0x9b, 0x37, 0xf3, 0x7e,
+	0xef, 0xbd, 0xdf, 0xcc, 0x3c, 0x0a, 0xfe, 0xd2, 0x73, 0xec, 0x3d, 0x62, 0x61, 0x4b, 0x23, 0x35,
+	0xec, 0x79, 0x0e, 0xdd, 0xee, 0x7b, 0xa4, 0xb6, 0x77, 0x65, 0x20, 0x54, 0x7b, 0x8e, 0xed, 0xd9,
+	0xe8, 0xec, 0xc0, 0xb0, 0x3a, 0x98, 0xdb, 0xbb, 0x52, 0x9c, 0xc5, 0x26, 0xb5, 0xec, 0x1a, 0xff,
+	0x2b, 0x6c, 0x8b, 0x25, 0xcd, 0x76, 0x4d, 0xdb, 0xad, 0x6d, 0x63, 0x97, 0xed, 0xb5, 0x4d, 0x3c,
+	0x7c, 0xa5, 0xa6, 0xd9, 0xd4, 0xf2, 0xe7, 0xe7, 0xba, 0x76, 0xd7, 0xe6, 0xc3, 0x1a, 0x1b, 0xf9,
+	0xda, 0x72, 0xd7, 0xb6, 0xbb, 0x06, 0xa9, 0x71, 0x69, 0xbb, 0xbf, 0x53, 0xf3, 0xa8, 0x49, 0x5c,
+	0x0f, 0x9b, 0x3d, 0x61, 0xa0, 0x3c, 0x92, 0x20, 0xbf, 0x89, 0x1d, 0x6c, 0xba, 0x68, 0x01, 0x0a,
+	0x26, 0xde, 0xef, 0xec, 0x61, 0xa3, 0x4f, 0x3a, 0x06, 0xb1, 0xba, 0xde, 0xee, 0xbc, 0x54, 0x91,
+	0x16, 0xa6, 0xd4, 0x69, 0x13, 0xef, 0xff, 0x87, 0xa9, 0x6f, 0x72, 0x2d, 0x6a, 0xc3, 0x8c, 0xb0,
+	0x72, 0xe9, 0x7d, 0xd2, 0xd9, 0x21, 0xc4, 0x9d, 0xcf, 0x54, 0xa4, 0x85, 0x89, 0xab, 0x97, 0xab,
+	0x29, 0x11, 0x55, 0xf9, 0xf2, 0x16, 0xbd, 0x4f, 0x56, 0x09, 0x71, 0xeb, 0xb9, 0xa7, 0xcf, 0xcb,
+	0x23, 0xea, 0xd4, 0x5e, 0x54, 0xa9, 0xfc, 0x98, 0x85, 0xa9, 0x98, 0x19, 0x5a, 0x84, 0x59, 0x93,
+	0xe8, 0xb4, 0x6f, 0x76, 0x4c, 0x6a, 0xc5, 0x21, 0xcd, 0x88, 0x89, 0x35, 0x6a, 0xf9, 0x98, 0x16,
+	0xa0, 0x60, 0x60, 0xa7, 0x4b, 0xa2, 0xa6, 0x19, 0x81, 0x9e, 0xeb, 0x07, 0x96, 0x0f, 0x40, 0x76,
+	0x4d, 0x6c, 0x18, 0x0c, 0xf8, 0x7c, 0xb6, 0x92, 0x5d, 0x98, 0xb8, 0xfa, 0xa7, 0xaa, 0xc8, 0x6e,
+	0x95, 0x65, 0xb7, 0xea, 0x67, 0xb7, 0x7a, 0xdd, 0xa6, 0x56, 0x7d, 0x95, 0x41, 0xfd, 0xfa, 0x45,
+	0x79, 0xa1, 0x4b, 0xbd, 0xdd, 0xfe, 0x76, 0x55, 0xb3, 0xcd, 0x9a, 0x5f, 0x0a, 0xf1, 0x6f, 0xc9,
+	0xd5, 0xef, 0xd6, 0xbc, 0x83, 0x1e, 0x71, 0xf9, 0x02, 0xf7, 0xe3, 0xd7, 0x4f, 0x16, 0x27, 0x0d,
+	0xd2, 0xc5, 0xda, 0x41, 0x87, 0xd5, 0xc7, 0xfd, 0xea, 0xf5, 0x93, 0x45, 0x49, 0x1d, 0xe7, 0x3e,
+	0x57, 0x09, 0x41, 0x0f, 0x25, 0x00, 0x3f, 0x2c, 0x86, 0x20, 0xf7, 0xbe, 0x10, 0xc8, 0xc2, 0x29,
+	0x83, 0xf0, 0x00, 0x64, 0x91, 0x2c, 0x06, 0x60, 0xf4, 0xbd, 0xa5, 0x80, 0xfb, 0x5c, 0x25, 0x44,
+	0xf9, 0x36, 0x03, 0xf2, 0x72, 0x40, 0x0f, 0x84, 0x20, 0x67, 0x61, 0x93, 0xf0, 0xca, 0xca, 0x2a,
+	0x1f, 0xa3, 0x39, 0x18, 0xe5, 0xec, 0xe0, 0x35, 0x9c, 0x54, 0x85, 0x80, 0xd6, 0x60, 0x3a, 0x64,
+	0x55, 0x87, 0x39, 0x9c, 0xcf, 0x56, 0xa4, 0x85, 0xe9, 0x13, 0x78, 0x17, 0x7a, 0x69, 0x1f, 0xf4,
+	0x88, 0x3a, 0x85, 0xa3, 0x22, 0x9a, 0x87, 0x31, 0xac, 0xeb, 0x0e, 0x71, 0xdd, 0xf9, 0x1c, 0xf7,
+	0x1d, 0x88, 0x68, 0x0d, 0x66, 0xc8, 0x7e, 0x8f, 0x3a, 0xd8, 0xa3, 0xb6, 0xd5, 0xd1, 0xb1, 0xc7,
+	0xd2, 0xc4, 0x18, 0x5e, 0xac, 0x8a, 0x13, 0x55, 0x0d, 0x4e, 0x54, 0xb5, 0x1d, 0x9c, 0xa8, 0xfa,
+	0xf8, 0xd3, 0xe7, 0x65, 0xe9, 0xf1, 0x8b, 0xb2, 0xa4, 0x4e, 0x0f, 0x16, 0xaf, 0x60, 0x8f, 0xa0,
+	0x3a, 0xe4, 0x6d, 0x87, 0x76, 0xa9, 0x35, 0x9f, 0xe7, 0xbb, 0x2c, 0x9e, 0x84, 0x97, 0xed, 0xc6,
+	0x56, 0x6e, 0xf0, 0x15, 0xaa, 0xbf, 0xf2, 0xef, 0xb9, 0x8f, 0x3e, 0x2b, 0x8f, 0x28, 0x3b, 0x30,
+	0x7b, 0xcc, 0x04, 0x9d, 0x07, 0xd0, 0x76, 0xb1, 0x65, 0x11, 0xa3, 0x43, 0x75, 0x3f, 0x8d, 0xb2,
+	0xaf, 0x69, 0xea, 0xe8, 0x0c, 0xe4, 0xa9, 0xeb, 0xf6, 0x89, 0xc3, 0x93, 0x29, 0xab, 0xbe, 0x84,
+	0x8a, 0x30, 0xee, 0x92, 0x7b, 0x7d, 0x62, 0x69, 0x22, 0x8f, 0x39, 0x35, 0x94, 0x95, 0xff, 0xc6,
+	0xfc, 0xb4, 0xec, 0xbe, 0xa3, 0x25, 0x17, 0x2a, 0xee, 0x3b, 0x93, 0xee, 0x3b, 0x1b, 0xf5, 0xad,
+	0xfc, 0x22, 0xc1, 0x5c, 0x58, 0x9b, 0x88, 0x27, 0x5e, 0x13, 0x4d, 0xb3, 0xfb, 0x96, 0xe7, 0xbb,
+	0x09, 0xc4, 0xd0, 0x7b, 0x26, 0x89, 0x26, 0xd9, 0x93, 0x69, 0x92, 0x7b, 0x17, 0x9a, 0xfc, 0xb1,
+	0x64, 0x50, 0x3e, 0x94, 0xa0, 0x94, 0x14, 0xfa, 0x26, 0xd6, 0xee, 0x12, 0x6f, 0x05, 0x7b, 0x38,
+	0x92, 0x35, 0x29, 0x56, 0xb1, 0x5b, 0x30, 0x89, 0x07, 0x0b, 0xd8, 0xad, 0xcb, 0x8e, 0xee, 0xd2,
+	0x9b, 0xc3, 0x8a, 0xb8, 0xf1, 0x2f, 0xdf, 0xd8, 0x46, 0xca, 0x3f, 0x60, 0x4a, 0x25, 0x3b, 0x0e,
+	0x71, 0x77, 0x37, 0x1c, 0xac, 0x19, 0xc9, 0xa5, 0x3e, 0xc3, 0x58, 0xcc, 0x66, 0x03, 0x1e, 0x09,
+	0x49, 0xf9, 0xbf, 0x04, 0x28, 0xf4, 0xb4, 0x42, 0x7a, 0xc4, 0xd2, 0x89, 0xe5, 0x31, 0x73, 0xd3,
+	0xd6, 0xfb, 0x46, 0xb0, 0x89, 0x2f, 0xb1, 0xad, 0xef, 0x52, 0x2b, 0xe0, 0x0a, 0x1f, 0xa3, 0x69,
+	0xc8, 0x50, 0xdd, 0xa7, 0x48, 0x86, 0xea, 0xa8, 0x06, 0xa7, 0x1c, 0x72, 0xaf, 0x4f, 0x1d, 0xa2,
+	0x77, 0xc2, 0x88, 0x5c, 0x7e, 0x57, 0xca, 0x2a, 0x0a, 0xa6, 0x42, 0xa7, 0xae, 0xf2, 0xb9, 0x04,
+	0xb3, 0x8d, 0x3d, 0x62, 0x79, 0x83, 0x90, 0x75, 0xfd, 0xcd, 0x37, 0x8b, 0x1c, 0x50, 0x06, 0x41,
+	0x2e, 0xbc, 0x4f, 0x64, 0x95, 0x8f, 0xa3, 0x54, 0xcc, 0xc5, 0xa9, 0x38, 0x07, 0xa3, 0xf6, 0xff,
+	0x2c, 0xe2, 0x70, 0x1e, 0xc8, 0xaa, 0x10, 0x50, 0x09, 0x60, 0x50, 0x6a, 0x7e, 0xd2, 0x65, 0x35,
+	0xa2, 0x51, 0x7e, 0x92, 0x60, 0x2e, 0x8e, 0x71, 0xab, 0xc7, 0xd8, 0x94, 0x08, 0xf3, 0x12, 0x4c,
+	0x8b, 0x83, 0x8f, 0x8d, 0x4e, 0x14, 0xef, 0x54, 0xa0, 0xe5, 0x4f, 0x25, 0xba, 0x08, 0xa1, 0xa2,
+	0x13, 0x09, 0x60, 0x32, 0x50, 0x72, 0x02, 0x5f, 0x80, 0xc9, 0x3e, 0xf7, 0xe4, 0xef, 0x24, 0xa2,
+	0x99, 0x10, 0x3a, 0xb1, 0x4f, 0x19, 0x7c, 0x51, 0xec, 0x22, 0xe2, 0x02, 0xa1, 0x6a, 0x0f, 0x25,
+	0x23, 0x9f, 0x92, 0x8c, 0xb1, 0x48, 0x32, 0x94, 0xef, 0x25, 0x28, 0xc5, 0x83, 0x6d, 0x84, 0x99,
+	0x38, 0x21, 0xec, 0xe4, 0xea, 0x44, 0x9c, 0x67, 0x53, 0x9c, 0xe7, 0xa2, 0x95, 0xa8, 0xc1, 0xa9,
+	0x30, 0x2b, 0x91, 0x92, 0x88, 0xa8, 0x50, 0x30, 0x35, 0x00, 0x84, 0x96, 0x00, 0x89, 0x58, 0xf5,
+	0xce, 0xb1, 0x12, 0xce, 0xfa, 0x33, 0x03, 0x73, 0xe5, 0xce, 0x70, 0x21, 0x57, 0x88, 0x41, 0x52,
+	0x22, 0x8a, 0x60, 0xcf, 0xa4, 0x60, 0xcf, 0x46, 0x13, 0xf7, 0xa9, 0x04, 0x7f, 0x1e, 0xda, 0x9c,
+	0xba, 0x1e, 0xb5, 0x34, 0xef, 0x04, 0x27, 0xc9, 0x69, 0xbb, 0x94, 0xf8, 0x5c, 0xca, 0x49, 0xcf,
+	0xe0, 0x5b, 0xf0, 0x5c, 0xf9, 0x46, 0x82, 0xd3, 0x09, 0xa5, 0x25, 0x7a, 0xda, 0x03, 0x21, 0x9a,
+	0xc5, 0x5d, 0xec, 0xee, 0x06, 0x0f, 0x04, 0xd7, 0xdc, 0xc0, 0xee, 0xee, 0xbb, 0x63, 0x8c, 0x9f,
+	0xba, 0xd1, 0x63, 0xa7, 0xee, 0x1a, 0x9c, 0x15, 0x60, 0x85, 0x3d, 0xbb, 0x5f, 0x05, 0xff, 0xf4,
+	0xf4, 0xb7, 0x46, 0xf9, 0x59, 0x82, 0x73, 0xf1, 0x10, 0x45, 0x93, 0x1c, 0xac, 0x4c, 0xeb, 0x95,
+	0xe5, 0x63, 0xbd, 0x72, 0x62, 0x0f, 0x2b, 0xb2, 0xf0, 0x9b, 0x7a, 0x58, 0x91, 0x8d, 0xe1, 0x1e,
+	0xf6, 0x5c, 0xb4, 0x87, 0x15, 0x09, 0x19, 0x34, 0x98, 0xe7, 0x63, 0xfd, 0xa5, 0xc8, 0x48, 0xa4,
+	0xf9, 0x3b, 0x17, 0x6d, 0xfe, 0x04, 0xc5, 0x07, 0x9d, 0xd9, 0x27, 0x12, 0x14, 0xe3, 0x81, 0x47,
+	0x5b, 0xf2, 0xb7, 0x24, 0xf8, 0x79, 0x00, 0xfe, 0x85, 0xa0, 0x19, 0xd8, 0x75, 0xfd, 0x48, 0x64,
+	0xa6, 0xb9, 0xce, 0x14, 0xec, 0x5a, 0x8a, 0x25, 0xd0, 0xbf, 0x96, 0xf6, 0x22, 0xd9, 0x2b, 0x40,
+	0x76, 0x10, 0x03, 0x1b, 0x2a, 0x74, 0xb8, 0x30, 0x6b, 0xd4, 0x71, 0x6c, 0x27, 0x28, 0x4c, 0x11,
+	0xc6, 0x35, 0xdb, 0xf2, 0x1c, 0xac, 0x05, 0x35, 0x0d, 0x65, 0x06, 0x94, 0x58, 0x78, 0xdb, 0x20,
+	0xe2, 0xed, 0x19, 0x57, 0x03, 0x91, 0xf1, 0x1c, 0xeb, 0x26, 0xb5, 0x82, 0x93, 0xc8, 0x05, 0xe5,
+	0xa1, 0x04, 0x4a, 0xdc, 0x57, 0xec, 0x8d, 0x0c, 0x5c, 0xbe, 0xc5, 0x53, 0x19, 0x85, 0x90, 0x3d,
+	0x06, 0xe1, 0xf8, 0x45, 0xa6, 0x7c, 0x21, 0xc1, 0xe5, 0xa1, 0x67, 0x6d, 0xb8, 0x2b, 0x3b, 0x09,
+	0xc6, 0xef, 0x6b, 0xce, 0xa2, 0x28, 0x73, 0x29, 0x28, 0x63, 0x17, 0xc2, 0x97, 0x12, 0x5c, 0x48,
+	0x45, 0xd9, 0x34, 0x7b, 0xb6, 0x93, 0x06, 0xf0, 0x44, 0xee, 0x44, 0xa0, 0x67, 0xd3, 0xa1, 0xe7,
+	0x52, 0x7b, 0xda, 0x51, 0xff, 0x5c, 0x04, 0x3d, 0xed, 0x23, 0x09, 0xca, 0xc3, 0xd7, 0xb6, 0xdf,
+	0xac, 0xb8, 0xb7, 0xb0, 0x63, 0x51, 0xab, 0x9b, 0x08, 0xf2, 0xdf, 0x00, 0x7a, 0x68, 0xe8, 0xf7,
+	0x5c, 0x7f, 0x7d, 0x73, 0xcf, 0x15, 0x6e, 0xee, 0x77, 0x5c, 0x91, 0x4d, 0x16, 0x3f, 0xc8, 0xc2,
+	0x54, 0xac, 0xe7, 0x44, 0x35, 0x28, 0x2e, 0xb7, 0xdb, 0x6a, 0xb3, 0xbe, 0xd5, 0x6e, 0x74, 0xda,
+	0xb7, 0x37, 0x1b, 0x9d, 0xad, 0xf5, 0xd6, 0x66, 0xe3, 0x7a, 0x73, 0xb5, 0xd9, 0x58, 0x29, 0x8c,
+	0x14, 0x67, 0x0e, 0x8f, 0x2a, 0x13, 0x5b, 0x96, 0xdb, 0x23, 0x1a, 0xdd, 0xa1, 0x44, 0x47, 0x17,
+	0xe0, 0xd4, 0xf0, 0x82, 0xad, 0xe6, 0x4a, 0x41, 0x2a, 0x8e, 0x1f, 0x1e, 0x55, 0x72, 0x6c, 0x9c,
+	0x60, 0xf2, 0xaf, 0xd6, 0xc6, 0x7a, 0x21, 0x23, 0x4c, 0xd8, 0x18, 0x5d, 0x82, 0xd3, 0x43, 0x26,
+	0xad, 0xb6, 0xda, 0x5c, 0xff, 0x67, 0x21, 0x5b, 0x84, 0xc3, 0xa3, 0x4a, 0xbe, 0xe5, 0x39, 0x2c,
+	0x2d, 0x65, 0x40, 0xc3, 0xce, 0xd4, 0x66, 0x21, 0x57, 0x1c, 0x3b, 0x3c, 0xaa, 0x64, 0xb7, 0x1c,
+	0x9a, 0x60, 0xd0, 0x5c, 0x6f, 0x17, 0x46, 0x85, 0x41, 0xd3, 0xf2, 0xd0, 0x45, 0x98, 0x1b, 0x32,
+	0x58, 0xbd, 0xb9, 0xb1, 0xdc, 0x2e, 0xe4, 0x8b, 0xf2, 0xe1, 0x51, 0x65, 0x74, 0xd5, 0xb0, 0x71,
+	0x92, 0xd1, 0xa6, 0xba, 0xd1, 0xde, 0x28, 0x8c, 0x09, 0xa3, 0x4d, 0xfe, 0xab, 0xc9, 0x71, 0xa3,
+	0xfa, 0xed, 0x76, 0xa3, 0x55, 0x18, 0x17, 0x46, 0xf5, 0x03, 0x8f, 0xb8, 0x09, 0x71, 0xdd, 0x58,
+	0x6e, 0xdd, 0x68, 0xac, 0x14, 0x64, 0x11, 0x17, 0x7b, 0x7b, 0x88, 0x5e, 0x37, 0x9f, 0xbe, 0x2c,
+	0x49, 0xcf, 0x5e, 0x96, 0xa4, 0x1f, 0x5e, 0x96, 0xa4, 0xc7, 0xaf, 0x4a, 0x23, 0xcf, 0x5e, 0x95,
+	0x46, 0xbe, 0x7b, 0x55, 0x1a, 0x81, 0x22, 0xb5, 0xd3, 0x4a, 0xbc, 0x29, 0xdd, 0xf9, 0x5b, 0xe4,
+	0x53, 0x78, 0x60, 0xb5, 0x44, 0xed, 0x88, 0x54, 0xdb, 0x8f, 0xfc, 0xfa, 0xc3, 0xbf, 0x8e, 0xb7,
+	0xf3, 0xfc, 0x43, 0xe1, 0xda, 0xaf, 0x03, 0x00, 0x83, 0x41, 0xad, 0x46, 0x22, 0x12, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.OriginalType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.UpdateValue)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.UpdateType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	{
+		size, err := m.ValueSizeFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttribute(dAtA, i, uint64(size))
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxValueLength != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeExpirationUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValueSizeFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueSizeFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueSizeFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.LargeFee) > 0 {
+		for iNdEx := len(m.LargeFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LargeFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.MediumFee) > 0 {
+		for iNdEx := len(m.MediumFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MediumFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.SmallFee) > 0 {
+		for iNdEx := len(m.SmallFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SmallFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.LargeMinLength != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.LargeMinLength))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.OriginalExpiration)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.UpdatedExpiration)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.MediumMinLength != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MediumMinLength))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeDelete) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAttribute(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.ExpirationDate != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAttribute(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if m.AttributeType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeDistinctDelete) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.AttributeType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.Sequence != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeExpired) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttestationSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.ValueHash)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
-	}
-	l = len(m.AttributeType)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Expiration)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountDataUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttributeAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if m.ExpirationDate != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintAttribute(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if m.AttributeType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttributeAttestationPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeAttestationPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeAttestationPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MaxValueLength)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovAttribute(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RefreshOracle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozAttribute(x uint64) (n int) {
-	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RefreshOracle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *RefreshOracle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeDependent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeDependent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeDependent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintAttribute(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UpdateType) > 0 {
+		i -= len(m.UpdateType)
+		copy(dAtA[i:], m.UpdateType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UpdateValue) > 0 {
+		i -= len(m.UpdateValue)
+		copy(dAtA[i:], m.UpdateValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OriginalType) > 0 {
+		i -= len(m.OriginalType)
+		copy(dAtA[i:], m.OriginalType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalValue) > 0 {
+		i -= len(m.OriginalValue)
+		copy(dAtA[i:], m.OriginalValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeExpirationUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeExpirationUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeExpirationUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedExpiration) > 0 {
+		i -= len(m.UpdatedExpiration)
+		copy(dAtA[i:], m.UpdatedExpiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdatedExpiration)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OriginalExpiration) > 0 {
+		i -= len(m.OriginalExpiration)
+		copy(dAtA[i:], m.OriginalExpiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalExpiration)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeDistinctDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeDistinctDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeDistinctDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttributeType) > 0 {
+		i -= len(m.AttributeType)
+		copy(dAtA[i:], m.AttributeType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttributeType) > 0 {
+		i -= len(m.AttributeType)
+		copy(dAtA[i:], m.AttributeType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueHash) > 0 {
+		i -= len(m.ValueHash)
+		copy(dAtA[i:], m.ValueHash)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ValueHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAccountDataUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAccountDataUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAccountDataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LargeFee) > 0 {
+		i -= len(m.LargeFee)
+		copy(dAtA[i:], m.LargeFee)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.LargeFee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MediumFee) > 0 {
+		i -= len(m.MediumFee)
+		copy(dAtA[i:], m.MediumFee)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MediumFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SmallFee) > 0 {
+		i -= len(m.SmallFee)
+		copy(dAtA[i:], m.SmallFee)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.SmallFee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LargeMinLength) > 0 {
+		i -= len(m.LargeMinLength)
+		copy(dAtA[i:], m.LargeMinLength)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.LargeMinLength)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MediumMinLength) > 0 {
+		i -= len(m.MediumMinLength)
+		copy(dAtA[i:], m.MediumMinLength)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MediumMinLength)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MaxValueLength) > 0 {
+		i -= len(m.MaxValueLength)
+		copy(dAtA[i:], m.MaxValueLength)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.MaxValueLength)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeValueSizeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeValueSizeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeValueSizeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValueLength) > 0 {
+		i -= len(m.ValueLength)
+		copy(dAtA[i:], m.ValueLength)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ValueLength)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SizeClass) > 0 {
+		i -= len(m.SizeClass)
+		copy(dAtA[i:], m.SizeClass)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.SizeClass)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeMirrorUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeMirrorUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeMirrorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeRefreshOracleUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeRefreshOracleUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeRefreshOracleUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeAttestationSourceUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeAttestationSourceUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeAttestationSourceUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeAttestationImported) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeAttestationImported) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeAttestationImported) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sequence) > 0 {
+		i -= len(m.Sequence)
+		copy(dAtA[i:], m.Sequence)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Sequence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeDependentsWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeDependentsWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeDependentsWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dependents) > 0 {
+		for iNdEx := len(m.Dependents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxValueLength != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxValueLength))
+	}
+	l = m.ValueSizeFees.Size()
+	n += 1 + l + sovAttribute(uint64(l))
+	return n
+}
+
+func (m *ValueSizeFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MediumMinLength != 0 {
+		n += 1 + sovAttribute(uint64(m.MediumMinLength))
+	}
+	if m.LargeMinLength != 0 {
+		n += 1 + sovAttribute(uint64(m.LargeMinLength))
+	}
+	if len(m.SmallFee) > 0 {
+		for _, e := range m.SmallFee {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	if len(m.MediumFee) > 0 {
+		for _, e := range m.MediumFee {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	if len(m.LargeFee) > 0 {
+		for _, e := range m.LargeFee {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovAttribute(uint64(m.AttributeType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAttribute(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *AttestationSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovAttribute(uint64(m.AttributeType))
+	}
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeAttestationPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RefreshOracle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeDependent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAttributeAdd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.OriginalType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdateValue)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdateType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeExpirationUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.OriginalExpiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.UpdatedExpiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDistinctDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ValueHash)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAccountDataUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxValueLength)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MediumMinLength)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.LargeMinLength)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.SmallFee)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.MediumFee)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.LargeFee)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeValueSizeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.SizeClass)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ValueLength)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeMirrorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeRefreshOracleUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeAttestationSourceUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeAttestationImported) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Sequence)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeDependentsWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.Dependents) > 0 {
+		for _, e := range m.Dependents {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAttribute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttribute(x uint64) (n int) {
+	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+			}
+			m.MaxValueLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValueLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValueSizeFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueSizeFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueSizeFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueSizeFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediumMinLength", wireType)
+			}
+			m.MediumMinLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MediumMinLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeMinLength", wireType)
+			}
+			m.LargeMinLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LargeMinLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmallFee = append(m.SmallFee, types.Coin{})
+			if err := m.SmallFee[len(m.SmallFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediumFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediumFee = append(m.MediumFee, types.Coin{})
+			if err := m.MediumFee[len(m.MediumFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LargeFee = append(m.LargeFee, types.Coin{})
+			if err := m.LargeFee[len(m.LargeFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &AttestationOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeAttestationPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeAttestationPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeAttestationPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, AttributeAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshOracle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshOracle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshOracle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeDependent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeDependent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeDependent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeExpirationUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeExpirationUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExpiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalExpiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedExpiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedExpiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1638,30 +5921,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowAttribute
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.MaxValueLength = 0
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1671,11 +6018,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValueLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttribute(dAtA[iNdEx:])
@@ -1697,7 +6057,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,10 +6080,10 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1762,7 +6122,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1772,31 +6132,29 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
-			m.AttributeType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1806,14 +6164,27 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1841,13 +6212,13 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1857,27 +6228,23 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1900,7 +6267,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,10 +6290,10 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1963,7 +6330,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1991,11 +6358,11 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ValueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2023,7 +6390,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -2058,38 +6425,6 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
@@ -2142,7 +6477,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2165,15 +6500,15 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAccountDataUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAccountDataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2201,11 +6536,61 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2233,11 +6618,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			m.MaxValueLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MediumMinLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2265,11 +6650,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			m.MediumMinLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeMinLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2297,11 +6682,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			m.LargeMinLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SmallFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2329,11 +6714,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			m.SmallFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MediumFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2361,11 +6746,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.MediumFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LargeFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2393,7 +6778,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.LargeFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2416,7 +6801,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeValueSizeFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2439,10 +6824,10 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeExpirationUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeValueSizeFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeExpirationUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeValueSizeFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2479,7 +6864,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2507,11 +6892,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2539,11 +6924,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.SizeClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2571,11 +6956,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ValueLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2603,11 +6988,61 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalExpiration = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeMirrorUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeMirrorUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeMirrorUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,7 +7070,59 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedExpiration = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2658,7 +7145,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeRefreshOracleUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,10 +7168,10 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeRefreshOracleUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeRefreshOracleUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2721,7 +7208,7 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2749,9 +7236,29 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2804,7 +7311,7 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeAttestationSourceUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,10 +7334,10 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeAttestationSourceUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeAttestationSourceUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2867,7 +7374,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2895,11 +7402,11 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2927,13 +7434,13 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -2943,24 +7450,12 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
@@ -3014,7 +7509,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeAttestationImported) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,10 +7532,10 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeExpired: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeAttestationImported: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeAttestationImported: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3077,7 +7572,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3105,11 +7600,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueHash = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3137,11 +7632,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3169,11 +7664,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3201,7 +7696,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expiration = string(dAtA[iNdEx:postIndex])
+			m.Sequence = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3224,7 +7719,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeDependentsWarning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3247,15 +7742,15 @@ func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAccountDataUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeDependentsWarning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAccountDataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeDependentsWarning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3283,63 +7778,13 @@ func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAttribute(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeParamsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -3349,23 +7794,25 @@ func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxValueLength = string(dAtA[iNdEx:postIndex])
+			m.Dependents = append(m.Dependents, AttributeDependent{})
+			if err := m.Dependents[len(m.Dependents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
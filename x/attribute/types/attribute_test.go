@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -190,6 +191,26 @@ func (s *AttributeTestSuite) TestAttributeValidateBasic() {
 			false,
 			"",
 		},
+		"should fail to validate basic attribute invalid value for type hashed": {
+			Attribute{
+				Name:          "hashed",
+				Value:         []byte("not a hash"),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Hashed,
+			},
+			true,
+			"invalid attribute value for assigned type: ATTRIBUTE_TYPE_HASHED",
+		},
+		"should succeed to validate basic attribute for type hashed": {
+			Attribute{
+				Name:          "hashed",
+				Value:         HashAttributeValue([]byte("salt"), []byte("preimage")),
+				Address:       "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+				AttributeType: AttributeType_Hashed,
+			},
+			false,
+			"",
+		},
 	}
 
 	for n, tc := range cases {
@@ -205,3 +226,60 @@ func (s *AttributeTestSuite) TestAttributeValidateBasic() {
 		})
 	}
 }
+
+func (s *AttributeTestSuite) TestHashAttributeValue() {
+	// echo -n "saltpreimage" | sha256sum
+	expected, err := hex.DecodeString("c507b56982406e9dce388f3985f2ebce626f51505e5c3b03847be1df238b85f1")
+	s.Require().NoError(err, "hex.DecodeString")
+	s.Assert().Equal(expected, HashAttributeValue([]byte("salt"), []byte("preimage")), "HashAttributeValue")
+	s.Assert().Equal(expected, HashAttributeValue(nil, []byte("saltpreimage")), "HashAttributeValue without salt")
+}
+
+func (s *AttributeTestSuite) TestAttributeMatchesPreimage() {
+	salt := []byte("salt")
+	preimage := []byte("preimage")
+	hashed := HashAttributeValue(salt, preimage)
+
+	tests := []struct {
+		name     string
+		attr     Attribute
+		salt     []byte
+		preimage []byte
+		exp      bool
+	}{
+		{
+			name:     "match",
+			attr:     Attribute{AttributeType: AttributeType_Hashed, Value: hashed},
+			salt:     salt,
+			preimage: preimage,
+			exp:      true,
+		},
+		{
+			name:     "wrong preimage",
+			attr:     Attribute{AttributeType: AttributeType_Hashed, Value: hashed},
+			salt:     salt,
+			preimage: []byte("other"),
+			exp:      false,
+		},
+		{
+			name:     "wrong salt",
+			attr:     Attribute{AttributeType: AttributeType_Hashed, Value: hashed},
+			salt:     []byte("pepper"),
+			preimage: preimage,
+			exp:      false,
+		},
+		{
+			name:     "not a hashed attribute",
+			attr:     Attribute{AttributeType: AttributeType_Bytes, Value: hashed},
+			salt:     salt,
+			preimage: preimage,
+			exp:      false,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Assert().Equal(tc.exp, tc.attr.MatchesPreimage(tc.salt, tc.preimage), "MatchesPreimage")
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttributeDependencyReporter defines the function other modules use to report the things that require an attribute.
+type AttributeDependencyReporter interface {
+	// GetAttributeDependents returns the things in the module that require the given attribute name.
+	GetAttributeDependents(ctx sdk.Context, name string) []AttributeDependent
+}
+
+var _ AttributeDependencyReporter = MultiAttributeDependencyReporter{}
+
+// MultiAttributeDependencyReporter combines multiple attribute dependency reporters.
+type MultiAttributeDependencyReporter []AttributeDependencyReporter
+
+// NewMultiAttributeDependencyReporter creates a new MultiAttributeDependencyReporter with the provided reporters.
+func NewMultiAttributeDependencyReporter(reporters ...AttributeDependencyReporter) MultiAttributeDependencyReporter {
+	return reporters
+}
+
+// GetAttributeDependents gets the dependents from each of the reporters, in order.
+func (r MultiAttributeDependencyReporter) GetAttributeDependents(ctx sdk.Context, name string) []AttributeDependent {
+	var rv []AttributeDependent
+	for _, reporter := range r {
+		rv = append(rv, reporter.GetAttributeDependents(ctx, name)...)
+	}
+	return rv
+}
+
+// NewAttributeDependent creates a new AttributeDependent.
+func NewAttributeDependent(module, kind, id string, requiredAttributes []string) AttributeDependent {
+	return AttributeDependent{
+		Module:             module,
+		Kind:               kind,
+		Id:                 id,
+		RequiredAttributes: requiredAttributes,
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// staticReporter is an AttributeDependencyReporter that returns a dependent for each of its ids.
+type staticReporter struct {
+	kind string
+	ids  []string
+}
+
+func (r staticReporter) GetAttributeDependents(_ sdk.Context, name string) []AttributeDependent {
+	var rv []AttributeDependent
+	for _, id := range r.ids {
+		rv = append(rv, NewAttributeDependent("test", r.kind, id, []string{name}))
+	}
+	return rv
+}
+
+func TestMultiAttributeDependencyReporter(t *testing.T) {
+	reporter := NewMultiAttributeDependencyReporter(
+		staticReporter{kind: "marker", ids: []string{"hotdog", "hamburger"}},
+		staticReporter{kind: "nothing"},
+		staticReporter{kind: "market", ids: []string{"3"}},
+	)
+
+	expected := []AttributeDependent{
+		{Module: "test", Kind: "marker", Id: "hotdog", RequiredAttributes: []string{"kyc.pb"}},
+		{Module: "test", Kind: "marker", Id: "hamburger", RequiredAttributes: []string{"kyc.pb"}},
+		{Module: "test", Kind: "market", Id: "3", RequiredAttributes: []string{"kyc.pb"}},
+	}
+	actual := reporter.GetAttributeDependents(sdk.Context{}, "kyc.pb")
+	assert.Equal(t, expected, actual, "GetAttributeDependents")
+
+	assert.Nil(t, NewMultiAttributeDependencyReporter().GetAttributeDependents(sdk.Context{}, "kyc.pb"), "empty reporter")
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"strconv"
 	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -124,5 +126,74 @@ func NewEventAttributeExpired(attribute Attribute) *EventAttributeExpired {
 }
 
 func NewEventAttributeParamsUpdated(params Params) *EventAttributeParamsUpdated {
-	return &EventAttributeParamsUpdated{MaxValueLength: strconv.FormatUint(uint64(params.MaxValueLength), 10)}
+	return &EventAttributeParamsUpdated{
+		MaxValueLength:  strconv.FormatUint(uint64(params.MaxValueLength), 10),
+		MediumMinLength: strconv.FormatUint(uint64(params.ValueSizeFees.MediumMinLength), 10),
+		LargeMinLength:  strconv.FormatUint(uint64(params.ValueSizeFees.LargeMinLength), 10),
+		SmallFee:        params.ValueSizeFees.SmallFee.String(),
+		MediumFee:       params.ValueSizeFees.MediumFee.String(),
+		LargeFee:        params.ValueSizeFees.LargeFee.String(),
+	}
+}
+
+// NewEventAttributeValueSizeFee creates a new EventAttributeValueSizeFee.
+func NewEventAttributeValueSizeFee(name, account, sizeClass string, valueLength int, fee sdk.Coins) *EventAttributeValueSizeFee {
+	return &EventAttributeValueSizeFee{
+		Name:        name,
+		Account:     account,
+		SizeClass:   sizeClass,
+		ValueLength: strconv.Itoa(valueLength),
+		Fee:         fee.String(),
+	}
+}
+
+func NewEventAttributeMirrorUpdated(contract string, enabled bool, admin string) *EventAttributeMirrorUpdated {
+	return &EventAttributeMirrorUpdated{
+		Contract: contract,
+		Enabled:  enabled,
+		Admin:    admin,
+	}
+}
+
+// NewEventAttributeRefreshOracleUpdated creates a new EventAttributeRefreshOracleUpdated.
+func NewEventAttributeRefreshOracleUpdated(name, oracle string, enabled bool, owner string) *EventAttributeRefreshOracleUpdated {
+	return &EventAttributeRefreshOracleUpdated{
+		Name:    name,
+		Oracle:  oracle,
+		Enabled: enabled,
+		Owner:   owner,
+	}
+}
+
+// NewEventAttributeAttestationSourceUpdated creates a new EventAttributeAttestationSourceUpdated.
+func NewEventAttributeAttestationSourceUpdated(source AttestationSource, enabled bool, owner string) *EventAttributeAttestationSourceUpdated {
+	return &EventAttributeAttestationSourceUpdated{
+		Name:      source.Name,
+		ChannelId: source.ChannelId,
+		Issuer:    source.Issuer,
+		Enabled:   enabled,
+		Owner:     owner,
+	}
+}
+
+// NewEventAttributeAttestationImported creates a new EventAttributeAttestationImported.
+func NewEventAttributeAttestationImported(attribute Attribute) *EventAttributeAttestationImported {
+	rv := &EventAttributeAttestationImported{
+		Name:    attribute.Name,
+		Account: attribute.Address,
+	}
+	if attribute.Origin != nil {
+		rv.ChannelId = attribute.Origin.ChannelId
+		rv.Issuer = attribute.Origin.Issuer
+		rv.Sequence = strconv.FormatUint(attribute.Origin.Sequence, 10)
+	}
+	return rv
+}
+
+// NewEventAttributeDependentsWarning creates a new EventAttributeDependentsWarning.
+func NewEventAttributeDependentsWarning(name string, dependents []AttributeDependent) *EventAttributeDependentsWarning {
+	return &EventAttributeDependentsWarning{
+		Name:       name,
+		Dependents: dependents,
+	}
 }
@@ -3,7 +3,10 @@ package types
 import (
 	"context"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
@@ -24,4 +27,22 @@ type NameKeeper interface {
 	SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error
 	UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress, restrict bool) error
 	IterateRecords(ctx sdk.Context, prefix []byte, handle func(nametypes.NameRecord) error) error
+	ValidateNameNotFrozen(ctx sdk.Context, name string) error
+}
+
+// WasmKeeper defines the wasm keeper functionality needed by the attribute module.
+type WasmKeeper interface {
+	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
+
+// PortKeeper defines the IBC port keeper functionality needed by the attribute module.
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// ScopedKeeper defines the x/capability scoped keeper functionality needed by the attribute module.
+type ScopedKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+	AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool
+	ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error
 }
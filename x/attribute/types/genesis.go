@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, attributes []Attribute) *GenesisState {
 	return &GenesisState{
@@ -10,11 +18,54 @@ func NewGenesisState(params Params, attributes []Attribute) *GenesisState {
 
 // ValidateBasic ensures a genesis state is valid.
 func (state GenesisState) ValidateBasic() error {
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
 	for _, a := range state.Attributes {
 		if err := a.ValidateBasic(); err != nil {
 			return err
 		}
 	}
+	seen := make(map[string]bool, len(state.MirroredContracts))
+	for i, contract := range state.MirroredContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid mirrored contracts[%d]: %w", i, err)
+		}
+		if seen[contract] {
+			return fmt.Errorf("invalid mirrored contracts[%d]: duplicate contract %s", i, contract)
+		}
+		seen[contract] = true
+	}
+	seenOracles := make(map[string]bool, len(state.RefreshOracles))
+	for i, oracle := range state.RefreshOracles {
+		if len(strings.TrimSpace(oracle.Name)) == 0 {
+			return fmt.Errorf("invalid refresh oracles[%d]: empty name", i)
+		}
+		if _, err := sdk.AccAddressFromBech32(oracle.Oracle); err != nil {
+			return fmt.Errorf("invalid refresh oracles[%d]: %w", i, err)
+		}
+		key := oracle.Name + " " + oracle.Oracle
+		if seenOracles[key] {
+			return fmt.Errorf("invalid refresh oracles[%d]: duplicate oracle %s for %s", i, oracle.Oracle, oracle.Name)
+		}
+		seenOracles[key] = true
+	}
+	if len(state.PortId) > 0 {
+		if err := host.PortIdentifierValidator(state.PortId); err != nil {
+			return fmt.Errorf("invalid port id: %w", err)
+		}
+	}
+	seenSources := make(map[string]bool, len(state.AttestationSources))
+	for i, source := range state.AttestationSources {
+		if err := source.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid attestation sources[%d]: %w", i, err)
+		}
+		key := string(AttestationSourceKey(source.Name, source.ChannelId, source.Issuer))
+		if seenSources[key] {
+			return fmt.Errorf("invalid attestation sources[%d]: duplicate issuer %s on %s for %s", i, source.Issuer, source.ChannelId, source.Name)
+		}
+		seenSources[key] = true
+	}
 	return nil
 }
 
@@ -23,5 +74,6 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:     DefaultParams(),
 		Attributes: []Attribute{},
+		PortId:     PortID,
 	}
 }
@@ -29,6 +29,14 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// deposits defines all the deposits present at genesis.
 	Attributes []Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
+	// mirrored_contracts are the smart contracts that inherit the attributes of their admins.
+	MirroredContracts []string `protobuf:"bytes,3,rep,name=mirrored_contracts,json=mirroredContracts,proto3" json:"mirrored_contracts,omitempty"`
+	// refresh_oracles are the addresses allowed to extend the expiration of attributes with a name.
+	RefreshOracles []RefreshOracle `protobuf:"bytes,4,rep,name=refresh_oracles,json=refreshOracles,proto3" json:"refresh_oracles"`
+	// port_id is the IBC port that attribute attestations are received on.
+	PortId string `protobuf:"bytes,5,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// attestation_sources are the issuers on other chains allowed to attest attributes with a name.
+	AttestationSources []AttestationSource `protobuf:"bytes,6,rep,name=attestation_sources,json=attestationSources,proto3" json:"attestation_sources"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,23 +81,31 @@ func init() {
 }
 
 var fileDescriptor_7690f9b78d391c2d = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x4f, 0x2c, 0x29, 0x29, 0xca, 0x4c, 0x2a, 0x2d, 0x49,
-	0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x47, 0x28, 0xd3, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x71, 0x99, 0x8a, 0xd0, 0x0b, 0x56,
-	0xa8, 0xb4, 0x90, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x53, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x2d,
-	0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xbc,
-	0x1e, 0x0e, 0x9b, 0xf5, 0x02, 0xc0, 0xca, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a,
-	0x12, 0xf2, 0xe0, 0xe2, 0x82, 0x2b, 0x2a, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc2,
-	0x69, 0x84, 0x23, 0x8c, 0x03, 0x35, 0x05, 0x49, 0xaf, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x17,
-	0x0b, 0xe4, 0x19, 0x9c, 0x72, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b,
-	0x2a, 0x33, 0x1f, 0x97, 0xd9, 0x01, 0x8c, 0x51, 0xa6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0x55, 0xba, 0x99, 0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0xa4, 0xf0, 0x29,
-	0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x8c, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x2c,
-	0xfc, 0x9c, 0x6e, 0x9a, 0x01, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4a, 0xe3, 0x40,
+	0x18, 0xc7, 0x93, 0x4d, 0x37, 0xbb, 0x9d, 0x2e, 0xbb, 0xec, 0x28, 0x34, 0xf4, 0x90, 0x84, 0x82,
+	0x1a, 0x84, 0x26, 0xb4, 0xe2, 0x45, 0xf0, 0x60, 0x3d, 0xa8, 0x27, 0x4b, 0x8a, 0x17, 0x2f, 0x61,
+	0x9a, 0x8e, 0xe9, 0x80, 0xc9, 0x84, 0x99, 0x69, 0xd1, 0x37, 0xf0, 0xe8, 0x23, 0x14, 0x7c, 0x99,
+	0x1e, 0x7b, 0xf4, 0x24, 0xd2, 0x5e, 0x7c, 0x0c, 0xe9, 0x34, 0x69, 0x83, 0x10, 0x6f, 0xf3, 0xcd,
+	0xf7, 0xfb, 0x7f, 0xbf, 0x19, 0xf8, 0xc0, 0x5e, 0xca, 0xe8, 0x04, 0x27, 0x28, 0x09, 0xb1, 0x87,
+	0x84, 0x60, 0x64, 0x30, 0x16, 0xd8, 0x9b, 0xb4, 0xbd, 0x08, 0x27, 0x98, 0x13, 0xee, 0xa6, 0x8c,
+	0x0a, 0x0a, 0xeb, 0x5b, 0xcc, 0xdd, 0x60, 0xee, 0xa4, 0xdd, 0xd8, 0x8d, 0x68, 0x44, 0x25, 0xe3,
+	0xad, 0x4e, 0x6b, 0xbc, 0x71, 0x50, 0x36, 0x75, 0x9b, 0x95, 0x60, 0xf3, 0x45, 0x03, 0x7f, 0x2e,
+	0xd6, 0xa6, 0xbe, 0x40, 0x02, 0xc3, 0x53, 0xa0, 0xa7, 0x88, 0xa1, 0x98, 0x1b, 0xaa, 0xad, 0x3a,
+	0xb5, 0x8e, 0xe5, 0x96, 0x98, 0xdd, 0x9e, 0xc4, 0xba, 0x95, 0xd9, 0x9b, 0xa5, 0xf8, 0x59, 0x08,
+	0x5e, 0x02, 0xb0, 0x81, 0xb8, 0xf1, 0xc3, 0xd6, 0x9c, 0x5a, 0xa7, 0x59, 0x3a, 0xe2, 0x2c, 0x2f,
+	0xb2, 0x29, 0x85, 0x2c, 0x6c, 0x01, 0x18, 0x13, 0xc6, 0x28, 0xc3, 0xc3, 0x20, 0xa4, 0x89, 0x60,
+	0x28, 0x14, 0xdc, 0xd0, 0x6c, 0xcd, 0xa9, 0xfa, 0xff, 0xf3, 0xce, 0x79, 0xde, 0x80, 0x37, 0xe0,
+	0x1f, 0xc3, 0x77, 0x0c, 0xf3, 0x51, 0x40, 0x19, 0x0a, 0xef, 0x31, 0x37, 0x2a, 0xd2, 0xbe, 0x5f,
+	0x6a, 0xf7, 0xd7, 0xfc, 0xb5, 0xc4, 0xb3, 0x17, 0xfc, 0x65, 0xc5, 0x4b, 0x0e, 0xeb, 0xe0, 0x57,
+	0x4a, 0x99, 0x08, 0xc8, 0xd0, 0xf8, 0x69, 0xab, 0x4e, 0xd5, 0xd7, 0x57, 0xe5, 0xd5, 0x10, 0x22,
+	0xb0, 0x83, 0x84, 0xc0, 0x5c, 0x20, 0x41, 0x68, 0x12, 0x70, 0x3a, 0x66, 0x21, 0xe6, 0x86, 0x2e,
+	0x9d, 0x87, 0xdf, 0xfd, 0x38, 0xcf, 0xf4, 0x65, 0x24, 0xf3, 0x42, 0xf4, 0xb5, 0xc1, 0x4f, 0x7e,
+	0x3f, 0x4d, 0x2d, 0xe5, 0x63, 0x6a, 0x29, 0xdd, 0x78, 0xb6, 0x30, 0xd5, 0xf9, 0xc2, 0x54, 0xdf,
+	0x17, 0xa6, 0xfa, 0xbc, 0x34, 0x95, 0xf9, 0xd2, 0x54, 0x5e, 0x97, 0xa6, 0x02, 0x1a, 0x84, 0x96,
+	0xb9, 0x7a, 0xea, 0xed, 0x71, 0x44, 0xc4, 0x68, 0x3c, 0x70, 0x43, 0x1a, 0x7b, 0x5b, 0xaa, 0x45,
+	0x68, 0xa1, 0xf2, 0x1e, 0x0a, 0x1b, 0x22, 0x1e, 0x53, 0xcc, 0x07, 0xba, 0xdc, 0x8d, 0xa3, 0xcf,
+	0x01, 0x00, 0x5b, 0x42, 0x48, 0x9c, 0x9c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +128,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AttestationSources) > 0 {
+		for iNdEx := len(m.AttestationSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RefreshOracles) > 0 {
+		for iNdEx := len(m.RefreshOracles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefreshOracles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MirroredContracts) > 0 {
+		for iNdEx := len(m.MirroredContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MirroredContracts[iNdEx])
+			copy(dAtA[i:], m.MirroredContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.MirroredContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Attributes) > 0 {
 		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -164,6 +224,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MirroredContracts) > 0 {
+		for _, s := range m.MirroredContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefreshOracles) > 0 {
+		for _, e := range m.RefreshOracles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.AttestationSources) > 0 {
+		for _, e := range m.AttestationSources {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +351,138 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirroredContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MirroredContracts = append(m.MirroredContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshOracles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefreshOracles = append(m.RefreshOracles, RefreshOracle{})
+			if err := m.RefreshOracles[len(m.RefreshOracles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationSources = append(m.AttestationSources, AttestationSource{})
+			if err := m.AttestationSources[len(m.AttestationSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
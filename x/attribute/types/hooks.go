@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttributeHooks defines the functions other modules can use to react to changes in attributes.
+type AttributeHooks interface {
+	// AfterAttributeDeleted is called after one or more attributes with the given name are removed from an account.
+	AfterAttributeDeleted(ctx sdk.Context, addr sdk.AccAddress, name string) error
+}
+
+var _ AttributeHooks = MultiAttributeHooks{}
+
+// MultiAttributeHooks combines multiple attribute hooks. The hooks are run in order,
+// stopping at the first one that returns an error.
+type MultiAttributeHooks []AttributeHooks
+
+// NewMultiAttributeHooks creates a new MultiAttributeHooks with the provided hooks.
+func NewMultiAttributeHooks(hooks ...AttributeHooks) MultiAttributeHooks {
+	return hooks
+}
+
+// AfterAttributeDeleted calls AfterAttributeDeleted on each of the hooks.
+func (h MultiAttributeHooks) AfterAttributeDeleted(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	for _, hook := range h {
+		if err := hook.AfterAttributeDeleted(ctx, addr, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
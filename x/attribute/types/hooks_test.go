@@ -0,0 +1,35 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordingHooks is an AttributeHooks that records its calls and returns a preset error.
+type recordingHooks struct {
+	name  string
+	err   error
+	calls *[]string
+}
+
+func (h recordingHooks) AfterAttributeDeleted(_ sdk.Context, addr sdk.AccAddress, name string) error {
+	*h.calls = append(*h.calls, h.name+":"+string(addr)+":"+name)
+	return h.err
+}
+
+func TestMultiAttributeHooks(t *testing.T) {
+	var calls []string
+	hooks := NewMultiAttributeHooks(
+		recordingHooks{name: "first", calls: &calls},
+		recordingHooks{name: "second", err: errors.New("second failed"), calls: &calls},
+		recordingHooks{name: "third", calls: &calls},
+	)
+
+	err := hooks.AfterAttributeDeleted(sdk.Context{}, sdk.AccAddress("addr"), "kyc.pb")
+	assert.EqualError(t, err, "second failed", "AfterAttributeDeleted error")
+	assert.Equal(t, []string{"first:addr:kyc.pb", "second:addr:kyc.pb"}, calls, "hook calls")
+}
@@ -0,0 +1,341 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// JSONPatchOperation is a single operation of an RFC 6902 JSON patch.
+type JSONPatchOperation struct {
+	// Op is the operation to perform: add, remove, replace, move, copy, or test.
+	Op string `json:"op"`
+	// Path is the RFC 6901 JSON pointer to the location the operation is performed on.
+	Path string `json:"path"`
+	// From is the JSON pointer to the location a move or copy operation takes its value from.
+	From string `json:"from,omitempty"`
+	// Value is the value used by add, replace, and test operations.
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ParseJSONPatch reads and validates an RFC 6902 JSON patch without applying it.
+func ParseJSONPatch(patch []byte) ([]JSONPatchOperation, error) {
+	var ops []JSONPatchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("invalid json patch: %w", err)
+	}
+	if len(ops) == 0 {
+		return nil, errors.New("invalid json patch: no operations")
+	}
+	for i, op := range ops {
+		if err := op.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid json patch operation %d: %w", i, err)
+		}
+	}
+	return ops, nil
+}
+
+// Validate returns an error if this operation is malformed.
+func (o JSONPatchOperation) Validate() error {
+	if _, err := parseJSONPointer(o.Path); err != nil {
+		return fmt.Errorf("invalid path: %w", err)
+	}
+	switch o.Op {
+	case "add", "replace", "test":
+		if len(o.Value) == 0 {
+			return fmt.Errorf("%s operation requires a value", o.Op)
+		}
+	case "remove":
+		if len(o.Path) == 0 {
+			return errors.New("cannot remove the whole document")
+		}
+	case "move", "copy":
+		from, err := parseJSONPointer(o.From)
+		if err != nil {
+			return fmt.Errorf("invalid from: %w", err)
+		}
+		if o.Op == "move" && o.Path != o.From && strings.HasPrefix(o.Path, o.From) &&
+			(len(from) == 0 || o.Path[len(o.From)] == '/') {
+			return errors.New("cannot move a value into one of its children")
+		}
+	default:
+		return fmt.Errorf("unknown op %q", o.Op)
+	}
+	return nil
+}
+
+// ApplyJSONPatch applies an RFC 6902 JSON patch to a JSON document and returns the result.
+// Either every operation is applied, or an error is returned.
+func ApplyJSONPatch(doc []byte, patch []byte) ([]byte, error) {
+	ops, err := ParseJSONPatch(patch)
+	if err != nil {
+		return nil, err
+	}
+	node, err := decodeJSON(doc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid json document: %w", err)
+	}
+	for i, op := range ops {
+		if node, err = op.apply(node); err != nil {
+			return nil, fmt.Errorf("could not apply json patch operation %d (%s %q): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err = enc.Encode(node); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
+
+// apply performs this operation on the provided document and returns the updated document.
+func (o JSONPatchOperation) apply(node any) (any, error) {
+	path, _ := parseJSONPointer(o.Path)
+	switch o.Op {
+	case "add":
+		value, err := decodeJSON(o.Value)
+		if err != nil {
+			return nil, err
+		}
+		return jsonAdd(node, path, value)
+	case "remove":
+		return jsonRemove(node, path)
+	case "replace":
+		value, err := decodeJSON(o.Value)
+		if err != nil {
+			return nil, err
+		}
+		if node, err = jsonRemove(node, path); err != nil {
+			return nil, err
+		}
+		return jsonAdd(node, path, value)
+	case "move", "copy":
+		from, _ := parseJSONPointer(o.From)
+		value, err := jsonGet(node, from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from: %w", err)
+		}
+		if o.Op == "copy" {
+			value = copyJSON(value)
+		} else if node, err = jsonRemove(node, from); err != nil {
+			return nil, err
+		}
+		return jsonAdd(node, path, value)
+	case "test":
+		value, err := decodeJSON(o.Value)
+		if err != nil {
+			return nil, err
+		}
+		actual, err := jsonGet(node, path)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonEqual(actual, value) {
+			return nil, errors.New("test failed: value does not match")
+		}
+		return node, nil
+	}
+	return nil, fmt.Errorf("unknown op %q", o.Op)
+}
+
+// decodeJSON decodes a JSON value, keeping numbers exactly as written.
+func decodeJSON(bz []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var node any
+	if err := dec.Decode(&node); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after json value")
+	}
+	return node, nil
+}
+
+// parseJSONPointer splits an RFC 6901 JSON pointer into its unescaped reference tokens.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if len(pointer) == 0 {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("json pointer %q must be empty or start with /", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// jsonArrayIndex parses an array index token. If allowEnd is true, the index can be the length of the array,
+// which can also be written as "-".
+func jsonArrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return length, nil
+	}
+	if len(token) == 0 || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i > length || (i == length && !allowEnd) {
+		return 0, fmt.Errorf("array index %q out of range", token)
+	}
+	return i, nil
+}
+
+// jsonGet returns the value at the provided path.
+func jsonGet(node any, path []string) (any, error) {
+	for _, token := range path {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			node = child
+		case []any:
+			i, err := jsonArrayIndex(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("cannot find %q in a json value that is not an object or array", token)
+		}
+	}
+	return node, nil
+}
+
+// jsonUpdateParent finds the object or array that holds the last token of the path, and replaces it with the result
+// of the update function.
+func jsonUpdateParent(node any, path []string, update func(parent any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return update(node, path[0])
+	}
+	child, err := jsonGet(node, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = jsonUpdateParent(child, path[1:], update); err != nil {
+		return nil, err
+	}
+	switch n := node.(type) {
+	case map[string]any:
+		n[path[0]] = child
+	case []any:
+		i, _ := jsonArrayIndex(path[0], len(n), false)
+		n[i] = child
+	}
+	return node, nil
+}
+
+// jsonAdd adds the value at the provided path. An existing object member is replaced, and array elements at or after
+// the index are shifted over.
+func jsonAdd(node any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return jsonUpdateParent(node, path, func(parent any, token string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			p[token] = value
+			return p, nil
+		case []any:
+			i, err := jsonArrayIndex(token, len(p), true)
+			if err != nil {
+				return nil, err
+			}
+			p = append(p, nil)
+			copy(p[i+1:], p[i:])
+			p[i] = value
+			return p, nil
+		}
+		return nil, fmt.Errorf("cannot add %q to a json value that is not an object or array", token)
+	})
+}
+
+// jsonRemove removes the value at the provided path, which must exist.
+func jsonRemove(node any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, errors.New("cannot remove the whole document")
+	}
+	return jsonUpdateParent(node, path, func(parent any, token string) (any, error) {
+		switch p := parent.(type) {
+		case map[string]any:
+			if _, ok := p[token]; !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			delete(p, token)
+			return p, nil
+		case []any:
+			i, err := jsonArrayIndex(token, len(p), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(p[:i], p[i+1:]...), nil
+		}
+		return nil, fmt.Errorf("cannot remove %q from a json value that is not an object or array", token)
+	})
+}
+
+// copyJSON returns a deep copy of a decoded JSON value.
+func copyJSON(node any) any {
+	switch n := node.(type) {
+	case map[string]any:
+		rv := make(map[string]any, len(n))
+		for k, v := range n {
+			rv[k] = copyJSON(v)
+		}
+		return rv
+	case []any:
+		rv := make([]any, len(n))
+		for i, v := range n {
+			rv[i] = copyJSON(v)
+		}
+		return rv
+	}
+	return node
+}
+
+// jsonEqual returns true if two decoded JSON values are equal. Numbers are compared by value.
+func jsonEqual(a, b any) bool {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			if w, found := bv[k]; !found || !jsonEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ar, aok := new(big.Rat).SetString(av.String())
+		br, bok := new(big.Rat).SetString(bv.String())
+		return aok && bok && ar.Cmp(br) == 0
+	}
+	return a == b
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		doc    string
+		patch  string
+		exp    string
+		expErr string
+	}{
+		{name: "add member", doc: `{"a":1}`, patch: `[{"op":"add","path":"/b","value":[1,2]}]`, exp: `{"a":1,"b":[1,2]}`},
+		{name: "add to array", doc: `{"a":[1,3]}`, patch: `[{"op":"add","path":"/a/1","value":2}]`, exp: `{"a":[1,2,3]}`},
+		{name: "append", doc: `{"a":[1]}`, patch: `[{"op":"add","path":"/a/-","value":2}]`, exp: `{"a":[1,2]}`},
+		{name: "remove", doc: `{"a":[1,2,3]}`, patch: `[{"op":"remove","path":"/a/1"}]`, exp: `{"a":[1,3]}`},
+		{name: "replace", doc: `{"a":{"b":"x"}}`, patch: `[{"op":"replace","path":"/a/b","value":"<y>"}]`, exp: `{"a":{"b":"<y>"}}`},
+		{name: "replace missing", doc: `{"a":1}`, patch: `[{"op":"replace","path":"/b","value":2}]`, expErr: "could not apply json patch operation 0 (replace \"/b\"): member \"b\" not found"},
+		{name: "move", doc: `{"a":{"b":1},"c":{}}`, patch: `[{"op":"move","from":"/a/b","path":"/c/d"}]`, exp: `{"a":{},"c":{"d":1}}`},
+		{name: "move into child", doc: `{"a":{"b":1}}`, patch: `[{"op":"move","from":"/a","path":"/a/b"}]`, expErr: "invalid json patch operation 0: cannot move a value into one of its children"},
+		{name: "move to sibling prefix", doc: `{"a":1}`, patch: `[{"op":"move","from":"/a","path":"/ab"}]`, exp: `{"ab":1}`},
+		{name: "copy", doc: `{"a":{"b":1}}`, patch: `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`, exp: `{"a":{"b":1},"c":{"b":2}}`},
+		{name: "test passes", doc: `{"a":1.0}`, patch: `[{"op":"test","path":"/a","value":1},{"op":"add","path":"/b","value":null}]`, exp: `{"a":1.0,"b":null}`},
+		{name: "test fails", doc: `{"a":1}`, patch: `[{"op":"test","path":"/a","value":2}]`, expErr: "could not apply json patch operation 0 (test \"/a\"): test failed: value does not match"},
+		{name: "escaped", doc: `{"a/b":{"~c":1}}`, patch: `[{"op":"remove","path":"/a~1b/~0c"}]`, exp: `{"a/b":{}}`},
+		{name: "replace root", doc: `{"a":1}`, patch: `[{"op":"add","path":"","value":[]}]`, exp: `[]`},
+		{name: "bad index", doc: `[1]`, patch: `[{"op":"add","path":"/01","value":2}]`, expErr: "could not apply json patch operation 0 (add \"/01\"): invalid array index \"01\""},
+		{name: "index out of range", doc: `[1]`, patch: `[{"op":"remove","path":"/1"}]`, expErr: "could not apply json patch operation 0 (remove \"/1\"): array index \"1\" out of range"},
+		{name: "unknown op", doc: `{}`, patch: `[{"op":"merge","path":"/a"}]`, expErr: "invalid json patch operation 0: unknown op \"merge\""},
+		{name: "missing value", doc: `{}`, patch: `[{"op":"add","path":"/a"}]`, expErr: "invalid json patch operation 0: add operation requires a value"},
+		{name: "bad path", doc: `{}`, patch: `[{"op":"remove","path":"a"}]`, expErr: "invalid json patch operation 0: invalid path: json pointer \"a\" must be empty or start with /"},
+		{name: "empty", doc: `{}`, patch: `[]`, expErr: "invalid json patch: no operations"},
+		{name: "big number kept", doc: `{"a":123456789012345678901234567890}`, patch: `[{"op":"add","path":"/b","value":1}]`, exp: `{"a":123456789012345678901234567890,"b":1}`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act, err := ApplyJSONPatch([]byte(tc.doc), []byte(tc.patch))
+			assertions.AssertErrorValue(t, err, tc.expErr, "ApplyJSONPatch error")
+			assert.Equal(t, tc.exp, string(act), "ApplyJSONPatch result")
+		})
+	}
+}
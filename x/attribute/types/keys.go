@@ -23,6 +23,12 @@ const (
 
 	// AccountDataName is the name of the attribute used to store account data.
 	AccountDataName = "accountdata"
+
+	// PortID is the default IBC port that the module receives attribute attestations on.
+	PortID = ModuleName
+
+	// Version is the IBC application version of attribute attestation channels.
+	Version = "attribute-attestation-1"
 )
 
 var (
@@ -32,6 +38,10 @@ var (
 	AttributeAddrLookupKeyPrefix = []byte{0x03}
 	AttributeExpirationKeyPrefix = []byte{0x04}
 	AttributeParamPrefix         = []byte{0x05}
+	AttributeMirrorKeyPrefix     = []byte{0x06}
+	RefreshOracleKeyPrefix       = []byte{0x07}
+	AttestationSourceKeyPrefix   = []byte{0x08}
+	PortStoreKey                 = []byte{0x09}
 )
 
 // AddrAttributeKey creates a key for an account attribute
@@ -93,6 +103,27 @@ func AttributeNameAddrKeyPrefix(attributeName string, addr []byte) []byte {
 	return append(key, address.MustLengthPrefix(addr)...)
 }
 
+// AttributeMirrorKey returns a key for a smart contract's attribute mirror [AttributeMirrorKeyPrefix][length + contract address bytes]
+func AttributeMirrorKey(contract []byte) []byte {
+	return append(AttributeMirrorKeyPrefix, address.MustLengthPrefix(contract)...)
+}
+
+// RefreshOracleKey returns a key for a refresh oracle of an attribute name [RefreshOracleKeyPrefix][name hash][length + oracle address bytes]
+func RefreshOracleKey(name string, oracle []byte) []byte {
+	key := RefreshOracleKeyPrefix
+	key = append(key, GetNameKeyBytes(name)...)
+	return append(key, address.MustLengthPrefix(oracle)...)
+}
+
+// AttestationSourceKey returns a key for an attestation source of an attribute name
+// [AttestationSourceKeyPrefix][name hash][length + channel id][issuer]
+func AttestationSourceKey(name, channelID, issuer string) []byte {
+	key := AttestationSourceKeyPrefix
+	key = append(key, GetNameKeyBytes(name)...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, issuer...)
+}
+
 // GetAddressFromKey returns the AccAddress from full attribute address key ([prefix][name hash][length + AccAddress bytes][attribute hash])
 func GetAddressFromKey(nameAddrKey []byte) (sdk.AccAddress, error) {
 	// start index of slice is [prefix (1)] + [name hash (32)] + [address len prefix (1)]
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strings"
 	time "time"
@@ -17,6 +18,11 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgDeleteDistinctAttributeRequest)(nil),
 	(*MsgSetAccountDataRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetAttributeMirrorRequest)(nil),
+	(*MsgSetRefreshOracleRequest)(nil),
+	(*MsgRefreshAttributesRequest)(nil),
+	(*MsgPatchAttributeRequest)(nil),
+	(*MsgSetAttestationSourceRequest)(nil),
 }
 
 func NewMsgAddAttributeRequest(account string, owner sdk.AccAddress, name string, attributeType AttributeType, value []byte) *MsgAddAttributeRequest {
@@ -164,5 +170,144 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return fmt.Errorf("invalid authority: %w", err)
 	}
+	return m.Params.Validate()
+}
+
+// NewMsgSetAttributeMirrorRequest creates a new MsgSetAttributeMirrorRequest.
+func NewMsgSetAttributeMirrorRequest(contract sdk.AccAddress, enabled bool, admin sdk.AccAddress) *MsgSetAttributeMirrorRequest {
+	return &MsgSetAttributeMirrorRequest{
+		Contract: contract.String(),
+		Enabled:  enabled,
+		Admin:    admin.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributeMirrorRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return fmt.Errorf("invalid contract address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return fmt.Errorf("invalid admin address: %w", err)
+	}
+	return nil
+}
+
+// NewMsgSetRefreshOracleRequest creates a new MsgSetRefreshOracleRequest.
+func NewMsgSetRefreshOracleRequest(name string, oracle sdk.AccAddress, enabled bool, owner sdk.AccAddress) *MsgSetRefreshOracleRequest {
+	return &MsgSetRefreshOracleRequest{
+		Name:    strings.ToLower(strings.TrimSpace(name)),
+		Oracle:  oracle.String(),
+		Enabled: enabled,
+		Owner:   owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetRefreshOracleRequest) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.Name)) == 0 {
+		return fmt.Errorf("invalid name: empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Oracle); err != nil {
+		return fmt.Errorf("invalid oracle address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
 	return nil
 }
+
+// NewMsgRefreshAttributesRequest creates a new MsgRefreshAttributesRequest.
+func NewMsgRefreshAttributesRequest(name string, accounts []string, expirationDate time.Time, oracle sdk.AccAddress) *MsgRefreshAttributesRequest {
+	return &MsgRefreshAttributesRequest{
+		Name:           strings.ToLower(strings.TrimSpace(name)),
+		Accounts:       accounts,
+		ExpirationDate: expirationDate,
+		Oracle:         oracle.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRefreshAttributesRequest) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.Name)) == 0 {
+		return fmt.Errorf("invalid name: empty")
+	}
+	if len(msg.Accounts) == 0 {
+		return fmt.Errorf("invalid accounts: empty")
+	}
+	seen := make(map[string]bool, len(msg.Accounts))
+	for i, account := range msg.Accounts {
+		if err := ValidateAttributeAddress(account); err != nil {
+			return fmt.Errorf("invalid accounts[%d]: %w", i, err)
+		}
+		if seen[account] {
+			return fmt.Errorf("invalid accounts[%d]: duplicate account %s", i, account)
+		}
+		seen[account] = true
+	}
+	if msg.ExpirationDate.IsZero() {
+		return fmt.Errorf("invalid expiration date: empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Oracle); err != nil {
+		return fmt.Errorf("invalid oracle address: %w", err)
+	}
+	return nil
+}
+
+// NewMsgPatchAttributeRequest creates a new MsgPatchAttributeRequest.
+func NewMsgPatchAttributeRequest(account string, owner sdk.AccAddress, name string, valueHash []byte, patch []byte) *MsgPatchAttributeRequest {
+	return &MsgPatchAttributeRequest{
+		Name:      strings.ToLower(strings.TrimSpace(name)),
+		ValueHash: valueHash,
+		Patch:     patch,
+		Account:   account,
+		Owner:     owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgPatchAttributeRequest) ValidateBasic() error {
+	if len(strings.TrimSpace(msg.Name)) == 0 {
+		return fmt.Errorf("invalid name: empty")
+	}
+	if len(msg.ValueHash) != sha256.Size {
+		return fmt.Errorf("invalid value hash: expected %d bytes, got %d", sha256.Size, len(msg.ValueHash))
+	}
+	if _, err := ParseJSONPatch(msg.Patch); err != nil {
+		return err
+	}
+	if err := ValidateAttributeAddress(msg.Account); err != nil {
+		return fmt.Errorf("invalid attribute address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+// NewMsgSetAttestationSourceRequest creates a new MsgSetAttestationSourceRequest.
+func NewMsgSetAttestationSourceRequest(name, channelID, issuer string, enabled bool, owner sdk.AccAddress) *MsgSetAttestationSourceRequest {
+	return &MsgSetAttestationSourceRequest{
+		Name:      strings.ToLower(strings.TrimSpace(name)),
+		ChannelId: channelID,
+		Issuer:    issuer,
+		Enabled:   enabled,
+		Owner:     owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttestationSourceRequest) ValidateBasic() error {
+	if err := msg.GetSource().ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+// GetSource returns the AttestationSource being updated by this message.
+func (msg MsgSetAttestationSourceRequest) GetSource() AttestationSource {
+	return AttestationSource{Name: msg.Name, ChannelId: msg.ChannelId, Issuer: msg.Issuer}
+}
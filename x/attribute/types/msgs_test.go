@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +33,11 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgDeleteDistinctAttributeRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgSetAccountDataRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeMirrorRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgSetRefreshOracleRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgRefreshAttributesRequest{Oracle: signer} },
+		func(signer string) sdk.Msg { return &MsgPatchAttributeRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttestationSourceRequest{Owner: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -161,6 +167,164 @@ func TestMsgSetAccountDataRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgRefreshAttributesRequest_ValidateBasic(t *testing.T) {
+	account := sdk.AccAddress("account").String()
+	oracle := sdk.AccAddress("oracle").String()
+	expiration := time.Date(2050, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		msg  MsgRefreshAttributesRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgRefreshAttributesRequest{Name: "kyc.pb", Accounts: []string{account}, ExpirationDate: expiration, Oracle: oracle},
+		},
+		{
+			name: "no name",
+			msg:  MsgRefreshAttributesRequest{Name: " ", Accounts: []string{account}, ExpirationDate: expiration, Oracle: oracle},
+			exp:  "invalid name: empty",
+		},
+		{
+			name: "no accounts",
+			msg:  MsgRefreshAttributesRequest{Name: "kyc.pb", ExpirationDate: expiration, Oracle: oracle},
+			exp:  "invalid accounts: empty",
+		},
+		{
+			name: "bad account",
+			msg:  MsgRefreshAttributesRequest{Name: "kyc.pb", Accounts: []string{account, "notabech32"}, ExpirationDate: expiration, Oracle: oracle},
+			exp:  `invalid accounts[1]: must be either an account address or scope metadata address: "notabech32"`,
+		},
+		{
+			name: "duplicate account",
+			msg:  MsgRefreshAttributesRequest{Name: "kyc.pb", Accounts: []string{account, account}, ExpirationDate: expiration, Oracle: oracle},
+			exp:  "invalid accounts[1]: duplicate account " + account,
+		},
+		{
+			name: "no expiration date",
+			msg:  MsgRefreshAttributesRequest{Name: "kyc.pb", Accounts: []string{account}, Oracle: oracle},
+			exp:  "invalid expiration date: empty",
+		},
+		{
+			name: "bad oracle",
+			msg:  MsgRefreshAttributesRequest{Name: "kyc.pb", Accounts: []string{account}, ExpirationDate: expiration, Oracle: "notabech32"},
+			exp:  "invalid oracle address: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetAttestationSourceRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetAttestationSourceRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetAttestationSourceRequest{Name: "kyc.pb", ChannelId: "channel-3", Issuer: "issuer", Enabled: true, Owner: owner},
+		},
+		{
+			name: "no name",
+			msg:  MsgSetAttestationSourceRequest{Name: " ", ChannelId: "channel-3", Issuer: "issuer", Owner: owner},
+			exp:  "invalid name: empty",
+		},
+		{
+			name: "bad channel id",
+			msg:  MsgSetAttestationSourceRequest{Name: "kyc.pb", ChannelId: "channel/3", Issuer: "issuer", Owner: owner},
+			exp:  "invalid channel id: identifier channel/3 cannot contain separator '/'",
+		},
+		{
+			name: "no issuer",
+			msg:  MsgSetAttestationSourceRequest{Name: "kyc.pb", ChannelId: "channel-3", Issuer: "", Owner: owner},
+			exp:  "invalid issuer: empty",
+		},
+		{
+			name: "bad owner",
+			msg:  MsgSetAttestationSourceRequest{Name: "kyc.pb", ChannelId: "channel-3", Issuer: "issuer", Owner: "notabech32"},
+			exp:  "invalid owner address: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgPatchAttributeRequest_ValidateBasic(t *testing.T) {
+	account := sdk.AccAddress("account").String()
+	owner := sdk.AccAddress("owner").String()
+	hash := make([]byte, 32)
+	patch := []byte(`[{"op":"replace","path":"/a","value":1}]`)
+
+	tests := []struct {
+		name string
+		msg  MsgPatchAttributeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgPatchAttributeRequest{Name: "kyc.pb", ValueHash: hash, Patch: patch, Account: account, Owner: owner},
+		},
+		{
+			name: "no name",
+			msg:  MsgPatchAttributeRequest{Name: " ", ValueHash: hash, Patch: patch, Account: account, Owner: owner},
+			exp:  "invalid name: empty",
+		},
+		{
+			name: "short value hash",
+			msg:  MsgPatchAttributeRequest{Name: "kyc.pb", ValueHash: hash[:31], Patch: patch, Account: account, Owner: owner},
+			exp:  "invalid value hash: expected 32 bytes, got 31",
+		},
+		{
+			name: "bad patch",
+			msg:  MsgPatchAttributeRequest{Name: "kyc.pb", ValueHash: hash, Patch: []byte(`[{"op":"merge","path":"/a"}]`), Account: account, Owner: owner},
+			exp:  `invalid json patch operation 0: unknown op "merge"`,
+		},
+		{
+			name: "bad account",
+			msg:  MsgPatchAttributeRequest{Name: "kyc.pb", ValueHash: hash, Patch: patch, Account: "notabech32", Owner: owner},
+			exp:  `invalid attribute address: must be either an account address or scope metadata address: "notabech32"`,
+		},
+		{
+			name: "bad owner",
+			msg:  MsgPatchAttributeRequest{Name: "kyc.pb", ValueHash: hash, Patch: patch, Account: account, Owner: "notabech32"},
+			exp:  "invalid owner address: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
 func TestMsgUpdateParamsRequest(t *testing.T) {
 	tests := []struct {
 		name           string
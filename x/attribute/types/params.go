@@ -1,9 +1,22 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	DefaultMaxValueLength = 10000
 )
 
+// The size classes of attribute values.
+const (
+	ValueSizeClassSmall  = "small"
+	ValueSizeClassMedium = "medium"
+	ValueSizeClassLarge  = "large"
+)
+
 // NewParams create a new Params object
 func NewParams(
 	maxValueLength uint32,
@@ -19,3 +32,70 @@ func DefaultParams() Params {
 		DefaultMaxValueLength,
 	)
 }
+
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if err := p.ValueSizeFees.Validate(); err != nil {
+		return fmt.Errorf("invalid value size fees: %w", err)
+	}
+	return nil
+}
+
+// NewValueSizeFees creates a new ValueSizeFees object.
+func NewValueSizeFees(mediumMinLength, largeMinLength uint32, smallFee, mediumFee, largeFee sdk.Coins) ValueSizeFees {
+	return ValueSizeFees{
+		MediumMinLength: mediumMinLength,
+		LargeMinLength:  largeMinLength,
+		SmallFee:        smallFee,
+		MediumFee:       mediumFee,
+		LargeFee:        largeFee,
+	}
+}
+
+// Validate returns an error if there's something wrong with these value size fees.
+func (f ValueSizeFees) Validate() error {
+	if f.MediumMinLength > 0 && f.LargeMinLength > 0 && f.LargeMinLength <= f.MediumMinLength {
+		return fmt.Errorf("large min length %d must be greater than medium min length %d", f.LargeMinLength, f.MediumMinLength)
+	}
+	if err := f.SmallFee.Validate(); err != nil {
+		return fmt.Errorf("invalid %s fee: %w", ValueSizeClassSmall, err)
+	}
+	if err := f.MediumFee.Validate(); err != nil {
+		return fmt.Errorf("invalid %s fee: %w", ValueSizeClassMedium, err)
+	}
+	if f.MediumMinLength == 0 && !f.MediumFee.IsZero() {
+		return fmt.Errorf("%s fee %s cannot be used without a medium min length", ValueSizeClassMedium, f.MediumFee)
+	}
+	if err := f.LargeFee.Validate(); err != nil {
+		return fmt.Errorf("invalid %s fee: %w", ValueSizeClassLarge, err)
+	}
+	if f.LargeMinLength == 0 && !f.LargeFee.IsZero() {
+		return fmt.Errorf("%s fee %s cannot be used without a large min length", ValueSizeClassLarge, f.LargeFee)
+	}
+	return nil
+}
+
+// GetSizeClass returns the size class of a value with the provided length.
+func (f ValueSizeFees) GetSizeClass(valueLength int) string {
+	switch {
+	case f.LargeMinLength > 0 && valueLength >= int(f.LargeMinLength):
+		return ValueSizeClassLarge
+	case f.MediumMinLength > 0 && valueLength >= int(f.MediumMinLength):
+		return ValueSizeClassMedium
+	default:
+		return ValueSizeClassSmall
+	}
+}
+
+// GetFee returns the size class of a value with the provided length, and the surcharge for writing it.
+func (f ValueSizeFees) GetFee(valueLength int) (string, sdk.Coins) {
+	sizeClass := f.GetSizeClass(valueLength)
+	switch sizeClass {
+	case ValueSizeClassLarge:
+		return sizeClass, f.LargeFee
+	case ValueSizeClassMedium:
+		return sizeClass, f.MediumFee
+	default:
+		return sizeClass, f.SmallFee
+	}
+}
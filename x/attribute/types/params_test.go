@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValueSizeFeesValidate(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))
+	badFee := sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}
+
+	tests := []struct {
+		name   string
+		fees   ValueSizeFees
+		expErr string
+	}{
+		{name: "empty", fees: ValueSizeFees{}},
+		{name: "all size classes", fees: NewValueSizeFees(10, 20, fee, fee, fee)},
+		{name: "only small fee", fees: NewValueSizeFees(0, 0, fee, nil, nil)},
+		{name: "only large size class", fees: NewValueSizeFees(0, 20, nil, nil, fee)},
+		{
+			name:   "large min length equals medium",
+			fees:   NewValueSizeFees(20, 20, nil, nil, nil),
+			expErr: "large min length 20 must be greater than medium min length 20",
+		},
+		{
+			name:   "large min length less than medium",
+			fees:   NewValueSizeFees(20, 10, nil, nil, nil),
+			expErr: "large min length 10 must be greater than medium min length 20",
+		},
+		{
+			name:   "invalid small fee",
+			fees:   NewValueSizeFees(10, 20, badFee, nil, nil),
+			expErr: "invalid small fee: coin -1nhash amount is not positive",
+		},
+		{
+			name:   "invalid medium fee",
+			fees:   NewValueSizeFees(10, 20, nil, badFee, nil),
+			expErr: "invalid medium fee: coin -1nhash amount is not positive",
+		},
+		{
+			name:   "invalid large fee",
+			fees:   NewValueSizeFees(10, 20, nil, nil, badFee),
+			expErr: "invalid large fee: coin -1nhash amount is not positive",
+		},
+		{
+			name:   "medium fee without medium min length",
+			fees:   NewValueSizeFees(0, 20, nil, fee, nil),
+			expErr: "medium fee 5nhash cannot be used without a medium min length",
+		},
+		{
+			name:   "large fee without large min length",
+			fees:   NewValueSizeFees(10, 0, nil, nil, fee),
+			expErr: "large fee 5nhash cannot be used without a large min length",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.fees.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+
+			params := Params{MaxValueLength: DefaultMaxValueLength, ValueSizeFees: tc.fees}
+			err = params.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, "invalid value size fees: "+tc.expErr, "Params.Validate")
+			} else {
+				assert.NoError(t, err, "Params.Validate")
+			}
+		})
+	}
+}
+
+func TestValueSizeFeesGetFee(t *testing.T) {
+	small := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1))
+	medium := sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))
+	large := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	tests := []struct {
+		name         string
+		fees         ValueSizeFees
+		valueLength  int
+		expSizeClass string
+		expFee       sdk.Coins
+	}{
+		{name: "empty fees", fees: ValueSizeFees{}, valueLength: 1000, expSizeClass: ValueSizeClassSmall, expFee: nil},
+		{name: "below medium", fees: NewValueSizeFees(10, 20, small, medium, large), valueLength: 9, expSizeClass: ValueSizeClassSmall, expFee: small},
+		{name: "at medium", fees: NewValueSizeFees(10, 20, small, medium, large), valueLength: 10, expSizeClass: ValueSizeClassMedium, expFee: medium},
+		{name: "below large", fees: NewValueSizeFees(10, 20, small, medium, large), valueLength: 19, expSizeClass: ValueSizeClassMedium, expFee: medium},
+		{name: "at large", fees: NewValueSizeFees(10, 20, small, medium, large), valueLength: 20, expSizeClass: ValueSizeClassLarge, expFee: large},
+		{name: "no medium class", fees: NewValueSizeFees(0, 20, small, nil, large), valueLength: 15, expSizeClass: ValueSizeClassSmall, expFee: small},
+		{name: "no large class", fees: NewValueSizeFees(10, 0, small, medium, nil), valueLength: 5000, expSizeClass: ValueSizeClassMedium, expFee: medium},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expSizeClass, tc.fees.GetSizeClass(tc.valueLength), "GetSizeClass")
+			sizeClass, fee := tc.fees.GetFee(tc.valueLength)
+			assert.Equal(t, tc.expSizeClass, sizeClass, "GetFee size class")
+			assert.Equal(t, tc.expFee.String(), fee.String(), "GetFee fee")
+		})
+	}
+}
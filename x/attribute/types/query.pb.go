@@ -488,6 +488,125 @@ func (m *QueryScanResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryVerifyHashedAttributeRequest is the request type for the Query/VerifyHashedAttribute method.
+type QueryVerifyHashedAttributeRequest struct {
+	// account defines the address to query for.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// name is the attribute name to query for.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// salt is the salt that was prepended to the preimage when the attribute value was hashed.
+	Salt []byte `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	// preimage is the presented value to check against the hashed attribute values.
+	Preimage []byte `protobuf:"bytes,4,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (m *QueryVerifyHashedAttributeRequest) Reset()         { *m = QueryVerifyHashedAttributeRequest{} }
+func (m *QueryVerifyHashedAttributeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyHashedAttributeRequest) ProtoMessage()    {}
+func (*QueryVerifyHashedAttributeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{8}
+}
+func (m *QueryVerifyHashedAttributeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyHashedAttributeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyHashedAttributeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyHashedAttributeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyHashedAttributeRequest.Merge(m, src)
+}
+func (m *QueryVerifyHashedAttributeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyHashedAttributeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyHashedAttributeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyHashedAttributeRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyHashedAttributeRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryVerifyHashedAttributeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryVerifyHashedAttributeRequest) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *QueryVerifyHashedAttributeRequest) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+// QueryVerifyHashedAttributeResponse is the response type for the Query/VerifyHashedAttribute method.
+type QueryVerifyHashedAttributeResponse struct {
+	// verified is true if an unexpired hashed attribute with the requested name matches the salt and preimage.
+	Verified bool `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (m *QueryVerifyHashedAttributeResponse) Reset()         { *m = QueryVerifyHashedAttributeResponse{} }
+func (m *QueryVerifyHashedAttributeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyHashedAttributeResponse) ProtoMessage()    {}
+func (*QueryVerifyHashedAttributeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{9}
+}
+func (m *QueryVerifyHashedAttributeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyHashedAttributeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyHashedAttributeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyHashedAttributeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyHashedAttributeResponse.Merge(m, src)
+}
+func (m *QueryVerifyHashedAttributeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyHashedAttributeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyHashedAttributeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyHashedAttributeResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyHashedAttributeResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 // QueryAttributeAccountsRequest is the request type for the Query/AttributeAccounts method.
 type QueryAttributeAccountsRequest struct {
 	// name is the attribute name to query for
@@ -500,7 +619,7 @@ func (m *QueryAttributeAccountsRequest) Reset()         { *m = QueryAttributeAcc
 func (m *QueryAttributeAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAttributeAccountsRequest) ProtoMessage()    {}
 func (*QueryAttributeAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{8}
+	return fileDescriptor_79f9aff39a1796c1, []int{10}
 }
 func (m *QueryAttributeAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +674,7 @@ func (m *QueryAttributeAccountsResponse) Reset()         { *m = QueryAttributeAc
 func (m *QueryAttributeAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAttributeAccountsResponse) ProtoMessage()    {}
 func (*QueryAttributeAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{9}
+	return fileDescriptor_79f9aff39a1796c1, []int{11}
 }
 func (m *QueryAttributeAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +727,7 @@ func (m *QueryAccountDataRequest) Reset()         { *m = QueryAccountDataRequest
 func (m *QueryAccountDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDataRequest) ProtoMessage()    {}
 func (*QueryAccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{10}
+	return fileDescriptor_79f9aff39a1796c1, []int{12}
 }
 func (m *QueryAccountDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +773,7 @@ func (m *QueryAccountDataResponse) Reset()         { *m = QueryAccountDataRespon
 func (m *QueryAccountDataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDataResponse) ProtoMessage()    {}
 func (*QueryAccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79f9aff39a1796c1, []int{11}
+	return fileDescriptor_79f9aff39a1796c1, []int{13}
 }
 func (m *QueryAccountDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,6 +809,218 @@ func (m *QueryAccountDataResponse) GetValue() string {
 	return ""
 }
 
+// QueryAttributeMirrorRequest is the request type for the Query/AttributeMirror method.
+type QueryAttributeMirrorRequest struct {
+	// contract is the bech32 address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryAttributeMirrorRequest) Reset()         { *m = QueryAttributeMirrorRequest{} }
+func (m *QueryAttributeMirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeMirrorRequest) ProtoMessage()    {}
+func (*QueryAttributeMirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{14}
+}
+func (m *QueryAttributeMirrorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeMirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeMirrorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeMirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeMirrorRequest.Merge(m, src)
+}
+func (m *QueryAttributeMirrorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeMirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeMirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeMirrorRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeMirrorRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// QueryAttributeMirrorResponse is the response type for the Query/AttributeMirror method.
+type QueryAttributeMirrorResponse struct {
+	// enabled is whether the contract inherits the attributes of its admin.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// source is the bech32 address of the account whose attributes are currently inherited.
+	// It is empty if the contract does not currently inherit any attributes.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *QueryAttributeMirrorResponse) Reset()         { *m = QueryAttributeMirrorResponse{} }
+func (m *QueryAttributeMirrorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeMirrorResponse) ProtoMessage()    {}
+func (*QueryAttributeMirrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{15}
+}
+func (m *QueryAttributeMirrorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeMirrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeMirrorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeMirrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeMirrorResponse.Merge(m, src)
+}
+func (m *QueryAttributeMirrorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeMirrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeMirrorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeMirrorResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeMirrorResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryAttributeMirrorResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+// QueryAttributeDependentsRequest is the request type for the Query/AttributeDependents method.
+type QueryAttributeDependentsRequest struct {
+	// name is the attribute name to look up.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeDependentsRequest) Reset()         { *m = QueryAttributeDependentsRequest{} }
+func (m *QueryAttributeDependentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeDependentsRequest) ProtoMessage()    {}
+func (*QueryAttributeDependentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{16}
+}
+func (m *QueryAttributeDependentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeDependentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeDependentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeDependentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeDependentsRequest.Merge(m, src)
+}
+func (m *QueryAttributeDependentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeDependentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeDependentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeDependentsRequest proto.InternalMessageInfo
+
+func (m *QueryAttributeDependentsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryAttributeDependentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttributeDependentsResponse is the response type for the Query/AttributeDependents method.
+type QueryAttributeDependentsResponse struct {
+	// dependents are the things that require the attribute name.
+	Dependents []AttributeDependent `protobuf:"bytes,1,rep,name=dependents,proto3" json:"dependents"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttributeDependentsResponse) Reset()         { *m = QueryAttributeDependentsResponse{} }
+func (m *QueryAttributeDependentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttributeDependentsResponse) ProtoMessage()    {}
+func (*QueryAttributeDependentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79f9aff39a1796c1, []int{17}
+}
+func (m *QueryAttributeDependentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttributeDependentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttributeDependentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttributeDependentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttributeDependentsResponse.Merge(m, src)
+}
+func (m *QueryAttributeDependentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttributeDependentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttributeDependentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttributeDependentsResponse proto.InternalMessageInfo
+
+func (m *QueryAttributeDependentsResponse) GetDependents() []AttributeDependent {
+	if m != nil {
+		return m.Dependents
+	}
+	return nil
+}
+
+func (m *QueryAttributeDependentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.attribute.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.attribute.v1.QueryParamsResponse")
@@ -699,10 +1030,16 @@ func init() {
 	proto.RegisterType((*QueryAttributesResponse)(nil), "provenance.attribute.v1.QueryAttributesResponse")
 	proto.RegisterType((*QueryScanRequest)(nil), "provenance.attribute.v1.QueryScanRequest")
 	proto.RegisterType((*QueryScanResponse)(nil), "provenance.attribute.v1.QueryScanResponse")
+	proto.RegisterType((*QueryVerifyHashedAttributeRequest)(nil), "provenance.attribute.v1.QueryVerifyHashedAttributeRequest")
+	proto.RegisterType((*QueryVerifyHashedAttributeResponse)(nil), "provenance.attribute.v1.QueryVerifyHashedAttributeResponse")
 	proto.RegisterType((*QueryAttributeAccountsRequest)(nil), "provenance.attribute.v1.QueryAttributeAccountsRequest")
 	proto.RegisterType((*QueryAttributeAccountsResponse)(nil), "provenance.attribute.v1.QueryAttributeAccountsResponse")
 	proto.RegisterType((*QueryAccountDataRequest)(nil), "provenance.attribute.v1.QueryAccountDataRequest")
 	proto.RegisterType((*QueryAccountDataResponse)(nil), "provenance.attribute.v1.QueryAccountDataResponse")
+	proto.RegisterType((*QueryAttributeMirrorRequest)(nil), "provenance.attribute.v1.QueryAttributeMirrorRequest")
+	proto.RegisterType((*QueryAttributeMirrorResponse)(nil), "provenance.attribute.v1.QueryAttributeMirrorResponse")
+	proto.RegisterType((*QueryAttributeDependentsRequest)(nil), "provenance.attribute.v1.QueryAttributeDependentsRequest")
+	proto.RegisterType((*QueryAttributeDependentsResponse)(nil), "provenance.attribute.v1.QueryAttributeDependentsResponse")
 }
 
 func init() {
@@ -710,55 +1047,71 @@ func init() {
 }
 
 var fileDescriptor_79f9aff39a1796c1 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0x3b, 0x05, 0xca, 0x8f, 0x47, 0x7e, 0xbf, 0xfc, 0x1c, 0x11, 0x9a, 0x8d, 0x16, 0x5c,
-	0xa3, 0x20, 0xca, 0x0e, 0x2d, 0x82, 0x09, 0xea, 0x01, 0x62, 0xd4, 0x93, 0xc1, 0xea, 0xc9, 0x8b,
-	0x99, 0xae, 0xcb, 0xba, 0x09, 0xdd, 0x59, 0x3a, 0xbb, 0x0d, 0xd8, 0xf4, 0x62, 0xe2, 0x0d, 0x8d,
-	0x89, 0x7f, 0x81, 0x17, 0x13, 0xfd, 0x2b, 0xbc, 0x68, 0x38, 0x92, 0x78, 0xf1, 0x64, 0x0c, 0xf8,
-	0x7f, 0x68, 0x76, 0x66, 0xba, 0xdd, 0xb6, 0x2e, 0x5b, 0x88, 0x17, 0x6e, 0x3b, 0xd3, 0xf7, 0xe6,
-	0x7d, 0xde, 0x77, 0xde, 0x7b, 0x53, 0xb8, 0xe0, 0xd5, 0x58, 0xdd, 0x72, 0xa9, 0x6b, 0x5a, 0x84,
-	0xfa, 0x7e, 0xcd, 0xa9, 0x04, 0xbe, 0x45, 0xea, 0x45, 0xb2, 0x19, 0x58, 0xb5, 0x6d, 0xc3, 0xab,
-	0x31, 0x9f, 0xe1, 0x89, 0xb6, 0x91, 0x11, 0x19, 0x19, 0xf5, 0xa2, 0x36, 0x6b, 0x32, 0x5e, 0x65,
-	0x9c, 0x54, 0x28, 0xb7, 0xa4, 0x07, 0xa9, 0x17, 0x2b, 0x96, 0x4f, 0x8b, 0xc4, 0xa3, 0xb6, 0xe3,
-	0x52, 0xdf, 0x61, 0xae, 0x3c, 0x44, 0x1b, 0xb3, 0x99, 0xcd, 0xc4, 0x27, 0x09, 0xbf, 0xd4, 0xee,
-	0x59, 0x9b, 0x31, 0x7b, 0xc3, 0x22, 0xd4, 0x73, 0x08, 0x75, 0x5d, 0xe6, 0x0b, 0x17, 0xae, 0x7e,
-	0x9d, 0x4e, 0xa2, 0x6b, 0x53, 0x08, 0x43, 0x7d, 0x0c, 0xf0, 0x83, 0x30, 0xfc, 0x1a, 0xad, 0xd1,
-	0x2a, 0x2f, 0x5b, 0x9b, 0x81, 0xc5, 0x7d, 0xfd, 0x11, 0x9c, 0xee, 0xd8, 0xe5, 0x1e, 0x73, 0xb9,
-	0x85, 0x6f, 0x41, 0xce, 0x13, 0x3b, 0x79, 0x34, 0x85, 0x66, 0x46, 0x4b, 0x93, 0x46, 0x42, 0x7e,
-	0x86, 0x74, 0x5c, 0x1d, 0xdc, 0xfd, 0x3e, 0x99, 0x29, 0x2b, 0x27, 0xfd, 0x15, 0x82, 0x33, 0xe2,
-	0xd8, 0x95, 0x96, 0xa9, 0x8a, 0x87, 0xf3, 0x30, 0x4c, 0x4d, 0x93, 0x05, 0xae, 0x2f, 0x4e, 0x1e,
-	0x29, 0xb7, 0x96, 0x18, 0xc3, 0xa0, 0x4b, 0xab, 0x56, 0x3e, 0x2b, 0xb6, 0xc5, 0x37, 0xbe, 0x03,
-	0xd0, 0x16, 0x29, 0x3f, 0x20, 0x50, 0x2e, 0x19, 0x52, 0x51, 0x23, 0x54, 0xd4, 0x90, 0x77, 0xa0,
-	0x14, 0x35, 0xd6, 0xa8, 0xdd, 0x8a, 0x54, 0x8e, 0x79, 0xea, 0x9f, 0x11, 0x8c, 0x77, 0xf3, 0xa8,
-	0x4c, 0x93, 0x81, 0xee, 0x01, 0x44, 0x99, 0xf2, 0x7c, 0x76, 0x6a, 0x60, 0x66, 0xb4, 0xa4, 0x27,
-	0xea, 0x10, 0x9d, 0xac, 0xa4, 0x88, 0xf9, 0xe2, 0xbb, 0x7f, 0x48, 0x63, 0x3a, 0x35, 0x0d, 0x09,
-	0xd8, 0x91, 0xc7, 0xf3, 0xee, 0x34, 0x78, 0xba, 0xae, 0x9d, 0x1a, 0x66, 0x8f, 0xad, 0xe1, 0x17,
-	0x04, 0x13, 0x3d, 0xc1, 0x4f, 0xa2, 0x88, 0x3b, 0x08, 0xfe, 0x17, 0x89, 0x3c, 0x34, 0xa9, 0x9b,
-	0xae, 0xdf, 0x38, 0xe4, 0x78, 0xb0, 0xbe, 0xee, 0x6c, 0xa9, 0xca, 0x54, 0xab, 0xbf, 0x56, 0x9b,
-	0x9f, 0x10, 0x9c, 0x8a, 0xe1, 0x9c, 0x44, 0x45, 0x5f, 0x23, 0x38, 0xd7, 0x59, 0x1a, 0x2b, 0x12,
-	0x36, 0x2a, 0xcf, 0x8b, 0xf0, 0x5f, 0x14, 0xf8, 0x89, 0x68, 0x73, 0x99, 0xd5, 0xbf, 0xd1, 0xee,
-	0xfd, 0xde, 0x7e, 0x37, 0x8f, 0xad, 0xe9, 0x4b, 0x04, 0x85, 0x24, 0x20, 0x25, 0xb0, 0x06, 0xff,
-	0x28, 0x45, 0xc3, 0x19, 0x37, 0x30, 0x33, 0x52, 0x8e, 0xd6, 0x5d, 0xc2, 0x98, 0xc7, 0x17, 0x66,
-	0xa1, 0xd5, 0x32, 0xf2, 0xe4, 0xdb, 0xd4, 0xa7, 0xa9, 0x05, 0xa7, 0xcf, 0x43, 0xbe, 0xd7, 0x49,
-	0x51, 0x8f, 0xc1, 0x50, 0x9d, 0x6e, 0x04, 0x2d, 0xf9, 0xe4, 0xa2, 0xf4, 0x6b, 0x18, 0x86, 0x84,
-	0x0b, 0xde, 0x41, 0x90, 0x93, 0x13, 0x19, 0x5f, 0x49, 0xac, 0x89, 0xde, 0x67, 0x40, 0xbb, 0xda,
-	0x9f, 0xb1, 0xa4, 0xd0, 0xa7, 0x5f, 0x7c, 0xfd, 0xf9, 0x36, 0x7b, 0x1e, 0x4f, 0x92, 0xa4, 0xc7,
-	0x47, 0xbe, 0x03, 0xf8, 0x03, 0x82, 0x91, 0xe8, 0x0a, 0xb0, 0x71, 0x78, 0x90, 0xee, 0xb7, 0x42,
-	0x23, 0x7d, 0xdb, 0x2b, 0xae, 0x1b, 0x82, 0x6b, 0x11, 0x2f, 0x90, 0xd4, 0x47, 0x91, 0x34, 0x94,
-	0xdc, 0x4d, 0xd2, 0x08, 0xeb, 0xb1, 0x89, 0xdf, 0x23, 0x80, 0xf6, 0x68, 0xc3, 0xfd, 0x06, 0x8f,
-	0x24, 0x9c, 0xef, 0xdf, 0x41, 0xe1, 0x2e, 0x0a, 0x5c, 0x82, 0xe7, 0xd2, 0x71, 0x79, 0x9b, 0x17,
-	0xbf, 0x43, 0x30, 0x18, 0xce, 0x0a, 0x7c, 0xf9, 0xf0, 0x88, 0xb1, 0xf1, 0xa6, 0xcd, 0xf6, 0x63,
-	0xaa, 0xb0, 0x56, 0x05, 0xd6, 0x4d, 0xbc, 0x7c, 0x24, 0x15, 0xb9, 0x49, 0x5d, 0xd2, 0x90, 0xb3,
-	0xb1, 0x89, 0xc3, 0xa1, 0xd6, 0xd3, 0x7b, 0x78, 0xa9, 0x4f, 0x89, 0xba, 0xa6, 0x87, 0x76, 0xfd,
-	0xc8, 0x7e, 0x2a, 0x95, 0x65, 0x91, 0xca, 0x35, 0x5c, 0x4a, 0x4e, 0x45, 0xb9, 0x90, 0x46, 0xe7,
-	0x7c, 0x6a, 0xe2, 0x8f, 0x08, 0x46, 0x63, 0x2d, 0x88, 0xd3, 0xee, 0xb7, 0xa7, 0xc5, 0xb5, 0xe2,
-	0x11, 0x3c, 0x14, 0xf0, 0x92, 0x00, 0x9e, 0xc7, 0x46, 0x1a, 0xf0, 0x53, 0xea, 0xd3, 0xb6, 0xfa,
-	0xab, 0xd5, 0xdd, 0xfd, 0x02, 0xda, 0xdb, 0x2f, 0xa0, 0x1f, 0xfb, 0x05, 0xf4, 0xe6, 0xa0, 0x90,
-	0xd9, 0x3b, 0x28, 0x64, 0xbe, 0x1d, 0x14, 0x32, 0xa0, 0x39, 0x2c, 0x09, 0x63, 0x0d, 0x3d, 0x5e,
-	0xb4, 0x1d, 0xff, 0x59, 0x50, 0x31, 0x4c, 0x56, 0x8d, 0x45, 0x9c, 0x73, 0x58, 0x3c, 0xfe, 0x56,
-	0x8c, 0xc0, 0xdf, 0xf6, 0x2c, 0x5e, 0xc9, 0x89, 0xbf, 0x94, 0x0b, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0x45, 0xc2, 0xe9, 0x1b, 0x0b, 0x00, 0x00,
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x6e, 0x48, 0x5e, 0xca, 0xaf, 0xd7, 0xb4, 0xb5, 0x96, 0xe2, 0xa4, 0x8b, 0x20,
+	0x21, 0xb4, 0x3b, 0xb1, 0xd3, 0x14, 0x9a, 0x82, 0x44, 0xa3, 0x8a, 0xf4, 0x02, 0x4a, 0x0d, 0xe2,
+	0xc0, 0x05, 0x8d, 0xd7, 0x13, 0x77, 0xa5, 0x78, 0x67, 0xbb, 0xbb, 0xb6, 0x1a, 0x2c, 0x1f, 0x40,
+	0xe2, 0x56, 0x10, 0x12, 0x7f, 0x01, 0x17, 0x24, 0x38, 0xf2, 0x07, 0x54, 0x5c, 0x40, 0x3d, 0x70,
+	0xa8, 0x04, 0x07, 0x4e, 0x08, 0x25, 0xfc, 0x21, 0xc8, 0xb3, 0xb3, 0x3f, 0xfc, 0x63, 0xbd, 0x6b,
+	0xb7, 0x97, 0xdc, 0xf6, 0x8d, 0xe7, 0xcd, 0xfb, 0xbe, 0x6f, 0xde, 0xcc, 0x37, 0x86, 0xd7, 0x1c,
+	0x57, 0xb4, 0xb9, 0xcd, 0x6c, 0x93, 0x53, 0xe6, 0xfb, 0xae, 0x55, 0x6b, 0xf9, 0x9c, 0xb6, 0xcb,
+	0xf4, 0x7e, 0x8b, 0xbb, 0x47, 0x86, 0xe3, 0x0a, 0x5f, 0xe0, 0xc5, 0x78, 0x92, 0x11, 0x4d, 0x32,
+	0xda, 0x65, 0x6d, 0xc3, 0x14, 0x5e, 0x53, 0x78, 0xb4, 0xc6, 0x3c, 0x1e, 0x64, 0xd0, 0x76, 0xb9,
+	0xc6, 0x7d, 0x56, 0xa6, 0x0e, 0x6b, 0x58, 0x36, 0xf3, 0x2d, 0x61, 0x07, 0x8b, 0x68, 0xcb, 0x0d,
+	0xd1, 0x10, 0xf2, 0x93, 0xf6, 0xbe, 0xd4, 0xe8, 0xa5, 0x86, 0x10, 0x8d, 0x43, 0x4e, 0x99, 0x63,
+	0x51, 0x66, 0xdb, 0xc2, 0x97, 0x29, 0x9e, 0xfa, 0x75, 0x2d, 0x0d, 0x5d, 0x8c, 0x42, 0x4e, 0xd4,
+	0x97, 0x01, 0xef, 0xf6, 0xca, 0xef, 0x33, 0x97, 0x35, 0xbd, 0x2a, 0xbf, 0xdf, 0xe2, 0x9e, 0xaf,
+	0x7f, 0x02, 0xe7, 0xfa, 0x46, 0x3d, 0x47, 0xd8, 0x1e, 0xc7, 0xf7, 0x60, 0xde, 0x91, 0x23, 0x45,
+	0xb2, 0x4a, 0xd6, 0x97, 0x2a, 0x2b, 0x46, 0x0a, 0x3f, 0x23, 0x48, 0xdc, 0x2d, 0x3c, 0xfe, 0x67,
+	0x65, 0xa6, 0xaa, 0x92, 0xf4, 0x6f, 0x08, 0x9c, 0x97, 0xcb, 0xde, 0x0a, 0xa7, 0xaa, 0x7a, 0x58,
+	0x84, 0xe7, 0x98, 0x69, 0x8a, 0x96, 0xed, 0xcb, 0x95, 0x17, 0xab, 0x61, 0x88, 0x08, 0x05, 0x9b,
+	0x35, 0x79, 0x71, 0x56, 0x0e, 0xcb, 0x6f, 0xfc, 0x00, 0x20, 0x16, 0xa9, 0x38, 0x27, 0xa1, 0xbc,
+	0x61, 0x04, 0x8a, 0x1a, 0x3d, 0x45, 0x8d, 0x60, 0x0f, 0x94, 0xa2, 0xc6, 0x3e, 0x6b, 0x84, 0x95,
+	0xaa, 0x89, 0x4c, 0xfd, 0x37, 0x02, 0x17, 0x06, 0xf1, 0x28, 0xa6, 0xe9, 0x80, 0xee, 0x00, 0x44,
+	0x4c, 0xbd, 0xe2, 0xec, 0xea, 0xdc, 0xfa, 0x52, 0x45, 0x4f, 0xd5, 0x21, 0x5a, 0x59, 0x49, 0x91,
+	0xc8, 0xc5, 0xbd, 0x11, 0x34, 0xd6, 0x32, 0x69, 0x04, 0x00, 0xfb, 0x78, 0x7c, 0x31, 0x48, 0xc3,
+	0xcb, 0xd6, 0xb5, 0x5f, 0xc3, 0xd9, 0xa9, 0x35, 0xfc, 0x9d, 0xc0, 0xc5, 0xa1, 0xe2, 0xa7, 0x51,
+	0xc4, 0x87, 0x04, 0x5e, 0x92, 0x44, 0x3e, 0x36, 0x99, 0x9d, 0xad, 0xdf, 0x05, 0x98, 0xf7, 0x5a,
+	0x07, 0x07, 0xd6, 0x03, 0xd5, 0x99, 0x2a, 0x7a, 0x66, 0xbd, 0xf9, 0x2b, 0x81, 0x97, 0x13, 0x70,
+	0x4e, 0xa3, 0xa2, 0x5f, 0x12, 0xb8, 0x2c, 0x29, 0x7c, 0xca, 0x5d, 0xeb, 0xe0, 0xe8, 0x0e, 0xf3,
+	0xee, 0xf1, 0xfa, 0x53, 0x1e, 0x7d, 0x84, 0x82, 0xc7, 0x0e, 0x7d, 0x09, 0xeb, 0x6c, 0x55, 0x7e,
+	0xa3, 0x06, 0x0b, 0x8e, 0xcb, 0xad, 0x26, 0x6b, 0xf0, 0x62, 0x41, 0x8e, 0x47, 0xb1, 0xfe, 0x3e,
+	0xe8, 0xe3, 0x20, 0x28, 0x59, 0x35, 0x58, 0x68, 0xf7, 0x26, 0x58, 0xbc, 0x2e, 0x41, 0x2c, 0x54,
+	0xa3, 0x58, 0xff, 0x96, 0xc0, 0xab, 0xfd, 0x0d, 0x7e, 0x2b, 0xc0, 0x17, 0x1d, 0xb2, 0xd7, 0xe1,
+	0x85, 0x48, 0xbe, 0xcf, 0x25, 0xe2, 0x80, 0xc8, 0xf3, 0xd1, 0xe8, 0x47, 0xc3, 0xb7, 0x96, 0x39,
+	0x75, 0x67, 0x7c, 0x4d, 0xa0, 0x94, 0x06, 0x28, 0xe6, 0xa3, 0x44, 0xec, 0xdd, 0xd4, 0x73, 0xeb,
+	0x8b, 0xd5, 0x28, 0xc6, 0xbd, 0x11, 0x30, 0xa6, 0xda, 0xde, 0xad, 0xf0, 0xe0, 0x07, 0x2b, 0xdf,
+	0x66, 0x3e, 0xcb, 0xdc, 0x53, 0x7d, 0x13, 0x8a, 0xc3, 0x49, 0x0a, 0xf5, 0x32, 0x9c, 0x69, 0xb3,
+	0xc3, 0x56, 0x28, 0x5f, 0x10, 0xe8, 0x37, 0xe0, 0x95, 0x7e, 0xb6, 0x1f, 0x5a, 0xae, 0x2b, 0xdc,
+	0xb0, 0x94, 0x06, 0x0b, 0xa6, 0xb0, 0x7d, 0x97, 0x99, 0x61, 0xad, 0x28, 0xd6, 0xf7, 0xe1, 0xd2,
+	0xe8, 0xd4, 0xf8, 0x34, 0x71, 0x9b, 0xd5, 0x0e, 0xa3, 0x5d, 0x0f, 0x43, 0x79, 0xba, 0x45, 0xcb,
+	0x35, 0x79, 0x74, 0xba, 0x65, 0xa4, 0x77, 0x61, 0xa5, 0x7f, 0xc5, 0xdb, 0xdc, 0xe1, 0x76, 0x9d,
+	0x27, 0xba, 0x21, 0xec, 0x5a, 0x92, 0x6a, 0x58, 0xd3, 0x5f, 0xb6, 0x8f, 0x08, 0xac, 0xa6, 0xd7,
+	0x57, 0xac, 0xee, 0x02, 0xd4, 0xa3, 0x51, 0xb9, 0xfd, 0x4b, 0x95, 0xb7, 0xb2, 0x6f, 0x82, 0x68,
+	0xa5, 0xf0, 0x4a, 0x88, 0x17, 0xc1, 0xbd, 0x11, 0xf8, 0xa7, 0xe9, 0x99, 0xca, 0x1f, 0x67, 0xe1,
+	0x8c, 0x24, 0x80, 0x0f, 0x09, 0xcc, 0x07, 0x8f, 0x04, 0x4c, 0x07, 0x37, 0xfc, 0x32, 0xd1, 0xae,
+	0xe4, 0x9b, 0x1c, 0xd4, 0xd6, 0xd7, 0xbe, 0xfa, 0xf3, 0xbf, 0xef, 0x67, 0x2f, 0xe3, 0x0a, 0x4d,
+	0x7b, 0x0f, 0x05, 0x4f, 0x13, 0xfc, 0x89, 0xc0, 0x62, 0x24, 0x05, 0x1a, 0xe3, 0x8b, 0x0c, 0xde,
+	0x61, 0x1a, 0xcd, 0x3d, 0x5f, 0xe1, 0xba, 0x29, 0x71, 0x6d, 0xe3, 0x16, 0xcd, 0x7c, 0xa7, 0xd1,
+	0x8e, 0x3a, 0x3b, 0x5d, 0xda, 0xe9, 0x35, 0x53, 0x17, 0x7f, 0x24, 0x00, 0xb1, 0xdb, 0x62, 0xde,
+	0xe2, 0x91, 0x84, 0x9b, 0xf9, 0x13, 0x14, 0xdc, 0x6d, 0x09, 0x97, 0xe2, 0xd5, 0x6c, 0xb8, 0x5e,
+	0x8c, 0x17, 0x7f, 0x20, 0x50, 0xe8, 0xd9, 0x17, 0xbe, 0x39, 0xbe, 0x62, 0xc2, 0x71, 0xb5, 0x8d,
+	0x3c, 0x53, 0x15, 0xac, 0x5d, 0x09, 0xeb, 0x5d, 0xdc, 0x99, 0x48, 0x45, 0xcf, 0x64, 0x36, 0xed,
+	0x04, 0x76, 0xdd, 0xc5, 0xbf, 0x08, 0x9c, 0x1f, 0x69, 0x0e, 0xb8, 0x33, 0x1e, 0xc9, 0x38, 0x53,
+	0xd3, 0x6e, 0x4e, 0x95, 0xfb, 0x54, 0xb4, 0xa4, 0x61, 0x1d, 0x85, 0x3d, 0xd2, 0x7b, 0x3e, 0x0c,
+	0xf9, 0x03, 0x5e, 0xcf, 0xb9, 0xf3, 0x03, 0x0e, 0xa7, 0xbd, 0x3d, 0x71, 0x9e, 0xa2, 0xb2, 0x23,
+	0xa9, 0x5c, 0xc3, 0x4a, 0x3a, 0x15, 0x95, 0x42, 0x3b, 0xfd, 0x1e, 0xda, 0xc5, 0x9f, 0x09, 0x2c,
+	0x25, 0x6c, 0x02, 0xb3, 0xda, 0x76, 0xc8, 0x86, 0xb4, 0xf2, 0x04, 0x19, 0x0a, 0xf0, 0x75, 0x09,
+	0x78, 0x13, 0x8d, 0x2c, 0xc0, 0x75, 0xe6, 0xb3, 0x44, 0xab, 0xff, 0x42, 0xe0, 0xc5, 0x01, 0x9b,
+	0xc1, 0x6b, 0x39, 0x55, 0xeb, 0x33, 0x34, 0x6d, 0x7b, 0xc2, 0x2c, 0x05, 0xbc, 0x22, 0x81, 0x5f,
+	0xc1, 0x8d, 0x54, 0xe0, 0x4d, 0x99, 0x40, 0x3b, 0xa1, 0x3d, 0x76, 0xf1, 0x11, 0x81, 0x73, 0x23,
+	0x9c, 0x04, 0xdf, 0xc9, 0x09, 0x61, 0xc8, 0xfc, 0xb4, 0x1b, 0x53, 0x64, 0xe6, 0x26, 0x10, 0x1b,
+	0x92, 0xea, 0xf2, 0xdd, 0xe6, 0xe3, 0xe3, 0x12, 0x79, 0x72, 0x5c, 0x22, 0xff, 0x1e, 0x97, 0xc8,
+	0x77, 0x27, 0xa5, 0x99, 0x27, 0x27, 0xa5, 0x99, 0xbf, 0x4f, 0x4a, 0x33, 0xa0, 0x59, 0x22, 0x0d,
+	0xca, 0x3e, 0xf9, 0x6c, 0xbb, 0x61, 0xf9, 0xf7, 0x5a, 0x35, 0xc3, 0x14, 0xcd, 0x44, 0xb5, 0xab,
+	0x96, 0x48, 0xd6, 0x7e, 0x90, 0xa8, 0xee, 0x1f, 0x39, 0xdc, 0xab, 0xcd, 0xcb, 0xbf, 0xcc, 0x5b,
+	0xff, 0x0f, 0x00, 0x45, 0x97, 0x6a, 0x86, 0xfb, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -781,10 +1134,18 @@ type QueryClient interface {
 	Attributes(ctx context.Context, in *QueryAttributesRequest, opts ...grpc.CallOption) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(ctx context.Context, in *QueryScanRequest, opts ...grpc.CallOption) (*QueryScanResponse, error)
+	// VerifyHashedAttribute checks whether an account has a hashed attribute with the given name whose value
+	// is the SHA-256 hash of the provided salt followed by the provided preimage.
+	VerifyHashedAttribute(ctx context.Context, in *QueryVerifyHashedAttributeRequest, opts ...grpc.CallOption) (*QueryVerifyHashedAttributeResponse, error)
 	// AttributeAccounts queries accounts on a given attribute name
 	AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error)
 	// AccountData returns the accountdata for a specified account.
 	AccountData(ctx context.Context, in *QueryAccountDataRequest, opts ...grpc.CallOption) (*QueryAccountDataResponse, error)
+	// AttributeMirror returns whether a smart contract inherits the attributes of its admin for required attribute checks.
+	AttributeMirror(ctx context.Context, in *QueryAttributeMirrorRequest, opts ...grpc.CallOption) (*QueryAttributeMirrorResponse, error)
+	// AttributeDependents returns the things (e.g. markers and exchange markets) that require an attribute name.
+	// It shows what would be affected if the name were unbound and its attributes deleted.
+	AttributeDependents(ctx context.Context, in *QueryAttributeDependentsRequest, opts ...grpc.CallOption) (*QueryAttributeDependentsResponse, error)
 }
 
 type queryClient struct {
@@ -831,6 +1192,15 @@ func (c *queryClient) Scan(ctx context.Context, in *QueryScanRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) VerifyHashedAttribute(ctx context.Context, in *QueryVerifyHashedAttributeRequest, opts ...grpc.CallOption) (*QueryVerifyHashedAttributeResponse, error) {
+	out := new(QueryVerifyHashedAttributeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/VerifyHashedAttribute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AttributeAccounts(ctx context.Context, in *QueryAttributeAccountsRequest, opts ...grpc.CallOption) (*QueryAttributeAccountsResponse, error) {
 	out := new(QueryAttributeAccountsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeAccounts", in, out, opts...)
@@ -849,6 +1219,24 @@ func (c *queryClient) AccountData(ctx context.Context, in *QueryAccountDataReque
 	return out, nil
 }
 
+func (c *queryClient) AttributeMirror(ctx context.Context, in *QueryAttributeMirrorRequest, opts ...grpc.CallOption) (*QueryAttributeMirrorResponse, error) {
+	out := new(QueryAttributeMirrorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeMirror", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttributeDependents(ctx context.Context, in *QueryAttributeDependentsRequest, opts ...grpc.CallOption) (*QueryAttributeDependentsResponse, error) {
+	out := new(QueryAttributeDependentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Query/AttributeDependents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the attribute module.
@@ -859,10 +1247,18 @@ type QueryServer interface {
 	Attributes(context.Context, *QueryAttributesRequest) (*QueryAttributesResponse, error)
 	// Scan queries attributes on a given account (address) for any that match the provided suffix
 	Scan(context.Context, *QueryScanRequest) (*QueryScanResponse, error)
+	// VerifyHashedAttribute checks whether an account has a hashed attribute with the given name whose value
+	// is the SHA-256 hash of the provided salt followed by the provided preimage.
+	VerifyHashedAttribute(context.Context, *QueryVerifyHashedAttributeRequest) (*QueryVerifyHashedAttributeResponse, error)
 	// AttributeAccounts queries accounts on a given attribute name
 	AttributeAccounts(context.Context, *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error)
 	// AccountData returns the accountdata for a specified account.
 	AccountData(context.Context, *QueryAccountDataRequest) (*QueryAccountDataResponse, error)
+	// AttributeMirror returns whether a smart contract inherits the attributes of its admin for required attribute checks.
+	AttributeMirror(context.Context, *QueryAttributeMirrorRequest) (*QueryAttributeMirrorResponse, error)
+	// AttributeDependents returns the things (e.g. markers and exchange markets) that require an attribute name.
+	// It shows what would be affected if the name were unbound and its attributes deleted.
+	AttributeDependents(context.Context, *QueryAttributeDependentsRequest) (*QueryAttributeDependentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -881,12 +1277,21 @@ func (*UnimplementedQueryServer) Attributes(ctx context.Context, req *QueryAttri
 func (*UnimplementedQueryServer) Scan(ctx context.Context, req *QueryScanRequest) (*QueryScanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Scan not implemented")
 }
+func (*UnimplementedQueryServer) VerifyHashedAttribute(ctx context.Context, req *QueryVerifyHashedAttributeRequest) (*QueryVerifyHashedAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyHashedAttribute not implemented")
+}
 func (*UnimplementedQueryServer) AttributeAccounts(ctx context.Context, req *QueryAttributeAccountsRequest) (*QueryAttributeAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AttributeAccounts not implemented")
 }
 func (*UnimplementedQueryServer) AccountData(ctx context.Context, req *QueryAccountDataRequest) (*QueryAccountDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountData not implemented")
 }
+func (*UnimplementedQueryServer) AttributeMirror(ctx context.Context, req *QueryAttributeMirrorRequest) (*QueryAttributeMirrorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeMirror not implemented")
+}
+func (*UnimplementedQueryServer) AttributeDependents(ctx context.Context, req *QueryAttributeDependentsRequest) (*QueryAttributeDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttributeDependents not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -964,6 +1369,24 @@ func _Query_Scan_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifyHashedAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyHashedAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyHashedAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/VerifyHashedAttribute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyHashedAttribute(ctx, req.(*QueryVerifyHashedAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AttributeAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAttributeAccountsRequest)
 	if err := dec(in); err != nil {
@@ -1000,26 +1423,66 @@ func _Query_AccountData_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.attribute.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Attribute",
-			Handler:    _Query_Attribute_Handler,
-		},
-		{
-			MethodName: "Attributes",
-			Handler:    _Query_Attributes_Handler,
-		},
-		{
-			MethodName: "Scan",
-			Handler:    _Query_Scan_Handler,
+func _Query_AttributeMirror_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeMirrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeMirror(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeMirror",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeMirror(ctx, req.(*QueryAttributeMirrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttributeDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttributeDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttributeDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Query/AttributeDependents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttributeDependents(ctx, req.(*QueryAttributeDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.attribute.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Attribute",
+			Handler:    _Query_Attribute_Handler,
+		},
+		{
+			MethodName: "Attributes",
+			Handler:    _Query_Attributes_Handler,
+		},
+		{
+			MethodName: "Scan",
+			Handler:    _Query_Scan_Handler,
+		},
+		{
+			MethodName: "VerifyHashedAttribute",
+			Handler:    _Query_VerifyHashedAttribute_Handler,
 		},
 		{
 			MethodName: "AttributeAccounts",
@@ -1029,6 +1492,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountData",
 			Handler:    _Query_AccountData_Handler,
 		},
+		{
+			MethodName: "AttributeMirror",
+			Handler:    _Query_AttributeMirror_Handler,
+		},
+		{
+			MethodName: "AttributeDependents",
+			Handler:    _Query_AttributeDependents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/query.proto",
@@ -1398,6 +1869,90 @@ func (m *QueryScanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVerifyHashedAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyHashedAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyHashedAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Preimage) > 0 {
+		i -= len(m.Preimage)
+		copy(dAtA[i:], m.Preimage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Preimage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyHashedAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyHashedAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyHashedAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryAttributeAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1548,6 +2103,167 @@ func (m *QueryAccountDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAttributeMirrorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeMirrorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeMirrorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeMirrorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeMirrorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeMirrorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeDependentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeDependentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeDependentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttributeDependentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttributeDependentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttributeDependentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Dependents) > 0 {
+		for iNdEx := len(m.Dependents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1707,19 +2423,56 @@ func (m *QueryScanResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryAttributeAccountsRequest) Size() (n int) {
+func (m *QueryVerifyHashedAttributeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AttributeName)
+	l = len(m.Account)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Preimage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyHashedAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAttributeAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttributeName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -1769,6 +2522,71 @@ func (m *QueryAccountDataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAttributeMirrorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeMirrorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeDependentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttributeDependentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dependents) > 0 {
+		for _, e := range m.Dependents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2782,7 +3600,7 @@ func (m *QueryScanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyHashedAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2805,15 +3623,15 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyHashedAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyHashedAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2841,13 +3659,13 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeName = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2857,26 +3675,90 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preimage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preimage = append(m.Preimage[:0], dAtA[iNdEx:postIndex]...)
+			if m.Preimage == nil {
+				m.Preimage = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2900,7 +3782,7 @@ func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyHashedAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2923,15 +3805,85 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttributeAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyHashedAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttributeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyHashedAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2959,7 +3911,7 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.AttributeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
@@ -2991,7 +3943,7 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3018,7 +3970,7 @@ func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttributeAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,15 +3993,15 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttributeAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttributeAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3077,16 +4029,134 @@ func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3182,6 +4252,428 @@ func (m *QueryAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAttributeMirrorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeMirrorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeMirrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeMirrorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeMirrorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeMirrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeDependentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeDependentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeDependentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttributeDependentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttributeDependentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttributeDependentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependents = append(m.Dependents, AttributeDependent{})
+			if err := m.Dependents[len(m.Dependents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,136 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &MarketTradingAuthorization{}
+
+const (
+	// TypeURLMsgCreateAskRequest is the type url of a MsgCreateAskRequest.
+	TypeURLMsgCreateAskRequest = "/provenance.exchange.v1.MsgCreateAskRequest"
+	// TypeURLMsgCreateBidRequest is the type url of a MsgCreateBidRequest.
+	TypeURLMsgCreateBidRequest = "/provenance.exchange.v1.MsgCreateBidRequest"
+	// TypeURLMsgCancelOrderRequest is the type url of a MsgCancelOrderRequest.
+	TypeURLMsgCancelOrderRequest = "/provenance.exchange.v1.MsgCancelOrderRequest"
+)
+
+// NewMarketTradingAuthorization creates a new MarketTradingAuthorization for the given msg type url.
+func NewMarketTradingAuthorization(msgTypeURL string, marketIDs []uint32, spendLimit sdk.Coins) *MarketTradingAuthorization {
+	return &MarketTradingAuthorization{
+		Msg:        msgTypeURL,
+		MarketIds:  marketIDs,
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a MarketTradingAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+// Order cancellations cannot be executed through authz since the keeper needs to know who actually
+// requested the cancellation. Instead, the grantee should submit the MsgCancelOrderRequest with themselves as the signer.
+func (a MarketTradingAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var marketID uint32
+	var amount sdk.Coins
+	switch m := msg.(type) {
+	case *MsgCreateAskRequest:
+		marketID = m.AskOrder.MarketId
+		amount = sdk.Coins{m.AskOrder.Assets}
+		if m.AskOrder.SellerSettlementFlatFee != nil {
+			amount = amount.Add(*m.AskOrder.SellerSettlementFlatFee)
+		}
+		if m.OrderCreationFee != nil {
+			amount = amount.Add(*m.OrderCreationFee)
+		}
+	case *MsgCreateBidRequest:
+		marketID = m.BidOrder.MarketId
+		amount = m.BidOrder.BuyerSettlementFees.Add(m.BidOrder.Price)
+		if m.OrderCreationFee != nil {
+			amount = amount.Add(*m.OrderCreationFee)
+		}
+	case *MsgCancelOrderRequest:
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap(
+			"order cancellations cannot be executed through authz: submit the cancel order request with the grantee as the signer")
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if msgTypeURL := sdk.MsgTypeURL(msg); msgTypeURL != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("type mismatch: expected %s, got %s", a.Msg, msgTypeURL)
+	}
+	if !a.AllowsMarket(marketID) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("market %d is not allowed by this authorization", marketID)
+	}
+	if len(a.SpendLimit) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"requested amount %s is more than the spend limit %s", amount, a.SpendLimit)
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Delete:  limitLeft.IsZero(),
+		Updated: NewMarketTradingAuthorization(a.Msg, a.MarketIds, limitLeft),
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MarketTradingAuthorization) ValidateBasic() error {
+	var errs []error
+	switch a.Msg {
+	case TypeURLMsgCreateAskRequest, TypeURLMsgCreateBidRequest:
+		if err := a.SpendLimit.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid spend limit: %w", err))
+		}
+	case TypeURLMsgCancelOrderRequest:
+		if len(a.SpendLimit) > 0 {
+			errs = append(errs, fmt.Errorf("invalid spend limit %q: must be empty for %s", a.SpendLimit, a.Msg))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid msg type url %q: must be one of %s, %s, %s",
+			a.Msg, TypeURLMsgCreateAskRequest, TypeURLMsgCreateBidRequest, TypeURLMsgCancelOrderRequest))
+	}
+
+	if len(a.MarketIds) == 0 {
+		errs = append(errs, errors.New("no market ids provided"))
+	}
+	seen := make(map[uint32]bool, len(a.MarketIds))
+	for _, marketID := range a.MarketIds {
+		if marketID == 0 {
+			errs = append(errs, errors.New("invalid market id: cannot be zero"))
+			continue
+		}
+		if seen[marketID] {
+			errs = append(errs, fmt.Errorf("duplicate market id %d", marketID))
+		}
+		seen[marketID] = true
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return nil
+}
+
+// AllowsMarket returns true if this authorization applies to the given market.
+func (a MarketTradingAuthorization) AllowsMarket(marketID uint32) bool {
+	for _, id := range a.MarketIds {
+		if id == marketID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/authz.proto
+
+package exchange
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketTradingAuthorization gives the grantee permission to create or cancel orders on behalf of the granter,
+// limited to a specific set of markets.
+type MarketTradingAuthorization struct {
+	// msg is the type url of the msg this authorization allows.
+	// It must be one of MsgCreateAskRequest, MsgCreateBidRequest, or MsgCancelOrderRequest.
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// market_ids are the markets in which the grantee can act on behalf of the granter.
+	MarketIds []uint32 `protobuf:"varint,2,rep,packed,name=market_ids,json=marketIds,proto3" json:"market_ids,omitempty"`
+	// spend_limit is the total amount the grantee can still commit to new orders on behalf of the granter.
+	// Ask orders are applied using their assets and seller settlement flat fee, bid orders are applied using their
+	// price and buyer settlement fees, and the order creation fee is applied to both.
+	// If empty, there is no limit. Only the denoms listed here can be used when a limit is provided.
+	// It must be empty for MsgCancelOrderRequest authorizations.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *MarketTradingAuthorization) Reset()         { *m = MarketTradingAuthorization{} }
+func (m *MarketTradingAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MarketTradingAuthorization) ProtoMessage()    {}
+func (*MarketTradingAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6282187844c8a0e0, []int{0}
+}
+func (m *MarketTradingAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketTradingAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketTradingAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketTradingAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketTradingAuthorization.Merge(m, src)
+}
+func (m *MarketTradingAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketTradingAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketTradingAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketTradingAuthorization proto.InternalMessageInfo
+
+func (m *MarketTradingAuthorization) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *MarketTradingAuthorization) GetMarketIds() []uint32 {
+	if m != nil {
+		return m.MarketIds
+	}
+	return nil
+}
+
+func (m *MarketTradingAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MarketTradingAuthorization)(nil), "provenance.exchange.v1.MarketTradingAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/authz.proto", fileDescriptor_6282187844c8a0e0)
+}
+
+var fileDescriptor_6282187844c8a0e0 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xcf, 0x4a, 0xc3, 0x30,
+	0x18, 0x6f, 0x2c, 0x08, 0xcb, 0x1c, 0xb8, 0x22, 0xb2, 0x0d, 0xec, 0xc6, 0x4e, 0x65, 0xb0, 0x84,
+	0xe9, 0xcd, 0x9b, 0x13, 0x04, 0x41, 0x41, 0x86, 0x27, 0x2f, 0x25, 0x6d, 0x43, 0x1b, 0xb6, 0x26,
+	0xa5, 0xc9, 0xc6, 0xb6, 0xa3, 0x4f, 0xe0, 0xd9, 0x27, 0x10, 0x4f, 0x3b, 0xf8, 0x10, 0xc3, 0xd3,
+	0x8e, 0x9e, 0x54, 0x36, 0x64, 0xaf, 0x21, 0xfd, 0xa3, 0x9b, 0xe0, 0xa5, 0xfd, 0x7e, 0x7f, 0x92,
+	0xef, 0xf7, 0xe5, 0x83, 0xcd, 0x28, 0x16, 0x23, 0xca, 0x09, 0x77, 0x29, 0xa6, 0x63, 0x37, 0x20,
+	0xdc, 0xa7, 0x78, 0xd4, 0xc1, 0x64, 0xa8, 0x82, 0x29, 0x8a, 0x62, 0xa1, 0x84, 0x71, 0xb8, 0xf1,
+	0xa0, 0x1f, 0x0f, 0x1a, 0x75, 0x6a, 0x65, 0x12, 0x32, 0x2e, 0x70, 0xfa, 0xcd, 0xac, 0x35, 0xd3,
+	0x15, 0x32, 0x14, 0x12, 0x3b, 0x44, 0x26, 0xd7, 0x38, 0x54, 0x91, 0x0e, 0x76, 0x05, 0xe3, 0xb9,
+	0x5e, 0xcd, 0x74, 0x3b, 0x45, 0x38, 0x03, 0xb9, 0x74, 0xe0, 0x0b, 0x5f, 0x64, 0x7c, 0x52, 0x65,
+	0x6c, 0xf3, 0x0b, 0xc0, 0xda, 0x35, 0x89, 0xfb, 0x54, 0xdd, 0xc6, 0xc4, 0x63, 0xdc, 0x3f, 0x1b,
+	0xaa, 0x40, 0xc4, 0x6c, 0x4a, 0x14, 0x13, 0xdc, 0xd8, 0x87, 0x7a, 0x28, 0xfd, 0x0a, 0x68, 0x00,
+	0xab, 0xd0, 0x4b, 0x4a, 0xe3, 0x08, 0xc2, 0x30, 0xf5, 0xdb, 0xcc, 0x93, 0x95, 0x9d, 0x86, 0x6e,
+	0x95, 0x7a, 0x85, 0x8c, 0xb9, 0xf4, 0xa4, 0x71, 0x0f, 0x60, 0x51, 0x46, 0x94, 0x7b, 0xf6, 0x80,
+	0x85, 0x4c, 0x55, 0xf4, 0x86, 0x6e, 0x15, 0x8f, 0xab, 0x28, 0x8f, 0x92, 0xe4, 0x46, 0x79, 0x6e,
+	0x74, 0x2e, 0x18, 0xef, 0x5e, 0xcc, 0xdf, 0xeb, 0xda, 0xf3, 0x47, 0xdd, 0xf2, 0x99, 0x0a, 0x86,
+	0x0e, 0x72, 0x45, 0x98, 0xe7, 0xce, 0x7f, 0x6d, 0xe9, 0xf5, 0xb1, 0x9a, 0x44, 0x54, 0xa6, 0x07,
+	0xe4, 0xe3, 0x7a, 0xd6, 0xda, 0x1b, 0x50, 0x9f, 0xb8, 0x13, 0x3b, 0x99, 0x5c, 0x3e, 0xad, 0x67,
+	0x2d, 0xd0, 0x83, 0x69, 0xd7, 0xab, 0xa4, 0xe9, 0x69, 0xf9, 0xf5, 0xa5, 0x5d, 0xfa, 0x33, 0x48,
+	0x97, 0xce, 0x97, 0x26, 0x58, 0x2c, 0x4d, 0xf0, 0xb9, 0x34, 0xc1, 0xc3, 0xca, 0xd4, 0x16, 0x2b,
+	0x53, 0x7b, 0x5b, 0x99, 0x1a, 0xac, 0x32, 0x81, 0xfe, 0x5f, 0xc0, 0x0d, 0xb8, 0x43, 0x5b, 0xa9,
+	0x36, 0xa6, 0x36, 0x13, 0x5b, 0x08, 0x8f, 0x7f, 0x37, 0xeb, 0xec, 0xa6, 0xaf, 0x7a, 0xf2, 0x3d,
+	0x00, 0x38, 0x5e, 0xf9, 0x21, 0xf7, 0x01, 0x00, 0x00,
+}
+
+func (m *MarketTradingAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketTradingAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketTradingAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MarketIds) > 0 {
+		dAtA2 := make([]byte, len(m.MarketIds)*10)
+		var j1 int
+		for _, num := range m.MarketIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketTradingAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.MarketIds) > 0 {
+		l = 0
+		for _, e := range m.MarketIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketTradingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketTradingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketTradingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MarketIds = append(m.MarketIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MarketIds) == 0 {
+					m.MarketIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MarketIds = append(m.MarketIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
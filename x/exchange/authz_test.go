@@ -0,0 +1,243 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestTypeURLConstants(t *testing.T) {
+	assert.Equal(t, sdk.MsgTypeURL(&MsgCreateAskRequest{}), TypeURLMsgCreateAskRequest, "TypeURLMsgCreateAskRequest")
+	assert.Equal(t, sdk.MsgTypeURL(&MsgCreateBidRequest{}), TypeURLMsgCreateBidRequest, "TypeURLMsgCreateBidRequest")
+	assert.Equal(t, sdk.MsgTypeURL(&MsgCancelOrderRequest{}), TypeURLMsgCancelOrderRequest, "TypeURLMsgCancelOrderRequest")
+}
+
+func TestMarketTradingAuthorization_ValidateBasic(t *testing.T) {
+	coins := func(coins string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(coins)
+		if err != nil {
+			t.Fatalf("ParseCoinsNormalized(%q) error: %v", coins, err)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		auth   MarketTradingAuthorization
+		expErr []string
+	}{
+		{
+			name: "create ask, no limit",
+			auth: MarketTradingAuthorization{Msg: TypeURLMsgCreateAskRequest, MarketIds: []uint32{1}},
+		},
+		{
+			name: "create bid, with limit",
+			auth: MarketTradingAuthorization{Msg: TypeURLMsgCreateBidRequest, MarketIds: []uint32{3, 1}, SpendLimit: coins("10nhash")},
+		},
+		{
+			name: "cancel order",
+			auth: MarketTradingAuthorization{Msg: TypeURLMsgCancelOrderRequest, MarketIds: []uint32{1, 2, 3}},
+		},
+		{
+			name:   "unknown msg",
+			auth:   MarketTradingAuthorization{Msg: "/provenance.exchange.v1.MsgFillBidsRequest", MarketIds: []uint32{1}},
+			expErr: []string{"invalid msg type url \"/provenance.exchange.v1.MsgFillBidsRequest\""},
+		},
+		{
+			name:   "cancel order with limit",
+			auth:   MarketTradingAuthorization{Msg: TypeURLMsgCancelOrderRequest, MarketIds: []uint32{1}, SpendLimit: coins("10nhash")},
+			expErr: []string{"invalid spend limit \"10nhash\": must be empty for " + TypeURLMsgCancelOrderRequest},
+		},
+		{
+			name: "invalid spend limit",
+			auth: MarketTradingAuthorization{
+				Msg:        TypeURLMsgCreateAskRequest,
+				MarketIds:  []uint32{1},
+				SpendLimit: sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: []string{"invalid spend limit: coin -1nhash amount is not positive"},
+		},
+		{
+			name:   "no market ids",
+			auth:   MarketTradingAuthorization{Msg: TypeURLMsgCreateAskRequest},
+			expErr: []string{"no market ids provided"},
+		},
+		{
+			name:   "zero and duplicate market ids",
+			auth:   MarketTradingAuthorization{Msg: TypeURLMsgCreateBidRequest, MarketIds: []uint32{1, 0, 1}},
+			expErr: []string{"invalid market id: cannot be zero", "duplicate market id 1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.auth.ValidateBasic()
+			}
+			assert.NotPanics(t, testFunc, "MarketTradingAuthorization.ValidateBasic()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketTradingAuthorization.ValidateBasic()")
+		})
+	}
+}
+
+func TestMarketTradingAuthorization_Accept(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	askMsg := func(marketID uint32, assets sdk.Coin) *MsgCreateAskRequest {
+		return &MsgCreateAskRequest{AskOrder: AskOrder{MarketId: marketID, Assets: assets, Price: coin(5, "nhash")}}
+	}
+	bidMsg := func(marketID uint32, price sdk.Coin) *MsgCreateBidRequest {
+		return &MsgCreateBidRequest{BidOrder: BidOrder{MarketId: marketID, Assets: coin(5, "apple"), Price: price}}
+	}
+
+	tests := []struct {
+		name   string
+		auth   *MarketTradingAuthorization
+		msg    sdk.Msg
+		expRes authz.AcceptResponse
+		expErr string
+	}{
+		{
+			name:   "wrong msg type",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, nil),
+			msg:    &banktypes.MsgSend{},
+			expErr: "type mismatch: invalid type",
+		},
+		{
+			name:   "bid msg for ask authorization",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, nil),
+			msg:    bidMsg(1, coin(5, "nhash")),
+			expErr: "type mismatch: expected " + TypeURLMsgCreateAskRequest + ", got " + TypeURLMsgCreateBidRequest + ": invalid type",
+		},
+		{
+			name:   "cancel order",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCancelOrderRequest, []uint32{1}, nil),
+			msg:    &MsgCancelOrderRequest{OrderId: 1},
+			expErr: "order cancellations cannot be executed through authz: submit the cancel order request with the grantee as the signer: unauthorized",
+		},
+		{
+			name:   "market not allowed",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1, 3}, nil),
+			msg:    askMsg(2, coin(5, "apple")),
+			expErr: "market 2 is not allowed by this authorization: unauthorized",
+		},
+		{
+			name:   "ask without limit",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1, 3}, nil),
+			msg:    askMsg(3, coin(5, "apple")),
+			expRes: authz.AcceptResponse{Accept: true},
+		},
+		{
+			name:   "ask more than limit",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(4, "apple"))),
+			msg:    askMsg(1, coin(5, "apple")),
+			expErr: "requested amount 5apple is more than the spend limit 4apple: insufficient funds",
+		},
+		{
+			name:   "ask denom not in limit",
+			auth:   NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(40, "apple"))),
+			msg:    askMsg(1, coin(5, "banana")),
+			expErr: "requested amount 5banana is more than the spend limit 40apple: insufficient funds",
+		},
+		{
+			name: "ask less than limit",
+			auth: NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(7, "apple"), coin(3, "banana"))),
+			msg:  askMsg(1, coin(5, "apple")),
+			expRes: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(2, "apple"), coin(3, "banana"))),
+			},
+		},
+		{
+			name: "bid uses all of limit",
+			auth: NewMarketTradingAuthorization(TypeURLMsgCreateBidRequest, []uint32{2}, sdk.NewCoins(coin(5, "nhash"))),
+			msg:  bidMsg(2, coin(5, "nhash")),
+			expRes: authz.AcceptResponse{
+				Accept:  true,
+				Delete:  true,
+				Updated: NewMarketTradingAuthorization(TypeURLMsgCreateBidRequest, []uint32{2}, sdk.Coins{}),
+			},
+		},
+		{
+			name: "ask with fees",
+			auth: NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(5, "apple"), coin(10, "nhash"))),
+			msg: &MsgCreateAskRequest{
+				AskOrder: AskOrder{
+					MarketId:                1,
+					Assets:                  coin(5, "apple"),
+					Price:                   coin(5, "nhash"),
+					SellerSettlementFlatFee: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(3)},
+				},
+				OrderCreationFee: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(2)},
+			},
+			expRes: authz.AcceptResponse{
+				Accept:  true,
+				Updated: NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(5, "nhash"))),
+			},
+		},
+		{
+			name: "ask fees more than limit",
+			auth: NewMarketTradingAuthorization(TypeURLMsgCreateAskRequest, []uint32{1}, sdk.NewCoins(coin(5, "apple"), coin(4, "nhash"))),
+			msg: &MsgCreateAskRequest{
+				AskOrder: AskOrder{
+					MarketId:                1,
+					Assets:                  coin(5, "apple"),
+					Price:                   coin(5, "nhash"),
+					SellerSettlementFlatFee: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(3)},
+				},
+				OrderCreationFee: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(2)},
+			},
+			expErr: "requested amount 5apple,5nhash is more than the spend limit 5apple,4nhash: insufficient funds",
+		},
+		{
+			name: "bid with fees",
+			auth: NewMarketTradingAuthorization(TypeURLMsgCreateBidRequest, []uint32{2}, sdk.NewCoins(coin(10, "nhash"), coin(1, "fig"))),
+			msg: &MsgCreateBidRequest{
+				BidOrder: BidOrder{
+					MarketId:            2,
+					Assets:              coin(5, "apple"),
+					Price:               coin(5, "nhash"),
+					BuyerSettlementFees: sdk.NewCoins(coin(3, "nhash"), coin(1, "fig")),
+				},
+				OrderCreationFee: &sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(2)},
+			},
+			expRes: authz.AcceptResponse{
+				Accept:  true,
+				Delete:  true,
+				Updated: NewMarketTradingAuthorization(TypeURLMsgCreateBidRequest, []uint32{2}, sdk.Coins{}),
+			},
+		},
+		{
+			name: "bid creation fee denom not in limit",
+			auth: NewMarketTradingAuthorization(TypeURLMsgCreateBidRequest, []uint32{2}, sdk.NewCoins(coin(10, "nhash"))),
+			msg: &MsgCreateBidRequest{
+				BidOrder:         BidOrder{MarketId: 2, Assets: coin(5, "apple"), Price: coin(5, "nhash")},
+				OrderCreationFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(1)},
+			},
+			expErr: "requested amount 1fig,5nhash is more than the spend limit 10nhash: insufficient funds",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var res authz.AcceptResponse
+			var err error
+			testFunc := func() {
+				res, err = tc.auth.Accept(context.Background(), tc.msg)
+			}
+			assert.NotPanics(t, testFunc, "Accept")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Accept error")
+			assert.Equal(t, tc.expRes, res, "Accept response")
+		})
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -26,8 +28,10 @@ const (
 	FlagAcceptingOrders      = "accepting-orders"
 	FlagAccessGrants         = "access-grants"
 	FlagAccount              = "account"
+	FlagAction               = "action"
 	FlagAdmin                = "admin"
 	FlagAfter                = "after"
+	FlagAllOrNothing         = "all-or-nothing"
 	FlagAllowUserSettle      = "allow-user-settle"
 	FlagAmount               = "amount"
 	FlagAsk                  = "ask"
@@ -54,6 +58,7 @@ const (
 	FlagCreateBid            = "create-bid"
 	FlagCreateCommitment     = "create-commitment"
 	FlagCreationFee          = "creation-fee"
+	FlagDealer               = "dealer"
 	FlagDefault              = "default"
 	FlagDenom                = "denom"
 	FlagDescription          = "description"
@@ -61,22 +66,35 @@ const (
 	FlagDisable              = "disable"
 	FlagEnable               = "enable"
 	FlagEmptyExternalID      = "empty-external-id"
+	FlagExpiration           = "expiration"
 	FlagExternalID           = "external-id"
 	FlagExternalIDs          = "external-ids"
 	FlagFile                 = "file"
+	FlagFillOrKill           = "fill-or-kill"
 	FlagGrant                = "grant"
 	FlagIcon                 = "icon"
 	FlagInputs               = "inputs"
+	FlagIssuer               = "issuer"
+	FlagMarkerDenom          = "marker-denom"
 	FlagMarket               = "market"
+	FlagMinFill              = "min-fill"
 	FlagName                 = "name"
 	FlagNavs                 = "navs"
+	FlagNet                  = "net"
 	FlagNewTarget            = "new-target"
+	FlagOracle               = "oracle"
+	FlagOracles              = "oracles"
 	FlagOrder                = "order"
 	FlagOutputs              = "outputs"
+	FlagOverride             = "override"
 	FlagOwner                = "owner"
 	FlagPartial              = "partial"
+	FlagPayoutInterval       = "payout-interval"
 	FlagPrice                = "price"
+	FlagPriceDenom           = "price-denom"
 	FlagProposal             = "proposal"
+	FlagReceipt              = "receipt"
+	FlagReference            = "reference"
 	FlagRelease              = "release"
 	FlagReleaseAll           = "release-all"
 	FlagReqAttrAsk           = "req-attr-ask"
@@ -84,6 +102,8 @@ const (
 	FlagReqAttrCommitment    = "req-attr-commitment"
 	FlagRevoke               = "revoke"
 	FlagRevokeAll            = "revoke-all"
+	FlagRFQ                  = "rfq"
+	FlagSampleInterval       = "sample-interval"
 	FlagSeller               = "seller"
 	FlagSellerFlat           = "seller-flat"
 	FlagSellerFlatAdd        = "seller-flat-add"
@@ -101,6 +121,7 @@ const (
 	FlagTag                  = "tag"
 	FlagTarget               = "target"
 	FlagTargetAmount         = "target-amount"
+	FlagTimeout              = "timeout"
 	FlagTo                   = "to"
 	FlagUnsetBips            = "unset-bips"
 	FlagURL                  = "url"
@@ -317,6 +338,58 @@ func ReadFlagOrderOrArg(flagSet *pflag.FlagSet, args []string) (uint64, error) {
 	return orderID, nil
 }
 
+// ReadFlagReceiptOrArg gets a required receipt id from either the --receipt flag or the first provided arg.
+// This assumes that the flag was defined with a default of 0.
+func ReadFlagReceiptOrArg(flagSet *pflag.FlagSet, args []string) (uint64, error) {
+	receiptID, err := flagSet.GetUint64(FlagReceipt)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(args) > 0 && len(args[0]) > 0 {
+		if receiptID != 0 {
+			return 0, fmt.Errorf("cannot provide <receipt id> as both an arg (%q) and flag (--%s %d)", args[0], FlagReceipt, receiptID)
+		}
+
+		receiptID, err = strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not convert <receipt id> arg: %w", err)
+		}
+	}
+
+	if receiptID == 0 {
+		return 0, errors.New("no <receipt id> provided")
+	}
+
+	return receiptID, nil
+}
+
+// ReadFlagRFQOrArg gets a required rfq id from either the --rfq flag or the first provided arg.
+// This assumes that the flag was defined with a default of 0.
+func ReadFlagRFQOrArg(flagSet *pflag.FlagSet, args []string) (uint64, error) {
+	rfqID, err := flagSet.GetUint64(FlagRFQ)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(args) > 0 && len(args[0]) > 0 {
+		if rfqID != 0 {
+			return 0, fmt.Errorf("cannot provide <rfq id> as both an arg (%q) and flag (--%s %d)", args[0], FlagRFQ, rfqID)
+		}
+
+		rfqID, err = strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("could not convert <rfq id> arg: %w", err)
+		}
+	}
+
+	if rfqID == 0 {
+		return 0, errors.New("no <rfq id> provided")
+	}
+
+	return rfqID, nil
+}
+
 // ReadFlagMarketOrArg gets a required market id from either the --market flag or the first provided arg.
 // This assumes that the flag was defined with a default of 0.
 func ReadFlagMarketOrArg(flagSet *pflag.FlagSet, args []string) (uint32, error) {
@@ -418,6 +491,20 @@ func ReadCoinFlag(flagSet *pflag.FlagSet, name string) (*sdk.Coin, error) {
 	return &rv, nil
 }
 
+// ReadIntFlag reads a string flag and converts it into a sdkmath.Int.
+// If the flag wasn't provided, this returns nil, nil.
+func ReadIntFlag(flagSet *pflag.FlagSet, name string) (*sdkmath.Int, error) {
+	value, err := flagSet.GetString(name)
+	if len(value) == 0 || err != nil {
+		return nil, err
+	}
+	rv, ok := sdkmath.NewIntFromString(value)
+	if !ok {
+		return nil, fmt.Errorf("error parsing --%s as an integer: invalid value %q", name, value)
+	}
+	return &rv, nil
+}
+
 // ReadReqCoinFlag reads a string flag and converts it into a sdk.Coin and requires it to have a value.
 // Returns an error if not provided.
 //
@@ -896,6 +896,97 @@ func TestReadFlagOrderOrArg(t *testing.T) {
 	}
 }
 
+func TestReadFlagReceiptOrArg(t *testing.T) {
+	theFlag := cli.FlagReceipt
+	goodFlagSet := func() *pflag.FlagSet {
+		flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+		flagSet.Uint64(theFlag, 0, "The id")
+		return flagSet
+	}
+	badFlagSet := func() *pflag.FlagSet {
+		flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+		flagSet.String(theFlag, "", "The id")
+		return flagSet
+	}
+
+	tests := []struct {
+		name    string
+		flags   []string
+		flagSet *pflag.FlagSet
+		args    []string
+		expID   uint64
+		expErr  string
+	}{
+		{
+			name:    "unknown flag",
+			flagSet: pflag.NewFlagSet("", pflag.ContinueOnError),
+			expErr:  "flag accessed but not defined: " + theFlag,
+		},
+		{
+			name:    "wrong flag type",
+			flagSet: badFlagSet(),
+			expErr:  "trying to get uint64 value of flag of type string",
+		},
+		{
+			name:    "both flag and arg",
+			flags:   []string{"--" + theFlag, "8"},
+			flagSet: goodFlagSet(),
+			args:    []string{"8"},
+			expErr:  "cannot provide <receipt id> as both an arg (\"8\") and flag (--receipt 8)",
+		},
+		{
+			name:    "just flag",
+			flags:   []string{"--" + theFlag, "8"},
+			flagSet: goodFlagSet(),
+			expID:   8,
+		},
+		{
+			name:    "just flag zero",
+			flags:   []string{"--" + theFlag, "0"},
+			flagSet: goodFlagSet(),
+			expErr:  "no <receipt id> provided",
+		},
+		{
+			name:    "just arg, bad",
+			flagSet: goodFlagSet(),
+			args:    []string{"8v8"},
+			expErr:  "could not convert <receipt id> arg: strconv.ParseUint: parsing \"8v8\": invalid syntax",
+		},
+		{
+			name:    "just arg, zero",
+			flagSet: goodFlagSet(),
+			args:    []string{"0"},
+			expErr:  "no <receipt id> provided",
+		},
+		{
+			name:    "just arg, good",
+			flagSet: goodFlagSet(),
+			args:    []string{"987"},
+			expID:   987,
+		},
+		{
+			name:    "neither flag nor arg",
+			flagSet: goodFlagSet(),
+			expErr:  "no <receipt id> provided",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var id uint64
+			testFunc := func() {
+				id, err = cli.ReadFlagReceiptOrArg(tc.flagSet, tc.args)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagReceiptOrArg")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagReceiptOrArg error")
+			assert.Equal(t, int(tc.expID), int(id), "ReadFlagReceiptOrArg id")
+		})
+	}
+}
+
 func TestReadFlagMarketOrArg(t *testing.T) {
 	theFlag := cli.FlagMarket
 	goodFlagSet := func() *pflag.FlagSet {
@@ -1356,6 +1447,68 @@ func TestReadCoinFlag(t *testing.T) {
 	}
 }
 
+func TestReadIntFlag(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string
+		expInt   *sdkmath.Int
+		expErr   string
+	}{
+		{
+			testName: "unknown flag",
+			name:     "unknown",
+			expErr:   "flag accessed but not defined: unknown",
+		},
+		{
+			testName: "wrong flag type",
+			name:     flagInt,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "nothing provided",
+			name:     flagString,
+			expErr:   "",
+		},
+		{
+			testName: "invalid int",
+			flags:    []string{"--" + flagString, "1.5"},
+			name:     flagString,
+			expErr:   "error parsing --" + flagString + " as an integer: invalid value \"1.5\"",
+		},
+		{
+			testName: "zero",
+			flags:    []string{"--" + flagString, "0"},
+			name:     flagString,
+			expInt:   func() *sdkmath.Int { rv := sdkmath.NewInt(0); return &rv }(),
+		},
+		{
+			testName: "big number",
+			flags:    []string{"--" + flagString, "123456789012345678901234567890"},
+			name:     flagString,
+			expInt:   func() *sdkmath.Int { rv, _ := sdkmath.NewIntFromString("123456789012345678901234567890"); return &rv }(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "An int")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var amt *sdkmath.Int
+			testFunc := func() {
+				amt, err = cli.ReadIntFlag(flagSet, tc.name)
+			}
+			require.NotPanics(t, testFunc, "ReadIntFlag(%q)", tc.name)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadIntFlag(%q) error", tc.name)
+			assert.Equal(t, tc.expInt, amt, "ReadIntFlag(%q)", tc.name)
+		})
+	}
+}
+
 func TestReadReqCoinFlag(t *testing.T) {
 	tests := []struct {
 		testName string
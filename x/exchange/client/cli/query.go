@@ -27,12 +27,18 @@ func CmdQuery() *cobra.Command {
 		CmdQueryGetOwnerOrders(),
 		CmdQueryGetAssetOrders(),
 		CmdQueryGetAllOrders(),
+		CmdQueryGetSettlementReceipt(),
+		CmdQueryGetRFQ(),
+		CmdQueryGetMarketRFQs(),
 		CmdQueryGetCommitment(),
 		CmdQueryGetAccountCommitments(),
 		CmdQueryGetMarketCommitments(),
 		CmdQueryGetAllCommitments(),
 		CmdQueryGetMarket(),
 		CmdQueryGetAllMarkets(),
+		CmdQueryGetMarketRebates(),
+		CmdQueryGetMarketExternalSettlements(),
+		CmdQueryGetMarketPriceBands(),
 		CmdQueryParams(),
 		CmdQueryCommitmentSettlementFeeCalc(),
 		CmdQueryValidateCreateMarket(),
@@ -146,6 +152,48 @@ func CmdQueryGetAllOrders() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetSettlementReceipt creates the receipt sub-command for the exchange query command.
+func CmdQueryGetSettlementReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receipt",
+		Aliases: []string{"get-receipt", "settlement-receipt"},
+		Short:   "Get a settlement receipt by id",
+		RunE:    genericQueryRunE(MakeQueryGetSettlementReceipt, exchange.QueryClient.GetSettlementReceipt),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetSettlementReceipt(cmd)
+	return cmd
+}
+
+// CmdQueryGetRFQ creates the rfq sub-command for the exchange query command.
+func CmdQueryGetRFQ() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rfq",
+		Aliases: []string{"get-rfq", "request-for-quote"},
+		Short:   "Get a request for quote (and its quotes) by id",
+		RunE:    genericQueryRunE(MakeQueryGetRFQ, exchange.QueryClient.GetRFQ),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetRFQ(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketRFQs creates the market-rfqs sub-command for the exchange query command.
+func CmdQueryGetMarketRFQs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-rfqs",
+		Aliases: []string{"get-market-rfqs", "rfqs"},
+		Short:   "Get the requests for quote in a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketRFQs, exchange.QueryClient.GetMarketRFQs),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketRFQs(cmd)
+	return cmd
+}
+
 // CmdQueryGetCommitment creates the commitment sub-command for the exchange query command.
 func CmdQueryGetCommitment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -230,6 +278,48 @@ func CmdQueryGetAllMarkets() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetMarketRebates creates the market-rebates sub-command for the exchange query command.
+func CmdQueryGetMarketRebates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-rebates",
+		Aliases: []string{"get-market-rebates", "rebates"},
+		Short:   "Get a market's rebate configuration, pool, and earned liquidity points",
+		RunE:    genericQueryRunE(MakeQueryGetMarketRebates, exchange.QueryClient.GetMarketRebates),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketRebates(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketExternalSettlements creates the market-external-settlements sub-command for the exchange query command.
+func CmdQueryGetMarketExternalSettlements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-external-settlements",
+		Aliases: []string{"get-market-external-settlements", "external-settlements"},
+		Short:   "Get a market's external settlement configuration and pending external settlements",
+		RunE:    genericQueryRunE(MakeQueryGetMarketExternalSettlements, exchange.QueryClient.GetMarketExternalSettlements),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketExternalSettlements(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketPriceBands creates the market-price-bands sub-command for the exchange query command.
+func CmdQueryGetMarketPriceBands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-price-bands",
+		Aliases: []string{"get-market-price-bands", "price-bands"},
+		Short:   "Get a market's price band configuration and whether its settlements are paused",
+		RunE:    genericQueryRunE(MakeQueryGetMarketPriceBands, exchange.QueryClient.GetMarketPriceBands),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketPriceBands(cmd)
+	return cmd
+}
+
 // CmdQueryParams creates the params sub-command for the exchange query command.
 func CmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -289,6 +289,85 @@ func MakeQueryGetAllOrders(_ client.Context, flagSet *pflag.FlagSet, _ []string)
 	return req, err
 }
 
+// SetupCmdQueryGetSettlementReceipt adds all the flags needed for MakeQueryGetSettlementReceipt.
+func SetupCmdQueryGetSettlementReceipt(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagReceipt, 0, "The receipt id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<receipt id>|--%s <receipt id>}", FlagReceipt),
+	)
+	AddUseDetails(cmd, "A <receipt id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "12")
+	AddQueryExample(cmd, "--"+FlagReceipt, "12")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetSettlementReceipt reads all the SetupCmdQueryGetSettlementReceipt flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetSettlementReceipt(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetSettlementReceiptRequest, error) {
+	req := &exchange.QueryGetSettlementReceiptRequest{}
+
+	var err error
+	req.ReceiptId, err = ReadFlagReceiptOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetRFQ adds all the flags needed for MakeQueryGetRFQ.
+func SetupCmdQueryGetRFQ(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagRFQ, 0, "The rfq id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<rfq id>|--%s <rfq id>}", FlagRFQ),
+	)
+	AddUseDetails(cmd, "A <rfq id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "4")
+	AddQueryExample(cmd, "--"+FlagRFQ, "4")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetRFQ reads all the SetupCmdQueryGetRFQ flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetRFQ(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetRFQRequest, error) {
+	req := &exchange.QueryGetRFQRequest{}
+
+	var err error
+	req.RfqId, err = ReadFlagRFQOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketRFQs adds all the flags needed for MakeQueryGetMarketRFQs.
+func SetupCmdQueryGetMarketRFQs(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "rfqs")
+
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+flags.FlagLimit, "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketRFQs reads all the SetupCmdQueryGetMarketRFQs flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketRFQs(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketRFQsRequest, error) {
+	req := &exchange.QueryGetMarketRFQsRequest{}
+
+	errs := make([]error, 2)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
 // SetupCmdQueryGetCommitment adds all the flags needed for MakeQueryGetCommitment.
 func SetupCmdQueryGetCommitment(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAccount, "", "The account's address")
@@ -320,16 +399,18 @@ func MakeQueryGetCommitment(_ client.Context, flagSet *pflag.FlagSet, _ []string
 
 // SetupCmdQueryGetAccountCommitments adds all the flags needed for MakeQueryGetAccountCommitments.
 func SetupCmdQueryGetAccountCommitments(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "commitments")
 	cmd.Flags().String(FlagAccount, "", "The account's address")
 
 	AddUseArgs(cmd,
 		fmt.Sprintf("{<account>|--%s <account>}", FlagAccount),
+		PageFlagsUse,
 	)
 	AddUseDetails(cmd,
 		"An <account> is required as either an arg or flag, but not both.",
 	)
 	AddQueryExample(cmd, ExampleAddr)
-	AddQueryExample(cmd, "--"+FlagAccount, ExampleAddr)
+	AddQueryExample(cmd, "--"+FlagAccount, ExampleAddr, "--limit", "10")
 
 	cmd.Args = cobra.MaximumNArgs(1)
 }
@@ -339,10 +420,11 @@ func SetupCmdQueryGetAccountCommitments(cmd *cobra.Command) {
 func MakeQueryGetAccountCommitments(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetAccountCommitmentsRequest, error) {
 	rv := &exchange.QueryGetAccountCommitmentsRequest{}
 
-	var err error
-	rv.Account, err = ReadStringFlagOrArg(flagSet, args, FlagAccount, "account")
+	errs := make([]error, 2)
+	rv.Account, errs[0] = ReadStringFlagOrArg(flagSet, args, FlagAccount, "account")
+	rv.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
 
-	return rv, err
+	return rv, errors.Join(errs...)
 }
 
 // SetupCmdQueryGetMarketCommitments adds all the flags needed for MakeQueryGetMarketCommitments.
@@ -444,6 +526,81 @@ func MakeQueryGetAllMarkets(_ client.Context, flagSet *pflag.FlagSet, _ []string
 	return req, err
 }
 
+// SetupCmdQueryGetMarketRebates adds all the flags needed for MakeQueryGetMarketRebates.
+func SetupCmdQueryGetMarketRebates(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketRebates reads all the SetupCmdQueryGetMarketRebates flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketRebates(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketRebatesRequest, error) {
+	req := &exchange.QueryGetMarketRebatesRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketExternalSettlements adds all the flags needed for MakeQueryGetMarketExternalSettlements.
+func SetupCmdQueryGetMarketExternalSettlements(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketExternalSettlements reads all the SetupCmdQueryGetMarketExternalSettlements flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketExternalSettlements(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketExternalSettlementsRequest, error) {
+	req := &exchange.QueryGetMarketExternalSettlementsRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketPriceBands adds all the flags needed for MakeQueryGetMarketPriceBands.
+func SetupCmdQueryGetMarketPriceBands(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketPriceBands reads all the SetupCmdQueryGetMarketPriceBands flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketPriceBands(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketPriceBandsRequest, error) {
+	req := &exchange.QueryGetMarketPriceBandsRequest{}
+
+	var err error
+	req.MarketId, err = ReadFlagMarketOrArg(flagSet, args)
+
+	return req, err
+}
+
 // SetupCmdQueryParams adds all the flags needed for MakeQueryParams.
 func SetupCmdQueryParams(cmd *cobra.Command) {
 	AddUseDetails(cmd)
@@ -729,6 +729,193 @@ func TestMakeQueryGetAllOrders(t *testing.T) {
 	}
 }
 
+func TestSetupCmdQueryGetSettlementReceipt(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetSettlementReceipt",
+		setup:    cli.SetupCmdQueryGetSettlementReceipt,
+		expFlags: []string{cli.FlagReceipt},
+		expInUse: []string{
+			"{<receipt id>|--receipt <receipt id>}",
+			"A <receipt id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 12",
+			exampleStart + " --receipt 12",
+		},
+	})
+}
+
+func TestMakeQueryGetSettlementReceipt(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetSettlementReceiptRequest]{
+		makerName: "MakeQueryGetSettlementReceipt",
+		maker:     cli.MakeQueryGetSettlementReceipt,
+		setup:     cli.SetupCmdQueryGetSettlementReceipt,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetSettlementReceiptRequest]{
+		{
+			name:   "no receipt id",
+			expReq: &exchange.QueryGetSettlementReceiptRequest{},
+			expErr: "no <receipt id> provided",
+		},
+		{
+			name:   "just receipt flag",
+			flags:  []string{"--receipt", "4"},
+			expReq: &exchange.QueryGetSettlementReceiptRequest{ReceiptId: 4},
+		},
+		{
+			name:   "just receipt id arg",
+			args:   []string{"52"},
+			expReq: &exchange.QueryGetSettlementReceiptRequest{ReceiptId: 52},
+		},
+		{
+			name:   "both receipt flag and arg",
+			flags:  []string{"--receipt", "4"},
+			args:   []string{"52"},
+			expReq: &exchange.QueryGetSettlementReceiptRequest{},
+			expErr: "cannot provide <receipt id> as both an arg (\"52\") and flag (--receipt 4)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetRFQ(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetRFQ",
+		setup:    cli.SetupCmdQueryGetRFQ,
+		expFlags: []string{cli.FlagRFQ},
+		expInUse: []string{
+			"{<rfq id>|--rfq <rfq id>}",
+			"A <rfq id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 4",
+			exampleStart + " --rfq 4",
+		},
+	})
+}
+
+func TestMakeQueryGetRFQ(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetRFQRequest]{
+		makerName: "MakeQueryGetRFQ",
+		maker:     cli.MakeQueryGetRFQ,
+		setup:     cli.SetupCmdQueryGetRFQ,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetRFQRequest]{
+		{
+			name:   "no rfq id",
+			expReq: &exchange.QueryGetRFQRequest{},
+			expErr: "no <rfq id> provided",
+		},
+		{
+			name:   "just rfq flag",
+			flags:  []string{"--rfq", "3"},
+			expReq: &exchange.QueryGetRFQRequest{RfqId: 3},
+		},
+		{
+			name:   "just rfq id arg",
+			args:   []string{"71"},
+			expReq: &exchange.QueryGetRFQRequest{RfqId: 71},
+		},
+		{
+			name:   "both rfq flag and arg",
+			flags:  []string{"--rfq", "3"},
+			args:   []string{"71"},
+			expReq: &exchange.QueryGetRFQRequest{},
+			expErr: "cannot provide <rfq id> as both an arg (\"71\") and flag (--rfq 3)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketRFQs(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketRFQs",
+		setup: cli.SetupCmdQueryGetMarketRFQs,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket,
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}", cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1 --limit 10",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketRFQs(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketRFQsRequest]{
+		makerName: "MakeQueryGetMarketRFQs",
+		maker:     cli.MakeQueryGetMarketRFQs,
+		setup:     cli.SetupCmdQueryGetMarketRFQs,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketRFQsRequest]{
+		{
+			name:   "no market id",
+			expReq: &exchange.QueryGetMarketRFQsRequest{Pagination: defaultPageReq},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "just market id flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetMarketRFQsRequest{MarketId: 2, Pagination: defaultPageReq},
+		},
+		{
+			name:   "just market id arg",
+			args:   []string{"8"},
+			expReq: &exchange.QueryGetMarketRFQsRequest{MarketId: 8, Pagination: defaultPageReq},
+		},
+		{
+			name:   "both market id flag and arg",
+			flags:  []string{"--market", "2"},
+			args:   []string{"8"},
+			expReq: &exchange.QueryGetMarketRFQsRequest{Pagination: defaultPageReq},
+			expErr: "cannot provide <market id> as both an arg (\"8\") and flag (--market 2)",
+		},
+		{
+			name:  "all opts",
+			flags: []string{"--limit", "12", "--offset", "3", "--count-total"},
+			args:  []string{"5"},
+			expReq: &exchange.QueryGetMarketRFQsRequest{
+				MarketId: 5,
+				Pagination: &query.PageRequest{
+					Key:        []byte{},
+					Offset:     3,
+					Limit:      12,
+					CountTotal: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdQueryGetCommitment(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdQueryGetCommitment",
@@ -784,15 +971,18 @@ func TestSetupCmdQueryGetAccountCommitments(t *testing.T) {
 		name:  "SetupCmdQueryGetAccountCommitments",
 		setup: cli.SetupCmdQueryGetAccountCommitments,
 		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
 			cli.FlagAccount,
 		},
 		expInUse: []string{
 			"{<account>|--account <account>}",
+			cli.PageFlagsUse,
 			"An <account> is required as either an arg or flag, but not both.",
 		},
 		expExamples: []string{
 			exampleStart + " " + cli.ExampleAddr,
-			exampleStart + " --account " + cli.ExampleAddr,
+			exampleStart + " --account " + cli.ExampleAddr + " --limit 10",
 		},
 	})
 }
@@ -804,33 +994,51 @@ func TestMakeQueryGetAccountCommitments(t *testing.T) {
 		setup:     cli.SetupCmdQueryGetAccountCommitments,
 	}
 
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
 	tests := []queryMakerTestCase[exchange.QueryGetAccountCommitmentsRequest]{
 		{
 			name:   "no account",
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{Pagination: defaultPageReq},
 			expErr: "no <account> provided",
 		},
 		{
 			name:  "account as flag",
 			flags: []string{"--account", "someaddr"},
 			expReq: &exchange.QueryGetAccountCommitmentsRequest{
-				Account: "someaddr",
+				Account:    "someaddr",
+				Pagination: defaultPageReq,
 			},
 		},
 		{
 			name: "account as arg",
 			args: []string{"otheraddr"},
 			expReq: &exchange.QueryGetAccountCommitmentsRequest{
-				Account: "otheraddr",
+				Account:    "otheraddr",
+				Pagination: defaultPageReq,
 			},
 		},
 		{
 			name:   "account as flag and arg",
 			flags:  []string{"--account", "someaddr"},
 			args:   []string{"otheraddr"},
-			expReq: &exchange.QueryGetAccountCommitmentsRequest{},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{Pagination: defaultPageReq},
 			expErr: "cannot provide <account> as both an arg (\"otheraddr\") and flag (--account \"someaddr\")",
 		},
+		{
+			name:  "with pagination",
+			flags: []string{"--limit", "2", "--reverse", "--account", "someaddr"},
+			expReq: &exchange.QueryGetAccountCommitmentsRequest{
+				Account: "someaddr",
+				Pagination: &query.PageRequest{
+					Key:     []byte{},
+					Limit:   2,
+					Reverse: true,
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1081,6 +1289,150 @@ func TestMakeQueryGetAllMarkets(t *testing.T) {
 	}
 }
 
+func TestSetupCmdQueryGetMarketRebates(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetMarketRebates",
+		setup:    cli.SetupCmdQueryGetMarketRebates,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketRebates(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketRebatesRequest]{
+		makerName: "MakeQueryGetMarketRebates",
+		maker:     cli.MakeQueryGetMarketRebates,
+		setup:     cli.SetupCmdQueryGetMarketRebates,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetMarketRebatesRequest]{
+		{
+			name:   "no market",
+			expReq: &exchange.QueryGetMarketRebatesRequest{},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetMarketRebatesRequest{MarketId: 2},
+		},
+		{
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketRebatesRequest{MarketId: 1000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketExternalSettlements(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetMarketExternalSettlements",
+		setup:    cli.SetupCmdQueryGetMarketExternalSettlements,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketExternalSettlements(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketExternalSettlementsRequest]{
+		makerName: "MakeQueryGetMarketExternalSettlements",
+		maker:     cli.MakeQueryGetMarketExternalSettlements,
+		setup:     cli.SetupCmdQueryGetMarketExternalSettlements,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetMarketExternalSettlementsRequest]{
+		{
+			name:   "no market",
+			expReq: &exchange.QueryGetMarketExternalSettlementsRequest{},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetMarketExternalSettlementsRequest{MarketId: 2},
+		},
+		{
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketExternalSettlementsRequest{MarketId: 1000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketPriceBands(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetMarketPriceBands",
+		setup:    cli.SetupCmdQueryGetMarketPriceBands,
+		expFlags: []string{cli.FlagMarket},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketPriceBands(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketPriceBandsRequest]{
+		makerName: "MakeQueryGetMarketPriceBands",
+		maker:     cli.MakeQueryGetMarketPriceBands,
+		setup:     cli.SetupCmdQueryGetMarketPriceBands,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetMarketPriceBandsRequest]{
+		{
+			name:   "no market",
+			expReq: &exchange.QueryGetMarketPriceBandsRequest{},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "just flag",
+			flags:  []string{"--market", "2"},
+			expReq: &exchange.QueryGetMarketPriceBandsRequest{MarketId: 2},
+		},
+		{
+			name:   "just arg",
+			args:   []string{"1000"},
+			expReq: &exchange.QueryGetMarketPriceBandsRequest{MarketId: 1000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdQueryParams(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:        "SetupCmdQueryParams",
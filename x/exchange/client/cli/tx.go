@@ -31,6 +31,13 @@ func CmdTx() *cobra.Command {
 		CmdTxFillBids(),
 		CmdTxFillAsks(),
 		CmdTxMarketSettle(),
+		CmdTxMarketStartExternalSettlement(),
+		CmdTxConfirmExternalPayment(),
+		CmdTxCreateRFQ(),
+		CmdTxCancelRFQ(),
+		CmdTxSubmitQuote(),
+		CmdTxWithdrawQuote(),
+		CmdTxAcceptQuote(),
 		CmdTxMarketCommitmentSettle(),
 		CmdTxMarketReleaseCommitments(),
 		CmdTxMarketSetOrderExternalID(),
@@ -40,6 +47,10 @@ func CmdTx() *cobra.Command {
 		CmdTxMarketUpdateUserSettle(),
 		CmdTxMarketUpdateAcceptingCommitments(),
 		CmdTxMarketUpdateIntermediaryDenom(),
+		CmdTxMarketUpdateRebates(),
+		CmdTxMarketUpdateExternalSettlement(),
+		CmdTxMarketUpdatePriceBand(),
+		CmdTxMarketResumeSettlements(),
 		CmdTxMarketManagePermissions(),
 		CmdTxMarketManageReqAttrs(),
 		CmdTxCreatePayment(),
@@ -48,6 +59,7 @@ func CmdTx() *cobra.Command {
 		CmdTxRejectPayments(),
 		CmdTxCancelPayments(),
 		CmdTxChangePaymentTarget(),
+		CmdTxIssuerCreateMarket(),
 		CmdTxGovCreateMarket(),
 		CmdTxGovManageFees(),
 		CmdTxGovCloseMarket(),
@@ -153,6 +165,101 @@ func CmdTxMarketSettle() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketStartExternalSettlement creates the market-start-external-settlement sub-command for the exchange tx command.
+func CmdTxMarketStartExternalSettlement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-start-external-settlement",
+		Aliases: []string{"start-external-settlement", "external-settle"},
+		Short:   "Lock an ask order for settlement with an off-chain payment",
+		RunE:    genericTxRunE(MakeMsgMarketStartExternalSettlement),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketStartExternalSettlement(cmd)
+	return cmd
+}
+
+// CmdTxConfirmExternalPayment creates the confirm-external-payment sub-command for the exchange tx command.
+func CmdTxConfirmExternalPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "confirm-external-payment",
+		Aliases: []string{"confirm-payment", "external-payment"},
+		Short:   "Confirm the off-chain payment for an external settlement",
+		RunE:    genericTxRunE(MakeMsgConfirmExternalPayment),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxConfirmExternalPayment(cmd)
+	return cmd
+}
+
+// CmdTxCreateRFQ creates the create-rfq sub-command for the exchange tx command.
+func CmdTxCreateRFQ() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-rfq",
+		Aliases: []string{"rfq", "request-for-quote"},
+		Short:   "Request quotes from dealers for some assets",
+		RunE:    genericTxRunE(MakeMsgCreateRFQ),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateRFQ(cmd)
+	return cmd
+}
+
+// CmdTxCancelRFQ creates the cancel-rfq sub-command for the exchange tx command.
+func CmdTxCancelRFQ() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-rfq",
+		Short: "Cancel a request for quote",
+		RunE:  genericTxRunE(MakeMsgCancelRFQ),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCancelRFQ(cmd)
+	return cmd
+}
+
+// CmdTxSubmitQuote creates the submit-quote sub-command for the exchange tx command.
+func CmdTxSubmitQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-quote",
+		Aliases: []string{"quote"},
+		Short:   "Offer to sell the assets of a request for quote for a price",
+		RunE:    genericTxRunE(MakeMsgSubmitQuote),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxSubmitQuote(cmd)
+	return cmd
+}
+
+// CmdTxWithdrawQuote creates the withdraw-quote sub-command for the exchange tx command.
+func CmdTxWithdrawQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-quote",
+		Short: "Withdraw a quote from a request for quote",
+		RunE:  genericTxRunE(MakeMsgWithdrawQuote),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxWithdrawQuote(cmd)
+	return cmd
+}
+
+// CmdTxAcceptQuote creates the accept-quote sub-command for the exchange tx command.
+func CmdTxAcceptQuote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-quote",
+		Short: "Accept a quote and settle it",
+		RunE:  genericTxRunE(MakeMsgAcceptQuote),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxAcceptQuote(cmd)
+	return cmd
+}
+
 // CmdTxMarketCommitmentSettle creates the market-commitment-settle sub-command for the exchange tx command.
 func CmdTxMarketCommitmentSettle() *cobra.Command {
 	cmd := &cobra.Command{
@@ -279,6 +386,62 @@ func CmdTxMarketUpdateIntermediaryDenom() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketUpdateRebates creates the market-rebates sub-command for the exchange tx command.
+func CmdTxMarketUpdateRebates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-rebates",
+		Aliases: []string{"market-update-rebates", "update-market-rebates", "update-rebates"},
+		Short:   "Change a market's liquidity rebate configuration",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateRebates),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateRebates(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdateExternalSettlement creates the market-external-settlement sub-command for the exchange tx command.
+func CmdTxMarketUpdateExternalSettlement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-external-settlement",
+		Aliases: []string{"market-update-external-settlement", "update-market-external-settlement", "update-external-settlement"},
+		Short:   "Change a market's external settlement configuration",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateExternalSettlement),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateExternalSettlement(cmd)
+	return cmd
+}
+
+// CmdTxMarketUpdatePriceBand creates the market-price-band sub-command for the exchange tx command.
+func CmdTxMarketUpdatePriceBand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-price-band",
+		Aliases: []string{"market-update-price-band", "update-market-price-band", "update-price-band"},
+		Short:   "Change a market's price band configuration",
+		RunE:    genericTxRunE(MakeMsgMarketUpdatePriceBand),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdatePriceBand(cmd)
+	return cmd
+}
+
+// CmdTxMarketResumeSettlements creates the market-resume-settlements sub-command for the exchange tx command.
+func CmdTxMarketResumeSettlements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-resume-settlements",
+		Aliases: []string{"resume-market-settlements", "resume-settlements"},
+		Short:   "Resume a market's settlements that were paused because of its price band",
+		RunE:    genericTxRunE(MakeMsgMarketResumeSettlements),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketResumeSettlements(cmd)
+	return cmd
+}
+
 // CmdTxMarketManagePermissions creates the market-permissions sub-command for the exchange tx command.
 func CmdTxMarketManagePermissions() *cobra.Command {
 	cmd := &cobra.Command{
@@ -392,6 +555,19 @@ func CmdTxChangePaymentTarget() *cobra.Command {
 	return cmd
 }
 
+// CmdTxIssuerCreateMarket creates the issuer-create-market sub-command for the exchange tx command.
+func CmdTxIssuerCreateMarket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuer-create-market",
+		Short: "Create a market for a marker that you administer",
+		RunE:  genericTxRunE(MakeMsgIssuerCreateMarket),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxIssuerCreateMarket(cmd)
+	return cmd
+}
+
 // CmdTxGovCreateMarket creates the gov-create-market sub-command for the exchange tx command.
 func CmdTxGovCreateMarket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -21,6 +21,9 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
 	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
+	cmd.Flags().Bool(FlagFillOrKill, false, "Cancel whatever is left of this order after it is partially filled")
+	cmd.Flags().String(FlagMinFill, "", "The minimum amount of assets that this order can be partially filled with")
+	cmd.Flags().Bool(FlagAllOrNothing, false, "Only settle this order with other orders that are all filled in full")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
 	cmd.Flags().String(FlagCreationFee, "", "The ask order creation fee, e.g. 10nhash")
 
@@ -35,6 +38,9 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
 		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagFillOrKill, ""),
+		OptFlagUse(FlagMinFill, "min fill assets amount"),
+		OptFlagUse(FlagAllOrNothing, ""),
 		OptFlagUse(FlagExternalID, "external id"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
 	)
@@ -48,7 +54,7 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateAskRequest, error) {
 	msg := &exchange.MsgCreateAskRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 11)
 	msg.AskOrder.Seller, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSeller)
 	msg.AskOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.AskOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
@@ -57,6 +63,9 @@ func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 	msg.AskOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.AskOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
 	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.AskOrder.FillOrKill, errs[8] = flagSet.GetBool(FlagFillOrKill)
+	msg.AskOrder.MinFillAssets, errs[9] = ReadIntFlag(flagSet, FlagMinFill)
+	msg.AskOrder.AllOrNothing, errs[10] = flagSet.GetBool(FlagAllOrNothing)
 
 	return msg, errors.Join(errs...)
 }
@@ -69,6 +78,9 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
 	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
+	cmd.Flags().Bool(FlagFillOrKill, false, "Cancel whatever is left of this order after it is partially filled")
+	cmd.Flags().String(FlagMinFill, "", "The minimum amount of assets that this order can be partially filled with")
+	cmd.Flags().Bool(FlagAllOrNothing, false, "Only settle this order with other orders that are all filled in full")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
 	cmd.Flags().String(FlagCreationFee, "", "The bid order creation fee, e.g. 10nhash")
 
@@ -83,6 +95,9 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 		UseFlagsBreak,
 		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
 		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagFillOrKill, ""),
+		OptFlagUse(FlagMinFill, "min fill assets amount"),
+		OptFlagUse(FlagAllOrNothing, ""),
 		OptFlagUse(FlagExternalID, "external id"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
 	)
@@ -96,7 +111,7 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateBidRequest, error) {
 	msg := &exchange.MsgCreateBidRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 11)
 	msg.BidOrder.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
 	msg.BidOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.BidOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
@@ -105,6 +120,9 @@ func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 	msg.BidOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.BidOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
 	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.BidOrder.FillOrKill, errs[8] = flagSet.GetBool(FlagFillOrKill)
+	msg.BidOrder.MinFillAssets, errs[9] = ReadIntFlag(flagSet, FlagMinFill)
+	msg.BidOrder.AllOrNothing, errs[10] = flagSet.GetBool(FlagAllOrNothing)
 
 	return msg, errors.Join(errs...)
 }
@@ -270,6 +288,8 @@ func SetupCmdTxMarketSettle(cmd *cobra.Command) {
 	cmd.Flags().UintSlice(FlagAsks, nil, "The ask order ids (repeatable, required)")
 	cmd.Flags().UintSlice(FlagBids, nil, "The bid order ids (repeatable, required)")
 	cmd.Flags().Bool(FlagPartial, false, "Expect partial settlement")
+	cmd.Flags().Bool(FlagNet, false, "Only transfer the net amounts owed between each pair of parties")
+	cmd.Flags().Bool(FlagOverride, false, "Settle even if outside the market's price band or the market's settlements are paused")
 
 	MarkFlagsRequired(cmd, FlagMarket, FlagAsks, FlagBids)
 
@@ -279,8 +299,12 @@ func SetupCmdTxMarketSettle(cmd *cobra.Command) {
 		ReqFlagUse(FlagAsks, "ask order ids"),
 		ReqFlagUse(FlagBids, "bid order ids"),
 		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagNet, ""),
+		OptFlagUse(FlagOverride, ""),
+	)
+	AddUseDetails(cmd, ReqAdminDesc, RepeatableDesc,
+		"Using --"+FlagOverride+" requires the admin to also have update permission in the market.",
 	)
-	AddUseDetails(cmd, ReqAdminDesc, RepeatableDesc)
 
 	cmd.Args = cobra.NoArgs
 }
@@ -290,12 +314,252 @@ func SetupCmdTxMarketSettle(cmd *cobra.Command) {
 func MakeMsgMarketSettle(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketSettleRequest, error) {
 	msg := &exchange.MsgMarketSettleRequest{}
 
-	errs := make([]error, 5)
+	errs := make([]error, 7)
 	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
 	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.AskOrderIds, errs[2] = ReadOrderIDsFlag(flagSet, FlagAsks)
 	msg.BidOrderIds, errs[3] = ReadOrderIDsFlag(flagSet, FlagBids)
 	msg.ExpectPartial, errs[4] = flagSet.GetBool(FlagPartial)
+	msg.NetTransfers, errs[5] = flagSet.GetBool(FlagNet)
+	msg.OverridePriceBand, errs[6] = flagSet.GetBool(FlagOverride)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketStartExternalSettlement adds all the flags needed for MakeMsgMarketStartExternalSettlement.
+func SetupCmdTxMarketStartExternalSettlement(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint64(FlagOrder, 0, "The ask order id (required)")
+	cmd.Flags().String(FlagBuyer, "", "The buyer that will pay off-chain and receive the assets (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket, FlagOrder, FlagBuyer)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagOrder, "order id"),
+		ReqFlagUse(FlagBuyer, "buyer"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketStartExternalSettlement reads all the SetupCmdTxMarketStartExternalSettlement flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketStartExternalSettlement(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketStartExternalSettlementRequest, error) {
+	msg := &exchange.MsgMarketStartExternalSettlementRequest{}
+
+	errs := make([]error, 4)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.OrderId, errs[2] = flagSet.GetUint64(FlagOrder)
+	msg.Buyer, errs[3] = flagSet.GetString(FlagBuyer)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxConfirmExternalPayment adds all the flags needed for MakeMsgConfirmExternalPayment.
+func SetupCmdTxConfirmExternalPayment(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOracle, "", "The oracle account (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint64(FlagOrder, 0, "The ask order id (required)")
+	cmd.Flags().String(FlagReference, "", "The reference of the off-chain payment (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagOracle)
+	MarkFlagsRequired(cmd, FlagMarket, FlagOrder, FlagReference)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagOracle),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagOrder, "order id"),
+		ReqFlagUse(FlagReference, "payment reference"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagOracle))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgConfirmExternalPayment reads all the SetupCmdTxConfirmExternalPayment flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgConfirmExternalPayment(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgConfirmExternalPaymentRequest, error) {
+	msg := &exchange.MsgConfirmExternalPaymentRequest{}
+
+	errs := make([]error, 4)
+	msg.Oracle, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagOracle)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.OrderId, errs[2] = flagSet.GetUint64(FlagOrder)
+	msg.PaymentReference, errs[3] = flagSet.GetString(FlagReference)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCreateRFQ adds all the flags needed for MakeMsgCreateRFQ.
+func SetupCmdTxCreateRFQ(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBuyer, "", "The buyer (defaults to --from account)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagAssets, "", "The assets to buy, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagPriceDenom, "", "The denom that quotes must be priced in (required)")
+	cmd.Flags().Int64(FlagExpiration, 0, "The block height at which the request will be cancelled (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
+	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPriceDenom, FlagExpiration)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagBuyer),
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAssets, "assets"),
+		ReqFlagUse(FlagPriceDenom, "denom"),
+		ReqFlagUse(FlagExpiration, "height"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateRFQ reads all the SetupCmdTxCreateRFQ flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateRFQ(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateRFQRequest, error) {
+	msg := &exchange.MsgCreateRFQRequest{}
+
+	errs := make([]error, 5)
+	msg.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
+	msg.PriceDenom, errs[3] = flagSet.GetString(FlagPriceDenom)
+	msg.ExpirationHeight, errs[4] = flagSet.GetInt64(FlagExpiration)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCancelRFQ adds all the flags needed for MakeMsgCancelRFQ.
+func SetupCmdTxCancelRFQ(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBuyer, "", "The buyer (defaults to --from account)")
+	cmd.Flags().Uint64(FlagRFQ, 0, "The rfq id (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
+	MarkFlagsRequired(cmd, FlagRFQ)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagBuyer),
+		ReqFlagUse(FlagRFQ, "rfq id"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCancelRFQ reads all the SetupCmdTxCancelRFQ flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCancelRFQ(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCancelRFQRequest, error) {
+	msg := &exchange.MsgCancelRFQRequest{}
+
+	errs := make([]error, 2)
+	msg.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
+	msg.RfqId, errs[1] = flagSet.GetUint64(FlagRFQ)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxSubmitQuote adds all the flags needed for MakeMsgSubmitQuote.
+func SetupCmdTxSubmitQuote(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDealer, "", "The dealer (defaults to --from account)")
+	cmd.Flags().Uint64(FlagRFQ, 0, "The rfq id (required)")
+	cmd.Flags().String(FlagPrice, "", "The price you want for the assets, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this quote, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagDealer)
+	MarkFlagsRequired(cmd, FlagRFQ, FlagPrice)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagDealer),
+		ReqFlagUse(FlagRFQ, "rfq id"),
+		ReqFlagUse(FlagPrice, "price"),
+		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagDealer))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgSubmitQuote reads all the SetupCmdTxSubmitQuote flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgSubmitQuote(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgSubmitQuoteRequest, error) {
+	msg := &exchange.MsgSubmitQuoteRequest{}
+
+	errs := make([]error, 4)
+	msg.Dealer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagDealer)
+	msg.RfqId, errs[1] = flagSet.GetUint64(FlagRFQ)
+	msg.Price, errs[2] = ReadReqCoinFlag(flagSet, FlagPrice)
+	msg.SellerSettlementFlatFee, errs[3] = ReadCoinFlag(flagSet, FlagSettlementFee)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxWithdrawQuote adds all the flags needed for MakeMsgWithdrawQuote.
+func SetupCmdTxWithdrawQuote(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDealer, "", "The dealer (defaults to --from account)")
+	cmd.Flags().Uint64(FlagRFQ, 0, "The rfq id (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagDealer)
+	MarkFlagsRequired(cmd, FlagRFQ)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagDealer),
+		ReqFlagUse(FlagRFQ, "rfq id"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagDealer))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgWithdrawQuote reads all the SetupCmdTxWithdrawQuote flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgWithdrawQuote(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgWithdrawQuoteRequest, error) {
+	msg := &exchange.MsgWithdrawQuoteRequest{}
+
+	errs := make([]error, 2)
+	msg.Dealer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagDealer)
+	msg.RfqId, errs[1] = flagSet.GetUint64(FlagRFQ)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxAcceptQuote adds all the flags needed for MakeMsgAcceptQuote.
+func SetupCmdTxAcceptQuote(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBuyer, "", "The buyer (defaults to --from account)")
+	cmd.Flags().Uint64(FlagRFQ, 0, "The rfq id (required)")
+	cmd.Flags().String(FlagDealer, "", "The dealer whose quote is being accepted (required)")
+	cmd.Flags().String(FlagPrice, "", "The price of the quote being accepted, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this settlement, e.g. 10nhash")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
+	MarkFlagsRequired(cmd, FlagRFQ, FlagDealer, FlagPrice)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagBuyer),
+		ReqFlagUse(FlagRFQ, "rfq id"),
+		ReqFlagUse(FlagDealer, "dealer"),
+		ReqFlagUse(FlagPrice, "price"),
+		OptFlagUse(FlagSettlementFee, "buyer settlement fees"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgAcceptQuote reads all the SetupCmdTxAcceptQuote flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgAcceptQuote(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgAcceptQuoteRequest, error) {
+	msg := &exchange.MsgAcceptQuoteRequest{}
+
+	errs := make([]error, 5)
+	msg.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
+	msg.RfqId, errs[1] = flagSet.GetUint64(FlagRFQ)
+	msg.Dealer, errs[2] = flagSet.GetString(FlagDealer)
+	msg.Price, errs[3] = ReadReqCoinFlag(flagSet, FlagPrice)
+	msg.BuyerSettlementFees, errs[4] = ReadCoinsFlag(flagSet, FlagSettlementFee)
 
 	return msg, errors.Join(errs...)
 }
@@ -650,6 +914,167 @@ func MakeMsgMarketUpdateIntermediaryDenom(clientCtx client.Context, flagSet *pfl
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketUpdateRebates adds all the flags needed for MakeMsgMarketUpdateRebates.
+func SetupCmdTxMarketUpdateRebates(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint32(FlagBips, 0, "The portion of market fees to set aside for rebates (min=0, max=10,000)")
+	cmd.Flags().String(FlagDenom, "", "The denom of order prices that earn liquidity points")
+	cmd.Flags().Int64(FlagSampleInterval, 0, "The number of blocks between samplings of resting orders")
+	cmd.Flags().Int64(FlagPayoutInterval, 0, "The number of blocks between rebate payouts")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		OptFlagUse(FlagBips, "bips"),
+		OptFlagUse(FlagDenom, "price denom"),
+		OptFlagUse(FlagSampleInterval, "blocks"),
+		OptFlagUse(FlagPayoutInterval, "blocks"),
+	)
+	AddUseDetails(cmd,
+		ReqAdminDesc,
+		`Providing a --bips of zero (or omitting it) disables rebates for the market.
+When disabling rebates, the other rebate flags must not be provided.
+Disabling rebates will immediately pay out any funds in the rebate pool.`,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateRebates reads all the SetupCmdTxMarketUpdateRebates flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateRebates(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateRebatesRequest, error) {
+	msg := &exchange.MsgMarketUpdateRebatesRequest{}
+
+	errs := make([]error, 6)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.Config.PoolBips, errs[2] = flagSet.GetUint32(FlagBips)
+	msg.Config.PriceDenom, errs[3] = flagSet.GetString(FlagDenom)
+	msg.Config.SampleInterval, errs[4] = flagSet.GetInt64(FlagSampleInterval)
+	msg.Config.PayoutInterval, errs[5] = flagSet.GetInt64(FlagPayoutInterval)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdateExternalSettlement adds all the flags needed for MakeMsgMarketUpdateExternalSettlement.
+func SetupCmdTxMarketUpdateExternalSettlement(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().StringSlice(FlagOracles, nil, "The accounts allowed to confirm external payments (repeatable)")
+	cmd.Flags().Int64(FlagTimeout, 0, "The number of blocks an external settlement can wait for payment confirmation")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		OptFlagUse(FlagOracles, "oracles"),
+		OptFlagUse(FlagTimeout, "blocks"),
+	)
+	AddUseDetails(cmd,
+		ReqAdminDesc,
+		RepeatableDesc,
+		`Omitting --oracles disables external settlement for the market.
+When disabling external settlement, the --timeout flag must not be provided.`,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateExternalSettlement reads all the SetupCmdTxMarketUpdateExternalSettlement flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateExternalSettlement(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateExternalSettlementRequest, error) {
+	msg := &exchange.MsgMarketUpdateExternalSettlementRequest{}
+
+	errs := make([]error, 4)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.Config.Oracles, errs[2] = flagSet.GetStringSlice(FlagOracles)
+	msg.Config.TimeoutBlocks, errs[3] = flagSet.GetInt64(FlagTimeout)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketUpdatePriceBand adds all the flags needed for MakeMsgMarketUpdatePriceBand.
+func SetupCmdTxMarketUpdatePriceBand(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().Uint32(FlagBips, 0, "The max deviation from the reference price (min=0, max=10,000)")
+	cmd.Flags().String(FlagReference, "", "The reference price to compare settlements against: last-settlement or nav")
+	cmd.Flags().String(FlagAction, "", "What to do when a settlement is outside the price band: reject or pause")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		OptFlagUse(FlagBips, "bips"),
+		OptFlagUse(FlagReference, "reference"),
+		OptFlagUse(FlagAction, "action"),
+	)
+	AddUseDetails(cmd,
+		ReqAdminDesc,
+		`Providing a --bips of zero (or omitting it) removes the market's price band.
+When removing the price band, the --reference and --action flags must not be provided.
+Removing the price band will also resume the market's settlements if they are paused.`,
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdatePriceBand reads all the SetupCmdTxMarketUpdatePriceBand flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdatePriceBand(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdatePriceBandRequest, error) {
+	msg := &exchange.MsgMarketUpdatePriceBandRequest{}
+
+	errs := make([]error, 5)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.Config.MaxDeviationBips, errs[2] = flagSet.GetUint32(FlagBips)
+	var reference, action string
+	reference, errs[3] = flagSet.GetString(FlagReference)
+	if errs[3] == nil {
+		msg.Config.Reference, errs[3] = exchange.ParsePriceBandReference(reference)
+	}
+	action, errs[4] = flagSet.GetString(FlagAction)
+	if errs[4] == nil {
+		msg.Config.Action, errs[4] = exchange.ParsePriceBandAction(action)
+	}
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxMarketResumeSettlements adds all the flags needed for MakeMsgMarketResumeSettlements.
+func SetupCmdTxMarketResumeSettlements(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+	)
+	AddUseDetails(cmd, ReqAdminDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketResumeSettlements reads all the SetupCmdTxMarketResumeSettlements flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketResumeSettlements(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketResumeSettlementsRequest, error) {
+	msg := &exchange.MsgMarketResumeSettlementsRequest{}
+
+	errs := make([]error, 2)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxMarketManagePermissions adds all the flags needed for MakeMsgMarketManagePermissions.
 func SetupCmdTxMarketManagePermissions(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
@@ -951,6 +1376,100 @@ func MakeMsgChangePaymentTarget(clientCtx client.Context, flagSet *pflag.FlagSet
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxIssuerCreateMarket adds all the flags needed for MakeMsgIssuerCreateMarket.
+func SetupCmdTxIssuerCreateMarket(cmd *cobra.Command) {
+	cmd.Flags().String(FlagIssuer, "", "The issuer creating the market (defaults to --from account)")
+	cmd.Flags().String(FlagMarkerDenom, "", "The denom of the marker the market is for (required)")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id")
+	AddFlagsMarketDetails(cmd)
+	cmd.Flags().StringSlice(FlagCreateAsk, nil, "The create-ask fee options, e.g. 10nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagCreateBid, nil, "The create-bid fee options, e.g. 10nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagSellerFlat, nil, "The seller settlement flat fee options, e.g. 10nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagSellerRatios, nil, "The seller settlement fee ratios, e.g. 100nhash:1nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagBuyerFlat, nil, "The buyer settlement flat fee options, e.g. 10nhash (repeatable)")
+	cmd.Flags().StringSlice(FlagBuyerRatios, nil, "The buyer settlement fee ratios, e.g. 100nhash:1nhash (repeatable)")
+	cmd.Flags().Bool(FlagAcceptingOrders, false, "The market should allow orders to be created")
+	cmd.Flags().Bool(FlagAllowUserSettle, false, "The market should allow user-initiated settlement")
+	cmd.Flags().StringSlice(FlagAccessGrants, nil, "The <access grants> that the market should have (repeatable)")
+	cmd.Flags().StringSlice(FlagReqAttrAsk, nil, "Attributes required to create ask orders (repeatable)")
+	cmd.Flags().StringSlice(FlagReqAttrBid, nil, "Attributes required to create bid orders (repeatable)")
+	cmd.Flags().Bool(FlagAcceptingCommitments, false, "The market should allow commitments to be created")
+	cmd.Flags().StringSlice(FlagCreateCommitment, nil, "The create-commitment fee options, e.g. 10nhash (repeatable)")
+	cmd.Flags().Uint32(FlagBips, 0, "The commitment settlement bips (min=0, max=10,000)")
+	cmd.Flags().String(FlagDenom, "", "The intermediary denom")
+	cmd.Flags().StringSlice(FlagReqAttrCommitment, nil, "Attributes required to create commitments (repeatable)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagIssuer)
+	MarkFlagsRequired(cmd, FlagMarkerDenom)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagIssuer),
+		ReqFlagUse(FlagMarkerDenom, "denom"),
+		OptFlagUse(FlagMarket, "market id"),
+		UseFlagsBreak,
+		OptFlagUse(FlagName, "name"),
+		OptFlagUse(FlagDescription, "description"),
+		OptFlagUse(FlagURL, "website url"),
+		OptFlagUse(FlagIcon, "icon uri"),
+		UseFlagsBreak,
+		OptFlagUse(FlagCreateAsk, "coins"),
+		OptFlagUse(FlagCreateBid, "coins"),
+		OptFlagUse(FlagCreateCommitment, "coins"),
+		UseFlagsBreak,
+		OptFlagUse(FlagSellerFlat, "coins"),
+		OptFlagUse(FlagSellerRatios, "fee ratios"),
+		UseFlagsBreak,
+		OptFlagUse(FlagBuyerFlat, "coins"),
+		OptFlagUse(FlagBuyerRatios, "fee ratios"),
+		UseFlagsBreak,
+		OptFlagUse(FlagAcceptingOrders, ""),
+		OptFlagUse(FlagAllowUserSettle, ""),
+		OptFlagUse(FlagAcceptingCommitments, ""),
+		UseFlagsBreak,
+		OptFlagUse(FlagAccessGrants, "access grants"),
+		UseFlagsBreak,
+		OptFlagUse(FlagReqAttrAsk, "attrs"),
+		OptFlagUse(FlagReqAttrBid, "attrs"),
+		OptFlagUse(FlagReqAttrCommitment, "attrs"),
+		UseFlagsBreak,
+		OptFlagUse(FlagBips, "bips"),
+		OptFlagUse(FlagDenom, "denom"),
+	)
+	AddUseDetails(cmd, ReqSignerDesc(FlagIssuer), RepeatableDesc, AccessGrantsDesc, FeeRatioDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgIssuerCreateMarket reads all the SetupCmdTxIssuerCreateMarket flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgIssuerCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgIssuerCreateMarketRequest, error) {
+	msg := &exchange.MsgIssuerCreateMarketRequest{}
+
+	errs := make([]error, 20)
+	msg.Issuer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagIssuer)
+	msg.MarkerDenom, errs[1] = flagSet.GetString(FlagMarkerDenom)
+	msg.Market.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, 0)
+	msg.Market.MarketDetails, errs[3] = ReadFlagsMarketDetails(flagSet, msg.Market.MarketDetails)
+	msg.Market.FeeCreateAskFlat, errs[4] = ReadFlatFeeFlag(flagSet, FlagCreateAsk, nil)
+	msg.Market.FeeCreateBidFlat, errs[5] = ReadFlatFeeFlag(flagSet, FlagCreateBid, nil)
+	msg.Market.FeeCreateCommitmentFlat, errs[6] = ReadFlatFeeFlag(flagSet, FlagCreateCommitment, nil)
+	msg.Market.FeeSellerSettlementFlat, errs[7] = ReadFlatFeeFlag(flagSet, FlagSellerFlat, nil)
+	msg.Market.FeeSellerSettlementRatios, errs[8] = ReadFeeRatiosFlag(flagSet, FlagSellerRatios, nil)
+	msg.Market.FeeBuyerSettlementFlat, errs[9] = ReadFlatFeeFlag(flagSet, FlagBuyerFlat, nil)
+	msg.Market.FeeBuyerSettlementRatios, errs[10] = ReadFeeRatiosFlag(flagSet, FlagBuyerRatios, nil)
+	msg.Market.AcceptingOrders, errs[11] = ReadFlagBoolOrDefault(flagSet, FlagAcceptingOrders, false)
+	msg.Market.AllowUserSettlement, errs[12] = ReadFlagBoolOrDefault(flagSet, FlagAllowUserSettle, false)
+	msg.Market.AcceptingCommitments, errs[13] = ReadFlagBoolOrDefault(flagSet, FlagAcceptingCommitments, false)
+	msg.Market.AccessGrants, errs[14] = ReadAccessGrantsFlag(flagSet, FlagAccessGrants, nil)
+	msg.Market.ReqAttrCreateAsk, errs[15] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrAsk, nil)
+	msg.Market.ReqAttrCreateBid, errs[16] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrBid, nil)
+	msg.Market.ReqAttrCreateCommitment, errs[17] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrCommitment, nil)
+	msg.Market.CommitmentSettlementBips, errs[18] = ReadFlagUint32OrDefault(flagSet, FlagBips, 0)
+	msg.Market.IntermediaryDenom, errs[19] = ReadFlagStringOrDefault(flagSet, FlagDenom, "")
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxGovCreateMarket adds all the flags needed for MakeMsgGovCreateMarket.
 func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAuthority, "", "The authority address to use (defaults to the governance module account)")
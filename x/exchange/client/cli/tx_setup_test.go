@@ -85,7 +85,8 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		setup: cli.SetupCmdTxCreateAsk,
 		expFlags: []string{
 			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
-			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagFillOrKill, cli.FlagMinFill, cli.FlagAllOrNothing,
+			cli.FlagExternalID, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -98,6 +99,7 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		expInUse: []string{
 			"--seller", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
+			"[--fill-or-kill]", "[--min-fill <min fill assets amount>]", "[--all-or-nothing]",
 			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
 			cli.ReqSignerDesc(cli.FlagSeller),
 		},
@@ -111,11 +113,12 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 		setup:     cli.SetupCmdTxCreateAsk,
 	}
 
+	minFill := sdkmath.NewInt(3)
 	tests := []txMakerTestCase[*exchange.MsgCreateAskRequest]{
 		{
 			name:      "a couple errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--assets", "nope", "--creation-fee", "123"},
+			flags:     []string{"--assets", "nope", "--creation-fee", "123", "--min-fill", "1.5"},
 			expMsg: &exchange.MsgCreateAskRequest{
 				AskOrder: exchange.AskOrder{Seller: sdk.AccAddress("FromAddress_________").String()},
 			},
@@ -123,6 +126,7 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 				"error parsing --assets as a coin: invalid coin expression: \"nope\"",
 				"missing required --price flag",
 				"error parsing --creation-fee as a coin: invalid coin expression: \"123\"",
+				"error parsing --min-fill as an integer: invalid value \"1.5\"",
 			),
 		},
 		{
@@ -130,7 +134,7 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 			flags: []string{
 				"--seller", "someaddr", "--market", "4",
 				"--assets", "10apple", "--price", "55plum",
-				"--settlement-fee", "5fig", "--partial",
+				"--settlement-fee", "5fig", "--partial", "--fill-or-kill", "--min-fill", "3", "--all-or-nothing",
 				"--external-id", "uuid", "--creation-fee", "6grape",
 			},
 			expMsg: &exchange.MsgCreateAskRequest{
@@ -142,6 +146,9 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 					SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
 					AllowPartial:            true,
 					ExternalId:              "uuid",
+					FillOrKill:              true,
+					MinFillAssets:           &minFill,
+					AllOrNothing:            true,
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
@@ -161,7 +168,8 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		setup: cli.SetupCmdTxCreateBid,
 		expFlags: []string{
 			cli.FlagBuyer, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
-			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagFillOrKill, cli.FlagMinFill, cli.FlagAllOrNothing,
+			cli.FlagExternalID, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -174,6 +182,7 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		expInUse: []string{
 			"--buyer", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
+			"[--fill-or-kill]", "[--min-fill <min fill assets amount>]", "[--all-or-nothing]",
 			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
 			cli.ReqSignerDesc(cli.FlagBuyer),
 		},
@@ -187,11 +196,12 @@ func TestMakeMsgCreateBid(t *testing.T) {
 		setup:     cli.SetupCmdTxCreateBid,
 	}
 
+	minFill := sdkmath.NewInt(3)
 	tests := []txMakerTestCase[*exchange.MsgCreateBidRequest]{
 		{
 			name:      "a couple errors",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--assets", "nope", "--creation-fee", "123"},
+			flags:     []string{"--assets", "nope", "--creation-fee", "123", "--min-fill", "1.5"},
 			expMsg: &exchange.MsgCreateBidRequest{
 				BidOrder: exchange.BidOrder{Buyer: sdk.AccAddress("FromAddress_________").String()},
 			},
@@ -199,6 +209,7 @@ func TestMakeMsgCreateBid(t *testing.T) {
 				"error parsing --assets as a coin: invalid coin expression: \"nope\"",
 				"missing required --price flag",
 				"error parsing --creation-fee as a coin: invalid coin expression: \"123\"",
+				"error parsing --min-fill as an integer: invalid value \"1.5\"",
 			),
 		},
 		{
@@ -206,7 +217,7 @@ func TestMakeMsgCreateBid(t *testing.T) {
 			flags: []string{
 				"--buyer", "someaddr", "--market", "4",
 				"--assets", "10apple", "--price", "55plum",
-				"--settlement-fee", "5fig", "--partial",
+				"--settlement-fee", "5fig", "--partial", "--fill-or-kill", "--min-fill", "3", "--all-or-nothing",
 				"--external-id", "uuid", "--creation-fee", "6grape",
 			},
 			expMsg: &exchange.MsgCreateBidRequest{
@@ -218,6 +229,9 @@ func TestMakeMsgCreateBid(t *testing.T) {
 					BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
 					AllowPartial:        true,
 					ExternalId:          "uuid",
+					FillOrKill:          true,
+					MinFillAssets:       &minFill,
+					AllOrNothing:        true,
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
@@ -509,7 +523,7 @@ func TestSetupCmdTxMarketSettle(t *testing.T) {
 		setup: cli.SetupCmdTxMarketSettle,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagPartial,
+			cli.FlagMarket, cli.FlagAsks, cli.FlagBids, cli.FlagPartial, cli.FlagNet, cli.FlagOverride,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -529,8 +543,9 @@ func TestSetupCmdTxMarketSettle(t *testing.T) {
 		expInUse: []string{
 			cli.ReqAdminUse, "--market <market id>",
 			"--asks <ask order ids>", "--bids <bid order ids>",
-			"[--partial]",
+			"[--partial]", "[--net]", "[--override]",
 			cli.ReqAdminDesc, cli.RepeatableDesc,
+			"Using --override requires the admin to also have update permission in the market.",
 		},
 	})
 }
@@ -568,13 +583,14 @@ func TestMakeMsgMarketSettle(t *testing.T) {
 		},
 		{
 			name:  "authority",
-			flags: []string{"--market", "52", "--asks", "91", "--bids", "12,13", "--authority", "--partial"},
+			flags: []string{"--market", "52", "--asks", "91", "--bids", "12,13", "--authority", "--partial", "--net"},
 			expMsg: &exchange.MsgMarketSettleRequest{
 				Admin:         cli.AuthorityAddr.String(),
 				MarketId:      52,
 				AskOrderIds:   []uint64{91},
 				BidOrderIds:   []uint64{12, 13},
 				ExpectPartial: true,
+				NetTransfers:  true,
 			},
 		},
 		{
@@ -587,6 +603,17 @@ func TestMakeMsgMarketSettle(t *testing.T) {
 				BidOrderIds: []uint64{5},
 			},
 		},
+		{
+			name:  "override",
+			flags: []string{"--market", "3", "--admin", "bob", "--asks", "4", "--bids", "6", "--override"},
+			expMsg: &exchange.MsgMarketSettleRequest{
+				Admin:             "bob",
+				MarketId:          3,
+				AskOrderIds:       []uint64{4},
+				BidOrderIds:       []uint64{6},
+				OverridePriceBand: true,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -596,161 +623,1074 @@ func TestMakeMsgMarketSettle(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketCommitmentSettle(t *testing.T) {
+func TestSetupCmdTxMarketStartExternalSettlement(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketCommitmentSettle",
-		setup: cli.SetupCmdTxMarketCommitmentSettle,
+		name:  "SetupCmdTxMarketStartExternalSettlement",
+		setup: cli.SetupCmdTxMarketStartExternalSettlement,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagInputs, cli.FlagOutputs,
-			cli.FlagSettlementFees, cli.FlagNavs, cli.FlagTag, cli.FlagFile,
+			cli.FlagMarket, cli.FlagOrder, cli.FlagBuyer,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
-			flags.FlagFrom: {oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
 			cli.FlagAdmin: {
 				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagAuthority: {
 				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
-				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
-			cli.FlagFile: {oneReq: {
-				cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority,
-				cli.FlagFile + " " + cli.FlagMarket,
-			}},
-			cli.FlagMarket: {oneReq: {cli.FlagFile + " " + cli.FlagMarket}},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagOrder:  {required: {"true"}},
+			cli.FlagBuyer:  {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "[--market <market id>]",
-			"[--inputs <account-amount>]", "[--outputs <account-amount>]",
-			"[--settlement-fees <account-amount>]", "[--navs <nav>]", "[--tag <event tag>]",
-			"[--file <filename>]",
-			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccountAmountDesc, cli.NAVDesc,
-			cli.MsgFileDesc(&exchange.MsgMarketCommitmentSettleRequest{}),
+			cli.ReqAdminUse, "--market <market id>", "--order <order id>", "--buyer <buyer>",
+			cli.ReqAdminDesc,
 		},
 	})
 }
 
-func TestMakeMsgMarketCommitmentSettle(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketCommitmentSettleRequest]{
-		makerName: "MakeMsgMarketCommitmentSettle",
-		maker:     cli.MakeMsgMarketCommitmentSettle,
-		setup:     cli.SetupCmdTxMarketCommitmentSettle,
-	}
-
-	tdir := t.TempDir()
-	filename := filepath.Join(tdir, "commitment-settle.json")
-	fileMsg := &exchange.MsgMarketCommitmentSettleRequest{
-		Admin:    sdk.AccAddress("msg_admin___________").String(),
-		MarketId: 4,
-		Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
-		Outputs:  []exchange.AccountAmount{{Account: "parker", Amount: sdk.NewCoins(sdk.NewInt64Coin("peach", 11))}},
-		Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
-		Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
-		EventTag: "the-msg-event-tag",
+func TestMakeMsgMarketStartExternalSettlement(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketStartExternalSettlementRequest]{
+		makerName: "MakeMsgMarketStartExternalSettlement",
+		maker:     cli.MakeMsgMarketStartExternalSettlement,
+		setup:     cli.SetupCmdTxMarketStartExternalSettlement,
 	}
-	tx := newTx(t, fileMsg)
-	writeFileAsJson(t, filename, tx)
 
-	tests := []txMakerTestCase[*exchange.MsgMarketCommitmentSettleRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketStartExternalSettlementRequest]{
 		{
-			name:   "no flags",
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{},
+			name:  "no admin",
+			flags: []string{"--market", "3", "--order", "44", "--buyer", "fry"},
+			expMsg: &exchange.MsgMarketStartExternalSettlementRequest{
+				MarketId: 3, OrderId: 44, Buyer: "fry",
+			},
 			expErr: "no <admin> provided",
 		},
 		{
 			name:      "admin from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
+			flags:     []string{"--market", "12", "--order", "9001", "--buyer", "leela"},
+			expMsg: &exchange.MsgMarketStartExternalSettlementRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 12, OrderId: 9001, Buyer: "leela",
 			},
 		},
 		{
-			name:   "admin from flag",
-			flags:  []string{"--admin", "kelly"},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "kelly"},
+			name:  "all the flags",
+			flags: []string{"--admin", "hermes", "--market", "5", "--order", "100000000000001", "--buyer", "zoidberg"},
+			expMsg: &exchange.MsgMarketStartExternalSettlementRequest{
+				Admin: "hermes", MarketId: 5, OrderId: 100000000000001, Buyer: "zoidberg",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxConfirmExternalPayment(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxConfirmExternalPayment",
+		setup: cli.SetupCmdTxConfirmExternalPayment,
+		expFlags: []string{
+			cli.FlagOracle, cli.FlagMarket, cli.FlagOrder, cli.FlagReference,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagMarket:    {required: {"true"}},
+			cli.FlagOrder:     {required: {"true"}},
+			cli.FlagReference: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--oracle} <oracle>", "--market <market id>", "--order <order id>",
+			"--reference <payment reference>",
+			cli.ReqSignerDesc(cli.FlagOracle),
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagOracle)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgConfirmExternalPayment(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgConfirmExternalPaymentRequest]{
+		makerName: "MakeMsgConfirmExternalPayment",
+		maker:     cli.MakeMsgConfirmExternalPayment,
+		setup:     cli.SetupCmdTxConfirmExternalPayment,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgConfirmExternalPaymentRequest]{
+		{
+			name:  "no oracle",
+			flags: []string{"--market", "3", "--order", "44", "--reference", "wire-1"},
+			expMsg: &exchange.MsgConfirmExternalPaymentRequest{
+				MarketId: 3, OrderId: 44, PaymentReference: "wire-1",
+			},
+			expErr: "no <oracle> provided",
 		},
 		{
-			name:  "admin as authority",
-			flags: []string{"--authority"},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin: cli.AuthorityAddr.String(),
+			name:      "oracle from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "12", "--order", "9001", "--reference", "ach 55"},
+			expMsg: &exchange.MsgConfirmExternalPaymentRequest{
+				Oracle:   sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 12, OrderId: 9001, PaymentReference: "ach 55",
 			},
 		},
 		{
-			name: "bad inputs outputs fees and navs",
+			name:  "all the flags",
+			flags: []string{"--oracle", "bender", "--market", "5", "--order", "7", "--reference", "swift-xyz"},
+			expMsg: &exchange.MsgConfirmExternalPaymentRequest{
+				Oracle: "bender", MarketId: 5, OrderId: 7, PaymentReference: "swift-xyz",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxCreateRFQ(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxCreateRFQ",
+		setup: cli.SetupCmdTxCreateRFQ,
+		expFlags: []string{
+			cli.FlagBuyer, cli.FlagMarket, cli.FlagAssets, cli.FlagPriceDenom, cli.FlagExpiration,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagMarket:     {required: {"true"}},
+			cli.FlagAssets:     {required: {"true"}},
+			cli.FlagPriceDenom: {required: {"true"}},
+			cli.FlagExpiration: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--buyer} <buyer>", "--market <market id>", "--assets <assets>",
+			"--price-denom <denom>", "--expiration <height>",
+			cli.ReqSignerDesc(cli.FlagBuyer),
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagBuyer)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgCreateRFQ(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCreateRFQRequest]{
+		makerName: "MakeMsgCreateRFQ",
+		maker:     cli.MakeMsgCreateRFQ,
+		setup:     cli.SetupCmdTxCreateRFQ,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgCreateRFQRequest]{
+		{
+			name:   "a couple errors",
+			flags:  []string{"--assets", "nope", "--price-denom", "pear"},
+			expMsg: &exchange.MsgCreateRFQRequest{PriceDenom: "pear"},
+			expErr: joinErrs(
+				"no <buyer> provided",
+				"error parsing --assets as a coin: invalid coin expression: \"nope\"",
+			),
+		},
+		{
+			name:      "buyer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "3", "--assets", "10apple", "--price-denom", "pear", "--expiration", "500"},
+			expMsg: &exchange.MsgCreateRFQRequest{
+				Buyer:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 3, Assets: sdk.NewInt64Coin("apple", 10), PriceDenom: "pear", ExpirationHeight: 500,
+			},
+		},
+		{
+			name: "all the flags",
 			flags: []string{
-				"--admin", "sam",
-				"--inputs", "10nhash", "--outputs", "addr3",
-				"--settlement-fees", "42cherry", "--navs", "18banana",
+				"--buyer", "someaddr", "--market", "12", "--assets", "77banana",
+				"--price-denom", "cherry", "--expiration", "9001",
+			},
+			expMsg: &exchange.MsgCreateRFQRequest{
+				Buyer: "someaddr", MarketId: 12, Assets: sdk.NewInt64Coin("banana", 77),
+				PriceDenom: "cherry", ExpirationHeight: 9001,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxCancelRFQ(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxCancelRFQ",
+		setup: cli.SetupCmdTxCancelRFQ,
+		expFlags: []string{
+			cli.FlagBuyer, cli.FlagRFQ,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagRFQ: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--buyer} <buyer>", "--rfq <rfq id>",
+			cli.ReqSignerDesc(cli.FlagBuyer),
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagBuyer)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgCancelRFQ(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCancelRFQRequest]{
+		makerName: "MakeMsgCancelRFQ",
+		maker:     cli.MakeMsgCancelRFQ,
+		setup:     cli.SetupCmdTxCancelRFQ,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgCancelRFQRequest]{
+		{
+			name:   "no buyer",
+			flags:  []string{"--rfq", "4"},
+			expMsg: &exchange.MsgCancelRFQRequest{RfqId: 4},
+			expErr: "no <buyer> provided",
+		},
+		{
+			name:      "buyer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--rfq", "18"},
+			expMsg: &exchange.MsgCancelRFQRequest{
+				Buyer: sdk.AccAddress("FromAddress_________").String(), RfqId: 18,
+			},
+		},
+		{
+			name:   "all the flags",
+			flags:  []string{"--buyer", "someaddr", "--rfq", "5"},
+			expMsg: &exchange.MsgCancelRFQRequest{Buyer: "someaddr", RfqId: 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxSubmitQuote(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxSubmitQuote",
+		setup: cli.SetupCmdTxSubmitQuote,
+		expFlags: []string{
+			cli.FlagDealer, cli.FlagRFQ, cli.FlagPrice, cli.FlagSettlementFee,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagRFQ:   {required: {"true"}},
+			cli.FlagPrice: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--dealer} <dealer>", "--rfq <rfq id>", "--price <price>",
+			"[--settlement-fee <seller settlement flat fee>]",
+			cli.ReqSignerDesc(cli.FlagDealer),
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagDealer)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgSubmitQuote(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgSubmitQuoteRequest]{
+		makerName: "MakeMsgSubmitQuote",
+		maker:     cli.MakeMsgSubmitQuote,
+		setup:     cli.SetupCmdTxSubmitQuote,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgSubmitQuoteRequest]{
+		{
+			name:   "a couple errors",
+			flags:  []string{"--rfq", "2", "--settlement-fee", "bad"},
+			expMsg: &exchange.MsgSubmitQuoteRequest{RfqId: 2},
+			expErr: joinErrs(
+				"no <dealer> provided",
+				"missing required --price flag",
+				"error parsing --settlement-fee as a coin: invalid coin expression: \"bad\"",
+			),
+		},
+		{
+			name:      "dealer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--rfq", "7", "--price", "55pear"},
+			expMsg: &exchange.MsgSubmitQuoteRequest{
+				Dealer: sdk.AccAddress("FromAddress_________").String(),
+				RfqId:  7, Price: sdk.NewInt64Coin("pear", 55),
+			},
+		},
+		{
+			name:  "all the flags",
+			flags: []string{"--dealer", "someaddr", "--rfq", "3", "--price", "40pear", "--settlement-fee", "2fig"},
+			expMsg: &exchange.MsgSubmitQuoteRequest{
+				Dealer: "someaddr", RfqId: 3, Price: sdk.NewInt64Coin("pear", 40),
+				SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(2)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxWithdrawQuote(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxWithdrawQuote",
+		setup: cli.SetupCmdTxWithdrawQuote,
+		expFlags: []string{
+			cli.FlagDealer, cli.FlagRFQ,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagRFQ: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--dealer} <dealer>", "--rfq <rfq id>",
+			cli.ReqSignerDesc(cli.FlagDealer),
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagDealer)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgWithdrawQuote(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgWithdrawQuoteRequest]{
+		makerName: "MakeMsgWithdrawQuote",
+		maker:     cli.MakeMsgWithdrawQuote,
+		setup:     cli.SetupCmdTxWithdrawQuote,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgWithdrawQuoteRequest]{
+		{
+			name:   "no dealer",
+			flags:  []string{"--rfq", "4"},
+			expMsg: &exchange.MsgWithdrawQuoteRequest{RfqId: 4},
+			expErr: "no <dealer> provided",
+		},
+		{
+			name:      "dealer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--rfq", "21"},
+			expMsg: &exchange.MsgWithdrawQuoteRequest{
+				Dealer: sdk.AccAddress("FromAddress_________").String(), RfqId: 21,
+			},
+		},
+		{
+			name:   "all the flags",
+			flags:  []string{"--dealer", "someaddr", "--rfq", "6"},
+			expMsg: &exchange.MsgWithdrawQuoteRequest{Dealer: "someaddr", RfqId: 6},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxAcceptQuote(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxAcceptQuote",
+		setup: cli.SetupCmdTxAcceptQuote,
+		expFlags: []string{
+			cli.FlagBuyer, cli.FlagRFQ, cli.FlagDealer, cli.FlagPrice, cli.FlagSettlementFee,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagRFQ:    {required: {"true"}},
+			cli.FlagDealer: {required: {"true"}},
+			cli.FlagPrice:  {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--buyer} <buyer>", "--rfq <rfq id>", "--dealer <dealer>", "--price <price>",
+			"[--settlement-fee <buyer settlement fees>]",
+			cli.ReqSignerDesc(cli.FlagBuyer),
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagBuyer)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgAcceptQuote(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgAcceptQuoteRequest]{
+		makerName: "MakeMsgAcceptQuote",
+		maker:     cli.MakeMsgAcceptQuote,
+		setup:     cli.SetupCmdTxAcceptQuote,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgAcceptQuoteRequest]{
+		{
+			name:   "a couple errors",
+			flags:  []string{"--rfq", "2", "--dealer", "dealeraddr", "--settlement-fee", "bad"},
+			expMsg: &exchange.MsgAcceptQuoteRequest{RfqId: 2, Dealer: "dealeraddr"},
+			expErr: joinErrs(
+				"no <buyer> provided",
+				"missing required --price flag",
+				"error parsing --settlement-fee as coins: invalid coin expression: \"bad\"",
+			),
+		},
+		{
+			name:      "buyer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--rfq", "7", "--dealer", "dealeraddr", "--price", "55pear"},
+			expMsg: &exchange.MsgAcceptQuoteRequest{
+				Buyer: sdk.AccAddress("FromAddress_________").String(),
+				RfqId: 7, Dealer: "dealeraddr", Price: sdk.NewInt64Coin("pear", 55),
+			},
+		},
+		{
+			name: "all the flags",
+			flags: []string{
+				"--buyer", "someaddr", "--rfq", "3", "--dealer", "dealeraddr",
+				"--price", "40pear", "--settlement-fee", "2fig,1pear",
+			},
+			expMsg: &exchange.MsgAcceptQuoteRequest{
+				Buyer: "someaddr", RfqId: 3, Dealer: "dealeraddr", Price: sdk.NewInt64Coin("pear", 40),
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 2), sdk.NewInt64Coin("pear", 1)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketCommitmentSettle(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketCommitmentSettle",
+		setup: cli.SetupCmdTxMarketCommitmentSettle,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagInputs, cli.FlagOutputs,
+			cli.FlagSettlementFees, cli.FlagNavs, cli.FlagTag, cli.FlagFile,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagFile: {oneReq: {
+				cli.FlagFile + " " + flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority,
+				cli.FlagFile + " " + cli.FlagMarket,
+			}},
+			cli.FlagMarket: {oneReq: {cli.FlagFile + " " + cli.FlagMarket}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "[--market <market id>]",
+			"[--inputs <account-amount>]", "[--outputs <account-amount>]",
+			"[--settlement-fees <account-amount>]", "[--navs <nav>]", "[--tag <event tag>]",
+			"[--file <filename>]",
+			cli.ReqAdminDesc, cli.RepeatableDesc, cli.AccountAmountDesc, cli.NAVDesc,
+			cli.MsgFileDesc(&exchange.MsgMarketCommitmentSettleRequest{}),
+		},
+	})
+}
+
+func TestMakeMsgMarketCommitmentSettle(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketCommitmentSettleRequest]{
+		makerName: "MakeMsgMarketCommitmentSettle",
+		maker:     cli.MakeMsgMarketCommitmentSettle,
+		setup:     cli.SetupCmdTxMarketCommitmentSettle,
+	}
+
+	tdir := t.TempDir()
+	filename := filepath.Join(tdir, "commitment-settle.json")
+	fileMsg := &exchange.MsgMarketCommitmentSettleRequest{
+		Admin:    sdk.AccAddress("msg_admin___________").String(),
+		MarketId: 4,
+		Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
+		Outputs:  []exchange.AccountAmount{{Account: "parker", Amount: sdk.NewCoins(sdk.NewInt64Coin("peach", 11))}},
+		Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
+		Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
+		EventTag: "the-msg-event-tag",
+	}
+	tx := newTx(t, fileMsg)
+	writeFileAsJson(t, filename, tx)
+
+	tests := []txMakerTestCase[*exchange.MsgMarketCommitmentSettleRequest]{
+		{
+			name:   "no flags",
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+			},
+		},
+		{
+			name:   "admin from flag",
+			flags:  []string{"--admin", "kelly"},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "kelly"},
+		},
+		{
+			name:  "admin as authority",
+			flags: []string{"--authority"},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin: cli.AuthorityAddr.String(),
+			},
+		},
+		{
+			name: "bad inputs outputs fees and navs",
+			flags: []string{
+				"--admin", "sam",
+				"--inputs", "10nhash", "--outputs", "addr3",
+				"--settlement-fees", "42cherry", "--navs", "18banana",
+			},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "sam"},
+			expErr: joinErrs(
+				"invalid account-amount \"10nhash\": expected format <account>:<amount>",
+				"invalid account-amount \"addr3\": expected format <account>:<amount>",
+				"invalid account-amount \"42cherry\": expected format <account>:<amount>",
+				"invalid net-asset-price \"18banana\": expected format <assets>:<price>",
+			),
+		},
+		{
+			name: "all provided",
+			flags: []string{
+				"--authority", "--market", "18", "--tag", "thing-4DE17436",
+				"--inputs", "addr1:10nhash,5cherry,addr2:12nhash,addr3:4nhash,10cherry",
+				"--settlement-fees", "addr7:10apple,1prune",
+				"--outputs", "addr4:26nhash,1cherry",
+				"--settlement-fees", "addr6:8apple",
+				"--navs", "8apple:10cherry,10apple:3nhash",
+				"--outputs", "addr5:14cherry",
+				"--navs", "4cherry:15nhash",
+			},
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 18,
+				Inputs: []exchange.AccountAmount{
+					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10), sdk.NewInt64Coin("cherry", 5))},
+					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 12))},
+					{Account: "addr3", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 4), sdk.NewInt64Coin("cherry", 10))},
+				},
+				Outputs: []exchange.AccountAmount{
+					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 26), sdk.NewInt64Coin("cherry", 1))},
+					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 14))},
+				},
+				Fees: []exchange.AccountAmount{
+					{Account: "addr7", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("prune", 1))},
+					{Account: "addr6", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 8))},
+				},
+				Navs: []exchange.NetAssetPrice{
+					{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("cherry", 10)},
+					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 3)},
+					{Assets: sdk.NewInt64Coin("cherry", 4), Price: sdk.NewInt64Coin("nhash", 15)},
+				},
+				EventTag: "thing-4DE17436",
+			},
+		},
+		{
+			name:      "from file",
+			clientCtx: newClientContext(t),
+			flags:     []string{"--file", filename},
+			expMsg:    fileMsg,
+		},
+		{
+			name: "file with overrides",
+			flags: []string{
+				"--file", filename, "--tag", "new-thang", "--authority",
+				"--outputs", "monroe:87plum",
+			},
+			clientCtx: newClientContext(t),
+			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 4,
+				Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
+				Outputs:  []exchange.AccountAmount{{Account: "monroe", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 87))}},
+				Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
+				Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
+				EventTag: "new-thang",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketReleaseCommitments(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketReleaseCommitments",
+		setup: cli.SetupCmdTxMarketReleaseCommitments,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagRelease, cli.FlagReleaseAll, cli.FlagTag,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket:     {required: {"true"}},
+			cli.FlagRelease:    {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
+			cli.FlagReleaseAll: {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--release <account-amount>]", "[--release-all <account>]", "[--tag <event tag>]",
+			cli.ReqAdminUse,
+			"At least one of --release and/or --release-all must be provided",
+			cli.RepeatableDesc, cli.AccountAmountDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketReleaseCommitments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketReleaseCommitmentsRequest]{
+		makerName: "MakeMsgMarketReleaseCommitments",
+		maker:     cli.MakeMsgMarketReleaseCommitments,
+		setup:     cli.SetupCmdTxMarketReleaseCommitments,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketReleaseCommitmentsRequest]{
+		{
+			name:   "nothing",
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "admin from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+			},
+		},
+		{
+			name:  "admin from flag",
+			flags: []string{"--admin", "forest"},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: "forest",
+			},
+		},
+		{
+			name:  "authority",
+			flags: []string{"--authority"},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: cli.AuthorityAddr.String(),
+			},
+		},
+		{
+			name:      "only release",
+			flags:     []string{"--release", "addr1:10cherry,addr2:5apple"},
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+				ToRelease: []exchange.AccountAmount{
+					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
+					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
+				},
+			},
+		},
+		{
+			name:      "only release-all",
+			flags:     []string{"--release-all", "addr1,addr2"},
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin: sdk.AccAddress("FromAddress_________").String(),
+				ToRelease: []exchange.AccountAmount{
+					{Account: "addr1", Amount: nil},
+					{Account: "addr2", Amount: nil},
+				},
+			},
+		},
+		{
+			name: "everything",
+			flags: []string{
+				"--admin", "charly", "--market", "4", "--tag", "someragtag",
+				"--release", "addr1:4apple,5banana",
+				"--release-all", "addr2,addr3",
+				"--release", "addr4:10cherry,addr5:8date",
+			},
+			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
+				Admin:    "charly",
+				MarketId: 4,
+				ToRelease: []exchange.AccountAmount{
+					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 4), sdk.NewInt64Coin("banana", 5))},
+					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
+					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("date", 8))},
+					{Account: "addr2", Amount: nil},
+					{Account: "addr3", Amount: nil},
+				},
+				EventTag: "someragtag",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketSetOrderExternalID(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketSetOrderExternalID",
+		setup: cli.SetupCmdTxMarketSetOrderExternalID,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagOrder, cli.FlagExternalID,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagOrder:  {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--order <order id>",
+			"[--external-id <external id>]",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketSetOrderExternalID(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketSetOrderExternalIDRequest]{
+		makerName: "MakeMsgMarketSetOrderExternalID",
+		maker:     cli.MakeMsgMarketSetOrderExternalID,
+		setup:     cli.SetupCmdTxMarketSetOrderExternalID,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketSetOrderExternalIDRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "8", "--order", "7", "--external-id", "markus"},
+			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
+				MarketId: 8, OrderId: 7, ExternalId: "markus",
+			},
+			expErr: "no <admin> provided",
+		},
+		{
+			name:      "no external id",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "4000", "--order", "9001"},
+			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4000, OrderId: 9001, ExternalId: "",
+			},
+		},
+		{
+			name: "all the flags",
+			flags: []string{
+				"--market", "5", "--order", "100000000000001",
+				"--external-id", "one", "--admin", "michelle",
+			},
+			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
+				Admin: "michelle", MarketId: 5, OrderId: 100000000000001, ExternalId: "one",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdTxMarketWithdraw(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketWithdraw",
+		setup: cli.SetupCmdTxMarketWithdraw,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagTo, cli.FlagAmount,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagTo:     {required: {"true"}},
+			cli.FlagAmount: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", "--to <to address>", "--amount <amount>",
+			cli.ReqAdminDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketWithdraw(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketWithdrawRequest]{
+		makerName: "MakeMsgMarketWithdraw",
+		maker:     cli.MakeMsgMarketWithdraw,
+		setup:     cli.SetupCmdTxMarketWithdraw,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketWithdrawRequest]{
+		{
+			name:  "some errors",
+			flags: []string{"--market", "5", "--to", "annie", "--amount", "bill"},
+			expMsg: &exchange.MsgMarketWithdrawRequest{
+				Admin: "", MarketId: 5, ToAddress: "annie", Amount: nil,
+			},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"error parsing --amount as coins: invalid coin expression: \"bill\"",
+			),
+		},
+		{
+			name:      "all fields",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "2", "--to", "samantha", "--amount", "52plum,18pear"},
+			expMsg: &exchange.MsgMarketWithdrawRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 2, ToAddress: "samantha",
+				Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 52), sdk.NewInt64Coin("pear", 18)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
+func TestAddFlagsMarketDetails(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:               "AddFlagsMarketDetails",
+		setup:              cli.AddFlagsMarketDetails,
+		expFlags:           []string{cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon},
+		skipArgsCheck:      true,
+		skipFlagInUseCheck: true,
+	})
+}
+
+func TestReadFlagsMarketDetails(t *testing.T) {
+	tests := []struct {
+		name       string
+		skipSetup  bool
+		flags      []string
+		def        exchange.MarketDetails
+		expDetails exchange.MarketDetails
+		expErr     string
+	}{
+		{
+			name:      "no setup",
+			skipSetup: true,
+			expErr: joinErrs(
+				"flag accessed but not defined: name",
+				"flag accessed but not defined: description",
+				"flag accessed but not defined: url",
+				"flag accessed but not defined: icon",
+			),
+		},
+		{
+			name:       "just name",
+			flags:      []string{"--name", "Richard"},
+			expDetails: exchange.MarketDetails{Name: "Richard"},
+		},
+		{
+			name:  "name and url, no defaults",
+			flags: []string{"--url", "https://example.com", "--name", "Sally"},
+			expDetails: exchange.MarketDetails{
+				Name:       "Sally",
+				WebsiteUrl: "https://example.com",
+			},
+		},
+		{
+			name:  "name and url, with defaults",
+			flags: []string{"--url", "https://example.com/new", "--name", "Glen"},
+			def: exchange.MarketDetails{
+				Name:        "Martha",
+				Description: "Some existing Description",
+				WebsiteUrl:  "https://old.example.com",
+				IconUri:     "https://example.com/icon",
+			},
+			expDetails: exchange.MarketDetails{
+				Name:        "Glen",
+				Description: "Some existing Description",
+				WebsiteUrl:  "https://example.com/new",
+				IconUri:     "https://example.com/icon",
+			},
+		},
+		{
+			name: "all fields",
+			flags: []string{
+				"--name", "Market Eight Dude",
+				"--description", "The Little Lebowski",
+				"--url", "https://bowling.god",
+				"--icon", "https://bowling.god/icon",
+			},
+			expDetails: exchange.MarketDetails{
+				Name:        "Market Eight Dude",
+				Description: "The Little Lebowski",
+				WebsiteUrl:  "https://bowling.god",
+				IconUri:     "https://bowling.god/icon",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{
+				Use: "dummy",
+				RunE: func(cmd *cobra.Command, args []string) error {
+					return errors.New("this dummy command should not have been executed")
+				},
+			}
+			if !tc.skipSetup {
+				cli.AddFlagsMarketDetails(cmd)
+			}
+
+			err := cmd.Flags().Parse(tc.flags)
+			require.NoError(t, err, "cmd.Flags().Parse(%q)", tc.flags)
+
+			var details exchange.MarketDetails
+			testFunc := func() {
+				details, err = cli.ReadFlagsMarketDetails(cmd.Flags(), tc.def)
+			}
+			require.NotPanics(t, testFunc, "ReadFlagsMarketDetails")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsMarketDetails")
+			assert.Equal(t, tc.expDetails, details, "ReadFlagsMarketDetails")
+		})
+	}
+}
+
+func TestSetupCmdTxMarketUpdateDetails(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateDetails",
+		setup: cli.SetupCmdTxMarketUpdateDetails,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket,
+			cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>",
+			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
+			cli.ReqAdminDesc,
+			`All fields of a market's details will be updated.
+If you omit an optional flag, that field will be updated to an empty string.`,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateDetails(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateDetailsRequest]{
+		makerName: "MakeMsgMarketUpdateDetails",
+		maker:     cli.MakeMsgMarketUpdateDetails,
+		setup:     cli.SetupCmdTxMarketUpdateDetails,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateDetailsRequest]{
+		{
+			name:  "no admin",
+			flags: []string{"--market", "8", "--name", "Lynne"},
+			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
+				MarketId:      8,
+				MarketDetails: exchange.MarketDetails{Name: "Lynne"},
 			},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{Admin: "sam"},
-			expErr: joinErrs(
-				"invalid account-amount \"10nhash\": expected format <account>:<amount>",
-				"invalid account-amount \"addr3\": expected format <account>:<amount>",
-				"invalid account-amount \"42cherry\": expected format <account>:<amount>",
-				"invalid net-asset-price \"18banana\": expected format <assets>:<price>",
-			),
+			expErr: "no <admin> provided",
 		},
 		{
-			name: "all provided",
-			flags: []string{
-				"--authority", "--market", "18", "--tag", "thing-4DE17436",
-				"--inputs", "addr1:10nhash,5cherry,addr2:12nhash,addr3:4nhash,10cherry",
-				"--settlement-fees", "addr7:10apple,1prune",
-				"--outputs", "addr4:26nhash,1cherry",
-				"--settlement-fees", "addr6:8apple",
-				"--navs", "8apple:10cherry,10apple:3nhash",
-				"--outputs", "addr5:14cherry",
-				"--navs", "4cherry:15nhash",
-			},
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
-				Admin:    cli.AuthorityAddr.String(),
-				MarketId: 18,
-				Inputs: []exchange.AccountAmount{
-					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 10), sdk.NewInt64Coin("cherry", 5))},
-					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 12))},
-					{Account: "addr3", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 4), sdk.NewInt64Coin("cherry", 10))},
-				},
-				Outputs: []exchange.AccountAmount{
-					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 26), sdk.NewInt64Coin("cherry", 1))},
-					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 14))},
-				},
-				Fees: []exchange.AccountAmount{
-					{Account: "addr7", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10), sdk.NewInt64Coin("prune", 1))},
-					{Account: "addr6", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 8))},
-				},
-				Navs: []exchange.NetAssetPrice{
-					{Assets: sdk.NewInt64Coin("apple", 8), Price: sdk.NewInt64Coin("cherry", 10)},
-					{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("nhash", 3)},
-					{Assets: sdk.NewInt64Coin("cherry", 4), Price: sdk.NewInt64Coin("nhash", 15)},
+			name:      "just name and description",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--market", "9002", "--name", "River", "--description", "The person, not the water."},
+			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 9002,
+				MarketDetails: exchange.MarketDetails{
+					Name:        "River",
+					Description: "The person, not the water.",
 				},
-				EventTag: "thing-4DE17436",
 			},
 		},
 		{
-			name:      "from file",
-			clientCtx: newClientContext(t),
-			flags:     []string{"--file", filename},
-			expMsg:    fileMsg,
-		},
-		{
-			name: "file with overrides",
+			name: "all fields",
 			flags: []string{
-				"--file", filename, "--tag", "new-thang", "--authority",
-				"--outputs", "monroe:87plum",
+				"--market", "14", "--authority", "--name", "Ashley",
+				"--icon", "https://example.com/ashley/icon",
+				"--url", "https://example.com/ashley",
+				"--description", "The best market out there.",
 			},
-			clientCtx: newClientContext(t),
-			expMsg: &exchange.MsgMarketCommitmentSettleRequest{
+			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
 				Admin:    cli.AuthorityAddr.String(),
-				MarketId: 4,
-				Inputs:   []exchange.AccountAmount{{Account: "devin", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 10))}},
-				Outputs:  []exchange.AccountAmount{{Account: "monroe", Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 87))}},
-				Fees:     []exchange.AccountAmount{{Account: "tracey", Amount: sdk.NewCoins(sdk.NewInt64Coin("fig", 4))}},
-				Navs:     []exchange.NetAssetPrice{{Assets: sdk.NewInt64Coin("acorn", 44), Price: sdk.NewInt64Coin("pear", 7)}},
-				EventTag: "new-thang",
+				MarketId: 14,
+				MarketDetails: exchange.MarketDetails{
+					Name:        "Ashley",
+					Description: "The best market out there.",
+					WebsiteUrl:  "https://example.com/ashley",
+					IconUri:     "https://example.com/ashley/icon",
+				},
 			},
 		},
 	}
@@ -762,13 +1702,13 @@ func TestMakeMsgMarketCommitmentSettle(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketReleaseCommitments(t *testing.T) {
+func TestSetupCmdTxMarketUpdateAcceptingOrders(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketReleaseCommitments",
-		setup: cli.SetupCmdTxMarketReleaseCommitments,
+		name:  "SetupCmdTxMarketUpdateAcceptingOrders",
+		setup: cli.SetupCmdTxMarketUpdateAcceptingOrders,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagRelease, cli.FlagReleaseAll, cli.FlagTag,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -781,97 +1721,58 @@ func TestSetupCmdTxMarketReleaseCommitments(t *testing.T) {
 				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
-			cli.FlagMarket:     {required: {"true"}},
-			cli.FlagRelease:    {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
-			cli.FlagReleaseAll: {oneReq: {cli.FlagRelease + " " + cli.FlagReleaseAll}},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"[--release <account-amount>]", "[--release-all <account>]", "[--tag <event tag>]",
-			cli.ReqAdminUse,
-			"At least one of --release and/or --release-all must be provided",
-			cli.RepeatableDesc, cli.AccountAmountDesc,
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
 		},
 	})
 }
 
-func TestMakeMsgMarketReleaseCommitments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketReleaseCommitmentsRequest]{
-		makerName: "MakeMsgMarketReleaseCommitments",
-		maker:     cli.MakeMsgMarketReleaseCommitments,
-		setup:     cli.SetupCmdTxMarketReleaseCommitments,
+func TestMakeMsgMarketUpdateAcceptingOrders(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
+		makerName: "MakeMsgMarketUpdateAcceptingOrders",
+		maker:     cli.MakeMsgMarketUpdateAcceptingOrders,
+		setup:     cli.SetupCmdTxMarketUpdateAcceptingOrders,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketReleaseCommitmentsRequest]{
-		{
-			name:   "nothing",
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{},
-			expErr: "no <admin> provided",
-		},
-		{
-			name:      "admin from from",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-			},
-		},
-		{
-			name:  "admin from flag",
-			flags: []string{"--admin", "forest"},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: "forest",
-			},
-		},
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
 		{
-			name:  "authority",
-			flags: []string{"--authority"},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: cli.AuthorityAddr.String(),
-			},
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
 		},
 		{
-			name:      "only release",
-			flags:     []string{"--release", "addr1:10cherry,addr2:5apple"},
+			name:      "enable",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-				ToRelease: []exchange.AccountAmount{
-					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
-					{Account: "addr2", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 5))},
-				},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           sdk.AccAddress("FromAddress_________").String(),
+				MarketId:        4,
+				AcceptingOrders: true,
 			},
 		},
 		{
-			name:      "only release-all",
-			flags:     []string{"--release-all", "addr1,addr2"},
+			name:      "disable",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin: sdk.AccAddress("FromAddress_________").String(),
-				ToRelease: []exchange.AccountAmount{
-					{Account: "addr1", Amount: nil},
-					{Account: "addr2", Amount: nil},
-				},
-			},
-		},
-		{
-			name: "everything",
-			flags: []string{
-				"--admin", "charly", "--market", "4", "--tag", "someragtag",
-				"--release", "addr1:4apple,5banana",
-				"--release-all", "addr2,addr3",
-				"--release", "addr4:10cherry,addr5:8date",
-			},
-			expMsg: &exchange.MsgMarketReleaseCommitmentsRequest{
-				Admin:    "charly",
-				MarketId: 4,
-				ToRelease: []exchange.AccountAmount{
-					{Account: "addr1", Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 4), sdk.NewInt64Coin("banana", 5))},
-					{Account: "addr4", Amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 10))},
-					{Account: "addr5", Amount: sdk.NewCoins(sdk.NewInt64Coin("date", 8))},
-					{Account: "addr2", Amount: nil},
-					{Account: "addr3", Amount: nil},
-				},
-				EventTag: "someragtag",
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
+				Admin:           "Blake",
+				MarketId:        94,
+				AcceptingOrders: false,
 			},
 		},
 	}
@@ -883,13 +1784,13 @@ func TestMakeMsgMarketReleaseCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketSetOrderExternalID(t *testing.T) {
+func TestSetupCmdTxMarketUpdateUserSettle(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketSetOrderExternalID",
-		setup: cli.SetupCmdTxMarketSetOrderExternalID,
+		name:  "SetupCmdTxMarketUpdateUserSettle",
+		setup: cli.SetupCmdTxMarketUpdateUserSettle,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagOrder, cli.FlagExternalID,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -903,49 +1804,57 @@ func TestSetupCmdTxMarketSetOrderExternalID(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagOrder:  {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", "--order <order id>",
-			"[--external-id <external id>]",
-			cli.ReqAdminDesc,
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
 		},
 	})
 }
 
-func TestMakeMsgMarketSetOrderExternalID(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketSetOrderExternalIDRequest]{
-		makerName: "MakeMsgMarketSetOrderExternalID",
-		maker:     cli.MakeMsgMarketSetOrderExternalID,
-		setup:     cli.SetupCmdTxMarketSetOrderExternalID,
+func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateUserSettleRequest]{
+		makerName: "MakeMsgMarketUpdateUserSettle",
+		maker:     cli.MakeMsgMarketUpdateUserSettle,
+		setup:     cli.SetupCmdTxMarketUpdateUserSettle,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketSetOrderExternalIDRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateUserSettleRequest]{
 		{
-			name:  "no admin",
-			flags: []string{"--market", "8", "--order", "7", "--external-id", "markus"},
-			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
-				MarketId: 8, OrderId: 7, ExternalId: "markus",
-			},
-			expErr: "no <admin> provided",
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
 		},
 		{
-			name:      "no external id",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "4000", "--order", "9001"},
-			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin:    sdk.AccAddress("FromAddress_________").String(),
-				MarketId: 4000, OrderId: 9001, ExternalId: "",
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               sdk.AccAddress("FromAddress_________").String(),
+				MarketId:            4,
+				AllowUserSettlement: true,
 			},
 		},
 		{
-			name: "all the flags",
-			flags: []string{
-				"--market", "5", "--order", "100000000000001",
-				"--external-id", "one", "--admin", "michelle",
-			},
-			expMsg: &exchange.MsgMarketSetOrderExternalIDRequest{
-				Admin: "michelle", MarketId: 5, OrderId: 100000000000001, ExternalId: "one",
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
+				Admin:               "Blake",
+				MarketId:            94,
+				AllowUserSettlement: false,
 			},
 		},
 	}
@@ -957,13 +1866,13 @@ func TestMakeMsgMarketSetOrderExternalID(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketWithdraw(t *testing.T) {
+func TestSetupCmdTxMarketUpdateAcceptingCommitments(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketWithdraw",
-		setup: cli.SetupCmdTxMarketWithdraw,
+		name:  "SetupCmdTxMarketUpdateAcceptingCommitments",
+		setup: cli.SetupCmdTxMarketUpdateAcceptingCommitments,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagTo, cli.FlagAmount,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -977,163 +1886,75 @@ func TestSetupCmdTxMarketWithdraw(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagTo:     {required: {"true"}},
-			cli.FlagAmount: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", "--to <to address>", "--amount <amount>",
-			cli.ReqAdminDesc,
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
 		},
 	})
 }
 
-func TestMakeMsgMarketWithdraw(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketWithdrawRequest]{
-		makerName: "MakeMsgMarketWithdraw",
-		maker:     cli.MakeMsgMarketWithdraw,
-		setup:     cli.SetupCmdTxMarketWithdraw,
+func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
+		makerName: "MakeMsgMarketUpdateAcceptingCommitments",
+		maker:     cli.MakeMsgMarketUpdateAcceptingCommitments,
+		setup:     cli.SetupCmdTxMarketUpdateAcceptingCommitments,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketWithdrawRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
 		{
-			name:  "some errors",
-			flags: []string{"--market", "5", "--to", "annie", "--amount", "bill"},
-			expMsg: &exchange.MsgMarketWithdrawRequest{
-				Admin: "", MarketId: 5, ToAddress: "annie", Amount: nil,
-			},
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{MarketId: 56},
 			expErr: joinErrs(
 				"no <admin> provided",
-				"error parsing --amount as coins: invalid coin expression: \"bill\"",
+				"exactly one of --enable or --disable must be provided",
 			),
 		},
 		{
-			name:      "all fields",
+			name:      "enable",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "2", "--to", "samantha", "--amount", "52plum,18pear"},
-			expMsg: &exchange.MsgMarketWithdrawRequest{
-				Admin:    sdk.AccAddress("FromAddress_________").String(),
-				MarketId: 2, ToAddress: "samantha",
-				Amount: sdk.NewCoins(sdk.NewInt64Coin("plum", 52), sdk.NewInt64Coin("pear", 18)),
-			},
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			runTxMakerTestCase(t, td, tc)
-		})
-	}
-}
-
-func TestAddFlagsMarketDetails(t *testing.T) {
-	runSetupTestCase(t, setupTestCase{
-		name:               "AddFlagsMarketDetails",
-		setup:              cli.AddFlagsMarketDetails,
-		expFlags:           []string{cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon},
-		skipArgsCheck:      true,
-		skipFlagInUseCheck: true,
-	})
-}
-
-func TestReadFlagsMarketDetails(t *testing.T) {
-	tests := []struct {
-		name       string
-		skipSetup  bool
-		flags      []string
-		def        exchange.MarketDetails
-		expDetails exchange.MarketDetails
-		expErr     string
-	}{
-		{
-			name:      "no setup",
-			skipSetup: true,
-			expErr: joinErrs(
-				"flag accessed but not defined: name",
-				"flag accessed but not defined: description",
-				"flag accessed but not defined: url",
-				"flag accessed but not defined: icon",
-			),
-		},
-		{
-			name:       "just name",
-			flags:      []string{"--name", "Richard"},
-			expDetails: exchange.MarketDetails{Name: "Richard"},
-		},
-		{
-			name:  "name and url, no defaults",
-			flags: []string{"--url", "https://example.com", "--name", "Sally"},
-			expDetails: exchange.MarketDetails{
-				Name:       "Sally",
-				WebsiteUrl: "https://example.com",
-			},
-		},
-		{
-			name:  "name and url, with defaults",
-			flags: []string{"--url", "https://example.com/new", "--name", "Glen"},
-			def: exchange.MarketDetails{
-				Name:        "Martha",
-				Description: "Some existing Description",
-				WebsiteUrl:  "https://old.example.com",
-				IconUri:     "https://example.com/icon",
-			},
-			expDetails: exchange.MarketDetails{
-				Name:        "Glen",
-				Description: "Some existing Description",
-				WebsiteUrl:  "https://example.com/new",
-				IconUri:     "https://example.com/icon",
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                sdk.AccAddress("FromAddress_________").String(),
+				MarketId:             4,
+				AcceptingCommitments: true,
 			},
 		},
 		{
-			name: "all fields",
-			flags: []string{
-				"--name", "Market Eight Dude",
-				"--description", "The Little Lebowski",
-				"--url", "https://bowling.god",
-				"--icon", "https://bowling.god/icon",
-			},
-			expDetails: exchange.MarketDetails{
-				Name:        "Market Eight Dude",
-				Description: "The Little Lebowski",
-				WebsiteUrl:  "https://bowling.god",
-				IconUri:     "https://bowling.god/icon",
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
+				Admin:                "Blake",
+				MarketId:             94,
+				AcceptingCommitments: false,
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			cmd := &cobra.Command{
-				Use: "dummy",
-				RunE: func(cmd *cobra.Command, args []string) error {
-					return errors.New("this dummy command should not have been executed")
-				},
-			}
-			if !tc.skipSetup {
-				cli.AddFlagsMarketDetails(cmd)
-			}
-
-			err := cmd.Flags().Parse(tc.flags)
-			require.NoError(t, err, "cmd.Flags().Parse(%q)", tc.flags)
-
-			var details exchange.MarketDetails
-			testFunc := func() {
-				details, err = cli.ReadFlagsMarketDetails(cmd.Flags(), tc.def)
-			}
-			require.NotPanics(t, testFunc, "ReadFlagsMarketDetails")
-			assertions.AssertErrorValue(t, err, tc.expErr, "ReadFlagsMarketDetails")
-			assert.Equal(t, tc.expDetails, details, "ReadFlagsMarketDetails")
+			runTxMakerTestCase(t, td, tc)
 		})
 	}
 }
 
-func TestSetupCmdTxMarketUpdateDetails(t *testing.T) {
+func TestSetupCmdTxMarketUpdateIntermediaryDenom(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateDetails",
-		setup: cli.SetupCmdTxMarketUpdateDetails,
+		name:  "SetupCmdTxMarketUpdateIntermediaryDenom",
+		setup: cli.SetupCmdTxMarketUpdateIntermediaryDenom,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket,
-			cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
+			cli.FlagMarket, cli.FlagDenom,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -1147,64 +1968,57 @@ func TestSetupCmdTxMarketUpdateDetails(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
+			cli.FlagDenom:  {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>",
-			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
+			cli.ReqAdminUse, "--market <market id>", "--denom <denom>",
 			cli.ReqAdminDesc,
-			`All fields of a market's details will be updated.
-If you omit an optional flag, that field will be updated to an empty string.`,
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateDetails(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateDetailsRequest]{
-		makerName: "MakeMsgMarketUpdateDetails",
-		maker:     cli.MakeMsgMarketUpdateDetails,
-		setup:     cli.SetupCmdTxMarketUpdateDetails,
+func TestMakeMsgMarketUpdateIntermediaryDenom(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
+		makerName: "MakeMsgMarketUpdateIntermediaryDenom",
+		maker:     cli.MakeMsgMarketUpdateIntermediaryDenom,
+		setup:     cli.SetupCmdTxMarketUpdateIntermediaryDenom,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateDetailsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
 		{
-			name:  "no admin",
-			flags: []string{"--market", "8", "--name", "Lynne"},
-			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
-				MarketId:      8,
-				MarketDetails: exchange.MarketDetails{Name: "Lynne"},
+			name:  "an error",
+			flags: []string{"--market", "12"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				MarketId: 12,
 			},
 			expErr: "no <admin> provided",
 		},
 		{
-			name:      "just name and description",
+			name:      "admin from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "9002", "--name", "River", "--description", "The person, not the water."},
-			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
-				Admin:    sdk.AccAddress("FromAddress_________").String(),
-				MarketId: 9002,
-				MarketDetails: exchange.MarketDetails{
-					Name:        "River",
-					Description: "The person, not the water.",
-				},
+			flags:     []string{"--market", "4", "--denom", "cherry"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				Admin:             sdk.AccAddress("FromAddress_________").String(),
+				MarketId:          4,
+				IntermediaryDenom: "cherry",
 			},
 		},
 		{
-			name: "all fields",
-			flags: []string{
-				"--market", "14", "--authority", "--name", "Ashley",
-				"--icon", "https://example.com/ashley/icon",
-				"--url", "https://example.com/ashley",
-				"--description", "The best market out there.",
+			name:  "admin from flag",
+			flags: []string{"--market", "51", "--denom", "prune", "--admin", "blake"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				Admin:             "blake",
+				MarketId:          51,
+				IntermediaryDenom: "prune",
 			},
-			expMsg: &exchange.MsgMarketUpdateDetailsRequest{
-				Admin:    cli.AuthorityAddr.String(),
-				MarketId: 14,
-				MarketDetails: exchange.MarketDetails{
-					Name:        "Ashley",
-					Description: "The best market out there.",
-					WebsiteUrl:  "https://example.com/ashley",
-					IconUri:     "https://example.com/ashley/icon",
-				},
+		},
+		{
+			name:  "admin as authority",
+			flags: []string{"--market", "7", "--authority", "--denom", "banana"},
+			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
+				Admin:             cli.AuthorityAddr.String(),
+				MarketId:          7,
+				IntermediaryDenom: "banana",
 			},
 		},
 	}
@@ -1216,13 +2030,14 @@ func TestMakeMsgMarketUpdateDetails(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateAcceptingOrders(t *testing.T) {
+func TestSetupCmdTxMarketUpdateRebates(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateAcceptingOrders",
-		setup: cli.SetupCmdTxMarketUpdateAcceptingOrders,
+		name:  "SetupCmdTxMarketUpdateRebates",
+		setup: cli.SetupCmdTxMarketUpdateRebates,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			cli.FlagMarket, cli.FlagBips, cli.FlagDenom,
+			cli.FlagSampleInterval, cli.FlagPayoutInterval,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -1236,57 +2051,63 @@ func TestSetupCmdTxMarketUpdateAcceptingOrders(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagEnable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
-			cli.FlagDisable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
-			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+			cli.ReqAdminUse, "--market <market id>", "[--bips <bips>]", "[--denom <price denom>]",
+			"[--sample-interval <blocks>]", "[--payout-interval <blocks>]",
+			cli.ReqAdminDesc,
+			"Providing a --bips of zero (or omitting it) disables rebates for the market.",
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateAcceptingOrders(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
-		makerName: "MakeMsgMarketUpdateAcceptingOrders",
-		maker:     cli.MakeMsgMarketUpdateAcceptingOrders,
-		setup:     cli.SetupCmdTxMarketUpdateAcceptingOrders,
+func TestMakeMsgMarketUpdateRebates(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateRebatesRequest]{
+		makerName: "MakeMsgMarketUpdateRebates",
+		maker:     cli.MakeMsgMarketUpdateRebates,
+		setup:     cli.SetupCmdTxMarketUpdateRebates,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingOrdersRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateRebatesRequest]{
 		{
-			name:   "some errors",
-			flags:  []string{"--market", "56"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{MarketId: 56},
-			expErr: joinErrs(
-				"no <admin> provided",
-				"exactly one of --enable or --disable must be provided",
-			),
+			name:  "an error",
+			flags: []string{"--market", "12"},
+			expMsg: &exchange.MsgMarketUpdateRebatesRequest{
+				MarketId: 12,
+			},
+			expErr: "no <admin> provided",
 		},
 		{
-			name:      "enable",
+			name:      "disable with admin from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--enable", "--market", "4"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:           sdk.AccAddress("FromAddress_________").String(),
-				MarketId:        4,
-				AcceptingOrders: true,
+			flags:     []string{"--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateRebatesRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4,
 			},
 		},
 		{
-			name:      "disable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingOrdersRequest{
-				Admin:           "Blake",
-				MarketId:        94,
-				AcceptingOrders: false,
+			name: "all fields",
+			flags: []string{"--market", "51", "--admin", "blake", "--bips", "250",
+				"--denom", "cherry", "--sample-interval", "10", "--payout-interval", "1000"},
+			expMsg: &exchange.MsgMarketUpdateRebatesRequest{
+				Admin:    "blake",
+				MarketId: 51,
+				Config: exchange.MarketRebateConfig{
+					PoolBips:       250,
+					PriceDenom:     "cherry",
+					SampleInterval: 10,
+					PayoutInterval: 1000,
+				},
+			},
+		},
+		{
+			name:  "admin as authority",
+			flags: []string{"--market", "7", "--authority", "--bips", "1", "--denom", "banana"},
+			expMsg: &exchange.MsgMarketUpdateRebatesRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 7,
+				Config:   exchange.MarketRebateConfig{PoolBips: 1, PriceDenom: "banana"},
 			},
 		},
 	}
@@ -1298,13 +2119,13 @@ func TestMakeMsgMarketUpdateAcceptingOrders(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateUserSettle(t *testing.T) {
+func TestSetupCmdTxMarketUpdateExternalSettlement(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateUserSettle",
-		setup: cli.SetupCmdTxMarketUpdateUserSettle,
+		name:  "SetupCmdTxMarketUpdateExternalSettlement",
+		setup: cli.SetupCmdTxMarketUpdateExternalSettlement,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			cli.FlagMarket, cli.FlagOracles, cli.FlagTimeout,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -1318,57 +2139,53 @@ func TestSetupCmdTxMarketUpdateUserSettle(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagEnable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
-			cli.FlagDisable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
-			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+			cli.ReqAdminUse, "--market <market id>", "[--oracles <oracles>]", "[--timeout <blocks>]",
+			cli.ReqAdminDesc, cli.RepeatableDesc,
+			"Omitting --oracles disables external settlement for the market.",
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateUserSettleRequest]{
-		makerName: "MakeMsgMarketUpdateUserSettle",
-		maker:     cli.MakeMsgMarketUpdateUserSettle,
-		setup:     cli.SetupCmdTxMarketUpdateUserSettle,
+func TestMakeMsgMarketUpdateExternalSettlement(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateExternalSettlementRequest]{
+		makerName: "MakeMsgMarketUpdateExternalSettlement",
+		maker:     cli.MakeMsgMarketUpdateExternalSettlement,
+		setup:     cli.SetupCmdTxMarketUpdateExternalSettlement,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateUserSettleRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateExternalSettlementRequest]{
 		{
-			name:   "some errors",
-			flags:  []string{"--market", "56"},
-			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{MarketId: 56},
-			expErr: joinErrs(
-				"no <admin> provided",
-				"exactly one of --enable or --disable must be provided",
-			),
+			name:  "an error",
+			flags: []string{"--market", "12"},
+			expMsg: &exchange.MsgMarketUpdateExternalSettlementRequest{
+				MarketId: 12,
+				Config:   exchange.ExternalSettlementConfig{Oracles: []string{}},
+			},
+			expErr: "no <admin> provided",
 		},
 		{
-			name:      "enable",
+			name:      "disable with admin from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--enable", "--market", "4"},
-			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               sdk.AccAddress("FromAddress_________").String(),
-				MarketId:            4,
-				AllowUserSettlement: true,
+			flags:     []string{"--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateExternalSettlementRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4,
+				Config:   exchange.ExternalSettlementConfig{Oracles: []string{}},
 			},
 		},
 		{
-			name:      "disable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
-			expMsg: &exchange.MsgMarketUpdateUserSettleRequest{
-				Admin:               "Blake",
-				MarketId:            94,
-				AllowUserSettlement: false,
+			name: "all fields",
+			flags: []string{"--market", "51", "--admin", "amy", "--oracles", "kif,scruffy",
+				"--oracles", "nibbler", "--timeout", "600"},
+			expMsg: &exchange.MsgMarketUpdateExternalSettlementRequest{
+				Admin:    "amy",
+				MarketId: 51,
+				Config: exchange.ExternalSettlementConfig{
+					Oracles:       []string{"kif", "scruffy", "nibbler"},
+					TimeoutBlocks: 600,
+				},
 			},
 		},
 	}
@@ -1380,13 +2197,13 @@ func TestMakeMsgMarketUpdateUserSettle(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateAcceptingCommitments(t *testing.T) {
+func TestSetupCmdTxMarketUpdatePriceBand(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateAcceptingCommitments",
-		setup: cli.SetupCmdTxMarketUpdateAcceptingCommitments,
+		name:  "SetupCmdTxMarketUpdatePriceBand",
+		setup: cli.SetupCmdTxMarketUpdatePriceBand,
 		expFlags: []string{
 			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			cli.FlagMarket, cli.FlagBips, cli.FlagReference, cli.FlagAction,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -1400,57 +2217,68 @@ func TestSetupCmdTxMarketUpdateAcceptingCommitments(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagEnable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
-			cli.FlagDisable: {
-				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
-				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
-			},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
-			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+			cli.ReqAdminUse, "--market <market id>", "[--bips <bips>]", "[--reference <reference>]", "[--action <action>]",
+			cli.ReqAdminDesc,
+			"Providing a --bips of zero (or omitting it) removes the market's price band.",
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
-		makerName: "MakeMsgMarketUpdateAcceptingCommitments",
-		maker:     cli.MakeMsgMarketUpdateAcceptingCommitments,
-		setup:     cli.SetupCmdTxMarketUpdateAcceptingCommitments,
+func TestMakeMsgMarketUpdatePriceBand(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdatePriceBandRequest]{
+		makerName: "MakeMsgMarketUpdatePriceBand",
+		maker:     cli.MakeMsgMarketUpdatePriceBand,
+		setup:     cli.SetupCmdTxMarketUpdatePriceBand,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAcceptingCommitmentsRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdatePriceBandRequest]{
 		{
-			name:   "some errors",
-			flags:  []string{"--market", "56"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{MarketId: 56},
+			name:  "some errors",
+			flags: []string{"--market", "12", "--reference", "bananas", "--action", "explode"},
+			expMsg: &exchange.MsgMarketUpdatePriceBandRequest{
+				MarketId: 12,
+			},
 			expErr: joinErrs(
 				"no <admin> provided",
-				"exactly one of --enable or --disable must be provided",
+				"invalid price band reference: \"bananas\"",
+				"invalid price band action: \"explode\"",
 			),
 		},
 		{
-			name:      "enable",
+			name:      "disable with admin from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--enable", "--market", "4"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                sdk.AccAddress("FromAddress_________").String(),
-				MarketId:             4,
-				AcceptingCommitments: true,
+			flags:     []string{"--market", "4"},
+			expMsg: &exchange.MsgMarketUpdatePriceBandRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4,
 			},
 		},
 		{
-			name:      "disable",
-			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
-			expMsg: &exchange.MsgMarketUpdateAcceptingCommitmentsRequest{
-				Admin:                "Blake",
-				MarketId:             94,
-				AcceptingCommitments: false,
+			name:  "last settlement and reject",
+			flags: []string{"--market", "51", "--admin", "amy", "--bips", "500", "--reference", "last_settlement", "--action", "REJECT"},
+			expMsg: &exchange.MsgMarketUpdatePriceBandRequest{
+				Admin:    "amy",
+				MarketId: 51,
+				Config: exchange.MarketPriceBandConfig{
+					MaxDeviationBips: 500,
+					Reference:        exchange.PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT,
+					Action:           exchange.PriceBandAction_PRICE_BAND_ACTION_REJECT,
+				},
+			},
+		},
+		{
+			name:  "nav and pause",
+			flags: []string{"--market", "7", "--authority", "--bips", "25", "--reference", "nav", "--action", "pause"},
+			expMsg: &exchange.MsgMarketUpdatePriceBandRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 7,
+				Config: exchange.MarketPriceBandConfig{
+					MaxDeviationBips: 25,
+					Reference:        exchange.PriceBandReference_PRICE_BAND_REFERENCE_NAV,
+					Action:           exchange.PriceBandAction_PRICE_BAND_ACTION_PAUSE,
+				},
 			},
 		},
 	}
@@ -1462,13 +2290,12 @@ func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
 	}
 }
 
-func TestSetupCmdTxMarketUpdateIntermediaryDenom(t *testing.T) {
+func TestSetupCmdTxMarketResumeSettlements(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
-		name:  "SetupCmdTxMarketUpdateIntermediaryDenom",
-		setup: cli.SetupCmdTxMarketUpdateIntermediaryDenom,
+		name:  "SetupCmdTxMarketResumeSettlements",
+		setup: cli.SetupCmdTxMarketResumeSettlements,
 		expFlags: []string{
-			cli.FlagAdmin, cli.FlagAuthority,
-			cli.FlagMarket, cli.FlagDenom,
+			cli.FlagAdmin, cli.FlagAuthority, cli.FlagMarket,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -1482,57 +2309,43 @@ func TestSetupCmdTxMarketUpdateIntermediaryDenom(t *testing.T) {
 				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
 			},
 			cli.FlagMarket: {required: {"true"}},
-			cli.FlagDenom:  {required: {"true"}},
 		},
 		expInUse: []string{
-			cli.ReqAdminUse, "--market <market id>", "--denom <denom>",
+			cli.ReqAdminUse, "--market <market id>",
 			cli.ReqAdminDesc,
 		},
 	})
 }
 
-func TestMakeMsgMarketUpdateIntermediaryDenom(t *testing.T) {
-	td := txMakerTestDef[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
-		makerName: "MakeMsgMarketUpdateIntermediaryDenom",
-		maker:     cli.MakeMsgMarketUpdateIntermediaryDenom,
-		setup:     cli.SetupCmdTxMarketUpdateIntermediaryDenom,
+func TestMakeMsgMarketResumeSettlements(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketResumeSettlementsRequest]{
+		makerName: "MakeMsgMarketResumeSettlements",
+		maker:     cli.MakeMsgMarketResumeSettlements,
+		setup:     cli.SetupCmdTxMarketResumeSettlements,
 	}
 
-	tests := []txMakerTestCase[*exchange.MsgMarketUpdateIntermediaryDenomRequest]{
+	tests := []txMakerTestCase[*exchange.MsgMarketResumeSettlementsRequest]{
 		{
-			name:  "an error",
-			flags: []string{"--market", "12"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				MarketId: 12,
-			},
+			name:   "no admin",
+			flags:  []string{"--market", "12"},
+			expMsg: &exchange.MsgMarketResumeSettlementsRequest{MarketId: 12},
 			expErr: "no <admin> provided",
 		},
 		{
 			name:      "admin from from",
 			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
-			flags:     []string{"--market", "4", "--denom", "cherry"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				Admin:             sdk.AccAddress("FromAddress_________").String(),
-				MarketId:          4,
-				IntermediaryDenom: "cherry",
-			},
-		},
-		{
-			name:  "admin from flag",
-			flags: []string{"--market", "51", "--denom", "prune", "--admin", "blake"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				Admin:             "blake",
-				MarketId:          51,
-				IntermediaryDenom: "prune",
+			flags:     []string{"--market", "4"},
+			expMsg: &exchange.MsgMarketResumeSettlementsRequest{
+				Admin:    sdk.AccAddress("FromAddress_________").String(),
+				MarketId: 4,
 			},
 		},
 		{
-			name:  "admin as authority",
-			flags: []string{"--market", "7", "--authority", "--denom", "banana"},
-			expMsg: &exchange.MsgMarketUpdateIntermediaryDenomRequest{
-				Admin:             cli.AuthorityAddr.String(),
-				MarketId:          7,
-				IntermediaryDenom: "banana",
+			name:  "authority",
+			flags: []string{"--market", "9", "--authority"},
+			expMsg: &exchange.MsgMarketResumeSettlementsRequest{
+				Admin:    cli.AuthorityAddr.String(),
+				MarketId: 9,
 			},
 		},
 	}
@@ -2261,6 +3074,98 @@ func TestMakeMsgChangePaymentTarget(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxIssuerCreateMarket(t *testing.T) {
+	tc := setupTestCase{
+		name:  "SetupCmdTxIssuerCreateMarket",
+		setup: cli.SetupCmdTxIssuerCreateMarket,
+		expFlags: []string{
+			cli.FlagIssuer, cli.FlagMarkerDenom,
+			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
+			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
+			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
+			cli.FlagBips, cli.FlagDenom,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagMarkerDenom: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{--from|--issuer} <issuer>", "--marker-denom <denom>", "[--market <market id>]",
+			"[--name <name>]", "[--description <description>]", "[--url <website url>]", "[--icon <icon uri>]",
+			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
+			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
+			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--access-grants <access grants>]",
+			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
+			"[--bips <bips>]", "[--denom <denom>]",
+			cli.ReqSignerDesc(cli.FlagIssuer), cli.RepeatableDesc, cli.AccessGrantsDesc, cli.FeeRatioDesc,
+		},
+	}
+	addOneReqAnnotations(&tc, flags.FlagFrom, cli.FlagIssuer)
+
+	runSetupTestCase(t, tc)
+}
+
+func TestMakeMsgIssuerCreateMarket(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgIssuerCreateMarketRequest]{
+		makerName: "MakeMsgIssuerCreateMarket",
+		maker:     cli.MakeMsgIssuerCreateMarket,
+		setup:     cli.SetupCmdTxIssuerCreateMarket,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgIssuerCreateMarketRequest]{
+		{
+			name:   "no issuer",
+			flags:  []string{"--marker-denom", "acorn"},
+			expMsg: &exchange.MsgIssuerCreateMarketRequest{MarkerDenom: "acorn"},
+			expErr: "no <issuer> provided",
+		},
+		{
+			name:      "issuer from from",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("the_from_address____")},
+			flags:     []string{"--marker-denom", "acorn", "--name", "Acorns"},
+			expMsg: &exchange.MsgIssuerCreateMarketRequest{
+				Issuer:      sdk.AccAddress("the_from_address____").String(),
+				MarkerDenom: "acorn",
+				Market:      exchange.Market{MarketDetails: exchange.MarketDetails{Name: "Acorns"}},
+			},
+		},
+		{
+			name: "a little of everything",
+			flags: []string{
+				"--issuer", "theissuer", "--marker-denom", "acorn", "--market", "3",
+				"--name", "Acorns", "--create-ask", "10fig", "--seller-ratios", "100fig:1fig",
+				"--accepting-orders", "--access-grants", "addr1:all",
+				"--req-attr-bid", "*.kyc", "--bips", "5", "--denom", "fig",
+			},
+			expMsg: &exchange.MsgIssuerCreateMarketRequest{
+				Issuer:      "theissuer",
+				MarkerDenom: "acorn",
+				Market: exchange.Market{
+					MarketId:                  3,
+					MarketDetails:             exchange.MarketDetails{Name: "Acorns"},
+					FeeCreateAskFlat:          []sdk.Coin{sdk.NewInt64Coin("fig", 10)},
+					FeeSellerSettlementRatios: []exchange.FeeRatio{{Price: sdk.NewInt64Coin("fig", 100), Fee: sdk.NewInt64Coin("fig", 1)}},
+					AcceptingOrders:           true,
+					AccessGrants:              []exchange.AccessGrant{{Address: "addr1", Permissions: exchange.AllPermissions()}},
+					ReqAttrCreateBid:          []string{"*.kyc"},
+					CommitmentSettlementBips:  5,
+					IntermediaryDenom:         "fig",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 	tc := setupTestCase{
 		name:  "SetupCmdTxGovCreateMarket",
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -14,6 +15,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	copy(messages, AllRequestMsgs)
 	registry.RegisterImplementations((*sdk.Msg)(nil), messages...)
 
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&MarketTradingAuthorization{},
+	)
+
 	registry.RegisterInterface(
 		"provenance.exchange.v1.MarketAccount",
 		(*sdk.AccountI)(nil),
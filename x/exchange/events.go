@@ -23,7 +23,7 @@ func NewEventOrderCancelled(order OrderI, cancelledBy string) *EventOrderCancell
 	}
 }
 
-func NewEventOrderFilled(order OrderI) *EventOrderFilled {
+func NewEventOrderFilled(order OrderI, receiptID uint64) *EventOrderFilled {
 	return &EventOrderFilled{
 		OrderId:    order.GetOrderID(),
 		Assets:     order.GetAssets().String(),
@@ -31,10 +31,11 @@ func NewEventOrderFilled(order OrderI) *EventOrderFilled {
 		Fees:       order.GetSettlementFees().String(),
 		MarketId:   order.GetMarketID(),
 		ExternalId: order.GetExternalID(),
+		ReceiptId:  receiptID,
 	}
 }
 
-func NewEventOrderPartiallyFilled(order OrderI) *EventOrderPartiallyFilled {
+func NewEventOrderPartiallyFilled(order OrderI, receiptID uint64) *EventOrderPartiallyFilled {
 	return &EventOrderPartiallyFilled{
 		OrderId:    order.GetOrderID(),
 		Assets:     order.GetAssets().String(),
@@ -42,6 +43,7 @@ func NewEventOrderPartiallyFilled(order OrderI) *EventOrderPartiallyFilled {
 		Fees:       order.GetSettlementFees().String(),
 		MarketId:   order.GetMarketID(),
 		ExternalId: order.GetExternalID(),
+		ReceiptId:  receiptID,
 	}
 }
 
@@ -163,6 +165,131 @@ func NewEventMarketIntermediaryDenomUpdated(marketID uint32, updatedBy string) *
 	}
 }
 
+func NewEventMarketRebatesUpdated(marketID uint32, updatedBy string) *EventMarketRebatesUpdated {
+	return &EventMarketRebatesUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketRebatesPaid(marketID uint32, amount sdk.Coins, recipients int) *EventMarketRebatesPaid {
+	return &EventMarketRebatesPaid{
+		MarketId:   marketID,
+		Amount:     amount.String(),
+		Recipients: uint32(recipients), //nolint:gosec // G115: There won't be anywhere near 4 billion recipients.
+	}
+}
+
+func NewEventMarketExternalSettlementUpdated(marketID uint32, updatedBy string) *EventMarketExternalSettlementUpdated {
+	return &EventMarketExternalSettlementUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventExternalSettlementStarted(settlement *ExternalSettlement) *EventExternalSettlementStarted {
+	return &EventExternalSettlementStarted{
+		OrderId:          settlement.OrderId,
+		MarketId:         settlement.AskOrder.MarketId,
+		Buyer:            settlement.Buyer,
+		ExpirationHeight: settlement.ExpirationHeight,
+	}
+}
+
+func NewEventExternalSettlementConfirmed(settlement *ExternalSettlement, paymentRef, confirmedBy string, receiptID uint64) *EventExternalSettlementConfirmed {
+	return &EventExternalSettlementConfirmed{
+		OrderId:          settlement.OrderId,
+		MarketId:         settlement.AskOrder.MarketId,
+		PaymentReference: paymentRef,
+		ConfirmedBy:      confirmedBy,
+		ReceiptId:        receiptID,
+	}
+}
+
+func NewEventExternalSettlementExpired(settlement *ExternalSettlement) *EventExternalSettlementExpired {
+	return &EventExternalSettlementExpired{
+		OrderId:  settlement.OrderId,
+		MarketId: settlement.AskOrder.MarketId,
+	}
+}
+
+func NewEventRFQCreated(rfq *RequestForQuote) *EventRFQCreated {
+	return &EventRFQCreated{
+		RfqId:            rfq.RfqId,
+		MarketId:         rfq.MarketId,
+		Buyer:            rfq.Buyer,
+		Assets:           rfq.Assets.String(),
+		PriceDenom:       rfq.PriceDenom,
+		ExpirationHeight: rfq.ExpirationHeight,
+	}
+}
+
+func NewEventRFQCancelled(rfq *RequestForQuote) *EventRFQCancelled {
+	return &EventRFQCancelled{
+		RfqId:    rfq.RfqId,
+		MarketId: rfq.MarketId,
+	}
+}
+
+func NewEventRFQExpired(rfq *RequestForQuote) *EventRFQExpired {
+	return &EventRFQExpired{
+		RfqId:    rfq.RfqId,
+		MarketId: rfq.MarketId,
+	}
+}
+
+func NewEventQuoteSubmitted(rfq *RequestForQuote, quote Quote) *EventQuoteSubmitted {
+	return &EventQuoteSubmitted{
+		RfqId:    rfq.RfqId,
+		MarketId: rfq.MarketId,
+		Dealer:   quote.Dealer,
+		Price:    quote.Price.String(),
+	}
+}
+
+func NewEventQuoteWithdrawn(rfq *RequestForQuote, dealer string) *EventQuoteWithdrawn {
+	return &EventQuoteWithdrawn{
+		RfqId:    rfq.RfqId,
+		MarketId: rfq.MarketId,
+		Dealer:   dealer,
+	}
+}
+
+func NewEventQuoteAccepted(rfq *RequestForQuote, quote Quote, askOrderID, bidOrderID uint64) *EventQuoteAccepted {
+	return &EventQuoteAccepted{
+		RfqId:      rfq.RfqId,
+		MarketId:   rfq.MarketId,
+		Dealer:     quote.Dealer,
+		Price:      quote.Price.String(),
+		AskOrderId: askOrderID,
+		BidOrderId: bidOrderID,
+	}
+}
+
+func NewEventMarketPriceBandUpdated(marketID uint32, updatedBy string) *EventMarketPriceBandUpdated {
+	return &EventMarketPriceBandUpdated{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketPriceBandTripped(marketID uint32, settlement, reference NetAssetPrice) *EventMarketPriceBandTripped {
+	return &EventMarketPriceBandTripped{
+		MarketId:        marketID,
+		Assets:          settlement.Assets.String(),
+		Price:           settlement.Price.String(),
+		ReferenceAssets: reference.Assets.String(),
+		ReferencePrice:  reference.Price.String(),
+	}
+}
+
+func NewEventMarketSettlementsResumed(marketID uint32, updatedBy string) *EventMarketSettlementsResumed {
+	return &EventMarketSettlementsResumed{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
 func NewEventMarketPermissionsUpdated(marketID uint32, updatedBy string) *EventMarketPermissionsUpdated {
 	return &EventMarketPermissionsUpdated{
 		MarketId:  marketID,
@@ -184,6 +184,8 @@ type EventOrderFilled struct {
 	MarketId uint32 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// external_id is the order's external id.
 	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// receipt_id is the numerical identifier of the settlement receipt created for this fill.
+	ReceiptId uint64 `protobuf:"varint,7,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
 }
 
 func (m *EventOrderFilled) Reset()         { *m = EventOrderFilled{} }
@@ -261,6 +263,13 @@ func (m *EventOrderFilled) GetExternalId() string {
 	return ""
 }
 
+func (m *EventOrderFilled) GetReceiptId() uint64 {
+	if m != nil {
+		return m.ReceiptId
+	}
+	return 0
+}
+
 // EventOrderPartiallyFilled is an event emitted when an order filled in part and still has more left to fill.
 type EventOrderPartiallyFilled struct {
 	// order_id is the numerical identifier of the order partially filled.
@@ -277,6 +286,8 @@ type EventOrderPartiallyFilled struct {
 	MarketId uint32 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	// external_id is the order's external id.
 	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// receipt_id is the numerical identifier of the settlement receipt created for this fill.
+	ReceiptId uint64 `protobuf:"varint,7,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
 }
 
 func (m *EventOrderPartiallyFilled) Reset()         { *m = EventOrderPartiallyFilled{} }
@@ -354,6 +365,13 @@ func (m *EventOrderPartiallyFilled) GetExternalId() string {
 	return ""
 }
 
+func (m *EventOrderPartiallyFilled) GetReceiptId() uint64 {
+	if m != nil {
+		return m.ReceiptId
+	}
+	return 0
+}
+
 // EventOrderExternalIDUpdated is an event emitted when an order's external id is updated.
 type EventOrderExternalIDUpdated struct {
 	// order_id is the numerical identifier of the order partially filled.
@@ -1194,26 +1212,26 @@ func (m *EventMarketIntermediaryDenomUpdated) GetUpdatedBy() string {
 	return ""
 }
 
-// EventMarketPermissionsUpdated is an event emitted when a market's permissions are updated.
-type EventMarketPermissionsUpdated struct {
+// EventMarketRebatesUpdated is an event emitted when a market updates its rebate configuration.
+type EventMarketRebatesUpdated struct {
 	// market_id is the numerical identifier of the market.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the permissions.
+	// updated_by is the account that updated the rebate configuration.
 	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermissionsUpdated{} }
-func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
-func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
+func (m *EventMarketRebatesUpdated) Reset()         { *m = EventMarketRebatesUpdated{} }
+func (m *EventMarketRebatesUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketRebatesUpdated) ProtoMessage()    {}
+func (*EventMarketRebatesUpdated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{18}
 }
-func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketRebatesUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketPermissionsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketRebatesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketPermissionsUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketRebatesUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1223,52 +1241,54 @@ func (m *EventMarketPermissionsUpdated) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EventMarketPermissionsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketPermissionsUpdated.Merge(m, src)
+func (m *EventMarketRebatesUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketRebatesUpdated.Merge(m, src)
 }
-func (m *EventMarketPermissionsUpdated) XXX_Size() int {
+func (m *EventMarketRebatesUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketPermissionsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketPermissionsUpdated.DiscardUnknown(m)
+func (m *EventMarketRebatesUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketRebatesUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketPermissionsUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketRebatesUpdated proto.InternalMessageInfo
 
-func (m *EventMarketPermissionsUpdated) GetMarketId() uint32 {
+func (m *EventMarketRebatesUpdated) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketPermissionsUpdated) GetUpdatedBy() string {
+func (m *EventMarketRebatesUpdated) GetUpdatedBy() string {
 	if m != nil {
 		return m.UpdatedBy
 	}
 	return ""
 }
 
-// EventMarketReqAttrUpdated is an event emitted when a market's required attributes are updated.
-type EventMarketReqAttrUpdated struct {
+// EventMarketRebatesPaid is an event emitted when a market's rebate pool is paid out.
+type EventMarketRebatesPaid struct {
 	// market_id is the numerical identifier of the market.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// updated_by is the account that updated the required attributes.
-	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// amount is the coins amount string of the total funds paid out of the rebate pool.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// recipients is the number of accounts that received a rebate.
+	Recipients uint32 `protobuf:"varint,3,opt,name=recipients,proto3" json:"recipients,omitempty"`
 }
 
-func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpdated{} }
-func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
-func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
+func (m *EventMarketRebatesPaid) Reset()         { *m = EventMarketRebatesPaid{} }
+func (m *EventMarketRebatesPaid) String() string { return proto.CompactTextString(m) }
+func (*EventMarketRebatesPaid) ProtoMessage()    {}
+func (*EventMarketRebatesPaid) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{19}
 }
-func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketRebatesPaid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketReqAttrUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketRebatesPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketReqAttrUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketRebatesPaid.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1278,50 +1298,59 @@ func (m *EventMarketReqAttrUpdated) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *EventMarketReqAttrUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketReqAttrUpdated.Merge(m, src)
+func (m *EventMarketRebatesPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketRebatesPaid.Merge(m, src)
 }
-func (m *EventMarketReqAttrUpdated) XXX_Size() int {
+func (m *EventMarketRebatesPaid) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketReqAttrUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketReqAttrUpdated.DiscardUnknown(m)
+func (m *EventMarketRebatesPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketRebatesPaid.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketReqAttrUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketRebatesPaid proto.InternalMessageInfo
 
-func (m *EventMarketReqAttrUpdated) GetMarketId() uint32 {
+func (m *EventMarketRebatesPaid) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *EventMarketReqAttrUpdated) GetUpdatedBy() string {
+func (m *EventMarketRebatesPaid) GetAmount() string {
 	if m != nil {
-		return m.UpdatedBy
+		return m.Amount
 	}
 	return ""
 }
 
-// EventMarketCreated is an event emitted when a market has been created.
-type EventMarketCreated struct {
+func (m *EventMarketRebatesPaid) GetRecipients() uint32 {
+	if m != nil {
+		return m.Recipients
+	}
+	return 0
+}
+
+// EventMarketExternalSettlementUpdated is an event emitted when a market updates its external settlement configuration.
+type EventMarketExternalSettlementUpdated struct {
 	// market_id is the numerical identifier of the market.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the external settlement configuration.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
-func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketCreated) ProtoMessage()    {}
-func (*EventMarketCreated) Descriptor() ([]byte, []int) {
+func (m *EventMarketExternalSettlementUpdated) Reset()         { *m = EventMarketExternalSettlementUpdated{} }
+func (m *EventMarketExternalSettlementUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketExternalSettlementUpdated) ProtoMessage()    {}
+func (*EventMarketExternalSettlementUpdated) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{20}
 }
-func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventMarketExternalSettlementUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventMarketExternalSettlementUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventMarketExternalSettlementUpdated.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1331,43 +1360,56 @@ func (m *EventMarketCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventMarketCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketCreated.Merge(m, src)
+func (m *EventMarketExternalSettlementUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketExternalSettlementUpdated.Merge(m, src)
 }
-func (m *EventMarketCreated) XXX_Size() int {
+func (m *EventMarketExternalSettlementUpdated) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketCreated.DiscardUnknown(m)
+func (m *EventMarketExternalSettlementUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketExternalSettlementUpdated.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventMarketExternalSettlementUpdated proto.InternalMessageInfo
 
-func (m *EventMarketCreated) GetMarketId() uint32 {
+func (m *EventMarketExternalSettlementUpdated) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// EventMarketFeesUpdated is an event emitted when a market's fees have been updated.
-type EventMarketFeesUpdated struct {
+func (m *EventMarketExternalSettlementUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+// EventExternalSettlementStarted is an event emitted when a market starts the external settlement of an ask order.
+type EventExternalSettlementStarted struct {
+	// order_id is the numerical identifier of the ask order being settled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	// market_id is the numerical identifier of the market.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// buyer is the account that will receive the assets once payment is confirmed.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// expiration_height is the block height at which the settlement will be cancelled if payment hasn't been confirmed.
+	ExpirationHeight int64 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
 }
 
-func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{} }
-func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventMarketFeesUpdated) ProtoMessage()    {}
-func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
+func (m *EventExternalSettlementStarted) Reset()         { *m = EventExternalSettlementStarted{} }
+func (m *EventExternalSettlementStarted) String() string { return proto.CompactTextString(m) }
+func (*EventExternalSettlementStarted) ProtoMessage()    {}
+func (*EventExternalSettlementStarted) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{21}
 }
-func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventExternalSettlementStarted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMarketFeesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExternalSettlementStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMarketFeesUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExternalSettlementStarted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1377,88 +1419,72 @@ func (m *EventMarketFeesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventMarketFeesUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMarketFeesUpdated.Merge(m, src)
+func (m *EventExternalSettlementStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExternalSettlementStarted.Merge(m, src)
 }
-func (m *EventMarketFeesUpdated) XXX_Size() int {
+func (m *EventExternalSettlementStarted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMarketFeesUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMarketFeesUpdated.DiscardUnknown(m)
+func (m *EventExternalSettlementStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExternalSettlementStarted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMarketFeesUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventExternalSettlementStarted proto.InternalMessageInfo
 
-func (m *EventMarketFeesUpdated) GetMarketId() uint32 {
+func (m *EventExternalSettlementStarted) GetOrderId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.OrderId
 	}
 	return 0
 }
 
-// EventParamsUpdated is an event emitted when the exchange module's params have been updated.
-type EventParamsUpdated struct {
+func (m *EventExternalSettlementStarted) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
-func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventParamsUpdated) ProtoMessage()    {}
-func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{22}
-}
-func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *EventExternalSettlementStarted) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
+	return ""
 }
-func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
-}
-func (m *EventParamsUpdated) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventParamsUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
 
-// EventPaymentCreated is an event emitted when a payment is created.
-type EventPaymentCreated struct {
-	// source is the account that created the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the coins amount string of the funds that the source will pay (to the target).
-	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// target is the account that can accept the Payment.
-	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	// target_amount is the coins amount string of the funds that the target will pay (to the source).
-	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+func (m *EventExternalSettlementStarted) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
 }
 
-func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
-func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentCreated) ProtoMessage()    {}
-func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{23}
+// EventExternalSettlementConfirmed is an event emitted when an oracle confirms payment and the assets are delivered.
+type EventExternalSettlementConfirmed struct {
+	// order_id is the numerical identifier of the ask order that was settled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// payment_reference identifies the external payment.
+	PaymentReference string `protobuf:"bytes,3,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	// confirmed_by is the oracle account that confirmed the payment.
+	ConfirmedBy string `protobuf:"bytes,4,opt,name=confirmed_by,json=confirmedBy,proto3" json:"confirmed_by,omitempty"`
+	// receipt_id is the numerical identifier of the settlement receipt created for this fill.
+	ReceiptId uint64 `protobuf:"varint,5,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+}
+
+func (m *EventExternalSettlementConfirmed) Reset()         { *m = EventExternalSettlementConfirmed{} }
+func (m *EventExternalSettlementConfirmed) String() string { return proto.CompactTextString(m) }
+func (*EventExternalSettlementConfirmed) ProtoMessage()    {}
+func (*EventExternalSettlementConfirmed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{22}
 }
-func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
+func (m *EventExternalSettlementConfirmed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExternalSettlementConfirmed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentCreated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExternalSettlementConfirmed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1468,81 +1494,74 @@ func (m *EventPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentCreated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentCreated.Merge(m, src)
+func (m *EventExternalSettlementConfirmed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExternalSettlementConfirmed.Merge(m, src)
 }
-func (m *EventPaymentCreated) XXX_Size() int {
+func (m *EventExternalSettlementConfirmed) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentCreated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentCreated.DiscardUnknown(m)
+func (m *EventExternalSettlementConfirmed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExternalSettlementConfirmed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentCreated proto.InternalMessageInfo
+var xxx_messageInfo_EventExternalSettlementConfirmed proto.InternalMessageInfo
 
-func (m *EventPaymentCreated) GetSource() string {
+func (m *EventExternalSettlementConfirmed) GetOrderId() uint64 {
 	if m != nil {
-		return m.Source
+		return m.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentCreated) GetSourceAmount() string {
+func (m *EventExternalSettlementConfirmed) GetMarketId() uint32 {
 	if m != nil {
-		return m.SourceAmount
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentCreated) GetTarget() string {
+func (m *EventExternalSettlementConfirmed) GetPaymentReference() string {
 	if m != nil {
-		return m.Target
+		return m.PaymentReference
 	}
 	return ""
 }
 
-func (m *EventPaymentCreated) GetTargetAmount() string {
+func (m *EventExternalSettlementConfirmed) GetConfirmedBy() string {
 	if m != nil {
-		return m.TargetAmount
+		return m.ConfirmedBy
 	}
 	return ""
 }
 
-func (m *EventPaymentCreated) GetExternalId() string {
+func (m *EventExternalSettlementConfirmed) GetReceiptId() uint64 {
 	if m != nil {
-		return m.ExternalId
+		return m.ReceiptId
 	}
-	return ""
+	return 0
 }
 
-// EventPaymentUpdated is an event emitted when a payment is updated.
-type EventPaymentUpdated struct {
-	// source is the account that updated (and previously created) the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the coins amount string of the funds that the source will pay (to the target).
-	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// old_target is the account that used to be able to accept the Payment (but not any more).
-	OldTarget string `protobuf:"bytes,3,opt,name=old_target,json=oldTarget,proto3" json:"old_target,omitempty"`
-	// new_target is the account that is now able to accept the Payment.
-	NewTarget string `protobuf:"bytes,4,opt,name=new_target,json=newTarget,proto3" json:"new_target,omitempty"`
-	// target_amount is the coins amount string of the funds that the target will pay (to the source).
-	TargetAmount string `protobuf:"bytes,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// EventExternalSettlementExpired is an event emitted when an external settlement is cancelled because payment
+// wasn't confirmed in time.
+type EventExternalSettlementExpired struct {
+	// order_id is the numerical identifier of the ask order that was cancelled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
-func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentUpdated) ProtoMessage()    {}
-func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{24}
+func (m *EventExternalSettlementExpired) Reset()         { *m = EventExternalSettlementExpired{} }
+func (m *EventExternalSettlementExpired) String() string { return proto.CompactTextString(m) }
+func (*EventExternalSettlementExpired) ProtoMessage()    {}
+func (*EventExternalSettlementExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{23}
 }
-func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
+func (m *EventExternalSettlementExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventExternalSettlementExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentUpdated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventExternalSettlementExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1552,86 +1571,143 @@ func (m *EventPaymentUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentUpdated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentUpdated.Merge(m, src)
+func (m *EventExternalSettlementExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExternalSettlementExpired.Merge(m, src)
 }
-func (m *EventPaymentUpdated) XXX_Size() int {
+func (m *EventExternalSettlementExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentUpdated) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentUpdated.DiscardUnknown(m)
+func (m *EventExternalSettlementExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExternalSettlementExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentUpdated proto.InternalMessageInfo
+var xxx_messageInfo_EventExternalSettlementExpired proto.InternalMessageInfo
 
-func (m *EventPaymentUpdated) GetSource() string {
+func (m *EventExternalSettlementExpired) GetOrderId() uint64 {
 	if m != nil {
-		return m.Source
+		return m.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentUpdated) GetSourceAmount() string {
+func (m *EventExternalSettlementExpired) GetMarketId() uint32 {
 	if m != nil {
-		return m.SourceAmount
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentUpdated) GetOldTarget() string {
-	if m != nil {
-		return m.OldTarget
+// EventRFQCreated is an event emitted when a request for quote is created.
+type EventRFQCreated struct {
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// buyer is the account that wants to buy the assets.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// assets is the coin amount string of the assets being requested.
+	Assets string `protobuf:"bytes,4,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price_denom is the denom that the quotes must be priced in.
+	PriceDenom string `protobuf:"bytes,5,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// expiration_height is the block height at which the request will be cancelled.
+	ExpirationHeight int64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *EventRFQCreated) Reset()         { *m = EventRFQCreated{} }
+func (m *EventRFQCreated) String() string { return proto.CompactTextString(m) }
+func (*EventRFQCreated) ProtoMessage()    {}
+func (*EventRFQCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{24}
+}
+func (m *EventRFQCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRFQCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRFQCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *EventRFQCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRFQCreated.Merge(m, src)
+}
+func (m *EventRFQCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRFQCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRFQCreated.DiscardUnknown(m)
 }
 
-func (m *EventPaymentUpdated) GetNewTarget() string {
+var xxx_messageInfo_EventRFQCreated proto.InternalMessageInfo
+
+func (m *EventRFQCreated) GetRfqId() uint64 {
 	if m != nil {
-		return m.NewTarget
+		return m.RfqId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentUpdated) GetTargetAmount() string {
+func (m *EventRFQCreated) GetMarketId() uint32 {
 	if m != nil {
-		return m.TargetAmount
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventRFQCreated) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
 	return ""
 }
 
-func (m *EventPaymentUpdated) GetExternalId() string {
+func (m *EventRFQCreated) GetAssets() string {
 	if m != nil {
-		return m.ExternalId
+		return m.Assets
 	}
 	return ""
 }
 
-// EventPaymentAccepted is an event emitted when a payment is accepted.
-type EventPaymentAccepted struct {
-	// source is the account that created the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// source_amount is the coins amount string of the funds that the source will pay (to the target).
-	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
-	// target is the account that accepted the Payment.
-	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
-	// target_amount is the coins amount string of the funds that the target will pay (to the source).
-	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+func (m *EventRFQCreated) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
 
-func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
-func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentAccepted) ProtoMessage()    {}
-func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
+func (m *EventRFQCreated) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// EventRFQCancelled is an event emitted when a buyer cancels a request for quote.
+type EventRFQCancelled struct {
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *EventRFQCancelled) Reset()         { *m = EventRFQCancelled{} }
+func (m *EventRFQCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventRFQCancelled) ProtoMessage()    {}
+func (*EventRFQCancelled) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c1b69385a348cffa, []int{25}
 }
-func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
+func (m *EventRFQCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventRFQCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentAccepted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventRFQCancelled.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1641,75 +1717,111 @@ func (m *EventPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentAccepted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentAccepted.Merge(m, src)
+func (m *EventRFQCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRFQCancelled.Merge(m, src)
 }
-func (m *EventPaymentAccepted) XXX_Size() int {
+func (m *EventRFQCancelled) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentAccepted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentAccepted.DiscardUnknown(m)
+func (m *EventRFQCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRFQCancelled.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentAccepted proto.InternalMessageInfo
+var xxx_messageInfo_EventRFQCancelled proto.InternalMessageInfo
 
-func (m *EventPaymentAccepted) GetSource() string {
+func (m *EventRFQCancelled) GetRfqId() uint64 {
 	if m != nil {
-		return m.Source
+		return m.RfqId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentAccepted) GetSourceAmount() string {
+func (m *EventRFQCancelled) GetMarketId() uint32 {
 	if m != nil {
-		return m.SourceAmount
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentAccepted) GetTarget() string {
-	if m != nil {
-		return m.Target
+// EventRFQExpired is an event emitted when a request for quote is cancelled because it reached its expiration height.
+type EventRFQExpired struct {
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *EventRFQExpired) Reset()         { *m = EventRFQExpired{} }
+func (m *EventRFQExpired) String() string { return proto.CompactTextString(m) }
+func (*EventRFQExpired) ProtoMessage()    {}
+func (*EventRFQExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{26}
+}
+func (m *EventRFQExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRFQExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRFQExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *EventRFQExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRFQExpired.Merge(m, src)
+}
+func (m *EventRFQExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRFQExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRFQExpired.DiscardUnknown(m)
 }
 
-func (m *EventPaymentAccepted) GetTargetAmount() string {
+var xxx_messageInfo_EventRFQExpired proto.InternalMessageInfo
+
+func (m *EventRFQExpired) GetRfqId() uint64 {
 	if m != nil {
-		return m.TargetAmount
+		return m.RfqId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentAccepted) GetExternalId() string {
+func (m *EventRFQExpired) GetMarketId() uint32 {
 	if m != nil {
-		return m.ExternalId
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-// EventPaymentRejected is an event emitted when a payment is rejected (by the target).
-type EventPaymentRejected struct {
-	// source is the account that created the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// target is the account that rejected the Payment.
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// EventQuoteSubmitted is an event emitted when a dealer submits a quote for a request for quote.
+type EventQuoteSubmitted struct {
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// dealer is the account offering to sell the assets.
+	Dealer string `protobuf:"bytes,3,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// price is the coin amount string of the price the dealer wants for the assets.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 }
 
-func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
-func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentRejected) ProtoMessage()    {}
-func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{26}
+func (m *EventQuoteSubmitted) Reset()         { *m = EventQuoteSubmitted{} }
+func (m *EventQuoteSubmitted) String() string { return proto.CompactTextString(m) }
+func (*EventQuoteSubmitted) ProtoMessage()    {}
+func (*EventQuoteSubmitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{27}
 }
-func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
+func (m *EventQuoteSubmitted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventQuoteSubmitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentRejected.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventQuoteSubmitted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1719,61 +1831,68 @@ func (m *EventPaymentRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentRejected) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentRejected.Merge(m, src)
+func (m *EventQuoteSubmitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQuoteSubmitted.Merge(m, src)
 }
-func (m *EventPaymentRejected) XXX_Size() int {
+func (m *EventQuoteSubmitted) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentRejected) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentRejected.DiscardUnknown(m)
+func (m *EventQuoteSubmitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQuoteSubmitted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentRejected proto.InternalMessageInfo
+var xxx_messageInfo_EventQuoteSubmitted proto.InternalMessageInfo
 
-func (m *EventPaymentRejected) GetSource() string {
+func (m *EventQuoteSubmitted) GetRfqId() uint64 {
 	if m != nil {
-		return m.Source
+		return m.RfqId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentRejected) GetTarget() string {
+func (m *EventQuoteSubmitted) GetMarketId() uint32 {
 	if m != nil {
-		return m.Target
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventQuoteSubmitted) GetDealer() string {
+	if m != nil {
+		return m.Dealer
 	}
 	return ""
 }
 
-func (m *EventPaymentRejected) GetExternalId() string {
+func (m *EventQuoteSubmitted) GetPrice() string {
 	if m != nil {
-		return m.ExternalId
+		return m.Price
 	}
 	return ""
 }
 
-// EventPaymentCancelled is an event emitted when a payment is cancelled (by the source).
-type EventPaymentCancelled struct {
-	// source is the account that cancelled (and created) the Payment.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// target is the account that could have accepted the Payment.
-	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
-	// external_id is used along with the source to uniquely identify this Payment.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// EventQuoteWithdrawn is an event emitted when a dealer withdraws their quote.
+type EventQuoteWithdrawn struct {
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// dealer is the account that withdrew their quote.
+	Dealer string `protobuf:"bytes,3,opt,name=dealer,proto3" json:"dealer,omitempty"`
 }
 
-func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
-func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
-func (*EventPaymentCancelled) ProtoMessage()    {}
-func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{27}
+func (m *EventQuoteWithdrawn) Reset()         { *m = EventQuoteWithdrawn{} }
+func (m *EventQuoteWithdrawn) String() string { return proto.CompactTextString(m) }
+func (*EventQuoteWithdrawn) ProtoMessage()    {}
+func (*EventQuoteWithdrawn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{28}
 }
-func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
+func (m *EventQuoteWithdrawn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EventQuoteWithdrawn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPaymentCancelled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EventQuoteWithdrawn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1783,1095 +1902,1081 @@ func (m *EventPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventPaymentCancelled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPaymentCancelled.Merge(m, src)
+func (m *EventQuoteWithdrawn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQuoteWithdrawn.Merge(m, src)
 }
-func (m *EventPaymentCancelled) XXX_Size() int {
+func (m *EventQuoteWithdrawn) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPaymentCancelled) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPaymentCancelled.DiscardUnknown(m)
+func (m *EventQuoteWithdrawn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQuoteWithdrawn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPaymentCancelled proto.InternalMessageInfo
+var xxx_messageInfo_EventQuoteWithdrawn proto.InternalMessageInfo
 
-func (m *EventPaymentCancelled) GetSource() string {
+func (m *EventQuoteWithdrawn) GetRfqId() uint64 {
 	if m != nil {
-		return m.Source
+		return m.RfqId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentCancelled) GetTarget() string {
+func (m *EventQuoteWithdrawn) GetMarketId() uint32 {
 	if m != nil {
-		return m.Target
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *EventPaymentCancelled) GetExternalId() string {
+func (m *EventQuoteWithdrawn) GetDealer() string {
 	if m != nil {
-		return m.ExternalId
+		return m.Dealer
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventOrderCreated)(nil), "provenance.exchange.v1.EventOrderCreated")
-	proto.RegisterType((*EventOrderCancelled)(nil), "provenance.exchange.v1.EventOrderCancelled")
-	proto.RegisterType((*EventOrderFilled)(nil), "provenance.exchange.v1.EventOrderFilled")
-	proto.RegisterType((*EventOrderPartiallyFilled)(nil), "provenance.exchange.v1.EventOrderPartiallyFilled")
-	proto.RegisterType((*EventOrderExternalIDUpdated)(nil), "provenance.exchange.v1.EventOrderExternalIDUpdated")
-	proto.RegisterType((*EventFundsCommitted)(nil), "provenance.exchange.v1.EventFundsCommitted")
-	proto.RegisterType((*EventCommitmentReleased)(nil), "provenance.exchange.v1.EventCommitmentReleased")
-	proto.RegisterType((*EventMarketWithdraw)(nil), "provenance.exchange.v1.EventMarketWithdraw")
-	proto.RegisterType((*EventMarketDetailsUpdated)(nil), "provenance.exchange.v1.EventMarketDetailsUpdated")
-	proto.RegisterType((*EventMarketEnabled)(nil), "provenance.exchange.v1.EventMarketEnabled")
-	proto.RegisterType((*EventMarketDisabled)(nil), "provenance.exchange.v1.EventMarketDisabled")
-	proto.RegisterType((*EventMarketOrdersEnabled)(nil), "provenance.exchange.v1.EventMarketOrdersEnabled")
-	proto.RegisterType((*EventMarketOrdersDisabled)(nil), "provenance.exchange.v1.EventMarketOrdersDisabled")
-	proto.RegisterType((*EventMarketUserSettleEnabled)(nil), "provenance.exchange.v1.EventMarketUserSettleEnabled")
-	proto.RegisterType((*EventMarketUserSettleDisabled)(nil), "provenance.exchange.v1.EventMarketUserSettleDisabled")
-	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
-	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
-	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
-	proto.RegisterType((*EventMarketPermissionsUpdated)(nil), "provenance.exchange.v1.EventMarketPermissionsUpdated")
-	proto.RegisterType((*EventMarketReqAttrUpdated)(nil), "provenance.exchange.v1.EventMarketReqAttrUpdated")
-	proto.RegisterType((*EventMarketCreated)(nil), "provenance.exchange.v1.EventMarketCreated")
-	proto.RegisterType((*EventMarketFeesUpdated)(nil), "provenance.exchange.v1.EventMarketFeesUpdated")
-	proto.RegisterType((*EventParamsUpdated)(nil), "provenance.exchange.v1.EventParamsUpdated")
-	proto.RegisterType((*EventPaymentCreated)(nil), "provenance.exchange.v1.EventPaymentCreated")
-	proto.RegisterType((*EventPaymentUpdated)(nil), "provenance.exchange.v1.EventPaymentUpdated")
-	proto.RegisterType((*EventPaymentAccepted)(nil), "provenance.exchange.v1.EventPaymentAccepted")
-	proto.RegisterType((*EventPaymentRejected)(nil), "provenance.exchange.v1.EventPaymentRejected")
-	proto.RegisterType((*EventPaymentCancelled)(nil), "provenance.exchange.v1.EventPaymentCancelled")
+// EventQuoteAccepted is an event emitted when a buyer accepts a quote and it is settled.
+type EventQuoteAccepted struct {
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// dealer is the account whose quote was accepted.
+	Dealer string `protobuf:"bytes,3,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// price is the coin amount string of the price the buyer paid for the assets.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	// ask_order_id is the numerical identifier of the ask order that was created for the dealer and settled.
+	AskOrderId uint64 `protobuf:"varint,5,opt,name=ask_order_id,json=askOrderId,proto3" json:"ask_order_id,omitempty"`
+	// bid_order_id is the numerical identifier of the bid order that was created for the buyer and settled.
+	BidOrderId uint64 `protobuf:"varint,6,opt,name=bid_order_id,json=bidOrderId,proto3" json:"bid_order_id,omitempty"`
+}
+
+func (m *EventQuoteAccepted) Reset()         { *m = EventQuoteAccepted{} }
+func (m *EventQuoteAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventQuoteAccepted) ProtoMessage()    {}
+func (*EventQuoteAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{29}
+}
+func (m *EventQuoteAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQuoteAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQuoteAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQuoteAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQuoteAccepted.Merge(m, src)
+}
+func (m *EventQuoteAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQuoteAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQuoteAccepted.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterFile("provenance/exchange/v1/events.proto", fileDescriptor_c1b69385a348cffa)
+var xxx_messageInfo_EventQuoteAccepted proto.InternalMessageInfo
+
+func (m *EventQuoteAccepted) GetRfqId() uint64 {
+	if m != nil {
+		return m.RfqId
+	}
+	return 0
 }
 
-var fileDescriptor_c1b69385a348cffa = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x31, 0x6f, 0xe4, 0x44,
-	0x14, 0xce, 0x6c, 0x92, 0xbd, 0xdb, 0x97, 0x9c, 0x74, 0x98, 0x10, 0x36, 0x1c, 0xb7, 0x44, 0x4e,
-	0x93, 0xe6, 0x76, 0x2f, 0x20, 0x14, 0xe9, 0xa8, 0xb2, 0x97, 0x44, 0x4a, 0x81, 0x58, 0xf9, 0x72,
-	0x42, 0xa2, 0x59, 0x4d, 0xec, 0xc7, 0x66, 0xc0, 0x9e, 0xf1, 0xcd, 0xcc, 0x6e, 0x62, 0xf1, 0x13,
-	0x68, 0xae, 0xa0, 0x83, 0x92, 0x0e, 0xd1, 0x21, 0xfe, 0x00, 0x0d, 0xe5, 0x89, 0x8a, 0x12, 0x25,
-	0xf0, 0x3f, 0x90, 0x3d, 0x76, 0xd6, 0xce, 0xe6, 0xec, 0x15, 0xc8, 0x22, 0xa2, 0x9b, 0x19, 0xbf,
-	0xf7, 0xbe, 0xef, 0x7b, 0x9e, 0x79, 0x6f, 0x06, 0xb6, 0x42, 0x29, 0x26, 0xc8, 0x29, 0x77, 0xb1,
-	0x87, 0xe7, 0xee, 0x29, 0xe5, 0x23, 0xec, 0x4d, 0x76, 0x7a, 0x38, 0x41, 0xae, 0x55, 0x37, 0x94,
-	0x42, 0x0b, 0x6b, 0x7d, 0x6a, 0xd4, 0xcd, 0x8c, 0xba, 0x93, 0x9d, 0x77, 0x36, 0x5c, 0xa1, 0x02,
-	0xa1, 0x86, 0x89, 0x55, 0xcf, 0x4c, 0x8c, 0x8b, 0xfd, 0x35, 0x81, 0x37, 0x0e, 0xe2, 0x18, 0x9f,
-	0x48, 0x0f, 0xe5, 0x53, 0x89, 0x54, 0xa3, 0x67, 0x6d, 0xc0, 0x5d, 0x11, 0xcf, 0x87, 0xcc, 0x6b,
-	0x93, 0x4d, 0xb2, 0xbd, 0xe4, 0xdc, 0x49, 0xe6, 0x47, 0x9e, 0xf5, 0x10, 0xc0, 0x7c, 0xd2, 0x51,
-	0x88, 0xed, 0xc6, 0x26, 0xd9, 0x6e, 0x39, 0xad, 0x64, 0xe5, 0x38, 0x0a, 0xd1, 0x7a, 0x00, 0xad,
-	0x80, 0xca, 0x2f, 0x51, 0xc7, 0xae, 0x8b, 0x9b, 0x64, 0xfb, 0x9e, 0x73, 0xd7, 0x2c, 0x1c, 0x79,
-	0xd6, 0x7b, 0xb0, 0x82, 0xe7, 0x1a, 0x25, 0xa7, 0x7e, 0xfc, 0x79, 0x29, 0x71, 0x86, 0x6c, 0xe9,
-	0xc8, 0xb3, 0x7f, 0x20, 0xf0, 0x66, 0x8e, 0x4d, 0x2c, 0xc4, 0xf7, 0xcb, 0xf9, 0x7c, 0x04, 0xab,
-	0x6e, 0x66, 0x37, 0x3c, 0x89, 0x0c, 0xa3, 0x7e, 0xfb, 0xb7, 0x9f, 0x1e, 0xad, 0xa5, 0x42, 0xf7,
-	0x3c, 0x4f, 0xa2, 0x52, 0xcf, 0xb4, 0x64, 0x7c, 0xe4, 0xac, 0x5c, 0x59, 0xf7, 0xa3, 0x7f, 0xc9,
-	0xf6, 0x47, 0x02, 0xf7, 0xa7, 0x6c, 0x0f, 0x59, 0x15, 0xd5, 0x75, 0x68, 0x52, 0xa5, 0x50, 0xab,
-	0x34, 0x6d, 0xe9, 0xcc, 0x5a, 0x83, 0xe5, 0x50, 0x32, 0x17, 0x13, 0x06, 0x2d, 0xc7, 0x4c, 0x2c,
-	0x0b, 0x96, 0x3e, 0x47, 0x54, 0x29, 0x6e, 0x32, 0x2e, 0xf2, 0x5d, 0x2e, 0xe7, 0xdb, 0x9c, 0xe1,
-	0xfb, 0x33, 0x81, 0x8d, 0x29, 0xdf, 0x01, 0x95, 0x9a, 0x51, 0xdf, 0x8f, 0x6e, 0x3f, 0xf1, 0x09,
-	0x3c, 0x98, 0xf2, 0x3e, 0xc8, 0xd6, 0xf7, 0x9f, 0x87, 0x5e, 0xd5, 0x6e, 0x2d, 0xe0, 0x36, 0xca,
-	0x71, 0x17, 0x67, 0x70, 0x5f, 0x66, 0xdb, 0xf1, 0x70, 0xcc, 0x3d, 0xf5, 0x54, 0x04, 0x01, 0xd3,
-	0x31, 0xe0, 0xfb, 0x70, 0x87, 0xba, 0xae, 0x18, 0x73, 0x9d, 0xe0, 0x95, 0x6d, 0xb7, 0xcc, 0xb0,
-	0x9c, 0x49, 0x9c, 0xe0, 0x20, 0x89, 0xb7, 0x98, 0x26, 0x38, 0x99, 0x59, 0xf7, 0x61, 0x51, 0xd3,
-	0x51, 0x9a, 0xc9, 0x78, 0x68, 0x7f, 0x43, 0xe0, 0xed, 0x84, 0x92, 0x61, 0x13, 0x20, 0xd7, 0x0e,
-	0xfa, 0x48, 0xd5, 0x7f, 0x4b, 0xeb, 0x97, 0x2c, 0x53, 0x1f, 0x27, 0xbe, 0x9f, 0x32, 0x7d, 0xea,
-	0x49, 0x7a, 0x56, 0x0c, 0x4f, 0x5e, 0x1b, 0xbe, 0x51, 0x08, 0xff, 0x04, 0x56, 0x3c, 0x54, 0x9a,
-	0x71, 0xaa, 0x99, 0xe0, 0x06, 0xbb, 0xec, 0x44, 0xe7, 0x8c, 0xe3, 0x72, 0x70, 0x96, 0x82, 0xf3,
-	0xb8, 0x1c, 0x2c, 0x55, 0x39, 0x5f, 0x59, 0xf7, 0x23, 0xfb, 0x45, 0x7a, 0x3e, 0x8c, 0x88, 0x7d,
-	0xd4, 0x94, 0xf9, 0x2a, 0xdb, 0x65, 0xa5, 0x52, 0x76, 0x01, 0xc6, 0xc6, 0x6e, 0x9e, 0x1a, 0xd4,
-	0x4a, 0x6d, 0xfb, 0x91, 0xcd, 0xc1, 0xca, 0x41, 0x1e, 0x70, 0x7a, 0xe2, 0xd7, 0x85, 0xf5, 0xa4,
-	0xd1, 0x26, 0xb6, 0x28, 0xfc, 0xa7, 0x7d, 0xa6, 0xea, 0x06, 0x0c, 0xa1, 0x9d, 0x03, 0x4c, 0x4e,
-	0xb0, 0xaa, 0x55, 0xe6, 0xb5, 0xbf, 0x68, 0x10, 0xeb, 0x15, 0x6a, 0x6b, 0x78, 0x37, 0x07, 0xf9,
-	0x5c, 0xa1, 0x7c, 0x86, 0x5a, 0xfb, 0x58, 0xaf, 0xd0, 0x31, 0x3c, 0xbc, 0x11, 0xb5, 0x66, 0xb1,
-	0x45, 0xd8, 0x69, 0x1d, 0xaa, 0xf9, 0xb7, 0x4e, 0xa0, 0x73, 0x33, 0x6c, 0xcd, 0x72, 0xbf, 0x82,
-	0xad, 0x1c, 0xee, 0x11, 0xd7, 0x28, 0x03, 0xf4, 0x18, 0x95, 0xd1, 0x3e, 0x72, 0x11, 0xd4, 0x5b,
-	0x1e, 0x8a, 0xb9, 0x1e, 0xa0, 0x0c, 0x98, 0x52, 0x4c, 0xf0, 0x9a, 0xab, 0x52, 0xf1, 0x08, 0x39,
-	0xf8, 0x62, 0x4f, 0x6b, 0x59, 0x2f, 0xe4, 0x4e, 0xa1, 0x10, 0x66, 0x17, 0xd1, 0x32, 0x2c, 0xfb,
-	0x43, 0x58, 0xcf, 0xb9, 0x1c, 0x22, 0xce, 0x95, 0x15, 0x7b, 0x2d, 0x45, 0x1a, 0x50, 0x49, 0x83,
-	0xcc, 0xc5, 0xfe, 0x33, 0xeb, 0x60, 0x03, 0x1a, 0xc5, 0xdb, 0x2a, 0x63, 0xf0, 0x18, 0x9a, 0x4a,
-	0x8c, 0xa5, 0x8b, 0x95, 0x3d, 0x35, 0xb5, 0xb3, 0xb6, 0xe0, 0x9e, 0x19, 0x0d, 0x0b, 0xdd, 0x6d,
-	0xd5, 0x2c, 0xee, 0x99, 0x1e, 0xf7, 0x18, 0x9a, 0x9a, 0xca, 0x11, 0xea, 0xca, 0xf6, 0x96, 0xda,
-	0xc5, 0x61, 0xcd, 0x28, 0x0b, 0x6b, 0xda, 0xef, 0xaa, 0x59, 0x4c, 0xc3, 0x5e, 0xbb, 0xd2, 0x2c,
-	0xcf, 0x5c, 0x69, 0xbe, 0x6f, 0x14, 0x65, 0x66, 0x19, 0xab, 0x49, 0xe6, 0x2e, 0x80, 0xf0, 0xbd,
-	0xe1, 0x9c, 0x52, 0x5b, 0xc2, 0xf7, 0x8e, 0x8d, 0xda, 0x5d, 0x00, 0x8e, 0x67, 0x99, 0x63, 0x55,
-	0x17, 0x6f, 0x71, 0x3c, 0x3b, 0x7e, 0x4d, 0x9a, 0x96, 0xab, 0xd3, 0x34, 0x7b, 0xe3, 0xfc, 0x8b,
-	0xc0, 0x5a, 0x3e, 0x4d, 0x7b, 0xae, 0x8b, 0xe1, 0xff, 0x70, 0x3b, 0x7c, 0x7b, 0x4d, 0xa7, 0x83,
-	0x5f, 0xa0, 0xfb, 0xcf, 0x74, 0x4e, 0x25, 0x34, 0xe6, 0x94, 0x50, 0x79, 0xff, 0xfe, 0x8e, 0xc0,
-	0x5b, 0x85, 0x33, 0x79, 0xf5, 0x20, 0xbc, 0x0d, 0xf4, 0xfa, 0xf8, 0xeb, 0x45, 0x87, 0xbc, 0xba,
-	0xe8, 0x90, 0x3f, 0x2e, 0x3a, 0xe4, 0xe5, 0x65, 0x67, 0xe1, 0xd5, 0x65, 0x67, 0xe1, 0xf7, 0xcb,
-	0xce, 0x02, 0x6c, 0x30, 0xd1, 0xbd, 0xf9, 0x2d, 0x3e, 0x20, 0x9f, 0x75, 0x47, 0x4c, 0x9f, 0x8e,
-	0x4f, 0xba, 0xae, 0x08, 0x7a, 0x53, 0xa3, 0x47, 0x4c, 0xe4, 0x66, 0xbd, 0xf3, 0xab, 0x57, 0xfe,
-	0x49, 0x33, 0x79, 0xa9, 0x7f, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x79, 0x48, 0x20,
-	0x03, 0x10, 0x00, 0x00,
+func (m *EventQuoteAccepted) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventQuoteAccepted) GetDealer() string {
+	if m != nil {
+		return m.Dealer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventQuoteAccepted) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
 }
 
-func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventQuoteAccepted) GetAskOrderId() uint64 {
+	if m != nil {
+		return m.AskOrderId
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventOrderCancelled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventQuoteAccepted) GetBidOrderId() uint64 {
+	if m != nil {
+		return m.BidOrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketPriceBandUpdated is an event emitted when a market updates its price band configuration.
+type EventMarketPriceBandUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the price band configuration.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CancelledBy) > 0 {
-		i -= len(m.CancelledBy)
-		copy(dAtA[i:], m.CancelledBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventMarketPriceBandUpdated) Reset()         { *m = EventMarketPriceBandUpdated{} }
+func (m *EventMarketPriceBandUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketPriceBandUpdated) ProtoMessage()    {}
+func (*EventMarketPriceBandUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{30}
+}
+func (m *EventMarketPriceBandUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketPriceBandUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketPriceBandUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventMarketPriceBandUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketPriceBandUpdated.Merge(m, src)
+}
+func (m *EventMarketPriceBandUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketPriceBandUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketPriceBandUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketPriceBandUpdated proto.InternalMessageInfo
+
+func (m *EventMarketPriceBandUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketPriceBandUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketPriceBandTripped is an event emitted when a settlement is outside of a market's price band and the
+// market's settlements are paused because of it.
+type EventMarketPriceBandTripped struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// assets is the coin string of the assets in the settlement that was outside the price band.
+	Assets string `protobuf:"bytes,2,opt,name=assets,proto3" json:"assets,omitempty"`
+	// price is the coin string of the price of the settlement that was outside the price band.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// reference_assets is the coin string of the assets of the reference price that the settlement was compared against.
+	ReferenceAssets string `protobuf:"bytes,4,opt,name=reference_assets,json=referenceAssets,proto3" json:"reference_assets,omitempty"`
+	// reference_price is the coin string of the price of the reference price that the settlement was compared against.
+	ReferencePrice string `protobuf:"bytes,5,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
 }
 
-func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
+func (m *EventMarketPriceBandTripped) Reset()         { *m = EventMarketPriceBandTripped{} }
+func (m *EventMarketPriceBandTripped) String() string { return proto.CompactTextString(m) }
+func (*EventMarketPriceBandTripped) ProtoMessage()    {}
+func (*EventMarketPriceBandTripped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{31}
+}
+func (m *EventMarketPriceBandTripped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketPriceBandTripped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketPriceBandTripped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *EventMarketPriceBandTripped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketPriceBandTripped.Merge(m, src)
+}
+func (m *EventMarketPriceBandTripped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketPriceBandTripped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketPriceBandTripped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketPriceBandTripped proto.InternalMessageInfo
+
+func (m *EventMarketPriceBandTripped) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *EventMarketPriceBandTripped) GetAssets() string {
+	if m != nil {
+		return m.Assets
 	}
-	if len(m.Assets) > 0 {
-		i -= len(m.Assets)
-		copy(dAtA[i:], m.Assets)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventMarketPriceBandTripped) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventMarketPriceBandTripped) GetReferenceAssets() string {
+	if m != nil {
+		return m.ReferenceAssets
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketPriceBandTripped) GetReferencePrice() string {
+	if m != nil {
+		return m.ReferencePrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderPartiallyFilled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketSettlementsResumed is an event emitted when a market's paused settlements are resumed.
+type EventMarketSettlementsResumed struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that resumed the settlements.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Assets) > 0 {
-		i -= len(m.Assets)
-		copy(dAtA[i:], m.Assets)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventMarketSettlementsResumed) Reset()         { *m = EventMarketSettlementsResumed{} }
+func (m *EventMarketSettlementsResumed) String() string { return proto.CompactTextString(m) }
+func (*EventMarketSettlementsResumed) ProtoMessage()    {}
+func (*EventMarketSettlementsResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{32}
+}
+func (m *EventMarketSettlementsResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketSettlementsResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketSettlementsResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarketSettlementsResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketSettlementsResumed.Merge(m, src)
+}
+func (m *EventMarketSettlementsResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketSettlementsResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketSettlementsResumed.DiscardUnknown(m)
 }
 
-func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarketSettlementsResumed proto.InternalMessageInfo
+
+func (m *EventMarketSettlementsResumed) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventOrderExternalIDUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketSettlementsResumed) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
 }
 
-func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// EventMarketPermissionsUpdated is an event emitted when a market's permissions are updated.
+type EventMarketPermissionsUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the permissions.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermissionsUpdated{} }
+func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
+func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{33}
+}
+func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketPermissionsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketPermissionsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventFundsCommitted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketPermissionsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketPermissionsUpdated.Merge(m, src)
+}
+func (m *EventMarketPermissionsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketPermissionsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketPermissionsUpdated.DiscardUnknown(m)
 }
 
-func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EventMarketPermissionsUpdated proto.InternalMessageInfo
+
+func (m *EventMarketPermissionsUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketPermissionsUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventCommitmentReleased) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketReqAttrUpdated is an event emitted when a market's required attributes are updated.
+type EventMarketReqAttrUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the required attributes.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 }
 
-func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpdated{} }
+func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
+func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{34}
 }
-
-func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketReqAttrUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketReqAttrUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMarketReqAttrUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketReqAttrUpdated.Merge(m, src)
+}
+func (m *EventMarketReqAttrUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketReqAttrUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketReqAttrUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawnBy) > 0 {
-		i -= len(m.WithdrawnBy)
-		copy(dAtA[i:], m.WithdrawnBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawnBy)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventMarketReqAttrUpdated proto.InternalMessageInfo
+
+func (m *EventMarketReqAttrUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMarketReqAttrUpdated) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketDetailsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketCreated is an event emitted when a market has been created.
+type EventMarketCreated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *EventMarketDetailsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
+func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketCreated) ProtoMessage()    {}
+func (*EventMarketCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{35}
+}
+func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarketCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketCreated.Merge(m, src)
+}
+func (m *EventMarketCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketCreated.DiscardUnknown(m)
 }
 
-func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarketCreated proto.InternalMessageInfo
+
+func (m *EventMarketCreated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventMarketEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventMarketFeesUpdated is an event emitted when a market's fees have been updated.
+type EventMarketFeesUpdated struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *EventMarketEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{} }
+func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMarketFeesUpdated) ProtoMessage()    {}
+func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{36}
+}
+func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketFeesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketFeesUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventMarketFeesUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketFeesUpdated.Merge(m, src)
+}
+func (m *EventMarketFeesUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketFeesUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketFeesUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventMarketFeesUpdated proto.InternalMessageInfo
+
+func (m *EventMarketFeesUpdated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventMarketDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventParamsUpdated is an event emitted when the exchange module's params have been updated.
+type EventParamsUpdated struct {
 }
 
-func (m *EventMarketDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
+func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsUpdated) ProtoMessage()    {}
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{37}
 }
-
-func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+}
+func (m *EventParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketOrdersEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+
+// EventPaymentCreated is an event emitted when a payment is created.
+type EventPaymentCreated struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that the source will pay (to the target).
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// target is the account that can accept the Payment.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// target_amount is the coins amount string of the funds that the target will pay (to the source).
+	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketOrdersEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
+func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentCreated) ProtoMessage()    {}
+func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{38}
+}
+func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventPaymentCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentCreated.Merge(m, src)
+}
+func (m *EventPaymentCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentCreated.DiscardUnknown(m)
 }
 
-func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventPaymentCreated proto.InternalMessageInfo
+
+func (m *EventPaymentCreated) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketOrdersDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentCreated) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return ""
 }
 
-func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPaymentCreated) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentCreated) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EventMarketUserSettleEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPaymentCreated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// EventPaymentUpdated is an event emitted when a payment is updated.
+type EventPaymentUpdated struct {
+	// source is the account that updated (and previously created) the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that the source will pay (to the target).
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// old_target is the account that used to be able to accept the Payment (but not any more).
+	OldTarget string `protobuf:"bytes,3,opt,name=old_target,json=oldTarget,proto3" json:"old_target,omitempty"`
+	// new_target is the account that is now able to accept the Payment.
+	NewTarget string `protobuf:"bytes,4,opt,name=new_target,json=newTarget,proto3" json:"new_target,omitempty"`
+	// target_amount is the coins amount string of the funds that the target will pay (to the source).
+	TargetAmount string `protobuf:"bytes,5,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketUserSettleDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
+func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentUpdated) ProtoMessage()    {}
+func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{39}
 }
-
-func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketCommitmentsEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentUpdated.Merge(m, src)
 }
-
-func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventPaymentUpdated) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EventPaymentUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentUpdated.DiscardUnknown(m)
 }
 
-func (m *EventMarketCommitmentsDisabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_EventPaymentUpdated proto.InternalMessageInfo
 
-func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPaymentUpdated) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentUpdated) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentUpdated) GetOldTarget() string {
+	if m != nil {
+		return m.OldTarget
+	}
+	return ""
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPaymentUpdated) GetNewTarget() string {
+	if m != nil {
+		return m.NewTarget
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentUpdated) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketPermissionsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentUpdated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
 }
 
-func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// EventPaymentAccepted is an event emitted when a payment is accepted.
+type EventPaymentAccepted struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// source_amount is the coins amount string of the funds that the source will pay (to the target).
+	SourceAmount string `protobuf:"bytes,2,opt,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	// target is the account that accepted the Payment.
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// target_amount is the coins amount string of the funds that the target will pay (to the source).
+	TargetAmount string `protobuf:"bytes,4,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
+func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentAccepted) ProtoMessage()    {}
+func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{40}
+}
+func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentAccepted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventMarketReqAttrUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentAccepted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentAccepted.Merge(m, src)
+}
+func (m *EventPaymentAccepted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentAccepted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentAccepted.DiscardUnknown(m)
 }
 
-func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventPaymentAccepted proto.InternalMessageInfo
+
+func (m *EventPaymentAccepted) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentAccepted) GetSourceAmount() string {
+	if m != nil {
+		return m.SourceAmount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPaymentAccepted) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+func (m *EventPaymentAccepted) GetTargetAmount() string {
+	if m != nil {
+		return m.TargetAmount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentAccepted) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventMarketFeesUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPaymentRejected is an event emitted when a payment is rejected (by the target).
+type EventPaymentRejected struct {
+	// source is the account that created the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that rejected the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
+func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentRejected) ProtoMessage()    {}
+func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{41}
 }
-
-func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventPaymentRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentRejected.Merge(m, src)
+}
+func (m *EventPaymentRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentRejected.DiscardUnknown(m)
 }
 
-func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventPaymentRejected proto.InternalMessageInfo
+
+func (m *EventPaymentRejected) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EventPaymentRejected) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-func (m *EventPaymentCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPaymentRejected) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPaymentCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPaymentCancelled is an event emitted when a payment is cancelled (by the source).
+type EventPaymentCancelled struct {
+	// source is the account that cancelled (and created) the Payment.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// target is the account that could have accepted the Payment.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// external_id is used along with the source to uniquely identify this Payment.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *EventPaymentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
+func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentCancelled) ProtoMessage()    {}
+func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{42}
+}
+func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.TargetAmount) > 0 {
-		i -= len(m.TargetAmount)
-		copy(dAtA[i:], m.TargetAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventPaymentCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentCancelled.Merge(m, src)
+}
+func (m *EventPaymentCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentCancelled proto.InternalMessageInfo
+
+func (m *EventPaymentCancelled) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.SourceAmount) > 0 {
-		i -= len(m.SourceAmount)
-		copy(dAtA[i:], m.SourceAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventPaymentCancelled) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventPaymentCancelled) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventPaymentUpdated) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterType((*EventOrderCreated)(nil), "provenance.exchange.v1.EventOrderCreated")
+	proto.RegisterType((*EventOrderCancelled)(nil), "provenance.exchange.v1.EventOrderCancelled")
+	proto.RegisterType((*EventOrderFilled)(nil), "provenance.exchange.v1.EventOrderFilled")
+	proto.RegisterType((*EventOrderPartiallyFilled)(nil), "provenance.exchange.v1.EventOrderPartiallyFilled")
+	proto.RegisterType((*EventOrderExternalIDUpdated)(nil), "provenance.exchange.v1.EventOrderExternalIDUpdated")
+	proto.RegisterType((*EventFundsCommitted)(nil), "provenance.exchange.v1.EventFundsCommitted")
+	proto.RegisterType((*EventCommitmentReleased)(nil), "provenance.exchange.v1.EventCommitmentReleased")
+	proto.RegisterType((*EventMarketWithdraw)(nil), "provenance.exchange.v1.EventMarketWithdraw")
+	proto.RegisterType((*EventMarketDetailsUpdated)(nil), "provenance.exchange.v1.EventMarketDetailsUpdated")
+	proto.RegisterType((*EventMarketEnabled)(nil), "provenance.exchange.v1.EventMarketEnabled")
+	proto.RegisterType((*EventMarketDisabled)(nil), "provenance.exchange.v1.EventMarketDisabled")
+	proto.RegisterType((*EventMarketOrdersEnabled)(nil), "provenance.exchange.v1.EventMarketOrdersEnabled")
+	proto.RegisterType((*EventMarketOrdersDisabled)(nil), "provenance.exchange.v1.EventMarketOrdersDisabled")
+	proto.RegisterType((*EventMarketUserSettleEnabled)(nil), "provenance.exchange.v1.EventMarketUserSettleEnabled")
+	proto.RegisterType((*EventMarketUserSettleDisabled)(nil), "provenance.exchange.v1.EventMarketUserSettleDisabled")
+	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
+	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
+	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
+	proto.RegisterType((*EventMarketRebatesUpdated)(nil), "provenance.exchange.v1.EventMarketRebatesUpdated")
+	proto.RegisterType((*EventMarketRebatesPaid)(nil), "provenance.exchange.v1.EventMarketRebatesPaid")
+	proto.RegisterType((*EventMarketExternalSettlementUpdated)(nil), "provenance.exchange.v1.EventMarketExternalSettlementUpdated")
+	proto.RegisterType((*EventExternalSettlementStarted)(nil), "provenance.exchange.v1.EventExternalSettlementStarted")
+	proto.RegisterType((*EventExternalSettlementConfirmed)(nil), "provenance.exchange.v1.EventExternalSettlementConfirmed")
+	proto.RegisterType((*EventExternalSettlementExpired)(nil), "provenance.exchange.v1.EventExternalSettlementExpired")
+	proto.RegisterType((*EventRFQCreated)(nil), "provenance.exchange.v1.EventRFQCreated")
+	proto.RegisterType((*EventRFQCancelled)(nil), "provenance.exchange.v1.EventRFQCancelled")
+	proto.RegisterType((*EventRFQExpired)(nil), "provenance.exchange.v1.EventRFQExpired")
+	proto.RegisterType((*EventQuoteSubmitted)(nil), "provenance.exchange.v1.EventQuoteSubmitted")
+	proto.RegisterType((*EventQuoteWithdrawn)(nil), "provenance.exchange.v1.EventQuoteWithdrawn")
+	proto.RegisterType((*EventQuoteAccepted)(nil), "provenance.exchange.v1.EventQuoteAccepted")
+	proto.RegisterType((*EventMarketPriceBandUpdated)(nil), "provenance.exchange.v1.EventMarketPriceBandUpdated")
+	proto.RegisterType((*EventMarketPriceBandTripped)(nil), "provenance.exchange.v1.EventMarketPriceBandTripped")
+	proto.RegisterType((*EventMarketSettlementsResumed)(nil), "provenance.exchange.v1.EventMarketSettlementsResumed")
+	proto.RegisterType((*EventMarketPermissionsUpdated)(nil), "provenance.exchange.v1.EventMarketPermissionsUpdated")
+	proto.RegisterType((*EventMarketReqAttrUpdated)(nil), "provenance.exchange.v1.EventMarketReqAttrUpdated")
+	proto.RegisterType((*EventMarketCreated)(nil), "provenance.exchange.v1.EventMarketCreated")
+	proto.RegisterType((*EventMarketFeesUpdated)(nil), "provenance.exchange.v1.EventMarketFeesUpdated")
+	proto.RegisterType((*EventParamsUpdated)(nil), "provenance.exchange.v1.EventParamsUpdated")
+	proto.RegisterType((*EventPaymentCreated)(nil), "provenance.exchange.v1.EventPaymentCreated")
+	proto.RegisterType((*EventPaymentUpdated)(nil), "provenance.exchange.v1.EventPaymentUpdated")
+	proto.RegisterType((*EventPaymentAccepted)(nil), "provenance.exchange.v1.EventPaymentAccepted")
+	proto.RegisterType((*EventPaymentRejected)(nil), "provenance.exchange.v1.EventPaymentRejected")
+	proto.RegisterType((*EventPaymentCancelled)(nil), "provenance.exchange.v1.EventPaymentCancelled")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/events.proto", fileDescriptor_c1b69385a348cffa)
+}
+
+var fileDescriptor_c1b69385a348cffa = []byte{
+	// 1281 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0x26, 0xb1, 0x5b, 0xbf, 0xa4, 0x34, 0x59, 0xd2, 0xe2, 0x50, 0xea, 0x46, 0x5b, 0x24,
+	0x8a, 0xaa, 0x3a, 0x2d, 0x08, 0x55, 0x2a, 0xa7, 0xb8, 0x49, 0x20, 0x07, 0x54, 0x77, 0x93, 0x0a,
+	0xc4, 0xc5, 0x1a, 0xef, 0xbe, 0x38, 0x43, 0xf7, 0x5f, 0x66, 0xc6, 0x4e, 0xac, 0xf2, 0x0d, 0xb8,
+	0x14, 0x89, 0x1b, 0x1c, 0xb9, 0x71, 0x85, 0x1b, 0x37, 0x2e, 0x1c, 0x38, 0x54, 0x08, 0x24, 0x24,
+	0x2e, 0xa8, 0x81, 0xef, 0x81, 0x76, 0x66, 0xff, 0x3a, 0x89, 0x6d, 0xb9, 0x6c, 0x0a, 0xdc, 0x76,
+	0xde, 0xbe, 0x79, 0xef, 0xf7, 0x7b, 0xf3, 0xe6, 0xcd, 0x9b, 0x5d, 0xb8, 0x16, 0x30, 0xbf, 0x87,
+	0x1e, 0xf1, 0x2c, 0x5c, 0xc1, 0x03, 0x6b, 0x97, 0x78, 0x1d, 0x5c, 0xe9, 0xdd, 0x5e, 0xc1, 0x1e,
+	0x7a, 0x82, 0xd7, 0x03, 0xe6, 0x0b, 0x5f, 0xbf, 0x94, 0x2a, 0xd5, 0x63, 0xa5, 0x7a, 0xef, 0xf6,
+	0xab, 0x4b, 0x96, 0xcf, 0x5d, 0x9f, 0xb7, 0xa4, 0xd6, 0x8a, 0x1a, 0xa8, 0x29, 0xc6, 0x67, 0x1a,
+	0x2c, 0xac, 0x87, 0x36, 0xee, 0x33, 0x1b, 0xd9, 0x3d, 0x86, 0x44, 0xa0, 0xad, 0x2f, 0xc1, 0x39,
+	0x3f, 0x1c, 0xb7, 0xa8, 0x5d, 0xd5, 0x96, 0xb5, 0xeb, 0x33, 0xe6, 0x59, 0x39, 0xde, 0xb4, 0xf5,
+	0x2b, 0x00, 0xea, 0x95, 0xe8, 0x07, 0x58, 0x9d, 0x5a, 0xd6, 0xae, 0x57, 0xcc, 0x8a, 0x94, 0x6c,
+	0xf7, 0x03, 0xd4, 0x2f, 0x43, 0xc5, 0x25, 0xec, 0x11, 0x8a, 0x70, 0xea, 0xf4, 0xb2, 0x76, 0xfd,
+	0xbc, 0x79, 0x4e, 0x09, 0x36, 0x6d, 0xfd, 0x2a, 0xcc, 0xe2, 0x81, 0x40, 0xe6, 0x11, 0x27, 0x7c,
+	0x3d, 0x23, 0x27, 0x43, 0x2c, 0xda, 0xb4, 0x8d, 0x6f, 0x34, 0x78, 0x39, 0x83, 0x26, 0x24, 0xe2,
+	0x38, 0xc3, 0xf1, 0xbc, 0x0b, 0x73, 0x56, 0xac, 0xd7, 0x6a, 0xf7, 0x15, 0xa2, 0x46, 0xf5, 0xe7,
+	0x6f, 0x6f, 0x2e, 0x46, 0x44, 0x57, 0x6d, 0x9b, 0x21, 0xe7, 0x5b, 0x82, 0x51, 0xaf, 0x63, 0xce,
+	0x26, 0xda, 0x8d, 0xfe, 0x73, 0xa2, 0xfd, 0x49, 0x83, 0xf9, 0x14, 0xed, 0x06, 0x1d, 0x05, 0xf5,
+	0x12, 0x94, 0x09, 0xe7, 0x28, 0x78, 0x14, 0xb6, 0x68, 0xa4, 0x2f, 0x42, 0x29, 0x60, 0xd4, 0x42,
+	0x89, 0xa0, 0x62, 0xaa, 0x81, 0xae, 0xc3, 0xcc, 0x0e, 0x22, 0x8f, 0xfc, 0xca, 0xe7, 0x3c, 0xde,
+	0xd2, 0x70, 0xbc, 0xe5, 0x41, 0xbc, 0xe1, 0xd2, 0x31, 0xb4, 0x90, 0x06, 0x72, 0xfa, 0x59, 0x09,
+	0xae, 0x12, 0x49, 0x36, 0x6d, 0xe3, 0x57, 0x0d, 0x96, 0x52, 0x3a, 0x4d, 0xc2, 0x04, 0x25, 0x8e,
+	0xd3, 0xff, 0xcf, 0xf3, 0xea, 0xc1, 0xe5, 0x94, 0xd6, 0x7a, 0x3c, 0x6d, 0xed, 0x61, 0x60, 0x8f,
+	0xca, 0xf5, 0x1c, 0xac, 0xa9, 0xe1, 0xb0, 0xa6, 0x8f, 0xa4, 0xc7, 0x93, 0x38, 0x99, 0x37, 0xba,
+	0x9e, 0xcd, 0xef, 0xf9, 0xae, 0x4b, 0x45, 0xe8, 0xf0, 0x2d, 0x38, 0x4b, 0x2c, 0xcb, 0xef, 0x7a,
+	0xa2, 0xaa, 0x8d, 0x48, 0xd6, 0x58, 0x71, 0x38, 0x92, 0x30, 0xfe, 0xae, 0xb4, 0x37, 0x1d, 0xc5,
+	0x5f, 0x8e, 0xf4, 0x79, 0x98, 0x16, 0xa4, 0x13, 0x05, 0x3a, 0x7c, 0x34, 0xbe, 0xd0, 0xe0, 0x15,
+	0x09, 0x49, 0xa1, 0x71, 0xd1, 0x13, 0x26, 0x3a, 0x48, 0xf8, 0x8b, 0x85, 0xf5, 0x43, 0x1c, 0xa9,
+	0x0f, 0xe4, 0xdc, 0x0f, 0xa9, 0xd8, 0xb5, 0x19, 0xd9, 0xcf, 0x9b, 0xd7, 0x4e, 0x34, 0x3f, 0x95,
+	0x33, 0x7f, 0x17, 0x66, 0x6d, 0xe4, 0x82, 0x7a, 0x44, 0x50, 0xdf, 0xab, 0x4e, 0x8f, 0xe0, 0x92,
+	0x55, 0x0e, 0x8b, 0xc9, 0x7e, 0xe4, 0xdc, 0x0b, 0x8b, 0xc9, 0xcc, 0xa8, 0xc9, 0x89, 0x76, 0xa3,
+	0x6f, 0xec, 0xc1, 0x52, 0x86, 0xc4, 0x1a, 0x0a, 0x42, 0x1d, 0x1e, 0x67, 0xd9, 0x50, 0x2a, 0x77,
+	0x00, 0xba, 0x4a, 0x6f, 0x9c, 0x0a, 0x56, 0x89, 0x74, 0x1b, 0x7d, 0xc3, 0x03, 0x3d, 0xe3, 0x72,
+	0xdd, 0x23, 0x6d, 0xa7, 0x28, 0x5f, 0x77, 0xa7, 0xaa, 0x9a, 0xe1, 0xe7, 0xd6, 0x69, 0x8d, 0xf2,
+	0xa2, 0x1d, 0x06, 0x50, 0xcd, 0x38, 0x94, 0x3b, 0x98, 0x17, 0x4a, 0x73, 0x60, 0x15, 0x95, 0xc7,
+	0x62, 0x89, 0x1a, 0x02, 0x5e, 0xcb, 0xb8, 0x7c, 0xc8, 0x91, 0x6d, 0xa1, 0x10, 0x0e, 0x16, 0x4b,
+	0xb4, 0x0b, 0x57, 0x8e, 0xf5, 0x5a, 0x30, 0xd9, 0xbc, 0xdb, 0xb4, 0x0e, 0x15, 0xbc, 0xac, 0x3d,
+	0xa8, 0x1d, 0xef, 0xb6, 0x60, 0xba, 0x8f, 0xe1, 0x5a, 0xc6, 0xef, 0xa6, 0x27, 0x90, 0xb9, 0x68,
+	0x53, 0xc2, 0xfa, 0x6b, 0xe8, 0xf9, 0x6e, 0xb1, 0xe5, 0x21, 0x9f, 0xcb, 0x26, 0xb6, 0x89, 0xc0,
+	0x82, 0x2b, 0x92, 0x0b, 0x97, 0x8e, 0xba, 0x6c, 0x12, 0x6a, 0x4f, 0x56, 0xcc, 0x6b, 0xf2, 0x68,
+	0xa7, 0x01, 0x0d, 0x97, 0x2a, 0xea, 0xd0, 0x32, 0x12, 0xe3, 0x53, 0x78, 0x3d, 0x5b, 0x00, 0xa3,
+	0xc3, 0x57, 0x25, 0x72, 0xb8, 0xbc, 0xc5, 0x92, 0xfd, 0x4e, 0x8b, 0xb2, 0xea, 0xa8, 0xe3, 0x2d,
+	0x41, 0xd8, 0xf3, 0x74, 0x17, 0x75, 0x28, 0xb5, 0xbb, 0x7d, 0x64, 0x23, 0xcf, 0x2f, 0xa5, 0xa6,
+	0xdf, 0x80, 0x05, 0x3c, 0x08, 0x28, 0x93, 0xe7, 0x58, 0x6b, 0x17, 0x69, 0x67, 0x57, 0xc8, 0xe3,
+	0x6b, 0xda, 0x9c, 0x4f, 0x5f, 0xbc, 0x2f, 0xe5, 0xc6, 0xa1, 0x06, 0xcb, 0x27, 0xe0, 0xbe, 0xe7,
+	0x7b, 0x3b, 0x94, 0xb9, 0xcf, 0x81, 0xfc, 0x06, 0x2c, 0x04, 0xa4, 0x1f, 0xda, 0x6a, 0x31, 0xdc,
+	0x41, 0x86, 0x5e, 0xd2, 0x01, 0xce, 0x47, 0x2f, 0xcc, 0x58, 0x2e, 0xbb, 0xf7, 0xd8, 0xe3, 0x58,
+	0x07, 0x6e, 0xa2, 0xdd, 0xe8, 0x0f, 0xf4, 0x7d, 0xa5, 0xc1, 0xbe, 0xef, 0xa3, 0x13, 0x17, 0x67,
+	0x3d, 0x0c, 0xc8, 0xe4, 0x14, 0x8d, 0xdf, 0x35, 0xb8, 0x20, 0x4d, 0x9b, 0x1b, 0x0f, 0xe2, 0x2b,
+	0xd3, 0x45, 0x28, 0xb3, 0x9d, 0xbd, 0xd4, 0x52, 0x89, 0xed, 0xec, 0xfd, 0xd3, 0x8b, 0x9c, 0x36,
+	0xda, 0x33, 0xb9, 0x46, 0xfb, 0x2a, 0xcc, 0xca, 0xde, 0xba, 0x65, 0x87, 0x35, 0x45, 0x46, 0xa2,
+	0x62, 0x82, 0x14, 0xc9, 0x2a, 0x73, 0x7c, 0x76, 0x94, 0x4f, 0xc8, 0x8e, 0xf7, 0x60, 0x21, 0x21,
+	0x97, 0xdc, 0xc0, 0x26, 0xa0, 0x67, 0xac, 0xa7, 0x51, 0x8a, 0x23, 0x3e, 0x89, 0x99, 0xcf, 0xe3,
+	0xee, 0xf0, 0x41, 0xd7, 0x17, 0xb8, 0xd5, 0x6d, 0x47, 0x7d, 0xf4, 0x24, 0x11, 0xbf, 0x05, 0x65,
+	0x1b, 0x89, 0x33, 0x46, 0xc8, 0x23, 0xbd, 0xf4, 0x12, 0x33, 0x93, 0xb9, 0xc4, 0x18, 0x8f, 0xb3,
+	0x90, 0xe2, 0x7e, 0xd5, 0x3b, 0x1d, 0x48, 0xc6, 0x2f, 0x1a, 0xe8, 0xa9, 0xf7, 0x55, 0xcb, 0xc2,
+	0xe0, 0x05, 0xc7, 0x43, 0x5f, 0x86, 0x39, 0xc2, 0x1f, 0xb5, 0x92, 0xdd, 0xa4, 0x36, 0x23, 0x10,
+	0xfe, 0xe8, 0x7e, 0xb4, 0xa1, 0x96, 0x61, 0xae, 0x4d, 0xed, 0x54, 0xa3, 0xac, 0x34, 0xda, 0xd4,
+	0x8e, 0x34, 0x0c, 0x0e, 0x97, 0x33, 0xb5, 0xbc, 0x19, 0xda, 0x6d, 0x10, 0xcf, 0x2e, 0xb6, 0x84,
+	0x7f, 0xaf, 0x1d, 0xef, 0x75, 0x9b, 0xd1, 0x20, 0xc0, 0x31, 0x4e, 0xad, 0xf1, 0x2f, 0xbe, 0x6f,
+	0xc2, 0x7c, 0x52, 0x10, 0x5b, 0xb9, 0x7d, 0x7c, 0x21, 0x91, 0xaf, 0x2a, 0x03, 0x6f, 0x40, 0x2a,
+	0x6a, 0x29, 0x53, 0x6a, 0x53, 0xbf, 0x94, 0x88, 0x25, 0xd2, 0x81, 0x6e, 0x2a, 0xad, 0x70, 0xdc,
+	0x44, 0xde, 0x75, 0x4f, 0xa9, 0x89, 0x6b, 0x22, 0x73, 0x29, 0xe7, 0xd4, 0xf7, 0xf8, 0xe9, 0xf6,
+	0x33, 0x7b, 0xab, 0x42, 0xb0, 0x62, 0x5d, 0xde, 0xce, 0xdd, 0xb0, 0xe2, 0x62, 0x3f, 0xcc, 0x97,
+	0xf1, 0x4e, 0xae, 0x05, 0xda, 0xc0, 0xf1, 0x5a, 0x2e, 0x63, 0x31, 0xf2, 0xd4, 0x24, 0x8c, 0xb8,
+	0xf1, 0x14, 0xe3, 0xcf, 0xb8, 0xf8, 0x35, 0xd5, 0xd1, 0x19, 0x23, 0xb8, 0x05, 0x65, 0xee, 0x77,
+	0x99, 0x85, 0x23, 0x2f, 0xeb, 0x91, 0x9e, 0x7e, 0x0d, 0xce, 0xab, 0xa7, 0x56, 0xae, 0xd3, 0x9a,
+	0x53, 0xc2, 0x55, 0x29, 0x0b, 0xcd, 0x0a, 0xc2, 0x3a, 0x28, 0x46, 0xd7, 0x03, 0xa5, 0x17, 0x9a,
+	0x55, 0x4f, 0xb1, 0x59, 0x95, 0xd2, 0x73, 0x4a, 0x18, 0x99, 0x1d, 0xf8, 0x56, 0x52, 0x3a, 0xf2,
+	0xad, 0xe4, 0xeb, 0xa9, 0x3c, 0xcd, 0x38, 0x62, 0x05, 0xd1, 0xbc, 0x03, 0xe0, 0x3b, 0x76, 0x6b,
+	0x4c, 0xaa, 0x15, 0xdf, 0xb1, 0xb7, 0x15, 0xdb, 0x3b, 0x00, 0x1e, 0xee, 0xc7, 0x13, 0x47, 0x75,
+	0x2b, 0x15, 0x0f, 0xf7, 0xb7, 0x4f, 0x08, 0x53, 0x69, 0x74, 0x98, 0x8e, 0x7c, 0xe9, 0x32, 0xfe,
+	0xd2, 0x60, 0x31, 0x1b, 0xa6, 0xa4, 0xf6, 0xff, 0xcf, 0xd2, 0xe1, 0xcb, 0x01, 0x9e, 0x26, 0x7e,
+	0x82, 0xd6, 0x64, 0x3c, 0x53, 0x0a, 0x53, 0x63, 0x52, 0x18, 0xf9, 0x61, 0xef, 0x2b, 0x0d, 0x2e,
+	0xe6, 0xf6, 0x64, 0xd2, 0x25, 0xfd, 0x1b, 0xe0, 0x35, 0xf0, 0xc7, 0x67, 0x35, 0xed, 0xe9, 0xb3,
+	0x9a, 0xf6, 0xc7, 0xb3, 0x9a, 0xf6, 0xe4, 0xb0, 0x76, 0xe6, 0xe9, 0x61, 0xed, 0xcc, 0x6f, 0x87,
+	0xb5, 0x33, 0xb0, 0x44, 0xfd, 0xfa, 0xf1, 0xbf, 0x08, 0x9a, 0xda, 0xc7, 0xf5, 0x0e, 0x15, 0xbb,
+	0xdd, 0x76, 0xdd, 0xf2, 0xdd, 0x95, 0x54, 0xe9, 0x26, 0xf5, 0x33, 0xa3, 0x95, 0x83, 0xe4, 0xe7,
+	0x43, 0xbb, 0x2c, 0x7f, 0x20, 0xbc, 0xfd, 0xf7, 0x00, 0x54, 0xfc, 0x38, 0x44, 0x9a, 0x18, 0x00,
+	0x00,
+}
+
+func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2881,12 +2986,12 @@ func (m *EventPaymentUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPaymentUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPaymentUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2896,47 +3001,29 @@ func (m *EventPaymentUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TargetAmount) > 0 {
-		i -= len(m.TargetAmount)
-		copy(dAtA[i:], m.TargetAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.NewTarget) > 0 {
-		i -= len(m.NewTarget)
-		copy(dAtA[i:], m.NewTarget)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTarget)))
-		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.OldTarget) > 0 {
-		i -= len(m.OldTarget)
-		copy(dAtA[i:], m.OldTarget)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldTarget)))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.SourceAmount) > 0 {
-		i -= len(m.SourceAmount)
-		copy(dAtA[i:], m.SourceAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentAccepted) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderCancelled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2946,12 +3033,12 @@ func (m *EventPaymentAccepted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPaymentAccepted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPaymentAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2961,40 +3048,29 @@ func (m *EventPaymentAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TargetAmount) > 0 {
-		i -= len(m.TargetAmount)
-		copy(dAtA[i:], m.TargetAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
-		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.SourceAmount) > 0 {
-		i -= len(m.SourceAmount)
-		copy(dAtA[i:], m.SourceAmount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+	if len(m.CancelledBy) > 0 {
+		i -= len(m.CancelledBy)
+		copy(dAtA[i:], m.CancelledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CancelledBy)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentRejected) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3004,41 +3080,63 @@ func (m *EventPaymentRejected) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPaymentRejected) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPaymentRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReceiptId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentCancelled) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3048,615 +3146,4331 @@ func (m *EventPaymentCancelled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPaymentCancelled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderPartiallyFilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPaymentCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReceiptId))
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x28
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		dAtA[i] = 0x22
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderCancelled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderExternalIDUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.CancelledBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFilled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundsCommitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.Assets)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Fees)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderPartiallyFilled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitmentReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
-	}
-	l = len(m.Assets)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Fees)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderExternalIDUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovEvents(uint64(m.OrderId))
+	if len(m.WithdrawnBy) > 0 {
+		i -= len(m.WithdrawnBy)
+		copy(dAtA[i:], m.WithdrawnBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawnBy)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventFundsCommitted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketDetailsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketDetailsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventCommitmentReleased) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.WithdrawnBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMarketDetailsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMarketDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketOrdersEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketOrdersEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketDisabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketOrdersDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketOrdersEnabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketUserSettleEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketOrdersDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMarketUserSettleEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventMarketUserSettleDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketUserSettleDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketCommitmentsEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMarketCommitmentsDisabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *EventMarketCommitmentsEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketPermissionsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
-	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMarketReqAttrUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMarketCommitmentsDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UpdatedBy)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketFeesUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketRebatesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketRebatesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketRebatesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MarketId != 0 {
-		n += 1 + sovEvents(uint64(m.MarketId))
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketRebatesPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventPaymentCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMarketRebatesPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketRebatesPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.SourceAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Recipients != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Recipients))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TargetAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMarketExternalSettlementUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketExternalSettlementUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketExternalSettlementUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.SourceAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.OldTarget)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventExternalSettlementStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NewTarget)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventExternalSettlementStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExternalSettlementStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.TargetAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentAccepted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventExternalSettlementConfirmed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExternalSettlementConfirmed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExternalSettlementConfirmed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ReceiptId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReceiptId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.SourceAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ConfirmedBy) > 0 {
+		i -= len(m.ConfirmedBy)
+		copy(dAtA[i:], m.ConfirmedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ConfirmedBy)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PaymentReference) > 0 {
+		i -= len(m.PaymentReference)
+		copy(dAtA[i:], m.PaymentReference)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PaymentReference)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.TargetAmount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentRejected) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventExternalSettlementExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExternalSettlementExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExternalSettlementExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPaymentCancelled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRFQCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRFQCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRFQCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRFQCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRFQCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRFQCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRFQExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRFQExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRFQExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQuoteSubmitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQuoteSubmitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQuoteSubmitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQuoteWithdrawn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQuoteWithdrawn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQuoteWithdrawn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQuoteAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQuoteAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQuoteAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BidOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BidOrderId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AskOrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AskOrderId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPriceBandUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPriceBandUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPriceBandUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPriceBandTripped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPriceBandTripped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPriceBandTripped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferencePrice) > 0 {
+		i -= len(m.ReferencePrice)
+		copy(dAtA[i:], m.ReferencePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReferencePrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReferenceAssets) > 0 {
+		i -= len(m.ReferenceAssets)
+		copy(dAtA[i:], m.ReferenceAssets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReferenceAssets)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketSettlementsResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketSettlementsResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketSettlementsResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPermissionsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketReqAttrUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketFeesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewTarget) > 0 {
+		i -= len(m.NewTarget)
+		copy(dAtA[i:], m.NewTarget)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTarget)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldTarget) > 0 {
+		i -= len(m.OldTarget)
+		copy(dAtA[i:], m.OldTarget)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OldTarget)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentAccepted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentAccepted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAmount) > 0 {
+		i -= len(m.TargetAmount)
+		copy(dAtA[i:], m.TargetAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TargetAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAmount) > 0 {
+		i -= len(m.SourceAmount)
+		copy(dAtA[i:], m.SourceAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.CancelledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReceiptId != 0 {
+		n += 1 + sovEvents(uint64(m.ReceiptId))
+	}
+	return n
+}
+
+func (m *EventOrderPartiallyFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReceiptId != 0 {
+		n += 1 + sovEvents(uint64(m.ReceiptId))
+	}
+	return n
+}
+
+func (m *EventOrderExternalIDUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundsCommitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitmentReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WithdrawnBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketDetailsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketOrdersEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketOrdersDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketUserSettleEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketUserSettleDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketCommitmentsEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketCommitmentsDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketRebatesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketRebatesPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Recipients != 0 {
+		n += 1 + sovEvents(uint64(m.Recipients))
+	}
+	return n
+}
+
+func (m *EventMarketExternalSettlementUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventExternalSettlementStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *EventExternalSettlementConfirmed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.PaymentReference)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ConfirmedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ReceiptId != 0 {
+		n += 1 + sovEvents(uint64(m.ReceiptId))
+	}
+	return n
+}
+
+func (m *EventExternalSettlementExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventRFQCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovEvents(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *EventRFQCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovEvents(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventRFQExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovEvents(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventQuoteSubmitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovEvents(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventQuoteWithdrawn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovEvents(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventQuoteAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovEvents(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.AskOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.AskOrderId))
+	}
+	if m.BidOrderId != 0 {
+		n += 1 + sovEvents(uint64(m.BidOrderId))
+	}
+	return n
+}
+
+func (m *EventMarketPriceBandUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketPriceBandTripped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReferenceAssets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReferencePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketSettlementsResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketPermissionsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketReqAttrUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventMarketFeesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventPaymentCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OldTarget)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewTarget)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentAccepted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SourceAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TargetAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPaymentCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptId", wireType)
+			}
+			m.ReceiptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderPartiallyFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderPartiallyFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptId", wireType)
+			}
+			m.ReceiptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExternalIDUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExternalIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundsCommitted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundsCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitmentReleased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitmentReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketDetailsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketDetailsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketOrdersEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketOrdersEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3679,17 +7493,17 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketOrdersDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketOrdersDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3699,14 +7513,14 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3734,9 +7548,59 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketUserSettleEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketUserSettleEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -3755,9 +7619,9 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3785,7 +7649,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3808,7 +7672,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3831,17 +7695,17 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketUserSettleDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketUserSettleDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3851,14 +7715,14 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3886,9 +7750,59 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -3907,9 +7821,9 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3937,7 +7851,7 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3960,7 +7874,7 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,17 +7897,17 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4003,16 +7917,98 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4022,27 +8018,14 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Assets = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4070,41 +8053,59 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketRebatesUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketRebatesUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketRebatesUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -4123,9 +8124,9 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4153,7 +8154,7 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4176,7 +8177,7 @@ func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketRebatesPaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4199,17 +8200,17 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderPartiallyFilled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketRebatesPaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderPartiallyFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketRebatesPaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.OrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4219,14 +8220,14 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4254,13 +8255,13 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			m.Recipients = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4270,57 +8271,62 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Recipients |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketExternalSettlementUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketExternalSettlementUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketExternalSettlementUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -4339,9 +8345,9 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4369,7 +8375,7 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4392,7 +8398,7 @@ func (m *EventOrderPartiallyFilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventExternalSettlementStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,10 +8421,10 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderExternalIDUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExternalSettlementStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderExternalIDUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExternalSettlementStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4461,7 +8467,7 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4489,8 +8495,27 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4512,7 +8537,7 @@ func (m *EventOrderExternalIDUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
+func (m *EventExternalSettlementConfirmed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4535,17 +8560,17 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundsCommitted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExternalSettlementConfirmed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundsCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExternalSettlementConfirmed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4555,24 +8580,11 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
@@ -4594,7 +8606,7 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentReference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4622,11 +8634,11 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.PaymentReference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4654,8 +8666,27 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.ConfirmedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptId", wireType)
+			}
+			m.ReceiptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4677,7 +8708,7 @@ func (m *EventFundsCommitted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
+func (m *EventExternalSettlementExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4700,17 +8731,17 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCommitmentReleased: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventExternalSettlementExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCommitmentReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventExternalSettlementExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4720,24 +8751,11 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
@@ -4757,70 +8775,6 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4842,7 +8796,7 @@ func (m *EventCommitmentReleased) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventRFQCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4865,13 +8819,32 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRFQCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRFQCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -4890,9 +8863,9 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4920,11 +8893,11 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4952,11 +8925,11 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			m.Assets = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4984,8 +8957,27 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnBy = string(dAtA[iNdEx:postIndex])
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5007,7 +8999,7 @@ func (m *EventMarketWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRFQCancelled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5030,17 +9022,17 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketDetailsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRFQCancelled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketDetailsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRFQCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
 			}
-			m.MarketId = 0
+			m.RfqId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5050,16 +9042,16 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.RfqId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5069,24 +9061,11 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5108,7 +9087,7 @@ func (m *EventMarketDetailsUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventRFQExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5131,17 +9110,17 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRFQExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRFQExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
 			}
-			m.MarketId = 0
+			m.RfqId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5151,16 +9130,16 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.RfqId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5170,24 +9149,11 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5209,7 +9175,7 @@ func (m *EventMarketEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventQuoteSubmitted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5232,13 +9198,32 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQuoteSubmitted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQuoteSubmitted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -5257,9 +9242,41 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5287,7 +9304,7 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5310,7 +9327,7 @@ func (m *EventMarketDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventQuoteWithdrawn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5333,13 +9350,32 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketOrdersEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQuoteWithdrawn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketOrdersEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQuoteWithdrawn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -5358,9 +9394,9 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5388,7 +9424,7 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Dealer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5411,7 +9447,7 @@ func (m *EventMarketOrdersEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventQuoteAccepted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5434,13 +9470,32 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketOrdersDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQuoteAccepted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketOrdersDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQuoteAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
@@ -5459,9 +9514,9 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5489,63 +9544,45 @@ func (m *EventMarketOrdersDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Dealer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketUserSettleEnabled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketUserSettleEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderId", wireType)
 			}
-			m.MarketId = 0
+			m.AskOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5555,16 +9592,16 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				m.AskOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderId", wireType)
 			}
-			var stringLen uint64
+			m.BidOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5574,24 +9611,11 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BidOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -5613,7 +9637,7 @@ func (m *EventMarketUserSettleEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketPriceBandUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5636,10 +9660,10 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketUserSettleDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketPriceBandUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketUserSettleDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketPriceBandUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5714,7 +9738,7 @@ func (m *EventMarketUserSettleDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventMarketPriceBandTripped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5737,10 +9761,10 @@ func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketPriceBandTripped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketCommitmentsEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketPriceBandTripped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5764,7 +9788,7 @@ func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5792,63 +9816,45 @@ func (m *EventMarketCommitmentsEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.Assets = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketCommitmentsDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceAssets", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5858,14 +9864,27 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceAssets = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5893,7 +9912,7 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			m.ReferencePrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5916,7 +9935,7 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventMarketSettlementsResumed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5939,10 +9958,10 @@ func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMarketSettlementsResumed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarketIntermediaryDenomUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMarketSettlementsResumed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
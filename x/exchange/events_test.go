@@ -153,9 +153,10 @@ func TestNewEventOrderFilled(t *testing.T) {
 	}
 
 	tests := []struct {
-		name     string
-		order    OrderI
-		expected *EventOrderFilled
+		name      string
+		order     OrderI
+		receiptID uint64
+		expected  *EventOrderFilled
 	}{
 		{
 			name: "ask",
@@ -166,6 +167,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "one",
 			}),
+			receiptID: 1,
 			expected: &EventOrderFilled{
 				OrderId:    4,
 				Assets:     "22apple",
@@ -173,6 +175,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				Fees:       "57fig",
 				MarketId:   57,
 				ExternalId: "one",
+				ReceiptId:  1,
 			},
 		},
 		{
@@ -184,6 +187,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "two",
 			}), sdk.NewInt64Coin("plum", 88), sdk.NewCoins(sdk.NewInt64Coin("fig", 61), sdk.NewInt64Coin("grape", 12))),
+			receiptID: 2,
 			expected: &EventOrderFilled{
 				OrderId:    4,
 				Assets:     "22apple",
@@ -191,6 +195,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				Fees:       "61fig,12grape",
 				MarketId:   1234,
 				ExternalId: "two",
+				ReceiptId:  2,
 			},
 		},
 		{
@@ -202,6 +207,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 58)),
 				ExternalId:          "three",
 			}),
+			receiptID: 3,
 			expected: &EventOrderFilled{
 				OrderId:    104,
 				Assets:     "23apple",
@@ -209,6 +215,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				Fees:       "58fig",
 				MarketId:   87878,
 				ExternalId: "three",
+				ReceiptId:  3,
 			},
 		},
 		{
@@ -220,6 +227,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 59)),
 				ExternalId:          "four",
 			}), sdk.NewInt64Coin("plum", 89), sdk.NewCoins(sdk.NewInt64Coin("fig", 62), sdk.NewInt64Coin("grape", 13))),
+			receiptID: 4,
 			expected: &EventOrderFilled{
 				OrderId:    105,
 				Assets:     "24apple",
@@ -227,6 +235,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 				Fees:       "62fig,13grape",
 				MarketId:   9119,
 				ExternalId: "four",
+				ReceiptId:  4,
 			},
 		},
 	}
@@ -235,7 +244,7 @@ func TestNewEventOrderFilled(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var event *EventOrderFilled
 			testFunc := func() {
-				event = NewEventOrderFilled(tc.order)
+				event = NewEventOrderFilled(tc.order, tc.receiptID)
 			}
 			require.NotPanics(t, testFunc, "NewEventOrderFilled")
 			assert.Equal(t, tc.expected, event, "NewEventOrderFilled result")
@@ -251,9 +260,10 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 	}
 
 	tests := []struct {
-		name     string
-		order    OrderI
-		expected *EventOrderPartiallyFilled
+		name      string
+		order     OrderI
+		receiptID uint64
+		expected  *EventOrderPartiallyFilled
 	}{
 		{
 			name: "ask",
@@ -264,6 +274,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "five",
 			}),
+			receiptID: 1,
 			expected: &EventOrderPartiallyFilled{
 				OrderId:    4,
 				Assets:     "22apple",
@@ -271,6 +282,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				Fees:       "57fig",
 				MarketId:   432,
 				ExternalId: "five",
+				ReceiptId:  1,
 			},
 		},
 		{
@@ -282,6 +294,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				SellerSettlementFlatFee: coinP("fig", 57),
 				ExternalId:              "six",
 			}), sdk.NewInt64Coin("plum", 88), sdk.NewCoins(sdk.NewInt64Coin("fig", 61), sdk.NewInt64Coin("grape", 12))),
+			receiptID: 2,
 			expected: &EventOrderPartiallyFilled{
 				OrderId:    4,
 				Assets:     "22apple",
@@ -289,6 +302,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				Fees:       "61fig,12grape",
 				MarketId:   456,
 				ExternalId: "six",
+				ReceiptId:  2,
 			},
 		},
 		{
@@ -300,6 +314,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 58)),
 				ExternalId:          "seven",
 			}),
+			receiptID: 3,
 			expected: &EventOrderPartiallyFilled{
 				OrderId:    104,
 				Assets:     "23apple",
@@ -307,6 +322,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				Fees:       "58fig",
 				MarketId:   765,
 				ExternalId: "seven",
+				ReceiptId:  3,
 			},
 		},
 		{
@@ -318,6 +334,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 58)),
 				ExternalId:          "eight",
 			}), sdk.NewInt64Coin("plum", 89), sdk.NewCoins(sdk.NewInt64Coin("fig", 62), sdk.NewInt64Coin("grape", 13))),
+			receiptID: 4,
 			expected: &EventOrderPartiallyFilled{
 				OrderId:    104,
 				Assets:     "23apple",
@@ -325,6 +342,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 				Fees:       "62fig,13grape",
 				MarketId:   818,
 				ExternalId: "eight",
+				ReceiptId:  4,
 			},
 		},
 	}
@@ -333,7 +351,7 @@ func TestNewEventOrderPartiallyFilled(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var event *EventOrderPartiallyFilled
 			testFunc := func() {
-				event = NewEventOrderPartiallyFilled(tc.order)
+				event = NewEventOrderPartiallyFilled(tc.order, tc.receiptID)
 			}
 			require.NotPanics(t, testFunc, "NewEventOrderPartiallyFilled")
 			assert.Equal(t, tc.expected, event, "NewEventOrderPartiallyFilled result")
@@ -668,6 +686,249 @@ func TestNewEventMarketIntermediaryDenomUpdated(t *testing.T) {
 	assertEverythingSet(t, event, "EventMarketIntermediaryDenomUpdated")
 }
 
+func TestNewEventMarketRebatesUpdated(t *testing.T) {
+	marketID := uint32(3434)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketRebatesUpdated
+	testFunc := func() {
+		event = NewEventMarketRebatesUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketRebatesUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketRebatesUpdated")
+}
+
+func TestNewEventMarketRebatesPaid(t *testing.T) {
+	marketID := uint32(99)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 12), sdk.NewInt64Coin("banana", 3))
+	recipients := 7
+
+	var event *EventMarketRebatesPaid
+	testFunc := func() {
+		event = NewEventMarketRebatesPaid(marketID, amount, recipients)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketRebatesPaid(%d, %q, %d)", marketID, amount, recipients)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, amount.String(), event.Amount, "Amount")
+	assert.Equal(t, uint32(recipients), event.Recipients, "Recipients")
+	assertEverythingSet(t, event, "EventMarketRebatesPaid")
+}
+
+func TestNewEventMarketExternalSettlementUpdated(t *testing.T) {
+	marketID := uint32(4545)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketExternalSettlementUpdated
+	testFunc := func() {
+		event = NewEventMarketExternalSettlementUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketExternalSettlementUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketExternalSettlementUpdated")
+}
+
+func TestNewEventExternalSettlementStarted(t *testing.T) {
+	settlement := &ExternalSettlement{
+		OrderId:          17,
+		AskOrder:         AskOrder{MarketId: 4},
+		Buyer:            sdk.AccAddress("buyer_______________").String(),
+		ExpirationHeight: 555,
+	}
+
+	var event *EventExternalSettlementStarted
+	testFunc := func() {
+		event = NewEventExternalSettlementStarted(settlement)
+	}
+	require.NotPanics(t, testFunc, "NewEventExternalSettlementStarted")
+	assert.Equal(t, settlement.OrderId, event.OrderId, "OrderId")
+	assert.Equal(t, settlement.AskOrder.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, settlement.Buyer, event.Buyer, "Buyer")
+	assert.Equal(t, settlement.ExpirationHeight, event.ExpirationHeight, "ExpirationHeight")
+	assertEverythingSet(t, event, "EventExternalSettlementStarted")
+}
+
+func TestNewEventExternalSettlementConfirmed(t *testing.T) {
+	settlement := &ExternalSettlement{OrderId: 18, AskOrder: AskOrder{MarketId: 5}}
+	paymentRef := "wire-0042"
+	confirmedBy := sdk.AccAddress("confirmedBy_________").String()
+	receiptID := uint64(7)
+
+	var event *EventExternalSettlementConfirmed
+	testFunc := func() {
+		event = NewEventExternalSettlementConfirmed(settlement, paymentRef, confirmedBy, receiptID)
+	}
+	require.NotPanics(t, testFunc, "NewEventExternalSettlementConfirmed")
+	assert.Equal(t, settlement.OrderId, event.OrderId, "OrderId")
+	assert.Equal(t, settlement.AskOrder.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, paymentRef, event.PaymentReference, "PaymentReference")
+	assert.Equal(t, confirmedBy, event.ConfirmedBy, "ConfirmedBy")
+	assert.Equal(t, receiptID, event.ReceiptId, "ReceiptId")
+	assertEverythingSet(t, event, "EventExternalSettlementConfirmed")
+}
+
+func TestNewEventExternalSettlementExpired(t *testing.T) {
+	settlement := &ExternalSettlement{OrderId: 19, AskOrder: AskOrder{MarketId: 6}}
+
+	var event *EventExternalSettlementExpired
+	testFunc := func() {
+		event = NewEventExternalSettlementExpired(settlement)
+	}
+	require.NotPanics(t, testFunc, "NewEventExternalSettlementExpired")
+	assert.Equal(t, settlement.OrderId, event.OrderId, "OrderId")
+	assert.Equal(t, settlement.AskOrder.MarketId, event.MarketId, "MarketId")
+	assertEverythingSet(t, event, "EventExternalSettlementExpired")
+}
+
+func TestNewEventMarketPriceBandUpdated(t *testing.T) {
+	marketID := uint32(4646)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketPriceBandUpdated
+	testFunc := func() {
+		event = NewEventMarketPriceBandUpdated(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketPriceBandUpdated(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketPriceBandUpdated")
+}
+
+func TestNewEventMarketPriceBandTripped(t *testing.T) {
+	marketID := uint32(4747)
+	settlement := NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 3), Price: sdk.NewInt64Coin("pear", 45)}
+	reference := NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("pear", 10)}
+
+	var event *EventMarketPriceBandTripped
+	testFunc := func() {
+		event = NewEventMarketPriceBandTripped(marketID, settlement, reference)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketPriceBandTripped")
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, "3apple", event.Assets, "Assets")
+	assert.Equal(t, "45pear", event.Price, "Price")
+	assert.Equal(t, "1apple", event.ReferenceAssets, "ReferenceAssets")
+	assert.Equal(t, "10pear", event.ReferencePrice, "ReferencePrice")
+	assertEverythingSet(t, event, "EventMarketPriceBandTripped")
+}
+
+func TestNewEventMarketSettlementsResumed(t *testing.T) {
+	marketID := uint32(4848)
+	updatedBy := sdk.AccAddress("updatedBy___________").String()
+
+	var event *EventMarketSettlementsResumed
+	testFunc := func() {
+		event = NewEventMarketSettlementsResumed(marketID, updatedBy)
+	}
+	require.NotPanics(t, testFunc, "NewEventMarketSettlementsResumed(%d, %q)", marketID, updatedBy)
+	assert.Equal(t, marketID, event.MarketId, "MarketId")
+	assert.Equal(t, updatedBy, event.UpdatedBy, "UpdatedBy")
+	assertEverythingSet(t, event, "EventMarketSettlementsResumed")
+}
+
+func TestNewEventRFQCreated(t *testing.T) {
+	rfq := &RequestForQuote{
+		RfqId:            4,
+		MarketId:         7,
+		Buyer:            sdk.AccAddress("buyer_______________").String(),
+		Assets:           sdk.NewInt64Coin("apple", 15),
+		PriceDenom:       "pear",
+		ExpirationHeight: 300,
+	}
+
+	var event *EventRFQCreated
+	testFunc := func() {
+		event = NewEventRFQCreated(rfq)
+	}
+	require.NotPanics(t, testFunc, "NewEventRFQCreated")
+	assert.Equal(t, rfq.RfqId, event.RfqId, "RfqId")
+	assert.Equal(t, rfq.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, rfq.Buyer, event.Buyer, "Buyer")
+	assert.Equal(t, "15apple", event.Assets, "Assets")
+	assert.Equal(t, rfq.PriceDenom, event.PriceDenom, "PriceDenom")
+	assert.Equal(t, rfq.ExpirationHeight, event.ExpirationHeight, "ExpirationHeight")
+	assertEverythingSet(t, event, "EventRFQCreated")
+}
+
+func TestNewEventRFQCancelled(t *testing.T) {
+	rfq := &RequestForQuote{RfqId: 5, MarketId: 8}
+
+	var event *EventRFQCancelled
+	testFunc := func() {
+		event = NewEventRFQCancelled(rfq)
+	}
+	require.NotPanics(t, testFunc, "NewEventRFQCancelled")
+	assert.Equal(t, rfq.RfqId, event.RfqId, "RfqId")
+	assert.Equal(t, rfq.MarketId, event.MarketId, "MarketId")
+	assertEverythingSet(t, event, "EventRFQCancelled")
+}
+
+func TestNewEventRFQExpired(t *testing.T) {
+	rfq := &RequestForQuote{RfqId: 6, MarketId: 9}
+
+	var event *EventRFQExpired
+	testFunc := func() {
+		event = NewEventRFQExpired(rfq)
+	}
+	require.NotPanics(t, testFunc, "NewEventRFQExpired")
+	assert.Equal(t, rfq.RfqId, event.RfqId, "RfqId")
+	assert.Equal(t, rfq.MarketId, event.MarketId, "MarketId")
+	assertEverythingSet(t, event, "EventRFQExpired")
+}
+
+func TestNewEventQuoteSubmitted(t *testing.T) {
+	rfq := &RequestForQuote{RfqId: 7, MarketId: 10}
+	quote := Quote{Dealer: sdk.AccAddress("dealer______________").String(), Price: sdk.NewInt64Coin("pear", 33)}
+
+	var event *EventQuoteSubmitted
+	testFunc := func() {
+		event = NewEventQuoteSubmitted(rfq, quote)
+	}
+	require.NotPanics(t, testFunc, "NewEventQuoteSubmitted")
+	assert.Equal(t, rfq.RfqId, event.RfqId, "RfqId")
+	assert.Equal(t, rfq.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, quote.Dealer, event.Dealer, "Dealer")
+	assert.Equal(t, "33pear", event.Price, "Price")
+	assertEverythingSet(t, event, "EventQuoteSubmitted")
+}
+
+func TestNewEventQuoteWithdrawn(t *testing.T) {
+	rfq := &RequestForQuote{RfqId: 8, MarketId: 11}
+	dealer := sdk.AccAddress("dealer______________").String()
+
+	var event *EventQuoteWithdrawn
+	testFunc := func() {
+		event = NewEventQuoteWithdrawn(rfq, dealer)
+	}
+	require.NotPanics(t, testFunc, "NewEventQuoteWithdrawn")
+	assert.Equal(t, rfq.RfqId, event.RfqId, "RfqId")
+	assert.Equal(t, rfq.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, dealer, event.Dealer, "Dealer")
+	assertEverythingSet(t, event, "EventQuoteWithdrawn")
+}
+
+func TestNewEventQuoteAccepted(t *testing.T) {
+	rfq := &RequestForQuote{RfqId: 9, MarketId: 12}
+	quote := Quote{Dealer: sdk.AccAddress("dealer______________").String(), Price: sdk.NewInt64Coin("pear", 44)}
+	askOrderID := uint64(15)
+	bidOrderID := uint64(16)
+
+	var event *EventQuoteAccepted
+	testFunc := func() {
+		event = NewEventQuoteAccepted(rfq, quote, askOrderID, bidOrderID)
+	}
+	require.NotPanics(t, testFunc, "NewEventQuoteAccepted")
+	assert.Equal(t, rfq.RfqId, event.RfqId, "RfqId")
+	assert.Equal(t, rfq.MarketId, event.MarketId, "MarketId")
+	assert.Equal(t, quote.Dealer, event.Dealer, "Dealer")
+	assert.Equal(t, "44pear", event.Price, "Price")
+	assert.Equal(t, askOrderID, event.AskOrderId, "AskOrderId")
+	assert.Equal(t, bidOrderID, event.BidOrderId, "BidOrderId")
+	assertEverythingSet(t, event, "EventQuoteAccepted")
+}
+
 func TestNewEventMarketPermissionsUpdated(t *testing.T) {
 	marketID := uint32(5432)
 	updatedBy := sdk.AccAddress("updatedBy___________").String()
@@ -1347,7 +1608,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:                   pcoin,
 				SellerSettlementFlatFee: &fcoin,
 				ExternalId:              "eeeeiiiiiddddd",
-			})),
+			}), 6),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderFilled",
 				Attributes: []abci.EventAttribute{
@@ -1357,6 +1618,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "33"},
 					{Key: "order_id", Value: quoteStr("4")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "receipt_id", Value: quoteStr("6")},
 				},
 			},
 		},
@@ -1368,7 +1630,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:               pcoin,
 				BuyerSettlementFees: sdk.Coins{fcoin},
 				ExternalId:          "that one thing",
-			})),
+			}), 7),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderFilled",
 				Attributes: []abci.EventAttribute{
@@ -1378,6 +1640,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "44"},
 					{Key: "order_id", Value: quoteStr("104")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "receipt_id", Value: quoteStr("7")},
 				},
 			},
 		},
@@ -1389,7 +1652,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:                   pcoin,
 				SellerSettlementFlatFee: &fcoin,
 				ExternalId:              "12345",
-			})),
+			}), 8),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderPartiallyFilled",
 				Attributes: []abci.EventAttribute{
@@ -1399,6 +1662,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "22"},
 					{Key: "order_id", Value: quoteStr("5")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "receipt_id", Value: quoteStr("8")},
 				},
 			},
 		},
@@ -1410,7 +1674,7 @@ func TestTypedEventToEvent(t *testing.T) {
 				Price:               pcoin,
 				BuyerSettlementFees: sdk.Coins{fcoin},
 				ExternalId:          "67890",
-			})),
+			}), 9),
 			expEvent: sdk.Event{
 				Type: "provenance.exchange.v1.EventOrderPartiallyFilled",
 				Attributes: []abci.EventAttribute{
@@ -1420,6 +1684,7 @@ func TestTypedEventToEvent(t *testing.T) {
 					{Key: "market_id", Value: "11"},
 					{Key: "order_id", Value: quoteStr("5")},
 					{Key: "price", Value: pcoinQ},
+					{Key: "receipt_id", Value: quoteStr("9")},
 				},
 			},
 		},
@@ -1574,6 +1839,199 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventMarketRebatesUpdated",
+			tev:  NewEventMarketRebatesUpdated(19, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketRebatesUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "19"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketRebatesPaid",
+			tev:  NewEventMarketRebatesPaid(20, sdk.NewCoins(sdk.NewInt64Coin("apple", 5)), 2),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketRebatesPaid",
+				Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: `"5apple"`},
+					{Key: "market_id", Value: "20"},
+					{Key: "recipients", Value: "2"},
+				},
+			},
+		},
+		{
+			name: "EventMarketExternalSettlementUpdated",
+			tev:  NewEventMarketExternalSettlementUpdated(21, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketExternalSettlementUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "21"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventExternalSettlementStarted",
+			tev: NewEventExternalSettlementStarted(&ExternalSettlement{
+				OrderId: 8, AskOrder: AskOrder{MarketId: 22}, Buyer: account, ExpirationHeight: 300,
+			}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventExternalSettlementStarted",
+				Attributes: []abci.EventAttribute{
+					{Key: "buyer", Value: accountQ},
+					{Key: "expiration_height", Value: quoteStr("300")},
+					{Key: "market_id", Value: "22"},
+					{Key: "order_id", Value: quoteStr("8")},
+				},
+			},
+		},
+		{
+			name: "EventExternalSettlementConfirmed",
+			tev:  NewEventExternalSettlementConfirmed(&ExternalSettlement{OrderId: 9, AskOrder: AskOrder{MarketId: 23}}, "ref", updatedBy, 10),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventExternalSettlementConfirmed",
+				Attributes: []abci.EventAttribute{
+					{Key: "confirmed_by", Value: updatedByQ},
+					{Key: "market_id", Value: "23"},
+					{Key: "order_id", Value: quoteStr("9")},
+					{Key: "payment_reference", Value: quoteStr("ref")},
+					{Key: "receipt_id", Value: quoteStr("10")},
+				},
+			},
+		},
+		{
+			name: "EventExternalSettlementExpired",
+			tev:  NewEventExternalSettlementExpired(&ExternalSettlement{OrderId: 10, AskOrder: AskOrder{MarketId: 24}}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventExternalSettlementExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "24"},
+					{Key: "order_id", Value: quoteStr("10")},
+				},
+			},
+		},
+		{
+			name: "EventMarketPriceBandUpdated",
+			tev:  NewEventMarketPriceBandUpdated(26, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketPriceBandUpdated",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "26"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventMarketPriceBandTripped",
+			tev: NewEventMarketPriceBandTripped(27,
+				NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 2), Price: sdk.NewInt64Coin("pear", 30)},
+				NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("pear", 10)},
+			),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketPriceBandTripped",
+				Attributes: []abci.EventAttribute{
+					{Key: "assets", Value: quoteStr("2apple")},
+					{Key: "market_id", Value: "27"},
+					{Key: "price", Value: quoteStr("30pear")},
+					{Key: "reference_assets", Value: quoteStr("1apple")},
+					{Key: "reference_price", Value: quoteStr("10pear")},
+				},
+			},
+		},
+		{
+			name: "EventMarketSettlementsResumed",
+			tev:  NewEventMarketSettlementsResumed(28, updatedBy),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventMarketSettlementsResumed",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "28"},
+					{Key: "updated_by", Value: updatedByQ},
+				},
+			},
+		},
+		{
+			name: "EventRFQCreated",
+			tev: NewEventRFQCreated(&RequestForQuote{
+				RfqId: 4, MarketId: 25, Buyer: account, Assets: sdk.NewInt64Coin("apple", 15),
+				PriceDenom: "pear", ExpirationHeight: 301,
+			}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRFQCreated",
+				Attributes: []abci.EventAttribute{
+					{Key: "assets", Value: quoteStr("15apple")},
+					{Key: "buyer", Value: accountQ},
+					{Key: "expiration_height", Value: quoteStr("301")},
+					{Key: "market_id", Value: "25"},
+					{Key: "price_denom", Value: quoteStr("pear")},
+					{Key: "rfq_id", Value: quoteStr("4")},
+				},
+			},
+		},
+		{
+			name: "EventRFQCancelled",
+			tev:  NewEventRFQCancelled(&RequestForQuote{RfqId: 5, MarketId: 26}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRFQCancelled",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "26"},
+					{Key: "rfq_id", Value: quoteStr("5")},
+				},
+			},
+		},
+		{
+			name: "EventRFQExpired",
+			tev:  NewEventRFQExpired(&RequestForQuote{RfqId: 6, MarketId: 27}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventRFQExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "market_id", Value: "27"},
+					{Key: "rfq_id", Value: quoteStr("6")},
+				},
+			},
+		},
+		{
+			name: "EventQuoteSubmitted",
+			tev:  NewEventQuoteSubmitted(&RequestForQuote{RfqId: 7, MarketId: 28}, Quote{Dealer: account, Price: sdk.NewInt64Coin("pear", 33)}),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventQuoteSubmitted",
+				Attributes: []abci.EventAttribute{
+					{Key: "dealer", Value: accountQ},
+					{Key: "market_id", Value: "28"},
+					{Key: "price", Value: quoteStr("33pear")},
+					{Key: "rfq_id", Value: quoteStr("7")},
+				},
+			},
+		},
+		{
+			name: "EventQuoteWithdrawn",
+			tev:  NewEventQuoteWithdrawn(&RequestForQuote{RfqId: 8, MarketId: 29}, account),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventQuoteWithdrawn",
+				Attributes: []abci.EventAttribute{
+					{Key: "dealer", Value: accountQ},
+					{Key: "market_id", Value: "29"},
+					{Key: "rfq_id", Value: quoteStr("8")},
+				},
+			},
+		},
+		{
+			name: "EventQuoteAccepted",
+			tev:  NewEventQuoteAccepted(&RequestForQuote{RfqId: 9, MarketId: 30}, Quote{Dealer: account, Price: sdk.NewInt64Coin("pear", 44)}, 15, 16),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventQuoteAccepted",
+				Attributes: []abci.EventAttribute{
+					{Key: "ask_order_id", Value: quoteStr("15")},
+					{Key: "bid_order_id", Value: quoteStr("16")},
+					{Key: "dealer", Value: accountQ},
+					{Key: "market_id", Value: "30"},
+					{Key: "price", Value: quoteStr("44pear")},
+					{Key: "rfq_id", Value: quoteStr("9")},
+				},
+			},
+		},
 		{
 			name: "EventMarketPermissionsUpdated",
 			tev:  NewEventMarketPermissionsUpdated(12, updatedBy),
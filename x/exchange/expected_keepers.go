@@ -2,8 +2,10 @@ package exchange
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
@@ -19,7 +21,11 @@ type AccountKeeper interface {
 }
 
 type AttributeKeeper interface {
-	GetAllAttributesAddr(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
+	GetAllAttributesAddrWithMirror(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
+}
+
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
 }
 
 type BankKeeper interface {
@@ -27,6 +33,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	InputOutputCoinsProv(ctx context.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
 	BlockedAddr(addr sdk.AccAddress) bool
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 type HoldKeeper interface {
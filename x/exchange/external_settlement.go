@@ -0,0 +1,112 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPaymentReferenceLength is the maximum length that an external payment reference can have.
+const MaxPaymentReferenceLength = 100
+
+// IsEnabled returns true if this config has a market using external settlement.
+func (c ExternalSettlementConfig) IsEnabled() bool {
+	return len(c.Oracles) > 0
+}
+
+// HasOracle returns true if the provided address is one of this config's oracles.
+func (c ExternalSettlementConfig) HasOracle(addr string) bool {
+	for _, oracle := range c.Oracles {
+		if oracle == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if any of this ExternalSettlementConfig's info is invalid.
+// A config without any oracles (i.e. disabled) must not have a timeout.
+func (c ExternalSettlementConfig) Validate() error {
+	if !c.IsEnabled() {
+		if c.TimeoutBlocks != 0 {
+			return errors.New("invalid external settlement config: a config without oracles must not have a timeout")
+		}
+		return nil
+	}
+
+	var errs []error
+	seen := make(map[string]int, len(c.Oracles))
+	for i, oracle := range c.Oracles {
+		if j, dup := seen[oracle]; dup {
+			errs = append(errs, fmt.Errorf("invalid oracles[%d]: duplicate address %s seen at [%d]", i, oracle, j))
+			continue
+		}
+		seen[oracle] = i
+		if _, err := sdk.AccAddressFromBech32(oracle); err != nil {
+			errs = append(errs, fmt.Errorf("invalid oracles[%d] %q: %w", i, oracle, err))
+		}
+	}
+	if c.TimeoutBlocks <= 0 {
+		errs = append(errs, fmt.Errorf("invalid external settlement timeout %d: must be positive", c.TimeoutBlocks))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if any of this ExternalSettlement's info is invalid.
+func (s ExternalSettlement) Validate() error {
+	var errs []error
+	if s.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: must not be zero"))
+	}
+	if err := s.AskOrder.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Buyer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid buyer %q: %w", s.Buyer, err))
+	}
+	if s.ExpirationHeight <= 0 {
+		errs = append(errs, fmt.Errorf("invalid expiration height %d: must be positive", s.ExpirationHeight))
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if any of this MarketExternalSettlements's info is invalid.
+func (m MarketExternalSettlements) Validate() error {
+	var errs []error
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	seen := make(map[uint64]int, len(m.Pending))
+	for i, pending := range m.Pending {
+		if j, dup := seen[pending.OrderId]; dup {
+			errs = append(errs, fmt.Errorf("invalid pending[%d]: duplicate order id %d seen at [%d]", i, pending.OrderId, j))
+			continue
+		}
+		seen[pending.OrderId] = i
+		if err := pending.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid pending[%d]: %w", i, err))
+			continue
+		}
+		if pending.AskOrder.MarketId != m.MarketId {
+			errs = append(errs, fmt.Errorf("invalid pending[%d]: ask order market id %d does not equal %d",
+				i, pending.AskOrder.MarketId, m.MarketId))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidatePaymentReference returns an error if the provided payment reference is empty or too long.
+func ValidatePaymentReference(ref string) error {
+	if len(ref) == 0 {
+		return errors.New("invalid payment reference: cannot be empty")
+	}
+	if len(ref) > MaxPaymentReferenceLength {
+		return fmt.Errorf("invalid payment reference %q (length %d): max length %d",
+			ref[:5]+"..."+ref[len(ref)-5:], len(ref), MaxPaymentReferenceLength)
+	}
+	return nil
+}
@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/external_settlement.proto
+
+package exchange
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExternalSettlementConfig defines how a market settles orders whose payment happens outside of the blockchain.
+type ExternalSettlementConfig struct {
+	// oracles are the bech32 address strings of the accounts allowed to confirm that an external payment was made.
+	// If empty, the market does not use external settlement.
+	Oracles []string `protobuf:"bytes,1,rep,name=oracles,proto3" json:"oracles,omitempty"`
+	// timeout_blocks is the number of blocks an oracle has to confirm payment before an external settlement is cancelled.
+	TimeoutBlocks int64 `protobuf:"varint,2,opt,name=timeout_blocks,json=timeoutBlocks,proto3" json:"timeout_blocks,omitempty"`
+}
+
+func (m *ExternalSettlementConfig) Reset()         { *m = ExternalSettlementConfig{} }
+func (m *ExternalSettlementConfig) String() string { return proto.CompactTextString(m) }
+func (*ExternalSettlementConfig) ProtoMessage()    {}
+func (*ExternalSettlementConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3df8452a1a9fcb8, []int{0}
+}
+func (m *ExternalSettlementConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalSettlementConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalSettlementConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalSettlementConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalSettlementConfig.Merge(m, src)
+}
+func (m *ExternalSettlementConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalSettlementConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalSettlementConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalSettlementConfig proto.InternalMessageInfo
+
+func (m *ExternalSettlementConfig) GetOracles() []string {
+	if m != nil {
+		return m.Oracles
+	}
+	return nil
+}
+
+func (m *ExternalSettlementConfig) GetTimeoutBlocks() int64 {
+	if m != nil {
+		return m.TimeoutBlocks
+	}
+	return 0
+}
+
+// ExternalSettlement is an ask order that is waiting for confirmation that its buyer paid for it outside of the blockchain.
+type ExternalSettlement struct {
+	// order_id is the numerical identifier of the ask order being settled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// ask_order is the ask order being settled. Its funds remain on hold until the settlement is confirmed or expires.
+	AskOrder AskOrder `protobuf:"bytes,2,opt,name=ask_order,json=askOrder,proto3" json:"ask_order"`
+	// buyer is the bech32 address string of the account that will receive the assets once payment is confirmed.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// expiration_height is the block height at which this settlement will be cancelled if payment hasn't been confirmed.
+	ExpirationHeight int64 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *ExternalSettlement) Reset()         { *m = ExternalSettlement{} }
+func (m *ExternalSettlement) String() string { return proto.CompactTextString(m) }
+func (*ExternalSettlement) ProtoMessage()    {}
+func (*ExternalSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3df8452a1a9fcb8, []int{1}
+}
+func (m *ExternalSettlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalSettlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalSettlement.Merge(m, src)
+}
+func (m *ExternalSettlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalSettlement proto.InternalMessageInfo
+
+func (m *ExternalSettlement) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *ExternalSettlement) GetAskOrder() AskOrder {
+	if m != nil {
+		return m.AskOrder
+	}
+	return AskOrder{}
+}
+
+func (m *ExternalSettlement) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ExternalSettlement) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// MarketExternalSettlements contains a market's external settlement configuration and its pending external settlements.
+type MarketExternalSettlements struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// config is the market's external settlement configuration.
+	Config ExternalSettlementConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	// pending are the external settlements that are waiting for payment confirmation.
+	Pending []ExternalSettlement `protobuf:"bytes,3,rep,name=pending,proto3" json:"pending"`
+}
+
+func (m *MarketExternalSettlements) Reset()         { *m = MarketExternalSettlements{} }
+func (m *MarketExternalSettlements) String() string { return proto.CompactTextString(m) }
+func (*MarketExternalSettlements) ProtoMessage()    {}
+func (*MarketExternalSettlements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3df8452a1a9fcb8, []int{2}
+}
+func (m *MarketExternalSettlements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketExternalSettlements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketExternalSettlements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketExternalSettlements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketExternalSettlements.Merge(m, src)
+}
+func (m *MarketExternalSettlements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketExternalSettlements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketExternalSettlements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketExternalSettlements proto.InternalMessageInfo
+
+func (m *MarketExternalSettlements) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketExternalSettlements) GetConfig() ExternalSettlementConfig {
+	if m != nil {
+		return m.Config
+	}
+	return ExternalSettlementConfig{}
+}
+
+func (m *MarketExternalSettlements) GetPending() []ExternalSettlement {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExternalSettlementConfig)(nil), "provenance.exchange.v1.ExternalSettlementConfig")
+	proto.RegisterType((*ExternalSettlement)(nil), "provenance.exchange.v1.ExternalSettlement")
+	proto.RegisterType((*MarketExternalSettlements)(nil), "provenance.exchange.v1.MarketExternalSettlements")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/external_settlement.proto", fileDescriptor_d3df8452a1a9fcb8)
+}
+
+var fileDescriptor_d3df8452a1a9fcb8 = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xdd, 0x6a, 0x13, 0x41,
+	0x18, 0xcd, 0xb8, 0xb1, 0x4d, 0xa6, 0x54, 0x74, 0x28, 0xb2, 0xa9, 0xb0, 0x2e, 0x11, 0x21, 0x28,
+	0xdd, 0x6d, 0xe3, 0x13, 0x34, 0x45, 0xb0, 0x82, 0x3f, 0xa4, 0x77, 0xde, 0x2c, 0x9b, 0x9d, 0xcf,
+	0xc9, 0x90, 0xec, 0x7c, 0x61, 0x66, 0x12, 0xe2, 0x5b, 0xf8, 0x30, 0x3e, 0x44, 0x2f, 0xab, 0x78,
+	0xe1, 0x95, 0x48, 0xf2, 0x22, 0x92, 0xd9, 0x49, 0x23, 0xc4, 0x88, 0x77, 0x7b, 0xce, 0x9c, 0x73,
+	0xbe, 0xef, 0xcc, 0x0e, 0x3d, 0x9d, 0x68, 0x9c, 0x81, 0xca, 0x55, 0x01, 0x29, 0xcc, 0x8b, 0x61,
+	0xae, 0x04, 0xa4, 0xb3, 0xb3, 0x14, 0xe6, 0x16, 0xb4, 0xca, 0xc7, 0x99, 0x01, 0x6b, 0xc7, 0x50,
+	0x82, 0xb2, 0xc9, 0x44, 0xa3, 0x45, 0xf6, 0x70, 0xe3, 0x48, 0xd6, 0x8e, 0x64, 0x76, 0x76, 0xdc,
+	0x2a, 0xd0, 0x94, 0x68, 0x32, 0xa7, 0x4a, 0x2b, 0x50, 0x59, 0x8e, 0x8f, 0x04, 0x0a, 0xac, 0xf8,
+	0xd5, 0x97, 0x67, 0x9f, 0xec, 0x18, 0x8d, 0x9a, 0x83, 0xf6, 0xd6, 0xf6, 0x94, 0x86, 0x2f, 0xfd,
+	0x2a, 0x57, 0xb7, 0x9b, 0x5c, 0xa0, 0xfa, 0x28, 0x05, 0xeb, 0xd2, 0x7d, 0xd4, 0x79, 0x31, 0x06,
+	0x13, 0x92, 0x38, 0xe8, 0x34, 0x7b, 0xe1, 0xb7, 0x2f, 0x27, 0x47, 0x7e, 0xf2, 0x39, 0xe7, 0x1a,
+	0x8c, 0xb9, 0xb2, 0x5a, 0x2a, 0xd1, 0x5f, 0x0b, 0xd9, 0x53, 0x7a, 0xcf, 0xca, 0x12, 0x70, 0x6a,
+	0xb3, 0xc1, 0x18, 0x8b, 0x91, 0x09, 0xef, 0xc4, 0xa4, 0x13, 0xf4, 0x0f, 0x3d, 0xdb, 0x73, 0x64,
+	0xfb, 0x2b, 0xa1, 0x6c, 0x7b, 0x2e, 0x6b, 0xd1, 0x86, 0xdb, 0x2e, 0x93, 0x3c, 0x24, 0x31, 0xe9,
+	0xd4, 0x57, 0xc1, 0x1c, 0xf4, 0x25, 0x67, 0x17, 0xb4, 0x99, 0x9b, 0x51, 0xe6, 0xa0, 0xcb, 0x3c,
+	0xe8, 0xc6, 0xc9, 0xdf, 0xaf, 0x2a, 0x39, 0x37, 0xa3, 0x77, 0x2b, 0x5d, 0xaf, 0x7e, 0xfd, 0xf3,
+	0x71, 0xad, 0xdf, 0xc8, 0x3d, 0x66, 0x09, 0xbd, 0x3b, 0x98, 0x7e, 0x02, 0x1d, 0x06, 0x31, 0xf9,
+	0x67, 0x9f, 0x4a, 0xc6, 0x9e, 0xd3, 0x07, 0x30, 0x9f, 0x48, 0x9d, 0x5b, 0x89, 0x2a, 0x1b, 0x82,
+	0x14, 0x43, 0x1b, 0xd6, 0x5d, 0xa1, 0xfb, 0x9b, 0x83, 0x57, 0x8e, 0x6f, 0x7f, 0x27, 0xb4, 0xf5,
+	0x26, 0xd7, 0x23, 0xb0, 0xdb, 0xcd, 0x0c, 0x7b, 0x44, 0x9b, 0xa5, 0x3b, 0x5c, 0x77, 0x3b, 0xec,
+	0x37, 0x2a, 0xe2, 0x92, 0xb3, 0xb7, 0x74, 0xaf, 0x70, 0x77, 0xee, 0x9b, 0x9d, 0xee, 0x6a, 0xb6,
+	0xeb, 0x5f, 0xf9, 0xa6, 0x3e, 0x85, 0xbd, 0xa6, 0xfb, 0x13, 0x50, 0x5c, 0x2a, 0x11, 0x06, 0x71,
+	0xd0, 0x39, 0xe8, 0x3e, 0xfb, 0xff, 0x40, 0x1f, 0xb5, 0x0e, 0xe8, 0xc1, 0xf5, 0x22, 0x22, 0x37,
+	0x8b, 0x88, 0xfc, 0x5a, 0x44, 0xe4, 0xf3, 0x32, 0xaa, 0xdd, 0x2c, 0xa3, 0xda, 0x8f, 0x65, 0x54,
+	0xa3, 0x2d, 0x89, 0x3b, 0x62, 0xdf, 0x93, 0x0f, 0x89, 0x90, 0x76, 0x38, 0x1d, 0x24, 0x05, 0x96,
+	0xe9, 0x46, 0x74, 0x22, 0xf1, 0x0f, 0x94, 0xce, 0x6f, 0x1f, 0xe6, 0x60, 0xcf, 0xbd, 0xc7, 0x17,
+	0xbf, 0x07, 0x00, 0x8f, 0x1f, 0x3a, 0x0d, 0x31, 0x03, 0x00, 0x00,
+}
+
+func (m *ExternalSettlementConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalSettlementConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalSettlementConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutBlocks != 0 {
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(m.TimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Oracles) > 0 {
+		for iNdEx := len(m.Oracles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Oracles[iNdEx])
+			copy(dAtA[i:], m.Oracles[iNdEx])
+			i = encodeVarintExternalSettlement(dAtA, i, uint64(len(m.Oracles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalSettlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalSettlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalSettlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderId != 0 {
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketExternalSettlements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketExternalSettlements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketExternalSettlements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExternalSettlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintExternalSettlement(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExternalSettlement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExternalSettlement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExternalSettlementConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Oracles) > 0 {
+		for _, s := range m.Oracles {
+			l = len(s)
+			n += 1 + l + sovExternalSettlement(uint64(l))
+		}
+	}
+	if m.TimeoutBlocks != 0 {
+		n += 1 + sovExternalSettlement(uint64(m.TimeoutBlocks))
+	}
+	return n
+}
+
+func (m *ExternalSettlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovExternalSettlement(uint64(m.OrderId))
+	}
+	l = m.AskOrder.Size()
+	n += 1 + l + sovExternalSettlement(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovExternalSettlement(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovExternalSettlement(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MarketExternalSettlements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovExternalSettlement(uint64(m.MarketId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovExternalSettlement(uint64(l))
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovExternalSettlement(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovExternalSettlement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExternalSettlement(x uint64) (n int) {
+	return sovExternalSettlement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExternalSettlementConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExternalSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalSettlementConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalSettlementConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracles = append(m.Oracles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutBlocks", wireType)
+			}
+			m.TimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExternalSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalSettlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExternalSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalSettlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalSettlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExternalSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketExternalSettlements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExternalSettlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketExternalSettlements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketExternalSettlements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, ExternalSettlement{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExternalSettlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExternalSettlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExternalSettlement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExternalSettlement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExternalSettlement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExternalSettlement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExternalSettlement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExternalSettlement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExternalSettlement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExternalSettlement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExternalSettlement = fmt.Errorf("proto: unexpected end of group")
+)
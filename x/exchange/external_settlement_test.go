@@ -0,0 +1,213 @@
+package exchange
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestExternalSettlementConfig_HasOracle(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+
+	tests := []struct {
+		name   string
+		config ExternalSettlementConfig
+		addr   string
+		exp    bool
+	}{
+		{name: "no oracles", config: ExternalSettlementConfig{}, addr: addr1, exp: false},
+		{name: "only oracle", config: ExternalSettlementConfig{Oracles: []string{addr1}}, addr: addr1, exp: true},
+		{name: "last oracle", config: ExternalSettlementConfig{Oracles: []string{addr1, addr2}}, addr: addr2, exp: true},
+		{name: "not an oracle", config: ExternalSettlementConfig{Oracles: []string{addr1, addr2}}, addr: addr3, exp: false},
+		{name: "empty addr", config: ExternalSettlementConfig{Oracles: []string{addr1}}, addr: "", exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act bool
+			testFunc := func() {
+				act = tc.config.HasOracle(tc.addr)
+			}
+			assert.NotPanics(t, testFunc, "HasOracle(%q)", tc.addr)
+			assert.Equal(t, tc.exp, act, "HasOracle(%q)", tc.addr)
+		})
+	}
+}
+
+func TestExternalSettlementConfig_Validate(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name   string
+		config ExternalSettlementConfig
+		expErr []string
+	}{
+		{
+			name:   "zero value",
+			config: ExternalSettlementConfig{},
+		},
+		{
+			name:   "enabled",
+			config: ExternalSettlementConfig{Oracles: []string{addr1, addr2}, TimeoutBlocks: 100},
+		},
+		{
+			name:   "disabled with timeout",
+			config: ExternalSettlementConfig{TimeoutBlocks: 100},
+			expErr: []string{"invalid external settlement config: a config without oracles must not have a timeout"},
+		},
+		{
+			name:   "duplicate oracle",
+			config: ExternalSettlementConfig{Oracles: []string{addr1, addr2, addr1}, TimeoutBlocks: 100},
+			expErr: []string{"invalid oracles[2]: duplicate address " + addr1 + " seen at [0]"},
+		},
+		{
+			name:   "bad oracle",
+			config: ExternalSettlementConfig{Oracles: []string{addr1, "bad"}, TimeoutBlocks: 100},
+			expErr: []string{"invalid oracles[1] \"bad\": decoding bech32 failed"},
+		},
+		{
+			name:   "zero timeout",
+			config: ExternalSettlementConfig{Oracles: []string{addr1}},
+			expErr: []string{"invalid external settlement timeout 0: must be positive"},
+		},
+		{
+			name:   "multiple errors",
+			config: ExternalSettlementConfig{Oracles: []string{"", addr1, addr1}, TimeoutBlocks: -3},
+			expErr: []string{
+				"invalid oracles[0] \"\": empty address string is not allowed",
+				"invalid oracles[2]: duplicate address " + addr1 + " seen at [1]",
+				"invalid external settlement timeout -3: must be positive",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.config.Validate()
+			}
+			assert.NotPanics(t, testFunc, "ExternalSettlementConfig.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ExternalSettlementConfig.Validate()")
+		})
+	}
+}
+
+func TestMarketExternalSettlements_Validate(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	oracle := sdk.AccAddress("oracle______________").String()
+	goodConfig := ExternalSettlementConfig{Oracles: []string{oracle}, TimeoutBlocks: 10}
+	askOrder := func(marketID uint32) AskOrder {
+		return AskOrder{
+			MarketId: marketID,
+			Seller:   seller,
+			Assets:   sdk.NewInt64Coin("apple", 5),
+			Price:    sdk.NewInt64Coin("nhash", 12),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		es     MarketExternalSettlements
+		expErr []string
+	}{
+		{
+			name: "control",
+			es: MarketExternalSettlements{
+				MarketId: 3,
+				Config:   goodConfig,
+				Pending: []ExternalSettlement{
+					{OrderId: 1, AskOrder: askOrder(3), Buyer: buyer, ExpirationHeight: 15},
+					{OrderId: 4, AskOrder: askOrder(3), Buyer: buyer, ExpirationHeight: 16},
+				},
+			},
+		},
+		{
+			name:   "market zero",
+			es:     MarketExternalSettlements{Config: goodConfig},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "bad config",
+			es:     MarketExternalSettlements{MarketId: 1, Config: ExternalSettlementConfig{Oracles: []string{oracle}}},
+			expErr: []string{"invalid external settlement timeout 0: must be positive"},
+		},
+		{
+			name: "duplicate order id",
+			es: MarketExternalSettlements{
+				MarketId: 3,
+				Config:   goodConfig,
+				Pending: []ExternalSettlement{
+					{OrderId: 1, AskOrder: askOrder(3), Buyer: buyer, ExpirationHeight: 15},
+					{OrderId: 1, AskOrder: askOrder(3), Buyer: buyer, ExpirationHeight: 16},
+				},
+			},
+			expErr: []string{"invalid pending[1]: duplicate order id 1 seen at [0]"},
+		},
+		{
+			name: "bad pending entries",
+			es: MarketExternalSettlements{
+				MarketId: 3,
+				Config:   goodConfig,
+				Pending: []ExternalSettlement{
+					{OrderId: 0, AskOrder: askOrder(3), Buyer: "", ExpirationHeight: 0},
+					{OrderId: 2, AskOrder: askOrder(4), Buyer: buyer, ExpirationHeight: 15},
+				},
+			},
+			expErr: []string{
+				"invalid pending[0]: invalid order id: must not be zero",
+				"invalid pending[0]: invalid buyer \"\": empty address string is not allowed",
+				"invalid expiration height 0: must be positive",
+				"invalid pending[1]: ask order market id 4 does not equal 3",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.es.Validate()
+			}
+			assert.NotPanics(t, testFunc, "MarketExternalSettlements.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketExternalSettlements.Validate()")
+		})
+	}
+}
+
+func TestValidatePaymentReference(t *testing.T) {
+	tests := []struct {
+		name   string
+		ref    string
+		expErr string
+	}{
+		{name: "empty", ref: "", expErr: "invalid payment reference: cannot be empty"},
+		{name: "one char", ref: "x"},
+		{name: "max length", ref: strings.Repeat("p", MaxPaymentReferenceLength)},
+		{
+			name:   "too long",
+			ref:    "abcdef" + strings.Repeat("p", MaxPaymentReferenceLength-10) + "uvwxy",
+			expErr: "invalid payment reference \"abcde...uvwxy\" (length 101): max length 100",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = ValidatePaymentReference(tc.ref)
+			}
+			assert.NotPanics(t, testFunc, "ValidatePaymentReference(%q)", tc.ref)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidatePaymentReference(%q)", tc.ref)
+		})
+	}
+}
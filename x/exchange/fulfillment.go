@@ -31,7 +31,11 @@ type Settlement struct {
 	// This is not included in FullyFilledOrders.
 	PartialOrderFilled *FilledOrder
 	// PartialOrderLeft is what's left of the partially filled order.
+	// If that order is fill-or-kill, this is what will be cancelled instead of being left on the books.
 	PartialOrderLeft *Order
+	// NetTransfers is whether the Transfers should be netted (see NetTransfers) before they are made.
+	// The Transfers themselves are left as-is so that they still reflect who traded with whom.
+	NetTransfers bool
 }
 
 // BuildSettlement processes the provided orders, identifying how the provided orders can be settled.
@@ -54,6 +58,11 @@ func BuildSettlement(askOrders, bidOrders []*Order, sellerFeeRatioLookup func(de
 		return nil, err
 	}
 
+	// Make sure there aren't any all-or-nothing orders being settled with a partial order.
+	if err := validateAllOrNothing(askOFs, bidOFs, settlement); err != nil {
+		return nil, err
+	}
+
 	// Allocate the prices.
 	if err := allocatePrice(askOFs, bidOFs); err != nil {
 		return nil, err
@@ -283,6 +292,21 @@ func (f orderFulfillment) PartialFillAllowed() bool {
 	return f.Order.PartialFillAllowed()
 }
 
+// IsFillOrKill gets this fulfillment's order's FillOrKill flag.
+func (f orderFulfillment) IsFillOrKill() bool {
+	return f.Order.IsFillOrKill()
+}
+
+// GetMinFillAssets gets this fulfillment's order's min fill assets amount.
+func (f orderFulfillment) GetMinFillAssets() sdkmath.Int {
+	return f.Order.GetMinFillAssets()
+}
+
+// IsAllOrNothing gets this fulfillment's order's AllOrNothing flag.
+func (f orderFulfillment) IsAllOrNothing() bool {
+	return f.Order.IsAllOrNothing()
+}
+
 // GetExternalID gets this fulfillment's external id.
 func (f orderFulfillment) GetExternalID() string {
 	return f.Order.GetExternalID()
@@ -532,6 +556,26 @@ func splitOrderFulfillments(fulfillments []*orderFulfillment, settlement *Settle
 	return nil
 }
 
+// validateAllOrNothing returns an error if the provided Settlement has a partial order and any
+// of the provided fulfillments are for all-or-nothing orders.
+func validateAllOrNothing(askOFs, bidOFs []*orderFulfillment, settlement *Settlement) error {
+	partial := settlement.PartialOrderLeft
+	if partial == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, fulfillments := range [][]*orderFulfillment{askOFs, bidOFs} {
+		for _, f := range fulfillments {
+			if f.IsAllOrNothing() {
+				errs = append(errs, fmt.Errorf("all-or-nothing %s order %d cannot be settled with partially filled %s order %d",
+					f.GetOrderType(), f.GetOrderID(), partial.GetOrderType(), partial.GetOrderID()))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // allocatePrice distributes the prices among the fulfillments.
 func allocatePrice(askOFs, bidOFs []*orderFulfillment) error {
 	// Check that the total ask price is not more than the total bid price.
@@ -882,6 +926,91 @@ func getPriceTransfer(f *orderFulfillment) (*Transfer, error) {
 	panic(fmt.Errorf("%s order %d: unknown order type", f.GetOrderType(), f.GetOrderID()))
 }
 
+// NetTransfers offsets the amounts that the same two parties send each other across the provided transfers and
+// returns the transfers needed to move just the net amounts. There is one resulting transfer for each address that
+// still has something to send, with that address as its only input. Amounts an address would send to itself are
+// dropped. A transfer with multiple inputs and multiple outputs can't be attributed to specific pairs of parties,
+// so it is included as-is after the netted transfers.
+func NetTransfers(transfers []*Transfer) []*Transfer {
+	type flow struct {
+		from  string
+		to    string
+		coins sdk.Coins
+	}
+	var flows []*flow
+	flowIndexes := make(map[string]int)
+	flowKey := func(from, to string) string {
+		return from + " " + to
+	}
+	addFlow := func(from, to string, coins sdk.Coins) {
+		key := flowKey(from, to)
+		n, known := flowIndexes[key]
+		if !known {
+			n = len(flows)
+			flowIndexes[key] = n
+			flows = append(flows, &flow{from: from, to: to})
+		}
+		flows[n].coins = flows[n].coins.Add(coins...)
+	}
+
+	var unattributable []*Transfer
+	for _, transfer := range transfers {
+		switch {
+		case len(transfer.Inputs) == 1:
+			for _, output := range transfer.Outputs {
+				addFlow(transfer.Inputs[0].Address, output.Address, output.Coins)
+			}
+		case len(transfer.Outputs) == 1:
+			for _, input := range transfer.Inputs {
+				addFlow(input.Address, transfer.Outputs[0].Address, input.Coins)
+			}
+		default:
+			unattributable = append(unattributable, transfer)
+		}
+	}
+
+	// Offset each flow by the one going the other way, and group what's left by sender.
+	var senders []string
+	sent := make(map[string]*IndexedAddrAmts)
+	for _, f := range flows {
+		if f.from == f.to {
+			continue
+		}
+		diff := f.coins
+		if n, known := flowIndexes[flowKey(f.to, f.from)]; known {
+			diff, _ = diff.SafeSub(flows[n].coins...)
+		}
+		net := sdk.NewCoins()
+		for _, coin := range diff {
+			if coin.IsPositive() {
+				net = net.Add(coin)
+			}
+		}
+		if net.IsZero() {
+			continue
+		}
+		if sent[f.from] == nil {
+			senders = append(senders, f.from)
+			sent[f.from] = NewIndexedAddrAmts()
+		}
+		sent[f.from].Add(f.to, net...)
+	}
+
+	rv := make([]*Transfer, 0, len(senders)+len(unattributable))
+	for _, sender := range senders {
+		outputs := sent[sender].GetAsOutputs()
+		total := sdk.NewCoins()
+		for _, output := range outputs {
+			total = total.Add(output.Coins...)
+		}
+		rv = append(rv, &Transfer{
+			Inputs:  []banktypes.Input{{Address: sender, Coins: total}},
+			Outputs: outputs,
+		})
+	}
+	return append(rv, unattributable...)
+}
+
 // filterOrders returns all the filled orders (partial or full) that return true from the checker.
 func filterOrders(settlement *Settlement, checker func(order OrderI) bool) []OrderI {
 	var rv []OrderI
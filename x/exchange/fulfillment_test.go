@@ -342,6 +342,14 @@ func TestBuildSettlement(t *testing.T) {
 			AllowPartial:        allowPartial,
 		})
 	}
+	allOrNothing := func(order *Order) *Order {
+		if order.IsAskOrder() {
+			order.GetAskOrder().AllOrNothing = true
+		} else {
+			order.GetBidOrder().AllOrNothing = true
+		}
+		return order
+	}
 	ratio := func(price, fee int64) func(denom string) (*FeeRatio, error) {
 		return func(denom string) (*FeeRatio, error) {
 			return &FeeRatio{Price: sdk.NewInt64Coin(priceDenom, price), Fee: sdk.NewInt64Coin(feeDenoms[0], fee)}, nil
@@ -413,6 +421,33 @@ func TestBuildSettlement(t *testing.T) {
 			},
 			expErr: "failed calculate ratio fee for ask order 3: cannot apply ratio 10prune:1fig to price 10peach: incorrect price denom",
 		},
+		{
+			name:      "all-or-nothing order with a partial order",
+			askOrders: []*Order{askOrder(3, 10, 100, true)},
+			bidOrders: []*Order{allOrNothing(bidOrder(4, 7, 70, false))},
+			expErr:    "all-or-nothing bid order 4 cannot be settled with partially filled ask order 3",
+		},
+		{
+			name:      "all-or-nothing orders without a partial order",
+			askOrders: []*Order{allOrNothing(askOrder(3, 10, 100, false))},
+			bidOrders: []*Order{allOrNothing(bidOrder(4, 10, 100, false))},
+			expSettlement: &Settlement{
+				Transfers: []*Transfer{
+					{
+						Inputs:  []banktypes.Input{assetsInput(3, 10)},
+						Outputs: []banktypes.Output{assetsOutput(4, 10)},
+					},
+					{
+						Inputs:  []banktypes.Input{priceInput(4, 100)},
+						Outputs: []banktypes.Output{priceOutput(3, 100)},
+					},
+				},
+				FullyFilledOrders: []*FilledOrder{
+					filled(allOrNothing(askOrder(3, 10, 100, false)), 100),
+					filled(allOrNothing(bidOrder(4, 10, 100, false)), 100),
+				},
+			},
+		},
 		{
 			name:      "one ask, three bids: last bid not used",
 			askOrders: []*Order{askOrder(3, 10, 20, false)},
@@ -4784,6 +4819,67 @@ func TestSplitOrderFulfillments(t *testing.T) {
 	}
 }
 
+func TestValidateAllOrNothing(t *testing.T) {
+	newOF := func(order *Order) *orderFulfillment {
+		return &orderFulfillment{Order: order}
+	}
+	askOrder := func(orderID uint64, allOrNothing bool) *Order {
+		return NewOrder(orderID).WithAsk(&AskOrder{AllOrNothing: allOrNothing})
+	}
+	bidOrder := func(orderID uint64, allOrNothing bool) *Order {
+		return NewOrder(orderID).WithBid(&BidOrder{AllOrNothing: allOrNothing})
+	}
+
+	tests := []struct {
+		name       string
+		askOFs     []*orderFulfillment
+		bidOFs     []*orderFulfillment
+		settlement *Settlement
+		expErr     string
+	}{
+		{
+			name:       "no partial, all-or-nothing orders",
+			askOFs:     []*orderFulfillment{newOF(askOrder(1, true))},
+			bidOFs:     []*orderFulfillment{newOF(bidOrder(2, true))},
+			settlement: &Settlement{},
+		},
+		{
+			name:       "partial, no all-or-nothing orders",
+			askOFs:     []*orderFulfillment{newOF(askOrder(1, false))},
+			bidOFs:     []*orderFulfillment{newOF(bidOrder(2, false)), newOF(bidOrder(3, false))},
+			settlement: &Settlement{PartialOrderLeft: bidOrder(3, false)},
+		},
+		{
+			name:       "partial, all-or-nothing ask",
+			askOFs:     []*orderFulfillment{newOF(askOrder(1, false)), newOF(askOrder(4, true))},
+			bidOFs:     []*orderFulfillment{newOF(bidOrder(2, false)), newOF(bidOrder(3, false))},
+			settlement: &Settlement{PartialOrderLeft: bidOrder(3, false)},
+			expErr:     "all-or-nothing ask order 4 cannot be settled with partially filled bid order 3",
+		},
+		{
+			name:       "partial, all-or-nothing ask and bid",
+			askOFs:     []*orderFulfillment{newOF(askOrder(1, true)), newOF(askOrder(4, false))},
+			bidOFs:     []*orderFulfillment{newOF(bidOrder(2, true))},
+			settlement: &Settlement{PartialOrderLeft: askOrder(4, false)},
+			expErr: joinErrs(
+				"all-or-nothing ask order 1 cannot be settled with partially filled ask order 4",
+				"all-or-nothing bid order 2 cannot be settled with partially filled ask order 4",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = validateAllOrNothing(tc.askOFs, tc.bidOFs, tc.settlement)
+			}
+			require.NotPanics(t, testFunc, "validateAllOrNothing")
+			assertions.AssertErrorValue(t, err, tc.expErr, "validateAllOrNothing error")
+		})
+	}
+}
+
 func TestAllocatePrice(t *testing.T) {
 	askOrder := func(orderID uint64, assetsAmt, priceAmt int64) *Order {
 		return NewOrder(orderID).WithAsk(&AskOrder{
@@ -6233,6 +6329,111 @@ func TestGetPriceTransfer(t *testing.T) {
 	}
 }
 
+func TestNetTransfers(t *testing.T) {
+	coins := func(amts ...int64) sdk.Coins {
+		// The amounts are for apple, then peach.
+		rv := sdk.NewCoins()
+		for i, denom := range []string{"apple", "peach"}[:len(amts)] {
+			rv = rv.Add(sdk.NewInt64Coin(denom, amts[i]))
+		}
+		return rv
+	}
+	transfer := func(from string, amts sdk.Coins, tos ...string) *Transfer {
+		rv := &Transfer{Inputs: []banktypes.Input{{Address: from, Coins: amts}}}
+		for _, to := range tos {
+			rv.Outputs = append(rv.Outputs, banktypes.Output{Address: to, Coins: amts})
+		}
+		if len(tos) > 1 {
+			rv.Inputs[0].Coins = amts.MulInt(sdkmath.NewInt(int64(len(tos))))
+		}
+		return rv
+	}
+
+	multi := &Transfer{
+		Inputs:  []banktypes.Input{{Address: "alice", Coins: coins(1)}, {Address: "bob", Coins: coins(1)}},
+		Outputs: []banktypes.Output{{Address: "carl", Coins: coins(1)}, {Address: "dana", Coins: coins(1)}},
+	}
+
+	tests := []struct {
+		name      string
+		transfers []*Transfer
+		exp       []*Transfer
+	}{
+		{
+			name:      "nil transfers",
+			transfers: nil,
+			exp:       []*Transfer{},
+		},
+		{
+			name:      "nothing to offset",
+			transfers: []*Transfer{transfer("alice", coins(10), "bob"), transfer("bob", coins(0, 100), "alice")},
+			exp:       []*Transfer{transfer("alice", coins(10), "bob"), transfer("bob", coins(0, 100), "alice")},
+		},
+		{
+			name: "reciprocal orders",
+			transfers: []*Transfer{
+				transfer("alice", coins(10), "bob"),
+				transfer("bob", coins(4), "alice"),
+				transfer("bob", coins(0, 100), "alice"),
+				transfer("alice", coins(0, 40), "bob"),
+			},
+			exp: []*Transfer{
+				transfer("alice", coins(6), "bob"),
+				transfer("bob", coins(0, 60), "alice"),
+			},
+		},
+		{
+			name:      "fully offset",
+			transfers: []*Transfer{transfer("alice", coins(5, 7), "bob"), transfer("bob", coins(5, 7), "alice")},
+			exp:       []*Transfer{},
+		},
+		{
+			name:      "to self",
+			transfers: []*Transfer{transfer("alice", coins(5), "alice")},
+			exp:       []*Transfer{},
+		},
+		{
+			name: "several counterparties",
+			transfers: []*Transfer{
+				transfer("alice", coins(5), "bob", "carl"),
+				{
+					Inputs:  []banktypes.Input{{Address: "bob", Coins: coins(2)}, {Address: "carl", Coins: coins(5)}},
+					Outputs: []banktypes.Output{{Address: "alice", Coins: coins(7)}},
+				},
+			},
+			exp: []*Transfer{
+				{
+					Inputs:  []banktypes.Input{{Address: "alice", Coins: coins(3)}},
+					Outputs: []banktypes.Output{{Address: "bob", Coins: coins(3)}},
+				},
+			},
+		},
+		{
+			name:      "multiple inputs and outputs",
+			transfers: []*Transfer{multi, transfer("carl", coins(1), "alice")},
+			exp:       []*Transfer{transfer("carl", coins(1), "alice"), multi},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []*Transfer
+			testFunc := func() {
+				actual = NetTransfers(tc.transfers)
+			}
+			require.NotPanics(t, testFunc, "NetTransfers")
+			if !assert.Equal(t, tc.exp, actual, "NetTransfers result") {
+				for i, transfer := range actual {
+					t.Logf("  Actual[%d]: %s", i, transferString(transfer))
+				}
+				for i, transfer := range tc.exp {
+					t.Logf("Expected[%d]: %s", i, transferString(transfer))
+				}
+			}
+		})
+	}
+}
+
 func TestFilterOrders(t *testing.T) {
 	askOrder := func(orderID uint64) *FilledOrder {
 		return NewFilledOrder(NewOrder(orderID).WithAsk(&AskOrder{}), sdk.NewInt64Coin("accordion", 12), nil)
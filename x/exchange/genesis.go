@@ -95,5 +95,101 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	rebateMarketIDs := make(map[uint32]int, len(g.MarketRebates))
+	for i, rebates := range g.MarketRebates {
+		if j, seen := rebateMarketIDs[rebates.MarketId]; seen {
+			errs = append(errs, fmt.Errorf("invalid market rebates[%d]: duplicate market id %d seen at [%d]", i, rebates.MarketId, j))
+			continue
+		}
+		rebateMarketIDs[rebates.MarketId] = i
+
+		if err := rebates.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid market rebates[%d]: %w", i, err))
+		} else if _, known := marketIDs[rebates.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid market rebates[%d]: unknown market id %d", i, rebates.MarketId))
+		}
+	}
+
+	extSetMarketIDs := make(map[uint32]int, len(g.MarketExternalSettlements))
+	for i, extSet := range g.MarketExternalSettlements {
+		if j, seen := extSetMarketIDs[extSet.MarketId]; seen {
+			errs = append(errs, fmt.Errorf("invalid market external settlements[%d]: duplicate market id %d seen at [%d]", i, extSet.MarketId, j))
+			continue
+		}
+		extSetMarketIDs[extSet.MarketId] = i
+
+		if err := extSet.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid market external settlements[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[extSet.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid market external settlements[%d]: unknown market id %d", i, extSet.MarketId))
+		}
+		for k, pending := range extSet.Pending {
+			if _, isOrder := orderIDs[pending.OrderId]; isOrder {
+				errs = append(errs, fmt.Errorf("invalid market external settlements[%d].pending[%d]: order id %d is also in the orders",
+					i, k, pending.OrderId))
+			}
+			if pending.OrderId > g.LastOrderId {
+				errs = append(errs, fmt.Errorf("invalid market external settlements[%d].pending[%d]: order id %d is greater than the last order id %d",
+					i, k, pending.OrderId, g.LastOrderId))
+			}
+		}
+	}
+
+	receiptIDs := make(map[uint64]int, len(g.SettlementReceipts))
+	for i, receipt := range g.SettlementReceipts {
+		if j, seen := receiptIDs[receipt.ReceiptId]; seen {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: duplicate receipt id %d seen at [%d]", i, receipt.ReceiptId, j))
+			continue
+		}
+		receiptIDs[receipt.ReceiptId] = i
+
+		if err := receipt.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: %w", i, err))
+			continue
+		}
+		if receipt.ReceiptId > g.LastReceiptId {
+			errs = append(errs, fmt.Errorf("invalid settlement receipt[%d]: receipt id %d is greater than the last receipt id %d",
+				i, receipt.ReceiptId, g.LastReceiptId))
+		}
+	}
+
+	rfqIDs := make(map[uint64]int, len(g.Rfqs))
+	for i, rfq := range g.Rfqs {
+		if j, seen := rfqIDs[rfq.RfqId]; seen {
+			errs = append(errs, fmt.Errorf("invalid rfq[%d]: duplicate rfq id %d seen at [%d]", i, rfq.RfqId, j))
+			continue
+		}
+		rfqIDs[rfq.RfqId] = i
+
+		if err := rfq.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid rfq[%d]: %w", i, err))
+			continue
+		}
+		if _, known := marketIDs[rfq.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid rfq[%d]: unknown market id %d", i, rfq.MarketId))
+		}
+		if rfq.RfqId > g.LastRfqId {
+			errs = append(errs, fmt.Errorf("invalid rfq[%d]: rfq id %d is greater than the last rfq id %d",
+				i, rfq.RfqId, g.LastRfqId))
+		}
+	}
+
+	priceBandMarketIDs := make(map[uint32]int, len(g.MarketPriceBands))
+	for i, bands := range g.MarketPriceBands {
+		if j, seen := priceBandMarketIDs[bands.MarketId]; seen {
+			errs = append(errs, fmt.Errorf("invalid market price bands[%d]: duplicate market id %d seen at [%d]", i, bands.MarketId, j))
+			continue
+		}
+		priceBandMarketIDs[bands.MarketId] = i
+
+		if err := bands.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid market price bands[%d]: %w", i, err))
+		} else if _, known := marketIDs[bands.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid market price bands[%d]: unknown market id %d", i, bands.MarketId))
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -39,6 +39,21 @@ type GenesisState struct {
 	Commitments []Commitment `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments"`
 	// payments are all the payments to create at genesis.
 	Payments []Payment `protobuf:"bytes,7,rep,name=payments,proto3" json:"payments"`
+	// market_rebates are the rebate configurations and pools of the markets that pay liquidity rebates.
+	MarketRebates []MarketRebates `protobuf:"bytes,8,rep,name=market_rebates,json=marketRebates,proto3" json:"market_rebates"`
+	// market_external_settlements are the external settlement configurations and pending settlements of the markets
+	// that use external settlement.
+	MarketExternalSettlements []MarketExternalSettlements `protobuf:"bytes,9,rep,name=market_external_settlements,json=marketExternalSettlements,proto3" json:"market_external_settlements"`
+	// settlement_receipts are all of the settlement receipts to create at genesis.
+	SettlementReceipts []SettlementReceipt `protobuf:"bytes,10,rep,name=settlement_receipts,json=settlementReceipts,proto3" json:"settlement_receipts"`
+	// last_receipt_id is the value of the last settlement receipt id created.
+	LastReceiptId uint64 `protobuf:"varint,11,opt,name=last_receipt_id,json=lastReceiptId,proto3" json:"last_receipt_id,omitempty"`
+	// rfqs are all of the requests for quote (and their quotes) to create at genesis.
+	Rfqs []RequestForQuote `protobuf:"bytes,12,rep,name=rfqs,proto3" json:"rfqs"`
+	// last_rfq_id is the value of the last request for quote id created.
+	LastRfqId uint64 `protobuf:"varint,13,opt,name=last_rfq_id,json=lastRfqId,proto3" json:"last_rfq_id,omitempty"`
+	// market_price_bands are the price band configurations and circuit breaker states of the markets that have price bands.
+	MarketPriceBands []MarketPriceBands `protobuf:"bytes,14,rep,name=market_price_bands,json=marketPriceBands,proto3" json:"market_price_bands"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,32 +98,45 @@ func init() {
 }
 
 var fileDescriptor_087ceebafabf03c9 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x4f, 0xad, 0x48, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x43, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x34, 0x70, 0x98, 0x99, 0x9c, 0x9f, 0x9b, 0x9b,
-	0x59, 0x92, 0x9b, 0x9a, 0x57, 0x02, 0x35, 0x57, 0x4a, 0x19, 0x87, 0xca, 0xdc, 0xc4, 0xa2, 0xec,
-	0xd4, 0x12, 0x02, 0x8a, 0xf2, 0x8b, 0x52, 0x52, 0x8b, 0x08, 0x99, 0x54, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x53, 0xa4, 0x8a, 0x53, 0x51, 0x25, 0x92, 0xab, 0x94, 0x96, 0x31, 0x73, 0xf1, 0xb8, 0x43,
-	0xfc, 0x1f, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc6, 0xc5, 0x06, 0x31, 0x47, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0x7b, 0x78, 0xe8, 0x05, 0x80, 0x55, 0x05, 0x41, 0x55, 0x0b,
-	0xd9, 0x71, 0xb1, 0x43, 0x7c, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0x8c, 0x4f, 0xa3, 0x2f, 0x58, 0x99,
-	0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x30, 0x4d, 0x42, 0xd6, 0x5c, 0x6c, 0x10, 0x4f, 0x4a,
-	0x30, 0x83, 0xb5, 0xcb, 0xe2, 0xd2, 0xee, 0x0f, 0x52, 0x05, 0xd5, 0x0d, 0xd5, 0x22, 0xa4, 0xc2,
-	0xc5, 0x97, 0x93, 0x58, 0x5c, 0x12, 0x0f, 0x31, 0x2c, 0x3e, 0x33, 0x45, 0x82, 0x45, 0x81, 0x51,
-	0x83, 0x37, 0x88, 0x07, 0x24, 0x0a, 0xb1, 0xcf, 0x33, 0x45, 0x48, 0x89, 0x8b, 0x17, 0xac, 0x0a,
-	0xac, 0x09, 0xa4, 0x88, 0x55, 0x81, 0x51, 0x83, 0x25, 0x88, 0x1b, 0x24, 0x08, 0x36, 0xd5, 0x33,
-	0x45, 0xc8, 0x8b, 0x8b, 0x1b, 0x29, 0xea, 0x24, 0xd8, 0xc0, 0x6e, 0x51, 0xc2, 0xe5, 0x16, 0x67,
-	0xb8, 0x52, 0xa8, 0x83, 0x90, 0x35, 0x0b, 0x39, 0x72, 0x71, 0xc0, 0x42, 0x5b, 0x82, 0x1d, 0x6c,
-	0x90, 0x3c, 0xee, 0xc0, 0xac, 0x44, 0x32, 0x05, 0xae, 0xcd, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3,
-	0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0xa9, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0,
-	0x25, 0x99, 0x99, 0x8f, 0xc3, 0xd8, 0x00, 0xc6, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x84, 0x22, 0xdd, 0xcc, 0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x3c, 0x85,
-	0x24, 0xb1, 0x81, 0x93, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x2c, 0xee, 0x93, 0x2c,
-	0x03, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x8f, 0xd2, 0x4e,
+	0x18, 0xc7, 0xdb, 0xdf, 0xf2, 0x63, 0xd9, 0xe1, 0x8f, 0x66, 0x34, 0xa6, 0x8b, 0xb1, 0x10, 0xdc,
+	0x75, 0xf1, 0x60, 0x2b, 0x9a, 0x78, 0xd0, 0xc4, 0x64, 0x31, 0x6a, 0x30, 0x31, 0x62, 0xf7, 0x66,
+	0x4c, 0xb0, 0xb4, 0x0f, 0x6c, 0x23, 0xed, 0xc0, 0xcc, 0x80, 0xec, 0x3b, 0xf0, 0xe8, 0x4b, 0xd8,
+	0x57, 0xe2, 0x79, 0x8f, 0x7b, 0xf4, 0x64, 0x0c, 0x5c, 0x7c, 0x19, 0xa6, 0xd3, 0x81, 0x36, 0x9b,
+	0x1d, 0xb8, 0xc1, 0xf4, 0xf3, 0x7d, 0x3e, 0x33, 0xcf, 0x33, 0x2d, 0x3a, 0x18, 0x53, 0x32, 0x83,
+	0xc8, 0x8d, 0x3c, 0xb0, 0x61, 0xee, 0x9d, 0xba, 0xd1, 0x10, 0xec, 0x59, 0xcb, 0x1e, 0x42, 0x04,
+	0x2c, 0x60, 0xd6, 0x98, 0x12, 0x4e, 0xf0, 0x9d, 0x94, 0xb2, 0x56, 0x94, 0x35, 0x6b, 0x55, 0x6f,
+	0x0f, 0xc9, 0x90, 0x08, 0xc4, 0x8e, 0x7f, 0x25, 0x74, 0xb5, 0xa9, 0xa8, 0xe9, 0x91, 0x30, 0x0c,
+	0x78, 0x08, 0x11, 0x97, 0x75, 0xab, 0x8f, 0x15, 0x24, 0xcc, 0x39, 0xd0, 0xc8, 0x1d, 0xf5, 0x18,
+	0x70, 0x3e, 0x82, 0x38, 0x22, 0x13, 0xf7, 0x15, 0x89, 0xd0, 0xa5, 0x5f, 0x61, 0x1b, 0x44, 0xa8,
+	0x0f, 0x94, 0x6d, 0x81, 0xc6, 0x2e, 0x75, 0xc3, 0x15, 0x74, 0xa8, 0x84, 0xce, 0xb2, 0xe7, 0x38,
+	0x52, 0x61, 0x34, 0xf0, 0xa0, 0xd7, 0x77, 0x23, 0x5f, 0x82, 0xaa, 0x76, 0x53, 0xe8, 0xbb, 0x1c,
+	0xb6, 0x59, 0x29, 0x78, 0x10, 0x8c, 0xd7, 0xd6, 0xba, 0x0a, 0x1b, 0x4c, 0x12, 0xa2, 0xf1, 0x73,
+	0x17, 0x95, 0xde, 0x26, 0x93, 0x3c, 0xe1, 0x2e, 0x07, 0xfc, 0x0c, 0xe5, 0x93, 0xf3, 0x19, 0x7a,
+	0x5d, 0x6f, 0x16, 0x9f, 0x98, 0xd6, 0xf5, 0x93, 0xb5, 0xba, 0x82, 0x72, 0x24, 0x8d, 0x5f, 0xa2,
+	0xdd, 0xa4, 0xc3, 0xcc, 0xf8, 0xaf, 0xbe, 0xb3, 0x29, 0xf8, 0x5e, 0x60, 0xed, 0xdc, 0xc5, 0xef,
+	0x9a, 0xe6, 0xac, 0x42, 0xf8, 0x05, 0xca, 0x27, 0xcd, 0x37, 0x76, 0x44, 0xfc, 0x9e, 0x2a, 0xfe,
+	0x21, 0xa6, 0x64, 0x5a, 0x46, 0xf0, 0x01, 0xaa, 0x8c, 0x5c, 0xc6, 0x7b, 0x49, 0xb1, 0x5e, 0xe0,
+	0x1b, 0xb9, 0xba, 0xde, 0x2c, 0x3b, 0xa5, 0x78, 0x35, 0xf1, 0x75, 0x7c, 0xdc, 0x40, 0x65, 0x41,
+	0x89, 0x50, 0x0c, 0xfd, 0x5f, 0xd7, 0x9b, 0x39, 0xa7, 0x18, 0x2f, 0x8a, 0xaa, 0x1d, 0x1f, 0xbf,
+	0x43, 0xc5, 0xcc, 0x25, 0x34, 0xf2, 0x62, 0x2f, 0x0d, 0xd5, 0x5e, 0x5e, 0xad, 0x51, 0xb9, 0xa1,
+	0x6c, 0x18, 0x1f, 0xa3, 0xc2, 0xea, 0x16, 0x18, 0xbb, 0xa2, 0x50, 0x4d, 0xdd, 0xcc, 0xb3, 0x4c,
+	0x95, 0x75, 0x0c, 0x3b, 0xa8, 0x22, 0xcf, 0x24, 0xe7, 0x6f, 0x14, 0x44, 0xa1, 0xc3, 0xcd, 0xcd,
+	0x75, 0x12, 0x58, 0x96, 0x2b, 0x87, 0xd9, 0x45, 0xfc, 0x0d, 0xdd, 0x95, 0x35, 0xaf, 0x79, 0x89,
+	0x98, 0xb1, 0x27, 0x04, 0xad, 0xcd, 0x82, 0xd7, 0x32, 0x79, 0x92, 0x06, 0xa5, 0x6c, 0x3f, 0x54,
+	0x01, 0xf8, 0x0b, 0xba, 0x95, 0x8a, 0x7a, 0xab, 0xab, 0x6a, 0x20, 0x21, 0x7c, 0xa8, 0x12, 0xa6,
+	0x15, 0x9c, 0x24, 0x21, 0x45, 0x98, 0x5d, 0x7d, 0xc0, 0xf0, 0x03, 0x74, 0x43, 0x4c, 0x58, 0xd6,
+	0x8e, 0x67, 0x5c, 0x14, 0x33, 0x16, 0x83, 0x97, 0x58, 0xc7, 0xc7, 0xc7, 0x28, 0x47, 0x07, 0x13,
+	0x66, 0x94, 0x84, 0xfa, 0x48, 0xa5, 0x76, 0x60, 0x32, 0x05, 0xc6, 0xdf, 0x10, 0xfa, 0x71, 0x4a,
+	0x38, 0x48, 0xb1, 0x88, 0x62, 0x13, 0x15, 0x13, 0xd5, 0x60, 0x12, 0x6b, 0xca, 0x42, 0xb3, 0x27,
+	0x34, 0x83, 0x49, 0xc7, 0xc7, 0x9f, 0x11, 0x96, 0x5d, 0x4e, 0x5f, 0x71, 0x66, 0x54, 0x84, 0xb0,
+	0xb9, 0xb9, 0xb9, 0xdd, 0x38, 0xd0, 0x8e, 0x79, 0x69, 0xbc, 0x19, 0x5e, 0x59, 0x7f, 0x5e, 0xf8,
+	0x7e, 0x5e, 0xd3, 0xfe, 0x9e, 0xd7, 0xb4, 0x36, 0x5c, 0x2c, 0x4c, 0xfd, 0x72, 0x61, 0xea, 0x7f,
+	0x16, 0xa6, 0xfe, 0x63, 0x69, 0x6a, 0x97, 0x4b, 0x53, 0xfb, 0xb5, 0x34, 0x35, 0xb4, 0x1f, 0x10,
+	0x85, 0xa7, 0xab, 0x7f, 0xb2, 0x86, 0x01, 0x3f, 0x9d, 0xf6, 0x2d, 0x8f, 0x84, 0x76, 0x0a, 0x3d,
+	0x0a, 0x48, 0xe6, 0x9f, 0x3d, 0x5f, 0x7f, 0x34, 0xfa, 0x79, 0xf1, 0xb9, 0x78, 0xfa, 0x6f, 0x00,
+	0x85, 0x3b, 0xbd, 0x3a, 0x0e, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +159,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MarketPriceBands) > 0 {
+		for iNdEx := len(m.MarketPriceBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketPriceBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.LastRfqId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRfqId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Rfqs) > 0 {
+		for iNdEx := len(m.Rfqs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rfqs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastReceiptId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastReceiptId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.SettlementReceipts) > 0 {
+		for iNdEx := len(m.SettlementReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MarketExternalSettlements) > 0 {
+		for iNdEx := len(m.MarketExternalSettlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketExternalSettlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MarketRebates) > 0 {
+		for iNdEx := len(m.MarketRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Payments) > 0 {
 		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -263,6 +371,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MarketRebates) > 0 {
+		for _, e := range m.MarketRebates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketExternalSettlements) > 0 {
+		for _, e := range m.MarketExternalSettlements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettlementReceipts) > 0 {
+		for _, e := range m.SettlementReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastReceiptId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastReceiptId))
+	}
+	if len(m.Rfqs) > 0 {
+		for _, e := range m.Rfqs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRfqId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRfqId))
+	}
+	if len(m.MarketPriceBands) > 0 {
+		for _, e := range m.MarketPriceBands {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -511,6 +655,214 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRebates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketRebates = append(m.MarketRebates, MarketRebates{})
+			if err := m.MarketRebates[len(m.MarketRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketExternalSettlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketExternalSettlements = append(m.MarketExternalSettlements, MarketExternalSettlements{})
+			if err := m.MarketExternalSettlements[len(m.MarketExternalSettlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementReceipts = append(m.SettlementReceipts, SettlementReceipt{})
+			if err := m.SettlementReceipts[len(m.SettlementReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReceiptId", wireType)
+			}
+			m.LastReceiptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReceiptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rfqs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rfqs = append(m.Rfqs, RequestForQuote{})
+			if err := m.Rfqs[len(m.Rfqs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRfqId", wireType)
+			}
+			m.LastRfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketPriceBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketPriceBands = append(m.MarketPriceBands, MarketPriceBands{})
+			if err := m.MarketPriceBands[len(m.MarketPriceBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -69,6 +69,34 @@ func TestGenesisState_Validate(t *testing.T) {
 			Price:    priceCoin,
 		})
 	}
+	extSettlement := func(orderID uint64, marketID uint32, buyer string, expHeight int64) ExternalSettlement {
+		ask := askOrder(orderID, marketID, "5fry", "1leela")
+		return ExternalSettlement{OrderId: orderID, AskOrder: *ask.GetAskOrder(), Buyer: buyer, ExpirationHeight: expHeight}
+	}
+	receipt := func(receiptID uint64) SettlementReceipt {
+		return SettlementReceipt{
+			ReceiptId:      receiptID,
+			MarketId:       1,
+			OrderId:        receiptID,
+			OrderType:      OrderTypeAsk,
+			Owner:          addr1,
+			Counterparties: []string{addr2},
+			Assets:         coin(5, "fry"),
+			Price:          coin(1, "leela"),
+			Height:         10,
+		}
+	}
+	rfq := func(rfqID uint64, marketID uint32) RequestForQuote {
+		return RequestForQuote{
+			RfqId:            rfqID,
+			MarketId:         marketID,
+			Buyer:            addr1,
+			Assets:           coin(5, "fry"),
+			PriceDenom:       "leela",
+			ExpirationHeight: 20,
+			Quotes:           []Quote{{Dealer: addr2, Price: coin(3, "leela")}},
+		}
+	}
 	payment := func(source, sourceAmount, target, targetAmount, externalID string) Payment {
 		rv := Payment{
 			Source:     source,
@@ -436,6 +464,180 @@ func TestGenesisState_Validate(t *testing.T) {
 				"invalid payment[2]: duplicate payment, source " + addr3 + " and external id \"there's two of me\" seen at [1]",
 			},
 		},
+		{
+			name: "market rebates: okay",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				MarketRebates: []MarketRebates{
+					{
+						MarketId: 1,
+						Config:   MarketRebateConfig{PoolBips: 50, PriceDenom: "nhash", SampleInterval: 1, PayoutInterval: 10},
+						Pool:     sdk.Coins{coin(12, "nhash")},
+						Points:   []LiquidityPoints{{Address: addr1, Points: sdkmath.NewInt(3)}},
+					},
+				},
+			},
+		},
+		{
+			name: "market rebates: all invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				MarketRebates: []MarketRebates{
+					{MarketId: 2, Config: MarketRebateConfig{PoolBips: 50, PriceDenom: "nhash", SampleInterval: 1, PayoutInterval: 10}},
+					{MarketId: 1, Config: MarketRebateConfig{PriceDenom: "nhash"}},
+					{MarketId: 2},
+				},
+			},
+			expErr: []string{
+				"invalid market rebates[0]: unknown market id 2",
+				"invalid market rebates[1]: invalid rebate config: a config with zero pool bips must not have any other fields set",
+				"invalid market rebates[2]: duplicate market id 2 seen at [0]",
+			},
+		},
+		{
+			name: "market external settlements: okay",
+			genState: GenesisState{
+				Markets:     []Market{{MarketId: 1}},
+				Orders:      []Order{askOrder(1, 1, "99fry", "9leela")},
+				LastOrderId: 2,
+				MarketExternalSettlements: []MarketExternalSettlements{
+					{
+						MarketId: 1,
+						Config:   ExternalSettlementConfig{Oracles: []string{addr2}, TimeoutBlocks: 10},
+						Pending: []ExternalSettlement{
+							extSettlement(2, 1, addr3, 20),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "market external settlements: all invalid",
+			genState: GenesisState{
+				Markets:     []Market{{MarketId: 1}},
+				Orders:      []Order{askOrder(1, 1, "99fry", "9leela")},
+				LastOrderId: 2,
+				MarketExternalSettlements: []MarketExternalSettlements{
+					{MarketId: 2, Config: ExternalSettlementConfig{Oracles: []string{addr2}, TimeoutBlocks: 10}},
+					{MarketId: 1, Config: ExternalSettlementConfig{TimeoutBlocks: 10}},
+					{MarketId: 2},
+				},
+			},
+			expErr: []string{
+				"invalid market external settlements[0]: unknown market id 2",
+				"invalid market external settlements[1]: invalid external settlement config: a config without oracles must not have a timeout",
+				"invalid market external settlements[2]: duplicate market id 2 seen at [0]",
+			},
+		},
+		{
+			name: "market external settlements: bad pending order ids",
+			genState: GenesisState{
+				Markets:     []Market{{MarketId: 1}},
+				Orders:      []Order{askOrder(1, 1, "99fry", "9leela")},
+				LastOrderId: 2,
+				MarketExternalSettlements: []MarketExternalSettlements{
+					{
+						MarketId: 1,
+						Config:   ExternalSettlementConfig{Oracles: []string{addr2}, TimeoutBlocks: 10},
+						Pending: []ExternalSettlement{
+							extSettlement(1, 1, addr3, 20),
+							extSettlement(3, 1, addr3, 20),
+						},
+					},
+				},
+			},
+			expErr: []string{
+				"invalid market external settlements[0].pending[0]: order id 1 is also in the orders",
+				"invalid market external settlements[0].pending[1]: order id 3 is greater than the last order id 2",
+			},
+		},
+		{
+			name: "settlement receipts: okay",
+			genState: GenesisState{
+				SettlementReceipts: []SettlementReceipt{receipt(1), receipt(3), receipt(2)},
+				LastReceiptId:      3,
+			},
+		},
+		{
+			name: "settlement receipts: all invalid",
+			genState: GenesisState{
+				SettlementReceipts: []SettlementReceipt{
+					receipt(1),
+					{ReceiptId: 2, MarketId: 1, OrderId: 2, OrderType: OrderTypeBid, Owner: "bad"},
+					receipt(1),
+					receipt(4),
+				},
+				LastReceiptId: 3,
+			},
+			expErr: []string{
+				"invalid settlement receipt[1]: invalid owner \"bad\"",
+				"invalid settlement receipt[2]: duplicate receipt id 1 seen at [0]",
+				"invalid settlement receipt[3]: receipt id 4 is greater than the last receipt id 3",
+			},
+		},
+		{
+			name: "rfqs: okay",
+			genState: GenesisState{
+				Markets:   []Market{{MarketId: 1}, {MarketId: 2}},
+				Rfqs:      []RequestForQuote{rfq(1, 1), rfq(3, 2), rfq(2, 1)},
+				LastRfqId: 3,
+			},
+		},
+		{
+			name: "rfqs: all invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				Rfqs: []RequestForQuote{
+					rfq(1, 1),
+					{RfqId: 2, MarketId: 1, Buyer: "bad", Assets: coin(5, "fry"), PriceDenom: "leela", ExpirationHeight: 20},
+					rfq(1, 1),
+					rfq(3, 2),
+					rfq(4, 1),
+				},
+				LastRfqId: 3,
+			},
+			expErr: []string{
+				"invalid rfq[1]: invalid buyer \"bad\"",
+				"invalid rfq[2]: duplicate rfq id 1 seen at [0]",
+				"invalid rfq[3]: unknown market id 2",
+				"invalid rfq[4]: rfq id 4 is greater than the last rfq id 3",
+			},
+		},
+		{
+			name: "market price bands: okay",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				MarketPriceBands: []MarketPriceBands{
+					{
+						MarketId: 1,
+						Config: MarketPriceBandConfig{
+							MaxDeviationBips: 500,
+							Reference:        PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT,
+							Action:           PriceBandAction_PRICE_BAND_ACTION_PAUSE,
+						},
+						Paused:     true,
+						LastPrices: []NetAssetPrice{{Assets: coin(5, "fry"), Price: coin(20, "leela")}},
+					},
+					{MarketId: 2, Paused: true},
+				},
+			},
+		},
+		{
+			name: "market price bands: all invalid",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				MarketPriceBands: []MarketPriceBands{
+					{MarketId: 2, Paused: true},
+					{MarketId: 1, Config: MarketPriceBandConfig{Action: PriceBandAction_PRICE_BAND_ACTION_REJECT}},
+					{MarketId: 2},
+				},
+			},
+			expErr: []string{
+				"invalid market price bands[0]: unknown market id 2",
+				"invalid market price bands[1]: invalid price band config: a config with zero max deviation bips must not have any other fields set",
+				"invalid market price bands[2]: duplicate market id 2 seen at [0]",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -269,7 +269,7 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 					ReqAttrCreateCommitment:  []string{"com.can.do"},
 				})
 			},
-			attrKeeper:  NewMockAttributeKeeper().WithGetAllAttributesAddrResult(s.addr4, []string{"com.can.do"}, ""),
+			attrKeeper:  NewMockAttributeKeeper().WithGetAllAttributesAddrWithMirrorResult(s.addr4, []string{"com.can.do"}, ""),
 			marketID:    4,
 			addr:        s.addr4,
 			amount:      s.coins("15peach"),
@@ -373,7 +373,7 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 		{
 			name:        "existing commitments: additional commitment with req attr",
 			setup:       existingSetup("magic.com.creator"),
-			attrKeeper:  NewMockAttributeKeeper().WithGetAllAttributesAddrResult(s.addr2, []string{"magic.com.creator"}, ""),
+			attrKeeper:  NewMockAttributeKeeper().WithGetAllAttributesAddrWithMirrorResult(s.addr2, []string{"magic.com.creator"}, ""),
 			marketID:    2,
 			addr:        s.addr2,
 			amount:      s.coins("100apple"),
@@ -392,7 +392,7 @@ func (s *TestSuite) TestKeeper_AddCommitment() {
 			}
 			var expAttrCalls AttributeCalls
 			if tc.expAttrCall {
-				expAttrCalls.GetAllAttributesAddr = append(expAttrCalls.GetAllAttributesAddr, tc.addr)
+				expAttrCalls.GetAllAttributesAddrWithMirror = append(expAttrCalls.GetAllAttributesAddrWithMirror, tc.addr)
 			}
 
 			var expEvents sdk.Events
@@ -24,6 +24,12 @@ func (k Keeper) WithAttributeKeeper(attrKeeper exchange.AttributeKeeper) Keeper
 	return k
 }
 
+// WithAuthzKeeper is a test-only method that returns a new Keeper that uses the provided AuthzKeeper.
+func (k Keeper) WithAuthzKeeper(authzKeeper exchange.AuthzKeeper) Keeper {
+	k.authzKeeper = authzKeeper
+	return k
+}
+
 // WithBankKeeper is a test-only method that returns a new Keeper that uses the provided BankKeeper.
 func (k Keeper) WithBankKeeper(bankKeeper exchange.BankKeeper) Keeper {
 	k.bankKeeper = bankKeeper
@@ -73,6 +79,11 @@ func (k Keeper) SetPaymentInStore(store storetypes.KVStore, payment *exchange.Pa
 	return k.setPaymentInStore(store, payment)
 }
 
+// SetSettlementReceipt is a test-only exposure of setSettlementReceipt.
+func (k Keeper) SetSettlementReceipt(store storetypes.KVStore, receipt *exchange.SettlementReceipt) error {
+	return k.setSettlementReceipt(store, receipt)
+}
+
 // GetCodec is a test-only exposure of this keeper's cdc.
 func (k Keeper) GetCodec() codec.BinaryCodec {
 	return k.cdc
@@ -98,6 +109,12 @@ var (
 	SetParamsFeeCreatePaymentFlat = setParamsFeeCreatePaymentFlat
 	// SetParamsFeeAcceptPaymentFlat is a test-only exposure of setParamsFeeAcceptPaymentFlat.
 	SetParamsFeeAcceptPaymentFlat = setParamsFeeAcceptPaymentFlat
+	// SetParamsMarketIssuers is a test-only exposure of setParamsMarketIssuers.
+	SetParamsMarketIssuers = setParamsMarketIssuers
+	// SetParamsIssuerMarketMaxFlatFees is a test-only exposure of setParamsIssuerMarketMaxFlatFees.
+	SetParamsIssuerMarketMaxFlatFees = setParamsIssuerMarketMaxFlatFees
+	// SetParamsIssuerMarketMaxRatioBips is a test-only exposure of setParamsIssuerMarketMaxRatioBips.
+	SetParamsIssuerMarketMaxRatioBips = setParamsIssuerMarketMaxRatioBips
 
 	// GetLastAutoMarketID is a test-only exposure of getLastAutoMarketID.
 	GetLastAutoMarketID = getLastAutoMarketID
@@ -151,4 +168,9 @@ var (
 
 	// SetCommitmentAmount is a test-only exposure of setCommitmentAmount.
 	SetCommitmentAmount = setCommitmentAmount
+
+	// GetLastReceiptID is a test-only exposure of getLastReceiptID.
+	GetLastReceiptID = getLastReceiptID
+	// GetCounterparties is a test-only exposure of getCounterparties.
+	GetCounterparties = getCounterparties
 )
@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getExternalSettlementConfig gets a market's external settlement config.
+// If the market doesn't have one, a disabled config is returned.
+func (k Keeper) getExternalSettlementConfig(store storetypes.KVStore, marketID uint32) exchange.ExternalSettlementConfig {
+	var rv exchange.ExternalSettlementConfig
+	bz := store.Get(MakeKeyMarketExternalSettlementConfig(marketID))
+	if len(bz) > 0 {
+		// If it can't be unmarshalled, we treat it as disabled.
+		if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+			return exchange.ExternalSettlementConfig{}
+		}
+	}
+	return rv
+}
+
+// setExternalSettlementConfig sets a market's external settlement config. A disabled config is deleted from state.
+func (k Keeper) setExternalSettlementConfig(store storetypes.KVStore, marketID uint32, config exchange.ExternalSettlementConfig) error {
+	key := MakeKeyMarketExternalSettlementConfig(marketID)
+	if !config.IsEnabled() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return fmt.Errorf("error marshaling external settlement config for market %d: %w", marketID, err)
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// validateNotExternallySettled returns an error if the market uses external settlement.
+// Orders in such markets can only be settled by having an oracle confirm payment.
+func (k Keeper) validateNotExternallySettled(store storetypes.KVStore, marketID uint32) error {
+	if k.getExternalSettlementConfig(store, marketID).IsEnabled() {
+		return fmt.Errorf("market %d uses external settlement", marketID)
+	}
+	return nil
+}
+
+// getExternalSettlement gets an external settlement from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getExternalSettlement(store storetypes.KVStore, marketID uint32, orderID uint64) (*exchange.ExternalSettlement, error) {
+	bz := store.Get(MakeKeyExternalSettlement(marketID, orderID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv exchange.ExternalSettlement
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal external settlement for order %d in market %d: %w", orderID, marketID, err)
+	}
+	return &rv, nil
+}
+
+// setExternalSettlement writes an external settlement (and its expiration index entry) to the store.
+func (k Keeper) setExternalSettlement(store storetypes.KVStore, settlement *exchange.ExternalSettlement) error {
+	bz, err := k.cdc.Marshal(settlement)
+	if err != nil {
+		return fmt.Errorf("error marshaling external settlement for order %d: %w", settlement.OrderId, err)
+	}
+	marketID := settlement.AskOrder.MarketId
+	store.Set(MakeKeyExternalSettlement(marketID, settlement.OrderId), bz)
+	store.Set(MakeIndexKeyExternalSettlementExpiration(settlement.ExpirationHeight, marketID, settlement.OrderId), []byte{})
+	return nil
+}
+
+// deleteExternalSettlement removes an external settlement (and its expiration index entry) from the store.
+func deleteExternalSettlement(store storetypes.KVStore, settlement *exchange.ExternalSettlement) {
+	marketID := settlement.AskOrder.MarketId
+	store.Delete(MakeKeyExternalSettlement(marketID, settlement.OrderId))
+	store.Delete(MakeIndexKeyExternalSettlementExpiration(settlement.ExpirationHeight, marketID, settlement.OrderId))
+}
+
+// getMarketExternalSettlements gets all of a market's pending external settlements.
+func (k Keeper) getMarketExternalSettlements(store storetypes.KVStore, marketID uint32) []exchange.ExternalSettlement {
+	var rv []exchange.ExternalSettlement
+	iterate(store, GetKeyPrefixMarketExternalSettlements(marketID), func(_, value []byte) bool {
+		var settlement exchange.ExternalSettlement
+		if err := k.cdc.Unmarshal(value, &settlement); err == nil {
+			rv = append(rv, settlement)
+		}
+		return false
+	})
+	return rv
+}
+
+// GetMarketExternalSettlements gets a market's external settlement config and pending external settlements.
+// Returns nil if the market does not use external settlement and has nothing pending.
+func (k Keeper) GetMarketExternalSettlements(ctx sdk.Context, marketID uint32) *exchange.MarketExternalSettlements {
+	store := k.getStore(ctx)
+	rv := &exchange.MarketExternalSettlements{
+		MarketId: marketID,
+		Config:   k.getExternalSettlementConfig(store, marketID),
+		Pending:  k.getMarketExternalSettlements(store, marketID),
+	}
+	if !rv.Config.IsEnabled() && len(rv.Pending) == 0 {
+		return nil
+	}
+	return rv
+}
+
+// IterateMarketExternalSettlements iterates over the external settlement info of each market that uses it.
+// The callback should return false to continue iteration, or true to stop.
+func (k Keeper) IterateMarketExternalSettlements(ctx sdk.Context, cb func(extSet exchange.MarketExternalSettlements) bool) {
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		extSet := k.GetMarketExternalSettlements(ctx, marketID)
+		return extSet != nil && cb(*extSet)
+	})
+}
+
+// initMarketExternalSettlements stores all the provided external settlement info (e.g. from genesis).
+func (k Keeper) initMarketExternalSettlements(store storetypes.KVStore, extSet exchange.MarketExternalSettlements) error {
+	if err := k.setExternalSettlementConfig(store, extSet.MarketId, extSet.Config); err != nil {
+		return err
+	}
+	for i := range extSet.Pending {
+		if err := k.setExternalSettlement(store, &extSet.Pending[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateMarketExternalSettlement sets a market's external settlement config.
+// Any pending external settlements are left alone and will expire if not confirmed.
+func (k Keeper) UpdateMarketExternalSettlement(ctx sdk.Context, marketID uint32, config exchange.ExternalSettlementConfig, updatedBy string) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return err
+	}
+	if err := k.setExternalSettlementConfig(store, marketID, config); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, exchange.NewEventMarketExternalSettlementUpdated(marketID, updatedBy))
+	return nil
+}
+
+// StartExternalSettlement removes an ask order from the order book, and creates an external settlement for it.
+// The order's funds stay on hold until an oracle confirms the buyer's payment, or the settlement expires.
+// The caller is responsible for making sure this should be allowed (e.g. by calling CanSettleOrders first).
+func (k Keeper) StartExternalSettlement(ctx sdk.Context, marketID uint32, orderID uint64, buyer string) (*exchange.ExternalSettlement, error) {
+	store := k.getStore(ctx)
+	config := k.getExternalSettlementConfig(store, marketID)
+	if !config.IsEnabled() {
+		return nil, fmt.Errorf("market %d does not use external settlement", marketID)
+	}
+
+	order, err := k.getOrderFromStore(store, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, fmt.Errorf("order %d not found", orderID)
+	}
+	if order.GetMarketID() != marketID {
+		return nil, fmt.Errorf("order %d market id %d does not equal requested market id %d", orderID, order.GetMarketID(), marketID)
+	}
+	if !order.IsAskOrder() {
+		return nil, fmt.Errorf("order %d is type %s: expected ask", orderID, order.GetOrderType())
+	}
+
+	buyerAddr, err := sdk.AccAddressFromBech32(buyer)
+	if err != nil {
+		return nil, fmt.Errorf("invalid buyer %q: %w", buyer, err)
+	}
+	if k.bankKeeper.BlockedAddr(buyerAddr) {
+		return nil, fmt.Errorf("%s is not allowed to receive funds", buyerAddr)
+	}
+	if err = k.validateUserCanCreateBid(ctx, marketID, buyerAddr); err != nil {
+		return nil, err
+	}
+
+	settlement := &exchange.ExternalSettlement{
+		OrderId:          orderID,
+		AskOrder:         *order.GetAskOrder(),
+		Buyer:            buyer,
+		ExpirationHeight: ctx.BlockHeight() + config.TimeoutBlocks,
+	}
+	deleteAndDeIndexOrder(store, *order)
+	if err = k.setExternalSettlement(store, settlement); err != nil {
+		return nil, err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventExternalSettlementStarted(settlement))
+	return settlement, nil
+}
+
+// ConfirmExternalPayment completes an external settlement after an oracle confirms that the buyer paid.
+// The hold on the ask order's funds is released, the assets are sent to the buyer, the seller's
+// settlement flat fee (if there is one) is collected, and a settlement receipt is created.
+func (k Keeper) ConfirmExternalPayment(ctx sdk.Context, marketID uint32, orderID uint64, oracle, paymentRef string) error {
+	store := k.getStore(ctx)
+	if !k.getExternalSettlementConfig(store, marketID).HasOracle(oracle) {
+		return fmt.Errorf("account %s is not an oracle for market %d", oracle, marketID)
+	}
+
+	settlement, err := k.getExternalSettlement(store, marketID, orderID)
+	if err != nil {
+		return err
+	}
+	if settlement == nil {
+		return fmt.Errorf("no external settlement found for order %d in market %d", orderID, marketID)
+	}
+
+	ask := settlement.AskOrder
+	if err = k.releaseHoldOnOrder(ctx, exchange.NewOrder(orderID).WithAsk(&ask)); err != nil {
+		return err
+	}
+
+	assets := sdk.Coins{ask.Assets}
+	inputs := []banktypes.Input{{Address: ask.Seller, Coins: assets}}
+	outputs := []banktypes.Output{{Address: settlement.Buyer, Coins: assets}}
+	if err = k.DoTransfer(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	if ask.SellerSettlementFlatFee != nil {
+		seller := sdk.MustAccAddressFromBech32(ask.Seller)
+		if err = k.CollectFee(ctx, marketID, seller, sdk.Coins{*ask.SellerSettlementFlatFee}); err != nil {
+			return fmt.Errorf("error collecting seller settlement fee %q: %w", ask.SellerSettlementFlatFee, err)
+		}
+	}
+
+	deleteExternalSettlement(store, settlement)
+	filled := exchange.NewOrder(orderID).WithAsk(&ask)
+	receiptID, err := k.createSettlementReceipt(ctx, store, filled, []string{settlement.Buyer}, false, nil)
+	if err != nil {
+		return err
+	}
+	k.emitEvent(ctx, exchange.NewEventExternalSettlementConfirmed(settlement, paymentRef, oracle, receiptID))
+	return nil
+}
+
+// expireExternalSettlement cancels an external settlement, releasing the hold on the ask order's funds.
+func (k Keeper) expireExternalSettlement(ctx sdk.Context, settlement *exchange.ExternalSettlement) error {
+	ask := settlement.AskOrder
+	if err := k.releaseHoldOnOrder(ctx, exchange.NewOrder(settlement.OrderId).WithAsk(&ask)); err != nil {
+		return err
+	}
+	deleteExternalSettlement(k.getStore(ctx), settlement)
+	k.emitEvent(ctx, exchange.NewEventExternalSettlementExpired(settlement))
+	return nil
+}
+
+// ExpireExternalSettlements cancels all the external settlements that have reached their expiration height.
+// Errors are logged but do not stop the processing of other settlements.
+func (k Keeper) ExpireExternalSettlements(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := k.getStore(ctx)
+
+	type settlementID struct {
+		marketID uint32
+		orderID  uint64
+	}
+	var expired []settlementID
+	iterate(store, GetIndexKeyPrefixExternalSettlementExpiration(), func(keySuffix, _ []byte) bool {
+		expHeight, marketID, orderID, err := ParseIndexKeySuffixExternalSettlementExpiration(keySuffix)
+		if err != nil {
+			return false
+		}
+		if expHeight > height {
+			return true
+		}
+		expired = append(expired, settlementID{marketID: marketID, orderID: orderID})
+		return false
+	})
+
+	var errs []error
+	for _, id := range expired {
+		settlement, err := k.getExternalSettlement(store, id.marketID, id.orderID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if settlement == nil {
+			continue
+		}
+
+		// Use a cache context so that a failed expiration doesn't leave anything half done.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.expireExternalSettlement(cacheCtx, settlement); err != nil {
+			errs = append(errs, fmt.Errorf("error expiring external settlement for order %d in market %d: %w",
+				id.orderID, id.marketID, err))
+			continue
+		}
+		writeCache()
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered expiring external settlements:\n%v", len(errs), errors.Join(errs...))
+	}
+}
@@ -50,6 +50,9 @@ func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) erro
 	if err := validateAcceptingOrdersAndCanUserSettle(store, marketID); err != nil {
 		return err
 	}
+	if err := k.validateNotExternallySettled(store, marketID); err != nil {
+		return err
+	}
 	seller := sdk.MustAccAddressFromBech32(msg.Seller)
 	if err := k.validateUserCanCreateAsk(ctx, marketID, seller); err != nil {
 		return err
@@ -119,6 +122,9 @@ func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) erro
 	}
 	settlement.FeeInputs = feeAddrIdx.GetAsInputs()
 
+	if tripped, err := k.checkPriceBand(ctx, store, marketID, exchange.GetNAVs(settlement)); err != nil || tripped {
+		return err
+	}
 	if err := k.closeSettlement(ctx, store, marketID, settlement); err != nil {
 		return err
 	}
@@ -146,6 +152,9 @@ func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) erro
 	if err := validateAcceptingOrdersAndCanUserSettle(store, marketID); err != nil {
 		return err
 	}
+	if err := k.validateNotExternallySettled(store, marketID); err != nil {
+		return err
+	}
 	buyer := sdk.MustAccAddressFromBech32(msg.Buyer)
 	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
 		return err
@@ -210,6 +219,9 @@ func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) erro
 	}
 	settlement.FeeInputs = feeAddrIdx.GetAsInputs()
 
+	if tripped, err := k.checkPriceBand(ctx, store, marketID, exchange.GetNAVs(settlement)); err != nil || tripped {
+		return err
+	}
 	if err := k.closeSettlement(ctx, store, marketID, settlement); err != nil {
 		return err
 	}
@@ -236,6 +248,9 @@ func (k Keeper) SettleOrders(ctx sdk.Context, req *exchange.MsgMarketSettleReque
 	if err := validateMarketExists(store, req.MarketId); err != nil {
 		return err
 	}
+	if err := k.validateNotExternallySettled(store, req.MarketId); err != nil {
+		return err
+	}
 
 	askOrders, aoerr := k.getAskOrders(store, req.MarketId, req.AskOrderIds, "")
 	bidOrders, boerr := k.getBidOrders(store, req.MarketId, req.BidOrderIds, "")
@@ -258,8 +273,15 @@ func (k Keeper) SettleOrders(ctx sdk.Context, req *exchange.MsgMarketSettleReque
 	if req.ExpectPartial && settlement.PartialOrderFilled == nil {
 		return errors.New("settlement unexpectedly resulted in all orders fully filled")
 	}
+	settlement.NetTransfers = req.NetTransfers
+
+	if !req.OverridePriceBand {
+		if tripped, err := k.checkPriceBand(ctx, store, req.MarketId, exchange.GetNAVs(settlement)); err != nil || tripped {
+			return err
+		}
+	}
 
-	return k.closeSettlement(markertypes.WithTransferAgents(ctx, admin), store, req.MarketId, settlement)
+	return k.closeSettlement(markertypes.AddTransferAgents(ctx, admin), store, req.MarketId, settlement)
 }
 
 // closeSettlement does all the processing needed to complete a settlement.
@@ -282,7 +304,11 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 	}
 
 	// Transfer all the things!!!!
-	for _, transfer := range settlement.Transfers {
+	transfers := settlement.Transfers
+	if settlement.NetTransfers {
+		transfers = exchange.NetTransfers(transfers)
+	}
+	for _, transfer := range transfers {
 		if err := k.DoTransfer(ctx, transfer.Inputs, transfer.Outputs); err != nil {
 			errs = append(errs, err)
 		}
@@ -297,8 +323,17 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 		return errors.Join(errs...)
 	}
 
-	// Update the partial order if there was one.
-	if settlement.PartialOrderLeft != nil {
+	// Update the partial order if there was one, or cancel what's left of it if it's fill-or-kill.
+	var killed *exchange.Order
+	switch {
+	case settlement.PartialOrderLeft == nil:
+	case settlement.PartialOrderLeft.IsFillOrKill():
+		killed = settlement.PartialOrderLeft
+		if err := k.releaseHoldOnOrder(ctx, killed); err != nil {
+			return err
+		}
+		deleteAndDeIndexOrder(store, *killed)
+	default:
 		if err := k.setOrderInStore(store, *settlement.PartialOrderLeft); err != nil {
 			return fmt.Errorf("could not update partial %s order %d: %w",
 				settlement.PartialOrderLeft.GetOrderType(), settlement.PartialOrderLeft.OrderId, err)
@@ -309,18 +344,33 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 		deleteAndDeIndexOrder(store, *order.GetOriginalOrder())
 	}
 
-	// Emit all the needed events.
-	events := make([]proto.Message, 0, len(settlement.FullyFilledOrders)+1)
+	// Create a receipt for each fill and emit all the needed events.
+	navs := exchange.GetNAVs(settlement)
+	if err := k.recordLastSettlementPrices(store, marketID, navs); err != nil {
+		return err
+	}
+	events := make([]proto.Message, 0, len(settlement.FullyFilledOrders)+2)
 	for _, order := range settlement.FullyFilledOrders {
-		events = append(events, exchange.NewEventOrderFilled(order))
+		receiptID, err := k.createSettlementReceipt(ctx, store, order, getCounterparties(settlement, order.GetOwner()), false, navs)
+		if err != nil {
+			return err
+		}
+		events = append(events, exchange.NewEventOrderFilled(order, receiptID))
 	}
 	if settlement.PartialOrderFilled != nil {
-		events = append(events, exchange.NewEventOrderPartiallyFilled(settlement.PartialOrderFilled))
+		order := settlement.PartialOrderFilled
+		receiptID, err := k.createSettlementReceipt(ctx, store, order, getCounterparties(settlement, order.GetOwner()), true, navs)
+		if err != nil {
+			return err
+		}
+		events = append(events, exchange.NewEventOrderPartiallyFilled(order, receiptID))
+	}
+	if killed != nil {
+		events = append(events, exchange.NewEventOrderCancelled(killed, exchange.GetMarketAddress(marketID).String()))
 	}
 	k.emitEvents(ctx, events)
 
 	// Record the NAVs
-	navs := exchange.GetNAVs(settlement)
 	k.recordNAVs(ctx, marketID, navs)
 
 	return nil
@@ -99,7 +99,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{1},
 			},
 			expErr:       "account " + s.addr1.String() + " is not allowed to create ask orders in market 1",
-			expAttrCalls: AttributeCalls{GetAllAttributesAddr: [][]byte{s.addr1}},
+			expAttrCalls: AttributeCalls{GetAllAttributesAddrWithMirror: [][]byte{s.addr1}},
 		},
 		{
 			name: "not enough creation fee",
@@ -454,7 +454,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expErr: "error collecting create-ask fee \"2fig\": error transferring 2fig from " + s.addr4.String() +
 				" to market 2: another error for testing",
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 99, Assets: "1apple", Price: "6plum", MarketId: 2},
+				{OrderId: 99, Assets: "1apple", Price: "6plum", MarketId: 2, ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("6plum")}}},
 			expBankCalls: BankCalls{
@@ -494,7 +494,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
 			expBankCalls: BankCalls{
@@ -531,7 +531,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			adlEvents:    sdk.Events{s.markerNavSetEvent("12apple", "60plum", 6)},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
@@ -562,7 +562,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			adlEvents:    sdk.Events{s.markerNavSetEvent("12apple", "60plum", 6)},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
@@ -594,7 +594,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "184467440737095516150apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "184467440737095516150apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			adlEvents:    sdk.Events{s.markerNavSetEvent("184467440737095516150apple", "60plum", 6)},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
@@ -628,7 +628,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
 			expBankCalls: BankCalls{
@@ -677,7 +677,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				AskOrderCreationFee:     s.coinP("15fig"),
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", Fees: "10fig", MarketId: 3, ExternalId: "thirteen"},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", Fees: "10fig", MarketId: 3, ExternalId: "thirteen", ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("10fig,60plum")}}},
 			expBankCalls: BankCalls{
@@ -739,9 +739,9 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{55, 121, 17},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 55, Assets: "5acorn", Price: "50prune", MarketId: 3, Fees: "22fig"},
-				{OrderId: 121, Assets: "6apple", Price: "33prune", MarketId: 3},
-				{OrderId: 17, Assets: "12apple", Price: "60plum", MarketId: 3},
+				{OrderId: 55, Assets: "5acorn", Price: "50prune", MarketId: 3, Fees: "22fig", ReceiptId: 1},
+				{OrderId: 121, Assets: "6apple", Price: "33prune", MarketId: 3, ReceiptId: 2},
+				{OrderId: 17, Assets: "12apple", Price: "60plum", MarketId: 3, ReceiptId: 3},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
 				{addr: s.addr2, funds: s.coins("22fig,50prune")},
@@ -957,7 +957,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{1},
 			},
 			expErr:       "account " + s.addr1.String() + " is not allowed to create bid orders in market 1",
-			expAttrCalls: AttributeCalls{GetAllAttributesAddr: [][]byte{s.addr1}},
+			expAttrCalls: AttributeCalls{GetAllAttributesAddrWithMirror: [][]byte{s.addr1}},
 		},
 		{
 			name: "not enough creation fee",
@@ -1317,7 +1317,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expErr: "error collecting create-ask fee \"2fig\": error transferring 2fig from " + s.addr4.String() +
 				" to market 2: another error for testing",
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 99, Assets: "1apple", Price: "6plum", MarketId: 2},
+				{OrderId: 99, Assets: "1apple", Price: "6plum", MarketId: 2, ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("1apple")}}},
 			expBankCalls: BankCalls{
@@ -1357,7 +1357,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
 			expBankCalls: BankCalls{
@@ -1394,7 +1394,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			adlEvents:    sdk.Events{s.markerNavSetEvent("12apple", "60plum", 6)},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
@@ -1425,7 +1425,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			adlEvents:    sdk.Events{s.markerNavSetEvent("12apple", "60plum", 6)},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
@@ -1456,7 +1456,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "184467440737095516150apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "184467440737095516150apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			adlEvents:    sdk.Events{s.markerNavSetEvent("184467440737095516150apple", "60plum", 6)},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("184467440737095516150apple")}}},
@@ -1490,7 +1490,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{13},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6, ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
 			expBankCalls: BankCalls{
@@ -1539,7 +1539,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				BidOrderCreationFee: s.coinP("15fig"),
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 13, Assets: "12apple", Price: "60plum", Fees: "8fig,2plum", MarketId: 3, ExternalId: "thirteen"},
+				{OrderId: 13, Assets: "12apple", Price: "60plum", Fees: "8fig,2plum", MarketId: 3, ExternalId: "thirteen", ReceiptId: 1},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple,8fig")}}},
 			expBankCalls: BankCalls{
@@ -1601,9 +1601,9 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{55, 121, 17},
 			},
 			expEvents: []*exchange.EventOrderFilled{
-				{OrderId: 55, Assets: "5acorn", Price: "50prune", MarketId: 3, Fees: "22fig,2prune"},
-				{OrderId: 121, Assets: "6apple", Price: "33prune", MarketId: 3, Fees: "2prune"},
-				{OrderId: 17, Assets: "12apple", Price: "60prune", MarketId: 3, Fees: "3prune"},
+				{OrderId: 55, Assets: "5acorn", Price: "50prune", MarketId: 3, Fees: "22fig,2prune", ReceiptId: 1},
+				{OrderId: 121, Assets: "6apple", Price: "33prune", MarketId: 3, Fees: "2prune", ReceiptId: 2},
+				{OrderId: 17, Assets: "12apple", Price: "60prune", MarketId: 3, Fees: "3prune", ReceiptId: 3},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
 				{addr: s.addr2, funds: s.coins("5acorn,22fig")},
@@ -1744,6 +1744,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 		askOrderIDs    []uint64
 		bidOrderIDs    []uint64
 		expectPartial  bool
+		netTransfers   bool
 		expErr         string
 		expEvents      []proto.Message
 		adlEvents      sdk.Events
@@ -2010,8 +2011,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 2},
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
@@ -2054,8 +2055,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: scopeID1.Coin().String(), Price: "5peach", MarketId: 1},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: scopeID1.Coin().String(), Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: scopeID1.Coin().String(), Price: "5peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: scopeID1.Coin().String(), Price: "5peach", MarketId: 1, ReceiptId: 2},
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
@@ -2098,8 +2099,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 2},
 			},
 			adlEvents: sdk.Events{s.markerNavSetEvent("1apple", "5peach", 1)},
 			expHoldCalls: HoldCalls{
@@ -2137,8 +2138,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 2},
 			},
 			adlEvents: sdk.Events{s.markerNavSetEvent("1apple", "5peach", 1)},
 			expHoldCalls: HoldCalls{
@@ -2177,8 +2178,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "184467440737095516150apple", Price: "5peach", MarketId: 1},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: "184467440737095516150apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "184467440737095516150apple", Price: "5peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "184467440737095516150apple", Price: "5peach", MarketId: 1, ReceiptId: 2},
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
@@ -2216,8 +2217,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 2},
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
@@ -2268,8 +2269,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{5},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "5peach"},
-				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "15peach"},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "5peach", ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "10apple", Price: "50peach", MarketId: 1, Fees: "15peach", ReceiptId: 2},
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
@@ -2332,11 +2333,11 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			expEvents: []proto.Message{
 				&exchange.EventOrderFilled{
 					OrderId: 2, Assets: "7apple", Price: "40peach",
-					MarketId: 1, ExternalId: "the-bid-order",
+					MarketId: 1, ExternalId: "the-bid-order", ReceiptId: 1,
 				},
 				&exchange.EventOrderPartiallyFilled{
 					OrderId: 1, Assets: "7apple", Price: "40peach", Fees: "14fig",
-					MarketId: 1, ExternalId: "the-ask-order",
+					MarketId: 1, ExternalId: "the-ask-order", ReceiptId: 2,
 				},
 			},
 			expPartialLeft: exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
@@ -2370,6 +2371,69 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				},
 			},
 		},
+		{
+			name:         "one ask one bid: partial fill-or-kill ask",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.k.SetParams(s.ctx, &exchange.Params{})
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("10apple"), Price: s.coin("50peach"), MarketId: 1, Seller: s.addr5.String(),
+					SellerSettlementFlatFee: s.coinP("20fig"),
+					ExternalId:              "the-ask-order",
+					AllowPartial:            true,
+					FillOrKill:              true,
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					Assets: s.coin("7apple"), Price: s.coin("40peach"), MarketId: 1, Buyer: s.addr3.String(),
+					ExternalId: "the-bid-order",
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1},
+			bidOrderIDs:   []uint64{2},
+			expectPartial: true,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{
+					OrderId: 2, Assets: "7apple", Price: "40peach",
+					MarketId: 1, ExternalId: "the-bid-order", ReceiptId: 1,
+				},
+				&exchange.EventOrderPartiallyFilled{
+					OrderId: 1, Assets: "7apple", Price: "40peach", Fees: "14fig",
+					MarketId: 1, ExternalId: "the-ask-order", ReceiptId: 2,
+				},
+				&exchange.EventOrderCancelled{
+					OrderId: 1, CancelledBy: s.marketAddr1.String(),
+					MarketId: 1, ExternalId: "the-ask-order",
+				},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("40peach")},
+					{addr: s.addr5, funds: s.coins("14fig,7apple")},
+					{addr: s.addr5, funds: s.coins("6fig,3apple")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr3, s.addr5},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr5, toAddr: s.addr3, amt: s.coins("7apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr5, amt: s.coins("40peach")},
+					{fromAddr: s.addr5, toAddr: s.marketAddr1, amt: s.coins("14fig")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("40peach"), Volume: 7}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
 		{
 			name:         "one ask one bid: partial bid",
 			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
@@ -2395,11 +2459,11 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			expEvents: []proto.Message{
 				&exchange.EventOrderFilled{
 					OrderId: 1, Assets: "7apple", Price: "35peach",
-					MarketId: 1, ExternalId: "the-ask-order",
+					MarketId: 1, ExternalId: "the-ask-order", ReceiptId: 1,
 				},
 				&exchange.EventOrderPartiallyFilled{
 					OrderId: 2, Assets: "7apple", Price: "35peach", Fees: "14fig",
-					MarketId: 1, ExternalId: "the-bid-order",
+					MarketId: 1, ExternalId: "the-bid-order", ReceiptId: 2,
 				},
 			},
 			expPartialLeft: exchange.NewOrder(2).WithBid(&exchange.BidOrder{
@@ -2461,11 +2525,11 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			bidOrderIDs:   []uint64{7, 6, 88},
 			expectPartial: false,
 			expEvents: []proto.Message{
-				&exchange.EventOrderFilled{OrderId: 77, Assets: "75apple", Price: "50peach", MarketId: 2},
-				&exchange.EventOrderFilled{OrderId: 1, Assets: "25apple", Price: "100peach", MarketId: 2},
-				&exchange.EventOrderFilled{OrderId: 7, Assets: "30apple", Price: "60peach", MarketId: 2},
-				&exchange.EventOrderFilled{OrderId: 6, Assets: "20apple", Price: "40peach", MarketId: 2},
-				&exchange.EventOrderFilled{OrderId: 88, Assets: "50apple", Price: "50peach", MarketId: 2},
+				&exchange.EventOrderFilled{OrderId: 77, Assets: "75apple", Price: "50peach", MarketId: 2, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "25apple", Price: "100peach", MarketId: 2, ReceiptId: 2},
+				&exchange.EventOrderFilled{OrderId: 7, Assets: "30apple", Price: "60peach", MarketId: 2, ReceiptId: 3},
+				&exchange.EventOrderFilled{OrderId: 6, Assets: "20apple", Price: "40peach", MarketId: 2, ReceiptId: 4},
+				&exchange.EventOrderFilled{OrderId: 88, Assets: "50apple", Price: "50peach", MarketId: 2, ReceiptId: 5},
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
@@ -2518,6 +2582,62 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				},
 			},
 		},
+		{
+			name:         "two asks two bids: reciprocal parties, netted",
+			markerKeeper: NewMockMarkerKeeper().WithGetMarkerAccount(appleMarker),
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("2apple"), Price: s.coin("10peach"), MarketId: 1, Seller: s.addr3.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("1apple"), Price: s.coin("5peach"), MarketId: 1, Seller: s.addr4.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(5).WithBid(&exchange.BidOrder{
+					Assets: s.coin("2apple"), Price: s.coin("10peach"), MarketId: 1, Buyer: s.addr4.String(),
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(6).WithBid(&exchange.BidOrder{
+					Assets: s.coin("1apple"), Price: s.coin("5peach"), MarketId: 1, Buyer: s.addr3.String(),
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{1, 2},
+			bidOrderIDs:   []uint64{5, 6},
+			expectPartial: false,
+			netTransfers:  true,
+			expEvents: []proto.Message{
+				&exchange.EventOrderFilled{OrderId: 1, Assets: "2apple", Price: "10peach", MarketId: 1, ReceiptId: 1},
+				&exchange.EventOrderFilled{OrderId: 2, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 2},
+				&exchange.EventOrderFilled{OrderId: 5, Assets: "2apple", Price: "10peach", MarketId: 1, ReceiptId: 3},
+				&exchange.EventOrderFilled{OrderId: 6, Assets: "1apple", Price: "5peach", MarketId: 1, ReceiptId: 4},
+			},
+			expHoldCalls: HoldCalls{
+				ReleaseHold: []*ReleaseHoldArgs{
+					{addr: s.addr3, funds: s.coins("2apple")},
+					{addr: s.addr4, funds: s.coins("1apple")},
+					{addr: s.addr4, funds: s.coins("10peach")},
+					{addr: s.addr3, funds: s.coins("5peach")},
+				},
+			},
+			expBankCalls: BankCalls{
+				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr3},
+				SendCoins: []*SendCoinsArgs{
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr3, toAddr: s.addr4, amt: s.coins("1apple")},
+					{ctxHasQuarantineBypass: true, fromAddr: s.addr4, toAddr: s.addr3, amt: s.coins("5peach")},
+				},
+			},
+			expMarkerCalls: MarkerCalls{
+				GetMarker: []sdk.AccAddress{appleMarker.GetAddress()},
+				AddSetNetAssetValues: []*AddSetNetAssetValuesArgs{
+					{
+						marker:         appleMarker,
+						netAssetValues: []markertypes.NetAssetValue{{Price: s.coin("15peach"), Volume: 3}},
+						source:         "x/exchange market 1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2564,6 +2684,7 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				AskOrderIds:   tc.askOrderIDs,
 				BidOrderIds:   tc.bidOrderIDs,
 				ExpectPartial: tc.expectPartial,
+				NetTransfers:  tc.netTransfers,
 			}
 
 			em := sdk.NewEventManager()
@@ -2595,6 +2716,26 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				return
 			}
 
+			// Make sure there's a receipt for each fill.
+			for _, ev := range tc.expEvents {
+				var orderID, receiptID uint64
+				var partial bool
+				switch e := ev.(type) {
+				case *exchange.EventOrderFilled:
+					orderID, receiptID = e.OrderId, e.ReceiptId
+				case *exchange.EventOrderPartiallyFilled:
+					orderID, receiptID, partial = e.OrderId, e.ReceiptId, true
+				default:
+					continue
+				}
+				receipt, rerr := s.k.GetSettlementReceipt(s.ctx, receiptID)
+				if s.Assert().NoError(rerr, "GetSettlementReceipt(%d) after SettleOrders", receiptID) &&
+					s.Assert().NotNil(receipt, "GetSettlementReceipt(%d) after SettleOrders", receiptID) {
+					s.Assert().Equal(int(orderID), int(receipt.OrderId), "receipt %d OrderId", receiptID)
+					s.Assert().Equal(partial, receipt.Partial, "receipt %d Partial", receiptID)
+				}
+			}
+
 			for _, orderID := range tc.askOrderIDs {
 				if tc.expPartialLeft == nil || tc.expPartialLeft.OrderId != orderID {
 					order, oerr := s.k.GetOrder(s.ctx, orderID)
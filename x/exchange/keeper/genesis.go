@@ -69,6 +69,62 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		recordHold(payment.Source, payment.SourceAmount)
 	}
 
+	for i, rebates := range genState.MarketRebates {
+		if err := k.initMarketRebates(store, rebates); err != nil {
+			panic(fmt.Errorf("failed to store MarketRebates[%d]: %w", i, err))
+		}
+	}
+
+	for i, extSet := range genState.MarketExternalSettlements {
+		if err := k.initMarketExternalSettlements(store, extSet); err != nil {
+			panic(fmt.Errorf("failed to store MarketExternalSettlements[%d]: %w", i, err))
+		}
+		for _, pending := range extSet.Pending {
+			recordHold(pending.AskOrder.Seller, exchange.NewOrder(pending.OrderId).WithAsk(&pending.AskOrder).GetHoldAmount())
+		}
+	}
+
+	var maxReceiptID uint64
+	for i := range genState.SettlementReceipts {
+		receipt := &genState.SettlementReceipts[i]
+		if err := k.setSettlementReceipt(store, receipt); err != nil {
+			panic(fmt.Errorf("failed to store SettlementReceipts[%d]: %w", i, err))
+		}
+		if receipt.ReceiptId > maxReceiptID {
+			maxReceiptID = receipt.ReceiptId
+		}
+	}
+
+	if genState.LastReceiptId < maxReceiptID {
+		panic(fmt.Errorf("last receipt id %d is less than largest receipt id %d", genState.LastReceiptId, maxReceiptID))
+	}
+	setLastReceiptID(store, genState.LastReceiptId)
+
+	var maxRFQID uint64
+	for i := range genState.Rfqs {
+		rfq := &genState.Rfqs[i]
+		if err := k.setRFQ(store, rfq); err != nil {
+			panic(fmt.Errorf("failed to store Rfqs[%d]: %w", i, err))
+		}
+		for _, quote := range rfq.Quotes {
+			recordHold(quote.Dealer, rfq.GetQuoteHoldAmount(quote))
+		}
+		if rfq.RfqId > maxRFQID {
+			maxRFQID = rfq.RfqId
+		}
+	}
+
+	if genState.LastRfqId < maxRFQID {
+		panic(fmt.Errorf("last rfq id %d is less than largest rfq id %d", genState.LastRfqId, maxRFQID))
+	}
+	setLastRFQID(store, genState.LastRfqId)
+
+	for i, bands := range genState.MarketPriceBands {
+		if err := k.initMarketPriceBands(store, bands); err != nil {
+			panic(fmt.Errorf("failed to store MarketPriceBands[%d]: %w", i, err))
+		}
+	}
+
 	// Make sure all the needed funds have holds on them. These should have been placed during initialization of the hold module.
 	for _, addr := range holdAddrs {
 		for _, reqAmt := range holdAmounts[addr] {
@@ -87,9 +143,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 	store := k.getStore(ctx)
 	genState := &exchange.GenesisState{
-		Params:       k.GetParams(ctx),
-		LastMarketId: getLastAutoMarketID(store),
-		LastOrderId:  getLastOrderID(store),
+		Params:        k.GetParams(ctx),
+		LastMarketId:  getLastAutoMarketID(store),
+		LastOrderId:   getLastOrderID(store),
+		LastReceiptId: getLastReceiptID(store),
+		LastRfqId:     getLastRFQID(store),
 	}
 
 	k.IterateMarkets(ctx, func(market *exchange.Market) bool {
@@ -115,5 +173,30 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 		return false
 	})
 
+	k.IterateMarketRebates(ctx, func(rebates exchange.MarketRebates) bool {
+		genState.MarketRebates = append(genState.MarketRebates, rebates)
+		return false
+	})
+
+	k.IterateMarketExternalSettlements(ctx, func(extSet exchange.MarketExternalSettlements) bool {
+		genState.MarketExternalSettlements = append(genState.MarketExternalSettlements, extSet)
+		return false
+	})
+
+	k.IterateSettlementReceipts(ctx, func(receipt exchange.SettlementReceipt) bool {
+		genState.SettlementReceipts = append(genState.SettlementReceipts, receipt)
+		return false
+	})
+
+	k.IterateRFQs(ctx, func(rfq exchange.RequestForQuote) bool {
+		genState.Rfqs = append(genState.Rfqs, rfq)
+		return false
+	})
+
+	k.IterateMarketPriceBands(ctx, func(bands exchange.MarketPriceBands) bool {
+		genState.MarketPriceBands = append(genState.MarketPriceBands, bands)
+		return false
+	})
+
 	return genState
 }
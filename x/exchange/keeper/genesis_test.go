@@ -114,6 +114,21 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 			ExternalId:   externalID,
 		}
 	}
+	receipt := func(receiptID uint64, marketID uint32) exchange.SettlementReceipt {
+		return exchange.SettlementReceipt{
+			ReceiptId:      receiptID,
+			MarketId:       marketID,
+			OrderId:        receiptID * 10,
+			OrderType:      exchange.OrderTypeAsk,
+			Owner:          s.addr1.String(),
+			Counterparties: []string{s.addr2.String()},
+			Assets:         s.coin("5apple"),
+			Price:          s.coin("20peach"),
+			Fees:           s.coins("1fig"),
+			Navs:           []exchange.NetAssetPrice{{Assets: s.coin("5apple"), Price: s.coin("20peach")}},
+			Height:         int64(receiptID) + 100,
+		}
+	}
 
 	tests := []struct {
 		name         string
@@ -431,6 +446,21 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 			name:     "just last order id",
 			genState: &exchange.GenesisState{LastOrderId: 9},
 		},
+		{
+			name: "last receipt id too low",
+			genState: &exchange.GenesisState{
+				SettlementReceipts: []exchange.SettlementReceipt{receipt(3, 1), receipt(12, 2)},
+				LastReceiptId:      11,
+			},
+			expInitPanic: "last receipt id 11 is less than largest receipt id 12",
+		},
+		{
+			name: "some settlement receipts",
+			genState: &exchange.GenesisState{
+				SettlementReceipts: []exchange.SettlementReceipt{receipt(3, 1), receipt(12, 2), receipt(5, 1)},
+				LastReceiptId:      15,
+			},
+		},
 		{
 			name: "error reading orders",
 			setup: func() {
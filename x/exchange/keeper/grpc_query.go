@@ -219,6 +219,79 @@ func (k QueryServer) GetAllOrders(goCtx context.Context, req *exchange.QueryGetA
 	return resp, nil
 }
 
+// GetSettlementReceipt looks up a settlement receipt by id.
+func (k QueryServer) GetSettlementReceipt(goCtx context.Context, req *exchange.QueryGetSettlementReceiptRequest) (*exchange.QueryGetSettlementReceiptResponse, error) {
+	if req == nil || req.ReceiptId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receipt, err := k.Keeper.GetSettlementReceipt(ctx, req.ReceiptId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if receipt == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "settlement receipt %d not found", req.ReceiptId)
+	}
+
+	return &exchange.QueryGetSettlementReceiptResponse{Receipt: receipt}, nil
+}
+
+// GetRFQ looks up a request for quote (and its quotes) by its id.
+func (k QueryServer) GetRFQ(goCtx context.Context, req *exchange.QueryGetRFQRequest) (*exchange.QueryGetRFQResponse, error) {
+	if req == nil || req.RfqId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rfq, err := k.Keeper.GetRFQ(ctx, req.RfqId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if rfq == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rfq %d not found", req.RfqId)
+	}
+
+	return &exchange.QueryGetRFQResponse{Rfq: rfq}, nil
+}
+
+// GetMarketRFQs looks up the requests for quote in a market.
+func (k QueryServer) GetMarketRFQs(goCtx context.Context, req *exchange.QueryGetMarketRFQsRequest) (*exchange.QueryGetMarketRFQsResponse, error) {
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	kvStore := k.getStore(ctx)
+	pre := GetIndexKeyPrefixMarketToRFQ(req.MarketId)
+	store := prefix.NewStore(kvStore, pre)
+
+	resp := &exchange.QueryGetMarketRFQsResponse{}
+	var pageErr error
+	resp.Pagination, pageErr = query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		// If we can't get the rfq id from the key, just pretend like it doesn't exist.
+		rfqID, err := ParseIndexKeySuffixMarketToRFQ(key)
+		if err != nil {
+			return false, nil
+		}
+		if accumulate {
+			// Only add it to the result if we can read it. This might result in fewer results than the limit,
+			// but at least one bad entry won't block others by causing the whole thing to return an error.
+			rfq, err := k.getRFQ(kvStore, rfqID)
+			if err == nil && rfq != nil {
+				resp.Rfqs = append(resp.Rfqs, rfq)
+			}
+		}
+		return true, nil
+	})
+
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating rfqs for market %d: %v", req.MarketId, pageErr)
+	}
+
+	return resp, nil
+}
+
 // GetCommitment gets the funds in an account that are committed to the market.
 func (k QueryServer) GetCommitment(goCtx context.Context, req *exchange.QueryGetCommitmentRequest) (*exchange.QueryGetCommitmentResponse, error) {
 	if req == nil || len(req.Account) == 0 || req.MarketId == 0 {
@@ -250,15 +323,29 @@ func (k QueryServer) GetAccountCommitments(goCtx context.Context, req *exchange.
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	store := k.getStore(ctx)
+	marketIDStore := prefix.NewStore(store, GetKeyPrefixKnownMarketID())
+
 	resp := &exchange.QueryGetAccountCommitmentsResponse{}
-	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+	var pageErr error
+	resp.Pagination, pageErr = query.FilteredPaginate(marketIDStore, req.Pagination, func(keySuffix []byte, _ []byte, accumulate bool) (bool, error) {
+		marketID, ok := ParseKeySuffixKnownMarketID(keySuffix)
+		if !ok {
+			return false, nil
+		}
 		amount := getCommitmentAmount(store, marketID, addr)
-		if !amount.IsZero() {
+		if amount.IsZero() {
+			return false, nil
+		}
+		if accumulate {
 			resp.Commitments = append(resp.Commitments, &exchange.MarketAmount{MarketId: marketID, Amount: amount})
 		}
-		return false
+		return true, nil
 	})
 
+	if pageErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating commitments for account %s: %v", req.Account, pageErr)
+	}
+
 	return resp, nil
 }
 
@@ -337,6 +424,64 @@ func (k QueryServer) GetMarket(goCtx context.Context, req *exchange.QueryGetMark
 	return resp, nil
 }
 
+// GetMarketExternalSettlements returns a market's external settlement configuration and pending settlements.
+func (k QueryServer) GetMarketExternalSettlements(goCtx context.Context, req *exchange.QueryGetMarketExternalSettlementsRequest) (*exchange.QueryGetMarketExternalSettlementsResponse, error) {
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateMarketExists(k.getStore(ctx), req.MarketId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	settlements := k.Keeper.GetMarketExternalSettlements(ctx, req.MarketId)
+	if settlements == nil {
+		settlements = &exchange.MarketExternalSettlements{MarketId: req.MarketId}
+	}
+
+	return &exchange.QueryGetMarketExternalSettlementsResponse{Settlements: settlements}, nil
+}
+
+// GetMarketPriceBands returns a market's price band configuration, whether its settlements are paused, and the
+// last settlement prices of its assets.
+func (k QueryServer) GetMarketPriceBands(goCtx context.Context, req *exchange.QueryGetMarketPriceBandsRequest) (*exchange.QueryGetMarketPriceBandsResponse, error) {
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateMarketExists(k.getStore(ctx), req.MarketId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bands := k.Keeper.GetMarketPriceBands(ctx, req.MarketId)
+	if bands == nil {
+		bands = &exchange.MarketPriceBands{MarketId: req.MarketId}
+	}
+
+	return &exchange.QueryGetMarketPriceBandsResponse{PriceBands: bands}, nil
+}
+
+// GetMarketRebates returns a market's rebate configuration, pool, and the liquidity points earned so far.
+func (k QueryServer) GetMarketRebates(goCtx context.Context, req *exchange.QueryGetMarketRebatesRequest) (*exchange.QueryGetMarketRebatesResponse, error) {
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateMarketExists(k.getStore(ctx), req.MarketId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rebates := k.Keeper.GetMarketRebates(ctx, req.MarketId)
+	if rebates == nil {
+		rebates = &exchange.MarketRebates{MarketId: req.MarketId}
+	}
+
+	return &exchange.QueryGetMarketRebatesResponse{Rebates: rebates}, nil
+}
+
 // GetAllMarkets returns brief information about each market.
 func (k QueryServer) GetAllMarkets(goCtx context.Context, req *exchange.QueryGetAllMarketsRequest) (*exchange.QueryGetAllMarketsResponse, error) {
 	var pagination *query.PageRequest
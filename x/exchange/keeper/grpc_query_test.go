@@ -3129,6 +3129,77 @@ func (s *TestSuite) TestQueryServer_GetAllOrders() {
 	}
 }
 
+func (s *TestSuite) TestQueryServer_GetSettlementReceipt() {
+	testDef := queryTestDef[exchange.QueryGetSettlementReceiptRequest, exchange.QueryGetSettlementReceiptResponse]{
+		queryName: "GetSettlementReceipt",
+		query:     keeper.NewQueryServer(s.k).GetSettlementReceipt,
+	}
+
+	receipt := func(receiptID uint64) *exchange.SettlementReceipt {
+		return &exchange.SettlementReceipt{
+			ReceiptId:      receiptID,
+			MarketId:       3,
+			OrderId:        receiptID + 40,
+			OrderType:      exchange.OrderTypeBid,
+			Owner:          s.addr1.String(),
+			Counterparties: []string{s.addr2.String(), s.addr3.String()},
+			Assets:         s.coin("10apple"),
+			Price:          s.coin("55peach"),
+			Fees:           s.coins("3fig"),
+			Partial:        true,
+			ExternalId:     "some-external-id",
+			Navs:           []exchange.NetAssetPrice{{Assets: s.coin("15apple"), Price: s.coin("82peach")}},
+			Height:         88,
+		}
+	}
+
+	tests := []queryTestCase[exchange.QueryGetSettlementReceiptRequest, exchange.QueryGetSettlementReceiptResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "receipt 0",
+			req:      &exchange.QueryGetSettlementReceiptRequest{ReceiptId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name: "error reading receipt",
+			setup: func() {
+				s.getStore().Set(keeper.MakeKeySettlementReceipt(2), []byte{0xff})
+			},
+			req:      &exchange.QueryGetSettlementReceiptRequest{ReceiptId: 2},
+			expInErr: []string{invalidArgErr, "failed to unmarshal settlement receipt 2"},
+		},
+		{
+			name: "receipt not found",
+			setup: func() {
+				s.Require().NoError(s.k.SetSettlementReceipt(s.getStore(), receipt(1)), "SetSettlementReceipt 1")
+			},
+			req:      &exchange.QueryGetSettlementReceiptRequest{ReceiptId: 2},
+			expInErr: []string{invalidArgErr, "settlement receipt 2 not found"},
+		},
+		{
+			name: "receipt found",
+			setup: func() {
+				store := s.getStore()
+				s.Require().NoError(s.k.SetSettlementReceipt(store, receipt(1)), "SetSettlementReceipt 1")
+				s.Require().NoError(s.k.SetSettlementReceipt(store, receipt(2)), "SetSettlementReceipt 2")
+				s.Require().NoError(s.k.SetSettlementReceipt(store, receipt(3)), "SetSettlementReceipt 3")
+			},
+			req:     &exchange.QueryGetSettlementReceiptRequest{ReceiptId: 2},
+			expResp: &exchange.QueryGetSettlementReceiptResponse{Receipt: receipt(2)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestQueryServer_GetCommitment() {
 	testDef := queryTestDef[exchange.QueryGetCommitmentRequest, exchange.QueryGetCommitmentResponse]{
 		queryName: "GetCommitment",
@@ -3224,7 +3295,7 @@ func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
 				keeper.SetCommitmentAmount(store, 3, s.addr3, s.coins("33apple"))
 			},
 			req:     &exchange.QueryGetAccountCommitmentsRequest{Account: s.addr2.String()},
-			expResp: &exchange.QueryGetAccountCommitmentsResponse{},
+			expResp: &exchange.QueryGetAccountCommitmentsResponse{Pagination: &query.PageResponse{}},
 		},
 		{
 			name: "funds committed",
@@ -3250,6 +3321,50 @@ func (s *TestSuite) TestQueryServer_GetAccountCommitments() {
 					{MarketId: 2, Amount: s.coins("22apple,157banana,386cherry")},
 					{MarketId: 3, Amount: s.coins("32apple")},
 				},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "limit 1 skips markets without a commitment",
+			setup: func() {
+				store := s.getStore()
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				s.requireCreateMarket(exchange.Market{MarketId: 2})
+				s.requireCreateMarket(exchange.Market{MarketId: 3})
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
+			},
+			req: &exchange.QueryGetAccountCommitmentsRequest{
+				Account:    s.addr2.String(),
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			expResp: &exchange.QueryGetAccountCommitmentsResponse{
+				Commitments: []*exchange.MarketAmount{{MarketId: 1, Amount: s.coins("12apple")}},
+				Pagination:  &query.PageResponse{NextKey: []byte{0, 0, 0, 3}},
+			},
+		},
+		{
+			name: "reversed",
+			setup: func() {
+				store := s.getStore()
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				s.requireCreateMarket(exchange.Market{MarketId: 2})
+				s.requireCreateMarket(exchange.Market{MarketId: 3})
+				keeper.SetCommitmentAmount(store, 1, s.addr2, s.coins("12apple"))
+				keeper.SetCommitmentAmount(store, 2, s.addr1, s.coins("21apple"))
+				keeper.SetCommitmentAmount(store, 3, s.addr2, s.coins("32apple"))
+			},
+			req: &exchange.QueryGetAccountCommitmentsRequest{
+				Account:    s.addr2.String(),
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetAccountCommitmentsResponse{
+				Commitments: []*exchange.MarketAmount{
+					{MarketId: 3, Amount: s.coins("32apple")},
+					{MarketId: 1, Amount: s.coins("12apple")},
+				},
+				Pagination: &query.PageResponse{Total: 2},
 			},
 		},
 	}
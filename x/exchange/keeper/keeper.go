@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/quarantine"
 )
 
@@ -34,6 +35,7 @@ type Keeper struct {
 
 	accountKeeper  exchange.AccountKeeper
 	attrKeeper     exchange.AttributeKeeper
+	authzKeeper    exchange.AuthzKeeper
 	bankKeeper     exchange.BankKeeper
 	holdKeeper     exchange.HoldKeeper
 	markerKeeper   exchange.MarkerKeeper
@@ -44,7 +46,7 @@ type Keeper struct {
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, feeCollectorName string,
-	accountKeeper exchange.AccountKeeper, attrKeeper exchange.AttributeKeeper,
+	accountKeeper exchange.AccountKeeper, attrKeeper exchange.AttributeKeeper, authzKeeper exchange.AuthzKeeper,
 	bankKeeper exchange.BankKeeper, holdKeeper exchange.HoldKeeper, markerKeeper exchange.MarkerKeeper,
 	metadataKeeper exchange.MetadataKeeper,
 ) Keeper {
@@ -53,6 +55,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, feeCollector
 		storeKey:         storeKey,
 		accountKeeper:    accountKeeper,
 		attrKeeper:       attrKeeper,
+		authzKeeper:      authzKeeper,
 		bankKeeper:       bankKeeper,
 		holdKeeper:       holdKeeper,
 		markerKeeper:     markerKeeper,
@@ -199,9 +202,9 @@ func (k Keeper) iterate(ctx sdk.Context, keyPrefix []byte, cb func(keySuffix, va
 
 // DoTransfer facilitates a transfer of things using the bank module.
 func (k Keeper) DoTransfer(ctxIn sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
-	// We bypass the quarantine module here under the assumption that someone creating
+	// We bypass the quarantine module and marker receipts here under the assumption that someone creating
 	// an order counts as acceptance of the stuff to receive (that they defined when creating the order).
-	ctx := quarantine.WithBypass(ctxIn)
+	ctx := markertypes.WithReceiptBypass(quarantine.WithBypass(ctxIn))
 	if len(inputs) == 1 && len(outputs) == 1 {
 		// If there's only one of each, we use SendCoins for the nicer events.
 		if !inputs[0].Coins.Equal(outputs[0].Coins) {
@@ -259,6 +262,7 @@ func (k Keeper) CalculateExchangeSplit(ctx sdk.Context, feeAmt sdk.Coins) sdk.Co
 
 // CollectFee will transfer the fee amount to the market account,
 // then the exchange's cut from the market to the fee collector.
+// If the market pays rebates, some of what's left is then set aside in its rebate pool.
 // If you have fees to collect from multiple payers, consider using CollectFees.
 func (k Keeper) CollectFee(ctx sdk.Context, marketID uint32, payer sdk.AccAddress, fee sdk.Coins) error {
 	if fee.IsZero() {
@@ -275,12 +279,16 @@ func (k Keeper) CollectFee(ctx sdk.Context, marketID uint32, payer sdk.AccAddres
 			return fmt.Errorf("error collecting exchange fee %s (based off %s) from market %d: %w", exchangeSplit, fee, marketID, err)
 		}
 	}
+	if marketAmt, hasNeg := fee.SafeSub(exchangeSplit...); !hasNeg {
+		k.addToRebatePool(ctx, marketID, marketAmt)
+	}
 
 	return nil
 }
 
 // CollectFees will transfer the inputs to the market account,
 // then the exchange's cut from the market to the fee collector.
+// If the market pays rebates, some of what's left is then set aside in its rebate pool.
 // If there is only one input, CollectFee is used.
 func (k Keeper) CollectFees(ctx sdk.Context, marketID uint32, inputs []banktypes.Input) error {
 	if len(inputs) == 0 {
@@ -315,6 +323,9 @@ func (k Keeper) CollectFees(ctx sdk.Context, marketID uint32, inputs []banktypes
 			return fmt.Errorf("error collecting exchange fee %s (based off %s) from market %d: %w", exchangeAmt, feeAmt, marketID, err)
 		}
 	}
+	if marketAmt, hasNeg := feeAmt.SafeSub(exchangeAmt...); !hasNeg {
+		k.addToRebatePool(ctx, marketID, marketAmt)
+	}
 
 	return nil
 }
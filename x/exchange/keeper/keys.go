@@ -25,6 +25,10 @@ import (
 //   The payment flat fees are stored as string versions of the coins.
 //   Create Payment Flat: 0x00 | "fee_create_payment_flat" => string(coins)
 //   Accept Payment Flat: 0x00 | "fee_accept_payment_flat" => string(coins)
+//   The market issuers are stored as a comma-separated string of bech32 addresses.
+//   Market Issuers: 0x00 | "market_issuers" => string(addresses)
+//   Issuer Market Max Flat Fees: 0x00 | "issuer_market_max_flat_fees" => string(coins)
+//   Issuer Market Max Ratio Bips: 0x00 | "issuer_market_max_ratio_bips" => uint16
 //
 // Last Market ID: 0x06 => uint32
 //   This stores the last auto-selected market id.
@@ -33,6 +37,10 @@ import (
 //
 // Last Order ID: 0x08 => uint64
 //
+// Last Receipt ID: 0x12 => uint64
+//
+// Last RFQ ID: 0x13 => uint64
+//
 // Markets:
 //   Some aspects of a market are stored using the accounts module and the MarketAccount type.
 //   Others are stored in the exchange module.
@@ -53,6 +61,14 @@ import (
 //   Market Create-Commitment Flat Fee: 0x01 | <market_id> | 0x11 | <denom> => <amount> (string)
 //   Market Commitment Settlement Bips: 0x01 | <market_id> | 0x12 => uint16
 //   Market Intermediary Denom: 0x01 | <market_id> | 0x13 => <denom>
+//   Market Rebate Config: 0x01 | <market_id> | 0x14 => protobuf(MarketRebateConfig)
+//   Market Rebate Pool: 0x01 | <market_id> | 0x15 => <coins> (string)
+//   Market Rebate Last Payout Height: 0x01 | <market_id> | 0x16 => int64
+//   Market Liquidity Points: 0x01 | <market_id> | 0x17 | <addr len byte> | <address> => <points> (string)
+//   Market External Settlement Config: 0x01 | <market_id> | 0x18 => protobuf(ExternalSettlementConfig)
+//   Market Price Band Config: 0x01 | <market_id> | 0x19 => protobuf(MarketPriceBandConfig)
+//   Market settlements paused indicator: 0x01 | <market_id> | 0x1A => nil
+//   Market Last Settlement Price: 0x01 | <market_id> | 0x1B | <assets_denom> | 0x1E | <price_denom> => protobuf(NetAssetPrice)
 //
 //   The <permission_type_byte> is a single byte as uint8 with the same values as the enum entries.
 //   The <req_attr_type_byte> is either an order type byte or 0x63 (= 'c' for commitments).
@@ -74,12 +90,24 @@ import (
 // Payments:
 //    0x70 | len(<source>) (1 byte) | <source> | <external id>
 //
+// External Settlements:
+//    0x65 | <market_id> (4 bytes) | <order_id> (8 bytes) => protobuf(ExternalSettlement)
+//
+// Settlement Receipts:
+//    0x72 | <receipt_id> (8 bytes) => protobuf(SettlementReceipt)
+//
+// Requests for quote:
+//    0x71 | <rfq_id> (8 bytes) => protobuf(RequestForQuote)
+//
 // Indexes:
 //    Market to order: 0x03 | <market_id> (4 bytes) | <order_id> (8 bytes) => <order type byte>
 //    Address to order: 0x04 | len(<address>) (1 byte) | <address> | <order_id> (8 bytes) => <order type byte>
 //    Asset denom to order: 0x05 | <asset_denom> | <order_id> (8 bytes) => <order type byte>
 //    Market + external id to order: 0x09 | <market id> (4 bytes) | <external_id> => <order id> (8 bytes)
 //    Target to payment: 0x10 | len(<target>) (1 byte) | <target> | len(<source>) (1 byte) | <source> | <external id>
+//    External settlement expiration: 0x11 | <height> (8 bytes) | <market_id> (4 bytes) | <order_id> (8 bytes) => nil
+//    Market to RFQ: 0x14 | <market_id> (4 bytes) | <rfq_id> (8 bytes) => nil
+//    RFQ expiration: 0x15 | <height> (8 bytes) | <rfq_id> (8 bytes) => nil
 
 const (
 	// KeyTypeParams is the type byte for params entries.
@@ -108,6 +136,22 @@ const (
 	KeyTypePayment = byte(0x70)
 	// KeyTypeTargetToPaymentIndex is the type byte for entries in the target to payment index.
 	KeyTypeTargetToPaymentIndex = byte(0x10)
+	// KeyTypeExternalSettlement is the type byte for external settlements.
+	KeyTypeExternalSettlement = byte(0x65)
+	// KeyTypeExternalSettlementExpirationIndex is the type byte for entries in the external settlement expiration index.
+	KeyTypeExternalSettlementExpirationIndex = byte(0x11)
+	// KeyTypeLastReceiptID is the type byte for the id of the last settlement receipt created.
+	KeyTypeLastReceiptID = byte(0x12)
+	// KeyTypeSettlementReceipt is the type byte for settlement receipts.
+	KeyTypeSettlementReceipt = byte(0x72)
+	// KeyTypeLastRFQID is the type byte for the id of the last request for quote created.
+	KeyTypeLastRFQID = byte(0x13)
+	// KeyTypeRFQ is the type byte for requests for quote.
+	KeyTypeRFQ = byte(0x71)
+	// KeyTypeMarketToRFQIndex is the type byte for entries in the market to request for quote index.
+	KeyTypeMarketToRFQIndex = byte(0x14)
+	// KeyTypeRFQExpirationIndex is the type byte for entries in the request for quote expiration index.
+	KeyTypeRFQExpirationIndex = byte(0x15)
 
 	// ParamsKeyTypeSplit is the type string used in the keys for params.DefaultSplit and params.DenomSplits.
 	ParamsKeyTypeSplit = "split"
@@ -115,6 +159,12 @@ const (
 	ParamsKeyTypeFeeCreatePaymentFlat = "fee_create_payment_flat"
 	// ParamsKeyTypeFeeAcceptPaymentFlat is the type string used in the keys for params.FeeAcceptPaymentFlat.
 	ParamsKeyTypeFeeAcceptPaymentFlat = "fee_accept_payment_flat"
+	// ParamsKeyTypeMarketIssuers is the type string used in the keys for params.MarketIssuers.
+	ParamsKeyTypeMarketIssuers = "market_issuers"
+	// ParamsKeyTypeIssuerMarketMaxFlatFees is the type string used in the keys for params.IssuerMarketMaxFlatFees.
+	ParamsKeyTypeIssuerMarketMaxFlatFees = "issuer_market_max_flat_fees"
+	// ParamsKeyTypeIssuerMarketMaxRatioBips is the type string used in the keys for params.IssuerMarketMaxRatioBips.
+	ParamsKeyTypeIssuerMarketMaxRatioBips = "issuer_market_max_ratio_bips"
 
 	// MarketKeyTypeCreateAskFlat is the market-specific type byte for the create-ask flat fees.
 	MarketKeyTypeCreateAskFlat = byte(0x00)
@@ -144,6 +194,22 @@ const (
 	MarketKeyTypeCommitmentSettlementBips = byte(0x12)
 	// MarketKeyTypeIntermediaryDenom is the market-specific type byte for the intermediary denom used in fee calcs.
 	MarketKeyTypeIntermediaryDenom = byte(0x13)
+	// MarketKeyTypeRebateConfig is the market-specific type byte for the market's rebate configuration.
+	MarketKeyTypeRebateConfig = byte(0x14)
+	// MarketKeyTypeRebatePool is the market-specific type byte for the funds set aside in the market's rebate pool.
+	MarketKeyTypeRebatePool = byte(0x15)
+	// MarketKeyTypeRebateLastPayout is the market-specific type byte for the height of a market's last rebate payout.
+	MarketKeyTypeRebateLastPayout = byte(0x16)
+	// MarketKeyTypeLiquidityPoints is the market-specific type byte for the liquidity points earned by accounts.
+	MarketKeyTypeLiquidityPoints = byte(0x17)
+	// MarketKeyTypeExternalSettlementConfig is the market-specific type byte for the market's external settlement configuration.
+	MarketKeyTypeExternalSettlementConfig = byte(0x18)
+	// MarketKeyTypePriceBandConfig is the market-specific type byte for the market's price band configuration.
+	MarketKeyTypePriceBandConfig = byte(0x19)
+	// MarketKeyTypeSettlementsPaused is the market-specific type byte for the settlements-paused indicators.
+	MarketKeyTypeSettlementsPaused = byte(0x1A)
+	// MarketKeyTypeLastSettlementPrice is the market-specific type byte for the last prices that assets settled at.
+	MarketKeyTypeLastSettlementPrice = byte(0x1B)
 
 	// OrderKeyTypeAsk is the order-specific type byte for ask orders.
 	OrderKeyTypeAsk = exchange.OrderTypeByteAsk
@@ -256,6 +322,21 @@ func MakeKeyParamsFeeAcceptPaymentFlat() []byte {
 	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeFeeAcceptPaymentFlat), 0)
 }
 
+// MakeKeyParamsMarketIssuers creates the key to use for the params MarketIssuers entry.
+func MakeKeyParamsMarketIssuers() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeMarketIssuers), 0)
+}
+
+// MakeKeyParamsIssuerMarketMaxFlatFees creates the key to use for the params IssuerMarketMaxFlatFees entry.
+func MakeKeyParamsIssuerMarketMaxFlatFees() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeIssuerMarketMaxFlatFees), 0)
+}
+
+// MakeKeyParamsIssuerMarketMaxRatioBips creates the key to use for the params IssuerMarketMaxRatioBips entry.
+func MakeKeyParamsIssuerMarketMaxRatioBips() []byte {
+	return prepKey(KeyTypeParams, []byte(ParamsKeyTypeIssuerMarketMaxRatioBips), 0)
+}
+
 // MakeKeyLastMarketID creates the key for the last auto-selected market id.
 func MakeKeyLastMarketID() []byte {
 	return []byte{KeyTypeLastMarketID}
@@ -613,6 +694,89 @@ func MakeKeyMarketIntermediaryDenom(marketID uint32) []byte {
 	return keyPrefixMarketType(marketID, MarketKeyTypeIntermediaryDenom, 0)
 }
 
+// MakeKeyMarketRebateConfig creates the key to use for a market's rebate configuration.
+func MakeKeyMarketRebateConfig(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeRebateConfig, 0)
+}
+
+// MakeKeyMarketRebatePool creates the key to use for the funds in a market's rebate pool.
+func MakeKeyMarketRebatePool(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeRebatePool, 0)
+}
+
+// MakeKeyMarketRebateLastPayout creates the key to use for the height of a market's last rebate payout.
+func MakeKeyMarketRebateLastPayout(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeRebateLastPayout, 0)
+}
+
+// marketKeyPrefixLiquidityPoints creates the key prefix for a market's liquidity points with extra capacity for the address.
+func marketKeyPrefixLiquidityPoints(marketID uint32, extraCap int) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeLiquidityPoints, extraCap)
+}
+
+// GetKeyPrefixMarketLiquidityPoints creates the key prefix for all of a market's liquidity points entries.
+func GetKeyPrefixMarketLiquidityPoints(marketID uint32) []byte {
+	return marketKeyPrefixLiquidityPoints(marketID, 0)
+}
+
+// MakeKeyMarketLiquidityPoints creates the key to use for the liquidity points an address has earned in a market.
+func MakeKeyMarketLiquidityPoints(marketID uint32, addr sdk.AccAddress) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	rv := marketKeyPrefixLiquidityPoints(marketID, 1+len(addr))
+	rv = append(rv, address.MustLengthPrefix(addr)...)
+	return rv
+}
+
+// ParseKeySuffixMarketLiquidityPoints parses the address out of a liquidity points key that has had the
+// market type prefix removed (i.e. the key suffix should be <addr len byte> | <address>).
+func ParseKeySuffixMarketLiquidityPoints(suffix []byte) (sdk.AccAddress, error) {
+	addr, rest, err := parseLengthPrefixedAddr(suffix)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse address from liquidity points key: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("cannot parse liquidity points key: found %d bytes after address, expected 0", len(rest))
+	}
+	return addr, nil
+}
+
+// MakeKeyMarketExternalSettlementConfig creates the key to use for a market's external settlement configuration.
+func MakeKeyMarketExternalSettlementConfig(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeExternalSettlementConfig, 0)
+}
+
+// MakeKeyMarketPriceBandConfig creates the key to use for a market's price band configuration.
+func MakeKeyMarketPriceBandConfig(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypePriceBandConfig, 0)
+}
+
+// MakeKeyMarketSettlementsPaused creates the key to use to indicate that a market's settlements are paused.
+func MakeKeyMarketSettlementsPaused(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeSettlementsPaused, 0)
+}
+
+// marketKeyPrefixLastSettlementPrice creates the key prefix for a market's last settlement prices with extra capacity for the rest.
+func marketKeyPrefixLastSettlementPrice(marketID uint32, extraCap int) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeLastSettlementPrice, extraCap)
+}
+
+// GetKeyPrefixMarketLastSettlementPrice creates the key prefix for all of a market's last settlement prices.
+func GetKeyPrefixMarketLastSettlementPrice(marketID uint32) []byte {
+	return marketKeyPrefixLastSettlementPrice(marketID, 0)
+}
+
+// MakeKeyMarketLastSettlementPrice creates the key to use for the last price that the given assets denom
+// settled at (in the given price denom) in a market.
+func MakeKeyMarketLastSettlementPrice(marketID uint32, assetsDenom, priceDenom string) []byte {
+	rv := marketKeyPrefixLastSettlementPrice(marketID, len(assetsDenom)+1+len(priceDenom))
+	rv = append(rv, assetsDenom...)
+	rv = append(rv, RecordSeparator)
+	rv = append(rv, priceDenom...)
+	return rv
+}
+
 // keyPrefixOrder creates the key prefix for orders with the provided extra capacity for additional elements.
 func keyPrefixOrder(extraCap int) []byte {
 	return prepKey(KeyTypeOrder, nil, extraCap)
@@ -1011,3 +1175,186 @@ func ParseIndexKeySuffixTargetToPayment(suffix []byte) (sdk.AccAddress, string,
 	}
 	return source, string(left), nil
 }
+
+// keyPrefixExternalSettlement creates the key prefix for external settlements with the provided extra capacity for additional elements.
+func keyPrefixExternalSettlement(extraCap int) []byte {
+	return prepKey(KeyTypeExternalSettlement, nil, extraCap)
+}
+
+// keyPrefixMarketExternalSettlements creates the key prefix for a market's external settlements with the provided extra capacity for additional elements.
+func keyPrefixMarketExternalSettlements(marketID uint32, extraCap int) []byte {
+	suffix := uint32Bz(marketID)
+	rv := keyPrefixExternalSettlement(extraCap + len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// GetKeyPrefixExternalSettlements gets the key prefix for all external settlements.
+func GetKeyPrefixExternalSettlements() []byte {
+	return keyPrefixExternalSettlement(0)
+}
+
+// GetKeyPrefixMarketExternalSettlements gets the key prefix for all of a market's external settlements.
+func GetKeyPrefixMarketExternalSettlements(marketID uint32) []byte {
+	return keyPrefixMarketExternalSettlements(marketID, 0)
+}
+
+// MakeKeyExternalSettlement creates the key to use for an external settlement.
+func MakeKeyExternalSettlement(marketID uint32, orderID uint64) []byte {
+	suffix := uint64Bz(orderID)
+	rv := keyPrefixMarketExternalSettlements(marketID, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// ParseKeySuffixExternalSettlement parses the order id out of an external settlement key that has
+// had the type byte and market id removed (i.e. the key suffix should be just the <order_id>).
+func ParseKeySuffixExternalSettlement(suffix []byte) (uint64, error) {
+	if len(suffix) != 8 {
+		return 0, fmt.Errorf("cannot parse external settlement key: has %d bytes, expected 8", len(suffix))
+	}
+	orderID, _ := uint64FromBz(suffix)
+	return orderID, nil
+}
+
+// indexPrefixExternalSettlementExpiration creates the key prefix for the external settlement expiration
+// index entries with the provided extra capacity for additional elements.
+func indexPrefixExternalSettlementExpiration(extraCap int) []byte {
+	return prepKey(KeyTypeExternalSettlementExpirationIndex, nil, extraCap)
+}
+
+// GetIndexKeyPrefixExternalSettlementExpiration gets the key prefix for all external settlement expiration index entries.
+func GetIndexKeyPrefixExternalSettlementExpiration() []byte {
+	return indexPrefixExternalSettlementExpiration(0)
+}
+
+// MakeIndexKeyExternalSettlementExpiration creates the key to use in the external settlement expiration index.
+func MakeIndexKeyExternalSettlementExpiration(height int64, marketID uint32, orderID uint64) []byte {
+	if height < 0 {
+		panic(fmt.Errorf("negative expiration height %d not allowed", height))
+	}
+	rv := indexPrefixExternalSettlementExpiration(20)
+	rv = append(rv, uint64Bz(uint64(height))...)
+	rv = append(rv, uint32Bz(marketID)...)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// ParseIndexKeySuffixExternalSettlementExpiration parses an external settlement expiration index key that has had
+// its type byte removed (i.e. the key suffix should be <height> | <market_id> | <order_id>).
+func ParseIndexKeySuffixExternalSettlementExpiration(suffix []byte) (int64, uint32, uint64, error) {
+	if len(suffix) != 20 {
+		return 0, 0, 0, fmt.Errorf("cannot parse external settlement expiration index key: has %d bytes, expected 20", len(suffix))
+	}
+	height, _ := uint64FromBz(suffix[:8])
+	marketID, _ := uint32FromBz(suffix[8:12])
+	orderID, _ := uint64FromBz(suffix[12:])
+	return int64(height), marketID, orderID, nil //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
+
+// MakeKeyLastReceiptID creates the key for the id of the last settlement receipt created.
+func MakeKeyLastReceiptID() []byte {
+	return []byte{KeyTypeLastReceiptID}
+}
+
+// keyPrefixSettlementReceipt creates the key prefix for settlement receipts with the provided extra capacity for additional elements.
+func keyPrefixSettlementReceipt(extraCap int) []byte {
+	return prepKey(KeyTypeSettlementReceipt, nil, extraCap)
+}
+
+// GetKeyPrefixSettlementReceipt gets the key prefix for all settlement receipts.
+func GetKeyPrefixSettlementReceipt() []byte {
+	return keyPrefixSettlementReceipt(0)
+}
+
+// MakeKeySettlementReceipt creates the key to use for a settlement receipt.
+func MakeKeySettlementReceipt(receiptID uint64) []byte {
+	suffix := uint64Bz(receiptID)
+	rv := keyPrefixSettlementReceipt(len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// MakeKeyLastRFQID creates the key for the id of the last request for quote created.
+func MakeKeyLastRFQID() []byte {
+	return []byte{KeyTypeLastRFQID}
+}
+
+// keyPrefixRFQ creates the key prefix for requests for quote with the provided extra capacity for additional elements.
+func keyPrefixRFQ(extraCap int) []byte {
+	return prepKey(KeyTypeRFQ, nil, extraCap)
+}
+
+// GetKeyPrefixRFQ gets the key prefix for all requests for quote.
+func GetKeyPrefixRFQ() []byte {
+	return keyPrefixRFQ(0)
+}
+
+// MakeKeyRFQ creates the key to use for a request for quote.
+func MakeKeyRFQ(rfqID uint64) []byte {
+	suffix := uint64Bz(rfqID)
+	rv := keyPrefixRFQ(len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// indexPrefixMarketToRFQ creates the key prefix for the market to request for quote index limited to the
+// given market id and with the provided extra capacity for additional elements.
+func indexPrefixMarketToRFQ(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeMarketToRFQIndex, uint32Bz(marketID), extraCap)
+}
+
+// GetIndexKeyPrefixMarketToRFQ gets the key prefix for the market to request for quote index limited to the given market id.
+func GetIndexKeyPrefixMarketToRFQ(marketID uint32) []byte {
+	return indexPrefixMarketToRFQ(marketID, 0)
+}
+
+// MakeIndexKeyMarketToRFQ creates the key to use in the market to request for quote index.
+func MakeIndexKeyMarketToRFQ(marketID uint32, rfqID uint64) []byte {
+	rv := indexPrefixMarketToRFQ(marketID, 8)
+	rv = append(rv, uint64Bz(rfqID)...)
+	return rv
+}
+
+// ParseIndexKeySuffixMarketToRFQ parses the rfq id out of a market to request for quote index key that has
+// had the type byte and market id removed (i.e. the key suffix should be just the <rfq_id>).
+func ParseIndexKeySuffixMarketToRFQ(suffix []byte) (uint64, error) {
+	if len(suffix) != 8 {
+		return 0, fmt.Errorf("cannot parse market to rfq index key: has %d bytes, expected 8", len(suffix))
+	}
+	rfqID, _ := uint64FromBz(suffix)
+	return rfqID, nil
+}
+
+// indexPrefixRFQExpiration creates the key prefix for the request for quote expiration
+// index entries with the provided extra capacity for additional elements.
+func indexPrefixRFQExpiration(extraCap int) []byte {
+	return prepKey(KeyTypeRFQExpirationIndex, nil, extraCap)
+}
+
+// GetIndexKeyPrefixRFQExpiration gets the key prefix for all request for quote expiration index entries.
+func GetIndexKeyPrefixRFQExpiration() []byte {
+	return indexPrefixRFQExpiration(0)
+}
+
+// MakeIndexKeyRFQExpiration creates the key to use in the request for quote expiration index.
+func MakeIndexKeyRFQExpiration(height int64, rfqID uint64) []byte {
+	if height < 0 {
+		panic(fmt.Errorf("negative expiration height %d not allowed", height))
+	}
+	rv := indexPrefixRFQExpiration(16)
+	rv = append(rv, uint64Bz(uint64(height))...)
+	rv = append(rv, uint64Bz(rfqID)...)
+	return rv
+}
+
+// ParseIndexKeySuffixRFQExpiration parses a request for quote expiration index key that has had
+// its type byte removed (i.e. the key suffix should be <height> | <rfq_id>).
+func ParseIndexKeySuffixRFQExpiration(suffix []byte) (int64, uint64, error) {
+	if len(suffix) != 16 {
+		return 0, 0, fmt.Errorf("cannot parse rfq expiration index key: has %d bytes, expected 16", len(suffix))
+	}
+	height, _ := uint64FromBz(suffix[:8])
+	rfqID, _ := uint64FromBz(suffix[8:])
+	return int64(height), rfqID, nil //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
@@ -106,6 +106,14 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "KeyTypeCommitment", value: keeper.KeyTypeCommitment},
 				{name: "KeyTypePayment", value: keeper.KeyTypePayment},
 				{name: "KeyTypeTargetToPaymentIndex", value: keeper.KeyTypeTargetToPaymentIndex},
+				{name: "KeyTypeExternalSettlement", value: keeper.KeyTypeExternalSettlement},
+				{name: "KeyTypeExternalSettlementExpirationIndex", value: keeper.KeyTypeExternalSettlementExpirationIndex},
+				{name: "KeyTypeLastReceiptID", value: keeper.KeyTypeLastReceiptID},
+				{name: "KeyTypeSettlementReceipt", value: keeper.KeyTypeSettlementReceipt},
+				{name: "KeyTypeLastRFQID", value: keeper.KeyTypeLastRFQID},
+				{name: "KeyTypeRFQ", value: keeper.KeyTypeRFQ},
+				{name: "KeyTypeMarketToRFQIndex", value: keeper.KeyTypeMarketToRFQIndex},
+				{name: "KeyTypeRFQExpirationIndex", value: keeper.KeyTypeRFQExpirationIndex},
 			},
 		},
 		{
@@ -125,6 +133,14 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "MarketKeyTypeCreateCommitmentFlat", value: keeper.MarketKeyTypeCreateCommitmentFlat},
 				{name: "MarketKeyTypeCommitmentSettlementBips", value: keeper.MarketKeyTypeCommitmentSettlementBips},
 				{name: "MarketKeyTypeIntermediaryDenom", value: keeper.MarketKeyTypeIntermediaryDenom},
+				{name: "MarketKeyTypeRebateConfig", value: keeper.MarketKeyTypeRebateConfig},
+				{name: "MarketKeyTypeRebatePool", value: keeper.MarketKeyTypeRebatePool},
+				{name: "MarketKeyTypeRebateLastPayout", value: keeper.MarketKeyTypeRebateLastPayout},
+				{name: "MarketKeyTypeLiquidityPoints", value: keeper.MarketKeyTypeLiquidityPoints},
+				{name: "MarketKeyTypeExternalSettlementConfig", value: keeper.MarketKeyTypeExternalSettlementConfig},
+				{name: "MarketKeyTypePriceBandConfig", value: keeper.MarketKeyTypePriceBandConfig},
+				{name: "MarketKeyTypeSettlementsPaused", value: keeper.MarketKeyTypeSettlementsPaused},
+				{name: "MarketKeyTypeLastSettlementPrice", value: keeper.MarketKeyTypeLastSettlementPrice},
 			},
 		},
 		{
@@ -321,6 +337,36 @@ func TestMakeKeyParamsFeeAcceptPaymentFlat(t *testing.T) {
 	checkKey(t, ktc, "MakeKeyParamsFeeAcceptPaymentFlat")
 }
 
+func TestMakeKeyParamsMarketIssuers(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyParamsMarketIssuers()
+		},
+		expected: append([]byte{keeper.KeyTypeParams}, []byte("market_issuers")...),
+	}
+	checkKey(t, ktc, "MakeKeyParamsMarketIssuers")
+}
+
+func TestMakeKeyParamsIssuerMarketMaxFlatFees(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyParamsIssuerMarketMaxFlatFees()
+		},
+		expected: append([]byte{keeper.KeyTypeParams}, []byte("issuer_market_max_flat_fees")...),
+	}
+	checkKey(t, ktc, "MakeKeyParamsIssuerMarketMaxFlatFees")
+}
+
+func TestMakeKeyParamsIssuerMarketMaxRatioBips(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyParamsIssuerMarketMaxRatioBips()
+		},
+		expected: append([]byte{keeper.KeyTypeParams}, []byte("issuer_market_max_ratio_bips")...),
+	}
+	checkKey(t, ktc, "MakeKeyParamsIssuerMarketMaxRatioBips")
+}
+
 func TestMakeKeyLastMarketID(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -3231,6 +3277,197 @@ func TestMakeKeyMarketIntermediaryDenom(t *testing.T) {
 	}
 }
 
+func TestMakeKeyMarketRebateKeys(t *testing.T) {
+	makers := []struct {
+		name     string
+		typeByte byte
+		maker    func(marketID uint32) []byte
+	}{
+		{name: "MakeKeyMarketRebateConfig", typeByte: keeper.MarketKeyTypeRebateConfig, maker: keeper.MakeKeyMarketRebateConfig},
+		{name: "MakeKeyMarketRebatePool", typeByte: keeper.MarketKeyTypeRebatePool, maker: keeper.MakeKeyMarketRebatePool},
+		{name: "MakeKeyMarketRebateLastPayout", typeByte: keeper.MarketKeyTypeRebateLastPayout, maker: keeper.MakeKeyMarketRebateLastPayout},
+		{name: "GetKeyPrefixMarketLiquidityPoints", typeByte: keeper.MarketKeyTypeLiquidityPoints, maker: keeper.GetKeyPrefixMarketLiquidityPoints},
+		{name: "MakeKeyMarketExternalSettlementConfig", typeByte: keeper.MarketKeyTypeExternalSettlementConfig, maker: keeper.MakeKeyMarketExternalSettlementConfig},
+		{name: "MakeKeyMarketPriceBandConfig", typeByte: keeper.MarketKeyTypePriceBandConfig, maker: keeper.MakeKeyMarketPriceBandConfig},
+		{name: "MakeKeyMarketSettlementsPaused", typeByte: keeper.MarketKeyTypeSettlementsPaused, maker: keeper.MakeKeyMarketSettlementsPaused},
+		{name: "GetKeyPrefixMarketLastSettlementPrice", typeByte: keeper.MarketKeyTypeLastSettlementPrice, maker: keeper.GetKeyPrefixMarketLastSettlementPrice},
+	}
+
+	marketIDs := []struct {
+		marketID uint32
+		idBytes  []byte
+	}{
+		{marketID: 0, idBytes: []byte{0, 0, 0, 0}},
+		{marketID: 1, idBytes: []byte{0, 0, 0, 1}},
+		{marketID: 256, idBytes: []byte{0, 0, 1, 0}},
+		{marketID: 16_843_009, idBytes: []byte{1, 1, 1, 1}},
+		{marketID: 4_294_967_295, idBytes: []byte{255, 255, 255, 255}},
+	}
+
+	for _, m := range makers {
+		for _, id := range marketIDs {
+			t.Run(fmt.Sprintf("%s(%d)", m.name, id.marketID), func(t *testing.T) {
+				expected := append([]byte{keeper.KeyTypeMarket}, id.idBytes...)
+				expected = append(expected, m.typeByte)
+				ktc := keyTestCase{
+					maker: func() []byte {
+						return m.maker(id.marketID)
+					},
+					expected: expected,
+					expPrefixes: []expectedPrefix{
+						{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(id.marketID)},
+					},
+				}
+				checkKey(t, ktc, "%s(%d)", m.name, id.marketID)
+			})
+		}
+	}
+}
+
+func TestMakeKeyMarketLiquidityPoints(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeLiquidityPoints
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		addr     sdk.AccAddress
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "nil addr",
+			marketID: 1,
+			addr:     nil,
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "empty addr",
+			marketID: 1,
+			addr:     sdk.AccAddress{},
+			expPanic: "empty address not allowed",
+		},
+		{
+			name:     "market 1, 5 byte addr",
+			marketID: 1,
+			addr:     sdk.AccAddress{1, 2, 3, 4, 5},
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 5, 1, 2, 3, 4, 5},
+		},
+		{
+			name:     "market 16,843,009, 20 byte addr",
+			marketID: 16_843_009,
+			addr:     sdk.AccAddress("20_byte_address_____"),
+			expected: append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte, 20}, "20_byte_address_____"...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketLiquidityPoints(tc.marketID, tc.addr)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+					{name: "GetKeyPrefixMarketLiquidityPoints", value: keeper.GetKeyPrefixMarketLiquidityPoints(tc.marketID)},
+				}
+			}
+			checkKey(t, ktc, "MakeKeyMarketLiquidityPoints(%d, %s)", tc.marketID, tc.addr)
+		})
+	}
+}
+
+func TestMakeKeyMarketLastSettlementPrice(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeLastSettlementPrice
+	rs := keeper.RecordSeparator
+
+	tests := []struct {
+		name        string
+		marketID    uint32
+		assetsDenom string
+		priceDenom  string
+		expected    []byte
+	}{
+		{
+			name:        "market 1, short denoms",
+			marketID:    1,
+			assetsDenom: "a",
+			priceDenom:  "p",
+			expected:    []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte, 'a', rs, 'p'},
+		},
+		{
+			name:        "market 16,843,009, longer denoms",
+			marketID:    16_843_009,
+			assetsDenom: "apple",
+			priceDenom:  "nhash",
+			expected: append(append([]byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte}, "apple"...),
+				append([]byte{rs}, "nhash"...)...),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketLastSettlementPrice(tc.marketID, tc.assetsDenom, tc.priceDenom)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+					{name: "GetKeyPrefixMarketLastSettlementPrice", value: keeper.GetKeyPrefixMarketLastSettlementPrice(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketLastSettlementPrice(%d, %q, %q)", tc.marketID, tc.assetsDenom, tc.priceDenom)
+		})
+	}
+}
+
+func TestParseKeySuffixMarketLiquidityPoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		suffix  []byte
+		expAddr sdk.AccAddress
+		expErr  string
+	}{
+		{
+			name:   "empty suffix",
+			suffix: []byte{},
+			expErr: "cannot parse address from liquidity points key: slice is empty",
+		},
+		{
+			name:   "byte length too short",
+			suffix: []byte{5, 1, 2},
+			expErr: "cannot parse address from liquidity points key: length byte is 5, but slice only has 2 left",
+		},
+		{
+			name:   "extra bytes after addr",
+			suffix: []byte{5, 1, 2, 3, 4, 5, 6},
+			expErr: "cannot parse liquidity points key: found 1 bytes after address, expected 0",
+		},
+		{
+			name:    "5 byte addr",
+			suffix:  []byte{5, 1, 2, 3, 4, 5},
+			expAddr: sdk.AccAddress{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var addr sdk.AccAddress
+			var err error
+			testFunc := func() {
+				addr, err = keeper.ParseKeySuffixMarketLiquidityPoints(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixMarketLiquidityPoints")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySuffixMarketLiquidityPoints error")
+			assert.Equal(t, tc.expAddr, addr, "ParseKeySuffixMarketLiquidityPoints address")
+		})
+	}
+}
+
 func TestGetKeyPrefixOrder(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -5375,3 +5612,546 @@ func TestParseIndexKeySuffixTargetToPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestGetKeyPrefixExternalSettlements(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixExternalSettlements()
+		},
+		expected: []byte{keeper.KeyTypeExternalSettlement},
+	}
+	checkKey(t, ktc, "GetKeyPrefixExternalSettlements")
+}
+
+func TestGetKeyPrefixMarketExternalSettlements(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeExternalSettlement, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeExternalSettlement, 1, 1, 1, 1},
+		},
+		{
+			name:     "market 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeExternalSettlement, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketExternalSettlements(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixExternalSettlements", value: keeper.GetKeyPrefixExternalSettlements()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketExternalSettlements(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyExternalSettlement(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "market 1, order 1",
+			marketID: 1,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeExternalSettlement, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 16,843,009, order 72,340,172,838,076,673",
+			marketID: 16_843_009,
+			orderID:  72_340_172_838_076_673,
+			expected: []byte{keeper.KeyTypeExternalSettlement, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:     "max market, max order",
+			marketID: 4_294_967_295,
+			orderID:  18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeExternalSettlement, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyExternalSettlement(tc.marketID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixExternalSettlements", value: keeper.GetKeyPrefixExternalSettlements()},
+					{name: "GetKeyPrefixMarketExternalSettlements", value: keeper.GetKeyPrefixMarketExternalSettlements(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyExternalSettlement(%d, %d)", tc.marketID, tc.orderID)
+		})
+	}
+}
+
+func TestParseKeySuffixExternalSettlement(t *testing.T) {
+	tests := []struct {
+		name       string
+		suffix     []byte
+		expOrderID uint64
+		expErr     string
+	}{
+		{
+			name:   "nil",
+			suffix: nil,
+			expErr: "cannot parse external settlement key: has 0 bytes, expected 8",
+		},
+		{
+			name:   "too short",
+			suffix: []byte{1, 2, 3, 4, 5, 6, 7},
+			expErr: "cannot parse external settlement key: has 7 bytes, expected 8",
+		},
+		{
+			name:   "too long",
+			suffix: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expErr: "cannot parse external settlement key: has 9 bytes, expected 8",
+		},
+		{
+			name:       "order 1",
+			suffix:     []byte{0, 0, 0, 0, 0, 0, 0, 1},
+			expOrderID: 1,
+		},
+		{
+			name:       "order 72,340,172,838,076,673",
+			suffix:     []byte{1, 1, 1, 1, 1, 1, 1, 1},
+			expOrderID: 72_340_172_838_076_673,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				orderID, err = keeper.ParseKeySuffixExternalSettlement(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseKeySuffixExternalSettlement")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeySuffixExternalSettlement error")
+			assert.Equal(t, tc.expOrderID, orderID, "ParseKeySuffixExternalSettlement order id")
+		})
+	}
+}
+
+func TestGetIndexKeyPrefixExternalSettlementExpiration(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetIndexKeyPrefixExternalSettlementExpiration()
+		},
+		expected: []byte{keeper.KeyTypeExternalSettlementExpirationIndex},
+	}
+	checkKey(t, ktc, "GetIndexKeyPrefixExternalSettlementExpiration")
+}
+
+func TestMakeIndexKeyExternalSettlementExpiration(t *testing.T) {
+	tests := []struct {
+		name     string
+		height   int64
+		marketID uint32
+		orderID  uint64
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "negative height",
+			height:   -1,
+			marketID: 1,
+			orderID:  1,
+			expPanic: "negative expiration height -1 not allowed",
+		},
+		{
+			name:     "all zeros",
+			expected: append([]byte{keeper.KeyTypeExternalSettlementExpirationIndex}, make([]byte, 20)...),
+		},
+		{
+			name:     "height 258, market 3, order 5",
+			height:   258,
+			marketID: 3,
+			orderID:  5,
+			expected: []byte{
+				keeper.KeyTypeExternalSettlementExpirationIndex,
+				0, 0, 0, 0, 0, 0, 1, 2,
+				0, 0, 0, 3,
+				0, 0, 0, 0, 0, 0, 0, 5,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExternalSettlementExpiration(tc.height, tc.marketID, tc.orderID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixExternalSettlementExpiration", value: keeper.GetIndexKeyPrefixExternalSettlementExpiration()},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyExternalSettlementExpiration(%d, %d, %d)", tc.height, tc.marketID, tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeySuffixExternalSettlementExpiration(t *testing.T) {
+	tests := []struct {
+		name        string
+		suffix      []byte
+		expHeight   int64
+		expMarketID uint32
+		expOrderID  uint64
+		expErr      string
+	}{
+		{
+			name:   "nil",
+			suffix: nil,
+			expErr: "cannot parse external settlement expiration index key: has 0 bytes, expected 20",
+		},
+		{
+			name:   "too long",
+			suffix: make([]byte, 21),
+			expErr: "cannot parse external settlement expiration index key: has 21 bytes, expected 20",
+		},
+		{
+			name:        "height 258, market 3, order 5",
+			suffix:      []byte{0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 5},
+			expHeight:   258,
+			expMarketID: 3,
+			expOrderID:  5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var height int64
+			var marketID uint32
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				height, marketID, orderID, err = keeper.ParseIndexKeySuffixExternalSettlementExpiration(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixExternalSettlementExpiration")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixExternalSettlementExpiration error")
+			assert.Equal(t, tc.expHeight, height, "height")
+			assert.Equal(t, tc.expMarketID, marketID, "market id")
+			assert.Equal(t, tc.expOrderID, orderID, "order id")
+		})
+	}
+}
+
+func TestMakeKeyLastReceiptID(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyLastReceiptID()
+		},
+		expected: []byte{keeper.KeyTypeLastReceiptID},
+	}
+	checkKey(t, ktc, "MakeKeyLastReceiptID")
+}
+
+func TestGetKeyPrefixSettlementReceipt(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixSettlementReceipt()
+		},
+		expected: []byte{keeper.KeyTypeSettlementReceipt},
+	}
+	checkKey(t, ktc, "GetKeyPrefixSettlementReceipt")
+}
+
+func TestMakeKeySettlementReceipt(t *testing.T) {
+	tests := []struct {
+		name      string
+		receiptID uint64
+		expected  []byte
+	}{
+		{
+			name:      "receipt id 0",
+			receiptID: 0,
+			expected:  []byte{keeper.KeyTypeSettlementReceipt, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:      "receipt id 1",
+			receiptID: 1,
+			expected:  []byte{keeper.KeyTypeSettlementReceipt, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:      "receipt id 72,340,172,838,076,673",
+			receiptID: 72_340_172_838_076_673,
+			expected:  []byte{keeper.KeyTypeSettlementReceipt, 1, 1, 1, 1, 1, 1, 1, 1},
+		},
+		{
+			name:      "max receipt id",
+			receiptID: 18_446_744_073_709_551_615,
+			expected:  []byte{keeper.KeyTypeSettlementReceipt, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeySettlementReceipt(tc.receiptID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixSettlementReceipt", value: keeper.GetKeyPrefixSettlementReceipt()},
+				},
+			}
+			checkKey(t, ktc, "MakeKeySettlementReceipt(%d)", tc.receiptID)
+		})
+	}
+}
+
+func TestMakeKeyLastRFQID(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.MakeKeyLastRFQID()
+		},
+		expected: []byte{keeper.KeyTypeLastRFQID},
+	}
+	checkKey(t, ktc, "MakeKeyLastRFQID")
+}
+
+func TestGetKeyPrefixRFQ(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixRFQ()
+		},
+		expected: []byte{keeper.KeyTypeRFQ},
+	}
+	checkKey(t, ktc, "GetKeyPrefixRFQ")
+}
+
+func TestMakeKeyRFQ(t *testing.T) {
+	tests := []struct {
+		name     string
+		rfqID    uint64
+		expected []byte
+	}{
+		{
+			name:     "rfq id 0",
+			rfqID:    0,
+			expected: []byte{keeper.KeyTypeRFQ, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "rfq id 1",
+			rfqID:    1,
+			expected: []byte{keeper.KeyTypeRFQ, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "max rfq id",
+			rfqID:    18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeRFQ, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyRFQ(tc.rfqID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixRFQ", value: keeper.GetKeyPrefixRFQ()},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyRFQ(%d)", tc.rfqID)
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketToRFQ(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		rfqID    uint64
+		expected []byte
+	}{
+		{
+			name:     "market 0, rfq 0",
+			expected: append([]byte{keeper.KeyTypeMarketToRFQIndex}, make([]byte, 12)...),
+		},
+		{
+			name:     "market 3, rfq 258",
+			marketID: 3,
+			rfqID:    258,
+			expected: []byte{keeper.KeyTypeMarketToRFQIndex, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 1, 2},
+		},
+		{
+			name:     "max market, max rfq",
+			marketID: 4_294_967_295,
+			rfqID:    18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeMarketToRFQIndex, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyMarketToRFQ(tc.marketID, tc.rfqID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetIndexKeyPrefixMarketToRFQ", value: keeper.GetIndexKeyPrefixMarketToRFQ(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyMarketToRFQ(%d, %d)", tc.marketID, tc.rfqID)
+		})
+	}
+}
+
+func TestParseIndexKeySuffixMarketToRFQ(t *testing.T) {
+	tests := []struct {
+		name     string
+		suffix   []byte
+		expRFQID uint64
+		expErr   string
+	}{
+		{
+			name:   "nil",
+			suffix: nil,
+			expErr: "cannot parse market to rfq index key: has 0 bytes, expected 8",
+		},
+		{
+			name:   "too long",
+			suffix: make([]byte, 9),
+			expErr: "cannot parse market to rfq index key: has 9 bytes, expected 8",
+		},
+		{
+			name:     "rfq 258",
+			suffix:   []byte{0, 0, 0, 0, 0, 0, 1, 2},
+			expRFQID: 258,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var rfqID uint64
+			var err error
+			testFunc := func() {
+				rfqID, err = keeper.ParseIndexKeySuffixMarketToRFQ(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixMarketToRFQ")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixMarketToRFQ error")
+			assert.Equal(t, tc.expRFQID, rfqID, "ParseIndexKeySuffixMarketToRFQ rfq id")
+		})
+	}
+}
+
+func TestMakeIndexKeyRFQExpiration(t *testing.T) {
+	tests := []struct {
+		name     string
+		height   int64
+		rfqID    uint64
+		expected []byte
+		expPanic string
+	}{
+		{
+			name:     "negative height",
+			height:   -1,
+			rfqID:    1,
+			expPanic: "negative expiration height -1 not allowed",
+		},
+		{
+			name:     "all zeros",
+			expected: append([]byte{keeper.KeyTypeRFQExpirationIndex}, make([]byte, 16)...),
+		},
+		{
+			name:   "height 258, rfq 5",
+			height: 258,
+			rfqID:  5,
+			expected: []byte{
+				keeper.KeyTypeRFQExpirationIndex,
+				0, 0, 0, 0, 0, 0, 1, 2,
+				0, 0, 0, 0, 0, 0, 0, 5,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyRFQExpiration(tc.height, tc.rfqID)
+				},
+				expected: tc.expected,
+				expPanic: tc.expPanic,
+			}
+			if len(tc.expPanic) == 0 {
+				ktc.expPrefixes = []expectedPrefix{
+					{name: "GetIndexKeyPrefixRFQExpiration", value: keeper.GetIndexKeyPrefixRFQExpiration()},
+				}
+			}
+			checkKey(t, ktc, "MakeIndexKeyRFQExpiration(%d, %d)", tc.height, tc.rfqID)
+		})
+	}
+}
+
+func TestParseIndexKeySuffixRFQExpiration(t *testing.T) {
+	tests := []struct {
+		name      string
+		suffix    []byte
+		expHeight int64
+		expRFQID  uint64
+		expErr    string
+	}{
+		{
+			name:   "nil",
+			suffix: nil,
+			expErr: "cannot parse rfq expiration index key: has 0 bytes, expected 16",
+		},
+		{
+			name:   "too long",
+			suffix: make([]byte, 17),
+			expErr: "cannot parse rfq expiration index key: has 17 bytes, expected 16",
+		},
+		{
+			name:      "height 258, rfq 5",
+			suffix:    []byte{0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 0, 5},
+			expHeight: 258,
+			expRFQID:  5,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var height int64
+			var rfqID uint64
+			var err error
+			testFunc := func() {
+				height, rfqID, err = keeper.ParseIndexKeySuffixRFQExpiration(tc.suffix)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeySuffixRFQExpiration")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeySuffixRFQExpiration error")
+			assert.Equal(t, tc.expHeight, height, "height")
+			assert.Equal(t, tc.expRFQID, rfqID, "rfq id")
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -12,6 +13,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/exchange"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/quarantine"
@@ -1251,7 +1253,7 @@ func (k Keeper) acctHasReqAttrs(ctx sdk.Context, addr sdk.AccAddress, reqAttrs [
 	if len(reqAttrs) == 0 {
 		return true
 	}
-	attrs, err := k.attrKeeper.GetAllAttributesAddr(ctx, addr)
+	attrs, err := k.attrKeeper.GetAllAttributesAddrWithMirror(ctx, addr)
 	if err != nil {
 		return false
 	}
@@ -1278,6 +1280,34 @@ func (k Keeper) GetReqAttrsCommitment(ctx sdk.Context, marketID uint32) []string
 	return getReqAttrsCommitment(k.getStore(ctx), marketID)
 }
 
+var _ attrtypes.AttributeDependencyReporter = Keeper{}
+
+// GetAttributeDependents returns an entry for each market with a required attribute (to create an ask,
+// bid, or commitment) that is satisfied by the provided name.
+func (k Keeper) GetAttributeDependents(ctx sdk.Context, name string) []attrtypes.AttributeDependent {
+	var rv []attrtypes.AttributeDependent
+	store := k.getStore(ctx)
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		var matched []string
+		for _, reqAttrs := range [][]string{
+			getReqAttrsAsk(store, marketID),
+			getReqAttrsBid(store, marketID),
+			getReqAttrsCommitment(store, marketID),
+		} {
+			for _, reqAttr := range reqAttrs {
+				if exchange.IsReqAttrMatch(reqAttr, name) && !slices.Contains(matched, reqAttr) {
+					matched = append(matched, reqAttr)
+				}
+			}
+		}
+		if len(matched) > 0 {
+			rv = append(rv, attrtypes.NewAttributeDependent(exchange.ModuleName, "market", fmt.Sprintf("%d", marketID), matched))
+		}
+		return false
+	})
+	return rv
+}
+
 // CanCreateAsk returns true if the provided address is allowed to create an ask order in the given market.
 func (k Keeper) CanCreateAsk(ctx sdk.Context, marketID uint32, addr sdk.AccAddress) bool {
 	reqAttrs := k.GetReqAttrsAsk(ctx, marketID)
@@ -1481,6 +1511,56 @@ func (k Keeper) CreateMarket(ctx sdk.Context, market exchange.Market) (uint32, e
 	return market.MarketId, nil
 }
 
+// IssuerCreateMarket creates a market on behalf of an approved issuer for a marker that the issuer administers.
+// The market's fees must be within the issuer market limits defined in the params, and the marker's
+// required attributes are added to each of the market's required attribute lists.
+func (k Keeper) IssuerCreateMarket(ctx sdk.Context, issuer sdk.AccAddress, markerDenom string, market exchange.Market) (uint32, error) {
+	// Note: The Market is passed in by value, so any alterations directly to it here will be lost upon return.
+	params := k.GetParamsOrDefaults(ctx)
+	if !params.IsMarketIssuer(issuer.String()) {
+		return 0, fmt.Errorf("account %s is not an approved market issuer", issuer)
+	}
+
+	markerAddr, err := markertypes.MarkerAddress(markerDenom)
+	if err != nil {
+		return 0, fmt.Errorf("invalid marker denom %q: %w", markerDenom, err)
+	}
+	marker, err := k.markerKeeper.GetMarker(ctx, markerAddr)
+	if err != nil {
+		return 0, fmt.Errorf("error getting marker %q: %w", markerDenom, err)
+	}
+	if marker == nil {
+		return 0, fmt.Errorf("marker %q not found", markerDenom)
+	}
+	if !marker.AddressHasAccess(issuer, markertypes.Access_Admin) {
+		return 0, fmt.Errorf("account %s does not have %s on marker %q", issuer, markertypes.Access_Admin, markerDenom)
+	}
+
+	if err = params.ValidateIssuerMarketFees(&market); err != nil {
+		return 0, err
+	}
+
+	if reqAttrs := marker.GetRequiredAttributes(); len(reqAttrs) > 0 {
+		market.ReqAttrCreateAsk = addReqAttrs(market.ReqAttrCreateAsk, reqAttrs)
+		market.ReqAttrCreateBid = addReqAttrs(market.ReqAttrCreateBid, reqAttrs)
+		market.ReqAttrCreateCommitment = addReqAttrs(market.ReqAttrCreateCommitment, reqAttrs)
+	}
+
+	return k.CreateMarket(ctx, market)
+}
+
+// addReqAttrs returns a copy of the provided required attributes with each of the toAdd entries
+// that it doesn't already have appended to it.
+func addReqAttrs(reqAttrs, toAdd []string) []string {
+	rv := slices.Clone(reqAttrs)
+	for _, attr := range toAdd {
+		if !slices.Contains(rv, attr) {
+			rv = append(rv, attr)
+		}
+	}
+	return rv
+}
+
 // GetMarket reads all the market info from state and returns it.
 // Returns nil if the market account doesn't exist or it's not a market account.
 func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
@@ -1547,8 +1627,11 @@ func (k Keeper) WithdrawMarketFunds(ctx sdk.Context, marketID uint32, toAddr sdk
 	if k.bankKeeper.BlockedAddr(toAddr) {
 		return fmt.Errorf("%s is not allowed to receive funds", toAddr)
 	}
+	if err = k.validateWithdrawNotFromRebatePool(ctx, marketID, amount); err != nil {
+		return err
+	}
 	marketAddr := exchange.GetMarketAddress(marketID)
-	xferCtx := markertypes.WithTransferAgents(ctx, admin)
+	xferCtx := markertypes.AddTransferAgents(ctx, admin)
 	if toAddr.Equals(admin) {
 		xferCtx = quarantine.WithBypass(xferCtx)
 	}
@@ -1596,11 +1679,12 @@ func (k Keeper) ValidateMarket(ctx sdk.Context, marketID uint32) error {
 	return errors.Join(errs...)
 }
 
-// CloseMarket disables order and commitment creation in a market,
-// cancels all its existing orders, and releases all its commitments.
+// CloseMarket disables order and commitment creation in a market, cancels all its
+// existing orders and requests for quote, and releases all its commitments.
 func (k Keeper) CloseMarket(ctx sdk.Context, marketID uint32, signer string) {
 	_ = k.UpdateMarketAcceptingOrders(ctx, marketID, false, signer)
 	_ = k.UpdateMarketAcceptingCommitments(ctx, marketID, false, signer)
 	k.CancelAllOrdersForMarket(ctx, marketID, signer)
 	k.ReleaseAllCommitmentsForMarket(ctx, marketID)
+	k.CancelAllRFQsForMarket(ctx, marketID)
 }
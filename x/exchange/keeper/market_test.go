@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/exchange"
 	"github.com/provenance-io/provenance/x/exchange/keeper"
 )
@@ -5648,9 +5649,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(store, 9, []string{"yy.zz", "*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, nil, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, nil, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
 			marketID: 8,
 			addr:     addr2,
 			expected: true,
@@ -5663,9 +5664,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(store, 9, []string{"yy.zz", "*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"left", "right"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"left", "right"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
 			marketID: 8,
 			addr:     addr2,
 			expected: true,
@@ -5676,7 +5677,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 4, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, nil, "injected test error"),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, nil, "injected test error"),
 			marketID:       4,
 			addr:           addr1,
 			expected:       false,
@@ -5688,7 +5689,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 88, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "bb.aa", "lm.no"}, ""),
 			marketID:       88,
 			addr:           addr2,
 			expected:       true,
@@ -5700,7 +5701,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 88, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
 			marketID:       88,
 			addr:           addr2,
 			expected:       false,
@@ -5712,7 +5713,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "jk.lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "jk.lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       true,
@@ -5724,7 +5725,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "ab.cd.lm.no", "cc.bb.aa", "jk.lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "ab.cd.lm.no", "cc.bb.aa", "jk.lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       true,
@@ -5736,7 +5737,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       false,
@@ -5748,9 +5749,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"one.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"one.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr2,
 			expected:       false,
@@ -5762,9 +5763,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"one.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"one.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr3,
 			expected:       false,
@@ -5776,9 +5777,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr3,
 			expected:       false,
@@ -5790,9 +5791,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr1,
 			expected:       true,
@@ -5804,9 +5805,9 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"two.bb.aa", "one.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"two.bb.aa", "one.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr1,
 			expected:       true,
@@ -5823,7 +5824,7 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 
 			var expCalls AttributeCalls
 			if tc.expGetAttrCall {
-				expCalls.GetAllAttributesAddr = append(expCalls.GetAllAttributesAddr, tc.addr)
+				expCalls.GetAllAttributesAddrWithMirror = append(expCalls.GetAllAttributesAddrWithMirror, tc.addr)
 			}
 
 			if tc.attrKeeper == nil {
@@ -5842,6 +5843,25 @@ func (s *TestSuite) TestKeeper_CanCreateAsk() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_CanCreateAsk_AttributeMirror() {
+	nameOwner := sdk.AccAddress("name_owner__________")
+	admin := sdk.AccAddress("contract_admin______")
+	contract := sdk.AccAddress("mirrored_contract___")
+
+	s.clearExchangeState()
+	keeper.SetReqAttrsAsk(s.getStore(), 1, []string{"kyc.provenance.io"})
+	s.app.AttributeKeeper.SetWasmKeeper(NewMockWasmKeeper().WithContract(contract, admin))
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, nameOwner))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.provenance.io", nameOwner, false), "SetNameRecord")
+	attr := attrtypes.NewAttribute("kyc.provenance.io", admin.String(), attrtypes.AttributeType_String, []byte("yes"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, nameOwner), "SetAttribute")
+
+	s.Assert().True(s.k.CanCreateAsk(s.ctx, 1, admin), "CanCreateAsk(admin)")
+	s.Assert().False(s.k.CanCreateAsk(s.ctx, 1, contract), "CanCreateAsk(contract) without mirror")
+	s.app.AttributeKeeper.SetAttributeMirror(s.ctx, contract, admin)
+	s.Assert().True(s.k.CanCreateAsk(s.ctx, 1, contract), "CanCreateAsk(contract) with mirror")
+}
+
 func (s *TestSuite) TestKeeper_CanCreateBid() {
 	setter := keeper.SetReqAttrsBid
 	addr1 := sdk.AccAddress("addr_one____________")
@@ -5871,9 +5891,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(store, 9, []string{"yy.zz", "*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, nil, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, nil, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
 			marketID: 8,
 			addr:     addr2,
 			expected: true,
@@ -5886,9 +5906,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(store, 9, []string{"yy.zz", "*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"left", "right"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"left", "right"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
 			marketID: 8,
 			addr:     addr2,
 			expected: true,
@@ -5899,7 +5919,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 4, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, nil, "injected test error"),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, nil, "injected test error"),
 			marketID:       4,
 			addr:           addr1,
 			expected:       false,
@@ -5911,7 +5931,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 88, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "bb.aa", "lm.no"}, ""),
 			marketID:       88,
 			addr:           addr2,
 			expected:       true,
@@ -5923,7 +5943,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 88, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
 			marketID:       88,
 			addr:           addr2,
 			expected:       false,
@@ -5935,7 +5955,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "jk.lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "jk.lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       true,
@@ -5947,7 +5967,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "ab.cd.lm.no", "cc.bb.aa", "jk.lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "ab.cd.lm.no", "cc.bb.aa", "jk.lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       true,
@@ -5959,7 +5979,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       false,
@@ -5971,9 +5991,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"one.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"one.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr2,
 			expected:       false,
@@ -5985,9 +6005,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"one.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"one.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr3,
 			expected:       false,
@@ -5999,9 +6019,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr3,
 			expected:       false,
@@ -6013,9 +6033,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr1,
 			expected:       true,
@@ -6027,9 +6047,9 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"two.bb.aa", "one.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"two.bb.aa", "one.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr1,
 			expected:       true,
@@ -6046,7 +6066,7 @@ func (s *TestSuite) TestKeeper_CanCreateBid() {
 
 			var expCalls AttributeCalls
 			if tc.expGetAttrCall {
-				expCalls.GetAllAttributesAddr = append(expCalls.GetAllAttributesAddr, tc.addr)
+				expCalls.GetAllAttributesAddrWithMirror = append(expCalls.GetAllAttributesAddrWithMirror, tc.addr)
 			}
 
 			if tc.attrKeeper == nil {
@@ -6094,9 +6114,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(store, 9, []string{"yy.zz", "*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, nil, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, nil, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
 			marketID: 8,
 			addr:     addr2,
 			expected: true,
@@ -6109,9 +6129,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(store, 9, []string{"yy.zz", "*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"left", "right"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"left", "right"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"jk.lm.nl", "yy.zz"}, ""),
 			marketID: 8,
 			addr:     addr2,
 			expected: true,
@@ -6122,7 +6142,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 4, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, nil, "injected test error"),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, nil, "injected test error"),
 			marketID:       4,
 			addr:           addr1,
 			expected:       false,
@@ -6134,7 +6154,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 88, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "bb.aa", "lm.no"}, ""),
 			marketID:       88,
 			addr:           addr2,
 			expected:       true,
@@ -6146,7 +6166,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 88, []string{"bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
 			marketID:       88,
 			addr:           addr2,
 			expected:       false,
@@ -6158,7 +6178,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "jk.lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "jk.lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       true,
@@ -6170,7 +6190,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "ab.cd.lm.no", "cc.bb.aa", "jk.lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "ab.cd.lm.no", "cc.bb.aa", "jk.lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       true,
@@ -6182,7 +6202,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 42, []string{"*.lm.no"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"yy.zz", "cc.bb.aa", "lm.no"}, ""),
 			marketID:       42,
 			addr:           addr2,
 			expected:       false,
@@ -6194,9 +6214,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"one.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"one.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr2,
 			expected:       false,
@@ -6208,9 +6228,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"one.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"one.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr3,
 			expected:       false,
@@ -6222,9 +6242,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr3,
 			expected:       false,
@@ -6236,9 +6256,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"one.bb.aa", "two.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr1,
 			expected:       true,
@@ -6250,9 +6270,9 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 				setter(s.getStore(), 123, []string{"one.bb.aa", "two.bb.aa"})
 			},
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(addr1, []string{"two.bb.aa", "one.bb.aa"}, "").
-				WithGetAllAttributesAddrResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
-				WithGetAllAttributesAddrResult(addr3, []string{"two.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(addr1, []string{"two.bb.aa", "one.bb.aa"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr2, []string{"one.yy.zz", "two.yy.zz"}, "").
+				WithGetAllAttributesAddrWithMirrorResult(addr3, []string{"two.bb.aa"}, ""),
 			marketID:       123,
 			addr:           addr1,
 			expected:       true,
@@ -6269,7 +6289,7 @@ func (s *TestSuite) TestKeeper_CanCreateCommitment() {
 
 			var expCalls AttributeCalls
 			if tc.expGetAttrCall {
-				expCalls.GetAllAttributesAddr = append(expCalls.GetAllAttributesAddr, tc.addr)
+				expCalls.GetAllAttributesAddrWithMirror = append(expCalls.GetAllAttributesAddrWithMirror, tc.addr)
 			}
 
 			if tc.attrKeeper == nil {
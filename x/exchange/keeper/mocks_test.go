@@ -8,6 +8,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -165,33 +167,33 @@ var _ exchange.AttributeKeeper = (*MockAttributeKeeper)(nil)
 
 // MockAttributeKeeper satisfies the exchange.AttributeKeeper interface but just records the calls and allows dictation of results.
 type MockAttributeKeeper struct {
-	Calls                          AttributeCalls
-	GetAllAttributesAddrResultsMap map[string]*GetAllAttributesAddrResult
+	Calls                                    AttributeCalls
+	GetAllAttributesAddrWithMirrorResultsMap map[string]*GetAllAttributesAddrWithMirrorResult
 }
 
 // AttributeCalls contains all the calls that the mock attribute keeper makes.
 type AttributeCalls struct {
-	GetAllAttributesAddr [][]byte
+	GetAllAttributesAddrWithMirror [][]byte
 }
 
-// GetAllAttributesAddrResult contains the result args to return for a GetAllAttributesAddr call.
-type GetAllAttributesAddrResult struct {
+// GetAllAttributesAddrWithMirrorResult contains the result args to return for a GetAllAttributesAddrWithMirror call.
+type GetAllAttributesAddrWithMirrorResult struct {
 	attrs []attrtypes.Attribute
 	err   error
 }
 
 // NewMockAttributeKeeper creates a new empty MockAttributeKeeper.
-// Follow it up with WithGetAllAttributesAddrResult to dictate results.
+// Follow it up with WithGetAllAttributesAddrWithMirrorResult to dictate results.
 func NewMockAttributeKeeper() *MockAttributeKeeper {
 	return &MockAttributeKeeper{
-		GetAllAttributesAddrResultsMap: make(map[string]*GetAllAttributesAddrResult),
+		GetAllAttributesAddrWithMirrorResultsMap: make(map[string]*GetAllAttributesAddrWithMirrorResult),
 	}
 }
 
-// WithGetAllAttributesAddrResult sets up the provided address to return the given attrs
-// and error from calls to GetAllAttributesAddr. An empty string means no error.
+// WithGetAllAttributesAddrWithMirrorResult sets up the provided address to return the given attrs
+// and error from calls to GetAllAttributesAddrWithMirror. An empty string means no error.
 // This method both updates the receiver and returns it.
-func (k *MockAttributeKeeper) WithGetAllAttributesAddrResult(addr []byte, attrNames []string, errStr string) *MockAttributeKeeper {
+func (k *MockAttributeKeeper) WithGetAllAttributesAddrWithMirrorResult(addr []byte, attrNames []string, errStr string) *MockAttributeKeeper {
 	var attrs []attrtypes.Attribute
 	if attrNames != nil {
 		attrs = make([]attrtypes.Attribute, len(attrNames))
@@ -204,37 +206,37 @@ func (k *MockAttributeKeeper) WithGetAllAttributesAddrResult(addr []byte, attrNa
 			}
 		}
 	}
-	k.GetAllAttributesAddrResultsMap[string(addr)] = NewGetAllAttributesAddrResult(attrs, errStr)
+	k.GetAllAttributesAddrWithMirrorResultsMap[string(addr)] = NewGetAllAttributesAddrWithMirrorResult(attrs, errStr)
 	return k
 }
 
-func (k *MockAttributeKeeper) GetAllAttributesAddr(_ sdk.Context, addr []byte) ([]attrtypes.Attribute, error) {
-	k.Calls.GetAllAttributesAddr = append(k.Calls.GetAllAttributesAddr, addr)
-	if rv, found := k.GetAllAttributesAddrResultsMap[string(addr)]; found {
+func (k *MockAttributeKeeper) GetAllAttributesAddrWithMirror(_ sdk.Context, addr []byte) ([]attrtypes.Attribute, error) {
+	k.Calls.GetAllAttributesAddrWithMirror = append(k.Calls.GetAllAttributesAddrWithMirror, addr)
+	if rv, found := k.GetAllAttributesAddrWithMirrorResultsMap[string(addr)]; found {
 		return rv.attrs, rv.err
 	}
 	return nil, nil
 }
 
-// assertGetAllAttributesAddrCalls asserts that a mock keeper's Calls.GetAllAttributesAddr match the provided expected calls.
-func (s *TestSuite) assertGetAllAttributesAddrCalls(mk *MockAttributeKeeper, expected [][]byte, msg string, args ...interface{}) bool {
+// assertGetAllAttributesAddrWithMirrorCalls asserts that a mock keeper's Calls.GetAllAttributesAddrWithMirror match the provided expected calls.
+func (s *TestSuite) assertGetAllAttributesAddrWithMirrorCalls(mk *MockAttributeKeeper, expected [][]byte, msg string, args ...interface{}) bool {
 	s.T().Helper()
-	return assertEqualSlice(s, expected, mk.Calls.GetAllAttributesAddr,
+	return assertEqualSlice(s, expected, mk.Calls.GetAllAttributesAddrWithMirror,
 		func(addr []byte) string {
 			return s.getAddrName(addr)
 		},
-		msg+" GetAllAttributesAddr calls", args...)
+		msg+" GetAllAttributesAddrWithMirror calls", args...)
 }
 
 // assertAttributeKeeperCalls asserts that all the calls made to a mock account keeper match the provided expected calls.
 func (s *TestSuite) assertAttributeKeeperCalls(mk *MockAttributeKeeper, expected AttributeCalls, msg string, args ...interface{}) bool {
 	s.T().Helper()
-	return s.assertGetAllAttributesAddrCalls(mk, expected.GetAllAttributesAddr, msg, args...)
+	return s.assertGetAllAttributesAddrWithMirrorCalls(mk, expected.GetAllAttributesAddrWithMirror, msg, args...)
 }
 
-// NewGetAllAttributesAddrResult creates a new GetAllAttributesAddrResult from the provided stuff.
-func NewGetAllAttributesAddrResult(attrs []attrtypes.Attribute, errStr string) *GetAllAttributesAddrResult {
-	rv := &GetAllAttributesAddrResult{attrs: attrs}
+// NewGetAllAttributesAddrWithMirrorResult creates a new GetAllAttributesAddrWithMirrorResult from the provided stuff.
+func NewGetAllAttributesAddrWithMirrorResult(attrs []attrtypes.Attribute, errStr string) *GetAllAttributesAddrWithMirrorResult {
+	rv := &GetAllAttributesAddrWithMirrorResult{attrs: attrs}
 	if len(errStr) > 0 {
 		rv.err = errors.New(errStr)
 	}
@@ -256,6 +258,7 @@ type MockBankKeeper struct {
 	SendCoinsFromAccountToModuleResultsQueue []string
 	InputOutputCoinsResultsQueue             []string
 	BlockedAddrQueue                         []bool
+	SpendableCoinsQueue                      []sdk.Coins
 }
 
 // BankCalls contains all the calls that the mock bank keeper makes.
@@ -264,6 +267,7 @@ type BankCalls struct {
 	SendCoinsFromAccountToModule []*SendCoinsFromAccountToModuleArgs
 	InputOutputCoins             []*InputOutputCoinsArgs
 	BlockedAddr                  []sdk.AccAddress
+	SpendableCoins               []sdk.AccAddress
 }
 
 // SendCoinsArgs is a record of a call that is made to SendCoins.
@@ -331,6 +335,14 @@ func (k *MockBankKeeper) WithBlockedAddrResults(results ...bool) *MockBankKeeper
 	return k
 }
 
+// WithSpendableCoinsResults queues up the provided coins to be returned from SpendableCoins.
+// Each entry is used only once. If entries run out, nil is returned.
+// This method both updates the receiver and returns it.
+func (k *MockBankKeeper) WithSpendableCoinsResults(results ...sdk.Coins) *MockBankKeeper {
+	k.SpendableCoinsQueue = append(k.SpendableCoinsQueue, results...)
+	return k
+}
+
 func (k *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	k.Calls.SendCoins = append(k.Calls.SendCoins, NewSendCoinsArgs(ctx, fromAddr, toAddr, amt))
 	var err error
@@ -378,6 +390,16 @@ func (k *MockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return rv
 }
 
+func (k *MockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	k.Calls.SpendableCoins = append(k.Calls.SpendableCoins, addr)
+	var rv sdk.Coins
+	if len(k.SpendableCoinsQueue) > 0 {
+		rv = k.SpendableCoinsQueue[0]
+		k.SpendableCoinsQueue = k.SpendableCoinsQueue[1:]
+	}
+	return rv
+}
+
 // assertSendCoinsCalls asserts that a mock keeper's Calls.SendCoins match the provided expected calls.
 func (s *TestSuite) assertSendCoinsCalls(mk *MockBankKeeper, expected []*SendCoinsArgs, msg string, args ...interface{}) bool {
 	s.T().Helper()
@@ -1099,3 +1121,36 @@ func (p MDGetNetAssetValueResult) Err() error {
 	}
 	return errors.New(p.B)
 }
+
+// #############################################################################
+// ##############################                ###############################
+// ############################   MockWasmKeeper   #############################
+// ##############################                ###############################
+// #############################################################################
+
+var _ attrtypes.WasmKeeper = (*MockWasmKeeper)(nil)
+
+// MockWasmKeeper satisfies the attribute module's WasmKeeper interface, but only knows about the contract admins it was given.
+type MockWasmKeeper struct {
+	Admins map[string]string
+}
+
+// NewMockWasmKeeper creates a new MockWasmKeeper without any contracts.
+func NewMockWasmKeeper() *MockWasmKeeper {
+	return &MockWasmKeeper{Admins: make(map[string]string)}
+}
+
+// WithContract adds a contract with the provided admin. This method both updates the receiver and returns it.
+func (k *MockWasmKeeper) WithContract(contract, admin sdk.AccAddress) *MockWasmKeeper {
+	k.Admins[string(contract)] = admin.String()
+	return k
+}
+
+// GetContractInfo returns info with the admin of the contract, or nil if it doesn't exist.
+func (k *MockWasmKeeper) GetContractInfo(_ context.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	admin, found := k.Admins[string(contract)]
+	if !found {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin}
+}
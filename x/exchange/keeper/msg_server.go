@@ -102,6 +102,9 @@ func (k MsgServer) MarketSettle(goCtx context.Context, msg *exchange.MsgMarketSe
 	if !k.CanSettleOrders(ctx, msg.MarketId, msg.Admin) {
 		return nil, permError("settle orders for", msg.Admin, msg.MarketId)
 	}
+	if msg.OverridePriceBand && !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("override the price band of", msg.Admin, msg.MarketId)
+	}
 	err := k.SettleOrders(ctx, msg)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -109,6 +112,80 @@ func (k MsgServer) MarketSettle(goCtx context.Context, msg *exchange.MsgMarketSe
 	return &exchange.MsgMarketSettleResponse{}, nil
 }
 
+// MarketStartExternalSettlement is a market endpoint to start the settlement of an ask order that will be paid for
+// outside of the blockchain.
+func (k MsgServer) MarketStartExternalSettlement(goCtx context.Context, msg *exchange.MsgMarketStartExternalSettlementRequest) (*exchange.MsgMarketStartExternalSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanSettleOrders(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("settle orders for", msg.Admin, msg.MarketId)
+	}
+	settlement, err := k.StartExternalSettlement(ctx, msg.MarketId, msg.OrderId, msg.Buyer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketStartExternalSettlementResponse{ExpirationHeight: settlement.ExpirationHeight}, nil
+}
+
+// ConfirmExternalPayment is used by a market's oracle to confirm payment was made for an external settlement.
+func (k MsgServer) ConfirmExternalPayment(goCtx context.Context, msg *exchange.MsgConfirmExternalPaymentRequest) (*exchange.MsgConfirmExternalPaymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.ConfirmExternalPayment(ctx, msg.MarketId, msg.OrderId, msg.Oracle, msg.PaymentReference)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgConfirmExternalPaymentResponse{}, nil
+}
+
+// CreateRFQ creates a request for quote (to ask dealers what they'd sell some assets for).
+func (k MsgServer) CreateRFQ(goCtx context.Context, msg *exchange.MsgCreateRFQRequest) (*exchange.MsgCreateRFQResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rfqID, err := k.Keeper.CreateRFQ(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCreateRFQResponse{RfqId: rfqID}, nil
+}
+
+// CancelRFQ cancels a request for quote, releasing the holds on all of its quotes.
+func (k MsgServer) CancelRFQ(goCtx context.Context, msg *exchange.MsgCancelRFQRequest) (*exchange.MsgCancelRFQResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.CancelRFQ(ctx, msg.RfqId, msg.Buyer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCancelRFQResponse{}, nil
+}
+
+// SubmitQuote is used by a dealer to offer to sell the assets of a request for quote for a price.
+func (k MsgServer) SubmitQuote(goCtx context.Context, msg *exchange.MsgSubmitQuoteRequest) (*exchange.MsgSubmitQuoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.SubmitQuote(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgSubmitQuoteResponse{}, nil
+}
+
+// WithdrawQuote is used by a dealer to withdraw their quote from a request for quote.
+func (k MsgServer) WithdrawQuote(goCtx context.Context, msg *exchange.MsgWithdrawQuoteRequest) (*exchange.MsgWithdrawQuoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.Keeper.WithdrawQuote(ctx, msg.RfqId, msg.Dealer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgWithdrawQuoteResponse{}, nil
+}
+
+// AcceptQuote is used by a buyer to accept a quote, settling it immediately.
+func (k MsgServer) AcceptQuote(goCtx context.Context, msg *exchange.MsgAcceptQuoteRequest) (*exchange.MsgAcceptQuoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	askOrderID, bidOrderID, err := k.Keeper.AcceptQuote(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgAcceptQuoteResponse{AskOrderId: askOrderID, BidOrderId: bidOrderID}, nil
+}
+
 // MarketCommitmentSettle is a market endpoint to transfer committed funds.
 func (k MsgServer) MarketCommitmentSettle(goCtx context.Context, msg *exchange.MsgMarketCommitmentSettleRequest) (*exchange.MsgMarketCommitmentSettleResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -242,6 +319,58 @@ func (k MsgServer) MarketUpdateIntermediaryDenom(goCtx context.Context, msg *exc
 	return &exchange.MsgMarketUpdateIntermediaryDenomResponse{}, nil
 }
 
+// MarketUpdateRebates sets a market's liquidity rebate configuration.
+func (k MsgServer) MarketUpdateRebates(goCtx context.Context, msg *exchange.MsgMarketUpdateRebatesRequest) (*exchange.MsgMarketUpdateRebatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateMarketRebates(ctx, msg.MarketId, msg.Config, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateRebatesResponse{}, nil
+}
+
+// MarketUpdateExternalSettlement sets a market's external settlement configuration.
+func (k MsgServer) MarketUpdateExternalSettlement(goCtx context.Context, msg *exchange.MsgMarketUpdateExternalSettlementRequest) (*exchange.MsgMarketUpdateExternalSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateMarketExternalSettlement(ctx, msg.MarketId, msg.Config, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateExternalSettlementResponse{}, nil
+}
+
+// MarketUpdatePriceBand sets a market's price band configuration.
+func (k MsgServer) MarketUpdatePriceBand(goCtx context.Context, msg *exchange.MsgMarketUpdatePriceBandRequest) (*exchange.MsgMarketUpdatePriceBandResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateMarketPriceBand(ctx, msg.MarketId, msg.Config, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdatePriceBandResponse{}, nil
+}
+
+// MarketResumeSettlements resumes settlements in a market that were paused because one was outside its price band.
+func (k MsgServer) MarketResumeSettlements(goCtx context.Context, msg *exchange.MsgMarketResumeSettlementsRequest) (*exchange.MsgMarketResumeSettlementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.ResumeMarketSettlements(ctx, msg.MarketId, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketResumeSettlementsResponse{}, nil
+}
+
 // MarketManagePermissions is a market endpoint to manage a market's user permissions.
 func (k MsgServer) MarketManagePermissions(goCtx context.Context, msg *exchange.MsgMarketManagePermissionsRequest) (*exchange.MsgMarketManagePermissionsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -375,6 +504,22 @@ func (k MsgServer) ChangePaymentTarget(goCtx context.Context, msg *exchange.MsgC
 	return &exchange.MsgChangePaymentTargetResponse{}, nil
 }
 
+// IssuerCreateMarket can be used by an approved issuer to create a market for a marker denom that they administer.
+func (k MsgServer) IssuerCreateMarket(goCtx context.Context, msg *exchange.MsgIssuerCreateMarketRequest) (*exchange.MsgIssuerCreateMarketResponse, error) {
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid issuer %q: %v", msg.Issuer, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	marketID, err := k.Keeper.IssuerCreateMarket(ctx, issuer, msg.MarkerDenom, msg.Market)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &exchange.MsgIssuerCreateMarketResponse{MarketId: marketID}, nil
+}
+
 // GovCreateMarket is a governance proposal endpoint for creating a market.
 func (k MsgServer) GovCreateMarket(goCtx context.Context, msg *exchange.MsgGovCreateMarketRequest) (*exchange.MsgGovCreateMarketResponse, error) {
 	if err := k.ValidateAuthority(msg.Authority); err != nil {
@@ -971,7 +971,7 @@ func (s *TestSuite) TestMsgServer_CommitFunds() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1cherry"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1cherry"),
 				s.eventMessageSender(s.marketAddr3),
-				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), "x/exchange: commitment to 3")),
+				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), hold.HoldReason{Description: "x/exchange: commitment to 3"})),
 				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 3, s.coins("50apple,90cherry"), "yayayayeah")),
 			},
 		},
@@ -1291,7 +1291,7 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 				s.eventTransfer(s.addr2, s.addr1, "50pear"),
 				s.eventMessageSender(s.addr1),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, ReceiptId: 1,
 				}),
 				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
@@ -1349,7 +1349,7 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 				s.eventTransfer(s.addr2, s.addr1, "50pear"),
 				s.eventMessageSender(s.addr1),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, ReceiptId: 1,
 				}),
 				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
@@ -1660,6 +1660,7 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 					Fees:       "35fig",
 					MarketId:   1,
 					ExternalId: "first order",
+					ReceiptId:  1,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
 					OrderId:    98765,
@@ -1668,6 +1669,7 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 					Fees:       "32fig",
 					MarketId:   1,
 					ExternalId: "second order",
+					ReceiptId:  2,
 				}),
 
 				// The net-asset-value event.
@@ -1781,7 +1783,7 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 				s.eventTransfer(s.addr2, s.addr1, "50pear"),
 				s.eventMessageSender(s.addr1),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, ReceiptId: 1,
 				}),
 				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
@@ -1839,7 +1841,7 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 				s.eventTransfer(s.addr2, s.addr1, "50pear"),
 				s.eventMessageSender(s.addr1),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3,
+					OrderId: 54, Assets: "10apple", Price: "50pear", MarketId: 3, ReceiptId: 1,
 				}),
 				s.markerNavSetEvent("10apple", "50pear", 3),
 			},
@@ -2149,6 +2151,7 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 					Fees:       "8pear",
 					MarketId:   1,
 					ExternalId: "first order",
+					ReceiptId:  1,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
 					OrderId:    98765,
@@ -2157,6 +2160,7 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 					Fees:       "12fig,2pear",
 					MarketId:   1,
 					ExternalId: "second order",
+					ReceiptId:  2,
 				}),
 
 				// The net-asset-value event.
@@ -2427,16 +2431,16 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 
 				// Orders filled (24-27)
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1,
+					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1, ReceiptId: 1,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1,
+					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1, ReceiptId: 2,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1,
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1, ReceiptId: 3,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1,
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1, ReceiptId: 4,
 				}),
 
 				// The net-asset-value event (28).
@@ -2601,16 +2605,16 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 
 				// Orders filled (24-27)
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1,
+					OrderId: 333, Assets: "11apple", Price: "109pear", MarketId: 1, ReceiptId: 1,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1,
+					OrderId: 1, Assets: "7apple", Price: "76pear", MarketId: 1, ReceiptId: 2,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1,
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1, ReceiptId: 3,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1,
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1, ReceiptId: 4,
 				}),
 
 				// The net-asset-value event (28).
@@ -2720,16 +2724,16 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 
 				// Orders filled
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 1,
+					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 1, ReceiptId: 1,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 1,
+					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 1, ReceiptId: 2,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1,
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 1, ReceiptId: 3,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1,
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 1, ReceiptId: 4,
 				}),
 
 				// The net-asset-value event.
@@ -2801,11 +2805,11 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 
 				// Orders filled
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "7apple", Price: "75pear", MarketId: 3,
+					OrderId: 22, Assets: "7apple", Price: "75pear", MarketId: 3, ReceiptId: 1,
 				}),
 				// Partial fill
 				s.untypeEvent(&exchange.EventOrderPartiallyFilled{
-					OrderId: 1, Assets: "7apple", Price: "75pear", MarketId: 3,
+					OrderId: 1, Assets: "7apple", Price: "75pear", MarketId: 3, ReceiptId: 2,
 				}),
 
 				// The net-asset-value event.
@@ -2877,11 +2881,11 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 
 				// Orders filled
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "70pear", MarketId: 3,
+					OrderId: 1, Assets: "7apple", Price: "70pear", MarketId: 3, ReceiptId: 1,
 				}),
 				// Partial fill
 				s.untypeEvent(&exchange.EventOrderPartiallyFilled{
-					OrderId: 22, Assets: "7apple", Price: "70pear", MarketId: 3,
+					OrderId: 22, Assets: "7apple", Price: "70pear", MarketId: 3, ReceiptId: 2,
 				}),
 
 				// The net-asset-value event.
@@ -3085,16 +3089,16 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 
 				// Orders filled
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 2, Fees: "10fig,8pear",
+					OrderId: 1, Assets: "7apple", Price: "77pear", MarketId: 2, Fees: "10fig,8pear", ReceiptId: 1,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 2, Fees: "16pear",
+					OrderId: 333, Assets: "11apple", Price: "108pear", MarketId: 2, Fees: "16pear", ReceiptId: 2,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 2, Fees: "20fig",
+					OrderId: 22, Assets: "10apple", Price: "100pear", MarketId: 2, Fees: "20fig", ReceiptId: 3,
 				}),
 				s.untypeEvent(&exchange.EventOrderFilled{
-					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 2, Fees: "10pear",
+					OrderId: 4444, Assets: "8apple", Price: "85pear", MarketId: 2, Fees: "10pear", ReceiptId: 4,
 				}),
 
 				// The net-asset-value event.
@@ -5165,6 +5169,135 @@ func (s *TestSuite) TestMsgServer_ChangePaymentTarget() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_IssuerCreateMarket() {
+	testDef := msgServerTestDef[exchange.MsgIssuerCreateMarketRequest, exchange.MsgIssuerCreateMarketResponse, exchange.Market]{
+		endpointName: "IssuerCreateMarket",
+		endpoint:     keeper.NewMsgServer(s.k).IssuerCreateMarket,
+		expResp:      &exchange.MsgIssuerCreateMarketResponse{MarketId: 3},
+		followup: func(_ *exchange.MsgIssuerCreateMarketRequest, expMarket exchange.Market) {
+			actMarket := s.k.GetMarket(s.ctx, expMarket.MarketId)
+			s.Assert().Equal(expMarket, *actMarket, "GetMarket(%d)", expMarket.MarketId)
+		},
+	}
+
+	setParams := func() {
+		s.k.SetParams(s.ctx, &exchange.Params{
+			MarketIssuers:            []string{s.addr1.String(), s.addr2.String()},
+			IssuerMarketMaxFlatFees:  s.coins("20fig"),
+			IssuerMarketMaxRatioBips: 100,
+		})
+	}
+	setup := func() {
+		setParams()
+		s.requireAddFinalizeAndActivateMarker(s.coin("1000acorn"), s.addr1, "kyc.acorn.pb")
+		s.requireAddFinalizeAndActivateMarker(s.coin("1000apple"), s.addr2)
+	}
+	validMarket := func(marketID uint32) exchange.Market {
+		return exchange.Market{
+			MarketId:                  marketID,
+			MarketDetails:             exchange.MarketDetails{Name: "Acorn Market"},
+			FeeCreateAskFlat:          s.coins("20fig"),
+			FeeSellerSettlementRatios: s.ratios("100fig:1fig"),
+			AcceptingOrders:           true,
+			AccessGrants:              []exchange.AccessGrant{s.agCanEverything(s.addr1)},
+			ReqAttrCreateBid:          []string{"*.some.thing"},
+		}
+	}
+
+	tests := []msgServerTestCase[exchange.MsgIssuerCreateMarketRequest, exchange.Market]{
+		{
+			name:  "not a market issuer",
+			setup: setup,
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer: s.addr3.String(), MarkerDenom: "acorn", Market: validMarket(3),
+			},
+			expInErr: []string{invReqErr, "account " + s.addr3.String() + " is not an approved market issuer"},
+		},
+		{
+			name:  "marker does not exist",
+			setup: setup,
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer: s.addr1.String(), MarkerDenom: "banana", Market: validMarket(3),
+			},
+			expInErr: []string{invReqErr, "marker \"banana\" not found"},
+		},
+		{
+			name:  "issuer is not an admin of the marker",
+			setup: setup,
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer: s.addr1.String(), MarkerDenom: "apple", Market: validMarket(3),
+			},
+			expInErr: []string{invReqErr, "account " + s.addr1.String() + " does not have ACCESS_ADMIN on marker \"apple\""},
+		},
+		{
+			name:  "fees too large",
+			setup: setup,
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer:      s.addr1.String(),
+				MarkerDenom: "acorn",
+				Market: exchange.Market{
+					MarketDetails:            exchange.MarketDetails{Name: "Expensive"},
+					FeeCreateBidFlat:         s.coins("21fig"),
+					FeeBuyerSettlementRatios: s.ratios("100fig:2fig"),
+				},
+			},
+			expInErr: []string{
+				invReqErr,
+				"create-bid flat fee \"21fig\" exceeds the issuer market max of 20fig",
+				"buyer settlement fee ratio \"100fig:2fig\" exceeds the issuer market max of 100 bips",
+			},
+		},
+		{
+			name: "error creating market",
+			setup: func() {
+				setup()
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 3})
+			},
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer: s.addr1.String(), MarkerDenom: "acorn", Market: validMarket(3),
+			},
+			expInErr: []string{invReqErr, "market id 3 account " + exchange.GetMarketAddress(3).String() + " already exists"},
+		},
+		{
+			name: "okay: next market id, marker attributes added",
+			setup: func() {
+				setup()
+				keeper.SetLastAutoMarketID(s.getStore(), 2)
+			},
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer: s.addr1.String(), MarkerDenom: "acorn", Market: validMarket(0),
+			},
+			fArgs: func() exchange.Market {
+				rv := validMarket(3)
+				rv.ReqAttrCreateAsk = []string{"kyc.acorn.pb"}
+				rv.ReqAttrCreateBid = []string{"*.some.thing", "kyc.acorn.pb"}
+				rv.ReqAttrCreateCommitment = []string{"kyc.acorn.pb"}
+				return rv
+			}(),
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketCreated{MarketId: 3}),
+			},
+		},
+		{
+			name:  "okay: marker without required attributes",
+			setup: setup,
+			msg: exchange.MsgIssuerCreateMarketRequest{
+				Issuer: s.addr2.String(), MarkerDenom: "apple", Market: validMarket(3),
+			},
+			fArgs: validMarket(3),
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketCreated{MarketId: 3}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_GovCreateMarket() {
 	testDef := msgServerTestDef[exchange.MsgGovCreateMarketRequest, exchange.MsgGovCreateMarketResponse, uint32]{
 		endpointName: "GovCreateMarket",
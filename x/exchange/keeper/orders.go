@@ -676,6 +676,9 @@ func (k Keeper) CreateBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, crea
 	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
 		return 0, err
 	}
+	if err := k.validateNotExternallySettled(store, marketID); err != nil {
+		return 0, err
+	}
 	buyer := sdk.MustAccAddressFromBech32(bidOrder.Buyer)
 	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
 		return 0, err
@@ -716,7 +719,8 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 	}
 
 	orderOwner := order.GetOwner()
-	if signer != orderOwner && !k.CanCancelOrdersForMarket(ctx, order.GetMarketID(), signer) {
+	if signer != orderOwner && !k.CanCancelOrdersForMarket(ctx, order.GetMarketID(), signer) &&
+		!k.HasTradingAuthorization(ctx, signer, orderOwner, exchange.TypeURLMsgCancelOrderRequest, order.GetMarketID()) {
 		return fmt.Errorf("account %s does not have permission to cancel order %d", signer, orderID)
 	}
 
@@ -733,6 +737,22 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 	return nil
 }
 
+// HasTradingAuthorization returns true if the granter has given the grantee a MarketTradingAuthorization
+// for the provided msg type url that includes the provided market. Expired grants are not considered.
+func (k Keeper) HasTradingAuthorization(ctx sdk.Context, grantee, granter, msgTypeURL string, marketID uint32) bool {
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return false
+	}
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return false
+	}
+	authorization, _ := k.authzKeeper.GetAuthorization(ctx, granteeAddr, granterAddr, msgTypeURL)
+	tradingAuth, ok := authorization.(*exchange.MarketTradingAuthorization)
+	return ok && tradingAuth.AllowsMarket(marketID)
+}
+
 // SetOrderExternalID updates an order's external id.
 // The caller is responsible for making sure this update should be allowed (e.g. by calling CanSetIDs first).
 func (k Keeper) SetOrderExternalID(ctx sdk.Context, marketID uint32, orderID uint64, newExternalID string) error {
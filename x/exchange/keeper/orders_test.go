@@ -513,7 +513,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 		{
 			name: "attrs required: does not have",
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(s.addr4, []string{"ccc.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(s.addr4, []string{"ccc.bb.aa"}, ""),
 			setup: func() {
 				s.requireCreateMarket(exchange.Market{
 					MarketId:         7,
@@ -722,7 +722,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 		},
 		{
 			name:       "attrs required: has",
-			attrKeeper: NewMockAttributeKeeper().WithGetAllAttributesAddrResult(s.addr2, []string{"dd.cc.bb.aa"}, ""),
+			attrKeeper: NewMockAttributeKeeper().WithGetAllAttributesAddrWithMirrorResult(s.addr2, []string{"dd.cc.bb.aa"}, ""),
 			setup: func() {
 				s.k.SetParams(s.ctx, &exchange.Params{
 					DefaultSplit: 200,
@@ -986,7 +986,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 		{
 			name: "attrs required: does not have",
 			attrKeeper: NewMockAttributeKeeper().
-				WithGetAllAttributesAddrResult(s.addr4, []string{"ccc.bb.aa"}, ""),
+				WithGetAllAttributesAddrWithMirrorResult(s.addr4, []string{"ccc.bb.aa"}, ""),
 			setup: func() {
 				s.requireCreateMarket(exchange.Market{
 					MarketId:         7,
@@ -1214,7 +1214,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 		},
 		{
 			name:       "attrs required: has",
-			attrKeeper: NewMockAttributeKeeper().WithGetAllAttributesAddrResult(s.addr2, []string{"dd.cc.bb.aa"}, ""),
+			attrKeeper: NewMockAttributeKeeper().WithGetAllAttributesAddrWithMirrorResult(s.addr2, []string{"dd.cc.bb.aa"}, ""),
 			setup: func() {
 				s.k.SetParams(s.ctx, &exchange.Params{
 					DefaultSplit: 200,
@@ -1647,6 +1647,55 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			signer:       s.addr1.String(),
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("55plum")}}},
 		},
+		{
+			name: "signer has trading authorization for other market",
+			setup: func() *exchange.Order {
+				s.requireSaveTradingGrant(s.addr4, s.addr1, exchange.TypeURLMsgCancelOrderRequest, 2)
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(61).WithAsk(&exchange.AskOrder{
+					MarketId: 1,
+					Seller:   s.addr1.String(),
+					Assets:   s.coin("12apple"),
+					Price:    s.coin("55plum"),
+				}))
+				return nil
+			},
+			orderID: 61,
+			signer:  s.addr4.String(),
+			expErr:  "account " + s.addr4.String() + " does not have permission to cancel order 61",
+		},
+		{
+			name: "signer has trading authorization for create ask in market",
+			setup: func() *exchange.Order {
+				s.requireSaveTradingGrant(s.addr4, s.addr1, exchange.TypeURLMsgCreateAskRequest, 1)
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(62).WithAsk(&exchange.AskOrder{
+					MarketId: 1,
+					Seller:   s.addr1.String(),
+					Assets:   s.coin("12apple"),
+					Price:    s.coin("55plum"),
+				}))
+				return nil
+			},
+			orderID: 62,
+			signer:  s.addr4.String(),
+			expErr:  "account " + s.addr4.String() + " does not have permission to cancel order 62",
+		},
+		{
+			name: "signer has trading authorization for market",
+			setup: func() *exchange.Order {
+				s.requireSaveTradingGrant(s.addr4, s.addr1, exchange.TypeURLMsgCancelOrderRequest, 3, 1)
+				orderToCancel := exchange.NewOrder(63).WithAsk(&exchange.AskOrder{
+					MarketId: 1,
+					Seller:   s.addr1.String(),
+					Assets:   s.coin("12apple"),
+					Price:    s.coin("55plum"),
+				})
+				s.requireSetOrderInStore(s.getStore(), orderToCancel)
+				return orderToCancel
+			},
+			orderID:      63,
+			signer:       s.addr4.String(),
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("12apple")}}},
+		},
 	}
 
 	for _, tc := range tests {
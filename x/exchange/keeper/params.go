@@ -112,13 +112,60 @@ func getParamsFeeAcceptPaymentFlat(store storetypes.KVStore) []sdk.Coin {
 	return getParamsPaymentFlatFee(store, MakeKeyParamsFeeAcceptPaymentFlat())
 }
 
+// setParamsMarketIssuers sets the params entry for the market issuers.
+func setParamsMarketIssuers(store storetypes.KVStore, issuers []string) {
+	key := MakeKeyParamsMarketIssuers()
+	if len(issuers) == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, []byte(strings.Join(issuers, ",")))
+}
+
+// getParamsMarketIssuers gets the params entry for the market issuers.
+func getParamsMarketIssuers(store storetypes.KVStore) []string {
+	val := store.Get(MakeKeyParamsMarketIssuers())
+	if len(val) == 0 {
+		return nil
+	}
+	return strings.Split(string(val), ",")
+}
+
+// setParamsIssuerMarketMaxFlatFees sets the params entry for the issuer market max flat fees.
+func setParamsIssuerMarketMaxFlatFees(store storetypes.KVStore, maxFees []sdk.Coin) {
+	setParamsFeePaymentFlat(store, MakeKeyParamsIssuerMarketMaxFlatFees(), maxFees)
+}
+
+// getParamsIssuerMarketMaxFlatFees gets the params entry for the issuer market max flat fees.
+func getParamsIssuerMarketMaxFlatFees(store storetypes.KVStore) []sdk.Coin {
+	return getParamsPaymentFlatFee(store, MakeKeyParamsIssuerMarketMaxFlatFees())
+}
+
+// setParamsIssuerMarketMaxRatioBips sets the params entry for the issuer market max ratio bips.
+func setParamsIssuerMarketMaxRatioBips(store storetypes.KVStore, bips uint32) {
+	key := MakeKeyParamsIssuerMarketMaxRatioBips()
+	if bips == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, uint16Bz(uint16(bips))) //nolint:gosec // G115: Validated elsewhere to be 10,000 max.
+}
+
+// getParamsIssuerMarketMaxRatioBips gets the params entry for the issuer market max ratio bips.
+func getParamsIssuerMarketMaxRatioBips(store storetypes.KVStore) uint32 {
+	bips, _ := uint16FromBz(store.Get(MakeKeyParamsIssuerMarketMaxRatioBips()))
+	return uint32(bips)
+}
+
 // SetParams updates the params to match those provided.
 // If nil is provided, all params are deleted.
 func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
 	store := k.getStore(ctx)
 
 	deleteAllParamsSplits(store)
-	var feeCreate, feeAccept []sdk.Coin
+	var feeCreate, feeAccept, issuerMaxFlat []sdk.Coin
+	var issuers []string
+	var issuerMaxBips uint32
 	if params != nil {
 		setParamsSplit(store, "", uint16(params.DefaultSplit)) //nolint:gosec // G115: Validated elsewhere to be 10,000 max.
 		for _, split := range params.DenomSplits {
@@ -126,10 +173,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params *exchange.Params) {
 		}
 		feeCreate = params.FeeCreatePaymentFlat
 		feeAccept = params.FeeAcceptPaymentFlat
+		issuers = params.MarketIssuers
+		issuerMaxFlat = params.IssuerMarketMaxFlatFees
+		issuerMaxBips = params.IssuerMarketMaxRatioBips
 	}
 
 	setParamsFeeCreatePaymentFlat(store, feeCreate)
 	setParamsFeeAcceptPaymentFlat(store, feeAccept)
+	setParamsMarketIssuers(store, issuers)
+	setParamsIssuerMarketMaxFlatFees(store, issuerMaxFlat)
+	setParamsIssuerMarketMaxRatioBips(store, issuerMaxBips)
 }
 
 // GetParams gets the exchange module params.
@@ -159,6 +212,27 @@ func (k Keeper) GetParams(ctx sdk.Context) *exchange.Params {
 		rv.FeeAcceptPaymentFlat = opts
 	}
 
+	if issuers := getParamsMarketIssuers(store); len(issuers) > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.MarketIssuers = issuers
+	}
+
+	if maxFees := getParamsIssuerMarketMaxFlatFees(store); len(maxFees) > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.IssuerMarketMaxFlatFees = maxFees
+	}
+
+	if bips := getParamsIssuerMarketMaxRatioBips(store); bips > 0 {
+		if rv == nil {
+			rv = &exchange.Params{}
+		}
+		rv.IssuerMarketMaxRatioBips = bips
+	}
+
 	return rv
 }
 
@@ -21,6 +21,20 @@ func (s *TestSuite) TestKeeper_SetParams() {
 		keyBz := keeper.MakeKeyParamsFeeCreatePaymentFlat()
 		return s.stateEntryString(keyBz, []byte(value))
 	}
+	expIssuersEntry := func(value string) string {
+		keyBz := keeper.MakeKeyParamsMarketIssuers()
+		return s.stateEntryString(keyBz, []byte(value))
+	}
+	expIssuerMaxFlatEntry := func(value string) string {
+		keyBz := keeper.MakeKeyParamsIssuerMarketMaxFlatFees()
+		return s.stateEntryString(keyBz, []byte(value))
+	}
+	expIssuerMaxBipsEntry := func(value uint16) string {
+		keyBz := keeper.MakeKeyParamsIssuerMarketMaxRatioBips()
+		return s.stateEntryString(keyBz, keeper.Uint16Bz(value))
+	}
+	issuer1 := sdk.AccAddress("issuer_one__________").String()
+	issuer2 := sdk.AccAddress("issuer_two__________").String()
 
 	tests := []struct {
 		name     string
@@ -107,6 +121,20 @@ func (s *TestSuite) TestKeeper_SetParams() {
 				expEntry("", 0),
 			},
 		},
+		{
+			name: "issuer market params",
+			params: &exchange.Params{
+				MarketIssuers:            []string{issuer2, issuer1},
+				IssuerMarketMaxFlatFees:  []sdk.Coin{sdk.NewInt64Coin("banana", 3), sdk.NewInt64Coin("cherry", 9)},
+				IssuerMarketMaxRatioBips: 150,
+			},
+			expState: []string{
+				expIssuerMaxFlatEntry("3banana,9cherry"),
+				expIssuerMaxBipsEntry(150),
+				expIssuersEntry(issuer2 + "," + issuer1),
+				expEntry("", 0),
+			},
+		},
 		{
 			name: "one split",
 			params: &exchange.Params{
@@ -151,6 +179,9 @@ func (s *TestSuite) TestKeeper_GetParams() {
 		splits            []exchange.DenomSplit
 		createPaymentFlat []sdk.Coin
 		acceptPaymentFlat []sdk.Coin
+		issuers           []string
+		issuerMaxFlat     []sdk.Coin
+		issuerMaxBips     uint32
 		exp               *exchange.Params
 	}{
 		{
@@ -177,6 +208,21 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			acceptPaymentFlat: coins("57apple"),
 			exp:               &exchange.Params{FeeAcceptPaymentFlat: coins("57apple")},
 		},
+		{
+			name:    "just market issuers",
+			issuers: []string{"issuer2", "issuer1"},
+			exp:     &exchange.Params{MarketIssuers: []string{"issuer2", "issuer1"}},
+		},
+		{
+			name:          "just issuer market max flat fees",
+			issuerMaxFlat: coins("3banana,8cherry"),
+			exp:           &exchange.Params{IssuerMarketMaxFlatFees: coins("3banana,8cherry")},
+		},
+		{
+			name:          "just issuer market max ratio bips",
+			issuerMaxBips: 75,
+			exp:           &exchange.Params{IssuerMarketMaxRatioBips: 75},
+		},
 		{
 			name: "a little of everything",
 			splits: []exchange.DenomSplit{
@@ -209,6 +255,9 @@ func (s *TestSuite) TestKeeper_GetParams() {
 			}
 			keeper.SetParamsFeeCreatePaymentFlat(store, tc.createPaymentFlat)
 			keeper.SetParamsFeeAcceptPaymentFlat(store, tc.acceptPaymentFlat)
+			keeper.SetParamsMarketIssuers(store, tc.issuers)
+			keeper.SetParamsIssuerMarketMaxFlatFees(store, tc.issuerMaxFlat)
+			keeper.SetParamsIssuerMarketMaxRatioBips(store, tc.issuerMaxBips)
 
 			var actual *exchange.Params
 			testFunc := func() {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/quarantine"
 )
 
@@ -272,7 +273,7 @@ func (k Keeper) AcceptPayment(ctx sdk.Context, payment *exchange.Payment) error
 		return err
 	}
 
-	ctx = quarantine.WithBypass(ctx)
+	ctx = markertypes.WithReceiptBypass(quarantine.WithBypass(ctx))
 	if !existing.SourceAmount.IsZero() {
 		err = k.bankKeeper.SendCoins(ctx, source, target, existing.SourceAmount)
 		if err != nil {
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getPriceBandConfig gets a market's price band config. If the market doesn't have one, a disabled config is returned.
+func (k Keeper) getPriceBandConfig(store storetypes.KVStore, marketID uint32) exchange.MarketPriceBandConfig {
+	var rv exchange.MarketPriceBandConfig
+	bz := store.Get(MakeKeyMarketPriceBandConfig(marketID))
+	if len(bz) > 0 {
+		// If it can't be unmarshalled, we treat it as disabled.
+		if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+			return exchange.MarketPriceBandConfig{}
+		}
+	}
+	return rv
+}
+
+// setPriceBandConfig sets a market's price band config. A disabled config is deleted from state.
+func (k Keeper) setPriceBandConfig(store storetypes.KVStore, marketID uint32, config exchange.MarketPriceBandConfig) error {
+	key := MakeKeyMarketPriceBandConfig(marketID)
+	if !config.IsEnabled() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return fmt.Errorf("error marshaling price band config for market %d: %w", marketID, err)
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// isSettlementsPaused returns true if a market's settlements are paused.
+func isSettlementsPaused(store storetypes.KVStore, marketID uint32) bool {
+	return store.Has(MakeKeyMarketSettlementsPaused(marketID))
+}
+
+// setSettlementsPaused sets whether a market's settlements are paused.
+func setSettlementsPaused(store storetypes.KVStore, marketID uint32, paused bool) {
+	key := MakeKeyMarketSettlementsPaused(marketID)
+	if paused {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// getLastSettlementPrice gets the last price that the given assets denom settled at (in the given price denom) in a market.
+// Returns nil if there isn't one.
+func (k Keeper) getLastSettlementPrice(store storetypes.KVStore, marketID uint32, assetsDenom, priceDenom string) *exchange.NetAssetPrice {
+	bz := store.Get(MakeKeyMarketLastSettlementPrice(marketID, assetsDenom, priceDenom))
+	if len(bz) == 0 {
+		return nil
+	}
+	var rv exchange.NetAssetPrice
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil
+	}
+	return &rv
+}
+
+// setLastSettlementPrice sets the last price that some assets settled at in a market.
+func (k Keeper) setLastSettlementPrice(store storetypes.KVStore, marketID uint32, nav exchange.NetAssetPrice) error {
+	bz, err := k.cdc.Marshal(&nav)
+	if err != nil {
+		return fmt.Errorf("error marshaling last settlement price %s for market %d: %w", nav, marketID, err)
+	}
+	store.Set(MakeKeyMarketLastSettlementPrice(marketID, nav.Assets.Denom, nav.Price.Denom), bz)
+	return nil
+}
+
+// getAllLastSettlementPrices gets the last settlement prices of all the assets that have settled in a market.
+func (k Keeper) getAllLastSettlementPrices(store storetypes.KVStore, marketID uint32) []exchange.NetAssetPrice {
+	var rv []exchange.NetAssetPrice
+	iterate(store, GetKeyPrefixMarketLastSettlementPrice(marketID), func(_, value []byte) bool {
+		var nav exchange.NetAssetPrice
+		if err := k.cdc.Unmarshal(value, &nav); err == nil {
+			rv = append(rv, nav)
+		}
+		return false
+	})
+	return rv
+}
+
+// GetMarketPriceBands gets a market's price band config, whether its settlements are paused, and its last settlement prices.
+// Returns nil if the market does not have a price band and its settlements are not paused.
+func (k Keeper) GetMarketPriceBands(ctx sdk.Context, marketID uint32) *exchange.MarketPriceBands {
+	store := k.getStore(ctx)
+	rv := &exchange.MarketPriceBands{
+		MarketId: marketID,
+		Config:   k.getPriceBandConfig(store, marketID),
+		Paused:   isSettlementsPaused(store, marketID),
+	}
+	if !rv.Config.IsEnabled() && !rv.Paused {
+		return nil
+	}
+	rv.LastPrices = k.getAllLastSettlementPrices(store, marketID)
+	return rv
+}
+
+// IterateMarketPriceBands iterates over the price band info of each market that has a price band.
+// The callback should return false to continue iteration, or true to stop.
+func (k Keeper) IterateMarketPriceBands(ctx sdk.Context, cb func(bands exchange.MarketPriceBands) bool) {
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		bands := k.GetMarketPriceBands(ctx, marketID)
+		return bands != nil && cb(*bands)
+	})
+}
+
+// initMarketPriceBands stores all the provided price band info (e.g. from genesis).
+func (k Keeper) initMarketPriceBands(store storetypes.KVStore, bands exchange.MarketPriceBands) error {
+	if err := k.setPriceBandConfig(store, bands.MarketId, bands.Config); err != nil {
+		return err
+	}
+	setSettlementsPaused(store, bands.MarketId, bands.Paused)
+	for _, nav := range bands.LastPrices {
+		if err := k.setLastSettlementPrice(store, bands.MarketId, nav); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateMarketPriceBand sets a market's price band config.
+// If the new config is disabled, the market's last settlement prices are forgotten and its settlements are resumed.
+func (k Keeper) UpdateMarketPriceBand(ctx sdk.Context, marketID uint32, config exchange.MarketPriceBandConfig, updatedBy string) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return err
+	}
+
+	if !config.IsEnabled() {
+		deleteAll(store, GetKeyPrefixMarketLastSettlementPrice(marketID))
+		if isSettlementsPaused(store, marketID) {
+			setSettlementsPaused(store, marketID, false)
+			k.emitEvent(ctx, exchange.NewEventMarketSettlementsResumed(marketID, updatedBy))
+		}
+	}
+
+	if err := k.setPriceBandConfig(store, marketID, config); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, exchange.NewEventMarketPriceBandUpdated(marketID, updatedBy))
+	return nil
+}
+
+// ResumeMarketSettlements resumes the settlements of a market that were paused because one was outside its price band.
+func (k Keeper) ResumeMarketSettlements(ctx sdk.Context, marketID uint32, updatedBy string) error {
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return err
+	}
+	if !isSettlementsPaused(store, marketID) {
+		return fmt.Errorf("market %d settlements are not paused", marketID)
+	}
+	setSettlementsPaused(store, marketID, false)
+	k.emitEvent(ctx, exchange.NewEventMarketSettlementsResumed(marketID, updatedBy))
+	return nil
+}
+
+// getPriceBandReference gets the price that a settlement of the given denoms should be compared against.
+// Returns nil if there isn't one (e.g. the assets have never settled in the market).
+func (k Keeper) getPriceBandReference(ctx sdk.Context, store storetypes.KVStore, marketID uint32, config exchange.MarketPriceBandConfig, assetsDenom, priceDenom string) *exchange.NetAssetPrice {
+	switch config.Reference {
+	case exchange.PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT:
+		return k.getLastSettlementPrice(store, marketID, assetsDenom, priceDenom)
+	case exchange.PriceBandReference_PRICE_BAND_REFERENCE_NAV:
+		return k.GetNav(ctx, assetsDenom, priceDenom)
+	default:
+		return nil
+	}
+}
+
+// checkPriceBand returns an error if the market's settlements are paused, or if any of the provided settlement prices
+// are outside the market's price band and the market rejects such settlements. If the market instead pauses, its
+// settlements are paused, an event is emitted, and true is returned (without an error) so that the pause is kept.
+// Callers should not settle anything when this returns true. This should be called before any state is changed.
+func (k Keeper) checkPriceBand(ctx sdk.Context, store storetypes.KVStore, marketID uint32, navs []exchange.NetAssetPrice) (bool, error) {
+	if isSettlementsPaused(store, marketID) {
+		return false, fmt.Errorf("market %d settlements are paused", marketID)
+	}
+	config := k.getPriceBandConfig(store, marketID)
+	if !config.IsEnabled() {
+		return false, nil
+	}
+
+	for _, nav := range navs {
+		ref := k.getPriceBandReference(ctx, store, marketID, config, nav.Assets.Denom, nav.Price.Denom)
+		if ref == nil || !config.IsOutsideBand(nav, *ref) {
+			continue
+		}
+		if config.Action == exchange.PriceBandAction_PRICE_BAND_ACTION_PAUSE {
+			setSettlementsPaused(store, marketID, true)
+			k.emitEvent(ctx, exchange.NewEventMarketPriceBandTripped(marketID, nav, *ref))
+			return true, nil
+		}
+		return false, fmt.Errorf("settlement of %s is outside market %d price band: deviates more than %d bips from %s",
+			nav, marketID, config.MaxDeviationBips, ref)
+	}
+	return false, nil
+}
+
+// recordLastSettlementPrices records the provided settlement prices as the last ones for their assets in a market.
+// Nothing is recorded if the market doesn't have a price band.
+func (k Keeper) recordLastSettlementPrices(store storetypes.KVStore, marketID uint32, navs []exchange.NetAssetPrice) error {
+	if !k.getPriceBandConfig(store, marketID).IsEnabled() {
+		return nil
+	}
+	for _, nav := range navs {
+		if !nav.Assets.Amount.IsPositive() || !nav.Price.Amount.IsPositive() {
+			continue
+		}
+		if err := k.setLastSettlementPrice(store, marketID, nav); err != nil {
+			return err
+		}
+	}
+	return nil
+}
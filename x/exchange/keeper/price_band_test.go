@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+func (s *TestSuite) TestPriceBands() {
+	seller, buyer := s.addr1, s.addr2
+	s.requireFundAccount(seller, "100apple")
+	s.requireFundAccount(buyer, "10000fig")
+	s.requireCreateMarketUnmocked(exchange.Market{
+		MarketId:            1,
+		AcceptingOrders:     true,
+		AllowUserSettlement: true,
+	})
+
+	band := func(action exchange.PriceBandAction) exchange.MarketPriceBandConfig {
+		return exchange.MarketPriceBandConfig{
+			MaxDeviationBips: 1000,
+			Reference:        exchange.PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT,
+			Action:           action,
+		}
+	}
+	newAsk := func(assets, price string) uint64 {
+		orderID, err := s.k.CreateAskOrder(s.ctx, exchange.AskOrder{
+			MarketId: 1,
+			Seller:   seller.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		}, nil)
+		s.Require().NoError(err, "CreateAskOrder(%s, %s)", assets, price)
+		return orderID
+	}
+	newBid := func(assets, price string) uint64 {
+		orderID, err := s.k.CreateBidOrder(s.ctx, exchange.BidOrder{
+			MarketId: 1,
+			Buyer:    buyer.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		}, nil)
+		s.Require().NoError(err, "CreateBidOrder(%s, %s)", assets, price)
+		return orderID
+	}
+	fillAsk := func(orderID uint64, price string) error {
+		return s.k.FillAsks(s.ctx, &exchange.MsgFillAsksRequest{
+			Buyer:       buyer.String(),
+			MarketId:    1,
+			TotalPrice:  s.coin(price),
+			AskOrderIds: []uint64{orderID},
+		})
+	}
+	assertBalances := func(apple, fig string) {
+		s.Assert().Equal(apple, s.app.BankKeeper.GetBalance(s.ctx, buyer, "apple").String(), "buyer apple balance")
+		s.Assert().Equal(fig, s.app.BankKeeper.GetBalance(s.ctx, buyer, "fig").String(), "buyer fig balance")
+	}
+
+	s.Run("enable a rejecting band", func() {
+		err := s.k.UpdateMarketPriceBand(s.ctx, 1, band(exchange.PriceBandAction_PRICE_BAND_ACTION_REJECT), s.addr5.String())
+		s.Require().NoError(err, "UpdateMarketPriceBand")
+	})
+
+	s.Run("first settlement has no reference", func() {
+		orderID := newAsk("10apple", "100fig")
+		s.Require().NoError(fillAsk(orderID, "100fig"), "FillAsks")
+		assertBalances("10apple", "9900fig")
+		bands := s.k.GetMarketPriceBands(s.ctx, 1)
+		s.Require().NotNil(bands, "GetMarketPriceBands")
+		s.assertEqualNAVs([]exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("100fig")}},
+			bands.LastPrices, "LastPrices")
+	})
+
+	outOfBandID := newAsk("10apple", "200fig")
+	s.Run("settlement outside a rejecting band", func() {
+		err := fillAsk(outOfBandID, "200fig")
+		s.Require().EqualError(err, `settlement of "10apple"="200fig" is outside market 1 price band: `+
+			`deviates more than 1000 bips from "10apple"="100fig"`, "FillAsks")
+		assertBalances("10apple", "9900fig")
+	})
+
+	s.Run("settlement inside the band", func() {
+		orderID := newAsk("20apple", "210fig")
+		s.Require().NoError(fillAsk(orderID, "210fig"), "FillAsks")
+		assertBalances("30apple", "9690fig")
+	})
+
+	s.Run("settlement outside a pausing band", func() {
+		err := s.k.UpdateMarketPriceBand(s.ctx, 1, band(exchange.PriceBandAction_PRICE_BAND_ACTION_PAUSE), s.addr5.String())
+		s.Require().NoError(err, "UpdateMarketPriceBand")
+		s.Require().NoError(fillAsk(outOfBandID, "200fig"), "FillAsks")
+		assertBalances("30apple", "9690fig")
+		bands := s.k.GetMarketPriceBands(s.ctx, 1)
+		s.Require().NotNil(bands, "GetMarketPriceBands")
+		s.Assert().True(bands.Paused, "Paused")
+	})
+
+	s.Run("settlement while paused", func() {
+		orderID := newAsk("10apple", "105fig")
+		s.Require().EqualError(fillAsk(orderID, "105fig"), "market 1 settlements are paused", "FillAsks")
+		assertBalances("30apple", "9690fig")
+	})
+
+	s.Run("resume settlements", func() {
+		s.Require().NoError(s.k.ResumeMarketSettlements(s.ctx, 1, s.addr5.String()), "ResumeMarketSettlements")
+		err := s.k.ResumeMarketSettlements(s.ctx, 1, s.addr5.String())
+		s.Require().EqualError(err, "market 1 settlements are not paused", "ResumeMarketSettlements again")
+	})
+
+	s.Run("market override settles outside the band", func() {
+		err := s.k.SettleOrders(s.ctx, &exchange.MsgMarketSettleRequest{
+			Admin:             s.addr5.String(),
+			MarketId:          1,
+			AskOrderIds:       []uint64{outOfBandID},
+			BidOrderIds:       []uint64{newBid("10apple", "200fig")},
+			OverridePriceBand: true,
+		})
+		s.Require().NoError(err, "SettleOrders")
+		bands := s.k.GetMarketPriceBands(s.ctx, 1)
+		s.Require().NotNil(bands, "GetMarketPriceBands")
+		s.assertEqualNAVs([]exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("200fig")}},
+			bands.LastPrices, "LastPrices")
+	})
+
+	s.Run("disable the band", func() {
+		err := s.k.UpdateMarketPriceBand(s.ctx, 1, exchange.MarketPriceBandConfig{}, s.addr5.String())
+		s.Require().NoError(err, "UpdateMarketPriceBand")
+		s.Assert().Nil(s.k.GetMarketPriceBands(s.ctx, 1), "GetMarketPriceBands")
+	})
+}
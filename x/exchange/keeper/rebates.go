@@ -0,0 +1,402 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/quarantine"
+)
+
+// getRebateConfig gets a market's rebate config. If the market doesn't have one, a disabled config is returned.
+func (k Keeper) getRebateConfig(store storetypes.KVStore, marketID uint32) exchange.MarketRebateConfig {
+	var rv exchange.MarketRebateConfig
+	bz := store.Get(MakeKeyMarketRebateConfig(marketID))
+	if len(bz) > 0 {
+		// If it can't be unmarshalled, we treat it as disabled.
+		if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+			return exchange.MarketRebateConfig{}
+		}
+	}
+	return rv
+}
+
+// setRebateConfig sets a market's rebate config. A disabled config is deleted from state.
+func (k Keeper) setRebateConfig(store storetypes.KVStore, marketID uint32, config exchange.MarketRebateConfig) error {
+	key := MakeKeyMarketRebateConfig(marketID)
+	if !config.IsEnabled() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return fmt.Errorf("error marshaling rebate config for market %d: %w", marketID, err)
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// getRebatePool gets the funds set aside in a market's rebate pool.
+func getRebatePool(store storetypes.KVStore, marketID uint32) sdk.Coins {
+	bz := store.Get(MakeKeyMarketRebatePool(marketID))
+	if len(bz) == 0 {
+		return nil
+	}
+	rv, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		return nil
+	}
+	return rv
+}
+
+// setRebatePool sets the funds set aside in a market's rebate pool.
+func setRebatePool(store storetypes.KVStore, marketID uint32, pool sdk.Coins) {
+	key := MakeKeyMarketRebatePool(marketID)
+	if pool.IsZero() {
+		store.Delete(key)
+	} else {
+		store.Set(key, []byte(pool.String()))
+	}
+}
+
+// getRebateLastPayout gets the height of a market's last rebate payout.
+func getRebateLastPayout(store storetypes.KVStore, marketID uint32) int64 {
+	bz := store.Get(MakeKeyMarketRebateLastPayout(marketID))
+	rv, ok := uint64FromBz(bz)
+	if !ok {
+		return 0
+	}
+	return int64(rv) //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
+
+// setRebateLastPayout sets the height of a market's last rebate payout.
+func setRebateLastPayout(store storetypes.KVStore, marketID uint32, height int64) {
+	key := MakeKeyMarketRebateLastPayout(marketID)
+	if height <= 0 {
+		store.Delete(key)
+	} else {
+		store.Set(key, uint64Bz(uint64(height)))
+	}
+}
+
+// getLiquidityPoints gets the liquidity points an address has earned in a market.
+func getLiquidityPoints(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress) sdkmath.Int {
+	bz := store.Get(MakeKeyMarketLiquidityPoints(marketID, addr))
+	if len(bz) == 0 {
+		return sdkmath.ZeroInt()
+	}
+	rv, ok := sdkmath.NewIntFromString(string(bz))
+	if !ok {
+		return sdkmath.ZeroInt()
+	}
+	return rv
+}
+
+// setLiquidityPoints sets the liquidity points an address has earned in a market.
+func setLiquidityPoints(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, points sdkmath.Int) {
+	key := MakeKeyMarketLiquidityPoints(marketID, addr)
+	if points.IsNil() || !points.IsPositive() {
+		store.Delete(key)
+	} else {
+		store.Set(key, []byte(points.String()))
+	}
+}
+
+// getAllLiquidityPoints gets all the liquidity points that have been earned in a market.
+func getAllLiquidityPoints(store storetypes.KVStore, marketID uint32) []exchange.LiquidityPoints {
+	var rv []exchange.LiquidityPoints
+	iterate(store, GetKeyPrefixMarketLiquidityPoints(marketID), func(keySuffix, value []byte) bool {
+		addr, err := ParseKeySuffixMarketLiquidityPoints(keySuffix)
+		if err != nil {
+			return false
+		}
+		points, ok := sdkmath.NewIntFromString(string(value))
+		if !ok || !points.IsPositive() {
+			return false
+		}
+		rv = append(rv, exchange.LiquidityPoints{Address: addr.String(), Points: points})
+		return false
+	})
+	return rv
+}
+
+// GetMarketRebates gets a market's rebate config, pool, and earned liquidity points.
+// Returns nil if the market does not have rebates enabled and nothing is in its pool.
+func (k Keeper) GetMarketRebates(ctx sdk.Context, marketID uint32) *exchange.MarketRebates {
+	store := k.getStore(ctx)
+	rv := &exchange.MarketRebates{
+		MarketId:         marketID,
+		Config:           k.getRebateConfig(store, marketID),
+		Pool:             getRebatePool(store, marketID),
+		LastPayoutHeight: getRebateLastPayout(store, marketID),
+		Points:           getAllLiquidityPoints(store, marketID),
+	}
+	if !rv.Config.IsEnabled() && rv.Pool.IsZero() && len(rv.Points) == 0 {
+		return nil
+	}
+	return rv
+}
+
+// IterateMarketRebates iterates over the rebate info of each market that has rebates.
+// The callback should return false to continue iteration, or true to stop.
+func (k Keeper) IterateMarketRebates(ctx sdk.Context, cb func(rebates exchange.MarketRebates) bool) {
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		rebates := k.GetMarketRebates(ctx, marketID)
+		return rebates != nil && cb(*rebates)
+	})
+}
+
+// initMarketRebates stores all the provided rebate info (e.g. from genesis).
+func (k Keeper) initMarketRebates(store storetypes.KVStore, rebates exchange.MarketRebates) error {
+	if err := k.setRebateConfig(store, rebates.MarketId, rebates.Config); err != nil {
+		return err
+	}
+	setRebatePool(store, rebates.MarketId, rebates.Pool)
+	setRebateLastPayout(store, rebates.MarketId, rebates.LastPayoutHeight)
+	for _, points := range rebates.Points {
+		addr, err := sdk.AccAddressFromBech32(points.Address)
+		if err != nil {
+			return fmt.Errorf("invalid liquidity points address %q: %w", points.Address, err)
+		}
+		setLiquidityPoints(store, rebates.MarketId, addr, points.Points)
+	}
+	return nil
+}
+
+// UpdateMarketRebates sets a market's rebate config.
+// If the new config is disabled, the pool is paid out one last time and anything left over is
+// released back to the market's general funds.
+func (k Keeper) UpdateMarketRebates(ctx sdk.Context, marketID uint32, config exchange.MarketRebateConfig, updatedBy string) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	store := k.getStore(ctx)
+	if err := validateMarketExists(store, marketID); err != nil {
+		return err
+	}
+
+	if !config.IsEnabled() {
+		if err := k.payMarketRebates(ctx, marketID); err != nil {
+			return err
+		}
+		setRebatePool(store, marketID, nil)
+		setRebateLastPayout(store, marketID, 0)
+		deleteAll(store, GetKeyPrefixMarketLiquidityPoints(marketID))
+	} else if !k.getRebateConfig(store, marketID).IsEnabled() {
+		// Rebates are being turned on, so the first payout period starts now.
+		setRebateLastPayout(store, marketID, ctx.BlockHeight())
+	}
+
+	if err := k.setRebateConfig(store, marketID, config); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, exchange.NewEventMarketRebatesUpdated(marketID, updatedBy))
+	return nil
+}
+
+// addToRebatePool sets aside the market's configured portion of the provided amount in its rebate pool.
+// The provided amount should be what the market received after the exchange took its split.
+func (k Keeper) addToRebatePool(ctx sdk.Context, marketID uint32, marketAmt sdk.Coins) {
+	store := k.getStore(ctx)
+	toAdd := k.getRebateConfig(store, marketID).CalculatePoolAmount(marketAmt)
+	if toAdd.IsZero() {
+		return
+	}
+	setRebatePool(store, marketID, getRebatePool(store, marketID).Add(toAdd...))
+}
+
+// GetRebatePool gets the funds that a market has set aside for its next rebate payout.
+func (k Keeper) GetRebatePool(ctx sdk.Context, marketID uint32) sdk.Coins {
+	return getRebatePool(k.getStore(ctx), marketID)
+}
+
+// validateWithdrawNotFromRebatePool returns an error if withdrawing the provided amount from a
+// market would use funds that are set aside in its rebate pool.
+func (k Keeper) validateWithdrawNotFromRebatePool(ctx sdk.Context, marketID uint32, amount sdk.Coins) error {
+	pool := k.GetRebatePool(ctx, marketID)
+	if pool.IsZero() {
+		return nil
+	}
+	spendable := k.bankKeeper.SpendableCoins(ctx, exchange.GetMarketAddress(marketID))
+	available, hasNeg := spendable.SafeSub(pool.Min(spendable)...)
+	if hasNeg || !available.IsAllGTE(amount) {
+		return fmt.Errorf("cannot withdraw %s from market %d: only %s is available because %s is set aside for rebates",
+			amount, marketID, available, pool)
+	}
+	return nil
+}
+
+// getRebateBandBips gets how far (in basis points) an order's unit price can be from the best price on the other side
+// of a market's book and still earn liquidity points. Markets with a price band use its max deviation.
+func (k Keeper) getRebateBandBips(store storetypes.KVStore, marketID uint32) uint32 {
+	if band := k.getPriceBandConfig(store, marketID); band.IsEnabled() {
+		return band.MaxDeviationBips
+	}
+	return exchange.DefaultRebateBandBips
+}
+
+// sampleMarketLiquidity credits the owners of resting orders near the top of the book with liquidity points.
+// An ask only earns points if its unit price is within the rebate band above the best bid for the same assets,
+// and a bid only earns points if its unit price is within the band below the best ask. Each of those orders
+// earns its price amount (i.e. its notional) multiplied by the sample interval. Orders that are not likely to
+// be filled (e.g. asks at absurd prices) don't earn anything.
+func (k Keeper) sampleMarketLiquidity(ctx sdk.Context, marketID uint32, config exchange.MarketRebateConfig) {
+	type restingOrder struct {
+		owner     string
+		isAsk     bool
+		assets    string
+		notional  sdkmath.Int
+		unitPrice sdkmath.LegacyDec
+	}
+
+	store := k.getStore(ctx)
+	var orders []restingOrder
+	bestAsks := make(map[string]sdkmath.LegacyDec)
+	bestBids := make(map[string]sdkmath.LegacyDec)
+	k.IterateMarketOrders(ctx, marketID, func(orderID uint64, _ byte) bool {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil || order == nil {
+			return false
+		}
+		assets, price := order.GetAssets(), order.GetPrice()
+		if price.Denom != config.PriceDenom || !price.Amount.IsPositive() || !assets.Amount.IsPositive() {
+			return false
+		}
+		ro := restingOrder{
+			owner:     order.GetOwner(),
+			isAsk:     order.IsAskOrder(),
+			assets:    assets.Denom,
+			notional:  price.Amount,
+			unitPrice: price.Amount.ToLegacyDec().QuoInt(assets.Amount),
+		}
+		if ro.isAsk {
+			if best, known := bestAsks[ro.assets]; !known || ro.unitPrice.LT(best) {
+				bestAsks[ro.assets] = ro.unitPrice
+			}
+		} else if best, known := bestBids[ro.assets]; !known || ro.unitPrice.GT(best) {
+			bestBids[ro.assets] = ro.unitPrice
+		}
+		orders = append(orders, ro)
+		return false
+	})
+
+	band := sdkmath.LegacyNewDec(int64(k.getRebateBandBips(store, marketID))).QuoInt64(int64(exchange.MaxBips))
+	interval := sdkmath.NewInt(config.SampleInterval)
+	earned := make(map[string]sdkmath.Int)
+	var owners []string
+	for _, ro := range orders {
+		if ro.isAsk {
+			bestBid, known := bestBids[ro.assets]
+			if !known || ro.unitPrice.GT(bestBid.Mul(sdkmath.LegacyOneDec().Add(band))) {
+				continue
+			}
+		} else {
+			bestAsk, known := bestAsks[ro.assets]
+			if !known || ro.unitPrice.LT(bestAsk.Mul(sdkmath.LegacyOneDec().Sub(band))) {
+				continue
+			}
+		}
+		if _, known := earned[ro.owner]; !known {
+			owners = append(owners, ro.owner)
+			earned[ro.owner] = sdkmath.ZeroInt()
+		}
+		earned[ro.owner] = earned[ro.owner].Add(ro.notional.Mul(interval))
+	}
+
+	for _, owner := range owners {
+		addr, err := sdk.AccAddressFromBech32(owner)
+		if err != nil {
+			continue
+		}
+		setLiquidityPoints(store, marketID, addr, getLiquidityPoints(store, marketID, addr).Add(earned[owner]))
+	}
+}
+
+// payMarketRebates distributes a market's rebate pool to the accounts that have earned liquidity points,
+// in proportion to the points they've earned. The points are then cleared. Anything that can't be evenly
+// distributed stays in the pool for the next payout.
+func (k Keeper) payMarketRebates(ctx sdk.Context, marketID uint32) error {
+	store := k.getStore(ctx)
+	pool := getRebatePool(store, marketID)
+	var points []exchange.LiquidityPoints
+	for _, entry := range getAllLiquidityPoints(store, marketID) {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil || k.bankKeeper.BlockedAddr(addr) {
+			continue
+		}
+		points = append(points, entry)
+	}
+
+	rebates := exchange.CalculateRebates(pool, points)
+	if len(rebates) > 0 {
+		marketAddr := exchange.GetMarketAddress(marketID)
+		var total sdk.Coins
+		outputs := make([]banktypes.Output, len(rebates))
+		for i, rebate := range rebates {
+			total = total.Add(rebate.Amount...)
+			outputs[i] = banktypes.Output{Address: rebate.Account, Coins: rebate.Amount}
+		}
+		inputs := []banktypes.Input{{Address: marketAddr.String(), Coins: total}}
+		// Rebates are something the recipients opted into by placing orders, so they bypass quarantine and marker receipts.
+		if err := k.bankKeeper.InputOutputCoinsProv(markertypes.WithReceiptBypass(quarantine.WithBypass(ctx)), inputs, outputs); err != nil {
+			return fmt.Errorf("error paying rebates %s for market %d: %w", total, marketID, err)
+		}
+		setRebatePool(store, marketID, pool.Sub(total...))
+		k.emitEvent(ctx, exchange.NewEventMarketRebatesPaid(marketID, total, len(rebates)))
+	}
+
+	deleteAll(store, GetKeyPrefixMarketLiquidityPoints(marketID))
+	return nil
+}
+
+// ProcessMarketRebates samples the resting liquidity of each market with rebates enabled,
+// and pays out the rebate pools of those that have reached their payout interval.
+// Errors are logged but do not stop the processing of other markets.
+func (k Keeper) ProcessMarketRebates(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	var marketIDs []uint32
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		marketIDs = append(marketIDs, marketID)
+		return false
+	})
+
+	var errs []error
+	for _, marketID := range marketIDs {
+		store := k.getStore(ctx)
+		config := k.getRebateConfig(store, marketID)
+		if !config.IsEnabled() {
+			continue
+		}
+
+		if height%config.SampleInterval == 0 {
+			k.sampleMarketLiquidity(ctx, marketID, config)
+		}
+
+		lastPayout := getRebateLastPayout(store, marketID)
+		if height-lastPayout < config.PayoutInterval {
+			continue
+		}
+
+		// Use a cache context so that a failed payout doesn't leave anything half done.
+		// Either way, the next payout isn't attempted until another full payout interval has passed.
+		// After a failed payout, the pool and points carry over to that next one.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.payMarketRebates(cacheCtx, marketID); err != nil {
+			errs = append(errs, err)
+		} else {
+			writeCache()
+		}
+		setRebateLastPayout(store, marketID, height)
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered processing market rebates:\n%v", len(errs), errors.Join(errs...))
+	}
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestMarketRebates() {
+	seller, buyer, farSeller, farBuyer := s.addr1, s.addr2, s.addr3, s.addr4
+	s.requireFundAccount(seller, "10apple")
+	s.requireFundAccount(farSeller, "10apple")
+	s.requireFundAccount(buyer, "98fig")
+	s.requireFundAccount(farBuyer, "10fig")
+	s.requireCreateMarketUnmocked(exchange.Market{
+		MarketId:            1,
+		AcceptingOrders:     true,
+		AllowUserSettlement: true,
+	})
+
+	config := exchange.MarketRebateConfig{PoolBips: 100, PriceDenom: "fig", SampleInterval: 2, PayoutInterval: 10}
+	err := s.k.UpdateMarketRebates(s.ctx.WithBlockHeight(1), 1, config, s.addr5.String())
+	s.Require().NoError(err, "UpdateMarketRebates")
+
+	newAsk := func(seller sdk.AccAddress, price string) {
+		_, err := s.k.CreateAskOrder(s.ctx, exchange.AskOrder{
+			MarketId: 1,
+			Seller:   seller.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin(price),
+		}, nil)
+		s.Require().NoError(err, "CreateAskOrder(%s, %s)", s.getAddrName(seller), price)
+	}
+	newBid := func(buyer sdk.AccAddress, price string) {
+		_, err := s.k.CreateBidOrder(s.ctx, exchange.BidOrder{
+			MarketId: 1,
+			Buyer:    buyer.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin(price),
+		}, nil)
+		s.Require().NoError(err, "CreateBidOrder(%s, %s)", s.getAddrName(buyer), price)
+	}
+	// The default band is 5%, so the seller's ask is within it of the best bid and the buyer's bid is within it of
+	// the best ask. The other two orders are too far from the top of the book to earn anything.
+	newAsk(seller, "100fig")
+	newAsk(farSeller, "1000000fig")
+	newBid(buyer, "98fig")
+	newBid(farBuyer, "10fig")
+
+	getRebates := func(height int64) (*exchange.MarketRebates, map[string]string) {
+		rebates := s.k.GetMarketRebates(s.ctx.WithBlockHeight(height), 1)
+		s.Require().NotNil(rebates, "GetMarketRebates")
+		points := make(map[string]string)
+		for _, entry := range rebates.Points {
+			addr, err := sdk.AccAddressFromBech32(entry.Address)
+			s.Require().NoError(err, "AccAddressFromBech32(%q)", entry.Address)
+			points[s.getAddrName(addr)] = entry.Points.String()
+		}
+		return rebates, points
+	}
+
+	s.Run("only orders near the top of the book earn points", func() {
+		s.k.ProcessMarketRebates(s.ctx.WithBlockHeight(2))
+		_, points := getRebates(2)
+		s.Assert().Equal(map[string]string{"addr1": "200", "addr2": "196"}, points, "points")
+	})
+
+	// The pool says there's 50fig, but the market account doesn't have it yet, so the payout fails.
+	s.k.GetStore(s.ctx).Set(keeper.MakeKeyMarketRebatePool(1), []byte("50fig"))
+
+	s.Run("failed payout", func() {
+		s.k.ProcessMarketRebates(s.ctx.WithBlockHeight(11))
+		rebates, points := getRebates(11)
+		s.Assert().Equal(int64(11), rebates.LastPayoutHeight, "LastPayoutHeight")
+		s.Assert().Equal("50fig", rebates.Pool.String(), "Pool")
+		s.Assert().Equal(map[string]string{"addr1": "200", "addr2": "196"}, points, "points")
+	})
+
+	s.Run("no payout retry until the next interval", func() {
+		s.k.ProcessMarketRebates(s.ctx.WithBlockHeight(12))
+		rebates, points := getRebates(12)
+		s.Assert().Equal(int64(11), rebates.LastPayoutHeight, "LastPayoutHeight")
+		s.Assert().Equal(map[string]string{"addr1": "400", "addr2": "392"}, points, "points")
+	})
+
+	s.Run("next payout", func() {
+		s.requireFundAccount(exchange.GetMarketAddress(1), "50fig")
+		s.k.ProcessMarketRebates(s.ctx.WithBlockHeight(21))
+		rebates, points := getRebates(21)
+		s.Assert().Equal(int64(21), rebates.LastPayoutHeight, "LastPayoutHeight")
+		s.Assert().Equal("1fig", rebates.Pool.String(), "Pool")
+		s.Assert().Empty(points, "points")
+		s.Assert().Equal("25fig", s.app.BankKeeper.GetBalance(s.ctx, seller, "fig").String(), "seller fig balance")
+	})
+}
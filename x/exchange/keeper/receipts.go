@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getLastReceiptID gets the id of the last settlement receipt created.
+func getLastReceiptID(store storetypes.KVStore) uint64 {
+	key := MakeKeyLastReceiptID()
+	value := store.Get(key)
+	rv, _ := uint64FromBz(value)
+	return rv
+}
+
+// setLastReceiptID sets the id of the last settlement receipt created.
+func setLastReceiptID(store storetypes.KVStore, receiptID uint64) {
+	key := MakeKeyLastReceiptID()
+	value := uint64Bz(receiptID)
+	store.Set(key, value)
+}
+
+// nextReceiptID finds the next available settlement receipt id, updates the last
+// receipt id store entry, and returns the unused id it found.
+func nextReceiptID(store storetypes.KVStore) uint64 {
+	receiptID := getLastReceiptID(store) + 1
+	setLastReceiptID(store, receiptID)
+	return receiptID
+}
+
+// setSettlementReceipt writes a settlement receipt to the store.
+func (k Keeper) setSettlementReceipt(store storetypes.KVStore, receipt *exchange.SettlementReceipt) error {
+	bz, err := k.cdc.Marshal(receipt)
+	if err != nil {
+		return fmt.Errorf("error marshaling settlement receipt %d: %w", receipt.ReceiptId, err)
+	}
+	store.Set(MakeKeySettlementReceipt(receipt.ReceiptId), bz)
+	return nil
+}
+
+// getSettlementReceipt gets a settlement receipt from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getSettlementReceipt(store storetypes.KVStore, receiptID uint64) (*exchange.SettlementReceipt, error) {
+	bz := store.Get(MakeKeySettlementReceipt(receiptID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv exchange.SettlementReceipt
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal settlement receipt %d: %w", receiptID, err)
+	}
+	return &rv, nil
+}
+
+// GetSettlementReceipt gets a settlement receipt. Returns nil, nil if the receipt does not exist.
+func (k Keeper) GetSettlementReceipt(ctx sdk.Context, receiptID uint64) (*exchange.SettlementReceipt, error) {
+	return k.getSettlementReceipt(k.getStore(ctx), receiptID)
+}
+
+// IterateSettlementReceipts iterates over all settlement receipts.
+// The callback should return false to continue iteration, or true to stop.
+func (k Keeper) IterateSettlementReceipts(ctx sdk.Context, cb func(receipt exchange.SettlementReceipt) bool) {
+	iterate(k.getStore(ctx), GetKeyPrefixSettlementReceipt(), func(_, value []byte) bool {
+		var receipt exchange.SettlementReceipt
+		if err := k.cdc.Unmarshal(value, &receipt); err != nil {
+			return false
+		}
+		return cb(receipt)
+	})
+}
+
+// createSettlementReceipt creates and stores a settlement receipt for the provided filled order, returning its id.
+func (k Keeper) createSettlementReceipt(ctx sdk.Context, store storetypes.KVStore, order exchange.OrderI,
+	counterparties []string, partial bool, navs []exchange.NetAssetPrice,
+) (uint64, error) {
+	receipt := exchange.NewSettlementReceipt(nextReceiptID(store), order, counterparties, partial, navs, ctx.BlockHeight())
+	if err := k.setSettlementReceipt(store, receipt); err != nil {
+		return 0, err
+	}
+	return receipt.ReceiptId, nil
+}
+
+// getCounterparties gets the addresses on the other side of the provided owner's transfers in a settlement.
+// Addresses are returned in the order they first appear in the transfers.
+func getCounterparties(settlement *exchange.Settlement, owner string) []string {
+	var rv []string
+	seen := make(map[string]bool)
+	add := func(addr string) {
+		if addr != owner && !seen[addr] {
+			seen[addr] = true
+			rv = append(rv, addr)
+		}
+	}
+
+	for _, transfer := range settlement.Transfers {
+		for _, input := range transfer.Inputs {
+			if input.Address != owner {
+				continue
+			}
+			for _, output := range transfer.Outputs {
+				add(output.Address)
+			}
+		}
+		for _, output := range transfer.Outputs {
+			if output.Address != owner {
+				continue
+			}
+			for _, input := range transfer.Inputs {
+				add(input.Address)
+			}
+		}
+	}
+
+	return rv
+}
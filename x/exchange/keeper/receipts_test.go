@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func TestGetCounterparties(t *testing.T) {
+	addr := func(name string) string {
+		return sdk.AccAddress(name + "__________________")[:20].String()
+	}
+	seller1, seller2, buyer1, buyer2 := addr("seller1"), addr("seller2"), addr("buyer1"), addr("buyer2")
+	transfer := func(inputs []string, outputs []string) *exchange.Transfer {
+		rv := &exchange.Transfer{}
+		for _, input := range inputs {
+			rv.Inputs = append(rv.Inputs, banktypes.Input{Address: input})
+		}
+		for _, output := range outputs {
+			rv.Outputs = append(rv.Outputs, banktypes.Output{Address: output})
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name       string
+		settlement *exchange.Settlement
+		owner      string
+		expected   []string
+	}{
+		{
+			name:       "no transfers",
+			settlement: &exchange.Settlement{},
+			owner:      seller1,
+			expected:   nil,
+		},
+		{
+			name: "owner not in any transfers",
+			settlement: &exchange.Settlement{Transfers: []*exchange.Transfer{
+				transfer([]string{seller2}, []string{buyer1}),
+				transfer([]string{buyer1}, []string{seller2}),
+			}},
+			owner:    seller1,
+			expected: nil,
+		},
+		{
+			name: "one ask one bid: seller",
+			settlement: &exchange.Settlement{Transfers: []*exchange.Transfer{
+				transfer([]string{seller1}, []string{buyer1}),
+				transfer([]string{buyer1}, []string{seller1}),
+			}},
+			owner:    seller1,
+			expected: []string{buyer1},
+		},
+		{
+			name: "one ask one bid: buyer",
+			settlement: &exchange.Settlement{Transfers: []*exchange.Transfer{
+				transfer([]string{seller1}, []string{buyer1}),
+				transfer([]string{buyer1}, []string{seller1}),
+			}},
+			owner:    buyer1,
+			expected: []string{seller1},
+		},
+		{
+			name: "two asks two bids: multiple counterparties",
+			settlement: &exchange.Settlement{Transfers: []*exchange.Transfer{
+				transfer([]string{seller1}, []string{buyer2, buyer1}),
+				transfer([]string{seller2}, []string{buyer1}),
+				transfer([]string{buyer1, buyer2}, []string{seller1}),
+				transfer([]string{buyer1}, []string{seller2}),
+			}},
+			owner:    buyer1,
+			expected: []string{seller1, seller2},
+		},
+		{
+			name: "owner on both sides of a transfer",
+			settlement: &exchange.Settlement{Transfers: []*exchange.Transfer{
+				transfer([]string{seller1}, []string{seller1, buyer1}),
+			}},
+			owner:    seller1,
+			expected: []string{buyer1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []string
+			testFunc := func() {
+				actual = keeper.GetCounterparties(tc.settlement, tc.owner)
+			}
+			require.NotPanics(t, testFunc, "getCounterparties")
+			assert.Equal(t, tc.expected, actual, "getCounterparties result")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_IterateSettlementReceipts() {
+	receipt := func(receiptID uint64) exchange.SettlementReceipt {
+		return exchange.SettlementReceipt{
+			ReceiptId: receiptID,
+			MarketId:  1,
+			OrderId:   receiptID,
+			OrderType: exchange.OrderTypeAsk,
+			Owner:     s.addr1.String(),
+			Assets:    s.coin("1apple"),
+			Price:     s.coin("2peach"),
+			Height:    5,
+		}
+	}
+	setup := func() {
+		store := s.getStore()
+		for _, id := range []uint64{3, 1, 258, 2} {
+			r := receipt(id)
+			s.Require().NoError(s.k.SetSettlementReceipt(store, &r), "SetSettlementReceipt %d", id)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		setup    func()
+		stopAt   uint64
+		expected []exchange.SettlementReceipt
+	}{
+		{
+			name:     "no receipts",
+			expected: nil,
+		},
+		{
+			name:     "all receipts",
+			setup:    setup,
+			expected: []exchange.SettlementReceipt{receipt(1), receipt(2), receipt(3), receipt(258)},
+		},
+		{
+			name:     "stop early",
+			setup:    setup,
+			stopAt:   2,
+			expected: []exchange.SettlementReceipt{receipt(1), receipt(2)},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual []exchange.SettlementReceipt
+			cb := func(receipt exchange.SettlementReceipt) bool {
+				actual = append(actual, receipt)
+				return receipt.ReceiptId == tc.stopAt
+			}
+			testFunc := func() {
+				s.k.IterateSettlementReceipts(s.ctx, cb)
+			}
+			s.Require().NotPanics(testFunc, "IterateSettlementReceipts")
+			s.Assert().Equal(tc.expected, actual, "receipts iterated")
+		})
+	}
+}
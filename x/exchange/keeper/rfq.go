@@ -0,0 +1,453 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// getLastRFQID gets the id of the last request for quote created.
+func getLastRFQID(store storetypes.KVStore) uint64 {
+	key := MakeKeyLastRFQID()
+	value := store.Get(key)
+	rv, _ := uint64FromBz(value)
+	return rv
+}
+
+// setLastRFQID sets the id of the last request for quote created.
+func setLastRFQID(store storetypes.KVStore, rfqID uint64) {
+	key := MakeKeyLastRFQID()
+	value := uint64Bz(rfqID)
+	store.Set(key, value)
+}
+
+// nextRFQID finds the next available request for quote id, updates the last
+// rfq id store entry, and returns the unused id it found.
+func nextRFQID(store storetypes.KVStore) uint64 {
+	rfqID := getLastRFQID(store) + 1
+	setLastRFQID(store, rfqID)
+	return rfqID
+}
+
+// getRFQ gets a request for quote from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getRFQ(store storetypes.KVStore, rfqID uint64) (*exchange.RequestForQuote, error) {
+	bz := store.Get(MakeKeyRFQ(rfqID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv exchange.RequestForQuote
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal rfq %d: %w", rfqID, err)
+	}
+	return &rv, nil
+}
+
+// setRFQ writes a request for quote (and its index entries) to the store.
+func (k Keeper) setRFQ(store storetypes.KVStore, rfq *exchange.RequestForQuote) error {
+	bz, err := k.cdc.Marshal(rfq)
+	if err != nil {
+		return fmt.Errorf("error marshaling rfq %d: %w", rfq.RfqId, err)
+	}
+	store.Set(MakeKeyRFQ(rfq.RfqId), bz)
+	store.Set(MakeIndexKeyMarketToRFQ(rfq.MarketId, rfq.RfqId), []byte{})
+	store.Set(MakeIndexKeyRFQExpiration(rfq.ExpirationHeight, rfq.RfqId), []byte{})
+	return nil
+}
+
+// deleteRFQ removes a request for quote (and its index entries) from the store.
+func deleteRFQ(store storetypes.KVStore, rfq *exchange.RequestForQuote) {
+	store.Delete(MakeKeyRFQ(rfq.RfqId))
+	store.Delete(MakeIndexKeyMarketToRFQ(rfq.MarketId, rfq.RfqId))
+	store.Delete(MakeIndexKeyRFQExpiration(rfq.ExpirationHeight, rfq.RfqId))
+}
+
+// getRFQOrErr gets a request for quote from the store, returning an error if it does not exist.
+func (k Keeper) getRFQOrErr(store storetypes.KVStore, rfqID uint64) (*exchange.RequestForQuote, error) {
+	rfq, err := k.getRFQ(store, rfqID)
+	if err != nil {
+		return nil, err
+	}
+	if rfq == nil {
+		return nil, fmt.Errorf("rfq %d not found", rfqID)
+	}
+	return rfq, nil
+}
+
+// validateRFQNotExpired returns an error if the request for quote has reached its expiration height.
+// Expired requests for quote aren't deleted until the end of the block, so they can still be found until then.
+func validateRFQNotExpired(ctx sdk.Context, rfq *exchange.RequestForQuote) error {
+	if rfq.ExpirationHeight <= ctx.BlockHeight() {
+		return fmt.Errorf("rfq %d expired at height %d", rfq.RfqId, rfq.ExpirationHeight)
+	}
+	return nil
+}
+
+// GetRFQ gets a request for quote. Returns nil, nil if it does not exist.
+func (k Keeper) GetRFQ(ctx sdk.Context, rfqID uint64) (*exchange.RequestForQuote, error) {
+	return k.getRFQ(k.getStore(ctx), rfqID)
+}
+
+// IterateRFQs iterates over all requests for quote.
+// The callback should return false to continue iteration, or true to stop.
+func (k Keeper) IterateRFQs(ctx sdk.Context, cb func(rfq exchange.RequestForQuote) bool) {
+	k.iterate(ctx, GetKeyPrefixRFQ(), func(_, value []byte) bool {
+		var rfq exchange.RequestForQuote
+		if err := k.cdc.Unmarshal(value, &rfq); err != nil {
+			return false
+		}
+		return cb(rfq)
+	})
+}
+
+// placeHoldOnQuote places a hold on the funds a dealer is offering in a quote.
+func (k Keeper) placeHoldOnQuote(ctx sdk.Context, rfq *exchange.RequestForQuote, quote exchange.Quote) error {
+	dealer, err := sdk.AccAddressFromBech32(quote.Dealer)
+	if err != nil {
+		return fmt.Errorf("invalid dealer %q: %w", quote.Dealer, err)
+	}
+	toHold := rfq.GetQuoteHoldAmount(quote)
+	err = k.holdKeeper.AddHold(ctx, dealer, toHold, fmt.Sprintf("x/exchange: rfq %d", rfq.RfqId))
+	if err != nil {
+		return fmt.Errorf("error placing hold for quote from %s on rfq %d: %w", quote.Dealer, rfq.RfqId, err)
+	}
+	return nil
+}
+
+// releaseHoldOnQuote releases the hold that was placed on the funds a dealer offered in a quote.
+func (k Keeper) releaseHoldOnQuote(ctx sdk.Context, rfq *exchange.RequestForQuote, quote exchange.Quote) error {
+	dealer, err := sdk.AccAddressFromBech32(quote.Dealer)
+	if err != nil {
+		return fmt.Errorf("invalid dealer %q: %w", quote.Dealer, err)
+	}
+	held := rfq.GetQuoteHoldAmount(quote)
+	err = k.holdKeeper.ReleaseHold(ctx, dealer, held)
+	if err != nil {
+		return fmt.Errorf("error releasing hold for quote from %s on rfq %d: %w", quote.Dealer, rfq.RfqId, err)
+	}
+	return nil
+}
+
+// releaseHoldsOnQuotes releases the holds on all the quotes of a request for quote except for the one
+// from the provided dealer (provide "" to release them all).
+func (k Keeper) releaseHoldsOnQuotes(ctx sdk.Context, rfq *exchange.RequestForQuote, except string) error {
+	var errs []error
+	for _, quote := range rfq.Quotes {
+		if quote.Dealer == except {
+			continue
+		}
+		if err := k.releaseHoldOnQuote(ctx, rfq, quote); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CreateRFQ creates a request for quote and returns its id.
+func (k Keeper) CreateRFQ(ctx sdk.Context, msg *exchange.MsgCreateRFQRequest) (uint64, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if msg.ExpirationHeight <= ctx.BlockHeight() {
+		return 0, fmt.Errorf("expiration height %d must be after the current block height %d",
+			msg.ExpirationHeight, ctx.BlockHeight())
+	}
+
+	store := k.getStore(ctx)
+	if err := validateAcceptingOrdersAndCanUserSettle(store, msg.MarketId); err != nil {
+		return 0, err
+	}
+	if err := k.validateNotExternallySettled(store, msg.MarketId); err != nil {
+		return 0, err
+	}
+	buyer := sdk.MustAccAddressFromBech32(msg.Buyer)
+	if err := k.validateUserCanCreateBid(ctx, msg.MarketId, buyer); err != nil {
+		return 0, err
+	}
+
+	rfq := &exchange.RequestForQuote{
+		RfqId:            nextRFQID(store),
+		MarketId:         msg.MarketId,
+		Buyer:            msg.Buyer,
+		Assets:           msg.Assets,
+		PriceDenom:       msg.PriceDenom,
+		ExpirationHeight: msg.ExpirationHeight,
+	}
+	if err := k.setRFQ(store, rfq); err != nil {
+		return 0, err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventRFQCreated(rfq))
+	return rfq.RfqId, nil
+}
+
+// cancelRFQ releases the holds on all of a request for quote's quotes and deletes it.
+func (k Keeper) cancelRFQ(ctx sdk.Context, rfq *exchange.RequestForQuote) error {
+	if err := k.releaseHoldsOnQuotes(ctx, rfq, ""); err != nil {
+		return err
+	}
+	deleteRFQ(k.getStore(ctx), rfq)
+	return nil
+}
+
+// CancelRFQ cancels a request for quote, releasing the holds on all of its quotes.
+func (k Keeper) CancelRFQ(ctx sdk.Context, rfqID uint64, buyer string) error {
+	rfq, err := k.getRFQOrErr(k.getStore(ctx), rfqID)
+	if err != nil {
+		return err
+	}
+	if rfq.Buyer != buyer {
+		return fmt.Errorf("account %s does not have permission to cancel rfq %d", buyer, rfqID)
+	}
+	if err = k.cancelRFQ(ctx, rfq); err != nil {
+		return err
+	}
+	k.emitEvent(ctx, exchange.NewEventRFQCancelled(rfq))
+	return nil
+}
+
+// SubmitQuote adds a dealer's quote to a request for quote, placing a hold on the funds being offered.
+// A dealer can only have one quote on a request for quote; to change it, withdraw it and submit a new one.
+func (k Keeper) SubmitQuote(ctx sdk.Context, msg *exchange.MsgSubmitQuoteRequest) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := k.getStore(ctx)
+	rfq, err := k.getRFQOrErr(store, msg.RfqId)
+	if err != nil {
+		return err
+	}
+	if err = validateRFQNotExpired(ctx, rfq); err != nil {
+		return err
+	}
+	if rfq.FindQuote(msg.Dealer) != nil {
+		return fmt.Errorf("dealer %s already has a quote on rfq %d", msg.Dealer, rfq.RfqId)
+	}
+
+	quote := exchange.Quote{Dealer: msg.Dealer, Price: msg.Price, SellerSettlementFlatFee: msg.SellerSettlementFlatFee}
+	if err = rfq.ValidateQuote(quote); err != nil {
+		return err
+	}
+	if err = validateMarketIsAcceptingOrders(store, rfq.MarketId); err != nil {
+		return err
+	}
+	dealer := sdk.MustAccAddressFromBech32(msg.Dealer)
+	if err = k.validateUserCanCreateAsk(ctx, rfq.MarketId, dealer); err != nil {
+		return err
+	}
+	if err = validateSellerSettlementFlatFee(store, rfq.MarketId, quote.SellerSettlementFlatFee); err != nil {
+		return err
+	}
+	if err = validateAskPrice(store, rfq.MarketId, quote.Price, quote.SellerSettlementFlatFee); err != nil {
+		return err
+	}
+
+	if err = k.placeHoldOnQuote(ctx, rfq, quote); err != nil {
+		return err
+	}
+	rfq.Quotes = append(rfq.Quotes, quote)
+	if err = k.setRFQ(store, rfq); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventQuoteSubmitted(rfq, quote))
+	return nil
+}
+
+// WithdrawQuote removes a dealer's quote from a request for quote, releasing the hold on its funds.
+func (k Keeper) WithdrawQuote(ctx sdk.Context, rfqID uint64, dealer string) error {
+	store := k.getStore(ctx)
+	rfq, err := k.getRFQOrErr(store, rfqID)
+	if err != nil {
+		return err
+	}
+	quote := rfq.FindQuote(dealer)
+	if quote == nil {
+		return fmt.Errorf("dealer %s does not have a quote on rfq %d", dealer, rfqID)
+	}
+	if err = k.releaseHoldOnQuote(ctx, rfq, *quote); err != nil {
+		return err
+	}
+
+	quotes := make([]exchange.Quote, 0, len(rfq.Quotes)-1)
+	for _, q := range rfq.Quotes {
+		if q.Dealer != dealer {
+			quotes = append(quotes, q)
+		}
+	}
+	rfq.Quotes = quotes
+	if err = k.setRFQ(store, rfq); err != nil {
+		return err
+	}
+
+	k.emitEvent(ctx, exchange.NewEventQuoteWithdrawn(rfq, dealer))
+	return nil
+}
+
+// AcceptQuote settles a dealer's quote for a request for quote. An ask order is created for the dealer and
+// a bid order for the buyer; they're settled immediately using the same machinery as other settlements.
+// The holds on all other quotes are released and the request for quote is deleted.
+// Returns the ids of the ask and bid orders that were settled.
+func (k Keeper) AcceptQuote(ctx sdk.Context, msg *exchange.MsgAcceptQuoteRequest) (uint64, uint64, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, 0, err
+	}
+
+	store := k.getStore(ctx)
+	rfq, err := k.getRFQOrErr(store, msg.RfqId)
+	if err != nil {
+		return 0, 0, err
+	}
+	if rfq.Buyer != msg.Buyer {
+		return 0, 0, fmt.Errorf("account %s does not have permission to accept quotes on rfq %d", msg.Buyer, rfq.RfqId)
+	}
+	if err = validateRFQNotExpired(ctx, rfq); err != nil {
+		return 0, 0, err
+	}
+	quote := rfq.FindQuote(msg.Dealer)
+	if quote == nil {
+		return 0, 0, fmt.Errorf("dealer %s does not have a quote on rfq %d", msg.Dealer, rfq.RfqId)
+	}
+	if !quote.Price.Equal(msg.Price) {
+		return 0, 0, fmt.Errorf("price %s does not equal quote price %s", msg.Price, quote.Price)
+	}
+
+	marketID := rfq.MarketId
+	if err = validateAcceptingOrdersAndCanUserSettle(store, marketID); err != nil {
+		return 0, 0, err
+	}
+	if err = k.validateNotExternallySettled(store, marketID); err != nil {
+		return 0, 0, err
+	}
+	buyer := sdk.MustAccAddressFromBech32(msg.Buyer)
+	if err = k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
+		return 0, 0, err
+	}
+	if err = validateBuyerSettlementFee(store, marketID, msg.Price, msg.BuyerSettlementFees); err != nil {
+		return 0, 0, err
+	}
+
+	// Checked before the holds are changed so that the rfq and quotes are left alone if the market is paused.
+	tripped, err := k.checkPriceBand(ctx, store, marketID, []exchange.NetAssetPrice{{Assets: rfq.Assets, Price: msg.Price}})
+	if err != nil || tripped {
+		return 0, 0, err
+	}
+
+	if err = k.releaseHoldsOnQuotes(ctx, rfq, quote.Dealer); err != nil {
+		return 0, 0, err
+	}
+
+	// The orders are never stored; they only exist so that the settlement can be built and closed.
+	askOrder := exchange.NewOrder(nextOrderID(store)).WithAsk(rfq.QuoteAskOrder(*quote))
+	bidOrder := exchange.NewOrder(nextOrderID(store)).WithBid(&exchange.BidOrder{
+		MarketId:            marketID,
+		Buyer:               msg.Buyer,
+		Assets:              rfq.Assets,
+		Price:               msg.Price,
+		BuyerSettlementFees: msg.BuyerSettlementFees,
+	})
+	// The ask order's funds are already on hold (from the quote), but the bid order's aren't yet.
+	// Closing the settlement releases the holds on both.
+	if err = k.placeHoldOnOrder(ctx, bidOrder); err != nil {
+		return 0, 0, err
+	}
+
+	ratioGetter := func(denom string) (*exchange.FeeRatio, error) {
+		return getSellerSettlementRatio(store, marketID, denom)
+	}
+	settlement, err := exchange.BuildSettlement([]*exchange.Order{askOrder}, []*exchange.Order{bidOrder}, ratioGetter)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err = k.closeSettlement(ctx, store, marketID, settlement); err != nil {
+		return 0, 0, err
+	}
+
+	deleteRFQ(store, rfq)
+	k.emitEvent(ctx, exchange.NewEventQuoteAccepted(rfq, *quote, askOrder.OrderId, bidOrder.OrderId))
+	return askOrder.OrderId, bidOrder.OrderId, nil
+}
+
+// CancelAllRFQsForMarket cancels all of the requests for quote in a market.
+func (k Keeper) CancelAllRFQsForMarket(ctx sdk.Context, marketID uint32) {
+	store := k.getStore(ctx)
+	var rfqIDs []uint64
+	iterate(store, GetIndexKeyPrefixMarketToRFQ(marketID), func(keySuffix, _ []byte) bool {
+		if rfqID, err := ParseIndexKeySuffixMarketToRFQ(keySuffix); err == nil {
+			rfqIDs = append(rfqIDs, rfqID)
+		}
+		return false
+	})
+
+	var errs []error
+	for _, rfqID := range rfqIDs {
+		rfq, err := k.getRFQ(store, rfqID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if rfq == nil {
+			continue
+		}
+		if err = k.cancelRFQ(ctx, rfq); err != nil {
+			errs = append(errs, fmt.Errorf("error cancelling rfq %d: %w", rfqID, err))
+			continue
+		}
+		k.emitEvent(ctx, exchange.NewEventRFQCancelled(rfq))
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered cancelling all rfqs for market %d:\n%v",
+			len(errs), marketID, errors.Join(errs...))
+	}
+}
+
+// ExpireRFQs cancels all the requests for quote that have reached their expiration height.
+// Errors are logged but do not stop the processing of other requests for quote.
+func (k Keeper) ExpireRFQs(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := k.getStore(ctx)
+
+	var expired []uint64
+	iterate(store, GetIndexKeyPrefixRFQExpiration(), func(keySuffix, _ []byte) bool {
+		expHeight, rfqID, err := ParseIndexKeySuffixRFQExpiration(keySuffix)
+		if err != nil {
+			return false
+		}
+		if expHeight > height {
+			return true
+		}
+		expired = append(expired, rfqID)
+		return false
+	})
+
+	var errs []error
+	for _, rfqID := range expired {
+		rfq, err := k.getRFQ(store, rfqID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if rfq == nil {
+			continue
+		}
+
+		// Use a cache context so that a failed expiration doesn't leave anything half done.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.cancelRFQ(cacheCtx, rfq); err != nil {
+			errs = append(errs, fmt.Errorf("error expiring rfq %d: %w", rfqID, err))
+			continue
+		}
+		writeCache()
+		k.emitEvent(ctx, exchange.NewEventRFQExpired(rfq))
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered expiring rfqs:\n%v", len(errs), errors.Join(errs...))
+	}
+}
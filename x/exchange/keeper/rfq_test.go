@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+func (s *TestSuite) TestRFQExpiration() {
+	buyer, dealer1, dealer2 := s.addr1, s.addr2, s.addr3
+	s.requireFundAccount(dealer1, "10apple")
+	s.requireFundAccount(dealer2, "10apple")
+	s.requireFundAccount(buyer, "100fig")
+	s.requireCreateMarketUnmocked(exchange.Market{
+		MarketId:            1,
+		AcceptingOrders:     true,
+		AllowUserSettlement: true,
+	})
+
+	newRFQ := func(expirationHeight int64) uint64 {
+		rfqID, err := s.k.CreateRFQ(s.ctx, &exchange.MsgCreateRFQRequest{
+			Buyer:            buyer.String(),
+			MarketId:         1,
+			Assets:           s.coin("10apple"),
+			PriceDenom:       "fig",
+			ExpirationHeight: expirationHeight,
+		})
+		s.Require().NoError(err, "CreateRFQ(expiration height %d)", expirationHeight)
+		return rfqID
+	}
+	submitQuote := func(rfqID uint64, dealerAddr string, price string) error {
+		return s.k.SubmitQuote(s.ctx, &exchange.MsgSubmitQuoteRequest{
+			Dealer: dealerAddr,
+			RfqId:  rfqID,
+			Price:  s.coin(price),
+		})
+	}
+	acceptQuote := func(rfqID uint64, dealerAddr string, price string) error {
+		_, _, err := s.k.AcceptQuote(s.ctx, &exchange.MsgAcceptQuoteRequest{
+			Buyer:  buyer.String(),
+			RfqId:  rfqID,
+			Dealer: dealerAddr,
+			Price:  s.coin(price),
+		})
+		return err
+	}
+
+	s.ctx = s.ctx.WithBlockHeight(10)
+	rfqID := newRFQ(12)
+
+	s.Run("submit quote before expiration height", func() {
+		s.ctx = s.ctx.WithBlockHeight(11)
+		s.Require().NoError(submitQuote(rfqID, dealer1.String(), "50fig"), "SubmitQuote")
+	})
+
+	s.Run("submit quote at expiration height", func() {
+		s.ctx = s.ctx.WithBlockHeight(12)
+		err := submitQuote(rfqID, dealer2.String(), "40fig")
+		s.Require().EqualError(err, "rfq 1 expired at height 12", "SubmitQuote")
+	})
+
+	s.Run("accept quote at expiration height", func() {
+		s.ctx = s.ctx.WithBlockHeight(12)
+		err := acceptQuote(rfqID, dealer1.String(), "50fig")
+		s.Require().EqualError(err, "rfq 1 expired at height 12", "AcceptQuote")
+		s.Assert().Equal("10apple", s.app.BankKeeper.GetBalance(s.ctx, dealer1, "apple").String(), "dealer1 apple balance")
+		s.Assert().Equal("100fig", s.app.BankKeeper.GetBalance(s.ctx, buyer, "fig").String(), "buyer fig balance")
+	})
+
+	s.Run("accept quote before expiration height", func() {
+		s.ctx = s.ctx.WithBlockHeight(20)
+		otherID := newRFQ(22)
+		s.ctx = s.ctx.WithBlockHeight(21)
+		s.Require().NoError(submitQuote(otherID, dealer2.String(), "40fig"), "SubmitQuote")
+		s.Require().NoError(acceptQuote(otherID, dealer2.String(), "40fig"), "AcceptQuote")
+		s.Assert().Equal("10apple", s.app.BankKeeper.GetBalance(s.ctx, buyer, "apple").String(), "buyer apple balance")
+		s.Assert().Equal("60fig", s.app.BankKeeper.GetBalance(s.ctx, buyer, "fig").String(), "buyer fig balance")
+	})
+}
@@ -424,6 +424,38 @@ func (s *TestSuite) copyPayments(orig []exchange.Payment) []exchange.Payment {
 	return copySlice(orig, s.copyPayment)
 }
 
+// copyNAV creates a copy of a net asset price.
+func (s *TestSuite) copyNAV(orig exchange.NetAssetPrice) exchange.NetAssetPrice {
+	return exchange.NetAssetPrice{
+		Assets: s.copyCoin(orig.Assets),
+		Price:  s.copyCoin(orig.Price),
+	}
+}
+
+// copySettlementReceipt creates a copy of a settlement receipt.
+func (s *TestSuite) copySettlementReceipt(orig exchange.SettlementReceipt) exchange.SettlementReceipt {
+	return exchange.SettlementReceipt{
+		ReceiptId:      orig.ReceiptId,
+		MarketId:       orig.MarketId,
+		OrderId:        orig.OrderId,
+		OrderType:      orig.OrderType,
+		Owner:          orig.Owner,
+		Counterparties: copySlice(orig.Counterparties, noOpCopier[string]),
+		Assets:         s.copyCoin(orig.Assets),
+		Price:          s.copyCoin(orig.Price),
+		Fees:           s.copyCoins(orig.Fees),
+		Partial:        orig.Partial,
+		ExternalId:     orig.ExternalId,
+		Navs:           copySlice(orig.Navs, s.copyNAV),
+		Height:         orig.Height,
+	}
+}
+
+// copySettlementReceipts creates a copy of a slice of settlement receipts.
+func (s *TestSuite) copySettlementReceipts(orig []exchange.SettlementReceipt) []exchange.SettlementReceipt {
+	return copySlice(orig, s.copySettlementReceipt)
+}
+
 // untypeEvent applies sdk.TypedEventToEvent(tev) requiring it to not error.
 func (s *TestSuite) untypeEvent(tev proto.Message) sdk.Event {
 	rv, err := sdk.TypedEventToEvent(tev)
@@ -474,13 +506,15 @@ func (s *TestSuite) copyGenState(genState *exchange.GenesisState) *exchange.Gene
 		return nil
 	}
 	return &exchange.GenesisState{
-		Params:       s.copyParams(genState.Params),
-		Markets:      s.copyMarkets(genState.Markets),
-		Orders:       s.copyOrders(genState.Orders),
-		LastMarketId: genState.LastMarketId,
-		LastOrderId:  genState.LastOrderId,
-		Commitments:  s.copyCommitments(genState.Commitments),
-		Payments:     s.copyPayments(genState.Payments),
+		Params:             s.copyParams(genState.Params),
+		Markets:            s.copyMarkets(genState.Markets),
+		Orders:             s.copyOrders(genState.Orders),
+		LastMarketId:       genState.LastMarketId,
+		LastOrderId:        genState.LastOrderId,
+		Commitments:        s.copyCommitments(genState.Commitments),
+		Payments:           s.copyPayments(genState.Payments),
+		SettlementReceipts: s.copySettlementReceipts(genState.SettlementReceipts),
+		LastReceiptId:      genState.LastReceiptId,
 	}
 }
 
@@ -588,6 +622,12 @@ func (s *TestSuite) sortGenState(genState *exchange.GenesisState) *exchange.Gene
 		})
 	}
 
+	if len(genState.SettlementReceipts) > 0 {
+		sort.Slice(genState.SettlementReceipts, func(i, j int) bool {
+			return genState.SettlementReceipts[i].ReceiptId < genState.SettlementReceipts[j].ReceiptId
+		})
+	}
+
 	return genState
 }
 
@@ -797,6 +837,13 @@ func (s *TestSuite) requireCreatePayments(payments ...*exchange.Payment) {
 	}
 }
 
+// requireSaveTradingGrant saves a MarketTradingAuthorization grant from the granter to the grantee, making sure it doesn't return an error.
+func (s *TestSuite) requireSaveTradingGrant(grantee, granter sdk.AccAddress, msgTypeURL string, marketIDs ...uint32) {
+	authorization := exchange.NewMarketTradingAuthorization(msgTypeURL, marketIDs, nil)
+	err := s.app.AuthzKeeper.SaveGrant(s.ctx, grantee, granter, authorization, nil)
+	s.Require().NoError(err, "SaveGrant(%s, %s, %s)", s.getAddrName(grantee), s.getAddrName(granter), authorization)
+}
+
 // assertAccAddressFromBech32 calls AccAddressFromBech32 asserting that it doesn't return an error.
 func (s *TestSuite) assertAccAddressFromBech32(bech32 string, msg string, args ...interface{}) (sdk.AccAddress, bool) {
 	rv, err := sdk.AccAddressFromBech32(bech32)
@@ -30,7 +30,8 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 type AppModuleBasic struct {
@@ -122,6 +123,16 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	exchange.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
 }
 
+// EndBlock samples the resting liquidity of markets that pay rebates, pays out any rebates that are due,
+// and cancels any external settlements and requests for quote that have expired.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	am.keeper.ProcessMarketRebates(ctx)
+	am.keeper.ExpireExternalSettlements(ctx)
+	am.keeper.ExpireRFQs(ctx)
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
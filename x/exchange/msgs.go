@@ -22,6 +22,13 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgFillBidsRequest)(nil),
 	(*MsgFillAsksRequest)(nil),
 	(*MsgMarketSettleRequest)(nil),
+	(*MsgMarketStartExternalSettlementRequest)(nil),
+	(*MsgConfirmExternalPaymentRequest)(nil),
+	(*MsgCreateRFQRequest)(nil),
+	(*MsgCancelRFQRequest)(nil),
+	(*MsgSubmitQuoteRequest)(nil),
+	(*MsgWithdrawQuoteRequest)(nil),
+	(*MsgAcceptQuoteRequest)(nil),
 	(*MsgMarketCommitmentSettleRequest)(nil),
 	(*MsgMarketReleaseCommitmentsRequest)(nil),
 	(*MsgMarketSetOrderExternalIDRequest)(nil),
@@ -32,6 +39,10 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgMarketUpdateUserSettleRequest)(nil),
 	(*MsgMarketUpdateAcceptingCommitmentsRequest)(nil),
 	(*MsgMarketUpdateIntermediaryDenomRequest)(nil),
+	(*MsgMarketUpdateRebatesRequest)(nil),
+	(*MsgMarketUpdateExternalSettlementRequest)(nil),
+	(*MsgMarketUpdatePriceBandRequest)(nil),
+	(*MsgMarketResumeSettlementsRequest)(nil),
 	(*MsgMarketManagePermissionsRequest)(nil),
 	(*MsgMarketManageReqAttrsRequest)(nil),
 	(*MsgCreatePaymentRequest)(nil),
@@ -40,6 +51,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgRejectPaymentsRequest)(nil),
 	(*MsgCancelPaymentsRequest)(nil),
 	(*MsgChangePaymentTargetRequest)(nil),
+	(*MsgIssuerCreateMarketRequest)(nil),
 	(*MsgGovCreateMarketRequest)(nil),
 	(*MsgGovManageFeesRequest)(nil),
 	(*MsgGovCloseMarketRequest)(nil),
@@ -261,6 +273,115 @@ func (m MsgMarketSettleRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgMarketStartExternalSettlementRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if m.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid buyer %q: %w", m.Buyer, err))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgConfirmExternalPaymentRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Oracle); err != nil {
+		errs = append(errs, fmt.Errorf("invalid oracle %q: %w", m.Oracle, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if m.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: cannot be zero"))
+	}
+	if err := ValidatePaymentReference(m.PaymentReference); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgCreateRFQRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid buyer %q: %w", m.Buyer, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := validateRFQAssets(m.Assets, m.PriceDenom); err != nil {
+		errs = append(errs, err)
+	}
+	if m.ExpirationHeight <= 0 {
+		errs = append(errs, fmt.Errorf("invalid expiration height %d: must be positive", m.ExpirationHeight))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgCancelRFQRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid buyer %q: %w", m.Buyer, err))
+	}
+	if m.RfqId == 0 {
+		errs = append(errs, errors.New("invalid rfq id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgSubmitQuoteRequest) ValidateBasic() error {
+	var errs []error
+	if m.RfqId == 0 {
+		errs = append(errs, errors.New("invalid rfq id: cannot be zero"))
+	}
+	quote := Quote{Dealer: m.Dealer, Price: m.Price, SellerSettlementFlatFee: m.SellerSettlementFlatFee}
+	if err := quote.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgWithdrawQuoteRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Dealer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid dealer %q: %w", m.Dealer, err))
+	}
+	if m.RfqId == 0 {
+		errs = append(errs, errors.New("invalid rfq id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgAcceptQuoteRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid buyer %q: %w", m.Buyer, err))
+	}
+	if m.RfqId == 0 {
+		errs = append(errs, errors.New("invalid rfq id: cannot be zero"))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Dealer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid dealer %q: %w", m.Dealer, err))
+	} else if m.Dealer == m.Buyer {
+		errs = append(errs, fmt.Errorf("dealer %s cannot be the buyer", m.Dealer))
+	}
+	if err := validateCoin("price", m.Price); err != nil {
+		errs = append(errs, err)
+	}
+	if len(m.BuyerSettlementFees) > 0 {
+		if err := m.BuyerSettlementFees.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid buyer settlement fees: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketCommitmentSettleRequest) Validate(requireInputs bool) error {
 	var errs []error
 
@@ -487,6 +608,59 @@ func (m MsgMarketUpdateIntermediaryDenomRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgMarketUpdateRebatesRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdateExternalSettlementRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketUpdatePriceBandRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := m.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (m MsgMarketResumeSettlementsRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketManagePermissionsRequest) ValidateBasic() error {
 	var errs []error
 
@@ -675,6 +849,18 @@ func (m MsgChangePaymentTargetRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgIssuerCreateMarketRequest) ValidateBasic() error {
+	errs := make([]error, 0, 3)
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid issuer: %w", err))
+	}
+	if err := sdk.ValidateDenom(m.MarkerDenom); err != nil {
+		errs = append(errs, fmt.Errorf("invalid marker denom: %w", err))
+	}
+	errs = append(errs, m.Market.Validate())
+	return errors.Join(errs...)
+}
+
 func (m MsgGovCreateMarketRequest) ValidateBasic() error {
 	errs := make([]error, 0, 2)
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -38,6 +38,13 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgFillBidsRequest{Seller: signer} },
 		func(signer string) sdk.Msg { return &MsgFillAsksRequest{Buyer: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketSettleRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketStartExternalSettlementRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgConfirmExternalPaymentRequest{Oracle: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateRFQRequest{Buyer: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelRFQRequest{Buyer: signer} },
+		func(signer string) sdk.Msg { return &MsgSubmitQuoteRequest{Dealer: signer} },
+		func(signer string) sdk.Msg { return &MsgWithdrawQuoteRequest{Dealer: signer} },
+		func(signer string) sdk.Msg { return &MsgAcceptQuoteRequest{Buyer: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketCommitmentSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketReleaseCommitmentsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketSetOrderExternalIDRequest{Admin: signer} },
@@ -48,6 +55,10 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgMarketUpdateUserSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingCommitmentsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateIntermediaryDenomRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateRebatesRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateExternalSettlementRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdatePriceBandRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketResumeSettlementsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManagePermissionsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManageReqAttrsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgCreatePaymentRequest{Payment: Payment{Source: signer}} },
@@ -56,6 +67,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgRejectPaymentsRequest{Target: signer} },
 		func(signer string) sdk.Msg { return &MsgCancelPaymentsRequest{Source: signer} },
 		func(signer string) sdk.Msg { return &MsgChangePaymentTargetRequest{Source: signer} },
+		func(signer string) sdk.Msg { return &MsgIssuerCreateMarketRequest{Issuer: signer} },
 		func(signer string) sdk.Msg { return &MsgGovCreateMarketRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgGovManageFeesRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgGovCloseMarketRequest{Authority: signer} },
@@ -964,6 +976,350 @@ func TestMsgMarketSettleRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMarketStartExternalSettlementRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketStartExternalSettlementRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgMarketStartExternalSettlementRequest{Admin: admin, MarketId: 1, OrderId: 5, Buyer: buyer},
+		},
+		{
+			name:   "bad admin",
+			msg:    MsgMarketStartExternalSettlementRequest{Admin: "notanadminaddr", MarketId: 1, OrderId: 5, Buyer: buyer},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name:   "market zero",
+			msg:    MsgMarketStartExternalSettlementRequest{Admin: admin, MarketId: 0, OrderId: 5, Buyer: buyer},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "order zero",
+			msg:    MsgMarketStartExternalSettlementRequest{Admin: admin, MarketId: 1, OrderId: 0, Buyer: buyer},
+			expErr: []string{"invalid order id: cannot be zero"},
+		},
+		{
+			name:   "bad buyer",
+			msg:    MsgMarketStartExternalSettlementRequest{Admin: admin, MarketId: 1, OrderId: 5, Buyer: "notabuyeraddr"},
+			expErr: []string{"invalid buyer \"notabuyeraddr\": " + bech32Err},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketStartExternalSettlementRequest{},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid order id: cannot be zero",
+				"invalid buyer \"\": " + emptyAddrErr,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgConfirmExternalPaymentRequest_ValidateBasic(t *testing.T) {
+	oracle := sdk.AccAddress("oracle______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgConfirmExternalPaymentRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgConfirmExternalPaymentRequest{Oracle: oracle, MarketId: 1, OrderId: 5, PaymentReference: "wire-123"},
+		},
+		{
+			name: "max length reference",
+			msg: MsgConfirmExternalPaymentRequest{
+				Oracle: oracle, MarketId: 1, OrderId: 5,
+				PaymentReference: strings.Repeat("r", MaxPaymentReferenceLength),
+			},
+		},
+		{
+			name:   "bad oracle",
+			msg:    MsgConfirmExternalPaymentRequest{Oracle: "notanoracleaddr", MarketId: 1, OrderId: 5, PaymentReference: "wire-123"},
+			expErr: []string{"invalid oracle \"notanoracleaddr\": " + bech32Err},
+		},
+		{
+			name:   "market zero",
+			msg:    MsgConfirmExternalPaymentRequest{Oracle: oracle, MarketId: 0, OrderId: 5, PaymentReference: "wire-123"},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "order zero",
+			msg:    MsgConfirmExternalPaymentRequest{Oracle: oracle, MarketId: 1, OrderId: 0, PaymentReference: "wire-123"},
+			expErr: []string{"invalid order id: cannot be zero"},
+		},
+		{
+			name: "reference too long",
+			msg: MsgConfirmExternalPaymentRequest{
+				Oracle: oracle, MarketId: 1, OrderId: 5,
+				PaymentReference: strings.Repeat("r", MaxPaymentReferenceLength+1),
+			},
+			expErr: []string{"invalid payment reference \"rrrrr...rrrrr\" (length 101): max length 100"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgConfirmExternalPaymentRequest{},
+			expErr: []string{
+				"invalid oracle \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid order id: cannot be zero",
+				"invalid payment reference: cannot be empty",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCreateRFQRequest_ValidateBasic(t *testing.T) {
+	buyer := sdk.AccAddress("buyer_______________").String()
+	assets := sdk.NewInt64Coin("apple", 10)
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateRFQRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgCreateRFQRequest{Buyer: buyer, MarketId: 1, Assets: assets, PriceDenom: "pear", ExpirationHeight: 100},
+		},
+		{
+			name:   "bad buyer",
+			msg:    MsgCreateRFQRequest{Buyer: "notabuyeraddr", MarketId: 1, Assets: assets, PriceDenom: "pear", ExpirationHeight: 100},
+			expErr: []string{"invalid buyer \"notabuyeraddr\": " + bech32Err},
+		},
+		{
+			name:   "market zero",
+			msg:    MsgCreateRFQRequest{Buyer: buyer, MarketId: 0, Assets: assets, PriceDenom: "pear", ExpirationHeight: 100},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "zero assets",
+			msg:    MsgCreateRFQRequest{Buyer: buyer, MarketId: 1, Assets: sdk.NewInt64Coin("apple", 0), PriceDenom: "pear", ExpirationHeight: 100},
+			expErr: []string{"invalid assets: cannot be zero"},
+		},
+		{
+			name:   "bad price denom",
+			msg:    MsgCreateRFQRequest{Buyer: buyer, MarketId: 1, Assets: assets, PriceDenom: "x", ExpirationHeight: 100},
+			expErr: []string{"invalid price denom \"x\": invalid denom: x"},
+		},
+		{
+			name:   "price denom same as assets",
+			msg:    MsgCreateRFQRequest{Buyer: buyer, MarketId: 1, Assets: assets, PriceDenom: "apple", ExpirationHeight: 100},
+			expErr: []string{"invalid assets: price denom apple cannot also be the assets denom"},
+		},
+		{
+			name:   "negative expiration",
+			msg:    MsgCreateRFQRequest{Buyer: buyer, MarketId: 1, Assets: assets, PriceDenom: "pear", ExpirationHeight: -1},
+			expErr: []string{"invalid expiration height -1: must be positive"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgCreateRFQRequest{},
+			expErr: []string{
+				"invalid buyer \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid assets: invalid denom: ",
+				"invalid price denom \"\": invalid denom: ",
+				"invalid expiration height 0: must be positive",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgCancelRFQRequest_ValidateBasic(t *testing.T) {
+	buyer := sdk.AccAddress("buyer_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgCancelRFQRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgCancelRFQRequest{Buyer: buyer, RfqId: 3},
+		},
+		{
+			name:   "bad buyer",
+			msg:    MsgCancelRFQRequest{Buyer: "notabuyeraddr", RfqId: 3},
+			expErr: []string{"invalid buyer \"notabuyeraddr\": " + bech32Err},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgCancelRFQRequest{},
+			expErr: []string{
+				"invalid buyer \"\": " + emptyAddrErr,
+				"invalid rfq id: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgSubmitQuoteRequest_ValidateBasic(t *testing.T) {
+	dealer := sdk.AccAddress("dealer______________").String()
+	price := sdk.NewInt64Coin("pear", 12)
+	fee := sdk.NewInt64Coin("fig", 1)
+	zeroFee := sdk.NewInt64Coin("fig", 0)
+
+	tests := []struct {
+		name   string
+		msg    MsgSubmitQuoteRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgSubmitQuoteRequest{Dealer: dealer, RfqId: 3, Price: price},
+		},
+		{
+			name: "with seller settlement flat fee",
+			msg:  MsgSubmitQuoteRequest{Dealer: dealer, RfqId: 3, Price: price, SellerSettlementFlatFee: &fee},
+		},
+		{
+			name:   "zero seller settlement flat fee",
+			msg:    MsgSubmitQuoteRequest{Dealer: dealer, RfqId: 3, Price: price, SellerSettlementFlatFee: &zeroFee},
+			expErr: []string{"invalid seller settlement flat fee: fig amount cannot be zero"},
+		},
+		{
+			name:   "zero price",
+			msg:    MsgSubmitQuoteRequest{Dealer: dealer, RfqId: 3, Price: sdk.NewInt64Coin("pear", 0)},
+			expErr: []string{"invalid price: cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgSubmitQuoteRequest{},
+			expErr: []string{
+				"invalid rfq id: cannot be zero",
+				"invalid dealer \"\": " + emptyAddrErr,
+				"invalid price: invalid denom: ",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgWithdrawQuoteRequest_ValidateBasic(t *testing.T) {
+	dealer := sdk.AccAddress("dealer______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgWithdrawQuoteRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgWithdrawQuoteRequest{Dealer: dealer, RfqId: 3},
+		},
+		{
+			name:   "bad dealer",
+			msg:    MsgWithdrawQuoteRequest{Dealer: "notadealeraddr", RfqId: 3},
+			expErr: []string{"invalid dealer \"notadealeraddr\": " + bech32Err},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgWithdrawQuoteRequest{},
+			expErr: []string{
+				"invalid dealer \"\": " + emptyAddrErr,
+				"invalid rfq id: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgAcceptQuoteRequest_ValidateBasic(t *testing.T) {
+	buyer := sdk.AccAddress("buyer_______________").String()
+	dealer := sdk.AccAddress("dealer______________").String()
+	price := sdk.NewInt64Coin("pear", 12)
+
+	tests := []struct {
+		name   string
+		msg    MsgAcceptQuoteRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgAcceptQuoteRequest{Buyer: buyer, RfqId: 3, Dealer: dealer, Price: price},
+		},
+		{
+			name: "with buyer settlement fees",
+			msg: MsgAcceptQuoteRequest{
+				Buyer: buyer, RfqId: 3, Dealer: dealer, Price: price,
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 2)),
+			},
+		},
+		{
+			name: "invalid buyer settlement fees",
+			msg: MsgAcceptQuoteRequest{
+				Buyer: buyer, RfqId: 3, Dealer: dealer, Price: price,
+				BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: []string{"invalid buyer settlement fees: coin -1fig amount is not positive"},
+		},
+		{
+			name:   "dealer is buyer",
+			msg:    MsgAcceptQuoteRequest{Buyer: buyer, RfqId: 3, Dealer: buyer, Price: price},
+			expErr: []string{"dealer " + buyer + " cannot be the buyer"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgAcceptQuoteRequest{},
+			expErr: []string{
+				"invalid buyer \"\": " + emptyAddrErr,
+				"invalid rfq id: cannot be zero",
+				"invalid dealer \"\": " + emptyAddrErr,
+				"invalid price: invalid denom: ",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgMarketCommitmentSettleRequest_ValidateBasic(t *testing.T) {
 	type testCase struct {
 		name         string
@@ -1992,6 +2348,218 @@ func TestMsgMarketUpdateIntermediaryDenomRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMarketUpdateRebatesRequest_ValidateBasic(t *testing.T) {
+	goodConfig := MarketRebateConfig{PoolBips: 100, PriceDenom: "nhash", SampleInterval: 10, PayoutInterval: 100}
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateRebatesRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg: MsgMarketUpdateRebatesRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 1,
+				Config:   goodConfig,
+			},
+		},
+		{
+			name: "disabled",
+			msg: MsgMarketUpdateRebatesRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 1,
+			},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketUpdateRebatesRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
+				Config:   goodConfig,
+			},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketUpdateRebatesRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
+				Config:   goodConfig,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "invalid config",
+			msg: MsgMarketUpdateRebatesRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 1,
+				Config:   MarketRebateConfig{PoolBips: 10_001, PriceDenom: "nhash", SampleInterval: 10, PayoutInterval: 100},
+			},
+			expErr: []string{"invalid rebate pool bips 10001: exceeds max of 10000"},
+		},
+		{
+			name: "multiple errors",
+			msg: MsgMarketUpdateRebatesRequest{
+				Admin:    "",
+				MarketId: 0,
+				Config:   MarketRebateConfig{PriceDenom: "nhash"},
+			},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid rebate config: a config with zero pool bips must not have any other fields set",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketUpdateExternalSettlementRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	goodConfig := ExternalSettlementConfig{Oracles: []string{sdk.AccAddress("oracle______________").String()}, TimeoutBlocks: 100}
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateExternalSettlementRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgMarketUpdateExternalSettlementRequest{Admin: admin, MarketId: 1, Config: goodConfig},
+		},
+		{
+			name: "disabled",
+			msg:  MsgMarketUpdateExternalSettlementRequest{Admin: admin, MarketId: 1},
+		},
+		{
+			name:   "bad admin",
+			msg:    MsgMarketUpdateExternalSettlementRequest{Admin: "notanadminaddr", MarketId: 1, Config: goodConfig},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name:   "market zero",
+			msg:    MsgMarketUpdateExternalSettlementRequest{Admin: admin, MarketId: 0, Config: goodConfig},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "invalid config",
+			msg:    MsgMarketUpdateExternalSettlementRequest{Admin: admin, MarketId: 1, Config: ExternalSettlementConfig{Oracles: goodConfig.Oracles}},
+			expErr: []string{"invalid external settlement timeout 0: must be positive"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketUpdateExternalSettlementRequest{Config: ExternalSettlementConfig{TimeoutBlocks: 5}},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid external settlement config: a config without oracles must not have a timeout",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketUpdatePriceBandRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+	goodConfig := MarketPriceBandConfig{
+		MaxDeviationBips: 500,
+		Reference:        PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT,
+		Action:           PriceBandAction_PRICE_BAND_ACTION_REJECT,
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdatePriceBandRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgMarketUpdatePriceBandRequest{Admin: admin, MarketId: 1, Config: goodConfig},
+		},
+		{
+			name: "disabled",
+			msg:  MsgMarketUpdatePriceBandRequest{Admin: admin, MarketId: 1},
+		},
+		{
+			name:   "bad admin",
+			msg:    MsgMarketUpdatePriceBandRequest{Admin: "notanadminaddr", MarketId: 1, Config: goodConfig},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name:   "market zero",
+			msg:    MsgMarketUpdatePriceBandRequest{Admin: admin, MarketId: 0, Config: goodConfig},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "invalid config",
+			msg: MsgMarketUpdatePriceBandRequest{Admin: admin, MarketId: 1, Config: MarketPriceBandConfig{
+				MaxDeviationBips: 500, Reference: goodConfig.Reference,
+			}},
+			expErr: []string{"invalid price band action PRICE_BAND_ACTION_UNSPECIFIED"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketUpdatePriceBandRequest{Config: MarketPriceBandConfig{Action: goodConfig.Action}},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+				"invalid price band config: a config with zero max deviation bips must not have any other fields set",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
+func TestMsgMarketResumeSettlementsRequest_ValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgMarketResumeSettlementsRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgMarketResumeSettlementsRequest{Admin: admin, MarketId: 1},
+		},
+		{
+			name:   "bad admin",
+			msg:    MsgMarketResumeSettlementsRequest{Admin: "notanadminaddr", MarketId: 1},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketResumeSettlementsRequest{},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgMarketManagePermissionsRequest_ValidateBasic(t *testing.T) {
 	goodAdminAddr := sdk.AccAddress("goodAdminAddr_______").String()
 	goodAddr1 := sdk.AccAddress("goodAddr1___________").String()
@@ -3160,6 +3728,71 @@ func TestMsgChangePaymentTargetRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgIssuerCreateMarketRequest_ValidateBasic(t *testing.T) {
+	issuer := sdk.AccAddress("issuer______________").String()
+	validMarket := Market{
+		MarketDetails:    MarketDetails{Name: "Issuer Market"},
+		FeeCreateAskFlat: []sdk.Coin{sdk.NewInt64Coin("nhash", 10)},
+		AcceptingOrders:  true,
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgIssuerCreateMarketRequest
+		expErr []string
+	}{
+		{
+			name:   "zero value",
+			msg:    MsgIssuerCreateMarketRequest{},
+			expErr: []string{"invalid issuer", emptyAddrErr, "invalid marker denom"},
+		},
+		{
+			name: "control",
+			msg: MsgIssuerCreateMarketRequest{
+				Issuer:      issuer,
+				MarkerDenom: "mycoin",
+				Market:      validMarket,
+			},
+			expErr: nil,
+		},
+		{
+			name: "bad issuer",
+			msg: MsgIssuerCreateMarketRequest{
+				Issuer:      "bad",
+				MarkerDenom: "mycoin",
+				Market:      validMarket,
+			},
+			expErr: []string{"invalid issuer", bech32Err},
+		},
+		{
+			name: "bad marker denom",
+			msg: MsgIssuerCreateMarketRequest{
+				Issuer:      issuer,
+				MarkerDenom: "x",
+				Market:      validMarket,
+			},
+			expErr: []string{"invalid marker denom: invalid denom: x"},
+		},
+		{
+			name: "invalid market",
+			msg: MsgIssuerCreateMarketRequest{
+				Issuer:      issuer,
+				MarkerDenom: "mycoin",
+				Market: Market{
+					FeeCreateAskFlat: []sdk.Coin{{Denom: "badbad", Amount: sdkmath.NewInt(0)}},
+				},
+			},
+			expErr: []string{`invalid create-ask flat fee option "0badbad": amount cannot be zero`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgGovCreateMarketRequest_ValidateBasic(t *testing.T) {
 	authority := sdk.AccAddress("authority___________").String()
 
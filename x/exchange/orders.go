@@ -34,6 +34,9 @@ type SubOrderI interface {
 	GetPrice() sdk.Coin
 	GetSettlementFees() sdk.Coins
 	PartialFillAllowed() bool
+	IsFillOrKill() bool
+	GetMinFillAssets() sdkmath.Int
+	IsAllOrNothing() bool
 	GetExternalID() string
 	GetOrderType() string
 	GetOrderTypeByte() byte
@@ -107,6 +110,29 @@ func ValidateExternalID(externalID string) error {
 	return nil
 }
 
+// validateFillControls returns an error if the fill-or-kill, min fill assets, or all-or-nothing settings
+// of the provided sub-order are invalid or don't make sense together.
+func validateFillControls(order SubOrderI) error {
+	var errs []error
+
+	minFill := order.GetMinFillAssets()
+	switch {
+	case minFill.IsNegative():
+		errs = append(errs, fmt.Errorf("invalid min fill assets %q: cannot be negative", minFill))
+	case minFill.IsPositive() && !order.PartialFillAllowed():
+		errs = append(errs, fmt.Errorf("invalid min fill assets %q: partial fulfillment is not allowed", minFill))
+	case !order.GetAssets().Amount.IsNil() && minFill.GT(order.GetAssets().Amount):
+		errs = append(errs, fmt.Errorf("invalid min fill assets %q: cannot be more than the assets amount %q",
+			minFill, order.GetAssets().Amount))
+	}
+
+	if order.IsAllOrNothing() && order.PartialFillAllowed() {
+		errs = append(errs, errors.New("an all-or-nothing order cannot allow partial fulfillment"))
+	}
+
+	return errors.Join(errs...)
+}
+
 // NewOrder creates a new empty Order with the provided order id.
 // The order details are set using one of: WithAsk, WithBid.
 func NewOrder(orderID uint64) *Order {
@@ -199,6 +225,21 @@ func (o Order) PartialFillAllowed() bool {
 	return o.MustGetSubOrder().PartialFillAllowed()
 }
 
+// IsFillOrKill returns true if any unfilled part of this order should be cancelled after it's partially filled.
+func (o Order) IsFillOrKill() bool {
+	return o.MustGetSubOrder().IsFillOrKill()
+}
+
+// GetMinFillAssets returns the smallest amount of assets that this order can be partially filled with.
+func (o Order) GetMinFillAssets() sdkmath.Int {
+	return o.MustGetSubOrder().GetMinFillAssets()
+}
+
+// IsAllOrNothing returns true if this order can only be settled with other orders that are all filled in full.
+func (o Order) IsAllOrNothing() bool {
+	return o.MustGetSubOrder().IsAllOrNothing()
+}
+
 // GetUUID returns this order's UUID.
 func (o Order) GetExternalID() string {
 	return o.MustGetSubOrder().GetExternalID()
@@ -260,6 +301,16 @@ func (o Order) Split(assetsFilledAmt sdkmath.Int) (filled *Order, unfilled *Orde
 			o.GetOrderType(), o.OrderId, orderAssets, assetsFilled)
 	}
 
+	minFillAmt := o.GetMinFillAssets()
+	if assetsFilledAmt.LT(minFillAmt) {
+		return nil, nil, fmt.Errorf("cannot split %s order %d having assets %q at %q: less than min fill assets %q",
+			o.GetOrderType(), o.OrderId, orderAssets, assetsFilled, sdk.Coin{Denom: orderAssets.Denom, Amount: minFillAmt})
+	}
+	if !o.IsFillOrKill() && orderAssetsAmt.Sub(assetsFilledAmt).LT(minFillAmt) {
+		return nil, nil, fmt.Errorf("cannot split %s order %d having assets %q at %q: assets left would be less than min fill assets %q",
+			o.GetOrderType(), o.OrderId, orderAssets, assetsFilled, sdk.Coin{Denom: orderAssets.Denom, Amount: minFillAmt})
+	}
+
 	orderPrice := o.GetPrice()
 	priceFilledAmt, priceRem := QuoRemInt(orderPrice.Amount.Mul(assetsFilledAmt), orderAssetsAmt)
 	if !priceRem.IsZero() {
@@ -349,6 +400,24 @@ func (a AskOrder) PartialFillAllowed() bool {
 	return a.AllowPartial
 }
 
+// IsFillOrKill returns true if any unfilled part of this ask order should be cancelled after it's partially filled.
+func (a AskOrder) IsFillOrKill() bool {
+	return a.FillOrKill
+}
+
+// GetMinFillAssets returns the smallest amount of assets that this ask order can be partially filled with.
+func (a AskOrder) GetMinFillAssets() sdkmath.Int {
+	if a.MinFillAssets == nil || a.MinFillAssets.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return *a.MinFillAssets
+}
+
+// IsAllOrNothing returns true if this ask order can only be settled with other orders that are all filled in full.
+func (a AskOrder) IsAllOrNothing() bool {
+	return a.AllOrNothing
+}
+
 // GetExternalID returns this ask order's external id.
 func (a AskOrder) GetExternalID() string {
 	return a.ExternalId
@@ -417,6 +486,10 @@ func (a AskOrder) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateFillControls(a); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -430,6 +503,9 @@ func (a AskOrder) CopyChange(newAssets, newPrice sdk.Coin, newFee *sdk.Coin) *As
 		SellerSettlementFlatFee: newFee,
 		AllowPartial:            a.AllowPartial,
 		ExternalId:              a.ExternalId,
+		FillOrKill:              a.FillOrKill,
+		MinFillAssets:           a.MinFillAssets,
+		AllOrNothing:            a.AllOrNothing,
 	}
 }
 
@@ -463,6 +539,24 @@ func (b BidOrder) PartialFillAllowed() bool {
 	return b.AllowPartial
 }
 
+// IsFillOrKill returns true if any unfilled part of this bid order should be cancelled after it's partially filled.
+func (b BidOrder) IsFillOrKill() bool {
+	return b.FillOrKill
+}
+
+// GetMinFillAssets returns the smallest amount of assets that this bid order can be partially filled with.
+func (b BidOrder) GetMinFillAssets() sdkmath.Int {
+	if b.MinFillAssets == nil || b.MinFillAssets.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return *b.MinFillAssets
+}
+
+// IsAllOrNothing returns true if this bid order can only be settled with other orders that are all filled in full.
+func (b BidOrder) IsAllOrNothing() bool {
+	return b.AllOrNothing
+}
+
 // GetExternalID returns this bid order's external id.
 func (b BidOrder) GetExternalID() string {
 	return b.ExternalId
@@ -523,6 +617,10 @@ func (b BidOrder) Validate() error {
 		errs = append(errs, err)
 	}
 
+	if err := validateFillControls(b); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -536,6 +634,9 @@ func (b BidOrder) CopyChange(newAssets, newPrice sdk.Coin, newFees sdk.Coins) *B
 		BuyerSettlementFees: newFees,
 		AllowPartial:        b.AllowPartial,
 		ExternalId:          b.ExternalId,
+		FillOrKill:          b.FillOrKill,
+		MinFillAssets:       b.MinFillAssets,
+		AllOrNothing:        b.AllOrNothing,
 	}
 }
 
@@ -618,6 +719,21 @@ func (o FilledOrder) PartialFillAllowed() bool {
 	return o.order.PartialFillAllowed()
 }
 
+// IsFillOrKill returns true if any unfilled part of this order should be cancelled after it's partially filled.
+func (o FilledOrder) IsFillOrKill() bool {
+	return o.order.IsFillOrKill()
+}
+
+// GetMinFillAssets returns the smallest amount of assets that this order can be partially filled with.
+func (o FilledOrder) GetMinFillAssets() sdkmath.Int {
+	return o.order.GetMinFillAssets()
+}
+
+// IsAllOrNothing returns true if this order can only be settled with other orders that are all filled in full.
+func (o FilledOrder) IsAllOrNothing() bool {
+	return o.order.IsAllOrNothing()
+}
+
 // GetExternalID returns this order's external id.
 func (o FilledOrder) GetExternalID() string {
 	return o.order.GetExternalID()
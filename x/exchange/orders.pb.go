@@ -4,6 +4,7 @@
 package exchange
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -141,6 +142,16 @@ type AskOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// fill_or_kill should be true if any part of this order that is not filled by the first settlement that it is part
+	// of should be cancelled instead of being left on the books.
+	FillOrKill bool `protobuf:"varint,8,opt,name=fill_or_kill,json=fillOrKill,proto3" json:"fill_or_kill,omitempty"`
+	// min_fill_assets is the smallest amount of assets that this order can be partially filled with. Unless this order is
+	// fill-or-kill, it is also the smallest amount of assets that can be left in this order after a partial fill.
+	// It can only be positive if partial fulfillment of this order is allowed.
+	MinFillAssets *cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=min_fill_assets,json=minFillAssets,proto3,customtype=cosmossdk.io/math.Int" json:"min_fill_assets,omitempty"`
+	// all_or_nothing should be true if this order should only be settled along with other orders that are all being
+	// filled in full. An all-or-nothing order cannot also allow partial fulfillment.
+	AllOrNothing bool `protobuf:"varint,10,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
 }
 
 func (m *AskOrder) Reset()         { *m = AskOrder{} }
@@ -196,6 +207,16 @@ type BidOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// fill_or_kill should be true if any part of this order that is not filled by the first settlement that it is part
+	// of should be cancelled instead of being left on the books.
+	FillOrKill bool `protobuf:"varint,8,opt,name=fill_or_kill,json=fillOrKill,proto3" json:"fill_or_kill,omitempty"`
+	// min_fill_assets is the smallest amount of assets that this order can be partially filled with. Unless this order is
+	// fill-or-kill, it is also the smallest amount of assets that can be left in this order after a partial fill.
+	// It can only be positive if partial fulfillment of this order is allowed.
+	MinFillAssets *cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=min_fill_assets,json=minFillAssets,proto3,customtype=cosmossdk.io/math.Int" json:"min_fill_assets,omitempty"`
+	// all_or_nothing should be true if this order should only be settled along with other orders that are all being
+	// filled in full. An all-or-nothing order cannot also allow partial fulfillment.
+	AllOrNothing bool `protobuf:"varint,10,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
 }
 
 func (m *BidOrder) Reset()         { *m = BidOrder{} }
@@ -242,45 +263,51 @@ func init() {
 }
 
 var fileDescriptor_dab7cbe63f582471 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb6, 0xdb, 0x38, 0x75, 0xaf, 0xed, 0xef, 0x27, 0x4c, 0xa1, 0x4e, 0x91, 0x9c, 0xa8, 0x5d,
-	0xa2, 0x4a, 0xb5, 0x29, 0x08, 0x21, 0x75, 0x41, 0x0d, 0x52, 0x45, 0x27, 0x2a, 0x57, 0x62, 0x60,
-	0xb1, 0xce, 0xf6, 0xab, 0x7b, 0x8a, 0xed, 0x8b, 0x7c, 0xd7, 0xd2, 0xae, 0x4c, 0x8c, 0x2c, 0x2c,
-	0x4c, 0x8c, 0x88, 0x29, 0x12, 0xf0, 0x3f, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0xfe, 0x05,
-	0x46, 0xe4, 0xbb, 0x73, 0x1a, 0x24, 0x08, 0x1d, 0x10, 0x8b, 0x7d, 0xef, 0xbd, 0xef, 0x7d, 0xef,
-	0xdd, 0xf7, 0xf4, 0x0e, 0xad, 0xf7, 0x0a, 0x7a, 0x02, 0x39, 0xce, 0x23, 0xf0, 0xe0, 0x34, 0x3a,
-	0xc2, 0x79, 0x02, 0xde, 0xc9, 0x96, 0x47, 0x8b, 0x18, 0x0a, 0xe6, 0xf6, 0x0a, 0xca, 0xa9, 0x75,
-	0xf3, 0x12, 0xe4, 0x56, 0x20, 0xf7, 0x64, 0x6b, 0xf5, 0x1a, 0xce, 0x48, 0x4e, 0x3d, 0xf1, 0x95,
-	0xd0, 0x55, 0x27, 0xa2, 0x2c, 0xa3, 0xcc, 0x0b, 0x31, 0x2b, 0x79, 0x42, 0xe0, 0x78, 0xcb, 0x8b,
-	0x28, 0xc9, 0x55, 0x7c, 0x45, 0xc5, 0x33, 0x96, 0x94, 0x65, 0x32, 0x96, 0xa8, 0x40, 0x43, 0x06,
-	0x02, 0x61, 0x79, 0xd2, 0x50, 0xa1, 0xe5, 0x84, 0x26, 0x54, 0xfa, 0xcb, 0x93, 0xf4, 0xae, 0xbd,
-	0xd7, 0x91, 0xf1, 0xb8, 0xec, 0xd2, 0x6a, 0x20, 0x53, 0xb4, 0x1b, 0x90, 0xd8, 0xd6, 0x5b, 0x7a,
-	0xbb, 0xe6, 0xcf, 0x09, 0x7b, 0x2f, 0xb6, 0x1e, 0xa0, 0x79, 0xcc, 0xba, 0x81, 0x30, 0xed, 0x99,
-	0x96, 0xde, 0x5e, 0xb8, 0xd3, 0x72, 0x7f, 0x7d, 0x1b, 0x77, 0x87, 0x75, 0x05, 0xdf, 0x23, 0xcd,
-	0x37, 0xb1, 0x3a, 0x97, 0x04, 0x21, 0x89, 0x15, 0xc1, 0xec, 0x74, 0x82, 0x0e, 0x89, 0xc7, 0x04,
-	0xa1, 0x3a, 0x6f, 0xd7, 0x5e, 0xbc, 0x69, 0x6a, 0x9d, 0x39, 0x64, 0x08, 0x8a, 0xb5, 0xef, 0x33,
-	0xc8, 0xac, 0x0a, 0x59, 0xb7, 0xd0, 0x7c, 0x86, 0x8b, 0x2e, 0xf0, 0xaa, 0xf3, 0x25, 0xdf, 0x94,
-	0x8e, 0xbd, 0xd8, 0xba, 0x8d, 0xea, 0x0c, 0xd2, 0x54, 0xf5, 0x3d, 0xdf, 0xb1, 0x3f, 0x7d, 0xd8,
-	0x5c, 0x56, 0xba, 0xec, 0xc4, 0x71, 0x01, 0x8c, 0x1d, 0xf0, 0x82, 0xe4, 0x89, 0xaf, 0x70, 0xd6,
-	0x7d, 0x54, 0xc7, 0x8c, 0x01, 0x67, 0xaa, 0xd1, 0x86, 0xab, 0xe0, 0xe5, 0x30, 0x5c, 0x35, 0x0c,
-	0xf7, 0x21, 0x25, 0x79, 0xa7, 0x76, 0xfe, 0xa5, 0xa9, 0xf9, 0x0a, 0x6e, 0xdd, 0x43, 0x46, 0xaf,
-	0x20, 0x11, 0xd8, 0xb5, 0xab, 0xe5, 0x49, 0xb4, 0xf5, 0x04, 0xad, 0xca, 0xca, 0x01, 0x03, 0xce,
-	0x53, 0xc8, 0x20, 0xe7, 0xc1, 0x61, 0x8a, 0x79, 0x70, 0x08, 0x60, 0x1b, 0x7f, 0xe0, 0xf2, 0x57,
-	0x64, 0xf2, 0xc1, 0x38, 0x77, 0x37, 0xc5, 0x7c, 0x17, 0xc0, 0x5a, 0x47, 0x4b, 0x38, 0x4d, 0xe9,
-	0xb3, 0xa0, 0x87, 0x0b, 0x4e, 0x70, 0x6a, 0xd7, 0x5b, 0x7a, 0xdb, 0xf4, 0x17, 0x85, 0x73, 0x5f,
-	0xfa, 0xac, 0x26, 0x5a, 0x80, 0x53, 0x0e, 0x45, 0x8e, 0xd3, 0x52, 0xbd, 0xb9, 0x52, 0x23, 0x1f,
-	0x55, 0xae, 0xbd, 0x78, 0xfb, 0xff, 0x52, 0xf8, 0xe7, 0xa3, 0xfe, 0x86, 0x92, 0x67, 0xed, 0xe3,
-	0x2c, 0x32, 0xab, 0x11, 0x4d, 0x97, 0xde, 0x45, 0x46, 0x78, 0x7c, 0x76, 0x05, 0xe5, 0x25, 0xec,
-	0x9f, 0x0b, 0xff, 0x4a, 0x47, 0x37, 0x44, 0xe5, 0x9f, 0x84, 0x07, 0x60, 0xb6, 0xd1, 0x9a, 0x9d,
-	0xce, 0xb3, 0x5b, 0xf2, 0xbc, 0xfb, 0xda, 0x6c, 0x27, 0x84, 0x1f, 0x1d, 0x87, 0x6e, 0x44, 0x33,
-	0xb5, 0x6c, 0xea, 0xb7, 0xc9, 0xe2, 0xae, 0xc7, 0xcf, 0x7a, 0xc0, 0x44, 0x02, 0x7b, 0x3d, 0xea,
-	0x6f, 0x2c, 0xa6, 0x90, 0xe0, 0xe8, 0x2c, 0x28, 0xf7, 0x98, 0xbd, 0x1d, 0xf5, 0x37, 0x74, 0xff,
-	0xba, 0xa8, 0x3f, 0x31, 0x3b, 0x00, 0xf6, 0x97, 0x06, 0xf7, 0x5f, 0x35, 0x38, 0xa9, 0x6e, 0x07,
-	0xce, 0x07, 0x8e, 0x7e, 0x31, 0x70, 0xf4, 0x6f, 0x03, 0x47, 0x7f, 0x39, 0x74, 0xb4, 0x8b, 0xa1,
-	0xa3, 0x7d, 0x1e, 0x3a, 0x1a, 0x6a, 0x10, 0xfa, 0x9b, 0x5d, 0xdc, 0xd7, 0x9f, 0xba, 0x13, 0x37,
-	0xbc, 0x04, 0x6d, 0x12, 0x3a, 0x61, 0x79, 0xa7, 0xe3, 0x47, 0x2f, 0xac, 0x8b, 0x67, 0xe5, 0xee,
-	0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x15, 0x76, 0x98, 0x12, 0x05, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xda, 0x24, 0xdd, 0x4c, 0x7f, 0xe1, 0xda, 0xda, 0x4d, 0x85, 0x4d, 0x68, 0x05, 0x43,
+	0x21, 0xbb, 0x56, 0x11, 0xa1, 0x17, 0x69, 0x84, 0x60, 0x10, 0x4c, 0xd9, 0x82, 0x07, 0x2f, 0xcb,
+	0xec, 0xee, 0x74, 0x33, 0x64, 0x76, 0x26, 0xec, 0x4c, 0x6b, 0x7b, 0xf5, 0xe4, 0x51, 0x0f, 0x5e,
+	0x3c, 0x79, 0x14, 0x4f, 0x05, 0xfb, 0x47, 0xf4, 0x24, 0xa5, 0x27, 0xf1, 0x50, 0xa5, 0x3d, 0xf4,
+	0xdf, 0x90, 0x9d, 0x99, 0xa4, 0x15, 0xb4, 0xf6, 0xe4, 0xc1, 0x4b, 0x32, 0xef, 0x7b, 0xdf, 0x7c,
+	0xef, 0xcd, 0x9b, 0x8f, 0x59, 0xb0, 0x34, 0xc8, 0xd8, 0x36, 0xa2, 0x90, 0x46, 0xc8, 0x43, 0x3b,
+	0x51, 0x0f, 0xd2, 0x04, 0x79, 0xdb, 0x2b, 0x1e, 0xcb, 0x62, 0x94, 0x71, 0x77, 0x90, 0x31, 0xc1,
+	0xac, 0x9b, 0xe7, 0x24, 0x77, 0x48, 0x72, 0xb7, 0x57, 0x16, 0xae, 0xc3, 0x14, 0x53, 0xe6, 0xc9,
+	0x5f, 0x45, 0x5d, 0x70, 0x22, 0xc6, 0x53, 0xc6, 0xbd, 0x10, 0xf2, 0x5c, 0x27, 0x44, 0x02, 0xae,
+	0x78, 0x11, 0xc3, 0x54, 0xe7, 0xe7, 0x75, 0x3e, 0xe5, 0x49, 0x5e, 0x26, 0xe5, 0x89, 0x4e, 0x54,
+	0x55, 0x22, 0x90, 0x91, 0xa7, 0x02, 0x9d, 0x9a, 0x4d, 0x58, 0xc2, 0x14, 0x9e, 0xaf, 0x14, 0xba,
+	0xf8, 0xd9, 0x00, 0xa5, 0x6e, 0xde, 0xa5, 0x55, 0x05, 0xa6, 0x6c, 0x37, 0xc0, 0xb1, 0x6d, 0xd4,
+	0x8d, 0x46, 0xd1, 0x1f, 0x97, 0x71, 0x27, 0xb6, 0x1e, 0x81, 0x0a, 0xe4, 0xfd, 0x40, 0x86, 0xf6,
+	0xb5, 0xba, 0xd1, 0x98, 0xb8, 0x57, 0x77, 0x7f, 0x7f, 0x1a, 0x77, 0x8d, 0xf7, 0xa5, 0xde, 0x93,
+	0x82, 0x6f, 0x42, 0xbd, 0xce, 0x05, 0x42, 0x1c, 0x6b, 0x81, 0xb1, 0xcb, 0x05, 0x5a, 0x38, 0x1e,
+	0x09, 0x84, 0x7a, 0xbd, 0x5a, 0x7c, 0xfd, 0xa1, 0x56, 0x68, 0x8d, 0x83, 0x92, 0x94, 0x58, 0x7c,
+	0x5b, 0x04, 0xe6, 0xb0, 0x90, 0x75, 0x0b, 0x54, 0x52, 0x98, 0xf5, 0x91, 0x18, 0x76, 0x3e, 0xe5,
+	0x9b, 0x0a, 0xe8, 0xc4, 0xd6, 0x5d, 0x50, 0xe6, 0x88, 0x10, 0xdd, 0x77, 0xa5, 0x65, 0x1f, 0xed,
+	0x37, 0x67, 0xf5, 0x5c, 0xd6, 0xe2, 0x38, 0x43, 0x9c, 0x6f, 0x88, 0x0c, 0xd3, 0xc4, 0xd7, 0x3c,
+	0xeb, 0x21, 0x28, 0x43, 0xce, 0x91, 0xe0, 0xba, 0xd1, 0xaa, 0xab, 0xe9, 0xf9, 0x65, 0xb8, 0xfa,
+	0x32, 0xdc, 0xc7, 0x0c, 0xd3, 0x56, 0xf1, 0xe0, 0xb8, 0x56, 0xf0, 0x35, 0xdd, 0x7a, 0x00, 0x4a,
+	0x83, 0x0c, 0x47, 0xc8, 0x2e, 0x5e, 0x6d, 0x9f, 0x62, 0x5b, 0xcf, 0xc1, 0x82, 0xaa, 0x1c, 0x70,
+	0x24, 0x04, 0x41, 0x29, 0xa2, 0x22, 0xd8, 0x24, 0x50, 0x04, 0x9b, 0x08, 0xd9, 0xa5, 0xbf, 0x68,
+	0xf9, 0xf3, 0x6a, 0xf3, 0xc6, 0x68, 0x6f, 0x9b, 0x40, 0xd1, 0x46, 0xc8, 0x5a, 0x02, 0x53, 0x90,
+	0x10, 0xf6, 0x32, 0x18, 0xc0, 0x4c, 0x60, 0x48, 0xec, 0x72, 0xdd, 0x68, 0x98, 0xfe, 0xa4, 0x04,
+	0xd7, 0x15, 0x66, 0xd5, 0xc0, 0x04, 0xda, 0x11, 0x28, 0xa3, 0x90, 0xe4, 0xd3, 0x1b, 0xcf, 0x67,
+	0xe4, 0x83, 0x21, 0xd4, 0x89, 0xad, 0x3a, 0x98, 0xdc, 0xc4, 0x84, 0x04, 0x2c, 0x0b, 0xfa, 0x98,
+	0x10, 0xdb, 0x94, 0x22, 0x20, 0xc7, 0xba, 0xd9, 0x53, 0x4c, 0x88, 0xd5, 0x05, 0x33, 0x29, 0xa6,
+	0x81, 0x64, 0xe9, 0xc1, 0x55, 0xe4, 0xa8, 0xef, 0x7c, 0x3b, 0xae, 0xcd, 0xa9, 0xbe, 0x79, 0xdc,
+	0x77, 0x31, 0xf3, 0x52, 0x28, 0x7a, 0x6e, 0x87, 0x8a, 0xa3, 0xfd, 0x26, 0xd0, 0x07, 0xea, 0x50,
+	0xe1, 0x4f, 0xa5, 0x98, 0xb6, 0x31, 0x21, 0x6b, 0x6a, 0x8e, 0xb7, 0xc1, 0x34, 0x54, 0x15, 0x29,
+	0x13, 0x3d, 0x4c, 0x13, 0x1b, 0x8c, 0x3a, 0xef, 0x66, 0xcf, 0x14, 0xb6, 0x3a, 0x93, 0x3b, 0xe2,
+	0xd5, 0xd9, 0xde, 0xb2, 0xbe, 0xb7, 0xc5, 0x2f, 0x45, 0x60, 0x0e, 0xbd, 0x73, 0xb9, 0x27, 0x5c,
+	0x50, 0x0a, 0xb7, 0x76, 0xaf, 0x60, 0x09, 0x45, 0xfb, 0xe7, 0x8e, 0x78, 0x67, 0x80, 0x39, 0x59,
+	0xf9, 0x17, 0x47, 0x20, 0xc4, 0xed, 0x52, 0x7d, 0xec, 0x72, 0x9d, 0x76, 0xae, 0xf3, 0xe9, 0x7b,
+	0xad, 0x91, 0x60, 0xd1, 0xdb, 0x0a, 0xdd, 0x88, 0xa5, 0xfa, 0x15, 0xd0, 0x7f, 0x4d, 0x1e, 0xf7,
+	0x3d, 0xb1, 0x3b, 0x40, 0x5c, 0x6e, 0xe0, 0xef, 0xcf, 0xf6, 0x96, 0x27, 0x09, 0x4a, 0x60, 0xb4,
+	0x1b, 0xe4, 0x0f, 0x0c, 0xff, 0x78, 0xb6, 0xb7, 0x6c, 0xf8, 0x37, 0x64, 0xfd, 0x0b, 0xa6, 0x42,
+	0x88, 0xff, 0xef, 0x8e, 0x9a, 0x1e, 0x3a, 0x4a, 0x5d, 0x7b, 0x0b, 0x1d, 0x9c, 0x38, 0xc6, 0xe1,
+	0x89, 0x63, 0xfc, 0x38, 0x71, 0x8c, 0x37, 0xa7, 0x4e, 0xe1, 0xf0, 0xd4, 0x29, 0x7c, 0x3d, 0x75,
+	0x0a, 0xa0, 0x8a, 0xd9, 0x1f, 0x5e, 0xaf, 0x75, 0xe3, 0x85, 0x7b, 0x61, 0xf4, 0xe7, 0xa4, 0x26,
+	0x66, 0x17, 0x22, 0x6f, 0x67, 0xf4, 0x99, 0x08, 0xcb, 0xf2, 0x21, 0xbe, 0xff, 0x73, 0x00, 0x03,
+	0xfb, 0xb2, 0x20, 0x44, 0x06, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -382,6 +409,38 @@ func (m *AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllOrNothing {
+		i--
+		if m.AllOrNothing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinFillAssets != nil {
+		{
+			size := m.MinFillAssets.Size()
+			i -= size
+			if _, err := m.MinFillAssets.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FillOrKill {
+		i--
+		if m.FillOrKill {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -466,6 +525,38 @@ func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllOrNothing {
+		i--
+		if m.AllOrNothing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinFillAssets != nil {
+		{
+			size := m.MinFillAssets.Size()
+			i -= size
+			if _, err := m.MinFillAssets.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FillOrKill {
+		i--
+		if m.FillOrKill {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -610,6 +701,16 @@ func (m *AskOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.FillOrKill {
+		n += 2
+	}
+	if m.MinFillAssets != nil {
+		l = m.MinFillAssets.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.AllOrNothing {
+		n += 2
+	}
 	return n
 }
 
@@ -643,6 +744,16 @@ func (m *BidOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.FillOrKill {
+		n += 2
+	}
+	if m.MinFillAssets != nil {
+		l = m.MinFillAssets.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	if m.AllOrNothing {
+		n += 2
+	}
 	return n
 }
 
@@ -1025,6 +1136,82 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillOrKill", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FillOrKill = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinFillAssets = &v
+			if err := m.MinFillAssets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNothing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNothing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -1278,6 +1465,82 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillOrKill", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FillOrKill = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MinFillAssets = &v
+			if err := m.MinFillAssets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOrNothing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOrNothing = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -110,6 +110,9 @@ func askOrderString(askOrder *AskOrder) string {
 		fmt.Sprintf("SellerSettlementFlatFee:%s", coinPString(askOrder.SellerSettlementFlatFee)),
 		fmt.Sprintf("AllowPartial:%t", askOrder.AllowPartial),
 		fmt.Sprintf("ExternalID:%s", askOrder.ExternalId),
+		fmt.Sprintf("FillOrKill:%t", askOrder.FillOrKill),
+		fmt.Sprintf("MinFillAssets:%s", askOrder.GetMinFillAssets()),
+		fmt.Sprintf("AllOrNothing:%t", askOrder.AllOrNothing),
 	}
 	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
 }
@@ -128,6 +131,9 @@ func bidOrderString(bidOrder *BidOrder) string {
 		fmt.Sprintf("BuyerSettlementFees:%s", coinsString(bidOrder.BuyerSettlementFees)),
 		fmt.Sprintf("AllowPartial:%t", bidOrder.AllowPartial),
 		fmt.Sprintf("ExternalID:%s", bidOrder.ExternalId),
+		fmt.Sprintf("FillOrKill:%t", bidOrder.FillOrKill),
+		fmt.Sprintf("MinFillAssets:%s", bidOrder.GetMinFillAssets()),
+		fmt.Sprintf("AllOrNothing:%t", bidOrder.AllOrNothing),
 	}
 	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
 }
@@ -985,6 +991,143 @@ func TestOrder_PartialFillAllowed(t *testing.T) {
 	}
 }
 
+func TestOrder_IsFillOrKill(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    *Order
+		expected bool
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{FillOrKill: true}),
+			expected: true,
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(2).WithBid(&BidOrder{FillOrKill: true}),
+			expected: true,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(3),
+			expPanic: nilSubTypeErr(3),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(4),
+			expPanic: unknownSubTypeErr(4),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.order.IsFillOrKill()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "IsFillOrKill()")
+			assert.Equal(t, tc.expected, actual, "IsFillOrKill() result")
+		})
+	}
+}
+
+func TestOrder_GetMinFillAssets(t *testing.T) {
+	minFill := sdkmath.NewInt(12)
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected sdkmath.Int
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{MinFillAssets: &minFill}),
+			expected: minFill,
+		},
+		{
+			name:     "AskOrder: not set",
+			order:    NewOrder(2).WithAsk(&AskOrder{}),
+			expected: sdkmath.ZeroInt(),
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(3).WithBid(&BidOrder{MinFillAssets: &minFill}),
+			expected: minFill,
+		},
+		{
+			name:     "BidOrder: not set",
+			order:    NewOrder(4).WithBid(&BidOrder{}),
+			expected: sdkmath.ZeroInt(),
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(5),
+			expPanic: nilSubTypeErr(5),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(6),
+			expPanic: unknownSubTypeErr(6),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual sdkmath.Int
+			testFunc := func() {
+				actual = tc.order.GetMinFillAssets()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "GetMinFillAssets()")
+			if len(tc.expPanic) == 0 {
+				assert.Equal(t, tc.expected.String(), actual.String(), "GetMinFillAssets() result")
+			}
+		})
+	}
+}
+
+func TestOrder_IsAllOrNothing(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    *Order
+		expected bool
+		expPanic string
+	}{
+		{
+			name:     "AskOrder",
+			order:    NewOrder(1).WithAsk(&AskOrder{AllOrNothing: true}),
+			expected: true,
+		},
+		{
+			name:     "BidOrder",
+			order:    NewOrder(2).WithBid(&BidOrder{AllOrNothing: true}),
+			expected: true,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(3),
+			expPanic: nilSubTypeErr(3),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(4),
+			expPanic: unknownSubTypeErr(4),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.order.IsAllOrNothing()
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "IsAllOrNothing()")
+			assert.Equal(t, tc.expected, actual, "IsAllOrNothing() result")
+		})
+	}
+}
+
 func TestOrder_GetExternalID(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1235,6 +1378,18 @@ func TestOrder_Split(t *testing.T) {
 		}
 		return NewOrder(orderID).WithBid(bidOrder)
 	}
+	withFillControls := func(order *Order, fillOrKill bool, minFill int64) *Order {
+		minFillAmt := sdkmath.NewInt(minFill)
+		switch so := order.Order.(type) {
+		case *Order_AskOrder:
+			so.AskOrder.FillOrKill = fillOrKill
+			so.AskOrder.MinFillAssets = &minFillAmt
+		case *Order_BidOrder:
+			so.BidOrder.FillOrKill = fillOrKill
+			so.BidOrder.MinFillAssets = &minFillAmt
+		}
+		return order
+	}
 
 	tests := []struct {
 		name            string
@@ -1373,6 +1528,58 @@ func TestOrder_Split(t *testing.T) {
 			expFilled:       bidOrder(24, 8, 400, coin(4, "fig"), coin(12, "grape")),
 			expUnfilled:     bidOrder(24, 2, 100, coin(1, "fig"), coin(3, "grape")),
 		},
+		{
+			name:            "less than min fill: ask",
+			order:           withFillControls(askOrder(25, 10, 100), false, 3),
+			assetsFilledAmt: sdkmath.NewInt(2),
+			expErr:          "cannot split ask order 25 having assets \"10apple\" at \"2apple\": less than min fill assets \"3apple\"",
+		},
+		{
+			name:            "less than min fill: bid",
+			order:           withFillControls(bidOrder(26, 10, 100), false, 3),
+			assetsFilledAmt: sdkmath.NewInt(2),
+			expErr:          "cannot split bid order 26 having assets \"10apple\" at \"2apple\": less than min fill assets \"3apple\"",
+		},
+		{
+			name:            "less than min fill left: ask",
+			order:           withFillControls(askOrder(27, 10, 100), false, 3),
+			assetsFilledAmt: sdkmath.NewInt(8),
+			expErr:          "cannot split ask order 27 having assets \"10apple\" at \"8apple\": assets left would be less than min fill assets \"3apple\"",
+		},
+		{
+			name:            "less than min fill left: bid",
+			order:           withFillControls(bidOrder(28, 10, 100), false, 3),
+			assetsFilledAmt: sdkmath.NewInt(8),
+			expErr:          "cannot split bid order 28 having assets \"10apple\" at \"8apple\": assets left would be less than min fill assets \"3apple\"",
+		},
+		{
+			name:            "min fill on both sides: ask",
+			order:           withFillControls(askOrder(29, 10, 100), false, 3),
+			assetsFilledAmt: sdkmath.NewInt(7),
+			expFilled:       withFillControls(askOrder(29, 7, 70), false, 3),
+			expUnfilled:     withFillControls(askOrder(29, 3, 30), false, 3),
+		},
+		{
+			name:            "min fill on both sides: bid",
+			order:           withFillControls(bidOrder(30, 10, 100), false, 3),
+			assetsFilledAmt: sdkmath.NewInt(3),
+			expFilled:       withFillControls(bidOrder(30, 3, 30), false, 3),
+			expUnfilled:     withFillControls(bidOrder(30, 7, 70), false, 3),
+		},
+		{
+			name:            "fill-or-kill with less than min fill left: ask",
+			order:           withFillControls(askOrder(31, 10, 100), true, 3),
+			assetsFilledAmt: sdkmath.NewInt(8),
+			expFilled:       withFillControls(askOrder(31, 8, 80), true, 3),
+			expUnfilled:     withFillControls(askOrder(31, 2, 20), true, 3),
+		},
+		{
+			name:            "fill-or-kill with less than min fill left: bid",
+			order:           withFillControls(bidOrder(32, 10, 100), true, 3),
+			assetsFilledAmt: sdkmath.NewInt(8),
+			expFilled:       withFillControls(bidOrder(32, 8, 80), true, 3),
+			expUnfilled:     withFillControls(bidOrder(32, 2, 20), true, 3),
+		},
 	}
 
 	for _, tc := range tests {
@@ -1659,6 +1866,10 @@ func TestAskOrder_Validate(t *testing.T) {
 	coin := func(amount int64, denom string) *sdk.Coin {
 		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
+	intP := func(amount int64) *sdkmath.Int {
+		rv := sdkmath.NewInt(amount)
+		return &rv
+	}
 
 	tests := []struct {
 		name  string
@@ -1854,6 +2065,110 @@ func TestAskOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid seller settlement flat fee", "negative coin amount: -3"},
 		},
+		{
+			name: "fill-or-kill",
+			order: AskOrder{
+				MarketId:   1,
+				Seller:     sdk.AccAddress("control_address_____").String(),
+				Assets:     *coin(99, "bender"),
+				Price:      *coin(42, "farnsworth"),
+				FillOrKill: true,
+			},
+			exp: nil,
+		},
+		{
+			name: "fill-or-kill with partial",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				AllowPartial: true,
+				FillOrKill:   true,
+			},
+			exp: nil,
+		},
+		{
+			name: "min fill assets with partial",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				AllowPartial:  true,
+				MinFillAssets: intP(99),
+			},
+			exp: nil,
+		},
+		{
+			name: "zero min fill assets without partial",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				MinFillAssets: intP(0),
+			},
+			exp: nil,
+		},
+		{
+			name: "min fill assets without partial",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				MinFillAssets: intP(1),
+			},
+			exp: []string{"invalid min fill assets \"1\": partial fulfillment is not allowed"},
+		},
+		{
+			name: "negative min fill assets",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				AllowPartial:  true,
+				MinFillAssets: intP(-1),
+			},
+			exp: []string{"invalid min fill assets \"-1\": cannot be negative"},
+		},
+		{
+			name: "min fill assets more than assets",
+			order: AskOrder{
+				MarketId:      1,
+				Seller:        sdk.AccAddress("control_address_____").String(),
+				Assets:        *coin(99, "bender"),
+				Price:         *coin(42, "farnsworth"),
+				AllowPartial:  true,
+				MinFillAssets: intP(100),
+			},
+			exp: []string{"invalid min fill assets \"100\": cannot be more than the assets amount \"99\""},
+		},
+		{
+			name: "all-or-nothing",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				AllOrNothing: true,
+			},
+			exp: nil,
+		},
+		{
+			name: "all-or-nothing with partial",
+			order: AskOrder{
+				MarketId:     1,
+				Seller:       sdk.AccAddress("control_address_____").String(),
+				Assets:       *coin(99, "bender"),
+				Price:        *coin(42, "farnsworth"),
+				AllowPartial: true,
+				AllOrNothing: true,
+			},
+			exp: []string{"an all-or-nothing order cannot allow partial fulfillment"},
+		},
 		{
 			name: "multiple problems",
 			order: AskOrder{
@@ -1887,6 +2202,8 @@ func TestAskOrder_CopyChange(t *testing.T) {
 		return &rv
 	}
 
+	minFill := sdkmath.NewInt(2)
+
 	tests := []struct {
 		name      string
 		order     AskOrder
@@ -1983,6 +2300,51 @@ func TestAskOrder_CopyChange(t *testing.T) {
 				AllowPartial:            false,
 			},
 		},
+		{
+			name: "fill controls",
+			order: AskOrder{
+				MarketId:                3,
+				Seller:                  "sseelleerr",
+				Assets:                  coin(8, "apple"),
+				Price:                   coin(56, "peach"),
+				SellerSettlementFlatFee: coinP(12, "fig"),
+				AllowPartial:            true,
+				FillOrKill:              true,
+				MinFillAssets:           &minFill,
+			},
+			newAssets: coin(4, "apple"),
+			newPrice:  coin(28, "peach"),
+			newFee:    coinP(6, "fig"),
+			expected: &AskOrder{
+				MarketId:                3,
+				Seller:                  "sseelleerr",
+				Assets:                  coin(4, "apple"),
+				Price:                   coin(28, "peach"),
+				SellerSettlementFlatFee: coinP(6, "fig"),
+				AllowPartial:            true,
+				FillOrKill:              true,
+				MinFillAssets:           &minFill,
+			},
+		},
+		{
+			name: "all-or-nothing",
+			order: AskOrder{
+				MarketId:     3,
+				Seller:       "sseelleerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(56, "peach"),
+				AllOrNothing: true,
+			},
+			newAssets: coin(8, "apple"),
+			newPrice:  coin(60, "peach"),
+			expected: &AskOrder{
+				MarketId:     3,
+				Seller:       "sseelleerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(60, "peach"),
+				AllOrNothing: true,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2272,6 +2634,10 @@ func TestBidOrder_Validate(t *testing.T) {
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
+	intP := func(amount int64) *sdkmath.Int {
+		rv := sdkmath.NewInt(amount)
+		return &rv
+	}
 	coins := func(coins string) sdk.Coins {
 		rv, err := sdk.ParseCoinsNormalized(coins)
 		require.NoError(t, err, "sdk.ParseCoinsNormalized(%q)", coins)
@@ -2473,6 +2839,110 @@ func TestBidOrder_Validate(t *testing.T) {
 			},
 			exp: []string{"invalid buyer settlement fees", "coin nibbler amount is not positive"},
 		},
+		{
+			name: "fill-or-kill",
+			order: BidOrder{
+				MarketId:   1,
+				Buyer:      sdk.AccAddress("control_address_____").String(),
+				Assets:     coin(99, "bender"),
+				Price:      coin(42, "farnsworth"),
+				FillOrKill: true,
+			},
+			exp: nil,
+		},
+		{
+			name: "fill-or-kill with partial",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				AllowPartial: true,
+				FillOrKill:   true,
+			},
+			exp: nil,
+		},
+		{
+			name: "min fill assets with partial",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				AllowPartial:  true,
+				MinFillAssets: intP(99),
+			},
+			exp: nil,
+		},
+		{
+			name: "zero min fill assets without partial",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				MinFillAssets: intP(0),
+			},
+			exp: nil,
+		},
+		{
+			name: "min fill assets without partial",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				MinFillAssets: intP(1),
+			},
+			exp: []string{"invalid min fill assets \"1\": partial fulfillment is not allowed"},
+		},
+		{
+			name: "negative min fill assets",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				AllowPartial:  true,
+				MinFillAssets: intP(-1),
+			},
+			exp: []string{"invalid min fill assets \"-1\": cannot be negative"},
+		},
+		{
+			name: "min fill assets more than assets",
+			order: BidOrder{
+				MarketId:      1,
+				Buyer:         sdk.AccAddress("control_address_____").String(),
+				Assets:        coin(99, "bender"),
+				Price:         coin(42, "farnsworth"),
+				AllowPartial:  true,
+				MinFillAssets: intP(100),
+			},
+			exp: []string{"invalid min fill assets \"100\": cannot be more than the assets amount \"99\""},
+		},
+		{
+			name: "all-or-nothing",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				AllOrNothing: true,
+			},
+			exp: nil,
+		},
+		{
+			name: "all-or-nothing with partial",
+			order: BidOrder{
+				MarketId:     1,
+				Buyer:        sdk.AccAddress("control_address_____").String(),
+				Assets:       coin(99, "bender"),
+				Price:        coin(42, "farnsworth"),
+				AllowPartial: true,
+				AllOrNothing: true,
+			},
+			exp: []string{"an all-or-nothing order cannot allow partial fulfillment"},
+		},
 		{
 			name: "multiple problems",
 			order: BidOrder{
@@ -2502,6 +2972,8 @@ func TestBidOrder_CopyChange(t *testing.T) {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
 
+	minFill := sdkmath.NewInt(2)
+
 	tests := []struct {
 		name      string
 		order     BidOrder
@@ -2598,6 +3070,51 @@ func TestBidOrder_CopyChange(t *testing.T) {
 				AllowPartial:        false,
 			},
 		},
+		{
+			name: "fill controls",
+			order: BidOrder{
+				MarketId:            3,
+				Buyer:               "bbuuyyeerr",
+				Assets:              coin(8, "apple"),
+				Price:               coin(56, "peach"),
+				BuyerSettlementFees: sdk.Coins{coin(12, "fig")},
+				AllowPartial:        true,
+				FillOrKill:          true,
+				MinFillAssets:       &minFill,
+			},
+			newAssets: coin(4, "apple"),
+			newPrice:  coin(28, "peach"),
+			newFees:   sdk.Coins{coin(6, "fig")},
+			expected: &BidOrder{
+				MarketId:            3,
+				Buyer:               "bbuuyyeerr",
+				Assets:              coin(4, "apple"),
+				Price:               coin(28, "peach"),
+				BuyerSettlementFees: sdk.Coins{coin(6, "fig")},
+				AllowPartial:        true,
+				FillOrKill:          true,
+				MinFillAssets:       &minFill,
+			},
+		},
+		{
+			name: "all-or-nothing",
+			order: BidOrder{
+				MarketId:     3,
+				Buyer:        "bbuuyyeerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(56, "peach"),
+				AllOrNothing: true,
+			},
+			newAssets: coin(8, "apple"),
+			newPrice:  coin(56, "peach"),
+			expected: &BidOrder{
+				MarketId:     3,
+				Buyer:        "bbuuyyeerr",
+				Assets:       coin(8, "apple"),
+				Price:        coin(56, "peach"),
+				AllOrNothing: true,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/internal/pioconfig"
@@ -51,6 +53,79 @@ func (p Params) Validate() error {
 		errs = append(errs, err)
 	}
 
+	seen := make(map[string]bool)
+	for _, issuer := range p.MarketIssuers {
+		if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+			errs = append(errs, fmt.Errorf("invalid market issuer %q: %w", issuer, err))
+			continue
+		}
+		if seen[issuer] {
+			errs = append(errs, fmt.Errorf("duplicate market issuer %q", issuer))
+		}
+		seen[issuer] = true
+	}
+	if err := sdk.Coins(p.IssuerMarketMaxFlatFees).Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid issuer market max flat fees %q: %w", sdk.Coins(p.IssuerMarketMaxFlatFees), err))
+	}
+	if p.IssuerMarketMaxRatioBips > MaxSplit {
+		errs = append(errs, fmt.Errorf("issuer market max ratio bips %d cannot be greater than %d", p.IssuerMarketMaxRatioBips, MaxSplit))
+	}
+
+	return errors.Join(errs...)
+}
+
+// IsMarketIssuer returns true if the provided address is one of the market issuers in these params.
+func (p Params) IsMarketIssuer(addr string) bool {
+	for _, issuer := range p.MarketIssuers {
+		if issuer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateIssuerMarketFees returns an error if any of the provided market's settlement or creation fees
+// are larger than what these params allow for an issuer-created market.
+func (p Params) ValidateIssuerMarketFees(market *Market) error {
+	var errs []error
+	maxFlat := sdk.Coins(p.IssuerMarketMaxFlatFees)
+	flatFees := []struct {
+		name string
+		fees []sdk.Coin
+	}{
+		{name: "create-ask flat fee", fees: market.FeeCreateAskFlat},
+		{name: "create-bid flat fee", fees: market.FeeCreateBidFlat},
+		{name: "create-commitment flat fee", fees: market.FeeCreateCommitmentFlat},
+		{name: "seller settlement flat fee", fees: market.FeeSellerSettlementFlat},
+		{name: "buyer settlement flat fee", fees: market.FeeBuyerSettlementFlat},
+	}
+	for _, flat := range flatFees {
+		for _, fee := range flat.fees {
+			maxAmt := maxFlat.AmountOf(fee.Denom)
+			if fee.Amount.GT(maxAmt) {
+				errs = append(errs, fmt.Errorf("%s %q exceeds the issuer market max of %s%s", flat.name, fee, maxAmt, fee.Denom))
+			}
+		}
+	}
+
+	ratioFees := []struct {
+		name   string
+		ratios []FeeRatio
+	}{
+		{name: "seller settlement fee ratio", ratios: market.FeeSellerSettlementRatios},
+		{name: "buyer settlement fee ratio", ratios: market.FeeBuyerSettlementRatios},
+	}
+	maxBips := sdkmath.NewIntFromUint64(uint64(p.IssuerMarketMaxRatioBips))
+	for _, ratioFee := range ratioFees {
+		for _, ratio := range ratioFee.ratios {
+			// fee / price > bips / 10,000  <=>  fee * 10,000 > price * bips
+			if ratio.Fee.Amount.MulRaw(int64(MaxSplit)).GT(ratio.Price.Amount.Mul(maxBips)) {
+				errs = append(errs, fmt.Errorf("%s %q exceeds the issuer market max of %d bips",
+					ratioFee.name, ratio, p.IssuerMarketMaxRatioBips))
+			}
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
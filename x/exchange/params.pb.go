@@ -5,6 +5,7 @@ package exchange
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -41,6 +42,16 @@ type Params struct {
 	// If the target amount is not zero then one of these fee entries is required to accept the payment.
 	// This field is currently limited to zero or one entries.
 	FeeAcceptPaymentFlat []types.Coin `protobuf:"bytes,4,rep,name=fee_accept_payment_flat,json=feeAcceptPaymentFlat,proto3" json:"fee_accept_payment_flat"`
+	// market_issuers are the addresses allowed to create markets (without a governance proposal) for the
+	// marker denoms that they administer. See the IssuerCreateMarket endpoint.
+	MarketIssuers []string `protobuf:"bytes,5,rep,name=market_issuers,json=marketIssuers,proto3" json:"market_issuers,omitempty"`
+	// issuer_market_max_flat_fees are the largest flat fees that an issuer-created market can have.
+	// A flat fee in a denom that does not have an entry here is not allowed in an issuer-created market.
+	IssuerMarketMaxFlatFees []types.Coin `protobuf:"bytes,6,rep,name=issuer_market_max_flat_fees,json=issuerMarketMaxFlatFees,proto3" json:"issuer_market_max_flat_fees"`
+	// issuer_market_max_ratio_bips is the largest a settlement fee ratio can be in an issuer-created market.
+	// It is compared to the fee amount divided by the price amount and is in basis points.
+	// E.g. 100 = 1%. Min = 0, Max = 10000.
+	IssuerMarketMaxRatioBips uint32 `protobuf:"varint,7,opt,name=issuer_market_max_ratio_bips,json=issuerMarketMaxRatioBips,proto3" json:"issuer_market_max_ratio_bips,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -104,6 +115,27 @@ func (m *Params) GetFeeAcceptPaymentFlat() []types.Coin {
 	return nil
 }
 
+func (m *Params) GetMarketIssuers() []string {
+	if m != nil {
+		return m.MarketIssuers
+	}
+	return nil
+}
+
+func (m *Params) GetIssuerMarketMaxFlatFees() []types.Coin {
+	if m != nil {
+		return m.IssuerMarketMaxFlatFees
+	}
+	return nil
+}
+
+func (m *Params) GetIssuerMarketMaxRatioBips() uint32 {
+	if m != nil {
+		return m.IssuerMarketMaxRatioBips
+	}
+	return 0
+}
+
 // DenomSplit associates a coin denomination with an amount the exchange receives for that denom.
 type DenomSplit struct {
 	// denom is the coin denomination this split applies to.
@@ -170,31 +202,38 @@ func init() {
 }
 
 var fileDescriptor_5d689cfc7a7422f1 = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x1c, 0xc6, 0x5b, 0xf8, 0xfd, 0x48, 0x38, 0x60, 0xb0, 0x21, 0x0a, 0x0c, 0x95, 0xc0, 0x42, 0x4c,
-	0xbc, 0x0b, 0xba, 0xb8, 0x0a, 0xc6, 0xb9, 0xc1, 0x4d, 0x87, 0xe6, 0x7a, 0x7c, 0x5b, 0x9a, 0xb4,
-	0x77, 0x4d, 0x7b, 0x10, 0x7c, 0x17, 0xbe, 0x0c, 0x47, 0x57, 0xdf, 0x01, 0x23, 0xa3, 0x93, 0x31,
-	0x30, 0xf8, 0x36, 0x4c, 0xef, 0xf8, 0x67, 0xa2, 0x83, 0x4b, 0xf3, 0xfd, 0x3e, 0x7d, 0xfa, 0x69,
-	0x9f, 0xa7, 0x87, 0xba, 0x49, 0x2a, 0x66, 0xc0, 0x29, 0x67, 0x40, 0x60, 0xce, 0x26, 0x94, 0x07,
-	0x40, 0x66, 0x7d, 0x92, 0xd0, 0x94, 0xc6, 0x19, 0x4e, 0x52, 0x21, 0x85, 0x75, 0xbc, 0x37, 0xe1,
-	0xad, 0x09, 0xcf, 0xfa, 0xad, 0x23, 0x1a, 0x87, 0x5c, 0x10, 0x75, 0xd5, 0xd6, 0x56, 0x3d, 0x10,
-	0x81, 0x50, 0x23, 0xc9, 0xa7, 0x8d, 0x6a, 0x33, 0x91, 0xc5, 0x22, 0x23, 0x1e, 0xcd, 0x72, 0xba,
-	0x07, 0x92, 0xf6, 0x09, 0x13, 0x21, 0xd7, 0xf7, 0x3b, 0xaf, 0x05, 0x54, 0x72, 0xd4, 0x1b, 0xad,
-	0x2e, 0xaa, 0x8d, 0xc1, 0xa7, 0xd3, 0x48, 0xba, 0x59, 0x12, 0x85, 0xb2, 0x61, 0xb6, 0xcd, 0x5e,
-	0x6d, 0x54, 0xdd, 0x88, 0x77, 0xb9, 0x66, 0x39, 0xa8, 0x3a, 0x06, 0x2e, 0x62, 0x6d, 0xc9, 0x1a,
-	0x85, 0x76, 0xb1, 0x57, 0xb9, 0xe8, 0xe0, 0x9f, 0xbf, 0x13, 0xdf, 0xe4, 0x5e, 0xf5, 0xe4, 0xa0,
-	0xbc, 0x78, 0x3f, 0x35, 0x9e, 0x3f, 0x5f, 0xce, 0xcc, 0x51, 0x65, 0xbc, 0x93, 0x33, 0xeb, 0x01,
-	0x9d, 0xf8, 0x00, 0x2e, 0x4b, 0x81, 0x4a, 0x70, 0x13, 0xfa, 0x18, 0x03, 0x97, 0xae, 0x1f, 0x51,
-	0xd9, 0x28, 0x2a, 0x78, 0x13, 0xeb, 0x0c, 0x38, 0xcf, 0x80, 0x37, 0x19, 0xf0, 0x50, 0x84, 0xfc,
-	0x90, 0x59, 0xf7, 0x01, 0x86, 0x8a, 0xe1, 0x68, 0xc4, 0x6d, 0x44, 0xe5, 0x16, 0x4e, 0x19, 0x83,
-	0x44, 0x7e, 0x87, 0xff, 0xfb, 0x23, 0xfc, 0x5a, 0x31, 0x0e, 0xe0, 0x9d, 0x2b, 0x84, 0xf6, 0xf9,
-	0xac, 0x3a, 0xfa, 0xaf, 0x62, 0xa9, 0xda, 0xca, 0x23, 0xbd, 0xe4, 0xaa, 0x2e, 0xb3, 0xa0, 0xca,
-	0xd4, 0xcb, 0x00, 0x16, 0x2b, 0xdb, 0x5c, 0xae, 0x6c, 0xf3, 0x63, 0x65, 0x9b, 0x4f, 0x6b, 0xdb,
-	0x58, 0xae, 0x6d, 0xe3, 0x6d, 0x6d, 0x1b, 0xa8, 0x19, 0x8a, 0x5f, 0xba, 0x74, 0xcc, 0x7b, 0x1c,
-	0x84, 0x72, 0x32, 0xf5, 0x30, 0x13, 0x31, 0xd9, 0x9b, 0xce, 0x43, 0x71, 0xb0, 0x91, 0xf9, 0xee,
-	0x34, 0x79, 0x25, 0xf5, 0x8f, 0x2f, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x9c, 0x91, 0x75,
-	0x6b, 0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0xa6, 0x0d, 0xca, 0xb6, 0x41, 0xc2, 0x8a, 0xa8, 0x53, 0x90, 0x89, 0xd2, 0x4b,
+	0x84, 0xd4, 0x5d, 0x05, 0x2e, 0x9c, 0x40, 0x4d, 0x51, 0x25, 0x0e, 0x95, 0x22, 0xf7, 0x06, 0x87,
+	0xd5, 0xda, 0x1e, 0xbb, 0x2b, 0xe2, 0x5d, 0x6b, 0x77, 0x13, 0x85, 0xb7, 0xe0, 0x09, 0x38, 0x73,
+	0xe4, 0xc0, 0x43, 0xf4, 0x58, 0x71, 0xe2, 0x84, 0x50, 0x72, 0xe0, 0x35, 0x90, 0x77, 0xdd, 0x26,
+	0xfc, 0xe9, 0xa1, 0x17, 0xcb, 0xf3, 0xed, 0x37, 0xbf, 0x9d, 0xd1, 0xcc, 0xa2, 0xc3, 0x52, 0xc9,
+	0x39, 0x08, 0x26, 0x12, 0x20, 0xb0, 0x48, 0x2e, 0x98, 0xc8, 0x81, 0xcc, 0x47, 0xa4, 0x64, 0x8a,
+	0x15, 0x1a, 0x97, 0x4a, 0x1a, 0xe9, 0x3f, 0x5c, 0x9b, 0xf0, 0xb5, 0x09, 0xcf, 0x47, 0x07, 0x0f,
+	0x58, 0xc1, 0x85, 0x24, 0xf6, 0xeb, 0xac, 0x07, 0xbd, 0x44, 0xea, 0x42, 0x6a, 0x6a, 0x23, 0xe2,
+	0x82, 0xfa, 0xa8, 0x9b, 0xcb, 0x5c, 0x3a, 0xbd, 0xfa, 0xab, 0xd5, 0xd0, 0x79, 0x48, 0xcc, 0x74,
+	0x75, 0x71, 0x0c, 0x86, 0x8d, 0x48, 0x22, 0xb9, 0x70, 0xe7, 0x83, 0x4f, 0xdb, 0xa8, 0x35, 0xb1,
+	0xc5, 0xf8, 0x87, 0xa8, 0x93, 0x42, 0xc6, 0x66, 0x53, 0x43, 0x75, 0x39, 0xe5, 0x26, 0xf0, 0xfa,
+	0xde, 0xb0, 0x13, 0xed, 0xd5, 0xe2, 0x79, 0xa5, 0xf9, 0x13, 0xb4, 0x97, 0x82, 0x90, 0x85, 0xb3,
+	0xe8, 0x60, 0xab, 0xdf, 0x1c, 0xee, 0x3e, 0x1b, 0xe0, 0xff, 0xb7, 0x80, 0x5f, 0x57, 0x5e, 0x9b,
+	0x39, 0x6e, 0x5f, 0xfe, 0x78, 0xd2, 0xf8, 0xfc, 0xeb, 0xcb, 0x53, 0x2f, 0xda, 0x4d, 0x6f, 0x64,
+	0xed, 0xbf, 0x43, 0xfb, 0x19, 0x00, 0x4d, 0x14, 0x30, 0x03, 0xb4, 0x64, 0x1f, 0x0a, 0x10, 0x86,
+	0x66, 0x53, 0x66, 0x82, 0xa6, 0x85, 0xf7, 0x70, 0xdd, 0x67, 0xd5, 0x03, 0xae, 0x7b, 0xc0, 0x27,
+	0x92, 0x8b, 0x4d, 0x66, 0x37, 0x03, 0x38, 0xb1, 0x8c, 0x89, 0x43, 0x9c, 0x4e, 0x99, 0xb9, 0x86,
+	0xb3, 0x24, 0x81, 0xd2, 0xfc, 0x09, 0xdf, 0xbe, 0x23, 0xfc, 0xd8, 0x32, 0x36, 0xe1, 0xaf, 0xd0,
+	0xfd, 0x82, 0xa9, 0xf7, 0x60, 0x28, 0xd7, 0x7a, 0x06, 0x4a, 0x07, 0x3b, 0xfd, 0xe6, 0xb0, 0x3d,
+	0x0e, 0xbe, 0x7d, 0x3d, 0xea, 0xd6, 0xd8, 0xe3, 0x34, 0x55, 0xa0, 0xf5, 0xb9, 0x51, 0x5c, 0xe4,
+	0x51, 0xc7, 0xf9, 0xdf, 0x38, 0xbb, 0x1f, 0xa3, 0x47, 0x2e, 0x93, 0xd6, 0x9c, 0x82, 0x2d, 0x6c,
+	0x71, 0x34, 0x03, 0xd0, 0x41, 0xeb, 0x0e, 0x15, 0xee, 0x3b, 0xd0, 0x99, 0xe5, 0x9c, 0xb1, 0x45,
+	0x55, 0xe0, 0x29, 0x80, 0xf6, 0x5f, 0xa2, 0xc7, 0xff, 0xde, 0xa1, 0x98, 0xe1, 0x92, 0xc6, 0xbc,
+	0xd4, 0xc1, 0x3d, 0x3b, 0xe4, 0xe0, 0xaf, 0xf4, 0xa8, 0x32, 0x8c, 0x79, 0xa9, 0x07, 0x2f, 0x10,
+	0x5a, 0x0f, 0xd1, 0xef, 0xa2, 0x1d, 0x3b, 0x3b, 0xbb, 0x1b, 0xed, 0xc8, 0x05, 0x95, 0xea, 0x36,
+	0x66, 0xcb, 0xc2, 0x5c, 0x30, 0x86, 0xcb, 0x65, 0xe8, 0x5d, 0x2d, 0x43, 0xef, 0xe7, 0x32, 0xf4,
+	0x3e, 0xae, 0xc2, 0xc6, 0xd5, 0x2a, 0x6c, 0x7c, 0x5f, 0x85, 0x0d, 0xd4, 0xe3, 0xf2, 0x96, 0x85,
+	0x99, 0x78, 0x6f, 0x71, 0xce, 0xcd, 0xc5, 0x2c, 0xc6, 0x89, 0x2c, 0xc8, 0xda, 0x74, 0xc4, 0xe5,
+	0x46, 0x44, 0x16, 0x37, 0xaf, 0x29, 0x6e, 0xd9, 0x45, 0x7e, 0xfe, 0x7b, 0x00, 0x1c, 0x3c, 0xb6,
+	0x1a, 0x6b, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -217,6 +256,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IssuerMarketMaxRatioBips != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.IssuerMarketMaxRatioBips))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IssuerMarketMaxFlatFees) > 0 {
+		for iNdEx := len(m.IssuerMarketMaxFlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssuerMarketMaxFlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MarketIssuers) > 0 {
+		for iNdEx := len(m.MarketIssuers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MarketIssuers[iNdEx])
+			copy(dAtA[i:], m.MarketIssuers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MarketIssuers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.FeeAcceptPaymentFlat) > 0 {
 		for iNdEx := len(m.FeeAcceptPaymentFlat) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -340,6 +407,21 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.MarketIssuers) > 0 {
+		for _, s := range m.MarketIssuers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.IssuerMarketMaxFlatFees) > 0 {
+		for _, e := range m.IssuerMarketMaxFlatFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.IssuerMarketMaxRatioBips != 0 {
+		n += 1 + sovParams(uint64(m.IssuerMarketMaxRatioBips))
+	}
 	return n
 }
 
@@ -515,6 +597,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketIssuers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketIssuers = append(m.MarketIssuers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerMarketMaxFlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerMarketMaxFlatFees = append(m.IssuerMarketMaxFlatFees, types.Coin{})
+			if err := m.IssuerMarketMaxFlatFees[len(m.IssuerMarketMaxFlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerMarketMaxRatioBips", wireType)
+			}
+			m.IssuerMarketMaxRatioBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuerMarketMaxRatioBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
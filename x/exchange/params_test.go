@@ -107,6 +107,35 @@ func TestParams_Validate(t *testing.T) {
 			params: Params{FeeAcceptPaymentFlat: []sdk.Coin{{Denom: "banana", Amount: sdkmath.NewInt(-1)}}},
 			expErr: []string{"invalid accept payment flat fee \"-1banana\": negative coin amount: -1"},
 		},
+		{
+			name: "valid issuer market params",
+			params: Params{
+				MarketIssuers:            []string{sdk.AccAddress("issuer_one__________").String(), sdk.AccAddress("issuer_two__________").String()},
+				IssuerMarketMaxFlatFees:  []sdk.Coin{sdk.NewInt64Coin("banana", 5), sdk.NewInt64Coin("cherry", 8)},
+				IssuerMarketMaxRatioBips: 10_000,
+			},
+			expErr: nil,
+		},
+		{
+			name:   "invalid market issuer",
+			params: Params{MarketIssuers: []string{"notanaddress"}},
+			expErr: []string{"invalid market issuer \"notanaddress\""},
+		},
+		{
+			name:   "duplicate market issuer",
+			params: Params{MarketIssuers: []string{sdk.AccAddress("issuer_one__________").String(), sdk.AccAddress("issuer_one__________").String()}},
+			expErr: []string{"duplicate market issuer \"" + sdk.AccAddress("issuer_one__________").String() + "\""},
+		},
+		{
+			name:   "invalid issuer market max flat fees",
+			params: Params{IssuerMarketMaxFlatFees: []sdk.Coin{sdk.NewInt64Coin("cherry", 8), sdk.NewInt64Coin("banana", 5)}},
+			expErr: []string{"invalid issuer market max flat fees \"8cherry,5banana\""},
+		},
+		{
+			name:   "issuer market max ratio bips too large",
+			params: Params{IssuerMarketMaxRatioBips: 10_001},
+			expErr: []string{"issuer market max ratio bips 10001 cannot be greater than 10000"},
+		},
 		{
 			name: "multiple errors",
 			params: Params{
@@ -230,3 +259,117 @@ func TestValidateSplit(t *testing.T) {
 		assert.ErrorContains(t, err, maxSplitStr, "maximum split value")
 	}
 }
+
+func TestParams_IsMarketIssuer(t *testing.T) {
+	issuer1 := sdk.AccAddress("issuer_one__________").String()
+	issuer2 := sdk.AccAddress("issuer_two__________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	tests := []struct {
+		name   string
+		params Params
+		addr   string
+		exp    bool
+	}{
+		{name: "no issuers", params: Params{}, addr: issuer1, exp: false},
+		{name: "only issuer", params: Params{MarketIssuers: []string{issuer1}}, addr: issuer1, exp: true},
+		{name: "second issuer", params: Params{MarketIssuers: []string{issuer1, issuer2}}, addr: issuer2, exp: true},
+		{name: "not an issuer", params: Params{MarketIssuers: []string{issuer1, issuer2}}, addr: other, exp: false},
+		{name: "empty address", params: Params{MarketIssuers: []string{issuer1}}, addr: "", exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.params.IsMarketIssuer(tc.addr)
+			assert.Equal(t, tc.exp, actual, "IsMarketIssuer(%q)", tc.addr)
+		})
+	}
+}
+
+func TestParams_ValidateIssuerMarketFees(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, amount)
+	}
+	ratio := func(price, fee sdk.Coin) FeeRatio {
+		return FeeRatio{Price: price, Fee: fee}
+	}
+	params := Params{
+		IssuerMarketMaxFlatFees:  []sdk.Coin{coin(100, "banana"), coin(50, "cherry")},
+		IssuerMarketMaxRatioBips: 200,
+	}
+
+	tests := []struct {
+		name   string
+		params Params
+		market Market
+		expErr []string
+	}{
+		{
+			name:   "no fees",
+			params: params,
+			market: Market{},
+		},
+		{
+			name:   "all fees at the limits",
+			params: params,
+			market: Market{
+				FeeCreateAskFlat:          []sdk.Coin{coin(100, "banana"), coin(50, "cherry")},
+				FeeCreateBidFlat:          []sdk.Coin{coin(1, "banana")},
+				FeeCreateCommitmentFlat:   []sdk.Coin{coin(50, "cherry")},
+				FeeSellerSettlementFlat:   []sdk.Coin{coin(99, "banana")},
+				FeeSellerSettlementRatios: []FeeRatio{ratio(coin(100, "banana"), coin(2, "banana"))},
+				FeeBuyerSettlementFlat:    []sdk.Coin{coin(49, "cherry")},
+				FeeBuyerSettlementRatios:  []FeeRatio{ratio(coin(500, "banana"), coin(10, "cherry"))},
+			},
+		},
+		{
+			name:   "flat fee too large",
+			params: params,
+			market: Market{FeeCreateBidFlat: []sdk.Coin{coin(101, "banana")}},
+			expErr: []string{`create-bid flat fee "101banana" exceeds the issuer market max of 100banana`},
+		},
+		{
+			name:   "flat fee denom without a max",
+			params: params,
+			market: Market{FeeBuyerSettlementFlat: []sdk.Coin{coin(1, "apple")}},
+			expErr: []string{`buyer settlement flat fee "1apple" exceeds the issuer market max of 0apple`},
+		},
+		{
+			name:   "ratio too large",
+			params: params,
+			market: Market{FeeSellerSettlementRatios: []FeeRatio{ratio(coin(100, "banana"), coin(3, "banana"))}},
+			expErr: []string{`seller settlement fee ratio "100banana:3banana" exceeds the issuer market max of 200 bips`},
+		},
+		{
+			name:   "no ratios allowed",
+			params: Params{},
+			market: Market{FeeBuyerSettlementRatios: []FeeRatio{ratio(coin(100, "banana"), coin(1, "banana"))}},
+			expErr: []string{`buyer settlement fee ratio "100banana:1banana" exceeds the issuer market max of 0 bips`},
+		},
+		{
+			name:   "multiple errors",
+			params: params,
+			market: Market{
+				FeeCreateAskFlat:          []sdk.Coin{coin(51, "cherry")},
+				FeeCreateCommitmentFlat:   []sdk.Coin{coin(1, "apple")},
+				FeeSellerSettlementFlat:   []sdk.Coin{coin(101, "banana")},
+				FeeSellerSettlementRatios: []FeeRatio{ratio(coin(10, "banana"), coin(1, "banana"))},
+				FeeBuyerSettlementRatios:  []FeeRatio{ratio(coin(1, "banana"), coin(1, "banana"))},
+			},
+			expErr: []string{
+				`create-ask flat fee "51cherry" exceeds the issuer market max of 50cherry`,
+				`create-commitment flat fee "1apple" exceeds the issuer market max of 0apple`,
+				`seller settlement flat fee "101banana" exceeds the issuer market max of 100banana`,
+				`seller settlement fee ratio "10banana:1banana" exceeds the issuer market max of 200 bips`,
+				`buyer settlement fee ratio "1banana:1banana" exceeds the issuer market max of 200 bips`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateIssuerMarketFees(&tc.market)
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateIssuerMarketFees")
+		})
+	}
+}
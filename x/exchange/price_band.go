@@ -0,0 +1,124 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// IsEnabled returns true if this config has a market restricting settlements to a price band.
+func (c MarketPriceBandConfig) IsEnabled() bool {
+	return c.MaxDeviationBips > 0
+}
+
+// Validate returns an error if any of this MarketPriceBandConfig's info is invalid.
+// A config with a zero MaxDeviationBips (i.e. disabled) must not have any other fields set.
+func (c MarketPriceBandConfig) Validate() error {
+	if !c.IsEnabled() {
+		if c.Reference != PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED || c.Action != PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED {
+			return errors.New("invalid price band config: a config with zero max deviation bips must not have any other fields set")
+		}
+		return nil
+	}
+
+	var errs []error
+	if err := ValidateBips("price band max deviation", c.MaxDeviationBips); err != nil {
+		errs = append(errs, err)
+	}
+	switch c.Reference {
+	case PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT, PriceBandReference_PRICE_BAND_REFERENCE_NAV:
+	default:
+		errs = append(errs, fmt.Errorf("invalid price band reference %s", c.Reference))
+	}
+	switch c.Action {
+	case PriceBandAction_PRICE_BAND_ACTION_REJECT, PriceBandAction_PRICE_BAND_ACTION_PAUSE:
+	default:
+		errs = append(errs, fmt.Errorf("invalid price band action %s", c.Action))
+	}
+	return errors.Join(errs...)
+}
+
+// ParsePriceBandReference converts the provided string into a PriceBandReference.
+// The "PRICE_BAND_REFERENCE_" prefix is optional and it's case-insensitive.
+// An empty string is returned as PRICE_BAND_REFERENCE_UNSPECIFIED.
+func ParsePriceBandReference(reference string) (PriceBandReference, error) {
+	refUC := strings.ToUpper(strings.TrimSpace(reference))
+	if len(refUC) == 0 {
+		return PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED, nil
+	}
+	if !strings.HasPrefix(refUC, "PRICE_BAND_REFERENCE_") {
+		refUC = "PRICE_BAND_REFERENCE_" + refUC
+	}
+	if val, found := PriceBandReference_value[refUC]; found {
+		return PriceBandReference(val), nil
+	}
+	return PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED, fmt.Errorf("invalid price band reference: %q", reference)
+}
+
+// ParsePriceBandAction converts the provided string into a PriceBandAction.
+// The "PRICE_BAND_ACTION_" prefix is optional and it's case-insensitive.
+// An empty string is returned as PRICE_BAND_ACTION_UNSPECIFIED.
+func ParsePriceBandAction(action string) (PriceBandAction, error) {
+	actUC := strings.ToUpper(strings.TrimSpace(action))
+	if len(actUC) == 0 {
+		return PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED, nil
+	}
+	if !strings.HasPrefix(actUC, "PRICE_BAND_ACTION_") {
+		actUC = "PRICE_BAND_ACTION_" + actUC
+	}
+	if val, found := PriceBandAction_value[actUC]; found {
+		return PriceBandAction(val), nil
+	}
+	return PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED, fmt.Errorf("invalid price band action: %q", action)
+}
+
+// IsOutsideBand returns true if the unit price of the provided settlement deviates from
+// the unit price of the provided reference by more than this config allows.
+// A disabled config, mismatched denoms, or a reference without a positive assets and price amount
+// cannot be used to compare, so false is returned in those cases.
+func (c MarketPriceBandConfig) IsOutsideBand(settlement, reference NetAssetPrice) bool {
+	if !c.IsEnabled() ||
+		settlement.Assets.Denom != reference.Assets.Denom || settlement.Price.Denom != reference.Price.Denom ||
+		!settlement.Assets.Amount.IsPositive() || !reference.Assets.Amount.IsPositive() || !reference.Price.Amount.IsPositive() {
+		return false
+	}
+
+	// The deviation is |sp/sa - rp/ra| / (rp/ra) = |sp*ra - rp*sa| / (rp*sa).
+	// To avoid division, we compare |sp*ra - rp*sa| * MaxBips against bips * rp*sa.
+	refTotal := reference.Price.Amount.Mul(settlement.Assets.Amount)
+	diff := settlement.Price.Amount.Mul(reference.Assets.Amount).Sub(refTotal).Abs()
+	lhs := diff.Mul(sdkmath.NewIntFromUint64(uint64(MaxBips)))
+	rhs := refTotal.Mul(sdkmath.NewIntFromUint64(uint64(c.MaxDeviationBips)))
+	return lhs.GT(rhs)
+}
+
+// Validate returns an error if any of this MarketPriceBands's info is invalid.
+func (b MarketPriceBands) Validate() error {
+	var errs []error
+	if b.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := b.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	type denomPair struct {
+		assets string
+		price  string
+	}
+	seen := make(map[denomPair]int, len(b.LastPrices))
+	for i, nav := range b.LastPrices {
+		pair := denomPair{assets: nav.Assets.Denom, price: nav.Price.Denom}
+		if j, dup := seen[pair]; dup {
+			errs = append(errs, fmt.Errorf("invalid last prices[%d]: duplicate assets denom %q and price denom %q seen at [%d]",
+				i, pair.assets, pair.price, j))
+			continue
+		}
+		seen[pair] = i
+		if err := nav.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid last prices[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
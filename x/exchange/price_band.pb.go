@@ -0,0 +1,780 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/price_band.proto
+
+package exchange
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceBandReference defines the price that a settlement is compared against to see if it's within a market's price band.
+type PriceBandReference int32
+
+const (
+	// PRICE_BAND_REFERENCE_UNSPECIFIED is the zero-value default and is not allowed for an enabled price band.
+	PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED PriceBandReference = 0
+	// PRICE_BAND_REFERENCE_LAST_SETTLEMENT compares settlements against the last price that the assets settled at in the market.
+	PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT PriceBandReference = 1
+	// PRICE_BAND_REFERENCE_NAV compares settlements against the net-asset-value recorded for the assets.
+	PriceBandReference_PRICE_BAND_REFERENCE_NAV PriceBandReference = 2
+)
+
+var PriceBandReference_name = map[int32]string{
+	0: "PRICE_BAND_REFERENCE_UNSPECIFIED",
+	1: "PRICE_BAND_REFERENCE_LAST_SETTLEMENT",
+	2: "PRICE_BAND_REFERENCE_NAV",
+}
+
+var PriceBandReference_value = map[string]int32{
+	"PRICE_BAND_REFERENCE_UNSPECIFIED":     0,
+	"PRICE_BAND_REFERENCE_LAST_SETTLEMENT": 1,
+	"PRICE_BAND_REFERENCE_NAV":             2,
+}
+
+func (x PriceBandReference) String() string {
+	return proto.EnumName(PriceBandReference_name, int32(x))
+}
+
+func (PriceBandReference) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_28004ba2c3a5255e, []int{0}
+}
+
+// PriceBandAction defines what happens when a settlement is outside of a market's price band.
+type PriceBandAction int32
+
+const (
+	// PRICE_BAND_ACTION_UNSPECIFIED is the zero-value default and is not allowed for an enabled price band.
+	PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED PriceBandAction = 0
+	// PRICE_BAND_ACTION_REJECT causes the settlement to fail.
+	PriceBandAction_PRICE_BAND_ACTION_REJECT PriceBandAction = 1
+	// PRICE_BAND_ACTION_PAUSE causes the settlement to be skipped and pauses all settlements in the market until
+	// they are resumed by a market admin or governance.
+	PriceBandAction_PRICE_BAND_ACTION_PAUSE PriceBandAction = 2
+)
+
+var PriceBandAction_name = map[int32]string{
+	0: "PRICE_BAND_ACTION_UNSPECIFIED",
+	1: "PRICE_BAND_ACTION_REJECT",
+	2: "PRICE_BAND_ACTION_PAUSE",
+}
+
+var PriceBandAction_value = map[string]int32{
+	"PRICE_BAND_ACTION_UNSPECIFIED": 0,
+	"PRICE_BAND_ACTION_REJECT":      1,
+	"PRICE_BAND_ACTION_PAUSE":       2,
+}
+
+func (x PriceBandAction) String() string {
+	return proto.EnumName(PriceBandAction_name, int32(x))
+}
+
+func (PriceBandAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_28004ba2c3a5255e, []int{1}
+}
+
+// MarketPriceBandConfig defines how far from a reference price a market allows its settlements to be.
+type MarketPriceBandConfig struct {
+	// max_deviation_bips is the furthest that a settlement's unit price can be from the reference unit price.
+	// It is represented in basis points (1/100th of 1%, e.g. 0.0001) of the reference price and is limited
+	// to 0 to 10,000 inclusive. A value of zero means the market does not have a price band.
+	MaxDeviationBips uint32 `protobuf:"varint,1,opt,name=max_deviation_bips,json=maxDeviationBips,proto3" json:"max_deviation_bips,omitempty"`
+	// reference is the price that settlements are compared against.
+	Reference PriceBandReference `protobuf:"varint,2,opt,name=reference,proto3,enum=provenance.exchange.v1.PriceBandReference" json:"reference,omitempty"`
+	// action is what happens when a settlement is outside of the price band.
+	Action PriceBandAction `protobuf:"varint,3,opt,name=action,proto3,enum=provenance.exchange.v1.PriceBandAction" json:"action,omitempty"`
+}
+
+func (m *MarketPriceBandConfig) Reset()         { *m = MarketPriceBandConfig{} }
+func (m *MarketPriceBandConfig) String() string { return proto.CompactTextString(m) }
+func (*MarketPriceBandConfig) ProtoMessage()    {}
+func (*MarketPriceBandConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28004ba2c3a5255e, []int{0}
+}
+func (m *MarketPriceBandConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketPriceBandConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPriceBandConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPriceBandConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPriceBandConfig.Merge(m, src)
+}
+func (m *MarketPriceBandConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPriceBandConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPriceBandConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPriceBandConfig proto.InternalMessageInfo
+
+func (m *MarketPriceBandConfig) GetMaxDeviationBips() uint32 {
+	if m != nil {
+		return m.MaxDeviationBips
+	}
+	return 0
+}
+
+func (m *MarketPriceBandConfig) GetReference() PriceBandReference {
+	if m != nil {
+		return m.Reference
+	}
+	return PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED
+}
+
+func (m *MarketPriceBandConfig) GetAction() PriceBandAction {
+	if m != nil {
+		return m.Action
+	}
+	return PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED
+}
+
+// MarketPriceBands contains a market's price band configuration and the current state of its circuit breaker.
+type MarketPriceBands struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// config is the market's price band configuration.
+	Config MarketPriceBandConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	// paused is whether settlements in the market are paused because a settlement was outside of the price band.
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// last_prices are the most recent settlement prices of each assets and price denom pair in the market.
+	LastPrices []NetAssetPrice `protobuf:"bytes,4,rep,name=last_prices,json=lastPrices,proto3" json:"last_prices"`
+}
+
+func (m *MarketPriceBands) Reset()         { *m = MarketPriceBands{} }
+func (m *MarketPriceBands) String() string { return proto.CompactTextString(m) }
+func (*MarketPriceBands) ProtoMessage()    {}
+func (*MarketPriceBands) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28004ba2c3a5255e, []int{1}
+}
+func (m *MarketPriceBands) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketPriceBands) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPriceBands.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPriceBands) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPriceBands.Merge(m, src)
+}
+func (m *MarketPriceBands) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPriceBands) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPriceBands.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPriceBands proto.InternalMessageInfo
+
+func (m *MarketPriceBands) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketPriceBands) GetConfig() MarketPriceBandConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MarketPriceBandConfig{}
+}
+
+func (m *MarketPriceBands) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MarketPriceBands) GetLastPrices() []NetAssetPrice {
+	if m != nil {
+		return m.LastPrices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("provenance.exchange.v1.PriceBandReference", PriceBandReference_name, PriceBandReference_value)
+	proto.RegisterEnum("provenance.exchange.v1.PriceBandAction", PriceBandAction_name, PriceBandAction_value)
+	proto.RegisterType((*MarketPriceBandConfig)(nil), "provenance.exchange.v1.MarketPriceBandConfig")
+	proto.RegisterType((*MarketPriceBands)(nil), "provenance.exchange.v1.MarketPriceBands")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/price_band.proto", fileDescriptor_28004ba2c3a5255e)
+}
+
+var fileDescriptor_28004ba2c3a5255e = []byte{
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0xeb, 0x6e, 0xaa, 0x36, 0x57, 0x40, 0x64, 0xc1, 0x28, 0x1b, 0x84, 0x32, 0x0d, 0xad,
+	0xaa, 0x58, 0xa2, 0x95, 0x07, 0x40, 0x69, 0xea, 0x89, 0x42, 0x17, 0x2a, 0x37, 0xe3, 0x82, 0x9b,
+	0xc8, 0x4d, 0xbc, 0xcc, 0x82, 0xc4, 0x51, 0x9c, 0x55, 0xbd, 0xe6, 0x09, 0x78, 0xac, 0x5d, 0xf6,
+	0x92, 0x2b, 0x84, 0xda, 0x17, 0x41, 0x71, 0xd3, 0x75, 0x74, 0xad, 0x76, 0x97, 0x1c, 0x7f, 0xff,
+	0xff, 0x9f, 0x73, 0x2c, 0xc3, 0xe3, 0x24, 0x15, 0x23, 0x16, 0xd3, 0xd8, 0x67, 0x26, 0x1b, 0xfb,
+	0x57, 0x34, 0x0e, 0x99, 0x39, 0x3a, 0x35, 0x93, 0x94, 0xfb, 0xcc, 0x1b, 0xd2, 0x38, 0x30, 0x92,
+	0x54, 0x64, 0x02, 0xed, 0x2d, 0x41, 0x63, 0x01, 0x1a, 0xa3, 0xd3, 0xfd, 0xa7, 0xa1, 0x08, 0x85,
+	0x42, 0xcc, 0xfc, 0x6b, 0x4e, 0xef, 0x37, 0x36, 0xd8, 0xfa, 0x22, 0x8a, 0x78, 0x16, 0xb1, 0x38,
+	0x93, 0x73, 0xf2, 0x70, 0x02, 0xe0, 0xb3, 0x73, 0x9a, 0x7e, 0x67, 0x59, 0x3f, 0x8f, 0x6c, 0xd3,
+	0x38, 0xb0, 0x45, 0x7c, 0xc9, 0x43, 0xf4, 0x0e, 0xa2, 0x88, 0x8e, 0xbd, 0x80, 0x8d, 0x38, 0xcd,
+	0xb8, 0x88, 0xbd, 0x21, 0x4f, 0x64, 0x0d, 0xd4, 0x41, 0xe3, 0x11, 0xd1, 0x22, 0x3a, 0xee, 0x2c,
+	0x0e, 0xda, 0x3c, 0x91, 0xe8, 0x23, 0xdc, 0x4d, 0xd9, 0x25, 0x4b, 0x59, 0xec, 0xb3, 0x5a, 0xb9,
+	0x0e, 0x1a, 0x8f, 0x5b, 0x4d, 0x63, 0x7d, 0xcf, 0xc6, 0x6d, 0x12, 0x59, 0x28, 0xc8, 0x52, 0x8c,
+	0x3e, 0xc0, 0x0a, 0xf5, 0x73, 0xdf, 0xda, 0x96, 0xb2, 0x39, 0x7e, 0xd0, 0xc6, 0x52, 0x38, 0x29,
+	0x64, 0x87, 0x53, 0x00, 0xb5, 0x95, 0x91, 0x24, 0x3a, 0x80, 0xbb, 0x91, 0xaa, 0x79, 0x3c, 0x28,
+	0x86, 0xd8, 0x99, 0x17, 0xba, 0x01, 0xfa, 0x0c, 0x2b, 0xbe, 0x1a, 0x5a, 0x75, 0x5e, 0x6d, 0x9d,
+	0x6c, 0x8a, 0x5c, 0xbb, 0xa9, 0xf6, 0xf6, 0xcd, 0x9f, 0xd7, 0x25, 0x52, 0x58, 0xa0, 0x3d, 0x58,
+	0x49, 0xe8, 0xb5, 0x64, 0x81, 0xea, 0x7f, 0x87, 0x14, 0x7f, 0xa8, 0x07, 0xab, 0x3f, 0xa8, 0xcc,
+	0x3c, 0x75, 0xb5, 0xb2, 0xb6, 0x5d, 0xdf, 0x6a, 0x54, 0x5b, 0x6f, 0x37, 0x25, 0x39, 0x2c, 0xb3,
+	0xa4, 0x5c, 0x64, 0xcd, 0x13, 0x60, 0xae, 0x57, 0x05, 0xd9, 0xfc, 0x09, 0x20, 0xba, 0xbf, 0x47,
+	0x74, 0x04, 0xeb, 0x7d, 0xd2, 0xb5, 0xb1, 0xd7, 0xb6, 0x9c, 0x8e, 0x47, 0xf0, 0x19, 0x26, 0xd8,
+	0xb1, 0xb1, 0x77, 0xe1, 0x0c, 0xfa, 0xd8, 0xee, 0x9e, 0x75, 0x71, 0x47, 0x2b, 0xa1, 0x06, 0x3c,
+	0x5a, 0x4b, 0xf5, 0xac, 0x81, 0xeb, 0x0d, 0xb0, 0xeb, 0xf6, 0xf0, 0x39, 0x76, 0x5c, 0x0d, 0xa0,
+	0x97, 0xb0, 0xb6, 0x96, 0x74, 0xac, 0xaf, 0x5a, 0xb9, 0x29, 0xe0, 0x93, 0x95, 0x4b, 0x40, 0x6f,
+	0xe0, 0xab, 0x3b, 0x02, 0xcb, 0x76, 0xbb, 0x5f, 0x9c, 0x95, 0xf4, 0xff, 0x3d, 0x0b, 0x84, 0xe0,
+	0x4f, 0xd8, 0xce, 0x13, 0x0f, 0xe0, 0xf3, 0xfb, 0xa7, 0x7d, 0xeb, 0x62, 0x80, 0xb5, 0x72, 0x9b,
+	0xdd, 0x4c, 0x75, 0x30, 0x99, 0xea, 0xe0, 0xef, 0x54, 0x07, 0xbf, 0x66, 0x7a, 0x69, 0x32, 0xd3,
+	0x4b, 0xbf, 0x67, 0x7a, 0x09, 0xbe, 0xe0, 0x62, 0xc3, 0x2a, 0xfb, 0xe0, 0x9b, 0x11, 0xf2, 0xec,
+	0xea, 0x7a, 0x68, 0xf8, 0x22, 0x32, 0x97, 0xd0, 0x09, 0x17, 0x77, 0xfe, 0xcc, 0xf1, 0xed, 0x4b,
+	0x19, 0x56, 0xd4, 0xdb, 0x78, 0xff, 0x6f, 0x00, 0x0e, 0x4b, 0xdf, 0x77, 0x9e, 0x03, 0x00, 0x00,
+}
+
+func (m *MarketPriceBandConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPriceBandConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPriceBandConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintPriceBand(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Reference != 0 {
+		i = encodeVarintPriceBand(dAtA, i, uint64(m.Reference))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxDeviationBips != 0 {
+		i = encodeVarintPriceBand(dAtA, i, uint64(m.MaxDeviationBips))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketPriceBands) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPriceBands) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPriceBands) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastPrices) > 0 {
+		for iNdEx := len(m.LastPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPriceBand(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPriceBand(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintPriceBand(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceBand(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceBand(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketPriceBandConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDeviationBips != 0 {
+		n += 1 + sovPriceBand(uint64(m.MaxDeviationBips))
+	}
+	if m.Reference != 0 {
+		n += 1 + sovPriceBand(uint64(m.Reference))
+	}
+	if m.Action != 0 {
+		n += 1 + sovPriceBand(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *MarketPriceBands) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovPriceBand(uint64(m.MarketId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovPriceBand(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	if len(m.LastPrices) > 0 {
+		for _, e := range m.LastPrices {
+			l = e.Size()
+			n += 1 + l + sovPriceBand(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPriceBand(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceBand(x uint64) (n int) {
+	return sovPriceBand(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketPriceBandConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceBand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPriceBandConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPriceBandConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBips", wireType)
+			}
+			m.MaxDeviationBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			m.Reference = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reference |= PriceBandReference(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= PriceBandAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceBand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceBand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketPriceBands) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceBand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPriceBands: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPriceBands: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceBand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceBand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceBand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceBand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastPrices = append(m.LastPrices, NetAssetPrice{})
+			if err := m.LastPrices[len(m.LastPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceBand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceBand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceBand(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceBand
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceBand
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceBand
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceBand
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceBand
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceBand        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceBand          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceBand = fmt.Errorf("proto: unexpected end of group")
+)
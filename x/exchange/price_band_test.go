@@ -0,0 +1,275 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestMarketPriceBandConfig_Validate(t *testing.T) {
+	lastSettlement := PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT
+	reject := PriceBandAction_PRICE_BAND_ACTION_REJECT
+
+	tests := []struct {
+		name   string
+		config MarketPriceBandConfig
+		expErr []string
+	}{
+		{
+			name:   "zero value",
+			config: MarketPriceBandConfig{},
+		},
+		{
+			name:   "last settlement reject",
+			config: MarketPriceBandConfig{MaxDeviationBips: 500, Reference: lastSettlement, Action: reject},
+		},
+		{
+			name: "nav pause",
+			config: MarketPriceBandConfig{
+				MaxDeviationBips: MaxBips,
+				Reference:        PriceBandReference_PRICE_BAND_REFERENCE_NAV,
+				Action:           PriceBandAction_PRICE_BAND_ACTION_PAUSE,
+			},
+		},
+		{
+			name:   "disabled with reference",
+			config: MarketPriceBandConfig{Reference: lastSettlement},
+			expErr: []string{"invalid price band config: a config with zero max deviation bips must not have any other fields set"},
+		},
+		{
+			name:   "disabled with action",
+			config: MarketPriceBandConfig{Action: reject},
+			expErr: []string{"invalid price band config: a config with zero max deviation bips must not have any other fields set"},
+		},
+		{
+			name:   "bips too large",
+			config: MarketPriceBandConfig{MaxDeviationBips: MaxBips + 1, Reference: lastSettlement, Action: reject},
+			expErr: []string{"invalid price band max deviation bips 10001: exceeds max of 10000"},
+		},
+		{
+			name:   "unknown reference",
+			config: MarketPriceBandConfig{MaxDeviationBips: 5, Reference: 3, Action: reject},
+			expErr: []string{"invalid price band reference 3"},
+		},
+		{
+			name: "multiple errors",
+			config: MarketPriceBandConfig{
+				MaxDeviationBips: MaxBips + 5,
+				Reference:        PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED,
+				Action:           PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED,
+			},
+			expErr: []string{
+				"invalid price band max deviation bips 10005: exceeds max of 10000",
+				"invalid price band reference PRICE_BAND_REFERENCE_UNSPECIFIED",
+				"invalid price band action PRICE_BAND_ACTION_UNSPECIFIED",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.config.Validate()
+			}
+			assert.NotPanics(t, testFunc, "MarketPriceBandConfig.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketPriceBandConfig.Validate()")
+		})
+	}
+}
+
+func TestParsePriceBandReference(t *testing.T) {
+	tests := []struct {
+		input  string
+		exp    PriceBandReference
+		expErr string
+	}{
+		{input: "", exp: PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED},
+		{input: "last_settlement", exp: PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT},
+		{input: " NAV ", exp: PriceBandReference_PRICE_BAND_REFERENCE_NAV},
+		{input: "price_band_reference_nav", exp: PriceBandReference_PRICE_BAND_REFERENCE_NAV},
+		{input: "unspecified", exp: PriceBandReference_PRICE_BAND_REFERENCE_UNSPECIFIED},
+		{input: "last", expErr: "invalid price band reference: \"last\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var act PriceBandReference
+			var err error
+			testFunc := func() {
+				act, err = ParsePriceBandReference(tc.input)
+			}
+			assert.NotPanics(t, testFunc, "ParsePriceBandReference(%q)", tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParsePriceBandReference(%q) error", tc.input)
+			assert.Equal(t, tc.exp, act, "ParsePriceBandReference(%q) result", tc.input)
+		})
+	}
+}
+
+func TestParsePriceBandAction(t *testing.T) {
+	tests := []struct {
+		input  string
+		exp    PriceBandAction
+		expErr string
+	}{
+		{input: "", exp: PriceBandAction_PRICE_BAND_ACTION_UNSPECIFIED},
+		{input: "reject", exp: PriceBandAction_PRICE_BAND_ACTION_REJECT},
+		{input: " Pause ", exp: PriceBandAction_PRICE_BAND_ACTION_PAUSE},
+		{input: "PRICE_BAND_ACTION_REJECT", exp: PriceBandAction_PRICE_BAND_ACTION_REJECT},
+		{input: "halt", expErr: "invalid price band action: \"halt\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var act PriceBandAction
+			var err error
+			testFunc := func() {
+				act, err = ParsePriceBandAction(tc.input)
+			}
+			assert.NotPanics(t, testFunc, "ParsePriceBandAction(%q)", tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParsePriceBandAction(%q) error", tc.input)
+			assert.Equal(t, tc.exp, act, "ParsePriceBandAction(%q) result", tc.input)
+		})
+	}
+}
+
+func TestMarketPriceBandConfig_IsOutsideBand(t *testing.T) {
+	nav := func(assets, price string) NetAssetPrice {
+		assetsCoin, err := ParseCoin(assets)
+		require.NoError(t, err, "ParseCoin(%q)", assets)
+		priceCoin, err := ParseCoin(price)
+		require.NoError(t, err, "ParseCoin(%q)", price)
+		return NetAssetPrice{Assets: assetsCoin, Price: priceCoin}
+	}
+	band := func(bips uint32) MarketPriceBandConfig {
+		return MarketPriceBandConfig{
+			MaxDeviationBips: bips,
+			Reference:        PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT,
+			Action:           PriceBandAction_PRICE_BAND_ACTION_REJECT,
+		}
+	}
+	// The reference unit price is 10pear per apple.
+	ref := nav("2apple", "20pear")
+
+	tests := []struct {
+		name       string
+		config     MarketPriceBandConfig
+		settlement NetAssetPrice
+		reference  NetAssetPrice
+		exp        bool
+	}{
+		{name: "disabled", config: MarketPriceBandConfig{}, settlement: nav("1apple", "1000pear"), reference: ref, exp: false},
+		{name: "same unit price", config: band(1), settlement: nav("5apple", "50pear"), reference: ref, exp: false},
+		{name: "up at the limit", config: band(1000), settlement: nav("10apple", "110pear"), reference: ref, exp: false},
+		{name: "up past the limit", config: band(1000), settlement: nav("10apple", "111pear"), reference: ref, exp: true},
+		{name: "down at the limit", config: band(1000), settlement: nav("10apple", "90pear"), reference: ref, exp: false},
+		{name: "down past the limit", config: band(1000), settlement: nav("10apple", "89pear"), reference: ref, exp: true},
+		{name: "one bip at the limit", config: band(1), settlement: nav("10000apple", "100010pear"), reference: ref, exp: false},
+		{name: "one bip past the limit", config: band(1), settlement: nav("10000apple", "100011pear"), reference: ref, exp: true},
+		{name: "max bips way down", config: band(MaxBips), settlement: nav("1apple", "1pear"), reference: ref, exp: false},
+		{name: "max bips double", config: band(MaxBips), settlement: nav("1apple", "21pear"), reference: ref, exp: true},
+		{name: "different assets denom", config: band(1), settlement: nav("1banana", "1000pear"), reference: ref, exp: false},
+		{name: "different price denom", config: band(1), settlement: nav("1apple", "1000fig"), reference: ref, exp: false},
+		{
+			name:       "zero reference price",
+			config:     band(1),
+			settlement: nav("1apple", "1000pear"),
+			reference:  NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 1), Price: sdk.NewInt64Coin("pear", 0)},
+			exp:        false,
+		},
+		{
+			name:       "zero settlement assets",
+			config:     band(1),
+			settlement: NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("pear", 1000)},
+			reference:  ref,
+			exp:        false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act bool
+			testFunc := func() {
+				act = tc.config.IsOutsideBand(tc.settlement, tc.reference)
+			}
+			assert.NotPanics(t, testFunc, "IsOutsideBand(%s, %s)", tc.settlement, tc.reference)
+			assert.Equal(t, tc.exp, act, "IsOutsideBand(%s, %s)", tc.settlement, tc.reference)
+		})
+	}
+}
+
+func TestMarketPriceBands_Validate(t *testing.T) {
+	goodConfig := MarketPriceBandConfig{
+		MaxDeviationBips: 250,
+		Reference:        PriceBandReference_PRICE_BAND_REFERENCE_LAST_SETTLEMENT,
+		Action:           PriceBandAction_PRICE_BAND_ACTION_PAUSE,
+	}
+	nav := func(assets, price string) NetAssetPrice {
+		assetsCoin, err := ParseCoin(assets)
+		require.NoError(t, err, "ParseCoin(%q)", assets)
+		priceCoin, err := ParseCoin(price)
+		require.NoError(t, err, "ParseCoin(%q)", price)
+		return NetAssetPrice{Assets: assetsCoin, Price: priceCoin}
+	}
+
+	tests := []struct {
+		name   string
+		bands  MarketPriceBands
+		expErr []string
+	}{
+		{
+			name:  "control",
+			bands: MarketPriceBands{MarketId: 1, Config: goodConfig, Paused: true, LastPrices: []NetAssetPrice{nav("1apple", "5pear")}},
+		},
+		{
+			name:  "paused without a config",
+			bands: MarketPriceBands{MarketId: 1, Paused: true},
+		},
+		{
+			name: "same assets in different price denoms",
+			bands: MarketPriceBands{MarketId: 1, Config: goodConfig, LastPrices: []NetAssetPrice{
+				nav("1apple", "5pear"), nav("1apple", "7fig"),
+			}},
+		},
+		{
+			name:   "market zero",
+			bands:  MarketPriceBands{Config: goodConfig},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:   "bad config",
+			bands:  MarketPriceBands{MarketId: 1, Config: MarketPriceBandConfig{Action: goodConfig.Action}},
+			expErr: []string{"invalid price band config: a config with zero max deviation bips must not have any other fields set"},
+		},
+		{
+			name: "duplicate last price",
+			bands: MarketPriceBands{MarketId: 1, Config: goodConfig, LastPrices: []NetAssetPrice{
+				nav("1apple", "5pear"), nav("2banana", "5pear"), nav("3apple", "12pear"),
+			}},
+			expErr: []string{"invalid last prices[2]: duplicate assets denom \"apple\" and price denom \"pear\" seen at [0]"},
+		},
+		{
+			name: "invalid last price",
+			bands: MarketPriceBands{MarketId: 1, Config: goodConfig, LastPrices: []NetAssetPrice{
+				{Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("pear", 5)},
+			}},
+			expErr: []string{"invalid last prices[0]: invalid assets \"0apple\": cannot be zero"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.bands.Validate()
+			}
+			assert.NotPanics(t, testFunc, "MarketPriceBands.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketPriceBands.Validate()")
+		})
+	}
+}
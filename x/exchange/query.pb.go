@@ -843,6 +843,300 @@ func (m *QueryGetAllOrdersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGetSettlementReceiptRequest is a request message for the GetSettlementReceipt query.
+type QueryGetSettlementReceiptRequest struct {
+	// receipt_id is the id of the settlement receipt to look up.
+	ReceiptId uint64 `protobuf:"varint,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+}
+
+func (m *QueryGetSettlementReceiptRequest) Reset()         { *m = QueryGetSettlementReceiptRequest{} }
+func (m *QueryGetSettlementReceiptRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSettlementReceiptRequest) ProtoMessage()    {}
+func (*QueryGetSettlementReceiptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{14}
+}
+func (m *QueryGetSettlementReceiptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSettlementReceiptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSettlementReceiptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSettlementReceiptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSettlementReceiptRequest.Merge(m, src)
+}
+func (m *QueryGetSettlementReceiptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSettlementReceiptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSettlementReceiptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSettlementReceiptRequest proto.InternalMessageInfo
+
+func (m *QueryGetSettlementReceiptRequest) GetReceiptId() uint64 {
+	if m != nil {
+		return m.ReceiptId
+	}
+	return 0
+}
+
+// QueryGetSettlementReceiptResponse is a response message for the GetSettlementReceipt query.
+type QueryGetSettlementReceiptResponse struct {
+	// receipt is the requested settlement receipt.
+	Receipt *SettlementReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (m *QueryGetSettlementReceiptResponse) Reset()         { *m = QueryGetSettlementReceiptResponse{} }
+func (m *QueryGetSettlementReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetSettlementReceiptResponse) ProtoMessage()    {}
+func (*QueryGetSettlementReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{15}
+}
+func (m *QueryGetSettlementReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetSettlementReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetSettlementReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetSettlementReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetSettlementReceiptResponse.Merge(m, src)
+}
+func (m *QueryGetSettlementReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetSettlementReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetSettlementReceiptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetSettlementReceiptResponse proto.InternalMessageInfo
+
+func (m *QueryGetSettlementReceiptResponse) GetReceipt() *SettlementReceipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+// QueryGetRFQRequest is a request message for the GetRFQ query.
+type QueryGetRFQRequest struct {
+	// rfq_id is the id of the request for quote to look up.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+}
+
+func (m *QueryGetRFQRequest) Reset()         { *m = QueryGetRFQRequest{} }
+func (m *QueryGetRFQRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRFQRequest) ProtoMessage()    {}
+func (*QueryGetRFQRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{16}
+}
+func (m *QueryGetRFQRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRFQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRFQRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRFQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRFQRequest.Merge(m, src)
+}
+func (m *QueryGetRFQRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRFQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRFQRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRFQRequest proto.InternalMessageInfo
+
+func (m *QueryGetRFQRequest) GetRfqId() uint64 {
+	if m != nil {
+		return m.RfqId
+	}
+	return 0
+}
+
+// QueryGetRFQResponse is a response message for the GetRFQ query.
+type QueryGetRFQResponse struct {
+	// rfq is the requested request for quote.
+	Rfq *RequestForQuote `protobuf:"bytes,1,opt,name=rfq,proto3" json:"rfq,omitempty"`
+}
+
+func (m *QueryGetRFQResponse) Reset()         { *m = QueryGetRFQResponse{} }
+func (m *QueryGetRFQResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRFQResponse) ProtoMessage()    {}
+func (*QueryGetRFQResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{17}
+}
+func (m *QueryGetRFQResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRFQResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRFQResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetRFQResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRFQResponse.Merge(m, src)
+}
+func (m *QueryGetRFQResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRFQResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRFQResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetRFQResponse proto.InternalMessageInfo
+
+func (m *QueryGetRFQResponse) GetRfq() *RequestForQuote {
+	if m != nil {
+		return m.Rfq
+	}
+	return nil
+}
+
+// QueryGetMarketRFQsRequest is a request message for the GetMarketRFQs query.
+type QueryGetMarketRFQsRequest struct {
+	// market_id is the id of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetMarketRFQsRequest) Reset()         { *m = QueryGetMarketRFQsRequest{} }
+func (m *QueryGetMarketRFQsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketRFQsRequest) ProtoMessage()    {}
+func (*QueryGetMarketRFQsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{18}
+}
+func (m *QueryGetMarketRFQsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketRFQsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketRFQsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketRFQsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketRFQsRequest.Merge(m, src)
+}
+func (m *QueryGetMarketRFQsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketRFQsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketRFQsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketRFQsRequest proto.InternalMessageInfo
+
+func (m *QueryGetMarketRFQsRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryGetMarketRFQsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetMarketRFQsResponse is a response message for the GetMarketRFQs query.
+type QueryGetMarketRFQsResponse struct {
+	// rfqs are the requests for quote in the market.
+	Rfqs []*RequestForQuote `protobuf:"bytes,1,rep,name=rfqs,proto3" json:"rfqs,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetMarketRFQsResponse) Reset()         { *m = QueryGetMarketRFQsResponse{} }
+func (m *QueryGetMarketRFQsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketRFQsResponse) ProtoMessage()    {}
+func (*QueryGetMarketRFQsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{19}
+}
+func (m *QueryGetMarketRFQsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketRFQsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketRFQsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketRFQsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketRFQsResponse.Merge(m, src)
+}
+func (m *QueryGetMarketRFQsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketRFQsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketRFQsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketRFQsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMarketRFQsResponse) GetRfqs() []*RequestForQuote {
+	if m != nil {
+		return m.Rfqs
+	}
+	return nil
+}
+
+func (m *QueryGetMarketRFQsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetCommitmentRequest is a request message for the GetCommitment query.
 type QueryGetCommitmentRequest struct {
 	// account is the bech32 address string of the account in the commitment.
@@ -855,7 +1149,7 @@ func (m *QueryGetCommitmentRequest) Reset()         { *m = QueryGetCommitmentReq
 func (m *QueryGetCommitmentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCommitmentRequest) ProtoMessage()    {}
 func (*QueryGetCommitmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{14}
+	return fileDescriptor_00949b75b1c10bfe, []int{20}
 }
 func (m *QueryGetCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -908,7 +1202,7 @@ func (m *QueryGetCommitmentResponse) Reset()         { *m = QueryGetCommitmentRe
 func (m *QueryGetCommitmentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCommitmentResponse) ProtoMessage()    {}
 func (*QueryGetCommitmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{15}
+	return fileDescriptor_00949b75b1c10bfe, []int{21}
 }
 func (m *QueryGetCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -948,13 +1242,15 @@ func (m *QueryGetCommitmentResponse) GetAmount() github_com_cosmos_cosmos_sdk_ty
 type QueryGetAccountCommitmentsRequest struct {
 	// account is the bech32 address string of the account with the commitments.
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetAccountCommitmentsRequest) Reset()         { *m = QueryGetAccountCommitmentsRequest{} }
 func (m *QueryGetAccountCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAccountCommitmentsRequest) ProtoMessage()    {}
 func (*QueryGetAccountCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{16}
+	return fileDescriptor_00949b75b1c10bfe, []int{22}
 }
 func (m *QueryGetAccountCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,17 +1286,26 @@ func (m *QueryGetAccountCommitmentsRequest) GetAccount() string {
 	return ""
 }
 
+func (m *QueryGetAccountCommitmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetAccountCommitmentsResponse is a response message for the GetAccountCommitments query.
 type QueryGetAccountCommitmentsResponse struct {
 	// commitments is the amounts committed from the account to the any market.
 	Commitments []*MarketAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryGetAccountCommitmentsResponse) Reset()         { *m = QueryGetAccountCommitmentsResponse{} }
 func (m *QueryGetAccountCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAccountCommitmentsResponse) ProtoMessage()    {}
 func (*QueryGetAccountCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{17}
+	return fileDescriptor_00949b75b1c10bfe, []int{23}
 }
 func (m *QueryGetAccountCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,6 +1341,13 @@ func (m *QueryGetAccountCommitmentsResponse) GetCommitments() []*MarketAmount {
 	return nil
 }
 
+func (m *QueryGetAccountCommitmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryGetMarketCommitmentsRequest is a request message for the GetMarketCommitments query.
 type QueryGetMarketCommitmentsRequest struct {
 	// market_id is the numeric identifier of the market with the commitment.
@@ -1048,7 +1360,7 @@ func (m *QueryGetMarketCommitmentsRequest) Reset()         { *m = QueryGetMarket
 func (m *QueryGetMarketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketCommitmentsRequest) ProtoMessage()    {}
 func (*QueryGetMarketCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{18}
+	return fileDescriptor_00949b75b1c10bfe, []int{24}
 }
 func (m *QueryGetMarketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1415,7 @@ func (m *QueryGetMarketCommitmentsResponse) Reset()         { *m = QueryGetMarke
 func (m *QueryGetMarketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketCommitmentsResponse) ProtoMessage()    {}
 func (*QueryGetMarketCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{19}
+	return fileDescriptor_00949b75b1c10bfe, []int{25}
 }
 func (m *QueryGetMarketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,7 +1468,7 @@ func (m *QueryGetAllCommitmentsRequest) Reset()         { *m = QueryGetAllCommit
 func (m *QueryGetAllCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllCommitmentsRequest) ProtoMessage()    {}
 func (*QueryGetAllCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{20}
+	return fileDescriptor_00949b75b1c10bfe, []int{26}
 }
 func (m *QueryGetAllCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1516,7 @@ func (m *QueryGetAllCommitmentsResponse) Reset()         { *m = QueryGetAllCommi
 func (m *QueryGetAllCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllCommitmentsResponse) ProtoMessage()    {}
 func (*QueryGetAllCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{21}
+	return fileDescriptor_00949b75b1c10bfe, []int{27}
 }
 func (m *QueryGetAllCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1257,7 +1569,7 @@ func (m *QueryGetMarketRequest) Reset()         { *m = QueryGetMarketRequest{} }
 func (m *QueryGetMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketRequest) ProtoMessage()    {}
 func (*QueryGetMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{22}
+	return fileDescriptor_00949b75b1c10bfe, []int{28}
 }
 func (m *QueryGetMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1305,7 +1617,7 @@ func (m *QueryGetMarketResponse) Reset()         { *m = QueryGetMarketResponse{}
 func (m *QueryGetMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMarketResponse) ProtoMessage()    {}
 func (*QueryGetMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{23}
+	return fileDescriptor_00949b75b1c10bfe, []int{29}
 }
 func (m *QueryGetMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1358,7 +1670,7 @@ func (m *QueryGetAllMarketsRequest) Reset()         { *m = QueryGetAllMarketsReq
 func (m *QueryGetAllMarketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllMarketsRequest) ProtoMessage()    {}
 func (*QueryGetAllMarketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{24}
+	return fileDescriptor_00949b75b1c10bfe, []int{30}
 }
 func (m *QueryGetAllMarketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1406,7 +1718,7 @@ func (m *QueryGetAllMarketsResponse) Reset()         { *m = QueryGetAllMarketsRe
 func (m *QueryGetAllMarketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllMarketsResponse) ProtoMessage()    {}
 func (*QueryGetAllMarketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{25}
+	return fileDescriptor_00949b75b1c10bfe, []int{31}
 }
 func (m *QueryGetAllMarketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,22 +1761,26 @@ func (m *QueryGetAllMarketsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryParamsRequest is a request message for the Params query.
-type QueryParamsRequest struct {
+// QueryGetMarketExternalSettlementsRequest is a request message for the GetMarketExternalSettlements query.
+type QueryGetMarketExternalSettlementsRequest struct {
+	// market_id is the id of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{26}
+func (m *QueryGetMarketExternalSettlementsRequest) Reset() {
+	*m = QueryGetMarketExternalSettlementsRequest{}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketExternalSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketExternalSettlementsRequest) ProtoMessage()    {}
+func (*QueryGetMarketExternalSettlementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{32}
+}
+func (m *QueryGetMarketExternalSettlementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketExternalSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketExternalSettlementsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1474,32 +1790,310 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryGetMarketExternalSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketExternalSettlementsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryGetMarketExternalSettlementsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryGetMarketExternalSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketExternalSettlementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketExternalSettlementsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is a response message for the Params query.
-type QueryParamsResponse struct {
-	// params are the exchange module parameter values.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryGetMarketExternalSettlementsRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{27}
+// QueryGetMarketExternalSettlementsResponse is a response message for the GetMarketExternalSettlements query.
+type QueryGetMarketExternalSettlementsResponse struct {
+	// settlements is the market's external settlement configuration and pending settlements.
+	Settlements *MarketExternalSettlements `protobuf:"bytes,1,opt,name=settlements,proto3" json:"settlements,omitempty"`
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *QueryGetMarketExternalSettlementsResponse) Reset() {
+	*m = QueryGetMarketExternalSettlementsResponse{}
+}
+func (m *QueryGetMarketExternalSettlementsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetMarketExternalSettlementsResponse) ProtoMessage() {}
+func (*QueryGetMarketExternalSettlementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{33}
+}
+func (m *QueryGetMarketExternalSettlementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketExternalSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketExternalSettlementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketExternalSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketExternalSettlementsResponse.Merge(m, src)
+}
+func (m *QueryGetMarketExternalSettlementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketExternalSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketExternalSettlementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketExternalSettlementsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMarketExternalSettlementsResponse) GetSettlements() *MarketExternalSettlements {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+// QueryGetMarketPriceBandsRequest is a request message for the GetMarketPriceBands query.
+type QueryGetMarketPriceBandsRequest struct {
+	// market_id is the id of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryGetMarketPriceBandsRequest) Reset()         { *m = QueryGetMarketPriceBandsRequest{} }
+func (m *QueryGetMarketPriceBandsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketPriceBandsRequest) ProtoMessage()    {}
+func (*QueryGetMarketPriceBandsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{34}
+}
+func (m *QueryGetMarketPriceBandsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketPriceBandsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketPriceBandsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketPriceBandsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketPriceBandsRequest.Merge(m, src)
+}
+func (m *QueryGetMarketPriceBandsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketPriceBandsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketPriceBandsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketPriceBandsRequest proto.InternalMessageInfo
+
+func (m *QueryGetMarketPriceBandsRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+// QueryGetMarketPriceBandsResponse is a response message for the GetMarketPriceBands query.
+type QueryGetMarketPriceBandsResponse struct {
+	// price_bands is the market's price band configuration and circuit breaker state.
+	PriceBands *MarketPriceBands `protobuf:"bytes,1,opt,name=price_bands,json=priceBands,proto3" json:"price_bands,omitempty"`
+}
+
+func (m *QueryGetMarketPriceBandsResponse) Reset()         { *m = QueryGetMarketPriceBandsResponse{} }
+func (m *QueryGetMarketPriceBandsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketPriceBandsResponse) ProtoMessage()    {}
+func (*QueryGetMarketPriceBandsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{35}
+}
+func (m *QueryGetMarketPriceBandsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketPriceBandsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketPriceBandsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketPriceBandsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketPriceBandsResponse.Merge(m, src)
+}
+func (m *QueryGetMarketPriceBandsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketPriceBandsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketPriceBandsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketPriceBandsResponse proto.InternalMessageInfo
+
+func (m *QueryGetMarketPriceBandsResponse) GetPriceBands() *MarketPriceBands {
+	if m != nil {
+		return m.PriceBands
+	}
+	return nil
+}
+
+// QueryGetMarketRebatesRequest is a request message for the GetMarketRebates query.
+type QueryGetMarketRebatesRequest struct {
+	// market_id is the id of the market to look up.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+}
+
+func (m *QueryGetMarketRebatesRequest) Reset()         { *m = QueryGetMarketRebatesRequest{} }
+func (m *QueryGetMarketRebatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketRebatesRequest) ProtoMessage()    {}
+func (*QueryGetMarketRebatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{36}
+}
+func (m *QueryGetMarketRebatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketRebatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketRebatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketRebatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketRebatesRequest.Merge(m, src)
+}
+func (m *QueryGetMarketRebatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketRebatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketRebatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketRebatesRequest proto.InternalMessageInfo
+
+func (m *QueryGetMarketRebatesRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+// QueryGetMarketRebatesResponse is a response message for the GetMarketRebates query.
+type QueryGetMarketRebatesResponse struct {
+	// rebates is the market's rebate configuration and pool state.
+	Rebates *MarketRebates `protobuf:"bytes,1,opt,name=rebates,proto3" json:"rebates,omitempty"`
+}
+
+func (m *QueryGetMarketRebatesResponse) Reset()         { *m = QueryGetMarketRebatesResponse{} }
+func (m *QueryGetMarketRebatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketRebatesResponse) ProtoMessage()    {}
+func (*QueryGetMarketRebatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{37}
+}
+func (m *QueryGetMarketRebatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMarketRebatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMarketRebatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMarketRebatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketRebatesResponse.Merge(m, src)
+}
+func (m *QueryGetMarketRebatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMarketRebatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketRebatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMarketRebatesResponse proto.InternalMessageInfo
+
+func (m *QueryGetMarketRebatesResponse) GetRebates() *MarketRebates {
+	if m != nil {
+		return m.Rebates
+	}
+	return nil
+}
+
+// QueryParamsRequest is a request message for the Params query.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{38}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is a response message for the Params query.
+type QueryParamsResponse struct {
+	// params are the exchange module parameter values.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{39}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
@@ -1550,7 +2144,7 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Reset() {
 func (m *QueryCommitmentSettlementFeeCalcRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommitmentSettlementFeeCalcRequest) ProtoMessage()    {}
 func (*QueryCommitmentSettlementFeeCalcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{28}
+	return fileDescriptor_00949b75b1c10bfe, []int{40}
 }
 func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1613,7 +2207,7 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Reset() {
 func (m *QueryCommitmentSettlementFeeCalcResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommitmentSettlementFeeCalcResponse) ProtoMessage()    {}
 func (*QueryCommitmentSettlementFeeCalcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{29}
+	return fileDescriptor_00949b75b1c10bfe, []int{41}
 }
 func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1687,7 +2281,7 @@ func (m *QueryValidateCreateMarketRequest) Reset()         { *m = QueryValidateC
 func (m *QueryValidateCreateMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidateCreateMarketRequest) ProtoMessage()    {}
 func (*QueryValidateCreateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{30}
+	return fileDescriptor_00949b75b1c10bfe, []int{42}
 }
 func (m *QueryValidateCreateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1739,7 +2333,7 @@ func (m *QueryValidateCreateMarketResponse) Reset()         { *m = QueryValidate
 func (m *QueryValidateCreateMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidateCreateMarketResponse) ProtoMessage()    {}
 func (*QueryValidateCreateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{31}
+	return fileDescriptor_00949b75b1c10bfe, []int{43}
 }
 func (m *QueryValidateCreateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +2386,7 @@ func (m *QueryValidateMarketRequest) Reset()         { *m = QueryValidateMarketR
 func (m *QueryValidateMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidateMarketRequest) ProtoMessage()    {}
 func (*QueryValidateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{32}
+	return fileDescriptor_00949b75b1c10bfe, []int{44}
 }
 func (m *QueryValidateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1838,7 +2432,7 @@ func (m *QueryValidateMarketResponse) Reset()         { *m = QueryValidateMarket
 func (m *QueryValidateMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidateMarketResponse) ProtoMessage()    {}
 func (*QueryValidateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{33}
+	return fileDescriptor_00949b75b1c10bfe, []int{45}
 }
 func (m *QueryValidateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1884,7 +2478,7 @@ func (m *QueryValidateManageFeesRequest) Reset()         { *m = QueryValidateMan
 func (m *QueryValidateManageFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidateManageFeesRequest) ProtoMessage()    {}
 func (*QueryValidateManageFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{34}
+	return fileDescriptor_00949b75b1c10bfe, []int{46}
 }
 func (m *QueryValidateManageFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1936,7 +2530,7 @@ func (m *QueryValidateManageFeesResponse) Reset()         { *m = QueryValidateMa
 func (m *QueryValidateManageFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidateManageFeesResponse) ProtoMessage()    {}
 func (*QueryValidateManageFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{35}
+	return fileDescriptor_00949b75b1c10bfe, []int{47}
 }
 func (m *QueryValidateManageFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1991,7 +2585,7 @@ func (m *QueryGetPaymentRequest) Reset()         { *m = QueryGetPaymentRequest{}
 func (m *QueryGetPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPaymentRequest) ProtoMessage()    {}
 func (*QueryGetPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{36}
+	return fileDescriptor_00949b75b1c10bfe, []int{48}
 }
 func (m *QueryGetPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2044,7 +2638,7 @@ func (m *QueryGetPaymentResponse) Reset()         { *m = QueryGetPaymentResponse
 func (m *QueryGetPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPaymentResponse) ProtoMessage()    {}
 func (*QueryGetPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{37}
+	return fileDescriptor_00949b75b1c10bfe, []int{49}
 }
 func (m *QueryGetPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,7 +2686,7 @@ func (m *QueryGetPaymentsWithSourceRequest) Reset()         { *m = QueryGetPayme
 func (m *QueryGetPaymentsWithSourceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPaymentsWithSourceRequest) ProtoMessage()    {}
 func (*QueryGetPaymentsWithSourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{38}
+	return fileDescriptor_00949b75b1c10bfe, []int{50}
 }
 func (m *QueryGetPaymentsWithSourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2147,7 +2741,7 @@ func (m *QueryGetPaymentsWithSourceResponse) Reset()         { *m = QueryGetPaym
 func (m *QueryGetPaymentsWithSourceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPaymentsWithSourceResponse) ProtoMessage()    {}
 func (*QueryGetPaymentsWithSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{39}
+	return fileDescriptor_00949b75b1c10bfe, []int{51}
 }
 func (m *QueryGetPaymentsWithSourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2202,7 +2796,7 @@ func (m *QueryGetPaymentsWithTargetRequest) Reset()         { *m = QueryGetPayme
 func (m *QueryGetPaymentsWithTargetRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPaymentsWithTargetRequest) ProtoMessage()    {}
 func (*QueryGetPaymentsWithTargetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{40}
+	return fileDescriptor_00949b75b1c10bfe, []int{52}
 }
 func (m *QueryGetPaymentsWithTargetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2257,7 +2851,7 @@ func (m *QueryGetPaymentsWithTargetResponse) Reset()         { *m = QueryGetPaym
 func (m *QueryGetPaymentsWithTargetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPaymentsWithTargetResponse) ProtoMessage()    {}
 func (*QueryGetPaymentsWithTargetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{41}
+	return fileDescriptor_00949b75b1c10bfe, []int{53}
 }
 func (m *QueryGetPaymentsWithTargetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2310,7 +2904,7 @@ func (m *QueryGetAllPaymentsRequest) Reset()         { *m = QueryGetAllPaymentsR
 func (m *QueryGetAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllPaymentsRequest) ProtoMessage()    {}
 func (*QueryGetAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{42}
+	return fileDescriptor_00949b75b1c10bfe, []int{54}
 }
 func (m *QueryGetAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2358,7 +2952,7 @@ func (m *QueryGetAllPaymentsResponse) Reset()         { *m = QueryGetAllPayments
 func (m *QueryGetAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAllPaymentsResponse) ProtoMessage()    {}
 func (*QueryGetAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{43}
+	return fileDescriptor_00949b75b1c10bfe, []int{55}
 }
 func (m *QueryGetAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2411,7 +3005,7 @@ func (m *QueryPaymentFeeCalcRequest) Reset()         { *m = QueryPaymentFeeCalcR
 func (m *QueryPaymentFeeCalcRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPaymentFeeCalcRequest) ProtoMessage()    {}
 func (*QueryPaymentFeeCalcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{44}
+	return fileDescriptor_00949b75b1c10bfe, []int{56}
 }
 func (m *QueryPaymentFeeCalcRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2459,7 +3053,7 @@ func (m *QueryPaymentFeeCalcResponse) Reset()         { *m = QueryPaymentFeeCalc
 func (m *QueryPaymentFeeCalcResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPaymentFeeCalcResponse) ProtoMessage()    {}
 func (*QueryPaymentFeeCalcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{45}
+	return fileDescriptor_00949b75b1c10bfe, []int{57}
 }
 func (m *QueryPaymentFeeCalcResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2517,6 +3111,12 @@ func init() {
 	proto.RegisterType((*QueryGetAssetOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAssetOrdersResponse")
 	proto.RegisterType((*QueryGetAllOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAllOrdersRequest")
 	proto.RegisterType((*QueryGetAllOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAllOrdersResponse")
+	proto.RegisterType((*QueryGetSettlementReceiptRequest)(nil), "provenance.exchange.v1.QueryGetSettlementReceiptRequest")
+	proto.RegisterType((*QueryGetSettlementReceiptResponse)(nil), "provenance.exchange.v1.QueryGetSettlementReceiptResponse")
+	proto.RegisterType((*QueryGetRFQRequest)(nil), "provenance.exchange.v1.QueryGetRFQRequest")
+	proto.RegisterType((*QueryGetRFQResponse)(nil), "provenance.exchange.v1.QueryGetRFQResponse")
+	proto.RegisterType((*QueryGetMarketRFQsRequest)(nil), "provenance.exchange.v1.QueryGetMarketRFQsRequest")
+	proto.RegisterType((*QueryGetMarketRFQsResponse)(nil), "provenance.exchange.v1.QueryGetMarketRFQsResponse")
 	proto.RegisterType((*QueryGetCommitmentRequest)(nil), "provenance.exchange.v1.QueryGetCommitmentRequest")
 	proto.RegisterType((*QueryGetCommitmentResponse)(nil), "provenance.exchange.v1.QueryGetCommitmentResponse")
 	proto.RegisterType((*QueryGetAccountCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsRequest")
@@ -2529,6 +3129,12 @@ func init() {
 	proto.RegisterType((*QueryGetMarketResponse)(nil), "provenance.exchange.v1.QueryGetMarketResponse")
 	proto.RegisterType((*QueryGetAllMarketsRequest)(nil), "provenance.exchange.v1.QueryGetAllMarketsRequest")
 	proto.RegisterType((*QueryGetAllMarketsResponse)(nil), "provenance.exchange.v1.QueryGetAllMarketsResponse")
+	proto.RegisterType((*QueryGetMarketExternalSettlementsRequest)(nil), "provenance.exchange.v1.QueryGetMarketExternalSettlementsRequest")
+	proto.RegisterType((*QueryGetMarketExternalSettlementsResponse)(nil), "provenance.exchange.v1.QueryGetMarketExternalSettlementsResponse")
+	proto.RegisterType((*QueryGetMarketPriceBandsRequest)(nil), "provenance.exchange.v1.QueryGetMarketPriceBandsRequest")
+	proto.RegisterType((*QueryGetMarketPriceBandsResponse)(nil), "provenance.exchange.v1.QueryGetMarketPriceBandsResponse")
+	proto.RegisterType((*QueryGetMarketRebatesRequest)(nil), "provenance.exchange.v1.QueryGetMarketRebatesRequest")
+	proto.RegisterType((*QueryGetMarketRebatesResponse)(nil), "provenance.exchange.v1.QueryGetMarketRebatesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.exchange.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.exchange.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryCommitmentSettlementFeeCalcRequest)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcRequest")
@@ -2556,159 +3162,186 @@ func init() {
 }
 
 var fileDescriptor_00949b75b1c10bfe = []byte{
-	// 2424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0xdc, 0xd6,
-	0xf5, 0xf6, 0x95, 0x2d, 0x59, 0x3a, 0xb6, 0x65, 0xf8, 0x5a, 0xf6, 0x6f, 0x34, 0xb6, 0x47, 0x32,
-	0xfd, 0x12, 0x64, 0x7b, 0x68, 0x49, 0xb6, 0x22, 0xe7, 0x07, 0xd7, 0x91, 0x94, 0x4a, 0x30, 0xd0,
-	0xd8, 0xca, 0x58, 0xa8, 0x03, 0x01, 0xed, 0x84, 0xe2, 0x5c, 0x8d, 0x09, 0x71, 0xc8, 0x09, 0x49,
-	0x8d, 0x25, 0x08, 0x02, 0xda, 0xf4, 0x11, 0x24, 0x8b, 0xa2, 0x40, 0x17, 0x4d, 0x1b, 0x34, 0x5d,
-	0xb8, 0x68, 0x8b, 0x6c, 0xe2, 0x45, 0xbb, 0x2a, 0x8a, 0x2c, 0xba, 0xa8, 0x37, 0x05, 0x82, 0x76,
-	0xd3, 0x02, 0x45, 0x1b, 0xd8, 0x05, 0xb2, 0x69, 0xff, 0x85, 0xa2, 0xe0, 0xbd, 0x87, 0x43, 0x72,
-	0x86, 0xaf, 0x51, 0x26, 0x86, 0x36, 0x1e, 0x91, 0x3c, 0xe7, 0x9e, 0xef, 0x7c, 0xf7, 0x71, 0xee,
-	0xfd, 0xae, 0x41, 0xaa, 0x5b, 0x66, 0x83, 0x19, 0x8a, 0xa1, 0x32, 0x99, 0x6d, 0xaa, 0x0f, 0x15,
-	0xa3, 0xca, 0xe4, 0xc6, 0x84, 0xfc, 0xd6, 0x06, 0xb3, 0xb6, 0x8a, 0x75, 0xcb, 0x74, 0x4c, 0x7a,
-	0xd2, 0xb7, 0x29, 0x7a, 0x36, 0xc5, 0xc6, 0x44, 0xfe, 0x98, 0x52, 0xd3, 0x0c, 0x53, 0xe6, 0xff,
-	0x0a, 0xd3, 0xfc, 0xb0, 0x6a, 0xda, 0x35, 0xd3, 0x2e, 0xf3, 0x27, 0x59, 0x3c, 0xe0, 0xa7, 0x71,
-	0xf1, 0x24, 0xaf, 0x2a, 0x36, 0x13, 0xcd, 0xcb, 0x8d, 0x89, 0x55, 0xe6, 0x28, 0x13, 0x72, 0x5d,
-	0xa9, 0x6a, 0x86, 0xe2, 0x68, 0xa6, 0x81, 0xb6, 0x85, 0xa0, 0xad, 0x67, 0xa5, 0x9a, 0x9a, 0xf7,
-	0xfd, 0x74, 0xd5, 0x34, 0xab, 0x3a, 0x93, 0x95, 0xba, 0x26, 0x2b, 0x86, 0x61, 0x3a, 0xdc, 0xd9,
-	0x8b, 0x34, 0x54, 0x35, 0xab, 0xa6, 0x40, 0xe0, 0xfe, 0x85, 0x6f, 0xc7, 0x62, 0x32, 0x55, 0xcd,
-	0x5a, 0x4d, 0x73, 0x6a, 0xcc, 0x70, 0x3c, 0xff, 0x73, 0x31, 0x96, 0x35, 0xc5, 0x5a, 0x67, 0x4e,
-	0x8a, 0x91, 0x69, 0x55, 0x98, 0x95, 0xd6, 0x52, 0x5d, 0xb1, 0x94, 0x9a, 0x67, 0x74, 0x21, 0xd6,
-	0x68, 0x2b, 0x88, 0x6a, 0x24, 0xc6, 0xcc, 0xd9, 0x14, 0x06, 0xd2, 0xfb, 0x04, 0x72, 0xaf, 0xbb,
-	0xbc, 0xde, 0x73, 0x21, 0x2c, 0x30, 0x36, 0xaf, 0xe8, 0x6a, 0x89, 0xbd, 0xb5, 0xc1, 0x6c, 0x87,
-	0xde, 0x82, 0x01, 0xc5, 0x5e, 0x2f, 0x73, 0x74, 0xb9, 0x9e, 0x51, 0x32, 0x76, 0x68, 0x72, 0xb4,
-	0x18, 0xdd, 0xaf, 0xc5, 0x59, 0x7b, 0x9d, 0x37, 0x51, 0xea, 0x57, 0xf0, 0x2f, 0xd7, 0x7d, 0x55,
-	0xab, 0xa0, 0xfb, 0xfe, 0x64, 0xf7, 0x39, 0xad, 0x82, 0xee, 0xab, 0xf8, 0x97, 0xf4, 0xa4, 0x07,
-	0x86, 0x23, 0xa0, 0xd9, 0x75, 0xd3, 0xb0, 0x19, 0x7d, 0x1d, 0x86, 0x54, 0x8b, 0xf1, 0x2e, 0x2c,
-	0xaf, 0x31, 0x56, 0x36, 0xeb, 0xbc, 0x37, 0x73, 0x64, 0x74, 0xff, 0xd8, 0xa1, 0xc9, 0xe1, 0x22,
-	0x0e, 0x23, 0x77, 0x30, 0x14, 0x71, 0x30, 0x14, 0xe7, 0x4d, 0xcd, 0x98, 0x3b, 0xf0, 0xf4, 0x1f,
-	0x23, 0xfb, 0x4a, 0xd4, 0x73, 0x5e, 0x60, 0xec, 0x9e, 0x70, 0xa5, 0xdf, 0x84, 0x53, 0x36, 0x73,
-	0x1c, 0x9d, 0xb9, 0x0c, 0x96, 0xd7, 0x74, 0xc5, 0x09, 0xb5, 0xdc, 0x93, 0xad, 0xe5, 0x9c, 0xdf,
-	0xc6, 0x82, 0xae, 0x38, 0x81, 0xf6, 0xdf, 0x84, 0xd3, 0x81, 0xf6, 0x2d, 0x37, 0x7c, 0x28, 0xc0,
-	0xfe, 0x6c, 0x01, 0x86, 0xfd, 0x46, 0x4a, 0x6e, 0x1b, 0x7e, 0x04, 0x69, 0x02, 0x86, 0x38, 0x63,
-	0x8b, 0xcc, 0x11, 0x6c, 0x62, 0x47, 0x0e, 0x43, 0x3f, 0xef, 0x85, 0xb2, 0x56, 0xc9, 0x91, 0x51,
-	0x32, 0x76, 0xa0, 0x74, 0x90, 0x3f, 0xdf, 0xa9, 0x48, 0x5f, 0x83, 0x13, 0x2d, 0x2e, 0x48, 0xf0,
-	0x14, 0xf4, 0x8a, 0x9e, 0x23, 0xbc, 0xe7, 0xce, 0xc4, 0xf5, 0x9c, 0xf0, 0x12, 0xb6, 0xd2, 0x9b,
-	0x30, 0x1a, 0x6a, 0x6d, 0x6e, 0xeb, 0xab, 0x9b, 0x0e, 0xb3, 0x0c, 0x45, 0xbf, 0xf3, 0xaa, 0x07,
-	0xe6, 0x14, 0x0c, 0x88, 0x49, 0xe1, 0xa1, 0x39, 0x52, 0xea, 0x17, 0x2f, 0xee, 0x54, 0xe8, 0x08,
-	0x1c, 0x62, 0xe8, 0xe1, 0x7e, 0x76, 0x07, 0xdd, 0x40, 0x09, 0xbc, 0x57, 0x77, 0x2a, 0xd2, 0x1b,
-	0x70, 0x36, 0x21, 0xc2, 0x17, 0xc1, 0xfe, 0x47, 0x02, 0xa7, 0xbc, 0xa6, 0x5f, 0xe3, 0x78, 0xf8,
-	0x67, 0x3b, 0x13, 0xee, 0x33, 0x00, 0x82, 0x61, 0x67, 0xab, 0xce, 0x10, 0xf6, 0x00, 0x7f, 0xb3,
-	0xbc, 0x55, 0x67, 0xf4, 0x3c, 0x0c, 0x2a, 0x6b, 0x0e, 0xb3, 0xca, 0xcd, 0x6e, 0xd8, 0xcf, 0xbb,
-	0xe1, 0x30, 0x7f, 0x7b, 0x4f, 0xf4, 0x05, 0x5d, 0x00, 0xf0, 0x57, 0xb5, 0x9c, 0xca, 0xb1, 0x5f,
-	0x0c, 0x0d, 0x07, 0xb1, 0xc2, 0x7a, 0x83, 0x62, 0x49, 0xa9, 0x32, 0x44, 0x57, 0x0a, 0x78, 0x4a,
-	0x1f, 0x12, 0x38, 0x1d, 0x9d, 0x09, 0xf2, 0x73, 0x03, 0xfa, 0xc4, 0x92, 0x83, 0xd3, 0x25, 0x85,
-	0x20, 0x34, 0xa6, 0x8b, 0x11, 0xf8, 0x2e, 0xa5, 0xe2, 0x13, 0x31, 0x43, 0x00, 0xff, 0x46, 0x20,
-	0xdf, 0xec, 0xc5, 0x47, 0x06, 0x32, 0xd0, 0x64, 0xba, 0x08, 0xbd, 0xa6, 0xfb, 0x96, 0xb3, 0x3c,
-	0x30, 0x97, 0xfb, 0xf3, 0x6f, 0xae, 0x0e, 0x61, 0x94, 0xd9, 0x4a, 0xc5, 0x62, 0xb6, 0x7d, 0xdf,
-	0xb1, 0x34, 0xa3, 0x5a, 0x12, 0x66, 0x7b, 0x8b, 0xfc, 0x9f, 0x05, 0x86, 0x51, 0x28, 0xb7, 0x3d,
-	0xc2, 0xfd, 0x27, 0x01, 0xee, 0x67, 0x6d, 0xbb, 0x75, 0x94, 0x0f, 0x41, 0xaf, 0xe2, 0xbe, 0x15,
-	0xdc, 0x97, 0xc4, 0xc3, 0xde, 0x65, 0x38, 0x94, 0xc1, 0x1e, 0x61, 0x78, 0x15, 0x4b, 0xaa, 0x0b,
-	0x4f, 0xd7, 0xc3, 0xf4, 0x76, 0x8b, 0x83, 0x0f, 0x08, 0x16, 0xc7, 0x70, 0x90, 0x3d, 0xc2, 0x80,
-	0xee, 0x83, 0x9b, 0x6f, 0xee, 0x94, 0x3c, 0x0a, 0x26, 0xe1, 0xa0, 0xa2, 0xaa, 0xe6, 0x86, 0xe1,
-	0xa4, 0xce, 0x6f, 0xcf, 0x30, 0xbc, 0xf6, 0xf6, 0x84, 0xd7, 0x5e, 0xe9, 0xc7, 0x81, 0x11, 0x1d,
-	0x0c, 0x87, 0x64, 0x6c, 0x41, 0x9f, 0x52, 0xc3, 0x70, 0x29, 0x05, 0x76, 0xc1, 0x2d, 0xb0, 0x1f,
-	0xfd, 0x73, 0x64, 0xac, 0xaa, 0x39, 0x0f, 0x37, 0x56, 0x8b, 0xaa, 0x59, 0xc3, 0xfd, 0x28, 0xfe,
-	0x5c, 0xb5, 0x2b, 0xeb, 0xb2, 0x3b, 0x07, 0x6c, 0xee, 0x60, 0xff, 0xf4, 0xf3, 0x27, 0xe3, 0x87,
-	0x75, 0x56, 0x55, 0xd4, 0xad, 0xb2, 0xbb, 0xd5, 0xb4, 0x7f, 0xfd, 0xf9, 0x93, 0x71, 0x52, 0xc2,
-	0x80, 0xd2, 0x03, 0xbf, 0x58, 0xcd, 0x8a, 0x4c, 0x7c, 0x7c, 0xf6, 0x17, 0xe0, 0x43, 0xd2, 0x41,
-	0x4a, 0x6a, 0x18, 0x33, 0x5f, 0x80, 0x43, 0x81, 0x8d, 0x2a, 0xa6, 0x7f, 0x3e, 0x6e, 0x2c, 0x88,
-	0x4a, 0x31, 0xcb, 0x91, 0x97, 0x82, 0x8e, 0xd2, 0x3b, 0xc4, 0x2f, 0xeb, 0xc2, 0x2a, 0x22, 0x8d,
-	0xc4, 0xf2, 0xd8, 0xad, 0x61, 0xff, 0x5b, 0xe2, 0x33, 0x1a, 0x81, 0x04, 0xf3, 0x5e, 0x8c, 0xca,
-	0xfb, 0x42, 0xec, 0xce, 0x55, 0x10, 0x18, 0x91, 0x78, 0xf7, 0x26, 0x44, 0x15, 0xce, 0x04, 0x66,
-	0x6b, 0x04, 0x7b, 0xdd, 0x22, 0xe8, 0x63, 0x02, 0x85, 0xb8, 0x48, 0xc8, 0xce, 0xab, 0x51, 0xec,
-	0x48, 0x71, 0xec, 0x04, 0x26, 0xd4, 0x97, 0x43, 0xcd, 0x75, 0x7f, 0x03, 0x2a, 0x7a, 0x34, 0xcb,
-	0x80, 0x92, 0xbe, 0x4b, 0xe0, 0x64, 0xab, 0x1b, 0xe6, 0xe7, 0xce, 0x27, 0x31, 0x6b, 0x32, 0xcc,
-	0x27, 0xf1, 0x48, 0xa7, 0xa1, 0x4f, 0x34, 0x8d, 0xc7, 0x9c, 0x42, 0xf2, 0x24, 0x29, 0xa1, 0xb5,
-	0xa4, 0x86, 0x56, 0x61, 0xf1, 0xb1, 0xeb, 0x7d, 0xfa, 0x8b, 0x60, 0xc5, 0x0e, 0x44, 0xc1, 0x7c,
-	0x6f, 0xc1, 0x41, 0x81, 0xc6, 0xeb, 0xcb, 0x73, 0xc9, 0xe0, 0xe7, 0x2c, 0x8d, 0xad, 0x95, 0x3c,
-	0x9f, 0xee, 0x75, 0xe4, 0x10, 0x50, 0x8e, 0x72, 0x89, 0x9f, 0x53, 0x31, 0x11, 0xe9, 0x35, 0x38,
-	0x1e, 0x7a, 0x8b, 0xa0, 0xa7, 0xa1, 0x4f, 0x9c, 0x67, 0x71, 0x8b, 0x1e, 0x4b, 0x38, 0xfa, 0xa1,
-	0xb5, 0xf4, 0x7b, 0x02, 0x97, 0x78, 0x7b, 0xfe, 0xb8, 0xbc, 0xef, 0x9f, 0xb7, 0xc2, 0xc7, 0xd7,
-	0x37, 0x00, 0xfc, 0xa3, 0x12, 0xc6, 0x99, 0x89, 0xe5, 0xc6, 0xae, 0xb6, 0x2e, 0x28, 0xa2, 0xe1,
-	0x66, 0x8f, 0xf8, 0x6d, 0xd1, 0x19, 0xc8, 0x69, 0x86, 0xaa, 0x6f, 0x54, 0x58, 0x79, 0xd5, 0x62,
-	0xca, 0x7a, 0xc5, 0x7c, 0x64, 0x94, 0xd7, 0x34, 0xa6, 0x57, 0x6c, 0x3e, 0x80, 0xfa, 0x4b, 0x27,
-	0xf1, 0xfb, 0x9c, 0xf7, 0x79, 0x81, 0x7f, 0x95, 0x3e, 0x3b, 0x00, 0x63, 0xe9, 0xf8, 0x91, 0xa4,
-	0xef, 0x13, 0x38, 0xe2, 0x61, 0x74, 0x4f, 0x8a, 0xf6, 0x8b, 0xab, 0x60, 0x87, 0xbd, 0xb8, 0x0b,
-	0x8c, 0xd9, 0xf4, 0x6d, 0x02, 0x87, 0x34, 0xa3, 0xbe, 0xe1, 0x94, 0x1d, 0xd3, 0x51, 0xf4, 0xf4,
-	0xa3, 0x70, 0xb7, 0x60, 0x00, 0x8f, 0xba, 0xec, 0x06, 0xa5, 0xef, 0x11, 0x38, 0xaa, 0x9a, 0x46,
-	0x83, 0x59, 0x0e, 0xab, 0x20, 0x90, 0xfd, 0x2f, 0x0a, 0xc8, 0x60, 0x33, 0xb2, 0x00, 0xb3, 0xec,
-	0x61, 0xb1, 0x35, 0xd3, 0x28, 0x1b, 0x4a, 0xc3, 0xce, 0x1d, 0x48, 0x2e, 0x33, 0x77, 0x71, 0xb3,
-	0xba, 0x64, 0x69, 0x2a, 0xc3, 0xa3, 0xfc, 0xa0, 0xdf, 0xc6, 0x5d, 0xa5, 0x61, 0xd3, 0x79, 0x00,
-	0x47, 0x68, 0x02, 0x86, 0xd2, 0xc8, 0xf5, 0xf2, 0x11, 0x9b, 0xad, 0xc1, 0x52, 0xbf, 0x63, 0x2e,
-	0x30, 0x76, 0x57, 0x69, 0x48, 0xef, 0x7a, 0xd5, 0xfa, 0xeb, 0x8a, 0xae, 0x55, 0x14, 0x87, 0xcd,
-	0x5b, 0x4c, 0x71, 0x58, 0x78, 0x71, 0x65, 0x70, 0x82, 0x2b, 0x20, 0xac, 0x8c, 0x6b, 0xac, 0x25,
-	0x3e, 0xe0, 0x34, 0x99, 0x48, 0x98, 0x26, 0x8b, 0x66, 0x23, 0xa2, 0xc5, 0xd2, 0x71, 0xb5, 0xfd,
-	0xa5, 0xb4, 0x86, 0xe5, 0x3a, 0x1a, 0x0a, 0x0e, 0xf3, 0x21, 0xe8, 0x65, 0x96, 0x65, 0x5a, 0xde,
-	0x91, 0x83, 0x3f, 0xd0, 0xcb, 0x40, 0xab, 0x66, 0xa3, 0x5c, 0xb7, 0xcc, 0x7a, 0xf9, 0x91, 0xa6,
-	0xeb, 0xe5, 0xba, 0x62, 0x7b, 0xb3, 0xeb, 0x68, 0xd5, 0x6c, 0x2c, 0x59, 0x66, 0xfd, 0x81, 0xa6,
-	0xeb, 0x4b, 0x8a, 0x6d, 0x4b, 0x37, 0x71, 0x85, 0xf4, 0xe2, 0x74, 0x50, 0x49, 0xa6, 0xf0, 0x30,
-	0xd1, 0xea, 0x9a, 0x04, 0x4e, 0xfa, 0xb6, 0x57, 0x66, 0x7d, 0x2f, 0x43, 0x11, 0x93, 0xc5, 0x0b,
-	0x5a, 0x86, 0xe3, 0x35, 0xfe, 0x92, 0xcf, 0xdc, 0x16, 0x7e, 0xe5, 0x64, 0x7e, 0xdb, 0x5a, 0x2b,
-	0x1d, 0xab, 0xb5, 0xbe, 0x92, 0x2a, 0x30, 0x12, 0x0b, 0xa1, 0x7b, 0xcc, 0xae, 0xfb, 0x75, 0x76,
-	0x49, 0x68, 0x8b, 0x5e, 0x82, 0xd7, 0xa0, 0xcf, 0x36, 0x37, 0x2c, 0x95, 0xa5, 0x96, 0x59, 0xb4,
-	0x4b, 0x17, 0x77, 0x96, 0xe1, 0xff, 0xda, 0x82, 0x61, 0x2a, 0x37, 0xe1, 0x20, 0x6a, 0x9b, 0x48,
-	0xe1, 0x48, 0x7c, 0xc5, 0x10, 0x9e, 0x9e, 0xbd, 0x7b, 0x5e, 0x3c, 0xdb, 0xd2, 0xac, 0xfd, 0x40,
-	0x73, 0x1e, 0xde, 0xe7, 0xa8, 0x76, 0x9f, 0x4e, 0xb7, 0xea, 0xfb, 0x47, 0xc4, 0xdf, 0xcd, 0x47,
-	0xe1, 0x43, 0x06, 0xfe, 0x1f, 0xfa, 0x3d, 0x75, 0x17, 0xeb, 0x40, 0x2a, 0x05, 0x4d, 0x87, 0xee,
-	0x55, 0xf9, 0x38, 0x32, 0x97, 0x15, 0xab, 0xca, 0x82, 0x63, 0xc3, 0xe1, 0x2f, 0xd2, 0xc9, 0x14,
-	0x76, 0x5f, 0x3a, 0x99, 0x1e, 0xbe, 0x3d, 0x45, 0x66, 0x25, 0xb4, 0xb1, 0xf3, 0xe0, 0x76, 0x7b,
-	0xff, 0xf8, 0x38, 0xa8, 0x97, 0x04, 0xc3, 0xec, 0x29, 0x2e, 0xbe, 0x81, 0x5c, 0x60, 0x88, 0x96,
-	0xbd, 0xdc, 0xed, 0x4e, 0xa7, 0x3f, 0x56, 0xd8, 0xe6, 0x22, 0xf0, 0xb8, 0x07, 0x49, 0x68, 0x6d,
-	0x1f, 0x49, 0xf8, 0x16, 0x01, 0x70, 0x0b, 0xaf, 0xa8, 0x62, 0x2f, 0x6e, 0xa3, 0x35, 0xb0, 0xc6,
-	0xb0, 0x2a, 0x36, 0x21, 0x28, 0xaa, 0xca, 0xea, 0xce, 0x8b, 0xdb, 0x64, 0xb9, 0x10, 0x66, 0x79,
-	0xcc, 0xc9, 0x5f, 0x9e, 0x83, 0x5e, 0xce, 0x12, 0xfd, 0x39, 0x81, 0xc3, 0xc1, 0x8b, 0x17, 0x7a,
-	0x2d, 0x8e, 0xf0, 0xb8, 0xeb, 0xa3, 0xfc, 0x44, 0x07, 0x1e, 0xa2, 0x17, 0xa4, 0xf1, 0xb7, 0xff,
-	0xf2, 0xaf, 0x1f, 0xf5, 0x9c, 0xa7, 0x92, 0x1c, 0x73, 0x71, 0xe5, 0xd6, 0x52, 0x71, 0x5d, 0x46,
-	0x7f, 0x42, 0xa0, 0xdf, 0xbb, 0x05, 0xa0, 0x57, 0x12, 0x63, 0xb5, 0xdc, 0x87, 0xe4, 0xaf, 0x66,
-	0xb4, 0x46, 0x54, 0xd7, 0x38, 0xaa, 0x71, 0x3a, 0x26, 0x27, 0xdd, 0xdf, 0xc9, 0xdb, 0x9e, 0xfa,
-	0xb9, 0x43, 0xdf, 0xef, 0x81, 0xa1, 0xa8, 0x1b, 0x0a, 0x3a, 0x93, 0x29, 0x72, 0xc4, 0xb5, 0x49,
-	0xfe, 0xe6, 0x2e, 0x3c, 0x11, 0xff, 0x7b, 0x84, 0x27, 0xf0, 0x1d, 0xb2, 0xf2, 0x0a, 0xfd, 0x8a,
-	0x9c, 0x78, 0x51, 0x29, 0x6f, 0x37, 0x77, 0x4a, 0x3b, 0x5e, 0x5a, 0x81, 0x9a, 0xbd, 0x43, 0x6f,
-	0x27, 0x72, 0x60, 0x47, 0x35, 0x13, 0x6e, 0xe0, 0xdf, 0x04, 0x8e, 0xb6, 0xdc, 0x4b, 0xd0, 0xa9,
-	0xb4, 0xdc, 0x22, 0xee, 0x63, 0xf2, 0xd7, 0x3b, 0x73, 0x42, 0x2e, 0x0c, 0x4e, 0xc5, 0xc3, 0x95,
-	0x29, 0x3a, 0xd1, 0x29, 0x13, 0x76, 0xbc, 0x4b, 0x6c, 0xf2, 0xf4, 0x63, 0x02, 0x83, 0xe1, 0x9b,
-	0x00, 0x3a, 0x99, 0xda, 0x93, 0x6d, 0x57, 0x22, 0xf9, 0xa9, 0x8e, 0x7c, 0x30, 0xd7, 0xeb, 0x3c,
-	0xd7, 0x22, 0xbd, 0x92, 0x02, 0x9b, 0xdf, 0xa2, 0xc8, 0xdb, 0xfc, 0xa7, 0x89, 0x38, 0xa0, 0xac,
-	0xa7, 0x23, 0x6e, 0xbf, 0x48, 0x48, 0x47, 0x1c, 0x21, 0xdd, 0x67, 0x46, 0xcc, 0x6f, 0x25, 0xe4,
-	0x6d, 0xfe, 0xb3, 0x43, 0x3f, 0x20, 0x70, 0x38, 0xa8, 0x83, 0xa7, 0xac, 0x55, 0x11, 0xba, 0x7c,
-	0xca, 0x5a, 0x15, 0x25, 0xb2, 0x4b, 0x17, 0x39, 0xd6, 0x51, 0x5a, 0x48, 0xc6, 0x4a, 0x3f, 0x21,
-	0x70, 0x24, 0xa4, 0x4c, 0xd3, 0xd4, 0x60, 0x6d, 0xa2, 0x79, 0x7e, 0xb2, 0x13, 0x17, 0x04, 0xb8,
-	0xc8, 0x01, 0xce, 0xc6, 0x4f, 0xd9, 0x88, 0x81, 0xee, 0x4b, 0x7c, 0xf2, 0x36, 0x8a, 0xcd, 0x3b,
-	0xf4, 0x4f, 0x04, 0x4e, 0x44, 0x2a, 0xcd, 0x34, 0x75, 0x51, 0x8a, 0x95, 0xbd, 0xf3, 0x2f, 0xef,
-	0xc6, 0x15, 0x33, 0xbb, 0xc5, 0x33, 0x7b, 0x89, 0xde, 0x90, 0xd3, 0xff, 0x7f, 0x86, 0x8c, 0x69,
-	0x04, 0xf2, 0xf9, 0x9e, 0x58, 0x9d, 0xdb, 0x04, 0xe4, 0xf4, 0xd5, 0x39, 0x4e, 0xfd, 0x4e, 0x5f,
-	0x9d, 0x63, 0xd5, 0x6a, 0x69, 0x93, 0x27, 0x63, 0xad, 0xcc, 0xd0, 0xe9, 0x5d, 0x75, 0x94, 0x1d,
-	0xef, 0x17, 0xa4, 0x21, 0x7a, 0x6d, 0x3a, 0xd6, 0xa6, 0x13, 0xd3, 0x1b, 0x19, 0xa6, 0x42, 0x04,
-	0x03, 0xd3, 0x9d, 0xba, 0x61, 0xfa, 0x97, 0x79, 0xfa, 0x17, 0xe8, 0xb9, 0x0c, 0x49, 0xd0, 0x0f,
-	0x09, 0x0c, 0x34, 0xc9, 0xa4, 0x57, 0xb3, 0x91, 0xee, 0x21, 0x2c, 0x66, 0x35, 0x47, 0x64, 0x93,
-	0x1c, 0xd9, 0x15, 0x3a, 0x9e, 0xbd, 0x5b, 0xdc, 0x6d, 0xd3, 0x91, 0x90, 0x4c, 0x4b, 0xb3, 0xac,
-	0x2c, 0x61, 0xe1, 0x38, 0x7d, 0xb2, 0xb7, 0xab, 0xc0, 0xd2, 0x25, 0x0e, 0xf6, 0x2c, 0x1d, 0x49,
-	0x06, 0x6b, 0xd3, 0x77, 0x09, 0xf4, 0x09, 0x51, 0x95, 0x8e, 0x27, 0xc6, 0x09, 0xe9, 0xb8, 0xf9,
-	0xcb, 0x99, 0x6c, 0xb3, 0x2e, 0x8d, 0x42, 0xcd, 0xa5, 0x7f, 0x27, 0x70, 0x2a, 0x41, 0x08, 0xa5,
-	0xb7, 0x13, 0x83, 0xa6, 0x4b, 0xc0, 0xf9, 0x57, 0x76, 0xdf, 0x00, 0xa6, 0xf2, 0x32, 0x4f, 0xe5,
-	0x3a, 0x9d, 0x4c, 0xdc, 0x91, 0xfa, 0x63, 0xb4, 0x1c, 0x90, 0x89, 0xff, 0x40, 0x60, 0x28, 0x4a,
-	0xf9, 0x4a, 0x59, 0x67, 0x12, 0x74, 0xbb, 0x94, 0x75, 0x26, 0x49, 0x66, 0x93, 0xa6, 0x79, 0x26,
-	0xd7, 0x68, 0x31, 0x2e, 0x93, 0x06, 0x7a, 0xcb, 0x21, 0x65, 0x90, 0xfe, 0x87, 0xc0, 0x60, 0x58,
-	0x1c, 0x4b, 0xd9, 0x0f, 0x44, 0x8a, 0x70, 0x29, 0xfb, 0x81, 0x68, 0xf5, 0x4d, 0xb2, 0x38, 0x66,
-	0x7d, 0xe5, 0x06, 0x9d, 0xea, 0x60, 0x6d, 0xf4, 0x12, 0x89, 0x77, 0x6a, 0xa6, 0x1a, 0x31, 0x85,
-	0x7f, 0x47, 0x80, 0xb6, 0x6b, 0x6a, 0x74, 0x3a, 0x23, 0xfe, 0x16, 0x99, 0x2e, 0xff, 0x52, 0xc7,
-	0x7e, 0x59, 0xf7, 0x42, 0x81, 0x24, 0x9a, 0x3a, 0x23, 0xfd, 0x2f, 0x01, 0xf0, 0xa5, 0x0f, 0x9a,
-	0xba, 0xe6, 0x85, 0x45, 0xbd, 0xbc, 0x9c, 0xd9, 0x1e, 0x51, 0xfe, 0x40, 0x9c, 0x2d, 0xde, 0x21,
-	0x2b, 0x09, 0xe7, 0x23, 0x3c, 0x84, 0xcb, 0xdb, 0x42, 0x39, 0xdb, 0x49, 0xaa, 0x75, 0xad, 0xb6,
-	0x2d, 0xc7, 0x87, 0x91, 0x14, 0x3f, 0xfa, 0x54, 0x6c, 0x56, 0xda, 0x85, 0xb4, 0xf4, 0xcd, 0x4a,
-	0xac, 0x38, 0x98, 0xbe, 0x59, 0x89, 0xd7, 0xed, 0xa4, 0x19, 0x4e, 0xd0, 0x24, 0xbd, 0x96, 0x82,
-	0xdc, 0x96, 0x45, 0xc6, 0xcd, 0xcc, 0xa3, 0x52, 0x11, 0x32, 0x56, 0x67, 0xa9, 0x84, 0xa4, 0xb9,
-	0xce, 0x52, 0x09, 0xab, 0x66, 0x1d, 0xa4, 0x22, 0x54, 0x3d, 0x79, 0x5b, 0xfc, 0xee, 0xd0, 0xc7,
-	0x78, 0xa8, 0xf0, 0xe5, 0x27, 0x9a, 0xa5, 0xca, 0xb5, 0x48, 0x62, 0x19, 0x0e, 0x15, 0xed, 0xfa,
-	0x96, 0x34, 0xc6, 0x51, 0x4b, 0x74, 0x34, 0x0d, 0x35, 0xfd, 0x15, 0x81, 0xc1, 0xb0, 0x3e, 0x94,
-	0x82, 0x32, 0x52, 0xac, 0x4a, 0x41, 0x19, 0x2d, 0x40, 0x49, 0x57, 0x38, 0xca, 0x8b, 0xf4, 0x7c,
-	0x62, 0xa1, 0x41, 0xa8, 0x73, 0xec, 0xe9, 0xb3, 0x02, 0xf9, 0xf4, 0x59, 0x81, 0x7c, 0xf6, 0xac,
-	0x40, 0x7e, 0xf8, 0xbc, 0xb0, 0xef, 0xd3, 0xe7, 0x85, 0x7d, 0x7f, 0x7d, 0x5e, 0xd8, 0x07, 0xc3,
-	0x9a, 0x19, 0x13, 0x7e, 0x89, 0xac, 0x14, 0x03, 0x52, 0x91, 0x6f, 0x74, 0x55, 0x33, 0x83, 0x41,
-	0x37, 0x9b, 0x61, 0x57, 0xfb, 0xf8, 0xff, 0x12, 0x9e, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xf2, 0x8a, 0xf8, 0x1c, 0xf2, 0x2d, 0x00, 0x00,
+	// 2854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdd, 0x6f, 0x14, 0xd7,
+	0x15, 0x67, 0x6c, 0x6c, 0xec, 0x63, 0xe2, 0x34, 0x17, 0x93, 0xae, 0x07, 0xb0, 0xcd, 0x04, 0x82,
+	0x63, 0x60, 0xc7, 0x1f, 0x60, 0x6c, 0xa2, 0x00, 0xb6, 0x53, 0x5b, 0x96, 0x1a, 0x30, 0x0b, 0x6a,
+	0x22, 0xa4, 0x76, 0x19, 0xef, 0xde, 0x5d, 0x46, 0xde, 0x9d, 0x59, 0xcf, 0x8c, 0x17, 0x2c, 0xcb,
+	0x52, 0x9a, 0x7e, 0x44, 0xc9, 0x43, 0x5b, 0xa9, 0x0f, 0x4d, 0x1b, 0x35, 0x48, 0xa5, 0x6a, 0xab,
+	0xbc, 0x84, 0x87, 0xe6, 0xa9, 0xaa, 0xa2, 0xaa, 0x0f, 0xe5, 0xa5, 0x52, 0xd4, 0xbe, 0xb4, 0x52,
+	0xd5, 0x46, 0x50, 0x29, 0x2f, 0xed, 0xbf, 0x50, 0x55, 0x73, 0xef, 0x99, 0x9d, 0x99, 0xdd, 0xf9,
+	0x34, 0x8b, 0xe5, 0x17, 0xbc, 0x3b, 0x73, 0xce, 0x3d, 0xbf, 0xf3, 0xbb, 0xdf, 0xe7, 0xb7, 0x80,
+	0x54, 0x33, 0xf4, 0x3a, 0xd5, 0x14, 0xad, 0x40, 0x65, 0x7a, 0xaf, 0x70, 0x47, 0xd1, 0xca, 0x54,
+	0xae, 0x4f, 0xc8, 0xeb, 0x1b, 0xd4, 0xd8, 0xcc, 0xd6, 0x0c, 0xdd, 0xd2, 0xc9, 0x8b, 0xae, 0x4d,
+	0xd6, 0xb1, 0xc9, 0xd6, 0x27, 0xc4, 0x17, 0x94, 0xaa, 0xaa, 0xe9, 0x32, 0xfb, 0x97, 0x9b, 0x8a,
+	0x83, 0x05, 0xdd, 0xac, 0xea, 0x66, 0x9e, 0x7d, 0x93, 0xf9, 0x17, 0x7c, 0x35, 0xc6, 0xbf, 0xc9,
+	0xab, 0x8a, 0x49, 0x79, 0xf3, 0x72, 0x7d, 0x62, 0x95, 0x5a, 0xca, 0x84, 0x5c, 0x53, 0xca, 0xaa,
+	0xa6, 0x58, 0xaa, 0xae, 0xa1, 0xed, 0x90, 0xd7, 0xd6, 0xb1, 0x2a, 0xe8, 0xaa, 0xf3, 0xfe, 0x68,
+	0x59, 0xd7, 0xcb, 0x15, 0x2a, 0x2b, 0x35, 0x55, 0x56, 0x34, 0x4d, 0xb7, 0x98, 0xb3, 0x13, 0x69,
+	0xa0, 0xac, 0x97, 0x75, 0x8e, 0xc0, 0xfe, 0x84, 0x4f, 0x47, 0x43, 0x32, 0x2d, 0xe8, 0xd5, 0xaa,
+	0x6a, 0x55, 0xa9, 0x66, 0x39, 0xfe, 0xe3, 0x21, 0x96, 0xf4, 0x9e, 0x45, 0x0d, 0x4d, 0xa9, 0xe4,
+	0x4d, 0x6a, 0x59, 0x15, 0x6a, 0xbb, 0xa0, 0xc7, 0x4b, 0x21, 0x1e, 0x55, 0xc5, 0x58, 0xa3, 0x71,
+	0x46, 0xba, 0x51, 0xa4, 0x86, 0x19, 0x63, 0x54, 0x53, 0x0c, 0xa5, 0xea, 0x18, 0x9d, 0x0c, 0x35,
+	0xda, 0xf4, 0xe6, 0x71, 0x2a, 0xcc, 0xcc, 0x50, 0x0b, 0x34, 0xbf, 0xaa, 0x68, 0x45, 0x34, 0x3c,
+	0x11, 0x62, 0x68, 0xd0, 0x55, 0xc5, 0xa2, 0x71, 0x51, 0x0d, 0x5a, 0xa0, 0x6a, 0xad, 0x11, 0x75,
+	0x24, 0xcc, 0xac, 0xb4, 0x8e, 0x16, 0xc3, 0x21, 0x16, 0xd6, 0x3d, 0x6e, 0x20, 0x7d, 0x20, 0x40,
+	0xe6, 0xba, 0x3d, 0x42, 0xae, 0xd9, 0xd4, 0x2c, 0x52, 0xba, 0xa0, 0x54, 0x0a, 0x39, 0xba, 0xbe,
+	0x41, 0x4d, 0x8b, 0xbc, 0x06, 0xbd, 0x8a, 0xb9, 0x96, 0x67, 0xac, 0x65, 0x3a, 0x46, 0x84, 0xd1,
+	0xbe, 0xc9, 0x91, 0x6c, 0xf0, 0x08, 0xcd, 0xce, 0x99, 0x6b, 0xac, 0x89, 0x5c, 0x8f, 0x82, 0x9f,
+	0x6c, 0xf7, 0x55, 0xb5, 0x88, 0xee, 0x9d, 0xd1, 0xee, 0xf3, 0x6a, 0x11, 0xdd, 0x57, 0xf1, 0x93,
+	0xf4, 0xb0, 0x03, 0x06, 0x03, 0xa0, 0x99, 0x35, 0x5d, 0x33, 0x29, 0xb9, 0x0e, 0x03, 0x05, 0x83,
+	0xb2, 0xc1, 0x98, 0x2f, 0x51, 0x9a, 0xd7, 0x6b, 0xf6, 0x47, 0x33, 0x23, 0x8c, 0x74, 0x8e, 0xf6,
+	0x4d, 0x0e, 0x66, 0x71, 0x42, 0xd8, 0xc3, 0x3a, 0x8b, 0xc3, 0x3a, 0xbb, 0xa0, 0xab, 0xda, 0xfc,
+	0xfe, 0x47, 0xff, 0x1c, 0xde, 0x97, 0x23, 0x8e, 0xf3, 0x22, 0xa5, 0xd7, 0xb8, 0x2b, 0xf9, 0x16,
+	0x1c, 0x71, 0x87, 0x5b, 0xbe, 0x54, 0x51, 0x2c, 0x5f, 0xcb, 0x1d, 0xc9, 0x5a, 0xce, 0xb8, 0x6d,
+	0x2c, 0x56, 0x14, 0xcb, 0xd3, 0xfe, 0x6d, 0x38, 0xea, 0x69, 0xdf, 0xb0, 0xc3, 0xfb, 0x02, 0x74,
+	0x26, 0x0b, 0x30, 0xe8, 0x36, 0x92, 0xb3, 0xdb, 0x70, 0x23, 0x48, 0x13, 0x30, 0xc0, 0x18, 0x5b,
+	0xa2, 0x16, 0x67, 0x13, 0x3b, 0x72, 0x10, 0x7a, 0x58, 0x2f, 0xe4, 0xd5, 0x62, 0x46, 0x18, 0x11,
+	0x46, 0xf7, 0xe7, 0x0e, 0xb0, 0xef, 0xcb, 0x45, 0xe9, 0xeb, 0x70, 0xb8, 0xc9, 0x05, 0x09, 0x9e,
+	0x82, 0x2e, 0xde, 0x73, 0x02, 0xeb, 0xb9, 0x63, 0x61, 0x3d, 0xc7, 0xbd, 0xb8, 0xad, 0x74, 0x1b,
+	0x46, 0x7c, 0xad, 0xcd, 0x6f, 0x7e, 0x0d, 0x67, 0xf2, 0xf2, 0xeb, 0x0e, 0x98, 0x23, 0xd0, 0xcb,
+	0x27, 0xab, 0x83, 0xe6, 0xb9, 0x5c, 0x0f, 0x7f, 0xb0, 0x5c, 0x24, 0xc3, 0xd0, 0xd7, 0x98, 0xfb,
+	0x6a, 0x91, 0x0d, 0xba, 0xde, 0x1c, 0x38, 0x8f, 0x96, 0x8b, 0xd2, 0x5b, 0x70, 0x3c, 0x22, 0xc2,
+	0xd3, 0x60, 0xff, 0x93, 0x00, 0x47, 0x9c, 0xa6, 0xdf, 0x60, 0x78, 0xd8, 0x6b, 0x33, 0x11, 0xee,
+	0x63, 0x00, 0x9c, 0x61, 0x6b, 0xb3, 0x46, 0x11, 0x76, 0x2f, 0x7b, 0x72, 0x73, 0xb3, 0x46, 0xc9,
+	0x09, 0xe8, 0x57, 0x4a, 0x16, 0x35, 0xf2, 0x8d, 0x6e, 0xe8, 0x64, 0xdd, 0x70, 0x90, 0x3d, 0xbd,
+	0xc6, 0xfb, 0x82, 0x2c, 0x02, 0xb8, 0xeb, 0x73, 0xa6, 0xc0, 0xb0, 0xbf, 0xec, 0x1b, 0x0e, 0x7c,
+	0xaf, 0x70, 0x06, 0xc5, 0x8a, 0x52, 0xa6, 0x88, 0x2e, 0xe7, 0xf1, 0x94, 0x3e, 0x12, 0xe0, 0x68,
+	0x70, 0x26, 0xc8, 0xcf, 0x79, 0xe8, 0xe6, 0x4b, 0x21, 0x4e, 0x97, 0x18, 0x82, 0xd0, 0x98, 0x2c,
+	0x05, 0xe0, 0x3b, 0x15, 0x8b, 0x8f, 0xc7, 0xf4, 0x01, 0xfc, 0xbb, 0x00, 0x62, 0xa3, 0x17, 0xef,
+	0x6a, 0xd4, 0xf0, 0x33, 0x9d, 0x85, 0x2e, 0xdd, 0x7e, 0xca, 0x58, 0xee, 0x9d, 0xcf, 0xfc, 0xe5,
+	0xb7, 0x67, 0x07, 0x30, 0xca, 0x5c, 0xb1, 0x68, 0x50, 0xd3, 0xbc, 0x61, 0x19, 0xaa, 0x56, 0xce,
+	0x71, 0xb3, 0xbd, 0x45, 0xfe, 0xcf, 0x3d, 0xc3, 0xc8, 0x97, 0xdb, 0x1e, 0xe1, 0xfe, 0x33, 0x0f,
+	0xf7, 0x73, 0xa6, 0xd9, 0x3c, 0xca, 0x07, 0xa0, 0x4b, 0xb1, 0x9f, 0x72, 0xee, 0x73, 0xfc, 0xcb,
+	0xde, 0x65, 0xd8, 0x97, 0xc1, 0x1e, 0x61, 0x78, 0x15, 0x32, 0x0d, 0x78, 0x95, 0x8a, 0x9f, 0xde,
+	0x76, 0x71, 0xf0, 0xa1, 0x00, 0x83, 0x01, 0x41, 0xf6, 0x08, 0x03, 0x73, 0xee, 0x36, 0x70, 0xc3,
+	0xdd, 0xac, 0xf8, 0xe1, 0xc5, 0x61, 0xe2, 0x18, 0x00, 0x1e, 0x67, 0xdc, 0x5d, 0xa9, 0x17, 0x9f,
+	0x2c, 0x17, 0xa5, 0x3b, 0x70, 0x3c, 0xa2, 0x09, 0xcc, 0x73, 0x01, 0x0e, 0xa0, 0x07, 0xae, 0xf4,
+	0xaf, 0x84, 0x25, 0xda, 0xda, 0x86, 0xe3, 0x29, 0x9d, 0x06, 0xe2, 0x44, 0xca, 0x2d, 0x5e, 0x77,
+	0xe0, 0x1d, 0x86, 0x6e, 0xa3, 0xb4, 0xee, 0x42, 0xeb, 0x32, 0x4a, 0xeb, 0xcb, 0x45, 0x69, 0x05,
+	0x0e, 0xf9, 0x8c, 0x11, 0xc8, 0x2c, 0x74, 0x1a, 0xa5, 0x75, 0x04, 0x71, 0x2a, 0x0c, 0x04, 0xb6,
+	0xbd, 0xa8, 0x1b, 0xd7, 0x37, 0x74, 0x8b, 0xe6, 0x6c, 0x1f, 0xe9, 0x6d, 0x4f, 0x4f, 0xf2, 0xc5,
+	0x3a, 0xb7, 0x78, 0x3d, 0xd9, 0xa6, 0xd3, 0xae, 0xc1, 0xf4, 0x0b, 0xcf, 0x92, 0xe0, 0x85, 0x80,
+	0xc9, 0xbd, 0x0a, 0xfb, 0x8d, 0xd2, 0xba, 0x33, 0x96, 0x12, 0x67, 0xc7, 0x9c, 0xda, 0x37, 0xa6,
+	0x2a, 0x2e, 0x4d, 0x0b, 0x8d, 0x7b, 0x84, 0x43, 0xd3, 0x24, 0x1c, 0x50, 0x0a, 0x05, 0x7d, 0x43,
+	0xb3, 0x62, 0xf7, 0x0c, 0xc7, 0xd0, 0x4f, 0x6d, 0x87, 0x9f, 0x5a, 0xe9, 0x27, 0x1e, 0x4a, 0xbc,
+	0xe1, 0x90, 0x92, 0x4d, 0xe8, 0x56, 0xaa, 0x18, 0x2e, 0xe6, 0xd0, 0xb6, 0x68, 0x1f, 0xda, 0x3e,
+	0xfe, 0xd7, 0xf0, 0x68, 0x59, 0xb5, 0xee, 0x6c, 0xac, 0x66, 0x0b, 0x7a, 0x15, 0x6f, 0x6b, 0xf8,
+	0xe7, 0xac, 0x59, 0x5c, 0x93, 0xed, 0x75, 0xd5, 0x64, 0x0e, 0xe6, 0xcf, 0xbe, 0x7c, 0x38, 0x76,
+	0xb0, 0x42, 0xcb, 0x4a, 0x61, 0x33, 0x6f, 0x5f, 0xc4, 0xcc, 0xdf, 0x7c, 0xf9, 0x70, 0x4c, 0xc8,
+	0x61, 0x40, 0xe9, 0xbe, 0xe0, 0xce, 0x8c, 0x39, 0x9e, 0x8a, 0x0b, 0xd0, 0x7c, 0x1a, 0x42, 0xda,
+	0x35, 0x9c, 0x3e, 0x15, 0x40, 0x8a, 0x42, 0x88, 0x1c, 0x2e, 0x42, 0x9f, 0xe7, 0x42, 0x88, 0x44,
+	0x9e, 0x08, 0x1b, 0x5d, 0x7c, 0x5c, 0xce, 0x31, 0x0e, 0x72, 0x5e, 0xc7, 0xf6, 0x8d, 0xb0, 0x77,
+	0x05, 0x77, 0xd9, 0xe2, 0xe1, 0x02, 0x88, 0xdd, 0x95, 0x09, 0xf9, 0xa9, 0xa7, 0x8f, 0x03, 0x90,
+	0x20, 0x81, 0x4b, 0x41, 0x04, 0x9e, 0x0c, 0xbd, 0xa0, 0xf1, 0x9e, 0x78, 0xa6, 0x0c, 0x96, 0xe1,
+	0x98, 0x67, 0x53, 0x0a, 0x60, 0xaf, 0x5d, 0x04, 0x7d, 0x22, 0xc0, 0x50, 0x58, 0x24, 0x64, 0xe7,
+	0xf5, 0x20, 0x76, 0xa4, 0x30, 0x76, 0x3c, 0x73, 0xfc, 0xd9, 0x50, 0x73, 0x0e, 0x0e, 0xfb, 0x7b,
+	0x34, 0xc9, 0x80, 0x92, 0xbe, 0x2b, 0xc0, 0x8b, 0xcd, 0x6e, 0x98, 0x9f, 0x3d, 0xc3, 0xf9, 0x3c,
+	0x4e, 0x30, 0xc3, 0xf9, 0x57, 0x32, 0x0d, 0xdd, 0xbc, 0x69, 0xbc, 0xcd, 0x0f, 0x45, 0xcf, 0xb6,
+	0x1c, 0x5a, 0x4b, 0x05, 0xdf, 0x61, 0x83, 0xbf, 0x6c, 0x7b, 0x9f, 0xfe, 0xd2, 0x7b, 0x30, 0xf5,
+	0x44, 0xc1, 0x7c, 0x5f, 0x83, 0x03, 0x1c, 0x8d, 0xd3, 0x97, 0x2f, 0x45, 0x83, 0x9f, 0x37, 0x54,
+	0x5a, 0xca, 0x39, 0x3e, 0xed, 0xeb, 0xc8, 0x25, 0x18, 0xf5, 0xf7, 0x88, 0x73, 0xff, 0x74, 0x8f,
+	0x18, 0x89, 0x16, 0x0b, 0x7b, 0xe3, 0x7f, 0x25, 0x41, 0x4b, 0x98, 0xfe, 0x0d, 0xe8, 0x73, 0xef,
+	0xfd, 0x26, 0x9e, 0x34, 0x26, 0xa2, 0x29, 0x08, 0x6a, 0xcf, 0xdb, 0x8a, 0x74, 0x09, 0x86, 0xfd,
+	0x08, 0x56, 0x0c, 0xb5, 0x40, 0xe7, 0x15, 0xad, 0x98, 0x2c, 0x85, 0x2a, 0x8c, 0x84, 0xfb, 0x23,
+	0xf0, 0x65, 0xe8, 0x73, 0xab, 0x60, 0x0e, 0xf0, 0xd1, 0x68, 0xe0, 0x9e, 0x66, 0xa0, 0xd6, 0xf8,
+	0x2c, 0xbd, 0xda, 0x7c, 0xad, 0xcd, 0xf1, 0xaa, 0x59, 0x22, 0xac, 0xb7, 0xe1, 0x58, 0x88, 0x33,
+	0x02, 0xbd, 0x6c, 0x1f, 0x26, 0xd9, 0x23, 0x04, 0x79, 0x32, 0x66, 0x76, 0xa0, 0xbf, 0xe3, 0x25,
+	0x0d, 0xe0, 0x41, 0x72, 0x85, 0x15, 0x10, 0x11, 0x94, 0xf4, 0x06, 0x1c, 0xf2, 0x3d, 0xc5, 0x68,
+	0xd3, 0xd0, 0xcd, 0x0b, 0x8d, 0x19, 0x21, 0x7a, 0x2a, 0xa2, 0x1f, 0x5a, 0x4b, 0xbf, 0x17, 0xe0,
+	0x14, 0x6b, 0xcf, 0x5d, 0xb1, 0xdc, 0xee, 0x6d, 0xaa, 0xdf, 0xbd, 0x05, 0xe0, 0xf6, 0x36, 0xc6,
+	0x99, 0x09, 0x4d, 0xca, 0x2c, 0x37, 0x6f, 0x35, 0xbc, 0xe1, 0xc6, 0x5c, 0x75, 0xdb, 0x22, 0x33,
+	0x90, 0x51, 0xb5, 0x42, 0x65, 0xa3, 0x48, 0xf3, 0xab, 0x06, 0x55, 0xd6, 0x8a, 0xfa, 0x5d, 0x2d,
+	0x5f, 0x52, 0x69, 0xa5, 0x68, 0xb2, 0xa5, 0xa5, 0x27, 0xf7, 0x22, 0xbe, 0x9f, 0x77, 0x5e, 0x2f,
+	0xb2, 0xb7, 0xd2, 0x17, 0xfb, 0x71, 0xfe, 0x44, 0xe2, 0x47, 0x92, 0xbe, 0x2f, 0xc0, 0x73, 0x0e,
+	0x46, 0xbb, 0x54, 0x66, 0xee, 0xde, 0x71, 0xeb, 0xa0, 0x13, 0x77, 0x91, 0x52, 0x93, 0xbc, 0x23,
+	0x40, 0x9f, 0xaa, 0xd5, 0x36, 0xac, 0xbc, 0xa5, 0x5b, 0x4a, 0x25, 0xd3, 0xb1, 0x5b, 0x30, 0x80,
+	0x45, 0xbd, 0x69, 0x07, 0x25, 0xef, 0x0b, 0xf0, 0x7c, 0x41, 0xd7, 0xea, 0xd4, 0xb0, 0x68, 0x11,
+	0x81, 0x74, 0xee, 0x16, 0x90, 0xfe, 0x46, 0x64, 0x0e, 0xe6, 0xa6, 0x83, 0xc5, 0xb4, 0x2b, 0xb0,
+	0x9a, 0x52, 0x37, 0x33, 0xfb, 0xa3, 0x0f, 0x20, 0x57, 0xf1, 0xb6, 0xce, 0x27, 0x37, 0xaf, 0x65,
+	0xf6, 0xbb, 0x6d, 0x5c, 0x55, 0xea, 0x26, 0x59, 0x00, 0xb0, 0x78, 0x51, 0x54, 0x53, 0xea, 0x99,
+	0xae, 0x11, 0x21, 0x71, 0x83, 0xb9, 0x1e, 0xcb, 0xae, 0x84, 0x5e, 0x55, 0xea, 0xd2, 0x7b, 0xce,
+	0x39, 0xee, 0x1b, 0x4a, 0x45, 0x2d, 0x2a, 0x16, 0x5d, 0x30, 0xa8, 0x62, 0x51, 0xff, 0xb6, 0x4b,
+	0xe1, 0x30, 0x2b, 0x01, 0xd3, 0x3c, 0x2e, 0x19, 0x06, 0x7f, 0x11, 0xbb, 0xb2, 0x9a, 0xe5, 0x25,
+	0xbd, 0x1e, 0xd0, 0x62, 0xee, 0x50, 0xa1, 0xf5, 0xa1, 0x54, 0x82, 0xe3, 0x11, 0x50, 0x70, 0x98,
+	0x0f, 0x40, 0x17, 0x35, 0x0c, 0xdd, 0x70, 0x6a, 0x2e, 0xec, 0x0b, 0x39, 0x0d, 0xa4, 0xac, 0xd7,
+	0x6d, 0x7d, 0xa7, 0x96, 0xbf, 0xab, 0x56, 0x2a, 0xf9, 0x9a, 0x62, 0x3a, 0xb3, 0xeb, 0xf9, 0xb2,
+	0x5e, 0x5f, 0x31, 0xf4, 0xda, 0x9b, 0x6a, 0xa5, 0xb2, 0xa2, 0x98, 0xa6, 0x34, 0x0b, 0xa2, 0x2f,
+	0x4e, 0x8a, 0x33, 0xc6, 0x14, 0x1c, 0x09, 0x74, 0x8d, 0x02, 0x27, 0x7d, 0xdb, 0x39, 0x80, 0xb9,
+	0x5e, 0x9a, 0xc2, 0x27, 0x8b, 0x13, 0x34, 0x0f, 0x87, 0xaa, 0xec, 0x21, 0x9b, 0xb9, 0x4d, 0xfc,
+	0xca, 0xd1, 0xfc, 0xb6, 0xb4, 0x96, 0x7b, 0xa1, 0xda, 0xfc, 0x48, 0x2a, 0xc2, 0x70, 0x28, 0x84,
+	0xf6, 0x31, 0xbb, 0xe6, 0x9e, 0xc0, 0x56, 0xb8, 0xe8, 0xe3, 0x24, 0x38, 0x0e, 0xdd, 0xa6, 0xbe,
+	0x61, 0x14, 0x68, 0xec, 0x01, 0x0c, 0xed, 0xe2, 0xab, 0xdb, 0x37, 0xe1, 0xab, 0x2d, 0xc1, 0x1a,
+	0x25, 0x86, 0x03, 0x28, 0x3a, 0x21, 0x85, 0xc3, 0xe1, 0x3b, 0x06, 0xf7, 0x74, 0xec, 0xed, 0x82,
+	0xd9, 0xf1, 0xa6, 0x66, 0xcd, 0x37, 0x55, 0xeb, 0xce, 0x0d, 0x86, 0x6a, 0xe7, 0xe9, 0xb4, 0xeb,
+	0xe4, 0xf7, 0xb1, 0xe7, 0xc2, 0x18, 0x84, 0xaf, 0x51, 0x87, 0xe8, 0xc1, 0x8c, 0x9c, 0x7d, 0x20,
+	0x96, 0x82, 0x86, 0x43, 0xfb, 0xce, 0x7f, 0x61, 0x64, 0xde, 0x54, 0x8c, 0x32, 0xf5, 0x8e, 0x0d,
+	0x8b, 0x3d, 0x88, 0x27, 0x93, 0xdb, 0x3d, 0x73, 0x32, 0x1d, 0x7c, 0x7b, 0x8a, 0xcc, 0xa2, 0xef,
+	0xc8, 0xef, 0xc0, 0x6d, 0xf7, 0xcd, 0xe2, 0x81, 0xb7, 0x60, 0xec, 0x0d, 0xb3, 0xa7, 0xb8, 0xf8,
+	0x26, 0x72, 0x81, 0x21, 0x9a, 0xce, 0x72, 0x97, 0xd3, 0x4e, 0x7f, 0xdc, 0x61, 0x1b, 0x8b, 0xc0,
+	0x83, 0x0e, 0x24, 0xa1, 0xb9, 0x7d, 0x24, 0xe1, 0x6d, 0x01, 0xc0, 0xde, 0x78, 0xf9, 0x2e, 0xb6,
+	0x7b, 0x07, 0xad, 0xde, 0x12, 0xc5, 0x5d, 0xb1, 0x01, 0x41, 0x29, 0x14, 0x68, 0xcd, 0xca, 0x74,
+	0xec, 0x26, 0x84, 0x39, 0x16, 0x73, 0xf2, 0x57, 0x67, 0xa0, 0x8b, 0xb1, 0x44, 0xee, 0x0b, 0x70,
+	0xd0, 0xab, 0x3c, 0x93, 0xf1, 0x30, 0xc2, 0xc3, 0xf4, 0x73, 0x71, 0x22, 0x85, 0x07, 0xef, 0x05,
+	0x69, 0xec, 0x9d, 0xbf, 0xfe, 0xfb, 0xc7, 0x1d, 0x27, 0x88, 0x24, 0x87, 0x28, 0xf7, 0xf6, 0x5e,
+	0xca, 0x7f, 0xc7, 0x40, 0x7e, 0x2a, 0x40, 0x8f, 0x23, 0x83, 0x92, 0x33, 0x91, 0xb1, 0x9a, 0x04,
+	0x61, 0xf1, 0x6c, 0x42, 0x6b, 0x44, 0x35, 0xce, 0x50, 0x8d, 0x91, 0x51, 0x39, 0xea, 0x87, 0x15,
+	0xf2, 0x96, 0x23, 0xff, 0x6c, 0x93, 0x0f, 0x3a, 0x60, 0x20, 0x48, 0xa2, 0x25, 0x33, 0x89, 0x22,
+	0x07, 0xe8, 0xc6, 0xe2, 0xec, 0x0e, 0x3c, 0x11, 0xff, 0xfb, 0x02, 0x4b, 0xe0, 0x3b, 0x02, 0xb9,
+	0x1c, 0x99, 0x81, 0x89, 0x3f, 0x23, 0x91, 0xb7, 0x1a, 0xc7, 0xa5, 0x6d, 0x79, 0xcb, 0xb3, 0x65,
+	0x6f, 0xdf, 0xba, 0x42, 0x2e, 0xc9, 0x91, 0x3f, 0x41, 0xf1, 0xf9, 0x22, 0x2f, 0xde, 0x16, 0xc8,
+	0x7f, 0x04, 0x78, 0xbe, 0x49, 0x98, 0x25, 0x53, 0x71, 0xb9, 0x05, 0x08, 0xd2, 0xe2, 0xb9, 0x74,
+	0x4e, 0xc8, 0x85, 0xc6, 0xa8, 0xb8, 0x43, 0x26, 0x52, 0x33, 0x71, 0x6b, 0x2a, 0xdc, 0x29, 0x2c,
+	0x77, 0x93, 0x7c, 0x22, 0x40, 0xbf, 0x5f, 0x0a, 0x25, 0x93, 0xb1, 0x3d, 0xd9, 0xa2, 0x09, 0x8b,
+	0x53, 0xa9, 0x7c, 0x30, 0xd7, 0x73, 0x2c, 0xd7, 0x2c, 0x39, 0x13, 0x93, 0x2b, 0x93, 0x91, 0xe5,
+	0x2d, 0xf6, 0x67, 0xdb, 0x41, 0xec, 0x91, 0x16, 0xe3, 0x11, 0xb7, 0x2a, 0xa9, 0xe2, 0x54, 0x2a,
+	0x9f, 0x94, 0x88, 0x99, 0x2c, 0x2b, 0x6f, 0xb1, 0x3f, 0xdb, 0xe4, 0x43, 0x01, 0x0e, 0x7a, 0x85,
+	0xc0, 0x98, 0xb5, 0x2a, 0x40, 0x98, 0x14, 0x27, 0x52, 0x78, 0x20, 0xd6, 0x97, 0x19, 0xd6, 0x11,
+	0x32, 0x14, 0x8d, 0x95, 0xfc, 0x41, 0x60, 0x6b, 0x41, 0x8b, 0x04, 0x17, 0xbf, 0x16, 0x84, 0x89,
+	0x87, 0xe2, 0xec, 0x0e, 0x3c, 0x93, 0x32, 0x8c, 0xba, 0xa0, 0xbc, 0xe5, 0xca, 0x93, 0xdb, 0xe4,
+	0x87, 0x02, 0x74, 0x73, 0xcd, 0x8f, 0x8c, 0xc5, 0xc5, 0x76, 0x55, 0x44, 0xf1, 0x74, 0x22, 0x5b,
+	0x44, 0x76, 0x86, 0x21, 0x7b, 0x99, 0x9c, 0x90, 0xc3, 0x7f, 0xd7, 0x25, 0x6f, 0x71, 0x55, 0x92,
+	0x8d, 0xd2, 0xe7, 0x7c, 0x7a, 0x1d, 0x99, 0x48, 0xb6, 0x1e, 0x78, 0xe4, 0x45, 0x71, 0x32, 0x8d,
+	0x0b, 0xc2, 0xbc, 0xc0, 0x60, 0x4e, 0x10, 0x39, 0xc5, 0x5a, 0xc0, 0xa4, 0xc0, 0xcf, 0x38, 0x62,
+	0xb7, 0xf0, 0x13, 0x8f, 0xb8, 0x45, 0xe9, 0x13, 0x27, 0xd3, 0xb8, 0x20, 0xe2, 0x25, 0x86, 0x78,
+	0x2e, 0x7c, 0xf1, 0x0f, 0x40, 0xec, 0x8a, 0x00, 0xf2, 0x16, 0x0a, 0x64, 0xdb, 0xe4, 0xcf, 0x02,
+	0x1c, 0x0e, 0x14, 0xb5, 0x48, 0xec, 0x80, 0x0c, 0x95, 0xea, 0xc4, 0x8b, 0x3b, 0x71, 0xc5, 0xcc,
+	0x5e, 0x63, 0x99, 0x5d, 0x20, 0xe7, 0xe5, 0xf8, 0x9f, 0x5c, 0xca, 0x98, 0x86, 0x27, 0x9f, 0xef,
+	0xf1, 0x5d, 0xba, 0x45, 0x62, 0x8a, 0x9f, 0x99, 0x61, 0xfa, 0x98, 0x38, 0xbb, 0x03, 0x4f, 0x4c,
+	0xe6, 0x1e, 0x4b, 0xc6, 0x20, 0xd3, 0x49, 0x92, 0x09, 0xd8, 0x9e, 0x66, 0xc2, 0x3d, 0x23, 0x3b,
+	0x98, 0xed, 0x51, 0x2f, 0xb4, 0x28, 0x49, 0xe4, 0x7c, 0x82, 0x25, 0x31, 0x80, 0x81, 0xe9, 0xb4,
+	0x6e, 0x98, 0xfe, 0x69, 0x96, 0xfe, 0x49, 0xf2, 0x52, 0x82, 0xf4, 0xc9, 0x47, 0x02, 0xf4, 0x36,
+	0xc8, 0x24, 0x67, 0x13, 0x4e, 0x63, 0x44, 0x98, 0x4d, 0x6a, 0x8e, 0xc8, 0x26, 0x19, 0xb2, 0x33,
+	0x64, 0x2c, 0x39, 0xbd, 0xe4, 0x3e, 0x9f, 0xec, 0xae, 0x90, 0x43, 0x92, 0xec, 0x30, 0x7e, 0x69,
+	0x49, 0x9c, 0x4c, 0xe3, 0x82, 0x60, 0x4f, 0x31, 0xb0, 0xc7, 0xc9, 0x70, 0x34, 0x58, 0xd3, 0x3e,
+	0x87, 0x1d, 0x8d, 0x92, 0x5e, 0xc8, 0x95, 0x64, 0x34, 0x85, 0xeb, 0x3f, 0xe2, 0xdc, 0x53, 0xb4,
+	0xf0, 0x14, 0x6b, 0x57, 0xc0, 0xaf, 0xa7, 0x4d, 0xf2, 0x48, 0x80, 0x43, 0x01, 0x3a, 0x0d, 0xb9,
+	0x90, 0x0c, 0x63, 0x8b, 0x32, 0x24, 0xce, 0xa4, 0x77, 0xc4, 0x9c, 0x2e, 0xb1, 0x9c, 0xd2, 0x4d,
+	0x57, 0x8f, 0x86, 0x44, 0x7e, 0x27, 0xc0, 0x57, 0x9a, 0x65, 0x1c, 0x72, 0x2e, 0xe9, 0xa0, 0xf6,
+	0x4a, 0x46, 0xe2, 0xf9, 0x94, 0x5e, 0x98, 0xc1, 0x45, 0x96, 0xc1, 0x39, 0x32, 0x99, 0x66, 0x0f,
+	0x44, 0xa0, 0xef, 0x09, 0xd0, 0xcd, 0x45, 0x9d, 0x98, 0xa3, 0x84, 0x4f, 0x47, 0x12, 0x4f, 0x27,
+	0xb2, 0x4d, 0x7a, 0x34, 0xe3, 0x6a, 0x12, 0xf9, 0x87, 0x00, 0x47, 0x22, 0x84, 0x18, 0x72, 0x39,
+	0x32, 0x68, 0xbc, 0x04, 0x25, 0x5e, 0xd9, 0x79, 0x03, 0x49, 0xa9, 0x66, 0x37, 0x62, 0x77, 0x6d,
+	0xf4, 0x0c, 0x7a, 0xf2, 0x47, 0x01, 0x06, 0x82, 0x2a, 0xef, 0x31, 0xfb, 0x5b, 0x84, 0x6e, 0x20,
+	0xce, 0xee, 0xc0, 0x13, 0x33, 0x99, 0x66, 0x99, 0x8c, 0x93, 0x6c, 0x58, 0x26, 0x75, 0xf4, 0x96,
+	0x7d, 0xca, 0x04, 0xf9, 0xaf, 0x00, 0xfd, 0xfe, 0xe2, 0x7c, 0xcc, 0x7d, 0x24, 0x50, 0x04, 0x10,
+	0xa7, 0x52, 0xf9, 0x20, 0x66, 0x83, 0x61, 0xae, 0x90, 0xa9, 0x58, 0xcc, 0x01, 0x1b, 0xf2, 0xf9,
+	0x70, 0xb7, 0x80, 0xf9, 0xe1, 0xb4, 0x64, 0x4f, 0x6f, 0xd2, 0x5a, 0xd3, 0x27, 0xd3, 0x09, 0xf1,
+	0x37, 0xc9, 0x04, 0xe2, 0x85, 0xd4, 0x7e, 0x49, 0x6f, 0x0a, 0x9e, 0xdc, 0x1b, 0x3a, 0x07, 0xf9,
+	0x9f, 0x00, 0xe0, 0x96, 0x5e, 0x49, 0xec, 0x5e, 0xeb, 0x17, 0x15, 0x44, 0x39, 0xb1, 0x3d, 0xa2,
+	0xfc, 0x01, 0xaf, 0x6d, 0xbc, 0x2b, 0x84, 0xef, 0x78, 0x58, 0x02, 0xbc, 0x15, 0x51, 0xc0, 0x41,
+	0x13, 0x79, 0x8b, 0x97, 0xf6, 0x23, 0x0f, 0x53, 0xcd, 0xb6, 0x4d, 0xf5, 0x8d, 0x47, 0xfc, 0x90,
+	0xdc, 0x5a, 0xc8, 0x8f, 0x3f, 0x24, 0x87, 0x8a, 0x13, 0xe2, 0xc5, 0x9d, 0xb8, 0x22, 0x43, 0x33,
+	0x8c, 0xa0, 0x49, 0x32, 0x1e, 0x93, 0x90, 0x29, 0xf3, 0x84, 0x1a, 0x89, 0x05, 0xa5, 0xc2, 0xcb,
+	0xe8, 0xe9, 0x52, 0xf1, 0x49, 0x03, 0xe2, 0xc5, 0x9d, 0xb8, 0xa6, 0x4e, 0x85, 0xab, 0x0a, 0xf2,
+	0x16, 0xff, 0xbb, 0x4d, 0x1e, 0x60, 0x51, 0xc3, 0x2d, 0x7f, 0x93, 0x24, 0xa7, 0xab, 0xa6, 0x92,
+	0xbc, 0x38, 0x95, 0xca, 0x07, 0x51, 0x8f, 0x32, 0xd4, 0x12, 0x19, 0x89, 0x43, 0x4d, 0x7e, 0x2d,
+	0x40, 0xbf, 0xbf, 0x3e, 0x1d, 0x83, 0x32, 0xb0, 0x58, 0x2e, 0x4e, 0xa5, 0xf2, 0x49, 0x7a, 0xfd,
+	0x66, 0x1b, 0x0d, 0x42, 0x9d, 0xa7, 0x8f, 0x1e, 0x0f, 0x09, 0x9f, 0x3f, 0x1e, 0x12, 0xbe, 0x78,
+	0x3c, 0x24, 0xfc, 0xe8, 0xc9, 0xd0, 0xbe, 0xcf, 0x9f, 0x0c, 0xed, 0xfb, 0xdb, 0x93, 0xa1, 0x7d,
+	0x30, 0xa8, 0xea, 0x21, 0xe1, 0x57, 0x84, 0x5b, 0x59, 0x4f, 0xa9, 0xda, 0x35, 0x3a, 0xab, 0xea,
+	0xde, 0xa0, 0xf7, 0x1a, 0x61, 0x57, 0xbb, 0xd9, 0x7f, 0xd3, 0x9a, 0xfa, 0xff, 0x00, 0x90, 0x47,
+	0xd9, 0xe4, 0x3d, 0x38, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2737,6 +3370,12 @@ type QueryClient interface {
 	GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error)
 	// GetAllOrders gets all orders in the exchange module.
 	GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error)
+	// GetSettlementReceipt looks up a settlement receipt by its id.
+	GetSettlementReceipt(ctx context.Context, in *QueryGetSettlementReceiptRequest, opts ...grpc.CallOption) (*QueryGetSettlementReceiptResponse, error)
+	// GetRFQ looks up a request for quote (and its quotes) by its id.
+	GetRFQ(ctx context.Context, in *QueryGetRFQRequest, opts ...grpc.CallOption) (*QueryGetRFQResponse, error)
+	// GetMarketRFQs looks up the requests for quote in a market.
+	GetMarketRFQs(ctx context.Context, in *QueryGetMarketRFQsRequest, opts ...grpc.CallOption) (*QueryGetMarketRFQsResponse, error)
 	// GetCommitment gets the funds in an account that are committed to the market.
 	GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error)
 	// GetAccountCommitments gets all the funds in an account that are committed to any market.
@@ -2749,6 +3388,13 @@ type QueryClient interface {
 	GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error)
 	// GetAllMarkets returns brief information about each market.
 	GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error)
+	// GetMarketExternalSettlements returns a market's external settlement configuration and pending settlements.
+	GetMarketExternalSettlements(ctx context.Context, in *QueryGetMarketExternalSettlementsRequest, opts ...grpc.CallOption) (*QueryGetMarketExternalSettlementsResponse, error)
+	// GetMarketPriceBands returns a market's price band configuration, whether its settlements are paused, and the
+	// last settlement prices of its assets.
+	GetMarketPriceBands(ctx context.Context, in *QueryGetMarketPriceBandsRequest, opts ...grpc.CallOption) (*QueryGetMarketPriceBandsResponse, error)
+	// GetMarketRebates returns a market's rebate configuration, pool, and the liquidity points earned so far.
+	GetMarketRebates(ctx context.Context, in *QueryGetMarketRebatesRequest, opts ...grpc.CallOption) (*QueryGetMarketRebatesResponse, error)
 	// Params returns the exchange module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
@@ -2842,6 +3488,33 @@ func (c *queryClient) GetAllOrders(ctx context.Context, in *QueryGetAllOrdersReq
 	return out, nil
 }
 
+func (c *queryClient) GetSettlementReceipt(ctx context.Context, in *QueryGetSettlementReceiptRequest, opts ...grpc.CallOption) (*QueryGetSettlementReceiptResponse, error) {
+	out := new(QueryGetSettlementReceiptResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetSettlementReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRFQ(ctx context.Context, in *QueryGetRFQRequest, opts ...grpc.CallOption) (*QueryGetRFQResponse, error) {
+	out := new(QueryGetRFQResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetRFQ", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMarketRFQs(ctx context.Context, in *QueryGetMarketRFQsRequest, opts ...grpc.CallOption) (*QueryGetMarketRFQsResponse, error) {
+	out := new(QueryGetMarketRFQsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketRFQs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error) {
 	out := new(QueryGetCommitmentResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetCommitment", in, out, opts...)
@@ -2896,6 +3569,33 @@ func (c *queryClient) GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsR
 	return out, nil
 }
 
+func (c *queryClient) GetMarketExternalSettlements(ctx context.Context, in *QueryGetMarketExternalSettlementsRequest, opts ...grpc.CallOption) (*QueryGetMarketExternalSettlementsResponse, error) {
+	out := new(QueryGetMarketExternalSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketExternalSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMarketPriceBands(ctx context.Context, in *QueryGetMarketPriceBandsRequest, opts ...grpc.CallOption) (*QueryGetMarketPriceBandsResponse, error) {
+	out := new(QueryGetMarketPriceBandsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketPriceBands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMarketRebates(ctx context.Context, in *QueryGetMarketRebatesRequest, opts ...grpc.CallOption) (*QueryGetMarketRebatesResponse, error) {
+	out := new(QueryGetMarketRebatesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketRebates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/Params", in, out, opts...)
@@ -3002,6 +3702,12 @@ type QueryServer interface {
 	GetAssetOrders(context.Context, *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error)
 	// GetAllOrders gets all orders in the exchange module.
 	GetAllOrders(context.Context, *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error)
+	// GetSettlementReceipt looks up a settlement receipt by its id.
+	GetSettlementReceipt(context.Context, *QueryGetSettlementReceiptRequest) (*QueryGetSettlementReceiptResponse, error)
+	// GetRFQ looks up a request for quote (and its quotes) by its id.
+	GetRFQ(context.Context, *QueryGetRFQRequest) (*QueryGetRFQResponse, error)
+	// GetMarketRFQs looks up the requests for quote in a market.
+	GetMarketRFQs(context.Context, *QueryGetMarketRFQsRequest) (*QueryGetMarketRFQsResponse, error)
 	// GetCommitment gets the funds in an account that are committed to the market.
 	GetCommitment(context.Context, *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error)
 	// GetAccountCommitments gets all the funds in an account that are committed to any market.
@@ -3014,6 +3720,13 @@ type QueryServer interface {
 	GetMarket(context.Context, *QueryGetMarketRequest) (*QueryGetMarketResponse, error)
 	// GetAllMarkets returns brief information about each market.
 	GetAllMarkets(context.Context, *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error)
+	// GetMarketExternalSettlements returns a market's external settlement configuration and pending settlements.
+	GetMarketExternalSettlements(context.Context, *QueryGetMarketExternalSettlementsRequest) (*QueryGetMarketExternalSettlementsResponse, error)
+	// GetMarketPriceBands returns a market's price band configuration, whether its settlements are paused, and the
+	// last settlement prices of its assets.
+	GetMarketPriceBands(context.Context, *QueryGetMarketPriceBandsRequest) (*QueryGetMarketPriceBandsResponse, error)
+	// GetMarketRebates returns a market's rebate configuration, pool, and the liquidity points earned so far.
+	GetMarketRebates(context.Context, *QueryGetMarketRebatesRequest) (*QueryGetMarketRebatesResponse, error)
 	// Params returns the exchange module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
@@ -3061,6 +3774,15 @@ func (*UnimplementedQueryServer) GetAssetOrders(ctx context.Context, req *QueryG
 func (*UnimplementedQueryServer) GetAllOrders(ctx context.Context, req *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
 }
+func (*UnimplementedQueryServer) GetSettlementReceipt(ctx context.Context, req *QueryGetSettlementReceiptRequest) (*QueryGetSettlementReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementReceipt not implemented")
+}
+func (*UnimplementedQueryServer) GetRFQ(ctx context.Context, req *QueryGetRFQRequest) (*QueryGetRFQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRFQ not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketRFQs(ctx context.Context, req *QueryGetMarketRFQsRequest) (*QueryGetMarketRFQsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketRFQs not implemented")
+}
 func (*UnimplementedQueryServer) GetCommitment(ctx context.Context, req *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommitment not implemented")
 }
@@ -3079,6 +3801,15 @@ func (*UnimplementedQueryServer) GetMarket(ctx context.Context, req *QueryGetMar
 func (*UnimplementedQueryServer) GetAllMarkets(ctx context.Context, req *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllMarkets not implemented")
 }
+func (*UnimplementedQueryServer) GetMarketExternalSettlements(ctx context.Context, req *QueryGetMarketExternalSettlementsRequest) (*QueryGetMarketExternalSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketExternalSettlements not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketPriceBands(ctx context.Context, req *QueryGetMarketPriceBandsRequest) (*QueryGetMarketPriceBandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketPriceBands not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketRebates(ctx context.Context, req *QueryGetMarketRebatesRequest) (*QueryGetMarketRebatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketRebates not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -3240,6 +3971,60 @@ func _Query_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetSettlementReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetSettlementReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetSettlementReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetSettlementReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSettlementReceipt(ctx, req.(*QueryGetSettlementReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRFQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRFQRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRFQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetRFQ",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRFQ(ctx, req.(*QueryGetRFQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarketRFQs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketRFQsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketRFQs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketRFQs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketRFQs(ctx, req.(*QueryGetMarketRFQsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetCommitmentRequest)
 	if err := dec(in); err != nil {
@@ -3348,6 +4133,60 @@ func _Query_GetAllMarkets_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetMarketExternalSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketExternalSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketExternalSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketExternalSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketExternalSettlements(ctx, req.(*QueryGetMarketExternalSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarketPriceBands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketPriceBandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketPriceBands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketPriceBands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketPriceBands(ctx, req.(*QueryGetMarketPriceBandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarketRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketRebatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketRebates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketRebates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketRebates(ctx, req.(*QueryGetMarketRebatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -3561,6 +4400,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllOrders",
 			Handler:    _Query_GetAllOrders_Handler,
 		},
+		{
+			MethodName: "GetSettlementReceipt",
+			Handler:    _Query_GetSettlementReceipt_Handler,
+		},
+		{
+			MethodName: "GetRFQ",
+			Handler:    _Query_GetRFQ_Handler,
+		},
+		{
+			MethodName: "GetMarketRFQs",
+			Handler:    _Query_GetMarketRFQs_Handler,
+		},
 		{
 			MethodName: "GetCommitment",
 			Handler:    _Query_GetCommitment_Handler,
@@ -3585,6 +4436,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllMarkets",
 			Handler:    _Query_GetAllMarkets_Handler,
 		},
+		{
+			MethodName: "GetMarketExternalSettlements",
+			Handler:    _Query_GetMarketExternalSettlements_Handler,
+		},
+		{
+			MethodName: "GetMarketPriceBands",
+			Handler:    _Query_GetMarketPriceBands_Handler,
+		},
+		{
+			MethodName: "GetMarketRebates",
+			Handler:    _Query_GetMarketRebates_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -4282,7 +5145,7 @@ func (m *QueryGetAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetSettlementReceiptRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4292,32 +5155,25 @@ func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetSettlementReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetSettlementReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+	if m.ReceiptId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiptId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetSettlementReceiptResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4327,34 +5183,32 @@ func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetSettlementReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetSettlementReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetRFQRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4364,27 +5218,25 @@ func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAccountCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetRFQRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAccountCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetRFQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+	if m.RfqId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RfqId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetRFQResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4394,34 +5246,32 @@ func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAccountCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetRFQResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAccountCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetRFQResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Rfq != nil {
+		{
+			size, err := m.Rfq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketRFQsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4431,12 +5281,12 @@ func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRFQsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRFQsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4463,7 +5313,7 @@ func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketRFQsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4473,12 +5323,12 @@ func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRFQsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRFQsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4497,10 +5347,10 @@ func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Rfqs) > 0 {
+		for iNdEx := len(m.Rfqs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Rfqs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4514,7 +5364,7 @@ func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4524,34 +5374,32 @@ func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4561,34 +5409,20 @@ func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4602,7 +5436,7 @@ func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4612,25 +5446,41 @@ func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAccountCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAccountCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4640,19 +5490,19 @@ func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAccountCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAccountCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Market != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4660,19 +5510,28 @@ func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4682,12 +5541,12 @@ func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4706,10 +5565,15 @@ func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4719,12 +5583,12 @@ func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4743,10 +5607,10 @@ func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4760,7 +5624,7 @@ func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4770,20 +5634,34 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAllCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4793,19 +5671,19 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAllCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4813,12 +5691,28 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4828,42 +5722,25 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeBreakdownFields {
-		i--
-		if m.IncludeBreakdownFields {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Settlement != nil {
-		{
-			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4873,19 +5750,19 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ToFeeNav != nil {
+	if m.Market != nil {
 		{
-			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4893,68 +5770,19 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []b
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConversionNavs) > 0 {
-		for iNdEx := len(m.ConversionNavs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConversionNavs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ConvertedTotal) > 0 {
-		for iNdEx := len(m.ConvertedTotal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConvertedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.InputTotal) > 0 {
-		for iNdEx := len(m.InputTotal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InputTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x12
 	}
-	if len(m.ExchangeFees) > 0 {
-		for iNdEx := len(m.ExchangeFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4964,19 +5792,19 @@ func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CreateMarketRequest != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.CreateMarketRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4984,12 +5812,14 @@ func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4999,37 +5829,48 @@ func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GovPropWillPass {
-		i--
-		if m.GovPropWillPass {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		dAtA[i] = 0x6
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9a
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketExternalSettlementsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5039,12 +5880,12 @@ func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketExternalSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketExternalSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5057,7 +5898,7 @@ func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketExternalSettlementsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5067,27 +5908,32 @@ func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketExternalSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketExternalSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+	if m.Settlements != nil {
+		{
+			size, err := m.Settlements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketPriceBandsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5097,32 +5943,25 @@ func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketPriceBandsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketPriceBandsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ManageFeesRequest != nil {
-		{
-			size, err := m.ManageFeesRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketPriceBandsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5132,37 +5971,32 @@ func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketPriceBandsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketPriceBandsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GovPropWillPass {
-		i--
-		if m.GovPropWillPass {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.PriceBands != nil {
+		{
+			size, err := m.PriceBands.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketRebatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5172,34 +6006,25 @@ func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRebatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRebatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketRebatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5209,19 +6034,19 @@ func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Payment != nil {
+	if m.Rebates != nil {
 		{
-			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Rebates.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5234,7 +6059,7 @@ func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5244,41 +6069,20 @@ func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5288,19 +6092,19 @@ func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Params != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5308,28 +6112,12 @@ func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5339,19 +6127,29 @@ func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.IncludeBreakdownFields {
+		i--
+		if m.IncludeBreakdownFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Settlement != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5359,21 +6157,12 @@ func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5383,19 +6172,19 @@ func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.ToFeeNav != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5403,14 +6192,54 @@ func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x2a
 	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ConversionNavs) > 0 {
+		for iNdEx := len(m.ConversionNavs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ConversionNavs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConvertedTotal) > 0 {
+		for iNdEx := len(m.ConvertedTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvertedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InputTotal) > 0 {
+		for iNdEx := len(m.InputTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InputTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExchangeFees) > 0 {
+		for iNdEx := len(m.ExchangeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5424,7 +6253,7 @@ func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5434,19 +6263,19 @@ func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidateCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.CreateMarketRequest != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CreateMarketRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5454,14 +6283,12 @@ func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5471,48 +6298,37 @@ func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidateCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidateCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.GovPropWillPass {
+		i--
+		if m.GovPropWillPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x10
 	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5522,30 +6338,25 @@ func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5555,477 +6366,660 @@ func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeAccept) > 0 {
-		for iNdEx := len(m.FeeAccept) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeAccept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.FeeCreate) > 0 {
-		for iNdEx := len(m.FeeCreate) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryOrderFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryValidateManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AskOrder != nil {
-		l = m.AskOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BidOrder != nil {
-		l = m.BidOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ManageFeesRequest != nil {
+		{
+			size, err := m.ManageFeesRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOrderFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CreationFeeOptions) > 0 {
-		for _, e := range m.CreationFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.SettlementFlatFeeOptions) > 0 {
-		for _, e := range m.SettlementFlatFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.GovPropWillPass {
+		i--
+		if m.GovPropWillPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.SettlementRatioFeeOptions) > 0 {
-		for _, e := range m.SettlementRatioFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovQuery(uint64(m.OrderId))
+func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetOrderByExternalIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOrderByExternalIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Payment != nil {
+		{
+			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOwnerOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetOwnerOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPaymentsWithSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAssetOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetAssetOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPaymentsWithSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPaymentsWithTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAccountCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if len(m.FeeAccept) > 0 {
+		for iNdEx := len(m.FeeAccept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAccept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.FeeCreate) > 0 {
+		for iNdEx := len(m.FeeCreate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOrderFeeCalcRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.AskOrder != nil {
+		l = m.AskOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.BidOrder != nil {
+		l = m.BidOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetAllCommitmentsRequest) Size() (n int) {
+func (m *QueryOrderFeeCalcResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	if len(m.CreationFeeOptions) > 0 {
+		for _, e := range m.CreationFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementFlatFeeOptions) > 0 {
+		for _, e := range m.SettlementFlatFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementRatioFeeOptions) > 0 {
+		for _, e := range m.SettlementRatioFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
 
-func (m *QueryGetAllCommitmentsResponse) Size() (n int) {
+func (m *QueryGetOrderRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
 	}
 	return n
 }
 
-func (m *QueryGetMarketRequest) Size() (n int) {
+func (m *QueryGetOrderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetMarketResponse) Size() (n int) {
+func (m *QueryGetOrderByExternalIDRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Market != nil {
-		l = m.Market.Size()
+	return n
+}
+
+func (m *QueryGetOrderByExternalIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetAllMarketsRequest) Size() (n int) {
+func (m *QueryGetMarketOrdersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -6033,14 +7027,14 @@ func (m *QueryGetAllMarketsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetAllMarketsResponse) Size() (n int) {
+func (m *QueryGetMarketOrdersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -6052,237 +7046,247 @@ func (m *QueryGetAllMarketsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryGetOwnerOrdersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryGetOwnerOrdersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Size() (n int) {
+func (m *QueryGetAssetOrdersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Settlement != nil {
-		l = m.Settlement.Size()
+	l = len(m.Asset)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.IncludeBreakdownFields {
-		n += 2
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
+func (m *QueryGetAssetOrdersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ExchangeFees) > 0 {
-		for _, e := range m.ExchangeFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.InputTotal) > 0 {
-		for _, e := range m.InputTotal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ConvertedTotal) > 0 {
-		for _, e := range m.ConvertedTotal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ConversionNavs) > 0 {
-		for _, e := range m.ConversionNavs {
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.ToFeeNav != nil {
-		l = m.ToFeeNav.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryValidateCreateMarketRequest) Size() (n int) {
+func (m *QueryGetAllOrdersRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.CreateMarketRequest != nil {
-		l = m.CreateMarketRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryValidateCreateMarketResponse) Size() (n int) {
+func (m *QueryGetAllOrdersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
-	if m.GovPropWillPass {
-		n += 2
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryValidateMarketRequest) Size() (n int) {
+func (m *QueryGetSettlementReceiptRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if m.ReceiptId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiptId))
 	}
 	return n
 }
 
-func (m *QueryValidateMarketResponse) Size() (n int) {
+func (m *QueryGetSettlementReceiptResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryValidateManageFeesRequest) Size() (n int) {
+func (m *QueryGetRFQRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ManageFeesRequest != nil {
-		l = m.ManageFeesRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.RfqId != 0 {
+		n += 1 + sovQuery(uint64(m.RfqId))
 	}
 	return n
 }
 
-func (m *QueryValidateManageFeesResponse) Size() (n int) {
+func (m *QueryGetRFQResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
+	if m.Rfq != nil {
+		l = m.Rfq.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.GovPropWillPass {
-		n += 2
-	}
 	return n
 }
 
-func (m *QueryGetPaymentRequest) Size() (n int) {
+func (m *QueryGetMarketRFQsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetPaymentResponse) Size() (n int) {
+func (m *QueryGetMarketRFQsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Payment != nil {
-		l = m.Payment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rfqs) > 0 {
+		for _, e := range m.Rfqs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Size() (n int) {
+func (m *QueryGetCommitmentRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Source)
+	l = len(m.Account)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
 	}
 	return n
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Size() (n int) {
+func (m *QueryGetCommitmentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
+func (m *QueryGetAccountCommitmentsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Target)
+	l = len(m.Account)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -6293,14 +7297,14 @@ func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
+func (m *QueryGetAccountCommitmentsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -6312,12 +7316,15 @@ func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetAllPaymentsRequest) Size() (n int) {
+func (m *QueryGetMarketCommitmentsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -6325,64 +7332,1874 @@ func (m *QueryGetAllPaymentsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetAllPaymentsResponse) Size() (n int) {
+func (m *QueryGetMarketCommitmentsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketExternalSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketExternalSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Settlements != nil {
+		l = m.Settlements.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketPriceBandsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketPriceBandsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceBands != nil {
+		l = m.PriceBands.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketRebatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketRebatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rebates != nil {
+		l = m.Rebates.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Settlement != nil {
+		l = m.Settlement.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeBreakdownFields {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeFees) > 0 {
+		for _, e := range m.ExchangeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.InputTotal) > 0 {
+		for _, e := range m.InputTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConvertedTotal) > 0 {
+		for _, e := range m.ConvertedTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConversionNavs) > 0 {
+		for _, e := range m.ConversionNavs {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryPaymentFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Payment.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	if m.ToFeeNav != nil {
+		l = m.ToFeeNav.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCreateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateMarketRequest != nil {
+		l = m.CreateMarketRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCreateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GovPropWillPass {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryValidateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateManageFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ManageFeesRequest != nil {
+		l = m.ManageFeesRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateManageFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GovPropWillPass {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeCreate) > 0 {
+		for _, e := range m.FeeCreate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeeAccept) > 0 {
+		for _, e := range m.FeeAccept {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AskOrder == nil {
+				m.AskOrder = &AskOrder{}
+			}
+			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidOrder == nil {
+				m.BidOrder = &BidOrder{}
+			}
+			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFeeOptions = append(m.CreationFeeOptions, types.Coin{})
+			if err := m.CreationFeeOptions[len(m.CreationFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFlatFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementFlatFeeOptions = append(m.SettlementFlatFeeOptions, types.Coin{})
+			if err := m.SettlementFlatFeeOptions[len(m.SettlementFlatFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRatioFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementRatioFeeOptions = append(m.SettlementRatioFeeOptions, types.Coin{})
+			if err := m.SettlementRatioFeeOptions[len(m.SettlementRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FeeCreate) > 0 {
-		for _, e := range m.FeeCreate {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.FeeAccept) > 0 {
-		for _, e := range m.FeeAccept {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6405,15 +9222,15 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6440,16 +9257,14 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AskOrder == nil {
-				m.AskOrder = &AskOrder{}
-			}
-			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6476,10 +9291,10 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BidOrder == nil {
-				m.BidOrder = &BidOrder{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6504,7 +9319,7 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6527,15 +9342,15 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6562,14 +9377,66 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreationFeeOptions = append(m.CreationFeeOptions, types.Coin{})
-			if err := m.CreationFeeOptions[len(m.CreationFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAllOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFlatFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6596,14 +9463,14 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementFlatFeeOptions = append(m.SettlementFlatFeeOptions, types.Coin{})
-			if err := m.SettlementFlatFeeOptions[len(m.SettlementFlatFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRatioFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6630,8 +9497,10 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementRatioFeeOptions = append(m.SettlementRatioFeeOptions, types.Coin{})
-			if err := m.SettlementRatioFeeOptions[len(m.SettlementRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6656,7 +9525,7 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSettlementReceiptRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6679,17 +9548,17 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSettlementReceiptRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSettlementReceiptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptId", wireType)
 			}
-			m.OrderId = 0
+			m.ReceiptId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6699,7 +9568,7 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.ReceiptId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6725,7 +9594,7 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSettlementReceiptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6748,15 +9617,15 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSettlementReceiptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSettlementReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6783,10 +9652,10 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
+			if m.Receipt == nil {
+				m.Receipt = &SettlementReceipt{}
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6811,7 +9680,7 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRFQRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6834,63 +9703,31 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRFQRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRFQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
 			}
-			var stringLen uint64
+			m.RfqId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6912,7 +9749,7 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetRFQResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6935,15 +9772,15 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetRFQResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetRFQResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rfq", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6970,10 +9807,10 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
+			if m.Rfq == nil {
+				m.Rfq = &RequestForQuote{}
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rfq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6998,7 +9835,7 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketRFQsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7021,10 +9858,10 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketRFQsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketRFQsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7046,57 +9883,6 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
-			}
-			m.AfterOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7154,7 +9940,7 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketRFQsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7177,15 +9963,15 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketRFQsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketRFQsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rfqs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7212,8 +9998,8 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rfqs = append(m.Rfqs, &RequestForQuote{})
+			if err := m.Rfqs[len(m.Rfqs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7274,7 +10060,7 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7297,15 +10083,15 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7333,64 +10119,13 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
-			}
-			m.AfterOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7400,28 +10135,11 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7443,7 +10161,7 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7466,49 +10184,15 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7535,10 +10219,8 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7563,7 +10245,7 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7571,62 +10253,30 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7654,27 +10304,8 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
-			}
-			m.AfterOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7732,7 +10363,7 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7755,15 +10386,15 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7790,8 +10421,8 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, &MarketAmount{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7852,7 +10483,7 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7875,12 +10506,31 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7938,7 +10588,7 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7961,15 +10611,15 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7996,8 +10646,8 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, &AccountAmount{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8058,7 +10708,7 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8081,17 +10731,17 @@ func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCommitmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8101,43 +10751,28 @@ func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8159,7 +10794,7 @@ func (m *QueryGetCommitmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8182,15 +10817,15 @@ func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCommitmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8217,8 +10852,44 @@ func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commitments = append(m.Commitments, &Commitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8243,7 +10914,7 @@ func (m *QueryGetCommitmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8266,17 +10937,17 @@ func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8286,24 +10957,11 @@ func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8325,7 +10983,7 @@ func (m *QueryGetAccountCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8335,28 +10993,60 @@ func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAccountCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8383,8 +11073,10 @@ func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, &MarketAmount{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Market == nil {
+				m.Market = &Market{}
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8409,7 +11101,7 @@ func (m *QueryGetAccountCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8432,31 +11124,12 @@ func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllMarketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -8514,7 +11187,7 @@ func (m *QueryGetMarketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8537,15 +11210,15 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllMarketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8572,8 +11245,8 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, &AccountAmount{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Markets = append(m.Markets, &MarketBrief{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8634,7 +11307,7 @@ func (m *QueryGetMarketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketExternalSettlementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8657,17 +11330,17 @@ func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketExternalSettlementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketExternalSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8677,28 +11350,11 @@ func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8720,7 +11376,7 @@ func (m *QueryGetAllCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketExternalSettlementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8743,49 +11399,15 @@ func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketExternalSettlementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketExternalSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commitments = append(m.Commitments, &Commitment{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8812,10 +11434,10 @@ func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Settlements == nil {
+				m.Settlements = &MarketExternalSettlements{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Settlements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8840,7 +11462,7 @@ func (m *QueryGetAllCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketPriceBandsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8863,10 +11485,10 @@ func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketPriceBandsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketPriceBandsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8909,7 +11531,7 @@ func (m *QueryGetMarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketPriceBandsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8932,47 +11554,15 @@ func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketPriceBandsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketPriceBandsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBands", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8999,10 +11589,10 @@ func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Market == nil {
-				m.Market = &Market{}
+			if m.PriceBands == nil {
+				m.PriceBands = &MarketPriceBands{}
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceBands.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9027,7 +11617,7 @@ func (m *QueryGetMarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketRebatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9050,17 +11640,17 @@ func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllMarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketRebatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllMarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketRebatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9070,28 +11660,11 @@ func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9113,7 +11686,7 @@ func (m *QueryGetAllMarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketRebatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9136,49 +11709,15 @@ func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllMarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketRebatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllMarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Markets = append(m.Markets, &MarketBrief{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9205,10 +11744,10 @@ func (m *QueryGetAllMarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Rebates == nil {
+				m.Rebates = &MarketRebates{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rebates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,131 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultRebateBandBips is how far (in basis points) an order's unit price can be from the best price on the other side
+// of the book and still earn liquidity points. It's used for markets that don't have a price band.
+const DefaultRebateBandBips = uint32(500)
+
+// IsEnabled returns true if this config has a market setting aside fees for rebates.
+func (c MarketRebateConfig) IsEnabled() bool {
+	return c.PoolBips > 0
+}
+
+// Validate returns an error if any of this MarketRebateConfig's info is invalid.
+// A config with a zero PoolBips (i.e. disabled) must not have any other fields set.
+func (c MarketRebateConfig) Validate() error {
+	if !c.IsEnabled() {
+		if len(c.PriceDenom) > 0 || c.SampleInterval != 0 || c.PayoutInterval != 0 {
+			return errors.New("invalid rebate config: a config with zero pool bips must not have any other fields set")
+		}
+		return nil
+	}
+
+	var errs []error
+	if err := ValidateBips("rebate pool", c.PoolBips); err != nil {
+		errs = append(errs, err)
+	}
+	if err := sdk.ValidateDenom(c.PriceDenom); err != nil {
+		errs = append(errs, fmt.Errorf("invalid rebate price denom: %w", err))
+	}
+	if c.SampleInterval <= 0 {
+		errs = append(errs, fmt.Errorf("invalid rebate sample interval %d: must be positive", c.SampleInterval))
+	}
+	if c.PayoutInterval < c.SampleInterval {
+		errs = append(errs, fmt.Errorf("invalid rebate payout interval %d: cannot be less than the sample interval %d",
+			c.PayoutInterval, c.SampleInterval))
+	}
+	return errors.Join(errs...)
+}
+
+// CalculatePoolAmount returns the portion of the provided market fee amount that should be added to the rebate pool.
+func (c MarketRebateConfig) CalculatePoolAmount(marketAmt sdk.Coins) sdk.Coins {
+	if !c.IsEnabled() || marketAmt.IsZero() {
+		return nil
+	}
+	bips := sdkmath.NewIntFromUint64(uint64(c.PoolBips))
+	maxBips := sdkmath.NewIntFromUint64(uint64(MaxBips))
+	var rv sdk.Coins
+	for _, coin := range marketAmt {
+		amt := coin.Amount.Mul(bips).Quo(maxBips)
+		if amt.IsPositive() {
+			rv = rv.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return rv
+}
+
+// Validate returns an error if any of this MarketRebates's info is invalid.
+func (r MarketRebates) Validate() error {
+	var errs []error
+	if r.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if err := r.Config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := r.Pool.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid rebate pool %q: %w", r.Pool, err))
+	}
+	if r.LastPayoutHeight < 0 {
+		errs = append(errs, fmt.Errorf("invalid last payout height %d: cannot be negative", r.LastPayoutHeight))
+	}
+	seen := make(map[string]int, len(r.Points))
+	for i, points := range r.Points {
+		if j, dup := seen[points.Address]; dup {
+			errs = append(errs, fmt.Errorf("invalid points[%d]: duplicate address %s seen at [%d]", i, points.Address, j))
+			continue
+		}
+		seen[points.Address] = i
+		if err := points.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid points[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Validate returns an error if any of this LiquidityPoints's info is invalid.
+func (p LiquidityPoints) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid address %q: %w", p.Address, err)
+	}
+	if p.Points.IsNil() || !p.Points.IsPositive() {
+		return fmt.Errorf("invalid points %q: must be positive", p.Points)
+	}
+	return nil
+}
+
+// CalculateRebates divides the pool among the provided points.
+// Each entry's share is rounded down, so some of the pool might be left over.
+// Entries that would not receive anything are omitted from the result.
+func CalculateRebates(pool sdk.Coins, points []LiquidityPoints) []AccountAmount {
+	total := sdkmath.ZeroInt()
+	for _, p := range points {
+		total = total.Add(p.Points)
+	}
+	if pool.IsZero() || !total.IsPositive() {
+		return nil
+	}
+
+	var rv []AccountAmount
+	for _, p := range points {
+		var amt sdk.Coins
+		for _, coin := range pool {
+			share := coin.Amount.Mul(p.Points).Quo(total)
+			if share.IsPositive() {
+				amt = amt.Add(sdk.NewCoin(coin.Denom, share))
+			}
+		}
+		if !amt.IsZero() {
+			rv = append(rv, AccountAmount{Account: p.Address, Amount: amt})
+		}
+	}
+	return rv
+}
@@ -0,0 +1,1054 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/rebates.proto
+
+package exchange
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MarketRebateConfig defines how a market sets aside some of its fees to pay back to accounts that provide liquidity.
+type MarketRebateConfig struct {
+	// pool_bips is the portion of the fees received by a market (after the exchange takes its split) that is set aside
+	// in the market's rebate pool. It is represented in basis points (1/100th of 1%, e.g. 0.0001) and is limited to
+	// 0 to 10,000 inclusive. A value of zero means the market does not pay rebates.
+	PoolBips uint32 `protobuf:"varint,1,opt,name=pool_bips,json=poolBips,proto3" json:"pool_bips,omitempty"`
+	// price_denom is the denom of order prices that earn liquidity points. Orders priced in other denoms are ignored.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// sample_interval is the number of blocks between samplings of the market's resting orders.
+	// Each time the orders are sampled, the owner of each order near the top of the book earns points equal to the
+	// order's price amount multiplied by the sample_interval.
+	SampleInterval int64 `protobuf:"varint,3,opt,name=sample_interval,json=sampleInterval,proto3" json:"sample_interval,omitempty"`
+	// payout_interval is the number of blocks between payouts of the market's rebate pool.
+	// During a payout, the pool is distributed to accounts in proportion to the liquidity points they've earned.
+	PayoutInterval int64 `protobuf:"varint,4,opt,name=payout_interval,json=payoutInterval,proto3" json:"payout_interval,omitempty"`
+}
+
+func (m *MarketRebateConfig) Reset()         { *m = MarketRebateConfig{} }
+func (m *MarketRebateConfig) String() string { return proto.CompactTextString(m) }
+func (*MarketRebateConfig) ProtoMessage()    {}
+func (*MarketRebateConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_107348f982045d84, []int{0}
+}
+func (m *MarketRebateConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRebateConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRebateConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketRebateConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRebateConfig.Merge(m, src)
+}
+func (m *MarketRebateConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRebateConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRebateConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketRebateConfig proto.InternalMessageInfo
+
+func (m *MarketRebateConfig) GetPoolBips() uint32 {
+	if m != nil {
+		return m.PoolBips
+	}
+	return 0
+}
+
+func (m *MarketRebateConfig) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *MarketRebateConfig) GetSampleInterval() int64 {
+	if m != nil {
+		return m.SampleInterval
+	}
+	return 0
+}
+
+func (m *MarketRebateConfig) GetPayoutInterval() int64 {
+	if m != nil {
+		return m.PayoutInterval
+	}
+	return 0
+}
+
+// MarketRebates contains a market's rebate configuration and the current state of its rebate pool.
+type MarketRebates struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// config is the market's rebate configuration.
+	Config MarketRebateConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	// pool is the amount of the market's funds that are set aside for the next rebate payout.
+	Pool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=pool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool"`
+	// last_payout_height is the block height of the last rebate payout (or when rebates were last configured).
+	LastPayoutHeight int64 `protobuf:"varint,4,opt,name=last_payout_height,json=lastPayoutHeight,proto3" json:"last_payout_height,omitempty"`
+	// points are the liquidity points that have been earned since the last payout.
+	Points []LiquidityPoints `protobuf:"bytes,5,rep,name=points,proto3" json:"points"`
+}
+
+func (m *MarketRebates) Reset()         { *m = MarketRebates{} }
+func (m *MarketRebates) String() string { return proto.CompactTextString(m) }
+func (*MarketRebates) ProtoMessage()    {}
+func (*MarketRebates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_107348f982045d84, []int{1}
+}
+func (m *MarketRebates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRebates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRebates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketRebates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRebates.Merge(m, src)
+}
+func (m *MarketRebates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRebates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRebates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketRebates proto.InternalMessageInfo
+
+func (m *MarketRebates) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MarketRebates) GetConfig() MarketRebateConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MarketRebateConfig{}
+}
+
+func (m *MarketRebates) GetPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
+}
+
+func (m *MarketRebates) GetLastPayoutHeight() int64 {
+	if m != nil {
+		return m.LastPayoutHeight
+	}
+	return 0
+}
+
+func (m *MarketRebates) GetPoints() []LiquidityPoints {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// LiquidityPoints is the amount of time-weighted resting liquidity that an account has provided to a market.
+type LiquidityPoints struct {
+	// address is the bech32 address string of the account that earned the points.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// points is the number of liquidity points earned.
+	Points cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=points,proto3,customtype=cosmossdk.io/math.Int" json:"points"`
+}
+
+func (m *LiquidityPoints) Reset()         { *m = LiquidityPoints{} }
+func (m *LiquidityPoints) String() string { return proto.CompactTextString(m) }
+func (*LiquidityPoints) ProtoMessage()    {}
+func (*LiquidityPoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_107348f982045d84, []int{2}
+}
+func (m *LiquidityPoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityPoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityPoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityPoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityPoints.Merge(m, src)
+}
+func (m *LiquidityPoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityPoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityPoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityPoints proto.InternalMessageInfo
+
+func (m *LiquidityPoints) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MarketRebateConfig)(nil), "provenance.exchange.v1.MarketRebateConfig")
+	proto.RegisterType((*MarketRebates)(nil), "provenance.exchange.v1.MarketRebates")
+	proto.RegisterType((*LiquidityPoints)(nil), "provenance.exchange.v1.LiquidityPoints")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/rebates.proto", fileDescriptor_107348f982045d84)
+}
+
+var fileDescriptor_107348f982045d84 = []byte{
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x1c, 0x6d, 0xd6, 0x31, 0x98, 0xc7, 0x18, 0x58, 0x03, 0x65, 0x43, 0x4a, 0xab, 0x09, 0x69, 0xd5,
+	0xa0, 0x8e, 0x56, 0x3e, 0x01, 0x29, 0xa0, 0x55, 0x02, 0xa9, 0x0a, 0x37, 0x2e, 0x91, 0x93, 0x98,
+	0xd4, 0x6a, 0x63, 0x87, 0xd8, 0xad, 0xd6, 0xaf, 0xc0, 0x89, 0x33, 0x67, 0x0e, 0x88, 0xd3, 0x0e,
+	0xfb, 0x10, 0x3b, 0x4e, 0x3b, 0x21, 0x0e, 0x03, 0xb5, 0x87, 0x7d, 0x0d, 0xe4, 0x3f, 0x59, 0x2b,
+	0x60, 0x97, 0x24, 0xbf, 0xf7, 0x7b, 0x3f, 0xfb, 0xbd, 0x17, 0x1b, 0x3c, 0x29, 0x4a, 0x3e, 0x21,
+	0x0c, 0xb3, 0x84, 0xf8, 0xe4, 0x38, 0x19, 0x60, 0x96, 0x11, 0x7f, 0x72, 0xe8, 0x97, 0x24, 0xc6,
+	0x92, 0x08, 0x54, 0x94, 0x5c, 0x72, 0xf8, 0x68, 0xc1, 0x42, 0x15, 0x0b, 0x4d, 0x0e, 0x77, 0x1f,
+	0xe0, 0x9c, 0x32, 0xee, 0xeb, 0xa7, 0xa1, 0xee, 0x7a, 0x09, 0x17, 0x39, 0x17, 0x7e, 0x8c, 0x85,
+	0x5a, 0x28, 0x26, 0x12, 0x1f, 0xfa, 0x09, 0xa7, 0xcc, 0xf6, 0x77, 0x4c, 0x3f, 0xd2, 0x95, 0x6f,
+	0x0a, 0xdb, 0xda, 0xce, 0x78, 0xc6, 0x0d, 0xae, 0xbe, 0x0c, 0xba, 0xf7, 0xd5, 0x01, 0xf0, 0x2d,
+	0x2e, 0x87, 0x44, 0x86, 0x5a, 0x53, 0x97, 0xb3, 0x0f, 0x34, 0x83, 0x8f, 0xc1, 0x7a, 0xc1, 0xf9,
+	0x28, 0x8a, 0x69, 0x21, 0x5c, 0xa7, 0xe9, 0xb4, 0x36, 0xc3, 0x3b, 0x0a, 0x08, 0x68, 0x21, 0x60,
+	0x03, 0x6c, 0x14, 0x25, 0x4d, 0x48, 0x94, 0x12, 0xc6, 0x73, 0x77, 0xa5, 0xe9, 0xb4, 0xd6, 0x43,
+	0xa0, 0xa1, 0x97, 0x0a, 0x81, 0xfb, 0x60, 0x4b, 0xe0, 0xbc, 0x18, 0x91, 0x88, 0x32, 0x49, 0xca,
+	0x09, 0x1e, 0xb9, 0xf5, 0xa6, 0xd3, 0xaa, 0x87, 0xf7, 0x0c, 0xdc, 0xb3, 0xa8, 0x22, 0x16, 0x78,
+	0xca, 0xc7, 0x72, 0x41, 0x5c, 0x35, 0x44, 0x03, 0x57, 0xc4, 0xbd, 0xd9, 0x0a, 0xd8, 0x5c, 0x96,
+	0x29, 0x94, 0xc2, 0x5c, 0x03, 0x11, 0x4d, 0x2b, 0x85, 0x06, 0xe8, 0xa5, 0xf0, 0x08, 0xac, 0x25,
+	0xda, 0x88, 0x16, 0xb7, 0xd1, 0x39, 0x40, 0xff, 0x8f, 0x18, 0xfd, 0x6b, 0x3d, 0x58, 0x3d, 0xbb,
+	0x6c, 0xd4, 0x42, 0x3b, 0x0f, 0xc7, 0x60, 0x55, 0xf9, 0x76, 0xeb, 0xcd, 0x7a, 0x6b, 0xa3, 0xb3,
+	0x83, 0x6c, 0xa4, 0x2a, 0x7f, 0x64, 0xf3, 0x47, 0x5d, 0x4e, 0x59, 0xf0, 0x5a, 0x8d, 0x7d, 0xff,
+	0xd5, 0x68, 0x65, 0x54, 0x0e, 0xc6, 0x31, 0x4a, 0x78, 0x6e, 0xf3, 0xb7, 0xaf, 0xb6, 0x48, 0x87,
+	0xbe, 0x9c, 0x16, 0x44, 0xe8, 0x01, 0xf1, 0xe5, 0xea, 0xe4, 0xe0, 0xee, 0x88, 0x64, 0x38, 0x99,
+	0x46, 0xea, 0x0f, 0x8a, 0x6f, 0x57, 0x27, 0x07, 0x4e, 0xa8, 0xb7, 0x83, 0xcf, 0x00, 0x1c, 0x61,
+	0x21, 0x23, 0x9b, 0xce, 0x80, 0xd0, 0x6c, 0x20, 0x6d, 0x36, 0xf7, 0x55, 0xa7, 0xaf, 0x1b, 0x47,
+	0x1a, 0x87, 0xaf, 0xc0, 0x5a, 0xc1, 0x29, 0x93, 0xc2, 0xbd, 0xa5, 0x65, 0xee, 0xdf, 0x64, 0xf7,
+	0x0d, 0xfd, 0x38, 0xa6, 0x29, 0x95, 0xd3, 0xbe, 0xa6, 0x57, 0x5e, 0xcd, 0xf0, 0xde, 0x27, 0x07,
+	0x6c, 0xfd, 0xc5, 0x80, 0x1d, 0x70, 0x1b, 0xa7, 0x69, 0x49, 0x84, 0x39, 0x06, 0xeb, 0x81, 0x7b,
+	0x71, 0xda, 0xde, 0xb6, 0x29, 0xbc, 0x30, 0x9d, 0x77, 0xb2, 0xa4, 0x2c, 0x0b, 0x2b, 0x22, 0xec,
+	0x5e, 0xcb, 0xd1, 0x47, 0x23, 0x78, 0xaa, 0x76, 0xf9, 0x79, 0xd9, 0x78, 0x68, 0xc6, 0x44, 0x3a,
+	0x44, 0x94, 0xfb, 0x39, 0x96, 0x03, 0xd4, 0x63, 0xf2, 0xe2, 0xb4, 0x0d, 0xec, 0x7a, 0x3d, 0x26,
+	0x2b, 0x31, 0x01, 0x39, 0x9b, 0x79, 0xce, 0xf9, 0xcc, 0x73, 0x7e, 0xcf, 0x3c, 0xe7, 0xf3, 0xdc,
+	0xab, 0x9d, 0xcf, 0xbd, 0xda, 0x8f, 0xb9, 0x57, 0x03, 0x3b, 0x94, 0xdf, 0xe0, 0xaf, 0xef, 0xbc,
+	0x47, 0x4b, 0xf1, 0x2f, 0x48, 0x6d, 0xca, 0x97, 0x2a, 0xff, 0xf8, 0xfa, 0x32, 0xc6, 0x6b, 0xfa,
+	0x1a, 0x3c, 0xff, 0x33, 0x00, 0xf5, 0x52, 0xa3, 0x04, 0xaa, 0x03, 0x00, 0x00,
+}
+
+func (m *MarketRebateConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRebateConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRebateConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutInterval != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.PayoutInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SampleInterval != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.SampleInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintRebates(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolBips != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.PoolBips))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRebates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRebates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRebates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRebates(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastPayoutHeight != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.LastPayoutHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pool) > 0 {
+		for iNdEx := len(m.Pool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRebates(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRebates(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MarketId != 0 {
+		i = encodeVarintRebates(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Points.Size()
+		i -= size
+		if _, err := m.Points.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRebates(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRebates(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRebates(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRebates(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketRebateConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolBips != 0 {
+		n += 1 + sovRebates(uint64(m.PoolBips))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovRebates(uint64(l))
+	}
+	if m.SampleInterval != 0 {
+		n += 1 + sovRebates(uint64(m.SampleInterval))
+	}
+	if m.PayoutInterval != 0 {
+		n += 1 + sovRebates(uint64(m.PayoutInterval))
+	}
+	return n
+}
+
+func (m *MarketRebates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovRebates(uint64(m.MarketId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovRebates(uint64(l))
+	if len(m.Pool) > 0 {
+		for _, e := range m.Pool {
+			l = e.Size()
+			n += 1 + l + sovRebates(uint64(l))
+		}
+	}
+	if m.LastPayoutHeight != 0 {
+		n += 1 + sovRebates(uint64(m.LastPayoutHeight))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovRebates(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidityPoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRebates(uint64(l))
+	}
+	l = m.Points.Size()
+	n += 1 + l + sovRebates(uint64(l))
+	return n
+}
+
+func sovRebates(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRebates(x uint64) (n int) {
+	return sovRebates(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketRebateConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRebateConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRebateConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBips", wireType)
+			}
+			m.PoolBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleInterval", wireType)
+			}
+			m.SampleInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutInterval", wireType)
+			}
+			m.PayoutInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRebates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRebates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRebates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = append(m.Pool, types.Coin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPayoutHeight", wireType)
+			}
+			m.LastPayoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPayoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, LiquidityPoints{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRebates
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Points.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRebates(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRebates
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRebates(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRebates
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRebates
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRebates
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRebates
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRebates
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRebates        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRebates          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRebates = fmt.Errorf("proto: unexpected end of group")
+)
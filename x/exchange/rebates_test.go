@@ -0,0 +1,311 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestMarketRebateConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config MarketRebateConfig
+		expErr []string
+	}{
+		{
+			name:   "zero value",
+			config: MarketRebateConfig{},
+		},
+		{
+			name:   "enabled",
+			config: MarketRebateConfig{PoolBips: 25, PriceDenom: "nhash", SampleInterval: 10, PayoutInterval: 100},
+		},
+		{
+			name:   "max bips, payout equals sample",
+			config: MarketRebateConfig{PoolBips: MaxBips, PriceDenom: "nhash", SampleInterval: 5, PayoutInterval: 5},
+		},
+		{
+			name:   "disabled with denom",
+			config: MarketRebateConfig{PriceDenom: "nhash"},
+			expErr: []string{"invalid rebate config: a config with zero pool bips must not have any other fields set"},
+		},
+		{
+			name:   "disabled with sample interval",
+			config: MarketRebateConfig{SampleInterval: 1},
+			expErr: []string{"invalid rebate config: a config with zero pool bips must not have any other fields set"},
+		},
+		{
+			name:   "disabled with payout interval",
+			config: MarketRebateConfig{PayoutInterval: 1},
+			expErr: []string{"invalid rebate config: a config with zero pool bips must not have any other fields set"},
+		},
+		{
+			name:   "bips too large",
+			config: MarketRebateConfig{PoolBips: 10_001, PriceDenom: "nhash", SampleInterval: 10, PayoutInterval: 100},
+			expErr: []string{"invalid rebate pool bips 10001: exceeds max of 10000"},
+		},
+		{
+			name:   "no price denom",
+			config: MarketRebateConfig{PoolBips: 1, SampleInterval: 10, PayoutInterval: 100},
+			expErr: []string{"invalid rebate price denom: invalid denom: "},
+		},
+		{
+			name:   "zero sample interval",
+			config: MarketRebateConfig{PoolBips: 1, PriceDenom: "nhash", PayoutInterval: 100},
+			expErr: []string{"invalid rebate sample interval 0: must be positive"},
+		},
+		{
+			name:   "payout interval less than sample interval",
+			config: MarketRebateConfig{PoolBips: 1, PriceDenom: "nhash", SampleInterval: 10, PayoutInterval: 9},
+			expErr: []string{"invalid rebate payout interval 9: cannot be less than the sample interval 10"},
+		},
+		{
+			name:   "multiple errors",
+			config: MarketRebateConfig{PoolBips: 10_001, PriceDenom: "x", SampleInterval: -1, PayoutInterval: -2},
+			expErr: []string{
+				"invalid rebate pool bips 10001: exceeds max of 10000",
+				"invalid rebate price denom: invalid denom: x",
+				"invalid rebate sample interval -1: must be positive",
+				"invalid rebate payout interval -2: cannot be less than the sample interval -1",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.config.Validate()
+			}
+			assert.NotPanics(t, testFunc, "MarketRebateConfig.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketRebateConfig.Validate()")
+		})
+	}
+}
+
+func TestMarketRebateConfig_CalculatePoolAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    MarketRebateConfig
+		marketAmt sdk.Coins
+		exp       sdk.Coins
+	}{
+		{
+			name:      "disabled",
+			config:    MarketRebateConfig{},
+			marketAmt: sdk.NewCoins(sdk.NewInt64Coin("apple", 10_000)),
+			exp:       nil,
+		},
+		{
+			name:      "nil amount",
+			config:    MarketRebateConfig{PoolBips: 100},
+			marketAmt: nil,
+			exp:       nil,
+		},
+		{
+			name:      "one percent of one coin",
+			config:    MarketRebateConfig{PoolBips: 100},
+			marketAmt: sdk.NewCoins(sdk.NewInt64Coin("apple", 5_000)),
+			exp:       sdk.NewCoins(sdk.NewInt64Coin("apple", 50)),
+		},
+		{
+			name:      "rounds down",
+			config:    MarketRebateConfig{PoolBips: 100},
+			marketAmt: sdk.NewCoins(sdk.NewInt64Coin("apple", 199)),
+			exp:       sdk.NewCoins(sdk.NewInt64Coin("apple", 1)),
+		},
+		{
+			name:      "too small to set anything aside",
+			config:    MarketRebateConfig{PoolBips: 100},
+			marketAmt: sdk.NewCoins(sdk.NewInt64Coin("apple", 99)),
+			exp:       nil,
+		},
+		{
+			name:      "multiple coins, one too small",
+			config:    MarketRebateConfig{PoolBips: 2_500},
+			marketAmt: sdk.NewCoins(sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("banana", 1_000)),
+			exp:       sdk.NewCoins(sdk.NewInt64Coin("banana", 250)),
+		},
+		{
+			name:      "max bips",
+			config:    MarketRebateConfig{PoolBips: MaxBips},
+			marketAmt: sdk.NewCoins(sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("banana", 1_000)),
+			exp:       sdk.NewCoins(sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("banana", 1_000)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act sdk.Coins
+			testFunc := func() {
+				act = tc.config.CalculatePoolAmount(tc.marketAmt)
+			}
+			assert.NotPanics(t, testFunc, "CalculatePoolAmount(%q)", tc.marketAmt)
+			assert.Equal(t, tc.exp.String(), act.String(), "CalculatePoolAmount(%q)", tc.marketAmt)
+		})
+	}
+}
+
+func TestMarketRebates_Validate(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	goodConfig := MarketRebateConfig{PoolBips: 100, PriceDenom: "nhash", SampleInterval: 10, PayoutInterval: 100}
+
+	tests := []struct {
+		name    string
+		rebates MarketRebates
+		expErr  []string
+	}{
+		{
+			name: "control",
+			rebates: MarketRebates{
+				MarketId:         3,
+				Config:           goodConfig,
+				Pool:             sdk.NewCoins(sdk.NewInt64Coin("apple", 8)),
+				LastPayoutHeight: 12,
+				Points: []LiquidityPoints{
+					{Address: addr1, Points: sdkmath.NewInt(5)},
+					{Address: addr2, Points: sdkmath.NewInt(7)},
+				},
+			},
+		},
+		{
+			name:    "market zero",
+			rebates: MarketRebates{Config: goodConfig},
+			expErr:  []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name:    "bad config",
+			rebates: MarketRebates{MarketId: 1, Config: MarketRebateConfig{PriceDenom: "nhash"}},
+			expErr:  []string{"invalid rebate config: a config with zero pool bips must not have any other fields set"},
+		},
+		{
+			name: "bad pool",
+			rebates: MarketRebates{
+				MarketId: 1,
+				Config:   goodConfig,
+				Pool:     sdk.Coins{sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: []string{"invalid rebate pool \"-1apple\": coin -1apple amount is not positive"},
+		},
+		{
+			name:    "negative last payout height",
+			rebates: MarketRebates{MarketId: 1, Config: goodConfig, LastPayoutHeight: -1},
+			expErr:  []string{"invalid last payout height -1: cannot be negative"},
+		},
+		{
+			name: "duplicate address",
+			rebates: MarketRebates{
+				MarketId: 1,
+				Config:   goodConfig,
+				Points: []LiquidityPoints{
+					{Address: addr1, Points: sdkmath.NewInt(5)},
+					{Address: addr2, Points: sdkmath.NewInt(7)},
+					{Address: addr1, Points: sdkmath.NewInt(2)},
+				},
+			},
+			expErr: []string{"invalid points[2]: duplicate address " + addr1 + " seen at [0]"},
+		},
+		{
+			name: "bad points entries",
+			rebates: MarketRebates{
+				MarketId: 1,
+				Config:   goodConfig,
+				Points: []LiquidityPoints{
+					{Address: "bad", Points: sdkmath.NewInt(5)},
+					{Address: addr2, Points: sdkmath.ZeroInt()},
+				},
+			},
+			expErr: []string{
+				"invalid points[0]: invalid address \"bad\": decoding bech32 failed",
+				"invalid points[1]: invalid points \"0\": must be positive",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.rebates.Validate()
+			}
+			assert.NotPanics(t, testFunc, "MarketRebates.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "MarketRebates.Validate()")
+		})
+	}
+}
+
+func TestCalculateRebates(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	addr3 := sdk.AccAddress("addr3_______________").String()
+
+	tests := []struct {
+		name   string
+		pool   sdk.Coins
+		points []LiquidityPoints
+		exp    []AccountAmount
+	}{
+		{
+			name:   "empty pool",
+			pool:   nil,
+			points: []LiquidityPoints{{Address: addr1, Points: sdkmath.NewInt(5)}},
+			exp:    nil,
+		},
+		{
+			name:   "no points",
+			pool:   sdk.NewCoins(sdk.NewInt64Coin("apple", 100)),
+			points: nil,
+			exp:    nil,
+		},
+		{
+			name:   "one account gets everything",
+			pool:   sdk.NewCoins(sdk.NewInt64Coin("apple", 100), sdk.NewInt64Coin("banana", 3)),
+			points: []LiquidityPoints{{Address: addr1, Points: sdkmath.NewInt(5)}},
+			exp: []AccountAmount{
+				{Account: addr1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 100), sdk.NewInt64Coin("banana", 3))},
+			},
+		},
+		{
+			name: "pro-rata with rounding down",
+			pool: sdk.NewCoins(sdk.NewInt64Coin("apple", 100), sdk.NewInt64Coin("banana", 3)),
+			points: []LiquidityPoints{
+				{Address: addr1, Points: sdkmath.NewInt(50)},
+				{Address: addr2, Points: sdkmath.NewInt(30)},
+				{Address: addr3, Points: sdkmath.NewInt(20)},
+			},
+			exp: []AccountAmount{
+				{Account: addr1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 50), sdk.NewInt64Coin("banana", 1))},
+				{Account: addr2, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 30))},
+				{Account: addr3, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 20))},
+			},
+		},
+		{
+			name: "share too small is omitted",
+			pool: sdk.NewCoins(sdk.NewInt64Coin("apple", 10)),
+			points: []LiquidityPoints{
+				{Address: addr1, Points: sdkmath.NewInt(1_000)},
+				{Address: addr2, Points: sdkmath.NewInt(1)},
+			},
+			exp: []AccountAmount{
+				{Account: addr1, Amount: sdk.NewCoins(sdk.NewInt64Coin("apple", 9))},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act []AccountAmount
+			testFunc := func() {
+				act = CalculateRebates(tc.pool, tc.points)
+			}
+			assert.NotPanics(t, testFunc, "CalculateRebates")
+			assert.Equal(t, tc.exp, act, "CalculateRebates result")
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSettlementReceipt creates a new SettlementReceipt for the provided filled order.
+func NewSettlementReceipt(receiptID uint64, order OrderI, counterparties []string, partial bool, navs []NetAssetPrice, height int64) *SettlementReceipt {
+	return &SettlementReceipt{
+		ReceiptId:      receiptID,
+		MarketId:       order.GetMarketID(),
+		OrderId:        order.GetOrderID(),
+		OrderType:      order.GetOrderType(),
+		Owner:          order.GetOwner(),
+		Counterparties: counterparties,
+		Assets:         order.GetAssets(),
+		Price:          order.GetPrice(),
+		Fees:           order.GetSettlementFees(),
+		Partial:        partial,
+		ExternalId:     order.GetExternalID(),
+		Navs:           navs,
+		Height:         height,
+	}
+}
+
+// Validate returns an error if any of this SettlementReceipt's info is invalid.
+func (r SettlementReceipt) Validate() error {
+	var errs []error
+	if r.ReceiptId == 0 {
+		errs = append(errs, errors.New("invalid receipt id: must not be zero"))
+	}
+	if r.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: must not be zero"))
+	}
+	if r.OrderId == 0 {
+		errs = append(errs, errors.New("invalid order id: must not be zero"))
+	}
+	if r.OrderType != OrderTypeAsk && r.OrderType != OrderTypeBid {
+		errs = append(errs, fmt.Errorf("invalid order type %q: must be %q or %q", r.OrderType, OrderTypeAsk, OrderTypeBid))
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner %q: %w", r.Owner, err))
+	}
+	for i, cp := range r.Counterparties {
+		if _, err := sdk.AccAddressFromBech32(cp); err != nil {
+			errs = append(errs, fmt.Errorf("invalid counterparties[%d] %q: %w", i, cp, err))
+		}
+	}
+	if err := r.Assets.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid assets %q: %w", r.Assets, err))
+	}
+	if err := r.Price.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid price %q: %w", r.Price, err))
+	}
+	if err := r.Fees.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid fees %q: %w", r.Fees, err))
+	}
+	for i, nav := range r.Navs {
+		if err := nav.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid navs[%d]: %w", i, err))
+		}
+	}
+	if r.Height < 0 {
+		errs = append(errs, fmt.Errorf("invalid height %d: cannot be negative", r.Height))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,837 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/receipts.proto
+
+package exchange
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SettlementReceipt is a trade confirmation for an order that was filled (either in full or in part).
+type SettlementReceipt struct {
+	// receipt_id is the numerical identifier of this receipt.
+	ReceiptId uint64 `protobuf:"varint,1,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	// market_id is the numerical identifier of the market that the order was settled in.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// order_id is the numerical identifier of the order that was filled.
+	OrderId uint64 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of order that was filled, i.e. "ask" or "bid".
+	OrderType string `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	// owner is the bech32 address string of the account that owns the order.
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// counterparties are the bech32 address strings of the accounts on the other side of this fill.
+	Counterparties []string `protobuf:"bytes,6,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	// assets are the assets that were bought or sold in this fill.
+	Assets types.Coin `protobuf:"bytes,7,opt,name=assets,proto3" json:"assets"`
+	// price is the price that was paid or received in this fill.
+	Price types.Coin `protobuf:"bytes,8,opt,name=price,proto3" json:"price"`
+	// fees are the settlement fees that the owner paid for this fill.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// partial is true if the order was only partially filled.
+	Partial bool `protobuf:"varint,10,opt,name=partial,proto3" json:"partial,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,11,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// navs are the net asset values recorded as a result of the settlement that this fill was part of.
+	Navs []NetAssetPrice `protobuf:"bytes,12,rep,name=navs,proto3" json:"navs"`
+	// height is the block height at which the order was filled.
+	Height int64 `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SettlementReceipt) Reset()         { *m = SettlementReceipt{} }
+func (m *SettlementReceipt) String() string { return proto.CompactTextString(m) }
+func (*SettlementReceipt) ProtoMessage()    {}
+func (*SettlementReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7368a1b1d5e95d2, []int{0}
+}
+func (m *SettlementReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettlementReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettlementReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettlementReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementReceipt.Merge(m, src)
+}
+func (m *SettlementReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettlementReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementReceipt proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SettlementReceipt)(nil), "provenance.exchange.v1.SettlementReceipt")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/receipts.proto", fileDescriptor_c7368a1b1d5e95d2)
+}
+
+var fileDescriptor_c7368a1b1d5e95d2 = []byte{
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6b, 0xd4, 0x4e,
+	0x14, 0xde, 0xf9, 0x6d, 0xba, 0xdd, 0x9d, 0xb6, 0x3f, 0xe8, 0x50, 0xca, 0x6c, 0xc5, 0x6c, 0x10,
+	0x0a, 0xa1, 0xd0, 0x84, 0x2a, 0x22, 0x78, 0xd1, 0x56, 0x10, 0xf6, 0x22, 0x25, 0xf5, 0xe4, 0xa5,
+	0xcc, 0x26, 0xcf, 0xec, 0xd0, 0xcd, 0x4c, 0x98, 0x99, 0xae, 0xed, 0x7f, 0xe0, 0xd1, 0xb3, 0x27,
+	0x8f, 0xe2, 0xa9, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0xed, 0xa1, 0xff, 0x84, 0x07,
+	0x99, 0xc9, 0xac, 0x2d, 0x62, 0x8b, 0x97, 0x24, 0xdf, 0xfb, 0xbe, 0x2f, 0xf3, 0xde, 0x37, 0x0f,
+	0xaf, 0xd7, 0x4a, 0x4e, 0x41, 0x30, 0x91, 0x43, 0x0a, 0x47, 0xf9, 0x98, 0x89, 0x12, 0xd2, 0xe9,
+	0x56, 0xaa, 0x20, 0x07, 0x5e, 0x1b, 0x9d, 0xd4, 0x4a, 0x1a, 0x49, 0x56, 0xaf, 0x64, 0xc9, 0x4c,
+	0x96, 0x4c, 0xb7, 0xd6, 0x96, 0x59, 0xc5, 0x85, 0x4c, 0xdd, 0xb3, 0x91, 0xae, 0xf5, 0x73, 0xa9,
+	0x2b, 0xa9, 0xf7, 0x1d, 0x4a, 0x1b, 0xe0, 0xa9, 0xb0, 0x41, 0xe9, 0x88, 0x69, 0x7b, 0xc8, 0x08,
+	0x0c, 0xdb, 0x4a, 0x73, 0xc9, 0x85, 0xe7, 0x57, 0x4a, 0x59, 0xca, 0xc6, 0x67, 0xbf, 0x7c, 0x35,
+	0xbe, 0xa1, 0xc5, 0x5c, 0x56, 0x15, 0x37, 0x15, 0x88, 0x59, 0x97, 0xf7, 0x7e, 0x06, 0x78, 0x79,
+	0x0f, 0x8c, 0x99, 0x80, 0xad, 0x66, 0xcd, 0x08, 0xe4, 0x2e, 0xc6, 0x7e, 0x9a, 0x7d, 0x5e, 0x50,
+	0x14, 0xa1, 0x38, 0xc8, 0x7a, 0xbe, 0x32, 0x2c, 0xc8, 0x1d, 0xdc, 0xab, 0x98, 0x3a, 0x00, 0xc7,
+	0xfe, 0x17, 0xa1, 0x78, 0x29, 0xeb, 0x36, 0x85, 0x61, 0x41, 0xfa, 0xb8, 0x2b, 0x55, 0x01, 0xca,
+	0x72, 0x6d, 0xe7, 0x9c, 0x77, 0x78, 0x58, 0xd8, 0xdf, 0x36, 0x94, 0x39, 0xae, 0x81, 0x06, 0x11,
+	0x8a, 0x7b, 0x59, 0xcf, 0x55, 0x5e, 0x1e, 0xd7, 0x40, 0x12, 0x3c, 0x27, 0xdf, 0x08, 0x50, 0x74,
+	0xce, 0x32, 0x3b, 0xf4, 0xcb, 0xe7, 0xcd, 0x15, 0x1f, 0xc6, 0x76, 0x51, 0x28, 0xd0, 0x7a, 0xcf,
+	0x28, 0x2e, 0xca, 0xac, 0x91, 0x91, 0xa7, 0xf8, 0xff, 0x5c, 0x1e, 0x0a, 0x03, 0xaa, 0x66, 0xca,
+	0x70, 0xd0, 0xb4, 0x13, 0xb5, 0x6f, 0x35, 0xfe, 0xa1, 0x27, 0x8f, 0x70, 0x87, 0x69, 0x0d, 0x46,
+	0xd3, 0xf9, 0x08, 0xc5, 0x0b, 0xf7, 0xfb, 0x89, 0xb7, 0xd9, 0xb8, 0x13, 0x1f, 0x77, 0xf2, 0x4c,
+	0x72, 0xb1, 0x13, 0x9c, 0x7e, 0x1b, 0xb4, 0x32, 0x2f, 0x27, 0x0f, 0xf1, 0x5c, 0xad, 0x78, 0x0e,
+	0xb4, 0xfb, 0x6f, 0xbe, 0x46, 0x4d, 0x0e, 0x71, 0xf0, 0x1a, 0x40, 0xd3, 0x5e, 0xd4, 0xbe, 0xdd,
+	0xf5, 0xdc, 0xba, 0x3e, 0x7d, 0x1f, 0xc4, 0x25, 0x37, 0xe3, 0xc3, 0x51, 0x92, 0xcb, 0xca, 0xef,
+	0x85, 0x7f, 0x6d, 0xea, 0xe2, 0x20, 0xb5, 0x31, 0x6a, 0x67, 0xd0, 0xef, 0x2f, 0x4f, 0x36, 0x16,
+	0x27, 0x50, 0xb2, 0xfc, 0x78, 0xdf, 0xae, 0x87, 0xfe, 0x78, 0x79, 0xb2, 0x81, 0x32, 0x77, 0x1c,
+	0xa1, 0x78, 0xde, 0x4d, 0xcc, 0x26, 0x14, 0x47, 0x28, 0xee, 0x66, 0x33, 0x48, 0x06, 0x78, 0x01,
+	0x8e, 0x0c, 0x28, 0xc1, 0x26, 0xf6, 0xbe, 0x16, 0xdc, 0x95, 0xe0, 0x59, 0x69, 0x58, 0x90, 0x27,
+	0x38, 0x10, 0x6c, 0xaa, 0xe9, 0xa2, 0xeb, 0x78, 0x3d, 0xf9, 0xfb, 0x52, 0x27, 0x2f, 0xc0, 0x6c,
+	0xdb, 0x64, 0x76, 0xed, 0x98, 0x7e, 0x66, 0x67, 0x24, 0xab, 0xb8, 0x33, 0x06, 0x5e, 0x8e, 0x0d,
+	0x5d, 0x8a, 0x50, 0xdc, 0xce, 0x3c, 0x7a, 0x1c, 0xbc, 0xfd, 0x30, 0x68, 0xed, 0xc0, 0xe9, 0x79,
+	0x88, 0xce, 0xce, 0x43, 0xf4, 0xe3, 0x3c, 0x44, 0xef, 0x2e, 0xc2, 0xd6, 0xd9, 0x45, 0xd8, 0xfa,
+	0x7a, 0x11, 0xb6, 0x70, 0x9f, 0xcb, 0x1b, 0x0e, 0xdb, 0x45, 0xaf, 0x92, 0x6b, 0xb1, 0x5c, 0x89,
+	0x36, 0xb9, 0xbc, 0x86, 0xd2, 0xa3, 0xdf, 0xab, 0x3f, 0xea, 0xb8, 0x65, 0x7f, 0xf0, 0x6b, 0x00,
+	0x76, 0xbc, 0xdd, 0x0b, 0xbb, 0x03, 0x00, 0x00,
+}
+
+func (m *SettlementReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SettlementReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SettlementReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintReceipts(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReceipts(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintReceipts(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Partial {
+		i--
+		if m.Partial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReceipts(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReceipts(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReceipts(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Counterparties) > 0 {
+		for iNdEx := len(m.Counterparties) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Counterparties[iNdEx])
+			copy(dAtA[i:], m.Counterparties[iNdEx])
+			i = encodeVarintReceipts(dAtA, i, uint64(len(m.Counterparties[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintReceipts(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintReceipts(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintReceipts(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintReceipts(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReceiptId != 0 {
+		i = encodeVarintReceipts(dAtA, i, uint64(m.ReceiptId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReceipts(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReceipts(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SettlementReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReceiptId != 0 {
+		n += 1 + sovReceipts(uint64(m.ReceiptId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovReceipts(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovReceipts(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovReceipts(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovReceipts(uint64(l))
+	}
+	if len(m.Counterparties) > 0 {
+		for _, s := range m.Counterparties {
+			l = len(s)
+			n += 1 + l + sovReceipts(uint64(l))
+		}
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovReceipts(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovReceipts(uint64(l))
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovReceipts(uint64(l))
+		}
+	}
+	if m.Partial {
+		n += 2
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovReceipts(uint64(l))
+	}
+	if len(m.Navs) > 0 {
+		for _, e := range m.Navs {
+			l = e.Size()
+			n += 1 + l + sovReceipts(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovReceipts(uint64(m.Height))
+	}
+	return n
+}
+
+func sovReceipts(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReceipts(x uint64) (n int) {
+	return sovReceipts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SettlementReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReceipts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SettlementReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SettlementReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptId", wireType)
+			}
+			m.ReceiptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparties = append(m.Counterparties, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partial = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Navs = append(m.Navs, NetAssetPrice{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReceipts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReceipts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReceipts(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReceipts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReceipts
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReceipts
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReceipts
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReceipts        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReceipts          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReceipts = fmt.Errorf("proto: unexpected end of group")
+)
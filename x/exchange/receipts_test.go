@@ -0,0 +1,203 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestNewSettlementReceipt(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	navs := []NetAssetPrice{{Assets: sdk.NewInt64Coin("apple", 15), Price: sdk.NewInt64Coin("plum", 90)}}
+	feeCoin := sdk.NewInt64Coin("fig", 3)
+
+	tests := []struct {
+		name           string
+		receiptID      uint64
+		order          OrderI
+		counterparties []string
+		partial        bool
+		navs           []NetAssetPrice
+		height         int64
+		expected       *SettlementReceipt
+	}{
+		{
+			name:      "ask order",
+			receiptID: 5,
+			order: NewOrder(8).WithAsk(&AskOrder{
+				MarketId:                2,
+				Seller:                  seller,
+				Assets:                  sdk.NewInt64Coin("apple", 10),
+				Price:                   sdk.NewInt64Coin("plum", 55),
+				SellerSettlementFlatFee: &feeCoin,
+				ExternalId:              "ask-ext",
+			}),
+			counterparties: []string{buyer},
+			navs:           navs,
+			height:         100,
+			expected: &SettlementReceipt{
+				ReceiptId:      5,
+				MarketId:       2,
+				OrderId:        8,
+				OrderType:      OrderTypeAsk,
+				Owner:          seller,
+				Counterparties: []string{buyer},
+				Assets:         sdk.NewInt64Coin("apple", 10),
+				Price:          sdk.NewInt64Coin("plum", 55),
+				Fees:           sdk.Coins{feeCoin},
+				ExternalId:     "ask-ext",
+				Navs:           navs,
+				Height:         100,
+			},
+		},
+		{
+			name:      "partially filled bid order",
+			receiptID: 6,
+			order: NewFilledOrder(NewOrder(9).WithBid(&BidOrder{
+				MarketId:            2,
+				Buyer:               buyer,
+				Assets:              sdk.NewInt64Coin("apple", 20),
+				Price:               sdk.NewInt64Coin("plum", 120),
+				BuyerSettlementFees: sdk.NewCoins(sdk.NewInt64Coin("fig", 8)),
+			}), sdk.NewInt64Coin("plum", 60), sdk.NewCoins(sdk.NewInt64Coin("fig", 4))),
+			counterparties: []string{seller},
+			partial:        true,
+			navs:           navs,
+			height:         101,
+			expected: &SettlementReceipt{
+				ReceiptId:      6,
+				MarketId:       2,
+				OrderId:        9,
+				OrderType:      OrderTypeBid,
+				Owner:          buyer,
+				Counterparties: []string{seller},
+				Assets:         sdk.NewInt64Coin("apple", 20),
+				Price:          sdk.NewInt64Coin("plum", 60),
+				Fees:           sdk.NewCoins(sdk.NewInt64Coin("fig", 4)),
+				Partial:        true,
+				Navs:           navs,
+				Height:         101,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual *SettlementReceipt
+			testFunc := func() {
+				actual = NewSettlementReceipt(tc.receiptID, tc.order, tc.counterparties, tc.partial, tc.navs, tc.height)
+			}
+			require.NotPanics(t, testFunc, "NewSettlementReceipt")
+			assert.Equal(t, tc.expected, actual, "NewSettlementReceipt result")
+		})
+	}
+}
+
+func TestSettlementReceipt_Validate(t *testing.T) {
+	seller := sdk.AccAddress("seller______________").String()
+	buyer := sdk.AccAddress("buyer_______________").String()
+	goodReceipt := func() SettlementReceipt {
+		return SettlementReceipt{
+			ReceiptId:      1,
+			MarketId:       2,
+			OrderId:        3,
+			OrderType:      OrderTypeAsk,
+			Owner:          seller,
+			Counterparties: []string{buyer},
+			Assets:         sdk.NewInt64Coin("apple", 10),
+			Price:          sdk.NewInt64Coin("plum", 55),
+			Fees:           sdk.NewCoins(sdk.NewInt64Coin("fig", 2)),
+			Navs:           []NetAssetPrice{{Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("plum", 55)}},
+			Height:         4,
+		}
+	}
+	withChange := func(changer func(r *SettlementReceipt)) SettlementReceipt {
+		rv := goodReceipt()
+		changer(&rv)
+		return rv
+	}
+
+	tests := []struct {
+		name    string
+		receipt SettlementReceipt
+		expErr  []string
+	}{
+		{
+			name:    "control",
+			receipt: goodReceipt(),
+		},
+		{
+			name:    "zero receipt id",
+			receipt: withChange(func(r *SettlementReceipt) { r.ReceiptId = 0 }),
+			expErr:  []string{"invalid receipt id: must not be zero"},
+		},
+		{
+			name:    "zero market id",
+			receipt: withChange(func(r *SettlementReceipt) { r.MarketId = 0 }),
+			expErr:  []string{"invalid market id: must not be zero"},
+		},
+		{
+			name:    "zero order id",
+			receipt: withChange(func(r *SettlementReceipt) { r.OrderId = 0 }),
+			expErr:  []string{"invalid order id: must not be zero"},
+		},
+		{
+			name:    "unknown order type",
+			receipt: withChange(func(r *SettlementReceipt) { r.OrderType = "swap" }),
+			expErr:  []string{"invalid order type \"swap\": must be \"ask\" or \"bid\""},
+		},
+		{
+			name:    "bad owner",
+			receipt: withChange(func(r *SettlementReceipt) { r.Owner = "owner" }),
+			expErr:  []string{"invalid owner \"owner\": "},
+		},
+		{
+			name:    "bad counterparty",
+			receipt: withChange(func(r *SettlementReceipt) { r.Counterparties = []string{buyer, "cp"} }),
+			expErr:  []string{"invalid counterparties[1] \"cp\": "},
+		},
+		{
+			name: "bad assets, price, and fees",
+			receipt: withChange(func(r *SettlementReceipt) {
+				r.Assets = sdk.Coin{Denom: "apple", Amount: sdkmath.NewInt(-1)}
+				r.Price = sdk.Coin{Denom: "p", Amount: sdkmath.NewInt(5)}
+				r.Fees = sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(0)}}
+			}),
+			expErr: []string{
+				"invalid assets \"-1apple\": negative coin amount: -1",
+				"invalid price \"5p\": invalid denom: p",
+				"invalid fees \"0fig\": coin 0fig amount is not positive",
+			},
+		},
+		{
+			name: "bad nav",
+			receipt: withChange(func(r *SettlementReceipt) {
+				r.Navs = append(r.Navs, NetAssetPrice{Assets: sdk.NewInt64Coin("apple", 0), Price: sdk.NewInt64Coin("plum", 1)})
+			}),
+			expErr: []string{"invalid navs[1]: invalid assets \"0apple\": cannot be zero"},
+		},
+		{
+			name:    "negative height",
+			receipt: withChange(func(r *SettlementReceipt) { r.Height = -3 }),
+			expErr:  []string{"invalid height -3: cannot be negative"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.receipt.Validate()
+			}
+			require.NotPanics(t, testFunc, "SettlementReceipt.Validate")
+			assertions.AssertErrorContents(t, err, tc.expErr, "SettlementReceipt.Validate")
+		})
+	}
+}
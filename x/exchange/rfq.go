@@ -0,0 +1,116 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if any of this RequestForQuote's info is invalid.
+func (r RequestForQuote) Validate() error {
+	var errs []error
+	if r.RfqId == 0 {
+		errs = append(errs, errors.New("invalid rfq id: cannot be zero"))
+	}
+	if r.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Buyer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid buyer %q: %w", r.Buyer, err))
+	}
+	if err := validateRFQAssets(r.Assets, r.PriceDenom); err != nil {
+		errs = append(errs, err)
+	}
+	if r.ExpirationHeight <= 0 {
+		errs = append(errs, fmt.Errorf("invalid expiration height %d: must be positive", r.ExpirationHeight))
+	}
+
+	seen := make(map[string]int, len(r.Quotes))
+	for i, quote := range r.Quotes {
+		if j, dup := seen[quote.Dealer]; dup {
+			errs = append(errs, fmt.Errorf("invalid quotes[%d]: duplicate dealer %s seen at [%d]", i, quote.Dealer, j))
+			continue
+		}
+		seen[quote.Dealer] = i
+		if err := quote.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid quotes[%d]: %w", i, err))
+			continue
+		}
+		if err := r.ValidateQuote(quote); err != nil {
+			errs = append(errs, fmt.Errorf("invalid quotes[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateRFQAssets returns an error if the assets or price denom of a request for quote are invalid.
+func validateRFQAssets(assets sdk.Coin, priceDenom string) error {
+	var errs []error
+	if err := validateCoin("assets", assets); err != nil {
+		errs = append(errs, err)
+	}
+	if err := sdk.ValidateDenom(priceDenom); err != nil {
+		errs = append(errs, fmt.Errorf("invalid price denom %q: %w", priceDenom, err))
+	} else if assets.Denom == priceDenom {
+		errs = append(errs, fmt.Errorf("invalid assets: price denom %s cannot also be the assets denom", priceDenom))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateQuote returns an error if the provided quote cannot be used for this request for quote.
+// It does not check the validity of the quote itself; use Quote.Validate for that.
+func (r RequestForQuote) ValidateQuote(quote Quote) error {
+	if quote.Dealer == r.Buyer {
+		return fmt.Errorf("dealer %s cannot be the buyer", quote.Dealer)
+	}
+	if quote.Price.Denom != r.PriceDenom {
+		return fmt.Errorf("quote price denom %s does not equal request price denom %s", quote.Price.Denom, r.PriceDenom)
+	}
+	return nil
+}
+
+// FindQuote returns the quote from the provided dealer, or nil if the dealer hasn't submitted one.
+func (r RequestForQuote) FindQuote(dealer string) *Quote {
+	for i := range r.Quotes {
+		if r.Quotes[i].Dealer == dealer {
+			return &r.Quotes[i]
+		}
+	}
+	return nil
+}
+
+// QuoteAskOrder returns the ask order that the provided quote represents.
+func (r RequestForQuote) QuoteAskOrder(quote Quote) *AskOrder {
+	return &AskOrder{
+		MarketId:                r.MarketId,
+		Seller:                  quote.Dealer,
+		Assets:                  r.Assets,
+		Price:                   quote.Price,
+		SellerSettlementFlatFee: quote.SellerSettlementFlatFee,
+	}
+}
+
+// GetQuoteHoldAmount returns the funds that are placed on hold in the dealer's account for the provided quote.
+func (r RequestForQuote) GetQuoteHoldAmount(quote Quote) sdk.Coins {
+	return r.QuoteAskOrder(quote).GetHoldAmount()
+}
+
+// Validate returns an error if any of this Quote's info is invalid.
+func (q Quote) Validate() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(q.Dealer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid dealer %q: %w", q.Dealer, err))
+	}
+	if err := validateCoin("price", q.Price); err != nil {
+		errs = append(errs, err)
+	}
+	if q.SellerSettlementFlatFee != nil {
+		if err := q.SellerSettlementFlatFee.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid seller settlement flat fee: %w", err))
+		} else if q.SellerSettlementFlatFee.IsZero() {
+			errs = append(errs, fmt.Errorf("invalid seller settlement flat fee: %s amount cannot be zero", q.SellerSettlementFlatFee.Denom))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,834 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/rfq.proto
+
+package exchange
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RequestForQuote is a buyer's request for dealers to quote a price for some assets.
+type RequestForQuote struct {
+	// rfq_id is the numerical identifier of this request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// market_id is the numerical identifier of the market that the quotes are settled in.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// buyer is the bech32 address string of the account that wants to buy the assets.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// assets are the funds that the buyer wants to buy.
+	Assets types.Coin `protobuf:"bytes,4,opt,name=assets,proto3" json:"assets"`
+	// price_denom is the denom that the quotes must be priced in.
+	PriceDenom string `protobuf:"bytes,5,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// expiration_height is the block height at which this request (and all its quotes) will be cancelled.
+	ExpirationHeight int64 `protobuf:"varint,6,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// quotes are the quotes that dealers have submitted for this request.
+	Quotes []Quote `protobuf:"bytes,7,rep,name=quotes,proto3" json:"quotes"`
+}
+
+func (m *RequestForQuote) Reset()         { *m = RequestForQuote{} }
+func (m *RequestForQuote) String() string { return proto.CompactTextString(m) }
+func (*RequestForQuote) ProtoMessage()    {}
+func (*RequestForQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef9578f60339f4b6, []int{0}
+}
+func (m *RequestForQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestForQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestForQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestForQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestForQuote.Merge(m, src)
+}
+func (m *RequestForQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestForQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestForQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestForQuote proto.InternalMessageInfo
+
+// Quote is a dealer's offer to sell the assets of a request for quote for a price.
+type Quote struct {
+	// dealer is the bech32 address string of the account offering to sell the assets.
+	// The assets are placed on hold in this account until the quote is accepted, withdrawn, or expires.
+	Dealer string `protobuf:"bytes,1,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// price is the amount that the dealer wants for the assets.
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// seller_settlement_flat_fee is the flat fee the dealer will pay if this quote is accepted.
+	SellerSettlementFlatFee *types.Coin `protobuf:"bytes,3,opt,name=seller_settlement_flat_fee,json=sellerSettlementFlatFee,proto3" json:"seller_settlement_flat_fee,omitempty"`
+}
+
+func (m *Quote) Reset()         { *m = Quote{} }
+func (m *Quote) String() string { return proto.CompactTextString(m) }
+func (*Quote) ProtoMessage()    {}
+func (*Quote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef9578f60339f4b6, []int{1}
+}
+func (m *Quote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quote.Merge(m, src)
+}
+func (m *Quote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quote proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RequestForQuote)(nil), "provenance.exchange.v1.RequestForQuote")
+	proto.RegisterType((*Quote)(nil), "provenance.exchange.v1.Quote")
+}
+
+func init() { proto.RegisterFile("provenance/exchange/v1/rfq.proto", fileDescriptor_ef9578f60339f4b6) }
+
+var fileDescriptor_ef9578f60339f4b6 = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xfe, 0x09, 0x9c, 0x2b, 0x04, 0x58, 0x07, 0xb8, 0x45, 0xa4, 0xd1, 0x4d, 0x91,
+	0xd0, 0x39, 0xf4, 0x10, 0x42, 0x82, 0x89, 0x82, 0x2a, 0x6e, 0x83, 0x9c, 0xc4, 0xc0, 0x12, 0xb9,
+	0xc9, 0xdb, 0xd4, 0x22, 0x8d, 0x1b, 0xdb, 0xad, 0xca, 0x37, 0x60, 0xe4, 0x23, 0xf0, 0x21, 0xd8,
+	0x59, 0x6f, 0x42, 0x27, 0x26, 0x26, 0x84, 0xda, 0x2f, 0x82, 0x12, 0xe7, 0xae, 0x0c, 0xa0, 0xbb,
+	0x2d, 0xef, 0xf3, 0xfe, 0x1e, 0xe7, 0xf1, 0x23, 0x63, 0x6f, 0xa1, 0xe4, 0x0a, 0x72, 0x9e, 0xc7,
+	0x10, 0xc0, 0x3a, 0x9e, 0xf1, 0x3c, 0x85, 0x60, 0x35, 0x0c, 0xd4, 0xb4, 0x60, 0x0b, 0x25, 0x8d,
+	0x24, 0x77, 0x77, 0x04, 0x3b, 0x27, 0xd8, 0x6a, 0xd8, 0xef, 0xc5, 0x52, 0xcf, 0xa5, 0x8e, 0x2a,
+	0x2a, 0xb0, 0x83, 0xb5, 0xf4, 0x5d, 0x3b, 0x05, 0x13, 0xae, 0xcb, 0xc3, 0x26, 0x60, 0xf8, 0x30,
+	0x88, 0xa5, 0xc8, 0xeb, 0xfd, 0x7e, 0x2a, 0x53, 0x69, 0x7d, 0xe5, 0x97, 0x55, 0x0f, 0xbe, 0x35,
+	0xf1, 0xcd, 0x10, 0x8a, 0x25, 0x68, 0x33, 0x96, 0xea, 0xed, 0x52, 0x1a, 0x20, 0x77, 0xb0, 0xa3,
+	0xa6, 0x45, 0x24, 0x12, 0x8a, 0x3c, 0xe4, 0xb7, 0xc3, 0x8e, 0x9a, 0x16, 0xc7, 0x09, 0xb9, 0x8f,
+	0xf7, 0xe6, 0x5c, 0x7d, 0x00, 0x53, 0x6e, 0x9a, 0x1e, 0xf2, 0x6f, 0x84, 0xd7, 0xad, 0x70, 0x9c,
+	0x10, 0x86, 0x3b, 0x93, 0xe5, 0x47, 0x50, 0xb4, 0xe5, 0x21, 0x7f, 0x6f, 0x44, 0x7f, 0x7c, 0x3d,
+	0xdc, 0xaf, 0xe3, 0xbd, 0x48, 0x12, 0x05, 0x5a, 0x9f, 0x18, 0x25, 0xf2, 0x34, 0xb4, 0x18, 0x79,
+	0x8a, 0x1d, 0xae, 0x35, 0x18, 0x4d, 0xdb, 0x1e, 0xf2, 0xbb, 0x47, 0x3d, 0x56, 0xd3, 0x65, 0x7c,
+	0x56, 0xc7, 0x67, 0x2f, 0xa5, 0xc8, 0x47, 0xed, 0xd3, 0x5f, 0x83, 0x46, 0x58, 0xe3, 0x64, 0x80,
+	0xbb, 0x0b, 0x25, 0x62, 0x88, 0x12, 0xc8, 0xe5, 0x9c, 0x76, 0xca, 0xdf, 0x85, 0xb8, 0x92, 0x5e,
+	0x95, 0x0a, 0x79, 0x88, 0x6f, 0xc3, 0x7a, 0x21, 0x14, 0x37, 0x42, 0xe6, 0xd1, 0x0c, 0x44, 0x3a,
+	0x33, 0xd4, 0xf1, 0x90, 0xdf, 0x0a, 0x6f, 0xed, 0x16, 0xaf, 0x2b, 0x9d, 0x3c, 0xc7, 0x4e, 0x51,
+	0xde, 0x59, 0xd3, 0x6b, 0x5e, 0xcb, 0xef, 0x1e, 0x3d, 0x60, 0xff, 0x2e, 0x9e, 0x55, 0xcd, 0x9c,
+	0x47, 0xb1, 0x96, 0x67, 0xed, 0x4f, 0x5f, 0x06, 0x8d, 0x83, 0xef, 0x08, 0x77, 0x6c, 0x6f, 0x8f,
+	0xb0, 0x93, 0x00, 0xcf, 0x40, 0x51, 0x74, 0x49, 0x09, 0x35, 0x47, 0x9e, 0xe0, 0x4e, 0x95, 0x9c,
+	0x36, 0xaf, 0x56, 0x82, 0xa5, 0xc9, 0x3b, 0xdc, 0xd7, 0x90, 0x65, 0xa0, 0x22, 0x0d, 0xc6, 0x64,
+	0x30, 0x87, 0xdc, 0x44, 0xd3, 0x8c, 0x9b, 0x68, 0x0a, 0x40, 0x5b, 0x97, 0x9c, 0x15, 0xde, 0xb3,
+	0xe6, 0x93, 0x0b, 0xef, 0x38, 0xe3, 0x66, 0x0c, 0x60, 0x2f, 0x34, 0x82, 0xd3, 0x8d, 0x8b, 0xce,
+	0x36, 0x2e, 0xfa, 0xbd, 0x71, 0xd1, 0xe7, 0xad, 0xdb, 0x38, 0xdb, 0xba, 0x8d, 0x9f, 0x5b, 0xb7,
+	0x81, 0x7b, 0x42, 0xfe, 0xa7, 0x9f, 0x37, 0xe8, 0x3d, 0x4b, 0x85, 0x99, 0x2d, 0x27, 0x2c, 0x96,
+	0xf3, 0x60, 0x07, 0x1d, 0x0a, 0xf9, 0xd7, 0x14, 0xac, 0x2f, 0xde, 0xfb, 0xc4, 0xa9, 0x1e, 0xe0,
+	0xe3, 0x3f, 0x03, 0x00, 0x49, 0x03, 0x38, 0xf6, 0x0d, 0x03, 0x00, 0x00,
+}
+
+func (m *RequestForQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestForQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestForQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotes) > 0 {
+		for iNdEx := len(m.Quotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRfq(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintRfq(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintRfq(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRfq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintRfq(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintRfq(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RfqId != 0 {
+		i = encodeVarintRfq(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Quote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellerSettlementFlatFee != nil {
+		{
+			size, err := m.SellerSettlementFlatFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRfq(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRfq(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintRfq(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRfq(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRfq(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RequestForQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovRfq(uint64(m.RfqId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovRfq(uint64(m.MarketId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovRfq(uint64(l))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovRfq(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovRfq(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovRfq(uint64(m.ExpirationHeight))
+	}
+	if len(m.Quotes) > 0 {
+		for _, e := range m.Quotes {
+			l = e.Size()
+			n += 1 + l + sovRfq(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Quote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovRfq(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovRfq(uint64(l))
+	if m.SellerSettlementFlatFee != nil {
+		l = m.SellerSettlementFlatFee.Size()
+		n += 1 + l + sovRfq(uint64(l))
+	}
+	return n
+}
+
+func sovRfq(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRfq(x uint64) (n int) {
+	return sovRfq(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RequestForQuote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRfq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestForQuote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestForQuote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotes = append(m.Quotes, Quote{})
+			if err := m.Quotes[len(m.Quotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRfq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRfq
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerSettlementFlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRfq
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerSettlementFlatFee == nil {
+				m.SellerSettlementFlatFee = &types.Coin{}
+			}
+			if err := m.SellerSettlementFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRfq(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRfq
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRfq(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRfq
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRfq
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRfq
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRfq
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRfq
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRfq        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRfq          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRfq = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,153 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestRequestForQuote_Validate(t *testing.T) {
+	buyer := sdk.AccAddress("buyer_______________").String()
+	dealer1 := sdk.AccAddress("dealer1_____________").String()
+	dealer2 := sdk.AccAddress("dealer2_____________").String()
+	newRFQ := func(quotes ...Quote) RequestForQuote {
+		return RequestForQuote{
+			RfqId:            3,
+			MarketId:         5,
+			Buyer:            buyer,
+			Assets:           sdk.NewInt64Coin("apple", 10),
+			PriceDenom:       "pear",
+			ExpirationHeight: 100,
+			Quotes:           quotes,
+		}
+	}
+	quote := func(dealer string, price string) Quote {
+		priceCoin, err := sdk.ParseCoinNormalized(price)
+		if err != nil {
+			panic(err)
+		}
+		return Quote{Dealer: dealer, Price: priceCoin}
+	}
+
+	tests := []struct {
+		name   string
+		rfq    RequestForQuote
+		expErr []string
+	}{
+		{
+			name: "control: no quotes",
+			rfq:  newRFQ(),
+		},
+		{
+			name: "control: two quotes",
+			rfq:  newRFQ(quote(dealer1, "12pear"), quote(dealer2, "13pear")),
+		},
+		{
+			name: "empty",
+			rfq:  RequestForQuote{},
+			expErr: []string{
+				"invalid rfq id: cannot be zero",
+				"invalid market id: cannot be zero",
+				"invalid buyer \"\": empty address string is not allowed",
+				"invalid assets: invalid denom: ",
+				"invalid price denom \"\": invalid denom: ",
+				"invalid expiration height 0: must be positive",
+			},
+		},
+		{
+			name: "price denom same as assets",
+			rfq: RequestForQuote{
+				RfqId: 1, MarketId: 1, Buyer: buyer, Assets: sdk.NewInt64Coin("apple", 10),
+				PriceDenom: "apple", ExpirationHeight: 100,
+			},
+			expErr: []string{"invalid assets: price denom apple cannot also be the assets denom"},
+		},
+		{
+			name:   "duplicate dealer",
+			rfq:    newRFQ(quote(dealer1, "12pear"), quote(dealer1, "13pear")),
+			expErr: []string{"invalid quotes[1]: duplicate dealer " + dealer1 + " seen at [0]"},
+		},
+		{
+			name: "bad quotes",
+			rfq: newRFQ(
+				quote("", "12pear"),
+				quote(dealer1, "12plum"),
+				quote(buyer, "12pear"),
+			),
+			expErr: []string{
+				"invalid quotes[0]: invalid dealer \"\": empty address string is not allowed",
+				"invalid quotes[1]: quote price denom plum does not equal request price denom pear",
+				"invalid quotes[2]: dealer " + buyer + " cannot be the buyer",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.rfq.Validate()
+			}
+			assert.NotPanics(t, testFunc, "RequestForQuote.Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "RequestForQuote.Validate()")
+		})
+	}
+}
+
+func TestRequestForQuote_FindQuote(t *testing.T) {
+	dealer1 := sdk.AccAddress("dealer1_____________").String()
+	dealer2 := sdk.AccAddress("dealer2_____________").String()
+	dealer3 := sdk.AccAddress("dealer3_____________").String()
+	rfq := RequestForQuote{Quotes: []Quote{
+		{Dealer: dealer1, Price: sdk.NewInt64Coin("pear", 1)},
+		{Dealer: dealer2, Price: sdk.NewInt64Coin("pear", 2)},
+	}}
+
+	assert.Equal(t, &rfq.Quotes[1], rfq.FindQuote(dealer2), "FindQuote(dealer2)")
+	assert.Nil(t, rfq.FindQuote(dealer3), "FindQuote(dealer3)")
+	assert.Nil(t, RequestForQuote{}.FindQuote(dealer1), "FindQuote on empty rfq")
+}
+
+func TestRequestForQuote_GetQuoteHoldAmount(t *testing.T) {
+	dealer := sdk.AccAddress("dealer______________").String()
+	rfq := RequestForQuote{MarketId: 2, Assets: sdk.NewInt64Coin("apple", 10), PriceDenom: "pear"}
+	priceFee := sdk.NewInt64Coin("pear", 1)
+	otherFee := sdk.NewInt64Coin("fig", 2)
+
+	tests := []struct {
+		name  string
+		quote Quote
+		exp   sdk.Coins
+	}{
+		{
+			name:  "no fee",
+			quote: Quote{Dealer: dealer, Price: sdk.NewInt64Coin("pear", 5)},
+			exp:   sdk.NewCoins(sdk.NewInt64Coin("apple", 10)),
+		},
+		{
+			name:  "fee in price denom",
+			quote: Quote{Dealer: dealer, Price: sdk.NewInt64Coin("pear", 5), SellerSettlementFlatFee: &priceFee},
+			exp:   sdk.NewCoins(sdk.NewInt64Coin("apple", 10)),
+		},
+		{
+			name:  "fee in other denom",
+			quote: Quote{Dealer: dealer, Price: sdk.NewInt64Coin("pear", 5), SellerSettlementFlatFee: &otherFee},
+			exp:   sdk.NewCoins(sdk.NewInt64Coin("apple", 10), otherFee),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act sdk.Coins
+			testFunc := func() {
+				act = rfq.GetQuoteHoldAmount(tc.quote)
+			}
+			assert.NotPanics(t, testFunc, "GetQuoteHoldAmount")
+			assert.Equal(t, tc.exp.String(), act.String(), "GetQuoteHoldAmount result")
+		})
+	}
+}
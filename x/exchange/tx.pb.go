@@ -725,6 +725,13 @@ type MsgMarketSettleRequest struct {
 	// the last ask order, or last bid order will be partially filled by this settlement. Set to false to indicate
 	// that all provided orders will be filled in full during this settlement.
 	ExpectPartial bool `protobuf:"varint,5,opt,name=expect_partial,json=expectPartial,proto3" json:"expect_partial,omitempty"`
+	// net_transfers is whether to offset the amounts that the same two parties owe each other across the orders in
+	// this settlement, so that only the net amounts are transferred between them. Fees are not affected.
+	NetTransfers bool `protobuf:"varint,6,opt,name=net_transfers,json=netTransfers,proto3" json:"net_transfers,omitempty"`
+	// override_price_band is whether to settle these orders even if they are outside the market's price band or the
+	// market's settlements are paused. Only an account with "update" permission in the market (or the governance
+	// module account) can use this.
+	OverridePriceBand bool `protobuf:"varint,7,opt,name=override_price_band,json=overridePriceBand,proto3" json:"override_price_band,omitempty"`
 }
 
 func (m *MsgMarketSettleRequest) Reset()         { *m = MsgMarketSettleRequest{} }
@@ -795,6 +802,20 @@ func (m *MsgMarketSettleRequest) GetExpectPartial() bool {
 	return false
 }
 
+func (m *MsgMarketSettleRequest) GetNetTransfers() bool {
+	if m != nil {
+		return m.NetTransfers
+	}
+	return false
+}
+
+func (m *MsgMarketSettleRequest) GetOverridePriceBand() bool {
+	if m != nil {
+		return m.OverridePriceBand
+	}
+	return false
+}
+
 // MsgMarketSettleResponse is a response message for the MarketSettle endpoint.
 type MsgMarketSettleResponse struct {
 }
@@ -832,37 +853,32 @@ func (m *MsgMarketSettleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarketSettleResponse proto.InternalMessageInfo
 
-// MsgMarketCommitmentSettleRequest is a request message for the MarketCommitmentSettle endpoint.
-type MsgMarketCommitmentSettleRequest struct {
-	// admin is the account with "settle" permission requesting this settlement.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market requesting this settlement.
+// MsgCreateRFQRequest is a request message for the CreateRFQ endpoint.
+type MsgCreateRFQRequest struct {
+	// buyer is the address of the account that wants to buy the assets.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// market_id is the numerical identifier of the market that the quotes will be settled in.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// inputs defines where the funds are coming from. All of these funds must be already committed to the market.
-	Inputs []AccountAmount `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs"`
-	// outputs defines how the funds are to be distributed. These funds will be re-committed in the destination accounts.
-	Outputs []AccountAmount `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs"`
-	// fees is the funds that the market is collecting as part of this settlement.
-	// All of these funds must be already committed to the market.
-	Fees []AccountAmount `protobuf:"bytes,5,rep,name=fees,proto3" json:"fees"`
-	// navs are any NAV info that should be updated at the beginning of this settlement.
-	Navs []NetAssetPrice `protobuf:"bytes,6,rep,name=navs,proto3" json:"navs"`
-	// event_tag is a string that is included in the funds-committed/released events. Max length is 100 characters.
-	EventTag string `protobuf:"bytes,7,opt,name=event_tag,json=eventTag,proto3" json:"event_tag,omitempty"`
-}
-
-func (m *MsgMarketCommitmentSettleRequest) Reset()         { *m = MsgMarketCommitmentSettleRequest{} }
-func (m *MsgMarketCommitmentSettleRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketCommitmentSettleRequest) ProtoMessage()    {}
-func (*MsgMarketCommitmentSettleRequest) Descriptor() ([]byte, []int) {
+	// assets are the funds that the buyer wants to buy.
+	Assets types.Coin `protobuf:"bytes,3,opt,name=assets,proto3" json:"assets"`
+	// price_denom is the denom that the quotes must be priced in.
+	PriceDenom string `protobuf:"bytes,4,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// expiration_height is the block height at which the request (and all its quotes) will be cancelled.
+	ExpirationHeight int64 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *MsgCreateRFQRequest) Reset()         { *m = MsgCreateRFQRequest{} }
+func (m *MsgCreateRFQRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRFQRequest) ProtoMessage()    {}
+func (*MsgCreateRFQRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{14}
 }
-func (m *MsgMarketCommitmentSettleRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRFQRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketCommitmentSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRFQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketCommitmentSettleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRFQRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,83 +888,71 @@ func (m *MsgMarketCommitmentSettleRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketCommitmentSettleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketCommitmentSettleRequest.Merge(m, src)
+func (m *MsgCreateRFQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRFQRequest.Merge(m, src)
 }
-func (m *MsgMarketCommitmentSettleRequest) XXX_Size() int {
+func (m *MsgCreateRFQRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketCommitmentSettleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketCommitmentSettleRequest.DiscardUnknown(m)
+func (m *MsgCreateRFQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRFQRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketCommitmentSettleRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRFQRequest proto.InternalMessageInfo
 
-func (m *MsgMarketCommitmentSettleRequest) GetAdmin() string {
+func (m *MsgCreateRFQRequest) GetBuyer() string {
 	if m != nil {
-		return m.Admin
+		return m.Buyer
 	}
 	return ""
 }
 
-func (m *MsgMarketCommitmentSettleRequest) GetMarketId() uint32 {
+func (m *MsgCreateRFQRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketCommitmentSettleRequest) GetInputs() []AccountAmount {
-	if m != nil {
-		return m.Inputs
-	}
-	return nil
-}
-
-func (m *MsgMarketCommitmentSettleRequest) GetOutputs() []AccountAmount {
-	if m != nil {
-		return m.Outputs
-	}
-	return nil
-}
-
-func (m *MsgMarketCommitmentSettleRequest) GetFees() []AccountAmount {
+func (m *MsgCreateRFQRequest) GetAssets() types.Coin {
 	if m != nil {
-		return m.Fees
+		return m.Assets
 	}
-	return nil
+	return types.Coin{}
 }
 
-func (m *MsgMarketCommitmentSettleRequest) GetNavs() []NetAssetPrice {
+func (m *MsgCreateRFQRequest) GetPriceDenom() string {
 	if m != nil {
-		return m.Navs
+		return m.PriceDenom
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgMarketCommitmentSettleRequest) GetEventTag() string {
+func (m *MsgCreateRFQRequest) GetExpirationHeight() int64 {
 	if m != nil {
-		return m.EventTag
+		return m.ExpirationHeight
 	}
-	return ""
+	return 0
 }
 
-// MsgMarketCommitmentSettleResponse is a response message for the MarketCommitmentSettle endpoint.
-type MsgMarketCommitmentSettleResponse struct {
+// MsgCreateRFQResponse is a response message for the CreateRFQ endpoint.
+type MsgCreateRFQResponse struct {
+	// rfq_id is the numerical identifier of the newly created request for quote.
+	RfqId uint64 `protobuf:"varint,1,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
 }
 
-func (m *MsgMarketCommitmentSettleResponse) Reset()         { *m = MsgMarketCommitmentSettleResponse{} }
-func (m *MsgMarketCommitmentSettleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketCommitmentSettleResponse) ProtoMessage()    {}
-func (*MsgMarketCommitmentSettleResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateRFQResponse) Reset()         { *m = MsgCreateRFQResponse{} }
+func (m *MsgCreateRFQResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRFQResponse) ProtoMessage()    {}
+func (*MsgCreateRFQResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{15}
 }
-func (m *MsgMarketCommitmentSettleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRFQResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketCommitmentSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRFQResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketCommitmentSettleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRFQResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -958,43 +962,45 @@ func (m *MsgMarketCommitmentSettleResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketCommitmentSettleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketCommitmentSettleResponse.Merge(m, src)
+func (m *MsgCreateRFQResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRFQResponse.Merge(m, src)
 }
-func (m *MsgMarketCommitmentSettleResponse) XXX_Size() int {
+func (m *MsgCreateRFQResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketCommitmentSettleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketCommitmentSettleResponse.DiscardUnknown(m)
+func (m *MsgCreateRFQResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRFQResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketCommitmentSettleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRFQResponse proto.InternalMessageInfo
 
-// MsgMarketReleaseCommitmentsRequest is a request message for the MarketReleaseCommitments endpoint.
-type MsgMarketReleaseCommitmentsRequest struct {
-	// admin is the account with "cancel" permission requesting this release.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market releasing these funds.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// to_release is the funds that are to be released.
-	// An entry with a zero amount indicates that all committed funds for that account should be released.
-	ToRelease []AccountAmount `protobuf:"bytes,3,rep,name=to_release,json=toRelease,proto3" json:"to_release"`
-	// event_tag is a string that is included in the funds-released events. Max length is 100 characters.
-	EventTag string `protobuf:"bytes,4,opt,name=event_tag,json=eventTag,proto3" json:"event_tag,omitempty"`
+func (m *MsgCreateRFQResponse) GetRfqId() uint64 {
+	if m != nil {
+		return m.RfqId
+	}
+	return 0
 }
 
-func (m *MsgMarketReleaseCommitmentsRequest) Reset()         { *m = MsgMarketReleaseCommitmentsRequest{} }
-func (m *MsgMarketReleaseCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketReleaseCommitmentsRequest) ProtoMessage()    {}
-func (*MsgMarketReleaseCommitmentsRequest) Descriptor() ([]byte, []int) {
+// MsgCancelRFQRequest is a request message for the CancelRFQ endpoint.
+type MsgCancelRFQRequest struct {
+	// buyer is the address of the account that created the request for quote.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// rfq_id is the numerical identifier of the request for quote to cancel.
+	RfqId uint64 `protobuf:"varint,2,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+}
+
+func (m *MsgCancelRFQRequest) Reset()         { *m = MsgCancelRFQRequest{} }
+func (m *MsgCancelRFQRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRFQRequest) ProtoMessage()    {}
+func (*MsgCancelRFQRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{16}
 }
-func (m *MsgMarketReleaseCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelRFQRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketReleaseCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRFQRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketReleaseCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRFQRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,62 +1010,48 @@ func (m *MsgMarketReleaseCommitmentsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketReleaseCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketReleaseCommitmentsRequest.Merge(m, src)
+func (m *MsgCancelRFQRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRFQRequest.Merge(m, src)
 }
-func (m *MsgMarketReleaseCommitmentsRequest) XXX_Size() int {
+func (m *MsgCancelRFQRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketReleaseCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketReleaseCommitmentsRequest.DiscardUnknown(m)
+func (m *MsgCancelRFQRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRFQRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketReleaseCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRFQRequest proto.InternalMessageInfo
 
-func (m *MsgMarketReleaseCommitmentsRequest) GetAdmin() string {
+func (m *MsgCancelRFQRequest) GetBuyer() string {
 	if m != nil {
-		return m.Admin
+		return m.Buyer
 	}
 	return ""
 }
 
-func (m *MsgMarketReleaseCommitmentsRequest) GetMarketId() uint32 {
+func (m *MsgCancelRFQRequest) GetRfqId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.RfqId
 	}
 	return 0
 }
 
-func (m *MsgMarketReleaseCommitmentsRequest) GetToRelease() []AccountAmount {
-	if m != nil {
-		return m.ToRelease
-	}
-	return nil
-}
-
-func (m *MsgMarketReleaseCommitmentsRequest) GetEventTag() string {
-	if m != nil {
-		return m.EventTag
-	}
-	return ""
-}
-
-// MsgMarketReleaseCommitmentsResponse is a response message for the MarketReleaseCommitments endpoint.
-type MsgMarketReleaseCommitmentsResponse struct {
+// MsgCancelRFQResponse is a response message for the CancelRFQ endpoint.
+type MsgCancelRFQResponse struct {
 }
 
-func (m *MsgMarketReleaseCommitmentsResponse) Reset()         { *m = MsgMarketReleaseCommitmentsResponse{} }
-func (m *MsgMarketReleaseCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketReleaseCommitmentsResponse) ProtoMessage()    {}
-func (*MsgMarketReleaseCommitmentsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCancelRFQResponse) Reset()         { *m = MsgCancelRFQResponse{} }
+func (m *MsgCancelRFQResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRFQResponse) ProtoMessage()    {}
+func (*MsgCancelRFQResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{17}
 }
-func (m *MsgMarketReleaseCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelRFQResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketReleaseCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRFQResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketReleaseCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRFQResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1069,43 +1061,42 @@ func (m *MsgMarketReleaseCommitmentsResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketReleaseCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketReleaseCommitmentsResponse.Merge(m, src)
+func (m *MsgCancelRFQResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRFQResponse.Merge(m, src)
 }
-func (m *MsgMarketReleaseCommitmentsResponse) XXX_Size() int {
+func (m *MsgCancelRFQResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketReleaseCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketReleaseCommitmentsResponse.DiscardUnknown(m)
+func (m *MsgCancelRFQResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRFQResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketReleaseCommitmentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRFQResponse proto.InternalMessageInfo
 
-// MsgMarketSetOrderExternalIDRequest is a request message for the MarketSetOrderExternalID endpoint.
-type MsgMarketSetOrderExternalIDRequest struct {
-	// admin is the account with "set_ids" permission requesting this settlement.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market with the orders to update.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// order_id is the numerical identifier of the order to update.
-	OrderId uint64 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	// external_id is the new external id to associate with the order. Max length is 100 characters.
-	// If the external id is already associated with another order in this market, this update will fail.
-	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// MsgSubmitQuoteRequest is a request message for the SubmitQuote endpoint.
+type MsgSubmitQuoteRequest struct {
+	// dealer is the address of the account offering to sell the assets.
+	Dealer string `protobuf:"bytes,1,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// rfq_id is the numerical identifier of the request for quote being quoted.
+	RfqId uint64 `protobuf:"varint,2,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// price is the amount that the dealer wants for the assets.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	// seller_settlement_flat_fee is the flat fee the dealer will pay if this quote is accepted.
+	SellerSettlementFlatFee *types.Coin `protobuf:"bytes,4,opt,name=seller_settlement_flat_fee,json=sellerSettlementFlatFee,proto3" json:"seller_settlement_flat_fee,omitempty"`
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) Reset()         { *m = MsgMarketSetOrderExternalIDRequest{} }
-func (m *MsgMarketSetOrderExternalIDRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketSetOrderExternalIDRequest) ProtoMessage()    {}
-func (*MsgMarketSetOrderExternalIDRequest) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitQuoteRequest) Reset()         { *m = MsgSubmitQuoteRequest{} }
+func (m *MsgSubmitQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQuoteRequest) ProtoMessage()    {}
+func (*MsgSubmitQuoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{18}
 }
-func (m *MsgMarketSetOrderExternalIDRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitQuoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketSetOrderExternalIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketSetOrderExternalIDRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitQuoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1115,62 +1106,62 @@ func (m *MsgMarketSetOrderExternalIDRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketSetOrderExternalIDRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketSetOrderExternalIDRequest.Merge(m, src)
+func (m *MsgSubmitQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQuoteRequest.Merge(m, src)
 }
-func (m *MsgMarketSetOrderExternalIDRequest) XXX_Size() int {
+func (m *MsgSubmitQuoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketSetOrderExternalIDRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketSetOrderExternalIDRequest.DiscardUnknown(m)
+func (m *MsgSubmitQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQuoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketSetOrderExternalIDRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitQuoteRequest proto.InternalMessageInfo
 
-func (m *MsgMarketSetOrderExternalIDRequest) GetAdmin() string {
+func (m *MsgSubmitQuoteRequest) GetDealer() string {
 	if m != nil {
-		return m.Admin
+		return m.Dealer
 	}
 	return ""
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) GetMarketId() uint32 {
+func (m *MsgSubmitQuoteRequest) GetRfqId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.RfqId
 	}
 	return 0
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) GetOrderId() uint64 {
+func (m *MsgSubmitQuoteRequest) GetPrice() types.Coin {
 	if m != nil {
-		return m.OrderId
+		return m.Price
 	}
-	return 0
+	return types.Coin{}
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) GetExternalId() string {
+func (m *MsgSubmitQuoteRequest) GetSellerSettlementFlatFee() *types.Coin {
 	if m != nil {
-		return m.ExternalId
+		return m.SellerSettlementFlatFee
 	}
-	return ""
+	return nil
 }
 
-// MsgMarketSetOrderExternalIDResponse is a response message for the MarketSetOrderExternalID endpoint.
-type MsgMarketSetOrderExternalIDResponse struct {
+// MsgSubmitQuoteResponse is a response message for the SubmitQuote endpoint.
+type MsgSubmitQuoteResponse struct {
 }
 
-func (m *MsgMarketSetOrderExternalIDResponse) Reset()         { *m = MsgMarketSetOrderExternalIDResponse{} }
-func (m *MsgMarketSetOrderExternalIDResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketSetOrderExternalIDResponse) ProtoMessage()    {}
-func (*MsgMarketSetOrderExternalIDResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSubmitQuoteResponse) Reset()         { *m = MsgSubmitQuoteResponse{} }
+func (m *MsgSubmitQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQuoteResponse) ProtoMessage()    {}
+func (*MsgSubmitQuoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{19}
 }
-func (m *MsgMarketSetOrderExternalIDResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitQuoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketSetOrderExternalIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketSetOrderExternalIDResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitQuoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1180,42 +1171,38 @@ func (m *MsgMarketSetOrderExternalIDResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketSetOrderExternalIDResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketSetOrderExternalIDResponse.Merge(m, src)
+func (m *MsgSubmitQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQuoteResponse.Merge(m, src)
 }
-func (m *MsgMarketSetOrderExternalIDResponse) XXX_Size() int {
+func (m *MsgSubmitQuoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketSetOrderExternalIDResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketSetOrderExternalIDResponse.DiscardUnknown(m)
+func (m *MsgSubmitQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQuoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketSetOrderExternalIDResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitQuoteResponse proto.InternalMessageInfo
 
-// MsgMarketWithdrawRequest is a request message for the MarketWithdraw endpoint.
-type MsgMarketWithdrawRequest struct {
-	// admin is the account with withdraw permission requesting the withdrawal.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to withdraw from.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// to_address is the address that will receive the funds.
-	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
-	// amount is the funds to withdraw.
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+// MsgWithdrawQuoteRequest is a request message for the WithdrawQuote endpoint.
+type MsgWithdrawQuoteRequest struct {
+	// dealer is the address of the account that submitted the quote.
+	Dealer string `protobuf:"bytes,1,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// rfq_id is the numerical identifier of the request for quote that was quoted.
+	RfqId uint64 `protobuf:"varint,2,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
 }
 
-func (m *MsgMarketWithdrawRequest) Reset()         { *m = MsgMarketWithdrawRequest{} }
-func (m *MsgMarketWithdrawRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketWithdrawRequest) ProtoMessage()    {}
-func (*MsgMarketWithdrawRequest) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawQuoteRequest) Reset()         { *m = MsgWithdrawQuoteRequest{} }
+func (m *MsgWithdrawQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawQuoteRequest) ProtoMessage()    {}
+func (*MsgWithdrawQuoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{20}
 }
-func (m *MsgMarketWithdrawRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawQuoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketWithdrawRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawQuoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1225,62 +1212,48 @@ func (m *MsgMarketWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketWithdrawRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketWithdrawRequest.Merge(m, src)
+func (m *MsgWithdrawQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawQuoteRequest.Merge(m, src)
 }
-func (m *MsgMarketWithdrawRequest) XXX_Size() int {
+func (m *MsgWithdrawQuoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketWithdrawRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketWithdrawRequest.DiscardUnknown(m)
+func (m *MsgWithdrawQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawQuoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketWithdrawRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawQuoteRequest proto.InternalMessageInfo
 
-func (m *MsgMarketWithdrawRequest) GetAdmin() string {
+func (m *MsgWithdrawQuoteRequest) GetDealer() string {
 	if m != nil {
-		return m.Admin
+		return m.Dealer
 	}
 	return ""
 }
 
-func (m *MsgMarketWithdrawRequest) GetMarketId() uint32 {
+func (m *MsgWithdrawQuoteRequest) GetRfqId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.RfqId
 	}
 	return 0
 }
 
-func (m *MsgMarketWithdrawRequest) GetToAddress() string {
-	if m != nil {
-		return m.ToAddress
-	}
-	return ""
-}
-
-func (m *MsgMarketWithdrawRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.Amount
-	}
-	return nil
-}
-
-// MsgMarketWithdrawResponse is a response message for the MarketWithdraw endpoint.
-type MsgMarketWithdrawResponse struct {
+// MsgWithdrawQuoteResponse is a response message for the WithdrawQuote endpoint.
+type MsgWithdrawQuoteResponse struct {
 }
 
-func (m *MsgMarketWithdrawResponse) Reset()         { *m = MsgMarketWithdrawResponse{} }
-func (m *MsgMarketWithdrawResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketWithdrawResponse) ProtoMessage()    {}
-func (*MsgMarketWithdrawResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawQuoteResponse) Reset()         { *m = MsgWithdrawQuoteResponse{} }
+func (m *MsgWithdrawQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawQuoteResponse) ProtoMessage()    {}
+func (*MsgWithdrawQuoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{21}
 }
-func (m *MsgMarketWithdrawResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawQuoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketWithdrawResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawQuoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1290,40 +1263,45 @@ func (m *MsgMarketWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketWithdrawResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketWithdrawResponse.Merge(m, src)
+func (m *MsgWithdrawQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawQuoteResponse.Merge(m, src)
 }
-func (m *MsgMarketWithdrawResponse) XXX_Size() int {
+func (m *MsgWithdrawQuoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketWithdrawResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketWithdrawResponse.DiscardUnknown(m)
+func (m *MsgWithdrawQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawQuoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketWithdrawResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawQuoteResponse proto.InternalMessageInfo
 
-// MsgMarketUpdateDetailsRequest is a request message for the MarketUpdateDetails endpoint.
-type MsgMarketUpdateDetailsRequest struct {
-	// admin is the account with "update" permission requesting this change.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to update details for.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// market_details is some information about this market.
-	MarketDetails MarketDetails `protobuf:"bytes,3,opt,name=market_details,json=marketDetails,proto3" json:"market_details"`
+// MsgAcceptQuoteRequest is a request message for the AcceptQuote endpoint.
+type MsgAcceptQuoteRequest struct {
+	// buyer is the address of the account that created the request for quote.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// rfq_id is the numerical identifier of the request for quote.
+	RfqId uint64 `protobuf:"varint,2,opt,name=rfq_id,json=rfqId,proto3" json:"rfq_id,omitempty"`
+	// dealer is the address of the account whose quote is being accepted.
+	Dealer string `protobuf:"bytes,3,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	// price is the price of the quote being accepted. It must equal the quote's price.
+	Price types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// buyer_settlement_fees are the fees (both flat and proportional) that the buyer will pay (in addition to the price)
+	// for this settlement.
+	BuyerSettlementFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=buyer_settlement_fees,json=buyerSettlementFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"buyer_settlement_fees"`
 }
 
-func (m *MsgMarketUpdateDetailsRequest) Reset()         { *m = MsgMarketUpdateDetailsRequest{} }
-func (m *MsgMarketUpdateDetailsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateDetailsRequest) ProtoMessage()    {}
-func (*MsgMarketUpdateDetailsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptQuoteRequest) Reset()         { *m = MsgAcceptQuoteRequest{} }
+func (m *MsgAcceptQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptQuoteRequest) ProtoMessage()    {}
+func (*MsgAcceptQuoteRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{22}
 }
-func (m *MsgMarketUpdateDetailsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptQuoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateDetailsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptQuoteRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1333,55 +1311,73 @@ func (m *MsgMarketUpdateDetailsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateDetailsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateDetailsRequest.Merge(m, src)
+func (m *MsgAcceptQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptQuoteRequest.Merge(m, src)
 }
-func (m *MsgMarketUpdateDetailsRequest) XXX_Size() int {
+func (m *MsgAcceptQuoteRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateDetailsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateDetailsRequest.DiscardUnknown(m)
+func (m *MsgAcceptQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptQuoteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateDetailsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptQuoteRequest proto.InternalMessageInfo
 
-func (m *MsgMarketUpdateDetailsRequest) GetAdmin() string {
+func (m *MsgAcceptQuoteRequest) GetBuyer() string {
 	if m != nil {
-		return m.Admin
+		return m.Buyer
 	}
 	return ""
 }
 
-func (m *MsgMarketUpdateDetailsRequest) GetMarketId() uint32 {
+func (m *MsgAcceptQuoteRequest) GetRfqId() uint64 {
 	if m != nil {
-		return m.MarketId
+		return m.RfqId
 	}
 	return 0
 }
 
-func (m *MsgMarketUpdateDetailsRequest) GetMarketDetails() MarketDetails {
+func (m *MsgAcceptQuoteRequest) GetDealer() string {
 	if m != nil {
-		return m.MarketDetails
+		return m.Dealer
 	}
-	return MarketDetails{}
+	return ""
 }
 
-// MsgMarketUpdateDetailsResponse is a response message for the MarketUpdateDetails endpoint.
-type MsgMarketUpdateDetailsResponse struct {
+func (m *MsgAcceptQuoteRequest) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
 }
 
-func (m *MsgMarketUpdateDetailsResponse) Reset()         { *m = MsgMarketUpdateDetailsResponse{} }
-func (m *MsgMarketUpdateDetailsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateDetailsResponse) ProtoMessage()    {}
-func (*MsgMarketUpdateDetailsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptQuoteRequest) GetBuyerSettlementFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BuyerSettlementFees
+	}
+	return nil
+}
+
+// MsgAcceptQuoteResponse is a response message for the AcceptQuote endpoint.
+type MsgAcceptQuoteResponse struct {
+	// ask_order_id is the numerical identifier of the ask order that was created for the dealer and settled.
+	AskOrderId uint64 `protobuf:"varint,1,opt,name=ask_order_id,json=askOrderId,proto3" json:"ask_order_id,omitempty"`
+	// bid_order_id is the numerical identifier of the bid order that was created for the buyer and settled.
+	BidOrderId uint64 `protobuf:"varint,2,opt,name=bid_order_id,json=bidOrderId,proto3" json:"bid_order_id,omitempty"`
+}
+
+func (m *MsgAcceptQuoteResponse) Reset()         { *m = MsgAcceptQuoteResponse{} }
+func (m *MsgAcceptQuoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptQuoteResponse) ProtoMessage()    {}
+func (*MsgAcceptQuoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{23}
 }
-func (m *MsgMarketUpdateDetailsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptQuoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptQuoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateDetailsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptQuoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1391,46 +1387,63 @@ func (m *MsgMarketUpdateDetailsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateDetailsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateDetailsResponse.Merge(m, src)
+func (m *MsgAcceptQuoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptQuoteResponse.Merge(m, src)
 }
-func (m *MsgMarketUpdateDetailsResponse) XXX_Size() int {
+func (m *MsgAcceptQuoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateDetailsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateDetailsResponse.DiscardUnknown(m)
+func (m *MsgAcceptQuoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptQuoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateDetailsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptQuoteResponse proto.InternalMessageInfo
 
-// MsgMarketUpdateEnabledRequest is a request message for the MarketUpdateEnabled endpoint.
-// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-//
-// Deprecated: Do not use.
-type MsgMarketUpdateEnabledRequest struct {
-	// admin is the account with "update" permission requesting this change.
-	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"` // Deprecated: Do not use.
-	// market_id is the numerical identifier of the market to enable or disable.
-	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"` // Deprecated: Do not use.
-	// accepting_orders is whether this market is allowing orders to be created for it.
-	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-	AcceptingOrders bool `protobuf:"varint,3,opt,name=accepting_orders,json=acceptingOrders,proto3" json:"accepting_orders,omitempty"` // Deprecated: Do not use.
+func (m *MsgAcceptQuoteResponse) GetAskOrderId() uint64 {
+	if m != nil {
+		return m.AskOrderId
+	}
+	return 0
 }
 
-func (m *MsgMarketUpdateEnabledRequest) Reset()         { *m = MsgMarketUpdateEnabledRequest{} }
-func (m *MsgMarketUpdateEnabledRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateEnabledRequest) ProtoMessage()    {}
-func (*MsgMarketUpdateEnabledRequest) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptQuoteResponse) GetBidOrderId() uint64 {
+	if m != nil {
+		return m.BidOrderId
+	}
+	return 0
+}
+
+// MsgMarketCommitmentSettleRequest is a request message for the MarketCommitmentSettle endpoint.
+type MsgMarketCommitmentSettleRequest struct {
+	// admin is the account with "settle" permission requesting this settlement.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market requesting this settlement.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// inputs defines where the funds are coming from. All of these funds must be already committed to the market.
+	Inputs []AccountAmount `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs"`
+	// outputs defines how the funds are to be distributed. These funds will be re-committed in the destination accounts.
+	Outputs []AccountAmount `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs"`
+	// fees is the funds that the market is collecting as part of this settlement.
+	// All of these funds must be already committed to the market.
+	Fees []AccountAmount `protobuf:"bytes,5,rep,name=fees,proto3" json:"fees"`
+	// navs are any NAV info that should be updated at the beginning of this settlement.
+	Navs []NetAssetPrice `protobuf:"bytes,6,rep,name=navs,proto3" json:"navs"`
+	// event_tag is a string that is included in the funds-committed/released events. Max length is 100 characters.
+	EventTag string `protobuf:"bytes,7,opt,name=event_tag,json=eventTag,proto3" json:"event_tag,omitempty"`
+}
+
+func (m *MsgMarketCommitmentSettleRequest) Reset()         { *m = MsgMarketCommitmentSettleRequest{} }
+func (m *MsgMarketCommitmentSettleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketCommitmentSettleRequest) ProtoMessage()    {}
+func (*MsgMarketCommitmentSettleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{24}
 }
-func (m *MsgMarketUpdateEnabledRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketCommitmentSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketCommitmentSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateEnabledRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketCommitmentSettleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1440,61 +1453,83 @@ func (m *MsgMarketUpdateEnabledRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateEnabledRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateEnabledRequest.Merge(m, src)
+func (m *MsgMarketCommitmentSettleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketCommitmentSettleRequest.Merge(m, src)
 }
-func (m *MsgMarketUpdateEnabledRequest) XXX_Size() int {
+func (m *MsgMarketCommitmentSettleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateEnabledRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateEnabledRequest.DiscardUnknown(m)
+func (m *MsgMarketCommitmentSettleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketCommitmentSettleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateEnabledRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketCommitmentSettleRequest proto.InternalMessageInfo
 
-// Deprecated: Do not use.
-func (m *MsgMarketUpdateEnabledRequest) GetAdmin() string {
+func (m *MsgMarketCommitmentSettleRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (m *MsgMarketUpdateEnabledRequest) GetMarketId() uint32 {
+func (m *MsgMarketCommitmentSettleRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *MsgMarketUpdateEnabledRequest) GetAcceptingOrders() bool {
+func (m *MsgMarketCommitmentSettleRequest) GetInputs() []AccountAmount {
 	if m != nil {
-		return m.AcceptingOrders
+		return m.Inputs
 	}
-	return false
+	return nil
 }
 
-// MsgMarketUpdateEnabledResponse is a response message for the MarketUpdateEnabled endpoint.
-// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-//
-// Deprecated: Do not use.
-type MsgMarketUpdateEnabledResponse struct {
+func (m *MsgMarketCommitmentSettleRequest) GetOutputs() []AccountAmount {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
 }
 
-func (m *MsgMarketUpdateEnabledResponse) Reset()         { *m = MsgMarketUpdateEnabledResponse{} }
-func (m *MsgMarketUpdateEnabledResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateEnabledResponse) ProtoMessage()    {}
-func (*MsgMarketUpdateEnabledResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketCommitmentSettleRequest) GetFees() []AccountAmount {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *MsgMarketCommitmentSettleRequest) GetNavs() []NetAssetPrice {
+	if m != nil {
+		return m.Navs
+	}
+	return nil
+}
+
+func (m *MsgMarketCommitmentSettleRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
+}
+
+// MsgMarketCommitmentSettleResponse is a response message for the MarketCommitmentSettle endpoint.
+type MsgMarketCommitmentSettleResponse struct {
+}
+
+func (m *MsgMarketCommitmentSettleResponse) Reset()         { *m = MsgMarketCommitmentSettleResponse{} }
+func (m *MsgMarketCommitmentSettleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketCommitmentSettleResponse) ProtoMessage()    {}
+func (*MsgMarketCommitmentSettleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{25}
 }
-func (m *MsgMarketUpdateEnabledResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketCommitmentSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketCommitmentSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateEnabledResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketCommitmentSettleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1504,40 +1539,43 @@ func (m *MsgMarketUpdateEnabledResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateEnabledResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateEnabledResponse.Merge(m, src)
+func (m *MsgMarketCommitmentSettleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketCommitmentSettleResponse.Merge(m, src)
 }
-func (m *MsgMarketUpdateEnabledResponse) XXX_Size() int {
+func (m *MsgMarketCommitmentSettleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateEnabledResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateEnabledResponse.DiscardUnknown(m)
+func (m *MsgMarketCommitmentSettleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketCommitmentSettleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateEnabledResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketCommitmentSettleResponse proto.InternalMessageInfo
 
-// MsgMarketUpdateAcceptingOrdersRequest is a request message for the MarketUpdateAcceptingOrders endpoint.
-type MsgMarketUpdateAcceptingOrdersRequest struct {
-	// admin is the account with "update" permission requesting this change.
+// MsgMarketReleaseCommitmentsRequest is a request message for the MarketReleaseCommitments endpoint.
+type MsgMarketReleaseCommitmentsRequest struct {
+	// admin is the account with "cancel" permission requesting this release.
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to enable or disable.
+	// market_id is the numerical identifier of the market releasing these funds.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// accepting_orders is whether this market is allowing orders to be created for it.
-	AcceptingOrders bool `protobuf:"varint,3,opt,name=accepting_orders,json=acceptingOrders,proto3" json:"accepting_orders,omitempty"`
+	// to_release is the funds that are to be released.
+	// An entry with a zero amount indicates that all committed funds for that account should be released.
+	ToRelease []AccountAmount `protobuf:"bytes,3,rep,name=to_release,json=toRelease,proto3" json:"to_release"`
+	// event_tag is a string that is included in the funds-released events. Max length is 100 characters.
+	EventTag string `protobuf:"bytes,4,opt,name=event_tag,json=eventTag,proto3" json:"event_tag,omitempty"`
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) Reset()         { *m = MsgMarketUpdateAcceptingOrdersRequest{} }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateAcceptingOrdersRequest) ProtoMessage()    {}
-func (*MsgMarketUpdateAcceptingOrdersRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketReleaseCommitmentsRequest) Reset()         { *m = MsgMarketReleaseCommitmentsRequest{} }
+func (m *MsgMarketReleaseCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketReleaseCommitmentsRequest) ProtoMessage()    {}
+func (*MsgMarketReleaseCommitmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{26}
 }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketReleaseCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketReleaseCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketReleaseCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1547,57 +1585,62 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest.Merge(m, src)
+func (m *MsgMarketReleaseCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketReleaseCommitmentsRequest.Merge(m, src)
 }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Size() int {
+func (m *MsgMarketReleaseCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest.DiscardUnknown(m)
+func (m *MsgMarketReleaseCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketReleaseCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketReleaseCommitmentsRequest proto.InternalMessageInfo
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) GetAdmin() string {
+func (m *MsgMarketReleaseCommitmentsRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) GetMarketId() uint32 {
+func (m *MsgMarketReleaseCommitmentsRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) GetAcceptingOrders() bool {
+func (m *MsgMarketReleaseCommitmentsRequest) GetToRelease() []AccountAmount {
 	if m != nil {
-		return m.AcceptingOrders
+		return m.ToRelease
 	}
-	return false
+	return nil
 }
 
-// MsgMarketUpdateAcceptingOrdersResponse is a response message for the MarketUpdateAcceptingOrders endpoint.
-type MsgMarketUpdateAcceptingOrdersResponse struct {
+func (m *MsgMarketReleaseCommitmentsRequest) GetEventTag() string {
+	if m != nil {
+		return m.EventTag
+	}
+	return ""
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersResponse) Reset() {
-	*m = MsgMarketUpdateAcceptingOrdersResponse{}
+// MsgMarketReleaseCommitmentsResponse is a response message for the MarketReleaseCommitments endpoint.
+type MsgMarketReleaseCommitmentsResponse struct {
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateAcceptingOrdersResponse) ProtoMessage()    {}
-func (*MsgMarketUpdateAcceptingOrdersResponse) Descriptor() ([]byte, []int) {
+
+func (m *MsgMarketReleaseCommitmentsResponse) Reset()         { *m = MsgMarketReleaseCommitmentsResponse{} }
+func (m *MsgMarketReleaseCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketReleaseCommitmentsResponse) ProtoMessage()    {}
+func (*MsgMarketReleaseCommitmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{27}
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketReleaseCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketReleaseCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketReleaseCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1607,42 +1650,43 @@ func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse.Merge(m, src)
+func (m *MsgMarketReleaseCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketReleaseCommitmentsResponse.Merge(m, src)
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Size() int {
+func (m *MsgMarketReleaseCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse.DiscardUnknown(m)
+func (m *MsgMarketReleaseCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketReleaseCommitmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketReleaseCommitmentsResponse proto.InternalMessageInfo
 
-// MsgMarketUpdateUserSettleRequest is a request message for the MarketUpdateUserSettle endpoint.
-type MsgMarketUpdateUserSettleRequest struct {
-	// admin is the account with "update" permission requesting this change.
+// MsgMarketSetOrderExternalIDRequest is a request message for the MarketSetOrderExternalID endpoint.
+type MsgMarketSetOrderExternalIDRequest struct {
+	// admin is the account with "set_ids" permission requesting this settlement.
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to enable or disable user-settlement for.
+	// market_id is the numerical identifier of the market with the orders to update.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// allow_user_settlement is whether this market allows users to initiate their own settlements.
-	// For example, the FillBids and FillAsks endpoints are available if and only if this is true.
-	// The MarketSettle endpoint is available (only to market actors) regardless of the value of this field.
-	AllowUserSettlement bool `protobuf:"varint,3,opt,name=allow_user_settlement,json=allowUserSettlement,proto3" json:"allow_user_settlement,omitempty"`
+	// order_id is the numerical identifier of the order to update.
+	OrderId uint64 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// external_id is the new external id to associate with the order. Max length is 100 characters.
+	// If the external id is already associated with another order in this market, this update will fail.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) Reset()         { *m = MsgMarketUpdateUserSettleRequest{} }
-func (m *MsgMarketUpdateUserSettleRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateUserSettleRequest) ProtoMessage()    {}
-func (*MsgMarketUpdateUserSettleRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketSetOrderExternalIDRequest) Reset()         { *m = MsgMarketSetOrderExternalIDRequest{} }
+func (m *MsgMarketSetOrderExternalIDRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketSetOrderExternalIDRequest) ProtoMessage()    {}
+func (*MsgMarketSetOrderExternalIDRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{28}
 }
-func (m *MsgMarketUpdateUserSettleRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketSetOrderExternalIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateUserSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketSetOrderExternalIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateUserSettleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketSetOrderExternalIDRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,55 +1696,62 @@ func (m *MsgMarketUpdateUserSettleRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateUserSettleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateUserSettleRequest.Merge(m, src)
+func (m *MsgMarketSetOrderExternalIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketSetOrderExternalIDRequest.Merge(m, src)
 }
-func (m *MsgMarketUpdateUserSettleRequest) XXX_Size() int {
+func (m *MsgMarketSetOrderExternalIDRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateUserSettleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateUserSettleRequest.DiscardUnknown(m)
+func (m *MsgMarketSetOrderExternalIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketSetOrderExternalIDRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateUserSettleRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketSetOrderExternalIDRequest proto.InternalMessageInfo
 
-func (m *MsgMarketUpdateUserSettleRequest) GetAdmin() string {
+func (m *MsgMarketSetOrderExternalIDRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) GetMarketId() uint32 {
+func (m *MsgMarketSetOrderExternalIDRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) GetAllowUserSettlement() bool {
+func (m *MsgMarketSetOrderExternalIDRequest) GetOrderId() uint64 {
 	if m != nil {
-		return m.AllowUserSettlement
+		return m.OrderId
 	}
-	return false
+	return 0
 }
 
-// MsgMarketUpdateUserSettleResponse is a response message for the MarketUpdateUserSettle endpoint.
-type MsgMarketUpdateUserSettleResponse struct {
+func (m *MsgMarketSetOrderExternalIDRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
 }
 
-func (m *MsgMarketUpdateUserSettleResponse) Reset()         { *m = MsgMarketUpdateUserSettleResponse{} }
-func (m *MsgMarketUpdateUserSettleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateUserSettleResponse) ProtoMessage()    {}
-func (*MsgMarketUpdateUserSettleResponse) Descriptor() ([]byte, []int) {
+// MsgMarketSetOrderExternalIDResponse is a response message for the MarketSetOrderExternalID endpoint.
+type MsgMarketSetOrderExternalIDResponse struct {
+}
+
+func (m *MsgMarketSetOrderExternalIDResponse) Reset()         { *m = MsgMarketSetOrderExternalIDResponse{} }
+func (m *MsgMarketSetOrderExternalIDResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketSetOrderExternalIDResponse) ProtoMessage()    {}
+func (*MsgMarketSetOrderExternalIDResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{29}
 }
-func (m *MsgMarketUpdateUserSettleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketSetOrderExternalIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateUserSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketSetOrderExternalIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateUserSettleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketSetOrderExternalIDResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1710,46 +1761,42 @@ func (m *MsgMarketUpdateUserSettleResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateUserSettleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateUserSettleResponse.Merge(m, src)
+func (m *MsgMarketSetOrderExternalIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketSetOrderExternalIDResponse.Merge(m, src)
 }
-func (m *MsgMarketUpdateUserSettleResponse) XXX_Size() int {
+func (m *MsgMarketSetOrderExternalIDResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateUserSettleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateUserSettleResponse.DiscardUnknown(m)
+func (m *MsgMarketSetOrderExternalIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketSetOrderExternalIDResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateUserSettleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketSetOrderExternalIDResponse proto.InternalMessageInfo
 
-// MsgMarketUpdateAcceptingCommitmentsRequest is a request message for the MarketUpdateAcceptingCommitments endpoint.
-type MsgMarketUpdateAcceptingCommitmentsRequest struct {
-	// admin is the account with "update" permission requesting this change.
+// MsgMarketWithdrawRequest is a request message for the MarketWithdraw endpoint.
+type MsgMarketWithdrawRequest struct {
+	// admin is the account with withdraw permission requesting the withdrawal.
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to enable or disable commitments for.
+	// market_id is the numerical identifier of the market to withdraw from.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// accepting_commitments is whether this market allows users to commit funds to it.
-	// For example, the CommitFunds endpoint is available if and only if this is true.
-	// The MarketCommitmentSettle endpoint is available (only to market actors) regardless of the value of this field.
-	AcceptingCommitments bool `protobuf:"varint,3,opt,name=accepting_commitments,json=acceptingCommitments,proto3" json:"accepting_commitments,omitempty"`
+	// to_address is the address that will receive the funds.
+	ToAddress string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// amount is the funds to withdraw.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Reset() {
-	*m = MsgMarketUpdateAcceptingCommitmentsRequest{}
-}
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MsgMarketUpdateAcceptingCommitmentsRequest) ProtoMessage() {}
-func (*MsgMarketUpdateAcceptingCommitmentsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketWithdrawRequest) Reset()         { *m = MsgMarketWithdrawRequest{} }
+func (m *MsgMarketWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketWithdrawRequest) ProtoMessage()    {}
+func (*MsgMarketWithdrawRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{30}
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketWithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketWithdrawRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1759,59 +1806,62 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest.Merge(m, src)
+func (m *MsgMarketWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketWithdrawRequest.Merge(m, src)
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Size() int {
+func (m *MsgMarketWithdrawRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest.DiscardUnknown(m)
+func (m *MsgMarketWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketWithdrawRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketWithdrawRequest proto.InternalMessageInfo
 
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) GetAdmin() string {
+func (m *MsgMarketWithdrawRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) GetMarketId() uint32 {
+func (m *MsgMarketWithdrawRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) GetAcceptingCommitments() bool {
+func (m *MsgMarketWithdrawRequest) GetToAddress() string {
 	if m != nil {
-		return m.AcceptingCommitments
+		return m.ToAddress
 	}
-	return false
+	return ""
 }
 
-// MsgMarketUpdateAcceptingCommitmentsResponse is a response message for the MarketUpdateAcceptingCommitments endpoint.
-type MsgMarketUpdateAcceptingCommitmentsResponse struct {
+func (m *MsgMarketWithdrawRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Reset() {
-	*m = MsgMarketUpdateAcceptingCommitmentsResponse{}
-}
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) String() string {
-	return proto.CompactTextString(m)
+// MsgMarketWithdrawResponse is a response message for the MarketWithdraw endpoint.
+type MsgMarketWithdrawResponse struct {
 }
-func (*MsgMarketUpdateAcceptingCommitmentsResponse) ProtoMessage() {}
-func (*MsgMarketUpdateAcceptingCommitmentsResponse) Descriptor() ([]byte, []int) {
+
+func (m *MsgMarketWithdrawResponse) Reset()         { *m = MsgMarketWithdrawResponse{} }
+func (m *MsgMarketWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketWithdrawResponse) ProtoMessage()    {}
+func (*MsgMarketWithdrawResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{31}
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketWithdrawResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1821,42 +1871,40 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse.Merge(m, src)
+func (m *MsgMarketWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketWithdrawResponse.Merge(m, src)
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Size() int {
+func (m *MsgMarketWithdrawResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse.DiscardUnknown(m)
+func (m *MsgMarketWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketWithdrawResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketWithdrawResponse proto.InternalMessageInfo
 
-// MsgMarketUpdateIntermediaryDenomRequest is a request message for the MarketUpdateIntermediaryDenom endpoint.
-type MsgMarketUpdateIntermediaryDenomRequest struct {
+// MsgMarketUpdateDetailsRequest is a request message for the MarketUpdateDetails endpoint.
+type MsgMarketUpdateDetailsRequest struct {
 	// admin is the account with "update" permission requesting this change.
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market changing the intermediary denom.
+	// market_id is the numerical identifier of the market to update details for.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// intermediary_denom is the new intermediary denom for this market to use.
-	IntermediaryDenom string `protobuf:"bytes,3,opt,name=intermediary_denom,json=intermediaryDenom,proto3" json:"intermediary_denom,omitempty"`
+	// market_details is some information about this market.
+	MarketDetails MarketDetails `protobuf:"bytes,3,opt,name=market_details,json=marketDetails,proto3" json:"market_details"`
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomRequest) Reset() {
-	*m = MsgMarketUpdateIntermediaryDenomRequest{}
-}
-func (m *MsgMarketUpdateIntermediaryDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateIntermediaryDenomRequest) ProtoMessage()    {}
-func (*MsgMarketUpdateIntermediaryDenomRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateDetailsRequest) Reset()         { *m = MsgMarketUpdateDetailsRequest{} }
+func (m *MsgMarketUpdateDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateDetailsRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateDetailsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{32}
 }
-func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateDetailsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1866,57 +1914,55 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest.Merge(m, src)
+func (m *MsgMarketUpdateDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateDetailsRequest.Merge(m, src)
 }
-func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Size() int {
+func (m *MsgMarketUpdateDetailsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest.DiscardUnknown(m)
-}
+func (m *MsgMarketUpdateDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateDetailsRequest.DiscardUnknown(m)
+}
 
-var xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateDetailsRequest proto.InternalMessageInfo
 
-func (m *MsgMarketUpdateIntermediaryDenomRequest) GetAdmin() string {
+func (m *MsgMarketUpdateDetailsRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomRequest) GetMarketId() uint32 {
+func (m *MsgMarketUpdateDetailsRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomRequest) GetIntermediaryDenom() string {
+func (m *MsgMarketUpdateDetailsRequest) GetMarketDetails() MarketDetails {
 	if m != nil {
-		return m.IntermediaryDenom
+		return m.MarketDetails
 	}
-	return ""
+	return MarketDetails{}
 }
 
-// MsgMarketUpdateIntermediaryDenomResponse is a response message for the MarketUpdateIntermediaryDenom endpoint.
-type MsgMarketUpdateIntermediaryDenomResponse struct {
+// MsgMarketUpdateDetailsResponse is a response message for the MarketUpdateDetails endpoint.
+type MsgMarketUpdateDetailsResponse struct {
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomResponse) Reset() {
-	*m = MsgMarketUpdateIntermediaryDenomResponse{}
-}
-func (m *MsgMarketUpdateIntermediaryDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketUpdateIntermediaryDenomResponse) ProtoMessage()    {}
-func (*MsgMarketUpdateIntermediaryDenomResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateDetailsResponse) Reset()         { *m = MsgMarketUpdateDetailsResponse{} }
+func (m *MsgMarketUpdateDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateDetailsResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateDetailsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{33}
 }
-func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateDetailsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1926,44 +1972,46 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse.Merge(m, src)
+func (m *MsgMarketUpdateDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateDetailsResponse.Merge(m, src)
 }
-func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Size() int {
+func (m *MsgMarketUpdateDetailsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateDetailsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateDetailsResponse proto.InternalMessageInfo
 
-// MsgMarketManagePermissionsRequest is a request message for the MarketManagePermissions endpoint.
-type MsgMarketManagePermissionsRequest struct {
-	// admin is the account with "permissions" permission requesting this change.
-	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to manage permissions for.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// revoke_all are addresses that should have all their permissions revoked.
-	RevokeAll []string `protobuf:"bytes,3,rep,name=revoke_all,json=revokeAll,proto3" json:"revoke_all,omitempty"`
-	// to_revoke are the specific permissions to remove for addresses.
-	ToRevoke []AccessGrant `protobuf:"bytes,4,rep,name=to_revoke,json=toRevoke,proto3" json:"to_revoke"`
-	// to_grant are the permissions to grant to addresses.
-	ToGrant []AccessGrant `protobuf:"bytes,5,rep,name=to_grant,json=toGrant,proto3" json:"to_grant"`
+// MsgMarketUpdateEnabledRequest is a request message for the MarketUpdateEnabled endpoint.
+// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+//
+// Deprecated: Do not use.
+type MsgMarketUpdateEnabledRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"` // Deprecated: Do not use.
+	// market_id is the numerical identifier of the market to enable or disable.
+	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"` // Deprecated: Do not use.
+	// accepting_orders is whether this market is allowing orders to be created for it.
+	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+	AcceptingOrders bool `protobuf:"varint,3,opt,name=accepting_orders,json=acceptingOrders,proto3" json:"accepting_orders,omitempty"` // Deprecated: Do not use.
 }
 
-func (m *MsgMarketManagePermissionsRequest) Reset()         { *m = MsgMarketManagePermissionsRequest{} }
-func (m *MsgMarketManagePermissionsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketManagePermissionsRequest) ProtoMessage()    {}
-func (*MsgMarketManagePermissionsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateEnabledRequest) Reset()         { *m = MsgMarketUpdateEnabledRequest{} }
+func (m *MsgMarketUpdateEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateEnabledRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateEnabledRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{34}
 }
-func (m *MsgMarketManagePermissionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketManagePermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketManagePermissionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateEnabledRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1973,69 +2021,61 @@ func (m *MsgMarketManagePermissionsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketManagePermissionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketManagePermissionsRequest.Merge(m, src)
+func (m *MsgMarketUpdateEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateEnabledRequest.Merge(m, src)
 }
-func (m *MsgMarketManagePermissionsRequest) XXX_Size() int {
+func (m *MsgMarketUpdateEnabledRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketManagePermissionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketManagePermissionsRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateEnabledRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketManagePermissionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateEnabledRequest proto.InternalMessageInfo
 
-func (m *MsgMarketManagePermissionsRequest) GetAdmin() string {
+// Deprecated: Do not use.
+func (m *MsgMarketUpdateEnabledRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgMarketManagePermissionsRequest) GetMarketId() uint32 {
+// Deprecated: Do not use.
+func (m *MsgMarketUpdateEnabledRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketManagePermissionsRequest) GetRevokeAll() []string {
-	if m != nil {
-		return m.RevokeAll
-	}
-	return nil
-}
-
-func (m *MsgMarketManagePermissionsRequest) GetToRevoke() []AccessGrant {
-	if m != nil {
-		return m.ToRevoke
-	}
-	return nil
-}
-
-func (m *MsgMarketManagePermissionsRequest) GetToGrant() []AccessGrant {
+// Deprecated: Do not use.
+func (m *MsgMarketUpdateEnabledRequest) GetAcceptingOrders() bool {
 	if m != nil {
-		return m.ToGrant
+		return m.AcceptingOrders
 	}
-	return nil
+	return false
 }
 
-// MsgMarketManagePermissionsResponse is a response message for the MarketManagePermissions endpoint.
-type MsgMarketManagePermissionsResponse struct {
+// MsgMarketUpdateEnabledResponse is a response message for the MarketUpdateEnabled endpoint.
+// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+//
+// Deprecated: Do not use.
+type MsgMarketUpdateEnabledResponse struct {
 }
 
-func (m *MsgMarketManagePermissionsResponse) Reset()         { *m = MsgMarketManagePermissionsResponse{} }
-func (m *MsgMarketManagePermissionsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketManagePermissionsResponse) ProtoMessage()    {}
-func (*MsgMarketManagePermissionsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateEnabledResponse) Reset()         { *m = MsgMarketUpdateEnabledResponse{} }
+func (m *MsgMarketUpdateEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateEnabledResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateEnabledResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{35}
 }
-func (m *MsgMarketManagePermissionsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketManagePermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketManagePermissionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateEnabledResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2045,50 +2085,40 @@ func (m *MsgMarketManagePermissionsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketManagePermissionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketManagePermissionsResponse.Merge(m, src)
+func (m *MsgMarketUpdateEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateEnabledResponse.Merge(m, src)
 }
-func (m *MsgMarketManagePermissionsResponse) XXX_Size() int {
+func (m *MsgMarketUpdateEnabledResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketManagePermissionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketManagePermissionsResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateEnabledResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketManagePermissionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateEnabledResponse proto.InternalMessageInfo
 
-// MsgMarketManageReqAttrsRequest is a request message for the MarketManageReqAttrs endpoint.
-type MsgMarketManageReqAttrsRequest struct {
-	// admin is the account with "attributes" permission requesting this change.
+// MsgMarketUpdateAcceptingOrdersRequest is a request message for the MarketUpdateAcceptingOrders endpoint.
+type MsgMarketUpdateAcceptingOrdersRequest struct {
+	// admin is the account with "update" permission requesting this change.
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
-	// market_id is the numerical identifier of the market to update required attributes for.
+	// market_id is the numerical identifier of the market to enable or disable.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// create_ask_to_add are the attributes that should now also be required to create an ask order.
-	CreateAskToAdd []string `protobuf:"bytes,3,rep,name=create_ask_to_add,json=createAskToAdd,proto3" json:"create_ask_to_add,omitempty"`
-	// create_ask_to_remove are the attributes that should no longer be required to create an ask order.
-	CreateAskToRemove []string `protobuf:"bytes,4,rep,name=create_ask_to_remove,json=createAskToRemove,proto3" json:"create_ask_to_remove,omitempty"`
-	// create_bid_to_add are the attributes that should now also be required to create a bid order.
-	CreateBidToAdd []string `protobuf:"bytes,5,rep,name=create_bid_to_add,json=createBidToAdd,proto3" json:"create_bid_to_add,omitempty"`
-	// create_bid_to_remove are the attributes that should no longer be required to create a bid order.
-	CreateBidToRemove []string `protobuf:"bytes,6,rep,name=create_bid_to_remove,json=createBidToRemove,proto3" json:"create_bid_to_remove,omitempty"`
-	// create_commitment_to_add are the attributes that should now also be required to create a commitment.
-	CreateCommitmentToAdd []string `protobuf:"bytes,7,rep,name=create_commitment_to_add,json=createCommitmentToAdd,proto3" json:"create_commitment_to_add,omitempty"`
-	// create_commitment_to_remove are the attributes that should no longer be required to create a commitment.
-	CreateCommitmentToRemove []string `protobuf:"bytes,8,rep,name=create_commitment_to_remove,json=createCommitmentToRemove,proto3" json:"create_commitment_to_remove,omitempty"`
+	// accepting_orders is whether this market is allowing orders to be created for it.
+	AcceptingOrders bool `protobuf:"varint,3,opt,name=accepting_orders,json=acceptingOrders,proto3" json:"accepting_orders,omitempty"`
 }
 
-func (m *MsgMarketManageReqAttrsRequest) Reset()         { *m = MsgMarketManageReqAttrsRequest{} }
-func (m *MsgMarketManageReqAttrsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketManageReqAttrsRequest) ProtoMessage()    {}
-func (*MsgMarketManageReqAttrsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) Reset()         { *m = MsgMarketUpdateAcceptingOrdersRequest{} }
+func (m *MsgMarketUpdateAcceptingOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateAcceptingOrdersRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateAcceptingOrdersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{36}
 }
-func (m *MsgMarketManageReqAttrsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMarketManageReqAttrsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketManageReqAttrsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2098,90 +2128,57 @@ func (m *MsgMarketManageReqAttrsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketManageReqAttrsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketManageReqAttrsRequest.Merge(m, src)
+func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest.Merge(m, src)
 }
-func (m *MsgMarketManageReqAttrsRequest) XXX_Size() int {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketManageReqAttrsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketManageReqAttrsRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketManageReqAttrsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateAcceptingOrdersRequest proto.InternalMessageInfo
 
-func (m *MsgMarketManageReqAttrsRequest) GetAdmin() string {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) GetAdmin() string {
 	if m != nil {
 		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgMarketManageReqAttrsRequest) GetMarketId() uint32 {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgMarketManageReqAttrsRequest) GetCreateAskToAdd() []string {
-	if m != nil {
-		return m.CreateAskToAdd
-	}
-	return nil
-}
-
-func (m *MsgMarketManageReqAttrsRequest) GetCreateAskToRemove() []string {
+func (m *MsgMarketUpdateAcceptingOrdersRequest) GetAcceptingOrders() bool {
 	if m != nil {
-		return m.CreateAskToRemove
+		return m.AcceptingOrders
 	}
-	return nil
+	return false
 }
 
-func (m *MsgMarketManageReqAttrsRequest) GetCreateBidToAdd() []string {
-	if m != nil {
-		return m.CreateBidToAdd
-	}
-	return nil
+// MsgMarketUpdateAcceptingOrdersResponse is a response message for the MarketUpdateAcceptingOrders endpoint.
+type MsgMarketUpdateAcceptingOrdersResponse struct {
 }
 
-func (m *MsgMarketManageReqAttrsRequest) GetCreateBidToRemove() []string {
-	if m != nil {
-		return m.CreateBidToRemove
-	}
-	return nil
+func (m *MsgMarketUpdateAcceptingOrdersResponse) Reset() {
+	*m = MsgMarketUpdateAcceptingOrdersResponse{}
 }
-
-func (m *MsgMarketManageReqAttrsRequest) GetCreateCommitmentToAdd() []string {
-	if m != nil {
-		return m.CreateCommitmentToAdd
-	}
-	return nil
+func (m *MsgMarketUpdateAcceptingOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateAcceptingOrdersResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateAcceptingOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{37}
 }
-
-func (m *MsgMarketManageReqAttrsRequest) GetCreateCommitmentToRemove() []string {
-	if m != nil {
-		return m.CreateCommitmentToRemove
-	}
-	return nil
+func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// MsgMarketManageReqAttrsResponse is a response message for the MarketManageReqAttrs endpoint.
-type MsgMarketManageReqAttrsResponse struct {
-}
-
-func (m *MsgMarketManageReqAttrsResponse) Reset()         { *m = MsgMarketManageReqAttrsResponse{} }
-func (m *MsgMarketManageReqAttrsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMarketManageReqAttrsResponse) ProtoMessage()    {}
-func (*MsgMarketManageReqAttrsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{37}
-}
-func (m *MsgMarketManageReqAttrsResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgMarketManageReqAttrsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMarketManageReqAttrsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2191,36 +2188,42 @@ func (m *MsgMarketManageReqAttrsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgMarketManageReqAttrsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMarketManageReqAttrsResponse.Merge(m, src)
+func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse.Merge(m, src)
 }
-func (m *MsgMarketManageReqAttrsResponse) XXX_Size() int {
+func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMarketManageReqAttrsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMarketManageReqAttrsResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMarketManageReqAttrsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateAcceptingOrdersResponse proto.InternalMessageInfo
 
-// MsgCreatePaymentRequest is a request message for the CreatePayment endpoint.
-type MsgCreatePaymentRequest struct {
-	// payment is the details of the payment to create.
-	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
+// MsgMarketUpdateUserSettleRequest is a request message for the MarketUpdateUserSettle endpoint.
+type MsgMarketUpdateUserSettleRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to enable or disable user-settlement for.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// allow_user_settlement is whether this market allows users to initiate their own settlements.
+	// For example, the FillBids and FillAsks endpoints are available if and only if this is true.
+	// The MarketSettle endpoint is available (only to market actors) regardless of the value of this field.
+	AllowUserSettlement bool `protobuf:"varint,3,opt,name=allow_user_settlement,json=allowUserSettlement,proto3" json:"allow_user_settlement,omitempty"`
 }
 
-func (m *MsgCreatePaymentRequest) Reset()         { *m = MsgCreatePaymentRequest{} }
-func (m *MsgCreatePaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgCreatePaymentRequest) ProtoMessage()    {}
-func (*MsgCreatePaymentRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateUserSettleRequest) Reset()         { *m = MsgMarketUpdateUserSettleRequest{} }
+func (m *MsgMarketUpdateUserSettleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateUserSettleRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateUserSettleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{38}
 }
-func (m *MsgCreatePaymentRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateUserSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreatePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateUserSettleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreatePaymentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateUserSettleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2230,41 +2233,55 @@ func (m *MsgCreatePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreatePaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreatePaymentRequest.Merge(m, src)
+func (m *MsgMarketUpdateUserSettleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateUserSettleRequest.Merge(m, src)
 }
-func (m *MsgCreatePaymentRequest) XXX_Size() int {
+func (m *MsgMarketUpdateUserSettleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreatePaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreatePaymentRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateUserSettleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateUserSettleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreatePaymentRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateUserSettleRequest proto.InternalMessageInfo
 
-func (m *MsgCreatePaymentRequest) GetPayment() Payment {
+func (m *MsgMarketUpdateUserSettleRequest) GetAdmin() string {
 	if m != nil {
-		return m.Payment
+		return m.Admin
 	}
-	return Payment{}
+	return ""
 }
 
-// MsgCreatePaymentResponse is a response message for the CreatePayment endpoint.
-type MsgCreatePaymentResponse struct {
+func (m *MsgMarketUpdateUserSettleRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *MsgCreatePaymentResponse) Reset()         { *m = MsgCreatePaymentResponse{} }
-func (m *MsgCreatePaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreatePaymentResponse) ProtoMessage()    {}
-func (*MsgCreatePaymentResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateUserSettleRequest) GetAllowUserSettlement() bool {
+	if m != nil {
+		return m.AllowUserSettlement
+	}
+	return false
+}
+
+// MsgMarketUpdateUserSettleResponse is a response message for the MarketUpdateUserSettle endpoint.
+type MsgMarketUpdateUserSettleResponse struct {
+}
+
+func (m *MsgMarketUpdateUserSettleResponse) Reset()         { *m = MsgMarketUpdateUserSettleResponse{} }
+func (m *MsgMarketUpdateUserSettleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateUserSettleResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateUserSettleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{39}
 }
-func (m *MsgCreatePaymentResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateUserSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreatePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateUserSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreatePaymentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateUserSettleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2274,36 +2291,46 @@ func (m *MsgCreatePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCreatePaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreatePaymentResponse.Merge(m, src)
+func (m *MsgMarketUpdateUserSettleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateUserSettleResponse.Merge(m, src)
 }
-func (m *MsgCreatePaymentResponse) XXX_Size() int {
+func (m *MsgMarketUpdateUserSettleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreatePaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreatePaymentResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateUserSettleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateUserSettleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreatePaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateUserSettleResponse proto.InternalMessageInfo
 
-// MsgAcceptPaymentRequest is a request message for the AcceptPayment endpoint.
-type MsgAcceptPaymentRequest struct {
-	// payment is the details of the payment to accept.
-	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
+// MsgMarketUpdateAcceptingCommitmentsRequest is a request message for the MarketUpdateAcceptingCommitments endpoint.
+type MsgMarketUpdateAcceptingCommitmentsRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to enable or disable commitments for.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// accepting_commitments is whether this market allows users to commit funds to it.
+	// For example, the CommitFunds endpoint is available if and only if this is true.
+	// The MarketCommitmentSettle endpoint is available (only to market actors) regardless of the value of this field.
+	AcceptingCommitments bool `protobuf:"varint,3,opt,name=accepting_commitments,json=acceptingCommitments,proto3" json:"accepting_commitments,omitempty"`
 }
 
-func (m *MsgAcceptPaymentRequest) Reset()         { *m = MsgAcceptPaymentRequest{} }
-func (m *MsgAcceptPaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgAcceptPaymentRequest) ProtoMessage()    {}
-func (*MsgAcceptPaymentRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Reset() {
+	*m = MsgMarketUpdateAcceptingCommitmentsRequest{}
+}
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMarketUpdateAcceptingCommitmentsRequest) ProtoMessage() {}
+func (*MsgMarketUpdateAcceptingCommitmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{40}
 }
-func (m *MsgAcceptPaymentRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAcceptPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAcceptPaymentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2313,41 +2340,59 @@ func (m *MsgAcceptPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgAcceptPaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAcceptPaymentRequest.Merge(m, src)
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest.Merge(m, src)
 }
-func (m *MsgAcceptPaymentRequest) XXX_Size() int {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAcceptPaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAcceptPaymentRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAcceptPaymentRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsRequest proto.InternalMessageInfo
 
-func (m *MsgAcceptPaymentRequest) GetPayment() Payment {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) GetAdmin() string {
 	if m != nil {
-		return m.Payment
+		return m.Admin
 	}
-	return Payment{}
+	return ""
 }
 
-// MsgAcceptPaymentResponse is a response message for the AcceptPayment endpoint.
-type MsgAcceptPaymentResponse struct {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *MsgAcceptPaymentResponse) Reset()         { *m = MsgAcceptPaymentResponse{} }
-func (m *MsgAcceptPaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAcceptPaymentResponse) ProtoMessage()    {}
-func (*MsgAcceptPaymentResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) GetAcceptingCommitments() bool {
+	if m != nil {
+		return m.AcceptingCommitments
+	}
+	return false
+}
+
+// MsgMarketUpdateAcceptingCommitmentsResponse is a response message for the MarketUpdateAcceptingCommitments endpoint.
+type MsgMarketUpdateAcceptingCommitmentsResponse struct {
+}
+
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Reset() {
+	*m = MsgMarketUpdateAcceptingCommitmentsResponse{}
+}
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMarketUpdateAcceptingCommitmentsResponse) ProtoMessage() {}
+func (*MsgMarketUpdateAcceptingCommitmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{41}
 }
-func (m *MsgAcceptPaymentResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAcceptPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAcceptPaymentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2357,40 +2402,42 @@ func (m *MsgAcceptPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgAcceptPaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAcceptPaymentResponse.Merge(m, src)
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse.Merge(m, src)
 }
-func (m *MsgAcceptPaymentResponse) XXX_Size() int {
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAcceptPaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAcceptPaymentResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAcceptPaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse proto.InternalMessageInfo
 
-// MsgRejectPaymentRequest is a request message for the RejectPayment endpoint.
-type MsgRejectPaymentRequest struct {
-	// target is the target account of the payment to reject.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// source is the source account of the payment to reject.
-	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	// external_id is the external id of the payment to reject.
-	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// MsgMarketUpdateIntermediaryDenomRequest is a request message for the MarketUpdateIntermediaryDenom endpoint.
+type MsgMarketUpdateIntermediaryDenomRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market changing the intermediary denom.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// intermediary_denom is the new intermediary denom for this market to use.
+	IntermediaryDenom string `protobuf:"bytes,3,opt,name=intermediary_denom,json=intermediaryDenom,proto3" json:"intermediary_denom,omitempty"`
 }
 
-func (m *MsgRejectPaymentRequest) Reset()         { *m = MsgRejectPaymentRequest{} }
-func (m *MsgRejectPaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgRejectPaymentRequest) ProtoMessage()    {}
-func (*MsgRejectPaymentRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) Reset() {
+	*m = MsgMarketUpdateIntermediaryDenomRequest{}
+}
+func (m *MsgMarketUpdateIntermediaryDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateIntermediaryDenomRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateIntermediaryDenomRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{42}
 }
-func (m *MsgRejectPaymentRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRejectPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRejectPaymentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2400,55 +2447,57 @@ func (m *MsgRejectPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgRejectPaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRejectPaymentRequest.Merge(m, src)
+func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest.Merge(m, src)
 }
-func (m *MsgRejectPaymentRequest) XXX_Size() int {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRejectPaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRejectPaymentRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRejectPaymentRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateIntermediaryDenomRequest proto.InternalMessageInfo
 
-func (m *MsgRejectPaymentRequest) GetTarget() string {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) GetAdmin() string {
 	if m != nil {
-		return m.Target
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgRejectPaymentRequest) GetSource() string {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Source
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRejectPaymentRequest) GetExternalId() string {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) GetIntermediaryDenom() string {
 	if m != nil {
-		return m.ExternalId
+		return m.IntermediaryDenom
 	}
 	return ""
 }
 
-// MsgRejectPaymentResponse is a response message for the RejectPayment endpoint.
-type MsgRejectPaymentResponse struct {
+// MsgMarketUpdateIntermediaryDenomResponse is a response message for the MarketUpdateIntermediaryDenom endpoint.
+type MsgMarketUpdateIntermediaryDenomResponse struct {
 }
 
-func (m *MsgRejectPaymentResponse) Reset()         { *m = MsgRejectPaymentResponse{} }
-func (m *MsgRejectPaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRejectPaymentResponse) ProtoMessage()    {}
-func (*MsgRejectPaymentResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateIntermediaryDenomResponse) Reset() {
+	*m = MsgMarketUpdateIntermediaryDenomResponse{}
+}
+func (m *MsgMarketUpdateIntermediaryDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateIntermediaryDenomResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateIntermediaryDenomResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{43}
 }
-func (m *MsgRejectPaymentResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRejectPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRejectPaymentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2458,38 +2507,41 @@ func (m *MsgRejectPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgRejectPaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRejectPaymentResponse.Merge(m, src)
+func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse.Merge(m, src)
 }
-func (m *MsgRejectPaymentResponse) XXX_Size() int {
+func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRejectPaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRejectPaymentResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRejectPaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateIntermediaryDenomResponse proto.InternalMessageInfo
 
-// MsgRejectPaymentsRequest is a request message for the RejectPayments endpoint.
-type MsgRejectPaymentsRequest struct {
-	// target is the account that wishes to reject some payments.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// sources is the source accounts of the payments to reject.
-	Sources []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+// MsgMarketUpdateRebatesRequest is a request message for the MarketUpdateRebates endpoint.
+type MsgMarketUpdateRebatesRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market changing its rebate configuration.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// config is the new rebate configuration for the market.
+	// A config with a pool_bips of zero will turn off rebates for the market, paying out anything still in the pool.
+	Config MarketRebateConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
-func (m *MsgRejectPaymentsRequest) Reset()         { *m = MsgRejectPaymentsRequest{} }
-func (m *MsgRejectPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgRejectPaymentsRequest) ProtoMessage()    {}
-func (*MsgRejectPaymentsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateRebatesRequest) Reset()         { *m = MsgMarketUpdateRebatesRequest{} }
+func (m *MsgMarketUpdateRebatesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateRebatesRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateRebatesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{44}
 }
-func (m *MsgRejectPaymentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateRebatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRejectPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateRebatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRejectPaymentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateRebatesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2499,48 +2551,55 @@ func (m *MsgRejectPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgRejectPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRejectPaymentsRequest.Merge(m, src)
+func (m *MsgMarketUpdateRebatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateRebatesRequest.Merge(m, src)
 }
-func (m *MsgRejectPaymentsRequest) XXX_Size() int {
+func (m *MsgMarketUpdateRebatesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRejectPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRejectPaymentsRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateRebatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateRebatesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRejectPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateRebatesRequest proto.InternalMessageInfo
 
-func (m *MsgRejectPaymentsRequest) GetTarget() string {
+func (m *MsgMarketUpdateRebatesRequest) GetAdmin() string {
 	if m != nil {
-		return m.Target
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgRejectPaymentsRequest) GetSources() []string {
+func (m *MsgMarketUpdateRebatesRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Sources
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-// MsgRejectPaymentsResponse is a response message for the RejectPayments endpoint.
-type MsgRejectPaymentsResponse struct {
+func (m *MsgMarketUpdateRebatesRequest) GetConfig() MarketRebateConfig {
+	if m != nil {
+		return m.Config
+	}
+	return MarketRebateConfig{}
 }
 
-func (m *MsgRejectPaymentsResponse) Reset()         { *m = MsgRejectPaymentsResponse{} }
-func (m *MsgRejectPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRejectPaymentsResponse) ProtoMessage()    {}
-func (*MsgRejectPaymentsResponse) Descriptor() ([]byte, []int) {
+// MsgMarketUpdateRebatesResponse is a response message for the MarketUpdateRebates endpoint.
+type MsgMarketUpdateRebatesResponse struct {
+}
+
+func (m *MsgMarketUpdateRebatesResponse) Reset()         { *m = MsgMarketUpdateRebatesResponse{} }
+func (m *MsgMarketUpdateRebatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateRebatesResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateRebatesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{45}
 }
-func (m *MsgRejectPaymentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateRebatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRejectPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateRebatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRejectPaymentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateRebatesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2550,38 +2609,43 @@ func (m *MsgRejectPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgRejectPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRejectPaymentsResponse.Merge(m, src)
+func (m *MsgMarketUpdateRebatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateRebatesResponse.Merge(m, src)
 }
-func (m *MsgRejectPaymentsResponse) XXX_Size() int {
+func (m *MsgMarketUpdateRebatesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRejectPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRejectPaymentsResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateRebatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateRebatesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRejectPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateRebatesResponse proto.InternalMessageInfo
 
-// MsgCancelPaymentsRequest is a request message for the CancelPayments endpoint.
-type MsgCancelPaymentsRequest struct {
-	// source is the account that wishes to cancel some of their payments.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// external_ids is all of the external ids of the payments to cancel.
-	ExternalIds []string `protobuf:"bytes,2,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty"`
+// MsgMarketUpdateExternalSettlementRequest is a request message for the MarketUpdateExternalSettlement endpoint.
+type MsgMarketUpdateExternalSettlementRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market changing its external settlement configuration.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// config is the new external settlement configuration for the market.
+	// A config without any oracles will turn off external settlement for the market.
+	Config ExternalSettlementConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
-func (m *MsgCancelPaymentsRequest) Reset()         { *m = MsgCancelPaymentsRequest{} }
-func (m *MsgCancelPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelPaymentsRequest) ProtoMessage()    {}
-func (*MsgCancelPaymentsRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdateExternalSettlementRequest) Reset() {
+	*m = MsgMarketUpdateExternalSettlementRequest{}
+}
+func (m *MsgMarketUpdateExternalSettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateExternalSettlementRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateExternalSettlementRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{46}
 }
-func (m *MsgCancelPaymentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateExternalSettlementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateExternalSettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelPaymentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateExternalSettlementRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2591,48 +2655,59 @@ func (m *MsgCancelPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelPaymentsRequest.Merge(m, src)
+func (m *MsgMarketUpdateExternalSettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateExternalSettlementRequest.Merge(m, src)
 }
-func (m *MsgCancelPaymentsRequest) XXX_Size() int {
+func (m *MsgMarketUpdateExternalSettlementRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelPaymentsRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdateExternalSettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateExternalSettlementRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateExternalSettlementRequest proto.InternalMessageInfo
 
-func (m *MsgCancelPaymentsRequest) GetSource() string {
+func (m *MsgMarketUpdateExternalSettlementRequest) GetAdmin() string {
 	if m != nil {
-		return m.Source
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgCancelPaymentsRequest) GetExternalIds() []string {
+func (m *MsgMarketUpdateExternalSettlementRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.ExternalIds
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-// MsgCancelPaymentsResponse is a response message for the CancelPayments endpoint.
-type MsgCancelPaymentsResponse struct {
+func (m *MsgMarketUpdateExternalSettlementRequest) GetConfig() ExternalSettlementConfig {
+	if m != nil {
+		return m.Config
+	}
+	return ExternalSettlementConfig{}
 }
 
-func (m *MsgCancelPaymentsResponse) Reset()         { *m = MsgCancelPaymentsResponse{} }
-func (m *MsgCancelPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelPaymentsResponse) ProtoMessage()    {}
-func (*MsgCancelPaymentsResponse) Descriptor() ([]byte, []int) {
+// MsgMarketUpdateExternalSettlementResponse is a response message for the MarketUpdateExternalSettlement endpoint.
+type MsgMarketUpdateExternalSettlementResponse struct {
+}
+
+func (m *MsgMarketUpdateExternalSettlementResponse) Reset() {
+	*m = MsgMarketUpdateExternalSettlementResponse{}
+}
+func (m *MsgMarketUpdateExternalSettlementResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMarketUpdateExternalSettlementResponse) ProtoMessage() {}
+func (*MsgMarketUpdateExternalSettlementResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{47}
 }
-func (m *MsgCancelPaymentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdateExternalSettlementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdateExternalSettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelPaymentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdateExternalSettlementResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2642,40 +2717,41 @@ func (m *MsgCancelPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelPaymentsResponse.Merge(m, src)
+func (m *MsgMarketUpdateExternalSettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateExternalSettlementResponse.Merge(m, src)
 }
-func (m *MsgCancelPaymentsResponse) XXX_Size() int {
+func (m *MsgMarketUpdateExternalSettlementResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelPaymentsResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdateExternalSettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateExternalSettlementResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdateExternalSettlementResponse proto.InternalMessageInfo
 
-// MsgChangePaymentTargetRequest is a request message for the ChangePaymentTarget endpoint.
-type MsgChangePaymentTargetRequest struct {
-	// source is the account that wishes to update the target of one of their payments.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// external_id is the external id of the payment to update.
-	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	// new_target is the new target account of the payment.
-	NewTarget string `protobuf:"bytes,3,opt,name=new_target,json=newTarget,proto3" json:"new_target,omitempty"`
+// MsgMarketUpdatePriceBandRequest is a request message for the MarketUpdatePriceBand endpoint.
+type MsgMarketUpdatePriceBandRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market changing its price band configuration.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// config is the new price band configuration for the market.
+	// A config with zero max_deviation_bips will remove the market's price band.
+	Config MarketPriceBandConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
-func (m *MsgChangePaymentTargetRequest) Reset()         { *m = MsgChangePaymentTargetRequest{} }
-func (m *MsgChangePaymentTargetRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgChangePaymentTargetRequest) ProtoMessage()    {}
-func (*MsgChangePaymentTargetRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdatePriceBandRequest) Reset()         { *m = MsgMarketUpdatePriceBandRequest{} }
+func (m *MsgMarketUpdatePriceBandRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdatePriceBandRequest) ProtoMessage()    {}
+func (*MsgMarketUpdatePriceBandRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{48}
 }
-func (m *MsgChangePaymentTargetRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdatePriceBandRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangePaymentTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdatePriceBandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangePaymentTargetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdatePriceBandRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2685,55 +2761,55 @@ func (m *MsgChangePaymentTargetRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgChangePaymentTargetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangePaymentTargetRequest.Merge(m, src)
+func (m *MsgMarketUpdatePriceBandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdatePriceBandRequest.Merge(m, src)
 }
-func (m *MsgChangePaymentTargetRequest) XXX_Size() int {
+func (m *MsgMarketUpdatePriceBandRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangePaymentTargetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangePaymentTargetRequest.DiscardUnknown(m)
+func (m *MsgMarketUpdatePriceBandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdatePriceBandRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangePaymentTargetRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdatePriceBandRequest proto.InternalMessageInfo
 
-func (m *MsgChangePaymentTargetRequest) GetSource() string {
+func (m *MsgMarketUpdatePriceBandRequest) GetAdmin() string {
 	if m != nil {
-		return m.Source
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgChangePaymentTargetRequest) GetExternalId() string {
+func (m *MsgMarketUpdatePriceBandRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.ExternalId
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgChangePaymentTargetRequest) GetNewTarget() string {
+func (m *MsgMarketUpdatePriceBandRequest) GetConfig() MarketPriceBandConfig {
 	if m != nil {
-		return m.NewTarget
+		return m.Config
 	}
-	return ""
+	return MarketPriceBandConfig{}
 }
 
-// MsgChangePaymentTargetResponse is a response message for the ChangePaymentTarget endpoint.
-type MsgChangePaymentTargetResponse struct {
+// MsgMarketUpdatePriceBandResponse is a response message for the MarketUpdatePriceBand endpoint.
+type MsgMarketUpdatePriceBandResponse struct {
 }
 
-func (m *MsgChangePaymentTargetResponse) Reset()         { *m = MsgChangePaymentTargetResponse{} }
-func (m *MsgChangePaymentTargetResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgChangePaymentTargetResponse) ProtoMessage()    {}
-func (*MsgChangePaymentTargetResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketUpdatePriceBandResponse) Reset()         { *m = MsgMarketUpdatePriceBandResponse{} }
+func (m *MsgMarketUpdatePriceBandResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdatePriceBandResponse) ProtoMessage()    {}
+func (*MsgMarketUpdatePriceBandResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{49}
 }
-func (m *MsgChangePaymentTargetResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketUpdatePriceBandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangePaymentTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketUpdatePriceBandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangePaymentTargetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketUpdatePriceBandResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2743,40 +2819,38 @@ func (m *MsgChangePaymentTargetResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgChangePaymentTargetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangePaymentTargetResponse.Merge(m, src)
+func (m *MsgMarketUpdatePriceBandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdatePriceBandResponse.Merge(m, src)
 }
-func (m *MsgChangePaymentTargetResponse) XXX_Size() int {
+func (m *MsgMarketUpdatePriceBandResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangePaymentTargetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangePaymentTargetResponse.DiscardUnknown(m)
+func (m *MsgMarketUpdatePriceBandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdatePriceBandResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangePaymentTargetResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketUpdatePriceBandResponse proto.InternalMessageInfo
 
-// MsgGovCreateMarketRequest is a request message for the GovCreateMarket endpoint.
-type MsgGovCreateMarketRequest struct {
-	// authority should be the governance module account address.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// market is the initial market configuration.
-	// If the market_id is 0, the next available market_id will be used (once voting ends).
-	// If it is not zero, it must not yet be in use when the voting period ends.
-	Market Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market"`
+// MsgMarketResumeSettlementsRequest is a request message for the MarketResumeSettlements endpoint.
+type MsgMarketResumeSettlementsRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to resume settlements in.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *MsgGovCreateMarketRequest) Reset()         { *m = MsgGovCreateMarketRequest{} }
-func (m *MsgGovCreateMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgGovCreateMarketRequest) ProtoMessage()    {}
-func (*MsgGovCreateMarketRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketResumeSettlementsRequest) Reset()         { *m = MsgMarketResumeSettlementsRequest{} }
+func (m *MsgMarketResumeSettlementsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketResumeSettlementsRequest) ProtoMessage()    {}
+func (*MsgMarketResumeSettlementsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{50}
 }
-func (m *MsgGovCreateMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketResumeSettlementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketResumeSettlementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovCreateMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketResumeSettlementsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2786,48 +2860,48 @@ func (m *MsgGovCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgGovCreateMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovCreateMarketRequest.Merge(m, src)
+func (m *MsgMarketResumeSettlementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketResumeSettlementsRequest.Merge(m, src)
 }
-func (m *MsgGovCreateMarketRequest) XXX_Size() int {
+func (m *MsgMarketResumeSettlementsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovCreateMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovCreateMarketRequest.DiscardUnknown(m)
+func (m *MsgMarketResumeSettlementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketResumeSettlementsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovCreateMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketResumeSettlementsRequest proto.InternalMessageInfo
 
-func (m *MsgGovCreateMarketRequest) GetAuthority() string {
+func (m *MsgMarketResumeSettlementsRequest) GetAdmin() string {
 	if m != nil {
-		return m.Authority
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgGovCreateMarketRequest) GetMarket() Market {
+func (m *MsgMarketResumeSettlementsRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Market
+		return m.MarketId
 	}
-	return Market{}
+	return 0
 }
 
-// MsgGovCreateMarketResponse is a response message for the GovCreateMarket endpoint.
-type MsgGovCreateMarketResponse struct {
+// MsgMarketResumeSettlementsResponse is a response message for the MarketResumeSettlements endpoint.
+type MsgMarketResumeSettlementsResponse struct {
 }
 
-func (m *MsgGovCreateMarketResponse) Reset()         { *m = MsgGovCreateMarketResponse{} }
-func (m *MsgGovCreateMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGovCreateMarketResponse) ProtoMessage()    {}
-func (*MsgGovCreateMarketResponse) Descriptor() ([]byte, []int) {
+func (m *MsgMarketResumeSettlementsResponse) Reset()         { *m = MsgMarketResumeSettlementsResponse{} }
+func (m *MsgMarketResumeSettlementsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketResumeSettlementsResponse) ProtoMessage()    {}
+func (*MsgMarketResumeSettlementsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{51}
 }
-func (m *MsgGovCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketResumeSettlementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketResumeSettlementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovCreateMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketResumeSettlementsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2837,72 +2911,44 @@ func (m *MsgGovCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgGovCreateMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovCreateMarketResponse.Merge(m, src)
+func (m *MsgMarketResumeSettlementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketResumeSettlementsResponse.Merge(m, src)
 }
-func (m *MsgGovCreateMarketResponse) XXX_Size() int {
+func (m *MsgMarketResumeSettlementsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovCreateMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovCreateMarketResponse.DiscardUnknown(m)
+func (m *MsgMarketResumeSettlementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketResumeSettlementsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovCreateMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketResumeSettlementsResponse proto.InternalMessageInfo
 
-// MsgGovManageFeesRequest is a request message for the GovManageFees endpoint.
-type MsgGovManageFeesRequest struct {
-	// authority should be the governance module account address.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// market_id is the market id that will get these fee updates.
+// MsgMarketStartExternalSettlementRequest is a request message for the MarketStartExternalSettlement endpoint.
+type MsgMarketStartExternalSettlementRequest struct {
+	// admin is the account with "settle" permission requesting this settlement.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market starting this settlement.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// add_fee_create_ask_flat are the create-ask flat fee options to add.
-	AddFeeCreateAskFlat []types.Coin `protobuf:"bytes,3,rep,name=add_fee_create_ask_flat,json=addFeeCreateAskFlat,proto3" json:"add_fee_create_ask_flat"`
-	// remove_fee_create_ask_flat are the create-ask flat fee options to remove.
-	RemoveFeeCreateAskFlat []types.Coin `protobuf:"bytes,4,rep,name=remove_fee_create_ask_flat,json=removeFeeCreateAskFlat,proto3" json:"remove_fee_create_ask_flat"`
-	// add_fee_create_bid_flat are the create-bid flat fee options to add.
-	AddFeeCreateBidFlat []types.Coin `protobuf:"bytes,5,rep,name=add_fee_create_bid_flat,json=addFeeCreateBidFlat,proto3" json:"add_fee_create_bid_flat"`
-	// remove_fee_create_bid_flat are the create-bid flat fee options to remove.
-	RemoveFeeCreateBidFlat []types.Coin `protobuf:"bytes,6,rep,name=remove_fee_create_bid_flat,json=removeFeeCreateBidFlat,proto3" json:"remove_fee_create_bid_flat"`
-	// add_fee_seller_settlement_flat are the seller settlement flat fee options to add.
-	AddFeeSellerSettlementFlat []types.Coin `protobuf:"bytes,7,rep,name=add_fee_seller_settlement_flat,json=addFeeSellerSettlementFlat,proto3" json:"add_fee_seller_settlement_flat"`
-	// remove_fee_seller_settlement_flat are the seller settlement flat fee options to remove.
-	RemoveFeeSellerSettlementFlat []types.Coin `protobuf:"bytes,8,rep,name=remove_fee_seller_settlement_flat,json=removeFeeSellerSettlementFlat,proto3" json:"remove_fee_seller_settlement_flat"`
-	// add_fee_seller_settlement_ratios are the seller settlement fee ratios to add.
-	AddFeeSellerSettlementRatios []FeeRatio `protobuf:"bytes,9,rep,name=add_fee_seller_settlement_ratios,json=addFeeSellerSettlementRatios,proto3" json:"add_fee_seller_settlement_ratios"`
-	// remove_fee_seller_settlement_ratios are the seller settlement fee ratios to remove.
-	RemoveFeeSellerSettlementRatios []FeeRatio `protobuf:"bytes,10,rep,name=remove_fee_seller_settlement_ratios,json=removeFeeSellerSettlementRatios,proto3" json:"remove_fee_seller_settlement_ratios"`
-	// add_fee_buyer_settlement_flat are the buyer settlement flat fee options to add.
-	AddFeeBuyerSettlementFlat []types.Coin `protobuf:"bytes,11,rep,name=add_fee_buyer_settlement_flat,json=addFeeBuyerSettlementFlat,proto3" json:"add_fee_buyer_settlement_flat"`
-	// remove_fee_buyer_settlement_flat are the buyer settlement flat fee options to remove.
-	RemoveFeeBuyerSettlementFlat []types.Coin `protobuf:"bytes,12,rep,name=remove_fee_buyer_settlement_flat,json=removeFeeBuyerSettlementFlat,proto3" json:"remove_fee_buyer_settlement_flat"`
-	// add_fee_buyer_settlement_ratios are the buyer settlement fee ratios to add.
-	AddFeeBuyerSettlementRatios []FeeRatio `protobuf:"bytes,13,rep,name=add_fee_buyer_settlement_ratios,json=addFeeBuyerSettlementRatios,proto3" json:"add_fee_buyer_settlement_ratios"`
-	// remove_fee_buyer_settlement_ratios are the buyer settlement fee ratios to remove.
-	RemoveFeeBuyerSettlementRatios []FeeRatio `protobuf:"bytes,14,rep,name=remove_fee_buyer_settlement_ratios,json=removeFeeBuyerSettlementRatios,proto3" json:"remove_fee_buyer_settlement_ratios"`
-	// add_fee_create_commitment_flat are the create-commitment flat fee options to add.
-	AddFeeCreateCommitmentFlat []types.Coin `protobuf:"bytes,15,rep,name=add_fee_create_commitment_flat,json=addFeeCreateCommitmentFlat,proto3" json:"add_fee_create_commitment_flat"`
-	// remove_fee_create_commitment_flat are the create-commitment flat fee options to remove.
-	RemoveFeeCreateCommitmentFlat []types.Coin `protobuf:"bytes,16,rep,name=remove_fee_create_commitment_flat,json=removeFeeCreateCommitmentFlat,proto3" json:"remove_fee_create_commitment_flat"`
-	// set_fee_commitment_settlement_bips is the new fee_commitment_settlement_bips for the market.
-	// It is ignored if it is zero. To set it to zero set unset_fee_commitment_settlement_bips to true.
-	SetFeeCommitmentSettlementBips uint32 `protobuf:"varint,17,opt,name=set_fee_commitment_settlement_bips,json=setFeeCommitmentSettlementBips,proto3" json:"set_fee_commitment_settlement_bips,omitempty"`
-	// unset_fee_commitment_settlement_bips, if true, sets the fee_commitment_settlement_bips to zero.
-	// If false, it is ignored.
-	UnsetFeeCommitmentSettlementBips bool `protobuf:"varint,18,opt,name=unset_fee_commitment_settlement_bips,json=unsetFeeCommitmentSettlementBips,proto3" json:"unset_fee_commitment_settlement_bips,omitempty"`
+	// order_id is the numerical identifier of the ask order to settle.
+	OrderId uint64 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// buyer is the bech32 address string of the account that will receive the assets once payment is confirmed.
+	Buyer string `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
 }
 
-func (m *MsgGovManageFeesRequest) Reset()         { *m = MsgGovManageFeesRequest{} }
-func (m *MsgGovManageFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgGovManageFeesRequest) ProtoMessage()    {}
-func (*MsgGovManageFeesRequest) Descriptor() ([]byte, []int) {
+func (m *MsgMarketStartExternalSettlementRequest) Reset() {
+	*m = MsgMarketStartExternalSettlementRequest{}
+}
+func (m *MsgMarketStartExternalSettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketStartExternalSettlementRequest) ProtoMessage()    {}
+func (*MsgMarketStartExternalSettlementRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e333fcffc093bd1b, []int{52}
 }
-func (m *MsgGovManageFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketStartExternalSettlementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketStartExternalSettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovManageFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketStartExternalSettlementRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2912,160 +2958,183 @@ func (m *MsgGovManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgGovManageFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovManageFeesRequest.Merge(m, src)
+func (m *MsgMarketStartExternalSettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketStartExternalSettlementRequest.Merge(m, src)
 }
-func (m *MsgGovManageFeesRequest) XXX_Size() int {
+func (m *MsgMarketStartExternalSettlementRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovManageFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovManageFeesRequest.DiscardUnknown(m)
+func (m *MsgMarketStartExternalSettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketStartExternalSettlementRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovManageFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketStartExternalSettlementRequest proto.InternalMessageInfo
 
-func (m *MsgGovManageFeesRequest) GetAuthority() string {
+func (m *MsgMarketStartExternalSettlementRequest) GetAdmin() string {
 	if m != nil {
-		return m.Authority
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgGovManageFeesRequest) GetMarketId() uint32 {
+func (m *MsgMarketStartExternalSettlementRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *MsgGovManageFeesRequest) GetAddFeeCreateAskFlat() []types.Coin {
+func (m *MsgMarketStartExternalSettlementRequest) GetOrderId() uint64 {
 	if m != nil {
-		return m.AddFeeCreateAskFlat
+		return m.OrderId
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgGovManageFeesRequest) GetRemoveFeeCreateAskFlat() []types.Coin {
+func (m *MsgMarketStartExternalSettlementRequest) GetBuyer() string {
 	if m != nil {
-		return m.RemoveFeeCreateAskFlat
+		return m.Buyer
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgGovManageFeesRequest) GetAddFeeCreateBidFlat() []types.Coin {
-	if m != nil {
-		return m.AddFeeCreateBidFlat
-	}
-	return nil
+// MsgMarketStartExternalSettlementResponse is a response message for the MarketStartExternalSettlement endpoint.
+type MsgMarketStartExternalSettlementResponse struct {
+	// expiration_height is the block height at which the settlement will be cancelled if payment hasn't been confirmed.
+	ExpirationHeight int64 `protobuf:"varint,1,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
 }
 
-func (m *MsgGovManageFeesRequest) GetRemoveFeeCreateBidFlat() []types.Coin {
-	if m != nil {
-		return m.RemoveFeeCreateBidFlat
-	}
-	return nil
+func (m *MsgMarketStartExternalSettlementResponse) Reset() {
+	*m = MsgMarketStartExternalSettlementResponse{}
 }
-
-func (m *MsgGovManageFeesRequest) GetAddFeeSellerSettlementFlat() []types.Coin {
-	if m != nil {
-		return m.AddFeeSellerSettlementFlat
-	}
-	return nil
+func (m *MsgMarketStartExternalSettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketStartExternalSettlementResponse) ProtoMessage()    {}
+func (*MsgMarketStartExternalSettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{53}
 }
-
-func (m *MsgGovManageFeesRequest) GetRemoveFeeSellerSettlementFlat() []types.Coin {
-	if m != nil {
-		return m.RemoveFeeSellerSettlementFlat
-	}
-	return nil
+func (m *MsgMarketStartExternalSettlementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgGovManageFeesRequest) GetAddFeeSellerSettlementRatios() []FeeRatio {
-	if m != nil {
-		return m.AddFeeSellerSettlementRatios
+func (m *MsgMarketStartExternalSettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketStartExternalSettlementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *MsgGovManageFeesRequest) GetRemoveFeeSellerSettlementRatios() []FeeRatio {
-	if m != nil {
-		return m.RemoveFeeSellerSettlementRatios
-	}
-	return nil
+func (m *MsgMarketStartExternalSettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketStartExternalSettlementResponse.Merge(m, src)
 }
-
-func (m *MsgGovManageFeesRequest) GetAddFeeBuyerSettlementFlat() []types.Coin {
-	if m != nil {
-		return m.AddFeeBuyerSettlementFlat
-	}
-	return nil
+func (m *MsgMarketStartExternalSettlementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketStartExternalSettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketStartExternalSettlementResponse.DiscardUnknown(m)
 }
 
-func (m *MsgGovManageFeesRequest) GetRemoveFeeBuyerSettlementFlat() []types.Coin {
+var xxx_messageInfo_MsgMarketStartExternalSettlementResponse proto.InternalMessageInfo
+
+func (m *MsgMarketStartExternalSettlementResponse) GetExpirationHeight() int64 {
 	if m != nil {
-		return m.RemoveFeeBuyerSettlementFlat
+		return m.ExpirationHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgGovManageFeesRequest) GetAddFeeBuyerSettlementRatios() []FeeRatio {
-	if m != nil {
-		return m.AddFeeBuyerSettlementRatios
-	}
-	return nil
+// MsgConfirmExternalPaymentRequest is a request message for the ConfirmExternalPayment endpoint.
+type MsgConfirmExternalPaymentRequest struct {
+	// oracle is one of the market's oracle accounts.
+	Oracle string `protobuf:"bytes,1,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// market_id is the numerical identifier of the market that started the settlement.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// order_id is the numerical identifier of the ask order being settled.
+	OrderId uint64 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// payment_reference identifies the external payment (e.g. a wire confirmation number).
+	PaymentReference string `protobuf:"bytes,4,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
 }
 
-func (m *MsgGovManageFeesRequest) GetRemoveFeeBuyerSettlementRatios() []FeeRatio {
-	if m != nil {
-		return m.RemoveFeeBuyerSettlementRatios
+func (m *MsgConfirmExternalPaymentRequest) Reset()         { *m = MsgConfirmExternalPaymentRequest{} }
+func (m *MsgConfirmExternalPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmExternalPaymentRequest) ProtoMessage()    {}
+func (*MsgConfirmExternalPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{54}
+}
+func (m *MsgConfirmExternalPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmExternalPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmExternalPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *MsgConfirmExternalPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmExternalPaymentRequest.Merge(m, src)
+}
+func (m *MsgConfirmExternalPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmExternalPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmExternalPaymentRequest.DiscardUnknown(m)
 }
 
-func (m *MsgGovManageFeesRequest) GetAddFeeCreateCommitmentFlat() []types.Coin {
+var xxx_messageInfo_MsgConfirmExternalPaymentRequest proto.InternalMessageInfo
+
+func (m *MsgConfirmExternalPaymentRequest) GetOracle() string {
 	if m != nil {
-		return m.AddFeeCreateCommitmentFlat
+		return m.Oracle
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgGovManageFeesRequest) GetRemoveFeeCreateCommitmentFlat() []types.Coin {
+func (m *MsgConfirmExternalPaymentRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.RemoveFeeCreateCommitmentFlat
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgGovManageFeesRequest) GetSetFeeCommitmentSettlementBips() uint32 {
+func (m *MsgConfirmExternalPaymentRequest) GetOrderId() uint64 {
 	if m != nil {
-		return m.SetFeeCommitmentSettlementBips
+		return m.OrderId
 	}
 	return 0
 }
 
-func (m *MsgGovManageFeesRequest) GetUnsetFeeCommitmentSettlementBips() bool {
+func (m *MsgConfirmExternalPaymentRequest) GetPaymentReference() string {
 	if m != nil {
-		return m.UnsetFeeCommitmentSettlementBips
+		return m.PaymentReference
 	}
-	return false
+	return ""
 }
 
-// MsgGovManageFeesResponse is a response message for the GovManageFees endpoint.
-type MsgGovManageFeesResponse struct {
+// MsgConfirmExternalPaymentResponse is a response message for the ConfirmExternalPayment endpoint.
+type MsgConfirmExternalPaymentResponse struct {
 }
 
-func (m *MsgGovManageFeesResponse) Reset()         { *m = MsgGovManageFeesResponse{} }
-func (m *MsgGovManageFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGovManageFeesResponse) ProtoMessage()    {}
-func (*MsgGovManageFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{53}
+func (m *MsgConfirmExternalPaymentResponse) Reset()         { *m = MsgConfirmExternalPaymentResponse{} }
+func (m *MsgConfirmExternalPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmExternalPaymentResponse) ProtoMessage()    {}
+func (*MsgConfirmExternalPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{55}
 }
-func (m *MsgGovManageFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgConfirmExternalPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgConfirmExternalPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovManageFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgConfirmExternalPaymentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3075,38 +3144,44 @@ func (m *MsgGovManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgGovManageFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovManageFeesResponse.Merge(m, src)
+func (m *MsgConfirmExternalPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmExternalPaymentResponse.Merge(m, src)
 }
-func (m *MsgGovManageFeesResponse) XXX_Size() int {
+func (m *MsgConfirmExternalPaymentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovManageFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovManageFeesResponse.DiscardUnknown(m)
+func (m *MsgConfirmExternalPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmExternalPaymentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovManageFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgConfirmExternalPaymentResponse proto.InternalMessageInfo
 
-// MsgGovCloseMarketRequest is a request message for the GovCloseMarket endpoint.
-type MsgGovCloseMarketRequest struct {
-	// authority must be the governance module account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// market_id is the numerical identifier of the market to close.
+// MsgMarketManagePermissionsRequest is a request message for the MarketManagePermissions endpoint.
+type MsgMarketManagePermissionsRequest struct {
+	// admin is the account with "permissions" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to manage permissions for.
 	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// revoke_all are addresses that should have all their permissions revoked.
+	RevokeAll []string `protobuf:"bytes,3,rep,name=revoke_all,json=revokeAll,proto3" json:"revoke_all,omitempty"`
+	// to_revoke are the specific permissions to remove for addresses.
+	ToRevoke []AccessGrant `protobuf:"bytes,4,rep,name=to_revoke,json=toRevoke,proto3" json:"to_revoke"`
+	// to_grant are the permissions to grant to addresses.
+	ToGrant []AccessGrant `protobuf:"bytes,5,rep,name=to_grant,json=toGrant,proto3" json:"to_grant"`
 }
 
-func (m *MsgGovCloseMarketRequest) Reset()         { *m = MsgGovCloseMarketRequest{} }
-func (m *MsgGovCloseMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgGovCloseMarketRequest) ProtoMessage()    {}
-func (*MsgGovCloseMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{54}
+func (m *MsgMarketManagePermissionsRequest) Reset()         { *m = MsgMarketManagePermissionsRequest{} }
+func (m *MsgMarketManagePermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketManagePermissionsRequest) ProtoMessage()    {}
+func (*MsgMarketManagePermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{56}
 }
-func (m *MsgGovCloseMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketManagePermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovCloseMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketManagePermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovCloseMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketManagePermissionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3116,49 +3191,70 @@ func (m *MsgGovCloseMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgGovCloseMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovCloseMarketRequest.Merge(m, src)
+func (m *MsgMarketManagePermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketManagePermissionsRequest.Merge(m, src)
 }
-func (m *MsgGovCloseMarketRequest) XXX_Size() int {
+func (m *MsgMarketManagePermissionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovCloseMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovCloseMarketRequest.DiscardUnknown(m)
+func (m *MsgMarketManagePermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketManagePermissionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovCloseMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketManagePermissionsRequest proto.InternalMessageInfo
 
-func (m *MsgGovCloseMarketRequest) GetAuthority() string {
+func (m *MsgMarketManagePermissionsRequest) GetAdmin() string {
 	if m != nil {
-		return m.Authority
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgGovCloseMarketRequest) GetMarketId() uint32 {
+func (m *MsgMarketManagePermissionsRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// MsgGovCloseMarketResponse is a response message for the GovCloseMarket endpoint.
-type MsgGovCloseMarketResponse struct {
+func (m *MsgMarketManagePermissionsRequest) GetRevokeAll() []string {
+	if m != nil {
+		return m.RevokeAll
+	}
+	return nil
 }
 
-func (m *MsgGovCloseMarketResponse) Reset()         { *m = MsgGovCloseMarketResponse{} }
-func (m *MsgGovCloseMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGovCloseMarketResponse) ProtoMessage()    {}
-func (*MsgGovCloseMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{55}
-}
-func (m *MsgGovCloseMarketResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *MsgMarketManagePermissionsRequest) GetToRevoke() []AccessGrant {
+	if m != nil {
+		return m.ToRevoke
+	}
+	return nil
 }
-func (m *MsgGovCloseMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgGovCloseMarketResponse.Marshal(b, m, deterministic)
-	} else {
+
+func (m *MsgMarketManagePermissionsRequest) GetToGrant() []AccessGrant {
+	if m != nil {
+		return m.ToGrant
+	}
+	return nil
+}
+
+// MsgMarketManagePermissionsResponse is a response message for the MarketManagePermissions endpoint.
+type MsgMarketManagePermissionsResponse struct {
+}
+
+func (m *MsgMarketManagePermissionsResponse) Reset()         { *m = MsgMarketManagePermissionsResponse{} }
+func (m *MsgMarketManagePermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketManagePermissionsResponse) ProtoMessage()    {}
+func (*MsgMarketManagePermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{57}
+}
+func (m *MsgMarketManagePermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketManagePermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketManagePermissionsResponse.Marshal(b, m, deterministic)
+	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
@@ -3167,41 +3263,50 @@ func (m *MsgGovCloseMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgGovCloseMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovCloseMarketResponse.Merge(m, src)
+func (m *MsgMarketManagePermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketManagePermissionsResponse.Merge(m, src)
 }
-func (m *MsgGovCloseMarketResponse) XXX_Size() int {
+func (m *MsgMarketManagePermissionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovCloseMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovCloseMarketResponse.DiscardUnknown(m)
+func (m *MsgMarketManagePermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketManagePermissionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovCloseMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketManagePermissionsResponse proto.InternalMessageInfo
 
-// MsgGovUpdateParamsRequest is a request message for the GovUpdateParams endpoint.
-// Deprecated: Use MsgUpdateParamsRequest instead.
-//
-// Deprecated: Do not use.
-type MsgGovUpdateParamsRequest struct {
-	// authority should be the governance module account address.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params are the new param values to set
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgMarketManageReqAttrsRequest is a request message for the MarketManageReqAttrs endpoint.
+type MsgMarketManageReqAttrsRequest struct {
+	// admin is the account with "attributes" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to update required attributes for.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// create_ask_to_add are the attributes that should now also be required to create an ask order.
+	CreateAskToAdd []string `protobuf:"bytes,3,rep,name=create_ask_to_add,json=createAskToAdd,proto3" json:"create_ask_to_add,omitempty"`
+	// create_ask_to_remove are the attributes that should no longer be required to create an ask order.
+	CreateAskToRemove []string `protobuf:"bytes,4,rep,name=create_ask_to_remove,json=createAskToRemove,proto3" json:"create_ask_to_remove,omitempty"`
+	// create_bid_to_add are the attributes that should now also be required to create a bid order.
+	CreateBidToAdd []string `protobuf:"bytes,5,rep,name=create_bid_to_add,json=createBidToAdd,proto3" json:"create_bid_to_add,omitempty"`
+	// create_bid_to_remove are the attributes that should no longer be required to create a bid order.
+	CreateBidToRemove []string `protobuf:"bytes,6,rep,name=create_bid_to_remove,json=createBidToRemove,proto3" json:"create_bid_to_remove,omitempty"`
+	// create_commitment_to_add are the attributes that should now also be required to create a commitment.
+	CreateCommitmentToAdd []string `protobuf:"bytes,7,rep,name=create_commitment_to_add,json=createCommitmentToAdd,proto3" json:"create_commitment_to_add,omitempty"`
+	// create_commitment_to_remove are the attributes that should no longer be required to create a commitment.
+	CreateCommitmentToRemove []string `protobuf:"bytes,8,rep,name=create_commitment_to_remove,json=createCommitmentToRemove,proto3" json:"create_commitment_to_remove,omitempty"`
 }
 
-func (m *MsgGovUpdateParamsRequest) Reset()         { *m = MsgGovUpdateParamsRequest{} }
-func (m *MsgGovUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgGovUpdateParamsRequest) ProtoMessage()    {}
-func (*MsgGovUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{56}
+func (m *MsgMarketManageReqAttrsRequest) Reset()         { *m = MsgMarketManageReqAttrsRequest{} }
+func (m *MsgMarketManageReqAttrsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketManageReqAttrsRequest) ProtoMessage()    {}
+func (*MsgMarketManageReqAttrsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{58}
 }
-func (m *MsgGovUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarketManageReqAttrsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketManageReqAttrsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovUpdateParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketManageReqAttrsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3211,51 +3316,90 @@ func (m *MsgGovUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgGovUpdateParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovUpdateParamsRequest.Merge(m, src)
+func (m *MsgMarketManageReqAttrsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketManageReqAttrsRequest.Merge(m, src)
 }
-func (m *MsgGovUpdateParamsRequest) XXX_Size() int {
+func (m *MsgMarketManageReqAttrsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovUpdateParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovUpdateParamsRequest.DiscardUnknown(m)
+func (m *MsgMarketManageReqAttrsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketManageReqAttrsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovUpdateParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketManageReqAttrsRequest proto.InternalMessageInfo
 
-func (m *MsgGovUpdateParamsRequest) GetAuthority() string {
+func (m *MsgMarketManageReqAttrsRequest) GetAdmin() string {
 	if m != nil {
-		return m.Authority
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *MsgGovUpdateParamsRequest) GetParams() Params {
+func (m *MsgMarketManageReqAttrsRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Params
+		return m.MarketId
 	}
-	return Params{}
+	return 0
 }
 
-// MsgGovUpdateParamsResponse is a response message for the GovUpdateParams endpoint.
-// Deprecated: Use MsgUpdateParamsResponse instead.
-//
-// Deprecated: Do not use.
-type MsgGovUpdateParamsResponse struct {
+func (m *MsgMarketManageReqAttrsRequest) GetCreateAskToAdd() []string {
+	if m != nil {
+		return m.CreateAskToAdd
+	}
+	return nil
 }
 
-func (m *MsgGovUpdateParamsResponse) Reset()         { *m = MsgGovUpdateParamsResponse{} }
-func (m *MsgGovUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGovUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgGovUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{57}
+func (m *MsgMarketManageReqAttrsRequest) GetCreateAskToRemove() []string {
+	if m != nil {
+		return m.CreateAskToRemove
+	}
+	return nil
 }
-func (m *MsgGovUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgMarketManageReqAttrsRequest) GetCreateBidToAdd() []string {
+	if m != nil {
+		return m.CreateBidToAdd
+	}
+	return nil
+}
+
+func (m *MsgMarketManageReqAttrsRequest) GetCreateBidToRemove() []string {
+	if m != nil {
+		return m.CreateBidToRemove
+	}
+	return nil
+}
+
+func (m *MsgMarketManageReqAttrsRequest) GetCreateCommitmentToAdd() []string {
+	if m != nil {
+		return m.CreateCommitmentToAdd
+	}
+	return nil
+}
+
+func (m *MsgMarketManageReqAttrsRequest) GetCreateCommitmentToRemove() []string {
+	if m != nil {
+		return m.CreateCommitmentToRemove
+	}
+	return nil
+}
+
+// MsgMarketManageReqAttrsResponse is a response message for the MarketManageReqAttrs endpoint.
+type MsgMarketManageReqAttrsResponse struct {
+}
+
+func (m *MsgMarketManageReqAttrsResponse) Reset()         { *m = MsgMarketManageReqAttrsResponse{} }
+func (m *MsgMarketManageReqAttrsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketManageReqAttrsResponse) ProtoMessage()    {}
+func (*MsgMarketManageReqAttrsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{59}
+}
+func (m *MsgMarketManageReqAttrsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGovUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarketManageReqAttrsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGovUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarketManageReqAttrsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3265,38 +3409,36 @@ func (m *MsgGovUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgGovUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGovUpdateParamsResponse.Merge(m, src)
+func (m *MsgMarketManageReqAttrsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketManageReqAttrsResponse.Merge(m, src)
 }
-func (m *MsgGovUpdateParamsResponse) XXX_Size() int {
+func (m *MsgMarketManageReqAttrsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGovUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGovUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgMarketManageReqAttrsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketManageReqAttrsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGovUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarketManageReqAttrsResponse proto.InternalMessageInfo
 
-// MsgGovUpdateParamsRequest is a request message for the GovUpdateParams endpoint.
-type MsgUpdateParamsRequest struct {
-	// authority should be the governance module account address.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params are the new param values to set
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgCreatePaymentRequest is a request message for the CreatePayment endpoint.
+type MsgCreatePaymentRequest struct {
+	// payment is the details of the payment to create.
+	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
 }
 
-func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
-func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsRequest) ProtoMessage()    {}
-func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{58}
+func (m *MsgCreatePaymentRequest) Reset()         { *m = MsgCreatePaymentRequest{} }
+func (m *MsgCreatePaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentRequest) ProtoMessage()    {}
+func (*MsgCreatePaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{60}
 }
-func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePaymentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3306,48 +3448,41 @@ func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
+func (m *MsgCreatePaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentRequest.Merge(m, src)
 }
-func (m *MsgUpdateParamsRequest) XXX_Size() int {
+func (m *MsgCreatePaymentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
+func (m *MsgCreatePaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
-
-func (m *MsgUpdateParamsRequest) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgCreatePaymentRequest proto.InternalMessageInfo
 
-func (m *MsgUpdateParamsRequest) GetParams() Params {
+func (m *MsgCreatePaymentRequest) GetPayment() Payment {
 	if m != nil {
-		return m.Params
+		return m.Payment
 	}
-	return Params{}
+	return Payment{}
 }
 
-// MsgUpdateParamsResponse is a response message for the GovUpdateParams endpoint.
-type MsgUpdateParamsResponse struct {
+// MsgCreatePaymentResponse is a response message for the CreatePayment endpoint.
+type MsgCreatePaymentResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{59}
+func (m *MsgCreatePaymentResponse) Reset()         { *m = MsgCreatePaymentResponse{} }
+func (m *MsgCreatePaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentResponse) ProtoMessage()    {}
+func (*MsgCreatePaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{61}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePaymentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3357,3391 +3492,3185 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCreatePaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCreatePaymentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCreatePaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePaymentResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateAskRequest)(nil), "provenance.exchange.v1.MsgCreateAskRequest")
-	proto.RegisterType((*MsgCreateAskResponse)(nil), "provenance.exchange.v1.MsgCreateAskResponse")
-	proto.RegisterType((*MsgCreateBidRequest)(nil), "provenance.exchange.v1.MsgCreateBidRequest")
-	proto.RegisterType((*MsgCreateBidResponse)(nil), "provenance.exchange.v1.MsgCreateBidResponse")
-	proto.RegisterType((*MsgCommitFundsRequest)(nil), "provenance.exchange.v1.MsgCommitFundsRequest")
-	proto.RegisterType((*MsgCommitFundsResponse)(nil), "provenance.exchange.v1.MsgCommitFundsResponse")
-	proto.RegisterType((*MsgCancelOrderRequest)(nil), "provenance.exchange.v1.MsgCancelOrderRequest")
-	proto.RegisterType((*MsgCancelOrderResponse)(nil), "provenance.exchange.v1.MsgCancelOrderResponse")
-	proto.RegisterType((*MsgFillBidsRequest)(nil), "provenance.exchange.v1.MsgFillBidsRequest")
-	proto.RegisterType((*MsgFillBidsResponse)(nil), "provenance.exchange.v1.MsgFillBidsResponse")
-	proto.RegisterType((*MsgFillAsksRequest)(nil), "provenance.exchange.v1.MsgFillAsksRequest")
-	proto.RegisterType((*MsgFillAsksResponse)(nil), "provenance.exchange.v1.MsgFillAsksResponse")
-	proto.RegisterType((*MsgMarketSettleRequest)(nil), "provenance.exchange.v1.MsgMarketSettleRequest")
-	proto.RegisterType((*MsgMarketSettleResponse)(nil), "provenance.exchange.v1.MsgMarketSettleResponse")
-	proto.RegisterType((*MsgMarketCommitmentSettleRequest)(nil), "provenance.exchange.v1.MsgMarketCommitmentSettleRequest")
-	proto.RegisterType((*MsgMarketCommitmentSettleResponse)(nil), "provenance.exchange.v1.MsgMarketCommitmentSettleResponse")
-	proto.RegisterType((*MsgMarketReleaseCommitmentsRequest)(nil), "provenance.exchange.v1.MsgMarketReleaseCommitmentsRequest")
-	proto.RegisterType((*MsgMarketReleaseCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketReleaseCommitmentsResponse")
-	proto.RegisterType((*MsgMarketSetOrderExternalIDRequest)(nil), "provenance.exchange.v1.MsgMarketSetOrderExternalIDRequest")
-	proto.RegisterType((*MsgMarketSetOrderExternalIDResponse)(nil), "provenance.exchange.v1.MsgMarketSetOrderExternalIDResponse")
-	proto.RegisterType((*MsgMarketWithdrawRequest)(nil), "provenance.exchange.v1.MsgMarketWithdrawRequest")
-	proto.RegisterType((*MsgMarketWithdrawResponse)(nil), "provenance.exchange.v1.MsgMarketWithdrawResponse")
-	proto.RegisterType((*MsgMarketUpdateDetailsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateDetailsRequest")
-	proto.RegisterType((*MsgMarketUpdateDetailsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateDetailsResponse")
-	proto.RegisterType((*MsgMarketUpdateEnabledRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateEnabledRequest")
-	proto.RegisterType((*MsgMarketUpdateEnabledResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateEnabledResponse")
-	proto.RegisterType((*MsgMarketUpdateAcceptingOrdersRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingOrdersRequest")
-	proto.RegisterType((*MsgMarketUpdateAcceptingOrdersResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingOrdersResponse")
-	proto.RegisterType((*MsgMarketUpdateUserSettleRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleRequest")
-	proto.RegisterType((*MsgMarketUpdateUserSettleResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleResponse")
-	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsRequest")
-	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsResponse")
-	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomRequest")
-	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomResponse")
-	proto.RegisterType((*MsgMarketManagePermissionsRequest)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsRequest")
-	proto.RegisterType((*MsgMarketManagePermissionsResponse)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsResponse")
-	proto.RegisterType((*MsgMarketManageReqAttrsRequest)(nil), "provenance.exchange.v1.MsgMarketManageReqAttrsRequest")
-	proto.RegisterType((*MsgMarketManageReqAttrsResponse)(nil), "provenance.exchange.v1.MsgMarketManageReqAttrsResponse")
-	proto.RegisterType((*MsgCreatePaymentRequest)(nil), "provenance.exchange.v1.MsgCreatePaymentRequest")
-	proto.RegisterType((*MsgCreatePaymentResponse)(nil), "provenance.exchange.v1.MsgCreatePaymentResponse")
-	proto.RegisterType((*MsgAcceptPaymentRequest)(nil), "provenance.exchange.v1.MsgAcceptPaymentRequest")
-	proto.RegisterType((*MsgAcceptPaymentResponse)(nil), "provenance.exchange.v1.MsgAcceptPaymentResponse")
-	proto.RegisterType((*MsgRejectPaymentRequest)(nil), "provenance.exchange.v1.MsgRejectPaymentRequest")
-	proto.RegisterType((*MsgRejectPaymentResponse)(nil), "provenance.exchange.v1.MsgRejectPaymentResponse")
-	proto.RegisterType((*MsgRejectPaymentsRequest)(nil), "provenance.exchange.v1.MsgRejectPaymentsRequest")
-	proto.RegisterType((*MsgRejectPaymentsResponse)(nil), "provenance.exchange.v1.MsgRejectPaymentsResponse")
-	proto.RegisterType((*MsgCancelPaymentsRequest)(nil), "provenance.exchange.v1.MsgCancelPaymentsRequest")
-	proto.RegisterType((*MsgCancelPaymentsResponse)(nil), "provenance.exchange.v1.MsgCancelPaymentsResponse")
-	proto.RegisterType((*MsgChangePaymentTargetRequest)(nil), "provenance.exchange.v1.MsgChangePaymentTargetRequest")
-	proto.RegisterType((*MsgChangePaymentTargetResponse)(nil), "provenance.exchange.v1.MsgChangePaymentTargetResponse")
-	proto.RegisterType((*MsgGovCreateMarketRequest)(nil), "provenance.exchange.v1.MsgGovCreateMarketRequest")
-	proto.RegisterType((*MsgGovCreateMarketResponse)(nil), "provenance.exchange.v1.MsgGovCreateMarketResponse")
-	proto.RegisterType((*MsgGovManageFeesRequest)(nil), "provenance.exchange.v1.MsgGovManageFeesRequest")
-	proto.RegisterType((*MsgGovManageFeesResponse)(nil), "provenance.exchange.v1.MsgGovManageFeesResponse")
-	proto.RegisterType((*MsgGovCloseMarketRequest)(nil), "provenance.exchange.v1.MsgGovCloseMarketRequest")
-	proto.RegisterType((*MsgGovCloseMarketResponse)(nil), "provenance.exchange.v1.MsgGovCloseMarketResponse")
-	proto.RegisterType((*MsgGovUpdateParamsRequest)(nil), "provenance.exchange.v1.MsgGovUpdateParamsRequest")
-	proto.RegisterType((*MsgGovUpdateParamsResponse)(nil), "provenance.exchange.v1.MsgGovUpdateParamsResponse")
-	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.exchange.v1.MsgUpdateParamsRequest")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.exchange.v1.MsgUpdateParamsResponse")
+// MsgAcceptPaymentRequest is a request message for the AcceptPayment endpoint.
+type MsgAcceptPaymentRequest struct {
+	// payment is the details of the payment to accept.
+	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
 }
 
-func init() { proto.RegisterFile("provenance/exchange/v1/tx.proto", fileDescriptor_e333fcffc093bd1b) }
-
-var fileDescriptor_e333fcffc093bd1b = []byte{
-	// 2830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0x78, 0xfd, 0xb5, 0xc7, 0x1f, 0x4d, 0xae, 0xed, 0x64, 0x3d, 0xae, 0xd7, 0x9b, 0x4d,
-	0x03, 0xc6, 0xc5, 0xbb, 0xb1, 0x2b, 0x5a, 0x30, 0x2d, 0xa9, 0xd7, 0x89, 0x23, 0x57, 0x4a, 0xb0,
-	0x36, 0x09, 0x48, 0xe5, 0x61, 0x75, 0xbd, 0x73, 0xb3, 0x19, 0x3c, 0x3b, 0xb3, 0x99, 0x3b, 0xeb,
-	0xd8, 0x12, 0x08, 0x84, 0x2a, 0x01, 0x0f, 0x95, 0x2a, 0x21, 0x5e, 0x10, 0x42, 0x02, 0x24, 0x04,
-	0xe4, 0x81, 0x20, 0x10, 0xe2, 0xe3, 0x91, 0x97, 0x3e, 0xf4, 0xa1, 0xe2, 0x89, 0x27, 0xa8, 0x12,
-	0x89, 0xfc, 0x17, 0x08, 0xdd, 0x8f, 0xd9, 0x9d, 0xef, 0x99, 0x75, 0xb3, 0x11, 0x2f, 0x6d, 0x76,
-	0xe6, 0x9c, 0xf3, 0x3b, 0xbf, 0x73, 0xee, 0x9d, 0x7b, 0xe6, 0x9c, 0x31, 0xac, 0x74, 0x6c, 0xeb,
-	0x88, 0x98, 0xd8, 0x6c, 0x92, 0x2a, 0x39, 0x6e, 0xde, 0xc7, 0x66, 0x8b, 0x54, 0x8f, 0x36, 0xaa,
-	0xce, 0x71, 0xa5, 0x63, 0x5b, 0x8e, 0x85, 0xce, 0xf7, 0x05, 0x2a, 0xae, 0x40, 0xe5, 0x68, 0x43,
-	0x3d, 0x87, 0xdb, 0xba, 0x69, 0x55, 0xf9, 0x7f, 0x85, 0xa8, 0x5a, 0x6c, 0x5a, 0xb4, 0x6d, 0xd1,
-	0xea, 0x01, 0xa6, 0xcc, 0xc6, 0x01, 0x71, 0xf0, 0x46, 0xb5, 0x69, 0xe9, 0xa6, 0xbc, 0x7f, 0x41,
-	0xde, 0x6f, 0xd3, 0x16, 0x83, 0x68, 0xd3, 0x96, 0xbc, 0xb1, 0x28, 0x6e, 0x34, 0xf8, 0xaf, 0xaa,
-	0xf8, 0x21, 0x6f, 0xcd, 0xb7, 0xac, 0x96, 0x25, 0xae, 0xb3, 0x7f, 0xc9, 0xab, 0xab, 0x31, 0x5e,
-	0x37, 0xad, 0x76, 0x5b, 0x77, 0xda, 0xc4, 0x74, 0x5c, 0xfd, 0x4b, 0x31, 0x92, 0x6d, 0x6c, 0x1f,
-	0x12, 0x27, 0x45, 0xc8, 0xb2, 0x35, 0x62, 0xa7, 0x59, 0xea, 0x60, 0x1b, 0xb7, 0x5d, 0xa1, 0xcb,
-	0xb1, 0x42, 0x27, 0x1e, 0xaf, 0xca, 0x7f, 0x50, 0x60, 0xee, 0x26, 0x6d, 0xed, 0xd8, 0x04, 0x3b,
-	0x64, 0x9b, 0x1e, 0xd6, 0xc9, 0x83, 0x2e, 0xa1, 0x0e, 0xda, 0x81, 0x3c, 0xa6, 0x87, 0x0d, 0x8e,
-	0x5b, 0x50, 0x4a, 0xca, 0xea, 0xd4, 0x66, 0xa9, 0x12, 0x9d, 0x80, 0xca, 0x36, 0x3d, 0xfc, 0x2a,
-	0x93, 0xab, 0x8d, 0x7e, 0xf8, 0xaf, 0x95, 0x33, 0xf5, 0x49, 0x2c, 0x7f, 0xa3, 0x1b, 0x80, 0xb8,
-	0x81, 0x46, 0x93, 0x99, 0xd7, 0x2d, 0xb3, 0x71, 0x8f, 0x90, 0xc2, 0x08, 0xb7, 0xb6, 0x58, 0x91,
-	0xd1, 0x65, 0x39, 0xaa, 0xc8, 0x1c, 0x55, 0x76, 0x2c, 0xdd, 0xac, 0x9f, 0xe5, 0x4a, 0x3b, 0x52,
-	0x67, 0x97, 0x90, 0xad, 0xd9, 0xef, 0x3d, 0x7b, 0xbc, 0xd6, 0x77, 0xa8, 0xbc, 0x01, 0xf3, 0x7e,
-	0xa7, 0x69, 0xc7, 0x32, 0x29, 0x41, 0x8b, 0x30, 0x29, 0x00, 0x75, 0x8d, 0x3b, 0x3d, 0x5a, 0x9f,
-	0xe0, 0xbf, 0xf7, 0x34, 0x3f, 0xd1, 0x9a, 0xae, 0x79, 0x88, 0x1e, 0xe8, 0x5a, 0x36, 0xa2, 0x35,
-	0x5d, 0xf3, 0x11, 0x3d, 0x90, 0xbf, 0x9f, 0x37, 0xd1, 0x9e, 0x43, 0x3e, 0xa2, 0xdc, 0xe9, 0x74,
-	0xa2, 0x1f, 0x8d, 0xc0, 0x02, 0xd3, 0xe1, 0x0b, 0x70, 0xb7, 0x6b, 0x6a, 0xd4, 0xa5, 0xba, 0x09,
-	0x13, 0xb8, 0xd9, 0xb4, 0xba, 0xa6, 0xc3, 0x75, 0xf2, 0xb5, 0xc2, 0x3f, 0xfe, 0xb8, 0x3e, 0x2f,
-	0xbd, 0xdb, 0xd6, 0x34, 0x9b, 0x50, 0x7a, 0xdb, 0xb1, 0x75, 0xb3, 0x55, 0x77, 0x05, 0xd1, 0x12,
-	0xe4, 0xc5, 0x02, 0x65, 0x48, 0x8c, 0xd0, 0x4c, 0x7d, 0x52, 0x5c, 0xd8, 0xd3, 0xd0, 0x09, 0x8c,
-	0xe3, 0x36, 0xb7, 0x97, 0x2b, 0xe5, 0x12, 0xa9, 0xd6, 0x76, 0x59, 0xc4, 0x7e, 0xfb, 0xef, 0x95,
-	0xd5, 0x96, 0xee, 0xdc, 0xef, 0x1e, 0x54, 0x9a, 0x56, 0x5b, 0x6e, 0x2f, 0xf9, 0xbf, 0x75, 0xaa,
-	0x1d, 0x56, 0x9d, 0x93, 0x0e, 0xa1, 0x5c, 0x81, 0xfe, 0xe4, 0xd9, 0xe3, 0xb5, 0x69, 0x83, 0xb4,
-	0x70, 0xf3, 0xa4, 0xc1, 0x76, 0x2e, 0xfd, 0xf5, 0xb3, 0xc7, 0x6b, 0x4a, 0x5d, 0x02, 0xa2, 0x37,
-	0x61, 0xda, 0x17, 0xeb, 0xd1, 0xb4, 0x58, 0x4f, 0x35, 0xfb, 0x61, 0x66, 0xac, 0xc8, 0x11, 0x31,
-	0x9d, 0x86, 0x83, 0x5b, 0x85, 0x31, 0x16, 0x8b, 0xfa, 0x24, 0xbf, 0x70, 0x07, 0xb7, 0xb6, 0xa6,
-	0x59, 0x0e, 0xdc, 0x00, 0x94, 0x0b, 0x70, 0x3e, 0x18, 0x4d, 0x91, 0x83, 0xf2, 0x03, 0x11, 0x67,
-	0xb6, 0x4a, 0x0c, 0xbe, 0x0c, 0xdc, 0x38, 0x5f, 0x81, 0x71, 0xaa, 0xb7, 0x4c, 0xb9, 0x9e, 0x92,
-	0xc2, 0x2c, 0xe5, 0x7c, 0xe9, 0x1c, 0xf1, 0xa5, 0x73, 0x6b, 0x8a, 0x79, 0x23, 0xe5, 0x5c, 0x67,
-	0xbc, 0x90, 0xd2, 0x99, 0xbf, 0xe7, 0x00, 0xdd, 0xa4, 0xad, 0x5d, 0xdd, 0x30, 0x6a, 0x7a, 0x3f,
-	0xe5, 0xcc, 0x15, 0x62, 0x18, 0x99, 0x5c, 0xe1, 0x72, 0xc9, 0x09, 0x7f, 0x4f, 0x81, 0x69, 0xc7,
-	0x72, 0xb0, 0xd1, 0xc0, 0x94, 0x12, 0x87, 0xbe, 0xb8, 0xbc, 0x4f, 0x71, 0xd8, 0x6d, 0x8e, 0x8a,
-	0xca, 0x30, 0xd3, 0xdb, 0x22, 0x0d, 0x5d, 0xa3, 0x85, 0xd1, 0x52, 0x6e, 0x75, 0xb4, 0x3e, 0xe5,
-	0xee, 0xc7, 0x3d, 0x8d, 0xa2, 0xaf, 0x81, 0x2a, 0x18, 0x35, 0x28, 0x71, 0x1c, 0x83, 0xb0, 0x87,
-	0x5e, 0xe3, 0x9e, 0x81, 0x1d, 0xbe, 0x5c, 0xc6, 0xd2, 0x96, 0xcb, 0x05, 0xa1, 0x7c, 0xbb, 0xa7,
-	0xbb, 0x6b, 0x60, 0x87, 0x2d, 0x9d, 0x5b, 0x70, 0xbe, 0xf7, 0x1c, 0xf2, 0x6f, 0xf7, 0xf1, 0x34,
-	0x9b, 0x73, 0xee, 0x83, 0xd1, 0xbb, 0xe3, 0x65, 0x7e, 0x39, 0x5a, 0x79, 0x81, 0x3f, 0xa3, 0xfa,
-	0x49, 0x94, 0xc9, 0xfd, 0x5b, 0x3f, 0xb9, 0xdb, 0xf4, 0xb0, 0x97, 0xdc, 0x0a, 0x8c, 0x1d, 0x74,
-	0x4f, 0x32, 0xe4, 0x56, 0x88, 0x25, 0xa7, 0xf6, 0x6d, 0x10, 0x21, 0x6e, 0x74, 0x6c, 0xbd, 0x49,
-	0x0a, 0xb9, 0x14, 0x32, 0xf2, 0x11, 0x08, 0x5c, 0x67, 0x9f, 0xa9, 0xb0, 0xac, 0xf4, 0x23, 0xe3,
-	0xc9, 0x8a, 0xcb, 0x9a, 0x65, 0xe5, 0xc7, 0x0a, 0x2c, 0x70, 0x67, 0x7c, 0x59, 0x21, 0x84, 0x16,
-	0xc6, 0x5e, 0xd4, 0x4a, 0x9a, 0xe3, 0xf8, 0x9e, 0xc4, 0x12, 0x42, 0x59, 0x56, 0xfb, 0x2b, 0x6a,
-	0xc0, 0xac, 0xba, 0xab, 0xce, 0x9b, 0x55, 0x60, 0x59, 0x15, 0x61, 0xf7, 0x24, 0x55, 0x24, 0x4f,
-	0x26, 0xf5, 0x13, 0x85, 0x6f, 0xe6, 0x9b, 0x3c, 0x01, 0xc2, 0x1d, 0x4f, 0x62, 0xb1, 0xd6, 0xd6,
-	0xcd, 0xf4, 0xc4, 0x72, 0xb1, 0xe4, 0xc4, 0x86, 0xd2, 0x92, 0x0b, 0xa7, 0x25, 0xcb, 0x86, 0xba,
-	0x0c, 0xb3, 0xe4, 0xb8, 0x43, 0x9a, 0x4e, 0xa3, 0x83, 0x6d, 0x47, 0xc7, 0x06, 0xdf, 0x44, 0x93,
-	0xf5, 0x19, 0x71, 0x75, 0x5f, 0x5c, 0x94, 0xcc, 0xb9, 0x5f, 0xe5, 0x45, 0xb8, 0x10, 0x62, 0x28,
-	0xd9, 0xff, 0x2a, 0x07, 0xa5, 0xde, 0xbd, 0x9d, 0x5e, 0xb1, 0x34, 0xc4, 0x38, 0xec, 0xc0, 0xb8,
-	0x6e, 0x76, 0xba, 0xbd, 0x87, 0xd6, 0xe5, 0xd8, 0x72, 0x46, 0x3c, 0xf9, 0xb7, 0xf9, 0x41, 0x23,
-	0xd7, 0xb9, 0x54, 0x45, 0xd7, 0x61, 0xc2, 0xea, 0x3a, 0xdc, 0xca, 0xe8, 0xe0, 0x56, 0x5c, 0x5d,
-	0x74, 0x15, 0x46, 0x3d, 0x8b, 0x7e, 0x20, 0x1b, 0x5c, 0x91, 0x19, 0x30, 0xf1, 0x11, 0x2d, 0x8c,
-	0x27, 0x1b, 0xb8, 0x45, 0x1c, 0xfe, 0xc8, 0xe4, 0x1b, 0xd4, 0x35, 0xc0, 0x14, 0xfd, 0x27, 0xe0,
-	0x44, 0xe0, 0x04, 0xf4, 0xe6, 0xf0, 0x12, 0x5c, 0x4c, 0xc8, 0x93, 0xcc, 0xe6, 0x7f, 0x14, 0x28,
-	0xf7, 0xa4, 0xea, 0xc4, 0x20, 0x98, 0x92, 0xbe, 0x30, 0x1d, 0x4a, 0x3e, 0xdf, 0x01, 0x70, 0xac,
-	0x86, 0x2d, 0xc0, 0x4e, 0x93, 0xd3, 0xbc, 0x63, 0x49, 0x57, 0xfd, 0xd1, 0x18, 0x4d, 0x88, 0xc6,
-	0x65, 0xb8, 0x94, 0xc8, 0x53, 0xc6, 0xe3, 0x2f, 0xde, 0x78, 0xdc, 0x26, 0x0e, 0xdf, 0x44, 0xd7,
-	0x8f, 0x1d, 0x62, 0x9b, 0xd8, 0xd8, 0xbb, 0x36, 0x94, 0x78, 0x78, 0x6b, 0x88, 0x9c, 0xaf, 0x86,
-	0x40, 0x2b, 0x30, 0x45, 0x24, 0x38, 0xbb, 0x2b, 0x08, 0x82, 0x7b, 0x69, 0x4f, 0x8b, 0xa5, 0x18,
-	0xe5, 0xba, 0xa4, 0xf8, 0xfe, 0x08, 0x14, 0x7a, 0x72, 0x5f, 0xd7, 0x9d, 0xfb, 0x9a, 0x8d, 0x1f,
-	0x0e, 0x85, 0xd8, 0x32, 0x4f, 0x34, 0x16, 0x7a, 0x9c, 0x5a, 0x9e, 0xe5, 0x4e, 0x1a, 0xf2, 0x14,
-	0xa1, 0xa3, 0x2f, 0xb8, 0x08, 0xf5, 0x85, 0x6d, 0x09, 0x16, 0x23, 0xc2, 0x21, 0x83, 0xf5, 0x91,
-	0x02, 0xcb, 0xbd, 0xbb, 0x77, 0x3b, 0x1a, 0x76, 0xc8, 0x35, 0xe2, 0x60, 0xdd, 0x18, 0xce, 0xd6,
-	0xa8, 0xc3, 0xac, 0xbc, 0xa9, 0x09, 0x14, 0x79, 0x9c, 0xc7, 0x6e, 0x0f, 0xe1, 0x98, 0x74, 0x49,
-	0x6e, 0x8f, 0x99, 0xb6, 0xf7, 0xa2, 0x8f, 0x6b, 0x09, 0x8a, 0x71, 0x6c, 0x24, 0xe1, 0xdf, 0x85,
-	0x09, 0x5f, 0x37, 0xf1, 0x81, 0x41, 0xb4, 0x7e, 0x65, 0xea, 0x23, 0xac, 0xc6, 0x11, 0x2e, 0x28,
-	0x2e, 0xe5, 0x95, 0x10, 0xe5, 0xda, 0x48, 0x41, 0xf1, 0xd0, 0x5e, 0x87, 0xb3, 0xb8, 0xd9, 0x24,
-	0x1d, 0x47, 0x37, 0x5b, 0xe2, 0x2c, 0x13, 0xc4, 0x27, 0xb9, 0xdc, 0x4b, 0xbd, 0x7b, 0x7c, 0x49,
-	0x53, 0x51, 0xe7, 0xbb, 0x4e, 0x94, 0x5f, 0x09, 0x71, 0xea, 0x39, 0x2c, 0x38, 0x6d, 0x8d, 0x14,
-	0x94, 0xf2, 0x23, 0x05, 0x2e, 0x07, 0xc4, 0xb6, 0xfd, 0x66, 0x87, 0x92, 0xd0, 0xcf, 0xc5, 0x31,
-	0x0b, 0xb3, 0xf2, 0xe6, 0x69, 0x15, 0x3e, 0x93, 0xe6, 0x6c, 0x3f, 0x5f, 0xa5, 0x80, 0xe8, 0x5d,
-	0xea, 0x56, 0x49, 0x43, 0xa1, 0xb4, 0x09, 0x0b, 0xd8, 0x30, 0xac, 0x87, 0x8d, 0x2e, 0xf5, 0x55,
-	0x83, 0x92, 0xd7, 0x1c, 0xbf, 0xd9, 0xf7, 0x81, 0xdd, 0x8a, 0x3d, 0x97, 0xc2, 0x0e, 0x4b, 0x5a,
-	0x7f, 0x55, 0x60, 0x2d, 0x2e, 0x02, 0xc3, 0x3e, 0x9f, 0x5e, 0x83, 0x85, 0x7e, 0xce, 0x3c, 0xed,
-	0x20, 0x49, 0x70, 0x1e, 0x47, 0x38, 0xe2, 0x63, 0xb8, 0x0e, 0xaf, 0x66, 0xf2, 0x5d, 0x72, 0xfd,
-	0xbd, 0x02, 0x9f, 0x0d, 0xc8, 0xef, 0x99, 0x0e, 0xb1, 0xdb, 0x44, 0xd3, 0xb1, 0x7d, 0x72, 0x8d,
-	0x98, 0x56, 0x7b, 0x28, 0x44, 0xd7, 0x01, 0xe9, 0x1e, 0xa0, 0x86, 0xc6, 0x90, 0xe4, 0x73, 0xfa,
-	0x9c, 0x1e, 0x74, 0xc1, 0x47, 0x71, 0x0d, 0x56, 0xd3, 0x5d, 0x96, 0xfc, 0x7e, 0x33, 0xe2, 0xc9,
-	0xf8, 0x4d, 0x6c, 0xe2, 0x16, 0xd9, 0x27, 0x76, 0x5b, 0xa7, 0x54, 0xb7, 0x4c, 0x3a, 0xac, 0x93,
-	0xc7, 0x26, 0x47, 0xd6, 0x21, 0x69, 0x60, 0xc3, 0xe0, 0x25, 0x46, 0xbe, 0x9e, 0x17, 0x57, 0xb6,
-	0x0d, 0x03, 0xed, 0x42, 0x9e, 0x57, 0x20, 0xec, 0xb7, 0x3c, 0x7c, 0x2e, 0x25, 0x14, 0x20, 0x84,
-	0xd2, 0x1b, 0x36, 0xee, 0x95, 0x1f, 0x93, 0xac, 0xfc, 0x60, 0xaa, 0xe8, 0x1a, 0x4c, 0x3a, 0x56,
-	0xa3, 0xc5, 0xee, 0xc9, 0x8a, 0x70, 0x00, 0x33, 0x13, 0x8e, 0xc5, 0x7f, 0xfa, 0xe2, 0xfa, 0x8a,
-	0xa7, 0xfc, 0x88, 0x08, 0x95, 0x1b, 0xd1, 0x9c, 0xe7, 0x99, 0x27, 0xc4, 0xea, 0xe4, 0xc1, 0xb6,
-	0xe3, 0x0c, 0xed, 0x29, 0x76, 0x8e, 0xbf, 0x5a, 0x91, 0x06, 0x7b, 0x21, 0x11, 0x67, 0xba, 0x8c,
-	0xea, 0x6c, 0xd3, 0xed, 0xe5, 0xdd, 0x61, 0x07, 0x3b, 0xaa, 0xc2, 0xbc, 0x5f, 0xd4, 0x26, 0x6d,
-	0xeb, 0x48, 0x44, 0x39, 0x5f, 0x3f, 0xe7, 0x91, 0xae, 0xf3, 0x1b, 0x1e, 0xdb, 0xec, 0x45, 0x46,
-	0xda, 0x1e, 0xf3, 0xda, 0xae, 0xe9, 0x5a, 0xd0, 0xb6, 0x14, 0x95, 0xb6, 0xc7, 0xbd, 0xb6, 0xb9,
-	0xb4, 0xb4, 0xfd, 0x06, 0x14, 0xa4, 0x42, 0x7f, 0x1b, 0xbb, 0x10, 0x13, 0x5c, 0x69, 0x41, 0xdc,
-	0xef, 0x6f, 0x4b, 0x81, 0xf4, 0x16, 0x2c, 0x45, 0x2a, 0x4a, 0xc0, 0x49, 0xae, 0x5b, 0x08, 0xeb,
-	0x0a, 0x5c, 0x5f, 0x46, 0x2f, 0xc2, 0x4a, 0x6c, 0xaa, 0x64, 0x3a, 0xdf, 0xe5, 0x6f, 0x5b, 0xa2,
-	0x57, 0xb8, 0x2f, 0xba, 0xbc, 0x6e, 0x1a, 0xaf, 0xc2, 0x84, 0xec, 0xfb, 0xca, 0x16, 0xe7, 0x4a,
-	0xdc, 0x02, 0x93, 0x8a, 0xee, 0xe2, 0x92, 0x5a, 0x65, 0x95, 0x17, 0x7b, 0x01, 0xdb, 0x3e, 0x5c,
-	0xf1, 0x6c, 0x1a, 0x0e, 0x6e, 0xc0, 0xb6, 0xc4, 0x7d, 0xa4, 0x70, 0xe0, 0x3a, 0xf9, 0x26, 0x7f,
-	0xfd, 0xf4, 0x01, 0x5f, 0x81, 0x71, 0x07, 0xdb, 0x2d, 0x92, 0xde, 0xe9, 0x94, 0x72, 0xbc, 0x53,
-	0x66, 0x75, 0xed, 0xa6, 0x68, 0xdb, 0x26, 0x77, 0xca, 0xb8, 0x5c, 0xb0, 0xaa, 0xce, 0x85, 0xaa,
-	0x6a, 0xd1, 0xda, 0x11, 0xf6, 0x25, 0x93, 0x80, 0xb3, 0x6e, 0x2d, 0xad, 0x84, 0x6f, 0xd2, 0xd3,
-	0x53, 0xd9, 0x84, 0x09, 0xe1, 0x22, 0x2d, 0x8c, 0xb0, 0x25, 0x96, 0xd4, 0xe7, 0x95, 0x82, 0x7e,
-	0x5f, 0x45, 0x2d, 0x1b, 0x74, 0x47, 0x3a, 0xfb, 0x2d, 0xb1, 0x14, 0x78, 0x0f, 0x32, 0xc2, 0x57,
-	0x19, 0x44, 0x25, 0x63, 0x10, 0x2f, 0xc2, 0xb4, 0x27, 0x88, 0xd2, 0xe1, 0xfa, 0x54, 0x3f, 0x8a,
-	0xae, 0x6b, 0x42, 0x5e, 0xba, 0x16, 0x44, 0x97, 0xae, 0xfd, 0x59, 0x54, 0x9d, 0x3b, 0x7c, 0x55,
-	0xc9, 0xbb, 0x77, 0x38, 0xa5, 0xd3, 0x3b, 0x18, 0xc8, 0xf2, 0x48, 0x30, 0xcb, 0xe8, 0x0d, 0x00,
-	0x93, 0x3c, 0x6c, 0xc8, 0x1c, 0xe5, 0x52, 0xcc, 0xe6, 0x4d, 0xf2, 0x50, 0xb8, 0xe4, 0xe7, 0x25,
-	0x4a, 0xea, 0x48, 0xcf, 0x25, 0xb9, 0x9f, 0x2b, 0x9c, 0xfa, 0x0d, 0xeb, 0x48, 0x6c, 0x43, 0xf7,
-	0x25, 0x54, 0x10, 0x7b, 0x1d, 0xf2, 0xb8, 0xeb, 0xdc, 0xb7, 0x6c, 0xdd, 0x39, 0x49, 0xe5, 0xd6,
-	0x17, 0x45, 0x6f, 0xc2, 0xb8, 0x78, 0x3e, 0xcb, 0x69, 0x45, 0x31, 0xf9, 0x15, 0xc1, 0x6d, 0x87,
-	0x08, 0x1d, 0x77, 0x2e, 0xe3, 0x5a, 0x2b, 0xbf, 0x0c, 0x6a, 0x94, 0x8b, 0x92, 0xc1, 0x9f, 0x66,
-	0xf8, 0x86, 0xbd, 0x61, 0x1d, 0x89, 0x27, 0xd8, 0x2e, 0x21, 0xf4, 0xd3, 0xfa, 0x9f, 0x78, 0xe0,
-	0xdc, 0x85, 0x0b, 0x58, 0xd3, 0x1a, 0xf7, 0x08, 0x69, 0x78, 0x4e, 0x93, 0x7b, 0x06, 0xce, 0x30,
-	0xb0, 0x10, 0x44, 0xe7, 0xb0, 0xa6, 0xed, 0x12, 0xd2, 0x9b, 0x34, 0xed, 0x1a, 0xd8, 0x41, 0xdf,
-	0x00, 0x55, 0x3c, 0xc1, 0x23, 0x2d, 0x8f, 0x66, 0xb3, 0x7c, 0x5e, 0x98, 0x08, 0x19, 0x0f, 0xfb,
-	0xcc, 0x4e, 0x29, 0x6e, 0x79, 0xec, 0x14, 0x3e, 0xd7, 0x74, 0x2d, 0xde, 0xe7, 0x9e, 0xe5, 0xf1,
-	0xd3, 0xf9, 0xec, 0x1a, 0x6f, 0x42, 0xd1, 0xf5, 0x39, 0xba, 0xe7, 0xce, 0x8f, 0xc9, 0x0c, 0x00,
-	0xaa, 0x70, 0xfd, 0x76, 0x44, 0xef, 0x1d, 0xe9, 0x70, 0xd1, 0xc3, 0x20, 0x06, 0x67, 0x32, 0x1b,
-	0xce, 0x72, 0x8f, 0x48, 0x24, 0x94, 0x09, 0xa5, 0x78, 0x3e, 0x36, 0x76, 0x74, 0x8b, 0x16, 0xf2,
-	0x1c, 0x29, 0x76, 0x54, 0xb8, 0x4b, 0x48, 0x9d, 0x09, 0x4a, 0xc0, 0x97, 0xa3, 0x89, 0x71, 0x11,
-	0x8a, 0x1c, 0xb8, 0x94, 0x48, 0x4d, 0x42, 0xc2, 0x40, 0x90, 0x2b, 0xb1, 0x1c, 0x25, 0x2a, 0x86,
-	0x65, 0x97, 0x65, 0xb8, 0x25, 0xcf, 0x82, 0x39, 0x95, 0x2d, 0x98, 0x8b, 0x82, 0x5b, 0x2d, 0xd0,
-	0x56, 0x67, 0x81, 0x6c, 0x41, 0xc9, 0x43, 0x2c, 0x1a, 0x65, 0x3a, 0x1b, 0xca, 0xcb, 0x3d, 0x3a,
-	0x51, 0x40, 0x06, 0xac, 0xc4, 0x72, 0x91, 0xd1, 0x9b, 0x19, 0x28, 0x7a, 0x4b, 0x91, 0xa4, 0x64,
-	0xe4, 0x6c, 0x28, 0x27, 0xd1, 0x92, 0x80, 0xb3, 0x03, 0x01, 0x16, 0xe3, 0xf8, 0x49, 0x4c, 0xcf,
-	0x1e, 0x0b, 0xd7, 0x94, 0x3c, 0x90, 0x2f, 0x0d, 0xb4, 0xc7, 0x76, 0x02, 0x55, 0x67, 0xc4, 0x1e,
-	0x8b, 0xc1, 0x39, 0x3b, 0xe8, 0x1e, 0x8b, 0x84, 0x7a, 0x07, 0xca, 0x94, 0x38, 0x02, 0xa7, 0x0f,
-	0xe0, 0x89, 0xe2, 0x81, 0xde, 0xa1, 0x85, 0x73, 0xfc, 0x89, 0x5e, 0xa4, 0xc4, 0x61, 0x76, 0x02,
-	0xed, 0x67, 0x5e, 0x30, 0xea, 0x1d, 0x8a, 0x6e, 0xc1, 0x2b, 0x5d, 0x33, 0x83, 0x35, 0xc4, 0xdf,
-	0xbc, 0x4b, 0x5c, 0x36, 0xc1, 0x5e, 0xe8, 0x58, 0x13, 0xb5, 0x5b, 0xe0, 0xdc, 0x92, 0x87, 0xda,
-	0x77, 0xdc, 0x7b, 0x3b, 0x86, 0x45, 0x9f, 0xd3, 0xa1, 0x9c, 0x74, 0xa8, 0x85, 0x9c, 0x5b, 0xea,
-	0x95, 0x05, 0x5e, 0x07, 0xa4, 0x77, 0xbf, 0xec, 0x15, 0x0d, 0xe2, 0xf5, 0x7a, 0x9f, 0x7f, 0x22,
-	0xf2, 0x1c, 0x8a, 0x06, 0xf1, 0xad, 0x49, 0x5a, 0xd1, 0x20, 0xe0, 0xdc, 0xa2, 0x41, 0xe8, 0x6c,
-	0x9d, 0xf5, 0x13, 0x28, 0x28, 0xe5, 0x92, 0x5b, 0x36, 0xf8, 0x9d, 0xf4, 0xf4, 0xdd, 0x7e, 0x26,
-	0x86, 0x65, 0xff, 0x3f, 0x24, 0x82, 0x59, 0x10, 0xa3, 0xae, 0x28, 0xff, 0x37, 0xff, 0xbb, 0x0c,
-	0xb9, 0x9b, 0xb4, 0x85, 0xee, 0x41, 0xbe, 0x77, 0xd4, 0xa3, 0x57, 0x63, 0xeb, 0xac, 0xf0, 0xc7,
-	0x38, 0xea, 0xe7, 0xb3, 0x09, 0xcb, 0x6f, 0x43, 0x7a, 0x38, 0x35, 0x5d, 0xcb, 0x80, 0xd3, 0xff,
-	0x16, 0x26, 0x03, 0x8e, 0xf7, 0x1b, 0x14, 0x03, 0xa6, 0x3c, 0x9f, 0x45, 0xa0, 0xf5, 0x24, 0xe5,
-	0xd0, 0xc7, 0x28, 0x6a, 0x25, 0xab, 0xb8, 0x07, 0xad, 0xff, 0xdd, 0x43, 0x32, 0x5a, 0xe8, 0x93,
-	0x8c, 0x64, 0xb4, 0xf0, 0xe7, 0x14, 0xa8, 0x09, 0x93, 0xee, 0x14, 0x1e, 0xad, 0x25, 0xe8, 0x06,
-	0xbe, 0xb7, 0x50, 0x5f, 0xcd, 0x24, 0xeb, 0x07, 0xd9, 0xa6, 0x87, 0xe9, 0x20, 0x9e, 0xb9, 0x7f,
-	0x2a, 0x88, 0x77, 0xcc, 0x8c, 0x2c, 0x98, 0xf6, 0x0e, 0x60, 0x51, 0x52, 0x24, 0x22, 0x66, 0xd1,
-	0x6a, 0x35, 0xb3, 0xbc, 0x04, 0x7c, 0x9f, 0x6d, 0xd5, 0xc8, 0x71, 0x21, 0xfa, 0x62, 0xaa, 0xad,
-	0x98, 0x49, 0xb0, 0xfa, 0xa5, 0x53, 0x68, 0x4a, 0x7f, 0x7e, 0xc4, 0x5e, 0xae, 0x63, 0x06, 0x76,
-	0x68, 0x2b, 0xd5, 0x6e, 0xec, 0x34, 0x53, 0xfd, 0xf2, 0xa9, 0x74, 0x43, 0x5e, 0x85, 0x67, 0x6c,
-	0x19, 0xbc, 0x8a, 0x9d, 0x29, 0x66, 0xf0, 0x2a, 0x7e, 0xa8, 0x87, 0xba, 0x30, 0xeb, 0x9f, 0x60,
-	0xa1, 0x2b, 0xa9, 0xe6, 0x02, 0xb3, 0x3f, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x9e, 0x02, 0x73,
-	0x11, 0xd3, 0x24, 0xf4, 0x85, 0x54, 0x53, 0x51, 0xb3, 0x34, 0xf5, 0xf5, 0x41, 0xd5, 0xa4, 0x1b,
-	0x3f, 0x0c, 0xb8, 0x21, 0x07, 0x40, 0x99, 0xdd, 0xf0, 0x4f, 0xb8, 0x32, 0xbb, 0x11, 0x98, 0x33,
-	0x95, 0x73, 0x3f, 0x18, 0x51, 0xd0, 0x4f, 0x15, 0x58, 0x4a, 0x18, 0xdc, 0xa0, 0xb7, 0x32, 0x1a,
-	0x8f, 0x9e, 0x4e, 0xa9, 0x5f, 0x39, 0xad, 0x7a, 0x68, 0x93, 0x07, 0x67, 0x2f, 0x19, 0x36, 0x79,
-	0xcc, 0x7c, 0x29, 0xc3, 0x26, 0x8f, 0x1b, 0xf4, 0xa0, 0x47, 0x0a, 0x94, 0xd2, 0x26, 0x25, 0xa8,
-	0x36, 0x28, 0xe9, 0x88, 0x4d, 0xbf, 0xf3, 0xa9, 0x6c, 0x48, 0x6f, 0x7f, 0xa1, 0xc0, 0x72, 0xe2,
-	0xd0, 0x03, 0x5d, 0xcd, 0x08, 0x13, 0x37, 0xe1, 0x51, 0xdf, 0x3e, 0xbd, 0x01, 0xe9, 0xe4, 0x07,
-	0x0a, 0x5c, 0x88, 0x99, 0x20, 0xa0, 0xf4, 0x4c, 0xc5, 0x0d, 0x68, 0xd4, 0xad, 0xd3, 0xa8, 0x4a,
-	0x97, 0xbe, 0xaf, 0xc0, 0x7c, 0x54, 0x0b, 0x1c, 0xbd, 0x9e, 0xd1, 0x68, 0x60, 0xbc, 0xa1, 0xbe,
-	0x31, 0xb0, 0x9e, 0xf4, 0xc4, 0x86, 0x19, 0x5f, 0x33, 0x1c, 0x55, 0x53, 0x4b, 0x27, 0x7f, 0x87,
-	0x5a, 0xbd, 0x92, 0x5d, 0xa1, 0x8f, 0xe9, 0x6b, 0x84, 0x27, 0x62, 0x46, 0xb5, 0xe3, 0x13, 0x31,
-	0x23, 0x7b, 0xec, 0x0c, 0xd3, 0xd7, 0x06, 0x4e, 0xc4, 0x8c, 0xea, 0xc4, 0x27, 0x62, 0x46, 0x76,
-	0xc3, 0xd9, 0x21, 0xe4, 0x6f, 0x3d, 0xa3, 0xcc, 0x36, 0x68, 0x96, 0x43, 0x28, 0xba, 0xaf, 0xcd,
-	0x60, 0xfd, 0x6d, 0xe5, 0x44, 0xd8, 0xc8, 0xfe, 0x77, 0x22, 0x6c, 0x74, 0xcf, 0x9a, 0x9f, 0x7d,
-	0x11, 0x6d, 0xdf, 0xc4, 0x43, 0x27, 0xbe, 0xc1, 0x9d, 0x78, 0xe8, 0x24, 0x74, 0x97, 0xd1, 0x31,
-	0xbc, 0x14, 0x68, 0xdb, 0xa2, 0x24, 0x32, 0xd1, 0x5d, 0x68, 0x75, 0x73, 0x10, 0x95, 0xfe, 0x12,
-	0xf3, 0xbd, 0x59, 0x27, 0x2e, 0xb1, 0xa8, 0xde, 0x71, 0xe2, 0x12, 0x8b, 0x7c, 0x69, 0x67, 0xb9,
-	0xf6, 0xbf, 0x30, 0xa3, 0x14, 0x1b, 0xe1, 0x97, 0x7b, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x6d,
-	0x1e, 0x64, 0xef, 0x4b, 0x62, 0x5a, 0x90, 0x23, 0x5e, 0x78, 0xd3, 0x82, 0x1c, 0xf5, 0x0e, 0x2a,
-	0x6a, 0x0a, 0x0b, 0xa6, 0x7d, 0xd8, 0x49, 0xaf, 0x02, 0x51, 0xc0, 0xd5, 0xcc, 0xf2, 0x02, 0x55,
-	0x1d, 0xfb, 0xee, 0xb3, 0xc7, 0x6b, 0x4a, 0x8d, 0x7c, 0xf8, 0xa4, 0xa8, 0x7c, 0xfc, 0xa4, 0xa8,
-	0x7c, 0xf2, 0xa4, 0xa8, 0x7c, 0xf0, 0xb4, 0x78, 0xe6, 0xe3, 0xa7, 0xc5, 0x33, 0xff, 0x7c, 0x5a,
-	0x3c, 0x03, 0x8b, 0xba, 0x15, 0x63, 0x73, 0x5f, 0x79, 0xb7, 0xe2, 0xf9, 0x4a, 0xab, 0x2f, 0xb4,
-	0xae, 0x5b, 0x9e, 0x5f, 0xd5, 0xe3, 0xde, 0x1f, 0xb7, 0x1c, 0x8c, 0xf3, 0xbf, 0x68, 0x79, 0xed,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x40, 0x23, 0x7a, 0x49, 0x34, 0x00, 0x00,
+func (m *MsgAcceptPaymentRequest) Reset()         { *m = MsgAcceptPaymentRequest{} }
+func (m *MsgAcceptPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPaymentRequest) ProtoMessage()    {}
+func (*MsgAcceptPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{62}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateAsk creates an ask order (to sell something you own).
-	CreateAsk(ctx context.Context, in *MsgCreateAskRequest, opts ...grpc.CallOption) (*MsgCreateAskResponse, error)
-	// CreateBid creates a bid order (to buy something you want).
-	CreateBid(ctx context.Context, in *MsgCreateBidRequest, opts ...grpc.CallOption) (*MsgCreateBidResponse, error)
-	// CommitFunds marks funds in an account as manageable by a market.
-	CommitFunds(ctx context.Context, in *MsgCommitFundsRequest, opts ...grpc.CallOption) (*MsgCommitFundsResponse, error)
-	// CancelOrder cancels an order.
-	CancelOrder(ctx context.Context, in *MsgCancelOrderRequest, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
-	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
-	FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error)
-	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
-	FillAsks(ctx context.Context, in *MsgFillAsksRequest, opts ...grpc.CallOption) (*MsgFillAsksResponse, error)
-	// MarketSettle is a market endpoint to trigger the settlement of orders.
-	MarketSettle(ctx context.Context, in *MsgMarketSettleRequest, opts ...grpc.CallOption) (*MsgMarketSettleResponse, error)
-	// MarketCommitmentSettle is a market endpoint to transfer committed funds.
-	MarketCommitmentSettle(ctx context.Context, in *MsgMarketCommitmentSettleRequest, opts ...grpc.CallOption) (*MsgMarketCommitmentSettleResponse, error)
-	// MarketReleaseCommitments is a market endpoint return control of funds back to the account owner(s).
-	MarketReleaseCommitments(ctx context.Context, in *MsgMarketReleaseCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketReleaseCommitmentsResponse, error)
-	// MarketSetOrderExternalID updates an order's external id field.
-	MarketSetOrderExternalID(ctx context.Context, in *MsgMarketSetOrderExternalIDRequest, opts ...grpc.CallOption) (*MsgMarketSetOrderExternalIDResponse, error)
-	// MarketWithdraw is a market endpoint to withdraw fees that have been collected.
-	MarketWithdraw(ctx context.Context, in *MsgMarketWithdrawRequest, opts ...grpc.CallOption) (*MsgMarketWithdrawResponse, error)
-	// MarketUpdateDetails is a market endpoint to update its details.
-	MarketUpdateDetails(ctx context.Context, in *MsgMarketUpdateDetailsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateDetailsResponse, error)
-	// MarketUpdateEnabled is a market endpoint to update whether its accepting orders.
-	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-	MarketUpdateEnabled(ctx context.Context, in *MsgMarketUpdateEnabledRequest, opts ...grpc.CallOption) (*MsgMarketUpdateEnabledResponse, error)
-	// MarketUpdateAcceptingOrders is a market endpoint to update whether its accepting orders.
-	MarketUpdateAcceptingOrders(ctx context.Context, in *MsgMarketUpdateAcceptingOrdersRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingOrdersResponse, error)
-	// MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
-	MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpdateUserSettleRequest, opts ...grpc.CallOption) (*MsgMarketUpdateUserSettleResponse, error)
-	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
-	MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
-	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
-	MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error)
-	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
-	MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error)
-	// MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
-	MarketManageReqAttrs(ctx context.Context, in *MsgMarketManageReqAttrsRequest, opts ...grpc.CallOption) (*MsgMarketManageReqAttrsResponse, error)
-	// CreatePayment creates a payment to facilitate a trade between two accounts.
-	CreatePayment(ctx context.Context, in *MsgCreatePaymentRequest, opts ...grpc.CallOption) (*MsgCreatePaymentResponse, error)
-	// AcceptPayment is used by a target to accept a payment.
-	AcceptPayment(ctx context.Context, in *MsgAcceptPaymentRequest, opts ...grpc.CallOption) (*MsgAcceptPaymentResponse, error)
-	// RejectPayment can be used by a target to reject a payment.
-	RejectPayment(ctx context.Context, in *MsgRejectPaymentRequest, opts ...grpc.CallOption) (*MsgRejectPaymentResponse, error)
-	// RejectPayments can be used by a target to reject all payments from one or more sources.
-	RejectPayments(ctx context.Context, in *MsgRejectPaymentsRequest, opts ...grpc.CallOption) (*MsgRejectPaymentsResponse, error)
-	// CancelPayments can be used by a source to cancel one or more payments.
-	CancelPayments(ctx context.Context, in *MsgCancelPaymentsRequest, opts ...grpc.CallOption) (*MsgCancelPaymentsResponse, error)
-	// ChangePaymentTarget can be used by a source to change the target in one of their payments.
-	ChangePaymentTarget(ctx context.Context, in *MsgChangePaymentTargetRequest, opts ...grpc.CallOption) (*MsgChangePaymentTargetResponse, error)
-	// GovCreateMarket is a governance proposal endpoint for creating a market.
-	GovCreateMarket(ctx context.Context, in *MsgGovCreateMarketRequest, opts ...grpc.CallOption) (*MsgGovCreateMarketResponse, error)
-	// GovManageFees is a governance proposal endpoint for updating a market's fees.
-	GovManageFees(ctx context.Context, in *MsgGovManageFeesRequest, opts ...grpc.CallOption) (*MsgGovManageFeesResponse, error)
-	// GovCloseMarket is a governance proposal endpoint that will disable order and commitment creation,
-	// cancel all orders, and release all commitments.
-	GovCloseMarket(ctx context.Context, in *MsgGovCloseMarketRequest, opts ...grpc.CallOption) (*MsgGovCloseMarketResponse, error)
-	// GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
-	// Deprecated: Use UpdateParams instead.
-	GovUpdateParams(ctx context.Context, in *MsgGovUpdateParamsRequest, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the exchange module's params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgAcceptPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAcceptPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgAcceptPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPaymentRequest.Merge(m, src)
 }
-
-func (c *msgClient) CreateAsk(ctx context.Context, in *MsgCreateAskRequest, opts ...grpc.CallOption) (*MsgCreateAskResponse, error) {
-	out := new(MsgCreateAskResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreateAsk", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAcceptPaymentRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) CreateBid(ctx context.Context, in *MsgCreateBidRequest, opts ...grpc.CallOption) (*MsgCreateBidResponse, error) {
-	out := new(MsgCreateBidResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreateBid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAcceptPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPaymentRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) CommitFunds(ctx context.Context, in *MsgCommitFundsRequest, opts ...grpc.CallOption) (*MsgCommitFundsResponse, error) {
-	out := new(MsgCommitFundsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CommitFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgAcceptPaymentRequest proto.InternalMessageInfo
 
-func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrderRequest, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
-	out := new(MsgCancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptPaymentRequest) GetPayment() Payment {
+	if m != nil {
+		return m.Payment
 	}
-	return out, nil
+	return Payment{}
 }
 
-func (c *msgClient) FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error) {
-	out := new(MsgFillBidsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/FillBids", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgAcceptPaymentResponse is a response message for the AcceptPayment endpoint.
+type MsgAcceptPaymentResponse struct {
 }
 
-func (c *msgClient) FillAsks(ctx context.Context, in *MsgFillAsksRequest, opts ...grpc.CallOption) (*MsgFillAsksResponse, error) {
-	out := new(MsgFillAsksResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/FillAsks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAcceptPaymentResponse) Reset()         { *m = MsgAcceptPaymentResponse{} }
+func (m *MsgAcceptPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPaymentResponse) ProtoMessage()    {}
+func (*MsgAcceptPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{63}
 }
-
-func (c *msgClient) MarketSettle(ctx context.Context, in *MsgMarketSettleRequest, opts ...grpc.CallOption) (*MsgMarketSettleResponse, error) {
-	out := new(MsgMarketSettleResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketSettle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) MarketCommitmentSettle(ctx context.Context, in *MsgMarketCommitmentSettleRequest, opts ...grpc.CallOption) (*MsgMarketCommitmentSettleResponse, error) {
-	out := new(MsgMarketCommitmentSettleResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketCommitmentSettle", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAcceptPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPaymentResponse.Merge(m, src)
 }
-
-func (c *msgClient) MarketReleaseCommitments(ctx context.Context, in *MsgMarketReleaseCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketReleaseCommitmentsResponse, error) {
-	out := new(MsgMarketReleaseCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketReleaseCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAcceptPaymentResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) MarketSetOrderExternalID(ctx context.Context, in *MsgMarketSetOrderExternalIDRequest, opts ...grpc.CallOption) (*MsgMarketSetOrderExternalIDResponse, error) {
-	out := new(MsgMarketSetOrderExternalIDResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketSetOrderExternalID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgAcceptPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPaymentResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) MarketWithdraw(ctx context.Context, in *MsgMarketWithdrawRequest, opts ...grpc.CallOption) (*MsgMarketWithdrawResponse, error) {
-	out := new(MsgMarketWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketWithdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgAcceptPaymentResponse proto.InternalMessageInfo
 
-func (c *msgClient) MarketUpdateDetails(ctx context.Context, in *MsgMarketUpdateDetailsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateDetailsResponse, error) {
-	out := new(MsgMarketUpdateDetailsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectPaymentRequest is a request message for the RejectPayment endpoint.
+type MsgRejectPaymentRequest struct {
+	// target is the target account of the payment to reject.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// source is the source account of the payment to reject.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id is the external id of the payment to reject.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-// Deprecated: Do not use.
-func (c *msgClient) MarketUpdateEnabled(ctx context.Context, in *MsgMarketUpdateEnabledRequest, opts ...grpc.CallOption) (*MsgMarketUpdateEnabledResponse, error) {
-	out := new(MsgMarketUpdateEnabledResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateEnabled", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectPaymentRequest) Reset()         { *m = MsgRejectPaymentRequest{} }
+func (m *MsgRejectPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectPaymentRequest) ProtoMessage()    {}
+func (*MsgRejectPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{64}
 }
-
-func (c *msgClient) MarketUpdateAcceptingOrders(ctx context.Context, in *MsgMarketUpdateAcceptingOrdersRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingOrdersResponse, error) {
-	out := new(MsgMarketUpdateAcceptingOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAcceptingOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpdateUserSettleRequest, opts ...grpc.CallOption) (*MsgMarketUpdateUserSettleResponse, error) {
-	out := new(MsgMarketUpdateUserSettleResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateUserSettle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
-	out := new(MsgMarketUpdateAcceptingCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAcceptingCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectPaymentRequest.Merge(m, src)
 }
-
-func (c *msgClient) MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
-	out := new(MsgMarketUpdateIntermediaryDenomResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateIntermediaryDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectPaymentRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error) {
-	out := new(MsgMarketManagePermissionsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketManagePermissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRejectPaymentRequest proto.InternalMessageInfo
+
+func (m *MsgRejectPaymentRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MarketManageReqAttrs(ctx context.Context, in *MsgMarketManageReqAttrsRequest, opts ...grpc.CallOption) (*MsgMarketManageReqAttrsResponse, error) {
-	out := new(MsgMarketManageReqAttrsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketManageReqAttrs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreatePayment(ctx context.Context, in *MsgCreatePaymentRequest, opts ...grpc.CallOption) (*MsgCreatePaymentResponse, error) {
-	out := new(MsgCreatePaymentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreatePayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AcceptPayment(ctx context.Context, in *MsgAcceptPaymentRequest, opts ...grpc.CallOption) (*MsgAcceptPaymentResponse, error) {
-	out := new(MsgAcceptPaymentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/AcceptPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectPaymentResponse is a response message for the RejectPayment endpoint.
+type MsgRejectPaymentResponse struct {
 }
 
-func (c *msgClient) RejectPayment(ctx context.Context, in *MsgRejectPaymentRequest, opts ...grpc.CallOption) (*MsgRejectPaymentResponse, error) {
-	out := new(MsgRejectPaymentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/RejectPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentResponse) Reset()         { *m = MsgRejectPaymentResponse{} }
+func (m *MsgRejectPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectPaymentResponse) ProtoMessage()    {}
+func (*MsgRejectPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{65}
+}
+func (m *MsgRejectPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectPaymentResponse.Merge(m, src)
+}
+func (m *MsgRejectPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectPaymentResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RejectPayments(ctx context.Context, in *MsgRejectPaymentsRequest, opts ...grpc.CallOption) (*MsgRejectPaymentsResponse, error) {
-	out := new(MsgRejectPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/RejectPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRejectPaymentResponse proto.InternalMessageInfo
+
+// MsgRejectPaymentsRequest is a request message for the RejectPayments endpoint.
+type MsgRejectPaymentsRequest struct {
+	// target is the account that wishes to reject some payments.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// sources is the source accounts of the payments to reject.
+	Sources []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func (c *msgClient) CancelPayments(ctx context.Context, in *MsgCancelPaymentsRequest, opts ...grpc.CallOption) (*MsgCancelPaymentsResponse, error) {
-	out := new(MsgCancelPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CancelPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentsRequest) Reset()         { *m = MsgRejectPaymentsRequest{} }
+func (m *MsgRejectPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectPaymentsRequest) ProtoMessage()    {}
+func (*MsgRejectPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{66}
+}
+func (m *MsgRejectPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRejectPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRejectPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectPaymentsRequest.Merge(m, src)
+}
+func (m *MsgRejectPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRejectPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectPaymentsRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) ChangePaymentTarget(ctx context.Context, in *MsgChangePaymentTargetRequest, opts ...grpc.CallOption) (*MsgChangePaymentTargetResponse, error) {
-	out := new(MsgChangePaymentTargetResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/ChangePaymentTarget", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRejectPaymentsRequest proto.InternalMessageInfo
+
+func (m *MsgRejectPaymentsRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) GovCreateMarket(ctx context.Context, in *MsgGovCreateMarketRequest, opts ...grpc.CallOption) (*MsgGovCreateMarketResponse, error) {
-	out := new(MsgGovCreateMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovCreateMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentsRequest) GetSources() []string {
+	if m != nil {
+		return m.Sources
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) GovManageFees(ctx context.Context, in *MsgGovManageFeesRequest, opts ...grpc.CallOption) (*MsgGovManageFeesResponse, error) {
-	out := new(MsgGovManageFeesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovManageFees", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgRejectPaymentsResponse is a response message for the RejectPayments endpoint.
+type MsgRejectPaymentsResponse struct {
 }
 
-func (c *msgClient) GovCloseMarket(ctx context.Context, in *MsgGovCloseMarketRequest, opts ...grpc.CallOption) (*MsgGovCloseMarketResponse, error) {
-	out := new(MsgGovCloseMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovCloseMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectPaymentsResponse) Reset()         { *m = MsgRejectPaymentsResponse{} }
+func (m *MsgRejectPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRejectPaymentsResponse) ProtoMessage()    {}
+func (*MsgRejectPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{67}
 }
-
-// Deprecated: Do not use.
-func (c *msgClient) GovUpdateParams(ctx context.Context, in *MsgGovUpdateParamsRequest, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error) {
-	out := new(MsgGovUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovUpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRejectPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRejectPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRejectPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateAsk creates an ask order (to sell something you own).
-	CreateAsk(context.Context, *MsgCreateAskRequest) (*MsgCreateAskResponse, error)
-	// CreateBid creates a bid order (to buy something you want).
-	CreateBid(context.Context, *MsgCreateBidRequest) (*MsgCreateBidResponse, error)
-	// CommitFunds marks funds in an account as manageable by a market.
-	CommitFunds(context.Context, *MsgCommitFundsRequest) (*MsgCommitFundsResponse, error)
-	// CancelOrder cancels an order.
-	CancelOrder(context.Context, *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error)
-	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
-	FillBids(context.Context, *MsgFillBidsRequest) (*MsgFillBidsResponse, error)
-	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
-	FillAsks(context.Context, *MsgFillAsksRequest) (*MsgFillAsksResponse, error)
-	// MarketSettle is a market endpoint to trigger the settlement of orders.
-	MarketSettle(context.Context, *MsgMarketSettleRequest) (*MsgMarketSettleResponse, error)
-	// MarketCommitmentSettle is a market endpoint to transfer committed funds.
-	MarketCommitmentSettle(context.Context, *MsgMarketCommitmentSettleRequest) (*MsgMarketCommitmentSettleResponse, error)
-	// MarketReleaseCommitments is a market endpoint return control of funds back to the account owner(s).
-	MarketReleaseCommitments(context.Context, *MsgMarketReleaseCommitmentsRequest) (*MsgMarketReleaseCommitmentsResponse, error)
-	// MarketSetOrderExternalID updates an order's external id field.
-	MarketSetOrderExternalID(context.Context, *MsgMarketSetOrderExternalIDRequest) (*MsgMarketSetOrderExternalIDResponse, error)
-	// MarketWithdraw is a market endpoint to withdraw fees that have been collected.
-	MarketWithdraw(context.Context, *MsgMarketWithdrawRequest) (*MsgMarketWithdrawResponse, error)
-	// MarketUpdateDetails is a market endpoint to update its details.
-	MarketUpdateDetails(context.Context, *MsgMarketUpdateDetailsRequest) (*MsgMarketUpdateDetailsResponse, error)
-	// MarketUpdateEnabled is a market endpoint to update whether its accepting orders.
-	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
-	MarketUpdateEnabled(context.Context, *MsgMarketUpdateEnabledRequest) (*MsgMarketUpdateEnabledResponse, error)
-	// MarketUpdateAcceptingOrders is a market endpoint to update whether its accepting orders.
-	MarketUpdateAcceptingOrders(context.Context, *MsgMarketUpdateAcceptingOrdersRequest) (*MsgMarketUpdateAcceptingOrdersResponse, error)
-	// MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
-	MarketUpdateUserSettle(context.Context, *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error)
-	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
-	MarketUpdateAcceptingCommitments(context.Context, *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
-	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
-	MarketUpdateIntermediaryDenom(context.Context, *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error)
-	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
-	MarketManagePermissions(context.Context, *MsgMarketManagePermissionsRequest) (*MsgMarketManagePermissionsResponse, error)
-	// MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
-	MarketManageReqAttrs(context.Context, *MsgMarketManageReqAttrsRequest) (*MsgMarketManageReqAttrsResponse, error)
-	// CreatePayment creates a payment to facilitate a trade between two accounts.
-	CreatePayment(context.Context, *MsgCreatePaymentRequest) (*MsgCreatePaymentResponse, error)
-	// AcceptPayment is used by a target to accept a payment.
-	AcceptPayment(context.Context, *MsgAcceptPaymentRequest) (*MsgAcceptPaymentResponse, error)
-	// RejectPayment can be used by a target to reject a payment.
-	RejectPayment(context.Context, *MsgRejectPaymentRequest) (*MsgRejectPaymentResponse, error)
-	// RejectPayments can be used by a target to reject all payments from one or more sources.
-	RejectPayments(context.Context, *MsgRejectPaymentsRequest) (*MsgRejectPaymentsResponse, error)
-	// CancelPayments can be used by a source to cancel one or more payments.
-	CancelPayments(context.Context, *MsgCancelPaymentsRequest) (*MsgCancelPaymentsResponse, error)
-	// ChangePaymentTarget can be used by a source to change the target in one of their payments.
-	ChangePaymentTarget(context.Context, *MsgChangePaymentTargetRequest) (*MsgChangePaymentTargetResponse, error)
-	// GovCreateMarket is a governance proposal endpoint for creating a market.
-	GovCreateMarket(context.Context, *MsgGovCreateMarketRequest) (*MsgGovCreateMarketResponse, error)
-	// GovManageFees is a governance proposal endpoint for updating a market's fees.
-	GovManageFees(context.Context, *MsgGovManageFeesRequest) (*MsgGovManageFeesResponse, error)
-	// GovCloseMarket is a governance proposal endpoint that will disable order and commitment creation,
-	// cancel all orders, and release all commitments.
-	GovCloseMarket(context.Context, *MsgGovCloseMarketRequest) (*MsgGovCloseMarketResponse, error)
-	// GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
-	// Deprecated: Use UpdateParams instead.
-	GovUpdateParams(context.Context, *MsgGovUpdateParamsRequest) (*MsgGovUpdateParamsResponse, error)
-	// UpdateParams is a governance proposal endpoint for updating the exchange module's params.
-	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+func (m *MsgRejectPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRejectPaymentsResponse.Merge(m, src)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRejectPaymentsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedMsgServer) CreateAsk(ctx context.Context, req *MsgCreateAskRequest) (*MsgCreateAskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAsk not implemented")
+func (m *MsgRejectPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRejectPaymentsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) CreateBid(ctx context.Context, req *MsgCreateBidRequest) (*MsgCreateBidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
+
+var xxx_messageInfo_MsgRejectPaymentsResponse proto.InternalMessageInfo
+
+// MsgCancelPaymentsRequest is a request message for the CancelPayments endpoint.
+type MsgCancelPaymentsRequest struct {
+	// source is the account that wishes to cancel some of their payments.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_ids is all of the external ids of the payments to cancel.
+	ExternalIds []string `protobuf:"bytes,2,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty"`
 }
-func (*UnimplementedMsgServer) CommitFunds(ctx context.Context, req *MsgCommitFundsRequest) (*MsgCommitFundsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitFunds not implemented")
+
+func (m *MsgCancelPaymentsRequest) Reset()         { *m = MsgCancelPaymentsRequest{} }
+func (m *MsgCancelPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentsRequest) ProtoMessage()    {}
+func (*MsgCancelPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{68}
 }
-func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+func (m *MsgCancelPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) FillBids(ctx context.Context, req *MsgFillBidsRequest) (*MsgFillBidsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FillBids not implemented")
+func (m *MsgCancelPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) FillAsks(ctx context.Context, req *MsgFillAsksRequest) (*MsgFillAsksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FillAsks not implemented")
+func (m *MsgCancelPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentsRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MarketSettle(ctx context.Context, req *MsgMarketSettleRequest) (*MsgMarketSettleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketSettle not implemented")
+func (m *MsgCancelPaymentsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) MarketCommitmentSettle(ctx context.Context, req *MsgMarketCommitmentSettleRequest) (*MsgMarketCommitmentSettleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketCommitmentSettle not implemented")
+func (m *MsgCancelPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) MarketReleaseCommitments(ctx context.Context, req *MsgMarketReleaseCommitmentsRequest) (*MsgMarketReleaseCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketReleaseCommitments not implemented")
+
+var xxx_messageInfo_MsgCancelPaymentsRequest proto.InternalMessageInfo
+
+func (m *MsgCancelPaymentsRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MarketSetOrderExternalID(ctx context.Context, req *MsgMarketSetOrderExternalIDRequest) (*MsgMarketSetOrderExternalIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketSetOrderExternalID not implemented")
+
+func (m *MsgCancelPaymentsRequest) GetExternalIds() []string {
+	if m != nil {
+		return m.ExternalIds
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) MarketWithdraw(ctx context.Context, req *MsgMarketWithdrawRequest) (*MsgMarketWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketWithdraw not implemented")
+
+// MsgCancelPaymentsResponse is a response message for the CancelPayments endpoint.
+type MsgCancelPaymentsResponse struct {
 }
-func (*UnimplementedMsgServer) MarketUpdateDetails(ctx context.Context, req *MsgMarketUpdateDetailsRequest) (*MsgMarketUpdateDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateDetails not implemented")
+
+func (m *MsgCancelPaymentsResponse) Reset()         { *m = MsgCancelPaymentsResponse{} }
+func (m *MsgCancelPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentsResponse) ProtoMessage()    {}
+func (*MsgCancelPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{69}
 }
-func (*UnimplementedMsgServer) MarketUpdateEnabled(ctx context.Context, req *MsgMarketUpdateEnabledRequest) (*MsgMarketUpdateEnabledResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateEnabled not implemented")
+func (m *MsgCancelPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MarketUpdateAcceptingOrders(ctx context.Context, req *MsgMarketUpdateAcceptingOrdersRequest) (*MsgMarketUpdateAcceptingOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAcceptingOrders not implemented")
+func (m *MsgCancelPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) MarketUpdateUserSettle(ctx context.Context, req *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateUserSettle not implemented")
+func (m *MsgCancelPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentsResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) MarketUpdateAcceptingCommitments(ctx context.Context, req *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAcceptingCommitments not implemented")
+func (m *MsgCancelPaymentsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) MarketUpdateIntermediaryDenom(ctx context.Context, req *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateIntermediaryDenom not implemented")
+func (m *MsgCancelPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) MarketManagePermissions(ctx context.Context, req *MsgMarketManagePermissionsRequest) (*MsgMarketManagePermissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketManagePermissions not implemented")
+
+var xxx_messageInfo_MsgCancelPaymentsResponse proto.InternalMessageInfo
+
+// MsgChangePaymentTargetRequest is a request message for the ChangePaymentTarget endpoint.
+type MsgChangePaymentTargetRequest struct {
+	// source is the account that wishes to update the target of one of their payments.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id is the external id of the payment to update.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// new_target is the new target account of the payment.
+	NewTarget string `protobuf:"bytes,3,opt,name=new_target,json=newTarget,proto3" json:"new_target,omitempty"`
 }
-func (*UnimplementedMsgServer) MarketManageReqAttrs(ctx context.Context, req *MsgMarketManageReqAttrsRequest) (*MsgMarketManageReqAttrsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketManageReqAttrs not implemented")
+
+func (m *MsgChangePaymentTargetRequest) Reset()         { *m = MsgChangePaymentTargetRequest{} }
+func (m *MsgChangePaymentTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgChangePaymentTargetRequest) ProtoMessage()    {}
+func (*MsgChangePaymentTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{70}
 }
-func (*UnimplementedMsgServer) CreatePayment(ctx context.Context, req *MsgCreatePaymentRequest) (*MsgCreatePaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePayment not implemented")
+func (m *MsgChangePaymentTargetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) AcceptPayment(ctx context.Context, req *MsgAcceptPaymentRequest) (*MsgAcceptPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptPayment not implemented")
+func (m *MsgChangePaymentTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangePaymentTargetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RejectPayment(ctx context.Context, req *MsgRejectPaymentRequest) (*MsgRejectPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectPayment not implemented")
+func (m *MsgChangePaymentTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangePaymentTargetRequest.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RejectPayments(ctx context.Context, req *MsgRejectPaymentsRequest) (*MsgRejectPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectPayments not implemented")
+func (m *MsgChangePaymentTargetRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelPayments(ctx context.Context, req *MsgCancelPaymentsRequest) (*MsgCancelPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelPayments not implemented")
+func (m *MsgChangePaymentTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangePaymentTargetRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) ChangePaymentTarget(ctx context.Context, req *MsgChangePaymentTargetRequest) (*MsgChangePaymentTargetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePaymentTarget not implemented")
+
+var xxx_messageInfo_MsgChangePaymentTargetRequest proto.InternalMessageInfo
+
+func (m *MsgChangePaymentTargetRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) GovCreateMarket(ctx context.Context, req *MsgGovCreateMarketRequest) (*MsgGovCreateMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovCreateMarket not implemented")
+
+func (m *MsgChangePaymentTargetRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) GovManageFees(ctx context.Context, req *MsgGovManageFeesRequest) (*MsgGovManageFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovManageFees not implemented")
+
+func (m *MsgChangePaymentTargetRequest) GetNewTarget() string {
+	if m != nil {
+		return m.NewTarget
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) GovCloseMarket(ctx context.Context, req *MsgGovCloseMarketRequest) (*MsgGovCloseMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovCloseMarket not implemented")
+
+// MsgChangePaymentTargetResponse is a response message for the ChangePaymentTarget endpoint.
+type MsgChangePaymentTargetResponse struct {
 }
-func (*UnimplementedMsgServer) GovUpdateParams(ctx context.Context, req *MsgGovUpdateParamsRequest) (*MsgGovUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateParams not implemented")
+
+func (m *MsgChangePaymentTargetResponse) Reset()         { *m = MsgChangePaymentTargetResponse{} }
+func (m *MsgChangePaymentTargetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgChangePaymentTargetResponse) ProtoMessage()    {}
+func (*MsgChangePaymentTargetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{71}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgChangePaymentTargetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangePaymentTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangePaymentTargetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangePaymentTargetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangePaymentTargetResponse.Merge(m, src)
+}
+func (m *MsgChangePaymentTargetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangePaymentTargetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangePaymentTargetResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgChangePaymentTargetResponse proto.InternalMessageInfo
+
+// MsgIssuerCreateMarketRequest is a request message for the IssuerCreateMarket endpoint.
+type MsgIssuerCreateMarketRequest struct {
+	// issuer is the account creating the market. It must be one of the market_issuers in the exchange
+	// module params, and must have admin access on the marker.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// marker_denom is the denom of the marker that the market is being created for.
+	// The marker's required attributes are added to the market's required attributes.
+	MarkerDenom string `protobuf:"bytes,2,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+	// market is the initial market configuration.
+	// If the market_id is 0, the next available market_id will be used.
+	// Its fees are limited by the issuer_market_max_flat_fees and issuer_market_max_ratio_bips params.
+	Market Market `protobuf:"bytes,3,opt,name=market,proto3" json:"market"`
 }
 
-func _Msg_CreateAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateAskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateAsk(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/CreateAsk",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateAsk(ctx, req.(*MsgCreateAskRequest))
+func (m *MsgIssuerCreateMarketRequest) Reset()         { *m = MsgIssuerCreateMarketRequest{} }
+func (m *MsgIssuerCreateMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgIssuerCreateMarketRequest) ProtoMessage()    {}
+func (*MsgIssuerCreateMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{72}
+}
+func (m *MsgIssuerCreateMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssuerCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssuerCreateMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgIssuerCreateMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssuerCreateMarketRequest.Merge(m, src)
+}
+func (m *MsgIssuerCreateMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssuerCreateMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssuerCreateMarketRequest.DiscardUnknown(m)
 }
 
-func _Msg_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateBid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/CreateBid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBid(ctx, req.(*MsgCreateBidRequest))
+var xxx_messageInfo_MsgIssuerCreateMarketRequest proto.InternalMessageInfo
+
+func (m *MsgIssuerCreateMarketRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CommitFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCommitFundsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CommitFunds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/CommitFunds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CommitFunds(ctx, req.(*MsgCommitFundsRequest))
+func (m *MsgIssuerCreateMarketRequest) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/CancelOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrderRequest))
+func (m *MsgIssuerCreateMarketRequest) GetMarket() Market {
+	if m != nil {
+		return m.Market
 	}
-	return interceptor(ctx, in, info, handler)
+	return Market{}
 }
 
-func _Msg_FillBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFillBidsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FillBids(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/FillBids",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FillBids(ctx, req.(*MsgFillBidsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgIssuerCreateMarketResponse is a response message for the IssuerCreateMarket endpoint.
+type MsgIssuerCreateMarketResponse struct {
+	// market_id is the id of the newly created market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func _Msg_FillAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFillAsksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FillAsks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/FillAsks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FillAsks(ctx, req.(*MsgFillAsksRequest))
+func (m *MsgIssuerCreateMarketResponse) Reset()         { *m = MsgIssuerCreateMarketResponse{} }
+func (m *MsgIssuerCreateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssuerCreateMarketResponse) ProtoMessage()    {}
+func (*MsgIssuerCreateMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{73}
+}
+func (m *MsgIssuerCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssuerCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssuerCreateMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgIssuerCreateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssuerCreateMarketResponse.Merge(m, src)
+}
+func (m *MsgIssuerCreateMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssuerCreateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssuerCreateMarketResponse.DiscardUnknown(m)
 }
 
-func _Msg_MarketSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketSettleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketSettle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketSettle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketSettle(ctx, req.(*MsgMarketSettleRequest))
+var xxx_messageInfo_MsgIssuerCreateMarketResponse proto.InternalMessageInfo
+
+func (m *MsgIssuerCreateMarketResponse) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_MarketCommitmentSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketCommitmentSettleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketCommitmentSettle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketCommitmentSettle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketCommitmentSettle(ctx, req.(*MsgMarketCommitmentSettleRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgGovCreateMarketRequest is a request message for the GovCreateMarket endpoint.
+type MsgGovCreateMarketRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// market is the initial market configuration.
+	// If the market_id is 0, the next available market_id will be used (once voting ends).
+	// If it is not zero, it must not yet be in use when the voting period ends.
+	Market Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market"`
 }
 
-func _Msg_MarketReleaseCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketReleaseCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketReleaseCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketReleaseCommitments",
+func (m *MsgGovCreateMarketRequest) Reset()         { *m = MsgGovCreateMarketRequest{} }
+func (m *MsgGovCreateMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGovCreateMarketRequest) ProtoMessage()    {}
+func (*MsgGovCreateMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{74}
+}
+func (m *MsgGovCreateMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovCreateMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketReleaseCommitments(ctx, req.(*MsgMarketReleaseCommitmentsRequest))
+}
+func (m *MsgGovCreateMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovCreateMarketRequest.Merge(m, src)
+}
+func (m *MsgGovCreateMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovCreateMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovCreateMarketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovCreateMarketRequest proto.InternalMessageInfo
+
+func (m *MsgGovCreateMarketRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MarketSetOrderExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketSetOrderExternalIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgGovCreateMarketRequest) GetMarket() Market {
+	if m != nil {
+		return m.Market
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketSetOrderExternalID(ctx, in)
+	return Market{}
+}
+
+// MsgGovCreateMarketResponse is a response message for the GovCreateMarket endpoint.
+type MsgGovCreateMarketResponse struct {
+}
+
+func (m *MsgGovCreateMarketResponse) Reset()         { *m = MsgGovCreateMarketResponse{} }
+func (m *MsgGovCreateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovCreateMarketResponse) ProtoMessage()    {}
+func (*MsgGovCreateMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{75}
+}
+func (m *MsgGovCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovCreateMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketSetOrderExternalID",
+}
+func (m *MsgGovCreateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovCreateMarketResponse.Merge(m, src)
+}
+func (m *MsgGovCreateMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovCreateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovCreateMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovCreateMarketResponse proto.InternalMessageInfo
+
+// MsgGovManageFeesRequest is a request message for the GovManageFees endpoint.
+type MsgGovManageFeesRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// market_id is the market id that will get these fee updates.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// add_fee_create_ask_flat are the create-ask flat fee options to add.
+	AddFeeCreateAskFlat []types.Coin `protobuf:"bytes,3,rep,name=add_fee_create_ask_flat,json=addFeeCreateAskFlat,proto3" json:"add_fee_create_ask_flat"`
+	// remove_fee_create_ask_flat are the create-ask flat fee options to remove.
+	RemoveFeeCreateAskFlat []types.Coin `protobuf:"bytes,4,rep,name=remove_fee_create_ask_flat,json=removeFeeCreateAskFlat,proto3" json:"remove_fee_create_ask_flat"`
+	// add_fee_create_bid_flat are the create-bid flat fee options to add.
+	AddFeeCreateBidFlat []types.Coin `protobuf:"bytes,5,rep,name=add_fee_create_bid_flat,json=addFeeCreateBidFlat,proto3" json:"add_fee_create_bid_flat"`
+	// remove_fee_create_bid_flat are the create-bid flat fee options to remove.
+	RemoveFeeCreateBidFlat []types.Coin `protobuf:"bytes,6,rep,name=remove_fee_create_bid_flat,json=removeFeeCreateBidFlat,proto3" json:"remove_fee_create_bid_flat"`
+	// add_fee_seller_settlement_flat are the seller settlement flat fee options to add.
+	AddFeeSellerSettlementFlat []types.Coin `protobuf:"bytes,7,rep,name=add_fee_seller_settlement_flat,json=addFeeSellerSettlementFlat,proto3" json:"add_fee_seller_settlement_flat"`
+	// remove_fee_seller_settlement_flat are the seller settlement flat fee options to remove.
+	RemoveFeeSellerSettlementFlat []types.Coin `protobuf:"bytes,8,rep,name=remove_fee_seller_settlement_flat,json=removeFeeSellerSettlementFlat,proto3" json:"remove_fee_seller_settlement_flat"`
+	// add_fee_seller_settlement_ratios are the seller settlement fee ratios to add.
+	AddFeeSellerSettlementRatios []FeeRatio `protobuf:"bytes,9,rep,name=add_fee_seller_settlement_ratios,json=addFeeSellerSettlementRatios,proto3" json:"add_fee_seller_settlement_ratios"`
+	// remove_fee_seller_settlement_ratios are the seller settlement fee ratios to remove.
+	RemoveFeeSellerSettlementRatios []FeeRatio `protobuf:"bytes,10,rep,name=remove_fee_seller_settlement_ratios,json=removeFeeSellerSettlementRatios,proto3" json:"remove_fee_seller_settlement_ratios"`
+	// add_fee_buyer_settlement_flat are the buyer settlement flat fee options to add.
+	AddFeeBuyerSettlementFlat []types.Coin `protobuf:"bytes,11,rep,name=add_fee_buyer_settlement_flat,json=addFeeBuyerSettlementFlat,proto3" json:"add_fee_buyer_settlement_flat"`
+	// remove_fee_buyer_settlement_flat are the buyer settlement flat fee options to remove.
+	RemoveFeeBuyerSettlementFlat []types.Coin `protobuf:"bytes,12,rep,name=remove_fee_buyer_settlement_flat,json=removeFeeBuyerSettlementFlat,proto3" json:"remove_fee_buyer_settlement_flat"`
+	// add_fee_buyer_settlement_ratios are the buyer settlement fee ratios to add.
+	AddFeeBuyerSettlementRatios []FeeRatio `protobuf:"bytes,13,rep,name=add_fee_buyer_settlement_ratios,json=addFeeBuyerSettlementRatios,proto3" json:"add_fee_buyer_settlement_ratios"`
+	// remove_fee_buyer_settlement_ratios are the buyer settlement fee ratios to remove.
+	RemoveFeeBuyerSettlementRatios []FeeRatio `protobuf:"bytes,14,rep,name=remove_fee_buyer_settlement_ratios,json=removeFeeBuyerSettlementRatios,proto3" json:"remove_fee_buyer_settlement_ratios"`
+	// add_fee_create_commitment_flat are the create-commitment flat fee options to add.
+	AddFeeCreateCommitmentFlat []types.Coin `protobuf:"bytes,15,rep,name=add_fee_create_commitment_flat,json=addFeeCreateCommitmentFlat,proto3" json:"add_fee_create_commitment_flat"`
+	// remove_fee_create_commitment_flat are the create-commitment flat fee options to remove.
+	RemoveFeeCreateCommitmentFlat []types.Coin `protobuf:"bytes,16,rep,name=remove_fee_create_commitment_flat,json=removeFeeCreateCommitmentFlat,proto3" json:"remove_fee_create_commitment_flat"`
+	// set_fee_commitment_settlement_bips is the new fee_commitment_settlement_bips for the market.
+	// It is ignored if it is zero. To set it to zero set unset_fee_commitment_settlement_bips to true.
+	SetFeeCommitmentSettlementBips uint32 `protobuf:"varint,17,opt,name=set_fee_commitment_settlement_bips,json=setFeeCommitmentSettlementBips,proto3" json:"set_fee_commitment_settlement_bips,omitempty"`
+	// unset_fee_commitment_settlement_bips, if true, sets the fee_commitment_settlement_bips to zero.
+	// If false, it is ignored.
+	UnsetFeeCommitmentSettlementBips bool `protobuf:"varint,18,opt,name=unset_fee_commitment_settlement_bips,json=unsetFeeCommitmentSettlementBips,proto3" json:"unset_fee_commitment_settlement_bips,omitempty"`
+}
+
+func (m *MsgGovManageFeesRequest) Reset()         { *m = MsgGovManageFeesRequest{} }
+func (m *MsgGovManageFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGovManageFeesRequest) ProtoMessage()    {}
+func (*MsgGovManageFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{76}
+}
+func (m *MsgGovManageFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovManageFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketSetOrderExternalID(ctx, req.(*MsgMarketSetOrderExternalIDRequest))
+}
+func (m *MsgGovManageFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovManageFeesRequest.Merge(m, src)
+}
+func (m *MsgGovManageFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovManageFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovManageFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovManageFeesRequest proto.InternalMessageInfo
+
+func (m *MsgGovManageFeesRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_MarketWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketWithdrawRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgGovManageFeesRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketWithdraw(ctx, in)
+	return 0
+}
+
+func (m *MsgGovManageFeesRequest) GetAddFeeCreateAskFlat() []types.Coin {
+	if m != nil {
+		return m.AddFeeCreateAskFlat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketWithdraw",
+	return nil
+}
+
+func (m *MsgGovManageFeesRequest) GetRemoveFeeCreateAskFlat() []types.Coin {
+	if m != nil {
+		return m.RemoveFeeCreateAskFlat
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketWithdraw(ctx, req.(*MsgMarketWithdrawRequest))
+	return nil
+}
+
+func (m *MsgGovManageFeesRequest) GetAddFeeCreateBidFlat() []types.Coin {
+	if m != nil {
+		return m.AddFeeCreateBidFlat
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketUpdateDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketUpdateDetailsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgGovManageFeesRequest) GetRemoveFeeCreateBidFlat() []types.Coin {
+	if m != nil {
+		return m.RemoveFeeCreateBidFlat
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketUpdateDetails(ctx, in)
+	return nil
+}
+
+func (m *MsgGovManageFeesRequest) GetAddFeeSellerSettlementFlat() []types.Coin {
+	if m != nil {
+		return m.AddFeeSellerSettlementFlat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateDetails",
+	return nil
+}
+
+func (m *MsgGovManageFeesRequest) GetRemoveFeeSellerSettlementFlat() []types.Coin {
+	if m != nil {
+		return m.RemoveFeeSellerSettlementFlat
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketUpdateDetails(ctx, req.(*MsgMarketUpdateDetailsRequest))
+	return nil
+}
+
+func (m *MsgGovManageFeesRequest) GetAddFeeSellerSettlementRatios() []FeeRatio {
+	if m != nil {
+		return m.AddFeeSellerSettlementRatios
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketUpdateEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketUpdateEnabledRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketUpdateEnabled(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateEnabled",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketUpdateEnabled(ctx, req.(*MsgMarketUpdateEnabledRequest))
+func (m *MsgGovManageFeesRequest) GetRemoveFeeSellerSettlementRatios() []FeeRatio {
+	if m != nil {
+		return m.RemoveFeeSellerSettlementRatios
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketUpdateAcceptingOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketUpdateAcceptingOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketUpdateAcceptingOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateAcceptingOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketUpdateAcceptingOrders(ctx, req.(*MsgMarketUpdateAcceptingOrdersRequest))
+func (m *MsgGovManageFeesRequest) GetAddFeeBuyerSettlementFlat() []types.Coin {
+	if m != nil {
+		return m.AddFeeBuyerSettlementFlat
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketUpdateUserSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketUpdateUserSettleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketUpdateUserSettle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateUserSettle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketUpdateUserSettle(ctx, req.(*MsgMarketUpdateUserSettleRequest))
+func (m *MsgGovManageFeesRequest) GetRemoveFeeBuyerSettlementFlat() []types.Coin {
+	if m != nil {
+		return m.RemoveFeeBuyerSettlementFlat
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketUpdateAcceptingCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketUpdateAcceptingCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketUpdateAcceptingCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateAcceptingCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketUpdateAcceptingCommitments(ctx, req.(*MsgMarketUpdateAcceptingCommitmentsRequest))
+func (m *MsgGovManageFeesRequest) GetAddFeeBuyerSettlementRatios() []FeeRatio {
+	if m != nil {
+		return m.AddFeeBuyerSettlementRatios
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketUpdateIntermediaryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketUpdateIntermediaryDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketUpdateIntermediaryDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateIntermediaryDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketUpdateIntermediaryDenom(ctx, req.(*MsgMarketUpdateIntermediaryDenomRequest))
+func (m *MsgGovManageFeesRequest) GetRemoveFeeBuyerSettlementRatios() []FeeRatio {
+	if m != nil {
+		return m.RemoveFeeBuyerSettlementRatios
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketManagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketManagePermissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketManagePermissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketManagePermissions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketManagePermissions(ctx, req.(*MsgMarketManagePermissionsRequest))
+func (m *MsgGovManageFeesRequest) GetAddFeeCreateCommitmentFlat() []types.Coin {
+	if m != nil {
+		return m.AddFeeCreateCommitmentFlat
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MarketManageReqAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMarketManageReqAttrsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MarketManageReqAttrs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/MarketManageReqAttrs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MarketManageReqAttrs(ctx, req.(*MsgMarketManageReqAttrsRequest))
+func (m *MsgGovManageFeesRequest) GetRemoveFeeCreateCommitmentFlat() []types.Coin {
+	if m != nil {
+		return m.RemoveFeeCreateCommitmentFlat
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CreatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/CreatePayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePayment(ctx, req.(*MsgCreatePaymentRequest))
+func (m *MsgGovManageFeesRequest) GetSetFeeCommitmentSettlementBips() uint32 {
+	if m != nil {
+		return m.SetFeeCommitmentSettlementBips
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AcceptPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptPaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcceptPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/AcceptPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptPayment(ctx, req.(*MsgAcceptPaymentRequest))
+func (m *MsgGovManageFeesRequest) GetUnsetFeeCommitmentSettlementBips() bool {
+	if m != nil {
+		return m.UnsetFeeCommitmentSettlementBips
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_RejectPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectPaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/RejectPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectPayment(ctx, req.(*MsgRejectPaymentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgGovManageFeesResponse is a response message for the GovManageFees endpoint.
+type MsgGovManageFeesResponse struct {
 }
 
-func _Msg_RejectPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRejectPaymentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RejectPayments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/RejectPayments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RejectPayments(ctx, req.(*MsgRejectPaymentsRequest))
+func (m *MsgGovManageFeesResponse) Reset()         { *m = MsgGovManageFeesResponse{} }
+func (m *MsgGovManageFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovManageFeesResponse) ProtoMessage()    {}
+func (*MsgGovManageFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{77}
+}
+func (m *MsgGovManageFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovManageFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGovManageFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovManageFeesResponse.Merge(m, src)
+}
+func (m *MsgGovManageFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovManageFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovManageFeesResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelPaymentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelPayments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/CancelPayments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelPayments(ctx, req.(*MsgCancelPaymentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgGovManageFeesResponse proto.InternalMessageInfo
+
+// MsgGovCloseMarketRequest is a request message for the GovCloseMarket endpoint.
+type MsgGovCloseMarketRequest struct {
+	// authority must be the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// market_id is the numerical identifier of the market to close.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func _Msg_ChangePaymentTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangePaymentTargetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangePaymentTarget(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/ChangePaymentTarget",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangePaymentTarget(ctx, req.(*MsgChangePaymentTargetRequest))
+func (m *MsgGovCloseMarketRequest) Reset()         { *m = MsgGovCloseMarketRequest{} }
+func (m *MsgGovCloseMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGovCloseMarketRequest) ProtoMessage()    {}
+func (*MsgGovCloseMarketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{78}
+}
+func (m *MsgGovCloseMarketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovCloseMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovCloseMarketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGovCloseMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovCloseMarketRequest.Merge(m, src)
+}
+func (m *MsgGovCloseMarketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovCloseMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovCloseMarketRequest.DiscardUnknown(m)
 }
 
-func _Msg_GovCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovCreateMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovCreateMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/GovCreateMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovCreateMarket(ctx, req.(*MsgGovCreateMarketRequest))
+var xxx_messageInfo_MsgGovCloseMarketRequest proto.InternalMessageInfo
+
+func (m *MsgGovCloseMarketRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_GovManageFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovManageFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovManageFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/GovManageFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovManageFees(ctx, req.(*MsgGovManageFeesRequest))
+func (m *MsgGovCloseMarketRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_GovCloseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovCloseMarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovCloseMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/GovCloseMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovCloseMarket(ctx, req.(*MsgGovCloseMarketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgGovCloseMarketResponse is a response message for the GovCloseMarket endpoint.
+type MsgGovCloseMarketResponse struct {
 }
 
-func _Msg_GovUpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovUpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/GovUpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovUpdateParams(ctx, req.(*MsgGovUpdateParamsRequest))
+func (m *MsgGovCloseMarketResponse) Reset()         { *m = MsgGovCloseMarketResponse{} }
+func (m *MsgGovCloseMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovCloseMarketResponse) ProtoMessage()    {}
+func (*MsgGovCloseMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{79}
+}
+func (m *MsgGovCloseMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovCloseMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovCloseMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGovCloseMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovCloseMarketResponse.Merge(m, src)
+}
+func (m *MsgGovCloseMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovCloseMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovCloseMarketResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGovCloseMarketResponse proto.InternalMessageInfo
+
+// MsgGovUpdateParamsRequest is a request message for the GovUpdateParams endpoint.
+// Deprecated: Use MsgUpdateParamsRequest instead.
+//
+// Deprecated: Do not use.
+type MsgGovUpdateParamsRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the new param values to set
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgGovUpdateParamsRequest) Reset()         { *m = MsgGovUpdateParamsRequest{} }
+func (m *MsgGovUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateParamsRequest) ProtoMessage()    {}
+func (*MsgGovUpdateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{80}
+}
+func (m *MsgGovUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgGovUpdateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateParamsRequest.Merge(m, src)
+}
+func (m *MsgGovUpdateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGovUpdateParamsRequest proto.InternalMessageInfo
+
+func (m *MsgGovUpdateParamsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgGovUpdateParamsRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
+	return Params{}
+}
+
+// MsgGovUpdateParamsResponse is a response message for the GovUpdateParams endpoint.
+// Deprecated: Use MsgUpdateParamsResponse instead.
+//
+// Deprecated: Do not use.
+type MsgGovUpdateParamsResponse struct {
+}
+
+func (m *MsgGovUpdateParamsResponse) Reset()         { *m = MsgGovUpdateParamsResponse{} }
+func (m *MsgGovUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGovUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgGovUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{81}
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGovUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGovUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgGovUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGovUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGovUpdateParamsResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.exchange.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateAsk",
-			Handler:    _Msg_CreateAsk_Handler,
-		},
-		{
-			MethodName: "CreateBid",
-			Handler:    _Msg_CreateBid_Handler,
-		},
-		{
-			MethodName: "CommitFunds",
-			Handler:    _Msg_CommitFunds_Handler,
-		},
-		{
-			MethodName: "CancelOrder",
-			Handler:    _Msg_CancelOrder_Handler,
-		},
-		{
-			MethodName: "FillBids",
-			Handler:    _Msg_FillBids_Handler,
-		},
-		{
-			MethodName: "FillAsks",
-			Handler:    _Msg_FillAsks_Handler,
-		},
-		{
-			MethodName: "MarketSettle",
-			Handler:    _Msg_MarketSettle_Handler,
-		},
-		{
-			MethodName: "MarketCommitmentSettle",
-			Handler:    _Msg_MarketCommitmentSettle_Handler,
-		},
-		{
-			MethodName: "MarketReleaseCommitments",
-			Handler:    _Msg_MarketReleaseCommitments_Handler,
-		},
-		{
-			MethodName: "MarketSetOrderExternalID",
-			Handler:    _Msg_MarketSetOrderExternalID_Handler,
-		},
-		{
-			MethodName: "MarketWithdraw",
-			Handler:    _Msg_MarketWithdraw_Handler,
-		},
-		{
-			MethodName: "MarketUpdateDetails",
-			Handler:    _Msg_MarketUpdateDetails_Handler,
-		},
-		{
-			MethodName: "MarketUpdateEnabled",
-			Handler:    _Msg_MarketUpdateEnabled_Handler,
-		},
-		{
-			MethodName: "MarketUpdateAcceptingOrders",
-			Handler:    _Msg_MarketUpdateAcceptingOrders_Handler,
-		},
-		{
-			MethodName: "MarketUpdateUserSettle",
-			Handler:    _Msg_MarketUpdateUserSettle_Handler,
-		},
-		{
-			MethodName: "MarketUpdateAcceptingCommitments",
-			Handler:    _Msg_MarketUpdateAcceptingCommitments_Handler,
-		},
-		{
-			MethodName: "MarketUpdateIntermediaryDenom",
-			Handler:    _Msg_MarketUpdateIntermediaryDenom_Handler,
-		},
-		{
-			MethodName: "MarketManagePermissions",
-			Handler:    _Msg_MarketManagePermissions_Handler,
-		},
-		{
-			MethodName: "MarketManageReqAttrs",
-			Handler:    _Msg_MarketManageReqAttrs_Handler,
-		},
-		{
-			MethodName: "CreatePayment",
-			Handler:    _Msg_CreatePayment_Handler,
-		},
-		{
-			MethodName: "AcceptPayment",
-			Handler:    _Msg_AcceptPayment_Handler,
-		},
-		{
-			MethodName: "RejectPayment",
-			Handler:    _Msg_RejectPayment_Handler,
-		},
-		{
-			MethodName: "RejectPayments",
-			Handler:    _Msg_RejectPayments_Handler,
-		},
-		{
-			MethodName: "CancelPayments",
-			Handler:    _Msg_CancelPayments_Handler,
-		},
-		{
-			MethodName: "ChangePaymentTarget",
-			Handler:    _Msg_ChangePaymentTarget_Handler,
-		},
-		{
-			MethodName: "GovCreateMarket",
-			Handler:    _Msg_GovCreateMarket_Handler,
-		},
-		{
-			MethodName: "GovManageFees",
-			Handler:    _Msg_GovManageFees_Handler,
-		},
-		{
-			MethodName: "GovCloseMarket",
-			Handler:    _Msg_GovCloseMarket_Handler,
-		},
-		{
-			MethodName: "GovUpdateParams",
-			Handler:    _Msg_GovUpdateParams_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/exchange/v1/tx.proto",
-}
+var xxx_messageInfo_MsgGovUpdateParamsResponse proto.InternalMessageInfo
 
-func (m *MsgCreateAskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgGovUpdateParamsRequest is a request message for the GovUpdateParams endpoint.
+type MsgUpdateParamsRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the new param values to set
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgCreateAskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{} }
+func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsRequest) ProtoMessage()    {}
+func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{82}
 }
-
-func (m *MsgCreateAskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderCreationFee != nil {
-		{
-			size, err := m.OrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateAskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsRequest.Merge(m, src)
 }
-
-func (m *MsgCreateAskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsRequest.DiscardUnknown(m)
 }
 
-func (m *MsgCreateAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgUpdateParamsRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateBidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsRequest) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MsgCreateBidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse is a response message for the GovUpdateParams endpoint.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgCreateBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderCreationFee != nil {
-		{
-			size, err := m.OrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{83}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateAskRequest)(nil), "provenance.exchange.v1.MsgCreateAskRequest")
+	proto.RegisterType((*MsgCreateAskResponse)(nil), "provenance.exchange.v1.MsgCreateAskResponse")
+	proto.RegisterType((*MsgCreateBidRequest)(nil), "provenance.exchange.v1.MsgCreateBidRequest")
+	proto.RegisterType((*MsgCreateBidResponse)(nil), "provenance.exchange.v1.MsgCreateBidResponse")
+	proto.RegisterType((*MsgCommitFundsRequest)(nil), "provenance.exchange.v1.MsgCommitFundsRequest")
+	proto.RegisterType((*MsgCommitFundsResponse)(nil), "provenance.exchange.v1.MsgCommitFundsResponse")
+	proto.RegisterType((*MsgCancelOrderRequest)(nil), "provenance.exchange.v1.MsgCancelOrderRequest")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "provenance.exchange.v1.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgFillBidsRequest)(nil), "provenance.exchange.v1.MsgFillBidsRequest")
+	proto.RegisterType((*MsgFillBidsResponse)(nil), "provenance.exchange.v1.MsgFillBidsResponse")
+	proto.RegisterType((*MsgFillAsksRequest)(nil), "provenance.exchange.v1.MsgFillAsksRequest")
+	proto.RegisterType((*MsgFillAsksResponse)(nil), "provenance.exchange.v1.MsgFillAsksResponse")
+	proto.RegisterType((*MsgMarketSettleRequest)(nil), "provenance.exchange.v1.MsgMarketSettleRequest")
+	proto.RegisterType((*MsgMarketSettleResponse)(nil), "provenance.exchange.v1.MsgMarketSettleResponse")
+	proto.RegisterType((*MsgCreateRFQRequest)(nil), "provenance.exchange.v1.MsgCreateRFQRequest")
+	proto.RegisterType((*MsgCreateRFQResponse)(nil), "provenance.exchange.v1.MsgCreateRFQResponse")
+	proto.RegisterType((*MsgCancelRFQRequest)(nil), "provenance.exchange.v1.MsgCancelRFQRequest")
+	proto.RegisterType((*MsgCancelRFQResponse)(nil), "provenance.exchange.v1.MsgCancelRFQResponse")
+	proto.RegisterType((*MsgSubmitQuoteRequest)(nil), "provenance.exchange.v1.MsgSubmitQuoteRequest")
+	proto.RegisterType((*MsgSubmitQuoteResponse)(nil), "provenance.exchange.v1.MsgSubmitQuoteResponse")
+	proto.RegisterType((*MsgWithdrawQuoteRequest)(nil), "provenance.exchange.v1.MsgWithdrawQuoteRequest")
+	proto.RegisterType((*MsgWithdrawQuoteResponse)(nil), "provenance.exchange.v1.MsgWithdrawQuoteResponse")
+	proto.RegisterType((*MsgAcceptQuoteRequest)(nil), "provenance.exchange.v1.MsgAcceptQuoteRequest")
+	proto.RegisterType((*MsgAcceptQuoteResponse)(nil), "provenance.exchange.v1.MsgAcceptQuoteResponse")
+	proto.RegisterType((*MsgMarketCommitmentSettleRequest)(nil), "provenance.exchange.v1.MsgMarketCommitmentSettleRequest")
+	proto.RegisterType((*MsgMarketCommitmentSettleResponse)(nil), "provenance.exchange.v1.MsgMarketCommitmentSettleResponse")
+	proto.RegisterType((*MsgMarketReleaseCommitmentsRequest)(nil), "provenance.exchange.v1.MsgMarketReleaseCommitmentsRequest")
+	proto.RegisterType((*MsgMarketReleaseCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketReleaseCommitmentsResponse")
+	proto.RegisterType((*MsgMarketSetOrderExternalIDRequest)(nil), "provenance.exchange.v1.MsgMarketSetOrderExternalIDRequest")
+	proto.RegisterType((*MsgMarketSetOrderExternalIDResponse)(nil), "provenance.exchange.v1.MsgMarketSetOrderExternalIDResponse")
+	proto.RegisterType((*MsgMarketWithdrawRequest)(nil), "provenance.exchange.v1.MsgMarketWithdrawRequest")
+	proto.RegisterType((*MsgMarketWithdrawResponse)(nil), "provenance.exchange.v1.MsgMarketWithdrawResponse")
+	proto.RegisterType((*MsgMarketUpdateDetailsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateDetailsRequest")
+	proto.RegisterType((*MsgMarketUpdateDetailsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateDetailsResponse")
+	proto.RegisterType((*MsgMarketUpdateEnabledRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateEnabledRequest")
+	proto.RegisterType((*MsgMarketUpdateEnabledResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateEnabledResponse")
+	proto.RegisterType((*MsgMarketUpdateAcceptingOrdersRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingOrdersRequest")
+	proto.RegisterType((*MsgMarketUpdateAcceptingOrdersResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingOrdersResponse")
+	proto.RegisterType((*MsgMarketUpdateUserSettleRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleRequest")
+	proto.RegisterType((*MsgMarketUpdateUserSettleResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleResponse")
+	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsRequest")
+	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsResponse")
+	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomRequest")
+	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomResponse")
+	proto.RegisterType((*MsgMarketUpdateRebatesRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateRebatesRequest")
+	proto.RegisterType((*MsgMarketUpdateRebatesResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateRebatesResponse")
+	proto.RegisterType((*MsgMarketUpdateExternalSettlementRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateExternalSettlementRequest")
+	proto.RegisterType((*MsgMarketUpdateExternalSettlementResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateExternalSettlementResponse")
+	proto.RegisterType((*MsgMarketUpdatePriceBandRequest)(nil), "provenance.exchange.v1.MsgMarketUpdatePriceBandRequest")
+	proto.RegisterType((*MsgMarketUpdatePriceBandResponse)(nil), "provenance.exchange.v1.MsgMarketUpdatePriceBandResponse")
+	proto.RegisterType((*MsgMarketResumeSettlementsRequest)(nil), "provenance.exchange.v1.MsgMarketResumeSettlementsRequest")
+	proto.RegisterType((*MsgMarketResumeSettlementsResponse)(nil), "provenance.exchange.v1.MsgMarketResumeSettlementsResponse")
+	proto.RegisterType((*MsgMarketStartExternalSettlementRequest)(nil), "provenance.exchange.v1.MsgMarketStartExternalSettlementRequest")
+	proto.RegisterType((*MsgMarketStartExternalSettlementResponse)(nil), "provenance.exchange.v1.MsgMarketStartExternalSettlementResponse")
+	proto.RegisterType((*MsgConfirmExternalPaymentRequest)(nil), "provenance.exchange.v1.MsgConfirmExternalPaymentRequest")
+	proto.RegisterType((*MsgConfirmExternalPaymentResponse)(nil), "provenance.exchange.v1.MsgConfirmExternalPaymentResponse")
+	proto.RegisterType((*MsgMarketManagePermissionsRequest)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsRequest")
+	proto.RegisterType((*MsgMarketManagePermissionsResponse)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsResponse")
+	proto.RegisterType((*MsgMarketManageReqAttrsRequest)(nil), "provenance.exchange.v1.MsgMarketManageReqAttrsRequest")
+	proto.RegisterType((*MsgMarketManageReqAttrsResponse)(nil), "provenance.exchange.v1.MsgMarketManageReqAttrsResponse")
+	proto.RegisterType((*MsgCreatePaymentRequest)(nil), "provenance.exchange.v1.MsgCreatePaymentRequest")
+	proto.RegisterType((*MsgCreatePaymentResponse)(nil), "provenance.exchange.v1.MsgCreatePaymentResponse")
+	proto.RegisterType((*MsgAcceptPaymentRequest)(nil), "provenance.exchange.v1.MsgAcceptPaymentRequest")
+	proto.RegisterType((*MsgAcceptPaymentResponse)(nil), "provenance.exchange.v1.MsgAcceptPaymentResponse")
+	proto.RegisterType((*MsgRejectPaymentRequest)(nil), "provenance.exchange.v1.MsgRejectPaymentRequest")
+	proto.RegisterType((*MsgRejectPaymentResponse)(nil), "provenance.exchange.v1.MsgRejectPaymentResponse")
+	proto.RegisterType((*MsgRejectPaymentsRequest)(nil), "provenance.exchange.v1.MsgRejectPaymentsRequest")
+	proto.RegisterType((*MsgRejectPaymentsResponse)(nil), "provenance.exchange.v1.MsgRejectPaymentsResponse")
+	proto.RegisterType((*MsgCancelPaymentsRequest)(nil), "provenance.exchange.v1.MsgCancelPaymentsRequest")
+	proto.RegisterType((*MsgCancelPaymentsResponse)(nil), "provenance.exchange.v1.MsgCancelPaymentsResponse")
+	proto.RegisterType((*MsgChangePaymentTargetRequest)(nil), "provenance.exchange.v1.MsgChangePaymentTargetRequest")
+	proto.RegisterType((*MsgChangePaymentTargetResponse)(nil), "provenance.exchange.v1.MsgChangePaymentTargetResponse")
+	proto.RegisterType((*MsgIssuerCreateMarketRequest)(nil), "provenance.exchange.v1.MsgIssuerCreateMarketRequest")
+	proto.RegisterType((*MsgIssuerCreateMarketResponse)(nil), "provenance.exchange.v1.MsgIssuerCreateMarketResponse")
+	proto.RegisterType((*MsgGovCreateMarketRequest)(nil), "provenance.exchange.v1.MsgGovCreateMarketRequest")
+	proto.RegisterType((*MsgGovCreateMarketResponse)(nil), "provenance.exchange.v1.MsgGovCreateMarketResponse")
+	proto.RegisterType((*MsgGovManageFeesRequest)(nil), "provenance.exchange.v1.MsgGovManageFeesRequest")
+	proto.RegisterType((*MsgGovManageFeesResponse)(nil), "provenance.exchange.v1.MsgGovManageFeesResponse")
+	proto.RegisterType((*MsgGovCloseMarketRequest)(nil), "provenance.exchange.v1.MsgGovCloseMarketRequest")
+	proto.RegisterType((*MsgGovCloseMarketResponse)(nil), "provenance.exchange.v1.MsgGovCloseMarketResponse")
+	proto.RegisterType((*MsgGovUpdateParamsRequest)(nil), "provenance.exchange.v1.MsgGovUpdateParamsRequest")
+	proto.RegisterType((*MsgGovUpdateParamsResponse)(nil), "provenance.exchange.v1.MsgGovUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.exchange.v1.MsgUpdateParamsRequest")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.exchange.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("provenance/exchange/v1/tx.proto", fileDescriptor_e333fcffc093bd1b) }
+
+var fileDescriptor_e333fcffc093bd1b = []byte{
+	// 3593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x5b, 0x6c, 0x1c, 0x57,
+	0x19, 0xce, 0x78, 0x7d, 0xfd, 0x6d, 0xa7, 0xf1, 0xd8, 0x49, 0xd6, 0x93, 0x64, 0xbd, 0xd9, 0x24,
+	0x34, 0x75, 0xe2, 0xb5, 0x93, 0xd2, 0xa4, 0x71, 0x5b, 0x5a, 0xaf, 0x53, 0xa7, 0x2e, 0x4a, 0x49,
+	0x37, 0x29, 0x95, 0x0a, 0xd2, 0x6a, 0xbc, 0x73, 0xbc, 0x19, 0xb2, 0x3b, 0xe3, 0xcc, 0x99, 0x75,
+	0x6c, 0x09, 0xc4, 0x45, 0x95, 0xb8, 0x48, 0x95, 0x2a, 0x21, 0x5e, 0x10, 0x42, 0x02, 0x44, 0x05,
+	0xf4, 0x81, 0x22, 0x2e, 0xe2, 0x22, 0xf1, 0x00, 0x12, 0xf4, 0xa1, 0x48, 0x15, 0xbc, 0xf0, 0x04,
+	0xa8, 0x95, 0xe8, 0x03, 0xcf, 0xbc, 0xf1, 0x80, 0xce, 0x39, 0xff, 0xdc, 0xaf, 0xbb, 0xe9, 0x46,
+	0x7d, 0x69, 0xb3, 0x73, 0xfe, 0xdb, 0xf7, 0xff, 0xe7, 0xf2, 0x9f, 0xf3, 0xff, 0x09, 0x2c, 0xec,
+	0x58, 0xe6, 0x2e, 0x31, 0x54, 0xa3, 0x49, 0x96, 0xc9, 0x5e, 0xf3, 0xb6, 0x6a, 0xb4, 0xc8, 0xf2,
+	0xee, 0x85, 0x65, 0x7b, 0xaf, 0xba, 0x63, 0x99, 0xb6, 0x29, 0x1f, 0xf1, 0x08, 0xaa, 0x0e, 0x41,
+	0x75, 0xf7, 0x82, 0x32, 0xa3, 0x76, 0x74, 0xc3, 0x5c, 0xe6, 0xff, 0x15, 0xa4, 0x4a, 0xa9, 0x69,
+	0xd2, 0x8e, 0x49, 0x97, 0xb7, 0x54, 0xca, 0x64, 0x6c, 0x11, 0x5b, 0xbd, 0xb0, 0xdc, 0x34, 0x75,
+	0x03, 0xc7, 0x8f, 0xe2, 0x78, 0x87, 0xb6, 0x98, 0x8a, 0x0e, 0x6d, 0xe1, 0xc0, 0xbc, 0x18, 0x68,
+	0xf0, 0x5f, 0xcb, 0xe2, 0x07, 0x0e, 0xcd, 0xb5, 0xcc, 0x96, 0x29, 0xbe, 0xb3, 0x3f, 0xe1, 0xd7,
+	0xb3, 0x09, 0x56, 0x37, 0xcd, 0x4e, 0x47, 0xb7, 0x3b, 0xc4, 0xb0, 0x1d, 0xfe, 0x95, 0x04, 0x4a,
+	0xb2, 0x67, 0x13, 0xcb, 0x50, 0xdb, 0x0d, 0x4a, 0x6c, 0xbb, 0x4d, 0x18, 0x0b, 0x72, 0x9c, 0x4a,
+	0xe0, 0xe8, 0xa8, 0xd6, 0x1d, 0x92, 0x45, 0x64, 0x5a, 0x1a, 0xb1, 0x68, 0x06, 0xd1, 0x8e, 0x6a,
+	0xa9, 0x1d, 0x87, 0xe8, 0x4c, 0x22, 0xd1, 0xbe, 0x1f, 0xc7, 0xc3, 0x49, 0x64, 0x96, 0xde, 0x24,
+	0x8d, 0x2d, 0xd5, 0xd0, 0x90, 0xf0, 0x74, 0x02, 0xa1, 0x45, 0xb6, 0x54, 0x9b, 0x38, 0xe2, 0xca,
+	0x49, 0x54, 0xdb, 0x77, 0x05, 0x45, 0xe5, 0xe7, 0x12, 0xcc, 0x5e, 0xa7, 0xad, 0x75, 0x8b, 0xa8,
+	0x36, 0x59, 0xa3, 0x77, 0xea, 0xe4, 0x6e, 0x97, 0x50, 0x5b, 0x5e, 0x87, 0x09, 0x95, 0xde, 0x69,
+	0x70, 0xa0, 0x45, 0xa9, 0x2c, 0x9d, 0x9d, 0xbc, 0x58, 0xae, 0xc6, 0xcf, 0x91, 0xea, 0x1a, 0xbd,
+	0xf3, 0x29, 0x46, 0x57, 0x1b, 0x7e, 0xfb, 0x1f, 0x0b, 0x07, 0xea, 0xe3, 0x2a, 0xfe, 0x96, 0xaf,
+	0x81, 0xcc, 0x05, 0x34, 0x9a, 0x4c, 0xbc, 0x6e, 0x1a, 0x8d, 0x6d, 0x42, 0x8a, 0x43, 0x5c, 0xda,
+	0x7c, 0x15, 0x27, 0x00, 0x9b, 0x46, 0x55, 0x9c, 0x46, 0xd5, 0x75, 0x53, 0x37, 0xea, 0x87, 0x38,
+	0xd3, 0x3a, 0xf2, 0x6c, 0x10, 0xb2, 0x7a, 0xf0, 0x2b, 0x1f, 0xbc, 0xb5, 0xe8, 0x19, 0x54, 0xb9,
+	0x00, 0x73, 0x41, 0xa3, 0xe9, 0x8e, 0x69, 0x50, 0x22, 0xcf, 0xc3, 0xb8, 0x50, 0xa8, 0x6b, 0xdc,
+	0xe8, 0xe1, 0xfa, 0x18, 0xff, 0xbd, 0xa9, 0x05, 0x81, 0xd6, 0x74, 0xcd, 0x07, 0x74, 0x4b, 0xd7,
+	0xf2, 0x01, 0xad, 0xe9, 0x5a, 0x00, 0xe8, 0x96, 0xae, 0x0d, 0x04, 0xa8, 0x6b, 0x50, 0x00, 0x28,
+	0x37, 0x3a, 0x1b, 0xe8, 0x3b, 0x43, 0x70, 0x98, 0xf1, 0xf0, 0x35, 0xb2, 0xd1, 0x35, 0x34, 0xea,
+	0x40, 0xbd, 0x08, 0x63, 0x6a, 0xb3, 0x69, 0x76, 0x0d, 0x9b, 0xf3, 0x4c, 0xd4, 0x8a, 0x7f, 0xfd,
+	0xc5, 0xd2, 0x1c, 0x5a, 0xb7, 0xa6, 0x69, 0x16, 0xa1, 0xf4, 0xa6, 0x6d, 0xe9, 0x46, 0xab, 0xee,
+	0x10, 0xca, 0xc7, 0x60, 0x42, 0xac, 0x08, 0xa6, 0x89, 0x01, 0x9a, 0xae, 0x8f, 0x8b, 0x0f, 0x9b,
+	0x9a, 0xbc, 0x0f, 0xa3, 0x6a, 0x87, 0xcb, 0x2b, 0x94, 0x0b, 0xa9, 0x50, 0x6b, 0x1b, 0xcc, 0x63,
+	0x3f, 0xf9, 0xe7, 0xc2, 0xd9, 0x96, 0x6e, 0xdf, 0xee, 0x6e, 0x55, 0x9b, 0x66, 0x07, 0x77, 0x00,
+	0xfc, 0xdf, 0x12, 0xd5, 0xee, 0x2c, 0xdb, 0xfb, 0x3b, 0x84, 0x72, 0x06, 0xfa, 0xed, 0x0f, 0xde,
+	0x5a, 0x9c, 0x6a, 0x93, 0x96, 0xda, 0xdc, 0x6f, 0xb0, 0xcd, 0x85, 0xfe, 0xe8, 0x83, 0xb7, 0x16,
+	0xa5, 0x3a, 0x2a, 0x94, 0x9f, 0x84, 0xa9, 0x80, 0xaf, 0x87, 0xb3, 0x7c, 0x3d, 0xd9, 0xf4, 0xdc,
+	0xcc, 0x50, 0x91, 0x5d, 0x62, 0xd8, 0x0d, 0x5b, 0x6d, 0x15, 0x47, 0x98, 0x2f, 0xea, 0xe3, 0xfc,
+	0xc3, 0x2d, 0xb5, 0xb5, 0x3a, 0xc5, 0x62, 0xe0, 0x38, 0xa0, 0x52, 0x84, 0x23, 0x61, 0x6f, 0x8a,
+	0x18, 0x54, 0xee, 0x0a, 0x3f, 0xb3, 0x59, 0xd2, 0xe6, 0xd3, 0xc0, 0xf1, 0xf3, 0x0a, 0x8c, 0x52,
+	0xbd, 0x65, 0x10, 0x2b, 0xd3, 0xcd, 0x48, 0x17, 0x08, 0xe7, 0x50, 0x20, 0x9c, 0xab, 0x93, 0xcc,
+	0x1a, 0xa4, 0x73, 0x8c, 0xf1, 0xab, 0x44, 0x63, 0xfe, 0x58, 0x00, 0xf9, 0x3a, 0x6d, 0x6d, 0xe8,
+	0xed, 0x76, 0x4d, 0xd7, 0xa8, 0xdf, 0x14, 0xd2, 0x6e, 0xe7, 0x32, 0x85, 0xd3, 0xa5, 0x07, 0xfc,
+	0x55, 0x09, 0xa6, 0x6c, 0xd3, 0x56, 0xdb, 0x0d, 0x95, 0x52, 0x62, 0xd3, 0x07, 0x17, 0xf7, 0x49,
+	0xae, 0x76, 0x8d, 0x6b, 0x95, 0x2b, 0x30, 0xed, 0x2e, 0x91, 0x86, 0xae, 0xd1, 0xe2, 0x70, 0xb9,
+	0x70, 0x76, 0xb8, 0x3e, 0xe9, 0xac, 0xc7, 0x4d, 0x8d, 0xca, 0x9f, 0x06, 0x45, 0x20, 0xf2, 0x6d,
+	0xfd, 0x8d, 0xed, 0xb6, 0x6a, 0xf3, 0xe9, 0x32, 0x92, 0x35, 0x5d, 0x8e, 0x0a, 0xe6, 0x9b, 0x2e,
+	0xef, 0x46, 0x5b, 0xb5, 0xd9, 0xd4, 0x79, 0x01, 0x8e, 0xb8, 0xfb, 0x50, 0x70, 0xb9, 0x8f, 0x66,
+	0xc9, 0x9c, 0x75, 0x36, 0x46, 0xff, 0x8a, 0xc7, 0xf8, 0x72, 0x6d, 0x95, 0xc3, 0x30, 0x1b, 0x08,
+	0x22, 0x06, 0xf7, 0x77, 0x5e, 0x70, 0xd7, 0xe8, 0x1d, 0x37, 0xb8, 0x55, 0x18, 0xd9, 0xea, 0xee,
+	0xe7, 0x88, 0xad, 0x20, 0x4b, 0x0f, 0xed, 0x33, 0x20, 0x5c, 0xdc, 0xe0, 0x47, 0x4d, 0xb1, 0x90,
+	0x01, 0x06, 0xb7, 0x40, 0xe0, 0x3c, 0x37, 0x18, 0x0b, 0x8b, 0x8a, 0xe7, 0x19, 0x5f, 0x54, 0x1c,
+	0xd4, 0x2c, 0x2a, 0xdf, 0x92, 0xe0, 0x30, 0x37, 0x26, 0x10, 0x15, 0x42, 0x68, 0x71, 0xe4, 0x41,
+	0xcd, 0xa4, 0x59, 0xae, 0xdf, 0x17, 0x58, 0x42, 0x28, 0x8b, 0xaa, 0x37, 0xa3, 0x7a, 0x8c, 0xaa,
+	0x33, 0xeb, 0xfc, 0x51, 0x05, 0x16, 0x55, 0xe1, 0x76, 0x5f, 0x50, 0x45, 0xf0, 0x30, 0xa8, 0xbf,
+	0x1c, 0xe2, 0x8b, 0xf9, 0x3a, 0x0f, 0x80, 0x30, 0xc7, 0x17, 0x58, 0x55, 0xeb, 0xe8, 0x46, 0x76,
+	0x60, 0x39, 0x59, 0x7a, 0x60, 0x23, 0x61, 0x29, 0x44, 0xc3, 0x92, 0x67, 0x41, 0x9d, 0x81, 0x83,
+	0x64, 0x6f, 0x87, 0x34, 0xed, 0xc6, 0x8e, 0x6a, 0xd9, 0xba, 0xda, 0xe6, 0x8b, 0x68, 0xbc, 0x3e,
+	0x2d, 0xbe, 0xde, 0x10, 0x1f, 0xe5, 0x53, 0x30, 0x6d, 0x10, 0xbb, 0x61, 0x5b, 0xaa, 0x41, 0xb7,
+	0x89, 0x45, 0xb9, 0x03, 0xc7, 0xeb, 0x53, 0x06, 0xb1, 0x6f, 0x39, 0xdf, 0xe4, 0x2a, 0xcc, 0x9a,
+	0xbb, 0xc4, 0xb2, 0x74, 0x8d, 0x34, 0xbc, 0xd4, 0xa6, 0x38, 0xc6, 0x49, 0x67, 0x9c, 0x21, 0x3e,
+	0xad, 0x6a, 0xaa, 0xa1, 0xa1, 0x3b, 0x39, 0xd8, 0xca, 0x3c, 0x1c, 0x8d, 0xb8, 0x0d, 0x5d, 0xfa,
+	0x5f, 0xff, 0x19, 0x5f, 0xdf, 0x78, 0x71, 0x20, 0x0b, 0xe5, 0x32, 0x8c, 0xba, 0x9b, 0x5f, 0xae,
+	0x35, 0x82, 0xe4, 0xf2, 0x02, 0x4c, 0x0a, 0xac, 0x1a, 0x31, 0xcc, 0x0e, 0x3f, 0xb1, 0x26, 0xea,
+	0xc0, 0x3f, 0x5d, 0x65, 0x5f, 0xe4, 0x73, 0x30, 0x43, 0xf6, 0x76, 0x74, 0x4b, 0x4c, 0xbe, 0xdb,
+	0x44, 0x6f, 0xdd, 0xb6, 0xb9, 0x93, 0x0b, 0xf5, 0x43, 0xde, 0xc0, 0x73, 0xfc, 0x7b, 0x60, 0x86,
+	0x2d, 0xc1, 0x5c, 0x10, 0x36, 0x66, 0x09, 0x87, 0x61, 0xd4, 0xda, 0xbe, 0xeb, 0xe5, 0x08, 0x23,
+	0xd6, 0xf6, 0xdd, 0x4d, 0xad, 0x72, 0x1b, 0x66, 0xdd, 0x53, 0xe4, 0x3e, 0xbc, 0xe4, 0x49, 0x1f,
+	0xf2, 0x49, 0x0f, 0x18, 0x76, 0x04, 0xe6, 0x82, 0x9a, 0x30, 0x50, 0xff, 0x93, 0xf8, 0xd9, 0x79,
+	0xb3, 0xbb, 0xd5, 0xd1, 0xed, 0x17, 0xbb, 0xa6, 0x4d, 0x7c, 0x07, 0x96, 0x46, 0xd4, 0x5c, 0x07,
+	0x96, 0xa0, 0x4b, 0x30, 0x43, 0x7e, 0x0c, 0x46, 0x7a, 0xda, 0xc9, 0x04, 0x75, 0xc6, 0xb1, 0x31,
+	0xdc, 0xef, 0xb1, 0x81, 0xdb, 0xbc, 0x30, 0x19, 0x8f, 0xf1, 0x00, 0x7a, 0x74, 0x8c, 0xc9, 0x27,
+	0xf7, 0xcb, 0xba, 0x7d, 0x5b, 0xb3, 0xd4, 0x7b, 0x03, 0xf1, 0x4c, 0xd0, 0x14, 0x05, 0x8a, 0x51,
+	0x85, 0x68, 0xcc, 0x7f, 0x44, 0x26, 0xb9, 0xd6, 0x6c, 0x92, 0x9d, 0x60, 0x94, 0x3e, 0x9c, 0xa9,
+	0xe2, 0x83, 0x54, 0xc8, 0x09, 0xc9, 0x8d, 0xea, 0x70, 0x4f, 0x51, 0xfd, 0x88, 0x1e, 0x3b, 0x81,
+	0xb5, 0xf2, 0x59, 0x38, 0x12, 0x76, 0x36, 0x2e, 0xe3, 0x32, 0x4c, 0xf9, 0x77, 0x70, 0x5c, 0xcc,
+	0xe0, 0x6d, 0xe0, 0x8c, 0xc2, 0xbf, 0x7f, 0xa3, 0x97, 0xc1, 0xdb, 0xbe, 0x2b, 0x6f, 0x14, 0xa0,
+	0xec, 0x6e, 0x9b, 0xeb, 0xee, 0xfd, 0x79, 0x80, 0xe7, 0xce, 0x3a, 0x8c, 0xea, 0xc6, 0x4e, 0xd7,
+	0x4d, 0x12, 0xcf, 0x24, 0x5e, 0x1f, 0x45, 0xa6, 0xbd, 0xc6, 0x13, 0x7b, 0x67, 0xcf, 0x14, 0xac,
+	0xf2, 0xb3, 0x30, 0x66, 0x76, 0x6d, 0x2e, 0x65, 0xb8, 0x77, 0x29, 0x0e, 0xaf, 0xfc, 0x34, 0x0c,
+	0xfb, 0xa2, 0xdd, 0x93, 0x0c, 0xce, 0xc8, 0x04, 0x18, 0xea, 0x2e, 0x3b, 0xcc, 0x52, 0x05, 0xbc,
+	0x40, 0x6c, 0x9e, 0xa2, 0x8a, 0x93, 0x0b, 0x05, 0x30, 0xc6, 0xe0, 0x8d, 0x63, 0x2c, 0x74, 0xe3,
+	0xf0, 0x1f, 0x6f, 0xa7, 0xe0, 0x64, 0x4a, 0x9c, 0x70, 0x65, 0xfe, 0x5b, 0x82, 0x8a, 0x4b, 0x55,
+	0x27, 0x6d, 0xa2, 0x52, 0xe2, 0x11, 0xd3, 0x81, 0xc4, 0xf3, 0x79, 0x00, 0xdb, 0x6c, 0x58, 0x42,
+	0x59, 0x3f, 0x31, 0x9d, 0xb0, 0x4d, 0x34, 0x35, 0xe8, 0x8d, 0xe1, 0x14, 0x6f, 0x9c, 0x81, 0x53,
+	0xa9, 0x38, 0xd1, 0x1f, 0xbf, 0xf1, 0xfb, 0xe3, 0x26, 0xb1, 0xf9, 0xac, 0x7f, 0x16, 0xdf, 0x7e,
+	0x36, 0xaf, 0x0e, 0xc4, 0x1f, 0xfe, 0x3b, 0x5b, 0x21, 0x70, 0x67, 0x63, 0x27, 0xbd, 0xfb, 0xf0,
+	0xa4, 0x6b, 0xce, 0x49, 0xef, 0x7c, 0xda, 0xd4, 0x12, 0x21, 0xc6, 0x99, 0x8e, 0x10, 0x5f, 0x1b,
+	0x82, 0xa2, 0x4b, 0xe7, 0xec, 0xd7, 0x03, 0x01, 0x76, 0x82, 0x07, 0x5a, 0x15, 0x7c, 0x62, 0x67,
+	0x66, 0xb1, 0x43, 0x41, 0xbe, 0x4b, 0xff, 0xf0, 0x03, 0xbe, 0xf4, 0x07, 0xdc, 0x76, 0x0c, 0xe6,
+	0x63, 0xdc, 0x81, 0xce, 0x7a, 0x47, 0x82, 0x13, 0xee, 0xe8, 0x4b, 0x3b, 0x9a, 0x6a, 0x93, 0xab,
+	0xc4, 0x56, 0xf5, 0xf6, 0x60, 0x96, 0x46, 0x1d, 0x0e, 0xe2, 0xa0, 0x26, 0xb4, 0x60, 0xd2, 0x91,
+	0xb8, 0x3c, 0x84, 0x61, 0x68, 0x12, 0x2e, 0x8f, 0xe9, 0x8e, 0xff, 0x63, 0x00, 0x6b, 0x19, 0x4a,
+	0x49, 0x68, 0x10, 0xf0, 0x4f, 0xa3, 0x80, 0x9f, 0x35, 0xd4, 0xad, 0x36, 0xd1, 0xbc, 0xf4, 0x21,
+	0x00, 0x58, 0x49, 0x02, 0x5c, 0x94, 0x1c, 0xc8, 0x0b, 0x11, 0xc8, 0xb5, 0xa1, 0xa2, 0xe4, 0x83,
+	0xbd, 0x04, 0x87, 0x54, 0x7e, 0x5c, 0xe9, 0x46, 0x4b, 0x9c, 0x3d, 0x02, 0xf8, 0x38, 0xa7, 0x7b,
+	0xc8, 0x1d, 0xe3, 0x53, 0x9a, 0x8a, 0x77, 0x15, 0xc7, 0x88, 0xca, 0x69, 0x28, 0x25, 0x19, 0x2c,
+	0x30, 0xad, 0x0e, 0x15, 0xa5, 0xca, 0x9b, 0x12, 0x9c, 0x09, 0x91, 0xad, 0x05, 0xc5, 0x0e, 0x24,
+	0xa0, 0x8f, 0x24, 0x21, 0x8b, 0xa2, 0xf2, 0xc7, 0xe9, 0x2c, 0x7c, 0x2c, 0xcb, 0x58, 0x2f, 0x5e,
+	0xe5, 0x10, 0xe9, 0x4b, 0xd4, 0x49, 0x0f, 0x06, 0x02, 0xe9, 0x22, 0x1c, 0x56, 0xdb, 0x6d, 0xf3,
+	0x5e, 0xa3, 0x4b, 0x03, 0x69, 0x10, 0xe2, 0x9a, 0xe5, 0x83, 0x9e, 0x0d, 0x6c, 0x28, 0xf1, 0x5c,
+	0x8a, 0x1a, 0x8c, 0xb0, 0x7e, 0x2b, 0xc1, 0x62, 0x92, 0x07, 0x06, 0x7d, 0x3e, 0x3d, 0x0a, 0x87,
+	0xbd, 0x98, 0xf9, 0x2a, 0x04, 0x08, 0x70, 0x4e, 0x8d, 0x31, 0x24, 0x80, 0x70, 0x09, 0xce, 0xe5,
+	0xb2, 0x1d, 0xb1, 0xfe, 0x4c, 0x82, 0x87, 0x43, 0xf4, 0x9b, 0x86, 0x4d, 0xac, 0x0e, 0xd1, 0x74,
+	0xd5, 0xda, 0xe7, 0x57, 0xba, 0x81, 0x00, 0x5d, 0x02, 0x59, 0xf7, 0x29, 0xc2, 0xeb, 0xa4, 0xd8,
+	0xa7, 0x67, 0xf4, 0xb0, 0x09, 0x01, 0x88, 0x8b, 0x70, 0x36, 0xdb, 0x64, 0xc4, 0xf7, 0x87, 0xe8,
+	0x96, 0x52, 0x17, 0xc5, 0x85, 0x81, 0xa0, 0x7a, 0x0e, 0x46, 0x9b, 0xa6, 0xb1, 0xad, 0xb7, 0x70,
+	0xef, 0x5c, 0x4c, 0xdf, 0x3b, 0x85, 0x29, 0xeb, 0x9c, 0xc3, 0xc9, 0x19, 0x05, 0x7f, 0xc6, 0xce,
+	0xe9, 0x62, 0x40, 0x98, 0x7f, 0x93, 0x22, 0x3e, 0x71, 0x4e, 0x5f, 0x6f, 0x25, 0x0c, 0x04, 0xf1,
+	0x0b, 0x21, 0xc4, 0x2b, 0x49, 0x88, 0xa3, 0xf6, 0x64, 0xe2, 0x3e, 0x07, 0x8f, 0xe4, 0x00, 0x85,
+	0x2e, 0xf8, 0xb3, 0x04, 0x0b, 0x21, 0x6a, 0xf7, 0xe9, 0x65, 0x20, 0xc8, 0x3f, 0x19, 0x42, 0xbe,
+	0x94, 0x1e, 0x6b, 0xd7, 0x98, 0x4c, 0xd8, 0x95, 0xc8, 0xae, 0xea, 0x03, 0x82, 0x68, 0x3f, 0xef,
+	0xdb, 0xc8, 0xea, 0x84, 0x76, 0x3b, 0xc4, 0x73, 0xc9, 0x40, 0xa6, 0x76, 0xc0, 0xc2, 0xd3, 0x50,
+	0x49, 0xd3, 0x8e, 0x36, 0xfe, 0xc5, 0xbf, 0xb7, 0xdc, 0xb4, 0x55, 0xcb, 0x7e, 0x40, 0x73, 0x32,
+	0x25, 0xa9, 0x75, 0xef, 0xfc, 0xc3, 0xb9, 0xee, 0xfc, 0x01, 0xd4, 0x2f, 0xc3, 0xd9, 0x6c, 0x38,
+	0x78, 0xdb, 0x8d, 0x7d, 0x05, 0x93, 0xe2, 0x5f, 0xc1, 0x2a, 0x7f, 0x12, 0xe7, 0x28, 0x9f, 0x18,
+	0x56, 0xc7, 0x91, 0x7a, 0x43, 0xdd, 0xf7, 0x7b, 0x68, 0x05, 0x46, 0x4d, 0x4b, 0x6d, 0xb6, 0x49,
+	0xf6, 0xcb, 0x89, 0xa0, 0xeb, 0xdb, 0x47, 0xe7, 0x60, 0x06, 0x0b, 0xba, 0x0d, 0x8b, 0x6c, 0x13,
+	0x8b, 0x18, 0xf8, 0x54, 0x31, 0x51, 0x3f, 0xb4, 0xe3, 0x18, 0x85, 0xdf, 0xf1, 0x1d, 0x46, 0x68,
+	0xc4, 0xe3, 0x35, 0x09, 0x07, 0x4e, 0x8b, 0x1f, 0x0f, 0xf9, 0xe6, 0xee, 0x75, 0xd5, 0x50, 0x5b,
+	0xe4, 0x06, 0xb1, 0x3a, 0x3a, 0xa5, 0xba, 0x69, 0xd0, 0x41, 0x5d, 0x06, 0x2c, 0xb2, 0x6b, 0xde,
+	0x21, 0x0d, 0xb5, 0xdd, 0xe6, 0xb7, 0xbe, 0x89, 0xfa, 0x84, 0xf8, 0xb2, 0xd6, 0x6e, 0xcb, 0x1b,
+	0x30, 0xc1, 0x2f, 0x85, 0xec, 0x37, 0xde, 0x07, 0x4e, 0xa5, 0xdc, 0x09, 0x09, 0xa5, 0xd7, 0x2c,
+	0xd5, 0xbd, 0x11, 0x8e, 0xb3, 0x1b, 0x21, 0x63, 0x95, 0xaf, 0xc2, 0xb8, 0x6d, 0x36, 0x5a, 0x6c,
+	0xac, 0x38, 0xd2, 0xab, 0x98, 0x31, 0xdb, 0xe4, 0x3f, 0x13, 0x17, 0x5a, 0x8c, 0xab, 0x1c, 0x8f,
+	0x16, 0xa0, 0x14, 0x22, 0xab, 0x93, 0xbb, 0x6b, 0xb6, 0x3d, 0xb0, 0xc4, 0x72, 0x86, 0x57, 0x17,
+	0x48, 0x83, 0xbd, 0xe8, 0x88, 0x6b, 0x16, 0x7a, 0xf5, 0x60, 0xd3, 0x29, 0x67, 0xdf, 0x62, 0x77,
+	0x2d, 0x79, 0x19, 0xe6, 0x82, 0xa4, 0x16, 0xe9, 0x98, 0xbb, 0xc2, 0xcb, 0x13, 0xf5, 0x19, 0x1f,
+	0x75, 0x9d, 0x0f, 0xf8, 0x64, 0xb3, 0xb7, 0x20, 0x94, 0x3d, 0xe2, 0x97, 0x5d, 0xd3, 0xb5, 0xb0,
+	0x6c, 0x24, 0x45, 0xd9, 0xa3, 0x7e, 0xd9, 0x9c, 0x1a, 0x65, 0x5f, 0x86, 0x22, 0x32, 0x78, 0x99,
+	0x95, 0xa3, 0x62, 0x8c, 0x33, 0x1d, 0x16, 0xe3, 0x5e, 0xa6, 0x24, 0x34, 0x3d, 0x05, 0xc7, 0x62,
+	0x19, 0x51, 0xe1, 0x38, 0xe7, 0x2d, 0x46, 0x79, 0x85, 0xde, 0x40, 0x44, 0x4f, 0xc2, 0x42, 0x62,
+	0xa8, 0x30, 0x9c, 0xaf, 0xc0, 0x51, 0xf7, 0x21, 0x3c, 0xb4, 0x09, 0x3c, 0x0d, 0x63, 0xb8, 0x02,
+	0xb1, 0xca, 0xbf, 0x90, 0x34, 0xc1, 0x90, 0xd1, 0x99, 0x5c, 0xc8, 0x85, 0x2f, 0xa5, 0x21, 0xd9,
+	0x01, 0xbd, 0x22, 0x5d, 0x1c, 0x8c, 0xde, 0x90, 0x6c, 0xd4, 0xfb, 0xa6, 0xc4, 0x15, 0xd7, 0xc9,
+	0xe7, 0x48, 0xd3, 0x1b, 0x74, 0x77, 0x3d, 0x5b, 0xb5, 0x5a, 0x24, 0xbb, 0xd8, 0x8f, 0x74, 0x8c,
+	0x83, 0x9a, 0x5d, 0xab, 0x29, 0x3a, 0x17, 0x52, 0x39, 0x04, 0x5d, 0xf8, 0xa1, 0xa3, 0x10, 0x79,
+	0xe8, 0x10, 0x7b, 0x9c, 0x90, 0x8f, 0x48, 0x42, 0xc6, 0x3a, 0xcf, 0x1b, 0x52, 0x74, 0x90, 0xf6,
+	0x0f, 0xe5, 0x22, 0x8c, 0x09, 0x13, 0x69, 0x71, 0xa8, 0x5c, 0x48, 0x65, 0x71, 0x08, 0x83, 0xb6,
+	0x8a, 0xe7, 0x85, 0xb0, 0x39, 0x6e, 0x0a, 0x51, 0x74, 0xcb, 0x1a, 0x31, 0xb6, 0xa2, 0x13, 0xa5,
+	0x9c, 0x4e, 0x3c, 0x09, 0x53, 0x3e, 0x27, 0xa2, 0xc1, 0xf5, 0x49, 0xcf, 0x8b, 0x8e, 0x69, 0x82,
+	0x1e, 0x4d, 0x0b, 0x6b, 0x47, 0xd3, 0x7e, 0x2d, 0xb2, 0xf6, 0x75, 0x3e, 0xab, 0x70, 0xf4, 0x16,
+	0x87, 0xd4, 0xbf, 0x81, 0xa1, 0x28, 0x0f, 0x85, 0xa3, 0x2c, 0x5f, 0x06, 0x30, 0xc8, 0xbd, 0x06,
+	0xc6, 0x28, 0xeb, 0x2d, 0x7f, 0xc2, 0x20, 0xf7, 0x84, 0x49, 0x41, 0x5c, 0x22, 0x57, 0x8f, 0xb5,
+	0x1c, 0xc1, 0xfd, 0x5e, 0x82, 0xe3, 0xd7, 0x69, 0x6b, 0x93, 0xd2, 0x2e, 0x96, 0x5b, 0x89, 0x93,
+	0x49, 0xb9, 0xd8, 0x74, 0x3e, 0x98, 0x8d, 0x4d, 0xd0, 0x31, 0xe7, 0xf3, 0xcd, 0xd9, 0xc2, 0x6b,
+	0x94, 0x00, 0x37, 0x29, 0xbe, 0x89, 0xb2, 0xdc, 0x93, 0x30, 0xca, 0x7f, 0xda, 0x98, 0xad, 0x96,
+	0xd2, 0xb3, 0x55, 0x27, 0x3d, 0x15, 0x3c, 0x08, 0x51, 0x68, 0xab, 0x3c, 0x09, 0x27, 0x12, 0xec,
+	0xc7, 0xe4, 0x27, 0x70, 0x78, 0x48, 0xc1, 0xc3, 0xa3, 0xf2, 0x3d, 0x89, 0x47, 0xfe, 0x9a, 0xb9,
+	0x1b, 0x87, 0xfd, 0x12, 0x4c, 0xa8, 0x5d, 0xfb, 0xb6, 0x69, 0xe9, 0xf6, 0x7e, 0x26, 0x7c, 0x8f,
+	0xd4, 0x07, 0x6f, 0xa8, 0x0f, 0x78, 0xd8, 0x99, 0xe5, 0x48, 0xab, 0x1c, 0x07, 0x25, 0xce, 0x44,
+	0x0c, 0xe0, 0xaf, 0xa6, 0xf9, 0x7e, 0x75, 0xcd, 0xdc, 0x15, 0x1b, 0xf8, 0x06, 0x21, 0xf4, 0x7e,
+	0xed, 0x4f, 0x3d, 0x6f, 0x5f, 0x82, 0xa3, 0xaa, 0xa6, 0xb1, 0x52, 0x4f, 0xc3, 0x77, 0x98, 0xb2,
+	0x7a, 0x5e, 0x76, 0xeb, 0x8a, 0x00, 0x3a, 0xab, 0x6a, 0xda, 0x06, 0x21, 0x6e, 0xaf, 0x19, 0x2b,
+	0xe8, 0xc9, 0x9f, 0x01, 0x45, 0x1c, 0x60, 0xb1, 0x92, 0x87, 0xf3, 0x49, 0x3e, 0x22, 0x44, 0x44,
+	0x84, 0x47, 0x6d, 0x66, 0x87, 0x34, 0x97, 0x3c, 0xd2, 0x87, 0xcd, 0x35, 0x5d, 0x4b, 0xb6, 0xd9,
+	0x95, 0x3c, 0xda, 0x9f, 0xcd, 0x8e, 0xf0, 0x26, 0x94, 0x1c, 0x9b, 0xe3, 0xcb, 0xa7, 0xc5, 0xb1,
+	0x7c, 0x0a, 0x14, 0x61, 0xfa, 0xcd, 0x98, 0x32, 0xaa, 0xac, 0xc3, 0x49, 0x1f, 0x82, 0x04, 0x3d,
+	0xe3, 0xf9, 0xf4, 0x9c, 0x70, 0x81, 0xc4, 0xaa, 0x32, 0xa0, 0x9c, 0x8c, 0x87, 0xdf, 0x40, 0x68,
+	0x71, 0xa2, 0x5c, 0x48, 0x6b, 0x16, 0xdc, 0x20, 0xa4, 0xce, 0x08, 0x51, 0xe1, 0xf1, 0x78, 0x60,
+	0x9c, 0x84, 0xca, 0x36, 0x9c, 0x4a, 0x85, 0x86, 0x2a, 0xa1, 0x27, 0x95, 0x0b, 0x89, 0x18, 0x51,
+	0xab, 0x0a, 0x27, 0x1c, 0x94, 0xd1, 0xea, 0x28, 0x73, 0xe6, 0x64, 0x3e, 0x67, 0xce, 0x0b, 0x6c,
+	0xb5, 0xee, 0x7e, 0xc4, 0x91, 0x2d, 0x28, 0xfb, 0x80, 0xc5, 0x6b, 0x99, 0xca, 0xa7, 0xe5, 0xb8,
+	0x0b, 0x27, 0x4e, 0x51, 0x1b, 0x16, 0x12, 0xb1, 0xa0, 0xf7, 0xa6, 0x7b, 0xf2, 0xde, 0xb1, 0x58,
+	0x50, 0xe8, 0x39, 0x0b, 0x2a, 0x69, 0xb0, 0x50, 0xe1, 0xc1, 0x9e, 0x14, 0x96, 0x92, 0xf0, 0xa1,
+	0x4e, 0xdf, 0x1a, 0x8b, 0xa6, 0xd4, 0xdc, 0x91, 0x0f, 0xf5, 0xb4, 0xc6, 0xd6, 0x43, 0x49, 0x77,
+	0xcc, 0x1a, 0x4b, 0xd0, 0x73, 0xa8, 0xd7, 0x35, 0x16, 0xab, 0xea, 0x79, 0xa8, 0x50, 0x62, 0x0b,
+	0x3d, 0x9e, 0x02, 0x9f, 0x17, 0xb7, 0xf4, 0x1d, 0x5a, 0x9c, 0xe1, 0x3b, 0x7a, 0x89, 0x12, 0x9b,
+	0xc9, 0x09, 0x15, 0x44, 0xd9, 0x9f, 0x6a, 0xfa, 0x0e, 0xeb, 0xdf, 0x3a, 0xdd, 0x35, 0x72, 0x48,
+	0x93, 0xf9, 0x5b, 0x70, 0xb9, 0x6b, 0xa4, 0xcb, 0x8b, 0x1c, 0x6b, 0x22, 0x75, 0x0d, 0x9d, 0x5b,
+	0x78, 0xa8, 0x7d, 0xd1, 0x19, 0x5b, 0x6f, 0x9b, 0xf4, 0x43, 0x3a, 0x94, 0x53, 0xdf, 0x93, 0xc2,
+	0xc6, 0x1d, 0x83, 0xf9, 0x18, 0x03, 0xd0, 0xba, 0x1f, 0xb8, 0x49, 0x03, 0x3e, 0x88, 0xf1, 0xae,
+	0xf4, 0x0f, 0x21, 0x69, 0x10, 0xed, 0xed, 0x59, 0x49, 0x83, 0x50, 0xe7, 0x24, 0x0d, 0x82, 0x67,
+	0xf5, 0x50, 0x10, 0x40, 0x51, 0xaa, 0x94, 0x41, 0x89, 0x33, 0xd2, 0x57, 0x09, 0xfa, 0xae, 0xc4,
+	0xfb, 0x23, 0x3e, 0x3a, 0x20, 0xc2, 0x51, 0x10, 0x7d, 0x69, 0x71, 0xf6, 0x5f, 0x7c, 0x63, 0x11,
+	0x0a, 0xd7, 0x69, 0x4b, 0xde, 0x86, 0x09, 0xf7, 0xa8, 0x97, 0xcf, 0x25, 0xe6, 0x59, 0xd1, 0x76,
+	0x7c, 0xe5, 0x7c, 0x3e, 0x62, 0xcc, 0x22, 0x5d, 0x3d, 0x35, 0x5d, 0xcb, 0xa1, 0xc7, 0xeb, 0x86,
+	0x57, 0xce, 0xe7, 0x23, 0x46, 0x3d, 0x6d, 0x98, 0xf4, 0x35, 0x46, 0xcb, 0x4b, 0x69, 0xcc, 0x91,
+	0x76, 0x74, 0xa5, 0x9a, 0x97, 0xdc, 0xa7, 0xcd, 0xeb, 0x7c, 0x4e, 0xd7, 0x16, 0x69, 0xca, 0x56,
+	0xaa, 0x79, 0xc9, 0x51, 0x5b, 0x13, 0xc6, 0x9d, 0x3e, 0x5c, 0x79, 0x31, 0x85, 0x37, 0xd4, 0x71,
+	0xad, 0x9c, 0xcb, 0x45, 0x1b, 0x54, 0xc2, 0xfa, 0x42, 0x33, 0x95, 0xf8, 0x3a, 0x7f, 0x95, 0x73,
+	0xb9, 0x68, 0x51, 0x89, 0x09, 0x53, 0xfe, 0x6e, 0x49, 0x39, 0xcd, 0x13, 0x31, 0xdd, 0xa8, 0xca,
+	0x72, 0x6e, 0x7a, 0x54, 0xf8, 0x7d, 0x76, 0x07, 0x4d, 0x7b, 0xeb, 0x95, 0x9f, 0xce, 0x16, 0x99,
+	0xfa, 0xe8, 0xad, 0x3c, 0xd3, 0xbf, 0x00, 0x34, 0xf2, 0x35, 0x09, 0x8e, 0xc4, 0x3f, 0xb7, 0xca,
+	0x8f, 0xa7, 0x4e, 0xcc, 0x94, 0x97, 0x66, 0xe5, 0x4a, 0x1f, 0x9c, 0xe1, 0x35, 0x5b, 0xdf, 0x78,
+	0x31, 0xc7, 0x9a, 0xf5, 0xfa, 0x36, 0x95, 0xf3, 0xf9, 0x88, 0x7d, 0x7a, 0x9c, 0x7e, 0xcc, 0x74,
+	0x3d, 0xa1, 0xfe, 0x50, 0xe5, 0x7c, 0x3e, 0x62, 0x6f, 0xb5, 0xfa, 0x1a, 0x1c, 0x53, 0x57, 0x6b,
+	0xb4, 0x0d, 0x54, 0xa9, 0xe6, 0x25, 0x47, 0x6d, 0x16, 0x4c, 0x07, 0x7a, 0x18, 0xe5, 0xb4, 0x49,
+	0x1b, 0xd7, 0x5e, 0xa9, 0xac, 0xe4, 0x67, 0xf0, 0x10, 0xfa, 0xba, 0xf5, 0x52, 0x11, 0x46, 0x5b,
+	0x28, 0x95, 0x6a, 0x5e, 0x72, 0xdf, 0x7c, 0x8d, 0xef, 0x0a, 0x4b, 0x9d, 0xaf, 0xa9, 0x0d, 0x7f,
+	0xca, 0x95, 0x3e, 0x38, 0xd1, 0x9e, 0x6f, 0xb2, 0x07, 0xbb, 0x84, 0xbe, 0x2c, 0x79, 0x35, 0x53,
+	0x6e, 0x62, 0xd3, 0x9a, 0xf2, 0x44, 0x5f, 0xbc, 0x11, 0xab, 0xa2, 0xad, 0x54, 0x39, 0xac, 0x4a,
+	0x6c, 0x1d, 0x53, 0x9e, 0xe8, 0x8b, 0x17, 0xad, 0xea, 0xc2, 0xc1, 0x60, 0xa3, 0x92, 0xbc, 0x92,
+	0x29, 0x2e, 0xd4, 0xe2, 0xa5, 0x5c, 0xe8, 0x81, 0x03, 0xd5, 0xbe, 0xca, 0xda, 0xe1, 0xa3, 0x4d,
+	0x43, 0xf2, 0x63, 0x99, 0xa2, 0xe2, 0x5a, 0xa6, 0x94, 0x4b, 0xbd, 0xb2, 0xa1, 0x19, 0x5f, 0x0f,
+	0x99, 0x81, 0x7d, 0x3e, 0xb9, 0xcd, 0x08, 0x36, 0x32, 0x29, 0x97, 0x7a, 0x65, 0xc3, 0x44, 0xb8,
+	0xf0, 0xb5, 0x21, 0x49, 0xfe, 0x8e, 0x04, 0xc7, 0x52, 0xfa, 0x73, 0xe4, 0xa7, 0x72, 0x0a, 0x8f,
+	0x6f, 0x42, 0x52, 0x3e, 0xd1, 0x2f, 0x7b, 0x64, 0x91, 0x87, 0x5b, 0x6c, 0x72, 0x2c, 0xf2, 0x84,
+	0x36, 0x22, 0xe5, 0x4a, 0x1f, 0x9c, 0x68, 0xcf, 0x9b, 0xac, 0xbc, 0x9a, 0xd1, 0x10, 0x23, 0xd7,
+	0x7a, 0x05, 0x1d, 0xb3, 0xe8, 0xd7, 0xef, 0x4b, 0x46, 0x24, 0xef, 0x48, 0xe8, 0x6d, 0xc9, 0x91,
+	0x77, 0xa4, 0x37, 0xf2, 0x28, 0xcf, 0xf4, 0x2f, 0x20, 0x61, 0x51, 0x62, 0x3f, 0x4a, 0xee, 0xd5,
+	0x10, 0xec, 0xc1, 0x51, 0x2e, 0xf5, 0xca, 0x86, 0x66, 0xfc, 0x50, 0x82, 0x52, 0x7a, 0x7b, 0x88,
+	0x9c, 0x17, 0x6b, 0x72, 0x96, 0xb6, 0x76, 0x1f, 0x12, 0xd0, 0xce, 0x6f, 0xb0, 0xbf, 0x29, 0x12,
+	0xd7, 0xcf, 0x21, 0x5f, 0xce, 0x29, 0x3c, 0xdc, 0xca, 0xa2, 0x3c, 0xde, 0x3b, 0x23, 0x1a, 0xf3,
+	0x3a, 0x2b, 0xb7, 0xc5, 0xb7, 0x6e, 0xc8, 0x57, 0x72, 0x1c, 0x5b, 0xf1, 0xcd, 0x26, 0xca, 0x6a,
+	0x3f, 0xac, 0x11, 0x93, 0x22, 0x45, 0xee, 0x1c, 0x26, 0x25, 0xf5, 0x10, 0x28, 0xab, 0xfd, 0xb0,
+	0xa2, 0x49, 0x5f, 0x95, 0x60, 0x2e, 0xae, 0x4a, 0x2b, 0x5f, 0xca, 0x29, 0x34, 0x54, 0x81, 0x57,
+	0x2e, 0xf7, 0xcc, 0xe7, 0x65, 0x85, 0x81, 0x7a, 0x6d, 0x6a, 0x56, 0x18, 0x57, 0x35, 0x56, 0x56,
+	0xf2, 0x33, 0x78, 0x3a, 0x03, 0xb5, 0xda, 0x54, 0x9d, 0x71, 0x15, 0x63, 0x65, 0x25, 0x3f, 0x83,
+	0xa7, 0x33, 0x50, 0xa9, 0x4c, 0xd5, 0x19, 0x57, 0x2c, 0x56, 0x56, 0xf2, 0x33, 0x78, 0x39, 0x4d,
+	0x60, 0x80, 0xca, 0xb9, 0x65, 0xd0, 0x3c, 0x39, 0x4d, 0x7c, 0xe9, 0x95, 0xa9, 0x0d, 0x56, 0x3e,
+	0x53, 0xd5, 0xc6, 0x96, 0x68, 0x95, 0x0b, 0x3d, 0x70, 0xf8, 0x76, 0xed, 0x98, 0xca, 0x64, 0xea,
+	0xae, 0x9d, 0x5c, 0x83, 0x55, 0x2e, 0xf5, 0xca, 0x86, 0x66, 0x7c, 0x59, 0x02, 0x39, 0x5a, 0x3d,
+	0x94, 0x3f, 0x9e, 0x22, 0x2e, 0xb1, 0x58, 0xaa, 0x3c, 0xd6, 0x23, 0x17, 0xda, 0xb0, 0x07, 0x0f,
+	0x85, 0xca, 0x7b, 0x72, 0x9a, 0x43, 0xe3, 0xab, 0x95, 0xca, 0xc5, 0x5e, 0x58, 0xbc, 0x69, 0x1e,
+	0x78, 0x81, 0x4d, 0x9d, 0xe6, 0x71, 0x35, 0x46, 0x65, 0x25, 0x3f, 0x83, 0x37, 0xdf, 0x82, 0x0f,
+	0xab, 0x72, 0x86, 0x8c, 0xe8, 0x23, 0xb0, 0x72, 0xa1, 0x07, 0x0e, 0x54, 0xfb, 0x05, 0xee, 0x64,
+	0xff, 0x63, 0x62, 0x96, 0x93, 0x63, 0x1e, 0x46, 0x95, 0x8b, 0xbd, 0xb0, 0xf8, 0xd3, 0x64, 0x13,
+	0xa6, 0x02, 0xba, 0xd3, 0x2e, 0xab, 0x71, 0x8a, 0x97, 0x73, 0xd3, 0x0b, 0xad, 0xca, 0xc8, 0x97,
+	0xd8, 0x5f, 0xf4, 0xa8, 0x91, 0xb7, 0xdf, 0x2b, 0x49, 0xef, 0xbe, 0x57, 0x92, 0xfe, 0xf5, 0x5e,
+	0x49, 0x7a, 0xfd, 0xfd, 0xd2, 0x81, 0x77, 0xdf, 0x2f, 0x1d, 0xf8, 0xfb, 0xfb, 0xa5, 0x03, 0x30,
+	0xaf, 0x9b, 0x09, 0x32, 0x6f, 0x48, 0xaf, 0x54, 0x7d, 0x7f, 0xbf, 0xc4, 0x23, 0x5a, 0xd2, 0x4d,
+	0xdf, 0xaf, 0xe5, 0x3d, 0xf7, 0x5f, 0x40, 0xd9, 0x1a, 0xe5, 0xff, 0xf6, 0xc9, 0xa3, 0xff, 0x1f,
+	0x00, 0xe6, 0x4e, 0x08, 0x2c, 0x16, 0x47, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateAsk creates an ask order (to sell something you own).
+	CreateAsk(ctx context.Context, in *MsgCreateAskRequest, opts ...grpc.CallOption) (*MsgCreateAskResponse, error)
+	// CreateBid creates a bid order (to buy something you want).
+	CreateBid(ctx context.Context, in *MsgCreateBidRequest, opts ...grpc.CallOption) (*MsgCreateBidResponse, error)
+	// CommitFunds marks funds in an account as manageable by a market.
+	CommitFunds(ctx context.Context, in *MsgCommitFundsRequest, opts ...grpc.CallOption) (*MsgCommitFundsResponse, error)
+	// CancelOrder cancels an order.
+	CancelOrder(ctx context.Context, in *MsgCancelOrderRequest, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
+	FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error)
+	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
+	FillAsks(ctx context.Context, in *MsgFillAsksRequest, opts ...grpc.CallOption) (*MsgFillAsksResponse, error)
+	// MarketSettle is a market endpoint to trigger the settlement of orders.
+	MarketSettle(ctx context.Context, in *MsgMarketSettleRequest, opts ...grpc.CallOption) (*MsgMarketSettleResponse, error)
+	// MarketStartExternalSettlement is a market endpoint to start the settlement of an ask order that will be paid for
+	// outside of the blockchain. The assets are delivered once an oracle confirms the payment.
+	MarketStartExternalSettlement(ctx context.Context, in *MsgMarketStartExternalSettlementRequest, opts ...grpc.CallOption) (*MsgMarketStartExternalSettlementResponse, error)
+	// ConfirmExternalPayment is used by a market's oracle to confirm payment was made for an external settlement.
+	ConfirmExternalPayment(ctx context.Context, in *MsgConfirmExternalPaymentRequest, opts ...grpc.CallOption) (*MsgConfirmExternalPaymentResponse, error)
+	// CreateRFQ creates a request for quote (to ask dealers what they'd sell some assets for).
+	CreateRFQ(ctx context.Context, in *MsgCreateRFQRequest, opts ...grpc.CallOption) (*MsgCreateRFQResponse, error)
+	// CancelRFQ cancels a request for quote, releasing the holds on all of its quotes.
+	CancelRFQ(ctx context.Context, in *MsgCancelRFQRequest, opts ...grpc.CallOption) (*MsgCancelRFQResponse, error)
+	// SubmitQuote is used by a dealer to offer to sell the assets of a request for quote for a price.
+	SubmitQuote(ctx context.Context, in *MsgSubmitQuoteRequest, opts ...grpc.CallOption) (*MsgSubmitQuoteResponse, error)
+	// WithdrawQuote is used by a dealer to withdraw their quote from a request for quote.
+	WithdrawQuote(ctx context.Context, in *MsgWithdrawQuoteRequest, opts ...grpc.CallOption) (*MsgWithdrawQuoteResponse, error)
+	// AcceptQuote is used by a buyer to accept a quote, settling it immediately.
+	AcceptQuote(ctx context.Context, in *MsgAcceptQuoteRequest, opts ...grpc.CallOption) (*MsgAcceptQuoteResponse, error)
+	// MarketCommitmentSettle is a market endpoint to transfer committed funds.
+	MarketCommitmentSettle(ctx context.Context, in *MsgMarketCommitmentSettleRequest, opts ...grpc.CallOption) (*MsgMarketCommitmentSettleResponse, error)
+	// MarketReleaseCommitments is a market endpoint return control of funds back to the account owner(s).
+	MarketReleaseCommitments(ctx context.Context, in *MsgMarketReleaseCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketReleaseCommitmentsResponse, error)
+	// MarketSetOrderExternalID updates an order's external id field.
+	MarketSetOrderExternalID(ctx context.Context, in *MsgMarketSetOrderExternalIDRequest, opts ...grpc.CallOption) (*MsgMarketSetOrderExternalIDResponse, error)
+	// MarketWithdraw is a market endpoint to withdraw fees that have been collected.
+	MarketWithdraw(ctx context.Context, in *MsgMarketWithdrawRequest, opts ...grpc.CallOption) (*MsgMarketWithdrawResponse, error)
+	// MarketUpdateDetails is a market endpoint to update its details.
+	MarketUpdateDetails(ctx context.Context, in *MsgMarketUpdateDetailsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateDetailsResponse, error)
+	// MarketUpdateEnabled is a market endpoint to update whether its accepting orders.
+	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+	MarketUpdateEnabled(ctx context.Context, in *MsgMarketUpdateEnabledRequest, opts ...grpc.CallOption) (*MsgMarketUpdateEnabledResponse, error)
+	// MarketUpdateAcceptingOrders is a market endpoint to update whether its accepting orders.
+	MarketUpdateAcceptingOrders(ctx context.Context, in *MsgMarketUpdateAcceptingOrdersRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingOrdersResponse, error)
+	// MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
+	MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpdateUserSettleRequest, opts ...grpc.CallOption) (*MsgMarketUpdateUserSettleResponse, error)
+	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
+	MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
+	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
+	MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error)
+	// MarketUpdateRebates sets a market's liquidity rebate configuration.
+	MarketUpdateRebates(ctx context.Context, in *MsgMarketUpdateRebatesRequest, opts ...grpc.CallOption) (*MsgMarketUpdateRebatesResponse, error)
+	// MarketUpdateExternalSettlement sets a market's external settlement configuration.
+	MarketUpdateExternalSettlement(ctx context.Context, in *MsgMarketUpdateExternalSettlementRequest, opts ...grpc.CallOption) (*MsgMarketUpdateExternalSettlementResponse, error)
+	// MarketUpdatePriceBand sets a market's price band configuration.
+	MarketUpdatePriceBand(ctx context.Context, in *MsgMarketUpdatePriceBandRequest, opts ...grpc.CallOption) (*MsgMarketUpdatePriceBandResponse, error)
+	// MarketResumeSettlements resumes settlements in a market that were paused because one was outside its price band.
+	MarketResumeSettlements(ctx context.Context, in *MsgMarketResumeSettlementsRequest, opts ...grpc.CallOption) (*MsgMarketResumeSettlementsResponse, error)
+	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
+	MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error)
+	// MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
+	MarketManageReqAttrs(ctx context.Context, in *MsgMarketManageReqAttrsRequest, opts ...grpc.CallOption) (*MsgMarketManageReqAttrsResponse, error)
+	// CreatePayment creates a payment to facilitate a trade between two accounts.
+	CreatePayment(ctx context.Context, in *MsgCreatePaymentRequest, opts ...grpc.CallOption) (*MsgCreatePaymentResponse, error)
+	// AcceptPayment is used by a target to accept a payment.
+	AcceptPayment(ctx context.Context, in *MsgAcceptPaymentRequest, opts ...grpc.CallOption) (*MsgAcceptPaymentResponse, error)
+	// RejectPayment can be used by a target to reject a payment.
+	RejectPayment(ctx context.Context, in *MsgRejectPaymentRequest, opts ...grpc.CallOption) (*MsgRejectPaymentResponse, error)
+	// RejectPayments can be used by a target to reject all payments from one or more sources.
+	RejectPayments(ctx context.Context, in *MsgRejectPaymentsRequest, opts ...grpc.CallOption) (*MsgRejectPaymentsResponse, error)
+	// CancelPayments can be used by a source to cancel one or more payments.
+	CancelPayments(ctx context.Context, in *MsgCancelPaymentsRequest, opts ...grpc.CallOption) (*MsgCancelPaymentsResponse, error)
+	// ChangePaymentTarget can be used by a source to change the target in one of their payments.
+	ChangePaymentTarget(ctx context.Context, in *MsgChangePaymentTargetRequest, opts ...grpc.CallOption) (*MsgChangePaymentTargetResponse, error)
+	// IssuerCreateMarket can be used by an approved issuer to create a market for a marker denom that they administer.
+	IssuerCreateMarket(ctx context.Context, in *MsgIssuerCreateMarketRequest, opts ...grpc.CallOption) (*MsgIssuerCreateMarketResponse, error)
+	// GovCreateMarket is a governance proposal endpoint for creating a market.
+	GovCreateMarket(ctx context.Context, in *MsgGovCreateMarketRequest, opts ...grpc.CallOption) (*MsgGovCreateMarketResponse, error)
+	// GovManageFees is a governance proposal endpoint for updating a market's fees.
+	GovManageFees(ctx context.Context, in *MsgGovManageFeesRequest, opts ...grpc.CallOption) (*MsgGovManageFeesResponse, error)
+	// GovCloseMarket is a governance proposal endpoint that will disable order and commitment creation,
+	// cancel all orders, and release all commitments.
+	GovCloseMarket(ctx context.Context, in *MsgGovCloseMarketRequest, opts ...grpc.CallOption) (*MsgGovCloseMarketResponse, error)
+	// GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
+	// Deprecated: Use UpdateParams instead.
+	GovUpdateParams(ctx context.Context, in *MsgGovUpdateParamsRequest, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the exchange module's params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateAsk(ctx context.Context, in *MsgCreateAskRequest, opts ...grpc.CallOption) (*MsgCreateAskResponse, error) {
+	out := new(MsgCreateAskResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreateAsk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateBidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateBid(ctx context.Context, in *MsgCreateBidRequest, opts ...grpc.CallOption) (*MsgCreateBidResponse, error) {
+	out := new(MsgCreateBidResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreateBid", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateBidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CommitFunds(ctx context.Context, in *MsgCommitFundsRequest, opts ...grpc.CallOption) (*MsgCommitFundsResponse, error) {
+	out := new(MsgCommitFundsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CommitFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrderRequest, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCommitFundsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error) {
+	out := new(MsgFillBidsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/FillBids", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCommitFundsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FillAsks(ctx context.Context, in *MsgFillAsksRequest, opts ...grpc.CallOption) (*MsgFillAsksResponse, error) {
+	out := new(MsgFillAsksResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/FillAsks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCommitFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EventTag) > 0 {
-		i -= len(m.EventTag)
-		copy(dAtA[i:], m.EventTag)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
-		i--
-		dAtA[i] = 0x2a
+func (c *msgClient) MarketSettle(ctx context.Context, in *MsgMarketSettleRequest, opts ...grpc.CallOption) (*MsgMarketSettleResponse, error) {
+	out := new(MsgMarketSettleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketSettle", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CreationFee != nil {
-		{
-			size, err := m.CreationFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCommitFundsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketStartExternalSettlement(ctx context.Context, in *MsgMarketStartExternalSettlementRequest, opts ...grpc.CallOption) (*MsgMarketStartExternalSettlementResponse, error) {
+	out := new(MsgMarketStartExternalSettlementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketStartExternalSettlement", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCommitFundsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCommitFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConfirmExternalPayment(ctx context.Context, in *MsgConfirmExternalPaymentRequest, opts ...grpc.CallOption) (*MsgConfirmExternalPaymentResponse, error) {
+	out := new(MsgConfirmExternalPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/ConfirmExternalPayment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateRFQ(ctx context.Context, in *MsgCreateRFQRequest, opts ...grpc.CallOption) (*MsgCreateRFQResponse, error) {
+	out := new(MsgCreateRFQResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreateRFQ", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CancelRFQ(ctx context.Context, in *MsgCancelRFQRequest, opts ...grpc.CallOption) (*MsgCancelRFQResponse, error) {
+	out := new(MsgCancelRFQResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CancelRFQ", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitQuote(ctx context.Context, in *MsgSubmitQuoteRequest, opts ...grpc.CallOption) (*MsgSubmitQuoteResponse, error) {
+	out := new(MsgSubmitQuoteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/SubmitQuote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawQuote(ctx context.Context, in *MsgWithdrawQuoteRequest, opts ...grpc.CallOption) (*MsgWithdrawQuoteResponse, error) {
+	out := new(MsgWithdrawQuoteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/WithdrawQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) AcceptQuote(ctx context.Context, in *MsgAcceptQuoteRequest, opts ...grpc.CallOption) (*MsgAcceptQuoteResponse, error) {
+	out := new(MsgAcceptQuoteResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/AcceptQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFillBidsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketCommitmentSettle(ctx context.Context, in *MsgMarketCommitmentSettleRequest, opts ...grpc.CallOption) (*MsgMarketCommitmentSettleResponse, error) {
+	out := new(MsgMarketCommitmentSettleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketCommitmentSettle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFillBidsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketReleaseCommitments(ctx context.Context, in *MsgMarketReleaseCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketReleaseCommitmentsResponse, error) {
+	out := new(MsgMarketReleaseCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketReleaseCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFillBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AskOrderCreationFee != nil {
-		{
-			size, err := m.AskOrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SellerSettlementFlatFee != nil {
-		{
-			size, err := m.SellerSettlementFlatFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.BidOrderIds) > 0 {
-		dAtA9 := make([]byte, len(m.BidOrderIds)*10)
-		var j8 int
-		for _, num := range m.BidOrderIds {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintTx(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TotalAssets) > 0 {
-		for iNdEx := len(m.TotalAssets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MarketSetOrderExternalID(ctx context.Context, in *MsgMarketSetOrderExternalIDRequest, opts ...grpc.CallOption) (*MsgMarketSetOrderExternalIDResponse, error) {
+	out := new(MsgMarketSetOrderExternalIDResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketSetOrderExternalID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFillBidsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketWithdraw(ctx context.Context, in *MsgMarketWithdrawRequest, opts ...grpc.CallOption) (*MsgMarketWithdrawResponse, error) {
+	out := new(MsgMarketWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketWithdraw", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFillBidsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketUpdateDetails(ctx context.Context, in *MsgMarketUpdateDetailsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateDetailsResponse, error) {
+	out := new(MsgMarketUpdateDetailsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFillBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Deprecated: Do not use.
+func (c *msgClient) MarketUpdateEnabled(ctx context.Context, in *MsgMarketUpdateEnabledRequest, opts ...grpc.CallOption) (*MsgMarketUpdateEnabledResponse, error) {
+	out := new(MsgMarketUpdateEnabledResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFillAsksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketUpdateAcceptingOrders(ctx context.Context, in *MsgMarketUpdateAcceptingOrdersRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingOrdersResponse, error) {
+	out := new(MsgMarketUpdateAcceptingOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAcceptingOrders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFillAsksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpdateUserSettleRequest, opts ...grpc.CallOption) (*MsgMarketUpdateUserSettleResponse, error) {
+	out := new(MsgMarketUpdateUserSettleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateUserSettle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFillAsksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidOrderCreationFee != nil {
-		{
-			size, err := m.BidOrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.BuyerSettlementFees) > 0 {
-		for iNdEx := len(m.BuyerSettlementFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BuyerSettlementFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.AskOrderIds) > 0 {
-		dAtA12 := make([]byte, len(m.AskOrderIds)*10)
-		var j11 int
-		for _, num := range m.AskOrderIds {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.TotalPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Buyer) > 0 {
-		i -= len(m.Buyer)
-		copy(dAtA[i:], m.Buyer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
+	out := new(MsgMarketUpdateAcceptingCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAcceptingCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgFillAsksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
+	out := new(MsgMarketUpdateIntermediaryDenomResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateIntermediaryDenom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgFillAsksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketUpdateRebates(ctx context.Context, in *MsgMarketUpdateRebatesRequest, opts ...grpc.CallOption) (*MsgMarketUpdateRebatesResponse, error) {
+	out := new(MsgMarketUpdateRebatesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateRebates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgFillAsksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) MarketUpdateExternalSettlement(ctx context.Context, in *MsgMarketUpdateExternalSettlementRequest, opts ...grpc.CallOption) (*MsgMarketUpdateExternalSettlementResponse, error) {
+	out := new(MsgMarketUpdateExternalSettlementResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateExternalSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMarketSettleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketUpdatePriceBand(ctx context.Context, in *MsgMarketUpdatePriceBandRequest, opts ...grpc.CallOption) (*MsgMarketUpdatePriceBandResponse, error) {
+	out := new(MsgMarketUpdatePriceBandResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdatePriceBand", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMarketSettleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) MarketResumeSettlements(ctx context.Context, in *MsgMarketResumeSettlementsRequest, opts ...grpc.CallOption) (*MsgMarketResumeSettlementsResponse, error) {
+	out := new(MsgMarketResumeSettlementsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketResumeSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMarketSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpectPartial {
-		i--
-		if m.ExpectPartial {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BidOrderIds) > 0 {
-		dAtA15 := make([]byte, len(m.BidOrderIds)*10)
-		var j14 int
-		for _, num := range m.BidOrderIds {
-			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j14++
-			}
-			dAtA15[j14] = uint8(num)
-			j14++
-		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintTx(dAtA, i, uint64(j14))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AskOrderIds) > 0 {
-		dAtA17 := make([]byte, len(m.AskOrderIds)*10)
-		var j16 int
-		for _, num := range m.AskOrderIds {
-			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j16++
-			}
-			dAtA17[j16] = uint8(num)
-			j16++
-		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintTx(dAtA, i, uint64(j16))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+func (c *msgClient) MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error) {
+	out := new(MsgMarketManagePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketManagePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) MarketManageReqAttrs(ctx context.Context, in *MsgMarketManageReqAttrsRequest, opts ...grpc.CallOption) (*MsgMarketManageReqAttrsResponse, error) {
+	out := new(MsgMarketManageReqAttrsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketManageReqAttrs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMarketSettleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreatePayment(ctx context.Context, in *MsgCreatePaymentRequest, opts ...grpc.CallOption) (*MsgCreatePaymentResponse, error) {
+	out := new(MsgCreatePaymentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreatePayment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMarketSettleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AcceptPayment(ctx context.Context, in *MsgAcceptPaymentRequest, opts ...grpc.CallOption) (*MsgAcceptPaymentResponse, error) {
+	out := new(MsgAcceptPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/AcceptPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMarketSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RejectPayment(ctx context.Context, in *MsgRejectPaymentRequest, opts ...grpc.CallOption) (*MsgRejectPaymentResponse, error) {
+	out := new(MsgRejectPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/RejectPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMarketCommitmentSettleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RejectPayments(ctx context.Context, in *MsgRejectPaymentsRequest, opts ...grpc.CallOption) (*MsgRejectPaymentsResponse, error) {
+	out := new(MsgRejectPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/RejectPayments", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMarketCommitmentSettleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelPayments(ctx context.Context, in *MsgCancelPaymentsRequest, opts ...grpc.CallOption) (*MsgCancelPaymentsResponse, error) {
+	out := new(MsgCancelPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CancelPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgMarketCommitmentSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EventTag) > 0 {
-		i -= len(m.EventTag)
-		copy(dAtA[i:], m.EventTag)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Navs) > 0 {
-		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Fees) > 0 {
-		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Outputs) > 0 {
-		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgMarketCommitmentSettleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ChangePaymentTarget(ctx context.Context, in *MsgChangePaymentTargetRequest, opts ...grpc.CallOption) (*MsgChangePaymentTargetResponse, error) {
+	out := new(MsgChangePaymentTargetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/ChangePaymentTarget", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketCommitmentSettleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketCommitmentSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMarketReleaseCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) IssuerCreateMarket(ctx context.Context, in *MsgIssuerCreateMarketRequest, opts ...grpc.CallOption) (*MsgIssuerCreateMarketResponse, error) {
+	out := new(MsgIssuerCreateMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/IssuerCreateMarket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketReleaseCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketReleaseCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EventTag) > 0 {
-		i -= len(m.EventTag)
-		copy(dAtA[i:], m.EventTag)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ToRelease) > 0 {
-		for iNdEx := len(m.ToRelease) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ToRelease[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMarketReleaseCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GovCreateMarket(ctx context.Context, in *MsgGovCreateMarketRequest, opts ...grpc.CallOption) (*MsgGovCreateMarketResponse, error) {
+	out := new(MsgGovCreateMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovCreateMarket", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketReleaseCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketReleaseCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GovManageFees(ctx context.Context, in *MsgGovManageFeesRequest, opts ...grpc.CallOption) (*MsgGovManageFeesResponse, error) {
+	out := new(MsgGovManageFeesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovManageFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketSetOrderExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.OrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) GovCloseMarket(ctx context.Context, in *MsgGovCloseMarketRequest, opts ...grpc.CallOption) (*MsgGovCloseMarketResponse, error) {
+	out := new(MsgGovCloseMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovCloseMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMarketSetOrderExternalIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *msgClient) GovUpdateParams(ctx context.Context, in *MsgGovUpdateParamsRequest, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error) {
+	out := new(MsgGovUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/GovUpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketSetOrderExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketSetOrderExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgMarketWithdrawRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgMarketWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateAsk creates an ask order (to sell something you own).
+	CreateAsk(context.Context, *MsgCreateAskRequest) (*MsgCreateAskResponse, error)
+	// CreateBid creates a bid order (to buy something you want).
+	CreateBid(context.Context, *MsgCreateBidRequest) (*MsgCreateBidResponse, error)
+	// CommitFunds marks funds in an account as manageable by a market.
+	CommitFunds(context.Context, *MsgCommitFundsRequest) (*MsgCommitFundsResponse, error)
+	// CancelOrder cancels an order.
+	CancelOrder(context.Context, *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error)
+	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
+	FillBids(context.Context, *MsgFillBidsRequest) (*MsgFillBidsResponse, error)
+	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
+	FillAsks(context.Context, *MsgFillAsksRequest) (*MsgFillAsksResponse, error)
+	// MarketSettle is a market endpoint to trigger the settlement of orders.
+	MarketSettle(context.Context, *MsgMarketSettleRequest) (*MsgMarketSettleResponse, error)
+	// MarketStartExternalSettlement is a market endpoint to start the settlement of an ask order that will be paid for
+	// outside of the blockchain. The assets are delivered once an oracle confirms the payment.
+	MarketStartExternalSettlement(context.Context, *MsgMarketStartExternalSettlementRequest) (*MsgMarketStartExternalSettlementResponse, error)
+	// ConfirmExternalPayment is used by a market's oracle to confirm payment was made for an external settlement.
+	ConfirmExternalPayment(context.Context, *MsgConfirmExternalPaymentRequest) (*MsgConfirmExternalPaymentResponse, error)
+	// CreateRFQ creates a request for quote (to ask dealers what they'd sell some assets for).
+	CreateRFQ(context.Context, *MsgCreateRFQRequest) (*MsgCreateRFQResponse, error)
+	// CancelRFQ cancels a request for quote, releasing the holds on all of its quotes.
+	CancelRFQ(context.Context, *MsgCancelRFQRequest) (*MsgCancelRFQResponse, error)
+	// SubmitQuote is used by a dealer to offer to sell the assets of a request for quote for a price.
+	SubmitQuote(context.Context, *MsgSubmitQuoteRequest) (*MsgSubmitQuoteResponse, error)
+	// WithdrawQuote is used by a dealer to withdraw their quote from a request for quote.
+	WithdrawQuote(context.Context, *MsgWithdrawQuoteRequest) (*MsgWithdrawQuoteResponse, error)
+	// AcceptQuote is used by a buyer to accept a quote, settling it immediately.
+	AcceptQuote(context.Context, *MsgAcceptQuoteRequest) (*MsgAcceptQuoteResponse, error)
+	// MarketCommitmentSettle is a market endpoint to transfer committed funds.
+	MarketCommitmentSettle(context.Context, *MsgMarketCommitmentSettleRequest) (*MsgMarketCommitmentSettleResponse, error)
+	// MarketReleaseCommitments is a market endpoint return control of funds back to the account owner(s).
+	MarketReleaseCommitments(context.Context, *MsgMarketReleaseCommitmentsRequest) (*MsgMarketReleaseCommitmentsResponse, error)
+	// MarketSetOrderExternalID updates an order's external id field.
+	MarketSetOrderExternalID(context.Context, *MsgMarketSetOrderExternalIDRequest) (*MsgMarketSetOrderExternalIDResponse, error)
+	// MarketWithdraw is a market endpoint to withdraw fees that have been collected.
+	MarketWithdraw(context.Context, *MsgMarketWithdrawRequest) (*MsgMarketWithdrawResponse, error)
+	// MarketUpdateDetails is a market endpoint to update its details.
+	MarketUpdateDetails(context.Context, *MsgMarketUpdateDetailsRequest) (*MsgMarketUpdateDetailsResponse, error)
+	// MarketUpdateEnabled is a market endpoint to update whether its accepting orders.
+	// Deprecated: This endpoint is no longer usable. It is replaced by MarketUpdateAcceptingOrders.
+	MarketUpdateEnabled(context.Context, *MsgMarketUpdateEnabledRequest) (*MsgMarketUpdateEnabledResponse, error)
+	// MarketUpdateAcceptingOrders is a market endpoint to update whether its accepting orders.
+	MarketUpdateAcceptingOrders(context.Context, *MsgMarketUpdateAcceptingOrdersRequest) (*MsgMarketUpdateAcceptingOrdersResponse, error)
+	// MarketUpdateUserSettle is a market endpoint to update whether it allows user-initiated settlement.
+	MarketUpdateUserSettle(context.Context, *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error)
+	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
+	MarketUpdateAcceptingCommitments(context.Context, *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
+	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
+	MarketUpdateIntermediaryDenom(context.Context, *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error)
+	// MarketUpdateRebates sets a market's liquidity rebate configuration.
+	MarketUpdateRebates(context.Context, *MsgMarketUpdateRebatesRequest) (*MsgMarketUpdateRebatesResponse, error)
+	// MarketUpdateExternalSettlement sets a market's external settlement configuration.
+	MarketUpdateExternalSettlement(context.Context, *MsgMarketUpdateExternalSettlementRequest) (*MsgMarketUpdateExternalSettlementResponse, error)
+	// MarketUpdatePriceBand sets a market's price band configuration.
+	MarketUpdatePriceBand(context.Context, *MsgMarketUpdatePriceBandRequest) (*MsgMarketUpdatePriceBandResponse, error)
+	// MarketResumeSettlements resumes settlements in a market that were paused because one was outside its price band.
+	MarketResumeSettlements(context.Context, *MsgMarketResumeSettlementsRequest) (*MsgMarketResumeSettlementsResponse, error)
+	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
+	MarketManagePermissions(context.Context, *MsgMarketManagePermissionsRequest) (*MsgMarketManagePermissionsResponse, error)
+	// MarketManageReqAttrs is a market endpoint to manage the attributes required to interact with it.
+	MarketManageReqAttrs(context.Context, *MsgMarketManageReqAttrsRequest) (*MsgMarketManageReqAttrsResponse, error)
+	// CreatePayment creates a payment to facilitate a trade between two accounts.
+	CreatePayment(context.Context, *MsgCreatePaymentRequest) (*MsgCreatePaymentResponse, error)
+	// AcceptPayment is used by a target to accept a payment.
+	AcceptPayment(context.Context, *MsgAcceptPaymentRequest) (*MsgAcceptPaymentResponse, error)
+	// RejectPayment can be used by a target to reject a payment.
+	RejectPayment(context.Context, *MsgRejectPaymentRequest) (*MsgRejectPaymentResponse, error)
+	// RejectPayments can be used by a target to reject all payments from one or more sources.
+	RejectPayments(context.Context, *MsgRejectPaymentsRequest) (*MsgRejectPaymentsResponse, error)
+	// CancelPayments can be used by a source to cancel one or more payments.
+	CancelPayments(context.Context, *MsgCancelPaymentsRequest) (*MsgCancelPaymentsResponse, error)
+	// ChangePaymentTarget can be used by a source to change the target in one of their payments.
+	ChangePaymentTarget(context.Context, *MsgChangePaymentTargetRequest) (*MsgChangePaymentTargetResponse, error)
+	// IssuerCreateMarket can be used by an approved issuer to create a market for a marker denom that they administer.
+	IssuerCreateMarket(context.Context, *MsgIssuerCreateMarketRequest) (*MsgIssuerCreateMarketResponse, error)
+	// GovCreateMarket is a governance proposal endpoint for creating a market.
+	GovCreateMarket(context.Context, *MsgGovCreateMarketRequest) (*MsgGovCreateMarketResponse, error)
+	// GovManageFees is a governance proposal endpoint for updating a market's fees.
+	GovManageFees(context.Context, *MsgGovManageFeesRequest) (*MsgGovManageFeesResponse, error)
+	// GovCloseMarket is a governance proposal endpoint that will disable order and commitment creation,
+	// cancel all orders, and release all commitments.
+	GovCloseMarket(context.Context, *MsgGovCloseMarketRequest) (*MsgGovCloseMarketResponse, error)
+	// GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
+	// Deprecated: Use UpdateParams instead.
+	GovUpdateParams(context.Context, *MsgGovUpdateParamsRequest) (*MsgGovUpdateParamsResponse, error)
+	// UpdateParams is a governance proposal endpoint for updating the exchange module's params.
+	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgMarketWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ToAddress) > 0 {
-		i -= len(m.ToAddress)
-		copy(dAtA[i:], m.ToAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgMarketWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateAsk(ctx context.Context, req *MsgCreateAskRequest) (*MsgCreateAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAsk not implemented")
 }
-
-func (m *MsgMarketWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CreateBid(ctx context.Context, req *MsgCreateBidRequest) (*MsgCreateBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
 }
-
-func (m *MsgMarketWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CommitFunds(ctx context.Context, req *MsgCommitFundsRequest) (*MsgCommitFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitFunds not implemented")
 }
-
-func (m *MsgMarketUpdateDetailsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
-
-func (m *MsgMarketUpdateDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) FillBids(ctx context.Context, req *MsgFillBidsRequest) (*MsgFillBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillBids not implemented")
 }
-
-func (m *MsgMarketUpdateDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MarketDetails.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) FillAsks(ctx context.Context, req *MsgFillAsksRequest) (*MsgFillAsksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FillAsks not implemented")
 }
-
-func (m *MsgMarketUpdateDetailsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) MarketSettle(ctx context.Context, req *MsgMarketSettleRequest) (*MsgMarketSettleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketSettle not implemented")
 }
-
-func (m *MsgMarketUpdateDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) MarketStartExternalSettlement(ctx context.Context, req *MsgMarketStartExternalSettlementRequest) (*MsgMarketStartExternalSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketStartExternalSettlement not implemented")
 }
-
-func (m *MsgMarketUpdateDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) ConfirmExternalPayment(ctx context.Context, req *MsgConfirmExternalPaymentRequest) (*MsgConfirmExternalPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmExternalPayment not implemented")
 }
-
-func (m *MsgMarketUpdateEnabledRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateRFQ(ctx context.Context, req *MsgCreateRFQRequest) (*MsgCreateRFQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRFQ not implemented")
 }
-
-func (m *MsgMarketUpdateEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) CancelRFQ(ctx context.Context, req *MsgCancelRFQRequest) (*MsgCancelRFQResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRFQ not implemented")
 }
-
-func (m *MsgMarketUpdateEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AcceptingOrders {
-		i--
-		if m.AcceptingOrders {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) SubmitQuote(ctx context.Context, req *MsgSubmitQuoteRequest) (*MsgSubmitQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuote not implemented")
 }
-
-func (m *MsgMarketUpdateEnabledResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) WithdrawQuote(ctx context.Context, req *MsgWithdrawQuoteRequest) (*MsgWithdrawQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawQuote not implemented")
 }
-
-func (m *MsgMarketUpdateEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) AcceptQuote(ctx context.Context, req *MsgAcceptQuoteRequest) (*MsgAcceptQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptQuote not implemented")
+}
+func (*UnimplementedMsgServer) MarketCommitmentSettle(ctx context.Context, req *MsgMarketCommitmentSettleRequest) (*MsgMarketCommitmentSettleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketCommitmentSettle not implemented")
+}
+func (*UnimplementedMsgServer) MarketReleaseCommitments(ctx context.Context, req *MsgMarketReleaseCommitmentsRequest) (*MsgMarketReleaseCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketReleaseCommitments not implemented")
+}
+func (*UnimplementedMsgServer) MarketSetOrderExternalID(ctx context.Context, req *MsgMarketSetOrderExternalIDRequest) (*MsgMarketSetOrderExternalIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketSetOrderExternalID not implemented")
+}
+func (*UnimplementedMsgServer) MarketWithdraw(ctx context.Context, req *MsgMarketWithdrawRequest) (*MsgMarketWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateDetails(ctx context.Context, req *MsgMarketUpdateDetailsRequest) (*MsgMarketUpdateDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateDetails not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateEnabled(ctx context.Context, req *MsgMarketUpdateEnabledRequest) (*MsgMarketUpdateEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateEnabled not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateAcceptingOrders(ctx context.Context, req *MsgMarketUpdateAcceptingOrdersRequest) (*MsgMarketUpdateAcceptingOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAcceptingOrders not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateUserSettle(ctx context.Context, req *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateUserSettle not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateAcceptingCommitments(ctx context.Context, req *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAcceptingCommitments not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateIntermediaryDenom(ctx context.Context, req *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateIntermediaryDenom not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateRebates(ctx context.Context, req *MsgMarketUpdateRebatesRequest) (*MsgMarketUpdateRebatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateRebates not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdateExternalSettlement(ctx context.Context, req *MsgMarketUpdateExternalSettlementRequest) (*MsgMarketUpdateExternalSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateExternalSettlement not implemented")
+}
+func (*UnimplementedMsgServer) MarketUpdatePriceBand(ctx context.Context, req *MsgMarketUpdatePriceBandRequest) (*MsgMarketUpdatePriceBandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdatePriceBand not implemented")
+}
+func (*UnimplementedMsgServer) MarketResumeSettlements(ctx context.Context, req *MsgMarketResumeSettlementsRequest) (*MsgMarketResumeSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketResumeSettlements not implemented")
+}
+func (*UnimplementedMsgServer) MarketManagePermissions(ctx context.Context, req *MsgMarketManagePermissionsRequest) (*MsgMarketManagePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketManagePermissions not implemented")
+}
+func (*UnimplementedMsgServer) MarketManageReqAttrs(ctx context.Context, req *MsgMarketManageReqAttrsRequest) (*MsgMarketManageReqAttrsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketManageReqAttrs not implemented")
+}
+func (*UnimplementedMsgServer) CreatePayment(ctx context.Context, req *MsgCreatePaymentRequest) (*MsgCreatePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayment not implemented")
+}
+func (*UnimplementedMsgServer) AcceptPayment(ctx context.Context, req *MsgAcceptPaymentRequest) (*MsgAcceptPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPayment not implemented")
+}
+func (*UnimplementedMsgServer) RejectPayment(ctx context.Context, req *MsgRejectPaymentRequest) (*MsgRejectPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPayment not implemented")
+}
+func (*UnimplementedMsgServer) RejectPayments(ctx context.Context, req *MsgRejectPaymentsRequest) (*MsgRejectPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPayments not implemented")
+}
+func (*UnimplementedMsgServer) CancelPayments(ctx context.Context, req *MsgCancelPaymentsRequest) (*MsgCancelPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayments not implemented")
+}
+func (*UnimplementedMsgServer) ChangePaymentTarget(ctx context.Context, req *MsgChangePaymentTargetRequest) (*MsgChangePaymentTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePaymentTarget not implemented")
+}
+func (*UnimplementedMsgServer) IssuerCreateMarket(ctx context.Context, req *MsgIssuerCreateMarketRequest) (*MsgIssuerCreateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerCreateMarket not implemented")
+}
+func (*UnimplementedMsgServer) GovCreateMarket(ctx context.Context, req *MsgGovCreateMarketRequest) (*MsgGovCreateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovCreateMarket not implemented")
+}
+func (*UnimplementedMsgServer) GovManageFees(ctx context.Context, req *MsgGovManageFeesRequest) (*MsgGovManageFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovManageFees not implemented")
+}
+func (*UnimplementedMsgServer) GovCloseMarket(ctx context.Context, req *MsgGovCloseMarketRequest) (*MsgGovCloseMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovCloseMarket not implemented")
+}
+func (*UnimplementedMsgServer) GovUpdateParams(ctx context.Context, req *MsgGovUpdateParamsRequest) (*MsgGovUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovUpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgMarketUpdateEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAskRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAsk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CreateAsk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAsk(ctx, req.(*MsgCreateAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CreateBid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBid(ctx, req.(*MsgCreateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AcceptingOrders {
-		i--
-		if m.AcceptingOrders {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func _Msg_CommitFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).CommitFunds(ctx, in)
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CommitFunds",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitFunds(ctx, req.(*MsgCommitFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_FillBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFillBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FillBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/FillBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FillBids(ctx, req.(*MsgFillBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_FillAsks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFillAsksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FillAsks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/FillAsks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FillAsks(ctx, req.(*MsgFillAsksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketSettleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarketSettle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketSettle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketSettle(ctx, req.(*MsgMarketSettleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MarketStartExternalSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketStartExternalSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketStartExternalSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketStartExternalSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketStartExternalSettlement(ctx, req.(*MsgMarketStartExternalSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUserSettlement {
-		i--
-		if m.AllowUserSettlement {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func _Msg_ConfirmExternalPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmExternalPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmExternalPayment(ctx, in)
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/ConfirmExternalPayment",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmExternalPayment(ctx, req.(*MsgConfirmExternalPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateUserSettleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateRFQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRFQRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketUpdateUserSettleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketUpdateUserSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRFQ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CreateRFQ",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRFQ(ctx, req.(*MsgCreateRFQRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelRFQ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRFQRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AcceptingCommitments {
-		i--
-		if m.AcceptingCommitments {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRFQ(ctx, in)
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CancelRFQ",
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRFQ(ctx, req.(*MsgCancelRFQRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitQuoteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/SubmitQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitQuote(ctx, req.(*MsgSubmitQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WithdrawQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawQuoteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketUpdateIntermediaryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketUpdateIntermediaryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IntermediaryDenom) > 0 {
-		i -= len(m.IntermediaryDenom)
-		copy(dAtA[i:], m.IntermediaryDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IntermediaryDenom)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawQuote(ctx, in)
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/WithdrawQuote",
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawQuote(ctx, req.(*MsgWithdrawQuoteRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AcceptQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptQuoteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketUpdateIntermediaryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/AcceptQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptQuote(ctx, req.(*MsgAcceptQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_MarketCommitmentSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketCommitmentSettleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketCommitmentSettle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketCommitmentSettle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketCommitmentSettle(ctx, req.(*MsgMarketCommitmentSettleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManagePermissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketReleaseCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketReleaseCommitmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarketReleaseCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketReleaseCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketReleaseCommitments(ctx, req.(*MsgMarketReleaseCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManagePermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MarketSetOrderExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketSetOrderExternalIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketSetOrderExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketSetOrderExternalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketSetOrderExternalID(ctx, req.(*MsgMarketSetOrderExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManagePermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToGrant) > 0 {
-		for iNdEx := len(m.ToGrant) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ToGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ToRevoke) > 0 {
-		for iNdEx := len(m.ToRevoke) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ToRevoke[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_MarketWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RevokeAll) > 0 {
-		for iNdEx := len(m.RevokeAll) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RevokeAll[iNdEx])
-			copy(dAtA[i:], m.RevokeAll[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RevokeAll[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketWithdraw(ctx, in)
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketWithdraw",
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketWithdraw(ctx, req.(*MsgMarketWithdrawRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManagePermissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketUpdateDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateDetailsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgMarketManagePermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateDetails(ctx, req.(*MsgMarketUpdateDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManagePermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_MarketUpdateEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateEnabled(ctx, req.(*MsgMarketUpdateEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManageReqAttrsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketUpdateAcceptingOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateAcceptingOrdersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateAcceptingOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateAcceptingOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateAcceptingOrders(ctx, req.(*MsgMarketUpdateAcceptingOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManageReqAttrsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MarketUpdateUserSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateUserSettleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateUserSettle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateUserSettle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateUserSettle(ctx, req.(*MsgMarketUpdateUserSettleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManageReqAttrsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreateCommitmentToRemove) > 0 {
-		for iNdEx := len(m.CreateCommitmentToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CreateCommitmentToRemove[iNdEx])
-			copy(dAtA[i:], m.CreateCommitmentToRemove[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateCommitmentToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+func _Msg_MarketUpdateAcceptingCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateAcceptingCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CreateCommitmentToAdd) > 0 {
-		for iNdEx := len(m.CreateCommitmentToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CreateCommitmentToAdd[iNdEx])
-			copy(dAtA[i:], m.CreateCommitmentToAdd[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateCommitmentToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateAcceptingCommitments(ctx, in)
 	}
-	if len(m.CreateBidToRemove) > 0 {
-		for iNdEx := len(m.CreateBidToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CreateBidToRemove[iNdEx])
-			copy(dAtA[i:], m.CreateBidToRemove[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateBidToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateAcceptingCommitments",
 	}
-	if len(m.CreateBidToAdd) > 0 {
-		for iNdEx := len(m.CreateBidToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CreateBidToAdd[iNdEx])
-			copy(dAtA[i:], m.CreateBidToAdd[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateBidToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateAcceptingCommitments(ctx, req.(*MsgMarketUpdateAcceptingCommitmentsRequest))
 	}
-	if len(m.CreateAskToRemove) > 0 {
-		for iNdEx := len(m.CreateAskToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CreateAskToRemove[iNdEx])
-			copy(dAtA[i:], m.CreateAskToRemove[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateAskToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MarketUpdateIntermediaryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateIntermediaryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CreateAskToAdd) > 0 {
-		for iNdEx := len(m.CreateAskToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CreateAskToAdd[iNdEx])
-			copy(dAtA[i:], m.CreateAskToAdd[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateAskToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateIntermediaryDenom(ctx, in)
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateIntermediaryDenom",
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateIntermediaryDenom(ctx, req.(*MsgMarketUpdateIntermediaryDenomRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManageReqAttrsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketUpdateRebates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateRebatesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateRebates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateRebates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateRebates(ctx, req.(*MsgMarketUpdateRebatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgMarketManageReqAttrsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgMarketManageReqAttrsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCreatePaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketUpdateExternalSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateExternalSettlementRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCreatePaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateExternalSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateExternalSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateExternalSettlement(ctx, req.(*MsgMarketUpdateExternalSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_MarketUpdatePriceBand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdatePriceBandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdatePriceBand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdatePriceBand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdatePriceBand(ctx, req.(*MsgMarketUpdatePriceBandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarketResumeSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketResumeSettlementsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarketResumeSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketResumeSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketResumeSettlements(ctx, req.(*MsgMarketResumeSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MarketManagePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketManagePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketManagePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketManagePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketManagePermissions(ctx, req.(*MsgMarketManagePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreatePaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_MarketManageReqAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketManageReqAttrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketManageReqAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketManageReqAttrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketManageReqAttrs(ctx, req.(*MsgMarketManageReqAttrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptPaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CreatePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePayment(ctx, req.(*MsgCreatePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_AcceptPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/AcceptPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPayment(ctx, req.(*MsgAcceptPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_RejectPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RejectPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/RejectPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectPayment(ctx, req.(*MsgRejectPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RejectPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectPaymentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RejectPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/RejectPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RejectPayments(ctx, req.(*MsgRejectPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CancelPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CancelPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPayments(ctx, req.(*MsgCancelPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAcceptPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ChangePaymentTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangePaymentTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangePaymentTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/ChangePaymentTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangePaymentTarget(ctx, req.(*MsgChangePaymentTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectPaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_IssuerCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssuerCreateMarketRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).IssuerCreateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/IssuerCreateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssuerCreateMarket(ctx, req.(*MsgIssuerCreateMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_GovCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovCreateMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).GovCreateMarket(ctx, in)
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/GovCreateMarket",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRejectPaymentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovCreateMarket(ctx, req.(*MsgGovCreateMarketRequest))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRejectPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRejectPaymentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_GovManageFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovManageFeesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRejectPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sources) > 0 {
-		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sources[iNdEx])
-			copy(dAtA[i:], m.Sources[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Sources[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).GovManageFees(ctx, in)
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/GovManageFees",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRejectPaymentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovManageFees(ctx, req.(*MsgGovManageFeesRequest))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRejectPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRejectPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPaymentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_GovCloseMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovCloseMarketRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalIds) > 0 {
-		for iNdEx := len(m.ExternalIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ExternalIds[iNdEx])
-			copy(dAtA[i:], m.ExternalIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalIds[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).GovCloseMarket(ctx, in)
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/GovCloseMarket",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovCloseMarket(ctx, req.(*MsgGovCloseMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCancelPaymentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_GovUpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovUpdateParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).GovUpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/GovUpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovUpdateParams(ctx, req.(*MsgGovUpdateParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangePaymentTargetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgChangePaymentTargetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgChangePaymentTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewTarget) > 0 {
-		i -= len(m.NewTarget)
-		copy(dAtA[i:], m.NewTarget)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewTarget)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/UpdateParams",
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgChangePaymentTargetResponse) Marshal() (dAtA []byte, err error) {
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.exchange.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAsk",
+			Handler:    _Msg_CreateAsk_Handler,
+		},
+		{
+			MethodName: "CreateBid",
+			Handler:    _Msg_CreateBid_Handler,
+		},
+		{
+			MethodName: "CommitFunds",
+			Handler:    _Msg_CommitFunds_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "FillBids",
+			Handler:    _Msg_FillBids_Handler,
+		},
+		{
+			MethodName: "FillAsks",
+			Handler:    _Msg_FillAsks_Handler,
+		},
+		{
+			MethodName: "MarketSettle",
+			Handler:    _Msg_MarketSettle_Handler,
+		},
+		{
+			MethodName: "MarketStartExternalSettlement",
+			Handler:    _Msg_MarketStartExternalSettlement_Handler,
+		},
+		{
+			MethodName: "ConfirmExternalPayment",
+			Handler:    _Msg_ConfirmExternalPayment_Handler,
+		},
+		{
+			MethodName: "CreateRFQ",
+			Handler:    _Msg_CreateRFQ_Handler,
+		},
+		{
+			MethodName: "CancelRFQ",
+			Handler:    _Msg_CancelRFQ_Handler,
+		},
+		{
+			MethodName: "SubmitQuote",
+			Handler:    _Msg_SubmitQuote_Handler,
+		},
+		{
+			MethodName: "WithdrawQuote",
+			Handler:    _Msg_WithdrawQuote_Handler,
+		},
+		{
+			MethodName: "AcceptQuote",
+			Handler:    _Msg_AcceptQuote_Handler,
+		},
+		{
+			MethodName: "MarketCommitmentSettle",
+			Handler:    _Msg_MarketCommitmentSettle_Handler,
+		},
+		{
+			MethodName: "MarketReleaseCommitments",
+			Handler:    _Msg_MarketReleaseCommitments_Handler,
+		},
+		{
+			MethodName: "MarketSetOrderExternalID",
+			Handler:    _Msg_MarketSetOrderExternalID_Handler,
+		},
+		{
+			MethodName: "MarketWithdraw",
+			Handler:    _Msg_MarketWithdraw_Handler,
+		},
+		{
+			MethodName: "MarketUpdateDetails",
+			Handler:    _Msg_MarketUpdateDetails_Handler,
+		},
+		{
+			MethodName: "MarketUpdateEnabled",
+			Handler:    _Msg_MarketUpdateEnabled_Handler,
+		},
+		{
+			MethodName: "MarketUpdateAcceptingOrders",
+			Handler:    _Msg_MarketUpdateAcceptingOrders_Handler,
+		},
+		{
+			MethodName: "MarketUpdateUserSettle",
+			Handler:    _Msg_MarketUpdateUserSettle_Handler,
+		},
+		{
+			MethodName: "MarketUpdateAcceptingCommitments",
+			Handler:    _Msg_MarketUpdateAcceptingCommitments_Handler,
+		},
+		{
+			MethodName: "MarketUpdateIntermediaryDenom",
+			Handler:    _Msg_MarketUpdateIntermediaryDenom_Handler,
+		},
+		{
+			MethodName: "MarketUpdateRebates",
+			Handler:    _Msg_MarketUpdateRebates_Handler,
+		},
+		{
+			MethodName: "MarketUpdateExternalSettlement",
+			Handler:    _Msg_MarketUpdateExternalSettlement_Handler,
+		},
+		{
+			MethodName: "MarketUpdatePriceBand",
+			Handler:    _Msg_MarketUpdatePriceBand_Handler,
+		},
+		{
+			MethodName: "MarketResumeSettlements",
+			Handler:    _Msg_MarketResumeSettlements_Handler,
+		},
+		{
+			MethodName: "MarketManagePermissions",
+			Handler:    _Msg_MarketManagePermissions_Handler,
+		},
+		{
+			MethodName: "MarketManageReqAttrs",
+			Handler:    _Msg_MarketManageReqAttrs_Handler,
+		},
+		{
+			MethodName: "CreatePayment",
+			Handler:    _Msg_CreatePayment_Handler,
+		},
+		{
+			MethodName: "AcceptPayment",
+			Handler:    _Msg_AcceptPayment_Handler,
+		},
+		{
+			MethodName: "RejectPayment",
+			Handler:    _Msg_RejectPayment_Handler,
+		},
+		{
+			MethodName: "RejectPayments",
+			Handler:    _Msg_RejectPayments_Handler,
+		},
+		{
+			MethodName: "CancelPayments",
+			Handler:    _Msg_CancelPayments_Handler,
+		},
+		{
+			MethodName: "ChangePaymentTarget",
+			Handler:    _Msg_ChangePaymentTarget_Handler,
+		},
+		{
+			MethodName: "IssuerCreateMarket",
+			Handler:    _Msg_IssuerCreateMarket_Handler,
+		},
+		{
+			MethodName: "GovCreateMarket",
+			Handler:    _Msg_GovCreateMarket_Handler,
+		},
+		{
+			MethodName: "GovManageFees",
+			Handler:    _Msg_GovManageFees_Handler,
+		},
+		{
+			MethodName: "GovCloseMarket",
+			Handler:    _Msg_GovCloseMarket_Handler,
+		},
+		{
+			MethodName: "GovUpdateParams",
+			Handler:    _Msg_GovUpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/exchange/v1/tx.proto",
+}
+
+func (m *MsgCreateAskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6751,20 +6680,42 @@ func (m *MsgChangePaymentTargetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgChangePaymentTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateAskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgChangePaymentTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateAskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.OrderCreationFee != nil {
+		{
+			size, err := m.OrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateAskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6774,18 +6725,58 @@ func (m *MsgGovCreateMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateAskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.OrderCreationFee != nil {
+		{
+			size, err := m.OrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -6793,18 +6784,11 @@ func (m *MsgGovCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBidResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6814,20 +6798,25 @@ func (m *MsgGovCreateMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBidResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovManageFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCommitFundsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6837,223 +6826,39 @@ func (m *MsgGovManageFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCommitFundsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCommitFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnsetFeeCommitmentSettlementBips {
-		i--
-		if m.UnsetFeeCommitmentSettlementBips {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.SetFeeCommitmentSettlementBips != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SetFeeCommitmentSettlementBips))
-		i--
-		dAtA[i] = 0x1
+	if len(m.EventTag) > 0 {
+		i -= len(m.EventTag)
+		copy(dAtA[i:], m.EventTag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
 		i--
-		dAtA[i] = 0x88
-	}
-	if len(m.RemoveFeeCreateCommitmentFlat) > 0 {
-		for iNdEx := len(m.RemoveFeeCreateCommitmentFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeCreateCommitmentFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x82
-		}
-	}
-	if len(m.AddFeeCreateCommitmentFlat) > 0 {
-		for iNdEx := len(m.AddFeeCreateCommitmentFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddFeeCreateCommitmentFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x7a
-		}
-	}
-	if len(m.RemoveFeeBuyerSettlementRatios) > 0 {
-		for iNdEx := len(m.RemoveFeeBuyerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeBuyerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if len(m.AddFeeBuyerSettlementRatios) > 0 {
-		for iNdEx := len(m.AddFeeBuyerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddFeeBuyerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	if len(m.RemoveFeeBuyerSettlementFlat) > 0 {
-		for iNdEx := len(m.RemoveFeeBuyerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeBuyerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.AddFeeBuyerSettlementFlat) > 0 {
-		for iNdEx := len(m.AddFeeBuyerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddFeeBuyerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if len(m.RemoveFeeSellerSettlementRatios) > 0 {
-		for iNdEx := len(m.RemoveFeeSellerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeSellerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if len(m.AddFeeSellerSettlementRatios) > 0 {
-		for iNdEx := len(m.AddFeeSellerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddFeeSellerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.RemoveFeeSellerSettlementFlat) > 0 {
-		for iNdEx := len(m.RemoveFeeSellerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeSellerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.AddFeeSellerSettlementFlat) > 0 {
-		for iNdEx := len(m.AddFeeSellerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddFeeSellerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.RemoveFeeCreateBidFlat) > 0 {
-		for iNdEx := len(m.RemoveFeeCreateBidFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeCreateBidFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.AddFeeCreateBidFlat) > 0 {
-		for iNdEx := len(m.AddFeeCreateBidFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddFeeCreateBidFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+		dAtA[i] = 0x2a
 	}
-	if len(m.RemoveFeeCreateAskFlat) > 0 {
-		for iNdEx := len(m.RemoveFeeCreateAskFlat) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RemoveFeeCreateAskFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.CreationFee != nil {
+		{
+			size, err := m.CreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.AddFeeCreateAskFlat) > 0 {
-		for iNdEx := len(m.AddFeeCreateAskFlat) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AddFeeCreateAskFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7069,17 +6874,17 @@ func (m *MsgGovManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovManageFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCommitFundsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7089,12 +6894,12 @@ func (m *MsgGovManageFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCommitFundsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCommitFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7102,7 +6907,7 @@ func (m *MsgGovManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCloseMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelOrderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7112,32 +6917,32 @@ func (m *MsgGovCloseMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovCloseMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelOrderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovCloseMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCloseMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7147,12 +6952,12 @@ func (m *MsgGovCloseMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovCloseMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovCloseMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7160,7 +6965,7 @@ func (m *MsgGovCloseMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgFillBidsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7170,37 +6975,88 @@ func (m *MsgGovUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFillBidsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFillBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.AskOrderCreationFee != nil {
+		{
+			size, err := m.AskOrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x32
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.SellerSettlementFlatFee != nil {
+		{
+			size, err := m.SellerSettlementFlatFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BidOrderIds) > 0 {
+		dAtA9 := make([]byte, len(m.BidOrderIds)*10)
+		var j8 int
+		for _, num := range m.BidOrderIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalAssets) > 0 {
+		for iNdEx := len(m.TotalAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFillBidsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7210,12 +7066,12 @@ func (m *MsgGovUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgGovUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFillBidsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFillBidsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7223,7 +7079,7 @@ func (m *MsgGovUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgFillAsksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7233,18 +7089,62 @@ func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFillAsksRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFillAsksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.BidOrderCreationFee != nil {
+		{
+			size, err := m.BidOrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BuyerSettlementFees) > 0 {
+		for iNdEx := len(m.BuyerSettlementFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerSettlementFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AskOrderIds) > 0 {
+		dAtA12 := make([]byte, len(m.AskOrderIds)*10)
+		var j11 int
+		for _, num := range m.AskOrderIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TotalPrice.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -7252,18 +7152,23 @@ func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFillAsksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7273,12 +7178,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFillAsksResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFillAsksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7286,1069 +7191,6490 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateAskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AskOrder.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.OrderCreationFee != nil {
-		l = m.OrderCreationFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgMarketSettleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateAskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
-	}
-	return n
+func (m *MsgMarketSettleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBidRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BidOrder.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.OrderCreationFee != nil {
-		l = m.OrderCreationFee.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgCreateBidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.OverridePriceBand {
+		i--
+		if m.OverridePriceBand {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
+	if m.NetTransfers {
+		i--
+		if m.NetTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *MsgCommitFundsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ExpectPartial {
+		i--
+		if m.ExpectPartial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+	if len(m.BidOrderIds) > 0 {
+		dAtA15 := make([]byte, len(m.BidOrderIds)*10)
+		var j14 int
+		for _, num := range m.BidOrderIds {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTx(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AskOrderIds) > 0 {
+		dAtA17 := make([]byte, len(m.AskOrderIds)*10)
+		var j16 int
+		for _, num := range m.AskOrderIds {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintTx(dAtA, i, uint64(j16))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CreationFee != nil {
-		l = m.CreationFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.EventTag)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCommitFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketSettleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCancelOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
-	}
-	return n
+func (m *MsgMarketSettleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFillBidsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateRFQRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRFQRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRFQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.TotalAssets) > 0 {
-		for _, e := range m.TotalAssets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.BidOrderIds) > 0 {
-		l = 0
-		for _, e := range m.BidOrderIds {
-			l += sovTx(uint64(e))
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.SellerSettlementFlatFee != nil {
-		l = m.SellerSettlementFlatFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.AskOrderCreationFee != nil {
-		l = m.AskOrderCreationFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFillBidsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateRFQResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRFQResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRFQResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.RfqId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFillAsksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRFQRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRFQRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRFQRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	l = m.TotalPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.AskOrderIds) > 0 {
-		l = 0
-		for _, e := range m.AskOrderIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.BuyerSettlementFees) > 0 {
-		for _, e := range m.BuyerSettlementFees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.RfqId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.BidOrderCreationFee != nil {
-		l = m.BidOrderCreationFee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFillAsksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelRFQResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketSettleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCancelRFQResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRFQResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if len(m.AskOrderIds) > 0 {
-		l = 0
-		for _, e := range m.AskOrderIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.BidOrderIds) > 0 {
-		l = 0
-		for _, e := range m.BidOrderIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if m.ExpectPartial {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketSettleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketCommitmentSettleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Outputs) > 0 {
-		for _, e := range m.Outputs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if m.SellerSettlementFlatFee != nil {
+		{
+			size, err := m.SellerSettlementFlatFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Navs) > 0 {
-		for _, e := range m.Navs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x1a
+	if m.RfqId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.EventTag)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketCommitmentSettleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketReleaseCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if len(m.ToRelease) > 0 {
-		for _, e := range m.ToRelease {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.EventTag)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSubmitQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarketReleaseCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketSetOrderExternalIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if m.OrderId != 0 {
-		n += 1 + sovTx(uint64(m.OrderId))
-	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgWithdrawQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketSetOrderExternalIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgWithdrawQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarketWithdrawRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RfqId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketUpdateDetailsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	l = m.MarketDetails.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgWithdrawQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarketUpdateDetailsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWithdrawQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketUpdateEnabledRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptQuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if m.AcceptingOrders {
-		n += 2
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketUpdateEnabledResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgAcceptQuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAcceptQuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BuyerSettlementFees) > 0 {
+		for iNdEx := len(m.BuyerSettlementFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerSettlementFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.AcceptingOrders {
-		n += 2
+	i--
+	dAtA[i] = 0x22
+	if len(m.Dealer) > 0 {
+		i -= len(m.Dealer)
+		copy(dAtA[i:], m.Dealer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dealer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.RfqId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RfqId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketUpdateAcceptingOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptQuoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketUpdateUserSettleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgAcceptQuoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptQuoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+	if m.BidOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BidOrderId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.AllowUserSettlement {
-		n += 2
+	if m.AskOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AskOrderId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketUpdateUserSettleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketCommitmentSettleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketCommitmentSettleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketCommitmentSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+	if len(m.EventTag) > 0 {
+		i -= len(m.EventTag)
+		copy(dAtA[i:], m.EventTag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.AcceptingCommitments {
-		n += 2
+	if len(m.Navs) > 0 {
+		for iNdEx := len(m.Navs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Navs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return n
-}
-
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgMarketUpdateIntermediaryDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.IntermediaryDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketCommitmentSettleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketManagePermissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketCommitmentSettleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketCommitmentSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if len(m.RevokeAll) > 0 {
-		for _, s := range m.RevokeAll {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.ToRevoke) > 0 {
-		for _, e := range m.ToRevoke {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.ToGrant) > 0 {
-		for _, e := range m.ToGrant {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketManagePermissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketReleaseCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMarketManageReqAttrsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketReleaseCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketReleaseCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if len(m.CreateAskToAdd) > 0 {
-		for _, s := range m.CreateAskToAdd {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CreateAskToRemove) > 0 {
-		for _, s := range m.CreateAskToRemove {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.CreateBidToAdd) > 0 {
-		for _, s := range m.CreateBidToAdd {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.EventTag) > 0 {
+		i -= len(m.EventTag)
+		copy(dAtA[i:], m.EventTag)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EventTag)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.CreateBidToRemove) > 0 {
-		for _, s := range m.CreateBidToRemove {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.ToRelease) > 0 {
+		for iNdEx := len(m.ToRelease) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToRelease[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.CreateCommitmentToAdd) > 0 {
-		for _, s := range m.CreateCommitmentToAdd {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.CreateCommitmentToRemove) > 0 {
-		for _, s := range m.CreateCommitmentToRemove {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMarketManageReqAttrsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketReleaseCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Payment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgMarketReleaseCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketReleaseCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAcceptPaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketSetOrderExternalIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Payment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAcceptPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgMarketSetOrderExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectPaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketSetOrderExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRejectPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketSetOrderExternalIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRejectPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Sources) > 0 {
-		for _, s := range m.Sources {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgMarketSetOrderExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRejectPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMarketSetOrderExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ExternalIds) > 0 {
-		for _, s := range m.ExternalIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangePaymentTargetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.MarketDetails.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.NewTarget)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangePaymentTargetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCreateMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AcceptingOrders {
+		i--
+		if m.AcceptingOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Market.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCreateMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovManageFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateAcceptingOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAcceptingOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAcceptingOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
-	}
-	if len(m.AddFeeCreateAskFlat) > 0 {
-		for _, e := range m.AddFeeCreateAskFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeCreateAskFlat) > 0 {
-		for _, e := range m.RemoveFeeCreateAskFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AddFeeCreateBidFlat) > 0 {
-		for _, e := range m.AddFeeCreateBidFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeCreateBidFlat) > 0 {
-		for _, e := range m.RemoveFeeCreateBidFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AddFeeSellerSettlementFlat) > 0 {
-		for _, e := range m.AddFeeSellerSettlementFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeSellerSettlementFlat) > 0 {
-		for _, e := range m.RemoveFeeSellerSettlementFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AddFeeSellerSettlementRatios) > 0 {
-		for _, e := range m.AddFeeSellerSettlementRatios {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeSellerSettlementRatios) > 0 {
-		for _, e := range m.RemoveFeeSellerSettlementRatios {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AddFeeBuyerSettlementFlat) > 0 {
-		for _, e := range m.AddFeeBuyerSettlementFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeBuyerSettlementFlat) > 0 {
-		for _, e := range m.RemoveFeeBuyerSettlementFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AddFeeBuyerSettlementRatios) > 0 {
-		for _, e := range m.AddFeeBuyerSettlementRatios {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeBuyerSettlementRatios) > 0 {
-		for _, e := range m.RemoveFeeBuyerSettlementRatios {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AddFeeCreateCommitmentFlat) > 0 {
-		for _, e := range m.AddFeeCreateCommitmentFlat {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.RemoveFeeCreateCommitmentFlat) > 0 {
-		for _, e := range m.RemoveFeeCreateCommitmentFlat {
-			l = e.Size()
-			n += 2 + l + sovTx(uint64(l))
+	if m.AcceptingOrders {
+		i--
+		if m.AcceptingOrders {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.SetFeeCommitmentSettlementBips != 0 {
-		n += 2 + sovTx(uint64(m.SetFeeCommitmentSettlementBips))
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.UnsetFeeCommitmentSettlementBips {
-		n += 3
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovManageFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateAcceptingOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAcceptingOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAcceptingOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCloseMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateUserSettleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateUserSettleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateUserSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AllowUserSettlement {
+		i--
+		if m.AllowUserSettlement {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.MarketId != 0 {
-		n += 1 + sovTx(uint64(m.MarketId))
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovCloseMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateUserSettleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateUserSettleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateUserSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AcceptingCommitments {
+		i--
+		if m.AcceptingCommitments {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.IntermediaryDenom) > 0 {
+		i -= len(m.IntermediaryDenom)
+		copy(dAtA[i:], m.IntermediaryDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IntermediaryDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateRebatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateRebatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateRebatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateRebatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateRebatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateRebatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateExternalSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateExternalSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateExternalSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateExternalSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateExternalSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateExternalSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdatePriceBandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdatePriceBandRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdatePriceBandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdatePriceBandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdatePriceBandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdatePriceBandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketResumeSettlementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketResumeSettlementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketResumeSettlementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketResumeSettlementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketResumeSettlementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketResumeSettlementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketStartExternalSettlementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketStartExternalSettlementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketStartExternalSettlementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketStartExternalSettlementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketStartExternalSettlementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketStartExternalSettlementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmExternalPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmExternalPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmExternalPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentReference) > 0 {
+		i -= len(m.PaymentReference)
+		copy(dAtA[i:], m.PaymentReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmExternalPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmExternalPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmExternalPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketManagePermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketManagePermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketManagePermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToGrant) > 0 {
+		for iNdEx := len(m.ToGrant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ToRevoke) > 0 {
+		for iNdEx := len(m.ToRevoke) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToRevoke[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RevokeAll) > 0 {
+		for iNdEx := len(m.RevokeAll) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokeAll[iNdEx])
+			copy(dAtA[i:], m.RevokeAll[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RevokeAll[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketManagePermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketManagePermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketManagePermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketManageReqAttrsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketManageReqAttrsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketManageReqAttrsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreateCommitmentToRemove) > 0 {
+		for iNdEx := len(m.CreateCommitmentToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateCommitmentToRemove[iNdEx])
+			copy(dAtA[i:], m.CreateCommitmentToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateCommitmentToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CreateCommitmentToAdd) > 0 {
+		for iNdEx := len(m.CreateCommitmentToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateCommitmentToAdd[iNdEx])
+			copy(dAtA[i:], m.CreateCommitmentToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateCommitmentToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CreateBidToRemove) > 0 {
+		for iNdEx := len(m.CreateBidToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateBidToRemove[iNdEx])
+			copy(dAtA[i:], m.CreateBidToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateBidToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CreateBidToAdd) > 0 {
+		for iNdEx := len(m.CreateBidToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateBidToAdd[iNdEx])
+			copy(dAtA[i:], m.CreateBidToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateBidToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreateAskToRemove) > 0 {
+		for iNdEx := len(m.CreateAskToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateAskToRemove[iNdEx])
+			copy(dAtA[i:], m.CreateAskToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateAskToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CreateAskToAdd) > 0 {
+		for iNdEx := len(m.CreateAskToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CreateAskToAdd[iNdEx])
+			copy(dAtA[i:], m.CreateAskToAdd[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CreateAskToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketManageReqAttrsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketManageReqAttrsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketManageReqAttrsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sources[iNdEx])
+			copy(dAtA[i:], m.Sources[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Sources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRejectPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRejectPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRejectPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalIds) > 0 {
+		for iNdEx := len(m.ExternalIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExternalIds[iNdEx])
+			copy(dAtA[i:], m.ExternalIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangePaymentTargetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangePaymentTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangePaymentTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewTarget) > 0 {
+		i -= len(m.NewTarget)
+		copy(dAtA[i:], m.NewTarget)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewTarget)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangePaymentTargetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangePaymentTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangePaymentTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssuerCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssuerCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssuerCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssuerCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssuerCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssuerCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovManageFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnsetFeeCommitmentSettlementBips {
+		i--
+		if m.UnsetFeeCommitmentSettlementBips {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.SetFeeCommitmentSettlementBips != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SetFeeCommitmentSettlementBips))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RemoveFeeCreateCommitmentFlat) > 0 {
+		for iNdEx := len(m.RemoveFeeCreateCommitmentFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeCreateCommitmentFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.AddFeeCreateCommitmentFlat) > 0 {
+		for iNdEx := len(m.AddFeeCreateCommitmentFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeCreateCommitmentFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RemoveFeeBuyerSettlementRatios) > 0 {
+		for iNdEx := len(m.RemoveFeeBuyerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeBuyerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AddFeeBuyerSettlementRatios) > 0 {
+		for iNdEx := len(m.AddFeeBuyerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeBuyerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RemoveFeeBuyerSettlementFlat) > 0 {
+		for iNdEx := len(m.RemoveFeeBuyerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeBuyerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AddFeeBuyerSettlementFlat) > 0 {
+		for iNdEx := len(m.AddFeeBuyerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeBuyerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RemoveFeeSellerSettlementRatios) > 0 {
+		for iNdEx := len(m.RemoveFeeSellerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeSellerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AddFeeSellerSettlementRatios) > 0 {
+		for iNdEx := len(m.AddFeeSellerSettlementRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeSellerSettlementRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RemoveFeeSellerSettlementFlat) > 0 {
+		for iNdEx := len(m.RemoveFeeSellerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeSellerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AddFeeSellerSettlementFlat) > 0 {
+		for iNdEx := len(m.AddFeeSellerSettlementFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeSellerSettlementFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RemoveFeeCreateBidFlat) > 0 {
+		for iNdEx := len(m.RemoveFeeCreateBidFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeCreateBidFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AddFeeCreateBidFlat) > 0 {
+		for iNdEx := len(m.AddFeeCreateBidFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeCreateBidFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RemoveFeeCreateAskFlat) > 0 {
+		for iNdEx := len(m.RemoveFeeCreateAskFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveFeeCreateAskFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddFeeCreateAskFlat) > 0 {
+		for iNdEx := len(m.AddFeeCreateAskFlat) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddFeeCreateAskFlat[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovManageFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovCloseMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovCloseMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovCloseMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovCloseMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovCloseMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovCloseMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateAskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AskOrder.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderCreationFee != nil {
+		l = m.OrderCreationFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateAskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCreateBidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BidOrder.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderCreationFee != nil {
+		l = m.OrderCreationFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCommitFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CreationFee != nil {
+		l = m.CreationFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EventTag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFillBidsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.TotalAssets) > 0 {
+		for _, e := range m.TotalAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BidOrderIds) > 0 {
+		l = 0
+		for _, e := range m.BidOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.SellerSettlementFlatFee != nil {
+		l = m.SellerSettlementFlatFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskOrderCreationFee != nil {
+		l = m.AskOrderCreationFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFillBidsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFillAsksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.TotalPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.AskOrderIds) > 0 {
+		l = 0
+		for _, e := range m.AskOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BuyerSettlementFees) > 0 {
+		for _, e := range m.BuyerSettlementFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.BidOrderCreationFee != nil {
+		l = m.BidOrderCreationFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFillAsksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketSettleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.AskOrderIds) > 0 {
+		l = 0
+		for _, e := range m.AskOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.BidOrderIds) > 0 {
+		l = 0
+		for _, e := range m.BidOrderIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.ExpectPartial {
+		n += 2
+	}
+	if m.NetTransfers {
+		n += 2
+	}
+	if m.OverridePriceBand {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketSettleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRFQRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgCreateRFQResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RfqId != 0 {
+		n += 1 + sovTx(uint64(m.RfqId))
+	}
+	return n
+}
+
+func (m *MsgCancelRFQRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RfqId != 0 {
+		n += 1 + sovTx(uint64(m.RfqId))
+	}
+	return n
+}
+
+func (m *MsgCancelRFQResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RfqId != 0 {
+		n += 1 + sovTx(uint64(m.RfqId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SellerSettlementFlatFee != nil {
+		l = m.SellerSettlementFlatFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RfqId != 0 {
+		n += 1 + sovTx(uint64(m.RfqId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptQuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RfqId != 0 {
+		n += 1 + sovTx(uint64(m.RfqId))
+	}
+	l = len(m.Dealer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.BuyerSettlementFees) > 0 {
+		for _, e := range m.BuyerSettlementFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAcceptQuoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AskOrderId != 0 {
+		n += 1 + sovTx(uint64(m.AskOrderId))
+	}
+	if m.BidOrderId != 0 {
+		n += 1 + sovTx(uint64(m.BidOrderId))
+	}
+	return n
+}
+
+func (m *MsgMarketCommitmentSettleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Navs) > 0 {
+		for _, e := range m.Navs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.EventTag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarketCommitmentSettleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketReleaseCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.ToRelease) > 0 {
+		for _, e := range m.ToRelease {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.EventTag)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarketReleaseCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketSetOrderExternalIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarketSetOrderExternalIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMarketWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.MarketDetails.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMarketUpdateDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.AcceptingOrders {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateAcceptingOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.AcceptingOrders {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateAcceptingOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateUserSettleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.AllowUserSettlement {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateUserSettleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.AcceptingCommitments {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = len(m.IntermediaryDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateRebatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMarketUpdateRebatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateExternalSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMarketUpdateExternalSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdatePriceBandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMarketUpdatePriceBandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketResumeSettlementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *MsgMarketResumeSettlementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketStartExternalSettlementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarketStartExternalSettlementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
+func (m *MsgConfirmExternalPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = len(m.PaymentReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmExternalPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketManagePermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.RevokeAll) > 0 {
+		for _, s := range m.RevokeAll {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRevoke) > 0 {
+		for _, e := range m.ToRevoke {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToGrant) > 0 {
+		for _, e := range m.ToGrant {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMarketManagePermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketManageReqAttrsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.CreateAskToAdd) > 0 {
+		for _, s := range m.CreateAskToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreateAskToRemove) > 0 {
+		for _, s := range m.CreateAskToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreateBidToAdd) > 0 {
+		for _, s := range m.CreateBidToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreateBidToRemove) > 0 {
+		for _, s := range m.CreateBidToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreateCommitmentToAdd) > 0 {
+		for _, s := range m.CreateCommitmentToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CreateCommitmentToRemove) > 0 {
+		for _, s := range m.CreateCommitmentToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMarketManageReqAttrsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRejectPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRejectPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, s := range m.Sources {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRejectPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExternalIds) > 0 {
+		for _, s := range m.ExternalIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangePaymentTargetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewTarget)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangePaymentTargetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIssuerCreateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Market.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgIssuerCreateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *MsgGovCreateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Market.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGovCreateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovManageFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if len(m.AddFeeCreateAskFlat) > 0 {
+		for _, e := range m.AddFeeCreateAskFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeCreateAskFlat) > 0 {
+		for _, e := range m.RemoveFeeCreateAskFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddFeeCreateBidFlat) > 0 {
+		for _, e := range m.AddFeeCreateBidFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeCreateBidFlat) > 0 {
+		for _, e := range m.RemoveFeeCreateBidFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddFeeSellerSettlementFlat) > 0 {
+		for _, e := range m.AddFeeSellerSettlementFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeSellerSettlementFlat) > 0 {
+		for _, e := range m.RemoveFeeSellerSettlementFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddFeeSellerSettlementRatios) > 0 {
+		for _, e := range m.AddFeeSellerSettlementRatios {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeSellerSettlementRatios) > 0 {
+		for _, e := range m.RemoveFeeSellerSettlementRatios {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddFeeBuyerSettlementFlat) > 0 {
+		for _, e := range m.AddFeeBuyerSettlementFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeBuyerSettlementFlat) > 0 {
+		for _, e := range m.RemoveFeeBuyerSettlementFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddFeeBuyerSettlementRatios) > 0 {
+		for _, e := range m.AddFeeBuyerSettlementRatios {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeBuyerSettlementRatios) > 0 {
+		for _, e := range m.RemoveFeeBuyerSettlementRatios {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AddFeeCreateCommitmentFlat) > 0 {
+		for _, e := range m.AddFeeCreateCommitmentFlat {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveFeeCreateCommitmentFlat) > 0 {
+		for _, e := range m.RemoveFeeCreateCommitmentFlat {
+			l = e.Size()
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SetFeeCommitmentSettlementBips != 0 {
+		n += 2 + sovTx(uint64(m.SetFeeCommitmentSettlementBips))
+	}
+	if m.UnsetFeeCommitmentSettlementBips {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgGovManageFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovCloseMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *MsgGovCloseMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGovUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateAskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderCreationFee == nil {
+				m.OrderCreationFee = &types.Coin{}
+			}
+			if err := m.OrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateAskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateAskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderCreationFee == nil {
+				m.OrderCreationFee = &types.Coin{}
+			}
+			if err := m.OrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationFee == nil {
+				m.CreationFee = &types.Coin{}
+			}
+			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFillBidsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFillBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAssets = append(m.TotalAssets, types.Coin{})
+			if err := m.TotalAssets[len(m.TotalAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BidOrderIds = append(m.BidOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BidOrderIds) == 0 {
+					m.BidOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BidOrderIds = append(m.BidOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerSettlementFlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SellerSettlementFlatFee == nil {
+				m.SellerSettlementFlatFee = &types.Coin{}
+			}
+			if err := m.SellerSettlementFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AskOrderCreationFee == nil {
+				m.AskOrderCreationFee = &types.Coin{}
+			}
+			if err := m.AskOrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFillBidsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFillBidsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFillBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFillAsksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFillAsksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AskOrderIds = append(m.AskOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AskOrderIds) == 0 {
+					m.AskOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AskOrderIds = append(m.AskOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerSettlementFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerSettlementFees = append(m.BuyerSettlementFees, types.Coin{})
+			if err := m.BuyerSettlementFees[len(m.BuyerSettlementFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidOrderCreationFee == nil {
+				m.BidOrderCreationFee = &types.Coin{}
+			}
+			if err := m.BidOrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFillAsksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFillAsksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFillAsksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketSettleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketSettleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AskOrderIds = append(m.AskOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AskOrderIds) == 0 {
+					m.AskOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AskOrderIds = append(m.AskOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderIds", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BidOrderIds = append(m.BidOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BidOrderIds) == 0 {
+					m.BidOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BidOrderIds = append(m.BidOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderIds", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectPartial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpectPartial = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NetTransfers = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverridePriceBand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OverridePriceBand = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketSettleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketSettleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRFQRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRFQRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRFQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRFQResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRFQResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRFQResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRFQRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRFQRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRFQRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCancelRFQResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRFQResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRFQResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateAskRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitQuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8371,15 +13697,66 @@ func (m *MsgCreateAskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitQuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8406,13 +13783,13 @@ func (m *MsgCreateAskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerSettlementFlatFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8439,10 +13816,10 @@ func (m *MsgCreateAskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OrderCreationFee == nil {
-				m.OrderCreationFee = &types.Coin{}
+			if m.SellerSettlementFlatFee == nil {
+				m.SellerSettlementFlatFee = &types.Coin{}
 			}
-			if err := m.OrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SellerSettlementFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8467,7 +13844,7 @@ func (m *MsgCreateAskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateAskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8490,17 +13867,99 @@ func (m *MsgCreateAskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateAskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawQuoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawQuoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
 			}
-			m.OrderId = 0
+			m.RfqId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8510,7 +13969,7 @@ func (m *MsgCreateAskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.RfqId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8536,7 +13995,57 @@ func (m *MsgCreateAskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBidRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawQuoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawQuoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptQuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8559,15 +14068,98 @@ func (m *MsgCreateBidRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBidRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptQuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptQuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RfqId", wireType)
+			}
+			m.RfqId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RfqId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dealer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dealer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8594,13 +14186,13 @@ func (m *MsgCreateBidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerSettlementFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8627,10 +14219,8 @@ func (m *MsgCreateBidRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OrderCreationFee == nil {
-				m.OrderCreationFee = &types.Coin{}
-			}
-			if err := m.OrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BuyerSettlementFees = append(m.BuyerSettlementFees, types.Coin{})
+			if err := m.BuyerSettlementFees[len(m.BuyerSettlementFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8655,7 +14245,7 @@ func (m *MsgCreateBidRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptQuoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8678,17 +14268,17 @@ func (m *MsgCreateBidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptQuoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptQuoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderId", wireType)
 			}
-			m.OrderId = 0
+			m.AskOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8698,7 +14288,26 @@ func (m *MsgCreateBidResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				m.AskOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderId", wireType)
+			}
+			m.BidOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BidOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8724,7 +14333,7 @@ func (m *MsgCreateBidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitFundsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8747,15 +14356,15 @@ func (m *MsgCommitFundsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitFundsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketCommitmentSettleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketCommitmentSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8783,7 +14392,7 @@ func (m *MsgCommitFundsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -8806,7 +14415,7 @@ func (m *MsgCommitFundsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8833,184 +14442,84 @@ func (m *MsgCommitFundsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, AccountAmount{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CreationFee == nil {
-				m.CreationFee = &types.Coin{}
-			}
-			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventTag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCommitFundsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitFundsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelOrderRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Outputs = append(m.Outputs, AccountAmount{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Fees = append(m.Fees, AccountAmount{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9020,29 +14529,31 @@ func (m *MsgCancelOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Navs = append(m.Navs, NetAssetPrice{})
+			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
 			}
-			m.OrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9052,11 +14563,24 @@ func (m *MsgCancelOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9078,7 +14602,7 @@ func (m *MsgCancelOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketCommitmentSettleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9101,10 +14625,10 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketCommitmentSettleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketCommitmentSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9128,7 +14652,7 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketReleaseCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9151,15 +14675,15 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFillBidsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFillBidsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9187,7 +14711,7 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -9202,125 +14726,15 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAssets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalAssets = append(m.TotalAssets, types.Coin{})
-			if err := m.TotalAssets[len(m.TotalAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BidOrderIds = append(m.BidOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BidOrderIds) == 0 {
-					m.BidOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BidOrderIds = append(m.BidOrderIds, v)
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderIds", wireType)
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellerSettlementFlatFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRelease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9347,18 +14761,16 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SellerSettlementFlatFee == nil {
-				m.SellerSettlementFlatFee = &types.Coin{}
-			}
-			if err := m.SellerSettlementFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ToRelease = append(m.ToRelease, AccountAmount{})
+			if err := m.ToRelease[len(m.ToRelease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9368,27 +14780,23 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AskOrderCreationFee == nil {
-				m.AskOrderCreationFee = &types.Coin{}
-			}
-			if err := m.AskOrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventTag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9411,7 +14819,7 @@ func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFillBidsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketReleaseCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9434,10 +14842,10 @@ func (m *MsgFillBidsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFillBidsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFillBidsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9461,7 +14869,7 @@ func (m *MsgFillBidsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketSetOrderExternalIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9484,15 +14892,15 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFillAsksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFillAsksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9520,7 +14928,7 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -9542,10 +14950,29 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9555,106 +14982,180 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketSetOrderExternalIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketWithdrawRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketWithdrawRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.AskOrderIds = append(m.AskOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AskOrderIds) == 0 {
-					m.AskOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AskOrderIds = append(m.AskOrderIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderIds", wireType)
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuyerSettlementFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9664,29 +15165,27 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuyerSettlementFees = append(m.BuyerSettlementFees, types.Coin{})
-			if err := m.BuyerSettlementFees[len(m.BuyerSettlementFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9713,10 +15212,8 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BidOrderCreationFee == nil {
-				m.BidOrderCreationFee = &types.Coin{}
-			}
-			if err := m.BidOrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9741,7 +15238,7 @@ func (m *MsgFillAsksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFillAsksResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9764,10 +15261,10 @@ func (m *MsgFillAsksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFillAsksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFillAsksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9791,7 +15288,7 @@ func (m *MsgFillAsksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketSettleRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9814,10 +15311,10 @@ func (m *MsgMarketSettleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketSettleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateDetailsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9872,162 +15369,10 @@ func (m *MsgMarketSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AskOrderIds = append(m.AskOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AskOrderIds) == 0 {
-					m.AskOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AskOrderIds = append(m.AskOrderIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderIds", wireType)
-			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BidOrderIds = append(m.BidOrderIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BidOrderIds) == 0 {
-					m.BidOrderIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BidOrderIds = append(m.BidOrderIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderIds", wireType)
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectPartial", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketDetails", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10037,12 +15382,25 @@ func (m *MsgMarketSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExpectPartial = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10064,7 +15422,7 @@ func (m *MsgMarketSettleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketSettleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateDetailsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10087,10 +15445,10 @@ func (m *MsgMarketSettleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketSettleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateDetailsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10114,7 +15472,7 @@ func (m *MsgMarketSettleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10137,10 +15495,10 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketCommitmentSettleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketCommitmentSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10195,10 +15553,10 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptingOrders", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10208,65 +15566,117 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			m.AcceptingOrders = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, AccountAmount{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketUpdateEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Outputs = append(m.Outputs, AccountAmount{})
-			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketUpdateAcceptingOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10276,31 +15686,29 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, AccountAmount{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Navs", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10310,31 +15718,16 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Navs = append(m.Navs, NetAssetPrice{})
-			if err := m.Navs[len(m.Navs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptingOrders", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10344,24 +15737,12 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventTag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AcceptingOrders = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10383,7 +15764,7 @@ func (m *MsgMarketCommitmentSettleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketCommitmentSettleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateAcceptingOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10406,10 +15787,10 @@ func (m *MsgMarketCommitmentSettleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketCommitmentSettleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketCommitmentSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10433,7 +15814,7 @@ func (m *MsgMarketCommitmentSettleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketReleaseCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateUserSettleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10456,10 +15837,10 @@ func (m *MsgMarketReleaseCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateUserSettleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateUserSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10513,45 +15894,11 @@ func (m *MsgMarketReleaseCommitmentsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRelease", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToRelease = append(m.ToRelease, AccountAmount{})
-			if err := m.ToRelease[len(m.ToRelease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventTag", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUserSettlement", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10561,24 +15908,12 @@ func (m *MsgMarketReleaseCommitmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventTag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AllowUserSettlement = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10600,7 +15935,7 @@ func (m *MsgMarketReleaseCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketReleaseCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateUserSettleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10623,10 +15958,10 @@ func (m *MsgMarketReleaseCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateUserSettleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketReleaseCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateUserSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10650,7 +15985,7 @@ func (m *MsgMarketReleaseCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketSetOrderExternalIDRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10673,10 +16008,10 @@ func (m *MsgMarketSetOrderExternalIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10732,28 +16067,9 @@ func (m *MsgMarketSetOrderExternalIDRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			m.OrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptingCommitments", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -10763,24 +16079,12 @@ func (m *MsgMarketSetOrderExternalIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.AcceptingCommitments = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10802,7 +16106,7 @@ func (m *MsgMarketSetOrderExternalIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketSetOrderExternalIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10825,10 +16129,10 @@ func (m *MsgMarketSetOrderExternalIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketSetOrderExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10852,7 +16156,7 @@ func (m *MsgMarketSetOrderExternalIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketWithdrawRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10875,10 +16179,10 @@ func (m *MsgMarketWithdrawRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketWithdrawRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10934,7 +16238,7 @@ func (m *MsgMarketWithdrawRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10962,41 +16266,7 @@ func (m *MsgMarketWithdrawRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IntermediaryDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11019,7 +16289,7 @@ func (m *MsgMarketWithdrawRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketWithdrawResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateIntermediaryDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11042,10 +16312,10 @@ func (m *MsgMarketWithdrawResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketWithdrawResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11069,7 +16339,7 @@ func (m *MsgMarketWithdrawResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateDetailsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateRebatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11092,10 +16362,10 @@ func (m *MsgMarketUpdateDetailsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateDetailsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateRebatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateRebatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11151,7 +16421,7 @@ func (m *MsgMarketUpdateDetailsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11178,7 +16448,7 @@ func (m *MsgMarketUpdateDetailsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarketDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11203,7 +16473,7 @@ func (m *MsgMarketUpdateDetailsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateRebatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11226,10 +16496,10 @@ func (m *MsgMarketUpdateDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateRebatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateRebatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11253,7 +16523,7 @@ func (m *MsgMarketUpdateDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateEnabledRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateExternalSettlementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11276,10 +16546,10 @@ func (m *MsgMarketUpdateEnabledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateEnabledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateExternalSettlementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateExternalSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11334,10 +16604,10 @@ func (m *MsgMarketUpdateEnabledRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptingOrders", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11347,12 +16617,25 @@ func (m *MsgMarketUpdateEnabledRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AcceptingOrders = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11374,7 +16657,7 @@ func (m *MsgMarketUpdateEnabledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdateExternalSettlementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11397,10 +16680,10 @@ func (m *MsgMarketUpdateEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdateExternalSettlementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdateExternalSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11424,7 +16707,7 @@ func (m *MsgMarketUpdateEnabledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateAcceptingOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdatePriceBandRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11447,10 +16730,10 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdatePriceBandRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdatePriceBandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11505,10 +16788,10 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptingOrders", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11518,12 +16801,25 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AcceptingOrders = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11545,7 +16841,7 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateAcceptingOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketUpdatePriceBandResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11568,10 +16864,10 @@ func (m *MsgMarketUpdateAcceptingOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketUpdatePriceBandResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketUpdatePriceBandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11595,7 +16891,7 @@ func (m *MsgMarketUpdateAcceptingOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateUserSettleRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketResumeSettlementsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11618,10 +16914,10 @@ func (m *MsgMarketUpdateUserSettleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateUserSettleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketResumeSettlementsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateUserSettleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketResumeSettlementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11675,26 +16971,6 @@ func (m *MsgMarketUpdateUserSettleRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUserSettlement", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowUserSettlement = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11716,7 +16992,7 @@ func (m *MsgMarketUpdateUserSettleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateUserSettleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketResumeSettlementsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11739,10 +17015,10 @@ func (m *MsgMarketUpdateUserSettleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateUserSettleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketResumeSettlementsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateUserSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketResumeSettlementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11766,7 +17042,7 @@ func (m *MsgMarketUpdateUserSettleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketStartExternalSettlementRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11789,10 +17065,10 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketStartExternalSettlementRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketStartExternalSettlementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11848,9 +17124,9 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) erro
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptingCommitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var v int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11860,12 +17136,43 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AcceptingCommitments = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11887,7 +17194,7 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarketStartExternalSettlementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11910,12 +17217,31 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarketStartExternalSettlementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateAcceptingCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarketStartExternalSettlementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11937,7 +17263,7 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmExternalPaymentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11960,15 +17286,15 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmExternalPaymentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmExternalPaymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11996,7 +17322,7 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -12018,8 +17344,27 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentReference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12047,7 +17392,7 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IntermediaryDenom = string(dAtA[iNdEx:postIndex])
+			m.PaymentReference = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12070,7 +17415,7 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarketUpdateIntermediaryDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmExternalPaymentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12093,10 +17438,10 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmExternalPaymentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarketUpdateIntermediaryDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmExternalPaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13700,6 +19045,222 @@ func (m *MsgChangePaymentTargetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgIssuerCreateMarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssuerCreateMarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssuerCreateMarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIssuerCreateMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIssuerCreateMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIssuerCreateMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgGovCreateMarketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
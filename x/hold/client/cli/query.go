@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -31,6 +32,10 @@ func QueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		QueryCmdGetHolds(),
 		QueryCmdGetAllHolds(),
+		QueryCmdGetEscrow(),
+		QueryCmdGetAllEscrows(),
+		QueryCmdGetScopeLock(),
+		QueryCmdGetAllScopeLocks(),
 	)
 
 	return cmd
@@ -108,3 +113,141 @@ func QueryCmdGetAllHolds() *cobra.Command {
 
 	return cmd
 }
+
+func QueryCmdGetEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow <escrow id>",
+		Aliases: []string{"get-escrow"},
+		Short:   "Get an escrow by its id",
+		Example: fmt.Sprintf("$ %s escrow 3", exampleQueryCmdBase),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := hold.GetEscrowRequest{}
+			req.EscrowId, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid escrow id %q: %w", args[0], err)
+			}
+
+			var res *hold.GetEscrowResponse
+			queryClient := hold.NewQueryClient(clientCtx)
+			res, err = queryClient.GetEscrow(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryCmdGetAllEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrows",
+		Aliases: []string{"all-escrows"},
+		Short:   "Get all escrows",
+		Example: fmt.Sprintf("$ %s escrows", exampleQueryCmdBase),
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := hold.GetAllEscrowsRequest{}
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *hold.GetAllEscrowsResponse
+			queryClient := hold.NewQueryClient(clientCtx)
+			res, err = queryClient.GetAllEscrows(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all escrows")
+
+	return cmd
+}
+
+func QueryCmdGetScopeLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-lock <scope id>",
+		Aliases: []string{"get-scope-lock"},
+		Short:   "Get the lock on a scope's value ownership",
+		Example: fmt.Sprintf("$ %s scope-lock %s", exampleQueryCmdBase, exampleScopeID),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := hold.GetScopeLockRequest{ScopeId: args[0]}
+
+			var res *hold.GetScopeLockResponse
+			queryClient := hold.NewQueryClient(clientCtx)
+			res, err = queryClient.GetScopeLock(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryCmdGetAllScopeLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-locks",
+		Aliases: []string{"all-scope-locks"},
+		Short:   "Get all scope value ownership locks",
+		Example: fmt.Sprintf("$ %s scope-locks", exampleQueryCmdBase),
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := hold.GetAllScopeLocksRequest{}
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *hold.GetAllScopeLocksResponse
+			queryClient := hold.NewQueryClient(clientCtx)
+			res, err = queryClient.GetAllScopeLocks(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all scope locks")
+
+	return cmd
+}
@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	hold "github.com/provenance-io/provenance/x/hold"
+)
+
+const (
+	FlagArbiter         = "arbiter"
+	FlagDescription     = "description"
+	FlagPayCounterparty = "pay-counterparty"
+	FlagUnlocker        = "unlocker"
+	FlagReason          = "reason"
+)
+
+// exampleTxCmdBase is the base command that gets a user to one of the tx commands in here.
+var exampleTxCmdBase = fmt.Sprintf("%s tx %s", version.AppName, hold.ModuleName)
+
+var exampleTxAddr1 = sdk.AccAddress("exampleTxAddr1______")
+
+// exampleScopeID is a scope id used in the examples of the commands in here.
+const exampleScopeID = "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"
+
+func TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        hold.ModuleName,
+		Short:                      "Transaction commands for the hold module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		TxCmdCreateEscrow(),
+		TxCmdReleaseEscrow(),
+		TxCmdLockScope(),
+		TxCmdUnlockScope(),
+	)
+
+	return cmd
+}
+
+func TxCmdCreateEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-escrow <counterparty> <amount>",
+		Short: "Place funds on hold that can only be released with the approval of a counterparty or arbiter",
+		Example: fmt.Sprintf("$ %s create-escrow %s 100nhash --%s %s --from mykey",
+			exampleTxCmdBase, exampleTxAddr1, FlagDescription, `"deposit for widgets"`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &hold.MsgCreateEscrowRequest{
+				Holder:       clientCtx.GetFromAddress().String(),
+				Counterparty: args[0],
+			}
+			msg.Amount, err = sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+			msg.Arbiter, err = cmd.Flags().GetString(FlagArbiter)
+			if err != nil {
+				return err
+			}
+			msg.Description, err = cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagArbiter, "", "The address of an account that can approve a release in place of the counterparty")
+	cmd.Flags().String(FlagDescription, "", "A description of the deal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxCmdReleaseEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-escrow <escrow id> <approver>",
+		Short: "Release the funds of an escrow",
+		Long: `Release the funds of an escrow.
+The --from account must be the escrow's holder.
+The approver must be either the escrow's counterparty or arbiter, and must also sign the transaction.`,
+		Example: fmt.Sprintf("$ %s release-escrow 3 %s --%s --from mykey --generate-only",
+			exampleTxCmdBase, exampleTxAddr1, FlagPayCounterparty),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &hold.MsgReleaseEscrowRequest{
+				Holder:   clientCtx.GetFromAddress().String(),
+				Approver: args[1],
+			}
+			msg.EscrowId, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid escrow id %q: %w", args[0], err)
+			}
+			msg.PayCounterparty, err = cmd.Flags().GetBool(FlagPayCounterparty)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagPayCounterparty, false, "Send the released funds to the counterparty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxCmdLockScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-scope <scope id>",
+		Short: "Lock the value ownership of a scope so that its value owner cannot be changed",
+		Long: `Lock the value ownership of a scope so that its value owner cannot be changed.
+The --from account must be the scope's value owner.
+If an --unlocker is provided, only it can unlock the scope. Otherwise, the value owner can.`,
+		Example: fmt.Sprintf("$ %s lock-scope %s --%s %s --%s %s --from mykey",
+			exampleTxCmdBase, exampleScopeID, FlagUnlocker, exampleTxAddr1, FlagReason, `"exchange listing"`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &hold.MsgLockScopeRequest{
+				ValueOwner: clientCtx.GetFromAddress().String(),
+				ScopeId:    args[0],
+			}
+			msg.Unlocker, err = cmd.Flags().GetString(FlagUnlocker)
+			if err != nil {
+				return err
+			}
+			msg.Reason, err = cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagUnlocker, "", "The address of the account that can unlock the scope")
+	cmd.Flags().String(FlagReason, "", "The reason the scope is being locked")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxCmdUnlockScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-scope <scope id>",
+		Short: "Unlock the value ownership of a scope",
+		Long: `Unlock the value ownership of a scope.
+The --from account must be the lock's unlocker, or the scope's value owner if the lock doesn't have an unlocker.`,
+		Example: fmt.Sprintf("$ %s unlock-scope %s --from mykey", exampleTxCmdBase, exampleScopeID),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &hold.MsgUnlockScopeRequest{
+				Signer:  clientCtx.GetFromAddress().String(),
+				ScopeId: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
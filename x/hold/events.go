@@ -2,17 +2,54 @@ package hold
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-func NewEventHoldAdded(addr sdk.AccAddress, amount sdk.Coins, reason string) *EventHoldAdded {
+func NewEventHoldAdded(addr sdk.AccAddress, amount sdk.Coins, reason HoldReason) *EventHoldAdded {
 	return &EventHoldAdded{
-		Address: addr.String(),
-		Amount:  amount.String(),
-		Reason:  reason,
+		Address:  addr.String(),
+		Amount:   amount.String(),
+		Reason:   reason.Description,
+		Code:     reason.Code,
+		Metadata: reason.Metadata,
 	}
 }
 
-func NewEventHoldReleased(addr sdk.AccAddress, amount sdk.Coins) *EventHoldReleased {
+func NewEventHoldReleased(addr sdk.AccAddress, amount sdk.Coins, code HoldReasonCode) *EventHoldReleased {
 	return &EventHoldReleased{
 		Address: addr.String(),
 		Amount:  amount.String(),
+		Code:    code,
+	}
+}
+
+func NewEventEscrowCreated(escrow *Escrow) *EventEscrowCreated {
+	return &EventEscrowCreated{
+		EscrowId:     escrow.Id,
+		Holder:       escrow.Holder,
+		Counterparty: escrow.Counterparty,
+		Arbiter:      escrow.Arbiter,
+		Amount:       escrow.Amount.String(),
+	}
+}
+
+func NewEventEscrowReleased(escrowID uint64, approver, recipient sdk.AccAddress) *EventEscrowReleased {
+	return &EventEscrowReleased{
+		EscrowId:  escrowID,
+		Approver:  approver.String(),
+		Recipient: recipient.String(),
+	}
+}
+
+func NewEventScopeLocked(lock *ScopeLock) *EventScopeLocked {
+	return &EventScopeLocked{
+		ScopeId:    lock.ScopeId,
+		ValueOwner: lock.ValueOwner,
+		Unlocker:   lock.Unlocker,
+		Reason:     lock.Reason,
+	}
+}
+
+func NewEventScopeUnlocked(scopeID string, unlocker sdk.AccAddress) *EventScopeUnlocked {
+	return &EventScopeUnlocked{
+		ScopeId:  scopeID,
+		Unlocker: unlocker.String(),
 	}
 }
@@ -20,7 +20,7 @@ func TestNewEventHoldAdded(t *testing.T) {
 		name   string
 		addr   sdk.AccAddress
 		amount sdk.Coins
-		reason string
+		reason HoldReason
 		exp    *EventHoldAdded
 	}{
 		{
@@ -46,20 +46,37 @@ func TestNewEventHoldAdded(t *testing.T) {
 		},
 		{
 			name:   "only a reason",
-			reason: "this is a test reason",
+			reason: HoldReason{Description: "this is a test reason"},
 			exp:    &EventHoldAdded{Reason: "this is a test reason"},
 		},
 		{
 			name:   "control",
 			addr:   sdk.AccAddress("control_address_____"),
 			amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)),
-			reason: "control reason",
+			reason: HoldReason{Description: "control reason"},
 			exp: &EventHoldAdded{
 				Address: sdk.AccAddress("control_address_____").String(),
 				Amount:  sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)).String(),
 				Reason:  "control reason",
 			},
 		},
+		{
+			name:   "with a code and metadata",
+			addr:   sdk.AccAddress("control_address_____"),
+			amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)),
+			reason: HoldReason{
+				Code:        HoldReasonCode_HOLD_REASON_CODE_LEGAL_HOLD,
+				Description: "court order",
+				Metadata:    []HoldMetadata{{Key: "case", Value: "24-1138"}},
+			},
+			exp: &EventHoldAdded{
+				Address:  sdk.AccAddress("control_address_____").String(),
+				Amount:   sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)).String(),
+				Reason:   "court order",
+				Code:     HoldReasonCode_HOLD_REASON_CODE_LEGAL_HOLD,
+				Metadata: []HoldMetadata{{Key: "case", Value: "24-1138"}},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -75,6 +92,7 @@ func TestNewEventHoldReleased(t *testing.T) {
 		name   string
 		addr   sdk.AccAddress
 		amount sdk.Coins
+		code   HoldReasonCode
 		exp    *EventHoldReleased
 	}{
 		{
@@ -98,11 +116,22 @@ func TestNewEventHoldReleased(t *testing.T) {
 				Amount:  "10fingercoin,9toecoin",
 			},
 		},
+		{
+			name:   "with a code",
+			addr:   sdk.AccAddress("normal_address______"),
+			amount: sdk.NewCoins(sdk.NewInt64Coin("fingercoin", 10)),
+			code:   HoldReasonCode_HOLD_REASON_CODE_ESCROW,
+			exp: &EventHoldReleased{
+				Address: sdk.AccAddress("normal_address______").String(),
+				Amount:  "10fingercoin",
+				Code:    HoldReasonCode_HOLD_REASON_CODE_ESCROW,
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			event := NewEventHoldReleased(tc.addr, tc.amount)
+			event := NewEventHoldReleased(tc.addr, tc.amount, tc.code)
 			assert.Equal(t, tc.exp, event, "NewEventHoldReleased")
 		})
 	}
@@ -121,24 +150,27 @@ func TestTypedEventToEvent(t *testing.T) {
 	}{
 		{
 			name: "EventHoldAdded",
-			tev:  NewEventHoldAdded(addr, coins, "test reason"),
+			tev:  NewEventHoldAdded(addr, coins, HoldReason{Description: "test reason"}),
 			expEvent: sdk.Event{
 				Type: "provenance.hold.v1.EventHoldAdded",
 				Attributes: []abci.EventAttribute{
 					{Key: "address", Value: addrQ},
 					{Key: "amount", Value: coinsQ},
+					{Key: "code", Value: `"HOLD_REASON_CODE_UNSPECIFIED"`},
+					{Key: "metadata", Value: `[]`},
 					{Key: "reason", Value: `"test reason"`},
 				},
 			},
 		},
 		{
 			name: "EventHoldReleased",
-			tev:  NewEventHoldReleased(addr, coins),
+			tev:  NewEventHoldReleased(addr, coins, HoldReasonCode_HOLD_REASON_CODE_ESCROW),
 			expEvent: sdk.Event{
 				Type: "provenance.hold.v1.EventHoldReleased",
 				Attributes: []abci.EventAttribute{
 					{Key: "address", Value: addrQ},
 					{Key: "amount", Value: coinsQ},
+					{Key: "code", Value: `"HOLD_REASON_CODE_ESCROW"`},
 				},
 			},
 		},
@@ -10,4 +10,5 @@ import (
 type BankKeeper interface {
 	AppendLockedCoinsGetter(getter banktypes.GetLockedCoinsFn)
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
@@ -3,6 +3,8 @@ package hold
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func DefaultGenesisState() *GenesisState {
@@ -11,6 +13,7 @@ func DefaultGenesisState() *GenesisState {
 
 func (g GenesisState) Validate() error {
 	addrs := make(map[string]int)
+	held := make(map[string]sdk.Coins)
 	var errs []error
 	for i, ah := range g.Holds {
 		if ah == nil {
@@ -26,7 +29,67 @@ func (g GenesisState) Validate() error {
 			errs = append(errs, fmt.Errorf("invalid holds[%d]: duplicate address also at index %d", i, j))
 		} else {
 			addrs[ah.Address] = i
+			held[ah.Address] = ah.Amount
+		}
+	}
+
+	ids := make(map[uint64]int)
+	escrowed := make(map[string]sdk.Coins)
+	for i, escrow := range g.Escrows {
+		if escrow == nil {
+			errs = append(errs, fmt.Errorf("invalid escrows[%d]: cannot be nil", i))
+			continue
+		}
+		if err := escrow.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid escrows[%d]: %w", i, err))
+			continue
 		}
+		if escrow.Id > g.LastEscrowId {
+			errs = append(errs, fmt.Errorf("invalid escrows[%d]: id %d is greater than the last escrow id %d",
+				i, escrow.Id, g.LastEscrowId))
+		}
+		j, seen := ids[escrow.Id]
+		if seen {
+			errs = append(errs, fmt.Errorf("invalid escrows[%d]: duplicate id also at index %d", i, j))
+		} else {
+			ids[escrow.Id] = i
+		}
+		escrowed[escrow.Holder] = escrowed[escrow.Holder].Add(escrow.Amount...)
 	}
+
+	for i, escrow := range g.Escrows {
+		if escrow == nil {
+			continue
+		}
+		if amt, ok := escrowed[escrow.Holder]; ok && !held[escrow.Holder].IsAllGTE(amt) {
+			errs = append(errs, fmt.Errorf("invalid escrows[%d]: total escrowed %q for %s is more than the amount on hold %q",
+				i, amt, escrow.Holder, held[escrow.Holder]))
+			delete(escrowed, escrow.Holder)
+		}
+	}
+
+	scopes := make(map[string]int)
+	for i, lock := range g.ScopeLocks {
+		if lock == nil {
+			errs = append(errs, fmt.Errorf("invalid scope locks[%d]: cannot be nil", i))
+			continue
+		}
+		if err := lock.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid scope locks[%d]: %w", i, err))
+			continue
+		}
+		j, seen := scopes[lock.ScopeId]
+		if seen {
+			errs = append(errs, fmt.Errorf("invalid scope locks[%d]: duplicate scope id also at index %d", i, j))
+			continue
+		}
+		scopes[lock.ScopeId] = i
+		scopeID, _ := ParseScopeID(lock.ScopeId) // Can't fail since we know the lock is valid.
+		if coin := scopeID.Coin(); !held[lock.ValueOwner].IsAllGTE(sdk.Coins{coin}) {
+			errs = append(errs, fmt.Errorf("invalid scope locks[%d]: scope coin %q is not on hold for %s",
+				i, coin, lock.ValueOwner))
+		}
+	}
+
 	return errors.Join(errs...)
 }
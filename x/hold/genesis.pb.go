@@ -27,6 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// holds defines the funds on hold at genesis.
 	Holds []*AccountHold `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	// escrows defines the escrows that exist at genesis.
+	// The funds for these are expected to also be included in the holds.
+	Escrows []*Escrow `protobuf:"bytes,2,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	// last_escrow_id is the most recently used escrow id.
+	LastEscrowId uint64 `protobuf:"varint,3,opt,name=last_escrow_id,json=lastEscrowId,proto3" json:"last_escrow_id,omitempty"`
+	// scope_locks defines the scope value ownership locks that exist at genesis.
+	// The scope coins for these are expected to also be included in the holds.
+	ScopeLocks []*ScopeLock `protobuf:"bytes,4,rep,name=scope_locks,json=scopeLocks,proto3" json:"scope_locks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,21 +77,26 @@ func init() {
 func init() { proto.RegisterFile("provenance/hold/v1/genesis.proto", fileDescriptor_21691a3a4f2bf41c) }
 
 var fileDescriptor_21691a3a4f2bf41c = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
+	// 295 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
 	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0xc8, 0xcf, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x4f,
 	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0xa8,
 	0xd0, 0x03, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0xf9, 0x73, 0xf1, 0xb8,
-	0x43, 0x4c, 0x0e, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe5, 0x62, 0x05, 0xc9, 0x16, 0x4b, 0x30,
-	0x2a, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xeb, 0x61, 0x5a, 0xa4, 0xe7, 0x98, 0x9c, 0x9c, 0x5f, 0x9a,
-	0x57, 0xe2, 0x91, 0x9f, 0x93, 0x12, 0x04, 0x51, 0x6d, 0xc5, 0xd1, 0xb1, 0x40, 0x9e, 0xe1, 0xc5,
-	0x02, 0x79, 0x06, 0xa7, 0xd8, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48,
-	0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0xe0, 0x12,
-	0xcd, 0xcc, 0xc7, 0x62, 0x5a, 0x00, 0x63, 0x94, 0x56, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0x42, 0x81, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf, 0x02, 0xec, 0xea, 0x24,
-	0x36, 0xb0, 0xb3, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x8b, 0x1a, 0x69, 0x23, 0x01,
-	0x00, 0x00,
+	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0xbd, 0x66, 0xe4, 0xe2,
+	0x71, 0x87, 0x18, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xca, 0xc5, 0x0a, 0x92, 0x2e, 0x96,
+	0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xb4, 0x49, 0xcf, 0x31, 0x39, 0x39, 0xbf,
+	0x34, 0xaf, 0xc4, 0x23, 0x3f, 0x27, 0x25, 0x08, 0xa2, 0x5a, 0xc8, 0x84, 0x8b, 0x3d, 0xb5, 0x38,
+	0xb9, 0x28, 0xbf, 0xbc, 0x58, 0x82, 0x09, 0xac, 0x51, 0x0a, 0x9b, 0x46, 0x57, 0xb0, 0x92, 0x20,
+	0x98, 0x52, 0x21, 0x15, 0x2e, 0xbe, 0x9c, 0xc4, 0xe2, 0x92, 0x78, 0x08, 0x3f, 0x3e, 0x33, 0x45,
+	0x82, 0x59, 0x81, 0x51, 0x83, 0x25, 0x88, 0x07, 0x24, 0x0a, 0x51, 0xec, 0x99, 0x22, 0x64, 0xc7,
+	0xc5, 0x5d, 0x9c, 0x9c, 0x5f, 0x90, 0x1a, 0x9f, 0x93, 0x9f, 0x9c, 0x5d, 0x2c, 0xc1, 0x02, 0x36,
+	0x5f, 0x16, 0x9b, 0xf9, 0xc1, 0x20, 0x65, 0x3e, 0xf9, 0xc9, 0xd9, 0x41, 0x5c, 0xc5, 0x30, 0x66,
+	0xb1, 0x15, 0x47, 0xc7, 0x02, 0x79, 0x86, 0x17, 0x0b, 0xe4, 0x19, 0x9c, 0x62, 0x4f, 0x3c, 0x92,
+	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
+	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x81, 0x4b, 0x34, 0x33, 0x1f, 0x8b, 0x81, 0x01, 0x8c, 0x51,
+	0x5a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0x05, 0xba, 0x99,
+	0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0x70, 0x90, 0x26, 0xb1, 0x81, 0xc3, 0xd4, 0x18, 0x30, 0x00, 0x7c,
+	0xeb, 0x86, 0x7b, 0xc0, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -106,6 +119,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScopeLocks) > 0 {
+		for iNdEx := len(m.ScopeLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastEscrowId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEscrowId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Holds) > 0 {
 		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -146,6 +192,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEscrowId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEscrowId))
+	}
+	if len(m.ScopeLocks) > 0 {
+		for _, e := range m.ScopeLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -218,6 +279,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, &Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEscrowId", wireType)
+			}
+			m.LastEscrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEscrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeLocks = append(m.ScopeLocks, &ScopeLock{})
+			if err := m.ScopeLocks[len(m.ScopeLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
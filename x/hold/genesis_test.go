@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil/assertions"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 func TestDefaultGenesisState(t *testing.T) {
@@ -154,3 +156,66 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_Validate_ScopeLocks(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	scopeID1 := metadatatypes.ScopeMetadataAddress(uuid.MustParse("11111111-1111-1111-1111-111111111111"))
+	scopeID2 := metadatatypes.ScopeMetadataAddress(uuid.MustParse("22222222-2222-2222-2222-222222222222"))
+	holds := []*AccountHold{{Address: owner, Amount: scopeID1.Coins()}}
+
+	tests := []struct {
+		name     string
+		genState GenesisState
+		expErr   []string
+	}{
+		{
+			name: "nil lock",
+			genState: GenesisState{
+				Holds:      holds,
+				ScopeLocks: []*ScopeLock{nil},
+			},
+			expErr: []string{"invalid scope locks[0]: cannot be nil"},
+		},
+		{
+			name: "invalid lock",
+			genState: GenesisState{
+				Holds:      holds,
+				ScopeLocks: []*ScopeLock{{ScopeId: scopeID1.String()}},
+			},
+			expErr: []string{"invalid scope locks[0]: invalid value owner: empty address string is not allowed"},
+		},
+		{
+			name: "duplicate scope",
+			genState: GenesisState{
+				Holds: holds,
+				ScopeLocks: []*ScopeLock{
+					{ScopeId: scopeID1.String(), ValueOwner: owner},
+					{ScopeId: scopeID1.String(), ValueOwner: owner},
+				},
+			},
+			expErr: []string{"invalid scope locks[1]: duplicate scope id also at index 0"},
+		},
+		{
+			name: "scope coin not on hold",
+			genState: GenesisState{
+				Holds:      holds,
+				ScopeLocks: []*ScopeLock{{ScopeId: scopeID2.String(), ValueOwner: owner}},
+			},
+			expErr: []string{fmt.Sprintf("invalid scope locks[0]: scope coin %q is not on hold for %s", scopeID2.Coin(), owner)},
+		},
+		{
+			name: "okay",
+			genState: GenesisState{
+				Holds:      holds,
+				ScopeLocks: []*ScopeLock{{ScopeId: scopeID1.String(), ValueOwner: owner, Reason: "legal action"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
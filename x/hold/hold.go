@@ -1,9 +1,13 @@
 package hold
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 func (e AccountHold) Validate() error {
@@ -13,5 +17,147 @@ func (e AccountHold) Validate() error {
 	if err := e.Amount.Validate(); err != nil {
 		return fmt.Errorf("invalid amount: %w", err)
 	}
+	var classified sdk.Coins
+	seen := make(map[HoldReasonCode]int)
+	for i, reason := range e.Reasons {
+		if err := reason.Validate(); err != nil {
+			return fmt.Errorf("invalid reasons[%d]: %w", i, err)
+		}
+		if j, ok := seen[reason.Code]; ok {
+			return fmt.Errorf("invalid reasons[%d]: duplicate code %s also at index %d", i, reason.Code, j)
+		}
+		seen[reason.Code] = i
+		classified = classified.Add(reason.Amount...)
+	}
+	if !e.Amount.IsAllGTE(classified) {
+		return fmt.Errorf("invalid reasons: total %q is more than the amount on hold %q", classified, e.Amount)
+	}
+	return nil
+}
+
+// Validate returns an error if this code is not a known HoldReasonCode.
+func (c HoldReasonCode) Validate() error {
+	if _, ok := HoldReasonCode_name[int32(c)]; !ok {
+		return fmt.Errorf("unknown hold reason code %d", c)
+	}
+	return nil
+}
+
+// Validate makes sure that everything in this HoldReason is valid.
+func (r HoldReason) Validate() error {
+	if err := r.Code.Validate(); err != nil {
+		return err
+	}
+	keys := make(map[string]int)
+	for i, entry := range r.Metadata {
+		if len(strings.TrimSpace(entry.Key)) == 0 {
+			return fmt.Errorf("invalid metadata[%d]: key cannot be empty", i)
+		}
+		if j, ok := keys[entry.Key]; ok {
+			return fmt.Errorf("invalid metadata[%d]: duplicate key %q also at index %d", i, entry.Key, j)
+		}
+		keys[entry.Key] = i
+	}
+	return nil
+}
+
+// Validate makes sure that everything in this ReasonHold is valid.
+// Funds on hold without a reason code are not tracked by reason, so the code cannot be unspecified.
+func (h ReasonHold) Validate() error {
+	if err := h.Code.Validate(); err != nil {
+		return err
+	}
+	if h.Code == HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED {
+		return errors.New("code cannot be unspecified")
+	}
+	if err := h.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if h.Amount.IsZero() {
+		return errors.New("invalid amount: cannot be zero")
+	}
+	return nil
+}
+
+// Validate makes sure that everything in this Escrow is valid.
+func (e Escrow) Validate() error {
+	if e.Id == 0 {
+		return errors.New("invalid id: cannot be zero")
+	}
+	return ValidateEscrowParties(e.Holder, e.Counterparty, e.Arbiter, e.Amount)
+}
+
+// ValidateEscrowParties makes sure the provided escrow participants and amount are valid.
+func ValidateEscrowParties(holder, counterparty, arbiter string, amount sdk.Coins) error {
+	if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+		return fmt.Errorf("invalid holder: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(counterparty); err != nil {
+		return fmt.Errorf("invalid counterparty: %w", err)
+	}
+	if holder == counterparty {
+		return errors.New("holder and counterparty cannot be the same")
+	}
+	if len(arbiter) > 0 {
+		if _, err := sdk.AccAddressFromBech32(arbiter); err != nil {
+			return fmt.Errorf("invalid arbiter: %w", err)
+		}
+		if arbiter == holder {
+			return errors.New("holder cannot also be the arbiter")
+		}
+	}
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if amount.IsZero() {
+		return errors.New("invalid amount: cannot be zero")
+	}
 	return nil
 }
+
+// CanApproveRelease returns true if the provided address is allowed to approve the release of this escrow.
+func (e Escrow) CanApproveRelease(addr string) bool {
+	return addr == e.Counterparty || (len(e.Arbiter) > 0 && addr == e.Arbiter)
+}
+
+// Validate makes sure that everything in this ScopeLock is valid.
+func (l ScopeLock) Validate() error {
+	return ValidateScopeLockParties(l.ScopeId, l.ValueOwner, l.Unlocker)
+}
+
+// ValidateScopeLockParties makes sure the provided scope id and scope lock participants are valid.
+func ValidateScopeLockParties(scopeID, valueOwner, unlocker string) error {
+	if _, err := ParseScopeID(scopeID); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(valueOwner); err != nil {
+		return fmt.Errorf("invalid value owner: %w", err)
+	}
+	if len(unlocker) > 0 {
+		if _, err := sdk.AccAddressFromBech32(unlocker); err != nil {
+			return fmt.Errorf("invalid unlocker: %w", err)
+		}
+	}
+	return nil
+}
+
+// ParseScopeID converts the provided bech32 string into a scope's MetadataAddress.
+func ParseScopeID(scopeID string) (metadatatypes.MetadataAddress, error) {
+	rv, err := metadatatypes.MetadataAddressFromBech32(scopeID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scope id: %w", err)
+	}
+	if err = rv.ValidateIsScopeAddress(); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// CanUnlock returns true if the provided address is allowed to unlock the scope.
+// If the lock has an unlocker, only it can unlock the scope. Otherwise, the value owner can.
+func (l ScopeLock) CanUnlock(addr string) bool {
+	if len(l.Unlocker) > 0 {
+		return addr == l.Unlocker
+	}
+	return addr == l.ValueOwner
+}
@@ -5,6 +5,7 @@ package hold
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -26,12 +27,55 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HoldReasonCode classifies why funds are on hold.
+type HoldReasonCode int32
+
+const (
+	// HOLD_REASON_CODE_UNSPECIFIED is for funds placed on hold without a reason code.
+	HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED HoldReasonCode = 0
+	// HOLD_REASON_CODE_SETTLEMENT is for funds waiting to be used in the settlement of a trade or payment.
+	HoldReasonCode_HOLD_REASON_CODE_SETTLEMENT HoldReasonCode = 1
+	// HOLD_REASON_CODE_COLLATERAL is for funds (or scope value ownership) pledged as collateral.
+	HoldReasonCode_HOLD_REASON_CODE_COLLATERAL HoldReasonCode = 2
+	// HOLD_REASON_CODE_LEGAL_HOLD is for funds frozen for legal or regulatory reasons.
+	HoldReasonCode_HOLD_REASON_CODE_LEGAL_HOLD HoldReasonCode = 3
+	// HOLD_REASON_CODE_ESCROW is for funds in an escrow.
+	HoldReasonCode_HOLD_REASON_CODE_ESCROW HoldReasonCode = 4
+)
+
+var HoldReasonCode_name = map[int32]string{
+	0: "HOLD_REASON_CODE_UNSPECIFIED",
+	1: "HOLD_REASON_CODE_SETTLEMENT",
+	2: "HOLD_REASON_CODE_COLLATERAL",
+	3: "HOLD_REASON_CODE_LEGAL_HOLD",
+	4: "HOLD_REASON_CODE_ESCROW",
+}
+
+var HoldReasonCode_value = map[string]int32{
+	"HOLD_REASON_CODE_UNSPECIFIED": 0,
+	"HOLD_REASON_CODE_SETTLEMENT":  1,
+	"HOLD_REASON_CODE_COLLATERAL":  2,
+	"HOLD_REASON_CODE_LEGAL_HOLD":  3,
+	"HOLD_REASON_CODE_ESCROW":      4,
+}
+
+func (x HoldReasonCode) String() string {
+	return proto.EnumName(HoldReasonCode_name, int32(x))
+}
+
+func (HoldReasonCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{0}
+}
+
 // AccountHold associates an address with an amount on hold for that address.
 type AccountHold struct {
 	// address is the account address that holds the funds on hold.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// amount is the balances that are on hold for the address.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// reasons are the amounts on hold for each reason code, ordered by code.
+	// Funds on hold without a reason code are not included.
+	Reasons []ReasonHold `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons"`
 }
 
 func (m *AccountHold) Reset()         { *m = AccountHold{} }
@@ -81,33 +125,412 @@ func (m *AccountHold) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *AccountHold) GetReasons() []ReasonHold {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+// HoldReason describes why funds are being placed on hold.
+type HoldReason struct {
+	// code classifies the hold.
+	Code HoldReasonCode `protobuf:"varint,1,opt,name=code,proto3,enum=provenance.hold.v1.HoldReasonCode" json:"code,omitempty"`
+	// description is a human-readable indicator of why the hold is being added.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// metadata is structured info about the hold (e.g. an order or case id) for integrations to use.
+	Metadata []HoldMetadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *HoldReason) Reset()         { *m = HoldReason{} }
+func (m *HoldReason) String() string { return proto.CompactTextString(m) }
+func (*HoldReason) ProtoMessage()    {}
+func (*HoldReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{1}
+}
+func (m *HoldReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HoldReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HoldReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HoldReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HoldReason.Merge(m, src)
+}
+func (m *HoldReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *HoldReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_HoldReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HoldReason proto.InternalMessageInfo
+
+func (m *HoldReason) GetCode() HoldReasonCode {
+	if m != nil {
+		return m.Code
+	}
+	return HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED
+}
+
+func (m *HoldReason) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *HoldReason) GetMetadata() []HoldMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// HoldMetadata is a single key/value pair of structured info about a hold.
+type HoldMetadata struct {
+	// key is the name of this entry.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of this entry.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *HoldMetadata) Reset()         { *m = HoldMetadata{} }
+func (m *HoldMetadata) String() string { return proto.CompactTextString(m) }
+func (*HoldMetadata) ProtoMessage()    {}
+func (*HoldMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{2}
+}
+func (m *HoldMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HoldMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HoldMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HoldMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HoldMetadata.Merge(m, src)
+}
+func (m *HoldMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *HoldMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_HoldMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HoldMetadata proto.InternalMessageInfo
+
+func (m *HoldMetadata) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HoldMetadata) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// ReasonHold is the amount on hold in an account for a single reason code.
+type ReasonHold struct {
+	// code is the reason code of the funds on hold.
+	Code HoldReasonCode `protobuf:"varint,1,opt,name=code,proto3,enum=provenance.hold.v1.HoldReasonCode" json:"code,omitempty"`
+	// amount is the funds on hold with the code.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ReasonHold) Reset()         { *m = ReasonHold{} }
+func (m *ReasonHold) String() string { return proto.CompactTextString(m) }
+func (*ReasonHold) ProtoMessage()    {}
+func (*ReasonHold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{3}
+}
+func (m *ReasonHold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReasonHold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReasonHold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReasonHold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReasonHold.Merge(m, src)
+}
+func (m *ReasonHold) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReasonHold) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReasonHold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReasonHold proto.InternalMessageInfo
+
+func (m *ReasonHold) GetCode() HoldReasonCode {
+	if m != nil {
+		return m.Code
+	}
+	return HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED
+}
+
+func (m *ReasonHold) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// Escrow is a hold whose funds can only be released with the approval of both the holder and
+// either the counterparty or the arbiter.
+type Escrow struct {
+	// id is the unique identifier of this escrow.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// holder is the bech32 address string of the account with the funds on hold.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// counterparty is the bech32 address string of the other party to the deal.
+	Counterparty string `protobuf:"bytes,3,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	// arbiter is an optional bech32 address string of an account that can approve a release in place of the counterparty.
+	Arbiter string `protobuf:"bytes,4,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	// amount is the funds on hold for this escrow.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// description is a human-readable description of the deal.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *Escrow) Reset()         { *m = Escrow{} }
+func (m *Escrow) String() string { return proto.CompactTextString(m) }
+func (*Escrow) ProtoMessage()    {}
+func (*Escrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{4}
+}
+func (m *Escrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Escrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Escrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Escrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Escrow.Merge(m, src)
+}
+func (m *Escrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Escrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Escrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Escrow proto.InternalMessageInfo
+
+func (m *Escrow) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Escrow) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *Escrow) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *Escrow) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *Escrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Escrow) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// ScopeLock is a lock on the value ownership of a metadata scope.
+// While a scope is locked, its value owner cannot be changed.
+type ScopeLock struct {
+	// scope_id is the bech32 address string of the locked scope.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// value_owner is the bech32 address string of the scope's value owner when it was locked.
+	ValueOwner string `protobuf:"bytes,2,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+	// unlocker is an optional bech32 address string of the account that can unlock the scope.
+	// If empty, the value owner can unlock it.
+	Unlocker string `protobuf:"bytes,3,opt,name=unlocker,proto3" json:"unlocker,omitempty"`
+	// reason is a human-readable indicator of why the scope was locked.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ScopeLock) Reset()         { *m = ScopeLock{} }
+func (m *ScopeLock) String() string { return proto.CompactTextString(m) }
+func (*ScopeLock) ProtoMessage()    {}
+func (*ScopeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{5}
+}
+func (m *ScopeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeLock.Merge(m, src)
+}
+func (m *ScopeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeLock proto.InternalMessageInfo
+
+func (m *ScopeLock) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetUnlocker() string {
+	if m != nil {
+		return m.Unlocker
+	}
+	return ""
+}
+
+func (m *ScopeLock) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("provenance.hold.v1.HoldReasonCode", HoldReasonCode_name, HoldReasonCode_value)
 	proto.RegisterType((*AccountHold)(nil), "provenance.hold.v1.AccountHold")
+	proto.RegisterType((*HoldReason)(nil), "provenance.hold.v1.HoldReason")
+	proto.RegisterType((*HoldMetadata)(nil), "provenance.hold.v1.HoldMetadata")
+	proto.RegisterType((*ReasonHold)(nil), "provenance.hold.v1.ReasonHold")
+	proto.RegisterType((*Escrow)(nil), "provenance.hold.v1.Escrow")
+	proto.RegisterType((*ScopeLock)(nil), "provenance.hold.v1.ScopeLock")
 }
 
 func init() { proto.RegisterFile("provenance/hold/v1/hold.proto", fileDescriptor_cfc6e4f15dd47e2b) }
 
 var fileDescriptor_cfc6e4f15dd47e2b = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0xc8, 0xcf, 0x49, 0xd1, 0x2f, 0x33, 0x04, 0xd3,
-	0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42, 0x08, 0x69, 0x3d, 0xb0, 0x70, 0x99, 0xa1, 0x94,
-	0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x93, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0xce, 0xcf, 0xcc, 0x83, 0xca, 0x8b, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0x69, 0x11, 0x23, 0x17, 0xb7, 0x63, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x89, 0x47, 0x7e,
-	0x4e, 0x8a, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x54, 0xc9, 0xc5, 0x96, 0x98, 0x0b, 0x52, 0x27, 0xc1, 0xa4,
-	0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa9, 0x07, 0xb1, 0x50, 0x0f, 0x64, 0xa1, 0x1e, 0xd4, 0x42, 0x3d,
-	0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0xb7, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xba, 0x0e, 0x42, 0xe9, 0x16, 0xa7,
-	0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83, 0x35, 0x14, 0xcf, 0x7a, 0xbe, 0x41, 0x8b, 0x27,
-	0x27, 0x35, 0x3d, 0x31, 0xb9, 0x32, 0x1e, 0xe4, 0xe4, 0xe2, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06,
-	0x41, 0x2d, 0x74, 0x8a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x06, 0x2e, 0xd1,
-	0x4c, 0xb0, 0x47, 0xd0, 0x82, 0x27, 0x80, 0x31, 0x4a, 0x0b, 0xc9, 0x6a, 0x84, 0x02, 0xdd, 0xcc,
-	0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x38, 0x98, 0x93, 0xd8, 0xc0, 0x41, 0x61, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x01, 0x23, 0xb7, 0xfe, 0x88, 0x01, 0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x49, 0x9a, 0xb4, 0x2f, 0xa5, 0xc4, 0xa1, 0xea, 0xb6, 0xd5, 0x6d, 0xc8, 0xa9,
+	0x04, 0xba, 0x6b, 0xaa, 0x14, 0x04, 0x11, 0x92, 0x74, 0x6b, 0x0b, 0xdb, 0xa6, 0x6c, 0x22, 0x82,
+	0x20, 0xcb, 0x64, 0x77, 0x48, 0x97, 0x24, 0x3b, 0x61, 0x67, 0x93, 0x9a, 0x6f, 0xe1, 0xd9, 0x4f,
+	0x20, 0xbd, 0x58, 0xc4, 0xbb, 0xd7, 0x1e, 0x8b, 0x27, 0xbd, 0xa8, 0xa4, 0x87, 0x7e, 0x0d, 0xd9,
+	0xd9, 0x69, 0x93, 0x9a, 0x48, 0xc5, 0x83, 0x5e, 0xb2, 0xfb, 0xde, 0xfb, 0xbd, 0x99, 0xff, 0x7b,
+	0xf3, 0x26, 0x0b, 0xf7, 0xbb, 0x3e, 0xed, 0x13, 0x0f, 0x7b, 0x36, 0xd1, 0x0e, 0x69, 0xdb, 0xd1,
+	0xfa, 0x45, 0xfe, 0x54, 0xbb, 0x3e, 0x0d, 0x28, 0x42, 0xa3, 0xb0, 0xca, 0xdd, 0xfd, 0xe2, 0xf2,
+	0x2d, 0xdc, 0x71, 0x3d, 0xaa, 0xf1, 0xdf, 0x08, 0x5b, 0x56, 0x6c, 0xca, 0x3a, 0x94, 0x69, 0x0d,
+	0xcc, 0x88, 0xd6, 0x2f, 0x36, 0x48, 0x80, 0x8b, 0x9a, 0x4d, 0x5d, 0x4f, 0xc4, 0x97, 0xa2, 0xb8,
+	0xc5, 0x2d, 0x2d, 0x32, 0x44, 0x68, 0xb1, 0x49, 0x9b, 0x34, 0xf2, 0x87, 0x6f, 0x91, 0x37, 0x3f,
+	0x94, 0x20, 0x53, 0xb2, 0x6d, 0xda, 0xf3, 0x82, 0x1d, 0xda, 0x76, 0x90, 0x0c, 0x69, 0xec, 0x38,
+	0x3e, 0x61, 0x4c, 0x96, 0x72, 0xd2, 0xda, 0x9c, 0x79, 0x69, 0xa2, 0x01, 0xa4, 0x70, 0x27, 0xe4,
+	0xe4, 0x78, 0x2e, 0xb1, 0x96, 0xd9, 0x58, 0x52, 0xc5, 0xf2, 0xa1, 0x16, 0x55, 0x68, 0x51, 0x2b,
+	0xd4, 0xf5, 0xca, 0xdb, 0xa7, 0xdf, 0x56, 0x63, 0xc7, 0xdf, 0x57, 0xd7, 0x9a, 0x6e, 0x70, 0xd8,
+	0x6b, 0xa8, 0x36, 0xed, 0x08, 0x2d, 0xe2, 0xb1, 0xce, 0x9c, 0x96, 0x16, 0x0c, 0xba, 0x84, 0xf1,
+	0x04, 0xf6, 0xf6, 0xe2, 0xa4, 0x30, 0xdf, 0x26, 0x4d, 0x6c, 0x0f, 0xac, 0xb0, 0x1a, 0xf6, 0xee,
+	0xe2, 0xa4, 0x20, 0x99, 0x62, 0x43, 0xf4, 0x14, 0xd2, 0x3e, 0xc1, 0x8c, 0x7a, 0x4c, 0x4e, 0xf0,
+	0xbd, 0x15, 0x75, 0xb2, 0x5d, 0xaa, 0xc9, 0x91, 0xb0, 0x8a, 0x72, 0x32, 0x14, 0x60, 0x5e, 0x26,
+	0xe5, 0x8f, 0x25, 0x80, 0xd0, 0x1f, 0x11, 0x68, 0x13, 0x92, 0x36, 0x75, 0x08, 0x2f, 0x70, 0x61,
+	0x23, 0x3f, 0x6d, 0xad, 0x11, 0x5d, 0xa1, 0x0e, 0x31, 0x39, 0x8f, 0x72, 0x90, 0x71, 0x08, 0xb3,
+	0x7d, 0xb7, 0x1b, 0xb8, 0xd4, 0x93, 0xe3, 0xbc, 0x3f, 0xe3, 0x2e, 0x54, 0x86, 0xd9, 0x0e, 0x09,
+	0xb0, 0x83, 0x03, 0x2c, 0x94, 0xe6, 0x7e, 0xb7, 0xfa, 0x9e, 0xe0, 0x84, 0xd6, 0xab, 0xbc, 0xfc,
+	0x26, 0xcc, 0x8f, 0xc7, 0x51, 0x16, 0x12, 0x2d, 0x32, 0x10, 0xa7, 0x11, 0xbe, 0xa2, 0x45, 0x98,
+	0xe9, 0xe3, 0x76, 0x8f, 0x08, 0x05, 0x91, 0x91, 0xff, 0x24, 0x01, 0x8c, 0x5a, 0xf0, 0xd7, 0x45,
+	0xfe, 0xbf, 0x63, 0xce, 0x7f, 0x8d, 0x43, 0x4a, 0x67, 0xb6, 0x4f, 0x8f, 0xd0, 0x02, 0xc4, 0x5d,
+	0x87, 0x6b, 0x4f, 0x9a, 0x71, 0xd7, 0x41, 0x0f, 0x20, 0x15, 0xaa, 0x26, 0x7e, 0x54, 0x73, 0x59,
+	0xfe, 0xfc, 0x71, 0x7d, 0x51, 0x08, 0x2b, 0x45, 0x03, 0x5a, 0x0b, 0x7c, 0xd7, 0x6b, 0x9a, 0x82,
+	0x43, 0x4f, 0x60, 0x9e, 0x4f, 0x35, 0xf1, 0xbb, 0xd8, 0x0f, 0x06, 0x72, 0xe2, 0x86, 0xbc, 0x6b,
+	0x34, 0xda, 0x80, 0x34, 0xf6, 0x1b, 0x6e, 0x40, 0x7c, 0x39, 0x79, 0x43, 0xe2, 0x25, 0x38, 0xd6,
+	0xb9, 0x99, 0x7f, 0x7d, 0x41, 0x7e, 0x99, 0xcc, 0xd4, 0xc4, 0x64, 0xe6, 0xdf, 0x4b, 0x30, 0x57,
+	0xb3, 0x69, 0x97, 0x18, 0xd4, 0x6e, 0xa1, 0x25, 0x98, 0x65, 0xa1, 0x61, 0x89, 0x26, 0xcf, 0x99,
+	0x69, 0x6e, 0xef, 0x3a, 0xe8, 0x31, 0x64, 0xf8, 0x3c, 0x59, 0xf4, 0xc8, 0xfb, 0x83, 0x76, 0x03,
+	0x87, 0xab, 0x21, 0x8b, 0x1e, 0xc1, 0x6c, 0xcf, 0x6b, 0x53, 0xbb, 0x45, 0xfc, 0x1b, 0xdb, 0x7d,
+	0x45, 0xa2, 0x3b, 0x90, 0x8a, 0xee, 0x69, 0xd4, 0x69, 0x53, 0x58, 0x85, 0x0f, 0x12, 0x2c, 0x5c,
+	0x9f, 0x50, 0x94, 0x83, 0x7b, 0x3b, 0x55, 0x63, 0xcb, 0x32, 0xf5, 0x52, 0xad, 0xba, 0x6f, 0x55,
+	0xaa, 0x5b, 0xba, 0xf5, 0x7c, 0xbf, 0x76, 0xa0, 0x57, 0x76, 0xb7, 0x77, 0xf5, 0xad, 0x6c, 0x0c,
+	0xad, 0xc2, 0xca, 0x04, 0x51, 0xd3, 0xeb, 0x75, 0x43, 0xdf, 0xd3, 0xf7, 0xeb, 0x59, 0x69, 0x2a,
+	0x50, 0xa9, 0x1a, 0x46, 0xa9, 0xae, 0x9b, 0x25, 0x23, 0x1b, 0x9f, 0x0a, 0x18, 0xfa, 0xb3, 0x92,
+	0x61, 0x85, 0xee, 0x6c, 0x02, 0xad, 0xc0, 0xdd, 0x09, 0x40, 0xaf, 0x55, 0xcc, 0xea, 0x8b, 0x6c,
+	0xb2, 0xfc, 0xea, 0x74, 0xa8, 0x48, 0x67, 0x43, 0x45, 0xfa, 0x31, 0x54, 0xa4, 0x37, 0xe7, 0x4a,
+	0xec, 0xec, 0x5c, 0x89, 0x7d, 0x39, 0x57, 0x62, 0x70, 0xdb, 0xa5, 0x53, 0xee, 0xe0, 0x81, 0xf4,
+	0xb2, 0x30, 0x36, 0x03, 0x23, 0x60, 0xdd, 0xa5, 0x63, 0x96, 0xf6, 0x9a, 0x7f, 0x2b, 0x1a, 0x29,
+	0xfe, 0xa7, 0xfd, 0xf0, 0xe7, 0x00, 0xbd, 0x94, 0x38, 0x4e, 0x4d, 0x06, 0x00, 0x00,
 }
 
 func (m *AccountHold) Marshal() (dAtA []byte, err error) {
@@ -130,6 +553,20 @@ func (m *AccountHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,43 +591,1147 @@ func (m *AccountHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHold(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHold(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HoldReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccountHold) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *HoldReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HoldReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovHold(uint64(l))
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Amount) > 0 {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintHold(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HoldMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HoldMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HoldMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReasonHold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReasonHold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReasonHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Code != 0 {
+		i = encodeVarintHold(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Escrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintHold(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Unlocker) > 0 {
+		i -= len(m.Unlocker)
+		copy(dAtA[i:], m.Unlocker)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Unlocker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHold(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHold(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountHold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHold(uint64(l))
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for _, e := range m.Reasons {
+			l = e.Size()
+			n += 1 + l + sovHold(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HoldReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovHold(uint64(m.Code))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovHold(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HoldMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	return n
+}
+
+func (m *ReasonHold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovHold(uint64(m.Code))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHold(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHold(uint64(m.Id))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	if len(m.Amount) > 0 {
 		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovHold(uint64(l))
 		}
 	}
-	return n
-}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Unlocker)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	return n
+}
+
+func sovHold(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHold(x uint64) (n int) {
+	return sovHold(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountHold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountHold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountHold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, ReasonHold{})
+			if err := m.Reasons[len(m.Reasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HoldReason) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HoldReason: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HoldReason: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= HoldReasonCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, HoldMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HoldMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HoldMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HoldMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReasonHold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReasonHold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReasonHold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= HoldReasonCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovHold(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHold(x uint64) (n int) {
-	return sovHold(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AccountHold) Unmarshal(dAtA []byte) error {
+func (m *ScopeLock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -213,15 +1754,15 @@ func (m *AccountHold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountHold: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeLock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountHold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeLock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -249,13 +1790,13 @@ func (m *AccountHold) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHold
@@ -265,25 +1806,87 @@ func (m *AccountHold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHold
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHold
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -59,6 +59,50 @@ func TestAccountHold_Validate(t *testing.T) {
 			ae:   accountHold(addr, sdk.Coins{coin(-50, "badcoin")}),
 			exp:  "invalid amount: coin -50badcoin amount is not positive",
 		},
+		{
+			name: "with reasons",
+			ae: AccountHold{
+				Address: addr,
+				Amount:  coins("50atom,1000nhash"),
+				Reasons: []ReasonHold{
+					{Code: HoldReasonCode_HOLD_REASON_CODE_SETTLEMENT, Amount: coins("50atom,400nhash")},
+					{Code: HoldReasonCode_HOLD_REASON_CODE_ESCROW, Amount: coins("600nhash")},
+				},
+			},
+		},
+		{
+			name: "unspecified reason",
+			ae: AccountHold{
+				Address: addr,
+				Amount:  coins("1000nhash"),
+				Reasons: []ReasonHold{{Code: HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED, Amount: coins("5nhash")}},
+			},
+			exp: "invalid reasons[0]: code cannot be unspecified",
+		},
+		{
+			name: "duplicate reason",
+			ae: AccountHold{
+				Address: addr,
+				Amount:  coins("1000nhash"),
+				Reasons: []ReasonHold{
+					{Code: HoldReasonCode_HOLD_REASON_CODE_COLLATERAL, Amount: coins("5nhash")},
+					{Code: HoldReasonCode_HOLD_REASON_CODE_COLLATERAL, Amount: coins("6nhash")},
+				},
+			},
+			exp: "invalid reasons[1]: duplicate code HOLD_REASON_CODE_COLLATERAL also at index 0",
+		},
+		{
+			name: "reasons more than amount",
+			ae: AccountHold{
+				Address: addr,
+				Amount:  coins("1000nhash"),
+				Reasons: []ReasonHold{
+					{Code: HoldReasonCode_HOLD_REASON_CODE_COLLATERAL, Amount: coins("500nhash")},
+					{Code: HoldReasonCode_HOLD_REASON_CODE_LEGAL_HOLD, Amount: coins("501nhash")},
+				},
+			},
+			exp: "invalid reasons: total \"1001nhash\" is more than the amount on hold \"1000nhash\"",
+		},
 	}
 
 	for _, tc := range tests {
@@ -68,3 +112,46 @@ func TestAccountHold_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestHoldReason_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason HoldReason
+		exp    string
+	}{
+		{
+			name:   "zero value",
+			reason: HoldReason{},
+		},
+		{
+			name: "control",
+			reason: HoldReason{
+				Code:        HoldReasonCode_HOLD_REASON_CODE_LEGAL_HOLD,
+				Description: "court order",
+				Metadata:    []HoldMetadata{{Key: "case", Value: "24-1138"}, {Key: "court", Value: ""}},
+			},
+		},
+		{
+			name:   "unknown code",
+			reason: HoldReason{Code: 5},
+			exp:    "unknown hold reason code 5",
+		},
+		{
+			name:   "empty metadata key",
+			reason: HoldReason{Metadata: []HoldMetadata{{Key: " ", Value: "x"}}},
+			exp:    "invalid metadata[0]: key cannot be empty",
+		},
+		{
+			name:   "duplicate metadata key",
+			reason: HoldReason{Metadata: []HoldMetadata{{Key: "a"}, {Key: "b"}, {Key: "a"}}},
+			exp:    "invalid metadata[2]: duplicate key \"a\" also at index 0",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.reason.Validate()
+			assertions.AssertErrorValue(t, err, tc.exp, "Validate()")
+		})
+	}
+}
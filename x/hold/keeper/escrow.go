@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// getLastEscrowID gets the most recently used escrow id.
+func (k Keeper) getLastEscrowID(store storetypes.KVStore) uint64 {
+	return UnmarshalLastEscrowIDValue(store.Get(KeyLastEscrowID))
+}
+
+// setLastEscrowID records the most recently used escrow id.
+func (k Keeper) setLastEscrowID(store storetypes.KVStore, escrowID uint64) {
+	store.Set(KeyLastEscrowID, uint64Bz(escrowID))
+}
+
+// setEscrow writes the provided escrow to the store.
+func (k Keeper) setEscrow(store storetypes.KVStore, escrow *hold.Escrow) error {
+	bz, err := k.cdc.Marshal(escrow)
+	if err != nil {
+		return fmt.Errorf("could not marshal escrow %d: %w", escrow.Id, err)
+	}
+	store.Set(CreateEscrowKey(escrow.Id), bz)
+	return nil
+}
+
+// getEscrow reads an escrow from the store. Returns nil, nil if it doesn't exist.
+func (k Keeper) getEscrow(store storetypes.KVStore, escrowID uint64) (*hold.Escrow, error) {
+	bz := store.Get(CreateEscrowKey(escrowID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv hold.Escrow
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read escrow %d: %w", escrowID, err)
+	}
+	return &rv, nil
+}
+
+// GetEscrowByID gets the escrow with the provided id. Returns nil, nil if it doesn't exist.
+func (k Keeper) GetEscrowByID(ctx sdk.Context, escrowID uint64) (*hold.Escrow, error) {
+	return k.getEscrow(ctx.KVStore(k.storeKey), escrowID)
+}
+
+// IterateEscrows iterates over all escrows.
+// The process function should return whether to stop: false = keep iterating, true = stop.
+// If an error is encountered while reading from the store, that entry is skipped and an error is
+// returned for it when iteration is completed.
+func (k Keeper) IterateEscrows(ctx sdk.Context, process func(*hold.Escrow) bool) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), KeyPrefixEscrow)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		var escrow hold.Escrow
+		if err := k.cdc.Unmarshal(iter.Value(), &escrow); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read escrow %d: %w", ParseEscrowKeyUnprefixed(iter.Key()), err))
+			continue
+		}
+
+		if process(&escrow) {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetAllEscrowRecords gets all the escrows currently in the state store.
+func (k Keeper) GetAllEscrowRecords(ctx sdk.Context) ([]*hold.Escrow, error) {
+	var rv []*hold.Escrow
+	err := k.IterateEscrows(ctx, func(escrow *hold.Escrow) bool {
+		rv = append(rv, escrow)
+		return false
+	})
+	return rv, err
+}
+
+// CreateEscrow places the escrow's funds on hold in the holder's account and records the escrow.
+// The id of the provided escrow is ignored; a new one is assigned and returned.
+func (k Keeper) CreateEscrow(ctx sdk.Context, escrow *hold.Escrow) (uint64, error) {
+	if err := hold.ValidateEscrowParties(escrow.Holder, escrow.Counterparty, escrow.Arbiter, escrow.Amount); err != nil {
+		return 0, err
+	}
+
+	holder := sdk.MustAccAddressFromBech32(escrow.Holder)
+	store := ctx.KVStore(k.storeKey)
+	escrow.Id = k.getLastEscrowID(store) + 1
+
+	reason := hold.HoldReason{
+		Code:        hold.HoldReasonCode_HOLD_REASON_CODE_ESCROW,
+		Description: fmt.Sprintf("escrow %d", escrow.Id),
+		Metadata:    []hold.HoldMetadata{{Key: "escrow_id", Value: strconv.FormatUint(escrow.Id, 10)}},
+	}
+	if err := k.AddHoldWithReason(ctx, holder, escrow.Amount, reason); err != nil {
+		return 0, err
+	}
+	if err := k.setEscrow(store, escrow); err != nil {
+		return 0, err
+	}
+	k.setLastEscrowID(store, escrow.Id)
+
+	if err := ctx.EventManager().EmitTypedEvent(hold.NewEventEscrowCreated(escrow)); err != nil {
+		return 0, err
+	}
+	return escrow.Id, nil
+}
+
+// ReleaseEscrow releases the funds of an escrow and deletes the escrow.
+// The approver must be either the escrow's counterparty or arbiter.
+// If payCounterparty is true, the funds are then sent from the holder to the counterparty.
+func (k Keeper) ReleaseEscrow(ctx sdk.Context, escrowID uint64, holder, approver sdk.AccAddress, payCounterparty bool) error {
+	store := ctx.KVStore(k.storeKey)
+	escrow, err := k.getEscrow(store, escrowID)
+	if err != nil {
+		return err
+	}
+	if escrow == nil {
+		return fmt.Errorf("escrow %d not found", escrowID)
+	}
+	if escrow.Holder != holder.String() {
+		return fmt.Errorf("account %s is not the holder of escrow %d", holder, escrowID)
+	}
+	if !escrow.CanApproveRelease(approver.String()) {
+		return fmt.Errorf("account %s cannot approve the release of escrow %d", approver, escrowID)
+	}
+
+	if err = k.ReleaseHoldWithReason(ctx, holder, escrow.Amount, hold.HoldReasonCode_HOLD_REASON_CODE_ESCROW); err != nil {
+		return err
+	}
+	store.Delete(CreateEscrowKey(escrowID))
+
+	recipient := holder
+	if payCounterparty {
+		recipient = sdk.MustAccAddressFromBech32(escrow.Counterparty)
+		if err = k.bankKeeper.SendCoins(ctx, holder, recipient, escrow.Amount); err != nil {
+			return fmt.Errorf("could not pay escrow %d funds to counterparty: %w", escrowID, err)
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(hold.NewEventEscrowReleased(escrowID, approver, recipient))
+}
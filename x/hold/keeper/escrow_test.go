@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// untypeEvent converts the provided typed event into an sdk.Event, requiring it to not fail.
+func (s *TestSuite) untypeEvent(tev proto.Message) sdk.Event {
+	event, err := sdk.TypedEventToEvent(tev)
+	s.Require().NoError(err, "TypedEventToEvent(%T)", tev)
+	return event
+}
+
+func (s *TestSuite) TestKeeper_CreateEscrow() {
+	tests := []struct {
+		name   string
+		escrow *hold.Escrow
+		expErr string
+		expID  uint64
+	}{
+		{
+			name: "invalid counterparty",
+			escrow: &hold.Escrow{
+				Holder:       s.addr1.String(),
+				Counterparty: "bad",
+				Amount:       s.coins("10" + s.bondDenom),
+			},
+			expErr: "invalid counterparty: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "insufficient funds",
+			escrow: &hold.Escrow{
+				Holder:       s.addr1.String(),
+				Counterparty: s.addr2.String(),
+				Amount:       s.coins("10acorn"),
+			},
+			expErr: "account " + s.addr1.String() + " spendable balance 0acorn is less than hold amount 10acorn",
+		},
+		{
+			name: "without arbiter",
+			escrow: &hold.Escrow{
+				Holder:       s.addr1.String(),
+				Counterparty: s.addr2.String(),
+				Amount:       s.coins("10" + s.bondDenom),
+			},
+			expID: 1,
+		},
+		{
+			name: "with arbiter",
+			escrow: &hold.Escrow{
+				Holder:       s.addr1.String(),
+				Counterparty: s.addr2.String(),
+				Arbiter:      s.addr3.String(),
+				Amount:       s.coins("20" + s.bondDenom),
+				Description:  "widgets",
+			},
+			expID: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			id, err := s.keeper.CreateEscrow(ctx, tc.escrow)
+			s.assertErrorValue(err, tc.expErr, "CreateEscrow")
+			s.Assert().Equal(tc.expID, id, "CreateEscrow id")
+			if len(tc.expErr) > 0 {
+				s.Assert().Empty(em.Events(), "events emitted")
+				return
+			}
+
+			escrow, err := s.keeper.GetEscrowByID(s.ctx, id)
+			s.Require().NoError(err, "GetEscrowByID(%d)", id)
+			s.Assert().Equal(tc.escrow, escrow, "GetEscrowByID(%d)", id)
+
+			expEvents := sdk.Events{
+				s.untypeEvent(hold.NewEventHoldAdded(s.addr1, tc.escrow.Amount, hold.HoldReason{
+					Code:        hold.HoldReasonCode_HOLD_REASON_CODE_ESCROW,
+					Description: fmt.Sprintf("escrow %d", id),
+					Metadata:    []hold.HoldMetadata{{Key: "escrow_id", Value: fmt.Sprintf("%d", id)}},
+				})),
+				s.untypeEvent(hold.NewEventEscrowCreated(tc.escrow)),
+			}
+			s.assertEqualEvents(expEvents, em.Events(), "events emitted")
+		})
+	}
+
+	held, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+	s.Require().NoError(err, "GetHoldCoins(addr1)")
+	s.Assert().Equal(s.coins("30"+s.bondDenom).String(), held.String(), "GetHoldCoins(addr1)")
+}
+
+func (s *TestSuite) TestKeeper_ReleaseEscrow() {
+	amount := s.coins("100" + s.bondDenom)
+	newEscrow := func() uint64 {
+		id, err := s.keeper.CreateEscrow(s.ctx, &hold.Escrow{
+			Holder:       s.addr1.String(),
+			Counterparty: s.addr2.String(),
+			Arbiter:      s.addr3.String(),
+			Amount:       amount,
+		})
+		s.Require().NoError(err, "CreateEscrow")
+		return id
+	}
+
+	tests := []struct {
+		name     string
+		holder   sdk.AccAddress
+		approver sdk.AccAddress
+		pay      bool
+		expErr   string
+		expBal1  string
+		expBal2  string
+	}{
+		{
+			name:     "wrong holder",
+			holder:   s.addr4,
+			approver: s.addr2,
+			expErr:   "account " + s.addr4.String() + " is not the holder of escrow",
+		},
+		{
+			name:     "approver not allowed",
+			holder:   s.addr1,
+			approver: s.addr5,
+			expErr:   "account " + s.addr5.String() + " cannot approve the release of escrow",
+		},
+		{
+			name:     "approved by counterparty: kept by holder",
+			holder:   s.addr1,
+			approver: s.addr2,
+			expBal1:  "1000000000",
+			expBal2:  "1000000000",
+		},
+		{
+			name:     "approved by arbiter: paid to counterparty",
+			holder:   s.addr1,
+			approver: s.addr3,
+			pay:      true,
+			expBal1:  "999999900",
+			expBal2:  "1000000100",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() {
+				s.ctx = origCtx
+			}()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			id := newEscrow()
+			err := s.keeper.ReleaseEscrow(s.ctx, id, tc.holder, tc.approver, tc.pay)
+			if len(tc.expErr) > 0 {
+				s.assertErrorContents(err, []string{tc.expErr}, "ReleaseEscrow")
+				escrow, gerr := s.keeper.GetEscrowByID(s.ctx, id)
+				s.Require().NoError(gerr, "GetEscrowByID(%d)", id)
+				s.Assert().NotNil(escrow, "GetEscrowByID(%d)", id)
+				return
+			}
+			s.Require().NoError(err, "ReleaseEscrow")
+
+			escrow, err := s.keeper.GetEscrowByID(s.ctx, id)
+			s.Require().NoError(err, "GetEscrowByID(%d)", id)
+			s.Assert().Nil(escrow, "GetEscrowByID(%d)", id)
+
+			held, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+			s.Require().NoError(err, "GetHoldCoins(addr1)")
+			s.Assert().Empty(held, "GetHoldCoins(addr1)")
+
+			bal1 := s.bankKeeper.GetBalance(s.ctx, s.addr1, s.bondDenom)
+			s.Assert().Equal(tc.expBal1, bal1.Amount.String(), "addr1 balance")
+			bal2 := s.bankKeeper.GetBalance(s.ctx, s.addr2, s.bondDenom)
+			s.Assert().Equal(tc.expBal2, bal2.Amount.String(), "addr2 balance")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_ReleaseEscrow_NotFound() {
+	err := s.keeper.ReleaseEscrow(s.ctx, 55, s.addr1, s.addr2, false)
+	s.assertErrorValue(err, "escrow 55 not found", "ReleaseEscrow")
+}
@@ -26,6 +26,37 @@ func (k Keeper) InitGenesis(origCtx sdk.Context, genState *hold.GenesisState) {
 			panic(fmt.Errorf("holds[%d]: %w", i, err))
 		}
 	}
+
+	store := ctx.KVStore(k.storeKey)
+	for i, ah := range genState.Holds {
+		addr := sdk.MustAccAddressFromBech32(ah.Address)
+		for j, reason := range ah.Reasons {
+			for _, coin := range reason.Amount {
+				if err := k.addHoldReasonCoinAmount(store, addr, reason.Code, coin.Denom, coin.Amount); err != nil {
+					panic(fmt.Errorf("holds[%d]: reasons[%d]: %w", i, j, err))
+				}
+			}
+		}
+	}
+
+	for i, escrow := range genState.Escrows {
+		if err := k.setEscrow(store, escrow); err != nil {
+			panic(fmt.Errorf("escrows[%d]: %w", i, err))
+		}
+	}
+	if genState.LastEscrowId != 0 {
+		k.setLastEscrowID(store, genState.LastEscrowId)
+	}
+
+	for i, lock := range genState.ScopeLocks {
+		scopeID, err := hold.ParseScopeID(lock.ScopeId)
+		if err == nil {
+			err = k.setScopeLock(store, scopeID, lock)
+		}
+		if err != nil {
+			panic(fmt.Errorf("scope locks[%d]: %w", i, err))
+		}
+	}
 }
 
 // ExportGenesis creates a GenesisState from the current state store.
@@ -38,5 +69,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *hold.GenesisState {
 		panic(err)
 	}
 
+	rv.Escrows, err = k.GetAllEscrowRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+	rv.LastEscrowId = k.getLastEscrowID(ctx.KVStore(k.storeKey))
+
+	rv.ScopeLocks, err = k.GetAllScopeLockRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return rv
 }
@@ -9,6 +9,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,10 @@ func (k Keeper) GetHolds(goCtx context.Context, req *hold.GetHoldsRequest) (*hol
 	if err != nil {
 		return nil, err
 	}
+	resp.Reasons, err = k.GetHoldReasons(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
 	return resp, err
 }
 
@@ -109,6 +114,9 @@ func (k Keeper) paginateAllHolds(ctx sdk.Context, pageRequest *query.PageRequest
 			lastEntry.Amount = lastEntry.Amount.Add(sdk.Coin{Denom: denom, Amount: amount})
 		}
 
+		if err := k.addHoldReasons(ctx, resp.Holds); err != nil {
+			return nil, err
+		}
 		return resp, nil
 	}
 
@@ -156,6 +164,9 @@ func (k Keeper) paginateAllHolds(ctx sdk.Context, pageRequest *query.PageRequest
 		resp.Pagination.Total = numHits
 	}
 
+	if err := k.addHoldReasons(ctx, resp.Holds); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
 
@@ -176,3 +187,94 @@ func getIterator(prefixStore storetypes.KVStore, start []byte, reverse bool) dbm
 	}
 	return prefixStore.Iterator(start, nil)
 }
+
+// GetEscrow looks up an escrow by its id.
+func (k Keeper) GetEscrow(goCtx context.Context, req *hold.GetEscrowRequest) (*hold.GetEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.EscrowId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "escrow id cannot be zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	escrow, err := k.GetEscrowByID(ctx, req.EscrowId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if escrow == nil {
+		return nil, status.Errorf(codes.NotFound, "escrow %d not found", req.EscrowId)
+	}
+	return &hold.GetEscrowResponse{Escrow: escrow}, nil
+}
+
+// GetAllEscrows returns all escrows.
+func (k Keeper) GetAllEscrows(goCtx context.Context, req *hold.GetAllEscrowsRequest) (*hold.GetAllEscrowsResponse, error) {
+	var pageReq *query.PageRequest
+	if req != nil {
+		pageReq = req.Pagination
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), KeyPrefixEscrow)
+	resp := &hold.GetAllEscrowsResponse{}
+	var err error
+	resp.Pagination, err = query.Paginate(store, pageReq, func(_, value []byte) error {
+		var escrow hold.Escrow
+		if uerr := k.cdc.Unmarshal(value, &escrow); uerr != nil {
+			return uerr
+		}
+		resp.Escrows = append(resp.Escrows, &escrow)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating escrows: %v", err)
+	}
+	return resp, nil
+}
+
+// GetScopeLock looks up the lock on a scope's value ownership.
+func (k Keeper) GetScopeLock(goCtx context.Context, req *hold.GetScopeLockRequest) (*hold.GetScopeLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	scopeID, err := hold.ParseScopeID(req.ScopeId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lock, err := k.GetScopeLockByID(ctx, scopeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if lock == nil {
+		return nil, status.Errorf(codes.NotFound, "scope %s is not locked", req.ScopeId)
+	}
+	return &hold.GetScopeLockResponse{Lock: lock}, nil
+}
+
+// GetAllScopeLocks returns all scope value ownership locks.
+func (k Keeper) GetAllScopeLocks(goCtx context.Context, req *hold.GetAllScopeLocksRequest) (*hold.GetAllScopeLocksResponse, error) {
+	var pageReq *query.PageRequest
+	if req != nil {
+		pageReq = req.Pagination
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), KeyPrefixScopeLock)
+	resp := &hold.GetAllScopeLocksResponse{}
+	var err error
+	resp.Pagination, err = query.Paginate(store, pageReq, func(_, value []byte) error {
+		var lock hold.ScopeLock
+		if uerr := k.cdc.Unmarshal(value, &lock); uerr != nil {
+			return uerr
+		}
+		resp.Locks = append(resp.Locks, &lock)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating scope locks: %v", err)
+	}
+	return resp, nil
+}
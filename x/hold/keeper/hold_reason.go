@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// getHoldReasonCoinAmount gets (from the store) the amount on hold with the given reason code for the given address and denom.
+func (k Keeper) getHoldReasonCoinAmount(store storetypes.KVStore, addr sdk.AccAddress, code hold.HoldReasonCode, denom string) (sdkmath.Int, error) {
+	return UnmarshalHoldCoinValue(store.Get(CreateHoldReasonCoinKey(addr, code, denom)))
+}
+
+// addHoldReasonCoinAmount adds the provided (possibly negative) amount to the amount on hold with the given
+// reason code for the given address and denom. If the result is zero, the entry is deleted.
+func (k Keeper) addHoldReasonCoinAmount(store storetypes.KVStore, addr sdk.AccAddress, code hold.HoldReasonCode, denom string, amount sdkmath.Int) error {
+	if len(denom) == 0 {
+		return fmt.Errorf("cannot store hold with an empty denom for %s", addr)
+	}
+	cur, err := k.getHoldReasonCoinAmount(store, addr, code, denom)
+	if err != nil {
+		return err
+	}
+	newAmount := cur.Add(amount)
+	if newAmount.IsNegative() {
+		return fmt.Errorf("cannot store negative hold amount %s%s for %s", newAmount, denom, addr)
+	}
+
+	key := CreateHoldReasonCoinKey(addr, code, denom)
+	if newAmount.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	amountBz, err := newAmount.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, amountBz)
+	return nil
+}
+
+// getClassifiedHoldAmount gets the total amount of a denom on hold with a reason code for the given address.
+func (k Keeper) getClassifiedHoldAmount(store storetypes.KVStore, addr sdk.AccAddress, denom string) (sdkmath.Int, error) {
+	rv := sdkmath.ZeroInt()
+	for value := range hold.HoldReasonCode_name {
+		code := hold.HoldReasonCode(value)
+		if code == hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED {
+			continue
+		}
+		amount, err := k.getHoldReasonCoinAmount(store, addr, code, denom)
+		if err != nil {
+			return rv, err
+		}
+		rv = rv.Add(amount)
+	}
+	return rv, nil
+}
+
+// GetHoldReasons gets the funds on hold for each reason code for a given account, ordered by code.
+// Funds on hold without a reason code are not included.
+func (k Keeper) GetHoldReasons(ctx sdk.Context, addr sdk.AccAddress) ([]hold.ReasonHold, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), CreateHoldReasonCoinKeyAddrPrefix(addr))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var rv []hold.ReasonHold
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		code, denom := ParseHoldReasonCoinKeyAddrUnprefixed(iter.Key())
+		amount, err := UnmarshalHoldCoinValue(iter.Value())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read amount of %s with reason code %s for account %s: %w", denom, code, addr, err))
+			continue
+		}
+		if len(rv) == 0 || rv[len(rv)-1].Code != code {
+			rv = append(rv, hold.ReasonHold{Code: code})
+		}
+		rv[len(rv)-1].Amount = rv[len(rv)-1].Amount.Add(sdk.Coin{Denom: denom, Amount: amount})
+	}
+
+	return rv, errors.Join(errs...)
+}
+
+// addHoldReasons looks up and sets the reasons of each of the provided account holds.
+func (k Keeper) addHoldReasons(ctx sdk.Context, holds []*hold.AccountHold) error {
+	var errs []error
+	for _, ah := range holds {
+		addr, err := sdk.AccAddressFromBech32(ah.Address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ah.Reasons, err = k.GetHoldReasons(ctx, addr)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
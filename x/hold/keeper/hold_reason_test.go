@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+func (s *TestSuite) TestKeeper_HoldReasons() {
+	settlement := hold.HoldReasonCode_HOLD_REASON_CODE_SETTLEMENT
+	legal := hold.HoldReasonCode_HOLD_REASON_CODE_LEGAL_HOLD
+	unspecified := hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED
+	denom := s.bondDenom
+
+	getReasons := func() []hold.ReasonHold {
+		reasons, err := s.keeper.GetHoldReasons(s.ctx, s.addr1)
+		s.Require().NoError(err, "GetHoldReasons")
+		return reasons
+	}
+	getHeld := func() string {
+		held, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Require().NoError(err, "GetHoldCoins")
+		return held.String()
+	}
+
+	s.Run("unknown code", func() {
+		err := s.keeper.AddHoldWithReason(s.ctx, s.addr1, s.coins("5"+denom), hold.HoldReason{Code: 99})
+		s.Assert().EqualError(err, "invalid hold reason: unknown hold reason code 99", "AddHoldWithReason")
+	})
+
+	s.Run("duplicate metadata key", func() {
+		reason := hold.HoldReason{Code: legal, Metadata: []hold.HoldMetadata{{Key: "case", Value: "1"}, {Key: "case", Value: "2"}}}
+		err := s.keeper.AddHoldWithReason(s.ctx, s.addr1, s.coins("5"+denom), reason)
+		s.Assert().EqualError(err, `invalid hold reason: invalid metadata[1]: duplicate key "case" also at index 0`, "AddHoldWithReason")
+	})
+
+	s.Run("add holds", func() {
+		em := sdk.NewEventManager()
+		reason := hold.HoldReason{
+			Code:        legal,
+			Description: "court order",
+			Metadata:    []hold.HoldMetadata{{Key: "case", Value: "24-1138"}},
+		}
+		err := s.keeper.AddHoldWithReason(s.ctx.WithEventManager(em), s.addr1, s.coins("30"+denom), reason)
+		s.Require().NoError(err, "AddHoldWithReason legal")
+		s.assertEqualEvents(sdk.Events{s.untypeEvent(hold.NewEventHoldAdded(s.addr1, s.coins("30"+denom), reason))},
+			em.Events(), "events emitted")
+
+		err = s.keeper.AddHoldWithReason(s.ctx, s.addr1, s.coins("20"+denom), hold.HoldReason{Code: settlement})
+		s.Require().NoError(err, "AddHoldWithReason settlement")
+		err = s.keeper.AddHold(s.ctx, s.addr1, s.coins("10"+denom), "no code")
+		s.Require().NoError(err, "AddHold")
+
+		s.Assert().Equal("60"+denom, getHeld(), "held")
+		s.Assert().Equal([]hold.ReasonHold{
+			{Code: settlement, Amount: s.coins("20" + denom)},
+			{Code: legal, Amount: s.coins("30" + denom)},
+		}, getReasons(), "reasons")
+	})
+
+	s.Run("release more than unclassified without a code", func() {
+		err := s.keeper.ReleaseHold(s.ctx, s.addr1, s.coins("11"+denom))
+		exp := "cannot release 11" + denom + " from hold for " + s.addr1.String() +
+			": account only has 10" + denom + " on hold without a reason code"
+		s.Assert().EqualError(err, exp, "ReleaseHold")
+	})
+
+	s.Run("release more than available with a code", func() {
+		err := s.keeper.ReleaseHoldWithReason(s.ctx, s.addr1, s.coins("31"+denom), settlement)
+		exp := "cannot release 31" + denom + " from hold for " + s.addr1.String() +
+			": account only has 30" + denom + " on hold with reason code HOLD_REASON_CODE_SETTLEMENT or without a reason code"
+		s.Assert().EqualError(err, exp, "ReleaseHoldWithReason")
+	})
+
+	s.Run("release with a code", func() {
+		em := sdk.NewEventManager()
+		err := s.keeper.ReleaseHoldWithReason(s.ctx.WithEventManager(em), s.addr1, s.coins("30"+denom), legal)
+		s.Require().NoError(err, "ReleaseHoldWithReason")
+		s.assertEqualEvents(sdk.Events{s.untypeEvent(hold.NewEventHoldReleased(s.addr1, s.coins("30"+denom), legal))},
+			em.Events(), "events emitted")
+		s.Assert().Equal("30"+denom, getHeld(), "held")
+		s.Assert().Equal([]hold.ReasonHold{{Code: settlement, Amount: s.coins("20" + denom)}}, getReasons(), "reasons")
+	})
+
+	s.Run("release with a code falls back to unclassified funds", func() {
+		err := s.keeper.ReleaseHoldWithReason(s.ctx, s.addr1, s.coins("25"+denom), settlement)
+		s.Require().NoError(err, "ReleaseHoldWithReason")
+		s.Assert().Equal("5"+denom, getHeld(), "held")
+		s.Assert().Empty(getReasons(), "reasons")
+	})
+
+	s.Run("query", func() {
+		err := s.keeper.AddHoldWithReason(s.ctx, s.addr1, s.coins("7"+denom), hold.HoldReason{Code: settlement})
+		s.Require().NoError(err, "AddHoldWithReason")
+		resp, err := s.keeper.GetHolds(s.ctx, &hold.GetHoldsRequest{Address: s.addr1.String()})
+		s.Require().NoError(err, "GetHolds")
+		s.Assert().Equal("12"+denom, resp.Amount.String(), "Amount")
+		s.Assert().Equal([]hold.ReasonHold{{Code: settlement, Amount: s.coins("7" + denom)}}, resp.Reasons, "Reasons")
+
+		allResp, err := s.keeper.GetAllHolds(s.ctx, &hold.GetAllHoldsRequest{})
+		s.Require().NoError(err, "GetAllHolds")
+		s.Require().Len(allResp.Holds, 1, "Holds")
+		s.Assert().Equal(resp.Reasons, allResp.Holds[0].Reasons, "Holds[0].Reasons")
+	})
+
+	s.Run("genesis", func() {
+		genState := s.keeper.ExportGenesis(s.ctx)
+		s.Require().NoError(genState.Validate(), "exported genesis Validate")
+		s.clearHoldState()
+		s.keeper.InitGenesis(s.ctx, genState)
+		s.Assert().Equal("12"+denom, getHeld(), "held")
+		s.Assert().Equal([]hold.ReasonHold{{Code: settlement, Amount: s.coins("7" + denom)}}, getReasons(), "reasons")
+	})
+
+	s.Run("unspecified release", func() {
+		err := s.keeper.ReleaseHoldWithReason(s.ctx, s.addr1, s.coins("5"+denom), unspecified)
+		s.Require().NoError(err, "ReleaseHoldWithReason")
+		s.Assert().Equal("7"+denom, getHeld(), "held")
+	})
+}
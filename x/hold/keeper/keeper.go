@@ -90,12 +90,20 @@ func (k Keeper) ValidateNewHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.
 	return nil
 }
 
-// AddHold puts the provided funds on hold for the provided account.
+// AddHold puts the provided funds on hold for the provided account without a reason code.
 func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error {
+	return k.AddHoldWithReason(ctx, addr, funds, hold.HoldReason{Description: reason})
+}
+
+// AddHoldWithReason puts the provided funds on hold for the provided account, classified by the reason's code.
+func (k Keeper) AddHoldWithReason(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason hold.HoldReason) error {
 	if funds.IsZero() {
 		return nil
 	}
 
+	if err := reason.Validate(); err != nil {
+		return fmt.Errorf("invalid hold reason: %w", err)
+	}
 	if err := k.ValidateNewHold(ctx, addr, funds); err != nil {
 		return err
 	}
@@ -117,6 +125,12 @@ func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, r
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to place %s on hold for %s: %w", toAdd, addr, err))
 		}
+		if reason.Code != hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED {
+			err = k.addHoldReasonCoinAmount(store, addr, reason.Code, toAdd.Denom, toAdd.Amount)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to place %s on hold for %s with reason code %s: %w", toAdd, addr, reason.Code, err))
+			}
+		}
 		fundsAdded = fundsAdded.Add(toAdd)
 	}
 
@@ -131,13 +145,25 @@ func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, r
 }
 
 // ReleaseHold releases the hold on the provided funds for the provided account.
+// Only funds on hold without a reason code can be released this way.
 func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error {
+	return k.ReleaseHoldWithReason(ctx, addr, funds, hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED)
+}
+
+// ReleaseHoldWithReason releases the hold on the provided funds for the provided account.
+// The funds are released from those on hold with the provided reason code. If there aren't enough
+// of those (e.g. they were placed on hold before reason codes existed), the rest are released from
+// the funds on hold without a reason code.
+func (k Keeper) ReleaseHoldWithReason(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, code hold.HoldReasonCode) error {
 	if funds.IsZero() {
 		return nil
 	}
 	if funds.IsAnyNegative() {
 		return fmt.Errorf("cannot release %q from hold for %s: amounts cannot be negative", funds, addr)
 	}
+	if err := code.Validate(); err != nil {
+		return fmt.Errorf("cannot release %q from hold for %s: %w", funds, addr, err)
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	var fundsReleased sdk.Coins
@@ -159,17 +185,50 @@ func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coin
 			continue
 		}
 
+		classified, err := k.getClassifiedHoldAmount(store, addr, toRelease.Denom)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get current %s hold reason amounts for %s: %w", toRelease.Denom, addr, err))
+			continue
+		}
+		unclassified := onHold.Sub(classified)
+		fromCode := sdkmath.ZeroInt()
+		if code != hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED {
+			fromCode, err = k.getHoldReasonCoinAmount(store, addr, code, toRelease.Denom)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to get current %s hold amount for %s with reason code %s: %w", toRelease.Denom, addr, code, err))
+				continue
+			}
+			fromCode = sdkmath.MinInt(fromCode, toRelease.Amount)
+		}
+		if unclassified.LT(toRelease.Amount.Sub(fromCode)) {
+			if code == hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED {
+				errs = append(errs, fmt.Errorf("cannot release %s from hold for %s: account only has %s%s on hold without a reason code",
+					toRelease, addr, unclassified, toRelease.Denom))
+			} else {
+				errs = append(errs, fmt.Errorf("cannot release %s from hold for %s: account only has %s%s on hold with reason code %s or without a reason code",
+					toRelease, addr, unclassified.Add(fromCode), toRelease.Denom, code))
+			}
+			continue
+		}
+
 		err = k.setHoldCoinAmount(store, addr, toRelease.Denom, newAmount)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to release %s from hold for %s: %w", toRelease, addr, err))
 			continue
 		}
+		if fromCode.IsPositive() {
+			err = k.addHoldReasonCoinAmount(store, addr, code, toRelease.Denom, fromCode.Neg())
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to release %s from hold for %s with reason code %s: %w", toRelease, addr, code, err))
+				continue
+			}
+		}
 
 		fundsReleased = fundsReleased.Add(toRelease)
 	}
 
 	if !fundsReleased.IsZero() {
-		err := ctx.EventManager().EmitTypedEvent(hold.NewEventHoldReleased(addr, fundsReleased))
+		err := ctx.EventManager().EmitTypedEvent(hold.NewEventHoldReleased(addr, fundsReleased, code))
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -290,5 +349,5 @@ func (k Keeper) GetAllAccountHolds(ctx sdk.Context) ([]*hold.AccountHold, error)
 		lastEntry.Amount = lastEntry.Amount.Add(coin)
 		return false
 	})
-	return holds, err
+	return holds, errors.Join(err, k.addHoldReasons(ctx, holds))
 }
@@ -466,7 +466,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 	store = nil
 
 	makeEvents := func(addr sdk.AccAddress, coins sdk.Coins, reason string) sdk.Events {
-		event, err := sdk.TypedEventToEvent(hold.NewEventHoldAdded(addr, coins, reason))
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldAdded(addr, coins, hold.HoldReason{Description: reason}))
 		s.Require().NoError(err, "TypedEventToEvent EventHoldAdded(%s, %q)", s.getAddrName(addr), coins)
 		return sdk.Events{event}
 	}
@@ -674,7 +674,7 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 	store = nil
 
 	makeEvents := func(addr sdk.AccAddress, coins sdk.Coins) sdk.Events {
-		event, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(addr, coins))
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(addr, coins, hold.HoldReasonCode_HOLD_REASON_CODE_UNSPECIFIED))
 		s.Require().NoError(err, "TypedEventToEvent EventHoldReleased((%s, %q)", s.getAddrName(addr), coins)
 		return sdk.Events{event}
 	}
@@ -1,10 +1,15 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/provenance-io/provenance/x/hold"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // Keys for store prefixes.
@@ -12,9 +17,29 @@ import (
 //
 // Coin on hold:
 // - 0x00<addr len (1 byte)><addr><denom> -> <amount>
+//
+// Escrow:
+// - 0x01<escrow id (8 bytes)> -> protobuf(Escrow)
+//
+// Last escrow id:
+// - 0x02 -> <escrow id (8 bytes)>
+//
+// Scope lock:
+// - 0x03<scope id> -> protobuf(ScopeLock)
+//
+// Coin on hold for a reason code:
+// - 0x04<addr len (1 byte)><addr><code (1 byte)><denom> -> <amount>
 var (
 	// KeyPrefixHoldCoin is the prefix of a hold entry for an address and single denom.
 	KeyPrefixHoldCoin = []byte{0x00}
+	// KeyPrefixEscrow is the prefix of an escrow entry.
+	KeyPrefixEscrow = []byte{0x01}
+	// KeyLastEscrowID is the key of the most recently used escrow id.
+	KeyLastEscrowID = []byte{0x02}
+	// KeyPrefixScopeLock is the prefix of a scope lock entry.
+	KeyPrefixScopeLock = []byte{0x03}
+	// KeyPrefixHoldReasonCoin is the prefix of a hold entry for an address, reason code, and single denom.
+	KeyPrefixHoldReasonCoin = []byte{0x04}
 )
 
 // concatBzPlusCap creates a single byte slice consisting of the two provided byte slices with some extra capacity in the underlying array.
@@ -85,3 +110,67 @@ func UnmarshalHoldCoinValue(value []byte) (sdkmath.Int, error) {
 	}
 	return rv, nil
 }
+
+// uint64Bz converts the provided uint64 into a big-endian byte slice.
+func uint64Bz(val uint64) []byte {
+	rv := make([]byte, 8)
+	binary.BigEndian.PutUint64(rv, val)
+	return rv
+}
+
+// CreateEscrowKey creates the key for an escrow with the provided id.
+func CreateEscrowKey(escrowID uint64) []byte {
+	return concatBzPlusCap(KeyPrefixEscrow, uint64Bz(escrowID), 0)
+}
+
+// ParseEscrowKey parses a full escrow key into its escrow id.
+func ParseEscrowKey(key []byte) uint64 {
+	return ParseEscrowKeyUnprefixed(key[1:])
+}
+
+// ParseEscrowKeyUnprefixed parses an escrow key without the type prefix into its escrow id.
+func ParseEscrowKeyUnprefixed(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key)
+}
+
+// UnmarshalLastEscrowIDValue parses the store value of the last escrow id entry.
+func UnmarshalLastEscrowIDValue(value []byte) uint64 {
+	if len(value) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
+
+// CreateScopeLockKey creates the key for a lock on the provided scope.
+func CreateScopeLockKey(scopeID metadatatypes.MetadataAddress) []byte {
+	return concatBzPlusCap(KeyPrefixScopeLock, scopeID, 0)
+}
+
+// ParseScopeLockKey parses a full scope lock key into its scope id.
+func ParseScopeLockKey(key []byte) metadatatypes.MetadataAddress {
+	return ParseScopeLockKeyUnprefixed(key[1:])
+}
+
+// ParseScopeLockKeyUnprefixed parses a scope lock key without the type prefix into its scope id.
+func ParseScopeLockKeyUnprefixed(key []byte) metadatatypes.MetadataAddress {
+	return metadatatypes.MetadataAddress(key)
+}
+
+// CreateHoldReasonCoinKeyAddrPrefix creates a hold reason coin key prefix containing the provided address.
+// It's useful for iterating over all funds on hold for a reason code for an address.
+func CreateHoldReasonCoinKeyAddrPrefix(addr sdk.AccAddress) []byte {
+	return concatBzPlusCap(KeyPrefixHoldReasonCoin, address.MustLengthPrefix(addr), 0)
+}
+
+// CreateHoldReasonCoinKey creates a hold reason coin key for the provided address, reason code, and denom.
+func CreateHoldReasonCoinKey(addr sdk.AccAddress, code hold.HoldReasonCode, denom string) []byte {
+	rv := concatBzPlusCap(KeyPrefixHoldReasonCoin, address.MustLengthPrefix(addr), 1+len(denom))
+	rv = append(rv, byte(code))
+	rv = append(rv, []byte(denom)...)
+	return rv
+}
+
+// ParseHoldReasonCoinKeyAddrUnprefixed parses a hold reason coin key without the type prefix and address into its code and denom.
+func ParseHoldReasonCoinKeyAddrUnprefixed(key []byte) (hold.HoldReasonCode, string) {
+	return hold.HoldReasonCode(key[0]), string(key[1:])
+}
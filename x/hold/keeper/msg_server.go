@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// MsgServer is an alias for a Keeper that implements the hold.MsgServer interface.
+type MsgServer struct {
+	Keeper
+}
+
+func NewMsgServer(k Keeper) hold.MsgServer {
+	return MsgServer{
+		Keeper: k,
+	}
+}
+
+var _ hold.MsgServer = MsgServer{}
+
+// CreateEscrow puts funds on hold that can only be released with the approval of two parties.
+func (k MsgServer) CreateEscrow(goCtx context.Context, msg *hold.MsgCreateEscrowRequest) (*hold.MsgCreateEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	escrow := &hold.Escrow{
+		Holder:       msg.Holder,
+		Counterparty: msg.Counterparty,
+		Arbiter:      msg.Arbiter,
+		Amount:       msg.Amount,
+		Description:  msg.Description,
+	}
+	escrowID, err := k.Keeper.CreateEscrow(ctx, escrow)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &hold.MsgCreateEscrowResponse{EscrowId: escrowID}, nil
+}
+
+// ReleaseEscrow releases the funds of an escrow.
+func (k MsgServer) ReleaseEscrow(goCtx context.Context, msg *hold.MsgReleaseEscrowRequest) (*hold.MsgReleaseEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	holder, _ := sdk.AccAddressFromBech32(msg.Holder)
+	approver, _ := sdk.AccAddressFromBech32(msg.Approver)
+	err := k.Keeper.ReleaseEscrow(ctx, msg.EscrowId, holder, approver, msg.PayCounterparty)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &hold.MsgReleaseEscrowResponse{}, nil
+}
+
+// LockScope locks the value ownership of a scope.
+func (k MsgServer) LockScope(goCtx context.Context, msg *hold.MsgLockScopeRequest) (*hold.MsgLockScopeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lock := &hold.ScopeLock{
+		ScopeId:    msg.ScopeId,
+		ValueOwner: msg.ValueOwner,
+		Unlocker:   msg.Unlocker,
+		Reason:     msg.Reason,
+	}
+	if err := k.Keeper.LockScope(ctx, lock); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &hold.MsgLockScopeResponse{}, nil
+}
+
+// UnlockScope unlocks the value ownership of a scope.
+func (k MsgServer) UnlockScope(goCtx context.Context, msg *hold.MsgUnlockScopeRequest) (*hold.MsgUnlockScopeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	scopeID, err := hold.ParseScopeID(msg.ScopeId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = k.Keeper.UnlockScope(ctx, scopeID, signer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &hold.MsgUnlockScopeResponse{}, nil
+}
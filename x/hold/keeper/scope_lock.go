@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// setScopeLock writes the provided scope lock to the store.
+func (k Keeper) setScopeLock(store storetypes.KVStore, scopeID metadatatypes.MetadataAddress, lock *hold.ScopeLock) error {
+	bz, err := k.cdc.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("could not marshal scope lock for %s: %w", lock.ScopeId, err)
+	}
+	store.Set(CreateScopeLockKey(scopeID), bz)
+	return nil
+}
+
+// getScopeLock reads a scope lock from the store. Returns nil, nil if it doesn't exist.
+func (k Keeper) getScopeLock(store storetypes.KVStore, scopeID metadatatypes.MetadataAddress) (*hold.ScopeLock, error) {
+	bz := store.Get(CreateScopeLockKey(scopeID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv hold.ScopeLock
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read scope lock for %s: %w", scopeID, err)
+	}
+	return &rv, nil
+}
+
+// GetScopeLockByID gets the lock on the provided scope. Returns nil, nil if the scope isn't locked.
+func (k Keeper) GetScopeLockByID(ctx sdk.Context, scopeID metadatatypes.MetadataAddress) (*hold.ScopeLock, error) {
+	return k.getScopeLock(ctx.KVStore(k.storeKey), scopeID)
+}
+
+// IsScopeLocked returns true if the value ownership of the provided scope is locked.
+func (k Keeper) IsScopeLocked(ctx sdk.Context, scopeID metadatatypes.MetadataAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(CreateScopeLockKey(scopeID))
+}
+
+// IterateScopeLocks iterates over all scope locks.
+// The process function should return whether to stop: false = keep iterating, true = stop.
+// If an error is encountered while reading from the store, that entry is skipped and an error is
+// returned for it when iteration is completed.
+func (k Keeper) IterateScopeLocks(ctx sdk.Context, process func(*hold.ScopeLock) bool) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), KeyPrefixScopeLock)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		var lock hold.ScopeLock
+		if err := k.cdc.Unmarshal(iter.Value(), &lock); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read scope lock for %s: %w", ParseScopeLockKeyUnprefixed(iter.Key()), err))
+			continue
+		}
+
+		if process(&lock) {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetAllScopeLockRecords gets all the scope locks currently in the state store.
+func (k Keeper) GetAllScopeLockRecords(ctx sdk.Context) ([]*hold.ScopeLock, error) {
+	var rv []*hold.ScopeLock
+	err := k.IterateScopeLocks(ctx, func(lock *hold.ScopeLock) bool {
+		rv = append(rv, lock)
+		return false
+	})
+	return rv, err
+}
+
+// LockScope locks the value ownership of a scope by placing the scope's coin on hold in the value owner's account.
+// While locked, the scope's value owner cannot be changed (e.g. using WriteScope or UpdateValueOwners).
+func (k Keeper) LockScope(ctx sdk.Context, lock *hold.ScopeLock) error {
+	if err := lock.Validate(); err != nil {
+		return err
+	}
+
+	scopeID, _ := hold.ParseScopeID(lock.ScopeId) // Can't fail since we know the lock is valid.
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(CreateScopeLockKey(scopeID)) {
+		return fmt.Errorf("scope %s is already locked", lock.ScopeId)
+	}
+
+	valueOwner := sdk.MustAccAddressFromBech32(lock.ValueOwner)
+	reason := hold.HoldReason{
+		Code:        hold.HoldReasonCode_HOLD_REASON_CODE_COLLATERAL,
+		Description: "scope lock",
+		Metadata:    []hold.HoldMetadata{{Key: "scope_id", Value: lock.ScopeId}},
+	}
+	if err := k.AddHoldWithReason(ctx, valueOwner, scopeID.Coins(), reason); err != nil {
+		return fmt.Errorf("could not lock scope %s: %w", lock.ScopeId, err)
+	}
+	if err := k.setScopeLock(store, scopeID, lock); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(hold.NewEventScopeLocked(lock))
+}
+
+// UnlockScope unlocks the value ownership of a scope, releasing the hold on the scope's coin.
+// The unlocker must be the lock's unlocker, or the value owner if the lock doesn't have an unlocker.
+func (k Keeper) UnlockScope(ctx sdk.Context, scopeID metadatatypes.MetadataAddress, unlocker sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	lock, err := k.getScopeLock(store, scopeID)
+	if err != nil {
+		return err
+	}
+	if lock == nil {
+		return fmt.Errorf("scope %s is not locked", scopeID)
+	}
+	if !lock.CanUnlock(unlocker.String()) {
+		return fmt.Errorf("account %s cannot unlock scope %s", unlocker, scopeID)
+	}
+
+	valueOwner := sdk.MustAccAddressFromBech32(lock.ValueOwner)
+	if err = k.ReleaseHoldWithReason(ctx, valueOwner, scopeID.Coins(), hold.HoldReasonCode_HOLD_REASON_CODE_COLLATERAL); err != nil {
+		return err
+	}
+	store.Delete(CreateScopeLockKey(scopeID))
+
+	return ctx.EventManager().EmitTypedEvent(hold.NewEventScopeUnlocked(lock.ScopeId, unlocker))
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// newScope creates a new scope with the provided value owner, requiring it to work.
+func (s *TestSuite) newScope(valueOwner sdk.AccAddress) metadatatypes.MetadataAddress {
+	s.T().Helper()
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	scope := metadatatypes.Scope{
+		ScopeId:           scopeID,
+		Owners:            []metadatatypes.Party{{Address: valueOwner.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}},
+		ValueOwnerAddress: valueOwner.String(),
+	}
+	s.Require().NoError(s.app.MetadataKeeper.SetScope(s.ctx, scope), "SetScope")
+	return scopeID
+}
+
+func (s *TestSuite) TestKeeper_LockScope() {
+	scopeID := s.newScope(s.addr1)
+	otherScopeID := s.newScope(s.addr2)
+
+	tests := []struct {
+		name   string
+		lock   *hold.ScopeLock
+		expErr string
+	}{
+		{
+			name:   "invalid scope id",
+			lock:   &hold.ScopeLock{ScopeId: "bad", ValueOwner: s.addr1.String()},
+			expErr: "invalid scope id: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "not a scope",
+			lock:   &hold.ScopeLock{ScopeId: metadatatypes.RecordMetadataAddress(uuid.New(), "rec").String(), ValueOwner: s.addr1.String()},
+			expErr: "\": wrong type",
+		},
+		{
+			name:   "invalid unlocker",
+			lock:   &hold.ScopeLock{ScopeId: scopeID.String(), ValueOwner: s.addr1.String(), Unlocker: "bad"},
+			expErr: "invalid unlocker: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "not the value owner",
+			lock:   &hold.ScopeLock{ScopeId: otherScopeID.String(), ValueOwner: s.addr1.String()},
+			expErr: "could not lock scope " + otherScopeID.String() + ": account " + s.addr1.String() + " spendable balance 0" + otherScopeID.Denom(),
+		},
+		{
+			name: "okay",
+			lock: &hold.ScopeLock{ScopeId: scopeID.String(), ValueOwner: s.addr1.String(), Unlocker: s.addr3.String(), Reason: "exchange listing"},
+		},
+		{
+			name:   "already locked",
+			lock:   &hold.ScopeLock{ScopeId: scopeID.String(), ValueOwner: s.addr1.String()},
+			expErr: "scope " + scopeID.String() + " is already locked",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			err := s.keeper.LockScope(ctx, tc.lock)
+			if len(tc.expErr) > 0 {
+				s.assertErrorContents(err, []string{tc.expErr}, "LockScope")
+				s.Assert().Empty(em.Events(), "events emitted")
+				return
+			}
+			s.Require().NoError(err, "LockScope")
+
+			lock, err := s.keeper.GetScopeLockByID(s.ctx, scopeID)
+			s.Require().NoError(err, "GetScopeLockByID")
+			s.Assert().Equal(tc.lock, lock, "GetScopeLockByID")
+			s.Assert().True(s.keeper.IsScopeLocked(s.ctx, scopeID), "IsScopeLocked")
+
+			expEvents := sdk.Events{
+				s.untypeEvent(hold.NewEventHoldAdded(s.addr1, scopeID.Coins(), hold.HoldReason{
+					Code:        hold.HoldReasonCode_HOLD_REASON_CODE_COLLATERAL,
+					Description: "scope lock",
+					Metadata:    []hold.HoldMetadata{{Key: "scope_id", Value: tc.lock.ScopeId}},
+				})),
+				s.untypeEvent(hold.NewEventScopeLocked(tc.lock)),
+			}
+			s.assertEqualEvents(expEvents, em.Events(), "events emitted")
+		})
+	}
+
+	s.Assert().False(s.keeper.IsScopeLocked(s.ctx, otherScopeID), "IsScopeLocked(otherScopeID)")
+}
+
+func (s *TestSuite) TestKeeper_ScopeLockBlocksValueOwnerChanges() {
+	scopeID := s.newScope(s.addr1)
+	err := s.keeper.LockScope(s.ctx, &hold.ScopeLock{ScopeId: scopeID.String(), ValueOwner: s.addr1.String()})
+	s.Require().NoError(err, "LockScope")
+
+	err = s.app.MetadataKeeper.SetScopeValueOwner(s.ctx, scopeID, s.addr2.String())
+	s.Assert().ErrorContains(err, "insufficient funds", "SetScopeValueOwner while locked")
+	links := metadatatypes.AccMDLinks{metadatatypes.NewAccMDLink(s.addr1, scopeID)}
+	err = s.app.MetadataKeeper.SetScopeValueOwners(s.ctx, links, s.addr2.String())
+	s.Assert().ErrorContains(err, "insufficient funds", "SetScopeValueOwners while locked")
+	owner, err := s.app.MetadataKeeper.GetScopeValueOwner(s.ctx, scopeID)
+	s.Require().NoError(err, "GetScopeValueOwner while locked")
+	s.Assert().Equal(s.addr1.String(), owner.String(), "value owner while locked")
+
+	err = s.keeper.UnlockScope(s.ctx, scopeID, s.addr1)
+	s.Require().NoError(err, "UnlockScope")
+	err = s.app.MetadataKeeper.SetScopeValueOwner(s.ctx, scopeID, s.addr2.String())
+	s.Require().NoError(err, "SetScopeValueOwner after unlock")
+	owner, err = s.app.MetadataKeeper.GetScopeValueOwner(s.ctx, scopeID)
+	s.Require().NoError(err, "GetScopeValueOwner after unlock")
+	s.Assert().Equal(s.addr2.String(), owner.String(), "value owner after unlock")
+}
+
+func (s *TestSuite) TestKeeper_UnlockScope() {
+	tests := []struct {
+		name     string
+		unlocker string
+		signer   sdk.AccAddress
+		expErr   string
+	}{
+		{
+			name:   "value owner without unlocker",
+			signer: s.addr1,
+		},
+		{
+			name:   "other without unlocker",
+			signer: s.addr2,
+			expErr: "account " + s.addr2.String() + " cannot unlock scope",
+		},
+		{
+			name:     "unlocker",
+			unlocker: s.addr3.String(),
+			signer:   s.addr3,
+		},
+		{
+			name:     "value owner with unlocker",
+			unlocker: s.addr3.String(),
+			signer:   s.addr1,
+			expErr:   "account " + s.addr1.String() + " cannot unlock scope",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			origCtx := s.ctx
+			defer func() {
+				s.ctx = origCtx
+			}()
+			s.ctx, _ = s.ctx.CacheContext()
+
+			scopeID := s.newScope(s.addr1)
+			err := s.keeper.LockScope(s.ctx, &hold.ScopeLock{ScopeId: scopeID.String(), ValueOwner: s.addr1.String(), Unlocker: tc.unlocker})
+			s.Require().NoError(err, "LockScope")
+
+			em := sdk.NewEventManager()
+			err = s.keeper.UnlockScope(s.ctx.WithEventManager(em), scopeID, tc.signer)
+			if len(tc.expErr) > 0 {
+				s.assertErrorContents(err, []string{tc.expErr}, "UnlockScope")
+				s.Assert().True(s.keeper.IsScopeLocked(s.ctx, scopeID), "IsScopeLocked")
+				return
+			}
+			s.Require().NoError(err, "UnlockScope")
+			s.Assert().False(s.keeper.IsScopeLocked(s.ctx, scopeID), "IsScopeLocked")
+
+			held, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+			s.Require().NoError(err, "GetHoldCoins(addr1)")
+			s.Assert().Empty(held, "GetHoldCoins(addr1)")
+
+			expEvents := sdk.Events{
+				s.untypeEvent(hold.NewEventHoldReleased(s.addr1, scopeID.Coins(), hold.HoldReasonCode_HOLD_REASON_CODE_COLLATERAL)),
+				s.untypeEvent(hold.NewEventScopeUnlocked(scopeID.String(), tc.signer)),
+			}
+			s.assertEqualEvents(expEvents, em.Events(), "events emitted")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UnlockScope_NotLocked() {
+	scopeID := s.newScope(s.addr1)
+	err := s.keeper.UnlockScope(s.ctx, scopeID, s.addr1)
+	s.assertErrorValue(err, "scope "+scopeID.String()+" is not locked", "UnlockScope")
+}
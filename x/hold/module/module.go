@@ -80,7 +80,7 @@ func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 // GetTxCmd returns the transaction commands for the hold module.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.TxCmd()
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the hold module.
@@ -91,7 +91,9 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, m
 }
 
 // RegisterInterfaces registers the hold module's interface types
-func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	hold.RegisterInterfaces(registry)
+}
 
 // RegisterLegacyAminoCodec registers the hold module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
@@ -118,6 +120,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // RegisterServices registers a gRPC query service to respond to the hold-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	hold.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	hold.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
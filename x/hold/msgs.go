@@ -0,0 +1,56 @@
+package hold
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgCreateEscrowRequest)(nil),
+	(*MsgReleaseEscrowRequest)(nil),
+	(*MsgLockScopeRequest)(nil),
+	(*MsgUnlockScopeRequest)(nil),
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgCreateEscrowRequest) ValidateBasic() error {
+	return ValidateEscrowParties(m.Holder, m.Counterparty, m.Arbiter, m.Amount)
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgReleaseEscrowRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Holder); err != nil {
+		errs = append(errs, fmt.Errorf("invalid holder: %w", err))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Approver); err != nil {
+		errs = append(errs, fmt.Errorf("invalid approver: %w", err))
+	}
+	if len(m.Holder) > 0 && m.Holder == m.Approver {
+		errs = append(errs, errors.New("holder and approver cannot be the same"))
+	}
+	if m.EscrowId == 0 {
+		errs = append(errs, errors.New("invalid escrow id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgLockScopeRequest) ValidateBasic() error {
+	return ValidateScopeLockParties(m.ScopeId, m.ValueOwner, m.Unlocker)
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (m MsgUnlockScopeRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		errs = append(errs, fmt.Errorf("invalid signer: %w", err))
+	}
+	if _, err := ParseScopeID(m.ScopeId); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,189 @@
+package hold
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestMsgCreateEscrowRequest_ValidateBasic(t *testing.T) {
+	holder := sdk.AccAddress("holder______________").String()
+	counterparty := sdk.AccAddress("counterparty________").String()
+	arbiter := sdk.AccAddress("arbiter_____________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateEscrowRequest
+		expErr string
+	}{
+		{
+			name:   "no holder",
+			msg:    MsgCreateEscrowRequest{Counterparty: counterparty, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+			expErr: "invalid holder: empty address string is not allowed",
+		},
+		{
+			name:   "no counterparty",
+			msg:    MsgCreateEscrowRequest{Holder: holder, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+			expErr: "invalid counterparty: empty address string is not allowed",
+		},
+		{
+			name:   "holder is counterparty",
+			msg:    MsgCreateEscrowRequest{Holder: holder, Counterparty: holder, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+			expErr: "holder and counterparty cannot be the same",
+		},
+		{
+			name:   "bad arbiter",
+			msg:    MsgCreateEscrowRequest{Holder: holder, Counterparty: counterparty, Arbiter: "bad", Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+			expErr: "invalid arbiter: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "holder is arbiter",
+			msg:    MsgCreateEscrowRequest{Holder: holder, Counterparty: counterparty, Arbiter: holder, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+			expErr: "holder cannot also be the arbiter",
+		},
+		{
+			name:   "no amount",
+			msg:    MsgCreateEscrowRequest{Holder: holder, Counterparty: counterparty},
+			expErr: "invalid amount: cannot be zero",
+		},
+		{
+			name:   "invalid amount",
+			msg:    MsgCreateEscrowRequest{Holder: holder, Counterparty: counterparty, Amount: sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}},
+			expErr: "invalid amount: coin -1nhash amount is not positive",
+		},
+		{
+			name: "good without arbiter",
+			msg:  MsgCreateEscrowRequest{Holder: holder, Counterparty: counterparty, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+		},
+		{
+			name: "good with arbiter",
+			msg:  MsgCreateEscrowRequest{Holder: holder, Counterparty: counterparty, Arbiter: arbiter, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestMsgReleaseEscrowRequest_ValidateBasic(t *testing.T) {
+	holder := sdk.AccAddress("holder______________").String()
+	approver := sdk.AccAddress("approver____________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgReleaseEscrowRequest
+		expErr []string
+	}{
+		{
+			name: "empty",
+			msg:  MsgReleaseEscrowRequest{},
+			expErr: []string{
+				"invalid holder: empty address string is not allowed",
+				"invalid approver: empty address string is not allowed",
+				"invalid escrow id: cannot be zero",
+			},
+		},
+		{
+			name:   "holder is approver",
+			msg:    MsgReleaseEscrowRequest{Holder: holder, Approver: holder, EscrowId: 1},
+			expErr: []string{"holder and approver cannot be the same"},
+		},
+		{
+			name: "good",
+			msg:  MsgReleaseEscrowRequest{Holder: holder, Approver: approver, EscrowId: 1, PayCounterparty: true},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestMsgLockScopeRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	unlocker := sdk.AccAddress("unlocker____________").String()
+	scopeID := "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"
+
+	tests := []struct {
+		name   string
+		msg    MsgLockScopeRequest
+		expErr string
+	}{
+		{
+			name:   "no scope id",
+			msg:    MsgLockScopeRequest{ValueOwner: owner},
+			expErr: "invalid scope id: empty address string is not allowed",
+		},
+		{
+			name:   "not a scope id",
+			msg:    MsgLockScopeRequest{ValueOwner: owner, ScopeId: "scopespec1qjjwrht7ne25cq9tua7tn0vtezcqrsneea"},
+			expErr: "invalid scope id \"scopespec1qjjwrht7ne25cq9tua7tn0vtezcqrsneea\": wrong type",
+		},
+		{
+			name:   "no value owner",
+			msg:    MsgLockScopeRequest{ScopeId: scopeID},
+			expErr: "invalid value owner: empty address string is not allowed",
+		},
+		{
+			name:   "bad unlocker",
+			msg:    MsgLockScopeRequest{ValueOwner: owner, ScopeId: scopeID, Unlocker: "bad"},
+			expErr: "invalid unlocker: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "good without unlocker",
+			msg:  MsgLockScopeRequest{ValueOwner: owner, ScopeId: scopeID, Reason: "exchange listing"},
+		},
+		{
+			name: "good with unlocker",
+			msg:  MsgLockScopeRequest{ValueOwner: owner, ScopeId: scopeID, Unlocker: unlocker, Reason: "legal action"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			assertions.AssertErrorValue(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestMsgUnlockScopeRequest_ValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgUnlockScopeRequest
+		expErr []string
+	}{
+		{
+			name: "empty",
+			msg:  MsgUnlockScopeRequest{},
+			expErr: []string{
+				"invalid signer: empty address string is not allowed",
+				"invalid scope id: empty address string is not allowed",
+			},
+		},
+		{
+			name: "good",
+			msg:  MsgUnlockScopeRequest{Signer: signer, ScopeId: "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic")
+		})
+	}
+}
@@ -76,6 +76,9 @@ var xxx_messageInfo_GetHoldsRequest proto.InternalMessageInfo
 type GetHoldsResponse struct {
 	// amount is the total on hold for the requested address.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// reasons are the amounts on hold for each reason code, ordered by code.
+	// Funds on hold without a reason code are not included.
+	Reasons []ReasonHold `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons"`
 }
 
 func (m *GetHoldsResponse) Reset()         { *m = GetHoldsResponse{} }
@@ -205,51 +208,465 @@ func (m *GetAllHoldsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// GetEscrowRequest is the request type for the Query/GetEscrow query.
+type GetEscrowRequest struct {
+	// escrow_id is the unique identifier of the escrow to look up.
+	EscrowId uint64 `protobuf:"varint,1,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+}
+
+func (m *GetEscrowRequest) Reset()         { *m = GetEscrowRequest{} }
+func (m *GetEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEscrowRequest) ProtoMessage()    {}
+func (*GetEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{4}
+}
+func (m *GetEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEscrowRequest.Merge(m, src)
+}
+func (m *GetEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEscrowRequest proto.InternalMessageInfo
+
+func (m *GetEscrowRequest) GetEscrowId() uint64 {
+	if m != nil {
+		return m.EscrowId
+	}
+	return 0
+}
+
+// GetEscrowResponse is the response type for the Query/GetEscrow query.
+type GetEscrowResponse struct {
+	// escrow is the requested escrow.
+	Escrow *Escrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (m *GetEscrowResponse) Reset()         { *m = GetEscrowResponse{} }
+func (m *GetEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEscrowResponse) ProtoMessage()    {}
+func (*GetEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{5}
+}
+func (m *GetEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEscrowResponse.Merge(m, src)
+}
+func (m *GetEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEscrowResponse proto.InternalMessageInfo
+
+func (m *GetEscrowResponse) GetEscrow() *Escrow {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
+}
+
+// GetAllEscrowsRequest is the request type for the Query/GetAllEscrows query.
+type GetAllEscrowsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetAllEscrowsRequest) Reset()         { *m = GetAllEscrowsRequest{} }
+func (m *GetAllEscrowsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAllEscrowsRequest) ProtoMessage()    {}
+func (*GetAllEscrowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{6}
+}
+func (m *GetAllEscrowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllEscrowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllEscrowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllEscrowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllEscrowsRequest.Merge(m, src)
+}
+func (m *GetAllEscrowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllEscrowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllEscrowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllEscrowsRequest proto.InternalMessageInfo
+
+func (m *GetAllEscrowsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetAllEscrowsResponse is the response type for the Query/GetAllEscrows query.
+type GetAllEscrowsResponse struct {
+	// escrows is a list of escrows.
+	Escrows []*Escrow `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetAllEscrowsResponse) Reset()         { *m = GetAllEscrowsResponse{} }
+func (m *GetAllEscrowsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAllEscrowsResponse) ProtoMessage()    {}
+func (*GetAllEscrowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{7}
+}
+func (m *GetAllEscrowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllEscrowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllEscrowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllEscrowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllEscrowsResponse.Merge(m, src)
+}
+func (m *GetAllEscrowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllEscrowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllEscrowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllEscrowsResponse proto.InternalMessageInfo
+
+func (m *GetAllEscrowsResponse) GetEscrows() []*Escrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GetAllEscrowsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetScopeLockRequest is the request type for the Query/GetScopeLock query.
+type GetScopeLockRequest struct {
+	// scope_id is the bech32 address string of the scope to look up.
+	ScopeId string `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+}
+
+func (m *GetScopeLockRequest) Reset()         { *m = GetScopeLockRequest{} }
+func (m *GetScopeLockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScopeLockRequest) ProtoMessage()    {}
+func (*GetScopeLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{8}
+}
+func (m *GetScopeLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetScopeLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetScopeLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetScopeLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScopeLockRequest.Merge(m, src)
+}
+func (m *GetScopeLockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetScopeLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScopeLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScopeLockRequest proto.InternalMessageInfo
+
+func (m *GetScopeLockRequest) GetScopeId() string {
+	if m != nil {
+		return m.ScopeId
+	}
+	return ""
+}
+
+// GetScopeLockResponse is the response type for the Query/GetScopeLock query.
+type GetScopeLockResponse struct {
+	// lock is the requested scope lock.
+	Lock *ScopeLock `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+}
+
+func (m *GetScopeLockResponse) Reset()         { *m = GetScopeLockResponse{} }
+func (m *GetScopeLockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScopeLockResponse) ProtoMessage()    {}
+func (*GetScopeLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{9}
+}
+func (m *GetScopeLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetScopeLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetScopeLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetScopeLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScopeLockResponse.Merge(m, src)
+}
+func (m *GetScopeLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetScopeLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScopeLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScopeLockResponse proto.InternalMessageInfo
+
+func (m *GetScopeLockResponse) GetLock() *ScopeLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+// GetAllScopeLocksRequest is the request type for the Query/GetAllScopeLocks query.
+type GetAllScopeLocksRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetAllScopeLocksRequest) Reset()         { *m = GetAllScopeLocksRequest{} }
+func (m *GetAllScopeLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAllScopeLocksRequest) ProtoMessage()    {}
+func (*GetAllScopeLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{10}
+}
+func (m *GetAllScopeLocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllScopeLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllScopeLocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllScopeLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllScopeLocksRequest.Merge(m, src)
+}
+func (m *GetAllScopeLocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllScopeLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllScopeLocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllScopeLocksRequest proto.InternalMessageInfo
+
+func (m *GetAllScopeLocksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetAllScopeLocksResponse is the response type for the Query/GetAllScopeLocks query.
+type GetAllScopeLocksResponse struct {
+	// locks is a list of scope locks.
+	Locks []*ScopeLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetAllScopeLocksResponse) Reset()         { *m = GetAllScopeLocksResponse{} }
+func (m *GetAllScopeLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAllScopeLocksResponse) ProtoMessage()    {}
+func (*GetAllScopeLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41c9f383440a9df, []int{11}
+}
+func (m *GetAllScopeLocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAllScopeLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAllScopeLocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAllScopeLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllScopeLocksResponse.Merge(m, src)
+}
+func (m *GetAllScopeLocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAllScopeLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllScopeLocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllScopeLocksResponse proto.InternalMessageInfo
+
+func (m *GetAllScopeLocksResponse) GetLocks() []*ScopeLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GetAllScopeLocksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GetHoldsRequest)(nil), "provenance.hold.v1.GetHoldsRequest")
 	proto.RegisterType((*GetHoldsResponse)(nil), "provenance.hold.v1.GetHoldsResponse")
 	proto.RegisterType((*GetAllHoldsRequest)(nil), "provenance.hold.v1.GetAllHoldsRequest")
 	proto.RegisterType((*GetAllHoldsResponse)(nil), "provenance.hold.v1.GetAllHoldsResponse")
+	proto.RegisterType((*GetEscrowRequest)(nil), "provenance.hold.v1.GetEscrowRequest")
+	proto.RegisterType((*GetEscrowResponse)(nil), "provenance.hold.v1.GetEscrowResponse")
+	proto.RegisterType((*GetAllEscrowsRequest)(nil), "provenance.hold.v1.GetAllEscrowsRequest")
+	proto.RegisterType((*GetAllEscrowsResponse)(nil), "provenance.hold.v1.GetAllEscrowsResponse")
+	proto.RegisterType((*GetScopeLockRequest)(nil), "provenance.hold.v1.GetScopeLockRequest")
+	proto.RegisterType((*GetScopeLockResponse)(nil), "provenance.hold.v1.GetScopeLockResponse")
+	proto.RegisterType((*GetAllScopeLocksRequest)(nil), "provenance.hold.v1.GetAllScopeLocksRequest")
+	proto.RegisterType((*GetAllScopeLocksResponse)(nil), "provenance.hold.v1.GetAllScopeLocksResponse")
 }
 
 func init() { proto.RegisterFile("provenance/hold/v1/query.proto", fileDescriptor_e41c9f383440a9df) }
 
 var fileDescriptor_e41c9f383440a9df = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0xfd, 0xa9, 0xfd, 0x95, 0x0b, 0x12, 0x70, 0x80, 0x94, 0x06, 0x70, 0x42, 0x0a,
-	0x6d, 0x14, 0xd4, 0x3b, 0x25, 0xa8, 0x0b, 0x5b, 0x8b, 0xd4, 0x30, 0x96, 0x8c, 0x48, 0x08, 0x5d,
-	0xec, 0xab, 0x6b, 0xe1, 0xdc, 0xe3, 0xe6, 0xce, 0x11, 0x11, 0x62, 0xa0, 0x13, 0x63, 0x25, 0x84,
-	0x90, 0x98, 0x3a, 0x22, 0xa6, 0xbe, 0x8c, 0x8e, 0x95, 0x58, 0x98, 0x00, 0x25, 0x48, 0xe5, 0x65,
-	0x20, 0xdb, 0x67, 0xc5, 0x29, 0x2e, 0x5d, 0xec, 0xb3, 0x9f, 0xef, 0xe3, 0xef, 0xe7, 0xf9, 0x63,
-	0x6c, 0x87, 0x03, 0x18, 0x0a, 0xc9, 0xa5, 0x23, 0xd8, 0x2e, 0x04, 0x2e, 0x1b, 0xb6, 0xd8, 0x5e,
-	0x24, 0x06, 0x23, 0x1a, 0x0e, 0x40, 0x03, 0x21, 0xd3, 0x38, 0x8d, 0xe3, 0x74, 0xd8, 0xaa, 0x5c,
-	0xe3, 0x7d, 0x5f, 0x02, 0x4b, 0xae, 0xa9, 0xac, 0xd2, 0x74, 0x40, 0xf5, 0x41, 0xb1, 0x1e, 0x57,
-	0x22, 0xcd, 0x67, 0xc3, 0x56, 0x4f, 0x68, 0xde, 0x62, 0x21, 0xf7, 0x7c, 0xc9, 0xb5, 0x0f, 0xd2,
-	0x68, 0xed, 0xbc, 0x36, 0x53, 0x39, 0xe0, 0x67, 0xf1, 0x1b, 0x1e, 0x78, 0x90, 0x1c, 0x59, 0x7c,
-	0x32, 0x6f, 0x6f, 0x7b, 0x00, 0x5e, 0x20, 0x18, 0x0f, 0x7d, 0xc6, 0xa5, 0x04, 0x9d, 0x7c, 0x52,
-	0x99, 0xe8, 0x9d, 0x82, 0x32, 0x12, 0xdc, 0x24, 0x5c, 0x5f, 0xc7, 0x57, 0x3a, 0x42, 0x3f, 0x81,
-	0xc0, 0x55, 0x5d, 0xb1, 0x17, 0x09, 0xa5, 0x49, 0x19, 0xff, 0xcf, 0x5d, 0x77, 0x20, 0x94, 0x2a,
-	0xa3, 0x1a, 0x6a, 0x5c, 0xea, 0x66, 0x8f, 0x8f, 0x16, 0xdf, 0x1d, 0x56, 0xad, 0xdf, 0x87, 0x55,
-	0xab, 0xfe, 0x11, 0xe1, 0xab, 0xd3, 0x3c, 0x15, 0x82, 0x54, 0x82, 0x8c, 0xf0, 0x02, 0xef, 0x43,
-	0x24, 0x75, 0x19, 0xd5, 0xfe, 0x6b, 0x94, 0xda, 0x4b, 0x34, 0xad, 0x87, 0xc6, 0xf5, 0x50, 0x53,
-	0x0f, 0x7d, 0x0c, 0xbe, 0xdc, 0xdc, 0x3a, 0xfe, 0x5e, 0xb5, 0xbe, 0xfc, 0xa8, 0x36, 0x3c, 0x5f,
-	0xef, 0x46, 0x3d, 0xea, 0x40, 0x9f, 0x99, 0xe2, 0xd3, 0xdb, 0x9a, 0x72, 0x5f, 0x32, 0x3d, 0x0a,
-	0x85, 0x4a, 0x12, 0xd4, 0xa7, 0xd3, 0xa3, 0xe6, 0xe5, 0x40, 0x78, 0xdc, 0x19, 0xbd, 0x88, 0x3b,
-	0xa2, 0x3e, 0x9f, 0x1e, 0x35, 0x51, 0xd7, 0x18, 0xe6, 0xc8, 0x76, 0x30, 0xe9, 0x08, 0xbd, 0x11,
-	0x04, 0x33, 0x35, 0x6d, 0x61, 0x3c, 0xed, 0x76, 0xd9, 0xa9, 0xa1, 0x46, 0xa9, 0xbd, 0x32, 0x83,
-	0x97, 0x8e, 0x36, 0x83, 0xdc, 0xe6, 0x9e, 0x30, 0xb9, 0xdd, 0x5c, 0x66, 0xce, 0xe7, 0x03, 0xc2,
-	0xd7, 0x67, 0x8c, 0x4c, 0x13, 0xd6, 0xf1, 0x7c, 0xdc, 0x5e, 0x65, 0x7a, 0x50, 0xa5, 0x7f, 0xaf,
-	0x09, 0xdd, 0x70, 0x9c, 0x98, 0x3a, 0x4e, 0xec, 0xa6, 0x6a, 0xd2, 0x29, 0x00, 0x5c, 0xbd, 0x10,
-	0x30, 0xf5, 0xcc, 0x13, 0xb6, 0x0f, 0xe6, 0xf0, 0xfc, 0xd3, 0x58, 0x4a, 0xf6, 0x11, 0x5e, 0xcc,
-	0x66, 0x44, 0x96, 0x8b, 0x38, 0xce, 0x4c, 0xbe, 0x72, 0xef, 0xdf, 0xa2, 0xd4, 0xad, 0xfe, 0x60,
-	0xff, 0xeb, 0xaf, 0xf7, 0x73, 0xf7, 0xc9, 0x32, 0x2b, 0x58, 0xad, 0x9d, 0x48, 0xba, 0x8a, 0xbd,
-	0x36, 0x1b, 0xf3, 0x86, 0xbc, 0x45, 0xb8, 0x94, 0x6b, 0x13, 0x59, 0x39, 0xc7, 0xe2, 0xcc, 0xc0,
-	0x2a, 0xab, 0x17, 0xea, 0x0c, 0xcd, 0xdd, 0x84, 0xe6, 0x16, 0x59, 0x3a, 0x97, 0x66, 0xf3, 0xf9,
-	0xf1, 0xd8, 0x46, 0x27, 0x63, 0x1b, 0xfd, 0x1c, 0xdb, 0xe8, 0x60, 0x62, 0x5b, 0x27, 0x13, 0xdb,
-	0xfa, 0x36, 0xb1, 0x2d, 0x7c, 0xd3, 0x87, 0x02, 0x9f, 0x6d, 0xf4, 0xac, 0x99, 0xdb, 0xcb, 0xa9,
-	0x60, 0xcd, 0x87, 0xbc, 0xcb, 0xab, 0xc4, 0xa7, 0xb7, 0x90, 0xfc, 0x49, 0x0f, 0xff, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x68, 0x91, 0x92, 0x31, 0x04, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x2b, 0x45,
+	0x1c, 0xef, 0x3c, 0xa1, 0x3f, 0xa6, 0xcf, 0xe8, 0x1b, 0x79, 0xb1, 0x2c, 0xb2, 0xe5, 0x2d, 0xbe,
+	0xd7, 0x52, 0x60, 0xc7, 0x16, 0xb9, 0x78, 0x30, 0x01, 0x23, 0x95, 0xc4, 0x03, 0xae, 0x37, 0x13,
+	0x25, 0xdb, 0xdd, 0x61, 0xd9, 0xd0, 0xee, 0x94, 0xce, 0xb6, 0xda, 0x10, 0x0e, 0x72, 0xd2, 0x9b,
+	0x89, 0x81, 0x18, 0x4f, 0x1c, 0x8d, 0x27, 0xfe, 0x0c, 0x8e, 0x24, 0x1e, 0xd4, 0x8b, 0x1a, 0x30,
+	0xc1, 0x3f, 0xc3, 0xec, 0xcc, 0x2c, 0xdd, 0x2d, 0x5b, 0xca, 0xa1, 0x17, 0x76, 0x77, 0xe6, 0xf3,
+	0xe5, 0xf3, 0x63, 0xe7, 0xfb, 0xed, 0x42, 0xb5, 0xdd, 0xa1, 0x3d, 0xe2, 0x99, 0x9e, 0x45, 0xf0,
+	0x3e, 0x6d, 0xda, 0xb8, 0x57, 0xc5, 0x87, 0x5d, 0xd2, 0xe9, 0xeb, 0xed, 0x0e, 0xf5, 0x29, 0x42,
+	0x83, 0x7d, 0x3d, 0xd8, 0xd7, 0x7b, 0x55, 0xe5, 0x99, 0xd9, 0x72, 0x3d, 0x8a, 0xf9, 0x5f, 0x01,
+	0x53, 0x2a, 0x16, 0x65, 0x2d, 0xca, 0x70, 0xc3, 0x64, 0x44, 0xd4, 0xe3, 0x5e, 0xb5, 0x41, 0x7c,
+	0xb3, 0x8a, 0xdb, 0xa6, 0xe3, 0x7a, 0xa6, 0xef, 0x52, 0x4f, 0x62, 0xd5, 0x28, 0x36, 0x44, 0x59,
+	0xd4, 0x0d, 0xf7, 0x67, 0x1c, 0xea, 0x50, 0x7e, 0x8b, 0x83, 0x3b, 0xb9, 0xfa, 0x8e, 0x43, 0xa9,
+	0xd3, 0x24, 0xd8, 0x6c, 0xbb, 0xd8, 0xf4, 0x3c, 0xea, 0xf3, 0x7f, 0xc9, 0xe4, 0xee, 0x7c, 0x82,
+	0x8d, 0xe0, 0x2a, 0xb6, 0xb5, 0x75, 0xf8, 0x46, 0x9d, 0xf8, 0x9f, 0xd0, 0xa6, 0xcd, 0x0c, 0x72,
+	0xd8, 0x25, 0xcc, 0x47, 0x05, 0x98, 0x31, 0x6d, 0xbb, 0x43, 0x18, 0x2b, 0x80, 0x05, 0x50, 0xce,
+	0x19, 0xe1, 0xe3, 0x07, 0xd9, 0xef, 0xce, 0x8b, 0xa9, 0xff, 0xce, 0x8b, 0x29, 0xed, 0x77, 0x00,
+	0xdf, 0x1c, 0xd4, 0xb1, 0x36, 0xf5, 0x18, 0x41, 0x7d, 0x98, 0x36, 0x5b, 0xb4, 0xeb, 0xf9, 0x05,
+	0xb0, 0xf0, 0x5a, 0x39, 0x5f, 0x9b, 0xd5, 0x85, 0x1f, 0x3d, 0xf0, 0xa3, 0x4b, 0x3f, 0xfa, 0x47,
+	0xd4, 0xf5, 0x36, 0xb7, 0x2e, 0xff, 0x2a, 0xa6, 0x7e, 0xfd, 0xbb, 0x58, 0x76, 0x5c, 0x7f, 0xbf,
+	0xdb, 0xd0, 0x2d, 0xda, 0xc2, 0xd2, 0xbc, 0xb8, 0xac, 0x32, 0xfb, 0x00, 0xfb, 0xfd, 0x36, 0x61,
+	0xbc, 0x80, 0xfd, 0x7c, 0x7b, 0x51, 0x79, 0xda, 0x24, 0x8e, 0x69, 0xf5, 0x77, 0x83, 0x44, 0xd8,
+	0x2f, 0xb7, 0x17, 0x15, 0x60, 0x48, 0x42, 0xf4, 0x21, 0xcc, 0x74, 0x88, 0xc9, 0xa8, 0xc7, 0x0a,
+	0x4f, 0x38, 0xb7, 0xaa, 0xdf, 0x7f, 0x3d, 0xba, 0xc1, 0x21, 0x81, 0xe8, 0xcd, 0xa9, 0x40, 0x80,
+	0x11, 0x16, 0x45, 0x9c, 0xed, 0x41, 0x54, 0x27, 0xfe, 0x46, 0xb3, 0x19, 0xcb, 0x64, 0x0b, 0xc2,
+	0xc1, 0xdb, 0x2a, 0x58, 0x0b, 0xa0, 0x9c, 0xaf, 0xbd, 0x8a, 0xd9, 0x13, 0x47, 0x23, 0x34, 0xb9,
+	0x63, 0x3a, 0x44, 0xd6, 0x1a, 0x91, 0xca, 0x08, 0xcf, 0x29, 0x80, 0x6f, 0xc5, 0x88, 0x64, 0x88,
+	0xeb, 0x70, 0x3a, 0x90, 0xcb, 0x64, 0x86, 0xc5, 0x24, 0x1f, 0x1b, 0x96, 0x15, 0xb8, 0x0e, 0x0a,
+	0x0d, 0x81, 0x46, 0xf5, 0x04, 0x81, 0xa5, 0xb1, 0x02, 0x05, 0x67, 0x54, 0xa1, 0x86, 0xf9, 0x8b,
+	0xfd, 0x98, 0x59, 0x1d, 0xfa, 0x75, 0xe8, 0x7e, 0x0e, 0xe6, 0x08, 0x5f, 0xd8, 0x75, 0x6d, 0x7e,
+	0x26, 0xa6, 0x8c, 0xac, 0x58, 0xd8, 0xb6, 0xb5, 0x3a, 0x7c, 0x16, 0x29, 0x90, 0x2e, 0x6a, 0x30,
+	0x2d, 0x00, 0x1c, 0x9e, 0xaf, 0x29, 0x49, 0x36, 0x64, 0x8d, 0x44, 0x6a, 0x5f, 0xc1, 0x19, 0x11,
+	0x88, 0x58, 0x9f, 0x74, 0xf6, 0xda, 0x19, 0x80, 0xcf, 0x87, 0x08, 0xa4, 0xda, 0xf7, 0x61, 0x46,
+	0x68, 0x08, 0x53, 0x7f, 0x48, 0x6e, 0x08, 0x9d, 0x5c, 0xe4, 0xef, 0xf1, 0x93, 0xf0, 0xb9, 0x45,
+	0xdb, 0xe4, 0x53, 0x6a, 0x1d, 0x84, 0xbe, 0x67, 0x61, 0x96, 0x05, 0x6b, 0x61, 0xe8, 0x39, 0x23,
+	0xc3, 0x9f, 0xb7, 0x6d, 0x6d, 0x1b, 0xce, 0xc4, 0x2b, 0xa4, 0x91, 0x2a, 0x9c, 0x6a, 0x52, 0xeb,
+	0x40, 0x86, 0x3e, 0x9f, 0xe4, 0x62, 0x50, 0xc4, 0xa1, 0x9a, 0x09, 0xdf, 0x16, 0xa1, 0xdc, 0x6d,
+	0x4c, 0x3c, 0xf8, 0x9f, 0x00, 0x2c, 0xdc, 0xe7, 0x90, 0x92, 0xd7, 0xe0, 0x74, 0xa0, 0x23, 0x4c,
+	0x7e, 0x8c, 0x66, 0x81, 0x9d, 0x58, 0xf4, 0xb5, 0x3f, 0xd3, 0x70, 0xfa, 0xb3, 0x00, 0x8a, 0x4e,
+	0x00, 0xcc, 0x86, 0x13, 0x0d, 0x2d, 0x26, 0xa9, 0x18, 0x9a, 0x93, 0xca, 0xbb, 0x0f, 0x83, 0x04,
+	0x9b, 0xb6, 0x7c, 0xf2, 0xdb, 0xbf, 0x3f, 0x3e, 0x79, 0x89, 0x16, 0x71, 0xc2, 0x20, 0xde, 0xeb,
+	0x7a, 0x36, 0xc3, 0x47, 0x72, 0xbe, 0x1e, 0xa3, 0x6f, 0x01, 0xcc, 0x47, 0x86, 0x02, 0x7a, 0x35,
+	0x82, 0x62, 0x68, 0x3c, 0x29, 0xa5, 0xb1, 0x38, 0xa9, 0xe6, 0x05, 0x57, 0x33, 0x87, 0x66, 0x47,
+	0xaa, 0x41, 0xdf, 0x03, 0x98, 0xbb, 0x6b, 0x68, 0x34, 0xca, 0x64, 0x6c, 0x40, 0x28, 0x2f, 0xc7,
+	0xa0, 0x24, 0x3b, 0xe6, 0xec, 0x4b, 0xa8, 0x94, 0xc4, 0x2e, 0xdb, 0x0a, 0x1f, 0xdd, 0x8d, 0x9a,
+	0xe3, 0x40, 0xcb, 0xeb, 0xb1, 0x96, 0x45, 0xe5, 0xd1, 0x4e, 0xe3, 0x63, 0x43, 0x59, 0x7a, 0x04,
+	0x52, 0xea, 0x5a, 0xe4, 0xba, 0xe6, 0xd1, 0xdc, 0x03, 0xba, 0xd0, 0x29, 0x80, 0x4f, 0xa3, 0x4d,
+	0x87, 0x46, 0x85, 0x3e, 0xdc, 0xc8, 0x4a, 0x79, 0x3c, 0x50, 0x0a, 0xa9, 0x71, 0x21, 0x2b, 0xa8,
+	0x92, 0x24, 0x44, 0x0c, 0x03, 0xde, 0x00, 0xf8, 0x28, 0x9c, 0x0c, 0xc7, 0xe8, 0x4c, 0xfc, 0x14,
+	0xc7, 0xba, 0x0b, 0x2d, 0x8f, 0x36, 0x7f, 0xaf, 0xcf, 0x95, 0x95, 0xc7, 0x81, 0xa5, 0xc6, 0x12,
+	0xd7, 0xf8, 0x02, 0x15, 0xc7, 0x68, 0xdc, 0xfc, 0xf2, 0xf2, 0x5a, 0x05, 0x57, 0xd7, 0x2a, 0xf8,
+	0xe7, 0x5a, 0x05, 0x3f, 0xdc, 0xa8, 0xa9, 0xab, 0x1b, 0x35, 0xf5, 0xc7, 0x8d, 0x9a, 0x82, 0xcf,
+	0x5d, 0x9a, 0x40, 0xb9, 0x03, 0xbe, 0xa8, 0x44, 0x3e, 0x07, 0x06, 0x80, 0x55, 0x97, 0x46, 0xb9,
+	0xbe, 0xe1, 0x6c, 0x8d, 0x34, 0xff, 0x80, 0x59, 0xfb, 0x7f, 0x00, 0x3a, 0x0a, 0xbf, 0x74, 0xa8,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +685,14 @@ type QueryClient interface {
 	GetHolds(ctx context.Context, in *GetHoldsRequest, opts ...grpc.CallOption) (*GetHoldsResponse, error)
 	// GetAllHolds returns all addresses with funds on hold, and the amount held.
 	GetAllHolds(ctx context.Context, in *GetAllHoldsRequest, opts ...grpc.CallOption) (*GetAllHoldsResponse, error)
+	// GetEscrow looks up an escrow by its id.
+	GetEscrow(ctx context.Context, in *GetEscrowRequest, opts ...grpc.CallOption) (*GetEscrowResponse, error)
+	// GetAllEscrows returns all escrows.
+	GetAllEscrows(ctx context.Context, in *GetAllEscrowsRequest, opts ...grpc.CallOption) (*GetAllEscrowsResponse, error)
+	// GetScopeLock looks up the lock on a scope's value ownership.
+	GetScopeLock(ctx context.Context, in *GetScopeLockRequest, opts ...grpc.CallOption) (*GetScopeLockResponse, error)
+	// GetAllScopeLocks returns all scope value ownership locks.
+	GetAllScopeLocks(ctx context.Context, in *GetAllScopeLocksRequest, opts ...grpc.CallOption) (*GetAllScopeLocksResponse, error)
 }
 
 type queryClient struct {
@@ -296,12 +721,56 @@ func (c *queryClient) GetAllHolds(ctx context.Context, in *GetAllHoldsRequest, o
 	return out, nil
 }
 
+func (c *queryClient) GetEscrow(ctx context.Context, in *GetEscrowRequest, opts ...grpc.CallOption) (*GetEscrowResponse, error) {
+	out := new(GetEscrowResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Query/GetEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllEscrows(ctx context.Context, in *GetAllEscrowsRequest, opts ...grpc.CallOption) (*GetAllEscrowsResponse, error) {
+	out := new(GetAllEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Query/GetAllEscrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetScopeLock(ctx context.Context, in *GetScopeLockRequest, opts ...grpc.CallOption) (*GetScopeLockResponse, error) {
+	out := new(GetScopeLockResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Query/GetScopeLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllScopeLocks(ctx context.Context, in *GetAllScopeLocksRequest, opts ...grpc.CallOption) (*GetAllScopeLocksResponse, error) {
+	out := new(GetAllScopeLocksResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Query/GetAllScopeLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetHolds looks up the funds that are on hold for an address.
 	GetHolds(context.Context, *GetHoldsRequest) (*GetHoldsResponse, error)
 	// GetAllHolds returns all addresses with funds on hold, and the amount held.
 	GetAllHolds(context.Context, *GetAllHoldsRequest) (*GetAllHoldsResponse, error)
+	// GetEscrow looks up an escrow by its id.
+	GetEscrow(context.Context, *GetEscrowRequest) (*GetEscrowResponse, error)
+	// GetAllEscrows returns all escrows.
+	GetAllEscrows(context.Context, *GetAllEscrowsRequest) (*GetAllEscrowsResponse, error)
+	// GetScopeLock looks up the lock on a scope's value ownership.
+	GetScopeLock(context.Context, *GetScopeLockRequest) (*GetScopeLockResponse, error)
+	// GetAllScopeLocks returns all scope value ownership locks.
+	GetAllScopeLocks(context.Context, *GetAllScopeLocksRequest) (*GetAllScopeLocksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -314,6 +783,18 @@ func (*UnimplementedQueryServer) GetHolds(ctx context.Context, req *GetHoldsRequ
 func (*UnimplementedQueryServer) GetAllHolds(ctx context.Context, req *GetAllHoldsRequest) (*GetAllHoldsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllHolds not implemented")
 }
+func (*UnimplementedQueryServer) GetEscrow(ctx context.Context, req *GetEscrowRequest) (*GetEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEscrow not implemented")
+}
+func (*UnimplementedQueryServer) GetAllEscrows(ctx context.Context, req *GetAllEscrowsRequest) (*GetAllEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllEscrows not implemented")
+}
+func (*UnimplementedQueryServer) GetScopeLock(ctx context.Context, req *GetScopeLockRequest) (*GetScopeLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScopeLock not implemented")
+}
+func (*UnimplementedQueryServer) GetAllScopeLocks(ctx context.Context, req *GetAllScopeLocksRequest) (*GetAllScopeLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllScopeLocks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -355,6 +836,78 @@ func _Query_GetAllHolds_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Query/GetEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEscrow(ctx, req.(*GetEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Query/GetAllEscrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllEscrows(ctx, req.(*GetAllEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetScopeLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScopeLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetScopeLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Query/GetScopeLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetScopeLock(ctx, req.(*GetScopeLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllScopeLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllScopeLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllScopeLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Query/GetAllScopeLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllScopeLocks(ctx, req.(*GetAllScopeLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.hold.v1.Query",
@@ -368,6 +921,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllHolds",
 			Handler:    _Query_GetAllHolds_Handler,
 		},
+		{
+			MethodName: "GetEscrow",
+			Handler:    _Query_GetEscrow_Handler,
+		},
+		{
+			MethodName: "GetAllEscrows",
+			Handler:    _Query_GetAllEscrows_Handler,
+		},
+		{
+			MethodName: "GetScopeLock",
+			Handler:    _Query_GetScopeLock_Handler,
+		},
+		{
+			MethodName: "GetAllScopeLocks",
+			Handler:    _Query_GetAllScopeLocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/hold/v1/query.proto",
@@ -423,6 +992,20 @@ func (m *GetHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -466,146 +1049,1338 @@ func (m *GetAllHoldsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllHoldsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllHoldsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscrowId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EscrowId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escrow != nil {
+		{
+			size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllEscrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllEscrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllEscrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllEscrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllEscrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllEscrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetScopeLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetScopeLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetScopeLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeId) > 0 {
+		i -= len(m.ScopeId)
+		copy(dAtA[i:], m.ScopeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScopeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetScopeLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetScopeLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetScopeLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lock != nil {
+		{
+			size, err := m.Lock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllScopeLocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllScopeLocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllScopeLocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllScopeLocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllScopeLocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllScopeLocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetHoldsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHoldsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for _, e := range m.Reasons {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAllHoldsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllHoldsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowId != 0 {
+		n += 1 + sovQuery(uint64(m.EscrowId))
+	}
+	return n
+}
+
+func (m *GetEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Escrow != nil {
+		l = m.Escrow.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllEscrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllEscrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetScopeLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetScopeLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lock != nil {
+		l = m.Lock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllScopeLocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAllScopeLocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHoldsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHoldsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, ReasonHold{})
+			if err := m.Reasons[len(m.Reasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllHoldsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllHoldsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, &AccountHold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			m.EscrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Escrow == nil {
+				m.Escrow = &Escrow{}
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllEscrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllEscrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllEscrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *GetAllHoldsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetAllHoldsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *GetAllHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+func (m *GetAllEscrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Holds) > 0 {
-		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllEscrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllEscrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetHoldsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *GetHoldsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, &Escrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *GetAllHoldsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *GetAllHoldsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Holds) > 0 {
-		for _, e := range m.Holds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetScopeLockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -628,15 +2403,15 @@ func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetHoldsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetScopeLockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetScopeLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -664,7 +2439,7 @@ func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ScopeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -687,7 +2462,7 @@ func (m *GetHoldsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetScopeLockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -710,15 +2485,15 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetHoldsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetScopeLockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetScopeLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -745,8 +2520,10 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lock == nil {
+				m.Lock = &ScopeLock{}
+			}
+			if err := m.Lock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -771,7 +2548,7 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllScopeLocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -794,10 +2571,10 @@ func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllHoldsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllScopeLocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllHoldsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllScopeLocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 99:
@@ -857,7 +2634,7 @@ func (m *GetAllHoldsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAllScopeLocksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -880,15 +2657,15 @@ func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllHoldsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllScopeLocksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllHoldsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllScopeLocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -915,8 +2692,8 @@ func (m *GetAllHoldsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Holds = append(m.Holds, &AccountHold{})
-			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Locks = append(m.Locks, &ScopeLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,12 +7,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/keeper"
 )
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
 // Value to the corresponding group type.
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldCoin):
@@ -21,6 +22,19 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 			valBMsg := holdCoinValueMsg(kvB.Value)
 			return fmt.Sprintf("<HoldCoin><%s><%s>: A = %s, B = %s\n", addr, denom, valAMsg, valBMsg)
 
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixEscrow):
+			escrowID := keeper.ParseEscrowKey(kvA.Key)
+			return fmt.Sprintf("<Escrow><%d>: A = %s, B = %s\n", escrowID, escrowValueMsg(cdc, kvA.Value), escrowValueMsg(cdc, kvB.Value))
+
+		case bytes.Equal(kvA.Key, keeper.KeyLastEscrowID):
+			valA := keeper.UnmarshalLastEscrowIDValue(kvA.Value)
+			valB := keeper.UnmarshalLastEscrowIDValue(kvB.Value)
+			return fmt.Sprintf("<LastEscrowID>: A = %d, B = %d\n", valA, valB)
+
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixScopeLock):
+			scopeID := keeper.ParseScopeLockKey(kvA.Key)
+			return fmt.Sprintf("<ScopeLock><%s>: A = %s, B = %s\n", scopeID, scopeLockValueMsg(cdc, kvA.Value), scopeLockValueMsg(cdc, kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid hold key %X", kvA.Key))
 		}
@@ -35,3 +49,21 @@ func holdCoinValueMsg(value []byte) string {
 	}
 	return `"` + val.String() + `"`
 }
+
+// escrowValueMsg converts the given bytes into an escrow entry value string.
+func escrowValueMsg(cdc codec.Codec, value []byte) string {
+	var escrow hold.Escrow
+	if err := cdc.Unmarshal(value, &escrow); err != nil {
+		return fmt.Sprintf("<invalid>: %v", value)
+	}
+	return escrow.String()
+}
+
+// scopeLockValueMsg converts the given bytes into a scope lock entry value string.
+func scopeLockValueMsg(cdc codec.Codec, value []byte) string {
+	var lock hold.ScopeLock
+	if err := cdc.Unmarshal(value, &lock); err != nil {
+		return fmt.Sprintf("<invalid>: %v", value)
+	}
+	return lock.String()
+}
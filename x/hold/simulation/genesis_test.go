@@ -411,12 +411,15 @@ func TestRandomizedGenState(t *testing.T) {
 		rv := hold.DefaultGenesisState()
 		rv.Holds = make([]*hold.AccountHold, len(holds))
 		copy(rv.Holds, holds)
+		rv.Escrows = []*hold.Escrow{}
+		rv.ScopeLocks = []*hold.ScopeLock{}
 		return rv
 	}
 	accountHold := func(acc simtypes.Account, amount int64) *hold.AccountHold {
 		return &hold.AccountHold{
 			Address: acc.Address.String(),
 			Amount:  bondCoins(amount),
+			Reasons: []hold.ReasonHold{},
 		}
 	}
 	bankGen := func(bals ...banktypes.Balance) *banktypes.GenesisState {
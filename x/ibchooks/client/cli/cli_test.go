@@ -98,6 +98,23 @@ func (s *IntegrationTestSuite) TestUpdateParamsCmd() {
 			args:         []string{fmt.Sprintf("%v,%v", s.accountAddr.String(), sdk.AccAddress("input111111111111111").String())},
 			expectedCode: 0,
 		},
+		{
+			name: "success - update hook policy",
+			args: []string{
+				s.accountAddr.String(),
+				fmt.Sprintf("--%s=%s", ibchookscli.FlagAllowedHookContracts, s.accountAddr.String()),
+				fmt.Sprintf("--%s=%s=500000", ibchookscli.FlagHookGasLimits, s.accountAddr.String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "failure - invalid hook gas limit",
+			args: []string{
+				s.accountAddr.String(),
+				fmt.Sprintf("--%s=%s", ibchookscli.FlagHookGasLimits, s.accountAddr.String()),
+			},
+			expectErrMsg: fmt.Sprintf(`invalid hook gas limit %q: expected format <contract>=<gas-limit>`, s.accountAddr.String()),
+		},
 		{
 			name:         "failure - invalid args",
 			args:         []string{"contract1"},
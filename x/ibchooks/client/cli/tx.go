@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -15,6 +16,11 @@ import (
 	"github.com/provenance-io/provenance/x/ibchooks/types"
 )
 
+const (
+	FlagAllowedHookContracts = "allowed-hook-contracts"
+	FlagHookGasLimits        = "hook-gas-limits"
+)
+
 // NewTxCmd is the top-level command for attribute CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -34,11 +40,12 @@ func NewTxCmd() *cobra.Command {
 // NewUpdateParamsCmd creates a command to update the ibchooks module's params via governance proposal.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-params <allowed-async-ack-contracts>",
-		Short:   "Update the ibchooks module's params via governance proposal",
-		Long:    "Submit an update params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(1),
-		Example: fmt.Sprintf(`%[1]s tx ibchooks update-params contract1,contract2 --deposit 50000nhash`, version.AppName),
+		Use:   "update-params <allowed-async-ack-contracts>",
+		Short: "Update the ibchooks module's params via governance proposal",
+		Long:  "Submit an update params via governance proposal along with an initial deposit.",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s tx ibchooks update-params contract1,contract2 --deposit 50000nhash
+%[1]s tx ibchooks update-params contract1 --allowed-hook-contracts contract1,contract3 --hook-gas-limits contract3=500000 --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -50,6 +57,18 @@ func NewUpdateParamsCmd() *cobra.Command {
 			allowedAsyncAckContracts := strings.Split(args[0], ",")
 
 			msg := types.NewMsgUpdateParamsRequest(allowedAsyncAckContracts, authority)
+			msg.Params.AllowedHookContracts, err = flagSet.GetStringSlice(FlagAllowedHookContracts)
+			if err != nil {
+				return err
+			}
+			gasLimits, err := flagSet.GetStringSlice(FlagHookGasLimits)
+			if err != nil {
+				return err
+			}
+			msg.Params.HookGasLimits, err = parseHookGasLimits(gasLimits)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -57,9 +76,28 @@ func NewUpdateParamsCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringSlice(FlagAllowedHookContracts, nil, "The only contracts that can be executed by an ibc memo (default allows any contract)")
+	cmd.Flags().StringSlice(FlagHookGasLimits, nil, "The gas limits of contracts executed by an ibc memo as <contract>=<gas-limit>")
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseHookGasLimits parses entries of the form <contract>=<gas-limit> into hook gas limits.
+func parseHookGasLimits(entries []string) ([]types.HookGasLimit, error) {
+	var rv []types.HookGasLimit
+	for _, entry := range entries {
+		parts := strings.Split(entry, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid hook gas limit %q: expected format <contract>=<gas-limit>", entry)
+		}
+		gasLimit, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hook gas limit %q: %w", entry, err)
+		}
+		rv = append(rv, types.HookGasLimit{Contract: parts[0], GasLimit: gasLimit})
+	}
+	return rv, nil
+}
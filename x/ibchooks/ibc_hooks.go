@@ -49,7 +49,7 @@ func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, p
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	isIcs20, _ := isIcs20Packet(packet.GetData())
+	isIcs20, data := isIcs20Packet(packet.GetData())
 	if !isIcs20 {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
@@ -57,6 +57,9 @@ func (h IbcHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, p
 	if err := h.markerHooks.AddUpdateMarker(ctx, packet, h.ibcKeeper); err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerError, err.Error())
 	}
+	if hasMarkerActions, _ := jsonStringHasKey(data.GetMemo(), types.MarkerActionsKey); hasMarkerActions {
+		return h.markerHooks.OnRecvPacketOverride(im, ctx, packet, relayer, h.wasmHooks.bech32PrefixAccAddr)
+	}
 	return h.wasmHooks.OnRecvPacketOverride(im, ctx, packet, relayer)
 }
 
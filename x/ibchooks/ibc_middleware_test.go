@@ -29,6 +29,7 @@ import (
 	testutil "github.com/provenance-io/provenance/testutil/ibc"
 	"github.com/provenance-io/provenance/x/ibchooks"
 	"github.com/provenance-io/provenance/x/ibchooks/keeper"
+	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
 	"github.com/provenance-io/provenance/x/marker/types"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
@@ -324,6 +325,58 @@ func (suite *HooksTestSuite) TestFundsAreReturnedOnFailedContractExec() {
 	suite.Require().Equal(sdkmath.NewInt(0), balance.Amount)
 }
 
+// Contracts that are not in the allowed hook contracts cannot be executed and the funds are returned
+func (suite *HooksTestSuite) TestHookContractNotAllowed() {
+	codeID := suite.chainA.StoreContractEchoDirect(&suite.Suite)
+	addr := suite.chainA.InstantiateContract(&suite.Suite, "{}", codeID)
+
+	params := ibchookstypes.DefaultParams()
+	params.AllowedHookContracts = []string{sdk.AccAddress("other_contract______").String()}
+	suite.chainA.GetProvenanceApp().IBCHooksKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ackBytes := suite.receivePacket(addr.String(), fmt.Sprintf(`{"marker":{},"wasm":{"contract":"%s","msg":{"echo":{"msg":"test"}}}}`, addr))
+	var ack map[string]string
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack), "ack unmarshal")
+	suite.Require().Contains(ack, "error", "ack for contract not allowed")
+
+	localDenom := ibchooks.MustExtractDenomFromPacketOnRecv(suite.makeMockPacket("", "", 0))
+	balance := suite.chainA.GetProvenanceApp().BankKeeper.GetBalance(suite.chainA.GetContext(), addr, localDenom)
+	suite.Require().Equal(sdkmath.NewInt(0), balance.Amount, "contract balance")
+
+	params.AllowedHookContracts = append(params.AllowedHookContracts, addr.String())
+	suite.chainA.GetProvenanceApp().IBCHooksKeeper.SetParams(suite.chainA.GetContext(), params)
+	ackBytes = suite.receivePacketWithSequence(addr.String(), fmt.Sprintf(`{"marker":{},"wasm":{"contract":"%s","msg":{"echo":{"msg":"test"}}}}`, addr), 1)
+	ack = nil
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack), "ack unmarshal after allowing contract")
+	suite.Require().NotContains(ack, "error", "ack for allowed contract")
+}
+
+// Contracts that run out of their hook gas limit fail and the funds are returned
+func (suite *HooksTestSuite) TestHookGasLimit() {
+	codeID := suite.chainA.StoreContractEchoDirect(&suite.Suite)
+	addr := suite.chainA.InstantiateContract(&suite.Suite, "{}", codeID)
+
+	params := ibchookstypes.DefaultParams()
+	params.HookGasLimits = []ibchookstypes.HookGasLimit{{Contract: addr.String(), GasLimit: 1000}}
+	suite.chainA.GetProvenanceApp().IBCHooksKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ackBytes := suite.receivePacket(addr.String(), fmt.Sprintf(`{"marker":{},"wasm":{"contract":"%s","msg":{"echo":{"msg":"test"}}}}`, addr))
+	var ack map[string]string
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack), "ack unmarshal")
+	suite.Require().Contains(ack, "error", "ack for contract exceeding gas limit")
+
+	localDenom := ibchooks.MustExtractDenomFromPacketOnRecv(suite.makeMockPacket("", "", 0))
+	balance := suite.chainA.GetProvenanceApp().BankKeeper.GetBalance(suite.chainA.GetContext(), addr, localDenom)
+	suite.Require().Equal(sdkmath.NewInt(0), balance.Amount, "contract balance")
+
+	params.HookGasLimits[0].GasLimit = 10_000_000
+	suite.chainA.GetProvenanceApp().IBCHooksKeeper.SetParams(suite.chainA.GetContext(), params)
+	ackBytes = suite.receivePacketWithSequence(addr.String(), fmt.Sprintf(`{"marker":{},"wasm":{"contract":"%s","msg":{"echo":{"msg":"test"}}}}`, addr), 1)
+	ack = nil
+	suite.Require().NoError(json.Unmarshal(ackBytes, &ack), "ack unmarshal with higher gas limit")
+	suite.Require().NotContains(ack, "error", "ack for contract within gas limit")
+}
+
 // After successfully executing a wasm call, the contract should have the funds sent via IBC
 func (suite *HooksTestSuite) TestFundTracking() {
 	// Setup contract
@@ -536,3 +589,50 @@ func (suite *HooksTestSuite) TestSendWithoutMemo() {
 	suite.Require().Equal(marker.GetDenom(), denom)
 	suite.Require().True(marker.HasAccess(chainASenderAddress.String(), markertypes.Access_Transfer), "ChainB Ibc marker should have transfer rights added")
 }
+
+func (suite *HooksTestSuite) TestMarkerActions() {
+	pioApp := suite.chainA.GetProvenanceApp()
+	prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	intermediary, err := keeper.DeriveIntermediateSender("channel-0", suite.chainB.SenderAccount.GetAddress().String(), prefix)
+	suite.Require().NoError(err, "DeriveIntermediateSender")
+	intermediaryAddr := sdk.MustAccAddressFromBech32(intermediary)
+	localDenom := ibchooks.MustExtractDenomFromPacketOnRecv(suite.makeMockPacket("", "", 0))
+
+	navMarker := markertypes.NewEmptyMarkerAccount("navcoin", intermediary, []types.AccessGrant{*types.NewAccessGrant(intermediaryAddr, []types.Access{types.Access_Admin})})
+	navMarker.Supply = sdkmath.NewInt(100)
+	suite.Require().NoError(pioApp.MarkerKeeper.AddFinalizeAndActivateMarker(suite.chainA.GetContext(), navMarker), "AddFinalizeAndActivateMarker(navcoin)")
+	depositMarker := markertypes.NewEmptyMarkerAccount("depositcoin", intermediary, nil)
+	depositMarker.Supply = sdkmath.NewInt(100)
+	suite.Require().NoError(pioApp.MarkerKeeper.AddFinalizeAndActivateMarker(suite.chainA.GetContext(), depositMarker), "AddFinalizeAndActivateMarker(depositcoin)")
+	otherMarker := markertypes.NewEmptyMarkerAccount("othercoin", sdk.AccAddress("other_admin").String(), []types.AccessGrant{*types.NewAccessGrant(sdk.AccAddress("other_admin"), []types.Access{types.Access_Admin})})
+	otherMarker.Supply = sdkmath.NewInt(100)
+	suite.Require().NoError(pioApp.MarkerKeeper.AddFinalizeAndActivateMarker(suite.chainA.GetContext(), otherMarker), "AddFinalizeAndActivateMarker(othercoin)")
+
+	recipient := sdk.AccAddress("recipient")
+	receive := func(memo string, prevSequence uint64) map[string]string {
+		ackBytes := suite.receivePacketWithSequence("", memo, prevSequence)
+		var ack map[string]string
+		suite.Require().NoError(json.Unmarshal(ackBytes, &ack), "ack unmarshal")
+		return ack
+	}
+
+	memo := fmt.Sprintf(`{"marker":{},"marker-actions":[{"forward":{"to-address":"%s"}},{"set-nav":{"marker":"navcoin","price":"10usd","volume":2}}]}`, recipient.String())
+	ack := receive(memo, 0)
+	suite.Require().NotContains(ack, "error", "forward and set-nav ack")
+	suite.Assert().Equal("1", pioApp.BankKeeper.GetBalance(suite.chainA.GetContext(), recipient, localDenom).Amount.String(), "recipient balance after forward")
+	suite.Assert().Equal("0", pioApp.BankKeeper.GetBalance(suite.chainA.GetContext(), intermediaryAddr, localDenom).Amount.String(), "intermediary balance after forward")
+	nav, err := pioApp.MarkerKeeper.GetNetAssetValue(suite.chainA.GetContext(), "navcoin", "usd")
+	suite.Require().NoError(err, "GetNetAssetValue(navcoin)")
+	suite.Require().NotNil(nav, "GetNetAssetValue(navcoin)")
+	suite.Assert().Equal("10usd", nav.Price.String(), "navcoin net asset value price")
+	suite.Assert().Equal(uint64(2), nav.Volume, "navcoin net asset value volume")
+
+	ack = receive(`{"marker":{},"marker-actions":[{"deposit":{"marker":"depositcoin"}}]}`, 1)
+	suite.Require().NotContains(ack, "error", "deposit ack")
+	suite.Assert().Equal("1", pioApp.BankKeeper.GetBalance(suite.chainA.GetContext(), depositMarker.GetAddress(), localDenom).Amount.String(), "marker balance after deposit")
+
+	ack = receive(`{"marker":{},"marker-actions":[{"deposit":{"marker":"depositcoin"}},{"set-nav":{"marker":"othercoin","price":"10usd","volume":2}}]}`, 2)
+	suite.Require().Contains(ack, "error", "set-nav without access ack")
+	suite.Assert().Equal("1", pioApp.BankKeeper.GetBalance(suite.chainA.GetContext(), depositMarker.GetAddress(), localDenom).Amount.String(), "marker balance after failed actions")
+	suite.Assert().Equal("0", pioApp.BankKeeper.GetBalance(suite.chainA.GetContext(), intermediaryAddr, localDenom).Amount.String(), "intermediary balance after failed actions")
+}
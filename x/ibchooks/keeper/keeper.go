@@ -128,6 +128,16 @@ func (k Keeper) IsInAllowList(ctx sdk.Context, contract string) bool {
 	return false
 }
 
+// IsHookContractAllowed checks the params to see if the contract can be executed by an ibc memo
+func (k Keeper) IsHookContractAllowed(ctx sdk.Context, contract string) bool {
+	return k.GetParams(ctx).IsHookContractAllowed(contract)
+}
+
+// GetHookGasLimit returns the gas limit for a contract executed by an ibc memo, or 0 if there isn't one
+func (k Keeper) GetHookGasLimit(ctx sdk.Context, contract string) uint64 {
+	return k.GetParams(ctx).GetHookGasLimit(contract)
+}
+
 // DeletePacketCallback deletes the callback from storage once it has been processed
 func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequence uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -2,19 +2,23 @@ package ibchooks
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"strings"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	tendermintclient "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
+	"github.com/provenance-io/provenance/x/ibchooks/keeper"
 	"github.com/provenance-io/provenance/x/ibchooks/types"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
@@ -22,17 +26,129 @@ import (
 
 type MarkerHooks struct {
 	MarkerKeeper *markerkeeper.Keeper
+	BankKeeper   types.BankKeeper
 }
 
-func NewMarkerHooks(markerkeeper *markerkeeper.Keeper) MarkerHooks {
+func NewMarkerHooks(markerkeeper *markerkeeper.Keeper, bankKeeper types.BankKeeper) MarkerHooks {
 	return MarkerHooks{
 		MarkerKeeper: markerkeeper,
+		BankKeeper:   bankKeeper,
 	}
 }
 
 // ProperlyConfigured returns false when marker hooks are configured incorrectly
 func (h MarkerHooks) ProperlyConfigured() bool {
-	return h.MarkerKeeper != nil
+	return h.MarkerKeeper != nil && h.BankKeeper != nil
+}
+
+// OnRecvPacketOverride receives the funds of an ics20 packet into the intermediary account of the sender and then
+// runs the marker actions of the packet's memo as that intermediary account.
+func (h MarkerHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context, packet channeltypes.Packet, relayer sdktypes.AccAddress, bech32PrefixAccAddr string) exported.Acknowledgement {
+	isIcs20, data := isIcs20Packet(packet.GetData())
+	if !isIcs20 {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	isMarkerRouted, actions, err := ProcessMarkerActionsMemo(data.GetMemo())
+	if !isMarkerRouted {
+		return im.App.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
+	}
+
+	// The sender of the packet cannot be trusted, so the actions are run as the intermediary account
+	// derived from the channel and sender, the same way it is done for the wasm hooks.
+	channel := packet.GetDestChannel()
+	sender := data.GetSender()
+	senderBech32, err := keeper.DeriveIntermediateSender(channel, sender, bech32PrefixAccAddr)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrBadSender, fmt.Sprintf("cannot convert sender address %s/%s to bech32: %s", channel, sender, err.Error()))
+	}
+
+	data.Receiver = senderBech32
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrMarshaling, err.Error())
+	}
+	packet.Data = bz
+
+	ack := im.App.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	amount, ok := sdkmath.NewIntFromString(data.GetAmount())
+	if !ok {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrInvalidPacket, "Amount is not an int")
+	}
+	denom := MustExtractDenomFromPacketOnRecv(packet)
+	funds := sdktypes.NewCoins(sdktypes.NewCoin(denom, amount))
+
+	intermediary := sdktypes.MustAccAddressFromBech32(senderBech32)
+	for i, action := range actions {
+		if err = h.executeMarkerAction(ctx, intermediary, funds, action); err != nil {
+			return NewEmitErrorAcknowledgement(ctx, types.ErrMarkerActionError, fmt.Sprintf("marker action %d: %s", i, err.Error()))
+		}
+	}
+
+	return ack
+}
+
+// executeMarkerAction runs a single marker action as the intermediary account.
+func (h MarkerHooks) executeMarkerAction(ctx sdktypes.Context, intermediary sdktypes.AccAddress, funds sdktypes.Coins, action types.MarkerAction) error {
+	switch {
+	case action.Deposit != nil:
+		marker, err := h.MarkerKeeper.GetMarkerByDenom(ctx, action.Deposit.Marker)
+		if err != nil {
+			return err
+		}
+		return h.BankKeeper.SendCoins(ctx, intermediary, marker.GetAddress(), funds)
+	case action.Forward != nil:
+		// Restricted coins can only be forwarded to an account that has the marker's required attributes.
+		toAddr, err := sdktypes.AccAddressFromBech32(action.Forward.ToAddress)
+		if err != nil {
+			return err
+		}
+		return h.BankKeeper.SendCoins(ctx, intermediary, toAddr, funds)
+	case action.SetNav != nil:
+		nav, err := action.SetNav.GetNetAssetValue()
+		if err != nil {
+			return err
+		}
+		msg := &markertypes.MsgAddNetAssetValuesRequest{
+			Denom:          action.SetNav.Marker,
+			NetAssetValues: []markertypes.NetAssetValue{nav},
+			Administrator:  intermediary.String(),
+		}
+		_, err = markerkeeper.NewMsgServerImpl(*h.MarkerKeeper).AddNetAssetValues(ctx, msg)
+		return err
+	}
+	return fmt.Errorf("unknown marker action")
+}
+
+// ProcessMarkerActionsMemo extracts and validates the marker actions part of a packet memo
+func ProcessMarkerActionsMemo(memo string) (bool, []types.MarkerAction, error) {
+	found, jsonObject := jsonStringHasKey(memo, types.MarkerActionsKey)
+	if !found {
+		return false, nil, nil
+	}
+	if _, hasWasm := jsonObject["wasm"]; hasWasm {
+		return true, nil, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "marker actions cannot be combined with a wasm hook")
+	}
+
+	jsonBytes, err := json.Marshal(jsonObject)
+	if err != nil {
+		return true, nil, err
+	}
+	var actionsMemo types.MarkerActionsMemo
+	if err = json.Unmarshal(jsonBytes, &actionsMemo); err != nil {
+		return true, nil, err
+	}
+	if err = actionsMemo.Validate(); err != nil {
+		return true, nil, err
+	}
+	return true, actionsMemo.MarkerActions, nil
 }
 
 // AddUpdateMarker will add or update ibc Marker with transfer authorities
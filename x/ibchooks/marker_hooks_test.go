@@ -8,6 +8,7 @@ import (
 	"github.com/provenance-io/provenance/app"
 	testutil "github.com/provenance-io/provenance/testutil/ibc"
 	"github.com/provenance-io/provenance/x/ibchooks"
+	ibchookstypes "github.com/provenance-io/provenance/x/ibchooks/types"
 	"github.com/provenance-io/provenance/x/marker/types"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 
@@ -82,7 +83,7 @@ func (suite *MarkerHooksTestSuite) TestAddUpdateMarker() {
 	suite.chainA.GetProvenanceApp().BankKeeper.MintCoins(suite.chainA.GetContext(), markertypes.CoinPoolName, sdk.NewCoins(sdk.NewInt64Coin("ibc/F7466BCD642C14163B3E67D5B4401FD2B77271C3225FDE0C57ADD61B8046253D", 100)))
 	address1 := sdk.AccAddress("address1")
 	address2 := sdk.AccAddress("address2")
-	markerHooks := ibchooks.NewMarkerHooks(&suite.chainA.GetProvenanceApp().MarkerKeeper)
+	markerHooks := ibchooks.NewMarkerHooks(&suite.chainA.GetProvenanceApp().MarkerKeeper, suite.chainA.GetProvenanceApp().BankKeeper)
 	testCases := []struct {
 		name          string
 		denom         string
@@ -236,6 +237,92 @@ func (suite *MarkerHooksTestSuite) TestProcessMarkerMemo() {
 	}
 }
 
+func (suite *MarkerHooksTestSuite) TestProcessMarkerActionsMemo() {
+	address1 := sdk.AccAddress("address1")
+	testCases := []struct {
+		name       string
+		memo       string
+		expFound   bool
+		expActions []ibchookstypes.MarkerAction
+		expErr     string
+	}{
+		{
+			name:     "no marker actions",
+			memo:     `{"marker":{}}`,
+			expFound: false,
+		},
+		{
+			name:     "non json memo",
+			memo:     "55 burger 55 fries...",
+			expFound: false,
+		},
+		{
+			name:     "all actions",
+			memo:     fmt.Sprintf(`{"marker":{},"marker-actions":[{"forward":{"to-address":"%s"}},{"set-nav":{"marker":"hotdog","price":"10usd","volume":3}}]}`, address1.String()),
+			expFound: true,
+			expActions: []ibchookstypes.MarkerAction{
+				{Forward: &ibchookstypes.ForwardAction{ToAddress: address1.String()}},
+				{SetNav: &ibchookstypes.SetNavAction{Marker: "hotdog", Price: "10usd", Volume: 3}},
+			},
+		},
+		{
+			name:     "combined with wasm",
+			memo:     `{"marker-actions":[{"deposit":{"marker":"hotdog"}}],"wasm":{"contract":"%1234","msg":{}}}`,
+			expFound: true,
+			expErr:   "marker actions cannot be combined with a wasm hook",
+		},
+		{
+			name:     "empty actions",
+			memo:     `{"marker-actions":[]}`,
+			expFound: true,
+			expErr:   "marker actions cannot be empty",
+		},
+		{
+			name:     "two actions in one entry",
+			memo:     fmt.Sprintf(`{"marker-actions":[{"deposit":{"marker":"hotdog"},"forward":{"to-address":"%s"}}]}`, address1.String()),
+			expFound: true,
+			expErr:   "invalid marker action 0: exactly one of deposit, forward, or set-nav must be set, found 2",
+		},
+		{
+			name:     "funds moved twice",
+			memo:     fmt.Sprintf(`{"marker-actions":[{"deposit":{"marker":"hotdog"}},{"forward":{"to-address":"%s"}}]}`, address1.String()),
+			expFound: true,
+			expErr:   "invalid marker action 1: received funds can only be deposited or forwarded once",
+		},
+		{
+			name:     "invalid forward address",
+			memo:     `{"marker-actions":[{"forward":{"to-address":"invalidbech32"}}]}`,
+			expFound: true,
+			expErr:   "invalid marker action 0: invalid forward to-address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:     "invalid nav price",
+			memo:     `{"marker-actions":[{"set-nav":{"marker":"hotdog","price":"ten","volume":1}}]}`,
+			expFound: true,
+			expErr:   "invalid marker action 0: invalid set-nav price: invalid decimal coin expression: ten",
+		},
+		{
+			name:     "nav without volume",
+			memo:     `{"marker-actions":[{"set-nav":{"marker":"hotdog","price":"10usd"}}]}`,
+			expFound: true,
+			expErr:   "invalid marker action 0: invalid set-nav: marker net asset value volume must be positive value",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.T().Run(tc.name, func(t *testing.T) {
+			found, actions, err := ibchooks.ProcessMarkerActionsMemo(tc.memo)
+			assert.Equal(t, tc.expFound, found, "ProcessMarkerActionsMemo() found")
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "ProcessMarkerActionsMemo() error")
+			} else {
+				require.NoError(t, err, "ProcessMarkerActionsMemo() error")
+				assert.Equal(t, tc.expActions, actions, "ProcessMarkerActionsMemo() actions")
+			}
+		})
+	}
+}
+
 func (suite *MarkerHooksTestSuite) TestResetMarkerAccessGrants() {
 	address1 := sdk.AccAddress("address1")
 	address2 := sdk.AccAddress("address2")
@@ -335,7 +422,7 @@ func (suite *MarkerHooksTestSuite) TestSanitizeMemo() {
 
 func (suite *MarkerHooksTestSuite) TestPreSendPacketDataProcessingFn() {
 	address1 := sdk.AccAddress("address1")
-	markerHooks := ibchooks.NewMarkerHooks(&suite.chainA.GetProvenanceApp().MarkerKeeper)
+	markerHooks := ibchooks.NewMarkerHooks(&suite.chainA.GetProvenanceApp().MarkerKeeper, suite.chainA.GetProvenanceApp().BankKeeper)
 	marker1 := *markertypes.NewEmptyMarkerAccount("jackthecat", address1.String(), []types.AccessGrant{*types.NewAccessGrant(address1, []types.Access{types.Access_Transfer}), *types.NewAccessGrant(address1, []types.Access{types.Access_Admin})})
 	marker1.MarkerType = markertypes.MarkerType_RestrictedCoin
 	require.NoError(suite.T(), suite.chainA.GetProvenanceApp().MarkerKeeper.AddMarkerAccount(suite.chainA.GetContext(), &marker1), "AddMarkerAccount() in test setup")
@@ -19,4 +19,7 @@ var (
 	ErrAckPacketMismatch   = errorsmod.Register("wasm-hooks", 10, "packet does not match the expected packet")
 	ErrInvalidContractAddr = errorsmod.Register("wasm-hooks", 11, "invalid contract address")
 	ErrMarkerError         = errorsmod.Register("marker-hooks", 12, "marker error")
+	ErrContractNotAllowed  = errorsmod.Register("wasm-hooks", 14, "contract not allowed to be executed by ibc hooks")
+	ErrHookGasLimit        = errorsmod.Register("wasm-hooks", 15, "contract exceeded ibc hook gas limit")
+	ErrMarkerActionError   = errorsmod.Register("marker-hooks", 13, "marker action error")
 )
@@ -7,12 +7,20 @@ const (
 	IBCCallbackKey = "ibc_callback"
 	IBCAsyncAckKey = "ibc_async_ack"
 
+	MarkerActionsKey = "marker-actions"
+
 	MsgEmitAckKey           = "emit_ack"
 	AttributeSender         = "sender"
 	AttributeChannel        = "channel"
 	AttributePacketSequence = "sequence"
 
 	SenderPrefix = "ibc-wasm-hook-intermediary"
+
+	TelemetryKeyHookRejected    = "hook_rejected"
+	TelemetryLabelContract      = "contract"
+	TelemetryLabelReason        = "reason"
+	TelemetryReasonNotAllowed   = "not_allowed"
+	TelemetryReasonGasExhausted = "gas_limit"
 )
 
 var (
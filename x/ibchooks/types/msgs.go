@@ -35,6 +35,10 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 		}
 	}
 
+	if err := msg.Params.ValidateHookPolicy(); err != nil {
+		return err
+	}
+
 	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
 		return fmt.Errorf("invalid authority address: %q: %w", msg.Authority, err)
 	}
@@ -28,6 +28,7 @@ func TestNewMsgUpdateParamsRequest(t *testing.T) {
 	tests := []struct {
 		name      string
 		contracts []string
+		gasLimits []types.HookGasLimit
 		authority string
 		expErr    string
 	}{
@@ -42,6 +43,13 @@ func TestNewMsgUpdateParamsRequest(t *testing.T) {
 			authority: authority,
 			expErr:    `invalid contract address: "invalid_contract": decoding bech32 failed: invalid separator index -1`,
 		},
+		{
+			name:      "invalid hook policy",
+			contracts: []string{validContract},
+			gasLimits: []types.HookGasLimit{{Contract: validContract}},
+			authority: authority,
+			expErr:    `hook gas limit for "` + validContract + `" must be greater than zero`,
+		},
 		{
 			name:      "invalid authority address",
 			contracts: []string{validContract, validContract},
@@ -53,6 +61,7 @@ func TestNewMsgUpdateParamsRequest(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.NewMsgUpdateParamsRequest(tc.contracts, tc.authority)
+			msg.Params.HookGasLimits = tc.gasLimits
 			err := msg.ValidateBasic()
 			if tc.expErr != "" {
 				require.EqualError(t, err, tc.expErr, "MsgUpdateParamsRequest.ValidateBasic expected error message: %s, but got: %s", tc.expErr, err)
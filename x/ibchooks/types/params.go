@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,6 +16,8 @@ func NewParams(allowedAsyncAckContracts []string) Params {
 func DefaultParams() Params {
 	return Params{
 		AllowedAsyncAckContracts: []string{},
+		AllowedHookContracts:     []string{},
+		HookGasLimits:            []HookGasLimit{},
 	}
 }
 
@@ -24,5 +28,58 @@ func (p Params) Validate() error {
 			return err
 		}
 	}
+	return p.ValidateHookPolicy()
+}
+
+// ValidateHookPolicy returns an error if the allowed hook contracts or the hook gas limits are invalid.
+func (p Params) ValidateHookPolicy() error {
+	seen := make(map[string]bool)
+	for _, contract := range p.AllowedHookContracts {
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return fmt.Errorf("invalid allowed hook contract address: %q: %w", contract, err)
+		}
+		if seen[contract] {
+			return fmt.Errorf("duplicate allowed hook contract: %q", contract)
+		}
+		seen[contract] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, limit := range p.HookGasLimits {
+		if _, err := sdk.AccAddressFromBech32(limit.Contract); err != nil {
+			return fmt.Errorf("invalid hook gas limit contract address: %q: %w", limit.Contract, err)
+		}
+		if seen[limit.Contract] {
+			return fmt.Errorf("duplicate hook gas limit contract: %q", limit.Contract)
+		}
+		seen[limit.Contract] = true
+		if limit.GasLimit == 0 {
+			return fmt.Errorf("hook gas limit for %q must be greater than zero", limit.Contract)
+		}
+	}
 	return nil
 }
+
+// IsHookContractAllowed returns true if the contract can be executed by an ibc memo.
+// All contracts are allowed when there are no allowed hook contracts.
+func (p Params) IsHookContractAllowed(contract string) bool {
+	if len(p.AllowedHookContracts) == 0 {
+		return true
+	}
+	for _, addr := range p.AllowedHookContracts {
+		if addr == contract {
+			return true
+		}
+	}
+	return false
+}
+
+// GetHookGasLimit returns the gas limit for a contract executed by an ibc memo, or 0 if it doesn't have one.
+func (p Params) GetHookGasLimit(contract string) uint64 {
+	for _, limit := range p.HookGasLimits {
+		if limit.Contract == contract {
+			return limit.GasLimit
+		}
+	}
+	return 0
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,9 +23,14 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Params defines the allowed async ack contracts
+// Params defines the allowed async ack contracts and the controls on contracts executed by ibc memos
 type Params struct {
 	AllowedAsyncAckContracts []string `protobuf:"bytes,1,rep,name=allowed_async_ack_contracts,json=allowedAsyncAckContracts,proto3" json:"allowed_async_ack_contracts,omitempty"`
+	// allowed_hook_contracts are the only contracts that can be executed by an ibc memo.
+	// When empty, any contract can be executed.
+	AllowedHookContracts []string `protobuf:"bytes,2,rep,name=allowed_hook_contracts,json=allowedHookContracts,proto3" json:"allowed_hook_contracts,omitempty"`
+	// hook_gas_limits are the gas ceilings for contracts executed by an ibc memo.
+	HookGasLimits []HookGasLimit `protobuf:"bytes,3,rep,name=hook_gas_limits,json=hookGasLimits,proto3" json:"hook_gas_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,8 +73,76 @@ func (m *Params) GetAllowedAsyncAckContracts() []string {
 	return nil
 }
 
+func (m *Params) GetAllowedHookContracts() []string {
+	if m != nil {
+		return m.AllowedHookContracts
+	}
+	return nil
+}
+
+func (m *Params) GetHookGasLimits() []HookGasLimit {
+	if m != nil {
+		return m.HookGasLimits
+	}
+	return nil
+}
+
+// HookGasLimit defines the maximum gas a contract can use when executed by an ibc memo
+type HookGasLimit struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *HookGasLimit) Reset()         { *m = HookGasLimit{} }
+func (m *HookGasLimit) String() string { return proto.CompactTextString(m) }
+func (*HookGasLimit) ProtoMessage()    {}
+func (*HookGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61d9bd623dd1e2fd, []int{1}
+}
+func (m *HookGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookGasLimit.Merge(m, src)
+}
+func (m *HookGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookGasLimit proto.InternalMessageInfo
+
+func (m *HookGasLimit) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *HookGasLimit) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.ibchooks.v1.Params")
+	proto.RegisterType((*HookGasLimit)(nil), "provenance.ibchooks.v1.HookGasLimit")
 }
 
 func init() {
@@ -76,20 +150,27 @@ func init() {
 }
 
 var fileDescriptor_61d9bd623dd1e2fd = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0xce, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6,
-	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x43, 0x28, 0xd2, 0x83, 0x29, 0xd2, 0x2b, 0x33, 0x54, 0x72, 0xe7, 0x62, 0x0b, 0x00, 0xab,
-	0x13, 0xb2, 0xe5, 0x92, 0x4e, 0xcc, 0xc9, 0xc9, 0x2f, 0x4f, 0x4d, 0x89, 0x4f, 0x2c, 0xae, 0xcc,
-	0x4b, 0x8e, 0x4f, 0x4c, 0xce, 0x8e, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96,
-	0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x92, 0x80, 0x2a, 0x71, 0x04, 0xa9, 0x70, 0x4c, 0xce, 0x76,
-	0x86, 0xc9, 0x3b, 0x65, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x64,
-	0x66, 0xbe, 0x1e, 0x76, 0xdb, 0x03, 0x18, 0xa3, 0x4c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
-	0x92, 0xf3, 0x73, 0xf5, 0x11, 0x8a, 0x74, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15, 0x08, 0x7f, 0x95,
-	0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x3d, 0x65, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x2b,
-	0x3f, 0xf1, 0x7a, 0xfb, 0x00, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xc2, 0x40,
+	0x14, 0xc7, 0x7b, 0x40, 0x08, 0x9c, 0x1a, 0x93, 0x86, 0x90, 0x0a, 0xc9, 0xd9, 0xa0, 0x43, 0x17,
+	0xef, 0x82, 0xb2, 0x3a, 0xa0, 0x03, 0x0e, 0x0e, 0xa4, 0xa3, 0x4b, 0x73, 0x9c, 0x4d, 0xb9, 0x14,
+	0xfa, 0x9a, 0xde, 0x89, 0xf2, 0x2d, 0xfc, 0x58, 0x8c, 0xb8, 0x39, 0x19, 0x43, 0xbf, 0x88, 0x69,
+	0xa5, 0xb4, 0x83, 0x6e, 0xf7, 0xee, 0xfd, 0x7e, 0xf7, 0xde, 0xe5, 0x8f, 0x2f, 0xe2, 0x04, 0x56,
+	0x7e, 0xc4, 0x23, 0xe1, 0x33, 0x39, 0x13, 0x73, 0x80, 0x50, 0xb1, 0xd5, 0x90, 0xc5, 0x3c, 0xe1,
+	0x4b, 0x45, 0xe3, 0x04, 0x34, 0x98, 0xdd, 0x12, 0xa2, 0x05, 0x44, 0x57, 0xc3, 0x5e, 0x27, 0x80,
+	0x00, 0x72, 0x84, 0x65, 0xa7, 0x5f, 0x7a, 0xf0, 0x81, 0x70, 0x73, 0x9a, 0xeb, 0xe6, 0x2d, 0xee,
+	0xf3, 0xc5, 0x02, 0x5e, 0xfd, 0x67, 0x8f, 0xab, 0x75, 0x24, 0x3c, 0x2e, 0x42, 0x4f, 0x40, 0xa4,
+	0x13, 0x2e, 0xb4, 0xb2, 0x90, 0x5d, 0x77, 0xda, 0xae, 0xb5, 0x47, 0xc6, 0x19, 0x31, 0x16, 0xe1,
+	0x7d, 0xd1, 0x37, 0x47, 0xb8, 0x5b, 0xe8, 0xd9, 0xcc, 0x8a, 0x59, 0xcb, 0xcd, 0xce, 0xbe, 0xfb,
+	0x00, 0x50, 0xb1, 0x5c, 0x7c, 0x9a, 0xd3, 0x01, 0x57, 0xde, 0x42, 0x2e, 0xa5, 0x56, 0x56, 0xdd,
+	0xae, 0x3b, 0x47, 0xd7, 0x97, 0xf4, 0xef, 0x7f, 0xd0, 0xcc, 0x9f, 0x70, 0xf5, 0x98, 0xc1, 0x77,
+	0x8d, 0xcd, 0xd7, 0xb9, 0xe1, 0x9e, 0xcc, 0x2b, 0x77, 0x6a, 0x30, 0xc1, 0xc7, 0x55, 0xc8, 0xec,
+	0xe1, 0x56, 0xb1, 0x8c, 0x85, 0x6c, 0xe4, 0xb4, 0xdd, 0x43, 0x6d, 0xf6, 0x71, 0xfb, 0x30, 0xda,
+	0xaa, 0xd9, 0xc8, 0x69, 0xb8, 0xad, 0xa0, 0x78, 0x3d, 0xdc, 0xec, 0x08, 0xda, 0xee, 0x08, 0xfa,
+	0xde, 0x11, 0xf4, 0x9e, 0x12, 0x63, 0x9b, 0x12, 0xe3, 0x33, 0x25, 0x06, 0x3e, 0x93, 0xf0, 0xcf,
+	0x7e, 0x53, 0xf4, 0x34, 0x0a, 0xa4, 0x9e, 0xbf, 0xcc, 0xa8, 0x80, 0x25, 0x2b, 0xa1, 0x2b, 0x09,
+	0x95, 0x8a, 0xbd, 0x95, 0x09, 0xea, 0x75, 0xec, 0xab, 0x59, 0x33, 0x0f, 0xe4, 0xe6, 0x27, 0x00,
+	0x00, 0xff, 0xff, 0x80, 0xa6, 0x46, 0xe8, 0xe5, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +193,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HookGasLimits) > 0 {
+		for iNdEx := len(m.HookGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HookGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedHookContracts) > 0 {
+		for iNdEx := len(m.AllowedHookContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedHookContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedHookContracts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedHookContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AllowedAsyncAckContracts) > 0 {
 		for iNdEx := len(m.AllowedAsyncAckContracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAsyncAckContracts[iNdEx])
@@ -124,6 +228,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HookGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -147,6 +286,34 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.AllowedHookContracts) > 0 {
+		for _, s := range m.AllowedHookContracts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.HookGasLimits) > 0 {
+		for _, e := range m.HookGasLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HookGasLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovParams(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -217,6 +384,173 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAsyncAckContracts = append(m.AllowedAsyncAckContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedHookContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedHookContracts = append(m.AllowedHookContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookGasLimits = append(m.HookGasLimits, HookGasLimit{})
+			if err := m.HookGasLimits[len(m.HookGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HookGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibchooks/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	contract1 := sdk.AccAddress("contract1___________").String()
+	contract2 := sdk.AccAddress("contract2___________").String()
+
+	tests := []struct {
+		name   string
+		params types.Params
+		expErr string
+	}{
+		{
+			name:   "default params",
+			params: types.DefaultParams(),
+		},
+		{
+			name: "valid hook policy",
+			params: types.Params{
+				AllowedHookContracts: []string{contract1, contract2},
+				HookGasLimits:        []types.HookGasLimit{{Contract: contract1, GasLimit: 100}},
+			},
+		},
+		{
+			name:   "invalid allowed hook contract",
+			params: types.Params{AllowedHookContracts: []string{"invalid_contract"}},
+			expErr: `invalid allowed hook contract address: "invalid_contract": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:   "duplicate allowed hook contract",
+			params: types.Params{AllowedHookContracts: []string{contract1, contract1}},
+			expErr: `duplicate allowed hook contract: "` + contract1 + `"`,
+		},
+		{
+			name:   "invalid hook gas limit contract",
+			params: types.Params{HookGasLimits: []types.HookGasLimit{{Contract: "invalid_contract", GasLimit: 100}}},
+			expErr: `invalid hook gas limit contract address: "invalid_contract": decoding bech32 failed: invalid separator index -1`,
+		},
+		{
+			name:   "duplicate hook gas limit contract",
+			params: types.Params{HookGasLimits: []types.HookGasLimit{{Contract: contract1, GasLimit: 100}, {Contract: contract1, GasLimit: 200}}},
+			expErr: `duplicate hook gas limit contract: "` + contract1 + `"`,
+		},
+		{
+			name:   "zero hook gas limit",
+			params: types.Params{HookGasLimits: []types.HookGasLimit{{Contract: contract1}}},
+			expErr: `hook gas limit for "` + contract1 + `" must be greater than zero`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestParamsHookPolicy(t *testing.T) {
+	contract1 := sdk.AccAddress("contract1___________").String()
+	contract2 := sdk.AccAddress("contract2___________").String()
+
+	params := types.DefaultParams()
+	assert.True(t, params.IsHookContractAllowed(contract1), "IsHookContractAllowed with empty allow list")
+	assert.Equal(t, uint64(0), params.GetHookGasLimit(contract1), "GetHookGasLimit without gas limits")
+
+	params.AllowedHookContracts = []string{contract1}
+	params.HookGasLimits = []types.HookGasLimit{{Contract: contract2, GasLimit: 100}}
+	assert.True(t, params.IsHookContractAllowed(contract1), "IsHookContractAllowed(contract1)")
+	assert.False(t, params.IsHookContractAllowed(contract2), "IsHookContractAllowed(contract2)")
+	assert.Equal(t, uint64(0), params.GetHookGasLimit(contract1), "GetHookGasLimit(contract1)")
+	assert.Equal(t, uint64(100), params.GetHookGasLimit(contract2), "GetHookGasLimit(contract2)")
+}
@@ -2,9 +2,13 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // Async: The following types represent the response sent by a contract on OnRecvPacket when it wants the ack to be async
@@ -139,6 +143,105 @@ func NewMarkerPayload(transferAuthAddrs []sdk.AccAddress, allowForceTransfer boo
 	}
 }
 
+// MarkerActionsMemo parent marker actions struct for memo json
+type MarkerActionsMemo struct {
+	MarkerActions []MarkerAction `json:"marker-actions"`
+}
+
+// MarkerAction is a single marker action to run after an ics20 packet is received. Exactly one field should be set.
+type MarkerAction struct {
+	Deposit *DepositAction `json:"deposit,omitempty"`
+	Forward *ForwardAction `json:"forward,omitempty"`
+	SetNav  *SetNavAction  `json:"set-nav,omitempty"`
+}
+
+// DepositAction deposits the received funds into the account of a marker
+type DepositAction struct {
+	Marker string `json:"marker"`
+}
+
+// ForwardAction sends the received funds to another account, subject to the marker's required attributes
+type ForwardAction struct {
+	ToAddress string `json:"to-address"`
+}
+
+// SetNavAction sets a net asset value on a marker
+type SetNavAction struct {
+	Marker string `json:"marker"`
+	Price  string `json:"price"`
+	Volume uint64 `json:"volume"`
+}
+
+// Validate returns an error if the marker actions are not valid.
+// At most one action is allowed to move the received funds.
+func (m MarkerActionsMemo) Validate() error {
+	if len(m.MarkerActions) == 0 {
+		return errors.New("marker actions cannot be empty")
+	}
+	fundsMoved := false
+	for i, action := range m.MarkerActions {
+		if err := action.Validate(); err != nil {
+			return fmt.Errorf("invalid marker action %d: %w", i, err)
+		}
+		if action.Deposit != nil || action.Forward != nil {
+			if fundsMoved {
+				return fmt.Errorf("invalid marker action %d: received funds can only be deposited or forwarded once", i)
+			}
+			fundsMoved = true
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the marker action does not have exactly one valid action set.
+func (a MarkerAction) Validate() error {
+	count := 0
+	if a.Deposit != nil {
+		count++
+	}
+	if a.Forward != nil {
+		count++
+	}
+	if a.SetNav != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("exactly one of deposit, forward, or set-nav must be set, found %d", count)
+	}
+
+	switch {
+	case a.Deposit != nil:
+		if err := sdk.ValidateDenom(a.Deposit.Marker); err != nil {
+			return fmt.Errorf("invalid deposit marker: %w", err)
+		}
+	case a.Forward != nil:
+		if _, err := sdk.AccAddressFromBech32(a.Forward.ToAddress); err != nil {
+			return fmt.Errorf("invalid forward to-address: %w", err)
+		}
+	case a.SetNav != nil:
+		if _, err := a.SetNav.GetNetAssetValue(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetNetAssetValue returns the net asset value described by the set nav action.
+func (a SetNavAction) GetNetAssetValue() (markertypes.NetAssetValue, error) {
+	if err := sdk.ValidateDenom(a.Marker); err != nil {
+		return markertypes.NetAssetValue{}, fmt.Errorf("invalid set-nav marker: %w", err)
+	}
+	price, err := sdk.ParseCoinNormalized(a.Price)
+	if err != nil {
+		return markertypes.NetAssetValue{}, fmt.Errorf("invalid set-nav price: %w", err)
+	}
+	nav := markertypes.NewNetAssetValue(price, a.Volume)
+	if err = nav.Validate(); err != nil {
+		return markertypes.NetAssetValue{}, fmt.Errorf("invalid set-nav: %w", err)
+	}
+	return nav, nil
+}
+
 // NewIbcLifecycleCompleteAck returns a new ibc lifecycle complete acknowledgment object for json serialization
 func NewIbcLifecycleCompleteAck(sourceChannel string, sequence uint64, ackAsJSON []byte, success bool) IbcLifecycleComplete {
 	ibcLifecycleCompleteAck := IbcLifecycleCompleteAck{
@@ -6,10 +6,13 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/hashicorp/go-metrics"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -59,6 +62,10 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context,
 	if msgBytes == nil || contractAddr == nil { // This should never happen
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
 	}
+	if !h.ibcHooksKeeper.IsHookContractAllowed(ctx, contractAddr.String()) {
+		emitHookRejectedMetric(contractAddr.String(), types.TelemetryReasonNotAllowed)
+		return NewEmitErrorAcknowledgement(ctx, types.ErrContractNotAllowed, contractAddr.String())
+	}
 
 	// Calculate the receiver / contract caller based on the packet's channel and sender
 	channel := packet.GetDestChannel()
@@ -105,7 +112,7 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdktypes.Context,
 		Msg:      msgBytes,
 		Funds:    funds,
 	}
-	response, err := h.execWasmMsg(ctx, &execMsg)
+	response, err := h.execWasmMsgWithGasLimit(ctx, &execMsg, h.ibcHooksKeeper.GetHookGasLimit(ctx, execMsg.Contract))
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
 	}
@@ -144,6 +151,43 @@ func (h WasmHooks) execWasmMsg(ctx sdktypes.Context, execMsg *wasmtypes.MsgExecu
 	return wasmMsgServer.ExecuteContract(ctx, execMsg)
 }
 
+// execWasmMsgWithGasLimit executes the wasm msg with its own gas meter when the contract has a gas limit.
+// The gas used is then consumed from the context's gas meter.
+func (h WasmHooks) execWasmMsgWithGasLimit(ctx sdktypes.Context, execMsg *wasmtypes.MsgExecuteContract, gasLimit uint64) (response *wasmtypes.MsgExecuteContractResponse, err error) {
+	if gasLimit == 0 {
+		return h.execWasmMsg(ctx, execMsg)
+	}
+
+	limitedCtx := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	defer func() {
+		r := recover()
+		if r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+		}
+		ctx.GasMeter().ConsumeGas(limitedCtx.GasMeter().GasConsumedToLimit(), "ibc hook contract execution")
+		if r != nil || limitedCtx.GasMeter().IsOutOfGas() {
+			emitHookRejectedMetric(execMsg.Contract, types.TelemetryReasonGasExhausted)
+			response = nil
+			err = types.ErrHookGasLimit.Wrapf("contract %s exceeded gas limit %d", execMsg.Contract, gasLimit)
+		}
+	}()
+	return h.execWasmMsg(limitedCtx, execMsg)
+}
+
+// emitHookRejectedMetric records that an ibc memo was not allowed to execute a contract.
+func emitHookRejectedMetric(contract, reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.TelemetryKeyHookRejected},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.TelemetryLabelContract, contract),
+			telemetry.NewLabel(types.TelemetryLabelReason, reason),
+		},
+	)
+}
+
 func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
 	var packetdata transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(data, &packetdata); err != nil {
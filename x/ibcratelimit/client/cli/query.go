@@ -26,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetParamsCmd(),
+		GetQuotasCmd(),
+		GetQuotaUsageCmd(),
+		GetPausesCmd(),
 	)
 
 	return queryCmd
@@ -58,3 +61,96 @@ func GetParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetQuotasCmd returns the command handler for querying all the quotas.
+func GetQuotasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quotas",
+		Short:   "Query all of the configured quotas",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ratelimitedibc quotas`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.Quotas(context.Background(), &ibcratelimit.QuotasRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQuotaUsageCmd returns the command handler for querying a quota and its current usage.
+func GetQuotaUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quota-usage <channel-id> <denom> {send|recv}",
+		Short:   "Query a quota and the amount used during its current window",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf(`$ %s query ratelimitedibc quota-usage channel-0 nhash send`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction, err := ibcratelimit.ParseFlowDirection(args[2])
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.QuotaUsage(context.Background(), &ibcratelimit.QuotaUsageRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+				Direction: direction,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetPausesCmd returns the command handler for querying the active pauses.
+func GetPausesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pauses",
+		Short:   "Query the active pauses",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ratelimitedibc pauses`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.Pauses(context.Background(), &ibcratelimit.PausesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
@@ -14,6 +16,16 @@ import (
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 )
 
+const (
+	FlagChannel       = "channel"
+	FlagDenom         = "denom"
+	FlagGuardians     = "guardians"
+	FlagPauseDuration = "pause-duration"
+	FlagExempt        = "exempt-addresses"
+	FlagPriority      = "priority-addresses"
+	FlagPriorityPct   = "priority-percent"
+)
+
 // NewTxCmd is the top-level command for oracle CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -27,6 +39,11 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		GetCmdParamsUpdate(),
+		GetCmdSetQuota(),
+		GetCmdRemoveQuota(),
+		GetCmdPause(),
+		GetCmdRatifyPause(),
+		GetCmdUnpause(),
 	)
 
 	return txCmd
@@ -40,7 +57,10 @@ func GetCmdParamsUpdate() *cobra.Command {
 		Long:    "Submit an update params via governance proposal along with an initial deposit.",
 		Args:    cobra.ExactArgs(1),
 		Aliases: []string{"u"},
-		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc update-params pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --deposit 50000nhash`, version.AppName),
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc update-params pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --deposit 50000nhash
+%[1]s tx ratelimitedibc update-params "" --%[2]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[3]s 12h --deposit 50000nhash
+%[1]s tx ratelimitedibc update-params "" --%[4]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --%[5]s pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --deposit 50000nhash`,
+			version.AppName, FlagGuardians, FlagPauseDuration, FlagExempt, FlagPriority),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -50,6 +70,107 @@ func GetCmdParamsUpdate() *cobra.Command {
 			flagSet := cmd.Flags()
 			authority := provcli.GetAuthority(flagSet)
 			msg := ibcratelimit.NewMsgUpdateParamsRequest(authority, args[0])
+			msg.Params.Guardians, err = flagSet.GetStringSlice(FlagGuardians)
+			if err != nil {
+				return err
+			}
+			msg.Params.PauseDuration, err = flagSet.GetDuration(FlagPauseDuration)
+			if err != nil {
+				return err
+			}
+			msg.Params.ExemptAddresses, err = flagSet.GetStringSlice(FlagExempt)
+			if err != nil {
+				return err
+			}
+			msg.Params.PriorityAddresses, err = flagSet.GetStringSlice(FlagPriority)
+			if err != nil {
+				return err
+			}
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagGuardians, nil, "The accounts that can immediately pause transfers")
+	cmd.Flags().Duration(FlagPauseDuration, 0, fmt.Sprintf("How long a guardian's pause lasts unless ratified (default %s)", ibcratelimit.DefaultPauseDuration))
+	cmd.Flags().StringSlice(FlagExempt, nil, "The accounts whose transfers bypass the quotas and the rate limiter contract")
+	cmd.Flags().StringSlice(FlagPriority, nil, "The accounts that can use the priority portion of the quotas")
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSetQuota is a command to add or replace a (channel, denom, direction) quota.
+func GetCmdSetQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-quota <channel-id> <denom> {send|recv} <window> <max-percent>",
+		Short:   "Add or replace a quota",
+		Long:    "Submit a set quota via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(5),
+		Aliases: []string{"sq"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc set-quota channel-0 nhash send 24h 5 --deposit 50000nhash
+%[1]s tx ratelimitedibc set-quota channel-0 nhash send 24h 5 --%[2]s 1.5 --deposit 50000nhash`, version.AppName, FlagPriorityPct),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction, err := ibcratelimit.ParseFlowDirection(args[2])
+			if err != nil {
+				return err
+			}
+			window, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid window %q: %w", args[3], err)
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			quota := ibcratelimit.NewQuota(args[0], args[1], direction, window, args[4])
+			quota.PriorityPercent, err = flagSet.GetString(FlagPriorityPct)
+			if err != nil {
+				return err
+			}
+			msg := ibcratelimit.NewMsgSetQuotaRequest(authority, quota)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPriorityPct, "", "The portion of the max percent reserved for the priority addresses")
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveQuota is a command to remove a (channel, denom, direction) quota.
+func GetCmdRemoveQuota() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-quota <channel-id> <denom> {send|recv}",
+		Short:   "Remove a quota",
+		Long:    "Submit a remove quota via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(3),
+		Aliases: []string{"rq"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc remove-quota channel-0 nhash send --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction, err := ibcratelimit.ParseFlowDirection(args[2])
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgRemoveQuotaRequest(authority, args[0], args[1], direction)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
@@ -60,3 +181,123 @@ func GetCmdParamsUpdate() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPause is a command for a guardian to immediately pause transfers on a channel or for a denom.
+func GetCmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause {--channel <channel-id>|--denom <denom>}",
+		Short: "Immediately pause transfers on a channel or for a denom",
+		Long: `Immediately pause transfers on a channel, for a denom, or for a denom on a channel.
+The sender must be one of the guardians in the module's params.
+The pause is lifted automatically after the params' pause duration unless it's ratified by governance.`,
+		Args: cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc pause --%[2]s channel-0 --from guardian
+%[1]s tx ratelimitedibc pause --%[3]s nhash --from guardian`, version.AppName, FlagChannel, FlagDenom),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelID, denom, err := getPauseID(cmd)
+			if err != nil {
+				return err
+			}
+			msg := ibcratelimit.NewMsgPauseRequest(clientCtx.GetFromAddress().String(), channelID, denom)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addPauseIDFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRatifyPause is a command to ratify a guardian's pause so that it doesn't expire.
+func GetCmdRatifyPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ratify-pause {--channel <channel-id>|--denom <denom>}",
+		Short:   "Ratify a pause so it lasts until lifted",
+		Long:    "Submit a ratify pause via governance proposal along with an initial deposit.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc ratify-pause --%[2]s channel-0 --deposit 50000nhash`, version.AppName, FlagChannel),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelID, denom, err := getPauseID(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgRatifyPauseRequest(authority, channelID, denom)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	addPauseIDFlags(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUnpause is a command to lift a pause.
+func GetCmdUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause {--channel <channel-id>|--denom <denom>}",
+		Short:   "Lift a pause",
+		Long:    "Submit an unpause via governance proposal along with an initial deposit.",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc unpause --%[2]s channel-0 --deposit 50000nhash`, version.AppName, FlagChannel),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelID, denom, err := getPauseID(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgUnpauseRequest(authority, channelID, denom)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	addPauseIDFlags(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addPauseIDFlags adds the flags that identify a pause.
+func addPauseIDFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagChannel, "", "The channel on this chain")
+	cmd.Flags().String(FlagDenom, "", "The denom as known on this chain")
+}
+
+// getPauseID reads the flags that identify a pause.
+func getPauseID(cmd *cobra.Command) (channelID, denom string, err error) {
+	channelID, err = cmd.Flags().GetString(FlagChannel)
+	if err != nil {
+		return "", "", err
+	}
+	denom, err = cmd.Flags().GetString(FlagDenom)
+	if err != nil {
+		return "", "", err
+	}
+	if len(channelID) == 0 && len(denom) == 0 {
+		return "", "", fmt.Errorf("at least one of --%s or --%s is required", FlagChannel, FlagDenom)
+	}
+	return channelID, denom, nil
+}
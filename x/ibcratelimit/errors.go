@@ -8,4 +8,9 @@ var (
 	ErrRateLimitExceeded = cerrs.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = cerrs.Register(ModuleName, 3, "bad message")
 	ErrContractError     = cerrs.Register(ModuleName, 4, "contract error")
+	ErrQuotaNotFound     = cerrs.Register(ModuleName, 5, "quota not found")
+	ErrQuotaExceeded     = cerrs.Register(ModuleName, 6, "quota exceeded")
+	ErrTransfersPaused   = cerrs.Register(ModuleName, 7, "transfers paused")
+	ErrPauseNotFound     = cerrs.Register(ModuleName, 8, "pause not found")
+	ErrNotGuardian       = cerrs.Register(ModuleName, 9, "not a guardian")
 )
@@ -1,5 +1,9 @@
 package ibcratelimit
 
+import (
+	"time"
+)
+
 // NewEventAckRevertFailure returns a new EventAckRevertFailure.
 func NewEventAckRevertFailure(module, packet, ack string) *EventAckRevertFailure {
 	return &EventAckRevertFailure{
@@ -21,3 +25,51 @@ func NewEventTimeoutRevertFailure(module, packet string) *EventTimeoutRevertFail
 func NewEventParamsUpdated() *EventParamsUpdated {
 	return &EventParamsUpdated{}
 }
+
+// NewEventQuotaSet returns a new EventQuotaSet.
+func NewEventQuotaSet(quota Quota) *EventQuotaSet {
+	return &EventQuotaSet{
+		ChannelId: quota.ChannelId,
+		Denom:     quota.Denom,
+		Direction: quota.Direction.String(),
+	}
+}
+
+// NewEventQuotaRemoved returns a new EventQuotaRemoved.
+func NewEventQuotaRemoved(channelID, denom string, direction FlowDirection) *EventQuotaRemoved {
+	return &EventQuotaRemoved{
+		ChannelId: channelID,
+		Denom:     denom,
+		Direction: direction.String(),
+	}
+}
+
+// NewEventTransfersPaused returns a new EventTransfersPaused.
+func NewEventTransfersPaused(pause Pause) *EventTransfersPaused {
+	rv := &EventTransfersPaused{
+		ChannelId: pause.ChannelId,
+		Denom:     pause.Denom,
+		Guardian:  pause.Guardian,
+	}
+	if pause.Expiration != nil {
+		rv.Expiration = pause.Expiration.UTC().Format(time.RFC3339Nano)
+	}
+	return rv
+}
+
+// NewEventPauseRatified returns a new EventPauseRatified.
+func NewEventPauseRatified(channelID, denom string) *EventPauseRatified {
+	return &EventPauseRatified{
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// NewEventTransfersResumed returns a new EventTransfersResumed.
+func NewEventTransfersResumed(channelID, denom string, expired bool) *EventTransfersResumed {
+	return &EventTransfersResumed{
+		ChannelId: channelID,
+		Denom:     denom,
+		Expired:   expired,
+	}
+}
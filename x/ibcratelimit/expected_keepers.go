@@ -1,7 +1,16 @@
 package ibcratelimit
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type PermissionedKeeper interface {
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 }
+
+// BankKeeper defines the bank functionality needed by the ibcratelimit module.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
@@ -1,5 +1,9 @@
 package ibcratelimit
 
+import (
+	"fmt"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,7 +14,50 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	quotas := make(map[string]bool, len(gs.Quotas))
+	for i, quota := range gs.Quotas {
+		if err := quota.Validate(); err != nil {
+			return fmt.Errorf("invalid quota %d: %w", i, err)
+		}
+		key := string(GetQuotaKey(quota.ChannelId, quota.Denom, quota.Direction))
+		if quotas[key] {
+			return fmt.Errorf("invalid quota %d: duplicate quota for %s %s %s", i, quota.ChannelId, quota.Denom, quota.Direction)
+		}
+		quotas[key] = true
+	}
+
+	usages := make(map[string]bool, len(gs.Usages))
+	for i, usage := range gs.Usages {
+		if err := usage.Validate(); err != nil {
+			return fmt.Errorf("invalid usage %d: %w", i, err)
+		}
+		key := string(GetQuotaKey(usage.ChannelId, usage.Denom, usage.Direction))
+		if !quotas[key] {
+			return fmt.Errorf("invalid usage %d: no quota for %s %s %s", i, usage.ChannelId, usage.Denom, usage.Direction)
+		}
+		if usages[key] {
+			return fmt.Errorf("invalid usage %d: duplicate usage for %s %s %s", i, usage.ChannelId, usage.Denom, usage.Direction)
+		}
+		usages[key] = true
+	}
+
+	pauses := make(map[string]bool, len(gs.Pauses))
+	for i, pause := range gs.Pauses {
+		if err := pause.Validate(); err != nil {
+			return fmt.Errorf("invalid pause %d: %w", i, err)
+		}
+		key := string(GetPauseKey(pause.ChannelId, pause.Denom))
+		if pauses[key] {
+			return fmt.Errorf("invalid pause %d: duplicate pause for channel %q denom %q", i, pause.ChannelId, pause.Denom)
+		}
+		pauses[key] = true
+	}
+
+	return nil
 }
 
 // NewGenesisState returns a new instance of GenesisState object
@@ -2,6 +2,7 @@ package ibcratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	"github.com/stretchr/testify/assert"
@@ -72,3 +73,73 @@ func TestNewGenesisState(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidateQuotas(t *testing.T) {
+	send := ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "5")
+	recv := ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, time.Hour, "5")
+	usage := ibcratelimit.NewQuotaUsage(send)
+
+	tests := []struct {
+		name   string
+		quotas []ibcratelimit.Quota
+		usages []ibcratelimit.QuotaUsage
+		err    string
+	}{
+		{
+			name:   "success - quotas and usage",
+			quotas: []ibcratelimit.Quota{send, recv},
+			usages: []ibcratelimit.QuotaUsage{usage},
+		},
+		{
+			name:   "failure - invalid quota",
+			quotas: []ibcratelimit.Quota{send, ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, time.Hour, "0")},
+			err:    "invalid quota 1: invalid max percent \"0\": must be greater than 0 and at most 100",
+		},
+		{
+			name:   "failure - duplicate quota",
+			quotas: []ibcratelimit.Quota{send, send},
+			err:    "invalid quota 1: duplicate quota for channel-0 nhash FLOW_DIRECTION_SEND",
+		},
+		{
+			name:   "failure - usage without quota",
+			quotas: []ibcratelimit.Quota{recv},
+			usages: []ibcratelimit.QuotaUsage{usage},
+			err:    "invalid usage 0: no quota for channel-0 nhash FLOW_DIRECTION_SEND",
+		},
+		{
+			name:   "failure - duplicate usage",
+			quotas: []ibcratelimit.Quota{send},
+			usages: []ibcratelimit.QuotaUsage{usage, usage},
+			err:    "invalid usage 1: duplicate usage for channel-0 nhash FLOW_DIRECTION_SEND",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := ibcratelimit.NewGenesisState(ibcratelimit.DefaultParams())
+			genesis.Quotas = tc.quotas
+			genesis.Usages = tc.usages
+			err := genesis.Validate()
+
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should have the correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
+
+func TestGenesisValidatePauses(t *testing.T) {
+	pause := ibcratelimit.NewPause("channel-0", "", "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd", time.Now())
+
+	genesis := ibcratelimit.NewGenesisState(ibcratelimit.DefaultParams())
+	genesis.Pauses = []ibcratelimit.Pause{pause}
+	assert.NoError(t, genesis.Validate(), "Validate with a pause")
+
+	genesis.Pauses = []ibcratelimit.Pause{pause, pause}
+	assert.EqualError(t, genesis.Validate(), "invalid pause 1: duplicate pause for channel \"channel-0\" denom \"\"", "Validate with a duplicate pause")
+
+	genesis.Pauses = []ibcratelimit.Pause{ibcratelimit.NewPause("", "", pause.Guardian, time.Now())}
+	assert.EqualError(t, genesis.Validate(), "invalid pause 0: a channel id or denom must be provided", "Validate with an invalid pause")
+}
@@ -13,8 +13,24 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *ibcratelimit.GenesisState {
 		panic(err)
 	}
 
+	quotas, err := k.GetAllQuotas(ctx)
+	if err != nil {
+		panic(err)
+	}
+	usages, err := k.GetAllQuotaUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+	pauses, err := k.GetAllPauses(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &ibcratelimit.GenesisState{
 		Params: params,
+		Quotas: quotas,
+		Usages: usages,
+		Pauses: pauses,
 	}
 }
 
@@ -24,4 +40,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *ibcratelimit.GenesisState) {
 		panic(err)
 	}
 	k.SetParams(ctx, data.Params)
+	for _, quota := range data.Quotas {
+		k.SetQuota(ctx, quota)
+	}
+	for _, usage := range data.Usages {
+		k.SetQuotaUsage(ctx, usage)
+	}
+	for _, pause := range data.Pauses {
+		k.SetPause(ctx, pause)
+	}
 }
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
@@ -21,3 +24,53 @@ func (k Keeper) Params(ctx context.Context, _ *ibcratelimit.ParamsRequest) (*ibc
 
 	return &ibcratelimit.ParamsResponse{Params: params}, nil
 }
+
+// Quotas returns all of the configured quotas.
+func (k Keeper) Quotas(ctx context.Context, _ *ibcratelimit.QuotasRequest) (*ibcratelimit.QuotasResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	quotas, err := k.GetAllQuotas(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibcratelimit.QuotasResponse{Quotas: quotas}, nil
+}
+
+// QuotaUsage returns a quota along with the amount currently used and allowed in its window.
+func (k Keeper) QuotaUsage(ctx context.Context, req *ibcratelimit.QuotaUsageRequest) (*ibcratelimit.QuotaUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	quota, err := k.GetQuota(sdkCtx, req.ChannelId, req.Denom, req.Direction)
+	if err != nil {
+		return nil, err
+	}
+	used, limit, publicLimit, err := k.GetQuotaStatus(sdkCtx, quota)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibcratelimit.QuotaUsageResponse{Quota: quota, Used: used, Limit: limit, PublicLimit: publicLimit}, nil
+}
+
+// Pauses returns all of the active pauses.
+func (k Keeper) Pauses(ctx context.Context, _ *ibcratelimit.PausesRequest) (*ibcratelimit.PausesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pauses, err := k.GetAllPauses(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]ibcratelimit.Pause, 0, len(pauses))
+	for _, pause := range pauses {
+		if !pause.IsExpired(sdkCtx.BlockTime()) {
+			active = append(active, pause)
+		}
+	}
+
+	return &ibcratelimit.PausesResponse{Pauses: active}, nil
+}
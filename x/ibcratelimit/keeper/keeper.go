@@ -18,6 +18,7 @@ type Keeper struct {
 	storeKey           storetypes.StoreKey
 	cdc                codec.BinaryCodec
 	PermissionedKeeper ibcratelimit.PermissionedKeeper
+	bankKeeper         ibcratelimit.BankKeeper
 	authority          string
 }
 
@@ -26,11 +27,13 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
 	permissionedKeeper ibcratelimit.PermissionedKeeper,
+	bankKeeper ibcratelimit.BankKeeper,
 ) Keeper {
 	return Keeper{
 		storeKey:           key,
 		cdc:                cdc,
 		PermissionedKeeper: permissionedKeeper,
+		bankKeeper:         bankKeeper,
 		authority:          authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
@@ -41,3 +43,90 @@ func (k MsgServer) UpdateParams(goCtx context.Context, msg *ibcratelimit.MsgUpda
 
 	return &ibcratelimit.MsgUpdateParamsResponse{}, nil
 }
+
+// SetQuota is a governance proposal endpoint for adding or replacing a (channel, denom, direction) quota.
+func (k MsgServer) SetQuota(goCtx context.Context, msg *ibcratelimit.MsgSetQuotaRequest) (*ibcratelimit.MsgSetQuotaResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetQuota(ctx, msg.Quota)
+	k.emitEvent(ctx, ibcratelimit.NewEventQuotaSet(msg.Quota))
+
+	return &ibcratelimit.MsgSetQuotaResponse{}, nil
+}
+
+// RemoveQuota is a governance proposal endpoint for removing a (channel, denom, direction) quota.
+func (k MsgServer) RemoveQuota(goCtx context.Context, msg *ibcratelimit.MsgRemoveQuotaRequest) (*ibcratelimit.MsgRemoveQuotaResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.Keeper.RemoveQuota(ctx, msg.ChannelId, msg.Denom, msg.Direction) {
+		return nil, ibcratelimit.ErrQuotaNotFound.Wrapf("%s %s %s", msg.ChannelId, msg.Denom, msg.Direction)
+	}
+	k.emitEvent(ctx, ibcratelimit.NewEventQuotaRemoved(msg.ChannelId, msg.Denom, msg.Direction))
+
+	return &ibcratelimit.MsgRemoveQuotaResponse{}, nil
+}
+
+// Pause is an endpoint for a guardian to immediately pause transfers on a channel or for a denom.
+func (k MsgServer) Pause(goCtx context.Context, msg *ibcratelimit.MsgPauseRequest) (*ibcratelimit.MsgPauseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.IsGuardian(msg.Guardian) {
+		return nil, ibcratelimit.ErrNotGuardian.Wrap(msg.Guardian)
+	}
+
+	existing, err := k.GetPause(ctx, msg.ChannelId, msg.Denom)
+	if err == nil && !existing.IsExpired(ctx.BlockTime()) {
+		return nil, ibcratelimit.ErrTransfersPaused.Wrapf("channel %q denom %q is already paused", msg.ChannelId, msg.Denom)
+	}
+
+	pause := ibcratelimit.NewPause(msg.ChannelId, msg.Denom, msg.Guardian, ctx.BlockTime().Add(params.GetPauseDurationOrDefault()))
+	k.SetPause(ctx, pause)
+	k.emitEvent(ctx, ibcratelimit.NewEventTransfersPaused(pause))
+
+	return &ibcratelimit.MsgPauseResponse{}, nil
+}
+
+// RatifyPause is a governance proposal endpoint for making a guardian's pause last until it is lifted.
+func (k MsgServer) RatifyPause(goCtx context.Context, msg *ibcratelimit.MsgRatifyPauseRequest) (*ibcratelimit.MsgRatifyPauseResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pause, err := k.GetPause(ctx, msg.ChannelId, msg.Denom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "channel %q denom %q", msg.ChannelId, msg.Denom)
+	}
+	if pause.IsExpired(ctx.BlockTime()) {
+		return nil, ibcratelimit.ErrPauseNotFound.Wrapf("channel %q denom %q pause has expired", msg.ChannelId, msg.Denom)
+	}
+	pause.Expiration = nil
+	k.SetPause(ctx, pause)
+	k.emitEvent(ctx, ibcratelimit.NewEventPauseRatified(msg.ChannelId, msg.Denom))
+
+	return &ibcratelimit.MsgRatifyPauseResponse{}, nil
+}
+
+// Unpause is a governance proposal endpoint for lifting a pause.
+func (k MsgServer) Unpause(goCtx context.Context, msg *ibcratelimit.MsgUnpauseRequest) (*ibcratelimit.MsgUnpauseResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.RemovePause(ctx, msg.ChannelId, msg.Denom) {
+		return nil, ibcratelimit.ErrPauseNotFound.Wrapf("channel %q denom %q", msg.ChannelId, msg.Denom)
+	}
+	k.emitEvent(ctx, ibcratelimit.NewEventTransfersResumed(msg.ChannelId, msg.Denom, false))
+
+	return &ibcratelimit.MsgUnpauseResponse{}, nil
+}
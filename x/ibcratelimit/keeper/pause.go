@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// GetPause Gets a (channel, denom) pause from the store.
+func (k Keeper) GetPause(ctx sdk.Context, channelID, denom string) (pause ibcratelimit.Pause, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ibcratelimit.GetPauseKey(channelID, denom))
+	if len(bz) == 0 {
+		return pause, ibcratelimit.ErrPauseNotFound
+	}
+	err = k.cdc.Unmarshal(bz, &pause)
+	return pause, err
+}
+
+// SetPause Sets a pause in the store.
+func (k Keeper) SetPause(ctx sdk.Context, pause ibcratelimit.Pause) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pause)
+	store.Set(ibcratelimit.GetPauseKey(pause.ChannelId, pause.Denom), bz)
+}
+
+// RemovePause Removes a pause from the store.
+func (k Keeper) RemovePause(ctx sdk.Context, channelID, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := ibcratelimit.GetPauseKey(channelID, denom)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	return true
+}
+
+// GetAllPauses Gets all the pauses within the store.
+func (k Keeper) GetAllPauses(ctx sdk.Context) (pauses []ibcratelimit.Pause, err error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, ibcratelimit.PauseKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pause ibcratelimit.Pause
+		if err = k.cdc.Unmarshal(iterator.Value(), &pause); err != nil {
+			return nil, err
+		}
+		pauses = append(pauses, pause)
+	}
+	return pauses, nil
+}
+
+// IsPaused Checks if transfers of a denom on a channel are paused by an active pause on the channel, the denom, or both.
+func (k Keeper) IsPaused(ctx sdk.Context, channelID, denom string) bool {
+	for _, id := range [][2]string{{channelID, ""}, {"", denom}, {channelID, denom}} {
+		pause, err := k.GetPause(ctx, id[0], id[1])
+		if err == nil && !pause.IsExpired(ctx.BlockTime()) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPaused Returns an error if the transfer packet's channel or denom has been paused.
+func (k Keeper) CheckPaused(ctx sdk.Context, direction ibcratelimit.FlowDirection, packet exported.PacketI) error {
+	channelID, denom, _, err := ibcratelimit.GetPacketFlow(packet, direction)
+	if err != nil {
+		return errorsmod.Wrap(ibcratelimit.ErrBadMessage, err.Error())
+	}
+	if k.IsPaused(ctx, channelID, denom) {
+		return errorsmod.Wrapf(ibcratelimit.ErrTransfersPaused, "channel %q denom %q", channelID, denom)
+	}
+	return nil
+}
+
+// RemoveExpiredPauses Removes all the pauses that have expired without being ratified.
+func (k Keeper) RemoveExpiredPauses(ctx sdk.Context) {
+	pauses, err := k.GetAllPauses(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("unable to read pauses", "err", err)
+		return
+	}
+	for _, pause := range pauses {
+		if pause.IsExpired(ctx.BlockTime()) {
+			k.RemovePause(ctx, pause.ChannelId, pause.Denom)
+			k.emitEvent(ctx, ibcratelimit.NewEventTransfersResumed(pause.ChannelId, pause.Denom, true))
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func (s *TestSuite) TestPauseLifecycle() {
+	k := s.app.RateLimitingKeeper
+	authority := k.GetAuthority()
+	guardian := sdk.AccAddress("guardian____________").String()
+	params := ibcratelimit.NewParams("")
+	params.Guardians = []string{guardian}
+	params.PauseDuration = time.Hour
+	k.SetParams(s.ctx, params)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+	packet := NewMockPacket(NewMockSerializedPacketData(), true)
+	send := ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND
+
+	_, err := s.msgServer.Pause(s.ctx, ibcratelimit.NewMsgPauseRequest(authority, "src-channel", ""))
+	s.Assert().ErrorIs(err, ibcratelimit.ErrNotGuardian, "Pause by a non-guardian")
+
+	_, err = s.msgServer.Pause(s.ctx, ibcratelimit.NewMsgPauseRequest(guardian, "src-channel", ""))
+	s.Require().NoError(err, "Pause")
+	expected := ibcratelimit.NewPause("src-channel", "", guardian, start.Add(time.Hour))
+	events := s.ctx.EventManager().Events()
+	s.Require().Len(events, 1, "Pause events")
+	s.Assert().Equal(*typedEventToEvent(ibcratelimit.NewEventTransfersPaused(expected)), events[0], "Pause event")
+	_, err = s.msgServer.Pause(s.ctx, ibcratelimit.NewMsgPauseRequest(guardian, "src-channel", ""))
+	s.Assert().ErrorIs(err, ibcratelimit.ErrTransfersPaused, "Pause again")
+
+	s.Assert().ErrorIs(k.CheckPaused(s.ctx, send, packet), ibcratelimit.ErrTransfersPaused, "CheckPaused on the paused channel")
+	s.Assert().NoError(k.CheckPaused(s.ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, packet), "CheckPaused on another channel")
+
+	res, err := s.queryClient.Pauses(s.ctx, &ibcratelimit.PausesRequest{})
+	s.Require().NoError(err, "Pauses")
+	s.Require().Len(res.Pauses, 1, "Pauses")
+	s.Assert().Equal(expected.ChannelId, res.Pauses[0].ChannelId, "Pauses channel")
+	s.Assert().True(expected.Expiration.Equal(*res.Pauses[0].Expiration), "Pauses expiration")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(time.Hour))
+	s.Assert().NoError(k.CheckPaused(s.ctx, send, packet), "CheckPaused after expiration")
+	_, err = s.msgServer.RatifyPause(s.ctx, ibcratelimit.NewMsgRatifyPauseRequest(authority, "src-channel", ""))
+	s.Assert().ErrorIs(err, ibcratelimit.ErrPauseNotFound, "RatifyPause after expiration")
+	k.RemoveExpiredPauses(s.ctx)
+	_, err = k.GetPause(s.ctx, "src-channel", "")
+	s.Assert().ErrorIs(err, ibcratelimit.ErrPauseNotFound, "GetPause after RemoveExpiredPauses")
+
+	_, err = s.msgServer.Pause(s.ctx, ibcratelimit.NewMsgPauseRequest(guardian, "", "denom"))
+	s.Require().NoError(err, "Pause denom")
+	_, err = s.msgServer.RatifyPause(s.ctx, ibcratelimit.NewMsgRatifyPauseRequest(guardian, "", "denom"))
+	s.Assert().ErrorContains(err, "expected gov account as only signer for proposal message", "RatifyPause by guardian")
+	_, err = s.msgServer.RatifyPause(s.ctx, ibcratelimit.NewMsgRatifyPauseRequest(authority, "", "denom"))
+	s.Require().NoError(err, "RatifyPause")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(48 * time.Hour))
+	k.RemoveExpiredPauses(s.ctx)
+	s.Assert().ErrorIs(k.CheckPaused(s.ctx, send, packet), ibcratelimit.ErrTransfersPaused, "CheckPaused on a ratified denom pause")
+	s.Assert().Len(k.ExportGenesis(s.ctx).Pauses, 1, "ExportGenesis pauses")
+
+	_, err = s.msgServer.Unpause(s.ctx, ibcratelimit.NewMsgUnpauseRequest(authority, "", "denom"))
+	s.Require().NoError(err, "Unpause")
+	s.Assert().NoError(k.CheckPaused(s.ctx, send, packet), "CheckPaused after Unpause")
+	_, err = s.msgServer.Unpause(s.ctx, ibcratelimit.NewMsgUnpauseRequest(authority, "", "denom"))
+	s.Assert().ErrorIs(err, ibcratelimit.ErrPauseNotFound, "Unpause again")
+}
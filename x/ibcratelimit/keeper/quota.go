@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// GetQuota Gets a (channel, denom, direction) quota from the store.
+func (k Keeper) GetQuota(ctx sdk.Context, channelID, denom string, direction ibcratelimit.FlowDirection) (quota ibcratelimit.Quota, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ibcratelimit.GetQuotaKey(channelID, denom, direction))
+	if len(bz) == 0 {
+		return quota, ibcratelimit.ErrQuotaNotFound
+	}
+	err = k.cdc.Unmarshal(bz, &quota)
+	return quota, err
+}
+
+// SetQuota Sets a quota in the store.
+func (k Keeper) SetQuota(ctx sdk.Context, quota ibcratelimit.Quota) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&quota)
+	store.Set(ibcratelimit.GetQuotaKey(quota.ChannelId, quota.Denom, quota.Direction), bz)
+}
+
+// RemoveQuota Removes a quota and its recorded flows from the store.
+func (k Keeper) RemoveQuota(ctx sdk.Context, channelID, denom string, direction ibcratelimit.FlowDirection) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := ibcratelimit.GetQuotaKey(channelID, denom, direction)
+	if !store.Has(key) {
+		return false
+	}
+	store.Delete(key)
+	store.Delete(ibcratelimit.GetQuotaUsageKey(channelID, denom, direction))
+	return true
+}
+
+// GetAllQuotas Gets all the quotas within the store.
+func (k Keeper) GetAllQuotas(ctx sdk.Context) (quotas []ibcratelimit.Quota, err error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, ibcratelimit.QuotaKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var quota ibcratelimit.Quota
+		if err = k.cdc.Unmarshal(iterator.Value(), &quota); err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+	return quotas, nil
+}
+
+// GetQuotaUsage Gets the recorded flows of a quota from the store, or an empty usage if nothing has been recorded.
+func (k Keeper) GetQuotaUsage(ctx sdk.Context, quota ibcratelimit.Quota) (usage ibcratelimit.QuotaUsage, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ibcratelimit.GetQuotaUsageKey(quota.ChannelId, quota.Denom, quota.Direction))
+	if len(bz) == 0 {
+		return ibcratelimit.NewQuotaUsage(quota), nil
+	}
+	err = k.cdc.Unmarshal(bz, &usage)
+	return usage, err
+}
+
+// SetQuotaUsage Sets the recorded flows of a quota in the store.
+func (k Keeper) SetQuotaUsage(ctx sdk.Context, usage ibcratelimit.QuotaUsage) {
+	store := ctx.KVStore(k.storeKey)
+	key := ibcratelimit.GetQuotaUsageKey(usage.ChannelId, usage.Denom, usage.Direction)
+	if len(usage.Buckets) == 0 {
+		store.Delete(key)
+		return
+	}
+	bz := k.cdc.MustMarshal(&usage)
+	store.Set(key, bz)
+}
+
+// GetAllQuotaUsages Gets all the recorded quota flows within the store.
+func (k Keeper) GetAllQuotaUsages(ctx sdk.Context) (usages []ibcratelimit.QuotaUsage, err error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, ibcratelimit.QuotaUsageKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage ibcratelimit.QuotaUsage
+		if err = k.cdc.Unmarshal(iterator.Value(), &usage); err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// GetQuotaStatus Gets the amount that has moved during a quota's current window, the maximum allowed, and the maximum
+// allowed for accounts that aren't priority addresses.
+func (k Keeper) GetQuotaStatus(ctx sdk.Context, quota ibcratelimit.Quota) (used, limit, publicLimit sdkmath.Int, err error) {
+	usage, err := k.GetQuotaUsage(ctx, quota)
+	if err != nil {
+		return used, limit, publicLimit, err
+	}
+	usage.Prune(ctx.BlockTime().Add(-quota.Window))
+
+	supply := k.bankKeeper.GetSupply(ctx, quota.Denom)
+	limit, err = quota.GetLimit(supply.Amount)
+	if err != nil {
+		return used, limit, publicLimit, err
+	}
+	publicLimit, err = quota.GetPublicLimit(supply.Amount)
+	if err != nil {
+		return used, limit, publicLimit, err
+	}
+	return usage.GetTotal(), limit, publicLimit, nil
+}
+
+// CheckAndUpdateQuota Records a transfer packet against its quota, if there is one, and returns an error if that would
+// exceed the quota. Denoms without any supply on this chain are not limited. Only the priority addresses can use the
+// priority portion of the quota.
+func (k Keeper) CheckAndUpdateQuota(ctx sdk.Context, direction ibcratelimit.FlowDirection, packet exported.PacketI) error {
+	channelID, denom, amount, err := ibcratelimit.GetPacketFlow(packet, direction)
+	if err != nil {
+		return errorsmod.Wrap(ibcratelimit.ErrBadMessage, err.Error())
+	}
+
+	quota, err := k.GetQuota(ctx, channelID, denom, direction)
+	if err != nil {
+		if errorsmod.IsOf(err, ibcratelimit.ErrQuotaNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if supply.Amount.IsZero() {
+		return nil
+	}
+	account, err := ibcratelimit.GetPacketAccount(packet, direction)
+	if err != nil {
+		return errorsmod.Wrap(ibcratelimit.ErrBadMessage, err.Error())
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	getLimit := quota.GetPublicLimit
+	if params.IsPriority(account) {
+		getLimit = quota.GetLimit
+	}
+	limit, err := getLimit(supply.Amount)
+	if err != nil {
+		return err
+	}
+
+	usage, err := k.GetQuotaUsage(ctx, quota)
+	if err != nil {
+		return err
+	}
+	usage.Prune(ctx.BlockTime().Add(-quota.Window))
+	used := usage.GetTotal()
+	if used.Add(amount).GT(limit) {
+		return errorsmod.Wrapf(ibcratelimit.ErrQuotaExceeded, "%s %s %s: used %s + %s exceeds limit %s",
+			channelID, denom, direction, used, amount, limit)
+	}
+
+	usage.Add(ctx.BlockTime(), quota.GetBucketSize(), amount)
+	k.SetQuotaUsage(ctx, usage)
+	return nil
+}
+
+// RevertQuotaUsage Removes a sent packet from its quota's recorded flows.
+func (k Keeper) RevertQuotaUsage(ctx sdk.Context, packet exported.PacketI) error {
+	direction := ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND
+	channelID, denom, amount, err := ibcratelimit.GetPacketFlow(packet, direction)
+	if err != nil {
+		return err
+	}
+
+	quota, err := k.GetQuota(ctx, channelID, denom, direction)
+	if err != nil {
+		if errorsmod.IsOf(err, ibcratelimit.ErrQuotaNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	usage, err := k.GetQuotaUsage(ctx, quota)
+	if err != nil {
+		return err
+	}
+	usage.Subtract(amount)
+	k.SetQuotaUsage(ctx, usage)
+	return nil
+}
+
+// IsExemptTransfer Checks if a transfer packet moving in the given direction belongs to one of the exempt addresses.
+// Exempt transfers bypass the quotas and the rate limiter contract.
+func (k Keeper) IsExemptTransfer(ctx sdk.Context, direction ibcratelimit.FlowDirection, packet exported.PacketI) bool {
+	params, err := k.GetParams(ctx)
+	if err != nil || len(params.ExemptAddresses) == 0 {
+		return false
+	}
+	account, err := ibcratelimit.GetPacketAccount(packet, direction)
+	if err != nil {
+		return false
+	}
+	return params.IsExempt(account)
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func (s *TestSuite) TestCheckAndUpdateQuota() {
+	addr := sdk.AccAddress("quota_holder________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin("denom", 10_000))), "FundAccount")
+
+	k := s.app.RateLimitingKeeper
+	send := ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND
+	recv := ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV
+	quota := ibcratelimit.NewQuota("src-channel", "denom", send, time.Hour, "10")
+	k.SetQuota(s.ctx, quota)
+	packet := NewMockPacket(NewMockSerializedPacketData(), true)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+
+	s.Require().NoError(k.CheckAndUpdateQuota(s.ctx, send, packet), "first send")
+	s.ctx = s.ctx.WithBlockTime(start.Add(30 * time.Minute))
+	s.Require().NoError(k.CheckAndUpdateQuota(s.ctx, send, packet), "second send")
+	err := k.CheckAndUpdateQuota(s.ctx, send, packet)
+	s.Assert().ErrorIs(err, ibcratelimit.ErrQuotaExceeded, "third send")
+	s.Assert().ErrorContains(err, "used 1000 + 500 exceeds limit 1000", "third send")
+
+	used, limit, publicLimit, err := k.GetQuotaStatus(s.ctx, quota)
+	s.Require().NoError(err, "GetQuotaStatus")
+	s.Assert().Equal(sdkmath.NewInt(1000).String(), used.String(), "used")
+	s.Assert().Equal(sdkmath.NewInt(1000).String(), limit.String(), "limit")
+	s.Assert().Equal(sdkmath.NewInt(1000).String(), publicLimit.String(), "public limit")
+
+	s.Require().NoError(k.RevertSentPacket(s.ctx, packet), "RevertSentPacket")
+	s.Require().NoError(k.CheckAndUpdateQuota(s.ctx, send, packet), "send after revert")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(61 * time.Minute))
+	s.Require().NoError(k.CheckAndUpdateQuota(s.ctx, send, packet), "send after the first bucket left the window")
+
+	recvDenom := transfertypes.ParseDenomTrace("dest-port/dest-channel/denom").IBCDenom()
+	k.SetQuota(s.ctx, ibcratelimit.NewQuota("dest-channel", recvDenom, recv, time.Hour, "1"))
+	s.Assert().NoError(k.CheckAndUpdateQuota(s.ctx, recv, packet), "receive of a denom without supply")
+
+	s.Assert().ErrorIs(k.CheckAndUpdateQuota(s.ctx, send, NewMockPacket([]byte("garbage"), true)), ibcratelimit.ErrBadMessage, "bad packet")
+
+	other := transfertypes.NewFungibleTokenPacketData("other", "500", "sender", "receiver", "")
+	bz, _ := json.Marshal(other)
+	s.Assert().NoError(k.CheckAndUpdateQuota(s.ctx, send, NewMockPacket(bz, true)), "send without a quota")
+}
+
+func (s *TestSuite) TestQuotaPriorityAndExemptions() {
+	addr := sdk.AccAddress("quota_holder________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin("denom", 10_000))), "FundAccount")
+
+	k := s.app.RateLimitingKeeper
+	send := ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND
+	quota := ibcratelimit.NewQuota("src-channel", "denom", send, time.Hour, "10")
+	quota.PriorityPercent = "5"
+	k.SetQuota(s.ctx, quota)
+	packet := NewMockPacket(NewMockSerializedPacketData(), true)
+
+	s.Require().NoError(k.CheckAndUpdateQuota(s.ctx, send, packet), "first public send")
+	err := k.CheckAndUpdateQuota(s.ctx, send, packet)
+	s.Assert().ErrorIs(err, ibcratelimit.ErrQuotaExceeded, "second public send")
+	s.Assert().ErrorContains(err, "used 500 + 500 exceeds limit 500", "second public send")
+
+	k.SetParams(s.ctx, ibcratelimit.Params{PriorityAddresses: []string{"sender"}})
+	s.Require().NoError(k.CheckAndUpdateQuota(s.ctx, send, packet), "priority send")
+	err = k.CheckAndUpdateQuota(s.ctx, send, packet)
+	s.Assert().ErrorContains(err, "used 1000 + 500 exceeds limit 1000", "priority send beyond the max percent")
+
+	used, limit, publicLimit, err := k.GetQuotaStatus(s.ctx, quota)
+	s.Require().NoError(err, "GetQuotaStatus")
+	s.Assert().Equal("1000", used.String(), "used")
+	s.Assert().Equal("1000", limit.String(), "limit")
+	s.Assert().Equal("500", publicLimit.String(), "public limit")
+
+	s.Assert().False(k.IsExemptTransfer(s.ctx, send, packet), "IsExemptTransfer without exempt addresses")
+	k.SetParams(s.ctx, ibcratelimit.Params{ExemptAddresses: []string{"sender"}})
+	s.Assert().True(k.IsExemptTransfer(s.ctx, send, packet), "IsExemptTransfer sender")
+	s.Assert().False(k.IsExemptTransfer(s.ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, packet), "IsExemptTransfer receiver")
+	s.Require().NoError(k.RevertSentPacket(s.ctx, packet), "RevertSentPacket exempt")
+	used, _, _, err = k.GetQuotaStatus(s.ctx, quota)
+	s.Require().NoError(err, "GetQuotaStatus after exempt revert")
+	s.Assert().Equal("1000", used.String(), "used after exempt revert")
+}
+
+func (s *TestSuite) TestSetAndRemoveQuota() {
+	authority := s.app.RateLimitingKeeper.GetAuthority()
+	quota := ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "5")
+
+	_, err := s.msgServer.SetQuota(s.ctx, ibcratelimit.NewMsgSetQuotaRequest("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", quota))
+	s.Assert().EqualError(err, fmt.Sprintf("expected \"%s\" got \"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma\": expected gov account as only signer for proposal message", authority), "SetQuota wrong authority")
+
+	res, err := s.msgServer.SetQuota(s.ctx, ibcratelimit.NewMsgSetQuotaRequest(authority, quota))
+	s.Require().NoError(err, "SetQuota")
+	s.Assert().Equal(&ibcratelimit.MsgSetQuotaResponse{}, res, "SetQuota response")
+	events := s.ctx.EventManager().Events()
+	s.Require().Len(events, 1, "SetQuota events")
+	s.Assert().Equal(*typedEventToEvent(ibcratelimit.NewEventQuotaSet(quota)), events[0], "SetQuota event")
+
+	quotas, err := s.queryClient.Quotas(s.ctx, &ibcratelimit.QuotasRequest{})
+	s.Require().NoError(err, "Quotas")
+	s.Assert().Equal([]ibcratelimit.Quota{quota}, quotas.Quotas, "Quotas")
+
+	usage, err := s.queryClient.QuotaUsage(s.ctx, &ibcratelimit.QuotaUsageRequest{ChannelId: "channel-0", Denom: "nhash", Direction: quota.Direction})
+	s.Require().NoError(err, "QuotaUsage")
+	s.Assert().Equal(quota, usage.Quota, "QuotaUsage quota")
+	s.Assert().True(usage.Used.IsZero(), "QuotaUsage used")
+	s.Assert().Equal(s.app.BankKeeper.GetSupply(s.ctx, "nhash").Amount.QuoRaw(20).String(), usage.Limit.String(), "QuotaUsage limit")
+	s.Assert().Equal(usage.Limit.String(), usage.PublicLimit.String(), "QuotaUsage public limit")
+
+	s.Assert().Equal([]ibcratelimit.Quota{quota}, s.app.RateLimitingKeeper.ExportGenesis(s.ctx).Quotas, "ExportGenesis quotas")
+
+	_, err = s.msgServer.RemoveQuota(s.ctx, ibcratelimit.NewMsgRemoveQuotaRequest(authority, "channel-0", "nhash", quota.Direction))
+	s.Require().NoError(err, "RemoveQuota")
+	_, err = s.msgServer.RemoveQuota(s.ctx, ibcratelimit.NewMsgRemoveQuotaRequest(authority, "channel-0", "nhash", quota.Direction))
+	s.Assert().ErrorIs(err, ibcratelimit.ErrQuotaNotFound, "RemoveQuota again")
+	_, err = s.queryClient.QuotaUsage(s.ctx, &ibcratelimit.QuotaUsageRequest{ChannelId: "channel-0", Denom: "nhash", Direction: quota.Direction})
+	s.Assert().ErrorContains(err, ibcratelimit.ErrQuotaNotFound.Error(), "QuotaUsage after remove")
+}
@@ -89,11 +89,20 @@ func (k Keeper) buildWasmExecMsg(msgType string, packet exported.PacketI) ([]byt
 	return asJSON, nil
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received.
+// RevertSentPacket Removes a sent packet that wasn't properly received from its quota and notifies the contract.
+// Packets from exempt addresses were never recorded, so there's nothing to revert for them.
 func (k Keeper) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
+	if k.IsExemptTransfer(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, packet) {
+		return nil
+	}
+
+	if err := k.RevertQuotaUsage(ctx, packet); err != nil {
+		return err
+	}
+
 	if !k.IsContractConfigured(ctx) {
 		return nil
 	}
@@ -1,5 +1,9 @@
 package ibcratelimit
 
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "ratelimitedibc"
@@ -11,4 +15,44 @@ const (
 var (
 	// ParamsKey is the key to obtain the module's params.
 	ParamsKey = []byte{0x01}
+	// QuotaKeyPrefix is the prefix of the keys used to store quotas.
+	QuotaKeyPrefix = []byte{0x02}
+	// QuotaUsageKeyPrefix is the prefix of the keys used to store the recent flows of quotas.
+	QuotaUsageKeyPrefix = []byte{0x03}
+	// PauseKeyPrefix is the prefix of the keys used to store pauses.
+	PauseKeyPrefix = []byte{0x04}
 )
+
+// GetQuotaKey returns the store key for a (channel, denom, direction) quota.
+// Format: 0x02 | len(channel) | channel | len(denom) | denom | direction
+func GetQuotaKey(channelID, denom string, direction FlowDirection) []byte {
+	return buildQuotaKey(QuotaKeyPrefix, channelID, denom, direction)
+}
+
+// GetQuotaUsageKey returns the store key for the recent flows of a (channel, denom, direction) quota.
+// Format: 0x03 | len(channel) | channel | len(denom) | denom | direction
+func GetQuotaUsageKey(channelID, denom string, direction FlowDirection) []byte {
+	return buildQuotaKey(QuotaUsageKeyPrefix, channelID, denom, direction)
+}
+
+// buildQuotaKey creates a key with the given prefix for a (channel, denom, direction) quota.
+func buildQuotaKey(prefix []byte, channelID, denom string, direction FlowDirection) []byte {
+	channelBz := address.MustLengthPrefix([]byte(channelID))
+	denomBz := address.MustLengthPrefix([]byte(denom))
+	key := make([]byte, 0, len(prefix)+len(channelBz)+len(denomBz)+1)
+	key = append(key, prefix...)
+	key = append(key, channelBz...)
+	key = append(key, denomBz...)
+	return append(key, byte(direction))
+}
+
+// GetPauseKey returns the store key for a (channel, denom) pause. Either may be empty.
+// Format: 0x04 | len(channel) | channel | len(denom) | denom
+func GetPauseKey(channelID, denom string) []byte {
+	channelBz := address.MustLengthPrefix([]byte(channelID))
+	denomBz := address.MustLengthPrefix([]byte(denom))
+	key := make([]byte, 0, len(PauseKeyPrefix)+len(channelBz)+len(denomBz))
+	key = append(key, PauseKeyPrefix...)
+	key = append(key, channelBz...)
+	return append(key, denomBz...)
+}
@@ -135,6 +135,19 @@ func (im *IBCMiddleware) OnRecvPacket(
 		return ibc.NewEmitErrorAcknowledgement(ctx, ibcratelimit.ErrBadMessage, err.Error())
 	}
 
+	if err := im.keeper.CheckPaused(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, packet); err != nil {
+		return ibc.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
+	if im.keeper.IsExemptTransfer(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, packet) {
+		// The receiver is exempt from the rate limits.
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if err := im.keeper.CheckAndUpdateQuota(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, packet); err != nil {
+		return ibc.NewEmitErrorAcknowledgement(ctx, err)
+	}
+
 	if !im.keeper.IsContractConfigured(ctx) {
 		// The contract has not been configured. Continue as usual
 		return im.app.OnRecvPacket(ctx, packet, relayer)
@@ -198,10 +211,10 @@ func (im *IBCMiddleware) OnTimeoutPacket(
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
-// the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// This method checks for a pause and skips the remaining checks for exempt senders. It then checks the (channel, denom,
+// send) quota, retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
+// the current transfer, in which case it returns an error preventing the IBC send from taking place. If there's no quota and the contract param is not configured, or the contract doesn't have a
+// configuration for the (channel+denom) being used, transfers are not prevented and handled by the wrapped IBC app
 func (im *IBCMiddleware) SendPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -219,13 +232,8 @@ func (im *IBCMiddleware) SendPacket(
 		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
-		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
-	}
-
-	// We need the full packet so the contract can process it. If it can't be cast to a channeltypes.Packet, this
-	// should fail. The only reason that would happen is if another middleware is modifying the packet, though. In
+	// We need the full packet so the quotas and contract can process it. If it can't be cast to a channeltypes.Packet,
+	// this should fail. The only reason that would happen is if another middleware is modifying the packet, though. In
 	// that case we can modify the middleware order or change this cast so we have all the data we need.
 	packet := channeltypes.NewPacket(
 		data,
@@ -238,6 +246,26 @@ func (im *IBCMiddleware) SendPacket(
 		timeoutTimestamp,
 	)
 
+	err = im.keeper.CheckPaused(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, packet)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+	}
+
+	if im.keeper.IsExemptTransfer(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, packet) {
+		// The sender is exempt from the rate limits.
+		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	err = im.keeper.CheckAndUpdateQuota(ctx, ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, packet)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+	}
+
+	if !im.keeper.IsContractConfigured(ctx) {
+		// The contract has not been configured. Continue as usual
+		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
 	err = im.keeper.CheckAndUpdateRateLimits(ctx, "send_packet", packet)
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
@@ -32,7 +32,8 @@ var (
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the ibcratelimit module.
@@ -162,6 +163,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// EndBlock lifts the guardian pauses that have expired without being ratified.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.RemoveExpiredPauses(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
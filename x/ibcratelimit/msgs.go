@@ -11,6 +11,11 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgGovUpdateParamsRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetQuotaRequest)(nil),
+	(*MsgRemoveQuotaRequest)(nil),
+	(*MsgPauseRequest)(nil),
+	(*MsgRatifyPauseRequest)(nil),
+	(*MsgUnpauseRequest)(nil),
 }
 
 // ValidateBasic runs stateless validation checks on the message.
@@ -32,3 +37,83 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	}
 	return m.Params.Validate()
 }
+
+// NewMsgSetQuotaRequest creates a new SetQuota message.
+func NewMsgSetQuotaRequest(authority string, quota Quota) *MsgSetQuotaRequest {
+	return &MsgSetQuotaRequest{
+		Authority: authority,
+		Quota:     quota,
+	}
+}
+
+func (m MsgSetQuotaRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return m.Quota.Validate()
+}
+
+// NewMsgRemoveQuotaRequest creates a new RemoveQuota message.
+func NewMsgRemoveQuotaRequest(authority, channelID, denom string, direction FlowDirection) *MsgRemoveQuotaRequest {
+	return &MsgRemoveQuotaRequest{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+		Direction: direction,
+	}
+}
+
+func (m MsgRemoveQuotaRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return ValidateQuotaID(m.ChannelId, m.Denom, m.Direction)
+}
+
+// NewMsgPauseRequest creates a new Pause message.
+func NewMsgPauseRequest(guardian, channelID, denom string) *MsgPauseRequest {
+	return &MsgPauseRequest{
+		Guardian:  guardian,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+func (m MsgPauseRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Guardian); err != nil {
+		return fmt.Errorf("invalid guardian: %w", err)
+	}
+	return ValidatePauseID(m.ChannelId, m.Denom)
+}
+
+// NewMsgRatifyPauseRequest creates a new RatifyPause message.
+func NewMsgRatifyPauseRequest(authority, channelID, denom string) *MsgRatifyPauseRequest {
+	return &MsgRatifyPauseRequest{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+func (m MsgRatifyPauseRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return ValidatePauseID(m.ChannelId, m.Denom)
+}
+
+// NewMsgUnpauseRequest creates a new Unpause message.
+func NewMsgUnpauseRequest(authority, channelID, denom string) *MsgUnpauseRequest {
+	return &MsgUnpauseRequest{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+func (m MsgUnpauseRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return ValidatePauseID(m.ChannelId, m.Denom)
+}
@@ -2,6 +2,7 @@ package ibcratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -16,6 +17,11 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgGovUpdateParamsRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetQuotaRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveQuotaRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgPauseRequest{Guardian: signer} },
+		func(signer string) sdk.Msg { return &MsgRatifyPauseRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUnpauseRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -74,3 +80,112 @@ func TestNewMsgUpdateParamsValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetQuotaValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		authority string
+		quota     Quota
+		err       string
+	}{
+		{
+			name:      "success - valid message",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			quota:     NewQuota("channel-0", "nhash", FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "5"),
+		},
+		{
+			name:      "failure - invalid authority",
+			authority: "authority",
+			quota:     NewQuota("channel-0", "nhash", FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "5"),
+			err:       "invalid authority: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:      "failure - invalid quota",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			quota:     NewQuota("channel-0", "nhash", FlowDirection_FLOW_DIRECTION_UNSPECIFIED, time.Hour, "5"),
+			err:       "flow direction must be specified",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewMsgSetQuotaRequest(tc.authority, tc.quota).ValidateBasic()
+
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should return correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
+
+func TestMsgRemoveQuotaValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		authority string
+		channel   string
+		err       string
+	}{
+		{
+			name:      "success - valid message",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			channel:   "channel-0",
+		},
+		{
+			name:      "failure - invalid authority",
+			authority: "authority",
+			channel:   "channel-0",
+			err:       "invalid authority: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:      "failure - invalid channel",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			channel:   "c",
+			err:       "invalid channel id: identifier c has invalid length: 1, must be between 8-64 characters: invalid identifier",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewMsgRemoveQuotaRequest(tc.authority, tc.channel, "nhash", FlowDirection_FLOW_DIRECTION_RECV).ValidateBasic()
+
+			if len(tc.err) > 0 {
+				assert.ErrorContains(t, err, tc.err, "should return correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
+
+func TestPauseMsgsValidateBasic(t *testing.T) {
+	addr := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	tests := []struct {
+		name string
+		msg  sdk.HasValidateBasic
+		err  string
+	}{
+		{name: "success - pause", msg: NewMsgPauseRequest(addr, "channel-0", "")},
+		{name: "failure - pause invalid guardian", msg: NewMsgPauseRequest("guardian", "channel-0", ""), err: "invalid guardian: decoding bech32 failed: invalid separator index -1"},
+		{name: "failure - pause without channel or denom", msg: NewMsgPauseRequest(addr, "", ""), err: "a channel id or denom must be provided"},
+		{name: "success - ratify", msg: NewMsgRatifyPauseRequest(addr, "", "nhash")},
+		{name: "failure - ratify invalid authority", msg: NewMsgRatifyPauseRequest("authority", "", "nhash"), err: "invalid authority: decoding bech32 failed: invalid separator index -1"},
+		{name: "failure - ratify invalid denom", msg: NewMsgRatifyPauseRequest(addr, "", "1"), err: "invalid denom: invalid denom: 1"},
+		{name: "success - unpause", msg: NewMsgUnpauseRequest(addr, "channel-0", "nhash")},
+		{name: "failure - unpause invalid authority", msg: NewMsgUnpauseRequest("authority", "channel-0", "nhash"), err: "invalid authority: decoding bech32 failed: invalid separator index -1"},
+		{name: "failure - unpause without channel or denom", msg: NewMsgUnpauseRequest(addr, "", ""), err: "a channel id or denom must be provided"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should return correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
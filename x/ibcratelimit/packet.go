@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -92,3 +93,56 @@ func UnwrapPacket(packet exported.PacketI) (UnwrappedPacket, error) {
 		TimeoutTimestamp:   packet.GetTimeoutTimestamp(),
 	}, nil
 }
+
+// GetPacketFlow Gets the local channel, local denom, and amount of a transfer packet moving in the given direction.
+// The denom is the one known on this chain, e.g. nhash or ibc/<hash>.
+func GetPacketFlow(packet exported.PacketI, direction FlowDirection) (channelID, denom string, amount sdkmath.Int, err error) {
+	unwrapped, err := UnwrapPacket(packet)
+	if err != nil {
+		return "", "", sdkmath.Int{}, err
+	}
+	var ok bool
+	amount, ok = sdkmath.NewIntFromString(unwrapped.Data.Amount)
+	if !ok {
+		return "", "", sdkmath.Int{}, errorsmod.Wrapf(ErrBadMessage, "invalid amount %q", unwrapped.Data.Amount)
+	}
+
+	switch direction {
+	case FlowDirection_FLOW_DIRECTION_SEND:
+		// Sent vouchers have their full trace in the packet, e.g. transfer/channel-0/uatom.
+		channelID = unwrapped.SourceChannel
+		denom = transfertypes.ParseDenomTrace(unwrapped.Data.Denom).IBCDenom()
+	case FlowDirection_FLOW_DIRECTION_RECV:
+		channelID = unwrapped.DestinationChannel
+		if transfertypes.ReceiverChainIsSource(unwrapped.SourcePort, unwrapped.SourceChannel, unwrapped.Data.Denom) {
+			// The denom is returning to this chain, so the counterparty's prefix is removed.
+			prefix := transfertypes.GetDenomPrefix(unwrapped.SourcePort, unwrapped.SourceChannel)
+			denom = transfertypes.ParseDenomTrace(unwrapped.Data.Denom[len(prefix):]).IBCDenom()
+		} else {
+			// The denom is a voucher on this chain, so our own prefix is added.
+			prefixed := transfertypes.GetPrefixedDenom(unwrapped.DestinationPort, unwrapped.DestinationChannel, unwrapped.Data.Denom)
+			denom = transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+		}
+	default:
+		return "", "", sdkmath.Int{}, direction.Validate()
+	}
+
+	return channelID, denom, amount, nil
+}
+
+// GetPacketAccount Gets the account on this chain of a transfer packet moving in the given direction.
+// That's the sender of sent packets and the receiver of received packets.
+func GetPacketAccount(packet exported.PacketI, direction FlowDirection) (string, error) {
+	unwrapped, err := UnwrapPacket(packet)
+	if err != nil {
+		return "", err
+	}
+	switch direction {
+	case FlowDirection_FLOW_DIRECTION_SEND:
+		return unwrapped.Data.Sender, nil
+	case FlowDirection_FLOW_DIRECTION_RECV:
+		return unwrapped.Data.Receiver, nil
+	default:
+		return "", direction.Validate()
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	"github.com/stretchr/testify/assert"
@@ -128,3 +131,65 @@ func TestUnwrapPacket(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPacketFlow(t *testing.T) {
+	tests := []struct {
+		name      string
+		denom     string
+		direction ibcratelimit.FlowDirection
+		channel   string
+		expected  string
+		err       string
+	}{
+		{
+			name:      "success - send native denom",
+			denom:     "nhash",
+			direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND,
+			channel:   "src-channel",
+			expected:  "nhash",
+		},
+		{
+			name:      "success - send voucher",
+			denom:     "transfer/channel-1/uatom",
+			direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND,
+			channel:   "src-channel",
+			expected:  transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom(),
+		},
+		{
+			name:      "success - receive counterparty denom",
+			denom:     "uatom",
+			direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV,
+			channel:   "dest-channel",
+			expected:  transfertypes.ParseDenomTrace("dest-port/dest-channel/uatom").IBCDenom(),
+		},
+		{
+			name:      "success - receive returning native denom",
+			denom:     "src-port/src-channel/nhash",
+			direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV,
+			channel:   "dest-channel",
+			expected:  "nhash",
+		},
+		{
+			name:      "failure - unspecified direction",
+			denom:     "nhash",
+			direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_UNSPECIFIED,
+			err:       "flow direction must be specified",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data := transfertypes.NewFungibleTokenPacketData(tc.denom, "500", "sender", "receiver", "")
+			bytes, _ := json.Marshal(data)
+			channel, denom, amount, err := ibcratelimit.GetPacketFlow(NewMockPacket(bytes, true), tc.direction)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should return correct error when invalid")
+				return
+			}
+			assert.NoError(t, err, "should not return an error when valid")
+			assert.Equal(t, tc.channel, channel, "should return the local channel")
+			assert.Equal(t, tc.expected, denom, "should return the local denom")
+			assert.Equal(t, sdkmath.NewInt(500), amount, "should return the amount")
+		})
+	}
+}
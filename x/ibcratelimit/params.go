@@ -1,9 +1,15 @@
 package ibcratelimit
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultPauseDuration is how long a guardian's pause lasts when the params don't define a pause duration.
+const DefaultPauseDuration = 24 * time.Hour
+
 // NewParams creates a new Params object.
 func NewParams(contractAddress string) Params {
 	return Params{
@@ -18,7 +24,67 @@ func DefaultParams() Params {
 
 // Validate verifies all params are correct
 func (p Params) Validate() error {
-	return validateContractAddress(p.ContractAddress)
+	if err := validateContractAddress(p.ContractAddress); err != nil {
+		return err
+	}
+	if err := validateAddresses("guardian", p.Guardians); err != nil {
+		return err
+	}
+	if p.PauseDuration < 0 {
+		return fmt.Errorf("invalid pause duration %s: cannot be negative", p.PauseDuration)
+	}
+	if err := validateAddresses("exempt address", p.ExemptAddresses); err != nil {
+		return err
+	}
+	return validateAddresses("priority address", p.PriorityAddresses)
+}
+
+// IsGuardian returns true if the address is one of the guardians.
+func (p Params) IsGuardian(addr string) bool {
+	return containsAddress(p.Guardians, addr)
+}
+
+// IsExempt returns true if the address is one of the exempt addresses.
+func (p Params) IsExempt(addr string) bool {
+	return containsAddress(p.ExemptAddresses, addr)
+}
+
+// IsPriority returns true if the address is one of the priority addresses.
+func (p Params) IsPriority(addr string) bool {
+	return containsAddress(p.PriorityAddresses, addr)
+}
+
+// GetPauseDurationOrDefault returns the pause duration, or the DefaultPauseDuration if it's not set.
+func (p Params) GetPauseDurationOrDefault() time.Duration {
+	if p.PauseDuration == 0 {
+		return DefaultPauseDuration
+	}
+	return p.PauseDuration
+}
+
+// validateAddresses returns an error if any of the addresses are invalid or duplicated.
+func validateAddresses(field string, addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for i, addr := range addrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid %s %d: %w", field, i, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("invalid %s %d: duplicate address %s", field, i, addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// containsAddress returns true if the addr is in the list of addrs.
+func containsAddress(addrs []string, addr string) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
 }
 
 // validateContractAddress Checks if the supplied address is a valid contract address.
@@ -5,16 +5,22 @@ package ibcratelimit
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +32,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// contract_address is the address of the rate limiter contract.
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// guardians are the accounts that can immediately pause transfers on a channel or for a denom.
+	Guardians []string `protobuf:"bytes,2,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// pause_duration is how long a guardian's pause lasts unless ratified by governance.
+	// When zero, a default of 24 hours is used.
+	PauseDuration time.Duration `protobuf:"bytes,3,opt,name=pause_duration,json=pauseDuration,proto3,stdduration" json:"pause_duration"`
+	// exempt_addresses are the accounts on this chain whose transfers bypass the quotas and the rate limiter contract,
+	// e.g. the exchange settlement account. Pauses still apply to them.
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// priority_addresses are the accounts on this chain whose transfers can use the priority portion of each quota.
+	PriorityAddresses []string `protobuf:"bytes,5,rep,name=priority_addresses,json=priorityAddresses,proto3" json:"priority_addresses,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +84,34 @@ func (m *Params) GetContractAddress() string {
 	return ""
 }
 
+func (m *Params) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *Params) GetPauseDuration() time.Duration {
+	if m != nil {
+		return m.PauseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetPriorityAddresses() []string {
+	if m != nil {
+		return m.PriorityAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.ibcratelimit.v1.Params")
 }
@@ -77,19 +121,30 @@ func init() {
 }
 
 var fileDescriptor_ee0cbe8442d3fe43 = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2f, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x42, 0x28, 0xd4, 0x43, 0x56, 0xa8, 0x57, 0x66, 0xa8, 0x64, 0xcc,
-	0xc5, 0x16, 0x00, 0x56, 0x2b, 0xa4, 0xc9, 0x25, 0x90, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c,
-	0x12, 0x9f, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4,
-	0x0f, 0x13, 0x77, 0x84, 0x08, 0x3b, 0xe5, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x03, 0x97, 0x6c, 0x66, 0xbe, 0x1e, 0x6e, 0xdb, 0x02, 0x18, 0xa3, 0x8c, 0xd2, 0x33, 0x4b, 0x32,
-	0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x0a, 0x75, 0x33, 0xf3, 0x91, 0x78, 0xfa, 0x15,
-	0x28, 0xfe, 0x49, 0x62, 0x03, 0x7b, 0xc3, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xc8, 0x67,
-	0xbb, 0xf1, 0x00, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x4e, 0xf2, 0x40,
+	0x14, 0xc5, 0x3b, 0xf0, 0x7d, 0x44, 0xc6, 0x28, 0xd8, 0xb0, 0x28, 0x24, 0x16, 0xe2, 0x46, 0x5c,
+	0x30, 0x13, 0x30, 0x71, 0x0f, 0x9a, 0x98, 0xb8, 0x22, 0xb8, 0x73, 0x43, 0xa6, 0xed, 0x58, 0x27,
+	0xa1, 0xbd, 0xcd, 0xcc, 0x94, 0xe0, 0x5b, 0xb8, 0xf4, 0x41, 0x7c, 0x05, 0x13, 0x96, 0xc4, 0x95,
+	0x2b, 0x35, 0xf0, 0x22, 0xc6, 0xfe, 0x01, 0x5c, 0x18, 0x76, 0xbd, 0xe7, 0xfe, 0xce, 0xe9, 0xc9,
+	0xcc, 0xe0, 0xd3, 0x48, 0xc2, 0x94, 0x87, 0x2c, 0x74, 0x39, 0x15, 0x8e, 0x2b, 0x99, 0xe6, 0x13,
+	0x11, 0x08, 0x4d, 0xa7, 0x5d, 0x1a, 0x31, 0xc9, 0x02, 0x45, 0x22, 0x09, 0x1a, 0xcc, 0xc6, 0x06,
+	0x24, 0xdb, 0x20, 0x99, 0x76, 0x1b, 0x75, 0x17, 0x54, 0x00, 0x6a, 0x9c, 0x90, 0x34, 0x1d, 0x52,
+	0x5b, 0xa3, 0xe6, 0x83, 0x0f, 0xa9, 0xfe, 0xf3, 0x95, 0xa9, 0xb6, 0x0f, 0xe0, 0x4f, 0x38, 0x4d,
+	0x26, 0x27, 0xbe, 0xa7, 0x5e, 0x2c, 0x99, 0x16, 0x10, 0xa6, 0xfb, 0x93, 0xd7, 0x02, 0x2e, 0x0d,
+	0x93, 0xbf, 0x9b, 0x67, 0xb8, 0xea, 0x42, 0xa8, 0x25, 0x73, 0xf5, 0x98, 0x79, 0x9e, 0xe4, 0x4a,
+	0x59, 0xa8, 0x85, 0xda, 0xe5, 0x51, 0x25, 0xd7, 0xfb, 0xa9, 0x6c, 0x5e, 0xe0, 0xb2, 0x1f, 0x33,
+	0xe9, 0x09, 0x16, 0x2a, 0xab, 0xd0, 0x2a, 0xb6, 0xcb, 0x03, 0xeb, 0xed, 0xa5, 0x53, 0xcb, 0x0a,
+	0x65, 0xd8, 0xad, 0x96, 0x22, 0xf4, 0x47, 0x1b, 0xd4, 0xbc, 0xc1, 0x87, 0x11, 0x8b, 0x15, 0x1f,
+	0xe7, 0x2d, 0xac, 0x62, 0x0b, 0xb5, 0xf7, 0x7b, 0x75, 0x92, 0xd6, 0x24, 0x79, 0x4d, 0x72, 0x95,
+	0x01, 0x83, 0xbd, 0xf9, 0x47, 0xd3, 0x78, 0xfe, 0x6c, 0xa2, 0xd1, 0x41, 0x62, 0xcd, 0x17, 0xe6,
+	0x25, 0xae, 0xf2, 0x19, 0x0f, 0xa2, 0x75, 0x59, 0xae, 0xac, 0x7f, 0x3b, 0xaa, 0x54, 0x52, 0x47,
+	0x3f, 0x37, 0x98, 0xd7, 0xd8, 0x8c, 0xa4, 0x00, 0x29, 0xf4, 0xe3, 0x56, 0xcc, 0xff, 0x1d, 0x31,
+	0x47, 0xb9, 0x67, 0x1d, 0x34, 0x08, 0xe6, 0x4b, 0x1b, 0x2d, 0x96, 0x36, 0xfa, 0x5a, 0xda, 0xe8,
+	0x69, 0x65, 0x1b, 0x8b, 0x95, 0x6d, 0xbc, 0xaf, 0x6c, 0x03, 0x1f, 0x0b, 0x20, 0x7f, 0xdf, 0xe8,
+	0x10, 0xdd, 0xf5, 0x7c, 0xa1, 0x1f, 0x62, 0x87, 0xb8, 0x10, 0xd0, 0x0d, 0xd8, 0x11, 0xb0, 0x35,
+	0xd1, 0xd9, 0xaf, 0x37, 0xe3, 0x94, 0x92, 0x83, 0x3a, 0xff, 0x1e, 0x00, 0x6f, 0x0f, 0xae, 0x2e,
+	0x55, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +167,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriorityAddresses) > 0 {
+		for iNdEx := len(m.PriorityAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriorityAddresses[iNdEx])
+			copy(dAtA[i:], m.PriorityAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PriorityAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PauseDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PauseDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -143,6 +233,26 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PauseDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.PriorityAddresses) > 0 {
+		for _, s := range m.PriorityAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -213,6 +323,135 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PauseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityAddresses = append(m.PriorityAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package ibcratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	"github.com/stretchr/testify/assert"
@@ -69,3 +70,101 @@ func TestDefaultParams(t *testing.T) {
 	params := ibcratelimit.DefaultParams()
 	assert.Equal(t, "", params.ContractAddress)
 }
+
+func TestParamsGuardians(t *testing.T) {
+	guardian := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	tests := []struct {
+		name   string
+		params ibcratelimit.Params
+		err    string
+	}{
+		{
+			name:   "success - guardian and pause duration",
+			params: ibcratelimit.Params{Guardians: []string{guardian}, PauseDuration: time.Hour},
+		},
+		{
+			name:   "failure - invalid guardian",
+			params: ibcratelimit.Params{Guardians: []string{"guardian"}},
+			err:    "invalid guardian 0: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "failure - duplicate guardian",
+			params: ibcratelimit.Params{Guardians: []string{guardian, guardian}},
+			err:    "invalid guardian 1: duplicate address " + guardian,
+		},
+		{
+			name:   "failure - negative pause duration",
+			params: ibcratelimit.Params{PauseDuration: -time.Second},
+			err:    "invalid pause duration -1s: cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+
+	params := ibcratelimit.Params{Guardians: []string{guardian}}
+	assert.True(t, params.IsGuardian(guardian), "IsGuardian(guardian)")
+	assert.False(t, params.IsGuardian("other"), "IsGuardian(other)")
+	assert.Equal(t, ibcratelimit.DefaultPauseDuration, params.GetPauseDurationOrDefault(), "GetPauseDurationOrDefault unset")
+	params.PauseDuration = time.Hour
+	assert.Equal(t, time.Hour, params.GetPauseDurationOrDefault(), "GetPauseDurationOrDefault set")
+}
+
+func TestParamsExemptAndPriorityAddresses(t *testing.T) {
+	addr := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	tests := []struct {
+		name   string
+		params ibcratelimit.Params
+		err    string
+	}{
+		{
+			name:   "success - exempt and priority addresses",
+			params: ibcratelimit.Params{ExemptAddresses: []string{addr}, PriorityAddresses: []string{addr}},
+		},
+		{
+			name:   "failure - invalid exempt address",
+			params: ibcratelimit.Params{ExemptAddresses: []string{"exempt"}},
+			err:    "invalid exempt address 0: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "failure - duplicate exempt address",
+			params: ibcratelimit.Params{ExemptAddresses: []string{addr, addr}},
+			err:    "invalid exempt address 1: duplicate address " + addr,
+		},
+		{
+			name:   "failure - invalid priority address",
+			params: ibcratelimit.Params{PriorityAddresses: []string{"priority"}},
+			err:    "invalid priority address 0: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "failure - duplicate priority address",
+			params: ibcratelimit.Params{PriorityAddresses: []string{addr, addr}},
+			err:    "invalid priority address 1: duplicate address " + addr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+
+	params := ibcratelimit.Params{ExemptAddresses: []string{addr}, PriorityAddresses: []string{"other"}}
+	assert.True(t, params.IsExempt(addr), "IsExempt(addr)")
+	assert.False(t, params.IsExempt("other"), "IsExempt(other)")
+	assert.True(t, params.IsPriority("other"), "IsPriority(other)")
+	assert.False(t, params.IsPriority(addr), "IsPriority(addr)")
+}
@@ -0,0 +1,60 @@
+package ibcratelimit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// ValidatePauseID returns an error if the channel and denom cannot identify a pause.
+// Either can be empty, but not both.
+func ValidatePauseID(channelID, denom string) error {
+	if len(channelID) == 0 && len(denom) == 0 {
+		return errors.New("a channel id or denom must be provided")
+	}
+	if len(channelID) > 0 {
+		if err := host.ChannelIdentifierValidator(channelID); err != nil {
+			return fmt.Errorf("invalid channel id: %w", err)
+		}
+	}
+	if len(denom) > 0 {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid denom: %w", err)
+		}
+	}
+	return nil
+}
+
+// NewPause creates a new Pause that expires at the given time.
+func NewPause(channelID, denom, guardian string, expiration time.Time) Pause {
+	return Pause{
+		ChannelId:  channelID,
+		Denom:      denom,
+		Guardian:   guardian,
+		Expiration: &expiration,
+	}
+}
+
+// Validate returns an error if anything is wrong with this Pause.
+func (p Pause) Validate() error {
+	if err := ValidatePauseID(p.ChannelId, p.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+		return fmt.Errorf("invalid guardian: %w", err)
+	}
+	return nil
+}
+
+// IsRatified returns true if governance has ratified this Pause, so it doesn't expire.
+func (p Pause) IsRatified() bool {
+	return p.Expiration == nil
+}
+
+// IsExpired returns true if this Pause has not been ratified and its expiration has been reached.
+func (p Pause) IsExpired(blockTime time.Time) bool {
+	return !p.IsRatified() && !blockTime.Before(*p.Expiration)
+}
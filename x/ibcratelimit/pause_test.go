@@ -0,0 +1,76 @@
+package ibcratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func TestPauseValidate(t *testing.T) {
+	guardian := "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd"
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		pause ibcratelimit.Pause
+		err   string
+	}{
+		{
+			name:  "success - channel",
+			pause: ibcratelimit.NewPause("channel-0", "", guardian, expiration),
+		},
+		{
+			name:  "success - denom",
+			pause: ibcratelimit.NewPause("", "nhash", guardian, expiration),
+		},
+		{
+			name:  "success - ratified channel and denom",
+			pause: ibcratelimit.Pause{ChannelId: "channel-0", Denom: "nhash", Guardian: guardian},
+		},
+		{
+			name:  "failure - no channel or denom",
+			pause: ibcratelimit.NewPause("", "", guardian, expiration),
+			err:   "a channel id or denom must be provided",
+		},
+		{
+			name:  "failure - invalid channel",
+			pause: ibcratelimit.NewPause("c", "", guardian, expiration),
+			err:   "invalid channel id: identifier c has invalid length",
+		},
+		{
+			name:  "failure - invalid denom",
+			pause: ibcratelimit.NewPause("", "1", guardian, expiration),
+			err:   "invalid denom: invalid denom: 1",
+		},
+		{
+			name:  "failure - invalid guardian",
+			pause: ibcratelimit.NewPause("channel-0", "", "guardian", expiration),
+			err:   "invalid guardian: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pause.Validate()
+			if len(tc.err) > 0 {
+				assert.ErrorContains(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestPauseIsExpired(t *testing.T) {
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pause := ibcratelimit.NewPause("channel-0", "", "guardian", expiration)
+	assert.False(t, pause.IsRatified(), "IsRatified")
+	assert.False(t, pause.IsExpired(expiration.Add(-time.Second)), "IsExpired before expiration")
+	assert.True(t, pause.IsExpired(expiration), "IsExpired at expiration")
+
+	pause.Expiration = nil
+	assert.True(t, pause.IsRatified(), "IsRatified after ratifying")
+	assert.False(t, pause.IsExpired(expiration.Add(time.Hour)), "IsExpired after ratifying")
+}
@@ -5,6 +5,7 @@ package ibcratelimit
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -112,9 +113,297 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QuotasRequest is the request type for the Query/Quotas RPC method.
+type QuotasRequest struct {
+}
+
+func (m *QuotasRequest) Reset()         { *m = QuotasRequest{} }
+func (m *QuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*QuotasRequest) ProtoMessage()    {}
+func (*QuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{2}
+}
+func (m *QuotasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotasRequest.Merge(m, src)
+}
+func (m *QuotasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotasRequest proto.InternalMessageInfo
+
+// QuotasResponse is the response type for the Query/Quotas RPC method.
+type QuotasResponse struct {
+	// quotas are all of the configured quotas.
+	Quotas []Quota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas"`
+}
+
+func (m *QuotasResponse) Reset()         { *m = QuotasResponse{} }
+func (m *QuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*QuotasResponse) ProtoMessage()    {}
+func (*QuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{3}
+}
+func (m *QuotasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotasResponse.Merge(m, src)
+}
+func (m *QuotasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotasResponse proto.InternalMessageInfo
+
+func (m *QuotasResponse) GetQuotas() []Quota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// QuotaUsageRequest is the request type for the Query/QuotaUsage RPC method.
+type QuotaUsageRequest struct {
+	// channel_id is the channel of the quota.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom of the quota.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// direction is the direction of the quota.
+	Direction FlowDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=provenance.ibcratelimit.v1.FlowDirection" json:"direction,omitempty"`
+}
+
+func (m *QuotaUsageRequest) Reset()         { *m = QuotaUsageRequest{} }
+func (m *QuotaUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsageRequest) ProtoMessage()    {}
+func (*QuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{4}
+}
+func (m *QuotaUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsageRequest.Merge(m, src)
+}
+func (m *QuotaUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsageRequest proto.InternalMessageInfo
+
+func (m *QuotaUsageRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QuotaUsageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QuotaUsageRequest) GetDirection() FlowDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return FlowDirection_FLOW_DIRECTION_UNSPECIFIED
+}
+
+// QuotaUsageResponse is the response type for the Query/QuotaUsage RPC method.
+type QuotaUsageResponse struct {
+	// quota is the requested quota.
+	Quota Quota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota"`
+	// used is the amount that has moved during the current window.
+	Used cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	// limit is the maximum amount that can move during the window based on the current supply.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// public_limit is the maximum amount that accounts other than the priority addresses can move during the window.
+	PublicLimit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=public_limit,json=publicLimit,proto3,customtype=cosmossdk.io/math.Int" json:"public_limit"`
+}
+
+func (m *QuotaUsageResponse) Reset()         { *m = QuotaUsageResponse{} }
+func (m *QuotaUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsageResponse) ProtoMessage()    {}
+func (*QuotaUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{5}
+}
+func (m *QuotaUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuotaUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuotaUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsageResponse.Merge(m, src)
+}
+func (m *QuotaUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsageResponse proto.InternalMessageInfo
+
+func (m *QuotaUsageResponse) GetQuota() Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return Quota{}
+}
+
+// PausesRequest is the request type for the Query/Pauses RPC method.
+type PausesRequest struct {
+}
+
+func (m *PausesRequest) Reset()         { *m = PausesRequest{} }
+func (m *PausesRequest) String() string { return proto.CompactTextString(m) }
+func (*PausesRequest) ProtoMessage()    {}
+func (*PausesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{6}
+}
+func (m *PausesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausesRequest.Merge(m, src)
+}
+func (m *PausesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausesRequest proto.InternalMessageInfo
+
+// PausesResponse is the response type for the Query/Pauses RPC method.
+type PausesResponse struct {
+	// pauses are all of the active pauses.
+	Pauses []Pause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses"`
+}
+
+func (m *PausesResponse) Reset()         { *m = PausesResponse{} }
+func (m *PausesResponse) String() string { return proto.CompactTextString(m) }
+func (*PausesResponse) ProtoMessage()    {}
+func (*PausesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{7}
+}
+func (m *PausesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausesResponse.Merge(m, src)
+}
+func (m *PausesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausesResponse proto.InternalMessageInfo
+
+func (m *PausesResponse) GetPauses() []Pause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "provenance.ibcratelimit.v1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "provenance.ibcratelimit.v1.ParamsResponse")
+	proto.RegisterType((*QuotasRequest)(nil), "provenance.ibcratelimit.v1.QuotasRequest")
+	proto.RegisterType((*QuotasResponse)(nil), "provenance.ibcratelimit.v1.QuotasResponse")
+	proto.RegisterType((*QuotaUsageRequest)(nil), "provenance.ibcratelimit.v1.QuotaUsageRequest")
+	proto.RegisterType((*QuotaUsageResponse)(nil), "provenance.ibcratelimit.v1.QuotaUsageResponse")
+	proto.RegisterType((*PausesRequest)(nil), "provenance.ibcratelimit.v1.PausesRequest")
+	proto.RegisterType((*PausesResponse)(nil), "provenance.ibcratelimit.v1.PausesResponse")
 }
 
 func init() {
@@ -122,25 +411,45 @@ func init() {
 }
 
 var fileDescriptor_530d9ff030c0dc3e = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x42, 0xa8, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7,
-	0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7,
-	0x15, 0x43, 0x65, 0xd5, 0xf1, 0xd8, 0x5b, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x55, 0xa8, 0xc4, 0xcf,
-	0xc5, 0x1b, 0x00, 0xe6, 0x07, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0x05, 0x71, 0xf1, 0xc1,
-	0x04, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x1c, 0xb8, 0xd8, 0x20, 0x5a, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0xb8, 0x8d, 0x94, 0xf4, 0x70, 0x3b, 0x56, 0x0f, 0xa2, 0xd7, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x3e, 0xa3, 0xa9, 0x8c, 0x5c, 0xac, 0x81, 0x20, 0xdf, 0x0a, 0x75, 0x33,
-	0x72, 0xb1, 0x41, 0x94, 0x08, 0x69, 0x12, 0x36, 0x06, 0xea, 0x26, 0x29, 0x2d, 0x62, 0x94, 0x42,
-	0x5c, 0xab, 0xa4, 0xd5, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x15, 0x21, 0x25, 0x7d, 0x82, 0x41, 0xe0,
-	0x94, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x5c, 0xb2, 0x99, 0xf9, 0x78,
-	0xec, 0x0c, 0x60, 0x8c, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0x45,
-	0xb2, 0x48, 0x37, 0x33, 0x1f, 0xd9, 0xda, 0x0a, 0x14, 0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x6e, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0xae, 0xf7, 0xe5, 0x5c, 0x15, 0x02, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6b, 0xd3, 0x50,
+	0x1c, 0xc7, 0x9b, 0xad, 0x2d, 0xec, 0x55, 0x2b, 0x3e, 0x26, 0x94, 0x60, 0xb3, 0x19, 0x64, 0x76,
+	0x81, 0x25, 0xb4, 0x3b, 0x0f, 0x47, 0x91, 0xe9, 0x40, 0x64, 0x0d, 0x78, 0xf1, 0x32, 0x5e, 0x93,
+	0x47, 0xfa, 0xb0, 0xc9, 0x4b, 0xf3, 0x92, 0xaa, 0x8c, 0x5d, 0xbc, 0x7a, 0x51, 0xfc, 0x73, 0xfc,
+	0x07, 0x76, 0x1c, 0x78, 0x11, 0x0f, 0x43, 0x5a, 0xef, 0xfe, 0x05, 0x82, 0xbc, 0xf7, 0x92, 0x25,
+	0x3b, 0x98, 0x66, 0xb7, 0xf4, 0xf7, 0xbe, 0x9f, 0x7c, 0xbf, 0xaf, 0xbf, 0xdf, 0x2f, 0x60, 0x27,
+	0x8c, 0xe8, 0x1c, 0x07, 0x28, 0x70, 0xb0, 0x45, 0xc6, 0x4e, 0x84, 0x62, 0x3c, 0x25, 0x3e, 0x89,
+	0xad, 0x79, 0xdf, 0x9a, 0x25, 0x38, 0xfa, 0x60, 0x86, 0x11, 0x8d, 0x29, 0x54, 0x73, 0x9d, 0x59,
+	0xd4, 0x99, 0xf3, 0xbe, 0xba, 0xe9, 0x51, 0x8f, 0x0a, 0x99, 0xc5, 0x9f, 0x24, 0xa1, 0x3e, 0xf4,
+	0x28, 0xf5, 0xa6, 0xd8, 0x42, 0x21, 0xb1, 0x50, 0x10, 0xd0, 0x18, 0xc5, 0x84, 0x06, 0x2c, 0x3d,
+	0x7d, 0x52, 0xe2, 0x1b, 0xa2, 0x08, 0xf9, 0x99, 0x70, 0xa7, 0x54, 0x98, 0x30, 0x5c, 0x41, 0x37,
+	0x4b, 0x68, 0x8c, 0xa4, 0x4e, 0xbf, 0x07, 0xee, 0x9e, 0x88, 0xf7, 0xdb, 0x78, 0x96, 0x60, 0x16,
+	0xeb, 0x36, 0x68, 0x67, 0x05, 0x16, 0xd2, 0x80, 0x61, 0x78, 0x08, 0x9a, 0x32, 0x42, 0x47, 0xd9,
+	0x56, 0x7a, 0xad, 0x81, 0x6e, 0xfe, 0xff, 0xf2, 0xa6, 0x64, 0x87, 0xf5, 0x8b, 0xab, 0xad, 0x9a,
+	0x9d, 0x72, 0xdc, 0x64, 0xc4, 0x3d, 0xaf, 0x4d, 0x46, 0xa0, 0x9d, 0x15, 0x52, 0x93, 0xa7, 0xa0,
+	0x29, 0x62, 0x71, 0x93, 0xf5, 0x5e, 0x6b, 0xf0, 0xa8, 0xcc, 0x44, 0xb0, 0x99, 0x87, 0xc4, 0xf4,
+	0x2f, 0x0a, 0xb8, 0x2f, 0xea, 0xaf, 0x19, 0xf2, 0x70, 0x6a, 0x04, 0xbb, 0x00, 0x38, 0x13, 0x14,
+	0x04, 0x78, 0x7a, 0x4a, 0x5c, 0x91, 0x7f, 0xc3, 0xde, 0x48, 0x2b, 0xc7, 0x2e, 0xdc, 0x04, 0x0d,
+	0x17, 0x07, 0xd4, 0xef, 0xac, 0x89, 0x13, 0xf9, 0x03, 0x3e, 0x07, 0x1b, 0x2e, 0x89, 0xb0, 0xc3,
+	0x1b, 0xd4, 0x59, 0xdf, 0x56, 0x7a, 0xed, 0xc1, 0x6e, 0x59, 0x9c, 0xa3, 0x29, 0x7d, 0xf7, 0x2c,
+	0x03, 0xec, 0x9c, 0xd5, 0xff, 0x2a, 0x00, 0x16, 0x33, 0xa5, 0x77, 0x3d, 0x00, 0x0d, 0x11, 0x3a,
+	0xfd, 0x3f, 0x2b, 0x5f, 0x55, 0x52, 0xb0, 0x0f, 0xea, 0x09, 0xc3, 0xae, 0xcc, 0x3c, 0xec, 0xf2,
+	0xa3, 0x9f, 0x57, 0x5b, 0x0f, 0x1c, 0xca, 0x7c, 0xca, 0x98, 0xfb, 0xd6, 0x24, 0xd4, 0xf2, 0x51,
+	0x3c, 0x31, 0x8f, 0x83, 0xd8, 0x16, 0x52, 0xb8, 0x0f, 0x1a, 0xe2, 0x8d, 0x9d, 0xf5, 0x2a, 0x8c,
+	0xd4, 0xc2, 0x43, 0x70, 0x27, 0x4c, 0xc6, 0x53, 0xe2, 0x9c, 0x4a, 0xb6, 0x5e, 0x85, 0x6d, 0x49,
+	0xe4, 0x25, 0x27, 0xe4, 0x70, 0x25, 0x0c, 0x17, 0xfb, 0x9e, 0x15, 0xf2, 0xbe, 0x8b, 0xb1, 0xad,
+	0xd4, 0x77, 0xc1, 0xe6, 0xb3, 0xc5, 0xb1, 0xc1, 0x9f, 0x3a, 0x68, 0x8c, 0xf8, 0x66, 0xc2, 0x4f,
+	0x0a, 0x68, 0xca, 0xf1, 0x83, 0xbb, 0xab, 0x47, 0x34, 0x8d, 0xa4, 0x1a, 0x55, 0xa4, 0x32, 0xac,
+	0x6e, 0x7c, 0xfc, 0xfe, 0xfb, 0xeb, 0xda, 0x63, 0xa8, 0x5b, 0x2b, 0xd7, 0x55, 0xa4, 0x91, 0x33,
+	0x5e, 0x9e, 0xe6, 0xc6, 0x62, 0xa8, 0x46, 0x15, 0xe9, 0x6d, 0xd2, 0xc8, 0xed, 0x80, 0xdf, 0x14,
+	0x00, 0xf2, 0x49, 0x84, 0x7b, 0x2b, 0x6d, 0x8a, 0x5b, 0xa4, 0x9a, 0x55, 0xe5, 0x69, 0xb2, 0x57,
+	0x22, 0xd9, 0x0b, 0x78, 0xb4, 0x3a, 0x99, 0x75, 0x96, 0xef, 0xe7, 0xb9, 0x75, 0x76, 0xbd, 0x3b,
+	0xfc, 0x99, 0x2f, 0xe3, 0x81, 0x61, 0x9c, 0xa7, 0x9d, 0xe5, 0xed, 0x5e, 0xd5, 0xd9, 0xc2, 0xb0,
+	0xa9, 0x46, 0x15, 0xe9, 0xed, 0x3a, 0xcb, 0x99, 0xa1, 0x7f, 0xb1, 0xd0, 0x94, 0xcb, 0x85, 0xa6,
+	0xfc, 0x5a, 0x68, 0xca, 0xe7, 0xa5, 0x56, 0xbb, 0x5c, 0x6a, 0xb5, 0x1f, 0x4b, 0xad, 0x06, 0xba,
+	0x84, 0x96, 0x78, 0x9e, 0x28, 0x6f, 0x06, 0x1e, 0x89, 0x27, 0xc9, 0xd8, 0x74, 0xa8, 0x5f, 0x30,
+	0xda, 0x23, 0xb4, 0x68, 0xfb, 0xfe, 0x86, 0xf1, 0xb8, 0x29, 0x3e, 0xd4, 0xfb, 0xff, 0x06, 0x00,
+	0x2a, 0xfc, 0xf5, 0x11, 0x9b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +467,12 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibcratelimit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Quotas returns all of the configured quotas.
+	Quotas(ctx context.Context, in *QuotasRequest, opts ...grpc.CallOption) (*QuotasResponse, error)
+	// QuotaUsage returns a quota along with the amount currently used and allowed in its window.
+	QuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsageResponse, error)
+	// Pauses returns all of the active pauses.
+	Pauses(ctx context.Context, in *PausesRequest, opts ...grpc.CallOption) (*PausesResponse, error)
 }
 
 type queryClient struct {
@@ -177,11 +492,44 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) Quotas(ctx context.Context, in *QuotasRequest, opts ...grpc.CallOption) (*QuotasResponse, error) {
+	out := new(QuotasResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/Quotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsageResponse, error) {
+	out := new(QuotaUsageResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/QuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pauses(ctx context.Context, in *PausesRequest, opts ...grpc.CallOption) (*PausesResponse, error) {
+	out := new(PausesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/Pauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibcratelimit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Quotas returns all of the configured quotas.
+	Quotas(context.Context, *QuotasRequest) (*QuotasResponse, error)
+	// QuotaUsage returns a quota along with the amount currently used and allowed in its window.
+	QuotaUsage(context.Context, *QuotaUsageRequest) (*QuotaUsageResponse, error)
+	// Pauses returns all of the active pauses.
+	Pauses(context.Context, *PausesRequest) (*PausesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -191,6 +539,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Quotas(ctx context.Context, req *QuotasRequest) (*QuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quotas not implemented")
+}
+func (*UnimplementedQueryServer) QuotaUsage(ctx context.Context, req *QuotaUsageRequest) (*QuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotaUsage not implemented")
+}
+func (*UnimplementedQueryServer) Pauses(ctx context.Context, req *PausesRequest) (*PausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pauses not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -214,6 +571,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Quotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Quotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/Quotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Quotas(ctx, req.(*QuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/QuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuotaUsage(ctx, req.(*QuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/Pauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pauses(ctx, req.(*PausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.ibcratelimit.v1.Query",
@@ -223,6 +634,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Quotas",
+			Handler:    _Query_Quotas_Handler,
+		},
+		{
+			MethodName: "QuotaUsage",
+			Handler:    _Query_QuotaUsage_Handler,
+		},
+		{
+			MethodName: "Pauses",
+			Handler:    _Query_Pauses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/ibcratelimit/v1/query.proto",
@@ -284,40 +707,350 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuotasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuotasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuotasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+func (m *QuotasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for iNdEx := len(m.Quotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Quotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PublicLimit.Size()
+		i -= size
+		if _, err := m.PublicLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PausesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PausesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuotasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuotasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Quotas) > 0 {
+		for _, e := range m.Quotas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *QuotaUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quota.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Used.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PublicLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PausesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PausesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -454,6 +1187,592 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuotasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quotas = append(m.Quotas, Quota{})
+			if err := m.Quotas[len(m.Quotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= FlowDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
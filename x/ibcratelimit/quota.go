@@ -0,0 +1,200 @@
+package ibcratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// QuotaBucketsPerWindow is the number of buckets that a quota's window is divided into.
+// The window rolls forward one bucket at a time.
+const QuotaBucketsPerWindow = 10
+
+// ParseFlowDirection converts a string into a FlowDirection.
+// Accepts the enum name, or just its suffix, e.g. "FLOW_DIRECTION_SEND" or "send".
+func ParseFlowDirection(str string) (FlowDirection, error) {
+	name := strings.ToUpper(strings.TrimSpace(str))
+	if val, ok := FlowDirection_value[name]; ok {
+		return FlowDirection(val), nil
+	}
+	if val, ok := FlowDirection_value["FLOW_DIRECTION_"+name]; ok {
+		return FlowDirection(val), nil
+	}
+	return FlowDirection_FLOW_DIRECTION_UNSPECIFIED, fmt.Errorf("unknown flow direction %q", str)
+}
+
+// Validate returns an error if this FlowDirection isn't send or recv.
+func (d FlowDirection) Validate() error {
+	switch d {
+	case FlowDirection_FLOW_DIRECTION_SEND, FlowDirection_FLOW_DIRECTION_RECV:
+		return nil
+	case FlowDirection_FLOW_DIRECTION_UNSPECIFIED:
+		return errors.New("flow direction must be specified")
+	default:
+		return fmt.Errorf("unknown flow direction %d", d)
+	}
+}
+
+// ValidateQuotaID returns an error if the channel, denom, or direction cannot identify a quota.
+func ValidateQuotaID(channelID, denom string, direction FlowDirection) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return fmt.Errorf("invalid channel id: %w", err)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	return direction.Validate()
+}
+
+// NewQuota creates a new Quota.
+func NewQuota(channelID, denom string, direction FlowDirection, window time.Duration, maxPercent string) Quota {
+	return Quota{
+		ChannelId:  channelID,
+		Denom:      denom,
+		Direction:  direction,
+		Window:     window,
+		MaxPercent: maxPercent,
+	}
+}
+
+// Validate returns an error if anything is wrong with this Quota.
+func (q Quota) Validate() error {
+	if err := ValidateQuotaID(q.ChannelId, q.Denom, q.Direction); err != nil {
+		return err
+	}
+	if q.Window < QuotaBucketsPerWindow {
+		return fmt.Errorf("invalid window %s: must be at least %dns", q.Window, QuotaBucketsPerWindow)
+	}
+	percent, err := q.GetMaxPercentDec()
+	if err != nil {
+		return err
+	}
+	if !percent.IsPositive() || percent.GT(sdkmath.LegacyNewDec(100)) {
+		return fmt.Errorf("invalid max percent %q: must be greater than 0 and at most 100", q.MaxPercent)
+	}
+	priority, err := q.GetPriorityPercentDec()
+	if err != nil {
+		return err
+	}
+	if priority.IsNegative() || priority.GT(percent) {
+		return fmt.Errorf("invalid priority percent %q: must be between 0 and the max percent %q", q.PriorityPercent, q.MaxPercent)
+	}
+	return nil
+}
+
+// GetMaxPercentDec parses the max percent of this Quota.
+func (q Quota) GetMaxPercentDec() (sdkmath.LegacyDec, error) {
+	percent, err := sdkmath.LegacyNewDecFromStr(q.MaxPercent)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid max percent %q: %w", q.MaxPercent, err)
+	}
+	return percent, nil
+}
+
+// GetPriorityPercentDec parses the priority percent of this Quota. An empty priority percent is zero.
+func (q Quota) GetPriorityPercentDec() (sdkmath.LegacyDec, error) {
+	if len(q.PriorityPercent) == 0 {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+	percent, err := sdkmath.LegacyNewDecFromStr(q.PriorityPercent)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid priority percent %q: %w", q.PriorityPercent, err)
+	}
+	return percent, nil
+}
+
+// GetLimit returns the maximum amount that can move during the window given the denom's total supply.
+// This includes the priority portion, so it is the limit that applies to the priority addresses.
+func (q Quota) GetLimit(supply sdkmath.Int) (sdkmath.Int, error) {
+	percent, err := q.GetMaxPercentDec()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return percent.MulInt(supply).QuoInt64(100).TruncateInt(), nil
+}
+
+// GetPublicLimit returns the maximum amount that can move during the window given the denom's total supply
+// without using the priority portion. This is the limit that applies to everyone but the priority addresses.
+func (q Quota) GetPublicLimit(supply sdkmath.Int) (sdkmath.Int, error) {
+	percent, err := q.GetMaxPercentDec()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	priority, err := q.GetPriorityPercentDec()
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return percent.Sub(priority).MulInt(supply).QuoInt64(100).TruncateInt(), nil
+}
+
+// GetBucketSize returns the length of time covered by each bucket of this Quota's window.
+func (q Quota) GetBucketSize() time.Duration {
+	return q.Window / QuotaBucketsPerWindow
+}
+
+// NewQuotaUsage creates a new QuotaUsage without any flows for the given quota.
+func NewQuotaUsage(quota Quota) QuotaUsage {
+	return QuotaUsage{
+		ChannelId: quota.ChannelId,
+		Denom:     quota.Denom,
+		Direction: quota.Direction,
+	}
+}
+
+// Validate returns an error if anything is wrong with this QuotaUsage.
+func (u QuotaUsage) Validate() error {
+	if err := ValidateQuotaID(u.ChannelId, u.Denom, u.Direction); err != nil {
+		return err
+	}
+	for i, bucket := range u.Buckets {
+		if bucket.Amount.IsNil() || bucket.Amount.IsNegative() {
+			return fmt.Errorf("invalid bucket %d amount: must not be negative", i)
+		}
+		if i > 0 && bucket.Start.Before(u.Buckets[i-1].Start) {
+			return fmt.Errorf("invalid bucket %d start: buckets must be ordered oldest first", i)
+		}
+	}
+	return nil
+}
+
+// Prune removes the buckets that started before the given cutoff.
+func (u *QuotaUsage) Prune(cutoff time.Time) {
+	i := 0
+	for i < len(u.Buckets) && u.Buckets[i].Start.Before(cutoff) {
+		i++
+	}
+	u.Buckets = u.Buckets[i:]
+}
+
+// GetTotal returns the sum of all the buckets.
+func (u QuotaUsage) GetTotal() sdkmath.Int {
+	rv := sdkmath.ZeroInt()
+	for _, bucket := range u.Buckets {
+		rv = rv.Add(bucket.Amount)
+	}
+	return rv
+}
+
+// Add records an amount in the newest bucket, or a new one if the newest is at least bucketSize old.
+func (u *QuotaUsage) Add(now time.Time, bucketSize time.Duration, amount sdkmath.Int) {
+	if last := len(u.Buckets) - 1; last >= 0 && now.Before(u.Buckets[last].Start.Add(bucketSize)) {
+		u.Buckets[last].Amount = u.Buckets[last].Amount.Add(amount)
+		return
+	}
+	u.Buckets = append(u.Buckets, FlowBucket{Start: now, Amount: amount})
+}
+
+// Subtract removes an amount from the buckets, newest first, without letting any go below zero.
+func (u *QuotaUsage) Subtract(amount sdkmath.Int) {
+	for i := len(u.Buckets) - 1; i >= 0 && amount.IsPositive(); i-- {
+		taken := sdkmath.MinInt(amount, u.Buckets[i].Amount)
+		u.Buckets[i].Amount = u.Buckets[i].Amount.Sub(taken)
+		amount = amount.Sub(taken)
+	}
+}
@@ -0,0 +1,173 @@
+package ibcratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func TestParseFlowDirection(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		expected ibcratelimit.FlowDirection
+		err      string
+	}{
+		{name: "success - send", str: "send", expected: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND},
+		{name: "success - recv upper case", str: "RECV", expected: ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV},
+		{name: "success - full name", str: "FLOW_DIRECTION_SEND", expected: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND},
+		{name: "failure - unknown", str: "sideways", err: "unknown flow direction \"sideways\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			direction, err := ibcratelimit.ParseFlowDirection(tc.str)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should return the correct error")
+			} else {
+				assert.NoError(t, err, "should not return an error")
+			}
+			assert.Equal(t, tc.expected, direction, "should return the correct direction")
+		})
+	}
+}
+
+func TestQuotaValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		quota ibcratelimit.Quota
+		err   string
+	}{
+		{
+			name:  "success - valid quota",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "2.5"),
+		},
+		{
+			name:  "success - max percent of 100",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_RECV, time.Hour, "100"),
+		},
+		{
+			name:  "failure - invalid channel",
+			quota: ibcratelimit.NewQuota("", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "2.5"),
+			err:   "invalid channel id: identifier cannot be blank: invalid identifier",
+		},
+		{
+			name:  "failure - invalid denom",
+			quota: ibcratelimit.NewQuota("channel-0", "1", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "2.5"),
+			err:   "invalid denom: invalid denom: 1",
+		},
+		{
+			name:  "failure - unspecified direction",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_UNSPECIFIED, time.Hour, "2.5"),
+			err:   "flow direction must be specified",
+		},
+		{
+			name:  "failure - window too short",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, 9, "2.5"),
+			err:   "invalid window 9ns: must be at least 10ns",
+		},
+		{
+			name:  "failure - bad max percent",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "lots"),
+			err:   "invalid max percent \"lots\": failed to set decimal string with base 10: lots000000000000000000",
+		},
+		{
+			name:  "failure - zero max percent",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "0"),
+			err:   "invalid max percent \"0\": must be greater than 0 and at most 100",
+		},
+		{
+			name:  "failure - max percent over 100",
+			quota: ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "100.1"),
+			err:   "invalid max percent \"100.1\": must be greater than 0 and at most 100",
+		},
+		{
+			name:  "success - priority percent",
+			quota: ibcratelimit.Quota{ChannelId: "channel-0", Denom: "nhash", Direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, Window: time.Hour, MaxPercent: "5", PriorityPercent: "1.5"},
+		},
+		{
+			name:  "success - priority percent equals max percent",
+			quota: ibcratelimit.Quota{ChannelId: "channel-0", Denom: "nhash", Direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, Window: time.Hour, MaxPercent: "5", PriorityPercent: "5"},
+		},
+		{
+			name:  "failure - invalid priority percent",
+			quota: ibcratelimit.Quota{ChannelId: "channel-0", Denom: "nhash", Direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, Window: time.Hour, MaxPercent: "5", PriorityPercent: "some"},
+			err:   "invalid priority percent \"some\"",
+		},
+		{
+			name:  "failure - negative priority percent",
+			quota: ibcratelimit.Quota{ChannelId: "channel-0", Denom: "nhash", Direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, Window: time.Hour, MaxPercent: "5", PriorityPercent: "-1"},
+			err:   "invalid priority percent \"-1\": must be between 0 and the max percent \"5\"",
+		},
+		{
+			name:  "failure - priority percent above max percent",
+			quota: ibcratelimit.Quota{ChannelId: "channel-0", Denom: "nhash", Direction: ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, Window: time.Hour, MaxPercent: "5", PriorityPercent: "5.1"},
+			err:   "invalid priority percent \"5.1\": must be between 0 and the max percent \"5\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if len(tc.err) > 0 {
+				assert.ErrorContains(t, err, tc.err, "should return the correct error")
+			} else {
+				assert.NoError(t, err, "should not return an error")
+			}
+		})
+	}
+}
+
+func TestQuotaGetLimit(t *testing.T) {
+	quota := ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "2.5")
+	limit, err := quota.GetLimit(sdkmath.NewInt(1_001))
+	require.NoError(t, err, "GetLimit")
+	assert.Equal(t, sdkmath.NewInt(25), limit, "should truncate the limit")
+	assert.Equal(t, 6*time.Minute, quota.GetBucketSize(), "GetBucketSize")
+
+	publicLimit, err := quota.GetPublicLimit(sdkmath.NewInt(1_001))
+	require.NoError(t, err, "GetPublicLimit without priority percent")
+	assert.Equal(t, limit, publicLimit, "public limit without priority percent")
+
+	quota.PriorityPercent = "1"
+	limit, err = quota.GetLimit(sdkmath.NewInt(1_001))
+	require.NoError(t, err, "GetLimit with priority percent")
+	assert.Equal(t, sdkmath.NewInt(25), limit, "limit with priority percent")
+	publicLimit, err = quota.GetPublicLimit(sdkmath.NewInt(1_001))
+	require.NoError(t, err, "GetPublicLimit with priority percent")
+	assert.Equal(t, sdkmath.NewInt(15), publicLimit, "public limit with priority percent")
+}
+
+func TestQuotaUsage(t *testing.T) {
+	quota := ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, 10*time.Minute, "10")
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	usage := ibcratelimit.NewQuotaUsage(quota)
+	assert.True(t, usage.GetTotal().IsZero(), "new usage total")
+
+	usage.Add(start, quota.GetBucketSize(), sdkmath.NewInt(5))
+	usage.Add(start.Add(30*time.Second), quota.GetBucketSize(), sdkmath.NewInt(7))
+	usage.Add(start.Add(time.Minute), quota.GetBucketSize(), sdkmath.NewInt(3))
+	require.Len(t, usage.Buckets, 2, "buckets after adds")
+	assert.Equal(t, sdkmath.NewInt(12), usage.Buckets[0].Amount, "first bucket amount")
+	assert.Equal(t, sdkmath.NewInt(15), usage.GetTotal(), "total after adds")
+	assert.NoError(t, usage.Validate(), "Validate after adds")
+
+	usage.Subtract(sdkmath.NewInt(4))
+	assert.Equal(t, "0", usage.Buckets[1].Amount.String(), "newest bucket after subtract")
+	assert.Equal(t, sdkmath.NewInt(11), usage.Buckets[0].Amount, "oldest bucket after subtract")
+
+	usage.Prune(start.Add(30 * time.Second))
+	require.Len(t, usage.Buckets, 1, "buckets after prune")
+	assert.Equal(t, start.Add(time.Minute), usage.Buckets[0].Start, "remaining bucket start")
+
+	usage.Buckets = append(usage.Buckets, ibcratelimit.FlowBucket{Start: start, Amount: sdkmath.OneInt()})
+	assert.EqualError(t, usage.Validate(), "invalid bucket 1 start: buckets must be ordered oldest first", "Validate out of order")
+	usage.Buckets[1] = ibcratelimit.FlowBucket{Start: start.Add(time.Hour), Amount: sdkmath.NewInt(-1)}
+	assert.EqualError(t, usage.Validate(), "invalid bucket 1 amount: must not be negative", "Validate negative")
+}
@@ -22,6 +22,27 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("Params: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.QuotaKeyPrefix):
+			var quotaA, quotaB ibcratelimit.Quota
+
+			cdc.MustUnmarshal(kvA.Value, &quotaA)
+			cdc.MustUnmarshal(kvB.Value, &quotaB)
+
+			return fmt.Sprintf("Quota: A:[%v] B:[%v]\n", quotaA, quotaB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.QuotaUsageKeyPrefix):
+			var usageA, usageB ibcratelimit.QuotaUsage
+
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+
+			return fmt.Sprintf("QuotaUsage: A:[%v] B:[%v]\n", usageA, usageB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.PauseKeyPrefix):
+			var pauseA, pauseB ibcratelimit.Pause
+
+			cdc.MustUnmarshal(kvA.Value, &pauseA)
+			cdc.MustUnmarshal(kvB.Value, &pauseB)
+
+			return fmt.Sprintf("Pause: A:[%v] B:[%v]\n", pauseA, pauseB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", ibcratelimit.ModuleName, kvA.Key, kvA.Key))
 		}
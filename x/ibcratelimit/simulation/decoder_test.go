@@ -2,6 +2,7 @@ package simulation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -20,6 +21,12 @@ func TestDecodeStore(t *testing.T) {
 		p := ibcratelimit.NewParams("contract a")
 		return cdc.MustMarshal(&p)
 	}
+	quota := ibcratelimit.NewQuota("channel-0", "nhash", ibcratelimit.FlowDirection_FLOW_DIRECTION_SEND, time.Hour, "5")
+	usage := ibcratelimit.NewQuotaUsage(quota)
+	quotaKey := ibcratelimit.GetQuotaKey(quota.ChannelId, quota.Denom, quota.Direction)
+	usageKey := ibcratelimit.GetQuotaUsageKey(quota.ChannelId, quota.Denom, quota.Direction)
+	pause := ibcratelimit.Pause{ChannelId: "channel-0", Guardian: "guardian"}
+	pauseKey := ibcratelimit.GetPauseKey(pause.ChannelId, pause.Denom)
 
 	tests := []struct {
 		name     string
@@ -38,7 +45,25 @@ func TestDecodeStore(t *testing.T) {
 			name: "success - ParamsKey",
 			kvA:  kv.Pair{Key: ibcratelimit.ParamsKey, Value: params("contract a")},
 			kvB:  kv.Pair{Key: ibcratelimit.ParamsKey, Value: params("contract b")},
-			exp:  "Params: A:[{contract a}] B:[{contract a}]\n",
+			exp:  "Params: A:[{contract a [] 0s}] B:[{contract a [] 0s}]\n",
+		},
+		{
+			name: "success - QuotaKeyPrefix",
+			kvA:  kv.Pair{Key: quotaKey, Value: cdc.MustMarshal(&quota)},
+			kvB:  kv.Pair{Key: quotaKey, Value: cdc.MustMarshal(&quota)},
+			exp:  "Quota: A:[{channel-0 nhash FLOW_DIRECTION_SEND 1h0m0s 5}] B:[{channel-0 nhash FLOW_DIRECTION_SEND 1h0m0s 5}]\n",
+		},
+		{
+			name: "success - QuotaUsageKeyPrefix",
+			kvA:  kv.Pair{Key: usageKey, Value: cdc.MustMarshal(&usage)},
+			kvB:  kv.Pair{Key: usageKey, Value: cdc.MustMarshal(&usage)},
+			exp:  "QuotaUsage: A:[{channel-0 nhash FLOW_DIRECTION_SEND []}] B:[{channel-0 nhash FLOW_DIRECTION_SEND []}]\n",
+		},
+		{
+			name: "success - PauseKeyPrefix",
+			kvA:  kv.Pair{Key: pauseKey, Value: cdc.MustMarshal(&pause)},
+			kvB:  kv.Pair{Key: pauseKey, Value: cdc.MustMarshal(&pause)},
+			exp:  "Pause: A:[{channel-0  guardian <nil>}] B:[{channel-0  guardian <nil>}]\n",
 		},
 	}
 
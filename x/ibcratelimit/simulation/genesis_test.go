@@ -66,7 +66,10 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     0,
 			accounts: nil,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams(""),
+				Params: ibcratelimit.Params{ContractAddress: "", Guardians: []string{}},
+				Quotas: []ibcratelimit.Quota{},
+				Usages: []ibcratelimit.QuotaUsage{},
+				Pauses: []ibcratelimit.Pause{},
 			},
 		},
 		{
@@ -74,7 +77,10 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     1,
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams(""),
+				Params: ibcratelimit.Params{ContractAddress: "", Guardians: []string{}},
+				Quotas: []ibcratelimit.Quota{},
+				Usages: []ibcratelimit.QuotaUsage{},
+				Pauses: []ibcratelimit.Pause{},
 			},
 		},
 		{
@@ -82,7 +88,10 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     2,
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
-				Params: ibcratelimit.NewParams("cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl"),
+				Params: ibcratelimit.Params{ContractAddress: "cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl", Guardians: []string{}},
+				Quotas: []ibcratelimit.Quota{},
+				Usages: []ibcratelimit.QuotaUsage{},
+				Pauses: []ibcratelimit.Pause{},
 			},
 		},
 	}
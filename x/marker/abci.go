@@ -49,4 +49,16 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
+
+	// Apply any scheduled changes to markers' required attributes that have reached their effective height.
+	k.ApplyDuePolicyChanges(ctx)
+
+	// Flag the reserve attestations whose validity windows have ended.
+	k.MarkStaleReserveAttestations(ctx)
+
+	// Remove the swap offers that were not accepted before they expired.
+	k.RemoveExpiredSwapOffers(ctx)
+
+	// Return the held transfers that were not acknowledged by their recipients before they expired.
+	k.ReturnExpiredPendingReceipts(ctx)
 }
@@ -0,0 +1,247 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerBootstrapSpec defines a new marker and everything needed to set it up.
+// It is read from the spec file provided to the bootstrap command.
+type MarkerBootstrapSpec struct {
+	// Supply is the total supply coin of the new marker, e.g. "1000hotdog".
+	Supply string `json:"supply"`
+	// MarkerType is either COIN or RESTRICTED. Defaults to COIN.
+	MarkerType string `json:"marker_type,omitempty"`
+	// SupplyFixed indicates that the supply is fixed.
+	SupplyFixed bool `json:"supply_fixed,omitempty"`
+	// AllowGovernanceControl indicates that governance control is allowed.
+	AllowGovernanceControl bool `json:"allow_governance_control,omitempty"`
+	// AllowForcedTransfer indicates that forced transfers are allowed.
+	AllowForcedTransfer bool `json:"allow_forced_transfer,omitempty"`
+	// RequiredAttributes are the attributes needed for a restricted marker to have send authority.
+	RequiredAttributes []string `json:"required_attributes,omitempty"`
+	// AccessGrants are the access grants to add to the marker.
+	AccessGrants []MarkerBootstrapAccessGrant `json:"access_grants,omitempty"`
+	// DenomMetadata is optional bank metadata for the marker's denom.
+	DenomMetadata *banktypes.Metadata `json:"denom_metadata,omitempty"`
+	// NetAssetValues are optional net asset values to record for the marker.
+	NetAssetValues []MarkerBootstrapNetAssetValue `json:"net_asset_values,omitempty"`
+}
+
+// MarkerBootstrapAccessGrant is an access grant entry in a MarkerBootstrapSpec.
+type MarkerBootstrapAccessGrant struct {
+	// Address is the bech32 address to grant access to.
+	Address string `json:"address"`
+	// Permissions are the names of the permissions to grant, e.g. "mint" or "admin".
+	Permissions []string `json:"permissions"`
+}
+
+// MarkerBootstrapNetAssetValue is a net asset value entry in a MarkerBootstrapSpec.
+type MarkerBootstrapNetAssetValue struct {
+	// Price is the coin paid for the volume, e.g. "1000usd" (in mils).
+	Price string `json:"price"`
+	// Volume is the number of marker tokens equivalent in value to the price.
+	Volume uint64 `json:"volume"`
+}
+
+// ReadMarkerBootstrapSpec reads and validates a MarkerBootstrapSpec from the provided YAML (or JSON) file.
+func ReadMarkerBootstrapSpec(filename string) (*MarkerBootstrapSpec, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read spec file %q: %w", filename, err)
+	}
+	spec := &MarkerBootstrapSpec{}
+	if err = yaml.UnmarshalStrict(bz, spec); err != nil {
+		return nil, fmt.Errorf("could not parse spec file %q: %w", filename, err)
+	}
+	if err = spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid spec file %q: %w", filename, err)
+	}
+	return spec, nil
+}
+
+// Validate makes sure the spec is internally consistent.
+func (s MarkerBootstrapSpec) Validate() error {
+	supply, err := s.GetSupply()
+	if err != nil {
+		return err
+	}
+	markerType, err := s.GetMarkerType()
+	if err != nil {
+		return err
+	}
+	if s.AllowForcedTransfer && markerType != types.MarkerType_RestrictedCoin {
+		return errors.New("forced transfer is only available for restricted markers")
+	}
+	if len(s.RequiredAttributes) > 0 && markerType != types.MarkerType_RestrictedCoin {
+		return errors.New("required attributes are reserved for restricted markers")
+	}
+
+	grants, err := s.GetAccessGrants()
+	if err != nil {
+		return err
+	}
+	if err = types.ValidateGrants(grants...); err != nil {
+		return fmt.Errorf("invalid access grants: %w", err)
+	}
+	seenAddrs := make(map[string]bool)
+	for _, grant := range grants {
+		if seenAddrs[grant.Address] {
+			return fmt.Errorf("invalid access grants: %w: %s", types.ErrDuplicateAccessEntry, grant.Address)
+		}
+		seenAddrs[grant.Address] = true
+	}
+
+	if s.DenomMetadata != nil {
+		if s.DenomMetadata.Base != supply.Denom {
+			return fmt.Errorf("denom metadata base %q does not match supply denom %q", s.DenomMetadata.Base, supply.Denom)
+		}
+		if err = types.ValidateDenomMetadataBasic(*s.DenomMetadata); err != nil {
+			return fmt.Errorf("invalid denom metadata: %w", err)
+		}
+	}
+
+	navs, err := s.GetNetAssetValues()
+	if err != nil {
+		return err
+	}
+	seenDenoms := make(map[string]bool)
+	for _, nav := range navs {
+		if nav.Price.Denom == supply.Denom {
+			return fmt.Errorf("net asset value price denom cannot be the marker denom %q", supply.Denom)
+		}
+		if seenDenoms[nav.Price.Denom] {
+			return fmt.Errorf("net asset values contain duplicate price denom %q", nav.Price.Denom)
+		}
+		seenDenoms[nav.Price.Denom] = true
+	}
+
+	return nil
+}
+
+// GetSupply parses the spec's supply into a coin.
+func (s MarkerBootstrapSpec) GetSupply() (sdk.Coin, error) {
+	if len(strings.TrimSpace(s.Supply)) == 0 {
+		return sdk.Coin{}, errors.New("supply cannot be empty")
+	}
+	supply, err := sdk.ParseCoinNormalized(s.Supply)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("invalid supply %q: %w", s.Supply, err)
+	}
+	return supply, nil
+}
+
+// GetMarkerType converts the spec's marker type into a MarkerType.
+func (s MarkerBootstrapSpec) GetMarkerType() (types.MarkerType, error) {
+	if len(s.MarkerType) == 0 {
+		return types.MarkerType_Coin, nil
+	}
+	markerType := types.MarkerType(types.MarkerType_value["MARKER_TYPE_"+strings.ToUpper(strings.TrimSpace(s.MarkerType))])
+	if markerType < 1 {
+		return types.MarkerType_Unknown, fmt.Errorf("invalid marker type: %s; expected COIN|RESTRICTED", s.MarkerType)
+	}
+	return markerType, nil
+}
+
+// GetAccessGrants converts the spec's access grant entries into AccessGrants.
+func (s MarkerBootstrapSpec) GetAccessGrants() ([]types.AccessGrant, error) {
+	grants := make([]types.AccessGrant, len(s.AccessGrants))
+	for i, entry := range s.AccessGrants {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid access_grants[%d] address %q: %w", i, entry.Address, err)
+		}
+		if len(entry.Permissions) == 0 {
+			return nil, fmt.Errorf("access_grants[%d] for %s must have at least one permission", i, entry.Address)
+		}
+		perms := make(types.AccessList, len(entry.Permissions))
+		for j, name := range entry.Permissions {
+			perms[j] = types.AccessByName(name)
+			if perms[j] == types.Access_Unknown {
+				return nil, fmt.Errorf("invalid access_grants[%d] permission %q", i, name)
+			}
+		}
+		grants[i] = *types.NewAccessGrant(addr, perms)
+	}
+	return grants, nil
+}
+
+// GetNetAssetValues converts the spec's net asset value entries into NetAssetValues.
+func (s MarkerBootstrapSpec) GetNetAssetValues() ([]types.NetAssetValue, error) {
+	navs := make([]types.NetAssetValue, len(s.NetAssetValues))
+	for i, entry := range s.NetAssetValues {
+		price, err := sdk.ParseCoinNormalized(entry.Price)
+		if err != nil {
+			return nil, fmt.Errorf("invalid net_asset_values[%d] price %q: %w", i, entry.Price, err)
+		}
+		navs[i] = types.NewNetAssetValue(price, entry.Volume)
+		if err = navs[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid net_asset_values[%d]: %w", i, err)
+		}
+	}
+	return navs, nil
+}
+
+// Msgs validates the spec and returns the msgs needed to set up the marker, all signed by the provided authority.
+// The authority is the marker's manager while it's being set up. The msgs are, in order:
+// AddMarker, AddAccess (one for each access grant), SetDenomMetadata, Finalize, Activate, and AddNetAssetValues.
+// The denom metadata is set before finalizing so that the initial entry doesn't have the active marker restrictions.
+func (s MarkerBootstrapSpec) Msgs(authority sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// These were all checked in Validate, so the errors can be ignored here.
+	supply, _ := s.GetSupply()
+	markerType, _ := s.GetMarkerType()
+	grants, _ := s.GetAccessGrants()
+	navs, _ := s.GetNetAssetValues()
+
+	if len(navs) > 0 {
+		isGov := authority.Equals(authtypes.NewModuleAddress(govtypes.ModuleName))
+		hasGrant := len(types.GrantsForAddress(authority, grants...).GetAccessList()) > 0
+		if !hasGrant && !(isGov && s.AllowGovernanceControl) {
+			return nil, fmt.Errorf("%s must be given an access grant in order to add net asset values", authority)
+		}
+	}
+
+	msgs := make([]sdk.Msg, 0, len(grants)+5)
+	msgs = append(msgs, types.NewMsgAddMarkerRequest(
+		supply.Denom, supply.Amount, authority, authority, markerType,
+		s.SupplyFixed, s.AllowGovernanceControl, s.AllowForcedTransfer, s.RequiredAttributes, 0, 0,
+	))
+	for _, grant := range grants {
+		msgs = append(msgs, types.NewMsgAddAccessRequest(supply.Denom, authority, grant))
+	}
+	if s.DenomMetadata != nil {
+		msgs = append(msgs, types.NewSetDenomMetadataRequest(*s.DenomMetadata, authority))
+	}
+	msgs = append(msgs,
+		types.NewMsgFinalizeRequest(supply.Denom, authority),
+		types.NewMsgActivateRequest(supply.Denom, authority),
+	)
+	if len(navs) > 0 {
+		msgs = append(msgs, types.NewMsgAddNetAssetValuesRequest(supply.Denom, authority.String(), navs))
+	}
+
+	for i, msg := range msgs {
+		if vb, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := vb.ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("invalid msgs[%d] %s: %w", i, sdk.MsgTypeURL(msg), err)
+			}
+		}
+	}
+
+	return msgs, nil
+}
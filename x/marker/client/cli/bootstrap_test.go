@@ -0,0 +1,342 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	markercli "github.com/provenance-io/provenance/x/marker/client/cli"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReadMarkerBootstrapSpec(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	dir := t.TempDir()
+	writeFile := func(name, contents string) string {
+		filename := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(filename, []byte(contents), 0o600), "WriteFile(%q)", name)
+		return filename
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		expSpec  *markercli.MarkerBootstrapSpec
+		expInErr []string
+	}{
+		{
+			name:     "file does not exist",
+			filename: filepath.Join(dir, "missing.yaml"),
+			expInErr: []string{"could not read spec file"},
+		},
+		{
+			name:     "unknown field",
+			filename: writeFile("unknown.yaml", "supply: 10hotdog\nsuply_fixed: true\n"),
+			expInErr: []string{`unknown field "suply_fixed"`},
+		},
+		{
+			name:     "invalid spec",
+			filename: writeFile("invalid.yaml", "supply: 10hotdog\nmarker_type: nope\n"),
+			expInErr: []string{"invalid marker type: nope; expected COIN|RESTRICTED"},
+		},
+		{
+			name: "full spec",
+			filename: writeFile("full.yaml", `supply: 1000hotdog
+marker_type: RESTRICTED
+supply_fixed: true
+allow_governance_control: true
+allow_forced_transfer: true
+required_attributes: [kyc.provenance.io]
+access_grants:
+  - address: `+addr+`
+    permissions: [mint, admin]
+denom_metadata:
+  description: A coin for hotdogs.
+  base: hotdog
+  display: kilohotdog
+  name: Hotdog
+  symbol: HOTDOG
+  denom_units:
+    - denom: hotdog
+      exponent: 0
+    - denom: kilohotdog
+      exponent: 3
+net_asset_values:
+  - price: 1000usd
+    volume: 1
+`),
+			expSpec: &markercli.MarkerBootstrapSpec{
+				Supply:                 "1000hotdog",
+				MarkerType:             "RESTRICTED",
+				SupplyFixed:            true,
+				AllowGovernanceControl: true,
+				AllowForcedTransfer:    true,
+				RequiredAttributes:     []string{"kyc.provenance.io"},
+				AccessGrants: []markercli.MarkerBootstrapAccessGrant{
+					{Address: addr, Permissions: []string{"mint", "admin"}},
+				},
+				DenomMetadata: &banktypes.Metadata{
+					Description: "A coin for hotdogs.",
+					Base:        "hotdog",
+					Display:     "kilohotdog",
+					Name:        "Hotdog",
+					Symbol:      "HOTDOG",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: "hotdog", Exponent: 0},
+						{Denom: "kilohotdog", Exponent: 3},
+					},
+				},
+				NetAssetValues: []markercli.MarkerBootstrapNetAssetValue{{Price: "1000usd", Volume: 1}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			spec, err := markercli.ReadMarkerBootstrapSpec(tc.filename)
+			assertions.AssertErrorContents(t, err, tc.expInErr, "ReadMarkerBootstrapSpec error")
+			assert.Equal(t, tc.expSpec, spec, "ReadMarkerBootstrapSpec spec")
+		})
+	}
+}
+
+func TestMarkerBootstrapSpecValidate(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name     string
+		spec     markercli.MarkerBootstrapSpec
+		expInErr []string
+	}{
+		{
+			name:     "empty supply",
+			spec:     markercli.MarkerBootstrapSpec{},
+			expInErr: []string{"supply cannot be empty"},
+		},
+		{
+			name:     "invalid supply",
+			spec:     markercli.MarkerBootstrapSpec{Supply: "hotdog"},
+			expInErr: []string{`invalid supply "hotdog"`},
+		},
+		{
+			name:     "invalid marker type",
+			spec:     markercli.MarkerBootstrapSpec{Supply: "10hotdog", MarkerType: "bad"},
+			expInErr: []string{"invalid marker type: bad; expected COIN|RESTRICTED"},
+		},
+		{
+			name:     "forced transfer on coin",
+			spec:     markercli.MarkerBootstrapSpec{Supply: "10hotdog", AllowForcedTransfer: true},
+			expInErr: []string{"forced transfer is only available for restricted markers"},
+		},
+		{
+			name:     "required attributes on coin",
+			spec:     markercli.MarkerBootstrapSpec{Supply: "10hotdog", RequiredAttributes: []string{"a.b"}},
+			expInErr: []string{"required attributes are reserved for restricted markers"},
+		},
+		{
+			name: "invalid access grant address",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:       "10hotdog",
+				AccessGrants: []markercli.MarkerBootstrapAccessGrant{{Address: "bad", Permissions: []string{"mint"}}},
+			},
+			expInErr: []string{`invalid access_grants[0] address "bad"`},
+		},
+		{
+			name: "access grant without permissions",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:       "10hotdog",
+				AccessGrants: []markercli.MarkerBootstrapAccessGrant{{Address: addr}},
+			},
+			expInErr: []string{"access_grants[0] for " + addr + " must have at least one permission"},
+		},
+		{
+			name: "unknown permission",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:       "10hotdog",
+				AccessGrants: []markercli.MarkerBootstrapAccessGrant{{Address: addr, Permissions: []string{"eat"}}},
+			},
+			expInErr: []string{`invalid access_grants[0] permission "eat"`},
+		},
+		{
+			name: "duplicate access grant address",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply: "10hotdog",
+				AccessGrants: []markercli.MarkerBootstrapAccessGrant{
+					{Address: addr, Permissions: []string{"mint"}},
+					{Address: addr, Permissions: []string{"burn"}},
+				},
+			},
+			expInErr: []string{"invalid access grants: access list contains duplicate entry: " + addr},
+		},
+		{
+			name: "metadata base mismatch",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:        "10hotdog",
+				DenomMetadata: &banktypes.Metadata{Base: "other"},
+			},
+			expInErr: []string{`denom metadata base "other" does not match supply denom "hotdog"`},
+		},
+		{
+			name: "invalid metadata",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:        "10hotdog",
+				DenomMetadata: &banktypes.Metadata{Base: "hotdog"},
+			},
+			expInErr: []string{"invalid denom metadata"},
+		},
+		{
+			name: "invalid nav price",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:         "10hotdog",
+				NetAssetValues: []markercli.MarkerBootstrapNetAssetValue{{Price: "usd", Volume: 1}},
+			},
+			expInErr: []string{`invalid net_asset_values[0] price "usd"`},
+		},
+		{
+			name: "nav without volume",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:         "10hotdog",
+				NetAssetValues: []markercli.MarkerBootstrapNetAssetValue{{Price: "5usd"}},
+			},
+			expInErr: []string{"invalid net_asset_values[0]: marker net asset value volume must be positive value"},
+		},
+		{
+			name: "nav in marker denom",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:         "10hotdog",
+				NetAssetValues: []markercli.MarkerBootstrapNetAssetValue{{Price: "5hotdog", Volume: 1}},
+			},
+			expInErr: []string{`net asset value price denom cannot be the marker denom "hotdog"`},
+		},
+		{
+			name: "duplicate nav denom",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply: "10hotdog",
+				NetAssetValues: []markercli.MarkerBootstrapNetAssetValue{
+					{Price: "5usd", Volume: 1},
+					{Price: "7usd", Volume: 2},
+				},
+			},
+			expInErr: []string{`net asset values contain duplicate price denom "usd"`},
+		},
+		{
+			name: "minimal",
+			spec: markercli.MarkerBootstrapSpec{Supply: "10hotdog"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.spec.Validate()
+			assertions.AssertErrorContents(t, err, tc.expInErr, "Validate")
+		})
+	}
+}
+
+func TestMarkerBootstrapSpecMsgs(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	other := sdk.AccAddress("other_______________")
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	md := &banktypes.Metadata{
+		Base:    "hotdog",
+		Display: "kilohotdog",
+		Name:    "Hotdog",
+		Symbol:  "HOTDOG",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "hotdog", Exponent: 0},
+			{Denom: "kilohotdog", Exponent: 3},
+		},
+	}
+	mintGrant := markercli.MarkerBootstrapAccessGrant{Address: signer.String(), Permissions: []string{"mint", "admin"}}
+	otherGrant := markercli.MarkerBootstrapAccessGrant{Address: other.String(), Permissions: []string{"burn"}}
+	navs := []markercli.MarkerBootstrapNetAssetValue{{Price: "1000usd", Volume: 3}}
+
+	tests := []struct {
+		name      string
+		spec      markercli.MarkerBootstrapSpec
+		authority sdk.AccAddress
+		expMsgs   []sdk.Msg
+		expInErr  []string
+	}{
+		{
+			name:      "invalid spec",
+			spec:      markercli.MarkerBootstrapSpec{},
+			authority: signer,
+			expInErr:  []string{"supply cannot be empty"},
+		},
+		{
+			name:      "navs without signer grant",
+			spec:      markercli.MarkerBootstrapSpec{Supply: "10hotdog", AccessGrants: []markercli.MarkerBootstrapAccessGrant{otherGrant}, NetAssetValues: navs},
+			authority: signer,
+			expInErr:  []string{signer.String() + " must be given an access grant in order to add net asset values"},
+		},
+		{
+			name:      "navs from gov without gov control",
+			spec:      markercli.MarkerBootstrapSpec{Supply: "10hotdog", NetAssetValues: navs},
+			authority: govAddr,
+			expInErr:  []string{govAddr.String() + " must be given an access grant in order to add net asset values"},
+		},
+		{
+			name:      "minimal",
+			spec:      markercli.MarkerBootstrapSpec{Supply: "10hotdog"},
+			authority: signer,
+			expMsgs: []sdk.Msg{
+				types.NewMsgAddMarkerRequest("hotdog", sdkmath.NewInt(10), signer, signer, types.MarkerType_Coin, false, false, false, nil, 0, 0),
+				types.NewMsgFinalizeRequest("hotdog", signer),
+				types.NewMsgActivateRequest("hotdog", signer),
+			},
+		},
+		{
+			name: "everything",
+			spec: markercli.MarkerBootstrapSpec{
+				Supply:              "10hotdog",
+				MarkerType:          "restricted",
+				SupplyFixed:         true,
+				AllowForcedTransfer: true,
+				AccessGrants:        []markercli.MarkerBootstrapAccessGrant{mintGrant, otherGrant},
+				DenomMetadata:       md,
+				NetAssetValues:      navs,
+			},
+			authority: signer,
+			expMsgs: []sdk.Msg{
+				types.NewMsgAddMarkerRequest("hotdog", sdkmath.NewInt(10), signer, signer, types.MarkerType_RestrictedCoin, true, false, true, nil, 0, 0),
+				types.NewMsgAddAccessRequest("hotdog", signer, *types.NewAccessGrant(signer, types.AccessList{types.Access_Mint, types.Access_Admin})),
+				types.NewMsgAddAccessRequest("hotdog", signer, *types.NewAccessGrant(other, types.AccessList{types.Access_Burn})),
+				types.NewSetDenomMetadataRequest(*md, signer),
+				types.NewMsgFinalizeRequest("hotdog", signer),
+				types.NewMsgActivateRequest("hotdog", signer),
+				types.NewMsgAddNetAssetValuesRequest("hotdog", signer.String(), []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1000), 3)}),
+			},
+		},
+		{
+			name:      "gov prop with navs",
+			spec:      markercli.MarkerBootstrapSpec{Supply: "10hotdog", AllowGovernanceControl: true, NetAssetValues: navs},
+			authority: govAddr,
+			expMsgs: []sdk.Msg{
+				types.NewMsgAddMarkerRequest("hotdog", sdkmath.NewInt(10), govAddr, govAddr, types.MarkerType_Coin, false, true, false, nil, 0, 0),
+				types.NewMsgFinalizeRequest("hotdog", govAddr),
+				types.NewMsgActivateRequest("hotdog", govAddr),
+				types.NewMsgAddNetAssetValuesRequest("hotdog", govAddr.String(), []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1000), 3)}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := tc.spec.Msgs(tc.authority)
+			assertions.AssertErrorContents(t, err, tc.expInErr, "Msgs")
+			assert.Equal(t, tc.expMsgs, msgs, "Msgs")
+		})
+	}
+}
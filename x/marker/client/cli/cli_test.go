@@ -3,6 +3,8 @@ package cli_test
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -128,6 +130,18 @@ func (s *IntegrationTestSuite) SetupSuite() {
 			bal(markertypes.MustGetMarkerAddress("grantcoin"), coin(5000, s.cfg.BondDenom)),
 		)
 
+		bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, banktypes.Metadata{
+			Description: "hotdog",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "hotdog", Exponent: 0},
+				{Denom: "kilohotdog", Exponent: 3},
+			},
+			Base:    "hotdog",
+			Display: "kilohotdog",
+			Name:    "Hotdog",
+			Symbol:  "HOTDOG",
+		})
+
 		return bankGenState
 	})
 
@@ -458,7 +472,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000"}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000","creation_deposit":"0","creation_deposit_timeout_blocks":"100800"}`,
 		},
 		{
 			"get testcoin marker json",
@@ -467,7 +481,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"8","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[],"issuer_managed_flags":false}}`,
 		},
 		{
 			"get testcoin marker test",
@@ -487,6 +501,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
     pub_key: null
     sequence: "0"
   denom: testcoin
+  issuer_managed_flags: false
   manager: ""
   marker_type: MARKER_TYPE_COIN
   required_attributes: []
@@ -509,7 +524,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[]}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"9","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"allow_forced_transfer":false,"required_attributes":[],"issuer_managed_flags":false}}`,
 		},
 		{
 			"get restricted coin marker with forced transfer",
@@ -529,6 +544,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
     pub_key: null
     sequence: "0"
   denom: ` + s.holderDenom + `
+  issuer_managed_flags: false
   manager: ""
   marker_type: MARKER_TYPE_RESTRICTED
   required_attributes: []
@@ -584,6 +600,70 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestValidateProposalQueryCommand() {
+	dir := s.T().TempDir()
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	writeProp := func(name string, msgs ...sdk.Msg) string {
+		msgsJSON := make([]string, len(msgs))
+		for i, msg := range msgs {
+			bz, err := s.cfg.Codec.MarshalInterfaceJSON(msg)
+			s.Require().NoError(err, "MarshalInterfaceJSON(msgs[%d])", i)
+			msgsJSON[i] = string(bz)
+		}
+		contents := fmt.Sprintf(`{"messages":[%s],"title":"%s","summary":"%s","deposit":"10stake"}`, strings.Join(msgsJSON, ","), name, name)
+		filename := filepath.Join(dir, name+".json")
+		s.Require().NoError(os.WriteFile(filename, []byte(contents), 0o600), "WriteFile(%q)", filename)
+		return filename
+	}
+
+	goodProp := writeProp("good", markertypes.NewMsgAddMarkerRequest("validatepropcoin", sdkmath.NewInt(100), govAddr, govAddr,
+		markertypes.MarkerType_Coin, false, true, false, nil, 0, 0))
+	badProp := writeProp("bad", markertypes.NewMsgAddMarkerRequest("testcoin", sdkmath.NewInt(100), govAddr, govAddr,
+		markertypes.MarkerType_Coin, false, true, false, nil, 0, 0))
+	emptyProp := writeProp("empty")
+
+	tests := []struct {
+		name      string
+		args      []string
+		expErr    string
+		expOutput string
+	}{
+		{
+			name:   "file does not exist",
+			args:   []string{filepath.Join(dir, "missing.json")},
+			expErr: "could not read proposal file",
+		},
+		{
+			name:   "no messages",
+			args:   []string{emptyProp},
+			expErr: fmt.Sprintf("proposal file %q does not have any messages", emptyProp),
+		},
+		{
+			name:      "problems found",
+			args:      []string{badProp, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expOutput: `{"error":"msgs[0] /provenance.marker.v1.MsgAddMarkerRequest: a marker already exists for denom testcoin","gov_prop_will_pass":false}`,
+		},
+		{
+			name:      "will pass",
+			args:      []string{goodProp, fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
+			expOutput: `{"error":"","gov_prop_will_pass":true}`,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, markercli.ValidateProposalCmd(), tc.args)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "ValidateProposalCmd error")
+				return
+			}
+			s.Require().NoError(err, "ValidateProposalCmd error")
+			s.Assert().Equal(tc.expOutput, strings.TrimSpace(out.String()), "ValidateProposalCmd output")
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 	testCases := []struct {
 		name         string
@@ -720,6 +800,45 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"mint supply in display units",
+			markercli.GetCmdMint(),
+			[]string{
+				"0.1kilohotdog",
+				fmt.Sprintf("--%s", markercli.FlagDisplayUnits),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"burn supply in display units",
+			markercli.GetCmdBurn(),
+			[]string{
+				"0.1kilohotdog",
+				fmt.Sprintf("--%s", markercli.FlagDisplayUnits),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"fail to mint supply in display units, not a whole number of base units",
+			markercli.GetCmdMint(),
+			[]string{
+				"0.0001kilohotdog",
+				fmt.Sprintf("--%s", markercli.FlagDisplayUnits),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 		{
 			"finalize",
 			markercli.GetCmdFinalize(),
@@ -1674,6 +1793,120 @@ func (s *IntegrationTestSuite) TestGetCmdAddNetAssetValues() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestBootstrapMarkerTxCommand() {
+	dir := s.T().TempDir()
+	writeSpec := func(name, contents string) string {
+		filename := filepath.Join(dir, name)
+		s.Require().NoError(os.WriteFile(filename, []byte(contents), 0o600), "WriteFile(%q)", name)
+		return filename
+	}
+	argsWStdFlags := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		)
+	}
+
+	bootSpec := writeSpec("bootcoin.yaml", `supply: 1000bootcoin
+marker_type: RESTRICTED
+supply_fixed: true
+access_grants:
+  - address: `+s.testnet.Validators[0].Address.String()+`
+    permissions: [mint, burn, admin, withdraw, deposit, transfer]
+  - address: `+s.accountAddresses[1].String()+`
+    permissions: [burn]
+denom_metadata:
+  description: A coin for bootstrapping.
+  base: bootcoin
+  display: kilobootcoin
+  name: Bootcoin
+  symbol: BOOT
+  denom_units:
+    - denom: bootcoin
+      exponent: 0
+    - denom: kilobootcoin
+      exponent: 3
+net_asset_values:
+  - price: 1000usd
+    volume: 1
+`)
+	govSpec := writeSpec("govbootcoin.yaml", `supply: 500govbootcoin
+allow_governance_control: true
+net_asset_values:
+  - price: 1000usd
+    volume: 5
+`)
+	noGrantSpec := writeSpec("nograntcoin.yaml", `supply: 500nograntcoin
+net_asset_values:
+  - price: 1000usd
+    volume: 5
+`)
+	badSpec := writeSpec("bad.yaml", "supply: 500badcoin\nmarker_type: bad\n")
+
+	tests := []struct {
+		name    string
+		args    []string
+		expErr  string
+		expCode uint32
+	}{
+		{
+			name:   "invalid spec",
+			args:   argsWStdFlags(badSpec),
+			expErr: fmt.Sprintf("invalid spec file %q: invalid marker type: bad; expected COIN|RESTRICTED", badSpec),
+		},
+		{
+			name:   "navs without signer grant",
+			args:   argsWStdFlags(noGrantSpec),
+			expErr: s.testnet.Validators[0].Address.String() + " must be given an access grant in order to add net asset values",
+		},
+		{
+			name: "successful",
+			args: argsWStdFlags(bootSpec, fmt.Sprintf("--%s=%d", flags.FlagGas, 1_000_000)),
+		},
+		{
+			name: "successful gov prop",
+			args: argsWStdFlags(govSpec,
+				"--"+markercli.FlagGovProposal,
+				"--title", "bootstrap govbootcoin",
+				"--summary", "bootstrap govbootcoin",
+				"--deposit=1000000stake",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(markercli.GetCmdBootstrapMarker(), tc.args).
+				WithExpErrMsg(tc.expErr).
+				WithExpCode(tc.expCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+
+	s.Run("bootstrapped marker is active with its supply", func() {
+		markerAddr := markertypes.MustGetMarkerAddress("bootcoin").String()
+		balances := queries.GetAllBalances(s.T(), s.testnet, markerAddr)
+		s.Assert().Equal("1000bootcoin", balances.String(), "bootcoin marker balances")
+	})
+
+	s.Run("gov prop has all the msgs", func() {
+		prop := queries.GetLastGovProp(s.T(), s.testnet)
+		msgTypes := make([]string, len(prop.Messages))
+		for i, msg := range prop.Messages {
+			msgTypes[i] = msg.TypeUrl
+		}
+		expTypes := []string{
+			sdk.MsgTypeURL(&markertypes.MsgAddMarkerRequest{}),
+			sdk.MsgTypeURL(&markertypes.MsgFinalizeRequest{}),
+			sdk.MsgTypeURL(&markertypes.MsgActivateRequest{}),
+			sdk.MsgTypeURL(&markertypes.MsgAddNetAssetValuesRequest{}),
+		}
+		s.Assert().Equal(expTypes, msgTypes, "gov prop msg types")
+	})
+}
+
 func (s *IntegrationTestSuite) TestParseAccessGrantFromString() {
 	testCases := []struct {
 		name              string
@@ -1717,6 +1950,16 @@ func (s *IntegrationTestSuite) TestParseAccessGrantFromString() {
 			expPanic:          false,
 			expResult:         []types.AccessGrant{{Address: s.accountAddresses[0].String(), Permissions: []markertypes.Access{markertypes.Access_Mint}}},
 		},
+		{
+			name:              "should succeed to add access type and role",
+			accessGrantString: fmt.Sprintf("%s,mint,role:treasury;", s.accountAddresses[0].String()),
+			expPanic:          false,
+			expResult: []types.AccessGrant{{
+				Address:     s.accountAddresses[0].String(),
+				Permissions: []markertypes.Access{markertypes.Access_Mint},
+				Roles:       []string{markertypes.RoleTreasury},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -2136,6 +2379,120 @@ func TestParseBoolStrict(t *testing.T) {
 	}
 }
 
+func TestParseAttributeRevocationAction(t *testing.T) {
+	tests := []struct {
+		input  string
+		exp    types.AttributeRevocationAction
+		expErr bool
+	}{
+		{input: "none", exp: types.AttributeRevocationAction_None},
+		{input: "deny-send", exp: types.AttributeRevocationAction_DenySend},
+		{input: " DENY_SEND ", exp: types.AttributeRevocationAction_DenySend},
+		{input: "Freeze", exp: types.AttributeRevocationAction_Freeze},
+		{input: "ATTRIBUTE_REVOCATION_ACTION_UNSPECIFIED", exp: types.AttributeRevocationAction_None},
+		{input: "attribute_revocation_action_deny_send", exp: types.AttributeRevocationAction_DenySend},
+		{input: "ATTRIBUTE_REVOCATION_ACTION_FREEZE", exp: types.AttributeRevocationAction_Freeze},
+		{input: "", expErr: true},
+		{input: "deny", expErr: true},
+		{input: "1", expErr: true},
+	}
+
+	for _, tc := range tests {
+		name := tc.input
+		if len(name) == 0 {
+			name = "empty"
+		}
+		t.Run(name, func(t *testing.T) {
+			actual, err := markercli.ParseAttributeRevocationAction(tc.input)
+			if tc.expErr {
+				exp := fmt.Sprintf("invalid attribute revocation action: %q", tc.input)
+				assert.EqualError(t, err, exp, "ParseAttributeRevocationAction(%q) error", tc.input)
+			} else {
+				assert.NoError(t, err, "ParseAttributeRevocationAction(%q) error", tc.input)
+			}
+			assert.Equal(t, tc.exp, actual, "ParseAttributeRevocationAction(%q) value", tc.input)
+		})
+	}
+}
+
+func TestParseDustPolicy(t *testing.T) {
+	tests := []struct {
+		input  string
+		exp    types.DustPolicy
+		expErr bool
+	}{
+		{input: "truncate-to-issuer", exp: types.DustPolicy_TruncateToIssuer},
+		{input: "largest-remainder", exp: types.DustPolicy_LargestRemainder},
+		{input: " LARGEST_REMAINDER ", exp: types.DustPolicy_LargestRemainder},
+		{input: "Burn", exp: types.DustPolicy_Burn},
+		{input: "DUST_POLICY_UNSPECIFIED", exp: types.DustPolicy_TruncateToIssuer},
+		{input: "dust_policy_largest_remainder", exp: types.DustPolicy_LargestRemainder},
+		{input: "DUST_POLICY_BURN", exp: types.DustPolicy_Burn},
+		{input: "", expErr: true},
+		{input: "truncate", expErr: true},
+		{input: "2", expErr: true},
+	}
+
+	for _, tc := range tests {
+		name := tc.input
+		if len(name) == 0 {
+			name = "empty"
+		}
+		t.Run(name, func(t *testing.T) {
+			actual, err := markercli.ParseDustPolicy(tc.input)
+			if tc.expErr {
+				exp := fmt.Sprintf("invalid dust policy: %q", tc.input)
+				assert.EqualError(t, err, exp, "ParseDustPolicy(%q) error", tc.input)
+			} else {
+				assert.NoError(t, err, "ParseDustPolicy(%q) error", tc.input)
+			}
+			assert.Equal(t, tc.exp, actual, "ParseDustPolicy(%q) value", tc.input)
+		})
+	}
+}
+
+func TestFormatCanSendResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *types.QueryCanSendResponse
+		exp      string
+	}{
+		{
+			name:     "allowed",
+			response: &types.QueryCanSendResponse{Allowed: true},
+			exp:      "The send would be allowed by the marker module.\n",
+		},
+		{
+			name: "denied",
+			response: &types.QueryCanSendResponse{
+				Denials: []types.SendDenial{
+					{Reason: "cannot withdraw from marker account", Hints: []string{"use the withdraw command"}},
+					{
+						Denom:  "hotdogcoin",
+						Reason: "address does not contain the required attributes",
+						Hints:  []string{"recipient missing attribute kyc.acme.io", "recipient missing attribute *.dog"},
+					},
+					{Denom: "catcoin", Reason: "marker status (proposed) is not active"},
+				},
+			},
+			exp: "The send would be denied by the marker module:\n" +
+				"- cannot withdraw from marker account\n" +
+				"    hint: use the withdraw command\n" +
+				"- hotdogcoin: address does not contain the required attributes\n" +
+				"    hint: recipient missing attribute kyc.acme.io\n" +
+				"    hint: recipient missing attribute *.dog\n" +
+				"- catcoin: marker status (proposed) is not active\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := markercli.FormatCanSendResponse(tc.response)
+			assert.Equal(t, tc.exp, actual, "FormatCanSendResponse")
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestSupplyDecreaseProposal() {
 	testCases := []struct {
 		name         string
@@ -2471,6 +2828,49 @@ func (s *IntegrationTestSuite) TestWithdrawEscrowProposal() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSeizeEscrowProposal() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - submit seize escrow proposal",
+			args:         []string{"mycoin", s.accountAddresses[1].String()},
+			expectedCode: 0,
+			signer:       s.testnet.Validators[0].Address.String(),
+		},
+		{
+			name:         "failure - invalid recovery address",
+			args:         []string{"mycoin", "invalidaddress"},
+			expectErrMsg: "invalid recovery address invalidaddress: decoding bech32 failed: invalid separator index -1",
+			signer:       s.testnet.Validators[0].Address.String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := markercli.GetCmdSeizeEscrowProposal()
+			tc.args = append(tc.args,
+				"--title", fmt.Sprintf("title: %v", tc.name),
+				"--summary", fmt.Sprintf("summary: %v", tc.name),
+				"--deposit=1000000stake",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
 func (s *IntegrationTestSuite) TestSetDenomMetadataProposal() {
 	testCases := []struct {
 		name         string
@@ -2601,6 +3001,52 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 			},
 			expectErr: `invalid max supply: "invalid"`,
 		},
+		{
+			name: "update marker params with creation deposit",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"--" + markercli.FlagCreationDeposit, "1000000000",
+				"--" + markercli.FlagCreationDepositTimeout, "100",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update marker params, should fail incorrect creation deposit",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"--" + markercli.FlagCreationDeposit, "invalid",
+			},
+			expectErr: `invalid creation deposit: "invalid"`,
+		},
+		{
+			name: "update marker params with role templates",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"--" + markercli.FlagRole, "issuer:mint,burn",
+				"--" + markercli.FlagRole, "treasury:deposit,withdraw",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update marker params, should fail invalid role template",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"--" + markercli.FlagRole, "issuer",
+			},
+			expectErr: `invalid role "issuer": expected format <name>:<permission>[,<permission>...]`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ReadAssetManifest reads and validates an AssetManifest from the provided JSON file.
+func ReadAssetManifest(cdc codec.JSONCodec, filename string) (*types.AssetManifest, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest file %q: %w", filename, err)
+	}
+	manifest := &types.AssetManifest{}
+	if err = cdc.UnmarshalJSON(bz, manifest); err != nil {
+		return nil, fmt.Errorf("could not parse manifest file %q: %w", filename, err)
+	}
+	if err = manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest file %q: %w", filename, err)
+	}
+	return manifest, nil
+}
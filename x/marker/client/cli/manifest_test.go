@@ -0,0 +1,117 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	markercli "github.com/provenance-io/provenance/x/marker/client/cli"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReadAssetManifest(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	addr := sdk.AccAddress("addr________________")
+	dir := t.TempDir()
+	writeFile := func(name, contents string) string {
+		filename := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(filename, []byte(contents), 0o600), "WriteFile(%q)", name)
+		return filename
+	}
+
+	tests := []struct {
+		name        string
+		filename    string
+		expManifest *types.AssetManifest
+		expInErr    []string
+	}{
+		{
+			name:     "file does not exist",
+			filename: filepath.Join(dir, "missing.json"),
+			expInErr: []string{"could not read manifest file"},
+		},
+		{
+			name:     "unknown field",
+			filename: writeFile("unknown.json", `{"denom":"hotdog","suply":"10"}`),
+			expInErr: []string{"could not parse manifest file", "suply"},
+		},
+		{
+			name:     "invalid manifest",
+			filename: writeFile("invalid.json", `{"denom":"hotdog","supply":"10","marker_type":"MARKER_TYPE_COIN"}`),
+			expInErr: []string{"invalid manifest file", "access grants cannot be empty"},
+		},
+		{
+			name: "full manifest",
+			filename: writeFile("full.json", `{
+  "denom": "hotdog",
+  "supply": "1000",
+  "marker_type": "MARKER_TYPE_RESTRICTED",
+  "supply_fixed": true,
+  "allow_governance_control": true,
+  "allow_forced_transfer": true,
+  "issuer_managed_flags": false,
+  "required_attributes": ["kyc.provenance.io"],
+  "access_grants": [
+    {"address": "`+addr.String()+`", "permissions": ["ACCESS_MINT", "ACCESS_ADMIN"]}
+  ],
+  "denom_metadata": {
+    "description": "A coin for hotdogs.",
+    "denom_units": [
+      {"denom": "hotdog", "exponent": 0},
+      {"denom": "kilohotdog", "exponent": 3}
+    ],
+    "base": "hotdog",
+    "display": "kilohotdog",
+    "name": "Hotdog",
+    "symbol": "HOTDOG",
+    "uri": "",
+    "uri_hash": ""
+  },
+  "net_asset_values": [
+    {"price": {"denom": "usd", "amount": "1000"}, "volume": "1", "updated_block_height": "0"}
+  ]
+}`),
+			expManifest: &types.AssetManifest{
+				Denom:                  "hotdog",
+				Supply:                 sdkmath.NewInt(1000),
+				MarkerType:             types.MarkerType_RestrictedCoin,
+				SupplyFixed:            true,
+				AllowGovernanceControl: true,
+				AllowForcedTransfer:    true,
+				RequiredAttributes:     []string{"kyc.provenance.io"},
+				AccessGrants:           []types.AccessGrant{*types.NewAccessGrant(addr, types.AccessList{types.Access_Mint, types.Access_Admin})},
+				DenomMetadata: &banktypes.Metadata{
+					Description: "A coin for hotdogs.",
+					DenomUnits: []*banktypes.DenomUnit{
+						{Denom: "hotdog", Exponent: 0},
+						{Denom: "kilohotdog", Exponent: 3},
+					},
+					Base:    "hotdog",
+					Display: "kilohotdog",
+					Name:    "Hotdog",
+					Symbol:  "HOTDOG",
+				},
+				NetAssetValues: []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1000), 1)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest, err := markercli.ReadAssetManifest(cdc, tc.filename)
+			assertions.AssertErrorContents(t, err, tc.expInErr, "ReadAssetManifest error")
+			assert.Equal(t, tc.expManifest, manifest, "ReadAssetManifest manifest")
+		})
+	}
+}
@@ -2,13 +2,18 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -33,6 +38,34 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		MarkerHierarchyCmd(),
+		ValidateProposalCmd(),
+		ERC20PointersCmd(),
+		ERC20PointerByContractCmd(),
+		BridgeCmd(),
+		BridgeNonceCmd(),
+		DenyListMarkersCmd(),
+		PendingAdminGrantsCmd(),
+		ScheduledPolicyChangesCmd(),
+		ReserveStatusCmd(),
+		ReserveAttestationsCmd(),
+		DustPolicyCmd(),
+		ReqAttrBypassAddrsCmd(),
+		HoldersExportCmd(),
+		ExplainDenialCmd(),
+		TransferRestrictionCmd(),
+		TransferRestrictionMessageCmd(),
+		AssetManifestCmd(),
+		SwapOfferCmd(),
+		SwapOffersCmd(),
+		IssuanceTranchesCmd(),
+		SubLedgerCmd(),
+		BridgedSupplyCmd(),
+		SupplyChangePolicyCmd(),
+		ReceiptPolicyCmd(),
+		PendingReceiptsCmd(),
+		ContractSupplyCapsCmd(),
+		PortfolioValuationCmd(),
 	)
 	return queryCmd
 }
@@ -319,11 +352,15 @@ func NetAssetValuesCmd() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 			id := strings.TrimSpace(args[0])
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			var response *types.QueryNetAssetValuesResponse
 			if response, err = queryClient.NetAssetValues(
 				context.Background(),
-				&types.QueryNetAssetValuesRequest{Id: id},
+				&types.QueryNetAssetValuesRequest{Id: id, Pagination: pageReq},
 			); err != nil {
 				fmt.Printf("failed to query marker %q net asset values details: %v\n", id, err)
 				return nil
@@ -332,5 +369,987 @@ func NetAssetValuesCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "net asset values")
+	return cmd
+}
+
+// MarkerHierarchyCmd is the CLI command for querying the parent and child markers of a marker.
+func MarkerHierarchyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "hierarchy [address|denom]",
+		Aliases: []string{"parents", "children"},
+		Short:   "Get the parent and child markers of a marker",
+		Long: `A parent is a marker whose account has been granted access on the requested marker.
+A child is a marker that the requested marker's account has been granted access on.`,
+		Example: fmt.Sprintf(`$ %s query marker hierarchy "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.MarkerHierarchy(context.Background(), &types.QueryMarkerHierarchyRequest{Id: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "child markers")
+	return cmd
+}
+
+// ERC20PointersCmd is the CLI command for querying the ERC-20 contracts that represent a marker on external chains.
+func ERC20PointersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "erc20-pointers [address|denom]",
+		Aliases: []string{"erc20-pointer", "erc20"},
+		Short:   "Get the ERC-20 contracts that represent a marker on external chains",
+		Example: fmt.Sprintf(`$ %s query marker erc20-pointers "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.ERC20Pointers(context.Background(), &types.QueryERC20PointersRequest{Id: id, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "erc20 pointers")
+	return cmd
+}
+
+// ERC20PointerByContractCmd is the CLI command for querying the marker pointer of an ERC-20 contract on an external chain.
+func ERC20PointerByContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "erc20-contract <chain id> <contract address>",
+		Aliases: []string{"erc20-by-contract"},
+		Short:   "Get the marker pointer of an ERC-20 contract on an external chain",
+		Example: fmt.Sprintf(`$ %s query marker erc20-contract eip155:1 0x1c7d4b196cb0c7b01d743fbc6116a902379c7238`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ERC20PointerByContract(context.Background(), &types.QueryERC20PointerByContractRequest{
+				ChainId:         strings.TrimSpace(args[0]),
+				ContractAddress: strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ValidateProposalCmd is the CLI command for checking the msgs of a draft governance proposal.
+func ValidateProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "validate-proposal <proposal-file>",
+		Aliases: []string{"validate-prop", "dry-run-proposal"},
+		Short:   "Check the msgs of a draft governance proposal against the current state",
+		Long: strings.TrimSpace(`Check the msgs of a draft governance proposal against the current state.
+The proposal file has the same format as the one used with the gov submit-proposal command.
+The msgs are checked (e.g. for denom collisions and access grants that aren't valid for the marker type)
+and then executed, in order, without committing anything. All problems found are reported so they can be
+fixed before a deposit is spent on the proposal.`),
+		Example: fmt.Sprintf(`$ %s query marker validate-proposal proposal.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := readProposalMsgs(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			req, err := types.NewQueryValidateProposalRequest(msgs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			response, err := queryClient.ValidateProposal(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readProposalMsgs reads the msgs from a gov proposal file.
+func readProposalMsgs(cdc codec.Codec, filename string) ([]sdk.Msg, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not read proposal file %q: %w", filename, err)
+	}
+
+	var prop struct {
+		Messages []json.RawMessage `json:"messages"`
+	}
+	if err = json.Unmarshal(bz, &prop); err != nil {
+		return nil, fmt.Errorf("could not parse proposal file %q: %w", filename, err)
+	}
+	if len(prop.Messages) == 0 {
+		return nil, fmt.Errorf("proposal file %q does not have any messages", filename)
+	}
+
+	msgs := make([]sdk.Msg, len(prop.Messages))
+	for i, raw := range prop.Messages {
+		if err = cdc.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("could not parse proposal file %q messages[%d]: %w", filename, i, err)
+		}
+	}
+	return msgs, nil
+}
+
+// BridgeCmd is the CLI command for querying a marker bridge.
+func BridgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bridge <name>",
+		Short:   "Get a bridge that can mint and burn a marker's coin",
+		Example: fmt.Sprintf(`$ %s query marker bridge cctp`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.Bridge(context.Background(), &types.QueryBridgeRequest{Name: strings.TrimSpace(args[0])})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// BridgeNonceCmd is the CLI command for querying whether a marker bridge nonce has been used.
+func BridgeNonceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bridge-nonce <name> <nonce>",
+		Short:   "Get whether a bridge nonce has been used",
+		Example: fmt.Sprintf(`$ %s query marker bridge-nonce cctp 42`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid nonce %q: %w", args[1], err)
+			}
+
+			response, err := queryClient.BridgeNonce(context.Background(), &types.QueryBridgeNonceRequest{
+				Name:  strings.TrimSpace(args[0]),
+				Nonce: nonce,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DenyListMarkersCmd is the CLI command for querying the markers that have an address in their send deny list.
+func DenyListMarkersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deny-list-markers <address>",
+		Aliases: []string{"denied-by"},
+		Short:   "List the markers that have an address in their send deny list",
+		Example: fmt.Sprintf(`$ %s query marker deny-list-markers pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.DenyListMarkers(context.Background(), &types.QueryDenyListMarkersRequest{
+				Address:    strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingAdminGrantsCmd is the CLI command for querying the proposed grants of admin access on a marker.
+func PendingAdminGrantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-admins <address|denom>",
+		Short:   "Get the proposed grants of admin access on a marker that have not been accepted yet",
+		Example: fmt.Sprintf(`$ %s query marker pending-admins hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.PendingAdminGrants(context.Background(), &types.QueryPendingAdminGrantsRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending admin grants")
+	return cmd
+}
+
+// ScheduledPolicyChangesCmd is the CLI command for querying the changes to a marker's required attributes that have
+// not taken effect yet.
+func ScheduledPolicyChangesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scheduled-changes <address|denom>",
+		Short:   "Get the changes to a marker's required attributes that have not taken effect yet",
+		Example: fmt.Sprintf(`$ %s query marker scheduled-changes hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.ScheduledPolicyChanges(context.Background(), &types.QueryScheduledPolicyChangesRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled changes")
+	return cmd
+}
+
+// ReserveStatusCmd is the CLI command for querying a marker's reserve requirement and how well its supply is backed.
+func ReserveStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reserves <address|denom>",
+		Short:   "Get a marker's reserve requirement and how well its supply is currently backed",
+		Example: fmt.Sprintf(`$ %s query marker reserves wrappedusd`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ReserveStatus(context.Background(), &types.QueryReserveStatusRequest{
+				Id: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DustPolicyCmd is the CLI command for querying what a marker does with the dust left over from pro-rata operations.
+func DustPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dust-policy <address|denom>",
+		Short:   "Get what a marker does with the dust left over from pro-rata operations",
+		Example: fmt.Sprintf(`$ %s query marker dust-policy hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.DustPolicy(context.Background(), &types.QueryDustPolicyRequest{
+				Id: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ReserveAttestationsCmd is the CLI command for querying a marker's approved reserve attestors and their attestations.
+func ReserveAttestationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reserve-attestations <address|denom>",
+		Aliases: []string{"attestations"},
+		Short:   "Get a marker's approved reserve attestors and their latest attestations",
+		Example: fmt.Sprintf(`$ %s query marker reserve-attestations wrappedusd`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.ReserveAttestations(context.Background(), &types.QueryReserveAttestationsRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reserve attestations")
+	return cmd
+}
+
+// ReqAttrBypassAddrsCmd is the CLI command for querying the addresses that bypass the required attributes checking.
+func ReqAttrBypassAddrsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "req-attr-bypass",
+		Aliases: []string{"bypass"},
+		Short:   "Get the addresses that bypass the required attributes checking of restricted markers",
+		Example: fmt.Sprintf(`$ %s query marker req-attr-bypass`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.ReqAttrBypassAddrs(context.Background(), &types.QueryReqAttrBypassAddrsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bypass addresses")
+	return cmd
+}
+
+// HoldersExportCmd is the CLI command for exporting the accounts holding a marker's coin.
+func HoldersExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export-holders <address|denom>",
+		Aliases: []string{"holders-export"},
+		Short:   "Export the accounts holding a marker's coin in address order",
+		Long: strings.TrimSpace(fmt.Sprintf(`Export the accounts holding a marker's coin in address order.
+Unless --%[1]s is provided, pages have %[2]d holders (at most %[3]d are allowed).
+With --%[4]s, every page is requested (at the height of the first one) and printed on its own line.`,
+			flags.FlagLimit, types.DefaultHoldersExportLimit, types.MaxHoldersExportLimit, FlagAll)),
+		Example: strings.TrimSpace(fmt.Sprintf(`$ %[1]s query marker export-holders nhash --%[2]s 10000
+$ %[1]s query marker export-holders nhash --%[3]s -o json > holders.jsonl`,
+			version.AppName, flags.FlagLimit, FlagAll)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(flags.FlagLimit) {
+				// Let the query use its own (larger) default.
+				pageReq.Limit = 0
+			}
+			all, err := cmd.Flags().GetBool(FlagAll)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryHoldersExportRequest{Id: strings.TrimSpace(args[0]), Pagination: pageReq}
+			for {
+				queryClient := types.NewQueryClient(clientCtx)
+				response, err := queryClient.HoldersExport(context.Background(), req)
+				if err != nil {
+					return err
+				}
+				if err = clientCtx.PrintProto(response); err != nil {
+					return err
+				}
+				if !all || response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+					return nil
+				}
+				// Get the rest of the pages at the same height so that they're all from the same state.
+				clientCtx = clientCtx.WithHeight(response.Height)
+				req.Pagination.Key = response.Pagination.NextKey
+				req.Pagination.Offset = 0
+				req.Pagination.CountTotal = false
+			}
+		},
+	}
+
+	cmd.Flags().Bool(FlagAll, false, "request every page and print each one on its own line")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+	return cmd
+}
+
+// ExplainDenialCmd is the CLI command for explaining why the marker module would deny a send.
+func ExplainDenialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "explain-denial <from address> <to address> <amount>",
+		Aliases: []string{"can-send"},
+		Short:   "Explain why the marker module would deny a send",
+		Long: strings.TrimSpace(`Simulate the marker module's send restrictions for a send of the amount from one address to another.
+Each reason the send would be denied is printed along with hints on what could be done about it.
+Only the marker module's restrictions are checked (e.g. balances are not).`),
+		Example: fmt.Sprintf(`$ %s query marker explain-denial pb1skjw... pb1sh49... 10hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.CanSend(context.Background(), &types.QueryCanSendRequest{
+				FromAddress: strings.TrimSpace(args[0]),
+				ToAddress:   strings.TrimSpace(args[1]),
+				Amount:      strings.TrimSpace(args[2]),
+			})
+			if err != nil {
+				return err
+			}
+			if clientCtx.OutputFormat != "text" {
+				return clientCtx.PrintProto(response)
+			}
+			return clientCtx.PrintString(FormatCanSendResponse(response))
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// FormatCanSendResponse returns a human-readable explanation of a CanSend query response.
+func FormatCanSendResponse(response *types.QueryCanSendResponse) string {
+	if response.Allowed || len(response.Denials) == 0 {
+		return "The send would be allowed by the marker module.\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("The send would be denied by the marker module:\n")
+	for _, denial := range response.Denials {
+		if len(denial.Denom) > 0 {
+			sb.WriteString(fmt.Sprintf("- %s: %s\n", denial.Denom, denial.Reason))
+		} else {
+			sb.WriteString(fmt.Sprintf("- %s\n", denial.Reason))
+		}
+		for _, hint := range denial.Hints {
+			sb.WriteString(fmt.Sprintf("    hint: %s\n", hint))
+		}
+	}
+	return sb.String()
+}
+
+// TransferRestrictionCmd is the CLI command for getting the ERC-1404 style restriction code of a send.
+func TransferRestrictionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-restriction <from address> <to address> <amount>",
+		Aliases: []string{"detect-transfer-restriction", "restriction-code"},
+		Short:   "Get the ERC-1404 style restriction code of a send",
+		Long: strings.TrimSpace(`Get the ERC-1404 style restriction code for a send of a single coin from one address to another.
+A code of 0 means the send is not restricted. The sender's spendable balance is also checked.`),
+		Example: fmt.Sprintf(`$ %s query marker transfer-restriction pb1skjw... pb1sh49... 10hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.DetectTransferRestriction(context.Background(), &types.QueryDetectTransferRestrictionRequest{
+				FromAddress: strings.TrimSpace(args[0]),
+				ToAddress:   strings.TrimSpace(args[1]),
+				Amount:      strings.TrimSpace(args[2]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferRestrictionMessageCmd is the CLI command for getting the message of an ERC-1404 style restriction code.
+func TransferRestrictionMessageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-restriction-message <code>",
+		Aliases: []string{"restriction-message"},
+		Short:   "Get the message of an ERC-1404 style restriction code",
+		Example: fmt.Sprintf(`$ %s query marker transfer-restriction-message 9`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			code, err := strconv.ParseUint(strings.TrimSpace(args[0]), 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid code %q: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.TransferRestrictionMessage(context.Background(), &types.QueryTransferRestrictionMessageRequest{
+				Code: uint32(code),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AssetManifestCmd is the CLI command for exporting a marker's setup as an asset manifest.
+func AssetManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "manifest <address|denom>",
+		Aliases: []string{"asset-manifest", "export-manifest"},
+		Short:   "Export a marker's setup as an asset manifest",
+		Long: strings.TrimSpace(`Export a marker's setup as an asset manifest.
+The manifest has the marker's denom, supply, type, flags, required attributes, access grants,
+denom metadata, and net asset values. Use --output json to get a manifest file that can be
+imported on another chain using the import-manifest tx command.`),
+		Example: fmt.Sprintf(`$ %s query marker manifest hotdog --output json > hotdog.json`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.AssetManifest(context.Background(), &types.QueryAssetManifestRequest{
+				Id: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response.Manifest)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SwapOfferCmd is the CLI command for querying a swap offer.
+func SwapOfferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-offer <offer id>",
+		Short:   "Get a swap offer that has not been accepted, canceled, or expired yet",
+		Example: fmt.Sprintf(`$ %s query marker swap-offer 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			offerID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id %q: %w", args[0], err)
+			}
+
+			response, err := queryClient.SwapOffer(context.Background(), &types.QuerySwapOfferRequest{OfferId: offerID})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SwapOffersCmd is the CLI command for querying all swap offers.
+func SwapOffersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "swap-offers",
+		Short:   "Get all swap offers that have not been accepted, canceled, or expired yet",
+		Example: fmt.Sprintf(`$ %s query marker swap-offers`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.SwapOffers(context.Background(), &types.QuerySwapOffersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "swap offers")
+	return cmd
+}
+
+// IssuanceTranchesCmd is the CLI command for querying the issuance history of a marker.
+func IssuanceTranchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tranches <address|denom>",
+		Short:   "Get the issuance history of a marker, one tranche for each mint",
+		Example: fmt.Sprintf(`$ %s query marker tranches hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.IssuanceTranches(context.Background(), &types.QueryIssuanceTranchesRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tranches")
+	return cmd
+}
+
+// SubLedgerCmd is the CLI command for querying the sub-ledger of a custodian.
+func SubLedgerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sub-ledger <custodian> [sub-account]",
+		Short: "Get the entries in a custodian's sub-ledger, optionally limited to one sub-account",
+		Example: fmt.Sprintf(`$ %[1]s query marker sub-ledger tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx
+$ %[1]s query marker sub-ledger tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx client-42`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySubLedgerRequest{
+				Custodian:  strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			}
+			if len(args) > 1 {
+				req.SubAccount = args[1]
+			}
+
+			response, err := queryClient.SubLedger(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sub-ledger entries")
+	return cmd
+}
+
+// BridgedSupplyCmd is the CLI command for querying how a bridged marker's supply is reconciled and the latest result.
+func BridgedSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bridged-supply <address|denom>",
+		Short:   "Get a bridged marker's reconciliation settings and the result of its latest reconciliation",
+		Example: fmt.Sprintf(`$ %s query marker bridged-supply bridgedatom`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.BridgedSupply(context.Background(), &types.QueryBridgedSupplyRequest{
+				Id: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SupplyChangePolicyCmd is the CLI command for querying a marker's supply change policy.
+func SupplyChangePolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-change-policy <address|denom>",
+		Short:   "Get how much a marker's supply can still change in the current block without governance",
+		Example: fmt.Sprintf(`$ %s query marker supply-change-policy hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.SupplyChangePolicy(context.Background(), &types.QuerySupplyChangePolicyRequest{
+				Id: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ReceiptPolicyCmd is the CLI command for querying a marker's receipt policy.
+func ReceiptPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receipt-policy <address|denom>",
+		Short:   "Get how many blocks the recipients of a marker's coins have to acknowledge a transfer",
+		Example: fmt.Sprintf(`$ %s query marker receipt-policy hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			response, err := queryClient.ReceiptPolicy(context.Background(), &types.QueryReceiptPolicyRequest{
+				Id: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingReceiptsCmd is the CLI command for querying the transfers waiting to be acknowledged by an account.
+func PendingReceiptsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-receipts <address>",
+		Short:   "Get the transfers to an account that are waiting for it to acknowledge them",
+		Example: fmt.Sprintf(`$ %s query marker pending-receipts pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.PendingReceipts(context.Background(), &types.QueryPendingReceiptsRequest{
+				Address:    strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending receipts")
+	return cmd
+}
+
+// ContractSupplyCapsCmd is the CLI command for querying the daily mint and burn caps of a marker's contracts.
+func ContractSupplyCapsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-supply-caps <address|denom>",
+		Short:   "Get how much each capped smart contract can mint or burn of a marker each day",
+		Example: fmt.Sprintf(`$ %s query marker contract-supply-caps hotdogcoin`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.ContractSupplyCaps(context.Background(), &types.QueryContractSupplyCapsRequest{
+				Id:         strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract supply caps")
+	return cmd
+}
+
+// PortfolioValuationCmd is the CLI command for valuing an address's holdings of marker denoms.
+func PortfolioValuationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "portfolio <address> [max-nav-age]",
+		Short: "Get the value of an address's holdings of marker denoms using the markers' net asset values",
+		Long: `Get the value of an address's holdings of marker denoms using the markers' net asset values.
+The totals have the combined value of the holdings in each price denom.
+If a max-nav-age is provided, net asset values last updated more than that many blocks ago are flagged as stale.`,
+		Example: fmt.Sprintf(`$ %[1]s query marker portfolio tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx
+$ %[1]s query marker portfolio tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx 14400`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPortfolioValuationRequest{Address: strings.TrimSpace(args[0])}
+			if len(args) > 1 {
+				req.MaxNavAge, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid max-nav-age %q: %w", args[1], err)
+				}
+			}
+
+			response, err := queryClient.PortfolioValuation(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -19,7 +21,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -56,6 +60,17 @@ const (
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagDisplayUnits           = "display-units"
+	FlagCreationDeposit        = "creation-deposit"
+	FlagCreationDepositTimeout = "creation-deposit-timeout"
+	FlagAll                    = "all"
+	FlagValidFrom              = "valid-from"
+	FlagTaker                  = "taker"
+	FlagTrancheLabel           = "tranche-label"
+	FlagTranchePrice           = "tranche-price"
+	FlagFromSubAccount         = "from-sub-account"
+	FlagToSubAccount           = "to-sub-account"
+	FlagRole                   = "role"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -96,7 +111,39 @@ func NewTxCmd() *cobra.Command {
 		GetCmdRemoveAdministratorProposal(),
 		GetCmdChangeStatusProposal(),
 		GetCmdWithdrawEscrowProposal(),
+		GetCmdSeizeEscrowProposal(),
 		GetUpdateMarkerParamsCmd(),
+		GetCmdExecuteAsParent(),
+		GetCmdBootstrapMarker(),
+		GetCmdImportAssetManifest(),
+		GetCmdSetERC20Pointer(),
+		GetCmdRemoveERC20Pointer(),
+		GetCmdSetBridge(),
+		GetCmdBridgeMint(),
+		GetCmdBridgeBurn(),
+		GetCmdSetIssuerManagedFlags(),
+		GetCmdUpdateMarkerFlags(),
+		GetCmdProposeAdmin(),
+		GetCmdAcceptAdmin(),
+		GetCmdCancelAdminProposal(),
+		GetCmdUpdateReqAttrBypassAddrs(),
+		GetCmdSetAttributeRevocationAction(),
+		GetCmdSetDustPolicy(),
+		GetCmdSchedulePolicyChange(),
+		GetCmdSetReserveRequirement(),
+		GetCmdSetReserveAttestors(),
+		GetCmdAttestReserves(),
+		GetCmdCancelPolicyChange(),
+		GetCmdSwap(),
+		GetCmdCreateSwapOffer(),
+		GetCmdAcceptSwapOffer(),
+		GetCmdCancelSwapOffer(),
+		GetCmdSetBridgedSupply(),
+		GetCmdSetSupplyChangePolicy(),
+		GetCmdSetReceiptPolicy(),
+		GetCmdAcknowledgeReceipt(),
+		GetCmdDeclineReceipt(),
+		GetCmdSetContractSupplyCap(),
 	)
 	return txCmd
 }
@@ -183,23 +230,45 @@ func GetCmdMint() *cobra.Command {
 		Short:   "Mint coins against the marker",
 		Long: strings.TrimSpace(`Mints coins of the marker's denomination and places them
 in the marker's account under escrow.  Caller must possess the mint permission and 
-marker must be in the active status.`),
-		Example: fmt.Sprintf(`$ %s tx marker mint 1000hotdogcoin --from mykey`, version.AppName),
+marker must be in the active status.  Each mint is recorded as an issuance tranche,
+optionally with a label and a price per unit.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker mint 1000hotdogcoin --from mykey
+$ %[1]s tx marker mint 1.5kilohotdogcoin --%[2]s --from mykey
+$ %[1]s tx marker mint 1000hotdogcoin --%[3]s "Series A" --%[4]s 25usd --from mykey`,
+			version.AppName, FlagDisplayUnits, FlagTrancheLabel, FlagTranchePrice),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			coin, err := sdk.ParseCoinNormalized(args[0])
+			coin, err := parseCoinArg(clientCtx, cmd.Flags(), args[0])
 			if err != nil {
-				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+				return err
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgMintRequest(callerAddr, coin)
+			msg.TrancheLabel, err = cmd.Flags().GetString(FlagTrancheLabel)
+			if err != nil {
+				return err
+			}
+			price, err := cmd.Flags().GetString(FlagTranchePrice)
+			if err != nil {
+				return err
+			}
+			if len(price) > 0 {
+				trancheCoin, perr := sdk.ParseCoinNormalized(price)
+				if perr != nil {
+					return fmt.Errorf("invalid %s %q: %w", FlagTranchePrice, price, perr)
+				}
+				msg.TranchePrice = &trancheCoin
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addDisplayUnitsFlag(cmd)
+	cmd.Flags().String(FlagTrancheLabel, "", "a name for the issuance round of this mint, e.g. \"Series A\"")
+	cmd.Flags().String(FlagTranchePrice, "", "the price of each unit minted in this issuance round, e.g. 25usd")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -215,22 +284,24 @@ func GetCmdBurn() *cobra.Command {
 with the coin's denomination.  Only coins held in the marker's account may be burned.  Caller
 must possess the burn permission.  Use the bank send operation to transfer coin into the marker
 for burning.  Marker must be in the active status to burn coin.`),
-		Example: fmt.Sprintf(`$ %s tx marker burn 1000hotdogcoin --from mykey`, version.AppName),
+		Example: fmt.Sprintf(`$ %[1]s tx marker burn 1000hotdogcoin --from mykey
+$ %[1]s tx marker burn 1.5kilohotdogcoin --%[2]s --from mykey`, version.AppName, FlagDisplayUnits),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			coin, err := sdk.ParseCoinNormalized(args[0])
+			coin, err := parseCoinArg(clientCtx, cmd.Flags(), args[0])
 			if err != nil {
-				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+				return err
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgBurnRequest(callerAddr, coin)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addDisplayUnitsFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -342,8 +413,10 @@ func GetCmdAddAccess() *cobra.Command {
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(`Grant administrative access to a marker.  From Address must have appropriate
 existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
-		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey`, version.AppName),
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer].  A role template defined
+in the marker params can be granted using role:<name>, e.g. role:treasury.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey
+$ %[1]s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom role:issuer,deposit --from mykey`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -354,7 +427,9 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 			if err != nil {
 				return cerrs.Wrapf(err, "grant for invalid address %s", args[0])
 			}
-			grant := types.NewAccessGrant(targetAddr, types.AccessListByNames(args[2]))
+			permissions, roles := types.AccessListAndRolesByNames(args[2])
+			grant := types.NewAccessGrant(targetAddr, permissions)
+			grant.Roles = roles
 			if err = grant.Validate(); err != nil {
 				return cerrs.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
@@ -412,9 +487,9 @@ func GetCmdWithdrawCoins() *cobra.Command {
 				return err
 			}
 			denom := args[0]
-			coins, err := sdk.ParseCoinsNormalized(args[1])
+			coins, err := parseCoinsArg(clientCtx, cmd.Flags(), args[1])
 			if err != nil {
-				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[0])
+				return err
 			}
 			callerAddr := clientCtx.GetFromAddress()
 			recipientAddr := sdk.AccAddress{}
@@ -428,6 +503,7 @@ func GetCmdWithdrawCoins() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addDisplayUnitsFlag(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -438,8 +514,12 @@ func GetNewTransferCmd() *cobra.Command {
 		Use:     "transfer [from] [to] [coins]",
 		Aliases: []string{"t"},
 		Short:   "Transfer coins from one account to another",
-		Example: fmt.Sprintf(`$ %s tx marker transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --from mykey`, version.AppName),
-		Args:    cobra.ExactArgs(3),
+		Long: strings.TrimSpace(`Transfers restricted coins from one account to another.  The transfer can be tagged
+with a sub-account of the from and/or to account, in which case it is recorded in that account's sub-ledger.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --from mykey
+$ %[1]s tx marker transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --%[2]s client-42 --from mykey`,
+			version.AppName, FlagToSubAccount),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -453,17 +533,31 @@ func GetNewTransferCmd() *cobra.Command {
 			if err != nil {
 				return cerrs.Wrapf(err, "invalid recipient address %s", args[1])
 			}
-			coins, err := sdk.ParseCoinsNormalized(args[2])
+			coins, err := parseCoinsArg(clientCtx, cmd.Flags(), args[2])
 			if err != nil {
-				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[2])
+				return err
 			}
 			if len(coins) != 1 {
 				return sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", args[2])
 			}
 			msg := types.NewMsgTransferRequest(clientCtx.GetFromAddress(), from, to, coins[0])
+			fromSubAccount, err := cmd.Flags().GetString(FlagFromSubAccount)
+			if err != nil {
+				return err
+			}
+			toSubAccount, err := cmd.Flags().GetString(FlagToSubAccount)
+			if err != nil {
+				return err
+			}
+			if len(fromSubAccount) > 0 || len(toSubAccount) > 0 {
+				msg.SubAccountTag = &types.SubAccountTag{FromSubAccount: fromSubAccount, ToSubAccount: toSubAccount}
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addDisplayUnitsFlag(cmd)
+	cmd.Flags().String(FlagFromSubAccount, "", "the sub-account of the from account that the coins are leaving")
+	cmd.Flags().String(FlagToSubAccount, "", "the sub-account of the to account that the coins are entering")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -888,6 +982,150 @@ with the given supply amount and denomination provided in the coin argument
 	return cmd
 }
 
+// GetCmdBootstrapMarker returns a CLI command for setting up a new marker from a spec file in a single tx.
+func GetCmdBootstrapMarker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bootstrap <spec-file>",
+		Aliases: []string{"boot"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create and set up a new marker from a spec file in a single transaction",
+		Long: strings.TrimSpace(`Create and set up a new marker from a YAML (or JSON) spec file in a single transaction.
+The tx will have, in order: an add marker msg, an add access msg for each access grant,
+a set denom metadata msg (if provided), a finalize msg, an activate msg, and an add
+net asset values msg (if provided). The signer is the marker's manager. If --gov-proposal
+is provided, the msgs are submitted in a gov proposal and the gov module account is the manager.
+
+Net asset values can only be added if the signer is given an access grant, or if this is
+a gov proposal and allow_governance_control is true.
+
+Example spec file:
+
+supply: 1000000hotdog
+marker_type: RESTRICTED
+supply_fixed: true
+allow_governance_control: true
+allow_forced_transfer: false
+required_attributes: [kyc.provenance.io]
+access_grants:
+  - address: pb1...
+    permissions: [mint, burn, admin, withdraw, deposit, transfer]
+denom_metadata:
+  description: A coin for hotdogs.
+  base: hotdog
+  display: kilohotdog
+  name: Hotdog
+  symbol: HOTDOG
+  denom_units:
+    - denom: hotdog
+      exponent: 0
+    - denom: kilohotdog
+      exponent: 3
+net_asset_values:
+  - price: 1000usd
+    volume: 1
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker bootstrap hotdog.yaml --gas auto --from mykey
+$ %[1]s tx marker bootstrap hotdog.yaml --%[2]s --deposit 50000nhash --from mykey`,
+			version.AppName, FlagGovProposal),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spec, err := ReadMarkerBootstrapSpec(args[0])
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			isGov, err := flagSet.GetBool(FlagGovProposal)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress()
+			if isGov {
+				authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+			}
+
+			msgs, err := spec.Msgs(authority)
+			if err != nil {
+				return err
+			}
+
+			if isGov {
+				return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msgs...)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msgs...)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdImportAssetManifest returns a CLI command for creating a marker from an asset manifest file in a single tx.
+func GetCmdImportAssetManifest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "import-manifest <manifest-file>",
+		Aliases: []string{"import"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Create a marker from an asset manifest file in a single transaction",
+		Long: strings.TrimSpace(`Create a marker from a JSON asset manifest file in a single transaction.
+A manifest file can be exported from an existing marker using the manifest query command.
+The tx will have, in order: an add marker msg, a set issuer managed flags msg (if needed),
+an add access msg for each access grant, a set denom metadata msg (if provided), a finalize msg,
+an activate msg, and an add net asset values msg (if provided). The signer is the marker's manager.
+If --gov-proposal is provided, the msgs are submitted in a gov proposal and the gov module account
+is the manager.
+
+Net asset values can only be added if the signer is given an access grant, or if this is
+a gov proposal and allow_governance_control is true.
+`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker import-manifest hotdog.json --gas auto --from mykey
+$ %[1]s tx marker import-manifest hotdog.json --%[2]s --deposit 50000nhash --from mykey`,
+			version.AppName, FlagGovProposal),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := ReadAssetManifest(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			isGov, err := flagSet.GetBool(FlagGovProposal)
+			if err != nil {
+				return err
+			}
+
+			authority := clientCtx.GetFromAddress()
+			if isGov {
+				authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+			}
+
+			msgs, err := manifest.Msgs(authority)
+			if err != nil {
+				return err
+			}
+
+			if isGov {
+				return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msgs...)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msgs...)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdUpdateRequiredAttributes implements the update required attributes command
 func GetCmdUpdateRequiredAttributes() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1050,6 +1288,72 @@ $ %[1]s tx marker account-data hotdogcoin --%[4]s`,
 	return cmd
 }
 
+// GetCmdSetERC20Pointer returns a CLI command for setting the ERC-20 contract that represents a marker on an external chain via governance proposal.
+func GetCmdSetERC20Pointer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-erc20-pointer <denom> <chain id> <contract address>",
+		Aliases: []string{"set-erc20", "erc20"},
+		Short:   "Set the ERC-20 contract that represents a marker on an external chain via governance proposal",
+		Long: strings.TrimSpace(`Submit a governance proposal to set the ERC-20 contract that represents a marker on an external chain.
+If the contract is already a pointer for a different marker on the chain, that marker's pointer is removed.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-erc20-pointer hotdogcoin eip155:1 0x1c7d4b196cb0c7b01d743fbc6116a902379c7238 --deposit 50000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			msg := &types.MsgSetERC20PointerRequest{
+				Denom:           strings.TrimSpace(args[0]),
+				ChainId:         strings.TrimSpace(args[1]),
+				ContractAddress: strings.TrimSpace(args[2]),
+				Signer:          provcli.GetAuthority(flagSet),
+			}
+
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveERC20Pointer returns a CLI command for removing the ERC-20 contract pointer of a marker for an external chain.
+func GetCmdRemoveERC20Pointer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-erc20-pointer <denom> <chain id>",
+		Aliases: []string{"remove-erc20", "rm-erc20"},
+		Short:   "Remove the ERC-20 contract pointer of a marker for an external chain",
+		Example: fmt.Sprintf(`$ %s tx marker remove-erc20-pointer hotdogcoin eip155:1`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveERC20PointerRequest{
+				Denom:   strings.TrimSpace(args[0]),
+				ChainId: strings.TrimSpace(args[1]),
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdAddNetAssetValues returns a CLI command for adding/updating marker net asset values.
 func GetCmdAddNetAssetValues() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1329,7 +1633,8 @@ func getPeriod(duration int64) time.Duration {
 	return time.Duration(duration) * time.Second
 }
 
-// ParseAccessGrantFromString splits string (example address1,perm1,perm2...;address2, perm1...) to AccessGrant
+// ParseAccessGrantFromString splits string (example address1,perm1,perm2...;address2, perm1...) to AccessGrant.
+// Role templates can be included using role:<name>, e.g. address1,role:issuer,deposit.
 func ParseAccessGrantFromString(addressPermissionString string) []types.AccessGrant {
 	parts := strings.Split(addressPermissionString, ";")
 	grants := make([]types.AccessGrant, 0)
@@ -1343,16 +1648,28 @@ func ParseAccessGrantFromString(addressPermissionString string) []types.AccessGr
 		if !(len(partsPerAddress) > 1) {
 			panic("at least one grant should be provided with address")
 		}
-		var permissions types.AccessList
 		address := sdk.MustAccAddressFromBech32(partsPerAddress[0])
-		for _, permission := range partsPerAddress[1:] {
-			permissions = append(permissions, types.AccessByName(permission))
-		}
-		grants = append(grants, *types.NewAccessGrant(address, permissions))
+		permissions, roles := types.AccessListAndRolesByNames(strings.Join(partsPerAddress[1:], ","))
+		grant := types.NewAccessGrant(address, permissions)
+		grant.Roles = roles
+		grants = append(grants, *grant)
 	}
 	return grants
 }
 
+// ParseRoleTemplate parses a role template from a string of the form <name>:<permission>[,<permission>...].
+func ParseRoleTemplate(roleStr string) (types.RoleTemplate, error) {
+	name, permissionsStr, found := strings.Cut(roleStr, ":")
+	if !found || len(strings.TrimSpace(permissionsStr)) == 0 {
+		return types.RoleTemplate{}, fmt.Errorf("invalid role %q: expected format <name>:<permission>[,<permission>...]", roleStr)
+	}
+	role := types.NewRoleTemplate(types.NormalizeRoleName(name), types.AccessListByNames(permissionsStr)...)
+	if err := role.Validate(); err != nil {
+		return types.RoleTemplate{}, fmt.Errorf("invalid role %q: %w", roleStr, err)
+	}
+	return role, nil
+}
+
 // GetCmdWithdrawEscrowProposal returns a CLI command for submitting a withdraw escrow proposal.
 func GetCmdWithdrawEscrowProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1391,6 +1708,41 @@ func GetCmdWithdrawEscrowProposal() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSeizeEscrowProposal returns a CLI command for submitting a seize escrow proposal.
+func GetCmdSeizeEscrowProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "seize-escrow-proposal <denom> <recovery-address>",
+		Aliases: []string{"sep", "s-e-p"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to seize a marker's escrow and revoke all of its access grants",
+		Long: strings.TrimSpace(`Submit a proposal to seize a marker's escrow along with a title, summary, and deposit.
+Everything spendable in the marker's account is sent to the recovery address, and all access grants on the marker are revoked.
+Funds on hold stay in the marker's account.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker seize-escrow-proposal mycoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --title "My Title" --summary "My summary" --deposit 1000000000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			denom := args[0]
+
+			recoveryAddress, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid recovery address %v: %w", args[1], err)
+			}
+
+			msg := types.NewMsgSeizeEscrowProposalRequest(denom, recoveryAddress, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
 // GetCmdSetDenomMetadataProposal returns a CLI command for submitting a set denom metadata proposal.
 func GetCmdSetDenomMetadataProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1560,6 +1912,130 @@ func ParseBoolStrict(input string) (bool, error) {
 	return false, fmt.Errorf("invalid boolean string: %q", input)
 }
 
+// ParseAttributeRevocationAction converts the provided input into an AttributeRevocationAction.
+// It accepts "none", "deny-send", and "freeze" as well as the full enum names.
+func ParseAttributeRevocationAction(input string) (types.AttributeRevocationAction, error) {
+	val := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(input), "-", "_"))
+	switch val {
+	case "NONE":
+		return types.AttributeRevocationAction_None, nil
+	case "DENY_SEND":
+		return types.AttributeRevocationAction_DenySend, nil
+	case "FREEZE":
+		return types.AttributeRevocationAction_Freeze, nil
+	}
+	if action, found := types.AttributeRevocationAction_value[val]; found {
+		return types.AttributeRevocationAction(action), nil
+	}
+	return types.AttributeRevocationAction_None, fmt.Errorf("invalid attribute revocation action: %q", input)
+}
+
+// ParseDustPolicy converts the provided input into a DustPolicy.
+// It accepts "truncate-to-issuer", "largest-remainder", and "burn" as well as the full enum names.
+func ParseDustPolicy(input string) (types.DustPolicy, error) {
+	val := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(input), "-", "_"))
+	switch val {
+	case "TRUNCATE_TO_ISSUER":
+		return types.DustPolicy_TruncateToIssuer, nil
+	case "LARGEST_REMAINDER":
+		return types.DustPolicy_LargestRemainder, nil
+	case "BURN":
+		return types.DustPolicy_Burn, nil
+	}
+	if policy, found := types.DustPolicy_value[val]; found {
+		return types.DustPolicy(policy), nil
+	}
+	return types.DustPolicy_TruncateToIssuer, fmt.Errorf("invalid dust policy: %q", input)
+}
+
+// addDisplayUnitsFlag adds the --display-units flag to the provided command.
+// See also: parseCoinArg, parseCoinsArg.
+func addDisplayUnitsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagDisplayUnits, false,
+		"amounts are in display (or any other denom unit) units and are converted to base units using the denom metadata")
+}
+
+// parseCoinArg parses a single coin from the provided arg.
+// If --display-units was provided, the coin is converted to base units using the denom metadata.
+func parseCoinArg(clientCtx client.Context, flagSet *pflag.FlagSet, arg string) (sdk.Coin, error) {
+	useDisplay, err := flagSet.GetBool(FlagDisplayUnits)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !useDisplay {
+		coin, err := sdk.ParseCoinNormalized(arg)
+		if err != nil {
+			return sdk.Coin{}, sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", arg)
+		}
+		return coin, nil
+	}
+
+	decCoin, err := sdk.ParseDecCoin(arg)
+	if err != nil {
+		return sdk.Coin{}, sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", arg)
+	}
+	return convertToBaseCoin(clientCtx, decCoin)
+}
+
+// parseCoinsArg parses coins from the provided arg.
+// If --display-units was provided, each coin is converted to base units using its denom metadata.
+func parseCoinsArg(clientCtx client.Context, flagSet *pflag.FlagSet, arg string) (sdk.Coins, error) {
+	useDisplay, err := flagSet.GetBool(FlagDisplayUnits)
+	if err != nil {
+		return nil, err
+	}
+	if !useDisplay {
+		coins, err := sdk.ParseCoinsNormalized(arg)
+		if err != nil {
+			return nil, sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", arg)
+		}
+		return coins, nil
+	}
+
+	decCoins, err := sdk.ParseDecCoins(arg)
+	if err != nil {
+		return nil, sdkErrors.ErrInvalidCoins.Wrapf("invalid coin %s", arg)
+	}
+	var coins sdk.Coins
+	for _, decCoin := range decCoins {
+		coin, err := convertToBaseCoin(clientCtx, decCoin)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(coin)
+	}
+	return coins, nil
+}
+
+// convertToBaseCoin looks up the denom metadata for the provided coin's denom and uses it to convert
+// the coin into base units. The denom can be the base denom, or the denom or alias of any denom unit.
+func convertToBaseCoin(clientCtx client.Context, coin sdk.DecCoin) (sdk.Coin, error) {
+	queryClient := banktypes.NewQueryClient(clientCtx)
+	res, err := queryClient.DenomMetadata(context.Background(), &banktypes.QueryDenomMetadataRequest{Denom: coin.Denom})
+	if err == nil && res != nil {
+		return types.ConvertToBaseCoin(coin, res.Metadata)
+	}
+
+	var nextKey []byte
+	for {
+		page, err := queryClient.DenomsMetadata(context.Background(), &banktypes.QueryDenomsMetadataRequest{
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return sdk.Coin{}, fmt.Errorf("could not query denom metadata: %w", err)
+		}
+		for _, md := range page.Metadatas {
+			if _, found := types.FindDenomUnit(md, coin.Denom); found {
+				return types.ConvertToBaseCoin(coin, md)
+			}
+		}
+		if page.Pagination == nil || len(page.Pagination.NextKey) == 0 {
+			return sdk.Coin{}, fmt.Errorf("no denom metadata found for %q", coin.Denom)
+		}
+		nextKey = page.Pagination.NextKey
+	}
+}
+
 // addOptGovPropFlags adds the gov prop flags and a flag making them optional.
 // See also: generateOrBroadcastOptGovProp
 func addOptGovPropFlags(cmd *cobra.Command) {
@@ -1593,11 +2069,20 @@ func generateOrBroadcastOptGovProp(clientCtx client.Context, flagSet *pflag.Flag
 // GetUpdateMarkerParamsCmd creates a command to update the marker module's params via governance proposal.
 func GetUpdateMarkerParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
-		Short:   "Update the marker module's params via governance proposal",
-		Long:    "Submit an update marker params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(3),
-		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash`, version.AppName),
+		Use:   "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
+		Short: "Update the marker module's params via governance proposal",
+		Long: strings.TrimSpace(`Submit an update marker params via governance proposal along with an initial deposit.
+The --creation-deposit is the amount of the fee denom (e.g. nhash) required to create a marker (0 to disable).
+The --creation-deposit-timeout is the number of blocks after creation that a marker can be cancelled with its deposit refunded.
+The --role flag defines a role template as <name>:<permission>[,<permission>...] and can be provided multiple times.
+If no --role flags are provided, the default role templates are used.
+Changes to a role template are applied to every existing access grant of that role.
+`),
+		Args: cobra.ExactArgs(3),
+		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash
+%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --%[2]s 1000000000 --%[3]s 100800 --deposit 50000nhash
+%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --%[4]s issuer:mint,burn --%[4]s treasury:deposit,withdraw --deposit 50000nhash`,
+			version.AppName, FlagCreationDeposit, FlagCreationDepositTimeout, FlagRole),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1619,19 +2104,1093 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid max supply: %q", args[2])
 			}
 
+			creationDepositStr, err := flagSet.GetString(FlagCreationDeposit)
+			if err != nil {
+				return err
+			}
+			creationDeposit, ok := sdkmath.NewIntFromString(creationDepositStr)
+			if !ok {
+				return fmt.Errorf("invalid creation deposit: %q", creationDepositStr)
+			}
+
+			creationDepositTimeout, err := flagSet.GetInt64(FlagCreationDepositTimeout)
+			if err != nil {
+				return err
+			}
+
+			roleStrs, err := flagSet.GetStringArray(FlagRole)
+			if err != nil {
+				return err
+			}
+			roleTemplates := types.DefaultRoleTemplates()
+			if len(roleStrs) > 0 {
+				roleTemplates = make([]types.RoleTemplate, len(roleStrs))
+				for i, roleStr := range roleStrs {
+					if roleTemplates[i], err = ParseRoleTemplate(roleStr); err != nil {
+						return err
+					}
+				}
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				enableGovernance,
 				unrestrictedDenomRegex,
 				maxSupply,
+				creationDeposit,
+				creationDepositTimeout,
+				roleTemplates,
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagCreationDeposit, strconv.Itoa(types.DefaultCreationDeposit), "Amount of the fee denom required as a deposit to create a marker")
+	cmd.Flags().Int64(FlagCreationDepositTimeout, types.DefaultCreationDepositTimeoutBlocks, "Number of blocks after creation that a marker can be cancelled with its deposit refunded")
+	cmd.Flags().StringArray(FlagRole, nil, "A role template, e.g. issuer:mint,burn (can be provided multiple times)")
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// GetCmdUpdateReqAttrBypassAddrs creates a command to update the required attributes bypass list via governance proposal.
+func GetCmdUpdateReqAttrBypassAddrs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-req-attr-bypass",
+		Aliases: []string{"req-attr-bypass", "bypass"},
+		Short:   "Update the addresses that bypass the required attributes checking via governance proposal",
+		Long: strings.TrimSpace(`Submit a governance proposal to add and remove addresses that bypass the required attributes checking of restricted markers.
+The module accounts that always bypass the required attributes checking cannot be removed.
+`),
+		Args: cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s tx marker update-req-attr-bypass --%s=bech32addr1,bech32addr2,... --%s=bech32addr3,... --deposit 50000nhash`,
+			version.AppName,
+			FlagAdd,
+			FlagRemove,
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			addAddrs, err := flagSet.GetStringSlice(FlagAdd)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagAdd, err)
+			}
+			removeAddrs, err := flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of bech32 addresses Error: %w", FlagRemove, err)
+			}
+
+			msg := types.NewMsgUpdateReqAttrBypassAddrsRequest(addAddrs, removeAddrs, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAdd, []string{}, "comma delimited list of bech32 addresses to add to the required attributes bypass list")
+	cmd.Flags().StringSlice(FlagRemove, []string{}, "comma delimited list of bech32 addresses to remove from the required attributes bypass list")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExecuteAsParent returns a CLI command for executing msgs as a parent marker's account.
+func GetCmdExecuteAsParent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execute-as-parent <parent-denom> <msg-tx-json-file>",
+		Aliases: []string{"exec-as-parent"},
+		Short:   "Execute marker msgs as the account of a parent marker",
+		Long: strings.TrimSpace(`Execute marker msgs as the account of a parent marker.
+The signer must have admin access on the parent marker, and every msg in the file must
+have the parent marker's address as its only signer. The msg-tx-json-file is a tx in JSON
+format, usually created with the --generate-only flag.`),
+		Example: fmt.Sprintf(`$ %s tx marker execute-as-parent parentcoin tx.json --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecuteAsParentRequest(strings.TrimSpace(args[0]), clientCtx.GetFromAddress(), theTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetBridge returns a CLI command for creating or updating a bridge that can mint and burn a marker's coin.
+func GetCmdSetBridge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bridge <name> <denom> <threshold> <mint limit> <attester pubkey hex> [<attester pubkey hex> ...]",
+		Short: "Create or update a bridge that can mint and burn a marker's coin using external attestations",
+		Long: strings.TrimSpace(`Create or update a bridge that can mint and burn a marker's coin.
+Mints through the bridge must be signed by at least <threshold> of the attesters.
+Attesters are provided as hex encoded compressed secp256k1 public keys.
+The amount minted and not yet burned through the bridge cannot exceed the <mint limit>.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-bridge cctp usdc 2 1000000000000 02b0...e1 03a4...7c 0291...0f`, version.AppName),
+		Args:    cobra.MinimumNArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid threshold %q: %w", args[2], err)
+			}
+			attesters := make([][]byte, 0, len(args)-4)
+			for _, arg := range args[4:] {
+				attester, err := hex.DecodeString(strings.TrimSpace(arg))
+				if err != nil {
+					return fmt.Errorf("invalid attester %q: %w", arg, err)
+				}
+				attesters = append(attesters, attester)
+			}
+
+			msg := &types.MsgSetBridgeRequest{
+				Name:      strings.TrimSpace(args[0]),
+				Denom:     strings.TrimSpace(args[1]),
+				Attesters: attesters,
+				Threshold: uint32(threshold),
+				MintLimit: strings.TrimSpace(args[3]),
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBridgeMint returns a CLI command for relaying an attested bridge mint.
+func GetCmdBridgeMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-mint <bridge> <nonce> <source> <recipient> <amount> <signature hex> [<signature hex> ...]",
+		Short: "Relay an attested message to mint coin through a bridge",
+		Long: strings.TrimSpace(`Relay an attested message to mint coin through a bridge.
+The message is for the chain id of the client, and the signatures are the hex encoded attester signatures of it.`),
+		Example: fmt.Sprintf(`$ %s tx marker bridge-mint cctp 42 domain0:0xabc...def pb1...xyz 1000usdc 30440220...01 3045022100...9a --from mykey`, version.AppName),
+		Args:    cobra.MinimumNArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid nonce %q: %w", args[1], err)
+			}
+			amount, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[4], err)
+			}
+			signatures := make([][]byte, 0, len(args)-5)
+			for _, arg := range args[5:] {
+				sig, err := hex.DecodeString(strings.TrimSpace(arg))
+				if err != nil {
+					return fmt.Errorf("invalid signature %q: %w", arg, err)
+				}
+				signatures = append(signatures, sig)
+			}
+
+			message := types.BridgeMessage{
+				Bridge:    strings.TrimSpace(args[0]),
+				ChainId:   clientCtx.ChainID,
+				Nonce:     nonce,
+				Source:    args[2],
+				Recipient: strings.TrimSpace(args[3]),
+				Amount:    amount,
+			}
+			msg := types.NewMsgBridgeMintRequest(message, signatures, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBridgeBurn returns a CLI command for burning coin through a bridge.
+func GetCmdBridgeBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bridge-burn <bridge> <amount> <destination>",
+		Short:   "Burn coin through a bridge so that it can be released on another chain",
+		Example: fmt.Sprintf(`$ %s tx marker bridge-burn cctp 1000usdc domain0:0x1c7d4b196cb0c7b01d743fbc6116a902379c7238 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgBridgeBurnRequest(strings.TrimSpace(args[0]), amount, strings.TrimSpace(args[2]), clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetIssuerManagedFlags returns a CLI command for setting whether a marker's admins can update its flags without governance.
+func GetCmdSetIssuerManagedFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-issuer-managed-flags <denom> {true|false}",
+		Short: "Set whether a marker's admins can update its flags without governance",
+		Long: strings.TrimSpace(`Set whether a marker's admins can update its flags without governance.
+The manager can do this while the marker is proposed, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-issuer-managed-flags hotdogcoin true --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetIssuerManagedFlagsRequest{Denom: strings.TrimSpace(args[0])}
+			msg.IssuerManagedFlags, err = ParseBoolStrict(args[1])
+			if err != nil {
+				return err
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateMarkerFlags returns a CLI command for a marker's admins to update its flags without governance.
+func GetCmdUpdateMarkerFlags() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-flags <denom> <allow forced transfer> <allow governance control> [<other admin> ...]",
+		Short: "Update the allow_forced_transfer and allow_governance_control flags of a marker with issuer managed flags",
+		Long: strings.TrimSpace(`Update the allow_forced_transfer and allow_governance_control flags of a marker with issuer managed flags.
+Every account with admin access on the marker must sign. The --from account is the first signer, and the
+other admins are provided as extra arguments. Use --generate-only to create the tx for the other admins to sign.`),
+		Example: fmt.Sprintf(`$ %s tx marker update-flags hotdogcoin true false pb1...xyz --from mykey --generate-only`, version.AppName),
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateMarkerFlagsRequest{
+				Denom:   strings.TrimSpace(args[0]),
+				Signers: []string{clientCtx.GetFromAddress().String()},
+			}
+			msg.AllowForcedTransfer, err = ParseBoolStrict(args[1])
+			if err != nil {
+				return err
+			}
+			msg.AllowGovernanceControl, err = ParseBoolStrict(args[2])
+			if err != nil {
+				return err
+			}
+			for _, arg := range args[3:] {
+				msg.Signers = append(msg.Signers, strings.TrimSpace(arg))
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProposeAdmin returns a CLI command for proposing a grant of admin access on a marker.
+func GetCmdProposeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-admin <denom> <grantee>",
+		Short: "Propose a grant of admin access on a marker",
+		Long: strings.TrimSpace(`Propose a grant of admin access on a marker.
+The grant only takes effect once the grantee accepts it using the accept-admin command.`),
+		Example: fmt.Sprintf(`$ %s tx marker propose-admin hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProposeAdminRequest{
+				Denom:   strings.TrimSpace(args[0]),
+				Grantee: strings.TrimSpace(args[1]),
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcceptAdmin returns a CLI command for accepting a proposed grant of admin access on a marker.
+func GetCmdAcceptAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-admin <denom>",
+		Short:   "Accept a proposed grant of admin access on a marker",
+		Example: fmt.Sprintf(`$ %s tx marker accept-admin hotdogcoin --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptAdminRequest(strings.TrimSpace(args[0]), clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelAdminProposal returns a CLI command for canceling a proposed grant of admin access on a marker.
+func GetCmdCancelAdminProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-admin-proposal <denom> <grantee>",
+		Short:   "Cancel a proposed grant of admin access on a marker before it is accepted",
+		Example: fmt.Sprintf(`$ %s tx marker cancel-admin-proposal hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelAdminProposalRequest{
+				Denom:   strings.TrimSpace(args[0]),
+				Grantee: strings.TrimSpace(args[1]),
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetAttributeRevocationAction returns a CLI command for setting what a restricted marker does to an account
+// that loses one of its required attributes.
+func GetCmdSetAttributeRevocationAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-attribute-revocation-action <denom> {none|deny-send|freeze}",
+		Short: "Set what a restricted marker does to an account that loses one of its required attributes",
+		Long: strings.TrimSpace(`Set what a restricted marker does to an account that loses one of its required attributes.
+none: nothing is done (the default).
+deny-send: the account is added to the marker's send-deny list.
+freeze: the account cannot send the marker's coin until it has all of the required attributes again.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-attribute-revocation-action hotdogcoin freeze --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetAttributeRevocationActionRequest{Denom: strings.TrimSpace(args[0])}
+			msg.Action, err = ParseAttributeRevocationAction(args[1])
+			if err != nil {
+				return err
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetDustPolicy returns a CLI command for setting what a marker does with the dust left over from pro-rata
+// operations.
+func GetCmdSetDustPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-dust-policy <denom> {truncate-to-issuer|largest-remainder|burn}",
+		Short: "Set what a marker does with the dust left over from pro-rata operations",
+		Long: strings.TrimSpace(`Set what a marker does with the dust left over from pro-rata operations, e.g. distributions and redemptions.
+truncate-to-issuer: each share is truncated and the dust stays with the issuer (the default).
+largest-remainder: the dust is handed out one unit at a time to the shares with the largest remainders.
+burn: each share is truncated and the dust is burned.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-dust-policy hotdogcoin largest-remainder --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetDustPolicyRequest{Denom: strings.TrimSpace(args[0])}
+			msg.Policy, err = ParseDustPolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetReserveRequirement returns a CLI command for setting the collateral that a marker must hold in its
+// escrow to back its supply.
+func GetCmdSetReserveRequirement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reserve-requirement <denom> <ratio> [<reserve denom> ...]",
+		Short: "Set the collateral that a marker must hold in its escrow to back its supply",
+		Long: strings.TrimSpace(`Set the collateral that a marker must hold in its escrow to back its supply.
+The ratio is the minimum amount of reserves needed for each unit of the marker's supply, e.g. 1 for 1:1 backing.
+The amounts of all the reserve denoms held in the marker's escrow are added together.
+Mints (and withdrawals of reserves) that would leave the supply under-backed are rejected.
+Provide a ratio of 0 and no reserve denoms to remove the marker's reserve requirement.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-reserve-requirement wrappedusd 1 uusdc --from mykey
+$ %[1]s tx marker set-reserve-requirement wrappedusd 0 --from mykey`, version.AppName),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ratio, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid ratio %q: %w", args[1], err)
+			}
+			msg := &types.MsgSetReserveRequirementRequest{
+				Denom: strings.TrimSpace(args[0]),
+				Ratio: ratio,
+			}
+			for _, denom := range args[2:] {
+				msg.ReserveDenoms = append(msg.ReserveDenoms, strings.TrimSpace(denom))
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetReserveAttestors returns a CLI command for setting the accounts approved to publish reserve attestations
+// for a marker.
+func GetCmdSetReserveAttestors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-reserve-attestors <denom> [<attestor> ...]",
+		Short: "Set the accounts approved to publish reserve attestations for a marker",
+		Long: strings.TrimSpace(`Set the accounts approved to publish reserve attestations for a marker.
+Attestations from accounts that are no longer approved are removed. Provide no attestors to remove them all.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-reserve-attestors wrappedusd pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey`, version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetReserveAttestorsRequest{Denom: strings.TrimSpace(args[0])}
+			for _, attestor := range args[1:] {
+				msg.Attestors = append(msg.Attestors, strings.TrimSpace(attestor))
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAttestReserves returns a CLI command for publishing a proof-of-reserve attestation for a marker.
+func GetCmdAttestReserves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-reserves <denom> <amount> <custodian> <report hash> <valid until>",
+		Short: "Publish a proof-of-reserve attestation for a marker",
+		Long: strings.TrimSpace(`Publish a proof-of-reserve attestation for a marker.
+The amount is the amount of reserves held by the custodian, and the report hash is the hex-encoded hash of the full reserve report.
+The valid until time (and --valid-from, which defaults to now) must be in RFC3339 format.
+The attestation replaces the signer's previous attestation for the marker, and is flagged as stale after its valid until time.
+The signer must be one of the marker's approved reserve attestors.`),
+		Example: fmt.Sprintf(`$ %s tx marker attest-reserves wrappedusd 1000000usd "First Bank #1234" `+
+			`9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 2026-12-31T00:00:00Z --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+			reportHash, err := hex.DecodeString(strings.TrimSpace(args[3]))
+			if err != nil {
+				return fmt.Errorf("invalid report hash %q: %w", args[3], err)
+			}
+			validUntil, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[4], time.RFC3339, err)
+			}
+			validFrom := time.Now()
+			if validFromStr, _ := cmd.Flags().GetString(FlagValidFrom); len(validFromStr) > 0 {
+				validFrom, err = time.Parse(time.RFC3339, validFromStr)
+				if err != nil {
+					return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", validFromStr, time.RFC3339, err)
+				}
+			}
+
+			msg := types.NewMsgAttestReservesRequest(strings.TrimSpace(args[0]), amount, args[2], reportHash,
+				validFrom.UTC(), validUntil.UTC(), clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagValidFrom, "", "The time that the attestation takes effect, in RFC3339 format (default now)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSchedulePolicyChange returns a CLI command for scheduling a change to a restricted marker's required attributes.
+func GetCmdSchedulePolicyChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-policy-change <denom> <effective height>",
+		Short: "Schedule a change to a restricted marker's required attributes that takes effect at a future height",
+		Long: strings.TrimSpace(`Schedule a change to a restricted marker's required attributes that takes effect at a future block height.
+Until then, the change can be viewed using the scheduled-changes query, and can be canceled.
+The signer must have transfer access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker schedule-policy-change hotdogcoin 1500000 --%s=attr.one,*.attr.two,... --%s=attr.one,*.attr.two,... --from mykey`,
+			version.AppName,
+			FlagAdd,
+			FlagRemove,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			msg := &types.MsgSchedulePolicyChangeRequest{Denom: strings.TrimSpace(args[0])}
+			msg.EffectiveHeight, err = strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid effective height %q: %w", args[1], err)
+			}
+
+			msg.AddRequiredAttributes, err = flagSet.GetStringSlice(FlagAdd)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of attributes Error: %w", FlagAdd, err)
+			}
+
+			msg.RemoveRequiredAttributes, err = flagSet.GetStringSlice(FlagRemove)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: comma delimited list of attributes Error: %w", FlagRemove, err)
+			}
+
+			authSetter := func(authority string) {
+				msg.TransferAuthority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagAdd, []string{}, "comma delimited list of required attributes to be added to restricted marker")
+	cmd.Flags().StringSlice(FlagRemove, []string{}, "comma delimited list of required attributes to be removed from restricted marker")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelPolicyChange returns a CLI command for canceling a scheduled change to a restricted marker's required attributes.
+func GetCmdCancelPolicyChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-policy-change <denom> <id>",
+		Short: "Cancel a scheduled change to a restricted marker's required attributes",
+		Long: strings.TrimSpace(`Cancel a scheduled change to a restricted marker's required attributes before it takes effect.
+The signer must have transfer access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %s tx marker cancel-policy-change hotdogcoin 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelPolicyChangeRequest{Denom: strings.TrimSpace(args[0])}
+			msg.Id, err = strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id %q: %w", args[1], err)
+			}
+
+			authSetter := func(authority string) {
+				msg.TransferAuthority = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), authSetter, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSwap returns a CLI command for atomically exchanging amounts of two different marker denoms between two parties.
+func GetCmdSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap <party b> <amount a> <amount b>",
+		Short: "Atomically exchange amounts of two different marker denoms with another account",
+		Long: strings.TrimSpace(`Atomically exchange amounts of two different marker denoms with another account.
+The --from account is party a and sends <amount a> to party b, who sends <amount b> back.
+Both parties must sign the tx, so it is usually generated with --generate-only and then signed by each party.`),
+		Example: fmt.Sprintf(`$ %s tx marker swap pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 10hotdogcoin 25hamburgercoin --from mykey --generate-only`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSwapRequest{
+				PartyA: clientCtx.GetFromAddress().String(),
+				PartyB: strings.TrimSpace(args[0]),
+			}
+			msg.AmountA, err = sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount a %q: %w", args[1], err)
+			}
+			msg.AmountB, err = sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid amount b %q: %w", args[2], err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCreateSwapOffer returns a CLI command for offering to swap amounts of two different marker denoms.
+func GetCmdCreateSwapOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-swap-offer <offer> <ask> <expiration height>",
+		Short: "Offer to swap an amount of one marker denom for an amount of another",
+		Long: strings.TrimSpace(`Offer to swap an amount of one marker denom for an amount of another.
+When the offer is accepted, the --from account sends <offer> to the taker and receives <ask> from them.
+The offer can be accepted by anyone, or only by the --taker if provided, until the expiration height.`),
+		Example: fmt.Sprintf(`$ %s tx marker create-swap-offer 10hotdogcoin 25hamburgercoin 120000 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateSwapOfferRequest{Maker: clientCtx.GetFromAddress().String()}
+			msg.Offer, err = sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid offer %q: %w", args[0], err)
+			}
+			msg.Ask, err = sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid ask %q: %w", args[1], err)
+			}
+			msg.ExpirationHeight, err = strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiration height %q: %w", args[2], err)
+			}
+			msg.Taker, err = cmd.Flags().GetString(FlagTaker)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagTaker, "", "the only address that can accept the offer")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcceptSwapOffer returns a CLI command for accepting a swap offer.
+func GetCmdAcceptSwapOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-swap-offer <offer id>",
+		Short:   "Accept a swap offer, completing the swap it describes",
+		Example: fmt.Sprintf(`$ %s tx marker accept-swap-offer 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptSwapOfferRequest{Taker: clientCtx.GetFromAddress().String()}
+			msg.OfferId, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id %q: %w", args[0], err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelSwapOffer returns a CLI command for canceling a swap offer.
+func GetCmdCancelSwapOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-swap-offer <offer id>",
+		Short:   "Cancel a swap offer before it is accepted",
+		Example: fmt.Sprintf(`$ %s tx marker cancel-swap-offer 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelSwapOfferRequest{Maker: clientCtx.GetFromAddress().String()}
+			msg.OfferId, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid offer id %q: %w", args[0], err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetBridgedSupply returns a CLI command for setting how a bridged marker's supply is reconciled against the
+// balance locked on the counterparty chain.
+func GetCmdSetBridgedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-bridged-supply <denom> [<channel id> <lock address> <remote denom> <interval blocks>]",
+		Short: "Set how a bridged marker's supply is reconciled against the balance locked on the counterparty chain",
+		Long: strings.TrimSpace(`Set how a bridged marker's supply is reconciled against the balance locked on the counterparty chain.
+Every interval blocks, the balance of the remote denom held by the lock address is requested through an
+interchain query sent on the oracle module's channel. The result is compared to the marker's supply and the
+reconciliation status (matched or mismatched) is recorded.
+Provide only the denom to stop reconciling the marker's supply.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-bridged-supply bridgedatom channel-3 cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du uatom 100 --from mykey
+$ %[1]s tx marker set-bridged-supply bridgedatom --from mykey`, version.AppName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 && len(args) != 5 {
+				return fmt.Errorf("accepts 1 or 5 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetBridgedSupplyRequest{
+				Denom: strings.TrimSpace(args[0]),
+			}
+			if len(args) == 5 {
+				msg.ChannelId = strings.TrimSpace(args[1])
+				msg.LockAddress = strings.TrimSpace(args[2])
+				msg.RemoteDenom = strings.TrimSpace(args[3])
+				msg.IntervalBlocks, err = strconv.ParseUint(strings.TrimSpace(args[4]), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid interval blocks %q: %w", args[4], err)
+				}
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSetSupplyChangePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-supply-change-policy <denom> <max change>",
+		Short: "Set the largest portion of a marker's supply that the mints and burns of a single block can change",
+		Long: strings.TrimSpace(`Set the largest portion of a marker's supply that the mints and burns of a single block can change.
+The max change is a decimal portion of the marker's current supply, e.g. 0.1 for 10%.
+Mints and burns that change the supply by more than that must be done via governance proposal,
+even when the signer has mint or burn access on the marker.
+A max change of 0 removes the policy. The marker must allow governance control.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.
+Only a governance proposal can raise the max change or remove the policy.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-supply-change-policy hotdogcoin 0.1 --from mykey
+$ %[1]s tx marker set-supply-change-policy hotdogcoin 0 --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxChange, err := sdkmath.LegacyNewDecFromStr(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid max change %q: %w", args[1], err)
+			}
+
+			msg := &types.MsgSetSupplyChangePolicyRequest{
+				Denom:     strings.TrimSpace(args[0]),
+				MaxChange: maxChange,
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetReceiptPolicy returns a CLI command for setting how long recipients of a marker's coins have to
+// acknowledge a transfer.
+func GetCmdSetReceiptPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-receipt-policy <denom> <timeout blocks>",
+		Short: "Require recipients of a restricted marker's coins to acknowledge transfers",
+		Long: strings.TrimSpace(`Require recipients of a restricted marker's coins to acknowledge transfers.
+Coins sent to an account are held by the marker module until the recipient acknowledges them.
+If the recipient declines them, or does not acknowledge them within the timeout blocks, they are returned to the sender.
+A timeout of 0 removes the policy.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-receipt-policy hotdogcoin 1000 --from mykey
+$ %[1]s tx marker set-receipt-policy hotdogcoin 0 --from mykey`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetReceiptPolicyRequest{Denom: strings.TrimSpace(args[0])}
+			msg.TimeoutBlocks, err = strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid timeout blocks %q: %w", args[1], err)
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcknowledgeReceipt returns a CLI command for acknowledging a pending receipt.
+func GetCmdAcknowledgeReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "acknowledge-receipt <receipt id>",
+		Short:   "Acknowledge a transfer that is waiting for you to accept it, delivering the coins",
+		Example: fmt.Sprintf(`$ %s tx marker acknowledge-receipt 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcknowledgeReceiptRequest{Recipient: clientCtx.GetFromAddress().String()}
+			msg.Id, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid receipt id %q: %w", args[0], err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeclineReceipt returns a CLI command for declining a pending receipt.
+func GetCmdDeclineReceipt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "decline-receipt <receipt id>",
+		Short:   "Decline a transfer that is waiting for you to accept it, returning the coins to the sender",
+		Example: fmt.Sprintf(`$ %s tx marker decline-receipt 3 --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDeclineReceiptRequest{Recipient: clientCtx.GetFromAddress().String()}
+			msg.Id, err = strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid receipt id %q: %w", args[0], err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetContractSupplyCap returns a CLI command for setting a contract's daily mint and burn caps on a marker.
+func GetCmdSetContractSupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-supply-cap <denom> <contract> {<daily mint limit> <daily burn limit>|--remove}",
+		Short: "Set how much a smart contract can mint or burn of a marker each day",
+		Long: strings.TrimSpace(`Set how much a smart contract can mint or burn of a marker each day.
+The contract still needs mint or burn access on the marker; the cap limits how much it can use that access.
+Days are UTC days of the block time. The amounts already minted or burned today count against new limits.
+The limits must be in the marker's denom. Limits of 0 and 0 block the contract from minting or burning.
+Use --remove (without limits) to remove the cap, which leaves the contract limited only by its access.
+The signer must have admin access on the marker, otherwise it must be done via governance proposal.
+The contract cannot set its own cap.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-contract-supply-cap hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 1000000hotdogcoin 500000hotdogcoin --from mykey
+$ %[1]s tx marker set-contract-supply-cap hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk 0hotdogcoin 0hotdogcoin --from mykey
+$ %[1]s tx marker set-contract-supply-cap hotdogcoin pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --remove --from mykey`, version.AppName),
+		Args: cobra.RangeArgs(2, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetContractSupplyCapRequest{
+				Denom:    strings.TrimSpace(args[0]),
+				Contract: strings.TrimSpace(args[1]),
+			}
+			msg.Remove, err = cmd.Flags().GetBool(FlagRemove)
+			if err != nil {
+				return err
+			}
+			switch {
+			case msg.Remove && len(args) != 2:
+				return errors.New("daily limits cannot be provided with --" + FlagRemove)
+			case !msg.Remove && len(args) != 4:
+				return errors.New("both a daily mint limit and a daily burn limit are required")
+			case !msg.Remove:
+				msg.DailyMintLimit, err = sdk.ParseCoinNormalized(strings.TrimSpace(args[2]))
+				if err != nil {
+					return fmt.Errorf("invalid daily mint limit %q: %w", args[2], err)
+				}
+				msg.DailyBurnLimit, err = sdk.ParseCoinNormalized(strings.TrimSpace(args[3]))
+				if err != nil {
+					return fmt.Errorf("invalid daily burn limit %q: %w", args[3], err)
+				}
+			}
+
+			setSigner := func(signer string) {
+				msg.Signer = signer
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, cmd.Flags(), setSigner, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRemove, false, "Remove the contract's supply cap")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
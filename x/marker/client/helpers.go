@@ -0,0 +1,134 @@
+// Package client has helpers for building marker module workflows in Go.
+// Each helper returns msgs that have already been validated, ready to be put in a tx.
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerOptions are the optional settings for a new marker.
+type MarkerOptions struct {
+	// SupplyFixed indicates that the supply is fixed.
+	SupplyFixed bool
+	// AllowGovernanceControl indicates that governance control is allowed.
+	AllowGovernanceControl bool
+	// AllowForcedTransfer indicates that forced transfers are allowed. Only applicable to restricted markers.
+	AllowForcedTransfer bool
+	// RequiredAttributes are the attributes needed for a restricted marker to have send authority.
+	RequiredAttributes []string
+}
+
+// CreateAndActivate returns a msg that creates, finalizes, and activates a new marker with the provided supply.
+// The manager pays for (and signs) the msg. At least one access grant is required.
+func CreateAndActivate(
+	manager sdk.AccAddress,
+	supply sdk.Coin,
+	markerType types.MarkerType,
+	opts MarkerOptions,
+	grants ...types.AccessGrant,
+) (*types.MsgAddFinalizeActivateMarkerRequest, error) {
+	if markerType != types.MarkerType_Coin && markerType != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("invalid marker type: %s", markerType)
+	}
+	if err := types.ValidateGrants(grants...); err != nil {
+		return nil, fmt.Errorf("invalid access grants: %w", err)
+	}
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		supply.Denom, supply.Amount, manager, manager, markerType,
+		opts.SupplyFixed, opts.AllowGovernanceControl, opts.AllowForcedTransfer, opts.RequiredAttributes,
+		grants, 0, 0,
+	)
+	if err := ValidateMsgs(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Grant returns a msg that gives the grantee the provided permissions on a marker.
+func Grant(denom string, admin, grantee sdk.AccAddress, perms ...types.Access) (*types.MsgAddAccessRequest, error) {
+	if len(perms) == 0 {
+		return nil, errors.New("at least one permission is required")
+	}
+	if admin.Empty() {
+		return nil, errors.New("admin cannot be empty")
+	}
+	msg := types.NewMsgAddAccessRequest(denom, admin, *types.NewAccessGrant(grantee, perms))
+	if err := ValidateMsgs(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// MintTo returns the msgs that mint new marker coins and then withdraw them to the recipient.
+// The admin needs both mint and withdraw access on the marker.
+func MintTo(admin, recipient sdk.AccAddress, amount sdk.Coin) ([]sdk.Msg, error) {
+	if recipient.Empty() {
+		return nil, errors.New("recipient cannot be empty")
+	}
+	msgs := []sdk.Msg{
+		types.NewMsgMintRequest(admin, amount),
+		types.NewMsgWithdrawRequest(admin, recipient, amount.Denom, sdk.NewCoins(amount)),
+	}
+	if err := ValidateMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// TransferWithAgent returns a msg in which the agent moves restricted marker coins from one account to another.
+// The agent needs transfer access on the marker.
+func TransferWithAgent(agent, from, to sdk.AccAddress, amount sdk.Coin) (*types.MsgTransferRequest, error) {
+	msg := types.NewMsgTransferRequest(agent, from, to, amount)
+	if err := ValidateMsgs(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// UpdateNetAssetValues returns a msg that records new net asset values for a marker.
+func UpdateNetAssetValues(denom string, admin sdk.AccAddress, navs ...types.NetAssetValue) (*types.MsgAddNetAssetValuesRequest, error) {
+	msg := types.NewMsgAddNetAssetValuesRequest(denom, admin.String(), navs)
+	if err := ValidateMsgs(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ValidateMsgs runs ValidateBasic on each of the provided msgs that has it.
+func ValidateMsgs(msgs ...sdk.Msg) error {
+	if len(msgs) == 0 {
+		return errors.New("no msgs provided")
+	}
+	for i, msg := range msgs {
+		if vb, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := vb.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid msgs[%d] %s: %w", i, sdk.MsgTypeURL(msg), err)
+			}
+		}
+	}
+	return nil
+}
+
+// EstimateGas validates the msgs and simulates a tx with them to get the gas needed.
+// The returned gas has the factory's gas adjustment applied.
+func EstimateGas(clientCtx sdkclient.Context, txf tx.Factory, msgs ...sdk.Msg) (uint64, error) {
+	if err := ValidateMsgs(msgs...); err != nil {
+		return 0, err
+	}
+	txf, err := txf.Prepare(clientCtx)
+	if err != nil {
+		return 0, fmt.Errorf("could not prepare tx factory: %w", err)
+	}
+	_, gas, err := tx.CalculateGas(clientCtx, txf, msgs...)
+	if err != nil {
+		return 0, fmt.Errorf("could not simulate tx: %w", err)
+	}
+	return gas, nil
+}
@@ -0,0 +1,209 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	markerclient "github.com/provenance-io/provenance/x/marker/client"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________")
+	addr2 = sdk.AccAddress("addr2_______________")
+	addr3 = sdk.AccAddress("addr3_______________")
+)
+
+func TestCreateAndActivate(t *testing.T) {
+	supply := sdk.NewInt64Coin("hotdog", 1000)
+	grant := *types.NewAccessGrant(addr1, types.AccessList{types.Access_Mint, types.Access_Admin})
+
+	tests := []struct {
+		name       string
+		markerType types.MarkerType
+		opts       markerclient.MarkerOptions
+		grants     []types.AccessGrant
+		expMsg     *types.MsgAddFinalizeActivateMarkerRequest
+		expInErr   []string
+	}{
+		{
+			name:       "unknown marker type",
+			markerType: types.MarkerType_Unknown,
+			grants:     []types.AccessGrant{grant},
+			expInErr:   []string{"invalid marker type: MARKER_TYPE_UNSPECIFIED"},
+		},
+		{
+			name:       "invalid grant",
+			markerType: types.MarkerType_Coin,
+			grants:     []types.AccessGrant{{Address: "bad", Permissions: types.AccessList{types.Access_Mint}}},
+			expInErr:   []string{"invalid access grants", "invalid address"},
+		},
+		{
+			name:       "no grants",
+			markerType: types.MarkerType_Coin,
+			expInErr:   []string{"invalid msgs[0]", "must have access list defined"},
+		},
+		{
+			name:       "forced transfer on coin marker",
+			markerType: types.MarkerType_Coin,
+			opts:       markerclient.MarkerOptions{AllowForcedTransfer: true},
+			grants:     []types.AccessGrant{grant},
+			expInErr:   []string{"forced transfer is only available for restricted coins"},
+		},
+		{
+			name:       "restricted marker",
+			markerType: types.MarkerType_RestrictedCoin,
+			opts: markerclient.MarkerOptions{
+				SupplyFixed:            true,
+				AllowGovernanceControl: true,
+				AllowForcedTransfer:    true,
+				RequiredAttributes:     []string{"kyc.provenance.io"},
+			},
+			grants: []types.AccessGrant{grant},
+			expMsg: &types.MsgAddFinalizeActivateMarkerRequest{
+				Amount:                 supply,
+				Manager:                addr1.String(),
+				FromAddress:            addr1.String(),
+				MarkerType:             types.MarkerType_RestrictedCoin,
+				AccessList:             []types.AccessGrant{grant},
+				SupplyFixed:            true,
+				AllowGovernanceControl: true,
+				AllowForcedTransfer:    true,
+				RequiredAttributes:     []string{"kyc.provenance.io"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := markerclient.CreateAndActivate(addr1, supply, tc.markerType, tc.opts, tc.grants...)
+			assertions.AssertErrorContents(t, err, tc.expInErr, "CreateAndActivate error")
+			assert.Equal(t, tc.expMsg, msg, "CreateAndActivate msg")
+		})
+	}
+}
+
+func TestGrant(t *testing.T) {
+	tests := []struct {
+		name     string
+		denom    string
+		admin    sdk.AccAddress
+		perms    []types.Access
+		expMsg   *types.MsgAddAccessRequest
+		expInErr []string
+	}{
+		{
+			name:     "no permissions",
+			denom:    "hotdog",
+			admin:    addr1,
+			expInErr: []string{"at least one permission is required"},
+		},
+		{
+			name:     "no admin",
+			denom:    "hotdog",
+			perms:    []types.Access{types.Access_Mint},
+			expInErr: []string{"admin cannot be empty"},
+		},
+		{
+			name:     "invalid denom",
+			denom:    "x",
+			admin:    addr1,
+			perms:    []types.Access{types.Access_Mint},
+			expInErr: []string{"invalid msgs[0]", "invalid denom: x"},
+		},
+		{
+			name:  "okay",
+			denom: "hotdog",
+			admin: addr1,
+			perms: []types.Access{types.Access_Mint, types.Access_Withdraw},
+			expMsg: &types.MsgAddAccessRequest{
+				Denom:         "hotdog",
+				Administrator: addr1.String(),
+				Access:        []types.AccessGrant{*types.NewAccessGrant(addr2, types.AccessList{types.Access_Mint, types.Access_Withdraw})},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := markerclient.Grant(tc.denom, tc.admin, addr2, tc.perms...)
+			assertions.AssertErrorContents(t, err, tc.expInErr, "Grant error")
+			assert.Equal(t, tc.expMsg, msg, "Grant msg")
+		})
+	}
+}
+
+func TestMintTo(t *testing.T) {
+	amount := sdk.NewInt64Coin("hotdog", 5)
+
+	_, err := markerclient.MintTo(addr1, nil, amount)
+	assertions.AssertErrorContents(t, err, []string{"recipient cannot be empty"}, "MintTo without a recipient")
+
+	_, err = markerclient.MintTo(addr1, addr2, sdk.Coin{Denom: "hotdog", Amount: sdkmath.NewInt(-1)})
+	assertions.AssertErrorContents(t, err, []string{"invalid msgs[0]", "negative coin amount"}, "MintTo with a negative amount")
+
+	msgs, err := markerclient.MintTo(addr1, addr2, amount)
+	require.NoError(t, err, "MintTo")
+	expMsgs := []sdk.Msg{
+		&types.MsgMintRequest{Amount: amount, Administrator: addr1.String()},
+		&types.MsgWithdrawRequest{Denom: "hotdog", Administrator: addr1.String(), ToAddress: addr2.String(), Amount: sdk.NewCoins(amount)},
+	}
+	assert.Equal(t, expMsgs, msgs, "MintTo msgs")
+}
+
+func TestTransferWithAgent(t *testing.T) {
+	amount := sdk.NewInt64Coin("hotdog", 5)
+
+	_, err := markerclient.TransferWithAgent(addr1, nil, addr3, amount)
+	assertions.AssertErrorContents(t, err, []string{"invalid msgs[0]", "empty address string is not allowed"}, "TransferWithAgent without a from address")
+
+	msg, err := markerclient.TransferWithAgent(addr1, addr2, addr3, amount)
+	require.NoError(t, err, "TransferWithAgent")
+	expMsg := &types.MsgTransferRequest{
+		Amount:        amount,
+		Administrator: addr1.String(),
+		FromAddress:   addr2.String(),
+		ToAddress:     addr3.String(),
+	}
+	assert.Equal(t, expMsg, msg, "TransferWithAgent msg")
+}
+
+func TestUpdateNetAssetValues(t *testing.T) {
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin("usd", 1000), 1)
+
+	_, err := markerclient.UpdateNetAssetValues("hotdog", addr1)
+	assertions.AssertErrorContents(t, err, []string{"invalid msgs[0]", "net asset value list cannot be empty"}, "UpdateNetAssetValues without navs")
+
+	_, err = markerclient.UpdateNetAssetValues("hotdog", addr1, nav, nav)
+	assertions.AssertErrorContents(t, err, []string{"list of net asset values contains duplicates"}, "UpdateNetAssetValues with duplicate navs")
+
+	msg, err := markerclient.UpdateNetAssetValues("hotdog", addr1, nav)
+	require.NoError(t, err, "UpdateNetAssetValues")
+	expMsg := &types.MsgAddNetAssetValuesRequest{
+		Denom:          "hotdog",
+		Administrator:  addr1.String(),
+		NetAssetValues: []types.NetAssetValue{nav},
+	}
+	assert.Equal(t, expMsg, msg, "UpdateNetAssetValues msg")
+}
+
+func TestValidateMsgs(t *testing.T) {
+	err := markerclient.ValidateMsgs()
+	assertions.AssertErrorContents(t, err, []string{"no msgs provided"}, "ValidateMsgs with no msgs")
+
+	err = markerclient.ValidateMsgs(
+		types.NewMsgActivateRequest("hotdog", addr1),
+		types.NewMsgFinalizeRequest("x", addr1),
+	)
+	assertions.AssertErrorContents(t, err, []string{"invalid msgs[1] /provenance.marker.v1.MsgFinalizeRequest"}, "ValidateMsgs with a bad msg")
+
+	err = markerclient.ValidateMsgs(types.NewMsgActivateRequest("hotdog", addr1))
+	assert.NoError(t, err, "ValidateMsgs with a good msg")
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"bytes"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetAccessGrants returns the access grants of the marker with the provided address, in the order they were granted.
+func (k Keeper) GetAccessGrants(ctx sdk.Context, markerAddr sdk.AccAddress) []types.AccessGrant {
+	var entries []types.AccessGrantEntry
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AccessGrantKeyPrefix(markerAddr))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.AccessGrantEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			panic(err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	slices.SortFunc(entries, func(a, b types.AccessGrantEntry) int {
+		return int(a.Position) - int(b.Position)
+	})
+	rv := make([]types.AccessGrant, len(entries))
+	for i, entry := range entries {
+		rv[i] = entry.Grant
+	}
+	return rv
+}
+
+// GetAccessGrant returns the access grant that an address has on the marker with the provided address, and whether it has one.
+func (k Keeper) GetAccessGrant(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) (types.AccessGrant, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccessGrantKey(markerAddr, grantee))
+	if len(bz) == 0 {
+		return types.AccessGrant{}, false
+	}
+	var entry types.AccessGrantEntry
+	if err := k.cdc.Unmarshal(bz, &entry); err != nil {
+		panic(err)
+	}
+	return entry.Grant, true
+}
+
+// storeAccessGrants updates the access grant store to match the marker's access list.
+// Only the grants that changed are written. A copy of the marker without its access list is returned,
+// flagged so that it's known (and validated) as one that has its access grants stored separately.
+func (k Keeper) storeAccessGrants(ctx sdk.Context, marker types.MarkerAccountI) types.MarkerAccountI {
+	store := ctx.KVStore(k.storeKey)
+	markerAddr := marker.GetAddress()
+
+	var existingKeys [][]byte
+	existing := make(map[string][]byte)
+	it := storetypes.KVStorePrefixIterator(store, types.AccessGrantKeyPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		existingKeys = append(existingKeys, it.Key())
+		existing[string(it.Key())] = it.Value()
+	}
+	it.Close()
+
+	kept := make(map[string]bool)
+	for i, grant := range marker.GetAccessList() {
+		key := types.AccessGrantKey(markerAddr, grant.GetAddress())
+		bz := k.cdc.MustMarshal(&types.AccessGrantEntry{Grant: grant, Position: uint32(i)})
+		if !bytes.Equal(existing[string(key)], bz) {
+			store.Set(key, bz)
+		}
+		kept[string(key)] = true
+	}
+	for _, key := range existingKeys {
+		if !kept[string(key)] {
+			store.Delete(key)
+		}
+	}
+
+	ma, ok := marker.(*types.MarkerAccount)
+	if !ok {
+		return marker
+	}
+	rv := *ma
+	rv.AccessControl = nil
+	rv.AccessGrantsStored = true
+	return &rv
+}
+
+// removeAccessGrants deletes all of the access grants of the marker with the provided address.
+func (k Keeper) removeAccessGrants(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.AccessGrantKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// loadAccessGrants fills in the marker's access list from the access grant store.
+// Markers that still have their access list in the account (i.e. that haven't been migrated yet) are left as they are.
+func (k Keeper) loadAccessGrants(ctx sdk.Context, marker types.MarkerAccountI) {
+	ma, ok := marker.(*types.MarkerAccount)
+	if !ok || hasUnmigratedAccessList(ma) {
+		return
+	}
+	ma.AccessControl = k.GetAccessGrants(ctx, ma.GetAddress())
+	ma.AccessGrantsStored = false
+}
+
+// hasUnmigratedAccessList returns true if the marker account still has its access list in it.
+func hasUnmigratedAccessList(ma *types.MarkerAccount) bool {
+	return !ma.AccessGrantsStored && len(ma.AccessControl) > 0
+}
+
+// getMarkerWithGrantsFor looks up a marker by address, filling in its access list with only the grants of the
+// provided addresses. This is cheaper than GetMarker when only a few accounts' access needs to be checked.
+// The returned marker does not have its full access list, so it must NOT be saved.
+func (k Keeper) getMarkerWithGrantsFor(ctx sdk.Context, markerAddr sdk.AccAddress, addrs ...sdk.AccAddress) (types.MarkerAccountI, error) {
+	marker, err := k.getMarkerAccount(ctx, markerAddr)
+	if marker == nil || err != nil {
+		return marker, err
+	}
+	ma, ok := marker.(*types.MarkerAccount)
+	if !ok || hasUnmigratedAccessList(ma) {
+		return marker, nil
+	}
+	ma.AccessGrantsStored = false
+
+	seen := make(map[string]bool)
+	for _, addr := range addrs {
+		if seen[string(addr)] {
+			continue
+		}
+		seen[string(addr)] = true
+		if grant, found := k.GetAccessGrant(ctx, markerAddr, addr); found {
+			ma.AccessControl = append(ma.AccessControl, grant)
+		}
+	}
+	return marker, nil
+}
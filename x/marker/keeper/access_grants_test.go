@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestAccessGrantStore(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	admin := testUserAddress("admin")
+	minter := testUserAddress("minter")
+	other := testUserAddress("other")
+	grants := []types.AccessGrant{
+		*types.NewAccessGrant(minter, []types.Access{types.Access_Mint, types.Access_Burn}),
+		*types.NewAccessGrant(admin, []types.Access{types.Access_Admin}),
+	}
+
+	mac := types.NewEmptyMarkerAccount("grantcoin", admin.String(), grants)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+	markerAddr := mac.GetAddress()
+
+	getAccountAccessList := func() []types.AccessGrant {
+		acc := app.AccountKeeper.GetAccount(ctx, markerAddr)
+		require.NotNil(t, acc, "GetAccount")
+		marker, ok := acc.(types.MarkerAccountI)
+		require.True(t, ok, "account is a marker")
+		return marker.GetAccessList()
+	}
+	getMarkerAccessList := func() []types.AccessGrant {
+		marker, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+		require.NoError(t, err, "GetMarker")
+		require.NotNil(t, marker, "GetMarker")
+		return marker.GetAccessList()
+	}
+
+	assert.Empty(t, getAccountAccessList(), "access list stored with the account")
+	assert.Equal(t, grants, getMarkerAccessList(), "GetMarker access list")
+	assert.Equal(t, grants, app.MarkerKeeper.GetAccessGrants(ctx, markerAddr), "GetAccessGrants")
+
+	grant, found := app.MarkerKeeper.GetAccessGrant(ctx, markerAddr, admin)
+	assert.True(t, found, "GetAccessGrant(admin) found")
+	assert.Equal(t, grants[1], grant, "GetAccessGrant(admin)")
+	_, found = app.MarkerKeeper.GetAccessGrant(ctx, markerAddr, other)
+	assert.False(t, found, "GetAccessGrant(other) found")
+
+	t.Run("revoke access", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		marker, err := app.MarkerKeeper.GetMarker(cacheCtx, markerAddr)
+		require.NoError(t, err, "GetMarker")
+		require.NoError(t, marker.RevokeAccess(minter), "RevokeAccess")
+		app.MarkerKeeper.SetMarker(cacheCtx, marker)
+		assert.Equal(t, grants[1:], app.MarkerKeeper.GetAccessGrants(cacheCtx, markerAddr), "GetAccessGrants after revoke")
+		_, found = app.MarkerKeeper.GetAccessGrant(cacheCtx, markerAddr, minter)
+		assert.False(t, found, "GetAccessGrant(minter) after revoke")
+	})
+
+	t.Run("migrate 3 to 4", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		store := app.MarkerKeeper.GetStore(cacheCtx)
+		store.Delete(types.AccessGrantKey(markerAddr, minter))
+		store.Delete(types.AccessGrantKey(markerAddr, admin))
+		unmigrated := types.NewEmptyMarkerAccount("grantcoin", admin.String(), grants)
+		unmigrated.AccountNumber = mac.GetAccountNumber()
+		app.AccountKeeper.SetAccount(cacheCtx, unmigrated)
+
+		marker, err := app.MarkerKeeper.GetMarker(cacheCtx, markerAddr)
+		require.NoError(t, err, "GetMarker before migration")
+		assert.Equal(t, grants, marker.GetAccessList(), "GetMarker access list before migration")
+
+		require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate3to4(cacheCtx), "Migrate3to4")
+		assert.Equal(t, grants, app.MarkerKeeper.GetAccessGrants(cacheCtx, markerAddr), "GetAccessGrants after migration")
+		acc := app.AccountKeeper.GetAccount(cacheCtx, markerAddr)
+		assert.Empty(t, acc.(types.MarkerAccountI).GetAccessList(), "access list stored with the account after migration")
+	})
+
+	t.Run("migrate 3 to 5", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		store := app.MarkerKeeper.GetStore(cacheCtx)
+		store.Delete(types.AccessGrantKey(markerAddr, minter))
+		store.Delete(types.AccessGrantKey(markerAddr, admin))
+		store.Delete(types.MarkerDenomKey("grantcoin"))
+		unmigrated := types.NewEmptyMarkerAccount("grantcoin", admin.String(), grants)
+		unmigrated.AccountNumber = mac.GetAccountNumber()
+		app.AccountKeeper.SetAccount(cacheCtx, unmigrated)
+
+		migrator := markerkeeper.NewMigrator(app.MarkerKeeper)
+		require.NoError(t, migrator.Migrate3to4(cacheCtx), "Migrate3to4")
+		require.NoError(t, migrator.Migrate4to5(cacheCtx), "Migrate4to5")
+		assert.Equal(t, grants, app.MarkerKeeper.GetAccessGrants(cacheCtx, markerAddr), "GetAccessGrants after migrations")
+		assert.True(t, app.MarkerKeeper.IsMarkerDenom(cacheCtx, "grantcoin"), "IsMarkerDenom after migrations")
+		acc := app.AccountKeeper.GetAccount(cacheCtx, markerAddr)
+		require.NotNil(t, acc, "GetAccount after migrations")
+		assert.NoError(t, acc.(types.MarkerAccountI).Validate(), "Validate of account after migrations")
+		assert.True(t, acc.(*types.MarkerAccount).AccessGrantsStored, "AccessGrantsStored of account after migrations")
+	})
+
+	t.Run("genesis round trip", func(t *testing.T) {
+		// A finalized marker with zero supply is only valid because of its mint grant,
+		// so this also checks that the auth copy (without that grant) is still valid.
+		cacheCtx, _ := ctx.CacheContext()
+		marker, err := app.MarkerKeeper.GetMarker(cacheCtx, markerAddr)
+		require.NoError(t, err, "GetMarker")
+		require.NoError(t, marker.SetStatus(types.StatusFinalized), "SetStatus(finalized)")
+		app.MarkerKeeper.SetMarker(cacheCtx, marker)
+
+		authGen := app.AccountKeeper.ExportGenesis(cacheCtx)
+		require.NoError(t, authtypes.ValidateGenesis(*authGen), "auth ValidateGenesis")
+		markerGen := app.MarkerKeeper.ExportGenesis(cacheCtx)
+		require.NoError(t, markerGen.Validate(), "marker genesis Validate")
+
+		authAcc := app.AccountKeeper.GetAccount(cacheCtx, markerAddr)
+		require.NotNil(t, authAcc, "GetAccount")
+		require.True(t, authAcc.(*types.MarkerAccount).AccessGrantsStored, "AccessGrantsStored of exported account")
+
+		newApp := simapp.Setup(t)
+		newCtx := newApp.BaseApp.NewContext(false)
+		newApp.AccountKeeper.SetAccount(newCtx, authAcc)
+		newApp.MarkerKeeper.InitGenesis(newCtx, markerGen)
+
+		marker, err = newApp.MarkerKeeper.GetMarker(newCtx, markerAddr)
+		require.NoError(t, err, "GetMarker after import")
+		require.NotNil(t, marker, "GetMarker after import")
+		assert.Equal(t, grants, marker.GetAccessList(), "GetMarker access list after import")
+		assert.Equal(t, types.StatusFinalized, marker.GetStatus(), "GetMarker status after import")
+		assert.Equal(t, grants, newApp.MarkerKeeper.GetAccessGrants(newCtx, markerAddr), "GetAccessGrants after import")
+		acc := newApp.AccountKeeper.GetAccount(newCtx, markerAddr)
+		require.NotNil(t, acc, "GetAccount after import")
+		assert.Empty(t, acc.(types.MarkerAccountI).GetAccessList(), "access list stored with the account after import")
+		assert.NoError(t, acc.(types.MarkerAccountI).Validate(), "Validate of account after import")
+	})
+
+	t.Run("remove marker", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		marker, err := app.MarkerKeeper.GetMarker(cacheCtx, markerAddr)
+		require.NoError(t, err, "GetMarker")
+		app.MarkerKeeper.RemoveMarker(cacheCtx, marker)
+		assert.Empty(t, app.MarkerKeeper.GetAccessGrants(cacheCtx, markerAddr), "GetAccessGrants after removal")
+	})
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetPendingAdminGrant records a grant of admin access on a marker that is waiting to be accepted by the grantee.
+// Any existing pending grant for the same marker and grantee is replaced.
+func (k Keeper) SetPendingAdminGrant(ctx sdk.Context, grant types.PendingAdminGrant) error {
+	if err := grant.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(grant.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&grant)
+	if err != nil {
+		return err
+	}
+	grantee := sdk.MustAccAddressFromBech32(grant.Grantee)
+	ctx.KVStore(k.storeKey).Set(types.PendingAdminGrantKey(markerAddr, grantee), bz)
+	return nil
+}
+
+// GetPendingAdminGrant returns the pending admin grant on a marker for a grantee, and whether it was found.
+func (k Keeper) GetPendingAdminGrant(ctx sdk.Context, markerAddr, grantee sdk.AccAddress) (types.PendingAdminGrant, bool) {
+	var grant types.PendingAdminGrant
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingAdminGrantKey(markerAddr, grantee))
+	if len(bz) == 0 {
+		return grant, false
+	}
+	if err := k.cdc.Unmarshal(bz, &grant); err != nil {
+		return grant, false
+	}
+	return grant, true
+}
+
+// GetPendingAdminGrants returns all of the pending admin grants on the marker with the provided address.
+func (k Keeper) GetPendingAdminGrants(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.PendingAdminGrant, error) {
+	var rv []types.PendingAdminGrant
+	err := k.iteratePendingAdminGrants(ctx, types.PendingAdminGrantKeyPrefix(markerAddr), func(grant types.PendingAdminGrant) bool {
+		rv = append(rv, grant)
+		return false
+	})
+	return rv, err
+}
+
+// IteratePendingAdminGrants iterates over all pending admin grants on all markers.
+func (k Keeper) IteratePendingAdminGrants(ctx sdk.Context, cb func(grant types.PendingAdminGrant) (stop bool)) error {
+	return k.iteratePendingAdminGrants(ctx, types.PendingAdminGrantPrefix, cb)
+}
+
+// iteratePendingAdminGrants iterates over the pending admin grants with keys that have the provided prefix.
+func (k Keeper) iteratePendingAdminGrants(ctx sdk.Context, prefix []byte, cb func(grant types.PendingAdminGrant) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var grant types.PendingAdminGrant
+		if err := k.cdc.Unmarshal(it.Value(), &grant); err != nil {
+			return err
+		}
+		if cb(grant) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemovePendingAdminGrant removes the pending admin grant on a marker for a grantee.
+// The removed grant is returned. An error is returned if there wasn't one.
+func (k Keeper) RemovePendingAdminGrant(ctx sdk.Context, denom string, grantee sdk.AccAddress) (types.PendingAdminGrant, error) {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return types.PendingAdminGrant{}, err
+	}
+	grant, found := k.GetPendingAdminGrant(ctx, markerAddr, grantee)
+	if !found {
+		return grant, fmt.Errorf("marker %s does not have a pending admin grant for %s", denom, grantee)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.PendingAdminGrantKey(markerAddr, grantee))
+	return grant, nil
+}
+
+// RemovePendingAdminGrants removes all of the pending admin grants on the marker with the provided address.
+func (k Keeper) RemovePendingAdminGrants(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.PendingAdminGrantKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ValidateAdminGrantor returns an error if the provided address cannot grant admin access on the marker.
+// Governance can if the marker allows governance control. While a marker is proposed, only its manager can.
+// Otherwise, the address must have admin access on the marker, or be its manager while the marker is finalized.
+func (k Keeper) ValidateAdminGrantor(marker types.MarkerAccountI, addr string) error {
+	switch marker.GetStatus() {
+	case types.StatusProposed, types.StatusFinalized, types.StatusActive:
+	default:
+		return fmt.Errorf("marker in %s state can not be modified", marker.GetStatus())
+	}
+
+	if addr == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return fmt.Errorf("%s marker does not allow governance control", marker.GetDenom())
+		}
+		return nil
+	}
+	if marker.GetStatus() != types.StatusActive && marker.GetManager().String() == addr {
+		return nil
+	}
+	if marker.GetStatus() == types.StatusProposed {
+		return fmt.Errorf("updates to pending marker %s can only be made by %s", marker.GetDenom(), marker.GetManager())
+	}
+	return marker.ValidateHasAccess(addr, types.Access_Admin)
+}
+
+// AcceptAdminGrant grants admin access on a marker to a grantee with a pending admin grant, and removes the pending grant.
+// The address that proposed the grant must still be allowed to grant admin access on the marker.
+func (k Keeper) AcceptAdminGrant(ctx sdk.Context, denom string, grantee sdk.AccAddress) (types.PendingAdminGrant, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return types.PendingAdminGrant{}, fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	grant, found := k.GetPendingAdminGrant(ctx, marker.GetAddress(), grantee)
+	if !found {
+		return grant, fmt.Errorf("marker %s does not have a pending admin grant for %s", denom, grantee)
+	}
+	if err = k.ValidateAdminGrantor(marker, grant.Administrator); err != nil {
+		return grant, fmt.Errorf("admin grant proposed by %s is no longer valid: %w", grant.Administrator, err)
+	}
+
+	if err = marker.GrantAccess(types.NewAccessGrant(grantee, types.AccessList{types.Access_Admin})); err != nil {
+		return grant, fmt.Errorf("access grant failed: %w", err)
+	}
+	if err = marker.Validate(); err != nil {
+		return grant, err
+	}
+	k.SetMarker(ctx, marker)
+	ctx.KVStore(k.storeKey).Delete(types.PendingAdminGrantKey(marker.GetAddress(), grantee))
+	return grant, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+var _ attrTypes.AttributeDependencyReporter = Keeper{}
+
+// GetAttributeDependents returns an entry for each restricted marker with a required attribute satisfied by the provided name.
+func (k Keeper) GetAttributeDependents(ctx sdk.Context, name string) []attrTypes.AttributeDependent {
+	var rv []attrTypes.AttributeDependent
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+			return false
+		}
+		var matched []string
+		for _, reqAttr := range marker.GetRequiredAttributes() {
+			if MatchAttribute(reqAttr, name) {
+				matched = append(matched, reqAttr)
+			}
+		}
+		if len(matched) > 0 {
+			rv = append(rv, attrTypes.NewAttributeDependent(types.ModuleName, "marker", marker.GetDenom(), matched))
+		}
+		return false
+	})
+	return rv
+}
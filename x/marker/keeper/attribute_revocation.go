@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+var _ attrTypes.AttributeHooks = Keeper{}
+
+// AfterAttributeDeleted is called by the attribute module after an attribute is deleted from an account.
+// Each restricted marker with an attribute revocation action that requires the deleted attribute applies
+// its action to the account if the account no longer has all of the marker's required attributes.
+func (k Keeper) AfterAttributeDeleted(ctx sdk.Context, addr sdk.AccAddress, name string) error {
+	var configs []types.AttributeRevocationConfig
+	err := k.IterateAttributeRevocationConfigs(ctx, func(config types.AttributeRevocationConfig) bool {
+		configs = append(configs, config)
+		return false
+	})
+	if err != nil || len(configs) == 0 {
+		return err
+	}
+
+	var attributes []attrTypes.Attribute
+	haveAttributes := false
+	for _, config := range configs {
+		marker, err := k.GetMarkerByDenom(ctx, config.Denom)
+		if err != nil || marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+			continue
+		}
+		reqAttrs := marker.GetRequiredAttributes()
+		if !slices.ContainsFunc(reqAttrs, func(reqAttr string) bool { return MatchAttribute(reqAttr, name) }) {
+			continue
+		}
+		if marker.AddressHasAccess(addr, types.Access_Transfer) || k.CanBypassReqAttrs(ctx, addr) {
+			continue
+		}
+
+		if !haveAttributes {
+			attributes, err = k.attrKeeper.GetAllAttributesAddrWithMirror(ctx, addr)
+			if err != nil {
+				return fmt.Errorf("could not get attributes for %s: %w", addr, err)
+			}
+			haveAttributes = true
+		}
+		if len(findMissingAttributes(reqAttrs, attributes)) == 0 {
+			continue
+		}
+
+		if err = k.enforceAttributeRevocation(ctx, marker, addr, name, config.Action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enforceAttributeRevocation applies the provided action to an account that lost one of the marker's required attributes.
+func (k Keeper) enforceAttributeRevocation(ctx sdk.Context, marker types.MarkerAccountI, addr sdk.AccAddress, name string, action types.AttributeRevocationAction) error {
+	markerAddr := marker.GetAddress()
+	switch action {
+	case types.AttributeRevocationAction_DenySend:
+		if k.IsSendDeny(ctx, markerAddr, addr) {
+			return nil
+		}
+		k.AddSendDeny(ctx, markerAddr, addr)
+	case types.AttributeRevocationAction_Freeze:
+		if k.IsAccountFrozen(ctx, markerAddr, addr) {
+			return nil
+		}
+		if err := k.FreezeAccount(ctx, types.NewFrozenAccount(marker.GetDenom(), addr)); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerAttributeRevocationEnforced{
+		Denom:     marker.GetDenom(),
+		Address:   addr.String(),
+		Attribute: name,
+		Action:    action.String(),
+	})
+}
+
+// SetAttributeRevocationConfig stores what a marker does to accounts that lose one of its required attributes.
+// A config with no action is removed from state.
+func (k Keeper) SetAttributeRevocationConfig(ctx sdk.Context, config types.AttributeRevocationConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(config.Denom)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if config.Action == types.AttributeRevocationAction_None {
+		store.Delete(types.AttributeRevocationConfigKey(markerAddr))
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return err
+	}
+	store.Set(types.AttributeRevocationConfigKey(markerAddr), bz)
+	return nil
+}
+
+// GetAttributeRevocationAction returns what the marker with the provided address does to accounts that lose one of its required attributes.
+func (k Keeper) GetAttributeRevocationAction(ctx sdk.Context, markerAddr sdk.AccAddress) types.AttributeRevocationAction {
+	bz := ctx.KVStore(k.storeKey).Get(types.AttributeRevocationConfigKey(markerAddr))
+	if len(bz) == 0 {
+		return types.AttributeRevocationAction_None
+	}
+	var config types.AttributeRevocationConfig
+	if err := k.cdc.Unmarshal(bz, &config); err != nil {
+		return types.AttributeRevocationAction_None
+	}
+	return config.Action
+}
+
+// IterateAttributeRevocationConfigs iterates over all of the markers' attribute revocation configs.
+func (k Keeper) IterateAttributeRevocationConfigs(ctx sdk.Context, cb func(config types.AttributeRevocationConfig) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttributeRevocationConfigPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var config types.AttributeRevocationConfig
+		if err := k.cdc.Unmarshal(it.Value(), &config); err != nil {
+			return err
+		}
+		if cb(config) {
+			break
+		}
+	}
+	return nil
+}
+
+// IsAccountFrozen returns true if the account's outbound transfers of the marker's coin are frozen.
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, markerAddr, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.FrozenAccountKey(markerAddr, addr))
+}
+
+// FreezeAccount freezes an account's outbound transfers of a marker's coin.
+func (k Keeper) FreezeAccount(ctx sdk.Context, frozen types.FrozenAccount) error {
+	if err := frozen.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(frozen.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&frozen)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.FrozenAccountKey(markerAddr, sdk.MustAccAddressFromBech32(frozen.Address)), bz)
+	return nil
+}
+
+// UnfreezeAccount lifts the freeze on an account's outbound transfers of a marker's coin.
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, markerAddr, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.FrozenAccountKey(markerAddr, addr))
+}
+
+// IterateFrozenAccounts iterates over all of the frozen accounts.
+func (k Keeper) IterateFrozenAccounts(ctx sdk.Context, cb func(frozen types.FrozenAccount) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FrozenAccountPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var frozen types.FrozenAccount
+		if err := k.cdc.Unmarshal(it.Value(), &frozen); err != nil {
+			return err
+		}
+		if cb(frozen) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveAttributeRevocationState removes a marker's attribute revocation config and all of its frozen accounts.
+func (k Keeper) RemoveAttributeRevocationState(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AttributeRevocationConfigKey(markerAddr))
+
+	it := storetypes.KVStorePrefixIterator(store, types.FrozenAccountKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// validateNotFrozen returns an error if the account is frozen for the marker and still doesn't have all of the
+// marker's required attributes. If the account has them all again, the freeze is lifted.
+func (k Keeper) validateNotFrozen(ctx sdk.Context, marker types.MarkerAccountI, addr sdk.AccAddress) error {
+	markerAddr := marker.GetAddress()
+	if !k.IsAccountFrozen(ctx, markerAddr, addr) {
+		return nil
+	}
+
+	attributes, err := k.attrKeeper.GetAllAttributesAddrWithMirror(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("could not get attributes for %s: %w", addr, err)
+	}
+	missing := findMissingAttributes(marker.GetRequiredAttributes(), attributes)
+	if len(missing) != 0 {
+		return fmt.Errorf("%s is frozen for sending %s until it has the required attributes: \"%s\"",
+			addr, marker.GetDenom(), strings.Join(missing, `", "`))
+	}
+
+	k.UnfreezeAccount(ctx, markerAddr, addr)
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerAccountUnfrozen{
+		Denom:   marker.GetDenom(),
+		Address: addr.String(),
+	})
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestAttributeRevocationActions(t *testing.T) {
+	cz := func(amt int64, denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+
+	const attrName = "kyc.provenance.io"
+	denyDenom := "denycoin"
+	freezeDenom := "freezecoin"
+	plainDenom := "plaincoin"
+
+	addrNameOwner := sdk.AccAddress("name_owner__________")
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrHolder := sdk.AccAddress("holder______________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrNameOwner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, attrName, addrNameOwner, false), "SetNameRecord %s", attrName)
+
+	setAttr := func(addr sdk.AccAddress) {
+		require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+			attrTypes.Attribute{
+				Name:          attrName,
+				Value:         []byte("string value"),
+				Address:       addr.String(),
+				AttributeType: attrTypes.AttributeType_String,
+			},
+			addrNameOwner,
+		), "SetAttribute %s on %s", attrName, addr)
+	}
+	setAttr(addrHolder)
+	setAttr(addrOther)
+
+	for _, denom := range []string{denyDenom, freezeDenom, plainDenom} {
+		_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+			Amount:      sdk.NewInt64Coin(denom, 1000),
+			Manager:     addrAdmin.String(),
+			FromAddress: addrAdmin.String(),
+			MarkerType:  types.MarkerType_RestrictedCoin,
+			AccessList: []types.AccessGrant{
+				{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw}},
+			},
+			SupplyFixed:            true,
+			AllowGovernanceControl: true,
+			RequiredAttributes:     []string{attrName},
+		})
+		require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, addrAdmin, addrHolder, denom, cz(100, denom)), "WithdrawCoins %s", denom)
+	}
+
+	t.Run("set action requires admin", func(t *testing.T) {
+		msg := types.NewMsgSetAttributeRevocationActionRequest(denyDenom, types.AttributeRevocationAction_DenySend, addrOther)
+		_, err := msgServer.SetAttributeRevocationAction(ctx, msg)
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on denycoin marker", addrOther)
+		assert.ErrorContains(t, err, expErr, "SetAttributeRevocationAction")
+	})
+
+	_, err := msgServer.SetAttributeRevocationAction(ctx, types.NewMsgSetAttributeRevocationActionRequest(denyDenom, types.AttributeRevocationAction_DenySend, addrAdmin))
+	require.NoError(t, err, "SetAttributeRevocationAction %s", denyDenom)
+	_, err = msgServer.SetAttributeRevocationAction(ctx, types.NewMsgSetAttributeRevocationActionRequest(freezeDenom, types.AttributeRevocationAction_Freeze, addrAdmin))
+	require.NoError(t, err, "SetAttributeRevocationAction %s", freezeDenom)
+
+	t.Run("set same action again", func(t *testing.T) {
+		msg := types.NewMsgSetAttributeRevocationActionRequest(freezeDenom, types.AttributeRevocationAction_Freeze, addrAdmin)
+		_, err = msgServer.SetAttributeRevocationAction(ctx, msg)
+		assert.EqualError(t, err, "marker freezecoin already has attribute revocation action Freeze", "SetAttributeRevocationAction")
+	})
+
+	denyAddr := types.MustGetMarkerAddress(denyDenom)
+	freezeAddr := types.MustGetMarkerAddress(freezeDenom)
+	plainAddr := types.MustGetMarkerAddress(plainDenom)
+
+	sendWithCache := func(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = app.BankKeeper.SendCoins(cacheCtx, fromAddr, toAddr, amt)
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+
+	require.NoError(t, app.AttributeKeeper.DeleteAttribute(ctx, addrHolder.String(), attrName, nil, addrNameOwner), "DeleteAttribute")
+
+	t.Run("actions are enforced", func(t *testing.T) {
+		assert.True(t, app.MarkerKeeper.IsSendDeny(ctx, denyAddr, addrHolder), "IsSendDeny %s", denyDenom)
+		assert.False(t, app.MarkerKeeper.IsAccountFrozen(ctx, denyAddr, addrHolder), "IsAccountFrozen %s", denyDenom)
+		assert.True(t, app.MarkerKeeper.IsAccountFrozen(ctx, freezeAddr, addrHolder), "IsAccountFrozen %s", freezeDenom)
+		assert.False(t, app.MarkerKeeper.IsSendDeny(ctx, freezeAddr, addrHolder), "IsSendDeny %s", freezeDenom)
+		assert.False(t, app.MarkerKeeper.IsSendDeny(ctx, plainAddr, addrHolder), "IsSendDeny %s", plainDenom)
+		assert.False(t, app.MarkerKeeper.IsAccountFrozen(ctx, plainAddr, addrHolder), "IsAccountFrozen %s", plainDenom)
+		assert.False(t, app.MarkerKeeper.IsSendDeny(ctx, denyAddr, addrOther), "IsSendDeny other %s", denyDenom)
+	})
+
+	t.Run("frozen account cannot send", func(t *testing.T) {
+		err = sendWithCache(addrHolder, addrOther, cz(5, freezeDenom))
+		expErr := fmt.Sprintf("%s is frozen for sending %s until it has the required attributes: \"%s\"", addrHolder, freezeDenom, attrName)
+		assert.EqualError(t, err, expErr, "SendCoins %s", freezeDenom)
+	})
+
+	t.Run("plain marker still sends", func(t *testing.T) {
+		err = sendWithCache(addrHolder, addrOther, cz(5, plainDenom))
+		assert.NoError(t, err, "SendCoins %s", plainDenom)
+	})
+
+	setAttr(addrHolder)
+
+	t.Run("freeze lifted once attributes are back", func(t *testing.T) {
+		err = sendWithCache(addrHolder, addrOther, cz(5, freezeDenom))
+		assert.NoError(t, err, "SendCoins %s", freezeDenom)
+		assert.False(t, app.MarkerKeeper.IsAccountFrozen(ctx, freezeAddr, addrHolder), "IsAccountFrozen %s", freezeDenom)
+	})
+
+	t.Run("deny list is not lifted", func(t *testing.T) {
+		err = sendWithCache(addrHolder, addrOther, cz(5, denyDenom))
+		expErr := fmt.Sprintf("%s is on deny list for sending restricted marker", addrHolder)
+		assert.EqualError(t, err, expErr, "SendCoins %s", denyDenom)
+	})
+
+	t.Run("removed from state with the marker", func(t *testing.T) {
+		require.NoError(t, app.AttributeKeeper.DeleteAttribute(ctx, addrHolder.String(), attrName, nil, addrNameOwner), "DeleteAttribute")
+		require.True(t, app.MarkerKeeper.IsAccountFrozen(ctx, freezeAddr, addrHolder), "IsAccountFrozen %s", freezeDenom)
+		marker, err := app.MarkerKeeper.GetMarker(ctx, freezeAddr)
+		require.NoError(t, err, "GetMarker %s", freezeDenom)
+		app.MarkerKeeper.RemoveMarker(ctx, marker)
+		assert.False(t, app.MarkerKeeper.IsAccountFrozen(ctx, freezeAddr, addrHolder), "IsAccountFrozen %s", freezeDenom)
+		assert.Equal(t, types.AttributeRevocationAction_None, app.MarkerKeeper.GetAttributeRevocationAction(ctx, freezeAddr), "GetAttributeRevocationAction %s", freezeDenom)
+	})
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetBridge records the provided marker bridge.
+func (k Keeper) SetBridge(ctx sdk.Context, bridge types.MarkerBridge) error {
+	if err := bridge.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&bridge)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.BridgeKey(bridge.Name), bz)
+	return nil
+}
+
+// GetBridge returns the marker bridge with the provided name, and whether it was found.
+func (k Keeper) GetBridge(ctx sdk.Context, name string) (types.MarkerBridge, bool) {
+	var bridge types.MarkerBridge
+	bz := ctx.KVStore(k.storeKey).Get(types.BridgeKey(name))
+	if len(bz) == 0 {
+		return bridge, false
+	}
+	if err := k.cdc.Unmarshal(bz, &bridge); err != nil {
+		return bridge, false
+	}
+	return bridge, true
+}
+
+// IterateBridges iterates over all marker bridges.
+func (k Keeper) IterateBridges(ctx sdk.Context, cb func(bridge types.MarkerBridge) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BridgePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var bridge types.MarkerBridge
+		if err := k.cdc.Unmarshal(it.Value(), &bridge); err != nil {
+			return err
+		}
+		if cb(bridge) {
+			break
+		}
+	}
+	return nil
+}
+
+// ConfigureBridge creates or updates a marker bridge.
+// The amount outstanding through an existing bridge is kept, and its denom cannot be changed. Otherwise, the admin
+// of one marker could take over (and replace the attesters of) a bridge set up for another marker.
+func (k Keeper) ConfigureBridge(ctx sdk.Context, bridge types.MarkerBridge) error {
+	if existing, found := k.GetBridge(ctx, bridge.Name); found {
+		if existing.Denom != bridge.Denom {
+			return fmt.Errorf("bridge %s already exists for %s", bridge.Name, existing.Denom)
+		}
+		bridge.Outstanding = existing.Outstanding
+	}
+	return k.SetBridge(ctx, bridge)
+}
+
+// IsBridgeNonceUsed returns true if the provided nonce has already been used with the named bridge.
+func (k Keeper) IsBridgeNonceUsed(ctx sdk.Context, name string, nonce uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.BridgeNonceKey(name, nonce))
+}
+
+// SetBridgeNonceUsed records that the provided nonce has been used with the named bridge.
+func (k Keeper) SetBridgeNonceUsed(ctx sdk.Context, name string, nonce uint64) {
+	ctx.KVStore(k.storeKey).Set(types.BridgeNonceKey(name, nonce), []byte{0x01})
+}
+
+// IterateUsedBridgeNonces iterates over all nonces used by all marker bridges.
+func (k Keeper) IterateUsedBridgeNonces(ctx sdk.Context, cb func(name string, nonce uint64) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BridgeNoncePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if cb(types.ParseBridgeNonceKey(it.Key())) {
+			break
+		}
+	}
+}
+
+// BridgeMint mints coin through a bridge and sends it to the message's recipient.
+// The message must be for this chain, have an unused nonce, and be signed by at least the bridge's threshold of attesters.
+// The amount outstanding through the bridge (including this mint) cannot exceed the bridge's mint limit.
+func (k Keeper) BridgeMint(ctx sdk.Context, msg types.BridgeMessage, signatures [][]byte) error {
+	bridge, found := k.GetBridge(ctx, msg.Bridge)
+	if !found {
+		return fmt.Errorf("bridge %s not found", msg.Bridge)
+	}
+	if msg.ChainId != ctx.ChainID() {
+		return fmt.Errorf("message chain id %q does not match %q", msg.ChainId, ctx.ChainID())
+	}
+	if msg.Amount.Denom != bridge.Denom {
+		return fmt.Errorf("bridge %s cannot mint %s, only %s", bridge.Name, msg.Amount.Denom, bridge.Denom)
+	}
+	if k.IsBridgeNonceUsed(ctx, bridge.Name, msg.Nonce) {
+		return fmt.Errorf("nonce %d has already been used with bridge %s", msg.Nonce, bridge.Name)
+	}
+	if err := k.verifyBridgeSignatures(ctx, bridge, msg, signatures); err != nil {
+		return err
+	}
+
+	outstanding := bridge.Outstanding.Add(msg.Amount.Amount)
+	if outstanding.GT(bridge.MintLimit) {
+		return fmt.Errorf("cannot mint %s through bridge %s: outstanding amount would exceed the mint limit of %s%s",
+			msg.Amount, bridge.Name, bridge.MintLimit, bridge.Denom)
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, bridge.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", bridge.Denom, err)
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return errors.New("cannot mint coin for a marker that is not in Active status")
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	if err = k.IncreaseSupply(ctx, marker, msg.Amount); err != nil {
+		return err
+	}
+	// The bridge was approved by someone with mint authority, so the usual send restrictions are bypassed here
+	// the same way they are when a supply increase is sent to a target address.
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), marker.GetAddress(), recipient, sdk.NewCoins(msg.Amount)); err != nil {
+		return err
+	}
+
+	k.SetBridgeNonceUsed(ctx, bridge.Name, msg.Nonce)
+	bridge.Outstanding = outstanding
+	return k.SetBridge(ctx, bridge)
+}
+
+// verifyBridgeSignatures returns an error unless enough distinct attesters of the bridge have signed the message.
+func (k Keeper) verifyBridgeSignatures(ctx sdk.Context, bridge types.MarkerBridge, msg types.BridgeMessage, signatures [][]byte) error {
+	if len(signatures) > len(bridge.Attesters) {
+		return fmt.Errorf("too many signatures: bridge %s has %d attesters, got %d signatures",
+			bridge.Name, len(bridge.Attesters), len(signatures))
+	}
+	signBytes, err := msg.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	signed := make([]bool, len(bridge.Attesters))
+	count := uint32(0)
+	for _, sig := range signatures {
+		for i, attester := range bridge.Attesters {
+			if signed[i] {
+				continue
+			}
+			ctx.GasMeter().ConsumeGas(types.BridgeSigVerifyCost, "bridge attestation")
+			pubKey := &secp256k1.PubKey{Key: attester}
+			if pubKey.VerifySignature(signBytes, sig) {
+				signed[i] = true
+				count++
+				break
+			}
+		}
+	}
+
+	if count < bridge.Threshold {
+		return fmt.Errorf("message has %d valid attester signatures, bridge %s requires %d", count, bridge.Name, bridge.Threshold)
+	}
+	return nil
+}
+
+// BridgeBurn takes coin from the sender and burns it through a bridge so that it can be released on another chain.
+// The amount cannot be more than the amount outstanding through the bridge.
+func (k Keeper) BridgeBurn(ctx sdk.Context, name string, sender sdk.AccAddress, amount sdk.Coin) error {
+	bridge, found := k.GetBridge(ctx, name)
+	if !found {
+		return fmt.Errorf("bridge %s not found", name)
+	}
+	if amount.Denom != bridge.Denom {
+		return fmt.Errorf("bridge %s cannot burn %s, only %s", bridge.Name, amount.Denom, bridge.Denom)
+	}
+	if amount.Amount.GT(bridge.Outstanding) {
+		return fmt.Errorf("cannot burn %s through bridge %s: only %s%s is outstanding",
+			amount, bridge.Name, bridge.Outstanding, bridge.Denom)
+	}
+
+	marker, err := k.GetMarkerByDenom(ctx, bridge.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", bridge.Denom, err)
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return errors.New("cannot burn coin for a marker that is not in Active status")
+	}
+
+	// The sender is giving up coin it holds, so the marker's usual send restrictions (e.g. deny list, frozen accounts,
+	// required attributes) are applied to it as if it were sending the coin to itself. Holders don't have the deposit
+	// access needed to send coin to a marker, so only the move into the marker account bypasses them.
+	coins := sdk.NewCoins(amount)
+	if _, err = k.SendRestrictionFn(ctx, sender, sender, coins); err != nil {
+		return err
+	}
+	if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), sender, marker.GetAddress(), coins); err != nil {
+		return err
+	}
+	if err = k.DecreaseSupply(ctx, marker, amount); err != nil {
+		return err
+	}
+
+	bridge.Outstanding = bridge.Outstanding.Sub(amount.Amount)
+	return k.SetBridge(ctx, bridge)
+}
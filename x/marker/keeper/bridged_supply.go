@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetBridgedSupply stores how a bridged marker's supply is reconciled against the balance locked on the counterparty
+// chain. A bridged supply without a channel, lock address, remote denom, or interval is removed from state.
+func (k Keeper) SetBridgedSupply(ctx sdk.Context, bridged types.BridgedSupply) error {
+	markerAddr, err := types.MarkerAddress(bridged.Denom)
+	if err != nil {
+		return err
+	}
+	if bridged.IsEmpty() {
+		k.RemoveBridgedSupply(ctx, markerAddr)
+		return nil
+	}
+	if err = bridged.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&bridged)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.BridgedSupplyKey(markerAddr), bz)
+	return nil
+}
+
+// GetBridgedSupply returns how the supply of the marker with the provided address is reconciled.
+// Returns nil if the marker is not reconciled.
+func (k Keeper) GetBridgedSupply(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.BridgedSupply, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BridgedSupplyKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var bridged types.BridgedSupply
+	if err := k.cdc.Unmarshal(bz, &bridged); err != nil {
+		return nil, fmt.Errorf("could not read bridged supply for %s: %w", markerAddr, err)
+	}
+	return &bridged, nil
+}
+
+// RemoveBridgedSupply stops reconciling the supply of the marker with the provided address and removes its latest
+// reconciliation.
+func (k Keeper) RemoveBridgedSupply(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BridgedSupplyKey(markerAddr))
+	store.Delete(types.BridgedSupplyReconciliationKey(markerAddr))
+}
+
+// IterateBridgedSupplies iterates over all of the bridged markers' supply reconciliation settings.
+func (k Keeper) IterateBridgedSupplies(ctx sdk.Context, cb func(bridged types.BridgedSupply) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BridgedSupplyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var bridged types.BridgedSupply
+		if err := k.cdc.Unmarshal(it.Value(), &bridged); err != nil {
+			return err
+		}
+		if cb(bridged) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetBridgedSupplyReconciliation stores the latest supply reconciliation of a bridged marker.
+func (k Keeper) SetBridgedSupplyReconciliation(ctx sdk.Context, recon types.BridgedSupplyReconciliation) error {
+	if err := recon.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(recon.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&recon)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.BridgedSupplyReconciliationKey(markerAddr), bz)
+	return nil
+}
+
+// GetBridgedSupplyReconciliation returns the latest supply reconciliation of the marker with the provided address.
+// Returns nil if no remote balance has been received for the marker yet.
+func (k Keeper) GetBridgedSupplyReconciliation(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.BridgedSupplyReconciliation, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BridgedSupplyReconciliationKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var recon types.BridgedSupplyReconciliation
+	if err := k.cdc.Unmarshal(bz, &recon); err != nil {
+		return nil, fmt.Errorf("could not read bridged supply reconciliation for %s: %w", markerAddr, err)
+	}
+	return &recon, nil
+}
+
+// IterateBridgedSupplyReconciliations iterates over the latest supply reconciliations of all of the bridged markers.
+func (k Keeper) IterateBridgedSupplyReconciliations(ctx sdk.Context, cb func(recon types.BridgedSupplyReconciliation) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BridgedSupplyReconciliationPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var recon types.BridgedSupplyReconciliation
+		if err := k.cdc.Unmarshal(it.Value(), &recon); err != nil {
+			return err
+		}
+		if cb(recon) {
+			break
+		}
+	}
+	return nil
+}
+
+// RecordBridgedSupplyBalance compares the balance locked on the counterparty chain to the marker's current supply,
+// then records and emits the result. The marker must still be configured for reconciliation.
+func (k Keeper) RecordBridgedSupplyBalance(ctx sdk.Context, denom string, remoteBalance sdkmath.Int) error {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return err
+	}
+	bridged, err := k.GetBridgedSupply(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	if bridged == nil {
+		return fmt.Errorf("marker %s is not configured for bridged supply reconciliation", denom)
+	}
+
+	localSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	recon := types.NewBridgedSupplyReconciliation(denom, remoteBalance, localSupply, ctx.BlockHeight())
+	if err = k.SetBridgedSupplyReconciliation(ctx, recon); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerBridgedSupplyReconciled{
+		Denom:         denom,
+		Status:        recon.Status.String(),
+		RemoteBalance: recon.RemoteBalance.String(),
+		LocalSupply:   recon.LocalSupply.String(),
+	})
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestBridgedSupply(t *testing.T) {
+	const denom = "bridgedatom"
+	const lockAddr = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	queryServer := app.MarkerKeeper
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 100),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_Coin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+	require.NoError(t, err, "GetMarker %s", denom)
+
+	t.Run("set requires admin", func(t *testing.T) {
+		msg := types.NewMsgSetBridgedSupplyRequest(denom, "channel-0", lockAddr, "uatom", 10, addrOther)
+		_, err = msgServer.SetBridgedSupply(ctx, msg)
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrOther, denom)
+		assert.ErrorContains(t, err, expErr, "SetBridgedSupply")
+	})
+
+	t.Run("record without bridged supply", func(t *testing.T) {
+		err = app.MarkerKeeper.RecordBridgedSupplyBalance(ctx, denom, sdkmath.NewInt(100))
+		expErr := fmt.Sprintf("marker %s is not configured for bridged supply reconciliation", denom)
+		assert.EqualError(t, err, expErr, "RecordBridgedSupplyBalance")
+	})
+
+	msg := types.NewMsgSetBridgedSupplyRequest(denom, "channel-0", lockAddr, "uatom", 10, addrAdmin)
+	_, err = msgServer.SetBridgedSupply(ctx, msg)
+	require.NoError(t, err, "SetBridgedSupply")
+	expBridged := types.NewBridgedSupply(denom, "channel-0", lockAddr, "uatom", 10)
+
+	t.Run("query before reconciliation", func(t *testing.T) {
+		resp, err := queryServer.BridgedSupply(ctx, &types.QueryBridgedSupplyRequest{Id: denom})
+		require.NoError(t, err, "BridgedSupply")
+		assert.Equal(t, &expBridged, resp.BridgedSupply, "BridgedSupply")
+		assert.Nil(t, resp.Reconciliation, "Reconciliation")
+	})
+
+	t.Run("matched", func(t *testing.T) {
+		em := sdk.NewEventManager()
+		err = app.MarkerKeeper.RecordBridgedSupplyBalance(ctx.WithEventManager(em), denom, sdkmath.NewInt(100))
+		require.NoError(t, err, "RecordBridgedSupplyBalance")
+		recon, err := app.MarkerKeeper.GetBridgedSupplyReconciliation(ctx, markerAddr)
+		require.NoError(t, err, "GetBridgedSupplyReconciliation")
+		require.NotNil(t, recon, "GetBridgedSupplyReconciliation")
+		assert.Equal(t, types.ReconciliationStatus_Matched, recon.Status, "Status")
+		assert.Equal(t, int64(10), recon.Height, "Height")
+
+		expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerBridgedSupplyReconciled{
+			Denom:         denom,
+			Status:        types.ReconciliationStatus_Matched.String(),
+			RemoteBalance: "100",
+			LocalSupply:   "100",
+		})
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Equal(t, sdk.Events{expEvent}, em.Events(), "emitted events")
+	})
+
+	t.Run("mismatched", func(t *testing.T) {
+		err = app.MarkerKeeper.RecordBridgedSupplyBalance(ctx, denom, sdkmath.NewInt(90))
+		require.NoError(t, err, "RecordBridgedSupplyBalance")
+		resp, err := queryServer.BridgedSupply(ctx, &types.QueryBridgedSupplyRequest{Id: marker.GetAddress().String()})
+		require.NoError(t, err, "BridgedSupply")
+		require.NotNil(t, resp.Reconciliation, "Reconciliation")
+		assert.Equal(t, types.ReconciliationStatus_Mismatched, resp.Reconciliation.Status, "Status")
+		assert.Equal(t, "90", resp.Reconciliation.RemoteBalance.String(), "RemoteBalance")
+		assert.Equal(t, "100", resp.Reconciliation.LocalSupply.String(), "LocalSupply")
+	})
+
+	t.Run("exported in genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Equal(t, []types.BridgedSupply{expBridged}, genState.BridgedSupplies, "BridgedSupplies")
+		require.Len(t, genState.BridgedSupplyReconciliations, 1, "BridgedSupplyReconciliations")
+		assert.Equal(t, types.ReconciliationStatus_Mismatched, genState.BridgedSupplyReconciliations[0].Status, "reconciliation status")
+	})
+
+	t.Run("changing settings clears reconciliation", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		msg := types.NewMsgSetBridgedSupplyRequest(denom, "channel-1", lockAddr, "uatom", 20, addrAdmin)
+		_, err = msgServer.SetBridgedSupply(cacheCtx, msg)
+		require.NoError(t, err, "SetBridgedSupply")
+		recon, err := app.MarkerKeeper.GetBridgedSupplyReconciliation(cacheCtx, markerAddr)
+		require.NoError(t, err, "GetBridgedSupplyReconciliation")
+		assert.Nil(t, recon, "GetBridgedSupplyReconciliation")
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		msg := types.NewMsgSetBridgedSupplyRequest(denom, "", "", "", 0, addrAdmin)
+		_, err = msgServer.SetBridgedSupply(ctx, msg)
+		require.NoError(t, err, "SetBridgedSupply")
+		resp, err := queryServer.BridgedSupply(ctx, &types.QueryBridgedSupplyRequest{Id: denom})
+		require.NoError(t, err, "BridgedSupply")
+		assert.Nil(t, resp.BridgedSupply, "BridgedSupply")
+		assert.Nil(t, resp.Reconciliation, "Reconciliation")
+	})
+}
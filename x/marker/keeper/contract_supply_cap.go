@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetContractSupplyCap stores how much a smart contract can mint or burn of a marker each day.
+// A cap with both limits at zero is kept so that it blocks the contract; use RemoveContractSupplyCap to remove a cap.
+func (k Keeper) SetContractSupplyCap(ctx sdk.Context, supplyCap types.ContractSupplyCap) error {
+	if err := supplyCap.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(supplyCap.Denom)
+	if err != nil {
+		return err
+	}
+	contract := sdk.MustAccAddressFromBech32(supplyCap.Contract)
+	bz, err := k.cdc.Marshal(&supplyCap)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ContractSupplyCapKey(markerAddr, contract), bz)
+	return nil
+}
+
+// GetContractSupplyCap returns the supply cap of a smart contract on the marker with the provided address.
+// Returns nil if the contract does not have one.
+func (k Keeper) GetContractSupplyCap(ctx sdk.Context, markerAddr, contract sdk.AccAddress) (*types.ContractSupplyCap, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractSupplyCapKey(markerAddr, contract))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var supplyCap types.ContractSupplyCap
+	if err := k.cdc.Unmarshal(bz, &supplyCap); err != nil {
+		return nil, fmt.Errorf("could not read supply cap of %s for %s: %w", contract, markerAddr, err)
+	}
+	return &supplyCap, nil
+}
+
+// RemoveContractSupplyCap removes the supply cap of a smart contract on the marker with the provided address.
+func (k Keeper) RemoveContractSupplyCap(ctx sdk.Context, markerAddr, contract sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ContractSupplyCapKey(markerAddr, contract))
+}
+
+// RemoveContractSupplyCaps removes all of the contract supply caps of the marker with the provided address.
+func (k Keeper) RemoveContractSupplyCaps(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ContractSupplyCapKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateContractSupplyCaps iterates over all of the contract supply caps.
+func (k Keeper) IterateContractSupplyCaps(ctx sdk.Context, cb func(supplyCap types.ContractSupplyCap) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ContractSupplyCapPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var supplyCap types.ContractSupplyCap
+		if err := k.cdc.Unmarshal(it.Value(), &supplyCap); err != nil {
+			return err
+		}
+		if cb(supplyCap) {
+			break
+		}
+	}
+	return nil
+}
+
+// useContractSupplyCap records an amount minted or burned by the caller against its supply cap on the marker.
+// Returns an error if that would exceed the caller's daily limit. Callers without a cap are not limited.
+func (k Keeper) useContractSupplyCap(ctx sdk.Context, markerAddr, caller sdk.AccAddress, amount sdkmath.Int, mint bool) error {
+	supplyCap, err := k.GetContractSupplyCap(ctx, markerAddr, caller)
+	if err != nil || supplyCap == nil {
+		return err
+	}
+	day := types.ContractSupplyCapDay(ctx.BlockTime())
+	var updated types.ContractSupplyCap
+	if mint {
+		updated, err = supplyCap.AddMinted(day, amount)
+	} else {
+		updated, err = supplyCap.AddBurned(day, amount)
+	}
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&updated)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ContractSupplyCapKey(markerAddr, caller), bz)
+	return nil
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestContractSupplyCap(t *testing.T) {
+	const denom = "hotdog"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrContract := sdk.AccAddress("contract____________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC))
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	queryServer := app.MarkerKeeper
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_Coin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Burn}},
+			{Address: addrContract.String(), Permissions: types.AccessList{types.Access_Mint, types.Access_Burn}},
+		},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+
+	setCap := func(ctx sdk.Context, mintLimit, burnLimit int64, signer string) error {
+		_, err := msgServer.SetContractSupplyCap(ctx, types.NewMsgSetContractSupplyCapRequest(denom, addrContract,
+			sdkmath.NewInt(mintLimit), sdkmath.NewInt(burnLimit), sdk.MustAccAddressFromBech32(signer)))
+		return err
+	}
+	getCaps := func(ctx sdk.Context) []types.ContractSupplyCap {
+		resp, err := queryServer.ContractSupplyCaps(ctx, &types.QueryContractSupplyCapsRequest{Id: denom})
+		require.NoError(t, err, "ContractSupplyCaps")
+		return resp.Caps
+	}
+
+	t.Run("set requires admin", func(t *testing.T) {
+		err = setCap(ctx, 100, 50, addrOther.String())
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrOther, denom)
+		assert.ErrorContains(t, err, expErr, "SetContractSupplyCap")
+	})
+
+	t.Run("contract cannot set its own cap", func(t *testing.T) {
+		msg := types.NewMsgSetContractSupplyCapRequest(denom, addrContract, sdkmath.NewInt(100), sdkmath.NewInt(50), addrContract)
+		assert.EqualError(t, msg.ValidateBasic(), "a contract cannot set its own supply cap", "ValidateBasic")
+	})
+
+	t.Run("uncapped contract is not limited", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrContract, sdk.NewInt64Coin(denom, 5000))
+		assert.NoError(t, err, "MintCoin")
+	})
+
+	em := sdk.NewEventManager()
+	err = setCap(ctx.WithEventManager(em), 100, 50, addrAdmin.String())
+	require.NoError(t, err, "SetContractSupplyCap")
+	expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerContractSupplyCapSet{
+		Denom:          denom,
+		Contract:       addrContract.String(),
+		DailyMintLimit: "100",
+		DailyBurnLimit: "50",
+		Administrator:  addrAdmin.String(),
+	})
+	require.NoError(t, err, "TypedEventToEvent")
+	assert.Equal(t, sdk.Events{expEvent}, em.Events(), "emitted events")
+
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, addrContract, sdk.NewInt64Coin(denom, 60)), "MintCoin 60")
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, addrContract, sdk.NewInt64Coin(denom, 20)), "BurnCoin 20")
+
+	t.Run("query", func(t *testing.T) {
+		caps := getCaps(ctx)
+		require.Len(t, caps, 1, "caps")
+		assert.Equal(t, addrContract.String(), caps[0].Contract, "Contract")
+		assert.Equal(t, "60", caps[0].Minted.String(), "Minted")
+		assert.Equal(t, "20", caps[0].Burned.String(), "Burned")
+	})
+
+	t.Run("mint over daily limit", func(t *testing.T) {
+		err = app.MarkerKeeper.MintCoin(ctx, addrContract, sdk.NewInt64Coin(denom, 41))
+		expErr := fmt.Sprintf("cannot mint 41%s: contract %s can only mint 40%s more today (daily limit 100)", denom, addrContract, denom)
+		assert.EqualError(t, err, expErr, "MintCoin")
+	})
+
+	t.Run("burn over daily limit", func(t *testing.T) {
+		err = app.MarkerKeeper.BurnCoin(ctx, addrContract, sdk.NewInt64Coin(denom, 31))
+		assert.ErrorContains(t, err, "can only burn 30"+denom+" more today", "BurnCoin")
+	})
+
+	t.Run("admin is not limited by the contract cap", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 500))
+		assert.NoError(t, err, "MintCoin")
+	})
+
+	t.Run("changing the limits keeps today's usage", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, setCap(cacheCtx, 70, 50, addrAdmin.String()), "SetContractSupplyCap")
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrContract, sdk.NewInt64Coin(denom, 11))
+		assert.ErrorContains(t, err, "can only mint 10"+denom+" more today", "MintCoin")
+	})
+
+	t.Run("limits reset the next day", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithBlockTime(time.Date(2024, 3, 6, 0, 0, 1, 0, time.UTC))
+		caps := getCaps(cacheCtx)
+		require.Len(t, caps, 1, "caps")
+		assert.Equal(t, "0", caps[0].Minted.String(), "Minted")
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrContract, sdk.NewInt64Coin(denom, 100))
+		assert.NoError(t, err, "MintCoin")
+	})
+
+	t.Run("exported in genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		require.Len(t, genState.ContractSupplyCaps, 1, "ContractSupplyCaps")
+		assert.Equal(t, "60", genState.ContractSupplyCaps[0].Minted.String(), "Minted")
+	})
+
+	t.Run("zero limits block minting and burning", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithBlockTime(time.Date(2024, 3, 6, 0, 0, 1, 0, time.UTC))
+		require.NoError(t, setCap(cacheCtx, 0, 0, addrAdmin.String()), "SetContractSupplyCap")
+		require.Len(t, getCaps(cacheCtx), 1, "caps")
+		err = app.MarkerKeeper.MintTranche(cacheCtx, addrContract, sdk.NewInt64Coin(denom, 1), "", nil)
+		assert.ErrorContains(t, err, "can only mint 0"+denom+" more today (daily limit 0)", "MintTranche")
+		err = app.MarkerKeeper.BurnCoin(cacheCtx, addrContract, sdk.NewInt64Coin(denom, 1))
+		assert.ErrorContains(t, err, "can only burn 0"+denom+" more today (daily limit 0)", "BurnCoin")
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		em := sdk.NewEventManager()
+		_, err = msgServer.SetContractSupplyCap(ctx.WithEventManager(em), &types.MsgSetContractSupplyCapRequest{
+			Denom: denom, Contract: addrContract.String(), Signer: addrAdmin.String(), Remove: true,
+		})
+		require.NoError(t, err, "SetContractSupplyCap remove")
+		expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerContractSupplyCapSet{
+			Denom:         denom,
+			Contract:      addrContract.String(),
+			Administrator: addrAdmin.String(),
+			Removed:       true,
+		})
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Equal(t, sdk.Events{expEvent}, em.Events(), "emitted events")
+		assert.Empty(t, getCaps(ctx), "caps")
+		err = app.MarkerKeeper.MintCoin(ctx, addrContract, sdk.NewInt64Coin(denom, 500))
+		assert.NoError(t, err, "MintCoin")
+	})
+}
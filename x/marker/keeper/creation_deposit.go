@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetCreationDepositAmount returns the deposit currently required to create a marker, and whether one is required.
+func (k Keeper) GetCreationDepositAmount(ctx sdk.Context) (sdk.Coin, bool) {
+	params := k.GetParams(ctx)
+	if !params.HasCreationDeposit() {
+		return sdk.Coin{}, false
+	}
+	return sdk.NewCoin(pioconfig.GetProvenanceConfig().FeeDenom, params.CreationDeposit), true
+}
+
+// SetCreationDeposit records a creation deposit that is escrowed for a marker.
+func (k Keeper) SetCreationDeposit(ctx sdk.Context, deposit types.MarkerCreationDeposit) error {
+	if err := deposit.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(deposit.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&deposit)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.CreationDepositKey(markerAddr), bz)
+	return nil
+}
+
+// GetCreationDeposit returns the creation deposit escrowed for the marker with the provided address, and whether it was found.
+func (k Keeper) GetCreationDeposit(ctx sdk.Context, markerAddr sdk.AccAddress) (types.MarkerCreationDeposit, bool) {
+	var deposit types.MarkerCreationDeposit
+	bz := ctx.KVStore(k.storeKey).Get(types.CreationDepositKey(markerAddr))
+	if len(bz) == 0 {
+		return deposit, false
+	}
+	if err := k.cdc.Unmarshal(bz, &deposit); err != nil {
+		return deposit, false
+	}
+	return deposit, true
+}
+
+// IterateCreationDeposits iterates over all of the escrowed creation deposits.
+func (k Keeper) IterateCreationDeposits(ctx sdk.Context, cb func(deposit types.MarkerCreationDeposit) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CreationDepositPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var deposit types.MarkerCreationDeposit
+		if err := k.cdc.Unmarshal(it.Value(), &deposit); err != nil {
+			return err
+		}
+		if cb(deposit) {
+			break
+		}
+	}
+	return nil
+}
+
+// EscrowCreationDeposit moves the required creation deposit (if there is one) from the depositor into the
+// module account and records it against the marker.
+func (k Keeper) EscrowCreationDeposit(ctx sdk.Context, marker types.MarkerAccountI, depositor sdk.AccAddress) error {
+	amount, required := k.GetCreationDepositAmount(ctx)
+	if !required {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.CoinPoolName, sdk.NewCoins(amount)); err != nil {
+		return fmt.Errorf("could not escrow marker creation deposit %s from %s: %w", amount, depositor, err)
+	}
+	timeoutHeight := ctx.BlockHeight() + k.GetParams(ctx).CreationDepositTimeoutBlocks
+	deposit := types.NewMarkerCreationDeposit(marker.GetDenom(), depositor, amount, timeoutHeight)
+	return k.SetCreationDeposit(ctx, deposit)
+}
+
+// RefundCreationDeposit returns a marker's escrowed creation deposit (if there is one) to its depositor.
+func (k Keeper) RefundCreationDeposit(ctx sdk.Context, marker types.MarkerAccountI) error {
+	deposit, found := k.GetCreationDeposit(ctx, marker.GetAddress())
+	if !found {
+		return nil
+	}
+	depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return fmt.Errorf("invalid creation deposit depositor %q: %w", deposit.Depositor, err)
+	}
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, depositor, sdk.NewCoins(deposit.Amount)); err != nil {
+		return fmt.Errorf("could not refund marker creation deposit %s to %s: %w", deposit.Amount, depositor, err)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.CreationDepositKey(marker.GetAddress()))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerCreationDepositRefunded(deposit))
+}
+
+// SettleCreationDepositOnCancel handles a marker's escrowed creation deposit (if there is one) when the marker
+// is cancelled. The deposit is refunded if the marker is cancelled before the deposit's timeout, otherwise it's burned.
+func (k Keeper) SettleCreationDepositOnCancel(ctx sdk.Context, marker types.MarkerAccountI) error {
+	deposit, found := k.GetCreationDeposit(ctx, marker.GetAddress())
+	if !found {
+		return nil
+	}
+	if !deposit.IsTimedOut(ctx.BlockHeight()) {
+		return k.RefundCreationDeposit(ctx, marker)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.CoinPoolName, sdk.NewCoins(deposit.Amount)); err != nil {
+		return fmt.Errorf("could not burn marker creation deposit %s: %w", deposit.Amount, err)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.CreationDepositKey(marker.GetAddress()))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMarkerCreationDepositBurned(deposit))
+}
@@ -3,11 +3,13 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 // ValidateDenomMetadata performs extended validation of the denom metadata fields.
@@ -91,3 +93,62 @@ func (k Keeper) ValidateDenomMetadata(ctx sdk.Context, proposed banktypes.Metada
 
 	return nil
 }
+
+// GetDenomMetadataForUnit returns the denom metadata that has the provided denom as its base, or as the denom or
+// alias of one of its denom units.
+func (k Keeper) GetDenomMetadataForUnit(ctx sdk.Context, denom string) (banktypes.Metadata, bool) {
+	if md, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return md, true
+	}
+
+	var rv banktypes.Metadata
+	var found bool
+	k.bankKeeper.IterateAllDenomMetaData(ctx, func(md banktypes.Metadata) bool {
+		if _, found = types.FindDenomUnit(md, denom); found {
+			rv = md
+		}
+		return found
+	})
+	return rv, found
+}
+
+// ConvertToBaseCoin converts a coin in display (or any other) units into base units using the denom metadata.
+func (k Keeper) ConvertToBaseCoin(ctx sdk.Context, coin sdk.DecCoin) (sdk.Coin, error) {
+	md, found := k.GetDenomMetadataForUnit(ctx, coin.Denom)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("no denom metadata found for %q", coin.Denom)
+	}
+	return types.ConvertToBaseCoin(coin, md)
+}
+
+// ConvertToDisplayCoin converts a coin in base units into display units using the denom metadata.
+func (k Keeper) ConvertToDisplayCoin(ctx sdk.Context, coin sdk.Coin) (sdk.DecCoin, error) {
+	md, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom)
+	if !found {
+		return sdk.DecCoin{}, fmt.Errorf("no denom metadata found for %q", coin.Denom)
+	}
+	return types.ConvertToDisplayCoin(coin, md)
+}
+
+// ValidateDenomNameOwnership returns an error if denom-name linkage is being enforced and the provided creator
+// does not own the restricted name that the denom falls under. The closest existing name is used, starting with
+// the denom itself, then each of its parents, e.g. for "shares.acme.io": "shares.acme.io", "acme.io", then "io".
+// If that name is unrestricted (or there isn't one), anyone can create the denom.
+func (k Keeper) ValidateDenomNameOwnership(ctx sdk.Context, denom string, creator string) error {
+	if !k.nameKeeper.GetEnforceDenomNameLinkage(ctx) {
+		return nil
+	}
+
+	name := nametypes.NormalizeName(denom)
+	for len(name) > 0 {
+		record, err := k.nameKeeper.GetRecordByName(ctx, name)
+		if err == nil && record != nil {
+			if record.Restricted && record.Address != creator {
+				return fmt.Errorf("%s cannot create marker %s: restricted name %s is owned by %s", creator, denom, name, record.Address)
+			}
+			return nil
+		}
+		_, name, _ = strings.Cut(name, ".")
+	}
+	return nil
+}
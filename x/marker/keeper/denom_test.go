@@ -462,3 +462,117 @@ func (s *DenomTestSuite) TestValidateDenomMetadataExtended() {
 		})
 	}
 }
+
+func (s *DenomTestSuite) TestDisplayConversion() {
+	md := banktypes.Metadata{
+		Description: "hotdog",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "hotdog", Exponent: 0},
+			{Denom: "kilohotdog", Exponent: 3, Aliases: []string{"khotdog"}},
+		},
+		Base:    "hotdog",
+		Display: "kilohotdog",
+		Name:    "Hotdog",
+		Symbol:  "HOTDOG",
+	}
+	s.app.BankKeeper.SetDenomMetaData(s.ctx, md)
+
+	s.Run("GetDenomMetadataForUnit", func() {
+		for _, denom := range []string{"hotdog", "kilohotdog", "khotdog"} {
+			actual, found := s.app.MarkerKeeper.GetDenomMetadataForUnit(s.ctx, denom)
+			s.Assert().True(found, "GetDenomMetadataForUnit(%q) found", denom)
+			s.Assert().Equal(md, actual, "GetDenomMetadataForUnit(%q) metadata", denom)
+		}
+		_, found := s.app.MarkerKeeper.GetDenomMetadataForUnit(s.ctx, "megahotdog")
+		s.Assert().False(found, "GetDenomMetadataForUnit(megahotdog) found")
+	})
+
+	tests := []struct {
+		name   string
+		coin   string
+		exp    string
+		expErr string
+	}{
+		{name: "display denom", coin: "1.5kilohotdog", exp: "1500hotdog"},
+		{name: "alias", coin: "2khotdog", exp: "2000hotdog"},
+		{name: "base denom", coin: "7hotdog", exp: "7hotdog"},
+		{
+			name:   "too many decimals",
+			coin:   "0.0005kilohotdog",
+			expErr: "amount 0.000500000000000000kilohotdog is not a whole number of hotdog (exponent 3)",
+		},
+		{name: "no metadata", coin: "1megahotdog", expErr: "no denom metadata found for \"megahotdog\""},
+	}
+	for _, tc := range tests {
+		s.Run("ConvertToBaseCoin "+tc.name, func() {
+			coin, err := sdk.ParseDecCoin(tc.coin)
+			s.Require().NoError(err, "ParseDecCoin(%q)", tc.coin)
+			actual, err := s.app.MarkerKeeper.ConvertToBaseCoin(s.ctx, coin)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ConvertToBaseCoin error")
+				return
+			}
+			s.Require().NoError(err, "ConvertToBaseCoin error")
+			s.Assert().Equal(tc.exp, actual.String(), "ConvertToBaseCoin result")
+		})
+	}
+
+	s.Run("ConvertToDisplayCoin", func() {
+		actual, err := s.app.MarkerKeeper.ConvertToDisplayCoin(s.ctx, sdk.NewInt64Coin("hotdog", 1250))
+		s.Require().NoError(err, "ConvertToDisplayCoin error")
+		s.Assert().Equal("1.250000000000000000kilohotdog", actual.String(), "ConvertToDisplayCoin result")
+
+		_, err = s.app.MarkerKeeper.ConvertToDisplayCoin(s.ctx, sdk.NewInt64Coin("kilohotdog", 1))
+		s.Assert().EqualError(err, "no denom metadata found for \"kilohotdog\"", "ConvertToDisplayCoin error")
+	})
+}
+
+func (s *DenomTestSuite) TestValidateDenomNameOwnership() {
+	owner := sdk.AccAddress("owner_______________").String()
+	other := sdk.AccAddress("other_______________").String()
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "io", sdk.MustAccAddressFromBech32(other), false), "SetNameRecord(io)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "acme.io", sdk.MustAccAddressFromBech32(owner), true), "SetNameRecord(acme.io)")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "open.acme.io", sdk.MustAccAddressFromBech32(other), false), "SetNameRecord(open.acme.io)")
+
+	tests := []struct {
+		name    string
+		enforce bool
+		denom   string
+		creator string
+		expErr  string
+	}{
+		{name: "not enforced", enforce: false, denom: "shares.acme.io", creator: other},
+		{name: "no name", enforce: true, denom: "hotdog", creator: other},
+		{name: "unrestricted root", enforce: true, denom: "shares.io", creator: owner},
+		{name: "restricted parent, owner", enforce: true, denom: "shares.acme.io", creator: owner},
+		{name: "restricted parent, owner, mixed case", enforce: true, denom: "Shares.ACME.io", creator: owner},
+		{name: "restricted name itself, owner", enforce: true, denom: "acme.io", creator: owner},
+		{name: "restricted grandparent, owner", enforce: true, denom: "a.b.acme.io", creator: owner},
+		{name: "unrestricted child of restricted name", enforce: true, denom: "shares.open.acme.io", creator: other},
+		{
+			name:    "restricted parent, not owner",
+			enforce: true,
+			denom:   "shares.acme.io",
+			creator: other,
+			expErr:  other + " cannot create marker shares.acme.io: restricted name acme.io is owned by " + owner,
+		},
+		{
+			name:    "restricted name itself, not owner",
+			enforce: true,
+			denom:   "acme.io",
+			creator: other,
+			expErr:  other + " cannot create marker acme.io: restricted name acme.io is owned by " + owner,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			params := s.app.NameKeeper.GetParams(s.ctx)
+			params.EnforceDenomNameLinkage = tc.enforce
+			s.app.NameKeeper.SetParams(s.ctx, params)
+
+			err := s.app.MarkerKeeper.ValidateDenomNameOwnership(s.ctx, tc.denom, tc.creator)
+			assertions.AssertErrorValue(s.T(), err, tc.expErr, "ValidateDenomNameOwnership(%q, %q)", tc.denom, tc.creator)
+		})
+	}
+}
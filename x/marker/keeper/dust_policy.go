@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetDustPolicy stores what a marker does with the dust left over from pro-rata operations.
+// The default policy (truncate to issuer) is removed from state.
+func (k Keeper) SetDustPolicy(ctx sdk.Context, policy types.MarkerDustPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(policy.Denom)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if policy.Policy == types.DustPolicy_TruncateToIssuer {
+		store.Delete(types.DustPolicyKey(markerAddr))
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+	store.Set(types.DustPolicyKey(markerAddr), bz)
+	return nil
+}
+
+// GetDustPolicy returns what the marker with the provided address does with the dust left over from pro-rata operations.
+func (k Keeper) GetDustPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) types.DustPolicy {
+	bz := ctx.KVStore(k.storeKey).Get(types.DustPolicyKey(markerAddr))
+	if len(bz) == 0 {
+		return types.DustPolicy_TruncateToIssuer
+	}
+	var policy types.MarkerDustPolicy
+	if err := k.cdc.Unmarshal(bz, &policy); err != nil {
+		return types.DustPolicy_TruncateToIssuer
+	}
+	return policy.Policy
+}
+
+// IterateDustPolicies iterates over all of the markers' dust policies.
+func (k Keeper) IterateDustPolicies(ctx sdk.Context, cb func(policy types.MarkerDustPolicy) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DustPolicyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var policy types.MarkerDustPolicy
+		if err := k.cdc.Unmarshal(it.Value(), &policy); err != nil {
+			return err
+		}
+		if cb(policy) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveDustPolicy removes a marker's dust policy.
+func (k Keeper) RemoveDustPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.DustPolicyKey(markerAddr))
+}
+
+// AllocateProRata splits the total into shares proportional to the provided weights using the dust policy of the
+// marker with the provided address. It should be used by anything that splits an amount among a marker's holders,
+// e.g. distributions and redemptions, so that the results are reproducible. See types.AllocateProRata.
+func (k Keeper) AllocateProRata(ctx sdk.Context, markerAddr sdk.AccAddress, total sdkmath.Int, weights []sdkmath.Int) ([]sdkmath.Int, sdkmath.Int, types.DustPolicy, error) {
+	policy := k.GetDustPolicy(ctx, markerAddr)
+	shares, dust, err := types.AllocateProRata(total, weights, policy)
+	return shares, dust, policy, err
+}
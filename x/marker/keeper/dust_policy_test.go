@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestDustPolicy(t *testing.T) {
+	const denom = "dustcoin"
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_Coin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin}},
+		},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	markerAddr := types.MustGetMarkerAddress(denom)
+
+	queryPolicy := func(t *testing.T, id string) types.DustPolicy {
+		resp, qerr := app.MarkerKeeper.DustPolicy(ctx, &types.QueryDustPolicyRequest{Id: id})
+		require.NoError(t, qerr, "DustPolicy(%q)", id)
+		assert.Equal(t, denom, resp.Denom, "DustPolicy(%q) denom", id)
+		return resp.Policy
+	}
+
+	t.Run("default policy", func(t *testing.T) {
+		assert.Equal(t, types.DustPolicy_TruncateToIssuer, queryPolicy(t, denom), "policy by denom")
+		assert.Equal(t, types.DustPolicy_TruncateToIssuer, queryPolicy(t, markerAddr.String()), "policy by address")
+		_, qerr := app.MarkerKeeper.DustPolicy(ctx, nil)
+		assert.EqualError(t, qerr, "rpc error: code = InvalidArgument desc = invalid request", "DustPolicy(nil)")
+	})
+
+	t.Run("set policy requires admin", func(t *testing.T) {
+		_, err = msgServer.SetDustPolicy(ctx, types.NewMsgSetDustPolicyRequest(denom, types.DustPolicy_Burn, addrOther))
+		assert.ErrorContains(t, err, fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrOther, denom), "SetDustPolicy")
+	})
+
+	t.Run("set policy by gov without governance control", func(t *testing.T) {
+		authority := sdk.MustAccAddressFromBech32(app.MarkerKeeper.GetAuthority())
+		_, err = msgServer.SetDustPolicy(ctx, types.NewMsgSetDustPolicyRequest(denom, types.DustPolicy_Burn, authority))
+		assert.EqualError(t, err, denom+" marker does not allow governance control", "SetDustPolicy")
+	})
+
+	t.Run("set default policy again", func(t *testing.T) {
+		_, err = msgServer.SetDustPolicy(ctx, types.NewMsgSetDustPolicyRequest(denom, types.DustPolicy_TruncateToIssuer, addrAdmin))
+		assert.EqualError(t, err, "marker dustcoin already has dust policy TruncateToIssuer", "SetDustPolicy")
+	})
+
+	t.Run("set largest remainder", func(t *testing.T) {
+		em := sdk.NewEventManager()
+		_, err = msgServer.SetDustPolicy(ctx.WithEventManager(em), types.NewMsgSetDustPolicyRequest(denom, types.DustPolicy_LargestRemainder, addrAdmin))
+		require.NoError(t, err, "SetDustPolicy")
+		assert.Equal(t, types.DustPolicy_LargestRemainder, queryPolicy(t, denom), "policy after set")
+		require.Len(t, em.Events(), 1, "events")
+		assert.Equal(t, "provenance.marker.v1.EventMarkerDustPolicySet", em.Events()[0].Type, "event type")
+
+		shares, dust, policy, aerr := app.MarkerKeeper.AllocateProRata(ctx, markerAddr, sdkmath.NewInt(7),
+			[]sdkmath.Int{sdkmath.NewInt(2), sdkmath.NewInt(3), sdkmath.NewInt(5)})
+		require.NoError(t, aerr, "AllocateProRata")
+		assert.Equal(t, types.DustPolicy_LargestRemainder, policy, "AllocateProRata policy")
+		assert.Equal(t, "[1 2 4]", fmt.Sprintf("%v", shares), "AllocateProRata shares")
+		assert.Equal(t, "0", dust.String(), "AllocateProRata dust")
+	})
+
+	t.Run("genesis export", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		exp := []types.MarkerDustPolicy{types.NewMarkerDustPolicy(denom, types.DustPolicy_LargestRemainder)}
+		assert.Equal(t, exp, genState.DustPolicies, "DustPolicies")
+	})
+
+	t.Run("set back to default removes the policy", func(t *testing.T) {
+		_, err = msgServer.SetDustPolicy(ctx, types.NewMsgSetDustPolicyRequest(denom, types.DustPolicy_TruncateToIssuer, addrAdmin))
+		require.NoError(t, err, "SetDustPolicy")
+		assert.Equal(t, types.DustPolicy_TruncateToIssuer, queryPolicy(t, denom), "policy after reset")
+		assert.Empty(t, app.MarkerKeeper.ExportGenesis(ctx).DustPolicies, "DustPolicies after reset")
+	})
+}
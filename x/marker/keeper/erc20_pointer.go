@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetERC20Pointer records the ERC-20 contract that represents a marker on an external chain.
+// A marker can have only one pointer per external chain, so any existing one for the chain is replaced.
+// An error is returned if the contract is already a pointer for a different marker.
+func (k Keeper) SetERC20Pointer(ctx sdk.Context, pointer types.ERC20Pointer) error {
+	if err := pointer.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(pointer.Denom)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	contractKey := types.ERC20ContractKey(pointer.ChainId, pointer.ContractAddress)
+	if denom := string(store.Get(contractKey)); len(denom) > 0 && denom != pointer.Denom {
+		return fmt.Errorf("contract %s on %s is already a pointer for %s", pointer.ContractAddress, pointer.ChainId, denom)
+	}
+
+	if existing, found := k.GetERC20Pointer(ctx, pointer.Denom, pointer.ChainId); found {
+		store.Delete(types.ERC20ContractKey(existing.ChainId, existing.ContractAddress))
+	}
+
+	bz, err := k.cdc.Marshal(&pointer)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ERC20PointerKey(markerAddr, pointer.ChainId), bz)
+	store.Set(contractKey, []byte(pointer.Denom))
+	return nil
+}
+
+// GetERC20Pointer returns the ERC-20 pointer of a marker for an external chain, and whether it was found.
+func (k Keeper) GetERC20Pointer(ctx sdk.Context, denom, chainID string) (types.ERC20Pointer, bool) {
+	var pointer types.ERC20Pointer
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return pointer, false
+	}
+	bz := ctx.KVStore(k.storeKey).Get(types.ERC20PointerKey(markerAddr, chainID))
+	if len(bz) == 0 {
+		return pointer, false
+	}
+	if err = k.cdc.Unmarshal(bz, &pointer); err != nil {
+		return pointer, false
+	}
+	return pointer, true
+}
+
+// GetERC20PointerByContract returns the ERC-20 pointer for a contract on an external chain, and whether it was found.
+// The contract address lookup is not case sensitive.
+func (k Keeper) GetERC20PointerByContract(ctx sdk.Context, chainID, contractAddress string) (types.ERC20Pointer, bool) {
+	denom := ctx.KVStore(k.storeKey).Get(types.ERC20ContractKey(chainID, contractAddress))
+	if len(denom) == 0 {
+		return types.ERC20Pointer{}, false
+	}
+	return k.GetERC20Pointer(ctx, string(denom), chainID)
+}
+
+// GetERC20Pointers returns all of the ERC-20 pointers of the marker with the provided address.
+func (k Keeper) GetERC20Pointers(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.ERC20Pointer, error) {
+	var rv []types.ERC20Pointer
+	err := k.iterateERC20Pointers(ctx, types.ERC20PointerKeyPrefix(markerAddr), func(pointer types.ERC20Pointer) bool {
+		rv = append(rv, pointer)
+		return false
+	})
+	return rv, err
+}
+
+// IterateERC20Pointers iterates over all ERC-20 pointers of all markers.
+func (k Keeper) IterateERC20Pointers(ctx sdk.Context, cb func(pointer types.ERC20Pointer) (stop bool)) error {
+	return k.iterateERC20Pointers(ctx, types.ERC20PointerPrefix, cb)
+}
+
+// iterateERC20Pointers iterates over the ERC-20 pointers with keys that have the provided prefix.
+func (k Keeper) iterateERC20Pointers(ctx sdk.Context, prefix []byte, cb func(pointer types.ERC20Pointer) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var pointer types.ERC20Pointer
+		if err := k.cdc.Unmarshal(it.Value(), &pointer); err != nil {
+			return err
+		}
+		if cb(pointer) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveERC20Pointer removes the ERC-20 pointer of a marker for an external chain.
+// The removed pointer is returned. An error is returned if there wasn't one.
+func (k Keeper) RemoveERC20Pointer(ctx sdk.Context, denom, chainID string) (types.ERC20Pointer, error) {
+	pointer, found := k.GetERC20Pointer(ctx, denom, chainID)
+	if !found {
+		return pointer, fmt.Errorf("marker %s does not have an ERC-20 pointer for %s", denom, chainID)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ERC20PointerKey(types.MustGetMarkerAddress(denom), chainID))
+	store.Delete(types.ERC20ContractKey(pointer.ChainId, pointer.ContractAddress))
+	return pointer, nil
+}
+
+// RemoveERC20Pointers removes all of the ERC-20 pointers of the marker with the provided address.
+func (k Keeper) RemoveERC20Pointers(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.ERC20PointerKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+		var pointer types.ERC20Pointer
+		if err := k.cdc.Unmarshal(it.Value(), &pointer); err == nil {
+			keys = append(keys, types.ERC20ContractKey(pointer.ChainId, pointer.ContractAddress))
+		}
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -16,13 +16,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	}
 
 	// ensure our store contains references to any marker accounts in auth genesis
+	// and that their access grants are moved into our store.
 	store := ctx.KVStore(k.storeKey)
 	acc := k.authKeeper.GetAllAccounts(ctx)
 	for i := range acc {
 		if m, ok := acc[i].(types.MarkerAccountI); ok {
-			if err := m.Validate(); err == nil {
-				store.Set(types.MarkerStoreKey(m.GetAddress()), m.GetAddress())
+			if err := m.Validate(); err != nil {
+				continue
 			}
+			// The auth copy of a marker doesn't have its access grants (those come from the markers entries),
+			// so it's only registered here. Saving it would wipe out any grants already stored for it.
+			if ma, isMA := m.(*types.MarkerAccount); isMA && ma.AccessGrantsStored {
+				k.registerMarker(ctx, m)
+				continue
+			}
+			k.SetMarker(ctx, m)
 		}
 	}
 	// if any markers were included directly, add these as well.
@@ -60,6 +68,115 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			store.Set(types.NetAssetValueKey(address, navCopy.Price.Denom), bz)
 		}
 	}
+	for _, pointer := range data.Erc20Pointers {
+		if err := k.SetERC20Pointer(ctx, pointer); err != nil {
+			panic(err)
+		}
+	}
+	for _, bridge := range data.Bridges {
+		if err := k.SetBridge(ctx, bridge); err != nil {
+			panic(err)
+		}
+	}
+	for _, nonce := range data.UsedBridgeNonces {
+		k.SetBridgeNonceUsed(ctx, nonce.Bridge, nonce.Nonce)
+	}
+	for _, grant := range data.PendingAdminGrants {
+		if err := k.SetPendingAdminGrant(ctx, grant); err != nil {
+			panic(err)
+		}
+	}
+	for _, addr := range data.ReqAttrBypassAddrs {
+		k.setReqAttrBypassAddr(ctx, sdk.MustAccAddressFromBech32(addr))
+	}
+	for _, deposit := range data.CreationDeposits {
+		if err := k.SetCreationDeposit(ctx, deposit); err != nil {
+			panic(err)
+		}
+	}
+	for _, config := range data.AttributeRevocationConfigs {
+		if err := k.SetAttributeRevocationConfig(ctx, config); err != nil {
+			panic(err)
+		}
+	}
+	for _, frozen := range data.FrozenAccounts {
+		if err := k.FreezeAccount(ctx, frozen); err != nil {
+			panic(err)
+		}
+	}
+	for _, change := range data.ScheduledPolicyChanges {
+		if err := k.SetScheduledPolicyChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
+	k.setLastPolicyChangeID(ctx, data.LastPolicyChangeId)
+	for _, req := range data.ReserveRequirements {
+		if err := k.SetReserveRequirement(ctx, req); err != nil {
+			panic(err)
+		}
+	}
+	for _, attestors := range data.ReserveAttestors {
+		if err := k.SetReserveAttestors(ctx, attestors); err != nil {
+			panic(err)
+		}
+	}
+	for _, attestation := range data.ReserveAttestations {
+		if err := k.SetReserveAttestation(ctx, attestation); err != nil {
+			panic(err)
+		}
+	}
+	for _, policy := range data.DustPolicies {
+		if err := k.SetDustPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, offer := range data.SwapOffers {
+		if err := k.SetSwapOffer(ctx, offer); err != nil {
+			panic(err)
+		}
+	}
+	k.setLastSwapOfferID(ctx, data.LastSwapOfferId)
+	for _, tranche := range data.IssuanceTranches {
+		if err := k.SetIssuanceTranche(ctx, tranche); err != nil {
+			panic(err)
+		}
+	}
+	for _, entry := range data.SubLedgerEntries {
+		if err := k.SetSubLedgerEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+	for _, bridged := range data.BridgedSupplies {
+		if err := k.SetBridgedSupply(ctx, bridged); err != nil {
+			panic(err)
+		}
+	}
+	for _, recon := range data.BridgedSupplyReconciliations {
+		if err := k.SetBridgedSupplyReconciliation(ctx, recon); err != nil {
+			panic(err)
+		}
+	}
+	for _, policy := range data.SupplyChangePolicies {
+		if err := k.SetSupplyChangePolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, policy := range data.ReceiptPolicies {
+		if err := k.SetReceiptPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, receipt := range data.PendingReceipts {
+		if err := k.SetPendingReceipt(ctx, receipt); err != nil {
+			panic(err)
+		}
+	}
+	k.setLastPendingReceiptID(ctx, data.LastPendingReceiptId)
+	for _, supplyCap := range data.ContractSupplyCaps {
+		if err := k.SetContractSupplyCap(ctx, supplyCap); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -85,6 +202,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 			AllowGovernanceControl: marker.HasGovernanceEnabled(),
 			AllowForcedTransfer:    marker.AllowsForcedTransfer(),
 			RequiredAttributes:     marker.GetRequiredAttributes(),
+			IssuerManagedFlags:     marker.HasIssuerManagedFlags(),
 		})
 		return false
 	}
@@ -114,5 +232,219 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var pointers []types.ERC20Pointer
+	err := k.IterateERC20Pointers(ctx, func(pointer types.ERC20Pointer) bool {
+		pointers = append(pointers, pointer)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var bridges []types.MarkerBridge
+	err = k.IterateBridges(ctx, func(bridge types.MarkerBridge) bool {
+		bridges = append(bridges, bridge)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var usedNonces []types.BridgeNonce
+	k.IterateUsedBridgeNonces(ctx, func(name string, nonce uint64) bool {
+		usedNonces = append(usedNonces, types.BridgeNonce{Bridge: name, Nonce: nonce})
+		return false
+	})
+
+	var pendingAdminGrants []types.PendingAdminGrant
+	err = k.IteratePendingAdminGrants(ctx, func(grant types.PendingAdminGrant) bool {
+		pendingAdminGrants = append(pendingAdminGrants, grant)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var creationDeposits []types.MarkerCreationDeposit
+	err = k.IterateCreationDeposits(ctx, func(deposit types.MarkerCreationDeposit) bool {
+		creationDeposits = append(creationDeposits, deposit)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var revocationConfigs []types.AttributeRevocationConfig
+	err = k.IterateAttributeRevocationConfigs(ctx, func(config types.AttributeRevocationConfig) bool {
+		revocationConfigs = append(revocationConfigs, config)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var frozenAccounts []types.FrozenAccount
+	err = k.IterateFrozenAccounts(ctx, func(frozen types.FrozenAccount) bool {
+		frozenAccounts = append(frozenAccounts, frozen)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var policyChanges []types.ScheduledPolicyChange
+	err = k.IterateScheduledPolicyChanges(ctx, func(change types.ScheduledPolicyChange) bool {
+		policyChanges = append(policyChanges, change)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var reserveRequirements []types.ReserveRequirement
+	err = k.IterateReserveRequirements(ctx, func(req types.ReserveRequirement) bool {
+		reserveRequirements = append(reserveRequirements, req)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var reserveAttestors []types.ReserveAttestors
+	err = k.IterateReserveAttestors(ctx, func(attestors types.ReserveAttestors) bool {
+		reserveAttestors = append(reserveAttestors, attestors)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var reserveAttestations []types.ReserveAttestation
+	err = k.IterateReserveAttestations(ctx, func(attestation types.ReserveAttestation) bool {
+		reserveAttestations = append(reserveAttestations, attestation)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var dustPolicies []types.MarkerDustPolicy
+	err = k.IterateDustPolicies(ctx, func(policy types.MarkerDustPolicy) bool {
+		dustPolicies = append(dustPolicies, policy)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var swapOffers []types.SwapOffer
+	err = k.IterateSwapOffers(ctx, func(offer types.SwapOffer) bool {
+		swapOffers = append(swapOffers, offer)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var tranches []types.IssuanceTranche
+	err = k.IterateIssuanceTranches(ctx, func(tranche types.IssuanceTranche) bool {
+		tranches = append(tranches, tranche)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var subLedgerEntries []types.SubLedgerEntry
+	err = k.IterateSubLedgerEntries(ctx, func(entry types.SubLedgerEntry) bool {
+		subLedgerEntries = append(subLedgerEntries, entry)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var bridgedSupplies []types.BridgedSupply
+	err = k.IterateBridgedSupplies(ctx, func(bridged types.BridgedSupply) bool {
+		bridgedSupplies = append(bridgedSupplies, bridged)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var reconciliations []types.BridgedSupplyReconciliation
+	err = k.IterateBridgedSupplyReconciliations(ctx, func(recon types.BridgedSupplyReconciliation) bool {
+		reconciliations = append(reconciliations, recon)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var supplyChangePolicies []types.SupplyChangePolicy
+	err = k.IterateSupplyChangePolicies(ctx, func(policy types.SupplyChangePolicy) bool {
+		supplyChangePolicies = append(supplyChangePolicies, policy)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var receiptPolicies []types.ReceiptPolicy
+	err = k.IterateReceiptPolicies(ctx, func(policy types.ReceiptPolicy) bool {
+		receiptPolicies = append(receiptPolicies, policy)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var pendingReceipts []types.PendingReceipt
+	err = k.IteratePendingReceipts(ctx, func(receipt types.PendingReceipt) bool {
+		pendingReceipts = append(pendingReceipts, receipt)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var contractSupplyCaps []types.ContractSupplyCap
+	err = k.IterateContractSupplyCaps(ctx, func(supplyCap types.ContractSupplyCap) bool {
+		contractSupplyCaps = append(contractSupplyCaps, supplyCap)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	genState.Erc20Pointers = pointers
+	genState.Bridges = bridges
+	genState.UsedBridgeNonces = usedNonces
+	genState.PendingAdminGrants = pendingAdminGrants
+	genState.CreationDeposits = creationDeposits
+	genState.AttributeRevocationConfigs = revocationConfigs
+	genState.FrozenAccounts = frozenAccounts
+	genState.ScheduledPolicyChanges = policyChanges
+	genState.LastPolicyChangeId = k.GetLastPolicyChangeID(ctx)
+	genState.ReserveRequirements = reserveRequirements
+	genState.ReserveAttestors = reserveAttestors
+	genState.ReserveAttestations = reserveAttestations
+	genState.DustPolicies = dustPolicies
+	genState.SwapOffers = swapOffers
+	genState.LastSwapOfferId = k.GetLastSwapOfferID(ctx)
+	genState.IssuanceTranches = tranches
+	genState.SubLedgerEntries = subLedgerEntries
+	genState.BridgedSupplies = bridgedSupplies
+	genState.BridgedSupplyReconciliations = reconciliations
+	genState.SupplyChangePolicies = supplyChangePolicies
+	genState.ReceiptPolicies = receiptPolicies
+	genState.PendingReceipts = pendingReceipts
+	genState.LastPendingReceiptId = k.GetLastPendingReceiptID(ctx)
+	genState.ContractSupplyCaps = contractSupplyCaps
+	for _, addr := range k.GetAddedReqAttrBypassAddrs(ctx) {
+		genState.ReqAttrBypassAddrs = append(genState.ReqAttrBypassAddrs, addr.String())
+	}
+	return genState
 }
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ExecuteAsParent executes the provided msgs as the account of the parent marker.
+// The admin must have admin access on the parent marker, and each msg must be one of the marker msgs allowed for this
+// and have the parent marker's address as its only signer.
+// This allows the admins of a parent marker to administer the child markers that the parent's account has been granted access on.
+func (k Keeper) ExecuteAsParent(ctx sdk.Context, admin sdk.AccAddress, parentDenom string, msgs []sdk.Msg) ([][]byte, error) {
+	parent, err := k.GetMarkerByDenom(ctx, parentDenom)
+	if err != nil {
+		return nil, fmt.Errorf("parent marker not found for %s: %w", parentDenom, err)
+	}
+	if parent.GetStatus() != types.StatusActive {
+		return nil, fmt.Errorf("parent marker %s must be active, got %s", parentDenom, parent.GetStatus())
+	}
+	if err = parent.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+
+	parentAddr := parent.GetAddress()
+	results := make([][]byte, len(msgs))
+	msgTypes := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypes[i] = sdk.MsgTypeURL(msg)
+		if !types.IsExecuteAsParentAllowed(msgTypes[i]) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("msgs[%d] %s cannot be executed as a parent marker", i, msgTypes[i])
+		}
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, fmt.Errorf("could not get signers of msgs[%d] %s: %w", i, msgTypes[i], err)
+		}
+		if len(signers) != 1 || !parentAddr.Equals(sdk.AccAddress(signers[0])) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("msgs[%d] %s must have parent marker %s address %s as its only signer",
+				i, msgTypes[i], parentDenom, parentAddr)
+		}
+
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, sdkerrors.ErrUnknownRequest.Wrapf("no message handler found for msgs[%d] %s", i, msgTypes[i])
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error executing msgs[%d] %s", i, msgTypes[i])
+		}
+
+		results[i] = res.Data
+		events := res.GetEvents()
+		sdkEvents := make([]sdk.Event, len(events))
+		for j, event := range events {
+			sdkEvents[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(sdkEvents)
+	}
+
+	event := &types.EventMarkerExecuteAsParent{
+		ParentDenom:   parentDenom,
+		Administrator: admin.String(),
+		MsgTypes:      msgTypes,
+	}
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// GetMarkerParents returns the links to the parents of the provided marker.
+// Parents are the markers whose accounts have been granted access on the provided marker.
+// Children (the markers that the provided marker's account has been granted access on) are found by the MarkerHierarchy query.
+func (k Keeper) GetMarkerParents(ctx sdk.Context, marker types.MarkerAccountI) []types.MarkerHierarchyLink {
+	var parents []types.MarkerHierarchyLink
+	for _, grant := range marker.GetAccessList() {
+		parent, err := k.GetMarker(ctx, grant.GetAddress())
+		if err != nil || parent == nil {
+			continue
+		}
+		parents = append(parents, types.MarkerHierarchyLink{Denom: parent.GetDenom(), Permissions: grant.GetAccessList()})
+	}
+	return parents
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// holdersBatchSize is the number of holders read from the bank module at a time by GetAllMarkerHolders.
+const holdersBatchSize = 1_000
+
+// GetMarkerHolders returns up to limit of the accounts holding the provided denom in address order, starting with
+// the one identified by pageKey (nil to start with the first holder). The returned key identifies the next holder
+// to get, and is nil once there aren't any more.
+func (k Keeper) GetMarkerHolders(ctx sdk.Context, denom string, pageKey []byte, limit uint64) ([]types.Balance, []byte, error) {
+	resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Key: pageKey, Limit: limit},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	holders := make([]types.Balance, len(resp.DenomOwners))
+	for i, owner := range resp.DenomOwners {
+		holders[i] = types.Balance{
+			Address: owner.Address,
+			Coins:   sdk.NewCoins(owner.Balance),
+		}
+	}
+
+	var nextKey []byte
+	if resp.Pagination != nil && len(resp.Pagination.NextKey) > 0 {
+		nextKey = resp.Pagination.NextKey
+	}
+	return holders, nextKey, nil
+}
+
+// GetAllMarkerHolders calls cb with each account holding the provided denom in address order.
+// Holders are read in batches so that large holder sets are never loaded into memory all at once.
+func (k Keeper) GetAllMarkerHolders(ctx sdk.Context, denom string, cb func(holder types.Balance) (stop bool)) error {
+	var pageKey []byte
+	for {
+		holders, nextKey, err := k.GetMarkerHolders(ctx, denom, pageKey, holdersBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, holder := range holders {
+			if cb(holder) {
+				return nil
+			}
+		}
+		if len(nextKey) == 0 {
+			return nil
+		}
+		pageKey = nextKey
+	}
+}
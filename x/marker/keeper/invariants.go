@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -9,18 +13,27 @@ import (
 	"github.com/provenance-io/provenance/x/marker/types"
 )
 
-// The name of the marker supply invariant
-const invariantName = "required-marker-supply"
+const (
+	// The name of the marker supply invariant
+	invariantName = "required-marker-supply"
+	// The name of the marker index invariant
+	indexInvariantName = "marker-indexes"
+)
 
 // RegisterInvariants registers module invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, mk Keeper, bk bankkeeper.Keeper) {
 	ir.RegisterRoute(types.ModuleName, invariantName, supplyInvariant(mk, bk))
+	ir.RegisterRoute(types.ModuleName, indexInvariantName, IndexInvariant(mk))
 }
 
 // AllInvariants runs all invariants of the marker module.
 func AllInvariants(k Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return supplyInvariant(k, bk)(ctx)
+		res, stop := supplyInvariant(k, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+		return IndexInvariant(k)(ctx)
 	}
 }
 
@@ -58,3 +71,114 @@ func supplyInvariant(mk Keeper, bk bankkeeper.Keeper) sdk.Invariant {
 		return statusMessage, isBroken
 	}
 }
+
+// IndexInvariant checks that the marker registry and the indexes the marker queries rely on are consistent with
+// the records they index. This is useful for verifying the state of a node that was restored from a snapshot.
+func IndexInvariant(mk Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		problems = append(problems, mk.checkMarkerRegistry(ctx)...)
+		problems = append(problems, mk.checkDenySendIndex(ctx)...)
+		problems = append(problems, mk.checkERC20ContractIndex(ctx)...)
+
+		msg := fmt.Sprintf("%d marker index problems found\n", len(problems))
+		if len(problems) > 0 {
+			msg += strings.Join(problems, "\n") + "\n"
+		}
+		return sdk.FormatInvariant(types.ModuleName, indexInvariantName, msg), len(problems) > 0
+	}
+}
+
+// checkMarkerRegistry makes sure that each entry in the marker registry is for a marker account with the same address,
+// and that the denoms flagged as having a marker match the registry.
+func (k Keeper) checkMarkerRegistry(ctx sdk.Context) []string {
+	var rv []string
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerStoreKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		addr := types.SplitMarkerStoreKey(it.Key())
+		if !bytes.Equal(addr, it.Value()) {
+			rv = append(rv, fmt.Sprintf("marker registry entry %s has value %s", addr, sdk.AccAddress(it.Value())))
+			continue
+		}
+		account := k.authKeeper.GetAccount(ctx, addr)
+		if account == nil {
+			rv = append(rv, fmt.Sprintf("marker registry entry %s does not have an account", addr))
+			continue
+		}
+		marker, ok := account.(types.MarkerAccountI)
+		if !ok {
+			rv = append(rv, fmt.Sprintf("marker registry entry %s is a %T, not a marker account", addr, account))
+			continue
+		}
+		if !k.IsMarkerDenom(ctx, marker.GetDenom()) {
+			rv = append(rv, fmt.Sprintf("marker %s (%s) does not have its denom flagged", marker.GetDenom(), addr))
+		}
+	}
+
+	denomIt := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerDenomPrefix)
+	defer denomIt.Close()
+	for ; denomIt.Valid(); denomIt.Next() {
+		denom := string(denomIt.Key()[len(types.MarkerDenomPrefix):])
+		markerAddr, err := types.MarkerAddress(denom)
+		if err != nil || !k.IsMarkerAddress(ctx, markerAddr) {
+			rv = append(rv, fmt.Sprintf("denom %q is flagged but does not have a marker", denom))
+		}
+	}
+	return rv
+}
+
+// checkDenySendIndex makes sure that each deny list entry has an index entry, and vice versa.
+func (k Keeper) checkDenySendIndex(ctx sdk.Context) []string {
+	var rv []string
+	store := ctx.KVStore(k.storeKey)
+
+	it := storetypes.KVStorePrefixIterator(store, types.DenySendKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		markerAddr, denyAddr := types.GetDenySendAddresses(it.Key())
+		if !store.Has(types.DenySendAddressKey(denyAddr, markerAddr)) {
+			rv = append(rv, fmt.Sprintf("deny list entry of %s for marker %s is not indexed", denyAddr, markerAddr))
+		}
+	}
+	it.Close()
+
+	it = storetypes.KVStorePrefixIterator(store, types.DenySendAddressPrefix)
+	for ; it.Valid(); it.Next() {
+		denyAddr, markerAddr := types.GetDenySendAddressKeyAddresses(it.Key())
+		if !store.Has(types.DenySendKey(markerAddr, denyAddr)) {
+			rv = append(rv, fmt.Sprintf("deny list index entry of %s for marker %s does not have a deny list entry", denyAddr, markerAddr))
+		}
+	}
+	it.Close()
+
+	return rv
+}
+
+// checkERC20ContractIndex makes sure that each ERC-20 pointer has a contract index entry, and vice versa.
+func (k Keeper) checkERC20ContractIndex(ctx sdk.Context) []string {
+	var rv []string
+	store := ctx.KVStore(k.storeKey)
+
+	err := k.IterateERC20Pointers(ctx, func(pointer types.ERC20Pointer) bool {
+		denom := string(store.Get(types.ERC20ContractKey(pointer.ChainId, pointer.ContractAddress)))
+		if denom != pointer.Denom {
+			rv = append(rv, fmt.Sprintf("ERC-20 pointer of %s on %s is indexed to %q", pointer.Denom, pointer.ChainId, denom))
+		}
+		return false
+	})
+	if err != nil {
+		rv = append(rv, fmt.Sprintf("could not read ERC-20 pointers: %v", err))
+	}
+
+	it := storetypes.KVStorePrefixIterator(store, types.ERC20ContractPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		chainID, contract := types.GetERC20ContractKeyParts(it.Key())
+		denom := string(it.Value())
+		pointer, found := k.GetERC20Pointer(ctx, denom, chainID)
+		if !found || !strings.EqualFold(pointer.ContractAddress, contract) {
+			rv = append(rv, fmt.Sprintf("ERC-20 contract index entry of %s on %s does not match a pointer of %s", contract, chainID, denom))
+		}
+	}
+	return rv
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -70,3 +71,54 @@ func TestMarkerInvariant(t *testing.T) {
 	_, isBroken = invariantChecks(ctx)
 	require.False(t, isBroken)
 }
+
+func TestMarkerIndexInvariant(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	user := testUserAddress("test")
+	denied := testUserAddress("denied")
+
+	mac := markertypes.NewEmptyMarkerAccount("indexcoin", user.String(),
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(user, []markertypes.Access{markertypes.Access_Admin})})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+	app.MarkerKeeper.AddSendDeny(ctx, mac.GetAddress(), denied)
+	pointer := types.NewERC20Pointer(mac.GetDenom(), "eip155:1", "0x00000000000000000000000000000000000000AA")
+	require.NoError(t, app.MarkerKeeper.SetERC20Pointer(ctx, pointer), "SetERC20Pointer")
+
+	invariant := markerkeeper.IndexInvariant(app.MarkerKeeper)
+	msg, isBroken := invariant(ctx)
+	require.False(t, isBroken, "consistent state: %s", msg)
+
+	t.Run("missing deny list index", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx.KVStore(app.GetKey(types.StoreKey)).Delete(types.DenySendAddressKey(denied, mac.GetAddress()))
+		msg, isBroken = invariant(cacheCtx)
+		assert.True(t, isBroken, "isBroken")
+		assert.Contains(t, msg, "deny list entry of "+denied.String()+" for marker "+mac.GetAddress().String()+" is not indexed", "msg")
+	})
+
+	t.Run("stale ERC-20 contract index", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx.KVStore(app.GetKey(types.StoreKey)).Set(types.ERC20ContractKey("eip155:1", "0xBB"), []byte(mac.GetDenom()))
+		msg, isBroken = invariant(cacheCtx)
+		assert.True(t, isBroken, "isBroken")
+		assert.Contains(t, msg, "ERC-20 contract index entry of 0xbb on eip155:1 does not match a pointer of indexcoin", "msg")
+	})
+
+	t.Run("denom not flagged", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx.KVStore(app.GetKey(types.StoreKey)).Delete(types.MarkerDenomKey(mac.GetDenom()))
+		msg, isBroken = invariant(cacheCtx)
+		assert.True(t, isBroken, "isBroken")
+		assert.Contains(t, msg, "marker indexcoin ("+mac.GetAddress().String()+") does not have its denom flagged", "msg")
+	})
+
+	t.Run("registry entry without account", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		other := types.MustGetMarkerAddress("othercoin")
+		cacheCtx.KVStore(app.GetKey(types.StoreKey)).Set(types.MarkerStoreKey(other), other)
+		msg, isBroken = invariant(cacheCtx)
+		assert.True(t, isBroken, "isBroken")
+		assert.Contains(t, msg, "marker registry entry "+other.String()+" does not have an account", "msg")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -64,7 +65,7 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	// The codec for binary encoding/decoding.
-	cdc codec.BinaryCodec
+	cdc codec.Codec
 
 	// the signing authority for the gov proposals
 	authority string
@@ -87,6 +88,13 @@ type Keeper struct {
 
 	// groupChecker provides a way to check if an account is in a group.
 	groupChecker types.GroupChecker
+
+	// router is used to execute msgs on behalf of a parent marker's account, and to dry-run proposal msgs.
+	router baseapp.IMsgServiceRouter
+
+	// attrProofVerifiers are the registered verifiers of attribute proofs that can satisfy required attributes.
+	// It's a pointer so that verifiers registered after creation are available to the send restriction.
+	attrProofVerifiers *types.AttributeProofVerifiers
 }
 
 // NewKeeper returns a marker keeper. It handles:
@@ -95,7 +103,7 @@ type Keeper struct {
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	key storetypes.StoreKey,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -106,6 +114,7 @@ func NewKeeper(
 	ibcTransferServer types.IbcTransferMsgServer,
 	reqAttrBypassAddrs []sdk.AccAddress,
 	checker types.GroupChecker,
+	router baseapp.IMsgServiceRouter,
 ) Keeper {
 	rv := Keeper{
 		authKeeper:            authKeeper,
@@ -123,11 +132,20 @@ func NewKeeper(
 		ibcTransferServer:     ibcTransferServer,
 		reqAttrBypassAddrs:    types.NewImmutableAccAddresses(reqAttrBypassAddrs),
 		groupChecker:          checker,
+		router:                router,
+		attrProofVerifiers:    types.NewAttributeProofVerifiers(),
 	}
 	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
 	return rv
 }
 
+// RegisterAttributeProofVerifier registers a verifier of proofs that an account satisfies a marker's required attributes.
+// Once registered, a send of restricted coins to an account that is missing some required attributes will be allowed
+// if the context has an AttributeProof for that account, with this verifier's name, that the verifier accepts.
+func (k Keeper) RegisterAttributeProofVerifier(name string, verifier types.AttributeProofVerifier) error {
+	return k.attrProofVerifiers.Register(name, verifier)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -142,6 +160,16 @@ func (k Keeper) NewMarker(ctx sdk.Context, marker types.MarkerAccountI) types.Ma
 
 // GetMarker looks up a marker by a given address
 func (k Keeper) GetMarker(ctx sdk.Context, address sdk.AccAddress) (types.MarkerAccountI, error) {
+	macc, err := k.getMarkerAccount(ctx, address)
+	if macc == nil || err != nil {
+		return macc, err
+	}
+	k.loadAccessGrants(ctx, macc)
+	return macc, nil
+}
+
+// getMarkerAccount looks up a marker account by a given address without filling in its access list.
+func (k Keeper) getMarkerAccount(ctx sdk.Context, address sdk.AccAddress) (types.MarkerAccountI, error) {
 	mac := k.authKeeper.GetAccount(ctx, address)
 	if mac != nil {
 		macc, ok := mac.(types.MarkerAccountI)
@@ -155,14 +183,32 @@ func (k Keeper) GetMarker(ctx sdk.Context, address sdk.AccAddress) (types.Marker
 
 // SetMarker sets a marker in the auth account store will panic if the marker account is not valid or
 // if the auth module account keeper fails to marshall the account.
+// The marker's access list is kept in the marker store instead of with the account.
 func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
-	store := ctx.KVStore(k.storeKey)
-
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
-	k.authKeeper.SetAccount(ctx, marker)
+	k.authKeeper.SetAccount(ctx, k.storeAccessGrants(ctx, marker))
+	k.registerMarker(ctx, marker)
+}
+
+// registerMarker records the marker's address and denom in the marker registry.
+func (k Keeper) registerMarker(ctx sdk.Context, marker types.MarkerAccountI) {
+	store := ctx.KVStore(k.storeKey)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
+	store.Set(types.MarkerDenomKey(marker.GetDenom()), []byte{0x01})
+}
+
+// IsMarkerDenom returns true if there is a marker for the provided denom.
+// This only probes a single key, so it is much cheaper than looking up the marker.
+func (k Keeper) IsMarkerDenom(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MarkerDenomKey(denom))
+}
+
+// IsMarkerAddress returns true if the provided address is the address of a marker.
+// This only probes a single key, so it is much cheaper than looking up the account.
+func (k Keeper) IsMarkerAddress(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.MarkerStoreKey(addr))
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
@@ -173,7 +219,21 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
 	k.ClearSendDeny(ctx, marker.GetAddress())
+	k.RemoveERC20Pointers(ctx, marker.GetAddress())
+	k.RemovePendingAdminGrants(ctx, marker.GetAddress())
+	k.RemoveScheduledPolicyChanges(ctx, marker.GetAddress())
+	k.RemoveAttributeRevocationState(ctx, marker.GetAddress())
+	k.RemoveDustPolicy(ctx, marker.GetAddress())
+	k.RemoveReserveRequirement(ctx, marker.GetAddress())
+	k.RemoveReserveAttestationState(ctx, marker.GetAddress())
+	k.RemoveIssuanceTranches(ctx, marker.GetAddress())
+	k.RemoveBridgedSupply(ctx, marker.GetAddress())
+	k.RemoveSupplyChangePolicy(ctx, marker.GetAddress())
+	k.RemoveReceiptPolicy(ctx, marker.GetAddress())
+	k.RemoveContractSupplyCaps(ctx, marker.GetAddress())
+	k.removeAccessGrants(ctx, marker.GetAddress())
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
+	store.Delete(types.MarkerDenomKey(marker.GetDenom()))
 }
 
 // IterateMarkers iterates all markers with the given handler function.
@@ -188,6 +248,7 @@ func (k Keeper) IterateMarkers(ctx sdk.Context, cb func(marker types.MarkerAccou
 		if !ok {
 			panic(fmt.Errorf("invalid account type in marker account registry"))
 		}
+		k.loadAccessGrants(ctx, ma)
 		if cb(ma) {
 			break
 		}
@@ -227,12 +288,14 @@ func (k Keeper) IsSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddres
 func (k Keeper) AddSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.DenySendKey(markerAddr, senderAddr), []byte{})
+	store.Set(types.DenySendAddressKey(senderAddr, markerAddr), []byte{})
 }
 
 // RemoveSendDeny removes sender address from marker deny list
 func (k Keeper) RemoveSendDeny(ctx sdk.Context, markerAddr, senderAddr sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.DenySendKey(markerAddr, senderAddr))
+	store.Delete(types.DenySendAddressKey(senderAddr, markerAddr))
 }
 
 // ClearSendDeny removes all entries of a marker from a send deny list
@@ -271,6 +334,21 @@ func (k Keeper) GetSendDenyList(ctx sdk.Context, markerAddr sdk.AccAddress) []sd
 	return list
 }
 
+// GetSendDenyMarkers gets the addresses of the markers that have the provided address in their deny list.
+func (k Keeper) GetSendDenyMarkers(ctx sdk.Context, denyAddr sdk.AccAddress) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenySendAddressKeyPrefix(denyAddr))
+	list := []sdk.AccAddress{}
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, markerAddr := types.GetDenySendAddressKeyAddresses(iterator.Key())
+		list = append(list, markerAddr)
+	}
+
+	return list
+}
+
 // AddSetNetAssetValues adds a set of net asset values to a marker
 func (k Keeper) AddSetNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI, netAssetValues []types.NetAssetValue, source string) error {
 	var errs []error
@@ -418,12 +496,14 @@ func (k Keeper) RemoveNetAssetValues(ctx sdk.Context, markerAddr sdk.AccAddress)
 	}
 }
 
-// GetReqAttrBypassAddrs returns a deep copy of the addresses that bypass the required attributes checking.
+// GetReqAttrBypassAddrs returns a deep copy of the module account addresses that bypass the required attributes checking.
+// It does not include any addresses added through governance, see GetAddedReqAttrBypassAddrs.
 func (k Keeper) GetReqAttrBypassAddrs() []sdk.AccAddress {
 	return k.reqAttrBypassAddrs.GetSlice()
 }
 
-// IsReqAttrBypassAddr returns true if the provided addr can bypass the required attributes checking.
+// IsReqAttrBypassAddr returns true if the provided addr is one of the module accounts that bypass the required attributes checking.
+// Use CanBypassReqAttrs to also check the addresses added through governance.
 func (k Keeper) IsReqAttrBypassAddr(addr sdk.AccAddress) bool {
 	return k.reqAttrBypassAddrs.Has(addr)
 }
@@ -2483,6 +2483,81 @@ func TestAddRemoveSendDeny(t *testing.T) {
 	}
 }
 
+func TestSendDenyMarkers(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	user := testUserAddress("test")
+	denied := testUserAddress("denied")
+	other := testUserAddress("other")
+	markerAddrs := make(map[string]sdk.AccAddress)
+	for _, denom := range []string{"acoin", "bcoin", "ccoin"} {
+		mac := types.NewEmptyMarkerAccount(denom, user.String(), nil)
+		mac.MarkerType = types.MarkerType_RestrictedCoin
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount(%s)", denom)
+		markerAddrs[denom] = mac.GetAddress()
+	}
+	app.MarkerKeeper.AddSendDeny(ctx, markerAddrs["acoin"], denied)
+	app.MarkerKeeper.AddSendDeny(ctx, markerAddrs["ccoin"], denied)
+	app.MarkerKeeper.AddSendDeny(ctx, markerAddrs["ccoin"], other)
+
+	getDenoms := func(addr sdk.AccAddress) []string {
+		var denoms []string
+		for _, markerAddr := range app.MarkerKeeper.GetSendDenyMarkers(ctx, addr) {
+			marker, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+			require.NoError(t, err, "GetMarker(%s)", markerAddr)
+			denoms = append(denoms, marker.GetDenom())
+		}
+		sort.Strings(denoms)
+		return denoms
+	}
+
+	assert.Equal(t, []string{"acoin", "ccoin"}, getDenoms(denied), "GetSendDenyMarkers(denied)")
+	assert.Equal(t, []string{"ccoin"}, getDenoms(other), "GetSendDenyMarkers(other)")
+	assert.Empty(t, getDenoms(user), "GetSendDenyMarkers(user)")
+
+	app.MarkerKeeper.RemoveSendDeny(ctx, markerAddrs["acoin"], denied)
+	assert.Equal(t, []string{"ccoin"}, getDenoms(denied), "GetSendDenyMarkers(denied) after removal")
+	app.MarkerKeeper.ClearSendDeny(ctx, markerAddrs["ccoin"])
+	assert.Empty(t, getDenoms(denied), "GetSendDenyMarkers(denied) after clear")
+	assert.Empty(t, getDenoms(other), "GetSendDenyMarkers(other) after clear")
+
+	t.Run("query", func(t *testing.T) {
+		app.MarkerKeeper.AddSendDeny(ctx, markerAddrs["acoin"], denied)
+		app.MarkerKeeper.AddSendDeny(ctx, markerAddrs["bcoin"], denied)
+
+		_, err := app.MarkerKeeper.DenyListMarkers(ctx, nil)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "DenyListMarkers(nil)")
+		_, err = app.MarkerKeeper.DenyListMarkers(ctx, &types.QueryDenyListMarkersRequest{Address: "bad"})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid address: decoding bech32 failed: invalid bech32 string length 3", "DenyListMarkers(bad)")
+
+		resp, err := app.MarkerKeeper.DenyListMarkers(ctx, &types.QueryDenyListMarkersRequest{Address: denied.String()})
+		require.NoError(t, err, "DenyListMarkers(denied)")
+		denoms := resp.Denoms
+		sort.Strings(denoms)
+		assert.Equal(t, []string{"acoin", "bcoin"}, denoms, "DenyListMarkers(denied) denoms")
+
+		resp, err = app.MarkerKeeper.DenyListMarkers(ctx, &types.QueryDenyListMarkersRequest{Address: denied.String(), Pagination: &query.PageRequest{Limit: 1}})
+		require.NoError(t, err, "DenyListMarkers(denied) with limit")
+		assert.Len(t, resp.Denoms, 1, "DenyListMarkers(denied) with limit denoms")
+		assert.NotEmpty(t, resp.Pagination.NextKey, "DenyListMarkers(denied) with limit next key")
+
+		resp, err = app.MarkerKeeper.DenyListMarkers(ctx, &types.QueryDenyListMarkersRequest{Address: other.String()})
+		require.NoError(t, err, "DenyListMarkers(other)")
+		assert.Empty(t, resp.Denoms, "DenyListMarkers(other) denoms")
+	})
+
+	t.Run("migrate 2 to 3", func(t *testing.T) {
+		store := app.MarkerKeeper.GetStore(ctx)
+		store.Delete(types.DenySendAddressKey(denied, markerAddrs["acoin"]))
+		store.Delete(types.DenySendAddressKey(denied, markerAddrs["bcoin"]))
+		require.Empty(t, getDenoms(denied), "GetSendDenyMarkers(denied) without index")
+
+		require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate2to3(ctx), "Migrate2to3")
+		assert.Equal(t, []string{"acoin", "bcoin"}, getDenoms(denied), "GetSendDenyMarkers(denied) after migration")
+	})
+}
+
 func TestAddSetNetAssetValues(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.NewContext(false)
@@ -2814,6 +2889,77 @@ func TestGetNetAssetValue(t *testing.T) {
 	}
 }
 
+func TestNetAssetValuesQuery(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.NewContext(false)
+
+	admin := sdk.AccAddress("admin_account_______")
+	markerAcc := types.NewEmptyMarkerAccount("apple", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin, []types.Access{types.Access_Admin})})
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, markerAcc), "AddMarkerAccount apple")
+	navs := []types.NetAssetValue{
+		types.NewNetAssetValue(sdk.NewInt64Coin("banana", 3), 1),
+		types.NewNetAssetValue(sdk.NewInt64Coin("cherry", 57), 7777),
+		types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 50_000), 1_000_000),
+	}
+	for _, nav := range navs {
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, markerAcc, nav, "test setup"), "SetNetAssetValue %s", nav.Price.Denom)
+	}
+
+	priceDenoms := func(navs []types.NetAssetValue) []string {
+		var rv []string
+		for _, nav := range navs {
+			rv = append(rv, nav.Price.Denom)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name       string
+		pagination *query.PageRequest
+		expDenoms  []string
+		expTotal   uint64
+		expNextKey []byte
+	}{
+		{
+			name:      "no pagination",
+			expDenoms: []string{"banana", "cherry", types.UsdDenom},
+			expTotal:  3,
+		},
+		{
+			name:       "limit 2",
+			pagination: &query.PageRequest{Limit: 2},
+			expDenoms:  []string{"banana", "cherry"},
+			expNextKey: []byte(types.UsdDenom),
+		},
+		{
+			name:       "page key",
+			pagination: &query.PageRequest{Key: []byte("cherry"), Limit: 2},
+			expDenoms:  []string{"cherry", types.UsdDenom},
+		},
+		{
+			name:       "reverse with count",
+			pagination: &query.PageRequest{Limit: 2, Reverse: true, CountTotal: true},
+			expDenoms:  []string{types.UsdDenom, "cherry"},
+			expTotal:   3,
+			expNextKey: []byte("banana"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &types.QueryNetAssetValuesRequest{Id: "apple", Pagination: tc.pagination}
+			resp, err := app.MarkerKeeper.NetAssetValues(ctx, req)
+			require.NoError(t, err, "NetAssetValues")
+			require.NotNil(t, resp, "NetAssetValues response")
+			assert.Equal(t, tc.expDenoms, priceDenoms(resp.NetAssetValues), "NetAssetValues price denoms")
+			if assert.NotNil(t, resp.Pagination, "NetAssetValues Pagination") {
+				assert.Equal(t, tc.expTotal, resp.Pagination.Total, "Pagination.Total")
+				assert.Equal(t, tc.expNextKey, resp.Pagination.NextKey, "Pagination.NextKey")
+			}
+		})
+	}
+}
+
 func TestIterateAllNetAssetValues(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -3079,6 +3225,12 @@ func (d dummyBankKeeper) GetBalance(_ context.Context, _ sdk.AccAddress, _ strin
 	return sdk.Coin{}
 }
 
+func (d dummyBankKeeper) SpendableCoin(_ context.Context, _ sdk.AccAddress, _ string) sdk.Coin {
+	return sdk.Coin{}
+}
+
+func (d dummyBankKeeper) SpendableCoins(_ context.Context, _ sdk.AccAddress) sdk.Coins { return nil }
+
 func (d dummyBankKeeper) GetSupply(_ context.Context, _ string) sdk.Coin { return sdk.Coin{} }
 
 func (d dummyBankKeeper) DenomOwners(_ context.Context, _ *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
@@ -3111,6 +3263,9 @@ func (d dummyBankKeeper) GetDenomMetaData(_ context.Context, _ string) (banktype
 
 func (d dummyBankKeeper) SetDenomMetaData(_ context.Context, _ banktypes.Metadata) {}
 
+func (d dummyBankKeeper) IterateAllDenomMetaData(_ context.Context, _ func(banktypes.Metadata) bool) {
+}
+
 func (d dummyBankKeeper) IterateAllBalances(_ context.Context, _ func(sdk.AccAddress, sdk.Coin) bool) {
 }
 
@@ -3130,7 +3285,7 @@ func TestBypassAddrsLocked(t *testing.T) {
 		sdk.AccAddress("addrs[4]____________"),
 	}
 
-	mk := markerkeeper.NewKeeper(nil, nil, nil, &dummyBankKeeper{}, nil, nil, nil, nil, nil, addrs, nil)
+	mk := markerkeeper.NewKeeper(nil, nil, nil, &dummyBankKeeper{}, nil, nil, nil, nil, nil, addrs, nil, nil)
 
 	// Now that the keeper has been created using the provided addresses, change the first byte of
 	// the first address to something else. Then, get the addresses back from the keeper and make
@@ -3141,3 +3296,213 @@ func TestBypassAddrsLocked(t *testing.T) {
 	act00 := kAddrs[0][0]
 	assert.Equal(t, orig00, act00, "first byte of first address returned by GetReqAttrBypassAddrs")
 }
+
+func TestMarkerHierarchy(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	user := testUserAddress("test")
+
+	newMarker := func(denom string, grants ...types.AccessGrant) {
+		addr := types.MustGetMarkerAddress(denom)
+		app.MarkerKeeper.SetNewMarker(ctx, types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(addr), sdk.NewInt64Coin(denom, 1000), nil,
+			grants, types.StatusActive, types.MarkerType_Coin, true, true, false, nil))
+	}
+	grant := func(denom string, perms string) types.AccessGrant {
+		return types.AccessGrant{Address: types.MustGetMarkerAddress(denom).String(), Permissions: types.AccessListByNames(perms)}
+	}
+
+	newMarker("topcoin", types.AccessGrant{Address: user.String(), Permissions: types.AccessListByNames("ADMIN")})
+	newMarker("midcoin", grant("topcoin", "MINT,BURN"))
+	newMarker("leafcoin", grant("midcoin", "WITHDRAW"), grant("topcoin", "ADMIN"))
+	newMarker("othercoin")
+
+	tests := []struct {
+		name        string
+		id          string
+		expParents  []types.MarkerHierarchyLink
+		expChildren []types.MarkerHierarchyLink
+		expErr      string
+	}{
+		{
+			name: "top of hierarchy",
+			id:   "topcoin",
+			expChildren: []types.MarkerHierarchyLink{
+				{Denom: "leafcoin", Permissions: types.AccessListByNames("ADMIN")},
+				{Denom: "midcoin", Permissions: types.AccessListByNames("MINT,BURN")},
+			},
+		},
+		{
+			name:        "middle of hierarchy",
+			id:          types.MustGetMarkerAddress("midcoin").String(),
+			expParents:  []types.MarkerHierarchyLink{{Denom: "topcoin", Permissions: types.AccessListByNames("MINT,BURN")}},
+			expChildren: []types.MarkerHierarchyLink{{Denom: "leafcoin", Permissions: types.AccessListByNames("WITHDRAW")}},
+		},
+		{
+			name: "bottom of hierarchy",
+			id:   "leafcoin",
+			expParents: []types.MarkerHierarchyLink{
+				{Denom: "midcoin", Permissions: types.AccessListByNames("WITHDRAW")},
+				{Denom: "topcoin", Permissions: types.AccessListByNames("ADMIN")},
+			},
+		},
+		{
+			name: "no hierarchy",
+			id:   "othercoin",
+		},
+		{
+			name:   "unknown marker",
+			id:     "nocoin",
+			expErr: "invalid denom or address: marker not found",
+		},
+		{
+			name:   "address without an account",
+			id:     user.String(),
+			expErr: "invalid denom or address: marker not found",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := app.MarkerKeeper.MarkerHierarchy(ctx, &types.QueryMarkerHierarchyRequest{Id: tc.id})
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "MarkerHierarchy error")
+				return
+			}
+			require.NoError(t, err, "MarkerHierarchy error")
+			assert.ElementsMatch(t, tc.expParents, res.Parents, "MarkerHierarchy parents")
+			assert.ElementsMatch(t, tc.expChildren, res.Children, "MarkerHierarchy children")
+		})
+	}
+
+	t.Run("paginated children", func(t *testing.T) {
+		res, err := app.MarkerKeeper.MarkerHierarchy(ctx, &types.QueryMarkerHierarchyRequest{
+			Id: "topcoin", Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err, "MarkerHierarchy first page")
+		require.Len(t, res.Children, 1, "first page children")
+		require.NotNil(t, res.Pagination, "first page pagination")
+		assert.Equal(t, 2, int(res.Pagination.Total), "first page total")
+		require.NotEmpty(t, res.Pagination.NextKey, "first page next key")
+		first := res.Children[0]
+
+		res, err = app.MarkerKeeper.MarkerHierarchy(ctx, &types.QueryMarkerHierarchyRequest{
+			Id: "topcoin", Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		})
+		require.NoError(t, err, "MarkerHierarchy second page")
+		require.Len(t, res.Children, 1, "second page children")
+		second := res.Children[0]
+		assert.ElementsMatch(t, []string{"leafcoin", "midcoin"}, []string{first.Denom, second.Denom}, "children from both pages")
+
+		res, err = app.MarkerKeeper.MarkerHierarchy(ctx, &types.QueryMarkerHierarchyRequest{
+			Id: "topcoin", Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+		})
+		require.NoError(t, err, "MarkerHierarchy reversed")
+		assert.Equal(t, []types.MarkerHierarchyLink{second}, res.Children, "reversed children")
+	})
+}
+
+func TestMarkerHolders(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	denom := "holdcoin"
+	mac := types.NewEmptyMarkerAccount(denom, testUserAddress("manager").String(), nil)
+	mac.Status = types.StatusActive
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount(%s)", denom)
+
+	var expAddrs []string
+	for i := 1; i <= 5; i++ {
+		addr := sdk.AccAddress(fmt.Sprintf("holder_%d____________", i))
+		require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(denom, int64(i*10)))), "FundAccount(%s)", addr)
+		expAddrs = append(expAddrs, addr.String())
+	}
+
+	toAddrs := func(holders []types.Balance) []string {
+		addrs := make([]string, len(holders))
+		for i, holder := range holders {
+			addrs[i] = holder.Address
+		}
+		return addrs
+	}
+
+	t.Run("GetMarkerHolders pages", func(t *testing.T) {
+		holders, nextKey, err := app.MarkerKeeper.GetMarkerHolders(ctx, denom, nil, 2)
+		require.NoError(t, err, "GetMarkerHolders first page")
+		assert.Equal(t, expAddrs[:2], toAddrs(holders), "first page holders")
+		assert.Equal(t, "10holdcoin", holders[0].Coins.String(), "first holder coins")
+		require.NotEmpty(t, nextKey, "first page next key")
+
+		holders, nextKey, err = app.MarkerKeeper.GetMarkerHolders(ctx, denom, nextKey, 3)
+		require.NoError(t, err, "GetMarkerHolders second page")
+		assert.Equal(t, expAddrs[2:], toAddrs(holders), "second page holders")
+		assert.Empty(t, nextKey, "second page next key")
+	})
+
+	t.Run("GetAllMarkerHolders", func(t *testing.T) {
+		var holders []types.Balance
+		err := app.MarkerKeeper.GetAllMarkerHolders(ctx, denom, func(holder types.Balance) bool {
+			holders = append(holders, holder)
+			return false
+		})
+		require.NoError(t, err, "GetAllMarkerHolders")
+		assert.Equal(t, expAddrs, toAddrs(holders), "all holders")
+
+		holders = nil
+		err = app.MarkerKeeper.GetAllMarkerHolders(ctx, denom, func(holder types.Balance) bool {
+			holders = append(holders, holder)
+			return len(holders) == 3
+		})
+		require.NoError(t, err, "GetAllMarkerHolders stopping early")
+		assert.Equal(t, expAddrs[:3], toAddrs(holders), "holders when stopping early")
+	})
+
+	t.Run("query", func(t *testing.T) {
+		_, err := app.MarkerKeeper.HoldersExport(ctx, nil)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "HoldersExport(nil)")
+		_, err = app.MarkerKeeper.HoldersExport(ctx, &types.QueryHoldersExportRequest{Id: denom, Pagination: &query.PageRequest{Limit: types.MaxHoldersExportLimit + 1}})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = limit 10001 cannot be more than 10000", "HoldersExport too large a limit")
+		_, err = app.MarkerKeeper.HoldersExport(ctx, &types.QueryHoldersExportRequest{Id: "nosuchcoin"})
+		assert.ErrorContains(t, err, "invalid denom or address", "HoldersExport unknown marker")
+
+		ctx = ctx.WithBlockHeight(12)
+		resp, err := app.MarkerKeeper.HoldersExport(ctx, &types.QueryHoldersExportRequest{Id: denom, Pagination: &query.PageRequest{Limit: 4}})
+		require.NoError(t, err, "HoldersExport first page")
+		assert.Equal(t, expAddrs[:4], toAddrs(resp.Holders), "HoldersExport first page holders")
+		assert.Equal(t, int64(12), resp.Height, "HoldersExport height")
+		require.NotEmpty(t, resp.Pagination.NextKey, "HoldersExport first page next key")
+
+		resp, err = app.MarkerKeeper.HoldersExport(ctx, &types.QueryHoldersExportRequest{Id: mac.GetAddress().String(), Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+		require.NoError(t, err, "HoldersExport second page")
+		assert.Equal(t, expAddrs[4:], toAddrs(resp.Holders), "HoldersExport second page holders")
+		assert.Empty(t, resp.Pagination.NextKey, "HoldersExport second page next key")
+	})
+}
+
+func TestMarkerExistenceFlags(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	user := testUserAddress("test")
+
+	mac := types.NewEmptyMarkerAccount("flagcoin", user.String(), nil)
+	assert.False(t, app.MarkerKeeper.IsMarkerDenom(ctx, "flagcoin"), "IsMarkerDenom before add")
+	assert.False(t, app.MarkerKeeper.IsMarkerAddress(ctx, mac.GetAddress()), "IsMarkerAddress before add")
+
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac), "AddMarkerAccount")
+	assert.True(t, app.MarkerKeeper.IsMarkerDenom(ctx, "flagcoin"), "IsMarkerDenom after add")
+	assert.True(t, app.MarkerKeeper.IsMarkerAddress(ctx, mac.GetAddress()), "IsMarkerAddress after add")
+	assert.False(t, app.MarkerKeeper.IsMarkerDenom(ctx, "otherflagcoin"), "IsMarkerDenom other denom")
+	assert.False(t, app.MarkerKeeper.IsMarkerAddress(ctx, user), "IsMarkerAddress user")
+
+	t.Run("migrate 4 to 5", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		app.MarkerKeeper.GetStore(cacheCtx).Delete(types.MarkerDenomKey("flagcoin"))
+		require.False(t, app.MarkerKeeper.IsMarkerDenom(cacheCtx, "flagcoin"), "IsMarkerDenom without flag")
+		require.NoError(t, markerkeeper.NewMigrator(app.MarkerKeeper).Migrate4to5(cacheCtx), "Migrate4to5")
+		assert.True(t, app.MarkerKeeper.IsMarkerDenom(cacheCtx, "flagcoin"), "IsMarkerDenom after migration")
+	})
+
+	app.MarkerKeeper.RemoveMarker(ctx, mac)
+	assert.False(t, app.MarkerKeeper.IsMarkerDenom(ctx, "flagcoin"), "IsMarkerDenom after removal")
+	assert.False(t, app.MarkerKeeper.IsMarkerAddress(ctx, mac.GetAddress()), "IsMarkerAddress after removal")
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ExportAssetManifest returns the asset manifest that describes how the provided marker is set up.
+func (k Keeper) ExportAssetManifest(ctx sdk.Context, marker types.MarkerAccountI) (*types.AssetManifest, error) {
+	var denomMetadata *banktypes.Metadata
+	if md, found := k.bankKeeper.GetDenomMetaData(ctx, marker.GetDenom()); found {
+		denomMetadata = &md
+	}
+
+	var navs []types.NetAssetValue
+	err := k.IterateNetAssetValues(ctx, marker.GetAddress(), func(nav types.NetAssetValue) (stop bool) {
+		navs = append(navs, nav)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewAssetManifest(marker, denomMetadata, navs), nil
+}
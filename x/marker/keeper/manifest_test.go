@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestAssetManifest(t *testing.T) {
+	const denom = "manifestcoin"
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrMinter := sdk.AccAddress("minter______________")
+
+	grants := []types.AccessGrant{
+		{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Transfer}},
+		{Address: addrMinter.String(), Permissions: types.AccessList{types.Access_Mint}},
+	}
+	metadata := banktypes.Metadata{
+		Description: "A coin for testing manifests.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "mega" + denom, Exponent: 6},
+		},
+		Base:    denom,
+		Display: "mega" + denom,
+		Name:    "Manifest Coin",
+		Symbol:  "MANI",
+	}
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:                 sdk.NewInt64Coin(denom, 5000),
+		Manager:                addrAdmin.String(),
+		FromAddress:            addrAdmin.String(),
+		MarkerType:             types.MarkerType_RestrictedCoin,
+		AccessList:             grants,
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+		AllowForcedTransfer:    true,
+		RequiredAttributes:     []string{"kyc.provenance.io"},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin("nhash", 12), 3)
+	_, err = msgServer.AddNetAssetValues(ctx.WithBlockHeight(8), types.NewMsgAddNetAssetValuesRequest(denom, addrAdmin.String(), []types.NetAssetValue{nav}))
+	require.NoError(t, err, "AddNetAssetValues")
+
+	expManifest := &types.AssetManifest{
+		Denom:                  denom,
+		Supply:                 sdk.NewInt64Coin(denom, 5000).Amount,
+		MarkerType:             types.MarkerType_RestrictedCoin,
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+		AllowForcedTransfer:    true,
+		RequiredAttributes:     []string{"kyc.provenance.io"},
+		AccessGrants:           grants,
+		DenomMetadata:          &metadata,
+		NetAssetValues:         []types.NetAssetValue{nav},
+	}
+
+	t.Run("query", func(t *testing.T) {
+		_, err := app.MarkerKeeper.AssetManifest(ctx, nil)
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request", "AssetManifest(nil)")
+		_, err = app.MarkerKeeper.AssetManifest(ctx, &types.QueryAssetManifestRequest{Id: "nosuchcoin"})
+		assert.ErrorContains(t, err, "invalid denom or address", "AssetManifest unknown marker")
+
+		resp, err := app.MarkerKeeper.AssetManifest(ctx, &types.QueryAssetManifestRequest{Id: denom})
+		require.NoError(t, err, "AssetManifest by denom")
+		assert.Equal(t, expManifest, resp.Manifest, "AssetManifest by denom")
+		assert.NoError(t, resp.Manifest.Validate(), "exported manifest Validate()")
+
+		resp, err = app.MarkerKeeper.AssetManifest(ctx, &types.QueryAssetManifestRequest{Id: types.MustGetMarkerAddress(denom).String()})
+		require.NoError(t, err, "AssetManifest by address")
+		assert.Equal(t, expManifest, resp.Manifest, "AssetManifest by address")
+	})
+
+	t.Run("import round trip", func(t *testing.T) {
+		app2 := simapp.Setup(t)
+		ctx2 := app2.BaseApp.NewContext(false)
+
+		msgs, err := expManifest.Msgs(addrAdmin)
+		require.NoError(t, err, "Msgs")
+		for i, msg := range msgs {
+			handler := app2.MsgServiceRouter().Handler(msg)
+			require.NotNil(t, handler, "msgs[%d] %s handler", i, sdk.MsgTypeURL(msg))
+			_, err = handler(ctx2, msg)
+			require.NoError(t, err, "msgs[%d] %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		marker, err := app2.MarkerKeeper.GetMarkerByDenom(ctx2, denom)
+		require.NoError(t, err, "GetMarkerByDenom after import")
+		assert.Equal(t, types.StatusActive, marker.GetStatus(), "status after import")
+		manifest, err := app2.MarkerKeeper.ExportAssetManifest(ctx2, marker)
+		require.NoError(t, err, "ExportAssetManifest after import")
+		assert.Equal(t, expManifest, manifest, "manifest after import")
+	})
+}
@@ -105,6 +105,18 @@ func (k Keeper) AddAccess(
 		if !mgr.Equals(caller) && m.GetStatus() == types.StatusProposed {
 			return fmt.Errorf("updates to pending marker %s can only be made by %s", m.GetDenom(), mgr)
 		}
+		if ag, ok := grant.(*types.AccessGrant); ok && len(ag.Roles) > 0 {
+			expanded, err := types.ExpandRoles(k.GetRoleTemplates(ctx), *ag)
+			if err != nil {
+				return fmt.Errorf("access grant failed: %w", err)
+			}
+			grant = &expanded
+		}
+		// Admin access on a live marker must be proposed and then accepted by the grantee (see ProposeAdmin).
+		if m.GetStatus() != types.StatusProposed && grant.HasAccess(types.Access_Admin) {
+			return fmt.Errorf("%s on finalized/active %s marker can only be granted using a propose admin request",
+				types.Access_Admin, m.GetDenom())
+		}
 		if err = m.GrantAccess(grant); err != nil {
 			return fmt.Errorf("access grant failed: %w", err)
 		}
@@ -203,6 +215,11 @@ func (k Keeper) WithdrawCoins(
 		return err
 	}
 
+	// The marker's remaining reserves must still back its supply.
+	if err := k.ValidateReserves(ctx, m, k.CurrentCirculation(ctx, m)); err != nil {
+		return err
+	}
+
 	markerWithdrawEvent := types.NewEventMarkerWithdraw(coins.String(), denom, caller.String(), recipient.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerWithdrawEvent)
@@ -212,6 +229,12 @@ func (k Keeper) WithdrawCoins(
 // updating the marker's record of expected total supply, and transferring the created coin to the MarkerAccount
 // for holding pending further action.
 func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin) error {
+	return k.MintTranche(ctx, caller, coin, "", nil)
+}
+
+// MintTranche is the same as MintCoin, but records the mint as an issuance tranche with the provided label and price.
+// Both the label and price are optional.
+func (k Keeper) MintTranche(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin, label string, price *sdk.Coin) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "mint_coin")
 
 	// (if marker does not exist then fail)
@@ -222,6 +245,9 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err = m.ValidateAddressHasAccess(caller, types.Access_Mint); err != nil {
 		return err
 	}
+	if err = k.useContractSupplyCap(ctx, m.GetAddress(), caller, coin.Amount, true); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -245,6 +271,10 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	if err = k.recordIssuanceTranche(ctx, m, coin.Amount, label, price, caller.String()); err != nil {
+		return err
+	}
+
 	markerMintEvent := types.NewEventMarkerMint(coin.Amount.String(), coin.Denom, caller.String())
 
 	return ctx.EventManager().EmitTypedEvent(markerMintEvent)
@@ -262,6 +292,9 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err = m.ValidateAddressHasAccess(caller, types.Access_Burn); err != nil {
 		return err
 	}
+	if err = k.useContractSupplyCap(ctx, m.GetAddress(), caller, coin.Amount, false); err != nil {
+		return err
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -339,10 +372,16 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 	return nil
 }
 
-// IncreaseSupply will mint coins to the marker module coin pool account, then send these to the marker account
+// IncreaseSupply will mint coins to the marker module coin pool account, then send these to the marker account.
+// The change counts against the marker's supply change policy unless the context bypasses it.
 func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "increase_supply")
 
+	usage, err := k.checkSupplyChange(ctx, marker, coin)
+	if err != nil {
+		return err
+	}
+
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 	total := inCirculation.Add(coin)
 	maxAllowed := sdk.NewCoin(marker.GetDenom(), k.GetMaxSupply(ctx))
@@ -350,6 +389,9 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		return fmt.Errorf(
 			"requested supply %s exceeds maximum allowed value %s", total.Amount.String(), maxAllowed.Amount.String())
 	}
+	if err := k.ValidateReserves(ctx, marker, total.Amount); err != nil {
+		return err
+	}
 
 	// If the marker has a fixed supply then adjust the supply to match the new total
 	if marker.HasFixedSupply() {
@@ -362,13 +404,23 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		k.SetMarker(ctx, marker)
 	}
 
-	return k.AdjustCirculation(ctx, marker, total)
+	if err = k.AdjustCirculation(ctx, marker, total); err != nil {
+		return err
+	}
+	k.setSupplyChangeUsage(ctx, marker, usage)
+	return nil
 }
 
 // DecreaseSupply will move a given amount of coin from the marker to the markermodule coin pool account then burn it.
+// The change counts against the marker's supply change policy unless the context bypasses it.
 func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "decrease_supply")
 
+	usage, err := k.checkSupplyChange(ctx, marker, coin)
+	if err != nil {
+		return err
+	}
+
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 
 	// Ensure the request will not send the total supply below zero
@@ -394,9 +446,10 @@ func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 	}
 
 	// Adjust circulation to match configured supply.
-	if err := k.AdjustCirculation(ctx, marker, inCirculation); err != nil {
+	if err = k.AdjustCirculation(ctx, marker, inCirculation); err != nil {
 		panic(err)
 	}
+	k.setSupplyChangeUsage(ctx, marker, usage)
 
 	return nil
 }
@@ -493,11 +546,20 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 			" supply %v, can not finalize marker", supplyRequest, preexistingCoin)
 	}
 
+	if err = k.ValidateReserves(ctx, m, supplyRequest.Amount); err != nil {
+		return err
+	}
+
 	// Ensure the supply amount requested is minted and placed in the marker's account
 	if err = k.AdjustCirculation(ctx, m, supplyRequest); err != nil {
 		return err
 	}
 
+	// Now that the marker is going active, its creator gets their creation deposit back.
+	if err = k.RefundCreationDeposit(ctx, m); err != nil {
+		return err
+	}
+
 	// With the coin supply minted and assigned to the marker we can transition to the Active state.
 	// this will enable the Invariant supply enforcement constraint.
 	if err = m.SetStatus(types.StatusActive); err != nil {
@@ -548,6 +610,9 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	default:
 		return fmt.Errorf("marker must be proposed, finalized, or active status to be cancelled")
 	}
+	if err = k.SettleCreationDepositOnCancel(ctx, m); err != nil {
+		return err
+	}
 	if err = m.SetStatus(types.StatusCancelled); err != nil {
 		return fmt.Errorf("could not update marker status: %w", err)
 	}
@@ -622,6 +687,12 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 // TransferCoin transfers restricted coins between to accounts when the administrator account holds the transfer
 // access right and the marker type is restricted_coin
 func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) error {
+	return k.TransferTaggedCoin(ctx, from, to, admin, amount, nil)
+}
+
+// TransferTaggedCoin is the same as TransferCoin, but also records the transfer in the sub-ledgers of the
+// from and/or to addresses for the sub-accounts identified in the tag. The tag is optional.
+func (k Keeper) TransferTaggedCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin, tag *types.SubAccountTag) error {
 	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "transfer_coin")
 
 	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
@@ -681,8 +752,11 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 		to.String(),
 		from.String(),
 	)
+	if err = ctx.EventManager().EmitTypedEvent(markerTransferEvent); err != nil {
+		return err
+	}
 
-	return ctx.EventManager().EmitTypedEvent(markerTransferEvent)
+	return k.recordSubLedgerTransfer(ctx, from, to, amount, tag)
 }
 
 // canForceTransferFrom returns true if funds can be forcefully transferred out of the provided address.
@@ -1,5 +1,13 @@
 package keeper
 
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +17,62 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2to3 migrates the marker store from version 2 to 3.
+// It builds the index of denied addresses to the markers that deny them from the existing send deny lists.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var keys [][]byte
+	m.keeper.IterateSendDeny(ctx, func(key []byte) bool {
+		markerAddr, denyAddr := types.GetDenySendAddresses(key)
+		keys = append(keys, types.DenySendAddressKey(denyAddr, markerAddr))
+		return false
+	})
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+	ctx.Logger().Info("Indexed marker send deny list entries.", "count", len(keys))
+	return nil
+}
+
+// Migrate3to4 migrates the marker store from version 3 to 4.
+// It moves the access list of each marker out of its account and into the access grant store.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var markers []types.MarkerAccountI
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(m.keeper.storeKey), types.MarkerStoreKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		marker, err := m.keeper.getMarkerAccount(ctx, it.Value())
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if marker != nil && len(marker.GetAccessList()) > 0 {
+			markers = append(markers, marker)
+		}
+	}
+	it.Close()
+
+	for _, marker := range markers {
+		m.keeper.SetMarker(ctx, marker)
+	}
+	ctx.Logger().Info("Moved marker access grants into the marker store.", "markers", len(markers))
+	return nil
+}
+
+// Migrate4to5 migrates the marker store from version 4 to 5.
+// It flags the denom of each marker so that a denom's marker existence can be checked without loading any accounts.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	var denoms []string
+	m.keeper.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		denoms = append(denoms, marker.GetDenom())
+		return false
+	})
+
+	store := ctx.KVStore(m.keeper.storeKey)
+	for _, denom := range denoms {
+		store.Set(types.MarkerDenomKey(denom), []byte{0x01})
+	}
+	ctx.Logger().Info("Flagged marker denoms.", "count", len(denoms))
+	return nil
+}
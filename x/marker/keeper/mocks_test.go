@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
@@ -280,12 +282,58 @@ func (w *WrappedAttrKeeper) WithGetAllAttributesAddrErrs(errs ...string) *Wrappe
 
 // GetAllAttributesAddr either returns a pre-defined error, or, if there isn't one, calls GetAllAttributesAddr on the parent.
 func (w *WrappedAttrKeeper) GetAllAttributesAddr(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error) {
-	if len(w.GetAllAttributesAddrErrs) > 0 {
-		rv := w.GetAllAttributesAddrErrs[0]
-		w.GetAllAttributesAddrErrs = w.GetAllAttributesAddrErrs[1:]
-		if len(rv) > 0 {
-			return nil, errors.New(rv)
-		}
+	if err := w.nextGetAllAttributesAddrErr(); err != nil {
+		return nil, err
 	}
 	return w.AttrKeeper.GetAllAttributesAddr(ctx, addr)
 }
+
+// GetAllAttributesAddrWithMirror either returns a pre-defined error (from the GetAllAttributesAddrErrs),
+// or, if there isn't one, calls GetAllAttributesAddrWithMirror on the parent.
+func (w *WrappedAttrKeeper) GetAllAttributesAddrWithMirror(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error) {
+	if err := w.nextGetAllAttributesAddrErr(); err != nil {
+		return nil, err
+	}
+	return w.AttrKeeper.GetAllAttributesAddrWithMirror(ctx, addr)
+}
+
+// nextGetAllAttributesAddrErr pops the next entry off the GetAllAttributesAddrErrs and returns it as an error.
+// Returns nil if the entry is empty or there aren't any left.
+func (w *WrappedAttrKeeper) nextGetAllAttributesAddrErr() error {
+	if len(w.GetAllAttributesAddrErrs) == 0 {
+		return nil
+	}
+	rv := w.GetAllAttributesAddrErrs[0]
+	w.GetAllAttributesAddrErrs = w.GetAllAttributesAddrErrs[1:]
+	if len(rv) > 0 {
+		return errors.New(rv)
+	}
+	return nil
+}
+
+// MockWasmKeeper is a mocked wasm keeper that only knows about the contract admins it was given.
+type MockWasmKeeper struct {
+	Admins map[string]string
+}
+
+var _ attrtypes.WasmKeeper = (*MockWasmKeeper)(nil)
+
+// NewMockWasmKeeper creates a new MockWasmKeeper without any contracts.
+func NewMockWasmKeeper() *MockWasmKeeper {
+	return &MockWasmKeeper{Admins: make(map[string]string)}
+}
+
+// WithContract adds a contract with the provided admin. This method both updates the receiver and returns it.
+func (k *MockWasmKeeper) WithContract(contract, admin sdk.AccAddress) *MockWasmKeeper {
+	k.Admins[string(contract)] = admin.String()
+	return k
+}
+
+// GetContractInfo returns info with the admin of the contract, or nil if it doesn't exist.
+func (k *MockWasmKeeper) GetContractInfo(_ context.Context, contract sdk.AccAddress) *wasmtypes.ContractInfo {
+	admin, found := k.Admins[string(contract)]
+	if !found {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{Admin: admin}
+}
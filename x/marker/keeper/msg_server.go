@@ -3,6 +3,10 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -73,6 +77,9 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		if err = k.ValidateUnrestictedDenom(ctx, msg.Amount.Denom); err != nil {
 			return nil, err
 		}
+		if err = k.ValidateDenomNameOwnership(ctx, msg.Amount.Denom, msg.FromAddress); err != nil {
+			return nil, err
+		}
 	}
 
 	addr := types.MustGetMarkerAddress(msg.Amount.Denom)
@@ -96,11 +103,16 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		return nil, err
 	}
 
+	accessList, err := k.ExpandRoleTemplates(ctx, msg.AccessList)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
 	ma := types.NewMarkerAccount(
 		authtypes.NewBaseAccountWithAddress(addr),
 		sdk.NewCoin(msg.Amount.Denom, msg.Amount.Amount),
 		manager,
-		msg.AccessList,
+		accessList,
 		msg.Status,
 		msg.MarkerType,
 		msg.SupplyFixed,
@@ -114,6 +126,13 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	// Markers created outside of governance require a deposit (if configured) to deter squatting on denoms.
+	if !isGovProp {
+		if err = k.Keeper.EscrowCreationDeposit(ctx, ma, sdk.MustAccAddressFromBech32(msg.FromAddress)); err != nil {
+			return nil, err
+		}
+	}
+
 	// Only create a NAV entry if an explicit value is given for a NAV.  If a zero value is desired this can be set explicitly in a followup call.
 	// This check prevents a proliferation of incorrect NAV entries being recorded when setting up markers.
 	if msg.UsdMills > 0 {
@@ -265,7 +284,7 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMintRequest) (*type
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
-	if err := k.Keeper.MintCoin(ctx, admin, msg.Amount); err != nil {
+	if err := k.Keeper.MintTranche(ctx, admin, msg.Amount, msg.TrancheLabel, msg.TranchePrice); err != nil {
 		ctx.Logger().Error("unable to mint coin for marker", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -384,7 +403,7 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransferRequest
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
-	err := k.TransferCoin(ctx, from, to, admin, msg.Amount)
+	err := k.TransferTaggedCoin(ctx, from, to, admin, msg.Amount, msg.SubAccountTag)
 	if err != nil {
 		return nil, err
 	}
@@ -485,6 +504,9 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 	if err = k.ValidateUnrestictedDenom(ctx, msg.Amount.Denom); err != nil {
 		return nil, err
 	}
+	if err = k.ValidateDenomNameOwnership(ctx, msg.Amount.Denom, msg.FromAddress); err != nil {
+		return nil, err
+	}
 
 	// since this is a one shot process should have 1 access list member, to have any value for a marker.
 	if len(msg.AccessList) == 0 {
@@ -508,12 +530,17 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 		return nil, err
 	}
 
+	accessList, err := k.ExpandRoleTemplates(ctx, msg.AccessList)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
 	account := authtypes.NewBaseAccount(addr, nil, 0, 0)
 	ma := types.NewMarkerAccount(
 		account,
 		sdk.NewCoin(msg.Amount.Denom, msg.Amount.Amount),
 		manager,
-		msg.AccessList,
+		accessList,
 		types.StatusProposed,
 		msg.MarkerType,
 		msg.SupplyFixed,
@@ -841,10 +868,1005 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, err
 	}
 
+	oldRoleTemplates := k.GetRoleTemplates(ctx)
 	k.SetParams(ctx, msg.Params)
-	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply)); err != nil {
+	updated, err := k.PropagateRoleTemplates(ctx, oldRoleTemplates, msg.Params.RoleTemplates)
+	if err != nil {
+		return nil, err
+	}
+	if updated > 0 {
+		k.Logger(ctx).Info("updated marker access grants for role template changes", "markers", updated)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params)); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ExecuteAsParent executes the provided msgs as the account of the parent marker.
+func (k msgServer) ExecuteAsParent(goCtx context.Context, msg *types.MsgExecuteAsParentRequest) (*types.MsgExecuteAsParentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := k.Keeper.ExecuteAsParent(ctx, admin, msg.ParentDenom, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExecuteAsParentResponse{Results: results}, nil
+}
+
+// SetERC20Pointer sets the ERC-20 contract that represents a marker on an external chain.
+// Signer must be the governance module account since a pointer claims the contract for the marker.
+// If the contract is already a pointer for a different marker, that pointer is removed.
+func (k msgServer) SetERC20Pointer(goCtx context.Context, msg *types.MsgSetERC20PointerRequest) (*types.MsgSetERC20PointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Signer != k.GetAuthority() {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Signer)
+	}
+	if _, err := k.GetMarkerByDenom(ctx, msg.Denom); err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	pointer := types.NewERC20Pointer(msg.Denom, msg.ChainId, msg.ContractAddress)
+	if existing, found := k.GetERC20PointerByContract(ctx, pointer.ChainId, pointer.ContractAddress); found && existing.Denom != pointer.Denom {
+		if _, err := k.Keeper.RemoveERC20Pointer(ctx, existing.Denom, existing.ChainId); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		err := ctx.EventManager().EmitTypedEvent(&types.EventMarkerERC20PointerRemoved{
+			Denom:           existing.Denom,
+			ChainId:         existing.ChainId,
+			ContractAddress: existing.ContractAddress,
+			Administrator:   msg.Signer,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.Keeper.SetERC20Pointer(ctx, pointer); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMarkerERC20PointerSet{
+		Denom:           pointer.Denom,
+		ChainId:         pointer.ChainId,
+		ContractAddress: pointer.ContractAddress,
+		Administrator:   msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetERC20PointerResponse{}, nil
+}
+
+// RemoveERC20Pointer removes the ERC-20 contract pointer of a marker for an external chain.
+// Signer must have admin authority or be the governance module account (even if the marker doesn't allow governance control).
+func (k msgServer) RemoveERC20Pointer(goCtx context.Context, msg *types.MsgRemoveERC20PointerRequest) (*types.MsgRemoveERC20PointerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Signer != k.GetAuthority() {
+		marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+		if err != nil {
+			return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+		}
+		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+			return nil, err
+		}
+	}
+
+	pointer, err := k.Keeper.RemoveERC20Pointer(ctx, msg.Denom, msg.ChainId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerERC20PointerRemoved{
+		Denom:           pointer.Denom,
+		ChainId:         pointer.ChainId,
+		ContractAddress: pointer.ContractAddress,
+		Administrator:   msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveERC20PointerResponse{}, nil
+}
+
+// SetBridge creates or updates a bridge that can mint and burn a marker's coin using external attestations.
+// Signer must have mint and burn authority or be a gov proposal.
+func (k msgServer) SetBridge(goCtx context.Context, msg *types.MsgSetBridgeRequest) (*types.MsgSetBridgeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Mint); err != nil {
+			return nil, err
+		}
+		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Burn); err != nil {
+			return nil, err
+		}
+	}
+
+	bridge, err := msg.GetBridge()
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = k.ConfigureBridge(ctx, bridge); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerBridgeSet{
+		Name:          msg.Name,
+		Denom:         msg.Denom,
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBridgeResponse{}, nil
+}
+
+// BridgeMint mints coin through a bridge using a message attested to by the bridge's attesters.
+func (k msgServer) BridgeMint(goCtx context.Context, msg *types.MsgBridgeMintRequest) (*types.MsgBridgeMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.BridgeMint(ctx, msg.Message, msg.Signatures); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMarkerBridgeMint{
+		Bridge:    msg.Message.Bridge,
+		Nonce:     strconv.FormatUint(msg.Message.Nonce, 10),
+		Source:    msg.Message.Source,
+		Amount:    msg.Message.Amount.String(),
+		Recipient: msg.Message.Recipient,
+		Relayer:   msg.Relayer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBridgeMintResponse{}, nil
+}
+
+// BridgeBurn burns coin through a bridge so that it can be released on another chain.
+func (k msgServer) BridgeBurn(goCtx context.Context, msg *types.MsgBridgeBurnRequest) (*types.MsgBridgeBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.BridgeBurn(ctx, msg.Bridge, sender, msg.Amount); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerBridgeBurn{
+		Bridge:      msg.Bridge,
+		Amount:      msg.Amount.String(),
+		Sender:      msg.Sender,
+		Destination: msg.Destination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBridgeBurnResponse{}, nil
+}
+
+// SetIssuerManagedFlags sets whether a marker's admins can update its flags without governance.
+// Signer must be the marker's manager while the marker is proposed, or be a gov proposal.
+func (k msgServer) SetIssuerManagedFlags(goCtx context.Context, msg *types.MsgSetIssuerManagedFlagsRequest) (*types.MsgSetIssuerManagedFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		if marker.GetStatus() != types.StatusProposed {
+			return nil, fmt.Errorf("issuer managed flags can only be set by governance on %s marker with status %s", msg.Denom, marker.GetStatus())
+		}
+		if marker.GetManager().String() != msg.Signer {
+			return nil, fmt.Errorf("%s is not the manager of the %s marker", msg.Signer, msg.Denom)
+		}
+	}
+
+	if marker.HasIssuerManagedFlags() == msg.IssuerManagedFlags {
+		return nil, fmt.Errorf("marker %s already has issuer_managed_flags = %t", msg.Denom, msg.IssuerManagedFlags)
+	}
+
+	marker.SetIssuerManagedFlags(msg.IssuerManagedFlags)
+	k.SetMarker(ctx, marker)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerIssuerManagedFlagsUpdated{
+		Denom:              msg.Denom,
+		IssuerManagedFlags: msg.IssuerManagedFlags,
+		Administrator:      msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetIssuerManagedFlagsResponse{}, nil
+}
+
+// UpdateMarkerFlags updates the allow_forced_transfer and allow_governance_control flags of a marker
+// that has issuer managed flags. The signers must be exactly the accounts with admin access on the marker.
+func (k msgServer) UpdateMarkerFlags(goCtx context.Context, msg *types.MsgUpdateMarkerFlagsRequest) (*types.MsgUpdateMarkerFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if !marker.HasIssuerManagedFlags() {
+		return nil, fmt.Errorf("%s marker does not have issuer managed flags", msg.Denom)
+	}
+
+	for _, signer := range msg.Signers {
+		if err = marker.ValidateHasAccess(signer, types.Access_Admin); err != nil {
+			return nil, err
+		}
+	}
+	for _, admin := range marker.AddressListForPermission(types.Access_Admin) {
+		if !slices.Contains(msg.Signers, admin.String()) {
+			return nil, fmt.Errorf("missing signature from %s: all admins of the %s marker must sign", admin, msg.Denom)
+		}
+	}
+
+	if msg.AllowForcedTransfer && marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("cannot allow forced transfer on unrestricted marker %s", msg.Denom)
+	}
+	if marker.AllowsForcedTransfer() == msg.AllowForcedTransfer && marker.HasGovernanceEnabled() == msg.AllowGovernanceControl {
+		return nil, fmt.Errorf("marker %s already has allow_forced_transfer = %t and allow_governance_control = %t",
+			msg.Denom, msg.AllowForcedTransfer, msg.AllowGovernanceControl)
+	}
+
+	marker.SetAllowForcedTransfer(msg.AllowForcedTransfer)
+	marker.SetAllowGovernanceControl(msg.AllowGovernanceControl)
+	k.SetMarker(ctx, marker)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerFlagsUpdated{
+		Denom:                  msg.Denom,
+		AllowForcedTransfer:    msg.AllowForcedTransfer,
+		AllowGovernanceControl: msg.AllowGovernanceControl,
+		Administrators:         msg.Signers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMarkerFlagsResponse{}, nil
+}
+
+// ProposeAdmin proposes a grant of admin access on a marker that only takes effect once the grantee accepts it.
+// Signer must be allowed to grant admin access on the marker or be a gov proposal.
+func (k msgServer) ProposeAdmin(goCtx context.Context, msg *types.MsgProposeAdminRequest) (*types.MsgProposeAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+	if err = k.ValidateAdminGrantor(marker, msg.Signer); err != nil {
+		return nil, err
+	}
+	if marker.HasAccess(msg.Grantee, types.Access_Admin) {
+		return nil, fmt.Errorf("%s already has %s on %s marker", msg.Grantee, types.Access_Admin, msg.Denom)
+	}
+
+	grant := types.PendingAdminGrant{Denom: msg.Denom, Grantee: msg.Grantee, Administrator: msg.Signer}
+	if err = k.Keeper.SetPendingAdminGrant(ctx, grant); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerAdminProposed{
+		Denom:         msg.Denom,
+		Grantee:       msg.Grantee,
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+// AcceptAdmin accepts a proposed grant of admin access on a marker. Signer must be the grantee.
+func (k msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdminRequest) (*types.MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grant, err := k.Keeper.AcceptAdminGrant(ctx, msg.Denom, sdk.MustAccAddressFromBech32(msg.Grantee))
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerAdminAccepted{
+		Denom:         grant.Denom,
+		Grantee:       grant.Grantee,
+		Administrator: grant.Administrator,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
+// CancelAdminProposal cancels a proposed grant of admin access on a marker before it is accepted.
+// Signer must be allowed to grant admin access on the marker or be a gov proposal.
+func (k msgServer) CancelAdminProposal(goCtx context.Context, msg *types.MsgCancelAdminProposalRequest) (*types.MsgCancelAdminProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+	if err = k.ValidateAdminGrantor(marker, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	grant, err := k.Keeper.RemovePendingAdminGrant(ctx, msg.Denom, sdk.MustAccAddressFromBech32(msg.Grantee))
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerAdminProposalCanceled{
+		Denom:         grant.Denom,
+		Grantee:       grant.Grantee,
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAdminProposalResponse{}, nil
+}
+
+// UpdateReqAttrBypassAddrs is a governance proposal endpoint for adding and removing addresses that can bypass the
+// required attributes checking of restricted markers.
+func (k msgServer) UpdateReqAttrBypassAddrs(goCtx context.Context, msg *types.MsgUpdateReqAttrBypassAddrsRequest) (*types.MsgUpdateReqAttrBypassAddrsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	for _, addrStr := range msg.RemoveAddresses {
+		addr := sdk.MustAccAddressFromBech32(addrStr)
+		if err := k.RemoveReqAttrBypassAddr(ctx, addr); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventReqAttrBypassAddrRemoved{Address: addrStr}); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, addrStr := range msg.AddAddresses {
+		addr := sdk.MustAccAddressFromBech32(addrStr)
+		if err := k.AddReqAttrBypassAddr(ctx, addr); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventReqAttrBypassAddrAdded{Address: addrStr}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateReqAttrBypassAddrsResponse{}, nil
+}
+
+// SetAttributeRevocationAction sets what a restricted marker does to an account that loses one of its required attributes.
+// Signer must have admin access on the marker, or be a gov proposal.
+func (k msgServer) SetAttributeRevocationAction(goCtx context.Context, msg *types.MsgSetAttributeRevocationActionRequest) (*types.MsgSetAttributeRevocationActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	if k.GetAttributeRevocationAction(ctx, marker.GetAddress()) == msg.Action {
+		return nil, fmt.Errorf("marker %s already has attribute revocation action %s", msg.Denom, msg.Action)
+	}
+
+	if err = k.SetAttributeRevocationConfig(ctx, types.NewAttributeRevocationConfig(msg.Denom, msg.Action)); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerAttributeRevocationActionSet{
+		Denom:         msg.Denom,
+		Action:        msg.Action.String(),
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeRevocationActionResponse{}, nil
+}
+
+// SetDustPolicy sets what a marker does with the dust left over from pro-rata operations.
+// Signer must have admin access on the marker, or be a gov proposal.
+func (k msgServer) SetDustPolicy(goCtx context.Context, msg *types.MsgSetDustPolicyRequest) (*types.MsgSetDustPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	if k.GetDustPolicy(ctx, marker.GetAddress()) == msg.Policy {
+		return nil, fmt.Errorf("marker %s already has dust policy %s", msg.Denom, msg.Policy)
+	}
+
+	if err = k.Keeper.SetDustPolicy(ctx, types.NewMarkerDustPolicy(msg.Denom, msg.Policy)); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerDustPolicySet{
+		Denom:         msg.Denom,
+		Policy:        msg.Policy.String(),
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDustPolicyResponse{}, nil
+}
+
+// SetReserveRequirement sets the collateral that a marker must hold in its escrow to back its supply.
+// Signer must have admin access on the marker, or be a gov proposal.
+func (k msgServer) SetReserveRequirement(goCtx context.Context, msg *types.MsgSetReserveRequirementRequest) (*types.MsgSetReserveRequirementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	req := types.NewReserveRequirement(msg.Denom, msg.ReserveDenoms, msg.Ratio)
+	if err = k.Keeper.SetReserveRequirement(ctx, req); err != nil {
+		return nil, err
+	}
+	// A new requirement must already be met by the marker's current supply.
+	if err = k.ValidateReserves(ctx, marker, k.CurrentCirculation(ctx, marker)); err != nil {
+		return nil, err
+	}
+
+	ratio := ""
+	if !req.IsEmpty() {
+		ratio = req.Ratio.String()
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerReserveRequirementSet{
+		Denom:         msg.Denom,
+		ReserveDenoms: strings.Join(msg.ReserveDenoms, ","),
+		Ratio:         ratio,
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReserveRequirementResponse{}, nil
+}
+
+// SetReserveAttestors sets the accounts that are approved to publish reserve attestations for a marker.
+// Signer must have admin access on the marker, or be a gov proposal.
+func (k msgServer) SetReserveAttestors(goCtx context.Context, msg *types.MsgSetReserveAttestorsRequest) (*types.MsgSetReserveAttestorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.SetReserveAttestors(ctx, types.NewReserveAttestors(msg.Denom, msg.Attestors)); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerReserveAttestorsSet{
+		Denom:         msg.Denom,
+		Attestors:     strings.Join(msg.Attestors, ","),
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReserveAttestorsResponse{}, nil
+}
+
+// AttestReserves publishes a proof-of-reserve attestation for a marker. Attestor must be one of the marker's approved attestors.
+func (k msgServer) AttestReserves(goCtx context.Context, msg *types.MsgAttestReservesRequest) (*types.MsgAttestReservesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	attestors, err := k.GetReserveAttestors(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	if attestors == nil || !attestors.HasAttestor(msg.Attestor) {
+		return nil, fmt.Errorf("%s is not an approved reserve attestor for %s marker", msg.Attestor, msg.Denom)
+	}
+
+	if !msg.ValidUntil.After(ctx.BlockTime()) {
+		return nil, fmt.Errorf("valid until %s must be after the current block time %s",
+			msg.ValidUntil.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+
+	attestation := types.ReserveAttestation{
+		Denom:      msg.Denom,
+		Attestor:   msg.Attestor,
+		Amount:     msg.Amount,
+		Custodian:  msg.Custodian,
+		ReportHash: msg.ReportHash,
+		ValidFrom:  msg.ValidFrom,
+		ValidUntil: msg.ValidUntil,
+		Height:     ctx.BlockHeight(),
+	}
+	if err = k.SetReserveAttestation(ctx, attestation); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerReservesAttested{
+		Denom:      attestation.Denom,
+		Attestor:   attestation.Attestor,
+		Amount:     attestation.Amount.String(),
+		Custodian:  attestation.Custodian,
+		ReportHash: attestation.ReportHashString(),
+		ValidUntil: attestation.ValidUntil.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestReservesResponse{}, nil
+}
+
+// SchedulePolicyChange schedules a change to a restricted marker's required attributes that takes effect at a
+// future block height. Signer must have transfer access on the marker or be a gov proposal.
+func (k msgServer) SchedulePolicyChange(goCtx context.Context, msg *types.MsgSchedulePolicyChangeRequest) (*types.MsgSchedulePolicyChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+	if err = k.ValidatePolicyChanger(marker, msg.TransferAuthority); err != nil {
+		return nil, err
+	}
+
+	change, err := k.Keeper.SchedulePolicyChange(ctx, types.ScheduledPolicyChange{
+		Denom:                    msg.Denom,
+		EffectiveHeight:          msg.EffectiveHeight,
+		AddRequiredAttributes:    msg.AddRequiredAttributes,
+		RemoveRequiredAttributes: msg.RemoveRequiredAttributes,
+		ScheduledBy:              msg.TransferAuthority,
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerPolicyChangeScheduled{
+		Id:              change.Id,
+		Denom:           change.Denom,
+		EffectiveHeight: change.EffectiveHeight,
+		ScheduledBy:     change.ScheduledBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSchedulePolicyChangeResponse{Id: change.Id}, nil
+}
+
+// CancelPolicyChange cancels a scheduled change to a restricted marker's required attributes before it takes effect.
+// Signer must have transfer access on the marker or be a gov proposal.
+func (k msgServer) CancelPolicyChange(goCtx context.Context, msg *types.MsgCancelPolicyChangeRequest) (*types.MsgCancelPolicyChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+	if err = k.ValidatePolicyChanger(marker, msg.TransferAuthority); err != nil {
+		return nil, err
+	}
+
+	change, err := k.Keeper.RemoveScheduledPolicyChange(ctx, msg.Denom, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerPolicyChangeCanceled{
+		Id:         change.Id,
+		Denom:      change.Denom,
+		CanceledBy: msg.TransferAuthority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPolicyChangeResponse{}, nil
+}
+
+// Swap atomically exchanges amounts of two different marker denoms between two parties. Both parties must sign.
+func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapRequest) (*types.MsgSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	partyA := sdk.MustAccAddressFromBech32(msg.PartyA)
+	partyB := sdk.MustAccAddressFromBech32(msg.PartyB)
+	if err := k.Keeper.Swap(ctx, partyA, msg.AmountA, partyB, msg.AmountB, 0); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSwapResponse{}, nil
+}
+
+// CreateSwapOffer records an offer to swap amounts of two different marker denoms that can be accepted until it expires.
+func (k msgServer) CreateSwapOffer(goCtx context.Context, msg *types.MsgCreateSwapOfferRequest) (*types.MsgCreateSwapOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.Keeper.CreateSwapOffer(ctx, types.SwapOffer{
+		Maker:            msg.Maker,
+		Taker:            msg.Taker,
+		Offer:            msg.Offer,
+		Ask:              msg.Ask,
+		ExpirationHeight: msg.ExpirationHeight,
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerSwapOfferCreated{
+		Id:               offer.Id,
+		Maker:            offer.Maker,
+		Taker:            offer.Taker,
+		Offer:            offer.Offer.String(),
+		Ask:              offer.Ask.String(),
+		ExpirationHeight: offer.ExpirationHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSwapOfferResponse{OfferId: offer.Id}, nil
+}
+
+// AcceptSwapOffer completes the swap described by an unexpired swap offer.
+func (k msgServer) AcceptSwapOffer(goCtx context.Context, msg *types.MsgAcceptSwapOfferRequest) (*types.MsgAcceptSwapOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	taker := sdk.MustAccAddressFromBech32(msg.Taker)
+	if err := k.Keeper.AcceptSwapOffer(ctx, msg.OfferId, taker); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgAcceptSwapOfferResponse{}, nil
+}
+
+// CancelSwapOffer removes a swap offer before it is accepted. Signer must be the offer's maker.
+func (k msgServer) CancelSwapOffer(goCtx context.Context, msg *types.MsgCancelSwapOfferRequest) (*types.MsgCancelSwapOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	offer, err := k.Keeper.CancelSwapOffer(ctx, msg.OfferId, msg.Maker)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerSwapOfferCanceled{
+		Id:    offer.Id,
+		Maker: offer.Maker,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelSwapOfferResponse{}, nil
+}
+
+// SetBridgedSupply sets how a bridged marker's supply is reconciled against the balance locked on the counterparty
+// chain. Signer must have admin access on the marker, or be a gov proposal.
+func (k msgServer) SetBridgedSupply(goCtx context.Context, msg *types.MsgSetBridgedSupplyRequest) (*types.MsgSetBridgedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	// Any previous reconciliation was against the old settings, so it no longer applies.
+	k.RemoveBridgedSupply(ctx, marker.GetAddress())
+	bridged := types.NewBridgedSupply(msg.Denom, msg.ChannelId, msg.LockAddress, msg.RemoteDenom, msg.IntervalBlocks)
+	if err = k.Keeper.SetBridgedSupply(ctx, bridged); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerBridgedSupplySet{
+		Denom:          msg.Denom,
+		ChannelId:      msg.ChannelId,
+		LockAddress:    msg.LockAddress,
+		RemoteDenom:    msg.RemoteDenom,
+		IntervalBlocks: msg.IntervalBlocks,
+		Administrator:  msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBridgedSupplyResponse{}, nil
+}
+
+// SetSupplyChangePolicy sets the largest portion of a marker's supply that the mints and burns of a single block can
+// change without a governance proposal. Signer must have admin access on the marker, or be a gov proposal. Only a gov
+// proposal can loosen or remove an existing policy.
+func (k msgServer) SetSupplyChangePolicy(goCtx context.Context, msg *types.MsgSetSupplyChangePolicyRequest) (*types.MsgSetSupplyChangePolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	// Changes beyond the policy can only be made through governance, so it has to be allowed.
+	if !marker.HasGovernanceEnabled() {
+		return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+	}
+
+	policy := types.NewSupplyChangePolicy(msg.Denom, msg.MaxChange)
+	if msg.Signer != k.GetAuthority() {
+		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+			return nil, err
+		}
+		existing, err := k.GetSupplyChangePolicy(ctx, marker.GetAddress())
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && (policy.IsEmpty() || policy.MaxChange.GT(existing.MaxChange)) {
+			return nil, fmt.Errorf("only governance can loosen or remove the %s marker supply change policy", msg.Denom)
+		}
+	}
+
+	if err = k.Keeper.SetSupplyChangePolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	maxChange := ""
+	if !policy.IsEmpty() {
+		maxChange = policy.MaxChange.String()
+	}
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerSupplyChangePolicySet{
+		Denom:         msg.Denom,
+		MaxChange:     maxChange,
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSupplyChangePolicyResponse{}, nil
+}
+
+// SetReceiptPolicy sets how many blocks the recipients of a restricted marker's coins have to acknowledge a transfer
+// before the coins are returned to the sender. A timeout of zero removes the policy. Signer must have admin access
+// on the marker, or be a gov proposal.
+func (k msgServer) SetReceiptPolicy(goCtx context.Context, msg *types.MsgSetReceiptPolicyRequest) (*types.MsgSetReceiptPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker %s is not a restricted marker", msg.Denom)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.SetReceiptPolicy(ctx, types.NewReceiptPolicy(msg.Denom, msg.TimeoutBlocks)); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerReceiptPolicySet{
+		Denom:         msg.Denom,
+		TimeoutBlocks: msg.TimeoutBlocks,
+		Administrator: msg.Signer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetReceiptPolicyResponse{}, nil
+}
+
+// AcknowledgeReceipt delivers the coins of a pending receipt to its recipient. Signer must be the recipient.
+func (k msgServer) AcknowledgeReceipt(goCtx context.Context, msg *types.MsgAcknowledgeReceiptRequest) (*types.MsgAcknowledgeReceiptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+	if err := k.Keeper.AcknowledgeReceipt(ctx, msg.Id, recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgAcknowledgeReceiptResponse{}, nil
+}
+
+// DeclineReceipt returns the coins of a pending receipt to their sender. Signer must be the recipient.
+func (k msgServer) DeclineReceipt(goCtx context.Context, msg *types.MsgDeclineReceiptRequest) (*types.MsgDeclineReceiptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+	if err := k.Keeper.DeclineReceipt(ctx, msg.Id, recipient); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgDeclineReceiptResponse{}, nil
+}
+
+// SetContractSupplyCap sets how much a smart contract with mint or burn access on a marker can mint or burn each day,
+// or removes the contract's cap. Signer must have admin access on the marker, or be a gov proposal. The amounts the
+// contract has already minted and burned today still count against the new limits.
+func (k msgServer) SetContractSupplyCap(goCtx context.Context, msg *types.MsgSetContractSupplyCapRequest) (*types.MsgSetContractSupplyCapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %w", msg.Denom, err)
+	}
+
+	if msg.Signer == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else if err = marker.ValidateHasAccess(msg.Signer, types.Access_Admin); err != nil {
+		return nil, err
+	}
+
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+	event := &types.EventMarkerContractSupplyCapSet{
+		Denom:         msg.Denom,
+		Contract:      msg.Contract,
+		Administrator: msg.Signer,
+		Removed:       msg.Remove,
+	}
+	if msg.Remove {
+		k.RemoveContractSupplyCap(ctx, marker.GetAddress(), contract)
+	} else {
+		supplyCap := types.NewContractSupplyCap(msg.Denom, msg.Contract, msg.DailyMintLimit.Amount, msg.DailyBurnLimit.Amount)
+		existing, err := k.GetContractSupplyCap(ctx, marker.GetAddress(), contract)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			usage := existing.ForDay(types.ContractSupplyCapDay(ctx.BlockTime()))
+			supplyCap.Day, supplyCap.Minted, supplyCap.Burned = usage.Day, usage.Minted, usage.Burned
+		}
+		if err = k.Keeper.SetContractSupplyCap(ctx, supplyCap); err != nil {
+			return nil, err
+		}
+		event.DailyMintLimit = supplyCap.DailyMintLimit.String()
+		event.DailyBurnLimit = supplyCap.DailyBurnLimit.String()
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractSupplyCapResponse{}, nil
+}
+
+// SeizeEscrowProposal can only be called via gov proposal
+func (k msgServer) SeizeEscrowProposal(goCtx context.Context, msg *types.MsgSeizeEscrowProposalRequest) (*types.MsgSeizeEscrowProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	amount, revoked, err := k.Keeper.HandleSeizeEscrowProposal(ctx, msg.Denom, msg.RecoveryAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerEscrowSeized{
+		Denom:            msg.Denom,
+		Amount:           amount.String(),
+		RecoveryAddress:  msg.RecoveryAddress,
+		RevokedAddresses: revoked,
+		Administrator:    msg.Authority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSeizeEscrowProposalResponse{Amount: amount, RevokedAddresses: revoked}, nil
+}
@@ -16,12 +16,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 
 	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/internal/pioconfig"
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -761,6 +765,26 @@ func (s *MsgServerTestSuite) TestMsgAddAccessRequest() {
 			}
 		})
 	}
+
+	s.Run("admin access on an active marker", func() {
+		_, err = s.msgServer.AddFinalizeActivateMarker(s.ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+			Amount:      sdk.NewInt64Coin("activedog", 100),
+			Manager:     s.owner1,
+			FromAddress: s.owner1,
+			MarkerType:  types.MarkerType_Coin,
+			AccessList:  []types.AccessGrant{{Address: s.owner1, Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}}},
+		})
+		s.Require().NoError(err, "AddFinalizeActivateMarker")
+		expErr := "ACCESS_ADMIN on finalized/active activedog marker can only be granted using a propose admin request: unauthorized"
+
+		grant := types.AccessGrant{Address: s.owner2, Permissions: types.AccessList{types.Access_Mint, types.Access_Admin}}
+		_, err = s.msgServer.AddAccess(s.ctx, types.NewMsgAddAccessRequest("activedog", s.owner1Addr, grant))
+		s.Assert().EqualError(err, expErr, "AddAccess with admin permission")
+
+		grant = types.AccessGrant{Address: s.owner2, Roles: []string{types.RoleRegistrar}}
+		_, err = s.msgServer.AddAccess(s.ctx, types.NewMsgAddAccessRequest("activedog", s.owner1Addr, grant))
+		s.Assert().EqualError(err, expErr, "AddAccess with a role that has admin")
+	})
 }
 
 func (s *MsgServerTestSuite) TestMsgDeleteAccessMarkerRequest() {
@@ -879,6 +903,73 @@ func (s *MsgServerTestSuite) TestMsgCancelMarkerRequest() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestMarkerCreationDeposit() {
+	feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
+	deposit := sdk.NewInt64Coin(feeDenom, 1000)
+	params := s.app.MarkerKeeper.GetParams(s.ctx)
+	params.CreationDeposit = deposit.Amount
+	params.CreationDepositTimeoutBlocks = 10
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	s.ctx = s.ctx.WithBlockHeight(5)
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner1Addr, sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 2500))), "funding account")
+
+	addMarker := func(denom string) {
+		msg := types.NewMsgAddMarkerRequest(denom, sdkmath.NewInt(100), s.owner1Addr, s.owner1Addr, types.MarkerType_Coin, true, true, false, []string{}, 0, 0)
+		_, err := s.msgServer.AddMarker(s.ctx, msg)
+		s.Require().NoError(err, "AddMarker(%s)", denom)
+		escrowed, found := s.app.MarkerKeeper.GetCreationDeposit(s.ctx, types.MustGetMarkerAddress(denom))
+		s.Require().True(found, "GetCreationDeposit(%s) found", denom)
+		s.Assert().Equal(types.NewMarkerCreationDeposit(denom, s.owner1Addr, deposit, 15), escrowed, "GetCreationDeposit(%s)", denom)
+	}
+	balance := func() sdkmath.Int {
+		return s.app.BankKeeper.GetBalance(s.ctx, s.owner1Addr, feeDenom).Amount
+	}
+
+	addMarker("activatedcoin")
+	addMarker("earlycancelcoin")
+	addMarker("latecancelcoin")
+	s.Assert().Equal("100", balance().String(), "balance after creating markers")
+
+	msg := types.NewMsgAddMarkerRequest("brokecoin", sdkmath.NewInt(100), s.owner1Addr, s.owner1Addr, types.MarkerType_Coin, true, true, false, []string{}, 0, 0)
+	_, err := s.msgServer.AddMarker(s.ctx, msg)
+	s.Assert().ErrorContains(err, "could not escrow marker creation deposit 1000"+feeDenom, "AddMarker without enough funds")
+
+	s.Run("activate refunds deposit", func() {
+		_, err = s.msgServer.Finalize(s.ctx, types.NewMsgFinalizeRequest("activatedcoin", s.owner1Addr))
+		s.Require().NoError(err, "Finalize")
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err = s.msgServer.Activate(s.ctx, types.NewMsgActivateRequest("activatedcoin", s.owner1Addr))
+		s.Require().NoError(err, "Activate")
+		s.Assert().Equal("1100", balance().String(), "balance after activation")
+		expEvent := types.NewEventMarkerCreationDepositRefunded(types.NewMarkerCreationDeposit("activatedcoin", s.owner1Addr, deposit, 15))
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "refunded event emitted")
+		_, found := s.app.MarkerKeeper.GetCreationDeposit(s.ctx, types.MustGetMarkerAddress("activatedcoin"))
+		s.Assert().False(found, "deposit found after activation")
+	})
+
+	s.Run("cancel before timeout refunds deposit", func() {
+		s.ctx = s.ctx.WithBlockHeight(14)
+		_, err = s.msgServer.Cancel(s.ctx, types.NewMsgCancelRequest("earlycancelcoin", s.owner1Addr))
+		s.Require().NoError(err, "Cancel")
+		s.Assert().Equal("2100", balance().String(), "balance after early cancel")
+		_, found := s.app.MarkerKeeper.GetCreationDeposit(s.ctx, types.MustGetMarkerAddress("earlycancelcoin"))
+		s.Assert().False(found, "deposit found after early cancel")
+	})
+
+	s.Run("cancel after timeout burns deposit", func() {
+		s.ctx = s.ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+		supplyBefore := s.app.BankKeeper.GetSupply(s.ctx, feeDenom).Amount
+		_, err = s.msgServer.Cancel(s.ctx, types.NewMsgCancelRequest("latecancelcoin", s.owner1Addr))
+		s.Require().NoError(err, "Cancel")
+		s.Assert().Equal("2100", balance().String(), "balance after late cancel")
+		s.Assert().Equal(supplyBefore.SubRaw(1000).String(), s.app.BankKeeper.GetSupply(s.ctx, feeDenom).Amount.String(), "supply after late cancel")
+		expEvent := types.NewEventMarkerCreationDepositBurned(types.NewMarkerCreationDeposit("latecancelcoin", s.owner1Addr, deposit, 15))
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "burned event emitted")
+		_, found := s.app.MarkerKeeper.GetCreationDeposit(s.ctx, types.MustGetMarkerAddress("latecancelcoin"))
+		s.Assert().False(found, "deposit found after late cancel")
+	})
+}
+
 func (s *MsgServerTestSuite) TestMsgDeleteMarkerRequest() {
 	hotdogDenom := "hotdog"
 	accessDeleteMintGrant := types.AccessGrant{
@@ -948,6 +1039,23 @@ func (s *MsgServerTestSuite) TestMsgMintMarkerRequest() {
 			msg:           types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(hotdogDenom, 100)),
 			expectedEvent: types.NewEventMarkerMint("100", hotdogDenom, s.owner1),
 		},
+		{
+			name: "should record the tranche of a mint",
+			msg: &types.MsgMintRequest{
+				Amount:        sdk.NewInt64Coin(hotdogDenom, 50),
+				Administrator: s.owner1,
+				TrancheLabel:  "Series A",
+				TranchePrice:  &sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(25)},
+			},
+			expectedEvent: &types.EventMarkerTrancheRecorded{
+				Denom:    hotdogDenom,
+				Id:       2,
+				Label:    "Series A",
+				Amount:   "50",
+				Price:    "25usd",
+				MintedBy: s.owner1,
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -1750,6 +1858,56 @@ func (s *MsgServerTestSuite) TestWithdrawEscrowProposal() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestSeizeEscrowProposal() {
+	denom := "seizecoin"
+	authority := s.app.MarkerKeeper.GetAuthority()
+	recovery := testUserAddress("recovery").String()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		true,       // Supply fixed
+		true,       // Allow gov
+		false,      // don't allow forced transfer
+		[]string{}, // No required attributes.
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Transfer}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Mint}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	s.Run("not the authority", func() {
+		_, err = s.msgServer.SeizeEscrowProposal(s.ctx, &types.MsgSeizeEscrowProposalRequest{
+			Denom: denom, RecoveryAddress: recovery, Authority: s.owner1,
+		})
+		s.Assert().ErrorContains(err, fmt.Sprintf("expected %q got %q", authority, s.owner1), "SeizeEscrowProposal")
+	})
+
+	s.Run("seized", func() {
+		resp, err := s.msgServer.SeizeEscrowProposal(s.ctx, &types.MsgSeizeEscrowProposalRequest{
+			Denom: denom, RecoveryAddress: recovery, Authority: authority,
+		})
+		s.Require().NoError(err, "SeizeEscrowProposal")
+		s.Assert().Equal("100seizecoin", resp.Amount.String(), "SeizeEscrowProposal amount")
+		s.Assert().Equal([]string{s.owner1, s.owner2}, resp.RevokedAddresses, "SeizeEscrowProposal revoked addresses")
+
+		expectedEvent := &types.EventMarkerEscrowSeized{
+			Denom:            denom,
+			Amount:           "100seizecoin",
+			RecoveryAddress:  recovery,
+			RevokedAddresses: []string{s.owner1, s.owner2},
+			Administrator:    authority,
+		}
+		result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), expectedEvent)
+		s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", expectedEvent)
+	})
+}
+
 func (s *MsgServerTestSuite) TestSetDenomMetadataProposal() {
 	hotdogMarker := types.NewMarkerAccount(
 		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress("hotdog")),
@@ -1839,6 +1997,9 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					sdkmath.NewInt(1000),
+					100,
+					types.DefaultRoleTemplates(),
 				),
 			},
 		},
@@ -1850,6 +2011,9 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					sdkmath.NewInt(1000),
+					100,
+					types.DefaultRoleTemplates(),
 				),
 			},
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalidAuthority": expected gov account as only signer for proposal message`,
@@ -1870,3 +2034,964 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestExecuteAsParent() {
+	parentDenom, childDenom := "parentcoin", "childcoin"
+	parentAddr := types.MustGetMarkerAddress(parentDenom)
+	childAddr := types.MustGetMarkerAddress(childDenom)
+
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(parentAddr), sdk.NewInt64Coin(parentDenom, 1000), nil,
+		[]types.AccessGrant{{Address: s.owner1, Permissions: types.AccessListByNames("ADMIN")}},
+		types.StatusActive, types.MarkerType_Coin, true, true, false, nil))
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(childAddr), sdk.NewInt64Coin(childDenom, 0), nil,
+		[]types.AccessGrant{{Address: parentAddr.String(), Permissions: types.AccessListByNames("MINT")}},
+		types.StatusActive, types.MarkerType_Coin, false, true, false, nil))
+
+	newMsg := func(admin sdk.AccAddress, msgs ...sdk.Msg) *types.MsgExecuteAsParentRequest {
+		rv, err := types.NewMsgExecuteAsParentRequest(parentDenom, admin, msgs)
+		s.Require().NoError(err, "NewMsgExecuteAsParentRequest")
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		msg      *types.MsgExecuteAsParentRequest
+		expErr   string
+		expEvent proto.Message
+		expEsc   sdk.Coins
+	}{
+		{
+			name:   "signer without admin on parent",
+			msg:    newMsg(s.owner2Addr, types.NewMsgMintRequest(parentAddr, sdk.NewInt64Coin(childDenom, 5))),
+			expErr: s.noAccessErr(s.owner2, types.Access_Admin, parentDenom) + ": unauthorized",
+		},
+		{
+			name: "inner msg not signed by parent",
+			msg:  newMsg(s.owner1Addr, types.NewMsgMintRequest(s.owner1Addr, sdk.NewInt64Coin(childDenom, 5))),
+			expErr: "msgs[0] /provenance.marker.v1.MsgMintRequest must have parent marker parentcoin address " +
+				parentAddr.String() + " as its only signer: unauthorized",
+		},
+		{
+			name: "parent lacks access on child",
+			msg:  newMsg(s.owner1Addr, types.NewMsgBurnRequest(parentAddr, sdk.NewInt64Coin(childDenom, 5))),
+			expErr: "error executing msgs[0] /provenance.marker.v1.MsgBurnRequest: " +
+				s.noAccessErr(parentAddr.String(), types.Access_Burn, childDenom) + ": invalid request",
+		},
+		{
+			name: "admin of parent mints child",
+			msg:  newMsg(s.owner1Addr, types.NewMsgMintRequest(parentAddr, sdk.NewInt64Coin(childDenom, 5))),
+			expEvent: &types.EventMarkerExecuteAsParent{
+				ParentDenom:   parentDenom,
+				Administrator: s.owner1,
+				MsgTypes:      []string{"/provenance.marker.v1.MsgMintRequest"},
+			},
+			expEsc: sdk.NewCoins(sdk.NewInt64Coin(childDenom, 5)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.ExecuteAsParent(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "ExecuteAsParent error")
+				return
+			}
+			s.Require().NoError(err, "ExecuteAsParent error")
+			s.Assert().Len(res.Results, len(tc.msg.Msgs), "ExecuteAsParent results")
+			if tc.expEvent != nil {
+				result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expEvent)
+				s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", tc.expEvent)
+			}
+			child, err := s.app.MarkerKeeper.GetMarker(s.ctx, childAddr)
+			s.Require().NoError(err, "GetMarker(child)")
+			s.Assert().Equal(tc.expEsc.String(), s.app.MarkerKeeper.GetEscrow(s.ctx, child).String(), "child escrow")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestExecuteAsParentDisallowedMsgs() {
+	parentDenom, childDenom := "disparentcoin", "dischildcoin"
+	parentAddr := types.MustGetMarkerAddress(parentDenom)
+	childAddr := types.MustGetMarkerAddress(childDenom)
+
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(parentAddr), sdk.NewInt64Coin(parentDenom, 1000), nil,
+		[]types.AccessGrant{{Address: s.owner1, Permissions: types.AccessListByNames("ADMIN")}},
+		types.StatusActive, types.MarkerType_Coin, true, true, false, nil))
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(childAddr), sdk.NewInt64Coin(childDenom, 0), nil,
+		[]types.AccessGrant{{Address: parentAddr.String(), Permissions: types.AccessListByNames("MINT,ADMIN")}},
+		types.StatusActive, types.MarkerType_Coin, false, true, false, nil))
+
+	mintMsg := types.NewMsgMintRequest(parentAddr, sdk.NewInt64Coin(childDenom, 5))
+	authzMsg := authz.NewMsgExec(parentAddr, []sdk.Msg{mintMsg})
+	nestedMsg, err := types.NewMsgExecuteAsParentRequest(parentDenom, parentAddr, []sdk.Msg{mintMsg})
+	s.Require().NoError(err, "NewMsgExecuteAsParentRequest")
+
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{name: "authz exec", msg: &authzMsg},
+		{name: "bank send", msg: banktypes.NewMsgSend(parentAddr, s.owner1Addr, sdk.NewCoins(sdk.NewInt64Coin(parentDenom, 1)))},
+		{name: "marker fee grant", msg: &types.MsgGrantAllowanceRequest{Denom: childDenom, Administrator: parentAddr.String(), Grantee: s.owner1}},
+		{name: "nested execute as parent", msg: nestedMsg},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			_, err := s.app.MarkerKeeper.ExecuteAsParent(ctx, s.owner1Addr, parentDenom, []sdk.Msg{mintMsg, tc.msg})
+			expErr := "msgs[1] " + sdk.MsgTypeURL(tc.msg) + " cannot be executed as a parent marker: unauthorized"
+			s.Require().EqualError(err, expErr, "ExecuteAsParent error")
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestERC20Pointers() {
+	denomA := "erc20acoin"
+	denomB := "erc20bcoin"
+	denomNoGov := "erc20nogovcoin"
+	authority := s.app.MarkerKeeper.GetAuthority()
+	contract1 := "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238"
+	contract2 := "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+
+	for _, denom := range []string{denomA, denomB, denomNoGov} {
+		msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+			denom, sdkmath.NewInt(100),
+			s.owner1Addr, s.owner1Addr, // From and Manager.
+			types.MarkerType_Coin,
+			true,       // Supply fixed
+			true,       // Allow gov
+			false,      // don't allow forced transfer
+			[]string{}, // No required attributes.
+			[]types.AccessGrant{
+				{Address: s.owner1, Permissions: []types.Access{types.Access_Mint, types.Access_Admin}},
+				{Address: s.owner2, Permissions: []types.Access{types.Access_Deposit}},
+			},
+			0,
+			0,
+		)
+		_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+		s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+	}
+	noGovMarker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denomNoGov)
+	s.Require().NoError(err, "GetMarkerByDenom(%q)", denomNoGov)
+	noGovMarker.(*types.MarkerAccount).AllowGovernanceControl = false
+	s.app.MarkerKeeper.SetMarker(s.ctx, noGovMarker)
+
+	testcases := []struct {
+		name          string
+		msg           sdk.Msg
+		expectedEvent proto.Message
+		errorMsg      string
+	}{
+		{
+			name:     "set by admin",
+			msg:      types.NewMsgSetERC20PointerRequest(denomA, "eip155:1", contract1, s.owner1Addr),
+			errorMsg: "expected " + authority + " got " + s.owner1 + ": expected gov account as only signer for proposal message",
+		},
+		{
+			name:          "set via gov prop",
+			msg:           &types.MsgSetERC20PointerRequest{Denom: denomA, ChainId: "eip155:1", ContractAddress: contract1, Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerSet{Denom: denomA, ChainId: "eip155:1", ContractAddress: contract1, Administrator: authority},
+		},
+		{
+			name:          "set another chain via gov prop",
+			msg:           &types.MsgSetERC20PointerRequest{Denom: denomA, ChainId: "eip155:8453", ContractAddress: contract2, Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerSet{Denom: denomA, ChainId: "eip155:8453", ContractAddress: contract2, Administrator: authority},
+		},
+		{
+			name:          "set via gov prop on marker without gov control",
+			msg:           &types.MsgSetERC20PointerRequest{Denom: denomNoGov, ChainId: "eip155:1", ContractAddress: contract2, Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerSet{Denom: denomNoGov, ChainId: "eip155:1", ContractAddress: contract2, Administrator: authority},
+		},
+		{
+			name:     "set for unknown marker",
+			msg:      &types.MsgSetERC20PointerRequest{Denom: "unknowncoin", ChainId: "eip155:1", ContractAddress: contract2, Signer: authority},
+			errorMsg: "marker not found for unknowncoin: marker unknowncoin not found for address: " + types.MustGetMarkerAddress("unknowncoin").String(),
+		},
+		{
+			name:          "set contract already used by another marker",
+			msg:           &types.MsgSetERC20PointerRequest{Denom: denomB, ChainId: "eip155:1", ContractAddress: "0x1C7D4B196CB0C7B01D743FBC6116A902379C7238", Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerRemoved{Denom: denomA, ChainId: "eip155:1", ContractAddress: contract1, Administrator: authority},
+		},
+		{
+			name:          "set same contract for another marker on another chain",
+			msg:           &types.MsgSetERC20PointerRequest{Denom: denomB, ChainId: "eip155:10", ContractAddress: contract1, Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerSet{Denom: denomB, ChainId: "eip155:10", ContractAddress: contract1, Administrator: authority},
+		},
+		{
+			name:          "replace pointer for a chain",
+			msg:           &types.MsgSetERC20PointerRequest{Denom: denomB, ChainId: "eip155:10", ContractAddress: contract2, Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerSet{Denom: denomB, ChainId: "eip155:10", ContractAddress: contract2, Administrator: authority},
+		},
+		{
+			name:     "remove unknown pointer",
+			msg:      types.NewMsgRemoveERC20PointerRequest(denomA, "eip155:1", s.owner1Addr),
+			errorMsg: "marker " + denomA + " does not have an ERC-20 pointer for eip155:1: invalid request",
+		},
+		{
+			name:     "remove by signer without admin",
+			msg:      types.NewMsgRemoveERC20PointerRequest(denomA, "eip155:8453", s.owner2Addr),
+			errorMsg: s.noAccessErr(s.owner2, types.Access_Admin, denomA),
+		},
+		{
+			name:          "remove by admin",
+			msg:           types.NewMsgRemoveERC20PointerRequest(denomA, "eip155:8453", s.owner1Addr),
+			expectedEvent: &types.EventMarkerERC20PointerRemoved{Denom: denomA, ChainId: "eip155:8453", ContractAddress: contract2, Administrator: s.owner1},
+		},
+		{
+			name:          "remove via gov prop on marker without gov control",
+			msg:           &types.MsgRemoveERC20PointerRequest{Denom: denomNoGov, ChainId: "eip155:1", Signer: authority},
+			expectedEvent: &types.EventMarkerERC20PointerRemoved{Denom: denomNoGov, ChainId: "eip155:1", ContractAddress: contract2, Administrator: authority},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgSetERC20PointerRequest:
+				_, err = s.msgServer.SetERC20Pointer(s.ctx, msg)
+			case *types.MsgRemoveERC20PointerRequest:
+				_, err = s.msgServer.RemoveERC20Pointer(s.ctx, msg)
+			default:
+				s.FailNow("unexpected msg type", "%T", tc.msg)
+			}
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "handler(%T) error", tc.msg)
+				return
+			}
+			s.Require().NoError(err, "handler(%T) error", tc.msg)
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", tc.expectedEvent)
+		})
+	}
+
+	s.Run("query pointers", func() {
+		resp, err := s.app.MarkerKeeper.ERC20Pointers(s.ctx, &types.QueryERC20PointersRequest{Id: denomB})
+		s.Require().NoError(err, "ERC20Pointers error")
+		expected := []types.ERC20Pointer{
+			types.NewERC20Pointer(denomB, "eip155:1", "0x1C7D4B196CB0C7B01D743FBC6116A902379C7238"),
+			types.NewERC20Pointer(denomB, "eip155:10", contract2),
+		}
+		s.Assert().Equal(expected, resp.Pointers, "ERC20Pointers pointers")
+		s.Require().NotNil(resp.Pagination, "ERC20Pointers pagination")
+		s.Assert().Equal(2, int(resp.Pagination.Total), "ERC20Pointers pagination total")
+
+		resp, err = s.app.MarkerKeeper.ERC20Pointers(s.ctx, &types.QueryERC20PointersRequest{
+			Id: denomB, Pagination: &query.PageRequest{Offset: 2},
+		})
+		s.Require().NoError(err, "ERC20Pointers with offset error")
+		s.Assert().Empty(resp.Pointers, "ERC20Pointers with offset pointers")
+	})
+
+	s.Run("query by contract", func() {
+		resp, err := s.app.MarkerKeeper.ERC20PointerByContract(s.ctx, &types.QueryERC20PointerByContractRequest{
+			ChainId:         "eip155:1",
+			ContractAddress: contract1,
+		})
+		s.Require().NoError(err, "ERC20PointerByContract error")
+		s.Assert().Equal(types.NewERC20Pointer(denomB, "eip155:1", "0x1C7D4B196CB0C7B01D743FBC6116A902379C7238"), resp.Pointer, "ERC20PointerByContract pointer")
+
+		_, found := s.app.MarkerKeeper.GetERC20Pointer(s.ctx, denomA, "eip155:1")
+		s.Assert().False(found, "GetERC20Pointer of the marker the contract was taken from")
+
+		_, err = s.app.MarkerKeeper.ERC20PointerByContract(s.ctx, &types.QueryERC20PointerByContractRequest{
+			ChainId:         "eip155:8453",
+			ContractAddress: contract2,
+		})
+		s.Assert().EqualError(err, "rpc error: code = NotFound desc = no marker found for contract "+contract2+" on eip155:8453", "ERC20PointerByContract removed pointer error")
+	})
+
+	s.Run("export and import genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		expected := []types.ERC20Pointer{
+			types.NewERC20Pointer(denomB, "eip155:1", "0x1C7D4B196CB0C7B01D743FBC6116A902379C7238"),
+			types.NewERC20Pointer(denomB, "eip155:10", contract2),
+		}
+		s.Assert().ElementsMatch(expected, genState.Erc20Pointers, "exported Erc20Pointers")
+		s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	})
+
+	s.Run("removing marker removes pointers", func() {
+		ctx, _ := s.ctx.CacheContext()
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(ctx, denomB)
+		s.Require().NoError(err, "GetMarkerByDenom(%q)", denomB)
+		s.app.MarkerKeeper.RemoveMarker(ctx, marker)
+		_, found := s.app.MarkerKeeper.GetERC20PointerByContract(ctx, "eip155:1", contract1)
+		s.Assert().False(found, "GetERC20PointerByContract after RemoveMarker")
+	})
+}
+
+func (s *MsgServerTestSuite) TestBridges() {
+	denom := "bridgecoin"
+	bridgeName := "cctp"
+	authority := s.app.MarkerKeeper.GetAuthority()
+	s.ctx = s.ctx.WithChainID("bridge-test-chain")
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		false,      // Supply not fixed
+		true,       // Allow gov
+		false,      // don't allow forced transfer
+		[]string{}, // No required attributes.
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Admin}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Mint, types.Access_Transfer}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	attesterKeys := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	attesters := make([][]byte, len(attesterKeys))
+	for i, key := range attesterKeys {
+		attesters[i] = key.PubKey().Bytes()
+	}
+
+	newMessage := func(nonce uint64, amount int64) types.BridgeMessage {
+		return types.BridgeMessage{
+			Bridge:    bridgeName,
+			ChainId:   s.ctx.ChainID(),
+			Nonce:     nonce,
+			Source:    "domain0:0xabc",
+			Recipient: s.owner2,
+			Amount:    sdk.NewInt64Coin(denom, amount),
+		}
+	}
+	sign := func(message types.BridgeMessage, keys ...*secp256k1.PrivKey) [][]byte {
+		signBytes, err := message.SignBytes()
+		s.Require().NoError(err, "SignBytes")
+		rv := make([][]byte, len(keys))
+		for i, key := range keys {
+			rv[i], err = key.Sign(signBytes)
+			s.Require().NoError(err, "Sign")
+		}
+		return rv
+	}
+	mintEvent := func(message types.BridgeMessage) *types.EventMarkerBridgeMint {
+		return &types.EventMarkerBridgeMint{
+			Bridge:    message.Bridge,
+			Nonce:     fmt.Sprintf("%d", message.Nonce),
+			Source:    message.Source,
+			Amount:    message.Amount.String(),
+			Recipient: message.Recipient,
+			Relayer:   s.owner1,
+		}
+	}
+
+	msg1 := newMessage(1, 600)
+	msg2 := newMessage(2, 500)
+	wrongChain := newMessage(3, 10)
+	wrongChain.ChainId = "other-chain"
+	wrongDenom := newMessage(4, 10)
+	wrongDenom.Amount = sdk.NewInt64Coin("othercoin", 10)
+
+	testcases := []struct {
+		name          string
+		msg           sdk.Msg
+		expectedEvent proto.Message
+		errorMsg      string
+	}{
+		{
+			name:     "set by signer without burn",
+			msg:      types.NewMsgSetBridgeRequest(bridgeName, denom, attesters, 2, sdkmath.NewInt(1000), s.owner2Addr),
+			errorMsg: s.noAccessErr(s.owner2, types.Access_Burn, denom),
+		},
+		{
+			name:     "set for unknown marker",
+			msg:      types.NewMsgSetBridgeRequest(bridgeName, "unknowncoin", attesters, 2, sdkmath.NewInt(1000), s.owner1Addr),
+			errorMsg: "marker not found for unknowncoin: marker unknowncoin not found for address: " + types.MustGetMarkerAddress("unknowncoin").String(),
+		},
+		{
+			name:          "set by minter",
+			msg:           types.NewMsgSetBridgeRequest(bridgeName, denom, attesters, 2, sdkmath.NewInt(1000), s.owner1Addr),
+			expectedEvent: &types.EventMarkerBridgeSet{Name: bridgeName, Denom: denom, Administrator: s.owner1},
+		},
+		{
+			name:     "mint with too few signatures",
+			msg:      types.NewMsgBridgeMintRequest(msg1, sign(msg1, attesterKeys[0]), s.owner1Addr),
+			errorMsg: "message has 1 valid attester signatures, bridge cctp requires 2: invalid request",
+		},
+		{
+			name:     "mint with the same signature twice",
+			msg:      types.NewMsgBridgeMintRequest(msg1, sign(msg1, attesterKeys[1], attesterKeys[1]), s.owner1Addr),
+			errorMsg: "message has 1 valid attester signatures, bridge cctp requires 2: invalid request",
+		},
+		{
+			name:     "mint with signatures of another message",
+			msg:      types.NewMsgBridgeMintRequest(msg1, sign(msg2, attesterKeys[0], attesterKeys[1]), s.owner1Addr),
+			errorMsg: "message has 0 valid attester signatures, bridge cctp requires 2: invalid request",
+		},
+		{
+			name:     "mint for another chain",
+			msg:      types.NewMsgBridgeMintRequest(wrongChain, sign(wrongChain, attesterKeys[0], attesterKeys[1]), s.owner1Addr),
+			errorMsg: `message chain id "other-chain" does not match "bridge-test-chain": invalid request`,
+		},
+		{
+			name:     "mint another denom",
+			msg:      types.NewMsgBridgeMintRequest(wrongDenom, sign(wrongDenom, attesterKeys[0], attesterKeys[1]), s.owner1Addr),
+			errorMsg: "bridge cctp cannot mint othercoin, only bridgecoin: invalid request",
+		},
+		{
+			name:          "mint with enough signatures",
+			msg:           types.NewMsgBridgeMintRequest(msg1, sign(msg1, attesterKeys[2], attesterKeys[0]), s.owner1Addr),
+			expectedEvent: mintEvent(msg1),
+		},
+		{
+			name:     "mint replayed",
+			msg:      types.NewMsgBridgeMintRequest(msg1, sign(msg1, attesterKeys[0], attesterKeys[1]), s.owner1Addr),
+			errorMsg: "nonce 1 has already been used with bridge cctp: invalid request",
+		},
+		{
+			name:     "mint over the limit",
+			msg:      types.NewMsgBridgeMintRequest(msg2, sign(msg2, attesterKeys[0], attesterKeys[1]), s.owner1Addr),
+			errorMsg: "cannot mint 500bridgecoin through bridge cctp: outstanding amount would exceed the mint limit of 1000bridgecoin: invalid request",
+		},
+		{
+			name:     "burn more than outstanding",
+			msg:      types.NewMsgBridgeBurnRequest(bridgeName, sdk.NewInt64Coin(denom, 601), "domain0:0xabc", s.owner2Addr),
+			errorMsg: "cannot burn 601bridgecoin through bridge cctp: only 600bridgecoin is outstanding: invalid request",
+		},
+		{
+			name:          "burn",
+			msg:           types.NewMsgBridgeBurnRequest(bridgeName, sdk.NewInt64Coin(denom, 200), "domain0:0xabc", s.owner2Addr),
+			expectedEvent: &types.EventMarkerBridgeBurn{Bridge: bridgeName, Amount: "200bridgecoin", Sender: s.owner2, Destination: "domain0:0xabc"},
+		},
+		{
+			name:          "update via gov prop",
+			msg:           &types.MsgSetBridgeRequest{Name: bridgeName, Denom: denom, Attesters: attesters[:2], Threshold: 2, MintLimit: "900", Signer: authority},
+			expectedEvent: &types.EventMarkerBridgeSet{Name: bridgeName, Denom: denom, Administrator: authority},
+		},
+		{
+			name:          "mint after limit change",
+			msg:           types.NewMsgBridgeMintRequest(msg2, sign(msg2, attesterKeys[0], attesterKeys[1]), s.owner1Addr),
+			expectedEvent: mintEvent(msg2),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgSetBridgeRequest:
+				_, err = s.msgServer.SetBridge(s.ctx, msg)
+			case *types.MsgBridgeMintRequest:
+				_, err = s.msgServer.BridgeMint(s.ctx, msg)
+			case *types.MsgBridgeBurnRequest:
+				_, err = s.msgServer.BridgeBurn(s.ctx, msg)
+			default:
+				s.FailNow("unexpected msg type", "%T", tc.msg)
+			}
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "handler(%T) error", tc.msg)
+				return
+			}
+			s.Require().NoError(err, "handler(%T) error", tc.msg)
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", tc.expectedEvent)
+		})
+	}
+
+	s.Run("balances and supply", func() {
+		balance := s.app.BankKeeper.GetBalance(s.ctx, s.owner2Addr, denom)
+		s.Assert().Equal("900bridgecoin", balance.String(), "recipient balance")
+		supply := s.app.BankKeeper.GetSupply(s.ctx, denom)
+		s.Assert().Equal("1000bridgecoin", supply.String(), "supply")
+	})
+
+	s.Run("burn by sender on deny list", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		s.app.MarkerKeeper.AddSendDeny(cacheCtx, types.MustGetMarkerAddress(denom), s.owner2Addr)
+		_, err := s.msgServer.BridgeBurn(cacheCtx, types.NewMsgBridgeBurnRequest(bridgeName, sdk.NewInt64Coin(denom, 10), "domain0:0xabc", s.owner2Addr))
+		s.Assert().EqualError(err, s.owner2+" is on deny list for sending restricted marker: invalid request", "BridgeBurn error")
+		balance := s.app.BankKeeper.GetBalance(cacheCtx, s.owner2Addr, denom)
+		s.Assert().Equal("900bridgecoin", balance.String(), "sender balance after failed burn")
+	})
+
+	s.Run("query bridge", func() {
+		resp, err := s.app.MarkerKeeper.Bridge(s.ctx, &types.QueryBridgeRequest{Name: bridgeName})
+		s.Require().NoError(err, "Bridge error")
+		s.Assert().Equal(attesters[:2], resp.Bridge.Attesters, "Bridge attesters")
+		s.Assert().Equal("900", resp.Bridge.MintLimit.String(), "Bridge mint limit")
+		s.Assert().Equal("900", resp.Bridge.Outstanding.String(), "Bridge outstanding")
+
+		_, err = s.app.MarkerKeeper.Bridge(s.ctx, &types.QueryBridgeRequest{Name: "unknown"})
+		s.Assert().EqualError(err, "rpc error: code = NotFound desc = bridge unknown not found", "Bridge unknown error")
+	})
+
+	s.Run("query bridge nonce", func() {
+		for nonce, expUsed := range map[uint64]bool{1: true, 2: true, 3: false} {
+			resp, err := s.app.MarkerKeeper.BridgeNonce(s.ctx, &types.QueryBridgeNonceRequest{Name: bridgeName, Nonce: nonce})
+			s.Require().NoError(err, "BridgeNonce(%d) error", nonce)
+			s.Assert().Equal(expUsed, resp.Used, "BridgeNonce(%d) used", nonce)
+		}
+	})
+
+	s.Run("changing denom", func() {
+		bridge, found := s.app.MarkerKeeper.GetBridge(s.ctx, bridgeName)
+		s.Require().True(found, "GetBridge found")
+		bridge.Denom = "othercoin"
+		err := s.app.MarkerKeeper.ConfigureBridge(s.ctx, bridge)
+		s.Assert().EqualError(err, "bridge cctp already exists for bridgecoin", "ConfigureBridge error")
+	})
+
+	s.Run("name reused by another marker", func() {
+		cacheCtx, _ := s.ctx.CacheContext()
+		otherDenom := "otherbridgecoin"
+		otherMsg := types.NewMsgAddFinalizeActivateMarkerRequest(
+			otherDenom, sdkmath.NewInt(100),
+			s.owner2Addr, s.owner2Addr, // From and Manager.
+			types.MarkerType_RestrictedCoin,
+			false,      // Supply not fixed
+			true,       // Allow gov
+			false,      // don't allow forced transfer
+			[]string{}, // No required attributes.
+			[]types.AccessGrant{
+				{Address: s.owner2, Permissions: []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Admin}},
+			},
+			0,
+			0,
+		)
+		_, err := s.msgServer.AddFinalizeActivateMarker(cacheCtx, otherMsg)
+		s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", otherDenom)
+
+		// Even once nothing is outstanding, the admin of another marker can't take over the bridge.
+		bridge, found := s.app.MarkerKeeper.GetBridge(cacheCtx, bridgeName)
+		s.Require().True(found, "GetBridge found")
+		bridge.Outstanding = sdkmath.ZeroInt()
+		s.Require().NoError(s.app.MarkerKeeper.SetBridge(cacheCtx, bridge), "SetBridge")
+
+		takeover := types.NewMsgSetBridgeRequest(bridgeName, otherDenom, attesters[2:], 1, sdkmath.NewInt(1000), s.owner2Addr)
+		_, err = s.msgServer.SetBridge(cacheCtx, takeover)
+		s.Assert().EqualError(err, "bridge cctp already exists for bridgecoin: invalid request", "SetBridge error")
+		bridge, found = s.app.MarkerKeeper.GetBridge(cacheCtx, bridgeName)
+		s.Require().True(found, "GetBridge found after takeover attempt")
+		s.Assert().Equal(denom, bridge.Denom, "bridge denom after takeover attempt")
+		s.Assert().Equal(attesters[:2], bridge.Attesters, "bridge attesters after takeover attempt")
+	})
+
+	s.Run("export genesis", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Require().Len(genState.Bridges, 1, "exported Bridges")
+		s.Assert().Equal(bridgeName, genState.Bridges[0].Name, "exported Bridges[0].Name")
+		expNonces := []types.BridgeNonce{{Bridge: bridgeName, Nonce: 1}, {Bridge: bridgeName, Nonce: 2}}
+		s.Assert().Equal(expNonces, genState.UsedBridgeNonces, "exported UsedBridgeNonces")
+		s.Require().NoError(genState.Validate(), "exported genesis Validate")
+	})
+}
+
+func (s *MsgServerTestSuite) TestIssuerManagedFlags() {
+	denom := "issuercoin"
+	proposedDenom := "proposedissuercoin"
+	authority := s.app.MarkerKeeper.GetAuthority()
+	owner3Addr := testUserAddress("owner3")
+	owner3 := owner3Addr.String()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		true,       // Supply fixed
+		true,       // Allow gov
+		false,      // don't allow forced transfer
+		[]string{}, // No required attributes.
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Transfer}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Admin}},
+			{Address: owner3, Permissions: []types.Access{types.Access_Mint}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	proposedMarker := types.NewEmptyMarkerAccount(proposedDenom, s.owner1, []types.AccessGrant{
+		{Address: s.owner1, Permissions: types.AccessList{types.Access_Admin}},
+	})
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, proposedMarker), "AddMarkerAccount(%q)", proposedDenom)
+
+	testcases := []struct {
+		name          string
+		msg           sdk.Msg
+		expectedEvent proto.Message
+		errorMsg      string
+	}{
+		{
+			name:     "set by manager on active marker",
+			msg:      types.NewMsgSetIssuerManagedFlagsRequest(denom, true, s.owner1Addr),
+			errorMsg: "issuer managed flags can only be set by governance on " + denom + " marker with status active",
+		},
+		{
+			name:     "update flags without issuer managed flags",
+			msg:      types.NewMsgUpdateMarkerFlagsRequest(denom, true, true, s.owner1Addr, s.owner2Addr),
+			errorMsg: denom + " marker does not have issuer managed flags",
+		},
+		{
+			name:          "set via gov prop",
+			msg:           &types.MsgSetIssuerManagedFlagsRequest{Denom: denom, IssuerManagedFlags: true, Signer: authority},
+			expectedEvent: &types.EventMarkerIssuerManagedFlagsUpdated{Denom: denom, IssuerManagedFlags: true, Administrator: authority},
+		},
+		{
+			name:     "set via gov prop when already set",
+			msg:      &types.MsgSetIssuerManagedFlagsRequest{Denom: denom, IssuerManagedFlags: true, Signer: authority},
+			errorMsg: "marker " + denom + " already has issuer_managed_flags = true",
+		},
+		{
+			name:     "set by non-manager on proposed marker",
+			msg:      types.NewMsgSetIssuerManagedFlagsRequest(proposedDenom, true, s.owner2Addr),
+			errorMsg: s.owner2 + " is not the manager of the " + proposedDenom + " marker",
+		},
+		{
+			name:          "set by manager on proposed marker",
+			msg:           types.NewMsgSetIssuerManagedFlagsRequest(proposedDenom, true, s.owner1Addr),
+			expectedEvent: &types.EventMarkerIssuerManagedFlagsUpdated{Denom: proposedDenom, IssuerManagedFlags: true, Administrator: s.owner1},
+		},
+		{
+			name:     "update flags without all admins",
+			msg:      types.NewMsgUpdateMarkerFlagsRequest(denom, true, true, s.owner1Addr),
+			errorMsg: "missing signature from " + s.owner2 + ": all admins of the " + denom + " marker must sign",
+		},
+		{
+			name:     "update flags with a non-admin signer",
+			msg:      types.NewMsgUpdateMarkerFlagsRequest(denom, true, true, s.owner1Addr, s.owner2Addr, owner3Addr),
+			errorMsg: s.noAccessErr(owner3, types.Access_Admin, denom),
+		},
+		{
+			name:     "update flags without changes",
+			msg:      types.NewMsgUpdateMarkerFlagsRequest(denom, false, true, s.owner2Addr, s.owner1Addr),
+			errorMsg: "marker " + denom + " already has allow_forced_transfer = false and allow_governance_control = true",
+		},
+		{
+			name:     "allow forced transfer on unrestricted marker",
+			msg:      types.NewMsgUpdateMarkerFlagsRequest(proposedDenom, true, true, s.owner1Addr),
+			errorMsg: "cannot allow forced transfer on unrestricted marker " + proposedDenom,
+		},
+		{
+			name: "update flags with all admins",
+			msg:  types.NewMsgUpdateMarkerFlagsRequest(denom, true, false, s.owner2Addr, s.owner1Addr),
+			expectedEvent: &types.EventMarkerFlagsUpdated{
+				Denom:                  denom,
+				AllowForcedTransfer:    true,
+				AllowGovernanceControl: false,
+				Administrators:         []string{s.owner2, s.owner1},
+			},
+		},
+		{
+			name:     "unset via gov prop after governance control removed",
+			msg:      &types.MsgSetIssuerManagedFlagsRequest{Denom: denom, IssuerManagedFlags: false, Signer: authority},
+			errorMsg: denom + " marker does not allow governance control",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgSetIssuerManagedFlagsRequest:
+				_, err = s.msgServer.SetIssuerManagedFlags(s.ctx, msg)
+			case *types.MsgUpdateMarkerFlagsRequest:
+				_, err = s.msgServer.UpdateMarkerFlags(s.ctx, msg)
+			default:
+				s.FailNow("unexpected msg type", "%T", tc.msg)
+			}
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "handler(%T) error", tc.msg)
+				return
+			}
+			s.Require().NoError(err, "handler(%T) error", tc.msg)
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", tc.expectedEvent)
+		})
+	}
+
+	s.Run("marker flags updated", func() {
+		marker, err := s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, denom)
+		s.Require().NoError(err, "GetMarkerByDenom(%q)", denom)
+		s.Assert().True(marker.HasIssuerManagedFlags(), "HasIssuerManagedFlags")
+		s.Assert().True(marker.AllowsForcedTransfer(), "AllowsForcedTransfer")
+		s.Assert().False(marker.HasGovernanceEnabled(), "HasGovernanceEnabled")
+	})
+
+	s.Run("exported genesis has issuer managed flags", func() {
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		found := false
+		for _, marker := range genState.Markers {
+			if marker.Denom == denom {
+				found = true
+				s.Assert().True(marker.IssuerManagedFlags, "exported IssuerManagedFlags")
+			}
+		}
+		s.Assert().True(found, "exported marker %s found", denom)
+	})
+}
+
+func (s *MsgServerTestSuite) TestAdminHandshake() {
+	denom := "handshakecoin"
+	authority := s.app.MarkerKeeper.GetAuthority()
+	owner3Addr := testUserAddress("owner3")
+	owner3 := owner3Addr.String()
+	owner4Addr := testUserAddress("owner4")
+	owner4 := owner4Addr.String()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		true,       // Supply fixed
+		true,       // Allow gov
+		false,      // don't allow forced transfer
+		[]string{}, // No required attributes.
+		[]types.AccessGrant{
+			{Address: s.owner1, Permissions: []types.Access{types.Access_Admin, types.Access_Transfer}},
+			{Address: s.owner2, Permissions: []types.Access{types.Access_Mint}},
+		},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	testcases := []struct {
+		name          string
+		msg           sdk.Msg
+		expectedEvent proto.Message
+		errorMsg      string
+	}{
+		{
+			name:     "propose by non-admin",
+			msg:      types.NewMsgProposeAdminRequest(denom, owner3Addr, s.owner2Addr),
+			errorMsg: s.noAccessErr(s.owner2, types.Access_Admin, denom),
+		},
+		{
+			name:     "propose for existing admin",
+			msg:      &types.MsgProposeAdminRequest{Denom: denom, Grantee: s.owner1, Signer: authority},
+			errorMsg: s.owner1 + " already has ACCESS_ADMIN on " + denom + " marker",
+		},
+		{
+			name:     "accept without a proposal",
+			msg:      types.NewMsgAcceptAdminRequest(denom, owner3Addr),
+			errorMsg: "marker " + denom + " does not have a pending admin grant for " + owner3 + ": invalid request",
+		},
+		{
+			name:          "propose by admin",
+			msg:           types.NewMsgProposeAdminRequest(denom, owner3Addr, s.owner1Addr),
+			expectedEvent: &types.EventMarkerAdminProposed{Denom: denom, Grantee: owner3, Administrator: s.owner1},
+		},
+		{
+			name:          "propose via gov prop",
+			msg:           &types.MsgProposeAdminRequest{Denom: denom, Grantee: owner4, Signer: authority},
+			expectedEvent: &types.EventMarkerAdminProposed{Denom: denom, Grantee: owner4, Administrator: authority},
+		},
+		{
+			name:     "cancel by non-admin",
+			msg:      types.NewMsgCancelAdminProposalRequest(denom, owner4Addr, s.owner2Addr),
+			errorMsg: s.noAccessErr(s.owner2, types.Access_Admin, denom),
+		},
+		{
+			name:          "cancel by admin",
+			msg:           types.NewMsgCancelAdminProposalRequest(denom, owner4Addr, s.owner1Addr),
+			expectedEvent: &types.EventMarkerAdminProposalCanceled{Denom: denom, Grantee: owner4, Administrator: s.owner1},
+		},
+		{
+			name:     "cancel again",
+			msg:      types.NewMsgCancelAdminProposalRequest(denom, owner4Addr, s.owner1Addr),
+			errorMsg: "marker " + denom + " does not have a pending admin grant for " + owner4 + ": invalid request",
+		},
+		{
+			name:     "accept canceled proposal",
+			msg:      types.NewMsgAcceptAdminRequest(denom, owner4Addr),
+			errorMsg: "marker " + denom + " does not have a pending admin grant for " + owner4 + ": invalid request",
+		},
+		{
+			name:          "accept by grantee",
+			msg:           types.NewMsgAcceptAdminRequest(denom, owner3Addr),
+			expectedEvent: &types.EventMarkerAdminAccepted{Denom: denom, Grantee: owner3, Administrator: s.owner1},
+		},
+		{
+			name:          "propose by new admin",
+			msg:           types.NewMsgProposeAdminRequest(denom, owner4Addr, owner3Addr),
+			expectedEvent: &types.EventMarkerAdminProposed{Denom: denom, Grantee: owner4, Administrator: owner3},
+		},
+		{
+			name:          "remove proposer's admin access",
+			msg:           types.NewDeleteAccessRequest(denom, s.owner1Addr, owner3Addr),
+			expectedEvent: &types.EventMarkerDeleteAccess{RemoveAddress: owner3, Denom: denom, Administrator: s.owner1},
+		},
+		{
+			name: "accept after proposer lost admin access",
+			msg:  types.NewMsgAcceptAdminRequest(denom, owner4Addr),
+			errorMsg: "admin grant proposed by " + owner3 + " is no longer valid: " +
+				s.noAccessErr(owner3, types.Access_Admin, denom) + ": invalid request",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgProposeAdminRequest:
+				_, err = s.msgServer.ProposeAdmin(s.ctx, msg)
+			case *types.MsgAcceptAdminRequest:
+				_, err = s.msgServer.AcceptAdmin(s.ctx, msg)
+			case *types.MsgCancelAdminProposalRequest:
+				_, err = s.msgServer.CancelAdminProposal(s.ctx, msg)
+			case *types.MsgDeleteAccessRequest:
+				_, err = s.msgServer.DeleteAccess(s.ctx, msg)
+			default:
+				s.FailNow("unexpected msg type", "%T", tc.msg)
+			}
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "handler(%T) error", tc.msg)
+				return
+			}
+			s.Require().NoError(err, "handler(%T) error", tc.msg)
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", tc.expectedEvent)
+		})
+	}
+
+	s.Run("query pending admin grants", func() {
+		resp, err := s.app.MarkerKeeper.PendingAdminGrants(s.ctx, &types.QueryPendingAdminGrantsRequest{Id: denom})
+		s.Require().NoError(err, "PendingAdminGrants error")
+		expected := []types.PendingAdminGrant{{Denom: denom, Grantee: owner4, Administrator: owner3}}
+		s.Assert().Equal(expected, resp.Grants, "PendingAdminGrants grants")
+		s.Require().NotNil(resp.Pagination, "PendingAdminGrants pagination")
+		s.Assert().Equal(1, int(resp.Pagination.Total), "PendingAdminGrants pagination total")
+
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Equal(expected, genState.PendingAdminGrants, "exported PendingAdminGrants")
+	})
+}
+
+func (s *MsgServerTestSuite) TestUpdateReqAttrBypassAddrs() {
+	denom := "bypasscoin"
+	authority := s.app.MarkerKeeper.GetAuthority()
+	moduleAddr := s.app.MarkerKeeper.GetReqAttrBypassAddrs()[0].String()
+	markerAddr := types.MustGetMarkerAddress(denom).String()
+	partner1 := testUserAddress("partner1").String()
+	partner2 := testUserAddress("partner2").String()
+
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		denom, sdkmath.NewInt(100),
+		s.owner1Addr, s.owner1Addr, // From and Manager.
+		types.MarkerType_RestrictedCoin,
+		true,                          // Supply fixed
+		true,                          // Allow gov
+		false,                         // don't allow forced transfer
+		[]string{"kyc.provenance.io"}, // Required attributes.
+		[]types.AccessGrant{{Address: s.owner1, Permissions: []types.Access{types.Access_Admin}}},
+		0,
+		0,
+	)
+	_, err := s.msgServer.AddFinalizeActivateMarker(s.ctx, msg)
+	s.Require().NoError(err, "AddFinalizeActivateMarker(%q)", denom)
+
+	testcases := []struct {
+		name           string
+		msg            *types.MsgUpdateReqAttrBypassAddrsRequest
+		expectedEvents []proto.Message
+		errorMsg       string
+	}{
+		{
+			name:     "not the authority",
+			msg:      types.NewMsgUpdateReqAttrBypassAddrsRequest([]string{partner1}, nil, s.owner1),
+			errorMsg: "expected \"" + authority + "\" got \"" + s.owner1 + "\": expected gov account as only signer for proposal message",
+		},
+		{
+			name:     "add a module account",
+			msg:      types.NewMsgUpdateReqAttrBypassAddrsRequest([]string{moduleAddr}, nil, authority),
+			errorMsg: moduleAddr + " is a module account that already bypasses the required attributes checking: invalid request",
+		},
+		{
+			name:     "add a marker account",
+			msg:      types.NewMsgUpdateReqAttrBypassAddrsRequest([]string{markerAddr}, nil, authority),
+			errorMsg: markerAddr + " is a marker account and cannot bypass the required attributes checking: invalid request",
+		},
+		{
+			name:     "remove an address not in the list",
+			msg:      types.NewMsgUpdateReqAttrBypassAddrsRequest(nil, []string{partner1}, authority),
+			errorMsg: partner1 + " is not in the required attributes bypass list: invalid request",
+		},
+		{
+			name:     "remove a module account",
+			msg:      types.NewMsgUpdateReqAttrBypassAddrsRequest(nil, []string{moduleAddr}, authority),
+			errorMsg: moduleAddr + " is a module account that cannot be removed from the required attributes bypass list: invalid request",
+		},
+		{
+			name: "add two addresses",
+			msg:  types.NewMsgUpdateReqAttrBypassAddrsRequest([]string{partner1, partner2}, nil, authority),
+			expectedEvents: []proto.Message{
+				&types.EventReqAttrBypassAddrAdded{Address: partner1},
+				&types.EventReqAttrBypassAddrAdded{Address: partner2},
+			},
+		},
+		{
+			name:     "add an address already in the list",
+			msg:      types.NewMsgUpdateReqAttrBypassAddrsRequest([]string{partner2}, nil, authority),
+			errorMsg: partner2 + " is already in the required attributes bypass list: invalid request",
+		},
+		{
+			name:           "remove one",
+			msg:            types.NewMsgUpdateReqAttrBypassAddrsRequest(nil, []string{partner1}, authority),
+			expectedEvents: []proto.Message{&types.EventReqAttrBypassAddrRemoved{Address: partner1}},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.UpdateReqAttrBypassAddrs(s.ctx, tc.msg)
+			if len(tc.errorMsg) > 0 {
+				s.Require().EqualError(err, tc.errorMsg, "UpdateReqAttrBypassAddrs error")
+				return
+			}
+			s.Require().NoError(err, "UpdateReqAttrBypassAddrs error")
+			for _, expected := range tc.expectedEvents {
+				result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), expected)
+				s.Assert().True(result, "Expected typed event was not found.\n    Expected: %+v", expected)
+			}
+		})
+	}
+
+	s.Run("bypass checks", func() {
+		s.Assert().True(s.app.MarkerKeeper.CanBypassReqAttrs(s.ctx, sdk.MustAccAddressFromBech32(moduleAddr)), "CanBypassReqAttrs(module account)")
+		s.Assert().True(s.app.MarkerKeeper.CanBypassReqAttrs(s.ctx, sdk.MustAccAddressFromBech32(partner2)), "CanBypassReqAttrs(partner2)")
+		s.Assert().False(s.app.MarkerKeeper.CanBypassReqAttrs(s.ctx, sdk.MustAccAddressFromBech32(partner1)), "CanBypassReqAttrs(partner1)")
+		s.Assert().False(s.app.MarkerKeeper.IsReqAttrBypassAddr(sdk.MustAccAddressFromBech32(partner2)), "IsReqAttrBypassAddr(partner2)")
+	})
+
+	s.Run("send restriction", func() {
+		amt := sdk.NewCoins(sdk.NewInt64Coin(denom, 5))
+		_, err := s.app.MarkerKeeper.SendRestrictionFn(s.ctx, s.owner2Addr, sdk.MustAccAddressFromBech32(partner2), amt)
+		s.Assert().NoError(err, "SendRestrictionFn to partner2 (added to the bypass list)")
+		_, err = s.app.MarkerKeeper.SendRestrictionFn(s.ctx, s.owner2Addr, sdk.MustAccAddressFromBech32(partner1), amt)
+		s.Assert().ErrorContains(err, "does not contain the \""+denom+"\" required attribute", "SendRestrictionFn to partner1 (removed from the bypass list)")
+	})
+
+	s.Run("query and export", func() {
+		resp, err := s.app.MarkerKeeper.ReqAttrBypassAddrs(s.ctx, &types.QueryReqAttrBypassAddrsRequest{})
+		s.Require().NoError(err, "ReqAttrBypassAddrs error")
+		s.Assert().Contains(resp.ModuleAddresses, moduleAddr, "ReqAttrBypassAddrs module addresses")
+		s.Assert().Equal([]string{partner2}, resp.Addresses, "ReqAttrBypassAddrs addresses")
+		s.Require().NotNil(resp.Pagination, "ReqAttrBypassAddrs pagination")
+		s.Assert().Equal(1, int(resp.Pagination.Total), "ReqAttrBypassAddrs pagination total")
+
+		genState := s.app.MarkerKeeper.ExportGenesis(s.ctx)
+		s.Assert().Equal([]string{partner2}, genState.ReqAttrBypassAddrs, "exported ReqAttrBypassAddrs")
+	})
+}
@@ -8,6 +8,8 @@ import (
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/app"
@@ -37,6 +39,9 @@ func (s *ParamTestSuite) TestGetSetParams() {
 	s.Require().Equal(types.DefaultEnableGovernance, defaultParams.EnableGovernance, "Default EnableGovernance should match")
 	s.Require().Equal(types.DefaultUnrestrictedDenomRegex, defaultParams.UnrestrictedDenomRegex, "Default UnrestrictedDenomRegex should match")
 	s.Require().Equal(types.StringToBigInt(types.DefaultMaxSupply), defaultParams.MaxSupply, "Default MaxSupply should match")
+	s.Require().Equal(sdkmath.NewInt(types.DefaultCreationDeposit), defaultParams.CreationDeposit, "Default CreationDeposit should match")
+	s.Require().Equal(int64(types.DefaultCreationDepositTimeoutBlocks), defaultParams.CreationDepositTimeoutBlocks, "Default CreationDepositTimeoutBlocks should match")
+	s.Require().Equal(types.DefaultRoleTemplates(), defaultParams.RoleTemplates, "Default RoleTemplates should match")
 
 	newEnableGovernance := false
 	newUnrestrictedDenomRegex := "xyz.*"
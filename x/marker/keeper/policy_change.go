@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetLastPolicyChangeID returns the id of the last scheduled policy change.
+func (k Keeper) GetLastPolicyChangeID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastPolicyChangeIDKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastPolicyChangeID records the id of the last scheduled policy change.
+func (k Keeper) setLastPolicyChangeID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastPolicyChangeIDKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// nextPolicyChangeID increments the last policy change id and returns the new value.
+func (k Keeper) nextPolicyChangeID(ctx sdk.Context) uint64 {
+	id := k.GetLastPolicyChangeID(ctx) + 1
+	k.setLastPolicyChangeID(ctx, id)
+	return id
+}
+
+// SetScheduledPolicyChange records a change to a marker's required attributes that takes effect at a later height.
+// Any existing scheduled change for the same marker and id is replaced.
+func (k Keeper) SetScheduledPolicyChange(ctx sdk.Context, change types.ScheduledPolicyChange) error {
+	if err := change.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(change.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+	k.removeScheduledPolicyChange(ctx, markerAddr, change.Id)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduledPolicyChangeKey(markerAddr, change.Id), bz)
+	store.Set(types.PolicyChangeEffectiveHeightKey(change.EffectiveHeight, markerAddr, change.Id), []byte{})
+	return nil
+}
+
+// GetScheduledPolicyChange returns the scheduled policy change with the provided id on a marker, and whether it was found.
+func (k Keeper) GetScheduledPolicyChange(ctx sdk.Context, markerAddr sdk.AccAddress, id uint64) (types.ScheduledPolicyChange, bool) {
+	var change types.ScheduledPolicyChange
+	bz := ctx.KVStore(k.storeKey).Get(types.ScheduledPolicyChangeKey(markerAddr, id))
+	if len(bz) == 0 {
+		return change, false
+	}
+	if err := k.cdc.Unmarshal(bz, &change); err != nil {
+		return change, false
+	}
+	return change, true
+}
+
+// GetScheduledPolicyChanges returns all of the scheduled policy changes on the marker with the provided address, ordered by id.
+func (k Keeper) GetScheduledPolicyChanges(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.ScheduledPolicyChange, error) {
+	var rv []types.ScheduledPolicyChange
+	err := k.iterateScheduledPolicyChanges(ctx, types.ScheduledPolicyChangeKeyPrefix(markerAddr), func(change types.ScheduledPolicyChange) bool {
+		rv = append(rv, change)
+		return false
+	})
+	return rv, err
+}
+
+// IterateScheduledPolicyChanges iterates over all scheduled policy changes on all markers.
+func (k Keeper) IterateScheduledPolicyChanges(ctx sdk.Context, cb func(change types.ScheduledPolicyChange) (stop bool)) error {
+	return k.iterateScheduledPolicyChanges(ctx, types.ScheduledPolicyChangePrefix, cb)
+}
+
+// iterateScheduledPolicyChanges iterates over the scheduled policy changes with keys that have the provided prefix.
+func (k Keeper) iterateScheduledPolicyChanges(ctx sdk.Context, prefix []byte, cb func(change types.ScheduledPolicyChange) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.ScheduledPolicyChange
+		if err := k.cdc.Unmarshal(it.Value(), &change); err != nil {
+			return err
+		}
+		if cb(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveScheduledPolicyChange removes the scheduled policy change with the provided id on a marker.
+// The removed change is returned. An error is returned if there wasn't one.
+func (k Keeper) RemoveScheduledPolicyChange(ctx sdk.Context, denom string, id uint64) (types.ScheduledPolicyChange, error) {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return types.ScheduledPolicyChange{}, err
+	}
+	change, found := k.GetScheduledPolicyChange(ctx, markerAddr, id)
+	if !found {
+		return change, fmt.Errorf("marker %s does not have a scheduled policy change with id %d", denom, id)
+	}
+	k.removeScheduledPolicyChange(ctx, markerAddr, id)
+	return change, nil
+}
+
+// removeScheduledPolicyChange removes the scheduled policy change with the provided id on a marker,
+// along with its effective height index entry.
+func (k Keeper) removeScheduledPolicyChange(ctx sdk.Context, markerAddr sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if change, found := k.GetScheduledPolicyChange(ctx, markerAddr, id); found {
+		store.Delete(types.PolicyChangeEffectiveHeightKey(change.EffectiveHeight, markerAddr, id))
+	}
+	store.Delete(types.ScheduledPolicyChangeKey(markerAddr, id))
+}
+
+// RemoveScheduledPolicyChanges removes all of the scheduled policy changes on the marker with the provided address.
+func (k Keeper) RemoveScheduledPolicyChanges(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	pre := types.ScheduledPolicyChangeKeyPrefix(markerAddr)
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	var ids []uint64
+	for ; it.Valid(); it.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(it.Key()[len(pre):]))
+	}
+	it.Close()
+
+	for _, id := range ids {
+		k.removeScheduledPolicyChange(ctx, markerAddr, id)
+	}
+}
+
+// ValidatePolicyChanger returns an error if the provided address cannot change the required attributes of the marker.
+// Governance can if the marker allows governance control. Otherwise, the address must have transfer access on the marker.
+func (k Keeper) ValidatePolicyChanger(marker types.MarkerAccountI, addr string) error {
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker %s is not a restricted marker", marker.GetDenom())
+	}
+	if addr == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return fmt.Errorf("%s marker does not allow governance control", marker.GetDenom())
+		}
+		return nil
+	}
+	if !marker.HasAccess(addr, types.Access_Transfer) {
+		return fmt.Errorf("caller does not have authority to update required attributes %s", addr)
+	}
+	return nil
+}
+
+// SchedulePolicyChange normalizes and records a change to a marker's required attributes, assigning it the next id.
+// The effective height must be after the current block height. The recorded change is returned.
+func (k Keeper) SchedulePolicyChange(ctx sdk.Context, change types.ScheduledPolicyChange) (types.ScheduledPolicyChange, error) {
+	if change.EffectiveHeight <= ctx.BlockHeight() {
+		return change, fmt.Errorf("effective height %d must be after the current height %d", change.EffectiveHeight, ctx.BlockHeight())
+	}
+
+	var err error
+	change.RemoveRequiredAttributes, err = k.NormalizeRequiredAttributes(ctx, change.RemoveRequiredAttributes)
+	if err != nil {
+		return change, err
+	}
+	change.AddRequiredAttributes, err = k.NormalizeRequiredAttributes(ctx, change.AddRequiredAttributes)
+	if err != nil {
+		return change, err
+	}
+
+	change.Id = k.nextPolicyChangeID(ctx)
+	if err = k.SetScheduledPolicyChange(ctx, change); err != nil {
+		return change, err
+	}
+	return change, nil
+}
+
+// ApplyDuePolicyChanges applies and removes every scheduled policy change with an effective height at or before the
+// current block height. Only the changes that are due are looked at, and each one is applied on its own.
+// A change that can no longer be applied is still removed, the error is logged, and an event is emitted with the reason.
+func (k Keeper) ApplyDuePolicyChanges(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+
+	var dueKeys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.PolicyChangeEffectiveHeightPrefix)
+	for ; it.Valid(); it.Next() {
+		effHeight, _, _, err := types.ParsePolicyChangeEffectiveHeightKey(it.Key())
+		if err == nil && effHeight > height {
+			break
+		}
+		dueKeys = append(dueKeys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range dueKeys {
+		_, markerAddr, id, err := types.ParsePolicyChangeEffectiveHeightKey(key)
+		if err != nil {
+			store.Delete(key)
+			continue
+		}
+		change, found := k.GetScheduledPolicyChange(ctx, markerAddr, id)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		k.removeScheduledPolicyChange(ctx, markerAddr, id)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = k.applyPolicyChange(cacheCtx, change)
+		if err == nil {
+			err = cacheCtx.EventManager().EmitTypedEvent(&types.EventMarkerPolicyChangeApplied{
+				Id:    change.Id,
+				Denom: change.Denom,
+			})
+		}
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("could not apply scheduled policy change %d to %s marker", change.Id, change.Denom), "error", err)
+			err = ctx.EventManager().EmitTypedEvent(&types.EventMarkerPolicyChangeFailed{
+				Id:     change.Id,
+				Denom:  change.Denom,
+				Reason: err.Error(),
+			})
+			if err != nil {
+				k.Logger(ctx).Error("could not emit policy change failed event", "id", change.Id, "denom", change.Denom, "error", err)
+			}
+			continue
+		}
+		writeCache()
+	}
+}
+
+// applyPolicyChange updates the required attributes of a marker as described by a scheduled policy change.
+func (k Keeper) applyPolicyChange(ctx sdk.Context, change types.ScheduledPolicyChange) error {
+	marker, err := k.GetMarkerByDenom(ctx, change.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", change.Denom, err)
+	}
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker %s is not a restricted marker", change.Denom)
+	}
+
+	reqAttrs, err := types.RemoveFromRequiredAttributes(marker.GetRequiredAttributes(), change.RemoveRequiredAttributes)
+	if err != nil {
+		return err
+	}
+	reqAttrs, err = types.AddToRequiredAttributes(reqAttrs, change.AddRequiredAttributes)
+	if err != nil {
+		return err
+	}
+
+	marker.SetRequiredAttributes(reqAttrs)
+	k.SetMarker(ctx, marker)
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestScheduledPolicyChanges(t *testing.T) {
+	const attrOne = "one.kyc.provenance.io"
+	const attrTwo = "two.kyc.provenance.io"
+	restrictedDenom := "restrictedcoin"
+	coinDenom := "plaincoin"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	for _, denom := range []string{restrictedDenom, coinDenom} {
+		markerType := types.MarkerType_RestrictedCoin
+		var reqAttrs []string
+		if denom == coinDenom {
+			markerType = types.MarkerType_Coin
+		} else {
+			reqAttrs = []string{attrOne}
+		}
+		_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+			Amount:      sdk.NewInt64Coin(denom, 1000),
+			Manager:     addrAdmin.String(),
+			FromAddress: addrAdmin.String(),
+			MarkerType:  markerType,
+			AccessList: []types.AccessGrant{
+				{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Transfer}},
+			},
+			SupplyFixed:        true,
+			RequiredAttributes: reqAttrs,
+		})
+		require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	}
+
+	getReqAttrs := func() []string {
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, restrictedDenom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		return marker.GetRequiredAttributes()
+	}
+	queryChanges := func() []types.ScheduledPolicyChange {
+		resp, err := app.MarkerKeeper.ScheduledPolicyChanges(ctx, &types.QueryScheduledPolicyChangesRequest{Id: restrictedDenom})
+		require.NoError(t, err, "ScheduledPolicyChanges")
+		return resp.Changes
+	}
+
+	t.Run("schedule requires transfer access", func(t *testing.T) {
+		msg := types.NewMsgSchedulePolicyChangeRequest(restrictedDenom, 20, addrOther, nil, []string{attrTwo})
+		_, err := msgServer.SchedulePolicyChange(ctx, msg)
+		expErr := fmt.Sprintf("caller does not have authority to update required attributes %s", addrOther)
+		assert.EqualError(t, err, expErr, "SchedulePolicyChange")
+	})
+
+	t.Run("schedule on unrestricted marker", func(t *testing.T) {
+		msg := types.NewMsgSchedulePolicyChangeRequest(coinDenom, 20, addrAdmin, nil, []string{attrTwo})
+		_, err := msgServer.SchedulePolicyChange(ctx, msg)
+		assert.EqualError(t, err, "marker plaincoin is not a restricted marker", "SchedulePolicyChange")
+	})
+
+	t.Run("schedule in the past", func(t *testing.T) {
+		msg := types.NewMsgSchedulePolicyChangeRequest(restrictedDenom, 10, addrAdmin, nil, []string{attrTwo})
+		_, err := msgServer.SchedulePolicyChange(ctx, msg)
+		assert.EqualError(t, err, "effective height 10 must be after the current height 10: invalid request", "SchedulePolicyChange")
+	})
+
+	resp, err := msgServer.SchedulePolicyChange(ctx, types.NewMsgSchedulePolicyChangeRequest(restrictedDenom, 20, addrAdmin, []string{attrOne}, []string{attrTwo}))
+	require.NoError(t, err, "SchedulePolicyChange 1")
+	assert.Equal(t, uint64(1), resp.Id, "SchedulePolicyChange 1 id")
+	resp, err = msgServer.SchedulePolicyChange(ctx, types.NewMsgSchedulePolicyChangeRequest(restrictedDenom, 30, addrAdmin, []string{attrTwo}, nil))
+	require.NoError(t, err, "SchedulePolicyChange 2")
+	assert.Equal(t, uint64(2), resp.Id, "SchedulePolicyChange 2 id")
+
+	expChanges := []types.ScheduledPolicyChange{
+		{Id: 1, Denom: restrictedDenom, EffectiveHeight: 20, AddRequiredAttributes: []string{attrTwo}, RemoveRequiredAttributes: []string{attrOne}, ScheduledBy: addrAdmin.String()},
+		{Id: 2, Denom: restrictedDenom, EffectiveHeight: 30, RemoveRequiredAttributes: []string{attrTwo}, ScheduledBy: addrAdmin.String()},
+	}
+	assert.Equal(t, expChanges, queryChanges(), "scheduled changes")
+
+	t.Run("nothing applied before effective height", func(t *testing.T) {
+		cacheCtx, _ := ctx.WithBlockHeight(19).CacheContext()
+		app.MarkerKeeper.ApplyDuePolicyChanges(cacheCtx)
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(cacheCtx, restrictedDenom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, []string{attrOne}, marker.GetRequiredAttributes(), "required attributes")
+	})
+
+	t.Run("cancel unknown change", func(t *testing.T) {
+		_, err := msgServer.CancelPolicyChange(ctx, types.NewMsgCancelPolicyChangeRequest(restrictedDenom, 5, addrAdmin))
+		assert.EqualError(t, err, "marker restrictedcoin does not have a scheduled policy change with id 5: invalid request", "CancelPolicyChange")
+	})
+
+	t.Run("export and import", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		genState := app.MarkerKeeper.ExportGenesis(cacheCtx)
+		assert.Equal(t, expChanges, genState.ScheduledPolicyChanges, "exported scheduled policy changes")
+		assert.Equal(t, uint64(2), genState.LastPolicyChangeId, "exported last policy change id")
+		require.NoError(t, genState.Validate(), "genesis Validate")
+	})
+
+	ctx = ctx.WithBlockHeight(20)
+	app.MarkerKeeper.ApplyDuePolicyChanges(ctx)
+	assert.Equal(t, []string{attrTwo}, getReqAttrs(), "required attributes at 20")
+	assert.Equal(t, expChanges[1:], queryChanges(), "scheduled changes at 20")
+
+	_, err = msgServer.CancelPolicyChange(ctx, types.NewMsgCancelPolicyChangeRequest(restrictedDenom, 2, addrAdmin))
+	require.NoError(t, err, "CancelPolicyChange 2")
+	assert.Empty(t, queryChanges(), "scheduled changes after cancel")
+
+	ctx = ctx.WithBlockHeight(30)
+	app.MarkerKeeper.ApplyDuePolicyChanges(ctx)
+	assert.Equal(t, []string{attrTwo}, getReqAttrs(), "required attributes at 30")
+
+	t.Run("change that can no longer be applied", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		resp, err := msgServer.SchedulePolicyChange(cacheCtx, types.NewMsgSchedulePolicyChangeRequest(restrictedDenom, 31, addrAdmin, []string{attrOne}, nil))
+		require.NoError(t, err, "SchedulePolicyChange")
+		cacheCtx = cacheCtx.WithBlockHeight(31).WithEventManager(sdk.NewEventManager())
+		app.MarkerKeeper.ApplyDuePolicyChanges(cacheCtx)
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(cacheCtx, restrictedDenom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, []string{attrTwo}, marker.GetRequiredAttributes(), "required attributes")
+		changes, err := app.MarkerKeeper.GetScheduledPolicyChanges(cacheCtx, marker.GetAddress())
+		require.NoError(t, err, "GetScheduledPolicyChanges")
+		assert.Empty(t, changes, "scheduled changes")
+		expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerPolicyChangeFailed{
+			Id:     resp.Id,
+			Denom:  restrictedDenom,
+			Reason: fmt.Sprintf("attribute %q is already not required", attrOne),
+		})
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Equal(t, sdk.Events{expEvent}, cacheCtx.EventManager().Events(), "events")
+	})
+
+	t.Run("remove marker", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.SchedulePolicyChange(cacheCtx, types.NewMsgSchedulePolicyChangeRequest(restrictedDenom, 40, addrAdmin, nil, []string{attrOne}))
+		require.NoError(t, err, "SchedulePolicyChange")
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(cacheCtx, restrictedDenom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		app.MarkerKeeper.RemoveMarker(cacheCtx, marker)
+		changes, err := app.MarkerKeeper.GetScheduledPolicyChanges(cacheCtx, marker.GetAddress())
+		require.NoError(t, err, "GetScheduledPolicyChanges")
+		assert.Empty(t, changes, "scheduled changes after removal")
+
+		// Applied, cancelled, and removed changes don't leave anything in the effective height index.
+		it := storetypes.KVStorePrefixIterator(cacheCtx.KVStore(app.GetKey(types.StoreKey)), types.PolicyChangeEffectiveHeightPrefix)
+		defer it.Close()
+		assert.False(t, it.Valid(), "policy change effective height index has entries")
+	})
+}
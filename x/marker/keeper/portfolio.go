@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetPortfolioValuation values the provided address's holdings of marker denoms using the markers' net asset values.
+// Net asset values last updated more than maxNavAge blocks ago are flagged as stale (none are if maxNavAge is zero).
+// The totals are ordered by price denom, and the assets by denom.
+func (k Keeper) GetPortfolioValuation(ctx sdk.Context, addr sdk.AccAddress, maxNavAge uint64) ([]types.PortfolioTotal, []types.PortfolioAsset, error) {
+	var assets []types.PortfolioAsset
+	totals := make(map[string]*types.PortfolioTotal)
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, addr) {
+		markerAddr, err := types.MarkerAddress(balance.Denom)
+		if err != nil || !k.IsMarkerAccount(ctx, markerAddr) {
+			continue
+		}
+
+		asset := types.PortfolioAsset{Balance: balance}
+		err = k.IterateNetAssetValues(ctx, markerAddr, func(nav types.NetAssetValue) (stop bool) {
+			value := types.PortfolioAssetValue{
+				Value:         nav.ValueOf(balance.Amount),
+				NetAssetValue: nav,
+				Stale:         nav.IsStale(ctx.BlockHeight(), maxNavAge),
+			}
+			asset.Values = append(asset.Values, value)
+
+			total, ok := totals[value.Value.Denom]
+			if !ok {
+				total = &types.PortfolioTotal{Value: sdk.NewCoin(value.Value.Denom, sdkmath.ZeroInt())}
+				totals[value.Value.Denom] = total
+			}
+			total.Value = total.Value.Add(value.Value)
+			total.IncludesStale = total.IncludesStale || value.Stale
+			return false
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read net asset values of %q: %w", balance.Denom, err)
+		}
+		assets = append(assets, asset)
+	}
+
+	rv := make([]types.PortfolioTotal, 0, len(totals))
+	for _, total := range totals {
+		rv = append(rv, *total)
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].Value.Denom < rv[j].Value.Denom
+	})
+	return rv, assets, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestPortfolioValuation(t *testing.T) {
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrHolder := sdk.AccAddress("holder______________")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(100)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	queryServer := app.MarkerKeeper
+
+	for _, denom := range []string{"hotdog", "bun", "relish"} {
+		_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+			Amount:      sdk.NewInt64Coin(denom, 1000),
+			Manager:     addrAdmin.String(),
+			FromAddress: addrAdmin.String(),
+			MarkerType:  types.MarkerType_Coin,
+			AccessList: []types.AccessGrant{
+				{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+			},
+		})
+		require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	}
+
+	setNAV := func(denom, price string, volume uint64, height uint64) {
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom(%q)", denom)
+		priceCoin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "ParseCoinNormalized(%q)", price)
+		err = app.MarkerKeeper.SetNetAssetValueWithBlockHeight(ctx, marker, types.NewNetAssetValue(priceCoin, volume), "test", height)
+		require.NoError(t, err, "SetNetAssetValueWithBlockHeight(%q, %q)", denom, price)
+	}
+	// A hotdog is worth 2.5usd or 3fig, a bun is worth 0.5usd, and relish does not have a value.
+	setNAV("hotdog", "25usd", 10, 95)
+	setNAV("hotdog", "3fig", 1, 50)
+	setNAV("bun", "1usd", 2, 90)
+
+	holdings := sdk.NewCoins(sdk.NewInt64Coin("hotdog", 7), sdk.NewInt64Coin("bun", 9),
+		sdk.NewInt64Coin("relish", 4), sdk.NewInt64Coin("nothotdog", 12))
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addrHolder, holdings), "FundAccount")
+
+	t.Run("invalid address", func(t *testing.T) {
+		_, err := queryServer.PortfolioValuation(ctx, &types.QueryPortfolioValuationRequest{Address: "bad"})
+		assert.ErrorContains(t, err, "invalid address", "PortfolioValuation")
+	})
+
+	t.Run("without staleness", func(t *testing.T) {
+		resp, err := queryServer.PortfolioValuation(ctx, &types.QueryPortfolioValuationRequest{Address: addrHolder.String()})
+		require.NoError(t, err, "PortfolioValuation")
+		assert.Equal(t, int64(100), resp.Height, "Height")
+		assert.Equal(t, []types.PortfolioTotal{
+			{Value: sdk.NewInt64Coin("fig", 21)},
+			{Value: sdk.NewInt64Coin("usd", 21)},
+		}, resp.Totals, "Totals")
+
+		require.Len(t, resp.Assets, 3, "Assets")
+		assert.Equal(t, "9bun", resp.Assets[0].Balance.String(), "Assets[0].Balance")
+		require.Len(t, resp.Assets[0].Values, 1, "Assets[0].Values")
+		assert.Equal(t, "4usd", resp.Assets[0].Values[0].Value.String(), "Assets[0].Values[0].Value")
+		assert.Equal(t, "7hotdog", resp.Assets[1].Balance.String(), "Assets[1].Balance")
+		require.Len(t, resp.Assets[1].Values, 2, "Assets[1].Values")
+		assert.Equal(t, "21fig", resp.Assets[1].Values[0].Value.String(), "Assets[1].Values[0].Value")
+		assert.Equal(t, "17usd", resp.Assets[1].Values[1].Value.String(), "Assets[1].Values[1].Value")
+		assert.Equal(t, "4relish", resp.Assets[2].Balance.String(), "Assets[2].Balance")
+		assert.Empty(t, resp.Assets[2].Values, "Assets[2].Values")
+	})
+
+	t.Run("with staleness", func(t *testing.T) {
+		resp, err := queryServer.PortfolioValuation(ctx, &types.QueryPortfolioValuationRequest{
+			Address:   addrHolder.String(),
+			MaxNavAge: 8,
+		})
+		require.NoError(t, err, "PortfolioValuation")
+		assert.Equal(t, []types.PortfolioTotal{
+			{Value: sdk.NewInt64Coin("fig", 21), IncludesStale: true},
+			{Value: sdk.NewInt64Coin("usd", 21), IncludesStale: true},
+		}, resp.Totals, "Totals")
+		assert.True(t, resp.Assets[0].Values[0].Stale, "bun usd value stale")
+		assert.True(t, resp.Assets[1].Values[0].Stale, "hotdog fig value stale")
+		assert.False(t, resp.Assets[1].Values[1].Stale, "hotdog usd value stale")
+	})
+
+	t.Run("no holdings", func(t *testing.T) {
+		resp, err := queryServer.PortfolioValuation(ctx, &types.QueryPortfolioValuationRequest{Address: addrAdmin.String()})
+		require.NoError(t, err, "PortfolioValuation")
+		assert.Empty(t, resp.Totals, "Totals")
+		assert.Empty(t, resp.Assets, "Assets")
+	})
+}
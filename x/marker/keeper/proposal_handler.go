@@ -37,16 +37,20 @@ func (k Keeper) HandleSupplyIncreaseProposal(ctx sdk.Context, amount sdk.Coin, t
 		}
 		k.SetMarker(ctx, m)
 		logger.Info("marker configured supply increased", "marker", amount.Denom, "amount", amount.Amount.String())
-		return nil
+		return k.recordIssuanceTranche(ctx, m, amount.Amount, "", nil, k.GetAuthority())
 	} else if m.GetStatus() != types.StatusActive {
 		return fmt.Errorf("cannot mint coin for a marker that is not in Active status")
 	}
 
-	if err := k.IncreaseSupply(ctx, m, amount); err != nil {
+	// Governance is what a supply change policy defers to, so it's not limited by one.
+	if err := k.IncreaseSupply(types.WithSupplyChangePolicyBypass(ctx), m, amount); err != nil {
 		return err
 	}
 
 	logger.Info("marker total supply increased", "marker", amount.Denom, "amount", amount.Amount.String())
+	if err := k.recordIssuanceTranche(ctx, m, amount.Amount, "", nil, k.GetAuthority()); err != nil {
+		return err
+	}
 
 	// If a target address for minted coins is given then send them there.
 	if len(targetAddress) > 0 {
@@ -81,7 +85,8 @@ func (k Keeper) HandleSupplyDecreaseProposal(ctx sdk.Context, amount sdk.Coin) e
 		return fmt.Errorf("%s marker does not allow governance control", amount.Denom)
 	}
 
-	if err := k.DecreaseSupply(ctx, m, amount); err != nil {
+	// Governance is what a supply change policy defers to, so it's not limited by one.
+	if err := k.DecreaseSupply(types.WithSupplyChangePolicyBypass(ctx), m, amount); err != nil {
 		return err
 	}
 
@@ -247,6 +252,68 @@ func (k Keeper) HandleWithdrawEscrowProposal(ctx sdk.Context, denom, targetAddre
 	return nil
 }
 
+// HandleSeizeEscrowProposal handles a SeizeEscrow governance proposal request. Everything spendable in the marker's
+// account is sent to the recovery address, and all access grants (and pending admin grants) on the marker are revoked.
+// Funds that are on hold stay in the marker's account and are reported in an event.
+// The coins that were moved and the addresses that lost their access are returned.
+func (k Keeper) HandleSeizeEscrowProposal(ctx sdk.Context, denom, recoveryAddress string) (sdk.Coins, []string, error) {
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if m == nil {
+		return nil, nil, fmt.Errorf("%s marker does not exist", denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return nil, nil, fmt.Errorf("%s marker does not allow governance control", denom)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(recoveryAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	if recipient.Equals(addr) {
+		return nil, nil, fmt.Errorf("recovery address cannot be the %s marker's address", denom)
+	}
+
+	// Funds on hold (e.g. for an exchange order) belong to whatever placed the hold, so only spendable funds are seized.
+	escrow := k.bankKeeper.SpendableCoins(ctx, addr)
+	if !escrow.IsZero() {
+		if err = k.bankKeeper.SendCoins(types.WithBypass(ctx), addr, recipient, escrow); err != nil {
+			return nil, nil, fmt.Errorf("could not transfer escrow of %s marker: %w", denom, err)
+		}
+	}
+	if held := k.bankKeeper.GetAllBalances(ctx, addr); !held.IsZero() {
+		k.Logger(ctx).Info("marker escrow on hold was not seized", "marker", denom, "amount", held)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEscrowNotSeized,
+			sdk.NewAttribute(types.EventAttributeDenomKey, denom),
+			sdk.NewAttribute(types.EventAttributeAmountKey, held.String()),
+		))
+	}
+
+	var revoked []string
+	for _, grant := range m.GetAccessList() {
+		if err = m.RevokeAccess(grant.GetAddress()); err != nil {
+			return nil, nil, err
+		}
+		revoked = append(revoked, grant.Address)
+	}
+	if err = m.Validate(); err != nil {
+		return nil, nil, err
+	}
+	k.SetMarker(ctx, m)
+	k.RemovePendingAdminGrants(ctx, addr)
+
+	k.Logger(ctx).Info("seized marker escrow", "marker", denom, "amount", escrow, "recipient", recoveryAddress,
+		"revoked", revoked)
+
+	return escrow, revoked, nil
+}
+
 // HandleSetDenomMetadataProposal handles a Set Denom Metadata governance proposal request
 func (k Keeper) HandleSetDenomMetadataProposal(ctx sdk.Context, metadata banktypes.Metadata) error {
 	addr, err := types.MarkerAddress(metadata.Base)
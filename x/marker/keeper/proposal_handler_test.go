@@ -17,6 +17,7 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -462,6 +463,70 @@ func (s *KeeperTestSuite) TestWithdrawEscrowProposal() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSeizeEscrowProposal() {
+	hotdogMarker := s.createTestMarker("hotdog")
+	nonGovernanceMarker := s.createTestMarker("nonGovernanceMarker")
+	nonGovernanceMarker.AllowGovernanceControl = false
+	s.app.MarkerKeeper.SetMarker(s.ctx, nonGovernanceMarker)
+
+	activeMarker, err := s.app.MarkerKeeper.GetMarker(s.ctx, hotdogMarker.GetAddress())
+	s.Require().NoError(err, "GetMarker")
+	s.Require().NoError(activeMarker.GrantAccess(types.NewAccessGrant(s.user2Addr, types.AccessList{types.Access_Withdraw})), "GrantAccess")
+	s.app.MarkerKeeper.SetMarker(s.ctx, activeMarker)
+	s.Require().NoError(testutil.FundAccount(s.ctx, s.app.BankKeeper, hotdogMarker.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 70))), "FundAccount")
+	s.Require().NoError(s.app.HoldKeeper.AddHold(s.ctx, hotdogMarker.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), "test"), "AddHold")
+	s.Require().NoError(s.app.MarkerKeeper.SetPendingAdminGrant(s.ctx, types.PendingAdminGrant{
+		Denom: hotdogMarker.Denom, Grantee: s.user2, Administrator: s.user1,
+	}), "SetPendingAdminGrant")
+	recoveryAddr := sdk.AccAddress("recovery____________")
+
+	s.Run("marker does not exist", func() {
+		_, _, err := s.app.MarkerKeeper.HandleSeizeEscrowProposal(s.ctx, "invaliddenom", recoveryAddr.String())
+		s.Assert().EqualError(err, "invaliddenom marker does not exist", "HandleSeizeEscrowProposal")
+	})
+
+	s.Run("marker does not allow governance control", func() {
+		_, _, err := s.app.MarkerKeeper.HandleSeizeEscrowProposal(s.ctx, nonGovernanceMarker.Denom, recoveryAddr.String())
+		s.Assert().EqualError(err, "nonGovernanceMarker marker does not allow governance control", "HandleSeizeEscrowProposal")
+	})
+
+	s.Run("recovery address is the marker", func() {
+		_, _, err := s.app.MarkerKeeper.HandleSeizeEscrowProposal(s.ctx, hotdogMarker.Denom, hotdogMarker.GetAddress().String())
+		s.Assert().EqualError(err, "recovery address cannot be the hotdog marker's address", "HandleSeizeEscrowProposal")
+	})
+
+	s.Run("successful seizure", func() {
+		em := sdk.NewEventManager()
+		amount, revoked, err := s.app.MarkerKeeper.HandleSeizeEscrowProposal(s.ctx.WithEventManager(em), hotdogMarker.Denom, recoveryAddr.String())
+		s.Require().NoError(err, "HandleSeizeEscrowProposal")
+		s.Assert().Equal("1000hotdog,50stake", amount.String(), "seized amount")
+		s.Assert().Equal([]string{s.user1, s.user2}, revoked, "revoked addresses")
+
+		s.Assert().Equal("1000hotdog,50stake", s.app.BankKeeper.GetAllBalances(s.ctx, recoveryAddr).String(), "recovery address balance")
+		s.Assert().Equal("20stake", s.app.BankKeeper.GetAllBalances(s.ctx, hotdogMarker.GetAddress()).String(), "marker balance")
+		expEvent := sdk.NewEvent(types.EventTypeEscrowNotSeized,
+			sdk.NewAttribute(types.EventAttributeDenomKey, hotdogMarker.Denom),
+			sdk.NewAttribute(types.EventAttributeAmountKey, "20stake"),
+		)
+		s.Assert().Contains(em.Events(), expEvent, "emitted events")
+
+		marker, err := s.app.MarkerKeeper.GetMarker(s.ctx, hotdogMarker.GetAddress())
+		s.Require().NoError(err, "GetMarker")
+		s.Assert().Empty(marker.GetAccessList(), "marker access list")
+		s.Assert().Equal(types.StatusActive, marker.GetStatus(), "marker status")
+		grants, err := s.app.MarkerKeeper.GetPendingAdminGrants(s.ctx, hotdogMarker.GetAddress())
+		s.Require().NoError(err, "GetPendingAdminGrants")
+		s.Assert().Empty(grants, "pending admin grants")
+	})
+
+	s.Run("nothing left to seize", func() {
+		amount, revoked, err := s.app.MarkerKeeper.HandleSeizeEscrowProposal(s.ctx, hotdogMarker.Denom, recoveryAddr.String())
+		s.Require().NoError(err, "HandleSeizeEscrowProposal")
+		s.Assert().True(amount.IsZero(), "seized amount")
+		s.Assert().Empty(revoked, "revoked addresses")
+	})
+}
+
 func (s *KeeperTestSuite) TestSetDenomMetadataProposal() {
 	hotdogMarker := s.createTestMarker("hotdog")
 	nonGovernanceMarker := s.createTestMarker("nonGovernanceMarker")
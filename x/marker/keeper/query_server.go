@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -192,15 +194,89 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 	}
 
 	var navs []types.NetAssetValue
-	err = k.IterateNetAssetValues(ctx, marker.GetAddress(), func(nav types.NetAssetValue) (stop bool) {
+	navStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.NetAssetValueKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(navStore, req.Pagination, func(_ []byte, value []byte) error {
+		var nav types.NetAssetValue
+		if err := k.cdc.Unmarshal(value, &nav); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 		navs = append(navs, nav)
-		return false
+		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
+	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs, Pagination: pageRes}, nil
+}
+
+// MarkerHierarchy query for returning the parent and child markers of a marker
+func (k Keeper) MarkerHierarchy(c context.Context, req *types.QueryMarkerHierarchyRequest) (*types.QueryMarkerHierarchyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if marker == nil {
+		return nil, types.ErrMarkerNotFound.Wrap("invalid denom or address")
+	}
+
+	resp := &types.QueryMarkerHierarchyResponse{Parents: k.GetMarkerParents(ctx, marker)}
+
+	// The pagination applies to the children since finding them requires looking at every marker.
+	addr := marker.GetAddress()
+	markerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerStoreKeyPrefix)
+	resp.Pagination, err = query.FilteredPaginate(markerStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		childAddr := sdk.AccAddress(value)
+		if childAddr.Equals(addr) {
+			return false, nil
+		}
+		grant, found := k.GetAccessGrant(ctx, childAddr, addr)
+		if !found || len(grant.Permissions) == 0 {
+			return false, nil
+		}
+		if accumulate {
+			child, err := k.GetMarker(ctx, childAddr)
+			if err != nil {
+				return false, err
+			}
+			resp.Children = append(resp.Children, types.MarkerHierarchyLink{Denom: child.GetDenom(), Permissions: grant.GetAccessList()})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ValidateProposal query for checking the msgs of a draft governance proposal against the current state.
+func (k Keeper) ValidateProposal(c context.Context, req *types.QueryValidateProposalRequest) (*types.QueryValidateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	resp := &types.QueryValidateProposalResponse{}
+	msgs, err := req.GetMessages()
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	// The SDK should already be using a cache context for queries, but one is used here too to be on the safe side.
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	if err = k.ValidateProposalMsgs(ctx, msgs); err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+
+	resp.GovPropWillPass = true
+	return resp, nil
 }
 
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
@@ -220,3 +296,672 @@ func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (ty
 	}
 	return account, nil
 }
+
+// ERC20Pointers query for the ERC-20 contracts that represent a marker on external chains
+func (k Keeper) ERC20Pointers(c context.Context, req *types.QueryERC20PointersRequest) (*types.QueryERC20PointersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pointers []types.ERC20Pointer
+	pointerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ERC20PointerKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(pointerStore, req.Pagination, func(_ []byte, value []byte) error {
+		var pointer types.ERC20Pointer
+		if err := k.cdc.Unmarshal(value, &pointer); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		pointers = append(pointers, pointer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryERC20PointersResponse{Pointers: pointers, Pagination: pageRes}, nil
+}
+
+// ERC20PointerByContract query for the marker pointer of an ERC-20 contract on an external chain
+func (k Keeper) ERC20PointerByContract(c context.Context, req *types.QueryERC20PointerByContractRequest) (*types.QueryERC20PointerByContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateERC20ChainID(req.ChainId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateERC20ContractAddress(req.ContractAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pointer, found := k.GetERC20PointerByContract(ctx, req.ChainId, req.ContractAddress)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no marker found for contract %s on %s", req.ContractAddress, req.ChainId)
+	}
+
+	return &types.QueryERC20PointerByContractResponse{Pointer: pointer}, nil
+}
+
+// Bridge query for a marker bridge
+func (k Keeper) Bridge(c context.Context, req *types.QueryBridgeRequest) (*types.QueryBridgeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateBridgeName(req.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bridge, found := k.GetBridge(ctx, req.Name)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "bridge %s not found", req.Name)
+	}
+
+	return &types.QueryBridgeResponse{Bridge: bridge}, nil
+}
+
+// BridgeNonce query for whether a marker bridge nonce has been used
+func (k Keeper) BridgeNonce(c context.Context, req *types.QueryBridgeNonceRequest) (*types.QueryBridgeNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateBridgeName(req.Name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBridgeNonceResponse{Used: k.IsBridgeNonceUsed(ctx, req.Name, req.Nonce)}, nil
+}
+
+// DenyListMarkers query for the markers that have an address in their send deny list
+func (k Keeper) DenyListMarkers(c context.Context, req *types.QueryDenyListMarkersRequest) (*types.QueryDenyListMarkersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	denyAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denoms := make([]string, 0)
+	denyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenySendAddressKeyPrefix(denyAddr))
+	pageRes, err := query.Paginate(denyStore, req.Pagination, func(key []byte, _ []byte) error {
+		// The key is the length-prefixed marker address.
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(key[1:]))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if marker != nil {
+			denoms = append(denoms, marker.GetDenom())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenyListMarkersResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// PendingAdminGrants query for the proposed grants of admin access on a marker that have not been accepted yet
+func (k Keeper) PendingAdminGrants(c context.Context, req *types.QueryPendingAdminGrantsRequest) (*types.QueryPendingAdminGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []types.PendingAdminGrant
+	grantStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAdminGrantKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(grantStore, req.Pagination, func(_ []byte, value []byte) error {
+		var grant types.PendingAdminGrant
+		if err := k.cdc.Unmarshal(value, &grant); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		grants = append(grants, grant)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingAdminGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+// ScheduledPolicyChanges query for the changes to a marker's required attributes that have not taken effect yet
+func (k Keeper) ScheduledPolicyChanges(c context.Context, req *types.QueryScheduledPolicyChangesRequest) (*types.QueryScheduledPolicyChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []types.ScheduledPolicyChange
+	changeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledPolicyChangeKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(changeStore, req.Pagination, func(_ []byte, value []byte) error {
+		var change types.ScheduledPolicyChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduledPolicyChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// ReserveStatus returns a marker's reserve requirement along with how well its supply is currently backed.
+func (k Keeper) ReserveStatus(c context.Context, req *types.QueryReserveStatusRequest) (*types.QueryReserveStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	requirement, err := k.GetReserveRequirement(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryReserveStatusResponse{
+		Requirement:      requirement,
+		Supply:           sdk.NewCoin(marker.GetDenom(), k.CurrentCirculation(ctx, marker)),
+		RequiredReserves: sdkmath.ZeroInt(),
+		Mintable:         sdkmath.ZeroInt(),
+	}
+	if requirement == nil {
+		return resp, nil
+	}
+
+	resp.Reserves = k.GetReserves(ctx, marker, *requirement)
+	resp.RequiredReserves = requirement.RequiredReserves(resp.Supply.Amount)
+	maxSupply := requirement.MaxBackedSupply(types.TotalAmount(resp.Reserves))
+	if maxSupply.GT(resp.Supply.Amount) {
+		resp.Mintable = maxSupply.Sub(resp.Supply.Amount)
+	}
+	return resp, nil
+}
+
+// DustPolicy returns what a marker does with the dust left over from pro-rata operations.
+func (k Keeper) DustPolicy(c context.Context, req *types.QueryDustPolicyRequest) (*types.QueryDustPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDustPolicyResponse{
+		Denom:  marker.GetDenom(),
+		Policy: k.GetDustPolicy(ctx, marker.GetAddress()),
+	}, nil
+}
+
+// ReserveAttestations returns a marker's approved reserve attestors and their latest attestations.
+func (k Keeper) ReserveAttestations(c context.Context, req *types.QueryReserveAttestationsRequest) (*types.QueryReserveAttestationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryReserveAttestationsResponse{}
+	attestors, err := k.GetReserveAttestors(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if attestors != nil {
+		resp.Attestors = attestors.Attestors
+	}
+
+	attestationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReserveAttestationKeyPrefix(marker.GetAddress()))
+	resp.Pagination, err = query.Paginate(attestationStore, req.Pagination, func(_ []byte, value []byte) error {
+		var attestation types.ReserveAttestation
+		if err := k.cdc.Unmarshal(value, &attestation); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		resp.Attestations = append(resp.Attestations, attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReqAttrBypassAddrs returns the addresses that can bypass the required attributes checking of restricted markers.
+func (k Keeper) ReqAttrBypassAddrs(c context.Context, req *types.QueryReqAttrBypassAddrsRequest) (*types.QueryReqAttrBypassAddrsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryReqAttrBypassAddrsResponse{}
+	for _, addr := range k.GetReqAttrBypassAddrs() {
+		resp.ModuleAddresses = append(resp.ModuleAddresses, addr.String())
+	}
+
+	var err error
+	addrStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ReqAttrBypassAddrPrefix)
+	resp.Pagination, err = query.Paginate(addrStore, req.Pagination, func(key []byte, _ []byte) error {
+		resp.Addresses = append(resp.Addresses, types.GetReqAttrBypassAddrFromKey(append(types.ReqAttrBypassAddrPrefix, key...)).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, nil
+}
+
+// HoldersExport returns the accounts holding a marker's coin in address order, using large pages meant for exporting every holder.
+func (k Keeper) HoldersExport(c context.Context, req *types.QueryHoldersExportRequest) (*types.QueryHoldersExportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	if pageReq.Limit > types.MaxHoldersExportLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d cannot be more than %d", pageReq.Limit, types.MaxHoldersExportLimit)
+	}
+	if pageReq.Limit == 0 {
+		pageReq.Limit = types.DefaultHoldersExportLimit
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	denomOwners, err := k.bankKeeper.DenomOwners(c, &banktypes.QueryDenomOwnersRequest{
+		Denom:      marker.GetDenom(),
+		Pagination: pageReq,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	holders := make([]types.Balance, len(denomOwners.DenomOwners))
+	for i, owner := range denomOwners.DenomOwners {
+		holders[i] = types.Balance{
+			Address: owner.Address,
+			Coins:   sdk.NewCoins(owner.Balance),
+		}
+	}
+
+	return &types.QueryHoldersExportResponse{
+		Holders:    holders,
+		Pagination: denomOwners.Pagination,
+		Height:     ctx.BlockHeight(),
+	}, nil
+}
+
+// CanSend simulates the marker module's send restrictions for a bank send and explains why it would be denied.
+func (k Keeper) CanSend(c context.Context, req *types.QueryCanSendRequest) (*types.QueryCanSendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	fromAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from address: %v", err)
+	}
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %v", err)
+	}
+	amt, err := sdk.ParseCoinsNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denials := k.SimulateSend(ctx, fromAddr, toAddr, amt)
+	return &types.QueryCanSendResponse{Allowed: len(denials) == 0, Denials: denials}, nil
+}
+
+// DetectTransferRestriction returns the ERC-1404 style restriction code for a hypothetical send of one coin.
+func (k Keeper) DetectTransferRestriction(c context.Context, req *types.QueryDetectTransferRestrictionRequest) (*types.QueryDetectTransferRestrictionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	fromAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from address: %v", err)
+	}
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %v", err)
+	}
+	coin, err := sdk.ParseCoinNormalized(req.Amount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid amount: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	code, reason := k.GetTransferRestriction(ctx, fromAddr, toAddr, coin)
+	return &types.QueryDetectTransferRestrictionResponse{Code: uint32(code), Message: code.Message(), Reason: reason}, nil
+}
+
+// TransferRestrictionMessage returns the message of an ERC-1404 style restriction code.
+func (k Keeper) TransferRestrictionMessage(_ context.Context, req *types.QueryTransferRestrictionMessageRequest) (*types.QueryTransferRestrictionMessageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return &types.QueryTransferRestrictionMessageResponse{Message: types.TransferRestrictionCode(req.Code).Message()}, nil
+}
+
+// AssetManifest exports a marker's setup as an asset manifest that can be imported on another chain.
+func (k Keeper) AssetManifest(c context.Context, req *types.QueryAssetManifestRequest) (*types.QueryAssetManifestResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := k.ExportAssetManifest(ctx, marker)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAssetManifestResponse{Manifest: manifest}, nil
+}
+
+// SwapOffer returns a swap offer that has not been accepted, canceled, or expired yet.
+func (k Keeper) SwapOffer(c context.Context, req *types.QuerySwapOfferRequest) (*types.QuerySwapOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offer, found := k.GetSwapOffer(ctx, req.OfferId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "swap offer %d not found", req.OfferId)
+	}
+	return &types.QuerySwapOfferResponse{Offer: offer}, nil
+}
+
+// SwapOffers returns all swap offers that have not been accepted, canceled, or expired yet.
+func (k Keeper) SwapOffers(c context.Context, req *types.QuerySwapOffersRequest) (*types.QuerySwapOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	offers := make([]types.SwapOffer, 0)
+	offerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapOfferPrefix)
+	pageRes, err := query.Paginate(offerStore, req.Pagination, func(_ []byte, value []byte) error {
+		var offer types.SwapOffer
+		if err := k.cdc.Unmarshal(value, &offer); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		offers = append(offers, offer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySwapOffersResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+// IssuanceTranches returns the issuance history of a marker.
+func (k Keeper) IssuanceTranches(c context.Context, req *types.QueryIssuanceTranchesRequest) (*types.QueryIssuanceTranchesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tranches := make([]types.IssuanceTranche, 0)
+	trancheStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.IssuanceTrancheKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(trancheStore, req.Pagination, func(_ []byte, value []byte) error {
+		var tranche types.IssuanceTranche
+		if err := k.cdc.Unmarshal(value, &tranche); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		tranches = append(tranches, tranche)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIssuanceTranchesResponse{Tranches: tranches, Pagination: pageRes}, nil
+}
+
+// SubLedger returns the entries in a custodian's sub-ledger, optionally limited to a single sub-account.
+func (k Keeper) SubLedger(c context.Context, req *types.QuerySubLedgerRequest) (*types.QuerySubLedgerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	custodian, err := sdk.AccAddressFromBech32(req.Custodian)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid custodian: %v", err)
+	}
+
+	entries := make([]types.SubLedgerEntry, 0)
+	var pageRes *query.PageResponse
+	if len(req.SubAccount) == 0 {
+		entryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubLedgerEntryKeyPrefix(custodian))
+		pageRes, err = query.Paginate(entryStore, req.Pagination, func(_ []byte, value []byte) error {
+			var entry types.SubLedgerEntry
+			if err := k.cdc.Unmarshal(value, &entry); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			entries = append(entries, entry)
+			return nil
+		})
+	} else {
+		indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SubLedgerSubAccountIndexKeyPrefix(custodian, req.SubAccount))
+		pageRes, err = query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+			if len(key) != 8 {
+				return status.Errorf(codes.Internal, "invalid sub-ledger index key %X", key)
+			}
+			entry, err := k.GetSubLedgerEntry(ctx, custodian, binary.BigEndian.Uint64(key))
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if entry != nil {
+				entries = append(entries, *entry)
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySubLedgerResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// BridgedSupply returns a bridged marker's reconciliation settings along with the result of its latest reconciliation.
+func (k Keeper) BridgedSupply(c context.Context, req *types.QueryBridgedSupplyRequest) (*types.QueryBridgedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	bridged, err := k.GetBridgedSupply(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	recon, err := k.GetBridgedSupplyReconciliation(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBridgedSupplyResponse{BridgedSupply: bridged, Reconciliation: recon}, nil
+}
+
+// SupplyChangePolicy returns the limit on how much the mints and burns of a single block can change a marker's supply.
+func (k Keeper) SupplyChangePolicy(c context.Context, req *types.QuerySupplyChangePolicyRequest) (*types.QuerySupplyChangePolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := k.GetSupplyChangePolicy(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	supply := k.CurrentCirculation(ctx, marker)
+	resp := &types.QuerySupplyChangePolicyResponse{
+		Policy:          policy,
+		Supply:          sdk.NewCoin(marker.GetDenom(), supply),
+		MaxChangeAmount: sdkmath.ZeroInt(),
+	}
+	if policy != nil {
+		usage, err := k.getSupplyChangeUsage(ctx, marker)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.MaxChangeAmount = policy.MaxChangeAmount(usage.BaseSupply).Sub(usage.Changed)
+	}
+	return resp, nil
+}
+
+// ReceiptPolicy returns how long the recipients of a marker's coins have to acknowledge a transfer.
+func (k Keeper) ReceiptPolicy(c context.Context, req *types.QueryReceiptPolicyRequest) (*types.QueryReceiptPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := k.GetReceiptPolicy(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReceiptPolicyResponse{Policy: policy}, nil
+}
+
+// PendingReceipts returns the transfers to an account that are waiting to be acknowledged.
+func (k Keeper) PendingReceipts(c context.Context, req *types.QueryPendingReceiptsRequest) (*types.QueryPendingReceiptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipts := make([]types.PendingReceipt, 0)
+	receiptStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingReceiptKeyPrefix(addr))
+	pageRes, err := query.Paginate(receiptStore, req.Pagination, func(_ []byte, value []byte) error {
+		var receipt types.PendingReceipt
+		if err := k.cdc.Unmarshal(value, &receipt); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		receipts = append(receipts, receipt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingReceiptsResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
+
+// ContractSupplyCaps returns the daily mint and burn caps of the smart contracts that manage a marker's supply.
+func (k Keeper) ContractSupplyCaps(c context.Context, req *types.QueryContractSupplyCapsRequest) (*types.QueryContractSupplyCapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	day := types.ContractSupplyCapDay(ctx.BlockTime())
+	caps := make([]types.ContractSupplyCap, 0)
+	capStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractSupplyCapKeyPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(capStore, req.Pagination, func(_ []byte, value []byte) error {
+		var supplyCap types.ContractSupplyCap
+		if err := k.cdc.Unmarshal(value, &supplyCap); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		caps = append(caps, supplyCap.ForDay(day))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractSupplyCapsResponse{Caps: caps, Pagination: pageRes}, nil
+}
+
+// PortfolioValuation values an address's holdings of marker denoms using the markers' net asset values.
+func (k Keeper) PortfolioValuation(c context.Context, req *types.QueryPortfolioValuationRequest) (*types.QueryPortfolioValuationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	totals, assets, err := k.GetPortfolioValuation(ctx, addr, req.MaxNavAge)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPortfolioValuationResponse{Totals: totals, Assets: assets, Height: ctx.BlockHeight()}, nil
+}
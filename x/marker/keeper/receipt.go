@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+const (
+	// ReceiptReturnDeclined is the reason given when a pending receipt is returned because the recipient declined it.
+	ReceiptReturnDeclined = "declined"
+	// ReceiptReturnExpired is the reason given when a pending receipt is returned because it was not acknowledged in time.
+	ReceiptReturnExpired = "expired"
+)
+
+// SetReceiptPolicy stores how long the recipients of a marker's coins have to acknowledge a transfer.
+// A policy without a timeout is removed from state.
+func (k Keeper) SetReceiptPolicy(ctx sdk.Context, policy types.ReceiptPolicy) error {
+	markerAddr, err := types.MarkerAddress(policy.Denom)
+	if err != nil {
+		return err
+	}
+	if policy.TimeoutBlocks == 0 {
+		k.RemoveReceiptPolicy(ctx, markerAddr)
+		return nil
+	}
+	if err = policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ReceiptPolicyKey(markerAddr), bz)
+	return nil
+}
+
+// GetReceiptPolicy returns the receipt policy of the marker with the provided address.
+// Returns nil if the marker does not have one.
+func (k Keeper) GetReceiptPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.ReceiptPolicy, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReceiptPolicyKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var policy types.ReceiptPolicy
+	if err := k.cdc.Unmarshal(bz, &policy); err != nil {
+		return nil, fmt.Errorf("could not read receipt policy for %s: %w", markerAddr, err)
+	}
+	return &policy, nil
+}
+
+// RemoveReceiptPolicy removes the receipt policy of the marker with the provided address.
+func (k Keeper) RemoveReceiptPolicy(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ReceiptPolicyKey(markerAddr))
+}
+
+// IterateReceiptPolicies iterates over all of the markers' receipt policies.
+func (k Keeper) IterateReceiptPolicies(ctx sdk.Context, cb func(policy types.ReceiptPolicy) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReceiptPolicyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var policy types.ReceiptPolicy
+		if err := k.cdc.Unmarshal(it.Value(), &policy); err != nil {
+			return err
+		}
+		if cb(policy) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetLastPendingReceiptID returns the id of the last pending receipt.
+func (k Keeper) GetLastPendingReceiptID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastPendingReceiptIDKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastPendingReceiptID records the id of the last pending receipt.
+func (k Keeper) setLastPendingReceiptID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastPendingReceiptIDKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// nextPendingReceiptID increments the last pending receipt id and returns the new value.
+func (k Keeper) nextPendingReceiptID(ctx sdk.Context) uint64 {
+	id := k.GetLastPendingReceiptID(ctx) + 1
+	k.setLastPendingReceiptID(ctx, id)
+	return id
+}
+
+// SetPendingReceipt records a transfer that is waiting to be acknowledged by its recipient.
+func (k Keeper) SetPendingReceipt(ctx sdk.Context, receipt types.PendingReceipt) error {
+	if err := receipt.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+	recipient := sdk.MustAccAddressFromBech32(receipt.ToAddress)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingReceiptKey(recipient, receipt.Id), bz)
+	store.Set(types.PendingReceiptExpirationKey(receipt.ExpirationHeight, recipient, receipt.Id), []byte{})
+	return nil
+}
+
+// GetPendingReceipt returns the pending receipt with the provided recipient and id, and whether it was found.
+func (k Keeper) GetPendingReceipt(ctx sdk.Context, recipient sdk.AccAddress, id uint64) (types.PendingReceipt, bool) {
+	var receipt types.PendingReceipt
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingReceiptKey(recipient, id))
+	if len(bz) == 0 {
+		return receipt, false
+	}
+	if err := k.cdc.Unmarshal(bz, &receipt); err != nil {
+		return receipt, false
+	}
+	return receipt, true
+}
+
+// RemovePendingReceipt removes the pending receipt with the provided recipient and id.
+func (k Keeper) RemovePendingReceipt(ctx sdk.Context, recipient sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if receipt, found := k.GetPendingReceipt(ctx, recipient, id); found {
+		store.Delete(types.PendingReceiptExpirationKey(receipt.ExpirationHeight, recipient, id))
+	}
+	store.Delete(types.PendingReceiptKey(recipient, id))
+}
+
+// IteratePendingReceipts iterates over all pending receipts, ordered by recipient then id.
+func (k Keeper) IteratePendingReceipts(ctx sdk.Context, cb func(receipt types.PendingReceipt) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingReceiptPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var receipt types.PendingReceipt
+		if err := k.cdc.Unmarshal(it.Value(), &receipt); err != nil {
+			return err
+		}
+		if cb(receipt) {
+			break
+		}
+	}
+	return nil
+}
+
+// holdForReceipt checks whether the coins being sent are of markers with a receipt policy. If so, a pending receipt
+// is recorded for the send and the marker module account is returned so that it holds the coins until the recipient
+// acknowledges or declines them. Otherwise, or if toAddr cannot acknowledge them, the provided toAddr is returned.
+// The receipt expires after the longest timeout of the markers involved.
+//
+// A send restriction can only redirect an entire send, so a send that has coins both with and without a receipt
+// policy is rejected (rather than holding coins that don't need to be acknowledged).
+func (k Keeper) holdForReceipt(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	var timeout int64
+	var held, notHeld sdk.Coins
+	for _, coin := range amt {
+		var policy *types.ReceiptPolicy
+		if k.IsMarkerDenom(ctx, coin.Denom) {
+			var err error
+			policy, err = k.GetReceiptPolicy(ctx, types.MustGetMarkerAddress(coin.Denom))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if policy == nil || policy.TimeoutBlocks == 0 {
+			notHeld = append(notHeld, coin)
+			continue
+		}
+		held = append(held, coin)
+		if policy.TimeoutBlocks > timeout {
+			timeout = policy.TimeoutBlocks
+		}
+	}
+	// The receipt policies are checked first so that the recipient's account is only loaded when a hold is possible.
+	if len(held) == 0 || !k.canHoldForReceipt(ctx, toAddr) {
+		return toAddr, nil
+	}
+	if len(notHeld) > 0 {
+		return nil, fmt.Errorf("cannot send %s with %s: coins that require a receipt must be sent separately", held, notHeld)
+	}
+
+	receipt := types.PendingReceipt{
+		Id:               k.nextPendingReceiptID(ctx),
+		FromAddress:      fromAddr.String(),
+		ToAddress:        toAddr.String(),
+		Amount:           amt,
+		ExpirationHeight: ctx.BlockHeight() + timeout,
+	}
+	if err := k.SetPendingReceipt(ctx, receipt); err != nil {
+		return nil, fmt.Errorf("could not hold %s sent to %s for receipt: %w", amt, toAddr, err)
+	}
+	err := ctx.EventManager().EmitTypedEvent(&types.EventMarkerReceiptPending{
+		Id:               receipt.Id,
+		FromAddress:      receipt.FromAddress,
+		ToAddress:        receipt.ToAddress,
+		Amount:           amt.String(),
+		ExpirationHeight: receipt.ExpirationHeight,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return k.markerModuleAddr, nil
+}
+
+// AcknowledgeReceipt delivers the coins of an unexpired pending receipt to its recipient and removes the receipt.
+func (k Keeper) AcknowledgeReceipt(ctx sdk.Context, id uint64, recipient sdk.AccAddress) error {
+	receipt, found := k.GetPendingReceipt(ctx, recipient, id)
+	if !found {
+		return fmt.Errorf("pending receipt %d not found for %s", id, recipient)
+	}
+	if receipt.ExpirationHeight <= ctx.BlockHeight() {
+		return fmt.Errorf("pending receipt %d expired at height %d", id, receipt.ExpirationHeight)
+	}
+
+	k.RemovePendingReceipt(ctx, recipient, id)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, recipient, receipt.Amount); err != nil {
+		return fmt.Errorf("could not deliver %s to %s: %w", receipt.Amount, recipient, err)
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerReceiptAcknowledged{
+		Id:        id,
+		ToAddress: receipt.ToAddress,
+	})
+}
+
+// DeclineReceipt returns the coins of a pending receipt to their sender and removes the receipt.
+func (k Keeper) DeclineReceipt(ctx sdk.Context, id uint64, recipient sdk.AccAddress) error {
+	receipt, found := k.GetPendingReceipt(ctx, recipient, id)
+	if !found {
+		return fmt.Errorf("pending receipt %d not found for %s", id, recipient)
+	}
+	return k.returnPendingReceipt(ctx, receipt, ReceiptReturnDeclined)
+}
+
+// ReturnExpiredPendingReceipts returns the coins of every pending receipt with an expiration height at or before the
+// current block height to their senders. Each return is done on its own, so a failure to return one receipt (e.g. if
+// the sender can no longer receive the coins) is logged, and that receipt is left pending and is not tried again.
+// It can still be declined by its recipient.
+func (k Keeper) ReturnExpiredPendingReceipts(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+
+	var expiredKeys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.PendingReceiptExpirationPrefix)
+	for ; it.Valid(); it.Next() {
+		expHeight, _, _, err := types.ParsePendingReceiptExpirationKey(it.Key())
+		if err == nil && expHeight > height {
+			break
+		}
+		expiredKeys = append(expiredKeys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range expiredKeys {
+		_, recipient, id, err := types.ParsePendingReceiptExpirationKey(key)
+		if err != nil {
+			store.Delete(key)
+			continue
+		}
+		receipt, found := k.GetPendingReceipt(ctx, recipient, id)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.returnPendingReceipt(cacheCtx, receipt, ReceiptReturnExpired); err != nil {
+			k.Logger(ctx).Error("could not return expired pending receipt", "id", id, "recipient", recipient.String(), "error", err)
+			store.Delete(key)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeReceiptReturnFailed,
+				sdk.NewAttribute(types.EventAttributeIDKey, fmt.Sprintf("%d", id)),
+				sdk.NewAttribute(types.EventAttributeFromAddressKey, receipt.FromAddress),
+				sdk.NewAttribute(types.EventAttributeErrorKey, err.Error()),
+			))
+			continue
+		}
+		writeCache()
+	}
+}
+
+// returnPendingReceipt removes a pending receipt and sends its coins back to the sender.
+func (k Keeper) returnPendingReceipt(ctx sdk.Context, receipt types.PendingReceipt, reason string) error {
+	from, err := sdk.AccAddressFromBech32(receipt.FromAddress)
+	if err != nil {
+		return fmt.Errorf("invalid from address %q: %w", receipt.FromAddress, err)
+	}
+	k.RemovePendingReceipt(ctx, sdk.MustAccAddressFromBech32(receipt.ToAddress), receipt.Id)
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, from, receipt.Amount); err != nil {
+		return fmt.Errorf("could not return %s to %s: %w", receipt.Amount, from, err)
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerReceiptReturned{
+		Id:          receipt.Id,
+		FromAddress: receipt.FromAddress,
+		Reason:      reason,
+	})
+}
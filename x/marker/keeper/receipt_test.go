@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReceiptAcknowledgment(t *testing.T) {
+	const denom = "hotdog"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrSender := sdk.AccAddress("sender______________")
+	addrRecipient := sdk.AccAddress("recipient___________")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	queryServer := app.MarkerKeeper
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw}},
+			{Address: addrSender.String(), Permissions: types.AccessList{types.Access_Transfer}},
+		},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	err = app.MarkerKeeper.WithdrawCoins(ctx, addrAdmin, addrSender, denom, coins)
+	require.NoError(t, err, "WithdrawCoins")
+
+	t.Run("set requires admin", func(t *testing.T) {
+		_, err = msgServer.SetReceiptPolicy(ctx, &types.MsgSetReceiptPolicyRequest{Denom: denom, TimeoutBlocks: 5, Signer: addrSender.String()})
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrSender, denom)
+		assert.ErrorContains(t, err, expErr, "SetReceiptPolicy")
+	})
+
+	_, err = msgServer.SetReceiptPolicy(ctx, &types.MsgSetReceiptPolicyRequest{Denom: denom, TimeoutBlocks: 5, Signer: addrAdmin.String()})
+	require.NoError(t, err, "SetReceiptPolicy")
+	policyResp, err := queryServer.ReceiptPolicy(ctx, &types.QueryReceiptPolicyRequest{Id: denom})
+	require.NoError(t, err, "ReceiptPolicy")
+	assert.Equal(t, &types.ReceiptPolicy{Denom: denom, TimeoutBlocks: 5}, policyResp.Policy, "ReceiptPolicy result")
+
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) string {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).String()
+	}
+	send := func(ctx sdk.Context) types.PendingReceipt {
+		err := app.BankKeeper.SendCoins(ctx, addrSender, addrRecipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+		require.NoError(t, err, "SendCoins")
+		assert.Equal(t, "0"+denom, balance(ctx, addrRecipient), "recipient balance after send")
+		resp, err := queryServer.PendingReceipts(ctx, &types.QueryPendingReceiptsRequest{Address: addrRecipient.String()})
+		require.NoError(t, err, "PendingReceipts")
+		require.Len(t, resp.Receipts, 1, "pending receipts")
+		return resp.Receipts[0]
+	}
+
+	t.Run("acknowledge", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		receipt := send(cacheCtx)
+		assert.Equal(t, int64(15), receipt.ExpirationHeight, "ExpirationHeight")
+		_, err = msgServer.AcknowledgeReceipt(cacheCtx, &types.MsgAcknowledgeReceiptRequest{Id: receipt.Id, Recipient: addrRecipient.String()})
+		require.NoError(t, err, "AcknowledgeReceipt")
+		assert.Equal(t, "10"+denom, balance(cacheCtx, addrRecipient), "recipient balance")
+		assert.Equal(t, "90"+denom, balance(cacheCtx, addrSender), "sender balance")
+		_, found := app.MarkerKeeper.GetPendingReceipt(cacheCtx, addrRecipient, receipt.Id)
+		assert.False(t, found, "pending receipt found after acknowledgment")
+	})
+
+	t.Run("acknowledge by other account", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		receipt := send(cacheCtx)
+		_, err = msgServer.AcknowledgeReceipt(cacheCtx, &types.MsgAcknowledgeReceiptRequest{Id: receipt.Id, Recipient: addrSender.String()})
+		assert.ErrorContains(t, err, fmt.Sprintf("pending receipt %d not found for %s", receipt.Id, addrSender), "AcknowledgeReceipt")
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		receipt := send(cacheCtx)
+		_, err = msgServer.DeclineReceipt(cacheCtx, &types.MsgDeclineReceiptRequest{Id: receipt.Id, Recipient: addrRecipient.String()})
+		require.NoError(t, err, "DeclineReceipt")
+		assert.Equal(t, "0"+denom, balance(cacheCtx, addrRecipient), "recipient balance")
+		assert.Equal(t, "100"+denom, balance(cacheCtx, addrSender), "sender balance")
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		receipt := send(cacheCtx)
+		cacheCtx = cacheCtx.WithBlockHeight(receipt.ExpirationHeight)
+		_, err = msgServer.AcknowledgeReceipt(cacheCtx, &types.MsgAcknowledgeReceiptRequest{Id: receipt.Id, Recipient: addrRecipient.String()})
+		assert.ErrorContains(t, err, fmt.Sprintf("pending receipt %d expired at height 15", receipt.Id), "AcknowledgeReceipt")
+		app.MarkerKeeper.ReturnExpiredPendingReceipts(cacheCtx)
+		assert.Equal(t, "100"+denom, balance(cacheCtx, addrSender), "sender balance")
+		_, found := app.MarkerKeeper.GetPendingReceipt(cacheCtx, addrRecipient, receipt.Id)
+		assert.False(t, found, "pending receipt found after expiration")
+	})
+
+	t.Run("expired with a failed return", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		receipt1 := send(cacheCtx)
+		err = app.BankKeeper.SendCoins(cacheCtx, addrSender, addrRecipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+		require.NoError(t, err, "second SendCoins")
+		// Take some of the held coins out of the marker module account so that the second return fails.
+		err = app.BankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.CoinPoolName, addrAdmin, sdk.NewCoins(sdk.NewInt64Coin(denom, 15)))
+		require.NoError(t, err, "SendCoinsFromModuleToAccount")
+
+		cacheCtx = cacheCtx.WithBlockHeight(receipt1.ExpirationHeight).WithEventManager(sdk.NewEventManager())
+		require.NotPanics(t, func() { app.MarkerKeeper.ReturnExpiredPendingReceipts(cacheCtx) }, "ReturnExpiredPendingReceipts")
+		assert.Equal(t, "90"+denom, balance(cacheCtx, addrSender), "sender balance")
+		_, found := app.MarkerKeeper.GetPendingReceipt(cacheCtx, addrRecipient, receipt1.Id)
+		assert.False(t, found, "first pending receipt found after expiration")
+		receipt2, found := app.MarkerKeeper.GetPendingReceipt(cacheCtx, addrRecipient, receipt1.Id+1)
+		assert.True(t, found, "second pending receipt found after its return failed")
+
+		var failed []sdk.Event
+		for _, event := range cacheCtx.EventManager().Events() {
+			if event.Type == types.EventTypeReceiptReturnFailed {
+				failed = append(failed, event)
+			}
+		}
+		require.Len(t, failed, 1, "return failed events")
+		id, _ := failed[0].GetAttribute(types.EventAttributeIDKey)
+		assert.Equal(t, fmt.Sprintf("%d", receipt2.Id), id.Value, "return failed event id")
+
+		// The failed receipt isn't tried again, but it can still be declined.
+		cacheCtx = cacheCtx.WithBlockHeight(receipt1.ExpirationHeight + 1).WithEventManager(sdk.NewEventManager())
+		app.MarkerKeeper.ReturnExpiredPendingReceipts(cacheCtx)
+		assert.Empty(t, cacheCtx.EventManager().Events(), "events from the next ReturnExpiredPendingReceipts")
+		require.NoError(t, app.BankKeeper.SendCoins(cacheCtx, addrAdmin, app.AccountKeeper.GetModuleAddress(types.CoinPoolName), sdk.NewCoins(sdk.NewInt64Coin(denom, 15))), "refill marker module account")
+		_, err = msgServer.DeclineReceipt(cacheCtx, &types.MsgDeclineReceiptRequest{Id: receipt2.Id, Recipient: addrRecipient.String()})
+		require.NoError(t, err, "DeclineReceipt")
+		assert.Equal(t, "100"+denom, balance(cacheCtx, addrSender), "sender balance after decline")
+	})
+
+	t.Run("not held for ibc escrow", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+		amt := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+		err = simapp.NewTransferBankKeeper(app.BankKeeper).SendCoins(cacheCtx, addrSender, escrow, amt)
+		require.NoError(t, err, "SendCoins to escrow")
+		assert.Equal(t, "10"+denom, balance(cacheCtx, escrow), "escrow balance")
+		resp, err := queryServer.PendingReceipts(cacheCtx, &types.QueryPendingReceiptsRequest{Address: escrow.String()})
+		require.NoError(t, err, "PendingReceipts")
+		assert.Empty(t, resp.Receipts, "pending receipts for the escrow account")
+
+		// And the refund (e.g. after a timeout) is not held either.
+		err = simapp.NewTransferBankKeeper(app.BankKeeper).SendCoins(types.WithBypass(cacheCtx), escrow, addrSender, amt)
+		require.NoError(t, err, "SendCoins from escrow")
+		assert.Equal(t, "100"+denom, balance(cacheCtx, addrSender), "sender balance after refund")
+	})
+
+	t.Run("not held for exchange settlement", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		amt := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+		inputs := []banktypes.Input{{Address: addrSender.String(), Coins: amt}}
+		outputs := []banktypes.Output{{Address: addrRecipient.String(), Coins: amt}}
+		require.NoError(t, app.ExchangeKeeper.DoTransfer(cacheCtx, inputs, outputs), "DoTransfer")
+		assert.Equal(t, "10"+denom, balance(cacheCtx, addrRecipient), "recipient balance")
+		resp, err := queryServer.PendingReceipts(cacheCtx, &types.QueryPendingReceiptsRequest{Address: addrRecipient.String()})
+		require.NoError(t, err, "PendingReceipts")
+		assert.Empty(t, resp.Receipts, "pending receipts after settlement")
+	})
+
+	t.Run("not held for contract or module accounts", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		contract := address.Module("wasm", []byte("contract"))
+		amt := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+		require.NoError(t, app.BankKeeper.SendCoins(cacheCtx, addrSender, contract, amt), "SendCoins to contract")
+		assert.Equal(t, "10"+denom, balance(cacheCtx, contract), "contract balance")
+
+		moduleAcct := app.AccountKeeper.GetModuleAccount(cacheCtx, types.ModuleName)
+		require.NoError(t, app.BankKeeper.SendCoins(cacheCtx, addrSender, moduleAcct.GetAddress(), amt), "SendCoins to module account")
+		resp, err := queryServer.PendingReceipts(cacheCtx, &types.QueryPendingReceiptsRequest{Address: moduleAcct.GetAddress().String()})
+		require.NoError(t, err, "PendingReceipts")
+		assert.Empty(t, resp.Receipts, "pending receipts for the module account")
+	})
+
+	t.Run("exported in genesis", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		receipt := send(cacheCtx)
+		genState := app.MarkerKeeper.ExportGenesis(cacheCtx)
+		assert.Equal(t, []types.ReceiptPolicy{types.NewReceiptPolicy(denom, 5)}, genState.ReceiptPolicies, "ReceiptPolicies")
+		assert.Equal(t, []types.PendingReceipt{receipt}, genState.PendingReceipts, "PendingReceipts")
+		assert.Equal(t, receipt.Id, genState.LastPendingReceiptId, "LastPendingReceiptId")
+	})
+
+	t.Run("mixed denoms", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		require.NoError(t, testutil.FundAccount(cacheCtx, app.BankKeeper, addrSender, sdk.NewCoins(sdk.NewInt64Coin("acorn", 50))), "FundAccount")
+		amt := sdk.NewCoins(sdk.NewInt64Coin("acorn", 5), sdk.NewInt64Coin(denom, 10))
+		err = app.BankKeeper.SendCoins(cacheCtx, addrSender, addrRecipient, amt)
+		assert.ErrorContains(t, err, "cannot send 10"+denom+" with 5acorn: coins that require a receipt must be sent separately", "SendCoins")
+		assert.Equal(t, "0"+denom, balance(cacheCtx, addrRecipient), "recipient balance")
+		assert.Equal(t, "0acorn", app.BankKeeper.GetBalance(cacheCtx, addrRecipient, "acorn").String(), "recipient acorn balance")
+
+		err = app.BankKeeper.SendCoins(cacheCtx, addrSender, addrRecipient, sdk.NewCoins(sdk.NewInt64Coin("acorn", 5)))
+		require.NoError(t, err, "SendCoins acorn only")
+		assert.Equal(t, "5acorn", app.BankKeeper.GetBalance(cacheCtx, addrRecipient, "acorn").String(), "recipient acorn balance after sending it alone")
+		resp, err := queryServer.PendingReceipts(cacheCtx, &types.QueryPendingReceiptsRequest{Address: addrRecipient.String()})
+		require.NoError(t, err, "PendingReceipts")
+		assert.Empty(t, resp.Receipts, "pending receipts after sending acorn alone")
+	})
+
+	t.Run("removed policy", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.SetReceiptPolicy(cacheCtx, &types.MsgSetReceiptPolicyRequest{Denom: denom, Signer: addrAdmin.String()})
+		require.NoError(t, err, "SetReceiptPolicy remove")
+		err = app.BankKeeper.SendCoins(cacheCtx, addrSender, addrRecipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+		require.NoError(t, err, "SendCoins")
+		assert.Equal(t, "10"+denom, balance(cacheCtx, addrRecipient), "recipient balance")
+	})
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// AddReqAttrBypassAddr adds an address to the list of addresses (maintained through governance) that
+// bypass the required attributes checking. An error is returned if the address can already bypass it.
+// Marker accounts cannot be added since a send to a marker always requires transfer permission.
+func (k Keeper) AddReqAttrBypassAddr(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.IsMarkerAccount(ctx, addr) {
+		return fmt.Errorf("%s is a marker account and cannot bypass the required attributes checking", addr)
+	}
+	if k.IsReqAttrBypassAddr(addr) {
+		return fmt.Errorf("%s is a module account that already bypasses the required attributes checking", addr)
+	}
+	if k.IsAddedReqAttrBypassAddr(ctx, addr) {
+		return fmt.Errorf("%s is already in the required attributes bypass list", addr)
+	}
+	k.setReqAttrBypassAddr(ctx, addr)
+	return nil
+}
+
+// setReqAttrBypassAddr writes a bypass address to state without any checks.
+func (k Keeper) setReqAttrBypassAddr(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.ReqAttrBypassAddrKey(addr), []byte{})
+}
+
+// RemoveReqAttrBypassAddr removes an address from the list of addresses (maintained through governance)
+// that bypass the required attributes checking. The module accounts provided to the keeper cannot be removed.
+func (k Keeper) RemoveReqAttrBypassAddr(ctx sdk.Context, addr sdk.AccAddress) error {
+	if k.IsReqAttrBypassAddr(addr) {
+		return fmt.Errorf("%s is a module account that cannot be removed from the required attributes bypass list", addr)
+	}
+	if !k.IsAddedReqAttrBypassAddr(ctx, addr) {
+		return fmt.Errorf("%s is not in the required attributes bypass list", addr)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.ReqAttrBypassAddrKey(addr))
+	return nil
+}
+
+// IsAddedReqAttrBypassAddr returns true if the provided addr was added (through governance)
+// to the list of addresses that bypass the required attributes checking.
+func (k Keeper) IsAddedReqAttrBypassAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if len(addr) == 0 {
+		return false
+	}
+	return ctx.KVStore(k.storeKey).Has(types.ReqAttrBypassAddrKey(addr))
+}
+
+// CanBypassReqAttrs returns true if the provided addr is either one of the module accounts provided
+// to the keeper or was added (through governance) to the required attributes bypass list.
+func (k Keeper) CanBypassReqAttrs(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.IsReqAttrBypassAddr(addr) || k.IsAddedReqAttrBypassAddr(ctx, addr)
+}
+
+// GetAddedReqAttrBypassAddrs returns all addresses added (through governance) to the required attributes bypass list.
+func (k Keeper) GetAddedReqAttrBypassAddrs(ctx sdk.Context) []sdk.AccAddress {
+	var rv []sdk.AccAddress
+	k.IterateAddedReqAttrBypassAddrs(ctx, func(addr sdk.AccAddress) bool {
+		rv = append(rv, addr)
+		return false
+	})
+	return rv
+}
+
+// IterateAddedReqAttrBypassAddrs iterates over all addresses added (through governance) to the required attributes bypass list.
+func (k Keeper) IterateAddedReqAttrBypassAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReqAttrBypassAddrPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if cb(types.GetReqAttrBypassAddrFromKey(it.Key())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetReserveAttestors stores the accounts approved to publish reserve attestations for a marker.
+// Attestations from accounts that are no longer approved are removed. Providing no attestors removes them all.
+func (k Keeper) SetReserveAttestors(ctx sdk.Context, attestors types.ReserveAttestors) error {
+	markerAddr, err := types.MarkerAddress(attestors.Denom)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if len(attestors.Attestors) == 0 {
+		store.Delete(types.ReserveAttestorsKey(markerAddr))
+	} else {
+		if err = attestors.Validate(); err != nil {
+			return err
+		}
+		bz, err := k.cdc.Marshal(&attestors)
+		if err != nil {
+			return err
+		}
+		store.Set(types.ReserveAttestorsKey(markerAddr), bz)
+	}
+
+	attestations, err := k.GetReserveAttestations(ctx, markerAddr)
+	if err != nil {
+		return err
+	}
+	for _, attestation := range attestations {
+		if !attestors.HasAttestor(attestation.Attestor) {
+			k.removeReserveAttestation(ctx, markerAddr, sdk.MustAccAddressFromBech32(attestation.Attestor))
+		}
+	}
+	return nil
+}
+
+// GetReserveAttestors returns the accounts approved to publish reserve attestations for the marker with the provided address.
+// Returns nil if the marker does not have any.
+func (k Keeper) GetReserveAttestors(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.ReserveAttestors, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReserveAttestorsKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var attestors types.ReserveAttestors
+	if err := k.cdc.Unmarshal(bz, &attestors); err != nil {
+		return nil, fmt.Errorf("could not read reserve attestors for %s: %w", markerAddr, err)
+	}
+	return &attestors, nil
+}
+
+// IterateReserveAttestors iterates over all of the markers' approved reserve attestors.
+func (k Keeper) IterateReserveAttestors(ctx sdk.Context, cb func(attestors types.ReserveAttestors) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReserveAttestorsPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var attestors types.ReserveAttestors
+		if err := k.cdc.Unmarshal(it.Value(), &attestors); err != nil {
+			return err
+		}
+		if cb(attestors) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetReserveAttestation stores a reserve attestation, replacing the attestor's previous one for the marker.
+func (k Keeper) SetReserveAttestation(ctx sdk.Context, attestation types.ReserveAttestation) error {
+	if err := attestation.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(attestation.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&attestation)
+	if err != nil {
+		return err
+	}
+	attestor := sdk.MustAccAddressFromBech32(attestation.Attestor)
+	k.removeReserveAttestation(ctx, markerAddr, attestor)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReserveAttestationKey(markerAddr, attestor), bz)
+	if !attestation.Stale {
+		store.Set(types.ReserveAttestationExpirationKey(attestation.ValidUntil, markerAddr, attestor), []byte{})
+	}
+	return nil
+}
+
+// getReserveAttestation returns an attestor's reserve attestation for the marker with the provided address,
+// and whether it was found.
+func (k Keeper) getReserveAttestation(ctx sdk.Context, markerAddr, attestor sdk.AccAddress) (types.ReserveAttestation, bool) {
+	var attestation types.ReserveAttestation
+	bz := ctx.KVStore(k.storeKey).Get(types.ReserveAttestationKey(markerAddr, attestor))
+	if len(bz) == 0 {
+		return attestation, false
+	}
+	if err := k.cdc.Unmarshal(bz, &attestation); err != nil {
+		return attestation, false
+	}
+	return attestation, true
+}
+
+// removeReserveAttestation removes an attestor's reserve attestation for the marker with the provided address,
+// along with its expiration index entry.
+func (k Keeper) removeReserveAttestation(ctx sdk.Context, markerAddr, attestor sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if attestation, found := k.getReserveAttestation(ctx, markerAddr, attestor); found {
+		store.Delete(types.ReserveAttestationExpirationKey(attestation.ValidUntil, markerAddr, attestor))
+	}
+	store.Delete(types.ReserveAttestationKey(markerAddr, attestor))
+}
+
+// GetReserveAttestations returns the latest reserve attestation from each attestor of the marker with the provided address.
+func (k Keeper) GetReserveAttestations(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.ReserveAttestation, error) {
+	var rv []types.ReserveAttestation
+	err := k.iterateReserveAttestations(ctx, types.ReserveAttestationKeyPrefix(markerAddr), func(attestation types.ReserveAttestation) bool {
+		rv = append(rv, attestation)
+		return false
+	})
+	return rv, err
+}
+
+// IterateReserveAttestations iterates over all of the markers' reserve attestations.
+func (k Keeper) IterateReserveAttestations(ctx sdk.Context, cb func(attestation types.ReserveAttestation) (stop bool)) error {
+	return k.iterateReserveAttestations(ctx, types.ReserveAttestationPrefix, cb)
+}
+
+// iterateReserveAttestations iterates over the reserve attestations under the provided key prefix.
+func (k Keeper) iterateReserveAttestations(ctx sdk.Context, pre []byte, cb func(attestation types.ReserveAttestation) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var attestation types.ReserveAttestation
+		if err := k.cdc.Unmarshal(it.Value(), &attestation); err != nil {
+			return err
+		}
+		if cb(attestation) {
+			break
+		}
+	}
+	return nil
+}
+
+// MarkStaleReserveAttestations flags every reserve attestation whose validity window has ended as stale,
+// emitting an event for each. Only the attestations that are due are looked at. Each one is flagged on its own,
+// so a failure is logged and that attestation is skipped.
+func (k Keeper) MarkStaleReserveAttestations(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+	store := ctx.KVStore(k.storeKey)
+
+	var dueKeys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.ReserveAttestationExpirationPrefix)
+	for ; it.Valid(); it.Next() {
+		validUntil, _, _, err := types.ParseReserveAttestationExpirationKey(it.Key())
+		if err == nil && validUntil.After(blockTime) {
+			break
+		}
+		dueKeys = append(dueKeys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range dueKeys {
+		_, markerAddr, attestor, err := types.ParseReserveAttestationExpirationKey(key)
+		if err != nil {
+			store.Delete(key)
+			continue
+		}
+		attestation, found := k.getReserveAttestation(ctx, markerAddr, attestor)
+		if !found || attestation.Stale {
+			store.Delete(key)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		attestation.Stale = true
+		err = k.SetReserveAttestation(cacheCtx, attestation)
+		if err == nil {
+			err = cacheCtx.EventManager().EmitTypedEvent(&types.EventMarkerReserveAttestationStale{
+				Denom:      attestation.Denom,
+				Attestor:   attestation.Attestor,
+				ValidUntil: attestation.ValidUntil.UTC().Format(time.RFC3339Nano),
+			})
+		}
+		if err != nil {
+			k.Logger(ctx).Error("could not flag stale reserve attestation", "denom", attestation.Denom,
+				"attestor", attestation.Attestor, "error", err)
+			store.Delete(key)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// RemoveReserveAttestationState removes a marker's approved reserve attestors and all of their attestations.
+func (k Keeper) RemoveReserveAttestationState(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReserveAttestorsKey(markerAddr))
+
+	pre := types.ReserveAttestationKeyPrefix(markerAddr)
+	it := storetypes.KVStorePrefixIterator(store, pre)
+	var attestors []sdk.AccAddress
+	for ; it.Valid(); it.Next() {
+		attestors = append(attestors, sdk.AccAddress(it.Key()[len(pre)+1:]))
+	}
+	it.Close()
+
+	for _, attestor := range attestors {
+		k.removeReserveAttestation(ctx, markerAddr, attestor)
+	}
+}
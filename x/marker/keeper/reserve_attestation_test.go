@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReserveAttestations(t *testing.T) {
+	const denom = "wrappedusd"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrAttestor1 := sdk.AccAddress("attestor1___________")
+	addrAttestor2 := sdk.AccAddress("attestor2___________")
+
+	app := simapp.Setup(t)
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(now).WithBlockHeight(10)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 100),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_Coin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin}},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	markerAddr := types.MustGetMarkerAddress(denom)
+
+	hash := []byte("report_hash_____________________")
+	attest := func(ctx sdk.Context, attestor sdk.AccAddress, amount int64, validUntil time.Time) error {
+		msg := types.NewMsgAttestReservesRequest(denom, sdk.NewInt64Coin("usd", amount), "First Bank", hash, now, validUntil, attestor)
+		_, err := msgServer.AttestReserves(ctx, msg)
+		return err
+	}
+
+	t.Run("attestor must be approved", func(t *testing.T) {
+		err = attest(ctx, addrAttestor1, 100, now.Add(time.Hour))
+		expErr := fmt.Sprintf("%s is not an approved reserve attestor for %s marker", addrAttestor1, denom)
+		assert.EqualError(t, err, expErr, "AttestReserves")
+	})
+
+	t.Run("set attestors requires admin", func(t *testing.T) {
+		msg := types.NewMsgSetReserveAttestorsRequest(denom, []string{addrAttestor1.String()}, addrAttestor1)
+		_, err = msgServer.SetReserveAttestors(ctx, msg)
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrAttestor1, denom)
+		assert.ErrorContains(t, err, expErr, "SetReserveAttestors")
+	})
+
+	attestors := []string{addrAttestor1.String(), addrAttestor2.String()}
+	_, err = msgServer.SetReserveAttestors(ctx, types.NewMsgSetReserveAttestorsRequest(denom, attestors, addrAdmin))
+	require.NoError(t, err, "SetReserveAttestors")
+
+	t.Run("valid until must be in the future", func(t *testing.T) {
+		err = attest(ctx, addrAttestor1, 100, now)
+		assert.EqualError(t, err, "valid until 2026-06-01T00:00:00Z must be after the current block time 2026-06-01T00:00:00Z", "AttestReserves")
+	})
+
+	require.NoError(t, attest(ctx, addrAttestor1, 50, now.Add(time.Hour)), "first AttestReserves from attestor 1")
+	require.NoError(t, attest(ctx, addrAttestor1, 100, now.Add(time.Hour)), "second AttestReserves from attestor 1")
+	require.NoError(t, attest(ctx, addrAttestor2, 90, now.Add(2*time.Hour)), "AttestReserves from attestor 2")
+
+	t.Run("latest attestations are queryable", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.ReserveAttestations(ctx, &types.QueryReserveAttestationsRequest{Id: denom})
+		require.NoError(t, err, "ReserveAttestations")
+		assert.ElementsMatch(t, attestors, resp.Attestors, "Attestors")
+		amounts := make(map[string]string)
+		for _, attestation := range resp.Attestations {
+			amounts[attestation.Attestor] = attestation.Amount.String()
+			assert.Equal(t, int64(10), attestation.Height, "Height for %s", attestation.Attestor)
+			assert.False(t, attestation.Stale, "Stale for %s", attestation.Attestor)
+		}
+		expAmounts := map[string]string{addrAttestor1.String(): "100usd", addrAttestor2.String(): "90usd"}
+		assert.Equal(t, expAmounts, amounts, "attestation amounts")
+	})
+
+	t.Run("attestations are not flagged before their windows end", func(t *testing.T) {
+		earlyCtx := ctx.WithBlockTime(now.Add(time.Hour - time.Nanosecond)).WithEventManager(sdk.NewEventManager())
+		marker.BeginBlocker(earlyCtx, app.MarkerKeeper, app.BankKeeper)
+		assert.Empty(t, earlyCtx.EventManager().Events(), "events from begin block")
+	})
+
+	t.Run("stale attestations are flagged", func(t *testing.T) {
+		staleCtx := ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+		marker.BeginBlocker(staleCtx, app.MarkerKeeper, app.BankKeeper)
+		attestations, err := app.MarkerKeeper.GetReserveAttestations(staleCtx, markerAddr)
+		require.NoError(t, err, "GetReserveAttestations")
+		for _, attestation := range attestations {
+			assert.Equal(t, attestation.Attestor == addrAttestor1.String(), attestation.Stale, "Stale for %s", attestation.Attestor)
+		}
+		var staleEvents int
+		for _, event := range staleCtx.EventManager().Events() {
+			if event.Type == "provenance.marker.v1.EventMarkerReserveAttestationStale" {
+				staleEvents++
+			}
+		}
+		assert.Equal(t, 1, staleEvents, "stale events")
+
+		// A stale attestation is only flagged once.
+		staleCtx = staleCtx.WithEventManager(sdk.NewEventManager())
+		marker.BeginBlocker(staleCtx, app.MarkerKeeper, app.BankKeeper)
+		assert.Empty(t, staleCtx.EventManager().Events(), "events from second begin block")
+	})
+
+	t.Run("removed attestor's attestation is removed", func(t *testing.T) {
+		msg := types.NewMsgSetReserveAttestorsRequest(denom, []string{addrAttestor2.String()}, addrAdmin)
+		_, err = msgServer.SetReserveAttestors(ctx, msg)
+		require.NoError(t, err, "SetReserveAttestors")
+		attestations, err := app.MarkerKeeper.GetReserveAttestations(ctx, markerAddr)
+		require.NoError(t, err, "GetReserveAttestations")
+		require.Len(t, attestations, 1, "attestations")
+		assert.Equal(t, addrAttestor2.String(), attestations[0].Attestor, "remaining attestor")
+	})
+
+	t.Run("exported in genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Equal(t, []types.ReserveAttestors{types.NewReserveAttestors(denom, []string{addrAttestor2.String()})}, genState.ReserveAttestors, "ReserveAttestors")
+		assert.Len(t, genState.ReserveAttestations, 1, "ReserveAttestations")
+	})
+
+	t.Run("removed from state with the marker", func(t *testing.T) {
+		m, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+		require.NoError(t, err, "GetMarker %s", denom)
+		app.MarkerKeeper.RemoveMarker(ctx, m)
+		attestors, err := app.MarkerKeeper.GetReserveAttestors(ctx, markerAddr)
+		require.NoError(t, err, "GetReserveAttestors")
+		assert.Nil(t, attestors, "GetReserveAttestors")
+		attestations, err := app.MarkerKeeper.GetReserveAttestations(ctx, markerAddr)
+		require.NoError(t, err, "GetReserveAttestations")
+		assert.Empty(t, attestations, "GetReserveAttestations")
+
+		it := storetypes.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.ReserveAttestationExpirationPrefix)
+		defer it.Close()
+		assert.False(t, it.Valid(), "reserve attestation expiration index has entries")
+	})
+}
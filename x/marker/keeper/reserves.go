@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetReserveRequirement stores the collateral that a marker must hold in its escrow to back its supply.
+// A requirement without any reserve denoms or ratio is removed from state.
+func (k Keeper) SetReserveRequirement(ctx sdk.Context, req types.ReserveRequirement) error {
+	markerAddr, err := types.MarkerAddress(req.Denom)
+	if err != nil {
+		return err
+	}
+	if req.IsEmpty() {
+		k.RemoveReserveRequirement(ctx, markerAddr)
+		return nil
+	}
+	if err = req.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&req)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ReserveRequirementKey(markerAddr), bz)
+	return nil
+}
+
+// GetReserveRequirement returns the reserve requirement of the marker with the provided address.
+// Returns nil if the marker does not have one.
+func (k Keeper) GetReserveRequirement(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.ReserveRequirement, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ReserveRequirementKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var req types.ReserveRequirement
+	if err := k.cdc.Unmarshal(bz, &req); err != nil {
+		return nil, fmt.Errorf("could not read reserve requirement for %s: %w", markerAddr, err)
+	}
+	return &req, nil
+}
+
+// RemoveReserveRequirement removes the reserve requirement of the marker with the provided address.
+func (k Keeper) RemoveReserveRequirement(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.ReserveRequirementKey(markerAddr))
+}
+
+// IterateReserveRequirements iterates over all of the markers' reserve requirements.
+func (k Keeper) IterateReserveRequirements(ctx sdk.Context, cb func(req types.ReserveRequirement) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReserveRequirementPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var req types.ReserveRequirement
+		if err := k.cdc.Unmarshal(it.Value(), &req); err != nil {
+			return err
+		}
+		if cb(req) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetReserves returns the reserve coins held in the marker's escrow.
+func (k Keeper) GetReserves(ctx sdk.Context, marker types.MarkerAccountI, req types.ReserveRequirement) sdk.Coins {
+	var rv sdk.Coins
+	for _, denom := range req.ReserveDenoms {
+		if bal := k.bankKeeper.GetBalance(ctx, marker.GetAddress(), denom); bal.IsPositive() {
+			rv = rv.Add(bal)
+		}
+	}
+	return rv
+}
+
+// ValidateReserves returns an error if the marker has a reserve requirement that the reserves in its
+// escrow do not meet for the provided supply.
+func (k Keeper) ValidateReserves(ctx sdk.Context, marker types.MarkerAccountI, supply sdkmath.Int) error {
+	req, err := k.GetReserveRequirement(ctx, marker.GetAddress())
+	if err != nil || req == nil {
+		return err
+	}
+	reserves := k.GetReserves(ctx, marker, *req)
+	required := req.RequiredReserves(supply)
+	if types.TotalAmount(reserves).LT(required) {
+		return fmt.Errorf("%s marker reserves %q do not cover the %s required for a supply of %s",
+			marker.GetDenom(), reserves, required, supply)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReserveRequirements(t *testing.T) {
+	const denom = "wrappedusd"
+	const reserveDenom = "uusdc"
+	cz := func(amt int64, denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	queryServer := app.MarkerKeeper
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 100),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_Coin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Withdraw}},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	markerAddr := types.MustGetMarkerAddress(denom)
+	marker, err := app.MarkerKeeper.GetMarker(ctx, markerAddr)
+	require.NoError(t, err, "GetMarker %s", denom)
+
+	fundMarker := func(amt int64) {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, types.CoinPoolName, cz(amt, reserveDenom)), "MintCoins %d%s", amt, reserveDenom)
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, markerAddr, cz(amt, reserveDenom)), "fund marker with %d%s", amt, reserveDenom)
+	}
+	oneToOne := types.NewMsgSetReserveRequirementRequest(denom, []string{reserveDenom}, sdkmath.LegacyOneDec(), addrAdmin)
+
+	t.Run("set requires admin", func(t *testing.T) {
+		msg := types.NewMsgSetReserveRequirementRequest(denom, []string{reserveDenom}, sdkmath.LegacyOneDec(), addrOther)
+		_, err = msgServer.SetReserveRequirement(ctx, msg)
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrOther, denom)
+		assert.ErrorContains(t, err, expErr, "SetReserveRequirement")
+	})
+
+	t.Run("set requires current supply to be backed", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = msgServer.SetReserveRequirement(cacheCtx, oneToOne)
+		expErr := fmt.Sprintf("%s marker reserves \"\" do not cover the 100 required for a supply of 100", denom)
+		assert.EqualError(t, err, expErr, "SetReserveRequirement")
+	})
+
+	fundMarker(120)
+	_, err = msgServer.SetReserveRequirement(ctx, oneToOne)
+	require.NoError(t, err, "SetReserveRequirement")
+
+	t.Run("status", func(t *testing.T) {
+		resp, err := queryServer.ReserveStatus(ctx, &types.QueryReserveStatusRequest{Id: denom})
+		require.NoError(t, err, "ReserveStatus")
+		require.NotNil(t, resp.Requirement, "Requirement")
+		assert.Equal(t, []string{reserveDenom}, resp.Requirement.ReserveDenoms, "ReserveDenoms")
+		assert.Equal(t, "100"+denom, resp.Supply.String(), "Supply")
+		assert.Equal(t, "120"+reserveDenom, resp.Reserves.String(), "Reserves")
+		assert.Equal(t, "100", resp.RequiredReserves.String(), "RequiredReserves")
+		assert.Equal(t, "20", resp.Mintable.String(), "Mintable")
+	})
+
+	t.Run("mint over reserves", func(t *testing.T) {
+		err = app.MarkerKeeper.MintCoin(ctx, addrAdmin, sdk.NewInt64Coin(denom, 21))
+		expErr := fmt.Sprintf("%s marker reserves \"120%s\" do not cover the 121 required for a supply of 121", denom, reserveDenom)
+		assert.EqualError(t, err, expErr, "MintCoin")
+	})
+
+	t.Run("mint within reserves", func(t *testing.T) {
+		err = app.MarkerKeeper.MintCoin(ctx, addrAdmin, sdk.NewInt64Coin(denom, 20))
+		require.NoError(t, err, "MintCoin")
+		assert.Equal(t, "120", app.MarkerKeeper.CurrentCirculation(ctx, marker).String(), "CurrentCirculation")
+	})
+
+	t.Run("withdraw of reserves", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.WithdrawCoins(cacheCtx, addrAdmin, addrAdmin, denom, cz(1, reserveDenom))
+		expErr := fmt.Sprintf("%s marker reserves \"119%s\" do not cover the 120 required for a supply of 120", denom, reserveDenom)
+		assert.EqualError(t, err, expErr, "WithdrawCoins")
+	})
+
+	t.Run("withdraw of marker coin", func(t *testing.T) {
+		err = app.MarkerKeeper.WithdrawCoins(ctx, addrAdmin, addrAdmin, denom, cz(10, denom))
+		assert.NoError(t, err, "WithdrawCoins")
+	})
+
+	t.Run("exported in genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		expReqs := []types.ReserveRequirement{types.NewReserveRequirement(denom, []string{reserveDenom}, sdkmath.LegacyOneDec())}
+		assert.Equal(t, expReqs, genState.ReserveRequirements, "ReserveRequirements")
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		msg := types.NewMsgSetReserveRequirementRequest(denom, nil, sdkmath.LegacyZeroDec(), addrAdmin)
+		_, err = msgServer.SetReserveRequirement(ctx, msg)
+		require.NoError(t, err, "SetReserveRequirement")
+		req, err := app.MarkerKeeper.GetReserveRequirement(ctx, markerAddr)
+		require.NoError(t, err, "GetReserveRequirement")
+		assert.Nil(t, req, "GetReserveRequirement")
+		err = app.MarkerKeeper.MintCoin(ctx, addrAdmin, sdk.NewInt64Coin(denom, 1000))
+		assert.NoError(t, err, "MintCoin")
+	})
+
+	t.Run("removed from state with the marker", func(t *testing.T) {
+		require.NoError(t, app.MarkerKeeper.SetReserveRequirement(ctx, types.NewReserveRequirement(denom, []string{reserveDenom}, sdkmath.LegacyOneDec())), "SetReserveRequirement")
+		app.MarkerKeeper.RemoveMarker(ctx, marker)
+		req, err := app.MarkerKeeper.GetReserveRequirement(ctx, markerAddr)
+		require.NoError(t, err, "GetReserveRequirement")
+		assert.Nil(t, req, "GetReserveRequirement")
+	})
+}
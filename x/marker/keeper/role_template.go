@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetRoleTemplates returns the role templates defined in the marker module's params.
+func (k Keeper) GetRoleTemplates(ctx sdk.Context) []types.RoleTemplate {
+	return k.GetParams(ctx).RoleTemplates
+}
+
+// ExpandRoleTemplates returns a copy of the provided grants with the permissions of each grant's roles added to it.
+// An error is returned if any grant has a role that isn't defined in the params.
+func (k Keeper) ExpandRoleTemplates(ctx sdk.Context, grants []types.AccessGrant) ([]types.AccessGrant, error) {
+	var templates []types.RoleTemplate
+	rv := make([]types.AccessGrant, len(grants))
+	for i, grant := range grants {
+		if len(grant.Roles) == 0 {
+			rv[i] = grant
+			continue
+		}
+		if templates == nil {
+			templates = k.GetRoleTemplates(ctx)
+		}
+		var err error
+		if rv[i], err = types.ExpandRoles(templates, grant); err != nil {
+			return nil, fmt.Errorf("invalid access grant for %s: %w", grant.Address, err)
+		}
+	}
+	return rv, nil
+}
+
+// PropagateRoleTemplates updates the access grants of every marker for a change from the old role templates to
+// the new ones. See types.UpdateGrantRoles for how each grant is updated. Returns the number of markers updated.
+// This is done automatically when the params are updated, but is also available for use in upgrades.
+func (k Keeper) PropagateRoleTemplates(ctx sdk.Context, oldTemplates, newTemplates []types.RoleTemplate) (int, error) {
+	if rolesEqual(oldTemplates, newTemplates) {
+		return 0, nil
+	}
+
+	var toUpdate []types.MarkerAccountI
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		updated := false
+		grants := marker.GetAccessList()
+		for i, grant := range grants {
+			var changed bool
+			if grants[i], changed = types.UpdateGrantRoles(grant, marker.GetMarkerType(), oldTemplates, newTemplates); changed {
+				updated = true
+			}
+		}
+		if updated {
+			toUpdate = append(toUpdate, marker)
+		}
+		return false
+	})
+
+	for _, marker := range toUpdate {
+		if err := marker.Validate(); err != nil {
+			return 0, fmt.Errorf("could not update %s marker access grants: %w", marker.GetDenom(), err)
+		}
+		k.SetMarker(ctx, marker)
+	}
+	return len(toUpdate), nil
+}
+
+// rolesEqual returns true if both lists have the same role templates in the same order.
+func rolesEqual(a, b []types.RoleTemplate) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
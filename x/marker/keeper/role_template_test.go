@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestRoleTemplates(t *testing.T) {
+	const denom = "hotdog"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrIssuer := sdk.AccAddress("issuer______________")
+	addrTreasury := sdk.AccAddress("treasury____________")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	markerAddr := types.MustGetMarkerAddress(denom)
+
+	issuerGrant := types.AccessGrant{Address: addrIssuer.String(), Roles: []string{types.RoleIssuer}}
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin}},
+			issuerGrant,
+		},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+
+	getGrant := func(addr sdk.AccAddress) types.AccessGrant {
+		grant, found := app.MarkerKeeper.GetAccessGrant(ctx, markerAddr, addr)
+		require.True(t, found, "GetAccessGrant(%s) found", addr)
+		return grant
+	}
+
+	expIssuer := types.AccessGrant{
+		Address:     addrIssuer.String(),
+		Permissions: types.AccessList{types.Access_Mint, types.Access_Burn, types.Access_Withdraw},
+		Roles:       []string{types.RoleIssuer},
+	}
+	assert.Equal(t, expIssuer, getGrant(addrIssuer), "issuer grant after AddFinalizeActivateMarker")
+
+	t.Run("grant unknown role", func(t *testing.T) {
+		_, err = msgServer.AddAccess(ctx, &types.MsgAddAccessRequest{
+			Denom:         denom,
+			Administrator: addrAdmin.String(),
+			Access:        []types.AccessGrant{{Address: addrTreasury.String(), Roles: []string{"auditor"}}},
+		})
+		assert.ErrorContains(t, err, "access grant failed: unknown role \"auditor\"", "AddAccess")
+	})
+
+	_, err = msgServer.AddAccess(ctx, &types.MsgAddAccessRequest{
+		Denom:         denom,
+		Administrator: addrAdmin.String(),
+		Access: []types.AccessGrant{{
+			Address:     addrTreasury.String(),
+			Permissions: types.AccessList{types.Access_Burn},
+			Roles:       []string{types.RoleTreasury},
+		}},
+	})
+	require.NoError(t, err, "AddAccess treasury")
+	expTreasury := types.AccessGrant{
+		Address:     addrTreasury.String(),
+		Permissions: types.AccessList{types.Access_Burn, types.Access_Deposit, types.Access_Withdraw},
+		Roles:       []string{types.RoleTreasury},
+	}
+	assert.Equal(t, expTreasury, getGrant(addrTreasury), "treasury grant after AddAccess")
+
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.RoleTemplates = []types.RoleTemplate{
+		types.NewRoleTemplate(types.RoleIssuer, types.Access_Mint, types.Access_Deposit),
+		types.NewRoleTemplate(types.RoleRegistrar, types.Access_Admin),
+		types.NewRoleTemplate(types.RoleCompliance, types.Access_Transfer, types.Access_ForceTransfer),
+	}
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParamsRequest{Authority: app.MarkerKeeper.GetAuthority(), Params: params})
+	require.NoError(t, err, "UpdateParams")
+
+	expIssuer.Permissions = types.AccessList{types.Access_Mint, types.Access_Deposit}
+	assert.Equal(t, expIssuer, getGrant(addrIssuer), "issuer grant after UpdateParams")
+	expTreasury.Roles = nil
+	assert.Equal(t, expTreasury, getGrant(addrTreasury), "treasury grant after UpdateParams")
+	assert.Equal(t, types.AccessList{types.Access_Admin}, getGrant(addrAdmin).Permissions, "admin permissions after UpdateParams")
+
+	updated, err := app.MarkerKeeper.PropagateRoleTemplates(ctx, params.RoleTemplates, params.RoleTemplates)
+	require.NoError(t, err, "PropagateRoleTemplates with no changes")
+	assert.Equal(t, 0, updated, "PropagateRoleTemplates markers updated with no changes")
+}
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
@@ -23,8 +27,11 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		// But still don't let restricted denoms get sent to the fee collector.
 		if toAddr.Equals(k.feeCollectorAddr) {
 			for _, coin := range amt {
+				if !k.IsMarkerDenom(ctx, coin.Denom) {
+					continue
+				}
 				markerAddr := types.MustGetMarkerAddress(coin.Denom)
-				marker, err := k.GetMarker(ctx, markerAddr)
+				marker, err := k.getMarkerAccount(ctx, markerAddr)
 				if err != nil {
 					return nil, err
 				}
@@ -37,8 +44,14 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 	}
 
 	// If it's coming from a marker, make sure the withdraw is allowed.
+	// Only the access grants of the accounts involved in the send are needed for the checks in here.
+	// Most accounts aren't markers, so the marker registry is checked before loading any accounts.
 	admins := types.GetTransferAgents(ctx)
-	if fromMarker, _ := k.GetMarker(ctx, fromAddr); fromMarker != nil {
+	var fromMarker types.MarkerAccountI
+	if k.IsMarkerAddress(ctx, fromAddr) {
+		fromMarker, _ = k.getCachedMarkerWithGrantsFor(ctx, fromAddr, admins...)
+	}
+	if fromMarker != nil {
 		// The only ways to legitimately send from a marker account is to have a transfer agent with
 		// withdraw permissions, or through a feegrant. The only way to have a feegrant from
 		// a marker account is if an admin creates one using the marker module's GrantAllowance endpoint.
@@ -71,7 +84,11 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 
 	// If it's going to a restricted marker, either an admin (if there is one) or
 	// fromAddr (if there isn't an admin) must have deposit access on that marker.
-	toMarker, _ := k.GetMarker(ctx, toAddr)
+	accessAddrs := append([]sdk.AccAddress{fromAddr}, admins...)
+	var toMarker types.MarkerAccountI
+	if k.IsMarkerAddress(ctx, toAddr) {
+		toMarker, _ = k.getCachedMarkerWithGrantsFor(ctx, toAddr, accessAddrs...)
+	}
 	if toMarker != nil && toMarker.GetMarkerType() == types.MarkerType_RestrictedCoin {
 		if len(admins) > 0 {
 			if err := types.ValidateAtLeastOneAddrHasAccess(toMarker, admins, types.Access_Deposit); err != nil {
@@ -86,19 +103,55 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 
 	// Check the ability to send each denom involved.
 	for _, coin := range amt {
-		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin.Denom, toMarker); err != nil {
+		if err := k.validateSendDenom(ctx, fromAddr, toAddr, admins, coin.Denom, toMarker, accessAddrs); err != nil {
 			return nil, err
 		}
 	}
 
+	// If any of the coins require the recipient to acknowledge the transfer, hold them in the marker module account.
+	// Coins are never held when they're going to a marker, back to the sender, or when the context has a receipt
+	// bypass (e.g. ibc transfers to and from a channel's escrow account, and exchange settlements).
+	if toMarker == nil && !toAddr.Equals(fromAddr) && !types.HasReceiptBypass(ctx) {
+		return k.holdForReceipt(ctx, fromAddr, toAddr, amt)
+	}
+
 	return toAddr, nil
 }
 
+// canHoldForReceipt returns true if coins sent to toAddr can be held until toAddr acknowledges them.
+// Only an account controlled by a key can acknowledge a transfer, so coins are never held when they're going to a
+// req-attr bypass account, to a module or other special account (e.g. an exchange market), or to a derived account
+// (e.g. a smart contract). This loads the account, so it should only be used once we know a hold is needed.
+func (k Keeper) canHoldForReceipt(ctx sdk.Context, toAddr sdk.AccAddress) bool {
+	if k.CanBypassReqAttrs(ctx, toAddr) {
+		return false
+	}
+	// Smart contracts, interchain accounts, and other derived accounts have addresses with the longer derived length.
+	if len(toAddr) == address.Len {
+		return false
+	}
+	switch acct := k.authKeeper.GetAccount(ctx, toAddr).(type) {
+	case nil, *authtypes.BaseAccount:
+		return true
+	case sdk.ModuleAccountI:
+		return false
+	default:
+		_, isVesting := acct.(vestexported.VestingAccount)
+		return isVesting
+	}
+}
+
 // validateSendDenom makes sure a send of the given denom is allowed for the given addresses.
 // This is NOT the validation that is needed for the marker Transfer endpoint.
-func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, denom string, toMarker types.MarkerAccountI) error {
+// The accessAddrs are the addresses whose access grants on the denom's marker are needed (i.e. fromAddr and the admins).
+func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, admins []sdk.AccAddress, denom string, toMarker types.MarkerAccountI, accessAddrs []sdk.AccAddress) error {
+	// Most denoms don't have a marker, so there's nothing to check for them.
+	if !k.IsMarkerDenom(ctx, denom) {
+		return nil
+	}
+
 	markerAddr := types.MustGetMarkerAddress(denom)
-	marker, err := k.GetMarker(ctx, markerAddr)
+	marker, err := k.getCachedMarkerWithGrantsFor(ctx, markerAddr, accessAddrs...)
 	if err != nil {
 		return err
 	}
@@ -131,6 +184,11 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		return fmt.Errorf("%s is on deny list for sending restricted marker", fromAddr.String())
 	}
 
+	// If the fromAddr was frozen for losing a required attribute, it can't send until it has them all again.
+	if err = k.validateNotFrozen(ctx, marker, fromAddr); err != nil {
+		return err
+	}
+
 	// If the fromAddr has transfer access, there's nothing left to check.
 	if marker.AddressHasAccess(fromAddr, types.Access_Transfer) {
 		return nil
@@ -159,7 +217,7 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// account is by someone with transfer permission, which is then conveyed for this transfer too.
 	reqAttr := marker.GetRequiredAttributes()
 	if len(reqAttr) == 0 {
-		if k.IsReqAttrBypassAddr(fromAddr) {
+		if k.CanBypassReqAttrs(ctx, fromAddr) {
 			return nil
 		}
 		return fmt.Errorf("%s does not have transfer permissions for %s", fromAddr.String(), denom)
@@ -168,11 +226,11 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 	// At this point, we know there are required attributes and that fromAddr does not have transfer permission.
 	// If the toAddress has a bypass, skip checking the attributes and allow the transfer.
 	// When these funds are then being moved out of the bypass account, attributes are checked on that destination.
-	if k.IsReqAttrBypassAddr(toAddr) {
+	if k.CanBypassReqAttrs(ctx, toAddr) {
 		return nil
 	}
 
-	attributes, err := k.attrKeeper.GetAllAttributesAddr(ctx, toAddr)
+	attributes, err := k.getCachedAttributes(ctx, toAddr)
 	if err != nil {
 		return fmt.Errorf("could not get attributes for %s: %w", toAddr.String(), err)
 	}
@@ -182,12 +240,80 @@ func (k Keeper) validateSendDenom(ctx sdk.Context, fromAddr, toAddr sdk.AccAddre
 		if len(missing) != 1 {
 			pl = "s"
 		}
-		return fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`))
+		err = fmt.Errorf("address %s does not contain the %q required attribute%s: \"%s\"", toAddr.String(), denom, pl, strings.Join(missing, `", "`))
+		// An accepted attribute proof can stand in for the missing attributes.
+		return k.verifyAttributeProofs(ctx, denom, toAddr, missing, err)
 	}
 
 	return nil
 }
 
+// getCachedMarkerWithGrantsFor is the same as getMarkerWithGrantsFor except that it uses the
+// context's send restriction cache (if there is one). It's used so that the outputs of a multi-send
+// don't each have to look up the same markers and access grants.
+func (k Keeper) getCachedMarkerWithGrantsFor(ctx sdk.Context, markerAddr sdk.AccAddress, addrs ...sdk.AccAddress) (types.MarkerAccountI, error) {
+	cache := types.GetSendRestrictionCache(ctx)
+	if cache == nil {
+		return k.getMarkerWithGrantsFor(ctx, markerAddr, addrs...)
+	}
+
+	// The grants loaded depend on the addrs, so they're part of the key too.
+	key := make([]byte, 0, (1+len(addrs))*(1+len(markerAddr)))
+	for _, addr := range append([]sdk.AccAddress{markerAddr}, addrs...) {
+		key = append(key, address.MustLengthPrefix(addr)...)
+	}
+	if marker, found := cache.GetMarker(string(key)); found {
+		return marker, nil
+	}
+	marker, err := k.getMarkerWithGrantsFor(ctx, markerAddr, addrs...)
+	if err != nil {
+		return nil, err
+	}
+	cache.SetMarker(string(key), marker)
+	return marker, nil
+}
+
+// getCachedAttributes gets all the attributes of the provided address (with mirrored attributes),
+// using the context's send restriction cache (if there is one).
+func (k Keeper) getCachedAttributes(ctx sdk.Context, addr sdk.AccAddress) ([]attrTypes.Attribute, error) {
+	cache := types.GetSendRestrictionCache(ctx)
+	if attributes, found := cache.GetAttributes(addr); found {
+		return attributes, nil
+	}
+	attributes, err := k.attrKeeper.GetAllAttributesAddrWithMirror(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	cache.SetAttributes(addr, attributes)
+	return attributes, nil
+}
+
+// verifyAttributeProofs checks the attribute proofs in the context for the provided account.
+// If one of them is accepted by its verifier as proof that the account has all the missing attributes, it returns nil.
+// Otherwise, reqErr is returned, joined with any problems found with the account's proofs.
+func (k Keeper) verifyAttributeProofs(ctx sdk.Context, denom string, account sdk.AccAddress, missing []string, reqErr error) error {
+	errs := []error{reqErr}
+	for _, proof := range types.GetAttributeProofs(ctx) {
+		if !account.Equals(proof.Account) {
+			continue
+		}
+		verifier, found := k.attrProofVerifiers.Get(proof.Verifier)
+		if !found {
+			errs = append(errs, fmt.Errorf("unknown attribute proof verifier %q", proof.Verifier))
+			continue
+		}
+		err := verifier.VerifyAttributeProof(ctx, denom, account, missing, proof.Proof)
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("attribute proof rejected by %q: %w", proof.Verifier, err))
+	}
+	if len(errs) == 1 {
+		return reqErr
+	}
+	return errors.Join(errs...)
+}
+
 // findMissingAttributes returns all entries in required that don't pass
 // MatchAttribute on at least one of the provided attribute names.
 func findMissingAttributes(required []string, attributes []attrTypes.Attribute) []string {
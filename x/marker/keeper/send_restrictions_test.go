@@ -599,6 +599,28 @@ func TestSendRestrictionFn(t *testing.T) {
 			to:   addrWithAttrs,
 			amt:  cz(c(1, rDenomNoAttr)),
 		},
+		{
+			name: "with nested admins: outer one has transfer",
+			ctx:  ctxP(types.AddTransferAgents(types.WithTransferAgents(ctx, addrWithTransfer), addrOther)),
+			from: addrWithDenySend,
+			to:   addrWithAttrs,
+			amt:  cz(c(1, rDenomNoAttr)),
+		},
+		{
+			name: "with nested admins: inner one has transfer",
+			ctx:  ctxP(types.AddTransferAgents(types.WithTransferAgents(ctx, addrOther), addrWithTransfer)),
+			from: addrWithDenySend,
+			to:   addrWithAttrs,
+			amt:  cz(c(1, rDenomNoAttr)),
+		},
+		{
+			name:   "with nested admins: outer one with transfer was replaced",
+			ctx:    ctxP(types.WithTransferAgents(types.WithTransferAgents(ctx, addrWithTransfer), addrWithDeposit)),
+			from:   addrOther,
+			to:     addrWithAttrs,
+			amt:    cz(c(1, rDenomNoAttr)),
+			expErr: addrOther.String() + " does not have transfer permissions for " + rDenomNoAttr,
+		},
 		{
 			name:   "with two admins: neither has transfer",
 			ctx:    ctxP(types.WithTransferAgents(ctx, addrWithDeposit, addrWithWithdraw)),
@@ -875,6 +897,74 @@ func TestBankSendCoinsUsesSendRestrictionFn(t *testing.T) {
 	})
 }
 
+func TestBankSendCoinsToContractWithAttributeMirror(t *testing.T) {
+	cz := func(amt int64, denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+
+	markerDenom := "mirrorcoin"
+	addrNameOwner := sdk.AccAddress("name_owner__________")
+	addrHasWithdraw := sdk.AccAddress("has_withdraw________")
+	addrAdmin := sdk.AccAddress("contract_admin______")
+	addrContract := sdk.AccAddress("mirrored_contract___")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	// The wasm keeper is set on the app's attribute keeper; the copies held by other keepers need to see it too.
+	app.AttributeKeeper.SetWasmKeeper(NewMockWasmKeeper().WithContract(addrContract, addrAdmin))
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrNameOwner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "kyc.provenance.io", addrNameOwner, false), "SetNameRecord kyc.provenance.io")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          "kyc.provenance.io",
+			Value:         []byte("string value"),
+			Address:       addrAdmin.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		addrNameOwner,
+	), "SetAttribute kyc.provenance.io")
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(markerDenom, 1000),
+		Manager:     addrHasWithdraw.String(),
+		FromAddress: addrHasWithdraw.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{Address: addrHasWithdraw.String(), Permissions: types.AccessList{types.Access_Withdraw}},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+		RequiredAttributes:     []string{"kyc.provenance.io"},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, addrHasWithdraw, addrAdmin, markerDenom, cz(100, markerDenom)), "WithdrawCoins")
+
+	sendWithCache := func(fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = app.BankKeeper.SendCoins(cacheCtx, fromAddr, toAddr, amt)
+		if err == nil {
+			writeCache()
+		}
+		return err
+	}
+
+	t.Run("contract without mirror", func(t *testing.T) {
+		expErr := fmt.Sprintf("address %s does not contain the %q required attribute: \"kyc.provenance.io\"",
+			addrContract, markerDenom)
+		err = sendWithCache(addrAdmin, addrContract, cz(5, markerDenom))
+		assert.EqualError(t, err, expErr, "SendCoins")
+	})
+
+	t.Run("contract with mirror", func(t *testing.T) {
+		app.AttributeKeeper.SetAttributeMirror(ctx, addrContract, addrAdmin)
+		err = sendWithCache(addrAdmin, addrContract, cz(5, markerDenom))
+		assert.NoError(t, err, "SendCoins")
+		bal := app.BankKeeper.GetBalance(ctx, addrContract, markerDenom)
+		assert.Equal(t, cz(5, markerDenom).String(), bal.String(), "GetBalance addrContract")
+	})
+}
+
 func TestBankInputOutputCoinsUsesSendRestrictionFn(t *testing.T) {
 	// This test only checks that the marker SendRestrictionFn is applied during a InputOutputCoins.
 	// Testing of the actual SendRestrictionFn is assumed to be done elsewhere more extensively.
@@ -1049,6 +1139,201 @@ func TestBankInputOutputCoinsUsesSendRestrictionFn(t *testing.T) {
 	}
 }
 
+func TestSendRestrictionFnWithCache(t *testing.T) {
+	markerDenom := "cachecoin"
+	addrManager := sdk.AccAddress("addrManager_________")
+	addrSender := sdk.AccAddress("addrSender__________")
+	addrWithAttr := sdk.AccAddress("addrWithAttr________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(markerDenom, 5))
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrManager))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "cache.io", addrManager, false), "SetNameRecord cache.io")
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          "cache.io",
+			Value:         []byte("some value"),
+			Address:       addrWithAttr.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		addrManager,
+	), "SetAttribute cache.io on addrWithAttr")
+
+	markerAcct := types.NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(markerDenom)),
+		sdk.NewInt64Coin(markerDenom, 1000), addrManager,
+		[]types.AccessGrant{{Address: addrManager.String(), Permissions: types.AccessList{types.Access_Transfer}}},
+		types.StatusActive, types.MarkerType_RestrictedCoin, true, false, false, []string{"cache.io"},
+	)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, markerAcct), "AddMarkerAccount")
+
+	cachedCtx := types.WithSendRestrictionCache(ctx)
+	_, err := app.MarkerKeeper.SendRestrictionFn(cachedCtx, addrSender, addrWithAttr, coins)
+	require.NoError(t, err, "SendRestrictionFn with cache before attribute is deleted")
+
+	require.NoError(t, app.AttributeKeeper.DeleteAttribute(ctx, addrWithAttr.String(), "cache.io", nil, addrManager), "DeleteAttribute")
+	expErr := fmt.Sprintf("address %s does not contain the %q required attribute: %q", addrWithAttr, markerDenom, "cache.io")
+
+	// The cache still has the attributes from before they were deleted, which shows that it's being used.
+	_, err = app.MarkerKeeper.SendRestrictionFn(cachedCtx, addrSender, addrWithAttr, coins)
+	assert.NoError(t, err, "SendRestrictionFn with the same cache after attribute is deleted")
+
+	_, err = app.MarkerKeeper.SendRestrictionFn(types.WithSendRestrictionCache(ctx), addrSender, addrWithAttr, coins)
+	assert.EqualError(t, err, expErr, "SendRestrictionFn with a new cache after attribute is deleted")
+
+	_, err = app.MarkerKeeper.SendRestrictionFn(ctx, addrSender, addrWithAttr, coins)
+	assert.EqualError(t, err, expErr, "SendRestrictionFn without a cache after attribute is deleted")
+
+	// Outputs of a multi-send all get checked, even when the lookups come from the cache.
+	inputs := []banktypes.Input{{Address: addrSender.String(), Coins: coins.Add(coins...)}}
+	outputs := []banktypes.Output{
+		{Address: addrManager.String(), Coins: coins},
+		{Address: addrWithAttr.String(), Coins: coins},
+	}
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addrSender, inputs[0].Coins), "FundAccount")
+	err = app.BankKeeper.InputOutputCoinsProv(types.WithSendRestrictionCache(ctx), inputs, outputs)
+	expErr = fmt.Sprintf("address %s does not contain the %q required attribute: %q", addrManager, markerDenom, "cache.io")
+	assert.EqualError(t, err, expErr, "InputOutputCoinsProv with cache")
+}
+
+// mockAttrProofVerifier is an AttributeProofVerifier that only accepts the proof "valid".
+type mockAttrProofVerifier struct {
+	// reqAttrs are the required attributes provided to the last VerifyAttributeProof call.
+	reqAttrs []string
+}
+
+func (v *mockAttrProofVerifier) VerifyAttributeProof(_ sdk.Context, _ string, _ sdk.AccAddress, requiredAttributes []string, proof []byte) error {
+	v.reqAttrs = requiredAttributes
+	if string(proof) != "valid" {
+		return fmt.Errorf("invalid proof %q", string(proof))
+	}
+	return nil
+}
+
+func TestSendRestrictionFnWithAttributeProofs(t *testing.T) {
+	cz := func(amt int64, denom string) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amt))
+	}
+
+	markerDenom := "zkcoin"
+	kycAttr := "kyc.provenance.io"
+	amlAttr := "aml.provenance.io"
+
+	addrNameOwner := sdk.AccAddress("name_owner__________")
+	addrHasWithdraw := sdk.AccAddress("has_withdraw________")
+	addrHasKYC := sdk.AccAddress("has_kyc_attribute___")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrNameOwner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, kycAttr, addrNameOwner, false), "SetNameRecord %s", kycAttr)
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, amlAttr, addrNameOwner, false), "SetNameRecord %s", amlAttr)
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          kycAttr,
+			Value:         []byte("string value"),
+			Address:       addrHasKYC.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		addrNameOwner,
+	), "SetAttribute %s", kycAttr)
+
+	makeMarkerMsg := &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(markerDenom, 1000),
+		Manager:     addrHasWithdraw.String(),
+		FromAddress: addrHasWithdraw.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{Address: addrHasWithdraw.String(), Permissions: types.AccessList{types.Access_Withdraw}},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+		RequiredAttributes:     []string{kycAttr, amlAttr},
+	}
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, makeMarkerMsg)
+	require.NoError(t, err, "makeMarkerMsg")
+	err = app.MarkerKeeper.WithdrawCoins(ctx, addrHasWithdraw, addrOther, markerDenom, cz(100, markerDenom))
+	require.NoError(t, err, "WithdrawCoins to addrOther")
+
+	// The verifier is registered after the keeper was given to the bank module; it should still be used.
+	verifier := &mockAttrProofVerifier{}
+	require.NoError(t, app.MarkerKeeper.RegisterAttributeProofVerifier("mockzk", verifier), "RegisterAttributeProofVerifier")
+	err = app.MarkerKeeper.RegisterAttributeProofVerifier("mockzk", verifier)
+	require.EqualError(t, err, `attribute proof verifier "mockzk" is already registered`, "RegisterAttributeProofVerifier again")
+
+	reqErr := func(addr sdk.AccAddress, missing string) string {
+		return fmt.Sprintf("address %s does not contain the %q required attributes: %s", addr, markerDenom, missing)
+	}
+
+	tests := []struct {
+		name        string
+		toAddr      sdk.AccAddress
+		proofs      []types.AttributeProof
+		expErr      string
+		expReqAttrs []string
+	}{
+		{
+			name:   "no proofs",
+			toAddr: addrOther,
+			expErr: reqErr(addrOther, `"kyc.provenance.io", "aml.provenance.io"`),
+		},
+		{
+			name:   "proof for a different account",
+			toAddr: addrOther,
+			proofs: []types.AttributeProof{types.NewAttributeProof("mockzk", addrHasKYC, []byte("valid"))},
+			expErr: reqErr(addrOther, `"kyc.provenance.io", "aml.provenance.io"`),
+		},
+		{
+			name:   "unknown verifier",
+			toAddr: addrOther,
+			proofs: []types.AttributeProof{types.NewAttributeProof("unknownzk", addrOther, []byte("valid"))},
+			expErr: reqErr(addrOther, `"kyc.provenance.io", "aml.provenance.io"`) + "\n" +
+				`unknown attribute proof verifier "unknownzk"`,
+		},
+		{
+			name:   "rejected proof",
+			toAddr: addrOther,
+			proofs: []types.AttributeProof{types.NewAttributeProof("mockzk", addrOther, []byte("bad"))},
+			expErr: reqErr(addrOther, `"kyc.provenance.io", "aml.provenance.io"`) + "\n" +
+				`attribute proof rejected by "mockzk": invalid proof "bad"`,
+			expReqAttrs: []string{kycAttr, amlAttr},
+		},
+		{
+			name:   "rejected proof then accepted proof",
+			toAddr: addrOther,
+			proofs: []types.AttributeProof{
+				types.NewAttributeProof("mockzk", addrOther, []byte("bad")),
+				types.NewAttributeProof("mockzk", addrOther, []byte("valid")),
+			},
+			expReqAttrs: []string{kycAttr, amlAttr},
+		},
+		{
+			name:        "only the missing attributes are given to the verifier",
+			toAddr:      addrHasKYC,
+			proofs:      []types.AttributeProof{types.NewAttributeProof("mockzk", addrHasKYC, []byte("valid"))},
+			expReqAttrs: []string{amlAttr},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier.reqAttrs = nil
+			cacheCtx, _ := ctx.CacheContext()
+			cacheCtx = types.WithAttributeProofs(cacheCtx, tc.proofs...)
+			err = app.BankKeeper.SendCoins(cacheCtx, addrOther, tc.toAddr, cz(5, markerDenom))
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "SendCoins")
+			} else {
+				assert.NoError(t, err, "SendCoins")
+			}
+			assert.Equal(t, tc.expReqAttrs, verifier.reqAttrs, "required attributes given to the verifier")
+		})
+	}
+}
+
 func TestNormalizeRequiredAttributes(t *testing.T) {
 	app := simapp.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	internalsdk "github.com/provenance-io/provenance/internal/sdk"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SimulateSend runs the marker module's send restrictions for a bank send without changing any state.
+// It returns the reasons the send would be denied, each with hints on what could be done about it.
+// Denials that apply to the whole send (e.g. sending from a marker account) have an empty denom.
+func (k Keeper) SimulateSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) []types.SendDenial {
+	cacheCtx := newSendSimulationContext(ctx)
+
+	var rv []types.SendDenial
+	var sendErr string
+	if _, err := k.SendRestrictionFn(cacheCtx, fromAddr, toAddr, sdk.Coins{}); err != nil {
+		sendErr = err.Error()
+		rv = append(rv, types.SendDenial{
+			Reason: sendErr,
+			Hints:  k.sendDenialHints(cacheCtx, fromAddr, toAddr),
+		})
+	}
+
+	for _, coin := range amt {
+		_, err := k.SendRestrictionFn(cacheCtx, fromAddr, toAddr, sdk.Coins{coin})
+		if err == nil || err.Error() == sendErr {
+			continue
+		}
+		rv = append(rv, types.SendDenial{
+			Denom:  coin.Denom,
+			Reason: err.Error(),
+			Hints:  k.denomSendDenialHints(cacheCtx, fromAddr, toAddr, coin.Denom),
+		})
+	}
+	return rv
+}
+
+// newSendSimulationContext returns a context that the send restrictions can be run with without changing any state.
+func newSendSimulationContext(ctx sdk.Context) sdk.Context {
+	// The restriction function can change state (e.g. unfreezing an account), so only a cache context is used.
+	// Anything in the context that would change the outcome is removed so that the result only depends on state.
+	// This keeps it the same no matter where it's called from, e.g. by a smart contract during a transaction.
+	cacheCtx, _ := ctx.CacheContext()
+	return types.WithoutBypass(types.WithoutTransferAgents(internalsdk.WithoutFeeGrantInUse(cacheCtx)))
+}
+
+// GetTransferRestriction returns the ERC-1404 style restriction code for a bank send of the coin, without changing any state.
+// It also returns the reason the send is restricted, which is empty when the code is TransferRestrictionNone.
+// Unlike SimulateSend, the sender's spendable balance is also checked.
+func (k Keeper) GetTransferRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) (types.TransferRestrictionCode, string) {
+	cacheCtx := newSendSimulationContext(ctx)
+
+	if _, err := k.SendRestrictionFn(cacheCtx, fromAddr, toAddr, sdk.Coins{}); err != nil {
+		return k.sendRestrictionCode(cacheCtx, fromAddr, toAddr), err.Error()
+	}
+	if _, err := k.SendRestrictionFn(cacheCtx, fromAddr, toAddr, sdk.Coins{coin}); err != nil {
+		return k.denomRestrictionCode(cacheCtx, fromAddr, toAddr, coin.Denom), err.Error()
+	}
+
+	spendable := k.bankKeeper.SpendableCoin(ctx, fromAddr, coin.Denom)
+	if spendable.Amount.LT(coin.Amount) {
+		return types.TransferRestrictionInsufficientFunds, fmt.Sprintf("spendable balance %s is smaller than %s", spendable, coin)
+	}
+	return types.TransferRestrictionNone, ""
+}
+
+// sendRestrictionCode returns the restriction code for a send that is denied regardless of the denoms being sent.
+func (k Keeper) sendRestrictionCode(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress) types.TransferRestrictionCode {
+	switch {
+	case k.IsMarkerAddress(ctx, fromAddr):
+		return types.TransferRestrictionFromMarker
+	case k.IsMarkerAddress(ctx, toAddr):
+		return types.TransferRestrictionToMarker
+	default:
+		return types.TransferRestrictionOther
+	}
+}
+
+// denomRestrictionCode returns the restriction code for a send of a denom that is denied.
+// The checks are done in the same order that the send restrictions do them.
+func (k Keeper) denomRestrictionCode(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, denom string) types.TransferRestrictionCode {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil || marker == nil {
+		return types.TransferRestrictionOther
+	}
+	markerAddr := marker.GetAddress()
+	switch {
+	case marker.GetStatus() != types.StatusActive:
+		return types.TransferRestrictionMarkerNotActive
+	case toAddr.Equals(k.feeCollectorAddr):
+		return types.TransferRestrictionFeeCollector
+	case k.IsSendDeny(ctx, markerAddr, fromAddr):
+		return types.TransferRestrictionSenderDenied
+	case k.IsAccountFrozen(ctx, markerAddr, fromAddr):
+		return types.TransferRestrictionSenderFrozen
+	case k.IsMarkerAddress(ctx, toAddr), len(marker.GetRequiredAttributes()) == 0:
+		return types.TransferRestrictionNoTransferAccess
+	default:
+		return types.TransferRestrictionRecipientAttributes
+	}
+}
+
+// sendDenialHints returns suggestions for a send that is denied regardless of the denoms being sent.
+func (k Keeper) sendDenialHints(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress) []string {
+	var rv []string
+	if fromMarker, _ := k.GetMarker(ctx, fromAddr); fromMarker != nil {
+		rv = append(rv, fmt.Sprintf("coins can only be taken out of the %s marker account by an account with %s on it, using the marker withdraw command",
+			fromMarker.GetDenom(), types.Access_Withdraw))
+	}
+	toMarker, _ := k.GetMarker(ctx, toAddr)
+	if toMarker != nil && toMarker.GetMarkerType() == types.MarkerType_RestrictedCoin && !toMarker.AddressHasAccess(fromAddr, types.Access_Deposit) {
+		rv = append(rv, fmt.Sprintf("sender needs %s on the %s marker to send coins to it", types.Access_Deposit, toMarker.GetDenom()))
+	}
+	return rv
+}
+
+// denomSendDenialHints returns suggestions for a send of a denom that is denied.
+// The checks are done in the same order that the send restrictions do them.
+func (k Keeper) denomSendDenialHints(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, denom string) []string {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil || marker == nil {
+		return nil
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return []string{fmt.Sprintf("the %s marker must be activated before its coin can be sent", denom)}
+	}
+	if toAddr.Equals(k.feeCollectorAddr) {
+		return []string{fmt.Sprintf("%s is a restricted coin and cannot be used to pay fees", denom)}
+	}
+
+	markerAddr := marker.GetAddress()
+	if k.IsSendDeny(ctx, markerAddr, fromAddr) {
+		return []string{fmt.Sprintf("an account with %s on the %s marker must remove the sender from its send-deny list",
+			types.Access_Transfer, denom)}
+	}
+	if k.IsAccountFrozen(ctx, markerAddr, fromAddr) {
+		var rv []string
+		attributes, err := k.attrKeeper.GetAllAttributesAddrWithMirror(ctx, fromAddr)
+		if err == nil {
+			for _, attr := range findMissingAttributes(marker.GetRequiredAttributes(), attributes) {
+				rv = append(rv, fmt.Sprintf("sender missing attribute %s", attr))
+			}
+		}
+		return append(rv, fmt.Sprintf("the sender is frozen for %s until it has all of the marker's required attributes again", denom))
+	}
+
+	transferHint := fmt.Sprintf("an account with %s on the %s marker can move the coins using the marker transfer command",
+		types.Access_Transfer, denom)
+	if k.IsMarkerAddress(ctx, toAddr) {
+		return []string{
+			fmt.Sprintf("sender needs %s on the %s marker to send its coin to a marker account", types.Access_Transfer, denom),
+			transferHint,
+		}
+	}
+	reqAttrs := marker.GetRequiredAttributes()
+	if len(reqAttrs) == 0 {
+		return []string{
+			fmt.Sprintf("the %s marker does not have any required attributes, so only accounts with %s on it can send its coin",
+				denom, types.Access_Transfer),
+			transferHint,
+		}
+	}
+
+	attributes, err := k.attrKeeper.GetAllAttributesAddrWithMirror(ctx, toAddr)
+	if err != nil {
+		return nil
+	}
+	var rv []string
+	for _, attr := range findMissingAttributes(reqAttrs, attributes) {
+		rv = append(rv, fmt.Sprintf("recipient missing attribute %s", attr))
+	}
+	return rv
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	attrTypes "github.com/provenance-io/provenance/x/attribute/types"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestCanSend(t *testing.T) {
+	const attrName = "kyc.acme.io"
+	restrictedDenom := "restrictedcoin"
+	coinDenom := "plaincoin"
+
+	addrNameOwner := sdk.AccAddress("name_owner__________")
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrHolder := sdk.AccAddress("holder______________")
+	addrKYC := sdk.AccAddress("kyc_address_________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrNameOwner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, attrName, addrNameOwner, false), "SetNameRecord %s", attrName)
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          attrName,
+			Value:         []byte("string value"),
+			Address:       addrKYC.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		addrNameOwner,
+	), "SetAttribute %s", attrName)
+
+	for _, denom := range []string{restrictedDenom, coinDenom} {
+		markerType := types.MarkerType_Coin
+		var reqAttrs []string
+		if denom == restrictedDenom {
+			markerType = types.MarkerType_RestrictedCoin
+			reqAttrs = []string{attrName}
+		}
+		_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+			Amount:      sdk.NewInt64Coin(denom, 1000),
+			Manager:     addrAdmin.String(),
+			FromAddress: addrAdmin.String(),
+			MarkerType:  markerType,
+			AccessList: []types.AccessGrant{
+				{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw, types.Access_Transfer}},
+			},
+			SupplyFixed:        true,
+			RequiredAttributes: reqAttrs,
+		})
+		require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	}
+	restrictedAddr := types.MustGetMarkerAddress(restrictedDenom)
+	app.MarkerKeeper.AddSendDeny(ctx, restrictedAddr, addrOther)
+
+	canSend := func(from, to sdk.AccAddress, amount string) *types.QueryCanSendResponse {
+		resp, err := app.MarkerKeeper.CanSend(ctx, &types.QueryCanSendRequest{
+			FromAddress: from.String(),
+			ToAddress:   to.String(),
+			Amount:      amount,
+		})
+		require.NoError(t, err, "CanSend(%s, %s, %q)", from, to, amount)
+		return resp
+	}
+
+	t.Run("allowed", func(t *testing.T) {
+		resp := canSend(addrHolder, addrKYC, "5"+restrictedDenom+",5"+coinDenom+",5nhash")
+		assert.True(t, resp.Allowed, "allowed")
+		assert.Empty(t, resp.Denials, "denials")
+	})
+
+	t.Run("recipient missing attribute", func(t *testing.T) {
+		resp := canSend(addrHolder, addrOther, "5"+restrictedDenom+",5"+coinDenom)
+		assert.False(t, resp.Allowed, "allowed")
+		expDenials := []types.SendDenial{{
+			Denom:  restrictedDenom,
+			Reason: fmt.Sprintf("address %s does not contain the %q required attribute: \"%s\"", addrOther, restrictedDenom, attrName),
+			Hints:  []string{"recipient missing attribute " + attrName},
+		}}
+		assert.Equal(t, expDenials, resp.Denials, "denials")
+	})
+
+	t.Run("sender on deny list", func(t *testing.T) {
+		resp := canSend(addrOther, addrKYC, "5"+restrictedDenom)
+		assert.False(t, resp.Allowed, "allowed")
+		expDenials := []types.SendDenial{{
+			Denom:  restrictedDenom,
+			Reason: fmt.Sprintf("%s is on deny list for sending restricted marker", addrOther),
+			Hints:  []string{"an account with ACCESS_TRANSFER on the restrictedcoin marker must remove the sender from its send-deny list"},
+		}}
+		assert.Equal(t, expDenials, resp.Denials, "denials")
+	})
+
+	t.Run("from a marker account", func(t *testing.T) {
+		resp := canSend(restrictedAddr, addrKYC, "5"+coinDenom)
+		assert.False(t, resp.Allowed, "allowed")
+		expDenials := []types.SendDenial{{
+			Reason: fmt.Sprintf("cannot withdraw from marker account %s (%s)", restrictedAddr, restrictedDenom),
+			Hints:  []string{"coins can only be taken out of the restrictedcoin marker account by an account with ACCESS_WITHDRAW on it, using the marker withdraw command"},
+		}}
+		assert.Equal(t, expDenials, resp.Denials, "denials")
+	})
+
+	t.Run("bypass and transfer agents in context are ignored", func(t *testing.T) {
+		agentCtx := types.WithBypass(types.WithTransferAgents(ctx, addrAdmin))
+		resp, err := app.MarkerKeeper.CanSend(agentCtx, &types.QueryCanSendRequest{
+			FromAddress: addrHolder.String(),
+			ToAddress:   addrOther.String(),
+			Amount:      "5" + restrictedDenom,
+		})
+		require.NoError(t, err, "CanSend")
+		assert.Equal(t, canSend(addrHolder, addrOther, "5"+restrictedDenom), resp, "CanSend with bypass and transfer agents")
+		assert.False(t, resp.Allowed, "allowed")
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		_, err := app.MarkerKeeper.CanSend(ctx, &types.QueryCanSendRequest{
+			FromAddress: addrHolder.String(),
+			ToAddress:   addrKYC.String(),
+			Amount:      "5",
+		})
+		assert.ErrorContains(t, err, "invalid amount", "CanSend")
+	})
+}
+
+func TestDetectTransferRestriction(t *testing.T) {
+	const attrName = "kyc.acme.io"
+	restrictedDenom := "restrictedcoin"
+	proposedDenom := "proposedcoin"
+
+	addrNameOwner := sdk.AccAddress("name_owner__________")
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrHolder := sdk.AccAddress("holder______________")
+	addrKYC := sdk.AccAddress("kyc_address_________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrNameOwner))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, attrName, addrNameOwner, false), "SetNameRecord %s", attrName)
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrTypes.Attribute{
+			Name:          attrName,
+			Value:         []byte("string value"),
+			Address:       addrKYC.String(),
+			AttributeType: attrTypes.AttributeType_String,
+		},
+		addrNameOwner,
+	), "SetAttribute %s", attrName)
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(restrictedDenom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw, types.Access_Transfer}},
+		},
+		SupplyFixed:        true,
+		RequiredAttributes: []string{attrName},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", restrictedDenom)
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, types.NewEmptyMarkerAccount(proposedDenom, addrAdmin.String(), nil)),
+		"AddMarkerAccount %s", proposedDenom)
+
+	restrictedAddr := types.MustGetMarkerAddress(restrictedDenom)
+	app.MarkerKeeper.AddSendDeny(ctx, restrictedAddr, addrOther)
+	funds := sdk.NewCoins(sdk.NewInt64Coin(restrictedDenom, 10), sdk.NewInt64Coin(proposedDenom, 10), sdk.NewInt64Coin("nhash", 10))
+	for _, addr := range []sdk.AccAddress{addrHolder, addrOther} {
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addr, funds), "FundAccount %s", addr)
+	}
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	tests := []struct {
+		name      string
+		from      sdk.AccAddress
+		to        sdk.AccAddress
+		amount    string
+		expCode   types.TransferRestrictionCode
+		expReason string
+	}{
+		{
+			name:    "allowed",
+			from:    addrHolder,
+			to:      addrKYC,
+			amount:  "5" + restrictedDenom,
+			expCode: types.TransferRestrictionNone,
+		},
+		{
+			name:      "insufficient funds",
+			from:      addrHolder,
+			to:        addrKYC,
+			amount:    "11" + restrictedDenom,
+			expCode:   types.TransferRestrictionInsufficientFunds,
+			expReason: "spendable balance 10restrictedcoin is smaller than 11restrictedcoin",
+		},
+		{
+			name:      "from a marker account",
+			from:      restrictedAddr,
+			to:        addrKYC,
+			amount:    "5nhash",
+			expCode:   types.TransferRestrictionFromMarker,
+			expReason: fmt.Sprintf("cannot withdraw from marker account %s (%s)", restrictedAddr, restrictedDenom),
+		},
+		{
+			name:      "to a restricted marker account",
+			from:      addrHolder,
+			to:        restrictedAddr,
+			amount:    "5nhash",
+			expCode:   types.TransferRestrictionToMarker,
+			expReason: fmt.Sprintf("%s does not have ACCESS_DEPOSIT on %s marker (%s)", addrHolder, restrictedDenom, restrictedAddr),
+		},
+		{
+			name:      "marker not active",
+			from:      addrHolder,
+			to:        addrKYC,
+			amount:    "5" + proposedDenom,
+			expCode:   types.TransferRestrictionMarkerNotActive,
+			expReason: "cannot send proposedcoin coins: marker status (proposed) is not active",
+		},
+		{
+			name:      "to the fee collector",
+			from:      addrHolder,
+			to:        feeCollector,
+			amount:    "5" + restrictedDenom,
+			expCode:   types.TransferRestrictionFeeCollector,
+			expReason: "restricted denom restrictedcoin cannot be sent to the fee collector",
+		},
+		{
+			name:      "sender on deny list",
+			from:      addrOther,
+			to:        addrKYC,
+			amount:    "5" + restrictedDenom,
+			expCode:   types.TransferRestrictionSenderDenied,
+			expReason: fmt.Sprintf("%s is on deny list for sending restricted marker", addrOther),
+		},
+		{
+			name:      "recipient missing attribute",
+			from:      addrHolder,
+			to:        addrOther,
+			amount:    "5" + restrictedDenom,
+			expCode:   types.TransferRestrictionRecipientAttributes,
+			expReason: fmt.Sprintf("address %s does not contain the %q required attribute: \"%s\"", addrOther, restrictedDenom, attrName),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := app.MarkerKeeper.DetectTransferRestriction(ctx, &types.QueryDetectTransferRestrictionRequest{
+				FromAddress: tc.from.String(),
+				ToAddress:   tc.to.String(),
+				Amount:      tc.amount,
+			})
+			require.NoError(t, err, "DetectTransferRestriction")
+			assert.Equal(t, uint32(tc.expCode), resp.Code, "code")
+			assert.Equal(t, tc.expCode.Message(), resp.Message, "message")
+			assert.Equal(t, tc.expReason, resp.Reason, "reason")
+		})
+	}
+
+	t.Run("invalid amount", func(t *testing.T) {
+		_, err := app.MarkerKeeper.DetectTransferRestriction(ctx, &types.QueryDetectTransferRestrictionRequest{
+			FromAddress: addrHolder.String(),
+			ToAddress:   addrKYC.String(),
+			Amount:      "5" + restrictedDenom + ",5nhash",
+		})
+		assert.ErrorContains(t, err, "invalid amount", "DetectTransferRestriction")
+	})
+
+	t.Run("message", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.TransferRestrictionMessage(ctx, &types.QueryTransferRestrictionMessageRequest{Code: 6})
+		require.NoError(t, err, "TransferRestrictionMessage")
+		assert.Equal(t, "sender is on the marker's send-deny list", resp.Message, "message")
+	})
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetSubLedgerEntry records an entry in a custodian's sub-ledger. Any existing entry with the same custodian and id is replaced.
+func (k Keeper) SetSubLedgerEntry(ctx sdk.Context, entry types.SubLedgerEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+	custodian, err := sdk.AccAddressFromBech32(entry.Custodian)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SubLedgerEntryKey(custodian, entry.Id), bz)
+	store.Set(types.SubLedgerSubAccountIndexKey(custodian, entry.SubAccount, entry.Id), []byte{})
+	return nil
+}
+
+// GetSubLedgerEntry returns the entry with the provided id from a custodian's sub-ledger, or nil if it doesn't exist.
+func (k Keeper) GetSubLedgerEntry(ctx sdk.Context, custodian sdk.AccAddress, id uint64) (*types.SubLedgerEntry, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SubLedgerEntryKey(custodian, id))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var entry types.SubLedgerEntry
+	if err := k.cdc.Unmarshal(bz, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetSubLedgerEntries returns all of the entries in a custodian's sub-ledger, ordered by id.
+func (k Keeper) GetSubLedgerEntries(ctx sdk.Context, custodian sdk.AccAddress) ([]types.SubLedgerEntry, error) {
+	var rv []types.SubLedgerEntry
+	err := k.iterateSubLedgerEntries(ctx, types.SubLedgerEntryKeyPrefix(custodian), func(entry types.SubLedgerEntry) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv, err
+}
+
+// IterateSubLedgerEntries iterates over all entries of all custodians' sub-ledgers.
+func (k Keeper) IterateSubLedgerEntries(ctx sdk.Context, cb func(entry types.SubLedgerEntry) (stop bool)) error {
+	return k.iterateSubLedgerEntries(ctx, types.SubLedgerEntryPrefix, cb)
+}
+
+// iterateSubLedgerEntries iterates over the sub-ledger entries with keys that have the provided prefix.
+func (k Keeper) iterateSubLedgerEntries(ctx sdk.Context, prefix []byte, cb func(entry types.SubLedgerEntry) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var entry types.SubLedgerEntry
+		if err := k.cdc.Unmarshal(it.Value(), &entry); err != nil {
+			return err
+		}
+		if cb(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// lastSubLedgerEntryID returns the id of the most recent entry in a custodian's sub-ledger.
+func (k Keeper) lastSubLedgerEntryID(ctx sdk.Context, custodian sdk.AccAddress) uint64 {
+	prefix := types.SubLedgerEntryKeyPrefix(custodian)
+	it := storetypes.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return binary.BigEndian.Uint64(it.Key()[len(prefix):])
+}
+
+// recordSubLedgerTransfer records a tagged transfer in the sub-ledger of the from address (if the tag has a from
+// sub-account) and the sub-ledger of the to address (if the tag has a to sub-account).
+func (k Keeper) recordSubLedgerTransfer(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coin, tag *types.SubAccountTag) error {
+	if tag == nil {
+		return nil
+	}
+	if len(tag.FromSubAccount) > 0 {
+		if err := k.recordSubLedgerEntry(ctx, from, tag.FromSubAccount, amount, false, to); err != nil {
+			return err
+		}
+	}
+	if len(tag.ToSubAccount) > 0 {
+		if err := k.recordSubLedgerEntry(ctx, to, tag.ToSubAccount, amount, true, from); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordSubLedgerEntry records the movement of funds into (credit) or out of one of a custodian's sub-accounts
+// as the next entry in its sub-ledger.
+func (k Keeper) recordSubLedgerEntry(ctx sdk.Context, custodian sdk.AccAddress, subAccount string, amount sdk.Coin, credit bool, counterparty sdk.AccAddress) error {
+	entry := types.SubLedgerEntry{
+		Custodian:    custodian.String(),
+		Id:           k.lastSubLedgerEntryID(ctx, custodian) + 1,
+		SubAccount:   subAccount,
+		Amount:       amount,
+		Credit:       credit,
+		Counterparty: counterparty.String(),
+		RecordedAt:   ctx.BlockTime().UTC(),
+		Height:       ctx.BlockHeight(),
+	}
+	if err := k.SetSubLedgerEntry(ctx, entry); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerSubLedgerEntryRecorded{
+		Custodian:    entry.Custodian,
+		Id:           entry.Id,
+		SubAccount:   entry.SubAccount,
+		Amount:       entry.Amount.String(),
+		Credit:       entry.Credit,
+		Counterparty: entry.Counterparty,
+	})
+}
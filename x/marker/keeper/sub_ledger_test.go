@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSubLedger(t *testing.T) {
+	const denom = "omnibuscoin"
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrCustodian := sdk.AccAddress("custodian___________")
+	addrOther := sdk.AccAddress("other_______________")
+	blockTime := time.Date(2026, 4, 1, 9, 30, 0, 0, time.UTC)
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(7).WithBlockTime(blockTime)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Transfer}},
+			{Address: addrCustodian.String(), Permissions: types.AccessList{types.Access_Transfer}},
+		},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+	require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addrAdmin, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "FundAccount admin")
+
+	transfer := func(admin, from, to sdk.AccAddress, amount int64, tag *types.SubAccountTag) {
+		t.Helper()
+		msg := types.NewMsgTransferRequest(admin, from, to, sdk.NewInt64Coin(denom, amount))
+		msg.SubAccountTag = tag
+		_, terr := msgServer.Transfer(ctx, msg)
+		require.NoError(t, terr, "Transfer %d from %s to %s", amount, from, to)
+	}
+	transfer(addrAdmin, addrAdmin, addrCustodian, 30, &types.SubAccountTag{ToSubAccount: "client-1"})
+	transfer(addrAdmin, addrAdmin, addrCustodian, 20, &types.SubAccountTag{ToSubAccount: "client-2"})
+	transfer(addrAdmin, addrAdmin, addrCustodian, 10, nil)
+	transfer(addrCustodian, addrCustodian, addrOther, 5, &types.SubAccountTag{FromSubAccount: "client-1"})
+
+	newEntry := func(id uint64, subAccount string, amount int64, credit bool, counterparty sdk.AccAddress) types.SubLedgerEntry {
+		return types.SubLedgerEntry{
+			Custodian:    addrCustodian.String(),
+			Id:           id,
+			SubAccount:   subAccount,
+			Amount:       sdk.NewInt64Coin(denom, amount),
+			Credit:       credit,
+			Counterparty: counterparty.String(),
+			RecordedAt:   blockTime,
+			Height:       7,
+		}
+	}
+	expEntries := []types.SubLedgerEntry{
+		newEntry(1, "client-1", 30, true, addrAdmin),
+		newEntry(2, "client-2", 20, true, addrAdmin),
+		newEntry(3, "client-1", 5, false, addrOther),
+	}
+
+	t.Run("query", func(t *testing.T) {
+		_, qerr := app.MarkerKeeper.SubLedger(ctx, nil)
+		assert.EqualError(t, qerr, "rpc error: code = InvalidArgument desc = invalid request", "SubLedger(nil)")
+
+		_, qerr = app.MarkerKeeper.SubLedger(ctx, &types.QuerySubLedgerRequest{Custodian: "bad"})
+		assert.ErrorContains(t, qerr, "invalid custodian", "SubLedger bad custodian")
+
+		resp, qerr := app.MarkerKeeper.SubLedger(ctx, &types.QuerySubLedgerRequest{Custodian: addrCustodian.String()})
+		require.NoError(t, qerr, "SubLedger all sub-accounts")
+		assert.Equal(t, expEntries, resp.Entries, "SubLedger all sub-accounts")
+
+		resp, qerr = app.MarkerKeeper.SubLedger(ctx, &types.QuerySubLedgerRequest{Custodian: addrCustodian.String(), SubAccount: "client-1"})
+		require.NoError(t, qerr, "SubLedger client-1")
+		assert.Equal(t, []types.SubLedgerEntry{expEntries[0], expEntries[2]}, resp.Entries, "SubLedger client-1")
+
+		resp, qerr = app.MarkerKeeper.SubLedger(ctx, &types.QuerySubLedgerRequest{Custodian: addrAdmin.String()})
+		require.NoError(t, qerr, "SubLedger of an untagged account")
+		assert.Empty(t, resp.Entries, "SubLedger of an untagged account")
+	})
+
+	t.Run("genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Equal(t, expEntries, genState.SubLedgerEntries, "exported sub-ledger entries")
+		assert.NoError(t, genState.Validate(), "exported genesis Validate()")
+	})
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetSupplyChangePolicy stores the limit on how much the mints and burns of a single block can change a marker's supply.
+// A policy without a max change is removed from state.
+func (k Keeper) SetSupplyChangePolicy(ctx sdk.Context, policy types.SupplyChangePolicy) error {
+	markerAddr, err := types.MarkerAddress(policy.Denom)
+	if err != nil {
+		return err
+	}
+	if policy.IsEmpty() {
+		k.RemoveSupplyChangePolicy(ctx, markerAddr)
+		return nil
+	}
+	if err = policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.SupplyChangePolicyKey(markerAddr), bz)
+	return nil
+}
+
+// GetSupplyChangePolicy returns the supply change policy of the marker with the provided address.
+// Returns nil if the marker does not have one.
+func (k Keeper) GetSupplyChangePolicy(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.SupplyChangePolicy, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyChangePolicyKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var policy types.SupplyChangePolicy
+	if err := k.cdc.Unmarshal(bz, &policy); err != nil {
+		return nil, fmt.Errorf("could not read supply change policy for %s: %w", markerAddr, err)
+	}
+	return &policy, nil
+}
+
+// RemoveSupplyChangePolicy removes the supply change policy of the marker with the provided address, along with
+// how much its supply has changed by in the current block.
+func (k Keeper) RemoveSupplyChangePolicy(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SupplyChangePolicyKey(markerAddr))
+	store.Delete(types.SupplyChangeUsageKey(markerAddr))
+}
+
+// IterateSupplyChangePolicies iterates over all of the markers' supply change policies.
+func (k Keeper) IterateSupplyChangePolicies(ctx sdk.Context, cb func(policy types.SupplyChangePolicy) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SupplyChangePolicyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var policy types.SupplyChangePolicy
+		if err := k.cdc.Unmarshal(it.Value(), &policy); err != nil {
+			return err
+		}
+		if cb(policy) {
+			break
+		}
+	}
+	return nil
+}
+
+// ValidateSupplyChange returns an error if minting or burning the provided coin, together with the marker's other mints
+// and burns in the current block, would change its supply by more than its supply change policy allows. Such changes
+// must be made through a governance proposal.
+func (k Keeper) ValidateSupplyChange(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
+	_, err := k.checkSupplyChange(ctx, marker, coin)
+	return err
+}
+
+// checkSupplyChange returns the marker's supply change usage for the current block updated with the provided coin,
+// or an error if that would exceed the marker's supply change policy. Returns nil if there is nothing to track:
+// the marker is not active, it does not have a policy, or the context bypasses policies.
+func (k Keeper) checkSupplyChange(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) (*types.SupplyChangeUsage, error) {
+	if marker.GetStatus() != types.StatusActive || types.HasSupplyChangePolicyBypass(ctx) {
+		return nil, nil
+	}
+	policy, err := k.GetSupplyChangePolicy(ctx, marker.GetAddress())
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	usage, err := k.getSupplyChangeUsage(ctx, marker)
+	if err != nil {
+		return nil, err
+	}
+	limit := policy.MaxChangeAmount(usage.BaseSupply)
+	if changed := usage.Changed.Add(coin.Amount); changed.GT(limit) {
+		return nil, fmt.Errorf("%s exceeds the %s%s that can still be minted or burned this block without a governance proposal (max change %s of supply %s, already changed by %s)",
+			coin, limit.Sub(usage.Changed), coin.Denom, policy.MaxChange, usage.BaseSupply, usage.Changed)
+	}
+	usage.Changed = usage.Changed.Add(coin.Amount)
+	return usage, nil
+}
+
+// getSupplyChangeUsage returns how much the marker's supply has been changed by in the current block.
+func (k Keeper) getSupplyChangeUsage(ctx sdk.Context, marker types.MarkerAccountI) (*types.SupplyChangeUsage, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.SupplyChangeUsageKey(marker.GetAddress()))
+	if len(bz) > 0 {
+		usage, err := types.ParseSupplyChangeUsage(bz)
+		if err != nil {
+			return nil, fmt.Errorf("could not read supply change usage for %s: %w", marker.GetDenom(), err)
+		}
+		if usage.Height == ctx.BlockHeight() {
+			return &usage, nil
+		}
+	}
+	return &types.SupplyChangeUsage{
+		Height:     ctx.BlockHeight(),
+		BaseSupply: k.CurrentCirculation(ctx, marker),
+		Changed:    sdkmath.ZeroInt(),
+	}, nil
+}
+
+// setSupplyChangeUsage stores how much a marker's supply has been changed by in the current block.
+// Nothing is stored if the usage is nil.
+func (k Keeper) setSupplyChangeUsage(ctx sdk.Context, marker types.MarkerAccountI, usage *types.SupplyChangeUsage) {
+	if usage != nil {
+		ctx.KVStore(k.storeKey).Set(types.SupplyChangeUsageKey(marker.GetAddress()), usage.Bytes())
+	}
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSupplyChangePolicy(t *testing.T) {
+	const denom = "hotdog"
+
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrOther := sdk.AccAddress("other_address_______")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	queryServer := app.MarkerKeeper
+	authority := app.MarkerKeeper.GetAuthority()
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin(denom, 1000),
+		Manager:     addrAdmin.String(),
+		FromAddress: addrAdmin.String(),
+		MarkerType:  types.MarkerType_Coin,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint, types.Access_Burn}},
+		},
+		AllowGovernanceControl: true,
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+
+	setPolicy := func(ctx sdk.Context, maxChange sdkmath.LegacyDec, signer string) error {
+		_, err := msgServer.SetSupplyChangePolicy(ctx, &types.MsgSetSupplyChangePolicyRequest{Denom: denom, MaxChange: maxChange, Signer: signer})
+		return err
+	}
+
+	t.Run("set requires admin", func(t *testing.T) {
+		err = setPolicy(ctx, sdkmath.LegacyNewDecWithPrec(1, 1), addrOther.String())
+		expErr := fmt.Sprintf("%s does not have ACCESS_ADMIN on %s marker", addrOther, denom)
+		assert.ErrorContains(t, err, expErr, "SetSupplyChangePolicy")
+	})
+
+	em := sdk.NewEventManager()
+	err = setPolicy(ctx.WithEventManager(em), sdkmath.LegacyNewDecWithPrec(1, 1), addrAdmin.String())
+	require.NoError(t, err, "SetSupplyChangePolicy")
+	expEvent, err := sdk.TypedEventToEvent(&types.EventMarkerSupplyChangePolicySet{
+		Denom:         denom,
+		MaxChange:     "0.100000000000000000",
+		Administrator: addrAdmin.String(),
+	})
+	require.NoError(t, err, "TypedEventToEvent")
+	assert.Equal(t, sdk.Events{expEvent}, em.Events(), "emitted events")
+
+	t.Run("query", func(t *testing.T) {
+		resp, err := queryServer.SupplyChangePolicy(ctx, &types.QuerySupplyChangePolicyRequest{Id: denom})
+		require.NoError(t, err, "SupplyChangePolicy")
+		require.NotNil(t, resp.Policy, "Policy")
+		assert.Equal(t, "0.100000000000000000", resp.Policy.MaxChange.String(), "MaxChange")
+		assert.Equal(t, "1000hotdog", resp.Supply.String(), "Supply")
+		assert.Equal(t, "100", resp.MaxChangeAmount.String(), "MaxChangeAmount")
+	})
+
+	t.Run("mint within limit", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 100))
+		assert.NoError(t, err, "MintCoin")
+	})
+
+	t.Run("mint over limit", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 101))
+		expErr := "101hotdog exceeds the 100hotdog that can still be minted or burned this block without a governance proposal " +
+			"(max change 0.100000000000000000 of supply 1000, already changed by 0)"
+		assert.EqualError(t, err, expErr, "MintCoin")
+	})
+
+	t.Run("burn over limit", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.BurnCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 101))
+		assert.ErrorContains(t, err, "exceeds the 100hotdog", "BurnCoin")
+	})
+
+	t.Run("mints and burns in one block add up", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		for i := 1; i <= 3; i++ {
+			err = app.MarkerKeeper.MintCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 30))
+			require.NoError(t, err, "MintCoin %d", i)
+		}
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 11))
+		expErr := "11hotdog exceeds the 10hotdog that can still be minted or burned this block without a governance proposal " +
+			"(max change 0.100000000000000000 of supply 1000, already changed by 90)"
+		assert.EqualError(t, err, expErr, "MintCoin 4")
+		err = app.MarkerKeeper.BurnCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 11))
+		assert.ErrorContains(t, err, "exceeds the 10hotdog", "BurnCoin")
+		err = app.MarkerKeeper.BurnCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 10))
+		assert.NoError(t, err, "BurnCoin 10")
+		resp, err := queryServer.SupplyChangePolicy(cacheCtx, &types.QuerySupplyChangePolicyRequest{Id: denom})
+		require.NoError(t, err, "SupplyChangePolicy")
+		assert.Equal(t, "0", resp.MaxChangeAmount.String(), "MaxChangeAmount")
+
+		nextCtx := cacheCtx.WithBlockHeight(cacheCtx.BlockHeight() + 1)
+		err = app.MarkerKeeper.MintCoin(nextCtx, addrAdmin, sdk.NewInt64Coin(denom, 108))
+		assert.NoError(t, err, "MintCoin in the next block")
+	})
+
+	t.Run("governance is not limited", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.MarkerKeeper.HandleSupplyIncreaseProposal(cacheCtx, sdk.NewInt64Coin(denom, 500), "")
+		require.NoError(t, err, "HandleSupplyIncreaseProposal")
+		err = app.MarkerKeeper.HandleSupplyDecreaseProposal(cacheCtx, sdk.NewInt64Coin(denom, 500))
+		require.NoError(t, err, "HandleSupplyDecreaseProposal")
+		err = app.MarkerKeeper.MintCoin(cacheCtx, addrAdmin, sdk.NewInt64Coin(denom, 100))
+		assert.NoError(t, err, "MintCoin after proposals")
+	})
+
+	t.Run("admin can tighten", func(t *testing.T) {
+		cacheCtx, _ := ctx.CacheContext()
+		err = setPolicy(cacheCtx, sdkmath.LegacyNewDecWithPrec(5, 2), addrAdmin.String())
+		assert.NoError(t, err, "SetSupplyChangePolicy")
+	})
+
+	t.Run("admin cannot loosen or remove", func(t *testing.T) {
+		expErr := fmt.Sprintf("only governance can loosen or remove the %s marker supply change policy", denom)
+		err = setPolicy(ctx, sdkmath.LegacyNewDecWithPrec(2, 1), addrAdmin.String())
+		assert.EqualError(t, err, expErr, "SetSupplyChangePolicy loosen")
+		err = setPolicy(ctx, sdkmath.LegacyZeroDec(), addrAdmin.String())
+		assert.EqualError(t, err, expErr, "SetSupplyChangePolicy remove")
+	})
+
+	t.Run("exported in genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		expPolicy := types.NewSupplyChangePolicy(denom, sdkmath.LegacyNewDecWithPrec(1, 1))
+		assert.Equal(t, []types.SupplyChangePolicy{expPolicy}, genState.SupplyChangePolicies, "SupplyChangePolicies")
+	})
+
+	t.Run("gov can remove", func(t *testing.T) {
+		err = setPolicy(ctx, sdkmath.LegacyZeroDec(), authority)
+		require.NoError(t, err, "SetSupplyChangePolicy")
+		resp, err := queryServer.SupplyChangePolicy(ctx, &types.QuerySupplyChangePolicyRequest{Id: denom})
+		require.NoError(t, err, "SupplyChangePolicy")
+		assert.Nil(t, resp.Policy, "Policy")
+		assert.Equal(t, "0", resp.MaxChangeAmount.String(), "MaxChangeAmount")
+		err = app.MarkerKeeper.MintCoin(ctx, addrAdmin, sdk.NewInt64Coin(denom, 500))
+		assert.NoError(t, err, "MintCoin")
+	})
+}
@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetLastSwapOfferID returns the id of the last swap offer.
+func (k Keeper) GetLastSwapOfferID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastSwapOfferIDKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastSwapOfferID records the id of the last swap offer.
+func (k Keeper) setLastSwapOfferID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastSwapOfferIDKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// nextSwapOfferID increments the last swap offer id and returns the new value.
+func (k Keeper) nextSwapOfferID(ctx sdk.Context) uint64 {
+	id := k.GetLastSwapOfferID(ctx) + 1
+	k.setLastSwapOfferID(ctx, id)
+	return id
+}
+
+// SetSwapOffer records an offer to swap the coins of two markers. Any existing offer with the same id is replaced.
+func (k Keeper) SetSwapOffer(ctx sdk.Context, offer types.SwapOffer) error {
+	if err := offer.Validate(); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&offer)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if existing, found := k.GetSwapOffer(ctx, offer.Id); found {
+		store.Delete(types.SwapOfferExpirationKey(existing.ExpirationHeight, existing.Id))
+	}
+	store.Set(types.SwapOfferKey(offer.Id), bz)
+	store.Set(types.SwapOfferExpirationKey(offer.ExpirationHeight, offer.Id), []byte{})
+	return nil
+}
+
+// GetSwapOffer returns the swap offer with the provided id, and whether it was found.
+func (k Keeper) GetSwapOffer(ctx sdk.Context, id uint64) (types.SwapOffer, bool) {
+	var offer types.SwapOffer
+	bz := ctx.KVStore(k.storeKey).Get(types.SwapOfferKey(id))
+	if len(bz) == 0 {
+		return offer, false
+	}
+	if err := k.cdc.Unmarshal(bz, &offer); err != nil {
+		return offer, false
+	}
+	return offer, true
+}
+
+// IterateSwapOffers iterates over all swap offers, ordered by id.
+func (k Keeper) IterateSwapOffers(ctx sdk.Context, cb func(offer types.SwapOffer) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SwapOfferPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var offer types.SwapOffer
+		if err := k.cdc.Unmarshal(it.Value(), &offer); err != nil {
+			return err
+		}
+		if cb(offer) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveSwapOffer removes the swap offer with the provided id.
+func (k Keeper) RemoveSwapOffer(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if offer, found := k.GetSwapOffer(ctx, id); found {
+		store.Delete(types.SwapOfferExpirationKey(offer.ExpirationHeight, id))
+	}
+	store.Delete(types.SwapOfferKey(id))
+}
+
+// validateSwapDenom returns an error if the provided denom is not for an active marker.
+func (k Keeper) validateSwapDenom(ctx sdk.Context, denom string) error {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if marker.GetStatus() != types.StatusActive {
+		return fmt.Errorf("marker %s is not active", denom)
+	}
+	return nil
+}
+
+// Swap sends amountA from partyA to partyB and amountB from partyB to partyA. Both amounts must be of active markers.
+// Each leg is a normal bank send, so the send restrictions of both markers are applied.
+// The offerID is only used in the emitted event, and should be zero if the swap is not from an offer.
+func (k Keeper) Swap(ctx sdk.Context, partyA sdk.AccAddress, amountA sdk.Coin, partyB sdk.AccAddress, amountB sdk.Coin, offerID uint64) error {
+	if err := types.ValidateSwapAmounts("amount a", amountA, "amount b", amountB); err != nil {
+		return err
+	}
+	for _, denom := range []string{amountA.Denom, amountB.Denom} {
+		if err := k.validateSwapDenom(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, partyA, partyB, sdk.NewCoins(amountA)); err != nil {
+		return fmt.Errorf("could not send %s from %s to %s: %w", amountA, partyA, partyB, err)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, partyB, partyA, sdk.NewCoins(amountB)); err != nil {
+		return fmt.Errorf("could not send %s from %s to %s: %w", amountB, partyB, partyA, err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMarkerSwap{
+		PartyA:  partyA.String(),
+		AmountA: amountA.String(),
+		PartyB:  partyB.String(),
+		AmountB: amountB.String(),
+		OfferId: offerID,
+	})
+}
+
+// CreateSwapOffer records an offer to swap the coins of two markers, assigning it the next id.
+// The expiration height must be after the current block height. The recorded offer is returned.
+func (k Keeper) CreateSwapOffer(ctx sdk.Context, offer types.SwapOffer) (types.SwapOffer, error) {
+	if offer.ExpirationHeight <= ctx.BlockHeight() {
+		return offer, fmt.Errorf("expiration height %d must be after the current height %d", offer.ExpirationHeight, ctx.BlockHeight())
+	}
+	for _, denom := range []string{offer.Offer.Denom, offer.Ask.Denom} {
+		if err := k.validateSwapDenom(ctx, denom); err != nil {
+			return offer, err
+		}
+	}
+
+	offer.Id = k.nextSwapOfferID(ctx)
+	if err := k.SetSwapOffer(ctx, offer); err != nil {
+		return offer, err
+	}
+	return offer, nil
+}
+
+// AcceptSwapOffer completes the swap described by an offer and removes the offer.
+func (k Keeper) AcceptSwapOffer(ctx sdk.Context, id uint64, taker sdk.AccAddress) error {
+	offer, found := k.GetSwapOffer(ctx, id)
+	if !found {
+		return fmt.Errorf("swap offer %d not found", id)
+	}
+	if offer.ExpirationHeight <= ctx.BlockHeight() {
+		return fmt.Errorf("swap offer %d expired at height %d", id, offer.ExpirationHeight)
+	}
+	if !offer.CanAccept(taker.String()) {
+		return fmt.Errorf("account %s cannot accept swap offer %d", taker, id)
+	}
+	maker, err := sdk.AccAddressFromBech32(offer.Maker)
+	if err != nil {
+		return fmt.Errorf("invalid maker %q: %w", offer.Maker, err)
+	}
+
+	k.RemoveSwapOffer(ctx, id)
+	return k.Swap(ctx, maker, offer.Offer, taker, offer.Ask, id)
+}
+
+// CancelSwapOffer removes a swap offer before it is accepted. Only the maker can cancel it.
+// The removed offer is returned.
+func (k Keeper) CancelSwapOffer(ctx sdk.Context, id uint64, maker string) (types.SwapOffer, error) {
+	offer, found := k.GetSwapOffer(ctx, id)
+	if !found {
+		return offer, fmt.Errorf("swap offer %d not found", id)
+	}
+	if offer.Maker != maker {
+		return offer, fmt.Errorf("account %s cannot cancel swap offer %d", maker, id)
+	}
+	k.RemoveSwapOffer(ctx, id)
+	return offer, nil
+}
+
+// RemoveExpiredSwapOffers removes every swap offer with an expiration height at or before the current block height.
+// Only the offers that are due are looked at. Each one is removed on its own, so a failure is logged and skipped.
+func (k Keeper) RemoveExpiredSwapOffers(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+
+	var expiredKeys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.SwapOfferExpirationPrefix)
+	for ; it.Valid(); it.Next() {
+		expHeight, _, err := types.ParseSwapOfferExpirationKey(it.Key())
+		if err == nil && expHeight > height {
+			break
+		}
+		expiredKeys = append(expiredKeys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range expiredKeys {
+		_, id, err := types.ParseSwapOfferExpirationKey(key)
+		if err != nil {
+			store.Delete(key)
+			continue
+		}
+		offer, found := k.GetSwapOffer(ctx, id)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		k.RemoveSwapOffer(cacheCtx, id)
+		err = cacheCtx.EventManager().EmitTypedEvent(&types.EventMarkerSwapOfferExpired{
+			Id:    offer.Id,
+			Maker: offer.Maker,
+		})
+		if err != nil {
+			k.Logger(ctx).Error("could not remove expired swap offer", "id", id, "error", err)
+			store.Delete(key)
+			continue
+		}
+		writeCache()
+	}
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestSwap(t *testing.T) {
+	addrAdmin := sdk.AccAddress("admin_______________")
+	addrA := sdk.AccAddress("party_a_____________")
+	addrB := sdk.AccAddress("party_b_____________")
+	addrC := sdk.AccAddress("party_c_____________")
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(10)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	for _, tc := range []struct {
+		denom      string
+		markerType types.MarkerType
+	}{
+		{denom: "hotdogcoin", markerType: types.MarkerType_Coin},
+		{denom: "hamburgercoin", markerType: types.MarkerType_Coin},
+		{denom: "cheesecoin", markerType: types.MarkerType_RestrictedCoin},
+	} {
+		_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+			Amount:      sdk.NewInt64Coin(tc.denom, 1000),
+			Manager:     addrAdmin.String(),
+			FromAddress: addrAdmin.String(),
+			MarkerType:  tc.markerType,
+			AccessList: []types.AccessGrant{
+				{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin}},
+			},
+		})
+		require.NoError(t, err, "AddFinalizeActivateMarker %s", tc.denom)
+	}
+	fund := sdk.NewCoins(sdk.NewInt64Coin("hotdogcoin", 100), sdk.NewInt64Coin("hamburgercoin", 100), sdk.NewInt64Coin("cheesecoin", 100))
+	for _, addr := range []sdk.AccAddress{addrA, addrB, addrC} {
+		require.NoError(t, testutil.FundAccount(types.WithBypass(ctx), app.BankKeeper, addr, fund), "FundAccount %s", addr)
+	}
+	assertBalance := func(t *testing.T, addr sdk.AccAddress, denom string, exp int64) {
+		t.Helper()
+		assert.Equal(t, fmt.Sprintf("%d%s", exp, denom), app.BankKeeper.GetBalance(ctx, addr, denom).String(), "%s balance", denom)
+	}
+
+	t.Run("swap", func(t *testing.T) {
+		em := sdk.NewEventManager()
+		_, err := msgServer.Swap(ctx.WithEventManager(em), types.NewMsgSwapRequest(
+			addrA, sdk.NewInt64Coin("hotdogcoin", 10), addrB, sdk.NewInt64Coin("hamburgercoin", 25)))
+		require.NoError(t, err, "Swap")
+		assertBalance(t, addrA, "hotdogcoin", 90)
+		assertBalance(t, addrA, "hamburgercoin", 125)
+		assertBalance(t, addrB, "hotdogcoin", 110)
+		assertBalance(t, addrB, "hamburgercoin", 75)
+		assert.Equal(t, "provenance.marker.v1.EventMarkerSwap", em.Events()[len(em.Events())-1].Type, "last event type")
+	})
+
+	t.Run("swap of a non-marker denom", func(t *testing.T) {
+		_, err := msgServer.Swap(ctx, types.NewMsgSwapRequest(
+			addrA, sdk.NewInt64Coin("hotdogcoin", 10), addrB, sdk.NewInt64Coin("nosuchcoin", 25)))
+		assert.ErrorContains(t, err, "marker not found for nosuchcoin", "Swap")
+	})
+
+	t.Run("swap with a restricted leg", func(t *testing.T) {
+		// The first leg succeeds before the second fails, so use a cache context to keep the balances as they were.
+		cacheCtx, _ := ctx.CacheContext()
+		_, err := msgServer.Swap(cacheCtx, types.NewMsgSwapRequest(
+			addrA, sdk.NewInt64Coin("hotdogcoin", 10), addrB, sdk.NewInt64Coin("cheesecoin", 5)))
+		assert.ErrorContains(t, err, fmt.Sprintf("could not send 5cheesecoin from %s to %s", addrB, addrA), "Swap")
+	})
+
+	t.Run("swap with insufficient funds", func(t *testing.T) {
+		_, err := msgServer.Swap(ctx, types.NewMsgSwapRequest(
+			addrA, sdk.NewInt64Coin("hotdogcoin", 1000), addrB, sdk.NewInt64Coin("hamburgercoin", 5)))
+		assert.ErrorContains(t, err, "insufficient funds", "Swap")
+	})
+
+	offer := func(taker sdk.AccAddress, expirationHeight int64) *types.MsgCreateSwapOfferRequest {
+		return types.NewMsgCreateSwapOfferRequest(addrA, taker, sdk.NewInt64Coin("hotdogcoin", 5), sdk.NewInt64Coin("hamburgercoin", 7), expirationHeight)
+	}
+
+	t.Run("create offer that has already expired", func(t *testing.T) {
+		_, err := msgServer.CreateSwapOffer(ctx, offer(nil, 10))
+		assert.ErrorContains(t, err, "expiration height 10 must be after the current height 10", "CreateSwapOffer")
+	})
+
+	t.Run("offer for a specific taker", func(t *testing.T) {
+		resp, err := msgServer.CreateSwapOffer(ctx, offer(addrB, 20))
+		require.NoError(t, err, "CreateSwapOffer")
+		assert.Equal(t, uint64(1), resp.OfferId, "offer id")
+
+		qResp, err := app.MarkerKeeper.SwapOffer(ctx, &types.QuerySwapOfferRequest{OfferId: resp.OfferId})
+		require.NoError(t, err, "SwapOffer query")
+		assert.Equal(t, addrB.String(), qResp.Offer.Taker, "offer taker")
+
+		_, err = msgServer.AcceptSwapOffer(ctx, types.NewMsgAcceptSwapOfferRequest(resp.OfferId, addrC))
+		assert.ErrorContains(t, err, fmt.Sprintf("account %s cannot accept swap offer 1", addrC), "AcceptSwapOffer by other")
+		_, err = msgServer.CancelSwapOffer(ctx, types.NewMsgCancelSwapOfferRequest(resp.OfferId, addrB))
+		assert.ErrorContains(t, err, fmt.Sprintf("account %s cannot cancel swap offer 1", addrB), "CancelSwapOffer by taker")
+
+		_, err = msgServer.AcceptSwapOffer(ctx.WithBlockHeight(19), types.NewMsgAcceptSwapOfferRequest(resp.OfferId, addrB))
+		require.NoError(t, err, "AcceptSwapOffer")
+		assertBalance(t, addrA, "hotdogcoin", 85)
+		assertBalance(t, addrA, "hamburgercoin", 132)
+		assertBalance(t, addrB, "hotdogcoin", 115)
+		assertBalance(t, addrB, "hamburgercoin", 68)
+
+		_, err = app.MarkerKeeper.SwapOffer(ctx, &types.QuerySwapOfferRequest{OfferId: resp.OfferId})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = swap offer 1 not found", "SwapOffer query after accept")
+	})
+
+	t.Run("cancel offer", func(t *testing.T) {
+		resp, err := msgServer.CreateSwapOffer(ctx, offer(nil, 20))
+		require.NoError(t, err, "CreateSwapOffer")
+		_, err = msgServer.CancelSwapOffer(ctx, types.NewMsgCancelSwapOfferRequest(resp.OfferId, addrA))
+		require.NoError(t, err, "CancelSwapOffer")
+		_, err = msgServer.AcceptSwapOffer(ctx, types.NewMsgAcceptSwapOfferRequest(resp.OfferId, addrC))
+		assert.ErrorContains(t, err, "swap offer 2 not found", "AcceptSwapOffer after cancel")
+	})
+
+	t.Run("expired offer", func(t *testing.T) {
+		resp, err := msgServer.CreateSwapOffer(ctx, offer(nil, 15))
+		require.NoError(t, err, "CreateSwapOffer")
+		_, err = msgServer.AcceptSwapOffer(ctx.WithBlockHeight(15), types.NewMsgAcceptSwapOfferRequest(resp.OfferId, addrC))
+		assert.ErrorContains(t, err, "swap offer 3 expired at height 15", "AcceptSwapOffer at expiration")
+
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Equal(t, []types.SwapOffer{types.NewSwapOffer(3, addrA, nil, sdk.NewInt64Coin("hotdogcoin", 5), sdk.NewInt64Coin("hamburgercoin", 7), 15)},
+			genState.SwapOffers, "exported swap offers")
+		assert.Equal(t, uint64(3), genState.LastSwapOfferId, "exported last swap offer id")
+
+		em := sdk.NewEventManager()
+		app.MarkerKeeper.RemoveExpiredSwapOffers(ctx.WithBlockHeight(14).WithEventManager(em))
+		assert.Empty(t, em.Events(), "events before expiration")
+		app.MarkerKeeper.RemoveExpiredSwapOffers(ctx.WithBlockHeight(15).WithEventManager(em))
+		require.Len(t, em.Events(), 1, "events")
+		assert.Equal(t, "provenance.marker.v1.EventMarkerSwapOfferExpired", em.Events()[0].Type, "event type")
+
+		qResp, err := app.MarkerKeeper.SwapOffers(ctx, &types.QuerySwapOffersRequest{})
+		require.NoError(t, err, "SwapOffers query")
+		assert.Empty(t, qResp.Offers, "swap offers after expiration")
+
+		// Accepted, cancelled, and expired offers don't leave anything in the expiration index.
+		store := ctx.KVStore(app.GetKey(types.StoreKey))
+		it := storetypes.KVStorePrefixIterator(store, types.SwapOfferExpirationPrefix)
+		defer it.Close()
+		assert.False(t, it.Valid(), "swap offer expiration index has entries")
+	})
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetIssuanceTranche records a round of a marker's issuance. Any existing tranche with the same denom and id is replaced.
+func (k Keeper) SetIssuanceTranche(ctx sdk.Context, tranche types.IssuanceTranche) error {
+	if err := tranche.Validate(); err != nil {
+		return err
+	}
+	markerAddr, err := types.MarkerAddress(tranche.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&tranche)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.IssuanceTrancheKey(markerAddr, tranche.Id), bz)
+	return nil
+}
+
+// GetIssuanceTranches returns all of the issuance tranches of the marker with the provided address, ordered by id.
+func (k Keeper) GetIssuanceTranches(ctx sdk.Context, markerAddr sdk.AccAddress) ([]types.IssuanceTranche, error) {
+	var rv []types.IssuanceTranche
+	err := k.iterateIssuanceTranches(ctx, types.IssuanceTrancheKeyPrefix(markerAddr), func(tranche types.IssuanceTranche) bool {
+		rv = append(rv, tranche)
+		return false
+	})
+	return rv, err
+}
+
+// IterateIssuanceTranches iterates over all issuance tranches of all markers.
+func (k Keeper) IterateIssuanceTranches(ctx sdk.Context, cb func(tranche types.IssuanceTranche) (stop bool)) error {
+	return k.iterateIssuanceTranches(ctx, types.IssuanceTranchePrefix, cb)
+}
+
+// iterateIssuanceTranches iterates over the issuance tranches with keys that have the provided prefix.
+func (k Keeper) iterateIssuanceTranches(ctx sdk.Context, prefix []byte, cb func(tranche types.IssuanceTranche) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var tranche types.IssuanceTranche
+		if err := k.cdc.Unmarshal(it.Value(), &tranche); err != nil {
+			return err
+		}
+		if cb(tranche) {
+			break
+		}
+	}
+	return nil
+}
+
+// lastIssuanceTrancheID returns the id of the most recent issuance tranche of the marker with the provided address.
+func (k Keeper) lastIssuanceTrancheID(ctx sdk.Context, markerAddr sdk.AccAddress) uint64 {
+	prefix := types.IssuanceTrancheKeyPrefix(markerAddr)
+	it := storetypes.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	if !it.Valid() {
+		return 0
+	}
+	return binary.BigEndian.Uint64(it.Key()[len(prefix):])
+}
+
+// RemoveIssuanceTranches removes all of the issuance tranches of the marker with the provided address.
+func (k Keeper) RemoveIssuanceTranches(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.IssuanceTrancheKeyPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordIssuanceTranche records the mint of some of a marker's coin as its next issuance tranche.
+// The label and price are optional.
+func (k Keeper) recordIssuanceTranche(ctx sdk.Context, marker types.MarkerAccountI, amount sdkmath.Int, label string, price *sdk.Coin, mintedBy string) error {
+	tranche := types.IssuanceTranche{
+		Denom:    marker.GetDenom(),
+		Id:       k.lastIssuanceTrancheID(ctx, marker.GetAddress()) + 1,
+		Label:    label,
+		Amount:   amount,
+		Price:    price,
+		IssuedAt: ctx.BlockTime().UTC(),
+		Height:   ctx.BlockHeight(),
+		MintedBy: mintedBy,
+	}
+	if err := k.SetIssuanceTranche(ctx, tranche); err != nil {
+		return err
+	}
+
+	event := &types.EventMarkerTrancheRecorded{
+		Denom:    tranche.Denom,
+		Id:       tranche.Id,
+		Label:    tranche.Label,
+		Amount:   tranche.Amount.String(),
+		MintedBy: tranche.MintedBy,
+	}
+	if price != nil {
+		event.Price = price.String()
+	}
+	return ctx.EventManager().EmitTypedEvent(event)
+}
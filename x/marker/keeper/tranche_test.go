@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestIssuanceTranches(t *testing.T) {
+	const denom = "tranchecoin"
+	addrAdmin := sdk.AccAddress("admin_______________")
+	blockTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	app := simapp.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockHeight(5).WithBlockTime(blockTime)
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	_, err := msgServer.AddFinalizeActivateMarker(ctx, &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:                 sdk.NewInt64Coin(denom, 1000),
+		Manager:                addrAdmin.String(),
+		FromAddress:            addrAdmin.String(),
+		MarkerType:             types.MarkerType_Coin,
+		AllowGovernanceControl: true,
+		AccessList: []types.AccessGrant{
+			{Address: addrAdmin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+		},
+	})
+	require.NoError(t, err, "AddFinalizeActivateMarker %s", denom)
+
+	price := sdk.NewInt64Coin("usd", 25)
+	_, err = msgServer.Mint(ctx, &types.MsgMintRequest{
+		Amount:        sdk.NewInt64Coin(denom, 300),
+		Administrator: addrAdmin.String(),
+		TrancheLabel:  "Series A",
+		TranchePrice:  &price,
+	})
+	require.NoError(t, err, "Mint Series A")
+	require.NoError(t, app.MarkerKeeper.HandleSupplyIncreaseProposal(ctx.WithBlockHeight(8), sdk.NewInt64Coin(denom, 200), ""), "HandleSupplyIncreaseProposal")
+
+	_, err = msgServer.Mint(ctx, &types.MsgMintRequest{
+		Amount:        sdk.NewInt64Coin(denom, 1),
+		Administrator: addrAdmin.String(),
+		TranchePrice:  &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(1)},
+	})
+	assert.ErrorContains(t, err, "tranche price denom cannot be the marker denom \"tranchecoin\"", "Mint priced in its own denom")
+
+	expTranches := []types.IssuanceTranche{
+		{
+			Denom:    denom,
+			Id:       1,
+			Label:    "Series A",
+			Amount:   sdkmath.NewInt(300),
+			Price:    &price,
+			IssuedAt: blockTime,
+			Height:   5,
+			MintedBy: addrAdmin.String(),
+		},
+		{
+			Denom:    denom,
+			Id:       2,
+			Amount:   sdkmath.NewInt(200),
+			IssuedAt: blockTime,
+			Height:   8,
+			MintedBy: app.MarkerKeeper.GetAuthority(),
+		},
+	}
+
+	t.Run("query", func(t *testing.T) {
+		_, qerr := app.MarkerKeeper.IssuanceTranches(ctx, nil)
+		assert.EqualError(t, qerr, "rpc error: code = InvalidArgument desc = invalid request", "IssuanceTranches(nil)")
+
+		resp, qerr := app.MarkerKeeper.IssuanceTranches(ctx, &types.QueryIssuanceTranchesRequest{Id: denom})
+		require.NoError(t, qerr, "IssuanceTranches by denom")
+		assert.Equal(t, expTranches, resp.Tranches, "IssuanceTranches by denom")
+
+		resp, qerr = app.MarkerKeeper.IssuanceTranches(ctx, &types.QueryIssuanceTranchesRequest{Id: types.MustGetMarkerAddress(denom).String()})
+		require.NoError(t, qerr, "IssuanceTranches by address")
+		assert.Equal(t, expTranches, resp.Tranches, "IssuanceTranches by address")
+	})
+
+	t.Run("genesis", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Equal(t, expTranches, genState.IssuanceTranches, "exported issuance tranches")
+		assert.NoError(t, genState.Validate(), "exported genesis Validate()")
+	})
+
+	t.Run("removed with the marker", func(t *testing.T) {
+		marker, merr := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, merr, "GetMarkerByDenom")
+		app.MarkerKeeper.RemoveMarker(ctx, marker)
+		tranches, terr := app.MarkerKeeper.GetIssuanceTranches(ctx, marker.GetAddress())
+		require.NoError(t, terr, "GetIssuanceTranches")
+		assert.Empty(t, tranches, "tranches after RemoveMarker")
+	})
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ValidateProposalMsgs checks the msgs of a draft governance proposal against the current state.
+// Each msg is validated and, if no problems are found with it, executed in the provided context
+// so that later msgs are checked against the results of earlier ones. A cache context should be provided.
+// All problems found are returned together; nil is returned if the msgs should be processed successfully.
+func (k Keeper) ValidateProposalMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return errors.New("at least one msg is required")
+	}
+
+	var errs []error
+	for i, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+		msgErrs := k.validateProposalMsg(ctx, msg)
+		if len(msgErrs) == 0 {
+			if handler := k.router.Handler(msg); handler == nil {
+				msgErrs = append(msgErrs, errors.New("no message handler found"))
+			} else if _, err := handler(ctx, msg); err != nil {
+				msgErrs = append(msgErrs, err)
+			}
+		}
+		for _, err := range msgErrs {
+			errs = append(errs, fmt.Errorf("msgs[%d] %s: %w", i, msgType, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateProposalMsg checks a single msg of a draft governance proposal without executing it.
+func (k Keeper) validateProposalMsg(ctx sdk.Context, msg sdk.Msg) []error {
+	var errs []error
+
+	authority := k.GetAuthority()
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	switch {
+	case err != nil:
+		errs = append(errs, fmt.Errorf("could not get signers: %w", err))
+	case len(signers) != 1 || sdk.AccAddress(signers[0]).String() != authority:
+		errs = append(errs, fmt.Errorf("the governance module account %s must be the only signer", authority))
+	}
+
+	if vb, ok := msg.(sdk.HasValidateBasic); ok {
+		if err = vb.ValidateBasic(); err != nil {
+			// Don't bother with the state checks if the msg itself is invalid.
+			return append(errs, err)
+		}
+	}
+
+	switch m := msg.(type) {
+	case *types.MsgAddMarkerRequest:
+		errs = append(errs, k.validateAddMarkerProposal(ctx, m)...)
+	case *types.MsgSetAdministratorProposalRequest:
+		errs = append(errs, k.validateSetAdministratorProposal(ctx, m)...)
+	case *types.MsgChangeStatusProposalRequest:
+		errs = append(errs, k.validateChangeStatusProposal(ctx, m)...)
+	}
+
+	return errs
+}
+
+// validateAddMarkerProposal checks an add marker msg against the current state.
+func (k Keeper) validateAddMarkerProposal(ctx sdk.Context, msg *types.MsgAddMarkerRequest) []error {
+	var errs []error
+	denom := msg.Amount.Denom
+
+	if existing, _ := k.GetMarkerByDenom(ctx, denom); existing != nil {
+		errs = append(errs, fmt.Errorf("a marker already exists for denom %s", denom))
+	}
+	if md, found := k.GetDenomMetadataForUnit(ctx, denom); found && md.Base != denom {
+		errs = append(errs, fmt.Errorf("denom %s is already a denom unit of %s", denom, md.Base))
+	}
+	if err := types.ValidateGrantsForMarkerType(msg.MarkerType, msg.AccessList...); err != nil {
+		errs = append(errs, fmt.Errorf("invalid access privileges granted: %w", err))
+	}
+	ma := types.MarkerAccount{Denom: denom, SupplyFixed: msg.SupplyFixed, AccessControl: msg.AccessList}
+	if err := types.ValidateIbcDenom(ma); err != nil {
+		errs = append(errs, fmt.Errorf("invalid ibc denom configuration: %w", err))
+	}
+
+	return errs
+}
+
+// validateSetAdministratorProposal checks a set administrator msg against the current state.
+func (k Keeper) validateSetAdministratorProposal(ctx sdk.Context, msg *types.MsgSetAdministratorProposalRequest) []error {
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	if !m.HasGovernanceEnabled() {
+		errs = append(errs, fmt.Errorf("%s marker does not allow governance control", msg.Denom))
+	}
+	if err = types.ValidateGrantsForMarkerType(m.GetMarkerType(), msg.Access...); err != nil {
+		errs = append(errs, fmt.Errorf("invalid access privileges granted: %w", err))
+	}
+	ma := types.MarkerAccount{Denom: msg.Denom, SupplyFixed: m.HasFixedSupply(), AccessControl: msg.Access}
+	if err = types.ValidateIbcDenom(ma); err != nil {
+		errs = append(errs, fmt.Errorf("invalid ibc denom configuration: %w", err))
+	}
+
+	return errs
+}
+
+// validateChangeStatusProposal checks a change status msg against the current state.
+func (k Keeper) validateChangeStatusProposal(ctx sdk.Context, msg *types.MsgChangeStatusProposalRequest) []error {
+	m, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	if !m.HasGovernanceEnabled() {
+		errs = append(errs, fmt.Errorf("%s marker does not allow governance control", msg.Denom))
+	}
+	if m.GetStatus() > msg.NewStatus {
+		errs = append(errs, fmt.Errorf("invalid status transition %s precedes existing status of %s", msg.NewStatus, m.GetStatus()))
+	}
+	if msg.NewStatus == types.StatusDestroyed && m.GetStatus() != types.StatusCancelled {
+		errs = append(errs, errors.New("only cancelled markers can be deleted"))
+	}
+
+	return errs
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func (s *KeeperTestSuite) TestValidateProposalMsgs() {
+	hotdogMarker := s.createTestMarker("hotdog")
+	nonGovernanceMarker := s.createTestMarker("nonGovernanceMarker")
+	nonGovernanceMarker.AllowGovernanceControl = false
+	s.Require().NoError(nonGovernanceMarker.SetStatus(types.StatusFinalized), "SetStatus(finalized)")
+	s.app.MarkerKeeper.SetMarker(s.ctx, nonGovernanceMarker)
+	s.app.BankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base:    "metacoin",
+		Display: "kilometacoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "metacoin", Exponent: 0},
+			{Denom: "kilometacoin", Exponent: 3},
+		},
+	})
+
+	authority := s.app.MarkerKeeper.GetAuthority()
+	govAddr := sdk.MustAccAddressFromBech32(authority)
+	addMarker := func(denom string, markerType types.MarkerType, supplyFixed bool, grants ...types.AccessGrant) *types.MsgAddMarkerRequest {
+		msg := types.NewMsgAddMarkerRequest(denom, sdkmath.NewInt(1000), govAddr, govAddr, markerType, supplyFixed, true, false, nil, 0, 0)
+		msg.AccessList = grants
+		return msg
+	}
+	grant := func(perms ...types.Access) types.AccessGrant {
+		return *types.NewAccessGrant(s.user1Addr, perms)
+	}
+	msgPrefix := func(i int, msg sdk.Msg) string {
+		return fmt.Sprintf("msgs[%d] %s: ", i, sdk.MsgTypeURL(msg))
+	}
+
+	wrongSigner := addMarker("wrongsignercoin", types.MarkerType_Coin, false)
+	wrongSigner.FromAddress = s.user1
+	collision := addMarker(hotdogMarker.Denom, types.MarkerType_Coin, false, grant(types.Access_Transfer))
+	unitCollision := addMarker("kilometacoin", types.MarkerType_Coin, false)
+	ibcMarker := addMarker("ibc/0123456789ABCDEF", types.MarkerType_Coin, true, grant(types.Access_Mint))
+	badStatus := &types.MsgChangeStatusProposalRequest{Denom: nonGovernanceMarker.Denom, NewStatus: types.StatusProposed, Authority: authority}
+	missingAdmin := &types.MsgSetAdministratorProposalRequest{Denom: "missingcoin", Access: []types.AccessGrant{grant(types.Access_Mint)}, Authority: authority}
+	badSend := banktypes.NewMsgSend(govAddr, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("hotdog", 1)))
+
+	newMarker := addMarker("newcoin", types.MarkerType_RestrictedCoin, false)
+	newAdmin := &types.MsgSetAdministratorProposalRequest{Denom: "newcoin", Access: []types.AccessGrant{grant(types.Access_Admin, types.Access_Transfer)}, Authority: authority}
+	newActive := &types.MsgChangeStatusProposalRequest{Denom: "newcoin", NewStatus: types.StatusActive, Authority: authority}
+
+	tests := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expInErr []string
+	}{
+		{
+			name:     "no msgs",
+			expInErr: []string{"at least one msg is required"},
+		},
+		{
+			name:     "wrong signer",
+			msgs:     []sdk.Msg{wrongSigner},
+			expInErr: []string{msgPrefix(0, wrongSigner) + "the governance module account " + authority + " must be the only signer"},
+		},
+		{
+			name: "add marker collision and invalid grant",
+			msgs: []sdk.Msg{collision},
+			expInErr: []string{
+				msgPrefix(0, collision) + "a marker already exists for denom hotdog",
+				msgPrefix(0, collision) + "invalid access privileges granted: ACCESS_TRANSFER is not supported for marker type MARKER_TYPE_COIN",
+			},
+		},
+		{
+			name:     "add marker denom unit collision",
+			msgs:     []sdk.Msg{unitCollision},
+			expInErr: []string{msgPrefix(0, unitCollision) + "denom kilometacoin is already a denom unit of metacoin"},
+		},
+		{
+			name:     "add marker invalid ibc config",
+			msgs:     []sdk.Msg{ibcMarker},
+			expInErr: []string{msgPrefix(0, ibcMarker) + "invalid ibc denom configuration: fixed supply is not supported for ibc marker"},
+		},
+		{
+			name: "several problems across msgs",
+			msgs: []sdk.Msg{badStatus, missingAdmin, badSend},
+			expInErr: []string{
+				msgPrefix(0, badStatus) + "nonGovernanceMarker marker does not allow governance control",
+				msgPrefix(0, badStatus) + "invalid status transition proposed precedes existing status of finalized",
+				msgPrefix(1, missingAdmin) + "marker missingcoin not found",
+				msgPrefix(2, badSend) + "spendable balance 0hotdog is smaller than 1hotdog: insufficient funds",
+			},
+		},
+		{
+			name: "later msgs use results of earlier ones",
+			msgs: []sdk.Msg{newMarker, newAdmin, newActive},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+			err := s.app.MarkerKeeper.ValidateProposalMsgs(ctx, tc.msgs)
+			assertions.AssertErrorContents(s.T(), err, tc.expInErr, "ValidateProposalMsgs")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestValidateProposalQuery() {
+	authority := s.app.MarkerKeeper.GetAuthority()
+	govAddr := sdk.MustAccAddressFromBech32(authority)
+
+	s.Run("nil request", func() {
+		_, err := s.app.MarkerKeeper.ValidateProposal(s.ctx, nil)
+		s.Assert().EqualError(err, "rpc error: code = InvalidArgument desc = invalid request", "ValidateProposal error")
+	})
+
+	s.Run("problems found", func() {
+		msg := types.NewMsgAddMarkerRequest("querycoin", sdkmath.NewInt(10), s.user1Addr, s.user1Addr, types.MarkerType_Coin, false, true, false, nil, 0, 0)
+		req, err := types.NewQueryValidateProposalRequest([]sdk.Msg{msg})
+		s.Require().NoError(err, "NewQueryValidateProposalRequest")
+		resp, err := s.app.MarkerKeeper.ValidateProposal(s.ctx, req)
+		s.Require().NoError(err, "ValidateProposal error")
+		s.Assert().Contains(resp.Error, "must be the only signer", "ValidateProposal resp.Error")
+		s.Assert().False(resp.GovPropWillPass, "ValidateProposal resp.GovPropWillPass")
+	})
+
+	s.Run("will pass and nothing is committed", func() {
+		msg := types.NewMsgAddMarkerRequest("querycoin", sdkmath.NewInt(10), govAddr, govAddr, types.MarkerType_Coin, false, true, false, nil, 0, 0)
+		req, err := types.NewQueryValidateProposalRequest([]sdk.Msg{msg})
+		s.Require().NoError(err, "NewQueryValidateProposalRequest")
+		resp, err := s.app.MarkerKeeper.ValidateProposal(s.ctx, req)
+		s.Require().NoError(err, "ValidateProposal error")
+		s.Assert().Empty(resp.Error, "ValidateProposal resp.Error")
+		s.Assert().True(resp.GovPropWillPass, "ValidateProposal resp.GovPropWillPass")
+		_, err = s.app.MarkerKeeper.GetMarkerByDenom(s.ctx, "querycoin")
+		s.Assert().Error(err, "GetMarkerByDenom after ValidateProposal")
+	})
+}
@@ -0,0 +1,176 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshalls the KVPair's
+// Value
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.MarkerStoreKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.DenySendKeyPrefix):
+			markerA, denyA := types.GetDenySendAddresses(kvA.Key)
+			markerB, denyB := types.GetDenySendAddresses(kvB.Key)
+
+			return fmt.Sprintf("%v: %v\n%v: %v", markerA, denyA, markerB, denyB)
+		case bytes.Equal(kvA.Key[:1], types.NetAssetValuePrefix):
+			var navA, navB types.NetAssetValue
+
+			cdc.MustUnmarshal(kvA.Value, &navA)
+			cdc.MustUnmarshal(kvB.Value, &navB)
+
+			return fmt.Sprintf("%v\n%v", navA, navB)
+		case bytes.Equal(kvA.Key[:1], types.MarkerParamStoreKey):
+			var paramsA, paramsB types.Params
+
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.ERC20PointerPrefix):
+			var pointerA, pointerB types.ERC20Pointer
+
+			cdc.MustUnmarshal(kvA.Value, &pointerA)
+			cdc.MustUnmarshal(kvB.Value, &pointerB)
+
+			return fmt.Sprintf("%v\n%v", pointerA, pointerB)
+		case bytes.Equal(kvA.Key[:1], types.ERC20ContractPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.BridgePrefix):
+			var bridgeA, bridgeB types.MarkerBridge
+
+			cdc.MustUnmarshal(kvA.Value, &bridgeA)
+			cdc.MustUnmarshal(kvB.Value, &bridgeB)
+
+			return fmt.Sprintf("%v\n%v", bridgeA, bridgeB)
+		case bytes.Equal(kvA.Key[:1], types.BridgeNoncePrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.DenySendAddressPrefix):
+			denyA, markerA := types.GetDenySendAddressKeyAddresses(kvA.Key)
+			denyB, markerB := types.GetDenySendAddressKeyAddresses(kvB.Key)
+
+			return fmt.Sprintf("%v: %v\n%v: %v", denyA, markerA, denyB, markerB)
+		case bytes.Equal(kvA.Key[:1], types.PendingAdminGrantPrefix):
+			var grantA, grantB types.PendingAdminGrant
+
+			cdc.MustUnmarshal(kvA.Value, &grantA)
+			cdc.MustUnmarshal(kvB.Value, &grantB)
+
+			return fmt.Sprintf("%v\n%v", grantA, grantB)
+		case bytes.Equal(kvA.Key[:1], types.ReqAttrBypassAddrPrefix):
+			return fmt.Sprintf("%v\n%v", types.GetReqAttrBypassAddrFromKey(kvA.Key), types.GetReqAttrBypassAddrFromKey(kvB.Key))
+		case bytes.Equal(kvA.Key[:1], types.ScheduledPolicyChangePrefix):
+			var changeA, changeB types.ScheduledPolicyChange
+
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+		case bytes.Equal(kvA.Key[:1], types.LastPolicyChangeIDKey):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.SwapOfferPrefix):
+			var offerA, offerB types.SwapOffer
+
+			cdc.MustUnmarshal(kvA.Value, &offerA)
+			cdc.MustUnmarshal(kvB.Value, &offerB)
+
+			return fmt.Sprintf("%v\n%v", offerA, offerB)
+		case bytes.Equal(kvA.Key[:1], types.LastSwapOfferIDKey):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.IssuanceTranchePrefix):
+			var trancheA, trancheB types.IssuanceTranche
+
+			cdc.MustUnmarshal(kvA.Value, &trancheA)
+			cdc.MustUnmarshal(kvB.Value, &trancheB)
+
+			return fmt.Sprintf("%v\n%v", trancheA, trancheB)
+		case bytes.Equal(kvA.Key[:1], types.SubLedgerEntryPrefix):
+			var entryA, entryB types.SubLedgerEntry
+
+			cdc.MustUnmarshal(kvA.Value, &entryA)
+			cdc.MustUnmarshal(kvB.Value, &entryB)
+
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+		case bytes.Equal(kvA.Key[:1], types.SubLedgerSubAccountIndexPrefix):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Key[len(kvA.Key)-8:]), binary.BigEndian.Uint64(kvB.Key[len(kvB.Key)-8:]))
+		case bytes.Equal(kvA.Key[:1], types.BridgedSupplyPrefix):
+			var bridgedA, bridgedB types.BridgedSupply
+
+			cdc.MustUnmarshal(kvA.Value, &bridgedA)
+			cdc.MustUnmarshal(kvB.Value, &bridgedB)
+
+			return fmt.Sprintf("%v\n%v", bridgedA, bridgedB)
+		case bytes.Equal(kvA.Key[:1], types.BridgedSupplyReconciliationPrefix):
+			var reconA, reconB types.BridgedSupplyReconciliation
+
+			cdc.MustUnmarshal(kvA.Value, &reconA)
+			cdc.MustUnmarshal(kvB.Value, &reconB)
+
+			return fmt.Sprintf("%v\n%v", reconA, reconB)
+		case bytes.Equal(kvA.Key[:1], types.SupplyChangePolicyPrefix):
+			var policyA, policyB types.SupplyChangePolicy
+
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
+		case bytes.Equal(kvA.Key[:1], types.SupplyChangeUsagePrefix):
+			usageA, _ := types.ParseSupplyChangeUsage(kvA.Value)
+			usageB, _ := types.ParseSupplyChangeUsage(kvB.Value)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+		case bytes.Equal(kvA.Key[:1], types.ReceiptPolicyPrefix):
+			var policyA, policyB types.ReceiptPolicy
+
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
+		case bytes.Equal(kvA.Key[:1], types.PendingReceiptPrefix):
+			var receiptA, receiptB types.PendingReceipt
+
+			cdc.MustUnmarshal(kvA.Value, &receiptA)
+			cdc.MustUnmarshal(kvB.Value, &receiptB)
+
+			return fmt.Sprintf("%v\n%v", receiptA, receiptB)
+		case bytes.Equal(kvA.Key[:1], types.LastPendingReceiptIDKey):
+			return fmt.Sprintf("%v\n%v", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.ContractSupplyCapPrefix):
+			var capA, capB types.ContractSupplyCap
+
+			cdc.MustUnmarshal(kvA.Value, &capA)
+			cdc.MustUnmarshal(kvB.Value, &capB)
+
+			return fmt.Sprintf("%v\n%v", capA, capB)
+		case bytes.Equal(kvA.Key[:1], types.PendingReceiptExpirationPrefix):
+			heightA, _, idA, _ := types.ParsePendingReceiptExpirationKey(kvA.Key)
+			heightB, _, idB, _ := types.ParsePendingReceiptExpirationKey(kvB.Key)
+			return fmt.Sprintf("%d: %d\n%d: %d", heightA, idA, heightB, idB)
+		case bytes.Equal(kvA.Key[:1], types.ReserveAttestationExpirationPrefix):
+			timeA, markerA, attestorA, _ := types.ParseReserveAttestationExpirationKey(kvA.Key)
+			timeB, markerB, attestorB, _ := types.ParseReserveAttestationExpirationKey(kvB.Key)
+			return fmt.Sprintf("%s: %s: %s\n%s: %s: %s", timeA.Format(time.RFC3339), markerA, attestorA, timeB.Format(time.RFC3339), markerB, attestorB)
+		case bytes.Equal(kvA.Key[:1], types.PolicyChangeEffectiveHeightPrefix):
+			heightA, _, idA, _ := types.ParsePolicyChangeEffectiveHeightKey(kvA.Key)
+			heightB, _, idB, _ := types.ParsePolicyChangeEffectiveHeightKey(kvB.Key)
+			return fmt.Sprintf("%d: %d\n%d: %d", heightA, idA, heightB, idB)
+		case bytes.Equal(kvA.Key[:1], types.SwapOfferExpirationPrefix):
+			heightA, idA, _ := types.ParseSwapOfferExpirationKey(kvA.Key)
+			heightB, idB, _ := types.ParseSwapOfferExpirationKey(kvB.Key)
+			return fmt.Sprintf("%d: %d\n%d: %d", heightA, idA, heightB, idB)
+		default:
+			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/simulation"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := app.MakeTestEncodingConfig(t).Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	markerAddr := types.MustGetMarkerAddress("testcoin")
+	denyAddr := sdk.AccAddress("deny_address________")
+	nav := types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 100), 10)
+	params := types.DefaultParams()
+	pointer := types.NewERC20Pointer("testcoin", "eip155:1", "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238")
+	adminGrant := types.NewPendingAdminGrant("testcoin", denyAddr, markerAddr)
+	policyChange := types.ScheduledPolicyChange{Id: 3, Denom: "testcoin", EffectiveHeight: 10, AddRequiredAttributes: []string{"kyc.pb"}, ScheduledBy: markerAddr.String()}
+	swapOffer := types.NewSwapOffer(4, markerAddr, nil, sdk.NewInt64Coin("testcoin", 5), sdk.NewInt64Coin("othercoin", 7), 20)
+	tranche := types.IssuanceTranche{Denom: "testcoin", Id: 2, Amount: sdkmath.NewInt(100), MintedBy: denyAddr.String()}
+	subLedgerEntry := types.SubLedgerEntry{Custodian: denyAddr.String(), Id: 5, SubAccount: "client-1", Amount: sdk.NewInt64Coin("testcoin", 3), Credit: true, Counterparty: markerAddr.String()}
+	bridged := types.NewBridgedSupply("testcoin", "channel-3", "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", "uatom", 100)
+	recon := types.NewBridgedSupplyReconciliation("testcoin", sdkmath.NewInt(10), sdkmath.NewInt(10), 5)
+	supplyPolicy := types.NewSupplyChangePolicy("testcoin", sdkmath.LegacyNewDecWithPrec(1, 1))
+	supplyUsage := types.SupplyChangeUsage{Height: 12, BaseSupply: sdkmath.NewInt(1000), Changed: sdkmath.NewInt(40)}
+	receiptPolicy := types.NewReceiptPolicy("testcoin", 50)
+	contractCap := types.NewContractSupplyCap("testcoin", denyAddr.String(), sdkmath.NewInt(100), sdkmath.NewInt(50))
+	receipt := types.PendingReceipt{Id: 6, FromAddress: markerAddr.String(), ToAddress: denyAddr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("testcoin", 3)), ExpirationHeight: 60}
+	bridge := types.NewMarkerBridge("cctp", "testcoin", [][]byte{make([]byte, 33)}, 1, sdkmath.NewInt(100))
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.MarkerStoreKey(markerAddr), Value: markerAddr},
+			{Key: types.DenySendKey(markerAddr, denyAddr), Value: []byte{}},
+			{Key: types.NetAssetValueKey(markerAddr, types.UsdDenom), Value: cdc.MustMarshal(&nav)},
+			{Key: types.MarkerParamStoreKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.ERC20PointerKey(markerAddr, pointer.ChainId), Value: cdc.MustMarshal(&pointer)},
+			{Key: types.ERC20ContractKey(pointer.ChainId, pointer.ContractAddress), Value: []byte(pointer.Denom)},
+			{Key: types.BridgeKey(bridge.Name), Value: cdc.MustMarshal(&bridge)},
+			{Key: types.BridgeNonceKey(bridge.Name, 7), Value: []byte{0x01}},
+			{Key: types.DenySendAddressKey(denyAddr, markerAddr), Value: []byte{}},
+			{Key: types.PendingAdminGrantKey(markerAddr, denyAddr), Value: cdc.MustMarshal(&adminGrant)},
+			{Key: types.ReqAttrBypassAddrKey(denyAddr), Value: []byte{}},
+			{Key: types.ScheduledPolicyChangeKey(markerAddr, policyChange.Id), Value: cdc.MustMarshal(&policyChange)},
+			{Key: types.LastPolicyChangeIDKey, Value: []byte{0, 0, 0, 0, 0, 0, 0, 3}},
+			{Key: types.SwapOfferKey(swapOffer.Id), Value: cdc.MustMarshal(&swapOffer)},
+			{Key: types.LastSwapOfferIDKey, Value: []byte{0, 0, 0, 0, 0, 0, 0, 4}},
+			{Key: types.IssuanceTrancheKey(markerAddr, tranche.Id), Value: cdc.MustMarshal(&tranche)},
+			{Key: types.SubLedgerEntryKey(denyAddr, subLedgerEntry.Id), Value: cdc.MustMarshal(&subLedgerEntry)},
+			{Key: types.SubLedgerSubAccountIndexKey(denyAddr, subLedgerEntry.SubAccount, subLedgerEntry.Id), Value: []byte{}},
+			{Key: types.BridgedSupplyKey(markerAddr), Value: cdc.MustMarshal(&bridged)},
+			{Key: types.BridgedSupplyReconciliationKey(markerAddr), Value: cdc.MustMarshal(&recon)},
+			{Key: types.SupplyChangePolicyKey(markerAddr), Value: cdc.MustMarshal(&supplyPolicy)},
+			{Key: types.ReceiptPolicyKey(markerAddr), Value: cdc.MustMarshal(&receiptPolicy)},
+			{Key: types.PendingReceiptKey(denyAddr, receipt.Id), Value: cdc.MustMarshal(&receipt)},
+			{Key: types.LastPendingReceiptIDKey, Value: []byte{0, 0, 0, 0, 0, 0, 0, 6}},
+			{Key: types.ContractSupplyCapKey(markerAddr, denyAddr), Value: cdc.MustMarshal(&contractCap)},
+			{Key: types.PendingReceiptExpirationKey(receipt.ExpirationHeight, denyAddr, receipt.Id), Value: []byte{}},
+			{Key: types.SwapOfferExpirationKey(swapOffer.ExpirationHeight, swapOffer.Id), Value: []byte{}},
+			{Key: types.ReserveAttestationExpirationKey(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), markerAddr, denyAddr), Value: []byte{}},
+			{Key: types.PolicyChangeEffectiveHeightKey(policyChange.EffectiveHeight, markerAddr, policyChange.Id), Value: []byte{}},
+			{Key: types.SupplyChangeUsageKey(markerAddr), Value: supplyUsage.Bytes()},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Marker Address", fmt.Sprintf("%v\n%v", markerAddr, markerAddr)},
+		{"Deny Send", fmt.Sprintf("%v: %v\n%v: %v", markerAddr, denyAddr, markerAddr, denyAddr)},
+		{"Net Asset Value", fmt.Sprintf("%v\n%v", nav, nav)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"ERC-20 Pointer", fmt.Sprintf("%v\n%v", pointer, pointer)},
+		{"ERC-20 Contract", "testcoin\ntestcoin"},
+		{"Bridge", fmt.Sprintf("%v\n%v", bridge, bridge)},
+		{"Bridge Nonce", "[1]\n[1]"},
+		{"Deny Send Address", fmt.Sprintf("%v: %v\n%v: %v", denyAddr, markerAddr, denyAddr, markerAddr)},
+		{"Pending Admin Grant", fmt.Sprintf("%v\n%v", adminGrant, adminGrant)},
+		{"Req Attr Bypass Addr", fmt.Sprintf("%v\n%v", denyAddr, denyAddr)},
+		{"Scheduled Policy Change", fmt.Sprintf("%v\n%v", policyChange, policyChange)},
+		{"Last Policy Change ID", "3\n3"},
+		{"Swap Offer", fmt.Sprintf("%v\n%v", swapOffer, swapOffer)},
+		{"Last Swap Offer ID", "4\n4"},
+		{"Issuance Tranche", fmt.Sprintf("%v\n%v", tranche, tranche)},
+		{"Sub-Ledger Entry", fmt.Sprintf("%v\n%v", subLedgerEntry, subLedgerEntry)},
+		{"Sub-Ledger Sub-Account Index", "5\n5"},
+		{"Bridged Supply", fmt.Sprintf("%v\n%v", bridged, bridged)},
+		{"Bridged Supply Reconciliation", fmt.Sprintf("%v\n%v", recon, recon)},
+		{"Supply Change Policy", fmt.Sprintf("%v\n%v", supplyPolicy, supplyPolicy)},
+		{"Receipt Policy", fmt.Sprintf("%v\n%v", receiptPolicy, receiptPolicy)},
+		{"Pending Receipt", fmt.Sprintf("%v\n%v", receipt, receipt)},
+		{"Last Pending Receipt ID", "6\n6"},
+		{"Contract Supply Cap", fmt.Sprintf("%v\n%v", contractCap, contractCap)},
+		{"Pending Receipt Expiration", "60: 6\n60: 6"},
+		{"Swap Offer Expiration", "20: 4\n20: 4"},
+		{"Reserve Attestation Expiration", fmt.Sprintf("2026-06-01T00:00:00Z: %s: %s\n2026-06-01T00:00:00Z: %s: %s", markerAddr, denyAddr, markerAddr, denyAddr)},
+		{"Policy Change Effective Height", "10: 3\n10: 3"},
+		{"Supply Change Usage", fmt.Sprintf("%v\n%v", supplyUsage, supplyUsage)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"math/rand"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +21,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -44,6 +47,14 @@ const (
 	OpWeightMsgSetAccountData = "op_weight_msg_set_account_data"
 	//nolint:gosec // not credentials
 	OpWeightMsgUpdateSendDenyList = "op_weight_msg_update_send_deny_list"
+	//nolint:gosec // not credentials
+	OpWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
+	//nolint:gosec // not credentials
+	OpWeightMsgAddNetAssetValues = "op_weight_msg_add_net_asset_values"
+	//nolint:gosec // not credentials
+	OpWeightMsgGrantAllowance = "op_weight_msg_grant_allowance"
+	//nolint:gosec // not credentials
+	OpWeightMsgTransfer = "op_weight_msg_transfer"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -68,6 +79,10 @@ func WeightedOperations(
 		wMsgAddMarkerProposal  int
 		wMsgSetAccountData     int
 		wMsgUpdateSendDenyList int
+		wMsgSetDenomMetadata   int
+		wMsgAddNetAssetValues  int
+		wMsgGrantAllowance     int
+		wMsgTransfer           int
 	)
 
 	simState.AppParams.GetOrGenerate(OpWeightMsgAddMarker, &wMsgAddMarker, nil,
@@ -84,6 +99,14 @@ func WeightedOperations(
 		func(_ *rand.Rand) { wMsgSetAccountData = simappparams.DefaultWeightMsgSetAccountData })
 	simState.AppParams.GetOrGenerate(OpWeightMsgUpdateSendDenyList, &wMsgUpdateSendDenyList, nil,
 		func(_ *rand.Rand) { wMsgUpdateSendDenyList = simappparams.DefaultWeightMsgUpdateDenySendList })
+	simState.AppParams.GetOrGenerate(OpWeightMsgSetDenomMetadata, &wMsgSetDenomMetadata, nil,
+		func(_ *rand.Rand) { wMsgSetDenomMetadata = simappparams.DefaultWeightMsgSetDenomMetadata })
+	simState.AppParams.GetOrGenerate(OpWeightMsgAddNetAssetValues, &wMsgAddNetAssetValues, nil,
+		func(_ *rand.Rand) { wMsgAddNetAssetValues = simappparams.DefaultWeightMsgAddNetAssetValues })
+	simState.AppParams.GetOrGenerate(OpWeightMsgGrantAllowance, &wMsgGrantAllowance, nil,
+		func(_ *rand.Rand) { wMsgGrantAllowance = simappparams.DefaultWeightMsgGrantAllowance })
+	simState.AppParams.GetOrGenerate(OpWeightMsgTransfer, &wMsgTransfer, nil,
+		func(_ *rand.Rand) { wMsgTransfer = simappparams.DefaultWeightMsgTransfer })
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(wMsgAddMarker, SimulateMsgAddMarker(k, args)),
@@ -93,6 +116,10 @@ func WeightedOperations(
 		simulation.NewWeightedOperation(wMsgAddMarkerProposal, SimulateMsgAddMarkerProposal(k, args)),
 		simulation.NewWeightedOperation(wMsgSetAccountData, SimulateMsgSetAccountData(k, args)),
 		simulation.NewWeightedOperation(wMsgUpdateSendDenyList, SimulateMsgUpdateSendDenyList(k, args)),
+		simulation.NewWeightedOperation(wMsgSetDenomMetadata, SimulateMsgSetDenomMetadata(k, args)),
+		simulation.NewWeightedOperation(wMsgAddNetAssetValues, SimulateMsgAddNetAssetValues(k, args)),
+		simulation.NewWeightedOperation(wMsgGrantAllowance, SimulateMsgGrantAllowance(k, args)),
+		simulation.NewWeightedOperation(wMsgTransfer, SimulateMsgTransfer(k, args)),
 	}
 }
 
@@ -186,6 +213,10 @@ func SimulateMsgAddAccess(k keeper.Keeper, args *WeightedOpsArgs) simtypes.Opera
 			simAccount, _ = simtypes.FindAccount(accs, m.GetManager())
 		}
 		grants := randomAccessGrants(r, accs, 100, m.GetMarkerType())
+		if m.GetStatus() != types.StatusProposed {
+			// Admin access on a live marker can only be granted using a propose admin request.
+			_ = grants[0].RemoveAccess(types.Access_Admin)
+		}
 		msg := types.NewMsgAddAccessRequest(m.GetDenom(), simAccount.Address, grants[0])
 		return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, simAccount, chainID, msg, nil)
 	}
@@ -359,10 +390,148 @@ func SimulateMsgUpdateSendDenyList(k keeper.Keeper, args *WeightedOpsArgs) simty
 		msg.AddDeniedAddresses = addDenyAddresses
 		msg.Authority = signer.Address.String()
 
+		// 1 in 3 chance of also removing some of the addresses already on the deny list.
+		denied := k.GetSendDenyList(ctx, marker.GetAddress())
+		if len(denied) > 0 && r.Intn(3) == 0 {
+			r.Shuffle(len(denied), func(i, j int) {
+				denied[i], denied[j] = denied[j], denied[i]
+			})
+			for _, addr := range denied[:r.Intn(len(denied))+1] {
+				msg.RemoveDeniedAddresses = append(msg.RemoveDeniedAddresses, addr.String())
+			}
+		}
+
+		return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, signer, chainID, msg, nil)
+	}
+}
+
+// SimulateMsgSetDenomMetadata will set randomized denom metadata for a marker.
+func SimulateMsgSetDenomMetadata(k keeper.Keeper, args *WeightedOpsArgs) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgSetDenomMetadataRequest{}
+
+		marker, signer := randomMarkerWithAccessSigner(r, ctx, k, accs, types.Access_Admin)
+		if marker == nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to find marker with an admin signer"), nil, nil
+		}
+
+		msg.Metadata = randomDenomMetadata(r, ctx, k, args.BK, marker.GetDenom())
+		msg.Administrator = signer.Address.String()
+
+		return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, signer, chainID, msg, nil)
+	}
+}
+
+// SimulateMsgAddNetAssetValues will add randomized net asset values to a marker.
+func SimulateMsgAddNetAssetValues(k keeper.Keeper, args *WeightedOpsArgs) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgAddNetAssetValuesRequest{}
+
+		// Any access on the marker allows the setting of net asset values.
+		access := []types.Access{types.Access_Mint, types.Access_Burn, types.Access_Deposit, types.Access_Withdraw, types.Access_Admin}
+		marker, signer := randomMarkerWithAccessSigner(r, ctx, k, accs, access[r.Intn(len(access))])
+		if marker == nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to find marker with an access signer"), nil, nil
+		}
+
+		navs := []types.NetAssetValue{
+			types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, r.Int63n(1_000_000)+1), uint64(r.Int63n(1_000_000)+1)),
+		}
+		// 1 in 3 chance of also valuing the marker in the denom of another marker.
+		if other := randomMarker(r, ctx, k); r.Intn(3) == 0 && other != nil && other.GetDenom() != marker.GetDenom() {
+			navs = append(navs, types.NewNetAssetValue(sdk.NewInt64Coin(other.GetDenom(), r.Int63n(1_000_000)+1), uint64(r.Int63n(1_000_000)+1)))
+		}
+
+		msg.Denom = marker.GetDenom()
+		msg.NetAssetValues = navs
+		msg.Administrator = signer.Address.String()
+
 		return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, signer, chainID, msg, nil)
 	}
 }
 
+// SimulateMsgGrantAllowance will grant a randomized fee allowance from a marker to a random account.
+func SimulateMsgGrantAllowance(k keeper.Keeper, args *WeightedOpsArgs) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		marker, signer := randomMarkerWithAccessSigner(r, ctx, k, accs, types.Access_Admin)
+		if marker == nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgGrantAllowanceRequest{}), "unable to find marker with an admin signer"), nil, nil
+		}
+
+		grantee, _ := simtypes.RandomAcc(r, accs)
+		expiration := ctx.BlockTime().Add(time.Duration(r.Intn(720)+1) * time.Hour)
+		allowance := &feegrant.BasicAllowance{
+			SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1_000_000)+1)),
+			Expiration: &expiration,
+		}
+
+		msg, err := types.NewMsgGrantAllowance(marker.GetDenom(), signer.Address, grantee.Address, allowance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgGrantAllowanceRequest{}), "unable to create allowance msg"), nil, err
+		}
+
+		return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, signer, chainID, msg, nil)
+	}
+}
+
+// SimulateMsgTransfer will transfer funds of an active restricted marker between random accounts.
+// When the marker allows it, there's a 1 in 2 chance that it's a forced transfer from another account.
+func SimulateMsgTransfer(k keeper.Keeper, args *WeightedOpsArgs) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msg := &types.MsgTransferRequest{}
+
+		var markers []types.MarkerAccountI
+		k.IterateMarkers(ctx, func(marker types.MarkerAccountI) (stop bool) {
+			if marker.GetStatus() == types.StatusActive && marker.GetMarkerType() == types.MarkerType_RestrictedCoin {
+				markers = append(markers, marker)
+			}
+			return false
+		})
+		r.Shuffle(len(markers), func(i, j int) {
+			markers[i], markers[j] = markers[j], markers[i]
+		})
+
+		for _, marker := range markers {
+			forced := marker.AllowsForcedTransfer() && r.Intn(2) == 0
+			access := types.Access_Transfer
+			if forced {
+				access = types.Access_ForceTransfer
+			}
+			signer, found := randomAccWithAccess(r, marker, accs, access)
+			if !found {
+				continue
+			}
+
+			from := signer
+			if forced {
+				if from, found = randomForceTransferSource(r, ctx, args.AK, args.BK, accs, marker.GetDenom(), signer.Address); !found {
+					continue
+				}
+			}
+			balance := args.BK.GetBalance(ctx, from.Address, marker.GetDenom())
+			if !balance.IsPositive() {
+				continue
+			}
+
+			to, _ := simtypes.RandomAcc(r, accs)
+			amount := sdkmath.NewIntFromBigInt(sdkmath.ZeroInt().BigInt().Rand(r, balance.Amount.BigInt())).AddRaw(1)
+			msg = types.NewMsgTransferRequest(signer.Address, from.Address, to.Address, sdk.NewCoin(marker.GetDenom(), amount))
+
+			return Dispatch(r, app, ctx, args.SimState, args.AK, args.BK, signer, chainID, msg, nil)
+		}
+
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to find restricted marker with a funded transfer"), nil, nil
+	}
+}
+
 // Dispatch sends an operation to the chain using a given account/funds on account for fees.  Failures on the server side
 // are handled as no-op msg operations with the error string as the status/response.
 func Dispatch(
@@ -460,7 +629,7 @@ func randomAccessGrants(r *rand.Rand, accs []simtypes.Account, limit int, marker
 func randomAccessTypes(r *rand.Rand, markerType types.MarkerType) (result []types.Access) {
 	access := []string{"mint", "burn", "deposit", "withdraw", "delete", "admin"}
 	if markerType == types.MarkerType_RestrictedCoin {
-		access = append(access, "transfer")
+		access = append(access, "transfer", "forcetransfer")
 	}
 	for i := 0; i < len(access); i++ {
 		if r.Intn(10) < 4 {
@@ -530,6 +699,55 @@ func randomAccWithAccess(r *rand.Rand, marker types.MarkerAccountI, accs []simty
 	return simtypes.Account{}, false
 }
 
+// randomForceTransferSource returns a random account, other than the one excluded, that has a balance of the denom.
+// Funds can only be forcibly transferred out of accounts that have signed a tx, so only those are considered.
+func randomForceTransferSource(
+	r *rand.Rand, ctx sdk.Context, ak authkeeper.AccountKeeperI, bk bankkeeper.Keeper,
+	accs []simtypes.Account, denom string, exclude sdk.AccAddress,
+) (simtypes.Account, bool) {
+	var holders []simtypes.Account
+	for _, acc := range accs {
+		if acc.Address.Equals(exclude) || !bk.GetBalance(ctx, acc.Address, denom).IsPositive() {
+			continue
+		}
+		if account := ak.GetAccount(ctx, acc.Address); account != nil && account.GetSequence() != 0 {
+			holders = append(holders, acc)
+		}
+	}
+	if len(holders) == 0 {
+		return simtypes.Account{}, false
+	}
+	return holders[r.Intn(len(holders))], true
+}
+
+// randomDenomMetadata returns denom metadata for the denom with a random description.
+// If the denom already has metadata, its denom units are kept since they can't always be changed.
+// Otherwise, the display denom is a randomly SI prefixed version of the denom.
+func randomDenomMetadata(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper, denom string) banktypes.Metadata {
+	description := simtypes.RandStringOfLength(r, r.Intn(100)+1)
+	if md, found := bk.GetDenomMetaData(ctx, denom); found {
+		md.Description = description
+		return md
+	}
+
+	md := banktypes.Metadata{
+		Description: description,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      strings.ToUpper(denom),
+	}
+	prefixes := []types.SIPrefix{types.SI_PREFIX_KILO, types.SI_PREFIX_MEGA, types.SI_PREFIX_GIGA}
+	prefix := prefixes[r.Intn(len(prefixes))]
+	display := prefix.GetName() + denom
+	if err := k.ValidateUnrestictedDenom(ctx, display); err == nil {
+		md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: uint32(prefix.GetExponent())}) //nolint:gosec // G115: Prefix exponents are all small and positive.
+		md.Display = display
+	}
+	return md
+}
+
 func randomInt63(r *rand.Rand, maxVal int64) (result int64) {
 	if maxVal == 0 {
 		return 0
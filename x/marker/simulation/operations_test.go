@@ -112,6 +112,10 @@ func (s *SimTestSuite) TestWeightedOperations() {
 		{weight: simappparams.DefaultWeightMsgAddMarkerProposal, opMsgRoute: "gov", opMsgName: sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{})},
 		{weight: simappparams.DefaultWeightMsgSetAccountData, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgSetAccountDataRequest{})},
 		{weight: simappparams.DefaultWeightMsgUpdateDenySendList, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgUpdateSendDenyListRequest{})},
+		{weight: simappparams.DefaultWeightMsgSetDenomMetadata, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgSetDenomMetadataRequest{})},
+		{weight: simappparams.DefaultWeightMsgAddNetAssetValues, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgAddNetAssetValuesRequest{})},
+		{weight: simappparams.DefaultWeightMsgGrantAllowance, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgGrantAllowanceRequest{})},
+		{weight: simappparams.DefaultWeightMsgTransfer, opMsgRoute: types.RouterKey, opMsgName: sdk.MsgTypeURL(&types.MsgTransferRequest{})},
 	}
 
 	expNames := make([]string, len(expected))
@@ -457,6 +461,159 @@ func (s *SimTestSuite) TestSimulateMsgUpdateSendDenyList() {
 	s.Assert().Len(futureOperations, 0, "futureOperations")
 }
 
+func (s *SimTestSuite) TestSimulateMsgSetDenomMetadata() {
+	// setup 3 accounts
+	src := rand.NewSource(1)
+	r := rand.New(src)
+	accounts := s.getTestingAccounts(r, 3)
+	s.addSimMarker(accounts[1], false)
+
+	// execute operation
+	op := simulation.SimulateMsgSetDenomMetadata(s.app.MarkerKeeper, s.getWeightedOpsArgs())
+	operationMsg, futureOperations, err := op(r, s.app.BaseApp, s.ctx, accounts, "")
+	s.Require().NoError(err, "SimulateMsgSetDenomMetadata op(...) error")
+	s.LogOperationMsg(operationMsg)
+
+	var msg types.MsgSetDenomMetadataRequest
+	s.Require().NoError(s.app.AppCodec().Unmarshal(operationMsg.Msg, &msg), "UnmarshalJSON(operationMsg.Msg)")
+
+	s.Assert().True(operationMsg.OK, "operationMsg.OK")
+	s.Assert().Equal(sdk.MsgTypeURL(&msg), operationMsg.Name, "operationMsg.Name")
+	s.Assert().Equal("simcoin", msg.Metadata.Base, "msg.Metadata.Base")
+	s.Assert().Equal(accounts[1].Address.String(), msg.Administrator, "msg.Administrator")
+	s.Assert().Equal(types.RouterKey, operationMsg.Route, "operationMsg.Route")
+	s.Assert().Len(futureOperations, 0, "futureOperations")
+
+	md, found := s.app.BankKeeper.GetDenomMetaData(s.ctx, "simcoin")
+	s.Assert().True(found, "GetDenomMetaData found")
+	s.Assert().Equal(msg.Metadata, md, "GetDenomMetaData")
+}
+
+func (s *SimTestSuite) TestSimulateMsgAddNetAssetValues() {
+	// setup 3 accounts
+	src := rand.NewSource(1)
+	r := rand.New(src)
+	accounts := s.getTestingAccounts(r, 3)
+	s.addSimMarker(accounts[1], false)
+
+	// execute operation
+	op := simulation.SimulateMsgAddNetAssetValues(s.app.MarkerKeeper, s.getWeightedOpsArgs())
+	operationMsg, futureOperations, err := op(r, s.app.BaseApp, s.ctx, accounts, "")
+	s.Require().NoError(err, "SimulateMsgAddNetAssetValues op(...) error")
+	s.LogOperationMsg(operationMsg)
+
+	var msg types.MsgAddNetAssetValuesRequest
+	s.Require().NoError(s.app.AppCodec().Unmarshal(operationMsg.Msg, &msg), "UnmarshalJSON(operationMsg.Msg)")
+
+	s.Assert().True(operationMsg.OK, "operationMsg.OK")
+	s.Assert().Equal(sdk.MsgTypeURL(&msg), operationMsg.Name, "operationMsg.Name")
+	s.Assert().Equal("simcoin", msg.Denom, "msg.Denom")
+	if s.Assert().NotEmpty(msg.NetAssetValues, "msg.NetAssetValues") {
+		s.Assert().Equal(types.UsdDenom, msg.NetAssetValues[0].Price.Denom, "msg.NetAssetValues[0].Price.Denom")
+	}
+	s.Assert().Equal(accounts[1].Address.String(), msg.Administrator, "msg.Administrator")
+	s.Assert().Equal(types.RouterKey, operationMsg.Route, "operationMsg.Route")
+	s.Assert().Len(futureOperations, 0, "futureOperations")
+}
+
+func (s *SimTestSuite) TestSimulateMsgGrantAllowance() {
+	// setup 3 accounts
+	src := rand.NewSource(1)
+	r := rand.New(src)
+	accounts := s.getTestingAccounts(r, 3)
+	s.addSimMarker(accounts[1], false)
+	s.ctx = s.ctx.WithBlockTime(time.Now())
+
+	// execute operation
+	op := simulation.SimulateMsgGrantAllowance(s.app.MarkerKeeper, s.getWeightedOpsArgs())
+	operationMsg, futureOperations, err := op(r, s.app.BaseApp, s.ctx, accounts, "")
+	s.Require().NoError(err, "SimulateMsgGrantAllowance op(...) error")
+	s.LogOperationMsg(operationMsg)
+
+	var msg types.MsgGrantAllowanceRequest
+	s.Require().NoError(s.app.AppCodec().Unmarshal(operationMsg.Msg, &msg), "UnmarshalJSON(operationMsg.Msg)")
+
+	s.Assert().True(operationMsg.OK, "operationMsg.OK")
+	s.Assert().Equal(sdk.MsgTypeURL(&msg), operationMsg.Name, "operationMsg.Name")
+	s.Assert().Equal("simcoin", msg.Denom, "msg.Denom")
+	s.Assert().Equal(accounts[1].Address.String(), msg.Administrator, "msg.Administrator")
+	s.Assert().Equal(types.RouterKey, operationMsg.Route, "operationMsg.Route")
+	s.Assert().Len(futureOperations, 0, "futureOperations")
+}
+
+func (s *SimTestSuite) TestSimulateMsgTransfer() {
+	// setup 3 accounts
+	src := rand.NewSource(1)
+	r := rand.New(src)
+	accounts := s.getTestingAccounts(r, 3)
+
+	op := simulation.SimulateMsgTransfer(s.app.MarkerKeeper, s.getWeightedOpsArgs())
+
+	s.Run("no funded restricted marker", func() {
+		s.addSimMarker(accounts[1], true)
+		operationMsg, futureOperations, err := op(r, s.app.BaseApp, s.ctx, accounts, "")
+		s.Require().NoError(err, "SimulateMsgTransfer op(...) error")
+		s.LogOperationMsg(operationMsg)
+		s.Assert().False(operationMsg.OK, "operationMsg.OK")
+		s.Assert().Equal("unable to find restricted marker with a funded transfer", operationMsg.Comment, "operationMsg.Comment")
+		s.Assert().Len(futureOperations, 0, "futureOperations")
+	})
+
+	s.Run("funded restricted marker", func() {
+		// Give some of the simcoin to an account that isn't the admin so that it's available for forced transfers.
+		markerMsgServer := keeper.NewMsgServerImpl(s.app.MarkerKeeper)
+		withdraw := types.NewMsgWithdrawRequest(accounts[1].Address, accounts[2].Address, "simcoin",
+			sdk.NewCoins(sdk.NewInt64Coin("simcoin", 100)))
+		_, err := markerMsgServer.Withdraw(s.ctx, withdraw)
+		s.Require().NoError(err, "Withdraw")
+		// Forced transfers are only allowed from accounts that have signed a tx.
+		acc := s.app.AccountKeeper.GetAccount(s.ctx, accounts[2].Address)
+		s.Require().NoError(acc.SetSequence(1), "SetSequence(1)")
+		s.app.AccountKeeper.SetAccount(s.ctx, acc)
+
+		operationMsg, futureOperations, err := op(r, s.app.BaseApp, s.ctx, accounts, "")
+		s.Require().NoError(err, "SimulateMsgTransfer op(...) error")
+		s.LogOperationMsg(operationMsg)
+
+		var msg types.MsgTransferRequest
+		s.Require().NoError(s.app.AppCodec().Unmarshal(operationMsg.Msg, &msg), "UnmarshalJSON(operationMsg.Msg)")
+
+		s.Assert().True(operationMsg.OK, "operationMsg.OK")
+		s.Assert().Equal(sdk.MsgTypeURL(&msg), operationMsg.Name, "operationMsg.Name")
+		s.Assert().Equal("simcoin", msg.Amount.Denom, "msg.Amount.Denom")
+		s.Assert().Equal(accounts[1].Address.String(), msg.Administrator, "msg.Administrator")
+		s.Assert().Equal(accounts[2].Address.String(), msg.FromAddress, "msg.FromAddress")
+		s.Assert().Equal(types.RouterKey, operationMsg.Route, "operationMsg.Route")
+		s.Assert().Len(futureOperations, 0, "futureOperations")
+	})
+}
+
+// addSimMarker adds an active restricted simcoin marker with the admin having all permissions on it.
+func (s *SimTestSuite) addSimMarker(admin simtypes.Account, allowForcedTransfer bool) {
+	newMarker := &types.MsgAddFinalizeActivateMarkerRequest{
+		Amount:      sdk.NewInt64Coin("simcoin", 1000),
+		Manager:     admin.Address.String(),
+		FromAddress: admin.Address.String(),
+		MarkerType:  types.MarkerType_RestrictedCoin,
+		AccessList: []types.AccessGrant{
+			{
+				Address: admin.Address.String(),
+				Permissions: types.AccessList{
+					types.Access_Mint, types.Access_Burn, types.Access_Deposit, types.Access_Withdraw,
+					types.Access_Delete, types.Access_Admin, types.Access_Transfer, types.Access_ForceTransfer,
+				},
+			},
+		},
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+		AllowForcedTransfer:    allowForcedTransfer,
+		RequiredAttributes:     nil,
+	}
+	markerMsgServer := keeper.NewMsgServerImpl(s.app.MarkerKeeper)
+	_, err := markerMsgServer.AddFinalizeActivateMarker(s.ctx, newMarker)
+	s.Require().NoError(err, "AddFinalizeActivateMarker")
+}
+
 func (s *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
 	return testutil.GenerateTestingAccounts(s.T(), s.ctx, s.app, r, n)
 }
@@ -23,6 +23,7 @@ type AccessGrantI interface {
 
 	HasAccess(Access) bool
 	GetAccessList() []Access
+	GetRoles() []string
 
 	AddAccess(Access) error
 	RemoveAccess(Access) error
@@ -67,6 +68,25 @@ func AccessListByNames(names string) AccessList {
 	return result
 }
 
+// RolePrefix is the prefix used to identify a role template name in a list of access names, e.g. "role:issuer".
+const RolePrefix = "role:"
+
+// AccessListAndRolesByNames takes a comma separated list of access and role names and returns the AccessList and
+// role names it contains. Role names are identified by the RolePrefix, e.g. "mint,role:treasury".
+func AccessListAndRolesByNames(names string) (AccessList, []string) {
+	var accessList AccessList
+	var roles []string
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if role, isRole := strings.CutPrefix(strings.ToLower(name), RolePrefix); isRole {
+			roles = append(roles, NormalizeRoleName(role))
+			continue
+		}
+		accessList = append(accessList, AccessByName(name))
+	}
+	return accessList, roles
+}
+
 // ValidateGrants checks a collection of grants and returns any errors encountered or nil
 func ValidateGrants(grants ...AccessGrant) error {
 	registered := make(map[string]bool)
@@ -88,7 +108,7 @@ func GrantsForAddress(account sdk.AccAddress, grants ...AccessGrant) AccessGrant
 			return grant
 		}
 	}
-	return AccessGrant{account.String(), []Access{}}
+	return AccessGrant{Address: account.String(), Permissions: []Access{}}
 }
 
 // GetAddress returns the account address the access grant belongs to
@@ -105,11 +125,30 @@ func (ag AccessGrant) GetAccessList() AccessList {
 	return ag.Permissions
 }
 
+// GetRoles returns the names of the role templates this grant was made from
+func (ag AccessGrant) GetRoles() []string {
+	return ag.Roles
+}
+
+// HasRole returns true if this grant was made from the role template with the provided name
+func (ag AccessGrant) HasRole(name string) bool {
+	name = NormalizeRoleName(name)
+	for _, role := range ag.Roles {
+		if NormalizeRoleName(role) == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate performs checks to ensure this acccess grant is properly formed.
 func (ag AccessGrant) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(ag.Address); err != nil {
 		return fmt.Errorf("invalid address: %w", err)
 	}
+	if err := validateRoleNames(ag.Roles); err != nil {
+		return err
+	}
 	return validateAccess(ag.Permissions)
 }
 
@@ -154,6 +193,11 @@ func (ag *AccessGrant) MergeAdd(other AccessGrant) error {
 			ag.Permissions = append(ag.Permissions, p)
 		}
 	}
+	for _, role := range other.GetRoles() {
+		if !ag.HasRole(role) {
+			ag.Roles = append(ag.Roles, role)
+		}
+	}
 	return nil
 }
 
@@ -172,6 +216,13 @@ func (ag *AccessGrant) MergeRemove(other AccessGrant) error {
 		}
 	}
 	ag.Permissions = newPerms
+	var newRoles []string
+	for _, role := range ag.Roles {
+		if !other.HasRole(role) {
+			newRoles = append(newRoles, role)
+		}
+	}
+	ag.Roles = newRoles
 	return nil
 }
 
@@ -186,6 +237,9 @@ func (ag AccessGrant) String() string {
 			result = fmt.Sprintf("%s, %s", result, perm)
 		}
 	}
+	if len(ag.Roles) > 0 {
+		return fmt.Sprintf("AccessGrant: %s [%s] roles [%s]", ag.Address, result, strings.Join(ag.Roles, ", "))
+	}
 	return fmt.Sprintf("AccessGrant: %s [%s]", ag.Address, result)
 }
 
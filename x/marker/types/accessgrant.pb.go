@@ -94,6 +94,9 @@ func (Access) EnumDescriptor() ([]byte, []int) {
 type AccessGrant struct {
 	Address     string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+	// roles are the names of the role templates this grant was made from. The permissions of each role are included in
+	// the permissions of this grant, and are updated when the role template is changed.
+	Roles []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
 }
 
 func (m *AccessGrant) Reset()      { *m = AccessGrant{} }
@@ -128,9 +131,121 @@ func (m *AccessGrant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessGrant proto.InternalMessageInfo
 
+// RoleTemplate is a named set of permissions that can be granted together, e.g. "issuer" or "treasury".
+type RoleTemplate struct {
+	// name is the name of this role, used to reference it in access grants.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permissions are the access rights that are granted with this role.
+	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+}
+
+func (m *RoleTemplate) Reset()         { *m = RoleTemplate{} }
+func (m *RoleTemplate) String() string { return proto.CompactTextString(m) }
+func (*RoleTemplate) ProtoMessage()    {}
+func (*RoleTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7242c30a84644575, []int{1}
+}
+func (m *RoleTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleTemplate.Merge(m, src)
+}
+func (m *RoleTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleTemplate proto.InternalMessageInfo
+
+func (m *RoleTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RoleTemplate) GetPermissions() AccessList {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// AccessGrantEntry is an access grant of a marker as kept in the marker module's access grant store.
+type AccessGrantEntry struct {
+	// grant is the access granted to an address.
+	Grant AccessGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant"`
+	// position is the index of the grant in the marker's access list.
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *AccessGrantEntry) Reset()         { *m = AccessGrantEntry{} }
+func (m *AccessGrantEntry) String() string { return proto.CompactTextString(m) }
+func (*AccessGrantEntry) ProtoMessage()    {}
+func (*AccessGrantEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7242c30a84644575, []int{2}
+}
+func (m *AccessGrantEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessGrantEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessGrantEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessGrantEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessGrantEntry.Merge(m, src)
+}
+func (m *AccessGrantEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessGrantEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessGrantEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessGrantEntry proto.InternalMessageInfo
+
+func (m *AccessGrantEntry) GetGrant() AccessGrant {
+	if m != nil {
+		return m.Grant
+	}
+	return AccessGrant{}
+}
+
+func (m *AccessGrantEntry) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("provenance.marker.v1.Access", Access_name, Access_value)
 	proto.RegisterType((*AccessGrant)(nil), "provenance.marker.v1.AccessGrant")
+	proto.RegisterType((*RoleTemplate)(nil), "provenance.marker.v1.RoleTemplate")
+	proto.RegisterType((*AccessGrantEntry)(nil), "provenance.marker.v1.AccessGrantEntry")
 }
 
 func init() {
@@ -138,39 +253,44 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xed, 0xb4, 0x4d, 0xd2, 0x4b, 0x9a, 0xd7, 0xef, 0xa9, 0x88, 0xd4, 0x14, 0xc7, 0x80,
-	0x84, 0x2a, 0x44, 0x6d, 0xb5, 0x6c, 0x6c, 0x4e, 0xec, 0x80, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91,
-	0x58, 0x2a, 0xd7, 0x39, 0xd2, 0x53, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0xf4, 0x1b, 0x20, 0x4f, 0x2c,
-	0x48, 0x2c, 0x96, 0x32, 0x33, 0xf7, 0x43, 0x20, 0xa6, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xe4, 0x12, 0xe2, 0xa1, 0xdb, 0xf3, 0xdc, 0xff, 0x77, 0x3f, 0x3d, 0xba, 0x7b, 0xc0, 0xf3, 0x11,
-	0xa3, 0x63, 0x44, 0x42, 0x12, 0x21, 0x73, 0x18, 0xb2, 0x4b, 0xc4, 0xcc, 0xf1, 0x91, 0x19, 0x46,
-	0x11, 0xe2, 0x7c, 0xc0, 0x42, 0x12, 0x1b, 0x23, 0x46, 0x63, 0x0a, 0x77, 0xd7, 0x9c, 0x21, 0x38,
-	0x63, 0x7c, 0xa4, 0xee, 0x0e, 0xe8, 0x80, 0x2e, 0x00, 0x73, 0x5e, 0x09, 0x56, 0xdd, 0x8b, 0x28,
-	0x1f, 0x52, 0x7e, 0x26, 0x02, 0xd1, 0x88, 0xe8, 0xe9, 0x17, 0x19, 0x94, 0xac, 0x85, 0xfc, 0xcd,
-	0x5c, 0x0e, 0xab, 0xa0, 0x10, 0xf6, 0xfb, 0x0c, 0x71, 0x5e, 0x95, 0x75, 0xf9, 0x60, 0xdb, 0x5f,
-	0xb5, 0xd0, 0x03, 0xa5, 0x11, 0x62, 0x43, 0xcc, 0x39, 0xa6, 0x84, 0x57, 0x73, 0xfa, 0xc6, 0x41,
-	0xe5, 0x78, 0xdf, 0xb8, 0x6f, 0x0c, 0x43, 0x18, 0xeb, 0x95, 0x6f, 0xbf, 0x6a, 0x40, 0xd4, 0x27,
-	0x98, 0xc7, 0x7e, 0x56, 0xf0, 0x7a, 0xff, 0xd3, 0xa4, 0x26, 0x7d, 0x9d, 0xd4, 0xa4, 0x3f, 0x93,
-	0x9a, 0xfc, 0xe3, 0xf6, 0xb0, 0x9c, 0x19, 0xc3, 0x7d, 0x71, 0x9b, 0x03, 0x79, 0x71, 0x00, 0x9f,
-	0x01, 0x68, 0x35, 0x1a, 0x4e, 0xa7, 0x73, 0xd6, 0xf5, 0x3a, 0x6d, 0xa7, 0xe1, 0x36, 0x5d, 0xc7,
-	0x56, 0x24, 0xb5, 0x94, 0xa4, 0x7a, 0xa1, 0x4b, 0x2e, 0x09, 0xbd, 0x26, 0x70, 0x0f, 0x94, 0x96,
-	0x50, 0xcb, 0xf5, 0x02, 0x45, 0x56, 0x8b, 0x49, 0xaa, 0x6f, 0xb6, 0x30, 0x89, 0x33, 0x51, 0xbd,
-	0xeb, 0x7b, 0x4a, 0x4e, 0x44, 0xf5, 0x2b, 0x46, 0x60, 0x0d, 0x54, 0x96, 0x91, 0xed, 0xb4, 0x4f,
-	0x3b, 0x6e, 0xa0, 0x6c, 0x08, 0xad, 0x8d, 0x46, 0x94, 0xe3, 0x18, 0x3e, 0x01, 0xff, 0x2d, 0x81,
-	0x9e, 0x1b, 0xbc, 0xb5, 0x7d, 0xab, 0xa7, 0x6c, 0xaa, 0xe5, 0x24, 0xd5, 0x8b, 0x3d, 0x1c, 0x5f,
-	0xf4, 0x59, 0x78, 0x0d, 0x1f, 0x83, 0x9d, 0x7f, 0x8e, 0x13, 0x27, 0x70, 0x94, 0x2d, 0x15, 0x24,
-	0xa9, 0x9e, 0xb7, 0xd1, 0x07, 0x14, 0x23, 0xf8, 0x08, 0x94, 0x97, 0xb1, 0x65, 0xb7, 0x5c, 0x4f,
-	0xc9, 0xab, 0xdb, 0x49, 0xaa, 0x6f, 0x59, 0xfd, 0x21, 0x26, 0x19, 0x7d, 0xe0, 0x5b, 0x5e, 0xa7,
-	0xe9, 0xf8, 0x4a, 0x41, 0xe8, 0x03, 0x16, 0x12, 0xfe, 0x1e, 0x31, 0xf8, 0x12, 0x3c, 0x58, 0x22,
-	0xcd, 0x53, 0xbf, 0xe1, 0xac, 0xc1, 0xa2, 0xfa, 0x7f, 0x92, 0xea, 0x3b, 0x4d, 0xca, 0x22, 0xb4,
-	0xa2, 0xeb, 0x37, 0xdf, 0xa7, 0x9a, 0x7c, 0x37, 0xd5, 0xe4, 0xdf, 0x53, 0x4d, 0xfe, 0x3c, 0xd3,
-	0xa4, 0xbb, 0x99, 0x26, 0xfd, 0x9c, 0x69, 0x12, 0x78, 0x88, 0xe9, 0xbd, 0x7f, 0x55, 0x57, 0x32,
-	0xef, 0xde, 0x9e, 0xef, 0x44, 0x5b, 0x7e, 0x77, 0x3c, 0xc0, 0xf1, 0xc5, 0xd5, 0xb9, 0x11, 0xd1,
-	0xa1, 0xb9, 0xbe, 0x74, 0x88, 0x69, 0xa6, 0x33, 0x3f, 0xae, 0xf6, 0x33, 0xbe, 0x19, 0x21, 0x7e,
-	0x9e, 0x5f, 0x2c, 0xd4, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x70, 0xf5, 0x75, 0xc1,
-	0x02, 0x00, 0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x4f, 0xdb, 0x40,
+	0x18, 0xc6, 0xed, 0xfc, 0x23, 0x5c, 0x02, 0x75, 0x4f, 0x54, 0x0d, 0x2e, 0x4d, 0x0c, 0x95, 0xaa,
+	0xa8, 0x2a, 0x89, 0xa0, 0x1b, 0x52, 0x07, 0x3b, 0x76, 0x5a, 0x4b, 0x60, 0x22, 0xc7, 0x08, 0xa9,
+	0x0b, 0x32, 0xce, 0x35, 0x58, 0xc4, 0x77, 0xd6, 0x9d, 0xf9, 0xf7, 0x0d, 0x2a, 0x4f, 0x1d, 0xbb,
+	0x58, 0x62, 0x6e, 0x57, 0x3e, 0x04, 0xea, 0xc4, 0xd8, 0xa9, 0xad, 0x60, 0xe9, 0xc7, 0xa8, 0xe2,
+	0x33, 0xe0, 0x01, 0x75, 0xea, 0x76, 0x8f, 0x9e, 0xdf, 0xfb, 0xdc, 0x23, 0xbd, 0x7a, 0xc1, 0xcb,
+	0x90, 0x92, 0x63, 0x84, 0x5d, 0xec, 0xa1, 0x6e, 0xe0, 0xd2, 0x43, 0x44, 0xbb, 0xc7, 0x6b, 0x5d,
+	0xd7, 0xf3, 0x10, 0x63, 0x63, 0xea, 0xe2, 0xa8, 0x13, 0x52, 0x12, 0x11, 0xb8, 0x70, 0xcf, 0x75,
+	0x38, 0xd7, 0x39, 0x5e, 0x93, 0x17, 0xc6, 0x64, 0x4c, 0x52, 0xa0, 0x3b, 0x7d, 0x71, 0x56, 0x5e,
+	0xf4, 0x08, 0x0b, 0x08, 0xdb, 0xe3, 0x06, 0x17, 0xdc, 0x5a, 0xf9, 0x26, 0x82, 0x9a, 0x9a, 0x86,
+	0xbf, 0x9b, 0x86, 0xc3, 0x06, 0x98, 0x71, 0x47, 0x23, 0x8a, 0x18, 0x6b, 0x88, 0x8a, 0xd8, 0x9e,
+	0xb5, 0x6f, 0x25, 0xb4, 0x40, 0x2d, 0x44, 0x34, 0xf0, 0x19, 0xf3, 0x09, 0x66, 0x8d, 0x82, 0x52,
+	0x6c, 0xcf, 0xaf, 0x2f, 0x75, 0x1e, 0xaa, 0xd1, 0xe1, 0x89, 0xda, 0xfc, 0xd7, 0x5f, 0x2d, 0xc0,
+	0xdf, 0x9b, 0x3e, 0x8b, 0xec, 0x7c, 0x00, 0x5c, 0x00, 0x65, 0x4a, 0x26, 0x88, 0x35, 0x8a, 0x4a,
+	0xb1, 0x3d, 0x6b, 0x73, 0xb1, 0xb1, 0xf4, 0xe9, 0xbc, 0x25, 0x7c, 0x39, 0x6f, 0x09, 0x7f, 0xce,
+	0x5b, 0xe2, 0xf7, 0x8b, 0xd5, 0x7a, 0xae, 0x9c, 0xb9, 0x72, 0x0a, 0xea, 0x36, 0x99, 0x20, 0x07,
+	0x05, 0xe1, 0xc4, 0x8d, 0x10, 0x84, 0xa0, 0x84, 0xdd, 0x00, 0x65, 0x55, 0xd3, 0xf7, 0xff, 0xee,
+	0xb9, 0x51, 0x9a, 0x36, 0x59, 0x09, 0x80, 0x94, 0x6b, 0x62, 0xe0, 0x88, 0x9e, 0xc1, 0xb7, 0xa0,
+	0x9c, 0x6e, 0x24, 0xfd, 0xbe, 0xb6, 0xbe, 0xfc, 0xaf, 0x3f, 0xd2, 0x31, 0xad, 0x74, 0xf9, 0xb3,
+	0x25, 0xd8, 0x7c, 0x0a, 0xca, 0xa0, 0x1a, 0x12, 0xe6, 0x47, 0x3e, 0xc1, 0x8d, 0x82, 0x22, 0xb6,
+	0xe7, 0xec, 0x3b, 0xfd, 0xea, 0xa2, 0x00, 0x2a, 0x7c, 0x10, 0xbe, 0x00, 0x50, 0xed, 0xf5, 0x8c,
+	0xe1, 0x70, 0x6f, 0xc7, 0x1a, 0x0e, 0x8c, 0x9e, 0xd9, 0x37, 0x0d, 0x5d, 0x12, 0xe4, 0x5a, 0x9c,
+	0x28, 0x33, 0x3b, 0xf8, 0x10, 0x93, 0x13, 0x0c, 0x17, 0x41, 0x2d, 0x83, 0xb6, 0x4c, 0xcb, 0x91,
+	0x44, 0xb9, 0x1a, 0x27, 0x4a, 0x69, 0xcb, 0xc7, 0x51, 0xce, 0xd2, 0x76, 0x6c, 0x4b, 0x2a, 0x70,
+	0x4b, 0x3b, 0xa2, 0x18, 0xb6, 0xc0, 0x7c, 0x66, 0xe9, 0xc6, 0x60, 0x7b, 0x68, 0x3a, 0x52, 0x91,
+	0xc7, 0xea, 0x28, 0x6d, 0x02, 0x97, 0xc1, 0xa3, 0x0c, 0xd8, 0x35, 0x9d, 0xf7, 0xba, 0xad, 0xee,
+	0x4a, 0x25, 0xb9, 0x1e, 0x27, 0x4a, 0x75, 0xd7, 0x8f, 0x0e, 0x46, 0xd4, 0x3d, 0x81, 0xcf, 0xc1,
+	0xdc, 0x5d, 0xc6, 0xa6, 0xe1, 0x18, 0x52, 0x59, 0x06, 0x71, 0xa2, 0x54, 0x74, 0x34, 0x41, 0x11,
+	0x82, 0xcf, 0x40, 0x3d, 0xb3, 0x55, 0x7d, 0xcb, 0xb4, 0xa4, 0x8a, 0x3c, 0x1b, 0x27, 0x4a, 0x59,
+	0x1d, 0x05, 0x3e, 0xce, 0xc5, 0x3b, 0xb6, 0x6a, 0x0d, 0xfb, 0x86, 0x2d, 0xcd, 0xf0, 0x78, 0x87,
+	0xba, 0x98, 0x7d, 0x44, 0x14, 0xbe, 0x06, 0x4f, 0x32, 0xa4, 0xbf, 0x6d, 0xf7, 0x8c, 0x7b, 0xb0,
+	0x2a, 0x3f, 0x8e, 0x13, 0x65, 0xae, 0x4f, 0xa8, 0x87, 0x6e, 0x69, 0xed, 0xec, 0xf2, 0xba, 0x29,
+	0x5e, 0x5d, 0x37, 0xc5, 0xdf, 0xd7, 0x4d, 0xf1, 0xf3, 0x4d, 0x53, 0xb8, 0xba, 0x69, 0x0a, 0x3f,
+	0x6e, 0x9a, 0x02, 0x78, 0xea, 0x93, 0x07, 0xd7, 0xa3, 0xe5, 0xd7, 0x3a, 0x98, 0x9e, 0xc4, 0x40,
+	0xfc, 0xb0, 0x3e, 0xf6, 0xa3, 0x83, 0xa3, 0xfd, 0x8e, 0x47, 0x82, 0xee, 0xfd, 0xd0, 0xaa, 0x4f,
+	0x72, 0xaa, 0x7b, 0x7a, 0x7b, 0x9e, 0xd1, 0x59, 0x88, 0xd8, 0x7e, 0x25, 0xbd, 0xa7, 0x37, 0x7f,
+	0x07, 0x00, 0x80, 0x22, 0x65, 0xba, 0xc0, 0x03, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -203,6 +323,46 @@ func (this *AccessGrant) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if this.Roles[i] != that1.Roles[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *RoleTemplate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RoleTemplate)
+	if !ok {
+		that2, ok := that.(RoleTemplate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Permissions) != len(that1.Permissions) {
+		return false
+	}
+	for i := range this.Permissions {
+		if this.Permissions[i] != that1.Permissions[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
@@ -225,6 +385,15 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintAccessgrant(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Permissions) > 0 {
 		dAtA2 := make([]byte, len(m.Permissions)*10)
 		var j1 int
@@ -253,6 +422,92 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoleTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		dAtA4 := make([]byte, len(m.Permissions)*10)
+		var j3 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAccessgrant(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccessgrant(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessGrantEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessGrantEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessGrantEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		i = encodeVarintAccessgrant(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccessgrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAccessgrant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAccessgrant(v)
 	base := offset
@@ -281,6 +536,46 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovAccessgrant(uint64(l)) + l
 	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovAccessgrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoleTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAccessgrant(uint64(e))
+		}
+		n += 1 + sovAccessgrant(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *AccessGrantEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Grant.Size()
+	n += 1 + l + sovAccessgrant(uint64(l))
+	if m.Position != 0 {
+		n += 1 + sovAccessgrant(uint64(m.Position))
+	}
 	return n
 }
 
@@ -420,6 +715,291 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessgrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessgrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Access
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAccessgrant
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Access(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAccessgrant
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAccessgrant
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAccessgrant
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]Access, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Access
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAccessgrant
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Access(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccessgrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessGrantEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccessgrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessGrantEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessGrantEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccessgrant(dAtA[iNdEx:])
@@ -153,6 +153,28 @@ func TestAccessListByNames(t *testing.T) {
 	}
 }
 
+func TestAccessListAndRolesByNames(t *testing.T) {
+	cases := []struct {
+		name        string
+		accessNames string
+		permissions AccessList
+		roles       []string
+	}{
+		{"Single value", "mint", AccessList{Access_Mint}, nil},
+		{"Single role", "role:issuer", nil, []string{RoleIssuer}},
+		{"Role with spaces and caps", " Role:Treasury ", nil, []string{RoleTreasury}},
+		{"Values and roles", "mint,role:issuer,burn,role:compliance", AccessList{Access_Mint, Access_Burn}, []string{RoleIssuer, RoleCompliance}},
+		{"Unknown value", "foo,role:issuer", AccessList{Access_Unknown}, []string{RoleIssuer}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			permissions, roles := AccessListAndRolesByNames(tc.accessNames)
+			assert.Equal(t, tc.permissions, permissions, "permissions")
+			assert.Equal(t, tc.roles, roles, "roles")
+		})
+	}
+}
+
 func TestAccessOneOf(t *testing.T) {
 	cases := []struct {
 		name        string
@@ -230,6 +252,15 @@ func TestMergeAddRemovePermissions(t *testing.T) {
 	require.True(t, roleGrant.HasAccess(Access_Mint))
 	require.False(t, roleGrant.HasAccess(Access_Admin))
 
+	withRoles := *NewAccessGrant(roleAddr, nil)
+	withRoles.Roles = []string{RoleIssuer, RoleTreasury}
+	require.NoError(t, roleGrant.MergeAdd(withRoles))
+	require.Equal(t, []string{RoleIssuer, RoleTreasury}, roleGrant.GetRoles())
+	withRoles.Roles = []string{RoleTreasury}
+	require.NoError(t, roleGrant.MergeRemove(withRoles))
+	require.True(t, roleGrant.HasRole(RoleIssuer))
+	require.False(t, roleGrant.HasRole(RoleTreasury))
+
 	// Expect faults merging in grants for other addresses
 	require.Error(t, roleGrant.MergeAdd(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
 	require.Error(t, roleGrant.MergeRemove(*NewAccessGrant(otherAddr, AccessList{Access_Mint, Access_Admin})))
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewPendingAdminGrant creates a new PendingAdminGrant.
+func NewPendingAdminGrant(denom string, grantee, administrator sdk.AccAddress) PendingAdminGrant {
+	return PendingAdminGrant{
+		Denom:         denom,
+		Grantee:       grantee.String(),
+		Administrator: administrator.String(),
+	}
+}
+
+// Validate returns an error if this PendingAdminGrant is not in a valid state.
+func (g PendingAdminGrant) Validate() error {
+	if err := sdk.ValidateDenom(g.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Administrator); err != nil {
+		return fmt.Errorf("invalid administrator: %w", err)
+	}
+	return nil
+}
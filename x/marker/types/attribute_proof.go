@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttributeProofVerifier defines the functionality needed to check a proof (e.g. a zero-knowledge proof) that an
+// account satisfies some of a restricted marker's required attributes without those attributes being on-chain.
+// Verifiers are registered with the marker keeper by name, and can be backed by a module or a contract.
+// Verifiers are responsible for consuming gas appropriate to the verification they do.
+type AttributeProofVerifier interface {
+	// VerifyAttributeProof returns an error unless the proof attests that the account has each of
+	// the provided required attributes (as defined on the marker with the provided denom).
+	VerifyAttributeProof(ctx sdk.Context, denom string, account sdk.AccAddress, requiredAttributes []string, proof []byte) error
+}
+
+// AttributeProof is a proof that an account satisfies a restricted marker's required attributes.
+// It is provided to the marker send restriction through the context, see WithAttributeProofs.
+type AttributeProof struct {
+	// Verifier is the name of the registered AttributeProofVerifier that can check this proof.
+	Verifier string
+	// Account is the address that this proof is about, i.e. the receiver of the restricted funds.
+	Account sdk.AccAddress
+	// Proof is the proof to provide to the verifier.
+	Proof []byte
+}
+
+// NewAttributeProof creates a new AttributeProof.
+func NewAttributeProof(verifier string, account sdk.AccAddress, proof []byte) AttributeProof {
+	return AttributeProof{Verifier: verifier, Account: account, Proof: proof}
+}
+
+// AttributeProofVerifiers is a registry of named AttributeProofVerifiers.
+// The marker keeper holds a pointer to one so that all copies of the keeper share the same verifiers.
+type AttributeProofVerifiers struct {
+	mtx       sync.RWMutex
+	verifiers map[string]AttributeProofVerifier
+}
+
+// NewAttributeProofVerifiers creates a new, empty, AttributeProofVerifiers registry.
+func NewAttributeProofVerifiers() *AttributeProofVerifiers {
+	return &AttributeProofVerifiers{verifiers: make(map[string]AttributeProofVerifier)}
+}
+
+// Register adds the provided verifier to this registry with the given name.
+// An error is returned if the name is empty, the verifier is nil, or the name is already registered.
+func (v *AttributeProofVerifiers) Register(name string, verifier AttributeProofVerifier) error {
+	if len(name) == 0 {
+		return errors.New("attribute proof verifier name cannot be empty")
+	}
+	if verifier == nil {
+		return fmt.Errorf("attribute proof verifier %q cannot be nil", name)
+	}
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+	if _, found := v.verifiers[name]; found {
+		return fmt.Errorf("attribute proof verifier %q is already registered", name)
+	}
+	v.verifiers[name] = verifier
+	return nil
+}
+
+// Get returns the verifier registered with the given name, and whether one was found.
+func (v *AttributeProofVerifiers) Get(name string) (AttributeProofVerifier, bool) {
+	v.mtx.RLock()
+	defer v.mtx.RUnlock()
+	verifier, found := v.verifiers[name]
+	return verifier, found
+}
+
+// Names returns the sorted names of all registered verifiers.
+func (v *AttributeProofVerifiers) Names() []string {
+	v.mtx.RLock()
+	defer v.mtx.RUnlock()
+	rv := make([]string, 0, len(v.verifiers))
+	for name := range v.verifiers {
+		rv = append(rv, name)
+	}
+	sort.Strings(rv)
+	return rv
+}
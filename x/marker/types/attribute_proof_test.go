@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testProofVerifier is an AttributeProofVerifier that returns a pre-defined error.
+type testProofVerifier struct {
+	err error
+}
+
+func (v testProofVerifier) VerifyAttributeProof(_ sdk.Context, _ string, _ sdk.AccAddress, _ []string, _ []byte) error {
+	return v.err
+}
+
+func TestAttributeProofVerifiers(t *testing.T) {
+	verifiers := NewAttributeProofVerifiers()
+	assert.Empty(t, verifiers.Names(), "Names() on new registry")
+
+	verifier1 := testProofVerifier{}
+	verifier2 := &testProofVerifier{}
+
+	assert.EqualError(t, verifiers.Register("", verifier1), "attribute proof verifier name cannot be empty", "Register with empty name")
+	assert.EqualError(t, verifiers.Register("nilverifier", nil), `attribute proof verifier "nilverifier" cannot be nil`, "Register with nil verifier")
+	require.NoError(t, verifiers.Register("zkverifier", verifier1), "Register(zkverifier)")
+	require.NoError(t, verifiers.Register("anotherverifier", verifier2), "Register(anotherverifier)")
+	assert.EqualError(t, verifiers.Register("zkverifier", verifier2), `attribute proof verifier "zkverifier" is already registered`, "Register(zkverifier) again")
+
+	assert.Equal(t, []string{"anotherverifier", "zkverifier"}, verifiers.Names(), "Names()")
+
+	actual, found := verifiers.Get("zkverifier")
+	assert.True(t, found, "Get(zkverifier) found")
+	assert.Equal(t, verifier1, actual, "Get(zkverifier) verifier")
+	actual, found = verifiers.Get("anotherverifier")
+	assert.True(t, found, "Get(anotherverifier) found")
+	assert.Same(t, verifier2, actual, "Get(anotherverifier) verifier")
+	actual, found = verifiers.Get("unknown")
+	assert.False(t, found, "Get(unknown) found")
+	assert.Nil(t, actual, "Get(unknown) verifier")
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if this AttributeRevocationAction is not one of the known actions.
+func (a AttributeRevocationAction) Validate() error {
+	if _, known := AttributeRevocationAction_name[int32(a)]; !known {
+		return fmt.Errorf("unknown attribute revocation action %d", a)
+	}
+	return nil
+}
+
+// NewAttributeRevocationConfig creates a new AttributeRevocationConfig.
+func NewAttributeRevocationConfig(denom string, action AttributeRevocationAction) AttributeRevocationConfig {
+	return AttributeRevocationConfig{
+		Denom:  denom,
+		Action: action,
+	}
+}
+
+// Validate returns an error if this AttributeRevocationConfig is not in a valid state.
+func (c AttributeRevocationConfig) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	return c.Action.Validate()
+}
+
+// NewFrozenAccount creates a new FrozenAccount.
+func NewFrozenAccount(denom string, addr sdk.AccAddress) FrozenAccount {
+	return FrozenAccount{
+		Denom:   denom,
+		Address: addr.String(),
+	}
+}
+
+// Validate returns an error if this FrozenAccount is not in a valid state.
+func (f FrozenAccount) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	return nil
+}
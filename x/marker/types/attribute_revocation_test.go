@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestAttributeRevocationConfigValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config AttributeRevocationConfig
+		expErr string
+	}{
+		{
+			name:   "none",
+			config: NewAttributeRevocationConfig("testcoin", AttributeRevocationAction_None),
+		},
+		{
+			name:   "deny send",
+			config: NewAttributeRevocationConfig("testcoin", AttributeRevocationAction_DenySend),
+		},
+		{
+			name:   "freeze",
+			config: NewAttributeRevocationConfig("testcoin", AttributeRevocationAction_Freeze),
+		},
+		{
+			name:   "invalid denom",
+			config: NewAttributeRevocationConfig("x", AttributeRevocationAction_Freeze),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "unknown action",
+			config: NewAttributeRevocationConfig("testcoin", AttributeRevocationAction(3)),
+			expErr: "unknown attribute revocation action 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestFrozenAccountValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		frozen FrozenAccount
+		expErr string
+	}{
+		{
+			name:   "valid",
+			frozen: NewFrozenAccount("testcoin", sdk.AccAddress("frozen______________")),
+		},
+		{
+			name:   "invalid denom",
+			frozen: NewFrozenAccount("x", sdk.AccAddress("frozen______________")),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "invalid address",
+			frozen: FrozenAccount{Denom: "testcoin", Address: "bad"},
+			expErr: "invalid address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.frozen.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxBridgeNameLength is the maximum length of the name of a marker bridge.
+	MaxBridgeNameLength = 64
+
+	// BridgeSigVerifyCost is the gas consumed for each attester signature checked during a bridge mint.
+	BridgeSigVerifyCost = 1000
+)
+
+// NewMarkerBridge creates a new MarkerBridge with nothing outstanding.
+func NewMarkerBridge(name, denom string, attesters [][]byte, threshold uint32, mintLimit sdkmath.Int) MarkerBridge {
+	return MarkerBridge{
+		Name:        name,
+		Denom:       denom,
+		Attesters:   attesters,
+		Threshold:   threshold,
+		MintLimit:   mintLimit,
+		Outstanding: sdkmath.ZeroInt(),
+	}
+}
+
+// Validate returns an error if this MarkerBridge is not in a valid state.
+func (b MarkerBridge) Validate() error {
+	if err := ValidateBridgeName(b.Name); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return err
+	}
+	if len(b.Attesters) == 0 {
+		return errors.New("bridge must have at least one attester")
+	}
+	seen := make(map[string]bool, len(b.Attesters))
+	for i, attester := range b.Attesters {
+		if len(attester) != secp256k1.PubKeySize {
+			return fmt.Errorf("invalid attester[%d]: expected %d bytes, got %d", i, secp256k1.PubKeySize, len(attester))
+		}
+		if seen[string(attester)] {
+			return fmt.Errorf("invalid attester[%d]: duplicate attester", i)
+		}
+		seen[string(attester)] = true
+	}
+	if b.Threshold == 0 || int(b.Threshold) > len(b.Attesters) {
+		return fmt.Errorf("invalid threshold %d: must be between 1 and the number of attesters (%d)", b.Threshold, len(b.Attesters))
+	}
+	if b.MintLimit.IsNil() || b.MintLimit.IsNegative() {
+		return errors.New("mint limit cannot be negative")
+	}
+	if b.Outstanding.IsNil() || b.Outstanding.IsNegative() {
+		return errors.New("outstanding amount cannot be negative")
+	}
+	return nil
+}
+
+// ValidateBridgeName returns an error if the provided marker bridge name is not valid.
+func ValidateBridgeName(name string) error {
+	if len(name) == 0 {
+		return errors.New("bridge name cannot be empty")
+	}
+	if len(name) > MaxBridgeNameLength {
+		return fmt.Errorf("bridge name %q exceeds maximum length of %d", name, MaxBridgeNameLength)
+	}
+	if strings.ContainsAny(name, " \t\r\n") {
+		return fmt.Errorf("bridge name %q cannot contain whitespace", name)
+	}
+	return nil
+}
+
+// Validate returns an error if this BridgeMessage is not in a valid state.
+func (m BridgeMessage) Validate() error {
+	if err := ValidateBridgeName(m.Bridge); err != nil {
+		return err
+	}
+	if len(m.ChainId) == 0 {
+		return errors.New("chain id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !m.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", m.Amount)
+	}
+	return nil
+}
+
+// SignBytes returns the bytes that the attesters of a bridge sign for this message.
+func (m BridgeMessage) SignBytes() ([]byte, error) {
+	return m.Marshal()
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMarkerBridgeValidate(t *testing.T) {
+	attester1 := secp256k1.GenPrivKey().PubKey().Bytes()
+	attester2 := secp256k1.GenPrivKey().PubKey().Bytes()
+	limit := sdkmath.NewInt(1000)
+
+	tests := []struct {
+		name   string
+		bridge MarkerBridge
+		exp    string
+	}{
+		{
+			name:   "control",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1, attester2}, 2, limit),
+		},
+		{
+			name:   "empty name",
+			bridge: NewMarkerBridge("", "usdc", [][]byte{attester1}, 1, limit),
+			exp:    "bridge name cannot be empty",
+		},
+		{
+			name:   "name too long",
+			bridge: NewMarkerBridge(strings.Repeat("b", MaxBridgeNameLength+1), "usdc", [][]byte{attester1}, 1, limit),
+			exp:    "bridge name \"" + strings.Repeat("b", MaxBridgeNameLength+1) + "\" exceeds maximum length of 64",
+		},
+		{
+			name:   "name with whitespace",
+			bridge: NewMarkerBridge("my bridge", "usdc", [][]byte{attester1}, 1, limit),
+			exp:    "bridge name \"my bridge\" cannot contain whitespace",
+		},
+		{
+			name:   "invalid denom",
+			bridge: NewMarkerBridge("cctp", "u", [][]byte{attester1}, 1, limit),
+			exp:    "invalid denom: u",
+		},
+		{
+			name:   "no attesters",
+			bridge: NewMarkerBridge("cctp", "usdc", nil, 1, limit),
+			exp:    "bridge must have at least one attester",
+		},
+		{
+			name:   "attester wrong length",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1, attester2[1:]}, 1, limit),
+			exp:    "invalid attester[1]: expected 33 bytes, got 32",
+		},
+		{
+			name:   "duplicate attester",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1, attester1}, 1, limit),
+			exp:    "invalid attester[1]: duplicate attester",
+		},
+		{
+			name:   "zero threshold",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1, attester2}, 0, limit),
+			exp:    "invalid threshold 0: must be between 1 and the number of attesters (2)",
+		},
+		{
+			name:   "threshold more than attesters",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1, attester2}, 3, limit),
+			exp:    "invalid threshold 3: must be between 1 and the number of attesters (2)",
+		},
+		{
+			name:   "negative mint limit",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1}, 1, sdkmath.NewInt(-1)),
+			exp:    "mint limit cannot be negative",
+		},
+		{
+			name:   "nil mint limit",
+			bridge: NewMarkerBridge("cctp", "usdc", [][]byte{attester1}, 1, sdkmath.Int{}),
+			exp:    "mint limit cannot be negative",
+		},
+		{
+			name: "negative outstanding",
+			bridge: MarkerBridge{
+				Name: "cctp", Denom: "usdc", Attesters: [][]byte{attester1}, Threshold: 1,
+				MintLimit: limit, Outstanding: sdkmath.NewInt(-1),
+			},
+			exp: "outstanding amount cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bridge.Validate()
+			if len(tc.exp) > 0 {
+				require.EqualError(t, err, tc.exp, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestBridgeMessageValidate(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	newMsg := func(modifier func(msg *BridgeMessage)) BridgeMessage {
+		rv := BridgeMessage{
+			Bridge:    "cctp",
+			ChainId:   "test-chain",
+			Nonce:     3,
+			Source:    "domain0:0xabc",
+			Recipient: addr,
+			Amount:    sdk.NewInt64Coin("usdc", 100),
+		}
+		if modifier != nil {
+			modifier(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name string
+		msg  BridgeMessage
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(nil),
+		},
+		{
+			name: "no bridge",
+			msg:  newMsg(func(msg *BridgeMessage) { msg.Bridge = "" }),
+			exp:  "bridge name cannot be empty",
+		},
+		{
+			name: "no chain id",
+			msg:  newMsg(func(msg *BridgeMessage) { msg.ChainId = "" }),
+			exp:  "chain id cannot be empty",
+		},
+		{
+			name: "invalid recipient",
+			msg:  newMsg(func(msg *BridgeMessage) { msg.Recipient = "" }),
+			exp:  "invalid recipient: empty address string is not allowed",
+		},
+		{
+			name: "invalid amount",
+			msg:  newMsg(func(msg *BridgeMessage) { msg.Amount = sdk.Coin{Denom: "u", Amount: sdkmath.NewInt(1)} }),
+			exp:  "invalid amount: invalid denom: u",
+		},
+		{
+			name: "zero amount",
+			msg:  newMsg(func(msg *BridgeMessage) { msg.Amount = sdk.NewInt64Coin("usdc", 0) }),
+			exp:  "invalid amount 0usdc: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			if len(tc.exp) > 0 {
+				require.EqualError(t, err, tc.exp, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
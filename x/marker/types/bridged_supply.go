@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewBridgedSupply creates a new BridgedSupply.
+func NewBridgedSupply(denom, channelID, lockAddress, remoteDenom string, intervalBlocks uint64) BridgedSupply {
+	return BridgedSupply{
+		Denom:          denom,
+		ChannelId:      channelID,
+		LockAddress:    lockAddress,
+		RemoteDenom:    remoteDenom,
+		IntervalBlocks: intervalBlocks,
+	}
+}
+
+// IsEmpty returns true if this BridgedSupply does not identify anything to reconcile against.
+func (b BridgedSupply) IsEmpty() bool {
+	return len(b.ChannelId) == 0 && len(b.LockAddress) == 0 && len(b.RemoteDenom) == 0 && b.IntervalBlocks == 0
+}
+
+// Validate returns an error if this BridgedSupply is not in a valid state.
+func (b BridgedSupply) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(b.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel id %q", b.ChannelId)
+	}
+	// The lock address lives on the counterparty chain, so it can have any prefix.
+	if _, _, err := bech32.DecodeAndConvert(b.LockAddress); err != nil {
+		return fmt.Errorf("invalid lock address %q: %w", b.LockAddress, err)
+	}
+	if err := sdk.ValidateDenom(b.RemoteDenom); err != nil {
+		return fmt.Errorf("invalid remote denom: %w", err)
+	}
+	if b.IntervalBlocks == 0 {
+		return errors.New("interval blocks cannot be zero")
+	}
+	return nil
+}
+
+// IsDue returns true if the locked balance should be queried at the provided height given the height of the last query.
+func (b BridgedSupply) IsDue(lastQueryHeight, height int64) bool {
+	return lastQueryHeight == 0 || height-lastQueryHeight >= int64(b.IntervalBlocks)
+}
+
+// NewBridgedSupplyReconciliation compares a remote balance to a marker's supply and returns the result.
+func NewBridgedSupplyReconciliation(denom string, remoteBalance, localSupply sdkmath.Int, height int64) BridgedSupplyReconciliation {
+	status := ReconciliationStatus_Matched
+	if !remoteBalance.Equal(localSupply) {
+		status = ReconciliationStatus_Mismatched
+	}
+	return BridgedSupplyReconciliation{
+		Denom:         denom,
+		Status:        status,
+		RemoteBalance: remoteBalance,
+		LocalSupply:   localSupply,
+		Height:        height,
+	}
+}
+
+// Validate returns an error if this BridgedSupplyReconciliation is not in a valid state.
+func (r BridgedSupplyReconciliation) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if _, known := ReconciliationStatus_name[int32(r.Status)]; !known || r.Status == ReconciliationStatus_Pending {
+		return fmt.Errorf("invalid status %d", r.Status)
+	}
+	if r.RemoteBalance.IsNil() || r.RemoteBalance.IsNegative() {
+		return fmt.Errorf("invalid remote balance %q: cannot be negative", r.RemoteBalance)
+	}
+	if r.LocalSupply.IsNil() || r.LocalSupply.IsNegative() {
+		return fmt.Errorf("invalid local supply %q: cannot be negative", r.LocalSupply)
+	}
+	if r.RemoteBalance.Equal(r.LocalSupply) != (r.Status == ReconciliationStatus_Matched) {
+		return fmt.Errorf("status %s does not agree with remote balance %s and local supply %s",
+			r.Status, r.RemoteBalance, r.LocalSupply)
+	}
+	if r.Height <= 0 {
+		return fmt.Errorf("invalid height %d: must be positive", r.Height)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+const testLockAddress = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+func TestBridgedSupplyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		bridged BridgedSupply
+		expErr  string
+	}{
+		{
+			name:    "control",
+			bridged: NewBridgedSupply("bridged", "channel-3", testLockAddress, "uatom", 100),
+		},
+		{
+			name:    "invalid denom",
+			bridged: NewBridgedSupply("x", "channel-3", testLockAddress, "uatom", 100),
+			expErr:  "invalid denom: x",
+		},
+		{
+			name:    "invalid channel",
+			bridged: NewBridgedSupply("bridged", "x", testLockAddress, "uatom", 100),
+			expErr:  "invalid channel id \"x\"",
+		},
+		{
+			name:    "invalid lock address",
+			bridged: NewBridgedSupply("bridged", "channel-3", "notbech32", "uatom", 100),
+			expErr:  "invalid lock address \"notbech32\": decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:    "invalid remote denom",
+			bridged: NewBridgedSupply("bridged", "channel-3", testLockAddress, "y", 100),
+			expErr:  "invalid remote denom: invalid denom: y",
+		},
+		{
+			name:    "zero interval",
+			bridged: NewBridgedSupply("bridged", "channel-3", testLockAddress, "uatom", 0),
+			expErr:  "interval blocks cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.bridged.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestBridgedSupplyIsDue(t *testing.T) {
+	bridged := NewBridgedSupply("bridged", "channel-3", testLockAddress, "uatom", 10)
+	assert.True(t, bridged.IsDue(0, 5), "IsDue never queried")
+	assert.False(t, bridged.IsDue(5, 14), "IsDue before interval")
+	assert.True(t, bridged.IsDue(5, 15), "IsDue at interval")
+	assert.True(t, bridged.IsDue(5, 30), "IsDue after interval")
+}
+
+func TestNewBridgedSupplyReconciliation(t *testing.T) {
+	matched := NewBridgedSupplyReconciliation("bridged", sdkmath.NewInt(100), sdkmath.NewInt(100), 5)
+	assert.Equal(t, ReconciliationStatus_Matched, matched.Status, "status when equal")
+	assert.NoError(t, matched.Validate(), "Validate matched")
+
+	mismatched := NewBridgedSupplyReconciliation("bridged", sdkmath.NewInt(90), sdkmath.NewInt(100), 5)
+	assert.Equal(t, ReconciliationStatus_Mismatched, mismatched.Status, "status when not equal")
+	assert.NoError(t, mismatched.Validate(), "Validate mismatched")
+}
+
+func TestBridgedSupplyReconciliationValidate(t *testing.T) {
+	newRecon := func(status ReconciliationStatus, remote, local int64, height int64) BridgedSupplyReconciliation {
+		return BridgedSupplyReconciliation{
+			Denom:         "bridged",
+			Status:        status,
+			RemoteBalance: sdkmath.NewInt(remote),
+			LocalSupply:   sdkmath.NewInt(local),
+			Height:        height,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		recon  BridgedSupplyReconciliation
+		expErr string
+	}{
+		{
+			name:  "control",
+			recon: newRecon(ReconciliationStatus_Mismatched, 3, 4, 5),
+		},
+		{
+			name:   "pending status",
+			recon:  newRecon(ReconciliationStatus_Pending, 3, 4, 5),
+			expErr: "invalid status 0",
+		},
+		{
+			name:   "unknown status",
+			recon:  newRecon(ReconciliationStatus(7), 3, 4, 5),
+			expErr: "invalid status 7",
+		},
+		{
+			name:   "negative remote balance",
+			recon:  newRecon(ReconciliationStatus_Mismatched, -3, 4, 5),
+			expErr: "invalid remote balance \"-3\": cannot be negative",
+		},
+		{
+			name:   "nil local supply",
+			recon:  BridgedSupplyReconciliation{Denom: "bridged", Status: ReconciliationStatus_Matched, RemoteBalance: sdkmath.NewInt(3), Height: 5},
+			expErr: "invalid local supply \"<nil>\": cannot be negative",
+		},
+		{
+			name:   "status disagrees with amounts",
+			recon:  newRecon(ReconciliationStatus_Matched, 3, 4, 5),
+			expErr: "status RECONCILIATION_STATUS_MATCHED does not agree with remote balance 3 and local supply 4",
+		},
+		{
+			name:   "zero height",
+			recon:  newRecon(ReconciliationStatus_Matched, 4, 4, 0),
+			expErr: "invalid height 0: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recon.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
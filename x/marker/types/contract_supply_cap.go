@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// secondsPerDay is the length of the window that contract supply caps apply to.
+const secondsPerDay = 24 * 60 * 60
+
+// ContractSupplyCapDay returns the day (UTC) that the provided block time is in, for use with contract supply caps.
+func ContractSupplyCapDay(blockTime time.Time) int64 {
+	return blockTime.Unix() / secondsPerDay
+}
+
+// NewContractSupplyCap creates a new ContractSupplyCap without any usage. Nil limits are treated as zero.
+func NewContractSupplyCap(denom, contract string, dailyMintLimit, dailyBurnLimit sdkmath.Int) ContractSupplyCap {
+	if dailyMintLimit.IsNil() {
+		dailyMintLimit = sdkmath.ZeroInt()
+	}
+	if dailyBurnLimit.IsNil() {
+		dailyBurnLimit = sdkmath.ZeroInt()
+	}
+	return ContractSupplyCap{
+		Denom:          denom,
+		Contract:       contract,
+		DailyMintLimit: dailyMintLimit,
+		DailyBurnLimit: dailyBurnLimit,
+		Minted:         sdkmath.ZeroInt(),
+		Burned:         sdkmath.ZeroInt(),
+	}
+}
+
+// Validate returns an error if this ContractSupplyCap is not in a valid state.
+func (c ContractSupplyCap) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return fmt.Errorf("invalid contract: %w", err)
+	}
+	for _, amt := range []struct {
+		name  string
+		value sdkmath.Int
+	}{
+		{name: "daily mint limit", value: c.DailyMintLimit},
+		{name: "daily burn limit", value: c.DailyBurnLimit},
+		{name: "minted", value: c.Minted},
+		{name: "burned", value: c.Burned},
+	} {
+		if amt.value.IsNil() {
+			return fmt.Errorf("invalid %s: cannot be empty", amt.name)
+		}
+		if amt.value.IsNegative() {
+			return fmt.Errorf("invalid %s %s: cannot be negative", amt.name, amt.value)
+		}
+	}
+	if c.Day < 0 {
+		return fmt.Errorf("invalid day %d: cannot be negative", c.Day)
+	}
+	return nil
+}
+
+// ForDay returns a copy of this ContractSupplyCap with the amounts minted and burned on the provided day.
+// The usage is reset if it was recorded on a different day.
+func (c ContractSupplyCap) ForDay(day int64) ContractSupplyCap {
+	if c.Day != day || c.Minted.IsNil() {
+		c.Minted = sdkmath.ZeroInt()
+	}
+	if c.Day != day || c.Burned.IsNil() {
+		c.Burned = sdkmath.ZeroInt()
+	}
+	c.Day = day
+	return c
+}
+
+// AddMinted returns a copy of this ContractSupplyCap with the amount added to the amount minted on the provided day.
+// Returns an error if that would exceed the daily mint limit.
+func (c ContractSupplyCap) AddMinted(day int64, amount sdkmath.Int) (ContractSupplyCap, error) {
+	c = c.ForDay(day)
+	minted := c.Minted.Add(amount)
+	if minted.GT(c.DailyMintLimit) {
+		return c, fmt.Errorf("cannot mint %s%s: contract %s can only mint %s%s more today (daily limit %s)",
+			amount, c.Denom, c.Contract, c.DailyMintLimit.Sub(c.Minted), c.Denom, c.DailyMintLimit)
+	}
+	c.Minted = minted
+	return c, nil
+}
+
+// AddBurned returns a copy of this ContractSupplyCap with the amount added to the amount burned on the provided day.
+// Returns an error if that would exceed the daily burn limit.
+func (c ContractSupplyCap) AddBurned(day int64, amount sdkmath.Int) (ContractSupplyCap, error) {
+	c = c.ForDay(day)
+	burned := c.Burned.Add(amount)
+	if burned.GT(c.DailyBurnLimit) {
+		return c, fmt.Errorf("cannot burn %s%s: contract %s can only burn %s%s more today (daily limit %s)",
+			amount, c.Denom, c.Contract, c.DailyBurnLimit.Sub(c.Burned), c.Denom, c.DailyBurnLimit)
+	}
+	c.Burned = burned
+	return c, nil
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestContractSupplyCapValidate(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+
+	tests := []struct {
+		name      string
+		supplyCap ContractSupplyCap
+		expErr    string
+	}{
+		{
+			name:      "control",
+			supplyCap: NewContractSupplyCap("hotdog", contract, sdkmath.NewInt(100), sdkmath.NewInt(50)),
+		},
+		{
+			name:      "mint only",
+			supplyCap: NewContractSupplyCap("hotdog", contract, sdkmath.NewInt(100), sdkmath.ZeroInt()),
+		},
+		{
+			name:      "invalid denom",
+			supplyCap: NewContractSupplyCap("x", contract, sdkmath.NewInt(100), sdkmath.NewInt(50)),
+			expErr:    "invalid denom: x",
+		},
+		{
+			name:      "invalid contract",
+			supplyCap: NewContractSupplyCap("hotdog", "not1validaddr", sdkmath.NewInt(100), sdkmath.NewInt(50)),
+			expErr:    "invalid contract: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name:      "blocked",
+			supplyCap: NewContractSupplyCap("hotdog", contract, sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+		},
+		{
+			name:      "negative burn limit",
+			supplyCap: NewContractSupplyCap("hotdog", contract, sdkmath.NewInt(100), sdkmath.NewInt(-1)),
+			expErr:    "invalid daily burn limit -1: cannot be negative",
+		},
+		{
+			name: "nil minted",
+			supplyCap: ContractSupplyCap{
+				Denom: "hotdog", Contract: contract, DailyMintLimit: sdkmath.NewInt(100), DailyBurnLimit: sdkmath.ZeroInt(),
+				Burned: sdkmath.ZeroInt(),
+			},
+			expErr: "invalid minted: cannot be empty",
+		},
+		{
+			name: "negative day",
+			supplyCap: ContractSupplyCap{
+				Denom: "hotdog", Contract: contract, DailyMintLimit: sdkmath.NewInt(100), DailyBurnLimit: sdkmath.ZeroInt(),
+				Day: -1, Minted: sdkmath.ZeroInt(), Burned: sdkmath.ZeroInt(),
+			},
+			expErr: "invalid day -1: cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.supplyCap.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestContractSupplyCapUsage(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+	supplyCap := NewContractSupplyCap("hotdog", contract, sdkmath.NewInt(100), sdkmath.NewInt(50))
+
+	day := ContractSupplyCapDay(time.Date(2024, 3, 5, 23, 59, 59, 0, time.UTC))
+	assert.Equal(t, day, ContractSupplyCapDay(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)), "start of the same day")
+	assert.Equal(t, day+1, ContractSupplyCapDay(time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC)), "start of the next day")
+
+	supplyCap, err := supplyCap.AddMinted(day, sdkmath.NewInt(60))
+	require.NoError(t, err, "AddMinted 60")
+	supplyCap, err = supplyCap.AddMinted(day, sdkmath.NewInt(40))
+	require.NoError(t, err, "AddMinted 40")
+	_, err = supplyCap.AddMinted(day, sdkmath.NewInt(1))
+	assert.EqualError(t, err, "cannot mint 1hotdog: contract "+contract+" can only mint 0hotdog more today (daily limit 100)", "AddMinted over")
+
+	supplyCap, err = supplyCap.AddBurned(day, sdkmath.NewInt(50))
+	require.NoError(t, err, "AddBurned 50")
+	assert.Equal(t, "100", supplyCap.Minted.String(), "Minted")
+	assert.Equal(t, "50", supplyCap.Burned.String(), "Burned")
+
+	nextDay := supplyCap.ForDay(day + 1)
+	assert.Equal(t, day+1, nextDay.Day, "Day")
+	assert.Equal(t, "0", nextDay.Minted.String(), "Minted on the next day")
+	assert.Equal(t, "0", nextDay.Burned.String(), "Burned on the next day")
+	_, err = supplyCap.AddBurned(day+1, sdkmath.NewInt(50))
+	assert.NoError(t, err, "AddBurned on the next day")
+}
+
+func TestContractSupplyCapBlocked(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+	supplyCap := NewContractSupplyCap("hotdog", contract, sdkmath.ZeroInt(), sdkmath.ZeroInt())
+
+	_, err := supplyCap.AddMinted(5, sdkmath.OneInt())
+	assert.EqualError(t, err, "cannot mint 1hotdog: contract "+contract+" can only mint 0hotdog more today (daily limit 0)", "AddMinted")
+	_, err = supplyCap.AddBurned(5, sdkmath.OneInt())
+	assert.EqualError(t, err, "cannot burn 1hotdog: contract "+contract+" can only burn 0hotdog more today (daily limit 0)", "AddBurned")
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMarkerCreationDeposit creates a new MarkerCreationDeposit.
+func NewMarkerCreationDeposit(denom string, depositor sdk.AccAddress, amount sdk.Coin, timeoutHeight int64) MarkerCreationDeposit {
+	return MarkerCreationDeposit{
+		Denom:         denom,
+		Depositor:     depositor.String(),
+		Amount:        amount,
+		TimeoutHeight: timeoutHeight,
+	}
+}
+
+// Validate returns an error if this MarkerCreationDeposit is not in a valid state.
+func (d MarkerCreationDeposit) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor: %w", err)
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !d.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", d.Amount)
+	}
+	if d.TimeoutHeight < 0 {
+		return fmt.Errorf("invalid timeout height %d: cannot be negative", d.TimeoutHeight)
+	}
+	return nil
+}
+
+// IsTimedOut returns true if, at the provided height, the deposit should be burned instead of refunded on cancel.
+func (d MarkerCreationDeposit) IsTimedOut(height int64) bool {
+	return height >= d.TimeoutHeight
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestMarkerCreationDepositValidate(t *testing.T) {
+	depositor := sdk.AccAddress("depositor___________")
+
+	tests := []struct {
+		name    string
+		deposit MarkerCreationDeposit
+		expErr  string
+	}{
+		{
+			name:    "valid",
+			deposit: NewMarkerCreationDeposit("testcoin", depositor, sdk.NewInt64Coin("nhash", 100), 12),
+		},
+		{
+			name:    "invalid denom",
+			deposit: NewMarkerCreationDeposit("x", depositor, sdk.NewInt64Coin("nhash", 100), 12),
+			expErr:  "invalid denom: x",
+		},
+		{
+			name:    "invalid depositor",
+			deposit: MarkerCreationDeposit{Denom: "testcoin", Depositor: "bad", Amount: sdk.NewInt64Coin("nhash", 100), TimeoutHeight: 12},
+			expErr:  "invalid depositor: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:    "negative amount",
+			deposit: NewMarkerCreationDeposit("testcoin", depositor, sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}, 12),
+			expErr:  "invalid amount: negative coin amount: -1",
+		},
+		{
+			name:    "zero amount",
+			deposit: NewMarkerCreationDeposit("testcoin", depositor, sdk.NewInt64Coin("nhash", 0), 12),
+			expErr:  "invalid amount 0nhash: must be positive",
+		},
+		{
+			name:    "negative timeout height",
+			deposit: NewMarkerCreationDeposit("testcoin", depositor, sdk.NewInt64Coin("nhash", 100), -1),
+			expErr:  "invalid timeout height -1: cannot be negative",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.deposit.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestMarkerCreationDepositIsTimedOut(t *testing.T) {
+	deposit := MarkerCreationDeposit{TimeoutHeight: 10}
+	assert.False(t, deposit.IsTimedOut(9), "IsTimedOut(9)")
+	assert.True(t, deposit.IsTimedOut(10), "IsTimedOut(10)")
+	assert.True(t, deposit.IsTimedOut(11), "IsTimedOut(11)")
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -118,6 +120,61 @@ func GetRootCoinName(md banktypes.Metadata) string {
 	return ""
 }
 
+// FindDenomUnit returns the denom unit in the metadata with the provided denom as either its Denom or one of its Aliases.
+func FindDenomUnit(md banktypes.Metadata, denom string) (*banktypes.DenomUnit, bool) {
+	for _, du := range md.DenomUnits {
+		if du == nil {
+			continue
+		}
+		if du.Denom == denom {
+			return du, true
+		}
+		for _, alias := range du.Aliases {
+			if alias == denom {
+				return du, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// ConvertToBaseCoin converts a coin in any of the metadata's denom units into a coin of the metadata's base denom.
+// An error is returned if the denom isn't one of the metadata's units, or if the amount
+// would not be a whole number of base units.
+func ConvertToBaseCoin(coin sdk.DecCoin, md banktypes.Metadata) (sdk.Coin, error) {
+	du, found := FindDenomUnit(md, coin.Denom)
+	if !found {
+		return sdk.Coin{}, fmt.Errorf("denom %q is not a denom unit of %q", coin.Denom, md.Base)
+	}
+	if coin.Amount.IsNegative() {
+		return sdk.Coin{}, fmt.Errorf("amount %s cannot be negative", coin)
+	}
+	amount := coin.Amount.Mul(sdkmath.LegacyNewDec(10).Power(uint64(du.Exponent)))
+	if !amount.IsInteger() {
+		return sdk.Coin{}, fmt.Errorf("amount %s is not a whole number of %s (exponent %d)", coin, md.Base, du.Exponent)
+	}
+	return sdk.NewCoin(md.Base, amount.TruncateInt()), nil
+}
+
+// ConvertToDisplayCoin converts a coin of the metadata's base denom into a coin of the metadata's display denom.
+// An error is returned if the coin's denom is not the metadata's base denom, or if the display denom is not
+// one of the metadata's units, or if its exponent is too large to be represented exactly.
+func ConvertToDisplayCoin(coin sdk.Coin, md banktypes.Metadata) (sdk.DecCoin, error) {
+	if coin.Denom != md.Base {
+		return sdk.DecCoin{}, fmt.Errorf("denom %q is not the base denom %q", coin.Denom, md.Base)
+	}
+	du, found := FindDenomUnit(md, md.Display)
+	if !found {
+		return sdk.DecCoin{}, fmt.Errorf("display denom %q is not a denom unit of %q", md.Display, md.Base)
+	}
+	if du.Exponent > sdkmath.LegacyPrecision {
+		return sdk.DecCoin{}, fmt.Errorf("display denom %q exponent %d is larger than the max precision %d",
+			md.Display, du.Exponent, sdkmath.LegacyPrecision)
+	}
+	amount := sdkmath.LegacyNewDecFromInt(coin.Amount).Quo(sdkmath.LegacyNewDec(10).Power(uint64(du.Exponent)))
+	return sdk.DecCoin{Denom: md.Display, Amount: amount}, nil
+}
+
 // denomUnitValidateBasic performs validation of the denom unit fields.
 //   - The Denom must pass validateDenom.
 //   - The Exponenet must be {SI prefix exponent of this DenomUnit} - basePrefixExp
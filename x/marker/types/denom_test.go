@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -370,3 +371,152 @@ func (s *DenomTestSuite) TestGetRootCoinName() {
 		})
 	}
 }
+
+// hashMetadata returns denom metadata for hash with nhash as the base and hash as the display.
+func hashMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "hash",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "nhash", Exponent: 0, Aliases: []string{"nanohash"}},
+			{Denom: "mhash", Exponent: 6, Aliases: []string{"millihash"}},
+			{Denom: "hash", Exponent: 9},
+		},
+		Base:    "nhash",
+		Display: "hash",
+		Name:    "Hash",
+		Symbol:  "HASH",
+	}
+}
+
+func (s *DenomTestSuite) TestFindDenomUnit() {
+	tests := []struct {
+		name     string
+		denom    string
+		expDenom string
+	}{
+		{name: "base denom", denom: "nhash", expDenom: "nhash"},
+		{name: "base alias", denom: "nanohash", expDenom: "nhash"},
+		{name: "middle alias", denom: "millihash", expDenom: "mhash"},
+		{name: "display denom", denom: "hash", expDenom: "hash"},
+		{name: "unknown denom", denom: "khash"},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			du, found := FindDenomUnit(hashMetadata(), tc.denom)
+			if len(tc.expDenom) == 0 {
+				assert.False(t, found, "FindDenomUnit found")
+				assert.Nil(t, du, "FindDenomUnit denom unit")
+				return
+			}
+			assert.True(t, found, "FindDenomUnit found")
+			if assert.NotNil(t, du, "FindDenomUnit denom unit") {
+				assert.Equal(t, tc.expDenom, du.Denom, "FindDenomUnit denom unit denom")
+			}
+		})
+	}
+}
+
+func (s *DenomTestSuite) TestConvertToBaseCoin() {
+	tests := []struct {
+		name   string
+		coin   string
+		exp    string
+		expErr string
+	}{
+		{name: "base denom", coin: "15nhash", exp: "15nhash"},
+		{name: "display denom", coin: "1.5hash", exp: "1500000000nhash"},
+		{name: "alias", coin: "0.25millihash", exp: "250000nhash"},
+		{name: "smallest display amount", coin: "0.000000001hash", exp: "1nhash"},
+		{name: "zero", coin: "0hash", exp: "0nhash"},
+		{
+			name:   "fractional base units",
+			coin:   "0.0000000015hash",
+			expErr: "amount 0.000000001500000000hash is not a whole number of nhash (exponent 9)",
+		},
+		{
+			name:   "fractional base denom",
+			coin:   "1.5nhash",
+			expErr: "amount 1.500000000000000000nhash is not a whole number of nhash (exponent 0)",
+		},
+		{
+			name:   "unknown denom",
+			coin:   "1khash",
+			expErr: "denom \"khash\" is not a denom unit of \"nhash\"",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			coin, err := sdk.ParseDecCoin(tc.coin)
+			require.NoError(t, err, "ParseDecCoin(%q)", tc.coin)
+			actual, err := ConvertToBaseCoin(coin, hashMetadata())
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ConvertToBaseCoin error")
+				return
+			}
+			require.NoError(t, err, "ConvertToBaseCoin error")
+			assert.Equal(t, tc.exp, actual.String(), "ConvertToBaseCoin result")
+		})
+	}
+}
+
+func (s *DenomTestSuite) TestConvertToDisplayCoin() {
+	bigExp := hashMetadata()
+	bigExp.DenomUnits = append(bigExp.DenomUnits, &banktypes.DenomUnit{Denom: "yhash", Exponent: 33})
+	bigExp.Display = "yhash"
+
+	noDisplayUnit := hashMetadata()
+	noDisplayUnit.Display = "khash"
+
+	tests := []struct {
+		name   string
+		coin   sdk.Coin
+		md     banktypes.Metadata
+		exp    string
+		expErr string
+	}{
+		{
+			name: "whole display amount",
+			coin: sdk.NewInt64Coin("nhash", 3_000_000_000),
+			md:   hashMetadata(),
+			exp:  "3.000000000000000000hash",
+		},
+		{
+			name: "fractional display amount",
+			coin: sdk.NewInt64Coin("nhash", 1),
+			md:   hashMetadata(),
+			exp:  "0.000000001000000000hash",
+		},
+		{
+			name:   "not the base denom",
+			coin:   sdk.NewInt64Coin("hash", 1),
+			md:     hashMetadata(),
+			expErr: "denom \"hash\" is not the base denom \"nhash\"",
+		},
+		{
+			name:   "display is not a denom unit",
+			coin:   sdk.NewInt64Coin("nhash", 1),
+			md:     noDisplayUnit,
+			expErr: "display denom \"khash\" is not a denom unit of \"nhash\"",
+		},
+		{
+			name:   "display exponent too large",
+			coin:   sdk.NewInt64Coin("nhash", 1),
+			md:     bigExp,
+			expErr: "display denom \"yhash\" exponent 33 is larger than the max precision 18",
+		},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			actual, err := ConvertToDisplayCoin(tc.coin, tc.md)
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "ConvertToDisplayCoin error")
+				return
+			}
+			require.NoError(t, err, "ConvertToDisplayCoin error")
+			assert.Equal(t, tc.exp, actual.String(), "ConvertToDisplayCoin result")
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if this DustPolicy is not one of the known policies.
+func (p DustPolicy) Validate() error {
+	if _, known := DustPolicy_name[int32(p)]; !known {
+		return fmt.Errorf("unknown dust policy %d", p)
+	}
+	return nil
+}
+
+// NewMarkerDustPolicy creates a new MarkerDustPolicy.
+func NewMarkerDustPolicy(denom string, policy DustPolicy) MarkerDustPolicy {
+	return MarkerDustPolicy{
+		Denom:  denom,
+		Policy: policy,
+	}
+}
+
+// Validate returns an error if this MarkerDustPolicy is not in a valid state.
+func (p MarkerDustPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	return p.Policy.Validate()
+}
+
+// AllocateProRata splits the total into shares proportional to the provided weights, following the dust policy.
+// The shares are returned in the same order as the weights, along with the dust that wasn't allocated to any of them.
+// With the LargestRemainder policy, the dust is always zero. With the others, the caller is responsible for leaving
+// the dust with the issuer or burning it. The result only depends on the inputs, so it is reproducible.
+func AllocateProRata(total sdkmath.Int, weights []sdkmath.Int, policy DustPolicy) ([]sdkmath.Int, sdkmath.Int, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+	if total.IsNil() || total.IsNegative() {
+		return nil, sdkmath.Int{}, fmt.Errorf("invalid total %s: cannot be negative", total)
+	}
+	if len(weights) == 0 {
+		return nil, sdkmath.Int{}, errors.New("weights cannot be empty")
+	}
+	totalWeight := sdkmath.ZeroInt()
+	for i, weight := range weights {
+		if weight.IsNil() || weight.IsNegative() {
+			return nil, sdkmath.Int{}, fmt.Errorf("invalid weights[%d] %s: cannot be negative", i, weight)
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+	if !totalWeight.IsPositive() {
+		return nil, sdkmath.Int{}, errors.New("total weight must be positive")
+	}
+
+	shares := make([]sdkmath.Int, len(weights))
+	remainders := make([]sdkmath.Int, len(weights))
+	dust := total
+	for i, weight := range weights {
+		product := total.Mul(weight)
+		shares[i] = product.Quo(totalWeight)
+		remainders[i] = product.Mod(totalWeight)
+		dust = dust.Sub(shares[i])
+	}
+
+	if policy == DustPolicy_LargestRemainder && dust.IsPositive() {
+		// The dust is always less than the number of shares, and each unit goes to a different share.
+		order := make([]int, len(weights))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return remainders[order[a]].GT(remainders[order[b]])
+		})
+		for _, i := range order {
+			if !dust.IsPositive() {
+				break
+			}
+			shares[i] = shares[i].AddRaw(1)
+			dust = dust.SubRaw(1)
+		}
+	}
+
+	return shares, dust, nil
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestMarkerDustPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy MarkerDustPolicy
+		expErr string
+	}{
+		{
+			name:   "truncate to issuer",
+			policy: NewMarkerDustPolicy("testcoin", DustPolicy_TruncateToIssuer),
+		},
+		{
+			name:   "largest remainder",
+			policy: NewMarkerDustPolicy("testcoin", DustPolicy_LargestRemainder),
+		},
+		{
+			name:   "burn",
+			policy: NewMarkerDustPolicy("testcoin", DustPolicy_Burn),
+		},
+		{
+			name:   "invalid denom",
+			policy: NewMarkerDustPolicy("x", DustPolicy_Burn),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "unknown policy",
+			policy: NewMarkerDustPolicy("testcoin", DustPolicy(3)),
+			expErr: "unknown dust policy 3",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestAllocateProRata(t *testing.T) {
+	ints := func(vals ...int64) []sdkmath.Int {
+		rv := make([]sdkmath.Int, len(vals))
+		for i, val := range vals {
+			rv[i] = sdkmath.NewInt(val)
+		}
+		return rv
+	}
+	strs := func(vals []sdkmath.Int) []string {
+		if vals == nil {
+			return nil
+		}
+		rv := make([]string, len(vals))
+		for i, val := range vals {
+			rv[i] = val.String()
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name      string
+		total     sdkmath.Int
+		weights   []sdkmath.Int
+		policy    DustPolicy
+		expShares []sdkmath.Int
+		expDust   sdkmath.Int
+		expErr    string
+	}{
+		{
+			name:    "unknown policy",
+			total:   sdkmath.NewInt(10),
+			weights: ints(1),
+			policy:  DustPolicy(3),
+			expErr:  "unknown dust policy 3",
+		},
+		{
+			name:    "nil total",
+			weights: ints(1),
+			expErr:  "invalid total <nil>: cannot be negative",
+		},
+		{
+			name:    "negative total",
+			total:   sdkmath.NewInt(-1),
+			weights: ints(1),
+			expErr:  "invalid total -1: cannot be negative",
+		},
+		{
+			name:   "no weights",
+			total:  sdkmath.NewInt(10),
+			expErr: "weights cannot be empty",
+		},
+		{
+			name:    "negative weight",
+			total:   sdkmath.NewInt(10),
+			weights: ints(1, -1),
+			expErr:  "invalid weights[1] -1: cannot be negative",
+		},
+		{
+			name:    "zero total weight",
+			total:   sdkmath.NewInt(10),
+			weights: ints(0, 0),
+			expErr:  "total weight must be positive",
+		},
+		{
+			name:      "exact split",
+			total:     sdkmath.NewInt(100),
+			weights:   ints(50, 30, 20),
+			policy:    DustPolicy_LargestRemainder,
+			expShares: ints(50, 30, 20),
+			expDust:   sdkmath.ZeroInt(),
+		},
+		{
+			name:      "zero total",
+			total:     sdkmath.ZeroInt(),
+			weights:   ints(2, 3),
+			policy:    DustPolicy_TruncateToIssuer,
+			expShares: ints(0, 0),
+			expDust:   sdkmath.ZeroInt(),
+		},
+		{
+			name:      "truncate to issuer",
+			total:     sdkmath.NewInt(7),
+			weights:   ints(2, 3, 5),
+			policy:    DustPolicy_TruncateToIssuer,
+			expShares: ints(1, 2, 3),
+			expDust:   sdkmath.OneInt(),
+		},
+		{
+			name:      "burn",
+			total:     sdkmath.NewInt(7),
+			weights:   ints(2, 3, 5),
+			policy:    DustPolicy_Burn,
+			expShares: ints(1, 2, 3),
+			expDust:   sdkmath.OneInt(),
+		},
+		{
+			name:      "largest remainder",
+			total:     sdkmath.NewInt(7),
+			weights:   ints(2, 3, 5),
+			policy:    DustPolicy_LargestRemainder,
+			expShares: ints(1, 2, 4),
+			expDust:   sdkmath.ZeroInt(),
+		},
+		{
+			name:      "largest remainder ties go to the first share",
+			total:     sdkmath.NewInt(11),
+			weights:   ints(1, 1, 1),
+			policy:    DustPolicy_LargestRemainder,
+			expShares: ints(4, 4, 3),
+			expDust:   sdkmath.ZeroInt(),
+		},
+		{
+			name:      "largest remainder with a zero weight",
+			total:     sdkmath.NewInt(5),
+			weights:   ints(1, 0, 1),
+			policy:    DustPolicy_LargestRemainder,
+			expShares: ints(3, 0, 2),
+			expDust:   sdkmath.ZeroInt(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			shares, dust, err := AllocateProRata(tc.total, tc.weights, tc.policy)
+			assertions.AssertErrorValue(t, err, tc.expErr, "AllocateProRata error")
+			assert.Equal(t, strs(tc.expShares), strs(shares), "AllocateProRata shares")
+			if tc.expDust.IsNil() {
+				assert.True(t, dust.IsNil(), "AllocateProRata dust should be nil, got %s", dust)
+			} else {
+				assert.Equal(t, tc.expDust.String(), dust.String(), "AllocateProRata dust")
+			}
+		})
+	}
+}
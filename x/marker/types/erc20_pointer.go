@@ -0,0 +1,61 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxERC20ChainIDLength is the maximum length of the chain id of an ERC-20 pointer.
+const MaxERC20ChainIDLength = 64
+
+// NewERC20Pointer creates a new ERC20Pointer.
+func NewERC20Pointer(denom, chainID, contractAddress string) ERC20Pointer {
+	return ERC20Pointer{
+		Denom:           denom,
+		ChainId:         chainID,
+		ContractAddress: contractAddress,
+	}
+}
+
+// Validate returns an error if this ERC20Pointer is not in a valid state.
+func (p ERC20Pointer) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if err := ValidateERC20ChainID(p.ChainId); err != nil {
+		return err
+	}
+	return ValidateERC20ContractAddress(p.ContractAddress)
+}
+
+// ValidateERC20ChainID returns an error if the provided external chain id is not valid.
+func ValidateERC20ChainID(chainID string) error {
+	if len(chainID) == 0 {
+		return errors.New("chain id cannot be empty")
+	}
+	if len(chainID) > MaxERC20ChainIDLength {
+		return fmt.Errorf("chain id %q exceeds maximum length of %d", chainID, MaxERC20ChainIDLength)
+	}
+	if strings.TrimSpace(chainID) != chainID || strings.ContainsAny(chainID, " \t\r\n") {
+		return fmt.Errorf("chain id %q cannot contain whitespace", chainID)
+	}
+	return nil
+}
+
+// ValidateERC20ContractAddress returns an error if the provided string is not a hex (EVM) contract address.
+func ValidateERC20ContractAddress(contractAddress string) error {
+	if len(contractAddress) == 0 {
+		return errors.New("contract address cannot be empty")
+	}
+	if !strings.HasPrefix(contractAddress, "0x") || len(contractAddress) != 42 {
+		return fmt.Errorf("invalid contract address %q: must be 0x followed by 40 hex characters", contractAddress)
+	}
+	if _, err := hex.DecodeString(contractAddress[2:]); err != nil {
+		return fmt.Errorf("invalid contract address %q: %w", contractAddress, err)
+	}
+	return nil
+}
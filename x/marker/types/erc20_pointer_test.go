@@ -0,0 +1,126 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestERC20PointerValidate(t *testing.T) {
+	contract := "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238"
+
+	tests := []struct {
+		name    string
+		pointer ERC20Pointer
+		exp     string
+	}{
+		{
+			name:    "control",
+			pointer: NewERC20Pointer("hotdog", "eip155:1", contract),
+		},
+		{
+			name:    "mixed case contract address",
+			pointer: NewERC20Pointer("hotdog", "eip155:1", "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+		},
+		{
+			name:    "invalid denom",
+			pointer: NewERC20Pointer("h", "eip155:1", contract),
+			exp:     "invalid denom: h",
+		},
+		{
+			name:    "empty chain id",
+			pointer: NewERC20Pointer("hotdog", "", contract),
+			exp:     "chain id cannot be empty",
+		},
+		{
+			name:    "chain id too long",
+			pointer: NewERC20Pointer("hotdog", strings.Repeat("c", MaxERC20ChainIDLength+1), contract),
+			exp:     "chain id \"" + strings.Repeat("c", MaxERC20ChainIDLength+1) + "\" exceeds maximum length of 64",
+		},
+		{
+			name:    "chain id with whitespace",
+			pointer: NewERC20Pointer("hotdog", " eip155:1", contract),
+			exp:     "chain id \" eip155:1\" cannot contain whitespace",
+		},
+		{
+			name:    "empty contract address",
+			pointer: NewERC20Pointer("hotdog", "eip155:1", ""),
+			exp:     "contract address cannot be empty",
+		},
+		{
+			name:    "contract address without 0x",
+			pointer: NewERC20Pointer("hotdog", "eip155:1", contract[2:]+"00"),
+			exp:     "invalid contract address \"" + contract[2:] + "00\": must be 0x followed by 40 hex characters",
+		},
+		{
+			name:    "contract address not hex",
+			pointer: NewERC20Pointer("hotdog", "eip155:1", "0xzc7d4b196cb0c7b01d743fbc6116a902379c7238"),
+			exp:     "invalid contract address \"0xzc7d4b196cb0c7b01d743fbc6116a902379c7238\": encoding/hex: invalid byte: U+007A 'z'",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pointer.Validate()
+			if len(tc.exp) > 0 {
+				require.EqualError(t, err, tc.exp, "Validate error")
+			} else {
+				require.NoError(t, err, "Validate error")
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidateERC20Pointers(t *testing.T) {
+	contract := "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238"
+
+	tests := []struct {
+		name     string
+		pointers []ERC20Pointer
+		exp      string
+	}{
+		{
+			name: "control",
+			pointers: []ERC20Pointer{
+				NewERC20Pointer("hotdog", "eip155:1", contract),
+				NewERC20Pointer("hotdog", "eip155:10", contract),
+				NewERC20Pointer("bun", "eip155:1", "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+			},
+		},
+		{
+			name:     "invalid pointer",
+			pointers: []ERC20Pointer{NewERC20Pointer("hotdog", "", contract)},
+			exp:      "invalid erc20 pointers[0]: chain id cannot be empty",
+		},
+		{
+			name: "duplicate denom and chain",
+			pointers: []ERC20Pointer{
+				NewERC20Pointer("hotdog", "eip155:1", contract),
+				NewERC20Pointer("hotdog", "eip155:1", "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+			},
+			exp: "invalid erc20 pointers[1]: duplicate pointer for hotdog on eip155:1",
+		},
+		{
+			name: "duplicate contract with different case",
+			pointers: []ERC20Pointer{
+				NewERC20Pointer("hotdog", "eip155:1", contract),
+				NewERC20Pointer("bun", "eip155:1", "0x"+strings.ToUpper(contract[2:])),
+			},
+			exp: "invalid erc20 pointers[1]: duplicate contract 0x" + strings.ToUpper(contract[2:]) + " on eip155:1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := DefaultGenesisState()
+			genState.Erc20Pointers = tc.pointers
+			err := genState.Validate()
+			if len(tc.exp) > 0 {
+				require.EqualError(t, err, tc.exp, "Validate error")
+			} else {
+				require.NoError(t, err, "Validate error")
+			}
+		})
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	sdkmath "cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -16,6 +14,18 @@ const (
 
 	// EventTypeDestroy emitted when a marker is deleted during abci-begin_event
 	EventTypeDestroy string = "marker_destroyed"
+	// EventTypeReceiptReturnFailed emitted when the coins of an expired pending receipt could not be returned to its sender
+	EventTypeReceiptReturnFailed string = "marker_receipt_return_failed"
+	// EventAttributeIDKey is the attribute key for the id of the thing an event is about.
+	EventAttributeIDKey string = "id"
+	// EventAttributeFromAddressKey is the attribute key for the address that sent coins.
+	EventAttributeFromAddressKey string = "from_address"
+	// EventAttributeErrorKey is the attribute key for the error that caused an event.
+	EventAttributeErrorKey string = "error"
+	// EventTypeEscrowNotSeized emitted when some of a marker's escrow could not be seized because it's on hold
+	EventTypeEscrowNotSeized string = "marker_escrow_not_seized"
+	// EventAttributeAmountKey is the attribute key for the coins an event is about.
+	EventAttributeAmountKey string = "amount"
 
 	// EventTelemetryLabelAddress address label for telemetry metrics
 	EventTelemetryLabelAddress string = "address"
@@ -185,10 +195,30 @@ func NewEventSetNetAssetValue(denom string, price sdk.Coin, volume uint64, sourc
 }
 
 // NewEventMarkerParamsUpdated returns a new instance of EventMarkerParamsUpdated
-func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int) *EventMarkerParamsUpdated {
+func NewEventMarkerParamsUpdated(params Params) *EventMarkerParamsUpdated {
 	return &EventMarkerParamsUpdated{
-		EnableGovernance:       strconv.FormatBool(allowGovControl),
-		UnrestrictedDenomRegex: denomRegex,
-		MaxSupply:              maxSupply.String(),
+		EnableGovernance:             strconv.FormatBool(params.EnableGovernance),
+		UnrestrictedDenomRegex:       params.UnrestrictedDenomRegex,
+		MaxSupply:                    params.MaxSupply.String(),
+		CreationDeposit:              params.CreationDeposit.String(),
+		CreationDepositTimeoutBlocks: strconv.FormatInt(params.CreationDepositTimeoutBlocks, 10),
+	}
+}
+
+// NewEventMarkerCreationDepositRefunded returns a new instance of EventMarkerCreationDepositRefunded
+func NewEventMarkerCreationDepositRefunded(deposit MarkerCreationDeposit) *EventMarkerCreationDepositRefunded {
+	return &EventMarkerCreationDepositRefunded{
+		Denom:     deposit.Denom,
+		Depositor: deposit.Depositor,
+		Amount:    deposit.Amount.String(),
+	}
+}
+
+// NewEventMarkerCreationDepositBurned returns a new instance of EventMarkerCreationDepositBurned
+func NewEventMarkerCreationDepositBurned(deposit MarkerCreationDeposit) *EventMarkerCreationDepositBurned {
+	return &EventMarkerCreationDepositBurned{
+		Denom:     deposit.Denom,
+		Depositor: deposit.Depositor,
+		Amount:    deposit.Amount.String(),
 	}
 }
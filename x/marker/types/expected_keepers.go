@@ -12,6 +12,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	nametypes "github.com/provenance-io/provenance/x/name/types"
 )
 
 // AccountKeeper defines the auth/account functionality needed by the marker keeper.
@@ -35,6 +36,8 @@ type AuthzKeeper interface {
 type BankKeeper interface {
 	GetAllBalances(context context.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(context context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoin(context context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SpendableCoins(context context.Context, addr sdk.AccAddress) sdk.Coins
 	GetSupply(context context.Context, denom string) sdk.Coin
 	DenomOwners(context context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
 
@@ -49,6 +52,7 @@ type BankKeeper interface {
 
 	GetDenomMetaData(context context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(context context.Context, denomMetaData banktypes.Metadata)
+	IterateAllDenomMetaData(context context.Context, cb func(banktypes.Metadata) bool)
 }
 
 // FeeGrantKeeper defines the fee-grant functionality needed by the marker module.
@@ -65,6 +69,7 @@ type FeeGrantKeeper interface {
 type AttrKeeper interface {
 	GetMaxValueLength(ctx sdk.Context) uint32
 	GetAllAttributesAddr(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
+	GetAllAttributesAddrWithMirror(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
 	GetAccountData(ctx sdk.Context, addr string) (string, error)
 	SetAccountData(ctx sdk.Context, addr string, value string) error
 }
@@ -72,6 +77,8 @@ type AttrKeeper interface {
 // NameKeeper defines the name keeper functionality needed by the marker module.
 type NameKeeper interface {
 	Normalize(ctx sdk.Context, name string) (string, error)
+	GetRecordByName(ctx sdk.Context, name string) (*nametypes.NameRecord, error)
+	GetEnforceDenomNameLinkage(ctx sdk.Context) bool
 }
 
 // IbcTransferMsgServer defines the message server functionality needed by the marker module.
@@ -2,8 +2,11 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -31,6 +34,236 @@ func (state GenesisState) Validate() error {
 		}
 	}
 
+	chainDenoms := make(map[string]bool)
+	chainContracts := make(map[string]bool)
+	for i, pointer := range state.Erc20Pointers {
+		if err := pointer.Validate(); err != nil {
+			return fmt.Errorf("invalid erc20 pointers[%d]: %w", i, err)
+		}
+		chainDenom := pointer.ChainId + " " + pointer.Denom
+		if chainDenoms[chainDenom] {
+			return fmt.Errorf("invalid erc20 pointers[%d]: duplicate pointer for %s on %s", i, pointer.Denom, pointer.ChainId)
+		}
+		chainDenoms[chainDenom] = true
+		chainContract := pointer.ChainId + " " + strings.ToLower(pointer.ContractAddress)
+		if chainContracts[chainContract] {
+			return fmt.Errorf("invalid erc20 pointers[%d]: duplicate contract %s on %s", i, pointer.ContractAddress, pointer.ChainId)
+		}
+		chainContracts[chainContract] = true
+	}
+
+	bridgeNames := make(map[string]bool)
+	for i, bridge := range state.Bridges {
+		if err := bridge.Validate(); err != nil {
+			return fmt.Errorf("invalid bridges[%d]: %w", i, err)
+		}
+		if bridgeNames[bridge.Name] {
+			return fmt.Errorf("invalid bridges[%d]: duplicate bridge %s", i, bridge.Name)
+		}
+		bridgeNames[bridge.Name] = true
+	}
+	for i, nonce := range state.UsedBridgeNonces {
+		if err := ValidateBridgeName(nonce.Bridge); err != nil {
+			return fmt.Errorf("invalid used bridge nonces[%d]: %w", i, err)
+		}
+	}
+	for i, grant := range state.PendingAdminGrants {
+		if err := grant.Validate(); err != nil {
+			return fmt.Errorf("invalid pending admin grants[%d]: %w", i, err)
+		}
+	}
+	bypassAddrs := make(map[string]bool)
+	for i, addr := range state.ReqAttrBypassAddrs {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid req attr bypass addrs[%d]: %w", i, err)
+		}
+		if bypassAddrs[addr] {
+			return fmt.Errorf("invalid req attr bypass addrs[%d]: duplicate address %s", i, addr)
+		}
+		bypassAddrs[addr] = true
+	}
+	depositDenoms := make(map[string]bool)
+	for i, deposit := range state.CreationDeposits {
+		if err := deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid creation deposits[%d]: %w", i, err)
+		}
+		if depositDenoms[deposit.Denom] {
+			return fmt.Errorf("invalid creation deposits[%d]: duplicate deposit for %s", i, deposit.Denom)
+		}
+		depositDenoms[deposit.Denom] = true
+	}
+	revocationDenoms := make(map[string]bool)
+	for i, config := range state.AttributeRevocationConfigs {
+		if err := config.Validate(); err != nil {
+			return fmt.Errorf("invalid attribute revocation configs[%d]: %w", i, err)
+		}
+		if revocationDenoms[config.Denom] {
+			return fmt.Errorf("invalid attribute revocation configs[%d]: duplicate config for %s", i, config.Denom)
+		}
+		revocationDenoms[config.Denom] = true
+	}
+	for i, frozen := range state.FrozenAccounts {
+		if err := frozen.Validate(); err != nil {
+			return fmt.Errorf("invalid frozen accounts[%d]: %w", i, err)
+		}
+	}
+	changeIDs := make(map[uint64]bool)
+	for i, change := range state.ScheduledPolicyChanges {
+		if err := change.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled policy changes[%d]: %w", i, err)
+		}
+		if changeIDs[change.Id] {
+			return fmt.Errorf("invalid scheduled policy changes[%d]: duplicate id %d", i, change.Id)
+		}
+		if change.Id > state.LastPolicyChangeId {
+			return fmt.Errorf("invalid scheduled policy changes[%d]: id %d is greater than the last policy change id %d",
+				i, change.Id, state.LastPolicyChangeId)
+		}
+		changeIDs[change.Id] = true
+	}
+	reserveDenoms := make(map[string]bool)
+	for i, req := range state.ReserveRequirements {
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve requirements[%d]: %w", i, err)
+		}
+		if reserveDenoms[req.Denom] {
+			return fmt.Errorf("invalid reserve requirements[%d]: duplicate requirement for %s", i, req.Denom)
+		}
+		reserveDenoms[req.Denom] = true
+	}
+	attestorDenoms := make(map[string]bool)
+	for i, attestors := range state.ReserveAttestors {
+		if err := attestors.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve attestors[%d]: %w", i, err)
+		}
+		if attestorDenoms[attestors.Denom] {
+			return fmt.Errorf("invalid reserve attestors[%d]: duplicate attestors for %s", i, attestors.Denom)
+		}
+		attestorDenoms[attestors.Denom] = true
+	}
+	attestations := make(map[string]bool)
+	for i, attestation := range state.ReserveAttestations {
+		if err := attestation.Validate(); err != nil {
+			return fmt.Errorf("invalid reserve attestations[%d]: %w", i, err)
+		}
+		key := attestation.Denom + " " + attestation.Attestor
+		if attestations[key] {
+			return fmt.Errorf("invalid reserve attestations[%d]: duplicate attestation for %s from %s", i, attestation.Denom, attestation.Attestor)
+		}
+		attestations[key] = true
+	}
+	dustDenoms := make(map[string]bool)
+	for i, policy := range state.DustPolicies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid dust policies[%d]: %w", i, err)
+		}
+		if dustDenoms[policy.Denom] {
+			return fmt.Errorf("invalid dust policies[%d]: duplicate policy for %s", i, policy.Denom)
+		}
+		dustDenoms[policy.Denom] = true
+	}
+	offerIDs := make(map[uint64]bool)
+	for i, offer := range state.SwapOffers {
+		if err := offer.Validate(); err != nil {
+			return fmt.Errorf("invalid swap offers[%d]: %w", i, err)
+		}
+		if offerIDs[offer.Id] {
+			return fmt.Errorf("invalid swap offers[%d]: duplicate id %d", i, offer.Id)
+		}
+		if offer.Id > state.LastSwapOfferId {
+			return fmt.Errorf("invalid swap offers[%d]: id %d is greater than the last swap offer id %d",
+				i, offer.Id, state.LastSwapOfferId)
+		}
+		offerIDs[offer.Id] = true
+	}
+	trancheIDs := make(map[string]bool)
+	for i, tranche := range state.IssuanceTranches {
+		if err := tranche.Validate(); err != nil {
+			return fmt.Errorf("invalid issuance tranches[%d]: %w", i, err)
+		}
+		key := fmt.Sprintf("%s %d", tranche.Denom, tranche.Id)
+		if trancheIDs[key] {
+			return fmt.Errorf("invalid issuance tranches[%d]: duplicate tranche %d for %s", i, tranche.Id, tranche.Denom)
+		}
+		trancheIDs[key] = true
+	}
+	entryIDs := make(map[string]bool)
+	for i, entry := range state.SubLedgerEntries {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("invalid sub-ledger entries[%d]: %w", i, err)
+		}
+		key := fmt.Sprintf("%s %d", entry.Custodian, entry.Id)
+		if entryIDs[key] {
+			return fmt.Errorf("invalid sub-ledger entries[%d]: duplicate entry %d for %s", i, entry.Id, entry.Custodian)
+		}
+		entryIDs[key] = true
+	}
+	bridgedDenoms := make(map[string]bool)
+	for i, bridged := range state.BridgedSupplies {
+		if err := bridged.Validate(); err != nil {
+			return fmt.Errorf("invalid bridged supplies[%d]: %w", i, err)
+		}
+		if bridgedDenoms[bridged.Denom] {
+			return fmt.Errorf("invalid bridged supplies[%d]: duplicate bridged supply for %s", i, bridged.Denom)
+		}
+		bridgedDenoms[bridged.Denom] = true
+	}
+	reconciledDenoms := make(map[string]bool)
+	for i, recon := range state.BridgedSupplyReconciliations {
+		if err := recon.Validate(); err != nil {
+			return fmt.Errorf("invalid bridged supply reconciliations[%d]: %w", i, err)
+		}
+		if reconciledDenoms[recon.Denom] {
+			return fmt.Errorf("invalid bridged supply reconciliations[%d]: duplicate reconciliation for %s", i, recon.Denom)
+		}
+		reconciledDenoms[recon.Denom] = true
+	}
+	supplyPolicyDenoms := make(map[string]bool)
+	for i, policy := range state.SupplyChangePolicies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid supply change policies[%d]: %w", i, err)
+		}
+		if supplyPolicyDenoms[policy.Denom] {
+			return fmt.Errorf("invalid supply change policies[%d]: duplicate policy for %s", i, policy.Denom)
+		}
+		supplyPolicyDenoms[policy.Denom] = true
+	}
+	receiptPolicyDenoms := make(map[string]bool)
+	for i, policy := range state.ReceiptPolicies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid receipt policies[%d]: %w", i, err)
+		}
+		if receiptPolicyDenoms[policy.Denom] {
+			return fmt.Errorf("invalid receipt policies[%d]: duplicate policy for %s", i, policy.Denom)
+		}
+		receiptPolicyDenoms[policy.Denom] = true
+	}
+	receiptIDs := make(map[uint64]bool)
+	for i, receipt := range state.PendingReceipts {
+		if err := receipt.Validate(); err != nil {
+			return fmt.Errorf("invalid pending receipts[%d]: %w", i, err)
+		}
+		if receiptIDs[receipt.Id] {
+			return fmt.Errorf("invalid pending receipts[%d]: duplicate id %d", i, receipt.Id)
+		}
+		if receipt.Id > state.LastPendingReceiptId {
+			return fmt.Errorf("invalid pending receipts[%d]: id %d is greater than the last pending receipt id %d",
+				i, receipt.Id, state.LastPendingReceiptId)
+		}
+		receiptIDs[receipt.Id] = true
+	}
+	contractCaps := make(map[string]bool)
+	for i, supplyCap := range state.ContractSupplyCaps {
+		if err := supplyCap.Validate(); err != nil {
+			return fmt.Errorf("invalid contract supply caps[%d]: %w", i, err)
+		}
+		key := supplyCap.Denom + " " + supplyCap.Contract
+		if contractCaps[key] {
+			return fmt.Errorf("invalid contract supply caps[%d]: duplicate cap for %s on %s", i, supplyCap.Contract, supplyCap.Denom)
+		}
+		contractCaps[key] = true
+	}
+
 	return nil
 }
 
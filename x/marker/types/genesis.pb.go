@@ -33,6 +33,56 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of ERC-20 pointers for markers
+	Erc20Pointers []ERC20Pointer `protobuf:"bytes,5,rep,name=erc20_pointers,json=erc20Pointers,proto3" json:"erc20_pointers"`
+	// list of marker bridges
+	Bridges []MarkerBridge `protobuf:"bytes,6,rep,name=bridges,proto3" json:"bridges"`
+	// list of bridge nonces that have been used
+	UsedBridgeNonces []BridgeNonce `protobuf:"bytes,7,rep,name=used_bridge_nonces,json=usedBridgeNonces,proto3" json:"used_bridge_nonces"`
+	// list of admin grants waiting to be accepted
+	PendingAdminGrants []PendingAdminGrant `protobuf:"bytes,8,rep,name=pending_admin_grants,json=pendingAdminGrants,proto3" json:"pending_admin_grants"`
+	// list of addresses added through governance that can bypass the required attributes checking
+	ReqAttrBypassAddrs []string `protobuf:"bytes,9,rep,name=req_attr_bypass_addrs,json=reqAttrBypassAddrs,proto3" json:"req_attr_bypass_addrs,omitempty"`
+	// list of creation deposits that are still escrowed
+	CreationDeposits []MarkerCreationDeposit `protobuf:"bytes,10,rep,name=creation_deposits,json=creationDeposits,proto3" json:"creation_deposits"`
+	// list of markers' attribute revocation configs
+	AttributeRevocationConfigs []AttributeRevocationConfig `protobuf:"bytes,11,rep,name=attribute_revocation_configs,json=attributeRevocationConfigs,proto3" json:"attribute_revocation_configs"`
+	// list of accounts whose outbound transfers of a marker's coin are frozen
+	FrozenAccounts []FrozenAccount `protobuf:"bytes,12,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts"`
+	// list of changes to markers' required attributes that have not taken effect yet
+	ScheduledPolicyChanges []ScheduledPolicyChange `protobuf:"bytes,13,rep,name=scheduled_policy_changes,json=scheduledPolicyChanges,proto3" json:"scheduled_policy_changes"`
+	// the id of the last scheduled policy change
+	LastPolicyChangeId uint64 `protobuf:"varint,14,opt,name=last_policy_change_id,json=lastPolicyChangeId,proto3" json:"last_policy_change_id,omitempty"`
+	// list of markers' reserve requirements
+	ReserveRequirements []ReserveRequirement `protobuf:"bytes,15,rep,name=reserve_requirements,json=reserveRequirements,proto3" json:"reserve_requirements"`
+	// list of markers' approved reserve attestors
+	ReserveAttestors []ReserveAttestors `protobuf:"bytes,16,rep,name=reserve_attestors,json=reserveAttestors,proto3" json:"reserve_attestors"`
+	// list of markers' reserve attestations
+	ReserveAttestations []ReserveAttestation `protobuf:"bytes,17,rep,name=reserve_attestations,json=reserveAttestations,proto3" json:"reserve_attestations"`
+	// list of markers' dust policies
+	DustPolicies []MarkerDustPolicy `protobuf:"bytes,18,rep,name=dust_policies,json=dustPolicies,proto3" json:"dust_policies"`
+	// list of swap offers that have not been accepted, canceled, or expired
+	SwapOffers []SwapOffer `protobuf:"bytes,19,rep,name=swap_offers,json=swapOffers,proto3" json:"swap_offers"`
+	// the id of the last swap offer
+	LastSwapOfferId uint64 `protobuf:"varint,20,opt,name=last_swap_offer_id,json=lastSwapOfferId,proto3" json:"last_swap_offer_id,omitempty"`
+	// list of markers' issuance tranches
+	IssuanceTranches []IssuanceTranche `protobuf:"bytes,21,rep,name=issuance_tranches,json=issuanceTranches,proto3" json:"issuance_tranches"`
+	// list of custodians' sub-ledger entries
+	SubLedgerEntries []SubLedgerEntry `protobuf:"bytes,22,rep,name=sub_ledger_entries,json=subLedgerEntries,proto3" json:"sub_ledger_entries"`
+	// list of bridged markers' supply reconciliation settings
+	BridgedSupplies []BridgedSupply `protobuf:"bytes,23,rep,name=bridged_supplies,json=bridgedSupplies,proto3" json:"bridged_supplies"`
+	// list of bridged markers' latest supply reconciliations
+	BridgedSupplyReconciliations []BridgedSupplyReconciliation `protobuf:"bytes,24,rep,name=bridged_supply_reconciliations,json=bridgedSupplyReconciliations,proto3" json:"bridged_supply_reconciliations"`
+	// list of markers' supply change policies
+	SupplyChangePolicies []SupplyChangePolicy `protobuf:"bytes,25,rep,name=supply_change_policies,json=supplyChangePolicies,proto3" json:"supply_change_policies"`
+	// list of markers' receipt policies
+	ReceiptPolicies []ReceiptPolicy `protobuf:"bytes,26,rep,name=receipt_policies,json=receiptPolicies,proto3" json:"receipt_policies"`
+	// list of transfers waiting to be acknowledged by their recipients
+	PendingReceipts []PendingReceipt `protobuf:"bytes,27,rep,name=pending_receipts,json=pendingReceipts,proto3" json:"pending_receipts"`
+	// the id of the last pending receipt
+	LastPendingReceiptId uint64 `protobuf:"varint,28,opt,name=last_pending_receipt_id,json=lastPendingReceiptId,proto3" json:"last_pending_receipt_id,omitempty"`
+	// list of the daily mint and burn caps of smart contracts on markers
+	ContractSupplyCaps []ContractSupplyCap `protobuf:"bytes,29,rep,name=contract_supply_caps,json=contractSupplyCaps,proto3" json:"contract_supply_caps"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +118,61 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// BridgeNonce identifies a nonce of a marker bridge
+type BridgeNonce struct {
+	// bridge is the name of the bridge.
+	Bridge string `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	// nonce is the bridge nonce.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *BridgeNonce) Reset()         { *m = BridgeNonce{} }
+func (m *BridgeNonce) String() string { return proto.CompactTextString(m) }
+func (*BridgeNonce) ProtoMessage()    {}
+func (*BridgeNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{1}
+}
+func (m *BridgeNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BridgeNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeNonce.Merge(m, src)
+}
+func (m *BridgeNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BridgeNonce proto.InternalMessageInfo
+
+func (m *BridgeNonce) GetBridge() string {
+	if m != nil {
+		return m.Bridge
+	}
+	return ""
+}
+
+func (m *BridgeNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // DenySendAddress defines addresses that are denied sends for marker denom
 type DenySendAddress struct {
 	// marker_address is the marker's address for denied address
@@ -80,7 +185,7 @@ func (m *DenySendAddress) Reset()         { *m = DenySendAddress{} }
 func (m *DenySendAddress) String() string { return proto.CompactTextString(m) }
 func (*DenySendAddress) ProtoMessage()    {}
 func (*DenySendAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dcc4ab7c9d2f78f, []int{1}
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{2}
 }
 func (m *DenySendAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -121,7 +226,7 @@ func (m *MarkerNetAssetValues) Reset()         { *m = MarkerNetAssetValues{} }
 func (m *MarkerNetAssetValues) String() string { return proto.CompactTextString(m) }
 func (*MarkerNetAssetValues) ProtoMessage()    {}
 func (*MarkerNetAssetValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5dcc4ab7c9d2f78f, []int{2}
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{3}
 }
 func (m *MarkerNetAssetValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,6 +257,7 @@ var xxx_messageInfo_MarkerNetAssetValues proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
+	proto.RegisterType((*BridgeNonce)(nil), "provenance.marker.v1.BridgeNonce")
 	proto.RegisterType((*DenySendAddress)(nil), "provenance.marker.v1.DenySendAddress")
 	proto.RegisterType((*MarkerNetAssetValues)(nil), "provenance.marker.v1.MarkerNetAssetValues")
 }
@@ -161,33 +267,79 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 1149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x52, 0x1b, 0xc7,
+	0x13, 0x96, 0x00, 0x83, 0x19, 0x01, 0x12, 0x83, 0x0c, 0xfb, 0xe3, 0x47, 0xc4, 0x9f, 0xc4, 0x09,
+	0x15, 0x57, 0x24, 0x43, 0x2a, 0x17, 0xe7, 0x24, 0x84, 0xed, 0xa2, 0x2a, 0xb1, 0x89, 0x70, 0x52,
+	0x8e, 0x53, 0x95, 0xa9, 0xd1, 0xee, 0x20, 0xb6, 0x2c, 0x66, 0x97, 0xe9, 0x59, 0x11, 0xa5, 0x2a,
+	0xf7, 0xdc, 0x92, 0x47, 0xf0, 0x83, 0xe4, 0x01, 0x7c, 0xf4, 0x31, 0xa7, 0x54, 0x0a, 0x2e, 0x79,
+	0x8c, 0xd4, 0xfc, 0x43, 0xbb, 0xca, 0xb2, 0xe5, 0x9b, 0xb6, 0xfb, 0xfb, 0xbe, 0x6e, 0x75, 0xf7,
+	0xcc, 0x34, 0xda, 0x89, 0x45, 0x34, 0x64, 0x9c, 0x72, 0x9f, 0xb5, 0xce, 0xa9, 0x78, 0xcd, 0x44,
+	0x6b, 0xb8, 0xd7, 0xea, 0x33, 0xce, 0x20, 0x84, 0x66, 0x2c, 0x22, 0x19, 0xe1, 0xfa, 0x18, 0xd3,
+	0x34, 0x98, 0xe6, 0x70, 0x6f, 0xbd, 0xde, 0x8f, 0xfa, 0x91, 0x06, 0xb4, 0xd4, 0x2f, 0x83, 0x5d,
+	0xdf, 0xce, 0xd5, 0xb3, 0x2c, 0x0d, 0xd9, 0xf9, 0x63, 0x05, 0x2d, 0x3c, 0x35, 0x01, 0x4e, 0x24,
+	0x95, 0x0c, 0x3f, 0x42, 0xb3, 0x31, 0x15, 0xf4, 0x1c, 0xbc, 0xf2, 0x56, 0x79, 0xb7, 0xb2, 0xbf,
+	0xd1, 0xcc, 0x0b, 0xd8, 0x3c, 0xd6, 0x98, 0x83, 0x99, 0xb7, 0x7f, 0x6d, 0x96, 0xba, 0x96, 0x81,
+	0x3b, 0x68, 0xce, 0x20, 0xc0, 0x9b, 0xda, 0x9a, 0xde, 0xad, 0xec, 0x7f, 0x98, 0x4f, 0xfe, 0x5a,
+	0xff, 0x6a, 0xfb, 0x7e, 0x94, 0x70, 0x69, 0x35, 0x1c, 0x13, 0xbf, 0x42, 0x35, 0xce, 0x24, 0xa1,
+	0x00, 0x4c, 0x92, 0x21, 0x1d, 0x24, 0x0c, 0xbc, 0x69, 0xad, 0xf6, 0x69, 0x91, 0xda, 0x33, 0x26,
+	0xdb, 0x8a, 0xf2, 0x9d, 0x66, 0x58, 0xd1, 0x25, 0x9e, 0xb1, 0xe2, 0x1f, 0xd0, 0x4a, 0xc0, 0xf8,
+	0x88, 0x00, 0xe3, 0x01, 0xa1, 0x41, 0x20, 0x18, 0x00, 0x03, 0x6f, 0x46, 0xcb, 0xdf, 0xcf, 0x97,
+	0x3f, 0x64, 0x7c, 0x74, 0xc2, 0x78, 0xd0, 0x36, 0x70, 0xab, 0xbc, 0x1c, 0x64, 0xcd, 0x0c, 0xf0,
+	0x73, 0xb4, 0xc4, 0x84, 0xbf, 0xff, 0x90, 0xc4, 0x51, 0xc8, 0xa5, 0x2a, 0xc2, 0x1d, 0xad, 0xbb,
+	0x93, 0xaf, 0xfb, 0xb8, 0xdb, 0xd9, 0x7f, 0x78, 0x6c, 0xa0, 0x56, 0x74, 0x51, 0xf3, 0xad, 0x0d,
+	0xf0, 0x01, 0x9a, 0xeb, 0x89, 0x30, 0xe8, 0x33, 0xf0, 0x66, 0x8b, 0x94, 0x4c, 0x01, 0x0e, 0x34,
+	0xd4, 0x55, 0xd3, 0x12, 0xf1, 0xb7, 0x08, 0x27, 0xc0, 0x02, 0x62, 0xbe, 0x09, 0x8f, 0xb8, 0xcf,
+	0xc0, 0x9b, 0xd3, 0x72, 0xdb, 0xf9, 0x72, 0x46, 0xe8, 0x99, 0x42, 0x5a, 0xb5, 0x9a, 0x92, 0x48,
+	0x99, 0x01, 0x13, 0x54, 0x8f, 0x19, 0x0f, 0x42, 0xde, 0x27, 0x34, 0x38, 0x0f, 0x39, 0xe9, 0x0b,
+	0xca, 0x25, 0x78, 0x77, 0xb5, 0xf0, 0x27, 0xb7, 0xcc, 0x8c, 0x61, 0xb4, 0x15, 0xe1, 0xa9, 0xc2,
+	0x5b, 0x79, 0x1c, 0x4f, 0x3a, 0x00, 0xef, 0xa1, 0x7b, 0x82, 0x5d, 0x10, 0x2a, 0xa5, 0x20, 0xbd,
+	0x51, 0x4c, 0x01, 0x74, 0xbf, 0xc0, 0x9b, 0xdf, 0x9a, 0xde, 0x9d, 0xef, 0x62, 0xc1, 0x2e, 0xda,
+	0x52, 0x8a, 0x03, 0xed, 0x52, 0x3d, 0x00, 0xfc, 0x23, 0x5a, 0xf6, 0x05, 0xa3, 0x32, 0x8c, 0x38,
+	0x09, 0x58, 0x1c, 0x41, 0x28, 0xc1, 0x43, 0x3a, 0xa1, 0x07, 0x45, 0x85, 0xeb, 0x58, 0xd2, 0xa1,
+	0xe1, 0xb8, 0xff, 0xec, 0x67, 0xcd, 0x80, 0x2f, 0xd1, 0x86, 0x4a, 0x27, 0xec, 0x25, 0x92, 0x11,
+	0xc1, 0x86, 0x91, 0x6f, 0x62, 0xf9, 0x11, 0x3f, 0x0d, 0xfb, 0xe0, 0x55, 0x74, 0xa8, 0x56, 0x7e,
+	0xa8, 0xb6, 0x63, 0x76, 0x6f, 0x88, 0x1d, 0xcd, 0xb3, 0xe1, 0xd6, 0xe9, 0x6d, 0x00, 0xc0, 0x5d,
+	0x54, 0x3d, 0x15, 0xd1, 0xcf, 0x8c, 0x13, 0x6a, 0x8e, 0x0c, 0x78, 0x0b, 0x45, 0xc7, 0xeb, 0x89,
+	0x06, 0x67, 0x8f, 0xd7, 0xd2, 0x69, 0xda, 0x08, 0xf8, 0x35, 0xf2, 0xc0, 0x3f, 0x63, 0x41, 0x32,
+	0x60, 0x01, 0x89, 0xa3, 0x41, 0xe8, 0x8f, 0x88, 0x7f, 0x46, 0xb9, 0x1a, 0xb6, 0xc5, 0xa2, 0x9a,
+	0x9d, 0x38, 0xd6, 0xb1, 0x26, 0x75, 0x34, 0xc7, 0x06, 0x59, 0x85, 0x3c, 0xa7, 0x6e, 0xe6, 0x80,
+	0x82, 0xcc, 0xc6, 0x21, 0x61, 0xe0, 0x2d, 0x6d, 0x95, 0x77, 0x67, 0xba, 0x58, 0x39, 0xd3, 0x8c,
+	0xa3, 0x00, 0x53, 0x54, 0x17, 0x0c, 0x98, 0x18, 0xaa, 0x52, 0x5f, 0x24, 0xa1, 0x60, 0xe7, 0x4c,
+	0xfd, 0xf1, 0xaa, 0xce, 0x6d, 0x37, 0x3f, 0xb7, 0xae, 0x61, 0x74, 0xc7, 0x04, 0x9b, 0xd8, 0x8a,
+	0xf8, 0x8f, 0x07, 0xf0, 0xf7, 0x68, 0xd9, 0x85, 0xa0, 0x52, 0x32, 0x90, 0x91, 0x00, 0xaf, 0xa6,
+	0xf5, 0x3f, 0x2e, 0xd4, 0x6f, 0x3b, 0xb4, 0x1b, 0x15, 0x31, 0x61, 0x4f, 0x67, 0x6f, 0xa4, 0x75,
+	0x3f, 0xc1, 0x5b, 0x7e, 0x8f, 0xec, 0xdb, 0x63, 0xc2, 0x44, 0xf6, 0x29, 0x0f, 0xe0, 0x6f, 0xd0,
+	0x62, 0x90, 0xb8, 0x9a, 0x86, 0x0c, 0x3c, 0x5c, 0x94, 0xb9, 0x99, 0xf4, 0xc3, 0xc4, 0xd5, 0xd9,
+	0x2a, 0x2f, 0x04, 0xce, 0x12, 0x32, 0xc0, 0x4f, 0x50, 0x05, 0x2e, 0x69, 0x4c, 0xa2, 0xd3, 0x53,
+	0x75, 0x7b, 0xad, 0x68, 0xc1, 0xcd, 0x5b, 0xc6, 0xe0, 0x92, 0xc6, 0xcf, 0x15, 0xce, 0x2a, 0x21,
+	0x70, 0x06, 0xc0, 0x0f, 0x90, 0xee, 0x28, 0x19, 0x8b, 0xa9, 0x5e, 0xd7, 0x75, 0xaf, 0xab, 0xca,
+	0x73, 0x43, 0x3e, 0x0a, 0xf0, 0x4b, 0xb4, 0x1c, 0x02, 0x24, 0x4a, 0x9e, 0x48, 0x41, 0xb9, 0x7f,
+	0xc6, 0xc0, 0xbb, 0x57, 0x74, 0x21, 0x1f, 0x59, 0xf8, 0x0b, 0x83, 0x76, 0x4d, 0x08, 0xb3, 0x66,
+	0xc0, 0x2f, 0x11, 0x86, 0xa4, 0x47, 0x06, 0x2c, 0xe8, 0x33, 0x41, 0x18, 0x97, 0x42, 0x95, 0x69,
+	0x55, 0x4b, 0x7f, 0x74, 0xcb, 0xbf, 0x4a, 0x7a, 0x5f, 0x69, 0xf8, 0x63, 0x2e, 0x85, 0x2b, 0x52,
+	0x0d, 0xd2, 0x56, 0x55, 0xa8, 0x17, 0xa8, 0x66, 0xee, 0xd3, 0x80, 0x40, 0x12, 0xc7, 0x03, 0xa5,
+	0xbb, 0x56, 0x74, 0x22, 0xcd, 0xdd, 0x19, 0x9c, 0x28, 0xb0, 0x93, 0xad, 0xf6, 0x52, 0x46, 0xa5,
+	0xfa, 0x0b, 0x6a, 0x64, 0x54, 0x47, 0x44, 0x30, 0x3f, 0xe2, 0x7e, 0x38, 0x08, 0xed, 0xf8, 0x78,
+	0x3a, 0xc6, 0xde, 0x7b, 0xc4, 0xe8, 0x66, 0x98, 0x36, 0xe2, 0x46, 0xef, 0x76, 0x08, 0xe0, 0x00,
+	0xad, 0xda, 0xb0, 0xf6, 0x7c, 0xde, 0x4c, 0xd6, 0xff, 0x8a, 0xa6, 0xd6, 0x88, 0x99, 0x73, 0x9b,
+	0x99, 0xad, 0x3a, 0x4c, 0x7a, 0x6c, 0xe9, 0x04, 0xf3, 0x59, 0x18, 0xa7, 0x26, 0x77, 0xbd, 0xa8,
+	0x74, 0x5d, 0x83, 0xce, 0x48, 0x57, 0x45, 0xca, 0x18, 0xea, 0x57, 0xae, 0xe6, 0x9e, 0x23, 0xeb,
+	0x02, 0xef, 0xff, 0x45, 0x8d, 0xb6, 0x4f, 0x91, 0x15, 0x77, 0xb2, 0x71, 0xc6, 0x0a, 0xf8, 0x0b,
+	0xb4, 0x66, 0xee, 0xad, 0xac, 0xb6, 0x9a, 0xe6, 0x0d, 0x3d, 0xcd, 0x75, 0x7d, 0x73, 0x65, 0x58,
+	0x47, 0x81, 0x7a, 0x1c, 0xfd, 0x88, 0x4b, 0x41, 0x7d, 0xe9, 0x3a, 0xe9, 0xd3, 0x18, 0xbc, 0x0f,
+	0x8a, 0x1e, 0xc7, 0x8e, 0x65, 0xd8, 0x7a, 0xd2, 0xd8, 0x3d, 0x8e, 0xfe, 0xa4, 0x03, 0x1e, 0xdd,
+	0xfd, 0xf5, 0xcd, 0x66, 0xe9, 0x9f, 0x37, 0x9b, 0xa5, 0x9d, 0x2f, 0x51, 0x25, 0xf5, 0x2e, 0xe3,
+	0x55, 0x34, 0x6b, 0x7a, 0xac, 0x97, 0xb7, 0xf9, 0xae, 0xfd, 0xc2, 0x75, 0x74, 0x47, 0xbf, 0xfc,
+	0xde, 0x94, 0x4e, 0xdb, 0x7c, 0xec, 0x30, 0x54, 0x9d, 0x58, 0x6e, 0xf0, 0x7d, 0xb4, 0x64, 0x52,
+	0x72, 0xdb, 0x91, 0x15, 0x5a, 0x34, 0x56, 0x07, 0xdb, 0x46, 0x0b, 0x7a, 0x8f, 0x72, 0xa0, 0x29,
+	0x0d, 0xaa, 0x28, 0x9b, 0x85, 0xa4, 0x72, 0xfc, 0xad, 0x8c, 0xea, 0x79, 0x3b, 0x1a, 0xf6, 0xd0,
+	0x5c, 0x36, 0x8a, 0xfb, 0xc4, 0x27, 0x39, 0x3b, 0x60, 0xe1, 0x46, 0x99, 0x51, 0xce, 0x5f, 0xfe,
+	0xc6, 0x19, 0x1d, 0xf4, 0xdf, 0x5e, 0x35, 0xca, 0xef, 0xae, 0x1a, 0xe5, 0xbf, 0xaf, 0x1a, 0xe5,
+	0xdf, 0xaf, 0x1b, 0xa5, 0x77, 0xd7, 0x8d, 0xd2, 0x9f, 0xd7, 0x8d, 0x12, 0x5a, 0x0b, 0xa3, 0xdc,
+	0x00, 0xc7, 0xe5, 0x57, 0xfb, 0xfd, 0x50, 0x9e, 0x25, 0xbd, 0xa6, 0x1f, 0x9d, 0xb7, 0xc6, 0x90,
+	0xcf, 0xc2, 0x28, 0xf5, 0xd5, 0xfa, 0xc9, 0xed, 0xd9, 0x72, 0x14, 0x33, 0xe8, 0xcd, 0xea, 0x25,
+	0xfb, 0xf3, 0x7f, 0x07, 0x00, 0xce, 0x22, 0xd9, 0x3f, 0xd9, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,10 +362,10 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenySendAddresses) > 0 {
-		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ContractSupplyCaps) > 0 {
+		for iNdEx := len(m.ContractSupplyCaps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DenySendAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ContractSupplyCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -221,13 +373,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+	if m.LastPendingReceiptId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPendingReceiptId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.PendingReceipts) > 0 {
+		for iNdEx := len(m.PendingReceipts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -235,13 +396,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.Markers) > 0 {
-		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ReceiptPolicies) > 0 {
+		for iNdEx := len(m.ReceiptPolicies) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ReceiptPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -249,83 +412,79 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.SupplyChangePolicies) > 0 {
+		for iNdEx := len(m.SupplyChangePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyChangePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *DenySendAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DenySendAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DenySendAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenyAddress) > 0 {
-		i -= len(m.DenyAddress)
-		copy(dAtA[i:], m.DenyAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DenyAddress)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.BridgedSupplyReconciliations) > 0 {
+		for iNdEx := len(m.BridgedSupplyReconciliations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgedSupplyReconciliations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
 	}
-	if len(m.MarkerAddress) > 0 {
-		i -= len(m.MarkerAddress)
-		copy(dAtA[i:], m.MarkerAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarkerAddress)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.BridgedSupplies) > 0 {
+		for iNdEx := len(m.BridgedSupplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgedSupplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.SubLedgerEntries) > 0 {
+		for iNdEx := len(m.SubLedgerEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubLedgerEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MarkerNetAssetValues) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NetAssetValues) > 0 {
-		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.IssuanceTranches) > 0 {
+		for iNdEx := len(m.IssuanceTranches) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.IssuanceTranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -333,133 +492,1345 @@ func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+	if m.LastSwapOfferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSwapOfferId))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SwapOffers) > 0 {
+		for iNdEx := len(m.SwapOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Markers) > 0 {
-		for _, e := range m.Markers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DustPolicies) > 0 {
+		for iNdEx := len(m.DustPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReserveAttestations) > 0 {
+		for iNdEx := len(m.ReserveAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	if len(m.DenySendAddresses) > 0 {
-		for _, e := range m.DenySendAddresses {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReserveAttestors) > 0 {
+		for iNdEx := len(m.ReserveAttestors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveAttestors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
 		}
 	}
-	return n
-}
-
-func (m *DenySendAddress) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ReserveRequirements) > 0 {
+		for iNdEx := len(m.ReserveRequirements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveRequirements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.MarkerAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.LastPolicyChangeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPolicyChangeId))
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.DenyAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledPolicyChanges) > 0 {
+		for iNdEx := len(m.ScheduledPolicyChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledPolicyChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	return n
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AttributeRevocationConfigs) > 0 {
+		for iNdEx := len(m.AttributeRevocationConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeRevocationConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.CreationDeposits) > 0 {
+		for iNdEx := len(m.CreationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ReqAttrBypassAddrs) > 0 {
+		for iNdEx := len(m.ReqAttrBypassAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReqAttrBypassAddrs[iNdEx])
+			copy(dAtA[i:], m.ReqAttrBypassAddrs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ReqAttrBypassAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingAdminGrants) > 0 {
+		for iNdEx := len(m.PendingAdminGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UsedBridgeNonces) > 0 {
+		for iNdEx := len(m.UsedBridgeNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedBridgeNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Bridges) > 0 {
+		for iNdEx := len(m.Bridges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bridges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Erc20Pointers) > 0 {
+		for iNdEx := len(m.Erc20Pointers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Pointers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenySendAddresses) > 0 {
+		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenySendAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Markers) > 0 {
+		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MarkerNetAssetValues) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BridgeNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.NetAssetValues) > 0 {
-		for _, e := range m.NetAssetValues {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.Bridge) > 0 {
+		i -= len(m.Bridge)
+		copy(dAtA[i:], m.Bridge)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Bridge)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DenySendAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DenySendAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+
+func (m *DenySendAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenyAddress) > 0 {
+		i -= len(m.DenyAddress)
+		copy(dAtA[i:], m.DenyAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DenyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerAddress) > 0 {
+		i -= len(m.MarkerAddress)
+		copy(dAtA[i:], m.MarkerAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarkerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarkerNetAssetValues) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarkerNetAssetValues) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerNetAssetValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenySendAddresses) > 0 {
+		for _, e := range m.DenySendAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Pointers) > 0 {
+		for _, e := range m.Erc20Pointers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bridges) > 0 {
+		for _, e := range m.Bridges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedBridgeNonces) > 0 {
+		for _, e := range m.UsedBridgeNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAdminGrants) > 0 {
+		for _, e := range m.PendingAdminGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReqAttrBypassAddrs) > 0 {
+		for _, s := range m.ReqAttrBypassAddrs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreationDeposits) > 0 {
+		for _, e := range m.CreationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttributeRevocationConfigs) > 0 {
+		for _, e := range m.AttributeRevocationConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledPolicyChanges) > 0 {
+		for _, e := range m.ScheduledPolicyChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastPolicyChangeId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastPolicyChangeId))
+	}
+	if len(m.ReserveRequirements) > 0 {
+		for _, e := range m.ReserveRequirements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveAttestors) > 0 {
+		for _, e := range m.ReserveAttestors {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveAttestations) > 0 {
+		for _, e := range m.ReserveAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DustPolicies) > 0 {
+		for _, e := range m.DustPolicies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapOffers) > 0 {
+		for _, e := range m.SwapOffers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSwapOfferId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastSwapOfferId))
+	}
+	if len(m.IssuanceTranches) > 0 {
+		for _, e := range m.IssuanceTranches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubLedgerEntries) > 0 {
+		for _, e := range m.SubLedgerEntries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgedSupplies) > 0 {
+		for _, e := range m.BridgedSupplies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgedSupplyReconciliations) > 0 {
+		for _, e := range m.BridgedSupplyReconciliations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyChangePolicies) > 0 {
+		for _, e := range m.SupplyChangePolicies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptPolicies) > 0 {
+		for _, e := range m.ReceiptPolicies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingReceipts) > 0 {
+		for _, e := range m.PendingReceipts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastPendingReceiptId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastPendingReceiptId))
+	}
+	if len(m.ContractSupplyCaps) > 0 {
+		for _, e := range m.ContractSupplyCaps {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bridge)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *DenySendAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DenyAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *MarkerNetAssetValues) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, MarkerAccount{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenySendAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenySendAddresses = append(m.DenySendAddresses, DenySendAddress{})
+			if err := m.DenySendAddresses[len(m.DenySendAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Pointers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Pointers = append(m.Erc20Pointers, ERC20Pointer{})
+			if err := m.Erc20Pointers[len(m.Erc20Pointers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bridges = append(m.Bridges, MarkerBridge{})
+			if err := m.Bridges[len(m.Bridges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBridgeNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedBridgeNonces = append(m.UsedBridgeNonces, BridgeNonce{})
+			if err := m.UsedBridgeNonces[len(m.UsedBridgeNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminGrants = append(m.PendingAdminGrants, PendingAdminGrant{})
+			if err := m.PendingAdminGrants[len(m.PendingAdminGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqAttrBypassAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqAttrBypassAddrs = append(m.ReqAttrBypassAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationDeposits = append(m.CreationDeposits, MarkerCreationDeposit{})
+			if err := m.CreationDeposits[len(m.CreationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeRevocationConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeRevocationConfigs = append(m.AttributeRevocationConfigs, AttributeRevocationConfig{})
+			if err := m.AttributeRevocationConfigs[len(m.AttributeRevocationConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledPolicyChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledPolicyChanges = append(m.ScheduledPolicyChanges, ScheduledPolicyChange{})
+			if err := m.ScheduledPolicyChanges[len(m.ScheduledPolicyChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPolicyChangeId", wireType)
+			}
+			m.LastPolicyChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPolicyChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveRequirements = append(m.ReserveRequirements, ReserveRequirement{})
+			if err := m.ReserveRequirements[len(m.ReserveRequirements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAttestors = append(m.ReserveAttestors, ReserveAttestors{})
+			if err := m.ReserveAttestors[len(m.ReserveAttestors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAttestations = append(m.ReserveAttestations, ReserveAttestation{})
+			if err := m.ReserveAttestations[len(m.ReserveAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustPolicies = append(m.DustPolicies, MarkerDustPolicy{})
+			if err := m.DustPolicies[len(m.DustPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOffers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapOffers = append(m.SwapOffers, SwapOffer{})
+			if err := m.SwapOffers[len(m.SwapOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSwapOfferId", wireType)
+			}
+			m.LastSwapOfferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSwapOfferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceTranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IssuanceTranches = append(m.IssuanceTranches, IssuanceTranche{})
+			if err := m.IssuanceTranches[len(m.IssuanceTranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubLedgerEntries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,13 +1857,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubLedgerEntries = append(m.SubLedgerEntries, SubLedgerEntry{})
+			if err := m.SubLedgerEntries[len(m.SubLedgerEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgedSupplies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -519,14 +1891,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markers = append(m.Markers, MarkerAccount{})
-			if err := m.Markers[len(m.Markers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgedSupplies = append(m.BridgedSupplies, BridgedSupply{})
+			if err := m.BridgedSupplies[len(m.BridgedSupplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgedSupplyReconciliations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -553,14 +1925,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetAssetValues = append(m.NetAssetValues, MarkerNetAssetValues{})
-			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgedSupplyReconciliations = append(m.BridgedSupplyReconciliations, BridgedSupplyReconciliation{})
+			if err := m.BridgedSupplyReconciliations[len(m.BridgedSupplyReconciliations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenySendAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyChangePolicies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -587,11 +1959,233 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenySendAddresses = append(m.DenySendAddresses, DenySendAddress{})
-			if err := m.DenySendAddresses[len(m.DenySendAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SupplyChangePolicies = append(m.SupplyChangePolicies, SupplyChangePolicy{})
+			if err := m.SupplyChangePolicies[len(m.SupplyChangePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptPolicies = append(m.ReceiptPolicies, ReceiptPolicy{})
+			if err := m.ReceiptPolicies[len(m.ReceiptPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingReceipts = append(m.PendingReceipts, PendingReceipt{})
+			if err := m.PendingReceipts[len(m.PendingReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPendingReceiptId", wireType)
+			}
+			m.LastPendingReceiptId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPendingReceiptId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSupplyCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSupplyCaps = append(m.ContractSupplyCaps, ContractSupplyCap{})
+			if err := m.ContractSupplyCaps[len(m.ContractSupplyCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bridge = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
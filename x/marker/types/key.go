@@ -1,7 +1,10 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
@@ -35,6 +38,111 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// ERC20PointerPrefix prefix for ERC-20 pointers of markers
+	ERC20PointerPrefix = []byte{0x06}
+
+	// ERC20ContractPrefix prefix for the index of ERC-20 contracts to marker denoms
+	ERC20ContractPrefix = []byte{0x07}
+
+	// BridgePrefix prefix for marker bridges
+	BridgePrefix = []byte{0x08}
+
+	// BridgeNoncePrefix prefix for the nonces that have been used by marker bridges
+	BridgeNoncePrefix = []byte{0x09}
+
+	// DenySendAddressPrefix prefix for the index of denied addresses to the markers that deny them
+	DenySendAddressPrefix = []byte{0x0A}
+
+	// PendingAdminGrantPrefix prefix for grants of admin access on markers that are waiting to be accepted
+	PendingAdminGrantPrefix = []byte{0x0B}
+
+	// ReqAttrBypassAddrPrefix prefix for addresses added through governance that bypass the required attributes checking
+	ReqAttrBypassAddrPrefix = []byte{0x0C}
+
+	// CreationDepositPrefix prefix for the escrowed deposits of markers that have not yet been activated
+	CreationDepositPrefix = []byte{0x0D}
+
+	// AttributeRevocationConfigPrefix prefix for what markers do to accounts that lose one of their required attributes
+	AttributeRevocationConfigPrefix = []byte{0x0E}
+
+	// FrozenAccountPrefix prefix for accounts whose outbound transfers of a marker's coin are frozen
+	FrozenAccountPrefix = []byte{0x0F}
+
+	// AccessGrantPrefix prefix for the access grants of markers
+	AccessGrantPrefix = []byte{0x10}
+
+	// MarkerDenomPrefix prefix for the flags of which denoms have a marker
+	MarkerDenomPrefix = []byte{0x11}
+
+	// ScheduledPolicyChangePrefix prefix for changes to markers' required attributes that have not taken effect yet
+	ScheduledPolicyChangePrefix = []byte{0x12}
+
+	// LastPolicyChangeIDKey key for the id of the last scheduled policy change
+	LastPolicyChangeIDKey = []byte{0x13}
+
+	// ReserveRequirementPrefix prefix for the collateral that markers must hold in escrow to back their supply
+	ReserveRequirementPrefix = []byte{0x14}
+
+	// ReserveAttestorsPrefix prefix for the accounts approved to publish markers' reserve attestations
+	ReserveAttestorsPrefix = []byte{0x15}
+
+	// ReserveAttestationPrefix prefix for the latest reserve attestation from each of a marker's attestors
+	ReserveAttestationPrefix = []byte{0x16}
+
+	// DustPolicyPrefix prefix for what markers do with the dust left over from pro-rata operations
+	DustPolicyPrefix = []byte{0x17}
+
+	// SwapOfferPrefix prefix for offers to swap the coins of two markers that have not been accepted yet
+	SwapOfferPrefix = []byte{0x18}
+
+	// LastSwapOfferIDKey key for the id of the last swap offer
+	LastSwapOfferIDKey = []byte{0x19}
+
+	// IssuanceTranchePrefix prefix for the records of each round of a marker's issuance
+	IssuanceTranchePrefix = []byte{0x1A}
+
+	// SubLedgerEntryPrefix prefix for the entries of each custodian's sub-ledger
+	SubLedgerEntryPrefix = []byte{0x1B}
+
+	// SubLedgerSubAccountIndexPrefix prefix for the index of sub-ledger entries by custodian and sub-account
+	SubLedgerSubAccountIndexPrefix = []byte{0x1C}
+
+	// BridgedSupplyPrefix prefix for how bridged markers' supplies are reconciled against their counterparty chains
+	BridgedSupplyPrefix = []byte{0x1D}
+
+	// BridgedSupplyReconciliationPrefix prefix for the latest supply reconciliation of each bridged marker
+	BridgedSupplyReconciliationPrefix = []byte{0x1E}
+
+	// SupplyChangePolicyPrefix prefix for the limits on how much a marker's supply can change in a single block
+	SupplyChangePolicyPrefix = []byte{0x1F}
+
+	// ReceiptPolicyPrefix prefix for how long the recipients of markers' coins have to acknowledge transfers
+	ReceiptPolicyPrefix = []byte{0x20}
+
+	// PendingReceiptPrefix prefix for transfers that are waiting to be acknowledged by their recipients
+	PendingReceiptPrefix = []byte{0x21}
+
+	// LastPendingReceiptIDKey key for the id of the last pending receipt
+	LastPendingReceiptIDKey = []byte{0x22}
+
+	// ContractSupplyCapPrefix prefix for the daily mint and burn caps of smart contracts on markers
+	ContractSupplyCapPrefix = []byte{0x23}
+
+	// PendingReceiptExpirationPrefix prefix for the index of pending receipts by expiration height
+	PendingReceiptExpirationPrefix = []byte{0x24}
+
+	// SwapOfferExpirationPrefix prefix for the index of swap offers by expiration height
+	SwapOfferExpirationPrefix = []byte{0x25}
+
+	// ReserveAttestationExpirationPrefix prefix for the index of reserve attestations that are not stale yet by valid until time
+	ReserveAttestationExpirationPrefix = []byte{0x26}
+
+	// PolicyChangeEffectiveHeightPrefix prefix for the index of scheduled policy changes by effective height
+	PolicyChangeEffectiveHeightPrefix = []byte{0x27}
+
+	// SupplyChangeUsagePrefix prefix for how much each marker's supply has changed by in the current block
+	SupplyChangeUsagePrefix = []byte{0x28}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -59,6 +167,11 @@ func MarkerStoreKey(addr sdk.AccAddress) []byte {
 	return append(MarkerStoreKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
 }
 
+// MarkerDenomKey returns key [prefix][denom] for the flag that a denom has a marker
+func MarkerDenomKey(denom string) []byte {
+	return append(MarkerDenomPrefix, denom...)
+}
+
 // SplitMarkerStoreKey returns an account address given a store key, uses the length prefix to determine length of AccAddress
 func SplitMarkerStoreKey(key []byte) sdk.AccAddress {
 	return sdk.AccAddress(key[2 : key[1]+2])
@@ -87,6 +200,76 @@ func DenySendMarkerPrefix(markerAddr sdk.AccAddress) []byte {
 	return key
 }
 
+// DenySendAddressKey returns a key [prefix][deny addr][denom addr] for the index of denied addresses to markers
+func DenySendAddressKey(denyAddr sdk.AccAddress, markerAddr sdk.AccAddress) []byte {
+	return append(DenySendAddressKeyPrefix(denyAddr), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// DenySendAddressKeyPrefix returns an extended prefix [prefix][deny addr] for the index of denied addresses to markers
+func DenySendAddressKeyPrefix(denyAddr sdk.AccAddress) []byte {
+	return append(DenySendAddressPrefix, address.MustLengthPrefix(denyAddr.Bytes())...)
+}
+
+// GetDenySendAddressKeyAddresses returns the denied and marker sdk.AccAddress's from a DenySendAddressKey
+func GetDenySendAddressKeyAddresses(key []byte) (denyAddr sdk.AccAddress, markerAddr sdk.AccAddress) {
+	denyKeyLen := key[1]
+	markerKeyLen := key[denyKeyLen+2]
+	denyAddr = sdk.AccAddress(key[2 : denyKeyLen+2])
+	markerAddr = sdk.AccAddress(key[denyKeyLen+3 : denyKeyLen+3+markerKeyLen])
+	return
+}
+
+// PendingAdminGrantKeyPrefix returns key [prefix][marker address] for the pending admin grants of a marker
+func PendingAdminGrantKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(PendingAdminGrantPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingAdminGrantKey returns key [prefix][marker address][grantee address] for a pending admin grant
+func PendingAdminGrantKey(markerAddr sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(PendingAdminGrantKeyPrefix(markerAddr), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// ReqAttrBypassAddrKey returns key [prefix][address] for an address that bypasses the required attributes checking
+func ReqAttrBypassAddrKey(addr sdk.AccAddress) []byte {
+	return append(ReqAttrBypassAddrPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetReqAttrBypassAddrFromKey returns the address in a ReqAttrBypassAddrKey
+func GetReqAttrBypassAddrFromKey(key []byte) sdk.AccAddress {
+	addrLen := key[1]
+	return sdk.AccAddress(key[2 : 2+addrLen])
+}
+
+// CreationDepositKey returns key [prefix][marker address] for the creation deposit of a marker
+func CreationDepositKey(markerAddr sdk.AccAddress) []byte {
+	return append(CreationDepositPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// AttributeRevocationConfigKey returns key [prefix][marker address] for the attribute revocation config of a marker
+func AttributeRevocationConfigKey(markerAddr sdk.AccAddress) []byte {
+	return append(AttributeRevocationConfigPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// FrozenAccountKeyPrefix returns key [prefix][marker address] for the frozen accounts of a marker
+func FrozenAccountKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(FrozenAccountPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// FrozenAccountKey returns key [prefix][marker address][account address] for a frozen account
+func FrozenAccountKey(markerAddr sdk.AccAddress, addr sdk.AccAddress) []byte {
+	return append(FrozenAccountKeyPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// AccessGrantKeyPrefix returns key [prefix][marker address] for the access grants of a marker
+func AccessGrantKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(AccessGrantPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// AccessGrantKey returns key [prefix][marker address][grantee address] for an access grant of a marker
+func AccessGrantKey(markerAddr sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(AccessGrantKeyPrefix(markerAddr), address.MustLengthPrefix(grantee.Bytes())...)
+}
+
 // NetAssetValueKey returns key [prefix][marker address] for marker net asset values
 func NetAssetValueKeyPrefix(markerAddr sdk.AccAddress) []byte {
 	return append(NetAssetValuePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
@@ -103,3 +286,277 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// ERC20PointerKeyPrefix returns key [prefix][marker address] for marker ERC-20 pointers
+func ERC20PointerKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(ERC20PointerPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ERC20PointerKey returns key [prefix][marker address][chain id] for a marker ERC-20 pointer
+func ERC20PointerKey(markerAddr sdk.AccAddress, chainID string) []byte {
+	return append(ERC20PointerKeyPrefix(markerAddr), chainID...)
+}
+
+// ERC20ContractKey returns key [prefix][chain id][contract address] for the index of an ERC-20 contract to a marker denom.
+// The contract address is lower-cased so that the lookup isn't case sensitive.
+func ERC20ContractKey(chainID, contractAddress string) []byte {
+	key := append(ERC20ContractPrefix, address.MustLengthPrefix([]byte(chainID))...)
+	return append(key, strings.ToLower(contractAddress)...)
+}
+
+// GetERC20ContractKeyParts returns the chain id and (lower-cased) contract address from an ERC20ContractKey.
+func GetERC20ContractKeyParts(key []byte) (chainID, contractAddress string) {
+	chainIDLen := int(key[1])
+	return string(key[2 : chainIDLen+2]), string(key[chainIDLen+2:])
+}
+
+// BridgeKey returns key [prefix][bridge name] for a marker bridge
+func BridgeKey(name string) []byte {
+	return append(BridgePrefix, name...)
+}
+
+// BridgeNonceKeyPrefix returns key [prefix][bridge name] for the used nonces of a marker bridge
+func BridgeNonceKeyPrefix(name string) []byte {
+	return append(BridgeNoncePrefix, address.MustLengthPrefix([]byte(name))...)
+}
+
+// BridgeNonceKey returns key [prefix][bridge name][nonce] for a used nonce of a marker bridge
+func BridgeNonceKey(name string, nonce uint64) []byte {
+	return binary.BigEndian.AppendUint64(BridgeNonceKeyPrefix(name), nonce)
+}
+
+// ParseBridgeNonceKey returns the bridge name and nonce from a BridgeNonceKey
+func ParseBridgeNonceKey(key []byte) (string, uint64) {
+	nameLen := int(key[1])
+	return string(key[2 : nameLen+2]), binary.BigEndian.Uint64(key[nameLen+2:])
+}
+
+// ScheduledPolicyChangeKeyPrefix returns key [prefix][marker address] for the scheduled policy changes of a marker
+func ScheduledPolicyChangeKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(ScheduledPolicyChangePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ScheduledPolicyChangeKey returns key [prefix][marker address][id] for a scheduled policy change
+func ScheduledPolicyChangeKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(ScheduledPolicyChangeKeyPrefix(markerAddr), id)
+}
+
+// PolicyChangeEffectiveHeightKey returns key [prefix][effective height][marker address][id] for the scheduled policy
+// change effective height index.
+func PolicyChangeEffectiveHeightKey(height int64, markerAddr sdk.AccAddress, id uint64) []byte {
+	if height < 0 {
+		panic(fmt.Errorf("negative effective height %d not allowed", height))
+	}
+	rv := binary.BigEndian.AppendUint64(append([]byte{}, PolicyChangeEffectiveHeightPrefix...), uint64(height))
+	rv = append(rv, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return binary.BigEndian.AppendUint64(rv, id)
+}
+
+// ParsePolicyChangeEffectiveHeightKey returns the effective height, marker address, and id of a scheduled policy
+// change effective height index key.
+func ParsePolicyChangeEffectiveHeightKey(key []byte) (int64, sdk.AccAddress, uint64, error) {
+	pl := len(PolicyChangeEffectiveHeightPrefix)
+	if len(key) < pl+8+1+8 {
+		return 0, nil, 0, fmt.Errorf("cannot parse policy change effective height key: too short (%d bytes)", len(key))
+	}
+	height := binary.BigEndian.Uint64(key[pl : pl+8])
+	addrLen := int(key[pl+8])
+	if len(key) != pl+8+1+addrLen+8 {
+		return 0, nil, 0, fmt.Errorf("cannot parse policy change effective height key: has %d bytes, expected %d", len(key), pl+8+1+addrLen+8)
+	}
+	markerAddr := sdk.AccAddress(key[pl+9 : pl+9+addrLen])
+	id := binary.BigEndian.Uint64(key[pl+9+addrLen:])
+	return int64(height), markerAddr, id, nil //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
+
+// ReserveRequirementKey returns key [prefix][marker address] for the reserve requirement of a marker
+func ReserveRequirementKey(markerAddr sdk.AccAddress) []byte {
+	return append(ReserveRequirementPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ReserveAttestorsKey returns key [prefix][marker address] for the approved reserve attestors of a marker
+func ReserveAttestorsKey(markerAddr sdk.AccAddress) []byte {
+	return append(ReserveAttestorsPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// DustPolicyKey returns key [prefix][marker address] for the dust policy of a marker
+func DustPolicyKey(markerAddr sdk.AccAddress) []byte {
+	return append(DustPolicyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// IssuanceTrancheKeyPrefix returns key [prefix][marker address] for all of a marker's issuance tranches
+func IssuanceTrancheKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(IssuanceTranchePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// IssuanceTrancheKey returns key [prefix][marker address][id] for an issuance tranche
+func IssuanceTrancheKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(IssuanceTrancheKeyPrefix(markerAddr), id)
+}
+
+// SubLedgerEntryKeyPrefix returns key [prefix][custodian address] for all of a custodian's sub-ledger entries
+func SubLedgerEntryKeyPrefix(custodian sdk.AccAddress) []byte {
+	return append(SubLedgerEntryPrefix, address.MustLengthPrefix(custodian.Bytes())...)
+}
+
+// SubLedgerEntryKey returns key [prefix][custodian address][id] for a sub-ledger entry
+func SubLedgerEntryKey(custodian sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(SubLedgerEntryKeyPrefix(custodian), id)
+}
+
+// SubLedgerSubAccountIndexKeyPrefix returns key [prefix][custodian address][sub-account] for all of the sub-ledger entries
+// of one of a custodian's sub-accounts
+func SubLedgerSubAccountIndexKeyPrefix(custodian sdk.AccAddress, subAccount string) []byte {
+	key := append(SubLedgerSubAccountIndexPrefix, address.MustLengthPrefix(custodian.Bytes())...)
+	return append(key, address.MustLengthPrefix([]byte(subAccount))...)
+}
+
+// SubLedgerSubAccountIndexKey returns key [prefix][custodian address][sub-account][id] for a sub-ledger entry index
+func SubLedgerSubAccountIndexKey(custodian sdk.AccAddress, subAccount string, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(SubLedgerSubAccountIndexKeyPrefix(custodian, subAccount), id)
+}
+
+// BridgedSupplyKey returns key [prefix][marker address] for how a bridged marker's supply is reconciled
+func BridgedSupplyKey(markerAddr sdk.AccAddress) []byte {
+	return append(BridgedSupplyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// BridgedSupplyReconciliationKey returns key [prefix][marker address] for the latest supply reconciliation of a
+// bridged marker
+func BridgedSupplyReconciliationKey(markerAddr sdk.AccAddress) []byte {
+	return append(BridgedSupplyReconciliationPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SupplyChangePolicyKey returns key [prefix][marker address] for the supply change policy of a marker
+func SupplyChangePolicyKey(markerAddr sdk.AccAddress) []byte {
+	return append(SupplyChangePolicyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// SupplyChangeUsageKey returns key [prefix][marker address] for how much a marker's supply has changed by in a block
+func SupplyChangeUsageKey(markerAddr sdk.AccAddress) []byte {
+	return append(SupplyChangeUsagePrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ReceiptPolicyKey returns key [prefix][marker address] for the receipt policy of a marker
+func ReceiptPolicyKey(markerAddr sdk.AccAddress) []byte {
+	return append(ReceiptPolicyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingReceiptKeyPrefix returns key [prefix][recipient address] for the pending receipts of a recipient
+func PendingReceiptKeyPrefix(recipient sdk.AccAddress) []byte {
+	return append(PendingReceiptPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
+
+// PendingReceiptKey returns key [prefix][recipient address][id] for a pending receipt
+func PendingReceiptKey(recipient sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(PendingReceiptKeyPrefix(recipient), id)
+}
+
+// PendingReceiptExpirationKey returns key [prefix][expiration height][recipient address][id] for the pending receipt
+// expiration index.
+func PendingReceiptExpirationKey(height int64, recipient sdk.AccAddress, id uint64) []byte {
+	if height < 0 {
+		panic(fmt.Errorf("negative expiration height %d not allowed", height))
+	}
+	rv := binary.BigEndian.AppendUint64(append([]byte{}, PendingReceiptExpirationPrefix...), uint64(height))
+	rv = append(rv, address.MustLengthPrefix(recipient.Bytes())...)
+	return binary.BigEndian.AppendUint64(rv, id)
+}
+
+// ParsePendingReceiptExpirationKey returns the expiration height, recipient, and id of a pending receipt expiration
+// index key.
+func ParsePendingReceiptExpirationKey(key []byte) (int64, sdk.AccAddress, uint64, error) {
+	pl := len(PendingReceiptExpirationPrefix)
+	if len(key) < pl+8+1+8 {
+		return 0, nil, 0, fmt.Errorf("cannot parse pending receipt expiration key: too short (%d bytes)", len(key))
+	}
+	height := binary.BigEndian.Uint64(key[pl : pl+8])
+	addrLen := int(key[pl+8])
+	if len(key) != pl+8+1+addrLen+8 {
+		return 0, nil, 0, fmt.Errorf("cannot parse pending receipt expiration key: has %d bytes, expected %d", len(key), pl+8+1+addrLen+8)
+	}
+	recipient := sdk.AccAddress(key[pl+9 : pl+9+addrLen])
+	id := binary.BigEndian.Uint64(key[pl+9+addrLen:])
+	return int64(height), recipient, id, nil //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
+
+// ContractSupplyCapKeyPrefix returns key [prefix][marker address] for the contract supply caps of a marker
+func ContractSupplyCapKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(ContractSupplyCapPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ContractSupplyCapKey returns key [prefix][marker address][contract address] for a contract's supply cap on a marker
+func ContractSupplyCapKey(markerAddr, contract sdk.AccAddress) []byte {
+	return append(ContractSupplyCapKeyPrefix(markerAddr), address.MustLengthPrefix(contract.Bytes())...)
+}
+
+// SwapOfferKey returns key [prefix][id] for a swap offer
+func SwapOfferKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(SwapOfferPrefix, id)
+}
+
+// SwapOfferExpirationKey returns key [prefix][expiration height][id] for the swap offer expiration index.
+func SwapOfferExpirationKey(height int64, id uint64) []byte {
+	if height < 0 {
+		panic(fmt.Errorf("negative expiration height %d not allowed", height))
+	}
+	rv := binary.BigEndian.AppendUint64(append([]byte{}, SwapOfferExpirationPrefix...), uint64(height))
+	return binary.BigEndian.AppendUint64(rv, id)
+}
+
+// ParseSwapOfferExpirationKey returns the expiration height and id of a swap offer expiration index key.
+func ParseSwapOfferExpirationKey(key []byte) (int64, uint64, error) {
+	pl := len(SwapOfferExpirationPrefix)
+	if len(key) != pl+16 {
+		return 0, 0, fmt.Errorf("cannot parse swap offer expiration key: has %d bytes, expected %d", len(key), pl+16)
+	}
+	height := binary.BigEndian.Uint64(key[pl : pl+8])
+	id := binary.BigEndian.Uint64(key[pl+8:])
+	return int64(height), id, nil //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
+
+// ReserveAttestationKeyPrefix returns key [prefix][marker address] for the reserve attestations of a marker
+func ReserveAttestationKeyPrefix(markerAddr sdk.AccAddress) []byte {
+	return append(ReserveAttestationPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ReserveAttestationKey returns key [prefix][marker address][attestor address] for an attestor's reserve attestation
+func ReserveAttestationKey(markerAddr, attestor sdk.AccAddress) []byte {
+	return append(ReserveAttestationKeyPrefix(markerAddr), address.MustLengthPrefix(attestor.Bytes())...)
+}
+
+// ReserveAttestationExpirationKey returns key [prefix][valid until][marker address][attestor address] for the reserve
+// attestation expiration index.
+func ReserveAttestationExpirationKey(validUntil time.Time, markerAddr, attestor sdk.AccAddress) []byte {
+	rv := append([]byte{}, ReserveAttestationExpirationPrefix...)
+	rv = append(rv, sdk.FormatTimeBytes(validUntil)...)
+	rv = append(rv, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return append(rv, address.MustLengthPrefix(attestor.Bytes())...)
+}
+
+// ParseReserveAttestationExpirationKey returns the valid until time, marker address, and attestor address of a reserve
+// attestation expiration index key.
+func ParseReserveAttestationExpirationKey(key []byte) (time.Time, sdk.AccAddress, sdk.AccAddress, error) {
+	pl := len(ReserveAttestationExpirationPrefix)
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) < pl+timeLen+1 {
+		return time.Time{}, nil, nil, fmt.Errorf("cannot parse reserve attestation expiration key: too short (%d bytes)", len(key))
+	}
+	validUntil, err := sdk.ParseTimeBytes(key[pl : pl+timeLen])
+	if err != nil {
+		return time.Time{}, nil, nil, fmt.Errorf("cannot parse reserve attestation expiration key: %w", err)
+	}
+	addrs := key[pl+timeLen:]
+	markerLen := int(addrs[0])
+	if len(addrs) < 1+markerLen+1 {
+		return time.Time{}, nil, nil, fmt.Errorf("cannot parse reserve attestation expiration key: too short (%d bytes)", len(key))
+	}
+	attestorLen := int(addrs[1+markerLen])
+	if len(addrs) != 2+markerLen+attestorLen {
+		return time.Time{}, nil, nil, fmt.Errorf("cannot parse reserve attestation expiration key: has %d bytes, expected %d",
+			len(key), pl+timeLen+2+markerLen+attestorLen)
+	}
+	markerAddr := sdk.AccAddress(addrs[1 : 1+markerLen])
+	attestor := sdk.AccAddress(addrs[2+markerLen:])
+	return validUntil, markerAddr, attestor, nil
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -71,3 +73,257 @@ func TestDenySendMarkerPrefix(t *testing.T) {
 	assert.Equal(t, uint8(3), denyKey[0], "should have correct prefix for send deny")
 	assert.Equal(t, denyKey[2:], addr.Bytes(), "should have marker address in iterable prefix")
 }
+
+func TestDenySendAddressKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	denyAddr := sdk.AccAddress("cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h")
+	key := DenySendAddressKey(denyAddr, addr)
+	assert.Equal(t, uint8(10), key[0], "should have correct prefix for deny send address key")
+	assert.Equal(t, DenySendAddressKeyPrefix(denyAddr), key[:len(denyAddr)+2], "should start with the deny address prefix")
+	dAddr, mAddr := GetDenySendAddressKeyAddresses(key)
+	assert.Equal(t, denyAddr, dAddr, "deny address")
+	assert.Equal(t, addr, mAddr, "module address")
+}
+
+func TestReqAttrBypassAddrKey(t *testing.T) {
+	addr := sdk.AccAddress("bypass_address______")
+	key := ReqAttrBypassAddrKey(addr)
+	assert.Equal(t, uint8(12), key[0], "should have correct prefix for req attr bypass addr key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, GetReqAttrBypassAddrFromKey(key), "GetReqAttrBypassAddrFromKey")
+}
+
+func TestCreationDepositKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := CreationDepositKey(addr)
+	assert.Equal(t, uint8(13), key[0], "should have correct prefix for creation deposit key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestAttributeRevocationConfigKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := AttributeRevocationConfigKey(addr)
+	assert.Equal(t, uint8(14), key[0], "should have correct prefix for attribute revocation config key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestPolicyChangeEffectiveHeightKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("testcoin")
+	key := PolicyChangeEffectiveHeightKey(5, markerAddr, 258)
+	assert.Equal(t, uint8(39), key[0], "should have correct prefix for policy change effective height key")
+	assert.Equal(t, len(markerAddr)+18, len(key), "key length")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 5}, key[1:9], "height in key")
+
+	height, parsedAddr, id, err := ParsePolicyChangeEffectiveHeightKey(key)
+	require.NoError(t, err, "ParsePolicyChangeEffectiveHeightKey")
+	assert.Equal(t, int64(5), height, "parsed height")
+	assert.Equal(t, markerAddr, parsedAddr, "parsed marker address")
+	assert.Equal(t, uint64(258), id, "parsed id")
+
+	_, _, _, err = ParsePolicyChangeEffectiveHeightKey(key[:len(key)-1])
+	assert.EqualError(t, err, "cannot parse policy change effective height key: has 37 bytes, expected 38", "ParsePolicyChangeEffectiveHeightKey short key")
+	assert.Panics(t, func() { PolicyChangeEffectiveHeightKey(-1, markerAddr, 1) }, "PolicyChangeEffectiveHeightKey negative height")
+}
+
+func TestReserveRequirementKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := ReserveRequirementKey(addr)
+	assert.Equal(t, uint8(20), key[0], "should have correct prefix for reserve requirement key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestReserveAttestationKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("testcoin")
+	attestor := sdk.AccAddress("attestor____________")
+	assert.Equal(t, uint8(21), ReserveAttestorsKey(markerAddr)[0], "should have correct prefix for reserve attestors key")
+	key := ReserveAttestationKey(markerAddr, attestor)
+	assert.Equal(t, uint8(22), key[0], "should have correct prefix for reserve attestation key")
+	assert.Equal(t, len(markerAddr)+len(attestor)+3, len(key), "key length")
+	assert.Equal(t, ReserveAttestationKeyPrefix(markerAddr), key[:len(markerAddr)+2], "key prefix")
+	assert.Equal(t, attestor, sdk.AccAddress(key[len(markerAddr)+3:]), "attestor address in key")
+}
+
+func TestReserveAttestationExpirationKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("testcoin")
+	attestor := sdk.AccAddress("attestor____________")
+	validUntil := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	key := ReserveAttestationExpirationKey(validUntil, markerAddr, attestor)
+	assert.Equal(t, uint8(38), key[0], "should have correct prefix for reserve attestation expiration key")
+	timeLen := len(sdk.FormatTimeBytes(validUntil))
+	assert.Equal(t, 1+timeLen+len(markerAddr)+len(attestor)+2, len(key), "key length")
+	assert.Less(t, string(key), string(ReserveAttestationExpirationKey(validUntil.Add(time.Second), markerAddr, attestor)), "key ordering by time")
+
+	parsedTime, parsedMarker, parsedAttestor, err := ParseReserveAttestationExpirationKey(key)
+	require.NoError(t, err, "ParseReserveAttestationExpirationKey")
+	assert.Equal(t, validUntil, parsedTime, "parsed valid until")
+	assert.Equal(t, markerAddr, parsedMarker, "parsed marker address")
+	assert.Equal(t, attestor, parsedAttestor, "parsed attestor")
+
+	_, _, _, err = ParseReserveAttestationExpirationKey(key[:len(key)-1])
+	assert.EqualError(t, err, fmt.Sprintf("cannot parse reserve attestation expiration key: has %d bytes, expected %d", len(key)-1, len(key)),
+		"ParseReserveAttestationExpirationKey short key")
+}
+
+func TestDustPolicyKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := DustPolicyKey(addr)
+	assert.Equal(t, uint8(23), key[0], "should have correct prefix for dust policy key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestIssuanceTrancheKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := IssuanceTrancheKey(addr, 3)
+	assert.Equal(t, uint8(26), key[0], "should have correct prefix for issuance tranche key")
+	assert.Equal(t, len(addr)+10, len(key), "key length")
+	assert.Equal(t, IssuanceTrancheKeyPrefix(addr), key[:len(addr)+2], "key prefix")
+}
+
+func TestSubLedgerKeys(t *testing.T) {
+	addr := sdk.AccAddress("custodian___________")
+	key := SubLedgerEntryKey(addr, 3)
+	assert.Equal(t, uint8(27), key[0], "should have correct prefix for sub-ledger entry key")
+	assert.Equal(t, len(addr)+10, len(key), "entry key length")
+	assert.Equal(t, SubLedgerEntryKeyPrefix(addr), key[:len(addr)+2], "entry key prefix")
+
+	indexKey := SubLedgerSubAccountIndexKey(addr, "client-1", 3)
+	assert.Equal(t, uint8(28), indexKey[0], "should have correct prefix for sub-ledger sub-account index key")
+	assert.Equal(t, len(addr)+len("client-1")+11, len(indexKey), "index key length")
+	assert.Equal(t, SubLedgerSubAccountIndexKeyPrefix(addr, "client-1"), indexKey[:len(indexKey)-8], "index key prefix")
+	assert.NotEqual(t, SubLedgerSubAccountIndexKeyPrefix(addr, "client-1"), SubLedgerSubAccountIndexKeyPrefix(addr, "client-10")[:len(indexKey)-8],
+		"sub-account prefixes should not overlap")
+}
+
+func TestBridgedSupplyKeys(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := BridgedSupplyKey(addr)
+	assert.Equal(t, uint8(29), key[0], "should have correct prefix for bridged supply key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+
+	reconKey := BridgedSupplyReconciliationKey(addr)
+	assert.Equal(t, uint8(30), reconKey[0], "should have correct prefix for bridged supply reconciliation key")
+	assert.Equal(t, addr, SplitMarkerStoreKey(reconKey), "address in reconciliation key")
+}
+
+func TestSupplyChangePolicyKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := SupplyChangePolicyKey(addr)
+	assert.Equal(t, uint8(31), key[0], "should have correct prefix for supply change policy key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestSupplyChangeUsageKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := SupplyChangeUsageKey(addr)
+	assert.Equal(t, uint8(40), key[0], "should have correct prefix for supply change usage key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestReceiptPolicyKey(t *testing.T) {
+	addr := MustGetMarkerAddress("testcoin")
+	key := ReceiptPolicyKey(addr)
+	assert.Equal(t, uint8(32), key[0], "should have correct prefix for receipt policy key")
+	assert.Equal(t, len(addr)+2, len(key), "key length")
+	assert.Equal(t, addr, SplitMarkerStoreKey(key), "address in key")
+}
+
+func TestPendingReceiptKey(t *testing.T) {
+	addr := sdk.AccAddress("recipient___________")
+	key := PendingReceiptKey(addr, 258)
+	assert.Equal(t, uint8(33), key[0], "should have correct prefix for pending receipt key")
+	assert.Equal(t, len(addr)+10, len(key), "key length")
+	assert.Equal(t, PendingReceiptKeyPrefix(addr), key[:len(addr)+2], "key prefix")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 2}, key[len(addr)+2:], "id in key")
+}
+
+func TestPendingReceiptExpirationKey(t *testing.T) {
+	addr := sdk.AccAddress("recipient___________")
+	key := PendingReceiptExpirationKey(5, addr, 258)
+	assert.Equal(t, uint8(36), key[0], "should have correct prefix for pending receipt expiration key")
+	assert.Equal(t, len(addr)+18, len(key), "key length")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 5}, key[1:9], "height in key")
+
+	height, recipient, id, err := ParsePendingReceiptExpirationKey(key)
+	require.NoError(t, err, "ParsePendingReceiptExpirationKey")
+	assert.Equal(t, int64(5), height, "parsed height")
+	assert.Equal(t, addr, recipient, "parsed recipient")
+	assert.Equal(t, uint64(258), id, "parsed id")
+
+	_, _, _, err = ParsePendingReceiptExpirationKey(key[:len(key)-1])
+	assert.EqualError(t, err, "cannot parse pending receipt expiration key: has 37 bytes, expected 38", "ParsePendingReceiptExpirationKey short key")
+	assert.Panics(t, func() { PendingReceiptExpirationKey(-1, addr, 1) }, "PendingReceiptExpirationKey negative height")
+}
+
+func TestContractSupplyCapKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("testcoin")
+	contract := sdk.AccAddress("contract____________")
+	key := ContractSupplyCapKey(markerAddr, contract)
+	assert.Equal(t, uint8(35), key[0], "should have correct prefix for contract supply cap key")
+	assert.Equal(t, len(markerAddr)+len(contract)+3, len(key), "key length")
+	assert.Equal(t, ContractSupplyCapKeyPrefix(markerAddr), key[:len(markerAddr)+2], "key prefix")
+	assert.Equal(t, markerAddr, SplitMarkerStoreKey(key), "marker address in key")
+	assert.Equal(t, contract, sdk.AccAddress(key[len(markerAddr)+3:]), "contract address in key")
+}
+
+func TestSwapOfferKey(t *testing.T) {
+	key := SwapOfferKey(258)
+	assert.Equal(t, uint8(24), key[0], "should have correct prefix for swap offer key")
+	assert.Equal(t, []byte{0x18, 0, 0, 0, 0, 0, 0, 1, 2}, key, "key")
+}
+
+func TestSwapOfferExpirationKey(t *testing.T) {
+	key := SwapOfferExpirationKey(5, 258)
+	assert.Equal(t, uint8(37), key[0], "should have correct prefix for swap offer expiration key")
+	assert.Equal(t, []byte{0x25, 0, 0, 0, 0, 0, 0, 0, 5, 0, 0, 0, 0, 0, 0, 1, 2}, key, "key")
+
+	height, id, err := ParseSwapOfferExpirationKey(key)
+	require.NoError(t, err, "ParseSwapOfferExpirationKey")
+	assert.Equal(t, int64(5), height, "parsed height")
+	assert.Equal(t, uint64(258), id, "parsed id")
+
+	_, _, err = ParseSwapOfferExpirationKey(key[:len(key)-1])
+	assert.EqualError(t, err, "cannot parse swap offer expiration key: has 16 bytes, expected 17", "ParseSwapOfferExpirationKey short key")
+	assert.Panics(t, func() { SwapOfferExpirationKey(-1, 1) }, "SwapOfferExpirationKey negative height")
+}
+
+func TestFrozenAccountKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("testcoin")
+	addr := sdk.AccAddress("frozen______________")
+	key := FrozenAccountKey(markerAddr, addr)
+	assert.Equal(t, uint8(15), key[0], "should have correct prefix for frozen account key")
+	assert.Equal(t, len(markerAddr)+len(addr)+3, len(key), "key length")
+	assert.Equal(t, FrozenAccountKeyPrefix(markerAddr), key[:len(markerAddr)+2], "key prefix")
+	assert.Equal(t, addr, sdk.AccAddress(key[len(markerAddr)+3:]), "account address in key")
+}
+
+func TestGetERC20ContractKeyParts(t *testing.T) {
+	key := ERC20ContractKey("evm-1", "0xABCdef")
+	assert.Equal(t, uint8(7), key[0], "should have correct prefix for ERC-20 contract key")
+	chainID, contract := GetERC20ContractKeyParts(key)
+	assert.Equal(t, "evm-1", chainID, "chain id")
+	assert.Equal(t, "0xabcdef", contract, "contract address")
+}
+
+func TestAccessGrantKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("testcoin")
+	grantee := sdk.AccAddress("grantee_____________")
+	key := AccessGrantKey(markerAddr, grantee)
+	assert.Equal(t, uint8(16), key[0], "should have correct prefix for access grant key")
+	assert.Equal(t, len(markerAddr)+len(grantee)+3, len(key), "key length")
+	assert.Equal(t, AccessGrantKeyPrefix(markerAddr), key[:len(markerAddr)+2], "key prefix")
+	assert.Equal(t, grantee, sdk.AccAddress(key[len(markerAddr)+3:]), "grantee address in key")
+}
+
+func TestMarkerDenomKey(t *testing.T) {
+	key := MarkerDenomKey("testcoin")
+	assert.Equal(t, uint8(17), key[0], "should have correct prefix for marker denom key")
+	assert.Equal(t, "testcoin", string(key[1:]), "denom in key")
+}
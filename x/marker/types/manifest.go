@@ -0,0 +1,150 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewAssetManifest creates the asset manifest that describes the provided marker.
+// The updated block height is cleared from each net asset value since it is specific to the chain they came from.
+func NewAssetManifest(marker MarkerAccountI, denomMetadata *banktypes.Metadata, navs []NetAssetValue) *AssetManifest {
+	rv := &AssetManifest{
+		Denom:                  marker.GetDenom(),
+		Supply:                 marker.GetSupply().Amount,
+		MarkerType:             marker.GetMarkerType(),
+		SupplyFixed:            marker.HasFixedSupply(),
+		AllowGovernanceControl: marker.HasGovernanceEnabled(),
+		AllowForcedTransfer:    marker.AllowsForcedTransfer(),
+		IssuerManagedFlags:     marker.HasIssuerManagedFlags(),
+		RequiredAttributes:     marker.GetRequiredAttributes(),
+		AccessGrants:           marker.GetAccessList(),
+		DenomMetadata:          denomMetadata,
+	}
+	if len(navs) > 0 {
+		rv.NetAssetValues = make([]NetAssetValue, len(navs))
+		for i, nav := range navs {
+			rv.NetAssetValues[i] = NewNetAssetValue(nav.Price, nav.Volume)
+		}
+	}
+	return rv
+}
+
+// Validate makes sure the asset manifest is internally consistent.
+func (m AssetManifest) Validate() error {
+	supply := sdk.Coin{Denom: m.Denom, Amount: m.Supply}
+	if m.Supply.IsNil() {
+		return errors.New("supply cannot be empty")
+	}
+	if err := supply.Validate(); err != nil {
+		return fmt.Errorf("invalid denom/supply: %w", err)
+	}
+	if m.MarkerType != MarkerType_Coin && m.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("invalid marker type: %s", m.MarkerType)
+	}
+	if m.AllowForcedTransfer && m.MarkerType != MarkerType_RestrictedCoin {
+		return errors.New("forced transfer is only available for restricted markers")
+	}
+	if len(m.RequiredAttributes) > 0 && m.MarkerType != MarkerType_RestrictedCoin {
+		return errors.New("required attributes are reserved for restricted markers")
+	}
+
+	if len(m.AccessGrants) == 0 {
+		return errors.New("access grants cannot be empty")
+	}
+	if err := ValidateGrants(m.AccessGrants...); err != nil {
+		return fmt.Errorf("invalid access grants: %w", err)
+	}
+	seenAddrs := make(map[string]bool)
+	for _, grant := range m.AccessGrants {
+		if seenAddrs[grant.Address] {
+			return fmt.Errorf("invalid access grants: %w: %s", ErrDuplicateAccessEntry, grant.Address)
+		}
+		seenAddrs[grant.Address] = true
+	}
+
+	if m.DenomMetadata != nil {
+		if m.DenomMetadata.Base != m.Denom {
+			return fmt.Errorf("denom metadata base %q does not match denom %q", m.DenomMetadata.Base, m.Denom)
+		}
+		if err := ValidateDenomMetadataBasic(*m.DenomMetadata); err != nil {
+			return fmt.Errorf("invalid denom metadata: %w", err)
+		}
+	}
+
+	seenDenoms := make(map[string]bool)
+	for i, nav := range m.NetAssetValues {
+		if err := nav.Validate(); err != nil {
+			return fmt.Errorf("invalid net_asset_values[%d]: %w", i, err)
+		}
+		if nav.UpdatedBlockHeight != 0 {
+			return fmt.Errorf("net_asset_values[%d] cannot have an updated block height", i)
+		}
+		if nav.Price.Denom == m.Denom {
+			return fmt.Errorf("net asset value price denom cannot be the marker denom %q", m.Denom)
+		}
+		if seenDenoms[nav.Price.Denom] {
+			return fmt.Errorf("net asset values contain duplicate price denom %q", nav.Price.Denom)
+		}
+		seenDenoms[nav.Price.Denom] = true
+	}
+
+	return nil
+}
+
+// Msgs validates the manifest and returns the msgs needed to create the asset it describes, all signed by the
+// provided authority. The authority is the marker's manager while it's being set up. The msgs are, in order:
+// AddMarker, SetIssuerManagedFlags (if needed), AddAccess (one for each access grant), SetDenomMetadata,
+// Finalize, Activate, and AddNetAssetValues.
+func (m AssetManifest) Msgs(authority sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	isGov := authority.Equals(authtypes.NewModuleAddress(govtypes.ModuleName))
+	if isGov && m.IssuerManagedFlags && !m.AllowGovernanceControl {
+		return nil, errors.New("issuer managed flags can only be set by governance when governance control is allowed")
+	}
+	if len(m.NetAssetValues) > 0 {
+		hasGrant := len(GrantsForAddress(authority, m.AccessGrants...).GetAccessList()) > 0
+		if !hasGrant && !(isGov && m.AllowGovernanceControl) {
+			return nil, fmt.Errorf("%s must be given an access grant in order to add net asset values", authority)
+		}
+	}
+
+	msgs := make([]sdk.Msg, 0, len(m.AccessGrants)+6)
+	msgs = append(msgs, NewMsgAddMarkerRequest(
+		m.Denom, m.Supply, authority, authority, m.MarkerType,
+		m.SupplyFixed, m.AllowGovernanceControl, m.AllowForcedTransfer, m.RequiredAttributes, 0, 0,
+	))
+	if m.IssuerManagedFlags {
+		msgs = append(msgs, NewMsgSetIssuerManagedFlagsRequest(m.Denom, true, authority))
+	}
+	for _, grant := range m.AccessGrants {
+		msgs = append(msgs, NewMsgAddAccessRequest(m.Denom, authority, grant))
+	}
+	if m.DenomMetadata != nil {
+		msgs = append(msgs, NewSetDenomMetadataRequest(*m.DenomMetadata, authority))
+	}
+	msgs = append(msgs,
+		NewMsgFinalizeRequest(m.Denom, authority),
+		NewMsgActivateRequest(m.Denom, authority),
+	)
+	if len(m.NetAssetValues) > 0 {
+		msgs = append(msgs, NewMsgAddNetAssetValuesRequest(m.Denom, authority.String(), m.NetAssetValues))
+	}
+
+	for i, msg := range msgs {
+		if vb, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := vb.ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("invalid msgs[%d] %s: %w", i, sdk.MsgTypeURL(msg), err)
+			}
+		}
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/marker/v1/manifest.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AssetManifest is the canonical description of how a marker is set up. It can be exported from one chain
+// and imported on another to create the same asset there (e.g. when promoting an asset from testnet to mainnet).
+type AssetManifest struct {
+	// denom is the marker's denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply is the marker's total supply.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// marker_type is the marker's type, either MARKER_TYPE_COIN or MARKER_TYPE_RESTRICTED.
+	MarkerType MarkerType `protobuf:"varint,3,opt,name=marker_type,json=markerType,proto3,enum=provenance.marker.v1.MarkerType" json:"marker_type,omitempty"`
+	// supply_fixed indicates that the supply is fixed.
+	SupplyFixed bool `protobuf:"varint,4,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
+	// allow_governance_control indicates that governance control is allowed.
+	AllowGovernanceControl bool `protobuf:"varint,5,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// allow_forced_transfer indicates that forced transfers are allowed. Only applicable to restricted markers.
+	AllowForcedTransfer bool `protobuf:"varint,6,opt,name=allow_forced_transfer,json=allowForcedTransfer,proto3" json:"allow_forced_transfer,omitempty"`
+	// issuer_managed_flags indicates that the marker's flags are managed by its issuer instead of governance.
+	IssuerManagedFlags bool `protobuf:"varint,7,opt,name=issuer_managed_flags,json=issuerManagedFlags,proto3" json:"issuer_managed_flags,omitempty"`
+	// required_attributes are the attributes needed for a restricted marker to have send authority.
+	RequiredAttributes []string `protobuf:"bytes,8,rep,name=required_attributes,json=requiredAttributes,proto3" json:"required_attributes,omitempty"`
+	// access_grants are the access grants on the marker.
+	AccessGrants []AccessGrant `protobuf:"bytes,9,rep,name=access_grants,json=accessGrants,proto3" json:"access_grants"`
+	// denom_metadata is the bank metadata for the marker's denom, if it has any.
+	DenomMetadata *types.Metadata `protobuf:"bytes,10,opt,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata,omitempty"`
+	// net_asset_values are the marker's net asset values. They do not have an updated_block_height.
+	NetAssetValues []NetAssetValue `protobuf:"bytes,11,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+}
+
+func (m *AssetManifest) Reset()         { *m = AssetManifest{} }
+func (m *AssetManifest) String() string { return proto.CompactTextString(m) }
+func (*AssetManifest) ProtoMessage()    {}
+func (*AssetManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10d4c9005fd687, []int{0}
+}
+func (m *AssetManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetManifest.Merge(m, src)
+}
+func (m *AssetManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetManifest proto.InternalMessageInfo
+
+func (m *AssetManifest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetManifest) GetMarkerType() MarkerType {
+	if m != nil {
+		return m.MarkerType
+	}
+	return MarkerType_Unknown
+}
+
+func (m *AssetManifest) GetSupplyFixed() bool {
+	if m != nil {
+		return m.SupplyFixed
+	}
+	return false
+}
+
+func (m *AssetManifest) GetAllowGovernanceControl() bool {
+	if m != nil {
+		return m.AllowGovernanceControl
+	}
+	return false
+}
+
+func (m *AssetManifest) GetAllowForcedTransfer() bool {
+	if m != nil {
+		return m.AllowForcedTransfer
+	}
+	return false
+}
+
+func (m *AssetManifest) GetIssuerManagedFlags() bool {
+	if m != nil {
+		return m.IssuerManagedFlags
+	}
+	return false
+}
+
+func (m *AssetManifest) GetRequiredAttributes() []string {
+	if m != nil {
+		return m.RequiredAttributes
+	}
+	return nil
+}
+
+func (m *AssetManifest) GetAccessGrants() []AccessGrant {
+	if m != nil {
+		return m.AccessGrants
+	}
+	return nil
+}
+
+func (m *AssetManifest) GetDenomMetadata() *types.Metadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return nil
+}
+
+func (m *AssetManifest) GetNetAssetValues() []NetAssetValue {
+	if m != nil {
+		return m.NetAssetValues
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AssetManifest)(nil), "provenance.marker.v1.AssetManifest")
+}
+
+func init() {
+	proto.RegisterFile("provenance/marker/v1/manifest.proto", fileDescriptor_8d10d4c9005fd687)
+}
+
+var fileDescriptor_8d10d4c9005fd687 = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xdf, 0xfe, 0xfc, 0x36, 0x77, 0x9b, 0x90, 0xd7, 0x81, 0x99, 0x44, 0x96, 0x32,
+	0x09, 0x45, 0x42, 0x4b, 0x68, 0xb9, 0x70, 0x6d, 0x87, 0x3a, 0x4d, 0xa2, 0x08, 0x85, 0x89, 0x03,
+	0x17, 0xcb, 0x4d, 0xdc, 0x2c, 0x6a, 0x62, 0x07, 0xdb, 0x09, 0xeb, 0x5b, 0xe0, 0xc4, 0x8b, 0xe1,
+	0x45, 0xec, 0x38, 0x71, 0x42, 0x1c, 0x26, 0xd4, 0xbe, 0x11, 0x14, 0x3b, 0x6d, 0x77, 0x28, 0x37,
+	0x3f, 0xcf, 0xe7, 0xfb, 0x38, 0xdf, 0xf8, 0xf9, 0x82, 0xd3, 0x5c, 0xf0, 0x92, 0x32, 0xc2, 0x42,
+	0xea, 0x67, 0x44, 0x4c, 0xa8, 0xf0, 0xcb, 0x8e, 0x9f, 0x11, 0x96, 0x8c, 0xa9, 0x54, 0x5e, 0x2e,
+	0xb8, 0xe2, 0xb0, 0xb5, 0x12, 0x79, 0x46, 0xe4, 0x95, 0x9d, 0xe3, 0x56, 0xcc, 0x63, 0xae, 0x05,
+	0x7e, 0x75, 0x32, 0xda, 0xe3, 0xa7, 0x21, 0x97, 0x19, 0x97, 0xd8, 0x00, 0x53, 0xd4, 0xc8, 0x36,
+	0x95, 0x3f, 0x22, 0x6c, 0xe2, 0x97, 0x9d, 0x11, 0x55, 0xa4, 0xa3, 0x8b, 0x9a, 0xbf, 0x58, 0xeb,
+	0x85, 0x84, 0x21, 0x95, 0x32, 0x16, 0x84, 0xd5, 0x76, 0x8e, 0xdb, 0xff, 0xf0, 0xac, 0x8d, 0x69,
+	0xc9, 0xf3, 0x6f, 0x5b, 0x60, 0xbf, 0x27, 0x25, 0x55, 0xc3, 0xfa, 0x4f, 0x60, 0x0b, 0x6c, 0x45,
+	0x94, 0xf1, 0x0c, 0x59, 0x8e, 0xe5, 0xee, 0x06, 0xa6, 0x80, 0xe7, 0x60, 0x5b, 0x16, 0x79, 0x9e,
+	0x4e, 0xd1, 0x7f, 0x55, 0xbb, 0xff, 0xf2, 0xf6, 0xfe, 0xa4, 0xf1, 0xfb, 0xfe, 0xe4, 0xc8, 0x58,
+	0x95, 0xd1, 0xc4, 0x4b, 0xb8, 0x9f, 0x11, 0x75, 0xed, 0x5d, 0x32, 0xf5, 0xf3, 0xc7, 0x19, 0xa8,
+	0xff, 0xe8, 0x92, 0xa9, 0xa0, 0x1e, 0x85, 0x3d, 0xd0, 0x34, 0x1f, 0xc7, 0x6a, 0x9a, 0x53, 0xb4,
+	0xe1, 0x58, 0xee, 0x41, 0xd7, 0xf1, 0xd6, 0x3d, 0x9a, 0x37, 0xd4, 0xa7, 0xab, 0x69, 0x4e, 0x03,
+	0x90, 0x2d, 0xcf, 0xb0, 0x0d, 0xf6, 0xcc, 0x65, 0x78, 0x9c, 0xdc, 0xd0, 0x08, 0x6d, 0x3a, 0x96,
+	0xbb, 0x13, 0x34, 0x4d, 0x6f, 0x50, 0xb5, 0xe0, 0x1b, 0x80, 0x48, 0x9a, 0xf2, 0xaf, 0x38, 0xe6,
+	0x25, 0x15, 0xfa, 0x5e, 0x1c, 0x72, 0xa6, 0x04, 0x4f, 0xd1, 0x96, 0x96, 0x3f, 0xd6, 0xfc, 0x62,
+	0x89, 0xcf, 0x0d, 0x85, 0x5d, 0x70, 0x64, 0x26, 0xc7, 0x5c, 0x84, 0x34, 0xc2, 0x4a, 0x10, 0x26,
+	0xc7, 0x54, 0xa0, 0x6d, 0x3d, 0x76, 0xa8, 0xe1, 0x40, 0xb3, 0xab, 0x1a, 0xc1, 0x57, 0xa0, 0x95,
+	0x48, 0x59, 0x50, 0x81, 0x33, 0xc2, 0x48, 0x4c, 0x23, 0x3c, 0x4e, 0x49, 0x2c, 0xd1, 0xff, 0x7a,
+	0x04, 0x1a, 0x36, 0x34, 0x68, 0x50, 0x11, 0xe8, 0x83, 0x43, 0x41, 0xbf, 0x14, 0x89, 0xa0, 0x11,
+	0x26, 0x4a, 0x89, 0x64, 0x54, 0x28, 0x2a, 0xd1, 0x8e, 0xb3, 0xe1, 0xee, 0x06, 0x70, 0x81, 0x7a,
+	0x4b, 0x02, 0xdf, 0x81, 0x7d, 0xb3, 0x5b, 0xac, 0x97, 0x2b, 0xd1, 0xae, 0xb3, 0xe1, 0x36, 0xbb,
+	0xed, 0xf5, 0x0f, 0xd7, 0xd3, 0xd2, 0x8b, 0x4a, 0xd9, 0xdf, 0xac, 0xb6, 0x14, 0xec, 0x91, 0x55,
+	0x4b, 0xc2, 0xb7, 0xe0, 0x40, 0xaf, 0x14, 0x67, 0x54, 0x91, 0x88, 0x28, 0x82, 0x80, 0x63, 0xb9,
+	0xcd, 0xee, 0x33, 0xaf, 0xde, 0x98, 0x0e, 0x5a, 0x9d, 0x3a, 0x6f, 0x58, 0x8b, 0x82, 0x7d, 0x3d,
+	0xb4, 0x28, 0xe1, 0x47, 0xf0, 0x88, 0x51, 0x85, 0x49, 0x15, 0x1d, 0x5c, 0x92, 0xb4, 0xa0, 0x12,
+	0x35, 0xb5, 0xad, 0xd3, 0xf5, 0xb6, 0xde, 0x53, 0xa5, 0x73, 0xf6, 0xa9, 0xd2, 0xd6, 0xc6, 0x0e,
+	0xd8, 0xc3, 0xa6, 0xec, 0xc7, 0xb7, 0x33, 0xdb, 0xba, 0x9b, 0xd9, 0xd6, 0x9f, 0x99, 0x6d, 0x7d,
+	0x9f, 0xdb, 0x8d, 0xbb, 0xb9, 0xdd, 0xf8, 0x35, 0xb7, 0x1b, 0xe0, 0x49, 0xc2, 0xd7, 0x5e, 0xfb,
+	0xc1, 0xfa, 0xdc, 0x8d, 0x13, 0x75, 0x5d, 0x8c, 0xbc, 0x90, 0x67, 0xfe, 0x4a, 0x72, 0x96, 0xf0,
+	0x07, 0x95, 0x7f, 0xb3, 0xc8, 0x7f, 0x15, 0x3c, 0x39, 0xda, 0xd6, 0xe1, 0x7f, 0xfd, 0x77, 0x00,
+	0x2f, 0xb5, 0x13, 0x50, 0xd5, 0x03, 0x00, 0x00,
+}
+
+func (m *AssetManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetAssetValues) > 0 {
+		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintManifest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.DenomMetadata != nil {
+		{
+			size, err := m.DenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManifest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AccessGrants) > 0 {
+		for iNdEx := len(m.AccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintManifest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for iNdEx := len(m.RequiredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttributes[iNdEx])
+			copy(dAtA[i:], m.RequiredAttributes[iNdEx])
+			i = encodeVarintManifest(dAtA, i, uint64(len(m.RequiredAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.IssuerManagedFlags {
+		i--
+		if m.IssuerManagedFlags {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AllowForcedTransfer {
+		i--
+		if m.AllowForcedTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AllowGovernanceControl {
+		i--
+		if m.AllowGovernanceControl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SupplyFixed {
+		i--
+		if m.SupplyFixed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarkerType != 0 {
+		i = encodeVarintManifest(dAtA, i, uint64(m.MarkerType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintManifest(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintManifest(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintManifest(dAtA []byte, offset int, v uint64) int {
+	offset -= sovManifest(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AssetManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovManifest(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovManifest(uint64(l))
+	if m.MarkerType != 0 {
+		n += 1 + sovManifest(uint64(m.MarkerType))
+	}
+	if m.SupplyFixed {
+		n += 2
+	}
+	if m.AllowGovernanceControl {
+		n += 2
+	}
+	if m.AllowForcedTransfer {
+		n += 2
+	}
+	if m.IssuerManagedFlags {
+		n += 2
+	}
+	if len(m.RequiredAttributes) > 0 {
+		for _, s := range m.RequiredAttributes {
+			l = len(s)
+			n += 1 + l + sovManifest(uint64(l))
+		}
+	}
+	if len(m.AccessGrants) > 0 {
+		for _, e := range m.AccessGrants {
+			l = e.Size()
+			n += 1 + l + sovManifest(uint64(l))
+		}
+	}
+	if m.DenomMetadata != nil {
+		l = m.DenomMetadata.Size()
+		n += 1 + l + sovManifest(uint64(l))
+	}
+	if len(m.NetAssetValues) > 0 {
+		for _, e := range m.NetAssetValues {
+			l = e.Size()
+			n += 1 + l + sovManifest(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovManifest(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozManifest(x uint64) (n int) {
+	return sovManifest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AssetManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManifest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManifest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManifest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerType", wireType)
+			}
+			m.MarkerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarkerType |= MarkerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyFixed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SupplyFixed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowGovernanceControl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowGovernanceControl = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForcedTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowForcedTransfer = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerManagedFlags", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IssuerManagedFlags = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManifest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAttributes = append(m.RequiredAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManifest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessGrants = append(m.AccessGrants, AccessGrant{})
+			if err := m.AccessGrants[len(m.AccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManifest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomMetadata == nil {
+				m.DenomMetadata = &types.Metadata{}
+			}
+			if err := m.DenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManifest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValues = append(m.NetAssetValues, NetAssetValue{})
+			if err := m.NetAssetValues[len(m.NetAssetValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManifest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManifest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipManifest(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowManifest
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowManifest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthManifest
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupManifest
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthManifest
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthManifest        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowManifest          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupManifest = fmt.Errorf("proto: unexpected end of group")
+)
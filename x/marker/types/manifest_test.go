@@ -0,0 +1,234 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+// newTestManifest returns a valid asset manifest for the hotdog denom with the addr having admin and mint access.
+func newTestManifest(addr sdk.AccAddress) AssetManifest {
+	return AssetManifest{
+		Denom:              "hotdog",
+		Supply:             sdkmath.NewInt(1000),
+		MarkerType:         MarkerType_RestrictedCoin,
+		RequiredAttributes: []string{"kyc.provenance.io"},
+		AccessGrants:       []AccessGrant{*NewAccessGrant(addr, AccessList{Access_Admin, Access_Mint})},
+		DenomMetadata: &banktypes.Metadata{
+			Description: "A coin for hotdogs.",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "hotdog", Exponent: 0},
+				{Denom: "kilohotdog", Exponent: 3},
+			},
+			Base:    "hotdog",
+			Display: "kilohotdog",
+			Name:    "Hotdog",
+			Symbol:  "HOTDOG",
+		},
+		NetAssetValues: []NetAssetValue{NewNetAssetValue(sdk.NewInt64Coin("usd", 1000), 1)},
+	}
+}
+
+func TestNewAssetManifest(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	marker := NewMarkerAccount(
+		authtypes.NewBaseAccountWithAddress(MustGetMarkerAddress("hotdog")),
+		sdk.NewInt64Coin("hotdog", 1000), addr,
+		[]AccessGrant{*NewAccessGrant(addr, AccessList{Access_Admin, Access_Mint})},
+		StatusActive, MarkerType_RestrictedCoin, true, true, false, []string{"kyc.provenance.io"},
+	)
+	marker.SetIssuerManagedFlags(true)
+	metadata := &banktypes.Metadata{Base: "hotdog"}
+	navs := []NetAssetValue{{Price: sdk.NewInt64Coin("usd", 1000), Volume: 1, UpdatedBlockHeight: 5}}
+
+	expected := &AssetManifest{
+		Denom:                  "hotdog",
+		Supply:                 sdkmath.NewInt(1000),
+		MarkerType:             MarkerType_RestrictedCoin,
+		SupplyFixed:            true,
+		AllowGovernanceControl: true,
+		IssuerManagedFlags:     true,
+		RequiredAttributes:     []string{"kyc.provenance.io"},
+		AccessGrants:           []AccessGrant{*NewAccessGrant(addr, AccessList{Access_Admin, Access_Mint})},
+		DenomMetadata:          metadata,
+		NetAssetValues:         []NetAssetValue{{Price: sdk.NewInt64Coin("usd", 1000), Volume: 1}},
+	}
+
+	actual := NewAssetManifest(marker, metadata, navs)
+	assert.Equal(t, expected, actual, "NewAssetManifest")
+	assert.Equal(t, uint64(5), navs[0].UpdatedBlockHeight, "provided nav updated block height")
+}
+
+func TestAssetManifestValidate(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	tests := []struct {
+		name     string
+		modifier func(m *AssetManifest)
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			modifier: func(_ *AssetManifest) {},
+		},
+		{
+			name:     "no supply",
+			modifier: func(m *AssetManifest) { m.Supply = sdkmath.Int{} },
+			expErr:   "supply cannot be empty",
+		},
+		{
+			name:     "invalid denom",
+			modifier: func(m *AssetManifest) { m.Denom = "x" },
+			expErr:   "invalid denom/supply: invalid denom: x",
+		},
+		{
+			name:     "negative supply",
+			modifier: func(m *AssetManifest) { m.Supply = sdkmath.NewInt(-1) },
+			expErr:   "invalid denom/supply: negative coin amount: -1",
+		},
+		{
+			name:     "unknown marker type",
+			modifier: func(m *AssetManifest) { m.MarkerType = MarkerType_Unknown },
+			expErr:   "invalid marker type: MARKER_TYPE_UNSPECIFIED",
+		},
+		{
+			name: "forced transfer on coin marker",
+			modifier: func(m *AssetManifest) {
+				m.MarkerType = MarkerType_Coin
+				m.RequiredAttributes = nil
+				m.AllowForcedTransfer = true
+			},
+			expErr: "forced transfer is only available for restricted markers",
+		},
+		{
+			name:     "required attributes on coin marker",
+			modifier: func(m *AssetManifest) { m.MarkerType = MarkerType_Coin },
+			expErr:   "required attributes are reserved for restricted markers",
+		},
+		{
+			name:     "no access grants",
+			modifier: func(m *AssetManifest) { m.AccessGrants = nil },
+			expErr:   "access grants cannot be empty",
+		},
+		{
+			name:     "invalid access grant",
+			modifier: func(m *AssetManifest) { m.AccessGrants[0].Permissions = AccessList{Access_Unknown} },
+			expErr:   "invalid access grants: " + ErrAccessTypeInvalid.Error(),
+		},
+		{
+			name: "duplicate access grant",
+			modifier: func(m *AssetManifest) {
+				m.AccessGrants = append(m.AccessGrants, *NewAccessGrant(addr, AccessList{Access_Burn}))
+			},
+			expErr: "invalid access grants: " + ErrDuplicateAccessEntry.Error() + ": " + addr.String(),
+		},
+		{
+			name:     "denom metadata for another denom",
+			modifier: func(m *AssetManifest) { m.DenomMetadata.Base = "hamburger" },
+			expErr:   "denom metadata base \"hamburger\" does not match denom \"hotdog\"",
+		},
+		{
+			name:     "invalid denom metadata",
+			modifier: func(m *AssetManifest) { m.DenomMetadata.Name = "" },
+			expErr:   "invalid denom metadata: denom metadata name field cannot be blank",
+		},
+		{
+			name:     "invalid net asset value",
+			modifier: func(m *AssetManifest) { m.NetAssetValues[0].Volume = 0 },
+			expErr:   "invalid net_asset_values[0]: marker net asset value volume must be positive value",
+		},
+		{
+			name:     "net asset value with updated block height",
+			modifier: func(m *AssetManifest) { m.NetAssetValues[0].UpdatedBlockHeight = 3 },
+			expErr:   "net_asset_values[0] cannot have an updated block height",
+		},
+		{
+			name:     "net asset value in marker denom",
+			modifier: func(m *AssetManifest) { m.NetAssetValues[0].Price.Denom = "hotdog" },
+			expErr:   "net asset value price denom cannot be the marker denom \"hotdog\"",
+		},
+		{
+			name: "duplicate net asset value denom",
+			modifier: func(m *AssetManifest) {
+				m.NetAssetValues = append(m.NetAssetValues, NewNetAssetValue(sdk.NewInt64Coin("usd", 5), 2))
+			},
+			expErr: "net asset values contain duplicate price denom \"usd\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := newTestManifest(addr)
+			tc.modifier(&manifest)
+			err := manifest.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestAssetManifestMsgs(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	other := sdk.AccAddress("other_______________")
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	t.Run("invalid manifest", func(t *testing.T) {
+		manifest := newTestManifest(addr)
+		manifest.Denom = "x"
+		_, err := manifest.Msgs(addr)
+		assert.EqualError(t, err, "invalid denom/supply: invalid denom: x", "Msgs")
+	})
+
+	t.Run("navs without a grant", func(t *testing.T) {
+		manifest := newTestManifest(addr)
+		_, err := manifest.Msgs(other)
+		assert.EqualError(t, err, other.String()+" must be given an access grant in order to add net asset values", "Msgs")
+	})
+
+	t.Run("gov issuer managed flags without governance control", func(t *testing.T) {
+		manifest := newTestManifest(addr)
+		manifest.NetAssetValues = nil
+		manifest.IssuerManagedFlags = true
+		_, err := manifest.Msgs(gov)
+		assert.EqualError(t, err, "issuer managed flags can only be set by governance when governance control is allowed", "Msgs")
+	})
+
+	t.Run("gov with governance control", func(t *testing.T) {
+		manifest := newTestManifest(addr)
+		manifest.AllowGovernanceControl = true
+		msgs, err := manifest.Msgs(gov)
+		require.NoError(t, err, "Msgs")
+		require.Len(t, msgs, 6, "Msgs")
+		assert.Equal(t, gov.String(), msgs[0].(*MsgAddMarkerRequest).Manager, "add marker manager")
+		assert.IsType(t, &MsgAddNetAssetValuesRequest{}, msgs[5], "last msg")
+	})
+
+	t.Run("all msgs", func(t *testing.T) {
+		manifest := newTestManifest(addr)
+		manifest.SupplyFixed = true
+		manifest.AllowForcedTransfer = true
+		manifest.IssuerManagedFlags = true
+		msgs, err := manifest.Msgs(addr)
+		require.NoError(t, err, "Msgs")
+
+		expected := []sdk.Msg{
+			NewMsgAddMarkerRequest("hotdog", sdkmath.NewInt(1000), addr, addr, MarkerType_RestrictedCoin,
+				true, false, true, []string{"kyc.provenance.io"}, 0, 0),
+			NewMsgSetIssuerManagedFlagsRequest("hotdog", true, addr),
+			NewMsgAddAccessRequest("hotdog", addr, manifest.AccessGrants[0]),
+			NewSetDenomMetadataRequest(*manifest.DenomMetadata, addr),
+			NewMsgFinalizeRequest("hotdog", addr),
+			NewMsgActivateRequest("hotdog", addr),
+			NewMsgAddNetAssetValuesRequest("hotdog", addr.String(), manifest.NetAssetValues),
+		}
+		assert.Equal(t, expected, msgs, "Msgs")
+	})
+}
@@ -51,10 +51,14 @@ type MarkerAccountI interface {
 	AddressListForPermission(Access) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
+	SetAllowGovernanceControl(bool)
 
 	AllowsForcedTransfer() bool
 	SetAllowForcedTransfer(bool)
 
+	HasIssuerManagedFlags() bool
+	SetIssuerManagedFlags(bool)
+
 	GetRequiredAttributes() []string
 	SetRequiredAttributes([]string)
 }
@@ -130,6 +134,19 @@ func (ma *MarkerAccount) SetAllowForcedTransfer(allowForcedTransfer bool) {
 	ma.AllowForcedTransfer = allowForcedTransfer
 }
 
+func (ma *MarkerAccount) SetAllowGovernanceControl(allowGovernanceControl bool) {
+	ma.AllowGovernanceControl = allowGovernanceControl
+}
+
+// HasIssuerManagedFlags returns true if this marker's admins can update its flags without governance.
+func (ma MarkerAccount) HasIssuerManagedFlags() bool {
+	return ma.IssuerManagedFlags
+}
+
+func (ma *MarkerAccount) SetIssuerManagedFlags(issuerManagedFlags bool) {
+	ma.IssuerManagedFlags = issuerManagedFlags
+}
+
 // HasAccess returns true if the provided address has been assigned the provided
 // role within the current MarkerAccount AccessControl
 func (ma *MarkerAccount) HasAccess(addr string, role Access) bool {
@@ -207,11 +224,19 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Supply.IsNegative() {
 		return fmt.Errorf("total supply must be greater than or equal to zero")
 	}
-	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
-		return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
-	}
-	if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
-		return fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more")
+	// When the access list is kept in the marker store (e.g. the auth module's copy of the marker), the checks that
+	// depend on it can't be done here. The marker module does them whenever it saves the marker with its full list.
+	if ma.AccessGrantsStored {
+		if len(ma.AccessControl) > 0 {
+			return fmt.Errorf("access control must be empty when access grants are stored separately")
+		}
+	} else {
+		if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
+			return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
+		}
+		if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
+			return fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more")
+		}
 	}
 	// unlikely as this is set using a Coin which prohibits this value.
 	if strings.TrimSpace(ma.Denom) == "" {
@@ -266,18 +291,9 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 	for _, grant := range grants {
 		for _, access := range grant.Permissions {
 			switch markerType {
-			case MarkerType_Coin:
-				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw) {
-						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
-					}
-				}
-			// Restricted Coins also support Transfer access
-			case MarkerType_RestrictedCoin:
-				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer) {
-						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
-					}
+			case MarkerType_Coin, MarkerType_RestrictedCoin:
+				if !markerType.SupportsAccess(access) {
+					return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 				}
 			default:
 				return fmt.Errorf("cannot validate access grants for unsupported marker type %s", markerType.String())
@@ -287,6 +303,18 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 	return ValidateGrants(grants...)
 }
 
+// SupportsAccess returns true if the provided access can be granted on markers of this type.
+func (mt MarkerType) SupportsAccess(access Access) bool {
+	switch mt {
+	case MarkerType_Coin:
+		return access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw)
+	// Restricted Coins also support Transfer access
+	case MarkerType_RestrictedCoin:
+		return access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer)
+	}
+	return false
+}
+
 // ValidateRequiredAttributes checks that required attributes are of the correct format
 func ValidateRequiredAttributes(requiredAttributes []string) error {
 	for _, attr := range requiredAttributes {
@@ -401,7 +429,7 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	// Find any existing permissions and append specified permissions
 	for _, ac := range ma.AccessControl {
 		if ac.GetAddress().Equals(access.GetAddress()) {
-			if err := access.MergeAdd(*NewAccessGrant(ac.GetAddress(), ac.GetAccessList())); err != nil {
+			if err := access.MergeAdd(ac); err != nil {
 				return err
 			}
 		}
@@ -411,7 +439,9 @@ func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 		return err
 	}
 	// Append the new record
-	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	grant := NewAccessGrant(access.GetAddress(), access.GetAccessList())
+	grant.Roles = access.GetRoles()
+	ma.AccessControl = append(ma.AccessControl, *grant)
 	return nil
 }
 
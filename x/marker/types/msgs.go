@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	feegranttypes "cosmossdk.io/x/feegrant"
@@ -10,6 +12,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -46,6 +49,36 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgExecuteAsParentRequest)(nil),
+	(*MsgSetERC20PointerRequest)(nil),
+	(*MsgRemoveERC20PointerRequest)(nil),
+	(*MsgSetBridgeRequest)(nil),
+	(*MsgBridgeMintRequest)(nil),
+	(*MsgBridgeBurnRequest)(nil),
+	(*MsgSetIssuerManagedFlagsRequest)(nil),
+	(*MsgUpdateMarkerFlagsRequest)(nil),
+	(*MsgProposeAdminRequest)(nil),
+	(*MsgAcceptAdminRequest)(nil),
+	(*MsgCancelAdminProposalRequest)(nil),
+	(*MsgUpdateReqAttrBypassAddrsRequest)(nil),
+	(*MsgSetAttributeRevocationActionRequest)(nil),
+	(*MsgSetDustPolicyRequest)(nil),
+	(*MsgSchedulePolicyChangeRequest)(nil),
+	(*MsgCancelPolicyChangeRequest)(nil),
+	(*MsgSetReserveRequirementRequest)(nil),
+	(*MsgSetReserveAttestorsRequest)(nil),
+	(*MsgAttestReservesRequest)(nil),
+	(*MsgSwapRequest)(nil),
+	(*MsgCreateSwapOfferRequest)(nil),
+	(*MsgAcceptSwapOfferRequest)(nil),
+	(*MsgCancelSwapOfferRequest)(nil),
+	(*MsgSetBridgedSupplyRequest)(nil),
+	(*MsgSetSupplyChangePolicyRequest)(nil),
+	(*MsgSetReceiptPolicyRequest)(nil),
+	(*MsgAcknowledgeReceiptRequest)(nil),
+	(*MsgDeclineReceiptRequest)(nil),
+	(*MsgSetContractSupplyCapRequest)(nil),
+	(*MsgSeizeEscrowProposalRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -103,7 +136,10 @@ func (msg MsgMintRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
 		return err
 	}
-	return msg.Amount.Validate()
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return ValidateTranche(msg.Amount.Denom, msg.TrancheLabel, msg.TranchePrice)
 }
 
 func NewMsgBurnRequest(admin sdk.AccAddress, amount sdk.Coin) *MsgBurnRequest {
@@ -265,7 +301,13 @@ func (msg MsgTransferRequest) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
 		return err
 	}
-	return msg.Amount.Validate()
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	if msg.SubAccountTag != nil {
+		return msg.SubAccountTag.Validate()
+	}
+	return nil
 }
 
 func NewMsgIbcTransferRequest(
@@ -728,6 +770,9 @@ func NewMsgUpdateParamsRequest(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	creationDeposit sdkmath.Int,
+	creationDepositTimeoutBlocks int64,
+	roleTemplates []RoleTemplate,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -736,6 +781,9 @@ func NewMsgUpdateParamsRequest(
 			enableGovernance,
 			unrestrictedDenomRegex,
 			maxSupply,
+			creationDeposit,
+			creationDepositTimeoutBlocks,
+			roleTemplates,
 		),
 	}
 }
@@ -747,3 +795,713 @@ func (msg MsgUpdateParamsRequest) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	return err
 }
+
+// executeAsParentAllowedMsgs are the msgs that can be executed as a parent marker's account.
+// These are the msgs used to administer a child marker. Msgs that create markers, grant fee allowances,
+// are meant for governance, or execute other msgs are intentionally left out.
+var executeAsParentAllowedMsgs = []sdk.Msg{
+	(*MsgFinalizeRequest)(nil),
+	(*MsgActivateRequest)(nil),
+	(*MsgCancelRequest)(nil),
+	(*MsgDeleteRequest)(nil),
+	(*MsgAddAccessRequest)(nil),
+	(*MsgDeleteAccessRequest)(nil),
+	(*MsgMintRequest)(nil),
+	(*MsgBurnRequest)(nil),
+	(*MsgWithdrawRequest)(nil),
+	(*MsgTransferRequest)(nil),
+	(*MsgSetDenomMetadataRequest)(nil),
+	(*MsgUpdateRequiredAttributesRequest)(nil),
+	(*MsgUpdateForcedTransferRequest)(nil),
+	(*MsgSetAccountDataRequest)(nil),
+	(*MsgUpdateSendDenyListRequest)(nil),
+	(*MsgAddNetAssetValuesRequest)(nil),
+}
+
+// IsExecuteAsParentAllowed returns true if the msg with the provided type url can be executed as a parent marker's account.
+func IsExecuteAsParentAllowed(typeURL string) bool {
+	for _, msg := range executeAsParentAllowedMsgs {
+		if sdk.MsgTypeURL(msg) == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+func NewMsgExecuteAsParentRequest(parentDenom string, admin sdk.AccAddress, msgs []sdk.Msg) (*MsgExecuteAsParentRequest, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExecuteAsParentRequest{
+		ParentDenom:   parentDenom,
+		Administrator: admin.String(),
+		Msgs:          anys,
+	}, nil
+}
+
+func (msg MsgExecuteAsParentRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.ParentDenom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid administrator: %v", err)
+	}
+	if len(msg.Msgs) == 0 {
+		return errors.New("at least one msg is required")
+	}
+	for i, m := range msg.Msgs {
+		if m == nil {
+			return fmt.Errorf("msgs[%d] cannot be nil", i)
+		}
+		if !IsExecuteAsParentAllowed(m.TypeUrl) {
+			return fmt.Errorf("msgs[%d] %q cannot be executed as a parent marker", i, m.TypeUrl)
+		}
+	}
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		if vb, ok := m.(sdk.HasValidateBasic); ok {
+			if err = vb.ValidateBasic(); err != nil {
+				return fmt.Errorf("msgs[%d]: %w", i, err)
+			}
+		}
+		// Admin access can only be granted through the propose/accept handshake, never by a parent marker.
+		if addAccess, ok := m.(*MsgAddAccessRequest); ok {
+			for _, grant := range addAccess.Access {
+				if grant.HasAccess(Access_Admin) {
+					return fmt.Errorf("msgs[%d]: %s cannot be granted as a parent marker", i, Access_Admin)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetMessages returns the unpacked msgs to execute.
+func (msg MsgExecuteAsParentRequest) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "MsgExecuteAsParentRequest")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces for this MsgExecuteAsParentRequest.
+func (msg MsgExecuteAsParentRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
+
+func NewMsgSetERC20PointerRequest(denom, chainID, contractAddress string, signer sdk.AccAddress) *MsgSetERC20PointerRequest {
+	return &MsgSetERC20PointerRequest{
+		Denom:           denom,
+		ChainId:         chainID,
+		ContractAddress: contractAddress,
+		Signer:          signer.String(),
+	}
+}
+
+func (msg MsgSetERC20PointerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	return NewERC20Pointer(msg.Denom, msg.ChainId, msg.ContractAddress).Validate()
+}
+
+func NewMsgRemoveERC20PointerRequest(denom, chainID string, signer sdk.AccAddress) *MsgRemoveERC20PointerRequest {
+	return &MsgRemoveERC20PointerRequest{
+		Denom:   denom,
+		ChainId: chainID,
+		Signer:  signer.String(),
+	}
+}
+
+func (msg MsgRemoveERC20PointerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	return ValidateERC20ChainID(msg.ChainId)
+}
+
+func NewMsgSetBridgeRequest(name, denom string, attesters [][]byte, threshold uint32, mintLimit sdkmath.Int, signer sdk.AccAddress) *MsgSetBridgeRequest {
+	return &MsgSetBridgeRequest{
+		Name:      name,
+		Denom:     denom,
+		Attesters: attesters,
+		Threshold: threshold,
+		MintLimit: mintLimit.String(),
+		Signer:    signer.String(),
+	}
+}
+
+func (msg MsgSetBridgeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	bridge, err := msg.GetBridge()
+	if err != nil {
+		return err
+	}
+	return bridge.Validate()
+}
+
+// GetBridge returns the MarkerBridge defined by this msg.
+func (msg MsgSetBridgeRequest) GetBridge() (MarkerBridge, error) {
+	mintLimit, ok := sdkmath.NewIntFromString(msg.MintLimit)
+	if !ok {
+		return MarkerBridge{}, fmt.Errorf("invalid mint limit %q", msg.MintLimit)
+	}
+	return NewMarkerBridge(msg.Name, msg.Denom, msg.Attesters, msg.Threshold, mintLimit), nil
+}
+
+func NewMsgBridgeMintRequest(message BridgeMessage, signatures [][]byte, relayer sdk.AccAddress) *MsgBridgeMintRequest {
+	return &MsgBridgeMintRequest{
+		Message:    message,
+		Signatures: signatures,
+		Relayer:    relayer.String(),
+	}
+}
+
+func (msg MsgBridgeMintRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return fmt.Errorf("invalid relayer: %w", err)
+	}
+	if err := msg.Message.Validate(); err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+	if len(msg.Signatures) == 0 {
+		return errors.New("at least one signature is required")
+	}
+	return nil
+}
+
+func NewMsgBridgeBurnRequest(bridge string, amount sdk.Coin, destination string, sender sdk.AccAddress) *MsgBridgeBurnRequest {
+	return &MsgBridgeBurnRequest{
+		Bridge:      bridge,
+		Amount:      amount,
+		Destination: destination,
+		Sender:      sender.String(),
+	}
+}
+
+func (msg MsgBridgeBurnRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return fmt.Errorf("invalid sender: %w", err)
+	}
+	if err := ValidateBridgeName(msg.Bridge); err != nil {
+		return err
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", msg.Amount)
+	}
+	if len(strings.TrimSpace(msg.Destination)) == 0 {
+		return errors.New("destination cannot be empty")
+	}
+	return nil
+}
+
+func NewMsgSetIssuerManagedFlagsRequest(denom string, issuerManagedFlags bool, signer sdk.AccAddress) *MsgSetIssuerManagedFlagsRequest {
+	return &MsgSetIssuerManagedFlagsRequest{
+		Denom:              denom,
+		IssuerManagedFlags: issuerManagedFlags,
+		Signer:             signer.String(),
+	}
+}
+
+func (msg MsgSetIssuerManagedFlagsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgUpdateMarkerFlagsRequest(denom string, allowForcedTransfer, allowGovernanceControl bool, signers ...sdk.AccAddress) *MsgUpdateMarkerFlagsRequest {
+	rv := &MsgUpdateMarkerFlagsRequest{
+		Denom:                  denom,
+		AllowForcedTransfer:    allowForcedTransfer,
+		AllowGovernanceControl: allowGovernanceControl,
+	}
+	for _, signer := range signers {
+		rv.Signers = append(rv.Signers, signer.String())
+	}
+	return rv
+}
+
+func (msg MsgUpdateMarkerFlagsRequest) ValidateBasic() error {
+	if len(msg.Signers) == 0 {
+		return errors.New("at least one signer is required")
+	}
+	seen := make(map[string]bool, len(msg.Signers))
+	for i, signer := range msg.Signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid signers[%d]: %w", i, err)
+		}
+		if seen[signer] {
+			return fmt.Errorf("invalid signers[%d]: duplicate signer %s", i, signer)
+		}
+		seen[signer] = true
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgProposeAdminRequest(denom string, grantee, signer sdk.AccAddress) *MsgProposeAdminRequest {
+	return &MsgProposeAdminRequest{
+		Denom:   denom,
+		Grantee: grantee.String(),
+		Signer:  signer.String(),
+	}
+}
+
+func (msg MsgProposeAdminRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	if msg.Grantee == msg.Signer {
+		return errors.New("grantee cannot be the signer")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgAcceptAdminRequest(denom string, grantee sdk.AccAddress) *MsgAcceptAdminRequest {
+	return &MsgAcceptAdminRequest{
+		Denom:   denom,
+		Grantee: grantee.String(),
+	}
+}
+
+func (msg MsgAcceptAdminRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgCancelAdminProposalRequest(denom string, grantee, signer sdk.AccAddress) *MsgCancelAdminProposalRequest {
+	return &MsgCancelAdminProposalRequest{
+		Denom:   denom,
+		Grantee: grantee.String(),
+		Signer:  signer.String(),
+	}
+}
+
+func (msg MsgCancelAdminProposalRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return fmt.Errorf("invalid grantee: %w", err)
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgUpdateReqAttrBypassAddrsRequest(addAddresses, removeAddresses []string, authority string) *MsgUpdateReqAttrBypassAddrsRequest {
+	return &MsgUpdateReqAttrBypassAddrsRequest{
+		AddAddresses:    addAddresses,
+		RemoveAddresses: removeAddresses,
+		Authority:       authority,
+	}
+}
+
+func (msg MsgUpdateReqAttrBypassAddrsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if len(msg.AddAddresses) == 0 && len(msg.RemoveAddresses) == 0 {
+		return fmt.Errorf("both add and remove lists cannot be empty")
+	}
+
+	combined := []string{}
+	combined = append(combined, msg.AddAddresses...)
+	combined = append(combined, msg.RemoveAddresses...)
+	seen := make(map[string]bool)
+	for _, addr := range combined {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("address lists contain duplicate entry %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+func NewMsgSetAttributeRevocationActionRequest(denom string, action AttributeRevocationAction, signer sdk.AccAddress) *MsgSetAttributeRevocationActionRequest {
+	return &MsgSetAttributeRevocationActionRequest{
+		Denom:  denom,
+		Action: action,
+		Signer: signer.String(),
+	}
+}
+
+func (msg MsgSetAttributeRevocationActionRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if err := msg.Action.Validate(); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgSetDustPolicyRequest(denom string, policy DustPolicy, signer sdk.AccAddress) *MsgSetDustPolicyRequest {
+	return &MsgSetDustPolicyRequest{
+		Denom:  denom,
+		Policy: policy,
+		Signer: signer.String(),
+	}
+}
+
+func (msg MsgSetDustPolicyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if err := msg.Policy.Validate(); err != nil {
+		return err
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgSchedulePolicyChangeRequest(denom string, effectiveHeight int64, transferAuthority sdk.AccAddress, removeRequiredAttributes, addRequiredAttributes []string) *MsgSchedulePolicyChangeRequest {
+	return &MsgSchedulePolicyChangeRequest{
+		Denom:                    denom,
+		EffectiveHeight:          effectiveHeight,
+		AddRequiredAttributes:    addRequiredAttributes,
+		RemoveRequiredAttributes: removeRequiredAttributes,
+		TransferAuthority:        transferAuthority.String(),
+	}
+}
+
+func (msg MsgSchedulePolicyChangeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.TransferAuthority); err != nil {
+		return fmt.Errorf("invalid transfer authority: %w", err)
+	}
+	if msg.EffectiveHeight <= 0 {
+		return fmt.Errorf("invalid effective height %d: must be positive", msg.EffectiveHeight)
+	}
+	change := ScheduledPolicyChange{
+		Denom:                    msg.Denom,
+		EffectiveHeight:          msg.EffectiveHeight,
+		AddRequiredAttributes:    msg.AddRequiredAttributes,
+		RemoveRequiredAttributes: msg.RemoveRequiredAttributes,
+		ScheduledBy:              msg.TransferAuthority,
+	}
+	return change.ValidateAttributes()
+}
+
+func NewMsgCancelPolicyChangeRequest(denom string, id uint64, transferAuthority sdk.AccAddress) *MsgCancelPolicyChangeRequest {
+	return &MsgCancelPolicyChangeRequest{
+		Denom:             denom,
+		Id:                id,
+		TransferAuthority: transferAuthority.String(),
+	}
+}
+
+func (msg MsgCancelPolicyChangeRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.TransferAuthority); err != nil {
+		return fmt.Errorf("invalid transfer authority: %w", err)
+	}
+	if msg.Id == 0 {
+		return errors.New("policy change id cannot be zero")
+	}
+	return sdk.ValidateDenom(msg.Denom)
+}
+
+func NewMsgSetReserveRequirementRequest(denom string, reserveDenoms []string, ratio sdkmath.LegacyDec, signer sdk.AccAddress) *MsgSetReserveRequirementRequest {
+	return &MsgSetReserveRequirementRequest{
+		Denom:         denom,
+		ReserveDenoms: reserveDenoms,
+		Ratio:         ratio,
+		Signer:        signer.String(),
+	}
+}
+
+func (msg MsgSetReserveRequirementRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	req := NewReserveRequirement(msg.Denom, msg.ReserveDenoms, msg.Ratio)
+	if req.IsEmpty() {
+		return sdk.ValidateDenom(msg.Denom)
+	}
+	return req.Validate()
+}
+
+func NewMsgSetReserveAttestorsRequest(denom string, attestors []string, signer sdk.AccAddress) *MsgSetReserveAttestorsRequest {
+	return &MsgSetReserveAttestorsRequest{
+		Denom:     denom,
+		Attestors: attestors,
+		Signer:    signer.String(),
+	}
+}
+
+func (msg MsgSetReserveAttestorsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	return validateAttestors(msg.Attestors)
+}
+
+func NewMsgAttestReservesRequest(denom string, amount sdk.Coin, custodian string, reportHash []byte, validFrom, validUntil time.Time, attestor sdk.AccAddress) *MsgAttestReservesRequest {
+	return &MsgAttestReservesRequest{
+		Denom:      denom,
+		Amount:     amount,
+		Custodian:  custodian,
+		ReportHash: reportHash,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+		Attestor:   attestor.String(),
+	}
+}
+
+func (msg MsgAttestReservesRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Attestor); err != nil {
+		return fmt.Errorf("invalid attestor: %w", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	return validateAttestationReport(msg.Amount, msg.Custodian, msg.ReportHash, msg.ValidFrom, msg.ValidUntil)
+}
+
+func NewMsgSwapRequest(partyA sdk.AccAddress, amountA sdk.Coin, partyB sdk.AccAddress, amountB sdk.Coin) *MsgSwapRequest {
+	return &MsgSwapRequest{
+		PartyA:  partyA.String(),
+		AmountA: amountA,
+		PartyB:  partyB.String(),
+		AmountB: amountB,
+	}
+}
+
+func (msg MsgSwapRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.PartyA); err != nil {
+		return fmt.Errorf("invalid party a: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.PartyB); err != nil {
+		return fmt.Errorf("invalid party b: %w", err)
+	}
+	if msg.PartyA == msg.PartyB {
+		return errors.New("party a and party b cannot be the same")
+	}
+	return ValidateSwapAmounts("amount a", msg.AmountA, "amount b", msg.AmountB)
+}
+
+func NewMsgCreateSwapOfferRequest(maker, taker sdk.AccAddress, offer, ask sdk.Coin, expirationHeight int64) *MsgCreateSwapOfferRequest {
+	return &MsgCreateSwapOfferRequest{
+		Maker:            maker.String(),
+		Taker:            taker.String(),
+		Offer:            offer,
+		Ask:              ask,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
+func (msg MsgCreateSwapOfferRequest) ValidateBasic() error {
+	return validateSwapOffer(msg.Maker, msg.Taker, msg.Offer, msg.Ask, msg.ExpirationHeight)
+}
+
+func NewMsgAcceptSwapOfferRequest(offerID uint64, taker sdk.AccAddress) *MsgAcceptSwapOfferRequest {
+	return &MsgAcceptSwapOfferRequest{
+		OfferId: offerID,
+		Taker:   taker.String(),
+	}
+}
+
+func (msg MsgAcceptSwapOfferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Taker); err != nil {
+		return fmt.Errorf("invalid taker: %w", err)
+	}
+	if msg.OfferId == 0 {
+		return errors.New("swap offer id cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgCancelSwapOfferRequest(offerID uint64, maker sdk.AccAddress) *MsgCancelSwapOfferRequest {
+	return &MsgCancelSwapOfferRequest{
+		OfferId: offerID,
+		Maker:   maker.String(),
+	}
+}
+
+func (msg MsgCancelSwapOfferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Maker); err != nil {
+		return fmt.Errorf("invalid maker: %w", err)
+	}
+	if msg.OfferId == 0 {
+		return errors.New("swap offer id cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgSetBridgedSupplyRequest(denom, channelID, lockAddress, remoteDenom string, intervalBlocks uint64, signer sdk.AccAddress) *MsgSetBridgedSupplyRequest {
+	return &MsgSetBridgedSupplyRequest{
+		Denom:          denom,
+		ChannelId:      channelID,
+		LockAddress:    lockAddress,
+		RemoteDenom:    remoteDenom,
+		IntervalBlocks: intervalBlocks,
+		Signer:         signer.String(),
+	}
+}
+
+func (msg MsgSetBridgedSupplyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	bridged := NewBridgedSupply(msg.Denom, msg.ChannelId, msg.LockAddress, msg.RemoteDenom, msg.IntervalBlocks)
+	if bridged.IsEmpty() {
+		return sdk.ValidateDenom(msg.Denom)
+	}
+	return bridged.Validate()
+}
+
+func NewMsgSetSupplyChangePolicyRequest(denom string, maxChange sdkmath.LegacyDec, signer sdk.AccAddress) *MsgSetSupplyChangePolicyRequest {
+	return &MsgSetSupplyChangePolicyRequest{
+		Denom:     denom,
+		MaxChange: maxChange,
+		Signer:    signer.String(),
+	}
+}
+
+func (msg MsgSetSupplyChangePolicyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	policy := NewSupplyChangePolicy(msg.Denom, msg.MaxChange)
+	if policy.IsEmpty() {
+		return sdk.ValidateDenom(msg.Denom)
+	}
+	return policy.Validate()
+}
+
+func NewMsgSetReceiptPolicyRequest(denom string, timeoutBlocks int64, signer sdk.AccAddress) *MsgSetReceiptPolicyRequest {
+	return &MsgSetReceiptPolicyRequest{
+		Denom:         denom,
+		TimeoutBlocks: timeoutBlocks,
+		Signer:        signer.String(),
+	}
+}
+
+func (msg MsgSetReceiptPolicyRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if msg.TimeoutBlocks == 0 {
+		return sdk.ValidateDenom(msg.Denom)
+	}
+	return NewReceiptPolicy(msg.Denom, msg.TimeoutBlocks).Validate()
+}
+
+func NewMsgAcknowledgeReceiptRequest(id uint64, recipient sdk.AccAddress) *MsgAcknowledgeReceiptRequest {
+	return &MsgAcknowledgeReceiptRequest{
+		Id:        id,
+		Recipient: recipient.String(),
+	}
+}
+
+func (msg MsgAcknowledgeReceiptRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if msg.Id == 0 {
+		return errors.New("pending receipt id cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgDeclineReceiptRequest(id uint64, recipient sdk.AccAddress) *MsgDeclineReceiptRequest {
+	return &MsgDeclineReceiptRequest{
+		Id:        id,
+		Recipient: recipient.String(),
+	}
+}
+
+func (msg MsgDeclineReceiptRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient: %w", err)
+	}
+	if msg.Id == 0 {
+		return errors.New("pending receipt id cannot be zero")
+	}
+	return nil
+}
+
+func NewMsgSetContractSupplyCapRequest(denom string, contract sdk.AccAddress, dailyMintLimit, dailyBurnLimit sdkmath.Int, signer sdk.AccAddress) *MsgSetContractSupplyCapRequest {
+	return &MsgSetContractSupplyCapRequest{
+		Denom:          denom,
+		Contract:       contract.String(),
+		DailyMintLimit: sdk.Coin{Denom: denom, Amount: dailyMintLimit},
+		DailyBurnLimit: sdk.Coin{Denom: denom, Amount: dailyBurnLimit},
+		Signer:         signer.String(),
+	}
+}
+
+func (msg MsgSetContractSupplyCapRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if msg.Signer == msg.Contract {
+		return errors.New("a contract cannot set its own supply cap")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return fmt.Errorf("invalid contract: %w", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Remove {
+		if !msg.DailyMintLimit.IsNil() || !msg.DailyBurnLimit.IsNil() {
+			return errors.New("daily limits cannot be provided when removing a contract supply cap")
+		}
+		return nil
+	}
+	for _, limit := range []struct {
+		name  string
+		value sdk.Coin
+	}{
+		{name: "daily mint limit", value: msg.DailyMintLimit},
+		{name: "daily burn limit", value: msg.DailyBurnLimit},
+	} {
+		if limit.value.Denom != msg.Denom {
+			return fmt.Errorf("invalid %s %q: denom must be %s", limit.name, limit.value, msg.Denom)
+		}
+		if err := limit.value.Validate(); err != nil {
+			return fmt.Errorf("invalid %s: %w", limit.name, err)
+		}
+	}
+	return nil
+}
+
+func NewMsgSeizeEscrowProposalRequest(denom string, recoveryAddress sdk.AccAddress, authority string) *MsgSeizeEscrowProposalRequest {
+	return &MsgSeizeEscrowProposalRequest{
+		Denom:           denom,
+		RecoveryAddress: recoveryAddress.String(),
+		Authority:       authority,
+	}
+}
+
+func (msg MsgSeizeEscrowProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	recovery, err := sdk.AccAddressFromBech32(msg.RecoveryAddress)
+	if err != nil {
+		return fmt.Errorf("invalid recovery address: %w", err)
+	}
+	if markerAddr, err := MarkerAddress(msg.Denom); err == nil && recovery.Equals(markerAddr) {
+		return fmt.Errorf("recovery address cannot be the %s marker's address", msg.Denom)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,7 +14,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 
@@ -52,9 +55,38 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgExecuteAsParentRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgSetERC20PointerRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveERC20PointerRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetBridgeRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgBridgeMintRequest{Relayer: signer} },
+		func(signer string) sdk.Msg { return &MsgBridgeBurnRequest{Sender: signer} },
+		func(signer string) sdk.Msg { return &MsgSetIssuerManagedFlagsRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgProposeAdminRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgAcceptAdminRequest{Grantee: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelAdminProposalRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateReqAttrBypassAddrsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeRevocationActionRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetDustPolicyRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSchedulePolicyChangeRequest{TransferAuthority: signer} },
+		func(signer string) sdk.Msg { return &MsgCancelPolicyChangeRequest{TransferAuthority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetReserveRequirementRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetReserveAttestorsRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgAttestReservesRequest{Attestor: signer} },
+		func(signer string) sdk.Msg { return &MsgSetBridgedSupplyRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetSupplyChangePolicyRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSetReceiptPolicyRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgAcknowledgeReceiptRequest{Recipient: signer} },
+		func(signer string) sdk.Msg { return &MsgDeclineReceiptRequest{Recipient: signer} },
+		func(signer string) sdk.Msg { return &MsgSetContractSupplyCapRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgSeizeEscrowProposalRequest{Authority: signer} },
 	}
 
-	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
+	msgMakersMulti := []testutil.MsgMakerMulti{
+		func(signers []string) sdk.Msg { return &MsgUpdateMarkerFlagsRequest{Signers: signers} },
+	}
+
+	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, msgMakersMulti)
 }
 
 func TestMsgGrantAllowance(t *testing.T) {
@@ -1238,6 +1270,9 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					sdkmath.NewInt(DefaultCreationDeposit),
+					DefaultCreationDepositTimeoutBlocks,
+					DefaultRoleTemplates(),
 				),
 			},
 			expectError: false,
@@ -1250,6 +1285,9 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"^invalidregex$",
 					sdkmath.NewInt(1000000000000),
+					sdkmath.NewInt(DefaultCreationDeposit),
+					DefaultCreationDepositTimeoutBlocks,
+					DefaultRoleTemplates(),
 				),
 			},
 			expectError:   true,
@@ -1263,6 +1301,9 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					sdkmath.NewInt(DefaultCreationDeposit),
+					DefaultCreationDepositTimeoutBlocks,
+					DefaultRoleTemplates(),
 				),
 			},
 			expectError:   true,
@@ -1283,3 +1324,1330 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgExecuteAsParentRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	parentAddr := MustGetMarkerAddress("parentcoin")
+	mintMsg := NewMsgMintRequest(parentAddr, sdk.NewInt64Coin("childcoin", 100))
+	bankMsg := banktypes.NewMsgSend(parentAddr, admin, sdk.NewCoins(sdk.NewInt64Coin("childcoin", 1)))
+	badMintMsg := &MsgMintRequest{Administrator: parentAddr.String(), Amount: sdk.Coin{Denom: "childcoin", Amount: sdkmath.NewInt(-1)}}
+	authzMsg := authz.NewMsgExec(parentAddr, []sdk.Msg{mintMsg})
+	authzMsgPtr := &authzMsg
+	grantMsg := &MsgGrantAllowanceRequest{Denom: "childcoin", Administrator: parentAddr.String(), Grantee: admin.String()}
+	govMsg := &MsgChangeStatusProposalRequest{Denom: "childcoin", NewStatus: StatusCancelled, Authority: parentAddr.String()}
+	adminAccessMsg := NewMsgAddAccessRequest("childcoin", parentAddr, *NewAccessGrant(admin, AccessList{Access_Mint, Access_Admin}))
+
+	newMsg := func(parentDenom, administrator string, msgs ...sdk.Msg) *MsgExecuteAsParentRequest {
+		rv, err := NewMsgExecuteAsParentRequest(parentDenom, nil, msgs)
+		require.NoError(t, err, "NewMsgExecuteAsParentRequest")
+		rv.Administrator = administrator
+		return rv
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *MsgExecuteAsParentRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  newMsg("parentcoin", admin.String(), mintMsg),
+		},
+		{
+			name:   "invalid parent denom",
+			msg:    newMsg("p", admin.String(), mintMsg),
+			expErr: "invalid denom: p",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    newMsg("parentcoin", "invalidaddress", mintMsg),
+			expErr: "invalid administrator: decoding bech32 failed: invalid separator index -1: invalid address",
+		},
+		{
+			name:   "no msgs",
+			msg:    newMsg("parentcoin", admin.String()),
+			expErr: "at least one msg is required",
+		},
+		{
+			name:   "not a marker msg",
+			msg:    newMsg("parentcoin", admin.String(), mintMsg, bankMsg),
+			expErr: "msgs[1] \"/cosmos.bank.v1beta1.MsgSend\" cannot be executed as a parent marker",
+		},
+		{
+			name:   "authz exec",
+			msg:    newMsg("parentcoin", admin.String(), authzMsgPtr),
+			expErr: "msgs[0] \"/cosmos.authz.v1beta1.MsgExec\" cannot be executed as a parent marker",
+		},
+		{
+			name:   "marker fee grant",
+			msg:    newMsg("parentcoin", admin.String(), grantMsg),
+			expErr: "msgs[0] \"/provenance.marker.v1.MsgGrantAllowanceRequest\" cannot be executed as a parent marker",
+		},
+		{
+			name:   "marker gov msg",
+			msg:    newMsg("parentcoin", admin.String(), govMsg),
+			expErr: "msgs[0] \"/provenance.marker.v1.MsgChangeStatusProposalRequest\" cannot be executed as a parent marker",
+		},
+		{
+			name:   "nested execute as parent",
+			msg:    newMsg("parentcoin", admin.String(), newMsg("parentcoin", parentAddr.String(), mintMsg)),
+			expErr: "msgs[0] \"/provenance.marker.v1.MsgExecuteAsParentRequest\" cannot be executed as a parent marker",
+		},
+		{
+			name:   "invalid inner msg",
+			msg:    newMsg("parentcoin", admin.String(), badMintMsg),
+			expErr: "msgs[0]: negative coin amount: -1",
+		},
+		{
+			name:   "admin access grant",
+			msg:    newMsg("parentcoin", admin.String(), mintMsg, adminAccessMsg),
+			expErr: "msgs[1]: ACCESS_ADMIN cannot be granted as a parent marker",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgSetERC20PointerRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+	contract := "0x1c7d4b196cb0c7b01d743fbc6116a902379c7238"
+
+	tests := []struct {
+		name string
+		msg  MsgSetERC20PointerRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetERC20PointerRequest{Denom: denom, ChainId: "eip155:1", ContractAddress: contract, Signer: addr},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetERC20PointerRequest{Denom: denom, ChainId: "eip155:1", ContractAddress: contract, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetERC20PointerRequest{Denom: "1denomcannotstartwithdigit", ChainId: "eip155:1", ContractAddress: contract, Signer: addr},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "no chain id",
+			msg:  MsgSetERC20PointerRequest{Denom: denom, ContractAddress: contract, Signer: addr},
+			exp:  "chain id cannot be empty",
+		},
+		{
+			name: "invalid contract address",
+			msg:  MsgSetERC20PointerRequest{Denom: denom, ChainId: "eip155:1", ContractAddress: "0x1234", Signer: addr},
+			exp:  "invalid contract address \"0x1234\": must be 0x followed by 40 hex characters",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgRemoveERC20PointerRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	denom := "somedenom"
+
+	tests := []struct {
+		name string
+		msg  MsgRemoveERC20PointerRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgRemoveERC20PointerRequest{Denom: denom, ChainId: "eip155:1", Signer: addr},
+		},
+		{
+			name: "no signer",
+			msg:  MsgRemoveERC20PointerRequest{Denom: denom, ChainId: "eip155:1"},
+			exp:  "invalid signer: empty address string is not allowed",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgRemoveERC20PointerRequest{Denom: "1denomcannotstartwithdigit", ChainId: "eip155:1", Signer: addr},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "chain id with whitespace",
+			msg:  MsgRemoveERC20PointerRequest{Denom: denom, ChainId: "eip155: 1", Signer: addr},
+			exp:  "chain id \"eip155: 1\" cannot contain whitespace",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetBridgeRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	attesters := [][]byte{secp256k1.GenPrivKey().PubKey().Bytes()}
+
+	tests := []struct {
+		name string
+		msg  MsgSetBridgeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetBridgeRequest{Name: "cctp", Denom: "usdc", Attesters: attesters, Threshold: 1, MintLimit: "1000", Signer: addr},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetBridgeRequest{Name: "cctp", Denom: "usdc", Attesters: attesters, Threshold: 1, MintLimit: "1000", Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid mint limit",
+			msg:  MsgSetBridgeRequest{Name: "cctp", Denom: "usdc", Attesters: attesters, Threshold: 1, MintLimit: "lots", Signer: addr},
+			exp:  "invalid mint limit \"lots\"",
+		},
+		{
+			name: "invalid bridge",
+			msg:  MsgSetBridgeRequest{Name: "cctp", Denom: "usdc", Attesters: attesters, Threshold: 2, MintLimit: "1000", Signer: addr},
+			exp:  "invalid threshold 2: must be between 1 and the number of attesters (1)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgBridgeMintRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	message := BridgeMessage{
+		Bridge:    "cctp",
+		ChainId:   "test-chain",
+		Nonce:     1,
+		Recipient: addr,
+		Amount:    sdk.NewInt64Coin("usdc", 100),
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgBridgeMintRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgBridgeMintRequest{Message: message, Signatures: [][]byte{{0x01}}, Relayer: addr},
+		},
+		{
+			name: "invalid relayer",
+			msg:  MsgBridgeMintRequest{Message: message, Signatures: [][]byte{{0x01}}, Relayer: "not1validrelayer"},
+			exp:  "invalid relayer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid message",
+			msg:  MsgBridgeMintRequest{Message: BridgeMessage{Bridge: "cctp"}, Signatures: [][]byte{{0x01}}, Relayer: addr},
+			exp:  "invalid message: chain id cannot be empty",
+		},
+		{
+			name: "no signatures",
+			msg:  MsgBridgeMintRequest{Message: message, Relayer: addr},
+			exp:  "at least one signature is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgBridgeBurnRequestValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	amount := sdk.NewInt64Coin("usdc", 100)
+
+	tests := []struct {
+		name string
+		msg  MsgBridgeBurnRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgBridgeBurnRequest{Bridge: "cctp", Amount: amount, Destination: "domain0:0xabc", Sender: addr},
+		},
+		{
+			name: "invalid sender",
+			msg:  MsgBridgeBurnRequest{Bridge: "cctp", Amount: amount, Destination: "domain0:0xabc", Sender: "not1validsender"},
+			exp:  "invalid sender: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "no bridge",
+			msg:  MsgBridgeBurnRequest{Amount: amount, Destination: "domain0:0xabc", Sender: addr},
+			exp:  "bridge name cannot be empty",
+		},
+		{
+			name: "zero amount",
+			msg:  MsgBridgeBurnRequest{Bridge: "cctp", Amount: sdk.NewInt64Coin("usdc", 0), Destination: "domain0:0xabc", Sender: addr},
+			exp:  "invalid amount 0usdc: must be positive",
+		},
+		{
+			name: "no destination",
+			msg:  MsgBridgeBurnRequest{Bridge: "cctp", Amount: amount, Destination: " ", Sender: addr},
+			exp:  "destination cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMarkerFlagsRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateMarkerFlagsRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgUpdateMarkerFlagsRequest{Denom: "somedenom", AllowForcedTransfer: true, Signers: []string{addr1, addr2}},
+		},
+		{
+			name: "no signers",
+			msg:  MsgUpdateMarkerFlagsRequest{Denom: "somedenom"},
+			exp:  "at least one signer is required",
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgUpdateMarkerFlagsRequest{Denom: "somedenom", Signers: []string{addr1, "not1validsigner"}},
+			exp:  "invalid signers[1]: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "duplicate signer",
+			msg:  MsgUpdateMarkerFlagsRequest{Denom: "somedenom", Signers: []string{addr1, addr2, addr1}},
+			exp:  "invalid signers[2]: duplicate signer " + addr1,
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgUpdateMarkerFlagsRequest{Denom: "1denomcannotstartwithdigit", Signers: []string{addr1}},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgProposeAdminRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgProposeAdminRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgProposeAdminRequest{Denom: "somedenom", Grantee: addr1, Signer: addr2},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgProposeAdminRequest{Denom: "somedenom", Grantee: addr1, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid grantee",
+			msg:  MsgProposeAdminRequest{Denom: "somedenom", Grantee: "", Signer: addr2},
+			exp:  "invalid grantee: empty address string is not allowed",
+		},
+		{
+			name: "grantee is signer",
+			msg:  MsgProposeAdminRequest{Denom: "somedenom", Grantee: addr1, Signer: addr1},
+			exp:  "grantee cannot be the signer",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgProposeAdminRequest{Denom: "1denomcannotstartwithdigit", Grantee: addr1, Signer: addr2},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateReqAttrBypassAddrsRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	authority := sdk.AccAddress("authority___________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgUpdateReqAttrBypassAddrsRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgUpdateReqAttrBypassAddrsRequest{AddAddresses: []string{addr1}, RemoveAddresses: []string{addr2}, Authority: authority},
+		},
+		{
+			name: "invalid authority",
+			msg:  MsgUpdateReqAttrBypassAddrsRequest{AddAddresses: []string{addr1}, Authority: ""},
+			exp:  "invalid authority: empty address string is not allowed",
+		},
+		{
+			name: "empty lists",
+			msg:  MsgUpdateReqAttrBypassAddrsRequest{Authority: authority},
+			exp:  "both add and remove lists cannot be empty",
+		},
+		{
+			name: "invalid add address",
+			msg:  MsgUpdateReqAttrBypassAddrsRequest{AddAddresses: []string{"notanaddress"}, Authority: authority},
+			exp:  "invalid address \"notanaddress\": decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "invalid remove address",
+			msg:  MsgUpdateReqAttrBypassAddrsRequest{RemoveAddresses: []string{addr1, ""}, Authority: authority},
+			exp:  "invalid address \"\": empty address string is not allowed",
+		},
+		{
+			name: "address in both lists",
+			msg:  MsgUpdateReqAttrBypassAddrsRequest{AddAddresses: []string{addr1}, RemoveAddresses: []string{addr1}, Authority: authority},
+			exp:  "address lists contain duplicate entry " + addr1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetAttributeRevocationActionRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetAttributeRevocationActionRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetAttributeRevocationActionRequest{Denom: "somedenom", Action: AttributeRevocationAction_Freeze, Signer: addr1},
+		},
+		{
+			name: "none",
+			msg:  MsgSetAttributeRevocationActionRequest{Denom: "somedenom", Action: AttributeRevocationAction_None, Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetAttributeRevocationActionRequest{Denom: "somedenom", Action: AttributeRevocationAction_DenySend, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "unknown action",
+			msg:  MsgSetAttributeRevocationActionRequest{Denom: "somedenom", Action: AttributeRevocationAction(5), Signer: addr1},
+			exp:  "unknown attribute revocation action 5",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetAttributeRevocationActionRequest{Denom: "1denomcannotstartwithdigit", Action: AttributeRevocationAction_DenySend, Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetDustPolicyRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetDustPolicyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetDustPolicyRequest{Denom: "somedenom", Policy: DustPolicy_LargestRemainder, Signer: addr1},
+		},
+		{
+			name: "truncate to issuer",
+			msg:  MsgSetDustPolicyRequest{Denom: "somedenom", Policy: DustPolicy_TruncateToIssuer, Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetDustPolicyRequest{Denom: "somedenom", Policy: DustPolicy_Burn, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "unknown policy",
+			msg:  MsgSetDustPolicyRequest{Denom: "somedenom", Policy: DustPolicy(5), Signer: addr1},
+			exp:  "unknown dust policy 5",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetDustPolicyRequest{Denom: "1denomcannotstartwithdigit", Policy: DustPolicy_Burn, Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSchedulePolicyChangeRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSchedulePolicyChangeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "somedenom", EffectiveHeight: 5, AddRequiredAttributes: []string{"a.b"}, RemoveRequiredAttributes: []string{"c.d"}, TransferAuthority: addr1},
+		},
+		{
+			name: "invalid transfer authority",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "somedenom", EffectiveHeight: 5, AddRequiredAttributes: []string{"a.b"}, TransferAuthority: "not1validsigner"},
+			exp:  "invalid transfer authority: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "zero effective height",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "somedenom", AddRequiredAttributes: []string{"a.b"}, TransferAuthority: addr1},
+			exp:  "invalid effective height 0: must be positive",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "1denomcannotstartwithdigit", EffectiveHeight: 5, AddRequiredAttributes: []string{"a.b"}, TransferAuthority: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "no attributes",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "somedenom", EffectiveHeight: 5, TransferAuthority: addr1},
+			exp:  "both add and remove lists cannot be empty",
+		},
+		{
+			name: "empty attribute",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "somedenom", EffectiveHeight: 5, RemoveRequiredAttributes: []string{""}, TransferAuthority: addr1},
+			exp:  "required attribute lists cannot contain empty entries",
+		},
+		{
+			name: "duplicate attribute",
+			msg:  MsgSchedulePolicyChangeRequest{Denom: "somedenom", EffectiveHeight: 5, AddRequiredAttributes: []string{"a.b"}, RemoveRequiredAttributes: []string{"a.b"}, TransferAuthority: addr1},
+			exp:  `required attribute lists contain duplicate entry "a.b"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgCancelPolicyChangeRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgCancelPolicyChangeRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgCancelPolicyChangeRequest{Denom: "somedenom", Id: 1, TransferAuthority: addr1},
+		},
+		{
+			name: "invalid transfer authority",
+			msg:  MsgCancelPolicyChangeRequest{Denom: "somedenom", Id: 1, TransferAuthority: "not1validsigner"},
+			exp:  "invalid transfer authority: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "zero id",
+			msg:  MsgCancelPolicyChangeRequest{Denom: "somedenom", TransferAuthority: addr1},
+			exp:  "policy change id cannot be zero",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgCancelPolicyChangeRequest{Denom: "1denomcannotstartwithdigit", Id: 1, TransferAuthority: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetReserveRequirementRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetReserveRequirementRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetReserveRequirementRequest{Denom: "somedenom", ReserveDenoms: []string{"uusdc"}, Ratio: sdkmath.LegacyOneDec(), Signer: addr1},
+		},
+		{
+			name: "remove",
+			msg:  MsgSetReserveRequirementRequest{Denom: "somedenom", Ratio: sdkmath.LegacyZeroDec(), Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetReserveRequirementRequest{Denom: "somedenom", ReserveDenoms: []string{"uusdc"}, Ratio: sdkmath.LegacyOneDec(), Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetReserveRequirementRequest{Denom: "1denomcannotstartwithdigit", Ratio: sdkmath.LegacyZeroDec(), Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "ratio without reserve denoms",
+			msg:  MsgSetReserveRequirementRequest{Denom: "somedenom", Ratio: sdkmath.LegacyOneDec(), Signer: addr1},
+			exp:  "at least one reserve denom is required",
+		},
+		{
+			name: "reserve denoms without ratio",
+			msg:  MsgSetReserveRequirementRequest{Denom: "somedenom", ReserveDenoms: []string{"uusdc"}, Ratio: sdkmath.LegacyZeroDec(), Signer: addr1},
+			exp:  "invalid reserve ratio \"0.000000000000000000\": must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetBridgedSupplyRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	lockAddr := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	tests := []struct {
+		name string
+		msg  MsgSetBridgedSupplyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetBridgedSupplyRequest{Denom: "somedenom", ChannelId: "channel-3", LockAddress: lockAddr, RemoteDenom: "uatom", IntervalBlocks: 100, Signer: addr1},
+		},
+		{
+			name: "remove",
+			msg:  MsgSetBridgedSupplyRequest{Denom: "somedenom", Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetBridgedSupplyRequest{Denom: "somedenom", Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetBridgedSupplyRequest{Denom: "1denomcannotstartwithdigit", Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "channel without lock address",
+			msg:  MsgSetBridgedSupplyRequest{Denom: "somedenom", ChannelId: "channel-3", RemoteDenom: "uatom", IntervalBlocks: 100, Signer: addr1},
+			exp:  "invalid lock address \"\": decoding bech32 failed: invalid bech32 string length 0",
+		},
+		{
+			name: "missing interval",
+			msg:  MsgSetBridgedSupplyRequest{Denom: "somedenom", ChannelId: "channel-3", LockAddress: lockAddr, RemoteDenom: "uatom", Signer: addr1},
+			exp:  "interval blocks cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetSupplyChangePolicyRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetSupplyChangePolicyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetSupplyChangePolicyRequest{Denom: "somedenom", MaxChange: sdkmath.LegacyNewDecWithPrec(1, 1), Signer: addr1},
+		},
+		{
+			name: "remove",
+			msg:  MsgSetSupplyChangePolicyRequest{Denom: "somedenom", MaxChange: sdkmath.LegacyZeroDec(), Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetSupplyChangePolicyRequest{Denom: "somedenom", MaxChange: sdkmath.LegacyOneDec(), Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetSupplyChangePolicyRequest{Denom: "1denomcannotstartwithdigit", MaxChange: sdkmath.LegacyOneDec(), Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "negative max change",
+			msg:  MsgSetSupplyChangePolicyRequest{Denom: "somedenom", MaxChange: sdkmath.LegacyNewDec(-1), Signer: addr1},
+			exp:  "invalid max change \"-1.000000000000000000\": must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSetReserveAttestorsRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetReserveAttestorsRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetReserveAttestorsRequest{Denom: "somedenom", Attestors: []string{addr1, addr2}, Signer: addr1},
+		},
+		{
+			name: "remove all",
+			msg:  MsgSetReserveAttestorsRequest{Denom: "somedenom", Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetReserveAttestorsRequest{Denom: "somedenom", Attestors: []string{addr1}, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetReserveAttestorsRequest{Denom: "1denomcannotstartwithdigit", Attestors: []string{addr1}, Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "invalid attestor",
+			msg:  MsgSetReserveAttestorsRequest{Denom: "somedenom", Attestors: []string{"bad"}, Signer: addr1},
+			exp:  "invalid attestor \"bad\": decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name: "duplicate attestor",
+			msg:  MsgSetReserveAttestorsRequest{Denom: "somedenom", Attestors: []string{addr2, addr1, addr2}, Signer: addr1},
+			exp:  "duplicate attestor " + addr2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgAttestReservesRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	validFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	validUntil := validFrom.Add(24 * time.Hour)
+	amount := sdk.NewInt64Coin("usd", 1000)
+	hash := []byte("report_hash_____________________")
+	newMsg := func(modify func(msg *MsgAttestReservesRequest)) MsgAttestReservesRequest {
+		msg := NewMsgAttestReservesRequest("somedenom", amount, "First Bank", hash, validFrom, validUntil, addr1)
+		if modify != nil {
+			modify(msg)
+		}
+		return *msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgAttestReservesRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(nil),
+		},
+		{
+			name: "invalid attestor",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.Attestor = "not1validsigner" }),
+			exp:  "invalid attestor: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.Denom = "1denomcannotstartwithdigit" }),
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "invalid amount",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.Amount = sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(-1)} }),
+			exp:  "invalid amount: negative coin amount: -1",
+		},
+		{
+			name: "no custodian",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.Custodian = "  " }),
+			exp:  "custodian cannot be empty",
+		},
+		{
+			name: "custodian too long",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.Custodian = strings.Repeat("c", MaxCustodianLength+1) }),
+			exp:  fmt.Sprintf("custodian length %d exceeds maximum length of %d", MaxCustodianLength+1, MaxCustodianLength),
+		},
+		{
+			name: "no report hash",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.ReportHash = nil }),
+			exp:  "report hash cannot be empty",
+		},
+		{
+			name: "report hash too long",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.ReportHash = make([]byte, MaxReportHashLength+1) }),
+			exp:  fmt.Sprintf("report hash length %d exceeds maximum length of %d", MaxReportHashLength+1, MaxReportHashLength),
+		},
+		{
+			name: "valid until not after valid from",
+			msg:  newMsg(func(msg *MsgAttestReservesRequest) { msg.ValidUntil = validFrom }),
+			exp:  "valid until 2026-01-01T00:00:00Z must be after valid from 2026-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSwapRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	newMsg := func(modify func(msg *MsgSwapRequest)) MsgSwapRequest {
+		msg := NewMsgSwapRequest(addr1, sdk.NewInt64Coin("hotdog", 10), addr2, sdk.NewInt64Coin("hamburger", 25))
+		if modify != nil {
+			modify(msg)
+		}
+		return *msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSwapRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(nil),
+		},
+		{
+			name: "invalid party a",
+			msg:  newMsg(func(msg *MsgSwapRequest) { msg.PartyA = "" }),
+			exp:  "invalid party a: empty address string is not allowed",
+		},
+		{
+			name: "invalid party b",
+			msg:  newMsg(func(msg *MsgSwapRequest) { msg.PartyB = "not1validsigner" }),
+			exp:  "invalid party b: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "same parties",
+			msg:  newMsg(func(msg *MsgSwapRequest) { msg.PartyB = msg.PartyA }),
+			exp:  "party a and party b cannot be the same",
+		},
+		{
+			name: "invalid amount a",
+			msg:  newMsg(func(msg *MsgSwapRequest) { msg.AmountA.Denom = "1hotdog" }),
+			exp:  "invalid amount a: invalid denom: 1hotdog",
+		},
+		{
+			name: "zero amount b",
+			msg:  newMsg(func(msg *MsgSwapRequest) { msg.AmountB = sdk.NewInt64Coin("hamburger", 0) }),
+			exp:  "invalid amount b 0hamburger: must be positive",
+		},
+		{
+			name: "same denoms",
+			msg:  newMsg(func(msg *MsgSwapRequest) { msg.AmountB.Denom = "hotdog" }),
+			exp:  "amount a and amount b cannot have the same denom hotdog",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgCreateSwapOfferRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	newMsg := func(modify func(msg *MsgCreateSwapOfferRequest)) MsgCreateSwapOfferRequest {
+		msg := NewMsgCreateSwapOfferRequest(addr1, addr2, sdk.NewInt64Coin("hotdog", 10), sdk.NewInt64Coin("hamburger", 25), 100)
+		if modify != nil {
+			modify(msg)
+		}
+		return *msg
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgCreateSwapOfferRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  newMsg(nil),
+		},
+		{
+			name: "no taker",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.Taker = "" }),
+		},
+		{
+			name: "invalid maker",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.Maker = "not1validsigner" }),
+			exp:  "invalid maker: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid taker",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.Taker = "not1validsigner" }),
+			exp:  "invalid taker: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "taker is maker",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.Taker = msg.Maker }),
+			exp:  "maker and taker cannot be the same",
+		},
+		{
+			name: "zero offer",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.Offer = sdk.NewInt64Coin("hotdog", 0) }),
+			exp:  "invalid offer 0hotdog: must be positive",
+		},
+		{
+			name: "same denoms",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.Ask.Denom = "hotdog" }),
+			exp:  "offer and ask cannot have the same denom hotdog",
+		},
+		{
+			name: "zero expiration height",
+			msg:  newMsg(func(msg *MsgCreateSwapOfferRequest) { msg.ExpirationHeight = 0 }),
+			exp:  "invalid expiration height 0: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgAcceptAndCancelSwapOfferRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+
+	assert.NoError(t, NewMsgAcceptSwapOfferRequest(1, addr1).ValidateBasic(), "accept control")
+	assert.EqualError(t, NewMsgAcceptSwapOfferRequest(0, addr1).ValidateBasic(), "swap offer id cannot be zero", "accept zero id")
+	assert.EqualError(t, NewMsgAcceptSwapOfferRequest(1, nil).ValidateBasic(), "invalid taker: empty address string is not allowed", "accept no taker")
+
+	assert.NoError(t, NewMsgCancelSwapOfferRequest(1, addr1).ValidateBasic(), "cancel control")
+	assert.EqualError(t, NewMsgCancelSwapOfferRequest(0, addr1).ValidateBasic(), "swap offer id cannot be zero", "cancel zero id")
+	assert.EqualError(t, NewMsgCancelSwapOfferRequest(1, nil).ValidateBasic(), "invalid maker: empty address string is not allowed", "cancel no maker")
+}
+
+func TestMsgMintRequestValidateBasicTranche(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	usd := sdk.NewInt64Coin("usd", 25)
+	newMsg := func(label string, price *sdk.Coin) *MsgMintRequest {
+		msg := NewMsgMintRequest(addr1, sdk.NewInt64Coin("hotdog", 100))
+		msg.TrancheLabel = label
+		msg.TranchePrice = price
+		return msg
+	}
+
+	assert.NoError(t, newMsg("", nil).ValidateBasic(), "no tranche info")
+	assert.NoError(t, newMsg("Series A", &usd).ValidateBasic(), "label and price")
+	assert.EqualError(t, newMsg(strings.Repeat("a", MaxTrancheLabelLength+1), nil).ValidateBasic(),
+		"tranche label length 65 exceeds maximum length of 64", "long label")
+	assert.EqualError(t, newMsg("", &sdk.Coin{Denom: "usd", Amount: sdkmath.NewInt(-1)}).ValidateBasic(),
+		"invalid tranche price: negative coin amount: -1", "negative price")
+	assert.EqualError(t, newMsg("", &sdk.Coin{Denom: "hotdog", Amount: sdkmath.NewInt(1)}).ValidateBasic(),
+		"tranche price denom cannot be the marker denom \"hotdog\"", "price in marker denom")
+}
+
+func TestMsgTransferRequestValidateBasicSubAccountTag(t *testing.T) {
+	admin := sdk.AccAddress("admin_______________")
+	from := sdk.AccAddress("from________________")
+	to := sdk.AccAddress("to__________________")
+	newMsg := func(tag *SubAccountTag) *MsgTransferRequest {
+		msg := NewMsgTransferRequest(admin, from, to, sdk.NewInt64Coin("hotdog", 100))
+		msg.SubAccountTag = tag
+		return msg
+	}
+
+	assert.NoError(t, newMsg(nil).ValidateBasic(), "no tag")
+	assert.NoError(t, newMsg(&SubAccountTag{FromSubAccount: "client-1"}).ValidateBasic(), "from sub-account")
+	assert.NoError(t, newMsg(&SubAccountTag{ToSubAccount: "client-2"}).ValidateBasic(), "to sub-account")
+	assert.NoError(t, newMsg(&SubAccountTag{FromSubAccount: "client-1", ToSubAccount: "client-2"}).ValidateBasic(), "both sub-accounts")
+	assert.EqualError(t, newMsg(&SubAccountTag{}).ValidateBasic(),
+		"sub-account tag must have a from or to sub-account", "empty tag")
+	assert.EqualError(t, newMsg(&SubAccountTag{FromSubAccount: "   "}).ValidateBasic(),
+		"invalid from sub-account: sub-account cannot be empty", "blank from sub-account")
+	assert.EqualError(t, newMsg(&SubAccountTag{ToSubAccount: strings.Repeat("a", MaxSubAccountLength+1)}).ValidateBasic(),
+		"invalid to sub-account: sub-account length 65 exceeds maximum length of 64", "long to sub-account")
+}
+
+func TestMsgSetReceiptPolicyRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name string
+		msg  MsgSetReceiptPolicyRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetReceiptPolicyRequest{Denom: "somedenom", TimeoutBlocks: 100, Signer: addr1},
+		},
+		{
+			name: "remove",
+			msg:  MsgSetReceiptPolicyRequest{Denom: "somedenom", TimeoutBlocks: 0, Signer: addr1},
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetReceiptPolicyRequest{Denom: "somedenom", TimeoutBlocks: 100, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetReceiptPolicyRequest{Denom: "1denomcannotstartwithdigit", TimeoutBlocks: 100, Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "negative timeout",
+			msg:  MsgSetReceiptPolicyRequest{Denom: "somedenom", TimeoutBlocks: -1, Signer: addr1},
+			exp:  "invalid timeout blocks -1: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgAcknowledgeAndDeclineReceiptRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name      string
+		id        uint64
+		recipient string
+		exp       string
+	}{
+		{
+			name:      "control",
+			id:        1,
+			recipient: addr1,
+		},
+		{
+			name:      "invalid recipient",
+			id:        1,
+			recipient: "not1validsigner",
+			exp:       "invalid recipient: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name:      "zero id",
+			recipient: addr1,
+			exp:       "pending receipt id cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := []sdk.HasValidateBasic{
+				MsgAcknowledgeReceiptRequest{Id: tc.id, Recipient: tc.recipient},
+				MsgDeclineReceiptRequest{Id: tc.id, Recipient: tc.recipient},
+			}
+			for _, msg := range msgs {
+				err := msg.ValidateBasic()
+				if len(tc.exp) > 0 {
+					require.EqualErrorf(t, err, tc.exp, "%T ValidateBasic error", msg)
+				} else {
+					require.NoError(t, err, "%T ValidateBasic error", msg)
+				}
+			}
+		})
+	}
+}
+
+func TestMsgSetContractSupplyCapRequestValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	contract := sdk.AccAddress("contract____________").String()
+	hundred := sdk.NewInt64Coin("somedenom", 100)
+	zero := sdk.NewInt64Coin("somedenom", 0)
+
+	tests := []struct {
+		name string
+		msg  MsgSetContractSupplyCapRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, DailyMintLimit: hundred, DailyBurnLimit: hundred, Signer: addr1},
+		},
+		{
+			name: "block",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, DailyMintLimit: zero, DailyBurnLimit: zero, Signer: addr1},
+		},
+		{
+			name: "remove",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, Signer: addr1, Remove: true},
+		},
+		{
+			name: "remove with limits",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, DailyMintLimit: zero, DailyBurnLimit: zero, Signer: addr1, Remove: true},
+			exp:  "daily limits cannot be provided when removing a contract supply cap",
+		},
+		{
+			name: "no limits without remove",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, Signer: addr1},
+			exp:  `invalid daily mint limit "<nil>": denom must be somedenom`,
+		},
+		{
+			name: "invalid signer",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, DailyMintLimit: hundred, DailyBurnLimit: hundred, Signer: "not1validsigner"},
+			exp:  "invalid signer: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "contract is signer",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: addr1, DailyMintLimit: hundred, DailyBurnLimit: hundred, Signer: addr1},
+			exp:  "a contract cannot set its own supply cap",
+		},
+		{
+			name: "invalid contract",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: "not1validcontract", DailyMintLimit: hundred, DailyBurnLimit: hundred, Signer: addr1},
+			exp:  "invalid contract: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			name: "invalid denom",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "1denomcannotstartwithdigit", Contract: contract, DailyMintLimit: hundred, DailyBurnLimit: hundred, Signer: addr1},
+			exp:  "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name: "limit in other denom",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, DailyMintLimit: hundred, DailyBurnLimit: sdk.NewInt64Coin("other", 5), Signer: addr1},
+			exp:  `invalid daily burn limit "5other": denom must be somedenom`,
+		},
+		{
+			name: "negative mint limit",
+			msg:  MsgSetContractSupplyCapRequest{Denom: "somedenom", Contract: contract, DailyMintLimit: sdk.Coin{Denom: "somedenom", Amount: sdkmath.NewInt(-1)}, DailyBurnLimit: hundred, Signer: addr1},
+			exp:  "invalid daily mint limit: negative coin amount: -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualErrorf(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgSeizeEscrowProposalRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	recovery := sdk.AccAddress("recovery____________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgSeizeEscrowProposalRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  MsgSeizeEscrowProposalRequest{Denom: "somedenom", RecoveryAddress: recovery, Authority: authority},
+		},
+		{
+			name:   "invalid denom",
+			msg:    MsgSeizeEscrowProposalRequest{Denom: "1denomcannotstartwithdigit", RecoveryAddress: recovery, Authority: authority},
+			expErr: "invalid denom: 1denomcannotstartwithdigit",
+		},
+		{
+			name:   "invalid recovery address",
+			msg:    MsgSeizeEscrowProposalRequest{Denom: "somedenom", RecoveryAddress: "invalidrecovery0000", Authority: authority},
+			expErr: "invalid recovery address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:   "recovery address is the marker",
+			msg:    MsgSeizeEscrowProposalRequest{Denom: "somedenom", RecoveryAddress: MustGetMarkerAddress("somedenom").String(), Authority: authority},
+			expErr: "recovery address cannot be the somedenom marker's address",
+		},
+		{
+			name:   "invalid authority",
+			msg:    MsgSeizeEscrowProposalRequest{Denom: "somedenom", RecoveryAddress: recovery, Authority: "invalidauth0000"},
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
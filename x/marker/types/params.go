@@ -14,6 +14,11 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultCreationDeposit is the amount of the fee denom required as a deposit when creating a marker (disabled).
+	DefaultCreationDeposit = 0
+	// DefaultCreationDepositTimeoutBlocks is the number of blocks after creation that a marker can be cancelled
+	// with its creation deposit refunded (about one week).
+	DefaultCreationDepositTimeoutBlocks = 100_800
 )
 
 // NewParams creates a new parameter object
@@ -21,11 +26,17 @@ func NewParams(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	creationDeposit sdkmath.Int,
+	creationDepositTimeoutBlocks int64,
+	roleTemplates []RoleTemplate,
 ) Params {
 	return Params{
-		EnableGovernance:       enableGovernance,
-		UnrestrictedDenomRegex: unrestrictedDenomRegex,
-		MaxSupply:              maxSupply,
+		EnableGovernance:             enableGovernance,
+		UnrestrictedDenomRegex:       unrestrictedDenomRegex,
+		MaxSupply:                    maxSupply,
+		CreationDeposit:              creationDeposit,
+		CreationDepositTimeoutBlocks: creationDepositTimeoutBlocks,
+		RoleTemplates:                roleTemplates,
 	}
 }
 
@@ -35,6 +46,9 @@ func DefaultParams() Params {
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
+		sdkmath.NewInt(DefaultCreationDeposit),
+		DefaultCreationDepositTimeoutBlocks,
+		DefaultRoleTemplates(),
 	)
 }
 
@@ -43,8 +57,24 @@ func (p Params) Validate() error {
 	if len(exp) > 0 && (exp[0:1] == "^" || exp[len(exp)-1:] == "$") {
 		return fmt.Errorf("invalid parameter, validation regex must not contain anchors ^,$")
 	}
-	_, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp))
-	return err
+	if _, err := regexp.Compile(fmt.Sprintf(`^%s$`, exp)); err != nil {
+		return err
+	}
+	if !p.CreationDeposit.IsNil() && p.CreationDeposit.IsNegative() {
+		return fmt.Errorf("invalid parameter, creation deposit %s cannot be negative", p.CreationDeposit)
+	}
+	if p.CreationDepositTimeoutBlocks < 0 {
+		return fmt.Errorf("invalid parameter, creation deposit timeout blocks %d cannot be negative", p.CreationDepositTimeoutBlocks)
+	}
+	if err := ValidateRoleTemplates(p.RoleTemplates); err != nil {
+		return fmt.Errorf("invalid parameter, %w", err)
+	}
+	return nil
+}
+
+// HasCreationDeposit returns true if these params require a deposit when creating a marker.
+func (p Params) HasCreationDeposit() bool {
+	return !p.CreationDeposit.IsNil() && p.CreationDeposit.IsPositive()
 }
 
 func StringToBigInt(val string) sdkmath.Int {
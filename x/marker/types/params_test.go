@@ -18,11 +18,18 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
+	require.False(t, p.HasCreationDeposit(), "HasCreationDeposit")
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
+	deposit := sdkmath.NewInt(DefaultCreationDeposit)
+	timeout := int64(DefaultCreationDepositTimeoutBlocks)
+	roles := DefaultRoleTemplates()
+	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), deposit, timeout, roles)))
+	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), deposit, timeout, roles)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply), deposit, timeout, roles)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"), deposit, timeout, roles)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), sdkmath.NewInt(5), timeout, roles)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), deposit, 5, roles)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), deposit, timeout, roles[1:])))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -39,7 +46,13 @@ func TestDefaultParams(t *testing.T) {
 func TestParamString(t *testing.T) {
 	expected := `enable_governance:true ` +
 		`unrestricted_denom_regex:"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" ` +
-		`max_supply:"100000000000000000000" `
+		`max_supply:"100000000000000000000" ` +
+		`creation_deposit:"0" ` +
+		`creation_deposit_timeout_blocks:100800 ` +
+		`role_templates:<name:"issuer" permissions:ACCESS_MINT permissions:ACCESS_BURN permissions:ACCESS_WITHDRAW > ` +
+		`role_templates:<name:"registrar" permissions:ACCESS_ADMIN > ` +
+		`role_templates:<name:"compliance" permissions:ACCESS_TRANSFER permissions:ACCESS_FORCE_TRANSFER > ` +
+		`role_templates:<name:"treasury" permissions:ACCESS_DEPOSIT permissions:ACCESS_WITHDRAW > `
 	p := DefaultParams()
 	actual := p.String()
 	require.Equal(t, expected, actual)
@@ -97,6 +110,47 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expectedErr: "error parsing regexp: missing closing ):",
 		},
+		{
+			name: "positive creation deposit",
+			params: Params{
+				CreationDeposit:              sdkmath.NewInt(1_000_000_000),
+				CreationDepositTimeoutBlocks: 10,
+			},
+			expectedErr: "",
+		},
+		{
+			name: "negative creation deposit",
+			params: Params{
+				CreationDeposit: sdkmath.NewInt(-1),
+			},
+			expectedErr: "invalid parameter, creation deposit -1 cannot be negative",
+		},
+		{
+			name: "negative creation deposit timeout blocks",
+			params: Params{
+				CreationDepositTimeoutBlocks: -1,
+			},
+			expectedErr: "invalid parameter, creation deposit timeout blocks -1 cannot be negative",
+		},
+		{
+			name:        "default role templates",
+			params:      Params{RoleTemplates: DefaultRoleTemplates()},
+			expectedErr: "",
+		},
+		{
+			name: "invalid role template",
+			params: Params{
+				RoleTemplates: []RoleTemplate{NewRoleTemplate(RoleIssuer, Access_Mint), NewRoleTemplate("Treasury", Access_Deposit)},
+			},
+			expectedErr: "invalid parameter, invalid role template[1]: role name \"Treasury\" must be lowercase and cannot contain spaces, commas, or semicolons",
+		},
+		{
+			name: "duplicate role templates",
+			params: Params{
+				RoleTemplates: []RoleTemplate{NewRoleTemplate(RoleIssuer, Access_Mint), NewRoleTemplate(RoleIssuer, Access_Burn)},
+			},
+			expectedErr: "invalid parameter, invalid role template[1]: duplicate role \"issuer\"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if this ScheduledPolicyChange is not in a valid state.
+func (c ScheduledPolicyChange) Validate() error {
+	if c.Id == 0 {
+		return errors.New("id cannot be zero")
+	}
+	if c.EffectiveHeight <= 0 {
+		return fmt.Errorf("invalid effective height %d: must be positive", c.EffectiveHeight)
+	}
+	if _, err := sdk.AccAddressFromBech32(c.ScheduledBy); err != nil {
+		return fmt.Errorf("invalid scheduled by: %w", err)
+	}
+	return c.ValidateAttributes()
+}
+
+// ValidateAttributes returns an error if the denom or the attribute lists of this ScheduledPolicyChange are invalid.
+// At least one attribute must be added or removed, and no attribute can be listed more than once.
+func (c ScheduledPolicyChange) ValidateAttributes() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if len(c.AddRequiredAttributes) == 0 && len(c.RemoveRequiredAttributes) == 0 {
+		return errors.New("both add and remove lists cannot be empty")
+	}
+	seen := make(map[string]bool)
+	for _, attrs := range [][]string{c.AddRequiredAttributes, c.RemoveRequiredAttributes} {
+		for _, attr := range attrs {
+			if len(attr) == 0 {
+				return errors.New("required attribute lists cannot contain empty entries")
+			}
+			if seen[attr] {
+				return fmt.Errorf("required attribute lists contain duplicate entry %q", attr)
+			}
+			seen[attr] = true
+		}
+	}
+	return nil
+}
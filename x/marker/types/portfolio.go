@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValueOf returns the value of the provided amount of the marker's denom using this net asset value,
+// i.e. amount * price / volume (truncated). The value is zero if this net asset value has no volume.
+func (mnav *NetAssetValue) ValueOf(amount sdkmath.Int) sdk.Coin {
+	if mnav.Volume == 0 {
+		return sdk.NewCoin(mnav.Price.Denom, sdkmath.ZeroInt())
+	}
+	return sdk.NewCoin(mnav.Price.Denom, amount.Mul(mnav.Price.Amount).Quo(sdkmath.NewIntFromUint64(mnav.Volume)))
+}
+
+// IsStale returns true if this net asset value was last updated more than maxAge blocks before the provided height.
+// A net asset value is never stale if maxAge is zero.
+func (mnav *NetAssetValue) IsStale(height int64, maxAge uint64) bool {
+	if maxAge == 0 || height <= 0 {
+		return false
+	}
+	return uint64(height) > mnav.UpdatedBlockHeight+maxAge
+}
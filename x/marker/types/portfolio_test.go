@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNetAssetValueValueOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		nav    NetAssetValue
+		amount int64
+		exp    string
+	}{
+		{name: "one to one", nav: NewNetAssetValue(sdk.NewInt64Coin("usd", 1), 1), amount: 5, exp: "5usd"},
+		{name: "truncated", nav: NewNetAssetValue(sdk.NewInt64Coin("usd", 25), 10), amount: 7, exp: "17usd"},
+		{name: "zero amount", nav: NewNetAssetValue(sdk.NewInt64Coin("usd", 25), 10), amount: 0, exp: "0usd"},
+		{name: "zero volume", nav: NewNetAssetValue(sdk.NewInt64Coin("usd", 0), 0), amount: 5, exp: "0usd"},
+		{name: "less than one", nav: NewNetAssetValue(sdk.NewInt64Coin("fig", 1), 1000), amount: 999, exp: "0fig"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act sdk.Coin
+			testFunc := func() {
+				act = tc.nav.ValueOf(sdkmath.NewInt(tc.amount))
+			}
+			assert.NotPanics(t, testFunc, "ValueOf(%d)", tc.amount)
+			assert.Equal(t, tc.exp, act.String(), "ValueOf(%d)", tc.amount)
+		})
+	}
+}
+
+func TestNetAssetValueIsStale(t *testing.T) {
+	nav := NetAssetValue{Price: sdk.NewInt64Coin("usd", 1), Volume: 1, UpdatedBlockHeight: 50}
+
+	tests := []struct {
+		name   string
+		height int64
+		maxAge uint64
+		exp    bool
+	}{
+		{name: "no max age", height: 1000, maxAge: 0, exp: false},
+		{name: "same height", height: 50, maxAge: 1, exp: false},
+		{name: "at max age", height: 60, maxAge: 10, exp: false},
+		{name: "past max age", height: 61, maxAge: 10, exp: true},
+		{name: "zero height", height: 0, maxAge: 10, exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			act := nav.IsStale(tc.height, tc.maxAge)
+			assert.Equal(t, tc.exp, act, "IsStale(%d, %d)", tc.height, tc.maxAge)
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
 const (
 	QueryMarkers      = "all" // all instead of markers to prevent uri stuttering  in '/custom/marker/all'
 	QueryMarker       = "detail"
@@ -10,6 +16,13 @@ const (
 	QueryMarkerAssets = "assets"
 )
 
+const (
+	// DefaultHoldersExportLimit is the number of holders returned by the HoldersExport query when no limit is provided.
+	DefaultHoldersExportLimit = 1_000
+	// MaxHoldersExportLimit is the largest number of holders that can be returned by the HoldersExport query.
+	MaxHoldersExportLimit = 10_000
+)
+
 // QueryMarkersParams defines the params for the following legacy queries:
 // - 'custom/marker/all'
 type QueryMarkersParams struct {
@@ -21,3 +34,22 @@ type QueryMarkersParams struct {
 func NewQueryMarkersParams(page, limit int, denom, status string) QueryMarkersParams {
 	return QueryMarkersParams{page, limit, denom, status}
 }
+
+// NewQueryValidateProposalRequest creates a new QueryValidateProposalRequest for the provided msgs.
+func NewQueryValidateProposalRequest(msgs []sdk.Msg) (*QueryValidateProposalRequest, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryValidateProposalRequest{Msgs: anys}, nil
+}
+
+// GetMessages returns the unpacked msgs to validate.
+func (q QueryValidateProposalRequest) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(q.Msgs, "QueryValidateProposalRequest")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces for this QueryValidateProposalRequest.
+func (q QueryValidateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, q.Msgs)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReceiptPolicy creates a new ReceiptPolicy.
+func NewReceiptPolicy(denom string, timeoutBlocks int64) ReceiptPolicy {
+	return ReceiptPolicy{
+		Denom:         denom,
+		TimeoutBlocks: timeoutBlocks,
+	}
+}
+
+// Validate returns an error if this ReceiptPolicy is not in a valid state.
+func (p ReceiptPolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if p.TimeoutBlocks <= 0 {
+		return fmt.Errorf("invalid timeout blocks %d: must be positive", p.TimeoutBlocks)
+	}
+	return nil
+}
+
+// Validate returns an error if this PendingReceipt is not in a valid state.
+func (r PendingReceipt) Validate() error {
+	if r.Id == 0 {
+		return errors.New("id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.ToAddress); err != nil {
+		return fmt.Errorf("invalid to address: %w", err)
+	}
+	if r.FromAddress == r.ToAddress {
+		return errors.New("from address and to address cannot be the same")
+	}
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if r.Amount.IsZero() {
+		return errors.New("invalid amount: cannot be zero")
+	}
+	if r.ExpirationHeight <= 0 {
+		return fmt.Errorf("invalid expiration height %d: must be positive", r.ExpirationHeight)
+	}
+	return nil
+}
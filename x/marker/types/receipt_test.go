@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestReceiptPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy ReceiptPolicy
+		expErr string
+	}{
+		{
+			name:   "control",
+			policy: NewReceiptPolicy("hotdog", 100),
+		},
+		{
+			name:   "invalid denom",
+			policy: NewReceiptPolicy("x", 100),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "zero timeout",
+			policy: NewReceiptPolicy("hotdog", 0),
+			expErr: "invalid timeout blocks 0: must be positive",
+		},
+		{
+			name:   "negative timeout",
+			policy: NewReceiptPolicy("hotdog", -3),
+			expErr: "invalid timeout blocks -3: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestPendingReceiptValidate(t *testing.T) {
+	from := sdk.AccAddress("from________________").String()
+	to := sdk.AccAddress("to__________________").String()
+	newReceipt := func(modifier func(r *PendingReceipt)) PendingReceipt {
+		rv := PendingReceipt{
+			Id:               1,
+			FromAddress:      from,
+			ToAddress:        to,
+			Amount:           sdk.NewCoins(sdk.NewInt64Coin("hotdog", 5)),
+			ExpirationHeight: 10,
+		}
+		if modifier != nil {
+			modifier(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name    string
+		receipt PendingReceipt
+		expErr  string
+	}{
+		{
+			name:    "control",
+			receipt: newReceipt(nil),
+		},
+		{
+			name:    "zero id",
+			receipt: newReceipt(func(r *PendingReceipt) { r.Id = 0 }),
+			expErr:  "id cannot be zero",
+		},
+		{
+			name:    "invalid from address",
+			receipt: newReceipt(func(r *PendingReceipt) { r.FromAddress = "" }),
+			expErr:  "invalid from address: empty address string is not allowed",
+		},
+		{
+			name:    "invalid to address",
+			receipt: newReceipt(func(r *PendingReceipt) { r.ToAddress = "" }),
+			expErr:  "invalid to address: empty address string is not allowed",
+		},
+		{
+			name:    "same addresses",
+			receipt: newReceipt(func(r *PendingReceipt) { r.ToAddress = from }),
+			expErr:  "from address and to address cannot be the same",
+		},
+		{
+			name:    "no amount",
+			receipt: newReceipt(func(r *PendingReceipt) { r.Amount = nil }),
+			expErr:  "invalid amount: cannot be zero",
+		},
+		{
+			name:    "invalid amount",
+			receipt: newReceipt(func(r *PendingReceipt) { r.Amount = sdk.Coins{sdk.Coin{Denom: "x", Amount: r.Amount[0].Amount}} }),
+			expErr:  "invalid amount: invalid denom: x",
+		},
+		{
+			name:    "zero expiration height",
+			receipt: newReceipt(func(r *PendingReceipt) { r.ExpirationHeight = 0 }),
+			expErr:  "invalid expiration height 0: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.receipt.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewReserveRequirement creates a new ReserveRequirement.
+func NewReserveRequirement(denom string, reserveDenoms []string, ratio sdkmath.LegacyDec) ReserveRequirement {
+	return ReserveRequirement{
+		Denom:         denom,
+		ReserveDenoms: reserveDenoms,
+		Ratio:         ratio,
+	}
+}
+
+// IsEmpty returns true if this ReserveRequirement does not require any reserves.
+func (r ReserveRequirement) IsEmpty() bool {
+	return len(r.ReserveDenoms) == 0 && (r.Ratio.IsNil() || r.Ratio.IsZero())
+}
+
+// Validate returns an error if this ReserveRequirement is not in a valid state.
+func (r ReserveRequirement) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if len(r.ReserveDenoms) == 0 {
+		return errors.New("at least one reserve denom is required")
+	}
+	seen := make(map[string]bool, len(r.ReserveDenoms))
+	for _, denom := range r.ReserveDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid reserve denom: %w", err)
+		}
+		if denom == r.Denom {
+			return fmt.Errorf("marker denom %s cannot be one of its own reserve denoms", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate reserve denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	if r.Ratio.IsNil() || !r.Ratio.IsPositive() {
+		return fmt.Errorf("invalid reserve ratio %q: must be positive", r.Ratio)
+	}
+	return nil
+}
+
+// RequiredReserves returns the total amount of reserves needed to back the provided supply (rounded up).
+func (r ReserveRequirement) RequiredReserves(supply sdkmath.Int) sdkmath.Int {
+	return r.Ratio.MulInt(supply).Ceil().TruncateInt()
+}
+
+// MaxBackedSupply returns the largest supply that can be backed by the provided total amount of reserves.
+func (r ReserveRequirement) MaxBackedSupply(reserves sdkmath.Int) sdkmath.Int {
+	rv := sdkmath.LegacyNewDecFromInt(reserves).Quo(r.Ratio).TruncateInt()
+	// The division is rounded, so it can come out one too high.
+	if rv.IsPositive() && r.RequiredReserves(rv).GT(reserves) {
+		rv = rv.SubRaw(1)
+	}
+	return rv
+}
+
+// TotalAmount returns the sum of the amounts of the provided coins.
+func TotalAmount(coins sdk.Coins) sdkmath.Int {
+	rv := sdkmath.ZeroInt()
+	for _, coin := range coins {
+		rv = rv.Add(coin.Amount)
+	}
+	return rv
+}
+
+const (
+	// MaxCustodianLength is the maximum length of a reserve attestation's custodian reference.
+	MaxCustodianLength = 256
+	// MaxReportHashLength is the maximum length of a reserve attestation's report hash.
+	MaxReportHashLength = 64
+)
+
+// NewReserveAttestors creates a new ReserveAttestors.
+func NewReserveAttestors(denom string, attestors []string) ReserveAttestors {
+	return ReserveAttestors{
+		Denom:     denom,
+		Attestors: attestors,
+	}
+}
+
+// Validate returns an error if this ReserveAttestors is not in a valid state.
+func (a ReserveAttestors) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+	if len(a.Attestors) == 0 {
+		return errors.New("at least one attestor is required")
+	}
+	return validateAttestors(a.Attestors)
+}
+
+// HasAttestor returns true if the provided address is one of the approved attestors.
+func (a ReserveAttestors) HasAttestor(addr string) bool {
+	return slices.Contains(a.Attestors, addr)
+}
+
+// validateAttestors returns an error if any of the provided attestors is invalid or repeated.
+func validateAttestors(attestors []string) error {
+	seen := make(map[string]bool, len(attestors))
+	for _, attestor := range attestors {
+		if _, err := sdk.AccAddressFromBech32(attestor); err != nil {
+			return fmt.Errorf("invalid attestor %q: %w", attestor, err)
+		}
+		if seen[attestor] {
+			return fmt.Errorf("duplicate attestor %s", attestor)
+		}
+		seen[attestor] = true
+	}
+	return nil
+}
+
+// Validate returns an error if this ReserveAttestation is not in a valid state.
+func (a ReserveAttestation) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(a.Attestor); err != nil {
+		return fmt.Errorf("invalid attestor: %w", err)
+	}
+	return validateAttestationReport(a.Amount, a.Custodian, a.ReportHash, a.ValidFrom, a.ValidUntil)
+}
+
+// ReportHashString returns the report hash of this ReserveAttestation as a hex string.
+func (a ReserveAttestation) ReportHashString() string {
+	return hex.EncodeToString(a.ReportHash)
+}
+
+// validateAttestationReport returns an error if any of the provided reserve attestation report details are invalid.
+func validateAttestationReport(amount sdk.Coin, custodian string, reportHash []byte, validFrom, validUntil time.Time) error {
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if len(strings.TrimSpace(custodian)) == 0 {
+		return errors.New("custodian cannot be empty")
+	}
+	if len(custodian) > MaxCustodianLength {
+		return fmt.Errorf("custodian length %d exceeds maximum length of %d", len(custodian), MaxCustodianLength)
+	}
+	if len(reportHash) == 0 {
+		return errors.New("report hash cannot be empty")
+	}
+	if len(reportHash) > MaxReportHashLength {
+		return fmt.Errorf("report hash length %d exceeds maximum length of %d", len(reportHash), MaxReportHashLength)
+	}
+	if !validUntil.After(validFrom) {
+		return fmt.Errorf("valid until %s must be after valid from %s",
+			validUntil.UTC().Format(time.RFC3339), validFrom.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestReserveRequirementValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    ReserveRequirement
+		expErr string
+	}{
+		{
+			name: "one to one",
+			req:  NewReserveRequirement("wrapped", []string{"uusdc"}, sdkmath.LegacyOneDec()),
+		},
+		{
+			name: "multiple reserve denoms",
+			req:  NewReserveRequirement("wrapped", []string{"uusdc", "uusdt"}, sdkmath.LegacyMustNewDecFromStr("0.5")),
+		},
+		{
+			name:   "invalid denom",
+			req:    NewReserveRequirement("x", []string{"uusdc"}, sdkmath.LegacyOneDec()),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "no reserve denoms",
+			req:    NewReserveRequirement("wrapped", nil, sdkmath.LegacyOneDec()),
+			expErr: "at least one reserve denom is required",
+		},
+		{
+			name:   "invalid reserve denom",
+			req:    NewReserveRequirement("wrapped", []string{"y"}, sdkmath.LegacyOneDec()),
+			expErr: "invalid reserve denom: invalid denom: y",
+		},
+		{
+			name:   "own denom",
+			req:    NewReserveRequirement("wrapped", []string{"wrapped"}, sdkmath.LegacyOneDec()),
+			expErr: "marker denom wrapped cannot be one of its own reserve denoms",
+		},
+		{
+			name:   "duplicate reserve denom",
+			req:    NewReserveRequirement("wrapped", []string{"uusdc", "uusdc"}, sdkmath.LegacyOneDec()),
+			expErr: "duplicate reserve denom uusdc",
+		},
+		{
+			name:   "nil ratio",
+			req:    ReserveRequirement{Denom: "wrapped", ReserveDenoms: []string{"uusdc"}},
+			expErr: "invalid reserve ratio \"<nil>\": must be positive",
+		},
+		{
+			name:   "negative ratio",
+			req:    NewReserveRequirement("wrapped", []string{"uusdc"}, sdkmath.LegacyNewDec(-1)),
+			expErr: "invalid reserve ratio \"-1.000000000000000000\": must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestReserveRequirementAmounts(t *testing.T) {
+	tests := []struct {
+		name        string
+		ratio       string
+		supply      int64
+		expRequired int64
+		reserves    int64
+		expMaxSup   int64
+	}{
+		{name: "one to one", ratio: "1", supply: 100, expRequired: 100, reserves: 100, expMaxSup: 100},
+		{name: "half backed", ratio: "0.5", supply: 101, expRequired: 51, reserves: 51, expMaxSup: 102},
+		{name: "over backed", ratio: "1.5", supply: 3, expRequired: 5, reserves: 10, expMaxSup: 6},
+		{name: "thirds", ratio: "0.333333333333333333", supply: 4, expRequired: 2, reserves: 1, expMaxSup: 3},
+		{name: "not enough for one", ratio: "2", supply: 0, expRequired: 0, reserves: 1, expMaxSup: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := NewReserveRequirement("wrapped", []string{"uusdc"}, sdkmath.LegacyMustNewDecFromStr(tc.ratio))
+			required := req.RequiredReserves(sdkmath.NewInt(tc.supply))
+			assert.Equal(t, sdkmath.NewInt(tc.expRequired).String(), required.String(), "RequiredReserves(%d)", tc.supply)
+			maxSupply := req.MaxBackedSupply(sdkmath.NewInt(tc.reserves))
+			assert.Equal(t, sdkmath.NewInt(tc.expMaxSup).String(), maxSupply.String(), "MaxBackedSupply(%d)", tc.reserves)
+		})
+	}
+}
+
+func TestReserveAttestorsValidate(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+
+	tests := []struct {
+		name      string
+		attestors ReserveAttestors
+		expErr    string
+	}{
+		{
+			name:      "valid",
+			attestors: NewReserveAttestors("wrapped", []string{addr1}),
+		},
+		{
+			name:      "invalid denom",
+			attestors: NewReserveAttestors("x", []string{addr1}),
+			expErr:    "invalid denom: x",
+		},
+		{
+			name:      "no attestors",
+			attestors: NewReserveAttestors("wrapped", nil),
+			expErr:    "at least one attestor is required",
+		},
+		{
+			name:      "duplicate attestor",
+			attestors: NewReserveAttestors("wrapped", []string{addr1, addr1}),
+			expErr:    "duplicate attestor " + addr1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.attestors.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestReserveAttestationValidate(t *testing.T) {
+	validFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newAttestation := func(modify func(a *ReserveAttestation)) ReserveAttestation {
+		rv := ReserveAttestation{
+			Denom:      "wrapped",
+			Attestor:   sdk.AccAddress("attestor____________").String(),
+			Amount:     sdk.NewInt64Coin("usd", 0),
+			Custodian:  "First Bank",
+			ReportHash: []byte{0x01},
+			ValidFrom:  validFrom,
+			ValidUntil: validFrom.Add(time.Second),
+			Height:     5,
+		}
+		if modify != nil {
+			modify(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name        string
+		attestation ReserveAttestation
+		expErr      string
+	}{
+		{
+			name:        "valid",
+			attestation: newAttestation(nil),
+		},
+		{
+			name:        "stale",
+			attestation: newAttestation(func(a *ReserveAttestation) { a.Stale = true }),
+		},
+		{
+			name:        "invalid denom",
+			attestation: newAttestation(func(a *ReserveAttestation) { a.Denom = "x" }),
+			expErr:      "invalid denom: x",
+		},
+		{
+			name:        "invalid attestor",
+			attestation: newAttestation(func(a *ReserveAttestation) { a.Attestor = "" }),
+			expErr:      "invalid attestor: empty address string is not allowed",
+		},
+		{
+			name:        "invalid amount",
+			attestation: newAttestation(func(a *ReserveAttestation) { a.Amount = sdk.Coin{Denom: "x", Amount: sdkmath.OneInt()} }),
+			expErr:      "invalid amount: invalid denom: x",
+		},
+		{
+			name:        "valid until before valid from",
+			attestation: newAttestation(func(a *ReserveAttestation) { a.ValidUntil = validFrom.Add(-time.Second) }),
+			expErr:      "valid until 2025-12-31T23:59:59Z must be after valid from 2026-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.attestation.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+// TransferRestrictionCode is an ERC-1404 style code for why a transfer is restricted.
+// These values are part of the public API and must not be changed.
+type TransferRestrictionCode uint32
+
+const (
+	// TransferRestrictionNone means the transfer is not restricted.
+	TransferRestrictionNone TransferRestrictionCode = 0
+	// TransferRestrictionInsufficientFunds means the sender doesn't have enough spendable funds.
+	TransferRestrictionInsufficientFunds TransferRestrictionCode = 1
+	// TransferRestrictionFromMarker means coins cannot be sent out of the marker account this way.
+	TransferRestrictionFromMarker TransferRestrictionCode = 2
+	// TransferRestrictionToMarker means the sender needs deposit access to send to the marker account.
+	TransferRestrictionToMarker TransferRestrictionCode = 3
+	// TransferRestrictionMarkerNotActive means the coin's marker is not active.
+	TransferRestrictionMarkerNotActive TransferRestrictionCode = 4
+	// TransferRestrictionFeeCollector means restricted coins cannot go to the fee collector.
+	TransferRestrictionFeeCollector TransferRestrictionCode = 5
+	// TransferRestrictionSenderDenied means the sender is on the marker's send-deny list.
+	TransferRestrictionSenderDenied TransferRestrictionCode = 6
+	// TransferRestrictionSenderFrozen means the sender is frozen for missing the marker's required attributes.
+	TransferRestrictionSenderFrozen TransferRestrictionCode = 7
+	// TransferRestrictionNoTransferAccess means the sender needs transfer access on the marker.
+	TransferRestrictionNoTransferAccess TransferRestrictionCode = 8
+	// TransferRestrictionRecipientAttributes means the recipient doesn't have the marker's required attributes.
+	TransferRestrictionRecipientAttributes TransferRestrictionCode = 9
+	// TransferRestrictionOther means the transfer is restricted for some other reason.
+	TransferRestrictionOther TransferRestrictionCode = 255
+)
+
+// transferRestrictionMessages are the messages of each of the transfer restriction codes.
+var transferRestrictionMessages = map[TransferRestrictionCode]string{
+	TransferRestrictionNone:                "SUCCESS",
+	TransferRestrictionInsufficientFunds:   "sender does not have enough spendable funds",
+	TransferRestrictionFromMarker:          "coins cannot be sent from a marker account without withdraw access",
+	TransferRestrictionToMarker:            "sender needs deposit access to send to the marker account",
+	TransferRestrictionMarkerNotActive:     "the coin's marker is not active",
+	TransferRestrictionFeeCollector:        "restricted coins cannot be sent to the fee collector",
+	TransferRestrictionSenderDenied:        "sender is on the marker's send-deny list",
+	TransferRestrictionSenderFrozen:        "sender is frozen until it has the marker's required attributes",
+	TransferRestrictionNoTransferAccess:    "sender needs transfer access on the marker",
+	TransferRestrictionRecipientAttributes: "recipient does not have the marker's required attributes",
+	TransferRestrictionOther:               "transfer is restricted",
+}
+
+// Message returns the human-readable message of this code, or "unknown restriction code" if it isn't a known code.
+func (c TransferRestrictionCode) Message() string {
+	if msg, ok := transferRestrictionMessages[c]; ok {
+		return msg
+	}
+	return "unknown restriction code"
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferRestrictionCodeMessage(t *testing.T) {
+	tests := []struct {
+		code TransferRestrictionCode
+		exp  string
+	}{
+		{code: TransferRestrictionNone, exp: "SUCCESS"},
+		{code: TransferRestrictionInsufficientFunds, exp: "sender does not have enough spendable funds"},
+		{code: TransferRestrictionRecipientAttributes, exp: "recipient does not have the marker's required attributes"},
+		{code: TransferRestrictionOther, exp: "transfer is restricted"},
+		{code: 10, exp: "unknown restriction code"},
+		{code: 254, exp: "unknown restriction code"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.exp, func(t *testing.T) {
+			assert.Equal(t, tc.exp, tc.code.Message(), "TransferRestrictionCode(%d).Message()", tc.code)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// RoleIssuer is the name of the default role template for accounts that manage the supply of a marker.
+	RoleIssuer = "issuer"
+	// RoleRegistrar is the name of the default role template for accounts that administer a marker.
+	RoleRegistrar = "registrar"
+	// RoleCompliance is the name of the default role template for accounts that control transfers of a marker.
+	RoleCompliance = "compliance"
+	// RoleTreasury is the name of the default role template for accounts that move funds in and out of a marker.
+	RoleTreasury = "treasury"
+
+	// MaxRoleNameLength is the maximum length of a role template name.
+	MaxRoleNameLength = 32
+)
+
+// NewRoleTemplate creates a new RoleTemplate.
+func NewRoleTemplate(name string, permissions ...Access) RoleTemplate {
+	return RoleTemplate{
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+// DefaultRoleTemplates returns the role templates that are defined in the default params.
+func DefaultRoleTemplates() []RoleTemplate {
+	return []RoleTemplate{
+		NewRoleTemplate(RoleIssuer, Access_Mint, Access_Burn, Access_Withdraw),
+		NewRoleTemplate(RoleRegistrar, Access_Admin),
+		NewRoleTemplate(RoleCompliance, Access_Transfer, Access_ForceTransfer),
+		NewRoleTemplate(RoleTreasury, Access_Deposit, Access_Withdraw),
+	}
+}
+
+// NormalizeRoleName returns the provided role name in the form that it is stored.
+func NormalizeRoleName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// validateRoleName returns an error if the provided role name is not valid.
+func validateRoleName(name string) error {
+	if len(name) == 0 {
+		return errors.New("role name cannot be empty")
+	}
+	if len(name) > MaxRoleNameLength {
+		return fmt.Errorf("role name %q cannot be longer than %d characters", name, MaxRoleNameLength)
+	}
+	if name != NormalizeRoleName(name) || strings.ContainsAny(name, ", ;") {
+		return fmt.Errorf("role name %q must be lowercase and cannot contain spaces, commas, or semicolons", name)
+	}
+	return nil
+}
+
+// validateRoleNames returns an error if any of the role names are invalid or duplicated.
+func validateRoleNames(names []string) error {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if err := validateRoleName(name); err != nil {
+			return err
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate role %q", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Validate returns an error if this role template is not valid.
+func (r RoleTemplate) Validate() error {
+	if err := validateRoleName(r.Name); err != nil {
+		return err
+	}
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("role %q must have at least one permission", r.Name)
+	}
+	if err := validateAccess(r.Permissions); err != nil {
+		return fmt.Errorf("role %q: %w", r.Name, err)
+	}
+	return nil
+}
+
+// ValidateRoleTemplates returns an error if any of the role templates are invalid or have the same name.
+func ValidateRoleTemplates(templates []RoleTemplate) error {
+	seen := make(map[string]bool)
+	for i, template := range templates {
+		if err := template.Validate(); err != nil {
+			return fmt.Errorf("invalid role template[%d]: %w", i, err)
+		}
+		if seen[template.Name] {
+			return fmt.Errorf("invalid role template[%d]: duplicate role %q", i, template.Name)
+		}
+		seen[template.Name] = true
+	}
+	return nil
+}
+
+// FindRoleTemplate returns the role template with the provided name, and whether it was found.
+func FindRoleTemplate(templates []RoleTemplate, name string) (RoleTemplate, bool) {
+	name = NormalizeRoleName(name)
+	for _, template := range templates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return RoleTemplate{}, false
+}
+
+// ExpandRoles returns a copy of the provided grant with the permissions of each of its roles added to it.
+// An error is returned if the grant has a role that isn't one of the provided role templates.
+func ExpandRoles(templates []RoleTemplate, grant AccessGrant) (AccessGrant, error) {
+	if len(grant.Roles) == 0 {
+		return grant, nil
+	}
+	rv := AccessGrant{
+		Address:     grant.Address,
+		Permissions: append(AccessList{}, grant.Permissions...),
+		Roles:       make([]string, len(grant.Roles)),
+	}
+	for i, role := range grant.Roles {
+		template, found := FindRoleTemplate(templates, role)
+		if !found {
+			return AccessGrant{}, fmt.Errorf("unknown role %q", role)
+		}
+		rv.Roles[i] = template.Name
+		for _, access := range template.Permissions {
+			if !hasAccess(rv.Permissions, access) {
+				rv.Permissions = append(rv.Permissions, access)
+			}
+		}
+	}
+	return rv, nil
+}
+
+// UpdateGrantRoles returns a copy of the provided grant updated for a change from the old role templates to the new
+// ones, and whether anything changed. For each of the grant's roles, permissions added to the role's template are
+// added to the grant, and permissions removed from the role's template are removed from the grant unless another
+// of the grant's roles still includes them. Roles that no longer have a template are removed from the grant, but
+// the grant keeps their permissions. Only permissions supported by the provided marker type are added.
+func UpdateGrantRoles(grant AccessGrant, markerType MarkerType, oldTemplates, newTemplates []RoleTemplate) (AccessGrant, bool) {
+	if len(grant.Roles) == 0 {
+		return grant, false
+	}
+
+	rv := AccessGrant{Address: grant.Address}
+	var toAdd, toRemove AccessList
+	for _, role := range grant.Roles {
+		newTemplate, found := FindRoleTemplate(newTemplates, role)
+		if !found {
+			continue
+		}
+		rv.Roles = append(rv.Roles, role)
+		oldTemplate, _ := FindRoleTemplate(oldTemplates, role)
+		for _, access := range newTemplate.Permissions {
+			if !hasAccess(oldTemplate.Permissions, access) {
+				toAdd = append(toAdd, access)
+			}
+		}
+		for _, access := range oldTemplate.Permissions {
+			if !hasAccess(newTemplate.Permissions, access) {
+				toRemove = append(toRemove, access)
+			}
+		}
+	}
+
+	// Keep any permissions that are still part of one of the grant's roles.
+	for _, role := range rv.Roles {
+		template, _ := FindRoleTemplate(newTemplates, role)
+		var stillRemoving AccessList
+		for _, access := range toRemove {
+			if !hasAccess(template.Permissions, access) {
+				stillRemoving = append(stillRemoving, access)
+			}
+		}
+		toRemove = stillRemoving
+	}
+
+	for _, access := range grant.Permissions {
+		if !hasAccess(toRemove, access) {
+			rv.Permissions = append(rv.Permissions, access)
+		}
+	}
+	for _, access := range toAdd {
+		if !hasAccess(rv.Permissions, access) && markerType.SupportsAccess(access) {
+			rv.Permissions = append(rv.Permissions, access)
+		}
+	}
+
+	return rv, !rv.Equal(grant)
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestRoleTemplateValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   RoleTemplate
+		expErr string
+	}{
+		{
+			name: "control",
+			role: NewRoleTemplate(RoleIssuer, Access_Mint, Access_Burn),
+		},
+		{
+			name:   "empty name",
+			role:   NewRoleTemplate("", Access_Mint),
+			expErr: "role name cannot be empty",
+		},
+		{
+			name:   "name too long",
+			role:   NewRoleTemplate("abcdefghijklmnopqrstuvwxyz1234567", Access_Mint),
+			expErr: "role name \"abcdefghijklmnopqrstuvwxyz1234567\" cannot be longer than 32 characters",
+		},
+		{
+			name:   "uppercase name",
+			role:   NewRoleTemplate("Issuer", Access_Mint),
+			expErr: "role name \"Issuer\" must be lowercase and cannot contain spaces, commas, or semicolons",
+		},
+		{
+			name:   "name with a comma",
+			role:   NewRoleTemplate("issuer,treasury", Access_Mint),
+			expErr: "role name \"issuer,treasury\" must be lowercase and cannot contain spaces, commas, or semicolons",
+		},
+		{
+			name:   "no permissions",
+			role:   NewRoleTemplate(RoleIssuer),
+			expErr: "role \"issuer\" must have at least one permission",
+		},
+		{
+			name:   "duplicate permissions",
+			role:   NewRoleTemplate(RoleIssuer, Access_Mint, Access_Mint),
+			expErr: "role \"issuer\": " + ErrDuplicateAccessEntry.Error(),
+		},
+		{
+			name:   "unknown permission",
+			role:   NewRoleTemplate(RoleIssuer, Access_Unknown),
+			expErr: "role \"issuer\": " + ErrAccessTypeInvalid.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.role.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestDefaultRoleTemplates(t *testing.T) {
+	roles := DefaultRoleTemplates()
+	require.NoError(t, ValidateRoleTemplates(roles), "ValidateRoleTemplates(DefaultRoleTemplates())")
+	for _, name := range []string{RoleIssuer, RoleRegistrar, RoleCompliance, RoleTreasury} {
+		_, found := FindRoleTemplate(roles, name)
+		assert.True(t, found, "FindRoleTemplate(%q)", name)
+	}
+}
+
+func TestExpandRoles(t *testing.T) {
+	addr := MustGetMarkerAddress("test").String()
+	templates := DefaultRoleTemplates()
+	newGrant := func(roles []string, perms ...Access) AccessGrant {
+		return AccessGrant{Address: addr, Permissions: perms, Roles: roles}
+	}
+
+	tests := []struct {
+		name   string
+		grant  AccessGrant
+		exp    AccessGrant
+		expErr string
+	}{
+		{
+			name:  "no roles",
+			grant: newGrant(nil, Access_Admin),
+			exp:   newGrant(nil, Access_Admin),
+		},
+		{
+			name:  "one role",
+			grant: newGrant([]string{RoleTreasury}),
+			exp:   newGrant([]string{RoleTreasury}, Access_Deposit, Access_Withdraw),
+		},
+		{
+			name:  "overlapping roles and permissions",
+			grant: newGrant([]string{RoleIssuer, RoleTreasury}, Access_Admin, Access_Mint),
+			exp:   newGrant([]string{RoleIssuer, RoleTreasury}, Access_Admin, Access_Mint, Access_Burn, Access_Withdraw, Access_Deposit),
+		},
+		{
+			name:  "role name is normalized",
+			grant: newGrant([]string{" Registrar"}),
+			exp:   newGrant([]string{RoleRegistrar}, Access_Admin),
+		},
+		{
+			name:   "unknown role",
+			grant:  newGrant([]string{RoleIssuer, "auditor"}),
+			expErr: "unknown role \"auditor\"",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orig := tc.grant.String()
+			grant, err := ExpandRoles(templates, tc.grant)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ExpandRoles")
+			assert.Equal(t, tc.exp, grant, "ExpandRoles result")
+			assert.Equal(t, orig, tc.grant.String(), "provided grant after ExpandRoles")
+		})
+	}
+}
+
+func TestUpdateGrantRoles(t *testing.T) {
+	addr := MustGetMarkerAddress("test").String()
+	newGrant := func(roles []string, perms ...Access) AccessGrant {
+		return AccessGrant{Address: addr, Permissions: perms, Roles: roles}
+	}
+	oldTemplates := []RoleTemplate{
+		NewRoleTemplate(RoleIssuer, Access_Mint, Access_Burn),
+		NewRoleTemplate(RoleCompliance, Access_Transfer),
+		NewRoleTemplate(RoleTreasury, Access_Deposit, Access_Withdraw),
+		NewRoleTemplate("redeemer", Access_Burn),
+	}
+	newTemplates := []RoleTemplate{
+		NewRoleTemplate(RoleIssuer, Access_Mint, Access_Withdraw),
+		NewRoleTemplate(RoleCompliance, Access_Transfer, Access_ForceTransfer),
+		NewRoleTemplate(RoleTreasury, Access_Deposit, Access_Withdraw),
+		NewRoleTemplate("redeemer", Access_Burn),
+	}
+
+	tests := []struct {
+		name       string
+		grant      AccessGrant
+		markerType MarkerType
+		exp        AccessGrant
+		expChanged bool
+	}{
+		{
+			name:  "no roles",
+			grant: newGrant(nil, Access_Burn),
+			exp:   newGrant(nil, Access_Burn),
+		},
+		{
+			name:  "unchanged role",
+			grant: newGrant([]string{RoleTreasury}, Access_Deposit, Access_Withdraw),
+			exp:   newGrant([]string{RoleTreasury}, Access_Deposit, Access_Withdraw),
+		},
+		{
+			name:       "permissions added and removed",
+			grant:      newGrant([]string{RoleIssuer}, Access_Admin, Access_Mint, Access_Burn),
+			markerType: MarkerType_Coin,
+			exp:        newGrant([]string{RoleIssuer}, Access_Admin, Access_Mint, Access_Withdraw),
+			expChanged: true,
+		},
+		{
+			name:       "removed permission still provided by another role",
+			grant:      newGrant([]string{RoleIssuer, "redeemer"}, Access_Mint, Access_Burn),
+			markerType: MarkerType_Coin,
+			exp:        newGrant([]string{RoleIssuer, "redeemer"}, Access_Mint, Access_Burn, Access_Withdraw),
+			expChanged: true,
+		},
+		{
+			name:       "added permission not supported by marker type",
+			grant:      newGrant([]string{RoleCompliance}, Access_Deposit),
+			markerType: MarkerType_Coin,
+			exp:        newGrant([]string{RoleCompliance}, Access_Deposit),
+		},
+		{
+			name:       "added permission supported by marker type",
+			grant:      newGrant([]string{RoleCompliance}, Access_Transfer),
+			markerType: MarkerType_RestrictedCoin,
+			exp:        newGrant([]string{RoleCompliance}, Access_Transfer, Access_ForceTransfer),
+			expChanged: true,
+		},
+		{
+			name:       "role template removed",
+			grant:      newGrant([]string{"auditor", RoleTreasury}, Access_Admin, Access_Deposit, Access_Withdraw),
+			markerType: MarkerType_Coin,
+			exp:        newGrant([]string{RoleTreasury}, Access_Admin, Access_Deposit, Access_Withdraw),
+			expChanged: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			grant, changed := UpdateGrantRoles(tc.grant, tc.markerType, oldTemplates, newTemplates)
+			assert.Equal(t, tc.expChanged, changed, "UpdateGrantRoles changed")
+			assert.True(t, tc.exp.Equal(grant), "UpdateGrantRoles result\nExpected: %s\n  Actual: %s", tc.exp, grant)
+		})
+	}
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 var (
-	bypassKey        = "bypass-marker-restriction"
-	transferAgentKey = "marker-transfer-agents"
+	bypassKey               = "bypass-marker-restriction"
+	receiptBypassKey        = "bypass-marker-receipt"
+	transferAgentKey        = "marker-transfer-agents"
+	attributeProofKey       = "marker-attribute-proofs"
+	sendRestrictionCacheKey = "marker-send-restriction-cache"
 )
 
 // WithBypass returns a new context that will cause the marker bank send restriction to be skipped.
@@ -36,6 +41,33 @@ func HasBypass[C context.Context](ctx C) bool {
 	return isBool && bypass
 }
 
+// WithReceiptBypass returns a new context that will cause coins with a receipt policy to be sent directly
+// to their recipient instead of being held until the recipient acknowledges them.
+func WithReceiptBypass[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(receiptBypassKey, true)
+	return context.Context(sdkCtx).(C)
+}
+
+// WithoutReceiptBypass returns a new context that will cause coins with a receipt policy to be held until
+// their recipient acknowledges them.
+func WithoutReceiptBypass[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(receiptBypassKey, false)
+	return context.Context(sdkCtx).(C)
+}
+
+// HasReceiptBypass checks the context to see if coins with a receipt policy should be sent without being held.
+func HasReceiptBypass[C context.Context](ctx C) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bypassValue := sdkCtx.Value(receiptBypassKey)
+	if bypassValue == nil {
+		return false
+	}
+	bypass, isBool := bypassValue.(bool)
+	return isBool && bypass
+}
+
 // WithTransferAgents returns a new context that contains the provided marker transfer agent.
 // This will overwrite any existing transfer agents in the context.
 func WithTransferAgents[C context.Context](ctx C, transferAgents ...sdk.AccAddress) C {
@@ -51,7 +83,28 @@ func WithoutTransferAgents[C context.Context](ctx C) C {
 	return context.Context(sdkCtx).(C)
 }
 
+// AddTransferAgents returns a new context that contains the marker transfer agents already in the provided
+// context along with the provided ones. Use this (instead of WithTransferAgents) when a call might be nested
+// inside another that has already set transfer agents (e.g. wasm -> exchange -> bank), so they aren't lost.
+// The provided context is not altered, so the added agents only apply to uses of the returned context.
+func AddTransferAgents[C context.Context](ctx C, transferAgents ...sdk.AccAddress) C {
+	if len(transferAgents) == 0 {
+		return ctx
+	}
+	existing := GetTransferAgents(ctx)
+	// Always make a new slice so that the agents of an outer scope are never modified.
+	agents := make([]sdk.AccAddress, 0, len(existing)+len(transferAgents))
+	agents = append(agents, existing...)
+	for _, agent := range transferAgents {
+		if len(agent) > 0 && !containsAddr(agents, agent) {
+			agents = append(agents, agent)
+		}
+	}
+	return WithTransferAgents(ctx, agents...)
+}
+
 // GetTransferAgents gets the marker transfer agents from the provided context.
+// The returned slice is a copy, so changing it will not alter the context's transfer agents.
 func GetTransferAgents[C context.Context](ctx C) []sdk.AccAddress {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	val := sdkCtx.Value(transferAgentKey)
@@ -59,5 +112,123 @@ func GetTransferAgents[C context.Context](ctx C) []sdk.AccAddress {
 		return nil
 	}
 	rv, _ := val.([]sdk.AccAddress)
+	if len(rv) == 0 {
+		return rv
+	}
+	return append(make([]sdk.AccAddress, 0, len(rv)), rv...)
+}
+
+// containsAddr returns true if the provided addr is in the list of addrs.
+func containsAddr(addrs []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAttributeProofs returns a new context that contains the provided attribute proofs.
+// The marker send restriction will use these when the receiver is missing required attributes.
+// This will overwrite any existing attribute proofs in the context.
+func WithAttributeProofs[C context.Context](ctx C, proofs ...AttributeProof) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(attributeProofKey, proofs)
+	return context.Context(sdkCtx).(C)
+}
+
+// WithoutAttributeProofs returns a new context without any attribute proofs.
+func WithoutAttributeProofs[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(attributeProofKey, []AttributeProof(nil))
+	return context.Context(sdkCtx).(C)
+}
+
+// GetAttributeProofs gets the attribute proofs from the provided context.
+func GetAttributeProofs[C context.Context](ctx C) []AttributeProof {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	val := sdkCtx.Value(attributeProofKey)
+	if val == nil {
+		return nil
+	}
+	rv, _ := val.([]AttributeProof)
+	return rv
+}
+
+// SendRestrictionCache holds the marker and attribute lookups made by the marker send restriction so that they
+// can be reused across the outputs of a single multi-send. It is not updated when the underlying state changes,
+// so it should only be in a context that is used for a single bank send (e.g. one MsgMultiSend).
+// A nil SendRestrictionCache is valid; it just never has anything in it.
+type SendRestrictionCache struct {
+	markers    map[string]MarkerAccountI
+	attributes map[string][]attrtypes.Attribute
+}
+
+// NewSendRestrictionCache creates a new, empty, SendRestrictionCache.
+func NewSendRestrictionCache() *SendRestrictionCache {
+	return &SendRestrictionCache{
+		markers:    make(map[string]MarkerAccountI),
+		attributes: make(map[string][]attrtypes.Attribute),
+	}
+}
+
+// GetMarker returns the marker cached under the provided key, and whether there was one.
+// A cached marker can be nil, indicating that the marker doesn't exist.
+func (c *SendRestrictionCache) GetMarker(key string) (MarkerAccountI, bool) {
+	if c == nil {
+		return nil, false
+	}
+	marker, found := c.markers[key]
+	return marker, found
+}
+
+// SetMarker caches the provided marker under the provided key.
+func (c *SendRestrictionCache) SetMarker(key string, marker MarkerAccountI) {
+	if c != nil {
+		c.markers[key] = marker
+	}
+}
+
+// GetAttributes returns the cached attributes of the provided address, and whether they were cached.
+func (c *SendRestrictionCache) GetAttributes(addr sdk.AccAddress) ([]attrtypes.Attribute, bool) {
+	if c == nil {
+		return nil, false
+	}
+	attrs, found := c.attributes[string(addr)]
+	return attrs, found
+}
+
+// SetAttributes caches the provided attributes for the provided address.
+func (c *SendRestrictionCache) SetAttributes(addr sdk.AccAddress, attrs []attrtypes.Attribute) {
+	if c != nil {
+		c.attributes[string(addr)] = attrs
+	}
+}
+
+// WithSendRestrictionCache returns a new context that contains a new, empty, SendRestrictionCache.
+// The marker send restriction will use it to reuse lookups across the outputs of a multi-send.
+// This will overwrite any existing cache in the context.
+func WithSendRestrictionCache[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(sendRestrictionCacheKey, NewSendRestrictionCache())
+	return context.Context(sdkCtx).(C)
+}
+
+// WithoutSendRestrictionCache returns a new context without a SendRestrictionCache.
+func WithoutSendRestrictionCache[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(sendRestrictionCacheKey, (*SendRestrictionCache)(nil))
+	return context.Context(sdkCtx).(C)
+}
+
+// GetSendRestrictionCache gets the SendRestrictionCache from the provided context.
+// If the context doesn't have one, nil is returned (which can still be used, it just never has anything in it).
+func GetSendRestrictionCache[C context.Context](ctx C) *SendRestrictionCache {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	val := sdkCtx.Value(sendRestrictionCacheKey)
+	if val == nil {
+		return nil
+	}
+	rv, _ := val.(*SendRestrictionCache)
 	return rv
 }
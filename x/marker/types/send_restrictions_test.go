@@ -9,11 +9,15 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 func TestKeysContainModuleName(t *testing.T) {
 	assert.Contains(t, bypassKey, ModuleName, "bypassKey")
 	assert.Contains(t, transferAgentKey, ModuleName, "transferAgentKey")
+	assert.Contains(t, attributeProofKey, ModuleName, "attributeProofKey")
+	assert.Contains(t, sendRestrictionCacheKey, ModuleName, "sendRestrictionCacheKey")
 }
 
 func TestContextCombos(t *testing.T) {
@@ -132,6 +136,36 @@ func TestBypassFuncsDoNotModifyProvided(t *testing.T) {
 	assert.False(t, HasBypass(origCtx), "HasBypass(origCtx) after giving afterWith to WithoutBypass")
 }
 
+func TestReceiptBypassFuncs(t *testing.T) {
+	newCtx := func() sdk.Context {
+		return sdk.NewContext(nil, cmtproto.Header{}, false, nil)
+	}
+
+	tests := []struct {
+		name string
+		ctx  sdk.Context
+		exp  bool
+	}{
+		{name: "brand new mostly empty context", ctx: newCtx(), exp: false},
+		{name: "context with receipt bypass", ctx: WithReceiptBypass(newCtx()), exp: true},
+		{name: "context with receipt bypass on one that originally was without it", ctx: WithReceiptBypass(WithoutReceiptBypass(newCtx())), exp: true},
+		{name: "context without receipt bypass", ctx: WithoutReceiptBypass(newCtx()), exp: false},
+		{name: "context without receipt bypass on one that originally had it", ctx: WithoutReceiptBypass(WithReceiptBypass(newCtx())), exp: false},
+		{name: "context with the marker restriction bypass", ctx: WithBypass(newCtx()), exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = HasReceiptBypass(tc.ctx)
+			}
+			require.NotPanics(t, testFunc, "HasReceiptBypass")
+			assert.Equal(t, tc.exp, actual, "HasReceiptBypass")
+		})
+	}
+}
+
 func TestTransferAgentFuncs(t *testing.T) {
 	newCtx := func() sdk.Context {
 		return sdk.NewContext(nil, cmtproto.Header{}, false, nil)
@@ -248,3 +282,213 @@ func TestTransferAgentFuncsDoNotModifyProvided(t *testing.T) {
 	assert.Equal(t, expAgents, GetTransferAgents(afterWith), "GetTransferAgents(afterWith) after giving it to WithoutTransferAgents")
 	assert.Nil(t, GetTransferAgents(origCtx), "GetTransferAgents(origCtx) after giving afterWith to WithoutTransferAgents")
 }
+
+func TestAddTransferAgents(t *testing.T) {
+	newCtx := func() sdk.Context {
+		return sdk.NewContext(nil, cmtproto.Header{}, false, nil)
+	}
+	agent1 := sdk.AccAddress("transfer_agent_one__")
+	agent2 := sdk.AccAddress("transfer_agent_two__")
+	agent3 := sdk.AccAddress("transfer_agent_three")
+
+	tests := []struct {
+		name string
+		ctx  sdk.Context
+		exp  []sdk.AccAddress
+	}{
+		{
+			name: "nothing added to brand new context",
+			ctx:  AddTransferAgents(newCtx()),
+			exp:  nil,
+		},
+		{
+			name: "one added to brand new context",
+			ctx:  AddTransferAgents(newCtx(), agent1),
+			exp:  []sdk.AccAddress{agent1},
+		},
+		{
+			name: "one added to context with one",
+			ctx:  AddTransferAgents(WithTransferAgents(newCtx(), agent1), agent2),
+			exp:  []sdk.AccAddress{agent1, agent2},
+		},
+		{
+			name: "nothing added to context with two",
+			ctx:  AddTransferAgents(WithTransferAgents(newCtx(), agent1, agent2)),
+			exp:  []sdk.AccAddress{agent1, agent2},
+		},
+		{
+			name: "existing and new ones added to context with two",
+			ctx:  AddTransferAgents(WithTransferAgents(newCtx(), agent1, agent2), agent2, agent3, agent1),
+			exp:  []sdk.AccAddress{agent1, agent2, agent3},
+		},
+		{
+			name: "empty address added",
+			ctx:  AddTransferAgents(WithTransferAgents(newCtx(), agent1), sdk.AccAddress{}, agent2),
+			exp:  []sdk.AccAddress{agent1, agent2},
+		},
+		{
+			name: "added three levels deep",
+			ctx:  AddTransferAgents(AddTransferAgents(AddTransferAgents(newCtx(), agent1), agent2), agent3),
+			exp:  []sdk.AccAddress{agent1, agent2, agent3},
+		},
+		{
+			name: "added to context without transfer agents",
+			ctx:  AddTransferAgents(WithoutTransferAgents(WithTransferAgents(newCtx(), agent1)), agent2),
+			exp:  []sdk.AccAddress{agent2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := GetTransferAgents(tc.ctx)
+			assert.Equal(t, tc.exp, actual, "GetTransferAgents")
+		})
+	}
+}
+
+func TestAddTransferAgentsDoesNotModifyProvided(t *testing.T) {
+	agent1 := sdk.AccAddress("transfer_agent_one__")
+	agent2 := sdk.AccAddress("transfer_agent_two__")
+	agent3 := sdk.AccAddress("transfer_agent_three")
+
+	// Make sure the outer slice has extra capacity so that an append would write to its backing array.
+	outerAgents := make([]sdk.AccAddress, 1, 5)
+	outerAgents[0] = agent1
+	outerCtx := WithTransferAgents(sdk.NewContext(nil, cmtproto.Header{}, false, nil), outerAgents...)
+
+	innerCtx2 := AddTransferAgents(outerCtx, agent2)
+	innerCtx3 := AddTransferAgents(outerCtx, agent3)
+	assert.Equal(t, []sdk.AccAddress{agent1}, GetTransferAgents(outerCtx), "GetTransferAgents(outerCtx)")
+	assert.Equal(t, []sdk.AccAddress{agent1, agent2}, GetTransferAgents(innerCtx2), "GetTransferAgents(innerCtx2)")
+	assert.Equal(t, []sdk.AccAddress{agent1, agent3}, GetTransferAgents(innerCtx3), "GetTransferAgents(innerCtx3)")
+
+	agents := GetTransferAgents(innerCtx2)
+	agents[0] = agent3
+	assert.Equal(t, []sdk.AccAddress{agent1, agent2}, GetTransferAgents(innerCtx2), "GetTransferAgents(innerCtx2) after changing a returned slice")
+}
+
+func TestAttributeProofFuncs(t *testing.T) {
+	newCtx := func() sdk.Context {
+		return sdk.NewContext(nil, cmtproto.Header{}, false, nil)
+	}
+	proof1 := NewAttributeProof("verifier1", sdk.AccAddress("proof_account_1_____"), []byte("proof1"))
+	proof2 := NewAttributeProof("verifier2", sdk.AccAddress("proof_account_2_____"), []byte("proof2"))
+
+	tests := []struct {
+		name string
+		ctx  sdk.Context
+		exp  []AttributeProof
+	}{
+		{
+			name: "brand new mostly empty context",
+			ctx:  newCtx(),
+			exp:  nil,
+		},
+		{
+			name: "context with one proof",
+			ctx:  WithAttributeProofs(newCtx(), proof1),
+			exp:  []AttributeProof{proof1},
+		},
+		{
+			name: "context with two proofs",
+			ctx:  WithAttributeProofs(newCtx(), proof1, proof2),
+			exp:  []AttributeProof{proof1, proof2},
+		},
+		{
+			name: "context with proofs on one that already had some",
+			ctx:  WithAttributeProofs(WithAttributeProofs(newCtx(), proof1), proof2),
+			exp:  []AttributeProof{proof2},
+		},
+		{
+			name: "context without proofs on one that had some",
+			ctx:  WithoutAttributeProofs(WithAttributeProofs(newCtx(), proof1, proof2)),
+			exp:  nil,
+		},
+		{
+			name: "context with proofs and transfer agents and bypass",
+			ctx:  WithBypass(WithTransferAgents(WithAttributeProofs(newCtx(), proof2), sdk.AccAddress("some_transfer_agent_"))),
+			exp:  []AttributeProof{proof2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []AttributeProof
+			testFunc := func() {
+				actual = GetAttributeProofs(tc.ctx)
+			}
+			require.NotPanics(t, testFunc, "GetAttributeProofs")
+			assert.Equal(t, tc.exp, actual, "GetAttributeProofs")
+		})
+	}
+}
+
+func TestSendRestrictionCacheFuncs(t *testing.T) {
+	newCtx := func() sdk.Context {
+		return sdk.NewContext(nil, cmtproto.Header{}, false, nil)
+	}
+
+	t.Run("brand new mostly empty context", func(t *testing.T) {
+		assert.Nil(t, GetSendRestrictionCache(newCtx()), "GetSendRestrictionCache")
+	})
+
+	t.Run("context with a cache", func(t *testing.T) {
+		ctx := WithSendRestrictionCache(newCtx())
+		cache := GetSendRestrictionCache(ctx)
+		require.NotNil(t, cache, "GetSendRestrictionCache")
+		assert.Same(t, cache, GetSendRestrictionCache(ctx), "GetSendRestrictionCache second call")
+		assert.NotSame(t, cache, GetSendRestrictionCache(WithSendRestrictionCache(ctx)), "GetSendRestrictionCache after another WithSendRestrictionCache")
+	})
+
+	t.Run("context without a cache on one that had one", func(t *testing.T) {
+		ctx := WithoutSendRestrictionCache(WithSendRestrictionCache(newCtx()))
+		assert.Nil(t, GetSendRestrictionCache(ctx), "GetSendRestrictionCache")
+	})
+
+	t.Run("cache with other context values", func(t *testing.T) {
+		ctx := WithBypass(WithTransferAgents(WithSendRestrictionCache(newCtx()), sdk.AccAddress("some_transfer_agent_")))
+		assert.NotNil(t, GetSendRestrictionCache(ctx), "GetSendRestrictionCache")
+		assert.True(t, HasBypass(ctx), "HasBypass")
+		assert.Equal(t, []sdk.AccAddress{sdk.AccAddress("some_transfer_agent_")}, GetTransferAgents(ctx), "GetTransferAgents")
+	})
+}
+
+func TestSendRestrictionCache(t *testing.T) {
+	addr := sdk.AccAddress("cached_address______")
+	marker := NewEmptyMarkerAccount("cachedcoin", addr.String(), nil)
+	attrs := []attrtypes.Attribute{{Name: "cached.attr", Address: addr.String()}}
+
+	t.Run("nil cache", func(t *testing.T) {
+		var cache *SendRestrictionCache
+		require.NotPanics(t, func() { cache.SetMarker("key", marker) }, "SetMarker")
+		require.NotPanics(t, func() { cache.SetAttributes(addr, attrs) }, "SetAttributes")
+		actMarker, found := cache.GetMarker("key")
+		assert.False(t, found, "GetMarker found")
+		assert.Nil(t, actMarker, "GetMarker marker")
+		actAttrs, found := cache.GetAttributes(addr)
+		assert.False(t, found, "GetAttributes found")
+		assert.Nil(t, actAttrs, "GetAttributes attributes")
+	})
+
+	t.Run("new cache", func(t *testing.T) {
+		cache := NewSendRestrictionCache()
+		_, found := cache.GetMarker("key")
+		assert.False(t, found, "GetMarker found before SetMarker")
+		_, found = cache.GetAttributes(addr)
+		assert.False(t, found, "GetAttributes found before SetAttributes")
+
+		cache.SetMarker("key", marker)
+		cache.SetMarker("nil", nil)
+		cache.SetAttributes(addr, attrs)
+
+		actMarker, found := cache.GetMarker("key")
+		assert.True(t, found, "GetMarker(key) found")
+		assert.Equal(t, marker, actMarker, "GetMarker(key) marker")
+		actMarker, found = cache.GetMarker("nil")
+		assert.True(t, found, "GetMarker(nil) found")
+		assert.Nil(t, actMarker, "GetMarker(nil) marker")
+		actAttrs, found := cache.GetAttributes(addr)
+		assert.True(t, found, "GetAttributes found")
+		assert.Equal(t, attrs, actAttrs, "GetAttributes attributes")
+	})
+}
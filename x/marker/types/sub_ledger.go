@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSubAccountLength is the maximum length of a sub-account name.
+const MaxSubAccountLength = 64
+
+// ValidateSubAccount returns an error if the provided sub-account name cannot be recorded in a sub-ledger.
+func ValidateSubAccount(subAccount string) error {
+	if len(strings.TrimSpace(subAccount)) == 0 {
+		return errors.New("sub-account cannot be empty")
+	}
+	if len(subAccount) > MaxSubAccountLength {
+		return fmt.Errorf("sub-account length %d exceeds maximum length of %d", len(subAccount), MaxSubAccountLength)
+	}
+	return nil
+}
+
+// Validate returns an error if this SubAccountTag does not identify at least one valid sub-account.
+func (t SubAccountTag) Validate() error {
+	if len(t.FromSubAccount) == 0 && len(t.ToSubAccount) == 0 {
+		return errors.New("sub-account tag must have a from or to sub-account")
+	}
+	if len(t.FromSubAccount) > 0 {
+		if err := ValidateSubAccount(t.FromSubAccount); err != nil {
+			return fmt.Errorf("invalid from sub-account: %w", err)
+		}
+	}
+	if len(t.ToSubAccount) > 0 {
+		if err := ValidateSubAccount(t.ToSubAccount); err != nil {
+			return fmt.Errorf("invalid to sub-account: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if this SubLedgerEntry is not in a valid state.
+func (e SubLedgerEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Custodian); err != nil {
+		return fmt.Errorf("invalid custodian: %w", err)
+	}
+	if e.Id == 0 {
+		return errors.New("id cannot be zero")
+	}
+	if err := ValidateSubAccount(e.SubAccount); err != nil {
+		return err
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Counterparty); err != nil {
+		return fmt.Errorf("invalid counterparty: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSupplyChangePolicy creates a new SupplyChangePolicy.
+func NewSupplyChangePolicy(denom string, maxChange sdkmath.LegacyDec) SupplyChangePolicy {
+	return SupplyChangePolicy{
+		Denom:     denom,
+		MaxChange: maxChange,
+	}
+}
+
+// IsEmpty returns true if this SupplyChangePolicy does not limit anything.
+func (p SupplyChangePolicy) IsEmpty() bool {
+	return p.MaxChange.IsNil() || p.MaxChange.IsZero()
+}
+
+// Validate returns an error if this SupplyChangePolicy is not in a valid state.
+func (p SupplyChangePolicy) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	if p.MaxChange.IsNil() || !p.MaxChange.IsPositive() {
+		return fmt.Errorf("invalid max change %q: must be positive", p.MaxChange)
+	}
+	return nil
+}
+
+// MaxChangeAmount returns the largest amount that the mints and burns of a single block can change the provided
+// supply by (rounded down).
+func (p SupplyChangePolicy) MaxChangeAmount(supply sdkmath.Int) sdkmath.Int {
+	return p.MaxChange.MulInt(supply).TruncateInt()
+}
+
+var supplyChangePolicyBypassKey = "bypass-marker-supply-change-policy"
+
+// WithSupplyChangePolicyBypass returns a new context that will cause supply change policies to not be enforced.
+func WithSupplyChangePolicyBypass[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(supplyChangePolicyBypassKey, true)
+	return context.Context(sdkCtx).(C)
+}
+
+// WithoutSupplyChangePolicyBypass returns a new context that will cause supply change policies to be enforced.
+func WithoutSupplyChangePolicyBypass[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(supplyChangePolicyBypassKey, false)
+	return context.Context(sdkCtx).(C)
+}
+
+// HasSupplyChangePolicyBypass checks the context to see if supply change policies should not be enforced.
+func HasSupplyChangePolicyBypass[C context.Context](ctx C) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	bypassValue := sdkCtx.Value(supplyChangePolicyBypassKey)
+	if bypassValue == nil {
+		return false
+	}
+	bypass, isBool := bypassValue.(bool)
+	return isBool && bypass
+}
+
+// SupplyChangeUsage is how much a marker's supply has been changed by in a block.
+type SupplyChangeUsage struct {
+	// Height is the block the usage is for.
+	Height int64
+	// BaseSupply is the marker's supply before the first mint or burn of the block.
+	BaseSupply sdkmath.Int
+	// Changed is the total amount minted and burned in the block.
+	Changed sdkmath.Int
+}
+
+// Bytes returns the store encoding of this SupplyChangeUsage: [height][len(base supply)][base supply][changed].
+func (u SupplyChangeUsage) Bytes() []byte {
+	base := []byte(u.BaseSupply.String())
+	rv := binary.BigEndian.AppendUint64(nil, uint64(u.Height))
+	rv = binary.BigEndian.AppendUint16(rv, uint16(len(base)))
+	rv = append(rv, base...)
+	return append(rv, u.Changed.String()...)
+}
+
+// ParseSupplyChangeUsage reads a SupplyChangeUsage from its store encoding.
+func ParseSupplyChangeUsage(bz []byte) (SupplyChangeUsage, error) {
+	if len(bz) < 10 {
+		return SupplyChangeUsage{}, errors.New("invalid supply change usage: too short")
+	}
+	baseLen := int(binary.BigEndian.Uint16(bz[8:10]))
+	if len(bz) < 10+baseLen {
+		return SupplyChangeUsage{}, errors.New("invalid supply change usage: base supply too short")
+	}
+	base, ok := sdkmath.NewIntFromString(string(bz[10 : 10+baseLen]))
+	if !ok {
+		return SupplyChangeUsage{}, fmt.Errorf("invalid supply change usage base supply %q", bz[10:10+baseLen])
+	}
+	changed, ok := sdkmath.NewIntFromString(string(bz[10+baseLen:]))
+	if !ok {
+		return SupplyChangeUsage{}, fmt.Errorf("invalid supply change usage changed amount %q", bz[10+baseLen:])
+	}
+	return SupplyChangeUsage{
+		Height:     int64(binary.BigEndian.Uint64(bz[:8])),
+		BaseSupply: base,
+		Changed:    changed,
+	}, nil
+}
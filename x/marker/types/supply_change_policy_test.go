@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestSupplyChangePolicyValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy SupplyChangePolicy
+		expErr string
+	}{
+		{
+			name:   "control",
+			policy: NewSupplyChangePolicy("hotdog", sdkmath.LegacyNewDecWithPrec(1, 1)),
+		},
+		{
+			name:   "more than whole supply",
+			policy: NewSupplyChangePolicy("hotdog", sdkmath.LegacyNewDec(2)),
+		},
+		{
+			name:   "invalid denom",
+			policy: NewSupplyChangePolicy("x", sdkmath.LegacyOneDec()),
+			expErr: "invalid denom: x",
+		},
+		{
+			name:   "nil max change",
+			policy: SupplyChangePolicy{Denom: "hotdog"},
+			expErr: "invalid max change \"<nil>\": must be positive",
+		},
+		{
+			name:   "zero max change",
+			policy: NewSupplyChangePolicy("hotdog", sdkmath.LegacyZeroDec()),
+			expErr: "invalid max change \"0.000000000000000000\": must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestSupplyChangePolicyMaxChangeAmount(t *testing.T) {
+	policy := NewSupplyChangePolicy("hotdog", sdkmath.LegacyNewDecWithPrec(15, 2))
+	assert.Equal(t, "15", policy.MaxChangeAmount(sdkmath.NewInt(100)).String(), "MaxChangeAmount(100)")
+	assert.Equal(t, "1", policy.MaxChangeAmount(sdkmath.NewInt(13)).String(), "MaxChangeAmount(13)")
+	assert.Equal(t, "0", policy.MaxChangeAmount(sdkmath.ZeroInt()).String(), "MaxChangeAmount(0)")
+	assert.True(t, NewSupplyChangePolicy("hotdog", sdkmath.LegacyZeroDec()).IsEmpty(), "IsEmpty zero")
+	assert.True(t, SupplyChangePolicy{Denom: "hotdog"}.IsEmpty(), "IsEmpty nil")
+	assert.False(t, policy.IsEmpty(), "IsEmpty 0.15")
+}
+
+func TestSupplyChangeUsageBytes(t *testing.T) {
+	usage := SupplyChangeUsage{Height: 12, BaseSupply: sdkmath.NewInt(1000), Changed: sdkmath.NewInt(250)}
+	parsed, err := ParseSupplyChangeUsage(usage.Bytes())
+	if assert.NoError(t, err, "ParseSupplyChangeUsage") {
+		assert.Equal(t, usage.Height, parsed.Height, "Height")
+		assert.Equal(t, usage.BaseSupply.String(), parsed.BaseSupply.String(), "BaseSupply")
+		assert.Equal(t, usage.Changed.String(), parsed.Changed.String(), "Changed")
+	}
+
+	_, err = ParseSupplyChangeUsage([]byte{0, 1})
+	assertions.AssertErrorValue(t, err, "invalid supply change usage: too short", "ParseSupplyChangeUsage too short")
+	_, err = ParseSupplyChangeUsage(append(usage.Bytes()[:10], 'x'))
+	assertions.AssertErrorValue(t, err, "invalid supply change usage: base supply too short", "ParseSupplyChangeUsage truncated")
+}
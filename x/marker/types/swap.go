@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSwapOffer creates a new SwapOffer. The taker can be nil to allow anyone to accept it.
+func NewSwapOffer(id uint64, maker, taker sdk.AccAddress, offer, ask sdk.Coin, expirationHeight int64) SwapOffer {
+	return SwapOffer{
+		Id:               id,
+		Maker:            maker.String(),
+		Taker:            taker.String(),
+		Offer:            offer,
+		Ask:              ask,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
+// Validate returns an error if this SwapOffer is not in a valid state.
+func (o SwapOffer) Validate() error {
+	if o.Id == 0 {
+		return errors.New("id cannot be zero")
+	}
+	return validateSwapOffer(o.Maker, o.Taker, o.Offer, o.Ask, o.ExpirationHeight)
+}
+
+// CanAccept returns true if the provided address is allowed to accept this offer.
+func (o SwapOffer) CanAccept(addr string) bool {
+	if len(o.Taker) > 0 {
+		return addr == o.Taker
+	}
+	return addr != o.Maker
+}
+
+// validateSwapOffer returns an error if any of the provided swap offer fields are invalid.
+func validateSwapOffer(maker, taker string, offer, ask sdk.Coin, expirationHeight int64) error {
+	if _, err := sdk.AccAddressFromBech32(maker); err != nil {
+		return fmt.Errorf("invalid maker: %w", err)
+	}
+	if len(taker) > 0 {
+		if _, err := sdk.AccAddressFromBech32(taker); err != nil {
+			return fmt.Errorf("invalid taker: %w", err)
+		}
+		if taker == maker {
+			return errors.New("maker and taker cannot be the same")
+		}
+	}
+	if err := ValidateSwapAmounts("offer", offer, "ask", ask); err != nil {
+		return err
+	}
+	if expirationHeight <= 0 {
+		return fmt.Errorf("invalid expiration height %d: must be positive", expirationHeight)
+	}
+	return nil
+}
+
+// ValidateSwapAmounts returns an error if either amount of a swap is not positive, or if they have the same denom.
+// The names are used to identify the amounts in any error returned.
+func ValidateSwapAmounts(nameA string, amountA sdk.Coin, nameB string, amountB sdk.Coin) error {
+	for _, leg := range []struct {
+		name   string
+		amount sdk.Coin
+	}{{nameA, amountA}, {nameB, amountB}} {
+		if err := leg.amount.Validate(); err != nil {
+			return fmt.Errorf("invalid %s: %w", leg.name, err)
+		}
+		if !leg.amount.IsPositive() {
+			return fmt.Errorf("invalid %s %s: must be positive", leg.name, leg.amount)
+		}
+	}
+	if amountA.Denom == amountB.Denom {
+		return fmt.Errorf("%s and %s cannot have the same denom %s", nameA, nameB, amountA.Denom)
+	}
+	return nil
+}
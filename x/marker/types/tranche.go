@@ -0,0 +1,48 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTrancheLabelLength is the maximum length of an issuance tranche's label.
+const MaxTrancheLabelLength = 64
+
+// Validate returns an error if this IssuanceTranche is not in a valid state.
+func (t IssuanceTranche) Validate() error {
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return err
+	}
+	if t.Id == 0 {
+		return errors.New("id cannot be zero")
+	}
+	if t.Amount.IsNil() || !t.Amount.IsPositive() {
+		return fmt.Errorf("invalid amount %s: must be positive", t.Amount)
+	}
+	if err := ValidateTranche(t.Denom, t.Label, t.Price); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(t.MintedBy); err != nil {
+		return fmt.Errorf("invalid minted by: %w", err)
+	}
+	return nil
+}
+
+// ValidateTranche returns an error if the provided label or price cannot be recorded with a tranche of the denom.
+// Both are optional.
+func ValidateTranche(denom, label string, price *sdk.Coin) error {
+	if len(label) > MaxTrancheLabelLength {
+		return fmt.Errorf("tranche label length %d exceeds maximum length of %d", len(label), MaxTrancheLabelLength)
+	}
+	if price != nil {
+		if err := price.Validate(); err != nil {
+			return fmt.Errorf("invalid tranche price: %w", err)
+		}
+		if price.Denom == denom {
+			return fmt.Errorf("tranche price denom cannot be the marker denom %q", denom)
+		}
+	}
+	return nil
+}
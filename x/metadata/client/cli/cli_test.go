@@ -400,7 +400,7 @@ specification_id: %s`,
 		s.scopeSpecID,
 	)
 
-	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\"}",
+	s.contractSpecAsJson = fmt.Sprintf("{\"specification_id\":\"%s\",\"description\":null,\"owner_addresses\":[\"%s\"],\"parties_involved\":[\"PARTY_TYPE_OWNER\"],\"hash\":\"notreallyasourcehash\",\"class_name\":\"contractclassname\",\"record_write_roles\":[]}",
 		s.contractSpecID,
 		s.user1AddrStr,
 	)
@@ -411,6 +411,7 @@ owner_addresses:
 - %s
 parties_involved:
 - PARTY_TYPE_OWNER
+record_write_roles: []
 specification_id: %s`,
 		s.user1AddrStr,
 		s.contractSpecID,
@@ -439,6 +440,8 @@ type_name: recordtypename`,
 			eKey = "\"\""
 		}
 		return fmt.Sprintf(`encryption_key: %s
+endpoints: []
+last_heartbeat_height: "0"
 locator_uri: %s
 owner: %s`,
 			eKey,
@@ -447,7 +450,7 @@ owner: %s`,
 		)
 	}
 	locAsJson := func(loc metadatatypes.ObjectStoreLocator) string {
-		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\"}",
+		return fmt.Sprintf("{\"owner\":\"%s\",\"locator_uri\":\"%s\",\"encryption_key\":\"%s\",\"endpoints\":[],\"last_heartbeat_height\":\"0\"}",
 			loc.Owner,
 			loc.LocatorUri,
 			loc.EncryptionKey,
@@ -1766,6 +1769,36 @@ func (s *IntegrationCLITestSuite) TestGetOSLocatorCmd() {
 			args:   []string{"http://not-an-entry.corn"},
 			expErr: "No records found.: unknown request",
 		},
+		{
+			name:   "stale as json",
+			args:   []string{"stale", "0", s.asJson},
+			expOut: []string{s.objectLocator1AsJson, s.objectLocator2AsJson},
+		},
+		{
+			name:   "stale as text",
+			args:   []string{"stale", "0", s.asText},
+			expOut: []string{listEntryLocator1, listEntryLocator2},
+		},
+		{
+			name:   "stale none",
+			args:   []string{"stale", "1000000000", s.asJson},
+			expOut: []string{"\"locators\":[]"},
+		},
+		{
+			name:   "stale without max age",
+			args:   []string{"stale"},
+			expErr: "a max age (in blocks) is required for stale locators",
+		},
+		{
+			name:   "stale invalid max age",
+			args:   []string{"stale", "abc"},
+			expErr: "invalid max age blocks \"abc\": strconv.ParseInt: parsing \"abc\": invalid syntax",
+		},
+		{
+			name:   "two args not stale",
+			args:   []string{s.uri1, "0"},
+			expErr: "accepts 1 arg(s), received 2",
+		},
 	}
 
 	runQueryCmdTestCases(s, cmd, testCases)
@@ -2650,6 +2683,45 @@ func (s *IntegrationCLITestSuite) TestAddObjectLocatorCmd() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "Should successfully modify os locator with endpoints",
+			cmd:  cli.ModifyOsLocatorCmd,
+			args: []string{
+				s.accountAddrStr,
+				userURI,
+				fmt.Sprintf("--%s=%s", cli.FlagEndpoint, "http://bar.com"),
+				fmt.Sprintf("--%s=%s", cli.FlagEndpoint, "http://baz.com"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "Should successfully heartbeat os locator",
+			cmd:  cli.HeartbeatOsLocatorCmd,
+			args: []string{
+				s.accountAddrStr,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "heartbeat os locator invalid owner",
+			cmd:  cli.HeartbeatOsLocatorCmd,
+			args: []string{
+				"notabech32",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: "invalid owner \"notabech32\": decoding bech32 failed: invalid separator index -1",
+		},
 		{
 			name: "Should successfully delete os locator",
 			cmd:  cli.RemoveOsLocatorCmd,
@@ -2743,6 +2815,41 @@ func (s *IntegrationCLITestSuite) TestContractSpecificationTxCommands() {
 			},
 			expectedCode: 0,
 		},
+		{
+			name: "should successfully update contract specification with record write roles",
+			cmd:  addCommand,
+			args: []string{
+				specificationID.String(),
+				s.accountAddrStr,
+				"originator,servicer",
+				"hashvalue",
+				"myclassname",
+				fmt.Sprintf("--%s=%s", cli.FlagRecordWriteRoles, "payment=servicer"),
+				fmt.Sprintf("--%s=%s", cli.FlagRecordWriteRoles, "loan=originator,servicer"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "should fail to update contract specification with bad record write roles",
+			cmd:  addCommand,
+			args: []string{
+				specificationID.String(),
+				s.accountAddrStr,
+				"originator,servicer",
+				"hashvalue",
+				"myclassname",
+				fmt.Sprintf("--%s=%s", cli.FlagRecordWriteRoles, "servicer"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddrStr),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			},
+			expectErrMsg: "invalid record write roles \"servicer\": expected format <record-name>=<roles>",
+		},
 		{
 			name: "should successfully remove contract specification",
 			cmd:  removeCommand,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -60,6 +61,8 @@ func GetQueryCmd() *cobra.Command {
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
+		GetCmdRecordTombstonesQuery(),
+		GetCmdPendingChangesQuery(),
 	)
 	return queryCmd
 }
@@ -514,7 +517,7 @@ func GetValueOwnershipCmd() *cobra.Command {
 // GetOSLocatorCmd returns the command handler for metadata object store locator querying.
 func GetOSLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "locator {owner|scope_id|scope_uuid|uri|\"params\"|\"all\"}",
+		Use:     "locator {owner|scope_id|scope_uuid|uri|\"params\"|\"all\"|\"stale\" max_age_blocks}",
 		Aliases: []string{"l", "locators"},
 		Short:   "Query the current metadata for object store locators",
 		Long: fmt.Sprintf(`%[1]s locator {owner} - gets the object store locator for that owner.
@@ -522,16 +525,32 @@ func GetOSLocatorCmd() *cobra.Command {
 %[1]s locator {scope_uuid} - gets object store locators for all the owners of that scope.
 %[1]s locator {uri} - gets object store locators with that uri.
 %[1]s locator params - gets the object store locator params.
-%[1]s locator all - gets all object store locators.`, cmdStart),
-		Args: cobra.ExactArgs(1),
+%[1]s locator all - gets all object store locators.
+%[1]s locator stale {max_age_blocks} - gets object store locators without a heartbeat in more than that many blocks.`, cmdStart),
+		Args: cobra.RangeArgs(1, 2),
 		Example: fmt.Sprintf(`%[1]s locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42
 %[1]s locator scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
 %[1]s locator 91978ba2-5f35-459a-86a7-feca1b0512e0
 %[1]s locator https://provenance.io/
 %[1]s locator params
-%[1]s locator all`, cmdStart),
+%[1]s locator all
+%[1]s locator stale 1000`, cmdStart),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			arg0 := strings.TrimSpace(args[0])
+			// The stale lookup is the only one that takes a second arg.
+			if arg0 == "stale" {
+				if len(args) != 2 {
+					return errors.New("a max age (in blocks) is required for stale locators")
+				}
+				maxAge, err := strconv.ParseInt(strings.TrimSpace(args[1]), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid max age blocks %q: %w", args[1], err)
+				}
+				return outputOSLocatorsStale(cmd, maxAge)
+			}
+			if len(args) != 1 {
+				return fmt.Errorf("accepts 1 arg(s), received %d", len(args))
+			}
 			// First check if it's just the string "params".
 			if arg0 == "params" {
 				return outputOSLocatorParams(cmd)
@@ -562,7 +581,7 @@ func GetOSLocatorCmd() *cobra.Command {
 
 	addIncludeRequestFlag(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
-	flags.AddPaginationFlagsToCmd(cmd, "locators (all)")
+	flags.AddPaginationFlagsToCmd(cmd, "locators (all, stale)")
 
 	return cmd
 }
@@ -1111,6 +1130,28 @@ func outputOSLocatorsAll(cmd *cobra.Command) error {
 	return clientCtx.PrintProto(res)
 }
 
+// outputOSLocatorsStale calls the OSStaleLocators query and outputs the response.
+func outputOSLocatorsStale(cmd *cobra.Command, maxAgeBlocks int64) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+	pageReq, e := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+	if e != nil {
+		return e
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.OSStaleLocators(
+		cmd.Context(),
+		&types.OSStaleLocatorsRequest{MaxAgeBlocks: maxAgeBlocks, IncludeRequest: includeRequest, Pagination: pageReq},
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // GetCmdNetAssetValuesQuery is the CLI command for querying a scope's net asset values.
 func GetCmdNetAssetValuesQuery() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1131,10 +1172,15 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			var response *types.QueryScopeNetAssetValuesResponse
 			if response, err = queryClient.ScopeNetAssetValues(
 				context.Background(),
-				&types.QueryScopeNetAssetValuesRequest{Id: id},
+				&types.QueryScopeNetAssetValuesRequest{Id: id, Pagination: pageReq},
 			); err != nil {
 				fmt.Printf("failed to query scope %q net asset values details: %v\n", id, err)
 				return nil
@@ -1143,6 +1189,100 @@ func GetCmdNetAssetValuesQuery() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "net asset values")
+	return cmd
+}
+
+// GetCmdRecordTombstonesQuery is the CLI command for querying the tombstones of a scope's redacted records.
+func GetCmdRecordTombstonesQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-tombstones <scope-id|record-id>",
+		Aliases: []string{"tombstones"},
+		Short:   "Get the tombstones of a scope's records that have had their contents redacted",
+		Example: fmt.Sprintf(`$ %s query metadata record-tombstones scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			_, err = types.MetadataAddressFromBech32(id)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryRecordTombstonesResponse
+			if response, err = queryClient.RecordTombstones(
+				context.Background(),
+				&types.QueryRecordTombstonesRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record tombstones")
+	return cmd
+}
+
+// GetCmdPendingChangesQuery is the CLI command for querying the scope and record changes that are waiting on approvals.
+func GetCmdPendingChangesQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-changes [change-id|scope-id]",
+		Aliases: []string{"pending-change", "pc"},
+		Short:   "Get the scope and record changes that are waiting on approvals",
+		Long: fmt.Sprintf(`%[1]s pending-changes - gets all pending changes.
+%[1]s pending-changes {change_id} - gets the pending change with the given id.
+%[1]s pending-changes {scope_id} - gets the pending changes of the scope with the given id (or uuid).`, cmdStart),
+		Example: fmt.Sprintf(`%[1]s pending-changes
+%[1]s pending-changes 3
+%[1]s pending-changes scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, cmdStart),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var arg0 string
+			if len(args) > 0 {
+				arg0 = strings.TrimSpace(args[0])
+			}
+			if changeID, idErr := strconv.ParseUint(arg0, 10, 64); idErr == nil {
+				var response *types.QueryPendingChangeResponse
+				if response, err = queryClient.PendingChange(
+					context.Background(),
+					&types.QueryPendingChangeRequest{ChangeId: changeID},
+				); err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(response)
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			var response *types.QueryPendingChangesResponse
+			if response, err = queryClient.PendingChanges(
+				context.Background(),
+				&types.QueryPendingChangesRequest{ScopeId: arg0, Pagination: pageReq},
+			); err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending changes")
 	return cmd
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -26,6 +27,8 @@ const (
 	AddSwitch              = "add"
 	RemoveSwitch           = "remove"
 	FlagUsdMills           = "usd-mills"
+	FlagEndpoint           = "endpoint"
+	FlagRecordWriteRoles   = "record-write-roles"
 )
 
 // NewTxCmd is the top-level command for Metadata CLI transactions.
@@ -50,6 +53,7 @@ func NewTxCmd() *cobra.Command {
 		BindOsLocatorCmd(),
 		RemoveOsLocatorCmd(),
 		ModifyOsLocatorCmd(),
+		HeartbeatOsLocatorCmd(),
 
 		WriteScopeSpecificationCmd(),
 		RemoveScopeSpecificationCmd(),
@@ -67,6 +71,10 @@ func NewTxCmd() *cobra.Command {
 
 		WriteRecordCmd(),
 		RemoveRecordCmd(),
+		TombstoneRecordCmd(),
+
+		ProposeChangeCmd(),
+		ApproveChangeCmd(),
 
 		SetAccountDataCmd(),
 
@@ -392,10 +400,13 @@ func MigrateValueOwnerCmd() *cobra.Command {
 // BindOsLocatorCmd creates a command for binding an owner to uri in the object store.
 func BindOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind-locator [owner] [uri]",
-		Short:   "Bind a uri to an owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "bind-locator [owner] [uri]",
+		Short: "Bind a uri to an owner address on the provenance blockchain",
+		Long: `Failover endpoints can be provided using the --endpoint flag, which can be repeated.
+Endpoints are given priority in the order they are provided.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com"
+$ %[1]s tx metadata bind-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo.com" --endpoint "http://bar.com" --endpoint "http://baz.com"`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -407,8 +418,13 @@ func BindOsLocatorCmd() *cobra.Command {
 				return fmt.Errorf("invalid address: %w", errAddr)
 			}
 
+			endpoints, err := parseEndpoints(cmd)
+			if err != nil {
+				return err
+			}
+
 			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+				LocatorUri: args[1], Owner: args[0], Endpoints: endpoints,
 			}
 
 			addOSLocator := *types.NewMsgBindOSLocatorRequest(objectStoreLocator)
@@ -416,6 +432,7 @@ func BindOsLocatorCmd() *cobra.Command {
 		},
 	}
 
+	addEndpointFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -456,10 +473,13 @@ func RemoveOsLocatorCmd() *cobra.Command {
 // ModifyOsLocatorCmd creates a command to modify the object store locator uri for an owner.
 func ModifyOsLocatorCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "modify-locator [owner] [uri]",
-		Short:   "Modify a uri already associated owner address on the provenance blockchain",
-		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"`, version.AppName),
-		Args:    cobra.ExactArgs(2),
+		Use:   "modify-locator [owner] [uri]",
+		Short: "Modify a uri already associated owner address on the provenance blockchain",
+		Long: `Failover endpoints can be provided using the --endpoint flag, which can be repeated.
+Endpoints are given priority in the order they are provided.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com"
+$ %[1]s tx metadata modify-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "http://foo2.com" --endpoint "http://bar.com" --endpoint "http://baz.com"`, version.AppName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -471,8 +491,13 @@ func ModifyOsLocatorCmd() *cobra.Command {
 				return fmt.Errorf("invalid address: %w", errAddr)
 			}
 
+			endpoints, err := parseEndpoints(cmd)
+			if err != nil {
+				return err
+			}
+
 			objectStoreLocator := types.ObjectStoreLocator{
-				LocatorUri: args[1], Owner: args[0],
+				LocatorUri: args[1], Owner: args[0], Endpoints: endpoints,
 			}
 
 			modifyOSLocator := *types.NewMsgModifyOSLocatorRequest(objectStoreLocator)
@@ -480,6 +505,35 @@ func ModifyOsLocatorCmd() *cobra.Command {
 		},
 	}
 
+	addEndpointFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// HeartbeatOsLocatorCmd creates a command to record that an owner's object store is still alive.
+func HeartbeatOsLocatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "heartbeat-locator [owner]",
+		Short:   "Record a heartbeat for the object store locator of an owner address on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata heartbeat-locator pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner, err := validateAccAddress(args[0], "owner")
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgHeartbeatOSLocatorRequest(owner)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -555,9 +609,14 @@ classname          - name of contract specification
 description-name   - description name identifier (optional)
 description        - description text (optional, can only be provided with a description-name)
 website-url        - address of website (optional, can only be provided with a description)
-icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)`,
-		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey`, version.AppName),
-		Args:    cobra.RangeArgs(5, 9),
+icon-url           - address to a image to be used as an icon (optional, can only be provided with an website-url)
+
+The --record-write-roles flag restricts which party roles can write a record, and can be repeated.
+Its format is <record-name>=<comma delimited list of party types>.
+Records without an entry require the standard signers.`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "owner" "hashvalue" "myclassname" --from=mykey
+$ %[1]s tx metadata write-contract-specification contractspec1q0w6ys5g6jm509v2830374aprsrq260w62 pb1sh49f6ze3vn7cdl2amh2gnc70z5mten3dpvr42 "originator,servicer" "hashvalue" "myclassname" --record-write-roles "payment=servicer" --from=mykey`, version.AppName),
+		Args: cobra.RangeArgs(5, 9),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -572,12 +631,17 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 			if err != nil {
 				return err
 			}
+			recordWriteRoles, err := parseRecordWriteRoles(cmd)
+			if err != nil {
+				return err
+			}
 			description := parseDescription(args[5:])
 			contractSpecification := types.ContractSpecification{SpecificationId: specificationID,
-				Description:     description,
-				OwnerAddresses:  strings.Split(args[1], ","),
-				PartiesInvolved: partiesInvolved,
-				ClassName:       args[4],
+				Description:      description,
+				OwnerAddresses:   strings.Split(args[1], ","),
+				PartiesInvolved:  partiesInvolved,
+				ClassName:        args[4],
+				RecordWriteRoles: recordWriteRoles,
 			}
 			sourceValue := args[3]
 			var recordID sdk.AccAddress
@@ -601,6 +665,7 @@ icon-url           - address to a image to be used as an icon (optional, can onl
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().StringArray(FlagRecordWriteRoles, nil, "roles allowed to write a record, e.g. payment=servicer,originator (can be provided multiple times)")
 	addSignersFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -1105,6 +1170,127 @@ func RemoveRecordCmd() *cobra.Command {
 	return cmd
 }
 
+// TombstoneRecordCmd creates a command to redact the contents of a record
+func TombstoneRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tombstone-record <record-id> <reason>",
+		Short:   "Redact the input and output hashes of a record on the provenance blockchain",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata tombstone-record record1qtjqgzrza7h5w8a4amnk9ru9s7236qz42yxp5uejah5tje7c6l0pwue0yn3 "erasure request" --from=mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var recordID types.MetadataAddress
+			recordID, err = types.MetadataAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTombstoneRecordRequest(recordID, args[1], signers)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ProposeChangeCmd creates a command to propose a scope or record change that its required signers can approve over time
+func ProposeChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-change <msg-json-file> <expiration-height>",
+		Short: "Propose a scope or record change that its required signers can approve over time",
+		Long: `Propose a scope or record change that its required signers can approve over time.
+
+<msg-json-file> is a file with the JSON of a MsgWriteScopeRequest or MsgWriteRecordRequest (including its "@type").
+  Any signers in it are ignored; the signers of this request are the change's first approvals.
+<expiration-height> is the block height at which the change expires if it hasn't been executed.
+`,
+		Example: fmt.Sprintf(`$ %[1]s tx metadata propose-change write-scope.json 1500000 --from=mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var changeMsg sdk.Msg
+			if err = clientCtx.Codec.UnmarshalInterfaceJSON(contents, &changeMsg); err != nil {
+				return fmt.Errorf("invalid msg json: %w", err)
+			}
+
+			expirationHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiration height %q: %w", args[1], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+			msg, err := types.NewMsgProposeChangeRequest(changeMsg, expirationHeight, signers)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ApproveChangeCmd creates a command to approve a pending scope or record change
+func ApproveChangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approve-change <change-id>",
+		Short:   "Approve a pending scope or record change, executing it once its signer requirements are satisfied",
+		Example: fmt.Sprintf(`$ %[1]s tx metadata approve-change 3 --from=mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			changeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid change id %q: %w", args[0], err)
+			}
+
+			signers, err := parseSigners(cmd, &clientCtx)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgApproveChangeRequest(changeID, signers)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addSignersFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // RemoveRecordSpecificationCmd creates  a command to remove a record specification
 func RemoveRecordSpecificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1291,6 +1477,55 @@ func parseSigners(cmd *cobra.Command, client *client.Context) ([]string, error)
 	return []string{client.GetFromAddress().String()}, nil
 }
 
+// addEndpointFlagToCmd adds the repeatable --endpoint flag to a command.
+// See also: parseEndpoints.
+func addEndpointFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().StringArray(FlagEndpoint, nil, "a failover endpoint uri (can be provided multiple times)")
+}
+
+// parseEndpoints reads the --endpoint flags into locator endpoints, prioritized in the order provided.
+// See also: addEndpointFlagToCmd.
+func parseEndpoints(cmd *cobra.Command) ([]types.LocatorEndpoint, error) {
+	uris, err := cmd.Flags().GetStringArray(FlagEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if len(uris) == 0 {
+		return nil, nil
+	}
+	rv := make([]types.LocatorEndpoint, len(uris))
+	for i, uri := range uris {
+		rv[i] = types.LocatorEndpoint{Uri: uri, Priority: uint32(i)}
+	}
+	return rv, nil
+}
+
+// parseRecordWriteRoles reads the --record-write-roles flags into RecordWriteRoles entries.
+// Each flag value has the format <record-name>=<comma delimited list of party types>.
+func parseRecordWriteRoles(cmd *cobra.Command) ([]types.RecordWriteRoles, error) {
+	vals, err := cmd.Flags().GetStringArray(FlagRecordWriteRoles)
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, nil
+	}
+	rv := make([]types.RecordWriteRoles, len(vals))
+	for i, val := range vals {
+		name, roles, ok := strings.Cut(val, "=")
+		name = strings.TrimSpace(name)
+		if !ok || len(name) == 0 || len(strings.TrimSpace(roles)) == 0 {
+			return nil, fmt.Errorf("invalid record write roles %q: expected format <record-name>=<roles>", val)
+		}
+		rv[i].RecordName = name
+		rv[i].Roles, err = parsePartyTypes(roles)
+		if err != nil {
+			return nil, fmt.Errorf("invalid record write roles %q: %w", val, err)
+		}
+	}
+	return rv, nil
+}
+
 // validateAccAddress makes sure the provided addr is a valid bech32.
 // If not, an error is returned indicating the argName field.
 // If it's valid, it's returned as the first arg.
@@ -56,7 +56,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			if strings.TrimSpace(s.EncryptionKey) != "" {
 				encryptionKey, _ = sdk.AccAddressFromBech32(s.EncryptionKey)
 			}
-			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri)
+			err = k.ImportOSLocatorRecord(ctx, addr, encryptionKey, s.LocatorUri, s.Endpoints, s.LastHeartbeatHeight)
 			if err != nil {
 				panic(err)
 			}
@@ -80,6 +80,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			}
 		}
 	}
+
+	for _, tombstone := range data.RecordTombstones {
+		k.SetRecordTombstone(ctx, tombstone)
+	}
+
+	for _, change := range data.PendingChanges {
+		k.SetPendingChange(ctx, change)
+	}
+	k.SetLastPendingChangeID(ctx, data.LastPendingChangeId)
 }
 
 // ExportGenesis exports the current keeper state of the metadata module.ExportGenesis
@@ -168,5 +177,27 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	var recordTombstones []types.RecordTombstone
+	err := k.IterateRecordTombstones(ctx, types.MetadataAddress{}, func(tombstone types.RecordTombstone) (stop bool) {
+		recordTombstones = append(recordTombstones, tombstone)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var pendingChanges []types.PendingChange
+	err = k.IteratePendingChanges(ctx, func(change types.PendingChange) (stop bool) {
+		pendingChanges = append(pendingChanges, change)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	rv := types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	rv.RecordTombstones = recordTombstones
+	rv.PendingChanges = pendingChanges
+	rv.LastPendingChangeId = k.GetLastPendingChangeID(ctx)
+	return rv
 }
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// The name of the scope index invariant
+const scopeIndexInvariantName = "scope-indexes"
+
+// RegisterInvariants registers the metadata module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, scopeIndexInvariantName, ScopeIndexInvariant(k))
+}
+
+// ScopeIndexInvariant checks that the address and scope specification indexes of the scopes are consistent with
+// the scopes. These indexes are used by the scope queries, so this is useful for verifying the state of a node
+// that was restored from a snapshot.
+func ScopeIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		problems = append(problems, k.checkScopesIndexed(ctx)...)
+		problems = append(problems, k.checkScopeIndexEntries(ctx, types.AddressScopeCacheKeyPrefix, addressScopeIndexScopeID)...)
+		problems = append(problems, k.checkScopeIndexEntries(ctx, types.ScopeSpecScopeCacheKeyPrefix, scopeSpecScopeIndexScopeID)...)
+
+		msg := fmt.Sprintf("%d scope index problems found\n", len(problems))
+		if len(problems) > 0 {
+			msg += strings.Join(problems, "\n") + "\n"
+		}
+		return sdk.FormatInvariant(types.ModuleName, scopeIndexInvariantName, msg), len(problems) > 0
+	}
+}
+
+// checkScopesIndexed makes sure that each scope has all of its index entries.
+func (k Keeper) checkScopesIndexed(ctx sdk.Context) []string {
+	var rv []string
+	store := ctx.KVStore(k.storeKey)
+	err := k.IterateScopes(ctx, func(scope types.Scope) bool {
+		for _, indexKey := range getScopeIndexValues(&scope).IndexKeys() {
+			if !store.Has(indexKey) {
+				rv = append(rv, fmt.Sprintf("scope %s is missing index entry %X", scope.ScopeId, indexKey))
+			}
+		}
+		return false
+	})
+	if err != nil {
+		rv = append(rv, fmt.Sprintf("could not read scopes: %v", err))
+	}
+	return rv
+}
+
+// checkScopeIndexEntries makes sure that each index entry with the provided prefix is for a scope that should have it.
+func (k Keeper) checkScopeIndexEntries(ctx sdk.Context, prefix []byte, getScopeID func(key []byte) types.MetadataAddress) []string {
+	var rv []string
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		scopeID := getScopeID(key)
+		scope, found := k.GetScope(ctx, scopeID)
+		if !found {
+			rv = append(rv, fmt.Sprintf("index entry %X is for scope %s that does not exist", key, scopeID))
+			continue
+		}
+		if !containsKey(getScopeIndexValues(&scope).IndexKeys(), key) {
+			rv = append(rv, fmt.Sprintf("index entry %X is not an index of scope %s", key, scopeID))
+		}
+	}
+	return rv
+}
+
+// addressScopeIndexScopeID returns the scope id in an address scope cache key: [prefix][addr length][addr][scope id].
+func addressScopeIndexScopeID(key []byte) types.MetadataAddress {
+	addrLen := int(key[1])
+	return types.MetadataAddress(key[addrLen+2:])
+}
+
+// scopeSpecScopeIndexScopeID returns the scope id in a scope spec scope cache key: [prefix][scope spec id][scope id].
+// Scope ids and scope spec ids have the same length, so the scope id is the second half of the key (after the prefix).
+func scopeSpecScopeIndexScopeID(key []byte) types.MetadataAddress {
+	idLen := (len(key) - 1) / 2
+	return types.MetadataAddress(key[1+idLen:])
+}
+
+// containsKey returns true if the provided key is in the list of keys.
+func containsKey(keys [][]byte, key []byte) bool {
+	for _, k := range keys {
+		if bytes.Equal(k, key) {
+			return true
+		}
+	}
+	return false
+}
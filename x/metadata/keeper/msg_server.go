@@ -55,7 +55,7 @@ func (k msgServer) WriteScope(
 		}
 	}
 
-	err = k.SetScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.Scope)
+	err = k.SetScope(markertypes.AddTransferAgents(ctx, transferAgents...), msg.Scope)
 	if err != nil {
 		return nil, fmt.Errorf("could not write scope %q: %w", msg.Scope.ScopeId, err)
 	}
@@ -77,7 +77,7 @@ func (k msgServer) DeleteScope(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	err = k.RemoveScope(markertypes.WithTransferAgents(ctx, transferAgents...), msg.ScopeId)
+	err = k.RemoveScope(markertypes.AddTransferAgents(ctx, transferAgents...), msg.ScopeId)
 	if err != nil {
 		return nil, fmt.Errorf("could not delete scope %q: %w", msg.ScopeId, err)
 	}
@@ -234,7 +234,7 @@ func (k msgServer) UpdateValueOwners(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	err = k.SetScopeValueOwners(markertypes.WithTransferAgents(ctx, signers...), links, msg.ValueOwnerAddress)
+	err = k.SetScopeValueOwners(markertypes.AddTransferAgents(ctx, signers...), links, msg.ValueOwnerAddress)
 	if err != nil {
 		return nil, fmt.Errorf("failure setting scope value owners: %w", err)
 	}
@@ -269,7 +269,7 @@ func (k msgServer) MigrateValueOwner(
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
-	err = k.SetScopeValueOwners(markertypes.WithTransferAgents(ctx, signers...), links, msg.Proposed)
+	err = k.SetScopeValueOwners(markertypes.AddTransferAgents(ctx, signers...), links, msg.Proposed)
 	if err != nil {
 		return nil, fmt.Errorf("failure setting scope value owners: %w", err)
 	}
@@ -363,6 +363,60 @@ func (k msgServer) DeleteRecord(
 	return &types.MsgDeleteRecordResponse{}, nil
 }
 
+// TombstoneRecord redacts the contents of a record while keeping its structure.
+func (k msgServer) TombstoneRecord(
+	goCtx context.Context,
+	msg *types.MsgTombstoneRecordRequest,
+) (*types.MsgTombstoneRecordResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "TombstoneRecord")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	if err := k.ValidateTombstoneRecord(ctx, msg); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := k.Keeper.TombstoneRecord(ctx, msg.RecordId, msg.Reason, msg.GetSignerStrs()); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_TombstoneRecord, msg.GetSignerStrs()))
+	return &types.MsgTombstoneRecordResponse{}, nil
+}
+
+// ProposeChange creates a pending scope or record update that its required signers can approve over time.
+func (k msgServer) ProposeChange(
+	goCtx context.Context,
+	msg *types.MsgProposeChangeRequest,
+) (*types.MsgProposeChangeResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "ProposeChange")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	changeID, executed, err := k.Keeper.ProposeChange(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ProposeChange, msg.GetSignerStrs()))
+	return &types.MsgProposeChangeResponse{ChangeId: changeID, Executed: executed}, nil
+}
+
+// ApproveChange adds approvals to a pending change, executing it once its signer requirements are satisfied.
+func (k msgServer) ApproveChange(
+	goCtx context.Context,
+	msg *types.MsgApproveChangeRequest,
+) (*types.MsgApproveChangeResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "ApproveChange")
+	ctx := UnwrapMetadataContext(goCtx)
+
+	executed, err := k.Keeper.ApproveChange(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_ApproveChange, msg.GetSignerStrs()))
+	return &types.MsgApproveChangeResponse{Executed: executed}, nil
+}
+
 // WriteScopeSpecification adds or updates a scope specification.
 func (k msgServer) WriteScopeSpecification(
 	goCtx context.Context,
@@ -658,7 +712,7 @@ func (k msgServer) BindOSLocator(
 	}
 
 	// Bind owner to URI
-	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.SetOSLocator(ctx, ownerAddress, encryptionKey, msg.Locator.LocatorUri, msg.Locator.Endpoints); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -733,7 +787,7 @@ func (k msgServer) ModifyOSLocator(
 		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot delete os locator.")
 	}
 	// Modify
-	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri); err != nil {
+	if err := k.Keeper.ModifyOSLocator(ctx, ownerAddr, encryptionKey, msg.Locator.LocatorUri, msg.Locator.Endpoints); err != nil {
 		ctx.Logger().Error("error deleting name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
@@ -742,6 +796,42 @@ func (k msgServer) ModifyOSLocator(
 	return &types.MsgModifyOSLocatorResponse{Locator: msg.Locator}, nil
 }
 
+// HeartbeatOSLocator records that an owner's object store is still alive.
+func (k msgServer) HeartbeatOSLocator(
+	goCtx context.Context,
+	msg *types.MsgHeartbeatOSLocatorRequest,
+) (*types.MsgHeartbeatOSLocatorResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "tx", "HeartbeatOSLocator")
+	ctx := UnwrapMetadataContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	// already valid address, checked in ValidateBasic
+	ownerAddr, _ := sdk.AccAddressFromBech32(msg.Owner)
+
+	if !k.Keeper.OSLocatorExists(ctx, ownerAddr) {
+		ctx.Logger().Error("Address not bound to an URI", "owner", msg.Owner)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrAddressNotBound.Error())
+	}
+
+	if !k.Keeper.VerifyCorrectOwner(ctx, ownerAddr) {
+		ctx.Logger().Error("msg sender cannot heartbeat os locator", "owner", ownerAddr)
+		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot heartbeat os locator.")
+	}
+
+	locator, err := k.Keeper.HeartbeatOSLocator(ctx, ownerAddr)
+	if err != nil {
+		ctx.Logger().Error("error recording heartbeat", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	k.EmitEvent(ctx, types.NewEventTxCompleted(types.TxEndpoint_HeartbeatOSLocator, msg.GetSignerStrs()))
+	return &types.MsgHeartbeatOSLocatorResponse{Locator: locator}, nil
+}
+
 // SetAccountData associates some basic data with a metadata address.
 // Currently, only scope ids are supported.
 func (k msgServer) SetAccountData(
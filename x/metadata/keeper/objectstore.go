@@ -34,13 +34,17 @@ func (k Keeper) OSLocatorExists(ctx sdk.Context, ownerAddr sdk.AccAddress) bool
 }
 
 // SetOSLocator binds an OS Locator to an address in the kvstore.
+// The new locator's last heartbeat height is set to the current block height.
 // An error is returned if no account exists for the address.
 // An error is returned if an OS Locator already exists for the address.
-func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, endpoints []types.LocatorEndpoint) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
+	if err = k.checkValidEndpoints(ctx, endpoints); err != nil {
+		return err
+	}
 	if account := k.authKeeper.GetAccount(ctx, ownerAddr); account == nil {
 		return types.ErrInvalidAddress
 	}
@@ -51,6 +55,8 @@ func (k Keeper) SetOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAd
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.Endpoints = endpoints
+	record.LastHeartbeatHeight = ctx.BlockHeight()
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
@@ -129,11 +135,15 @@ func (k Keeper) RemoveOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) error
 }
 
 // ModifyOSLocator updates an existing os locator entry in the kvstore, returns an error if it doesn't exist.
-func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+// Modifying a locator also counts as a heartbeat for it.
+func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, endpoints []types.LocatorEndpoint) error {
 	urlToPersist, err := k.checkValidURI(uri, ctx)
 	if err != nil {
 		return err
 	}
+	if err = k.checkValidEndpoints(ctx, endpoints); err != nil {
+		return err
+	}
 	key := types.GetOSLocatorKey(ownerAddr)
 	store := ctx.KVStore(k.storeKey)
 	if !store.Has(key) {
@@ -141,6 +151,8 @@ func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.Ac
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, urlToPersist.String())
+	record.Endpoints = endpoints
+	record.LastHeartbeatHeight = ctx.BlockHeight()
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
@@ -150,11 +162,30 @@ func (k Keeper) ModifyOSLocator(ctx sdk.Context, ownerAddr, encryptionKey sdk.Ac
 	return nil
 }
 
+// HeartbeatOSLocator records that the owner's object store is still alive by setting the
+// locator's last heartbeat height to the current block height. The updated locator is returned.
+func (k Keeper) HeartbeatOSLocator(ctx sdk.Context, ownerAddr sdk.AccAddress) (types.ObjectStoreLocator, error) {
+	record, found := k.GetOsLocatorRecord(ctx, ownerAddr)
+	if !found {
+		return types.ObjectStoreLocator{}, types.ErrAddressNotBound
+	}
+
+	record.LastHeartbeatHeight = ctx.BlockHeight()
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return types.ObjectStoreLocator{}, err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetOSLocatorKey(ownerAddr), bz)
+	k.EmitEvent(ctx, types.NewEventOSLocatorHeartbeat(record.Owner))
+	return record, nil
+}
+
 // ImportOSLocatorRecord binds a name to an address in the kvstore.
 // Different from SetOSLocator in that there is less validation here.
 // The uri format is not checked, and the owner address account is not looked up.
+// The endpoints and last heartbeat height are stored as provided.
 // This also does not emit any events.
-func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string) error {
+func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey sdk.AccAddress, uri string, endpoints []types.LocatorEndpoint, lastHeartbeatHeight int64) error {
 	key := types.GetOSLocatorKey(ownerAddr)
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
@@ -162,6 +193,8 @@ func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey
 	}
 
 	record := types.NewOSLocatorRecord(ownerAddr, encryptionKey, uri)
+	record.Endpoints = endpoints
+	record.LastHeartbeatHeight = lastHeartbeatHeight
 	bz, err := k.cdc.Marshal(&record)
 	if err != nil {
 		return err
@@ -169,3 +202,16 @@ func (k Keeper) ImportOSLocatorRecord(ctx sdk.Context, ownerAddr, encryptionKey
 	store.Set(key, bz)
 	return nil
 }
+
+// checkValidEndpoints makes sure there aren't too many endpoints and that each endpoint has a valid uri.
+func (k Keeper) checkValidEndpoints(ctx sdk.Context, endpoints []types.LocatorEndpoint) error {
+	if len(endpoints) > types.MaxOSLocatorEndpoints {
+		return types.ErrOSLocatorTooManyEndpoints
+	}
+	for _, endpoint := range endpoints {
+		if _, err := k.checkValidURI(endpoint.Uri, ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
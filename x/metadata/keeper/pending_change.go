@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// GetPendingChange returns the pending change with the given id.
+func (k Keeper) GetPendingChange(ctx sdk.Context, changeID uint64) (change types.PendingChange, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.PendingChangeKey(changeID))
+	if b == nil {
+		return types.PendingChange{}, false
+	}
+	k.cdc.MustUnmarshal(b, &change)
+	return change, true
+}
+
+// SetPendingChange stores a pending change (and its expiration index entry) in the module kv store.
+func (k Keeper) SetPendingChange(ctx sdk.Context, change types.PendingChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingChangeKey(change.ChangeId), k.cdc.MustMarshal(&change))
+	store.Set(types.PendingChangeExpirationKey(change.ExpirationHeight, change.ChangeId), []byte{})
+}
+
+// RemovePendingChange removes a pending change (and its expiration index entry) from the module kv store.
+func (k Keeper) RemovePendingChange(ctx sdk.Context, change types.PendingChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingChangeKey(change.ChangeId))
+	store.Delete(types.PendingChangeExpirationKey(change.ExpirationHeight, change.ChangeId))
+}
+
+// IteratePendingChanges processes all stored pending changes, in order of their ids, with the given handler.
+func (k Keeper) IteratePendingChanges(ctx sdk.Context, handler func(types.PendingChange) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingChangePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.PendingChange
+		if err := k.cdc.Unmarshal(it.Value(), &change); err != nil {
+			return err
+		}
+		if handler(change) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetLastPendingChangeID returns the id of the most recently proposed pending change.
+func (k Keeper) GetLastPendingChangeID(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.LastPendingChangeIDKey)
+	if len(b) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// SetLastPendingChangeID stores the id of the most recently proposed pending change.
+func (k Keeper) SetLastPendingChangeID(ctx sdk.Context, changeID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastPendingChangeIDKey, binary.BigEndian.AppendUint64(nil, changeID))
+}
+
+// nextPendingChangeID returns the id to use for a new pending change, and records it as the last one used.
+func (k Keeper) nextPendingChangeID(ctx sdk.Context) uint64 {
+	changeID := k.GetLastPendingChangeID(ctx) + 1
+	k.SetLastPendingChangeID(ctx, changeID)
+	return changeID
+}
+
+// getPendingChangeMsg returns the msg of a pending change with its approvals as the msg's signers.
+func (k Keeper) getPendingChangeMsg(change types.PendingChange) (types.MetadataMsg, error) {
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(change.Msg, &msg); err != nil {
+		return nil, fmt.Errorf("could not unpack pending change %d msg: %w", change.ChangeId, err)
+	}
+	return types.NewPendingChangeMsg(msg, change.Approvals)
+}
+
+// ProposeChange stores a new pending change, using the signers of the proposal as its first approvals.
+// It returns the id of the new pending change, and whether those approvals were enough for it to be executed.
+func (k Keeper) ProposeChange(ctx sdk.Context, msg *types.MsgProposeChangeRequest) (uint64, bool, error) {
+	height := ctx.BlockHeight()
+	if msg.ExpirationHeight <= height {
+		return 0, false, fmt.Errorf("expiration height %d must be after the current block height %d", msg.ExpirationHeight, height)
+	}
+	if msg.ExpirationHeight > height+types.MaxPendingChangeBlocks {
+		return 0, false, fmt.Errorf("expiration height %d cannot be more than %d blocks after the current block height %d",
+			msg.ExpirationHeight, types.MaxPendingChangeBlocks, height)
+	}
+
+	pcMsg, err := msg.GetPendingChangeMsg()
+	if err != nil {
+		return 0, false, err
+	}
+	scopeID := types.GetPendingChangeScopeID(pcMsg)
+	if !scopeID.IsScopeAddress() {
+		return 0, false, fmt.Errorf("could not determine the scope being changed by %s", msg.Msg.TypeUrl)
+	}
+
+	change := types.NewPendingChange(k.nextPendingChangeID(ctx), scopeID, msg.Msg, k.UnionDistinct(msg.Signers), msg.ExpirationHeight)
+	k.SetPendingChange(ctx, *change)
+	k.EmitEvent(ctx, types.NewEventPendingChangeProposed(*change))
+
+	return change.ChangeId, k.tryExecutePendingChange(ctx, *change), nil
+}
+
+// ApproveChange adds the signers of the request as approvals of a pending change.
+// It returns whether the change was executed because of these approvals.
+func (k Keeper) ApproveChange(ctx sdk.Context, msg *types.MsgApproveChangeRequest) (bool, error) {
+	change, found := k.GetPendingChange(ctx, msg.ChangeId)
+	if !found {
+		return false, fmt.Errorf("pending change %d not found", msg.ChangeId)
+	}
+	if change.ExpirationHeight <= ctx.BlockHeight() {
+		return false, fmt.Errorf("pending change %d expired at height %d", change.ChangeId, change.ExpirationHeight)
+	}
+	for _, signer := range msg.Signers {
+		if change.HasApproval(signer) {
+			return false, fmt.Errorf("%s has already approved pending change %d", signer, change.ChangeId)
+		}
+	}
+
+	change.Approvals = k.UnionDistinct(change.Approvals, msg.Signers)
+	k.SetPendingChange(ctx, change)
+	k.EmitEvent(ctx, types.NewEventPendingChangeApproved(change.ChangeId, msg.Signers))
+
+	return k.tryExecutePendingChange(ctx, change), nil
+}
+
+// tryExecutePendingChange runs the msg of a pending change with the change's approvals as the msg's signers.
+// If that succeeds, the pending change is removed and true is returned. If it fails (e.g. because it's still
+// missing a required signer), nothing it did is kept, the change stays pending, and false is returned.
+func (k Keeper) tryExecutePendingChange(ctx sdk.Context, change types.PendingChange) bool {
+	msg, err := k.getPendingChangeMsg(change)
+	if err != nil {
+		k.Logger(ctx).Error("could not get pending change msg", "change_id", change.ChangeId, "error", err)
+		return false
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	msgServer := NewMsgServerImpl(k)
+	switch m := msg.(type) {
+	case *types.MsgWriteScopeRequest:
+		_, err = msgServer.WriteScope(cacheCtx, m)
+	case *types.MsgWriteRecordRequest:
+		_, err = msgServer.WriteRecord(cacheCtx, m)
+	default:
+		err = fmt.Errorf("unsupported pending change msg type %T", msg)
+	}
+	if err != nil {
+		k.Logger(ctx).Debug("pending change not executed", "change_id", change.ChangeId, "reason", err)
+		return false
+	}
+
+	writeCache()
+	k.RemovePendingChange(ctx, change)
+	k.EmitEvent(ctx, types.NewEventPendingChangeExecuted(change))
+	return true
+}
+
+// ExpirePendingChanges removes the pending changes that have reached their expiration height without being executed.
+func (k Keeper) ExpirePendingChanges(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	store := ctx.KVStore(k.storeKey)
+
+	var expiredKeys [][]byte
+	var expiredIDs []uint64
+	it := storetypes.KVStorePrefixIterator(store, types.PendingChangeExpirationPrefix)
+	for ; it.Valid(); it.Next() {
+		expHeight, changeID, err := types.ParsePendingChangeExpirationKey(it.Key())
+		if err != nil {
+			continue
+		}
+		if expHeight > height {
+			break
+		}
+		expiredKeys = append(expiredKeys, it.Key())
+		expiredIDs = append(expiredIDs, changeID)
+	}
+	it.Close()
+
+	for i, changeID := range expiredIDs {
+		change, found := k.GetPendingChange(ctx, changeID)
+		if !found {
+			store.Delete(expiredKeys[i])
+			continue
+		}
+		k.RemovePendingChange(ctx, change)
+		k.EmitEvent(ctx, types.NewEventPendingChangeExpired(change))
+	}
+}
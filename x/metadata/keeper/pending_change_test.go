@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func (s *ScopeKeeperTestSuite) TestPendingChanges() {
+	ctx := s.FreshCtx().WithBlockHeight(10)
+	k := s.app.MetadataKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	scopeSpec := types.NewScopeSpecification(s.scopeSpecID, nil, []string{s.user1}, []types.PartyType{types.PartyType_PARTY_TYPE_OWNER}, []types.MetadataAddress{})
+	k.SetScopeSpecification(ctx, *scopeSpec)
+	owners := []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+		{Address: s.user2, Role: types.PartyType_PARTY_TYPE_OWNER},
+	}
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, owners, nil, s.user1, false)
+	s.Require().NoError(k.SetScope(ctx, *scope), "SetScope")
+
+	newScopeMsg := func(dataAccess ...string) *types.MsgWriteScopeRequest {
+		updated := *scope
+		updated.DataAccess = dataAccess
+		return types.NewMsgWriteScopeRequest(updated, nil, 0)
+	}
+	propose := func(msg *types.MsgWriteScopeRequest, expirationHeight int64, signers ...string) (*types.MsgProposeChangeResponse, error) {
+		proposal, err := types.NewMsgProposeChangeRequest(msg, expirationHeight, signers)
+		s.Require().NoError(err, "NewMsgProposeChangeRequest")
+		return msgServer.ProposeChange(ctx, proposal)
+	}
+	approve := func(changeID uint64, signers ...string) (*types.MsgApproveChangeResponse, error) {
+		return msgServer.ApproveChange(ctx, types.NewMsgApproveChangeRequest(changeID, signers))
+	}
+	getDataAccess := func() []string {
+		found, ok := k.GetScope(ctx, s.scopeID)
+		s.Require().True(ok, "GetScope found")
+		return found.DataAccess
+	}
+
+	s.Run("unsupported msg", func() {
+		proposal, err := types.NewMsgProposeChangeRequest(types.NewMsgDeleteScopeRequest(s.scopeID, nil), 20, []string{s.user1})
+		s.Require().NoError(err, "NewMsgProposeChangeRequest")
+		_, err = msgServer.ProposeChange(ctx, proposal)
+		s.Assert().ErrorContains(err, "unsupported pending change msg type *types.MsgDeleteScopeRequest", "ProposeChange")
+	})
+
+	s.Run("expiration height not after current height", func() {
+		_, err := propose(newScopeMsg(s.user3), 10, s.user1)
+		s.Assert().ErrorContains(err, "expiration height 10 must be after the current block height 10", "ProposeChange")
+	})
+
+	s.Run("expiration height too far away", func() {
+		_, err := propose(newScopeMsg(s.user3), 11+types.MaxPendingChangeBlocks, s.user1)
+		s.Assert().ErrorContains(err, "cannot be more than 1000000 blocks after the current block height 10", "ProposeChange")
+	})
+
+	s.Run("approvals collected over time", func() {
+		resp, err := propose(newScopeMsg(s.user3), 20, s.user1)
+		s.Require().NoError(err, "ProposeChange")
+		s.Assert().Equal(uint64(1), resp.ChangeId, "ProposeChange change id")
+		s.Assert().False(resp.Executed, "ProposeChange executed")
+		s.Assert().Empty(getDataAccess(), "scope data access after proposal")
+
+		qResp, err := s.queryClient.PendingChange(ctx, &types.QueryPendingChangeRequest{ChangeId: 1})
+		s.Require().NoError(err, "PendingChange")
+		s.Assert().Equal(s.scopeID, qResp.PendingChange.ScopeId, "PendingChange scope id")
+		s.Assert().Equal([]string{s.user1}, qResp.PendingChange.Approvals, "PendingChange approvals")
+		s.Assert().Equal(int64(20), qResp.PendingChange.ExpirationHeight, "PendingChange expiration height")
+
+		listResp, err := s.queryClient.PendingChanges(ctx, &types.QueryPendingChangesRequest{ScopeId: s.scopeID.String()})
+		s.Require().NoError(err, "PendingChanges scope")
+		s.Assert().Len(listResp.PendingChanges, 1, "PendingChanges scope")
+		otherScopeID := types.ScopeMetadataAddress(uuid.New())
+		listResp, err = s.queryClient.PendingChanges(ctx, &types.QueryPendingChangesRequest{ScopeId: otherScopeID.String()})
+		s.Require().NoError(err, "PendingChanges other scope")
+		s.Assert().Empty(listResp.PendingChanges, "PendingChanges other scope")
+
+		_, err = approve(1, s.user1)
+		s.Assert().ErrorContains(err, s.user1+" has already approved pending change 1", "ApproveChange again")
+
+		ctx = ctx.WithBlockHeight(15)
+		aResp, err := approve(1, s.user2)
+		s.Require().NoError(err, "ApproveChange")
+		s.Assert().True(aResp.Executed, "ApproveChange executed")
+		s.Assert().Equal([]string{s.user3}, getDataAccess(), "scope data access after approval")
+		_, found := k.GetPendingChange(ctx, 1)
+		s.Assert().False(found, "GetPendingChange after execution")
+
+		_, err = approve(1, s.user3)
+		s.Assert().ErrorContains(err, "pending change 1 not found", "ApproveChange after execution")
+	})
+
+	s.Run("executed right away", func() {
+		resp, err := propose(newScopeMsg(s.user2), 30, s.user1, s.user2)
+		s.Require().NoError(err, "ProposeChange")
+		s.Assert().Equal(uint64(2), resp.ChangeId, "ProposeChange change id")
+		s.Assert().True(resp.Executed, "ProposeChange executed")
+		s.Assert().Equal([]string{s.user2}, getDataAccess(), "scope data access after proposal")
+	})
+
+	s.Run("approval from a non-owner does not execute", func() {
+		resp, err := propose(newScopeMsg(s.user1), 30, s.user1)
+		s.Require().NoError(err, "ProposeChange")
+		aResp, err := approve(resp.ChangeId, s.user3)
+		s.Require().NoError(err, "ApproveChange")
+		s.Assert().False(aResp.Executed, "ApproveChange executed")
+		s.Assert().Equal([]string{s.user2}, getDataAccess(), "scope data access after approval")
+		change, found := k.GetPendingChange(ctx, resp.ChangeId)
+		s.Require().True(found, "GetPendingChange found")
+		s.Assert().Equal([]string{s.user1, s.user3}, change.Approvals, "GetPendingChange approvals")
+
+		genState := k.ExportGenesis(ctx)
+		s.Assert().Equal([]types.PendingChange{change}, genState.PendingChanges, "exported pending changes")
+		s.Assert().Equal(resp.ChangeId, genState.LastPendingChangeId, "exported last pending change id")
+		s.Assert().NoError(genState.Validate(), "exported genesis Validate")
+	})
+
+	s.Run("expired", func() {
+		ctx = ctx.WithBlockHeight(30)
+		_, err := approve(3, s.user2)
+		s.Assert().ErrorContains(err, "pending change 3 expired at height 30", "ApproveChange at expiration height")
+
+		k.ExpirePendingChanges(ctx)
+		_, found := k.GetPendingChange(ctx, 3)
+		s.Assert().False(found, "GetPendingChange after expiration")
+		listResp, err := s.queryClient.PendingChanges(ctx, &types.QueryPendingChangesRequest{})
+		s.Require().NoError(err, "PendingChanges")
+		s.Assert().Empty(listResp.PendingChanges, "PendingChanges after expiration")
+		s.Assert().Equal([]string{s.user2}, getDataAccess(), "scope data access after expiration")
+	})
+}
@@ -1163,6 +1163,43 @@ func (k Keeper) OSAllLocators(ctx context.Context, request *types.OSAllLocatorsR
 	return &retval, nil
 }
 
+func (k Keeper) OSStaleLocators(ctx context.Context, request *types.OSStaleLocatorsRequest) (*types.OSStaleLocatorsResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "OSStaleLocators")
+	retval := types.OSStaleLocatorsResponse{}
+	if request == nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if request.IncludeRequest {
+		retval.Request = request
+	}
+	if request.MaxAgeBlocks < 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("max age blocks cannot be negative: %d", request.MaxAgeBlocks)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+	osLocatorStore := prefix.NewStore(sdkCtx.KVStore(k.storeKey), types.OSLocatorAddressKeyPrefix)
+	var err error
+	retval.Pagination, err = query.FilteredPaginate(osLocatorStore, request.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		record := types.ObjectStoreLocator{}
+		if rerr := k.cdc.Unmarshal(value, &record); rerr != nil {
+			return false, rerr
+		}
+		if !record.IsStale(height, request.MaxAgeBlocks) {
+			return false, nil
+		}
+		if accumulate {
+			retval.Locators = append(retval.Locators, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &retval, err
+	}
+
+	return &retval, nil
+}
+
 func (k Keeper) AccountData(c context.Context, req *types.AccountDataRequest) (*types.AccountDataResponse, error) {
 	if req == nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
@@ -1344,15 +1381,113 @@ func (k Keeper) ScopeNetAssetValues(c context.Context, req *types.QueryScopeNetA
 	}
 
 	var navs []types.NetAssetValue
-	err = k.IterateNetAssetValues(ctx, scopeID, func(nav types.NetAssetValue) (stop bool) {
+	navStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.NetAssetValueKeyPrefix(scopeID))
+	pageRes, err := query.Paginate(navStore, getPageRequest(req), func(_, value []byte) error {
+		var nav types.NetAssetValue
+		if vErr := k.cdc.Unmarshal(value, &nav); vErr != nil {
+			return vErr
+		}
 		navs = append(navs, nav)
-		return false
+		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs, Pagination: pageRes}, nil
+}
+
+// RecordTombstones returns the tombstones of a scope's records that have had their contents redacted.
+func (k Keeper) RecordTombstones(c context.Context, req *types.QueryRecordTombstonesRequest) (*types.QueryRecordTombstonesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "RecordTombstones")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(req.Id) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request parameters")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	id, err := types.MetadataAddressFromBech32(req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not parse [%s] into a metadata address: %v", req.Id, err)
 	}
 
-	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs}, nil
+	keyPrefix, err := types.RecordTombstoneScopeKeyPrefix(id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	var tombstones []types.RecordTombstone
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		var tombstone types.RecordTombstone
+		if vErr := k.cdc.Unmarshal(value, &tombstone); vErr != nil {
+			return vErr
+		}
+		tombstones = append(tombstones, tombstone)
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.QueryRecordTombstonesResponse{Tombstones: tombstones, Pagination: pageRes}, nil
+}
+
+// PendingChange returns a scope or record change that is waiting on approvals.
+func (k Keeper) PendingChange(c context.Context, req *types.QueryPendingChangeRequest) (*types.QueryPendingChangeResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "PendingChange")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+	if req.ChangeId == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("change id cannot be zero")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	change, found := k.GetPendingChange(ctx, req.ChangeId)
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("pending change %d not found", req.ChangeId)
+	}
+	return &types.QueryPendingChangeResponse{PendingChange: change}, nil
+}
+
+// PendingChanges returns the scope and record changes that are waiting on approvals (limited by pagination).
+// If a scope id is provided, only the pending changes of that scope are returned.
+func (k Keeper) PendingChanges(c context.Context, req *types.QueryPendingChangesRequest) (*types.QueryPendingChangesResponse, error) {
+	defer telemetry.MeasureSince(telemetry.Now(), types.ModuleName, "query", "PendingChanges")
+	var scopeID types.MetadataAddress
+	if req != nil && len(req.ScopeId) > 0 {
+		var err error
+		scopeID, err = ParseScopeID(req.ScopeId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingChangePrefix)
+
+	retval := &types.QueryPendingChangesResponse{}
+	pageRes, err := query.FilteredPaginate(prefixStore, getPageRequest(req), func(_, value []byte, accumulate bool) (bool, error) {
+		var change types.PendingChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return false, err
+		}
+		if !scopeID.Empty() && !scopeID.Equals(change.ScopeId) {
+			return false, nil
+		}
+		if accumulate {
+			retval.PendingChanges = append(retval.PendingChanges, change)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	retval.Pagination = pageRes
+	return retval, nil
 }
 
 // hasPageRequest is just for use with the getPageRequest func below.
@@ -2254,6 +2254,18 @@ func (s *QueryServerTestSuite) TestScopeNetAssetValuesQuery() {
 			expErr:     "",
 			expNavsLen: len(netAssetValues),
 		},
+		{
+			name:       "Valid Request with limit",
+			req:        &types.QueryScopeNetAssetValuesRequest{Id: scopeID.String(), Pagination: &query.PageRequest{Limit: 2}},
+			expErr:     "",
+			expNavsLen: 2,
+		},
+		{
+			name:       "Valid Request with offset",
+			req:        &types.QueryScopeNetAssetValuesRequest{Id: scopeID.String(), Pagination: &query.PageRequest{Offset: 4}},
+			expErr:     "",
+			expNavsLen: 1,
+		},
 		{
 			name:       "Valid Request without results",
 			req:        &types.QueryScopeNetAssetValuesRequest{Id: scopeIDNF.String()},
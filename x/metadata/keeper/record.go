@@ -83,6 +83,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
+	k.RemoveRecordTombstone(ctx, id)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 
 	// Remove the session too if there are no more records in it.
@@ -130,6 +131,9 @@ func (k Keeper) ValidateWriteRecord(
 		if existing.Name != proposed.Name {
 			return fmt.Errorf("the Name field of records cannot be changed")
 		}
+		if recordID := existing.GetRecordAddress(); k.IsRecordTombstoned(ctx, recordID) {
+			return fmt.Errorf("record %s has been tombstoned and cannot be changed", recordID)
+		}
 		if !existing.SessionId.Equals(proposed.SessionId) {
 			// If the session is changing, add the original session's parties to the required parties list.
 			// If it's somehow not found, just ignore that and let the record be updated as normal.
@@ -177,8 +181,25 @@ func (k Keeper) ValidateWriteRecord(
 			recSpecID, session.SpecificationId, proposed.Name)
 	}
 
+	// The contract spec can restrict which roles are allowed to write this record.
+	// If it's not found, it's the zero value, which doesn't restrict anything.
+	contractSpec, _ := k.GetContractSpecification(ctx, session.SpecificationId)
+	writeRoles, hasWriteRoles := contractSpec.WriteRolesForRecord(proposed.Name)
+
 	// Make sure everyone has signed.
-	if !scope.RequirePartyRollup {
+	switch {
+	case hasWriteRoles && oldSession == nil:
+		// Write roles:
+		//   - All roles required by the record spec must have a party in the session parties.
+		//   - At least one session party with a role allowed to write the record must be a signer.
+		//   - Changing a record's session still requires the normal signers (see the other cases).
+		if err = validateRolesPresent(session.Parties, recSpec.ResponsibleParties); err != nil {
+			return err
+		}
+		if err = k.ValidateSignersWithWriteRoles(ctx, session.Parties, writeRoles, msg); err != nil {
+			return err
+		}
+	case !scope.RequirePartyRollup:
 		// Old:
 		//   - All roles required by the record spec must have a party in the session parties.
 		//   - All session parties must sign.
@@ -193,7 +214,7 @@ func (k Keeper) ValidateWriteRecord(
 		if err = k.ValidateSignersWithoutParties(ctx, reqSigs, msg); err != nil {
 			return err
 		}
-	} else {
+	default:
 		// New:
 		//   - All roles required by the record spec must have a signer and associated party in the session.
 		//   - All optional=false scope owners and session parties must be signers.
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/app"
 	simapp "github.com/provenance-io/provenance/app"
@@ -1014,3 +1015,230 @@ func (s *RecordKeeperTestSuite) TestValidateWriteRecord() {
 		})
 	}
 }
+
+func (s *RecordKeeperTestSuite) TestValidateWriteRecordWithWriteRoles() {
+	originator := types.PartyType_PARTY_TYPE_ORIGINATOR
+	servicer := types.PartyType_PARTY_TYPE_SERVICER
+	ctx := s.FreshCtx()
+
+	cSpec := types.ContractSpecification{
+		SpecificationId: types.ContractSpecMetadataAddress(uuid.New()),
+		OwnerAddresses:  []string{s.user1},
+		PartiesInvolved: []types.PartyType{originator, servicer},
+		Source:          types.NewContractSpecificationSourceHash("somehash"),
+		ClassName:       "classname",
+		RecordWriteRoles: []types.RecordWriteRoles{
+			{RecordName: "payment", Roles: []types.PartyType{servicer}},
+		},
+	}
+	s.app.MetadataKeeper.SetContractSpecification(ctx, cSpec)
+	for _, name := range []string{"payment", "loan"} {
+		s.app.MetadataKeeper.SetRecordSpecification(ctx, types.RecordSpecification{
+			SpecificationId:    cSpec.SpecificationId.MustGetAsRecordSpecAddress(name),
+			Name:               name,
+			Inputs:             []*types.InputSpecification{},
+			TypeName:           "TestRecordTypeName",
+			ResultType:         types.DefinitionType_DEFINITION_TYPE_RECORD,
+			ResponsibleParties: []types.PartyType{servicer},
+		})
+	}
+
+	scopeUUID := uuid.New()
+	scope := types.NewScope(types.ScopeMetadataAddress(scopeUUID), s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+	s.app.MetadataKeeper.SetScope(ctx, *scope)
+	session := types.Session{
+		SessionId:       types.SessionMetadataAddress(scopeUUID, uuid.New()),
+		SpecificationId: cSpec.SpecificationId,
+		Parties: []types.Party{
+			{Address: s.user1, Role: originator},
+			{Address: s.user2, Role: servicer},
+		},
+		Name: s.sessionName,
+	}
+	s.app.MetadataKeeper.SetSession(ctx, session)
+
+	newRecord := func(name string) types.Record {
+		return types.Record{
+			Name:      name,
+			SessionId: session.SessionId,
+			Process:   *types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method"),
+			Outputs:   []types.RecordOutput{{Hash: "justsomeoutput", Status: types.ResultStatus_RESULT_STATUS_PASS}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		record   types.Record
+		signers  []string
+		errorMsg string
+	}{
+		{
+			name:    "restricted record signed by allowed role only",
+			record:  newRecord("payment"),
+			signers: []string{s.user2},
+		},
+		{
+			name:    "restricted record signed by everyone",
+			record:  newRecord("payment"),
+			signers: []string{s.user1, s.user2},
+		},
+		{
+			name:     "restricted record not signed by allowed role",
+			record:   newRecord("payment"),
+			signers:  []string{s.user1},
+			errorMsg: "missing signer with a role allowed to write this record: SERVICER",
+		},
+		{
+			name:     "unrestricted record signed by one party",
+			record:   newRecord("loan"),
+			signers:  []string{s.user2},
+			errorMsg: "missing signature: " + s.user1,
+		},
+		{
+			name:    "unrestricted record signed by all parties",
+			record:  newRecord("loan"),
+			signers: []string{s.user1, s.user2},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			msg := &types.MsgWriteRecordRequest{
+				Record:  tc.record,
+				Signers: tc.signers,
+			}
+			err := s.app.MetadataKeeper.ValidateWriteRecord(s.FreshCtx(), nil, msg)
+			if len(tc.errorMsg) != 0 {
+				s.Assert().EqualError(err, tc.errorMsg, "ValidateWriteRecord expected error")
+			} else {
+				s.Assert().NoError(err, "ValidateWriteRecord unexpected error")
+			}
+		})
+	}
+}
+
+func (s *RecordKeeperTestSuite) TestTombstoneRecord() {
+	ctx := s.FreshCtx()
+	k := s.app.MetadataKeeper
+	user3 := sdk.AccAddress("user_3______________").String()
+
+	owners := []types.Party{
+		{Address: s.user1, Role: types.PartyType_PARTY_TYPE_OWNER},
+		{Address: s.user2, Role: types.PartyType_PARTY_TYPE_CONTROLLER},
+		{Address: user3, Role: types.PartyType_PARTY_TYPE_INVESTOR},
+	}
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, owners, []string{s.user1}, s.user1, false)
+	s.Require().NoError(k.SetScope(ctx, *scope), "SetScope")
+
+	otherScopeUUID := uuid.New()
+	otherScope := types.NewScope(types.ScopeMetadataAddress(otherScopeUUID), s.scopeSpecID,
+		[]types.Party{{Address: user3, Role: types.PartyType_PARTY_TYPE_INVESTOR}}, nil, user3, false)
+	s.Require().NoError(k.SetScope(ctx, *otherScope), "SetScope other")
+
+	otherRecordID := types.RecordMetadataAddress(s.scopeUUID, "otherrecord")
+	inputs := []types.RecordInput{
+		*types.NewRecordInput("hashed", &types.RecordInput_Hash{Hash: "inputhash"}, "typename", types.RecordInputStatus_Proposed),
+		*types.NewRecordInput("recorded", &types.RecordInput_RecordId{RecordId: otherRecordID}, "typename", types.RecordInputStatus_Record),
+	}
+	outputs := []types.RecordOutput{
+		*types.NewRecordOutput("outputhash1", types.ResultStatus_RESULT_STATUS_PASS),
+		*types.NewRecordOutput("outputhash2", types.ResultStatus_RESULT_STATUS_SKIP),
+	}
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := types.NewRecord(s.recordName, s.sessionID, *process, inputs, outputs, s.recordSpecID)
+	k.SetRecord(ctx, *record)
+
+	otherScopeRecordID := types.RecordMetadataAddress(otherScopeUUID, s.recordName)
+	otherScopeRecord := types.NewRecord(s.recordName, types.SessionMetadataAddress(otherScopeUUID, uuid.New()), *process, inputs, outputs, s.recordSpecID)
+	k.SetRecord(ctx, *otherScopeRecord)
+
+	tests := []struct {
+		name   string
+		msg    *types.MsgTombstoneRecordRequest
+		expErr string
+	}{
+		{
+			name:   "record does not exist",
+			msg:    types.NewMsgTombstoneRecordRequest(otherRecordID, "reason", []string{s.user1, s.user2}),
+			expErr: "record does not exist to tombstone: " + otherRecordID.String(),
+		},
+		{
+			name:   "scope has no owners or controllers",
+			msg:    types.NewMsgTombstoneRecordRequest(otherScopeRecordID, "reason", []string{user3}),
+			expErr: "scope " + otherScope.ScopeId.String() + " does not have any OWNER or CONTROLLER owners to tombstone records",
+		},
+		{
+			name:   "missing controller signature",
+			msg:    types.NewMsgTombstoneRecordRequest(s.recordID, "reason", []string{s.user1, user3}),
+			expErr: "missing signature: " + s.user2,
+		},
+		{
+			name:   "missing owner signature",
+			msg:    types.NewMsgTombstoneRecordRequest(s.recordID, "reason", []string{s.user2}),
+			expErr: "missing signature: " + s.user1,
+		},
+		{
+			name: "owner and controller signed",
+			msg:  types.NewMsgTombstoneRecordRequest(s.recordID, "reason", []string{s.user1, s.user2}),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := k.ValidateTombstoneRecord(ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "ValidateTombstoneRecord")
+			} else {
+				s.Assert().NoError(err, "ValidateTombstoneRecord")
+			}
+		})
+	}
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(time.Unix(1700000000, 0).UTC())
+	signers := []string{s.user1, s.user2}
+	s.Require().NoError(k.TombstoneRecord(ctx, s.recordID, "erasure request", signers), "TombstoneRecord")
+
+	redacted, found := k.GetRecord(ctx, s.recordID)
+	s.Require().True(found, "GetRecord found")
+	s.Assert().Equal(record.Name, redacted.Name, "Name")
+	s.Assert().Equal(record.Process, redacted.Process, "Process")
+	s.Require().Len(redacted.Inputs, 2, "Inputs")
+	s.Assert().Equal(&types.RecordInput_Hash{Hash: types.RedactedHash}, redacted.Inputs[0].Source, "Inputs[0].Source")
+	s.Assert().Equal(inputs[1], redacted.Inputs[1], "Inputs[1]")
+	s.Require().Len(redacted.Outputs, 2, "Outputs")
+	for i, output := range redacted.Outputs {
+		s.Assert().Equal(types.RedactedHash, output.Hash, "Outputs[%d].Hash", i)
+		s.Assert().Equal(outputs[i].Status, output.Status, "Outputs[%d].Status", i)
+	}
+
+	expTombstone := *types.NewRecordTombstone(s.recordID, "erasure request", signers, ctx.BlockTime(), 12)
+	tombstone, found := k.GetRecordTombstone(ctx, s.recordID)
+	s.Require().True(found, "GetRecordTombstone found")
+	s.Assert().Equal(expTombstone, tombstone, "GetRecordTombstone")
+
+	resp, err := s.queryClient.RecordTombstones(ctx, &types.QueryRecordTombstonesRequest{Id: s.scopeID.String()})
+	s.Require().NoError(err, "RecordTombstones")
+	s.Assert().Equal([]types.RecordTombstone{expTombstone}, resp.Tombstones, "RecordTombstones")
+	resp, err = s.queryClient.RecordTombstones(ctx, &types.QueryRecordTombstonesRequest{
+		Id:         s.scopeID.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true, Reverse: true},
+	})
+	s.Require().NoError(err, "RecordTombstones paginated")
+	s.Assert().Equal([]types.RecordTombstone{expTombstone}, resp.Tombstones, "RecordTombstones paginated")
+	if s.Assert().NotNil(resp.Pagination, "RecordTombstones paginated Pagination") {
+		s.Assert().Equal(1, int(resp.Pagination.Total), "RecordTombstones paginated Pagination.Total")
+		s.Assert().Empty(resp.Pagination.NextKey, "RecordTombstones paginated Pagination.NextKey")
+	}
+	resp, err = s.queryClient.RecordTombstones(ctx, &types.QueryRecordTombstonesRequest{Id: otherScope.ScopeId.String()})
+	s.Require().NoError(err, "RecordTombstones other scope")
+	s.Assert().Empty(resp.Tombstones, "RecordTombstones other scope")
+
+	err = k.ValidateTombstoneRecord(ctx, types.NewMsgTombstoneRecordRequest(s.recordID, "again", signers))
+	s.Assert().EqualError(err, "record "+s.recordID.String()+" has already been tombstoned", "ValidateTombstoneRecord again")
+
+	err = k.ValidateWriteRecord(ctx, &redacted, &types.MsgWriteRecordRequest{Record: *record, Signers: signers})
+	s.Assert().EqualError(err, "record "+s.recordID.String()+" has been tombstoned and cannot be changed", "ValidateWriteRecord")
+
+	k.RemoveRecord(ctx, s.recordID)
+	s.Assert().False(k.IsRecordTombstoned(ctx, s.recordID), "IsRecordTombstoned after RemoveRecord")
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// TombstoneRoles are the scope owner roles that can tombstone a record in that scope.
+var TombstoneRoles = []types.PartyType{types.PartyType_PARTY_TYPE_OWNER, types.PartyType_PARTY_TYPE_CONTROLLER}
+
+// GetRecordTombstone returns the tombstone of the record with the given id.
+func (k Keeper) GetRecordTombstone(ctx sdk.Context, recordID types.MetadataAddress) (tombstone types.RecordTombstone, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.RecordTombstoneKey(recordID))
+	if b == nil {
+		return types.RecordTombstone{}, false
+	}
+	k.cdc.MustUnmarshal(b, &tombstone)
+	return tombstone, true
+}
+
+// IsRecordTombstoned returns true if the record with the given id has been tombstoned.
+func (k Keeper) IsRecordTombstoned(ctx sdk.Context, recordID types.MetadataAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RecordTombstoneKey(recordID))
+}
+
+// SetRecordTombstone stores a record tombstone in the module kv store.
+func (k Keeper) SetRecordTombstone(ctx sdk.Context, tombstone types.RecordTombstone) {
+	ctx.KVStore(k.storeKey).Set(types.RecordTombstoneKey(tombstone.RecordId), k.cdc.MustMarshal(&tombstone))
+}
+
+// RemoveRecordTombstone removes a record tombstone from the module kv store.
+func (k Keeper) RemoveRecordTombstone(ctx sdk.Context, recordID types.MetadataAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.RecordTombstoneKey(recordID))
+}
+
+// IterateRecordTombstones processes stored record tombstones with the given handler.
+// If the scopeID is an empty MetadataAddress, all record tombstones will be processed.
+// Otherwise, just the tombstones of the records in the given scopeID will be processed.
+func (k Keeper) IterateRecordTombstones(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.RecordTombstone) (stop bool)) error {
+	prefix, err := types.RecordTombstoneScopeKeyPrefix(scopeID)
+	if err != nil {
+		return err
+	}
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var tombstone types.RecordTombstone
+		if err = k.cdc.Unmarshal(it.Value(), &tombstone); err != nil {
+			return err
+		}
+		if handler(tombstone) {
+			break
+		}
+	}
+	return nil
+}
+
+// ValidateTombstoneRecord checks the current record and scope to determine if the proposed tombstoning is valid.
+// All of the scope's owners with one of the TombstoneRoles must be signers.
+func (k Keeper) ValidateTombstoneRecord(ctx sdk.Context, msg *types.MsgTombstoneRecordRequest) error {
+	if _, found := k.GetRecord(ctx, msg.RecordId); !found {
+		return fmt.Errorf("record does not exist to tombstone: %s", msg.RecordId)
+	}
+	if k.IsRecordTombstoned(ctx, msg.RecordId) {
+		return fmt.Errorf("record %s has already been tombstoned", msg.RecordId)
+	}
+
+	// GetRecord found a record, so we know the record id is good and will have a scope id.
+	scopeID, _ := msg.RecordId.AsScopeAddress()
+	scope, found := k.GetScope(ctx, scopeID)
+	if !found {
+		return fmt.Errorf("scope not found with id %s", scopeID)
+	}
+
+	var required []string
+	for _, owner := range scope.Owners {
+		if slices.Contains(TombstoneRoles, owner.Role) {
+			required = append(required, owner.Address)
+		}
+	}
+	if len(required) == 0 {
+		return fmt.Errorf("scope %s does not have any %s or %s owners to tombstone records", scopeID,
+			types.PartyType_PARTY_TYPE_OWNER.SimpleString(), types.PartyType_PARTY_TYPE_CONTROLLER.SimpleString())
+	}
+
+	return k.ValidateSignersWithoutParties(ctx, required, msg)
+}
+
+// TombstoneRecord replaces the hashes of a record's inputs and outputs with the redaction marker and
+// records who did it, when, and why. The rest of the record is kept as it is.
+func (k Keeper) TombstoneRecord(ctx sdk.Context, recordID types.MetadataAddress, reason string, signers []string) error {
+	record, found := k.GetRecord(ctx, recordID)
+	if !found {
+		return fmt.Errorf("record does not exist to tombstone: %s", recordID)
+	}
+	k.SetRecord(ctx, record.Redacted())
+	k.SetRecordTombstone(ctx, *types.NewRecordTombstone(recordID, reason, signers, ctx.BlockTime(), ctx.BlockHeight()))
+	k.EmitEvent(ctx, types.NewEventRecordTombstoned(recordID, reason))
+	return nil
+}
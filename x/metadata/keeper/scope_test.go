@@ -3570,3 +3570,41 @@ func (s *ScopeKeeperTestSuite) TestRemoveNetAssetValues() {
 		})
 	}
 }
+
+func (s *ScopeKeeperTestSuite) TestScopeIndexInvariant() {
+	ctx := s.FreshCtx()
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user2}, s.user1, false)
+	defer WriteTempScope(s.T(), s.app.MetadataKeeper, ctx, scope)()
+
+	invariant := keeper.ScopeIndexInvariant(s.app.MetadataKeeper)
+	msg, isBroken := invariant(ctx)
+	s.Require().False(isBroken, "consistent state: %s", msg)
+
+	s.Run("missing index entry", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		indexKey := types.GetAddressScopeCacheKey(s.user2Addr, s.scopeID)
+		cacheCtx.KVStore(s.app.GetKey(types.StoreKey)).Delete(indexKey)
+		msg, isBroken = invariant(cacheCtx)
+		s.Assert().True(isBroken, "isBroken")
+		s.Assert().Contains(msg, fmt.Sprintf("scope %s is missing index entry %X", s.scopeID, indexKey), "msg")
+	})
+
+	s.Run("stale index entry", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		indexKey := types.GetAddressScopeCacheKey(s.user3Addr, s.scopeID)
+		cacheCtx.KVStore(s.app.GetKey(types.StoreKey)).Set(indexKey, []byte{0x01})
+		msg, isBroken = invariant(cacheCtx)
+		s.Assert().True(isBroken, "isBroken")
+		s.Assert().Contains(msg, fmt.Sprintf("index entry %X is not an index of scope %s", indexKey, s.scopeID), "msg")
+	})
+
+	s.Run("index entry for unknown scope", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		otherID := types.ScopeMetadataAddress(uuid.New())
+		indexKey := types.GetScopeSpecScopeCacheKey(s.scopeSpecID, otherID)
+		cacheCtx.KVStore(s.app.GetKey(types.StoreKey)).Set(indexKey, []byte{0x01})
+		msg, isBroken = invariant(cacheCtx)
+		s.Assert().True(isBroken, "isBroken")
+		s.Assert().Contains(msg, fmt.Sprintf("index entry %X is for scope %s that does not exist", indexKey, otherID), "msg")
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -53,6 +54,63 @@ func (k Keeper) ValidateSignersWithParties(
 	return k.validateSmartContractSigners(ctx, types.GetUsedSigners(parties), msg)
 }
 
+// ValidateSignersWithWriteRoles ensures the following:
+//   - At least one of the availableParties with one of the allowedRoles is a signer.
+//   - All available parties with the PROVENANCE role are a smart contract account.
+//   - All available parties with a smart contract account have the PROVENANCE role.
+//   - All signers that are smart contracts are allowed to sign.
+//
+// The x/authz module is utilized to help facilitate signer checking, but only
+// if none of the parties with an allowed role are signers themselves.
+func (k Keeper) ValidateSignersWithWriteRoles(
+	ctx sdk.Context,
+	availableParties []types.Party,
+	allowedRoles []types.PartyType,
+	msg types.MetadataMsg,
+) error {
+	parties := types.BuildPartyDetails(nil, availableParties)
+	signers := NewSignersWrapper(msg.GetSignerStrs())
+	associateSigners(parties, signers)
+
+	found := false
+	var unsigned []*types.PartyDetails
+	for _, party := range parties {
+		if !party.CanBeUsed() || !slices.Contains(allowedRoles, party.GetRole()) {
+			continue
+		}
+		if party.HasSigner() {
+			party.MarkAsUsed()
+			found = true
+			break
+		}
+		unsigned = append(unsigned, party)
+	}
+
+	if !found {
+		err := k.associateAuthorizations(ctx, unsigned, signers, msg, func(party *types.PartyDetails) bool {
+			party.MarkAsUsed()
+			found = true
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		roles := make([]string, len(allowedRoles))
+		for i, role := range allowedRoles {
+			roles[i] = role.SimpleString()
+		}
+		return fmt.Errorf("missing signer with a role allowed to write this record: %s", strings.Join(roles, ", "))
+	}
+
+	if err := k.validateProvenanceRole(ctx, parties); err != nil {
+		return err
+	}
+	return k.validateSmartContractSigners(ctx, types.GetUsedSigners(parties), msg)
+}
+
 // validateAllRequiredPartiesSigned ensures the following:
 //   - All optional=false reqParties have signed.
 //   - All required roles are present in availableParties and are signers.
@@ -2489,6 +2489,9 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgWriteSessionRequest),
 		newCase(types.TypeURLMsgWriteRecordRequest, types.TypeURLMsgWriteSessionRequest),
 		newCase(types.TypeURLMsgDeleteRecordRequest),
+		newCase(types.TypeURLMsgTombstoneRecordRequest),
+		newCase(types.TypeURLMsgProposeChangeRequest),
+		newCase(types.TypeURLMsgApproveChangeRequest),
 		newCase(types.TypeURLMsgWriteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgDeleteScopeSpecificationRequest),
 		newCase(types.TypeURLMsgWriteContractSpecificationRequest),
@@ -2500,6 +2503,7 @@ func TestGetAuthzMessageTypeURLs(t *testing.T) {
 		newCase(types.TypeURLMsgBindOSLocatorRequest),
 		newCase(types.TypeURLMsgDeleteOSLocatorRequest),
 		newCase(types.TypeURLMsgModifyOSLocatorRequest),
+		newCase(types.TypeURLMsgHeartbeatOSLocatorRequest),
 		newCase(types.TypeURLMsgSetAccountDataRequest),
 	}
 
@@ -32,7 +32,8 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // AppModuleBasic contains non-dependent elements for the metadata module.
@@ -112,8 +113,9 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants does not register any invariants for the metadata module
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// RegisterInvariants registers the invariants of the metadata module.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers module services.
@@ -136,6 +138,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock removes the pending changes that have expired.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	am.keeper.ExpirePendingChanges(sdk.UnwrapSDKContext(goCtx))
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // GenerateGenesisState creates a randomized GenState of the metadata module.
@@ -14,4 +14,6 @@ var (
 	ErrOSLocatorURIToolong = cerrs.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = cerrs.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = cerrs.Register(ModuleName, 7, "uri is invalid")
+	// ErrOSLocatorTooManyEndpoints occurs when a locator has more than MaxOSLocatorEndpoints endpoints.
+	ErrOSLocatorTooManyEndpoints = cerrs.Register(ModuleName, 8, "locator has too many endpoints")
 )
@@ -21,8 +21,12 @@ const (
 
 	TxEndpoint_WriteSession TxEndpoint = "WriteSession"
 
-	TxEndpoint_WriteRecord  TxEndpoint = "WriteRecord"
-	TxEndpoint_DeleteRecord TxEndpoint = "DeleteRecord"
+	TxEndpoint_WriteRecord     TxEndpoint = "WriteRecord"
+	TxEndpoint_DeleteRecord    TxEndpoint = "DeleteRecord"
+	TxEndpoint_TombstoneRecord TxEndpoint = "TombstoneRecord"
+
+	TxEndpoint_ProposeChange TxEndpoint = "ProposeChange"
+	TxEndpoint_ApproveChange TxEndpoint = "ApproveChange"
 
 	TxEndpoint_WriteScopeSpecification  TxEndpoint = "WriteScopeSpecification"
 	TxEndpoint_DeleteScopeSpecification TxEndpoint = "DeleteScopeSpecification"
@@ -36,9 +40,10 @@ const (
 	TxEndpoint_WriteRecordSpecification  TxEndpoint = "WriteRecordSpecification"
 	TxEndpoint_DeleteRecordSpecification TxEndpoint = "DeleteRecordSpecification"
 
-	TxEndpoint_BindOSLocator   TxEndpoint = "BindOSLocator"
-	TxEndpoint_DeleteOSLocator TxEndpoint = "DeleteOSLocator"
-	TxEndpoint_ModifyOSLocator TxEndpoint = "ModifyOSLocator"
+	TxEndpoint_BindOSLocator      TxEndpoint = "BindOSLocator"
+	TxEndpoint_DeleteOSLocator    TxEndpoint = "DeleteOSLocator"
+	TxEndpoint_ModifyOSLocator    TxEndpoint = "ModifyOSLocator"
+	TxEndpoint_HeartbeatOSLocator TxEndpoint = "HeartbeatOSLocator"
 )
 
 func NewEventTxCompleted(endpoint TxEndpoint, signers []string) *EventTxCompleted {
@@ -111,6 +116,47 @@ func NewEventRecordDeleted(recordID MetadataAddress) *EventRecordDeleted {
 	}
 }
 
+func NewEventRecordTombstoned(recordID MetadataAddress, reason string) *EventRecordTombstoned {
+	return &EventRecordTombstoned{
+		RecordAddr: recordID.String(),
+		ScopeAddr:  recordID.MustGetAsScopeAddress().String(),
+		Reason:     reason,
+	}
+}
+
+func NewEventPendingChangeProposed(change PendingChange) *EventPendingChangeProposed {
+	rv := &EventPendingChangeProposed{
+		ChangeId:         change.ChangeId,
+		ScopeAddr:        change.ScopeId.String(),
+		ExpirationHeight: change.ExpirationHeight,
+	}
+	if change.Msg != nil {
+		rv.MsgTypeUrl = change.Msg.TypeUrl
+	}
+	return rv
+}
+
+func NewEventPendingChangeApproved(changeID uint64, signers []string) *EventPendingChangeApproved {
+	return &EventPendingChangeApproved{
+		ChangeId: changeID,
+		Signers:  signers,
+	}
+}
+
+func NewEventPendingChangeExecuted(change PendingChange) *EventPendingChangeExecuted {
+	return &EventPendingChangeExecuted{
+		ChangeId:  change.ChangeId,
+		ScopeAddr: change.ScopeId.String(),
+	}
+}
+
+func NewEventPendingChangeExpired(change PendingChange) *EventPendingChangeExpired {
+	return &EventPendingChangeExpired{
+		ChangeId:  change.ChangeId,
+		ScopeAddr: change.ScopeId.String(),
+	}
+}
+
 func NewEventScopeSpecificationCreated(scopeSpecificationID MetadataAddress) *EventScopeSpecificationCreated {
 	return &EventScopeSpecificationCreated{
 		ScopeSpecificationAddr: scopeSpecificationID.String(),
@@ -180,6 +226,12 @@ func NewEventOSLocatorUpdated(owner string) *EventOSLocatorUpdated {
 	}
 }
 
+func NewEventOSLocatorHeartbeat(owner string) *EventOSLocatorHeartbeat {
+	return &EventOSLocatorHeartbeat{
+		Owner: owner,
+	}
+}
+
 func NewEventOSLocatorDeleted(owner string) *EventOSLocatorDeleted {
 	return &EventOSLocatorDeleted{
 		Owner: owner,
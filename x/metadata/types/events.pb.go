@@ -572,6 +572,308 @@ func (m *EventRecordDeleted) GetScopeAddr() string {
 	return ""
 }
 
+// EventRecordTombstoned is an event message indicating a record's contents have been redacted.
+type EventRecordTombstoned struct {
+	// record_addr is the bech32 address string of the record id that was tombstoned.
+	RecordAddr string `protobuf:"bytes,1,opt,name=record_addr,json=recordAddr,proto3" json:"record_addr,omitempty"`
+	// scope_addr is the bech32 address string of the scope id this record belongs to.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// reason is why the record's contents were redacted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRecordTombstoned) Reset()         { *m = EventRecordTombstoned{} }
+func (m *EventRecordTombstoned) String() string { return proto.CompactTextString(m) }
+func (*EventRecordTombstoned) ProtoMessage()    {}
+func (*EventRecordTombstoned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{10}
+}
+func (m *EventRecordTombstoned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecordTombstoned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecordTombstoned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecordTombstoned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecordTombstoned.Merge(m, src)
+}
+func (m *EventRecordTombstoned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecordTombstoned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecordTombstoned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecordTombstoned proto.InternalMessageInfo
+
+func (m *EventRecordTombstoned) GetRecordAddr() string {
+	if m != nil {
+		return m.RecordAddr
+	}
+	return ""
+}
+
+func (m *EventRecordTombstoned) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventRecordTombstoned) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventPendingChangeProposed is an event message indicating a pending scope or record change has been proposed.
+type EventPendingChangeProposed struct {
+	// change_id is the id of the pending change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// scope_addr is the bech32 address string of the scope id being changed.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// msg_type_url is the type url of the msg that will be executed once the change is approved.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// expiration_height is the block height at which the change expires if it hasn't been executed.
+	ExpirationHeight int64 `protobuf:"varint,4,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *EventPendingChangeProposed) Reset()         { *m = EventPendingChangeProposed{} }
+func (m *EventPendingChangeProposed) String() string { return proto.CompactTextString(m) }
+func (*EventPendingChangeProposed) ProtoMessage()    {}
+func (*EventPendingChangeProposed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
+}
+func (m *EventPendingChangeProposed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingChangeProposed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingChangeProposed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingChangeProposed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingChangeProposed.Merge(m, src)
+}
+func (m *EventPendingChangeProposed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingChangeProposed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingChangeProposed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingChangeProposed proto.InternalMessageInfo
+
+func (m *EventPendingChangeProposed) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+func (m *EventPendingChangeProposed) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventPendingChangeProposed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventPendingChangeProposed) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+// EventPendingChangeApproved is an event message indicating that approvals were added to a pending change.
+type EventPendingChangeApproved struct {
+	// change_id is the id of the pending change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// signers are the addresses that approved the change.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *EventPendingChangeApproved) Reset()         { *m = EventPendingChangeApproved{} }
+func (m *EventPendingChangeApproved) String() string { return proto.CompactTextString(m) }
+func (*EventPendingChangeApproved) ProtoMessage()    {}
+func (*EventPendingChangeApproved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{12}
+}
+func (m *EventPendingChangeApproved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingChangeApproved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingChangeApproved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingChangeApproved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingChangeApproved.Merge(m, src)
+}
+func (m *EventPendingChangeApproved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingChangeApproved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingChangeApproved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingChangeApproved proto.InternalMessageInfo
+
+func (m *EventPendingChangeApproved) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+func (m *EventPendingChangeApproved) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// EventPendingChangeExecuted is an event message indicating a pending change has been executed.
+type EventPendingChangeExecuted struct {
+	// change_id is the id of the pending change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// scope_addr is the bech32 address string of the scope id that was changed.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventPendingChangeExecuted) Reset()         { *m = EventPendingChangeExecuted{} }
+func (m *EventPendingChangeExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventPendingChangeExecuted) ProtoMessage()    {}
+func (*EventPendingChangeExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{13}
+}
+func (m *EventPendingChangeExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingChangeExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingChangeExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingChangeExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingChangeExecuted.Merge(m, src)
+}
+func (m *EventPendingChangeExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingChangeExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingChangeExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingChangeExecuted proto.InternalMessageInfo
+
+func (m *EventPendingChangeExecuted) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+func (m *EventPendingChangeExecuted) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+// EventPendingChangeExpired is an event message indicating a pending change expired without being executed.
+type EventPendingChangeExpired struct {
+	// change_id is the id of the pending change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// scope_addr is the bech32 address string of the scope id that would have been changed.
+	ScopeAddr string `protobuf:"bytes,2,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+}
+
+func (m *EventPendingChangeExpired) Reset()         { *m = EventPendingChangeExpired{} }
+func (m *EventPendingChangeExpired) String() string { return proto.CompactTextString(m) }
+func (*EventPendingChangeExpired) ProtoMessage()    {}
+func (*EventPendingChangeExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
+}
+func (m *EventPendingChangeExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingChangeExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingChangeExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingChangeExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingChangeExpired.Merge(m, src)
+}
+func (m *EventPendingChangeExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingChangeExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingChangeExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingChangeExpired proto.InternalMessageInfo
+
+func (m *EventPendingChangeExpired) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+func (m *EventPendingChangeExpired) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
 // EventScopeSpecificationCreated is an event message indicating a scope specification has been created.
 type EventScopeSpecificationCreated struct {
 	// scope_specification_addr is the bech32 address string of the specification id of the scope specification that was
@@ -583,7 +885,7 @@ func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationCreated) ProtoMessage()    {}
 func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{10}
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
 func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +932,7 @@ func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
 func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
 func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +979,7 @@ func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
 func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{12}
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
 }
 func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +1026,7 @@ func (m *EventContractSpecificationCreated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationCreated) ProtoMessage()    {}
 func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{13}
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
 func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +1073,7 @@ func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationUpdated) ProtoMessage()    {}
 func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
 func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +1120,7 @@ func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContract
 func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationDeleted) ProtoMessage()    {}
 func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
 func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1170,7 @@ func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationCreated) ProtoMessage()    {}
 func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
 }
 func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1227,7 @@ func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
 func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
 func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1284,7 @@ func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
 func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
 func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1337,7 @@ func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
 func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorCreated) ProtoMessage()    {}
 func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+	return fileDescriptor_476cf6cf9459cf25, []int{24}
 }
 func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1383,7 @@ func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
 func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorUpdated) ProtoMessage()    {}
 func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+	return fileDescriptor_476cf6cf9459cf25, []int{25}
 }
 func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,6 +1419,52 @@ func (m *EventOSLocatorUpdated) GetOwner() string {
 	return ""
 }
 
+// EventOSLocatorHeartbeat is an event message indicating an object store locator owner has sent a heartbeat.
+type EventOSLocatorHeartbeat struct {
+	// owner is the owner in the object store locator that had a heartbeat.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventOSLocatorHeartbeat) Reset()         { *m = EventOSLocatorHeartbeat{} }
+func (m *EventOSLocatorHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*EventOSLocatorHeartbeat) ProtoMessage()    {}
+func (*EventOSLocatorHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{26}
+}
+func (m *EventOSLocatorHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOSLocatorHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOSLocatorHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOSLocatorHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOSLocatorHeartbeat.Merge(m, src)
+}
+func (m *EventOSLocatorHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOSLocatorHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOSLocatorHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOSLocatorHeartbeat proto.InternalMessageInfo
+
+func (m *EventOSLocatorHeartbeat) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 // EventOSLocatorDeleted is an event message indicating an object store locator has been deleted.
 type EventOSLocatorDeleted struct {
 	// owner is the owner in the object store locator that was deleted.
@@ -1127,7 +1475,7 @@ func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
 func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorDeleted) ProtoMessage()    {}
 func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+	return fileDescriptor_476cf6cf9459cf25, []int{27}
 }
 func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1523,7 @@ func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
 func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*EventSetNetAssetValue) ProtoMessage()    {}
 func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{22}
+	return fileDescriptor_476cf6cf9459cf25, []int{28}
 }
 func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,6 +1591,11 @@ func init() {
 	proto.RegisterType((*EventRecordCreated)(nil), "provenance.metadata.v1.EventRecordCreated")
 	proto.RegisterType((*EventRecordUpdated)(nil), "provenance.metadata.v1.EventRecordUpdated")
 	proto.RegisterType((*EventRecordDeleted)(nil), "provenance.metadata.v1.EventRecordDeleted")
+	proto.RegisterType((*EventRecordTombstoned)(nil), "provenance.metadata.v1.EventRecordTombstoned")
+	proto.RegisterType((*EventPendingChangeProposed)(nil), "provenance.metadata.v1.EventPendingChangeProposed")
+	proto.RegisterType((*EventPendingChangeApproved)(nil), "provenance.metadata.v1.EventPendingChangeApproved")
+	proto.RegisterType((*EventPendingChangeExecuted)(nil), "provenance.metadata.v1.EventPendingChangeExecuted")
+	proto.RegisterType((*EventPendingChangeExpired)(nil), "provenance.metadata.v1.EventPendingChangeExpired")
 	proto.RegisterType((*EventScopeSpecificationCreated)(nil), "provenance.metadata.v1.EventScopeSpecificationCreated")
 	proto.RegisterType((*EventScopeSpecificationUpdated)(nil), "provenance.metadata.v1.EventScopeSpecificationUpdated")
 	proto.RegisterType((*EventScopeSpecificationDeleted)(nil), "provenance.metadata.v1.EventScopeSpecificationDeleted")
@@ -1254,6 +1607,7 @@ func init() {
 	proto.RegisterType((*EventRecordSpecificationDeleted)(nil), "provenance.metadata.v1.EventRecordSpecificationDeleted")
 	proto.RegisterType((*EventOSLocatorCreated)(nil), "provenance.metadata.v1.EventOSLocatorCreated")
 	proto.RegisterType((*EventOSLocatorUpdated)(nil), "provenance.metadata.v1.EventOSLocatorUpdated")
+	proto.RegisterType((*EventOSLocatorHeartbeat)(nil), "provenance.metadata.v1.EventOSLocatorHeartbeat")
 	proto.RegisterType((*EventOSLocatorDeleted)(nil), "provenance.metadata.v1.EventOSLocatorDeleted")
 	proto.RegisterType((*EventSetNetAssetValue)(nil), "provenance.metadata.v1.EventSetNetAssetValue")
 }
@@ -1263,43 +1617,53 @@ func init() {
 }
 
 var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0xcd, 0x26, 0xd0, 0x36, 0x53, 0x0e, 0xb0, 0x40, 0xd8, 0x80, 0xd8, 0x7e, 0x70, 0xe9, 0xa5,
-	0x89, 0x0a, 0x1c, 0x10, 0x07, 0xa4, 0x12, 0x38, 0x20, 0x21, 0x40, 0x49, 0x01, 0xa9, 0x17, 0x70,
-	0xed, 0xa1, 0x58, 0x24, 0xeb, 0x95, 0xed, 0xa4, 0xe1, 0x5f, 0xf0, 0x07, 0xf8, 0x3f, 0x1c, 0x7b,
-	0xe4, 0x88, 0x92, 0x3f, 0x82, 0xd6, 0x1f, 0x64, 0xdb, 0xa4, 0x2c, 0x10, 0x0a, 0x3d, 0xbe, 0xf1,
-	0xbc, 0xf7, 0xc6, 0x6f, 0x27, 0x91, 0xe1, 0x56, 0x2a, 0xc5, 0x00, 0x13, 0x92, 0x50, 0x6c, 0xf6,
-	0x50, 0x13, 0x46, 0x34, 0x69, 0x0e, 0xb6, 0x9a, 0x38, 0xc0, 0x44, 0xab, 0x46, 0x2a, 0x85, 0x16,
-	0x61, 0x6d, 0xd2, 0xd4, 0xf0, 0x4d, 0x8d, 0xc1, 0xd6, 0xfa, 0x5b, 0xb8, 0xf8, 0x38, 0xeb, 0xdb,
-	0x19, 0xb6, 0x44, 0x2f, 0xed, 0xa2, 0x46, 0x16, 0xd6, 0x60, 0xa1, 0x27, 0x58, 0xbf, 0x8b, 0x51,
-	0xb0, 0x1a, 0x6c, 0x54, 0xdb, 0x0e, 0x85, 0xd7, 0x61, 0x09, 0x13, 0x96, 0x0a, 0x9e, 0xe8, 0xa8,
-	0x6c, 0x4e, 0x7e, 0xe0, 0x30, 0x82, 0x45, 0xc5, 0xf7, 0x13, 0x94, 0x2a, 0xaa, 0xac, 0x56, 0x36,
-	0xaa, 0x6d, 0x0f, 0xd7, 0x6f, 0xc3, 0x25, 0xe3, 0xd0, 0xa1, 0x22, 0xc5, 0x96, 0x44, 0x92, 0x59,
-	0xdc, 0x04, 0x50, 0x19, 0x7e, 0x43, 0x18, 0x93, 0xce, 0xa6, 0x6a, 0x2a, 0xdb, 0x8c, 0xc9, 0xa3,
-	0x9c, 0x97, 0x29, 0xfb, 0x6d, 0xce, 0x23, 0xb4, 0x57, 0x29, 0xe0, 0xbc, 0x86, 0xcb, 0x96, 0x83,
-	0x4a, 0x71, 0x91, 0xf8, 0xe9, 0xd6, 0xe0, 0x82, 0xb2, 0x95, 0x3c, 0x6f, 0xd9, 0xd5, 0x32, 0xe6,
-	0x31, 0xe1, 0x72, 0x81, 0xb0, 0xbf, 0xc2, 0x5f, 0x17, 0xf6, 0xf7, 0x9c, 0x5f, 0xf8, 0x00, 0x42,
-	0x23, 0xdc, 0x46, 0x2a, 0x24, 0xf3, 0x49, 0xac, 0xc0, 0xb2, 0x34, 0x85, 0xbc, 0x2c, 0xd8, 0x92,
-	0x51, 0x3d, 0x6e, 0x5c, 0x2e, 0x32, 0xae, 0xfc, 0xdc, 0xd8, 0x27, 0xf5, 0x0f, 0x8c, 0x77, 0x8e,
-	0x18, 0xfb, 0x24, 0x0b, 0x8d, 0x0b, 0x54, 0x77, 0x21, 0x9e, 0xac, 0x61, 0x27, 0x45, 0xca, 0xdf,
-	0x71, 0x4a, 0x74, 0x6e, 0xbb, 0xee, 0x41, 0x64, 0x05, 0x54, 0xfe, 0x34, 0x6f, 0x57, 0x53, 0x53,
-	0xe4, 0x02, 0x6d, 0x1f, 0xdb, 0x69, 0x68, 0xfb, 0x64, 0xfe, 0x5c, 0x9b, 0xc2, 0x9a, 0xd1, 0x6e,
-	0x89, 0x44, 0x4b, 0x42, 0xf5, 0xcc, 0x58, 0x1e, 0xc0, 0x0d, 0xea, 0xce, 0x4f, 0x76, 0xa8, 0xd3,
-	0x59, 0x12, 0xc5, 0x26, 0x3e, 0x9f, 0x53, 0x35, 0xf1, 0x41, 0xcd, 0x6b, 0xf2, 0x39, 0x80, 0x95,
-	0xdc, 0x66, 0xce, 0x4c, 0xeb, 0x3e, 0xd4, 0xdd, 0x9a, 0x9e, 0xe8, 0x70, 0x4d, 0x4e, 0xd3, 0xcd,
-	0x06, 0x17, 0xcc, 0x57, 0x9e, 0x67, 0x3e, 0x1f, 0xf4, 0x59, 0x9d, 0xcf, 0x7f, 0xa3, 0xff, 0x39,
-	0xdf, 0x26, 0x5c, 0x35, 0xe3, 0x3d, 0xef, 0x3c, 0x15, 0x94, 0x68, 0x21, 0xfd, 0x47, 0xbd, 0x02,
-	0xe7, 0xc5, 0x41, 0x82, 0x7e, 0x00, 0x0b, 0xa6, 0xdb, 0x7d, 0xc6, 0xbf, 0xd8, 0xee, 0xaf, 0x3c,
-	0xbb, 0x7d, 0xe8, 0xda, 0x3b, 0xa8, 0x9f, 0xa1, 0xde, 0x56, 0x0a, 0xf5, 0x2b, 0xd2, 0xed, 0x63,
-	0x58, 0x87, 0x25, 0xfb, 0x73, 0xe7, 0xcc, 0x31, 0x16, 0x0d, 0x7e, 0x62, 0x94, 0x52, 0xc9, 0x29,
-	0xba, 0xab, 0x5a, 0x90, 0x3d, 0x1b, 0x94, 0xe8, 0x4b, 0x8a, 0xee, 0x4f, 0xd1, 0xa1, 0xac, 0x3e,
-	0x10, 0xdd, 0x7e, 0x0f, 0xa3, 0x73, 0xb6, 0x6e, 0xd1, 0xc3, 0x0f, 0x5f, 0x46, 0x71, 0x70, 0x38,
-	0x8a, 0x83, 0x6f, 0xa3, 0x38, 0xf8, 0x34, 0x8e, 0x4b, 0x87, 0xe3, 0xb8, 0xf4, 0x75, 0x1c, 0x97,
-	0xa0, 0xce, 0x45, 0x63, 0xf6, 0x7b, 0xe5, 0x45, 0xb0, 0x7b, 0x77, 0x9f, 0xeb, 0xf7, 0xfd, 0xbd,
-	0x06, 0x15, 0xbd, 0xe6, 0xa4, 0x69, 0x93, 0x8b, 0x1c, 0x6a, 0x0e, 0x27, 0x2f, 0x21, 0xfd, 0x31,
-	0x45, 0xb5, 0xb7, 0x60, 0x9e, 0x41, 0x77, 0xbe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xd9, 0xf3,
-	0xfa, 0x2d, 0x09, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xd1, 0x4e, 0x13, 0x4d,
+	0x14, 0x66, 0x5b, 0x7e, 0x7e, 0x7a, 0xe0, 0x02, 0xf6, 0xff, 0x85, 0x16, 0x62, 0x29, 0xf5, 0x86,
+	0xc4, 0xd0, 0x06, 0xf5, 0xc2, 0x78, 0x61, 0x82, 0x95, 0x04, 0x12, 0xa3, 0xa4, 0x2d, 0x62, 0xb8,
+	0xa9, 0xdb, 0xd9, 0x63, 0xbb, 0xb1, 0x3b, 0x33, 0x99, 0x99, 0x96, 0xf2, 0x16, 0xbe, 0x80, 0xd7,
+	0xbe, 0x8a, 0x97, 0x5c, 0x7a, 0x69, 0xe0, 0x45, 0xcc, 0xce, 0xee, 0xd8, 0x2d, 0x2c, 0x2e, 0x58,
+	0x51, 0x2f, 0xbf, 0x33, 0xe7, 0x7c, 0xdf, 0xd9, 0x6f, 0x76, 0xce, 0x0c, 0xdc, 0xe3, 0x82, 0x0d,
+	0x90, 0x3a, 0x94, 0x60, 0xd5, 0x47, 0xe5, 0xb8, 0x8e, 0x72, 0xaa, 0x83, 0xad, 0x2a, 0x0e, 0x90,
+	0x2a, 0x59, 0xe1, 0x82, 0x29, 0x66, 0x2f, 0x8d, 0x92, 0x2a, 0x26, 0xa9, 0x32, 0xd8, 0x2a, 0xbf,
+	0x85, 0x85, 0x9d, 0x20, 0xaf, 0x39, 0xac, 0x31, 0x9f, 0xf7, 0x50, 0xa1, 0x6b, 0x2f, 0xc1, 0x8c,
+	0xcf, 0xdc, 0x7e, 0x0f, 0xf3, 0x56, 0xc9, 0xda, 0xc8, 0xd5, 0x23, 0x64, 0xaf, 0xc0, 0x2c, 0x52,
+	0x97, 0x33, 0x8f, 0xaa, 0x7c, 0x46, 0xaf, 0x7c, 0xc7, 0x76, 0x1e, 0xfe, 0x95, 0x5e, 0x87, 0xa2,
+	0x90, 0xf9, 0x6c, 0x29, 0xbb, 0x91, 0xab, 0x1b, 0x58, 0x7e, 0x00, 0x8b, 0x5a, 0xa1, 0x41, 0x18,
+	0xc7, 0x9a, 0x40, 0x27, 0x90, 0xb8, 0x0b, 0x20, 0x03, 0xdc, 0x72, 0x5c, 0x57, 0x44, 0x32, 0x39,
+	0x1d, 0xd9, 0x76, 0x5d, 0x31, 0x5e, 0x73, 0xc0, 0xdd, 0x1b, 0xd7, 0x3c, 0xc7, 0x1e, 0x5e, 0xa3,
+	0xe6, 0x10, 0xfe, 0x0b, 0x6b, 0x50, 0x4a, 0x8f, 0x51, 0xd3, 0xdd, 0x3a, 0xcc, 0xcb, 0x30, 0x12,
+	0xaf, 0x9b, 0x8b, 0x62, 0x41, 0xe5, 0x05, 0xe2, 0x4c, 0x0a, 0xb1, 0xf9, 0x84, 0x5f, 0x4e, 0x6c,
+	0xbe, 0x73, 0x72, 0xe2, 0x63, 0xb0, 0x35, 0x71, 0x1d, 0x09, 0x13, 0xae, 0x71, 0x62, 0x0d, 0xe6,
+	0x84, 0x0e, 0xc4, 0x69, 0x21, 0x0c, 0x69, 0xd6, 0x8b, 0xc2, 0x99, 0x34, 0xe1, 0xec, 0x8f, 0x85,
+	0x8d, 0x53, 0xbf, 0x41, 0xb8, 0x39, 0x26, 0x6c, 0x9c, 0x4c, 0x15, 0x4e, 0x61, 0x65, 0x70, 0x27,
+	0xc6, 0xda, 0x64, 0x7e, 0x5b, 0x2a, 0x46, 0x6f, 0x4e, 0x7c, 0x71, 0x83, 0x82, 0x53, 0x29, 0xd0,
+	0x91, 0x8c, 0x46, 0x9a, 0x11, 0x2a, 0x7f, 0xb2, 0x60, 0x45, 0x2b, 0xee, 0x23, 0x75, 0x3d, 0xda,
+	0xa9, 0x75, 0x1d, 0xda, 0xc1, 0x7d, 0xc1, 0x38, 0x93, 0xe8, 0xda, 0xab, 0x90, 0x23, 0x3a, 0xd2,
+	0xf2, 0x5c, 0x2d, 0x3a, 0x5d, 0x9f, 0x0d, 0x03, 0x7b, 0x6e, 0x9a, 0x64, 0x09, 0xe6, 0x7d, 0xd9,
+	0x69, 0xa9, 0x13, 0x8e, 0xad, 0xbe, 0xe8, 0x45, 0xc2, 0xe0, 0xcb, 0x4e, 0xf3, 0x84, 0xe3, 0x81,
+	0xe8, 0xd9, 0xf7, 0x61, 0x11, 0x87, 0xdc, 0x13, 0x8e, 0x0a, 0x36, 0xa2, 0x8b, 0x5e, 0xa7, 0xab,
+	0xf2, 0xd3, 0x25, 0x6b, 0x23, 0x5b, 0x5f, 0x18, 0x2d, 0xec, 0xea, 0x78, 0xb9, 0x91, 0xd4, 0xe8,
+	0x36, 0xd7, 0x93, 0x29, 0xa5, 0xd1, 0xd8, 0x78, 0xc9, 0x8c, 0x8f, 0x97, 0x37, 0x49, 0xa4, 0x3b,
+	0x43, 0x24, 0x7d, 0x35, 0xd9, 0xd7, 0x97, 0x0f, 0xa1, 0x90, 0xc4, 0xcc, 0x3d, 0x31, 0x21, 0xf1,
+	0x11, 0x14, 0x47, 0x93, 0xaa, 0xc1, 0x91, 0x78, 0xef, 0x3c, 0xa2, 0x8d, 0x32, 0xc7, 0xee, 0x31,
+	0xe4, 0x43, 0x02, 0x19, 0x5f, 0x8d, 0xff, 0x38, 0x4b, 0xf2, 0x52, 0x71, 0x0a, 0xb7, 0x39, 0x59,
+	0xb7, 0xc1, 0x6d, 0x0e, 0xcf, 0xcf, 0x73, 0x13, 0x58, 0xd7, 0xdc, 0x35, 0x46, 0x95, 0x70, 0x88,
+	0x4a, 0xb4, 0xe5, 0x29, 0xac, 0x92, 0x68, 0xfd, 0x6a, 0x85, 0x02, 0x49, 0xa2, 0x48, 0x17, 0x31,
+	0xfe, 0xdc, 0xaa, 0x88, 0x31, 0x6a, 0x52, 0x91, 0x8f, 0x16, 0xac, 0xc5, 0xc6, 0x4c, 0xa2, 0x5b,
+	0x4f, 0xa0, 0x10, 0x0d, 0x9c, 0x2b, 0x15, 0x96, 0xc5, 0xe5, 0x72, 0x7d, 0xf2, 0x53, 0xfa, 0xcb,
+	0x4c, 0xd2, 0x9f, 0x31, 0xfa, 0x6f, 0xed, 0xcf, 0xec, 0xd1, 0x9f, 0xec, 0x6f, 0x33, 0xba, 0x45,
+	0x5e, 0x35, 0x5e, 0x30, 0xe2, 0x28, 0x26, 0xcc, 0xa6, 0xfe, 0x0f, 0xff, 0xb0, 0x63, 0x8a, 0xa6,
+	0x81, 0x10, 0x5c, 0x4e, 0x37, 0x1e, 0x27, 0xa7, 0x57, 0x61, 0x79, 0x3c, 0x7d, 0x17, 0x1d, 0xa1,
+	0xda, 0xe8, 0xa8, 0xeb, 0xf2, 0x1b, 0x8f, 0x92, 0xd3, 0x87, 0x51, 0x7a, 0x03, 0xd5, 0x4b, 0x54,
+	0xdb, 0x52, 0xa2, 0x7a, 0xed, 0xf4, 0xfa, 0x68, 0x17, 0x60, 0x36, 0x9c, 0x0f, 0xd1, 0xd0, 0x0c,
+	0xe6, 0x78, 0x80, 0xf7, 0x34, 0x13, 0x17, 0x1e, 0xc1, 0xc8, 0x9b, 0x10, 0x04, 0x97, 0x9e, 0x64,
+	0x7d, 0x41, 0xd0, 0x5c, 0x7a, 0x21, 0x0a, 0xe2, 0x03, 0xd6, 0xeb, 0xfb, 0xa8, 0x2f, 0x9b, 0x5c,
+	0x3d, 0x42, 0xcf, 0xde, 0x7f, 0x3e, 0x2b, 0x5a, 0xa7, 0x67, 0x45, 0xeb, 0xeb, 0x59, 0xd1, 0xfa,
+	0x70, 0x5e, 0x9c, 0x3a, 0x3d, 0x2f, 0x4e, 0x7d, 0x39, 0x2f, 0x4e, 0x41, 0xc1, 0x63, 0x95, 0xe4,
+	0x37, 0xf0, 0xbe, 0x75, 0xf4, 0xa8, 0xe3, 0xa9, 0x6e, 0xbf, 0x5d, 0x21, 0xcc, 0xaf, 0x8e, 0x92,
+	0x36, 0x3d, 0x16, 0x43, 0xd5, 0xe1, 0xe8, 0x75, 0x1d, 0xdc, 0x88, 0xb2, 0x3d, 0xa3, 0x9f, 0xd6,
+	0x0f, 0xbf, 0x0d, 0x00, 0xd7, 0x37, 0x10, 0x20, 0x81, 0x0b, 0x00, 0x00,
 }
 
 func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
@@ -1674,7 +2038,7 @@ func (m *EventRecordDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventRecordTombstoned) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1684,27 +2048,41 @@ func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRecordTombstoned) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRecordTombstoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
-		i -= len(m.ScopeSpecificationAddr)
-		copy(dAtA[i:], m.ScopeSpecificationAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordAddr) > 0 {
+		i -= len(m.RecordAddr)
+		copy(dAtA[i:], m.RecordAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecordAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventPendingChangeProposed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1714,17 +2092,201 @@ func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventPendingChangeProposed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventPendingChangeProposed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ScopeSpecificationAddr) > 0 {
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChangeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingChangeApproved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingChangeApproved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingChangeApproved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ChangeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingChangeExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingChangeExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingChangeExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChangeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingChangeExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingChangeExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingChangeExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChangeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
+		i -= len(m.ScopeSpecificationAddr)
+		copy(dAtA[i:], m.ScopeSpecificationAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScopeSpecificationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeSpecificationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScopeSpecificationAddr) > 0 {
 		i -= len(m.ScopeSpecificationAddr)
 		copy(dAtA[i:], m.ScopeSpecificationAddr)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeSpecificationAddr)))
@@ -2025,6 +2587,36 @@ func (m *EventOSLocatorUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOSLocatorHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOSLocatorHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOSLocatorHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventOSLocatorDeleted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2289,128 +2881,145 @@ func (m *EventRecordDeleted) Size() (n int) {
 	return n
 }
 
-func (m *EventScopeSpecificationCreated) Size() (n int) {
+func (m *EventRecordTombstoned) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
+	l = len(m.RecordAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventScopeSpecificationUpdated) Size() (n int) {
+func (m *EventPendingChangeProposed) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
+	if m.ChangeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChangeId))
+	}
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpirationHeight))
+	}
 	return n
 }
 
-func (m *EventScopeSpecificationDeleted) Size() (n int) {
+func (m *EventPendingChangeApproved) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ScopeSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ChangeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChangeId))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *EventContractSpecificationCreated) Size() (n int) {
+func (m *EventPendingChangeExecuted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
+	if m.ChangeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChangeId))
+	}
+	l = len(m.ScopeAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventContractSpecificationUpdated) Size() (n int) {
+func (m *EventPendingChangeExpired) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
+	if m.ChangeId != 0 {
+		n += 1 + sovEvents(uint64(m.ChangeId))
+	}
+	l = len(m.ScopeAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventContractSpecificationDeleted) Size() (n int) {
+func (m *EventScopeSpecificationCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContractSpecificationAddr)
+	l = len(m.ScopeSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventRecordSpecificationCreated) Size() (n int) {
+func (m *EventScopeSpecificationUpdated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ContractSpecificationAddr)
+	l = len(m.ScopeSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventRecordSpecificationUpdated) Size() (n int) {
+func (m *EventScopeSpecificationDeleted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ContractSpecificationAddr)
+	l = len(m.ScopeSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventRecordSpecificationDeleted) Size() (n int) {
+func (m *EventContractSpecificationCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RecordSpecificationAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	l = len(m.ContractSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -2418,39 +3027,129 @@ func (m *EventRecordSpecificationDeleted) Size() (n int) {
 	return n
 }
 
-func (m *EventOSLocatorCreated) Size() (n int) {
+func (m *EventContractSpecificationUpdated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	l = len(m.ContractSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventOSLocatorUpdated) Size() (n int) {
+func (m *EventContractSpecificationDeleted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	l = len(m.ContractSpecificationAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventOSLocatorDeleted) Size() (n int) {
+func (m *EventRecordSpecificationCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecordSpecificationDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ContractSpecificationAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOSLocatorDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -3053,11 +3752,677 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecordTombstoned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecordTombstoned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecordTombstoned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3085,7 +4450,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3108,7 +4473,7 @@ func (m *EventSessionUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventPendingChangeProposed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3131,15 +4496,34 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSessionDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPendingChangeProposed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSessionDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPendingChangeProposed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3167,11 +4551,11 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3199,8 +4583,27 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3222,7 +4625,7 @@ func (m *EventSessionDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
+func (m *EventPendingChangeApproved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3245,17 +4648,17 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPendingChangeApproved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPendingChangeApproved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
 			}
-			var stringLen uint64
+			m.ChangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3265,59 +4668,14 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChangeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3345,7 +4703,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3368,7 +4726,7 @@ func (m *EventRecordCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventPendingChangeExecuted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3391,17 +4749,17 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPendingChangeExecuted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPendingChangeExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
 			}
-			var stringLen uint64
+			m.ChangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3411,57 +4769,12 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChangeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SessionAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -3514,7 +4827,7 @@ func (m *EventRecordUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventPendingChangeExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3537,17 +4850,17 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRecordDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPendingChangeExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRecordDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPendingChangeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
 			}
-			var stringLen uint64
+			m.ChangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3557,25 +4870,12 @@ func (m *EventRecordDeleted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChangeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
@@ -4626,6 +5926,88 @@ func (m *EventOSLocatorUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOSLocatorHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOSLocatorHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOSLocatorHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventOSLocatorDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
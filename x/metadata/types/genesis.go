@@ -1,7 +1,44 @@
 package types
 
+import (
+	"fmt"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = (*GenesisState)(nil)
+
 // Validate ensures the genesis state is valid.
 func (state GenesisState) Validate() error {
+	for i, tombstone := range state.RecordTombstones {
+		if err := tombstone.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid record tombstone[%d]: %w", i, err)
+		}
+	}
+	seen := make(map[uint64]bool)
+	for i, change := range state.PendingChanges {
+		if err := change.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pending change[%d]: %w", i, err)
+		}
+		if seen[change.ChangeId] {
+			return fmt.Errorf("invalid pending change[%d]: duplicate change id %d", i, change.ChangeId)
+		}
+		seen[change.ChangeId] = true
+		if change.ChangeId > state.LastPendingChangeId {
+			return fmt.Errorf("invalid pending change[%d]: change id %d is greater than the last pending change id %d",
+				i, change.ChangeId, state.LastPendingChangeId)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (state GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, change := range state.PendingChanges {
+		if err := change.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
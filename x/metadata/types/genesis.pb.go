@@ -38,6 +38,12 @@ type GenesisState struct {
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
 	// Net asset values assigned to scopes
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,10,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// Tombstones of records that have had their contents redacted
+	RecordTombstones []RecordTombstone `protobuf:"bytes,11,rep,name=record_tombstones,json=recordTombstones,proto3" json:"record_tombstones"`
+	// Scope and record changes that are waiting on approvals
+	PendingChanges []PendingChange `protobuf:"bytes,12,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes"`
+	// The id of the most recently proposed pending change
+	LastPendingChangeId uint64 `protobuf:"varint,13,opt,name=last_pending_change_id,json=lastPendingChangeId,proto3" json:"last_pending_change_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,42 +130,47 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6d, 0x5a, 0x92, 0x74, 0x8b, 0x00, 0x2d, 0x69, 0x31, 0x95, 0x70, 0xaa, 0x88, 0x8a,
-	0xa8, 0x50, 0x5b, 0x2d, 0x9c, 0x00, 0x21, 0xb5, 0x1c, 0xb8, 0x00, 0xad, 0x12, 0xc1, 0xa1, 0x42,
-	0xb2, 0x36, 0x9b, 0x6d, 0x30, 0x4d, 0x3c, 0xd6, 0xce, 0x36, 0x82, 0x37, 0xe0, 0x08, 0x6f, 0xd0,
-	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0xae, 0xbc, 0x01, 0xca, 0x7a, 0xdd, 0x34, 0x8d,
-	0x37, 0xdc, 0x6c, 0xcf, 0xff, 0xfd, 0xff, 0x8c, 0x3d, 0x5e, 0xf2, 0x20, 0x95, 0x30, 0x10, 0x09,
-	0x4b, 0xb8, 0x08, 0xfb, 0x42, 0xb1, 0x0e, 0x53, 0x2c, 0x1c, 0x6c, 0x87, 0x5d, 0x91, 0x08, 0x8c,
-	0x31, 0x48, 0x25, 0x28, 0xa0, 0xab, 0x13, 0x55, 0x90, 0xab, 0x82, 0xc1, 0xf6, 0x5a, 0xb5, 0x0b,
-	0x5d, 0xd0, 0x92, 0x70, 0x7c, 0x95, 0xa9, 0xd7, 0x36, 0x2c, 0x9e, 0x17, 0x64, 0x26, 0xab, 0x5b,
-	0x64, 0xc8, 0x21, 0x15, 0x46, 0xb3, 0x69, 0xd3, 0xa4, 0x82, 0xc7, 0x47, 0x31, 0x67, 0x2a, 0x86,
-	0xc4, 0x68, 0x1b, 0x16, 0x2d, 0xb4, 0x3f, 0x0b, 0xae, 0x50, 0x81, 0x34, 0xae, 0xf5, 0xbf, 0x25,
-	0x72, 0xe3, 0x75, 0x36, 0x60, 0x4b, 0x31, 0x25, 0xe8, 0x0b, 0x52, 0x4a, 0x99, 0x64, 0x7d, 0xf4,
-	0xdc, 0x75, 0xb7, 0xb1, 0xbc, 0xe3, 0x07, 0xc5, 0x03, 0x07, 0x07, 0x5a, 0xb5, 0xb7, 0x78, 0xf6,
-	0xab, 0xe6, 0x34, 0x0d, 0x43, 0x9f, 0x93, 0x92, 0xee, 0x19, 0xbd, 0x6b, 0xeb, 0x0b, 0x8d, 0xe5,
-	0x9d, 0xfb, 0x36, 0xba, 0x35, 0x56, 0xe5, 0x70, 0x86, 0xd0, 0x5d, 0x52, 0x41, 0x81, 0x18, 0x43,
-	0x82, 0xde, 0x82, 0xc6, 0x6b, 0x56, 0x3c, 0xd3, 0x19, 0x83, 0x0b, 0x8c, 0xbe, 0x24, 0x65, 0x29,
-	0x38, 0xc8, 0x0e, 0x7a, 0x8b, 0xda, 0xc1, 0xda, 0x7e, 0x53, 0xcb, 0x8c, 0x41, 0x0e, 0x51, 0x4e,
-	0xaa, 0xba, 0x99, 0x68, 0xea, 0xad, 0xa2, 0x77, 0x5d, 0x9b, 0x6d, 0xce, 0x9d, 0xa6, 0x75, 0x19,
-	0x31, 0xc6, 0x77, 0x70, 0xa6, 0x82, 0xb4, 0x47, 0xee, 0x72, 0x48, 0x94, 0x64, 0x5c, 0x5d, 0xcd,
-	0x29, 0xe9, 0x9c, 0x2d, 0x5b, 0xce, 0x2b, 0x83, 0x15, 0x45, 0xad, 0xf2, 0xa2, 0x22, 0xd2, 0x23,
-	0xb2, 0x92, 0x4d, 0x77, 0x35, 0xab, 0xac, 0xb3, 0x1e, 0xcd, 0x7f, 0x41, 0x45, 0x49, 0x55, 0x39,
-	0x5b, 0x42, 0x7a, 0x48, 0x28, 0x44, 0x18, 0xf5, 0x80, 0x33, 0x05, 0x32, 0x32, 0x4b, 0x54, 0xd1,
-	0x4b, 0xf4, 0xd0, 0x16, 0xb2, 0xdf, 0x7a, 0x93, 0xe9, 0xa7, 0xb6, 0xe9, 0x16, 0x4c, 0x3f, 0xa6,
-	0x1d, 0xb2, 0x92, 0xad, 0x6e, 0xa4, 0x77, 0x37, 0x0f, 0x41, 0x6f, 0x69, 0xfe, 0x77, 0xd9, 0xd7,
-	0x50, 0x6b, 0xcc, 0x18, 0xc3, 0xfc, 0xbb, 0xc0, 0x4c, 0x05, 0xe9, 0x47, 0x72, 0x3b, 0x11, 0x2a,
-	0x62, 0x88, 0x42, 0x45, 0x03, 0xd6, 0x3b, 0x11, 0xe8, 0x11, 0x1d, 0xf0, 0xd8, 0x16, 0xf0, 0x96,
-	0xc9, 0x63, 0x21, 0xdf, 0x09, 0xb5, 0x3b, 0x86, 0x3e, 0x68, 0xc6, 0x44, 0xdc, 0x4c, 0xa6, 0x9e,
-	0x3e, 0xab, 0x7c, 0x3b, 0xad, 0x39, 0x7f, 0x4e, 0x6b, 0x4e, 0xfd, 0x87, 0x4b, 0xaa, 0x45, 0x20,
-	0xf5, 0x48, 0x99, 0x75, 0x3a, 0x52, 0x60, 0xf6, 0xf3, 0x2d, 0x35, 0xf3, 0x5b, 0xfa, 0xbe, 0xa0,
-	0xb5, 0xec, 0x0f, 0xdb, 0xb0, 0xb5, 0x36, 0xe5, 0xfd, 0xbf, 0x9e, 0xf6, 0x8e, 0xcf, 0x86, 0xbe,
-	0x7b, 0x3e, 0xf4, 0xdd, 0xdf, 0x43, 0xdf, 0xfd, 0x3e, 0xf2, 0x9d, 0xf3, 0x91, 0xef, 0xfc, 0x1c,
-	0xf9, 0x0e, 0xb9, 0x17, 0x83, 0x25, 0xe2, 0xc0, 0x3d, 0x7c, 0xda, 0x8d, 0xd5, 0xa7, 0x93, 0x76,
-	0xc0, 0xa1, 0x1f, 0x4e, 0x44, 0x5b, 0x31, 0x5c, 0xba, 0x0b, 0xbf, 0x4c, 0xce, 0x20, 0xf5, 0x35,
-	0x15, 0xd8, 0x2e, 0xe9, 0xb3, 0xe7, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x60, 0x12,
-	0x92, 0x72, 0x05, 0x00, 0x00,
+	// 629 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x4e, 0x13, 0x41,
+	0x18, 0xc7, 0xbb, 0x14, 0x4b, 0x19, 0x50, 0x70, 0x28, 0xb8, 0x92, 0xb8, 0x6d, 0x88, 0xc4, 0x06,
+	0xa5, 0x1b, 0xc0, 0x93, 0x1a, 0x13, 0xe0, 0x60, 0x4c, 0x54, 0x48, 0x8b, 0x1e, 0x88, 0xc9, 0x66,
+	0x3a, 0x3b, 0x2c, 0x2b, 0xed, 0xcc, 0x66, 0xbe, 0xa1, 0xd1, 0x37, 0xf0, 0xa8, 0x6f, 0xc0, 0xe3,
+	0x70, 0xe4, 0xc8, 0xc9, 0x18, 0xb8, 0xf8, 0x18, 0xa6, 0xb3, 0xb3, 0x94, 0xa5, 0xbb, 0x7b, 0xeb,
+	0xce, 0xfc, 0xfe, 0xff, 0xff, 0xcc, 0xf7, 0x7d, 0x1d, 0xf4, 0x34, 0x92, 0x62, 0xc0, 0x38, 0xe1,
+	0x94, 0xb9, 0x7d, 0xa6, 0x88, 0x4f, 0x14, 0x71, 0x07, 0x1b, 0x6e, 0xc0, 0x38, 0x83, 0x10, 0x5a,
+	0x91, 0x14, 0x4a, 0xe0, 0xa5, 0x11, 0xd5, 0x4a, 0xa8, 0xd6, 0x60, 0x63, 0xb9, 0x16, 0x88, 0x40,
+	0x68, 0xc4, 0x1d, 0xfe, 0x8a, 0xe9, 0xe5, 0xd5, 0x1c, 0xcf, 0x1b, 0x65, 0x8c, 0xad, 0xe4, 0x60,
+	0x40, 0x45, 0xc4, 0x0c, 0xb3, 0x96, 0xc7, 0x44, 0x8c, 0x86, 0x47, 0x21, 0x25, 0x2a, 0x14, 0xdc,
+	0xb0, 0xcd, 0x1c, 0x56, 0x74, 0xbf, 0x31, 0xaa, 0x40, 0x09, 0x69, 0x5c, 0x57, 0x2e, 0xab, 0x68,
+	0xf6, 0x5d, 0x7c, 0xc1, 0x8e, 0x22, 0x8a, 0xe1, 0x37, 0xa8, 0x12, 0x11, 0x49, 0xfa, 0x60, 0x5b,
+	0x0d, 0xab, 0x39, 0xb3, 0xe9, 0xb4, 0xb2, 0x2f, 0xdc, 0xda, 0xd7, 0xd4, 0xce, 0xe4, 0xf9, 0x9f,
+	0x7a, 0xa9, 0x6d, 0x34, 0xf8, 0x35, 0xaa, 0xe8, 0x33, 0x83, 0x3d, 0xd1, 0x28, 0x37, 0x67, 0x36,
+	0x9f, 0xe4, 0xa9, 0x3b, 0x43, 0x2a, 0x11, 0xc7, 0x12, 0xbc, 0x8d, 0xaa, 0xc0, 0x00, 0x42, 0xc1,
+	0xc1, 0x2e, 0x6b, 0x79, 0x3d, 0x57, 0x1e, 0x73, 0xc6, 0xe0, 0x46, 0x86, 0xdf, 0xa2, 0x29, 0xc9,
+	0xa8, 0x90, 0x3e, 0xd8, 0x93, 0x8d, 0x72, 0xd1, 0xf1, 0xdb, 0x1a, 0x33, 0x06, 0x89, 0x08, 0x53,
+	0x54, 0xd3, 0x87, 0xf1, 0x52, 0x55, 0x05, 0xfb, 0x9e, 0x36, 0x5b, 0x2b, 0xbc, 0x4d, 0xe7, 0xb6,
+	0xc4, 0x18, 0x2f, 0xc0, 0xd8, 0x0e, 0xe0, 0x1e, 0x7a, 0x44, 0x05, 0x57, 0x92, 0x50, 0x75, 0x37,
+	0xa7, 0xa2, 0x73, 0xd6, 0xf3, 0x72, 0x76, 0x8d, 0x2c, 0x2b, 0x6a, 0x89, 0x66, 0x6d, 0x02, 0x3e,
+	0x42, 0x8b, 0xf1, 0xed, 0xee, 0x66, 0x4d, 0xe9, 0xac, 0xe7, 0xc5, 0x05, 0xca, 0x4a, 0xaa, 0xc9,
+	0xf1, 0x2d, 0xc0, 0x87, 0x08, 0x0b, 0x0f, 0xbc, 0x9e, 0xa0, 0x44, 0x09, 0xe9, 0x99, 0x21, 0xaa,
+	0xea, 0x21, 0x7a, 0x96, 0x17, 0xb2, 0xd7, 0xf9, 0x10, 0xf3, 0xa9, 0x69, 0x9a, 0x13, 0xe9, 0x65,
+	0xec, 0xa3, 0xc5, 0x78, 0x74, 0x3d, 0x3d, 0xbb, 0x49, 0x08, 0xd8, 0xd3, 0xc5, 0x7d, 0xd9, 0xd3,
+	0xa2, 0xce, 0x50, 0x63, 0x0c, 0x93, 0xbe, 0x88, 0xb1, 0x1d, 0xc0, 0x5f, 0xd1, 0x3c, 0x67, 0xca,
+	0x23, 0x00, 0x4c, 0x79, 0x03, 0xd2, 0x3b, 0x65, 0x60, 0x23, 0x1d, 0xf0, 0x22, 0x2f, 0xe0, 0x23,
+	0x91, 0x27, 0x4c, 0x7e, 0x62, 0x6a, 0x7b, 0x28, 0xfa, 0xa2, 0x35, 0x26, 0xe2, 0x01, 0x4f, 0xad,
+	0xe2, 0x43, 0xf4, 0xd0, 0xf4, 0x41, 0x89, 0x7e, 0x17, 0x94, 0xe0, 0x0c, 0xec, 0x99, 0x46, 0xb9,
+	0xa8, 0x3c, 0x71, 0x0f, 0x0e, 0x12, 0xde, 0x38, 0xcf, 0xcb, 0xf4, 0x32, 0xe0, 0x03, 0x34, 0x17,
+	0x31, 0xee, 0x87, 0x3c, 0xf0, 0xe8, 0x31, 0xe1, 0x01, 0x03, 0x7b, 0x56, 0x3b, 0xaf, 0xe6, 0xfe,
+	0x7b, 0x63, 0x7c, 0x57, 0xd3, 0xc9, 0x89, 0xa3, 0xdb, 0x8b, 0x80, 0xb7, 0xd0, 0x52, 0x8f, 0x80,
+	0xf2, 0xd2, 0xd6, 0x5e, 0xe8, 0xdb, 0xf7, 0x1b, 0x56, 0x73, 0xb2, 0xbd, 0x30, 0xdc, 0x4d, 0x19,
+	0xbd, 0xf7, 0x5f, 0x55, 0x7f, 0x9e, 0xd5, 0x4b, 0xff, 0xce, 0xea, 0xa5, 0x95, 0xdf, 0x16, 0xaa,
+	0x65, 0xd5, 0x07, 0xdb, 0x68, 0x8a, 0xf8, 0xbe, 0x64, 0x10, 0xbf, 0x31, 0xd3, 0xed, 0xe4, 0x13,
+	0x7f, 0xce, 0xe8, 0xc0, 0x44, 0xf1, 0x45, 0x52, 0xde, 0xd9, 0xa5, 0x1f, 0x9d, 0x69, 0xe7, 0xe4,
+	0xfc, 0xca, 0xb1, 0x2e, 0xae, 0x1c, 0xeb, 0xef, 0x95, 0x63, 0xfd, 0xba, 0x76, 0x4a, 0x17, 0xd7,
+	0x4e, 0xe9, 0xf2, 0xda, 0x29, 0xa1, 0xc7, 0xa1, 0xc8, 0x89, 0xd8, 0xb7, 0x0e, 0x5f, 0x06, 0xa1,
+	0x3a, 0x3e, 0xed, 0xb6, 0xa8, 0xe8, 0xbb, 0x23, 0x68, 0x3d, 0x14, 0xb7, 0xbe, 0xdc, 0xef, 0xa3,
+	0xa7, 0x56, 0xfd, 0x88, 0x18, 0x74, 0x2b, 0xfa, 0x89, 0xdd, 0xfa, 0x3f, 0x00, 0xac, 0x32, 0xec,
+	0x0f, 0x59, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +193,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastPendingChangeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPendingChangeId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.PendingChanges) > 0 {
+		for iNdEx := len(m.PendingChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RecordTombstones) > 0 {
+		for iNdEx := len(m.RecordTombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordTombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -430,6 +474,21 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecordTombstones) > 0 {
+		for _, e := range m.RecordTombstones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingChanges) > 0 {
+		for _, e := range m.PendingChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastPendingChangeId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastPendingChangeId))
+	}
 	return n
 }
 
@@ -825,6 +884,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordTombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordTombstones = append(m.RecordTombstones, RecordTombstone{})
+			if err := m.RecordTombstones[len(m.RecordTombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChanges = append(m.PendingChanges, PendingChange{})
+			if err := m.PendingChanges[len(m.PendingChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPendingChangeId", wireType)
+			}
+			m.LastPendingChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPendingChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -86,6 +89,16 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// RecordTombstonePrefix prefix for the tombstones of records that have had their contents redacted
+	RecordTombstonePrefix = []byte{0x24}
+
+	// PendingChangePrefix prefix for scope and record changes that are waiting on approvals
+	PendingChangePrefix = []byte{0x25}
+	// PendingChangeExpirationPrefix prefix for the index of pending changes by expiration height
+	PendingChangeExpirationPrefix = []byte{0x26}
+	// LastPendingChangeIDKey key for the id of the most recently proposed pending change
+	LastPendingChangeIDKey = []byte{0x27}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -152,3 +165,43 @@ func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 func NetAssetValueKey(scopeAddr MetadataAddress, denom string) []byte {
 	return append(NetAssetValueKeyPrefix(scopeAddr), denom...)
 }
+
+// RecordTombstoneKey returns key [prefix][record id] for a record's tombstone.
+func RecordTombstoneKey(recordID MetadataAddress) []byte {
+	return append(RecordTombstonePrefix, recordID.Bytes()...)
+}
+
+// RecordTombstoneScopeKeyPrefix returns the [prefix][record key prefix][scope uuid] part of the
+// tombstone keys of all records in the scope that contains the provided id.
+func RecordTombstoneScopeKeyPrefix(id MetadataAddress) ([]byte, error) {
+	recPre, err := id.ScopeRecordIteratorPrefix()
+	if err != nil {
+		return nil, err
+	}
+	return append(RecordTombstonePrefix, recPre...), nil
+}
+
+// PendingChangeKey returns key [prefix][change id] for a pending change.
+func PendingChangeKey(changeID uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, PendingChangePrefix...), changeID)
+}
+
+// PendingChangeExpirationKey returns key [prefix][expiration height][change id] for the pending change expiration index.
+func PendingChangeExpirationKey(height int64, changeID uint64) []byte {
+	if height < 0 {
+		panic(fmt.Errorf("negative expiration height %d not allowed", height))
+	}
+	rv := binary.BigEndian.AppendUint64(append([]byte{}, PendingChangeExpirationPrefix...), uint64(height))
+	return binary.BigEndian.AppendUint64(rv, changeID)
+}
+
+// ParsePendingChangeExpirationKey returns the expiration height and change id of a pending change expiration index key.
+func ParsePendingChangeExpirationKey(key []byte) (int64, uint64, error) {
+	if len(key) != len(PendingChangeExpirationPrefix)+16 {
+		return 0, 0, fmt.Errorf("cannot parse pending change expiration key: has %d bytes, expected %d", len(key), len(PendingChangeExpirationPrefix)+16)
+	}
+	suffix := key[len(PendingChangeExpirationPrefix):]
+	height := binary.BigEndian.Uint64(suffix[:8])
+	changeID := binary.BigEndian.Uint64(suffix[8:])
+	return int64(height), changeID, nil //nolint:gosec // G115: Only ever set from a non-negative int64.
+}
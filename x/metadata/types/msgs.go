@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -23,6 +24,9 @@ const (
 	TypeURLMsgWriteSessionRequest                    = "/provenance.metadata.v1.MsgWriteSessionRequest"
 	TypeURLMsgWriteRecordRequest                     = "/provenance.metadata.v1.MsgWriteRecordRequest"
 	TypeURLMsgDeleteRecordRequest                    = "/provenance.metadata.v1.MsgDeleteRecordRequest"
+	TypeURLMsgTombstoneRecordRequest                 = "/provenance.metadata.v1.MsgTombstoneRecordRequest"
+	TypeURLMsgProposeChangeRequest                   = "/provenance.metadata.v1.MsgProposeChangeRequest"
+	TypeURLMsgApproveChangeRequest                   = "/provenance.metadata.v1.MsgApproveChangeRequest"
 	TypeURLMsgWriteScopeSpecificationRequest         = "/provenance.metadata.v1.MsgWriteScopeSpecificationRequest"
 	TypeURLMsgDeleteScopeSpecificationRequest        = "/provenance.metadata.v1.MsgDeleteScopeSpecificationRequest"
 	TypeURLMsgWriteContractSpecificationRequest      = "/provenance.metadata.v1.MsgWriteContractSpecificationRequest"
@@ -34,6 +38,7 @@ const (
 	TypeURLMsgBindOSLocatorRequest                   = "/provenance.metadata.v1.MsgBindOSLocatorRequest"
 	TypeURLMsgDeleteOSLocatorRequest                 = "/provenance.metadata.v1.MsgDeleteOSLocatorRequest"
 	TypeURLMsgModifyOSLocatorRequest                 = "/provenance.metadata.v1.MsgModifyOSLocatorRequest"
+	TypeURLMsgHeartbeatOSLocatorRequest              = "/provenance.metadata.v1.MsgHeartbeatOSLocatorRequest"
 	TypeURLMsgSetAccountDataRequest                  = "/provenance.metadata.v1.MsgSetAccountDataRequest"
 )
 
@@ -60,6 +65,9 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgWriteSessionRequest)(nil),
 	(*MsgWriteRecordRequest)(nil),
 	(*MsgDeleteRecordRequest)(nil),
+	(*MsgTombstoneRecordRequest)(nil),
+	(*MsgProposeChangeRequest)(nil),
+	(*MsgApproveChangeRequest)(nil),
 
 	(*MsgWriteScopeSpecificationRequest)(nil),
 	(*MsgDeleteScopeSpecificationRequest)(nil),
@@ -76,6 +84,7 @@ var AllRequestMsgs = []MetadataMsg{
 	(*MsgBindOSLocatorRequest)(nil),
 	(*MsgDeleteOSLocatorRequest)(nil),
 	(*MsgModifyOSLocatorRequest)(nil),
+	(*MsgHeartbeatOSLocatorRequest)(nil),
 
 	(*MsgSetAccountDataRequest)(nil),
 
@@ -88,6 +97,8 @@ var (
 	_ sdk.Msg = (*MsgP8EMemorializeContractRequest)(nil)
 )
 
+var _ cdctypes.UnpackInterfacesMessage = (*MsgProposeChangeRequest)(nil)
+
 // ------------------  MsgWriteScopeRequest  ------------------
 
 // NewMsgWriteScopeRequest creates a new msg instance
@@ -540,6 +551,110 @@ func (msg MsgDeleteRecordRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgTombstoneRecordRequest  ------------------
+
+// NewMsgTombstoneRecordRequest creates a new msg instance
+func NewMsgTombstoneRecordRequest(recordID MetadataAddress, reason string, signers []string) *MsgTombstoneRecordRequest {
+	return &MsgTombstoneRecordRequest{RecordId: recordID, Reason: reason, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgTombstoneRecordRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgTombstoneRecordRequest) ValidateBasic() error {
+	if !msg.RecordId.IsRecordAddress() {
+		return fmt.Errorf("invalid record id %q: must be a record address", msg.RecordId)
+	}
+	if len(strings.TrimSpace(msg.Reason)) == 0 {
+		return fmt.Errorf("a reason is required")
+	}
+	if len(msg.Reason) > MaxTombstoneReasonLength {
+		return fmt.Errorf("reason length %d exceeds maximum length of %d", len(msg.Reason), MaxTombstoneReasonLength)
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
+// ------------------  MsgProposeChangeRequest  ------------------
+
+// NewMsgProposeChangeRequest creates a new msg instance
+func NewMsgProposeChangeRequest(msg sdk.Msg, expirationHeight int64, signers []string) (*MsgProposeChangeRequest, error) {
+	msgAny, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgProposeChangeRequest{Msg: msgAny, ExpirationHeight: expirationHeight, Signers: signers}, nil
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgProposeChangeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// GetPendingChangeMsg returns the msg being proposed, with the signers of this request as its signers.
+func (msg MsgProposeChangeRequest) GetPendingChangeMsg() (MetadataMsg, error) {
+	if msg.Msg == nil {
+		return nil, errors.New("msg cannot be empty")
+	}
+	inner, ok := msg.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("could not unpack msg with type url %q", msg.Msg.TypeUrl)
+	}
+	return NewPendingChangeMsg(inner, msg.Signers)
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgProposeChangeRequest) ValidateBasic() error {
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	if msg.ExpirationHeight <= 0 {
+		return fmt.Errorf("invalid expiration height %d: must be positive", msg.ExpirationHeight)
+	}
+	pcMsg, err := msg.GetPendingChangeMsg()
+	if err != nil {
+		return err
+	}
+	if err = pcMsg.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid %s: %w", msg.Msg.TypeUrl, err)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgProposeChangeRequest) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var inner sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &inner)
+}
+
+// ------------------  MsgApproveChangeRequest  ------------------
+
+// NewMsgApproveChangeRequest creates a new msg instance
+func NewMsgApproveChangeRequest(changeID uint64, signers []string) *MsgApproveChangeRequest {
+	return &MsgApproveChangeRequest{ChangeId: changeID, Signers: signers}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgApproveChangeRequest) GetSignerStrs() []string {
+	return msg.Signers
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgApproveChangeRequest) ValidateBasic() error {
+	if msg.ChangeId == 0 {
+		return errors.New("invalid change id: cannot be zero")
+	}
+	if len(msg.Signers) < 1 {
+		return fmt.Errorf("at least one signer is required")
+	}
+	return nil
+}
+
 // ------------------  MsgWriteScopeSpecificationRequest  ------------------
 
 // NewMsgWriteScopeSpecificationRequest creates a new msg instance
@@ -883,6 +998,31 @@ func (msg MsgModifyOSLocatorRequest) ValidateBasic() error {
 	return nil
 }
 
+// ------------------  MsgHeartbeatOSLocatorRequest  ------------------
+
+// NewMsgHeartbeatOSLocatorRequest creates a new msg instance
+func NewMsgHeartbeatOSLocatorRequest(owner string) *MsgHeartbeatOSLocatorRequest {
+	return &MsgHeartbeatOSLocatorRequest{
+		Owner: owner,
+	}
+}
+
+// GetSignerStrs returns the bech32 address(es) that signed. Implements MetadataMsg interface.
+func (msg MsgHeartbeatOSLocatorRequest) GetSignerStrs() []string {
+	return []string{msg.Owner}
+}
+
+// ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
+func (msg MsgHeartbeatOSLocatorRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Owner) == "" {
+		return fmt.Errorf("owner address cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %q: %w", msg.Owner, err)
+	}
+	return nil
+}
+
 // ------------------  MsgSetAccountDataRequest  ------------------
 
 // ValidateBasic performs as much validation as possible without outside info. Implements sdk.Msg interface.
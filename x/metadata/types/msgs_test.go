@@ -54,6 +54,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg {
 			return &MsgModifyOSLocatorRequest{Locator: ObjectStoreLocator{Owner: signer}}
 		},
+		func(signer string) sdk.Msg {
+			return &MsgHeartbeatOSLocatorRequest{Owner: signer}
+		},
 	}
 
 	multiSignerMsgMakers := []testutil.MsgMakerMulti{
@@ -68,6 +71,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signers []string) sdk.Msg { return &MsgWriteSessionRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteRecordRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteRecordRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgTombstoneRecordRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgProposeChangeRequest{Signers: signers} },
+		func(signers []string) sdk.Msg { return &MsgApproveChangeRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgDeleteScopeSpecificationRequest{Signers: signers} },
 		func(signers []string) sdk.Msg { return &MsgWriteContractSpecificationRequest{Signers: signers} },
@@ -752,7 +758,7 @@ func TestBindOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgBindOSLocatorRequest", sdk.MsgTypeURL(bindRequestMsg))
 
 	bz, _ := GetCdc(t).MarshalJSON(bindRequestMsg)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"endpoints\":[],\"last_heartbeat_height\":\"0\"}}", string(bz))
 }
 
 func TestModifyOSLocator(t *testing.T) {
@@ -764,7 +770,7 @@ func TestModifyOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgModifyOSLocatorRequest", sdk.MsgTypeURL(modifyRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(modifyRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"endpoints\":[],\"last_heartbeat_height\":\"0\"}}", string(bz))
 }
 
 func TestDeleteOSLocator(t *testing.T) {
@@ -776,7 +782,37 @@ func TestDeleteOSLocator(t *testing.T) {
 	require.Equal(t, "/provenance.metadata.v1.MsgDeleteOSLocatorRequest", sdk.MsgTypeURL(deleteRequest))
 
 	bz, _ := GetCdc(t).MarshalJSON(deleteRequest)
-	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\"}}", string(bz))
+	require.Equal(t, "{\"locator\":{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\",\"locator_uri\":\"http://foo.com\",\"encryption_key\":\"\",\"endpoints\":[],\"last_heartbeat_height\":\"0\"}}", string(bz))
+}
+
+func TestHeartbeatOSLocator(t *testing.T) {
+	owner := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	tests := []struct {
+		name   string
+		owner  string
+		expErr string
+	}{
+		{name: "valid", owner: owner},
+		{name: "empty owner", owner: "", expErr: "owner address cannot be empty"},
+		{name: "bad owner", owner: "vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck", expErr: "invalid owner address \"vamonos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgHeartbeatOSLocatorRequest(tc.owner)
+			err := msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+
+	msg := NewMsgHeartbeatOSLocatorRequest(owner)
+	require.Equal(t, "/provenance.metadata.v1.MsgHeartbeatOSLocatorRequest", sdk.MsgTypeURL(msg))
+	bz, _ := GetCdc(t).MarshalJSON(msg)
+	require.Equal(t, "{\"owner\":\"cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck\"}", string(bz))
 }
 
 func TestBindOSLocatorInvalid(t *testing.T) {
@@ -989,3 +1025,154 @@ func TestMsgAddNetAssetValueValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgTombstoneRecordRequest_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeUUID := uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")
+	recordID := RecordMetadataAddress(scopeUUID, "recordname")
+
+	tests := []struct {
+		name   string
+		msg    MsgTombstoneRecordRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  *NewMsgTombstoneRecordRequest(recordID, "erasure request", []string{addr}),
+		},
+		{
+			name:   "scope id",
+			msg:    *NewMsgTombstoneRecordRequest(ScopeMetadataAddress(scopeUUID), "erasure request", []string{addr}),
+			expErr: `invalid record id "` + ScopeMetadataAddress(scopeUUID).String() + `": must be a record address`,
+		},
+		{
+			name:   "empty record id",
+			msg:    *NewMsgTombstoneRecordRequest(nil, "erasure request", []string{addr}),
+			expErr: `invalid record id "": must be a record address`,
+		},
+		{
+			name:   "no reason",
+			msg:    *NewMsgTombstoneRecordRequest(recordID, "  ", []string{addr}),
+			expErr: "a reason is required",
+		},
+		{
+			name:   "reason too long",
+			msg:    *NewMsgTombstoneRecordRequest(recordID, strings.Repeat("r", MaxTombstoneReasonLength+1), []string{addr}),
+			expErr: "reason length 201 exceeds maximum length of 200",
+		},
+		{
+			name:   "no signers",
+			msg:    *NewMsgTombstoneRecordRequest(recordID, "erasure request", nil),
+			expErr: "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgProposeChangeRequest_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scope := Scope{
+		ScopeId:           ScopeMetadataAddress(uuid.MustParse("8d80b25a-c089-4446-956e-5d08cfe3e1a5")),
+		SpecificationId:   ScopeSpecMetadataAddress(uuid.MustParse("22fc17a6-40dd-4d68-a95b-ec94e7572a09")),
+		Owners:            OwnerPartyList(addr),
+		ValueOwnerAddress: addr,
+	}
+	newMsg := func(msg sdk.Msg, expirationHeight int64, signers []string) MsgProposeChangeRequest {
+		rv, err := NewMsgProposeChangeRequest(msg, expirationHeight, signers)
+		require.NoError(t, err, "NewMsgProposeChangeRequest")
+		return *rv
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgProposeChangeRequest
+		expErr string
+	}{
+		{
+			name: "valid write scope",
+			msg:  newMsg(NewMsgWriteScopeRequest(scope, nil, 0), 100, []string{addr}),
+		},
+		{
+			name:   "no signers",
+			msg:    newMsg(NewMsgWriteScopeRequest(scope, nil, 0), 100, nil),
+			expErr: "at least one signer is required",
+		},
+		{
+			name:   "zero expiration height",
+			msg:    newMsg(NewMsgWriteScopeRequest(scope, nil, 0), 0, []string{addr}),
+			expErr: "invalid expiration height 0: must be positive",
+		},
+		{
+			name:   "no msg",
+			msg:    MsgProposeChangeRequest{ExpirationHeight: 100, Signers: []string{addr}},
+			expErr: "msg cannot be empty",
+		},
+		{
+			name:   "unsupported msg",
+			msg:    newMsg(NewMsgDeleteScopeRequest(scope.ScopeId, nil), 100, []string{addr}),
+			expErr: "unsupported pending change msg type *types.MsgDeleteScopeRequest: must be a " + TypeURLMsgWriteScopeRequest + " or " + TypeURLMsgWriteRecordRequest,
+		},
+		{
+			name:   "invalid write scope",
+			msg:    newMsg(NewMsgWriteScopeRequest(Scope{ScopeId: scope.ScopeId, SpecificationId: scope.SpecificationId}, nil, 0), 100, []string{addr}),
+			expErr: "invalid /provenance.metadata.v1.MsgWriteScopeRequest: invalid scope owners: at least one party is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgApproveChangeRequest_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+
+	tests := []struct {
+		name   string
+		msg    MsgApproveChangeRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  *NewMsgApproveChangeRequest(1, []string{addr}),
+		},
+		{
+			name:   "zero change id",
+			msg:    *NewMsgApproveChangeRequest(0, []string{addr}),
+			expErr: "invalid change id: cannot be zero",
+		},
+		{
+			name:   "no signers",
+			msg:    *NewMsgApproveChangeRequest(1, nil),
+			expErr: "at least one signer is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualErrorf(t, err, tc.expErr, "ValidateBasic error")
+			} else {
+				require.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
@@ -3,11 +3,15 @@ package types
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxOSLocatorEndpoints is the maximum number of additional endpoints an ObjectStoreLocator can have.
+const MaxOSLocatorEndpoints = 10
+
 // NewOSLocatorRecord creates a oslocator for a given address.
 func NewOSLocatorRecord(ownerAddr, encryptionKey sdk.AccAddress, uri string) ObjectStoreLocator {
 	return ObjectStoreLocator{
@@ -40,5 +44,44 @@ func (r ObjectStoreLocator) Validate() error {
 				r.Owner, r.EncryptionKey)
 		}
 	}
+
+	if len(r.Endpoints) > MaxOSLocatorEndpoints {
+		return fmt.Errorf("locator cannot have more than %d endpoints, found %d", MaxOSLocatorEndpoints, len(r.Endpoints))
+	}
+	seen := map[string]bool{r.LocatorUri: true}
+	for i, endpoint := range r.Endpoints {
+		if strings.TrimSpace(endpoint.Uri) == "" {
+			return fmt.Errorf("endpoint[%d] uri cannot be empty", i)
+		}
+		if _, err := url.Parse(endpoint.Uri); err != nil {
+			return fmt.Errorf("endpoint[%d] has an invalid uri: %s", i, endpoint.Uri)
+		}
+		if seen[endpoint.Uri] {
+			return fmt.Errorf("endpoint[%d] has a duplicate uri: %s", i, endpoint.Uri)
+		}
+		seen[endpoint.Uri] = true
+	}
 	return nil
 }
+
+// FailoverURIs returns all of this locator's uris in the order they should be tried.
+// The locator_uri is always first, followed by the endpoints ordered by priority.
+func (r ObjectStoreLocator) FailoverURIs() []string {
+	endpoints := make([]LocatorEndpoint, len(r.Endpoints))
+	copy(endpoints, r.Endpoints)
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].Priority < endpoints[j].Priority
+	})
+
+	rv := make([]string, 0, 1+len(endpoints))
+	rv = append(rv, r.LocatorUri)
+	for _, endpoint := range endpoints {
+		rv = append(rv, endpoint.Uri)
+	}
+	return rv
+}
+
+// IsStale returns true if this locator hasn't had a heartbeat in more than maxAge blocks as of the provided height.
+func (r ObjectStoreLocator) IsStale(height, maxAge int64) bool {
+	return height-r.LastHeartbeatHeight > maxAge
+}
@@ -33,6 +33,10 @@ type ObjectStoreLocator struct {
 	LocatorUri string `protobuf:"bytes,2,opt,name=locator_uri,json=locatorUri,proto3" json:"locator_uri,omitempty"`
 	// owners encryption key address
 	EncryptionKey string `protobuf:"bytes,3,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+	// additional endpoints for the owner's object store, to use when the locator_uri is unavailable.
+	Endpoints []LocatorEndpoint `protobuf:"bytes,4,rep,name=endpoints,proto3" json:"endpoints"`
+	// block height of the owner's most recent heartbeat, bind, or modify; this is set by the chain.
+	LastHeartbeatHeight int64 `protobuf:"varint,5,opt,name=last_heartbeat_height,json=lastHeartbeatHeight,proto3" json:"last_heartbeat_height,omitempty"`
 }
 
 func (m *ObjectStoreLocator) Reset()         { *m = ObjectStoreLocator{} }
@@ -89,6 +93,75 @@ func (m *ObjectStoreLocator) GetEncryptionKey() string {
 	return ""
 }
 
+func (m *ObjectStoreLocator) GetEndpoints() []LocatorEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+func (m *ObjectStoreLocator) GetLastHeartbeatHeight() int64 {
+	if m != nil {
+		return m.LastHeartbeatHeight
+	}
+	return 0
+}
+
+// LocatorEndpoint defines an additional object store endpoint uri and its failover priority.
+type LocatorEndpoint struct {
+	// endpoint uri
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// failover priority; endpoints with lower values should be tried first.
+	Priority uint32 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *LocatorEndpoint) Reset()         { *m = LocatorEndpoint{} }
+func (m *LocatorEndpoint) String() string { return proto.CompactTextString(m) }
+func (*LocatorEndpoint) ProtoMessage()    {}
+func (*LocatorEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+}
+func (m *LocatorEndpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocatorEndpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocatorEndpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocatorEndpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocatorEndpoint.Merge(m, src)
+}
+func (m *LocatorEndpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocatorEndpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocatorEndpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocatorEndpoint proto.InternalMessageInfo
+
+func (m *LocatorEndpoint) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *LocatorEndpoint) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // Params defines the parameters for the metadata-locator module methods.
 type OSLocatorParams struct {
 	MaxUriLength uint32 `protobuf:"varint,1,opt,name=max_uri_length,json=maxUriLength,proto3,customtype=uint32" json:"max_uri_length"`
@@ -98,7 +171,7 @@ func (m *OSLocatorParams) Reset()         { *m = OSLocatorParams{} }
 func (m *OSLocatorParams) String() string { return proto.CompactTextString(m) }
 func (*OSLocatorParams) ProtoMessage()    {}
 func (*OSLocatorParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d17fc5ccfa1c263, []int{1}
+	return fileDescriptor_3d17fc5ccfa1c263, []int{2}
 }
 func (m *OSLocatorParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,6 +202,7 @@ var xxx_messageInfo_OSLocatorParams proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*ObjectStoreLocator)(nil), "provenance.metadata.v1.ObjectStoreLocator")
+	proto.RegisterType((*LocatorEndpoint)(nil), "provenance.metadata.v1.LocatorEndpoint")
 	proto.RegisterType((*OSLocatorParams)(nil), "provenance.metadata.v1.OSLocatorParams")
 }
 
@@ -137,28 +211,34 @@ func init() {
 }
 
 var fileDescriptor_3d17fc5ccfa1c263 = []byte{
-	// 331 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0x02, 0x31,
-	0x14, 0xc7, 0xef, 0x34, 0x92, 0x58, 0x05, 0x93, 0x0b, 0x51, 0x64, 0x38, 0x0c, 0x89, 0x09, 0x31,
-	0xf1, 0x1a, 0x84, 0xc9, 0x91, 0xc5, 0x41, 0x12, 0x08, 0x84, 0xc5, 0x85, 0x94, 0xb3, 0x29, 0x15,
-	0xda, 0x77, 0x69, 0x0b, 0x72, 0x8b, 0x83, 0x9f, 0xc0, 0x8f, 0xe2, 0xc7, 0x60, 0x64, 0x34, 0x0e,
-	0xc4, 0xc0, 0xe0, 0xd7, 0x30, 0xd7, 0xc3, 0x9c, 0x83, 0xdb, 0x7b, 0xff, 0xfe, 0xde, 0xff, 0xb5,
-	0xff, 0xa2, 0x5a, 0xa4, 0x60, 0x4e, 0x25, 0x91, 0x21, 0xc5, 0x82, 0x1a, 0xf2, 0x48, 0x0c, 0xc1,
-	0xf3, 0x3a, 0x86, 0xd1, 0x13, 0x0d, 0x8d, 0x36, 0xa0, 0x68, 0x10, 0x29, 0x30, 0xe0, 0x9d, 0x66,
-	0x64, 0xf0, 0x4b, 0x06, 0xf3, 0x7a, 0xf9, 0x2c, 0x04, 0x2d, 0x40, 0x63, 0xa1, 0x59, 0x32, 0x28,
-	0x34, 0x4b, 0x07, 0xca, 0x45, 0x06, 0x0c, 0x6c, 0x89, 0x93, 0x2a, 0x55, 0xab, 0x2f, 0xc8, 0xeb,
-	0x58, 0xef, 0x7e, 0xe2, 0xdd, 0x86, 0x90, 0x18, 0x50, 0x5e, 0x11, 0x1d, 0xc0, 0xb3, 0xa4, 0xaa,
-	0xe4, 0x5e, 0xb8, 0xb5, 0xc3, 0x5e, 0xda, 0x78, 0x15, 0x74, 0x34, 0x4d, 0x81, 0xe1, 0x4c, 0xf1,
-	0xd2, 0x9e, 0x3d, 0x43, 0x3b, 0x69, 0xa0, 0xb8, 0x77, 0x89, 0x0a, 0x54, 0x86, 0x2a, 0x8e, 0x0c,
-	0x07, 0x39, 0x9c, 0xd0, 0xb8, 0xb4, 0x6f, 0x99, 0x7c, 0xa6, 0xde, 0xd3, 0xf8, 0x16, 0xbd, 0x7e,
-	0xbf, 0x5f, 0xa5, 0x9e, 0xd5, 0x3b, 0x74, 0xd2, 0xe9, 0xef, 0xd6, 0x76, 0x89, 0x22, 0x42, 0x7b,
-	0x4d, 0x54, 0x10, 0x64, 0x91, 0xac, 0x18, 0x4e, 0xa9, 0x64, 0x66, 0x6c, 0x6f, 0x91, 0x6f, 0x15,
-	0x96, 0xeb, 0x8a, 0xf3, 0xb9, 0xae, 0xe4, 0x66, 0x5c, 0x9a, 0xc6, 0x4d, 0xef, 0x58, 0x90, 0xc5,
-	0x40, 0xf1, 0xb6, 0x65, 0x5a, 0x93, 0xe5, 0xc6, 0x77, 0x57, 0x1b, 0xdf, 0xfd, 0xda, 0xf8, 0xee,
-	0xdb, 0xd6, 0x77, 0x56, 0x5b, 0xdf, 0xf9, 0xd8, 0xfa, 0x0e, 0x3a, 0xe7, 0xf6, 0xb1, 0xff, 0x84,
-	0xd5, 0x75, 0x1f, 0x9a, 0x8c, 0x9b, 0xf1, 0x6c, 0x14, 0x84, 0x20, 0x70, 0x06, 0x5d, 0x73, 0xf8,
-	0xd3, 0xe1, 0x45, 0xf6, 0x17, 0x26, 0x8e, 0xa8, 0x1e, 0xe5, 0x6c, 0x78, 0x8d, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x78, 0x72, 0x38, 0xaf, 0x01, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x98, 0xb6, 0xd8, 0xa9, 0x49, 0x65, 0xac, 0xba, 0xe6, 0xb0, 0x09, 0x01, 0x31, 0x08,
+	0xee, 0xd2, 0xb4, 0x27, 0x2f, 0x42, 0x40, 0x2c, 0xb4, 0xd0, 0xb2, 0xa5, 0x17, 0x2f, 0x61, 0xb2,
+	0x1d, 0x36, 0x63, 0x33, 0xf3, 0x96, 0x99, 0x97, 0x98, 0xbd, 0xfa, 0x0b, 0xfc, 0x29, 0xfe, 0x8c,
+	0x1e, 0x7b, 0x14, 0x0f, 0x45, 0x92, 0x83, 0x3f, 0x43, 0x99, 0xd9, 0xad, 0x2b, 0xa2, 0xb7, 0xef,
+	0xbd, 0xef, 0x7b, 0xdf, 0xbe, 0xef, 0xed, 0xd0, 0x41, 0x6e, 0x60, 0x21, 0x34, 0xd7, 0xa9, 0x88,
+	0x95, 0x40, 0x7e, 0xc9, 0x91, 0xc7, 0x8b, 0xfd, 0x18, 0x26, 0x1f, 0x44, 0x8a, 0x16, 0xc1, 0x88,
+	0x28, 0x37, 0x80, 0xc0, 0x9e, 0xd4, 0xca, 0xe8, 0x4e, 0x19, 0x2d, 0xf6, 0x3b, 0x4f, 0x53, 0xb0,
+	0x0a, 0x6c, 0xac, 0x6c, 0xe6, 0x06, 0x95, 0xcd, 0xca, 0x81, 0xce, 0x5e, 0x06, 0x19, 0x78, 0x18,
+	0x3b, 0x54, 0x76, 0xfb, 0x3f, 0x09, 0x65, 0xa7, 0xde, 0xfc, 0xdc, 0x99, 0x9f, 0x40, 0xca, 0x11,
+	0x0c, 0xdb, 0xa3, 0x9b, 0xf0, 0x51, 0x0b, 0x13, 0x90, 0x1e, 0x19, 0x6c, 0x27, 0x65, 0xc1, 0xba,
+	0x74, 0x67, 0x56, 0x0a, 0xc6, 0x73, 0x23, 0x83, 0x7b, 0x9e, 0xa3, 0x55, 0xeb, 0xc2, 0x48, 0xf6,
+	0x9c, 0xb6, 0x85, 0x4e, 0x4d, 0x91, 0xa3, 0x04, 0x3d, 0xbe, 0x12, 0x45, 0xd0, 0xf4, 0x9a, 0x56,
+	0xdd, 0x3d, 0x16, 0x05, 0x3b, 0xa6, 0xdb, 0x42, 0x5f, 0xe6, 0x20, 0x35, 0xda, 0x60, 0xa3, 0xd7,
+	0x1c, 0xec, 0x0c, 0x5f, 0x44, 0xff, 0xce, 0x13, 0x55, 0x1b, 0xbd, 0xad, 0xf4, 0xa3, 0x8d, 0xeb,
+	0xdb, 0x6e, 0x23, 0xa9, 0xe7, 0xd9, 0x90, 0x3e, 0x9e, 0x71, 0x8b, 0xe3, 0xa9, 0xe0, 0x06, 0x27,
+	0x82, 0x3b, 0x24, 0xb3, 0x29, 0x06, 0x9b, 0x3d, 0x32, 0x68, 0x26, 0x8f, 0x1c, 0x79, 0x74, 0xc7,
+	0x1d, 0x79, 0xea, 0x35, 0xfd, 0xf4, 0xe3, 0xcb, 0xcb, 0x32, 0x54, 0xff, 0x0d, 0xdd, 0xfd, 0xeb,
+	0x1b, 0xec, 0x21, 0x6d, 0xba, 0x7c, 0x65, 0x76, 0x07, 0x59, 0x87, 0xde, 0xcf, 0x8d, 0x04, 0x23,
+	0xb1, 0xf0, 0xb1, 0x5b, 0xc9, 0xef, 0xba, 0xff, 0x8e, 0xee, 0x9e, 0x9e, 0x57, 0x16, 0x67, 0xdc,
+	0x70, 0x65, 0xd9, 0x21, 0x6d, 0x2b, 0xbe, 0x74, 0x47, 0x1a, 0xcf, 0x84, 0xce, 0x70, 0xea, 0xbd,
+	0x5a, 0xa3, 0xb6, 0x5b, 0xfe, 0xdb, 0x6d, 0x77, 0x6b, 0x2e, 0x35, 0x1e, 0x0c, 0x93, 0x07, 0x8a,
+	0x2f, 0x2f, 0x8c, 0x3c, 0xf1, 0x9a, 0xd1, 0xd5, 0xf5, 0x2a, 0x24, 0x37, 0xab, 0x90, 0x7c, 0x5f,
+	0x85, 0xe4, 0xf3, 0x3a, 0x6c, 0xdc, 0xac, 0xc3, 0xc6, 0xd7, 0x75, 0xd8, 0xa0, 0xcf, 0x24, 0xfc,
+	0xe7, 0x3e, 0x67, 0xe4, 0xfd, 0x61, 0x26, 0x71, 0x3a, 0x9f, 0x44, 0x29, 0xa8, 0xb8, 0x16, 0xbd,
+	0x92, 0xf0, 0x47, 0x15, 0x2f, 0xeb, 0xe7, 0x84, 0x45, 0x2e, 0xec, 0x64, 0xcb, 0xff, 0xff, 0x83,
+	0x5f, 0x03, 0x00, 0x58, 0x7d, 0xc8, 0x1b, 0x72, 0x02, 0x00, 0x00,
 }
 
 func (m *ObjectStoreLocator) Marshal() (dAtA []byte, err error) {
@@ -181,6 +261,25 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastHeartbeatHeight != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.LastHeartbeatHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Endpoints) > 0 {
+		for iNdEx := len(m.Endpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Endpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintObjectstore(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.EncryptionKey) > 0 {
 		i -= len(m.EncryptionKey)
 		copy(dAtA[i:], m.EncryptionKey)
@@ -205,6 +304,41 @@ func (m *ObjectStoreLocator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LocatorEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocatorEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocatorEndpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintObjectstore(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintObjectstore(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OSLocatorParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +396,31 @@ func (m *ObjectStoreLocator) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovObjectstore(uint64(l))
 	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovObjectstore(uint64(l))
+		}
+	}
+	if m.LastHeartbeatHeight != 0 {
+		n += 1 + sovObjectstore(uint64(m.LastHeartbeatHeight))
+	}
+	return n
+}
+
+func (m *LocatorEndpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovObjectstore(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovObjectstore(uint64(m.Priority))
+	}
 	return n
 }
 
@@ -408,6 +567,160 @@ func (m *ObjectStoreLocator) Unmarshal(dAtA []byte) error {
 			}
 			m.EncryptionKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, LocatorEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatHeight", wireType)
+			}
+			m.LastHeartbeatHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeatHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjectstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocatorEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjectstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocatorEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocatorEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObjectstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjectstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjectstore(dAtA[iNdEx:])
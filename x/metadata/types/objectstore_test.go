@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestObjectStoreLocatorValidate(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	tooMany := make([]LocatorEndpoint, MaxOSLocatorEndpoints+1)
+	for i := range tooMany {
+		tooMany[i] = LocatorEndpoint{Uri: fmt.Sprintf("http://e%d.com", i)}
+	}
+
+	tests := []struct {
+		name    string
+		locator ObjectStoreLocator
+		expErr  string
+	}{
+		{
+			name:    "no endpoints",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com"},
+		},
+		{
+			name: "with endpoints",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "http://bar.com", Priority: 1},
+				{Uri: "http://baz.com", Priority: 0},
+			}},
+		},
+		{
+			name:    "max endpoints",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: tooMany[:MaxOSLocatorEndpoints]},
+		},
+		{
+			name:    "too many endpoints",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: tooMany},
+			expErr:  fmt.Sprintf("locator cannot have more than %d endpoints, found %d", MaxOSLocatorEndpoints, MaxOSLocatorEndpoints+1),
+		},
+		{
+			name: "empty endpoint uri",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "http://bar.com"}, {Uri: " "},
+			}},
+			expErr: "endpoint[1] uri cannot be empty",
+		},
+		{
+			name: "invalid endpoint uri",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "%gh&%ij"},
+			}},
+			expErr: "endpoint[0] has an invalid uri: %gh&%ij",
+		},
+		{
+			name: "endpoint same as locator uri",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "http://foo.com"},
+			}},
+			expErr: "endpoint[0] has a duplicate uri: http://foo.com",
+		},
+		{
+			name: "duplicate endpoints",
+			locator: ObjectStoreLocator{Owner: owner, LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "http://bar.com"}, {Uri: "http://baz.com"}, {Uri: "http://bar.com"},
+			}},
+			expErr: "endpoint[2] has a duplicate uri: http://bar.com",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.locator.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestObjectStoreLocatorFailoverURIs(t *testing.T) {
+	tests := []struct {
+		name    string
+		locator ObjectStoreLocator
+		exp     []string
+	}{
+		{
+			name:    "no endpoints",
+			locator: ObjectStoreLocator{LocatorUri: "http://foo.com"},
+			exp:     []string{"http://foo.com"},
+		},
+		{
+			name: "ordered by priority",
+			locator: ObjectStoreLocator{LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "http://c.com", Priority: 3},
+				{Uri: "http://a.com", Priority: 1},
+				{Uri: "http://b.com", Priority: 2},
+			}},
+			exp: []string{"http://foo.com", "http://a.com", "http://b.com", "http://c.com"},
+		},
+		{
+			name: "equal priorities keep their order",
+			locator: ObjectStoreLocator{LocatorUri: "http://foo.com", Endpoints: []LocatorEndpoint{
+				{Uri: "http://b.com", Priority: 1},
+				{Uri: "http://a.com", Priority: 1},
+				{Uri: "http://z.com", Priority: 0},
+			}},
+			exp: []string{"http://foo.com", "http://z.com", "http://b.com", "http://a.com"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			orig := make([]LocatorEndpoint, len(tc.locator.Endpoints))
+			copy(orig, tc.locator.Endpoints)
+			act := tc.locator.FailoverURIs()
+			assert.Equal(t, tc.exp, act, "FailoverURIs")
+			assert.Equal(t, orig, tc.locator.Endpoints, "endpoints after FailoverURIs")
+		})
+	}
+}
+
+func TestObjectStoreLocatorIsStale(t *testing.T) {
+	locator := ObjectStoreLocator{LastHeartbeatHeight: 100}
+	tests := []struct {
+		height int64
+		maxAge int64
+		exp    bool
+	}{
+		{height: 100, maxAge: 0, exp: false},
+		{height: 101, maxAge: 0, exp: true},
+		{height: 150, maxAge: 50, exp: false},
+		{height: 151, maxAge: 50, exp: true},
+		{height: 99, maxAge: 0, exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("height %d max age %d", tc.height, tc.maxAge), func(t *testing.T) {
+			act := locator.IsStale(tc.height, tc.maxAge)
+			assert.Equal(t, tc.exp, act, "IsStale(%d, %d)", tc.height, tc.maxAge)
+		})
+	}
+}
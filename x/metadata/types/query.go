@@ -1,5 +1,14 @@
 package types
 
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = (*QueryPendingChangeResponse)(nil)
+	_ cdctypes.UnpackInterfacesMessage = (*QueryPendingChangesResponse)(nil)
+)
+
 // -------------- ScopeWrapper --------------
 
 // WrapScope wraps a scope in a ScopeWrapper and populates the _addr and _uuid fields.
@@ -136,3 +145,20 @@ func WrapRecordSpecNotFound(ma MetadataAddress) *RecordSpecificationWrapper {
 		RecordSpecIdInfo: GetRecordSpecIDInfo(ma),
 	}
 }
+
+// -------------- Pending Changes --------------
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryPendingChangeResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return r.PendingChange.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryPendingChangesResponse) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, change := range r.PendingChanges {
+		if err := change.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,17 +3,28 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/internal/provutils"
 )
 
+var _ cdctypes.UnpackInterfacesMessage = (*PendingChange)(nil)
+
 const (
 	// A sane default for maximum length of an audit message string (memo)
 	maxAuditMessageLength = 200
 	UsdDenom              = "usd"
+
+	// RedactedHash is the value that replaces the input and output hashes of a tombstoned record.
+	RedactedHash = "REDACTED"
+	// MaxTombstoneReasonLength is the maximum length of the reason a record is tombstoned.
+	MaxTombstoneReasonLength = 200
+	// MaxPendingChangeBlocks is the maximum number of blocks that a pending change can wait on approvals.
+	MaxPendingChangeBlocks = 1_000_000
 )
 
 // NewScope creates a new instance.
@@ -298,6 +309,135 @@ func (r Record) GetRecordAddress() MetadataAddress {
 	return MetadataAddress{}
 }
 
+// Redacted returns a copy of this record with the hashes of its inputs and outputs replaced with the RedactedHash.
+// Inputs that reference other records, and everything else about the record, are left as they are.
+func (r Record) Redacted() Record {
+	rv := r
+	rv.Inputs = make([]RecordInput, len(r.Inputs))
+	for i, input := range r.Inputs {
+		if _, isHash := input.Source.(*RecordInput_Hash); isHash {
+			input.Source = &RecordInput_Hash{Hash: RedactedHash}
+		}
+		rv.Inputs[i] = input
+	}
+	rv.Outputs = make([]RecordOutput, len(r.Outputs))
+	for i, output := range r.Outputs {
+		output.Hash = RedactedHash
+		rv.Outputs[i] = output
+	}
+	return rv
+}
+
+// NewRecordTombstone creates a new instance of RecordTombstone.
+func NewRecordTombstone(recordID MetadataAddress, reason string, signers []string, tombstonedAt time.Time, height int64) *RecordTombstone {
+	return &RecordTombstone{
+		RecordId:     recordID,
+		Reason:       reason,
+		Signers:      signers,
+		TombstonedAt: tombstonedAt,
+		Height:       height,
+	}
+}
+
+// ValidateBasic performs static checking of RecordTombstone format
+func (t RecordTombstone) ValidateBasic() error {
+	if !t.RecordId.IsRecordAddress() {
+		return fmt.Errorf("invalid record id %q: must be a record address", t.RecordId)
+	}
+	if len(t.Reason) == 0 {
+		return fmt.Errorf("missing reason for record tombstone %s", t.RecordId)
+	}
+	if len(t.Reason) > MaxTombstoneReasonLength {
+		return fmt.Errorf("record tombstone %s reason length %d exceeds maximum length of %d", t.RecordId, len(t.Reason), MaxTombstoneReasonLength)
+	}
+	if len(t.Signers) == 0 {
+		return fmt.Errorf("missing signers for record tombstone %s", t.RecordId)
+	}
+	return nil
+}
+
+// NewPendingChange creates a new instance of PendingChange.
+func NewPendingChange(changeID uint64, scopeID MetadataAddress, msg *cdctypes.Any, approvals []string, expirationHeight int64) *PendingChange {
+	return &PendingChange{
+		ChangeId:         changeID,
+		ScopeId:          scopeID,
+		Msg:              msg,
+		Approvals:        approvals,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
+// ValidateBasic performs static checking of PendingChange format
+func (c PendingChange) ValidateBasic() error {
+	if c.ChangeId == 0 {
+		return errors.New("invalid pending change id: cannot be zero")
+	}
+	if !c.ScopeId.IsScopeAddress() {
+		return fmt.Errorf("invalid pending change %d scope id %q: must be a scope address", c.ChangeId, c.ScopeId)
+	}
+	if c.Msg == nil {
+		return fmt.Errorf("missing msg for pending change %d", c.ChangeId)
+	}
+	if len(c.Approvals) == 0 {
+		return fmt.Errorf("missing approvals for pending change %d", c.ChangeId)
+	}
+	for _, approval := range c.Approvals {
+		if _, err := sdk.AccAddressFromBech32(approval); err != nil {
+			return fmt.Errorf("invalid pending change %d approval %q: %w", c.ChangeId, approval, err)
+		}
+	}
+	if c.ExpirationHeight <= 0 {
+		return fmt.Errorf("invalid pending change %d expiration height %d: must be positive", c.ChangeId, c.ExpirationHeight)
+	}
+	return nil
+}
+
+// HasApproval returns true if the provided address has approved this pending change.
+func (c PendingChange) HasApproval(addr string) bool {
+	return slices.Contains(c.Approvals, addr)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c PendingChange) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(c.Msg, &msg)
+}
+
+// NewPendingChangeMsg returns a copy of a msg that can be a pending change, with its optional fields converted and
+// its signers replaced with the ones provided. An error is returned if the msg can't be a pending change.
+func NewPendingChangeMsg(msg sdk.Msg, signers []string) (MetadataMsg, error) {
+	switch m := msg.(type) {
+	case *MsgWriteScopeRequest:
+		rv := *m
+		rv.Signers = signers
+		if err := rv.ConvertOptionalFields(); err != nil {
+			return nil, err
+		}
+		return &rv, nil
+	case *MsgWriteRecordRequest:
+		rv := *m
+		rv.Signers = signers
+		if err := rv.ConvertOptionalFields(); err != nil {
+			return nil, err
+		}
+		return &rv, nil
+	}
+	return nil, fmt.Errorf("unsupported pending change msg type %T: must be a %s or %s",
+		msg, TypeURLMsgWriteScopeRequest, TypeURLMsgWriteRecordRequest)
+}
+
+// GetPendingChangeScopeID returns the id of the scope changed by a msg returned from NewPendingChangeMsg.
+func GetPendingChangeScopeID(msg MetadataMsg) MetadataAddress {
+	switch m := msg.(type) {
+	case *MsgWriteScopeRequest:
+		return m.Scope.ScopeId
+	case *MsgWriteRecordRequest:
+		scopeID, _ := m.Record.SessionId.AsScopeAddress()
+		return scopeID
+	}
+	return MetadataAddress{}
+}
+
 // NewRecordInput creates new instance of RecordInput
 func NewRecordInput(name string, source isRecordInput_Source, typeName string, status RecordInputStatus) *RecordInput {
 	return &RecordInput{
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -344,6 +346,130 @@ func (m *Record) GetOutputs() []RecordOutput {
 	return nil
 }
 
+// RecordTombstone records that a record's contents were redacted.
+// The input and output hashes of a tombstoned record are replaced with a redaction marker; its structure is kept.
+type RecordTombstone struct {
+	// record_id is the id of the record that was tombstoned.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id"`
+	// reason is why the record's contents were redacted.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// signers are the addresses that signed the request to tombstone the record.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// tombstoned_at is the block time that the record was tombstoned.
+	TombstonedAt time.Time `protobuf:"bytes,4,opt,name=tombstoned_at,json=tombstonedAt,proto3,stdtime" json:"tombstoned_at"`
+	// height is the block height that the record was tombstoned.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RecordTombstone) Reset()         { *m = RecordTombstone{} }
+func (m *RecordTombstone) String() string { return proto.CompactTextString(m) }
+func (*RecordTombstone) ProtoMessage()    {}
+func (*RecordTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{3}
+}
+func (m *RecordTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordTombstone.Merge(m, src)
+}
+func (m *RecordTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordTombstone proto.InternalMessageInfo
+
+func (m *RecordTombstone) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RecordTombstone) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *RecordTombstone) GetTombstonedAt() time.Time {
+	if m != nil {
+		return m.TombstonedAt
+	}
+	return time.Time{}
+}
+
+func (m *RecordTombstone) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PendingChange is a scope or record update that is waiting on approvals from its required signers.
+// Approvals are collected over time, and the change is executed once they satisfy the scope's signer requirements.
+type PendingChange struct {
+	// change_id is the unique identifier of this pending change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// scope_id is the id of the scope being changed.
+	ScopeId MetadataAddress `protobuf:"bytes,2,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// msg is the MsgWriteScopeRequest or MsgWriteRecordRequest to execute once enough signers have approved it.
+	Msg *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// approvals are the addresses that have approved this change, in the order that they approved it.
+	Approvals []string `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// expiration_height is the block height at which this change expires if it hasn't been executed.
+	ExpirationHeight int64 `protobuf:"varint,5,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+}
+
+func (m *PendingChange) Reset()         { *m = PendingChange{} }
+func (m *PendingChange) String() string { return proto.CompactTextString(m) }
+func (*PendingChange) ProtoMessage()    {}
+func (*PendingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{4}
+}
+func (m *PendingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChange.Merge(m, src)
+}
+func (m *PendingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChange proto.InternalMessageInfo
+
 // Process contains information used to uniquely identify what was used to generate this record
 type Process struct {
 	// unique identifier for this process
@@ -361,7 +487,7 @@ type Process struct {
 func (m *Process) Reset()      { *m = Process{} }
 func (*Process) ProtoMessage() {}
 func (*Process) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{3}
+	return fileDescriptor_edeea634bfb18aba, []int{5}
 }
 func (m *Process) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +594,7 @@ type RecordInput struct {
 func (m *RecordInput) Reset()      { *m = RecordInput{} }
 func (*RecordInput) ProtoMessage() {}
 func (*RecordInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{4}
+	return fileDescriptor_edeea634bfb18aba, []int{6}
 }
 func (m *RecordInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,7 +693,7 @@ type RecordOutput struct {
 func (m *RecordOutput) Reset()      { *m = RecordOutput{} }
 func (*RecordOutput) ProtoMessage() {}
 func (*RecordOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{5}
+	return fileDescriptor_edeea634bfb18aba, []int{7}
 }
 func (m *RecordOutput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -623,7 +749,7 @@ type Party struct {
 func (m *Party) Reset()      { *m = Party{} }
 func (*Party) ProtoMessage() {}
 func (*Party) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{6}
+	return fileDescriptor_edeea634bfb18aba, []int{8}
 }
 func (m *Party) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +819,7 @@ func (m *AuditFields) Reset()         { *m = AuditFields{} }
 func (m *AuditFields) String() string { return proto.CompactTextString(m) }
 func (*AuditFields) ProtoMessage()    {}
 func (*AuditFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{7}
+	return fileDescriptor_edeea634bfb18aba, []int{9}
 }
 func (m *AuditFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +893,7 @@ func (m *AuditFields) GetMessage() string {
 // NetAssetValue defines a scope's net asset value
 type NetAssetValue struct {
 	// price is the complete value of the asset's volume
-	Price types.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	Price types1.Coin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
 	// updated_block_height is the block height of last update
 	UpdatedBlockHeight uint64 `protobuf:"varint,2,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
 	// volume is the number of scope instances that were purchased for the price
@@ -780,7 +906,7 @@ func (m *NetAssetValue) Reset()         { *m = NetAssetValue{} }
 func (m *NetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*NetAssetValue) ProtoMessage()    {}
 func (*NetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_edeea634bfb18aba, []int{8}
+	return fileDescriptor_edeea634bfb18aba, []int{10}
 }
 func (m *NetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,11 +935,11 @@ func (m *NetAssetValue) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetAssetValue proto.InternalMessageInfo
 
-func (m *NetAssetValue) GetPrice() types.Coin {
+func (m *NetAssetValue) GetPrice() types1.Coin {
 	if m != nil {
 		return m.Price
 	}
-	return types.Coin{}
+	return types1.Coin{}
 }
 
 func (m *NetAssetValue) GetUpdatedBlockHeight() uint64 {
@@ -836,6 +962,8 @@ func init() {
 	proto.RegisterType((*Scope)(nil), "provenance.metadata.v1.Scope")
 	proto.RegisterType((*Session)(nil), "provenance.metadata.v1.Session")
 	proto.RegisterType((*Record)(nil), "provenance.metadata.v1.Record")
+	proto.RegisterType((*RecordTombstone)(nil), "provenance.metadata.v1.RecordTombstone")
+	proto.RegisterType((*PendingChange)(nil), "provenance.metadata.v1.PendingChange")
 	proto.RegisterType((*Process)(nil), "provenance.metadata.v1.Process")
 	proto.RegisterType((*RecordInput)(nil), "provenance.metadata.v1.RecordInput")
 	proto.RegisterType((*RecordOutput)(nil), "provenance.metadata.v1.RecordOutput")
@@ -849,79 +977,90 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xfa, 0xbf, 0x9f, 0x5d, 0xea, 0x4e, 0xab, 0xe2, 0x1a, 0x6a, 0x1b, 0xc3, 0xc1, 0x44,
-	0x62, 0xdd, 0x18, 0x8a, 0x44, 0x01, 0x21, 0xbb, 0x49, 0xa9, 0x45, 0x49, 0xac, 0x71, 0xc2, 0x81,
-	0xcb, 0x6a, 0xbd, 0x3b, 0xb5, 0x57, 0xb1, 0x77, 0x96, 0x9d, 0x59, 0xb7, 0x86, 0x0b, 0xe7, 0x9c,
-	0xc2, 0x8d, 0x4b, 0x24, 0xf8, 0x14, 0x7c, 0x85, 0x72, 0xeb, 0x11, 0x01, 0x2a, 0x28, 0xb9, 0xf2,
-	0x21, 0xd0, 0xcc, 0xce, 0xfa, 0x0f, 0x71, 0xa2, 0x44, 0xe2, 0xb6, 0xef, 0xbd, 0xdf, 0x9b, 0xf7,
-	0xde, 0xef, 0xbd, 0x37, 0xb3, 0x50, 0xf7, 0x7c, 0x3a, 0x25, 0xae, 0xe9, 0x5a, 0xa4, 0x39, 0x21,
-	0xdc, 0xb4, 0x4d, 0x6e, 0x36, 0xa7, 0x9b, 0x4d, 0x66, 0x51, 0x8f, 0xe8, 0x9e, 0x4f, 0x39, 0x45,
-	0xb7, 0x17, 0x18, 0x3d, 0xc2, 0xe8, 0xd3, 0xcd, 0x72, 0xc5, 0xa2, 0x6c, 0x42, 0x59, 0x73, 0x60,
-	0x32, 0xd2, 0x9c, 0x6e, 0x0e, 0x08, 0x37, 0x37, 0x9b, 0x16, 0x75, 0xdc, 0xd0, 0xaf, 0x7c, 0x6b,
-	0x48, 0x87, 0x54, 0x7e, 0x36, 0xc5, 0x97, 0xd2, 0x56, 0x87, 0x94, 0x0e, 0xc7, 0xa4, 0x29, 0xa5,
-	0x41, 0xf0, 0xb4, 0xc9, 0x9d, 0x09, 0x61, 0xdc, 0x9c, 0x78, 0x0a, 0x50, 0xfb, 0x2f, 0xc0, 0x26,
-	0xcc, 0xf2, 0x1d, 0x8f, 0x53, 0x5f, 0x21, 0x36, 0xce, 0x4b, 0xda, 0x23, 0x96, 0xf3, 0xd4, 0xb1,
-	0x4c, 0xee, 0x50, 0x95, 0x44, 0xfd, 0xd7, 0x38, 0xa4, 0xfa, 0xa2, 0x18, 0xd4, 0x82, 0xac, 0xac,
-	0xca, 0x70, 0xec, 0x92, 0x56, 0xd3, 0x1a, 0x85, 0xce, 0xeb, 0x2f, 0x5e, 0x55, 0x63, 0xbf, 0xbf,
-	0xaa, 0x5e, 0xff, 0x52, 0x1d, 0xd2, 0xb6, 0x6d, 0x9f, 0x30, 0x86, 0x33, 0x12, 0xd8, 0xb5, 0x51,
-	0x07, 0x8a, 0x2b, 0x87, 0x0a, 0xdf, 0xf8, 0xc5, 0xbe, 0xd7, 0x57, 0x1c, 0xba, 0x36, 0xfa, 0x18,
-	0xd2, 0xf4, 0x99, 0x4b, 0x7c, 0x56, 0x4a, 0xd4, 0x12, 0x8d, 0x7c, 0xeb, 0xae, 0xbe, 0x9e, 0x4f,
-	0xbd, 0x67, 0xfa, 0x7c, 0xd6, 0x49, 0x8a, 0x83, 0xb1, 0x72, 0x41, 0x55, 0xc8, 0x0b, 0xb3, 0x61,
-	0x5a, 0x16, 0x61, 0xac, 0x94, 0xac, 0x25, 0x1a, 0x39, 0x0c, 0x32, 0x9e, 0xd4, 0x20, 0x1d, 0x6e,
-	0x4e, 0xcd, 0x71, 0x40, 0x0c, 0xe9, 0x60, 0x98, 0x61, 0x16, 0xa5, 0x54, 0x4d, 0x6b, 0xe4, 0xf0,
-	0x0d, 0x69, 0xda, 0x15, 0x16, 0x95, 0x1e, 0xba, 0x07, 0xb7, 0x7c, 0xf2, 0x4d, 0xe0, 0xf8, 0xc4,
-	0xf0, 0x44, 0x3c, 0xc3, 0xa7, 0xe3, 0x71, 0xe0, 0x95, 0xd2, 0x35, 0xad, 0x91, 0xc5, 0x48, 0xd9,
-	0x64, 0x2a, 0x58, 0x5a, 0x1e, 0x64, 0x7f, 0xfc, 0xa9, 0x1a, 0xfb, 0xfe, 0xcf, 0x9a, 0x56, 0xff,
-	0x25, 0x0e, 0x99, 0x3e, 0x61, 0xcc, 0xa1, 0x2e, 0xfa, 0x10, 0x80, 0x85, 0x9f, 0x97, 0xe0, 0x33,
-	0xa7, 0xa0, 0xff, 0x13, 0xa3, 0x9f, 0x42, 0x46, 0xe4, 0xee, 0x90, 0x2b, 0x51, 0x1a, 0xf9, 0x20,
-	0x04, 0x49, 0xd7, 0x9c, 0x90, 0x52, 0x52, 0x72, 0x24, 0xbf, 0x51, 0x09, 0x32, 0x16, 0x75, 0x39,
-	0x79, 0xce, 0x25, 0x75, 0x05, 0x1c, 0x89, 0xe8, 0x23, 0x48, 0x99, 0x81, 0xed, 0xf0, 0x92, 0x55,
-	0xd3, 0x1a, 0xf9, 0xd6, 0xdb, 0xe7, 0x85, 0x6a, 0x0b, 0xd0, 0x23, 0x87, 0x8c, 0x6d, 0x86, 0x43,
-	0x8f, 0x25, 0xe6, 0xfe, 0x89, 0x43, 0x1a, 0x13, 0x8b, 0xfa, 0xf6, 0x3c, 0xba, 0xb6, 0x14, 0x7d,
-	0x95, 0xcc, 0xf8, 0xa5, 0xc9, 0xfc, 0x0c, 0x32, 0x9e, 0x4f, 0xe5, 0x64, 0x24, 0x64, 0x76, 0xd5,
-	0x73, 0x89, 0x08, 0x61, 0x73, 0x2a, 0x42, 0x11, 0xb5, 0x21, 0xed, 0xb8, 0x5e, 0xc0, 0xc3, 0xc9,
-	0xba, 0xa0, 0xba, 0x30, 0xf9, 0xae, 0xc0, 0x46, 0x13, 0x1a, 0x3a, 0xa2, 0x2d, 0xc8, 0xd0, 0x80,
-	0xcb, 0x33, 0x52, 0xf2, 0x8c, 0x77, 0x2e, 0x3e, 0x63, 0x57, 0x82, 0xa3, 0x44, 0x94, 0xeb, 0xda,
-	0xb1, 0x48, 0x5f, 0x6d, 0x2c, 0x96, 0xe8, 0xfe, 0x0e, 0x32, 0xaa, 0x60, 0x54, 0x86, 0x4c, 0xb4,
-	0x13, 0x92, 0xf1, 0xc7, 0x31, 0x1c, 0x29, 0xd0, 0x2d, 0x48, 0x8e, 0x4c, 0x36, 0x92, 0x84, 0x0b,
-	0x83, 0x94, 0xe6, 0x0d, 0x4a, 0x2c, 0x35, 0xe8, 0x36, 0xa4, 0x27, 0x84, 0x8f, 0xa8, 0xad, 0x86,
-	0x46, 0x49, 0x0f, 0x92, 0x22, 0x64, 0xa7, 0x00, 0xa0, 0x08, 0x35, 0x1c, 0xbb, 0xfe, 0x87, 0x06,
-	0xf9, 0x25, 0xba, 0xd6, 0x36, 0xbc, 0x05, 0x39, 0x5f, 0x42, 0x16, 0xfd, 0xbe, 0xb9, 0xa6, 0xc6,
-	0xc7, 0x31, 0x9c, 0x0d, 0x71, 0x5d, 0x7b, 0x9e, 0x6d, 0x62, 0x25, 0xdb, 0x37, 0x20, 0xc7, 0x67,
-	0x1e, 0x31, 0x96, 0x26, 0x3a, 0x2b, 0x14, 0x3b, 0x22, 0x4c, 0x1b, 0xd2, 0x8c, 0x9b, 0x3c, 0x08,
-	0xef, 0x83, 0xd7, 0x5a, 0xef, 0x5e, 0xa2, 0xbd, 0x7d, 0xe9, 0x80, 0x95, 0xa3, 0xaa, 0x30, 0x0b,
-	0x69, 0x46, 0x03, 0xdf, 0x22, 0xf5, 0xa7, 0x50, 0x58, 0xee, 0xa3, 0xa8, 0x4e, 0x66, 0xa5, 0xaa,
-	0x93, 0x39, 0x7d, 0x32, 0x0f, 0x1b, 0x97, 0x61, 0x2f, 0x98, 0x08, 0x16, 0x8c, 0xd7, 0x46, 0xac,
-	0x7f, 0x0b, 0x29, 0xb9, 0xbc, 0x62, 0x33, 0x57, 0x1a, 0xb8, 0x68, 0xdf, 0x7d, 0x48, 0xfa, 0x74,
-	0x4c, 0x54, 0x90, 0xb7, 0x2e, 0xbc, 0x03, 0xf6, 0x66, 0x1e, 0xc1, 0x12, 0x8e, 0xca, 0x90, 0xa5,
-	0x9e, 0x18, 0x19, 0x73, 0x2c, 0xb9, 0xcc, 0xe2, 0xb9, 0xac, 0x62, 0xff, 0x10, 0x87, 0xfc, 0xd2,
-	0x3a, 0xa3, 0xcf, 0xa1, 0x60, 0xf9, 0xc4, 0xe4, 0xc4, 0x36, 0x6c, 0x93, 0x87, 0x9d, 0xcc, 0xb7,
-	0xca, 0x7a, 0xf8, 0x50, 0xe9, 0xd1, 0x43, 0xa5, 0xef, 0x45, 0x2f, 0x59, 0x27, 0x2b, 0x86, 0xf6,
-	0xe8, 0xaf, 0xaa, 0x86, 0xf3, 0xca, 0x73, 0xcb, 0xe4, 0x04, 0xdd, 0x05, 0x88, 0x0e, 0x1a, 0xcc,
-	0xc2, 0xb1, 0xc3, 0x39, 0xa5, 0xe9, 0xcc, 0x44, 0x9c, 0xc0, 0xb3, 0x17, 0x71, 0x12, 0x57, 0x89,
-	0xa3, 0x3c, 0xa3, 0x38, 0xd1, 0x41, 0x83, 0x99, 0x9a, 0x8a, 0x9c, 0xd2, 0x74, 0x24, 0xa5, 0x53,
-	0xe2, 0x8b, 0x3b, 0x44, 0xce, 0xc5, 0x35, 0x1c, 0x89, 0xc2, 0x32, 0x21, 0x8c, 0x99, 0x43, 0x22,
-	0xb7, 0x2f, 0x87, 0x23, 0xb1, 0x7e, 0xa4, 0xc1, 0xb5, 0x1d, 0xc2, 0xdb, 0x8c, 0x11, 0xfe, 0x95,
-	0x78, 0x55, 0xd0, 0x7d, 0x48, 0x79, 0xbe, 0x63, 0x45, 0x74, 0xdc, 0xd1, 0xc3, 0xdf, 0x01, 0x5d,
-	0xfc, 0x0e, 0xe8, 0xea, 0x77, 0x40, 0x7f, 0x48, 0x1d, 0x57, 0xed, 0x7a, 0x88, 0x16, 0x0f, 0xd0,
-	0x3c, 0xb7, 0x31, 0xb5, 0x0e, 0x8c, 0x11, 0x71, 0x86, 0x23, 0x2e, 0xd9, 0x48, 0x62, 0x14, 0x65,
-	0x29, 0x4c, 0x8f, 0xa5, 0x45, 0x2c, 0xdf, 0x94, 0x8e, 0x03, 0xb5, 0x92, 0x49, 0xac, 0xa4, 0x8d,
-	0x9f, 0x35, 0xb8, 0x71, 0x66, 0x70, 0xd1, 0x3d, 0xa8, 0xe2, 0xed, 0x87, 0xbb, 0x78, 0xcb, 0xe8,
-	0xee, 0xf4, 0xf6, 0xf7, 0x8c, 0xfe, 0x5e, 0x7b, 0x6f, 0xbf, 0x6f, 0xec, 0xef, 0xf4, 0x7b, 0xdb,
-	0x0f, 0xbb, 0x8f, 0xba, 0xdb, 0x5b, 0xc5, 0x58, 0x39, 0x7f, 0x78, 0x5c, 0xcb, 0xec, 0xbb, 0x07,
-	0x2e, 0x7d, 0xe6, 0x22, 0x1d, 0xde, 0x5c, 0xe7, 0xd1, 0xc3, 0xbb, 0xbd, 0xdd, 0xfe, 0xf6, 0x56,
-	0x51, 0x2b, 0x17, 0x0e, 0x8f, 0x6b, 0xd9, 0x9e, 0x4f, 0x3d, 0xca, 0x88, 0x8d, 0x36, 0xa0, 0xbc,
-	0x0e, 0x1f, 0xea, 0x8a, 0xf1, 0x32, 0x1c, 0x1e, 0xd7, 0xd4, 0x6d, 0xbf, 0x11, 0x88, 0x75, 0x59,
-	0x0c, 0x39, 0xba, 0x0b, 0x77, 0xf0, 0x76, 0x7f, 0xff, 0xc9, 0xfa, 0xbc, 0xd0, 0x6d, 0x40, 0xab,
-	0xe6, 0x5e, 0xbb, 0xdf, 0x2f, 0x6a, 0x67, 0xf5, 0xfd, 0x2f, 0xba, 0xbd, 0x62, 0xfc, 0xac, 0xfe,
-	0x51, 0xbb, 0xfb, 0xa4, 0x98, 0xe8, 0x1c, 0xbc, 0x38, 0xa9, 0x68, 0x2f, 0x4f, 0x2a, 0xda, 0xdf,
-	0x27, 0x15, 0xed, 0xe8, 0xb4, 0x12, 0x7b, 0x79, 0x5a, 0x89, 0xfd, 0x76, 0x5a, 0x89, 0xc1, 0x1d,
-	0x87, 0x9e, 0xb3, 0x28, 0x3d, 0xed, 0xeb, 0x0f, 0x86, 0x0e, 0x1f, 0x05, 0x03, 0xdd, 0xa2, 0x93,
-	0xe6, 0x02, 0xf4, 0x9e, 0x43, 0x97, 0xa4, 0xe6, 0xf3, 0xc5, 0x3f, 0x97, 0xb8, 0x68, 0xd8, 0x20,
-	0x2d, 0x07, 0xf3, 0xfd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x50, 0x80, 0x91, 0x4c, 0x0a,
-	0x00, 0x00,
+	// 1318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x8e, 0x3f, 0x9e, 0x1d, 0xea, 0x4e, 0xa3, 0xd4, 0x71, 0x5b, 0xdb, 0x18, 0x0e,
+	0x21, 0xa8, 0xeb, 0x26, 0xb4, 0x48, 0x14, 0x10, 0xb2, 0x93, 0x94, 0x58, 0xb4, 0x89, 0xb5, 0x4e,
+	0x38, 0x70, 0x59, 0x8d, 0x77, 0xa7, 0xf6, 0xaa, 0xf6, 0xce, 0xb2, 0x33, 0xeb, 0xd6, 0x70, 0xe1,
+	0x88, 0x7a, 0x2a, 0x37, 0x2e, 0x95, 0xca, 0xff, 0x80, 0xc4, 0xbf, 0x50, 0x38, 0xf5, 0x88, 0xa0,
+	0x2a, 0xa8, 0xbd, 0x72, 0xe6, 0x8c, 0x66, 0x76, 0xd6, 0x1f, 0x8d, 0x13, 0xa5, 0x12, 0xb7, 0x7d,
+	0xdf, 0x6f, 0xde, 0xfc, 0xde, 0x6f, 0x16, 0x6a, 0x9e, 0x4f, 0x47, 0xc4, 0xc5, 0xae, 0x45, 0xea,
+	0x43, 0xc2, 0xb1, 0x8d, 0x39, 0xae, 0x8f, 0x36, 0xeb, 0xcc, 0xa2, 0x1e, 0xd1, 0x3d, 0x9f, 0x72,
+	0x8a, 0x56, 0xa7, 0x3e, 0x7a, 0xe4, 0xa3, 0x8f, 0x36, 0x4b, 0x65, 0x8b, 0xb2, 0x21, 0x65, 0xf5,
+	0x2e, 0x66, 0xa4, 0x3e, 0xda, 0xec, 0x12, 0x8e, 0x37, 0xeb, 0x16, 0x75, 0xdc, 0x30, 0xae, 0xb4,
+	0x16, 0xda, 0x4d, 0x29, 0xd5, 0x43, 0x41, 0x99, 0x56, 0x7a, 0xb4, 0x47, 0x43, 0xbd, 0xf8, 0x8a,
+	0x02, 0x7a, 0x94, 0xf6, 0x06, 0xa4, 0x2e, 0xa5, 0x6e, 0x70, 0xb7, 0x8e, 0xdd, 0xb1, 0x32, 0x55,
+	0x5e, 0x37, 0x71, 0x67, 0x48, 0x18, 0xc7, 0x43, 0x4f, 0x39, 0x54, 0x5f, 0x77, 0xb0, 0x09, 0xb3,
+	0x7c, 0xc7, 0xe3, 0xd4, 0x57, 0x1e, 0x1b, 0x27, 0x1d, 0xd5, 0x23, 0x96, 0x73, 0xd7, 0xb1, 0x30,
+	0x77, 0xa8, 0x6a, 0xbd, 0xf6, 0x6b, 0x1c, 0x96, 0x3a, 0x62, 0x04, 0x68, 0x0b, 0x32, 0x72, 0x16,
+	0xa6, 0x63, 0x17, 0xb5, 0xaa, 0xb6, 0x9e, 0x6f, 0x5e, 0x7c, 0xfa, 0xa2, 0x12, 0xfb, 0xe3, 0x45,
+	0xe5, 0xdc, 0x1d, 0x95, 0xa4, 0x61, 0xdb, 0x3e, 0x61, 0xcc, 0x48, 0x4b, 0xc7, 0x96, 0x8d, 0x9a,
+	0x50, 0x98, 0x4b, 0x2a, 0x62, 0xe3, 0xa7, 0xc7, 0x9e, 0x9b, 0x0b, 0x68, 0xd9, 0xe8, 0x63, 0x48,
+	0xd1, 0xfb, 0x2e, 0xf1, 0x59, 0x31, 0x51, 0x4d, 0xac, 0xe7, 0xb6, 0xae, 0xe8, 0x8b, 0x6f, 0x41,
+	0x6f, 0x63, 0x9f, 0x8f, 0x9b, 0x49, 0x91, 0xd8, 0x50, 0x21, 0xa8, 0x02, 0x39, 0x61, 0x36, 0xb1,
+	0x65, 0x11, 0xc6, 0x8a, 0xc9, 0x6a, 0x62, 0x3d, 0x6b, 0x80, 0xac, 0x27, 0x35, 0x48, 0x87, 0x0b,
+	0x23, 0x3c, 0x08, 0x88, 0x29, 0x03, 0x4c, 0x1c, 0x76, 0x51, 0x5c, 0xaa, 0x6a, 0xeb, 0x59, 0xe3,
+	0xbc, 0x34, 0x1d, 0x08, 0x8b, 0x6a, 0x0f, 0x5d, 0x83, 0x15, 0x9f, 0x7c, 0x1d, 0x38, 0x3e, 0x31,
+	0x3d, 0x51, 0xcf, 0xf4, 0xe9, 0x60, 0x10, 0x78, 0xc5, 0x54, 0x55, 0x5b, 0xcf, 0x18, 0x48, 0xd9,
+	0x64, 0x2b, 0x86, 0xb4, 0xdc, 0xcc, 0xfc, 0xf8, 0xa4, 0x12, 0xfb, 0xee, 0x79, 0x55, 0xab, 0xfd,
+	0x12, 0x87, 0x74, 0x87, 0x30, 0xe6, 0x50, 0x17, 0x7d, 0x08, 0xc0, 0xc2, 0xcf, 0x33, 0xcc, 0x33,
+	0xab, 0x5c, 0xff, 0xa7, 0x89, 0x7e, 0x0a, 0x69, 0xd1, 0xbb, 0x43, 0xde, 0x68, 0xa4, 0x51, 0x0c,
+	0x42, 0x90, 0x74, 0xf1, 0x90, 0x14, 0x93, 0x72, 0x46, 0xf2, 0x1b, 0x15, 0x21, 0x6d, 0x51, 0x97,
+	0x93, 0x07, 0x5c, 0x8e, 0x2e, 0x6f, 0x44, 0x22, 0xfa, 0x08, 0x96, 0x70, 0x60, 0x3b, 0xbc, 0x68,
+	0x55, 0xb5, 0xf5, 0xdc, 0xd6, 0x3b, 0x27, 0x95, 0x6a, 0x08, 0xa7, 0x5b, 0x0e, 0x19, 0xd8, 0xcc,
+	0x08, 0x23, 0x66, 0x26, 0xf7, 0x4f, 0x1c, 0x52, 0x06, 0xb1, 0xa8, 0x6f, 0x4f, 0xaa, 0x6b, 0x33,
+	0xd5, 0xe7, 0x87, 0x19, 0x3f, 0xf3, 0x30, 0x3f, 0x83, 0xb4, 0xe7, 0x53, 0x89, 0x8c, 0x84, 0xec,
+	0xae, 0x72, 0xe2, 0x20, 0x42, 0xb7, 0xc9, 0x28, 0x42, 0x11, 0x35, 0x20, 0xe5, 0xb8, 0x5e, 0xc0,
+	0x43, 0x64, 0x9d, 0x72, 0xba, 0xb0, 0xf9, 0x96, 0xf0, 0x8d, 0x10, 0x1a, 0x06, 0xa2, 0x1d, 0x48,
+	0xd3, 0x80, 0xcb, 0x1c, 0x4b, 0x32, 0xc7, 0xbb, 0xa7, 0xe7, 0x38, 0x08, 0xf8, 0x34, 0x49, 0x14,
+	0xba, 0x10, 0x16, 0xa9, 0x37, 0x83, 0xc5, 0xcc, 0xb8, 0x9f, 0x6b, 0x70, 0x2e, 0xac, 0x76, 0x48,
+	0x87, 0x5d, 0xc6, 0xa9, 0x4b, 0xd0, 0x75, 0xc8, 0xfa, 0x52, 0x75, 0x06, 0xbc, 0x66, 0x42, 0xcf,
+	0x96, 0x8d, 0x56, 0x21, 0xe5, 0x13, 0xcc, 0xa8, 0x2b, 0x6f, 0x25, 0x6b, 0x28, 0x49, 0xe0, 0x85,
+	0x39, 0xbd, 0xc9, 0x56, 0x67, 0x8d, 0x48, 0x44, 0x2d, 0x58, 0xe6, 0x51, 0x51, 0xdb, 0xc4, 0x5c,
+	0xc2, 0x2c, 0xb7, 0x55, 0xd2, 0x43, 0x5a, 0xd3, 0x23, 0x5a, 0xd3, 0x0f, 0x23, 0xde, 0x6b, 0x66,
+	0x44, 0x1f, 0x8f, 0xfe, 0xaa, 0x68, 0x46, 0x7e, 0x1a, 0xda, 0xe0, 0xa2, 0x78, 0x9f, 0x38, 0xbd,
+	0x7e, 0x88, 0xc9, 0x84, 0xa1, 0xa4, 0xda, 0xbf, 0x1a, 0x2c, 0xb7, 0x89, 0x6b, 0x3b, 0x6e, 0x6f,
+	0xbb, 0x8f, 0xdd, 0x1e, 0x41, 0x97, 0x20, 0x6b, 0xc9, 0xaf, 0xe8, 0x70, 0x49, 0x23, 0x13, 0x2a,
+	0x5a, 0xf6, 0x1c, 0xf1, 0xc5, 0xcf, 0x48, 0x7c, 0xdb, 0x90, 0x18, 0xb2, 0x9e, 0x42, 0xd5, 0xca,
+	0xb1, 0xde, 0x1b, 0xee, 0xb8, 0x79, 0xe9, 0xb7, 0x9f, 0xaf, 0x5e, 0x54, 0x6f, 0x81, 0x78, 0x38,
+	0x74, 0xf5, 0x70, 0xe8, 0x77, 0x58, 0xcf, 0x10, 0xd1, 0xe8, 0x32, 0x64, 0xb1, 0x27, 0xc0, 0x80,
+	0x07, 0x11, 0x75, 0x4d, 0x15, 0xe8, 0x7d, 0x38, 0x4f, 0x1e, 0x78, 0x8e, 0x1f, 0xde, 0xf7, 0xdc,
+	0x41, 0x0b, 0x53, 0xc3, 0x9e, 0xd4, 0xdf, 0x4c, 0x7e, 0xff, 0xa4, 0x12, 0xab, 0x7d, 0x0b, 0x69,
+	0x05, 0x64, 0x54, 0x82, 0x74, 0xc4, 0x75, 0x72, 0x93, 0xf6, 0x62, 0x46, 0xa4, 0x40, 0x2b, 0x90,
+	0xec, 0x63, 0xd6, 0x0f, 0xaf, 0x6c, 0x2f, 0x66, 0x48, 0x69, 0xb2, 0x78, 0x89, 0x99, 0xc5, 0x5b,
+	0x85, 0xd4, 0x90, 0xf0, 0x3e, 0xb5, 0x15, 0x19, 0x28, 0xe9, 0x66, 0x52, 0x40, 0xa9, 0x99, 0x07,
+	0x50, 0x8b, 0x62, 0x3a, 0x76, 0xed, 0x4f, 0x0d, 0x72, 0x33, 0x6b, 0xb0, 0x70, 0x91, 0xb7, 0x66,
+	0x41, 0x16, 0xce, 0xfa, 0xc2, 0x82, 0x39, 0xef, 0xc5, 0x66, 0x20, 0x16, 0x75, 0x9b, 0x98, 0xeb,
+	0xf6, 0x12, 0x64, 0xf9, 0xd8, 0x23, 0xe6, 0x0c, 0x53, 0x65, 0x84, 0x62, 0x5f, 0x94, 0x69, 0x40,
+	0x8a, 0x71, 0xcc, 0x83, 0x90, 0xe7, 0xdf, 0xda, 0x7a, 0xef, 0x0c, 0x6b, 0xdb, 0x91, 0x01, 0x86,
+	0x0a, 0x54, 0x27, 0xcc, 0x40, 0x8a, 0xd1, 0xc0, 0xb7, 0x48, 0xed, 0x2e, 0xe4, 0x67, 0xf7, 0x53,
+	0x9c, 0x4e, 0x76, 0xa5, 0x4e, 0x27, 0x7b, 0xfa, 0x64, 0x52, 0x36, 0x2e, 0xcb, 0x9e, 0xb2, 0xe9,
+	0x2c, 0x18, 0x2c, 0xac, 0x58, 0xfb, 0x06, 0x96, 0x24, 0x29, 0x8b, 0x0d, 0x9a, 0xbb, 0xc0, 0xe9,
+	0xf5, 0xdd, 0x80, 0xa4, 0x4f, 0x07, 0x44, 0x15, 0x79, 0xfb, 0x54, 0x6e, 0x3f, 0x1c, 0x7b, 0xc4,
+	0x90, 0xee, 0xa8, 0x04, 0x19, 0xea, 0x09, 0xc8, 0xe0, 0x81, 0x9c, 0x65, 0xc6, 0x98, 0xc8, 0xaa,
+	0xf6, 0x0f, 0x71, 0xc8, 0xcd, 0xd0, 0x34, 0xfa, 0x1c, 0xf2, 0x96, 0x4f, 0x30, 0x27, 0xb6, 0x69,
+	0x63, 0x1e, 0xde, 0xe4, 0x59, 0x37, 0x35, 0xa7, 0x22, 0x77, 0x30, 0x27, 0xe8, 0x0a, 0x40, 0x94,
+	0xa8, 0x3b, 0x56, 0x4c, 0x91, 0x55, 0x9a, 0xe6, 0x58, 0xd4, 0x09, 0x3c, 0x7b, 0x5a, 0x27, 0xf1,
+	0x26, 0x75, 0x54, 0x64, 0x54, 0x27, 0x4a, 0xd4, 0x1d, 0x2b, 0x54, 0x64, 0x95, 0xa6, 0x29, 0x47,
+	0x3a, 0x22, 0xbe, 0x78, 0x1b, 0x24, 0x2e, 0x96, 0x8d, 0x48, 0x14, 0x96, 0x21, 0x61, 0x0c, 0xf7,
+	0x88, 0x64, 0xd5, 0xac, 0x11, 0x89, 0xb5, 0x47, 0x1a, 0x2c, 0xef, 0x13, 0xde, 0x60, 0x8c, 0xf0,
+	0x2f, 0xc5, 0xdf, 0x02, 0xba, 0x01, 0x4b, 0x9e, 0xef, 0x58, 0xd1, 0x38, 0xd6, 0xf4, 0x45, 0x3b,
+	0xbe, 0x4d, 0x1d, 0x57, 0x71, 0x78, 0xe8, 0x2d, 0x7e, 0x2c, 0x26, 0xbd, 0x0d, 0xa8, 0x75, 0x2f,
+	0xda, 0xe8, 0xb8, 0x64, 0x23, 0x14, 0x75, 0x29, 0x4c, 0xe1, 0x4e, 0x8b, 0xe5, 0x1b, 0xd1, 0x41,
+	0xa0, 0x56, 0x32, 0x69, 0x28, 0x69, 0xe3, 0x27, 0x0d, 0xce, 0x1f, 0x03, 0x2e, 0xba, 0x06, 0x15,
+	0x63, 0x77, 0xfb, 0xc0, 0xd8, 0x31, 0x5b, 0xfb, 0xed, 0xa3, 0x43, 0xb3, 0x73, 0xd8, 0x38, 0x3c,
+	0xea, 0x98, 0x47, 0xfb, 0x9d, 0xf6, 0xee, 0x76, 0xeb, 0x56, 0x6b, 0x77, 0xa7, 0x10, 0x2b, 0xe5,
+	0x1e, 0x3e, 0xae, 0xa6, 0x8f, 0xdc, 0x7b, 0x2e, 0xbd, 0xef, 0x22, 0x1d, 0x2e, 0x2f, 0x8a, 0x68,
+	0x1b, 0x07, 0xed, 0x83, 0xce, 0xee, 0x4e, 0x41, 0x2b, 0xe5, 0x1f, 0x3e, 0xae, 0x66, 0xda, 0x3e,
+	0xf5, 0x28, 0x23, 0x36, 0xda, 0x80, 0xd2, 0x22, 0xff, 0x50, 0x57, 0x88, 0x97, 0xe0, 0xe1, 0xe3,
+	0xaa, 0x7a, 0xc5, 0x37, 0x02, 0xc8, 0xcf, 0x82, 0x1c, 0x5d, 0x81, 0x35, 0x63, 0xb7, 0x73, 0x74,
+	0x7b, 0x71, 0x5f, 0x68, 0x15, 0xd0, 0xbc, 0xb9, 0xdd, 0xe8, 0x74, 0x0a, 0xda, 0x71, 0x7d, 0xe7,
+	0x8b, 0x56, 0xbb, 0x10, 0x3f, 0xae, 0xbf, 0xd5, 0x68, 0xdd, 0x2e, 0x24, 0x9a, 0xf7, 0x9e, 0xbe,
+	0x2c, 0x6b, 0xcf, 0x5e, 0x96, 0xb5, 0xbf, 0x5f, 0x96, 0xb5, 0x47, 0xaf, 0xca, 0xb1, 0x67, 0xaf,
+	0xca, 0xb1, 0xdf, 0x5f, 0x95, 0x63, 0xb0, 0xe6, 0xd0, 0x13, 0x16, 0xa5, 0xad, 0x7d, 0x75, 0xbd,
+	0xe7, 0xf0, 0x7e, 0xd0, 0xd5, 0x2d, 0x3a, 0xac, 0x4f, 0x9d, 0xae, 0x3a, 0x74, 0x46, 0xaa, 0x3f,
+	0x98, 0xfe, 0x4b, 0x0b, 0xa2, 0x61, 0xdd, 0x94, 0x04, 0xe6, 0x07, 0xff, 0x0d, 0x00, 0x75, 0x9b,
+	0xc1, 0x93, 0x5a, 0x0c, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -1180,6 +1319,132 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecordTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TombstonedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TombstonedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintScope(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScope(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ChangeId != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Process) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1457,12 +1722,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedDate):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintScope(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintScope(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.CreatedBy) > 0 {
@@ -1472,12 +1737,12 @@ func (m *AuditFields) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedDate):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintScope(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintScope(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1631,6 +1896,59 @@ func (m *Record) Size() (n int) {
 	return n
 }
 
+func (m *RecordTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TombstonedAt)
+	n += 1 + l + sovScope(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovScope(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		n += 1 + sovScope(uint64(m.ChangeId))
+	}
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovScope(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovScope(uint64(m.ExpirationHeight))
+	}
+	return n
+}
+
 func (m *Process) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2608,6 +2926,394 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecordTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstonedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TombstonedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Process) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
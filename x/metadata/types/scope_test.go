@@ -1967,3 +1967,35 @@ func TestNetAssetValueValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordRedacted(t *testing.T) {
+	scopeUUID := uuid.New()
+	sessionID := SessionMetadataAddress(scopeUUID, uuid.New())
+	otherRecordID := RecordMetadataAddress(scopeUUID, "other")
+	record := NewRecord(
+		"name",
+		sessionID,
+		*NewProcess("process", &Process_Hash{Hash: "processhash"}, "method"),
+		[]RecordInput{
+			*NewRecordInput("hashed", &RecordInput_Hash{Hash: "inputhash"}, "type", RecordInputStatus_Proposed),
+			*NewRecordInput("recorded", &RecordInput_RecordId{RecordId: otherRecordID}, "type", RecordInputStatus_Record),
+		},
+		[]RecordOutput{*NewRecordOutput("outputhash", ResultStatus_RESULT_STATUS_PASS)},
+		RecordSpecMetadataAddress(uuid.New(), "name"),
+	)
+	orig := *record
+	orig.Inputs = append([]RecordInput{}, record.Inputs...)
+	orig.Outputs = append([]RecordOutput{}, record.Outputs...)
+
+	expected := orig
+	expected.Inputs = []RecordInput{
+		*NewRecordInput("hashed", &RecordInput_Hash{Hash: RedactedHash}, "type", RecordInputStatus_Proposed),
+		*NewRecordInput("recorded", &RecordInput_RecordId{RecordId: otherRecordID}, "type", RecordInputStatus_Record),
+	}
+	expected.Outputs = []RecordOutput{*NewRecordOutput(RedactedHash, ResultStatus_RESULT_STATUS_PASS)}
+
+	actual := record.Redacted()
+	assert.Equal(t, expected, actual, "Redacted()")
+	assert.Equal(t, orig, *record, "record after Redacted()")
+	assert.NoError(t, actual.ValidateBasic(), "ValidateBasic() of redacted record")
+}
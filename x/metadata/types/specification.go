@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -172,6 +173,51 @@ func (s ContractSpecification) ValidateBasic() error {
 		return fmt.Errorf("class name exceeds maximum length (expected <= %d got: %d)",
 			maxContractSpecificationClassNameLength, len(s.ClassName))
 	}
+	seen := make(map[string]bool, len(s.RecordWriteRoles))
+	for i, wr := range s.RecordWriteRoles {
+		if err = wr.ValidateBasic(s.PartiesInvolved); err != nil {
+			return fmt.Errorf("invalid record write roles at index %d: %w", i, err)
+		}
+		if seen[wr.RecordName] {
+			return fmt.Errorf("duplicate record write roles for record name %q", wr.RecordName)
+		}
+		seen[wr.RecordName] = true
+	}
+	return nil
+}
+
+// WriteRolesForRecord returns the roles allowed to write the record with the provided name,
+// and whether this contract specification restricts that record's writers.
+func (s ContractSpecification) WriteRolesForRecord(recordName string) ([]PartyType, bool) {
+	for _, wr := range s.RecordWriteRoles {
+		if wr.RecordName == recordName {
+			return wr.Roles, true
+		}
+	}
+	return nil, false
+}
+
+// ValidateBasic performs basic format checking of data in a RecordWriteRoles.
+// All roles must be one of the provided partiesInvolved.
+func (r RecordWriteRoles) ValidateBasic(partiesInvolved []PartyType) error {
+	if len(r.RecordName) == 0 {
+		return errors.New("record name cannot be empty")
+	}
+	if len(r.RecordName) > maxRecordSpecificationNameLength {
+		return fmt.Errorf("record name exceeds maximum length (expected <= %d got: %d)",
+			maxRecordSpecificationNameLength, len(r.RecordName))
+	}
+	if len(r.Roles) == 0 {
+		return fmt.Errorf("no roles provided for record name %q", r.RecordName)
+	}
+	for _, role := range r.Roles {
+		if role == PartyType_PARTY_TYPE_UNSPECIFIED || !role.IsValid() {
+			return fmt.Errorf("invalid role %s for record name %q", role, r.RecordName)
+		}
+		if !slices.Contains(partiesInvolved, role) {
+			return fmt.Errorf("role %s for record name %q is not one of the parties involved", role.SimpleString(), r.RecordName)
+		}
+	}
 	return nil
 }
 
@@ -208,12 +208,14 @@ type ContractSpecification struct {
 	// contract
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*ContractSpecification_ResourceId
 	//	*ContractSpecification_Hash
 	Source isContractSpecification_Source `protobuf_oneof:"source"`
 	// name of the class/type of this contract executable
 	ClassName string `protobuf:"bytes,7,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	// the party roles allowed to write (or update) each of this contract's records.
+	// Records without an entry here use the standard signer requirements.
+	RecordWriteRoles []RecordWriteRoles `protobuf:"bytes,8,rep,name=record_write_roles,json=recordWriteRoles,proto3" json:"record_write_roles"`
 }
 
 func (m *ContractSpecification) Reset()      { *m = ContractSpecification{} }
@@ -313,6 +315,13 @@ func (m *ContractSpecification) GetClassName() string {
 	return ""
 }
 
+func (m *ContractSpecification) GetRecordWriteRoles() []RecordWriteRoles {
+	if m != nil {
+		return m.RecordWriteRoles
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -321,6 +330,61 @@ func (*ContractSpecification) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// RecordWriteRoles defines the party roles that are allowed to write a record of a contract specification.
+type RecordWriteRoles struct {
+	// the name of the record specification these roles apply to
+	RecordName string `protobuf:"bytes,1,opt,name=record_name,json=recordName,proto3" json:"record_name,omitempty"`
+	// the party roles allowed to write the record; a signer only needs one of these roles
+	Roles []PartyType `protobuf:"varint,2,rep,packed,name=roles,proto3,enum=provenance.metadata.v1.PartyType" json:"roles,omitempty"`
+}
+
+func (m *RecordWriteRoles) Reset()         { *m = RecordWriteRoles{} }
+func (m *RecordWriteRoles) String() string { return proto.CompactTextString(m) }
+func (*RecordWriteRoles) ProtoMessage()    {}
+func (*RecordWriteRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e2d1042057ea889, []int{2}
+}
+func (m *RecordWriteRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordWriteRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordWriteRoles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordWriteRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordWriteRoles.Merge(m, src)
+}
+func (m *RecordWriteRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordWriteRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordWriteRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordWriteRoles proto.InternalMessageInfo
+
+func (m *RecordWriteRoles) GetRecordName() string {
+	if m != nil {
+		return m.RecordName
+	}
+	return ""
+}
+
+func (m *RecordWriteRoles) GetRoles() []PartyType {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 // RecordSpecification defines the specification for a Record including allowed/required inputs/outputs
 type RecordSpecification struct {
 	// unique identifier for this specification on chain
@@ -340,7 +404,7 @@ type RecordSpecification struct {
 func (m *RecordSpecification) Reset()      { *m = RecordSpecification{} }
 func (*RecordSpecification) ProtoMessage() {}
 func (*RecordSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{2}
+	return fileDescriptor_1e2d1042057ea889, []int{3}
 }
 func (m *RecordSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +478,6 @@ type InputSpecification struct {
 	// source is either on chain (record_id) or off-chain (hash)
 	//
 	// Types that are valid to be assigned to Source:
-	//
 	//	*InputSpecification_RecordId
 	//	*InputSpecification_Hash
 	Source isInputSpecification_Source `protobuf_oneof:"source"`
@@ -423,7 +486,7 @@ type InputSpecification struct {
 func (m *InputSpecification) Reset()      { *m = InputSpecification{} }
 func (*InputSpecification) ProtoMessage() {}
 func (*InputSpecification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{3}
+	return fileDescriptor_1e2d1042057ea889, []int{4}
 }
 func (m *InputSpecification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +583,7 @@ func (m *Description) Reset()         { *m = Description{} }
 func (m *Description) String() string { return proto.CompactTextString(m) }
 func (*Description) ProtoMessage()    {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e2d1042057ea889, []int{4}
+	return fileDescriptor_1e2d1042057ea889, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,6 +645,7 @@ func init() {
 	proto.RegisterEnum("provenance.metadata.v1.PartyType", PartyType_name, PartyType_value)
 	proto.RegisterType((*ScopeSpecification)(nil), "provenance.metadata.v1.ScopeSpecification")
 	proto.RegisterType((*ContractSpecification)(nil), "provenance.metadata.v1.ContractSpecification")
+	proto.RegisterType((*RecordWriteRoles)(nil), "provenance.metadata.v1.RecordWriteRoles")
 	proto.RegisterType((*RecordSpecification)(nil), "provenance.metadata.v1.RecordSpecification")
 	proto.RegisterType((*InputSpecification)(nil), "provenance.metadata.v1.InputSpecification")
 	proto.RegisterType((*Description)(nil), "provenance.metadata.v1.Description")
@@ -592,63 +656,67 @@ func init() {
 }
 
 var fileDescriptor_1e2d1042057ea889 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0x4d, 0x5e, 0x50, 0x6b, 0xa6, 0xdd, 0xae, 0xbb, 0x0b, 0x49, 0x28, 0x12,
-	0x44, 0x95, 0x9a, 0xa8, 0x81, 0x13, 0x37, 0x27, 0x71, 0xb7, 0x23, 0x65, 0xed, 0x68, 0xe2, 0x14,
-	0x2d, 0x17, 0xcb, 0xb5, 0x67, 0x5b, 0x6b, 0x13, 0x8f, 0xe5, 0x71, 0xb2, 0xf4, 0x04, 0x3f, 0x80,
-	0x03, 0x47, 0x8e, 0x48, 0x48, 0xfc, 0x06, 0x7e, 0xc2, 0x1e, 0xf7, 0x88, 0x10, 0xaa, 0x50, 0x2b,
-	0x7e, 0x02, 0x17, 0x4e, 0xc8, 0x63, 0xb7, 0x71, 0x42, 0x8a, 0x38, 0x70, 0xe4, 0xd4, 0x99, 0xf7,
-	0x7d, 0xef, 0xcd, 0x7b, 0xdf, 0x37, 0xe3, 0x06, 0x0e, 0x83, 0x90, 0xcd, 0xa9, 0x6f, 0xfb, 0x0e,
-	0x6d, 0x4f, 0x69, 0x64, 0xbb, 0x76, 0x64, 0xb7, 0xe7, 0xc7, 0x6d, 0x1e, 0x50, 0xc7, 0x7b, 0xe9,
-	0x39, 0x76, 0xe4, 0x31, 0xbf, 0x15, 0x84, 0x2c, 0x62, 0x68, 0x6f, 0xc1, 0x6d, 0xdd, 0x71, 0x5b,
-	0xf3, 0xe3, 0x27, 0xbb, 0x17, 0xec, 0x82, 0x09, 0x4a, 0x3b, 0x5e, 0x25, 0xec, 0x83, 0xdf, 0xf3,
-	0x80, 0x46, 0x0e, 0x0b, 0xe8, 0x28, 0x5b, 0x0a, 0x75, 0x41, 0x5e, 0xaa, 0x6d, 0x79, 0xae, 0x22,
-	0x35, 0xa4, 0xe6, 0x3b, 0xdd, 0xc7, 0x6f, 0xae, 0xeb, 0xb9, 0x5f, 0xae, 0xeb, 0xdb, 0xcf, 0xd3,
-	0xda, 0xaa, 0xeb, 0x86, 0x94, 0x73, 0xb2, 0xbd, 0x94, 0x80, 0x5d, 0xa4, 0x41, 0xd5, 0xa5, 0xdc,
-	0x09, 0xbd, 0x20, 0x0e, 0x28, 0xf9, 0x86, 0xd4, 0xac, 0x76, 0x3e, 0x6c, 0xad, 0x6f, 0xaf, 0xd5,
-	0x5f, 0x50, 0x49, 0x36, 0x0f, 0x7d, 0x0c, 0xdb, 0xec, 0xb5, 0x4f, 0x43, 0xcb, 0x4e, 0x0e, 0xa2,
-	0x5c, 0x29, 0x34, 0x0a, 0xcd, 0x0a, 0xd9, 0x12, 0x61, 0xf5, 0x2e, 0x8a, 0x06, 0x20, 0x07, 0x76,
-	0x18, 0x79, 0x94, 0x5b, 0x9e, 0x3f, 0x67, 0x93, 0x39, 0x75, 0x95, 0x62, 0xa3, 0xd0, 0xdc, 0xea,
-	0x7c, 0xf0, 0xd0, 0xa1, 0x43, 0x3b, 0x8c, 0xae, 0xcc, 0xab, 0x80, 0x92, 0xed, 0x34, 0x15, 0xa7,
-	0x99, 0xa8, 0x07, 0xef, 0x3a, 0xcc, 0x8f, 0x42, 0xdb, 0x89, 0xac, 0x78, 0x32, 0xcb, 0x73, 0xb9,
-	0xb2, 0xd1, 0x28, 0xfc, 0xa3, 0x04, 0x77, 0x19, 0xb1, 0x98, 0xd8, 0xe5, 0x9f, 0x95, 0xbf, 0xfb,
-	0xbe, 0x9e, 0xfb, 0xfa, 0xd7, 0x86, 0x74, 0xf0, 0x53, 0x01, 0x1e, 0xf5, 0x32, 0xe8, 0xff, 0x52,
-	0x2f, 0xa4, 0x36, 0xa1, 0x1a, 0x52, 0xce, 0x66, 0xa1, 0x43, 0xe3, 0xe1, 0x37, 0xc4, 0xf0, 0xc7,
-	0x7f, 0x5e, 0xd7, 0x8f, 0x2e, 0xbc, 0xe8, 0x72, 0x76, 0xde, 0x72, 0xd8, 0xb4, 0xed, 0x30, 0x3e,
-	0x65, 0x3c, 0xfd, 0x73, 0xc4, 0xdd, 0x57, 0xed, 0xe8, 0x2a, 0xa0, 0xbc, 0xa5, 0x3a, 0x4e, 0xda,
-	0xd7, 0x69, 0x8e, 0xc0, 0x5d, 0x1d, 0xec, 0xa2, 0x5d, 0x28, 0x5e, 0xda, 0xfc, 0x52, 0x29, 0x35,
-	0xa4, 0x66, 0xe5, 0x34, 0x47, 0xc4, 0x0e, 0xbd, 0x0f, 0xe0, 0x4c, 0x6c, 0xce, 0x2d, 0xdf, 0x9e,
-	0x52, 0x65, 0x33, 0xc6, 0x48, 0x45, 0x44, 0x74, 0x7b, 0x4a, 0x17, 0x86, 0x75, 0xcb, 0x50, 0x4a,
-	0x4a, 0x1d, 0xfc, 0x91, 0x87, 0x1d, 0x42, 0x1d, 0x16, 0xba, 0xff, 0xbd, 0x71, 0x08, 0x8a, 0xa2,
-	0x91, 0xbc, 0x68, 0x44, 0xac, 0x51, 0x17, 0x4a, 0x9e, 0x1f, 0xcc, 0xa2, 0x44, 0xfc, 0x6a, 0xe7,
-	0xf0, 0x21, 0x49, 0x71, 0xcc, 0x5a, 0xea, 0x89, 0xa4, 0x99, 0xe8, 0x29, 0x54, 0x62, 0x79, 0x92,
-	0x29, 0x8b, 0xa2, 0x78, 0x39, 0x0e, 0xc4, 0x43, 0xa2, 0x67, 0x42, 0xef, 0xd9, 0x24, 0xb2, 0xe2,
-	0x90, 0xd0, 0x7b, 0xab, 0xf3, 0xd1, 0xc3, 0xb7, 0xe5, 0xa5, 0xe7, 0x7b, 0x71, 0x75, 0xe1, 0x1e,
-	0x24, 0xa9, 0xf1, 0x1a, 0x11, 0xd8, 0x09, 0x29, 0x0f, 0x98, 0xcf, 0xbd, 0xf3, 0x09, 0xb5, 0x52,
-	0x5f, 0x95, 0xd2, 0xbf, 0xbd, 0x09, 0x28, 0x93, 0x3d, 0x4c, 0x92, 0x33, 0x4f, 0xe6, 0x07, 0x09,
-	0xd0, 0xdf, 0x47, 0xbc, 0x97, 0x4c, 0xca, 0x48, 0xb6, 0x34, 0x6e, 0x7e, 0x65, 0xdc, 0x0e, 0x54,
-	0x42, 0x61, 0x5f, 0x6c, 0x50, 0x41, 0x18, 0xb4, 0xb3, 0xc6, 0x9c, 0xd3, 0x1c, 0x29, 0x27, 0xbc,
-	0xcc, 0xe5, 0x29, 0x66, 0x2f, 0xcf, 0xda, 0xdb, 0xf1, 0x15, 0x54, 0x33, 0xef, 0x69, 0x6d, 0x77,
-	0x8d, 0xe5, 0xd7, 0x59, 0x10, 0xd0, 0xd2, 0xc3, 0xab, 0x43, 0xf5, 0x35, 0x3d, 0xe7, 0x5e, 0x44,
-	0xad, 0x59, 0x38, 0x49, 0x0d, 0x83, 0x34, 0x34, 0x0e, 0x27, 0x68, 0x1f, 0xca, 0x9e, 0xc3, 0x7c,
-	0x81, 0x6e, 0x08, 0x74, 0x33, 0xde, 0x8f, 0xc3, 0xc9, 0xe1, 0x37, 0x12, 0x6c, 0x2d, 0x7b, 0x84,
-	0xea, 0xf0, 0xb4, 0xaf, 0x9d, 0x60, 0x1d, 0x9b, 0xd8, 0xd0, 0x2d, 0xf3, 0xc5, 0x50, 0xb3, 0xc6,
-	0xfa, 0x68, 0xa8, 0xf5, 0xf0, 0x09, 0xd6, 0xfa, 0x72, 0x0e, 0xbd, 0x07, 0xca, 0x2a, 0x61, 0x48,
-	0x8c, 0xa1, 0x31, 0xd2, 0xfa, 0xb2, 0x84, 0x9e, 0xc0, 0xde, 0x2a, 0x4a, 0xb4, 0x9e, 0x41, 0xfa,
-	0x72, 0x7e, 0x5d, 0xe9, 0x04, 0xb3, 0x06, 0x78, 0x64, 0xca, 0x85, 0xc3, 0x1f, 0xf3, 0x50, 0xb9,
-	0x77, 0x38, 0x2e, 0x35, 0x54, 0x89, 0xf9, 0x62, 0x5d, 0x13, 0xfb, 0xf0, 0x28, 0x83, 0x19, 0x04,
-	0x3f, 0xc3, 0xba, 0x6a, 0x1a, 0x44, 0x96, 0xd0, 0x63, 0xd8, 0xc9, 0x40, 0x23, 0x8d, 0x9c, 0xe1,
-	0x9e, 0x46, 0xe4, 0xfc, 0x0a, 0x80, 0xf5, 0x33, 0x6d, 0x14, 0x67, 0x14, 0x90, 0x02, 0xbb, 0x19,
-	0xa0, 0x37, 0x1e, 0x99, 0x46, 0x1f, 0xab, 0xba, 0x5c, 0x44, 0xbb, 0x20, 0x67, 0x8f, 0xf9, 0x5c,
-	0xd7, 0x88, 0xbc, 0xb1, 0xc2, 0x57, 0x4f, 0x4e, 0xf0, 0x00, 0xab, 0xa6, 0x26, 0x97, 0xd0, 0x1e,
-	0xa0, 0x2c, 0xff, 0xb9, 0x8e, 0xbb, 0xe3, 0x91, 0xbc, 0xb9, 0xd2, 0xee, 0x90, 0x18, 0x67, 0x9a,
-	0xae, 0xea, 0x3d, 0x4d, 0x2e, 0xaf, 0x40, 0x3d, 0x43, 0x37, 0x89, 0x31, 0x18, 0x68, 0x44, 0x86,
-	0x95, 0x73, 0xce, 0xd4, 0x01, 0xee, 0x8b, 0x19, 0xab, 0xdd, 0x57, 0x6f, 0x6e, 0x6a, 0xd2, 0xdb,
-	0x9b, 0x9a, 0xf4, 0xdb, 0x4d, 0x4d, 0xfa, 0xf6, 0xb6, 0x96, 0x7b, 0x7b, 0x5b, 0xcb, 0xfd, 0x7c,
-	0x5b, 0xcb, 0xc1, 0xbe, 0xc7, 0x1e, 0x78, 0x3b, 0x43, 0xe9, 0x8b, 0x4f, 0x33, 0xdf, 0xc4, 0x05,
-	0xe9, 0xc8, 0x63, 0x99, 0x5d, 0xfb, 0xcb, 0xc5, 0xaf, 0x04, 0xf1, 0x95, 0x3c, 0x2f, 0x89, 0xff,
-	0xf6, 0x9f, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xe1, 0xcd, 0x6d, 0x49, 0x08, 0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0x34, 0x4d, 0x5e, 0x50, 0x6b, 0xa6, 0xdd, 0xae, 0xbb, 0x0b, 0x49, 0x28, 0x12,
+	0x44, 0x95, 0x9a, 0xa8, 0x01, 0x09, 0x89, 0x5b, 0xfe, 0xb8, 0xdb, 0x91, 0xb2, 0x4e, 0x34, 0x71,
+	0xbb, 0x5a, 0x84, 0x64, 0xb9, 0xf6, 0x6c, 0x6b, 0x6d, 0xea, 0xb1, 0x3c, 0x6e, 0x4b, 0x4f, 0xf0,
+	0x01, 0x38, 0x70, 0x42, 0x1c, 0x91, 0x90, 0xf8, 0x2c, 0x7b, 0xdc, 0x23, 0x42, 0xa8, 0x42, 0xad,
+	0xf8, 0x08, 0x5c, 0x38, 0xa1, 0x19, 0xbb, 0x1b, 0xc7, 0x24, 0x68, 0x0f, 0x1c, 0x39, 0x79, 0xe6,
+	0xbd, 0xdf, 0xef, 0xcd, 0xef, 0xfd, 0x99, 0x91, 0x61, 0x37, 0x08, 0xd9, 0x25, 0xf5, 0x6d, 0xdf,
+	0xa1, 0xed, 0x73, 0x1a, 0xd9, 0xae, 0x1d, 0xd9, 0xed, 0xcb, 0xfd, 0x36, 0x0f, 0xa8, 0xe3, 0xbd,
+	0xf0, 0x1c, 0x3b, 0xf2, 0x98, 0xdf, 0x0a, 0x42, 0x16, 0x31, 0xb4, 0x35, 0xc3, 0xb6, 0xee, 0xb1,
+	0xad, 0xcb, 0xfd, 0x47, 0x9b, 0xa7, 0xec, 0x94, 0x49, 0x48, 0x5b, 0xac, 0x62, 0xf4, 0xce, 0x1f,
+	0x79, 0x40, 0x13, 0x87, 0x05, 0x74, 0x92, 0x0e, 0x85, 0x7a, 0xa0, 0xce, 0xc5, 0xb6, 0x3c, 0x57,
+	0x53, 0x1a, 0x4a, 0xf3, 0x9d, 0xde, 0xc3, 0x57, 0x37, 0xf5, 0xdc, 0xaf, 0x37, 0xf5, 0xf5, 0xa7,
+	0x49, 0xec, 0xae, 0xeb, 0x86, 0x94, 0x73, 0xb2, 0x3e, 0x47, 0xc0, 0x2e, 0xd2, 0xa1, 0xea, 0x52,
+	0xee, 0x84, 0x5e, 0x20, 0x0c, 0x5a, 0xbe, 0xa1, 0x34, 0xab, 0x9d, 0x0f, 0x5b, 0x8b, 0xe5, 0xb5,
+	0x06, 0x33, 0x28, 0x49, 0xf3, 0xd0, 0xc7, 0xb0, 0xce, 0xae, 0x7c, 0x1a, 0x5a, 0x76, 0x7c, 0x10,
+	0xe5, 0x5a, 0xa1, 0x51, 0x68, 0x56, 0xc8, 0x9a, 0x34, 0x77, 0xef, 0xad, 0x68, 0x08, 0x6a, 0x60,
+	0x87, 0x91, 0x47, 0xb9, 0xe5, 0xf9, 0x97, 0x6c, 0x7a, 0x49, 0x5d, 0xad, 0xd8, 0x28, 0x34, 0xd7,
+	0x3a, 0x1f, 0x2c, 0x3b, 0x74, 0x6c, 0x87, 0xd1, 0xb5, 0x79, 0x1d, 0x50, 0xb2, 0x9e, 0x50, 0x71,
+	0xc2, 0x44, 0x7d, 0x78, 0xd7, 0x61, 0x7e, 0x14, 0xda, 0x4e, 0x64, 0x89, 0xcc, 0x2c, 0xcf, 0xe5,
+	0xda, 0x4a, 0xa3, 0xf0, 0xaf, 0x25, 0xb8, 0x67, 0x88, 0x62, 0x62, 0x97, 0x7f, 0x5e, 0xfe, 0xe1,
+	0xc7, 0x7a, 0xee, 0x9b, 0xdf, 0x1a, 0xca, 0xce, 0xf7, 0x45, 0x78, 0xd0, 0x4f, 0x79, 0xff, 0x2f,
+	0xf5, 0xac, 0xd4, 0x26, 0x54, 0x43, 0xca, 0xd9, 0x45, 0xe8, 0x50, 0x91, 0xfc, 0x8a, 0x4c, 0x7e,
+	0xff, 0xaf, 0x9b, 0xfa, 0xde, 0xa9, 0x17, 0x9d, 0x5d, 0x9c, 0xb4, 0x1c, 0x76, 0xde, 0x76, 0x18,
+	0x3f, 0x67, 0x3c, 0xf9, 0xec, 0x71, 0xf7, 0x65, 0x3b, 0xba, 0x0e, 0x28, 0x6f, 0x75, 0x1d, 0x27,
+	0xd1, 0x75, 0x98, 0x23, 0x70, 0x1f, 0x07, 0xbb, 0x68, 0x13, 0x8a, 0x67, 0x36, 0x3f, 0xd3, 0x4a,
+	0x0d, 0xa5, 0x59, 0x39, 0xcc, 0x11, 0xb9, 0x43, 0xef, 0x03, 0x38, 0x53, 0x9b, 0x73, 0xcb, 0xb7,
+	0xcf, 0xa9, 0xb6, 0x2a, 0x7c, 0xa4, 0x22, 0x2d, 0x86, 0x7d, 0x4e, 0xd1, 0x97, 0x80, 0x42, 0xea,
+	0xb0, 0xd0, 0xb5, 0xae, 0x42, 0x2f, 0xa2, 0x56, 0xc8, 0xa6, 0x94, 0x6b, 0xe5, 0x46, 0xa1, 0x59,
+	0xed, 0x34, 0x97, 0xa5, 0x46, 0x24, 0xe3, 0x99, 0x20, 0x10, 0x81, 0xef, 0x15, 0x45, 0xe3, 0x88,
+	0x1a, 0x66, 0xec, 0xb3, 0x71, 0xe8, 0x95, 0xa1, 0x14, 0x0b, 0xdd, 0x99, 0x82, 0x9a, 0xe5, 0xa3,
+	0xba, 0x28, 0x88, 0x54, 0x21, 0x55, 0x2a, 0x52, 0x25, 0xc4, 0x26, 0x29, 0xf3, 0x33, 0x58, 0x89,
+	0x95, 0xe5, 0xdf, 0xb6, 0xe8, 0x31, 0x7e, 0xe7, 0xcf, 0x3c, 0x6c, 0xc4, 0xc7, 0xfd, 0xf7, 0x43,
+	0x88, 0xa0, 0x28, 0xe5, 0xe6, 0xa5, 0x5c, 0xb9, 0x46, 0x3d, 0x28, 0x79, 0x7e, 0x70, 0x11, 0xc5,
+	0x83, 0x54, 0xed, 0xec, 0x2e, 0x53, 0x8a, 0x05, 0x6a, 0x4e, 0x13, 0x49, 0x98, 0xe8, 0x31, 0x54,
+	0x44, 0xab, 0xe3, 0x5a, 0x14, 0x65, 0xf0, 0xb2, 0x30, 0xc8, 0x4a, 0x3c, 0x91, 0xb3, 0x73, 0x31,
+	0x8d, 0x2c, 0x61, 0x92, 0xb3, 0xb3, 0xd6, 0xf9, 0x68, 0xf9, 0xe4, 0xbf, 0xf0, 0x7c, 0x4f, 0x44,
+	0x97, 0x45, 0x81, 0x98, 0x2a, 0xd6, 0x88, 0xc0, 0x46, 0x48, 0x79, 0xc0, 0x7c, 0xee, 0x9d, 0x4c,
+	0xa9, 0x95, 0xcc, 0xa8, 0x56, 0x7a, 0xdb, 0x02, 0xa3, 0x14, 0x7b, 0x1c, 0x93, 0x53, 0xd7, 0xff,
+	0x27, 0x05, 0xd0, 0x3f, 0x53, 0x7c, 0x53, 0x32, 0x25, 0x55, 0xb2, 0xb9, 0x74, 0xf3, 0x99, 0x74,
+	0x3b, 0x50, 0x49, 0x26, 0xc3, 0x73, 0xb5, 0x82, 0x6c, 0xd0, 0xc6, 0x82, 0xe6, 0x1c, 0xe6, 0x48,
+	0x39, 0xc6, 0xa5, 0x2e, 0x42, 0x31, 0x7d, 0x11, 0x16, 0xce, 0xe2, 0xd7, 0x50, 0x4d, 0xbd, 0x0d,
+	0x0b, 0xd5, 0x35, 0xe6, 0x5f, 0x9a, 0x82, 0x74, 0xa5, 0x4d, 0x62, 0x78, 0xaf, 0xe8, 0x09, 0x17,
+	0xb7, 0xe7, 0x22, 0x9c, 0x26, 0x0d, 0x83, 0xc4, 0x74, 0x14, 0x4e, 0xd1, 0x36, 0x94, 0x3d, 0x87,
+	0xf9, 0xd2, 0xbb, 0x22, 0xbd, 0xab, 0x62, 0x7f, 0x14, 0x4e, 0x77, 0xbf, 0x55, 0x60, 0x6d, 0xbe,
+	0x47, 0xa8, 0x0e, 0x8f, 0x07, 0xfa, 0x01, 0x36, 0xb0, 0x89, 0x47, 0x86, 0x65, 0x3e, 0x1f, 0xeb,
+	0xd6, 0x91, 0x31, 0x19, 0xeb, 0x7d, 0x7c, 0x80, 0xf5, 0x81, 0x9a, 0x43, 0xef, 0x81, 0x96, 0x05,
+	0x8c, 0xc9, 0x68, 0x3c, 0x9a, 0xe8, 0x03, 0x55, 0x41, 0x8f, 0x60, 0x2b, 0xeb, 0x25, 0x7a, 0x7f,
+	0x44, 0x06, 0x6a, 0x7e, 0x51, 0xe8, 0xd8, 0x67, 0x0d, 0xf1, 0xc4, 0x54, 0x0b, 0xbb, 0x3f, 0xe7,
+	0xa1, 0xf2, 0xa6, 0xc3, 0x22, 0xd4, 0xb8, 0x4b, 0xcc, 0xe7, 0x8b, 0x44, 0x6c, 0xc3, 0x83, 0x94,
+	0x6f, 0x44, 0xf0, 0x13, 0x6c, 0x74, 0xcd, 0x11, 0x51, 0x15, 0xf4, 0x10, 0x36, 0x52, 0xae, 0x89,
+	0x4e, 0x8e, 0x71, 0x5f, 0x27, 0x6a, 0x3e, 0xe3, 0xc0, 0xc6, 0xb1, 0x3e, 0x11, 0x8c, 0x02, 0xd2,
+	0x60, 0x33, 0xe5, 0xe8, 0x1f, 0x4d, 0xcc, 0xd1, 0x00, 0x77, 0x0d, 0xb5, 0x88, 0x36, 0x41, 0x4d,
+	0x1f, 0xf3, 0xcc, 0xd0, 0x89, 0xba, 0x92, 0xc1, 0x77, 0x0f, 0x0e, 0xf0, 0x10, 0x77, 0x4d, 0x5d,
+	0x2d, 0xa1, 0x2d, 0x40, 0x69, 0xfc, 0x53, 0x03, 0xf7, 0x8e, 0x26, 0xea, 0x6a, 0x46, 0xee, 0x98,
+	0x8c, 0x8e, 0x75, 0xa3, 0x6b, 0xf4, 0x75, 0xb5, 0x9c, 0x71, 0xf5, 0x47, 0x86, 0x49, 0x46, 0xc3,
+	0xa1, 0x4e, 0x54, 0xc8, 0x9c, 0x73, 0xdc, 0x1d, 0xe2, 0x81, 0xcc, 0xb1, 0xda, 0x7b, 0xf9, 0xea,
+	0xb6, 0xa6, 0xbc, 0xbe, 0xad, 0x29, 0xbf, 0xdf, 0xd6, 0x94, 0xef, 0xee, 0x6a, 0xb9, 0xd7, 0x77,
+	0xb5, 0xdc, 0x2f, 0x77, 0xb5, 0x1c, 0x6c, 0x7b, 0x6c, 0xc9, 0xdd, 0x19, 0x2b, 0x5f, 0x7c, 0x9a,
+	0x7a, 0xdf, 0x67, 0xa0, 0x3d, 0x8f, 0xa5, 0x76, 0xed, 0xaf, 0x66, 0x7f, 0x3c, 0xf2, 0xc5, 0x3f,
+	0x29, 0xc9, 0x3f, 0x97, 0x4f, 0xfe, 0x1e, 0x00, 0x47, 0x0a, 0x33, 0x3a, 0x15, 0x09, 0x00, 0x00,
 }
 
 func (m *ScopeSpecification) Marshal() (dAtA []byte, err error) {
@@ -757,6 +825,20 @@ func (m *ContractSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecordWriteRoles) > 0 {
+		for iNdEx := len(m.RecordWriteRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordWriteRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSpecification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ClassName) > 0 {
 		i -= len(m.ClassName)
 		copy(dAtA[i:], m.ClassName)
@@ -855,7 +937,7 @@ func (m *ContractSpecification_Hash) MarshalToSizedBuffer(dAtA []byte) (int, err
 	dAtA[i] = 0x32
 	return len(dAtA) - i, nil
 }
-func (m *RecordSpecification) Marshal() (dAtA []byte, err error) {
+func (m *RecordWriteRoles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -865,20 +947,20 @@ func (m *RecordSpecification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordSpecification) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordWriteRoles) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordWriteRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ResponsibleParties) > 0 {
-		dAtA8 := make([]byte, len(m.ResponsibleParties)*10)
+	if len(m.Roles) > 0 {
+		dAtA8 := make([]byte, len(m.Roles)*10)
 		var j7 int
-		for _, num := range m.ResponsibleParties {
+		for _, num := range m.Roles {
 			for num >= 1<<7 {
 				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -891,6 +973,54 @@ func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA8[:j7])
 		i = encodeVarintSpecification(dAtA, i, uint64(j7))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecordName) > 0 {
+		i -= len(m.RecordName)
+		copy(dAtA[i:], m.RecordName)
+		i = encodeVarintSpecification(dAtA, i, uint64(len(m.RecordName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordSpecification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordSpecification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordSpecification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponsibleParties) > 0 {
+		dAtA10 := make([]byte, len(m.ResponsibleParties)*10)
+		var j9 int
+		for _, num := range m.ResponsibleParties {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintSpecification(dAtA, i, uint64(j9))
+		i--
 		dAtA[i] = 0x32
 	}
 	if m.ResultType != 0 {
@@ -1146,6 +1276,12 @@ func (m *ContractSpecification) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpecification(uint64(l))
 	}
+	if len(m.RecordWriteRoles) > 0 {
+		for _, e := range m.RecordWriteRoles {
+			l = e.Size()
+			n += 1 + l + sovSpecification(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1171,6 +1307,26 @@ func (m *ContractSpecification_Hash) Size() (n int) {
 	n += 1 + l + sovSpecification(uint64(l))
 	return n
 }
+func (m *RecordWriteRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordName)
+	if l > 0 {
+		n += 1 + l + sovSpecification(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovSpecification(uint64(e))
+		}
+		n += 1 + sovSpecification(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *RecordSpecification) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1295,6 +1451,11 @@ func (this *ContractSpecification) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRecordWriteRoles := "[]RecordWriteRoles{"
+	for _, f := range this.RecordWriteRoles {
+		repeatedStringForRecordWriteRoles += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForRecordWriteRoles += "}"
 	s := strings.Join([]string{`&ContractSpecification{`,
 		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
 		`Description:` + strings.Replace(fmt.Sprintf("%v", this.Description), "Description", "Description", 1) + `,`,
@@ -1302,6 +1463,7 @@ func (this *ContractSpecification) String() string {
 		`PartiesInvolved:` + fmt.Sprintf("%v", this.PartiesInvolved) + `,`,
 		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
 		`ClassName:` + fmt.Sprintf("%v", this.ClassName) + `,`,
+		`RecordWriteRoles:` + repeatedStringForRecordWriteRoles + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1937,6 +2099,191 @@ func (m *ContractSpecification) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordWriteRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordWriteRoles = append(m.RecordWriteRoles, RecordWriteRoles{})
+			if err := m.RecordWriteRoles[len(m.RecordWriteRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSpecification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordWriteRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSpecification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordWriteRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordWriteRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpecification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpecification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v PartyType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSpecification
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= PartyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSpecification
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSpecification
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSpecification
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]PartyType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v PartyType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSpecification
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= PartyType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpecification(dAtA[iNdEx:])
@@ -235,6 +235,18 @@ func (m *WeirdSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 
 func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 	contractSpecUuid1 := uuid.New()
+	withWriteRoles := func(writeRoles ...RecordWriteRoles) *ContractSpecification {
+		spec := NewContractSpecification(
+			ContractSpecMetadataAddress(uuid.New()),
+			nil,
+			[]string{specTestBech32},
+			[]PartyType{PartyType_PARTY_TYPE_ORIGINATOR, PartyType_PARTY_TYPE_SERVICER},
+			NewContractSpecificationSourceHash("somehash"),
+			"someclass",
+		)
+		spec.RecordWriteRoles = writeRoles
+		return spec
+	}
 	tests := []struct {
 		name string
 		spec *ContractSpecification
@@ -423,6 +435,58 @@ func (s *SpecificationTestSuite) TestContractSpecValidateBasic() {
 			"",
 		},
 
+		// RecordWriteRoles tests
+		{
+			"RecordWriteRoles - valid",
+			withWriteRoles(
+				RecordWriteRoles{RecordName: "payment", Roles: []PartyType{PartyType_PARTY_TYPE_SERVICER}},
+				RecordWriteRoles{RecordName: "loan", Roles: []PartyType{PartyType_PARTY_TYPE_ORIGINATOR, PartyType_PARTY_TYPE_SERVICER}},
+			),
+			"",
+		},
+		{
+			"RecordWriteRoles - empty record name",
+			withWriteRoles(RecordWriteRoles{RecordName: "", Roles: []PartyType{PartyType_PARTY_TYPE_SERVICER}}),
+			"invalid record write roles at index 0: record name cannot be empty",
+		},
+		{
+			"RecordWriteRoles - record name too long",
+			withWriteRoles(RecordWriteRoles{RecordName: strings.Repeat("r", maxRecordSpecificationNameLength+1), Roles: []PartyType{PartyType_PARTY_TYPE_SERVICER}}),
+			fmt.Sprintf("invalid record write roles at index 0: record name exceeds maximum length (expected <= %d got: %d)",
+				maxRecordSpecificationNameLength, maxRecordSpecificationNameLength+1),
+		},
+		{
+			"RecordWriteRoles - no roles",
+			withWriteRoles(RecordWriteRoles{RecordName: "payment"}),
+			"invalid record write roles at index 0: no roles provided for record name \"payment\"",
+		},
+		{
+			"RecordWriteRoles - unspecified role",
+			withWriteRoles(RecordWriteRoles{RecordName: "payment", Roles: []PartyType{PartyType_PARTY_TYPE_UNSPECIFIED}}),
+			"invalid record write roles at index 0: invalid role PARTY_TYPE_UNSPECIFIED for record name \"payment\"",
+		},
+		{
+			"RecordWriteRoles - unknown role",
+			withWriteRoles(RecordWriteRoles{RecordName: "payment", Roles: []PartyType{99}}),
+			"invalid record write roles at index 0: invalid role 99 for record name \"payment\"",
+		},
+		{
+			"RecordWriteRoles - role not involved",
+			withWriteRoles(
+				RecordWriteRoles{RecordName: "loan", Roles: []PartyType{PartyType_PARTY_TYPE_ORIGINATOR}},
+				RecordWriteRoles{RecordName: "payment", Roles: []PartyType{PartyType_PARTY_TYPE_INVESTOR}},
+			),
+			"invalid record write roles at index 1: role INVESTOR for record name \"payment\" is not one of the parties involved",
+		},
+		{
+			"RecordWriteRoles - duplicate record name",
+			withWriteRoles(
+				RecordWriteRoles{RecordName: "payment", Roles: []PartyType{PartyType_PARTY_TYPE_SERVICER}},
+				RecordWriteRoles{RecordName: "payment", Roles: []PartyType{PartyType_PARTY_TYPE_ORIGINATOR}},
+			),
+			"duplicate record write roles for record name \"payment\"",
+		},
+
 		// A simple valid ContractSpecification
 		{
 			"simple valid test case",
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -1064,6 +1066,272 @@ func (m *MsgDeleteRecordResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteRecordResponse proto.InternalMessageInfo
 
+// MsgTombstoneRecordRequest is the request type for the Msg/TombstoneRecord RPC method.
+type MsgTombstoneRecordRequest struct {
+	// record_id is the id of the record to tombstone.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id"`
+	// reason is why the record's contents are being redacted.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgTombstoneRecordRequest) Reset()         { *m = MsgTombstoneRecordRequest{} }
+func (m *MsgTombstoneRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTombstoneRecordRequest) ProtoMessage()    {}
+func (*MsgTombstoneRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{23}
+}
+func (m *MsgTombstoneRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTombstoneRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTombstoneRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTombstoneRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTombstoneRecordRequest.Merge(m, src)
+}
+func (m *MsgTombstoneRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTombstoneRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTombstoneRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTombstoneRecordRequest proto.InternalMessageInfo
+
+// MsgTombstoneRecordResponse is the response type for the Msg/TombstoneRecord RPC method.
+type MsgTombstoneRecordResponse struct {
+}
+
+func (m *MsgTombstoneRecordResponse) Reset()         { *m = MsgTombstoneRecordResponse{} }
+func (m *MsgTombstoneRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTombstoneRecordResponse) ProtoMessage()    {}
+func (*MsgTombstoneRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{24}
+}
+func (m *MsgTombstoneRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTombstoneRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTombstoneRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTombstoneRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTombstoneRecordResponse.Merge(m, src)
+}
+func (m *MsgTombstoneRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTombstoneRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTombstoneRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTombstoneRecordResponse proto.InternalMessageInfo
+
+// MsgProposeChangeRequest is the request type for the Msg/ProposeChange RPC method.
+type MsgProposeChangeRequest struct {
+	// msg is the MsgWriteScopeRequest or MsgWriteRecordRequest to execute once approved.
+	// The signers of this request are used as its first approvals; any signers in it are ignored.
+	Msg *types.Any `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// expiration_height is the block height at which the change expires if it hasn't been executed.
+	ExpirationHeight int64 `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgProposeChangeRequest) Reset()         { *m = MsgProposeChangeRequest{} }
+func (m *MsgProposeChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeChangeRequest) ProtoMessage()    {}
+func (*MsgProposeChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{25}
+}
+func (m *MsgProposeChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeChangeRequest.Merge(m, src)
+}
+func (m *MsgProposeChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeChangeRequest proto.InternalMessageInfo
+
+// MsgProposeChangeResponse is the response type for the Msg/ProposeChange RPC method.
+type MsgProposeChangeResponse struct {
+	// change_id is the id of the new pending change.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// executed is true if the signers of the proposal were enough for the change to be executed right away.
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgProposeChangeResponse) Reset()         { *m = MsgProposeChangeResponse{} }
+func (m *MsgProposeChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeChangeResponse) ProtoMessage()    {}
+func (*MsgProposeChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{26}
+}
+func (m *MsgProposeChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeChangeResponse.Merge(m, src)
+}
+func (m *MsgProposeChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeChangeResponse proto.InternalMessageInfo
+
+func (m *MsgProposeChangeResponse) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
+
+func (m *MsgProposeChangeResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgApproveChangeRequest is the request type for the Msg/ApproveChange RPC method.
+type MsgApproveChangeRequest struct {
+	// change_id is the id of the pending change to approve.
+	ChangeId uint64 `protobuf:"varint,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// signers is the list of address of those signing this request.
+	Signers []string `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *MsgApproveChangeRequest) Reset()         { *m = MsgApproveChangeRequest{} }
+func (m *MsgApproveChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveChangeRequest) ProtoMessage()    {}
+func (*MsgApproveChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{27}
+}
+func (m *MsgApproveChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveChangeRequest.Merge(m, src)
+}
+func (m *MsgApproveChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveChangeRequest proto.InternalMessageInfo
+
+// MsgApproveChangeResponse is the response type for the Msg/ApproveChange RPC method.
+type MsgApproveChangeResponse struct {
+	// executed is true if the change was executed because of these approvals.
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgApproveChangeResponse) Reset()         { *m = MsgApproveChangeResponse{} }
+func (m *MsgApproveChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveChangeResponse) ProtoMessage()    {}
+func (*MsgApproveChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{28}
+}
+func (m *MsgApproveChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveChangeResponse.Merge(m, src)
+}
+func (m *MsgApproveChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveChangeResponse proto.InternalMessageInfo
+
+func (m *MsgApproveChangeResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
 // MsgWriteScopeSpecificationRequest is the request type for the Msg/WriteScopeSpecification RPC method.
 type MsgWriteScopeSpecificationRequest struct {
 	// specification is the ScopeSpecification you want added or updated.
@@ -1083,7 +1351,7 @@ func (m *MsgWriteScopeSpecificationRequest) Reset()         { *m = MsgWriteScope
 func (m *MsgWriteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteScopeSpecificationRequest) ProtoMessage()    {}
 func (*MsgWriteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{23}
+	return fileDescriptor_3a3a0892f91e3036, []int{29}
 }
 func (m *MsgWriteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1122,7 +1390,7 @@ func (m *MsgWriteScopeSpecificationResponse) Reset()         { *m = MsgWriteScop
 func (m *MsgWriteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteScopeSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{24}
+	return fileDescriptor_3a3a0892f91e3036, []int{30}
 }
 func (m *MsgWriteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1169,7 +1437,7 @@ func (m *MsgDeleteScopeSpecificationRequest) Reset()         { *m = MsgDeleteSco
 func (m *MsgDeleteScopeSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeSpecificationRequest) ProtoMessage()    {}
 func (*MsgDeleteScopeSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{25}
+	return fileDescriptor_3a3a0892f91e3036, []int{31}
 }
 func (m *MsgDeleteScopeSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1474,7 @@ func (m *MsgDeleteScopeSpecificationResponse) Reset()         { *m = MsgDeleteSc
 func (m *MsgDeleteScopeSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteScopeSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteScopeSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{26}
+	return fileDescriptor_3a3a0892f91e3036, []int{32}
 }
 func (m *MsgDeleteScopeSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1522,7 @@ func (m *MsgWriteContractSpecificationRequest) Reset()         { *m = MsgWriteCo
 func (m *MsgWriteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteContractSpecificationRequest) ProtoMessage()    {}
 func (*MsgWriteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{27}
+	return fileDescriptor_3a3a0892f91e3036, []int{33}
 }
 func (m *MsgWriteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1294,7 +1562,7 @@ func (m *MsgWriteContractSpecificationResponse) Reset()         { *m = MsgWriteC
 func (m *MsgWriteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{28}
+	return fileDescriptor_3a3a0892f91e3036, []int{34}
 }
 func (m *MsgWriteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1343,7 +1611,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Reset()         { *m = MsgAddCont
 func (m *MsgAddContractSpecToScopeSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddContractSpecToScopeSpecRequest) ProtoMessage()    {}
 func (*MsgAddContractSpecToScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{29}
+	return fileDescriptor_3a3a0892f91e3036, []int{35}
 }
 func (m *MsgAddContractSpecToScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1648,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Reset()         { *m = MsgAddCon
 func (m *MsgAddContractSpecToScopeSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddContractSpecToScopeSpecResponse) ProtoMessage()    {}
 func (*MsgAddContractSpecToScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{30}
+	return fileDescriptor_3a3a0892f91e3036, []int{36}
 }
 func (m *MsgAddContractSpecToScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1426,7 +1694,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) String() string {
 }
 func (*MsgDeleteContractSpecFromScopeSpecRequest) ProtoMessage() {}
 func (*MsgDeleteContractSpecFromScopeSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{31}
+	return fileDescriptor_3a3a0892f91e3036, []int{37}
 }
 func (m *MsgDeleteContractSpecFromScopeSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1468,7 +1736,7 @@ func (m *MsgDeleteContractSpecFromScopeSpecResponse) String() string {
 }
 func (*MsgDeleteContractSpecFromScopeSpecResponse) ProtoMessage() {}
 func (*MsgDeleteContractSpecFromScopeSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{32}
+	return fileDescriptor_3a3a0892f91e3036, []int{38}
 }
 func (m *MsgDeleteContractSpecFromScopeSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1508,7 +1776,7 @@ func (m *MsgDeleteContractSpecificationRequest) Reset()         { *m = MsgDelete
 func (m *MsgDeleteContractSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteContractSpecificationRequest) ProtoMessage()    {}
 func (*MsgDeleteContractSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{33}
+	return fileDescriptor_3a3a0892f91e3036, []int{39}
 }
 func (m *MsgDeleteContractSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1547,7 +1815,7 @@ func (m *MsgDeleteContractSpecificationResponse) Reset() {
 func (m *MsgDeleteContractSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteContractSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteContractSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{34}
+	return fileDescriptor_3a3a0892f91e3036, []int{40}
 }
 func (m *MsgDeleteContractSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1595,7 +1863,7 @@ func (m *MsgWriteRecordSpecificationRequest) Reset()         { *m = MsgWriteReco
 func (m *MsgWriteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordSpecificationRequest) ProtoMessage()    {}
 func (*MsgWriteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{35}
+	return fileDescriptor_3a3a0892f91e3036, []int{41}
 }
 func (m *MsgWriteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1903,7 @@ func (m *MsgWriteRecordSpecificationResponse) Reset()         { *m = MsgWriteRec
 func (m *MsgWriteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgWriteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{36}
+	return fileDescriptor_3a3a0892f91e3036, []int{42}
 }
 func (m *MsgWriteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1682,7 +1950,7 @@ func (m *MsgDeleteRecordSpecificationRequest) Reset()         { *m = MsgDeleteRe
 func (m *MsgDeleteRecordSpecificationRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordSpecificationRequest) ProtoMessage()    {}
 func (*MsgDeleteRecordSpecificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{37}
+	return fileDescriptor_3a3a0892f91e3036, []int{43}
 }
 func (m *MsgDeleteRecordSpecificationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1987,7 @@ func (m *MsgDeleteRecordSpecificationResponse) Reset()         { *m = MsgDeleteR
 func (m *MsgDeleteRecordSpecificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteRecordSpecificationResponse) ProtoMessage()    {}
 func (*MsgDeleteRecordSpecificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{38}
+	return fileDescriptor_3a3a0892f91e3036, []int{44}
 }
 func (m *MsgDeleteRecordSpecificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1758,7 +2026,7 @@ func (m *MsgBindOSLocatorRequest) Reset()         { *m = MsgBindOSLocatorRequest
 func (m *MsgBindOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorRequest) ProtoMessage()    {}
 func (*MsgBindOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{39}
+	return fileDescriptor_3a3a0892f91e3036, []int{45}
 }
 func (m *MsgBindOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1796,7 +2064,7 @@ func (m *MsgBindOSLocatorResponse) Reset()         { *m = MsgBindOSLocatorRespon
 func (m *MsgBindOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBindOSLocatorResponse) ProtoMessage()    {}
 func (*MsgBindOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{40}
+	return fileDescriptor_3a3a0892f91e3036, []int{46}
 }
 func (m *MsgBindOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,7 +2110,7 @@ func (m *MsgDeleteOSLocatorRequest) Reset()         { *m = MsgDeleteOSLocatorReq
 func (m *MsgDeleteOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorRequest) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{41}
+	return fileDescriptor_3a3a0892f91e3036, []int{47}
 }
 func (m *MsgDeleteOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1880,7 +2148,7 @@ func (m *MsgDeleteOSLocatorResponse) Reset()         { *m = MsgDeleteOSLocatorRe
 func (m *MsgDeleteOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOSLocatorResponse) ProtoMessage()    {}
 func (*MsgDeleteOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{42}
+	return fileDescriptor_3a3a0892f91e3036, []int{48}
 }
 func (m *MsgDeleteOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1926,7 +2194,7 @@ func (m *MsgModifyOSLocatorRequest) Reset()         { *m = MsgModifyOSLocatorReq
 func (m *MsgModifyOSLocatorRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorRequest) ProtoMessage()    {}
 func (*MsgModifyOSLocatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{43}
+	return fileDescriptor_3a3a0892f91e3036, []int{49}
 }
 func (m *MsgModifyOSLocatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1964,7 +2232,7 @@ func (m *MsgModifyOSLocatorResponse) Reset()         { *m = MsgModifyOSLocatorRe
 func (m *MsgModifyOSLocatorResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyOSLocatorResponse) ProtoMessage()    {}
 func (*MsgModifyOSLocatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{44}
+	return fileDescriptor_3a3a0892f91e3036, []int{50}
 }
 func (m *MsgModifyOSLocatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2000,6 +2268,91 @@ func (m *MsgModifyOSLocatorResponse) GetLocator() ObjectStoreLocator {
 	return ObjectStoreLocator{}
 }
 
+// MsgHeartbeatOSLocatorRequest is the request type for the Msg/HeartbeatOSLocator RPC method.
+type MsgHeartbeatOSLocatorRequest struct {
+	// The owner of the object store locator.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgHeartbeatOSLocatorRequest) Reset()         { *m = MsgHeartbeatOSLocatorRequest{} }
+func (m *MsgHeartbeatOSLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeatOSLocatorRequest) ProtoMessage()    {}
+func (*MsgHeartbeatOSLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{51}
+}
+func (m *MsgHeartbeatOSLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHeartbeatOSLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHeartbeatOSLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHeartbeatOSLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeatOSLocatorRequest.Merge(m, src)
+}
+func (m *MsgHeartbeatOSLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHeartbeatOSLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeatOSLocatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHeartbeatOSLocatorRequest proto.InternalMessageInfo
+
+// MsgHeartbeatOSLocatorResponse is the response type for the Msg/HeartbeatOSLocator RPC method.
+type MsgHeartbeatOSLocatorResponse struct {
+	// The object store locator with its updated heartbeat.
+	Locator ObjectStoreLocator `protobuf:"bytes,1,opt,name=locator,proto3" json:"locator"`
+}
+
+func (m *MsgHeartbeatOSLocatorResponse) Reset()         { *m = MsgHeartbeatOSLocatorResponse{} }
+func (m *MsgHeartbeatOSLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeatOSLocatorResponse) ProtoMessage()    {}
+func (*MsgHeartbeatOSLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a3a0892f91e3036, []int{52}
+}
+func (m *MsgHeartbeatOSLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHeartbeatOSLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHeartbeatOSLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHeartbeatOSLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeatOSLocatorResponse.Merge(m, src)
+}
+func (m *MsgHeartbeatOSLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHeartbeatOSLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeatOSLocatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHeartbeatOSLocatorResponse proto.InternalMessageInfo
+
+func (m *MsgHeartbeatOSLocatorResponse) GetLocator() ObjectStoreLocator {
+	if m != nil {
+		return m.Locator
+	}
+	return ObjectStoreLocator{}
+}
+
 // MsgSetAccountDataRequest is the request to set/update/delete a scope's account data.
 type MsgSetAccountDataRequest struct {
 	// The identifier to associate the data with.
@@ -2015,7 +2368,7 @@ func (m *MsgSetAccountDataRequest) Reset()         { *m = MsgSetAccountDataReque
 func (m *MsgSetAccountDataRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAccountDataRequest) ProtoMessage()    {}
 func (*MsgSetAccountDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{45}
+	return fileDescriptor_3a3a0892f91e3036, []int{53}
 }
 func (m *MsgSetAccountDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2052,7 +2405,7 @@ func (m *MsgSetAccountDataResponse) Reset()         { *m = MsgSetAccountDataResp
 func (m *MsgSetAccountDataResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetAccountDataResponse) ProtoMessage()    {}
 func (*MsgSetAccountDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{46}
+	return fileDescriptor_3a3a0892f91e3036, []int{54}
 }
 func (m *MsgSetAccountDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2094,7 +2447,7 @@ func (m *MsgWriteP8EContractSpecRequest) Reset()         { *m = MsgWriteP8EContr
 func (m *MsgWriteP8EContractSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteP8EContractSpecRequest) ProtoMessage()    {}
 func (*MsgWriteP8EContractSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{47}
+	return fileDescriptor_3a3a0892f91e3036, []int{55}
 }
 func (m *MsgWriteP8EContractSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2136,7 +2489,7 @@ func (m *MsgWriteP8EContractSpecResponse) Reset()         { *m = MsgWriteP8ECont
 func (m *MsgWriteP8EContractSpecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWriteP8EContractSpecResponse) ProtoMessage()    {}
 func (*MsgWriteP8EContractSpecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{48}
+	return fileDescriptor_3a3a0892f91e3036, []int{56}
 }
 func (m *MsgWriteP8EContractSpecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2183,7 +2536,7 @@ func (m *MsgP8EMemorializeContractRequest) Reset()         { *m = MsgP8EMemorial
 func (m *MsgP8EMemorializeContractRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgP8EMemorializeContractRequest) ProtoMessage()    {}
 func (*MsgP8EMemorializeContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{49}
+	return fileDescriptor_3a3a0892f91e3036, []int{57}
 }
 func (m *MsgP8EMemorializeContractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2226,7 +2579,7 @@ func (m *MsgP8EMemorializeContractResponse) Reset()         { *m = MsgP8EMemoria
 func (m *MsgP8EMemorializeContractResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgP8EMemorializeContractResponse) ProtoMessage()    {}
 func (*MsgP8EMemorializeContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{50}
+	return fileDescriptor_3a3a0892f91e3036, []int{58}
 }
 func (m *MsgP8EMemorializeContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2619,7 @@ func (m *MsgAddNetAssetValuesRequest) Reset()         { *m = MsgAddNetAssetValue
 func (m *MsgAddNetAssetValuesRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddNetAssetValuesRequest) ProtoMessage()    {}
 func (*MsgAddNetAssetValuesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{51}
+	return fileDescriptor_3a3a0892f91e3036, []int{59}
 }
 func (m *MsgAddNetAssetValuesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2303,7 +2656,7 @@ func (m *MsgAddNetAssetValuesResponse) Reset()         { *m = MsgAddNetAssetValu
 func (m *MsgAddNetAssetValuesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddNetAssetValuesResponse) ProtoMessage()    {}
 func (*MsgAddNetAssetValuesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3a3a0892f91e3036, []int{52}
+	return fileDescriptor_3a3a0892f91e3036, []int{60}
 }
 func (m *MsgAddNetAssetValuesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2356,6 +2709,12 @@ func init() {
 	proto.RegisterType((*MsgWriteRecordResponse)(nil), "provenance.metadata.v1.MsgWriteRecordResponse")
 	proto.RegisterType((*MsgDeleteRecordRequest)(nil), "provenance.metadata.v1.MsgDeleteRecordRequest")
 	proto.RegisterType((*MsgDeleteRecordResponse)(nil), "provenance.metadata.v1.MsgDeleteRecordResponse")
+	proto.RegisterType((*MsgTombstoneRecordRequest)(nil), "provenance.metadata.v1.MsgTombstoneRecordRequest")
+	proto.RegisterType((*MsgTombstoneRecordResponse)(nil), "provenance.metadata.v1.MsgTombstoneRecordResponse")
+	proto.RegisterType((*MsgProposeChangeRequest)(nil), "provenance.metadata.v1.MsgProposeChangeRequest")
+	proto.RegisterType((*MsgProposeChangeResponse)(nil), "provenance.metadata.v1.MsgProposeChangeResponse")
+	proto.RegisterType((*MsgApproveChangeRequest)(nil), "provenance.metadata.v1.MsgApproveChangeRequest")
+	proto.RegisterType((*MsgApproveChangeResponse)(nil), "provenance.metadata.v1.MsgApproveChangeResponse")
 	proto.RegisterType((*MsgWriteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationRequest")
 	proto.RegisterType((*MsgWriteScopeSpecificationResponse)(nil), "provenance.metadata.v1.MsgWriteScopeSpecificationResponse")
 	proto.RegisterType((*MsgDeleteScopeSpecificationRequest)(nil), "provenance.metadata.v1.MsgDeleteScopeSpecificationRequest")
@@ -2378,6 +2737,8 @@ func init() {
 	proto.RegisterType((*MsgDeleteOSLocatorResponse)(nil), "provenance.metadata.v1.MsgDeleteOSLocatorResponse")
 	proto.RegisterType((*MsgModifyOSLocatorRequest)(nil), "provenance.metadata.v1.MsgModifyOSLocatorRequest")
 	proto.RegisterType((*MsgModifyOSLocatorResponse)(nil), "provenance.metadata.v1.MsgModifyOSLocatorResponse")
+	proto.RegisterType((*MsgHeartbeatOSLocatorRequest)(nil), "provenance.metadata.v1.MsgHeartbeatOSLocatorRequest")
+	proto.RegisterType((*MsgHeartbeatOSLocatorResponse)(nil), "provenance.metadata.v1.MsgHeartbeatOSLocatorResponse")
 	proto.RegisterType((*MsgSetAccountDataRequest)(nil), "provenance.metadata.v1.MsgSetAccountDataRequest")
 	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.metadata.v1.MsgSetAccountDataResponse")
 	proto.RegisterType((*MsgWriteP8EContractSpecRequest)(nil), "provenance.metadata.v1.MsgWriteP8eContractSpecRequest")
@@ -2391,141 +2752,159 @@ func init() {
 func init() { proto.RegisterFile("provenance/metadata/v1/tx.proto", fileDescriptor_3a3a0892f91e3036) }
 
 var fileDescriptor_3a3a0892f91e3036 = []byte{
-	// 2139 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5f, 0x6c, 0x1c, 0x47,
-	0x19, 0xf7, 0xda, 0x89, 0xed, 0xfb, 0x6c, 0xc7, 0xce, 0xc4, 0x89, 0xcf, 0x1b, 0x72, 0xe7, 0x5e,
-	0x93, 0xd6, 0xb8, 0xc9, 0x1d, 0x71, 0x8d, 0x70, 0xf3, 0x07, 0xb0, 0x1b, 0x41, 0x5d, 0xf5, 0x48,
-	0x74, 0xd7, 0x34, 0x2a, 0x12, 0x3a, 0x36, 0xbb, 0xe3, 0xcb, 0x52, 0xdf, 0xce, 0xb1, 0xb3, 0xe7,
-	0x26, 0x8d, 0x88, 0x00, 0x89, 0x3f, 0xe2, 0x01, 0x15, 0x21, 0x55, 0x54, 0x20, 0x54, 0x09, 0x09,
-	0xf1, 0x58, 0x89, 0x37, 0x5e, 0x78, 0xcd, 0x13, 0xaa, 0xc4, 0x0b, 0x2a, 0x52, 0x85, 0x92, 0x87,
-	0xf2, 0xc8, 0x33, 0x0f, 0x80, 0x76, 0x76, 0xf6, 0xcf, 0xdc, 0xed, 0xcc, 0xee, 0x9d, 0x4b, 0x5c,
-	0xa9, 0x0f, 0x96, 0x3c, 0xb3, 0xdf, 0xbf, 0xdf, 0x37, 0xdf, 0x7c, 0xf3, 0xcd, 0x37, 0x07, 0xe5,
-	0xae, 0x4b, 0xf6, 0xb1, 0x63, 0x38, 0x26, 0xae, 0x75, 0xb0, 0x67, 0x58, 0x86, 0x67, 0xd4, 0xf6,
-	0x2f, 0xd6, 0xbc, 0xbb, 0xd5, 0xae, 0x4b, 0x3c, 0x82, 0x4e, 0xc5, 0x04, 0xd5, 0x90, 0xa0, 0xba,
-	0x7f, 0x51, 0x5f, 0x32, 0x09, 0xed, 0x10, 0x5a, 0xeb, 0xd0, 0xb6, 0x4f, 0xdf, 0xa1, 0xed, 0x80,
-	0x41, 0x5f, 0x6c, 0x93, 0x36, 0x61, 0xff, 0xd6, 0xfc, 0xff, 0xf8, 0xec, 0x39, 0x89, 0x9e, 0x48,
-	0x64, 0x40, 0xb6, 0x2a, 0x21, 0x23, 0xb7, 0xbf, 0x83, 0x4d, 0x8f, 0x7a, 0xc4, 0xc5, 0x9c, 0xf2,
-	0xac, 0x84, 0xb2, 0xbb, 0x89, 0xfd, 0x3f, 0x4e, 0x55, 0x91, 0x50, 0x51, 0x93, 0x74, 0x43, 0x9a,
-	0x35, 0x19, 0x4d, 0x17, 0x9b, 0xf6, 0xae, 0x6d, 0x1a, 0x9e, 0x4d, 0x9c, 0x80, 0xb6, 0xf2, 0xa1,
-	0x06, 0x8b, 0x75, 0xda, 0xbe, 0xe5, 0xda, 0x1e, 0x6e, 0xfa, 0x32, 0x1a, 0xf8, 0xbb, 0x3d, 0x4c,
-	0x3d, 0xf4, 0x02, 0x1c, 0x65, 0x32, 0x8b, 0xda, 0x8a, 0xb6, 0x3a, 0xb3, 0x7e, 0xa6, 0x9a, 0xee,
-	0xb6, 0x2a, 0x63, 0xda, 0x3e, 0xf2, 0xf0, 0xa3, 0xf2, 0x58, 0x23, 0xe0, 0x40, 0x45, 0x98, 0xa2,
-	0x76, 0xdb, 0xc1, 0x2e, 0x2d, 0x8e, 0xaf, 0x4c, 0xac, 0x16, 0x1a, 0xe1, 0x10, 0x9d, 0x01, 0x60,
-	0x24, 0xad, 0x5e, 0xcf, 0xb6, 0x8a, 0x13, 0x2b, 0xda, 0x6a, 0xa1, 0x51, 0x60, 0x33, 0x37, 0x7b,
-	0xb6, 0x85, 0x4e, 0x43, 0xc1, 0xb7, 0x31, 0xf8, 0x7a, 0x84, 0x7d, 0x9d, 0xf6, 0x27, 0xc2, 0x8f,
-	0x3d, 0x6a, 0xb5, 0x3a, 0xf6, 0xde, 0x1e, 0x2d, 0x1e, 0x5d, 0xd1, 0x56, 0x8f, 0x34, 0xa6, 0x7b,
-	0xd4, 0xaa, 0xfb, 0xe3, 0x4b, 0x8b, 0x3f, 0x7d, 0xaf, 0x3c, 0xf6, 0xcf, 0xf7, 0xca, 0x63, 0x3f,
-	0xfc, 0xf8, 0xfd, 0xb5, 0x50, 0x5d, 0xe5, 0xdb, 0x70, 0xb2, 0x0f, 0x1b, 0xed, 0x12, 0x87, 0x62,
-	0xf4, 0x75, 0x98, 0x0b, 0xec, 0xb0, 0xad, 0x96, 0xed, 0xec, 0x12, 0x0e, 0xf2, 0x69, 0x25, 0xc8,
-	0x1d, 0x6b, 0xc7, 0xd9, 0x25, 0x8d, 0x19, 0x1a, 0x0f, 0x2a, 0xf7, 0x99, 0x86, 0x6b, 0x78, 0x0f,
-	0xf7, 0xb9, 0x6f, 0x1d, 0xa6, 0x43, 0x0d, 0x4c, 0xf8, 0xec, 0xf6, 0x92, 0xef, 0xa2, 0x0f, 0x3f,
-	0x2a, 0xcf, 0xd7, 0xb9, 0xe0, 0x2d, 0xcb, 0x72, 0x31, 0xa5, 0x8d, 0x29, 0x2e, 0x50, 0xee, 0x37,
-	0x09, 0xbc, 0x22, 0x9c, 0xea, 0x57, 0x1e, 0xe0, 0xab, 0xfc, 0x4e, 0x83, 0xcf, 0xd5, 0x69, 0x7b,
-	0xcb, 0xb2, 0xd8, 0xfc, 0x35, 0x5f, 0x9b, 0x69, 0xfa, 0xca, 0x0e, 0x60, 0x5e, 0x19, 0x66, 0xfc,
-	0xf9, 0x96, 0xc1, 0x24, 0x71, 0x13, 0xc1, 0x8a, 0x64, 0x27, 0xed, 0x9f, 0xc8, 0x63, 0x7f, 0x19,
-	0xce, 0x48, 0x8c, 0xe4, 0x30, 0x7e, 0xaf, 0x41, 0x59, 0x44, 0xf8, 0x29, 0x45, 0x52, 0x81, 0x15,
-	0xb9, 0x9d, 0x1c, 0xcc, 0x9f, 0x34, 0x58, 0x4a, 0xc0, 0xbd, 0xfe, 0xa6, 0x83, 0xdd, 0x83, 0x80,
-	0xb8, 0x0c, 0x93, 0xe4, 0xcd, 0x28, 0x58, 0x14, 0x3b, 0xf4, 0x86, 0xe1, 0x7a, 0xf7, 0xf8, 0x0e,
-	0xe5, 0x2c, 0x43, 0x03, 0xd4, 0xa1, 0x38, 0x68, 0x3b, 0x07, 0xf6, 0x2b, 0x0d, 0x74, 0x11, 0xfd,
-	0x81, 0xb1, 0x9d, 0x12, 0xb0, 0x15, 0x46, 0x36, 0xfb, 0x0c, 0x9c, 0x4e, 0xb5, 0x8c, 0x5b, 0xfe,
-	0x47, 0x8d, 0x7d, 0xbf, 0xd9, 0xb5, 0x0c, 0x0f, 0xbf, 0x66, 0xec, 0xf5, 0x82, 0xef, 0x51, 0x6c,
-	0x6d, 0x40, 0x21, 0x34, 0x9d, 0x16, 0xb5, 0x95, 0x09, 0x95, 0xed, 0xd3, 0xdc, 0x76, 0x8a, 0xaa,
-	0x70, 0x62, 0xdf, 0x97, 0xd5, 0x62, 0x46, 0xb7, 0x8c, 0x80, 0xa0, 0x38, 0xce, 0xf2, 0xd9, 0xf1,
-	0xfd, 0x48, 0x0d, 0xe7, 0x1c, 0x1a, 0x54, 0x89, 0xed, 0xed, 0x14, 0xa3, 0x39, 0xaa, 0x1f, 0x05,
-	0xa8, 0xea, 0x76, 0xdb, 0x15, 0x28, 0x42, 0x54, 0x3a, 0x4c, 0xe3, 0xbb, 0x36, 0xf5, 0x6c, 0xa7,
-	0xcd, 0x16, 0xa4, 0xd0, 0x88, 0xc6, 0xfe, 0xb7, 0xae, 0x4b, 0xba, 0x84, 0x62, 0x8b, 0x1b, 0x1c,
-	0x8d, 0x47, 0xb4, 0x33, 0xc5, 0x0c, 0x6e, 0xe7, 0x4f, 0xc6, 0x59, 0xfe, 0x0a, 0xd2, 0x33, 0xa6,
-	0xd4, 0x26, 0x4e, 0x68, 0xe2, 0x57, 0x60, 0x8a, 0x06, 0x33, 0x3c, 0x33, 0x97, 0xa5, 0x99, 0x39,
-	0x20, 0xe3, 0xe1, 0x1d, 0x72, 0x29, 0x8e, 0xa0, 0x16, 0x9c, 0xe4, 0x44, 0x7e, 0xf2, 0x37, 0x49,
-	0xa7, 0x4b, 0x1c, 0xec, 0x78, 0x94, 0x9d, 0x46, 0x33, 0xeb, 0xcf, 0x65, 0x28, 0xda, 0xb1, 0x5e,
-	0x8c, 0x58, 0x1a, 0x27, 0xe8, 0xe0, 0xa4, 0xf2, 0x10, 0x93, 0x78, 0xea, 0xe7, 0x1a, 0x9c, 0x48,
-	0x91, 0x8f, 0xca, 0xc2, 0x71, 0xc9, 0xd6, 0xea, 0xa5, 0xb1, 0xe4, 0x81, 0x19, 0x11, 0xf8, 0x41,
-	0x16, 0x2c, 0x58, 0x44, 0xe0, 0x87, 0x17, 0x7a, 0x0a, 0x66, 0x43, 0xb4, 0x89, 0x23, 0x77, 0x86,
-	0xcf, 0xf9, 0x32, 0xb6, 0x11, 0x2c, 0x84, 0x41, 0x8e, 0x1d, 0xcf, 0xde, 0xb5, 0xb1, 0x5b, 0xb9,
-	0xc3, 0x52, 0x95, 0xb8, 0x32, 0xfc, 0xe8, 0xac, 0xc3, 0x7c, 0xc2, 0x7f, 0x89, 0xc3, 0xf3, 0x5c,
-	0xa6, 0xe7, 0xd8, 0xf1, 0x39, 0x47, 0x93, 0xc3, 0xca, 0x5f, 0xc7, 0xe3, 0x33, 0xba, 0x81, 0x4d,
-	0xe2, 0x5a, 0x61, 0x0c, 0x5c, 0x81, 0x49, 0x97, 0x4d, 0x70, 0xf9, 0x25, 0x99, 0xfc, 0x80, 0x2d,
-	0x4c, 0x70, 0x01, 0xcf, 0x61, 0x06, 0xc0, 0x79, 0x40, 0x26, 0x71, 0x3c, 0xd7, 0x30, 0xbd, 0x56,
-	0x7f, 0x24, 0x2c, 0x84, 0x5f, 0x9a, 0x61, 0x59, 0x73, 0x15, 0xa6, 0xba, 0x86, 0xeb, 0xd9, 0xd8,
-	0x2f, 0x6a, 0x72, 0xe7, 0xf1, 0x90, 0x47, 0x12, 0x50, 0x56, 0xbc, 0xb3, 0x42, 0xa7, 0xf2, 0xe5,
-	0x7b, 0x19, 0x8e, 0x05, 0x1e, 0xea, 0x5b, 0xbd, 0xb3, 0x6a, 0xef, 0xf2, 0xc5, 0x9b, 0x75, 0x13,
-	0xa3, 0xca, 0x83, 0x44, 0xfd, 0x21, 0xae, 0xdd, 0x06, 0x14, 0x22, 0x2d, 0x59, 0x49, 0x7f, 0x3a,
-	0x94, 0x39, 0x74, 0xfd, 0xb3, 0xcc, 0xa2, 0x54, 0xd4, 0xcf, 0x73, 0xcb, 0x43, 0x0d, 0x9e, 0x12,
-	0x4a, 0xbf, 0x66, 0xb2, 0xf6, 0x0d, 0xcd, 0x7c, 0x0d, 0xe6, 0x84, 0x9a, 0x98, 0xfb, 0x62, 0x4d,
-	0x59, 0x06, 0x0a, 0x92, 0xf8, 0x72, 0x88, 0x62, 0x14, 0xc1, 0x27, 0x24, 0x87, 0x89, 0x5c, 0xc9,
-	0xe1, 0x2d, 0xa8, 0xa8, 0x90, 0xf0, 0x75, 0x7d, 0x15, 0x50, 0xb0, 0x8b, 0x99, 0x78, 0x71, 0x6d,
-	0x9f, 0xcd, 0xc4, 0xc3, 0x97, 0x77, 0x9e, 0x8a, 0x13, 0xfe, 0xd1, 0x5e, 0x11, 0x0f, 0xd0, 0x54,
-	0x3f, 0x6e, 0xc3, 0x82, 0xe0, 0x80, 0x1c, 0xab, 0x3e, 0x2f, 0x30, 0x8c, 0xb0, 0xf8, 0xe7, 0xe0,
-	0x69, 0xa5, 0x65, 0x3c, 0x10, 0xfe, 0xa2, 0xc1, 0xd9, 0xd0, 0x7d, 0x2f, 0x26, 0xf6, 0xde, 0x00,
-	0x86, 0xd7, 0xd3, 0x63, 0xe1, 0x82, 0xcc, 0x77, 0xa9, 0xc2, 0x9e, 0x40, 0x38, 0xfc, 0x58, 0x83,
-	0x73, 0x19, 0x80, 0x78, 0x48, 0x7c, 0x0b, 0x4e, 0x8a, 0x79, 0x48, 0x8c, 0x8a, 0xb5, 0x3c, 0xc8,
-	0x78, 0x60, 0x20, 0x73, 0x60, 0xae, 0xf2, 0xef, 0xc0, 0xb3, 0x5b, 0x96, 0x95, 0x64, 0x78, 0x95,
-	0x44, 0x8b, 0x11, 0x7a, 0xb6, 0x09, 0xcb, 0x82, 0x1d, 0xc3, 0x84, 0xc9, 0x92, 0x99, 0x06, 0x71,
-	0xc7, 0x42, 0x75, 0x38, 0x15, 0xc7, 0xbb, 0x20, 0x71, 0x5c, 0x2d, 0x71, 0x91, 0x0e, 0x04, 0xcb,
-	0xce, 0xf0, 0xb5, 0xcd, 0xb3, 0x6c, 0x11, 0x54, 0xd8, 0x79, 0xfc, 0xfd, 0x57, 0x83, 0xcf, 0x47,
-	0x71, 0x9a, 0x24, 0xfe, 0x9a, 0x4b, 0x3a, 0x9f, 0x09, 0x57, 0x9d, 0x87, 0xb5, 0x3c, 0x0e, 0xe0,
-	0xfe, 0xfa, 0x75, 0x10, 0xde, 0x83, 0xe4, 0x9f, 0x8a, 0xa4, 0xb3, 0x0a, 0xcf, 0x64, 0x19, 0xc7,
-	0x71, 0xfc, 0x5d, 0x8b, 0xd3, 0x76, 0x70, 0x36, 0xa5, 0x82, 0xb8, 0x95, 0x9e, 0x75, 0x9e, 0x53,
-	0x9f, 0xc6, 0x07, 0xca, 0x39, 0xe9, 0xe5, 0xc9, 0x44, 0x7a, 0x79, 0x22, 0xf1, 0xc3, 0x03, 0x96,
-	0x7c, 0xe5, 0xe0, 0x78, 0x06, 0xba, 0x05, 0x27, 0x78, 0x19, 0x90, 0x92, 0x7f, 0x56, 0xb3, 0x31,
-	0xf2, 0xec, 0xb3, 0xe0, 0xf6, 0xcd, 0x54, 0xde, 0xd5, 0x12, 0xd9, 0x5f, 0xe1, 0xde, 0xc3, 0x88,
-	0x91, 0x67, 0x58, 0x5a, 0x54, 0x98, 0xc6, 0x23, 0xe4, 0x3e, 0xab, 0x5e, 0xb6, 0x6d, 0xc7, 0xba,
-	0xde, 0x7c, 0x85, 0x98, 0x86, 0x47, 0xa2, 0x1b, 0xda, 0xcb, 0x30, 0xb5, 0x17, 0xcc, 0x64, 0xe5,
-	0xea, 0xeb, 0xac, 0x8d, 0xd8, 0xf4, 0x88, 0x8b, 0xb9, 0x8c, 0xb0, 0x40, 0xe4, 0x02, 0xfa, 0x8c,
-	0xe4, 0xb3, 0x95, 0x5d, 0x76, 0x9f, 0xef, 0x53, 0x1e, 0x95, 0x88, 0x9f, 0x98, 0xf6, 0xca, 0xf7,
-	0x60, 0x39, 0x72, 0xc6, 0x21, 0xc0, 0xbc, 0x93, 0xe8, 0x4c, 0x3c, 0x09, 0xa0, 0x75, 0x62, 0xd9,
-	0xbb, 0xf7, 0x0e, 0x0d, 0xe8, 0x80, 0xfa, 0xff, 0x03, 0xd0, 0xdf, 0x6a, 0x2c, 0x74, 0x9a, 0xd8,
-	0xdb, 0x32, 0x4d, 0xd2, 0x73, 0xbc, 0x6b, 0x86, 0x67, 0xc4, 0x77, 0xb6, 0xb9, 0x50, 0x5a, 0x70,
-	0x25, 0xcd, 0xd8, 0x6c, 0xb3, 0x9d, 0xc4, 0x04, 0x5a, 0x84, 0xa3, 0xac, 0x3b, 0xc2, 0x3b, 0x0f,
-	0xc1, 0x60, 0xe8, 0xf3, 0xe6, 0x34, 0x5b, 0x89, 0x7e, 0xfb, 0xf8, 0xa6, 0x7b, 0x47, 0x83, 0x52,
-	0x98, 0xb9, 0x6e, 0x6c, 0x0a, 0x39, 0x3c, 0xc4, 0xd0, 0x80, 0xd9, 0x30, 0x0b, 0xfa, 0xa9, 0x20,
-	0x2b, 0x5b, 0x75, 0x37, 0xb1, 0x50, 0x31, 0x71, 0x7f, 0x09, 0x32, 0x14, 0x39, 0x64, 0xd2, 0xc7,
-	0x50, 0xd4, 0x2a, 0x8f, 0x83, 0x56, 0x67, 0xba, 0x61, 0x4f, 0xa4, 0xa0, 0x43, 0xaf, 0xc3, 0x62,
-	0x4a, 0xb6, 0x0e, 0xdb, 0x8b, 0xf9, 0xd3, 0xf5, 0xf1, 0xfe, 0x74, 0x1d, 0xa3, 0xfc, 0xcf, 0x38,
-	0x6b, 0x94, 0xde, 0xd8, 0xc4, 0x75, 0xdc, 0x21, 0xae, 0x6d, 0xec, 0xd9, 0x6f, 0x45, 0x58, 0xc3,
-	0x05, 0x58, 0xee, 0x6b, 0x18, 0x16, 0xe2, 0xbe, 0xe0, 0x32, 0x4c, 0xb7, 0x5d, 0xd2, 0xeb, 0x86,
-	0xc5, 0x4b, 0xa1, 0x31, 0xc5, 0xc6, 0x3b, 0x16, 0xda, 0x90, 0x56, 0x39, 0xc1, 0xd1, 0x96, 0x5e,
-	0xcc, 0x7c, 0x15, 0xfc, 0xeb, 0xa7, 0xed, 0x19, 0x7b, 0x94, 0xdd, 0xd0, 0x15, 0x17, 0x61, 0x7f,
-	0xa1, 0x1b, 0x9c, 0xb6, 0x11, 0x71, 0xf9, 0x12, 0x42, 0x5f, 0xb2, 0x57, 0x89, 0x0c, 0x09, 0x11,
-	0xd8, 0x88, 0x0b, 0xbd, 0x04, 0xe0, 0x47, 0x83, 0xe1, 0xf5, 0x5c, 0x4c, 0x8b, 0x93, 0xd9, 0xe1,
-	0xd6, 0x0c, 0xa9, 0x9b, 0xd8, 0x6b, 0x24, 0x78, 0xfd, 0x30, 0xb3, 0x9d, 0x7d, 0xf2, 0x06, 0x76,
-	0x8b, 0x53, 0x81, 0x77, 0xf8, 0x30, 0x5a, 0x80, 0x5f, 0x8c, 0xb3, 0x7b, 0xb1, 0x6c, 0x01, 0x3e,
-	0xe1, 0xe7, 0x91, 0xb4, 0x66, 0xd1, 0xf8, 0xe8, 0xcd, 0x22, 0xf4, 0x0a, 0xcc, 0x8b, 0xcd, 0x8b,
-	0x20, 0x25, 0xe4, 0xed, 0x5e, 0xcc, 0x25, 0xbb, 0x17, 0x71, 0x50, 0xfe, 0x39, 0xe8, 0x97, 0x6e,
-	0x59, 0xd6, 0x37, 0xb0, 0xb7, 0x45, 0x29, 0xf6, 0x58, 0xb3, 0x92, 0xe6, 0x88, 0x47, 0x79, 0x95,
-	0x75, 0x13, 0x16, 0x1c, 0xec, 0xb5, 0x0c, 0x5f, 0x5c, 0x8b, 0x25, 0xb2, 0xd0, 0x56, 0x29, 0x74,
-	0x41, 0x3b, 0x4f, 0x23, 0xc7, 0x1c, 0xc1, 0x24, 0x65, 0xa7, 0x35, 0x05, 0x40, 0xb0, 0x9e, 0xeb,
-	0xff, 0x2a, 0xc2, 0x44, 0x9d, 0xb6, 0x91, 0x0d, 0x10, 0xf7, 0x11, 0xd0, 0x79, 0x99, 0x21, 0x69,
-	0xef, 0x81, 0xfa, 0x85, 0x9c, 0xd4, 0x3c, 0x84, 0xf6, 0x60, 0x26, 0x71, 0x37, 0x47, 0x2a, 0xee,
-	0xc1, 0xd7, 0x33, 0xbd, 0x9a, 0x97, 0x9c, 0x6b, 0xfb, 0x81, 0x06, 0x68, 0xf0, 0x1d, 0x09, 0x6d,
-	0x28, 0xc4, 0x48, 0xdf, 0xc6, 0xf4, 0x2f, 0x0e, 0xc9, 0xc5, 0x6d, 0xf8, 0x99, 0x06, 0x27, 0x53,
-	0x5f, 0x80, 0xd0, 0x97, 0xf2, 0xa1, 0x19, 0xb4, 0x64, 0x73, 0x78, 0x46, 0x6e, 0x8c, 0x0b, 0x73,
-	0xc2, 0x63, 0x0d, 0xaa, 0xe5, 0x00, 0x95, 0x7c, 0x25, 0xd0, 0xbf, 0x90, 0x9f, 0x81, 0xeb, 0xbc,
-	0x0f, 0x0b, 0xfd, 0x2f, 0x2d, 0x68, 0x3d, 0x1f, 0x02, 0x41, 0xf3, 0xf3, 0x43, 0xf1, 0x70, 0xe5,
-	0x0f, 0xe0, 0xf8, 0xc0, 0x8b, 0x08, 0x52, 0x49, 0x92, 0x3d, 0xfa, 0xe8, 0x1b, 0xc3, 0x31, 0xc5,
-	0xfa, 0x07, 0x5e, 0x3a, 0x94, 0xfa, 0x65, 0xcf, 0x33, 0x4a, 0xfd, 0xd2, 0xc7, 0x14, 0x44, 0x60,
-	0x36, 0xd9, 0xae, 0x47, 0xd5, 0xcc, 0xed, 0x2a, 0xbc, 0xb8, 0xe8, 0xb5, 0xdc, 0xf4, 0xf1, 0x06,
-	0x4f, 0xdc, 0xff, 0x50, 0x66, 0x7a, 0x10, 0x1a, 0xc4, 0x7a, 0x35, 0x2f, 0x79, 0x0c, 0x2f, 0x79,
-	0xa3, 0x42, 0xd9, 0x09, 0x42, 0xd4, 0x57, 0xcb, 0x4d, 0xcf, 0x15, 0xbe, 0xad, 0xc1, 0x92, 0xa4,
-	0xe7, 0x8a, 0x5e, 0xc8, 0x95, 0x0a, 0xd3, 0x2e, 0xa4, 0xfa, 0xa5, 0x51, 0x58, 0xb9, 0x49, 0xbf,
-	0xd4, 0xa0, 0x28, 0xeb, 0x77, 0xa2, 0x4b, 0xf9, 0x36, 0x4d, 0xaa, 0x51, 0x97, 0x47, 0xe2, 0xe5,
-	0x56, 0xbd, 0xab, 0x81, 0x2e, 0x6f, 0x46, 0xa2, 0x2b, 0x59, 0x80, 0x55, 0x3d, 0x1e, 0xfd, 0xea,
-	0x88, 0xdc, 0xdc, 0xb6, 0xdf, 0x68, 0x70, 0x5a, 0xd1, 0xac, 0x41, 0x57, 0x33, 0x81, 0x2b, 0xad,
-	0xfb, 0xf2, 0xa8, 0xec, 0x09, 0xd7, 0xc9, 0x5b, 0x88, 0x4a, 0xd7, 0x65, 0x76, 0x5d, 0x95, 0xae,
-	0xcb, 0xee, 0x5b, 0xa2, 0x3f, 0x68, 0x50, 0xce, 0xe8, 0xd9, 0xa1, 0xad, 0xa1, 0xf0, 0xa7, 0x35,
-	0x3c, 0xf5, 0xed, 0x83, 0x88, 0x48, 0xec, 0x0b, 0x59, 0x2b, 0x0a, 0x5d, 0xca, 0x97, 0x68, 0x86,
-	0xde, 0x17, 0x99, 0xbd, 0xaf, 0x77, 0x34, 0x58, 0x96, 0x36, 0x81, 0xd0, 0xe5, 0x9c, 0xf9, 0x28,
-	0xd5, 0xae, 0x2b, 0xa3, 0x31, 0xc7, 0xa5, 0x81, 0xd0, 0xf7, 0x51, 0x96, 0x06, 0x69, 0xed, 0x29,
-	0x65, 0x69, 0x90, 0xde, 0x52, 0xba, 0x0b, 0xf3, 0x7d, 0x4d, 0x18, 0x74, 0x31, 0x13, 0xc4, 0x80,
-	0xde, 0xf5, 0x61, 0x58, 0x62, 0xcd, 0x7d, 0x5d, 0x11, 0xa5, 0xe6, 0xf4, 0x06, 0x8e, 0x52, 0xb3,
-	0xac, 0xe9, 0xd2, 0x83, 0x63, 0x62, 0x13, 0x02, 0xa9, 0xfc, 0x96, 0xda, 0x4f, 0xd1, 0x2f, 0x0e,
-	0xc1, 0x11, 0x17, 0x22, 0x03, 0x17, 0x01, 0x65, 0x21, 0x22, 0xbb, 0xf7, 0xe8, 0x1b, 0xc3, 0x31,
-	0x05, 0xfa, 0xf5, 0xa3, 0xdf, 0xff, 0xf8, 0xfd, 0x35, 0x6d, 0xfb, 0x8d, 0x87, 0x8f, 0x4a, 0xda,
-	0x07, 0x8f, 0x4a, 0xda, 0x3f, 0x1e, 0x95, 0xb4, 0xb7, 0x1f, 0x97, 0xc6, 0x3e, 0x78, 0x5c, 0x1a,
-	0xfb, 0xdb, 0xe3, 0xd2, 0x18, 0x2c, 0xdb, 0x44, 0x22, 0xf8, 0x86, 0xf6, 0xcd, 0x8d, 0xb6, 0xed,
-	0xdd, 0xe9, 0xdd, 0xae, 0x9a, 0xa4, 0x53, 0x8b, 0x89, 0x2e, 0xd8, 0x24, 0x31, 0xaa, 0xdd, 0x8d,
-	0x7f, 0xd5, 0xe8, 0xdd, 0xeb, 0x62, 0x7a, 0x7b, 0x92, 0xfd, 0x96, 0xf1, 0xf9, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x0e, 0x34, 0xe1, 0xb8, 0xfc, 0x29, 0x00, 0x00,
+	// 2428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0xd8, 0x49, 0xec, 0x3d, 0xb6, 0x63, 0xe7, 0xc6, 0xb1, 0x77, 0xc7, 0xb1, 0xd7, 0xd9,
+	0xc4, 0xad, 0x71, 0x92, 0xdd, 0xd8, 0x75, 0x21, 0x75, 0x12, 0xc0, 0x4e, 0x05, 0x76, 0xd5, 0x25,
+	0xd6, 0x6e, 0xd2, 0xa8, 0x48, 0x68, 0x19, 0xcf, 0x5c, 0xaf, 0x87, 0x78, 0xe7, 0x2e, 0x73, 0x67,
+	0x5d, 0xbb, 0x11, 0x11, 0x20, 0xf1, 0x21, 0x1e, 0x50, 0x11, 0x52, 0x45, 0x05, 0x42, 0x95, 0x90,
+	0x10, 0x8f, 0x95, 0xe0, 0x09, 0x1e, 0x78, 0x8d, 0x78, 0x40, 0x95, 0x90, 0x10, 0x2a, 0x52, 0x85,
+	0x92, 0x87, 0xf2, 0x37, 0xf0, 0x00, 0x68, 0xe6, 0xde, 0xf9, 0xda, 0xf9, 0x5e, 0xa7, 0x49, 0xa4,
+	0x3e, 0x44, 0xca, 0xbd, 0x7b, 0x3e, 0x7e, 0xbf, 0x73, 0xcf, 0x9c, 0x7b, 0xef, 0xb9, 0x86, 0x62,
+	0x5b, 0x27, 0xfb, 0x58, 0x93, 0x34, 0x19, 0x57, 0x5a, 0xd8, 0x90, 0x14, 0xc9, 0x90, 0x2a, 0xfb,
+	0x4b, 0x15, 0xe3, 0xa0, 0xdc, 0xd6, 0x89, 0x41, 0xd0, 0xa4, 0x2b, 0x50, 0xb6, 0x05, 0xca, 0xfb,
+	0x4b, 0xe2, 0x94, 0x4c, 0x68, 0x8b, 0xd0, 0x4a, 0x8b, 0x36, 0x4d, 0xf9, 0x16, 0x6d, 0x32, 0x05,
+	0xb1, 0xc0, 0x7e, 0x68, 0x58, 0xa3, 0x0a, 0x1b, 0xf0, 0x9f, 0x26, 0x9a, 0xa4, 0x49, 0xd8, 0xbc,
+	0xf9, 0x3f, 0x5b, 0xa1, 0x49, 0x48, 0x73, 0x0f, 0x57, 0xac, 0xd1, 0x76, 0x67, 0xa7, 0x22, 0x69,
+	0x87, 0xfc, 0xa7, 0xf9, 0x08, 0x74, 0x0e, 0x10, 0x26, 0xb6, 0x10, 0x21, 0x46, 0xb6, 0xbf, 0x85,
+	0x65, 0x83, 0x1a, 0x44, 0xc7, 0x5c, 0xf2, 0x42, 0x84, 0x64, 0xfb, 0x2a, 0x36, 0xff, 0x71, 0xa9,
+	0x52, 0x84, 0x14, 0x95, 0x49, 0xdb, 0x96, 0x59, 0x8c, 0x92, 0x69, 0x63, 0x59, 0xdd, 0x51, 0x65,
+	0xc9, 0x50, 0x89, 0xc6, 0x64, 0x4b, 0x1f, 0x09, 0x30, 0x51, 0xa5, 0xcd, 0xbb, 0xba, 0x6a, 0xe0,
+	0xba, 0x69, 0xa3, 0x86, 0xbf, 0xdd, 0xc1, 0xd4, 0x40, 0xaf, 0xc0, 0x71, 0xcb, 0x66, 0x5e, 0x98,
+	0x13, 0x16, 0x86, 0x97, 0x67, 0xca, 0xe1, 0xc1, 0x2e, 0x5b, 0x4a, 0xeb, 0xc7, 0x1e, 0x7e, 0x5c,
+	0xec, 0xab, 0x31, 0x0d, 0x94, 0x87, 0x41, 0xaa, 0x36, 0x35, 0xac, 0xd3, 0x7c, 0xff, 0xdc, 0xc0,
+	0x42, 0xae, 0x66, 0x0f, 0xd1, 0x0c, 0x80, 0x25, 0xd2, 0xe8, 0x74, 0x54, 0x25, 0x3f, 0x30, 0x27,
+	0x2c, 0xe4, 0x6a, 0x39, 0x6b, 0xe6, 0x4e, 0x47, 0x55, 0xd0, 0x34, 0xe4, 0x4c, 0x8c, 0xec, 0xd7,
+	0x63, 0xd6, 0xaf, 0x43, 0xe6, 0x84, 0xfd, 0x63, 0x87, 0x2a, 0x8d, 0x96, 0xba, 0xb7, 0x47, 0xf3,
+	0xc7, 0xe7, 0x84, 0x85, 0x63, 0xb5, 0xa1, 0x0e, 0x55, 0xaa, 0xe6, 0x78, 0x75, 0xe2, 0xc7, 0xef,
+	0x17, 0xfb, 0xfe, 0xfd, 0x7e, 0xb1, 0xef, 0xfb, 0x9f, 0x7c, 0xb0, 0x68, 0xbb, 0x2b, 0x7d, 0x13,
+	0xce, 0x74, 0x71, 0xa3, 0x6d, 0xa2, 0x51, 0x8c, 0xbe, 0x0a, 0xa3, 0x0c, 0x87, 0xaa, 0x34, 0x54,
+	0x6d, 0x87, 0x70, 0x92, 0xe7, 0x63, 0x49, 0x6e, 0x2a, 0x9b, 0xda, 0x0e, 0xa9, 0x0d, 0x53, 0x77,
+	0x50, 0xba, 0x6f, 0x79, 0x78, 0x15, 0xef, 0xe1, 0xae, 0xf0, 0x2d, 0xc3, 0x90, 0xed, 0xc1, 0x32,
+	0x3e, 0xb2, 0x3e, 0x65, 0x86, 0xe8, 0xa3, 0x8f, 0x8b, 0x63, 0x55, 0x6e, 0x78, 0x4d, 0x51, 0x74,
+	0x4c, 0x69, 0x6d, 0x90, 0x1b, 0x8c, 0x8e, 0x5b, 0x04, 0xbd, 0x3c, 0x4c, 0x76, 0x3b, 0x67, 0xfc,
+	0x4a, 0xbf, 0x11, 0xe0, 0x6c, 0x95, 0x36, 0xd7, 0x14, 0xc5, 0x9a, 0x7f, 0xd5, 0xf4, 0x26, 0xcb,
+	0xa6, 0xb3, 0x23, 0xc0, 0x2b, 0xc2, 0xb0, 0x39, 0xdf, 0x90, 0x2c, 0x4b, 0x1c, 0x22, 0x28, 0x8e,
+	0x6d, 0x2f, 0xfe, 0x81, 0x34, 0xf8, 0x8b, 0x30, 0x13, 0x01, 0x92, 0xd3, 0xf8, 0xad, 0x00, 0x45,
+	0x3f, 0xc3, 0xe7, 0x94, 0x49, 0x09, 0xe6, 0xa2, 0x71, 0x72, 0x32, 0x7f, 0x14, 0x60, 0xca, 0x43,
+	0xf7, 0xd6, 0x5b, 0x1a, 0xd6, 0x8f, 0x42, 0xe2, 0x1a, 0x9c, 0x20, 0x6f, 0x39, 0xc9, 0x12, 0xf3,
+	0x85, 0x6e, 0x49, 0xba, 0x71, 0xc8, 0xbf, 0x50, 0xae, 0x92, 0x99, 0xa0, 0x08, 0xf9, 0x20, 0x76,
+	0x4e, 0xec, 0x17, 0x02, 0x88, 0x7e, 0xf6, 0x47, 0xe6, 0x36, 0xe9, 0xe3, 0x96, 0xeb, 0x19, 0xf6,
+	0x0c, 0x4c, 0x87, 0x22, 0xe3, 0xc8, 0x7f, 0x2f, 0x58, 0xbf, 0xdf, 0x69, 0x2b, 0x92, 0x81, 0xdf,
+	0x90, 0xf6, 0x3a, 0xec, 0x77, 0x27, 0xb7, 0x56, 0x20, 0x67, 0x43, 0xa7, 0x79, 0x61, 0x6e, 0x20,
+	0x0e, 0xfb, 0x10, 0xc7, 0x4e, 0x51, 0x19, 0x4e, 0xef, 0x9b, 0xb6, 0x1a, 0x16, 0xe8, 0x86, 0xc4,
+	0x04, 0xf2, 0xfd, 0x56, 0x3d, 0x3b, 0xb5, 0xef, 0xb8, 0xe1, 0x9a, 0x99, 0x49, 0xcd, 0xc2, 0xd9,
+	0x70, 0xd0, 0x9c, 0xd5, 0x0f, 0x18, 0xab, 0xaa, 0xda, 0xd4, 0x7d, 0x12, 0x36, 0x2b, 0x11, 0x86,
+	0xf0, 0x81, 0x4a, 0x0d, 0x55, 0x6b, 0x5a, 0x0b, 0x92, 0xab, 0x39, 0x63, 0xf3, 0xb7, 0xb6, 0x4e,
+	0xda, 0x84, 0x62, 0x85, 0x03, 0x76, 0xc6, 0x3d, 0xe2, 0x0c, 0x81, 0xc1, 0x71, 0xfe, 0xa8, 0x1f,
+	0x26, 0x9d, 0xf2, 0x8c, 0x29, 0x55, 0x89, 0x66, 0x43, 0xfc, 0x12, 0x0c, 0x52, 0x36, 0xc3, 0x2b,
+	0x73, 0x31, 0xb2, 0x32, 0x33, 0x31, 0x9e, 0xde, 0xb6, 0x56, 0xcc, 0x16, 0xd4, 0x80, 0x33, 0x5c,
+	0xc8, 0x2c, 0xfe, 0x32, 0x69, 0xb5, 0x89, 0x86, 0x35, 0x83, 0x5a, 0xbb, 0xd1, 0xf0, 0xf2, 0xc5,
+	0x04, 0x47, 0x9b, 0xca, 0x4d, 0x47, 0xa5, 0x76, 0x9a, 0x06, 0x27, 0x63, 0x37, 0xb1, 0x88, 0x48,
+	0xfd, 0x54, 0x80, 0xd3, 0x21, 0xf6, 0x51, 0xd1, 0xb7, 0x5d, 0x5a, 0x6b, 0xb5, 0xd1, 0xe7, 0xdd,
+	0x30, 0x1d, 0x01, 0x33, 0xc9, 0xf2, 0xfd, 0x3e, 0x01, 0x33, 0xbd, 0xd0, 0x39, 0x18, 0xb1, 0xd9,
+	0x7a, 0xb6, 0xdc, 0x61, 0x3e, 0x67, 0xda, 0x58, 0x47, 0x30, 0x6e, 0x27, 0x39, 0xd6, 0x0c, 0x75,
+	0x47, 0xc5, 0x7a, 0x69, 0x17, 0xa6, 0x02, 0x2b, 0xc3, 0xb7, 0xce, 0x2a, 0x8c, 0x79, 0xe2, 0xe7,
+	0xd9, 0x3c, 0xe7, 0x13, 0x23, 0x67, 0x6d, 0x9f, 0xa3, 0xd4, 0x3b, 0x2c, 0xfd, 0xad, 0xdf, 0xdd,
+	0xa3, 0x6b, 0x58, 0x26, 0xba, 0x62, 0xe7, 0xc0, 0x75, 0x38, 0xa1, 0x5b, 0x13, 0xdc, 0xfe, 0x6c,
+	0x94, 0x7d, 0xa6, 0x66, 0x17, 0x38, 0xa6, 0xf3, 0x2c, 0x13, 0xe0, 0x12, 0x20, 0x99, 0x68, 0x86,
+	0x2e, 0xc9, 0x46, 0xa3, 0x3b, 0x13, 0xc6, 0xed, 0x5f, 0xea, 0xf6, 0xb1, 0xe6, 0x06, 0x0c, 0xb6,
+	0x25, 0xdd, 0x50, 0xb1, 0x79, 0xa8, 0x49, 0x5d, 0xc7, 0x6d, 0x9d, 0x88, 0x84, 0x52, 0xdc, 0x2f,
+	0xcb, 0x0e, 0x2a, 0x5f, 0xbe, 0xd7, 0xe0, 0x24, 0x8b, 0x50, 0xd7, 0xea, 0x5d, 0x88, 0x8f, 0x2e,
+	0x5f, 0xbc, 0x11, 0xdd, 0x33, 0x2a, 0x3d, 0xf0, 0x9c, 0x3f, 0xfc, 0x6b, 0xb7, 0x02, 0x39, 0xc7,
+	0x4b, 0x52, 0xd1, 0x1f, 0xb2, 0x6d, 0x66, 0x3e, 0xff, 0x14, 0x60, 0x2a, 0xe0, 0x9f, 0xd7, 0x96,
+	0xf7, 0x04, 0x28, 0x54, 0x69, 0xf3, 0x36, 0x69, 0x6d, 0x53, 0x83, 0x68, 0x4f, 0x04, 0xde, 0xa4,
+	0x99, 0x90, 0x12, 0x25, 0x1a, 0xaf, 0x8c, 0x7c, 0x94, 0xb9, 0x2e, 0x9e, 0x05, 0x31, 0x0c, 0x1a,
+	0x47, 0xfe, 0x27, 0x76, 0x4c, 0xd8, 0x62, 0x55, 0xf7, 0xe6, 0xae, 0xa4, 0x35, 0x9d, 0x43, 0xe5,
+	0x4d, 0x18, 0x68, 0xd1, 0x26, 0x5f, 0xb1, 0x89, 0x32, 0xbb, 0x9c, 0x94, 0xed, 0xcb, 0x49, 0x79,
+	0x4d, 0x3b, 0x5c, 0x9f, 0xfe, 0xcb, 0x1f, 0x2e, 0xf3, 0xfb, 0x4f, 0x79, 0x5b, 0xa2, 0xb8, 0xbc,
+	0xbf, 0xb4, 0x8d, 0x0d, 0x69, 0xa9, 0x5c, 0xa5, 0xcd, 0x9a, 0xa9, 0x8d, 0x2e, 0xc2, 0x29, 0x7c,
+	0xd0, 0x56, 0x75, 0xeb, 0x16, 0xd0, 0xd8, 0xc5, 0x6a, 0x73, 0xd7, 0xb0, 0x18, 0x0d, 0xd4, 0xc6,
+	0xdd, 0x1f, 0x36, 0xac, 0xf9, 0xcc, 0xdc, 0xea, 0x90, 0x0f, 0x82, 0xe7, 0xa9, 0x37, 0x0d, 0x39,
+	0xd9, 0x9a, 0xb1, 0xa3, 0x7e, 0xac, 0x36, 0xc4, 0x26, 0x36, 0x15, 0xb6, 0x29, 0x61, 0xb9, 0x63,
+	0xf0, 0x8d, 0x67, 0xa8, 0xe6, 0x8c, 0x4b, 0x3b, 0xec, 0xe0, 0xd4, 0xb6, 0x32, 0xd4, 0x1f, 0x91,
+	0x58, 0x9b, 0x59, 0xf3, 0xe9, 0xf3, 0x90, 0x0f, 0xfa, 0xe1, 0xe0, 0xbd, 0xf8, 0x84, 0x2e, 0x7c,
+	0x0f, 0x05, 0x38, 0xe7, 0xbb, 0x67, 0xd4, 0xbd, 0x17, 0x2d, 0x1b, 0xea, 0x1b, 0x30, 0xea, 0xbb,
+	0x80, 0xf1, 0x65, 0x5c, 0x8c, 0xbd, 0x73, 0xf8, 0x2c, 0xf1, 0x6f, 0xdf, 0x6f, 0x26, 0xa6, 0xd2,
+	0xf9, 0x76, 0xa2, 0x81, 0x54, 0x3b, 0xd1, 0xdb, 0x50, 0x8a, 0x63, 0xc2, 0x83, 0x71, 0x1b, 0x10,
+	0xdb, 0x32, 0x2c, 0xf3, 0xfe, 0x42, 0xf2, 0x62, 0x22, 0x1f, 0x5e, 0x4b, 0xc6, 0xa8, 0x7f, 0xc2,
+	0x3c, 0x47, 0x96, 0xfc, 0xa7, 0xb5, 0xd0, 0x38, 0xae, 0xc3, 0xb8, 0x2f, 0x00, 0x29, 0xbe, 0xe1,
+	0x31, 0x9f, 0x42, 0x0f, 0x99, 0x31, 0x0f, 0xe7, 0x63, 0x91, 0xf1, 0x6f, 0xf7, 0xaf, 0x02, 0x5c,
+	0xb0, 0xc3, 0x77, 0xd3, 0x53, 0xe8, 0x03, 0x1c, 0xde, 0x0c, 0xcf, 0x85, 0xcb, 0x51, 0xb1, 0x0b,
+	0x35, 0xf6, 0x14, 0xd2, 0xe1, 0x87, 0x02, 0xcc, 0x27, 0x10, 0xe2, 0x29, 0xf1, 0x0d, 0x38, 0xe3,
+	0xdf, 0xf4, 0xfc, 0x59, 0xb1, 0x98, 0x86, 0x19, 0x4f, 0x0c, 0x24, 0x07, 0xe6, 0x4a, 0xff, 0x61,
+	0x91, 0x5d, 0x53, 0x14, 0xaf, 0xc2, 0x6d, 0xe2, 0x2c, 0x86, 0x1d, 0xd9, 0x3a, 0x14, 0x7c, 0x38,
+	0xb2, 0xa4, 0xc9, 0x94, 0x1c, 0x46, 0x71, 0x53, 0x41, 0x55, 0x98, 0x74, 0xf3, 0xdd, 0x67, 0xb1,
+	0x3f, 0xde, 0xe2, 0x04, 0x0d, 0x24, 0xcb, 0x66, 0xf6, 0x83, 0xf4, 0x8b, 0x30, 0x9f, 0xc0, 0x9d,
+	0xe7, 0xdf, 0xff, 0x04, 0xf8, 0x9c, 0x93, 0xa7, 0x5e, 0xe1, 0xaf, 0xe8, 0xa4, 0xf5, 0x99, 0x08,
+	0xd5, 0x25, 0x58, 0x4c, 0x13, 0x00, 0x1e, 0xaf, 0x5f, 0xb2, 0xf4, 0x0e, 0x8a, 0x3f, 0x17, 0x45,
+	0x67, 0x01, 0x5e, 0x48, 0x02, 0xc7, 0x79, 0xfc, 0x53, 0x70, 0xcb, 0x36, 0x3b, 0x4e, 0x84, 0x92,
+	0xb8, 0x1b, 0x5e, 0x75, 0x2e, 0xc6, 0x1f, 0xfd, 0x8e, 0x54, 0x73, 0xc2, 0xcf, 0xc2, 0x03, 0xe1,
+	0x67, 0xe1, 0x88, 0x38, 0x3c, 0x80, 0xf3, 0xb1, 0xe4, 0x78, 0x05, 0xba, 0x0b, 0xa7, 0xf9, 0xa1,
+	0x2e, 0xa4, 0xfe, 0x2c, 0x24, 0x73, 0xe4, 0xd5, 0x67, 0x5c, 0xef, 0x9a, 0x31, 0xcf, 0x92, 0xe7,
+	0xbb, 0xce, 0x99, 0xcf, 0x4d, 0x8e, 0xbc, 0x00, 0x17, 0xe2, 0xa1, 0xf1, 0x0c, 0xb9, 0x6f, 0x1d,
+	0xa1, 0xd6, 0x55, 0x4d, 0xb9, 0x55, 0x7f, 0x9d, 0xc8, 0x92, 0x41, 0x9c, 0x76, 0xc0, 0x6b, 0x30,
+	0xb8, 0xc7, 0x66, 0x92, 0x6a, 0xf5, 0x2d, 0xab, 0x67, 0x5d, 0x37, 0x88, 0x8e, 0xb9, 0x0d, 0xfb,
+	0x36, 0xc2, 0x0d, 0x74, 0x81, 0xe4, 0xb3, 0xa5, 0x1d, 0xc8, 0x07, 0x9d, 0x3b, 0xf7, 0x91, 0x27,
+	0xe6, 0xbd, 0xf4, 0x1d, 0x28, 0x38, 0xc1, 0x78, 0x06, 0x34, 0x77, 0x3d, 0x6d, 0xb0, 0xa7, 0x41,
+	0xb4, 0x4a, 0x14, 0x75, 0xe7, 0xf0, 0x99, 0x11, 0x0d, 0xb8, 0xff, 0x14, 0x88, 0x6e, 0x58, 0x2d,
+	0xa4, 0x0d, 0x2c, 0xe9, 0xc6, 0x36, 0x96, 0x8c, 0x00, 0xd7, 0x09, 0x38, 0x6e, 0x35, 0xd9, 0x78,
+	0x1f, 0x8b, 0x0d, 0x56, 0x91, 0x17, 0x35, 0x9b, 0x2b, 0xdd, 0x83, 0x99, 0x08, 0x4b, 0x9f, 0x02,
+	0xec, 0x5f, 0x0b, 0x56, 0xc6, 0xd7, 0xb1, 0xb1, 0x26, 0xcb, 0xa4, 0xa3, 0x19, 0x66, 0x3b, 0xd8,
+	0xed, 0x6b, 0x8c, 0xda, 0xd6, 0x58, 0xdb, 0x26, 0xa1, 0x46, 0x8c, 0xb4, 0x3c, 0x13, 0x26, 0x63,
+	0xab, 0x83, 0xc8, 0xef, 0xa0, 0x6c, 0x90, 0x79, 0x9b, 0x9c, 0x86, 0x42, 0x08, 0x3e, 0x5e, 0x2b,
+	0xde, 0x15, 0x60, 0xd6, 0x2e, 0xb8, 0x5b, 0x57, 0x7d, 0x5b, 0x8f, 0xcd, 0xa1, 0x06, 0x23, 0x76,
+	0xf1, 0x36, 0x2b, 0x58, 0x52, 0x91, 0x35, 0x9f, 0xaf, 0xbc, 0x66, 0x78, 0xbc, 0x7c, 0x36, 0x62,
+	0x4a, 0xdf, 0x09, 0x93, 0x43, 0x5e, 0x28, 0x3d, 0x66, 0xcf, 0x01, 0xe1, 0xc0, 0x9e, 0xca, 0x39,
+	0x14, 0xbd, 0x09, 0x13, 0x21, 0x9b, 0x8c, 0xdd, 0x82, 0x4f, 0xbf, 0xcb, 0x9c, 0xea, 0xde, 0x65,
+	0x5c, 0x96, 0xff, 0xed, 0xb7, 0x1e, 0x13, 0xb6, 0xae, 0xe2, 0x2a, 0x6e, 0x11, 0x5d, 0x95, 0xf6,
+	0xd4, 0xb7, 0x1d, 0xae, 0xf6, 0x02, 0x14, 0xba, 0x9a, 0xea, 0x39, 0xb7, 0x77, 0x5e, 0x80, 0xa1,
+	0xa6, 0x4e, 0x3a, 0x6d, 0xfb, 0xcc, 0x95, 0xab, 0x0d, 0x5a, 0xe3, 0x4d, 0x05, 0xad, 0x44, 0x1e,
+	0xce, 0xd8, 0x8e, 0x1c, 0x7e, 0x06, 0xfb, 0x32, 0x98, 0x3d, 0x10, 0xd5, 0x90, 0xf6, 0x68, 0xfe,
+	0x58, 0x7c, 0xb3, 0xc8, 0x5c, 0xe8, 0x1a, 0x97, 0xad, 0x39, 0x5a, 0xa6, 0x05, 0x3b, 0x96, 0xf9,
+	0xe3, 0xc9, 0x16, 0x1c, 0xb2, 0x8e, 0x16, 0xda, 0x00, 0x30, 0xb3, 0x41, 0x32, 0x3a, 0x3a, 0xa6,
+	0xf9, 0x13, 0xc9, 0xe9, 0x56, 0xb7, 0xa5, 0xeb, 0xd8, 0xa8, 0x79, 0x74, 0xcd, 0x34, 0x53, 0xb5,
+	0x7d, 0x72, 0x0f, 0xeb, 0xf9, 0x41, 0x16, 0x1d, 0x3e, 0x74, 0x16, 0xe0, 0x67, 0xfd, 0x70, 0x2e,
+	0x66, 0x01, 0x9e, 0xf0, 0x13, 0x62, 0x58, 0x43, 0xb5, 0xbf, 0xf7, 0x86, 0x2a, 0x7a, 0x1d, 0xc6,
+	0xfc, 0x0d, 0x3e, 0x56, 0x12, 0xd2, 0x76, 0xf8, 0x46, 0xbd, 0x1d, 0x3e, 0x37, 0x29, 0xff, 0xcc,
+	0xde, 0x14, 0xd6, 0x14, 0xe5, 0x6b, 0xd8, 0x58, 0xa3, 0x14, 0x1b, 0x56, 0x43, 0x9f, 0xa6, 0xc8,
+	0xc7, 0xe8, 0xc3, 0xe1, 0x1d, 0x18, 0xd7, 0xb0, 0xd1, 0x90, 0x4c, 0x73, 0x0d, 0xab, 0x90, 0xd9,
+	0x58, 0x23, 0xa9, 0xfb, 0xbc, 0xf3, 0x32, 0x72, 0x52, 0xf3, 0x41, 0x8a, 0x7d, 0x8d, 0x08, 0x21,
+	0xc0, 0xd6, 0x73, 0xf9, 0xef, 0x67, 0x61, 0xa0, 0x4a, 0x9b, 0x48, 0x05, 0x70, 0xdb, 0x1f, 0xe8,
+	0x52, 0x14, 0x90, 0xb0, 0x37, 0x73, 0xf1, 0x72, 0x4a, 0x69, 0x9e, 0x42, 0x7b, 0x30, 0xec, 0x69,
+	0x29, 0xa0, 0x38, 0xed, 0xe0, 0x0b, 0xb3, 0x58, 0x4e, 0x2b, 0xce, 0xbd, 0x7d, 0x4f, 0x00, 0x14,
+	0x7c, 0x6b, 0x45, 0x2b, 0x31, 0x66, 0x22, 0xdf, 0x8f, 0xc5, 0x97, 0x33, 0x6a, 0x71, 0x0c, 0x3f,
+	0x11, 0xe0, 0x4c, 0xe8, 0x2b, 0x29, 0xfa, 0x42, 0x3a, 0x36, 0x41, 0x24, 0x57, 0xb3, 0x2b, 0x72,
+	0x30, 0x3a, 0x8c, 0xfa, 0x1e, 0x34, 0x51, 0x25, 0x05, 0x29, 0xef, 0x4b, 0x9a, 0x78, 0x25, 0xbd,
+	0x02, 0xf7, 0x79, 0x1f, 0xc6, 0xbb, 0x5f, 0x23, 0xd1, 0x72, 0x3a, 0x06, 0x3e, 0xcf, 0x2f, 0x65,
+	0xd2, 0xe1, 0xce, 0x1f, 0xc0, 0xa9, 0xc0, 0xab, 0x21, 0x8a, 0xb3, 0x14, 0xf5, 0x30, 0x2a, 0xae,
+	0x64, 0x53, 0x72, 0xfd, 0x07, 0x5e, 0x03, 0x63, 0xfd, 0x47, 0x3d, 0x61, 0x8a, 0x2b, 0xd9, 0x94,
+	0xb8, 0x7f, 0x02, 0x23, 0xde, 0x27, 0x2d, 0x54, 0x4e, 0xfc, 0x5c, 0x7d, 0xaf, 0x92, 0x62, 0x25,
+	0xb5, 0xbc, 0xfb, 0x81, 0x7b, 0xae, 0xad, 0x28, 0xb1, 0x3c, 0xf8, 0x5e, 0x29, 0xc4, 0x72, 0x5a,
+	0x71, 0x97, 0x9e, 0xf7, 0x22, 0x88, 0x92, 0x0b, 0x84, 0xdf, 0x5f, 0x25, 0xb5, 0x3c, 0x77, 0x78,
+	0x00, 0x63, 0x5d, 0xaf, 0x18, 0x68, 0x29, 0xc6, 0x46, 0xf8, 0x63, 0x8c, 0xb8, 0x9c, 0x45, 0xc5,
+	0xfd, 0x74, 0x7d, 0x6f, 0x0c, 0xb1, 0x9f, 0x6e, 0xd8, 0x53, 0x8a, 0x78, 0x25, 0xbd, 0x82, 0xa7,
+	0x5c, 0x78, 0x9f, 0x06, 0xe2, 0xcb, 0x45, 0xc8, 0x63, 0x85, 0x78, 0x25, 0xbd, 0x02, 0xf7, 0xf9,
+	0x8e, 0x00, 0x53, 0x11, 0xcd, 0x78, 0xf4, 0x4a, 0xaa, 0xcd, 0x26, 0xac, 0x53, 0x21, 0xae, 0xf6,
+	0xa2, 0xca, 0x21, 0xfd, 0x5c, 0x80, 0x7c, 0x54, 0x23, 0x1c, 0xad, 0xa6, 0x2b, 0x4b, 0xa1, 0xa0,
+	0xae, 0xf5, 0xa4, 0xcb, 0x51, 0xbd, 0x27, 0x80, 0x18, 0xdd, 0xa5, 0x46, 0xd7, 0x93, 0x08, 0xc7,
+	0x35, 0xff, 0xc4, 0x1b, 0x3d, 0x6a, 0x73, 0x6c, 0xbf, 0x12, 0x60, 0x3a, 0xa6, 0x8b, 0x87, 0x6e,
+	0x24, 0x12, 0x8f, 0x45, 0xf7, 0xc5, 0x5e, 0xd5, 0x3d, 0xa1, 0x8b, 0xee, 0x2d, 0xc7, 0x86, 0x2e,
+	0xb1, 0x1d, 0x2f, 0xde, 0xe8, 0x51, 0x9b, 0x63, 0xfb, 0x9d, 0x00, 0xc5, 0x84, 0x66, 0x2e, 0x5a,
+	0xcb, 0xc4, 0x3f, 0xac, 0x13, 0x2e, 0xae, 0x1f, 0xc5, 0x84, 0xe7, 0xbb, 0x88, 0xea, 0x51, 0xa2,
+	0xd5, 0x74, 0xa5, 0x3c, 0xf3, 0x77, 0x91, 0xd8, 0x14, 0x7d, 0x57, 0x80, 0x42, 0x64, 0x77, 0x10,
+	0x5d, 0x4b, 0x59, 0xf1, 0x43, 0x71, 0x5d, 0xef, 0x4d, 0xd9, 0xad, 0xa6, 0xbe, 0x86, 0x60, 0x6c,
+	0x35, 0x0d, 0xeb, 0x5b, 0x8a, 0x57, 0xd2, 0x2b, 0xb8, 0xfb, 0x55, 0x57, 0x77, 0x2e, 0x76, 0xbf,
+	0x0a, 0x6f, 0x24, 0x8a, 0xcb, 0x59, 0x54, 0x5c, 0xcf, 0x5d, 0xed, 0xb2, 0x58, 0xcf, 0xe1, 0x9d,
+	0x3d, 0x71, 0x39, 0x8b, 0x8a, 0xe7, 0xd4, 0x1f, 0xec, 0x7a, 0xc5, 0x9e, 0xfa, 0x23, 0xdb, 0x6d,
+	0xe2, 0xcb, 0x19, 0xb5, 0x38, 0x86, 0x0e, 0x9c, 0xf4, 0xb7, 0x9a, 0x50, 0xdc, 0xda, 0x85, 0x76,
+	0xcd, 0xc4, 0xa5, 0x0c, 0x1a, 0xee, 0x71, 0x33, 0x70, 0xdd, 0x8b, 0x3d, 0x6e, 0x46, 0xdd, 0x6e,
+	0xc5, 0x95, 0x6c, 0x4a, 0xcc, 0xbf, 0x78, 0xfc, 0xbb, 0x9f, 0x7c, 0xb0, 0x28, 0xac, 0xdf, 0x7b,
+	0xf8, 0x68, 0x56, 0xf8, 0xf0, 0xd1, 0xac, 0xf0, 0xaf, 0x47, 0xb3, 0xc2, 0x3b, 0x8f, 0x67, 0xfb,
+	0x3e, 0x7c, 0x3c, 0xdb, 0xf7, 0x8f, 0xc7, 0xb3, 0x7d, 0x50, 0x50, 0x49, 0x84, 0xe1, 0x2d, 0xe1,
+	0xeb, 0x2b, 0x4d, 0xd5, 0xd8, 0xed, 0x6c, 0x97, 0x65, 0xd2, 0xaa, 0xb8, 0x42, 0x97, 0x55, 0xe2,
+	0x19, 0x55, 0x0e, 0xdc, 0xbf, 0xef, 0x36, 0x0e, 0xdb, 0x98, 0x6e, 0x9f, 0xb0, 0xfe, 0x18, 0xe4,
+	0xa5, 0xff, 0x0f, 0x00, 0x52, 0x70, 0xc3, 0x76, 0x3c, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2562,6 +2941,12 @@ type MsgClient interface {
 	WriteRecord(ctx context.Context, in *MsgWriteRecordRequest, opts ...grpc.CallOption) (*MsgWriteRecordResponse, error)
 	// DeleteRecord deletes a record.
 	DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest, opts ...grpc.CallOption) (*MsgDeleteRecordResponse, error)
+	// TombstoneRecord redacts the contents of a record while keeping its structure.
+	TombstoneRecord(ctx context.Context, in *MsgTombstoneRecordRequest, opts ...grpc.CallOption) (*MsgTombstoneRecordResponse, error)
+	// ProposeChange creates a pending scope or record update that its required signers can approve over time.
+	ProposeChange(ctx context.Context, in *MsgProposeChangeRequest, opts ...grpc.CallOption) (*MsgProposeChangeResponse, error)
+	// ApproveChange adds approvals to a pending change, executing it once its signer requirements are satisfied.
+	ApproveChange(ctx context.Context, in *MsgApproveChangeRequest, opts ...grpc.CallOption) (*MsgApproveChangeResponse, error)
 	// WriteScopeSpecification adds or updates a scope specification.
 	WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error)
 	// DeleteScopeSpecification deletes a scope specification.
@@ -2584,6 +2969,8 @@ type MsgClient interface {
 	DeleteOSLocator(ctx context.Context, in *MsgDeleteOSLocatorRequest, opts ...grpc.CallOption) (*MsgDeleteOSLocatorResponse, error)
 	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
 	ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorRequest, opts ...grpc.CallOption) (*MsgModifyOSLocatorResponse, error)
+	// HeartbeatOSLocator attests that the endpoints of an ObjectStoreLocator are still being maintained by its owner.
+	HeartbeatOSLocator(ctx context.Context, in *MsgHeartbeatOSLocatorRequest, opts ...grpc.CallOption) (*MsgHeartbeatOSLocatorResponse, error)
 	// SetAccountData associates some basic data with a metadata address.
 	// Currently, only scope ids are supported.
 	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
@@ -2698,8 +3085,35 @@ func (c *msgClient) DeleteRecord(ctx context.Context, in *MsgDeleteRecordRequest
 	return out, nil
 }
 
-func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
-	out := new(MsgWriteScopeSpecificationResponse)
+func (c *msgClient) TombstoneRecord(ctx context.Context, in *MsgTombstoneRecordRequest, opts ...grpc.CallOption) (*MsgTombstoneRecordResponse, error) {
+	out := new(MsgTombstoneRecordResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/TombstoneRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeChange(ctx context.Context, in *MsgProposeChangeRequest, opts ...grpc.CallOption) (*MsgProposeChangeResponse, error) {
+	out := new(MsgProposeChangeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ProposeChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveChange(ctx context.Context, in *MsgApproveChangeRequest, opts ...grpc.CallOption) (*MsgApproveChangeResponse, error) {
+	out := new(MsgApproveChangeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/ApproveChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WriteScopeSpecification(ctx context.Context, in *MsgWriteScopeSpecificationRequest, opts ...grpc.CallOption) (*MsgWriteScopeSpecificationResponse, error) {
+	out := new(MsgWriteScopeSpecificationResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/WriteScopeSpecification", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -2797,6 +3211,15 @@ func (c *msgClient) ModifyOSLocator(ctx context.Context, in *MsgModifyOSLocatorR
 	return out, nil
 }
 
+func (c *msgClient) HeartbeatOSLocator(ctx context.Context, in *MsgHeartbeatOSLocatorRequest, opts ...grpc.CallOption) (*MsgHeartbeatOSLocatorResponse, error) {
+	out := new(MsgHeartbeatOSLocatorResponse)
+	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/HeartbeatOSLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error) {
 	out := new(MsgSetAccountDataResponse)
 	err := c.cc.Invoke(ctx, "/provenance.metadata.v1.Msg/SetAccountData", in, out, opts...)
@@ -2839,6 +3262,12 @@ type MsgServer interface {
 	WriteRecord(context.Context, *MsgWriteRecordRequest) (*MsgWriteRecordResponse, error)
 	// DeleteRecord deletes a record.
 	DeleteRecord(context.Context, *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error)
+	// TombstoneRecord redacts the contents of a record while keeping its structure.
+	TombstoneRecord(context.Context, *MsgTombstoneRecordRequest) (*MsgTombstoneRecordResponse, error)
+	// ProposeChange creates a pending scope or record update that its required signers can approve over time.
+	ProposeChange(context.Context, *MsgProposeChangeRequest) (*MsgProposeChangeResponse, error)
+	// ApproveChange adds approvals to a pending change, executing it once its signer requirements are satisfied.
+	ApproveChange(context.Context, *MsgApproveChangeRequest) (*MsgApproveChangeResponse, error)
 	// WriteScopeSpecification adds or updates a scope specification.
 	WriteScopeSpecification(context.Context, *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error)
 	// DeleteScopeSpecification deletes a scope specification.
@@ -2861,6 +3290,8 @@ type MsgServer interface {
 	DeleteOSLocator(context.Context, *MsgDeleteOSLocatorRequest) (*MsgDeleteOSLocatorResponse, error)
 	// ModifyOSLocator updates an ObjectStoreLocator record by the current owner.
 	ModifyOSLocator(context.Context, *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error)
+	// HeartbeatOSLocator attests that the endpoints of an ObjectStoreLocator are still being maintained by its owner.
+	HeartbeatOSLocator(context.Context, *MsgHeartbeatOSLocatorRequest) (*MsgHeartbeatOSLocatorResponse, error)
 	// SetAccountData associates some basic data with a metadata address.
 	// Currently, only scope ids are supported.
 	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
@@ -2905,6 +3336,15 @@ func (*UnimplementedMsgServer) WriteRecord(ctx context.Context, req *MsgWriteRec
 func (*UnimplementedMsgServer) DeleteRecord(ctx context.Context, req *MsgDeleteRecordRequest) (*MsgDeleteRecordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecord not implemented")
 }
+func (*UnimplementedMsgServer) TombstoneRecord(ctx context.Context, req *MsgTombstoneRecordRequest) (*MsgTombstoneRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TombstoneRecord not implemented")
+}
+func (*UnimplementedMsgServer) ProposeChange(ctx context.Context, req *MsgProposeChangeRequest) (*MsgProposeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeChange not implemented")
+}
+func (*UnimplementedMsgServer) ApproveChange(ctx context.Context, req *MsgApproveChangeRequest) (*MsgApproveChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChange not implemented")
+}
 func (*UnimplementedMsgServer) WriteScopeSpecification(ctx context.Context, req *MsgWriteScopeSpecificationRequest) (*MsgWriteScopeSpecificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteScopeSpecification not implemented")
 }
@@ -2938,6 +3378,9 @@ func (*UnimplementedMsgServer) DeleteOSLocator(ctx context.Context, req *MsgDele
 func (*UnimplementedMsgServer) ModifyOSLocator(ctx context.Context, req *MsgModifyOSLocatorRequest) (*MsgModifyOSLocatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyOSLocator not implemented")
 }
+func (*UnimplementedMsgServer) HeartbeatOSLocator(ctx context.Context, req *MsgHeartbeatOSLocatorRequest) (*MsgHeartbeatOSLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeartbeatOSLocator not implemented")
+}
 func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAccountData not implemented")
 }
@@ -3147,6 +3590,60 @@ func _Msg_DeleteRecord_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TombstoneRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTombstoneRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TombstoneRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/TombstoneRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TombstoneRecord(ctx, req.(*MsgTombstoneRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ProposeChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeChange(ctx, req.(*MsgProposeChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/ApproveChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveChange(ctx, req.(*MsgApproveChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WriteScopeSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWriteScopeSpecificationRequest)
 	if err := dec(in); err != nil {
@@ -3345,6 +3842,24 @@ func _Msg_ModifyOSLocator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HeartbeatOSLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHeartbeatOSLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HeartbeatOSLocator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.metadata.v1.Msg/HeartbeatOSLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HeartbeatOSLocator(ctx, req.(*MsgHeartbeatOSLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetAccountData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetAccountDataRequest)
 	if err := dec(in); err != nil {
@@ -3430,6 +3945,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRecord",
 			Handler:    _Msg_DeleteRecord_Handler,
 		},
+		{
+			MethodName: "TombstoneRecord",
+			Handler:    _Msg_TombstoneRecord_Handler,
+		},
+		{
+			MethodName: "ProposeChange",
+			Handler:    _Msg_ProposeChange_Handler,
+		},
+		{
+			MethodName: "ApproveChange",
+			Handler:    _Msg_ApproveChange_Handler,
+		},
 		{
 			MethodName: "WriteScopeSpecification",
 			Handler:    _Msg_WriteScopeSpecification_Handler,
@@ -3474,6 +4001,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyOSLocator",
 			Handler:    _Msg_ModifyOSLocator_Handler,
 		},
+		{
+			MethodName: "HeartbeatOSLocator",
+			Handler:    _Msg_HeartbeatOSLocator_Handler,
+		},
 		{
 			MethodName: "SetAccountData",
 			Handler:    _Msg_SetAccountData_Handler,
@@ -4432,7 +4963,7 @@ func (m *MsgDeleteRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgTombstoneRecordRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4442,38 +4973,38 @@ func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTombstoneRecordRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTombstoneRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SpecUuid) > 0 {
-		i -= len(m.SpecUuid)
-		copy(dAtA[i:], m.SpecUuid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
-		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
@@ -4481,7 +5012,7 @@ func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTombstoneRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4491,19 +5022,56 @@ func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTombstoneRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTombstoneRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Msg != nil {
 		{
-			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4516,7 +5084,7 @@ func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposeChangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4526,12 +5094,50 @@ func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposeChangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposeChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4545,20 +5151,15 @@ func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size := m.SpecificationId.Size()
-		i -= size
-		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgApproveChangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4568,20 +5169,30 @@ func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgApproveChangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgApproveChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4591,12 +5202,12 @@ func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4630,7 +5241,7 @@ func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4640,19 +5251,19 @@ func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
+	if m.ScopeSpecIdInfo != nil {
 		{
-			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ScopeSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4665,7 +5276,7 @@ func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4675,12 +5286,12 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4691,23 +5302,13 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			dAtA[i] = 0x12
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size := m.ContractSpecificationId.Size()
+		size := m.SpecificationId.Size()
 		i -= size
-		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SpecificationId.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -4717,7 +5318,7 @@ func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeleteScopeSpecificationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4727,12 +5328,12 @@ func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeleteScopeSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4740,7 +5341,7 @@ func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgWriteContractSpecificationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4750,30 +5351,189 @@ func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWriteContractSpecificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecUuid) > 0 {
+		i -= len(m.SpecUuid)
+		copy(dAtA[i:], m.SpecUuid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpecUuid)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Signers) > 0 {
 		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Signers[iNdEx])
 			copy(dAtA[i:], m.Signers[iNdEx])
 			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
 	{
-		size := m.ScopeSpecificationId.Size()
-		i -= size
-		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+		size, err := m.Specification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWriteContractSpecificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWriteContractSpecificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		{
+			size, err := m.ContractSpecIdInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ContractSpecificationId.Size()
+		i -= size
+		if _, err := m.ContractSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteContractSpecFromScopeSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ScopeSpecificationId.Size()
+		i -= size
+		if _, err := m.ScopeSpecificationId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
@@ -5227,6 +5987,69 @@ func (m *MsgModifyOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgHeartbeatOSLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeatOSLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeatOSLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeatOSLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeatOSLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeatOSLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Locator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSetAccountDataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6030,48 +6853,49 @@ func (m *MsgDeleteRecordResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
+func (m *MsgTombstoneRecordRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Specification.Size()
+	l = m.RecordId.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+func (m *MsgTombstoneRecordResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ScopeSpecIdInfo != nil {
-		l = m.ScopeSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+func (m *MsgProposeChangeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
@@ -6081,58 +6905,58 @@ func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+func (m *MsgProposeChangeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
+	if m.Executed {
+		n += 2
+	}
 	return n
 }
 
-func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+func (m *MsgApproveChangeRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Specification.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
 			l = len(s)
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.SpecUuid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+func (m *MsgApproveChangeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ContractSpecIdInfo != nil {
-		l = m.ContractSpecIdInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Executed {
+		n += 2
 	}
 	return n
 }
 
-func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+func (m *MsgWriteScopeSpecificationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ContractSpecificationId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ScopeSpecificationId.Size()
+	l = m.Specification.Size()
 	n += 1 + l + sovTx(uint64(l))
 	if len(m.Signers) > 0 {
 		for _, s := range m.Signers {
@@ -6140,10 +6964,106 @@ func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
+func (m *MsgWriteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScopeSpecIdInfo != nil {
+		l = m.ScopeSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDeleteScopeSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWriteContractSpecificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Specification.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.SpecUuid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWriteContractSpecificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContractSpecIdInfo != nil {
+		l = m.ContractSpecIdInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ScopeSpecificationId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddContractSpecToScopeSpecResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6332,6 +7252,30 @@ func (m *MsgModifyOSLocatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgHeartbeatOSLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgHeartbeatOSLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Locator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgSetAccountDataRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8707,13 +9651,600 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.ContractSpecUuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parties = append(m.Parties, Party{})
+			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecordIdInfo == nil {
+				m.RecordIdInfo = &RecordIdInfo{}
+			}
+			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTombstoneRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTombstoneRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTombstoneRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTombstoneRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTombstoneRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTombstoneRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8723,25 +10254,23 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parties = append(m.Parties, Party{})
-			if err := m.Parties[len(m.Parties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8764,7 +10293,7 @@ func (m *MsgWriteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8787,17 +10316,17 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWriteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIdInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
 			}
-			var msglen int
+			m.ChangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8807,28 +10336,31 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChangeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RecordIdInfo == nil {
-				m.RecordIdInfo = &RecordIdInfo{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
 			}
-			if err := m.RecordIdInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8850,7 +10382,7 @@ func (m *MsgWriteRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveChangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8873,17 +10405,17 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveChangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
 			}
-			var byteLen int
+			m.ChangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8893,25 +10425,11 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ChangeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
@@ -8965,7 +10483,7 @@ func (m *MsgDeleteRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveChangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8988,12 +10506,32 @@ func (m *MsgDeleteRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveChangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11103,6 +12641,171 @@ func (m *MsgModifyOSLocatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgHeartbeatOSLocatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeatOSLocatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeatOSLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHeartbeatOSLocatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeatOSLocatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeatOSLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -25,6 +26,9 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		AllMsgFeesCmd(),
 		ListParamsCmd(),
+		FeeCatalogCmd(),
+		FeeExemptionsCmd(),
+		FeeAccrualsCmd(),
 	)
 	return queryCmd
 }
@@ -95,3 +99,131 @@ func ListParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// FeeCatalogCmd is the CLI command for getting the active and scheduled fee catalogs.
+func FeeCatalogCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-catalog",
+		Aliases: []string{"catalog", "fc"},
+		Short:   "Get the active and scheduled fee catalogs on the Provenance Blockchain",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryFeeCatalogResponse
+			if response, err = queryClient.FeeCatalog(
+				context.Background(),
+				&types.QueryFeeCatalogRequest{},
+			); err != nil {
+				fmt.Printf("failed to query fee catalog: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FeeExemptionsCmd is the CLI command for listing all fee exemptions.
+func FeeExemptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-exemptions",
+		Aliases: []string{"exemptions", "fe"},
+		Short:   "List all the fee exemptions on the Provenance Blockchain",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryFeeExemptionsResponse
+			if response, err = queryClient.FeeExemptions(
+				context.Background(),
+				&types.QueryFeeExemptionsRequest{Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query fee exemptions: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "fee exemptions")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FeeAccrualsCmd is the CLI command for getting the additional fees that a recipient has received.
+func FeeAccrualsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-accruals <recipient> [--msg-type <msg type url>] [--start <time>] [--end <time>]",
+		Aliases: []string{"accruals", "fa"},
+		Short:   "Get the additional fees a recipient has received, by msg type and day",
+		Long: `Get the additional fees a recipient has received, by msg type and day.
+Use the fee collector module account address to get the fees that went to the fee collector.
+The start and end times are RFC3339 formatted (e.g. 2024-03-01T00:00:00Z) or just a date (e.g. 2024-03-01).
+The days that the start and end times are in are both included.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeAccrualsRequest{Recipient: args[0]}
+			if req.MsgType, err = cmd.Flags().GetString(FlagMsgType); err != nil {
+				return err
+			}
+			if req.StartTime, err = readTimeFlag(cmd, FlagStartTime); err != nil {
+				return err
+			}
+			if req.EndTime, err = readTimeFlag(cmd, FlagEndTime); err != nil {
+				return err
+			}
+
+			var response *types.QueryFeeAccrualsResponse
+			if response, err = queryClient.FeeAccruals(context.Background(), req); err != nil {
+				fmt.Printf("failed to query fee accruals: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().String(FlagMsgType, "", "Only get the fees of this msg type url")
+	cmd.Flags().String(FlagStartTime, "", "Only get the fees from the day of this time onward")
+	cmd.Flags().String(FlagEndTime, "", "Only get the fees up to the day of this time")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readTimeFlag reads a flag with either a RFC3339 time or a date. Returns nil if the flag wasn't provided.
+func readTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if rv, perr := time.Parse(layout, value); perr == nil {
+			return &rv, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid --%s value %q: expected RFC3339 time or YYYY-MM-DD date", name, value)
+}
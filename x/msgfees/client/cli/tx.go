@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -23,6 +24,17 @@ const (
 	FlagMsgType   = "msg-type"
 	FlagRecipient = "recipient"
 	FlagBips      = "bips"
+
+	FlagRecipientSplits = "recipient-splits"
+	FlagContract        = "contract"
+	FlagMethod          = "method"
+
+	FlagMsgTypes  = "msg-types"
+	FlagAddresses = "addresses"
+	FlagAttribute = "attribute"
+
+	FlagStartTime = "start"
+	FlagEndTime   = "end"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -39,6 +51,8 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMsgFeesProposal(),
 		GetUpdateNhashPerUsdMilProposal(),
 		GetUpdateConversionFeeDenomProposal(),
+		GetScheduleFeeCatalogProposal(),
+		GetFeeExemptionProposal(),
 	)
 
 	return txCmd
@@ -55,6 +69,8 @@ For add, update, and removal of msg fees amount and min fee and/or rate fee must
 `),
 		Example: fmt.Sprintf(`$ %[1]s tx msgfees add --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612nhash --recipient=pb... --bips=5000 --deposit 1000000000nhash
 $ %[1]s tx msgfees update --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612000nhash --recipient=pb... --bips=5000 --deposit 1000000000nhash
+$ %[1]s tx msgfees add --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612nhash --recipient-splits=pb...:5000,pb...:3000,:2000 --deposit 1000000000nhash
+$ %[1]s tx msgfees add --msg-type=/cosmwasm.wasm.v1.MsgExecuteContract --contract=pb... --method=transfer --additional-fee=612nhash --deposit 1000000000nhash
 $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --deposit 1000000000nhash
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -87,12 +103,32 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 				return err
 			}
 
-			if len(recipient) > 0 || len(bips) > 0 {
-				if err := types.ValidateBips(recipient, bips); err != nil {
+			splitsStr, err := flagSet.GetString(FlagRecipientSplits)
+			if err != nil {
+				return err
+			}
+
+			recipientSplits, err := ParseRecipientSplits(splitsStr)
+			if err != nil {
+				return err
+			}
+
+			if len(recipient) > 0 || len(bips) > 0 || len(recipientSplits) > 0 {
+				if err := types.ValidateFeeRecipients(recipient, bips, recipientSplits); err != nil {
 					return fmt.Errorf("error validating basis points args: %w", err)
 				}
 			}
 
+			contract, err := flagSet.GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+
+			method, err := flagSet.GetString(FlagMethod)
+			if err != nil {
+				return err
+			}
+
 			var addFee sdk.Coin
 			if proposalType != "remove" {
 				additionalFee, errMinFee := flagSet.GetString(FlagMinFee)
@@ -110,11 +146,17 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 			var msg sdk.Msg
 			switch args[0] {
 			case "add":
-				msg = types.NewMsgAddMsgFeeProposalRequest(msgType, addFee, recipient, bips, authority)
+				addMsg := types.NewMsgAddMsgFeeProposalRequest(msgType, addFee, recipient, bips, recipientSplits, authority)
+				addMsg.ContractAddress, addMsg.Method = contract, method
+				msg = addMsg
 			case "update":
-				msg = types.NewMsgUpdateMsgFeeProposalRequest(msgType, addFee, recipient, bips, authority)
+				updateMsg := types.NewMsgUpdateMsgFeeProposalRequest(msgType, addFee, recipient, bips, recipientSplits, authority)
+				updateMsg.ContractAddress, updateMsg.Method = contract, method
+				msg = updateMsg
 			case "remove":
-				msg = types.NewMsgRemoveMsgFeeProposalRequest(msgType, authority)
+				removeMsg := types.NewMsgRemoveMsgFeeProposalRequest(msgType, authority)
+				removeMsg.ContractAddress, removeMsg.Method = contract, method
+				msg = removeMsg
 			default:
 				return fmt.Errorf("unknown proposal type %q", args[0])
 			}
@@ -128,9 +170,37 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 	cmd.Flags().String(FlagMinFee, "", "additional fee for msg based fee")
 	cmd.Flags().String(FlagRecipient, "", "optional recipient address for receiving partial fee based on basis points")
 	cmd.Flags().String(FlagBips, "", "basis fee points to distribute to recipient")
+	cmd.Flags().String(FlagContract, "", "optional wasm contract address that the fee is specific to (requires a MsgExecuteContract msg type)")
+	cmd.Flags().String(FlagMethod, "", "optional contract method that the fee is specific to (requires a contract)")
+	cmd.Flags().String(FlagRecipientSplits, "", "optional comma separated list of <recipient>:<bips> entries to split the fee between (an empty recipient is the fee collector)")
 	return cmd
 }
 
+// ParseRecipientSplits parses a comma separated list of <recipient>:<bips> entries.
+// An empty string yields no splits.
+func ParseRecipientSplits(arg string) ([]types.RecipientSplit, error) {
+	if len(arg) == 0 {
+		return nil, nil
+	}
+	entries := strings.Split(arg, ",")
+	rv := make([]types.RecipientSplit, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid recipient split %q: expected format <recipient>:<bips>", entry)
+		}
+		bips, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient split %q bips: %w", entry, err)
+		}
+		rv = append(rv, types.RecipientSplit{
+			Recipient:   strings.TrimSpace(parts[0]),
+			BasisPoints: uint32(bips), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
+		})
+	}
+	return rv, nil
+}
+
 func GetUpdateNhashPerUsdMilProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "nhash-per-usd-mil <nhash-per-usd-mil>",
@@ -194,3 +264,102 @@ $ %[1]s tx msgfees cfd customcoin --deposit 1000000000nhash
 	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
+
+func GetScheduleFeeCatalogProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-catalog <catalog-json-file>",
+		Aliases: []string{"fc", "f-c"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to schedule a fee catalog along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a proposal to schedule a fee catalog along with an initial deposit.
+The file must contain a JSON FeeCatalog with its tiers, tier assignments, overrides, and effective time.
+Once the effective time has been reached, the msg fees of the previously active catalog are removed and
+the msg fees of the new catalog are put into effect.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees fee-catalog catalog.json --deposit 1000000000nhash
+$ %[1]s tx msgfees fc catalog.json --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("could not read fee catalog file: %w", err)
+			}
+			var catalog types.FeeCatalog
+			if err = clientCtx.Codec.UnmarshalJSON(bz, &catalog); err != nil {
+				return fmt.Errorf("could not parse fee catalog file: %w", err)
+			}
+
+			msg := types.NewMsgScheduleFeeCatalogProposalRequest(catalog, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+func GetFeeExemptionProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-exemption {set|remove} <name>",
+		Aliases: []string{"fe", "f-e"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to set or remove a fee exemption along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a proposal to set or remove a fee exemption along with an initial deposit.
+A fee exemption lets its addresses, and accounts with its attribute, skip the additional fees of its msg types.
+Setting an exemption replaces any existing exemption with the same name. The --msg-types flag is required for set,
+along with either --addresses or --attribute.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees fee-exemption set partners --msg-types=/cosmos.bank.v1beta1.MsgSend --addresses=pb...,pb... --deposit 1000000000nhash
+$ %[1]s tx msgfees fee-exemption set kyc --msg-types=/cosmos.bank.v1beta1.MsgSend,/provenance.marker.v1.MsgTransferRequest --attribute=kyc.partner.pb --deposit 1000000000nhash
+$ %[1]s tx msgfees fe remove partners --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			var msg sdk.Msg
+			switch args[0] {
+			case "set":
+				exemption := types.FeeExemption{Name: args[1]}
+				if exemption.MsgTypeUrls, err = flagSet.GetStringSlice(FlagMsgTypes); err != nil {
+					return err
+				}
+				for _, msgType := range exemption.MsgTypeUrls {
+					if _, err = clientCtx.InterfaceRegistry.Resolve(msgType); err != nil {
+						return err
+					}
+				}
+				if exemption.Addresses, err = flagSet.GetStringSlice(FlagAddresses); err != nil {
+					return err
+				}
+				if exemption.Attribute, err = flagSet.GetString(FlagAttribute); err != nil {
+					return err
+				}
+				msg = types.NewMsgSetFeeExemptionProposalRequest(exemption, authority)
+			case "remove":
+				msg = types.NewMsgRemoveFeeExemptionProposalRequest(args[1], authority)
+			default:
+				return fmt.Errorf("unknown fee exemption proposal type %q, expected set or remove", args[0])
+			}
+
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagMsgTypes, nil, "comma separated list of msg type urls that the exemption applies to")
+	cmd.Flags().StringSlice(FlagAddresses, nil, "comma separated list of addresses that are exempt")
+	cmd.Flags().String(FlagAttribute, "", "attribute name; accounts with this attribute are exempt")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmosauthtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// GetFeeAccrual returns a recipient's fee accrual for a msg type on a day. Returns nil if there isn't one.
+func (k Keeper) GetFeeAccrual(ctx sdk.Context, recipient sdk.AccAddress, day uint64, msgType string) (*types.FeeAccrual, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeAccrualKey(recipient, day, msgType))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.FeeAccrual
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// SetFeeAccrual records a fee accrual, replacing any existing one for the same recipient, day and msg type.
+func (k Keeper) SetFeeAccrual(ctx sdk.Context, accrual types.FeeAccrual) error {
+	recipient, err := sdk.AccAddressFromBech32(accrual.Recipient)
+	if err != nil {
+		return err
+	}
+	key := types.GetFeeAccrualKey(recipient, accrual.DayNumber(), accrual.MsgType)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&accrual))
+	return nil
+}
+
+// AccrueMsgFees adds collected additional fees to the current day's fee accruals.
+// The keys of the maps are the composite msg type and recipient keys (see types.GetCompositeKey).
+// Fees without a recipient are accrued to the fee collector.
+func (k Keeper) AccrueMsgFees(ctx sdk.Context, fees map[string]sdk.Coins, calls map[string]uint64) error {
+	day := types.FeeAccrualDay(ctx.BlockTime())
+	feeCollector := cosmosauthtypes.NewModuleAddress(k.feeCollectorName)
+	for _, compositeKey := range sortedKeys(fees) {
+		amount := fees[compositeKey]
+		if amount.IsZero() {
+			continue
+		}
+
+		msgType, recipientStr := types.SplitCompositeKey(compositeKey)
+		recipient := feeCollector
+		if len(recipientStr) > 0 {
+			var err error
+			recipient, err = sdk.AccAddressFromBech32(recipientStr)
+			if err != nil {
+				return err
+			}
+		}
+
+		accrual, err := k.GetFeeAccrual(ctx, recipient, day, msgType)
+		if err != nil {
+			return err
+		}
+		if accrual == nil {
+			accrual = &types.FeeAccrual{
+				Recipient:   recipient.String(),
+				MsgType:     msgType,
+				Day:         types.FeeAccrualDayStart(day),
+				FirstHeight: ctx.BlockHeight(),
+			}
+		}
+		accrual.Count += calls[compositeKey]
+		accrual.Total = accrual.Total.Add(amount...)
+		accrual.LastHeight = ctx.BlockHeight()
+		if err = k.SetFeeAccrual(ctx, *accrual); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFeeAccruals returns a recipient's fee accruals from the day of the start time through the day of the end time.
+// If a msg type is provided, only its accruals are returned. A nil start or end leaves that side of the range open.
+// The results are ordered by day, then msg type.
+func (k Keeper) GetFeeAccruals(ctx sdk.Context, recipient sdk.AccAddress, msgType string, startTime, endTime *time.Time) ([]types.FeeAccrual, error) {
+	start := types.GetFeeAccrualRecipientPrefix(recipient)
+	end := storetypes.PrefixEndBytes(start)
+	if startTime != nil {
+		start = types.GetFeeAccrualDayPrefix(recipient, types.FeeAccrualDay(*startTime))
+	}
+	if endTime != nil {
+		end = storetypes.PrefixEndBytes(types.GetFeeAccrualDayPrefix(recipient, types.FeeAccrualDay(*endTime)))
+	}
+
+	var rv []types.FeeAccrual
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var accrual types.FeeAccrual
+		if err := k.cdc.Unmarshal(iterator.Value(), &accrual); err != nil {
+			return nil, err
+		}
+		if len(msgType) == 0 || accrual.MsgType == msgType {
+			rv = append(rv, accrual)
+		}
+	}
+	return rv, nil
+}
+
+// IterateFeeAccruals iterates all fee accruals with the given handler function.
+func (k Keeper) IterateFeeAccruals(ctx sdk.Context, handle func(accrual types.FeeAccrual) (stop bool)) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FeeAccrualKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var accrual types.FeeAccrual
+		if err := k.cdc.Unmarshal(iterator.Value(), &accrual); err != nil {
+			return err
+		}
+		if handle(accrual) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllFeeAccruals returns all of the fee accruals.
+func (k Keeper) GetAllFeeAccruals(ctx sdk.Context) ([]types.FeeAccrual, error) {
+	var rv []types.FeeAccrual
+	err := k.IterateFeeAccruals(ctx, func(accrual types.FeeAccrual) bool {
+		rv = append(rv, accrual)
+		return false
+	})
+	return rv, err
+}
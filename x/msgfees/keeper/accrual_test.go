@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmosauthtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func (s *TestSuite) TestAccrueMsgFees() {
+	app, ctx := s.app, s.ctx
+	k := app.MsgFeesKeeper
+	recipient := s.addrs[0]
+	feeCollector := cosmosauthtypes.NewModuleAddress(k.GetFeeCollectorName())
+	msgType := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
+	otherMsgType := bankSendAuthMsgType
+
+	day1 := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	day3 := day1.AddDate(0, 0, 2)
+
+	ctx = ctx.WithBlockTime(day1.Add(3 * time.Hour)).WithBlockHeight(10)
+	s.Require().NoError(k.AccrueMsgFees(ctx,
+		map[string]sdk.Coins{
+			types.GetCompositeKey(msgType, recipient.String()): sdk.NewCoins(sdk.NewInt64Coin("hotdog", 5)),
+			types.GetCompositeKey(msgType, ""):                 sdk.NewCoins(sdk.NewInt64Coin("hotdog", 15)),
+		},
+		map[string]uint64{
+			types.GetCompositeKey(msgType, recipient.String()): 1,
+			types.GetCompositeKey(msgType, ""):                 1,
+		},
+	), "AccrueMsgFees at height 10")
+
+	ctx = ctx.WithBlockTime(day1.Add(20 * time.Hour)).WithBlockHeight(12)
+	s.Require().NoError(k.AccrueMsgFees(ctx,
+		map[string]sdk.Coins{
+			types.GetCompositeKey(msgType, recipient.String()):      sdk.NewCoins(sdk.NewInt64Coin("hotdog", 10)),
+			types.GetCompositeKey(otherMsgType, recipient.String()): sdk.NewCoins(sdk.NewInt64Coin("nhash", 3)),
+			types.GetCompositeKey(otherMsgType, ""):                 sdk.Coins{},
+		},
+		map[string]uint64{
+			types.GetCompositeKey(msgType, recipient.String()):      2,
+			types.GetCompositeKey(otherMsgType, recipient.String()): 1,
+			types.GetCompositeKey(otherMsgType, ""):                 1,
+		},
+	), "AccrueMsgFees at height 12")
+
+	ctx = ctx.WithBlockTime(day3.Add(time.Minute)).WithBlockHeight(30)
+	s.Require().NoError(k.AccrueMsgFees(ctx,
+		map[string]sdk.Coins{types.GetCompositeKey(msgType, recipient.String()): sdk.NewCoins(sdk.NewInt64Coin("hotdog", 7))},
+		map[string]uint64{types.GetCompositeKey(msgType, recipient.String()): 1},
+	), "AccrueMsgFees at height 30")
+
+	day1Msg := types.FeeAccrual{
+		Recipient: recipient.String(), MsgType: msgType, Day: day1, Count: 3,
+		Total: sdk.NewCoins(sdk.NewInt64Coin("hotdog", 15)), FirstHeight: 10, LastHeight: 12,
+	}
+	day1Other := types.FeeAccrual{
+		Recipient: recipient.String(), MsgType: otherMsgType, Day: day1, Count: 1,
+		Total: sdk.NewCoins(sdk.NewInt64Coin("nhash", 3)), FirstHeight: 12, LastHeight: 12,
+	}
+	day3Msg := types.FeeAccrual{
+		Recipient: recipient.String(), MsgType: msgType, Day: day3, Count: 1,
+		Total: sdk.NewCoins(sdk.NewInt64Coin("hotdog", 7)), FirstHeight: 30, LastHeight: 30,
+	}
+	collectorMsg := types.FeeAccrual{
+		Recipient: feeCollector.String(), MsgType: msgType, Day: day1, Count: 1,
+		Total: sdk.NewCoins(sdk.NewInt64Coin("hotdog", 15)), FirstHeight: 10, LastHeight: 10,
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryFeeAccrualsRequest
+		expected []types.FeeAccrual
+		errorMsg string
+	}{
+		{
+			name:     "all for recipient",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: recipient.String()},
+			expected: []types.FeeAccrual{day1Other, day1Msg, day3Msg},
+		},
+		{
+			name:     "one msg type",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: recipient.String(), MsgType: msgType},
+			expected: []types.FeeAccrual{day1Msg, day3Msg},
+		},
+		{
+			name:     "start time mid day",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: recipient.String(), StartTime: timePtr(day1.Add(23 * time.Hour))},
+			expected: []types.FeeAccrual{day1Other, day1Msg, day3Msg},
+		},
+		{
+			name:     "start and end time on later days",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: recipient.String(), StartTime: &day2, EndTime: timePtr(day3.Add(time.Hour))},
+			expected: []types.FeeAccrual{day3Msg},
+		},
+		{
+			name:     "end time on day without fees",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: recipient.String(), MsgType: otherMsgType, StartTime: &day2, EndTime: &day2},
+			expected: nil,
+		},
+		{
+			name:     "fee collector",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: feeCollector.String()},
+			expected: []types.FeeAccrual{collectorMsg},
+		},
+		{
+			name:     "invalid recipient",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: "notanaddress"},
+			errorMsg: `invalid recipient "notanaddress"`,
+		},
+		{
+			name:     "end before start",
+			req:      &types.QueryFeeAccrualsRequest{Recipient: recipient.String(), StartTime: &day2, EndTime: &day1},
+			errorMsg: "end time cannot be before start time",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := k.FeeAccruals(ctx, tc.req)
+			if len(tc.errorMsg) > 0 {
+				s.Require().ErrorContains(err, tc.errorMsg, "FeeAccruals error")
+				return
+			}
+			s.Require().NoError(err, "FeeAccruals error")
+			s.Assert().Equal(tc.expected, resp.Accruals, "FeeAccruals accruals")
+			expCount := uint64(0)
+			expTotal := sdk.Coins{}
+			for _, accrual := range tc.expected {
+				expCount += accrual.Count
+				expTotal = expTotal.Add(accrual.Total...)
+			}
+			s.Assert().Equal(expCount, resp.Count, "FeeAccruals count")
+			s.Assert().Equal(expTotal.String(), resp.Total.String(), "FeeAccruals total")
+		})
+	}
+
+	genState := k.ExportGenesis(ctx)
+	s.Assert().ElementsMatch([]types.FeeAccrual{day1Msg, day1Other, day3Msg, collectorMsg}, genState.FeeAccruals, "exported fee accruals")
+	s.Assert().NoError(genState.Validate(), "exported genesis Validate")
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// getFeeCatalog reads the fee catalog stored under the provided key. Returns nil if there isn't one.
+func (k Keeper) getFeeCatalog(ctx sdk.Context, key []byte) (*types.FeeCatalog, error) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.FeeCatalog
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// setFeeCatalog writes the provided fee catalog under the provided key, or deletes the entry if it's nil.
+func (k Keeper) setFeeCatalog(ctx sdk.Context, key []byte, catalog *types.FeeCatalog) {
+	store := ctx.KVStore(k.storeKey)
+	if catalog == nil {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(catalog))
+}
+
+// GetActiveFeeCatalog returns the fee catalog currently in effect. Returns nil if there isn't one.
+func (k Keeper) GetActiveFeeCatalog(ctx sdk.Context) (*types.FeeCatalog, error) {
+	return k.getFeeCatalog(ctx, types.ActiveFeeCatalogKey)
+}
+
+// SetActiveFeeCatalog records the fee catalog currently in effect.
+// This only records the catalog, it does not update any msg fees.
+func (k Keeper) SetActiveFeeCatalog(ctx sdk.Context, catalog *types.FeeCatalog) {
+	k.setFeeCatalog(ctx, types.ActiveFeeCatalogKey, catalog)
+}
+
+// GetScheduledFeeCatalog returns the fee catalog waiting for its effective time. Returns nil if there isn't one.
+func (k Keeper) GetScheduledFeeCatalog(ctx sdk.Context) (*types.FeeCatalog, error) {
+	return k.getFeeCatalog(ctx, types.ScheduledFeeCatalogKey)
+}
+
+// SetScheduledFeeCatalog records the fee catalog waiting for its effective time, replacing any previously scheduled one.
+func (k Keeper) SetScheduledFeeCatalog(ctx sdk.Context, catalog *types.FeeCatalog) {
+	k.setFeeCatalog(ctx, types.ScheduledFeeCatalogKey, catalog)
+}
+
+// ScheduleFeeCatalog validates the provided fee catalog and schedules it, replacing any previously scheduled one.
+// The catalog's effective time must be after the current block time.
+func (k Keeper) ScheduleFeeCatalog(ctx sdk.Context, catalog types.FeeCatalog) error {
+	if err := catalog.Validate(); err != nil {
+		return types.ErrInvalidFeeProposal.Wrap(err.Error())
+	}
+	if !catalog.EffectiveTime.After(ctx.BlockTime()) {
+		return types.ErrInvalidFeeProposal.Wrapf("fee catalog effective time %s must be after the current block time %s",
+			catalog.EffectiveTime.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+	}
+	k.SetScheduledFeeCatalog(ctx, &catalog)
+	return nil
+}
+
+// ProcessScheduledFeeCatalog puts the scheduled fee catalog into effect if its effective time has been reached.
+// If that fails, nothing it did is kept, the error is logged, and the scheduled catalog is dropped
+// so that it isn't retried every block.
+func (k Keeper) ProcessScheduledFeeCatalog(ctx sdk.Context) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ActivateScheduledFeeCatalog(cacheCtx); err != nil {
+		k.Logger(ctx).Error("could not activate scheduled fee catalog, dropping it", "error", err)
+		k.SetScheduledFeeCatalog(ctx, nil)
+		return
+	}
+	writeCache()
+}
+
+// ActivateScheduledFeeCatalog puts the scheduled fee catalog into effect if its effective time has been reached.
+// The msg fees of the previously active catalog are replaced by the msg fees of the new one.
+// A msg fee that was set some other way (e.g. by a governance proposal after the previous catalog was activated)
+// is neither removed nor replaced; it stays in effect as a per-msg override of the catalog.
+func (k Keeper) ActivateScheduledFeeCatalog(ctx sdk.Context) error {
+	scheduled, err := k.GetScheduledFeeCatalog(ctx)
+	if err != nil {
+		return fmt.Errorf("could not read scheduled fee catalog: %w", err)
+	}
+	if scheduled == nil || ctx.BlockTime().Before(scheduled.EffectiveTime) {
+		return nil
+	}
+
+	active, err := k.GetActiveFeeCatalog(ctx)
+	if err != nil {
+		return fmt.Errorf("could not read active fee catalog: %w", err)
+	}
+	var prevMsgFees []types.MsgFee
+	if active != nil {
+		prevMsgFees = active.MsgFees()
+	}
+	prevFees := make(map[string]types.MsgFee, len(prevMsgFees))
+	for _, msgFee := range prevMsgFees {
+		prevFees[msgFee.KeyName()] = msgFee
+	}
+
+	// isCatalogFee returns true if the msg fee currently stored for the key is missing or came from the previous catalog.
+	isCatalogFee := func(key string) (bool, error) {
+		existing, err := k.GetMsgFee(ctx, key)
+		if err != nil || existing == nil {
+			return existing == nil, err
+		}
+		prev, found := prevFees[key]
+		return found && k.msgFeesEqual(*existing, prev), nil
+	}
+
+	newKeys := make(map[string]bool)
+	for _, msgFee := range scheduled.MsgFees() {
+		key := msgFee.KeyName()
+		newKeys[key] = true
+		ok, err := isCatalogFee(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			k.Logger(ctx).Info("keeping msg fee that was not set by the fee catalog", "msg_type", key)
+			continue
+		}
+		if err = k.SetMsgFee(ctx, msgFee); err != nil {
+			return err
+		}
+	}
+
+	for _, msgFee := range prevMsgFees {
+		key := msgFee.KeyName()
+		if newKeys[key] {
+			continue
+		}
+		ok, err := isCatalogFee(key)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			k.Logger(ctx).Info("keeping msg fee that was not set by the fee catalog", "msg_type", key)
+			continue
+		}
+		err = k.RemoveMsgFee(ctx, key)
+		if err != nil && !types.ErrMsgFeeDoesNotExist.Is(err) {
+			return err
+		}
+	}
+
+	k.SetActiveFeeCatalog(ctx, scheduled)
+	k.SetScheduledFeeCatalog(ctx, nil)
+	k.Logger(ctx).Info("fee catalog activated", "effective_time", scheduled.EffectiveTime)
+	return nil
+}
+
+// msgFeesEqual returns true if the two msg fees are the same.
+func (k Keeper) msgFeesEqual(a, b types.MsgFee) bool {
+	return bytes.Equal(k.cdc.MustMarshal(&a), k.cdc.MustMarshal(&b))
+}
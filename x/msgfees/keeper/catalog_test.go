@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func (s *TestSuite) TestActivateScheduledFeeCatalog() {
+	app, ctx := s.app, s.ctx
+	k := app.MsgFeesKeeper
+	msgType := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
+	otherMsgType := sdk.MsgTypeURL(&types.MsgAddMsgFeeProposalRequest{})
+
+	first := types.FeeCatalog{
+		Tiers:         []types.FeeTier{{Name: "low", AdditionalFee: sdk.NewInt64Coin("hotdog", 100)}},
+		Assignments:   []types.FeeTierAssignment{{MsgTypeUrl: msgType, Tier: "low"}},
+		EffectiveTime: ctx.BlockTime().Add(time.Hour),
+	}
+	s.Require().NoError(k.ScheduleFeeCatalog(ctx, first), "ScheduleFeeCatalog(first)")
+
+	s.Require().NoError(k.ActivateScheduledFeeCatalog(ctx), "ActivateScheduledFeeCatalog before effective time")
+	active, err := k.GetActiveFeeCatalog(ctx)
+	s.Require().NoError(err, "GetActiveFeeCatalog")
+	s.Assert().Nil(active, "active catalog before effective time")
+	msgFee, err := k.GetMsgFee(ctx, msgType)
+	s.Require().NoError(err, "GetMsgFee before effective time")
+	s.Assert().Nil(msgFee, "msg fee before effective time")
+
+	ctx = ctx.WithBlockTime(first.EffectiveTime)
+	s.Require().NoError(k.ActivateScheduledFeeCatalog(ctx), "ActivateScheduledFeeCatalog(first)")
+	active, err = k.GetActiveFeeCatalog(ctx)
+	s.Require().NoError(err, "GetActiveFeeCatalog")
+	s.Assert().Equal(&first, active, "active catalog")
+	scheduled, err := k.GetScheduledFeeCatalog(ctx)
+	s.Require().NoError(err, "GetScheduledFeeCatalog")
+	s.Assert().Nil(scheduled, "scheduled catalog")
+	msgFee, err = k.GetMsgFee(ctx, msgType)
+	s.Require().NoError(err, "GetMsgFee(%q)", msgType)
+	s.Require().NotNil(msgFee, "GetMsgFee(%q)", msgType)
+	s.Assert().Equal("100hotdog", msgFee.AdditionalFee.String(), "msg fee from tier")
+
+	second := types.FeeCatalog{
+		Overrides:     []types.MsgFee{types.NewMsgFee(otherMsgType, sdk.NewInt64Coin("hotdog", 7), "", 0)},
+		EffectiveTime: first.EffectiveTime.Add(time.Hour),
+	}
+	s.Require().NoError(k.ScheduleFeeCatalog(ctx, second), "ScheduleFeeCatalog(second)")
+	ctx = ctx.WithBlockTime(second.EffectiveTime.Add(time.Minute))
+	s.Require().NoError(k.ActivateScheduledFeeCatalog(ctx), "ActivateScheduledFeeCatalog(second)")
+
+	msgFee, err = k.GetMsgFee(ctx, msgType)
+	s.Require().NoError(err, "GetMsgFee(%q)", msgType)
+	s.Assert().Nil(msgFee, "msg fee from previous catalog")
+	msgFee, err = k.GetMsgFee(ctx, otherMsgType)
+	s.Require().NoError(err, "GetMsgFee(%q)", otherMsgType)
+	s.Require().NotNil(msgFee, "GetMsgFee(%q)", otherMsgType)
+	s.Assert().Equal("7hotdog", msgFee.AdditionalFee.String(), "msg fee from override")
+
+	resp, err := s.queryClient.FeeCatalog(ctx, &types.QueryFeeCatalogRequest{})
+	s.Require().NoError(err, "FeeCatalog query")
+	s.Assert().Equal(&second, resp.Active, "FeeCatalog query active")
+	s.Assert().Nil(resp.Scheduled, "FeeCatalog query scheduled")
+}
+
+func (s *TestSuite) TestScheduleFeeCatalogInvalid() {
+	err := s.app.MsgFeesKeeper.ScheduleFeeCatalog(s.ctx, types.FeeCatalog{})
+	s.Require().EqualError(err, "fee catalog effective time cannot be empty: invalid fee proposal")
+
+	for _, effectiveTime := range []time.Time{s.ctx.BlockTime(), s.ctx.BlockTime().Add(-time.Hour)} {
+		err = s.app.MsgFeesKeeper.ScheduleFeeCatalog(s.ctx, types.FeeCatalog{EffectiveTime: effectiveTime})
+		s.Assert().ErrorContains(err, "must be after the current block time", "ScheduleFeeCatalog(%s)", effectiveTime)
+	}
+	scheduled, err := s.app.MsgFeesKeeper.GetScheduledFeeCatalog(s.ctx)
+	s.Require().NoError(err, "GetScheduledFeeCatalog")
+	s.Assert().Nil(scheduled, "scheduled catalog")
+}
+
+func (s *TestSuite) TestActivateScheduledFeeCatalogKeepsOverrides() {
+	app, ctx := s.app, s.ctx
+	k := app.MsgFeesKeeper
+	msgType := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
+	otherMsgType := sdk.MsgTypeURL(&types.MsgAddMsgFeeProposalRequest{})
+	removedMsgType := sdk.MsgTypeURL(&types.MsgRemoveMsgFeeProposalRequest{})
+
+	first := types.FeeCatalog{
+		Tiers: []types.FeeTier{{Name: "low", AdditionalFee: sdk.NewInt64Coin("hotdog", 100)}},
+		Assignments: []types.FeeTierAssignment{
+			{MsgTypeUrl: msgType, Tier: "low"},
+			{MsgTypeUrl: otherMsgType, Tier: "low"},
+			{MsgTypeUrl: removedMsgType, Tier: "low"},
+		},
+		EffectiveTime: ctx.BlockTime().Add(time.Hour),
+	}
+	s.Require().NoError(k.ScheduleFeeCatalog(ctx, first), "ScheduleFeeCatalog(first)")
+	ctx = ctx.WithBlockTime(first.EffectiveTime)
+	s.Require().NoError(k.ActivateScheduledFeeCatalog(ctx), "ActivateScheduledFeeCatalog(first)")
+
+	// Governance overrides two of the catalog's fees.
+	s.Require().NoError(k.SetMsgFee(ctx, types.NewMsgFee(msgType, sdk.NewInt64Coin("hotdog", 55), "", 0)), "SetMsgFee(%q)", msgType)
+	s.Require().NoError(k.SetMsgFee(ctx, types.NewMsgFee(removedMsgType, sdk.NewInt64Coin("hotdog", 56), "", 0)), "SetMsgFee(%q)", removedMsgType)
+
+	second := types.FeeCatalog{
+		Tiers: []types.FeeTier{{Name: "high", AdditionalFee: sdk.NewInt64Coin("hotdog", 500)}},
+		Assignments: []types.FeeTierAssignment{
+			{MsgTypeUrl: msgType, Tier: "high"},
+			{MsgTypeUrl: otherMsgType, Tier: "high"},
+		},
+		EffectiveTime: first.EffectiveTime.Add(time.Hour),
+	}
+	s.Require().NoError(k.ScheduleFeeCatalog(ctx, second), "ScheduleFeeCatalog(second)")
+	ctx = ctx.WithBlockTime(second.EffectiveTime)
+	s.Require().NoError(k.ActivateScheduledFeeCatalog(ctx), "ActivateScheduledFeeCatalog(second)")
+
+	expFees := map[string]string{
+		msgType:        "55hotdog",
+		otherMsgType:   "500hotdog",
+		removedMsgType: "56hotdog",
+	}
+	for key, exp := range expFees {
+		msgFee, err := k.GetMsgFee(ctx, key)
+		s.Require().NoError(err, "GetMsgFee(%q)", key)
+		if s.Assert().NotNil(msgFee, "GetMsgFee(%q)", key) {
+			s.Assert().Equal(exp, msgFee.AdditionalFee.String(), "GetMsgFee(%q) additional fee", key)
+		}
+	}
+}
+
+func (s *TestSuite) TestProcessScheduledFeeCatalogError() {
+	app, ctx := s.app, s.ctx
+	k := app.MsgFeesKeeper
+	msgType := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
+	badMsgType := sdk.MsgTypeURL(&types.MsgAddMsgFeeProposalRequest{})
+
+	catalog := types.FeeCatalog{
+		Tiers: []types.FeeTier{{Name: "low", AdditionalFee: sdk.NewInt64Coin("hotdog", 100)}},
+		Assignments: []types.FeeTierAssignment{
+			{MsgTypeUrl: msgType, Tier: "low"},
+			{MsgTypeUrl: badMsgType, Tier: "low"},
+		},
+		EffectiveTime: ctx.BlockTime().Add(time.Hour),
+	}
+	s.Require().NoError(k.ScheduleFeeCatalog(ctx, catalog), "ScheduleFeeCatalog")
+	// A msg fee that can't be read makes the activation fail after the first msg fee was already set.
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.GetMsgFeeKey(badMsgType), []byte{0xff, 0xff})
+
+	ctx = ctx.WithBlockTime(catalog.EffectiveTime)
+	s.Require().NotPanics(func() { k.ProcessScheduledFeeCatalog(ctx) }, "ProcessScheduledFeeCatalog")
+
+	msgFee, err := k.GetMsgFee(ctx, msgType)
+	s.Require().NoError(err, "GetMsgFee(%q)", msgType)
+	s.Assert().Nil(msgFee, "msg fee set before the failure")
+	active, err := k.GetActiveFeeCatalog(ctx)
+	s.Require().NoError(err, "GetActiveFeeCatalog")
+	s.Assert().Nil(active, "active catalog")
+	scheduled, err := k.GetScheduledFeeCatalog(ctx)
+	s.Require().NoError(err, "GetScheduledFeeCatalog")
+	s.Assert().Nil(scheduled, "scheduled catalog")
+}
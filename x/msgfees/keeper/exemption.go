@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// SetFeeExemption adds a fee exemption, or replaces the one with the same name.
+func (k Keeper) SetFeeExemption(ctx sdk.Context, exemption types.FeeExemption) {
+	ctx.KVStore(k.storeKey).Set(types.GetFeeExemptionKey(exemption.Name), k.cdc.MustMarshal(&exemption))
+}
+
+// GetFeeExemption returns the fee exemption with the provided name. Returns nil if there isn't one.
+func (k Keeper) GetFeeExemption(ctx sdk.Context, name string) (*types.FeeExemption, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeeExemptionKey(name))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.FeeExemption
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// RemoveFeeExemption removes a fee exemption or returns an error if it does not exist.
+func (k Keeper) RemoveFeeExemption(ctx sdk.Context, name string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeExemptionKey(name)
+	if !store.Has(key) {
+		return types.ErrFeeExemptionDoesNotExist.Wrapf("name %q", name)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// IterateFeeExemptions iterates all fee exemptions with the given handler function.
+func (k Keeper) IterateFeeExemptions(ctx sdk.Context, handle func(exemption types.FeeExemption) (stop bool)) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FeeExemptionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var exemption types.FeeExemption
+		if err := k.cdc.Unmarshal(iterator.Value(), &exemption); err != nil {
+			return err
+		}
+		if handle(exemption) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllFeeExemptions returns all of the fee exemptions.
+func (k Keeper) GetAllFeeExemptions(ctx sdk.Context) ([]types.FeeExemption, error) {
+	var rv []types.FeeExemption
+	err := k.IterateFeeExemptions(ctx, func(exemption types.FeeExemption) bool {
+		rv = append(rv, exemption)
+		return false
+	})
+	return rv, err
+}
+
+// IsExemptFromMsgFee returns true if every signer of the msg is exempt from the additional fee of its msg type,
+// either by being listed in a fee exemption for it, or by having the attribute of such an exemption.
+func (k Keeper) IsExemptFromMsgFee(ctx sdk.Context, msg sdk.Msg) (bool, error) {
+	var exemptions []types.FeeExemption
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	err := k.IterateFeeExemptions(ctx, func(exemption types.FeeExemption) bool {
+		if exemption.AppliesTo(msgTypeURL) {
+			exemptions = append(exemptions, exemption)
+		}
+		return false
+	})
+	if err != nil || len(exemptions) == 0 {
+		return false, err
+	}
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return false, fmt.Errorf("could not get signers of %s: %w", msgTypeURL, err)
+	}
+	if len(signers) == 0 {
+		return false, nil
+	}
+	for _, signer := range signers {
+		exempt, err := k.isExemptSigner(ctx, sdk.AccAddress(signer).String(), exemptions)
+		if err != nil || !exempt {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// isExemptSigner returns true if the address is covered by any of the provided fee exemptions.
+func (k Keeper) isExemptSigner(ctx sdk.Context, addr string, exemptions []types.FeeExemption) (bool, error) {
+	for _, exemption := range exemptions {
+		if exemption.HasAddress(addr) {
+			return true, nil
+		}
+		if len(exemption.Attribute) == 0 || k.attributeKeeper == nil {
+			continue
+		}
+		attrs, err := k.attributeKeeper.GetAttributes(ctx, addr, exemption.Attribute)
+		if err != nil {
+			return false, fmt.Errorf("could not get %q attributes of %s: %w", exemption.Attribute, addr, err)
+		}
+		if len(attrs) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
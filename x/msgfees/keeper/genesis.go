@@ -17,7 +17,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateMsgFees(ctx, msgFeeRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, msgFees)
+	rv := types.NewGenesisState(params, msgFees)
+
+	var err error
+	if rv.ActiveFeeCatalog, err = k.GetActiveFeeCatalog(ctx); err != nil {
+		panic(err)
+	}
+	if rv.ScheduledFeeCatalog, err = k.GetScheduledFeeCatalog(ctx); err != nil {
+		panic(err)
+	}
+	if rv.FeeExemptions, err = k.GetAllFeeExemptions(ctx); err != nil {
+		panic(err)
+	}
+	if rv.FeeAccruals, err = k.GetAllFeeAccruals(ctx); err != nil {
+		panic(err)
+	}
+	return rv
 }
 
 // InitGenesis new msgfees genesis
@@ -32,4 +47,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	k.SetActiveFeeCatalog(ctx, data.ActiveFeeCatalog)
+	k.SetScheduledFeeCatalog(ctx, data.ScheduledFeeCatalog)
+	for _, exemption := range data.FeeExemptions {
+		k.SetFeeExemption(ctx, exemption)
+	}
+	for _, accrual := range data.FeeAccruals {
+		if err := k.SetFeeAccrual(ctx, accrual); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strconv"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"golang.org/x/exp/constraints"
 
 	"cosmossdk.io/log"
@@ -19,6 +21,7 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
 
@@ -29,19 +32,21 @@ type baseAppSimulateFunc func(txBytes []byte) (sdk.GasInfo, *sdk.Result, sdk.Con
 // Keeper of the Additional fee store
 type Keeper struct {
 	storeKey         storetypes.StoreKey
-	cdc              codec.BinaryCodec
+	cdc              codec.Codec
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 	defaultFeeDenom  string
 	simulateFunc     baseAppSimulateFunc
 	txDecoder        sdk.TxDecoder
 	registry         cdctypes.InterfaceRegistry
 	authority        string
+	markerKeeper     types.MarkerKeeper
+	attributeKeeper  types.AttributeKeeper
 }
 
 // NewKeeper returns a AdditionalFeeKeeper. It handles:
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	key storetypes.StoreKey,
 	feeCollectorName string,
 	defaultFeeDenom string,
@@ -61,6 +66,22 @@ func NewKeeper(
 	}
 }
 
+// SetMarkerKeeper sets the marker keeper used to look up the usd net asset value of the conversion fee denom.
+func (k *Keeper) SetMarkerKeeper(mk types.MarkerKeeper) {
+	if k.markerKeeper != nil && mk != nil && k.markerKeeper != mk {
+		panic("the marker keeper has already been set")
+	}
+	k.markerKeeper = mk
+}
+
+// SetAttributeKeeper sets the attribute keeper used to look up the attributes that fee exemptions are based on.
+func (k *Keeper) SetAttributeKeeper(ak types.AttributeKeeper) {
+	if k.attributeKeeper != nil && ak != nil && k.attributeKeeper != ak {
+		panic("the attribute keeper has already been set")
+	}
+	k.attributeKeeper = ak
+}
+
 // GetAuthority is signer of the proposal
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -79,11 +100,12 @@ func (k Keeper) GetFeeCollectorName() string {
 func (k Keeper) SetMsgFee(ctx sdk.Context, msgFees types.MsgFee) error {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&msgFees)
-	store.Set(types.GetMsgFeeKey(msgFees.MsgTypeUrl), bz)
+	store.Set(types.GetMsgFeeKey(msgFees.KeyName()), bz)
 	return nil
 }
 
-// GetMsgFee returns a MsgFee for the msg type if it exists nil if it does not
+// GetMsgFee returns a MsgFee for the msg type if it exists nil if it does not.
+// For a contract specific fee, the msgType should be the result of types.MsgFeeKeyName.
 func (k Keeper) GetMsgFee(ctx sdk.Context, msgType string) (*types.MsgFee, error) {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetMsgFeeKey(msgType)
@@ -100,6 +122,43 @@ func (k Keeper) GetMsgFee(ctx sdk.Context, msgType string) (*types.MsgFee, error
 	return &msgFee, nil
 }
 
+// GetContractMsgFee returns the MsgFee specific to the provided contract and method if it exists.
+// If there isn't one for the method, the one for just the contract is returned (if it exists).
+func (k Keeper) GetContractMsgFee(ctx sdk.Context, contractAddress, method string) (*types.MsgFee, error) {
+	if len(method) > 0 {
+		rv, err := k.GetMsgFee(ctx, types.MsgFeeKeyName(types.ExecuteContractMsgTypeURL, contractAddress, method))
+		if err != nil || rv != nil {
+			return rv, err
+		}
+	}
+	return k.GetMsgFee(ctx, types.MsgFeeKeyName(types.ExecuteContractMsgTypeURL, contractAddress, ""))
+}
+
+// getMsgFeeForMsg returns the MsgFee that applies to the provided msg if it exists.
+// For a MsgExecuteContract, a fee specific to the contract (and method) takes precedence over the msg type's fee.
+func (k Keeper) getMsgFeeForMsg(ctx sdk.Context, msg sdk.Msg) (*types.MsgFee, error) {
+	if execMsg, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+		rv, err := k.GetContractMsgFee(ctx, execMsg.Contract, getContractMethod(execMsg.Msg))
+		if err != nil || rv != nil {
+			return rv, err
+		}
+	}
+	return k.GetMsgFee(ctx, sdk.MsgTypeURL(msg))
+}
+
+// getContractMethod returns the method being invoked by a contract execute msg,
+// i.e. its only top-level key. Returns an empty string if it can't be determined.
+func getContractMethod(execMsg []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(execMsg, &fields); err != nil || len(fields) != 1 {
+		return ""
+	}
+	for method := range fields {
+		return method
+	}
+	return ""
+}
+
 // RemoveMsgFee removes MsgFee or returns an error if it does not exist
 func (k Keeper) RemoveMsgFee(ctx sdk.Context, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
@@ -177,13 +236,22 @@ func (k Keeper) DeductFeesDistributions(bankKeeper bankkeeper.Keeper, ctx sdk.Co
 }
 
 // ConvertDenomToHash converts usd coin to nhash coin using nhash per usd mil.
-// Currently, usd is only supported with nhash to usd mil coming from params
+// If the conversion denom's marker has a usd net asset value, that is used for the rate, but the result
+// is kept within MaxUsdNavDeviationBips of what the nhash per usd mil param gives.
+// Otherwise, the nhash per usd mil param is used.
 func (k Keeper) ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error) {
 	conversionDenom := k.GetConversionFeeDenom(ctx)
 	switch coin.Denom {
 	case types.UsdDenom:
 		nhashPerMil := sdkmath.NewIntFromUint64(k.GetNhashPerUsdMil(ctx))
 		amount := coin.Amount.Mul(nhashPerMil)
+		if nav := k.getUsdNetAssetValue(ctx, conversionDenom); nav != nil {
+			// The net asset value can only move the amount so far from what the param gives.
+			navAmount := coin.Amount.Mul(sdkmath.NewIntFromUint64(nav.Volume)).Quo(nav.Price.Amount)
+			minAmount := amount.MulRaw(10_000 - types.MaxUsdNavDeviationBips).QuoRaw(10_000)
+			maxAmount := amount.MulRaw(10_000 + types.MaxUsdNavDeviationBips).QuoRaw(10_000)
+			amount = sdkmath.MaxInt(minAmount, sdkmath.MinInt(maxAmount, navAmount))
+		}
 		msgFeeCoin := sdk.NewCoin(conversionDenom, amount)
 		return msgFeeCoin, nil
 	case conversionDenom:
@@ -193,7 +261,26 @@ func (k Keeper) ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, er
 	}
 }
 
+// getUsdNetAssetValue gets the usd net asset value of the provided marker denom.
+// Returns nil if there isn't a marker keeper, there isn't a usd net asset value, or it can't be used.
+// The price of a usd net asset value is in usd mils.
+func (k Keeper) getUsdNetAssetValue(ctx sdk.Context, denom string) *markertypes.NetAssetValue {
+	if k.markerKeeper == nil {
+		return nil
+	}
+	nav, err := k.markerKeeper.GetNetAssetValue(ctx, denom, types.UsdDenom)
+	if err != nil {
+		k.Logger(ctx).Error("could not get usd net asset value", "denom", denom, "error", err)
+		return nil
+	}
+	if nav == nil || nav.Volume == 0 || !nav.Price.Amount.IsPositive() {
+		return nil
+	}
+	return nav
+}
+
 // CalculateAdditionalFeesToBePaid computes the additional fees to be paid for the provided messages.
+// The msg fee of a message is skipped if all of its signers are exempt from it (see IsExemptFromMsgFee).
 func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (types.MsgFeesDistribution, error) {
 	msgFeesDistribution := types.MsgFeesDistribution{
 		RecipientDistributions: make(map[string]sdk.Coins),
@@ -201,12 +288,35 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 	assessCustomMsgTypeURL := sdk.MsgTypeURL(&types.MsgAssessCustomMsgFeeRequest{})
 	for _, msg := range msgs {
 		typeURL := sdk.MsgTypeURL(msg)
-		msgFees, err := k.GetMsgFee(ctx, typeURL)
+		msgFees, err := k.getMsgFeeForMsg(ctx, msg)
 		if err != nil {
 			return msgFeesDistribution, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 		}
 
 		if msgFees != nil {
+			exempt, err := k.IsExemptFromMsgFee(ctx, msg)
+			if err != nil {
+				return msgFeesDistribution, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+			if exempt {
+				msgFees = nil
+			}
+		}
+
+		if msgFees != nil && msgFees.AdditionalFee.Denom == types.UsdDenom {
+			msgFees.AdditionalFee, err = k.ConvertDenomToHash(ctx, msgFees.AdditionalFee)
+			if err != nil {
+				return msgFeesDistribution, err
+			}
+		}
+
+		switch {
+		case msgFees == nil:
+		case len(msgFees.RecipientSplits) > 0:
+			if err := msgFeesDistribution.IncreaseWithSplits(msgFees.AdditionalFee, msgFees.RecipientSplits); err != nil {
+				return msgFeesDistribution, err
+			}
+		default:
 			if err := msgFeesDistribution.Increase(msgFees.AdditionalFee, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
 				return msgFeesDistribution, err
 			}
@@ -247,24 +357,28 @@ func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 }
 
 // AddMsgFee adds a new msg fees
-func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin) error {
+func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, contractAddress, method, recipient, basisPoints string, recipientSplits []types.RecipientSplit, additionalFee sdk.Coin) error {
 	if msgTypeURL == "" {
 		return types.ErrEmptyMsgType
 	}
 
-	existing, err := k.GetMsgFee(ctx, msgTypeURL)
+	if err := types.ValidateContractFee(msgTypeURL, contractAddress, method); err != nil {
+		return err
+	}
+
+	existing, err := k.GetMsgFee(ctx, types.MsgFeeKeyName(msgTypeURL, contractAddress, method))
 	if err != nil {
 		return err
 	}
 	if existing != nil {
 		return types.ErrMsgFeeAlreadyExists
 	}
-	bips, err := DetermineBips(recipient, basisPoints)
+	msgFees, err := newMsgFee(msgTypeURL, recipient, basisPoints, recipientSplits, additionalFee)
 	if err != nil {
 		return err
 	}
-
-	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips)
+	msgFees.ContractAddress = contractAddress
+	msgFees.Method = method
 
 	err = k.SetMsgFee(ctx, msgFees)
 	if err != nil {
@@ -275,24 +389,28 @@ func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints st
 }
 
 // UpdateMsgFee updates  an existing msg fees
-func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin) error {
+func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, contractAddress, method, recipient, basisPoints string, recipientSplits []types.RecipientSplit, additionalFee sdk.Coin) error {
 	if msgTypeURL == "" {
 		return types.ErrEmptyMsgType
 	}
 
-	existing, err := k.GetMsgFee(ctx, msgTypeURL)
+	if err := types.ValidateContractFee(msgTypeURL, contractAddress, method); err != nil {
+		return err
+	}
+
+	existing, err := k.GetMsgFee(ctx, types.MsgFeeKeyName(msgTypeURL, contractAddress, method))
 	if err != nil {
 		return err
 	}
 	if existing == nil {
 		return types.ErrMsgFeeDoesNotExist
 	}
-	bips, err := DetermineBips(recipient, basisPoints)
+	msgFees, err := newMsgFee(msgTypeURL, recipient, basisPoints, recipientSplits, additionalFee)
 	if err != nil {
 		return err
 	}
-
-	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips)
+	msgFees.ContractAddress = contractAddress
+	msgFees.Method = method
 
 	err = k.SetMsgFee(ctx, msgFees)
 	if err != nil {
@@ -302,6 +420,24 @@ func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints
 	return nil
 }
 
+// newMsgFee creates a MsgFee that either uses the provided recipient and basis points, or the recipient splits.
+func newMsgFee(msgTypeURL, recipient, basisPoints string, recipientSplits []types.RecipientSplit, additionalFee sdk.Coin) (types.MsgFee, error) {
+	if len(recipientSplits) > 0 {
+		if err := types.ValidateFeeRecipients(recipient, basisPoints, recipientSplits); err != nil {
+			return types.MsgFee{}, err
+		}
+		rv := types.NewMsgFee(msgTypeURL, additionalFee, "", 0)
+		rv.RecipientSplits = recipientSplits
+		return rv, nil
+	}
+
+	bips, err := DetermineBips(recipient, basisPoints)
+	if err != nil {
+		return types.MsgFee{}, err
+	}
+	return types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips), nil
+}
+
 // DetermineBips converts basis point string to uint32
 func DetermineBips(recipient string, recipientBasisPoints string) (uint32, error) {
 	var bips uint32
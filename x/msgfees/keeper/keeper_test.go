@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	cosmosauthtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -20,6 +22,8 @@ import (
 
 	simapp "github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
@@ -108,6 +112,45 @@ func (s *TestSuite) TestConvertDenomToHash() {
 	s.Assert().Equal(sdk.Coin{}, nhash)
 }
 
+func (s *TestSuite) TestConvertDenomToHashWithNetAssetValue() {
+	app, ctx := s.app, s.ctx
+	conversionDenom := app.MsgFeesKeeper.GetConversionFeeDenom(ctx)
+	marker := markertypes.NewEmptyMarkerAccount(conversionDenom, s.addrs[0].String(), []markertypes.AccessGrant{})
+	s.Require().NoError(app.MarkerKeeper.AddMarkerAccount(ctx, marker), "AddMarkerAccount(%q)", conversionDenom)
+
+	usdDollar := sdk.NewInt64Coin(types.UsdDenom, 1_000)
+	// The param has $1 == 25hash, so the net asset value can only move it to between 12.5hash and 37.5hash.
+	tests := []struct {
+		name     string
+		navPrice int64 // usd mils per 1,000,000,000 nhash.
+		expected int64
+	}{
+		{name: "within bounds", navPrice: 30, expected: 33_333_333_333},
+		{name: "cheaper than the lower bound", navPrice: 100, expected: 12_500_000_000},
+		{name: "more expensive than the upper bound", navPrice: 1, expected: 37_500_000_000},
+	}
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			nav := markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, tc.navPrice), 1_000_000_000)
+			s.Require().NoError(app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue")
+			nhash, err := app.MsgFeesKeeper.ConvertDenomToHash(ctx, usdDollar)
+			s.Assert().NoError(err, "ConvertDenomToHash(%s)", usdDollar)
+			s.Assert().Equal(sdk.NewInt64Coin(conversionDenom, tc.expected).String(), nhash.String(), "ConvertDenomToHash(%s)", usdDollar)
+		})
+	}
+
+	// 1hash costs $0.025, i.e. 25 usd mils per 1,000,000,000 nhash, so $1 == 40hash, which is limited to 37.5hash.
+	nav := markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, 25), 1_000_000_000)
+	s.Require().NoError(app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue")
+
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	s.Require().NoError(app.MsgFeesKeeper.SetMsgFee(ctx, types.NewMsgFee(sendTypeURL, usdDollar, "", 0)), "setting MsgSend fee")
+	msgSend := banktypes.NewMsgSend(s.addrs[0], s.addrs[1], sdk.NewCoins(sdk.NewInt64Coin(conversionDenom, 1)))
+	dist, err := app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(ctx, msgSend)
+	s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+	s.Assert().Equal("37500000000"+conversionDenom, dist.TotalAdditionalFees.String(), "TotalAdditionalFees")
+}
+
 func (s *TestSuite) TestDeductFeesDistributions() {
 	app, ctx, addrs := s.app, s.ctx, s.addrs
 	var err error
@@ -385,6 +428,131 @@ func (s *TestSuite) TestCalculateAdditionalFeesToBePaid() {
 		s.Require().NoError(err)
 		assertEqualDist(s.T(), expected, actual)
 	})
+
+	sendFeeWithSplits := types.NewMsgFee(sendTypeURL, oneHash, "", 0)
+	sendFeeWithSplits.RecipientSplits = []types.RecipientSplit{
+		{Recipient: "recipient1", BasisPoints: 5_000},
+		{Recipient: "recipient2", BasisPoints: 3_000},
+		{Recipient: "", BasisPoints: 2_000},
+	}
+	s.Require().NoError(s.app.MsgFeesKeeper.SetMsgFee(s.ctx, sendFeeWithSplits), "setting MsgSend fee with recipient splits")
+
+	s.Run("send with recipient splits", func() {
+		expected := types.MsgFeesDistribution{
+			TotalAdditionalFees:  nhashCoins(1_000_000_000),
+			AdditionalModuleFees: nhashCoins(200_000_000),
+			RecipientDistributions: map[string]sdk.Coins{
+				"recipient1": nhashCoins(500_000_000),
+				"recipient2": nhashCoins(300_000_000),
+			},
+		}
+		actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msgSend)
+		s.Require().NoError(err)
+		assertEqualDist(s.T(), expected, actual)
+	})
+}
+
+func (s *TestSuite) TestCalculateAdditionalFeesToBePaidForContracts() {
+	nhashCoin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(pioconfig.GetProvenanceConfig().FeeDenom, amount)
+	}
+	sender := s.addrs[0].String()
+	dexContract := sdk.AccAddress("dex_contract________").String()
+	metadataContract := sdk.AccAddress("metadata_contract___").String()
+	otherContract := sdk.AccAddress("other_contract______").String()
+	execTypeURL := types.ExecuteContractMsgTypeURL
+
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, "", "", "", "", nil, nhashCoin(1)), "AddMsgFee for all contracts")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, dexContract, "", "", "", nil, nhashCoin(10)), "AddMsgFee for dex contract")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, dexContract, "swap", "", "", nil, nhashCoin(100)), "AddMsgFee for dex contract swap")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, metadataContract, "", "", "", nil, nhashCoin(1000)), "AddMsgFee for metadata contract")
+
+	err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "/cosmos.bank.v1beta1.MsgSend", dexContract, "", "", "", nil, nhashCoin(5))
+	s.Assert().EqualError(err, `a contract address can only be provided with msg type url "`+execTypeURL+`"`, "AddMsgFee with contract for MsgSend")
+	err = s.app.MsgFeesKeeper.AddMsgFee(s.ctx, execTypeURL, dexContract, "swap", "", "", nil, nhashCoin(5))
+	s.Assert().ErrorIs(err, types.ErrMsgFeeAlreadyExists, "AddMsgFee for dex contract swap again")
+
+	tests := []struct {
+		name     string
+		contract string
+		execMsg  string
+		expTotal int64
+	}{
+		{name: "dex contract with fee for method", contract: dexContract, execMsg: `{"swap":{}}`, expTotal: 100},
+		{name: "dex contract without fee for method", contract: dexContract, execMsg: `{"cancel":{"id":1}}`, expTotal: 10},
+		{name: "dex contract invalid json", contract: dexContract, execMsg: `not json`, expTotal: 10},
+		{name: "metadata contract", contract: metadataContract, execMsg: `{"swap":{}}`, expTotal: 1000},
+		{name: "other contract", contract: otherContract, execMsg: `{"swap":{}}`, expTotal: 1},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			msg := &wasmtypes.MsgExecuteContract{Sender: sender, Contract: tc.contract, Msg: []byte(tc.execMsg)}
+			dist, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, msg)
+			s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+			s.Assert().Equal(sdk.NewCoins(nhashCoin(tc.expTotal)).String(), dist.TotalAdditionalFees.String(), "TotalAdditionalFees")
+		})
+	}
+
+	s.Require().NoError(s.app.MsgFeesKeeper.RemoveMsgFee(s.ctx, types.MsgFeeKeyName(execTypeURL, dexContract, "swap")), "RemoveMsgFee dex contract swap")
+	msgFee, err := s.app.MsgFeesKeeper.GetContractMsgFee(s.ctx, dexContract, "swap")
+	s.Require().NoError(err, "GetContractMsgFee after remove")
+	if s.Assert().NotNil(msgFee, "GetContractMsgFee after remove") {
+		s.Assert().Equal(nhashCoin(10), msgFee.AdditionalFee, "GetContractMsgFee after remove: AdditionalFee")
+	}
+}
+
+func (s *TestSuite) TestCalculateAdditionalFeesToBePaidWithExemptions() {
+	nhashCoin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(pioconfig.GetProvenanceConfig().FeeDenom, amount)
+	}
+	listed, holder, other := s.addrs[0], s.addrs[1], s.addrs[2]
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, sendTypeURL, "", "", "", "", nil, nhashCoin(100)), "AddMsgFee for MsgSend")
+
+	attrName := "partner.pb"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, attrName, other, false), "SetNameRecord")
+	attr := attrtypes.NewAttribute(attrName, holder.String(), attrtypes.AttributeType_String, []byte("yes"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, other), "SetAttribute")
+
+	s.app.MsgFeesKeeper.SetFeeExemption(s.ctx, types.FeeExemption{Name: "listed", MsgTypeUrls: []string{sendTypeURL}, Addresses: []string{listed.String()}})
+	s.app.MsgFeesKeeper.SetFeeExemption(s.ctx, types.FeeExemption{Name: "holders", MsgTypeUrls: []string{sendTypeURL}, Attribute: attrName})
+
+	tests := []struct {
+		name     string
+		msg      sdk.Msg
+		expTotal sdk.Coins
+	}{
+		{name: "listed address", msg: banktypes.NewMsgSend(listed, other, nil)},
+		{name: "attribute holder", msg: banktypes.NewMsgSend(holder, other, nil)},
+		{name: "not exempt", msg: banktypes.NewMsgSend(other, listed, nil), expTotal: sdk.NewCoins(nhashCoin(100))},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			dist, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, tc.msg)
+			s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+			s.Assert().Equal(tc.expTotal.String(), dist.TotalAdditionalFees.String(), "TotalAdditionalFees")
+		})
+	}
+
+	resp, err := s.queryClient.FeeExemptions(s.ctx, &types.QueryFeeExemptionsRequest{})
+	s.Require().NoError(err, "FeeExemptions query")
+	s.Assert().Len(resp.Exemptions, 2, "FeeExemptions query")
+	resp, err = s.queryClient.FeeExemptions(s.ctx, &types.QueryFeeExemptionsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err, "FeeExemptions query with limit")
+	if s.Assert().Len(resp.Exemptions, 1, "FeeExemptions query with limit") {
+		s.Assert().Equal("holders", resp.Exemptions[0].Name, "FeeExemptions query with limit: name")
+	}
+	s.Assert().Equal(2, int(resp.Pagination.Total), "FeeExemptions query with limit: total")
+	s.Assert().NotEmpty(resp.Pagination.NextKey, "FeeExemptions query with limit: next key")
+
+	s.Require().NoError(s.app.MsgFeesKeeper.RemoveFeeExemption(s.ctx, "listed"), "RemoveFeeExemption")
+	err = s.app.MsgFeesKeeper.RemoveFeeExemption(s.ctx, "listed")
+	s.Assert().ErrorIs(err, types.ErrFeeExemptionDoesNotExist, "RemoveFeeExemption again")
+	dist, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(s.ctx, banktypes.NewMsgSend(listed, other, nil))
+	s.Require().NoError(err, "CalculateAdditionalFeesToBePaid after removing exemption")
+	s.Assert().Equal(nhashCoin(100).String(), dist.TotalAdditionalFees.String(), "TotalAdditionalFees after removing exemption")
 }
 
 func (s *TestSuite) TestAddMsgFee() {
@@ -427,7 +595,7 @@ func (s *TestSuite) TestAddMsgFee() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee)
+			err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, tc.msgTypeURL, "", "", tc.recipient, tc.basisPoints, nil, tc.additionalFee)
 			if tc.expectError {
 				s.Require().Error(err, "test was expected to fail")
 				s.Require().Contains(err.Error(), tc.errorMsg)
@@ -445,7 +613,7 @@ func (s *TestSuite) TestAddMsgFee() {
 }
 
 func (s *TestSuite) TestUpdateMsgFee() {
-	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "updateTypeURL", "initialRecipient", "500", sdk.NewInt64Coin("nhash", 2000)), "AddMsgFee() failed test setup")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "updateTypeURL", "", "", "initialRecipient", "500", nil, sdk.NewInt64Coin("nhash", 2000)), "AddMsgFee() failed test setup")
 
 	testCases := []struct {
 		name          string
@@ -486,7 +654,7 @@ func (s *TestSuite) TestUpdateMsgFee() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.MsgFeesKeeper.UpdateMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee)
+			err := s.app.MsgFeesKeeper.UpdateMsgFee(s.ctx, tc.msgTypeURL, "", "", tc.recipient, tc.basisPoints, nil, tc.additionalFee)
 			if tc.expectError {
 				s.Require().Error(err, "test was expected to fail")
 				s.Require().Contains(err.Error(), tc.errorMsg)
@@ -50,7 +50,7 @@ func (m msgServer) AddMsgFeeProposal(goCtx context.Context, req *types.MsgAddMsg
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.AddMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	err := m.Keeper.AddMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.ContractAddress, req.Method, req.Recipient, req.RecipientBasisPoints, req.RecipientSplits, req.AdditionalFee)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func (m msgServer) UpdateMsgFeeProposal(goCtx context.Context, req *types.MsgUpd
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.UpdateMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	err := m.Keeper.UpdateMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.ContractAddress, req.Method, req.Recipient, req.RecipientBasisPoints, req.RecipientSplits, req.AdditionalFee)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +76,7 @@ func (m msgServer) RemoveMsgFeeProposal(goCtx context.Context, req *types.MsgRem
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.RemoveMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl)
+	err := m.Keeper.RemoveMsgFee(sdk.UnwrapSDKContext(goCtx), types.MsgFeeKeyName(req.MsgTypeUrl, req.ContractAddress, req.Method))
 	if err != nil {
 		return nil, err
 	}
@@ -103,3 +103,40 @@ func (m msgServer) UpdateConversionFeeDenomProposal(goCtx context.Context, req *
 
 	return &types.MsgUpdateConversionFeeDenomProposalResponse{}, nil
 }
+
+func (m msgServer) ScheduleFeeCatalogProposal(goCtx context.Context, req *types.MsgScheduleFeeCatalogProposalRequest) (*types.MsgScheduleFeeCatalogProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	if err := m.Keeper.ScheduleFeeCatalog(sdk.UnwrapSDKContext(goCtx), req.Catalog); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleFeeCatalogProposalResponse{}, nil
+}
+
+func (m msgServer) SetFeeExemptionProposal(goCtx context.Context, req *types.MsgSetFeeExemptionProposalRequest) (*types.MsgSetFeeExemptionProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Exemption.Validate(); err != nil {
+		return nil, types.ErrInvalidFeeProposal.Wrap(err.Error())
+	}
+	m.Keeper.SetFeeExemption(sdk.UnwrapSDKContext(goCtx), req.Exemption)
+
+	return &types.MsgSetFeeExemptionProposalResponse{}, nil
+}
+
+func (m msgServer) RemoveFeeExemptionProposal(goCtx context.Context, req *types.MsgRemoveFeeExemptionProposalRequest) (*types.MsgRemoveFeeExemptionProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	if err := m.Keeper.RemoveFeeExemption(sdk.UnwrapSDKContext(goCtx), req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeExemptionProposalResponse{}, nil
+}
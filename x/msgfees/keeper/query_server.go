@@ -74,11 +74,79 @@ func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateT
 		gasAdjustment = 1.0
 	}
 	gasUsed := int64(float64(gasInfo.GasUsed) * float64(gasAdjustment))
-	totalFees := gasMeter.FeeConsumed().Add(sdk.NewCoin(baseDenom, minGasPrice.Amount.MulRaw(gasUsed)))
+	baseFee := sdk.NewCoin(baseDenom, minGasPrice.Amount.MulRaw(gasUsed))
+	totalFees := gasMeter.FeeConsumed().Add(baseFee)
 
 	return &types.CalculateTxFeesResponse{
 		AdditionalFees: gasMeter.FeeConsumed(),
 		TotalFees:      totalFees,
 		EstimatedGas:   uint64(gasUsed),
+		BaseFees:       sdk.NewCoins(baseFee),
+		FloorGasPrice:  sdk.NewCoin(baseDenom, minGasPrice.Amount),
+		MsgFees:        gasMeter.EventFeeSummary().MsgFees,
 	}, nil
 }
+
+func (k Keeper) FeeCatalog(c context.Context, _ *types.QueryFeeCatalogRequest) (*types.QueryFeeCatalogResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	active, err := k.GetActiveFeeCatalog(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	scheduled, err := k.GetScheduledFeeCatalog(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeCatalogResponse{Active: active, Scheduled: scheduled}, nil
+}
+
+func (k Keeper) FeeExemptions(c context.Context, req *types.QueryFeeExemptionsRequest) (*types.QueryFeeExemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var exemptions []types.FeeExemption
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeExemptionKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var exemption types.FeeExemption
+		if err := k.cdc.Unmarshal(value, &exemption); err != nil {
+			return err
+		}
+		exemptions = append(exemptions, exemption)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeExemptionsResponse{Exemptions: exemptions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FeeAccruals(c context.Context, req *types.QueryFeeAccrualsRequest) (*types.QueryFeeAccrualsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient %q: %v", req.Recipient, err)
+	}
+	if req.StartTime != nil && req.EndTime != nil && req.EndTime.Before(*req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time cannot be before start time")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	accruals, err := k.GetFeeAccruals(ctx, recipient, req.MsgType, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryFeeAccrualsResponse{Accruals: accruals, Total: sdk.Coins{}}
+	for _, accrual := range accruals {
+		resp.Count += accrual.Count
+		resp.Total = resp.Total.Add(accrual.Total...)
+	}
+	return resp, nil
+}
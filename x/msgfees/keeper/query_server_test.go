@@ -126,6 +126,13 @@ func (s *QueryServerTestSuite) TestCalculateTxFees() {
 	expectedTotalFees = response.AdditionalFees.Add(sdk.NewCoin(s.cfg.BondDenom, s.minGasPrice.Amount.MulRaw(int64(response.EstimatedGas))))
 	s.Assert().Equal(expectedTotalFees, response.TotalFees)
 	s.Assert().Equal(sdk.NewCoins(sdk.NewCoin(sendAddFee.Denom, sendAddFee.Amount.MulRaw(2))), response.AdditionalFees)
+	expectedBaseFees := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, s.minGasPrice.Amount.MulRaw(int64(response.EstimatedGas))))
+	s.Assert().Equal(expectedBaseFees.String(), response.BaseFees.String(), "BaseFees")
+	s.Assert().Equal(sdk.NewCoin(s.cfg.BondDenom, s.minGasPrice.Amount).String(), response.FloorGasPrice.String(), "FloorGasPrice")
+	expectedMsgFees := []types.EventMsgFee{
+		{MsgType: "/cosmos.bank.v1beta1.MsgSend", Count: "2", Total: "2" + sendAddFee.Denom},
+	}
+	s.Assert().Equal(expectedMsgFees, response.MsgFees, "MsgFees")
 }
 
 func (s *QueryServerTestSuite) TestCalculateTxFeesAuthz() {
@@ -30,7 +30,8 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the msgfee module.
@@ -130,6 +131,13 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 	return []abci.ValidatorUpdate{}
 }
 
+// BeginBlock is the `BeginBlocker` function run at the beginning of each block to
+// put a scheduled fee catalog into effect once its effective time has been reached.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.ProcessScheduledFeeCatalog(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the msgfees
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
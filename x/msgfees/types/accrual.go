@@ -0,0 +1,58 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// secondsPerDay is the length of a fee accrual day.
+const secondsPerDay = 24 * 60 * 60
+
+// FeeAccrualDay returns the number of days since the unix epoch (UTC) of the provided time.
+// Times before the epoch are all treated as day zero.
+func FeeAccrualDay(t time.Time) uint64 {
+	secs := t.Unix()
+	if secs < 0 {
+		return 0
+	}
+	return uint64(secs / secondsPerDay)
+}
+
+// FeeAccrualDayStart returns the start (UTC) of the provided fee accrual day.
+func FeeAccrualDayStart(day uint64) time.Time {
+	return time.Unix(int64(day)*secondsPerDay, 0).UTC() //nolint:gosec // G115: A day number won't be anywhere near big enough to overflow.
+}
+
+// DayNumber returns the fee accrual day number of this accrual.
+func (a FeeAccrual) DayNumber() uint64 {
+	return FeeAccrualDay(a.Day)
+}
+
+// Validate makes sure that the fee accrual is valid.
+func (a FeeAccrual) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Recipient); err != nil {
+		return fmt.Errorf("invalid fee accrual recipient: %w", err)
+	}
+	if len(a.MsgType) == 0 {
+		return fmt.Errorf("invalid fee accrual for %s: %w", a.Recipient, ErrEmptyMsgType)
+	}
+	if !a.Day.Equal(FeeAccrualDayStart(a.DayNumber())) {
+		return fmt.Errorf("invalid fee accrual day %s: must be the start of a UTC day", a.Day.Format(time.RFC3339))
+	}
+	if a.Count == 0 {
+		return errors.New("invalid fee accrual count: cannot be zero")
+	}
+	if err := a.Total.Validate(); err != nil {
+		return fmt.Errorf("invalid fee accrual total %q: %w", a.Total, err)
+	}
+	if a.Total.IsZero() {
+		return errors.New("invalid fee accrual total: cannot be zero")
+	}
+	if a.FirstHeight < 0 || a.LastHeight < a.FirstHeight {
+		return fmt.Errorf("invalid fee accrual heights: first %d, last %d", a.FirstHeight, a.LastHeight)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeAccrualDay(t *testing.T) {
+	tests := []struct {
+		name string
+		time time.Time
+		day  uint64
+	}{
+		{name: "epoch", time: time.Unix(0, 0), day: 0},
+		{name: "before epoch", time: time.Unix(-100_000, 0), day: 0},
+		{name: "last second of first day", time: time.Unix(86_399, 0), day: 0},
+		{name: "start of second day", time: time.Unix(86_400, 0), day: 1},
+		{name: "middle of a day", time: time.Date(2024, 3, 1, 13, 14, 15, 0, time.UTC), day: 19_783},
+		{name: "other time zone", time: time.Date(2024, 3, 1, 20, 0, 0, 0, time.FixedZone("EST", -5*60*60)), day: 19_784},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			day := FeeAccrualDay(tc.time)
+			assert.Equal(t, tc.day, day, "FeeAccrualDay(%s)", tc.time)
+			start := FeeAccrualDayStart(day)
+			assert.Equal(t, day, FeeAccrualDay(start), "FeeAccrualDay(FeeAccrualDayStart(%d))", day)
+			assert.Equal(t, time.UTC, start.Location(), "FeeAccrualDayStart(%d) location", day)
+		})
+	}
+}
+
+func TestFeeAccrualValidate(t *testing.T) {
+	addr := sdk.AccAddress("input111111111111111").String()
+	msgType := sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{})
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))
+
+	newAccrual := func(modify func(*FeeAccrual)) FeeAccrual {
+		rv := FeeAccrual{Recipient: addr, MsgType: msgType, Day: day, Count: 2, Total: fee, FirstHeight: 3, LastHeight: 4}
+		if modify != nil {
+			modify(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		accrual  FeeAccrual
+		errorMsg string
+	}{
+		{
+			name:    "valid",
+			accrual: newAccrual(nil),
+		},
+		{
+			name:     "invalid recipient",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.Recipient = "bad" }),
+			errorMsg: "invalid fee accrual recipient: decoding bech32 failed",
+		},
+		{
+			name:     "no msg type",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.MsgType = "" }),
+			errorMsg: "invalid fee accrual for " + addr + ": msg type is empty",
+		},
+		{
+			name:     "day not at start",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.Day = day.Add(time.Hour) }),
+			errorMsg: "invalid fee accrual day 2024-03-01T01:00:00Z: must be the start of a UTC day",
+		},
+		{
+			name:     "zero count",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.Count = 0 }),
+			errorMsg: "invalid fee accrual count: cannot be zero",
+		},
+		{
+			name:     "invalid total",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.Total = sdk.Coins{sdk.Coin{Denom: "x", Amount: fee[0].Amount}} }),
+			errorMsg: `invalid fee accrual total "5x"`,
+		},
+		{
+			name:     "zero total",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.Total = nil }),
+			errorMsg: "invalid fee accrual total: cannot be zero",
+		},
+		{
+			name:     "last height before first",
+			accrual:  newAccrual(func(a *FeeAccrual) { a.LastHeight = 2 }),
+			errorMsg: "invalid fee accrual heights: first 3, last 2",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.accrual.Validate()
+			if len(tc.errorMsg) > 0 {
+				require.ErrorContains(t, err, tc.errorMsg, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate makes sure that the fee catalog is valid.
+func (c FeeCatalog) Validate() error {
+	if c.EffectiveTime.IsZero() {
+		return errors.New("fee catalog effective time cannot be empty")
+	}
+
+	tiers := make(map[string]bool, len(c.Tiers))
+	for i, tier := range c.Tiers {
+		if len(tier.Name) == 0 {
+			return fmt.Errorf("invalid fee tier[%d]: name cannot be empty", i)
+		}
+		if tiers[tier.Name] {
+			return fmt.Errorf("invalid fee tier[%d]: duplicate name %q", i, tier.Name)
+		}
+		tiers[tier.Name] = true
+		if !tier.AdditionalFee.IsPositive() {
+			return fmt.Errorf("invalid fee tier %q: %w", tier.Name, ErrInvalidFee)
+		}
+		if err := tier.AdditionalFee.Validate(); err != nil {
+			return fmt.Errorf("invalid fee tier %q: %w", tier.Name, err)
+		}
+	}
+
+	msgTypes := make(map[string]bool, len(c.Assignments)+len(c.Overrides))
+	for i, assignment := range c.Assignments {
+		if len(assignment.MsgTypeUrl) == 0 {
+			return fmt.Errorf("invalid fee tier assignment[%d]: %w", i, ErrEmptyMsgType)
+		}
+		if msgTypes[assignment.MsgTypeUrl] {
+			return fmt.Errorf("invalid fee tier assignment[%d]: duplicate msg type %q", i, assignment.MsgTypeUrl)
+		}
+		msgTypes[assignment.MsgTypeUrl] = true
+		if !tiers[assignment.Tier] {
+			return fmt.Errorf("invalid fee tier assignment[%d]: unknown tier %q", i, assignment.Tier)
+		}
+	}
+
+	for i, override := range c.Overrides {
+		if err := override.Validate(); err != nil {
+			return fmt.Errorf("invalid fee override[%d]: %w", i, err)
+		}
+		if msgTypes[override.KeyName()] {
+			return fmt.Errorf("invalid fee override[%d]: duplicate msg type %q", i, override.KeyName())
+		}
+		msgTypes[override.KeyName()] = true
+	}
+
+	return nil
+}
+
+// MsgFees returns the msg fees defined by this catalog.
+// Each assignment yields a msg fee with its tier's fee, followed by each of the overrides.
+func (c FeeCatalog) MsgFees() []MsgFee {
+	tierFees := make(map[string]FeeTier, len(c.Tiers))
+	for _, tier := range c.Tiers {
+		tierFees[tier.Name] = tier
+	}
+
+	rv := make([]MsgFee, 0, len(c.Assignments)+len(c.Overrides))
+	for _, assignment := range c.Assignments {
+		rv = append(rv, NewMsgFee(assignment.MsgTypeUrl, tierFees[assignment.Tier].AdditionalFee, "", 0))
+	}
+	return append(rv, c.Overrides...)
+}
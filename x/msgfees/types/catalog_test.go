@@ -0,0 +1,123 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeCatalogValidate(t *testing.T) {
+	msgType := sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{})
+	otherMsgType := sdk.MsgTypeURL(&MsgAddMsgFeeProposalRequest{})
+	effective := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tier := FeeTier{Name: "low", AdditionalFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)}
+
+	cases := []struct {
+		name     string
+		catalog  FeeCatalog
+		errorMsg string
+	}{
+		{
+			name: "should succeed with tiers, assignments, and overrides",
+			catalog: FeeCatalog{
+				Tiers:         []FeeTier{tier},
+				Assignments:   []FeeTierAssignment{{MsgTypeUrl: msgType, Tier: "low"}},
+				Overrides:     []MsgFee{NewMsgFee(otherMsgType, sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), "", 0)},
+				EffectiveTime: effective,
+			},
+		},
+		{
+			name:     "should fail without an effective time",
+			catalog:  FeeCatalog{Tiers: []FeeTier{tier}},
+			errorMsg: "fee catalog effective time cannot be empty",
+		},
+		{
+			name:     "should fail with an unnamed tier",
+			catalog:  FeeCatalog{Tiers: []FeeTier{{AdditionalFee: tier.AdditionalFee}}, EffectiveTime: effective},
+			errorMsg: "invalid fee tier[0]: name cannot be empty",
+		},
+		{
+			name:     "should fail with a duplicate tier",
+			catalog:  FeeCatalog{Tiers: []FeeTier{tier, tier}, EffectiveTime: effective},
+			errorMsg: `invalid fee tier[1]: duplicate name "low"`,
+		},
+		{
+			name: "should fail with a zero tier fee",
+			catalog: FeeCatalog{
+				Tiers:         []FeeTier{{Name: "free", AdditionalFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}},
+				EffectiveTime: effective,
+			},
+			errorMsg: `invalid fee tier "free": invalid fee amount`,
+		},
+		{
+			name: "should fail with an assignment to an unknown tier",
+			catalog: FeeCatalog{
+				Tiers:         []FeeTier{tier},
+				Assignments:   []FeeTierAssignment{{MsgTypeUrl: msgType, Tier: "high"}},
+				EffectiveTime: effective,
+			},
+			errorMsg: `invalid fee tier assignment[0]: unknown tier "high"`,
+		},
+		{
+			name: "should fail with a duplicate assignment",
+			catalog: FeeCatalog{
+				Tiers:         []FeeTier{tier},
+				Assignments:   []FeeTierAssignment{{MsgTypeUrl: msgType, Tier: "low"}, {MsgTypeUrl: msgType, Tier: "low"}},
+				EffectiveTime: effective,
+			},
+			errorMsg: `invalid fee tier assignment[1]: duplicate msg type "` + msgType + `"`,
+		},
+		{
+			name: "should fail with an override of an assigned msg type",
+			catalog: FeeCatalog{
+				Tiers:         []FeeTier{tier},
+				Assignments:   []FeeTierAssignment{{MsgTypeUrl: msgType, Tier: "low"}},
+				Overrides:     []MsgFee{NewMsgFee(msgType, sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), "", 0)},
+				EffectiveTime: effective,
+			},
+			errorMsg: `invalid fee override[0]: duplicate msg type "` + msgType + `"`,
+		},
+		{
+			name: "should fail with an invalid override",
+			catalog: FeeCatalog{
+				Overrides:     []MsgFee{NewMsgFee(msgType, sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), "invalid", DefaultMsgFeeBips)},
+				EffectiveTime: effective,
+			},
+			errorMsg: "invalid fee override[0]: decoding bech32 failed: invalid bech32 string length 7",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.catalog.Validate()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeCatalogMsgFees(t *testing.T) {
+	msgType := sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{})
+	otherMsgType := sdk.MsgTypeURL(&MsgAddMsgFeeProposalRequest{})
+	override := NewMsgFee(otherMsgType, sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), "", 0)
+	catalog := FeeCatalog{
+		Tiers: []FeeTier{
+			{Name: "low", AdditionalFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)},
+			{Name: "high", AdditionalFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)},
+		},
+		Assignments: []FeeTierAssignment{{MsgTypeUrl: msgType, Tier: "high"}},
+		Overrides:   []MsgFee{override},
+	}
+
+	expected := []MsgFee{
+		NewMsgFee(msgType, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), "", 0),
+		override,
+	}
+	require.Equal(t, expected, catalog.MsgFees())
+}
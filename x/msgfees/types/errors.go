@@ -12,4 +12,7 @@ var (
 	ErrMsgFeeDoesNotExist  = cerrs.Register(ModuleName, 5, "fee for type does not exist")
 	ErrInvalidFeeProposal  = cerrs.Register(ModuleName, 6, "invalid fee proposal")
 	ErrInvalidBipsValue    = cerrs.Register(ModuleName, 7, "invalid bips amount")
+
+	ErrRecipientSplitsWithRecipient = cerrs.Register(ModuleName, 8, "recipient splits cannot be combined with a recipient or recipient basis points")
+	ErrFeeExemptionDoesNotExist     = cerrs.Register(ModuleName, 9, "fee exemption does not exist")
 )
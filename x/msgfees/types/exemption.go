@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate makes sure that the fee exemption is valid.
+func (e FeeExemption) Validate() error {
+	if len(e.Name) == 0 {
+		return errors.New("fee exemption name cannot be empty")
+	}
+	if len(e.MsgTypeUrls) == 0 {
+		return fmt.Errorf("fee exemption %q must have at least one msg type", e.Name)
+	}
+	msgTypes := make(map[string]bool, len(e.MsgTypeUrls))
+	for i, msgTypeURL := range e.MsgTypeUrls {
+		if len(msgTypeURL) == 0 {
+			return fmt.Errorf("invalid fee exemption %q msg type[%d]: %w", e.Name, i, ErrEmptyMsgType)
+		}
+		if msgTypes[msgTypeURL] {
+			return fmt.Errorf("invalid fee exemption %q msg type[%d]: duplicate msg type %q", e.Name, i, msgTypeURL)
+		}
+		msgTypes[msgTypeURL] = true
+	}
+	if len(e.Addresses) == 0 && len(e.Attribute) == 0 {
+		return fmt.Errorf("fee exemption %q must have at least one address or an attribute", e.Name)
+	}
+	addrs := make(map[string]bool, len(e.Addresses))
+	for i, addr := range e.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid fee exemption %q address[%d]: %w", e.Name, i, err)
+		}
+		if addrs[addr] {
+			return fmt.Errorf("invalid fee exemption %q address[%d]: duplicate address %s", e.Name, i, addr)
+		}
+		addrs[addr] = true
+	}
+	return nil
+}
+
+// AppliesTo returns true if this exemption skips the additional fees of the provided msg type.
+func (e FeeExemption) AppliesTo(msgTypeURL string) bool {
+	return slices.Contains(e.MsgTypeUrls, msgTypeURL)
+}
+
+// HasAddress returns true if the provided address is one of the accounts listed in this exemption.
+func (e FeeExemption) HasAddress(addr string) bool {
+	return slices.Contains(e.Addresses, addr)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeExemptionValidate(t *testing.T) {
+	msgType := sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{})
+	addr := sdk.AccAddress("input111111111111111").String()
+
+	cases := []struct {
+		name      string
+		exemption FeeExemption
+		errorMsg  string
+	}{
+		{
+			name:      "should succeed with addresses",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType}, Addresses: []string{addr}},
+		},
+		{
+			name:      "should succeed with an attribute",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType}, Attribute: "partner.pb"},
+		},
+		{
+			name:      "should fail without a name",
+			exemption: FeeExemption{MsgTypeUrls: []string{msgType}, Addresses: []string{addr}},
+			errorMsg:  "fee exemption name cannot be empty",
+		},
+		{
+			name:      "should fail without msg types",
+			exemption: FeeExemption{Name: "partners", Addresses: []string{addr}},
+			errorMsg:  `fee exemption "partners" must have at least one msg type`,
+		},
+		{
+			name:      "should fail with an empty msg type",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType, ""}, Addresses: []string{addr}},
+			errorMsg:  `invalid fee exemption "partners" msg type[1]: msg type is empty`,
+		},
+		{
+			name:      "should fail with a duplicate msg type",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType, msgType}, Addresses: []string{addr}},
+			errorMsg:  `invalid fee exemption "partners" msg type[1]: duplicate msg type "` + msgType + `"`,
+		},
+		{
+			name:      "should fail without addresses or an attribute",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType}},
+			errorMsg:  `fee exemption "partners" must have at least one address or an attribute`,
+		},
+		{
+			name:      "should fail with an invalid address",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType}, Addresses: []string{"bad"}},
+			errorMsg:  `invalid fee exemption "partners" address[0]: decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:      "should fail with a duplicate address",
+			exemption: FeeExemption{Name: "partners", MsgTypeUrls: []string{msgType}, Addresses: []string{addr, addr}},
+			errorMsg:  `invalid fee exemption "partners" address[1]: duplicate address ` + addr,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.exemption.Validate()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -26,6 +29,7 @@ type MsgFeesKeeper interface {
 	GetNhashPerUsdMil(ctx sdk.Context) uint64
 	ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error)
 	CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+	AccrueMsgFees(ctx sdk.Context, fees map[string]sdk.Coins, calls map[string]uint64) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -33,3 +37,13 @@ type FeegrantKeeper interface {
 	GetAllowance(ctx context.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// MarkerKeeper defines the expected marker keeper.
+type MarkerKeeper interface {
+	GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*markertypes.NetAssetValue, error)
+}
+
+// AttributeKeeper defines the expected attribute keeper.
+type AttributeKeeper interface {
+	GetAttributes(ctx sdk.Context, addr string, name string) ([]attrtypes.Attribute, error)
+}
@@ -71,3 +71,36 @@ func (d *MsgFeesDistribution) Increase(coin sdk.Coin, bips uint32, recipient str
 
 	return nil
 }
+
+// IncreaseWithSplits adds the provided coin to be distributed (as long as it's positive).
+// Each split's recipient gets its portion based on its bips. A split without a recipient, and any
+// amount left over due to truncation, goes to the module.
+func (d *MsgFeesDistribution) IncreaseWithSplits(coin sdk.Coin, splits []RecipientSplit) error {
+	if !coin.IsPositive() {
+		return nil
+	}
+
+	d.TotalAdditionalFees = d.TotalAdditionalFees.Add(coin)
+
+	remaining := coin
+	for _, split := range splits {
+		if len(split.Recipient) == 0 {
+			continue
+		}
+		recipientCoin, _, err := SplitCoinByBips(coin, split.BasisPoints)
+		if err != nil {
+			return err
+		}
+		if recipientCoin.IsZero() {
+			continue
+		}
+		d.RecipientDistributions[split.Recipient] = d.RecipientDistributions[split.Recipient].Add(recipientCoin)
+		remaining = remaining.Sub(recipientCoin)
+	}
+
+	if !remaining.IsZero() {
+		d.AdditionalModuleFees = d.AdditionalModuleFees.Add(remaining)
+	}
+
+	return nil
+}
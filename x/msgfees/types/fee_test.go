@@ -98,3 +98,25 @@ func TestSplitCoinByBips(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgFeesDistributionIncreaseWithSplits(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	splits := []RecipientSplit{
+		{Recipient: addr1, BasisPoints: 5_000},
+		{Recipient: addr2, BasisPoints: 3_000},
+		{Recipient: "", BasisPoints: 2_000},
+	}
+
+	dist := MsgFeesDistribution{RecipientDistributions: make(map[string]sdk.Coins)}
+	assert.NoError(t, dist.IncreaseWithSplits(sdk.NewInt64Coin("nhash", 0), splits), "IncreaseWithSplits zero coin")
+	assert.Empty(t, dist.TotalAdditionalFees, "TotalAdditionalFees after zero coin")
+
+	assert.NoError(t, dist.IncreaseWithSplits(sdk.NewInt64Coin("nhash", 1001), splits), "IncreaseWithSplits(1001nhash)")
+	assert.Equal(t, "1001nhash", dist.TotalAdditionalFees.String(), "TotalAdditionalFees")
+	assert.Equal(t, "500nhash", dist.RecipientDistributions[addr1].String(), "addr1 distribution")
+	assert.Equal(t, "300nhash", dist.RecipientDistributions[addr2].String(), "addr2 distribution")
+	// The fee collector gets its 200 plus the 1 left over from truncation.
+	assert.Equal(t, "201nhash", dist.AdditionalModuleFees.String(), "AdditionalModuleFees")
+	assert.Len(t, dist.RecipientDistributions, 2, "RecipientDistributions")
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -21,6 +23,38 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	if state.ActiveFeeCatalog != nil {
+		if err := state.ActiveFeeCatalog.Validate(); err != nil {
+			return fmt.Errorf("invalid active fee catalog: %w", err)
+		}
+	}
+	if state.ScheduledFeeCatalog != nil {
+		if err := state.ScheduledFeeCatalog.Validate(); err != nil {
+			return fmt.Errorf("invalid scheduled fee catalog: %w", err)
+		}
+	}
+	exemptions := make(map[string]bool, len(state.FeeExemptions))
+	for _, exemption := range state.FeeExemptions {
+		if err := exemption.Validate(); err != nil {
+			return err
+		}
+		if exemptions[exemption.Name] {
+			return fmt.Errorf("duplicate fee exemption %q", exemption.Name)
+		}
+		exemptions[exemption.Name] = true
+	}
+	accruals := make(map[string]bool, len(state.FeeAccruals))
+	for _, accrual := range state.FeeAccruals {
+		if err := accrual.Validate(); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s %d %s", accrual.Recipient, accrual.DayNumber(), accrual.MsgType)
+		if accruals[key] {
+			return fmt.Errorf("duplicate fee accrual for %s on %s for %q",
+				accrual.Recipient, accrual.Day.Format(time.DateOnly), accrual.MsgType)
+		}
+		accruals[key] = true
+	}
 	return nil
 }
 
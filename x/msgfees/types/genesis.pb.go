@@ -29,6 +29,14 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// msg_based_fees are the additional fees on specific tx msgs
 	MsgFees []MsgFee `protobuf:"bytes,2,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// active_fee_catalog is the fee catalog currently in effect.
+	ActiveFeeCatalog *FeeCatalog `protobuf:"bytes,3,opt,name=active_fee_catalog,json=activeFeeCatalog,proto3" json:"active_fee_catalog,omitempty"`
+	// scheduled_fee_catalog is the fee catalog that will take effect at its effective time.
+	ScheduledFeeCatalog *FeeCatalog `protobuf:"bytes,4,opt,name=scheduled_fee_catalog,json=scheduledFeeCatalog,proto3" json:"scheduled_fee_catalog,omitempty"`
+	// fee_exemptions are the rules that let specific accounts skip additional fees.
+	FeeExemptions []FeeExemption `protobuf:"bytes,5,rep,name=fee_exemptions,json=feeExemptions,proto3" json:"fee_exemptions"`
+	// fee_accruals are the daily totals of the additional fees received by each recipient for each msg type.
+	FeeAccruals []FeeAccrual `protobuf:"bytes,6,rep,name=fee_accruals,json=feeAccruals,proto3" json:"fee_accruals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +86,34 @@ func (m *GenesisState) GetMsgFees() []MsgFee {
 	return nil
 }
 
+func (m *GenesisState) GetActiveFeeCatalog() *FeeCatalog {
+	if m != nil {
+		return m.ActiveFeeCatalog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledFeeCatalog() *FeeCatalog {
+	if m != nil {
+		return m.ScheduledFeeCatalog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeExemptions() []FeeExemption {
+	if m != nil {
+		return m.FeeExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeAccruals() []FeeAccrual {
+	if m != nil {
+		return m.FeeAccruals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.msgfees.v1.GenesisState")
 }
@@ -87,22 +123,30 @@ func init() {
 }
 
 var fileDescriptor_34254b1b9555b95c = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x2d, 0x4e, 0x4f, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x45, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x70, 0x98, 0x08, 0xd3, 0x07, 0x56, 0xa4, 0xd4, 0xcd,
-	0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x23, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0xad, 0x20,
-	0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x56, 0x0f, 0xab, 0x9d,
-	0x7a, 0x01, 0x60, 0x45, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x08, 0xd9, 0x71,
-	0x71, 0xe4, 0x16, 0xa7, 0xc7, 0x83, 0xd4, 0x48, 0x30, 0x29, 0x30, 0xe3, 0xd1, 0xee, 0x5b, 0x9c,
-	0xee, 0x96, 0x9a, 0x0a, 0xd5, 0xce, 0x9e, 0x0b, 0xe6, 0x15, 0x3b, 0x65, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x44, 0x66, 0x3e, 0x76, 0x93, 0x02, 0x18, 0xa3, 0x8c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x6a, 0x74, 0x33, 0xf3,
-	0x91, 0x78, 0xfa, 0x15, 0xf0, 0x30, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xdf,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xad, 0x4f, 0x8e, 0x40, 0x78, 0x01, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0x87, 0x93, 0x9b, 0xde, 0xde, 0xcb, 0xb4, 0xf7, 0x22, 0xd1, 0x42, 0x28, 0x18, 0xab, 0xdd,
+	0xb8, 0x31, 0xa1, 0x76, 0x29, 0x08, 0x56, 0xac, 0x20, 0x88, 0xa5, 0xe2, 0xc6, 0x4d, 0x99, 0xa6,
+	0xa7, 0xd3, 0x81, 0x26, 0x13, 0x72, 0xa6, 0xa1, 0xbe, 0x85, 0x8f, 0xd5, 0x65, 0xdd, 0xb9, 0x12,
+	0x69, 0x5f, 0x44, 0x32, 0x49, 0xff, 0x08, 0x6d, 0x71, 0x37, 0x73, 0xf8, 0x7e, 0xdf, 0x39, 0x07,
+	0x0e, 0xa9, 0x86, 0x91, 0x88, 0x21, 0xa0, 0x81, 0x07, 0xae, 0x8f, 0xac, 0x0f, 0x80, 0x6e, 0x5c,
+	0x73, 0x19, 0x04, 0x80, 0x1c, 0x9d, 0x30, 0x12, 0x52, 0x98, 0xa5, 0x15, 0xe4, 0x64, 0x90, 0x13,
+	0xd7, 0xca, 0x07, 0x4c, 0x30, 0xa1, 0x08, 0x37, 0x79, 0xa5, 0x70, 0x79, 0x8b, 0x71, 0x91, 0x53,
+	0xd0, 0xc9, 0x9b, 0x41, 0x8a, 0xb7, 0x69, 0x8f, 0x47, 0x49, 0x25, 0x98, 0x17, 0x24, 0x1f, 0xd2,
+	0x88, 0xfa, 0x68, 0xe9, 0x15, 0xfd, 0xb4, 0x70, 0x7e, 0xe8, 0x6c, 0xec, 0xe9, 0xb4, 0x14, 0xd4,
+	0xc8, 0x4d, 0x3e, 0x8e, 0xb4, 0x76, 0x16, 0x31, 0x2f, 0xc9, 0x5f, 0x1f, 0x59, 0x27, 0x61, 0xac,
+	0x5f, 0x15, 0x63, 0x47, 0xfc, 0x1e, 0x59, 0x13, 0x20, 0x8b, 0xff, 0xf1, 0xd5, 0x0f, 0xcd, 0x07,
+	0x62, 0x52, 0x4f, 0xf2, 0x18, 0x12, 0x45, 0xc7, 0xa3, 0x92, 0x0e, 0x05, 0xb3, 0x0c, 0x35, 0xc8,
+	0xf1, 0x16, 0x53, 0x13, 0xe0, 0x3a, 0x05, 0xdb, 0x7b, 0x69, 0x78, 0x55, 0x31, 0x9f, 0x48, 0x09,
+	0xbd, 0x01, 0xf4, 0x46, 0x43, 0xe8, 0x7d, 0x73, 0xe6, 0x7e, 0xea, 0xdc, 0x5f, 0xe6, 0xd7, 0xb4,
+	0x2d, 0xf2, 0x3f, 0x91, 0xc1, 0x18, 0xfc, 0x50, 0x72, 0x11, 0xa0, 0xf5, 0x5b, 0x6d, 0x5b, 0xdd,
+	0xee, 0xbb, 0x59, 0xb0, 0xd9, 0xce, 0xff, 0xfa, 0x6b, 0x35, 0x34, 0xef, 0x48, 0x31, 0x31, 0x52,
+	0xcf, 0x8b, 0x46, 0x74, 0x88, 0x56, 0xbe, 0x62, 0xec, 0x9e, 0xef, 0x2a, 0x25, 0x33, 0x5b, 0xa1,
+	0xbf, 0xac, 0x60, 0x83, 0x4f, 0x66, 0xb6, 0x3e, 0x9d, 0xd9, 0xfa, 0xe7, 0xcc, 0xd6, 0x5f, 0xe7,
+	0xb6, 0x36, 0x9d, 0xdb, 0xda, 0xfb, 0xdc, 0xd6, 0x88, 0xc5, 0xc5, 0x66, 0x63, 0x4b, 0x7f, 0xae,
+	0x33, 0x2e, 0x07, 0xa3, 0xae, 0xe3, 0x09, 0xdf, 0x5d, 0x31, 0x67, 0x5c, 0xac, 0xfd, 0xdc, 0xf1,
+	0xf2, 0x92, 0xe4, 0x4b, 0x08, 0xd8, 0xcd, 0xab, 0x2b, 0xaa, 0x7f, 0x0d, 0x00, 0x4a, 0x5e, 0x6a,
+	0xbd, 0xbe, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +169,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeAccruals) > 0 {
+		for iNdEx := len(m.FeeAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeeExemptions) > 0 {
+		for iNdEx := len(m.FeeExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ScheduledFeeCatalog != nil {
+		{
+			size, err := m.ScheduledFeeCatalog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ActiveFeeCatalog != nil {
+		{
+			size, err := m.ActiveFeeCatalog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.MsgFees) > 0 {
 		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +273,26 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.ActiveFeeCatalog != nil {
+		l = m.ActiveFeeCatalog.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ScheduledFeeCatalog != nil {
+		l = m.ScheduledFeeCatalog.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.FeeExemptions) > 0 {
+		for _, e := range m.FeeExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeAccruals) > 0 {
+		for _, e := range m.FeeAccruals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +398,146 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFeeCatalog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActiveFeeCatalog == nil {
+				m.ActiveFeeCatalog = &FeeCatalog{}
+			}
+			if err := m.ActiveFeeCatalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledFeeCatalog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledFeeCatalog == nil {
+				m.ScheduledFeeCatalog = &FeeCatalog{}
+			}
+			if err := m.ScheduledFeeCatalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeExemptions = append(m.FeeExemptions, FeeExemption{})
+			if err := m.FeeExemptions[len(m.FeeExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAccruals = append(m.FeeAccruals, FeeAccrual{})
+			if err := m.FeeAccruals[len(m.FeeAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
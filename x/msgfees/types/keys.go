@@ -2,8 +2,12 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -34,8 +38,36 @@ var (
 	MsgFeeKeyPrefix = []byte{0x00}
 	// MsgFeesParamStoreKey key for msgfees module's params
 	MsgFeesParamStoreKey = []byte{0x01}
+	// ActiveFeeCatalogKey key for the fee catalog currently in effect
+	ActiveFeeCatalogKey = []byte{0x02}
+	// ScheduledFeeCatalogKey key for the fee catalog that is waiting for its effective time
+	ScheduledFeeCatalogKey = []byte{0x03}
+	// FeeExemptionKeyPrefix prefix for fee exemption entries
+	FeeExemptionKeyPrefix = []byte{0x04}
+	// FeeAccrualKeyPrefix prefix for the daily fee accrual entries
+	FeeAccrualKeyPrefix = []byte{0x05}
 )
 
+// GetFeeExemptionKey returns the key that a fee exemption is stored under.
+func GetFeeExemptionKey(name string) []byte {
+	return append(FeeExemptionKeyPrefix, []byte(name)...)
+}
+
+// GetFeeAccrualRecipientPrefix returns the prefix of all the fee accrual keys of a recipient.
+func GetFeeAccrualRecipientPrefix(recipient sdk.AccAddress) []byte {
+	return append(FeeAccrualKeyPrefix, address.MustLengthPrefix(recipient)...)
+}
+
+// GetFeeAccrualDayPrefix returns the prefix of the fee accrual keys of a recipient on a day.
+func GetFeeAccrualDayPrefix(recipient sdk.AccAddress, day uint64) []byte {
+	return binary.BigEndian.AppendUint64(GetFeeAccrualRecipientPrefix(recipient), day)
+}
+
+// GetFeeAccrualKey returns the key that a recipient's fee accrual for a msg type on a day is stored under.
+func GetFeeAccrualKey(recipient sdk.AccAddress, day uint64, msgType string) []byte {
+	return append(GetFeeAccrualDayPrefix(recipient, day), []byte(msgType)...)
+}
+
 func GetCompositeKey(msgType string, recipient string) string {
 	if len(recipient) == 0 {
 		return msgType
@@ -43,6 +75,20 @@ func GetCompositeKey(msgType string, recipient string) string {
 	return fmt.Sprintf("%s%s%s", msgType, CompositeKeyDelimiter, recipient)
 }
 
+// MsgFeeKeyName returns the name that a msg fee is stored under.
+// If there's no contract address, it's just the msg type url. Otherwise, it's a composite of
+// the msg type url, contract address, and method (if provided).
+func MsgFeeKeyName(msgTypeURL, contractAddress, method string) string {
+	if len(contractAddress) == 0 {
+		return msgTypeURL
+	}
+	rv := msgTypeURL + CompositeKeyDelimiter + contractAddress
+	if len(method) > 0 {
+		rv += CompositeKeyDelimiter + method
+	}
+	return rv
+}
+
 // SplitCompositKey splits the composite key into msgType and recipient, if recipient is empty then it is for the fee module
 func SplitCompositeKey(key string) (msgType, recipient string) {
 	msgAccountPair := strings.Split(key, CompositeKeyDelimiter)
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +13,9 @@ const (
 	DefaultMsgFeeBips = uint32(5_000)
 )
 
+// ExecuteContractMsgTypeURL is the msg type url of the only msg that can have contract specific fees.
+var ExecuteContractMsgTypeURL = sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})
+
 func NewMsgFee(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints uint32) MsgFee {
 	return MsgFee{
 		MsgTypeUrl:           msgTypeURL,
@@ -19,6 +25,11 @@ func NewMsgFee(msgTypeURL string, additionalFee sdk.Coin, recipient string, reci
 	}
 }
 
+// KeyName returns the name that this msg fee is stored under.
+func (msg MsgFee) KeyName() string {
+	return MsgFeeKeyName(msg.MsgTypeUrl, msg.ContractAddress, msg.Method)
+}
+
 func (msg *MsgFee) Validate() error {
 	if msg == nil {
 		return ErrEmptyMsgType
@@ -43,6 +54,61 @@ func (msg *MsgFee) Validate() error {
 	if msg.RecipientBasisPoints > 10_000 {
 		return fmt.Errorf("recipient basis points can only be between 0 and 10,000 : %v", msg.RecipientBasisPoints)
 	}
+	if err := ValidateContractFee(msg.MsgTypeUrl, msg.ContractAddress, msg.Method); err != nil {
+		return err
+	}
+	if len(msg.RecipientSplits) > 0 {
+		if len(msg.Recipient) != 0 || msg.RecipientBasisPoints != 0 {
+			return ErrRecipientSplitsWithRecipient
+		}
+		if err := ValidateRecipientSplits(msg.RecipientSplits); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// ValidateContractFee makes sure that, if there's a contract address, it's valid and the msg type url is
+// for MsgExecuteContract. A method can only be provided with a contract address.
+func ValidateContractFee(msgTypeURL, contractAddress, method string) error {
+	if len(contractAddress) == 0 {
+		if len(method) != 0 {
+			return errors.New("a contract method cannot be provided without a contract address")
+		}
+		return nil
+	}
+	if msgTypeURL != ExecuteContractMsgTypeURL {
+		return fmt.Errorf("a contract address can only be provided with msg type url %q", ExecuteContractMsgTypeURL)
+	}
+	if _, err := sdk.AccAddressFromBech32(contractAddress); err != nil {
+		return fmt.Errorf("invalid contract address %q: %w", contractAddress, err)
+	}
+	return nil
+}
+
+// ValidateRecipientSplits makes sure that each split is valid, that no recipient
+// is provided more than once, and that the basis points total 10,000.
+func ValidateRecipientSplits(splits []RecipientSplit) error {
+	seen := make(map[string]bool, len(splits))
+	total := uint32(0)
+	for i, split := range splits {
+		if len(split.Recipient) != 0 {
+			if _, err := sdk.AccAddressFromBech32(split.Recipient); err != nil {
+				return fmt.Errorf("invalid recipient split[%d] recipient %q: %w", i, split.Recipient, err)
+			}
+		}
+		if seen[split.Recipient] {
+			return fmt.Errorf("invalid recipient split[%d]: duplicate recipient %q", i, split.Recipient)
+		}
+		seen[split.Recipient] = true
+		if split.BasisPoints == 0 || split.BasisPoints > 10_000 {
+			return fmt.Errorf("invalid recipient split[%d]: basis points can only be between 1 and 10,000 : %v", i, split.BasisPoints)
+		}
+		total += split.BasisPoints
+	}
+	if total != 10_000 {
+		return fmt.Errorf("recipient split basis points must total 10,000 : %v", total)
+	}
 	return nil
 }
@@ -5,18 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,6 +118,16 @@ type MsgFee struct {
 	// The recipient will receive additional_fee * recipient_basis_points / 10,000.
 	// The fee collector will receive the rest, i.e. additional_fee * (10,000 - recipient_basis_points) / 10,000.
 	RecipientBasisPoints uint32 `protobuf:"varint,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
+	// recipient_splits is an optional list of recipients that will each receive a portion of the additional fee.
+	// If provided, the recipient and recipient_basis_points fields must be empty, and the basis points of all
+	// entries must total 10,000.
+	RecipientSplits []RecipientSplit `protobuf:"bytes,5,rep,name=recipient_splits,json=recipientSplits,proto3" json:"recipient_splits"`
+	// contract_address is an optional wasm contract address that this fee is specific to.
+	// If provided, the msg_type_url must be "/cosmwasm.wasm.v1.MsgExecuteContract".
+	ContractAddress string `protobuf:"bytes,6,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// method is an optional contract method (i.e. the top-level key of the execute msg) that this fee is specific to.
+	// There can only be a method if there is a contract_address.
+	Method string `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`
 }
 
 func (m *MsgFee) Reset()         { *m = MsgFee{} }
@@ -176,26 +191,52 @@ func (m *MsgFee) GetRecipientBasisPoints() uint32 {
 	return 0
 }
 
-// EventMsgFee final event property for msg fee on type
-type EventMsgFee struct {
-	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
-	Count     string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
-	Total     string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
-	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+func (m *MsgFee) GetRecipientSplits() []RecipientSplit {
+	if m != nil {
+		return m.RecipientSplits
+	}
+	return nil
 }
 
-func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
-func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
-func (*EventMsgFee) ProtoMessage()    {}
-func (*EventMsgFee) Descriptor() ([]byte, []int) {
+func (m *MsgFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgFee) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+// RecipientSplit defines a portion of an additional fee that is sent to a specific recipient.
+type RecipientSplit struct {
+	// recipient is the address that will receive this portion of the additional fee.
+	// If empty, this portion goes to the fee collector.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// basis_points is the portion of the additional fee that the recipient will receive.
+	// Must be between 1 and 10,000 (inclusive).
+	//
+	// The recipient will receive additional_fee * basis_points / 10,000 (truncated).
+	// Any amount left over due to truncation goes to the fee collector.
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *RecipientSplit) Reset()         { *m = RecipientSplit{} }
+func (m *RecipientSplit) String() string { return proto.CompactTextString(m) }
+func (*RecipientSplit) ProtoMessage()    {}
+func (*RecipientSplit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6265859d114362, []int{2}
 }
-func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
+func (m *RecipientSplit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RecipientSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMsgFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RecipientSplit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -205,63 +246,107 @@ func (m *EventMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventMsgFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMsgFee.Merge(m, src)
+func (m *RecipientSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientSplit.Merge(m, src)
 }
-func (m *EventMsgFee) XXX_Size() int {
+func (m *RecipientSplit) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMsgFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMsgFee.DiscardUnknown(m)
+func (m *RecipientSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientSplit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMsgFee proto.InternalMessageInfo
+var xxx_messageInfo_RecipientSplit proto.InternalMessageInfo
 
-func (m *EventMsgFee) GetMsgType() string {
+func (m *RecipientSplit) GetRecipient() string {
 	if m != nil {
-		return m.MsgType
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *EventMsgFee) GetCount() string {
+func (m *RecipientSplit) GetBasisPoints() uint32 {
 	if m != nil {
-		return m.Count
+		return m.BasisPoints
 	}
-	return ""
+	return 0
 }
 
-func (m *EventMsgFee) GetTotal() string {
+// FeeTier is a named additional fee that msg types can be assigned to.
+type FeeTier struct {
+	// name is the unique name of this tier, e.g. "standard".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// additional_fee is the extra fee required for each msg type assigned to this tier.
+	AdditionalFee types.Coin `protobuf:"bytes,2,opt,name=additional_fee,json=additionalFee,proto3" json:"additional_fee"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{3}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
+func (m *FeeTier) GetName() string {
 	if m != nil {
-		return m.Total
+		return m.Name
 	}
 	return ""
 }
 
-func (m *EventMsgFee) GetRecipient() string {
+func (m *FeeTier) GetAdditionalFee() types.Coin {
 	if m != nil {
-		return m.Recipient
+		return m.AdditionalFee
 	}
-	return ""
+	return types.Coin{}
 }
 
-// EventMsgFees event emitted with summary of msg fees
-type EventMsgFees struct {
-	MsgFees []EventMsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+// FeeTierAssignment assigns a msg type to a fee tier.
+type FeeTierAssignment struct {
+	// msg_type_url is the type-url of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// tier is the name of the fee tier the msg type is assigned to.
+	Tier string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
 }
 
-func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
-func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
-func (*EventMsgFees) ProtoMessage()    {}
-func (*EventMsgFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{3}
+func (m *FeeTierAssignment) Reset()         { *m = FeeTierAssignment{} }
+func (m *FeeTierAssignment) String() string { return proto.CompactTextString(m) }
+func (*FeeTierAssignment) ProtoMessage()    {}
+func (*FeeTierAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{4}
 }
-func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
+func (m *FeeTierAssignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeTierAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMsgFees.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeTierAssignment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -271,220 +356,518 @@ func (m *EventMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventMsgFees) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMsgFees.Merge(m, src)
+func (m *FeeTierAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTierAssignment.Merge(m, src)
 }
-func (m *EventMsgFees) XXX_Size() int {
+func (m *FeeTierAssignment) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMsgFees) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMsgFees.DiscardUnknown(m)
+func (m *FeeTierAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTierAssignment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMsgFees proto.InternalMessageInfo
+var xxx_messageInfo_FeeTierAssignment proto.InternalMessageInfo
 
-func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
+func (m *FeeTierAssignment) GetMsgTypeUrl() string {
 	if m != nil {
-		return m.MsgFees
+		return m.MsgTypeUrl
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
-	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
-	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
-	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
+func (m *FeeTierAssignment) GetTier() string {
+	if m != nil {
+		return m.Tier
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("provenance/msgfees/v1/msgfees.proto", fileDescriptor_0c6265859d114362)
+// FeeCatalog is a set of fee tiers and msg type assignments that is managed by governance as a whole.
+type FeeCatalog struct {
+	// tiers are the named fee tiers of this catalog.
+	Tiers []FeeTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers"`
+	// assignments map msg types to the tiers above.
+	Assignments []FeeTierAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments"`
+	// overrides are msg fees that are used as-is instead of a tier fee.
+	// An override's msg type cannot also have a tier assignment.
+	Overrides []MsgFee `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides"`
+	// effective_time is the block time at which this catalog replaces the msg fees of the previous one.
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
 }
 
-var fileDescriptor_0c6265859d114362 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0xd2, 0x10, 0xc8, 0xa6, 0x2d, 0xc2, 0x0a, 0xc8, 0xad, 0x90, 0x1b, 0x85, 0x4b, 0x38,
-	0x60, 0x93, 0x96, 0x13, 0xc7, 0x14, 0xd2, 0x53, 0xa5, 0xc8, 0xd0, 0x0b, 0x97, 0xd5, 0xc6, 0x99,
-	0x38, 0x2b, 0xd9, 0x3b, 0xd6, 0xee, 0xc6, 0xa2, 0x7f, 0xc1, 0x27, 0xf0, 0x11, 0x7c, 0x03, 0xea,
-	0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0x3e, 0x03, 0xad, 0xed, 0xc4, 0x01, 0xf5, 0xd0, 0xdb, 0xce,
-	0xbe, 0x79, 0x6f, 0xde, 0x5b, 0xcd, 0xd2, 0x97, 0x99, 0xc2, 0x1c, 0x24, 0x97, 0x11, 0x04, 0xa9,
-	0x8e, 0xe7, 0x00, 0x3a, 0xc8, 0x87, 0x9b, 0xa3, 0x9f, 0x29, 0x34, 0xe8, 0x3c, 0xab, 0x9b, 0xfc,
-	0x0d, 0x92, 0x0f, 0x8f, 0xbb, 0x31, 0xc6, 0x58, 0x74, 0x04, 0xf6, 0x54, 0x36, 0x1f, 0x7b, 0x11,
-	0xea, 0x14, 0x75, 0x30, 0xe5, 0x1a, 0x82, 0x7c, 0x38, 0x05, 0xc3, 0x87, 0x41, 0x84, 0x42, 0x96,
-	0x78, 0xff, 0x3b, 0xa1, 0xad, 0x09, 0x57, 0x3c, 0xd5, 0xce, 0x05, 0x7d, 0x32, 0x4f, 0x10, 0x15,
-	0x8b, 0xb9, 0x66, 0x99, 0x12, 0x11, 0xb8, 0x0f, 0x7a, 0x64, 0xd0, 0x39, 0x3d, 0xf2, 0x4b, 0x11,
-	0xdf, 0x8a, 0xf8, 0x95, 0x88, 0x7f, 0x8e, 0x42, 0x8e, 0x9a, 0x37, 0xbf, 0x4e, 0x1a, 0xe1, 0x41,
-	0xc1, 0xbb, 0xe0, 0x7a, 0x62, 0x59, 0xce, 0x2b, 0xfa, 0x54, 0x2e, 0xb8, 0x5e, 0xb0, 0x0c, 0x14,
-	0x5b, 0xea, 0x19, 0x4b, 0x45, 0xe2, 0xee, 0xf5, 0xc8, 0xa0, 0x19, 0x1e, 0x16, 0xc0, 0x04, 0xd4,
-	0x95, 0x9e, 0x5d, 0x8a, 0xc4, 0x79, 0x43, 0xbb, 0x11, 0xca, 0x1c, 0x94, 0x16, 0x28, 0xd9, 0x1c,
-	0x80, 0xcd, 0x40, 0x62, 0xea, 0x36, 0x7b, 0x64, 0xd0, 0x0e, 0x9d, 0x1a, 0x1b, 0x03, 0xbc, 0xb7,
-	0xc8, 0xbb, 0xe6, 0x9f, 0x6f, 0x27, 0x8d, 0xfe, 0x0f, 0x42, 0x5b, 0x97, 0x3a, 0x1e, 0x03, 0x38,
-	0x3d, 0xba, 0x9f, 0xea, 0x98, 0x99, 0xeb, 0x0c, 0xd8, 0x52, 0x25, 0x2e, 0x29, 0xa8, 0x34, 0xd5,
-	0xf1, 0xa7, 0xeb, 0x0c, 0xae, 0x54, 0xe2, 0x8c, 0xe9, 0x21, 0x9f, 0xcd, 0x84, 0x11, 0x28, 0x79,
-	0x62, 0x87, 0xdc, 0x3b, 0x57, 0x4d, 0xb3, 0x93, 0x5e, 0xd0, 0xb6, 0x82, 0x48, 0x64, 0x02, 0xa4,
-	0x29, 0xf2, 0xb4, 0xc3, 0xfa, 0xc2, 0x79, 0x4b, 0x9f, 0x6f, 0x0b, 0x36, 0xe5, 0x5a, 0x68, 0x96,
-	0xa1, 0x90, 0x46, 0x17, 0x61, 0x0e, 0xc2, 0xee, 0x16, 0x1d, 0x59, 0x70, 0x52, 0x60, 0x7d, 0x45,
-	0x3b, 0x1f, 0x72, 0x90, 0xa6, 0x0a, 0x73, 0x44, 0x1f, 0x6f, 0xc2, 0x54, 0x41, 0x1e, 0x55, 0x41,
-	0x9c, 0x2e, 0x7d, 0x18, 0xe1, 0x52, 0x9a, 0xc2, 0x7c, 0x3b, 0x2c, 0x0b, 0x7b, 0x6b, 0xd0, 0xf0,
-	0xa4, 0xf2, 0x53, 0x16, 0xff, 0x3a, 0x6d, 0xfe, 0xe7, 0xb4, 0xff, 0x91, 0xee, 0xef, 0xcc, 0xd4,
-	0xce, 0x79, 0x39, 0xd4, 0x2e, 0x92, 0x4b, 0x7a, 0x7b, 0x83, 0xce, 0x69, 0xdf, 0xbf, 0x73, 0xc7,
-	0xfc, 0x1d, 0x5a, 0xf5, 0x44, 0xd6, 0x9e, 0x15, 0x19, 0x89, 0x9b, 0x95, 0x47, 0x6e, 0x57, 0x1e,
-	0xf9, 0xbd, 0xf2, 0xc8, 0xd7, 0xb5, 0xd7, 0xb8, 0x5d, 0x7b, 0x8d, 0x9f, 0x6b, 0xaf, 0x41, 0x5d,
-	0x81, 0x77, 0xcb, 0x4d, 0xc8, 0xe7, 0xb3, 0x58, 0x98, 0xc5, 0x72, 0xea, 0x47, 0x98, 0x06, 0x75,
-	0xcf, 0x6b, 0x81, 0x3b, 0x55, 0xf0, 0x65, 0xfb, 0x17, 0xec, 0xbb, 0xe8, 0x69, 0xab, 0x58, 0xdd,
-	0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf6, 0x22, 0x0d, 0x2e, 0x03, 0x00, 0x00,
+func (m *FeeCatalog) Reset()         { *m = FeeCatalog{} }
+func (m *FeeCatalog) String() string { return proto.CompactTextString(m) }
+func (*FeeCatalog) ProtoMessage()    {}
+func (*FeeCatalog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{5}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeCatalog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCatalog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCatalog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeCatalog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCatalog.Merge(m, src)
+}
+func (m *FeeCatalog) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCatalog) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCatalog.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConversionFeeDenom) > 0 {
-		i -= len(m.ConversionFeeDenom)
-		copy(dAtA[i:], m.ConversionFeeDenom)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_FeeCatalog proto.InternalMessageInfo
+
+func (m *FeeCatalog) GetTiers() []FeeTier {
+	if m != nil {
+		return m.Tiers
 	}
-	if m.NhashPerUsdMil != 0 {
-		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+func (m *FeeCatalog) GetAssignments() []FeeTierAssignment {
+	if m != nil {
+		return m.Assignments
 	}
-	{
-		size, err := m.FloorGasPrice.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *FeeCatalog) GetOverrides() []MsgFee {
+	if m != nil {
+		return m.Overrides
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeCatalog) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeExemption lets specific accounts skip the additional fees of specific msg types.
+type FeeExemption struct {
+	// name is the unique name of this exemption, e.g. "partner-program".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_urls are the type-urls of the messages whose additional fees are skipped.
+	MsgTypeUrls []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// addresses are the accounts that are exempt.
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// attribute is an optional account attribute name. Accounts with this attribute are also exempt.
+	Attribute string `protobuf:"bytes,4,opt,name=attribute,proto3" json:"attribute,omitempty"`
 }
 
-func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecipientBasisPoints != 0 {
-		i = encodeVarintMsgfees(dAtA, i, uint64(m.RecipientBasisPoints))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *FeeExemption) Reset()         { *m = FeeExemption{} }
+func (m *FeeExemption) String() string { return proto.CompactTextString(m) }
+func (*FeeExemption) ProtoMessage()    {}
+func (*FeeExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{6}
+}
+func (m *FeeExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FeeExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeExemption.Merge(m, src)
+}
+func (m *FeeExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeExemption proto.InternalMessageInfo
+
+func (m *FeeExemption) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeExemption) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMsgFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeExemption) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
 }
 
-func (m *EventMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
+func (m *FeeExemption) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
 	}
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Total)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+// FeeAccrual is the running total of the additional fees that a recipient received for a msg type during one day.
+type FeeAccrual struct {
+	// recipient is the address that received the fees. Fees that went to the fee collector use its module address.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// msg_type is the type-url of the message that the fees were collected for.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	// day is the start (UTC) of the day that the fees were collected in.
+	Day time.Time `protobuf:"bytes,3,opt,name=day,proto3,stdtime" json:"day"`
+	// count is the number of times the fee was collected.
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// total is the sum of the fees collected.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// first_height is the height of the first block in the day with one of these fees.
+	FirstHeight int64 `protobuf:"varint,6,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	// last_height is the height of the last block in the day with one of these fees.
+	LastHeight int64 `protobuf:"varint,7,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *FeeAccrual) Reset()         { *m = FeeAccrual{} }
+func (m *FeeAccrual) String() string { return proto.CompactTextString(m) }
+func (*FeeAccrual) ProtoMessage()    {}
+func (*FeeAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{7}
+}
+func (m *FeeAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Count) > 0 {
-		i -= len(m.Count)
-		copy(dAtA[i:], m.Count)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Count)))
+}
+func (m *FeeAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAccrual.Merge(m, src)
+}
+func (m *FeeAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAccrual proto.InternalMessageInfo
+
+func (m *FeeAccrual) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *FeeAccrual) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *FeeAccrual) GetDay() time.Time {
+	if m != nil {
+		return m.Day
+	}
+	return time.Time{}
+}
+
+func (m *FeeAccrual) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *FeeAccrual) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *FeeAccrual) GetFirstHeight() int64 {
+	if m != nil {
+		return m.FirstHeight
+	}
+	return 0
+}
+
+func (m *FeeAccrual) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+// EventMsgFee final event property for msg fee on type
+type EventMsgFee struct {
+	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	Count     string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+	Total     string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
+func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
+func (*EventMsgFee) ProtoMessage()    {}
+func (*EventMsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{8}
+}
+func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMsgFee.Merge(m, src)
+}
+func (m *EventMsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMsgFee proto.InternalMessageInfo
+
+func (m *EventMsgFee) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *EventMsgFee) GetCount() string {
+	if m != nil {
+		return m.Count
+	}
+	return ""
+}
+
+func (m *EventMsgFee) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *EventMsgFee) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// EventMsgFees event emitted with summary of msg fees
+type EventMsgFees struct {
+	MsgFees []EventMsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+}
+
+func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
+func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
+func (*EventMsgFees) ProtoMessage()    {}
+func (*EventMsgFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{9}
+}
+func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMsgFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMsgFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMsgFees.Merge(m, src)
+}
+func (m *EventMsgFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMsgFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMsgFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMsgFees proto.InternalMessageInfo
+
+func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
+	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
+	proto.RegisterType((*RecipientSplit)(nil), "provenance.msgfees.v1.RecipientSplit")
+	proto.RegisterType((*FeeTier)(nil), "provenance.msgfees.v1.FeeTier")
+	proto.RegisterType((*FeeTierAssignment)(nil), "provenance.msgfees.v1.FeeTierAssignment")
+	proto.RegisterType((*FeeCatalog)(nil), "provenance.msgfees.v1.FeeCatalog")
+	proto.RegisterType((*FeeExemption)(nil), "provenance.msgfees.v1.FeeExemption")
+	proto.RegisterType((*FeeAccrual)(nil), "provenance.msgfees.v1.FeeAccrual")
+	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
+	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
+}
+
+func init() {
+	proto.RegisterFile("provenance/msgfees/v1/msgfees.proto", fileDescriptor_0c6265859d114362)
+}
+
+var fileDescriptor_0c6265859d114362 = []byte{
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x6f, 0xdb, 0x46,
+	0x14, 0x17, 0x2d, 0x59, 0x8a, 0x8e, 0xfe, 0x93, 0x1c, 0xd4, 0x80, 0x31, 0x5a, 0x49, 0x65, 0x51,
+	0x40, 0x19, 0x42, 0xc6, 0x4e, 0xd1, 0x21, 0x9b, 0xed, 0x46, 0x69, 0x51, 0x04, 0x50, 0x19, 0xa7,
+	0x43, 0x17, 0xe2, 0x44, 0x3e, 0x51, 0x87, 0x92, 0x3c, 0xe2, 0xee, 0x24, 0xc4, 0x6b, 0x3f, 0x41,
+	0x3e, 0x42, 0xe7, 0xcc, 0x9d, 0xfa, 0x09, 0x32, 0x66, 0xec, 0xd4, 0xb4, 0xf6, 0xd2, 0x8f, 0x51,
+	0xdc, 0xf1, 0x28, 0x4a, 0x81, 0x1d, 0xb7, 0x40, 0x26, 0xde, 0xbd, 0x3f, 0xbf, 0xf7, 0xe7, 0xf7,
+	0xee, 0x11, 0x7d, 0x51, 0x70, 0xb6, 0x84, 0x9c, 0xe4, 0x11, 0xf8, 0x99, 0x48, 0x66, 0x00, 0xc2,
+	0x5f, 0x1e, 0x56, 0x47, 0xaf, 0xe0, 0x4c, 0x32, 0xfc, 0x49, 0x6d, 0xe4, 0x55, 0x9a, 0xe5, 0xe1,
+	0x41, 0x2f, 0x61, 0x09, 0xd3, 0x16, 0xbe, 0x3a, 0x95, 0xc6, 0x07, 0xfd, 0x88, 0x89, 0x8c, 0x09,
+	0x7f, 0x4a, 0x04, 0xf8, 0xcb, 0xc3, 0x29, 0x48, 0x72, 0xe8, 0x47, 0x8c, 0xe6, 0x46, 0x3f, 0x48,
+	0x18, 0x4b, 0x52, 0xf0, 0xf5, 0x6d, 0xba, 0x98, 0xf9, 0x92, 0x66, 0x20, 0x24, 0xc9, 0x8a, 0xd2,
+	0xc0, 0xfd, 0xcd, 0x42, 0xed, 0x09, 0xe1, 0x24, 0x13, 0xf8, 0x29, 0xda, 0x9f, 0xa5, 0x8c, 0xf1,
+	0x30, 0x21, 0x22, 0x2c, 0x38, 0x8d, 0xc0, 0xd9, 0x1a, 0x5a, 0x23, 0xfb, 0xe8, 0x9e, 0x57, 0x46,
+	0xf1, 0x54, 0x14, 0xcf, 0x44, 0xf1, 0x4e, 0x19, 0xcd, 0x4f, 0x5a, 0x6f, 0xfe, 0x1c, 0x34, 0x82,
+	0x5d, 0xed, 0xf7, 0x94, 0x88, 0x89, 0xf2, 0xc2, 0xf7, 0xd1, 0x9d, 0x7c, 0x4e, 0xc4, 0x3c, 0x2c,
+	0x80, 0x87, 0x0b, 0x11, 0x87, 0x19, 0x4d, 0x9d, 0xe6, 0xd0, 0x1a, 0xb5, 0x82, 0x3d, 0xad, 0x98,
+	0x00, 0x7f, 0x21, 0xe2, 0x67, 0x34, 0xc5, 0x0f, 0x51, 0x2f, 0x62, 0xf9, 0x12, 0xb8, 0xa0, 0x2c,
+	0x0f, 0x67, 0x00, 0x61, 0x0c, 0x39, 0xcb, 0x9c, 0xd6, 0xd0, 0x1a, 0x75, 0x03, 0x5c, 0xeb, 0xc6,
+	0x00, 0xdf, 0x28, 0xcd, 0xe3, 0xd6, 0x3f, 0xbf, 0x0e, 0x1a, 0xee, 0xdf, 0x5b, 0xa8, 0xfd, 0x4c,
+	0x24, 0x63, 0x00, 0x3c, 0x44, 0x3b, 0x99, 0x48, 0x42, 0x79, 0x5e, 0x40, 0xb8, 0xe0, 0xa9, 0x63,
+	0x69, 0x57, 0x94, 0x89, 0xe4, 0xec, 0xbc, 0x80, 0x17, 0x3c, 0xc5, 0x63, 0xb4, 0x47, 0xe2, 0x98,
+	0x4a, 0xca, 0x72, 0x92, 0xaa, 0x20, 0xff, 0xb9, 0xae, 0xda, 0x4d, 0x45, 0xfa, 0x14, 0x75, 0x39,
+	0x44, 0xb4, 0xa0, 0x90, 0x4b, 0x5d, 0x4f, 0x37, 0xa8, 0x05, 0xf8, 0x2b, 0x74, 0x77, 0x75, 0x09,
+	0xa7, 0x44, 0x50, 0x11, 0x16, 0x8c, 0xe6, 0x52, 0xe8, 0x62, 0x76, 0x83, 0xde, 0x4a, 0x7b, 0xa2,
+	0x94, 0x13, 0xad, 0xc3, 0x3f, 0xa2, 0xdb, 0xb5, 0x97, 0x28, 0x52, 0x2a, 0x85, 0xb3, 0x3d, 0x6c,
+	0x8e, 0xec, 0xa3, 0x2f, 0xbd, 0x2b, 0x07, 0xc1, 0x0b, 0x2a, 0xf3, 0xe7, 0xca, 0xda, 0x64, 0xba,
+	0xcf, 0x37, 0xa4, 0x02, 0xdf, 0x47, 0xb7, 0x23, 0x96, 0x4b, 0x4e, 0x22, 0x19, 0x92, 0x38, 0xe6,
+	0x20, 0x84, 0xd3, 0xd6, 0x29, 0xef, 0x57, 0xf2, 0xe3, 0x52, 0x8c, 0xef, 0xa2, 0x76, 0x06, 0x72,
+	0xce, 0x62, 0xa7, 0xa3, 0x0d, 0xcc, 0xcd, 0xfd, 0x01, 0xed, 0x6d, 0xc6, 0xda, 0x6c, 0x80, 0xf5,
+	0x7e, 0x03, 0x3e, 0x47, 0x3b, 0x1b, 0x65, 0x6f, 0xe9, 0xb2, 0xed, 0x69, 0x5d, 0xad, 0x0b, 0xa8,
+	0x33, 0x06, 0x38, 0xa3, 0xc0, 0x31, 0x46, 0xad, 0x9c, 0x64, 0x60, 0x60, 0xf4, 0xf9, 0x63, 0x11,
+	0xe5, 0x7e, 0x87, 0xee, 0x98, 0x30, 0xc7, 0x42, 0xd0, 0x24, 0xcf, 0x54, 0x7a, 0x37, 0xcf, 0x09,
+	0x46, 0x2d, 0x49, 0x81, 0xeb, 0xa0, 0xdd, 0x40, 0x9f, 0xdd, 0xd7, 0x5b, 0x08, 0x8d, 0x01, 0x4e,
+	0x89, 0x24, 0x29, 0x4b, 0xf0, 0x63, 0xb4, 0xad, 0xc4, 0xc2, 0xb1, 0x34, 0x47, 0xfd, 0x6b, 0x38,
+	0x32, 0xd1, 0x4d, 0x76, 0xa5, 0x0b, 0x9e, 0x20, 0x9b, 0xac, 0xd2, 0x51, 0xed, 0x51, 0x08, 0xa3,
+	0x0f, 0x23, 0xd4, 0xf9, 0x1b, 0xac, 0x75, 0x08, 0x7c, 0x8c, 0xba, 0x6c, 0x09, 0x9c, 0xd3, 0x18,
+	0x84, 0xd3, 0xd4, 0x78, 0x9f, 0x5d, 0x83, 0x57, 0x3e, 0x16, 0x03, 0x52, 0x7b, 0xe1, 0xef, 0xd1,
+	0x1e, 0xcc, 0x66, 0x10, 0x49, 0xba, 0x84, 0x50, 0x2d, 0x07, 0x3d, 0xad, 0xf6, 0xd1, 0x81, 0x57,
+	0x6e, 0x0e, 0xaf, 0xda, 0x1c, 0xde, 0x59, 0xb5, 0x39, 0x4e, 0x6e, 0x29, 0x90, 0x57, 0xef, 0x06,
+	0x56, 0xb0, 0xbb, 0xf2, 0x55, 0x5a, 0xf7, 0x17, 0x0b, 0xed, 0x8c, 0x01, 0x9e, 0xbc, 0x84, 0xac,
+	0x50, 0x6c, 0x5c, 0x49, 0xb2, 0x8b, 0x76, 0xd7, 0x79, 0x28, 0x1b, 0xd1, 0x0d, 0xec, 0x9a, 0x08,
+	0xa1, 0x06, 0xcd, 0x0c, 0xad, 0x29, 0xac, 0x1b, 0xd4, 0x02, 0xad, 0x95, 0x92, 0xd3, 0xe9, 0x42,
+	0x82, 0xd9, 0x14, 0xb5, 0xc0, 0xfd, 0xbd, 0x64, 0xec, 0x38, 0x8a, 0xf8, 0x82, 0xa4, 0x37, 0xcc,
+	0xec, 0x3d, 0x74, 0xab, 0x4a, 0xc6, 0xd0, 0xde, 0x31, 0x79, 0xe0, 0xaf, 0x51, 0x33, 0x26, 0xe7,
+	0x4e, 0xf3, 0x7f, 0xb4, 0x43, 0x39, 0xe0, 0x1e, 0xda, 0x8e, 0xd8, 0x22, 0x97, 0x3a, 0xb3, 0x56,
+	0x50, 0x5e, 0x30, 0x41, 0xdb, 0x92, 0x49, 0x92, 0x9a, 0xc7, 0xfd, 0x81, 0x89, 0x7e, 0xa8, 0xe0,
+	0x5e, 0xbf, 0x1b, 0x8c, 0x12, 0x2a, 0xe7, 0x8b, 0xa9, 0x17, 0xb1, 0xcc, 0x37, 0x5b, 0xbe, 0xfc,
+	0x3c, 0x10, 0xf1, 0xcf, 0xbe, 0xca, 0x58, 0x68, 0x07, 0x11, 0x94, 0xc8, 0xea, 0xfd, 0xcd, 0x28,
+	0x17, 0x32, 0x9c, 0x03, 0x4d, 0xe6, 0x52, 0x3f, 0xf7, 0x66, 0x60, 0x6b, 0xd9, 0xb7, 0x5a, 0x84,
+	0x07, 0xc8, 0x4e, 0x49, 0x6d, 0xd1, 0xd1, 0x16, 0x28, 0x25, 0x95, 0x81, 0xcb, 0x91, 0xfd, 0x64,
+	0x09, 0xb9, 0x34, 0xbb, 0x75, 0xbd, 0x3d, 0xd6, 0x66, 0x7b, 0x56, 0x65, 0x96, 0x6d, 0x33, 0x65,
+	0xf6, 0xaa, 0x32, 0xcb, 0xf5, 0x68, 0x32, 0xdb, 0xe0, 0xa0, 0xf5, 0x1e, 0x07, 0xee, 0x73, 0xb4,
+	0xb3, 0x16, 0x53, 0xe0, 0xd3, 0x32, 0xa8, 0x9a, 0x5c, 0xf3, 0xcc, 0xdc, 0x6b, 0x86, 0x7a, 0xcd,
+	0xcd, 0x4c, 0x76, 0x27, 0x2b, 0x41, 0x4e, 0xe8, 0x9b, 0x8b, 0xbe, 0xf5, 0xf6, 0xa2, 0x6f, 0xfd,
+	0x75, 0xd1, 0xb7, 0x5e, 0x5d, 0xf6, 0x1b, 0x6f, 0x2f, 0xfb, 0x8d, 0x3f, 0x2e, 0xfb, 0x0d, 0xe4,
+	0x50, 0x76, 0x35, 0xdc, 0xc4, 0xfa, 0xe9, 0xd1, 0x5a, 0xcf, 0x6b, 0x9b, 0x07, 0x94, 0xad, 0xdd,
+	0xfc, 0x97, 0xab, 0x7f, 0xb7, 0x26, 0x61, 0xda, 0xd6, 0x33, 0xf1, 0xe8, 0xdf, 0x01, 0x00, 0x1b,
+	0x74, 0x81, 0x51, 0xde, 0x07, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConversionFeeDenom) > 0 {
+		i -= len(m.ConversionFeeDenom)
+		copy(dAtA[i:], m.ConversionFeeDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if len(m.MsgType) > 0 {
-		i -= len(m.MsgType)
-		copy(dAtA[i:], m.MsgType)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+	if m.NhashPerUsdMil != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FloorGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -494,20 +877,34 @@ func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMsgFees) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MsgFees) > 0 {
-		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RecipientSplits) > 0 {
+		for iNdEx := len(m.RecipientSplits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RecipientSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -515,110 +912,1608 @@ func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintMsgfees(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgfees(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.RecipientBasisPoints != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.RecipientBasisPoints))
+		i--
+		dAtA[i] = 0x20
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RecipientSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.FloorGasPrice.Size()
-	n += 1 + l + sovMsgfees(uint64(l))
-	if m.NhashPerUsdMil != 0 {
-		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
+	if m.BasisPoints != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ConversionFeeDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	{
+		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
 	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovMsgfees(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.RecipientBasisPoints != 0 {
-		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTierAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventMsgFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeTierAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTierAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCatalog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCatalog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCatalog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgType)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	l = len(m.Count)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	i -= n4
+	i = encodeVarintMsgfees(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMsgFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MsgFees) > 0 {
-		for _, e := range m.MsgFees {
-			l = e.Size()
-			n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Day, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Day):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMsgfees(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgfees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FloorGasPrice.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if m.NhashPerUsdMil != 0 {
+		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
+	}
+	l = len(m.ConversionFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.RecipientBasisPoints != 0 {
+		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
+	}
+	if len(m.RecipientSplits) > 0 {
+		for _, e := range m.RecipientSplits {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *RecipientSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovMsgfees(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	return n
+}
+
+func (m *FeeTierAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeCatalog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovMsgfees(uint64(l))
+	return n
+}
+
+func (m *FeeExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Day)
+	n += 1 + l + sovMsgfees(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovMsgfees(uint64(m.Count))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if m.FirstHeight != 0 {
+		n += 1 + sovMsgfees(uint64(m.FirstHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovMsgfees(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *EventMsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMsgFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMsgfees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgfees(x uint64) (n int) {
+	return sovMsgfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			}
+			m.NhashPerUsdMil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			m.RecipientBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientSplits = append(m.RecipientSplits, RecipientSplit{})
+			if err := m.RecipientSplits[len(m.RecipientSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipientSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTierAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTierAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTierAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCatalog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCatalog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCatalog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, FeeTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, FeeTierAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides, MsgFee{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovMsgfees(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgfees(x uint64) (n int) {
-	return sovMsgfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeExemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -641,17 +2536,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeExemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeExemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -661,30 +2556,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgfees
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgfees
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			m.NhashPerUsdMil = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -694,14 +2620,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -729,7 +2668,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Attribute = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -752,7 +2691,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFee) Unmarshal(dAtA []byte) error {
+func (m *FeeAccrual) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,15 +2714,15 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeAccrual: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -811,11 +2750,43 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -842,15 +2813,34 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Day, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -860,29 +2850,31 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgfees
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgfees
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
 			}
-			m.RecipientBasisPoints = 0
+			m.FirstHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -892,7 +2884,26 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecipientBasisPoints |= uint32(b&0x7F) << shift
+				m.FirstHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
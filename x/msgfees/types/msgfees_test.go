@@ -45,6 +45,24 @@ func TestMsgFeeValidate(t *testing.T) {
 			NewMsgFee(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), "", DefaultMsgFeeBips),
 			"invalid fee amount",
 		},
+		{
+			"should succeed to validate with recipient splits",
+			withSplits(NewMsgFee(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "", 0),
+				RecipientSplit{Recipient: validAddress, BasisPoints: 6_000}, RecipientSplit{BasisPoints: 4_000}),
+			"",
+		},
+		{
+			"should fail to validate with recipient splits and a recipient",
+			withSplits(NewMsgFee(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), validAddress, DefaultMsgFeeBips),
+				RecipientSplit{Recipient: validAddress, BasisPoints: 10_000}),
+			ErrRecipientSplitsWithRecipient.Error(),
+		},
+		{
+			"should fail to validate with recipient splits that do not total 10,000",
+			withSplits(NewMsgFee(sdk.MsgTypeURL(&MsgAssessCustomMsgFeeRequest{}), sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), "", 0),
+				RecipientSplit{Recipient: validAddress, BasisPoints: 6_000}),
+			"recipient split basis points must total 10,000 : 6000",
+		},
 	}
 
 	for _, tc := range cases {
@@ -60,3 +78,9 @@ func TestMsgFeeValidate(t *testing.T) {
 		})
 	}
 }
+
+// withSplits returns the provided msg fee after setting its recipient splits.
+func withSplits(msgFee MsgFee, splits ...RecipientSplit) MsgFee {
+	msgFee.RecipientSplits = splits
+	return msgFee
+}
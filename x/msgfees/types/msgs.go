@@ -25,6 +25,9 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgRemoveMsgFeeProposalRequest)(nil),
 	(*MsgUpdateConversionFeeDenomProposalRequest)(nil),
 	(*MsgUpdateNhashPerUsdMilProposalRequest)(nil),
+	(*MsgScheduleFeeCatalogProposalRequest)(nil),
+	(*MsgSetFeeExemptionProposalRequest)(nil),
+	(*MsgRemoveFeeExemptionProposalRequest)(nil),
 }
 
 func NewMsgAssessCustomMsgFeeRequest(
@@ -76,13 +79,14 @@ func (msg MsgAssessCustomMsgFeeRequest) GetBips() (uint32, error) {
 	return uint32(bips), err //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 }
 
-func NewMsgAddMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgAddMsgFeeProposalRequest {
+func NewMsgAddMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, recipientSplits []RecipientSplit, authority string) *MsgAddMsgFeeProposalRequest {
 	return &MsgAddMsgFeeProposalRequest{
 		MsgTypeUrl:           msgTypeURL,
 		AdditionalFee:        additionalFee,
 		Recipient:            recipient,
 		RecipientBasisPoints: recipientBasisPoints,
 		Authority:            authority,
+		RecipientSplits:      recipientSplits,
 	}
 }
 
@@ -99,7 +103,11 @@ func (msg *MsgAddMsgFeeProposalRequest) ValidateBasic() error {
 		return err
 	}
 
-	if err := ValidateBips(msg.Recipient, msg.RecipientBasisPoints); err != nil {
+	if err := ValidateContractFee(msg.MsgTypeUrl, msg.ContractAddress, msg.Method); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeRecipients(msg.Recipient, msg.RecipientBasisPoints, msg.RecipientSplits); err != nil {
 		return err
 	}
 
@@ -111,6 +119,18 @@ func (msg *MsgAddMsgFeeProposalRequest) ValidateBasic() error {
 	return nil
 }
 
+// ValidateFeeRecipients validates either the recipient and basis points or the recipient splits.
+// Recipient splits cannot be provided along with a recipient or basis points.
+func ValidateFeeRecipients(recipient, recipientBasisPoints string, recipientSplits []RecipientSplit) error {
+	if len(recipientSplits) == 0 {
+		return ValidateBips(recipient, recipientBasisPoints)
+	}
+	if len(recipient) != 0 || len(recipientBasisPoints) != 0 {
+		return ErrRecipientSplitsWithRecipient
+	}
+	return ValidateRecipientSplits(recipientSplits)
+}
+
 func ValidateBips(recipient, recipientBasisPoints string) error {
 	if len(recipient) != 0 {
 		_, err := sdk.AccAddressFromBech32(recipient)
@@ -133,13 +153,14 @@ func ValidateBips(recipient, recipientBasisPoints string) error {
 	return nil
 }
 
-func NewMsgUpdateMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgUpdateMsgFeeProposalRequest {
+func NewMsgUpdateMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, recipientSplits []RecipientSplit, authority string) *MsgUpdateMsgFeeProposalRequest {
 	return &MsgUpdateMsgFeeProposalRequest{
 		MsgTypeUrl:           msgTypeURL,
 		AdditionalFee:        additionalFee,
 		Recipient:            recipient,
 		RecipientBasisPoints: recipientBasisPoints,
 		Authority:            authority,
+		RecipientSplits:      recipientSplits,
 	}
 }
 
@@ -152,7 +173,11 @@ func (msg *MsgUpdateMsgFeeProposalRequest) ValidateBasic() error {
 		return ErrInvalidFee
 	}
 
-	if err := ValidateBips(msg.Recipient, msg.RecipientBasisPoints); err != nil {
+	if err := ValidateContractFee(msg.MsgTypeUrl, msg.ContractAddress, msg.Method); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeRecipients(msg.Recipient, msg.RecipientBasisPoints, msg.RecipientSplits); err != nil {
 		return err
 	}
 
@@ -176,6 +201,10 @@ func (msg *MsgRemoveMsgFeeProposalRequest) ValidateBasic() error {
 		return ErrEmptyMsgType
 	}
 
+	if err := ValidateContractFee(msg.MsgTypeUrl, msg.ContractAddress, msg.Method); err != nil {
+		return err
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
 		return err
@@ -223,3 +252,63 @@ func (msg *MsgUpdateNhashPerUsdMilProposalRequest) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgScheduleFeeCatalogProposalRequest(catalog FeeCatalog, authority string) *MsgScheduleFeeCatalogProposalRequest {
+	return &MsgScheduleFeeCatalogProposalRequest{
+		Catalog:   catalog,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgScheduleFeeCatalogProposalRequest) ValidateBasic() error {
+	if err := msg.Catalog.Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgSetFeeExemptionProposalRequest(exemption FeeExemption, authority string) *MsgSetFeeExemptionProposalRequest {
+	return &MsgSetFeeExemptionProposalRequest{
+		Exemption: exemption,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgSetFeeExemptionProposalRequest) ValidateBasic() error {
+	if err := msg.Exemption.Validate(); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgRemoveFeeExemptionProposalRequest(name string, authority string) *MsgRemoveFeeExemptionProposalRequest {
+	return &MsgRemoveFeeExemptionProposalRequest{
+		Name:      name,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgRemoveFeeExemptionProposalRequest) ValidateBasic() error {
+	if len(msg.Name) == 0 {
+		return errors.New("fee exemption name cannot be empty")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -21,6 +21,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgRemoveMsgFeeProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateConversionFeeDenomProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateNhashPerUsdMilProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgScheduleFeeCatalogProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetFeeExemptionProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveFeeExemptionProposalRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -577,3 +580,136 @@ func TestValidateBips(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFeeRecipients(t *testing.T) {
+	addr1 := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	cases := []struct {
+		name                 string
+		recipient            string
+		recipientBasisPoints string
+		recipientSplits      []RecipientSplit
+		expectedError        string
+	}{
+		{
+			name:                 "no splits: uses recipient and basis points",
+			recipient:            addr1,
+			recipientBasisPoints: "5000",
+		},
+		{
+			name:                 "no splits: invalid basis points",
+			recipient:            addr1,
+			recipientBasisPoints: "10001",
+			expectedError:        "recipient basis points can only be between 0 and 10,000 : 10001",
+		},
+		{
+			name:            "splits with recipient",
+			recipient:       addr1,
+			recipientSplits: []RecipientSplit{{Recipient: addr2, BasisPoints: 10_000}},
+			expectedError:   ErrRecipientSplitsWithRecipient.Error(),
+		},
+		{
+			name:                 "splits with basis points",
+			recipientBasisPoints: "5000",
+			recipientSplits:      []RecipientSplit{{Recipient: addr2, BasisPoints: 10_000}},
+			expectedError:        ErrRecipientSplitsWithRecipient.Error(),
+		},
+		{
+			name:            "split with invalid recipient",
+			recipientSplits: []RecipientSplit{{Recipient: addr1, BasisPoints: 5_000}, {Recipient: "invalid", BasisPoints: 5_000}},
+			expectedError:   `invalid recipient split[1] recipient "invalid": decoding bech32 failed: invalid bech32 string length 7`,
+		},
+		{
+			name:            "split with zero basis points",
+			recipientSplits: []RecipientSplit{{Recipient: addr1, BasisPoints: 10_000}, {Recipient: addr2}},
+			expectedError:   "invalid recipient split[1]: basis points can only be between 1 and 10,000 : 0",
+		},
+		{
+			name:            "duplicate recipient",
+			recipientSplits: []RecipientSplit{{Recipient: addr1, BasisPoints: 5_000}, {Recipient: addr1, BasisPoints: 5_000}},
+			expectedError:   `invalid recipient split[1]: duplicate recipient "` + addr1 + `"`,
+		},
+		{
+			name:            "splits total less than 10,000",
+			recipientSplits: []RecipientSplit{{Recipient: addr1, BasisPoints: 5_000}, {Recipient: addr2, BasisPoints: 3_000}},
+			expectedError:   "recipient split basis points must total 10,000 : 8000",
+		},
+		{
+			name:            "splits total more than 10,000",
+			recipientSplits: []RecipientSplit{{Recipient: addr1, BasisPoints: 5_000}, {Recipient: addr2, BasisPoints: 6_000}},
+			expectedError:   "recipient split basis points must total 10,000 : 11000",
+		},
+		{
+			name: "valid splits including fee collector",
+			recipientSplits: []RecipientSplit{
+				{Recipient: addr1, BasisPoints: 5_000},
+				{Recipient: addr2, BasisPoints: 3_000},
+				{Recipient: "", BasisPoints: 2_000},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateFeeRecipients(tc.recipient, tc.recipientBasisPoints, tc.recipientSplits)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateContractFee(t *testing.T) {
+	contract := sdk.AccAddress("contract____________").String()
+
+	cases := []struct {
+		name          string
+		msgTypeURL    string
+		contract      string
+		method        string
+		expectedError string
+	}{
+		{name: "no contract or method", msgTypeURL: "/cosmos.bank.v1beta1.MsgSend"},
+		{
+			name:          "method without contract",
+			msgTypeURL:    ExecuteContractMsgTypeURL,
+			method:        "swap",
+			expectedError: "a contract method cannot be provided without a contract address",
+		},
+		{
+			name:          "contract with wrong msg type",
+			msgTypeURL:    "/cosmos.bank.v1beta1.MsgSend",
+			contract:      contract,
+			expectedError: `a contract address can only be provided with msg type url "/cosmwasm.wasm.v1.MsgExecuteContract"`,
+		},
+		{
+			name:          "invalid contract",
+			msgTypeURL:    ExecuteContractMsgTypeURL,
+			contract:      "invalid",
+			expectedError: `invalid contract address "invalid": decoding bech32 failed: invalid bech32 string length 7`,
+		},
+		{name: "contract without method", msgTypeURL: ExecuteContractMsgTypeURL, contract: contract},
+		{name: "contract with method", msgTypeURL: ExecuteContractMsgTypeURL, contract: contract, method: "swap"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateContractFee(tc.msgTypeURL, tc.contract, tc.method)
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFeeKeyName(t *testing.T) {
+	require.Equal(t, "/a.Msg", MsgFeeKeyName("/a.Msg", "", ""), "no contract")
+	require.Equal(t, "/a.Msg", MsgFeeKeyName("/a.Msg", "", "swap"), "method without contract")
+	require.Equal(t, "/a.Msg\ncontract", MsgFeeKeyName("/a.Msg", "contract", ""), "contract without method")
+	require.Equal(t, "/a.Msg\ncontract\nswap", MsgFeeKeyName("/a.Msg", "contract", "swap"), "contract with method")
+}
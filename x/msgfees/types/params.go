@@ -19,6 +19,11 @@ func DefaultFloorGasPrice() sdk.Coin {
 
 var DefaultNhashPerUsdMil = uint64(25_000_000)
 
+// MaxUsdNavDeviationBips is how far (in basis points) a usd conversion that uses the conversion fee denom's net asset
+// value can be from one that uses the NhashPerUsdMil param. Net asset values are updated by things like exchange
+// settlements, so this keeps anyone who can move one from making msg fees much cheaper (or more expensive).
+const MaxUsdNavDeviationBips = 5_000
+
 // NewParams creates a new parameter object
 func NewParams(
 	floorGasPrice sdk.Coin,
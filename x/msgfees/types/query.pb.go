@@ -14,19 +14,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -217,6 +221,336 @@ func (m *QueryAllMsgFeesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryFeeCatalogRequest is the request type for the Query/FeeCatalog RPC method.
+type QueryFeeCatalogRequest struct {
+}
+
+func (m *QueryFeeCatalogRequest) Reset()         { *m = QueryFeeCatalogRequest{} }
+func (m *QueryFeeCatalogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeCatalogRequest) ProtoMessage()    {}
+func (*QueryFeeCatalogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+}
+func (m *QueryFeeCatalogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeCatalogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeCatalogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeCatalogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeCatalogRequest.Merge(m, src)
+}
+func (m *QueryFeeCatalogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeCatalogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeCatalogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeCatalogRequest proto.InternalMessageInfo
+
+// QueryFeeCatalogResponse is the response type for the Query/FeeCatalog RPC method.
+type QueryFeeCatalogResponse struct {
+	// active is the fee catalog currently in effect.
+	Active *FeeCatalog `protobuf:"bytes,1,opt,name=active,proto3" json:"active,omitempty"`
+	// scheduled is the fee catalog that will take effect at its effective time.
+	Scheduled *FeeCatalog `protobuf:"bytes,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+}
+
+func (m *QueryFeeCatalogResponse) Reset()         { *m = QueryFeeCatalogResponse{} }
+func (m *QueryFeeCatalogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeCatalogResponse) ProtoMessage()    {}
+func (*QueryFeeCatalogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+}
+func (m *QueryFeeCatalogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeCatalogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeCatalogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeCatalogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeCatalogResponse.Merge(m, src)
+}
+func (m *QueryFeeCatalogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeCatalogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeCatalogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeCatalogResponse proto.InternalMessageInfo
+
+func (m *QueryFeeCatalogResponse) GetActive() *FeeCatalog {
+	if m != nil {
+		return m.Active
+	}
+	return nil
+}
+
+func (m *QueryFeeCatalogResponse) GetScheduled() *FeeCatalog {
+	if m != nil {
+		return m.Scheduled
+	}
+	return nil
+}
+
+// QueryFeeExemptionsRequest is the request type for the Query/FeeExemptions RPC method.
+type QueryFeeExemptionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeExemptionsRequest) Reset()         { *m = QueryFeeExemptionsRequest{} }
+func (m *QueryFeeExemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeExemptionsRequest) ProtoMessage()    {}
+func (*QueryFeeExemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{6}
+}
+func (m *QueryFeeExemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeExemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeExemptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeExemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeExemptionsRequest.Merge(m, src)
+}
+func (m *QueryFeeExemptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeExemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeExemptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeExemptionsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeExemptionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeExemptionsResponse is the response type for the Query/FeeExemptions RPC method.
+type QueryFeeExemptionsResponse struct {
+	// exemptions are all of the fee exemptions.
+	Exemptions []FeeExemption `protobuf:"bytes,1,rep,name=exemptions,proto3" json:"exemptions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeExemptionsResponse) Reset()         { *m = QueryFeeExemptionsResponse{} }
+func (m *QueryFeeExemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeExemptionsResponse) ProtoMessage()    {}
+func (*QueryFeeExemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{7}
+}
+func (m *QueryFeeExemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeExemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeExemptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeExemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeExemptionsResponse.Merge(m, src)
+}
+func (m *QueryFeeExemptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeExemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeExemptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeExemptionsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeExemptionsResponse) GetExemptions() []FeeExemption {
+	if m != nil {
+		return m.Exemptions
+	}
+	return nil
+}
+
+func (m *QueryFeeExemptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeAccrualsRequest is the request type for the Query/FeeAccruals RPC method.
+type QueryFeeAccrualsRequest struct {
+	// recipient is the address that received the fees. Use the fee collector's module address for the fees it received.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// msg_type is an optional type-url to limit the results to.
+	MsgType string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	// start_time is the optional beginning of the time range. The day it is in is included.
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time is the optional end of the time range. The day it is in is included.
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *QueryFeeAccrualsRequest) Reset()         { *m = QueryFeeAccrualsRequest{} }
+func (m *QueryFeeAccrualsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAccrualsRequest) ProtoMessage()    {}
+func (*QueryFeeAccrualsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{8}
+}
+func (m *QueryFeeAccrualsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAccrualsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAccrualsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeAccrualsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAccrualsRequest.Merge(m, src)
+}
+func (m *QueryFeeAccrualsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAccrualsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAccrualsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeAccrualsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeAccrualsRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryFeeAccrualsRequest) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *QueryFeeAccrualsRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *QueryFeeAccrualsRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+// QueryFeeAccrualsResponse is the response type for the Query/FeeAccruals RPC method.
+type QueryFeeAccrualsResponse struct {
+	// accruals are the daily fee totals that match the request, ordered by day then msg type.
+	Accruals []FeeAccrual `protobuf:"bytes,1,rep,name=accruals,proto3" json:"accruals"`
+	// count is the total number of times the fees were collected.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// total is the sum of the fees in the accruals.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryFeeAccrualsResponse) Reset()         { *m = QueryFeeAccrualsResponse{} }
+func (m *QueryFeeAccrualsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeAccrualsResponse) ProtoMessage()    {}
+func (*QueryFeeAccrualsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{9}
+}
+func (m *QueryFeeAccrualsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeAccrualsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeAccrualsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeAccrualsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeAccrualsResponse.Merge(m, src)
+}
+func (m *QueryFeeAccrualsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeAccrualsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeAccrualsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeAccrualsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeAccrualsResponse) GetAccruals() []FeeAccrual {
+	if m != nil {
+		return m.Accruals
+	}
+	return nil
+}
+
+func (m *QueryFeeAccrualsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryFeeAccrualsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 // CalculateTxFeesRequest is the request type for the Query RPC method.
 type CalculateTxFeesRequest struct {
 	// tx_bytes is the transaction to simulate.
@@ -232,7 +566,7 @@ func (m *CalculateTxFeesRequest) Reset()         { *m = CalculateTxFeesRequest{}
 func (m *CalculateTxFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesRequest) ProtoMessage()    {}
 func (*CalculateTxFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{4}
+	return fileDescriptor_73f2d53a5aebf81b, []int{10}
 }
 func (m *CalculateTxFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,13 +625,19 @@ type CalculateTxFeesResponse struct {
 	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
 	// estimated_gas is the amount of gas needed for the transaction
 	EstimatedGas uint64 `protobuf:"varint,3,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
+	// base_fees are the gas fees needed for the transaction, i.e. estimated_gas * floor_gas_price.
+	BaseFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=base_fees,json=baseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"base_fees"`
+	// floor_gas_price is the gas price used to calculate the base_fees.
+	FloorGasPrice types.Coin `protobuf:"bytes,5,opt,name=floor_gas_price,json=floorGasPrice,proto3" json:"floor_gas_price"`
+	// msg_fees is a breakdown of the additional_fees by msg type and recipient.
+	MsgFees []EventMsgFee `protobuf:"bytes,6,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
 }
 
 func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse{} }
 func (m *CalculateTxFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*CalculateTxFeesResponse) ProtoMessage()    {}
 func (*CalculateTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+	return fileDescriptor_73f2d53a5aebf81b, []int{11}
 }
 func (m *CalculateTxFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,11 +687,38 @@ func (m *CalculateTxFeesResponse) GetEstimatedGas() uint64 {
 	return 0
 }
 
+func (m *CalculateTxFeesResponse) GetBaseFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BaseFees
+	}
+	return nil
+}
+
+func (m *CalculateTxFeesResponse) GetFloorGasPrice() types.Coin {
+	if m != nil {
+		return m.FloorGasPrice
+	}
+	return types.Coin{}
+}
+
+func (m *CalculateTxFeesResponse) GetMsgFees() []EventMsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.msgfees.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryAllMsgFeesRequest)(nil), "provenance.msgfees.v1.QueryAllMsgFeesRequest")
 	proto.RegisterType((*QueryAllMsgFeesResponse)(nil), "provenance.msgfees.v1.QueryAllMsgFeesResponse")
+	proto.RegisterType((*QueryFeeCatalogRequest)(nil), "provenance.msgfees.v1.QueryFeeCatalogRequest")
+	proto.RegisterType((*QueryFeeCatalogResponse)(nil), "provenance.msgfees.v1.QueryFeeCatalogResponse")
+	proto.RegisterType((*QueryFeeExemptionsRequest)(nil), "provenance.msgfees.v1.QueryFeeExemptionsRequest")
+	proto.RegisterType((*QueryFeeExemptionsResponse)(nil), "provenance.msgfees.v1.QueryFeeExemptionsResponse")
+	proto.RegisterType((*QueryFeeAccrualsRequest)(nil), "provenance.msgfees.v1.QueryFeeAccrualsRequest")
+	proto.RegisterType((*QueryFeeAccrualsResponse)(nil), "provenance.msgfees.v1.QueryFeeAccrualsResponse")
 	proto.RegisterType((*CalculateTxFeesRequest)(nil), "provenance.msgfees.v1.CalculateTxFeesRequest")
 	proto.RegisterType((*CalculateTxFeesResponse)(nil), "provenance.msgfees.v1.CalculateTxFeesResponse")
 }
@@ -359,52 +726,79 @@ func init() {
 func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
 
 var fileDescriptor_73f2d53a5aebf81b = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xc7, 0x3b, 0x85, 0x97, 0x1f, 0xf3, 0xf2, 0xe3, 0x7d, 0x47, 0x84, 0xd2, 0x40, 0xc1, 0x25,
-	0x28, 0x34, 0xb2, 0x9b, 0x82, 0x07, 0xa3, 0x27, 0x8a, 0x81, 0x93, 0x09, 0x6e, 0x3c, 0x79, 0x59,
-	0xa7, 0xbb, 0xc3, 0xb8, 0xba, 0xbb, 0xb3, 0x74, 0xa6, 0x4d, 0x7b, 0x33, 0x1e, 0x8c, 0xf1, 0x64,
-	0xa2, 0x27, 0xe3, 0xd9, 0x18, 0x4f, 0xfc, 0x19, 0x9c, 0x0c, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0x84,
-	0x7f, 0xc3, 0xcc, 0xcc, 0xb6, 0x5d, 0x68, 0x8b, 0x9c, 0xbc, 0xc0, 0xf6, 0x99, 0xe7, 0x99, 0xef,
-	0x67, 0x9e, 0xf9, 0x3e, 0x03, 0xaf, 0xc5, 0x55, 0x56, 0x27, 0x11, 0x8e, 0x5c, 0x62, 0x85, 0x9c,
-	0xee, 0x11, 0xc2, 0xad, 0x7a, 0xc9, 0xda, 0xaf, 0x91, 0x6a, 0xd3, 0x8c, 0xab, 0x4c, 0x30, 0x74,
-	0xb5, 0x93, 0x62, 0x26, 0x29, 0x66, 0xbd, 0x94, 0xff, 0x1f, 0x87, 0x7e, 0xc4, 0x2c, 0xf5, 0x57,
-	0x67, 0xe6, 0xa7, 0x28, 0xa3, 0x4c, 0x7d, 0x5a, 0xf2, 0x2b, 0x89, 0xce, 0x51, 0xc6, 0x68, 0x40,
-	0x2c, 0x1c, 0xfb, 0x16, 0x8e, 0x22, 0x26, 0xb0, 0xf0, 0x59, 0xc4, 0x93, 0xd5, 0xa5, 0xde, 0x00,
-	0x2d, 0x21, 0x9d, 0x54, 0x70, 0x19, 0x0f, 0x19, 0xb7, 0x2a, 0x98, 0x13, 0xab, 0x5e, 0xaa, 0x10,
-	0x81, 0x4b, 0x96, 0xcb, 0xfc, 0x28, 0x59, 0x2f, 0xa6, 0xd7, 0x15, 0x7b, 0x3b, 0x2b, 0xc6, 0xd4,
-	0x8f, 0x94, 0xa2, 0xce, 0x35, 0xa6, 0x20, 0x7a, 0x20, 0x33, 0x76, 0x71, 0x15, 0x87, 0xdc, 0x26,
-	0xfb, 0x35, 0xc2, 0x85, 0x61, 0xc3, 0x2b, 0x67, 0xa2, 0x3c, 0x66, 0x11, 0x27, 0xe8, 0x2e, 0x1c,
-	0x8a, 0x55, 0x24, 0x07, 0x16, 0xc1, 0xca, 0xbf, 0xeb, 0xf3, 0x66, 0xcf, 0x66, 0x98, 0xba, 0xac,
-	0x3c, 0x78, 0xf8, 0x7d, 0x21, 0x63, 0x27, 0x25, 0xc6, 0x63, 0x38, 0xad, 0xf6, 0xdc, 0x0c, 0x82,
-	0xfb, 0x9c, 0x6e, 0x13, 0xd2, 0x52, 0x43, 0xdb, 0x10, 0x76, 0xb8, 0x72, 0x59, 0xb5, 0xf5, 0x75,
-	0x53, 0x1f, 0xc2, 0x94, 0x87, 0x30, 0xf5, 0x05, 0x24, 0x87, 0x30, 0x77, 0x31, 0x25, 0x49, 0xad,
-	0x9d, 0xaa, 0x34, 0x3e, 0x00, 0x38, 0xd3, 0x25, 0x91, 0xa0, 0xdf, 0x86, 0x23, 0x21, 0xa7, 0x8e,
-	0x24, 0xcc, 0x81, 0xc5, 0x81, 0x0b, 0xe0, 0x75, 0xa5, 0x3d, 0x1c, 0xea, 0x1d, 0xd0, 0x4e, 0x0f,
-	0xba, 0x1b, 0x7f, 0xa4, 0xd3, 0xb2, 0x67, 0xf0, 0x5e, 0x01, 0x38, 0xbd, 0x85, 0x03, 0xb7, 0x16,
-	0x60, 0x41, 0x1e, 0x36, 0xd2, 0x1d, 0x98, 0x85, 0x23, 0xa2, 0xe1, 0x54, 0x9a, 0x82, 0xe8, 0xd6,
-	0x8e, 0xd9, 0xc3, 0xa2, 0x51, 0x96, 0x3f, 0xd1, 0x4d, 0x88, 0x3c, 0xb2, 0x87, 0x6b, 0x81, 0x70,
-	0xa4, 0x98, 0xe3, 0x91, 0x88, 0x85, 0x0a, 0x63, 0xd4, 0xfe, 0x2f, 0x59, 0x29, 0x63, 0x4e, 0xee,
-	0xc9, 0x38, 0x5a, 0x86, 0x13, 0x14, 0x73, 0x07, 0x7b, 0x4f, 0x6b, 0x5c, 0x84, 0x24, 0x12, 0xb9,
-	0x81, 0x45, 0xb0, 0x92, 0xb5, 0xc7, 0x29, 0xe6, 0x9b, 0xed, 0xa0, 0xf1, 0x25, 0x0b, 0x67, 0xba,
-	0x50, 0x92, 0x4e, 0xbd, 0x06, 0x70, 0x12, 0x7b, 0x9e, 0x2f, 0x99, 0x71, 0x90, 0xee, 0xd8, 0xec,
-	0x99, 0x53, 0xb7, 0xce, 0xbb, 0xc5, 0xfc, 0xa8, 0xbc, 0x2d, 0xaf, 0xfa, 0xf3, 0x8f, 0x85, 0x15,
-	0xea, 0x8b, 0x27, 0xb5, 0x8a, 0xe9, 0xb2, 0xd0, 0x4a, 0x5c, 0xa8, 0xff, 0xad, 0x71, 0xef, 0x99,
-	0x25, 0x9a, 0x31, 0xe1, 0xaa, 0x80, 0xbf, 0x3f, 0x3d, 0x28, 0x8e, 0x05, 0x84, 0x62, 0xb7, 0xe9,
-	0x48, 0xeb, 0xf2, 0x4f, 0xa7, 0x07, 0x45, 0x60, 0x4f, 0x74, 0x94, 0x55, 0xf3, 0x9f, 0x03, 0x08,
-	0x05, 0x13, 0x2d, 0x8e, 0xec, 0xdf, 0xe2, 0x18, 0x55, 0xa2, 0x0a, 0x61, 0x09, 0x8e, 0x13, 0x2e,
-	0xfc, 0x10, 0x0b, 0xe2, 0x39, 0x14, 0x73, 0xd5, 0xd1, 0x41, 0x7b, 0xac, 0x1d, 0xdc, 0xc1, 0x7c,
-	0xfd, 0x68, 0x00, 0xfe, 0xa3, 0xac, 0x87, 0x5e, 0x02, 0x38, 0xa4, 0xfd, 0x8f, 0x56, 0xfb, 0x38,
-	0xac, 0x7b, 0xe0, 0xf2, 0xc5, 0xcb, 0xa4, 0xea, 0x0b, 0x32, 0x96, 0x5f, 0x7c, 0xfd, 0xf5, 0x36,
-	0xbb, 0x80, 0xe6, 0xad, 0xde, 0x8f, 0x85, 0x9e, 0x37, 0xf4, 0x0e, 0xc0, 0xc9, 0x73, 0xd3, 0x80,
-	0xd6, 0x2e, 0x92, 0xe9, 0x1a, 0xcc, 0xbc, 0x79, 0xd9, 0xf4, 0x84, 0xcc, 0x50, 0x64, 0x73, 0x28,
-	0xdf, 0x87, 0x0c, 0x07, 0x01, 0xfa, 0x08, 0xe0, 0xe4, 0x39, 0xeb, 0xf5, 0xc5, 0xea, 0x3d, 0x2d,
-	0x7d, 0xb1, 0xfa, 0x38, 0xda, 0xb8, 0xa5, 0xb0, 0xcc, 0x3b, 0xa0, 0x68, 0xac, 0xa6, 0xc9, 0x44,
-	0x43, 0x42, 0xb9, 0xad, 0x2a, 0x47, 0x3e, 0x10, 0xd2, 0x52, 0x9e, 0x34, 0x5b, 0xd9, 0x3f, 0x3c,
-	0x2e, 0x80, 0xa3, 0xe3, 0x02, 0xf8, 0x79, 0x5c, 0x00, 0x6f, 0x4e, 0x0a, 0x99, 0xa3, 0x93, 0x42,
-	0xe6, 0xdb, 0x49, 0x21, 0x03, 0x73, 0x3e, 0xeb, 0x4d, 0xb0, 0x0b, 0x1e, 0x6d, 0xa4, 0x8c, 0xd7,
-	0xc9, 0x59, 0xf3, 0x59, 0x5a, 0xb8, 0xd1, 0x6e, 0x8a, 0x72, 0x62, 0x65, 0x48, 0xbd, 0xc5, 0x1b,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x36, 0xb4, 0xcd, 0xe3, 0x7f, 0x06, 0x00, 0x00,
+	// 1139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0xc9, 0xe6, 0xc7, 0x4e, 0x93, 0x06, 0x86, 0xd0, 0x6e, 0xac, 0x74, 0x93, 0x3a,
+	0x84, 0xb6, 0x2b, 0x62, 0xb3, 0x2d, 0x12, 0x3f, 0x7a, 0x40, 0xd9, 0xd0, 0x44, 0x1c, 0x90, 0x82,
+	0x95, 0x13, 0x17, 0x33, 0x6b, 0x4f, 0x5c, 0x83, 0xed, 0x71, 0x77, 0x66, 0x97, 0x5d, 0x21, 0x10,
+	0xe2, 0x80, 0x10, 0xa7, 0x4a, 0x80, 0x90, 0x10, 0x37, 0x24, 0x40, 0x9c, 0x72, 0xe6, 0x2f, 0xe8,
+	0x31, 0x12, 0x17, 0x0e, 0x88, 0xa2, 0x04, 0xa9, 0x37, 0xfe, 0x06, 0x34, 0x3f, 0xbc, 0xeb, 0x64,
+	0x7f, 0x64, 0x41, 0x28, 0x97, 0x64, 0xfd, 0xfc, 0xbe, 0xf3, 0x3e, 0xf3, 0xde, 0xcc, 0x7b, 0x86,
+	0xd7, 0xd3, 0x06, 0x6d, 0x91, 0x04, 0x27, 0x1e, 0xb1, 0x63, 0x16, 0x1c, 0x10, 0xc2, 0xec, 0x56,
+	0xd5, 0x7e, 0xd0, 0x24, 0x8d, 0x8e, 0x95, 0x36, 0x28, 0xa7, 0xe8, 0xd9, 0x9e, 0x8b, 0xa5, 0x5d,
+	0xac, 0x56, 0xd5, 0x78, 0x1a, 0xc7, 0x61, 0x42, 0x6d, 0xf9, 0x57, 0x79, 0x1a, 0x4b, 0x01, 0x0d,
+	0xa8, 0xfc, 0x69, 0x8b, 0x5f, 0xda, 0xba, 0x12, 0x50, 0x1a, 0x44, 0xc4, 0xc6, 0x69, 0x68, 0xe3,
+	0x24, 0xa1, 0x1c, 0xf3, 0x90, 0x26, 0x4c, 0xbf, 0x5d, 0x1f, 0x0c, 0x90, 0x05, 0x52, 0x4e, 0x65,
+	0x8f, 0xb2, 0x98, 0x32, 0xbb, 0x8e, 0x19, 0xb1, 0x5b, 0xd5, 0x3a, 0xe1, 0xb8, 0x6a, 0x7b, 0x34,
+	0x4c, 0xf4, 0xfb, 0x4a, 0xfe, 0xbd, 0x64, 0xef, 0x7a, 0xa5, 0x38, 0x08, 0x13, 0x19, 0x51, 0xfb,
+	0xae, 0x6a, 0x1c, 0xf9, 0x54, 0x6f, 0x1e, 0xd8, 0x3c, 0x8c, 0x09, 0xe3, 0x38, 0x4e, 0x95, 0x83,
+	0xb9, 0x04, 0xd1, 0xdb, 0x62, 0x89, 0x3d, 0xdc, 0xc0, 0x31, 0x73, 0xc8, 0x83, 0x26, 0x61, 0xdc,
+	0x74, 0xe0, 0x33, 0xa7, 0xac, 0x2c, 0xa5, 0x09, 0x23, 0xe8, 0x2e, 0x9c, 0x49, 0xa5, 0xa5, 0x04,
+	0xd6, 0xc0, 0xcd, 0x4b, 0xb7, 0xaf, 0x59, 0x03, 0xb3, 0x65, 0x29, 0x59, 0xad, 0xf0, 0xe8, 0x8f,
+	0xd5, 0x09, 0x47, 0x4b, 0xcc, 0x77, 0xe1, 0x15, 0xb9, 0xe6, 0x56, 0x14, 0xbd, 0xc5, 0x82, 0x1d,
+	0x42, 0xb2, 0x68, 0x68, 0x07, 0xc2, 0x1e, 0x78, 0x69, 0x52, 0x2e, 0xfd, 0xbc, 0xa5, 0x76, 0x69,
+	0x89, 0x5d, 0x5a, 0xaa, 0x42, 0x7a, 0x97, 0xd6, 0x1e, 0x0e, 0x88, 0xd6, 0x3a, 0x39, 0xa5, 0xf9,
+	0x1d, 0x80, 0x57, 0xfb, 0x42, 0x68, 0xf4, 0x57, 0xe0, 0x5c, 0xcc, 0x02, 0x57, 0x10, 0x96, 0xc0,
+	0xda, 0xd4, 0x08, 0x78, 0xa5, 0x74, 0x66, 0x63, 0xb5, 0x02, 0xda, 0x1d, 0x40, 0x77, 0xe3, 0x5c,
+	0x3a, 0x15, 0xf6, 0x14, 0x5e, 0x49, 0x27, 0x60, 0x87, 0x90, 0x6d, 0xcc, 0x71, 0x44, 0x83, 0x2c,
+	0xdd, 0x5f, 0x67, 0xe0, 0xf9, 0x57, 0x1a, 0xfc, 0x55, 0x38, 0x83, 0x3d, 0x1e, 0xb6, 0x88, 0xce,
+	0xf9, 0xf5, 0x21, 0xd8, 0x39, 0xa9, 0x16, 0xa0, 0xd7, 0x61, 0x91, 0x79, 0xf7, 0x89, 0xdf, 0x8c,
+	0x88, 0x5f, 0x9a, 0x1c, 0x57, 0xdd, 0xd3, 0x98, 0x1e, 0x5c, 0xce, 0xb0, 0xee, 0xb5, 0x49, 0x9c,
+	0xca, 0xa3, 0x3c, 0xb8, 0x6a, 0xe0, 0x3f, 0x57, 0xed, 0x10, 0x40, 0x63, 0x50, 0x14, 0xbd, 0xff,
+	0x37, 0x21, 0x24, 0x5d, 0xab, 0x2e, 0xdd, 0xfa, 0xf0, 0x5d, 0x74, 0x57, 0xd0, 0xa7, 0x2f, 0x27,
+	0xfe, 0xff, 0x2a, 0x79, 0x94, 0xab, 0xd7, 0x96, 0xe7, 0x35, 0x9a, 0x38, 0xea, 0xa6, 0x65, 0x05,
+	0x16, 0x1b, 0xc4, 0x0b, 0xd3, 0x90, 0x24, 0x5c, 0x66, 0xa5, 0xe8, 0xf4, 0x0c, 0x68, 0x59, 0x1d,
+	0x43, 0xde, 0x49, 0x89, 0x04, 0x28, 0xca, 0x73, 0xb6, 0xdf, 0x49, 0x45, 0xb5, 0x20, 0xe3, 0xb8,
+	0xc1, 0x5d, 0x71, 0x45, 0x4b, 0x53, 0x92, 0xce, 0xb0, 0xd4, 0xfd, 0xb5, 0xb2, 0xfb, 0x6b, 0xed,
+	0x67, 0xf7, 0xb7, 0x56, 0x78, 0xf8, 0x78, 0x15, 0x38, 0x45, 0xa9, 0x11, 0x56, 0x74, 0x17, 0xce,
+	0x91, 0xc4, 0x57, 0xf2, 0xc2, 0x98, 0xf2, 0x59, 0x92, 0xf8, 0xc2, 0x66, 0xfe, 0x0d, 0x60, 0xa9,
+	0x7f, 0x4b, 0xba, 0x06, 0xdb, 0x70, 0x0e, 0x6b, 0x9b, 0xae, 0xc0, 0x88, 0x73, 0xa4, 0xd5, 0x3a,
+	0xff, 0x5d, 0x21, 0x5a, 0x82, 0xd3, 0x1e, 0x6d, 0x26, 0x5c, 0xee, 0xbb, 0xe0, 0xa8, 0x07, 0xf4,
+	0x01, 0x9c, 0xe6, 0x94, 0xe3, 0xa8, 0x34, 0x25, 0xd7, 0x5d, 0x3e, 0x55, 0x8e, 0xac, 0x10, 0xdb,
+	0x34, 0x4c, 0x6a, 0x3b, 0x62, 0xbd, 0x9f, 0x1f, 0xaf, 0xde, 0x0c, 0x42, 0x7e, 0xbf, 0x59, 0xb7,
+	0x3c, 0x1a, 0xdb, 0xba, 0x13, 0xaa, 0x7f, 0x9b, 0xcc, 0x7f, 0xdf, 0x16, 0x99, 0x65, 0x52, 0xc0,
+	0xbe, 0x7d, 0x72, 0x58, 0x99, 0x8f, 0x48, 0x80, 0xbd, 0x8e, 0x2b, 0xda, 0x27, 0xfb, 0xe9, 0xc9,
+	0x61, 0x05, 0x38, 0x2a, 0x9e, 0xf9, 0x39, 0x80, 0x57, 0xb6, 0x71, 0xe4, 0x35, 0x23, 0xcc, 0xc9,
+	0x7e, 0x3b, 0xdf, 0x8f, 0x96, 0xe1, 0x1c, 0x6f, 0xbb, 0xf5, 0x0e, 0x27, 0xaa, 0xd1, 0xcd, 0x3b,
+	0xb3, 0xbc, 0x5d, 0x13, 0x8f, 0xe8, 0x05, 0x88, 0x7c, 0x72, 0x80, 0x9b, 0x11, 0x77, 0x05, 0xa1,
+	0xeb, 0x93, 0x84, 0xc6, 0xba, 0x92, 0x4f, 0xe9, 0x37, 0x35, 0xcc, 0xc8, 0x1b, 0xc2, 0x8e, 0x36,
+	0xe0, 0xe5, 0x00, 0x33, 0x17, 0xfb, 0xef, 0x35, 0x19, 0x8f, 0xc5, 0x81, 0x10, 0x65, 0x9d, 0x74,
+	0x16, 0x02, 0xcc, 0xb6, 0xba, 0x46, 0xf3, 0xf7, 0x02, 0xbc, 0xda, 0x87, 0xa2, 0x53, 0xff, 0x05,
+	0x80, 0x8b, 0xd8, 0xf7, 0x43, 0x71, 0xee, 0x70, 0x94, 0xef, 0x5f, 0x17, 0x90, 0xaa, 0xcb, 0xbd,
+	0xc8, 0xb2, 0x15, 0x7e, 0x02, 0x20, 0x94, 0xd9, 0x53, 0x1c, 0x93, 0x17, 0xc5, 0x51, 0x94, 0x41,
+	0x25, 0xc2, 0x3a, 0x5c, 0x20, 0x8c, 0x87, 0x31, 0xe6, 0xc4, 0x77, 0x03, 0xcc, 0x64, 0x46, 0x0b,
+	0xce, 0x7c, 0xd7, 0xb8, 0x8b, 0x19, 0xfa, 0x18, 0x16, 0x65, 0x75, 0x24, 0x65, 0xe1, 0xa2, 0x28,
+	0xe7, 0xc4, 0xd2, 0x7a, 0x64, 0x2c, 0x1e, 0x44, 0x94, 0x36, 0x04, 0xa0, 0x9b, 0x36, 0x42, 0x8f,
+	0x94, 0xa6, 0xd7, 0xc0, 0x68, 0x0a, 0x75, 0x5d, 0x16, 0xa4, 0x6e, 0x17, 0xb3, 0x3d, 0xa1, 0x42,
+	0xdb, 0xaa, 0x5d, 0xc8, 0x7d, 0xcc, 0xc8, 0x7d, 0x98, 0x43, 0x2e, 0xde, 0xbd, 0x16, 0x49, 0xb8,
+	0x1a, 0x5d, 0x7a, 0xa9, 0x6c, 0x80, 0xdd, 0xfe, 0x65, 0x16, 0x4e, 0xcb, 0xab, 0x8d, 0x3e, 0x03,
+	0x70, 0x46, 0xcd, 0x66, 0x74, 0x6b, 0xc8, 0x3a, 0xfd, 0x1f, 0x03, 0x46, 0x65, 0x1c, 0x57, 0x75,
+	0x5c, 0xcd, 0x8d, 0x4f, 0x7f, 0xfd, 0xeb, 0xcb, 0xc9, 0x55, 0x74, 0xcd, 0x1e, 0xfc, 0xa5, 0xa3,
+	0xbe, 0x05, 0xd0, 0x57, 0x00, 0x2e, 0x9e, 0x99, 0xd4, 0x68, 0x73, 0x54, 0x98, 0xbe, 0x8f, 0x06,
+	0xc3, 0x1a, 0xd7, 0x5d, 0x93, 0x99, 0x92, 0x6c, 0x05, 0x19, 0x43, 0xc8, 0x70, 0x14, 0xa1, 0x6f,
+	0x00, 0x84, 0xbd, 0x49, 0x38, 0x9a, 0xa8, 0x6f, 0x8a, 0x1b, 0xd6, 0xb8, 0xee, 0x9a, 0xa8, 0x22,
+	0x89, 0x9e, 0x43, 0xe6, 0x10, 0xa2, 0x03, 0x42, 0x5c, 0x4f, 0xa3, 0x7c, 0x0f, 0xe0, 0xc2, 0xa9,
+	0xf9, 0x88, 0x5e, 0x3c, 0x27, 0x5a, 0xdf, 0xc0, 0x36, 0xaa, 0xff, 0x42, 0xa1, 0x11, 0x37, 0x25,
+	0xe2, 0x0d, 0xb4, 0x31, 0x02, 0x31, 0x37, 0x60, 0x7f, 0x04, 0xf0, 0x52, 0x6e, 0x7e, 0xa0, 0xf3,
+	0x32, 0x72, 0x66, 0x76, 0x1a, 0xf6, 0xd8, 0xfe, 0x9a, 0xef, 0x65, 0xc9, 0x57, 0x45, 0xf6, 0x08,
+	0xbe, 0x6c, 0x00, 0xd9, 0x1f, 0x76, 0xc7, 0xf0, 0x47, 0xe8, 0x07, 0x00, 0x17, 0xcf, 0xb4, 0xdc,
+	0xa1, 0xe5, 0x1e, 0x3c, 0x25, 0x0c, 0x6b, 0x5c, 0x77, 0xcd, 0xfa, 0x92, 0x64, 0xb5, 0xcc, 0x5b,
+	0x79, 0x56, 0xde, 0x16, 0x98, 0x5e, 0x26, 0x71, 0xc5, 0x6d, 0x17, 0xbd, 0xc1, 0x17, 0x77, 0xfe,
+	0x35, 0x50, 0xa9, 0x85, 0x8f, 0x8e, 0xcb, 0xe0, 0xe8, 0xb8, 0x0c, 0xfe, 0x3c, 0x2e, 0x83, 0x87,
+	0x27, 0xe5, 0x89, 0xa3, 0x93, 0xf2, 0xc4, 0x6f, 0x27, 0xe5, 0x09, 0x58, 0x0a, 0xe9, 0x60, 0x82,
+	0x3d, 0xf0, 0xce, 0x9d, 0x5c, 0x2b, 0xeb, 0xf9, 0x6c, 0x86, 0x34, 0x1f, 0xbb, 0xdd, 0xcd, 0x94,
+	0xec, 0x6d, 0xf5, 0x19, 0xf9, 0x95, 0x70, 0xe7, 0x9f, 0x01, 0x00, 0x55, 0x61, 0xa0, 0xb1, 0x26,
+	0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -423,6 +817,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error)
+	// FeeCatalog returns the active fee catalog and the scheduled one (if any).
+	FeeCatalog(ctx context.Context, in *QueryFeeCatalogRequest, opts ...grpc.CallOption) (*QueryFeeCatalogResponse, error)
+	// FeeExemptions returns all of the fee exemptions.
+	FeeExemptions(ctx context.Context, in *QueryFeeExemptionsRequest, opts ...grpc.CallOption) (*QueryFeeExemptionsResponse, error)
+	// FeeAccruals returns the additional fees a recipient has received, by msg type and day.
+	FeeAccruals(ctx context.Context, in *QueryFeeAccrualsRequest, opts ...grpc.CallOption) (*QueryFeeAccrualsResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error)
 }
@@ -453,6 +853,33 @@ func (c *queryClient) QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRe
 	return out, nil
 }
 
+func (c *queryClient) FeeCatalog(ctx context.Context, in *QueryFeeCatalogRequest, opts ...grpc.CallOption) (*QueryFeeCatalogResponse, error) {
+	out := new(QueryFeeCatalogResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeExemptions(ctx context.Context, in *QueryFeeExemptionsRequest, opts ...grpc.CallOption) (*QueryFeeExemptionsResponse, error) {
+	out := new(QueryFeeExemptionsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeExemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAccruals(ctx context.Context, in *QueryFeeAccrualsRequest, opts ...grpc.CallOption) (*QueryFeeAccrualsResponse, error) {
+	out := new(QueryFeeAccrualsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeAccruals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error) {
 	out := new(CalculateTxFeesResponse)
 	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/CalculateTxFees", in, out, opts...)
@@ -468,6 +895,12 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Query all Msgs which have fees associated with them.
 	QueryAllMsgFees(context.Context, *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error)
+	// FeeCatalog returns the active fee catalog and the scheduled one (if any).
+	FeeCatalog(context.Context, *QueryFeeCatalogRequest) (*QueryFeeCatalogResponse, error)
+	// FeeExemptions returns all of the fee exemptions.
+	FeeExemptions(context.Context, *QueryFeeExemptionsRequest) (*QueryFeeExemptionsResponse, error)
+	// FeeAccruals returns the additional fees a recipient has received, by msg type and day.
+	FeeAccruals(context.Context, *QueryFeeAccrualsRequest) (*QueryFeeAccrualsResponse, error)
 	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
 	CalculateTxFees(context.Context, *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error)
 }
@@ -482,6 +915,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) QueryAllMsgFees(ctx context.Context, req *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMsgFees not implemented")
 }
+func (*UnimplementedQueryServer) FeeCatalog(ctx context.Context, req *QueryFeeCatalogRequest) (*QueryFeeCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeCatalog not implemented")
+}
+func (*UnimplementedQueryServer) FeeExemptions(ctx context.Context, req *QueryFeeExemptionsRequest) (*QueryFeeExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeExemptions not implemented")
+}
+func (*UnimplementedQueryServer) FeeAccruals(ctx context.Context, req *QueryFeeAccrualsRequest) (*QueryFeeAccrualsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAccruals not implemented")
+}
 func (*UnimplementedQueryServer) CalculateTxFees(ctx context.Context, req *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateTxFees not implemented")
 }
@@ -526,45 +968,111 @@ func _Query_QueryAllMsgFees_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateTxFeesRequest)
+func _Query_FeeCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeCatalogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).CalculateTxFees(ctx, in)
+		return srv.(QueryServer).FeeCatalog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/provenance.msgfees.v1.Query/CalculateTxFees",
+		FullMethod: "/provenance.msgfees.v1.Query/FeeCatalog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculateTxFees(ctx, req.(*CalculateTxFeesRequest))
+		return srv.(QueryServer).FeeCatalog(ctx, req.(*QueryFeeCatalogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.msgfees.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryAllMsgFees",
-			Handler:    _Query_QueryAllMsgFees_Handler,
-		},
-		{
-			MethodName: "CalculateTxFees",
-			Handler:    _Query_CalculateTxFees_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/msgfees/v1/query.proto",
-}
+func _Query_FeeExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeExemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeExemptions(ctx, req.(*QueryFeeExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAccruals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeAccrualsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAccruals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeAccruals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAccruals(ctx, req.(*QueryFeeAccrualsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculateTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/CalculateTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculateTxFees(ctx, req.(*CalculateTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.msgfees.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryAllMsgFees",
+			Handler:    _Query_QueryAllMsgFees_Handler,
+		},
+		{
+			MethodName: "FeeCatalog",
+			Handler:    _Query_FeeCatalog_Handler,
+		},
+		{
+			MethodName: "FeeExemptions",
+			Handler:    _Query_FeeExemptions_Handler,
+		},
+		{
+			MethodName: "FeeAccruals",
+			Handler:    _Query_FeeAccruals_Handler,
+		},
+		{
+			MethodName: "CalculateTxFees",
+			Handler:    _Query_CalculateTxFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/msgfees/v1/query.proto",
+}
 
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -706,7 +1214,7 @@ func (m *QueryAllMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeeCatalogRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -716,40 +1224,67 @@ func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CalculateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeeCatalogRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CalculateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeeCatalogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasAdjustment != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GasAdjustment))))
-		i--
-		dAtA[i] = 0x1d
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeCatalogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DefaultBaseDenom) > 0 {
-		i -= len(m.DefaultBaseDenom)
-		copy(dAtA[i:], m.DefaultBaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultBaseDenom)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeCatalogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeCatalogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scheduled != nil {
+		{
+			size, err := m.Scheduled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+	if m.Active != nil {
+		{
+			size, err := m.Active.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryFeeExemptionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -759,39 +1294,67 @@ func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CalculateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryFeeExemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryFeeExemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.EstimatedGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if len(m.TotalFees) > 0 {
-		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeExemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeExemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeExemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.AdditionalFees) > 0 {
-		for iNdEx := len(m.AdditionalFees) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Exemptions) > 0 {
+		for iNdEx := len(m.Exemptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AdditionalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Exemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -805,148 +1368,1186 @@ func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeAccrualsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFeeAccrualsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllMsgFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeAccrualsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllMsgFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EndTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if len(m.MsgFees) > 0 {
-		for _, e := range m.MsgFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.StartTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err9 != nil {
+			return 0, err9
 		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CalculateTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeAccrualsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeAccrualsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeAccrualsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.DefaultBaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Accruals) > 0 {
+		for iNdEx := len(m.Accruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CalculateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalculateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.GasAdjustment != 0 {
-		n += 5
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GasAdjustment))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.DefaultBaseDenom) > 0 {
+		i -= len(m.DefaultBaseDenom)
+		copy(dAtA[i:], m.DefaultBaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultBaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalculateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.FloorGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BaseFees) > 0 {
+		for iNdEx := len(m.BaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EstimatedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AdditionalFees) > 0 {
+		for iNdEx := len(m.AdditionalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *CalculateTxFeesResponse) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AdditionalFees) > 0 {
-		for _, e := range m.AdditionalFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMsgFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.TotalFees) > 0 {
-		for _, e := range m.TotalFees {
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMsgFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.EstimatedGas != 0 {
-		n += 1 + sovQuery(uint64(m.EstimatedGas))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryFeeCatalogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryFeeCatalogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active != nil {
+		l = m.Active.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Scheduled != nil {
+		l = m.Scheduled.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+
+func (m *QueryFeeExemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeExemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Exemptions) > 0 {
+		for _, e := range m.Exemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeAccrualsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeAccrualsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accruals) > 0 {
+		for _, e := range m.Accruals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CalculateTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DefaultBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasAdjustment != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *CalculateTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdditionalFees) > 0 {
+		for _, e := range m.AdditionalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EstimatedGas != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedGas))
+	}
+	if len(m.BaseFees) > 0 {
+		for _, e := range m.BaseFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.FloorGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, &MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeCatalogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeCatalogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeCatalogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeCatalogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeCatalogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeCatalogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Active == nil {
+				m.Active = &FeeCatalog{}
+			}
+			if err := m.Active.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scheduled == nil {
+				m.Scheduled = &FeeCatalog{}
+			}
+			if err := m.Scheduled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeExemptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeExemptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeExemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeExemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeExemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeExemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exemptions = append(m.Exemptions, FeeExemption{})
+			if err := m.Exemptions[len(m.Exemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -968,7 +2569,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeAccrualsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -991,17 +2592,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeAccrualsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeAccrualsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1011,78 +2612,95 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMsgFeesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1109,10 +2727,10 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1137,7 +2755,7 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeAccrualsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1160,15 +2778,15 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMsgFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeAccrualsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeAccrualsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accruals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1195,14 +2813,33 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgFees = append(m.MsgFees, &MsgFee{})
-			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accruals = append(m.Accruals, FeeAccrual{})
+			if err := m.Accruals[len(m.Accruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1229,10 +2866,8 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1500,6 +3135,107 @@ func (m *CalculateTxFeesResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFees = append(m.BaseFees, types.Coin{})
+			if err := m.BaseFees[len(m.BaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, EventMsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -123,6 +123,12 @@ type MsgAddMsgFeeProposalRequest struct {
 	RecipientBasisPoints string `protobuf:"bytes,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional list of recipients to split the fee between (cannot be used with recipient or recipient_basis_points)
+	RecipientSplits []RecipientSplit `protobuf:"bytes,6,rep,name=recipient_splits,json=recipientSplits,proto3" json:"recipient_splits"`
+	// optional wasm contract address this fee is specific to (msg_type_url must be MsgExecuteContract)
+	ContractAddress string `protobuf:"bytes,7,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// optional contract method this fee is specific to (requires contract_address)
+	Method string `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
 }
 
 func (m *MsgAddMsgFeeProposalRequest) Reset()         { *m = MsgAddMsgFeeProposalRequest{} }
@@ -193,6 +199,27 @@ func (m *MsgAddMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgAddMsgFeeProposalRequest) GetRecipientSplits() []RecipientSplit {
+	if m != nil {
+		return m.RecipientSplits
+	}
+	return nil
+}
+
+func (m *MsgAddMsgFeeProposalRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgAddMsgFeeProposalRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
 // MsgAddMsgFeeProposalResponse defines the Msg/AddMsgFeeProposal response type
 type MsgAddMsgFeeProposalResponse struct {
 }
@@ -242,6 +269,12 @@ type MsgUpdateMsgFeeProposalRequest struct {
 	RecipientBasisPoints string `protobuf:"bytes,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional list of recipients to split the fee between (cannot be used with recipient or recipient_basis_points)
+	RecipientSplits []RecipientSplit `protobuf:"bytes,6,rep,name=recipient_splits,json=recipientSplits,proto3" json:"recipient_splits"`
+	// optional wasm contract address this fee is specific to (msg_type_url must be MsgExecuteContract)
+	ContractAddress string `protobuf:"bytes,7,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// optional contract method this fee is specific to (requires contract_address)
+	Method string `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
 }
 
 func (m *MsgUpdateMsgFeeProposalRequest) Reset()         { *m = MsgUpdateMsgFeeProposalRequest{} }
@@ -312,6 +345,27 @@ func (m *MsgUpdateMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgUpdateMsgFeeProposalRequest) GetRecipientSplits() []RecipientSplit {
+	if m != nil {
+		return m.RecipientSplits
+	}
+	return nil
+}
+
+func (m *MsgUpdateMsgFeeProposalRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateMsgFeeProposalRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
 // MsgUpdateMsgFeeProposalResponse defines the Msg/RemoveMsgFeeProposal response type
 type MsgUpdateMsgFeeProposalResponse struct {
 }
@@ -355,6 +409,10 @@ type MsgRemoveMsgFeeProposalRequest struct {
 	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional wasm contract address of the fee to remove
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// optional contract method of the fee to remove
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
 }
 
 func (m *MsgRemoveMsgFeeProposalRequest) Reset()         { *m = MsgRemoveMsgFeeProposalRequest{} }
@@ -404,6 +462,20 @@ func (m *MsgRemoveMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgRemoveMsgFeeProposalRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRemoveMsgFeeProposalRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
 // MsgRemoveMsgFeeProposalResponse defines the Msg/RemoveMsgFeeProposal response type
 type MsgRemoveMsgFeeProposalResponse struct {
 }
@@ -637,6 +709,283 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConversionFeeDenomProposalResponse proto.InternalMessageInfo
 
+// MsgScheduleFeeCatalogProposalRequest defines a governance proposal to schedule a new fee catalog.
+// Once the catalog's effective time has been reached, its fees replace those of the previous catalog.
+type MsgScheduleFeeCatalogProposalRequest struct {
+	// the fee catalog to schedule
+	Catalog FeeCatalog `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgScheduleFeeCatalogProposalRequest) Reset()         { *m = MsgScheduleFeeCatalogProposalRequest{} }
+func (m *MsgScheduleFeeCatalogProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleFeeCatalogProposalRequest) ProtoMessage()    {}
+func (*MsgScheduleFeeCatalogProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{12}
+}
+func (m *MsgScheduleFeeCatalogProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleFeeCatalogProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleFeeCatalogProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleFeeCatalogProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleFeeCatalogProposalRequest.Merge(m, src)
+}
+func (m *MsgScheduleFeeCatalogProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleFeeCatalogProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleFeeCatalogProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleFeeCatalogProposalRequest proto.InternalMessageInfo
+
+func (m *MsgScheduleFeeCatalogProposalRequest) GetCatalog() FeeCatalog {
+	if m != nil {
+		return m.Catalog
+	}
+	return FeeCatalog{}
+}
+
+func (m *MsgScheduleFeeCatalogProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgScheduleFeeCatalogProposalResponse defines the Msg/ScheduleFeeCatalogProposal response type
+type MsgScheduleFeeCatalogProposalResponse struct {
+}
+
+func (m *MsgScheduleFeeCatalogProposalResponse) Reset()         { *m = MsgScheduleFeeCatalogProposalResponse{} }
+func (m *MsgScheduleFeeCatalogProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleFeeCatalogProposalResponse) ProtoMessage()    {}
+func (*MsgScheduleFeeCatalogProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{13}
+}
+func (m *MsgScheduleFeeCatalogProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleFeeCatalogProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleFeeCatalogProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleFeeCatalogProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleFeeCatalogProposalResponse.Merge(m, src)
+}
+func (m *MsgScheduleFeeCatalogProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleFeeCatalogProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleFeeCatalogProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleFeeCatalogProposalResponse proto.InternalMessageInfo
+
+// MsgSetFeeExemptionProposalRequest defines a governance proposal to add or replace a fee exemption.
+type MsgSetFeeExemptionProposalRequest struct {
+	// the fee exemption to add, or replace the one with the same name
+	Exemption FeeExemption `protobuf:"bytes,1,opt,name=exemption,proto3" json:"exemption"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSetFeeExemptionProposalRequest) Reset()         { *m = MsgSetFeeExemptionProposalRequest{} }
+func (m *MsgSetFeeExemptionProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeExemptionProposalRequest) ProtoMessage()    {}
+func (*MsgSetFeeExemptionProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{14}
+}
+func (m *MsgSetFeeExemptionProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeExemptionProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeExemptionProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeExemptionProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeExemptionProposalRequest.Merge(m, src)
+}
+func (m *MsgSetFeeExemptionProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeExemptionProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeExemptionProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeExemptionProposalRequest proto.InternalMessageInfo
+
+func (m *MsgSetFeeExemptionProposalRequest) GetExemption() FeeExemption {
+	if m != nil {
+		return m.Exemption
+	}
+	return FeeExemption{}
+}
+
+func (m *MsgSetFeeExemptionProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSetFeeExemptionProposalResponse defines the Msg/SetFeeExemptionProposal response type
+type MsgSetFeeExemptionProposalResponse struct {
+}
+
+func (m *MsgSetFeeExemptionProposalResponse) Reset()         { *m = MsgSetFeeExemptionProposalResponse{} }
+func (m *MsgSetFeeExemptionProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeExemptionProposalResponse) ProtoMessage()    {}
+func (*MsgSetFeeExemptionProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{15}
+}
+func (m *MsgSetFeeExemptionProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeExemptionProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeExemptionProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeExemptionProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeExemptionProposalResponse.Merge(m, src)
+}
+func (m *MsgSetFeeExemptionProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeExemptionProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeExemptionProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeExemptionProposalResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeExemptionProposalRequest defines a governance proposal to delete a fee exemption.
+type MsgRemoveFeeExemptionProposalRequest struct {
+	// the name of the fee exemption to remove
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRemoveFeeExemptionProposalRequest) Reset()         { *m = MsgRemoveFeeExemptionProposalRequest{} }
+func (m *MsgRemoveFeeExemptionProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeExemptionProposalRequest) ProtoMessage()    {}
+func (*MsgRemoveFeeExemptionProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{16}
+}
+func (m *MsgRemoveFeeExemptionProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeExemptionProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeExemptionProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeExemptionProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeExemptionProposalRequest.Merge(m, src)
+}
+func (m *MsgRemoveFeeExemptionProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeExemptionProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeExemptionProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeExemptionProposalRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeExemptionProposalRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRemoveFeeExemptionProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgRemoveFeeExemptionProposalResponse defines the Msg/RemoveFeeExemptionProposal response type
+type MsgRemoveFeeExemptionProposalResponse struct {
+}
+
+func (m *MsgRemoveFeeExemptionProposalResponse) Reset()         { *m = MsgRemoveFeeExemptionProposalResponse{} }
+func (m *MsgRemoveFeeExemptionProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeExemptionProposalResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeExemptionProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{17}
+}
+func (m *MsgRemoveFeeExemptionProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeExemptionProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeExemptionProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeExemptionProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeExemptionProposalResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeExemptionProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeExemptionProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeExemptionProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeExemptionProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAssessCustomMsgFeeRequest)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest")
 	proto.RegisterType((*MsgAssessCustomMsgFeeResponse)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeResponse")
@@ -650,64 +999,86 @@ func init() {
 	proto.RegisterType((*MsgUpdateNhashPerUsdMilProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateNhashPerUsdMilProposalResponse")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalRequest")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalResponse")
+	proto.RegisterType((*MsgScheduleFeeCatalogProposalRequest)(nil), "provenance.msgfees.v1.MsgScheduleFeeCatalogProposalRequest")
+	proto.RegisterType((*MsgScheduleFeeCatalogProposalResponse)(nil), "provenance.msgfees.v1.MsgScheduleFeeCatalogProposalResponse")
+	proto.RegisterType((*MsgSetFeeExemptionProposalRequest)(nil), "provenance.msgfees.v1.MsgSetFeeExemptionProposalRequest")
+	proto.RegisterType((*MsgSetFeeExemptionProposalResponse)(nil), "provenance.msgfees.v1.MsgSetFeeExemptionProposalResponse")
+	proto.RegisterType((*MsgRemoveFeeExemptionProposalRequest)(nil), "provenance.msgfees.v1.MsgRemoveFeeExemptionProposalRequest")
+	proto.RegisterType((*MsgRemoveFeeExemptionProposalResponse)(nil), "provenance.msgfees.v1.MsgRemoveFeeExemptionProposalResponse")
 }
 
 func init() { proto.RegisterFile("provenance/msgfees/v1/tx.proto", fileDescriptor_4c6bb65eaf858b5f) }
 
 var fileDescriptor_4c6bb65eaf858b5f = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x3b, 0x45,
-	0x14, 0xcf, 0xe4, 0x9b, 0x16, 0x3a, 0xd6, 0x42, 0x86, 0xa8, 0xe9, 0x5a, 0x37, 0x31, 0x07, 0x6d,
-	0x23, 0xd9, 0x35, 0x4d, 0xad, 0x50, 0x50, 0x68, 0x2a, 0xb9, 0x45, 0x42, 0xb4, 0x17, 0x2f, 0xcb,
-	0x66, 0x77, 0xba, 0x19, 0xcc, 0xce, 0xac, 0xfb, 0x36, 0xa1, 0x01, 0x41, 0x11, 0x84, 0xe2, 0xc9,
-	0xb3, 0x22, 0xf4, 0x24, 0xea, 0xa9, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0x2d, 0x34,
-	0xfa, 0x5f, 0xc8, 0xee, 0x8e, 0x49, 0xdb, 0x24, 0x1b, 0xfb, 0xc3, 0x9b, 0x97, 0x64, 0x66, 0x3f,
-	0x9f, 0x79, 0xef, 0xf3, 0xde, 0xdb, 0x37, 0x6f, 0xb1, 0xea, 0xf9, 0x62, 0x40, 0xb9, 0xc9, 0x2d,
-	0xaa, 0xbb, 0xe0, 0x1c, 0x51, 0x0a, 0xfa, 0xa0, 0xaa, 0x07, 0xc7, 0x9a, 0xe7, 0x8b, 0x40, 0x90,
-	0x17, 0x26, 0xb8, 0x26, 0x71, 0x6d, 0x50, 0x55, 0xb2, 0xa6, 0xcb, 0xb8, 0xd0, 0xa3, 0xdf, 0x98,
-	0xa9, 0xe4, 0x1c, 0xe1, 0x88, 0x68, 0xa9, 0x87, 0x2b, 0xf9, 0x74, 0xdd, 0x12, 0xe0, 0x0a, 0x30,
-	0x62, 0x20, 0xde, 0x48, 0x48, 0x8d, 0x77, 0x7a, 0xc7, 0x04, 0xaa, 0x0f, 0xaa, 0x1d, 0x1a, 0x98,
-	0x55, 0xdd, 0x12, 0x8c, 0x4b, 0xfc, 0x25, 0x89, 0xbb, 0xe0, 0x84, 0x92, 0x5c, 0x70, 0x62, 0xa0,
-	0x74, 0x8d, 0xf0, 0x46, 0x13, 0x9c, 0x7d, 0x00, 0x0a, 0x70, 0xd0, 0x87, 0x40, 0xb8, 0x4d, 0x70,
-	0x1a, 0x94, 0xb6, 0xe9, 0x27, 0x7d, 0x0a, 0x01, 0x21, 0x38, 0xc3, 0x4d, 0x97, 0xe6, 0x51, 0x11,
-	0x6d, 0xae, 0xb4, 0xa3, 0x35, 0x79, 0x1b, 0x2f, 0x9b, 0xae, 0xe8, 0xf3, 0x20, 0x9f, 0x2e, 0xa2,
-	0xcd, 0xe7, 0xb6, 0xd7, 0x35, 0x29, 0x26, 0x74, 0xaf, 0x49, 0xf7, 0xda, 0x81, 0x60, 0xbc, 0x9e,
-	0x39, 0xff, 0xad, 0x90, 0x6a, 0x4b, 0x3a, 0xd9, 0xc0, 0x2b, 0x3e, 0xb5, 0x98, 0xc7, 0x28, 0x0f,
-	0xf2, 0xcf, 0x22, 0x8b, 0x93, 0x07, 0xa1, 0xab, 0x23, 0x5f, 0xb8, 0xf9, 0x4c, 0xec, 0x2a, 0x5c,
-	0x93, 0x1d, 0xfc, 0xe2, 0x98, 0x60, 0x74, 0x4c, 0x60, 0x60, 0x78, 0x82, 0xf1, 0x00, 0xf2, 0x4b,
-	0x11, 0x2b, 0x37, 0x46, 0xeb, 0x21, 0xd8, 0x8a, 0xb0, 0xbd, 0xec, 0xc9, 0x69, 0x21, 0xf5, 0xe7,
-	0x69, 0x21, 0xf5, 0xc5, 0xe8, 0xac, 0x1c, 0x19, 0x2a, 0x15, 0xf0, 0x2b, 0x73, 0xe2, 0x04, 0x4f,
-	0x70, 0xa0, 0xa5, 0xeb, 0x34, 0x7e, 0x39, 0x64, 0xd8, 0x76, 0x0c, 0xb4, 0x7c, 0xe1, 0x09, 0x30,
-	0x7b, 0xff, 0x24, 0xa2, 0x88, 0x57, 0x5d, 0x70, 0x8c, 0x60, 0xe8, 0x51, 0xa3, 0xef, 0xf7, 0x64,
-	0x42, 0xb0, 0x0b, 0xce, 0x87, 0x43, 0x8f, 0x1e, 0xfa, 0x3d, 0x72, 0x82, 0xf0, 0x9a, 0x69, 0xdb,
-	0x2c, 0x60, 0x82, 0x9b, 0x3d, 0xe3, 0x88, 0xd2, 0xc5, 0xf9, 0x69, 0x84, 0xf9, 0xf9, 0xe9, 0xf7,
-	0xc2, 0xa6, 0xc3, 0x82, 0x6e, 0xbf, 0xa3, 0x59, 0xc2, 0x95, 0x95, 0x95, 0x7f, 0x15, 0xb0, 0x3f,
-	0xd6, 0x43, 0xa7, 0x10, 0x1d, 0x80, 0x6f, 0x46, 0x67, 0xe5, 0xd5, 0x1e, 0x75, 0x4c, 0x6b, 0x68,
-	0x84, 0x05, 0x86, 0x1f, 0x46, 0x67, 0x65, 0xd4, 0x7e, 0x7e, 0xe2, 0xb8, 0x41, 0xe9, 0x82, 0x44,
-	0xcf, 0x4f, 0x6a, 0x66, 0x7e, 0x52, 0xc9, 0x2e, 0x5e, 0x31, 0xfb, 0x41, 0x57, 0xf8, 0x2c, 0x18,
-	0xc6, 0xd9, 0xaf, 0xe7, 0x7f, 0xf9, 0xb9, 0x92, 0x93, 0xb1, 0xed, 0xdb, 0xb6, 0x4f, 0x01, 0x3e,
-	0x08, 0x7c, 0xc6, 0x9d, 0xf6, 0x84, 0xba, 0xb7, 0x16, 0x16, 0x61, 0xb2, 0x2f, 0xa9, 0xf1, 0x1b,
-	0x37, 0x9d, 0x67, 0x59, 0x88, 0xbf, 0xd2, 0x58, 0x6d, 0x82, 0x73, 0xe8, 0xd9, 0x66, 0x40, 0xff,
-	0xaf, 0xc5, 0x7f, 0x5a, 0x8b, 0x57, 0x71, 0x61, 0x6e, 0xaa, 0x65, 0x39, 0xbe, 0x42, 0x51, 0x39,
-	0xda, 0xd4, 0x15, 0x83, 0x07, 0x97, 0xe3, 0x96, 0xde, 0xf4, 0x63, 0xf5, 0xce, 0xd6, 0x22, 0xf5,
-	0x7e, 0x8b, 0xf0, 0x6b, 0xe3, 0x98, 0xde, 0xef, 0x9a, 0xd0, 0x6d, 0x51, 0xff, 0x10, 0xec, 0x26,
-	0xeb, 0xdd, 0xd5, 0xbd, 0x85, 0xb3, 0x3c, 0x24, 0x18, 0x1e, 0xf5, 0x8d, 0x3e, 0xd8, 0x86, 0xcb,
-	0x62, 0xf1, 0x99, 0xf6, 0x1a, 0xbf, 0x75, 0xf2, 0xc9, 0x02, 0xd8, 0xc2, 0xaf, 0x2f, 0x14, 0x27,
-	0x03, 0xf9, 0x1e, 0xe1, 0xf2, 0x98, 0x7b, 0x20, 0xf8, 0x80, 0xfa, 0xc0, 0x04, 0x6f, 0x50, 0xfa,
-	0x1e, 0xe5, 0xc2, 0xbd, 0x1b, 0xcc, 0x9b, 0x38, 0x67, 0x8d, 0x49, 0xe1, 0x0b, 0x6f, 0xd8, 0x21,
-	0x4d, 0x16, 0x83, 0x58, 0x53, 0x06, 0x9e, 0x2c, 0xa6, 0x0a, 0x7e, 0xe3, 0x5f, 0xe9, 0x8c, 0xe3,
-	0xda, 0x1e, 0x2d, 0xe3, 0x67, 0x4d, 0x70, 0xc8, 0x67, 0x98, 0x4c, 0x5f, 0xc7, 0xa4, 0xa6, 0xcd,
-	0x9c, 0x92, 0x5a, 0xd2, 0x90, 0x52, 0x76, 0xee, 0x77, 0x28, 0x16, 0x42, 0x3e, 0xc5, 0xd9, 0xa9,
-	0x5b, 0x88, 0x6c, 0x27, 0x98, 0x9a, 0x33, 0x1a, 0x94, 0xda, 0xbd, 0xce, 0x48, 0xef, 0x5f, 0x22,
-	0x9c, 0x9b, 0xd5, 0x78, 0xe4, 0xad, 0xf9, 0xd6, 0x12, 0xee, 0x44, 0x65, 0xf7, 0xbe, 0xc7, 0x6e,
-	0xe8, 0x98, 0xd5, 0x50, 0x49, 0x3a, 0x12, 0x2e, 0x83, 0x24, 0x1d, 0x49, 0x7d, 0x4b, 0xbe, 0x43,
-	0x78, 0x23, 0xa9, 0x2f, 0xc8, 0x3b, 0x8b, 0x02, 0x4c, 0x6c, 0x76, 0xe5, 0xdd, 0x87, 0x1e, 0x97,
-	0xfa, 0x7e, 0x44, 0xb8, 0xb8, 0xe8, 0x1d, 0x27, 0xfb, 0x8b, 0x9c, 0x2c, 0xec, 0x63, 0xa5, 0xfe,
-	0x18, 0x13, 0xb1, 0x56, 0x65, 0xe9, 0xf3, 0x70, 0x10, 0xd5, 0xd9, 0xf9, 0xa5, 0x8a, 0x2e, 0x2e,
-	0x55, 0xf4, 0xc7, 0xa5, 0x8a, 0xbe, 0xbe, 0x52, 0x53, 0x17, 0x57, 0x6a, 0xea, 0xd7, 0x2b, 0x35,
-	0x85, 0xf3, 0x4c, 0xcc, 0x76, 0xd3, 0x42, 0x1f, 0xd5, 0x6e, 0x8c, 0xbf, 0x09, 0xa7, 0xc2, 0xc4,
-	0x8d, 0x9d, 0x7e, 0x3c, 0xfe, 0xc2, 0x8d, 0xe6, 0x61, 0x67, 0x39, 0xfa, 0x9c, 0xac, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x92, 0xa9, 0x86, 0xe4, 0x04, 0x0b, 0x00, 0x00,
+	// 1088 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xde, 0x69, 0xb6, 0x29, 0x79, 0x2d, 0x69, 0x33, 0x0a, 0xad, 0x6b, 0x82, 0x37, 0x49, 0x5b,
+	0x9a, 0x04, 0x65, 0x4d, 0x92, 0x12, 0xa0, 0x14, 0xa4, 0x24, 0xb0, 0x9c, 0x16, 0x45, 0x1b, 0xc2,
+	0x81, 0x8b, 0xe5, 0xd8, 0x2f, 0x5e, 0x8b, 0xb5, 0xc7, 0x78, 0x66, 0x57, 0x89, 0x84, 0x04, 0x54,
+	0x20, 0xf5, 0x84, 0xb8, 0x21, 0x81, 0x90, 0x7a, 0x42, 0x80, 0x38, 0x04, 0xa9, 0x7f, 0x44, 0x2f,
+	0x48, 0x15, 0x27, 0x4e, 0x80, 0x12, 0x89, 0xf0, 0x67, 0x20, 0xdb, 0xb3, 0xbb, 0xf9, 0xb1, 0xb6,
+	0x93, 0x25, 0x37, 0xb8, 0xec, 0xda, 0x7e, 0xdf, 0x7b, 0xef, 0xfb, 0x9e, 0xdf, 0xbc, 0x19, 0x83,
+	0x16, 0x84, 0xac, 0x85, 0xbe, 0xe9, 0x5b, 0xa8, 0x7b, 0xdc, 0xd9, 0x44, 0xe4, 0x7a, 0x6b, 0x4e,
+	0x17, 0x5b, 0xe5, 0x20, 0x64, 0x82, 0xd1, 0x67, 0xba, 0xf6, 0xb2, 0xb4, 0x97, 0x5b, 0x73, 0xea,
+	0x88, 0xe9, 0xb9, 0x3e, 0xd3, 0xe3, 0xdf, 0x04, 0xa9, 0x8e, 0x3a, 0xcc, 0x61, 0xf1, 0xa5, 0x1e,
+	0x5d, 0xc9, 0xa7, 0xd7, 0x2d, 0xc6, 0x3d, 0xc6, 0x8d, 0xc4, 0x90, 0xdc, 0x48, 0x93, 0x96, 0xdc,
+	0xe9, 0x1b, 0x26, 0x47, 0xbd, 0x35, 0xb7, 0x81, 0xc2, 0x9c, 0xd3, 0x2d, 0xe6, 0xfa, 0xd2, 0x7e,
+	0x4d, 0xda, 0x3d, 0xee, 0x44, 0x94, 0x3c, 0xee, 0x48, 0xc3, 0x8d, 0xde, 0x9c, 0xdb, 0xf4, 0x62,
+	0xd0, 0xe4, 0x5f, 0x04, 0xc6, 0xaa, 0xdc, 0x59, 0xe2, 0x1c, 0x39, 0x5f, 0x69, 0x72, 0xc1, 0xbc,
+	0x2a, 0x77, 0x2a, 0x88, 0x35, 0xfc, 0xb0, 0x89, 0x5c, 0x50, 0x0a, 0x45, 0xdf, 0xf4, 0x50, 0x21,
+	0xe3, 0x64, 0x6a, 0xa8, 0x16, 0x5f, 0xd3, 0x97, 0x61, 0xd0, 0xf4, 0x58, 0xd3, 0x17, 0xca, 0xb9,
+	0x71, 0x32, 0x75, 0x71, 0xfe, 0x7a, 0x59, 0x32, 0x8e, 0x38, 0x96, 0x25, 0xc7, 0xf2, 0x0a, 0x73,
+	0xfd, 0xe5, 0xe2, 0xe3, 0xdf, 0x4b, 0x85, 0x9a, 0x84, 0xd3, 0x31, 0x18, 0x0a, 0xd1, 0x72, 0x03,
+	0x17, 0x7d, 0xa1, 0x0c, 0xc4, 0x11, 0xbb, 0x0f, 0xa2, 0x54, 0x9b, 0x21, 0xf3, 0x94, 0x62, 0x92,
+	0x2a, 0xba, 0xa6, 0x77, 0xe0, 0x6a, 0x07, 0x60, 0x6c, 0x98, 0xdc, 0xe5, 0x46, 0xc0, 0x5c, 0x5f,
+	0x70, 0xe5, 0x7c, 0x8c, 0x1a, 0xed, 0x58, 0x97, 0x23, 0xe3, 0x6a, 0x6c, 0xbb, 0x3b, 0xf2, 0xe0,
+	0x61, 0xa9, 0xf0, 0xf7, 0xc3, 0x52, 0xe1, 0xfe, 0xfe, 0xce, 0x4c, 0x1c, 0x68, 0xb2, 0x04, 0xcf,
+	0xa5, 0xe8, 0xe4, 0x01, 0xf3, 0x39, 0x4e, 0x7e, 0x5a, 0x84, 0x67, 0x23, 0x84, 0x6d, 0x27, 0x86,
+	0xd5, 0x90, 0x05, 0x8c, 0x9b, 0x8d, 0x76, 0x21, 0xc6, 0xe1, 0x92, 0xc7, 0x1d, 0x43, 0x6c, 0x07,
+	0x68, 0x34, 0xc3, 0x86, 0x2c, 0x08, 0x78, 0xdc, 0x79, 0x77, 0x3b, 0xc0, 0xf5, 0xb0, 0x41, 0x1f,
+	0x10, 0x18, 0x36, 0x6d, 0xdb, 0x15, 0x2e, 0xf3, 0xcd, 0x86, 0xb1, 0x89, 0x98, 0x5f, 0x9f, 0x4a,
+	0x54, 0x9f, 0x1f, 0xff, 0x28, 0x4d, 0x39, 0xae, 0xa8, 0x37, 0x37, 0xca, 0x16, 0xf3, 0xe4, 0xeb,
+	0x97, 0x7f, 0xb3, 0xdc, 0xfe, 0x40, 0x8f, 0x92, 0xf2, 0xd8, 0x81, 0x7f, 0xbd, 0xbf, 0x33, 0x73,
+	0xa9, 0x81, 0x8e, 0x69, 0x6d, 0x1b, 0x51, 0x17, 0xf0, 0xef, 0xf7, 0x77, 0x66, 0x48, 0xed, 0xe9,
+	0x6e, 0xe2, 0x0a, 0x62, 0x4e, 0xa1, 0xd3, 0x8b, 0x5a, 0x4c, 0x2f, 0x2a, 0x5d, 0x84, 0x21, 0xb3,
+	0x29, 0xea, 0x2c, 0x74, 0xc5, 0x76, 0x52, 0xfd, 0x65, 0xe5, 0xd7, 0x47, 0xb3, 0xa3, 0x52, 0xdb,
+	0x92, 0x6d, 0x87, 0xc8, 0xf9, 0x9a, 0x08, 0x5d, 0xdf, 0xa9, 0x75, 0xa1, 0xf4, 0x3d, 0xb8, 0xd2,
+	0xcd, 0xc6, 0x83, 0x86, 0x2b, 0xb8, 0x32, 0x38, 0x3e, 0x30, 0x75, 0x71, 0xfe, 0x56, 0xb9, 0xe7,
+	0xb2, 0x29, 0xd7, 0xda, 0xf0, 0xb5, 0x08, 0x2d, 0x7b, 0xe8, 0x72, 0x78, 0xe8, 0x29, 0xa7, 0xd3,
+	0x70, 0xc5, 0x62, 0xbe, 0x08, 0x4d, 0x4b, 0x18, 0x66, 0x92, 0x5c, 0xb9, 0x10, 0xf3, 0xbf, 0xdc,
+	0x7e, 0x2e, 0x39, 0xd1, 0xab, 0x30, 0xe8, 0xa1, 0xa8, 0x33, 0x5b, 0x79, 0x2a, 0x06, 0xc8, 0xbb,
+	0xbb, 0xc3, 0x51, 0x7f, 0x74, 0xa9, 0x4e, 0x6a, 0x30, 0xd6, 0xbb, 0x05, 0x64, 0x8f, 0x7c, 0x56,
+	0x04, 0xad, 0xca, 0x9d, 0xf5, 0xc0, 0x36, 0x05, 0xfe, 0xdf, 0x26, 0xff, 0xd5, 0x36, 0x99, 0x80,
+	0x52, 0x6a, 0x17, 0xc8, 0x4e, 0xf9, 0x85, 0xc4, 0x9d, 0x52, 0x43, 0x8f, 0xb5, 0xfa, 0xee, 0x94,
+	0x43, 0xa5, 0x3c, 0x77, 0xf2, 0x52, 0xf6, 0x92, 0x3c, 0x90, 0x27, 0xb9, 0x78, 0x02, 0xc9, 0xbd,
+	0xe5, 0x48, 0xc9, 0xdf, 0x10, 0x78, 0xbe, 0x53, 0x96, 0x77, 0xea, 0x26, 0xaf, 0xaf, 0x62, 0xb8,
+	0xce, 0xed, 0xaa, 0xdb, 0x38, 0x2a, 0x7d, 0x1a, 0x46, 0xfc, 0x08, 0x60, 0x04, 0x18, 0x1a, 0x4d,
+	0x6e, 0x1b, 0x9e, 0x9b, 0xe8, 0x2f, 0xd6, 0x86, 0xfd, 0x43, 0x9e, 0xfd, 0xd6, 0xe0, 0x98, 0x80,
+	0x69, 0xb8, 0x9d, 0x4b, 0x4e, 0x0a, 0xf9, 0x8e, 0xc0, 0x4c, 0x07, 0xbb, 0xc2, 0xfc, 0x16, 0x86,
+	0xdc, 0x65, 0x7e, 0x05, 0xf1, 0x4d, 0xf4, 0x99, 0x77, 0x54, 0xcc, 0x8b, 0x30, 0x6a, 0x75, 0x40,
+	0xd1, 0x72, 0x36, 0xec, 0x08, 0x26, 0xdf, 0x27, 0xb5, 0x8e, 0x05, 0x38, 0x33, 0x4d, 0xb3, 0xf0,
+	0xc2, 0x89, 0x78, 0x4a, 0x5d, 0x3f, 0x13, 0xb8, 0x59, 0xe5, 0xce, 0x9a, 0x55, 0x47, 0xbb, 0xd9,
+	0xc0, 0x0a, 0xe2, 0x8a, 0x29, 0xcc, 0x06, 0x73, 0x8e, 0x2a, 0x5a, 0x82, 0x0b, 0x56, 0x62, 0x89,
+	0x45, 0x5c, 0x9c, 0x9f, 0x48, 0x59, 0x81, 0xdd, 0x10, 0x72, 0xf5, 0xb5, 0xfd, 0xce, 0x4c, 0xe2,
+	0x6d, 0xb8, 0x95, 0x43, 0x59, 0x8a, 0x7b, 0x44, 0x60, 0x22, 0x42, 0xa2, 0xa8, 0x20, 0xbe, 0xb5,
+	0x85, 0x5e, 0x10, 0x8d, 0xb9, 0xa3, 0xca, 0xde, 0x86, 0x21, 0x6c, 0xdb, 0xa4, 0xb6, 0x1b, 0xe9,
+	0xda, 0x3a, 0x61, 0xa4, 0xba, 0xae, 0xef, 0x99, 0xe9, 0xbb, 0x09, 0x93, 0x59, 0xac, 0xa5, 0xb8,
+	0xfb, 0xc9, 0x9b, 0x4b, 0x96, 0x5f, 0x96, 0xbe, 0x5e, 0xa7, 0xb5, 0xb3, 0x7d, 0x15, 0x59, 0x1c,
+	0x12, 0xb6, 0xf3, 0x3f, 0x01, 0x0c, 0x54, 0xb9, 0x43, 0x3f, 0x06, 0x7a, 0xfc, 0xbc, 0x45, 0x17,
+	0x52, 0xea, 0x9d, 0x75, 0x0a, 0x55, 0xef, 0x9c, 0xce, 0x29, 0x21, 0x42, 0x3f, 0x82, 0x91, 0x63,
+	0x7b, 0x39, 0x9d, 0xcf, 0x08, 0x95, 0x72, 0xf6, 0x53, 0x17, 0x4e, 0xe5, 0x23, 0xb3, 0x7f, 0x4e,
+	0x60, 0xb4, 0xd7, 0x1e, 0x41, 0x5f, 0x4a, 0x8f, 0x96, 0x71, 0xb2, 0x50, 0x17, 0x4f, 0xeb, 0x76,
+	0x80, 0x47, 0xaf, 0xc1, 0x9d, 0xc5, 0x23, 0x63, 0xdf, 0x52, 0x17, 0x4f, 0xeb, 0x26, 0x79, 0x7c,
+	0x4b, 0x60, 0x2c, 0x6b, 0xfe, 0xd2, 0xd7, 0xf3, 0x04, 0x66, 0x6e, 0x2a, 0xea, 0x1b, 0xfd, 0xba,
+	0x4b, 0x7e, 0x3f, 0x10, 0x18, 0xcf, 0x9b, 0xa5, 0x74, 0x29, 0x2f, 0x49, 0xee, 0x7e, 0xa1, 0x2e,
+	0xff, 0x9b, 0x10, 0x92, 0xeb, 0x57, 0x04, 0xd4, 0xf4, 0xa1, 0x48, 0x5f, 0x4b, 0x4f, 0x91, 0x3b,
+	0xfd, 0xd5, 0x7b, 0xfd, 0x39, 0x4b, 0x66, 0x5f, 0x10, 0xb8, 0x96, 0x32, 0xce, 0xe8, 0x2b, 0x19,
+	0x91, 0x33, 0xe7, 0xb6, 0xfa, 0x6a, 0x1f, 0x9e, 0x07, 0x4a, 0x95, 0x3e, 0xb4, 0xb2, 0x4a, 0x95,
+	0x3b, 0x6e, 0xd5, 0x7b, 0xfd, 0x39, 0x27, 0xcc, 0xd4, 0xf3, 0x9f, 0x44, 0x67, 0xf2, 0x65, 0xf7,
+	0xf1, 0xae, 0x46, 0x9e, 0xec, 0x6a, 0xe4, 0xcf, 0x5d, 0x8d, 0x7c, 0xb9, 0xa7, 0x15, 0x9e, 0xec,
+	0x69, 0x85, 0xdf, 0xf6, 0xb4, 0x02, 0x28, 0x2e, 0xeb, 0x9d, 0x60, 0x95, 0xbc, 0xbf, 0x70, 0xe0,
+	0x4b, 0xa0, 0x8b, 0x99, 0x75, 0xd9, 0x81, 0x3b, 0x7d, 0xab, 0xf3, 0xe1, 0x1f, 0x7f, 0x1a, 0x6c,
+	0x0c, 0xc6, 0x1f, 0xfd, 0x0b, 0xff, 0x0c, 0x00, 0x45, 0xe1, 0xaf, 0x9b, 0xcf, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +1108,12 @@ type MsgClient interface {
 	UpdateNhashPerUsdMilProposal(ctx context.Context, in *MsgUpdateNhashPerUsdMilProposalRequest, opts ...grpc.CallOption) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(ctx context.Context, in *MsgUpdateConversionFeeDenomProposalRequest, opts ...grpc.CallOption) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// ScheduleFeeCatalogProposal defines a governance proposal to schedule a new fee catalog
+	ScheduleFeeCatalogProposal(ctx context.Context, in *MsgScheduleFeeCatalogProposalRequest, opts ...grpc.CallOption) (*MsgScheduleFeeCatalogProposalResponse, error)
+	// SetFeeExemptionProposal defines a governance proposal to add or replace a fee exemption
+	SetFeeExemptionProposal(ctx context.Context, in *MsgSetFeeExemptionProposalRequest, opts ...grpc.CallOption) (*MsgSetFeeExemptionProposalResponse, error)
+	// RemoveFeeExemptionProposal defines a governance proposal to delete a fee exemption
+	RemoveFeeExemptionProposal(ctx context.Context, in *MsgRemoveFeeExemptionProposalRequest, opts ...grpc.CallOption) (*MsgRemoveFeeExemptionProposalResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +1178,33 @@ func (c *msgClient) UpdateConversionFeeDenomProposal(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) ScheduleFeeCatalogProposal(ctx context.Context, in *MsgScheduleFeeCatalogProposalRequest, opts ...grpc.CallOption) (*MsgScheduleFeeCatalogProposalResponse, error) {
+	out := new(MsgScheduleFeeCatalogProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/ScheduleFeeCatalogProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeExemptionProposal(ctx context.Context, in *MsgSetFeeExemptionProposalRequest, opts ...grpc.CallOption) (*MsgSetFeeExemptionProposalResponse, error) {
+	out := new(MsgSetFeeExemptionProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/SetFeeExemptionProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeExemptionProposal(ctx context.Context, in *MsgRemoveFeeExemptionProposalRequest, opts ...grpc.CallOption) (*MsgRemoveFeeExemptionProposalResponse, error) {
+	out := new(MsgRemoveFeeExemptionProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/RemoveFeeExemptionProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AssessCustomMsgFee endpoint executes the additional fee charges.
@@ -818,6 +1222,12 @@ type MsgServer interface {
 	UpdateNhashPerUsdMilProposal(context.Context, *MsgUpdateNhashPerUsdMilProposalRequest) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(context.Context, *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// ScheduleFeeCatalogProposal defines a governance proposal to schedule a new fee catalog
+	ScheduleFeeCatalogProposal(context.Context, *MsgScheduleFeeCatalogProposalRequest) (*MsgScheduleFeeCatalogProposalResponse, error)
+	// SetFeeExemptionProposal defines a governance proposal to add or replace a fee exemption
+	SetFeeExemptionProposal(context.Context, *MsgSetFeeExemptionProposalRequest) (*MsgSetFeeExemptionProposalResponse, error)
+	// RemoveFeeExemptionProposal defines a governance proposal to delete a fee exemption
+	RemoveFeeExemptionProposal(context.Context, *MsgRemoveFeeExemptionProposalRequest) (*MsgRemoveFeeExemptionProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -842,6 +1252,15 @@ func (*UnimplementedMsgServer) UpdateNhashPerUsdMilProposal(ctx context.Context,
 func (*UnimplementedMsgServer) UpdateConversionFeeDenomProposal(ctx context.Context, req *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionFeeDenomProposal not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleFeeCatalogProposal(ctx context.Context, req *MsgScheduleFeeCatalogProposalRequest) (*MsgScheduleFeeCatalogProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleFeeCatalogProposal not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeExemptionProposal(ctx context.Context, req *MsgSetFeeExemptionProposalRequest) (*MsgSetFeeExemptionProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeExemptionProposal not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeExemptionProposal(ctx context.Context, req *MsgRemoveFeeExemptionProposalRequest) (*MsgRemoveFeeExemptionProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeExemptionProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -955,38 +1374,104 @@ func _Msg_UpdateConversionFeeDenomProposal_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.msgfees.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AssessCustomMsgFee",
-			Handler:    _Msg_AssessCustomMsgFee_Handler,
-		},
-		{
-			MethodName: "AddMsgFeeProposal",
-			Handler:    _Msg_AddMsgFeeProposal_Handler,
-		},
-		{
-			MethodName: "UpdateMsgFeeProposal",
-			Handler:    _Msg_UpdateMsgFeeProposal_Handler,
-		},
-		{
-			MethodName: "RemoveMsgFeeProposal",
-			Handler:    _Msg_RemoveMsgFeeProposal_Handler,
-		},
-		{
-			MethodName: "UpdateNhashPerUsdMilProposal",
-			Handler:    _Msg_UpdateNhashPerUsdMilProposal_Handler,
-		},
-		{
-			MethodName: "UpdateConversionFeeDenomProposal",
-			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/msgfees/v1/tx.proto",
+func _Msg_ScheduleFeeCatalogProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleFeeCatalogProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleFeeCatalogProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/ScheduleFeeCatalogProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleFeeCatalogProposal(ctx, req.(*MsgScheduleFeeCatalogProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeExemptionProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeExemptionProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeExemptionProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/SetFeeExemptionProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeExemptionProposal(ctx, req.(*MsgSetFeeExemptionProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeExemptionProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeExemptionProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeExemptionProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/RemoveFeeExemptionProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeExemptionProposal(ctx, req.(*MsgRemoveFeeExemptionProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.msgfees.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AssessCustomMsgFee",
+			Handler:    _Msg_AssessCustomMsgFee_Handler,
+		},
+		{
+			MethodName: "AddMsgFeeProposal",
+			Handler:    _Msg_AddMsgFeeProposal_Handler,
+		},
+		{
+			MethodName: "UpdateMsgFeeProposal",
+			Handler:    _Msg_UpdateMsgFeeProposal_Handler,
+		},
+		{
+			MethodName: "RemoveMsgFeeProposal",
+			Handler:    _Msg_RemoveMsgFeeProposal_Handler,
+		},
+		{
+			MethodName: "UpdateNhashPerUsdMilProposal",
+			Handler:    _Msg_UpdateNhashPerUsdMilProposal_Handler,
+		},
+		{
+			MethodName: "UpdateConversionFeeDenomProposal",
+			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
+		},
+		{
+			MethodName: "ScheduleFeeCatalogProposal",
+			Handler:    _Msg_ScheduleFeeCatalogProposal_Handler,
+		},
+		{
+			MethodName: "SetFeeExemptionProposal",
+			Handler:    _Msg_SetFeeExemptionProposal_Handler,
+		},
+		{
+			MethodName: "RemoveFeeExemptionProposal",
+			Handler:    _Msg_RemoveFeeExemptionProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/msgfees/v1/tx.proto",
 }
 
 func (m *MsgAssessCustomMsgFeeRequest) Marshal() (dAtA []byte, err error) {
@@ -1093,6 +1578,34 @@ func (m *MsgAddMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RecipientSplits) > 0 {
+		for iNdEx := len(m.RecipientSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1177,6 +1690,34 @@ func (m *MsgUpdateMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RecipientSplits) > 0 {
+		for iNdEx := len(m.RecipientSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1261,6 +1802,20 @@ func (m *MsgRemoveMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1419,101 +1974,301 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleFeeCatalogProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleFeeCatalogProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleFeeCatalogProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RecipientBasisPoints)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Catalog.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssessCustomMsgFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleFeeCatalogProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleFeeCatalogProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleFeeCatalogProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeExemptionProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeExemptionProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeExemptionProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecipientBasisPoints)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Exemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMsgFeeProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeExemptionProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetFeeExemptionProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeExemptionProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeExemptionProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeExemptionProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeExemptionProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeExemptionProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeExemptionProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeExemptionProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientBasisPoints)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAssessCustomMsgFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientBasisPoints)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RecipientSplits) > 0 {
+		for _, e := range m.RecipientSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMsgFeeProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = len(m.Recipient)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -1526,6 +2281,20 @@ func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.RecipientSplits) > 0 {
+		for _, e := range m.RecipientSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1552,6 +2321,14 @@ func (m *MsgRemoveMsgFeeProposalRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1615,6 +2392,80 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleFeeCatalogProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Catalog.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleFeeCatalogProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeExemptionProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Exemption.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeeExemptionProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeExemptionProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeExemptionProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1962,23 +2813,708 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientSplits = append(m.RecipientSplits, RecipientSplit{})
+			if err := m.RecipientSplits[len(m.RecipientSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientSplits = append(m.RecipientSplits, RecipientSplit{})
+			if err := m.RecipientSplits[len(m.RecipientSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2006,11 +3542,11 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2038,11 +3574,11 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2070,7 +3606,7 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2093,7 +3629,7 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2116,10 +3652,10 @@ func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2143,7 +3679,7 @@ func (m *MsgAddMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2166,17 +3702,17 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
 			}
-			var stringLen uint64
+			m.NhashPerUsdMil = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2186,29 +3722,16 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2218,60 +3741,127 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2299,9 +3889,9 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2354,7 +3944,7 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,10 +3967,10 @@ func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2404,7 +3994,7 @@ func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleFeeCatalogProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2427,17 +4017,17 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleFeeCatalogProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleFeeCatalogProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Catalog", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2447,23 +4037,24 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			if err := m.Catalog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2518,7 +4109,7 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleFeeCatalogProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,10 +4132,10 @@ func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleFeeCatalogProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleFeeCatalogProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2568,7 +4159,7 @@ func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeExemptionProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,17 +4182,17 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeExemptionProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeExemptionProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exemption", wireType)
 			}
-			m.NhashPerUsdMil = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2611,11 +4202,25 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Exemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
@@ -2669,7 +4274,7 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeExemptionProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +4297,10 @@ func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeExemptionProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeExemptionProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +4324,7 @@ func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeExemptionProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +4347,15 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeExemptionProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeExemptionProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,7 +4383,7 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2833,7 +4438,7 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeExemptionProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2856,10 +4461,10 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeExemptionProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeExemptionProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,14 @@
+package name
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+)
+
+// BeginBlocker removes the name freezes that have expired.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.DeleteExpiredNameFreezes(ctx); err != nil {
+		k.Logger(ctx).Error("could not delete expired name freezes", "err", err)
+	}
+}
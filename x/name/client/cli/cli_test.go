@@ -202,12 +202,13 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"enforce_denom_name_linkage\":false}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
 			`allow_unrestricted_names: true
+enforce_denom_name_linkage: false
 max_name_levels: 2
 max_segment_length: 32
 min_segment_length: 1`,
@@ -777,6 +778,103 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 			},
 			expectErr: `invalid allow unrestricted names flag: strconv.ParseBool: parsing "invalid": invalid syntax`,
 		},
+		{
+			name: "update name params with enforce denom name linkage, should succeed",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"true",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update name params, should fail incorrect enforce denom name linkage flag",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"invalid",
+			},
+			expectErr: `invalid enforce denom name linkage flag: strconv.ParseBool: parsing "invalid": invalid syntax`,
+		},
+		{
+			name: "update name params with binding fee community pool bips, should succeed",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"false",
+				"250",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update name params, should fail incorrect binding fee community pool bips",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"false",
+				"invalid",
+			},
+			expectErr: `invalid binding fee community pool bips: strconv.ParseUint: parsing "invalid": invalid syntax`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.args = append(tc.args,
+				"--title", fmt.Sprintf("title: %v", tc.name),
+				"--summary", fmt.Sprintf("summary: %v", tc.name),
+				"--deposit=1000000stake",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+			)
+			testcli.NewTxExecutor(tc.cmd, tc.args).
+				WithExpErrMsg(tc.expectErr).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGovFreezeNameCmds() {
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    string
+		expectedCode uint32
+	}{
+		{
+			name:         "freeze name, should succeed",
+			cmd:          namecli.GetGovFreezeNameCmd(),
+			args:         []string{"attribute", "1000", "compromised key"},
+			expectedCode: 0,
+		},
+		{
+			name:      "freeze name, should fail invalid expiration height",
+			cmd:       namecli.GetGovFreezeNameCmd(),
+			args:      []string{"attribute", "soon"},
+			expectErr: `invalid expiration height: strconv.ParseInt: parsing "soon": invalid syntax`,
+		},
+		{
+			name:         "unfreeze name, should succeed",
+			cmd:          namecli.GetGovUnfreezeNameCmd(),
+			args:         []string{"attribute"},
+			expectedCode: 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -29,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		BindingFeeCommand(),
+		NameFreezesCommand(),
 	)
 
 	return queryCmd
@@ -95,6 +97,67 @@ func ResolveNameCommand() *cobra.Command {
 	return cmd
 }
 
+// BindingFeeCommand returns the command handler for getting the fee to bind names under a given name.
+func BindingFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "binding-fee <name>",
+		Short:   "Get the fee to bind names under a name",
+		Example: fmt.Sprintf(`$ %s query name binding-fee attrib.name`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+			response, err := queryClient.BindingFee(context.Background(), &types.QueryBindingFeeRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NameFreezesCommand returns the command handler for listing the name freezes currently in effect.
+func NameFreezesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freezes",
+		Short:   "Query the name freezes currently in effect",
+		Example: fmt.Sprintf(`$ %s query name freezes`, version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.NameFreezes(context.Background(), &types.QueryNameFreezesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "freezes")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ReverseLookupCommand returns the command handler for finding all names that point to an address.
 func ReverseLookupCommand() *cobra.Command {
 	cmd := &cobra.Command{
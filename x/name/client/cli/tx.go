@@ -45,6 +45,11 @@ func NewTxCmd() *cobra.Command {
 		GetDeleteNameCmd(),
 		GetModifyNameCmd(),
 		GetGovRootNameCmd(),
+		GetSetBindingFeeCmd(),
+		GetGovFreezeNameCmd(),
+		GetGovUnfreezeNameCmd(),
+		GetBindNamesCmd(),
+		GetDeleteNamesCmd(),
 	)
 	return txCmd
 }
@@ -216,6 +221,117 @@ $ %s tx name gov-root-name \
 	return cmd
 }
 
+// GetSetBindingFeeCmd is the CLI command for setting the fee to bind names under a parent name.
+func GetSetBindingFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-binding-fee <name> [<fee>]",
+		Short: "Set the fee to bind names under a name you own",
+		Long: `Set the fee that must be paid to the owner of a name to bind names directly under it.
+Part of each fee may go to the community pool (see the binding_fee_community_pool_bips param).
+If no fee is provided, the name's binding fee is removed.`,
+		Example: fmt.Sprintf(`$ %[1]s tx name set-binding-fee example 1000000000nhash --from mykey
+$ %[1]s tx name set-binding-fee example --from mykey`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var fee sdk.Coins
+			if len(args) > 1 {
+				fee, err = sdk.ParseCoinsNormalized(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid fee: %w", err)
+				}
+			}
+
+			msg := types.NewMsgSetBindingFeeRequest(strings.ToLower(strings.TrimSpace(args[0])), fee, "")
+			isGov, err := cmd.Flags().GetBool(FlagGovProposal)
+			if err != nil {
+				return err
+			}
+			if isGov {
+				msg.Signer = provcli.GetAuthority(cmd.Flags())
+				return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, cmd.Flags(), msg)
+			}
+
+			msg.Signer = clientCtx.GetFromAddress().String()
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagGovProposal, false, "Run transaction as gov proposal")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGovFreezeNameCmd is the CLI command for freezing a name subtree via governance proposal.
+func GetGovFreezeNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gov-freeze-name <name> <expiration-height> [<reason>]",
+		Short: "Submit a governance proposal to freeze a name and all of the names under it",
+		Long: `Submit a governance proposal to freeze a name and all of the names under it.
+While frozen, names under it cannot be bound, modified, or deleted, and attributes under them cannot be written.
+The freeze ends automatically at the expiration height, or earlier if removed by governance.`,
+		Example: fmt.Sprintf(`$ %s tx name gov-freeze-name kyc.provider 20000000 "compromised signing key" --deposit 50000nhash`, version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expirationHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid expiration height: %w", err)
+			}
+			var reason string
+			if len(args) > 2 {
+				reason = args[2]
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := types.NewMsgFreezeNameRequest(authority, strings.ToLower(strings.TrimSpace(args[0])), expirationHeight, reason)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGovUnfreezeNameCmd is the CLI command for removing a name freeze via governance proposal.
+func GetGovUnfreezeNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gov-unfreeze-name <name>",
+		Short:   "Submit a governance proposal to remove the freeze from a name before it expires",
+		Example: fmt.Sprintf(`$ %s tx name gov-unfreeze-name kyc.provider --deposit 50000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := types.NewMsgUnfreezeNameRequest(authority, strings.ToLower(strings.TrimSpace(args[0])))
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // owner returns the proposal owner
 func owner(ctx client.Context, flags *pflag.FlagSet) (string, error) {
 	proposalOwner, err := flags.GetString(FlagOwner)
@@ -236,11 +352,14 @@ func owner(ctx client.Context, flags *pflag.FlagSet) (string, error) {
 // GetUpdateNameParamsCmd creates a command to update the name module's params via governance proposal.
 func GetUpdateNameParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-name-params <max-segment-length> <min-segment-length> <max-name-levels> <allow-unrestricted-names>",
-		Short:   "Update the name module's params via governance proposal",
-		Long:    "Submit an update name params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(4),
-		Example: fmt.Sprintf(`%[1]s tx name update-name-params 16 2 5 true --deposit 50000nhash`, version.AppName),
+		Use:   "update-name-params <max-segment-length> <min-segment-length> <max-name-levels> <allow-unrestricted-names> [enforce-denom-name-linkage [binding-fee-community-pool-bips]]",
+		Short: "Update the name module's params via governance proposal",
+		Long: `Submit an update name params via governance proposal along with an initial deposit.
+If not provided, enforce-denom-name-linkage defaults to false and binding-fee-community-pool-bips defaults to 0.`,
+		Args: cobra.RangeArgs(4, 6),
+		Example: fmt.Sprintf(`%[1]s tx name update-name-params 16 2 5 true --deposit 50000nhash
+%[1]s tx name update-name-params 16 2 5 true true --deposit 50000nhash
+%[1]s tx name update-name-params 16 2 5 true true 1000 --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -270,11 +389,29 @@ func GetUpdateNameParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid allow unrestricted names flag: %w", err)
 			}
 
+			enforceDenomNameLinkage := false
+			if len(args) > 4 {
+				enforceDenomNameLinkage, err = strconv.ParseBool(args[4])
+				if err != nil {
+					return fmt.Errorf("invalid enforce denom name linkage flag: %w", err)
+				}
+			}
+
+			var bindingFeeCommunityPoolBips uint64
+			if len(args) > 5 {
+				bindingFeeCommunityPoolBips, err = strconv.ParseUint(args[5], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid binding fee community pool bips: %w", err)
+				}
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				uint32(maxSegmentLength), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				uint32(minSegmentLength), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				uint32(maxNameLevels),    //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				allowUnrestrictedNames,
+				enforceDenomNameLinkage,
+				uint32(bindingFeeCommunityPoolBips), //nolint:gosec // G115: ParseUint bitsize is 32, so we know this is okay.
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
@@ -287,3 +424,69 @@ func GetUpdateNameParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetBindNamesCmd is the CLI command for binding several names at once.
+func GetBindNamesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind-names <name>=<address> [<name>=<address> ...]",
+		Short: "Bind several names at once",
+		Long: fmt.Sprintf(`Bind several full names at once. If any of them cannot be bound, none of them are.
+Each name is bound under its parent in the order provided, so a name can be bound under one bound earlier in the same
+transaction. The --from account must own any restricted parents and pays any binding fees. At most %d names can be bound.`,
+			types.MaxBulkNames),
+		Example: fmt.Sprintf(`$ %s tx name bind-names product.example=pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk \
+	kyc.product.example=pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --from mykey`, version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			restricted := !viper.GetBool(FlagUnrestricted)
+			records := make([]types.NameRecord, len(args))
+			for i, arg := range args {
+				name, addr, ok := strings.Cut(arg, "=")
+				if !ok {
+					return fmt.Errorf("invalid name binding %q: expected format <name>=<address>", arg)
+				}
+				address, err := sdk.AccAddressFromBech32(strings.TrimSpace(addr))
+				if err != nil {
+					return fmt.Errorf("invalid name binding %q: %w", arg, err)
+				}
+				records[i] = types.NewNameRecord(strings.ToLower(strings.TrimSpace(name)), address, restricted)
+			}
+			msg := types.NewMsgBindNamesRequest(clientCtx.GetFromAddress().String(), records)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().BoolP(FlagUnrestricted, "u", false, "Allow child name creation by everyone")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeleteNamesCmd is the CLI command for deleting several names at once.
+func GetDeleteNamesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-names <name> [<name> ...]",
+		Short: "Delete several bound names at once",
+		Long: fmt.Sprintf(`Delete several names at once. If any of them cannot be deleted, none of them are.
+Each name must resolve to the --from account, and all of the attributes with each name are deleted too. At most %d names can be deleted.`, types.MaxBulkNames),
+		Example: fmt.Sprintf(`$ %s tx name delete-names kyc.product.example product.example --from mykey`, version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			names := make([]string, len(args))
+			for i, arg := range args {
+				names[i] = strings.ToLower(strings.TrimSpace(arg))
+			}
+			msg := types.NewMsgDeleteNamesRequest(clientCtx.GetFromAddress().String(), names)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// SetBindingFee sets the fee to bind a name under a parent name. An empty fee removes it.
+func (k Keeper) SetBindingFee(ctx sdk.Context, bindingFee types.NameBindingFee) error {
+	key, err := types.GetBindingFeeKey(bindingFee.Name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if bindingFee.Fee.IsZero() {
+		store.Delete(key)
+		return nil
+	}
+	bz, err := k.cdc.Marshal(&bindingFee)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// GetBindingFee returns the fee to bind a name under a parent name.
+// If the parent name doesn't have a binding fee, the returned fee is empty.
+func (k Keeper) GetBindingFee(ctx sdk.Context, name string) (types.NameBindingFee, error) {
+	rv := types.NewNameBindingFee(name, nil)
+	key, err := types.GetBindingFeeKey(name)
+	if err != nil {
+		return rv, err
+	}
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return rv, nil
+	}
+	if err = k.cdc.Unmarshal(bz, &rv); err != nil {
+		return rv, fmt.Errorf("could not read binding fee for %q: %w", name, err)
+	}
+	return rv, nil
+}
+
+// IterateBindingFees iterates over all the stored name binding fees and passes them to a callback function.
+func (k Keeper) IterateBindingFees(ctx sdk.Context, handle func(bindingFee types.NameBindingFee) error) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BindingFeeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bindingFee types.NameBindingFee
+		if err := k.cdc.Unmarshal(iterator.Value(), &bindingFee); err != nil {
+			return err
+		}
+		if err := handle(bindingFee); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PayBindingFee collects the fee to bind a name under the provided parent record from the payer.
+// The fee is split between the parent name's owner and the community pool according to the params.
+// Nothing is collected if the parent name doesn't have a binding fee, or if the payer owns the parent name.
+func (k Keeper) PayBindingFee(ctx sdk.Context, parent types.NameRecord, name string, payer sdk.AccAddress) error {
+	if parent.Address == payer.String() {
+		return nil
+	}
+	bindingFee, err := k.GetBindingFee(ctx, parent.Name)
+	if err != nil || bindingFee.Fee.IsZero() {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(parent.Address)
+	if err != nil {
+		return fmt.Errorf("invalid %q owner address: %w", parent.Name, err)
+	}
+	ownerAmt, poolAmt := k.GetParams(ctx).SplitBindingFee(bindingFee.Fee)
+	if !ownerAmt.IsZero() {
+		if err = k.bankKeeper.SendCoins(ctx, payer, owner, ownerAmt); err != nil {
+			return fmt.Errorf("could not pay %q binding fee to owner: %w", parent.Name, err)
+		}
+	}
+	if !poolAmt.IsZero() {
+		if err = k.distrKeeper.FundCommunityPool(ctx, poolAmt, payer); err != nil {
+			return fmt.Errorf("could not pay %q binding fee to community pool: %w", parent.Name, err)
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNameBindingFeePaid(name, payer.String(), parent.Address, ownerAmt, poolAmt))
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// SetNameFreeze freezes a name and all of the names under it, replacing any existing freeze on that name.
+func (k Keeper) SetNameFreeze(ctx sdk.Context, freeze types.NameFreeze) error {
+	if err := freeze.Validate(); err != nil {
+		return err
+	}
+	key, err := types.GetNameFreezeKey(freeze.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&freeze)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+	return nil
+}
+
+// GetNameFreeze returns the freeze placed directly on a name, or nil if that name doesn't have one.
+// Freezes on names above the provided one are not considered here.
+func (k Keeper) GetNameFreeze(ctx sdk.Context, name string) (*types.NameFreeze, error) {
+	key, err := types.GetNameFreezeKey(name)
+	if err != nil {
+		return nil, err
+	}
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.NameFreeze
+	if err = k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read freeze for %q: %w", name, err)
+	}
+	return &rv, nil
+}
+
+// DeleteNameFreeze removes the freeze placed directly on a name.
+func (k Keeper) DeleteNameFreeze(ctx sdk.Context, name string) error {
+	key, err := types.GetNameFreezeKey(name)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(key)
+	return nil
+}
+
+// IterateNameFreezes iterates over all the stored name freezes and passes them to a callback function.
+// Freezes that have expired but have not yet been removed are included.
+func (k Keeper) IterateNameFreezes(ctx sdk.Context, handle func(freeze types.NameFreeze) error) error {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.NameFreezeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var freeze types.NameFreeze
+		if err := k.cdc.Unmarshal(iterator.Value(), &freeze); err != nil {
+			return err
+		}
+		if err := handle(freeze); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateNameNotFrozen returns an error if the provided name, or any name above it, has a freeze in effect.
+func (k Keeper) ValidateNameNotFrozen(ctx sdk.Context, name string) error {
+	segments := strings.Split(types.NormalizeName(name), ".")
+	for i := range segments {
+		subtree := strings.Join(segments[i:], ".")
+		freeze, err := k.GetNameFreeze(ctx, subtree)
+		if err != nil {
+			return err
+		}
+		if freeze != nil && !freeze.IsExpired(ctx.BlockHeight()) {
+			if i == 0 {
+				return types.ErrNameFrozen.Wrapf("%q is frozen until height %d", subtree, freeze.ExpirationHeight)
+			}
+			return types.ErrNameFrozen.Wrapf("%q is under %q which is frozen until height %d", name, subtree, freeze.ExpirationHeight)
+		}
+	}
+	return nil
+}
+
+// DeleteExpiredNameFreezes removes all the name freezes that are no longer in effect.
+func (k Keeper) DeleteExpiredNameFreezes(ctx sdk.Context) error {
+	var expired []string
+	err := k.IterateNameFreezes(ctx, func(freeze types.NameFreeze) error {
+		if freeze.IsExpired(ctx.BlockHeight()) {
+			expired = append(expired, freeze.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, name := range expired {
+		if err = k.DeleteNameFreeze(ctx, name); err != nil {
+			return err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventNameUnfrozen(name, true)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -18,6 +18,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, bindingFee := range data.BindingFees {
+		if err := k.SetBindingFee(ctx, bindingFee); err != nil {
+			panic(err)
+		}
+	}
+	for _, freeze := range data.Freezes {
+		if err := k.SetNameFreeze(ctx, freeze); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the name module.
@@ -34,5 +44,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, records)
+	genState := types.NewGenesisState(params, records)
+	err := k.IterateBindingFees(ctx, func(bindingFee types.NameBindingFee) error {
+		genState.BindingFees = append(genState.BindingFees, bindingFee)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = k.IterateNameFreezes(ctx, func(freeze types.NameFreeze) error {
+		genState.Freezes = append(genState.Freezes, freeze)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return genState
 }
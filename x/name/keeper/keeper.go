@@ -27,22 +27,29 @@ type Keeper struct {
 	// the signing authority for the gov proposals
 	authority string
 
-	attrKeeper types.AttributeKeeper
+	attrKeeper  types.AttributeKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
 }
 
 // NewKeeper returns a name keeper. It handles:
 // - managing a hierarchy of names
 // - enforcing permissions for name creation/deletion
+// - collecting the fees for binding names under a parent name
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
 ) Keeper {
 	return Keeper{
-		storeKey:  key,
-		cdc:       cdc,
-		authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		storeKey:    key,
+		cdc:         cdc,
+		authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
 	}
 }
 
@@ -95,6 +102,9 @@ func (k Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAddress,
 	if err = types.ValidateAddress(addr); err != nil {
 		return types.ErrInvalidAddress.Wrap(err.Error())
 	}
+	if err = k.ValidateNameNotFrozen(ctx, name); err != nil {
+		return err
+	}
 
 	if err = k.addRecord(ctx, name, addr, restrict, false); err != nil {
 		return err
@@ -114,6 +124,9 @@ func (k Keeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddre
 	if err = types.ValidateAddress(addr); err != nil {
 		return types.ErrInvalidAddress.Wrap(err.Error())
 	}
+	if err = k.ValidateNameNotFrozen(ctx, name); err != nil {
+		return err
+	}
 
 	// If there's an existing record, and the address is changing, we need to
 	// delete the existing address -> name index entry. If there's an error getting
@@ -204,6 +217,9 @@ func (k Keeper) GetRecordsByAddress(ctx sdk.Context, address sdk.AccAddress) (ty
 
 // DeleteRecord removes a name record from the kvstore.
 func (k Keeper) DeleteRecord(ctx sdk.Context, name string) error {
+	if err := k.ValidateNameNotFrozen(ctx, name); err != nil {
+		return err
+	}
 	// Need the record to clear the address index
 	record, err := k.GetRecordByName(ctx, name)
 	if err != nil {
@@ -229,6 +245,12 @@ func (k Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(addrPrefix) {
 		store.Delete(addrPrefix)
 	}
+	// Delete the fee for binding names under this one
+	feeKey, err := types.GetBindingFeeKey(name)
+	if err != nil {
+		return err
+	}
+	store.Delete(feeKey)
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name, record.Restricted)
 
@@ -70,6 +70,16 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("invalid address", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	// Collect the fee for binding a name under the parent (if there is one).
+	payer, err := sdk.AccAddressFromBech32(msg.Parent.Address)
+	if err != nil {
+		ctx.Logger().Error("unable to parse parent address", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = s.Keeper.PayBindingFee(ctx, *record, name, payer); err != nil {
+		ctx.Logger().Error("unable to pay binding fee", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 	if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -212,9 +222,166 @@ func (s msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		msg.Params.AllowUnrestrictedNames,
 		msg.Params.MaxNameLevels,
 		msg.Params.MaxSegmentLength,
-		msg.Params.MinSegmentLength)); err != nil {
+		msg.Params.MinSegmentLength,
+		msg.Params.EnforceDenomNameLinkage,
+		msg.Params.BindingFeeCommunityPoolBips)); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetBindingFee sets the fee that must be paid to bind a name under a parent name.
+func (s msgServer) SetBindingFee(goCtx context.Context, msg *types.MsgSetBindingFeeRequest) (*types.MsgSetBindingFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	existing, _ := s.Keeper.GetRecordByName(ctx, name)
+	if existing == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error())
+	}
+	if msg.Signer != s.Keeper.GetAuthority() && msg.Signer != existing.Address {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected %s or %s got %s", s.Keeper.GetAuthority(), existing.Address, msg.Signer)
+	}
+
+	if err = s.Keeper.SetBindingFee(ctx, types.NewNameBindingFee(name, msg.Fee)); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventNameBindingFeeSet(name, msg.Fee, msg.Signer)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBindingFeeResponse{}, nil
+}
+
+// FreezeName is a governance endpoint for freezing a name and all of the names under it.
+func (s msgServer) FreezeName(goCtx context.Context, msg *types.MsgFreezeNameRequest) (*types.MsgFreezeNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if msg.ExpirationHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration height %d must be after the current block height %d",
+			msg.ExpirationHeight, ctx.BlockHeight())
+	}
+
+	freeze := types.NewNameFreeze(name, msg.ExpirationHeight, msg.Reason)
+	if err = s.Keeper.SetNameFreeze(ctx, freeze); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventNameFrozen(freeze)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeNameResponse{}, nil
+}
+
+// UnfreezeName is a governance endpoint for removing a freeze from a name before it expires.
+func (s msgServer) UnfreezeName(goCtx context.Context, msg *types.MsgUnfreezeNameRequest) (*types.MsgUnfreezeNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := s.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	freeze, err := s.Keeper.GetNameFreeze(ctx, name)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if freeze == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("name %q is not frozen", name)
+	}
+
+	if err = s.Keeper.DeleteNameFreeze(ctx, name); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventNameUnfrozen(name, false)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeNameResponse{}, nil
+}
+
+// BindNames binds several names at once. If any of them cannot be bound, none of them are.
+func (s msgServer) BindNames(goCtx context.Context, msg *types.MsgBindNamesRequest) (*types.MsgBindNamesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	// The names are bound in a cache context so that nothing is kept if any of them fail.
+	cacheCtx, writeCache := ctx.CacheContext()
+	resp := &types.MsgBindNamesResponse{Results: make([]types.BindNamesResult, len(msg.Records))}
+	for i, record := range msg.Records {
+		name, err := s.Keeper.Normalize(cacheCtx, record.Name)
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest.Wrap(err.Error()), "could not bind records[%d] %q", i, record.Name)
+		}
+		segment, parentName := types.SplitName(name)
+		bindMsg := types.NewMsgBindNameRequest(
+			types.NameRecord{Name: segment, Address: record.Address, Restricted: record.Restricted},
+			types.NameRecord{Name: parentName, Address: msg.Signer},
+		)
+		if _, err = s.BindName(cacheCtx, bindMsg); err != nil {
+			return nil, errors.Wrapf(err, "could not bind records[%d] %q", i, record.Name)
+		}
+		resp.Results[i].Record = types.NameRecord{Name: name, Address: record.Address, Restricted: record.Restricted}
+
+		// The binding fee is only paid when the signer doesn't own the parent (see PayBindingFee).
+		parent, err := s.Keeper.GetRecordByName(cacheCtx, parentName)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		if parent.Address != msg.Signer {
+			bindingFee, err := s.Keeper.GetBindingFee(cacheCtx, parent.Name)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+			resp.Results[i].BindingFee = bindingFee.Fee
+		}
+	}
+
+	writeCache()
+	return resp, nil
+}
+
+// DeleteNames deletes several names at once. If any of them cannot be deleted, none of them are.
+func (s msgServer) DeleteNames(goCtx context.Context, msg *types.MsgDeleteNamesRequest) (*types.MsgDeleteNamesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	// The names are deleted in a cache context so that nothing is kept if any of them fail.
+	cacheCtx, writeCache := ctx.CacheContext()
+	resp := &types.MsgDeleteNamesResponse{Records: make([]types.NameRecord, len(msg.Names))}
+	for i, name := range msg.Names {
+		record, err := s.Keeper.GetRecordByName(cacheCtx, types.NormalizeName(name))
+		if err != nil {
+			return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest.Wrap(err.Error()), "could not delete names[%d] %q", i, name)
+		}
+		deleteMsg := types.NewMsgDeleteNameRequest(types.NameRecord{Name: name, Address: msg.Signer})
+		if _, err = s.DeleteName(cacheCtx, deleteMsg); err != nil {
+			return nil, errors.Wrapf(err, "could not delete names[%d] %q", i, name)
+		}
+		resp.Records[i] = *record
+	}
+
+	writeCache()
+	return resp, nil
+}
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -393,6 +394,8 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				3,
 				10,
 				true,
+				true,
+				250,
 				authority,
 			),
 			expectedEvent: types.NewEventNameParamsUpdated(
@@ -400,6 +403,8 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				10,
 				100,
 				3,
+				true,
+				250,
 			),
 		},
 		{
@@ -409,6 +414,8 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				3,
 				10,
 				true,
+				false,
+				0,
 				"invalid-authority",
 			),
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalid-authority": expected gov account as only signer for proposal message`,
@@ -431,3 +438,318 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestSetBindingFee() {
+	authority := s.app.NameKeeper.GetAuthority()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	tests := []struct {
+		name          string
+		msg           *types.MsgSetBindingFeeRequest
+		expErr        string
+		expFee        sdk.Coins
+		expectedEvent proto.Message
+	}{
+		{
+			name:   "name does not exist",
+			msg:    types.NewMsgSetBindingFeeRequest("unknown.name", fee, s.owner1),
+			expErr: sdkerrors.ErrInvalidRequest.Wrap(types.ErrNameNotBound.Error()).Error(),
+		},
+		{
+			name:   "signer is neither authority nor owner",
+			msg:    types.NewMsgSetBindingFeeRequest("example.name", fee, s.owner2),
+			expErr: fmt.Sprintf("expected %s or %s got %s: unauthorized", authority, s.owner1, s.owner2),
+		},
+		{
+			name:          "set by owner",
+			msg:           types.NewMsgSetBindingFeeRequest("example.name", fee, s.owner1),
+			expFee:        fee,
+			expectedEvent: types.NewEventNameBindingFeeSet("example.name", fee, s.owner1),
+		},
+		{
+			name:          "set by authority",
+			msg:           types.NewMsgSetBindingFeeRequest("Example.Name", fee.Add(fee...), authority),
+			expFee:        fee.Add(fee...),
+			expectedEvent: types.NewEventNameBindingFeeSet("example.name", fee.Add(fee...), authority),
+		},
+		{
+			name:          "removed by owner",
+			msg:           types.NewMsgSetBindingFeeRequest("example.name", nil, s.owner1),
+			expFee:        nil,
+			expectedEvent: types.NewEventNameBindingFeeSet("example.name", nil, s.owner1),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.SetBindingFee(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "SetBindingFee error")
+				return
+			}
+			s.Require().NoError(err, "SetBindingFee error")
+			bindingFee, err := s.app.NameKeeper.GetBindingFee(s.ctx, "example.name")
+			s.Require().NoError(err, "GetBindingFee error")
+			s.Assert().Equal(tc.expFee.String(), bindingFee.Fee.String(), "binding fee")
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found: %v", tc.expectedEvent)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestBindNameWithBindingFee() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	s.Require().NoError(s.app.NameKeeper.SetBindingFee(s.ctx, types.NewNameBindingFee("example.name", fee)), "SetBindingFee")
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.BindingFeeCommunityPoolBips = 2_500
+	s.app.NameKeeper.SetParams(s.ctx, params)
+
+	s.Run("payer cannot afford the fee", func() {
+		msg := types.NewMsgBindNameRequest(types.NewNameRecord("poor", s.owner2Addr, false), types.NewNameRecord("example.name", s.owner2Addr, false))
+		_, err := s.msgServer.BindName(s.ctx, msg)
+		s.Require().ErrorContains(err, "could not pay \"example.name\" binding fee to owner", "BindName error")
+		s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, "poor.example.name"), "NameExists")
+	})
+
+	s.Run("owner binds without paying", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgBindNameRequest(types.NewNameRecord("mine", s.owner1Addr, false), types.NewNameRecord("example.name", s.owner1Addr, false))
+		_, err := s.msgServer.BindName(s.ctx, msg)
+		s.Require().NoError(err, "BindName error")
+		for _, event := range s.ctx.EventManager().Events() {
+			s.Assert().NotEqual(proto.MessageName(&types.EventNameBindingFeePaid{}), event.Type, "event type")
+		}
+	})
+
+	s.Run("fee is split between owner and community pool", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, fee), "FundAccount")
+		ownerBalance := s.app.BankKeeper.GetBalance(s.ctx, s.owner1Addr, "nhash")
+		poolBefore, err := s.app.DistrKeeper.FeePool.Get(s.ctx)
+		s.Require().NoError(err, "FeePool.Get before")
+
+		msg := types.NewMsgBindNameRequest(types.NewNameRecord("new", s.owner2Addr, false), types.NewNameRecord("example.name", s.owner2Addr, false))
+		_, err = s.msgServer.BindName(s.ctx, msg)
+		s.Require().NoError(err, "BindName error")
+
+		ownerAmt := sdk.NewCoins(sdk.NewInt64Coin("nhash", 750))
+		poolAmt := sdk.NewCoins(sdk.NewInt64Coin("nhash", 250))
+		s.Assert().Equal(ownerBalance.Add(ownerAmt[0]).String(), s.app.BankKeeper.GetBalance(s.ctx, s.owner1Addr, "nhash").String(), "owner balance")
+		s.Assert().Equal("0nhash", s.app.BankKeeper.GetBalance(s.ctx, s.owner2Addr, "nhash").String(), "payer balance")
+		poolAfter, err := s.app.DistrKeeper.FeePool.Get(s.ctx)
+		s.Require().NoError(err, "FeePool.Get after")
+		s.Assert().Equal(poolBefore.CommunityPool.Add(sdk.NewDecCoinsFromCoins(poolAmt...)...).String(), poolAfter.CommunityPool.String(), "community pool")
+
+		expEvent := types.NewEventNameBindingFeePaid("new.example.name", s.owner2, s.owner1, ownerAmt, poolAmt)
+		result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent)
+		s.Assert().True(result, "Expected typed event was not found: %v", expEvent)
+	})
+}
+
+func (s *MsgServerTestSuite) TestFreezeName() {
+	authority := s.app.NameKeeper.GetAuthority()
+	s.ctx = s.ctx.WithBlockHeight(10)
+
+	tests := []struct {
+		name          string
+		msg           *types.MsgFreezeNameRequest
+		expErr        string
+		expectedEvent proto.Message
+	}{
+		{
+			name:   "signer is not the authority",
+			msg:    types.NewMsgFreezeNameRequest(s.owner1, "example.name", 20, "test"),
+			expErr: fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", authority, s.owner1),
+		},
+		{
+			name:   "expiration height already passed",
+			msg:    types.NewMsgFreezeNameRequest(authority, "example.name", 10, "test"),
+			expErr: "expiration height 10 must be after the current block height 10: invalid request",
+		},
+		{
+			name:          "frozen by authority",
+			msg:           types.NewMsgFreezeNameRequest(authority, "Example.Name", 20, "compromised key"),
+			expectedEvent: types.NewEventNameFrozen(types.NewNameFreeze("example.name", 20, "compromised key")),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.FreezeName(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "FreezeName error")
+				return
+			}
+			s.Require().NoError(err, "FreezeName error")
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found: %v", tc.expectedEvent)
+		})
+	}
+
+	s.Run("names under the frozen name cannot be changed", func() {
+		msg := types.NewMsgBindNameRequest(types.NewNameRecord("sub", s.owner1Addr, false), types.NewNameRecord("example.name", s.owner1Addr, false))
+		_, err := s.msgServer.BindName(s.ctx, msg)
+		s.Require().ErrorContains(err, `"sub.example.name" is under "example.name" which is frozen until height 20`, "BindName error")
+
+		modify := types.NewMsgModifyNameRequest(authority, "example.name", s.owner2Addr, false)
+		_, err = s.msgServer.ModifyName(s.ctx, modify)
+		s.Require().ErrorContains(err, `"example.name" is frozen until height 20`, "ModifyName error")
+
+		del := types.NewMsgDeleteNameRequest(types.NewNameRecord("example.name", s.owner1Addr, false))
+		_, err = s.msgServer.DeleteName(s.ctx, del)
+		s.Require().ErrorContains(err, `"example.name" is frozen until height 20`, "DeleteName error")
+	})
+
+	s.Run("names outside the frozen subtree can still be changed", func() {
+		msg := types.NewMsgBindNameRequest(types.NewNameRecord("other", s.owner1Addr, false), types.NewNameRecord("name", s.owner1Addr, false))
+		_, err := s.msgServer.BindName(s.ctx, msg)
+		s.Require().NoError(err, "BindName error")
+	})
+
+	s.Run("freeze expires", func() {
+		s.ctx = s.ctx.WithBlockHeight(20)
+		s.Require().NoError(s.app.NameKeeper.ValidateNameNotFrozen(s.ctx, "sub.example.name"), "ValidateNameNotFrozen")
+
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.app.NameKeeper.DeleteExpiredNameFreezes(s.ctx), "DeleteExpiredNameFreezes")
+		freeze, err := s.app.NameKeeper.GetNameFreeze(s.ctx, "example.name")
+		s.Require().NoError(err, "GetNameFreeze")
+		s.Assert().Nil(freeze, "freeze after expiration")
+		expEvent := types.NewEventNameUnfrozen("example.name", true)
+		result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent)
+		s.Assert().True(result, "Expected typed event was not found: %v", expEvent)
+	})
+}
+
+func (s *MsgServerTestSuite) TestUnfreezeName() {
+	authority := s.app.NameKeeper.GetAuthority()
+	s.Require().NoError(s.app.NameKeeper.SetNameFreeze(s.ctx, types.NewNameFreeze("example.name", 1_000, "test")), "SetNameFreeze")
+
+	tests := []struct {
+		name          string
+		msg           *types.MsgUnfreezeNameRequest
+		expErr        string
+		expectedEvent proto.Message
+	}{
+		{
+			name:   "signer is not the authority",
+			msg:    types.NewMsgUnfreezeNameRequest(s.owner1, "example.name"),
+			expErr: fmt.Sprintf("expected %q got %q: expected gov account as only signer for proposal message", authority, s.owner1),
+		},
+		{
+			name:   "name is not frozen",
+			msg:    types.NewMsgUnfreezeNameRequest(authority, "name"),
+			expErr: `name "name" is not frozen: invalid request`,
+		},
+		{
+			name:          "unfrozen by authority",
+			msg:           types.NewMsgUnfreezeNameRequest(authority, "example.name"),
+			expectedEvent: types.NewEventNameUnfrozen("example.name", false),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.UnfreezeName(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "UnfreezeName error")
+				return
+			}
+			s.Require().NoError(err, "UnfreezeName error")
+			s.Assert().NoError(s.app.NameKeeper.ValidateNameNotFrozen(s.ctx, "example.name"), "ValidateNameNotFrozen")
+			result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+			s.Assert().True(result, "Expected typed event was not found: %v", tc.expectedEvent)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestBindNames() {
+	s.Run("bind a namespace", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgBindNamesRequest(s.owner1, []types.NameRecord{
+			types.NewNameRecord("Product.example.name", s.owner1Addr, true),
+			types.NewNameRecord("kyc.product.example.name", s.owner2Addr, false),
+		})
+		resp, err := s.msgServer.BindNames(s.ctx, msg)
+		s.Require().NoError(err, "BindNames")
+		expResults := []types.BindNamesResult{
+			{Record: types.NewNameRecord("product.example.name", s.owner1Addr, true)},
+			{Record: types.NewNameRecord("kyc.product.example.name", s.owner2Addr, false)},
+		}
+		s.Assert().Equal(expResults, resp.Results, "BindNames results")
+		s.Assert().True(s.app.NameKeeper.ResolvesTo(s.ctx, "product.example.name", s.owner1Addr), "ResolvesTo product.example.name")
+		s.Assert().True(s.app.NameKeeper.ResolvesTo(s.ctx, "kyc.product.example.name", s.owner2Addr), "ResolvesTo kyc.product.example.name")
+		expEvent := types.NewEventNameBound(s.owner2, "kyc.product.example.name", false)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+	})
+
+	s.Run("one failure binds nothing", func() {
+		msg := types.NewMsgBindNamesRequest(s.owner2, []types.NameRecord{
+			types.NewNameRecord("other.example.name", s.owner2Addr, false),
+			types.NewNameRecord("aml.product.example.name", s.owner2Addr, false),
+		})
+		_, err := s.msgServer.BindNames(s.ctx, msg)
+		s.Require().EqualError(err, `could not bind records[1] "aml.product.example.name": `+
+			`parent name "product.example.name" is restricted and does not resolve to the provided parent address: invalid request`, "BindNames")
+		s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, "other.example.name"), "NameExists other.example.name")
+	})
+
+	s.Run("binding fee is reported", func() {
+		fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+		s.Require().NoError(s.app.NameKeeper.SetBindingFee(s.ctx, types.NewNameBindingFee("example.name", fee)), "SetBindingFee")
+		s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, fee), "FundAccount")
+		msg := types.NewMsgBindNamesRequest(s.owner2, []types.NameRecord{
+			types.NewNameRecord("paid.example.name", s.owner2Addr, false),
+			types.NewNameRecord("free.paid.example.name", s.owner2Addr, false),
+		})
+		resp, err := s.msgServer.BindNames(s.ctx, msg)
+		s.Require().NoError(err, "BindNames")
+		expResults := []types.BindNamesResult{
+			{Record: types.NewNameRecord("paid.example.name", s.owner2Addr, false), BindingFee: fee},
+			{Record: types.NewNameRecord("free.paid.example.name", s.owner2Addr, false)},
+		}
+		s.Assert().Equal(expResults, resp.Results, "BindNames results")
+		s.Assert().Equal("0nhash", s.app.BankKeeper.GetBalance(s.ctx, s.owner2Addr, "nhash").String(), "payer balance")
+	})
+}
+
+func (s *MsgServerTestSuite) TestDeleteNames() {
+	for _, name := range []string{"product.example.name", "kyc.product.example.name", "other.example.name"} {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.owner1Addr, false), "SetNameRecord(%q)", name)
+	}
+
+	s.Run("one failure deletes nothing", func() {
+		msg := types.NewMsgDeleteNamesRequest(s.owner1, []string{"other.example.name", "missing.example.name"})
+		_, err := s.msgServer.DeleteNames(s.ctx, msg)
+		s.Require().EqualError(err, `could not delete names[1] "missing.example.name": `+types.ErrNameNotBound.Error()+": invalid request", "DeleteNames")
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "other.example.name"), "NameExists other.example.name")
+	})
+
+	s.Run("not the owner", func() {
+		msg := types.NewMsgDeleteNamesRequest(s.owner2, []string{"other.example.name"})
+		_, err := s.msgServer.DeleteNames(s.ctx, msg)
+		s.Require().EqualError(err, `could not delete names[0] "other.example.name": msg sender cannot delete name: unauthorized`, "DeleteNames")
+		s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "other.example.name"), "NameExists other.example.name")
+	})
+
+	s.Run("delete several names", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgDeleteNamesRequest(s.owner1, []string{"kyc.product.example.name", "product.example.name", "other.example.name"})
+		resp, err := s.msgServer.DeleteNames(s.ctx, msg)
+		s.Require().NoError(err, "DeleteNames")
+		expRecords := []types.NameRecord{
+			types.NewNameRecord("kyc.product.example.name", s.owner1Addr, false),
+			types.NewNameRecord("product.example.name", s.owner1Addr, false),
+			types.NewNameRecord("other.example.name", s.owner1Addr, false),
+		}
+		s.Assert().Equal(expRecords, resp.Records, "DeleteNames records")
+		for _, name := range msg.Names {
+			s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, name), "NameExists(%q)", name)
+		}
+		expEvent := types.NewEventNameUnbound(s.owner1, "product.example.name", false)
+		s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "Expected typed event was not found: %v", expEvent)
+	})
+}
@@ -44,3 +44,8 @@ func (k Keeper) GetMinSegmentLength(ctx sdk.Context) uint32 {
 func (k Keeper) GetAllowUnrestrictedNames(ctx sdk.Context) bool {
 	return k.GetParams(ctx).AllowUnrestrictedNames
 }
+
+// GetEnforceDenomNameLinkage returns whether marker denoms under restricted names require the creator to own the name.
+func (k Keeper) GetEnforceDenomNameLinkage(ctx sdk.Context) bool {
+	return k.GetParams(ctx).EnforceDenomNameLinkage
+}
@@ -71,3 +71,46 @@ func (k Keeper) ReverseLookup(c context.Context, request *types.QueryReverseLook
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// BindingFee gets the fee to bind a name under a parent name.
+func (k Keeper) BindingFee(c context.Context, request *types.QueryBindingFeeRequest) (*types.QueryBindingFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	bindingFee, err := k.GetBindingFee(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryBindingFeeResponse{BindingFee: bindingFee}, nil
+}
+
+// NameFreezes gets all the name freezes that are currently in effect.
+func (k Keeper) NameFreezes(c context.Context, request *types.QueryNameFreezesRequest) (*types.QueryNameFreezesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var pageReq *query.PageRequest
+	if request != nil {
+		pageReq = request.Pagination
+	}
+	resp := &types.QueryNameFreezesResponse{}
+	freezeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.NameFreezeKeyPrefix)
+	pageRes, err := query.FilteredPaginate(freezeStore, pageReq, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var freeze types.NameFreeze
+		if err := k.cdc.Unmarshal(value, &freeze); err != nil {
+			return false, err
+		}
+		if freeze.IsExpired(ctx.BlockHeight()) {
+			return false, nil
+		}
+		if accumulate {
+			resp.Freezes = append(resp.Freezes, freeze)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
@@ -29,6 +29,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &nameB)
 
 			return fmt.Sprintf("Addr: A:[%v], B:[%v]\n", nameA, nameB)
+		case bytes.HasPrefix(kvA.Key, types.BindingFeeKeyPrefix):
+			var feeA, feeB types.NameBindingFee
+
+			cdc.MustUnmarshal(kvA.Value, &feeA)
+			cdc.MustUnmarshal(kvB.Value, &feeB)
+
+			return fmt.Sprintf("BindingFee: A:[%v], B:[%v]\n", feeA, feeB)
+		case bytes.HasPrefix(kvA.Key, types.NameFreezeKeyPrefix):
+			var freezeA, freezeB types.NameFreeze
+
+			cdc.MustUnmarshal(kvA.Value, &freezeA)
+			cdc.MustUnmarshal(kvB.Value, &freezeB)
+
+			return fmt.Sprintf("NameFreeze: A:[%v], B:[%v]\n", freezeA, freezeB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -19,11 +19,15 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	testNameRecord := types.NewNameRecord("test", sdk.AccAddress{}, true)
+	testBindingFee := types.NewNameBindingFee("test", sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)))
+	testNameFreeze := types.NewNameFreeze("test", 100, "investigation")
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.NameKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
 			{Key: types.AddressKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
+			{Key: types.BindingFeeKeyPrefix, Value: cdc.MustMarshal(&testBindingFee)},
+			{Key: types.NameFreezeKeyPrefix, Value: cdc.MustMarshal(&testNameFreeze)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +38,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Name Record", fmt.Sprintf("Name: A:[%v], B:[%v]\n", testNameRecord, testNameRecord)},
 		{"Address Cache", fmt.Sprintf("Addr: A:[%v], B:[%v]\n", testNameRecord, testNameRecord)},
+		{"Binding Fee", fmt.Sprintf("BindingFee: A:[%v], B:[%v]\n", testBindingFee, testBindingFee)},
+		{"Name Freeze", fmt.Sprintf("NameFreeze: A:[%v], B:[%v]\n", testNameFreeze, testNameFreeze)},
 		{"other", ""},
 	}
 
@@ -22,4 +22,6 @@ var (
 	ErrInvalidAddress = cerrs.Register(ModuleName, 8, "invalid account address")
 	// ErrNameContainsSegments indicates a multi-segment name in a single segment context.
 	ErrNameContainsSegments = cerrs.Register(ModuleName, 9, "invalid name: \".\" is reserved")
+	// ErrNameFrozen occurs when a change is requested to a name in a subtree that has been frozen by governance.
+	ErrNameFrozen = cerrs.Register(ModuleName, 10, "name is frozen")
 )
@@ -1,6 +1,10 @@
 package types
 
-import "strconv"
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// EventTypeNameBound is the type of event generated when a name is bound to an address.
@@ -39,11 +43,50 @@ func NewEventNameUpdate(address string, name string, restricted bool) *EventName
 }
 
 // NewEventNameParamsUpdated returns a new instance of EventNameParamsUpdated
-func NewEventNameParamsUpdated(allowUnrestrictedNames bool, maxNameLevels, minSegmentLength, maxSegmentLength uint32) *EventNameParamsUpdated {
+func NewEventNameParamsUpdated(allowUnrestrictedNames bool, maxNameLevels, minSegmentLength, maxSegmentLength uint32, enforceDenomNameLinkage bool, bindingFeeCommunityPoolBips uint32) *EventNameParamsUpdated {
 	return &EventNameParamsUpdated{
-		AllowUnrestrictedNames: strconv.FormatBool(allowUnrestrictedNames),
-		MaxNameLevels:          strconv.FormatUint(uint64(maxNameLevels), 10),
-		MinSegmentLength:       strconv.FormatUint(uint64(minSegmentLength), 10),
-		MaxSegmentLength:       strconv.FormatUint(uint64(maxSegmentLength), 10),
+		AllowUnrestrictedNames:      strconv.FormatBool(allowUnrestrictedNames),
+		MaxNameLevels:               strconv.FormatUint(uint64(maxNameLevels), 10),
+		MinSegmentLength:            strconv.FormatUint(uint64(minSegmentLength), 10),
+		MaxSegmentLength:            strconv.FormatUint(uint64(maxSegmentLength), 10),
+		EnforceDenomNameLinkage:     strconv.FormatBool(enforceDenomNameLinkage),
+		BindingFeeCommunityPoolBips: strconv.FormatUint(uint64(bindingFeeCommunityPoolBips), 10),
+	}
+}
+
+// NewEventNameBindingFeeSet returns a new instance of EventNameBindingFeeSet
+func NewEventNameBindingFeeSet(name string, fee sdk.Coins, administrator string) *EventNameBindingFeeSet {
+	return &EventNameBindingFeeSet{
+		Name:          name,
+		Fee:           fee.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventNameBindingFeePaid returns a new instance of EventNameBindingFeePaid
+func NewEventNameBindingFeePaid(name, payer, owner string, ownerAmount, communityPoolAmount sdk.Coins) *EventNameBindingFeePaid {
+	return &EventNameBindingFeePaid{
+		Name:                name,
+		Payer:               payer,
+		Owner:               owner,
+		OwnerAmount:         ownerAmount.String(),
+		CommunityPoolAmount: communityPoolAmount.String(),
+	}
+}
+
+// NewEventNameFrozen returns a new instance of EventNameFrozen
+func NewEventNameFrozen(freeze NameFreeze) *EventNameFrozen {
+	return &EventNameFrozen{
+		Name:             freeze.Name,
+		ExpirationHeight: strconv.FormatInt(freeze.ExpirationHeight, 10),
+		Reason:           freeze.Reason,
+	}
+}
+
+// NewEventNameUnfrozen returns a new instance of EventNameUnfrozen
+func NewEventNameUnfrozen(name string, expired bool) *EventNameUnfrozen {
+	return &EventNameUnfrozen{
+		Name:    name,
+		Expired: expired,
 	}
 }
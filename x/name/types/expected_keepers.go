@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,3 +17,13 @@ type AttributeKeeper interface {
 	PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddress) error
 	AccountsByAttribute(ctx sdk.Context, name string) (addresses []sdk.AccAddress, err error)
 }
+
+// BankKeeper defines the expected bank keeper interface (noalias)
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the expected distribution keeper interface (noalias)
+type DistrKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -42,6 +42,19 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 
 // Validate ensures a genesis state is valid.
 func (state GenesisState) Validate() error {
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
+	for _, fee := range state.BindingFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, freeze := range state.Freezes {
+		if err := freeze.Validate(); err != nil {
+			return err
+		}
+	}
 	for _, record := range state.Bindings {
 		if strings.TrimSpace(record.Name) == "" {
 			return fmt.Errorf("name cannot be empty")
@@ -29,6 +29,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// bindings defines all the name records present at genesis
 	Bindings []NameRecord `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings"`
+	// binding_fees defines all the name binding fees present at genesis
+	BindingFees []NameBindingFee `protobuf:"bytes,3,rep,name=binding_fees,json=bindingFees,proto3" json:"binding_fees"`
+	// freezes defines all the name freezes present at genesis
+	Freezes []NameFreeze `protobuf:"bytes,4,rep,name=freezes,proto3" json:"freezes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,23 +75,27 @@ func init() {
 func init() { proto.RegisterFile("provenance/name/v1/genesis.proto", fileDescriptor_dba8546991615694) }
 
 var fileDescriptor_dba8546991615694 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4b, 0xcc, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0xa8,
-	0xd0, 0x03, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0xb2, 0x58, 0xcc, 0x02, 0xeb, 0x00, 0x4b, 0x2b, 0x4d, 0x61, 0xe4, 0xe2,
-	0x71, 0x87, 0x18, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc1, 0xc5, 0x56, 0x90, 0x58, 0x94,
-	0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0x69, 0x95, 0x5e, 0x00,
-	0x58, 0x85, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xf5, 0x42, 0x0e, 0x5c, 0x1c, 0x49,
-	0x99, 0x79, 0x29, 0x99, 0x79, 0xe9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x72, 0xd8,
-	0xf4, 0xfa, 0x25, 0xe6, 0xa6, 0x06, 0xa5, 0x26, 0xe7, 0x17, 0xa5, 0x40, 0xf5, 0xc3, 0x75, 0x59,
-	0x71, 0x74, 0x2c, 0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0xc1, 0x29, 0xf9, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xb8, 0x44, 0x33, 0xf3, 0xb1, 0x98, 0x1a, 0xc0, 0x18, 0x65, 0x90,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x50, 0xa0, 0x9b, 0x99, 0x8f,
-	0xc4, 0xd3, 0xaf, 0x80, 0x84, 0x41, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x08, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x53, 0xef, 0x56, 0x6f, 0x01, 0x00, 0x00,
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4f, 0x32, 0x31,
+	0x18, 0xc7, 0x5b, 0x20, 0xbc, 0xa4, 0x30, 0x35, 0xaf, 0xc9, 0x85, 0xc4, 0x42, 0x98, 0x58, 0x6c,
+	0x05, 0x17, 0xe3, 0x60, 0x0c, 0x03, 0x0e, 0x26, 0x86, 0xe0, 0xe6, 0x62, 0x7a, 0xc7, 0xc3, 0xd9,
+	0xe1, 0xda, 0xcb, 0xf5, 0x24, 0xea, 0x27, 0x70, 0xf4, 0x23, 0xe0, 0xb7, 0x61, 0x64, 0x74, 0x32,
+	0x06, 0x16, 0x3f, 0x86, 0xa1, 0x77, 0x82, 0x89, 0xa7, 0xdb, 0xd3, 0x3e, 0xbf, 0xff, 0xaf, 0x4d,
+	0xfe, 0xa4, 0x1d, 0x27, 0x66, 0x06, 0x5a, 0xea, 0x00, 0x84, 0x96, 0x11, 0x88, 0x59, 0x4f, 0x84,
+	0xa0, 0xc1, 0x2a, 0xcb, 0xe3, 0xc4, 0xa4, 0x86, 0xd2, 0x1d, 0xc1, 0x37, 0x04, 0x9f, 0xf5, 0x9a,
+	0xff, 0x43, 0x13, 0x1a, 0xb7, 0x16, 0x9b, 0x29, 0x23, 0x9b, 0xfb, 0x05, 0x2e, 0x97, 0x70, 0xeb,
+	0xce, 0x4b, 0x89, 0x34, 0xce, 0x33, 0xf5, 0x55, 0x2a, 0x53, 0xa0, 0xc7, 0xa4, 0x1a, 0xcb, 0x44,
+	0x46, 0xd6, 0xc3, 0x6d, 0xdc, 0xad, 0xf7, 0x9b, 0xfc, 0xe7, 0x53, 0x7c, 0xe4, 0x88, 0x41, 0x65,
+	0xf1, 0xd6, 0x42, 0xe3, 0x9c, 0xa7, 0x67, 0xa4, 0xe6, 0x2b, 0x3d, 0x51, 0x3a, 0xb4, 0x5e, 0xa9,
+	0x5d, 0xee, 0xd6, 0xfb, 0xac, 0x28, 0x7b, 0x29, 0x23, 0x18, 0x43, 0x60, 0x92, 0x49, 0x9e, 0xdf,
+	0xa6, 0xe8, 0x05, 0x69, 0xe4, 0xf3, 0xcd, 0x14, 0xc0, 0x7a, 0x65, 0x67, 0xe9, 0xfc, 0x66, 0x19,
+	0x64, 0xec, 0x10, 0x20, 0x37, 0xd5, 0xfd, 0xed, 0x8d, 0xa5, 0xa7, 0xe4, 0xdf, 0x34, 0x01, 0x78,
+	0x04, 0xeb, 0x55, 0xfe, 0xfe, 0xcd, 0xd0, 0x61, 0xb9, 0xe3, 0x2b, 0x74, 0x52, 0x7b, 0x9a, 0xb7,
+	0xd0, 0xc7, 0xbc, 0x85, 0x06, 0xc1, 0x62, 0xc5, 0xf0, 0x72, 0xc5, 0xf0, 0xfb, 0x8a, 0xe1, 0xe7,
+	0x35, 0x43, 0xcb, 0x35, 0x43, 0xaf, 0x6b, 0x86, 0xc8, 0x9e, 0x32, 0x05, 0xd2, 0x11, 0xbe, 0x3e,
+	0x0c, 0x55, 0x7a, 0x7b, 0xe7, 0xf3, 0xc0, 0x44, 0x62, 0x07, 0x1c, 0x28, 0xf3, 0xed, 0x24, 0xee,
+	0xb3, 0x42, 0xd2, 0x87, 0x18, 0xac, 0x5f, 0x75, 0x7d, 0x1c, 0x7d, 0x0e, 0x00, 0xf2, 0xc9, 0x72,
+	0x47, 0xfc, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +118,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Freezes) > 0 {
+		for iNdEx := len(m.Freezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Freezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BindingFees) > 0 {
+		for iNdEx := len(m.BindingFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BindingFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Bindings) > 0 {
 		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -162,6 +198,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BindingFees) > 0 {
+		for _, e := range m.BindingFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Freezes) > 0 {
+		for _, e := range m.Freezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +315,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingFees = append(m.BindingFees, NameBindingFee{})
+			if err := m.BindingFees[len(m.BindingFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freezes = append(m.Freezes, NameFreeze{})
+			if err := m.Freezes[len(m.Freezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -27,6 +27,10 @@ var (
 	AddressKeyPrefix = []byte{0x05}
 	// NameParamStoreKey key for marker module's params
 	NameParamStoreKey = []byte{0x06}
+	// BindingFeeKeyPrefix is a prefix added to keys for the fees to bind names under a parent name.
+	BindingFeeKeyPrefix = []byte{0x07}
+	// NameFreezeKeyPrefix is a prefix added to keys for the governance freezes placed on names.
+	NameFreezeKeyPrefix = []byte{0x08}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -35,6 +39,16 @@ func GetNameKeyPrefix(name string) (key []byte, err error) {
 	return getNamePrefixByType(name, key)
 }
 
+// GetBindingFeeKey converts a parent name into the key format for its binding fee.
+func GetBindingFeeKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, BindingFeeKeyPrefix)
+}
+
+// GetNameFreezeKey converts a name into the key format for its freeze.
+func GetNameFreezeKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, NameFreezeKeyPrefix)
+}
+
 // internal common code for legacy and current way.
 func getNamePrefixByType(name string, key []byte) ([]byte, error) {
 	var err error
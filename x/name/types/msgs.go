@@ -15,8 +15,16 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgModifyNameRequest)(nil),
 	(*MsgCreateRootNameRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetBindingFeeRequest)(nil),
+	(*MsgFreezeNameRequest)(nil),
+	(*MsgUnfreezeNameRequest)(nil),
+	(*MsgBindNamesRequest)(nil),
+	(*MsgDeleteNamesRequest)(nil),
 }
 
+// MaxBulkNames is the maximum number of names that can be bound or deleted in a single bulk request.
+const MaxBulkNames = 100
+
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
 	return &MsgBindNameRequest{
 		Parent: parent,
@@ -108,6 +116,8 @@ func NewMsgUpdateParamsRequest(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	enforceDenomNameLinkage bool,
+	bindingFeeCommunityPoolBips uint32,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -117,11 +127,135 @@ func NewMsgUpdateParamsRequest(
 			minSegmentLength,
 			maxNameLevels,
 			allowUnrestrictedNames,
+			enforceDenomNameLinkage,
+			bindingFeeCommunityPoolBips,
 		),
 	}
 }
 
 func (msg MsgUpdateParamsRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Authority)
-	return err
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Params.Validate()
+}
+
+func NewMsgSetBindingFeeRequest(name string, fee sdk.Coins, signer string) *MsgSetBindingFeeRequest {
+	return &MsgSetBindingFeeRequest{
+		Name:   name,
+		Fee:    fee,
+		Signer: signer,
+	}
+}
+
+func (msg MsgSetBindingFeeRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if err := msg.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid fee: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	return nil
+}
+
+func NewMsgFreezeNameRequest(authority string, name string, expirationHeight int64, reason string) *MsgFreezeNameRequest {
+	return &MsgFreezeNameRequest{
+		Authority:        authority,
+		Name:             name,
+		ExpirationHeight: expirationHeight,
+		Reason:           reason,
+	}
+}
+
+func (msg MsgFreezeNameRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return NewNameFreeze(msg.Name, msg.ExpirationHeight, msg.Reason).Validate()
+}
+
+func NewMsgUnfreezeNameRequest(authority string, name string) *MsgUnfreezeNameRequest {
+	return &MsgUnfreezeNameRequest{
+		Authority: authority,
+		Name:      name,
+	}
+}
+
+func (msg MsgUnfreezeNameRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	return nil
+}
+
+func NewMsgBindNamesRequest(signer string, records []NameRecord) *MsgBindNamesRequest {
+	return &MsgBindNamesRequest{
+		Signer:  signer,
+		Records: records,
+	}
+}
+
+func (msg MsgBindNamesRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if len(msg.Records) == 0 {
+		return fmt.Errorf("records cannot be empty")
+	}
+	if len(msg.Records) > MaxBulkNames {
+		return fmt.Errorf("too many records: %d exceeds max of %d", len(msg.Records), MaxBulkNames)
+	}
+	seen := make(map[string]bool, len(msg.Records))
+	for i, record := range msg.Records {
+		label, parent := SplitName(record.Name)
+		if len(parent) == 0 {
+			return fmt.Errorf("invalid records[%d]: name %q does not have a parent", i, record.Name)
+		}
+		if err := NewMsgBindNameRequest(NameRecord{Name: label, Address: record.Address}, NameRecord{Name: parent, Address: msg.Signer}).ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid records[%d]: %w", i, err)
+		}
+		name := NormalizeName(record.Name)
+		if seen[name] {
+			return fmt.Errorf("invalid records[%d]: duplicate name %q", i, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+func NewMsgDeleteNamesRequest(signer string, names []string) *MsgDeleteNamesRequest {
+	return &MsgDeleteNamesRequest{
+		Signer: signer,
+		Names:  names,
+	}
+}
+
+func (msg MsgDeleteNamesRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return fmt.Errorf("invalid signer: %w", err)
+	}
+	if len(msg.Names) == 0 {
+		return fmt.Errorf("names cannot be empty")
+	}
+	if len(msg.Names) > MaxBulkNames {
+		return fmt.Errorf("too many names: %d exceeds max of %d", len(msg.Names), MaxBulkNames)
+	}
+	seen := make(map[string]bool, len(msg.Names))
+	for i, name := range msg.Names {
+		name = NormalizeName(name)
+		if len(name) == 0 {
+			return fmt.Errorf("invalid names[%d]: name cannot be empty", i)
+		}
+		if seen[name] {
+			return fmt.Errorf("invalid names[%d]: duplicate name %q", i, name)
+		}
+		seen[name] = true
+	}
+	return nil
 }
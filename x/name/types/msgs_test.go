@@ -1,10 +1,13 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil"
@@ -19,6 +22,11 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgModifyNameRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgCreateRootNameRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetBindingFeeRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgFreezeNameRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUnfreezeNameRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgBindNamesRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgDeleteNamesRequest{Signer: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -107,7 +115,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		msg := NewMsgUpdateParamsRequest(tc.maxSegmentLength, tc.minSegmentLength, tc.maxNameLevels, tc.allowUnrestrictedNames, tc.authority)
+		msg := NewMsgUpdateParamsRequest(tc.maxSegmentLength, tc.minSegmentLength, tc.maxNameLevels, tc.allowUnrestrictedNames, false, 0, tc.authority)
 		err := msg.ValidateBasic()
 		if tc.shouldFail {
 			require.EqualError(t, err, tc.expectedErr, "expected error for case: %s", tc.name)
@@ -116,3 +124,258 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetBindingFeeRequestValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("input111111111111111").String()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+
+	testCases := []struct {
+		name   string
+		msg    *MsgSetBindingFeeRequest
+		expErr string
+	}{
+		{
+			name: "valid request",
+			msg:  NewMsgSetBindingFeeRequest("example.name", fee, signer),
+		},
+		{
+			name: "valid request without fee",
+			msg:  NewMsgSetBindingFeeRequest("example.name", nil, signer),
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgSetBindingFeeRequest(" ", fee, signer),
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "invalid fee",
+			msg:    &MsgSetBindingFeeRequest{Name: "example.name", Fee: sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}, Signer: signer},
+			expErr: "invalid fee: coin -1nhash amount is not positive",
+		},
+		{
+			name:   "invalid signer",
+			msg:    NewMsgSetBindingFeeRequest("example.name", fee, "blah"),
+			expErr: "invalid signer: decoding bech32 failed: invalid bech32 string length 4",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgFreezeNameRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+
+	testCases := []struct {
+		name   string
+		msg    *MsgFreezeNameRequest
+		expErr string
+	}{
+		{
+			name: "valid request",
+			msg:  NewMsgFreezeNameRequest(authority, "example.name", 100, "investigation"),
+		},
+		{
+			name: "valid request without reason",
+			msg:  NewMsgFreezeNameRequest(authority, "example.name", 100, ""),
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgFreezeNameRequest("blah", "example.name", 100, ""),
+			expErr: "invalid authority: decoding bech32 failed: invalid bech32 string length 4",
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgFreezeNameRequest(authority, " ", 100, ""),
+			expErr: "name cannot be empty",
+		},
+		{
+			name:   "zero expiration height",
+			msg:    NewMsgFreezeNameRequest(authority, "example.name", 0, ""),
+			expErr: "invalid expiration height 0 for \"example.name\" freeze: must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgUnfreezeNameRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+
+	testCases := []struct {
+		name   string
+		msg    *MsgUnfreezeNameRequest
+		expErr string
+	}{
+		{
+			name: "valid request",
+			msg:  NewMsgUnfreezeNameRequest(authority, "example.name"),
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgUnfreezeNameRequest("", "example.name"),
+			expErr: "invalid authority: empty address string is not allowed",
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgUnfreezeNameRequest(authority, ""),
+			expErr: "name cannot be empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgBindNamesRequestValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("input111111111111111").String()
+	addr := sdk.AccAddress("addr1111111111111111")
+	record := func(name string) NameRecord {
+		return NewNameRecord(name, addr, true)
+	}
+	tooMany := make([]NameRecord, MaxBulkNames+1)
+	for i := range tooMany {
+		tooMany[i] = record(fmt.Sprintf("name%d.example", i))
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *MsgBindNamesRequest
+		expErr string
+	}{
+		{
+			name: "valid request",
+			msg:  NewMsgBindNamesRequest(signer, []NameRecord{record("product.example"), record("kyc.product.example")}),
+		},
+		{
+			name: "max records",
+			msg:  NewMsgBindNamesRequest(signer, tooMany[:MaxBulkNames]),
+		},
+		{
+			name:   "invalid signer",
+			msg:    NewMsgBindNamesRequest("", []NameRecord{record("product.example")}),
+			expErr: "invalid signer: empty address string is not allowed",
+		},
+		{
+			name:   "no records",
+			msg:    NewMsgBindNamesRequest(signer, nil),
+			expErr: "records cannot be empty",
+		},
+		{
+			name:   "too many records",
+			msg:    NewMsgBindNamesRequest(signer, tooMany),
+			expErr: fmt.Sprintf("too many records: %d exceeds max of %d", MaxBulkNames+1, MaxBulkNames),
+		},
+		{
+			name:   "root name",
+			msg:    NewMsgBindNamesRequest(signer, []NameRecord{record("product.example"), record("example")}),
+			expErr: "invalid records[1]: name \"example\" does not have a parent",
+		},
+		{
+			name:   "empty segment",
+			msg:    NewMsgBindNamesRequest(signer, []NameRecord{record(" .example")}),
+			expErr: "invalid records[0]: name cannot be empty",
+		},
+		{
+			name:   "empty address",
+			msg:    NewMsgBindNamesRequest(signer, []NameRecord{{Name: "product.example"}}),
+			expErr: "invalid records[0]: address cannot be empty",
+		},
+		{
+			name:   "duplicate name",
+			msg:    NewMsgBindNamesRequest(signer, []NameRecord{record("product.example"), record("Product.Example")}),
+			expErr: "invalid records[1]: duplicate name \"product.example\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgDeleteNamesRequestValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("input111111111111111").String()
+	tooMany := make([]string, MaxBulkNames+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("name%d.example", i)
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *MsgDeleteNamesRequest
+		expErr string
+	}{
+		{
+			name: "valid request",
+			msg:  NewMsgDeleteNamesRequest(signer, []string{"kyc.product.example", "product.example"}),
+		},
+		{
+			name:   "invalid signer",
+			msg:    NewMsgDeleteNamesRequest("blah", []string{"product.example"}),
+			expErr: "invalid signer: decoding bech32 failed: invalid bech32 string length 4",
+		},
+		{
+			name:   "no names",
+			msg:    NewMsgDeleteNamesRequest(signer, nil),
+			expErr: "names cannot be empty",
+		},
+		{
+			name:   "too many names",
+			msg:    NewMsgDeleteNamesRequest(signer, tooMany),
+			expErr: fmt.Sprintf("too many names: %d exceeds max of %d", MaxBulkNames+1, MaxBulkNames),
+		},
+		{
+			name:   "empty name",
+			msg:    NewMsgDeleteNamesRequest(signer, []string{"product.example", " "}),
+			expErr: "invalid names[1]: name cannot be empty",
+		},
+		{
+			name:   "duplicate name",
+			msg:    NewMsgDeleteNamesRequest(signer, []string{"product.example", " PRODUCT.example"}),
+			expErr: "invalid names[1]: duplicate name \"product.example\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
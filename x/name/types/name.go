@@ -38,6 +38,50 @@ func (nr NameRecord) Validate() error {
 	return nil
 }
 
+// NewNameBindingFee creates the fee to bind a name under a parent name.
+func NewNameBindingFee(name string, fee sdk.Coins) NameBindingFee {
+	return NameBindingFee{
+		Name: name,
+		Fee:  fee,
+	}
+}
+
+// Validate performs basic stateless validity checks.
+func (f NameBindingFee) Validate() error {
+	if strings.TrimSpace(f.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if err := f.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid binding fee for %q: %w", f.Name, err)
+	}
+	return nil
+}
+
+// NewNameFreeze creates a freeze on a name that ends at the provided block height.
+func NewNameFreeze(name string, expirationHeight int64, reason string) NameFreeze {
+	return NameFreeze{
+		Name:             name,
+		ExpirationHeight: expirationHeight,
+		Reason:           reason,
+	}
+}
+
+// Validate performs basic stateless validity checks.
+func (f NameFreeze) Validate() error {
+	if strings.TrimSpace(f.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if f.ExpirationHeight <= 0 {
+		return fmt.Errorf("invalid expiration height %d for %q freeze: must be positive", f.ExpirationHeight, f.Name)
+	}
+	return nil
+}
+
+// IsExpired returns true if this freeze is no longer in effect at the provided block height.
+func (f NameFreeze) IsExpired(height int64) bool {
+	return height >= f.ExpirationHeight
+}
+
 // NormalizeName lower-cases and strips out spaces around each segment in the provided string.
 func NormalizeName(name string) string {
 	nameSegments := strings.Split(name, ".")
@@ -48,6 +92,13 @@ func NormalizeName(name string) string {
 	return strings.Join(segments, ".")
 }
 
+// SplitName splits the provided name into its first segment and its parent (the rest of the name).
+// The parent is empty if the name does not have one.
+func SplitName(name string) (segment string, parent string) {
+	segment, parent, _ = strings.Cut(name, ".")
+	return strings.TrimSpace(segment), strings.TrimSpace(parent)
+}
+
 // IsValidName returns true if the provided name is valid (without consideration of length limits).
 // It is assumed that the name provided has already been normalized using NormalizeName.
 func IsValidName(name string) bool {
@@ -6,7 +6,10 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -35,6 +38,12 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// determines if creating a marker whose denom is (or is a sub-name of) a restricted name requires
+	// the creator to own that name. Example: only the owner of `acme.io` can create a `shares.acme.io` marker.
+	EnforceDenomNameLinkage bool `protobuf:"varint,5,opt,name=enforce_denom_name_linkage,json=enforceDenomNameLinkage,proto3" json:"enforce_denom_name_linkage,omitempty"`
+	// the portion (in basis points) of each name binding fee that is sent to the community pool.
+	// The rest of the fee goes to the owner of the parent name.
+	BindingFeeCommunityPoolBips uint32 `protobuf:"varint,6,opt,name=binding_fee_community_pool_bips,json=bindingFeeCommunityPoolBips,proto3" json:"binding_fee_community_pool_bips,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -98,6 +107,20 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetEnforceDenomNameLinkage() bool {
+	if m != nil {
+		return m.EnforceDenomNameLinkage
+	}
+	return false
+}
+
+func (m *Params) GetBindingFeeCommunityPoolBips() uint32 {
+	if m != nil {
+		return m.BindingFeeCommunityPoolBips
+	}
+	return 0
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// the bound name
@@ -161,6 +184,127 @@ func (m *NameRecord) GetRestricted() bool {
 	return false
 }
 
+// NameBindingFee is the fee that must be paid to bind a name directly under a parent name.
+type NameBindingFee struct {
+	// the parent name that the fee applies to
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the fee to bind a name under the parent name
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *NameBindingFee) Reset()         { *m = NameBindingFee{} }
+func (m *NameBindingFee) String() string { return proto.CompactTextString(m) }
+func (*NameBindingFee) ProtoMessage()    {}
+func (*NameBindingFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{2}
+}
+func (m *NameBindingFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameBindingFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameBindingFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameBindingFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameBindingFee.Merge(m, src)
+}
+func (m *NameBindingFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameBindingFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameBindingFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameBindingFee proto.InternalMessageInfo
+
+func (m *NameBindingFee) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameBindingFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// NameFreeze is an emergency freeze placed on a name and all of the names under it by governance.
+// While a freeze is in effect, names in the subtree cannot be bound, modified, or deleted, and
+// attributes under them cannot be written. A freeze ends automatically at its expiration height.
+type NameFreeze struct {
+	// the name at the top of the frozen subtree
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the block height at which the freeze ends
+	ExpirationHeight int64 `protobuf:"varint,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// the reason for the freeze
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *NameFreeze) Reset()         { *m = NameFreeze{} }
+func (m *NameFreeze) String() string { return proto.CompactTextString(m) }
+func (*NameFreeze) ProtoMessage()    {}
+func (*NameFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{3}
+}
+func (m *NameFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameFreeze.Merge(m, src)
+}
+func (m *NameFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameFreeze proto.InternalMessageInfo
+
+func (m *NameFreeze) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameFreeze) GetExpirationHeight() int64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *NameFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // CreateRootNameProposal details a proposal to create a new root name
 // that is controlled by a given owner and optionally restricted to the owner
 // for the sole creation of sub names.
@@ -184,7 +328,7 @@ type CreateRootNameProposal struct {
 func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
 func (*CreateRootNameProposal) ProtoMessage() {}
 func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+	return fileDescriptor_a314256905bb00ec, []int{4}
 }
 func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +368,7 @@ func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
 func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
 func (*EventNameBound) ProtoMessage()    {}
 func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+	return fileDescriptor_a314256905bb00ec, []int{5}
 }
 func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +429,7 @@ func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
 func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
 func (*EventNameUnbound) ProtoMessage()    {}
 func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+	return fileDescriptor_a314256905bb00ec, []int{6}
 }
 func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +490,7 @@ func (m *EventNameUpdate) Reset()         { *m = EventNameUpdate{} }
 func (m *EventNameUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventNameUpdate) ProtoMessage()    {}
 func (*EventNameUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{5}
+	return fileDescriptor_a314256905bb00ec, []int{7}
 }
 func (m *EventNameUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,17 +542,19 @@ func (m *EventNameUpdate) GetRestricted() bool {
 
 // EventNameParamsUpdated event emitted when name params are updated.
 type EventNameParamsUpdated struct {
-	AllowUnrestrictedNames string `protobuf:"bytes,1,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
-	MaxNameLevels          string `protobuf:"bytes,2,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
-	MinSegmentLength       string `protobuf:"bytes,3,opt,name=min_segment_length,json=minSegmentLength,proto3" json:"min_segment_length,omitempty"`
-	MaxSegmentLength       string `protobuf:"bytes,4,opt,name=max_segment_length,json=maxSegmentLength,proto3" json:"max_segment_length,omitempty"`
+	AllowUnrestrictedNames      string `protobuf:"bytes,1,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	MaxNameLevels               string `protobuf:"bytes,2,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
+	MinSegmentLength            string `protobuf:"bytes,3,opt,name=min_segment_length,json=minSegmentLength,proto3" json:"min_segment_length,omitempty"`
+	MaxSegmentLength            string `protobuf:"bytes,4,opt,name=max_segment_length,json=maxSegmentLength,proto3" json:"max_segment_length,omitempty"`
+	EnforceDenomNameLinkage     string `protobuf:"bytes,5,opt,name=enforce_denom_name_linkage,json=enforceDenomNameLinkage,proto3" json:"enforce_denom_name_linkage,omitempty"`
+	BindingFeeCommunityPoolBips string `protobuf:"bytes,6,opt,name=binding_fee_community_pool_bips,json=bindingFeeCommunityPoolBips,proto3" json:"binding_fee_community_pool_bips,omitempty"`
 }
 
 func (m *EventNameParamsUpdated) Reset()         { *m = EventNameParamsUpdated{} }
 func (m *EventNameParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventNameParamsUpdated) ProtoMessage()    {}
 func (*EventNameParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{6}
+	return fileDescriptor_a314256905bb00ec, []int{8}
 }
 func (m *EventNameParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,56 +611,364 @@ func (m *EventNameParamsUpdated) GetMaxSegmentLength() string {
 	return ""
 }
 
+func (m *EventNameParamsUpdated) GetEnforceDenomNameLinkage() string {
+	if m != nil {
+		return m.EnforceDenomNameLinkage
+	}
+	return ""
+}
+
+func (m *EventNameParamsUpdated) GetBindingFeeCommunityPoolBips() string {
+	if m != nil {
+		return m.BindingFeeCommunityPoolBips
+	}
+	return ""
+}
+
+// EventNameBindingFeeSet is emitted when the fee to bind names under a parent name is set.
+type EventNameBindingFeeSet struct {
+	// the parent name that the fee applies to
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the new fee, empty if it was removed
+	Fee string `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// the address that set the fee
+	Administrator string `protobuf:"bytes,3,opt,name=administrator,proto3" json:"administrator,omitempty"`
+}
+
+func (m *EventNameBindingFeeSet) Reset()         { *m = EventNameBindingFeeSet{} }
+func (m *EventNameBindingFeeSet) String() string { return proto.CompactTextString(m) }
+func (*EventNameBindingFeeSet) ProtoMessage()    {}
+func (*EventNameBindingFeeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
+}
+func (m *EventNameBindingFeeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameBindingFeeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameBindingFeeSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameBindingFeeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameBindingFeeSet.Merge(m, src)
+}
+func (m *EventNameBindingFeeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameBindingFeeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameBindingFeeSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameBindingFeeSet proto.InternalMessageInfo
+
+func (m *EventNameBindingFeeSet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameBindingFeeSet) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *EventNameBindingFeeSet) GetAdministrator() string {
+	if m != nil {
+		return m.Administrator
+	}
+	return ""
+}
+
+// EventNameBindingFeePaid is emitted when a binding fee is paid to bind a name under a parent name.
+type EventNameBindingFeePaid struct {
+	// the name that was bound
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the address that paid the fee
+	Payer string `protobuf:"bytes,2,opt,name=payer,proto3" json:"payer,omitempty"`
+	// the owner of the parent name
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the part of the fee that went to the owner of the parent name
+	OwnerAmount string `protobuf:"bytes,4,opt,name=owner_amount,json=ownerAmount,proto3" json:"owner_amount,omitempty"`
+	// the part of the fee that went to the community pool
+	CommunityPoolAmount string `protobuf:"bytes,5,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount,omitempty"`
+}
+
+func (m *EventNameBindingFeePaid) Reset()         { *m = EventNameBindingFeePaid{} }
+func (m *EventNameBindingFeePaid) String() string { return proto.CompactTextString(m) }
+func (*EventNameBindingFeePaid) ProtoMessage()    {}
+func (*EventNameBindingFeePaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventNameBindingFeePaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameBindingFeePaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameBindingFeePaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameBindingFeePaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameBindingFeePaid.Merge(m, src)
+}
+func (m *EventNameBindingFeePaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameBindingFeePaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameBindingFeePaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameBindingFeePaid proto.InternalMessageInfo
+
+func (m *EventNameBindingFeePaid) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameBindingFeePaid) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *EventNameBindingFeePaid) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventNameBindingFeePaid) GetOwnerAmount() string {
+	if m != nil {
+		return m.OwnerAmount
+	}
+	return ""
+}
+
+func (m *EventNameBindingFeePaid) GetCommunityPoolAmount() string {
+	if m != nil {
+		return m.CommunityPoolAmount
+	}
+	return ""
+}
+
+// EventNameFrozen is emitted when a name subtree is frozen.
+type EventNameFrozen struct {
+	// the name at the top of the frozen subtree
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the block height at which the freeze ends
+	ExpirationHeight string `protobuf:"bytes,2,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// the reason for the freeze
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventNameFrozen) Reset()         { *m = EventNameFrozen{} }
+func (m *EventNameFrozen) String() string { return proto.CompactTextString(m) }
+func (*EventNameFrozen) ProtoMessage()    {}
+func (*EventNameFrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{11}
+}
+func (m *EventNameFrozen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameFrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameFrozen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameFrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameFrozen.Merge(m, src)
+}
+func (m *EventNameFrozen) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameFrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameFrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameFrozen proto.InternalMessageInfo
+
+func (m *EventNameFrozen) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameFrozen) GetExpirationHeight() string {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return ""
+}
+
+func (m *EventNameFrozen) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventNameUnfrozen is emitted when a name subtree freeze is removed by governance or expires.
+type EventNameUnfrozen struct {
+	// the name at the top of the previously frozen subtree
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// whether the freeze ended because it expired
+	Expired bool `protobuf:"varint,2,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *EventNameUnfrozen) Reset()         { *m = EventNameUnfrozen{} }
+func (m *EventNameUnfrozen) String() string { return proto.CompactTextString(m) }
+func (*EventNameUnfrozen) ProtoMessage()    {}
+func (*EventNameUnfrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{12}
+}
+func (m *EventNameUnfrozen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameUnfrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameUnfrozen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameUnfrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameUnfrozen.Merge(m, src)
+}
+func (m *EventNameUnfrozen) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameUnfrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameUnfrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameUnfrozen proto.InternalMessageInfo
+
+func (m *EventNameUnfrozen) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameUnfrozen) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
 	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*NameBindingFee)(nil), "provenance.name.v1.NameBindingFee")
+	proto.RegisterType((*NameFreeze)(nil), "provenance.name.v1.NameFreeze")
 	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
 	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
 	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
 	proto.RegisterType((*EventNameUpdate)(nil), "provenance.name.v1.EventNameUpdate")
 	proto.RegisterType((*EventNameParamsUpdated)(nil), "provenance.name.v1.EventNameParamsUpdated")
+	proto.RegisterType((*EventNameBindingFeeSet)(nil), "provenance.name.v1.EventNameBindingFeeSet")
+	proto.RegisterType((*EventNameBindingFeePaid)(nil), "provenance.name.v1.EventNameBindingFeePaid")
+	proto.RegisterType((*EventNameFrozen)(nil), "provenance.name.v1.EventNameFrozen")
+	proto.RegisterType((*EventNameUnfrozen)(nil), "provenance.name.v1.EventNameUnfrozen")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
 
 var fileDescriptor_a314256905bb00ec = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3d, 0x6f, 0x13, 0x4d,
-	0x10, 0xc7, 0x6f, 0x13, 0x3b, 0x4f, 0xbc, 0x8f, 0x92, 0x58, 0x2b, 0x63, 0x8e, 0x48, 0x9c, 0x2d,
-	0x17, 0x28, 0x42, 0xc4, 0x47, 0xa0, 0x41, 0x74, 0x18, 0xd1, 0x45, 0xc8, 0xba, 0x28, 0x0d, 0x05,
-	0xc7, 0xfa, 0x6e, 0x75, 0x59, 0xe9, 0x76, 0xf7, 0xb4, 0xbb, 0x76, 0x4c, 0x4b, 0x81, 0x28, 0x29,
-	0x29, 0x5d, 0x53, 0x51, 0xf0, 0x19, 0x10, 0x65, 0x44, 0x81, 0x28, 0x91, 0x5d, 0xc0, 0xc7, 0x40,
-	0xb7, 0xeb, 0x97, 0xe3, 0x62, 0xa0, 0x81, 0xea, 0x76, 0x66, 0xfe, 0x73, 0xf3, 0xdb, 0xd1, 0xce,
-	0xc0, 0xeb, 0x99, 0x14, 0x23, 0xc2, 0x31, 0x8f, 0x88, 0xcf, 0x31, 0x23, 0xfe, 0xe8, 0xc8, 0x7c,
-	0xbb, 0x99, 0x14, 0x5a, 0x20, 0xb4, 0x0a, 0x77, 0x8d, 0x7b, 0x74, 0xb4, 0x7f, 0x35, 0x12, 0x8a,
-	0x09, 0xe5, 0x33, 0x95, 0xe4, 0x6a, 0xa6, 0x12, 0x2b, 0xde, 0xbf, 0x66, 0x03, 0xa1, 0xb1, 0x7c,
-	0x6b, 0xcc, 0x43, 0x8d, 0x44, 0x24, 0xc2, 0xfa, 0xf3, 0x93, 0xf5, 0x76, 0x3e, 0x00, 0xb8, 0xd5,
-	0xc7, 0x12, 0x33, 0x85, 0x6e, 0x41, 0xc4, 0xf0, 0x38, 0x54, 0x24, 0x61, 0x84, 0xeb, 0x30, 0x25,
-	0x3c, 0xd1, 0x67, 0x2e, 0x68, 0x83, 0x83, 0x9d, 0xa0, 0xce, 0xf0, 0xf8, 0xc4, 0x06, 0x8e, 0x8d,
-	0xdf, 0xa8, 0x29, 0x2f, 0xab, 0x37, 0xe6, 0x6a, 0xca, 0x7f, 0x56, 0xdf, 0x80, 0x7b, 0xf9, 0xbf,
-	0x73, 0xfe, 0x30, 0x25, 0x23, 0x92, 0x2a, 0x77, 0xd3, 0x48, 0x77, 0x18, 0x1e, 0x3f, 0xc6, 0x8c,
-	0x1c, 0x1b, 0x27, 0xba, 0x07, 0x5d, 0x9c, 0xa6, 0xe2, 0x3c, 0x1c, 0x72, 0x49, 0x94, 0x96, 0x34,
-	0xd2, 0x24, 0x36, 0x69, 0xca, 0xad, 0xb4, 0xc1, 0xc1, 0x76, 0xd0, 0x34, 0xf1, 0xd3, 0x42, 0x38,
-	0x4f, 0x57, 0x9d, 0x97, 0x00, 0xc2, 0xfc, 0x14, 0x90, 0x48, 0xc8, 0x18, 0x21, 0x58, 0xc9, 0xb3,
-	0x0c, 0x7e, 0x2d, 0x30, 0x67, 0x74, 0x07, 0xfe, 0x87, 0xe3, 0x58, 0x12, 0xa5, 0x0c, 0x67, 0xad,
-	0xe7, 0x7e, 0x7a, 0x7f, 0xd8, 0x98, 0x37, 0xe9, 0x81, 0x8d, 0x9c, 0x68, 0x49, 0x79, 0x12, 0x2c,
-	0x84, 0xc8, 0x83, 0x70, 0x55, 0xc9, 0x30, 0x6f, 0x07, 0x05, 0xcf, 0xfd, 0xfa, 0x9b, 0x49, 0xcb,
-	0x79, 0xf1, 0xed, 0xdd, 0xcd, 0x45, 0x46, 0xe7, 0x2d, 0x80, 0xcd, 0x87, 0x92, 0x60, 0x4d, 0x02,
-	0x21, 0x74, 0x8e, 0xd4, 0x97, 0x22, 0x13, 0x0a, 0xa7, 0xa8, 0x01, 0xab, 0x9a, 0xea, 0x74, 0x41,
-	0x65, 0x0d, 0xd4, 0x86, 0xff, 0xc7, 0x44, 0x45, 0x92, 0x66, 0x9a, 0x0a, 0x6e, 0xd1, 0x82, 0xa2,
-	0x6b, 0x79, 0x99, 0xcd, 0xc2, 0x65, 0x1a, 0xb0, 0x2a, 0xce, 0x39, 0x91, 0xa6, 0x2d, 0xb5, 0xc0,
-	0x1a, 0x25, 0xdc, 0xea, 0x25, 0xdc, 0xdd, 0x57, 0x93, 0x96, 0x93, 0x23, 0x7f, 0x9f, 0xb4, 0x1c,
-	0x17, 0x74, 0x9e, 0xc2, 0xdd, 0x47, 0x23, 0xc2, 0x0d, 0x66, 0x4f, 0x0c, 0x79, 0x8c, 0xdc, 0x55,
-	0x93, 0x2c, 0xe5, 0xb2, 0x15, 0x0b, 0x8a, 0x8d, 0x02, 0xc5, 0x1f, 0xda, 0xd3, 0x79, 0x06, 0xeb,
-	0xcb, 0xff, 0x9f, 0xf2, 0xc1, 0x3f, 0xa8, 0x10, 0xc2, 0xbd, 0x55, 0x85, 0x2c, 0xc6, 0x9a, 0xfc,
-	0xe5, 0x02, 0x9f, 0x01, 0x6c, 0x2e, 0x2b, 0xd8, 0x51, 0xb1, 0x75, 0xe2, 0xdf, 0xbe, 0x56, 0x5b,
-	0xf9, 0x17, 0xaf, 0x75, 0xdd, 0x3c, 0x58, 0xa6, 0xd2, 0x3c, 0xac, 0x9f, 0x32, 0xfb, 0x0e, 0x2e,
-	0x4f, 0xd9, 0xfa, 0x09, 0xae, 0xcc, 0xd5, 0xa5, 0x09, 0xee, 0x45, 0x1f, 0xa7, 0x1e, 0xb8, 0x98,
-	0x7a, 0xe0, 0xeb, 0xd4, 0x03, 0xaf, 0x67, 0x9e, 0x73, 0x31, 0xf3, 0x9c, 0x2f, 0x33, 0xcf, 0x81,
-	0x57, 0xa8, 0x59, 0x0f, 0xa5, 0xad, 0xd3, 0x07, 0x4f, 0x6e, 0x27, 0x54, 0x9f, 0x0d, 0x07, 0xdd,
-	0x48, 0x30, 0x7f, 0x25, 0x38, 0xa4, 0xa2, 0x60, 0xf9, 0x63, 0xbb, 0xc5, 0xf4, 0xf3, 0x8c, 0xa8,
-	0xc1, 0x96, 0x59, 0x33, 0x77, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa1, 0x20, 0x2f, 0xe5,
-	0x04, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x71, 0x1a, 0x4f, 0x9a, 0xd4, 0x59, 0xdc, 0x64, 0x1b, 0x84, 0x1d, 0x56, 0x08,
+	0x45, 0x81, 0xd8, 0x24, 0x5c, 0x10, 0x9c, 0xe2, 0x94, 0x88, 0x43, 0x85, 0xac, 0x8d, 0x7a, 0xe1,
+	0xc0, 0x32, 0xde, 0x7d, 0xd9, 0x0c, 0xdd, 0x9d, 0x59, 0xcd, 0x8c, 0x5d, 0xa7, 0x47, 0x0e, 0x88,
+	0x23, 0x47, 0xe0, 0x94, 0x23, 0xea, 0x29, 0x07, 0xfe, 0x88, 0x72, 0xab, 0x38, 0xc1, 0x05, 0x50,
+	0x72, 0x08, 0x7f, 0x06, 0x9a, 0x1f, 0xb6, 0xb7, 0x8e, 0xdb, 0xaa, 0x82, 0x5e, 0xec, 0x7d, 0xef,
+	0x7d, 0x33, 0xdf, 0xb7, 0x6f, 0xde, 0x7c, 0x5a, 0xf4, 0x56, 0xce, 0xd9, 0x00, 0x28, 0xa6, 0x11,
+	0xb4, 0x29, 0xce, 0xa0, 0x3d, 0xd8, 0xd5, 0xff, 0xad, 0x9c, 0x33, 0xc9, 0x5c, 0x77, 0x52, 0x6e,
+	0xe9, 0xf4, 0x60, 0x77, 0x63, 0x15, 0x67, 0x84, 0xb2, 0xb6, 0xfe, 0x35, 0xb0, 0x8d, 0x46, 0xc4,
+	0x44, 0xc6, 0x44, 0xbb, 0x87, 0x85, 0xda, 0xa1, 0x07, 0x12, 0xef, 0xb6, 0x23, 0x46, 0xa8, 0xad,
+	0xaf, 0xdb, 0x7a, 0x26, 0x12, 0x45, 0x90, 0x89, 0xc4, 0x16, 0xee, 0x98, 0x42, 0xa8, 0xa3, 0xb6,
+	0x09, 0x6c, 0xa9, 0x9e, 0xb0, 0x84, 0x99, 0xbc, 0x7a, 0x32, 0x59, 0xff, 0xd7, 0x39, 0xb4, 0xd0,
+	0xc5, 0x1c, 0x67, 0xc2, 0x7d, 0x1f, 0xb9, 0x19, 0x1e, 0x86, 0x02, 0x92, 0x0c, 0xa8, 0x0c, 0x53,
+	0xa0, 0x89, 0x3c, 0xf1, 0x9c, 0x4d, 0x67, 0x6b, 0x39, 0xa8, 0x65, 0x78, 0x78, 0x64, 0x0a, 0xf7,
+	0x74, 0x5e, 0xa3, 0x09, 0x9d, 0x46, 0xcf, 0x59, 0x34, 0xa1, 0xcf, 0xa2, 0xdf, 0x45, 0xb7, 0xd4,
+	0xde, 0xea, 0x95, 0xc3, 0x14, 0x06, 0x90, 0x0a, 0xaf, 0xac, 0xa1, 0xcb, 0x19, 0x1e, 0x7e, 0x8e,
+	0x33, 0xb8, 0xa7, 0x93, 0xee, 0x47, 0xc8, 0xc3, 0x69, 0xca, 0x1e, 0x86, 0x7d, 0xca, 0x41, 0x48,
+	0x4e, 0x22, 0x09, 0xb1, 0x5e, 0x26, 0xbc, 0xf9, 0x4d, 0x67, 0x6b, 0x31, 0x58, 0xd3, 0xf5, 0xfb,
+	0x85, 0xb2, 0x5a, 0x2e, 0xdc, 0x4f, 0xd0, 0x06, 0xd0, 0x63, 0xc6, 0x23, 0x08, 0x63, 0xa0, 0x2c,
+	0xb3, 0x5c, 0x84, 0x3e, 0xc0, 0x09, 0x78, 0x15, 0xbd, 0x76, 0xdd, 0x22, 0xee, 0x2a, 0x80, 0x66,
+	0x35, 0x65, 0xf7, 0x2e, 0x6a, 0xf6, 0x08, 0x8d, 0x09, 0x4d, 0xc2, 0x63, 0x80, 0x30, 0x62, 0x59,
+	0xd6, 0xa7, 0x44, 0x9e, 0x86, 0x39, 0x63, 0x69, 0xd8, 0x23, 0xb9, 0xf0, 0x16, 0xb4, 0xdc, 0x37,
+	0x2d, 0xec, 0x10, 0xe0, 0x60, 0x04, 0xea, 0x32, 0x96, 0x76, 0x48, 0x2e, 0xfc, 0x6f, 0x1d, 0x84,
+	0xd4, 0xae, 0x01, 0x44, 0x8c, 0xc7, 0xae, 0x8b, 0xe6, 0x95, 0x06, 0xdd, 0xc1, 0x6a, 0xa0, 0x9f,
+	0xdd, 0x3d, 0x74, 0x03, 0xc7, 0x31, 0x07, 0x21, 0x74, 0xab, 0xaa, 0x1d, 0xef, 0xb7, 0x5f, 0x76,
+	0xea, 0xf6, 0x9c, 0xf6, 0x4d, 0xe5, 0x48, 0x72, 0x42, 0x93, 0x60, 0x04, 0x74, 0x1b, 0x08, 0x4d,
+	0x5e, 0x56, 0xb7, 0x6d, 0x31, 0x28, 0x64, 0x3e, 0xae, 0xfd, 0x70, 0xd6, 0x2c, 0x7d, 0x73, 0x75,
+	0xbe, 0x3d, 0x5a, 0xe1, 0xff, 0xe8, 0xa0, 0x15, 0x25, 0xa4, 0x33, 0x16, 0x3b, 0x53, 0x8c, 0x40,
+	0xe5, 0x63, 0x00, 0x6f, 0x6e, 0xb3, 0xbc, 0xb5, 0xb4, 0x77, 0xa7, 0x65, 0x55, 0xa8, 0x99, 0x6b,
+	0xd9, 0x99, 0x6b, 0x1d, 0x30, 0x42, 0x3b, 0x87, 0x4f, 0xfe, 0x6c, 0x96, 0x1e, 0xff, 0xd5, 0xdc,
+	0x4a, 0x88, 0x3c, 0xe9, 0xf7, 0x5a, 0x11, 0xcb, 0xec, 0x68, 0xd9, 0xbf, 0x1d, 0x11, 0x3f, 0x68,
+	0xcb, 0xd3, 0x1c, 0x84, 0x5e, 0x20, 0x7e, 0xba, 0x3a, 0xdf, 0xbe, 0x99, 0x42, 0x82, 0xa3, 0xd3,
+	0x50, 0x4d, 0xad, 0xf8, 0xf9, 0xea, 0x7c, 0xdb, 0x09, 0x14, 0x9b, 0x0f, 0xa6, 0x47, 0x87, 0x1c,
+	0xe0, 0xd1, 0x6c, 0x59, 0xef, 0xa1, 0x55, 0x18, 0xe6, 0x84, 0x63, 0x49, 0x18, 0x0d, 0x4f, 0x80,
+	0x24, 0x27, 0x52, 0x77, 0xab, 0x1c, 0xd4, 0x26, 0x85, 0xcf, 0x74, 0xde, 0x5d, 0x43, 0x0b, 0x1c,
+	0xb0, 0x60, 0x54, 0x37, 0xa6, 0x1a, 0xd8, 0xc8, 0x7f, 0xec, 0xa0, 0xb5, 0x03, 0x0e, 0x58, 0x42,
+	0xc0, 0x98, 0x54, 0x8c, 0x5d, 0xce, 0x72, 0x26, 0x70, 0xea, 0xd6, 0x51, 0x45, 0x12, 0x99, 0x8e,
+	0x48, 0x4d, 0xe0, 0x6e, 0xa2, 0xa5, 0x18, 0x44, 0xc4, 0x49, 0xae, 0x76, 0x37, 0xa7, 0x13, 0x14,
+	0x53, 0x63, 0xad, 0xe5, 0x82, 0xd6, 0x3a, 0xaa, 0xb0, 0x87, 0x14, 0xb8, 0x1e, 0xce, 0x6a, 0x60,
+	0x82, 0xa9, 0x13, 0xab, 0x5c, 0x3b, 0xb1, 0x95, 0xef, 0xce, 0x9a, 0x25, 0x75, 0x6a, 0xff, 0x9c,
+	0x35, 0x4b, 0x9e, 0xe3, 0x7f, 0x89, 0x56, 0x3e, 0x1d, 0x00, 0xd5, 0x32, 0x3b, 0xac, 0x4f, 0x63,
+	0xd7, 0x9b, 0xcc, 0x89, 0x51, 0x39, 0x0a, 0xc7, 0x2a, 0xe6, 0x0a, 0x2a, 0x5e, 0x32, 0x21, 0xfe,
+	0x57, 0xa8, 0x36, 0xde, 0xff, 0x3e, 0xed, 0xbd, 0x06, 0x86, 0x10, 0xdd, 0x9a, 0x30, 0xe4, 0x31,
+	0x96, 0xf0, 0x3f, 0x13, 0xfc, 0x31, 0x87, 0xd6, 0xc6, 0x0c, 0xc6, 0xb0, 0x0c, 0x4f, 0xfc, 0x42,
+	0xcf, 0x30, 0xcc, 0xcf, 0xf3, 0x8c, 0x19, 0xae, 0x64, 0x34, 0x4d, 0xb9, 0xd2, 0x6c, 0xaf, 0x33,
+	0x73, 0x70, 0xdd, 0xeb, 0x66, 0xfb, 0xe8, 0xbc, 0x45, 0x4f, 0xfb, 0xe8, 0xcb, 0x7d, 0xab, 0xfa,
+	0x9f, 0x7d, 0xab, 0xfa, 0x62, 0xdf, 0x8a, 0x0b, 0xad, 0x9d, 0x58, 0xc6, 0x11, 0xc8, 0x99, 0xd7,
+	0xb3, 0x36, 0x72, 0x0d, 0x95, 0x52, 0x8f, 0xee, 0x3b, 0x68, 0x19, 0xc7, 0x19, 0xa1, 0x44, 0x48,
+	0x8e, 0x25, 0xe3, 0xb6, 0x33, 0xcf, 0x26, 0xfd, 0x73, 0x07, 0xad, 0xcf, 0xa0, 0xe9, 0x62, 0x32,
+	0xdb, 0x2a, 0xeb, 0xa8, 0x92, 0xe3, 0x53, 0xe0, 0x96, 0xc9, 0x04, 0x93, 0x0b, 0x57, 0x2e, 0x5e,
+	0xb8, 0xb7, 0xd1, 0x4d, 0xfd, 0x10, 0xe2, 0x8c, 0xf5, 0xa9, 0xb4, 0xcd, 0x5e, 0xd2, 0xb9, 0x7d,
+	0x9d, 0x72, 0xf7, 0xd0, 0xed, 0xa9, 0xf6, 0x58, 0xac, 0x69, 0xf1, 0x1b, 0x51, 0xb1, 0x2d, 0x66,
+	0x8d, 0xff, 0x75, 0x61, 0xaa, 0x0f, 0x39, 0x7b, 0x04, 0xf4, 0xd5, 0x0c, 0xab, 0xfa, 0x0a, 0x86,
+	0xb5, 0x8f, 0x56, 0x0b, 0x77, 0xf4, 0xf8, 0xf9, 0x6c, 0x1e, 0xba, 0xa1, 0x37, 0x85, 0x58, 0x73,
+	0x2c, 0x06, 0xa3, 0xb0, 0x13, 0x3d, 0xb9, 0x68, 0x38, 0x4f, 0x2f, 0x1a, 0xce, 0xdf, 0x17, 0x0d,
+	0xe7, 0xfb, 0xcb, 0x46, 0xe9, 0xe9, 0x65, 0xa3, 0xf4, 0xfb, 0x65, 0xa3, 0x84, 0x6e, 0x13, 0xd6,
+	0xba, 0xfe, 0xe5, 0xd1, 0x75, 0xbe, 0xf8, 0xa0, 0x60, 0xe9, 0x13, 0xc0, 0x0e, 0x61, 0x85, 0xa8,
+	0x3d, 0x34, 0x5f, 0x32, 0xda, 0xe0, 0x7b, 0x0b, 0xfa, 0xbb, 0xe1, 0xc3, 0x7f, 0x07, 0x00, 0x0e,
+	0xee, 0x18, 0x34, 0xe9, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -537,7 +991,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.AllowUnrestrictedNames {
+	if m.BindingFeeCommunityPoolBips != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.BindingFeeCommunityPoolBips))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnforceDenomNameLinkage {
+		i--
+		if m.EnforceDenomNameLinkage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AllowUnrestrictedNames {
 		i--
 		if m.AllowUnrestrictedNames {
 			dAtA[i] = 1
@@ -612,6 +1081,92 @@ func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NameBindingFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameBindingFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameBindingFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -834,6 +1389,20 @@ func (m *EventNameParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BindingFeeCommunityPoolBips) > 0 {
+		i -= len(m.BindingFeeCommunityPoolBips)
+		copy(dAtA[i:], m.BindingFeeCommunityPoolBips)
+		i = encodeVarintName(dAtA, i, uint64(len(m.BindingFeeCommunityPoolBips)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EnforceDenomNameLinkage) > 0 {
+		i -= len(m.EnforceDenomNameLinkage)
+		copy(dAtA[i:], m.EnforceDenomNameLinkage)
+		i = encodeVarintName(dAtA, i, uint64(len(m.EnforceDenomNameLinkage)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.MaxSegmentLength) > 0 {
 		i -= len(m.MaxSegmentLength)
 		copy(dAtA[i:], m.MaxSegmentLength)
@@ -865,6 +1434,192 @@ func (m *EventNameParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventNameBindingFeeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBindingFeeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBindingFeeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Administrator) > 0 {
+		i -= len(m.Administrator)
+		copy(dAtA[i:], m.Administrator)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Administrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBindingFeePaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameBindingFeePaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBindingFeePaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommunityPoolAmount) > 0 {
+		i -= len(m.CommunityPoolAmount)
+		copy(dAtA[i:], m.CommunityPoolAmount)
+		i = encodeVarintName(dAtA, i, uint64(len(m.CommunityPoolAmount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OwnerAmount) > 0 {
+		i -= len(m.OwnerAmount)
+		copy(dAtA[i:], m.OwnerAmount)
+		i = encodeVarintName(dAtA, i, uint64(len(m.OwnerAmount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameFrozen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameFrozen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameFrozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExpirationHeight) > 0 {
+		i -= len(m.ExpirationHeight)
+		copy(dAtA[i:], m.ExpirationHeight)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ExpirationHeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameUnfrozen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUnfrozen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUnfrozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintName(dAtA []byte, offset int, v uint64) int {
 	offset -= sovName(v)
 	base := offset
@@ -894,6 +1649,12 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnrestrictedNames {
 		n += 2
 	}
+	if m.EnforceDenomNameLinkage {
+		n += 2
+	}
+	if m.BindingFeeCommunityPoolBips != 0 {
+		n += 1 + sovName(uint64(m.BindingFeeCommunityPoolBips))
+	}
 	return n
 }
 
@@ -917,6 +1678,45 @@ func (m *NameRecord) Size() (n int) {
 	return n
 }
 
+func (m *NameBindingFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NameFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovName(uint64(m.ExpirationHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
 func (m *CreateRootNameProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1027,9 +1827,104 @@ func (m *EventNameParamsUpdated) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovName(uint64(l))
 	}
-	return n
-}
-
+	l = len(m.EnforceDenomNameLinkage)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.BindingFeeCommunityPoolBips)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameBindingFeeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Administrator)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameBindingFeePaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.OwnerAmount)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.CommunityPoolAmount)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameFrozen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ExpirationHeight)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameUnfrozen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
 func sovName(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1142,6 +2037,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceDenomNameLinkage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceDenomNameLinkage = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingFeeCommunityPoolBips", wireType)
+			}
+			m.BindingFeeCommunityPoolBips = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BindingFeeCommunityPoolBips |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1297,7 +2231,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *NameBindingFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,15 +2254,896 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: NameBindingFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NameBindingFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowUnrestrictedNames = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1356,11 +3171,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.MaxNameLevels = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1388,11 +3203,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.MinSegmentLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1420,11 +3235,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MaxSegmentLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceDenomNameLinkage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,13 +3267,13 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.EnforceDenomNameLinkage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingFeeCommunityPoolBips", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1468,12 +3283,24 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingFeeCommunityPoolBips = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1495,7 +3322,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventNameBindingFeeSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,15 +3345,15 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameBindingFeeSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameBindingFeeSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1554,11 +3381,11 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1586,13 +3413,13 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1602,12 +3429,24 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Administrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1629,7 +3468,7 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+func (m *EventNameBindingFeePaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1652,15 +3491,15 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameBindingFeePaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameBindingFeePaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1688,11 +3527,11 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1720,13 +3559,13 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Payer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1736,65 +3575,27 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipName(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthName
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowName
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1822,11 +3623,11 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.OwnerAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1854,28 +3655,8 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CommunityPoolAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1897,7 +3678,7 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventNameFrozen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,15 +3701,15 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameParamsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameFrozen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1956,11 +3737,11 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowUnrestrictedNames = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1988,11 +3769,11 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxNameLevels = string(dAtA[iNdEx:postIndex])
+			m.ExpirationHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2020,11 +3801,61 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinSegmentLength = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUnfrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUnfrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUnfrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2052,8 +3883,28 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSegmentLength = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1,10 +1,25 @@
 package types
 
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	DefaultMinSegmentLength       = uint32(2)
 	DefaultMaxSegmentLength       = uint32(32)
 	DefaultMaxNameLevels          = uint32(16)
 	DefaultAllowUnrestrictedNames = true
+	// DefaultEnforceDenomNameLinkage is whether marker denoms under restricted names require owning the name.
+	DefaultEnforceDenomNameLinkage = false
+	// DefaultBindingFeeCommunityPoolBips is the default portion (in basis points) of name binding fees sent to the community pool.
+	DefaultBindingFeeCommunityPoolBips = uint32(0)
+
+	// MaxBips is the number of basis points that make up a whole.
+	MaxBips = uint32(10_000)
 )
 
 // NewParams creates a new parameter object
@@ -13,12 +28,16 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	enforceDenomNameLinkage bool,
+	bindingFeeCommunityPoolBips uint32,
 ) Params {
 	return Params{
-		MaxSegmentLength:       maxSegmentLength,
-		MinSegmentLength:       minSegmentLength,
-		MaxNameLevels:          maxNameLevels,
-		AllowUnrestrictedNames: allowUnrestrictedNames,
+		MaxSegmentLength:            maxSegmentLength,
+		MinSegmentLength:            minSegmentLength,
+		MaxNameLevels:               maxNameLevels,
+		AllowUnrestrictedNames:      allowUnrestrictedNames,
+		EnforceDenomNameLinkage:     enforceDenomNameLinkage,
+		BindingFeeCommunityPoolBips: bindingFeeCommunityPoolBips,
 	}
 }
 
@@ -29,9 +48,33 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxNameLevels,
 		DefaultAllowUnrestrictedNames,
+		DefaultEnforceDenomNameLinkage,
+		DefaultBindingFeeCommunityPoolBips,
 	)
 }
 
+// Validate returns an error if any of the params are invalid.
+func (p Params) Validate() error {
+	if p.BindingFeeCommunityPoolBips > MaxBips {
+		return fmt.Errorf("binding fee community pool bips %d cannot be greater than %d", p.BindingFeeCommunityPoolBips, MaxBips)
+	}
+	return nil
+}
+
+// SplitBindingFee splits a name binding fee into the part that goes to the parent name's owner
+// and the part that goes to the community pool. The community pool's part is rounded down.
+func (p Params) SplitBindingFee(fee sdk.Coins) (owner, communityPool sdk.Coins) {
+	bips := sdkmath.NewIntFromUint64(uint64(p.BindingFeeCommunityPoolBips))
+	maxBips := sdkmath.NewIntFromUint64(uint64(MaxBips))
+	for _, coin := range fee {
+		amt := coin.Amount.Mul(bips).Quo(maxBips)
+		if amt.IsPositive() {
+			communityPool = communityPool.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return fee.Sub(communityPool...), communityPool
+}
+
 // Equal returns true if the given value is equivalent to the current instance of params
 func (p *Params) Equal(that interface{}) bool {
 	if that == nil {
@@ -64,6 +107,12 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if p.EnforceDenomNameLinkage != that1.EnforceDenomNameLinkage {
+		return false
+	}
+	if p.BindingFeeCommunityPoolBips != that1.BindingFeeCommunityPoolBips {
+		return false
+	}
 
 	return true
 }
@@ -3,7 +3,10 @@ package types
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestDefaultParams(t *testing.T) {
@@ -14,12 +17,16 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultMaxSegmentLength, p.MaxSegmentLength)
 	require.Equal(t, DefaultMaxNameLevels, p.MaxNameLevels)
 	require.Equal(t, DefaultAllowUnrestrictedNames, p.AllowUnrestrictedNames)
+	require.Equal(t, DefaultEnforceDenomNameLinkage, p.EnforceDenomNameLinkage)
+	require.Equal(t, DefaultBindingFeeCommunityPoolBips, p.BindingFeeCommunityPoolBips)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, false)))
+	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultEnforceDenomNameLinkage, DefaultBindingFeeCommunityPoolBips)))
+	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultEnforceDenomNameLinkage, DefaultBindingFeeCommunityPoolBips)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultEnforceDenomNameLinkage, DefaultBindingFeeCommunityPoolBips)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames, DefaultEnforceDenomNameLinkage, DefaultBindingFeeCommunityPoolBips)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, false, DefaultEnforceDenomNameLinkage, DefaultBindingFeeCommunityPoolBips)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, true, DefaultBindingFeeCommunityPoolBips)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxNameLevels, DefaultAllowUnrestrictedNames, DefaultEnforceDenomNameLinkage, 1)))
 
 	var p2 *Params
 	require.True(t, p2.Equal(nil))
@@ -30,3 +37,76 @@ func TestParamString(t *testing.T) {
 	p := DefaultParams()
 	require.Equal(t, `max_segment_length:32 min_segment_length:2 max_name_levels:16 allow_unrestricted_names:true `, p.String())
 }
+
+func TestParamsValidate(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate(), "default params")
+
+	p.BindingFeeCommunityPoolBips = MaxBips
+	require.NoError(t, p.Validate(), "max bips")
+
+	p.BindingFeeCommunityPoolBips = MaxBips + 1
+	require.EqualError(t, p.Validate(), "binding fee community pool bips 10001 cannot be greater than 10000", "too many bips")
+}
+
+func TestParamsSplitBindingFee(t *testing.T) {
+	coins := func(str string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(str)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", str)
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		bips     uint32
+		fee      sdk.Coins
+		expOwner sdk.Coins
+		expPool  sdk.Coins
+	}{
+		{
+			name:     "no fee",
+			bips:     500,
+			fee:      nil,
+			expOwner: nil,
+			expPool:  nil,
+		},
+		{
+			name:     "zero bips",
+			bips:     0,
+			fee:      coins("100nhash"),
+			expOwner: coins("100nhash"),
+			expPool:  nil,
+		},
+		{
+			name:     "all to community pool",
+			bips:     MaxBips,
+			fee:      coins("100nhash"),
+			expOwner: nil,
+			expPool:  coins("100nhash"),
+		},
+		{
+			name:     "even split",
+			bips:     5_000,
+			fee:      coins("100nhash"),
+			expOwner: coins("50nhash"),
+			expPool:  coins("50nhash"),
+		},
+		{
+			name:     "community pool part rounded down",
+			bips:     250,
+			fee:      coins("99nhash,39stake"),
+			expOwner: coins("97nhash,39stake"),
+			expPool:  coins("2nhash"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := DefaultParams()
+			p.BindingFeeCommunityPoolBips = tc.bips
+			owner, pool := p.SplitBindingFee(tc.fee)
+			assert.Equal(t, tc.expOwner.String(), owner.String(), "owner amount")
+			assert.Equal(t, tc.expPool.String(), pool.String(), "community pool amount")
+		})
+	}
+}
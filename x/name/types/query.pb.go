@@ -289,6 +289,199 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QueryBindingFeeRequest is the request type for the Query/BindingFee method.
+type QueryBindingFeeRequest struct {
+	// the parent name to get the binding fee of
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryBindingFeeRequest) Reset()         { *m = QueryBindingFeeRequest{} }
+func (m *QueryBindingFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBindingFeeRequest) ProtoMessage()    {}
+func (*QueryBindingFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QueryBindingFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBindingFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBindingFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBindingFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBindingFeeRequest.Merge(m, src)
+}
+func (m *QueryBindingFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBindingFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBindingFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBindingFeeRequest proto.InternalMessageInfo
+
+func (m *QueryBindingFeeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryBindingFeeResponse is the response type for the Query/BindingFee method.
+type QueryBindingFeeResponse struct {
+	// the fee to bind a name under the parent name, empty if there isn't one
+	BindingFee NameBindingFee `protobuf:"bytes,1,opt,name=binding_fee,json=bindingFee,proto3" json:"binding_fee"`
+}
+
+func (m *QueryBindingFeeResponse) Reset()         { *m = QueryBindingFeeResponse{} }
+func (m *QueryBindingFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBindingFeeResponse) ProtoMessage()    {}
+func (*QueryBindingFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QueryBindingFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBindingFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBindingFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBindingFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBindingFeeResponse.Merge(m, src)
+}
+func (m *QueryBindingFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBindingFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBindingFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBindingFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBindingFeeResponse) GetBindingFee() NameBindingFee {
+	if m != nil {
+		return m.BindingFee
+	}
+	return NameBindingFee{}
+}
+
+// QueryNameFreezesRequest is the request type for the Query/NameFreezes method.
+type QueryNameFreezesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameFreezesRequest) Reset()         { *m = QueryNameFreezesRequest{} }
+func (m *QueryNameFreezesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNameFreezesRequest) ProtoMessage()    {}
+func (*QueryNameFreezesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
+}
+func (m *QueryNameFreezesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameFreezesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameFreezesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameFreezesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameFreezesRequest.Merge(m, src)
+}
+func (m *QueryNameFreezesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameFreezesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameFreezesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNameFreezesRequest proto.InternalMessageInfo
+
+func (m *QueryNameFreezesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNameFreezesResponse is the response type for the Query/NameFreezes method.
+type QueryNameFreezesResponse struct {
+	// the name freezes currently in effect
+	Freezes []NameFreeze `protobuf:"bytes,1,rep,name=freezes,proto3" json:"freezes"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNameFreezesResponse) Reset()         { *m = QueryNameFreezesResponse{} }
+func (m *QueryNameFreezesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNameFreezesResponse) ProtoMessage()    {}
+func (*QueryNameFreezesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{9}
+}
+func (m *QueryNameFreezesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNameFreezesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNameFreezesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNameFreezesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNameFreezesResponse.Merge(m, src)
+}
+func (m *QueryNameFreezesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNameFreezesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNameFreezesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNameFreezesResponse proto.InternalMessageInfo
+
+func (m *QueryNameFreezesResponse) GetFreezes() []NameFreeze {
+	if m != nil {
+		return m.Freezes
+	}
+	return nil
+}
+
+func (m *QueryNameFreezesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -296,46 +489,59 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryBindingFeeRequest)(nil), "provenance.name.v1.QueryBindingFeeRequest")
+	proto.RegisterType((*QueryBindingFeeResponse)(nil), "provenance.name.v1.QueryBindingFeeResponse")
+	proto.RegisterType((*QueryNameFreezesRequest)(nil), "provenance.name.v1.QueryNameFreezesRequest")
+	proto.RegisterType((*QueryNameFreezesResponse)(nil), "provenance.name.v1.QueryNameFreezesResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x6d, 0x49, 0xcb, 0x55, 0x2c, 0x47, 0x90, 0x82, 0x55, 0x9c, 0xca, 0xaa, 0xd2,
-	0xa8, 0xa2, 0x77, 0x24, 0x5d, 0x10, 0x63, 0x07, 0x58, 0x90, 0x08, 0x1e, 0xd9, 0x2e, 0xce, 0xc9,
-	0x58, 0x24, 0x7e, 0x5d, 0x9f, 0x63, 0x51, 0x55, 0x59, 0x60, 0xa0, 0x23, 0x12, 0x2b, 0x43, 0x3f,
-	0x02, 0x1f, 0xa3, 0x63, 0x25, 0x16, 0x26, 0x84, 0x12, 0x06, 0x3e, 0x06, 0xf2, 0xdd, 0x45, 0x71,
-	0x94, 0x2b, 0x61, 0xbb, 0xbc, 0xff, 0x9e, 0xdf, 0xeb, 0xe7, 0x55, 0xb0, 0x97, 0x66, 0x50, 0x88,
-	0x84, 0x27, 0xa1, 0x60, 0x09, 0x1f, 0x09, 0x56, 0x74, 0xd8, 0xd9, 0x58, 0x64, 0xe7, 0x34, 0xcd,
-	0x20, 0x07, 0x42, 0x16, 0x79, 0x5a, 0xe6, 0x69, 0xd1, 0x71, 0x8f, 0x42, 0x90, 0x23, 0x90, 0xac,
-	0xcf, 0xa5, 0xd0, 0xc5, 0xac, 0xe8, 0xf4, 0x45, 0xce, 0x3b, 0x2c, 0xe5, 0x51, 0x9c, 0xf0, 0x3c,
-	0x86, 0x44, 0xf7, 0xbb, 0xf5, 0x08, 0x22, 0x50, 0x4f, 0x56, 0xbe, 0x4c, 0x74, 0x2f, 0x02, 0x88,
-	0x86, 0x82, 0xf1, 0x34, 0x66, 0x3c, 0x49, 0x20, 0x57, 0x2d, 0xd2, 0x64, 0x1f, 0x59, 0x98, 0x94,
-	0xb6, 0x4a, 0xfb, 0x75, 0x4c, 0x5e, 0x97, 0xa2, 0x3d, 0x9e, 0xf1, 0x91, 0x0c, 0xc4, 0xd9, 0x58,
-	0xc8, 0xdc, 0x7f, 0x85, 0xef, 0x2f, 0x45, 0x65, 0x0a, 0x89, 0x14, 0xe4, 0x29, 0xae, 0xa5, 0x2a,
-	0xd2, 0x40, 0xfb, 0xa8, 0xbd, 0xdb, 0x75, 0xe9, 0xea, 0x42, 0x54, 0xf7, 0x9c, 0x6e, 0x5d, 0xff,
-	0x6c, 0x3a, 0x81, 0xa9, 0xf7, 0x4f, 0xcc, 0xc0, 0x40, 0x48, 0x18, 0x16, 0xc2, 0xe8, 0x10, 0x82,
-	0xb7, 0xca, 0x36, 0x35, 0xee, 0x6e, 0xa0, 0xde, 0xcf, 0x76, 0x2e, 0xaf, 0x9a, 0xce, 0x9f, 0xab,
-	0xa6, 0xe3, 0xf7, 0x70, 0x7d, 0xb9, 0xc9, 0x60, 0x34, 0xf0, 0x36, 0x1f, 0x0c, 0x32, 0x21, 0xa5,
-	0x69, 0x9c, 0xff, 0x24, 0x1e, 0xc6, 0x99, 0x90, 0x79, 0x16, 0x87, 0xb9, 0x18, 0x34, 0x36, 0xf6,
-	0x51, 0x7b, 0x27, 0xa8, 0x44, 0xfc, 0x4f, 0x08, 0x3f, 0x34, 0x23, 0x0b, 0x91, 0x49, 0xf1, 0x12,
-	0xe0, 0xdd, 0x38, 0x9d, 0xd3, 0xdc, 0x3e, 0xf7, 0x39, 0xc6, 0x0b, 0x33, 0xd4, 0xdc, 0xdd, 0x6e,
-	0x8b, 0x6a, 0xe7, 0x68, 0xe9, 0x1c, 0xd5, 0x36, 0x1b, 0xe7, 0x68, 0x8f, 0x47, 0xf3, 0x1d, 0x83,
-	0x4a, 0x67, 0x65, 0xb7, 0x8f, 0x08, 0xbb, 0x36, 0x12, 0xb3, 0xe2, 0xe2, 0xc3, 0x6c, 0xce, 0x3f,
-	0x0c, 0x79, 0x61, 0x81, 0x38, 0x5c, 0x0b, 0xa1, 0x07, 0xda, 0x29, 0xba, 0xdf, 0x36, 0xf1, 0x1d,
-	0x45, 0x41, 0x26, 0xb8, 0xa6, 0x8d, 0x23, 0x2d, 0x9b, 0xa9, 0xab, 0x37, 0xe2, 0x1e, 0xae, 0xad,
-	0xd3, 0xd2, 0xbe, 0xff, 0xe1, 0xfb, 0xef, 0x2f, 0x1b, 0x7b, 0xc4, 0x65, 0x96, 0x53, 0xd4, 0xf7,
-	0x41, 0x2e, 0x11, 0xde, 0x36, 0x36, 0x93, 0xdb, 0x07, 0x2f, 0x5f, 0x8f, 0xdb, 0x5e, 0x5f, 0x68,
-	0x10, 0x8e, 0x14, 0xc2, 0x01, 0xf1, 0x6d, 0x08, 0x99, 0x2e, 0x66, 0x17, 0x65, 0x60, 0x42, 0xbe,
-	0x22, 0x7c, 0x6f, 0xc9, 0x14, 0x72, 0xfc, 0x0f, 0x9d, 0xd5, 0x33, 0x72, 0xe9, 0xff, 0x96, 0x1b,
-	0xb8, 0xc7, 0x0a, 0xae, 0x45, 0x0e, 0x6c, 0x70, 0x43, 0x55, 0xcb, 0x2e, 0xcc, 0x25, 0x4e, 0x4e,
-	0xc3, 0xeb, 0xa9, 0x87, 0x6e, 0xa6, 0x1e, 0xfa, 0x35, 0xf5, 0xd0, 0xe7, 0x99, 0xe7, 0xdc, 0xcc,
-	0x3c, 0xe7, 0xc7, 0xcc, 0x73, 0xf0, 0x83, 0x18, 0x2c, 0xca, 0x3d, 0xf4, 0xe6, 0x49, 0x14, 0xe7,
-	0x6f, 0xc7, 0x7d, 0x1a, 0xc2, 0xa8, 0x22, 0x71, 0x1c, 0x43, 0x55, 0xf0, 0xbd, 0x96, 0xcc, 0xcf,
-	0x53, 0x21, 0xfb, 0x35, 0xf5, 0xe7, 0x70, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x9d, 0xe6,
-	0x24, 0xd1, 0x04, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0xa5, 0xbf, 0x78, 0x11, 0xcb, 0x51, 0x20, 0x98, 0xd6, 0xad, 0x4c, 0x95, 0x46,
+	0xa5, 0xb5, 0x49, 0xba, 0x20, 0x06, 0x86, 0x0e, 0x45, 0x48, 0x08, 0x42, 0x46, 0x16, 0x74, 0x49,
+	0x5e, 0x8d, 0x45, 0xe3, 0x73, 0x7d, 0x4e, 0x44, 0xa9, 0xba, 0xc0, 0x40, 0x17, 0x24, 0x24, 0xc4,
+	0xc6, 0x50, 0x89, 0x7f, 0xa6, 0x63, 0x25, 0x96, 0x4e, 0x08, 0xb5, 0x0c, 0xfc, 0x19, 0xc8, 0x77,
+	0x67, 0xe2, 0x60, 0xbb, 0xad, 0x10, 0xdb, 0xe5, 0xdd, 0x7b, 0xef, 0xfb, 0x79, 0xf7, 0xde, 0x73,
+	0xc0, 0x0c, 0x42, 0x3e, 0x40, 0x9f, 0xf9, 0x1d, 0x74, 0x7c, 0xd6, 0x43, 0x67, 0x50, 0x77, 0xb6,
+	0xfb, 0x18, 0xee, 0xd8, 0x41, 0xc8, 0x23, 0x4e, 0xe9, 0xf0, 0xde, 0x8e, 0xef, 0xed, 0x41, 0xdd,
+	0x58, 0xee, 0x70, 0xd1, 0xe3, 0xc2, 0x69, 0x33, 0x81, 0xca, 0xd9, 0x19, 0xd4, 0xdb, 0x18, 0xb1,
+	0xba, 0x13, 0x30, 0xd7, 0xf3, 0x59, 0xe4, 0x71, 0x5f, 0xc5, 0x1b, 0x33, 0x2e, 0x77, 0xb9, 0x3c,
+	0x3a, 0xf1, 0x49, 0x5b, 0x67, 0x5d, 0xce, 0xdd, 0x2d, 0x74, 0x58, 0xe0, 0x39, 0xcc, 0xf7, 0x79,
+	0x24, 0x43, 0x84, 0xbe, 0x9d, 0xcb, 0x61, 0x92, 0xda, 0xf2, 0xda, 0x9a, 0x01, 0xfa, 0x2c, 0x16,
+	0x6d, 0xb2, 0x90, 0xf5, 0x44, 0x0b, 0xb7, 0xfb, 0x28, 0x22, 0xeb, 0x29, 0x5c, 0x1d, 0xb1, 0x8a,
+	0x80, 0xfb, 0x02, 0xe9, 0x3d, 0x98, 0x0c, 0xa4, 0xa5, 0x42, 0x16, 0x48, 0xad, 0xdc, 0x30, 0xec,
+	0x6c, 0x41, 0xb6, 0x8a, 0x59, 0x1f, 0x3f, 0xfc, 0x3e, 0x5f, 0x6a, 0x69, 0x7f, 0x6b, 0x4d, 0x27,
+	0x6c, 0xa1, 0xe0, 0x5b, 0x03, 0xd4, 0x3a, 0x94, 0xc2, 0x78, 0x1c, 0x26, 0xd3, 0x5d, 0x6e, 0xc9,
+	0xf3, 0xfd, 0xe9, 0xfd, 0x83, 0xf9, 0xd2, 0xaf, 0x83, 0xf9, 0x92, 0xd5, 0x84, 0x99, 0xd1, 0x20,
+	0x8d, 0x51, 0x81, 0x29, 0xd6, 0xed, 0x86, 0x28, 0x84, 0x0e, 0x4c, 0x7e, 0x52, 0x13, 0x20, 0x44,
+	0x11, 0x85, 0x5e, 0x27, 0xc2, 0x6e, 0x65, 0x6c, 0x81, 0xd4, 0xa6, 0x5b, 0x29, 0x8b, 0xf5, 0x9e,
+	0xc0, 0x4d, 0x9d, 0x72, 0x80, 0xa1, 0xc0, 0xc7, 0x9c, 0xbf, 0xea, 0x07, 0x09, 0x4d, 0x71, 0xde,
+	0x0d, 0x80, 0x61, 0x33, 0x64, 0xde, 0x72, 0xa3, 0x6a, 0xab, 0xce, 0xd9, 0x71, 0xe7, 0x6c, 0xd5,
+	0x66, 0xdd, 0x39, 0xbb, 0xc9, 0xdc, 0xa4, 0xc6, 0x56, 0x2a, 0x32, 0x55, 0xdb, 0x3b, 0x02, 0x46,
+	0x1e, 0x89, 0x2e, 0x71, 0xf8, 0x30, 0x97, 0x92, 0x87, 0xa1, 0x0f, 0x73, 0x20, 0x96, 0xce, 0x85,
+	0x50, 0x09, 0x0b, 0x28, 0x56, 0xe0, 0xba, 0x84, 0x58, 0xf7, 0xfc, 0xae, 0xe7, 0xbb, 0x1b, 0x78,
+	0x56, 0x67, 0xac, 0x2e, 0xdc, 0xc8, 0x78, 0x6b, 0xde, 0x47, 0x50, 0x6e, 0x2b, 0xeb, 0x8b, 0x4d,
+	0x44, 0x3d, 0x1e, 0x56, 0xde, 0x78, 0x3c, 0x61, 0x3d, 0x1c, 0x26, 0xd0, 0x63, 0x02, 0xed, 0x3f,
+	0x16, 0x8b, 0x69, 0x95, 0xd8, 0x71, 0x23, 0x44, 0x7c, 0x83, 0xc9, 0x58, 0xfe, 0xd5, 0x06, 0xf2,
+	0xaf, 0x6d, 0xb0, 0xbe, 0x12, 0xa8, 0x64, 0x35, 0x74, 0x29, 0x0f, 0x60, 0x6a, 0x53, 0x99, 0xe4,
+	0xeb, 0x97, 0x1b, 0x66, 0x51, 0x19, 0x2a, 0x52, 0x97, 0x90, 0x04, 0xfd, 0xb7, 0x36, 0x35, 0x8e,
+	0x27, 0x60, 0x42, 0x52, 0xd2, 0x3d, 0x98, 0x54, 0x5b, 0x45, 0xab, 0x79, 0x2c, 0xd9, 0x05, 0x36,
+	0x96, 0xce, 0xf5, 0x53, 0x82, 0x96, 0xf5, 0xf6, 0xdb, 0xcf, 0x4f, 0x63, 0xb3, 0xd4, 0x70, 0x72,
+	0xbe, 0x13, 0x6a, 0x79, 0xe9, 0x3e, 0x81, 0x29, 0xbd, 0x83, 0xb4, 0x38, 0xf1, 0xe8, 0x6a, 0x1b,
+	0xb5, 0xf3, 0x1d, 0x35, 0xc2, 0xb2, 0x44, 0x58, 0xa4, 0x56, 0x1e, 0x42, 0xa8, 0x9c, 0x9d, 0xdd,
+	0xd8, 0xb0, 0x47, 0xbf, 0x10, 0xb8, 0x32, 0xb2, 0x31, 0x74, 0xf5, 0x0c, 0x9d, 0xec, 0x8e, 0x1b,
+	0xf6, 0x45, 0xdd, 0x35, 0xdc, 0x8a, 0x84, 0xab, 0xd2, 0xc5, 0x3c, 0xb8, 0x2d, 0xe9, 0xeb, 0xec,
+	0xea, 0xcf, 0xc4, 0x1e, 0xfd, 0x4c, 0x00, 0x86, 0xc3, 0x4d, 0x97, 0x0b, 0xc5, 0x32, 0x0b, 0x67,
+	0xdc, 0xb9, 0x90, 0xaf, 0xa6, 0xb2, 0x25, 0x55, 0x8d, 0x56, 0xf3, 0xa8, 0x52, 0x8b, 0x98, 0x3c,
+	0xdb, 0x07, 0x02, 0xe5, 0xd4, 0xac, 0xd3, 0x62, 0xb1, 0xec, 0xd6, 0x19, 0x2b, 0x17, 0x73, 0xd6,
+	0x68, 0xb7, 0x25, 0xda, 0x1c, 0xbd, 0x95, 0x87, 0xa6, 0x77, 0x64, 0xbd, 0x73, 0x78, 0x62, 0x92,
+	0xa3, 0x13, 0x93, 0xfc, 0x38, 0x31, 0xc9, 0xc7, 0x53, 0xb3, 0x74, 0x74, 0x6a, 0x96, 0x8e, 0x4f,
+	0xcd, 0x12, 0x5c, 0xf3, 0x78, 0x8e, 0x5c, 0x93, 0x3c, 0xbf, 0xeb, 0x7a, 0xd1, 0xcb, 0x7e, 0xdb,
+	0xee, 0xf0, 0x5e, 0x2a, 0xf3, 0xaa, 0xc7, 0xd3, 0x3a, 0xaf, 0x95, 0x52, 0xb4, 0x13, 0xa0, 0x68,
+	0x4f, 0xca, 0x7f, 0xb8, 0xb5, 0xdf, 0x03, 0x00, 0xf3, 0x58, 0xf9, 0x81, 0x96, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -356,6 +562,10 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// BindingFee queries for the fee to bind a name under a given parent name
+	BindingFee(ctx context.Context, in *QueryBindingFeeRequest, opts ...grpc.CallOption) (*QueryBindingFeeResponse, error)
+	// NameFreezes queries for all of the name freezes currently in effect
+	NameFreezes(ctx context.Context, in *QueryNameFreezesRequest, opts ...grpc.CallOption) (*QueryNameFreezesResponse, error)
 }
 
 type queryClient struct {
@@ -393,6 +603,24 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) BindingFee(ctx context.Context, in *QueryBindingFeeRequest, opts ...grpc.CallOption) (*QueryBindingFeeResponse, error) {
+	out := new(QueryBindingFeeResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/BindingFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NameFreezes(ctx context.Context, in *QueryNameFreezesRequest, opts ...grpc.CallOption) (*QueryNameFreezesResponse, error) {
+	out := new(QueryNameFreezesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/NameFreezes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -401,6 +629,10 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// BindingFee queries for the fee to bind a name under a given parent name
+	BindingFee(context.Context, *QueryBindingFeeRequest) (*QueryBindingFeeResponse, error)
+	// NameFreezes queries for all of the name freezes currently in effect
+	NameFreezes(context.Context, *QueryNameFreezesRequest) (*QueryNameFreezesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -416,6 +648,12 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) BindingFee(ctx context.Context, req *QueryBindingFeeRequest) (*QueryBindingFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindingFee not implemented")
+}
+func (*UnimplementedQueryServer) NameFreezes(ctx context.Context, req *QueryNameFreezesRequest) (*QueryNameFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameFreezes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -475,6 +713,42 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BindingFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBindingFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BindingFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/BindingFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BindingFee(ctx, req.(*QueryBindingFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NameFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNameFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NameFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/NameFreezes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NameFreezes(ctx, req.(*QueryNameFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Query",
@@ -492,6 +766,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "BindingFee",
+			Handler:    _Query_BindingFee_Handler,
+		},
+		{
+			MethodName: "NameFreezes",
+			Handler:    _Query_NameFreezes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -709,93 +991,296 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBindingFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBindingFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryResolveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBindingFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Restricted {
-		n += 2
+func (m *QueryBindingFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReverseLookupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBindingFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReverseLookupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBindingFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.BindingFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameFreezesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameFreezesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameFreezesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNameFreezesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNameFreezesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNameFreezesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Freezes) > 0 {
+		for iNdEx := len(m.Freezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Freezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryReverseLookupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBindingFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBindingFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BindingFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNameFreezesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNameFreezesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Freezes) > 0 {
+		for _, e := range m.Freezes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	if m.Pagination != nil {
@@ -811,7 +1296,222 @@ func sovQuery(x uint64) (n int) {
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -834,12 +1534,64 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -861,7 +1613,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,15 +1636,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -919,7 +1703,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -944,7 +1731,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -967,10 +1754,10 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1003,7 +1790,43 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1026,7 +1849,7 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBindingFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1049,15 +1872,15 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBindingFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBindingFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1085,28 +1908,8 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1128,7 +1931,7 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBindingFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,17 +1954,17 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBindingFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBindingFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1171,25 +1974,76 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.BindingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNameFreezesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNameFreezesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNameFreezesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1246,7 +2100,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNameFreezesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1269,17 +2123,17 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNameFreezesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNameFreezesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freezes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1289,23 +2143,25 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			m.Freezes = append(m.Freezes, NameFreeze{})
+			if err := m.Freezes[len(m.Freezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -24,6 +24,12 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetQueryOracleAddressCmd(),
+		GetQueryOracleTopicsCmd(),
+		GetQueryTopicAnswerCmd(),
+		GetQueryTopicSubscriptionsCmd(),
+		GetQueryPriceFeedsCmd(),
+		GetQueryPriceCmd(),
+		GetQueryFeederViolationsCmd(),
 	)
 	return queryCmd
 }
@@ -59,3 +65,207 @@ func GetQueryOracleAddressCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetQueryOracleTopicsCmd queries for all of the oracle topics
+func GetQueryOracleTopicsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "topics",
+		Short:   "Returns all of the oracle topics",
+		Args:    cobra.ExactArgs(0),
+		Aliases: []string{"t"},
+		Example: fmt.Sprintf(`%[1]s q oracle topics`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OracleTopics(context.Background(), &types.QueryOracleTopicsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "topics")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryTopicAnswerCmd queries for the aggregated answer of a topic
+func GetQueryTopicAnswerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "topic-answer <topic>",
+		Short:   "Returns the aggregated answer of a topic along with its current answers",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"ta"},
+		Example: fmt.Sprintf(`%[1]s q oracle topic-answer HASH/USD`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TopicAnswer(context.Background(), &types.QueryTopicAnswerRequest{Topic: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryTopicSubscriptionsCmd queries for the modules subscribed to a topic
+func GetQueryTopicSubscriptionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "topic-subscriptions <topic>",
+		Short:   "Returns the modules subscribed to a topic",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"ts"},
+		Example: fmt.Sprintf(`%[1]s q oracle topic-subscriptions HASH/USD`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TopicSubscriptions(context.Background(), &types.QueryTopicSubscriptionsRequest{Topic: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "subscriptions")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryPriceFeedsCmd queries for all of the price feeds
+func GetQueryPriceFeedsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price-feeds",
+		Short:   "Returns all of the price feeds",
+		Args:    cobra.ExactArgs(0),
+		Aliases: []string{"pf"},
+		Example: fmt.Sprintf(`%[1]s q oracle price-feeds`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PriceFeeds(context.Background(), &types.QueryPriceFeedsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "price feeds")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryPriceCmd queries for the median price of a price feed
+func GetQueryPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "price <symbol>",
+		Short:   "Returns the median price of a price feed along with the latest price from each feeder",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"p"},
+		Example: fmt.Sprintf(`%[1]s q oracle price HASH/USD`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Price(context.Background(), &types.QueryPriceRequest{Symbol: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueryFeederViolationsCmd queries for the recent invalid prices from a price feed's feeders
+func GetQueryFeederViolationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "feeder-violations <symbol>",
+		Short:   "Returns the recent invalid prices from each of a price feed's feeders and whether they're suspended",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"fv"},
+		Example: fmt.Sprintf(`%[1]s q oracle feeder-violations HASH/USD`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeederViolations(context.Background(), &types.QueryFeederViolationsRequest{Symbol: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "feeder violations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -17,6 +18,9 @@ import (
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
 
+// FlagRemove is the flag for removing a topic subscription instead of adding or replacing it.
+const FlagRemove = "remove"
+
 // NewTxCmd is the top-level command for oracle CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -30,6 +34,12 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdSendQuery(),
 		GetCmdOracleUpdate(),
+		GetCmdTopicUpdate(),
+		GetCmdSendTopicQuery(),
+		GetCmdTopicSubscriptionUpdate(),
+		GetCmdPriceFeedUpdate(),
+		GetCmdSubmitPrice(),
+		GetCmdReinstateFeeder(),
 	)
 
 	return txCmd
@@ -99,3 +109,207 @@ func GetCmdSendQuery() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdTopicUpdate is a command to add or replace an oracle topic
+func GetCmdTopicUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-topic <topic-json>",
+		Short:   "Add or replace an oracle topic",
+		Long:    "Submit an update oracle topic via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"ut"},
+		Example: fmt.Sprintf(`%[1]s tx oracle update-topic '{"name":"HASH/USD","sources":[{"channel":"channel-1"},{"channel":"channel-2"}],"method":"AGGREGATION_METHOD_MEDIAN","max_deviation_bips":500,"min_answers":2}' --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var topic types.OracleTopic
+			if err = clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &topic); err != nil {
+				return fmt.Errorf("invalid topic json: %w", err)
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgUpdateOracleTopic(authority, topic)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSendTopicQuery is a command to send a query to each of a topic's oracles
+func GetCmdSendTopicQuery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-topic-query <topic> <json>",
+		Short:   "Send a query to each of a topic's oracles via IBC",
+		Long:    "Submit a topic query via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"stq"},
+		Example: fmt.Sprintf(`%[1]s tx oracle send-topic-query HASH/USD '{"price":{"symbol":"HASH"}}' --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryData := []byte(args[1])
+			if !json.Valid(queryData) {
+				return errors.New("query data must be json")
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgSendTopicQuery(authority, args[0], queryData)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTopicSubscriptionUpdate is a command to add, replace, or remove a module's subscription to a topic
+func GetCmdTopicSubscriptionUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-topic-subscription <topic> <subscriber> [max age blocks]",
+		Short:   "Add, replace, or remove a module's subscription to an oracle topic",
+		Long:    "Submit an update topic subscription via governance proposal along with an initial deposit.",
+		Args:    cobra.RangeArgs(2, 3),
+		Aliases: []string{"uts"},
+		Example: fmt.Sprintf(`%[1]s tx oracle update-topic-subscription HASH/USD marker 100 --deposit 50000nhash
+%[1]s tx oracle update-topic-subscription HASH/USD marker --%[2]s --deposit 50000nhash`, version.AppName, FlagRemove),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sub := types.TopicSubscription{Topic: args[0], Subscriber: args[1]}
+			if len(args) > 2 {
+				sub.MaxAgeBlocks, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid max age blocks %q: %w", args[2], err)
+				}
+			}
+
+			flagSet := cmd.Flags()
+			remove, err := flagSet.GetBool(FlagRemove)
+			if err != nil {
+				return err
+			}
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgUpdateTopicSubscription(authority, sub, remove)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRemove, false, "Remove the subscription instead of adding or replacing it")
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPriceFeedUpdate is a command to add or replace a price feed
+func GetCmdPriceFeedUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-price-feed <feed-json>",
+		Short:   "Add or replace a price feed",
+		Long:    "Submit an update price feed via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(1),
+		Aliases: []string{"upf"},
+		Example: fmt.Sprintf(`%[1]s tx oracle update-price-feed '{"symbol":"HASH/USD","feeders":["pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"],"nav":{"marker_denom":"nhash","price_denom":"usd","volume":1000000000}}' --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var feed types.PriceFeed
+			if err = clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &feed); err != nil {
+				return fmt.Errorf("invalid price feed json: %w", err)
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgUpdatePriceFeed(authority, feed)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitPrice is a command for a feeder to submit a price to a price feed
+func GetCmdSubmitPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "submit-price <symbol> <price>",
+		Short:   "Submit a price to a price feed",
+		Long:    "Submit a price to a price feed. The --from account must be one of the feed's feeders.",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"sp"},
+		Example: fmt.Sprintf(`%[1]s tx oracle submit-price HASH/USD 0.025 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitPrice(clientCtx.GetFromAddress().String(), args[0], args[1])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdReinstateFeeder is a command to let a suspended feeder submit prices to a price feed again
+func GetCmdReinstateFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reinstate-feeder <symbol> <feeder>",
+		Short:   "Let a suspended feeder submit prices to a price feed again",
+		Long:    "Submit a reinstate feeder via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"rf"},
+		Example: fmt.Sprintf(`%[1]s tx oracle reinstate-feeder HASH/USD pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			msg := types.NewMsgReinstateFeeder(authority, args[0], args[1])
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
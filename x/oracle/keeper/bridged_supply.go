@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cerrs "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// GetBridgedSupplyQueryHeight gets the block height a bridged marker's locked balance was last queried at.
+// Returns 0 if it hasn't been queried.
+func (k Keeper) GetBridgedSupplyQueryHeight(ctx sdk.Context, denom string) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBridgedSupplyQueryHeightStoreKey(denom))
+	if len(bz) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setBridgedSupplyQueryHeight sets the block height a bridged marker's locked balance was last queried at.
+func (k Keeper) setBridgedSupplyQueryHeight(ctx sdk.Context, denom string, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.GetBridgedSupplyQueryHeightStoreKey(denom), binary.BigEndian.AppendUint64(nil, uint64(height)))
+}
+
+// setBridgedSupplyQuery records an outstanding query for a bridged marker's locked balance.
+func (k Keeper) setBridgedSupplyQuery(ctx sdk.Context, channel string, sequence uint64, denom string) {
+	ctx.KVStore(k.storeKey).Set(types.GetBridgedSupplyQueryStoreKey(channel, sequence), []byte(denom))
+}
+
+// popBridgedSupplyQuery gets and deletes an outstanding bridged supply query, returning the denom of the marker it
+// was for. Returns an empty string if there isn't one.
+func (k Keeper) popBridgedSupplyQuery(ctx sdk.Context, channel string, sequence uint64) string {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetBridgedSupplyQueryStoreKey(channel, sequence)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return ""
+	}
+	store.Delete(key)
+	return string(bz)
+}
+
+// QueryBridgedSupply sends an ICQ to the counterparty chain of a bridged marker for the balance locked there.
+func (k Keeper) QueryBridgedSupply(ctx sdk.Context, bridged markertypes.BridgedSupply) (uint64, error) {
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(k.GetPort(ctx), bridged.ChannelId))
+	if !found {
+		return 0, cerrs.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	q := banktypes.QueryBalanceRequest{
+		Address: bridged.LockAddress,
+		Denom:   bridged.RemoteDenom,
+	}
+
+	reqs := []abci.RequestQuery{
+		{
+			Path: "/cosmos.bank.v1beta1.Query/Balance",
+			Data: k.cdc.MustMarshal(&q),
+		},
+	}
+
+	k.setBridgedSupplyQueryHeight(ctx, bridged.Denom, ctx.BlockHeight())
+	timeoutTimestamp := ctx.BlockTime().Add(time.Minute).UnixNano()
+	seq, err := k.SendQuery(ctx, types.PortID, bridged.ChannelId, chanCap, reqs, clienttypes.ZeroHeight(), uint64(timeoutTimestamp))
+	if err != nil {
+		return 0, err
+	}
+	k.setBridgedSupplyQuery(ctx, bridged.ChannelId, seq, bridged.Denom)
+
+	return seq, nil
+}
+
+// SendBridgedSupplyQueries queries the locked balance of each bridged marker whose interval has passed since it was
+// last queried. A failure to query one marker is logged and does not prevent the others from being queried.
+func (k Keeper) SendBridgedSupplyQueries(ctx sdk.Context) {
+	var due []markertypes.BridgedSupply
+	err := k.markerKeeper.IterateBridgedSupplies(ctx, func(bridged markertypes.BridgedSupply) bool {
+		if bridged.IsDue(k.GetBridgedSupplyQueryHeight(ctx, bridged.Denom), ctx.BlockHeight()) {
+			due = append(due, bridged)
+		}
+		return false
+	})
+	if err != nil {
+		k.Logger(ctx).Error("could not read bridged supplies", "error", err)
+		return
+	}
+
+	for _, bridged := range due {
+		cacheCtx, writeCache := ctx.CacheContext()
+		seq, err := k.QueryBridgedSupply(cacheCtx, bridged)
+		if err != nil {
+			k.Logger(ctx).Error("could not send bridged supply query", "denom", bridged.Denom, "error", err)
+			// Wait for the next interval before trying again.
+			k.setBridgedSupplyQueryHeight(ctx, bridged.Denom, ctx.BlockHeight())
+			continue
+		}
+		writeCache()
+		k.Logger(ctx).Debug("sent bridged supply query", "denom", bridged.Denom, "sequence", seq)
+	}
+}
+
+// RecordBridgedSupplyAnswer hands the balance received in response to a bridged supply query to the marker module
+// for reconciliation. The returned bool is false if the packet wasn't for a bridged supply query.
+func (k Keeper) RecordBridgedSupplyAnswer(ctx sdk.Context, channel string, sequence uint64, data []byte) (bool, error) {
+	denom := k.popBridgedSupplyQuery(ctx, channel, sequence)
+	if len(denom) == 0 {
+		return false, nil
+	}
+
+	var resp banktypes.QueryBalanceResponse
+	if err := k.cdc.Unmarshal(data, &resp); err != nil {
+		return true, fmt.Errorf("could not read bridged supply balance for %s: %w", denom, err)
+	}
+	balance := sdkmath.ZeroInt()
+	if resp.Balance != nil {
+		balance = resp.Balance.Amount
+	}
+
+	return true, k.markerKeeper.RecordBridgedSupplyBalance(ctx, denom, balance)
+}
+
+// DiscardBridgedSupplyQuery forgets about an outstanding bridged supply query that failed or timed out.
+func (k Keeper) DiscardBridgedSupplyQuery(ctx sdk.Context, channel string, sequence uint64) {
+	k.popBridgedSupplyQuery(ctx, channel, sequence)
+}
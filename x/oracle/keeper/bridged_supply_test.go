@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/oracle/keeper"
+)
+
+func (s *KeeperTestSuite) createBalanceICQResponse(balance *sdktypes.Coin) []byte {
+	value := s.app.AppCodec().MustMarshal(&banktypes.QueryBalanceResponse{Balance: balance})
+	bytes, err := icqtypes.SerializeCosmosResponse([]abci.ResponseQuery{{Value: value}})
+	s.Require().NoError(err, "SerializeCosmosResponse")
+	icqBytes, err := s.cdc.MarshalJSON(&icqtypes.InterchainQueryPacketAck{Data: bytes})
+	s.Require().NoError(err, "MarshalJSON")
+	return icqBytes
+}
+
+func (s *KeeperTestSuite) TestSendBridgedSupplyQueries() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(keeper.MockScopedKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	k := s.app.OracleKeeper
+
+	marker := markertypes.NewEmptyMarkerAccount("bridgedatom", s.accountAddresses[0].String(), nil)
+	marker.MarkerType = markertypes.MarkerType_Coin
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+	bridged := markertypes.NewBridgedSupply("bridgedatom", "channel-1", "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du", "uatom", 10)
+	s.Require().NoError(s.app.MarkerKeeper.SetBridgedSupply(s.ctx, bridged), "SetBridgedSupply")
+
+	getStatus := func(ctx sdktypes.Context) markertypes.ReconciliationStatus {
+		recon, err := s.app.MarkerKeeper.GetBridgedSupplyReconciliation(ctx, marker.GetAddress())
+		s.Require().NoError(err, "GetBridgedSupplyReconciliation")
+		if recon == nil {
+			return markertypes.ReconciliationStatus_Pending
+		}
+		return recon.Status
+	}
+	ack := func(ctx sdktypes.Context, seq uint64, balance *sdktypes.Coin) {
+		packet := channeltypes.Packet{Sequence: seq, SourceChannel: "channel-1", DestinationChannel: "oracle-channel"}
+		err := k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(s.createBalanceICQResponse(balance)))
+		s.Require().NoError(err, "OnAcknowledgementPacket(%d)", seq)
+	}
+
+	k.SendBridgedSupplyQueries(s.ctx)
+	s.Assert().Equal(s.ctx.BlockHeight(), k.GetBridgedSupplyQueryHeight(s.ctx, bridged.Denom), "query height after first block")
+	s.Assert().Equal(markertypes.ReconciliationStatus_Pending, getStatus(s.ctx), "status before ack")
+
+	zero := sdktypes.NewInt64Coin("uatom", 0)
+	ack(s.ctx, 1, &zero)
+	s.Assert().Equal(markertypes.ReconciliationStatus_Matched, getStatus(s.ctx), "status after matching ack")
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 9)
+	k.SendBridgedSupplyQueries(ctx)
+	s.Assert().Equal(s.ctx.BlockHeight(), k.GetBridgedSupplyQueryHeight(ctx, bridged.Denom), "query height before interval")
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.SendBridgedSupplyQueries(ctx)
+	s.Assert().Equal(ctx.BlockHeight(), k.GetBridgedSupplyQueryHeight(ctx, bridged.Denom), "query height after interval")
+
+	locked := sdktypes.NewCoin("uatom", sdkmath.NewInt(5))
+	ack(ctx, 2, &locked)
+	s.Assert().Equal(markertypes.ReconciliationStatus_Mismatched, getStatus(ctx), "status after mismatched ack")
+}
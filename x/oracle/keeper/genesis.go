@@ -9,9 +9,39 @@ import (
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	oracle, _ := k.GetOracle(ctx)
+	topics, err := k.GetAllTopics(ctx)
+	if err != nil {
+		panic(err)
+	}
+	aggregates, err := k.GetAllAggregatedAnswers(ctx)
+	if err != nil {
+		panic(err)
+	}
+	feeds, err := k.GetAllPriceFeeds(ctx)
+	if err != nil {
+		panic(err)
+	}
+	points, err := k.GetAllPricePoints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	violations, err := k.GetAllFeederViolations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	subscriptions, err := k.GetAllTopicSubscriptions(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		PortId: k.GetPort(ctx),
-		Oracle: oracle.String(),
+		PortId:           k.GetPort(ctx),
+		Oracle:           oracle.String(),
+		Topics:           topics,
+		Aggregates:       aggregates,
+		PriceFeeds:       feeds,
+		PricePoints:      points,
+		FeederViolations: violations,
+		Subscriptions:    subscriptions,
 	}
 }
 
@@ -36,4 +66,27 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		oracle = sdk.MustAccAddressFromBech32(genState.Oracle)
 	}
 	k.SetOracle(ctx, oracle)
+
+	for _, topic := range genState.Topics {
+		k.SetTopic(ctx, topic)
+	}
+	for _, aggregate := range genState.Aggregates {
+		k.SetAggregatedAnswer(ctx, aggregate)
+	}
+	for _, sub := range genState.Subscriptions {
+		if err := k.SetTopicSubscription(ctx, sub); err != nil {
+			panic(err)
+		}
+	}
+	for _, feed := range genState.PriceFeeds {
+		if err := k.SetPriceFeed(ctx, feed); err != nil {
+			panic(err)
+		}
+	}
+	for _, point := range genState.PricePoints {
+		k.SetPricePoint(ctx, point)
+	}
+	for _, fv := range genState.FeederViolations {
+		k.SetFeederViolations(ctx, fv)
+	}
 }
@@ -19,13 +19,20 @@ import (
 
 // QueryOracle sends an ICQ to the other chain's module
 func (k Keeper) QueryOracle(ctx sdk.Context, query wasmtypes.RawContractMessage, channel string) (uint64, error) {
+	return k.queryOracle(ctx, query, "", channel)
+}
+
+// queryOracle sends an ICQ to the other chain's module for the provided oracle.
+// If the oracle is empty, the other chain's module oracle is used.
+func (k Keeper) queryOracle(ctx sdk.Context, query wasmtypes.RawContractMessage, oracle, channel string) (uint64, error) {
 	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(k.GetPort(ctx), channel))
 	if !found {
 		return 0, cerrs.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
 	q := types.QueryOracleRequest{
-		Query: query,
+		Query:  query,
+		Oracle: oracle,
 	}
 
 	reqs := []abci.RequestQuery{
@@ -26,6 +26,9 @@ type Keeper struct {
 	portKeeper      types.PortKeeper
 	scopedKeeper    types.ScopedKeeper
 	wasmQueryServer wasmtypes.QueryServer
+	markerKeeper    types.MarkerKeeper
+	priceValidator  types.PriceValidator
+	subscribers     map[string]types.TopicSubscriber
 
 	// the signing authority for the gov proposals
 	authority string
@@ -40,6 +43,7 @@ func NewKeeper(
 	portKeeper types.PortKeeper,
 	scopedKeeper types.ScopedKeeper,
 	wasmQueryServer wasmtypes.QueryServer,
+	markerKeeper types.MarkerKeeper,
 ) *Keeper {
 	return &Keeper{
 		storeKey: storeKey,
@@ -51,6 +55,8 @@ func NewKeeper(
 		portKeeper:      portKeeper,
 		scopedKeeper:    scopedKeeper,
 		wasmQueryServer: wasmQueryServer,
+		markerKeeper:    markerKeeper,
+		subscribers:     make(map[string]types.TopicSubscriber),
 		authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 }
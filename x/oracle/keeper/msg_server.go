@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -47,3 +48,107 @@ func (s msgServer) SendQueryOracle(goCtx context.Context, msg *types.MsgSendQuer
 		Sequence: seq,
 	}, nil
 }
+
+// UpdateOracleTopic adds or replaces an oracle topic
+func (s msgServer) UpdateOracleTopic(goCtx context.Context, msg *types.MsgUpdateOracleTopicRequest) (*types.MsgUpdateOracleTopicResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != s.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
+	}
+
+	s.Keeper.SetTopic(ctx, msg.Topic)
+
+	return &types.MsgUpdateOracleTopicResponse{}, nil
+}
+
+// SendTopicQuery sends an icq to each of a topic's oracles
+func (s msgServer) SendTopicQuery(goCtx context.Context, msg *types.MsgSendTopicQueryRequest) (*types.MsgSendTopicQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != s.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
+	}
+
+	round, err := s.QueryTopic(ctx, msg.Topic, msg.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTopicQueryResponse{
+		Round: round,
+	}, nil
+}
+
+// UpdateTopicSubscription adds, replaces, or removes a module's subscription to an oracle topic
+func (s msgServer) UpdateTopicSubscription(goCtx context.Context, msg *types.MsgUpdateTopicSubscriptionRequest) (*types.MsgUpdateTopicSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != s.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
+	}
+
+	if msg.Remove {
+		s.Keeper.RemoveTopicSubscription(ctx, msg.Subscription.Topic, msg.Subscription.Subscriber)
+	} else if err := s.Keeper.SetTopicSubscription(ctx, msg.Subscription); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateTopicSubscriptionResponse{}, nil
+}
+
+// UpdatePriceFeed adds or replaces a price feed
+func (s msgServer) UpdatePriceFeed(goCtx context.Context, msg *types.MsgUpdatePriceFeedRequest) (*types.MsgUpdatePriceFeedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != s.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
+	}
+
+	if msg.Feed.Nav != nil {
+		if _, err := s.markerKeeper.GetMarkerByDenom(ctx, msg.Feed.Nav.MarkerDenom); err != nil {
+			return nil, fmt.Errorf("invalid price feed %q nav: %w", msg.Feed.Symbol, err)
+		}
+	}
+
+	if err := s.Keeper.SetPriceFeed(ctx, msg.Feed); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePriceFeedResponse{}, nil
+}
+
+// SubmitPrice records a price from one of a price feed's feeders
+func (s msgServer) SubmitPrice(goCtx context.Context, msg *types.MsgSubmitPriceRequest) (*types.MsgSubmitPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+	reason, err := s.Keeper.SubmitPrice(ctx, feeder, msg.Symbol, msg.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitPriceResponse{Accepted: len(reason) == 0, Reason: reason}, nil
+}
+
+// ReinstateFeeder lets a suspended feeder submit prices to a price feed again
+func (s msgServer) ReinstateFeeder(goCtx context.Context, msg *types.MsgReinstateFeederRequest) (*types.MsgReinstateFeederResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != s.Keeper.GetAuthority() {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("expected authority %s got %s", s.Keeper.GetAuthority(), msg.GetAuthority())
+	}
+
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.Keeper.ReinstateFeeder(ctx, msg.Symbol, feeder); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReinstateFeederResponse{}, nil
+}
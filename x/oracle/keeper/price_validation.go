@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// SetPriceValidator sets the module that checks the prices submitted to every price feed (in addition to each feed's own validation).
+func (k *Keeper) SetPriceValidator(validator types.PriceValidator) {
+	k.priceValidator = validator
+}
+
+// SetFeederViolations records the recent invalid prices from one of a price feed's feeders.
+func (k Keeper) SetFeederViolations(ctx sdk.Context, fv types.FeederViolations) {
+	key := types.GetFeederViolationsStoreKey(fv.Symbol, sdk.MustAccAddressFromBech32(fv.Feeder))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&fv))
+}
+
+// GetFeederViolations gets the recent invalid prices from one of a price feed's feeders.
+// Returns nil if the feeder doesn't have any.
+func (k Keeper) GetFeederViolations(ctx sdk.Context, symbol string, feeder sdk.AccAddress) (*types.FeederViolations, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFeederViolationsStoreKey(symbol, feeder))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.FeederViolations
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read price feed %q feeder %s violations: %w", symbol, feeder, err)
+	}
+	return &rv, nil
+}
+
+// RemoveFeederViolations deletes the recent invalid prices from one of a price feed's feeders.
+func (k Keeper) RemoveFeederViolations(ctx sdk.Context, symbol string, feeder sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetFeederViolationsStoreKey(symbol, feeder))
+}
+
+// GetPriceFeedViolations gets the recent invalid prices from each of a price feed's feeders that has any.
+func (k Keeper) GetPriceFeedViolations(ctx sdk.Context, symbol string) ([]types.FeederViolations, error) {
+	return k.getFeederViolations(ctx, types.GetFeederViolationsStoreKeyPrefix(symbol))
+}
+
+// GetAllFeederViolations gets the recent invalid prices from each feeder of every price feed.
+func (k Keeper) GetAllFeederViolations(ctx sdk.Context) ([]types.FeederViolations, error) {
+	return k.getFeederViolations(ctx, types.FeederViolationsStoreKeyPrefix)
+}
+
+// getFeederViolations gets all of the feeder violations under the provided key prefix.
+func (k Keeper) getFeederViolations(ctx sdk.Context, pre []byte) ([]types.FeederViolations, error) {
+	var rv []types.FeederViolations
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fv types.FeederViolations
+		if err := k.cdc.Unmarshal(iter.Value(), &fv); err != nil {
+			return nil, fmt.Errorf("could not read feeder violations: %w", err)
+		}
+		rv = append(rv, fv)
+	}
+	return rv, nil
+}
+
+// IsFeederSuspended returns true if the feeder has been suspended from submitting prices to the price feed.
+func (k Keeper) IsFeederSuspended(ctx sdk.Context, symbol string, feeder sdk.AccAddress) (bool, error) {
+	fv, err := k.GetFeederViolations(ctx, symbol, feeder)
+	if err != nil || fv == nil {
+		return false, err
+	}
+	return fv.Suspended, nil
+}
+
+// ReinstateFeeder lets a suspended feeder submit prices to a price feed again. Its violations are cleared.
+func (k Keeper) ReinstateFeeder(ctx sdk.Context, symbol string, feeder sdk.AccAddress) error {
+	suspended, err := k.IsFeederSuspended(ctx, symbol, feeder)
+	if err != nil {
+		return err
+	}
+	if !suspended {
+		return fmt.Errorf("feeder %s is not suspended from price feed %q", feeder, symbol)
+	}
+	k.RemoveFeederViolations(ctx, symbol, feeder)
+	return ctx.EventManager().EmitTypedEvent(&types.EventFeederReinstated{
+		Symbol: symbol,
+		Feeder: feeder.String(),
+	})
+}
+
+// validatePrice returns the reason a price submitted to a feed is invalid, or an empty string if it's valid.
+// The feed's bounds are checked first, then the module price validator, then the feed's validation contract.
+// An error is only returned if the price could not be checked.
+func (k Keeper) validatePrice(ctx sdk.Context, feed types.PriceFeed, feeder sdk.AccAddress, price sdkmath.LegacyDec) (string, error) {
+	if feed.Validation != nil {
+		median, _, err := k.GetMedianPrice(ctx, feed.Symbol)
+		if err != nil {
+			return "", err
+		}
+		if reason := feed.Validation.CheckPrice(price, median); len(reason) > 0 {
+			return reason, nil
+		}
+	}
+
+	if k.priceValidator != nil {
+		if reason := k.priceValidator.ValidatePrice(ctx, feed, feeder, price); len(reason) > 0 {
+			return reason, nil
+		}
+	}
+
+	if feed.Validation == nil || len(feed.Validation.Contract) == 0 {
+		return "", nil
+	}
+	query, err := json.Marshal(types.ValidatePriceQuery{
+		ValidatePrice: types.ValidatePriceQueryParams{
+			Symbol: feed.Symbol,
+			Feeder: feeder.String(),
+			Price:  price.String(),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, err := k.wasmQueryServer.SmartContractState(ctx, &wasmtypes.QuerySmartContractStateRequest{
+		Address:   feed.Validation.Contract,
+		QueryData: query,
+	})
+	if err != nil {
+		return "", fmt.Errorf("price feed %q validation contract: %w", feed.Symbol, err)
+	}
+	var result types.ValidatePriceResponse
+	if err = json.Unmarshal(resp.Data, &result); err != nil {
+		return "", fmt.Errorf("price feed %q validation contract: invalid response: %w", feed.Symbol, err)
+	}
+	if result.Valid {
+		return "", nil
+	}
+	if len(result.Reason) == 0 {
+		return "rejected by validation contract", nil
+	}
+	return result.Reason, nil
+}
+
+// recordViolation records an invalid price from one of a price feed's feeders. If the feeder has now
+// submitted the feed's maximum number of invalid prices in a row, it is suspended from the feed and its
+// latest price is no longer used.
+func (k Keeper) recordViolation(ctx sdk.Context, feed types.PriceFeed, feeder sdk.AccAddress, price sdkmath.LegacyDec, reason string) error {
+	fv, err := k.GetFeederViolations(ctx, feed.Symbol, feeder)
+	if err != nil {
+		return err
+	}
+	if fv == nil {
+		fv = &types.FeederViolations{Symbol: feed.Symbol, Feeder: feeder.String()}
+	}
+
+	fv.Violations = append(fv.Violations, types.PriceViolation{
+		Price:  price.String(),
+		Reason: reason,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+	// Only the violations that count towards a suspension are kept.
+	maxViolations := int(feed.Validation.GetMaxViolations())
+	keep := max(maxViolations, 1)
+	if len(fv.Violations) > keep {
+		fv.Violations = fv.Violations[len(fv.Violations)-keep:]
+	}
+	fv.Suspended = maxViolations > 0 && len(fv.Violations) >= maxViolations
+	k.SetFeederViolations(ctx, *fv)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventPriceRejected{
+		Symbol: feed.Symbol,
+		Feeder: feeder.String(),
+		Price:  price.String(),
+		Reason: reason,
+	}); err != nil {
+		return err
+	}
+	if !fv.Suspended {
+		return nil
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetPricePointStoreKey(feed.Symbol, feeder))
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventFeederSuspended{
+		Symbol:   feed.Symbol,
+		Feeder:   feeder.String(),
+		Evidence: fv.Violations,
+	}); err != nil {
+		return err
+	}
+	if feed.Nav == nil {
+		return nil
+	}
+	return k.updateNetAssetValue(ctx, feed)
+}
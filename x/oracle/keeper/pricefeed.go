@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// SetPriceFeed adds or replaces a price feed.
+// The prices and violations previously submitted by feeders that are no longer allowed are discarded.
+func (k Keeper) SetPriceFeed(ctx sdk.Context, feed types.PriceFeed) error {
+	points, err := k.GetPricePoints(ctx, feed.Symbol)
+	if err != nil {
+		return err
+	}
+	violations, err := k.GetPriceFeedViolations(ctx, feed.Symbol)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceFeedStoreKey(feed.Symbol), k.cdc.MustMarshal(&feed))
+	for _, point := range points {
+		if !feed.HasFeeder(point.Feeder) {
+			store.Delete(types.GetPricePointStoreKey(point.Symbol, sdk.MustAccAddressFromBech32(point.Feeder)))
+		}
+	}
+	for _, fv := range violations {
+		if !feed.HasFeeder(fv.Feeder) {
+			k.RemoveFeederViolations(ctx, fv.Symbol, sdk.MustAccAddressFromBech32(fv.Feeder))
+		}
+	}
+	return nil
+}
+
+// GetPriceFeed gets a price feed by symbol.
+func (k Keeper) GetPriceFeed(ctx sdk.Context, symbol string) (types.PriceFeed, error) {
+	var rv types.PriceFeed
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPriceFeedStoreKey(symbol))
+	if len(bz) == 0 {
+		return rv, types.ErrPriceFeedNotFound.Wrapf("symbol %q", symbol)
+	}
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return rv, fmt.Errorf("could not read price feed %q: %w", symbol, err)
+	}
+	return rv, nil
+}
+
+// GetAllPriceFeeds gets all of the price feeds.
+func (k Keeper) GetAllPriceFeeds(ctx sdk.Context) ([]types.PriceFeed, error) {
+	var rv []types.PriceFeed
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PriceFeedStoreKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var feed types.PriceFeed
+		if err := k.cdc.Unmarshal(iter.Value(), &feed); err != nil {
+			return nil, fmt.Errorf("could not read price feed: %w", err)
+		}
+		rv = append(rv, feed)
+	}
+	return rv, nil
+}
+
+// SetPricePoint records the latest price submitted by one of a price feed's feeders.
+func (k Keeper) SetPricePoint(ctx sdk.Context, point types.PricePoint) {
+	key := types.GetPricePointStoreKey(point.Symbol, sdk.MustAccAddressFromBech32(point.Feeder))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&point))
+}
+
+// GetPricePoints gets the latest price submitted by each of a price feed's feeders.
+func (k Keeper) GetPricePoints(ctx sdk.Context, symbol string) ([]types.PricePoint, error) {
+	return k.getPricePoints(ctx, types.GetPricePointStoreKeyPrefix(symbol))
+}
+
+// GetAllPricePoints gets the latest price submitted by each feeder of every price feed.
+func (k Keeper) GetAllPricePoints(ctx sdk.Context) ([]types.PricePoint, error) {
+	return k.getPricePoints(ctx, types.PricePointStoreKeyPrefix)
+}
+
+// getPricePoints gets all of the price points under the provided key prefix.
+func (k Keeper) getPricePoints(ctx sdk.Context, pre []byte) ([]types.PricePoint, error) {
+	var rv []types.PricePoint
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var point types.PricePoint
+		if err := k.cdc.Unmarshal(iter.Value(), &point); err != nil {
+			return nil, fmt.Errorf("could not read price point: %w", err)
+		}
+		rv = append(rv, point)
+	}
+	return rv, nil
+}
+
+// GetMedianPrice gets the median of the latest prices submitted to a price feed.
+// Returns nil if no prices have been submitted.
+func (k Keeper) GetMedianPrice(ctx sdk.Context, symbol string) (*sdkmath.LegacyDec, []types.PricePoint, error) {
+	points, err := k.GetPricePoints(ctx, symbol)
+	if err != nil || len(points) == 0 {
+		return nil, points, err
+	}
+	prices := make([]sdkmath.LegacyDec, len(points))
+	for i, point := range points {
+		if prices[i], err = types.ParsePrice(point.Price); err != nil {
+			return nil, nil, fmt.Errorf("price feed %q feeder %s: %w", symbol, point.Feeder, err)
+		}
+	}
+	median := types.Median(prices)
+	return &median, points, nil
+}
+
+// SubmitPrice records a price from one of a price feed's feeders. If the feed is mapped to a
+// marker, the marker's net asset value is updated using the median of the latest prices.
+//
+// If the price fails the feed's validation, it is not recorded and the reason is returned instead.
+// The rejection still counts as a violation against the feeder, so a nil error is returned for it.
+func (k Keeper) SubmitPrice(ctx sdk.Context, feeder sdk.AccAddress, symbol, price string) (string, error) {
+	feed, err := k.GetPriceFeed(ctx, symbol)
+	if err != nil {
+		return "", err
+	}
+	if !feed.HasFeeder(feeder.String()) {
+		return "", types.ErrUnauthorizedFeeder.Wrapf("%s cannot submit prices for %q", feeder, symbol)
+	}
+	value, err := types.ParsePrice(price)
+	if err != nil {
+		return "", err
+	}
+
+	violations, err := k.GetFeederViolations(ctx, symbol, feeder)
+	if err != nil {
+		return "", err
+	}
+	if violations != nil && violations.Suspended {
+		return "", types.ErrFeederSuspended.Wrapf("%s cannot submit prices for %q", feeder, symbol)
+	}
+	reason, err := k.validatePrice(ctx, feed, feeder, value)
+	if err != nil {
+		return "", err
+	}
+	if len(reason) > 0 {
+		return reason, k.recordViolation(ctx, feed, feeder, value, reason)
+	}
+	if violations != nil {
+		k.RemoveFeederViolations(ctx, symbol, feeder)
+	}
+
+	k.SetPricePoint(ctx, types.PricePoint{
+		Symbol: symbol,
+		Feeder: feeder.String(),
+		Price:  value.String(),
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventPriceSubmitted{
+		Symbol: symbol,
+		Feeder: feeder.String(),
+		Price:  value.String(),
+	}); err != nil {
+		return "", err
+	}
+
+	if feed.Nav == nil {
+		return "", nil
+	}
+	return "", k.updateNetAssetValue(ctx, feed)
+}
+
+// updateNetAssetValue sets the net asset value of a price feed's marker using the feed's median price.
+func (k Keeper) updateNetAssetValue(ctx sdk.Context, feed types.PriceFeed) error {
+	median, _, err := k.GetMedianPrice(ctx, feed.Symbol)
+	if err != nil || median == nil {
+		return err
+	}
+	marker, err := k.markerKeeper.GetMarkerByDenom(ctx, feed.Nav.MarkerDenom)
+	if err != nil {
+		return fmt.Errorf("price feed %q marker: %w", feed.Symbol, err)
+	}
+	nav := feed.Nav.NetAssetValue(*median)
+	if err = k.markerKeeper.SetNetAssetValue(ctx, marker, nav, types.ModuleName); err != nil {
+		return fmt.Errorf("could not set price feed %q net asset value: %w", feed.Symbol, err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestSubmitPrice() {
+	k := s.app.OracleKeeper
+	feeder1, feeder2, feeder3, outsider := s.accountAddresses[0], s.accountAddresses[1], s.accountAddresses[2], s.accountAddresses[3]
+
+	marker := markertypes.NewEmptyMarkerAccount("pricecoin", feeder1.String(), nil)
+	marker.Supply = sdkmath.NewInt(1_000)
+	marker.MarkerType = markertypes.MarkerType_Coin
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+
+	feed := types.PriceFeed{
+		Symbol:  "PRICECOIN/USD",
+		Feeders: []string{feeder1.String(), feeder2.String(), feeder3.String()},
+		Nav:     &types.PriceFeedNav{MarkerDenom: "pricecoin", PriceDenom: markertypes.UsdDenom, Volume: 1_000},
+	}
+
+	_, err := s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "1.5"))
+	s.Require().EqualError(err, `symbol "PRICECOIN/USD": price feed not found`, "SubmitPrice before feed exists")
+
+	_, err = s.msgServer.UpdatePriceFeed(s.ctx, types.NewMsgUpdatePriceFeed(outsider.String(), feed))
+	s.Require().ErrorContains(err, "expected authority", "UpdatePriceFeed with wrong authority")
+
+	badFeed := feed
+	badFeed.Nav = &types.PriceFeedNav{MarkerDenom: "nosuchcoin", PriceDenom: markertypes.UsdDenom, Volume: 1}
+	_, err = s.msgServer.UpdatePriceFeed(s.ctx, types.NewMsgUpdatePriceFeed(k.GetAuthority(), badFeed))
+	s.Require().ErrorContains(err, `invalid price feed "PRICECOIN/USD" nav`, "UpdatePriceFeed with unknown marker")
+
+	_, err = s.msgServer.UpdatePriceFeed(s.ctx, types.NewMsgUpdatePriceFeed(k.GetAuthority(), feed))
+	s.Require().NoError(err, "UpdatePriceFeed")
+
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(outsider.String(), feed.Symbol, "1.5"))
+	s.Require().ErrorIs(err, types.ErrUnauthorizedFeeder, "SubmitPrice from outsider")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "1.5"))
+	s.Require().NoError(err, "SubmitPrice feeder1")
+	expEvent, err := sdk.TypedEventToEvent(&types.EventPriceSubmitted{Symbol: feed.Symbol, Feeder: feeder1.String(), Price: "1.500000000000000000"})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "emitted events")
+
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder2.String(), feed.Symbol, "2.5"))
+	s.Require().NoError(err, "SubmitPrice feeder2")
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder3.String(), feed.Symbol, "100"))
+	s.Require().NoError(err, "SubmitPrice feeder3")
+
+	resp, err := s.queryClient.Price(s.ctx, &types.QueryPriceRequest{Symbol: feed.Symbol})
+	s.Require().NoError(err, "Price query")
+	s.Assert().Equal("2.500000000000000000", resp.Price, "Price query median")
+	s.Assert().Len(resp.Points, 3, "Price query points")
+
+	nav, err := s.app.MarkerKeeper.GetNetAssetValue(s.ctx, "pricecoin", markertypes.UsdDenom)
+	s.Require().NoError(err, "GetNetAssetValue")
+	s.Require().NotNil(nav, "GetNetAssetValue")
+	s.Assert().Equal(sdk.NewInt64Coin(markertypes.UsdDenom, 2_500), nav.Price, "net asset value price")
+	s.Assert().Equal(uint64(1_000), nav.Volume, "net asset value volume")
+
+	// Removing a feeder discards its price.
+	feed.Feeders = feed.Feeders[:2]
+	s.Require().NoError(k.SetPriceFeed(s.ctx, feed), "SetPriceFeed without feeder3")
+	points, err := k.GetPricePoints(s.ctx, feed.Symbol)
+	s.Require().NoError(err, "GetPricePoints")
+	s.Assert().Len(points, 2, "GetPricePoints after removing feeder3")
+	median, _, err := k.GetMedianPrice(s.ctx, feed.Symbol)
+	s.Require().NoError(err, "GetMedianPrice")
+	s.Assert().Equal("2.000000000000000000", median.String(), "GetMedianPrice after removing feeder3")
+
+	feeds, err := s.queryClient.PriceFeeds(s.ctx, &types.QueryPriceFeedsRequest{})
+	s.Require().NoError(err, "PriceFeeds query")
+	s.Assert().Equal([]types.PriceFeed{feed}, feeds.Feeds, "PriceFeeds query")
+}
+
+func (s *KeeperTestSuite) TestSubmitPriceValidation() {
+	k := s.app.OracleKeeper
+	feeder1, feeder2 := s.accountAddresses[0], s.accountAddresses[1]
+
+	feed := types.PriceFeed{
+		Symbol:     "VALIDCOIN/USD",
+		Feeders:    []string{feeder1.String(), feeder2.String()},
+		Validation: &types.PriceValidation{MinPrice: "1", MaxPrice: "10", MaxViolations: 2},
+	}
+	_, err := s.msgServer.UpdatePriceFeed(s.ctx, types.NewMsgUpdatePriceFeed(k.GetAuthority(), feed))
+	s.Require().NoError(err, "UpdatePriceFeed")
+
+	resp, err := s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "5"))
+	s.Require().NoError(err, "SubmitPrice valid price")
+	s.Assert().True(resp.Accepted, "SubmitPrice valid price accepted")
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	resp, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "50"))
+	s.Require().NoError(err, "SubmitPrice out of bounds")
+	s.Assert().False(resp.Accepted, "SubmitPrice out of bounds accepted")
+	s.Assert().Equal("price 50.000000000000000000 is greater than the maximum 10", resp.Reason, "SubmitPrice out of bounds reason")
+	expEvent, err := sdk.TypedEventToEvent(&types.EventPriceRejected{Symbol: feed.Symbol, Feeder: feeder1.String(), Price: "50.000000000000000000", Reason: resp.Reason})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "emitted events")
+
+	points, err := k.GetPricePoints(s.ctx, feed.Symbol)
+	s.Require().NoError(err, "GetPricePoints")
+	s.Require().Len(points, 1, "GetPricePoints after rejected price")
+	s.Assert().Equal("5.000000000000000000", points[0].Price, "price point after rejected price")
+
+	// A valid price clears the violations of a feeder that isn't suspended.
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "6"))
+	s.Require().NoError(err, "SubmitPrice valid price after violation")
+	fv, err := k.GetFeederViolations(s.ctx, feed.Symbol, feeder1)
+	s.Require().NoError(err, "GetFeederViolations")
+	s.Assert().Nil(fv, "GetFeederViolations after valid price")
+
+	// Too many invalid prices in a row suspends the feeder and discards its price.
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "0.5"))
+	s.Require().NoError(err, "SubmitPrice first violation")
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "11"))
+	s.Require().NoError(err, "SubmitPrice second violation")
+
+	violations, err := s.queryClient.FeederViolations(s.ctx, &types.QueryFeederViolationsRequest{Symbol: feed.Symbol})
+	s.Require().NoError(err, "FeederViolations query")
+	s.Require().Len(violations.Feeders, 1, "FeederViolations query feeders")
+	s.Assert().True(violations.Feeders[0].Suspended, "FeederViolations query suspended")
+	s.Assert().Len(violations.Feeders[0].Violations, 2, "FeederViolations query violations")
+	expEvent, err = sdk.TypedEventToEvent(&types.EventFeederSuspended{Symbol: feed.Symbol, Feeder: feeder1.String(), Evidence: violations.Feeders[0].Violations})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "emitted events")
+
+	points, err = k.GetPricePoints(s.ctx, feed.Symbol)
+	s.Require().NoError(err, "GetPricePoints")
+	s.Assert().Empty(points, "GetPricePoints after suspension")
+
+	_, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "5"))
+	s.Require().ErrorIs(err, types.ErrFeederSuspended, "SubmitPrice while suspended")
+
+	_, err = s.msgServer.ReinstateFeeder(s.ctx, types.NewMsgReinstateFeeder(feeder1.String(), feed.Symbol, feeder1.String()))
+	s.Require().ErrorContains(err, "expected authority", "ReinstateFeeder with wrong authority")
+	_, err = s.msgServer.ReinstateFeeder(s.ctx, types.NewMsgReinstateFeeder(k.GetAuthority(), feed.Symbol, feeder2.String()))
+	s.Require().EqualError(err, fmt.Sprintf("feeder %s is not suspended from price feed %q", feeder2, feed.Symbol), "ReinstateFeeder not suspended")
+	_, err = s.msgServer.ReinstateFeeder(s.ctx, types.NewMsgReinstateFeeder(k.GetAuthority(), feed.Symbol, feeder1.String()))
+	s.Require().NoError(err, "ReinstateFeeder")
+
+	resp, err = s.msgServer.SubmitPrice(s.ctx, types.NewMsgSubmitPrice(feeder1.String(), feed.Symbol, "5"))
+	s.Require().NoError(err, "SubmitPrice after reinstatement")
+	s.Assert().True(resp.Accepted, "SubmitPrice after reinstatement accepted")
+}
@@ -7,7 +7,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/provenance-io/provenance/x/oracle/types"
 )
@@ -31,12 +34,16 @@ func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*t
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	addr, err := k.GetOracle(ctx)
-	if err != nil {
-		return nil, err
+	addr := req.Oracle
+	if len(addr) == 0 {
+		oracle, err := k.GetOracle(ctx)
+		if err != nil {
+			return nil, err
+		}
+		addr = oracle.String()
 	}
 	query := &wasmtypes.QuerySmartContractStateRequest{
-		Address:   addr.String(),
+		Address:   addr,
 		QueryData: req.Query,
 	}
 	resp, err := k.wasmQueryServer.SmartContractState(ctx, query)
@@ -45,3 +52,161 @@ func (k Keeper) Oracle(goCtx context.Context, req *types.QueryOracleRequest) (*t
 	}
 	return &types.QueryOracleResponse{Data: resp.Data}, nil
 }
+
+// OracleTopics returns all of the oracle topics
+func (k Keeper) OracleTopics(goCtx context.Context, req *types.QueryOracleTopicsRequest) (*types.QueryOracleTopicsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var topics []types.OracleTopic
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TopicStoreKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var topic types.OracleTopic
+		if err := k.cdc.Unmarshal(value, &topic); err != nil {
+			return err
+		}
+		topics = append(topics, topic)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryOracleTopicsResponse{Topics: topics, Pagination: pageRes}, nil
+}
+
+// TopicAnswer returns the aggregated answer of a topic along with its current answers
+func (k Keeper) TopicAnswer(goCtx context.Context, req *types.QueryTopicAnswerRequest) (*types.QueryTopicAnswerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateTopicName(req.Topic); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	topic, err := k.GetTopic(ctx, req.Topic)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	aggregate, err := k.GetAggregatedAnswer(ctx, req.Topic)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	answers, err := k.GetTopicAnswers(ctx, req.Topic)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &types.QueryTopicAnswerResponse{Aggregate: aggregate, Answers: answers}
+	if aggregate != nil {
+		resp.Stale = topic.IsStale(aggregate.Height, ctx.BlockHeight())
+	}
+	return resp, nil
+}
+
+// TopicSubscriptions returns the modules subscribed to a topic
+func (k Keeper) TopicSubscriptions(goCtx context.Context, req *types.QueryTopicSubscriptionsRequest) (*types.QueryTopicSubscriptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateTopicName(req.Topic); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.GetTopic(ctx, req.Topic); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	var subs []types.TopicSubscription
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTopicSubscriptionStoreKeyPrefix(req.Topic))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var sub types.TopicSubscription
+		if err := k.cdc.Unmarshal(value, &sub); err != nil {
+			return err
+		}
+		subs = append(subs, sub)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTopicSubscriptionsResponse{Subscriptions: subs, Pagination: pageRes}, nil
+}
+
+// PriceFeeds returns all of the price feeds
+func (k Keeper) PriceFeeds(goCtx context.Context, req *types.QueryPriceFeedsRequest) (*types.QueryPriceFeedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var feeds []types.PriceFeed
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceFeedStoreKeyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var feed types.PriceFeed
+		if err := k.cdc.Unmarshal(value, &feed); err != nil {
+			return err
+		}
+		feeds = append(feeds, feed)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPriceFeedsResponse{Feeds: feeds, Pagination: pageRes}, nil
+}
+
+// Price returns the median price of a price feed along with the latest price from each feeder
+func (k Keeper) Price(goCtx context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	feed, err := k.GetPriceFeed(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	median, points, err := k.GetMedianPrice(ctx, req.Symbol)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &types.QueryPriceResponse{Feed: feed, Points: points}
+	if median != nil {
+		resp.Price = median.String()
+	}
+	return resp, nil
+}
+
+// FeederViolations returns the recent invalid prices from each of a price feed's feeders and whether they're suspended
+func (k Keeper) FeederViolations(goCtx context.Context, req *types.QueryFeederViolationsRequest) (*types.QueryFeederViolationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateSymbol(req.Symbol); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.GetPriceFeed(ctx, req.Symbol); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	var feeders []types.FeederViolations
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFeederViolationsStoreKeyPrefix(req.Symbol))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var fv types.FeederViolations
+		if err := k.cdc.Unmarshal(value, &fv); err != nil {
+			return err
+		}
+		feeders = append(feeders, fv)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFeederViolationsResponse{Feeders: feeders, Pagination: pageRes}, nil
+}
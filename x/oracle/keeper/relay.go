@@ -105,6 +105,18 @@ func (k Keeper) OnAcknowledgementPacket(
 			return cerrs.Wrap(sdkerrors.ErrInvalidRequest, "no responses in interchain query packet ack")
 		}
 
+		isBridged, berr := k.RecordBridgedSupplyAnswer(ctx, modulePacket.SourceChannel, modulePacket.Sequence, resps[0].Value)
+		if isBridged {
+			if berr != nil {
+				k.Logger(ctx).Error("interchain query ack response could not be recorded for bridged supply", "sequence", modulePacket.Sequence, "error", berr)
+			}
+			return ctx.EventManager().EmitTypedEvent(&types.EventOracleQuerySuccess{
+				SequenceId: strconv.FormatUint(modulePacket.Sequence, 10),
+				Result:     string(resp.Result),
+				Channel:    modulePacket.DestinationChannel,
+			})
+		}
+
 		var r types.QueryOracleResponse
 		if err = k.cdc.Unmarshal(resps[0].Value, &r); err != nil {
 			return cerrs.Wrapf(err, "failed to unmarshal interchain query response to type %T", resp)
@@ -118,11 +130,20 @@ func (k Keeper) OnAcknowledgementPacket(
 
 		k.Logger(ctx).Info("interchain query ack response", "sequence", modulePacket.Sequence, "response", r)
 
+		if terr := k.RecordTopicAnswer(ctx, modulePacket.SourceChannel, modulePacket.Sequence, r.Data); terr != nil {
+			k.Logger(ctx).Error("interchain query ack response could not be recorded for topic", "sequence", modulePacket.Sequence, "error", terr)
+		}
+
 		if err != nil {
 			k.Logger(ctx).Error("interchain query ack response was unable to emit event", "sequence", modulePacket.Sequence, "error", err)
 			return err
 		}
 	case *channeltypes.Acknowledgement_Error:
+		if terr := k.DiscardTopicQuery(ctx, modulePacket.SourceChannel, modulePacket.Sequence); terr != nil {
+			k.Logger(ctx).Error("interchain query ack error response could not be discarded for topic", "sequence", modulePacket.Sequence, "error", terr)
+		}
+		k.DiscardBridgedSupplyQuery(ctx, modulePacket.SourceChannel, modulePacket.Sequence)
+
 		err := ctx.EventManager().EmitTypedEvent(&types.EventOracleQueryError{
 			SequenceId: strconv.FormatUint(modulePacket.Sequence, 10),
 			Error:      resp.Error,
@@ -144,6 +165,11 @@ func (k Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	modulePacket channeltypes.Packet,
 ) error {
+	if terr := k.DiscardTopicQuery(ctx, modulePacket.SourceChannel, modulePacket.Sequence); terr != nil {
+		k.Logger(ctx).Error("interchain query timeout could not be discarded for topic", "sequence", modulePacket.Sequence, "error", terr)
+	}
+	k.DiscardBridgedSupplyQuery(ctx, modulePacket.SourceChannel, modulePacket.Sequence)
+
 	err := ctx.EventManager().EmitTypedEvent(&types.EventOracleQueryTimeout{
 		SequenceId: strconv.FormatUint(modulePacket.Sequence, 10),
 		Channel:    modulePacket.DestinationChannel,
@@ -153,7 +153,7 @@ func (s *KeeperTestSuite) TestOnTimeoutPacket() {
 
 func (s *KeeperTestSuite) createICQResponse(cdc codec.Codec, response string) []byte {
 	oracleResponse := types.QueryOracleResponse{
-		Data: []byte("{}"),
+		Data: []byte(response),
 	}
 	value, _ := cdc.Marshal(&oracleResponse)
 	bytes, _ := icqtypes.SerializeCosmosResponse([]abci.ResponseQuery{{
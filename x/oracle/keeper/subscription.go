@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// AddTopicSubscriber registers the module that is given the aggregated answers of the topics the named subscriber is
+// subscribed to. It panics if a module has already been registered with the name.
+func (k Keeper) AddTopicSubscriber(name string, subscriber types.TopicSubscriber) {
+	if _, exists := k.subscribers[name]; exists {
+		panic(fmt.Errorf("topic subscriber %q is already registered", name))
+	}
+	k.subscribers[name] = subscriber
+}
+
+// SetTopicSubscription adds or replaces a module's subscription to a topic.
+func (k Keeper) SetTopicSubscription(ctx sdk.Context, sub types.TopicSubscription) error {
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	if _, err := k.GetTopic(ctx, sub.Topic); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetTopicSubscriptionStoreKey(sub.Topic, sub.Subscriber), k.cdc.MustMarshal(&sub))
+	return nil
+}
+
+// GetTopicSubscription gets a module's subscription to a topic. Returns nil if there isn't one.
+func (k Keeper) GetTopicSubscription(ctx sdk.Context, topic, subscriber string) (*types.TopicSubscription, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTopicSubscriptionStoreKey(topic, subscriber))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.TopicSubscription
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read topic %q subscription of %s: %w", topic, subscriber, err)
+	}
+	return &rv, nil
+}
+
+// RemoveTopicSubscription deletes a module's subscription to a topic.
+func (k Keeper) RemoveTopicSubscription(ctx sdk.Context, topic, subscriber string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetTopicSubscriptionStoreKey(topic, subscriber))
+}
+
+// GetTopicSubscriptions gets the subscriptions to a topic.
+func (k Keeper) GetTopicSubscriptions(ctx sdk.Context, topic string) ([]types.TopicSubscription, error) {
+	return k.getTopicSubscriptions(ctx, types.GetTopicSubscriptionStoreKeyPrefix(topic))
+}
+
+// GetAllTopicSubscriptions gets the subscriptions to every topic.
+func (k Keeper) GetAllTopicSubscriptions(ctx sdk.Context) ([]types.TopicSubscription, error) {
+	return k.getTopicSubscriptions(ctx, types.TopicSubscriptionStoreKeyPrefix)
+}
+
+// getTopicSubscriptions gets the subscriptions stored under the provided prefix.
+func (k Keeper) getTopicSubscriptions(ctx sdk.Context, pre []byte) ([]types.TopicSubscription, error) {
+	var rv []types.TopicSubscription
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), pre)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var sub types.TopicSubscription
+		if err := k.cdc.Unmarshal(iter.Value(), &sub); err != nil {
+			return nil, fmt.Errorf("could not read topic subscription: %w", err)
+		}
+		rv = append(rv, sub)
+	}
+	return rv, nil
+}
+
+// GetSubscribedAnswer gets the most recent aggregated answer of a topic for one of its subscribers, returning an
+// error if the subscriber isn't subscribed to it, or if there isn't one that is fresh enough for the subscriber.
+// The answer is read from state, so any number of subscribers can use it without the topic being queried again.
+func (k Keeper) GetSubscribedAnswer(ctx sdk.Context, name, subscriber string) (*types.AggregatedAnswer, error) {
+	sub, err := k.GetTopicSubscription(ctx, name, subscriber)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, types.ErrNotSubscribed.Wrapf("%s to topic %q", subscriber, name)
+	}
+	topic, err := k.GetTopic(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	aggregate, err := k.GetAggregatedAnswer(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if aggregate == nil {
+		return nil, fmt.Errorf("topic %q has no aggregated answer", name)
+	}
+	if sub.IsStale(topic, aggregate.Height, ctx.BlockHeight()) {
+		return nil, fmt.Errorf("topic %q aggregated answer from height %d is stale for %s", name, aggregate.Height, subscriber)
+	}
+	return aggregate, nil
+}
+
+// isTopicDue returns true if a topic should be queried at the current height. That is either when it is recurring
+// and its interval has passed, or when its aggregated answer is missing or stale for one of its subscribers.
+// A stale subscriber only causes a new query once its max age has passed since the topic was last queried, so
+// that one outstanding round serves every subscriber.
+func (k Keeper) isTopicDue(ctx sdk.Context, topic types.OracleTopic) (bool, error) {
+	last := k.GetTopicQueryHeight(ctx, topic.Name)
+	if topic.IsRecurring() && (last == 0 || ctx.BlockHeight()-last >= int64(topic.IntervalBlocks)) {
+		return true, nil
+	}
+	if len(topic.Query) == 0 {
+		return false, nil
+	}
+
+	subs, err := k.GetTopicSubscriptions(ctx, topic.Name)
+	if err != nil || len(subs) == 0 {
+		return false, err
+	}
+	aggregate, err := k.GetAggregatedAnswer(ctx, topic.Name)
+	if err != nil {
+		return false, err
+	}
+	for _, sub := range subs {
+		if aggregate != nil && !sub.IsStale(topic, aggregate.Height, ctx.BlockHeight()) {
+			continue
+		}
+		maxAge := int64(sub.MaxAge(topic))
+		if last == 0 || (maxAge > 0 && ctx.BlockHeight()-last >= maxAge) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// notifySubscribers gives a topic's new aggregated answer to each of its subscribers that has a registered module.
+// A failure of one subscriber is logged, and its changes discarded, without affecting the others.
+func (k Keeper) notifySubscribers(ctx sdk.Context, answer types.AggregatedAnswer) error {
+	subs, err := k.GetTopicSubscriptions(ctx, answer.Topic)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		subscriber, ok := k.subscribers[sub.Subscriber]
+		if !ok {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = subscriber.OnTopicAnswer(cacheCtx, answer); err != nil {
+			k.Logger(ctx).Error("topic subscriber could not use aggregated answer", "topic", answer.Topic, "subscriber", sub.Subscriber, "error", err)
+			continue
+		}
+		writeCache()
+	}
+	return nil
+}
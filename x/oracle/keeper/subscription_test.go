@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/x/oracle/keeper"
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// mockTopicSubscriber records the answers it is given, optionally failing after writing to state.
+type mockTopicSubscriber struct {
+	keeper  keeper.Keeper
+	answers *[]types.AggregatedAnswer
+	fail    bool
+}
+
+func (m mockTopicSubscriber) OnTopicAnswer(ctx sdk.Context, answer types.AggregatedAnswer) error {
+	*m.answers = append(*m.answers, answer)
+	m.keeper.SetAggregatedAnswer(ctx, types.AggregatedAnswer{Topic: "written/" + answer.Topic, Value: answer.Value})
+	if m.fail {
+		return errors.New("subscriber failure")
+	}
+	return nil
+}
+
+func (s *KeeperTestSuite) TestTopicSubscriptions() {
+	k := s.app.OracleKeeper
+	topic := types.OracleTopic{
+		Name:    "HASH/USD",
+		Sources: []types.OracleSource{{Channel: "channel-1"}},
+		Method:  types.AggregationMethod_median,
+	}
+
+	err := k.SetTopicSubscription(s.ctx, types.TopicSubscription{Topic: topic.Name, Subscriber: "marker"})
+	s.Require().EqualError(err, `topic "HASH/USD": oracle topic not found`, "SetTopicSubscription before topic exists")
+
+	k.SetTopic(s.ctx, topic)
+	authority := k.GetAuthority()
+	marker := types.TopicSubscription{Topic: topic.Name, Subscriber: "marker", MaxAgeBlocks: 5}
+	exchange := types.TopicSubscription{Topic: topic.Name, Subscriber: "exchange"}
+
+	_, err = s.msgServer.UpdateTopicSubscription(s.ctx, types.NewMsgUpdateTopicSubscription(s.accountAddr.String(), marker, false))
+	s.Require().ErrorContains(err, "expected authority", "UpdateTopicSubscription with wrong authority")
+	_, err = s.msgServer.UpdateTopicSubscription(s.ctx, types.NewMsgUpdateTopicSubscription(authority, marker, false))
+	s.Require().NoError(err, "UpdateTopicSubscription marker")
+	_, err = s.msgServer.UpdateTopicSubscription(s.ctx, types.NewMsgUpdateTopicSubscription(authority, exchange, false))
+	s.Require().NoError(err, "UpdateTopicSubscription exchange")
+
+	resp, err := s.queryClient.TopicSubscriptions(s.ctx, &types.QueryTopicSubscriptionsRequest{Topic: topic.Name})
+	s.Require().NoError(err, "TopicSubscriptions query")
+	// Subscribers are length-prefixed in their keys, so shorter names come first.
+	s.Assert().Equal([]types.TopicSubscription{marker, exchange}, resp.Subscriptions, "TopicSubscriptions query subscriptions")
+	resp, err = s.queryClient.TopicSubscriptions(s.ctx, &types.QueryTopicSubscriptionsRequest{Topic: topic.Name, Pagination: &query.PageRequest{Limit: 1, Reverse: true, CountTotal: true}})
+	s.Require().NoError(err, "TopicSubscriptions query reversed with limit")
+	s.Assert().Equal([]types.TopicSubscription{exchange}, resp.Subscriptions, "TopicSubscriptions query reversed with limit subscriptions")
+	s.Assert().Equal(2, int(resp.Pagination.Total), "TopicSubscriptions query reversed with limit total")
+	_, err = s.queryClient.TopicSubscriptions(s.ctx, &types.QueryTopicSubscriptionsRequest{Topic: "BTC/USD"})
+	s.Require().ErrorContains(err, "oracle topic not found", "TopicSubscriptions query for unknown topic")
+
+	_, err = k.GetSubscribedAnswer(s.ctx, topic.Name, "msgfees")
+	s.Require().ErrorIs(err, types.ErrNotSubscribed, "GetSubscribedAnswer without subscription")
+	_, err = k.GetSubscribedAnswer(s.ctx, topic.Name, "marker")
+	s.Require().EqualError(err, `topic "HASH/USD" has no aggregated answer`, "GetSubscribedAnswer without answer")
+
+	answer := types.AggregatedAnswer{Topic: topic.Name, Value: "1.5", Answers: 1, Round: 1, Height: s.ctx.BlockHeight()}
+	k.SetAggregatedAnswer(s.ctx, answer)
+	ctx := s.ctx.WithBlockHeight(answer.Height + 6)
+	_, err = k.GetSubscribedAnswer(ctx, topic.Name, "marker")
+	s.Require().EqualError(err, `topic "HASH/USD" aggregated answer from height 100 is stale for marker`, "GetSubscribedAnswer stale for marker")
+	actual, err := k.GetSubscribedAnswer(ctx, topic.Name, "exchange")
+	s.Require().NoError(err, "GetSubscribedAnswer for exchange")
+	s.Assert().Equal(&answer, actual, "GetSubscribedAnswer for exchange")
+
+	_, err = s.msgServer.UpdateTopicSubscription(s.ctx, types.NewMsgUpdateTopicSubscription(authority, exchange, true))
+	s.Require().NoError(err, "UpdateTopicSubscription remove exchange")
+	subs, err := k.GetAllTopicSubscriptions(s.ctx)
+	s.Require().NoError(err, "GetAllTopicSubscriptions")
+	s.Assert().Equal([]types.TopicSubscription{marker}, subs, "GetAllTopicSubscriptions after removal")
+}
+
+func (s *KeeperTestSuite) TestTopicSubscriberFanOut() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(keeper.MockScopedKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	k := s.app.OracleKeeper
+
+	topic := types.OracleTopic{
+		Name:    "HASH/USD",
+		Sources: []types.OracleSource{{Channel: "channel-1"}},
+		Method:  types.AggregationMethod_median,
+		Query:   []byte(`{"price":{}}`),
+	}
+	k.SetTopic(s.ctx, topic)
+	s.Require().NoError(k.SetTopicSubscription(s.ctx, types.TopicSubscription{Topic: topic.Name, Subscriber: "fanout-marker", MaxAgeBlocks: 5}), "SetTopicSubscription marker")
+	s.Require().NoError(k.SetTopicSubscription(s.ctx, types.TopicSubscription{Topic: topic.Name, Subscriber: "fanout-exchange", MaxAgeBlocks: 20}), "SetTopicSubscription exchange")
+	s.Require().NoError(k.SetTopicSubscription(s.ctx, types.TopicSubscription{Topic: topic.Name, Subscriber: "fanout-msgfees"}), "SetTopicSubscription msgfees")
+
+	var markerAnswers, exchangeAnswers []types.AggregatedAnswer
+	k.AddTopicSubscriber("fanout-marker", mockTopicSubscriber{keeper: k, answers: &markerAnswers})
+	k.AddTopicSubscriber("fanout-exchange", mockTopicSubscriber{keeper: k, answers: &exchangeAnswers, fail: true})
+	s.Assert().Panics(func() {
+		k.AddTopicSubscriber("fanout-marker", mockTopicSubscriber{keeper: k, answers: &markerAnswers})
+	}, "AddTopicSubscriber with a duplicate name")
+
+	// Without an answer, the topic is queried once for all of its subscribers.
+	round := k.GetTopicRound(s.ctx, topic.Name)
+	k.SendRecurringQueries(s.ctx)
+	s.Assert().Equal(round+1, k.GetTopicRound(s.ctx, topic.Name), "round after first block")
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	k.SendRecurringQueries(ctx)
+	s.Assert().Equal(round+1, k.GetTopicRound(ctx, topic.Name), "round while the query is outstanding")
+
+	packet := channeltypes.Packet{Sequence: 1, SourceChannel: "channel-1", DestinationChannel: "oracle-channel"}
+	err := k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(s.createICQResponse(s.app.AppCodec(), `"2"`)))
+	s.Require().NoError(err, "OnAcknowledgementPacket")
+
+	s.Require().Len(markerAnswers, 1, "answers given to marker")
+	s.Assert().Equal("2.000000000000000000", markerAnswers[0].Value, "answer given to marker")
+	s.Assert().Len(exchangeAnswers, 1, "answers given to exchange")
+	written, err := k.GetAggregatedAnswer(ctx, "written/HASH/USD")
+	s.Require().NoError(err, "GetAggregatedAnswer written by subscribers")
+	s.Require().NotNil(written, "state written by the successful subscriber")
+
+	// The answer is fresh for every subscriber, so it isn't queried again.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	k.SendRecurringQueries(ctx)
+	s.Assert().Equal(round+1, k.GetTopicRound(ctx, topic.Name), "round while fresh for every subscriber")
+
+	// Once it's stale for the marker subscriber, it's queried again.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.SendRecurringQueries(ctx)
+	s.Assert().Equal(round+2, k.GetTopicRound(ctx, topic.Name), "round once stale for marker")
+	_, err = k.GetSubscribedAnswer(ctx, topic.Name, "fanout-exchange")
+	s.Assert().NoError(err, "GetSubscribedAnswer for exchange while stale for marker")
+}
@@ -0,0 +1,382 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+// SetTopic adds or replaces an oracle topic.
+// Any answers already received for the topic are discarded, and outstanding queries for it will be ignored.
+func (k Keeper) SetTopic(ctx sdk.Context, topic types.OracleTopic) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTopicStoreKey(topic.Name), k.cdc.MustMarshal(&topic))
+	k.clearAnswers(ctx, topic.Name)
+	k.setTopicRound(ctx, topic.Name, k.GetTopicRound(ctx, topic.Name)+1)
+}
+
+// GetTopic gets an oracle topic by name.
+func (k Keeper) GetTopic(ctx sdk.Context, name string) (types.OracleTopic, error) {
+	var rv types.OracleTopic
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTopicStoreKey(name))
+	if len(bz) == 0 {
+		return rv, types.ErrTopicNotFound.Wrapf("topic %q", name)
+	}
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return rv, fmt.Errorf("could not read topic %q: %w", name, err)
+	}
+	return rv, nil
+}
+
+// GetAllTopics gets all of the oracle topics.
+func (k Keeper) GetAllTopics(ctx sdk.Context) ([]types.OracleTopic, error) {
+	var rv []types.OracleTopic
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TopicStoreKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var topic types.OracleTopic
+		if err := k.cdc.Unmarshal(iter.Value(), &topic); err != nil {
+			return nil, fmt.Errorf("could not read topic: %w", err)
+		}
+		rv = append(rv, topic)
+	}
+	return rv, nil
+}
+
+// GetTopicRound gets the current query round of a topic.
+func (k Keeper) GetTopicRound(ctx sdk.Context, name string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTopicRoundStoreKey(name))
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setTopicRound sets the current query round of a topic.
+func (k Keeper) setTopicRound(ctx sdk.Context, name string, round uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetTopicRoundStoreKey(name), binary.BigEndian.AppendUint64(nil, round))
+}
+
+// GetTopicQueryHeight gets the block height a topic was last queried at. Returns 0 if it hasn't been queried.
+func (k Keeper) GetTopicQueryHeight(ctx sdk.Context, name string) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTopicQueryHeightStoreKey(name))
+	if len(bz) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// setTopicQueryHeight sets the block height a topic was last queried at.
+func (k Keeper) setTopicQueryHeight(ctx sdk.Context, name string, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.GetTopicQueryHeightStoreKey(name), binary.BigEndian.AppendUint64(nil, uint64(height)))
+}
+
+// setTopicQuery records an outstanding query for a topic.
+func (k Keeper) setTopicQuery(ctx sdk.Context, channel string, sequence uint64, query types.TopicQuery) {
+	ctx.KVStore(k.storeKey).Set(types.GetTopicQueryStoreKey(channel, sequence), k.cdc.MustMarshal(&query))
+}
+
+// popTopicQuery gets and deletes an outstanding topic query. Returns nil if there isn't one.
+func (k Keeper) popTopicQuery(ctx sdk.Context, channel string, sequence uint64) (*types.TopicQuery, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTopicQueryStoreKey(channel, sequence)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	store.Delete(key)
+	var rv types.TopicQuery
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read topic query %s/%d: %w", channel, sequence, err)
+	}
+	return &rv, nil
+}
+
+// setAnswer records the answer received from one of a topic's sources.
+func (k Keeper) setAnswer(ctx sdk.Context, answer types.OracleAnswer) {
+	ctx.KVStore(k.storeKey).Set(types.GetAnswerStoreKey(answer.Topic, answer.Source), k.cdc.MustMarshal(&answer))
+}
+
+// GetTopicAnswers gets the answers received from a topic's sources for its current round.
+func (k Keeper) GetTopicAnswers(ctx sdk.Context, name string) ([]types.OracleAnswer, error) {
+	round := k.GetTopicRound(ctx, name)
+	var rv []types.OracleAnswer
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetAnswerStoreKeyPrefix(name))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var answer types.OracleAnswer
+		if err := k.cdc.Unmarshal(iter.Value(), &answer); err != nil {
+			return nil, fmt.Errorf("could not read topic %q answer: %w", name, err)
+		}
+		if answer.Round == round {
+			rv = append(rv, answer)
+		}
+	}
+	return rv, nil
+}
+
+// clearAnswers deletes all of the answers of a topic.
+func (k Keeper) clearAnswers(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := storetypes.KVStorePrefixIterator(store, types.GetAnswerStoreKeyPrefix(name))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// SetAggregatedAnswer records the aggregated answer of a topic.
+func (k Keeper) SetAggregatedAnswer(ctx sdk.Context, answer types.AggregatedAnswer) {
+	ctx.KVStore(k.storeKey).Set(types.GetAggregateStoreKey(answer.Topic), k.cdc.MustMarshal(&answer))
+}
+
+// GetAggregatedAnswer gets the most recent aggregated answer of a topic. Returns nil if there isn't one.
+func (k Keeper) GetAggregatedAnswer(ctx sdk.Context, name string) (*types.AggregatedAnswer, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAggregateStoreKey(name))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.AggregatedAnswer
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, fmt.Errorf("could not read topic %q aggregated answer: %w", name, err)
+	}
+	return &rv, nil
+}
+
+// GetAllAggregatedAnswers gets the most recent aggregated answer of every topic that has one.
+func (k Keeper) GetAllAggregatedAnswers(ctx sdk.Context) ([]types.AggregatedAnswer, error) {
+	var rv []types.AggregatedAnswer
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AggregateStoreKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var answer types.AggregatedAnswer
+		if err := k.cdc.Unmarshal(iter.Value(), &answer); err != nil {
+			return nil, fmt.Errorf("could not read aggregated answer: %w", err)
+		}
+		rv = append(rv, answer)
+	}
+	return rv, nil
+}
+
+// QueryTopic starts a new round for a topic by sending the query to each of its sources.
+// The new round number is returned.
+func (k Keeper) QueryTopic(ctx sdk.Context, name string, query wasmtypes.RawContractMessage) (uint64, error) {
+	topic, err := k.GetTopic(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+
+	round := k.GetTopicRound(ctx, name) + 1
+	k.setTopicRound(ctx, name, round)
+	k.setTopicQueryHeight(ctx, name, ctx.BlockHeight())
+	for i, source := range topic.Sources {
+		seq, err := k.queryOracle(ctx, query, source.Address, source.Channel)
+		if err != nil {
+			return 0, fmt.Errorf("could not query topic %q source %s: %w", name, source.Key(), err)
+		}
+		k.setTopicQuery(ctx, source.Channel, seq, types.TopicQuery{Topic: name, Source: uint32(i), Round: round})
+	}
+
+	return round, nil
+}
+
+// MaxTopicChecksPerBlock is the most topics that SendRecurringQueries will check in a single block.
+const MaxTopicChecksPerBlock = 100
+
+// SendRecurringQueries queries each recurring topic whose interval has passed since it was last queried, and each
+// topic whose aggregated answer has become stale for one of its subscribers.
+// At most MaxTopicChecksPerBlock topics are checked each block, picking up after the last topic checked in the
+// previous block, so when there are more topics than that, each one is checked every few blocks.
+// A failure to query one topic is logged and does not prevent the others from being queried.
+func (k Keeper) SendRecurringQueries(ctx sdk.Context) {
+	topics, err := k.nextTopicsToCheck(ctx, MaxTopicChecksPerBlock)
+	if err != nil {
+		k.Logger(ctx).Error("could not read oracle topics", "error", err)
+		return
+	}
+
+	for _, topic := range topics {
+		due, err := k.isTopicDue(ctx, topic)
+		if err != nil {
+			k.Logger(ctx).Error("could not check if topic is due to be queried", "topic", topic.Name, "error", err)
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		round, err := k.QueryTopic(cacheCtx, topic.Name, topic.Query)
+		if err != nil {
+			k.Logger(ctx).Error("could not send recurring topic query", "topic", topic.Name, "error", err)
+			// Wait for the next interval before trying again.
+			k.setTopicQueryHeight(ctx, topic.Name, ctx.BlockHeight())
+			continue
+		}
+		writeCache()
+		k.Logger(ctx).Debug("sent recurring topic query", "topic", topic.Name, "round", round)
+	}
+}
+
+// nextTopicsToCheck gets up to limit topics, starting after the last topic checked and wrapping around to the first
+// topic once the end is reached. The last topic returned is recorded as the new last topic checked.
+func (k Keeper) nextTopicsToCheck(ctx sdk.Context, limit int) ([]types.OracleTopic, error) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.TopicStoreKeyPrefix
+	if cursor := store.Get(types.TopicCheckCursorStoreKey); len(cursor) > 0 {
+		// Appending a zero byte gives the first key after the cursor topic's key.
+		start = append(types.GetTopicStoreKey(string(cursor)), 0x00)
+	}
+
+	rv, err := k.readTopics(store, start, storetypes.PrefixEndBytes(types.TopicStoreKeyPrefix), limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(rv) < limit && !bytes.Equal(start, types.TopicStoreKeyPrefix) {
+		more, err := k.readTopics(store, types.TopicStoreKeyPrefix, start, limit-len(rv))
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, more...)
+	}
+
+	if len(rv) > 0 {
+		store.Set(types.TopicCheckCursorStoreKey, []byte(rv[len(rv)-1].Name))
+	}
+	return rv, nil
+}
+
+// readTopics reads up to limit topics with keys in the range [start, end).
+func (k Keeper) readTopics(store storetypes.KVStore, start, end []byte, limit int) ([]types.OracleTopic, error) {
+	var rv []types.OracleTopic
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid() && len(rv) < limit; iter.Next() {
+		var topic types.OracleTopic
+		if err := k.cdc.Unmarshal(iter.Value(), &topic); err != nil {
+			return nil, fmt.Errorf("could not read topic: %w", err)
+		}
+		rv = append(rv, topic)
+	}
+	return rv, nil
+}
+
+// GetFreshAggregatedAnswer gets the most recent aggregated answer of a topic, returning an
+// error if there isn't one or if it is older than the topic's max age.
+func (k Keeper) GetFreshAggregatedAnswer(ctx sdk.Context, name string) (*types.AggregatedAnswer, error) {
+	topic, err := k.GetTopic(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	aggregate, err := k.GetAggregatedAnswer(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if aggregate == nil {
+		return nil, fmt.Errorf("topic %q has no aggregated answer", name)
+	}
+	if topic.IsStale(aggregate.Height, ctx.BlockHeight()) {
+		return nil, fmt.Errorf("topic %q aggregated answer from height %d is stale", name, aggregate.Height)
+	}
+	return aggregate, nil
+}
+
+// RecordTopicAnswer records the data received in response to a topic query and updates the
+// topic's aggregated answer once it has enough usable answers.
+// Nothing is done if the packet wasn't for a topic query, or was for a previous round.
+// The outstanding query is always forgotten, but if there's an error, none of the other changes are kept.
+func (k Keeper) RecordTopicAnswer(ctx sdk.Context, channel string, sequence uint64, data []byte) error {
+	query, err := k.popTopicQuery(ctx, channel, sequence)
+	if err != nil || query == nil {
+		return err
+	}
+	if query.Round != k.GetTopicRound(ctx, query.Topic) {
+		return nil
+	}
+
+	value, err := types.ParseOracleValue(data)
+	if err != nil {
+		return fmt.Errorf("topic %q source %d: %w", query.Topic, query.Source, err)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	k.setAnswer(cacheCtx, types.OracleAnswer{
+		Topic:  query.Topic,
+		Source: query.Source,
+		Value:  value.String(),
+		Round:  query.Round,
+		Height: ctx.BlockHeight(),
+	})
+	if err = k.aggregateTopic(cacheCtx, query.Topic, query.Round); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
+
+// DiscardTopicQuery forgets about an outstanding topic query that failed or timed out.
+func (k Keeper) DiscardTopicQuery(ctx sdk.Context, channel string, sequence uint64) error {
+	_, err := k.popTopicQuery(ctx, channel, sequence)
+	return err
+}
+
+// aggregateTopic combines the current answers of a topic and records the result if there are enough of them.
+func (k Keeper) aggregateTopic(ctx sdk.Context, name string, round uint64) error {
+	topic, err := k.GetTopic(ctx, name)
+	if err != nil {
+		return err
+	}
+	answers, err := k.GetTopicAnswers(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(answers) < topic.RequiredAnswers() {
+		return nil
+	}
+
+	values := make([]sdkmath.LegacyDec, len(answers))
+	for i, answer := range answers {
+		if values[i], err = sdkmath.LegacyNewDecFromStr(answer.Value); err != nil {
+			return fmt.Errorf("invalid topic %q source %d answer %q: %w", name, answer.Source, answer.Value, err)
+		}
+	}
+
+	value, count, err := topic.Aggregate(values)
+	if err != nil {
+		k.Logger(ctx).Info("not enough usable answers to aggregate topic", "topic", name, "round", round, "reason", err)
+		return nil
+	}
+
+	aggregate := types.AggregatedAnswer{
+		Topic:   name,
+		Value:   value.String(),
+		Answers: uint32(count),
+		Round:   round,
+		Height:  ctx.BlockHeight(),
+	}
+	k.SetAggregatedAnswer(ctx, aggregate)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventOracleTopicAggregated{
+		Topic:   aggregate.Topic,
+		Value:   aggregate.Value,
+		Answers: aggregate.Answers,
+		Round:   aggregate.Round,
+	})
+	if err != nil {
+		return err
+	}
+	return k.notifySubscribers(ctx, aggregate)
+}
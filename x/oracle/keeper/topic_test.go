@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/provenance-io/provenance/x/oracle/keeper"
+	"github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestQueryTopic() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(keeper.MockScopedKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	k := s.app.OracleKeeper
+
+	_, err := k.QueryTopic(s.ctx, "HASH/USD", []byte("{}"))
+	s.Require().EqualError(err, `topic "HASH/USD": oracle topic not found`, "QueryTopic before topic exists")
+
+	topic := types.OracleTopic{
+		Name:             "HASH/USD",
+		Sources:          []types.OracleSource{{Channel: "channel-1"}, {Channel: "channel-2"}, {Channel: "channel-3"}},
+		Method:           types.AggregationMethod_median,
+		MaxDeviationBips: 1_000,
+		MinAnswers:       2,
+	}
+	k.SetTopic(s.ctx, topic)
+	round := k.GetTopicRound(s.ctx, topic.Name)
+
+	newRound, err := k.QueryTopic(s.ctx, topic.Name, []byte("{}"))
+	s.Require().NoError(err, "QueryTopic")
+	s.Require().Equal(round+1, newRound, "QueryTopic round")
+
+	ack := func(channel string, seq uint64, data string) {
+		packet := channeltypes.Packet{Sequence: seq, SourceChannel: channel, DestinationChannel: "oracle-channel"}
+		err := k.OnAcknowledgementPacket(s.ctx, packet, channeltypes.NewResultAcknowledgement(s.createICQResponse(s.app.AppCodec(), data)))
+		s.Require().NoError(err, "OnAcknowledgementPacket(%s, %d)", channel, seq)
+	}
+
+	s.ctx = s.ctx.WithEventManager(sdktypes.NewEventManager())
+	ack("channel-1", 1, `"10.0"`)
+	aggregate, err := k.GetAggregatedAnswer(s.ctx, topic.Name)
+	s.Require().NoError(err, "GetAggregatedAnswer after one answer")
+	s.Assert().Nil(aggregate, "GetAggregatedAnswer after one answer")
+
+	ack("channel-2", 2, `50`)
+	aggregate, err = k.GetAggregatedAnswer(s.ctx, topic.Name)
+	s.Require().NoError(err, "GetAggregatedAnswer after outlier")
+	s.Assert().Nil(aggregate, "GetAggregatedAnswer after outlier")
+
+	ack("channel-3", 3, `"10.5"`)
+	aggregate, err = k.GetAggregatedAnswer(s.ctx, topic.Name)
+	s.Require().NoError(err, "GetAggregatedAnswer after three answers")
+	expAggregate := &types.AggregatedAnswer{
+		Topic:   topic.Name,
+		Value:   "10.250000000000000000",
+		Answers: 2,
+		Round:   newRound,
+		Height:  s.ctx.BlockHeight(),
+	}
+	s.Assert().Equal(expAggregate, aggregate, "GetAggregatedAnswer after three answers")
+
+	expEvent, err := sdktypes.TypedEventToEvent(&types.EventOracleTopicAggregated{
+		Topic:   topic.Name,
+		Value:   expAggregate.Value,
+		Answers: expAggregate.Answers,
+		Round:   expAggregate.Round,
+	})
+	s.Require().NoError(err, "TypedEventToEvent")
+	s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "emitted events")
+
+	resp, err := s.queryClient.TopicAnswer(s.ctx, &types.QueryTopicAnswerRequest{Topic: topic.Name})
+	s.Require().NoError(err, "TopicAnswer query")
+	s.Assert().Equal(expAggregate, resp.Aggregate, "TopicAnswer query aggregate")
+	s.Assert().Len(resp.Answers, 3, "TopicAnswer query answers")
+
+	// A new round makes answers to the previous round stale.
+	_, err = k.QueryTopic(s.ctx, topic.Name, []byte("{}"))
+	s.Require().NoError(err, "QueryTopic second round")
+	ack("channel-1", 1, `"99"`)
+	answers, err := k.GetTopicAnswers(s.ctx, topic.Name)
+	s.Require().NoError(err, "GetTopicAnswers")
+	s.Assert().Empty(answers, "answers after a stale ack")
+}
+
+func (s *KeeperTestSuite) TestSendRecurringQueries() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(keeper.MockScopedKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	k := s.app.OracleKeeper
+
+	recurring := types.OracleTopic{
+		Name:           "HASH/USD",
+		Sources:        []types.OracleSource{{Channel: "channel-1"}},
+		Method:         types.AggregationMethod_median,
+		Query:          []byte(`{"price":{}}`),
+		IntervalBlocks: 10,
+		MaxAgeBlocks:   15,
+	}
+	manual := types.OracleTopic{
+		Name:    "BTC/USD",
+		Sources: []types.OracleSource{{Channel: "channel-1"}},
+		Method:  types.AggregationMethod_median,
+	}
+	k.SetTopic(s.ctx, recurring)
+	k.SetTopic(s.ctx, manual)
+	recurringRound := k.GetTopicRound(s.ctx, recurring.Name)
+	manualRound := k.GetTopicRound(s.ctx, manual.Name)
+
+	k.SendRecurringQueries(s.ctx)
+	s.Assert().Equal(recurringRound+1, k.GetTopicRound(s.ctx, recurring.Name), "recurring topic round after first block")
+	s.Assert().Equal(s.ctx.BlockHeight(), k.GetTopicQueryHeight(s.ctx, recurring.Name), "recurring topic query height")
+	s.Assert().Equal(manualRound, k.GetTopicRound(s.ctx, manual.Name), "manual topic round")
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 9)
+	k.SendRecurringQueries(ctx)
+	s.Assert().Equal(recurringRound+1, k.GetTopicRound(ctx, recurring.Name), "recurring topic round before interval")
+
+	ack := func(ctx sdktypes.Context, seq uint64, data string) {
+		packet := channeltypes.Packet{Sequence: seq, SourceChannel: "channel-1", DestinationChannel: "oracle-channel"}
+		err := k.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(s.createICQResponse(s.app.AppCodec(), data)))
+		s.Require().NoError(err, "OnAcknowledgementPacket(%d)", seq)
+	}
+	ack(ctx, 1, `"3"`)
+
+	answer, err := k.GetFreshAggregatedAnswer(ctx, recurring.Name)
+	s.Require().NoError(err, "GetFreshAggregatedAnswer")
+	s.Assert().Equal("3.000000000000000000", answer.Value, "GetFreshAggregatedAnswer value")
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.SendRecurringQueries(ctx)
+	s.Assert().Equal(recurringRound+2, k.GetTopicRound(ctx, recurring.Name), "recurring topic round after interval")
+
+	ctx = ctx.WithBlockHeight(answer.Height + 16)
+	_, err = k.GetFreshAggregatedAnswer(ctx, recurring.Name)
+	s.Assert().EqualError(err, `topic "HASH/USD" aggregated answer from height 109 is stale`, "GetFreshAggregatedAnswer when stale")
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, k)
+	resp, err := types.NewQueryClient(queryHelper).TopicAnswer(ctx, &types.QueryTopicAnswerRequest{Topic: recurring.Name})
+	s.Require().NoError(err, "TopicAnswer query")
+	s.Assert().True(resp.Stale, "TopicAnswer query stale")
+}
+
+func (s *KeeperTestSuite) TestSendRecurringQueriesLimit() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(keeper.MockScopedKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	k := s.app.OracleKeeper
+
+	names := make([]string, keeper.MaxTopicChecksPerBlock+1)
+	for i := range names {
+		names[i] = fmt.Sprintf("TOPIC%03d/USD", i)
+		k.SetTopic(s.ctx, types.OracleTopic{
+			Name:           names[i],
+			Sources:        []types.OracleSource{{Channel: "channel-1"}},
+			Method:         types.AggregationMethod_median,
+			Query:          []byte(`{"price":{}}`),
+			IntervalBlocks: 10,
+		})
+	}
+	rounds := make([]uint64, len(names))
+	for i, name := range names {
+		rounds[i] = k.GetTopicRound(s.ctx, name)
+	}
+
+	k.SendRecurringQueries(s.ctx)
+	for i, name := range names[:keeper.MaxTopicChecksPerBlock] {
+		s.Assert().Equal(rounds[i]+1, k.GetTopicRound(s.ctx, name), "%s round after first block", name)
+	}
+	last := names[keeper.MaxTopicChecksPerBlock]
+	s.Assert().Equal(rounds[keeper.MaxTopicChecksPerBlock], k.GetTopicRound(s.ctx, last), "%s round after first block", last)
+
+	// The next block picks up where the last one left off, and wraps around without re-querying the others.
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	k.SendRecurringQueries(ctx)
+	s.Assert().Equal(rounds[keeper.MaxTopicChecksPerBlock]+1, k.GetTopicRound(ctx, last), "%s round after second block", last)
+	for i, name := range names[:keeper.MaxTopicChecksPerBlock] {
+		s.Assert().Equal(rounds[i]+1, k.GetTopicRound(ctx, name), "%s round after second block", name)
+	}
+}
+
+func (s *KeeperTestSuite) TestRecordTopicAnswerDiscardsChangesOnError() {
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockScopedKeeper(keeper.MockScopedKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockChannelKeeper(&keeper.MockChannelKeeper{})
+	s.app.OracleKeeper = s.app.OracleKeeper.WithMockICS4Wrapper(&keeper.MockICS4Wrapper{})
+	k := s.app.OracleKeeper
+
+	topic := types.OracleTopic{
+		Name:       "HASH/USD",
+		Sources:    []types.OracleSource{{Channel: "channel-1"}, {Channel: "channel-2"}},
+		Method:     types.AggregationMethod_median,
+		MinAnswers: 2,
+	}
+	k.SetTopic(s.ctx, topic)
+	round, err := k.QueryTopic(s.ctx, topic.Name, []byte("{}"))
+	s.Require().NoError(err, "QueryTopic")
+
+	// An unreadable answer from the second source makes aggregation fail.
+	bad := types.OracleAnswer{Topic: topic.Name, Source: 1, Value: "bad", Round: round}
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Set(types.GetAnswerStoreKey(topic.Name, 1), s.app.AppCodec().MustMarshal(&bad))
+
+	err = k.RecordTopicAnswer(s.ctx, "channel-1", 1, []byte(`"10"`))
+	s.Require().Error(err, "RecordTopicAnswer")
+	s.Assert().Contains(err.Error(), `invalid topic "HASH/USD" source 1 answer "bad"`, "RecordTopicAnswer error")
+
+	answers, err := k.GetTopicAnswers(s.ctx, topic.Name)
+	s.Require().NoError(err, "GetTopicAnswers")
+	s.Assert().Equal([]types.OracleAnswer{bad}, answers, "answers after a failed aggregation")
+
+	// The query was still used up.
+	s.Assert().NoError(k.RecordTopicAnswer(s.ctx, "channel-1", 1, []byte(`"10"`)), "RecordTopicAnswer again")
+	answers, err = k.GetTopicAnswers(s.ctx, topic.Name)
+	s.Require().NoError(err, "GetTopicAnswers again")
+	s.Assert().Equal([]types.OracleAnswer{bad}, answers, "answers after repeating the ack")
+}
@@ -35,7 +35,8 @@ var (
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 )
 
 // AppModuleBasic defines the basic application module used by the oracle module.
@@ -173,6 +174,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// BeginBlock is the `BeginBlocker` function run at the beginning of each block to
+// send the queries of recurring topics and bridged marker supplies that are due.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	am.keeper.SendRecurringQueries(sdkCtx)
+	am.keeper.SendBridgedSupplyQueries(sdkCtx)
+	return nil
+}
+
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,7 +14,7 @@ import (
 
 // NewDecodeStore returns a decoder function closure that unmarshalls the KVPair's
 // Value
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.Equal(kvA.Key[:1], types.OracleStoreKey):
@@ -24,6 +25,47 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 			attribB := string(kvB.Value)
 
 			return fmt.Sprintf("Port: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.TopicStoreKeyPrefix):
+			var attribA, attribB types.OracleTopic
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Topic: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.TopicQueryStoreKeyPrefix):
+			var attribA, attribB types.TopicQuery
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Topic Query: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.AnswerStoreKeyPrefix):
+			var attribA, attribB types.OracleAnswer
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Answer: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.AggregateStoreKeyPrefix):
+			var attribA, attribB types.AggregatedAnswer
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Aggregated Answer: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.TopicRoundStoreKeyPrefix):
+			return fmt.Sprintf("Topic Round: A:[%v] B:[%v]\n", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TopicQueryHeightStoreKeyPrefix):
+			return fmt.Sprintf("Topic Query Height: A:[%v] B:[%v]\n", int64(binary.BigEndian.Uint64(kvA.Value)), int64(binary.BigEndian.Uint64(kvB.Value)))
+		case bytes.Equal(kvA.Key[:1], types.PriceFeedStoreKeyPrefix):
+			var attribA, attribB types.PriceFeed
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Price Feed: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.PricePointStoreKeyPrefix):
+			var attribA, attribB types.PricePoint
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Price Point: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.TopicSubscriptionStoreKeyPrefix):
+			var attribA, attribB types.TopicSubscription
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+			return fmt.Sprintf("Topic Subscription: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.TopicCheckCursorStoreKey):
+			return fmt.Sprintf("Topic Check Cursor: A:[%v] B:[%v]\n", string(kvA.Value), string(kvB.Value))
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
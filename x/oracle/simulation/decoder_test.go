@@ -42,6 +42,30 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: types.GetPortStoreKey(), Value: []byte("88")},
 			exp:  "Port: A:[99] B:[88]\n",
 		},
+		{
+			name: "success - AggregateStoreKey",
+			kvA:  kv.Pair{Key: types.GetAggregateStoreKey("HASH/USD"), Value: cdc.MustMarshal(&types.AggregatedAnswer{Topic: "HASH/USD", Value: "1.5"})},
+			kvB:  kv.Pair{Key: types.GetAggregateStoreKey("HASH/USD"), Value: cdc.MustMarshal(&types.AggregatedAnswer{Topic: "HASH/USD", Value: "2.5"})},
+			exp:  "Aggregated Answer: A:[{HASH/USD 1.5 0 0 0}] B:[{HASH/USD 2.5 0 0 0}]\n",
+		},
+		{
+			name: "success - TopicSubscriptionStoreKey",
+			kvA:  kv.Pair{Key: types.GetTopicSubscriptionStoreKey("HASH/USD", "marker"), Value: cdc.MustMarshal(&types.TopicSubscription{Topic: "HASH/USD", Subscriber: "marker", MaxAgeBlocks: 5})},
+			kvB:  kv.Pair{Key: types.GetTopicSubscriptionStoreKey("HASH/USD", "marker"), Value: cdc.MustMarshal(&types.TopicSubscription{Topic: "HASH/USD", Subscriber: "marker"})},
+			exp:  "Topic Subscription: A:[{HASH/USD marker 5}] B:[{HASH/USD marker 0}]\n",
+		},
+		{
+			name: "success - TopicCheckCursorStoreKey",
+			kvA:  kv.Pair{Key: types.TopicCheckCursorStoreKey, Value: []byte("HASH/USD")},
+			kvB:  kv.Pair{Key: types.TopicCheckCursorStoreKey, Value: []byte("BTC/USD")},
+			exp:  "Topic Check Cursor: A:[HASH/USD] B:[BTC/USD]\n",
+		},
+		{
+			name: "success - TopicRoundStoreKey",
+			kvA:  kv.Pair{Key: types.GetTopicRoundStoreKey("HASH/USD"), Value: []byte{0, 0, 0, 0, 0, 0, 0, 3}},
+			kvB:  kv.Pair{Key: types.GetTopicRoundStoreKey("HASH/USD"), Value: []byte{0, 0, 0, 0, 0, 0, 0, 4}},
+			exp:  "Topic Round: A:[3] B:[4]\n",
+		},
 	}
 
 	for _, tc := range tests {
@@ -92,8 +92,12 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     0,
 			accounts: nil,
 			expOracleGen: &types.GenesisState{
-				PortId: "vipxlpbshz",
-				Oracle: "",
+				PortId:      "vipxlpbshz",
+				Oracle:      "",
+				Topics:      []types.OracleTopic{},
+				Aggregates:  []types.AggregatedAnswer{},
+				PriceFeeds:  []types.PriceFeed{},
+				PricePoints: []types.PricePoint{},
 			},
 		},
 		{
@@ -101,8 +105,12 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     1,
 			accounts: accs,
 			expOracleGen: &types.GenesisState{
-				PortId: "oracle",
-				Oracle: "",
+				PortId:      "oracle",
+				Oracle:      "",
+				Topics:      []types.OracleTopic{},
+				Aggregates:  []types.AggregatedAnswer{},
+				PriceFeeds:  []types.PriceFeed{},
+				PricePoints: []types.PricePoint{},
 			},
 		},
 		{
@@ -110,8 +118,12 @@ func TestRandomizedGenState(t *testing.T) {
 			seed:     2,
 			accounts: accs,
 			expOracleGen: &types.GenesisState{
-				PortId: "knxndtw",
-				Oracle: "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0",
+				PortId:      "knxndtw",
+				Oracle:      "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0",
+				Topics:      []types.OracleTopic{},
+				Aggregates:  []types.AggregatedAnswer{},
+				PriceFeeds:  []types.PriceFeed{},
+				PricePoints: []types.PricePoint{},
 			},
 		},
 	}
@@ -8,4 +8,9 @@ var (
 	ErrInvalidPacketTimeout = cerrs.Register(ModuleName, 3, "invalid packet timeout")
 	ErrInvalidVersion       = cerrs.Register(ModuleName, 4, "invalid version")
 	ErrMissingOracleAddress = cerrs.Register(ModuleName, 5, "missing oracle address")
+	ErrTopicNotFound        = cerrs.Register(ModuleName, 6, "oracle topic not found")
+	ErrPriceFeedNotFound    = cerrs.Register(ModuleName, 7, "price feed not found")
+	ErrUnauthorizedFeeder   = cerrs.Register(ModuleName, 8, "unauthorized feeder")
+	ErrFeederSuspended      = cerrs.Register(ModuleName, 9, "feeder suspended")
+	ErrNotSubscribed        = cerrs.Register(ModuleName, 10, "not subscribed to oracle topic")
 )
@@ -1,10 +1,14 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // ICS4Wrapper defines the expected ICS4Wrapper for middleware
@@ -38,3 +42,24 @@ type ScopedKeeper interface {
 	AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool
 	ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error
 }
+
+// MarkerKeeper defines the expected marker keeper for updating net asset values from price feeds and reconciling the
+// supplies of bridged markers
+type MarkerKeeper interface {
+	GetMarkerByDenom(ctx sdk.Context, denom string) (markertypes.MarkerAccountI, error)
+	SetNetAssetValue(ctx sdk.Context, marker markertypes.MarkerAccountI, netAssetValue markertypes.NetAssetValue, source string) error
+	IterateBridgedSupplies(ctx sdk.Context, cb func(bridged markertypes.BridgedSupply) (stop bool)) error
+	RecordBridgedSupplyBalance(ctx sdk.Context, denom string, remoteBalance sdkmath.Int) error
+}
+
+// TopicSubscriber defines a module that consumes the aggregated answers of the oracle topics it is subscribed to.
+// OnTopicAnswer is called each time one of those topics produces a new aggregated answer.
+type TopicSubscriber interface {
+	OnTopicAnswer(ctx sdk.Context, answer AggregatedAnswer) error
+}
+
+// PriceValidator defines a module that checks the prices submitted to price feeds.
+// ValidatePrice returns the reason the price is invalid, or an empty string if it's valid.
+type PriceValidator interface {
+	ValidatePrice(ctx sdk.Context, feed PriceFeed, feeder sdk.AccAddress, price sdkmath.LegacyDec) string
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
@@ -29,5 +31,94 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	topics := make(map[string]bool, len(gs.Topics))
+	for _, topic := range gs.Topics {
+		if err = topic.Validate(); err != nil {
+			return err
+		}
+		if topics[topic.Name] {
+			return fmt.Errorf("duplicate topic %q", topic.Name)
+		}
+		topics[topic.Name] = true
+	}
+
+	aggregates := make(map[string]bool, len(gs.Aggregates))
+	for _, aggregate := range gs.Aggregates {
+		if err = aggregate.Validate(); err != nil {
+			return err
+		}
+		if !topics[aggregate.Topic] {
+			return fmt.Errorf("aggregated answer for unknown topic %q", aggregate.Topic)
+		}
+		if aggregates[aggregate.Topic] {
+			return fmt.Errorf("duplicate aggregated answer for topic %q", aggregate.Topic)
+		}
+		aggregates[aggregate.Topic] = true
+	}
+
+	subscriptions := make(map[string]bool, len(gs.Subscriptions))
+	for _, sub := range gs.Subscriptions {
+		if err = sub.Validate(); err != nil {
+			return err
+		}
+		if !topics[sub.Topic] {
+			return fmt.Errorf("subscription to unknown topic %q", sub.Topic)
+		}
+		key := sub.Topic + " " + sub.Subscriber
+		if subscriptions[key] {
+			return fmt.Errorf("duplicate subscription to topic %q by %s", sub.Topic, sub.Subscriber)
+		}
+		subscriptions[key] = true
+	}
+
+	feeds := make(map[string]PriceFeed, len(gs.PriceFeeds))
+	for _, feed := range gs.PriceFeeds {
+		if err = feed.Validate(); err != nil {
+			return err
+		}
+		if _, dup := feeds[feed.Symbol]; dup {
+			return fmt.Errorf("duplicate price feed %q", feed.Symbol)
+		}
+		feeds[feed.Symbol] = feed
+	}
+
+	points := make(map[string]bool, len(gs.PricePoints))
+	for _, point := range gs.PricePoints {
+		if err = point.Validate(); err != nil {
+			return err
+		}
+		feed, known := feeds[point.Symbol]
+		if !known {
+			return fmt.Errorf("price point for unknown price feed %q", point.Symbol)
+		}
+		if !feed.HasFeeder(point.Feeder) {
+			return fmt.Errorf("price point for price feed %q from unknown feeder %s", point.Symbol, point.Feeder)
+		}
+		key := point.Symbol + " " + point.Feeder
+		if points[key] {
+			return fmt.Errorf("duplicate price point for price feed %q from feeder %s", point.Symbol, point.Feeder)
+		}
+		points[key] = true
+	}
+
+	violations := make(map[string]bool, len(gs.FeederViolations))
+	for _, fv := range gs.FeederViolations {
+		if err = fv.Validate(); err != nil {
+			return err
+		}
+		feed, known := feeds[fv.Symbol]
+		if !known {
+			return fmt.Errorf("feeder violations for unknown price feed %q", fv.Symbol)
+		}
+		if !feed.HasFeeder(fv.Feeder) {
+			return fmt.Errorf("feeder violations for price feed %q from unknown feeder %s", fv.Symbol, fv.Feeder)
+		}
+		key := fv.Symbol + " " + fv.Feeder
+		if violations[key] {
+			return fmt.Errorf("duplicate feeder violations for price feed %q from feeder %s", fv.Symbol, fv.Feeder)
+		}
+		violations[key] = true
+	}
+
 	return nil
 }
@@ -29,6 +29,18 @@ type GenesisState struct {
 	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// The address of the oracle
 	Oracle string `protobuf:"bytes,3,opt,name=oracle,proto3" json:"oracle,omitempty"`
+	// The oracle topics
+	Topics []OracleTopic `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics"`
+	// The most recent aggregated answer of each topic
+	Aggregates []AggregatedAnswer `protobuf:"bytes,5,rep,name=aggregates,proto3" json:"aggregates"`
+	// The price feeds
+	PriceFeeds []PriceFeed `protobuf:"bytes,6,rep,name=price_feeds,json=priceFeeds,proto3" json:"price_feeds"`
+	// The latest price point from each feeder of each price feed
+	PricePoints []PricePoint `protobuf:"bytes,7,rep,name=price_points,json=pricePoints,proto3" json:"price_points"`
+	// The recent invalid prices of the feeders of each price feed
+	FeederViolations []FeederViolations `protobuf:"bytes,8,rep,name=feeder_violations,json=feederViolations,proto3" json:"feeder_violations"`
+	// The modules subscribed to each topic
+	Subscriptions []TopicSubscription `protobuf:"bytes,9,rep,name=subscriptions,proto3" json:"subscriptions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,21 +85,33 @@ func init() {
 }
 
 var fileDescriptor_f8d8aecd974cfd80 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0x95, 0x3c, 0xb9, 0x78, 0xdc, 0x21, 0x9a, 0x83, 0x4b, 0x12, 0x4b,
-	0x52, 0x85, 0xc4, 0xb9, 0xd8, 0x0b, 0xf2, 0x8b, 0x4a, 0xe2, 0x33, 0x53, 0x24, 0x98, 0x14, 0x18,
-	0x35, 0x38, 0x83, 0xd8, 0x40, 0x5c, 0xcf, 0x14, 0x21, 0x31, 0x2e, 0x36, 0x88, 0x59, 0x12, 0xcc,
-	0x10, 0x71, 0x08, 0xcf, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0xe9,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0, 0x25, 0x9e, 0x09, 0xb6, 0x0e, 0xc3,
-	0x4d, 0x01, 0x8c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0x08, 0x25, 0xba, 0x99, 0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0x98, 0x57, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x4e, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x6d, 0x21, 0xbd, 0x0c,
-	0x01, 0x00, 0x00,
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8f, 0xd2, 0x40,
+	0x18, 0x86, 0x5b, 0x17, 0xbb, 0xbb, 0xc3, 0x9a, 0xe8, 0x64, 0xe3, 0x36, 0x1c, 0x5a, 0xe0, 0xa0,
+	0x5c, 0x6c, 0x03, 0xde, 0xbc, 0x18, 0x38, 0x60, 0x48, 0x4c, 0x24, 0x60, 0x4c, 0xf4, 0x42, 0x4a,
+	0xfb, 0x31, 0x4e, 0x82, 0x9d, 0xc9, 0xcc, 0x50, 0xf5, 0x1f, 0x78, 0xf4, 0xee, 0x85, 0x9f, 0xc3,
+	0x91, 0xa3, 0x27, 0x63, 0xe0, 0xe2, 0xcf, 0x30, 0x9d, 0x69, 0x01, 0x4d, 0xc3, 0x6d, 0xbe, 0x37,
+	0xcf, 0xfb, 0xcc, 0xb4, 0xf9, 0x50, 0x9b, 0x0b, 0x96, 0x41, 0x1a, 0xa5, 0x31, 0x84, 0x4c, 0x44,
+	0xf1, 0x12, 0xc2, 0xac, 0x1b, 0x12, 0x48, 0x41, 0x52, 0x19, 0x70, 0xc1, 0x14, 0xc3, 0xb7, 0x47,
+	0x26, 0x30, 0x4c, 0x90, 0x75, 0x1b, 0xb7, 0x84, 0x11, 0xa6, 0x81, 0x30, 0x3f, 0x19, 0xb6, 0xd1,
+	0xaa, 0xf4, 0x15, 0x2d, 0x8d, 0xb4, 0x7f, 0xd4, 0xd0, 0xcd, 0x2b, 0x73, 0xc1, 0x54, 0x45, 0x0a,
+	0xf0, 0x1d, 0xba, 0xe4, 0x4c, 0xa8, 0x19, 0x4d, 0xdc, 0x7b, 0x4d, 0xbb, 0x73, 0x3d, 0x71, 0xf2,
+	0x71, 0x94, 0xe0, 0xc7, 0xc8, 0x31, 0x4d, 0xf7, 0xc2, 0xe4, 0x66, 0xc2, 0x2f, 0x91, 0xa3, 0x18,
+	0xa7, 0xb1, 0x74, 0x6b, 0xcd, 0x8b, 0x4e, 0xbd, 0xd7, 0x0a, 0xaa, 0x5e, 0x18, 0xbc, 0xd1, 0xa7,
+	0xb7, 0x39, 0x39, 0xa8, 0x6d, 0x7e, 0xf9, 0xd6, 0xa4, 0xa8, 0xe1, 0xd7, 0x08, 0x45, 0x84, 0x08,
+	0x20, 0x91, 0x02, 0xe9, 0xde, 0xd7, 0x92, 0x27, 0xd5, 0x92, 0x7e, 0xc9, 0x25, 0xfd, 0x54, 0x7e,
+	0x06, 0x51, 0x98, 0x4e, 0xfa, 0x78, 0x88, 0xea, 0x5c, 0xd0, 0x18, 0x66, 0x0b, 0x80, 0x44, 0xba,
+	0x8e, 0xd6, 0xf9, 0xd5, 0xba, 0x71, 0x0e, 0x0e, 0x01, 0x92, 0xd2, 0xc3, 0xcb, 0x40, 0xe2, 0x11,
+	0xba, 0x31, 0x1e, 0xce, 0x68, 0xaa, 0xa4, 0x7b, 0xa9, 0x45, 0xcd, 0x33, 0xa2, 0x71, 0x0e, 0x16,
+	0xa6, 0x3a, 0x3f, 0x24, 0x12, 0xbf, 0x47, 0x8f, 0xf2, 0xc7, 0x80, 0x98, 0x65, 0x94, 0x2d, 0x23,
+	0x45, 0x59, 0x2a, 0xdd, 0xab, 0x73, 0xdf, 0x39, 0xd4, 0xf8, 0xbb, 0x03, 0x5d, 0x58, 0x1f, 0x2e,
+	0xfe, 0xcb, 0xf1, 0x14, 0x3d, 0x90, 0xab, 0xb9, 0x8c, 0x05, 0xe5, 0x46, 0x7b, 0xad, 0xb5, 0x4f,
+	0xab, 0xb5, 0xfa, 0xef, 0x4f, 0x4f, 0xf8, 0xc2, 0xfb, 0xaf, 0xe3, 0xc5, 0xd5, 0xb7, 0xb5, 0x6f,
+	0xfd, 0x59, 0xfb, 0xd6, 0x80, 0x6c, 0x76, 0x9e, 0xbd, 0xdd, 0x79, 0xf6, 0xef, 0x9d, 0x67, 0x7f,
+	0xdf, 0x7b, 0xd6, 0x76, 0xef, 0x59, 0x3f, 0xf7, 0x9e, 0x85, 0xee, 0x28, 0xab, 0xbc, 0x63, 0x6c,
+	0x7f, 0xe8, 0x11, 0xaa, 0x3e, 0xae, 0xe6, 0x41, 0xcc, 0x3e, 0x85, 0x47, 0xe4, 0x19, 0x65, 0x27,
+	0x53, 0xf8, 0xa5, 0x5c, 0x48, 0xf5, 0x95, 0x83, 0x9c, 0x3b, 0x7a, 0x1b, 0x9f, 0xff, 0x1d, 0x00,
+	0x5b, 0x9c, 0x26, 0xdb, 0x02, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -110,6 +134,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FeederViolations) > 0 {
+		for iNdEx := len(m.FeederViolations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeederViolations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PricePoints) > 0 {
+		for iNdEx := len(m.PricePoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PricePoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PriceFeeds) > 0 {
+		for iNdEx := len(m.PriceFeeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceFeeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Aggregates) > 0 {
+		for iNdEx := len(m.Aggregates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aggregates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Oracle) > 0 {
 		i -= len(m.Oracle)
 		copy(dAtA[i:], m.Oracle)
@@ -152,6 +260,42 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Aggregates) > 0 {
+		for _, e := range m.Aggregates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceFeeds) > 0 {
+		for _, e := range m.PriceFeeds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PricePoints) > 0 {
+		for _, e := range m.PricePoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeederViolations) > 0 {
+		for _, e := range m.FeederViolations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -254,6 +398,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Oracle = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, OracleTopic{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregates = append(m.Aggregates, AggregatedAnswer{})
+			if err := m.Aggregates[len(m.Aggregates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFeeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceFeeds = append(m.PriceFeeds, PriceFeed{})
+			if err := m.PriceFeeds[len(m.PriceFeeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricePoints = append(m.PricePoints, PricePoint{})
+			if err := m.PricePoints[len(m.PricePoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederViolations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederViolations = append(m.FeederViolations, FeederViolations{})
+			if err := m.FeederViolations[len(m.FeederViolations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, TopicSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,6 +23,12 @@ func TestDefaultGenesis(t *testing.T) {
 }
 
 func TestGenesisValidate(t *testing.T) {
+	topic := OracleTopic{
+		Name:    "HASH/USD",
+		Sources: []OracleSource{{Channel: "channel-1"}},
+		Method:  AggregationMethod_median,
+	}
+
 	tests := []struct {
 		name  string
 		state *GenesisState
@@ -46,6 +52,95 @@ func TestGenesisValidate(t *testing.T) {
 			state: NewGenesisState(PortID, "abc"),
 			err:   "decoding bech32 failed: invalid bech32 string length 3",
 		},
+		{
+			name: "success - price feed with price point",
+			state: &GenesisState{
+				PortId:      PortID,
+				PriceFeeds:  []PriceFeed{{Symbol: "HASH/USD", Feeders: []string{"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}}},
+				PricePoints: []PricePoint{{Symbol: "HASH/USD", Feeder: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Price: "0.025"}},
+			},
+		},
+		{
+			name: "failure - price point for unknown price feed",
+			state: &GenesisState{
+				PortId:      PortID,
+				PricePoints: []PricePoint{{Symbol: "HASH/USD", Feeder: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Price: "0.025"}},
+			},
+			err: `price point for unknown price feed "HASH/USD"`,
+		},
+		{
+			name: "failure - price point from unknown feeder",
+			state: &GenesisState{
+				PortId:      PortID,
+				PriceFeeds:  []PriceFeed{{Symbol: "HASH/USD", Feeders: []string{"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}}},
+				PricePoints: []PricePoint{{Symbol: "HASH/USD", Feeder: "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0", Price: "0.025"}},
+			},
+			err: `price point for price feed "HASH/USD" from unknown feeder cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0`,
+		},
+		{
+			name: "success - price feed with feeder violations",
+			state: &GenesisState{
+				PortId:           PortID,
+				PriceFeeds:       []PriceFeed{{Symbol: "HASH/USD", Feeders: []string{"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}}},
+				FeederViolations: []FeederViolations{{Symbol: "HASH/USD", Feeder: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Violations: []PriceViolation{{Price: "5", Reason: "too high"}}, Suspended: true}},
+			},
+		},
+		{
+			name: "failure - feeder violations from unknown feeder",
+			state: &GenesisState{
+				PortId:           PortID,
+				PriceFeeds:       []PriceFeed{{Symbol: "HASH/USD", Feeders: []string{"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}}},
+				FeederViolations: []FeederViolations{{Symbol: "HASH/USD", Feeder: "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0", Violations: []PriceViolation{{Price: "5", Reason: "too high"}}}},
+			},
+			err: `feeder violations for price feed "HASH/USD" from unknown feeder cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0`,
+		},
+		{
+			name: "failure - duplicate feeder violations",
+			state: &GenesisState{
+				PortId:     PortID,
+				PriceFeeds: []PriceFeed{{Symbol: "HASH/USD", Feeders: []string{"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}}},
+				FeederViolations: []FeederViolations{
+					{Symbol: "HASH/USD", Feeder: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Violations: []PriceViolation{{Price: "5", Reason: "too high"}}},
+					{Symbol: "HASH/USD", Feeder: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Violations: []PriceViolation{{Price: "6", Reason: "too high"}}},
+				},
+			},
+			err: `duplicate feeder violations for price feed "HASH/USD" from feeder cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma`,
+		},
+		{
+			name: "success - topic with subscriptions",
+			state: &GenesisState{
+				PortId:        PortID,
+				Topics:        []OracleTopic{topic},
+				Subscriptions: []TopicSubscription{{Topic: "HASH/USD", Subscriber: "marker", MaxAgeBlocks: 10}, {Topic: "HASH/USD", Subscriber: "exchange"}},
+			},
+		},
+		{
+			name: "failure - invalid subscription",
+			state: &GenesisState{
+				PortId:        PortID,
+				Topics:        []OracleTopic{topic},
+				Subscriptions: []TopicSubscription{{Topic: "HASH/USD"}},
+			},
+			err: `topic "HASH/USD" subscriber cannot be empty`,
+		},
+		{
+			name: "failure - subscription to unknown topic",
+			state: &GenesisState{
+				PortId:        PortID,
+				Topics:        []OracleTopic{topic},
+				Subscriptions: []TopicSubscription{{Topic: "BTC/USD", Subscriber: "marker"}},
+			},
+			err: `subscription to unknown topic "BTC/USD"`,
+		},
+		{
+			name: "failure - duplicate subscription",
+			state: &GenesisState{
+				PortId:        PortID,
+				Topics:        []OracleTopic{topic},
+				Subscriptions: []TopicSubscription{{Topic: "HASH/USD", Subscriber: "marker"}, {Topic: "HASH/USD", Subscriber: "marker", MaxAgeBlocks: 5}},
+			},
+			err: `duplicate subscription to topic "HASH/USD" by marker`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1,6 +1,12 @@
 package types
 
-import icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v8/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -31,11 +37,103 @@ const (
 //	PortStoreKey
 //	- 0x02: string
 //	  | 1 |
+//
+//
+//	TopicStoreKey
+//	- 0x03<topic_len><topic>: OracleTopic
+//	  | 1 | 1 | N |
+//
+//
+//	TopicQueryStoreKey
+//	- 0x04<channel_len><channel><sequence>: TopicQuery
+//	  | 1 | 1 | N | 8 |
+//
+//
+//	AnswerStoreKey
+//	- 0x05<topic_len><topic><source>: OracleAnswer
+//	  | 1 | 1 | N | 4 |
+//
+//
+//	AggregateStoreKey
+//	- 0x06<topic_len><topic>: AggregatedAnswer
+//	  | 1 | 1 | N |
+//
+//
+//	TopicRoundStoreKey
+//	- 0x07<topic_len><topic>: uint64
+//	  | 1 | 1 | N |
+//
+//
+//	TopicQueryHeightStoreKey
+//	- 0x08<topic_len><topic>: int64
+//	  | 1 | 1 | N |
+//
+//
+//	PriceFeedStoreKey
+//	- 0x09<symbol_len><symbol>: PriceFeed
+//	  | 1 | 1 | N |
+//
+//
+//	PricePointStoreKey
+//	- 0x0A<symbol_len><symbol><feeder_len><feeder>: PricePoint
+//	  | 1 | 1 | N | 1 | N |
+//
+//
+//	FeederViolationsStoreKey
+//	- 0x0B<symbol_len><symbol><feeder_len><feeder>: FeederViolations
+//	  | 1 | 1 | N | 1 | N |
+//
+//
+//	BridgedSupplyQueryHeightStoreKey
+//	- 0x0C<denom_len><denom>: int64
+//	  | 1 | 1 | N |
+//
+//
+//	BridgedSupplyQueryStoreKey
+//	- 0x0D<channel_len><channel><sequence>: string
+//	  | 1 | 1 | N | 8 |
+//
+//
+//	TopicSubscriptionStoreKey
+//	- 0x0E<topic_len><topic><subscriber_len><subscriber>: TopicSubscription
+//	  | 1 | 1 | N | 1 | N |
+//
+//
+//	TopicCheckCursorStoreKey
+//	- 0x0F: string
+//	  | 1 |
 var (
 	// OracleStoreKey is the key for the module's oracle address
 	OracleStoreKey = []byte{0x01}
 	// PortStoreKey defines the key to store the port ID in store
 	PortStoreKey = []byte{0x02}
+	// TopicStoreKeyPrefix is the prefix for the oracle topics
+	TopicStoreKeyPrefix = []byte{0x03}
+	// TopicQueryStoreKeyPrefix is the prefix for the outstanding topic queries
+	TopicQueryStoreKeyPrefix = []byte{0x04}
+	// AnswerStoreKeyPrefix is the prefix for the answers received from a topic's sources
+	AnswerStoreKeyPrefix = []byte{0x05}
+	// AggregateStoreKeyPrefix is the prefix for the aggregated answer of each topic
+	AggregateStoreKeyPrefix = []byte{0x06}
+	// TopicRoundStoreKeyPrefix is the prefix for the current query round of each topic
+	TopicRoundStoreKeyPrefix = []byte{0x07}
+	// TopicQueryHeightStoreKeyPrefix is the prefix for the block height each topic was last queried at
+	TopicQueryHeightStoreKeyPrefix = []byte{0x08}
+	// PriceFeedStoreKeyPrefix is the prefix for the price feeds
+	PriceFeedStoreKeyPrefix = []byte{0x09}
+	// PricePointStoreKeyPrefix is the prefix for the latest price point from each feeder of a price feed
+	PricePointStoreKeyPrefix = []byte{0x0A}
+	// FeederViolationsStoreKeyPrefix is the prefix for the recent invalid prices from each feeder of a price feed
+	FeederViolationsStoreKeyPrefix = []byte{0x0B}
+	// BridgedSupplyQueryHeightStoreKeyPrefix is the prefix for the block height each bridged marker's locked balance
+	// was last queried at
+	BridgedSupplyQueryHeightStoreKeyPrefix = []byte{0x0C}
+	// BridgedSupplyQueryStoreKeyPrefix is the prefix for the outstanding bridged marker locked balance queries
+	BridgedSupplyQueryStoreKeyPrefix = []byte{0x0D}
+	// TopicSubscriptionStoreKeyPrefix is the prefix for the modules subscribed to each topic
+	TopicSubscriptionStoreKeyPrefix = []byte{0x0E}
+	// TopicCheckCursorStoreKey is the key for the name of the last topic checked for a recurring query
+	TopicCheckCursorStoreKey = []byte{0x0F}
 )
 
 // GetOracleStoreKey is a function to get the key for the oracle's address in store
@@ -47,3 +145,93 @@ func GetOracleStoreKey() []byte {
 func GetPortStoreKey() []byte {
 	return PortStoreKey
 }
+
+// prefixedName creates a key with the provided prefix followed by the length prefixed name.
+func prefixedName(prefix []byte, name string) []byte {
+	lpName := address.MustLengthPrefix([]byte(name))
+	rv := make([]byte, 0, len(prefix)+len(lpName))
+	rv = append(rv, prefix...)
+	return append(rv, lpName...)
+}
+
+// GetTopicStoreKey is a function to get the key for an oracle topic in store
+func GetTopicStoreKey(topic string) []byte {
+	return prefixedName(TopicStoreKeyPrefix, topic)
+}
+
+// GetTopicQueryStoreKey is a function to get the key for an outstanding topic query in store
+func GetTopicQueryStoreKey(channel string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(prefixedName(TopicQueryStoreKeyPrefix, channel), sequence)
+}
+
+// GetAnswerStoreKeyPrefix is a function to get the prefix for all of a topic's answers in store
+func GetAnswerStoreKeyPrefix(topic string) []byte {
+	return prefixedName(AnswerStoreKeyPrefix, topic)
+}
+
+// GetAnswerStoreKey is a function to get the key for the answer of a topic's source in store
+func GetAnswerStoreKey(topic string, source uint32) []byte {
+	return binary.BigEndian.AppendUint32(GetAnswerStoreKeyPrefix(topic), source)
+}
+
+// GetAggregateStoreKey is a function to get the key for a topic's aggregated answer in store
+func GetAggregateStoreKey(topic string) []byte {
+	return prefixedName(AggregateStoreKeyPrefix, topic)
+}
+
+// GetTopicRoundStoreKey is a function to get the key for a topic's current query round in store
+func GetTopicRoundStoreKey(topic string) []byte {
+	return prefixedName(TopicRoundStoreKeyPrefix, topic)
+}
+
+// GetTopicQueryHeightStoreKey is a function to get the key for the block height a topic was last queried at in store
+func GetTopicQueryHeightStoreKey(topic string) []byte {
+	return prefixedName(TopicQueryHeightStoreKeyPrefix, topic)
+}
+
+// GetPriceFeedStoreKey is a function to get the key for a price feed in store
+func GetPriceFeedStoreKey(symbol string) []byte {
+	return prefixedName(PriceFeedStoreKeyPrefix, symbol)
+}
+
+// GetPricePointStoreKeyPrefix is a function to get the prefix for all of a price feed's price points in store
+func GetPricePointStoreKeyPrefix(symbol string) []byte {
+	return prefixedName(PricePointStoreKeyPrefix, symbol)
+}
+
+// GetPricePointStoreKey is a function to get the key for a feeder's price point in store
+func GetPricePointStoreKey(symbol string, feeder sdk.AccAddress) []byte {
+	return append(GetPricePointStoreKeyPrefix(symbol), address.MustLengthPrefix(feeder)...)
+}
+
+// GetFeederViolationsStoreKeyPrefix is a function to get the prefix for all of a price feed's feeder violations in store
+func GetFeederViolationsStoreKeyPrefix(symbol string) []byte {
+	return prefixedName(FeederViolationsStoreKeyPrefix, symbol)
+}
+
+// GetFeederViolationsStoreKey is a function to get the key for a feeder's violations in store
+func GetFeederViolationsStoreKey(symbol string, feeder sdk.AccAddress) []byte {
+	return append(GetFeederViolationsStoreKeyPrefix(symbol), address.MustLengthPrefix(feeder)...)
+}
+
+// GetBridgedSupplyQueryHeightStoreKey is a function to get the key for the block height a bridged marker's locked
+// balance was last queried at in store
+func GetBridgedSupplyQueryHeightStoreKey(denom string) []byte {
+	return prefixedName(BridgedSupplyQueryHeightStoreKeyPrefix, denom)
+}
+
+// GetBridgedSupplyQueryStoreKey is a function to get the key for an outstanding bridged marker locked balance query
+// in store
+func GetBridgedSupplyQueryStoreKey(channel string, sequence uint64) []byte {
+	return binary.BigEndian.AppendUint64(prefixedName(BridgedSupplyQueryStoreKeyPrefix, channel), sequence)
+}
+
+// GetTopicSubscriptionStoreKeyPrefix is a function to get the prefix for all of a topic's subscriptions in store
+func GetTopicSubscriptionStoreKeyPrefix(topic string) []byte {
+	return prefixedName(TopicSubscriptionStoreKeyPrefix, topic)
+}
+
+// GetTopicSubscriptionStoreKey is a function to get the key for a module's subscription to a topic in store
+func GetTopicSubscriptionStoreKey(topic, subscriber string) []byte {
+	return append(GetTopicSubscriptionStoreKeyPrefix(topic), address.MustLengthPrefix([]byte(subscriber))...)
+}
@@ -15,3 +15,12 @@ func TestGetPortStoreKey(t *testing.T) {
 	key := GetPortStoreKey()
 	assert.EqualValues(t, PortStoreKey, key[0:1], "must return correct port key")
 }
+
+func TestGetTopicSubscriptionStoreKey(t *testing.T) {
+	key := GetTopicSubscriptionStoreKey("HASH/USD", "marker")
+	exp := append([]byte{0x0E, 8}, "HASH/USD"...)
+	exp = append(exp, 6)
+	exp = append(exp, "marker"...)
+	assert.Equal(t, exp, key, "GetTopicSubscriptionStoreKey")
+	assert.Equal(t, exp[:10], GetTopicSubscriptionStoreKeyPrefix("HASH/USD"), "GetTopicSubscriptionStoreKeyPrefix")
+}
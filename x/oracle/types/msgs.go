@@ -11,6 +11,12 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgUpdateOracleRequest)(nil),
 	(*MsgSendQueryOracleRequest)(nil),
+	(*MsgUpdateOracleTopicRequest)(nil),
+	(*MsgSendTopicQueryRequest)(nil),
+	(*MsgUpdateTopicSubscriptionRequest)(nil),
+	(*MsgUpdatePriceFeedRequest)(nil),
+	(*MsgSubmitPriceRequest)(nil),
+	(*MsgReinstateFeederRequest)(nil),
 }
 
 // NewMsgSendQueryOracle creates a new MsgSendQueryOracleRequest
@@ -54,3 +60,130 @@ func (msg MsgUpdateOracleRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgUpdateOracleTopic creates a new MsgUpdateOracleTopicRequest
+func NewMsgUpdateOracleTopic(creator string, topic OracleTopic) *MsgUpdateOracleTopicRequest {
+	return &MsgUpdateOracleTopicRequest{
+		Authority: creator,
+		Topic:     topic,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUpdateOracleTopicRequest) ValidateBasic() error {
+	if err := msg.Topic.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	return nil
+}
+
+// NewMsgSendTopicQuery creates a new MsgSendTopicQueryRequest
+func NewMsgSendTopicQuery(creator, topic string, query []byte) *MsgSendTopicQueryRequest {
+	return &MsgSendTopicQueryRequest{
+		Authority: creator,
+		Topic:     topic,
+		Query:     query,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSendTopicQueryRequest) ValidateBasic() error {
+	if err := ValidateTopicName(msg.Topic); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	if err := msg.Query.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid query data: %w", err)
+	}
+	return nil
+}
+
+// NewMsgUpdateTopicSubscription creates a new MsgUpdateTopicSubscriptionRequest
+func NewMsgUpdateTopicSubscription(creator string, subscription TopicSubscription, remove bool) *MsgUpdateTopicSubscriptionRequest {
+	return &MsgUpdateTopicSubscriptionRequest{
+		Authority:    creator,
+		Subscription: subscription,
+		Remove:       remove,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUpdateTopicSubscriptionRequest) ValidateBasic() error {
+	if err := msg.Subscription.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	return nil
+}
+
+// NewMsgUpdatePriceFeed creates a new MsgUpdatePriceFeedRequest
+func NewMsgUpdatePriceFeed(creator string, feed PriceFeed) *MsgUpdatePriceFeedRequest {
+	return &MsgUpdatePriceFeedRequest{
+		Authority: creator,
+		Feed:      feed,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUpdatePriceFeedRequest) ValidateBasic() error {
+	if err := msg.Feed.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	return nil
+}
+
+// NewMsgSubmitPrice creates a new MsgSubmitPriceRequest
+func NewMsgSubmitPrice(feeder, symbol, price string) *MsgSubmitPriceRequest {
+	return &MsgSubmitPriceRequest{
+		Feeder: feeder,
+		Symbol: symbol,
+		Price:  price,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSubmitPriceRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return fmt.Errorf("invalid feeder address: %w", err)
+	}
+	if err := ValidateSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if _, err := ParsePrice(msg.Price); err != nil {
+		return err
+	}
+	return nil
+}
+
+// NewMsgReinstateFeeder creates a new MsgReinstateFeederRequest
+func NewMsgReinstateFeeder(authority, symbol, feeder string) *MsgReinstateFeederRequest {
+	return &MsgReinstateFeederRequest{
+		Authority: authority,
+		Symbol:    symbol,
+		Feeder:    feeder,
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgReinstateFeederRequest) ValidateBasic() error {
+	if err := ValidateSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return fmt.Errorf("invalid feeder address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,12 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgUpdateOracleRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSendQueryOracleRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateOracleTopicRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSendTopicQueryRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateTopicSubscriptionRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdatePriceFeedRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSubmitPriceRequest{Feeder: signer} },
+		func(signer string) sdk.Msg { return &MsgReinstateFeederRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -113,3 +120,208 @@ func TestMsgSendQueryOracleRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateOracleTopicRequestValidateBasic(t *testing.T) {
+	topic := OracleTopic{
+		Name:    "HASH/USD",
+		Sources: []OracleSource{{Channel: "channel-1"}, {Channel: "channel-2"}},
+		Method:  AggregationMethod_median,
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgUpdateOracleTopicRequest
+		err  string
+	}{
+		{
+			name: "success - all fields are valid",
+			msg:  NewMsgUpdateOracleTopic("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", topic),
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgUpdateOracleTopic("jackthecat", topic),
+			err:  "invalid authority address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - invalid topic",
+			msg:  NewMsgUpdateOracleTopic("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", OracleTopic{Name: "HASH/USD"}),
+			err:  `topic "HASH/USD" must have at least one source`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "MsgUpdateOracleTopicRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, res, "MsgUpdateOracleTopicRequest.ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgUpdateTopicSubscriptionRequestValidateBasic(t *testing.T) {
+	sub := TopicSubscription{Topic: "HASH/USD", Subscriber: "marker", MaxAgeBlocks: 10}
+
+	tests := []struct {
+		name string
+		msg  *MsgUpdateTopicSubscriptionRequest
+		err  string
+	}{
+		{
+			name: "success - all fields are valid",
+			msg:  NewMsgUpdateTopicSubscription("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", sub, false),
+		},
+		{
+			name: "success - remove",
+			msg:  NewMsgUpdateTopicSubscription("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", TopicSubscription{Topic: "HASH/USD", Subscriber: "marker"}, true),
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgUpdateTopicSubscription("jackthecat", sub, false),
+			err:  "invalid authority address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - invalid topic",
+			msg:  NewMsgUpdateTopicSubscription("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", TopicSubscription{Subscriber: "marker"}, false),
+			err:  "topic name cannot be empty",
+		},
+		{
+			name: "failure - subscriber too long",
+			msg:  NewMsgUpdateTopicSubscription("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", TopicSubscription{Topic: "HASH/USD", Subscriber: strings.Repeat("m", 33)}, false),
+			err:  `topic "HASH/USD" subscriber length 33 exceeds maximum length of 32`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "MsgUpdateTopicSubscriptionRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, res, "MsgUpdateTopicSubscriptionRequest.ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgSendTopicQueryRequestValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSendTopicQueryRequest
+		err  string
+	}{
+		{
+			name: "success - all fields are valid",
+			msg:  NewMsgSendTopicQuery("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "HASH/USD", []byte("{}")),
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgSendTopicQuery("jackthecat", "HASH/USD", []byte("{}")),
+			err:  "invalid authority address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - empty topic",
+			msg:  NewMsgSendTopicQuery("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "", []byte("{}")),
+			err:  "topic name cannot be empty",
+		},
+		{
+			name: "failure - invalid query",
+			msg:  NewMsgSendTopicQuery("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "HASH/USD", []byte{}),
+			err:  "invalid query data: invalid",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "MsgSendTopicQueryRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, res, "MsgSendTopicQueryRequest.ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgSubmitPriceRequestValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgSubmitPriceRequest
+		err  string
+	}{
+		{
+			name: "success - all fields are valid",
+			msg:  NewMsgSubmitPrice("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "HASH/USD", "0.025"),
+		},
+		{
+			name: "failure - invalid feeder",
+			msg:  NewMsgSubmitPrice("jackthecat", "HASH/USD", "0.025"),
+			err:  "invalid feeder address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - empty symbol",
+			msg:  NewMsgSubmitPrice("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "", "0.025"),
+			err:  "symbol cannot be empty",
+		},
+		{
+			name: "failure - zero price",
+			msg:  NewMsgSubmitPrice("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", "HASH/USD", "0"),
+			err:  `invalid price "0": must be positive`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "MsgSubmitPriceRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, res, "MsgSubmitPriceRequest.ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgReinstateFeederRequestValidateBasic(t *testing.T) {
+	authority := "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+	feeder := "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+
+	tests := []struct {
+		name string
+		msg  *MsgReinstateFeederRequest
+		err  string
+	}{
+		{
+			name: "success - all fields are valid",
+			msg:  NewMsgReinstateFeeder(authority, "HASH/USD", feeder),
+		},
+		{
+			name: "failure - empty symbol",
+			msg:  NewMsgReinstateFeeder(authority, "", feeder),
+			err:  "symbol cannot be empty",
+		},
+		{
+			name: "failure - invalid feeder",
+			msg:  NewMsgReinstateFeeder(authority, "HASH/USD", "jackthecat"),
+			err:  "invalid feeder address: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "failure - invalid authority",
+			msg:  NewMsgReinstateFeeder("jackthecat", "HASH/USD", feeder),
+			err:  "invalid authority address: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "MsgReinstateFeederRequest.ValidateBasic")
+			} else {
+				assert.NoError(t, res, "MsgReinstateFeederRequest.ValidateBasic")
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// MaxTopicNameLength is the maximum length of an oracle topic name.
+	MaxTopicNameLength = 64
+	// MaxSubscriberLength is the maximum length of a topic subscriber's name.
+	MaxSubscriberLength = 32
+	// MaxBips is the number of basis points that make up 100%.
+	MaxBips = 10_000
+)
+
+// Validate returns an error if this source is invalid.
+func (s OracleSource) Validate() error {
+	if err := host.ChannelIdentifierValidator(s.Channel); err != nil {
+		return fmt.Errorf("invalid channel id %q", s.Channel)
+	}
+	if len(s.Address) > 0 {
+		// The oracle lives on another chain, so its address can have any prefix.
+		if _, _, err := bech32.DecodeAndConvert(s.Address); err != nil {
+			return fmt.Errorf("invalid oracle address %q: %w", s.Address, err)
+		}
+	}
+	return nil
+}
+
+// Key returns a string that uniquely identifies this source.
+func (s OracleSource) Key() string {
+	if len(s.Address) == 0 {
+		return s.Channel
+	}
+	return s.Channel + "/" + s.Address
+}
+
+// ValidateTopicName returns an error if the provided topic name is invalid.
+func ValidateTopicName(name string) error {
+	if len(strings.TrimSpace(name)) == 0 {
+		return errors.New("topic name cannot be empty")
+	}
+	if len(name) > MaxTopicNameLength {
+		return fmt.Errorf("topic name length %d exceeds maximum length of %d", len(name), MaxTopicNameLength)
+	}
+	return nil
+}
+
+// Validate returns an error if this topic is invalid.
+func (t OracleTopic) Validate() error {
+	if err := ValidateTopicName(t.Name); err != nil {
+		return err
+	}
+	if len(t.Sources) == 0 {
+		return fmt.Errorf("topic %q must have at least one source", t.Name)
+	}
+	seen := make(map[string]bool, len(t.Sources))
+	for i, source := range t.Sources {
+		if err := source.Validate(); err != nil {
+			return fmt.Errorf("invalid topic %q source[%d]: %w", t.Name, i, err)
+		}
+		if seen[source.Key()] {
+			return fmt.Errorf("invalid topic %q source[%d]: duplicate source %s", t.Name, i, source.Key())
+		}
+		seen[source.Key()] = true
+	}
+	if _, known := AggregationMethod_name[int32(t.Method)]; !known || t.Method == AggregationMethod_unspecified {
+		return fmt.Errorf("invalid topic %q aggregation method: %s", t.Name, t.Method)
+	}
+	if t.MaxDeviationBips > MaxBips {
+		return fmt.Errorf("invalid topic %q max deviation bips %d: cannot exceed %d", t.Name, t.MaxDeviationBips, MaxBips)
+	}
+	if int(t.MinAnswers) > len(t.Sources) {
+		return fmt.Errorf("invalid topic %q min answers %d: cannot exceed the number of sources %d", t.Name, t.MinAnswers, len(t.Sources))
+	}
+	if t.IntervalBlocks > 0 || len(t.Query) > 0 {
+		if err := t.Query.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid topic %q recurring query: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// IsRecurring returns true if this topic is automatically queried every so many blocks.
+func (t OracleTopic) IsRecurring() bool {
+	return t.IntervalBlocks > 0
+}
+
+// IsStale returns true if an answer produced at the provided height is too old at the current height.
+func (t OracleTopic) IsStale(answerHeight, currentHeight int64) bool {
+	if t.MaxAgeBlocks == 0 {
+		return false
+	}
+	return currentHeight-answerHeight > int64(t.MaxAgeBlocks)
+}
+
+// RequiredAnswers returns the number of usable answers needed to produce an aggregated answer.
+func (t OracleTopic) RequiredAnswers() int {
+	if t.MinAnswers == 0 {
+		return 1
+	}
+	return int(t.MinAnswers)
+}
+
+// Aggregate combines the provided values using this topic's method after discarding any that
+// deviate too far from their median. The number of values used is also returned.
+// An error is returned if fewer than the topic's minimum number of values are usable.
+func (t OracleTopic) Aggregate(values []sdkmath.LegacyDec) (sdkmath.LegacyDec, int, error) {
+	if len(values) == 0 {
+		return sdkmath.LegacyDec{}, 0, fmt.Errorf("topic %q has no answers", t.Name)
+	}
+
+	used := values
+	if t.MaxDeviationBips > 0 {
+		median := Median(values)
+		limit := median.Abs().MulInt64(int64(t.MaxDeviationBips)).QuoInt64(MaxBips)
+		used = make([]sdkmath.LegacyDec, 0, len(values))
+		for _, value := range values {
+			if value.Sub(median).Abs().LTE(limit) {
+				used = append(used, value)
+			}
+		}
+	}
+
+	if len(used) < t.RequiredAnswers() {
+		return sdkmath.LegacyDec{}, len(used), fmt.Errorf("topic %q has %d usable answers, need at least %d", t.Name, len(used), t.RequiredAnswers())
+	}
+
+	if t.Method == AggregationMethod_mean {
+		return Mean(used), len(used), nil
+	}
+	return Median(used), len(used), nil
+}
+
+// Median returns the median of the provided values. The values must not be empty.
+// When there's an even number of values, the mean of the two middle ones is returned.
+func Median(values []sdkmath.LegacyDec) sdkmath.LegacyDec {
+	sorted := make([]sdkmath.LegacyDec, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
+
+// Mean returns the mean of the provided values. The values must not be empty.
+func Mean(values []sdkmath.LegacyDec) sdkmath.LegacyDec {
+	sum := sdkmath.LegacyZeroDec()
+	for _, value := range values {
+		sum = sum.Add(value)
+	}
+	return sum.QuoInt64(int64(len(values)))
+}
+
+// ParseOracleValue extracts a decimal value from the json data returned by an oracle.
+// The data must be either a json number or a json string containing a decimal number.
+func ParseOracleValue(data []byte) (sdkmath.LegacyDec, error) {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		var num json.Number
+		if err = json.Unmarshal(data, &num); err != nil {
+			return sdkmath.LegacyDec{}, fmt.Errorf("oracle data %q is not a number", string(data))
+		}
+		str = num.String()
+	}
+	rv, err := sdkmath.LegacyNewDecFromStr(str)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid oracle value %q: %w", str, err)
+	}
+	return rv, nil
+}
+
+// Validate returns an error if this aggregated answer is invalid.
+func (a AggregatedAnswer) Validate() error {
+	if err := ValidateTopicName(a.Topic); err != nil {
+		return err
+	}
+	if _, err := sdkmath.LegacyNewDecFromStr(a.Value); err != nil {
+		return fmt.Errorf("invalid topic %q aggregated value %q: %w", a.Topic, a.Value, err)
+	}
+	return nil
+}
+
+// GetValueDec returns the value of this aggregated answer as a decimal.
+func (a AggregatedAnswer) GetValueDec() (sdkmath.LegacyDec, error) {
+	return sdkmath.LegacyNewDecFromStr(a.Value)
+}
+
+// Validate returns an error if this subscription is invalid.
+func (s TopicSubscription) Validate() error {
+	if err := ValidateTopicName(s.Topic); err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(s.Subscriber)) == 0 {
+		return fmt.Errorf("topic %q subscriber cannot be empty", s.Topic)
+	}
+	if len(s.Subscriber) > MaxSubscriberLength {
+		return fmt.Errorf("topic %q subscriber length %d exceeds maximum length of %d", s.Topic, len(s.Subscriber), MaxSubscriberLength)
+	}
+	return nil
+}
+
+// MaxAge returns the number of blocks after which this subscriber considers an answer to the provided topic stale.
+// Zero means answers never become stale.
+func (s TopicSubscription) MaxAge(topic OracleTopic) uint64 {
+	if s.MaxAgeBlocks == 0 {
+		return topic.MaxAgeBlocks
+	}
+	return s.MaxAgeBlocks
+}
+
+// IsStale returns true if an answer to the provided topic produced at the provided height is too old for this
+// subscriber at the current height.
+func (s TopicSubscription) IsStale(topic OracleTopic, answerHeight, currentHeight int64) bool {
+	maxAge := s.MaxAge(topic)
+	if maxAge == 0 {
+		return false
+	}
+	return currentHeight-answerHeight > int64(maxAge)
+}
@@ -0,0 +1,240 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	. "github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func decs(values ...string) []sdkmath.LegacyDec {
+	rv := make([]sdkmath.LegacyDec, len(values))
+	for i, value := range values {
+		rv[i] = sdkmath.LegacyMustNewDecFromStr(value)
+	}
+	return rv
+}
+
+func TestOracleTopicValidate(t *testing.T) {
+	sources := []OracleSource{{Channel: "channel-1"}, {Channel: "channel-1", Address: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"}}
+
+	tests := []struct {
+		name  string
+		topic OracleTopic
+		err   string
+	}{
+		{
+			name:  "success - all fields are valid",
+			topic: OracleTopic{Name: "HASH/USD", Sources: sources, Method: AggregationMethod_mean, MaxDeviationBips: 500, MinAnswers: 2},
+		},
+		{
+			name:  "failure - empty name",
+			topic: OracleTopic{Sources: sources, Method: AggregationMethod_median},
+			err:   "topic name cannot be empty",
+		},
+		{
+			name:  "failure - long name",
+			topic: OracleTopic{Name: strings.Repeat("x", 65), Sources: sources, Method: AggregationMethod_median},
+			err:   "topic name length 65 exceeds maximum length of 64",
+		},
+		{
+			name:  "failure - no sources",
+			topic: OracleTopic{Name: "HASH/USD", Method: AggregationMethod_median},
+			err:   `topic "HASH/USD" must have at least one source`,
+		},
+		{
+			name:  "failure - invalid channel",
+			topic: OracleTopic{Name: "HASH/USD", Sources: []OracleSource{{Channel: "bad"}}, Method: AggregationMethod_median},
+			err:   `invalid topic "HASH/USD" source[0]: invalid channel id "bad"`,
+		},
+		{
+			name:  "failure - invalid address",
+			topic: OracleTopic{Name: "HASH/USD", Sources: []OracleSource{{Channel: "channel-1", Address: "bad"}}, Method: AggregationMethod_median},
+			err:   `invalid topic "HASH/USD" source[0]: invalid oracle address "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:  "failure - duplicate source",
+			topic: OracleTopic{Name: "HASH/USD", Sources: []OracleSource{sources[0], sources[0]}, Method: AggregationMethod_median},
+			err:   `invalid topic "HASH/USD" source[1]: duplicate source channel-1`,
+		},
+		{
+			name:  "failure - unspecified method",
+			topic: OracleTopic{Name: "HASH/USD", Sources: sources},
+			err:   `invalid topic "HASH/USD" aggregation method: AGGREGATION_METHOD_UNSPECIFIED`,
+		},
+		{
+			name:  "failure - deviation too large",
+			topic: OracleTopic{Name: "HASH/USD", Sources: sources, Method: AggregationMethod_median, MaxDeviationBips: 10_001},
+			err:   `invalid topic "HASH/USD" max deviation bips 10001: cannot exceed 10000`,
+		},
+		{
+			name:  "failure - min answers more than sources",
+			topic: OracleTopic{Name: "HASH/USD", Sources: sources, Method: AggregationMethod_median, MinAnswers: 3},
+			err:   `invalid topic "HASH/USD" min answers 3: cannot exceed the number of sources 2`,
+		},
+		{
+			name:  "success - recurring",
+			topic: OracleTopic{Name: "HASH/USD", Sources: sources, Method: AggregationMethod_median, Query: []byte("{}"), IntervalBlocks: 10, MaxAgeBlocks: 30},
+		},
+		{
+			name:  "failure - recurring without a query",
+			topic: OracleTopic{Name: "HASH/USD", Sources: sources, Method: AggregationMethod_median, IntervalBlocks: 10},
+			err:   `invalid topic "HASH/USD" recurring query: invalid`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.topic.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "OracleTopic.Validate")
+			} else {
+				assert.NoError(t, res, "OracleTopic.Validate")
+			}
+		})
+	}
+}
+
+func TestOracleTopicAggregate(t *testing.T) {
+	tests := []struct {
+		name     string
+		topic    OracleTopic
+		values   []sdkmath.LegacyDec
+		expValue string
+		expCount int
+		err      string
+	}{
+		{
+			name:     "median of odd count",
+			topic:    OracleTopic{Name: "t", Method: AggregationMethod_median},
+			values:   decs("3", "1", "2"),
+			expValue: "2.000000000000000000",
+			expCount: 3,
+		},
+		{
+			name:     "median of even count",
+			topic:    OracleTopic{Name: "t", Method: AggregationMethod_median},
+			values:   decs("4", "1", "2", "3"),
+			expValue: "2.500000000000000000",
+			expCount: 4,
+		},
+		{
+			name:     "mean",
+			topic:    OracleTopic{Name: "t", Method: AggregationMethod_mean},
+			values:   decs("1", "2", "6"),
+			expValue: "3.000000000000000000",
+			expCount: 3,
+		},
+		{
+			name:     "mean with outlier discarded",
+			topic:    OracleTopic{Name: "t", Method: AggregationMethod_mean, MaxDeviationBips: 1_000},
+			values:   decs("10", "10.5", "9.5", "20"),
+			expValue: "10.000000000000000000",
+			expCount: 3,
+		},
+		{
+			name:     "not enough usable answers",
+			topic:    OracleTopic{Name: "t", Method: AggregationMethod_median, MaxDeviationBips: 100, MinAnswers: 2},
+			values:   decs("10", "20"),
+			expCount: 0,
+			err:      `topic "t" has 0 usable answers, need at least 2`,
+		},
+		{
+			name:  "no answers",
+			topic: OracleTopic{Name: "t", Method: AggregationMethod_median},
+			err:   `topic "t" has no answers`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, count, err := tc.topic.Aggregate(tc.values)
+			if len(tc.err) > 0 {
+				require.EqualError(t, err, tc.err, "Aggregate error")
+			} else {
+				require.NoError(t, err, "Aggregate error")
+				assert.Equal(t, tc.expValue, value.String(), "Aggregate value")
+			}
+			assert.Equal(t, tc.expCount, count, "Aggregate count")
+		})
+	}
+}
+
+func TestOracleTopicIsStale(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxAge        uint64
+		answerHeight  int64
+		currentHeight int64
+		exp           bool
+	}{
+		{name: "no max age", maxAge: 0, answerHeight: 1, currentHeight: 1_000_000, exp: false},
+		{name: "same height", maxAge: 5, answerHeight: 10, currentHeight: 10, exp: false},
+		{name: "at max age", maxAge: 5, answerHeight: 10, currentHeight: 15, exp: false},
+		{name: "past max age", maxAge: 5, answerHeight: 10, currentHeight: 16, exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			topic := OracleTopic{MaxAgeBlocks: tc.maxAge}
+			assert.Equal(t, tc.exp, topic.IsStale(tc.answerHeight, tc.currentHeight), "IsStale(%d, %d)", tc.answerHeight, tc.currentHeight)
+		})
+	}
+}
+
+func TestTopicSubscriptionIsStale(t *testing.T) {
+	tests := []struct {
+		name          string
+		topicMaxAge   uint64
+		maxAge        uint64
+		answerHeight  int64
+		currentHeight int64
+		exp           bool
+	}{
+		{name: "no max ages", answerHeight: 1, currentHeight: 1_000_000, exp: false},
+		{name: "topic max age, fresh", topicMaxAge: 5, answerHeight: 10, currentHeight: 15, exp: false},
+		{name: "topic max age, stale", topicMaxAge: 5, answerHeight: 10, currentHeight: 16, exp: true},
+		{name: "own max age shorter than topic's", topicMaxAge: 10, maxAge: 2, answerHeight: 10, currentHeight: 13, exp: true},
+		{name: "own max age longer than topic's", topicMaxAge: 2, maxAge: 10, answerHeight: 10, currentHeight: 13, exp: false},
+		{name: "own max age without topic's", maxAge: 3, answerHeight: 10, currentHeight: 14, exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			topic := OracleTopic{MaxAgeBlocks: tc.topicMaxAge}
+			sub := TopicSubscription{MaxAgeBlocks: tc.maxAge}
+			assert.Equal(t, tc.exp, sub.IsStale(topic, tc.answerHeight, tc.currentHeight), "IsStale(%d, %d)", tc.answerHeight, tc.currentHeight)
+		})
+	}
+}
+
+func TestParseOracleValue(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		exp  string
+		err  string
+	}{
+		{name: "json number", data: `1.25`, exp: "1.250000000000000000"},
+		{name: "json string", data: `"0.5"`, exp: "0.500000000000000000"},
+		{name: "json object", data: `{"price":"1"}`, err: `oracle data "{\"price\":\"1\"}" is not a number`},
+		{name: "bad string", data: `"abc"`, err: `invalid oracle value "abc": failed to set decimal string with base 10: abc000000000000000000`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := ParseOracleValue([]byte(tc.data))
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ParseOracleValue error")
+			} else {
+				require.NoError(t, err, "ParseOracleValue error")
+				assert.Equal(t, tc.exp, value.String(), "ParseOracleValue value")
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MaxSymbolLength is the maximum length of a price feed symbol.
+const MaxSymbolLength = 64
+
+// ValidateSymbol returns an error if the provided price feed symbol is invalid.
+func ValidateSymbol(symbol string) error {
+	if len(strings.TrimSpace(symbol)) == 0 {
+		return errors.New("symbol cannot be empty")
+	}
+	if len(symbol) > MaxSymbolLength {
+		return fmt.Errorf("symbol length %d exceeds maximum length of %d", len(symbol), MaxSymbolLength)
+	}
+	return nil
+}
+
+// ParsePrice parses the provided price, returning an error if it isn't a positive decimal.
+func ParsePrice(price string) (sdkmath.LegacyDec, error) {
+	rv, err := sdkmath.LegacyNewDecFromStr(price)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid price %q: %w", price, err)
+	}
+	if !rv.IsPositive() {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid price %q: must be positive", price)
+	}
+	return rv, nil
+}
+
+// Validate returns an error if this price feed is invalid.
+func (f PriceFeed) Validate() error {
+	if err := ValidateSymbol(f.Symbol); err != nil {
+		return err
+	}
+	if len(f.Feeders) == 0 {
+		return fmt.Errorf("price feed %q must have at least one feeder", f.Symbol)
+	}
+	seen := make(map[string]bool, len(f.Feeders))
+	for i, feeder := range f.Feeders {
+		if _, err := sdk.AccAddressFromBech32(feeder); err != nil {
+			return fmt.Errorf("invalid price feed %q feeder[%d]: %w", f.Symbol, i, err)
+		}
+		if seen[feeder] {
+			return fmt.Errorf("invalid price feed %q feeder[%d]: duplicate feeder %s", f.Symbol, i, feeder)
+		}
+		seen[feeder] = true
+	}
+	if f.Nav != nil {
+		if err := f.Nav.Validate(); err != nil {
+			return fmt.Errorf("invalid price feed %q nav: %w", f.Symbol, err)
+		}
+	}
+	if f.Validation != nil {
+		if err := f.Validation.Validate(); err != nil {
+			return fmt.Errorf("invalid price feed %q validation: %w", f.Symbol, err)
+		}
+	}
+	return nil
+}
+
+// HasFeeder returns true if the provided address is one of this feed's feeders.
+func (f PriceFeed) HasFeeder(feeder string) bool {
+	for _, addr := range f.Feeders {
+		if addr == feeder {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if this net asset value mapping is invalid.
+func (n PriceFeedNav) Validate() error {
+	if err := sdk.ValidateDenom(n.MarkerDenom); err != nil {
+		return fmt.Errorf("invalid marker denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(n.PriceDenom); err != nil {
+		return fmt.Errorf("invalid price denom: %w", err)
+	}
+	if n.MarkerDenom == n.PriceDenom {
+		return fmt.Errorf("price denom cannot be the marker denom %q", n.MarkerDenom)
+	}
+	if n.Volume == 0 {
+		return errors.New("volume cannot be zero")
+	}
+	return nil
+}
+
+// Validate returns an error if this price validation is invalid.
+func (v PriceValidation) Validate() error {
+	var minPrice, maxPrice sdkmath.LegacyDec
+	var err error
+	if len(v.MinPrice) > 0 {
+		if minPrice, err = ParsePrice(v.MinPrice); err != nil {
+			return fmt.Errorf("invalid min price: %w", err)
+		}
+	}
+	if len(v.MaxPrice) > 0 {
+		if maxPrice, err = ParsePrice(v.MaxPrice); err != nil {
+			return fmt.Errorf("invalid max price: %w", err)
+		}
+	}
+	if len(v.MinPrice) > 0 && len(v.MaxPrice) > 0 && minPrice.GT(maxPrice) {
+		return fmt.Errorf("min price %s cannot be greater than max price %s", v.MinPrice, v.MaxPrice)
+	}
+	if v.MaxDeviationBips > MaxBips {
+		return fmt.Errorf("max deviation bips %d cannot exceed %d", v.MaxDeviationBips, MaxBips)
+	}
+	if len(v.Contract) > 0 {
+		if _, err = sdk.AccAddressFromBech32(v.Contract); err != nil {
+			return fmt.Errorf("invalid contract: %w", err)
+		}
+	}
+	return nil
+}
+
+// CheckPrice returns the reason a price fails the bounds of this validation, or an empty string if it passes.
+// The median is the feed's current median price, and is only used if it isn't nil.
+// The contract is not consulted by this; that has to be done separately.
+func (v PriceValidation) CheckPrice(price sdkmath.LegacyDec, median *sdkmath.LegacyDec) string {
+	if len(v.MinPrice) > 0 {
+		if minPrice, err := sdkmath.LegacyNewDecFromStr(v.MinPrice); err == nil && price.LT(minPrice) {
+			return fmt.Sprintf("price %s is less than the minimum %s", price, v.MinPrice)
+		}
+	}
+	if len(v.MaxPrice) > 0 {
+		if maxPrice, err := sdkmath.LegacyNewDecFromStr(v.MaxPrice); err == nil && price.GT(maxPrice) {
+			return fmt.Sprintf("price %s is greater than the maximum %s", price, v.MaxPrice)
+		}
+	}
+	if v.MaxDeviationBips > 0 && median != nil {
+		limit := median.MulInt64(int64(v.MaxDeviationBips)).QuoInt64(MaxBips)
+		if price.Sub(*median).Abs().GT(limit) {
+			return fmt.Sprintf("price %s deviates from the median %s by more than %d bips", price, median, v.MaxDeviationBips)
+		}
+	}
+	return ""
+}
+
+// ValidatePriceQuery is the query sent to a price feed's validation contract.
+type ValidatePriceQuery struct {
+	ValidatePrice ValidatePriceQueryParams `json:"validate_price"`
+}
+
+// ValidatePriceQueryParams are the details of the price being validated by a validation contract.
+type ValidatePriceQueryParams struct {
+	Symbol string `json:"symbol"`
+	Feeder string `json:"feeder"`
+	Price  string `json:"price"`
+}
+
+// ValidatePriceResponse is the response expected from a price feed's validation contract.
+type ValidatePriceResponse struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Validate returns an error if these feeder violations are invalid.
+func (f FeederViolations) Validate() error {
+	if err := ValidateSymbol(f.Symbol); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Feeder); err != nil {
+		return fmt.Errorf("invalid feeder violations %q feeder: %w", f.Symbol, err)
+	}
+	if len(f.Violations) == 0 {
+		return fmt.Errorf("feeder violations %q for %s must have at least one violation", f.Symbol, f.Feeder)
+	}
+	for i, violation := range f.Violations {
+		if len(strings.TrimSpace(violation.Reason)) == 0 {
+			return fmt.Errorf("invalid feeder violations %q for %s violation[%d]: reason cannot be empty", f.Symbol, f.Feeder, i)
+		}
+	}
+	return nil
+}
+
+// NetAssetValue returns the marker net asset value for the provided feed price.
+// The price is for one marker token, so it is multiplied by the volume and truncated.
+func (n PriceFeedNav) NetAssetValue(price sdkmath.LegacyDec) markertypes.NetAssetValue {
+	amount := price.MulInt64(int64(n.Volume)).TruncateInt()
+	return markertypes.NewNetAssetValue(sdk.NewCoin(n.PriceDenom, amount), n.Volume)
+}
+
+// Validate returns an error if this price point is invalid.
+func (p PricePoint) Validate() error {
+	if err := ValidateSymbol(p.Symbol); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Feeder); err != nil {
+		return fmt.Errorf("invalid price point %q feeder: %w", p.Symbol, err)
+	}
+	if _, err := ParsePrice(p.Price); err != nil {
+		return fmt.Errorf("invalid price point %q: %w", p.Symbol, err)
+	}
+	return nil
+}
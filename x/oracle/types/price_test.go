@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/provenance-io/provenance/x/oracle/types"
+)
+
+func TestPriceFeedValidate(t *testing.T) {
+	feeder1 := "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
+	feeder2 := "cosmos10gqqppkly524p6v7hypvvl8sn7wky85jajrph0"
+
+	tests := []struct {
+		name string
+		feed PriceFeed
+		err  string
+	}{
+		{
+			name: "success - without nav",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1, feeder2}},
+		},
+		{
+			name: "success - with nav",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Nav: &PriceFeedNav{MarkerDenom: "nhash", PriceDenom: "usd", Volume: 1_000_000_000}},
+		},
+		{
+			name: "failure - empty symbol",
+			feed: PriceFeed{Feeders: []string{feeder1}},
+			err:  "symbol cannot be empty",
+		},
+		{
+			name: "failure - long symbol",
+			feed: PriceFeed{Symbol: strings.Repeat("x", 65), Feeders: []string{feeder1}},
+			err:  "symbol length 65 exceeds maximum length of 64",
+		},
+		{
+			name: "failure - no feeders",
+			feed: PriceFeed{Symbol: "HASH/USD"},
+			err:  `price feed "HASH/USD" must have at least one feeder`,
+		},
+		{
+			name: "failure - invalid feeder",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1, "bad"}},
+			err:  `invalid price feed "HASH/USD" feeder[1]: decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name: "failure - duplicate feeder",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1, feeder1}},
+			err:  `invalid price feed "HASH/USD" feeder[1]: duplicate feeder ` + feeder1,
+		},
+		{
+			name: "failure - nav with same denoms",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Nav: &PriceFeedNav{MarkerDenom: "nhash", PriceDenom: "nhash", Volume: 1}},
+			err:  `invalid price feed "HASH/USD" nav: price denom cannot be the marker denom "nhash"`,
+		},
+		{
+			name: "failure - nav without volume",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Nav: &PriceFeedNav{MarkerDenom: "nhash", PriceDenom: "usd"}},
+			err:  `invalid price feed "HASH/USD" nav: volume cannot be zero`,
+		},
+		{
+			name: "failure - nav with invalid marker denom",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Nav: &PriceFeedNav{MarkerDenom: "x", PriceDenom: "usd", Volume: 1}},
+			err:  `invalid price feed "HASH/USD" nav: invalid marker denom: invalid denom: x`,
+		},
+		{
+			name: "success - with validation",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Validation: &PriceValidation{MinPrice: "0.01", MaxPrice: "1", MaxDeviationBips: 500, Contract: feeder2, MaxViolations: 3}},
+		},
+		{
+			name: "failure - validation with invalid min price",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Validation: &PriceValidation{MinPrice: "-1"}},
+			err:  `invalid price feed "HASH/USD" validation: invalid min price: invalid price "-1": must be positive`,
+		},
+		{
+			name: "failure - validation with min price greater than max price",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Validation: &PriceValidation{MinPrice: "2", MaxPrice: "1"}},
+			err:  `invalid price feed "HASH/USD" validation: min price 2 cannot be greater than max price 1`,
+		},
+		{
+			name: "failure - validation with too many bips",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Validation: &PriceValidation{MaxDeviationBips: 10_001}},
+			err:  `invalid price feed "HASH/USD" validation: max deviation bips 10001 cannot exceed 10000`,
+		},
+		{
+			name: "failure - validation with invalid contract",
+			feed: PriceFeed{Symbol: "HASH/USD", Feeders: []string{feeder1}, Validation: &PriceValidation{Contract: "bad"}},
+			err:  `invalid price feed "HASH/USD" validation: invalid contract: decoding bech32 failed: invalid bech32 string length 3`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.feed.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "PriceFeed.Validate")
+			} else {
+				assert.NoError(t, res, "PriceFeed.Validate")
+			}
+		})
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		price string
+		exp   string
+		err   string
+	}{
+		{name: "success - integer", price: "3", exp: "3.000000000000000000"},
+		{name: "success - decimal", price: "0.025", exp: "0.025000000000000000"},
+		{name: "failure - zero", price: "0", err: `invalid price "0": must be positive`},
+		{name: "failure - negative", price: "-1.5", err: `invalid price "-1.5": must be positive`},
+		{name: "failure - not a number", price: "abc", err: `invalid price "abc": failed to set decimal string with base 10: abc000000000000000000`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := ParsePrice(tc.price)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "ParsePrice")
+			} else {
+				assert.NoError(t, err, "ParsePrice")
+				assert.Equal(t, tc.exp, res.String(), "ParsePrice")
+			}
+		})
+	}
+}
+
+func TestPriceFeedNavNetAssetValue(t *testing.T) {
+	nav := PriceFeedNav{MarkerDenom: "nhash", PriceDenom: "usd", Volume: 1_000}
+	res := nav.NetAssetValue(sdkmath.LegacyMustNewDecFromStr("0.0255"))
+	assert.Equal(t, sdk.NewInt64Coin("usd", 25), res.Price, "NetAssetValue price")
+	assert.Equal(t, uint64(1_000), res.Volume, "NetAssetValue volume")
+}
+
+func TestPriceValidationCheckPrice(t *testing.T) {
+	median := sdkmath.LegacyMustNewDecFromStr("1.00")
+	validation := PriceValidation{MinPrice: "0.5", MaxPrice: "2", MaxDeviationBips: 1_000}
+
+	tests := []struct {
+		name   string
+		price  string
+		median *sdkmath.LegacyDec
+		exp    string
+	}{
+		{name: "within bounds", price: "1.05", median: &median},
+		{name: "at max deviation", price: "0.9", median: &median},
+		{name: "less than minimum", price: "0.4", median: &median, exp: "price 0.400000000000000000 is less than the minimum 0.5"},
+		{name: "greater than maximum", price: "2.5", median: &median, exp: "price 2.500000000000000000 is greater than the maximum 2"},
+		{name: "deviates from median", price: "1.2", median: &median, exp: "price 1.200000000000000000 deviates from the median 1.000000000000000000 by more than 1000 bips"},
+		{name: "no median", price: "1.9"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := validation.CheckPrice(sdkmath.LegacyMustNewDecFromStr(tc.price), tc.median)
+			assert.Equal(t, tc.exp, res, "CheckPrice")
+		})
+	}
+}
@@ -233,407 +233,3000 @@ func (m *MsgUpdateOracleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateOracleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSendQueryOracleRequest)(nil), "provenance.oracle.v1.MsgSendQueryOracleRequest")
-	proto.RegisterType((*MsgSendQueryOracleResponse)(nil), "provenance.oracle.v1.MsgSendQueryOracleResponse")
-	proto.RegisterType((*MsgUpdateOracleRequest)(nil), "provenance.oracle.v1.MsgUpdateOracleRequest")
-	proto.RegisterType((*MsgUpdateOracleResponse)(nil), "provenance.oracle.v1.MsgUpdateOracleResponse")
+// MsgUpdateOracleTopicRequest is the request type for adding or replacing an oracle topic.
+type MsgUpdateOracleTopicRequest struct {
+	// The topic to add or replace.
+	Topic OracleTopic `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+	// The signing authority for the request
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/oracle/v1/tx.proto", fileDescriptor_66a39dda41c6a784) }
-
-var fileDescriptor_66a39dda41c6a784 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0xd1, 0x96, 0xd2, 0x53, 0x05, 0xd2, 0x29, 0x22, 0x8e, 0x25, 0xdc, 0x2a, 0x53, 0x55,
-	0x11, 0x1f, 0x0d, 0x12, 0x02, 0x24, 0x06, 0xd2, 0x39, 0x02, 0x1c, 0x21, 0x24, 0x16, 0x74, 0xb5,
-	0x4f, 0x17, 0x8b, 0xfa, 0xce, 0xbd, 0x77, 0x4e, 0x93, 0x0d, 0xf1, 0x0b, 0x18, 0x19, 0xf9, 0x09,
-	0x1d, 0xf8, 0x11, 0x8c, 0x55, 0x27, 0x26, 0x84, 0x92, 0xa1, 0x6c, 0xec, 0x4c, 0xc8, 0xbe, 0x04,
-	0xa7, 0xc1, 0xa0, 0x4c, 0xf6, 0xf7, 0xbe, 0xef, 0xde, 0xbd, 0xef, 0xe9, 0x3b, 0x7c, 0x27, 0xd5,
-	0x6a, 0xc8, 0x25, 0x93, 0x21, 0xa7, 0x4a, 0xb3, 0xf0, 0x98, 0xd3, 0xe1, 0x01, 0x35, 0x23, 0x3f,
-	0xd5, 0xca, 0x28, 0x52, 0x2f, 0x69, 0xdf, 0xd2, 0xfe, 0xf0, 0xc0, 0x6d, 0x84, 0x0a, 0x12, 0x05,
-	0x34, 0x01, 0x91, 0xab, 0x13, 0x10, 0x56, 0xee, 0x36, 0x2d, 0xf1, 0xa6, 0x40, 0xd4, 0x82, 0x19,
-	0x55, 0x17, 0x4a, 0x28, 0x5b, 0xcf, 0xff, 0x6c, 0xb5, 0x75, 0x81, 0x70, 0xb3, 0x07, 0xa2, 0xcf,
-	0x65, 0xf4, 0x22, 0xe3, 0x7a, 0xfc, 0xac, 0xb8, 0x23, 0xe0, 0x27, 0x19, 0x07, 0x43, 0xfa, 0x78,
-	0xe3, 0x24, 0xaf, 0x3a, 0x68, 0x17, 0xed, 0x6d, 0x77, 0x9f, 0xfc, 0xfa, 0xb6, 0xf3, 0x48, 0xc4,
-	0x66, 0x90, 0x1d, 0xf9, 0xa1, 0x4a, 0xe8, 0xa1, 0x82, 0xe4, 0x15, 0x83, 0x84, 0x9e, 0x32, 0x48,
-	0x22, 0x3a, 0x2a, 0xbe, 0xd4, 0x8c, 0x53, 0x0e, 0x7e, 0xc0, 0x4e, 0x0f, 0x95, 0x34, 0x9a, 0x85,
-	0xa6, 0xc7, 0x01, 0x98, 0xe0, 0x81, 0xed, 0x45, 0x1c, 0xbc, 0x19, 0x0e, 0x98, 0x94, 0xfc, 0xd8,
-	0x59, 0xdb, 0x45, 0x7b, 0x5b, 0xc1, 0x1c, 0x92, 0x07, 0x78, 0x8b, 0x65, 0x66, 0xa0, 0x74, 0x6c,
-	0xc6, 0xce, 0x7a, 0xce, 0x75, 0x9d, 0x8b, 0xcf, 0xed, 0xfa, 0xcc, 0xc7, 0xd3, 0x28, 0xd2, 0x1c,
-	0xa0, 0x6f, 0x74, 0x2c, 0x45, 0x50, 0x4a, 0x1f, 0xdf, 0x7c, 0x7f, 0x79, 0xb6, 0x5f, 0xe2, 0xd6,
-	0x43, 0xec, 0x56, 0x79, 0x82, 0x54, 0x49, 0xe0, 0xc4, 0xc5, 0x37, 0x20, 0xf7, 0x27, 0x43, 0x5e,
-	0xf8, 0x5a, 0x0f, 0xfe, 0xe0, 0xd6, 0x47, 0x84, 0x6f, 0xf7, 0x40, 0xbc, 0x4c, 0x23, 0x66, 0xf8,
-	0xd5, 0x5d, 0x74, 0xf0, 0x26, 0xb3, 0x03, 0x14, 0xa7, 0xfe, 0x37, 0xda, 0x5c, 0x78, 0xd5, 0xd0,
-	0xb5, 0xd5, 0x0d, 0x91, 0x1f, 0x9f, 0x76, 0xd0, 0x92, 0xa9, 0x26, 0x6e, 0xfc, 0x35, 0x99, 0x75,
-	0xd4, 0xf9, 0x89, 0xf0, 0x5a, 0x0f, 0x04, 0x79, 0x8b, 0xb7, 0x17, 0x79, 0x72, 0xd7, 0xaf, 0x4a,
-	0x8f, 0x5f, 0x6d, 0xd0, 0x6d, 0xaf, 0xa8, 0x9e, 0xad, 0xd1, 0xe0, 0x5b, 0x4b, 0x1b, 0x26, 0xf4,
-	0x9f, 0x1d, 0xaa, 0xf3, 0xe5, 0xde, 0x5b, 0xfd, 0x80, 0xbd, 0xd5, 0xdd, 0x78, 0x77, 0x79, 0xb6,
-	0x8f, 0xba, 0xe2, 0xcb, 0xc4, 0x43, 0xe7, 0x13, 0x0f, 0x7d, 0x9f, 0x78, 0xe8, 0xc3, 0xd4, 0xab,
-	0x9d, 0x4f, 0xbd, 0xda, 0xd7, 0xa9, 0x57, 0xc3, 0x8d, 0x58, 0x55, 0x36, 0x7d, 0x8e, 0x5e, 0x77,
-	0x16, 0xa2, 0x5b, 0x4a, 0xda, 0xb1, 0x5a, 0x40, 0x74, 0x34, 0x7f, 0x85, 0x45, 0x8c, 0x8f, 0xae,
-	0x17, 0xcf, 0xe4, 0xfe, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x66, 0x84, 0x0f, 0xa7, 0x03,
-	0x00, 0x00,
+func (m *MsgUpdateOracleTopicRequest) Reset()         { *m = MsgUpdateOracleTopicRequest{} }
+func (m *MsgUpdateOracleTopicRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleTopicRequest) ProtoMessage()    {}
+func (*MsgUpdateOracleTopicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{4}
 }
-
-func (this *MsgUpdateOracleRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgUpdateOracleRequest)
-	if !ok {
-		that2, ok := that.(MsgUpdateOracleRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgUpdateOracleTopicRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleTopicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleTopicRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateOracle is the RPC endpoint for updating the oracle
-	UpdateOracle(ctx context.Context, in *MsgUpdateOracleRequest, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error)
-	// SendQueryOracle sends a query to an oracle on another chain
-	SendQueryOracle(ctx context.Context, in *MsgSendQueryOracleRequest, opts ...grpc.CallOption) (*MsgSendQueryOracleResponse, error)
+func (m *MsgUpdateOracleTopicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleTopicRequest.Merge(m, src)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateOracleTopicRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateOracleTopicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleTopicRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateOracle(ctx context.Context, in *MsgUpdateOracleRequest, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error) {
-	out := new(MsgUpdateOracleResponse)
-	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/UpdateOracle", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateOracleTopicRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateOracleTopicRequest) GetTopic() OracleTopic {
+	if m != nil {
+		return m.Topic
 	}
-	return out, nil
+	return OracleTopic{}
 }
 
-func (c *msgClient) SendQueryOracle(ctx context.Context, in *MsgSendQueryOracleRequest, opts ...grpc.CallOption) (*MsgSendQueryOracleResponse, error) {
-	out := new(MsgSendQueryOracleResponse)
-	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/SendQueryOracle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateOracleTopicRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateOracle is the RPC endpoint for updating the oracle
-	UpdateOracle(context.Context, *MsgUpdateOracleRequest) (*MsgUpdateOracleResponse, error)
-	// SendQueryOracle sends a query to an oracle on another chain
-	SendQueryOracle(context.Context, *MsgSendQueryOracleRequest) (*MsgSendQueryOracleResponse, error)
+// MsgUpdateOracleTopicResponse is the response type for updating an oracle topic.
+type MsgUpdateOracleTopicResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateOracleTopicResponse) Reset()         { *m = MsgUpdateOracleTopicResponse{} }
+func (m *MsgUpdateOracleTopicResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleTopicResponse) ProtoMessage()    {}
+func (*MsgUpdateOracleTopicResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{5}
 }
-
-func (*UnimplementedMsgServer) UpdateOracle(ctx context.Context, req *MsgUpdateOracleRequest) (*MsgUpdateOracleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracle not implemented")
+func (m *MsgUpdateOracleTopicResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SendQueryOracle(ctx context.Context, req *MsgSendQueryOracleRequest) (*MsgSendQueryOracleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendQueryOracle not implemented")
+func (m *MsgUpdateOracleTopicResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleTopicResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleTopicResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleTopicResponse.Merge(m, src)
+}
+func (m *MsgUpdateOracleTopicResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleTopicResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleTopicResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateOracleTopicResponse proto.InternalMessageInfo
+
+// MsgSendTopicQueryRequest queries each of a topic's oracles.
+type MsgSendTopicQueryRequest struct {
+	// The name of the topic to query.
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// Query contains the query data passed to each oracle.
+	Query github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage `protobuf:"bytes,2,opt,name=query,proto3,casttype=github.com/CosmWasm/wasmd/x/wasm/types.RawContractMessage" json:"query,omitempty"`
+	// The signing authority for the request
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func _Msg_UpdateOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateOracleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateOracle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.oracle.v1.Msg/UpdateOracle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateOracle(ctx, req.(*MsgUpdateOracleRequest))
+func (m *MsgSendTopicQueryRequest) Reset()         { *m = MsgSendTopicQueryRequest{} }
+func (m *MsgSendTopicQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSendTopicQueryRequest) ProtoMessage()    {}
+func (*MsgSendTopicQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{6}
+}
+func (m *MsgSendTopicQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendTopicQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendTopicQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSendTopicQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendTopicQueryRequest.Merge(m, src)
+}
+func (m *MsgSendTopicQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendTopicQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendTopicQueryRequest.DiscardUnknown(m)
 }
 
-func _Msg_SendQueryOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendQueryOracleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendQueryOracle(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.oracle.v1.Msg/SendQueryOracle",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendQueryOracle(ctx, req.(*MsgSendQueryOracleRequest))
+var xxx_messageInfo_MsgSendTopicQueryRequest proto.InternalMessageInfo
+
+func (m *MsgSendTopicQueryRequest) GetTopic() string {
+	if m != nil {
+		return m.Topic
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.oracle.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateOracle",
-			Handler:    _Msg_UpdateOracle_Handler,
-		},
-		{
-			MethodName: "SendQueryOracle",
-			Handler:    _Msg_SendQueryOracle_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/oracle/v1/tx.proto",
+func (m *MsgSendTopicQueryRequest) GetQuery() github_com_CosmWasm_wasmd_x_wasm_types.RawContractMessage {
+	if m != nil {
+		return m.Query
+	}
+	return nil
 }
 
-func (m *MsgSendQueryOracleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendTopicQueryRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSendQueryOracleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSendTopicQueryResponse contains the round of the topic query.
+type MsgSendTopicQueryResponse struct {
+	// The round number that identifies the answers to this query.
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
 }
 
-func (m *MsgSendQueryOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSendTopicQueryResponse) Reset()         { *m = MsgSendTopicQueryResponse{} }
+func (m *MsgSendTopicQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendTopicQueryResponse) ProtoMessage()    {}
+func (*MsgSendTopicQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{7}
+}
+func (m *MsgSendTopicQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendTopicQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendTopicQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSendTopicQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendTopicQueryResponse.Merge(m, src)
+}
+func (m *MsgSendTopicQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendTopicQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendTopicQueryResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSendQueryOracleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSendTopicQueryResponse proto.InternalMessageInfo
+
+func (m *MsgSendTopicQueryResponse) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSendQueryOracleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateTopicSubscriptionRequest is the request type for adding, replacing, or removing a topic subscription.
+type MsgUpdateTopicSubscriptionRequest struct {
+	// The subscription to add or replace.
+	Subscription TopicSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription"`
+	// Whether to remove the subscription instead. Only its topic and subscriber are used.
+	Remove bool `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+	// The signing authority for the request
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func (m *MsgSendQueryOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgUpdateTopicSubscriptionRequest) Reset()         { *m = MsgUpdateTopicSubscriptionRequest{} }
+func (m *MsgUpdateTopicSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicSubscriptionRequest) ProtoMessage()    {}
+func (*MsgUpdateTopicSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{8}
+}
+func (m *MsgUpdateTopicSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicSubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateTopicSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicSubscriptionRequest.Merge(m, src)
+}
+func (m *MsgUpdateTopicSubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicSubscriptionRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateOracleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTopicSubscriptionRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateTopicSubscriptionRequest) GetSubscription() TopicSubscription {
+	if m != nil {
+		return m.Subscription
 	}
-	return dAtA[:n], nil
+	return TopicSubscription{}
 }
 
-func (m *MsgUpdateOracleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateTopicSubscriptionRequest) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
 }
 
-func (m *MsgUpdateOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUpdateTopicSubscriptionRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// MsgUpdateTopicSubscriptionResponse is the response type for updating a topic subscription.
+type MsgUpdateTopicSubscriptionResponse struct {
+}
+
+func (m *MsgUpdateTopicSubscriptionResponse) Reset()         { *m = MsgUpdateTopicSubscriptionResponse{} }
+func (m *MsgUpdateTopicSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTopicSubscriptionResponse) ProtoMessage()    {}
+func (*MsgUpdateTopicSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{9}
+}
+func (m *MsgUpdateTopicSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTopicSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTopicSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateTopicSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTopicSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgUpdateTopicSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTopicSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTopicSubscriptionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateOracleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTopicSubscriptionResponse proto.InternalMessageInfo
+
+// MsgUpdatePriceFeedRequest is the request type for adding or replacing a price feed.
+type MsgUpdatePriceFeedRequest struct {
+	// The price feed to add or replace.
+	Feed PriceFeed `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed"`
+	// The signing authority for the request
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdatePriceFeedRequest) Reset()         { *m = MsgUpdatePriceFeedRequest{} }
+func (m *MsgUpdatePriceFeedRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceFeedRequest) ProtoMessage()    {}
+func (*MsgUpdatePriceFeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{10}
+}
+func (m *MsgUpdatePriceFeedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceFeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceFeedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdatePriceFeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceFeedRequest.Merge(m, src)
+}
+func (m *MsgUpdatePriceFeedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceFeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceFeedRequest.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateOracleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdatePriceFeedRequest proto.InternalMessageInfo
+
+func (m *MsgUpdatePriceFeedRequest) GetFeed() PriceFeed {
+	if m != nil {
+		return m.Feed
+	}
+	return PriceFeed{}
 }
 
-func (m *MsgUpdateOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdatePriceFeedRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// MsgUpdatePriceFeedResponse is the response type for updating a price feed.
+type MsgUpdatePriceFeedResponse struct {
+}
+
+func (m *MsgUpdatePriceFeedResponse) Reset()         { *m = MsgUpdatePriceFeedResponse{} }
+func (m *MsgUpdatePriceFeedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceFeedResponse) ProtoMessage()    {}
+func (*MsgUpdatePriceFeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{11}
+}
+func (m *MsgUpdatePriceFeedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceFeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceFeedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgSendQueryOracleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePriceFeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceFeedResponse.Merge(m, src)
+}
+func (m *MsgUpdatePriceFeedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceFeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceFeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePriceFeedResponse proto.InternalMessageInfo
+
+// MsgSubmitPriceRequest is the request type for submitting a price to a feed.
+type MsgSubmitPriceRequest struct {
+	// The address of the feeder submitting the price.
+	Feeder string `protobuf:"bytes,1,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	// The symbol of the feed.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The decimal price.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *MsgSubmitPriceRequest) Reset()         { *m = MsgSubmitPriceRequest{} }
+func (m *MsgSubmitPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPriceRequest) ProtoMessage()    {}
+func (*MsgSubmitPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{12}
+}
+func (m *MsgSubmitPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSubmitPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPriceRequest.Merge(m, src)
+}
+func (m *MsgSubmitPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPriceRequest proto.InternalMessageInfo
+
+func (m *MsgSubmitPriceRequest) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSubmitPriceRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSubmitPriceRequest) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// MsgSubmitPriceResponse is the response type for submitting a price.
+type MsgSubmitPriceResponse struct {
+	// Whether the price passed the feed's validation and is being used.
+	Accepted bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Why the price failed the feed's validation. Empty if it was accepted.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSubmitPriceResponse) Reset()         { *m = MsgSubmitPriceResponse{} }
+func (m *MsgSubmitPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitPriceResponse) ProtoMessage()    {}
+func (*MsgSubmitPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{13}
+}
+func (m *MsgSubmitPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitPriceResponse.Merge(m, src)
+}
+func (m *MsgSubmitPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitPriceResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitPriceResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *MsgSubmitPriceResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgReinstateFeederRequest is the request type for reinstating a feeder that was suspended from a price feed
+type MsgReinstateFeederRequest struct {
+	// The symbol of the feed.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The address of the suspended feeder.
+	Feeder string `protobuf:"bytes,2,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	// The signing authority for the request
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgReinstateFeederRequest) Reset()         { *m = MsgReinstateFeederRequest{} }
+func (m *MsgReinstateFeederRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReinstateFeederRequest) ProtoMessage()    {}
+func (*MsgReinstateFeederRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{14}
+}
+func (m *MsgReinstateFeederRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReinstateFeederRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReinstateFeederRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReinstateFeederRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReinstateFeederRequest.Merge(m, src)
+}
+func (m *MsgReinstateFeederRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReinstateFeederRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReinstateFeederRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReinstateFeederRequest proto.InternalMessageInfo
+
+func (m *MsgReinstateFeederRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgReinstateFeederRequest) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
+func (m *MsgReinstateFeederRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgReinstateFeederResponse is the response type for the ReinstateFeeder RPC
+type MsgReinstateFeederResponse struct {
+}
+
+func (m *MsgReinstateFeederResponse) Reset()         { *m = MsgReinstateFeederResponse{} }
+func (m *MsgReinstateFeederResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReinstateFeederResponse) ProtoMessage()    {}
+func (*MsgReinstateFeederResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_66a39dda41c6a784, []int{15}
+}
+func (m *MsgReinstateFeederResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReinstateFeederResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReinstateFeederResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReinstateFeederResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReinstateFeederResponse.Merge(m, src)
+}
+func (m *MsgReinstateFeederResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReinstateFeederResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReinstateFeederResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReinstateFeederResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSendQueryOracleRequest)(nil), "provenance.oracle.v1.MsgSendQueryOracleRequest")
+	proto.RegisterType((*MsgSendQueryOracleResponse)(nil), "provenance.oracle.v1.MsgSendQueryOracleResponse")
+	proto.RegisterType((*MsgUpdateOracleRequest)(nil), "provenance.oracle.v1.MsgUpdateOracleRequest")
+	proto.RegisterType((*MsgUpdateOracleResponse)(nil), "provenance.oracle.v1.MsgUpdateOracleResponse")
+	proto.RegisterType((*MsgUpdateOracleTopicRequest)(nil), "provenance.oracle.v1.MsgUpdateOracleTopicRequest")
+	proto.RegisterType((*MsgUpdateOracleTopicResponse)(nil), "provenance.oracle.v1.MsgUpdateOracleTopicResponse")
+	proto.RegisterType((*MsgSendTopicQueryRequest)(nil), "provenance.oracle.v1.MsgSendTopicQueryRequest")
+	proto.RegisterType((*MsgSendTopicQueryResponse)(nil), "provenance.oracle.v1.MsgSendTopicQueryResponse")
+	proto.RegisterType((*MsgUpdateTopicSubscriptionRequest)(nil), "provenance.oracle.v1.MsgUpdateTopicSubscriptionRequest")
+	proto.RegisterType((*MsgUpdateTopicSubscriptionResponse)(nil), "provenance.oracle.v1.MsgUpdateTopicSubscriptionResponse")
+	proto.RegisterType((*MsgUpdatePriceFeedRequest)(nil), "provenance.oracle.v1.MsgUpdatePriceFeedRequest")
+	proto.RegisterType((*MsgUpdatePriceFeedResponse)(nil), "provenance.oracle.v1.MsgUpdatePriceFeedResponse")
+	proto.RegisterType((*MsgSubmitPriceRequest)(nil), "provenance.oracle.v1.MsgSubmitPriceRequest")
+	proto.RegisterType((*MsgSubmitPriceResponse)(nil), "provenance.oracle.v1.MsgSubmitPriceResponse")
+	proto.RegisterType((*MsgReinstateFeederRequest)(nil), "provenance.oracle.v1.MsgReinstateFeederRequest")
+	proto.RegisterType((*MsgReinstateFeederResponse)(nil), "provenance.oracle.v1.MsgReinstateFeederResponse")
+}
+
+func init() { proto.RegisterFile("provenance/oracle/v1/tx.proto", fileDescriptor_66a39dda41c6a784) }
+
+var fileDescriptor_66a39dda41c6a784 = []byte{
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xb4, 0x49, 0x7f, 0xbc, 0x56, 0x8b, 0xb0, 0x42, 0x93, 0x98, 0x25, 0xd9, 0x5a, 0x48,
+	0xac, 0x96, 0xad, 0x9d, 0x04, 0x09, 0x76, 0x57, 0xda, 0x03, 0x59, 0x89, 0x13, 0x11, 0xbb, 0x0e,
+	0x08, 0x89, 0x0b, 0x72, 0xec, 0xc1, 0xb1, 0xa8, 0x3d, 0xae, 0x67, 0x92, 0x6d, 0x38, 0x21, 0xc4,
+	0x91, 0x03, 0x47, 0x4e, 0x88, 0x03, 0x57, 0xa4, 0x3d, 0xf0, 0x1f, 0x70, 0xe9, 0x05, 0xa9, 0xea,
+	0x01, 0x71, 0xaa, 0x50, 0x7b, 0x28, 0x7f, 0x03, 0x27, 0x64, 0xcf, 0x38, 0x76, 0x12, 0x27, 0x75,
+	0xab, 0x9c, 0xda, 0xe7, 0xf7, 0xde, 0xbc, 0xef, 0x7b, 0xef, 0xe5, 0x9b, 0x81, 0xb7, 0xfc, 0x80,
+	0x8c, 0xb0, 0x67, 0x78, 0x26, 0xd6, 0x48, 0x60, 0x98, 0x87, 0x58, 0x1b, 0xb5, 0x34, 0x76, 0xac,
+	0xfa, 0x01, 0x61, 0x44, 0x2a, 0x27, 0x6e, 0x95, 0xbb, 0xd5, 0x51, 0x4b, 0xae, 0x98, 0x84, 0xba,
+	0x84, 0x6a, 0x2e, 0xb5, 0xc3, 0x68, 0x97, 0xda, 0x3c, 0x5c, 0xae, 0x71, 0xc7, 0x97, 0x91, 0xa5,
+	0x71, 0x43, 0xb8, 0xca, 0x36, 0xb1, 0x09, 0xff, 0x1e, 0xfe, 0x27, 0xbe, 0xee, 0x67, 0x96, 0x17,
+	0x95, 0xa2, 0x10, 0xe5, 0x0c, 0x41, 0xad, 0x4b, 0xed, 0x1e, 0xf6, 0xac, 0x17, 0x43, 0x1c, 0x8c,
+	0x3f, 0x89, 0x9c, 0x3a, 0x3e, 0x1a, 0x62, 0xca, 0xa4, 0x1e, 0x94, 0x8e, 0xc2, 0xaf, 0x55, 0x74,
+	0x0f, 0xdd, 0xdf, 0xed, 0x3c, 0xfd, 0xef, 0xbc, 0xf1, 0xd8, 0x76, 0xd8, 0x60, 0xd8, 0x57, 0x4d,
+	0xe2, 0x6a, 0xcf, 0x08, 0x75, 0x3f, 0x37, 0xa8, 0xab, 0xbd, 0x34, 0xa8, 0x6b, 0x69, 0xc7, 0xd1,
+	0x5f, 0x8d, 0x8d, 0x7d, 0x4c, 0x55, 0xdd, 0x78, 0xf9, 0x8c, 0x78, 0x2c, 0x30, 0x4c, 0xd6, 0xc5,
+	0x94, 0x1a, 0x36, 0xd6, 0xf9, 0x59, 0x52, 0x15, 0x36, 0xcd, 0x81, 0xe1, 0x79, 0xf8, 0xb0, 0xba,
+	0x7e, 0x0f, 0xdd, 0xdf, 0xd6, 0x63, 0x53, 0x7a, 0x1f, 0xb6, 0x8d, 0x21, 0x1b, 0x90, 0xc0, 0x61,
+	0xe3, 0x6a, 0x31, 0xf4, 0x75, 0xaa, 0x67, 0xbf, 0x1f, 0x94, 0x05, 0xd5, 0x0f, 0x2d, 0x2b, 0xc0,
+	0x94, 0xf6, 0x58, 0xe0, 0x78, 0xb6, 0x9e, 0x84, 0x3e, 0xb9, 0xf3, 0xdd, 0xd5, 0xab, 0x07, 0x89,
+	0xad, 0x3c, 0x02, 0x39, 0x8b, 0x13, 0xf5, 0x89, 0x47, 0xb1, 0x24, 0xc3, 0x16, 0x0d, 0xf9, 0x79,
+	0x26, 0x8e, 0x78, 0x15, 0xf5, 0x89, 0xad, 0xfc, 0x84, 0x60, 0xaf, 0x4b, 0xed, 0xcf, 0x7c, 0xcb,
+	0x60, 0x78, 0xba, 0x17, 0x6d, 0xd8, 0x34, 0x38, 0x80, 0x2a, 0xba, 0x06, 0x5a, 0x1c, 0x38, 0x4d,
+	0x68, 0x2d, 0x3f, 0x21, 0xe9, 0xdf, 0x5f, 0x1a, 0x68, 0x86, 0x54, 0x0d, 0x2a, 0x73, 0xc8, 0x38,
+	0x23, 0xe5, 0x57, 0x04, 0x6f, 0xce, 0xf8, 0x3e, 0x25, 0xbe, 0x63, 0xc6, 0xd0, 0x9f, 0x42, 0x89,
+	0x85, 0x76, 0x04, 0x7c, 0xa7, 0xbd, 0xaf, 0x66, 0xed, 0x9d, 0x9a, 0x4a, 0xec, 0x14, 0x4f, 0xce,
+	0x1b, 0x05, 0x9d, 0x67, 0xdd, 0x9a, 0xc5, 0xec, 0x58, 0xea, 0x70, 0x37, 0x1b, 0xa5, 0xa0, 0xf1,
+	0x27, 0x82, 0xaa, 0x98, 0x5b, 0xe4, 0x88, 0x86, 0x17, 0x73, 0x28, 0xa7, 0x39, 0x6c, 0xc7, 0xd0,
+	0x26, 0x0b, 0xba, 0xb6, 0xc2, 0x05, 0x9d, 0xe2, 0xbb, 0x7e, 0x7b, 0xbe, 0x2d, 0xa8, 0x65, 0xd0,
+	0x11, 0x5b, 0x58, 0x86, 0x52, 0x40, 0x86, 0x9e, 0x25, 0x56, 0x90, 0x1b, 0xca, 0x5f, 0x08, 0xf6,
+	0x27, 0x3d, 0x8a, 0xb2, 0x7a, 0xc3, 0x3e, 0x35, 0x03, 0xc7, 0x67, 0x0e, 0xf1, 0xe2, 0x5e, 0xbc,
+	0x80, 0x5d, 0x9a, 0xfa, 0x2c, 0xc6, 0xfa, 0x4e, 0xf6, 0x58, 0xe7, 0x4e, 0x11, 0xc3, 0x9d, 0x3a,
+	0x42, 0xda, 0x83, 0x8d, 0x00, 0xbb, 0x64, 0x84, 0xa3, 0x4e, 0x6e, 0xe9, 0xc2, 0x5a, 0x59, 0x2f,
+	0xde, 0x06, 0x65, 0x19, 0x2f, 0xb1, 0x01, 0x3f, 0x73, 0x35, 0xe2, 0x61, 0xcf, 0x03, 0xc7, 0xc4,
+	0x1f, 0x61, 0x6c, 0xc5, 0xb4, 0x1f, 0x43, 0xf1, 0x2b, 0x8c, 0x2d, 0x41, 0xb7, 0x91, 0x4d, 0x77,
+	0x92, 0x25, 0x68, 0x46, 0x29, 0x2b, 0x5b, 0xe1, 0xbb, 0x20, 0x67, 0xe1, 0x13, 0xf0, 0xbf, 0x47,
+	0xf0, 0x46, 0x38, 0xf1, 0x61, 0xdf, 0x75, 0x58, 0xe4, 0x8e, 0xa1, 0x37, 0x61, 0x23, 0xc4, 0x81,
+	0x83, 0x6b, 0xb5, 0x43, 0xc4, 0x85, 0x03, 0xa1, 0x63, 0xb7, 0x4f, 0x0e, 0x39, 0x5c, 0x5d, 0x58,
+	0xe1, 0xde, 0xf8, 0xe1, 0xc9, 0x42, 0x3b, 0xb9, 0xf1, 0x64, 0x27, 0xc4, 0x29, 0x52, 0x95, 0x8f,
+	0x61, 0x6f, 0x16, 0x45, 0x22, 0x7d, 0x86, 0x69, 0x62, 0x9f, 0x89, 0x2e, 0x6e, 0xe9, 0x13, 0x9b,
+	0x6f, 0x80, 0x41, 0x89, 0x17, 0x17, 0xe4, 0x96, 0xf2, 0x1b, 0x9f, 0x89, 0x8e, 0x1d, 0x8f, 0x32,
+	0x83, 0x45, 0x8c, 0x71, 0x10, 0x13, 0x4b, 0x60, 0xa2, 0x29, 0x98, 0x09, 0xe1, 0xb5, 0x9c, 0x84,
+	0x57, 0xb5, 0x69, 0x7c, 0x44, 0x73, 0x70, 0x79, 0x07, 0xda, 0x7f, 0x6c, 0xc2, 0x7a, 0x97, 0xda,
+	0xd2, 0xd7, 0xb0, 0x9b, 0x16, 0x22, 0xe9, 0x61, 0xf6, 0x36, 0x65, 0xdf, 0x05, 0xf2, 0x41, 0xce,
+	0x68, 0xd1, 0x76, 0x06, 0xaf, 0xcd, 0x5c, 0x46, 0x92, 0xb6, 0xf0, 0x84, 0xec, 0xab, 0x58, 0x6e,
+	0xe6, 0x4f, 0x10, 0x55, 0xbf, 0x81, 0xd7, 0xe7, 0xb4, 0x56, 0x6a, 0xe5, 0x42, 0x9e, 0xbe, 0x3d,
+	0xe4, 0xf6, 0x4d, 0x52, 0x44, 0xed, 0x23, 0xb8, 0x33, 0xad, 0x7b, 0x92, 0xba, 0x14, 0xff, 0x9c,
+	0xde, 0xcb, 0x5a, 0xee, 0x78, 0x51, 0xf2, 0x07, 0x04, 0x95, 0x05, 0xfa, 0x22, 0x7d, 0x70, 0x0d,
+	0x85, 0x45, 0x4a, 0x2b, 0x3f, 0xba, 0x79, 0x62, 0x32, 0xf3, 0x19, 0x99, 0x58, 0x32, 0xf3, 0x6c,
+	0xc1, 0x93, 0x9b, 0xf9, 0x13, 0x44, 0xd5, 0x01, 0xec, 0xa4, 0x7e, 0xf7, 0xd2, 0xbb, 0x8b, 0x9b,
+	0x38, 0xa7, 0x51, 0xf2, 0xc3, 0x7c, 0xc1, 0x09, 0xbf, 0x99, 0xdf, 0xd8, 0x12, 0x7e, 0xd9, 0xe2,
+	0x21, 0x37, 0xf3, 0x27, 0xf0, 0xaa, 0x72, 0xe9, 0xdb, 0xab, 0x57, 0x0f, 0x50, 0xc7, 0x3e, 0xb9,
+	0xa8, 0xa3, 0xd3, 0x8b, 0x3a, 0xfa, 0xe7, 0xa2, 0x8e, 0x7e, 0xbc, 0xac, 0x17, 0x4e, 0x2f, 0xeb,
+	0x85, 0xbf, 0x2f, 0xeb, 0x05, 0xa8, 0x38, 0x24, 0xf3, 0xd0, 0xe7, 0xe8, 0x8b, 0x76, 0xea, 0x61,
+	0x90, 0x84, 0x1c, 0x38, 0x24, 0x65, 0x69, 0xc7, 0xf1, 0x43, 0x39, 0x7a, 0x24, 0xf4, 0x37, 0xa2,
+	0x57, 0xf2, 0x7b, 0xff, 0x0f, 0x00, 0xad, 0xca, 0x8e, 0x1e, 0xc9, 0x0b, 0x00, 0x00,
+}
+
+func (this *MsgUpdateOracleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateOracleRequest)
+	if !ok {
+		that2, ok := that.(MsgUpdateOracleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateOracle is the RPC endpoint for updating the oracle
+	UpdateOracle(ctx context.Context, in *MsgUpdateOracleRequest, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error)
+	// SendQueryOracle sends a query to an oracle on another chain
+	SendQueryOracle(ctx context.Context, in *MsgSendQueryOracleRequest, opts ...grpc.CallOption) (*MsgSendQueryOracleResponse, error)
+	// UpdateOracleTopic is a governance proposal endpoint for adding or replacing an oracle topic.
+	UpdateOracleTopic(ctx context.Context, in *MsgUpdateOracleTopicRequest, opts ...grpc.CallOption) (*MsgUpdateOracleTopicResponse, error)
+	// SendTopicQuery sends a query to each of a topic's oracles to start a new round of answers.
+	SendTopicQuery(ctx context.Context, in *MsgSendTopicQueryRequest, opts ...grpc.CallOption) (*MsgSendTopicQueryResponse, error)
+	// UpdateTopicSubscription is a governance proposal endpoint for adding, replacing, or removing a module's
+	// subscription to an oracle topic.
+	UpdateTopicSubscription(ctx context.Context, in *MsgUpdateTopicSubscriptionRequest, opts ...grpc.CallOption) (*MsgUpdateTopicSubscriptionResponse, error)
+	// UpdatePriceFeed is a governance proposal endpoint for adding or replacing a price feed.
+	UpdatePriceFeed(ctx context.Context, in *MsgUpdatePriceFeedRequest, opts ...grpc.CallOption) (*MsgUpdatePriceFeedResponse, error)
+	// SubmitPrice records a price for a feed. Only the feed's feeders can submit prices.
+	SubmitPrice(ctx context.Context, in *MsgSubmitPriceRequest, opts ...grpc.CallOption) (*MsgSubmitPriceResponse, error)
+	// ReinstateFeeder is a governance proposal endpoint for reinstating a feeder that was suspended from a price feed.
+	ReinstateFeeder(ctx context.Context, in *MsgReinstateFeederRequest, opts ...grpc.CallOption) (*MsgReinstateFeederResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateOracle(ctx context.Context, in *MsgUpdateOracleRequest, opts ...grpc.CallOption) (*MsgUpdateOracleResponse, error) {
+	out := new(MsgUpdateOracleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/UpdateOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendQueryOracle(ctx context.Context, in *MsgSendQueryOracleRequest, opts ...grpc.CallOption) (*MsgSendQueryOracleResponse, error) {
+	out := new(MsgSendQueryOracleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/SendQueryOracle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateOracleTopic(ctx context.Context, in *MsgUpdateOracleTopicRequest, opts ...grpc.CallOption) (*MsgUpdateOracleTopicResponse, error) {
+	out := new(MsgUpdateOracleTopicResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/UpdateOracleTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendTopicQuery(ctx context.Context, in *MsgSendTopicQueryRequest, opts ...grpc.CallOption) (*MsgSendTopicQueryResponse, error) {
+	out := new(MsgSendTopicQueryResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/SendTopicQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTopicSubscription(ctx context.Context, in *MsgUpdateTopicSubscriptionRequest, opts ...grpc.CallOption) (*MsgUpdateTopicSubscriptionResponse, error) {
+	out := new(MsgUpdateTopicSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/UpdateTopicSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePriceFeed(ctx context.Context, in *MsgUpdatePriceFeedRequest, opts ...grpc.CallOption) (*MsgUpdatePriceFeedResponse, error) {
+	out := new(MsgUpdatePriceFeedResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/UpdatePriceFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitPrice(ctx context.Context, in *MsgSubmitPriceRequest, opts ...grpc.CallOption) (*MsgSubmitPriceResponse, error) {
+	out := new(MsgSubmitPriceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/SubmitPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReinstateFeeder(ctx context.Context, in *MsgReinstateFeederRequest, opts ...grpc.CallOption) (*MsgReinstateFeederResponse, error) {
+	out := new(MsgReinstateFeederResponse)
+	err := c.cc.Invoke(ctx, "/provenance.oracle.v1.Msg/ReinstateFeeder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateOracle is the RPC endpoint for updating the oracle
+	UpdateOracle(context.Context, *MsgUpdateOracleRequest) (*MsgUpdateOracleResponse, error)
+	// SendQueryOracle sends a query to an oracle on another chain
+	SendQueryOracle(context.Context, *MsgSendQueryOracleRequest) (*MsgSendQueryOracleResponse, error)
+	// UpdateOracleTopic is a governance proposal endpoint for adding or replacing an oracle topic.
+	UpdateOracleTopic(context.Context, *MsgUpdateOracleTopicRequest) (*MsgUpdateOracleTopicResponse, error)
+	// SendTopicQuery sends a query to each of a topic's oracles to start a new round of answers.
+	SendTopicQuery(context.Context, *MsgSendTopicQueryRequest) (*MsgSendTopicQueryResponse, error)
+	// UpdateTopicSubscription is a governance proposal endpoint for adding, replacing, or removing a module's
+	// subscription to an oracle topic.
+	UpdateTopicSubscription(context.Context, *MsgUpdateTopicSubscriptionRequest) (*MsgUpdateTopicSubscriptionResponse, error)
+	// UpdatePriceFeed is a governance proposal endpoint for adding or replacing a price feed.
+	UpdatePriceFeed(context.Context, *MsgUpdatePriceFeedRequest) (*MsgUpdatePriceFeedResponse, error)
+	// SubmitPrice records a price for a feed. Only the feed's feeders can submit prices.
+	SubmitPrice(context.Context, *MsgSubmitPriceRequest) (*MsgSubmitPriceResponse, error)
+	// ReinstateFeeder is a governance proposal endpoint for reinstating a feeder that was suspended from a price feed.
+	ReinstateFeeder(context.Context, *MsgReinstateFeederRequest) (*MsgReinstateFeederResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateOracle(ctx context.Context, req *MsgUpdateOracleRequest) (*MsgUpdateOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracle not implemented")
+}
+func (*UnimplementedMsgServer) SendQueryOracle(ctx context.Context, req *MsgSendQueryOracleRequest) (*MsgSendQueryOracleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendQueryOracle not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOracleTopic(ctx context.Context, req *MsgUpdateOracleTopicRequest) (*MsgUpdateOracleTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleTopic not implemented")
+}
+func (*UnimplementedMsgServer) SendTopicQuery(ctx context.Context, req *MsgSendTopicQueryRequest) (*MsgSendTopicQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTopicQuery not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTopicSubscription(ctx context.Context, req *MsgUpdateTopicSubscriptionRequest) (*MsgUpdateTopicSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTopicSubscription not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePriceFeed(ctx context.Context, req *MsgUpdatePriceFeedRequest) (*MsgUpdatePriceFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceFeed not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPrice(ctx context.Context, req *MsgSubmitPriceRequest) (*MsgSubmitPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPrice not implemented")
+}
+func (*UnimplementedMsgServer) ReinstateFeeder(ctx context.Context, req *MsgReinstateFeederRequest) (*MsgReinstateFeederResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReinstateFeeder not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/UpdateOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracle(ctx, req.(*MsgUpdateOracleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendQueryOracle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendQueryOracleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendQueryOracle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/SendQueryOracle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendQueryOracle(ctx, req.(*MsgSendQueryOracleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOracleTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/UpdateOracleTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleTopic(ctx, req.(*MsgUpdateOracleTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendTopicQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendTopicQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendTopicQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/SendTopicQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendTopicQuery(ctx, req.(*MsgSendTopicQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTopicSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTopicSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTopicSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/UpdateTopicSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTopicSubscription(ctx, req.(*MsgUpdateTopicSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePriceFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/UpdatePriceFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceFeed(ctx, req.(*MsgUpdatePriceFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/SubmitPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPrice(ctx, req.(*MsgSubmitPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReinstateFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReinstateFeederRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReinstateFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.oracle.v1.Msg/ReinstateFeeder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReinstateFeeder(ctx, req.(*MsgReinstateFeederRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.oracle.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateOracle",
+			Handler:    _Msg_UpdateOracle_Handler,
+		},
+		{
+			MethodName: "SendQueryOracle",
+			Handler:    _Msg_SendQueryOracle_Handler,
+		},
+		{
+			MethodName: "UpdateOracleTopic",
+			Handler:    _Msg_UpdateOracleTopic_Handler,
+		},
+		{
+			MethodName: "SendTopicQuery",
+			Handler:    _Msg_SendTopicQuery_Handler,
+		},
+		{
+			MethodName: "UpdateTopicSubscription",
+			Handler:    _Msg_UpdateTopicSubscription_Handler,
+		},
+		{
+			MethodName: "UpdatePriceFeed",
+			Handler:    _Msg_UpdatePriceFeed_Handler,
+		},
+		{
+			MethodName: "SubmitPrice",
+			Handler:    _Msg_SubmitPrice_Handler,
+		},
+		{
+			MethodName: "ReinstateFeeder",
+			Handler:    _Msg_ReinstateFeeder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/oracle/v1/tx.proto",
+}
+
+func (m *MsgSendQueryOracleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendQueryOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendQueryOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendQueryOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendQueryOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendQueryOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleTopicResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleTopicResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendTopicQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendTopicQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendTopicQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendTopicQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendTopicQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendTopicQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicSubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicSubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicSubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Subscription.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTopicSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTopicSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTopicSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePriceFeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceFeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceFeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Feed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePriceFeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceFeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceFeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinstateFeederRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinstateFeederRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinstateFeederRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReinstateFeederResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReinstateFeederResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReinstateFeederResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendQueryOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendQueryOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgUpdateOracleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOracleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOracleTopicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Topic.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOracleTopicResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendTopicQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSendTopicQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicSubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subscription.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTopicSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePriceFeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Feed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceFeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReinstateFeederRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReinstateFeederResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendQueryOracleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendQueryOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendQueryOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendQueryOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendQueryOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendTopicQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendTopicQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendTopicQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendTopicQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendTopicQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendTopicQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTopicSubscriptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTopicSubscriptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTopicSubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTopicSubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTopicSubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTopicSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePriceFeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePriceFeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePriceFeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Feed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSendQueryOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovTx(uint64(m.Sequence))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgUpdateOracleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdatePriceFeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePriceFeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePriceFeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateOracleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -656,17 +3249,17 @@ func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendQueryOracleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendQueryOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -676,29 +3269,27 @@ func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
-			if m.Query == nil {
-				m.Query = []byte{}
-			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -726,11 +3317,11 @@ func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -758,7 +3349,7 @@ func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -781,7 +3372,7 @@ func (m *MsgSendQueryOracleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendQueryOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,17 +3395,17 @@ func (m *MsgSendQueryOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendQueryOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendQueryOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
-			m.Sequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -824,11 +3415,44 @@ func (m *MsgSendQueryOracleResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -850,7 +3474,7 @@ func (m *MsgSendQueryOracleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOracleRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgReinstateFeederRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -873,15 +3497,15 @@ func (m *MsgUpdateOracleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOracleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReinstateFeederRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOracleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReinstateFeederRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -909,9 +3533,41 @@ func (m *MsgUpdateOracleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -964,7 +3620,7 @@ func (m *MsgUpdateOracleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReinstateFeederResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -987,10 +3643,10 @@ func (m *MsgUpdateOracleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOracleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReinstateFeederResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOracleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReinstateFeederResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
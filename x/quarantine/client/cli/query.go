@@ -29,6 +29,8 @@ func QueryCmd() *cobra.Command {
 		QueryQuarantinedFundsCmd(),
 		QueryIsQuarantinedCmd(),
 		QueryAutoResponsesCmd(),
+		QueryAutoAcceptRulesCmd(),
+		QueryFundsExpirationCmd(),
 	)
 
 	return queryCmd
@@ -45,12 +47,17 @@ If no arguments are provided, all quarantined funds will be returned.
 If only a to_address is provided, only undeclined funds quarantined for that address are returned.
 If both a to_address and from_address are provided, quarantined funds will be returned regardless of whether they've been declined.
 
+When a to_address is provided, the results can be further limited using the --%[4]s, --%[5]s, and --%[6]s flags.
+Use the --%[7]s flag to also get funds that have been declined.
+
 Examples:
   $ %[1]s funds
   $ %[1]s funds %[2]s
   $ %[1]s funds %[2]s %[3]s
+  $ %[1]s funds %[2]s --%[4]s nhash --%[6]s 720h
 `,
-			exampleQueryCmdBase, exampleAddr1, exampleAddr2),
+			exampleQueryCmdBase, exampleAddr1, exampleAddr2,
+			FlagDenom, FlagSenders, FlagMinAge, FlagIncludeDeclined),
 		Args: cobra.RangeArgs(0, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -74,6 +81,14 @@ Examples:
 				}
 			}
 
+			req.Filter, err = ReadFilterFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if req.Filter != nil && len(req.ToAddress) == 0 {
+				return fmt.Errorf("a to_address is required when filtering quarantined funds")
+			}
+
 			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
 			if err != nil {
 				return err
@@ -91,6 +106,7 @@ Examples:
 		},
 	}
 
+	AddFilterFlagsToCmd(cmd)
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "quarantined funds")
 
@@ -202,3 +218,95 @@ Examples:
 
 	return cmd
 }
+
+// QueryAutoAcceptRulesCmd returns the command for executing an AutoAcceptRules query.
+func QueryAutoAcceptRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auto-accept-rules <to_address>",
+		Aliases: []string{"rules"},
+		Short:   "Query auto-accept rules",
+		Long: fmt.Sprintf(`Query the auto-accept rules set up for a to_address.
+
+Examples:
+  $ %[1]s auto-accept-rules %[2]s
+`,
+			exampleQueryCmdBase, exampleAddr1),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := quarantine.QueryAutoAcceptRulesRequest{}
+
+			req.ToAddress, err = validateAddress(args[0], "to_address")
+			if err != nil {
+				return err
+			}
+
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := quarantine.NewQueryClient(clientCtx)
+
+			var res *quarantine.QueryAutoAcceptRulesResponse
+			res, err = queryClient.AutoAcceptRules(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auto-accept rules")
+
+	return cmd
+}
+
+// QueryFundsExpirationCmd returns the command for executing a FundsExpiration query.
+func QueryFundsExpirationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "funds-expiration <to_address>",
+		Aliases: []string{"expiration"},
+		Short:   "Query funds expiration settings",
+		Long: fmt.Sprintf(`Query how long funds sent to a to_address can remain quarantined.
+
+Examples:
+  $ %[1]s funds-expiration %[2]s
+`,
+			exampleQueryCmdBase, exampleAddr1),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := quarantine.QueryFundsExpirationRequest{}
+
+			req.ToAddress, err = validateAddress(args[0], "to_address")
+			if err != nil {
+				return err
+			}
+
+			queryClient := quarantine.NewQueryClient(clientCtx)
+
+			var res *quarantine.QueryFundsExpirationResponse
+			res, err = queryClient.FundsExpiration(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,12 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/quarantine"
@@ -16,6 +18,8 @@ import (
 const (
 	// FlagPermanent is the flag indicating a permanent accept/decline.
 	FlagPermanent = "permanent"
+	// FlagLimit is the flag for the maximum number of quarantined funds entries a bulk accept/decline can affect.
+	FlagLimit = "limit"
 )
 
 // exampleTxCmdBase is the base command that gets a user to one of the tx commands in here.
@@ -36,7 +40,11 @@ func TxCmd() *cobra.Command {
 		TxOptOutCmd(),
 		TxAcceptCmd(),
 		TxDeclineCmd(),
+		TxBulkAcceptCmd(),
+		TxBulkDeclineCmd(),
 		TxUpdateAutoResponsesCmd(),
+		TxUpdateAutoAcceptRulesCmd(),
+		TxUpdateFundsExpirationCmd(),
 	)
 
 	return txCmd
@@ -242,6 +250,118 @@ $ %[1]s decline personal %[3]s %[4]s
 	return cmd
 }
 
+// TxBulkAcceptCmd returns the command for executing a BulkAccept Tx.
+func TxBulkAcceptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-accept <to_name_or_address>",
+		Short: "Accept all quarantined funds sent to <to_name_or_address> that match the filter flags",
+		Long: fmt.Sprintf(`Accept all quarantined funds sent to <to_name_or_address> that match the filter flags.
+Note, the '--from' flag is ignored as it is implied from [to_name_or_address] (the signer of the message).
+
+Matching funds are accepted from all of their senders and released.
+Without any filter flags, all undeclined quarantined funds are accepted.
+At most --%[1]s entries are accepted (max %[2]d). Use a query to see what's left.
+`, FlagLimit, quarantine.MaxBulkRecords),
+		Example: fmt.Sprintf(`
+$ %[1]s bulk-accept %[2]s
+$ %[1]s bulk-accept personal --%[4]s nhash
+$ %[1]s bulk-accept personal --%[5]s %[3]s --%[6]s 24h --%[7]s
+`,
+			exampleTxCmdBase, exampleAddr1, exampleAddr2, FlagDenom, FlagSenders, FlagMinAge, FlagIncludeDeclined),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, toAddr, filter, limit, err := readBulkCmdArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			msg := quarantine.NewMsgBulkAccept(toAddr, filter, limit)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	AddFilterFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagLimit, 0, "The maximum number of quarantined funds entries to accept (default is the max allowed)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// TxBulkDeclineCmd returns the command for executing a BulkDecline Tx.
+func TxBulkDeclineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-decline <to_name_or_address>",
+		Short: "Decline all quarantined funds sent to <to_name_or_address> that match the filter flags",
+		Long: fmt.Sprintf(`Decline all quarantined funds sent to <to_name_or_address> that match the filter flags.
+Note, the '--from' flag is ignored as it is implied from [to_name_or_address] (the signer of the message).
+
+Without any filter flags, all undeclined quarantined funds are declined.
+At most --%[1]s entries are declined (max %[2]d). Use a query to see what's left.
+`, FlagLimit, quarantine.MaxBulkRecords),
+		Example: fmt.Sprintf(`
+$ %[1]s bulk-decline %[2]s
+$ %[1]s bulk-decline personal --%[4]s spamcoin
+$ %[1]s bulk-decline personal --%[5]s %[3]s --%[6]s 720h
+`,
+			exampleTxCmdBase, exampleAddr1, exampleAddr2, FlagDenom, FlagSenders, FlagMinAge),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, toAddr, filter, limit, err := readBulkCmdArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			msg := quarantine.NewMsgBulkDecline(toAddr, filter, limit)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	AddFilterFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagLimit, 0, "The maximum number of quarantined funds entries to decline (default is the max allowed)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readBulkCmdArgs reads the args and flags shared by the bulk accept and decline commands.
+func readBulkCmdArgs(cmd *cobra.Command, args []string) (client.Context, sdk.AccAddress, quarantine.QuarantinedFundsFilter, uint32, error) {
+	var filter quarantine.QuarantinedFundsFilter
+	if len(args[0]) == 0 {
+		return client.Context{}, nil, filter, 0, fmt.Errorf("no to_name_or_address provided")
+	}
+	if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+		return client.Context{}, nil, filter, 0, err
+	}
+
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return client.Context{}, nil, filter, 0, err
+	}
+
+	filterPtr, err := ReadFilterFlags(cmd.Flags())
+	if err != nil {
+		return client.Context{}, nil, filter, 0, err
+	}
+	if filterPtr != nil {
+		filter = *filterPtr
+	}
+
+	limit, err := cmd.Flags().GetUint32(FlagLimit)
+	if err != nil {
+		return client.Context{}, nil, filter, 0, err
+	}
+
+	return clientCtx, clientCtx.GetFromAddress(), filter, limit, nil
+}
+
 // TxUpdateAutoResponsesCmd returns the command for executing an UpdateAutoResponses Tx.
 func TxUpdateAutoResponsesCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -304,3 +424,131 @@ $ %[1]s auto-responses personal accept %[3]s %[6]s off %[5]s
 
 	return cmd
 }
+
+// TxUpdateAutoAcceptRulesCmd returns the command for executing an UpdateAutoAcceptRules Tx.
+func TxUpdateAutoAcceptRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-auto-accept-rules <to_name_or_address> [<rule> ...]",
+		Aliases: []string{"auto-accept-rules", "uaar"},
+		Short:   "Update auto-accept rules",
+		Long: `Replace the auto-accept rules for transfers to <to_name_or_address>.
+Note, the '--from' flag is ignored as it is implied from [to_name_or_address] (the signer of the message).
+
+The <to_name_or_address> is required.
+If no <rule> args are provided, all auto-accept rules for <to_name_or_address> are removed.
+
+Each <rule> has the format "[attr=<attribute>][,denom=<denom>][,max=<amount>]" (in any order).
+A rule must have an attr and/or a denom. A max can only be provided with a denom.
+  attr - the sender must have this attribute for the rule to apply.
+  denom - the rule only applies to funds of this denom.
+  max - the rule only applies if the amount of the denom is at most this.
+
+Funds are auto-accepted if every coin being sent is allowed by at least one rule.
+Rules are not applied to senders that have been set to auto-decline.
+`,
+		Example: fmt.Sprintf(`
+$ %[1]s update-auto-accept-rules %[2]s attr=kyc.pb
+$ %[1]s update-auto-accept-rules personal denom=nhash,max=1000 attr=kyc.pb,denom=usd
+$ %[1]s auto-accept-rules personal
+`,
+			exampleTxCmdBase, exampleAddr1),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args[0]) == 0 {
+				return fmt.Errorf("no to_name_or_address provided")
+			}
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr := clientCtx.GetFromAddress()
+
+			var rules []quarantine.AutoAcceptRule
+			rules, err = ParseAutoAcceptRulesFromArgs(args, 1)
+			if err != nil {
+				return err
+			}
+
+			msg := quarantine.NewMsgUpdateAutoAcceptRules(toAddr, rules)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// TxUpdateFundsExpirationCmd returns the command for executing an UpdateFundsExpiration Tx.
+func TxUpdateFundsExpirationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-funds-expiration <to_name_or_address> <timeout> [<fallback_address>]",
+		Aliases: []string{"funds-expiration", "ufe"},
+		Short:   "Update how long funds can remain quarantined",
+		Long: `Update how long funds sent to <to_name_or_address> can remain quarantined before they expire.
+Note, the '--from' flag is ignored as it is implied from [to_name_or_address] (the signer of the message).
+
+The <timeout> is a duration, e.g. "72h" or "30m". Provide "0" or "off" to turn off expiration.
+When funds expire, they are sent to the <fallback_address> if one is provided, otherwise, they are returned to the sender.
+Funds from multiple senders (e.g. from a MultiSend) can only expire if there is a <fallback_address>.
+
+New settings only apply to funds quarantined from now on.
+`,
+		Example: fmt.Sprintf(`
+$ %[1]s update-funds-expiration %[2]s 72h
+$ %[1]s update-funds-expiration personal 720h %[3]s
+$ %[1]s funds-expiration personal off
+`,
+			exampleTxCmdBase, exampleAddr1, exampleAddr2),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args[0]) == 0 {
+				return fmt.Errorf("no to_name_or_address provided")
+			}
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr := clientCtx.GetFromAddress()
+
+			var timeout time.Duration
+			timeout, err = ParseTimeoutArg(args[1])
+			if err != nil {
+				return err
+			}
+
+			var fallbackAddr sdk.AccAddress
+			if len(args) > 2 {
+				fallbackAddr, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return fmt.Errorf("invalid fallback_address: %w", err)
+				}
+			}
+
+			msg := quarantine.NewMsgUpdateFundsExpiration(toAddr, timeout, fallbackAddr)
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
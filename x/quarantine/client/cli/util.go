@@ -3,8 +3,13 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +17,57 @@ import (
 	"github.com/provenance-io/provenance/x/quarantine"
 )
 
+const (
+	// FlagDenom is the flag for limiting quarantined funds to those that include a denom.
+	FlagDenom = "denom"
+	// FlagSenders is the flag for limiting quarantined funds to those from some senders.
+	FlagSenders = "senders"
+	// FlagMinAge is the flag for limiting quarantined funds to those that have been quarantined for a while.
+	FlagMinAge = "min-age"
+	// FlagIncludeDeclined is the flag for including quarantined funds that have been declined.
+	FlagIncludeDeclined = "include-declined"
+)
+
+// AddFilterFlagsToCmd adds the flags for defining a QuarantinedFundsFilter to the provided command.
+func AddFilterFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDenom, "", "Only select quarantined funds that include this denom")
+	cmd.Flags().StringSlice(FlagSenders, nil, "Only select quarantined funds sent from any of these addresses (comma separated, repeatable)")
+	cmd.Flags().Duration(FlagMinAge, 0, "Only select quarantined funds that have been quarantined for at least this long, e.g. 72h")
+	cmd.Flags().Bool(FlagIncludeDeclined, false, "Also select quarantined funds that have been declined")
+}
+
+// ReadFilterFlags reads the flags added by AddFilterFlagsToCmd into a QuarantinedFundsFilter.
+// Returns nil if none of the filter flags were provided.
+func ReadFilterFlags(flagSet *pflag.FlagSet) (*quarantine.QuarantinedFundsFilter, error) {
+	if !flagSet.Changed(FlagDenom) && !flagSet.Changed(FlagSenders) && !flagSet.Changed(FlagMinAge) && !flagSet.Changed(FlagIncludeDeclined) {
+		return nil, nil
+	}
+
+	rv := &quarantine.QuarantinedFundsFilter{}
+	var err error
+	if rv.Denom, err = flagSet.GetString(FlagDenom); err != nil {
+		return nil, err
+	}
+	var senders []string
+	if senders, err = flagSet.GetStringSlice(FlagSenders); err != nil {
+		return nil, err
+	}
+	for i, sender := range senders {
+		var addr string
+		if addr, err = validateAddress(sender, fmt.Sprintf("--%s %d", FlagSenders, i+1)); err != nil {
+			return nil, err
+		}
+		rv.FromAddresses = append(rv.FromAddresses, addr)
+	}
+	if rv.MinAge, err = flagSet.GetDuration(FlagMinAge); err != nil {
+		return nil, err
+	}
+	if rv.IncludeDeclined, err = flagSet.GetBool(FlagIncludeDeclined); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
 // exampleAddr creates a consistent example address from the given name string.
 func exampleAddr(name string) sdk.AccAddress {
 	// The correct HRP may or may not be set yet.
@@ -103,3 +159,68 @@ func ParseAutoResponseArg(arg string) (quarantine.AutoResponse, bool) {
 		return quarantine.AUTO_RESPONSE_UNSPECIFIED, false
 	}
 }
+
+// ParseAutoAcceptRulesFromArgs parses the args to extract the desired AutoAcceptRule entries.
+// The args should be the entire args list. Parsing of the rule args will start at startIndex.
+func ParseAutoAcceptRulesFromArgs(args []string, startIndex int) ([]quarantine.AutoAcceptRule, error) {
+	var rv []quarantine.AutoAcceptRule
+	for i, arg := range args[startIndex:] {
+		rule, err := ParseAutoAcceptRuleArg(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid arg %d %q: %w", i+startIndex+1, arg, err)
+		}
+		rv = append(rv, rule)
+	}
+	return rv, nil
+}
+
+// ParseAutoAcceptRuleArg converts the provided arg to an AutoAcceptRule.
+// The arg should have the format "[attr=<attribute>][,denom=<denom>][,max=<amount>]".
+func ParseAutoAcceptRuleArg(arg string) (quarantine.AutoAcceptRule, error) {
+	rule := quarantine.AutoAcceptRule{MaxAmount: sdkmath.ZeroInt()}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(arg, ",") {
+		key, value, found := strings.Cut(part, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if !found || len(value) == 0 {
+			return quarantine.AutoAcceptRule{}, fmt.Errorf("expected <key>=<value>, got %q", part)
+		}
+		if seen[key] {
+			return quarantine.AutoAcceptRule{}, fmt.Errorf("duplicate key %q", key)
+		}
+		seen[key] = true
+		switch key {
+		case "attr", "attribute":
+			rule.Attribute = value
+		case "denom":
+			rule.Denom = value
+		case "max":
+			amt, ok := sdkmath.NewIntFromString(value)
+			if !ok {
+				return quarantine.AutoAcceptRule{}, fmt.Errorf("invalid max amount %q", value)
+			}
+			rule.MaxAmount = amt
+		default:
+			return quarantine.AutoAcceptRule{}, fmt.Errorf("unknown key %q", key)
+		}
+	}
+	return rule, nil
+}
+
+// ParseTimeoutArg converts the provided arg to a funds expiration timeout.
+// The values "off" and "0" both result in a zero timeout.
+func ParseTimeoutArg(arg string) (time.Duration, error) {
+	switch strings.ToLower(strings.TrimSpace(arg)) {
+	case "off", "0":
+		return 0, nil
+	}
+	rv, err := time.ParseDuration(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q: %w", arg, err)
+	}
+	if rv < 0 {
+		return 0, fmt.Errorf("invalid timeout %q: cannot be negative", arg)
+	}
+	return rv, nil
+}
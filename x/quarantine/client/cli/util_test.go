@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/testutil/assertions"
@@ -327,3 +330,90 @@ func TestParseAutoResponseArg(t *testing.T) {
 		})
 	}
 }
+
+func TestParseAutoAcceptRulesFromArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected []quarantine.AutoAcceptRule
+		expErr   string
+	}{
+		{
+			name:     "no rules",
+			args:     []string{"addr"},
+			expected: nil,
+		},
+		{
+			name: "attribute only",
+			args: []string{"addr", "attr=kyc.pb"},
+			expected: []quarantine.AutoAcceptRule{
+				quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt()),
+			},
+		},
+		{
+			name: "two rules",
+			args: []string{"addr", "max=1000,denom=nhash", "attribute=kyc.pb, denom=usd"},
+			expected: []quarantine.AutoAcceptRule{
+				quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(1000)),
+				quarantine.NewAutoAcceptRule("kyc.pb", "usd", sdkmath.ZeroInt()),
+			},
+		},
+		{
+			name:   "missing value",
+			args:   []string{"addr", "attr=kyc.pb", "denom="},
+			expErr: `invalid arg 3 "denom=": expected <key>=<value>, got "denom="`,
+		},
+		{
+			name:   "no equals",
+			args:   []string{"addr", "nhash"},
+			expErr: `invalid arg 2 "nhash": expected <key>=<value>, got "nhash"`,
+		},
+		{
+			name:   "duplicate key",
+			args:   []string{"addr", "denom=nhash,denom=usd"},
+			expErr: `invalid arg 2 "denom=nhash,denom=usd": duplicate key "denom"`,
+		},
+		{
+			name:   "unknown key",
+			args:   []string{"addr", "amount=5"},
+			expErr: `invalid arg 2 "amount=5": unknown key "amount"`,
+		},
+		{
+			name:   "bad max",
+			args:   []string{"addr", "denom=nhash,max=lots"},
+			expErr: `invalid arg 2 "denom=nhash,max=lots": invalid max amount "lots"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := ParseAutoAcceptRulesFromArgs(tc.args, 1)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseAutoAcceptRulesFromArgs error")
+			assert.Equal(t, tc.expected, actual, "ParseAutoAcceptRulesFromArgs result")
+		})
+	}
+}
+
+func TestParseTimeoutArg(t *testing.T) {
+	tests := []struct {
+		arg    string
+		exp    time.Duration
+		expErr string
+	}{
+		{arg: "off", exp: 0},
+		{arg: "OFF", exp: 0},
+		{arg: "0", exp: 0},
+		{arg: "72h", exp: 72 * time.Hour},
+		{arg: "1h30m", exp: 90 * time.Minute},
+		{arg: "-5m", expErr: `invalid timeout "-5m": cannot be negative`},
+		{arg: "soon", expErr: `invalid timeout "soon": time: invalid duration "soon"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.arg, func(t *testing.T) {
+			actual, err := ParseTimeoutArg(tc.arg)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseTimeoutArg error")
+			assert.Equal(t, tc.exp, actual, "ParseTimeoutArg result")
+		})
+	}
+}
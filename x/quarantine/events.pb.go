@@ -223,11 +223,166 @@ func (m *EventFundsReleased) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+// EventAutoAcceptRulesUpdated is an event emitted when the auto-accept rules of an address are updated.
+type EventAutoAcceptRulesUpdated struct {
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+}
+
+func (m *EventAutoAcceptRulesUpdated) Reset()         { *m = EventAutoAcceptRulesUpdated{} }
+func (m *EventAutoAcceptRulesUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventAutoAcceptRulesUpdated) ProtoMessage()    {}
+func (*EventAutoAcceptRulesUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c74f079d23a045, []int{4}
+}
+func (m *EventAutoAcceptRulesUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoAcceptRulesUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoAcceptRulesUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoAcceptRulesUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoAcceptRulesUpdated.Merge(m, src)
+}
+func (m *EventAutoAcceptRulesUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoAcceptRulesUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoAcceptRulesUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoAcceptRulesUpdated proto.InternalMessageInfo
+
+func (m *EventAutoAcceptRulesUpdated) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// EventFundsExpirationUpdated is an event emitted when the funds expiration settings of an address are updated.
+type EventFundsExpirationUpdated struct {
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+}
+
+func (m *EventFundsExpirationUpdated) Reset()         { *m = EventFundsExpirationUpdated{} }
+func (m *EventFundsExpirationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFundsExpirationUpdated) ProtoMessage()    {}
+func (*EventFundsExpirationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c74f079d23a045, []int{5}
+}
+func (m *EventFundsExpirationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFundsExpirationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFundsExpirationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFundsExpirationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundsExpirationUpdated.Merge(m, src)
+}
+func (m *EventFundsExpirationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFundsExpirationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundsExpirationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFundsExpirationUpdated proto.InternalMessageInfo
+
+func (m *EventFundsExpirationUpdated) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// EventFundsExpired is an event emitted when quarantined funds expire and are sent away from the quarantine funds holder.
+type EventFundsExpired struct {
+	ToAddress string                                   `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Coins     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// sent_to is the address that received the expired funds: either the sender or the fallback address.
+	SentTo string `protobuf:"bytes,3,opt,name=sent_to,json=sentTo,proto3" json:"sent_to,omitempty"`
+}
+
+func (m *EventFundsExpired) Reset()         { *m = EventFundsExpired{} }
+func (m *EventFundsExpired) String() string { return proto.CompactTextString(m) }
+func (*EventFundsExpired) ProtoMessage()    {}
+func (*EventFundsExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c74f079d23a045, []int{6}
+}
+func (m *EventFundsExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFundsExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFundsExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFundsExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundsExpired.Merge(m, src)
+}
+func (m *EventFundsExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFundsExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundsExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFundsExpired proto.InternalMessageInfo
+
+func (m *EventFundsExpired) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventFundsExpired) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *EventFundsExpired) GetSentTo() string {
+	if m != nil {
+		return m.SentTo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventOptIn)(nil), "cosmos.quarantine.v1beta1.EventOptIn")
 	proto.RegisterType((*EventOptOut)(nil), "cosmos.quarantine.v1beta1.EventOptOut")
 	proto.RegisterType((*EventFundsQuarantined)(nil), "cosmos.quarantine.v1beta1.EventFundsQuarantined")
 	proto.RegisterType((*EventFundsReleased)(nil), "cosmos.quarantine.v1beta1.EventFundsReleased")
+	proto.RegisterType((*EventAutoAcceptRulesUpdated)(nil), "cosmos.quarantine.v1beta1.EventAutoAcceptRulesUpdated")
+	proto.RegisterType((*EventFundsExpirationUpdated)(nil), "cosmos.quarantine.v1beta1.EventFundsExpirationUpdated")
+	proto.RegisterType((*EventFundsExpired)(nil), "cosmos.quarantine.v1beta1.EventFundsExpired")
 }
 
 func init() {
@@ -235,30 +390,35 @@ func init() {
 }
 
 var fileDescriptor_33c74f079d23a045 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2c, 0x4d, 0x2c, 0x4a, 0xcc, 0x2b, 0xc9, 0xcc, 0x4b, 0xd5, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x84, 0xa8, 0xd3, 0x43, 0xa8, 0xd3, 0x83, 0xaa, 0x93, 0x12, 0x4c, 0xcc,
-	0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xd5, 0x52, 0x72, 0x50, 0x53, 0x93, 0x12, 0x8b, 0x11,
-	0xe6, 0x25, 0xe7, 0x67, 0xe6, 0x41, 0xe5, 0xa1, 0xa6, 0xc5, 0x83, 0x79, 0xfa, 0x50, 0xa3, 0x21,
-	0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b, 0x22, 0xaa, 0xe4, 0xca, 0xc5, 0xe5,
-	0x0a, 0x72, 0x8e, 0x7f, 0x41, 0x89, 0x67, 0x9e, 0x90, 0x39, 0x17, 0x57, 0x49, 0x7e, 0x7c, 0x62,
-	0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d,
-	0xba, 0x22, 0x50, 0x93, 0x1c, 0x21, 0x32, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x9c, 0x25,
-	0xf9, 0x50, 0x01, 0x25, 0x37, 0x2e, 0x6e, 0x98, 0x31, 0xfe, 0xa5, 0x25, 0xe4, 0x9b, 0x73, 0x92,
-	0x91, 0x4b, 0x14, 0x6c, 0x90, 0x5b, 0x69, 0x5e, 0x4a, 0x71, 0x20, 0x3c, 0x4c, 0x52, 0xc8, 0x36,
-	0x52, 0xa8, 0x9c, 0x8b, 0x15, 0x14, 0x40, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92,
-	0x7a, 0x50, 0x0d, 0xa0, 0x20, 0x84, 0x05, 0xb5, 0x9e, 0x73, 0x7e, 0x66, 0x9e, 0x93, 0xdb, 0x89,
-	0x7b, 0xf2, 0x0c, 0xab, 0xee, 0xcb, 0x6b, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0x42, 0x83, 0x10, 0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83,
-	0x35, 0x14, 0xcf, 0x7a, 0xbe, 0x41, 0x8b, 0x27, 0x27, 0x35, 0x3d, 0x31, 0xb9, 0x32, 0x1e, 0x6c,
-	0xc7, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0x20, 0xf6, 0x29, 0x1d, 0x63, 0xe4, 0x12, 0x42, 0xf8,
-	0x25, 0x28, 0x35, 0x27, 0x35, 0xb1, 0x78, 0x28, 0x7a, 0xc4, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0x90, 0x2c, 0x28, 0x28, 0xca, 0x2f, 0x4b, 0xcd, 0x4b,
-	0xcc, 0x4b, 0x4e, 0xd5, 0xcd, 0xcc, 0x47, 0xe2, 0xe9, 0x57, 0x20, 0xe5, 0x81, 0x24, 0x36, 0x70,
-	0xb2, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x22, 0x32, 0x90, 0x8f, 0x1f, 0x03, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xbd, 0x8e, 0xd3, 0x40,
+	0x14, 0x85, 0x3d, 0xac, 0x58, 0xb4, 0xb3, 0x34, 0x6b, 0x2d, 0x92, 0x77, 0x91, 0xbc, 0xab, 0x14,
+	0x28, 0x5a, 0x29, 0x36, 0x81, 0x82, 0x3a, 0x41, 0x89, 0x04, 0xcd, 0x0a, 0xf3, 0x53, 0xd0, 0x58,
+	0x63, 0x7b, 0x64, 0x46, 0x24, 0x73, 0x8d, 0xe7, 0x3a, 0x24, 0x6f, 0x41, 0xcd, 0x13, 0x20, 0xaa,
+	0x14, 0x3c, 0x03, 0x0a, 0x5d, 0x44, 0x45, 0x05, 0x28, 0x29, 0xf2, 0x0a, 0x94, 0xc8, 0xe3, 0xc9,
+	0x8f, 0x68, 0x90, 0x92, 0x2a, 0x8d, 0x3d, 0x73, 0xef, 0x99, 0x6f, 0xee, 0x39, 0xc5, 0xd0, 0x7b,
+	0x31, 0xa8, 0x3e, 0x28, 0xff, 0x5d, 0xc1, 0x72, 0x26, 0x51, 0x48, 0xee, 0x0f, 0x9a, 0x11, 0x47,
+	0xd6, 0xf4, 0xf9, 0x80, 0x4b, 0x54, 0x5e, 0x96, 0x03, 0x82, 0x7d, 0x56, 0xe9, 0xbc, 0xb5, 0xce,
+	0x33, 0xba, 0xf3, 0x13, 0xd6, 0x17, 0x12, 0x7c, 0xfd, 0xad, 0xd4, 0xe7, 0xae, 0xa1, 0x46, 0x4c,
+	0xad, 0x79, 0x31, 0x08, 0x69, 0xfa, 0x86, 0x16, 0xea, 0x9d, 0x6f, 0xd0, 0x55, 0xeb, 0x34, 0x85,
+	0x14, 0xaa, 0x7a, 0xb9, 0xaa, 0xaa, 0xb5, 0x0e, 0xa5, 0x9d, 0x72, 0x9c, 0xeb, 0x0c, 0x9f, 0x48,
+	0xfb, 0x11, 0xa5, 0x08, 0x21, 0x4b, 0x92, 0x9c, 0x2b, 0xe5, 0x90, 0x4b, 0x52, 0x3f, 0x6a, 0x3b,
+	0xdf, 0xbf, 0x34, 0x4e, 0x0d, 0xa9, 0x55, 0x75, 0x9e, 0x63, 0x2e, 0x64, 0x1a, 0x1c, 0x21, 0x98,
+	0x42, 0xad, 0x4b, 0x8f, 0x97, 0x98, 0xeb, 0x02, 0xb7, 0xe7, 0x7c, 0x23, 0xf4, 0x8e, 0x06, 0x75,
+	0x0b, 0x99, 0xa8, 0x67, 0xab, 0x4c, 0x92, 0xad, 0x91, 0xf6, 0x7b, 0x7a, 0xb3, 0x0c, 0x48, 0x39,
+	0x37, 0x2e, 0x0f, 0xea, 0xc7, 0x0f, 0xce, 0x3c, 0x73, 0xa0, 0x8c, 0x70, 0x19, 0xb5, 0xf7, 0x18,
+	0x84, 0x6c, 0x77, 0x27, 0x3f, 0x2f, 0xac, 0xcf, 0xbf, 0x2e, 0xea, 0xa9, 0xc0, 0x37, 0x45, 0xe4,
+	0xc5, 0xd0, 0x37, 0x11, 0x9a, 0x5f, 0x43, 0x25, 0x6f, 0x7d, 0x1c, 0x65, 0x5c, 0xe9, 0x03, 0xea,
+	0xe3, 0x62, 0x7c, 0x75, 0xbb, 0xc7, 0x53, 0x16, 0x8f, 0x42, 0x7d, 0xc7, 0xa7, 0xc5, 0xf8, 0x8a,
+	0x04, 0xd5, 0x7d, 0xb5, 0xaf, 0x84, 0xda, 0x6b, 0x2f, 0x01, 0xef, 0x71, 0xa6, 0xf6, 0xd2, 0xc8,
+	0x2b, 0x7a, 0x57, 0xfb, 0x68, 0x15, 0x08, 0xad, 0x38, 0xe6, 0x19, 0x06, 0x45, 0x8f, 0xab, 0x97,
+	0x59, 0xc2, 0x70, 0x07, 0x43, 0x2b, 0xae, 0xce, 0xa7, 0x33, 0xcc, 0x44, 0xce, 0x50, 0x80, 0xdc,
+	0x99, 0xfb, 0x87, 0xd0, 0x93, 0x7f, 0xc0, 0xfb, 0x98, 0xbb, 0xdd, 0xa4, 0xb7, 0x14, 0x97, 0x18,
+	0x22, 0x38, 0x07, 0xff, 0x19, 0xf7, 0xb0, 0x14, 0xbe, 0x80, 0xf6, 0xd3, 0xc9, 0xcc, 0x25, 0xd3,
+	0x99, 0x4b, 0x7e, 0xcf, 0x5c, 0xf2, 0x61, 0xee, 0x5a, 0xd3, 0xb9, 0x6b, 0xfd, 0x98, 0xbb, 0xd6,
+	0xeb, 0xfb, 0x1b, 0x33, 0x65, 0x39, 0x0c, 0xb8, 0x64, 0x32, 0xe6, 0x0d, 0x01, 0x1b, 0x3b, 0x7f,
+	0xb8, 0xf1, 0x5c, 0x45, 0x87, 0xfa, 0x85, 0x78, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x64,
+	0xa7, 0xa6, 0xca, 0x04, 0x00, 0x00,
 }
 
 func (m *EventOptIn) Marshal() (dAtA []byte, err error) {
@@ -409,6 +569,117 @@ func (m *EventFundsReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAutoAcceptRulesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoAcceptRulesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoAcceptRulesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFundsExpirationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundsExpirationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundsExpirationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFundsExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundsExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundsExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SentTo) > 0 {
+		i -= len(m.SentTo)
+		copy(dAtA[i:], m.SentTo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SentTo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -484,6 +755,55 @@ func (m *EventFundsReleased) Size() (n int) {
 	return n
 }
 
+func (m *EventAutoAcceptRulesUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundsExpirationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundsExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.SentTo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -886,6 +1206,318 @@ func (m *EventFundsReleased) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAutoAcceptRulesUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoAcceptRulesUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoAcceptRulesUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundsExpirationUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundsExpirationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundsExpirationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFundsExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFundsExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFundsExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 // AccountKeeper defines the account/auth functionality needed from within the quarantine module.
@@ -21,3 +23,8 @@ type BankKeeper interface {
 	SendCoins(context context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SpendableCoins(context context.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// AttributeKeeper defines the attribute functionality needed from within the quarantine module.
+type AttributeKeeper interface {
+	GetAttributes(ctx sdk.Context, addr string, name string) ([]attrtypes.Attribute, error)
+}
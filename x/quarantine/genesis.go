@@ -24,6 +24,16 @@ func (gs GenesisState) Validate() error {
 			return errors.Wrapf(err, "invalid quarantined funds[%d]", i)
 		}
 	}
+	for i, entry := range gs.AutoAcceptRules {
+		if err := entry.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid quarantine auto-accept rules entry[%d]", i)
+		}
+	}
+	for i, entry := range gs.FundsExpirations {
+		if err := entry.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid quarantine funds expiration entry[%d]", i)
+		}
+	}
 	return nil
 }
 
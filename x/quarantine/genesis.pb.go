@@ -6,6 +6,7 @@ package quarantine
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -31,6 +32,10 @@ type GenesisState struct {
 	AutoResponses []*AutoResponseEntry `protobuf:"bytes,2,rep,name=auto_responses,json=autoResponses,proto3" json:"auto_responses,omitempty"`
 	// quarantined_funds defines funds that are quarantined.
 	QuarantinedFunds []*QuarantinedFunds `protobuf:"bytes,3,rep,name=quarantined_funds,json=quarantinedFunds,proto3" json:"quarantined_funds,omitempty"`
+	// auto_accept_rules defines the auto-accept rules for addresses.
+	AutoAcceptRules []AutoAcceptRulesEntry `protobuf:"bytes,4,rep,name=auto_accept_rules,json=autoAcceptRules,proto3" json:"auto_accept_rules"`
+	// funds_expirations defines the funds expiration settings for addresses.
+	FundsExpirations []FundsExpirationEntry `protobuf:"bytes,5,rep,name=funds_expirations,json=fundsExpirations,proto3" json:"funds_expirations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +92,20 @@ func (m *GenesisState) GetQuarantinedFunds() []*QuarantinedFunds {
 	return nil
 }
 
+func (m *GenesisState) GetAutoAcceptRules() []AutoAcceptRulesEntry {
+	if m != nil {
+		return m.AutoAcceptRules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundsExpirations() []FundsExpirationEntry {
+	if m != nil {
+		return m.FundsExpirations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.quarantine.v1beta1.GenesisState")
 }
@@ -96,26 +115,31 @@ func init() {
 }
 
 var fileDescriptor_1a60633c09654351 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2c, 0x4d, 0x2c, 0x4a, 0xcc, 0x2b, 0xc9, 0xcc, 0x4b, 0xd5, 0x2f, 0x33,
-	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x28, 0xd4, 0x43, 0x28, 0xd4, 0x83, 0x2a, 0x94, 0xd2, 0xc2,
-	0x6d, 0x06, 0x92, 0x6a, 0xb0, 0x31, 0x52, 0x50, 0x63, 0xe2, 0xc1, 0x3c, 0x7d, 0xa8, 0x99, 0x60,
-	0x8e, 0x52, 0x0f, 0x13, 0x17, 0x8f, 0x3b, 0xc4, 0xce, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x5f,
-	0x2e, 0x51, 0x84, 0xfe, 0x94, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xd4, 0x62, 0x09,
-	0x46, 0x05, 0x66, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x26, 0x38, 0x42, 0xe4,
-	0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x44, 0x90, 0xb4, 0x39, 0xc2, 0x74, 0x09, 0x05, 0x73,
-	0xf1, 0x25, 0x96, 0x96, 0xe4, 0xc7, 0x17, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x81, 0xcc, 0x61, 0x52,
-	0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd1, 0xc3, 0xe9, 0x35, 0x3d, 0xc7, 0xd2, 0x92, 0xfc, 0x20, 0xa8,
-	0x7a, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20, 0xde, 0x44, 0x24, 0xa1, 0x62, 0xa1, 0x08, 0x2e, 0x41,
-	0x64, 0x37, 0xa6, 0x95, 0xe6, 0xa5, 0x14, 0x4b, 0x30, 0x83, 0xcd, 0xd5, 0xc6, 0x63, 0x6e, 0x20,
-	0x42, 0x8f, 0x1b, 0x48, 0x4b, 0x90, 0x40, 0x21, 0x9a, 0x88, 0x93, 0xd7, 0x89, 0x47, 0x72, 0x8c,
-	0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72,
-	0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x17, 0x14, 0xe5, 0x97, 0xa5, 0xe6, 0x25, 0xe6, 0x25, 0xa7, 0xea, 0x66, 0xe6, 0x23,
-	0xf1, 0xf4, 0x2b, 0x90, 0xc2, 0x3e, 0x89, 0x0d, 0x1c, 0xc2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xfd, 0xad, 0xe4, 0xe9, 0xee, 0x01, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x4a, 0xc3, 0x30,
+	0x00, 0xc7, 0x5b, 0x3b, 0x05, 0xeb, 0xd7, 0x56, 0x26, 0xd4, 0x1d, 0xea, 0xf0, 0xe2, 0x50, 0xd7,
+	0x3a, 0x7d, 0x82, 0x0e, 0xa6, 0x20, 0x78, 0xb0, 0xbb, 0x88, 0x97, 0x92, 0xb6, 0x59, 0x0d, 0xb8,
+	0xa4, 0x4b, 0xd2, 0x31, 0xdf, 0xc2, 0x87, 0xf1, 0x21, 0x76, 0x1c, 0x7a, 0xf1, 0x24, 0xb2, 0xbd,
+	0x88, 0x34, 0xad, 0x2e, 0x13, 0xe6, 0x6e, 0xcd, 0xff, 0xe3, 0x97, 0x7f, 0x21, 0xfa, 0x71, 0x48,
+	0x58, 0x9f, 0x30, 0x67, 0x90, 0x02, 0x0a, 0x30, 0x47, 0x18, 0x3a, 0xc3, 0x56, 0x00, 0x39, 0x68,
+	0x39, 0x31, 0xc4, 0x90, 0x21, 0x66, 0x27, 0x94, 0x70, 0x62, 0x1c, 0xe4, 0x41, 0x7b, 0x1e, 0xb4,
+	0x8b, 0x60, 0xed, 0x64, 0x39, 0x43, 0x4a, 0x0b, 0x4c, 0xad, 0xc0, 0xf8, 0xe2, 0xe4, 0x14, 0xcc,
+	0xdc, 0xaa, 0xc6, 0x24, 0x26, 0xb9, 0x9e, 0x7d, 0xe5, 0xea, 0xd1, 0xbb, 0xa6, 0x6f, 0x5f, 0xe7,
+	0x4b, 0xba, 0x1c, 0x70, 0x68, 0xdc, 0xea, 0xfb, 0x73, 0x6a, 0xe4, 0x83, 0x28, 0xa2, 0x90, 0x31,
+	0xc8, 0x4c, 0xb5, 0xae, 0x35, 0x36, 0xdb, 0xe6, 0xdb, 0x6b, 0xb3, 0x5a, 0x70, 0xdd, 0xdc, 0xeb,
+	0x72, 0x8a, 0x70, 0xec, 0x55, 0xa5, 0x9a, 0xfb, 0xd3, 0x32, 0xba, 0xfa, 0x2e, 0x48, 0x39, 0xf1,
+	0x29, 0x64, 0x09, 0xc1, 0x19, 0x67, 0xad, 0xae, 0x35, 0xb6, 0x2e, 0xce, 0xec, 0xa5, 0x3f, 0x6c,
+	0xbb, 0x29, 0x27, 0x5e, 0x91, 0xef, 0x60, 0x4e, 0x9f, 0xbd, 0x1d, 0x20, 0x49, 0xcc, 0xb8, 0xd7,
+	0x2b, 0xf2, 0xc6, 0x5e, 0x8a, 0x23, 0x66, 0x6a, 0x82, 0x7b, 0xfa, 0x0f, 0xf7, 0x6e, 0xde, 0xb9,
+	0xca, 0x2a, 0x5e, 0x79, 0xf0, 0x47, 0x31, 0x80, 0x5e, 0x11, 0x73, 0x41, 0x18, 0xc2, 0x84, 0xfb,
+	0x34, 0x7d, 0x82, 0xcc, 0x2c, 0x09, 0xb2, 0xb3, 0x62, 0xb1, 0x2b, 0x2a, 0x5e, 0xd6, 0x10, 0xa3,
+	0xdb, 0xa5, 0xf1, 0xe7, 0xa1, 0xe2, 0xed, 0x81, 0x45, 0xcf, 0x08, 0xf4, 0x8a, 0x18, 0xec, 0xc3,
+	0x51, 0x82, 0x28, 0xe0, 0x88, 0x60, 0x66, 0xae, 0xaf, 0xbc, 0x42, 0xec, 0xeb, 0xfc, 0x56, 0xe4,
+	0x2b, 0xca, 0xbd, 0x45, 0x8f, 0xb5, 0x6f, 0xc6, 0x53, 0x4b, 0x9d, 0x4c, 0x2d, 0xf5, 0x6b, 0x6a,
+	0xa9, 0x2f, 0x33, 0x4b, 0x99, 0xcc, 0x2c, 0xe5, 0x63, 0x66, 0x29, 0x0f, 0xe7, 0x31, 0xe2, 0x8f,
+	0x69, 0x60, 0x87, 0xa4, 0xef, 0x24, 0x94, 0x0c, 0x21, 0x06, 0x38, 0x84, 0x4d, 0x44, 0xa4, 0x93,
+	0x33, 0x92, 0x1e, 0x56, 0xb0, 0x21, 0x1e, 0xca, 0xe5, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5,
+	0x89, 0x8d, 0xb6, 0xcb, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +162,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FundsExpirations) > 0 {
+		for iNdEx := len(m.FundsExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundsExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AutoAcceptRules) > 0 {
+		for iNdEx := len(m.AutoAcceptRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoAcceptRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.QuarantinedFunds) > 0 {
 		for iNdEx := len(m.QuarantinedFunds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -213,6 +265,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoAcceptRules) > 0 {
+		for _, e := range m.AutoAcceptRules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundsExpirations) > 0 {
+		for _, e := range m.FundsExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -351,6 +415,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoAcceptRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoAcceptRules = append(m.AutoAcceptRules, AutoAcceptRulesEntry{})
+			if err := m.AutoAcceptRules[len(m.AutoAcceptRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsExpirations = append(m.FundsExpirations, FundsExpirationEntry{})
+			if err := m.FundsExpirations[len(m.FundsExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
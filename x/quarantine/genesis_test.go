@@ -2,9 +2,12 @@ package quarantine_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/provenance-io/provenance/testutil/assertions"
 	"github.com/provenance-io/provenance/x/quarantine"
 	"github.com/provenance-io/provenance/x/quarantine/testutil"
@@ -39,6 +42,15 @@ func TestGenesisState_Validate(t *testing.T) {
 		Declined:                false,
 	}
 
+	goodRules := quarantine.AutoAcceptRulesEntry{
+		ToAddress: testAddr0,
+		Rules:     []quarantine.AutoAcceptRule{quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt())},
+	}
+	badRules := quarantine.AutoAcceptRulesEntry{ToAddress: testAddr1}
+
+	goodExpiration := quarantine.FundsExpirationEntry{ToAddress: testAddr0, Expiration: quarantine.FundsExpiration{Timeout: time.Hour}}
+	badExpiration := quarantine.FundsExpirationEntry{ToAddress: testAddr1}
+
 	tests := []struct {
 		name    string
 		gs      *quarantine.GenesisState
@@ -112,6 +124,26 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expErrs: []string{"invalid quarantined funds[1]"},
 		},
+		{
+			name:    "good auto-accept rules",
+			gs:      &quarantine.GenesisState{AutoAcceptRules: []quarantine.AutoAcceptRulesEntry{goodRules}},
+			expErrs: nil,
+		},
+		{
+			name:    "bad second auto-accept rules",
+			gs:      &quarantine.GenesisState{AutoAcceptRules: []quarantine.AutoAcceptRulesEntry{goodRules, badRules}},
+			expErrs: []string{"invalid quarantine auto-accept rules entry[1]", "no rules"},
+		},
+		{
+			name:    "good funds expiration",
+			gs:      &quarantine.GenesisState{FundsExpirations: []quarantine.FundsExpirationEntry{goodExpiration}},
+			expErrs: nil,
+		},
+		{
+			name:    "bad second funds expiration",
+			gs:      &quarantine.GenesisState{FundsExpirations: []quarantine.FundsExpirationEntry{goodExpiration, badExpiration}},
+			expErrs: []string{"invalid quarantine funds expiration entry[1]", "timeout must be positive"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -23,10 +23,27 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesisState *quarantine.GenesisSta
 		k.SetAutoResponse(ctx, toAddr, fromAddr, qar.Response)
 	}
 
+	for _, entry := range genesisState.AutoAcceptRules {
+		toAddr := sdk.MustAccAddressFromBech32(entry.ToAddress)
+		if err := k.SetAutoAcceptRules(ctx, toAddr, entry.Rules); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range genesisState.FundsExpirations {
+		toAddr := sdk.MustAccAddressFromBech32(entry.ToAddress)
+		expiration := entry.Expiration
+		if err := k.SetFundsExpiration(ctx, toAddr, &expiration); err != nil {
+			panic(err)
+		}
+	}
+
 	totalQuarantined := sdk.Coins{}
 	for _, qf := range genesisState.QuarantinedFunds {
 		toAddr := sdk.MustAccAddressFromBech32(qf.ToAddress)
 		qr := quarantine.NewQuarantineRecord(qf.UnacceptedFromAddresses, qf.Coins, qf.Declined)
+		qr.ExpiresAt = qf.ExpiresAt
+		qr.QuarantinedAt = qf.QuarantinedAt
 		k.SetQuarantineRecord(ctx, toAddr, qr)
 		totalQuarantined = totalQuarantined.Add(qf.Coins...)
 	}
@@ -46,7 +63,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *quarantine.GenesisState {
 	autoResps := k.GetAllAutoResponseEntries(ctx)
 	qFunds := k.GetAllQuarantinedFunds(ctx)
 
-	return quarantine.NewGenesisState(qAddrs, autoResps, qFunds)
+	genState := quarantine.NewGenesisState(qAddrs, autoResps, qFunds)
+	genState.AutoAcceptRules = k.GetAllAutoAcceptRulesEntries(ctx)
+	genState.FundsExpirations = k.GetAllFundsExpirationEntries(ctx)
+	return genState
 }
 
 // GetAllQuarantinedAccounts gets the bech32 string of every account that have opted into quarantine.
@@ -81,3 +101,25 @@ func (k Keeper) GetAllQuarantinedFunds(ctx sdk.Context) []*quarantine.Quarantine
 	})
 	return rv
 }
+
+// GetAllAutoAcceptRulesEntries gets an AutoAcceptRulesEntry for every address that has auto-accept rules.
+// This is designed for use with ExportGenesis. See also IterateAutoAcceptRules.
+func (k Keeper) GetAllAutoAcceptRulesEntries(ctx sdk.Context) []quarantine.AutoAcceptRulesEntry {
+	var rv []quarantine.AutoAcceptRulesEntry
+	k.IterateAutoAcceptRules(ctx, func(toAddr sdk.AccAddress, rules []quarantine.AutoAcceptRule) bool {
+		rv = append(rv, quarantine.NewAutoAcceptRulesEntry(toAddr, rules))
+		return false
+	})
+	return rv
+}
+
+// GetAllFundsExpirationEntries gets a FundsExpirationEntry for every address that has funds expiration settings.
+// This is designed for use with ExportGenesis. See also IterateFundsExpirations.
+func (k Keeper) GetAllFundsExpirationEntries(ctx sdk.Context) []quarantine.FundsExpirationEntry {
+	var rv []quarantine.FundsExpirationEntry
+	k.IterateFundsExpirations(ctx, func(toAddr sdk.AccAddress, expiration quarantine.FundsExpiration) bool {
+		rv = append(rv, quarantine.NewFundsExpirationEntry(toAddr, expiration))
+		return false
+	})
+	return rv
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/provenance-io/provenance/internal/provutils"
 	"github.com/provenance-io/provenance/x/quarantine"
 )
 
@@ -42,6 +43,9 @@ func (k Keeper) QuarantinedFunds(goCtx context.Context, req *quarantine.QueryQua
 	if len(req.FromAddress) > 0 && len(req.ToAddress) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "to address cannot be empty when from address is not")
 	}
+	if req.Filter != nil && len(req.ToAddress) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "to address cannot be empty when a filter is provided")
+	}
 
 	var toAddr, fromAddr sdk.AccAddress
 	var err error
@@ -60,14 +64,23 @@ func (k Keeper) QuarantinedFunds(goCtx context.Context, req *quarantine.QueryQua
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	filter, err := quarantine.NewRecordFilter(req.Filter, ctx.BlockTime())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %s", err.Error())
+	}
+
 	resp := &quarantine.QueryQuarantinedFundsResponse{}
 
 	if len(fromAddr) > 0 {
 		// Not paginating here because it's assumed that there are few results.
 		// Also, there's no way to use query.FilteredPaginate to iterate over just these specific entries.
 		// So it'd be doing a lot of extra unneeded work.
+		filter = filter.IncludingDeclined()
 		qRecords := k.GetQuarantineRecords(ctx, toAddr, fromAddr)
 		for _, qr := range qRecords {
+			if !filter.Matches(qr) {
+				continue
+			}
 			qf := qr.AsQuarantinedFunds(toAddr)
 			resp.QuarantinedFunds = append(resp.QuarantinedFunds, qf)
 		}
@@ -82,7 +95,7 @@ func (k Keeper) QuarantinedFunds(goCtx context.Context, req *quarantine.QueryQua
 				if err != nil {
 					return false, err
 				}
-				if qr.Declined {
+				if !filter.Matches(qr) {
 					return false, nil
 				}
 				if accumulate {
@@ -149,3 +162,43 @@ func (k Keeper) AutoResponses(goCtx context.Context, req *quarantine.QueryAutoRe
 
 	return resp, nil
 }
+
+func (k Keeper) AutoAcceptRules(goCtx context.Context, req *quarantine.QueryAutoAcceptRulesRequest) (*quarantine.QueryAutoAcceptRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ToAddress) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "to address cannot be empty")
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &quarantine.QueryAutoAcceptRulesResponse{}
+	resp.Rules, resp.Pagination, err = provutils.PaginateSlice(k.GetAutoAcceptRules(ctx, toAddr), nil, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) FundsExpiration(goCtx context.Context, req *quarantine.QueryFundsExpirationRequest) (*quarantine.QueryFundsExpirationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ToAddress) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "to address cannot be empty")
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &quarantine.QueryFundsExpirationResponse{Expiration: k.GetFundsExpiration(ctx, toAddr)}, nil
+}
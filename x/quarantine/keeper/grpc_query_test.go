@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -254,6 +256,73 @@ func (s *TestSuite) TestQuarantinedFunds() {
 				},
 			},
 		},
+		{
+			name: "filter without to",
+			req: &quarantine.QueryQuarantinedFundsRequest{
+				Filter: &quarantine.QuarantinedFundsFilter{Denom: "goldcoin"},
+			},
+			err: []string{"to address cannot be empty when a filter is provided"},
+		},
+		{
+			name: "bad filter",
+			req: &quarantine.QueryQuarantinedFundsRequest{
+				ToAddress: addr4Str,
+				Filter:    &quarantine.QuarantinedFundsFilter{FromAddresses: []string{"bad"}},
+			},
+			err: []string{"invalid filter", "invalid from address[0]"},
+		},
+		{
+			name: "only to with filter including declined funds",
+			req: &quarantine.QueryQuarantinedFundsRequest{
+				ToAddress: addr4Str,
+				Filter:    &quarantine.QuarantinedFundsFilter{IncludeDeclined: true},
+			},
+			resp: &quarantine.QueryQuarantinedFundsResponse{
+				QuarantinedFunds: qfz(qf40, qf42d, qf43),
+				Pagination: &query.PageResponse{
+					NextKey: nil,
+					Total:   3,
+				},
+			},
+		},
+		{
+			name: "only to with sender filter",
+			req: &quarantine.QueryQuarantinedFundsRequest{
+				ToAddress: addr4Str,
+				Filter:    &quarantine.QuarantinedFundsFilter{FromAddresses: []string{addr2Str, addr3Str}},
+			},
+			resp: &quarantine.QueryQuarantinedFundsResponse{
+				QuarantinedFunds: qfz(qf43),
+				Pagination: &query.PageResponse{
+					NextKey: nil,
+					Total:   1,
+				},
+			},
+		},
+		{
+			name: "to and from with denom filter",
+			req: &quarantine.QueryQuarantinedFundsRequest{
+				ToAddress:   addr0Str,
+				FromAddress: addr3Str,
+				Filter:      &quarantine.QuarantinedFundsFilter{Denom: "goldcoin"},
+			},
+			resp: &quarantine.QueryQuarantinedFundsResponse{
+				QuarantinedFunds: nil,
+				Pagination:       nil,
+			},
+		},
+		{
+			name: "to and from with filter including declined funds",
+			req: &quarantine.QueryQuarantinedFundsRequest{
+				ToAddress:   addr4Str,
+				FromAddress: addr2Str,
+				Filter:      &quarantine.QuarantinedFundsFilter{Denom: "goldcoin"},
+			},
+			resp: &quarantine.QueryQuarantinedFundsResponse{
+				QuarantinedFunds: qfz(qf42d),
+				Pagination:       nil,
+			},
+		},
 		{
 			name: "only to with page req",
 			req: &quarantine.QueryQuarantinedFundsRequest{
@@ -551,3 +620,94 @@ func (s *TestSuite) TestAutoResponses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestAutoAcceptRulesQuery() {
+	hashRule := quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(1000))
+	acornRule := quarantine.NewAutoAcceptRule("", "acorn", sdkmath.NewInt(5))
+	bananaRule := quarantine.NewAutoAcceptRule("", "banana", sdkmath.ZeroInt())
+	s.Require().NoError(s.keeper.SetAutoAcceptRules(s.sdkCtx, s.addr1, []quarantine.AutoAcceptRule{hashRule, acornRule, bananaRule}), "SetAutoAcceptRules")
+	defer func() {
+		s.Require().NoError(s.keeper.SetAutoAcceptRules(s.sdkCtx, s.addr1, nil), "SetAutoAcceptRules cleanup")
+	}()
+
+	// The first page's next key is used for the "with key" case.
+	firstPage, err := s.keeper.AutoAcceptRules(s.stdlibCtx, &quarantine.QueryAutoAcceptRulesRequest{
+		ToAddress:  s.addr1.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err, "AutoAcceptRules first page")
+	s.Require().NotNil(firstPage.Pagination, "AutoAcceptRules first page pagination")
+
+	tests := []struct {
+		name string
+		req  *quarantine.QueryAutoAcceptRulesRequest
+		resp *quarantine.QueryAutoAcceptRulesResponse
+		err  []string
+	}{
+		{
+			name: "nil req",
+			req:  nil,
+			err:  []string{"empty request"},
+		},
+		{
+			name: "bad to address",
+			req:  &quarantine.QueryAutoAcceptRulesRequest{ToAddress: "notgonnawork"},
+			err:  []string{"invalid to address"},
+		},
+		{
+			name: "no rules",
+			req:  &quarantine.QueryAutoAcceptRulesRequest{ToAddress: s.addr2.String()},
+			resp: &quarantine.QueryAutoAcceptRulesResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name: "all rules",
+			req:  &quarantine.QueryAutoAcceptRulesRequest{ToAddress: s.addr1.String()},
+			resp: &quarantine.QueryAutoAcceptRulesResponse{
+				Rules:      []quarantine.AutoAcceptRule{hashRule, acornRule, bananaRule},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "first page",
+			req: &quarantine.QueryAutoAcceptRulesRequest{
+				ToAddress:  s.addr1.String(),
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			resp: &quarantine.QueryAutoAcceptRulesResponse{
+				Rules:      []quarantine.AutoAcceptRule{hashRule, acornRule},
+				Pagination: &query.PageResponse{NextKey: firstPage.Pagination.NextKey},
+			},
+		},
+		{
+			name: "with key",
+			req: &quarantine.QueryAutoAcceptRulesRequest{
+				ToAddress:  s.addr1.String(),
+				Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey},
+			},
+			resp: &quarantine.QueryAutoAcceptRulesResponse{
+				Rules:      []quarantine.AutoAcceptRule{bananaRule},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name: "reversed with offset",
+			req: &quarantine.QueryAutoAcceptRulesRequest{
+				ToAddress:  s.addr1.String(),
+				Pagination: &query.PageRequest{Offset: 1, Reverse: true, CountTotal: true},
+			},
+			resp: &quarantine.QueryAutoAcceptRulesResponse{
+				Rules:      []quarantine.AutoAcceptRule{acornRule, hashRule},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.keeper.AutoAcceptRules(s.stdlibCtx, tc.req)
+			if s.AssertErrorContents(err, tc.err, "AutoAcceptRules error") {
+				s.Assert().Equal(tc.resp, resp, "AutoAcceptRules response")
+			}
+		})
+	}
+}
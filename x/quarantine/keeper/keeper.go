@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -19,11 +20,12 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	bankKeeper quarantine.BankKeeper
+	attrKeeper quarantine.AttributeKeeper
 
 	fundsHolder sdk.AccAddress
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper quarantine.BankKeeper, fundsHolder sdk.AccAddress) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper quarantine.BankKeeper, attrKeeper quarantine.AttributeKeeper, fundsHolder sdk.AccAddress) Keeper {
 	if len(fundsHolder) == 0 {
 		fundsHolder = authtypes.NewModuleAddress(quarantine.ModuleName)
 	}
@@ -31,6 +33,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper q
 		cdc:         cdc,
 		storeKey:    storeKey,
 		bankKeeper:  bankKeeper,
+		attrKeeper:  attrKeeper,
 		fundsHolder: fundsHolder,
 	}
 	bankKeeper.AppendSendRestriction(rv.SendRestrictionFn)
@@ -160,6 +163,200 @@ func (k Keeper) IterateAutoResponses(ctx sdk.Context, toAddr sdk.AccAddress, cb
 	}
 }
 
+// SetAutoAcceptRules replaces the quarantine auto-accept rules of a receiving address.
+// Providing no rules removes them.
+func (k Keeper) SetAutoAcceptRules(ctx sdk.Context, toAddr sdk.AccAddress, rules []quarantine.AutoAcceptRule) error {
+	key := quarantine.CreateAutoAcceptRulesKey(toAddr)
+	store := ctx.KVStore(k.storeKey)
+	if len(rules) == 0 {
+		store.Delete(key)
+	} else {
+		store.Set(key, k.cdc.MustMarshal(&quarantine.AutoAcceptRules{Rules: rules}))
+	}
+	return ctx.EventManager().EmitTypedEvent(&quarantine.EventAutoAcceptRulesUpdated{ToAddress: toAddr.String()})
+}
+
+// GetAutoAcceptRules returns the quarantine auto-accept rules of a receiving address.
+func (k Keeper) GetAutoAcceptRules(ctx sdk.Context, toAddr sdk.AccAddress) []quarantine.AutoAcceptRule {
+	key := quarantine.CreateAutoAcceptRulesKey(toAddr)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil
+	}
+	var rules quarantine.AutoAcceptRules
+	k.cdc.MustUnmarshal(bz, &rules)
+	return rules.Rules
+}
+
+// IterateAutoAcceptRules iterates over the auto-accept rules of all receiving addresses.
+// The callback function should return whether to stop, i.e. true = stop iterating, false = keep going.
+func (k Keeper) IterateAutoAcceptRules(ctx sdk.Context, cb func(toAddr sdk.AccAddress, rules []quarantine.AutoAcceptRule) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), quarantine.AutoAcceptRulesPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		toAddr := quarantine.ParseAutoAcceptRulesKey(quarantine.MakeKey(quarantine.AutoAcceptRulesPrefix, iter.Key()))
+		var rules quarantine.AutoAcceptRules
+		k.cdc.MustUnmarshal(iter.Value(), &rules)
+		if cb(toAddr, rules.Rules) {
+			break
+		}
+	}
+}
+
+// IsAutoAcceptedByRules returns true if the to address has auto-accept rules that accept all the provided coins
+// from the from address. Each coin must pass at least one rule, and a rule with an attribute only applies if the
+// from address has that attribute. Rules never apply to a from address that the to address has set to auto-decline.
+func (k Keeper) IsAutoAcceptedByRules(ctx sdk.Context, toAddr, fromAddr sdk.AccAddress, coins sdk.Coins) bool {
+	if coins.IsZero() {
+		return false
+	}
+	rules := k.GetAutoAcceptRules(ctx, toAddr)
+	if len(rules) == 0 || k.IsAutoDecline(ctx, toAddr, fromAddr) {
+		return false
+	}
+
+	hasAttr := make(map[string]bool)
+	senderHasAttribute := func(name string) bool {
+		if has, known := hasAttr[name]; known {
+			return has
+		}
+		has := false
+		if k.attrKeeper != nil {
+			attrs, err := k.attrKeeper.GetAttributes(ctx, fromAddr.String(), name)
+			has = err == nil && len(attrs) > 0
+		}
+		hasAttr[name] = has
+		return has
+	}
+
+coinLoop:
+	for _, coin := range coins {
+		for _, rule := range rules {
+			if rule.AcceptsCoin(coin) && (len(rule.Attribute) == 0 || senderHasAttribute(rule.Attribute)) {
+				continue coinLoop
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// SetFundsExpiration sets how long funds sent to toAddr can remain quarantined before they expire.
+// Providing nil removes the expiration settings so that funds do not expire.
+// This only affects funds that are quarantined from now on.
+func (k Keeper) SetFundsExpiration(ctx sdk.Context, toAddr sdk.AccAddress, expiration *quarantine.FundsExpiration) error {
+	key := quarantine.CreateFundsExpirationKey(toAddr)
+	store := ctx.KVStore(k.storeKey)
+	if expiration == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, k.cdc.MustMarshal(expiration))
+	}
+	return ctx.EventManager().EmitTypedEvent(&quarantine.EventFundsExpirationUpdated{ToAddress: toAddr.String()})
+}
+
+// GetFundsExpiration gets the funds expiration settings for toAddr.
+// Returns nil if funds sent to toAddr do not expire.
+func (k Keeper) GetFundsExpiration(ctx sdk.Context, toAddr sdk.AccAddress) *quarantine.FundsExpiration {
+	key := quarantine.CreateFundsExpirationKey(toAddr)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil
+	}
+	var rv quarantine.FundsExpiration
+	k.cdc.MustUnmarshal(bz, &rv)
+	return &rv
+}
+
+// IterateFundsExpirations iterates over the funds expiration settings of all receiving addresses.
+// The callback function should return whether to stop, i.e. true = stop iterating, false = keep going.
+func (k Keeper) IterateFundsExpirations(ctx sdk.Context, cb func(toAddr sdk.AccAddress, expiration quarantine.FundsExpiration) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), quarantine.FundsExpirationPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		toAddr := quarantine.ParseFundsExpirationKey(quarantine.MakeKey(quarantine.FundsExpirationPrefix, iter.Key()))
+		var expiration quarantine.FundsExpiration
+		k.cdc.MustUnmarshal(iter.Value(), &expiration)
+		if cb(toAddr, expiration) {
+			break
+		}
+	}
+}
+
+// ProcessExpiredFunds sends away the quarantined funds that have expired, up to MaxExpiredRecordsPerBlock records.
+// Expired funds go to the receiver's fallback address if they have one, otherwise, they're returned to the sender.
+func (k Keeper) ProcessExpiredFunds(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := storetypes.PrefixEndBytes(quarantine.CreateExpirationIndexTimePrefix(ctx.BlockTime()))
+
+	var keys [][]byte
+	iter := store.Iterator(quarantine.ExpirationIndexPrefix, end)
+	for ; iter.Valid() && len(keys) < quarantine.MaxExpiredRecordsPerBlock; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		expiresAt, toAddr, recordSuffix, err := quarantine.ParseExpirationIndexKey(key)
+		if err != nil {
+			ctx.Logger().Error("invalid quarantine expiration index entry",
+				"module", "x/"+quarantine.ModuleName, "key", fmt.Sprintf("%X", key), "error", err)
+			continue
+		}
+		k.expireQuarantineRecord(ctx, expiresAt, toAddr, recordSuffix)
+	}
+}
+
+// expireQuarantineRecord sends away the funds of the identified quarantine record if it's still set to expire at expiresAt.
+// If the funds cannot be sent, the record is kept, but will no longer expire.
+func (k Keeper) expireQuarantineRecord(ctx sdk.Context, expiresAt time.Time, toAddr, recordSuffix sdk.AccAddress) {
+	record := k.GetQuarantineRecord(ctx, toAddr, recordSuffix)
+	// The record might have been released, or gotten more funds (and a new expiration) since this entry was made.
+	if record == nil || record.ExpiresAt == nil || !record.ExpiresAt.Equal(expiresAt) {
+		return
+	}
+
+	fromAddrs := record.GetAllFromAddrs()
+	var sendTo sdk.AccAddress
+	if exp := k.GetFundsExpiration(ctx, toAddr); exp != nil && len(exp.FallbackAddress) > 0 {
+		sendTo = sdk.MustAccAddressFromBech32(exp.FallbackAddress)
+	} else if len(fromAddrs) == 1 {
+		sendTo = fromAddrs[0]
+	}
+
+	var err error
+	if len(sendTo) == 0 {
+		err = fmt.Errorf("funds from %d senders cannot be returned without a fallback address", len(fromAddrs))
+	} else {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = k.bankKeeper.SendCoins(quarantine.WithBypass(cacheCtx), k.fundsHolder, sendTo, record.Coins)
+		if err == nil {
+			err = cacheCtx.EventManager().EmitTypedEvent(&quarantine.EventFundsExpired{
+				ToAddress: toAddr.String(),
+				Coins:     record.Coins,
+				SentTo:    sendTo.String(),
+			})
+		}
+		if err == nil {
+			writeCache()
+			k.deleteQuarantineRecord(ctx.KVStore(k.storeKey), toAddr, fromAddrs)
+			return
+		}
+	}
+
+	ctx.Logger().Error("could not expire quarantined funds",
+		"module", "x/"+quarantine.ModuleName, "to_address", toAddr.String(), "coins", record.Coins.String(), "error", err)
+	record.ExpiresAt = nil
+	k.SetQuarantineRecord(ctx, toAddr, record)
+}
+
 // SetQuarantineRecord sets a quarantine record.
 // Panics if the record is nil.
 // If the record is fully accepted, it is deleted.
@@ -173,18 +370,28 @@ func (k Keeper) SetQuarantineRecord(ctx sdk.Context, toAddr sdk.AccAddress, reco
 	store := ctx.KVStore(k.storeKey)
 
 	if record.IsFullyAccepted() {
-		store.Delete(key)
-		if len(fromAddrs) > 1 {
-			_, suffix := quarantine.ParseRecordIndexKey(key)
-			k.deleteQuarantineRecordSuffixIndexes(store, toAddr, fromAddrs, suffix)
-		}
+		k.deleteQuarantineRecord(store, toAddr, fromAddrs)
 	} else {
 		val := k.cdc.MustMarshal(record)
 		store.Set(key, val)
+		_, suffix := quarantine.ParseRecordIndexKey(key)
 		if len(fromAddrs) > 1 {
-			_, suffix := quarantine.ParseRecordIndexKey(key)
 			k.addQuarantineRecordSuffixIndexes(store, toAddr, fromAddrs, suffix)
 		}
+		if record.ExpiresAt != nil {
+			store.Set(quarantine.CreateExpirationIndexKey(*record.ExpiresAt, toAddr, suffix), []byte{0x00})
+		}
+	}
+}
+
+// deleteQuarantineRecord deletes the quarantine record to toAddr from the fromAddrs, and its suffix index entries.
+// Expiration index entries are left alone; they are cleaned up as they're processed.
+func (k Keeper) deleteQuarantineRecord(store storetypes.KVStore, toAddr sdk.AccAddress, fromAddrs []sdk.AccAddress) {
+	key := quarantine.CreateRecordKey(toAddr, fromAddrs...)
+	store.Delete(key)
+	if len(fromAddrs) > 1 {
+		_, suffix := quarantine.ParseRecordIndexKey(key)
+		k.deleteQuarantineRecordSuffixIndexes(store, toAddr, fromAddrs, suffix)
 	}
 }
 
@@ -250,8 +457,10 @@ func (k Keeper) AddQuarantinedCoins(ctx sdk.Context, coins sdk.Coins, toAddr sdk
 	if qr != nil {
 		qr.AddCoins(coins...)
 	} else {
+		quarantinedAt := ctx.BlockTime()
 		qr = &quarantine.QuarantineRecord{
-			Coins: coins,
+			Coins:         coins,
+			QuarantinedAt: &quarantinedAt,
 		}
 		for _, fromAddr := range fromAddrs {
 			if k.IsAutoAccept(ctx, toAddr, fromAddr) {
@@ -271,6 +480,12 @@ func (k Keeper) AddQuarantinedCoins(ctx sdk.Context, coins sdk.Coins, toAddr sdk
 	}
 	// Regardless of if its new or existing, set declined based on current auto-decline info.
 	qr.Declined = k.IsAutoDecline(ctx, toAddr, fromAddrs...)
+	// Also restart the expiration clock using the current expiration settings.
+	qr.ExpiresAt = nil
+	if exp := k.GetFundsExpiration(ctx, toAddr); exp != nil {
+		expiresAt := ctx.BlockTime().Add(exp.Timeout)
+		qr.ExpiresAt = &expiresAt
+	}
 	k.SetQuarantineRecord(ctx, toAddr, qr)
 	return ctx.EventManager().EmitTypedEvent(&quarantine.EventFundsQuarantined{
 		ToAddress: toAddr.String(),
@@ -286,19 +501,10 @@ func (k Keeper) AcceptQuarantinedFunds(ctx sdk.Context, toAddr sdk.AccAddress, f
 	for _, record := range k.GetQuarantineRecords(ctx, toAddr, fromAddrs...) {
 		if record.AcceptFrom(fromAddrs) {
 			if record.IsFullyAccepted() {
-				err := k.bankKeeper.SendCoins(quarantine.WithBypass(ctx), k.fundsHolder, toAddr, record.Coins)
-				if err != nil {
+				if err := k.releaseQuarantinedFunds(ctx, toAddr, record.Coins); err != nil {
 					return nil, err
 				}
 				fundsReleased = fundsReleased.Add(record.Coins...)
-
-				err = ctx.EventManager().EmitTypedEvent(&quarantine.EventFundsReleased{
-					ToAddress: toAddr.String(),
-					Coins:     record.Coins,
-				})
-				if err != nil {
-					return nil, err
-				}
 			} else {
 				// update declined to false unless one of the unaccepted from addresses is set to auto-decline.
 				record.Declined = k.IsAutoDecline(ctx, toAddr, record.UnacceptedFromAddresses...)
@@ -319,6 +525,56 @@ func (k Keeper) DeclineQuarantinedFunds(ctx sdk.Context, toAddr sdk.AccAddress,
 	}
 }
 
+// BulkAcceptQuarantinedFunds accepts (from all senders) and releases up to limit of the quarantined funds to toAddr that match the filter.
+// Returns the number of records accepted, the total funds released, and possibly an error.
+func (k Keeper) BulkAcceptQuarantinedFunds(ctx sdk.Context, toAddr sdk.AccAddress, filter *quarantine.RecordFilter, limit int) (int, sdk.Coins, error) {
+	fundsReleased := sdk.Coins{}
+	records := k.getMatchingQuarantineRecords(ctx, toAddr, filter, limit)
+	for _, record := range records {
+		record.AcceptFrom(record.UnacceptedFromAddresses)
+		if err := k.releaseQuarantinedFunds(ctx, toAddr, record.Coins); err != nil {
+			return 0, nil, err
+		}
+		fundsReleased = fundsReleased.Add(record.Coins...)
+		k.SetQuarantineRecord(ctx, toAddr, record)
+	}
+	return len(records), fundsReleased, nil
+}
+
+// BulkDeclineQuarantinedFunds marks as declined up to limit of the undeclined quarantined funds to toAddr that match the filter.
+// Returns the number of records declined.
+func (k Keeper) BulkDeclineQuarantinedFunds(ctx sdk.Context, toAddr sdk.AccAddress, filter *quarantine.RecordFilter, limit int) int {
+	records := k.getMatchingQuarantineRecords(ctx, toAddr, filter.WithoutDeclined(), limit)
+	for _, record := range records {
+		record.DeclineFrom(nil)
+		k.SetQuarantineRecord(ctx, toAddr, record)
+	}
+	return len(records)
+}
+
+// getMatchingQuarantineRecords gets up to limit of the quarantine records to toAddr that match the filter.
+func (k Keeper) getMatchingQuarantineRecords(ctx sdk.Context, toAddr sdk.AccAddress, filter *quarantine.RecordFilter, limit int) []*quarantine.QuarantineRecord {
+	var rv []*quarantine.QuarantineRecord
+	k.IterateQuarantineRecords(ctx, toAddr, func(_, _ sdk.AccAddress, record *quarantine.QuarantineRecord) bool {
+		if filter.Matches(record) {
+			rv = append(rv, record)
+		}
+		return len(rv) >= limit
+	})
+	return rv
+}
+
+// releaseQuarantinedFunds sends the provided quarantined funds to toAddr.
+func (k Keeper) releaseQuarantinedFunds(ctx sdk.Context, toAddr sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoins(quarantine.WithBypass(ctx), k.fundsHolder, toAddr, coins); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&quarantine.EventFundsReleased{
+		ToAddress: toAddr.String(),
+		Coins:     coins,
+	})
+}
+
 // getQuarantineRecordPrefixStore returns a kv store prefixed for quarantine records and the prefix used.
 // If a toAddr is provided, the store is prefixed for just the given address.
 // If toAddr is empty, it will be prefixed for all quarantine records.
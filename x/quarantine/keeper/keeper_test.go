@@ -19,9 +19,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil/assertions"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	"github.com/provenance-io/provenance/x/quarantine"
 	"github.com/provenance-io/provenance/x/quarantine/keeper"
 	"github.com/provenance-io/provenance/x/quarantine/testutil"
@@ -486,6 +488,156 @@ func (s *TestSuite) TestAutoResponsesItateAndGetAll() {
 	})
 }
 
+func (s *TestSuite) TestAutoAcceptRules() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.sdkCtx, "kyc.pb", s.addr5, false), "SetNameRecord kyc.pb")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.sdkCtx,
+		attrtypes.Attribute{
+			Name:          "kyc.pb",
+			Value:         []byte("verified"),
+			Address:       s.addr2.String(),
+			AttributeType: attrtypes.AttributeType_String,
+		},
+		s.addr5,
+	), "SetAttribute kyc.pb on addr2")
+
+	kycRule := quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt())
+	hashRule := quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(1000))
+	rules := []quarantine.AutoAcceptRule{kycRule, hashRule}
+
+	s.Run("no rules", func() {
+		s.Assert().Empty(s.keeper.GetAutoAcceptRules(s.sdkCtx, s.addr1), "GetAutoAcceptRules")
+		s.Assert().False(s.keeper.IsAutoAcceptedByRules(s.sdkCtx, s.addr1, s.addr2, s.cz("5nhash")), "IsAutoAcceptedByRules")
+	})
+
+	s.Run("set rules", func() {
+		ctx := s.sdkCtx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.keeper.SetAutoAcceptRules(ctx, s.addr1, rules), "SetAutoAcceptRules")
+		s.Assert().Equal(rules, s.keeper.GetAutoAcceptRules(ctx, s.addr1), "GetAutoAcceptRules")
+		s.Assert().Len(ctx.EventManager().Events(), 1, "events emitted")
+		s.Assert().Equal([]quarantine.AutoAcceptRulesEntry{quarantine.NewAutoAcceptRulesEntry(s.addr1, rules)},
+			s.keeper.GetAllAutoAcceptRulesEntries(ctx), "GetAllAutoAcceptRulesEntries")
+	})
+
+	tests := []struct {
+		name     string
+		fromAddr sdk.AccAddress
+		amt      string
+		exp      bool
+	}{
+		{name: "sender with attribute, any denom", fromAddr: s.addr2, amt: "5000000bananas,5000nhash", exp: true},
+		{name: "sender without attribute, denom under max", fromAddr: s.addr3, amt: "999nhash", exp: true},
+		{name: "sender without attribute, denom at max", fromAddr: s.addr3, amt: "1000nhash", exp: true},
+		{name: "sender without attribute, denom over max", fromAddr: s.addr3, amt: "1001nhash", exp: false},
+		{name: "sender without attribute, other denom", fromAddr: s.addr3, amt: "5bananas", exp: false},
+		{name: "sender without attribute, one coin not covered", fromAddr: s.addr3, amt: "5bananas,5nhash", exp: false},
+		{name: "no coins", fromAddr: s.addr2, amt: "", exp: false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual := s.keeper.IsAutoAcceptedByRules(s.sdkCtx, s.addr1, tc.fromAddr, s.cz(tc.amt))
+			s.Assert().Equal(tc.exp, actual, "IsAutoAcceptedByRules")
+		})
+	}
+
+	s.Run("auto-decline sender", func() {
+		s.keeper.SetAutoResponse(s.sdkCtx, s.addr1, s.addr2, quarantine.AUTO_RESPONSE_DECLINE)
+		defer s.keeper.SetAutoResponse(s.sdkCtx, s.addr1, s.addr2, quarantine.AUTO_RESPONSE_UNSPECIFIED)
+		s.Assert().False(s.keeper.IsAutoAcceptedByRules(s.sdkCtx, s.addr1, s.addr2, s.cz("5nhash")), "IsAutoAcceptedByRules")
+	})
+
+	s.Run("remove rules", func() {
+		s.Require().NoError(s.keeper.SetAutoAcceptRules(s.sdkCtx, s.addr1, nil), "SetAutoAcceptRules")
+		s.Assert().Empty(s.keeper.GetAutoAcceptRules(s.sdkCtx, s.addr1), "GetAutoAcceptRules")
+		s.Assert().Empty(s.keeper.GetAllAutoAcceptRulesEntries(s.sdkCtx), "GetAllAutoAcceptRulesEntries")
+		s.Assert().False(s.keeper.IsAutoAcceptedByRules(s.sdkCtx, s.addr1, s.addr2, s.cz("5nhash")), "IsAutoAcceptedByRules")
+	})
+}
+
+func (s *TestSuite) TestFundsExpiration() {
+	fundsHolder := s.keeper.GetFundsHolder()
+	getBalance := func(addr sdk.AccAddress) string {
+		return s.bankKeeper.GetBalance(s.sdkCtx, addr, "acorn").String()
+	}
+	s.Require().NoError(banktestutil.FundAccount(s.sdkCtx, s.bankKeeper, s.addr2, s.cz("1000acorn")), "FundAccount addr2")
+	s.Require().NoError(banktestutil.FundAccount(s.sdkCtx, s.bankKeeper, s.addr3, s.cz("1000acorn")), "FundAccount addr3")
+	s.Require().NoError(s.keeper.SetOptIn(s.sdkCtx, s.addr1), "SetOptIn addr1")
+
+	s.Run("settings get and set", func() {
+		s.Assert().Nil(s.keeper.GetFundsExpiration(s.sdkCtx, s.addr1), "GetFundsExpiration before set")
+		exp := quarantine.NewFundsExpiration(time.Hour, nil)
+		s.Require().NoError(s.keeper.SetFundsExpiration(s.sdkCtx, s.addr1, &exp), "SetFundsExpiration")
+		s.Assert().Equal(&exp, s.keeper.GetFundsExpiration(s.sdkCtx, s.addr1), "GetFundsExpiration after set")
+		s.Assert().Equal([]quarantine.FundsExpirationEntry{quarantine.NewFundsExpirationEntry(s.addr1, exp)},
+			s.keeper.GetAllFundsExpirationEntries(s.sdkCtx), "GetAllFundsExpirationEntries")
+	})
+
+	s.Run("returned to sender", func() {
+		s.Require().NoError(s.bankKeeper.SendCoins(s.sdkCtx, s.addr2, s.addr1, s.cz("100acorn")), "SendCoins addr2 -> addr1")
+		record := s.keeper.GetQuarantineRecord(s.sdkCtx, s.addr1, s.addr2)
+		s.Require().NotNil(record, "GetQuarantineRecord after send")
+		s.Require().NotNil(record.ExpiresAt, "record ExpiresAt")
+		s.Assert().Equal(s.blockTime.Add(time.Hour).UTC(), record.ExpiresAt.UTC(), "record ExpiresAt")
+
+		s.keeper.ProcessExpiredFunds(s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour - time.Second)))
+		s.Assert().NotNil(s.keeper.GetQuarantineRecord(s.sdkCtx, s.addr1, s.addr2), "record before expiration")
+		s.Assert().Equal("100acorn", getBalance(fundsHolder), "funds holder balance before expiration")
+
+		ctx := s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+		s.keeper.ProcessExpiredFunds(ctx)
+		s.Assert().Nil(s.keeper.GetQuarantineRecord(s.sdkCtx, s.addr1, s.addr2), "record after expiration")
+		s.Assert().Equal("0acorn", getBalance(fundsHolder), "funds holder balance after expiration")
+		s.Assert().Equal("1000acorn", getBalance(s.addr2), "addr2 balance after expiration")
+		expEvent, err := sdk.TypedEventToEvent(&quarantine.EventFundsExpired{
+			ToAddress: s.addr1.String(),
+			Coins:     s.cz("100acorn"),
+			SentTo:    s.addr2.String(),
+		})
+		s.Require().NoError(err, "TypedEventToEvent EventFundsExpired")
+		s.Assert().Contains(ctx.EventManager().Events(), expEvent, "events emitted")
+	})
+
+	s.Run("accepted before expiration", func() {
+		s.Require().NoError(s.bankKeeper.SendCoins(s.sdkCtx, s.addr2, s.addr1, s.cz("5acorn")), "SendCoins addr2 -> addr1")
+		_, err := s.keeper.AcceptQuarantinedFunds(s.sdkCtx, s.addr1, s.addr2)
+		s.Require().NoError(err, "AcceptQuarantinedFunds")
+		s.keeper.ProcessExpiredFunds(s.sdkCtx.WithBlockTime(s.blockTime.Add(2 * time.Hour)))
+		s.Assert().Equal("995acorn", getBalance(s.addr2), "addr2 balance")
+		s.Assert().Equal("5acorn", getBalance(s.addr1), "addr1 balance")
+	})
+
+	s.Run("sent to fallback", func() {
+		exp := quarantine.NewFundsExpiration(time.Minute, s.addr4)
+		s.Require().NoError(s.keeper.SetFundsExpiration(s.sdkCtx, s.addr1, &exp), "SetFundsExpiration")
+		s.Require().NoError(s.bankKeeper.SendCoins(s.sdkCtx, s.addr3, s.addr1, s.cz("40acorn")), "SendCoins addr3 -> addr1")
+		s.keeper.ProcessExpiredFunds(s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Minute)))
+		s.Assert().Nil(s.keeper.GetQuarantineRecord(s.sdkCtx, s.addr1, s.addr3), "record after expiration")
+		s.Assert().Equal("960acorn", getBalance(s.addr3), "addr3 balance after expiration")
+		s.Assert().Equal("40acorn", getBalance(s.addr4), "addr4 balance after expiration")
+	})
+
+	s.Run("multiple senders without fallback", func() {
+		exp := quarantine.NewFundsExpiration(time.Minute, nil)
+		s.Require().NoError(s.keeper.SetFundsExpiration(s.sdkCtx, s.addr1, &exp), "SetFundsExpiration")
+		s.Require().NoError(banktestutil.FundAccount(s.sdkCtx, s.bankKeeper, fundsHolder, s.cz("7acorn")), "FundAccount funds holder")
+		s.Require().NoError(s.keeper.AddQuarantinedCoins(s.sdkCtx, s.cz("7acorn"), s.addr1, s.addr2, s.addr3), "AddQuarantinedCoins")
+		s.keeper.ProcessExpiredFunds(s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Minute)))
+		record := s.keeper.GetQuarantineRecord(s.sdkCtx, s.addr1, s.addr2, s.addr3)
+		s.Require().NotNil(record, "record after expiration")
+		s.Assert().Nil(record.ExpiresAt, "record ExpiresAt after expiration")
+		s.Assert().Equal("7acorn", getBalance(fundsHolder), "funds holder balance after expiration")
+	})
+
+	s.Run("settings removed", func() {
+		s.Require().NoError(s.keeper.SetFundsExpiration(s.sdkCtx, s.addr1, nil), "SetFundsExpiration nil")
+		s.Assert().Nil(s.keeper.GetFundsExpiration(s.sdkCtx, s.addr1), "GetFundsExpiration")
+		s.Require().NoError(s.bankKeeper.SendCoins(s.sdkCtx, s.addr2, s.addr1, s.cz("1acorn")), "SendCoins addr2 -> addr1")
+		record := s.keeper.GetQuarantineRecord(s.sdkCtx, s.addr1, s.addr2)
+		s.Require().NotNil(record, "GetQuarantineRecord")
+		s.Assert().Nil(record.ExpiresAt, "record ExpiresAt")
+	})
+}
+
 func (s *TestSuite) TestBzToQuarantineRecord() {
 	cdc := s.keeper.GetCodec()
 
@@ -1759,6 +1911,11 @@ func (s *TestSuite) TestAddQuarantinedCoins() {
 			}
 			updateQR(addrs, tc.existing)
 			updateQR(addrs, tc.expected)
+			if tc.existing == nil && tc.expected != nil {
+				// New records are marked with when the funds were quarantined.
+				quarantinedAt := s.sdkCtx.BlockTime()
+				tc.expected.QuarantinedAt = &quarantinedAt
+			}
 
 			expErr := ""
 			if len(tc.expErrFmt) > 0 {
@@ -2979,6 +3136,205 @@ func (s *TestSuite) TestDeclineQuarantinedFunds() {
 	}
 }
 
+func (s *TestSuite) TestBulkAcceptAndDeclineQuarantinedFunds() {
+	toAddr := testutil.MakeTestAddr("babdqf", 0)
+	fromAddr1 := testutil.MakeTestAddr("babdqf", 1)
+	fromAddr2 := testutil.MakeTestAddr("babdqf", 2)
+	fromAddr3 := testutil.MakeTestAddr("babdqf", 3)
+	twoHoursAgo := s.blockTime.Add(-2 * time.Hour)
+	tenMinutesAgo := s.blockTime.Add(-10 * time.Minute)
+
+	// Records are recreated for each test since they get updated.
+	newRecords := func() []*quarantine.QuarantineRecord {
+		return []*quarantine.QuarantineRecord{
+			{UnacceptedFromAddresses: accs(fromAddr1), Coins: s.cz("5banana"), QuarantinedAt: &twoHoursAgo},
+			{UnacceptedFromAddresses: accs(fromAddr2), Coins: s.cz("3apple"), QuarantinedAt: &tenMinutesAgo},
+			{UnacceptedFromAddresses: accs(fromAddr1, fromAddr3), Coins: s.cz("7banana")},
+			{UnacceptedFromAddresses: accs(fromAddr3), Coins: s.cz("1cherry"), Declined: true, QuarantinedAt: &twoHoursAgo},
+		}
+	}
+
+	setup := func(qKeeper keeper.Keeper) sdk.Context {
+		ctx, _ := s.sdkCtx.WithEventManager(sdk.NewEventManager()).CacheContext()
+		for i, record := range newRecords() {
+			qKeeper.SetQuarantineRecord(ctx, toAddr, record)
+			s.Require().NotNil(qKeeper.GetQuarantineRecord(ctx, toAddr, record.GetAllFromAddrs()...), "GetQuarantineRecord[%d] after set", i)
+		}
+		return ctx
+	}
+
+	getRemaining := func(qKeeper keeper.Keeper, ctx sdk.Context) (undeclined, declined int) {
+		qKeeper.IterateQuarantineRecords(ctx, toAddr, func(_, _ sdk.AccAddress, record *quarantine.QuarantineRecord) bool {
+			if record.Declined {
+				declined++
+			} else {
+				undeclined++
+			}
+			return false
+		})
+		return undeclined, declined
+	}
+
+	s.Run("accept", func() {
+		tests := []struct {
+			name          string
+			filter        *quarantine.QuarantinedFundsFilter
+			limit         int
+			expCount      int
+			expReleased   string
+			expUndeclined int
+			expDeclined   int
+		}{
+			{
+				name:        "no filter",
+				limit:       quarantine.MaxBulkRecords,
+				expCount:    3,
+				expReleased: "3apple,12banana",
+				expDeclined: 1,
+			},
+			{
+				name:          "denom",
+				filter:        &quarantine.QuarantinedFundsFilter{Denom: "banana"},
+				limit:         quarantine.MaxBulkRecords,
+				expCount:      2,
+				expReleased:   "12banana",
+				expUndeclined: 1,
+				expDeclined:   1,
+			},
+			{
+				name:          "min age",
+				filter:        &quarantine.QuarantinedFundsFilter{MinAge: time.Hour},
+				limit:         quarantine.MaxBulkRecords,
+				expCount:      2,
+				expReleased:   "12banana",
+				expUndeclined: 1,
+				expDeclined:   1,
+			},
+			{
+				name:          "sender including declined",
+				filter:        &quarantine.QuarantinedFundsFilter{FromAddresses: []string{fromAddr3.String()}, IncludeDeclined: true},
+				limit:         quarantine.MaxBulkRecords,
+				expCount:      2,
+				expReleased:   "7banana,1cherry",
+				expUndeclined: 2,
+			},
+			{
+				name:          "limited",
+				filter:        &quarantine.QuarantinedFundsFilter{IncludeDeclined: true},
+				limit:         1,
+				expCount:      1,
+				expUndeclined: -1,
+				expDeclined:   -1,
+			},
+			{
+				name:          "nothing matches",
+				filter:        &quarantine.QuarantinedFundsFilter{Denom: "durian"},
+				limit:         quarantine.MaxBulkRecords,
+				expReleased:   "",
+				expUndeclined: 3,
+				expDeclined:   1,
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				bKeeper := NewMockBankKeeper()
+				qKeeper := s.keeper.WithBankKeeper(bKeeper)
+				ctx := setup(qKeeper)
+				filter, err := quarantine.NewRecordFilter(tc.filter, s.blockTime)
+				s.Require().NoError(err, "NewRecordFilter")
+
+				count, released, err := qKeeper.BulkAcceptQuarantinedFunds(ctx, toAddr, filter, tc.limit)
+				s.Require().NoError(err, "BulkAcceptQuarantinedFunds")
+				s.Assert().Equal(tc.expCount, count, "BulkAcceptQuarantinedFunds count")
+				s.Assert().Len(bKeeper.SentCoins, tc.expCount, "sends made")
+				s.Assert().Len(ctx.EventManager().Events(), tc.expCount, "events emitted")
+				if tc.expUndeclined < 0 {
+					// Which records are accepted isn't important here, just how many.
+					undeclined, declined := getRemaining(qKeeper, ctx)
+					s.Assert().Equal(3, undeclined+declined, "number of records remaining")
+					return
+				}
+				s.Assert().Equal(tc.expReleased, released.String(), "BulkAcceptQuarantinedFunds funds released")
+				undeclined, declined := getRemaining(qKeeper, ctx)
+				s.Assert().Equal(tc.expUndeclined, undeclined, "number of undeclined records remaining")
+				s.Assert().Equal(tc.expDeclined, declined, "number of declined records remaining")
+			})
+		}
+	})
+
+	s.Run("accept send error", func() {
+		bKeeper := NewMockBankKeeper()
+		bKeeper.QueuedSendCoinsErrors = []error{fmt.Errorf("this is a test error")}
+		qKeeper := s.keeper.WithBankKeeper(bKeeper)
+		ctx := setup(qKeeper)
+		filter, err := quarantine.NewRecordFilter(nil, s.blockTime)
+		s.Require().NoError(err, "NewRecordFilter")
+
+		count, released, err := qKeeper.BulkAcceptQuarantinedFunds(ctx, toAddr, filter, quarantine.MaxBulkRecords)
+		s.Assert().EqualError(err, "this is a test error", "BulkAcceptQuarantinedFunds error")
+		s.Assert().Equal(0, count, "BulkAcceptQuarantinedFunds count")
+		s.Assert().Nil(released, "BulkAcceptQuarantinedFunds funds released")
+	})
+
+	s.Run("decline", func() {
+		tests := []struct {
+			name          string
+			filter        *quarantine.QuarantinedFundsFilter
+			limit         int
+			expCount      int
+			expUndeclined int
+		}{
+			{
+				name:     "no filter",
+				limit:    quarantine.MaxBulkRecords,
+				expCount: 3,
+			},
+			{
+				name:          "sender including declined",
+				filter:        &quarantine.QuarantinedFundsFilter{FromAddresses: []string{fromAddr3.String()}, IncludeDeclined: true},
+				limit:         quarantine.MaxBulkRecords,
+				expCount:      1,
+				expUndeclined: 2,
+			},
+			{
+				name:          "denom and min age",
+				filter:        &quarantine.QuarantinedFundsFilter{Denom: "banana", MinAge: time.Hour},
+				limit:         quarantine.MaxBulkRecords,
+				expCount:      2,
+				expUndeclined: 1,
+			},
+			{
+				name:          "limited",
+				limit:         2,
+				expCount:      2,
+				expUndeclined: 1,
+			},
+			{
+				name:          "only declined funds match",
+				filter:        &quarantine.QuarantinedFundsFilter{Denom: "cherry", IncludeDeclined: true},
+				limit:         quarantine.MaxBulkRecords,
+				expCount:      0,
+				expUndeclined: 3,
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				ctx := setup(s.keeper)
+				filter, err := quarantine.NewRecordFilter(tc.filter, s.blockTime)
+				s.Require().NoError(err, "NewRecordFilter")
+
+				count := s.keeper.BulkDeclineQuarantinedFunds(ctx, toAddr, filter, tc.limit)
+				s.Assert().Equal(tc.expCount, count, "BulkDeclineQuarantinedFunds count")
+				undeclined, declined := getRemaining(s.keeper, ctx)
+				s.Assert().Equal(tc.expUndeclined, undeclined, "number of undeclined records remaining")
+				s.Assert().Equal(4-tc.expUndeclined, declined, "number of declined records remaining")
+			})
+		}
+	})
+}
+
 func (s *TestSuite) TestQuarantineRecordsIterateAndGetAll() {
 	addrBase := "qriga"
 	addr0 := testutil.MakeTestAddr(addrBase, 0)
@@ -99,6 +99,53 @@ func (k Keeper) Decline(goCtx context.Context, msg *quarantine.MsgDecline) (*qua
 	return &quarantine.MsgDeclineResponse{}, nil
 }
 
+func (k Keeper) BulkAccept(goCtx context.Context, msg *quarantine.MsgBulkAccept) (*quarantine.MsgBulkAcceptResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %v", err)
+	}
+
+	filter, err := quarantine.NewRecordFilter(&msg.Filter, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	count, fundsReleased, err := k.BulkAcceptQuarantinedFunds(ctx, toAddr, filter, bulkLimit(msg.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &quarantine.MsgBulkAcceptResponse{FundsReleased: fundsReleased, Count: uint32(count)}, nil //nolint:gosec // G115: count is at most MaxBulkRecords.
+}
+
+func (k Keeper) BulkDecline(goCtx context.Context, msg *quarantine.MsgBulkDecline) (*quarantine.MsgBulkDeclineResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %v", err)
+	}
+
+	filter, err := quarantine.NewRecordFilter(&msg.Filter, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	count := k.BulkDeclineQuarantinedFunds(ctx, toAddr, filter, bulkLimit(msg.Limit))
+
+	return &quarantine.MsgBulkDeclineResponse{Count: uint32(count)}, nil //nolint:gosec // G115: count is at most MaxBulkRecords.
+}
+
+// bulkLimit returns the number of records a bulk Msg with the provided limit can affect.
+func bulkLimit(limit uint32) int {
+	if limit == 0 || limit > quarantine.MaxBulkRecords {
+		return quarantine.MaxBulkRecords
+	}
+	return int(limit)
+}
+
 func (k Keeper) UpdateAutoResponses(goCtx context.Context, msg *quarantine.MsgUpdateAutoResponses) (*quarantine.MsgUpdateAutoResponsesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -117,3 +164,39 @@ func (k Keeper) UpdateAutoResponses(goCtx context.Context, msg *quarantine.MsgUp
 
 	return &quarantine.MsgUpdateAutoResponsesResponse{}, nil
 }
+
+func (k Keeper) UpdateAutoAcceptRules(goCtx context.Context, msg *quarantine.MsgUpdateAutoAcceptRules) (*quarantine.MsgUpdateAutoAcceptRulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %v", err)
+	}
+
+	if err = k.SetAutoAcceptRules(ctx, toAddr, msg.Rules); err != nil {
+		return nil, err
+	}
+
+	return &quarantine.MsgUpdateAutoAcceptRulesResponse{}, nil
+}
+
+func (k Keeper) UpdateFundsExpiration(goCtx context.Context, msg *quarantine.MsgUpdateFundsExpiration) (*quarantine.MsgUpdateFundsExpirationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %v", err)
+	}
+
+	var expiration *quarantine.FundsExpiration
+	if msg.Timeout > 0 {
+		exp := msg.GetFundsExpiration()
+		expiration = &exp
+	}
+
+	if err = k.SetFundsExpiration(ctx, toAddr, expiration); err != nil {
+		return nil, err
+	}
+
+	return &quarantine.MsgUpdateFundsExpirationResponse{}, nil
+}
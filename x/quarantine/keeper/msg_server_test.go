@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/quarantine"
@@ -272,6 +274,7 @@ func (s *TestSuite) TestDecline() {
 	amt2 := s.cz("55hagar")
 	s.Require().NoError(s.keeper.AddQuarantinedCoins(s.sdkCtx, amt1, addr0Acc, addr1Acc), "AddQuarantinedCoins 0 1")
 	s.Require().NoError(s.keeper.AddQuarantinedCoins(s.sdkCtx, amt2, addr0Acc, addr2Acc), "AddQuarantinedCoins 0 2")
+	quarantinedAt := s.sdkCtx.BlockTime()
 
 	tests := []struct {
 		name    string
@@ -335,6 +338,7 @@ func (s *TestSuite) TestDecline() {
 				UnacceptedFromAddresses: accs(addr1Acc),
 				Coins:                   testutil.MakeCopyOfCoins(amt1),
 				Declined:                true,
+				QuarantinedAt:           &quarantinedAt,
 			},
 		},
 		{
@@ -348,6 +352,7 @@ func (s *TestSuite) TestDecline() {
 				UnacceptedFromAddresses: accs(addr2Acc),
 				Coins:                   testutil.MakeCopyOfCoins(amt2),
 				Declined:                true,
+				QuarantinedAt:           &quarantinedAt,
 			},
 			expPerm: true,
 		},
@@ -362,6 +367,7 @@ func (s *TestSuite) TestDecline() {
 				UnacceptedFromAddresses: accs(addr1Acc),
 				Coins:                   testutil.MakeCopyOfCoins(amt1),
 				Declined:                true,
+				QuarantinedAt:           &quarantinedAt,
 			},
 			expPerm: true,
 		},
@@ -401,6 +407,63 @@ func (s *TestSuite) TestDecline() {
 	}
 }
 
+func (s *TestSuite) TestBulkAcceptAndDecline() {
+	addr0Acc := testutil.MakeTestAddr("bulk", 0)
+	addr1Acc := testutil.MakeTestAddr("bulk", 1)
+	addr2Acc := testutil.MakeTestAddr("bulk", 2)
+	addr3Acc := testutil.MakeTestAddr("bulk", 3)
+	addr0 := addr0Acc.String()
+
+	amt1 := s.cz("11book")
+	amt2 := s.cz("22tape")
+	amt3 := s.cz("33book")
+	s.Require().NoError(s.keeper.AddQuarantinedCoins(s.sdkCtx, amt1, addr0Acc, addr1Acc), "AddQuarantinedCoins 0 1")
+	s.Require().NoError(s.keeper.AddQuarantinedCoins(s.sdkCtx, amt2, addr0Acc, addr2Acc), "AddQuarantinedCoins 0 2")
+	s.Require().NoError(s.keeper.AddQuarantinedCoins(s.sdkCtx, amt3, addr0Acc, addr3Acc), "AddQuarantinedCoins 0 3")
+
+	s.Run("accept bad to address", func() {
+		_, err := s.keeper.BulkAccept(s.sdkCtx, &quarantine.MsgBulkAccept{ToAddress: "stillbad"})
+		s.AssertErrorContents(err, []string{"decoding bech32 failed", "invalid to address"}, "BulkAccept error")
+	})
+
+	s.Run("accept bad filter", func() {
+		msg := quarantine.NewMsgBulkAccept(addr0Acc, quarantine.QuarantinedFundsFilter{MinAge: -time.Hour}, 0)
+		_, err := s.keeper.BulkAccept(s.sdkCtx, msg)
+		s.AssertErrorContents(err, []string{"min age cannot be negative"}, "BulkAccept error")
+	})
+
+	s.Run("decline bad to address", func() {
+		_, err := s.keeper.BulkDecline(s.sdkCtx, &quarantine.MsgBulkDecline{ToAddress: "stillbad"})
+		s.AssertErrorContents(err, []string{"decoding bech32 failed", "invalid to address"}, "BulkDecline error")
+	})
+
+	s.Run("decline by denom", func() {
+		ctx := s.sdkCtx.WithEventManager(sdk.NewEventManager())
+		msg := quarantine.NewMsgBulkDecline(addr0Acc, quarantine.QuarantinedFundsFilter{Denom: "tape"}, 0)
+		resp, err := s.keeper.BulkDecline(ctx, msg)
+		s.Require().NoError(err, "BulkDecline error")
+		s.Assert().Equal(&quarantine.MsgBulkDeclineResponse{Count: 1}, resp, "BulkDecline response")
+		record := s.keeper.GetQuarantineRecord(s.sdkCtx, addr0Acc, addr2Acc)
+		s.Require().NotNil(record, "GetQuarantineRecord 0 2")
+		s.Assert().True(record.Declined, "record 0 2 Declined")
+	})
+
+	s.Run("accept the rest", func() {
+		bKeeper := NewMockBankKeeper()
+		qKeeper := s.keeper.WithBankKeeper(bKeeper)
+		ctx := s.sdkCtx.WithEventManager(sdk.NewEventManager())
+		msg := quarantine.NewMsgBulkAccept(addr0Acc, quarantine.QuarantinedFundsFilter{}, 0)
+		resp, err := qKeeper.BulkAccept(ctx, msg)
+		s.Require().NoError(err, "BulkAccept error")
+		s.Assert().Equal(&quarantine.MsgBulkAcceptResponse{FundsReleased: s.cz("44book"), Count: 2}, resp, "BulkAccept response")
+		s.Assert().Len(bKeeper.SentCoins, 2, "sends made")
+		s.Assert().Nil(qKeeper.GetQuarantineRecord(s.sdkCtx, addr0Acc, addr1Acc), "GetQuarantineRecord 0 1")
+		s.Assert().Nil(qKeeper.GetQuarantineRecord(s.sdkCtx, addr0Acc, addr3Acc), "GetQuarantineRecord 0 3")
+		s.Assert().NotNil(qKeeper.GetQuarantineRecord(s.sdkCtx, addr0Acc, addr2Acc), "GetQuarantineRecord 0 2")
+		s.Assert().Equal(addr0, bKeeper.SentCoins[0].ToAddr.String(), "first send to address")
+	})
+}
+
 func (s *TestSuite) TestUpdateAutoResponses() {
 	addr0 := testutil.MakeTestAddr("uar", 0).String()
 	addr1 := testutil.MakeTestAddr("uar", 1).String()
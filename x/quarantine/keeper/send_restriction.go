@@ -23,8 +23,9 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		return toAddr, nil
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	// Nothing to do if they're not quarantined or if they are, but have auto-accept enabled for the fromAddr.
-	if !k.IsQuarantinedAddr(ctx, toAddr) || k.IsAutoAccept(ctx, toAddr, fromAddr) {
+	// Nothing to do if they're not quarantined or if they are, but have auto-accept enabled for the fromAddr
+	// or have auto-accept rules that allow these funds.
+	if !k.IsQuarantinedAddr(ctx, toAddr) || k.IsAutoAccept(ctx, toAddr, fromAddr) || k.IsAutoAcceptedByRules(ctx, toAddr, fromAddr, amt) {
 		return toAddr, nil
 	}
 	// Make sure there's a funds holder defined since we need it now.
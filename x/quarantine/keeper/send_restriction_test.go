@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -167,6 +169,20 @@ func (s *TestSuite) TestSendRestrictionFn() {
 	}
 }
 
+func (s *TestSuite) TestSendRestrictionFnWithAutoAcceptRules() {
+	s.Require().NoError(s.keeper.SetOptIn(s.sdkCtx, s.addr1), "SetOptIn addr1")
+	rules := []quarantine.AutoAcceptRule{quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(100))}
+	s.Require().NoError(s.keeper.SetAutoAcceptRules(s.sdkCtx, s.addr1, rules), "SetAutoAcceptRules addr1")
+
+	newToAddr, err := s.keeper.SendRestrictionFn(s.sdkCtx, s.addr2, s.addr1, s.cz("100nhash"))
+	s.Require().NoError(err, "SendRestrictionFn 100nhash")
+	s.Assert().Equal(s.addr1, newToAddr, "SendRestrictionFn 100nhash result")
+
+	newToAddr, err = s.keeper.SendRestrictionFn(s.sdkCtx, s.addr2, s.addr1, s.cz("101nhash"))
+	s.Require().NoError(err, "SendRestrictionFn 101nhash")
+	s.Assert().Equal(s.keeper.GetFundsHolder(), newToAddr, "SendRestrictionFn 101nhash result")
+}
+
 func (s *TestSuite) TestBankSendCoinsUsesSendRestrictionFn() {
 	// This specifically does NOT mock the bank keeper because it's testing
 	// that the bank keeper is applying this module's send restriction.
@@ -3,7 +3,9 @@ package quarantine
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 	"sort"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -30,8 +32,24 @@ var (
 
 	// RecordIndexPrefix is the prefix for the index of record suffixes.
 	RecordIndexPrefix = []byte{0x03}
+
+	// AutoAcceptRulesPrefix is the prefix for quarantine auto-accept rules.
+	AutoAcceptRulesPrefix = []byte{0x04}
+
+	// FundsExpirationPrefix is the prefix for quarantine funds expiration settings.
+	FundsExpirationPrefix = []byte{0x05}
+
+	// ExpirationIndexPrefix is the prefix for the index of quarantine records by when they expire.
+	ExpirationIndexPrefix = []byte{0x06}
 )
 
+// MaxExpiredRecordsPerBlock is the most quarantine records that will be expired in a single block.
+// Any others that have expired are handled in later blocks.
+const MaxExpiredRecordsPerBlock = 100
+
+// MaxBulkRecords is the most quarantine records that can be accepted or declined in a single bulk Msg.
+const MaxBulkRecords = 1000
+
 // MakeKey concatenates the two byte slices into a new byte slice.
 func MakeKey(part1, part2 []byte) []byte {
 	rv := make([]byte, len(part1)+len(part2))
@@ -179,3 +197,63 @@ func ParseRecordIndexKey(key []byte) (toAddr, fromAddr sdk.AccAddress) {
 
 	return toAddr, fromAddr
 }
+
+// CreateAutoAcceptRulesKey creates the key for the quarantine auto-accept rules of a receiving address.
+func CreateAutoAcceptRulesKey(toAddr sdk.AccAddress) []byte {
+	toAddrBz := address.MustLengthPrefix(toAddr)
+	return MakeKey(AutoAcceptRulesPrefix, toAddrBz)
+}
+
+// ParseAutoAcceptRulesKey extracts the to address from the provided quarantine auto-accept rules key.
+func ParseAutoAcceptRulesKey(key []byte) (toAddr sdk.AccAddress) {
+	// key is of format:
+	// 0x04<to addr len><to addr bytes>
+	toAddrLen, toAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1)
+	toAddr, _ = sdk.ParseLengthPrefixedBytes(key, toAddrLenEndIndex+1, int(toAddrLen[0]))
+
+	return toAddr
+}
+
+// CreateFundsExpirationKey creates the key for the quarantine funds expiration settings of a receiving address.
+func CreateFundsExpirationKey(toAddr sdk.AccAddress) []byte {
+	toAddrBz := address.MustLengthPrefix(toAddr)
+	return MakeKey(FundsExpirationPrefix, toAddrBz)
+}
+
+// ParseFundsExpirationKey extracts the to address from the provided quarantine funds expiration key.
+func ParseFundsExpirationKey(key []byte) (toAddr sdk.AccAddress) {
+	// key is of format:
+	// 0x05<to addr len><to addr bytes>
+	toAddrLen, toAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1)
+	toAddr, _ = sdk.ParseLengthPrefixedBytes(key, toAddrLenEndIndex+1, int(toAddrLen[0]))
+
+	return toAddr
+}
+
+// CreateExpirationIndexTimePrefix creates a prefix for the expiration index entries that expire at the given time.
+func CreateExpirationIndexTimePrefix(expiresAt time.Time) []byte {
+	return MakeKey(ExpirationIndexPrefix, sdk.FormatTimeBytes(expiresAt))
+}
+
+// CreateExpirationIndexKey creates the key for the expiration index entry of a quarantine record.
+func CreateExpirationIndexKey(expiresAt time.Time, toAddr, recordSuffix sdk.AccAddress) []byte {
+	timePreBz := CreateExpirationIndexTimePrefix(expiresAt)
+	recordKey := CreateRecordKey(toAddr, recordSuffix)
+	return MakeKey(timePreBz, recordKey[len(RecordPrefix):])
+}
+
+// ParseExpirationIndexKey extracts the expiration time, to address, and record suffix from the provided expiration index key.
+func ParseExpirationIndexKey(key []byte) (expiresAt time.Time, toAddr, recordSuffix sdk.AccAddress, err error) {
+	// key is of format:
+	// 0x06<formatted time bytes><to addr len><to addr bytes><record suffix len><record suffix bytes>
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) < 1+timeLen {
+		return expiresAt, nil, nil, fmt.Errorf("invalid expiration index key length %d", len(key))
+	}
+	expiresAt, err = sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		return expiresAt, nil, nil, err
+	}
+	toAddr, recordSuffix = ParseRecordKey(MakeKey(RecordPrefix, key[1+timeLen:]))
+	return expiresAt, toAddr, recordSuffix, nil
+}
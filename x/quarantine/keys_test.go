@@ -3,6 +3,7 @@ package quarantine_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -23,6 +24,9 @@ func TestPrefixValues(t *testing.T) {
 		{name: "AutoResponsePrefix", prefix: quarantine.AutoResponsePrefix, expected: []byte{0x01}},
 		{name: "RecordPrefix", prefix: quarantine.RecordPrefix, expected: []byte{0x02}},
 		{name: "RecordIndexPrefix", prefix: quarantine.RecordIndexPrefix, expected: []byte{0x03}},
+		{name: "AutoAcceptRulesPrefix", prefix: quarantine.AutoAcceptRulesPrefix, expected: []byte{0x04}},
+		{name: "FundsExpirationPrefix", prefix: quarantine.FundsExpirationPrefix, expected: []byte{0x05}},
+		{name: "ExpirationIndexPrefix", prefix: quarantine.ExpirationIndexPrefix, expected: []byte{0x06}},
 	}
 
 	for _, p := range prefixes {
@@ -1133,3 +1137,33 @@ func TestParseRecordIndexKey(t *testing.T) {
 		})
 	}
 }
+
+func TestFundsExpirationKey(t *testing.T) {
+	for _, addr := range []sdk.AccAddress{testutil.MakeTestAddr("fek", 0), testutil.MakeLongAddr("fek", 1)} {
+		key := quarantine.CreateFundsExpirationKey(addr)
+		assert.Equal(t, quarantine.FundsExpirationPrefix, key[:1], "CreateFundsExpirationKey prefix")
+		assert.Equal(t, addr, quarantine.ParseFundsExpirationKey(key), "ParseFundsExpirationKey")
+	}
+}
+
+func TestExpirationIndexKey(t *testing.T) {
+	toAddr := testutil.MakeTestAddr("eik", 0)
+	suffix := testutil.MakeLongAddr("eik", 1)[:32]
+	expiresAt := time.Date(2024, 3, 15, 10, 30, 45, 123, time.UTC)
+
+	key := quarantine.CreateExpirationIndexKey(expiresAt, toAddr, suffix)
+	assert.Equal(t, quarantine.CreateExpirationIndexTimePrefix(expiresAt), key[:30], "CreateExpirationIndexKey time prefix")
+
+	actTime, actToAddr, actSuffix, err := quarantine.ParseExpirationIndexKey(key)
+	if assert.NoError(t, err, "ParseExpirationIndexKey") {
+		assert.Equal(t, expiresAt, actTime, "ParseExpirationIndexKey time")
+		assert.Equal(t, toAddr, actToAddr, "ParseExpirationIndexKey to address")
+		assert.Equal(t, suffix, actSuffix, "ParseExpirationIndexKey record suffix")
+	}
+
+	earlier := quarantine.CreateExpirationIndexKey(expiresAt.Add(-time.Second), toAddr, suffix)
+	assert.Less(t, string(earlier), string(key), "earlier key should sort first")
+
+	_, _, _, err = quarantine.ParseExpirationIndexKey([]byte{0x06, 0x01})
+	assert.EqualError(t, err, "invalid expiration index key length 2", "ParseExpirationIndexKey short key")
+}
@@ -30,7 +30,8 @@ var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -138,6 +139,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
+// EndBlock sends away the quarantined funds that have expired.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.ProcessExpiredFunds(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
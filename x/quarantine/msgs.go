@@ -1,6 +1,8 @@
 package quarantine
 
 import (
+	"time"
+
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,7 +17,11 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgOptOut)(nil),
 	(*MsgAccept)(nil),
 	(*MsgDecline)(nil),
+	(*MsgBulkAccept)(nil),
+	(*MsgBulkDecline)(nil),
 	(*MsgUpdateAutoResponses)(nil),
+	(*MsgUpdateAutoAcceptRules)(nil),
+	(*MsgUpdateFundsExpiration)(nil),
 }
 
 // NewMsgOptIn creates a new msg to opt in to account quarantine.
@@ -98,6 +104,48 @@ func (msg MsgDecline) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgBulkAccept creates a new msg to accept all quarantined funds that match a filter.
+func NewMsgBulkAccept(toAddr sdk.AccAddress, filter QuarantinedFundsFilter, limit uint32) *MsgBulkAccept {
+	return &MsgBulkAccept{
+		ToAddress: toAddr.String(),
+		Filter:    filter,
+		Limit:     limit,
+	}
+}
+
+// ValidateBasic does simple stateless validation of this Msg.
+func (msg MsgBulkAccept) ValidateBasic() error {
+	return validateBulkMsg(msg.ToAddress, msg.Filter, msg.Limit)
+}
+
+// NewMsgBulkDecline creates a new msg to decline all quarantined funds that match a filter.
+func NewMsgBulkDecline(toAddr sdk.AccAddress, filter QuarantinedFundsFilter, limit uint32) *MsgBulkDecline {
+	return &MsgBulkDecline{
+		ToAddress: toAddr.String(),
+		Filter:    filter,
+		Limit:     limit,
+	}
+}
+
+// ValidateBasic does simple stateless validation of this Msg.
+func (msg MsgBulkDecline) ValidateBasic() error {
+	return validateBulkMsg(msg.ToAddress, msg.Filter, msg.Limit)
+}
+
+// validateBulkMsg does the simple stateless validation shared by the bulk accept and decline Msgs.
+func validateBulkMsg(toAddr string, filter QuarantinedFundsFilter, limit uint32) error {
+	if _, err := sdk.AccAddressFromBech32(toAddr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+	if limit > MaxBulkRecords {
+		return qerrors.ErrInvalidValue.Wrapf("limit %d cannot be more than %d", limit, MaxBulkRecords)
+	}
+	return nil
+}
+
 // NewMsgUpdateAutoResponses creates a new msg to update quarantined auto-responses.
 func NewMsgUpdateAutoResponses(toAddr sdk.AccAddress, updates []*AutoResponseUpdate) *MsgUpdateAutoResponses {
 	return &MsgUpdateAutoResponses{
@@ -121,3 +169,53 @@ func (msg MsgUpdateAutoResponses) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgUpdateAutoAcceptRules creates a new msg to replace the quarantine auto-accept rules.
+func NewMsgUpdateAutoAcceptRules(toAddr sdk.AccAddress, rules []AutoAcceptRule) *MsgUpdateAutoAcceptRules {
+	return &MsgUpdateAutoAcceptRules{
+		ToAddress: toAddr.String(),
+		Rules:     rules,
+	}
+}
+
+// ValidateBasic does simple stateless validation of this Msg.
+func (msg MsgUpdateAutoAcceptRules) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	return ValidateAutoAcceptRules(msg.Rules)
+}
+
+// NewMsgUpdateFundsExpiration creates a new msg to set how long funds can remain quarantined.
+func NewMsgUpdateFundsExpiration(toAddr sdk.AccAddress, timeout time.Duration, fallbackAddr sdk.AccAddress) *MsgUpdateFundsExpiration {
+	rv := &MsgUpdateFundsExpiration{
+		ToAddress: toAddr.String(),
+		Timeout:   timeout,
+	}
+	if len(fallbackAddr) > 0 {
+		rv.FallbackAddress = fallbackAddr.String()
+	}
+	return rv
+}
+
+// ValidateBasic does simple stateless validation of this Msg.
+func (msg MsgUpdateFundsExpiration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	if msg.Timeout == 0 {
+		if len(msg.FallbackAddress) > 0 {
+			return qerrors.ErrInvalidValue.Wrap("a fallback address cannot be provided without a timeout")
+		}
+		return nil
+	}
+	return msg.GetFundsExpiration().Validate()
+}
+
+// GetFundsExpiration returns the funds expiration settings defined in this Msg.
+func (msg MsgUpdateFundsExpiration) GetFundsExpiration() FundsExpiration {
+	return FundsExpiration{
+		Timeout:         msg.Timeout,
+		FallbackAddress: msg.FallbackAddress,
+	}
+}
@@ -2,9 +2,12 @@ package quarantine_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	provtestutil "github.com/provenance-io/provenance/testutil"
@@ -20,7 +23,11 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgOptOut{ToAddress: signer} },
 		func(signer string) sdk.Msg { return &MsgAccept{ToAddress: signer} },
 		func(signer string) sdk.Msg { return &MsgDecline{ToAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgBulkAccept{ToAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgBulkDecline{ToAddress: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateAutoResponses{ToAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateAutoAcceptRules{ToAddress: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateFundsExpiration{ToAddress: signer} },
 	}
 
 	provtestutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -815,3 +822,187 @@ func TestMsgUpdateAutoResponses_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgBulkAccept_ValidateBasic(t *testing.T) {
+	testAddr0 := testutil.MakeTestAddr("mbavb", 0)
+	testAddr1 := testutil.MakeTestAddr("mbavb", 1)
+
+	tests := []struct {
+		name          string
+		orig          *MsgBulkAccept
+		expectedInErr []string
+	}{
+		{
+			name: "control",
+			orig: NewMsgBulkAccept(testAddr0, QuarantinedFundsFilter{}, 0),
+		},
+		{
+			name: "full filter and max limit",
+			orig: NewMsgBulkAccept(testAddr0, QuarantinedFundsFilter{
+				Denom:           "banana",
+				FromAddresses:   []string{testAddr1.String()},
+				MinAge:          time.Hour,
+				IncludeDeclined: true,
+			}, MaxBulkRecords),
+		},
+		{
+			name:          "bad to address",
+			orig:          &MsgBulkAccept{ToAddress: "bad"},
+			expectedInErr: []string{"invalid to address"},
+		},
+		{
+			name:          "bad denom",
+			orig:          NewMsgBulkAccept(testAddr0, QuarantinedFundsFilter{Denom: "x"}, 0),
+			expectedInErr: []string{"invalid denom"},
+		},
+		{
+			name:          "bad from address",
+			orig:          NewMsgBulkAccept(testAddr0, QuarantinedFundsFilter{FromAddresses: []string{testAddr1.String(), "bad"}}, 0),
+			expectedInErr: []string{"invalid from address[1]"},
+		},
+		{
+			name:          "negative min age",
+			orig:          NewMsgBulkAccept(testAddr0, QuarantinedFundsFilter{MinAge: -time.Second}, 0),
+			expectedInErr: []string{"min age cannot be negative: -1s"},
+		},
+		{
+			name:          "limit too large",
+			orig:          NewMsgBulkAccept(testAddr0, QuarantinedFundsFilter{}, MaxBulkRecords+1),
+			expectedInErr: []string{"limit 1001 cannot be more than 1000"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.orig.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expectedInErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestMsgBulkDecline_ValidateBasic(t *testing.T) {
+	testAddr0 := testutil.MakeTestAddr("mbdvb", 0)
+
+	tests := []struct {
+		name          string
+		orig          *MsgBulkDecline
+		expectedInErr []string
+	}{
+		{
+			name: "control",
+			orig: NewMsgBulkDecline(testAddr0, QuarantinedFundsFilter{Denom: "spam", MinAge: time.Hour}, 10),
+		},
+		{
+			name:          "bad to address",
+			orig:          &MsgBulkDecline{ToAddress: "bad"},
+			expectedInErr: []string{"invalid to address"},
+		},
+		{
+			name:          "bad filter",
+			orig:          NewMsgBulkDecline(testAddr0, QuarantinedFundsFilter{FromAddresses: []string{"bad"}}, 0),
+			expectedInErr: []string{"invalid from address[0]"},
+		},
+		{
+			name:          "limit too large",
+			orig:          NewMsgBulkDecline(testAddr0, QuarantinedFundsFilter{}, 5000),
+			expectedInErr: []string{"limit 5000 cannot be more than 1000"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.orig.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expectedInErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestMsgUpdateAutoAcceptRules_ValidateBasic(t *testing.T) {
+	testAddr0 := testutil.MakeTestAddr("muaarvb", 0)
+	goodRule := NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt())
+
+	tests := []struct {
+		name          string
+		orig          *MsgUpdateAutoAcceptRules
+		expectedInErr []string
+	}{
+		{
+			name:          "control",
+			orig:          NewMsgUpdateAutoAcceptRules(testAddr0, []AutoAcceptRule{goodRule}),
+			expectedInErr: nil,
+		},
+		{
+			name:          "no rules",
+			orig:          NewMsgUpdateAutoAcceptRules(testAddr0, nil),
+			expectedInErr: nil,
+		},
+		{
+			name:          "bad to address",
+			orig:          &MsgUpdateAutoAcceptRules{ToAddress: "bad", Rules: []AutoAcceptRule{goodRule}},
+			expectedInErr: []string{"invalid to address"},
+		},
+		{
+			name:          "bad second rule",
+			orig:          NewMsgUpdateAutoAcceptRules(testAddr0, []AutoAcceptRule{goodRule, NewAutoAcceptRule("", "", sdkmath.ZeroInt())}),
+			expectedInErr: []string{"invalid rule 2", "an attribute or denom is required"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.orig.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expectedInErr, "ValidateBasic")
+		})
+	}
+}
+
+func TestMsgUpdateFundsExpiration_ValidateBasic(t *testing.T) {
+	testAddr0 := testutil.MakeTestAddr("mufevb", 0)
+	testAddr1 := testutil.MakeTestAddr("mufevb", 1)
+
+	tests := []struct {
+		name          string
+		orig          *MsgUpdateFundsExpiration
+		expectedInErr []string
+	}{
+		{
+			name: "control",
+			orig: NewMsgUpdateFundsExpiration(testAddr0, time.Hour, nil),
+		},
+		{
+			name: "with fallback",
+			orig: NewMsgUpdateFundsExpiration(testAddr0, time.Hour, testAddr1),
+		},
+		{
+			name: "turn off",
+			orig: NewMsgUpdateFundsExpiration(testAddr0, 0, nil),
+		},
+		{
+			name:          "turn off with fallback",
+			orig:          NewMsgUpdateFundsExpiration(testAddr0, 0, testAddr1),
+			expectedInErr: []string{"a fallback address cannot be provided without a timeout"},
+		},
+		{
+			name:          "negative timeout",
+			orig:          NewMsgUpdateFundsExpiration(testAddr0, -time.Minute, nil),
+			expectedInErr: []string{"timeout must be positive"},
+		},
+		{
+			name:          "bad to address",
+			orig:          &MsgUpdateFundsExpiration{ToAddress: "bad", Timeout: time.Hour},
+			expectedInErr: []string{"invalid to address"},
+		},
+		{
+			name:          "bad fallback address",
+			orig:          &MsgUpdateFundsExpiration{ToAddress: testAddr0.String(), Timeout: time.Hour, FallbackAddress: "bad"},
+			expectedInErr: []string{"invalid fallback address"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.orig.ValidateBasic()
+			assertions.AssertErrorContents(t, err, tc.expectedInErr, "ValidateBasic")
+		})
+	}
+}
@@ -3,6 +3,9 @@ package quarantine
 import (
 	"bytes"
 	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -122,6 +125,117 @@ func (u AutoResponseUpdate) Validate() error {
 	return nil
 }
 
+// NewAutoAcceptRule creates a new quarantine auto-accept rule.
+func NewAutoAcceptRule(attribute, denom string, maxAmount sdkmath.Int) AutoAcceptRule {
+	return AutoAcceptRule{
+		Attribute: attribute,
+		Denom:     denom,
+		MaxAmount: maxAmount,
+	}
+}
+
+// Validate does simple stateless validation of this rule.
+func (r AutoAcceptRule) Validate() error {
+	if len(r.Attribute) == 0 && len(r.Denom) == 0 {
+		return errors.ErrInvalidValue.Wrap("an attribute or denom is required")
+	}
+	if len(r.Denom) > 0 {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return errors.ErrInvalidValue.Wrapf("invalid denom: %v", err)
+		}
+	}
+	if !r.MaxAmount.IsNil() {
+		if r.MaxAmount.IsNegative() {
+			return errors.ErrInvalidValue.Wrapf("max amount cannot be negative: %s", r.MaxAmount)
+		}
+		if r.MaxAmount.IsPositive() && len(r.Denom) == 0 {
+			return errors.ErrInvalidValue.Wrap("a denom is required with a max amount")
+		}
+	}
+	return nil
+}
+
+// HasMaxAmount returns true if this rule limits the amount of its denom that it accepts.
+func (r AutoAcceptRule) HasMaxAmount() bool {
+	return !r.MaxAmount.IsNil() && r.MaxAmount.IsPositive()
+}
+
+// AcceptsCoin returns true if the provided coin passes this rule's denom and max amount.
+// The attribute requirement is not checked here.
+func (r AutoAcceptRule) AcceptsCoin(coin sdk.Coin) bool {
+	if len(r.Denom) > 0 && r.Denom != coin.Denom {
+		return false
+	}
+	return !r.HasMaxAmount() || coin.Amount.LTE(r.MaxAmount)
+}
+
+// ValidateAutoAcceptRules does simple stateless validation of each of the provided rules.
+func ValidateAutoAcceptRules(rules []AutoAcceptRule) error {
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid rule %d: %v", i+1, err)
+		}
+	}
+	return nil
+}
+
+// NewAutoAcceptRulesEntry creates a new quarantine auto-accept rules entry.
+func NewAutoAcceptRulesEntry(toAddr sdk.AccAddress, rules []AutoAcceptRule) AutoAcceptRulesEntry {
+	return AutoAcceptRulesEntry{
+		ToAddress: toAddr.String(),
+		Rules:     rules,
+	}
+}
+
+// Validate does simple stateless validation of these auto-accept rules.
+func (e AutoAcceptRulesEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %v", err)
+	}
+	if len(e.Rules) == 0 {
+		return errors.ErrInvalidValue.Wrap("no rules")
+	}
+	return ValidateAutoAcceptRules(e.Rules)
+}
+
+// NewFundsExpiration creates a new quarantine funds expiration.
+func NewFundsExpiration(timeout time.Duration, fallbackAddr sdk.AccAddress) FundsExpiration {
+	rv := FundsExpiration{Timeout: timeout}
+	if len(fallbackAddr) > 0 {
+		rv.FallbackAddress = fallbackAddr.String()
+	}
+	return rv
+}
+
+// Validate does simple stateless validation of this funds expiration.
+func (e FundsExpiration) Validate() error {
+	if e.Timeout <= 0 {
+		return errors.ErrInvalidValue.Wrapf("timeout must be positive, got %s", e.Timeout)
+	}
+	if len(e.FallbackAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(e.FallbackAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid fallback address: %v", err)
+		}
+	}
+	return nil
+}
+
+// NewFundsExpirationEntry creates a new quarantine funds expiration entry.
+func NewFundsExpirationEntry(toAddr sdk.AccAddress, expiration FundsExpiration) FundsExpirationEntry {
+	return FundsExpirationEntry{
+		ToAddress:  toAddr.String(),
+		Expiration: expiration,
+	}
+}
+
+// Validate does simple stateless validation of this funds expiration entry.
+func (e FundsExpirationEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %v", err)
+	}
+	return e.Expiration.Validate()
+}
+
 const (
 	// NoAutoB is a byte with value 0 (corresponding to AUTO_RESPONSE_UNSPECIFIED).
 	NoAutoB = byte(0x00)
@@ -256,7 +370,91 @@ func (r *QuarantineRecord) GetAllFromAddrs() []sdk.AccAddress {
 
 // AsQuarantinedFunds creates a new QuarantinedFunds using fields in this and the provided addresses.
 func (r QuarantineRecord) AsQuarantinedFunds(toAddr sdk.AccAddress) *QuarantinedFunds {
-	return NewQuarantinedFunds(toAddr, r.UnacceptedFromAddresses, r.Coins, r.Declined)
+	rv := NewQuarantinedFunds(toAddr, r.UnacceptedFromAddresses, r.Coins, r.Declined)
+	rv.ExpiresAt = r.ExpiresAt
+	rv.QuarantinedAt = r.QuarantinedAt
+	return rv
+}
+
+// Validate does simple stateless validation of this quarantined funds filter.
+func (f QuarantinedFundsFilter) Validate() error {
+	if len(f.Denom) > 0 {
+		if err := sdk.ValidateDenom(f.Denom); err != nil {
+			return errors.ErrInvalidValue.Wrapf("invalid denom: %v", err)
+		}
+	}
+	for i, addr := range f.FromAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address[%d]: %v", i, err)
+		}
+	}
+	if f.MinAge < 0 {
+		return errors.ErrInvalidValue.Wrapf("min age cannot be negative: %s", f.MinAge)
+	}
+	return nil
+}
+
+// RecordFilter is a QuarantinedFundsFilter that's ready to be applied to quarantine records.
+type RecordFilter struct {
+	denom             string
+	fromAddrs         []sdk.AccAddress
+	quarantinedBefore *time.Time
+	includeDeclined   bool
+}
+
+// NewRecordFilter creates a RecordFilter from the provided filter as of the provided time.
+// A nil filter matches every record that hasn't been declined.
+func NewRecordFilter(filter *QuarantinedFundsFilter, now time.Time) (*RecordFilter, error) {
+	rv := &RecordFilter{}
+	if filter == nil {
+		return rv, nil
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	rv.denom = filter.Denom
+	rv.includeDeclined = filter.IncludeDeclined
+	for _, addr := range filter.FromAddresses {
+		rv.fromAddrs = append(rv.fromAddrs, sdk.MustAccAddressFromBech32(addr))
+	}
+	if filter.MinAge > 0 {
+		before := now.Add(-filter.MinAge)
+		rv.quarantinedBefore = &before
+	}
+	return rv, nil
+}
+
+// WithoutDeclined returns a copy of this filter that never matches declined records.
+func (f RecordFilter) WithoutDeclined() *RecordFilter {
+	f.includeDeclined = false
+	return &f
+}
+
+// IncludingDeclined returns a copy of this filter that matches records regardless of whether they've been declined.
+func (f RecordFilter) IncludingDeclined() *RecordFilter {
+	f.includeDeclined = true
+	return &f
+}
+
+// Matches returns true if the provided record passes this filter.
+// Records without a quarantined-at time are treated as being older than any min age.
+func (f *RecordFilter) Matches(record *QuarantineRecord) bool {
+	if record.Declined && !f.includeDeclined {
+		return false
+	}
+	if len(f.denom) > 0 && record.Coins.AmountOf(f.denom).IsZero() {
+		return false
+	}
+	if len(f.fromAddrs) > 0 {
+		found, _ := findAddresses(record.GetAllFromAddrs(), f.fromAddrs)
+		if len(found) == 0 {
+			return false
+		}
+	}
+	if f.quarantinedBefore != nil && record.QuarantinedAt != nil && record.QuarantinedAt.After(*f.quarantinedBefore) {
+		return false
+	}
+	return true
 }
 
 // AddSuffixes adds the provided suffixes to this.
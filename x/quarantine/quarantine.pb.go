@@ -4,6 +4,7 @@
 package quarantine
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -11,15 +12,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -71,6 +77,10 @@ type QuarantinedFunds struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 	// declined is true if these funds were previously declined.
 	Declined bool `protobuf:"varint,4,opt,name=declined,proto3" json:"declined,omitempty"`
+	// expires_at is when these funds will be returned to the sender (or swept to the fallback address) if still quarantined.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// quarantined_at is when these funds were first quarantined.
+	QuarantinedAt *time.Time `protobuf:"bytes,6,opt,name=quarantined_at,json=quarantinedAt,proto3,stdtime" json:"quarantined_at,omitempty"`
 }
 
 func (m *QuarantinedFunds) Reset()         { *m = QuarantinedFunds{} }
@@ -134,6 +144,20 @@ func (m *QuarantinedFunds) GetDeclined() bool {
 	return false
 }
 
+func (m *QuarantinedFunds) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *QuarantinedFunds) GetQuarantinedAt() *time.Time {
+	if m != nil {
+		return m.QuarantinedAt
+	}
+	return nil
+}
+
 // AutoResponseEntry defines the auto response to one address from another.
 type AutoResponseEntry struct {
 	// to_address is the receiving address.
@@ -254,6 +278,348 @@ func (m *AutoResponseUpdate) GetResponse() AutoResponse {
 	return AUTO_RESPONSE_UNSPECIFIED
 }
 
+// AutoAcceptRule defines a rule for automatically accepting funds sent to a quarantined address.
+// At least one of attribute or denom must be provided.
+type AutoAcceptRule struct {
+	// attribute, if provided, is an attribute name that the sender must have for this rule to apply.
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// denom, if provided, limits this rule to coins of this denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_amount, if positive, is the largest amount of the denom that this rule will accept. Requires a denom.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+}
+
+func (m *AutoAcceptRule) Reset()         { *m = AutoAcceptRule{} }
+func (m *AutoAcceptRule) String() string { return proto.CompactTextString(m) }
+func (*AutoAcceptRule) ProtoMessage()    {}
+func (*AutoAcceptRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b055d4922680476, []int{3}
+}
+func (m *AutoAcceptRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoAcceptRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoAcceptRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoAcceptRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoAcceptRule.Merge(m, src)
+}
+func (m *AutoAcceptRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoAcceptRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoAcceptRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoAcceptRule proto.InternalMessageInfo
+
+func (m *AutoAcceptRule) GetAttribute() string {
+	if m != nil {
+		return m.Attribute
+	}
+	return ""
+}
+
+func (m *AutoAcceptRule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// AutoAcceptRulesEntry defines the auto-accept rules of a quarantined address.
+type AutoAcceptRulesEntry struct {
+	// to_address is the receiving address.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// rules are the auto-accept rules for the to_address.
+	Rules []AutoAcceptRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *AutoAcceptRulesEntry) Reset()         { *m = AutoAcceptRulesEntry{} }
+func (m *AutoAcceptRulesEntry) String() string { return proto.CompactTextString(m) }
+func (*AutoAcceptRulesEntry) ProtoMessage()    {}
+func (*AutoAcceptRulesEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b055d4922680476, []int{4}
+}
+func (m *AutoAcceptRulesEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoAcceptRulesEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoAcceptRulesEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoAcceptRulesEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoAcceptRulesEntry.Merge(m, src)
+}
+func (m *AutoAcceptRulesEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoAcceptRulesEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoAcceptRulesEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoAcceptRulesEntry proto.InternalMessageInfo
+
+func (m *AutoAcceptRulesEntry) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *AutoAcceptRulesEntry) GetRules() []AutoAcceptRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// AutoAcceptRules defines the list of auto-accept rules that is stored in state for a quarantined address.
+type AutoAcceptRules struct {
+	Rules []AutoAcceptRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *AutoAcceptRules) Reset()         { *m = AutoAcceptRules{} }
+func (m *AutoAcceptRules) String() string { return proto.CompactTextString(m) }
+func (*AutoAcceptRules) ProtoMessage()    {}
+func (*AutoAcceptRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b055d4922680476, []int{5}
+}
+func (m *AutoAcceptRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoAcceptRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoAcceptRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoAcceptRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoAcceptRules.Merge(m, src)
+}
+func (m *AutoAcceptRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoAcceptRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoAcceptRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoAcceptRules proto.InternalMessageInfo
+
+func (m *AutoAcceptRules) GetRules() []AutoAcceptRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// FundsExpiration defines how long funds sent to a quarantined address can remain undecided.
+type FundsExpiration struct {
+	// timeout is how long funds can remain quarantined before they expire.
+	Timeout time.Duration `protobuf:"bytes,1,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// fallback_address, if provided, is where expired funds are sent. Otherwise, they are returned to the sender.
+	FallbackAddress string `protobuf:"bytes,2,opt,name=fallback_address,json=fallbackAddress,proto3" json:"fallback_address,omitempty"`
+}
+
+func (m *FundsExpiration) Reset()         { *m = FundsExpiration{} }
+func (m *FundsExpiration) String() string { return proto.CompactTextString(m) }
+func (*FundsExpiration) ProtoMessage()    {}
+func (*FundsExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b055d4922680476, []int{6}
+}
+func (m *FundsExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundsExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundsExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundsExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundsExpiration.Merge(m, src)
+}
+func (m *FundsExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundsExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundsExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundsExpiration proto.InternalMessageInfo
+
+func (m *FundsExpiration) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *FundsExpiration) GetFallbackAddress() string {
+	if m != nil {
+		return m.FallbackAddress
+	}
+	return ""
+}
+
+// FundsExpirationEntry defines the funds expiration settings of a quarantined address.
+type FundsExpirationEntry struct {
+	// to_address is the receiving address.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// expiration is the funds expiration settings for the to_address.
+	Expiration FundsExpiration `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration"`
+}
+
+func (m *FundsExpirationEntry) Reset()         { *m = FundsExpirationEntry{} }
+func (m *FundsExpirationEntry) String() string { return proto.CompactTextString(m) }
+func (*FundsExpirationEntry) ProtoMessage()    {}
+func (*FundsExpirationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b055d4922680476, []int{7}
+}
+func (m *FundsExpirationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundsExpirationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundsExpirationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundsExpirationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundsExpirationEntry.Merge(m, src)
+}
+func (m *FundsExpirationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundsExpirationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundsExpirationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundsExpirationEntry proto.InternalMessageInfo
+
+func (m *FundsExpirationEntry) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *FundsExpirationEntry) GetExpiration() FundsExpiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return FundsExpiration{}
+}
+
+// QuarantinedFundsFilter defines criteria for selecting some of the funds quarantined for an address.
+// Funds must match every criterion that is provided.
+type QuarantinedFundsFilter struct {
+	// denom, if provided, limits the selection to funds that include this denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// from_addresses, if provided, limits the selection to funds sent by any of these addresses.
+	FromAddresses []string `protobuf:"bytes,2,rep,name=from_addresses,json=fromAddresses,proto3" json:"from_addresses,omitempty"`
+	// min_age, if provided, limits the selection to funds that have been quarantined for at least this long.
+	MinAge time.Duration `protobuf:"bytes,3,opt,name=min_age,json=minAge,proto3,stdduration" json:"min_age"`
+	// include_declined, if true, also selects funds that have been declined.
+	IncludeDeclined bool `protobuf:"varint,4,opt,name=include_declined,json=includeDeclined,proto3" json:"include_declined,omitempty"`
+}
+
+func (m *QuarantinedFundsFilter) Reset()         { *m = QuarantinedFundsFilter{} }
+func (m *QuarantinedFundsFilter) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedFundsFilter) ProtoMessage()    {}
+func (*QuarantinedFundsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b055d4922680476, []int{8}
+}
+func (m *QuarantinedFundsFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantinedFundsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantinedFundsFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuarantinedFundsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedFundsFilter.Merge(m, src)
+}
+func (m *QuarantinedFundsFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantinedFundsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedFundsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedFundsFilter proto.InternalMessageInfo
+
+func (m *QuarantinedFundsFilter) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QuarantinedFundsFilter) GetFromAddresses() []string {
+	if m != nil {
+		return m.FromAddresses
+	}
+	return nil
+}
+
+func (m *QuarantinedFundsFilter) GetMinAge() time.Duration {
+	if m != nil {
+		return m.MinAge
+	}
+	return 0
+}
+
+func (m *QuarantinedFundsFilter) GetIncludeDeclined() bool {
+	if m != nil {
+		return m.IncludeDeclined
+	}
+	return false
+}
+
 // QuarantineRecord defines information regarding quarantined funds that is stored in state.
 type QuarantineRecord struct {
 	// unaccepted_from_addresses are the senders that have not been part of an accept yet for these coins.
@@ -264,13 +630,17 @@ type QuarantineRecord struct {
 	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 	// declined is whether these funds have been declined.
 	Declined bool `protobuf:"varint,4,opt,name=declined,proto3" json:"declined,omitempty"`
+	// expires_at is when these funds will be returned to the sender (or swept to the fallback address) if still quarantined.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// quarantined_at is when these funds were first quarantined.
+	QuarantinedAt *time.Time `protobuf:"bytes,6,opt,name=quarantined_at,json=quarantinedAt,proto3,stdtime" json:"quarantined_at,omitempty"`
 }
 
 func (m *QuarantineRecord) Reset()         { *m = QuarantineRecord{} }
 func (m *QuarantineRecord) String() string { return proto.CompactTextString(m) }
 func (*QuarantineRecord) ProtoMessage()    {}
 func (*QuarantineRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b055d4922680476, []int{3}
+	return fileDescriptor_0b055d4922680476, []int{9}
 }
 func (m *QuarantineRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,6 +697,20 @@ func (m *QuarantineRecord) GetDeclined() bool {
 	return false
 }
 
+func (m *QuarantineRecord) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *QuarantineRecord) GetQuarantinedAt() *time.Time {
+	if m != nil {
+		return m.QuarantinedAt
+	}
+	return nil
+}
+
 // QuarantineRecordSuffixIndex defines a list of record suffixes that can be stored in state and used as an index.
 type QuarantineRecordSuffixIndex struct {
 	RecordSuffixes [][]byte `protobuf:"bytes,1,rep,name=record_suffixes,json=recordSuffixes,proto3" json:"record_suffixes,omitempty"`
@@ -336,7 +720,7 @@ func (m *QuarantineRecordSuffixIndex) Reset()         { *m = QuarantineRecordSuf
 func (m *QuarantineRecordSuffixIndex) String() string { return proto.CompactTextString(m) }
 func (*QuarantineRecordSuffixIndex) ProtoMessage()    {}
 func (*QuarantineRecordSuffixIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b055d4922680476, []int{4}
+	return fileDescriptor_0b055d4922680476, []int{10}
 }
 func (m *QuarantineRecordSuffixIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,6 +761,12 @@ func init() {
 	proto.RegisterType((*QuarantinedFunds)(nil), "cosmos.quarantine.v1beta1.QuarantinedFunds")
 	proto.RegisterType((*AutoResponseEntry)(nil), "cosmos.quarantine.v1beta1.AutoResponseEntry")
 	proto.RegisterType((*AutoResponseUpdate)(nil), "cosmos.quarantine.v1beta1.AutoResponseUpdate")
+	proto.RegisterType((*AutoAcceptRule)(nil), "cosmos.quarantine.v1beta1.AutoAcceptRule")
+	proto.RegisterType((*AutoAcceptRulesEntry)(nil), "cosmos.quarantine.v1beta1.AutoAcceptRulesEntry")
+	proto.RegisterType((*AutoAcceptRules)(nil), "cosmos.quarantine.v1beta1.AutoAcceptRules")
+	proto.RegisterType((*FundsExpiration)(nil), "cosmos.quarantine.v1beta1.FundsExpiration")
+	proto.RegisterType((*FundsExpirationEntry)(nil), "cosmos.quarantine.v1beta1.FundsExpirationEntry")
+	proto.RegisterType((*QuarantinedFundsFilter)(nil), "cosmos.quarantine.v1beta1.QuarantinedFundsFilter")
 	proto.RegisterType((*QuarantineRecord)(nil), "cosmos.quarantine.v1beta1.QuarantineRecord")
 	proto.RegisterType((*QuarantineRecordSuffixIndex)(nil), "cosmos.quarantine.v1beta1.QuarantineRecordSuffixIndex")
 }
@@ -386,45 +776,69 @@ func init() {
 }
 
 var fileDescriptor_0b055d4922680476 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x25, 0x05, 0xb5, 0xd7, 0xa8, 0xa4, 0xa7, 0x56, 0x75, 0x82, 0x70, 0xa3, 0x2e, 0xb5,
-	0x22, 0xc5, 0xa6, 0x65, 0x60, 0x60, 0x72, 0x5c, 0x47, 0x0a, 0x42, 0x6d, 0x71, 0xda, 0x85, 0xc5,
-	0x72, 0xec, 0x4b, 0x6a, 0x51, 0xdf, 0x99, 0xbb, 0x73, 0x69, 0x47, 0x36, 0x46, 0x26, 0x06, 0x56,
-	0x16, 0xc4, 0xd4, 0x01, 0xf1, 0x1b, 0x3a, 0xa1, 0x8a, 0x89, 0xa9, 0xa0, 0x76, 0xe8, 0x7f, 0x60,
-	0x42, 0xb1, 0x2f, 0xad, 0x0b, 0x6a, 0x41, 0xd9, 0x58, 0x6c, 0xdf, 0xfb, 0xde, 0xfb, 0xde, 0xf3,
-	0xf7, 0x3e, 0x1d, 0x6c, 0xf8, 0x94, 0x47, 0x94, 0x1b, 0x2f, 0x12, 0x8f, 0x79, 0x44, 0x84, 0x04,
-	0x1b, 0x7b, 0x2b, 0x3d, 0x2c, 0xbc, 0x95, 0x5c, 0x48, 0x8f, 0x19, 0x15, 0x14, 0x55, 0xb3, 0x5c,
-	0x3d, 0x07, 0xc8, 0xdc, 0xda, 0xac, 0x17, 0x85, 0x84, 0x1a, 0xe9, 0x33, 0xcb, 0xae, 0xa9, 0x92,
-	0xb9, 0xe7, 0xf1, 0x4b, 0x4e, 0x9f, 0x86, 0x44, 0xe2, 0x92, 0xcd, 0x4d, 0x4f, 0x86, 0xa4, 0xce,
-	0xa0, 0xb9, 0x01, 0x1d, 0xd0, 0x2c, 0x3e, 0xfc, 0xca, 0xa2, 0x4b, 0x9f, 0x8b, 0xb0, 0xf2, 0xf4,
-	0xa2, 0x75, 0xd0, 0x4e, 0x48, 0xc0, 0xd1, 0x43, 0x08, 0x05, 0x75, 0xbd, 0x20, 0x60, 0x98, 0x73,
-	0x05, 0xd4, 0x81, 0x36, 0xd5, 0x52, 0xbe, 0x7e, 0x6a, 0xce, 0x49, 0x42, 0x33, 0x43, 0xba, 0x82,
-	0x85, 0x64, 0xe0, 0x4c, 0x09, 0x2a, 0x03, 0x68, 0x0b, 0x56, 0x13, 0xe2, 0xf9, 0x3e, 0x8e, 0x05,
-	0x0e, 0xdc, 0x3e, 0xa3, 0xd1, 0x88, 0x05, 0x73, 0xa5, 0x58, 0x2f, 0xdd, 0xc8, 0xb3, 0x70, 0x59,
-	0xda, 0x66, 0x34, 0x32, 0x47, 0x85, 0xe8, 0x25, 0xbc, 0x35, 0xfc, 0x45, 0xae, 0x94, 0xea, 0x25,
-	0x6d, 0x7a, 0xb5, 0xaa, 0xcb, 0xf2, 0xa1, 0x08, 0x23, 0xb1, 0x74, 0x8b, 0x86, 0xa4, 0xd5, 0x3e,
-	0x3a, 0x59, 0x2c, 0x7c, 0xfc, 0xbe, 0xa8, 0x0d, 0x42, 0xb1, 0x93, 0xf4, 0x74, 0x9f, 0x46, 0x52,
-	0x04, 0xf9, 0x6a, 0xf2, 0xe0, 0xb9, 0x21, 0x0e, 0x62, 0xcc, 0xd3, 0x02, 0xfe, 0xee, 0xfc, 0xb0,
-	0x51, 0xde, 0xc5, 0x03, 0xcf, 0x3f, 0x70, 0xd3, 0x1e, 0x1f, 0xce, 0x0f, 0x1b, 0xc0, 0xc9, 0xfa,
-	0xa1, 0x1a, 0x9c, 0x0c, 0xb0, 0xbf, 0x3b, 0x14, 0x46, 0x99, 0xa8, 0x03, 0x6d, 0xd2, 0xb9, 0x38,
-	0x2f, 0x7d, 0x01, 0x70, 0xd6, 0x4c, 0x04, 0x75, 0x30, 0x8f, 0x29, 0xe1, 0xd8, 0x26, 0x82, 0x1d,
-	0x8c, 0xaf, 0xdc, 0x23, 0x58, 0xce, 0xcb, 0xa5, 0x14, 0xff, 0x52, 0x3a, 0xdd, 0xbf, 0x94, 0x08,
-	0x59, 0x70, 0x92, 0xc9, 0x31, 0x94, 0x52, 0x1d, 0x68, 0x33, 0xab, 0xcb, 0xfa, 0xb5, 0xb6, 0xd2,
-	0xf3, 0x53, 0x3b, 0x17, 0x85, 0x4b, 0x6f, 0x01, 0x44, 0x79, 0x68, 0x3b, 0x0e, 0x3c, 0x81, 0xff,
-	0x18, 0x0c, 0x8c, 0x3b, 0x58, 0x71, 0xdc, 0xc1, 0x5e, 0x95, 0xf2, 0x16, 0x75, 0xb0, 0x4f, 0x59,
-	0x80, 0xa2, 0x9b, 0x9c, 0x06, 0xea, 0x25, 0xad, 0xdc, 0x5a, 0xf9, 0x79, 0xb2, 0xd8, 0xfc, 0x07,
-	0x23, 0x98, 0xbe, 0x2f, 0xe7, 0xbd, 0xde, 0x82, 0x21, 0x5c, 0xb8, 0xc9, 0xd6, 0x63, 0x35, 0x9b,
-	0xff, 0x8f, 0xdc, 0xde, 0x86, 0x77, 0x7f, 0x5f, 0x41, 0x37, 0xe9, 0xf7, 0xc3, 0xfd, 0x0e, 0x09,
-	0xf0, 0x3e, 0x5a, 0x86, 0x77, 0x58, 0x1a, 0x74, 0x79, 0x1a, 0x1d, 0xed, 0xc0, 0x99, 0x61, 0xb9,
-	0x5c, 0xcc, 0x1b, 0x3b, 0xb0, 0x9c, 0xdf, 0x32, 0xba, 0x07, 0xab, 0xe6, 0xf6, 0xd6, 0x86, 0xeb,
-	0xd8, 0xdd, 0xcd, 0x8d, 0xf5, 0xae, 0xed, 0x6e, 0xaf, 0x77, 0x37, 0x6d, 0xab, 0xd3, 0xee, 0xd8,
-	0x6b, 0x95, 0x02, 0x52, 0xe0, 0xdc, 0x55, 0xd8, 0xb4, 0x2c, 0x7b, 0x73, 0xab, 0x02, 0x50, 0x15,
-	0xce, 0x5f, 0x45, 0xd6, 0x6c, 0xeb, 0x49, 0x67, 0xdd, 0xae, 0x14, 0x6b, 0x13, 0xaf, 0xdf, 0xab,
-	0x85, 0xd6, 0xe3, 0xa3, 0x53, 0x15, 0x1c, 0x9f, 0xaa, 0xe0, 0xc7, 0xa9, 0x0a, 0xde, 0x9c, 0xa9,
-	0x85, 0xe3, 0x33, 0xb5, 0xf0, 0xed, 0x4c, 0x2d, 0x3c, 0xbb, 0x9f, 0x93, 0x2b, 0x66, 0x74, 0x0f,
-	0x13, 0x8f, 0xf8, 0xb8, 0x19, 0xd2, 0xdc, 0xc9, 0xd8, 0xcf, 0xdd, 0xd4, 0xbd, 0xdb, 0xe9, 0x5d,
-	0xf9, 0xe0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xd9, 0x7b, 0x9e, 0xd8, 0x05, 0x00, 0x00,
+	// 979 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0x1a, 0xc7,
+	0x17, 0x67, 0x6c, 0xec, 0x98, 0xc1, 0x01, 0x32, 0xc2, 0xff, 0x00, 0xff, 0x16, 0x10, 0x97, 0x10,
+	0x2a, 0x96, 0x9a, 0x1e, 0x7a, 0x68, 0xab, 0x68, 0xc1, 0x4b, 0x45, 0x54, 0x39, 0xee, 0x62, 0x4b,
+	0x55, 0x2f, 0xab, 0x61, 0x77, 0xc0, 0x2b, 0xb3, 0x33, 0x74, 0x67, 0x36, 0xc5, 0xdf, 0xa0, 0x52,
+	0x2f, 0xbe, 0xb4, 0xaa, 0x7a, 0xab, 0x7a, 0xa9, 0x7a, 0xca, 0x21, 0x1f, 0xa0, 0xc7, 0x9c, 0xaa,
+	0x28, 0xa7, 0xa8, 0x07, 0xa7, 0xb2, 0x0f, 0xf9, 0x0e, 0x3d, 0x55, 0xbb, 0x3b, 0x6b, 0xd6, 0x54,
+	0x71, 0x5c, 0x7c, 0xed, 0x05, 0x98, 0xf7, 0xe6, 0xfd, 0xe6, 0x37, 0xbf, 0xf7, 0xe6, 0x3d, 0x60,
+	0xc3, 0x64, 0xdc, 0x61, 0xbc, 0xf5, 0x95, 0x87, 0x5d, 0x4c, 0x85, 0x4d, 0x49, 0xeb, 0xf1, 0xf6,
+	0x90, 0x08, 0xbc, 0x1d, 0x33, 0x29, 0x53, 0x97, 0x09, 0x86, 0x8a, 0xe1, 0x5e, 0x25, 0xe6, 0x90,
+	0x7b, 0x4b, 0x77, 0xb0, 0x63, 0x53, 0xd6, 0x0a, 0x3e, 0xc3, 0xdd, 0xa5, 0xb2, 0x44, 0x1e, 0x62,
+	0x3e, 0xc7, 0x34, 0x99, 0x4d, 0xa5, 0x5f, 0xa2, 0x19, 0xc1, 0xaa, 0x25, 0xa1, 0x43, 0x57, 0x7e,
+	0xcc, 0xc6, 0x2c, 0xb4, 0xfb, 0xbf, 0x22, 0xc0, 0x31, 0x63, 0xe3, 0x09, 0x69, 0x05, 0xab, 0xa1,
+	0x37, 0x6a, 0x59, 0x9e, 0x8b, 0x85, 0xcd, 0x22, 0xc0, 0xca, 0xa2, 0x5f, 0xd8, 0x0e, 0xe1, 0x02,
+	0x3b, 0xd3, 0x70, 0x43, 0xed, 0xb7, 0x55, 0x98, 0xfb, 0xfc, 0x82, 0xbb, 0xd5, 0xf3, 0xa8, 0xc5,
+	0xd1, 0x87, 0x10, 0x0a, 0x66, 0x60, 0xcb, 0x72, 0x09, 0xe7, 0x05, 0x50, 0x05, 0xf5, 0x54, 0xa7,
+	0xf0, 0xe2, 0x69, 0x33, 0x2f, 0x19, 0xa9, 0xa1, 0x67, 0x20, 0x5c, 0x9b, 0x8e, 0xf5, 0x94, 0x60,
+	0xd2, 0x80, 0xf6, 0x61, 0xd1, 0xa3, 0xd8, 0x34, 0xc9, 0x54, 0x10, 0xcb, 0x18, 0xb9, 0xcc, 0x89,
+	0x50, 0x08, 0x2f, 0xac, 0x54, 0x57, 0xaf, 0xc4, 0xb9, 0x3b, 0x0f, 0xed, 0xb9, 0xcc, 0x51, 0xa3,
+	0x40, 0xf4, 0x35, 0x5c, 0xf3, 0x35, 0xe2, 0x85, 0xd5, 0xea, 0x6a, 0x3d, 0xdd, 0x2e, 0x2a, 0x32,
+	0xdc, 0x57, 0x31, 0x52, 0x5b, 0xe9, 0x32, 0x9b, 0x76, 0x7a, 0xcf, 0x4e, 0x2b, 0x89, 0x5f, 0x5f,
+	0x55, 0xea, 0x63, 0x5b, 0x1c, 0x7a, 0x43, 0xc5, 0x64, 0x8e, 0x54, 0x51, 0x7e, 0x35, 0xb9, 0x75,
+	0xd4, 0x12, 0xc7, 0x53, 0xc2, 0x83, 0x00, 0xfe, 0xe3, 0xeb, 0x27, 0x8d, 0xcd, 0x09, 0x19, 0x63,
+	0xf3, 0xd8, 0x08, 0xce, 0xf8, 0xe5, 0xf5, 0x93, 0x06, 0xd0, 0xc3, 0xf3, 0x50, 0x09, 0x6e, 0x58,
+	0xc4, 0x9c, 0xf8, 0xc2, 0x14, 0x92, 0x55, 0x50, 0xdf, 0xd0, 0x2f, 0xd6, 0xe8, 0x01, 0x84, 0x64,
+	0x36, 0xb5, 0x5d, 0xc2, 0x0d, 0x2c, 0x0a, 0x6b, 0x55, 0x50, 0x4f, 0xb7, 0x4b, 0x4a, 0x28, 0xb7,
+	0x12, 0xc9, 0xad, 0xec, 0x47, 0x72, 0x77, 0x92, 0x27, 0xaf, 0x2a, 0x40, 0x4f, 0xc9, 0x18, 0x55,
+	0xa0, 0x4f, 0x61, 0x66, 0x5e, 0x34, 0x96, 0x0f, 0xb2, 0x7e, 0x4d, 0x90, 0xdb, 0xb1, 0x38, 0x55,
+	0xd4, 0x7e, 0x07, 0xf0, 0x8e, 0xea, 0x09, 0xa6, 0x13, 0x3e, 0x65, 0x94, 0x13, 0x8d, 0x0a, 0xf7,
+	0x78, 0xf9, 0x1c, 0x7e, 0x04, 0x37, 0xe3, 0x89, 0x2b, 0xac, 0xbc, 0x25, 0x34, 0x3d, 0x9a, 0x27,
+	0x0b, 0x75, 0xe1, 0x86, 0x2b, 0x69, 0x14, 0x56, 0xab, 0xa0, 0x9e, 0x69, 0xdf, 0x53, 0xde, 0xf8,
+	0x42, 0x94, 0x38, 0x6b, 0xfd, 0x22, 0xb0, 0xf6, 0x3d, 0x80, 0x28, 0xee, 0x3a, 0x98, 0x5a, 0x58,
+	0x90, 0x7f, 0x10, 0x03, 0xcb, 0x12, 0x5b, 0x59, 0x96, 0xd8, 0x09, 0x80, 0x19, 0xdf, 0xa5, 0x06,
+	0x65, 0xaa, 0x7b, 0x13, 0x82, 0xde, 0x81, 0x29, 0x2c, 0x84, 0x6b, 0x0f, 0x3d, 0x41, 0x42, 0x46,
+	0xfa, 0xdc, 0x80, 0xf2, 0x70, 0xcd, 0x22, 0x94, 0x39, 0xa1, 0x88, 0x7a, 0xb8, 0x40, 0x0f, 0x21,
+	0x74, 0xf0, 0xcc, 0xc0, 0x0e, 0xf3, 0xa8, 0x08, 0x64, 0x4a, 0x75, 0xde, 0xf3, 0x2b, 0xf7, 0x8f,
+	0xd3, 0xca, 0x56, 0x48, 0x8a, 0x5b, 0x47, 0x8a, 0xcd, 0x5a, 0x0e, 0x16, 0x87, 0x4a, 0x9f, 0x8a,
+	0x17, 0x4f, 0x9b, 0x50, 0xb2, 0xed, 0x53, 0xa1, 0xa7, 0x1c, 0x3c, 0x53, 0x83, 0x68, 0x5f, 0xab,
+	0xfc, 0x65, 0x4a, 0xfc, 0x86, 0xf9, 0xd7, 0xe0, 0x9a, 0xeb, 0x4d, 0xe4, 0x7b, 0x4d, 0xb7, 0xef,
+	0xbf, 0x45, 0xa6, 0xf9, 0xc1, 0x9d, 0xa4, 0x7f, 0x07, 0x3d, 0x8c, 0xae, 0x7d, 0x01, 0xb3, 0x0b,
+	0xbc, 0xe6, 0xc8, 0xe0, 0x46, 0xc8, 0xdf, 0x01, 0x98, 0x0d, 0xfa, 0x94, 0xe6, 0xbf, 0xa5, 0xa0,
+	0xdb, 0xa1, 0x4f, 0xe0, 0x2d, 0xbf, 0xb3, 0x31, 0x4f, 0x04, 0x57, 0xf5, 0x9b, 0xc4, 0xe2, 0x2b,
+	0xda, 0x91, 0x9d, 0xb1, 0xb3, 0xe1, 0x83, 0xfd, 0xe0, 0x3f, 0xa4, 0x28, 0x06, 0x75, 0x61, 0x6e,
+	0x84, 0x27, 0x93, 0x21, 0x36, 0x8f, 0xae, 0x5d, 0xf7, 0xd9, 0x28, 0x42, 0x9a, 0x6b, 0x3f, 0x01,
+	0x98, 0x5f, 0xe0, 0x75, 0xc3, 0x54, 0xec, 0xc9, 0x1e, 0x13, 0x60, 0x05, 0x84, 0xd2, 0xed, 0xc6,
+	0x15, 0xaa, 0x2d, 0x9c, 0x2e, 0x65, 0x8b, 0x61, 0xd4, 0x5e, 0x02, 0xf8, 0xbf, 0xc5, 0x76, 0xdf,
+	0xb3, 0x27, 0x82, 0xb8, 0xf3, 0x5a, 0x05, 0xf1, 0x5a, 0x7d, 0x00, 0x33, 0xff, 0xb2, 0x8d, 0xdf,
+	0x1e, 0x5d, 0x6a, 0xde, 0x1f, 0xc3, 0x5b, 0x8e, 0x4d, 0x0d, 0x3c, 0x0e, 0x1b, 0xc2, 0x35, 0x33,
+	0xb3, 0xee, 0xd8, 0x54, 0x1d, 0x13, 0x74, 0x1f, 0xe6, 0x6c, 0x6a, 0x4e, 0x3c, 0x8b, 0x18, 0x0b,
+	0x9d, 0x38, 0x2b, 0xed, 0x3b, 0xd2, 0x5c, 0xfb, 0x36, 0x19, 0x9f, 0x64, 0x3a, 0x31, 0x99, 0x6b,
+	0x21, 0xe7, 0xaa, 0x81, 0xe4, 0x97, 0xe1, 0x66, 0x67, 0xfb, 0xaf, 0xd3, 0x4a, 0xf3, 0x1a, 0xf3,
+	0x42, 0x35, 0x4d, 0x79, 0xab, 0x37, 0x4f, 0x2a, 0x1b, 0xde, 0xbd, 0x6a, 0xfa, 0x2d, 0x75, 0xd8,
+	0xd6, 0x7f, 0x43, 0x71, 0x89, 0xa1, 0xd8, 0x83, 0xff, 0x5f, 0x2c, 0x86, 0x81, 0x37, 0x1a, 0xd9,
+	0xb3, 0x3e, 0xb5, 0xc8, 0x0c, 0xdd, 0x83, 0x59, 0x37, 0x30, 0x1a, 0x3c, 0xb0, 0x46, 0xd5, 0xa0,
+	0x67, 0xdc, 0xd8, 0x5e, 0xc2, 0x1b, 0x87, 0x70, 0x33, 0x3e, 0x0c, 0xd0, 0xbb, 0xb0, 0xa8, 0x1e,
+	0xec, 0x3f, 0x32, 0x74, 0x6d, 0xb0, 0xf7, 0x68, 0x77, 0xa0, 0x19, 0x07, 0xbb, 0x83, 0x3d, 0xad,
+	0xdb, 0xef, 0xf5, 0xb5, 0x9d, 0x5c, 0x02, 0x15, 0x60, 0xfe, 0xb2, 0x5b, 0xed, 0x76, 0xb5, 0xbd,
+	0xfd, 0x1c, 0x40, 0x45, 0xb8, 0x75, 0xd9, 0xb3, 0xa3, 0x75, 0x3f, 0xeb, 0xef, 0x6a, 0xb9, 0x95,
+	0x52, 0xf2, 0x9b, 0x9f, 0xcb, 0x89, 0xce, 0xc3, 0x67, 0x67, 0x65, 0xf0, 0xfc, 0xac, 0x0c, 0xfe,
+	0x3c, 0x2b, 0x83, 0x93, 0xf3, 0x72, 0xe2, 0xf9, 0x79, 0x39, 0xf1, 0xf2, 0xbc, 0x9c, 0xf8, 0xf2,
+	0xfd, 0x58, 0xe2, 0xa6, 0x2e, 0x7b, 0x4c, 0x28, 0xa6, 0x26, 0x69, 0xda, 0x2c, 0xb6, 0x6a, 0xcd,
+	0x62, 0xff, 0x4d, 0x87, 0xeb, 0x81, 0x4c, 0x1f, 0xfc, 0x3d, 0x00, 0xce, 0xc4, 0xb4, 0x54, 0xca,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *QuarantinedFunds) Marshal() (dAtA []byte, err error) {
@@ -447,6 +861,26 @@ func (m *QuarantinedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QuarantinedAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuarantinedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuarantinedAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuarantine(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiresAt != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuarantine(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Declined {
 		i--
 		if m.Declined {
@@ -567,7 +1001,7 @@ func (m *AutoResponseUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QuarantineRecord) Marshal() (dAtA []byte, err error) {
+func (m *AutoAcceptRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -577,46 +1011,329 @@ func (m *QuarantineRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuarantineRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoAcceptRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuarantineRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoAcceptRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Declined {
-		i--
-		if m.Declined {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuarantine(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuarantine(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuarantine(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Attribute) > 0 {
+		i -= len(m.Attribute)
+		copy(dAtA[i:], m.Attribute)
+		i = encodeVarintQuarantine(dAtA, i, uint64(len(m.Attribute)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.AcceptedFromAddresses) > 0 {
-		for iNdEx := len(m.AcceptedFromAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AcceptedFromAddresses[iNdEx])
-			copy(dAtA[i:], m.AcceptedFromAddresses[iNdEx])
-			i = encodeVarintQuarantine(dAtA, i, uint64(len(m.AcceptedFromAddresses[iNdEx])))
-			i--
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoAcceptRulesEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoAcceptRulesEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoAcceptRulesEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuarantine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintQuarantine(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoAcceptRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoAcceptRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoAcceptRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuarantine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundsExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundsExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundsExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackAddress) > 0 {
+		i -= len(m.FallbackAddress)
+		copy(dAtA[i:], m.FallbackAddress)
+		i = encodeVarintQuarantine(dAtA, i, uint64(len(m.FallbackAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuarantine(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundsExpirationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundsExpirationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundsExpirationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuarantine(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintQuarantine(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantinedFundsFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantinedFundsFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantinedFundsFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeDeclined {
+		i--
+		if m.IncludeDeclined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinAge):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuarantine(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FromAddresses) > 0 {
+		for iNdEx := len(m.FromAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FromAddresses[iNdEx])
+			copy(dAtA[i:], m.FromAddresses[iNdEx])
+			i = encodeVarintQuarantine(dAtA, i, uint64(len(m.FromAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuarantine(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuarantineRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QuarantinedAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.QuarantinedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuarantinedAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuarantine(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiresAt != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuarantine(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Declined {
+		i--
+		if m.Declined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuarantine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AcceptedFromAddresses) > 0 {
+		for iNdEx := len(m.AcceptedFromAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedFromAddresses[iNdEx])
+			copy(dAtA[i:], m.AcceptedFromAddresses[iNdEx])
+			i = encodeVarintQuarantine(dAtA, i, uint64(len(m.AcceptedFromAddresses[iNdEx])))
+			i--
 			dAtA[i] = 0x12
 		}
 	}
@@ -700,6 +1417,14 @@ func (m *QuarantinedFunds) Size() (n int) {
 	if m.Declined {
 		n += 2
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if m.QuarantinedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuarantinedAt)
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
 	return n
 }
 
@@ -739,58 +1464,995 @@ func (m *AutoResponseUpdate) Size() (n int) {
 	return n
 }
 
-func (m *QuarantineRecord) Size() (n int) {
+func (m *AutoAcceptRule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.UnacceptedFromAddresses) > 0 {
-		for _, b := range m.UnacceptedFromAddresses {
-			l = len(b)
-			n += 1 + l + sovQuarantine(uint64(l))
-		}
+	l = len(m.Attribute)
+	if l > 0 {
+		n += 1 + l + sovQuarantine(uint64(l))
 	}
-	if len(m.AcceptedFromAddresses) > 0 {
-		for _, b := range m.AcceptedFromAddresses {
-			l = len(b)
-			n += 1 + l + sovQuarantine(uint64(l))
-		}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuarantine(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovQuarantine(uint64(l))
+	return n
+}
+
+func (m *AutoAcceptRulesEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
 			l = e.Size()
 			n += 1 + l + sovQuarantine(uint64(l))
 		}
 	}
-	if m.Declined {
-		n += 2
-	}
 	return n
 }
 
-func (m *QuarantineRecordSuffixIndex) Size() (n int) {
+func (m *AutoAcceptRules) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.RecordSuffixes) > 0 {
-		for _, b := range m.RecordSuffixes {
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuarantine(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundsExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovQuarantine(uint64(l))
+	l = len(m.FallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	return n
+}
+
+func (m *FundsExpirationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	l = m.Expiration.Size()
+	n += 1 + l + sovQuarantine(uint64(l))
+	return n
+}
+
+func (m *QuarantinedFundsFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if len(m.FromAddresses) > 0 {
+		for _, s := range m.FromAddresses {
+			l = len(s)
+			n += 1 + l + sovQuarantine(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinAge)
+	n += 1 + l + sovQuarantine(uint64(l))
+	if m.IncludeDeclined {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuarantineRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnacceptedFromAddresses) > 0 {
+		for _, b := range m.UnacceptedFromAddresses {
+			l = len(b)
+			n += 1 + l + sovQuarantine(uint64(l))
+		}
+	}
+	if len(m.AcceptedFromAddresses) > 0 {
+		for _, b := range m.AcceptedFromAddresses {
+			l = len(b)
+			n += 1 + l + sovQuarantine(uint64(l))
+		}
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuarantine(uint64(l))
+		}
+	}
+	if m.Declined {
+		n += 2
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	if m.QuarantinedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.QuarantinedAt)
+		n += 1 + l + sovQuarantine(uint64(l))
+	}
+	return n
+}
+
+func (m *QuarantineRecordSuffixIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecordSuffixes) > 0 {
+		for _, b := range m.RecordSuffixes {
 			l = len(b)
 			n += 1 + l + sovQuarantine(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovQuarantine(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuarantine(x uint64) (n int) {
+	return sovQuarantine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuarantinedFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantinedFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantinedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnacceptedFromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnacceptedFromAddresses = append(m.UnacceptedFromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Declined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Declined = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuarantinedAt == nil {
+				m.QuarantinedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuarantinedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoResponseEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoResponseEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			m.Response = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Response |= AutoResponse(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoResponseUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoResponseUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoResponseUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			m.Response = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Response |= AutoResponse(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoAcceptRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoAcceptRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoAcceptRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoAcceptRulesEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoAcceptRulesEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoAcceptRulesEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, AutoAcceptRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoAcceptRules) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuarantine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoAcceptRules: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoAcceptRules: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, AutoAcceptRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuarantine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuarantine(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuarantine(x uint64) (n int) {
-	return sovQuarantine(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuarantinedFunds) Unmarshal(dAtA []byte) error {
+func (m *FundsExpiration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -813,17 +2475,17 @@ func (m *QuarantinedFunds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuarantinedFunds: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundsExpiration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuarantinedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundsExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuarantine
@@ -833,27 +2495,28 @@ func (m *QuarantinedFunds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuarantine
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuarantine
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnacceptedFromAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -881,62 +2544,8 @@ func (m *QuarantinedFunds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnacceptedFromAddresses = append(m.UnacceptedFromAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuarantine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuarantine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuarantine
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FallbackAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Declined", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuarantine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Declined = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuarantine(dAtA[iNdEx:])
@@ -958,7 +2567,7 @@ func (m *QuarantinedFunds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AutoResponseEntry) Unmarshal(dAtA []byte) error {
+func (m *FundsExpirationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -981,10 +2590,10 @@ func (m *AutoResponseEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AutoResponseEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundsExpirationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoResponseEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundsExpirationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1021,9 +2630,9 @@ func (m *AutoResponseEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuarantine
@@ -1033,43 +2642,25 @@ func (m *AutoResponseEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuarantine
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuarantine
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
-			}
-			m.Response = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuarantine
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Response |= AutoResponse(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuarantine(dAtA[iNdEx:])
@@ -1091,7 +2682,7 @@ func (m *AutoResponseEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AutoResponseUpdate) Unmarshal(dAtA []byte) error {
+func (m *QuarantinedFundsFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,15 +2705,15 @@ func (m *AutoResponseUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AutoResponseUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuarantinedFundsFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AutoResponseUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuarantinedFundsFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1150,13 +2741,78 @@ func (m *AutoResponseUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddresses = append(m.FromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDeclined", wireType)
 			}
-			m.Response = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuarantine
@@ -1166,11 +2822,12 @@ func (m *AutoResponseUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Response |= AutoResponse(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IncludeDeclined = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuarantine(dAtA[iNdEx:])
@@ -1339,6 +2996,78 @@ func (m *QuarantineRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Declined = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuarantinedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuarantine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuarantine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuarantinedAt == nil {
+				m.QuarantinedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.QuarantinedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuarantine(dAtA[iNdEx:])
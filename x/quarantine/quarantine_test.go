@@ -3,6 +3,7 @@ package quarantine_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -1272,6 +1273,249 @@ func TestAutoResponseUpdate_Validate(t *testing.T) {
 	}
 }
 
+func TestAutoAcceptRule_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   quarantine.AutoAcceptRule
+		expErr []string
+	}{
+		{name: "attribute only", rule: quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt())},
+		{name: "denom only", rule: quarantine.NewAutoAcceptRule("", "nhash", sdkmath.ZeroInt())},
+		{name: "denom with max", rule: quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(5))},
+		{name: "all fields", rule: quarantine.NewAutoAcceptRule("kyc.pb", "nhash", sdkmath.NewInt(5))},
+		{name: "nil max", rule: quarantine.AutoAcceptRule{Denom: "nhash"}},
+		{
+			name:   "empty",
+			rule:   quarantine.NewAutoAcceptRule("", "", sdkmath.ZeroInt()),
+			expErr: []string{"an attribute or denom is required"},
+		},
+		{
+			name:   "bad denom",
+			rule:   quarantine.NewAutoAcceptRule("", "x", sdkmath.ZeroInt()),
+			expErr: []string{"invalid denom", "x"},
+		},
+		{
+			name:   "negative max",
+			rule:   quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(-1)),
+			expErr: []string{"max amount cannot be negative: -1"},
+		},
+		{
+			name:   "max without denom",
+			rule:   quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.NewInt(5)),
+			expErr: []string{"a denom is required with a max amount"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestAutoAcceptRule_AcceptsCoin(t *testing.T) {
+	tests := []struct {
+		name string
+		rule quarantine.AutoAcceptRule
+		coin sdk.Coin
+		exp  bool
+	}{
+		{name: "no denom", rule: quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt()), coin: sdk.NewInt64Coin("banana", 1), exp: true},
+		{name: "same denom no max", rule: quarantine.NewAutoAcceptRule("", "banana", sdkmath.ZeroInt()), coin: sdk.NewInt64Coin("banana", 99999), exp: true},
+		{name: "other denom", rule: quarantine.NewAutoAcceptRule("", "banana", sdkmath.ZeroInt()), coin: sdk.NewInt64Coin("apple", 1), exp: false},
+		{name: "under max", rule: quarantine.NewAutoAcceptRule("", "banana", sdkmath.NewInt(10)), coin: sdk.NewInt64Coin("banana", 9), exp: true},
+		{name: "at max", rule: quarantine.NewAutoAcceptRule("", "banana", sdkmath.NewInt(10)), coin: sdk.NewInt64Coin("banana", 10), exp: true},
+		{name: "over max", rule: quarantine.NewAutoAcceptRule("", "banana", sdkmath.NewInt(10)), coin: sdk.NewInt64Coin("banana", 11), exp: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.rule.AcceptsCoin(tc.coin)
+			assert.Equal(t, tc.exp, actual, "AcceptsCoin(%s)", tc.coin)
+		})
+	}
+}
+
+func TestAutoAcceptRulesEntry_Validate(t *testing.T) {
+	addr := testutil.MakeTestAddr("aare", 0)
+	rule := quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt())
+	tests := []struct {
+		name   string
+		entry  quarantine.AutoAcceptRulesEntry
+		expErr []string
+	}{
+		{name: "valid", entry: quarantine.NewAutoAcceptRulesEntry(addr, []quarantine.AutoAcceptRule{rule})},
+		{
+			name:   "bad address",
+			entry:  quarantine.AutoAcceptRulesEntry{ToAddress: "bad", Rules: []quarantine.AutoAcceptRule{rule}},
+			expErr: []string{"invalid to address"},
+		},
+		{
+			name:   "no rules",
+			entry:  quarantine.NewAutoAcceptRulesEntry(addr, nil),
+			expErr: []string{"no rules"},
+		},
+		{
+			name:   "invalid rule",
+			entry:  quarantine.NewAutoAcceptRulesEntry(addr, []quarantine.AutoAcceptRule{rule, {}}),
+			expErr: []string{"invalid rule 2", "an attribute or denom is required"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestFundsExpiration_Validate(t *testing.T) {
+	fallback := testutil.MakeTestAddr("fev", 0)
+	tests := []struct {
+		name   string
+		exp    quarantine.FundsExpiration
+		expErr []string
+	}{
+		{name: "no fallback", exp: quarantine.NewFundsExpiration(time.Hour, nil)},
+		{name: "with fallback", exp: quarantine.NewFundsExpiration(time.Minute, fallback)},
+		{
+			name:   "zero timeout",
+			exp:    quarantine.NewFundsExpiration(0, nil),
+			expErr: []string{"timeout must be positive, got 0s"},
+		},
+		{
+			name:   "negative timeout",
+			exp:    quarantine.NewFundsExpiration(-time.Second, nil),
+			expErr: []string{"timeout must be positive, got -1s"},
+		},
+		{
+			name:   "bad fallback",
+			exp:    quarantine.FundsExpiration{Timeout: time.Hour, FallbackAddress: "bad"},
+			expErr: []string{"invalid fallback address"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.exp.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate")
+		})
+	}
+}
+
+func TestNewRecordFilter(t *testing.T) {
+	addr0 := testutil.MakeTestAddr("nrf", 0)
+	addr1 := testutil.MakeTestAddr("nrf", 1)
+	addr2 := testutil.MakeTestAddr("nrf", 2)
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	twoHoursAgo := now.Add(-2 * time.Hour)
+	tenMinutesAgo := now.Add(-10 * time.Minute)
+
+	newRecord := func(declined bool, quarantinedAt *time.Time, coins sdk.Coins, fromAddrs ...sdk.AccAddress) *quarantine.QuarantineRecord {
+		return &quarantine.QuarantineRecord{
+			UnacceptedFromAddresses: fromAddrs,
+			Coins:                   coins,
+			Declined:                declined,
+			QuarantinedAt:           quarantinedAt,
+		}
+	}
+	recOld := newRecord(false, &twoHoursAgo, sdk.NewCoins(sdk.NewInt64Coin("banana", 5)), addr0)
+	recNew := newRecord(false, &tenMinutesAgo, sdk.NewCoins(sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("banana", 5)), addr1)
+	recNoTime := newRecord(false, nil, sdk.NewCoins(sdk.NewInt64Coin("cherry", 7)), addr0, addr1)
+	recDeclined := newRecord(true, &twoHoursAgo, sdk.NewCoins(sdk.NewInt64Coin("apple", 1)), addr2)
+	allRecords := []*quarantine.QuarantineRecord{recOld, recNew, recNoTime, recDeclined}
+
+	tests := []struct {
+		name     string
+		filter   *quarantine.QuarantinedFundsFilter
+		expErr   []string
+		expMatch []*quarantine.QuarantineRecord
+	}{
+		{
+			name:     "nil filter",
+			filter:   nil,
+			expMatch: []*quarantine.QuarantineRecord{recOld, recNew, recNoTime},
+		},
+		{
+			name:     "empty filter",
+			filter:   &quarantine.QuarantinedFundsFilter{},
+			expMatch: []*quarantine.QuarantineRecord{recOld, recNew, recNoTime},
+		},
+		{
+			name:     "include declined",
+			filter:   &quarantine.QuarantinedFundsFilter{IncludeDeclined: true},
+			expMatch: allRecords,
+		},
+		{
+			name:     "denom",
+			filter:   &quarantine.QuarantinedFundsFilter{Denom: "banana"},
+			expMatch: []*quarantine.QuarantineRecord{recOld, recNew},
+		},
+		{
+			name:     "denom including declined",
+			filter:   &quarantine.QuarantinedFundsFilter{Denom: "apple", IncludeDeclined: true},
+			expMatch: []*quarantine.QuarantineRecord{recNew, recDeclined},
+		},
+		{
+			name:     "one sender",
+			filter:   &quarantine.QuarantinedFundsFilter{FromAddresses: []string{addr0.String()}},
+			expMatch: []*quarantine.QuarantineRecord{recOld, recNoTime},
+		},
+		{
+			name:     "two senders",
+			filter:   &quarantine.QuarantinedFundsFilter{FromAddresses: []string{addr1.String(), addr2.String()}},
+			expMatch: []*quarantine.QuarantineRecord{recNew, recNoTime},
+		},
+		{
+			name:     "min age",
+			filter:   &quarantine.QuarantinedFundsFilter{MinAge: time.Hour},
+			expMatch: []*quarantine.QuarantineRecord{recOld, recNoTime},
+		},
+		{
+			name:     "min age equal to age",
+			filter:   &quarantine.QuarantinedFundsFilter{MinAge: 10 * time.Minute},
+			expMatch: []*quarantine.QuarantineRecord{recOld, recNew, recNoTime},
+		},
+		{
+			name:     "all criteria",
+			filter:   &quarantine.QuarantinedFundsFilter{Denom: "banana", FromAddresses: []string{addr0.String(), addr1.String()}, MinAge: time.Hour},
+			expMatch: []*quarantine.QuarantineRecord{recOld},
+		},
+		{
+			name:   "invalid filter",
+			filter: &quarantine.QuarantinedFundsFilter{FromAddresses: []string{"bad"}},
+			expErr: []string{"invalid from address[0]"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			filter, err := quarantine.NewRecordFilter(tc.filter, now)
+			assertions.AssertErrorContents(t, err, tc.expErr, "NewRecordFilter")
+			if len(tc.expErr) > 0 {
+				assert.Nil(t, filter, "NewRecordFilter result")
+				return
+			}
+
+			var actMatch []*quarantine.QuarantineRecord
+			for _, record := range allRecords {
+				if filter.Matches(record) {
+					actMatch = append(actMatch, record)
+				}
+			}
+			assert.Equal(t, tc.expMatch, actMatch, "matching records")
+
+			for _, record := range allRecords {
+				if record.Declined {
+					assert.False(t, filter.WithoutDeclined().Matches(record), "WithoutDeclined().Matches(declined record)")
+				}
+			}
+		})
+	}
+}
+
 func TestAutoBValues(t *testing.T) {
 	// If these were the same, it'd be bad.
 	assert.NotEqual(t, quarantine.NoAutoB, quarantine.AutoAcceptB, "NoAutoB vs AutoAcceptB")
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -128,6 +129,9 @@ type QueryQuarantinedFundsRequest struct {
 	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
 	// from_address is the sender of the coins. If provided, a to_address must also be provided.
 	FromAddress string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// filter, if provided, limits the results to the quarantined funds that match it.
+	// If provided, a to_address must also be provided.
+	Filter *QuarantinedFundsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	// pagination defines optional pagination parameters for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -179,6 +183,13 @@ func (m *QueryQuarantinedFundsRequest) GetFromAddress() string {
 	return ""
 }
 
+func (m *QueryQuarantinedFundsRequest) GetFilter() *QuarantinedFundsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 func (m *QueryQuarantinedFundsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
@@ -360,6 +371,208 @@ func (m *QueryAutoResponsesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAutoAcceptRulesRequest defines the RPC request for getting the auto-accept rules for an address.
+type QueryAutoAcceptRulesRequest struct {
+	// to_address is the quarantined account to get the rules of.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoAcceptRulesRequest) Reset()         { *m = QueryAutoAcceptRulesRequest{} }
+func (m *QueryAutoAcceptRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoAcceptRulesRequest) ProtoMessage()    {}
+func (*QueryAutoAcceptRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e6232ebe830d056, []int{6}
+}
+func (m *QueryAutoAcceptRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoAcceptRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoAcceptRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoAcceptRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoAcceptRulesRequest.Merge(m, src)
+}
+func (m *QueryAutoAcceptRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoAcceptRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoAcceptRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoAcceptRulesRequest proto.InternalMessageInfo
+
+func (m *QueryAutoAcceptRulesRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *QueryAutoAcceptRulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoAcceptRulesResponse defines the RPC response of an AutoAcceptRules query.
+type QueryAutoAcceptRulesResponse struct {
+	// rules are the auto-accept rules for the to_address.
+	Rules []AutoAcceptRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoAcceptRulesResponse) Reset()         { *m = QueryAutoAcceptRulesResponse{} }
+func (m *QueryAutoAcceptRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoAcceptRulesResponse) ProtoMessage()    {}
+func (*QueryAutoAcceptRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e6232ebe830d056, []int{7}
+}
+func (m *QueryAutoAcceptRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoAcceptRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoAcceptRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoAcceptRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoAcceptRulesResponse.Merge(m, src)
+}
+func (m *QueryAutoAcceptRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoAcceptRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoAcceptRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoAcceptRulesResponse proto.InternalMessageInfo
+
+func (m *QueryAutoAcceptRulesResponse) GetRules() []AutoAcceptRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *QueryAutoAcceptRulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFundsExpirationRequest defines the RPC request for getting the funds expiration settings for an address.
+type QueryFundsExpirationRequest struct {
+	// to_address is the quarantined account to get the funds expiration settings of.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+}
+
+func (m *QueryFundsExpirationRequest) Reset()         { *m = QueryFundsExpirationRequest{} }
+func (m *QueryFundsExpirationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFundsExpirationRequest) ProtoMessage()    {}
+func (*QueryFundsExpirationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e6232ebe830d056, []int{8}
+}
+func (m *QueryFundsExpirationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundsExpirationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundsExpirationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundsExpirationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundsExpirationRequest.Merge(m, src)
+}
+func (m *QueryFundsExpirationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundsExpirationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundsExpirationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundsExpirationRequest proto.InternalMessageInfo
+
+func (m *QueryFundsExpirationRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+// QueryFundsExpirationResponse defines the RPC response of a FundsExpiration query.
+type QueryFundsExpirationResponse struct {
+	// expiration is the funds expiration settings for the to_address. It is empty if funds do not expire.
+	Expiration *FundsExpiration `protobuf:"bytes,1,opt,name=expiration,proto3" json:"expiration,omitempty"`
+}
+
+func (m *QueryFundsExpirationResponse) Reset()         { *m = QueryFundsExpirationResponse{} }
+func (m *QueryFundsExpirationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFundsExpirationResponse) ProtoMessage()    {}
+func (*QueryFundsExpirationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e6232ebe830d056, []int{9}
+}
+func (m *QueryFundsExpirationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFundsExpirationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFundsExpirationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFundsExpirationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFundsExpirationResponse.Merge(m, src)
+}
+func (m *QueryFundsExpirationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFundsExpirationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFundsExpirationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFundsExpirationResponse proto.InternalMessageInfo
+
+func (m *QueryFundsExpirationResponse) GetExpiration() *FundsExpiration {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryIsQuarantinedRequest)(nil), "cosmos.quarantine.v1beta1.QueryIsQuarantinedRequest")
 	proto.RegisterType((*QueryIsQuarantinedResponse)(nil), "cosmos.quarantine.v1beta1.QueryIsQuarantinedResponse")
@@ -367,6 +580,10 @@ func init() {
 	proto.RegisterType((*QueryQuarantinedFundsResponse)(nil), "cosmos.quarantine.v1beta1.QueryQuarantinedFundsResponse")
 	proto.RegisterType((*QueryAutoResponsesRequest)(nil), "cosmos.quarantine.v1beta1.QueryAutoResponsesRequest")
 	proto.RegisterType((*QueryAutoResponsesResponse)(nil), "cosmos.quarantine.v1beta1.QueryAutoResponsesResponse")
+	proto.RegisterType((*QueryAutoAcceptRulesRequest)(nil), "cosmos.quarantine.v1beta1.QueryAutoAcceptRulesRequest")
+	proto.RegisterType((*QueryAutoAcceptRulesResponse)(nil), "cosmos.quarantine.v1beta1.QueryAutoAcceptRulesResponse")
+	proto.RegisterType((*QueryFundsExpirationRequest)(nil), "cosmos.quarantine.v1beta1.QueryFundsExpirationRequest")
+	proto.RegisterType((*QueryFundsExpirationResponse)(nil), "cosmos.quarantine.v1beta1.QueryFundsExpirationResponse")
 }
 
 func init() {
@@ -374,45 +591,57 @@ func init() {
 }
 
 var fileDescriptor_6e6232ebe830d056 = []byte{
-	// 607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x1c, 0xcd, 0x44, 0x2a, 0x76, 0x62, 0x4a, 0x19, 0x3c, 0xa4, 0xa1, 0x2e, 0x61, 0xa1, 0x1a, 0xb4,
-	0x99, 0x69, 0xe3, 0x9f, 0x0a, 0x55, 0xa1, 0x15, 0x2b, 0x7a, 0xb2, 0xa9, 0x20, 0xe4, 0x12, 0x26,
-	0xc9, 0x74, 0x1d, 0x30, 0x33, 0xc9, 0xce, 0x6c, 0xb0, 0x94, 0x5e, 0xfc, 0x04, 0x42, 0x6f, 0x1e,
-	0xfd, 0x0c, 0x3d, 0x79, 0xf3, 0xe6, 0xb1, 0xe8, 0x41, 0x05, 0x0f, 0x92, 0xf8, 0x05, 0xfc, 0x06,
-	0x92, 0xdd, 0x49, 0xb2, 0x49, 0x93, 0x5d, 0x82, 0x9e, 0x7a, 0xdc, 0xfd, 0xfd, 0xde, 0xfb, 0xfd,
-	0xde, 0xbe, 0x37, 0xb3, 0x70, 0xa5, 0x26, 0x55, 0x43, 0x2a, 0xd2, 0xf2, 0xa8, 0x4b, 0x85, 0xe6,
-	0x82, 0x91, 0xf6, 0x7a, 0x95, 0x69, 0xba, 0x4e, 0x5a, 0x1e, 0x73, 0x0f, 0x70, 0xd3, 0x95, 0x5a,
-	0xa2, 0xa5, 0xa0, 0x0d, 0x0f, 0xdb, 0xb0, 0x69, 0xcb, 0xde, 0x30, 0x0c, 0x55, 0xaa, 0x58, 0x80,
-	0x19, 0x30, 0x34, 0xa9, 0xc3, 0x05, 0xd5, 0x5c, 0x8a, 0x80, 0x66, 0xd0, 0x3b, 0x71, 0xda, 0x80,
-	0x39, 0xe8, 0x35, 0x23, 0x2b, 0xfe, 0x13, 0x31, 0xf3, 0x83, 0xd2, 0xb2, 0x23, 0xa5, 0xf3, 0x9a,
-	0x11, 0xda, 0xe4, 0x84, 0x0a, 0x21, 0xb5, 0x3f, 0xc3, 0x54, 0xed, 0x17, 0x70, 0x69, 0xb7, 0xb7,
-	0xc6, 0x53, 0xb5, 0x3b, 0xe0, 0xac, 0x97, 0x58, 0xcb, 0x63, 0x4a, 0xa3, 0x0d, 0x08, 0xb5, 0xac,
-	0xd0, 0x7a, 0xdd, 0x65, 0x4a, 0x65, 0x40, 0x0e, 0xe4, 0xe7, 0xb7, 0x33, 0x5f, 0x4e, 0x0a, 0x57,
-	0xcc, 0x80, 0xad, 0xa0, 0xb2, 0xa7, 0x5d, 0x2e, 0x9c, 0xd2, 0xbc, 0x96, 0xe6, 0x85, 0xfd, 0x08,
-	0x66, 0x27, 0xb1, 0xaa, 0xa6, 0x14, 0x8a, 0xa1, 0x15, 0xb8, 0xc0, 0x55, 0x65, 0xa8, 0xa1, 0xee,
-	0x53, 0x5f, 0x2a, 0xa5, 0x79, 0xb8, 0xdd, 0xfe, 0x09, 0xe0, 0xb2, 0xcf, 0x12, 0x7a, 0xb9, 0xe3,
-	0x89, 0xba, 0xfa, 0xd7, 0xf5, 0xd0, 0x26, 0xbc, 0xbc, 0xef, 0xca, 0xc6, 0x00, 0x9a, 0x8c, 0x81,
-	0xa6, 0x7a, 0xdd, 0x7d, 0xf0, 0x0e, 0x84, 0x43, 0xab, 0x32, 0xb5, 0x1c, 0xc8, 0xa7, 0x8a, 0xd7,
-	0xb0, 0xc1, 0xf5, 0x7c, 0xc5, 0x41, 0x16, 0x8c, 0x57, 0xf8, 0x39, 0x75, 0x98, 0xd9, 0xb8, 0x14,
-	0x42, 0xda, 0x9f, 0x00, 0xbc, 0x3a, 0x45, 0x9e, 0xf9, 0x4e, 0x2f, 0xe1, 0x62, 0x6b, 0xac, 0x96,
-	0x01, 0xb9, 0x0b, 0xf9, 0x54, 0xf1, 0x26, 0x9e, 0x1a, 0x31, 0x7c, 0x86, 0xee, 0x0c, 0x09, 0x7a,
-	0x32, 0x41, 0xc2, 0xf5, 0x58, 0x09, 0xc1, 0x56, 0x23, 0x1a, 0x7e, 0x00, 0x13, 0x9f, 0x2d, 0x4f,
-	0xcb, 0x7e, 0xc7, 0x39, 0xf1, 0xe7, 0x23, 0x30, 0x21, 0x1e, 0xd3, 0x66, 0xcc, 0xd9, 0x83, 0x0b,
-	0xd4, 0xd3, 0xb2, 0xe2, 0xf6, 0x2b, 0xc6, 0x9a, 0xd5, 0x08, 0x6b, 0xc2, 0x4c, 0x8f, 0x85, 0x76,
-	0x0f, 0x4a, 0x69, 0x1a, 0x26, 0xff, 0x6f, 0xc6, 0x14, 0xbf, 0xcd, 0xc1, 0x39, 0x7f, 0x79, 0x74,
-	0x02, 0x60, 0x7a, 0xe4, 0x18, 0xa2, 0xdb, 0x91, 0xe1, 0x99, 0x72, 0x17, 0x64, 0xef, 0xcc, 0x88,
-	0x0a, 0x96, 0xb2, 0xef, 0xbe, 0xfd, 0xfa, 0xfb, 0x38, 0xb9, 0x86, 0x30, 0x99, 0x7e, 0x9b, 0xd1,
-	0x9a, 0xe6, 0x6d, 0x46, 0x0e, 0x87, 0x61, 0x39, 0x42, 0x1f, 0x92, 0x70, 0x71, 0x3c, 0xc9, 0x68,
-	0x23, 0x6e, 0x87, 0x29, 0x37, 0x45, 0xf6, 0xde, 0xec, 0x40, 0xb3, 0xff, 0x7b, 0xe0, 0x0b, 0x38,
-	0x06, 0x65, 0x82, 0x0a, 0x11, 0x1a, 0xf6, 0x7b, 0xa8, 0x11, 0x09, 0xe5, 0x87, 0xe8, 0xfe, 0x4c,
-	0x00, 0x72, 0x18, 0x0e, 0xfd, 0x11, 0xca, 0xc5, 0xa1, 0xd1, 0x1f, 0x00, 0xd3, 0x23, 0xe9, 0x8c,
-	0xf7, 0x76, 0xd2, 0x41, 0x8d, 0xf7, 0x76, 0xe2, 0x11, 0xb0, 0x95, 0xff, 0x69, 0x1a, 0xe5, 0x07,
-	0x68, 0x33, 0xca, 0x5d, 0x4f, 0xcb, 0x48, 0x9d, 0xab, 0xb3, 0x80, 0xb7, 0x9f, 0x7d, 0xee, 0x58,
-	0xe0, 0xb4, 0x63, 0x81, 0x5f, 0x1d, 0x0b, 0xbc, 0xeb, 0x5a, 0x89, 0xd3, 0xae, 0x95, 0xf8, 0xde,
-	0xb5, 0x12, 0xe5, 0x35, 0x87, 0xeb, 0x57, 0x5e, 0x15, 0xd7, 0x64, 0x83, 0x34, 0x5d, 0xd9, 0x66,
-	0x82, 0x8a, 0x1a, 0x2b, 0x70, 0x19, 0x7a, 0x22, 0x6f, 0x42, 0x53, 0xaa, 0x17, 0xfd, 0x7f, 0xe0,
-	0xad, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x61, 0x91, 0x5b, 0xe3, 0xd8, 0x07, 0x00, 0x00,
+	// 800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0x94, 0x0f, 0x02, 0xd3, 0xaf, 0x40, 0x26, 0x1c, 0xca, 0x7e, 0xfd, 0xfa, 0x35, 0x4d,
+	0xf8, 0x44, 0x84, 0x5d, 0xa8, 0x40, 0x8d, 0xa8, 0x09, 0x18, 0x20, 0x70, 0x92, 0xc5, 0x68, 0xc2,
+	0xa5, 0x99, 0xb6, 0xc3, 0xba, 0xa6, 0xdd, 0x69, 0x77, 0x67, 0x09, 0x84, 0x70, 0xf1, 0x17, 0x98,
+	0x70, 0xf3, 0xe0, 0xc1, 0x8b, 0x37, 0x4f, 0x5c, 0xf4, 0x60, 0xe2, 0x8d, 0x23, 0x51, 0x0f, 0x7a,
+	0x31, 0x06, 0xfc, 0x03, 0xfe, 0x03, 0xd3, 0xd9, 0xe9, 0x76, 0xb7, 0x6c, 0x5b, 0x17, 0x48, 0xbc,
+	0xd1, 0xd9, 0xf7, 0x79, 0xde, 0xe7, 0x79, 0xde, 0xd9, 0x77, 0x81, 0x63, 0x45, 0x6a, 0x55, 0xa8,
+	0xa5, 0xd4, 0x6c, 0x6c, 0x62, 0x83, 0xe9, 0x06, 0x51, 0x76, 0x66, 0x0a, 0x84, 0xe1, 0x19, 0xa5,
+	0x66, 0x13, 0x73, 0x4f, 0xae, 0x9a, 0x94, 0x51, 0x34, 0xea, 0x94, 0xc9, 0xcd, 0x32, 0x59, 0x94,
+	0x49, 0x13, 0x82, 0xa1, 0x80, 0x2d, 0xe2, 0x60, 0x5c, 0x86, 0x2a, 0xd6, 0x74, 0x03, 0x33, 0x9d,
+	0x1a, 0x0e, 0x8d, 0x5b, 0x1b, 0xd8, 0xcd, 0x65, 0x76, 0x6a, 0x45, 0xcb, 0x3c, 0xff, 0xa5, 0x88,
+	0xfe, 0xce, 0xa3, 0x11, 0x8d, 0x6a, 0xd4, 0x39, 0xaf, 0xff, 0x25, 0x4e, 0x93, 0x1a, 0xa5, 0x5a,
+	0x99, 0x28, 0xb8, 0xaa, 0x2b, 0xd8, 0x30, 0x28, 0xe3, 0x9d, 0x05, 0x26, 0xf3, 0x10, 0x8e, 0x6e,
+	0xd4, 0xc5, 0xad, 0x59, 0x1b, 0x6e, 0xa7, 0x92, 0x4a, 0x6a, 0x36, 0xb1, 0x18, 0xca, 0x41, 0xc8,
+	0x68, 0x1e, 0x97, 0x4a, 0x26, 0xb1, 0xac, 0x04, 0x48, 0x83, 0xf1, 0x81, 0xa5, 0xc4, 0xc7, 0xa3,
+	0xa9, 0x11, 0xd1, 0x76, 0xd1, 0x79, 0xb2, 0xc9, 0x4c, 0xdd, 0xd0, 0xd4, 0x01, 0x46, 0xc5, 0x41,
+	0xe6, 0x3e, 0x94, 0x82, 0x58, 0xad, 0x2a, 0x35, 0x2c, 0x82, 0xc6, 0xe0, 0xa0, 0x6e, 0xe5, 0x9b,
+	0xce, 0x4a, 0x9c, 0xba, 0x5f, 0x8d, 0xeb, 0xde, 0xf2, 0xcc, 0xeb, 0x28, 0x4c, 0x72, 0x16, 0xcf,
+	0xe1, 0x8a, 0x6d, 0x94, 0xac, 0xcb, 0xca, 0x43, 0x0b, 0xf0, 0xef, 0x6d, 0x93, 0x56, 0x5c, 0x68,
+	0xb4, 0x0b, 0x34, 0x56, 0xaf, 0x6e, 0x80, 0xd7, 0x60, 0xdf, 0xb6, 0x5e, 0x66, 0xc4, 0x4c, 0xf4,
+	0xa4, 0xc1, 0x78, 0x2c, 0x3b, 0x23, 0xb7, 0xbd, 0x04, 0x72, 0xab, 0xf2, 0x15, 0x0e, 0x54, 0x05,
+	0x01, 0x5a, 0x81, 0xb0, 0x79, 0x17, 0x12, 0x45, 0x4e, 0xf7, 0x7f, 0x83, 0xae, 0x7e, 0x71, 0x64,
+	0xe7, 0xb2, 0x35, 0xe8, 0x1e, 0x60, 0x8d, 0x08, 0xf3, 0xaa, 0x07, 0x99, 0xf9, 0x00, 0xe0, 0xbf,
+	0x6d, 0x92, 0x12, 0x91, 0x3f, 0x86, 0xc3, 0xb5, 0x96, 0x67, 0x09, 0x90, 0xee, 0x19, 0x8f, 0x65,
+	0x6f, 0x84, 0x90, 0xaf, 0x9e, 0x23, 0x41, 0xab, 0x01, 0x16, 0xae, 0x75, 0xb5, 0xe0, 0xa8, 0xf2,
+	0x79, 0xf8, 0x0a, 0xc4, 0x4d, 0x5c, 0xb4, 0x19, 0x6d, 0x54, 0xfc, 0xe1, 0x51, 0x5f, 0xd5, 0x7c,
+	0xde, 0x01, 0x28, 0x05, 0x79, 0x13, 0xc3, 0xd9, 0x84, 0x83, 0xd8, 0x66, 0x34, 0x6f, 0x36, 0x9e,
+	0x88, 0xd1, 0x4c, 0x76, 0x18, 0x8d, 0x97, 0x69, 0xd9, 0x60, 0xe6, 0x9e, 0x1a, 0xc7, 0x5e, 0xf2,
+	0xab, 0x1b, 0xcc, 0x4b, 0x00, 0xff, 0x71, 0xc5, 0x2f, 0x16, 0x8b, 0xa4, 0xca, 0x54, 0xbb, 0x7c,
+	0x05, 0xa3, 0xf1, 0xa7, 0x1b, 0xbd, 0x70, 0xba, 0x6f, 0x00, 0x4c, 0x06, 0x0b, 0x14, 0xf9, 0x2e,
+	0xc3, 0x5e, 0xd3, 0x2e, 0xbb, 0xb1, 0x5e, 0xef, 0x12, 0x6b, 0x93, 0x62, 0xe9, 0xaf, 0xe3, 0x6f,
+	0xff, 0x45, 0x54, 0x07, 0x8d, 0x56, 0x03, 0xf4, 0x5e, 0x28, 0xd1, 0x47, 0x22, 0x50, 0xfe, 0x06,
+	0x2d, 0xef, 0x56, 0x75, 0x93, 0x9f, 0x5f, 0x7a, 0xeb, 0x3e, 0x85, 0xc9, 0x60, 0x5e, 0x91, 0xc3,
+	0x3a, 0x84, 0xc4, 0x3d, 0xe5, 0xc4, 0xb1, 0xec, 0x44, 0x87, 0x30, 0x5a, 0x79, 0x3c, 0xe8, 0xec,
+	0xe7, 0x7e, 0xd8, 0xcb, 0x9b, 0xa1, 0x23, 0x00, 0xe3, 0xbe, 0x3d, 0x8f, 0x66, 0x3b, 0xae, 0x94,
+	0x36, 0x1f, 0x1b, 0x69, 0x2e, 0x24, 0xca, 0x31, 0x95, 0x99, 0x7f, 0xf6, 0xe9, 0xc7, 0x61, 0x74,
+	0x1a, 0xc9, 0x4a, 0xfb, 0x8f, 0x28, 0x2e, 0x32, 0x7d, 0x87, 0x28, 0xfb, 0xcd, 0x58, 0x0f, 0xd0,
+	0xab, 0x28, 0x1c, 0x6e, 0xdd, 0x6f, 0x28, 0xd7, 0x4d, 0x43, 0x9b, 0x4f, 0x91, 0x74, 0x2b, 0x3c,
+	0x50, 0xe8, 0x7f, 0x01, 0xb8, 0x81, 0x43, 0x80, 0xd2, 0x1d, 0x1c, 0x6c, 0xd7, 0x31, 0x5b, 0x0a,
+	0x9a, 0xea, 0x56, 0xe3, 0x33, 0xb9, 0x75, 0x0f, 0xdd, 0x09, 0x05, 0x50, 0xf6, 0xbd, 0xcb, 0xf2,
+	0x00, 0xfd, 0x04, 0x30, 0xee, 0xdb, 0x59, 0xdd, 0x67, 0x1b, 0xb4, 0xbe, 0xa5, 0xb9, 0x90, 0x28,
+	0x91, 0x8d, 0xc5, 0xa3, 0xa9, 0xa0, 0xc9, 0x4e, 0xb3, 0xb5, 0x19, 0xf5, 0x9b, 0xbe, 0x8b, 0x16,
+	0xc2, 0xd4, 0xb7, 0x7a, 0x7e, 0x0b, 0xe0, 0x50, 0xcb, 0x26, 0x41, 0xf3, 0xbf, 0xa3, 0xff, 0xfc,
+	0x6e, 0x94, 0x72, 0xa1, 0x71, 0xc2, 0xf9, 0x1c, 0x77, 0xde, 0x79, 0xde, 0x7c, 0x2b, 0xf9, 0x2f,
+	0xf5, 0x7b, 0x00, 0x87, 0x5a, 0xde, 0xda, 0xee, 0xda, 0x83, 0xd7, 0x90, 0x94, 0x0b, 0x8d, 0x13,
+	0xda, 0x6f, 0x73, 0xed, 0xb3, 0x28, 0xdb, 0x41, 0x7b, 0x73, 0x93, 0xf8, 0x0c, 0x2c, 0xad, 0x1f,
+	0x9f, 0xa6, 0xc0, 0xc9, 0x69, 0x0a, 0x7c, 0x3f, 0x4d, 0x81, 0xe7, 0x67, 0xa9, 0xc8, 0xc9, 0x59,
+	0x2a, 0xf2, 0xe5, 0x2c, 0x15, 0xd9, 0x9a, 0xd6, 0x74, 0xf6, 0xc4, 0x2e, 0xc8, 0x45, 0x5a, 0x51,
+	0xaa, 0x26, 0xdd, 0x21, 0x06, 0x36, 0x8a, 0x64, 0x4a, 0xa7, 0x9e, 0x5f, 0xca, 0xae, 0xa7, 0x57,
+	0xa1, 0x8f, 0xff, 0x87, 0x7b, 0xf3, 0xd7, 0x00, 0x0b, 0x08, 0x91, 0x9e, 0xcc, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -435,12 +664,20 @@ type QueryClient interface {
 	// whether they've been declined. If only a to_address is provided, the unaccepted and undeclined funds waiting on a
 	// response from to_address will be returned. If neither a to_address nor from_address is provided, all non-declined
 	// quarantined funds for any address will be returned. The request is invalid if only a from_address is provided.
+	//
+	// A filter can be provided along with a to_address to limit the results, e.g. to funds of a specific denom, from
+	// specific senders, or that have been quarantined for a while. The filter's include_declined field is ignored when
+	// a from_address is also provided.
 	QuarantinedFunds(ctx context.Context, in *QueryQuarantinedFundsRequest, opts ...grpc.CallOption) (*QueryQuarantinedFundsResponse, error)
 	// AutoResponses gets the auto-response settings for a quarantined account.
 	//
 	// The to_address is required. If a from_address is provided only the auto response for that from_address will be
 	// returned. If no from_address is provided, all auto-response settings for the given to_address will be returned.
 	AutoResponses(ctx context.Context, in *QueryAutoResponsesRequest, opts ...grpc.CallOption) (*QueryAutoResponsesResponse, error)
+	// AutoAcceptRules gets the auto-accept rules for a quarantined account.
+	AutoAcceptRules(ctx context.Context, in *QueryAutoAcceptRulesRequest, opts ...grpc.CallOption) (*QueryAutoAcceptRulesResponse, error)
+	// FundsExpiration gets the funds expiration settings for a quarantined account.
+	FundsExpiration(ctx context.Context, in *QueryFundsExpirationRequest, opts ...grpc.CallOption) (*QueryFundsExpirationResponse, error)
 }
 
 type queryClient struct {
@@ -478,6 +715,24 @@ func (c *queryClient) AutoResponses(ctx context.Context, in *QueryAutoResponsesR
 	return out, nil
 }
 
+func (c *queryClient) AutoAcceptRules(ctx context.Context, in *QueryAutoAcceptRulesRequest, opts ...grpc.CallOption) (*QueryAutoAcceptRulesResponse, error) {
+	out := new(QueryAutoAcceptRulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Query/AutoAcceptRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundsExpiration(ctx context.Context, in *QueryFundsExpirationRequest, opts ...grpc.CallOption) (*QueryFundsExpirationResponse, error) {
+	out := new(QueryFundsExpirationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Query/FundsExpiration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// IsQuarantined checks if an account has opted into quarantine.
@@ -488,12 +743,20 @@ type QueryServer interface {
 	// whether they've been declined. If only a to_address is provided, the unaccepted and undeclined funds waiting on a
 	// response from to_address will be returned. If neither a to_address nor from_address is provided, all non-declined
 	// quarantined funds for any address will be returned. The request is invalid if only a from_address is provided.
+	//
+	// A filter can be provided along with a to_address to limit the results, e.g. to funds of a specific denom, from
+	// specific senders, or that have been quarantined for a while. The filter's include_declined field is ignored when
+	// a from_address is also provided.
 	QuarantinedFunds(context.Context, *QueryQuarantinedFundsRequest) (*QueryQuarantinedFundsResponse, error)
 	// AutoResponses gets the auto-response settings for a quarantined account.
 	//
 	// The to_address is required. If a from_address is provided only the auto response for that from_address will be
 	// returned. If no from_address is provided, all auto-response settings for the given to_address will be returned.
 	AutoResponses(context.Context, *QueryAutoResponsesRequest) (*QueryAutoResponsesResponse, error)
+	// AutoAcceptRules gets the auto-accept rules for a quarantined account.
+	AutoAcceptRules(context.Context, *QueryAutoAcceptRulesRequest) (*QueryAutoAcceptRulesResponse, error)
+	// FundsExpiration gets the funds expiration settings for a quarantined account.
+	FundsExpiration(context.Context, *QueryFundsExpirationRequest) (*QueryFundsExpirationResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +772,12 @@ func (*UnimplementedQueryServer) QuarantinedFunds(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) AutoResponses(ctx context.Context, req *QueryAutoResponsesRequest) (*QueryAutoResponsesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoResponses not implemented")
 }
+func (*UnimplementedQueryServer) AutoAcceptRules(ctx context.Context, req *QueryAutoAcceptRulesRequest) (*QueryAutoAcceptRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoAcceptRules not implemented")
+}
+func (*UnimplementedQueryServer) FundsExpiration(ctx context.Context, req *QueryFundsExpirationRequest) (*QueryFundsExpirationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundsExpiration not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -568,6 +837,42 @@ func _Query_AutoResponses_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoAcceptRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoAcceptRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoAcceptRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.quarantine.v1beta1.Query/AutoAcceptRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoAcceptRules(ctx, req.(*QueryAutoAcceptRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundsExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundsExpirationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundsExpiration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.quarantine.v1beta1.Query/FundsExpiration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundsExpiration(ctx, req.(*QueryFundsExpirationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.quarantine.v1beta1.Query",
@@ -585,6 +890,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoResponses",
 			Handler:    _Query_AutoResponses_Handler,
 		},
+		{
+			MethodName: "AutoAcceptRules",
+			Handler:    _Query_AutoAcceptRules_Handler,
+		},
+		{
+			MethodName: "FundsExpiration",
+			Handler:    _Query_FundsExpiration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/quarantine/v1beta1/query.proto",
@@ -687,6 +1000,18 @@ func (m *QueryQuarantinedFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.FromAddress) > 0 {
 		i -= len(m.FromAddress)
 		copy(dAtA[i:], m.FromAddress)
@@ -857,56 +1182,216 @@ func (m *QueryAutoResponsesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAutoAcceptRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIsQuarantinedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAutoAcceptRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIsQuarantinedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAutoAcceptRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IsQuarantined {
-		n += 2
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryQuarantinedFundsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoAcceptRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoAcceptRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoAcceptRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ToAddress)
-	if l > 0 {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundsExpirationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundsExpirationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundsExpirationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFundsExpirationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFundsExpirationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFundsExpirationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIsQuarantinedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsQuarantinedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsQuarantined {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryQuarantinedFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	l = len(m.FromAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 2 + l + sovQuery(uint64(l))
@@ -973,6 +1458,68 @@ func (m *QueryAutoResponsesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoAcceptRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoAcceptRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundsExpirationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFundsExpirationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1224,6 +1771,42 @@ func (m *QueryQuarantinedFundsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &QuarantinedFundsFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -1671,6 +2254,412 @@ func (m *QueryAutoResponsesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoAcceptRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoAcceptRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoAcceptRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoAcceptRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoAcceptRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoAcceptRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, AutoAcceptRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundsExpirationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundsExpirationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundsExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFundsExpirationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFundsExpirationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFundsExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &FundsExpiration{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &riB)
 			return fmt.Sprintf("%v\n%v", riA, riB)
 
+		case bytes.HasPrefix(kvA.Key, quarantine.AutoAcceptRulesPrefix):
+			var rulesA, rulesB quarantine.AutoAcceptRules
+			cdc.MustUnmarshal(kvA.Value, &rulesA)
+			cdc.MustUnmarshal(kvB.Value, &rulesB)
+			return fmt.Sprintf("%v\n%v", rulesA, rulesB)
+
+		case bytes.HasPrefix(kvA.Key, quarantine.FundsExpirationPrefix):
+			var expA, expB quarantine.FundsExpiration
+			cdc.MustUnmarshal(kvA.Value, &expA)
+			cdc.MustUnmarshal(kvB.Value, &expB)
+			return fmt.Sprintf("%v\n%v", expA, expB)
+
+		case bytes.HasPrefix(kvA.Key, quarantine.ExpirationIndexPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid quarantine key %X", kvA.Key))
 		}
@@ -2,10 +2,13 @@ package simulation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/gogoproto/proto"
@@ -51,6 +54,17 @@ func TestDecodeStore(t *testing.T) {
 	recordIndexABz := marshal(recordIndexA, "recordIndexA")
 	recordIndexBBz := marshal(recordIndexB, "recordIndexB")
 
+	rulesA := &quarantine.AutoAcceptRules{Rules: []quarantine.AutoAcceptRule{quarantine.NewAutoAcceptRule("kyc.pb", "", sdkmath.ZeroInt())}}
+	rulesB := &quarantine.AutoAcceptRules{Rules: []quarantine.AutoAcceptRule{quarantine.NewAutoAcceptRule("", "nhash", sdkmath.NewInt(1000))}}
+	rulesABz := marshal(rulesA, "rulesA")
+	rulesBBz := marshal(rulesB, "rulesB")
+
+	expA := &quarantine.FundsExpiration{Timeout: time.Hour}
+	expB := &quarantine.FundsExpiration{Timeout: time.Minute, FallbackAddress: addr3.String()}
+	expABz := marshal(expA, "expA")
+	expBBz := marshal(expB, "expB")
+	now := time.Unix(1700000000, 0)
+
 	tests := []struct {
 		name     string
 		kvA      kv.Pair
@@ -74,7 +88,7 @@ func TestDecodeStore(t *testing.T) {
 			name: "Record",
 			kvA:  kv.Pair{Key: quarantine.CreateRecordKey(addr0, addr1), Value: recordABz},
 			kvB:  kv.Pair{Key: quarantine.CreateRecordKey(addr2, addr3), Value: recordBBz},
-			exp:  "{[61646472315F5F5F5F5F5F5F5F5F5F5F5F5F5F5F] [] 5bananas false}\n{[61646472335F5F5F5F5F5F5F5F5F5F5F5F5F5F5F] [] 8sunflowers true}",
+			exp:  "{[61646472315F5F5F5F5F5F5F5F5F5F5F5F5F5F5F] [] 5bananas false <nil>}\n{[61646472335F5F5F5F5F5F5F5F5F5F5F5F5F5F5F] [] 8sunflowers true <nil>}",
 		},
 		{
 			name: "RecordIndex",
@@ -82,6 +96,24 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: quarantine.CreateRecordIndexKey(addr1, addr2), Value: recordIndexBBz},
 			exp:  "{[[48 49 50 51] [54 55 56 57]]}\n{[[97 98 99 100] [119 120 121 122]]}",
 		},
+		{
+			name: "AutoAcceptRules",
+			kvA:  kv.Pair{Key: quarantine.CreateAutoAcceptRulesKey(addr0), Value: rulesABz},
+			kvB:  kv.Pair{Key: quarantine.CreateAutoAcceptRulesKey(addr1), Value: rulesBBz},
+			exp:  "{[{kyc.pb  0}]}\n{[{ nhash 1000}]}",
+		},
+		{
+			name: "FundsExpiration",
+			kvA:  kv.Pair{Key: quarantine.CreateFundsExpirationKey(addr0), Value: expABz},
+			kvB:  kv.Pair{Key: quarantine.CreateFundsExpirationKey(addr1), Value: expBBz},
+			exp:  "{1h0m0s }\n{1m0s " + addr3.String() + "}",
+		},
+		{
+			name: "ExpirationIndex",
+			kvA:  kv.Pair{Key: quarantine.CreateExpirationIndexKey(now, addr0, addr1), Value: []byte{0x00}},
+			kvB:  kv.Pair{Key: quarantine.CreateExpirationIndexKey(now, addr2, addr3), Value: []byte{0x01}},
+			exp:  "[0]\n[1]",
+		},
 		{
 			name:     "unknown",
 			kvA:      kv.Pair{Key: []byte{0x9a}, Value: []byte{0x9b}},
@@ -117,6 +117,8 @@ func MakeCopyOfQuarantinedFunds(orig *quarantine.QuarantinedFunds) *quarantine.Q
 		UnacceptedFromAddresses: MakeCopyOfStringSlice(orig.UnacceptedFromAddresses),
 		Coins:                   MakeCopyOfCoins(orig.Coins),
 		Declined:                orig.Declined,
+		ExpiresAt:               orig.ExpiresAt,
+		QuarantinedAt:           orig.QuarantinedAt,
 	}
 }
 
@@ -205,9 +207,27 @@ func MakeCopyOfGenesisState(orig *quarantine.GenesisState) *quarantine.GenesisSt
 		QuarantinedAddresses: MakeCopyOfStringSlice(orig.QuarantinedAddresses),
 		AutoResponses:        MakeCopyOfAutoResponseEntries(orig.AutoResponses),
 		QuarantinedFunds:     MakeCopyOfQuarantinedFundsSlice(orig.QuarantinedFunds),
+		AutoAcceptRules:      MakeCopyOfAutoAcceptRulesEntries(orig.AutoAcceptRules),
+		FundsExpirations:     MakeCopyOfFundsExpirationEntries(orig.FundsExpirations),
 	}
 }
 
+// MakeCopyOfAutoAcceptRulesEntries makes a deep copy of a slice of AutoAcceptRulesEntry.
+func MakeCopyOfAutoAcceptRulesEntries(orig []quarantine.AutoAcceptRulesEntry) []quarantine.AutoAcceptRulesEntry {
+	if orig == nil {
+		return nil
+	}
+	rv := make([]quarantine.AutoAcceptRulesEntry, len(orig))
+	for i, entry := range orig {
+		rv[i] = quarantine.AutoAcceptRulesEntry{ToAddress: entry.ToAddress}
+		if entry.Rules != nil {
+			rv[i].Rules = make([]quarantine.AutoAcceptRule, len(entry.Rules))
+			copy(rv[i].Rules, entry.Rules)
+		}
+	}
+	return rv
+}
+
 // MakeCopyOfAutoResponseEntries makes a deep copy of a slice of AutoResponseEntries.
 func MakeCopyOfAutoResponseEntries(orig []*quarantine.AutoResponseEntry) []*quarantine.AutoResponseEntry {
 	if orig == nil {
@@ -241,3 +261,13 @@ func MakeCopyOfQuarantineRecordSuffixIndex(orig *quarantine.QuarantineRecordSuff
 		RecordSuffixes: MakeCopyOfByteSliceSlice(orig.RecordSuffixes),
 	}
 }
+
+// MakeCopyOfFundsExpirationEntries makes a copy of a slice of FundsExpirationEntry.
+func MakeCopyOfFundsExpirationEntries(orig []quarantine.FundsExpirationEntry) []quarantine.FundsExpirationEntry {
+	if orig == nil {
+		return nil
+	}
+	rv := make([]quarantine.FundsExpirationEntry, len(orig))
+	copy(rv, orig)
+	return rv
+}
@@ -14,18 +14,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -414,6 +418,237 @@ func (m *MsgDeclineResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeclineResponse proto.InternalMessageInfo
 
+// MsgBulkAccept represents a message for accepting all quarantined funds that match a filter.
+type MsgBulkAccept struct {
+	// to_address is the address of the quarantined account that is accepting funds.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// filter defines which of the quarantined funds to accept. An empty filter matches all undeclined funds.
+	// Matching funds are accepted from all of their senders and released.
+	Filter QuarantinedFundsFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
+	// limit is the maximum number of quarantined funds entries to accept. Zero means the max allowed (1000).
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgBulkAccept) Reset()         { *m = MsgBulkAccept{} }
+func (m *MsgBulkAccept) String() string { return proto.CompactTextString(m) }
+func (*MsgBulkAccept) ProtoMessage()    {}
+func (*MsgBulkAccept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{8}
+}
+func (m *MsgBulkAccept) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBulkAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBulkAccept.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBulkAccept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBulkAccept.Merge(m, src)
+}
+func (m *MsgBulkAccept) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBulkAccept) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBulkAccept.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBulkAccept proto.InternalMessageInfo
+
+func (m *MsgBulkAccept) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgBulkAccept) GetFilter() QuarantinedFundsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return QuarantinedFundsFilter{}
+}
+
+func (m *MsgBulkAccept) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// MsgBulkAcceptResponse defines the Msg/BulkAccept response type.
+type MsgBulkAcceptResponse struct {
+	// funds_released is the amount that was quarantined but has now been released and sent to the requester.
+	FundsReleased github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=funds_released,json=fundsReleased,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds_released"`
+	// count is the number of quarantined funds entries that were accepted.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MsgBulkAcceptResponse) Reset()         { *m = MsgBulkAcceptResponse{} }
+func (m *MsgBulkAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBulkAcceptResponse) ProtoMessage()    {}
+func (*MsgBulkAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{9}
+}
+func (m *MsgBulkAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBulkAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBulkAcceptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBulkAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBulkAcceptResponse.Merge(m, src)
+}
+func (m *MsgBulkAcceptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBulkAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBulkAcceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBulkAcceptResponse proto.InternalMessageInfo
+
+func (m *MsgBulkAcceptResponse) GetFundsReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FundsReleased
+	}
+	return nil
+}
+
+func (m *MsgBulkAcceptResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// MsgBulkDecline represents a message for declining all quarantined funds that match a filter.
+type MsgBulkDecline struct {
+	// to_address is the address of the quarantined account that is declining funds.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// filter defines which of the quarantined funds to decline. An empty filter matches all undeclined funds.
+	// Funds that have already been declined are never selected, regardless of the filter's include_declined field.
+	Filter QuarantinedFundsFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
+	// limit is the maximum number of quarantined funds entries to decline. Zero means the max allowed (1000).
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgBulkDecline) Reset()         { *m = MsgBulkDecline{} }
+func (m *MsgBulkDecline) String() string { return proto.CompactTextString(m) }
+func (*MsgBulkDecline) ProtoMessage()    {}
+func (*MsgBulkDecline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{10}
+}
+func (m *MsgBulkDecline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBulkDecline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBulkDecline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBulkDecline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBulkDecline.Merge(m, src)
+}
+func (m *MsgBulkDecline) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBulkDecline) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBulkDecline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBulkDecline proto.InternalMessageInfo
+
+func (m *MsgBulkDecline) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgBulkDecline) GetFilter() QuarantinedFundsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return QuarantinedFundsFilter{}
+}
+
+func (m *MsgBulkDecline) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// MsgBulkDeclineResponse defines the Msg/BulkDecline response type.
+type MsgBulkDeclineResponse struct {
+	// count is the number of quarantined funds entries that were declined.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *MsgBulkDeclineResponse) Reset()         { *m = MsgBulkDeclineResponse{} }
+func (m *MsgBulkDeclineResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBulkDeclineResponse) ProtoMessage()    {}
+func (*MsgBulkDeclineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{11}
+}
+func (m *MsgBulkDeclineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBulkDeclineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBulkDeclineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBulkDeclineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBulkDeclineResponse.Merge(m, src)
+}
+func (m *MsgBulkDeclineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBulkDeclineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBulkDeclineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBulkDeclineResponse proto.InternalMessageInfo
+
+func (m *MsgBulkDeclineResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // MsgUpdateAutoResponses represents a message for updating quarantine auto-responses for a receiving address.
 type MsgUpdateAutoResponses struct {
 	// to_address is the quarantined address that would be accepting or declining funds.
@@ -426,7 +661,7 @@ func (m *MsgUpdateAutoResponses) Reset()         { *m = MsgUpdateAutoResponses{}
 func (m *MsgUpdateAutoResponses) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAutoResponses) ProtoMessage()    {}
 func (*MsgUpdateAutoResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2d4535ca5d9aa17, []int{8}
+	return fileDescriptor_d2d4535ca5d9aa17, []int{12}
 }
 func (m *MsgUpdateAutoResponses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -477,7 +712,7 @@ func (m *MsgUpdateAutoResponsesResponse) Reset()         { *m = MsgUpdateAutoRes
 func (m *MsgUpdateAutoResponsesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateAutoResponsesResponse) ProtoMessage()    {}
 func (*MsgUpdateAutoResponsesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d2d4535ca5d9aa17, []int{9}
+	return fileDescriptor_d2d4535ca5d9aa17, []int{13}
 }
 func (m *MsgUpdateAutoResponsesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,6 +741,199 @@ func (m *MsgUpdateAutoResponsesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateAutoResponsesResponse proto.InternalMessageInfo
 
+// MsgUpdateAutoAcceptRules represents a message for replacing the quarantine auto-accept rules for a receiving address.
+type MsgUpdateAutoAcceptRules struct {
+	// to_address is the quarantined address that would be accepting funds.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// rules are the new auto-accept rules for the to_address. Provide no rules to remove all of them.
+	Rules []AutoAcceptRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *MsgUpdateAutoAcceptRules) Reset()         { *m = MsgUpdateAutoAcceptRules{} }
+func (m *MsgUpdateAutoAcceptRules) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAutoAcceptRules) ProtoMessage()    {}
+func (*MsgUpdateAutoAcceptRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{14}
+}
+func (m *MsgUpdateAutoAcceptRules) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAutoAcceptRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAutoAcceptRules.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAutoAcceptRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAutoAcceptRules.Merge(m, src)
+}
+func (m *MsgUpdateAutoAcceptRules) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAutoAcceptRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAutoAcceptRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAutoAcceptRules proto.InternalMessageInfo
+
+func (m *MsgUpdateAutoAcceptRules) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateAutoAcceptRules) GetRules() []AutoAcceptRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// MsgUpdateAutoAcceptRulesResponse defines the Msg/UpdateAutoAcceptRules response type.
+type MsgUpdateAutoAcceptRulesResponse struct {
+}
+
+func (m *MsgUpdateAutoAcceptRulesResponse) Reset()         { *m = MsgUpdateAutoAcceptRulesResponse{} }
+func (m *MsgUpdateAutoAcceptRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAutoAcceptRulesResponse) ProtoMessage()    {}
+func (*MsgUpdateAutoAcceptRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{15}
+}
+func (m *MsgUpdateAutoAcceptRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAutoAcceptRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAutoAcceptRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAutoAcceptRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAutoAcceptRulesResponse.Merge(m, src)
+}
+func (m *MsgUpdateAutoAcceptRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAutoAcceptRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAutoAcceptRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAutoAcceptRulesResponse proto.InternalMessageInfo
+
+// MsgUpdateFundsExpiration represents a message for setting how long funds can remain quarantined for a receiving address.
+type MsgUpdateFundsExpiration struct {
+	// to_address is the quarantined address that would be accepting funds.
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// timeout is how long funds can remain quarantined before they expire. Provide zero to turn off expiration.
+	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// fallback_address, if provided, is where expired funds are sent. Otherwise, they are returned to the sender.
+	FallbackAddress string `protobuf:"bytes,3,opt,name=fallback_address,json=fallbackAddress,proto3" json:"fallback_address,omitempty"`
+}
+
+func (m *MsgUpdateFundsExpiration) Reset()         { *m = MsgUpdateFundsExpiration{} }
+func (m *MsgUpdateFundsExpiration) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFundsExpiration) ProtoMessage()    {}
+func (*MsgUpdateFundsExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{16}
+}
+func (m *MsgUpdateFundsExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFundsExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFundsExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFundsExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFundsExpiration.Merge(m, src)
+}
+func (m *MsgUpdateFundsExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFundsExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFundsExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFundsExpiration proto.InternalMessageInfo
+
+func (m *MsgUpdateFundsExpiration) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateFundsExpiration) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *MsgUpdateFundsExpiration) GetFallbackAddress() string {
+	if m != nil {
+		return m.FallbackAddress
+	}
+	return ""
+}
+
+// MsgUpdateFundsExpirationResponse defines the Msg/UpdateFundsExpiration response type.
+type MsgUpdateFundsExpirationResponse struct {
+}
+
+func (m *MsgUpdateFundsExpirationResponse) Reset()         { *m = MsgUpdateFundsExpirationResponse{} }
+func (m *MsgUpdateFundsExpirationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFundsExpirationResponse) ProtoMessage()    {}
+func (*MsgUpdateFundsExpirationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2d4535ca5d9aa17, []int{17}
+}
+func (m *MsgUpdateFundsExpirationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFundsExpirationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFundsExpirationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFundsExpirationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFundsExpirationResponse.Merge(m, src)
+}
+func (m *MsgUpdateFundsExpirationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFundsExpirationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFundsExpirationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFundsExpirationResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgOptIn)(nil), "cosmos.quarantine.v1beta1.MsgOptIn")
 	proto.RegisterType((*MsgOptInResponse)(nil), "cosmos.quarantine.v1beta1.MsgOptInResponse")
@@ -515,8 +943,16 @@ func init() {
 	proto.RegisterType((*MsgAcceptResponse)(nil), "cosmos.quarantine.v1beta1.MsgAcceptResponse")
 	proto.RegisterType((*MsgDecline)(nil), "cosmos.quarantine.v1beta1.MsgDecline")
 	proto.RegisterType((*MsgDeclineResponse)(nil), "cosmos.quarantine.v1beta1.MsgDeclineResponse")
+	proto.RegisterType((*MsgBulkAccept)(nil), "cosmos.quarantine.v1beta1.MsgBulkAccept")
+	proto.RegisterType((*MsgBulkAcceptResponse)(nil), "cosmos.quarantine.v1beta1.MsgBulkAcceptResponse")
+	proto.RegisterType((*MsgBulkDecline)(nil), "cosmos.quarantine.v1beta1.MsgBulkDecline")
+	proto.RegisterType((*MsgBulkDeclineResponse)(nil), "cosmos.quarantine.v1beta1.MsgBulkDeclineResponse")
 	proto.RegisterType((*MsgUpdateAutoResponses)(nil), "cosmos.quarantine.v1beta1.MsgUpdateAutoResponses")
 	proto.RegisterType((*MsgUpdateAutoResponsesResponse)(nil), "cosmos.quarantine.v1beta1.MsgUpdateAutoResponsesResponse")
+	proto.RegisterType((*MsgUpdateAutoAcceptRules)(nil), "cosmos.quarantine.v1beta1.MsgUpdateAutoAcceptRules")
+	proto.RegisterType((*MsgUpdateAutoAcceptRulesResponse)(nil), "cosmos.quarantine.v1beta1.MsgUpdateAutoAcceptRulesResponse")
+	proto.RegisterType((*MsgUpdateFundsExpiration)(nil), "cosmos.quarantine.v1beta1.MsgUpdateFundsExpiration")
+	proto.RegisterType((*MsgUpdateFundsExpirationResponse)(nil), "cosmos.quarantine.v1beta1.MsgUpdateFundsExpirationResponse")
 }
 
 func init() {
@@ -524,47 +960,66 @@ func init() {
 }
 
 var fileDescriptor_d2d4535ca5d9aa17 = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xcf, 0x7d, 0xf3, 0xed, 0x8f, 0x5c, 0x69, 0xa1, 0x6e, 0x04, 0xa9, 0x85, 0xdc, 0x28, 0x80,
-	0x14, 0x05, 0x62, 0x93, 0x30, 0x20, 0x58, 0x50, 0x02, 0x02, 0x81, 0x14, 0x45, 0x32, 0x74, 0x00,
-	0x21, 0x45, 0x8e, 0x7d, 0x35, 0x16, 0xf1, 0x9d, 0xf1, 0x9d, 0xa3, 0x76, 0x43, 0xb0, 0x20, 0x26,
-	0x66, 0x06, 0x66, 0x04, 0x4b, 0x86, 0xf2, 0x3f, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0xfe,
-	0x0d, 0x64, 0xdf, 0x39, 0xb1, 0x68, 0x9a, 0x84, 0x8a, 0x85, 0x25, 0x3e, 0xbf, 0xf7, 0xf9, 0xf1,
-	0x5e, 0xf4, 0x9e, 0x0f, 0x16, 0x4c, 0x42, 0x5d, 0x42, 0xb5, 0x17, 0x81, 0xe1, 0x1b, 0x98, 0x39,
-	0x18, 0x69, 0xdd, 0x4a, 0x1b, 0x31, 0xa3, 0xa2, 0xb1, 0x5d, 0xd5, 0xf3, 0x09, 0x23, 0xd2, 0x26,
-	0xc7, 0xa8, 0x63, 0x8c, 0x2a, 0x30, 0xf2, 0xba, 0xe1, 0x3a, 0x98, 0x68, 0xd1, 0x2f, 0x47, 0xcb,
-	0x8a, 0x50, 0x6c, 0x1b, 0x74, 0xac, 0x65, 0x12, 0x07, 0x8b, 0xfc, 0x39, 0x91, 0x77, 0xa9, 0xad,
-	0x75, 0x2b, 0xe1, 0x43, 0x24, 0x4a, 0xc7, 0x97, 0x92, 0x70, 0xe6, 0x58, 0x51, 0x52, 0x2b, 0x7a,
-	0xd3, 0x44, 0x7d, 0x3c, 0x95, 0xb5, 0x89, 0x4d, 0x78, 0x3c, 0x3c, 0xf1, 0x68, 0xe1, 0x11, 0x5c,
-	0x6e, 0x50, 0xbb, 0xe9, 0xb1, 0xfb, 0x58, 0xba, 0x0e, 0x21, 0x23, 0x2d, 0xc3, 0xb2, 0x7c, 0x44,
-	0x69, 0x0e, 0xe4, 0x41, 0x31, 0x53, 0xcf, 0x7d, 0xdd, 0x2f, 0x67, 0x85, 0x4e, 0x8d, 0x67, 0x1e,
-	0x32, 0xdf, 0xc1, 0xb6, 0x9e, 0x61, 0x44, 0x04, 0x6e, 0x9e, 0x7e, 0x35, 0xec, 0x95, 0x12, 0xdc,
-	0x82, 0x04, 0xcf, 0xc4, 0xaa, 0x3a, 0xa2, 0x1e, 0xc1, 0x14, 0x15, 0xb6, 0x61, 0x86, 0xc7, 0x9a,
-	0x01, 0xfb, 0x8b, 0x56, 0x1b, 0x70, 0x7d, 0x24, 0x3b, 0xf2, 0xda, 0x07, 0x91, 0x59, 0xcd, 0x34,
-	0x91, 0x77, 0x72, 0x33, 0xe9, 0x16, 0x5c, 0xdb, 0xf1, 0x89, 0x1b, 0x53, 0x11, 0xcd, 0xfd, 0x97,
-	0x4f, 0x4f, 0x25, 0xaf, 0x86, 0xf8, 0x5a, 0x0c, 0x97, 0xce, 0xc3, 0x8c, 0x87, 0x7c, 0xd7, 0xc0,
-	0x08, 0xb3, 0x5c, 0x3a, 0x0f, 0x8a, 0xcb, 0xfa, 0x38, 0x70, 0xb4, 0x97, 0x0f, 0x20, 0x6a, 0x86,
-	0x97, 0x1d, 0x37, 0x23, 0xbd, 0x01, 0x70, 0x6d, 0x27, 0xc0, 0x16, 0x6d, 0xf9, 0xa8, 0x83, 0x0c,
-	0x8a, 0xac, 0x1c, 0xc8, 0xa7, 0x8b, 0x2b, 0xd5, 0x4d, 0x55, 0xd4, 0x10, 0x8e, 0x54, 0x3c, 0x7a,
-	0xea, 0x6d, 0xe2, 0xe0, 0xfa, 0xdd, 0x83, 0xef, 0x5b, 0xa9, 0x4f, 0x3f, 0xb6, 0x8a, 0xb6, 0xc3,
-	0x9e, 0x05, 0x6d, 0xd5, 0x24, 0xae, 0x98, 0x06, 0xf1, 0x28, 0x53, 0xeb, 0xb9, 0xc6, 0xf6, 0x3c,
-	0x44, 0x23, 0x02, 0x7d, 0x3f, 0xec, 0x95, 0x4e, 0x75, 0x90, 0x6d, 0x98, 0x7b, 0xad, 0x70, 0x28,
-	0xe9, 0xc7, 0x61, 0xaf, 0x04, 0xf4, 0xd5, 0xc8, 0x58, 0x17, 0xbe, 0x85, 0x2f, 0x00, 0xc2, 0x06,
-	0xb5, 0xef, 0x20, 0xb3, 0xe3, 0x60, 0xf4, 0xef, 0xfc, 0xb1, 0x59, 0x28, 0x8d, 0xcb, 0x1e, 0x4d,
-	0xc9, 0x67, 0x00, 0xcf, 0x36, 0xa8, 0xbd, 0xed, 0x59, 0x06, 0x43, 0xb5, 0x80, 0x91, 0x38, 0x43,
-	0x4f, 0xde, 0xd9, 0x3d, 0xb8, 0x14, 0x44, 0x7a, 0xbc, 0xa5, 0x95, 0x6a, 0x59, 0x3d, 0xf6, 0x2b,
-	0xa1, 0x26, 0x3d, 0x79, 0x15, 0x7a, 0xcc, 0x3e, 0xda, 0x43, 0x1e, 0x2a, 0x93, 0x8b, 0x8d, 0x0f,
-	0xd5, 0xb7, 0xff, 0xc3, 0x74, 0x83, 0xda, 0xd2, 0x63, 0xb8, 0xc0, 0x17, 0xfa, 0xc2, 0x14, 0xef,
-	0x78, 0x3f, 0xe5, 0xcb, 0x73, 0x80, 0x46, 0xb3, 0xf8, 0x14, 0x2e, 0x8a, 0x0d, 0xbe, 0x38, 0x93,
-	0xd6, 0x0c, 0x98, 0x7c, 0x65, 0x1e, 0x54, 0x52, 0x5d, 0xac, 0xec, 0x0c, 0x75, 0x8e, 0x9a, 0xa5,
-	0xfe, 0xdb, 0x1e, 0xb5, 0xe0, 0x52, 0x3c, 0xb8, 0x97, 0xa6, 0x13, 0x05, 0x4c, 0x2e, 0xcf, 0x05,
-	0x1b, 0x19, 0xbc, 0x06, 0x70, 0x63, 0xd2, 0x30, 0x55, 0xa6, 0xcb, 0x4c, 0xa0, 0xc8, 0x37, 0xfe,
-	0x98, 0x12, 0x1f, 0xe4, 0x85, 0x97, 0xe1, 0xe6, 0xd6, 0x1f, 0x1c, 0xf4, 0x15, 0x70, 0xd8, 0x57,
-	0xc0, 0xcf, 0xbe, 0x02, 0xde, 0x0d, 0x94, 0xd4, 0xe1, 0x40, 0x49, 0x7d, 0x1b, 0x28, 0xa9, 0x27,
-	0x57, 0x13, 0xdf, 0x04, 0xcf, 0x27, 0x5d, 0x84, 0x0d, 0x6c, 0xa2, 0xb2, 0x43, 0x12, 0x6f, 0xda,
-	0x6e, 0xe2, 0x6e, 0x69, 0x2f, 0x46, 0x77, 0xc5, 0xb5, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a,
-	0xd4, 0x9c, 0x3f, 0x15, 0x07, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x74, 0xc9, 0xaf, 0x17, 0x36, 0x6d, 0xdc, 0x14, 0x36, 0x16, 0xda, 0xac, 0x16, 0x90,
+	0xb6, 0xa1, 0x6b, 0x77, 0xd3, 0x03, 0x82, 0x0a, 0xa1, 0x6c, 0xdb, 0x20, 0x90, 0xa2, 0x08, 0x43,
+	0x0f, 0x20, 0xa4, 0x95, 0xd7, 0x3b, 0xeb, 0x5a, 0xb1, 0x3d, 0xc6, 0x33, 0x8e, 0xd2, 0x1b, 0x82,
+	0x0b, 0xdc, 0x38, 0x22, 0x0e, 0x9c, 0x11, 0xbd, 0xe4, 0x50, 0x24, 0x8e, 0x70, 0xeb, 0xb1, 0xe2,
+	0xc4, 0x89, 0xa0, 0xe4, 0x10, 0xfe, 0x05, 0x6e, 0xc8, 0x9e, 0x1f, 0xeb, 0x24, 0x9b, 0x5d, 0xb3,
+	0x41, 0x82, 0x5e, 0xba, 0x9d, 0x99, 0xef, 0x7b, 0xef, 0xfb, 0xc6, 0xcf, 0xef, 0x39, 0x50, 0x77,
+	0x08, 0x0d, 0x08, 0x35, 0x3f, 0x4d, 0xec, 0xd8, 0x0e, 0x99, 0x17, 0x62, 0x73, 0xb7, 0xd5, 0xc5,
+	0xcc, 0x6e, 0x99, 0x6c, 0xcf, 0x88, 0x62, 0xc2, 0x88, 0xb6, 0xc2, 0x31, 0xc6, 0x00, 0x63, 0x08,
+	0x8c, 0xbe, 0x64, 0x07, 0x5e, 0x48, 0xcc, 0xec, 0x5f, 0x8e, 0xd6, 0xab, 0x22, 0x62, 0xd7, 0xa6,
+	0x83, 0x58, 0x0e, 0xf1, 0x42, 0x71, 0xfe, 0xa2, 0x38, 0x0f, 0xa8, 0x6b, 0xee, 0xb6, 0xd2, 0x1f,
+	0x71, 0xb0, 0x76, 0xbe, 0x94, 0x5c, 0x66, 0x8e, 0x15, 0x92, 0x3a, 0xd9, 0xca, 0x14, 0xfa, 0xf8,
+	0xd1, 0xb2, 0x4b, 0x5c, 0xc2, 0xf7, 0xd3, 0xff, 0x49, 0x55, 0x2e, 0x21, 0xae, 0x8f, 0xcd, 0x6c,
+	0xd5, 0x4d, 0xfa, 0x66, 0x2f, 0x89, 0x6d, 0xe6, 0x11, 0xa1, 0xaa, 0xfe, 0x21, 0xcc, 0x6d, 0x51,
+	0x77, 0x3b, 0x62, 0xef, 0x86, 0xda, 0xeb, 0x00, 0x8c, 0x74, 0xec, 0x5e, 0x2f, 0xc6, 0x94, 0x56,
+	0x50, 0x0d, 0x35, 0xe6, 0xdb, 0x95, 0x5f, 0x1f, 0x37, 0x97, 0x45, 0x9e, 0x0d, 0x7e, 0xf2, 0x01,
+	0x8b, 0xbd, 0xd0, 0xb5, 0xe6, 0x19, 0x11, 0x1b, 0x6f, 0x5e, 0xfe, 0xfc, 0x78, 0x7f, 0x2d, 0xc7,
+	0xad, 0x6b, 0x70, 0x45, 0x46, 0xb5, 0x30, 0x8d, 0x48, 0x48, 0x71, 0xfd, 0x3e, 0xcc, 0xf3, 0xbd,
+	0xed, 0x84, 0xfd, 0x8b, 0xa9, 0xae, 0xc2, 0x92, 0x0a, 0xab, 0x72, 0x3d, 0x46, 0x59, 0xb2, 0x0d,
+	0xc7, 0xc1, 0xd1, 0xe4, 0xc9, 0xb4, 0xb7, 0x61, 0xb1, 0x1f, 0x93, 0x40, 0x52, 0x31, 0xad, 0x5c,
+	0xaa, 0x95, 0x46, 0x92, 0xcb, 0x29, 0x7e, 0x43, 0xc2, 0xb5, 0x97, 0x60, 0x3e, 0xc2, 0x71, 0x60,
+	0x87, 0x38, 0x64, 0x95, 0x52, 0x0d, 0x35, 0xe6, 0xac, 0xc1, 0xc6, 0x59, 0x2f, 0xdf, 0x21, 0x58,
+	0x52, 0xb2, 0xa5, 0x19, 0xed, 0x4b, 0x04, 0x8b, 0xfd, 0x24, 0xec, 0xd1, 0x4e, 0x8c, 0x7d, 0x6c,
+	0x53, 0xdc, 0xab, 0xa0, 0x5a, 0xa9, 0xb1, 0xb0, 0xbe, 0x62, 0x08, 0x0d, 0x69, 0xc9, 0xc9, 0xd2,
+	0x34, 0xee, 0x10, 0x2f, 0x6c, 0x6f, 0x3e, 0xf9, 0x7d, 0x75, 0xea, 0x87, 0x83, 0xd5, 0x86, 0xeb,
+	0xb1, 0x07, 0x49, 0xd7, 0x70, 0x48, 0x20, 0xaa, 0x45, 0xfc, 0x34, 0x69, 0x6f, 0xc7, 0x64, 0x0f,
+	0x23, 0x4c, 0x33, 0x02, 0xfd, 0xf6, 0x78, 0x7f, 0xed, 0x79, 0x1f, 0xbb, 0xb6, 0xf3, 0xb0, 0x93,
+	0x16, 0x2d, 0xfd, 0xfe, 0x78, 0x7f, 0x0d, 0x59, 0xe5, 0x2c, 0xb1, 0x25, 0xf2, 0xd6, 0x7f, 0x44,
+	0x00, 0x5b, 0xd4, 0xbd, 0x8b, 0x1d, 0xdf, 0x0b, 0xf1, 0xb3, 0x73, 0xb1, 0xcb, 0xa0, 0x0d, 0x64,
+	0xab, 0x2a, 0xf9, 0x19, 0x41, 0x79, 0x8b, 0xba, 0xed, 0xc4, 0xdf, 0xb9, 0x68, 0xa5, 0x6c, 0xc3,
+	0x4c, 0xdf, 0xf3, 0x19, 0x8e, 0x2b, 0x97, 0x6a, 0xa8, 0xb1, 0xb0, 0xde, 0x32, 0xce, 0xed, 0x1d,
+	0xc6, 0xfb, 0x6a, 0xab, 0xb7, 0x99, 0xde, 0xee, 0x66, 0x46, 0x6c, 0x3f, 0x97, 0x3e, 0x32, 0x4b,
+	0x84, 0xd1, 0x96, 0x61, 0xda, 0xf7, 0x02, 0x8f, 0x9b, 0x2b, 0x5b, 0x7c, 0x71, 0xd6, 0xd8, 0x4f,
+	0x08, 0xae, 0x9d, 0xb0, 0xf0, 0x3f, 0xac, 0x9a, 0xd4, 0x8b, 0x43, 0x92, 0x90, 0x65, 0x77, 0x53,
+	0xb6, 0xf8, 0xa2, 0xfe, 0x0b, 0x82, 0x45, 0x21, 0xfd, 0xc2, 0xf5, 0xf4, 0x5f, 0x5d, 0xbf, 0x01,
+	0x2f, 0x9c, 0xb4, 0xa0, 0xae, 0x5f, 0x79, 0x46, 0x79, 0xcf, 0x8f, 0x50, 0x46, 0xb8, 0x1f, 0xf5,
+	0x6c, 0x86, 0x37, 0x12, 0x46, 0x24, 0x9e, 0x4e, 0xee, 0xfd, 0x1d, 0x98, 0x4d, 0xb2, 0x78, 0xfc,
+	0x25, 0x5a, 0x58, 0x6f, 0x8e, 0x30, 0x9f, 0xcf, 0xc9, 0x55, 0x58, 0x92, 0x7d, 0xd6, 0x5d, 0x0d,
+	0xaa, 0xc3, 0xc5, 0xaa, 0x37, 0xe8, 0x11, 0x82, 0xca, 0x09, 0x88, 0x28, 0xc2, 0xc4, 0xbf, 0x88,
+	0xa3, 0x7b, 0x30, 0x1d, 0x27, 0xbe, 0xf2, 0x73, 0x7d, 0x8c, 0x9f, 0x41, 0x4e, 0xf1, 0x10, 0x39,
+	0xfb, 0xac, 0x9f, 0x3a, 0xd4, 0xce, 0x13, 0xab, 0x1c, 0xfd, 0x99, 0x77, 0x94, 0xd5, 0xc7, 0xbd,
+	0xbd, 0xc8, 0xe3, 0x23, 0x73, 0x72, 0x47, 0x6f, 0xc1, 0x2c, 0xf3, 0x02, 0x4c, 0x12, 0x26, 0x0a,
+	0x74, 0xc5, 0xe0, 0x73, 0xd9, 0x90, 0x73, 0xd9, 0xb8, 0x2b, 0xe6, 0x72, 0x7b, 0x2e, 0xf5, 0xf0,
+	0xcd, 0xc1, 0x2a, 0xb2, 0x24, 0x47, 0xbb, 0x03, 0x57, 0xfa, 0xb6, 0xef, 0x77, 0x6d, 0x67, 0x47,
+	0x65, 0x2f, 0x8d, 0xc9, 0x7e, 0x59, 0x32, 0xce, 0x9d, 0x9c, 0xf9, 0xeb, 0x38, 0xe5, 0x54, 0x5e,
+	0xc7, 0xfa, 0x5f, 0xb3, 0x50, 0xda, 0xa2, 0xae, 0xf6, 0x11, 0x4c, 0xf3, 0x6f, 0x84, 0x97, 0x47,
+	0x3c, 0x0c, 0x39, 0xf2, 0xf5, 0xd7, 0x0a, 0x80, 0xd4, 0x9b, 0xf2, 0x09, 0xcc, 0x88, 0x8f, 0x82,
+	0x57, 0xc6, 0xd2, 0xb6, 0x13, 0xa6, 0xdf, 0x28, 0x82, 0xca, 0x47, 0x17, 0xbd, 0x7d, 0x4c, 0x74,
+	0x8e, 0xd2, 0x6f, 0x14, 0x41, 0xa9, 0xe8, 0x1d, 0x98, 0x95, 0xbd, 0xeb, 0xd5, 0xd1, 0x44, 0x01,
+	0xd3, 0x9b, 0x85, 0x60, 0x2a, 0xc1, 0x03, 0x80, 0xdc, 0x78, 0x6a, 0x8c, 0x26, 0x0f, 0x90, 0xfa,
+	0xcd, 0xa2, 0x48, 0x95, 0x69, 0x07, 0x16, 0xf2, 0xad, 0xf8, 0xfa, 0xf8, 0x00, 0xd2, 0x52, 0xab,
+	0x30, 0x54, 0x25, 0xfb, 0x02, 0xc1, 0xd5, 0x61, 0x4d, 0x70, 0x4c, 0xa8, 0x21, 0x14, 0xfd, 0x8d,
+	0x7f, 0x4c, 0x51, 0x2a, 0xbe, 0x42, 0x70, 0x6d, 0x78, 0xeb, 0xba, 0x55, 0x34, 0x68, 0x8e, 0xa4,
+	0xdf, 0x9e, 0x80, 0x34, 0x44, 0xcb, 0xe9, 0xa6, 0x53, 0x48, 0xcb, 0x29, 0x92, 0x7e, 0x7b, 0x02,
+	0x92, 0xd4, 0xa2, 0x4f, 0x7f, 0x96, 0x4e, 0xf1, 0xf6, 0x7b, 0x4f, 0x0e, 0xab, 0xe8, 0xe9, 0x61,
+	0x15, 0xfd, 0x71, 0x58, 0x45, 0x5f, 0x1f, 0x55, 0xa7, 0x9e, 0x1e, 0x55, 0xa7, 0x7e, 0x3b, 0xaa,
+	0x4e, 0x7d, 0x7c, 0x33, 0xf7, 0x7d, 0x10, 0xc5, 0x64, 0x17, 0x87, 0x76, 0xe8, 0xe0, 0xa6, 0x47,
+	0x72, 0x2b, 0x73, 0x2f, 0xf7, 0xd7, 0x4b, 0x77, 0x26, 0xeb, 0x73, 0xb7, 0xfe, 0x1e, 0x00, 0xcf,
+	0xcc, 0xd6, 0x36, 0x77, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -589,8 +1044,16 @@ type MsgClient interface {
 	Accept(ctx context.Context, in *MsgAccept, opts ...grpc.CallOption) (*MsgAcceptResponse, error)
 	// Decline defines a method for declining quarantined funds.
 	Decline(ctx context.Context, in *MsgDecline, opts ...grpc.CallOption) (*MsgDeclineResponse, error)
+	// BulkAccept defines a method for accepting many quarantined funds at once.
+	BulkAccept(ctx context.Context, in *MsgBulkAccept, opts ...grpc.CallOption) (*MsgBulkAcceptResponse, error)
+	// BulkDecline defines a method for declining many quarantined funds at once.
+	BulkDecline(ctx context.Context, in *MsgBulkDecline, opts ...grpc.CallOption) (*MsgBulkDeclineResponse, error)
 	// UpdateAutoResponses defines a method for updating the auto-response settings for a quarantined address.
 	UpdateAutoResponses(ctx context.Context, in *MsgUpdateAutoResponses, opts ...grpc.CallOption) (*MsgUpdateAutoResponsesResponse, error)
+	// UpdateAutoAcceptRules defines a method for replacing the auto-accept rules for a quarantined address.
+	UpdateAutoAcceptRules(ctx context.Context, in *MsgUpdateAutoAcceptRules, opts ...grpc.CallOption) (*MsgUpdateAutoAcceptRulesResponse, error)
+	// UpdateFundsExpiration defines a method for setting how long funds can remain quarantined for an address.
+	UpdateFundsExpiration(ctx context.Context, in *MsgUpdateFundsExpiration, opts ...grpc.CallOption) (*MsgUpdateFundsExpirationResponse, error)
 }
 
 type msgClient struct {
@@ -637,29 +1100,73 @@ func (c *msgClient) Decline(ctx context.Context, in *MsgDecline, opts ...grpc.Ca
 	return out, nil
 }
 
-func (c *msgClient) UpdateAutoResponses(ctx context.Context, in *MsgUpdateAutoResponses, opts ...grpc.CallOption) (*MsgUpdateAutoResponsesResponse, error) {
-	out := new(MsgUpdateAutoResponsesResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Msg/UpdateAutoResponses", in, out, opts...)
+func (c *msgClient) BulkAccept(ctx context.Context, in *MsgBulkAccept, opts ...grpc.CallOption) (*MsgBulkAcceptResponse, error) {
+	out := new(MsgBulkAcceptResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Msg/BulkAccept", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// OptIn defines a method for opting in to account quarantine.
-	// Funds sent to a quarantined account must be approved before they can be received.
-	OptIn(context.Context, *MsgOptIn) (*MsgOptInResponse, error)
-	// OptOut defines a method for opting out of account quarantine.
+func (c *msgClient) BulkDecline(ctx context.Context, in *MsgBulkDecline, opts ...grpc.CallOption) (*MsgBulkDeclineResponse, error) {
+	out := new(MsgBulkDeclineResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Msg/BulkDecline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAutoResponses(ctx context.Context, in *MsgUpdateAutoResponses, opts ...grpc.CallOption) (*MsgUpdateAutoResponsesResponse, error) {
+	out := new(MsgUpdateAutoResponsesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Msg/UpdateAutoResponses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAutoAcceptRules(ctx context.Context, in *MsgUpdateAutoAcceptRules, opts ...grpc.CallOption) (*MsgUpdateAutoAcceptRulesResponse, error) {
+	out := new(MsgUpdateAutoAcceptRulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Msg/UpdateAutoAcceptRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFundsExpiration(ctx context.Context, in *MsgUpdateFundsExpiration, opts ...grpc.CallOption) (*MsgUpdateFundsExpirationResponse, error) {
+	out := new(MsgUpdateFundsExpirationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.quarantine.v1beta1.Msg/UpdateFundsExpiration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// OptIn defines a method for opting in to account quarantine.
+	// Funds sent to a quarantined account must be approved before they can be received.
+	OptIn(context.Context, *MsgOptIn) (*MsgOptInResponse, error)
+	// OptOut defines a method for opting out of account quarantine.
 	// Any pending funds for the account must still be accepted, but new sends will no longer be quarantined.
 	OptOut(context.Context, *MsgOptOut) (*MsgOptOutResponse, error)
 	// Accept defines a method for accepting quarantined funds.
 	Accept(context.Context, *MsgAccept) (*MsgAcceptResponse, error)
 	// Decline defines a method for declining quarantined funds.
 	Decline(context.Context, *MsgDecline) (*MsgDeclineResponse, error)
+	// BulkAccept defines a method for accepting many quarantined funds at once.
+	BulkAccept(context.Context, *MsgBulkAccept) (*MsgBulkAcceptResponse, error)
+	// BulkDecline defines a method for declining many quarantined funds at once.
+	BulkDecline(context.Context, *MsgBulkDecline) (*MsgBulkDeclineResponse, error)
 	// UpdateAutoResponses defines a method for updating the auto-response settings for a quarantined address.
 	UpdateAutoResponses(context.Context, *MsgUpdateAutoResponses) (*MsgUpdateAutoResponsesResponse, error)
+	// UpdateAutoAcceptRules defines a method for replacing the auto-accept rules for a quarantined address.
+	UpdateAutoAcceptRules(context.Context, *MsgUpdateAutoAcceptRules) (*MsgUpdateAutoAcceptRulesResponse, error)
+	// UpdateFundsExpiration defines a method for setting how long funds can remain quarantined for an address.
+	UpdateFundsExpiration(context.Context, *MsgUpdateFundsExpiration) (*MsgUpdateFundsExpirationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -678,9 +1185,21 @@ func (*UnimplementedMsgServer) Accept(ctx context.Context, req *MsgAccept) (*Msg
 func (*UnimplementedMsgServer) Decline(ctx context.Context, req *MsgDecline) (*MsgDeclineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Decline not implemented")
 }
+func (*UnimplementedMsgServer) BulkAccept(ctx context.Context, req *MsgBulkAccept) (*MsgBulkAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAccept not implemented")
+}
+func (*UnimplementedMsgServer) BulkDecline(ctx context.Context, req *MsgBulkDecline) (*MsgBulkDeclineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDecline not implemented")
+}
 func (*UnimplementedMsgServer) UpdateAutoResponses(ctx context.Context, req *MsgUpdateAutoResponses) (*MsgUpdateAutoResponsesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoResponses not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAutoAcceptRules(ctx context.Context, req *MsgUpdateAutoAcceptRules) (*MsgUpdateAutoAcceptRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoAcceptRules not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFundsExpiration(ctx context.Context, req *MsgUpdateFundsExpiration) (*MsgUpdateFundsExpirationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFundsExpiration not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -758,6 +1277,42 @@ func _Msg_Decline_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BulkAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBulkAccept)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BulkAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.quarantine.v1beta1.Msg/BulkAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BulkAccept(ctx, req.(*MsgBulkAccept))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BulkDecline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBulkDecline)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BulkDecline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.quarantine.v1beta1.Msg/BulkDecline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BulkDecline(ctx, req.(*MsgBulkDecline))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateAutoResponses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateAutoResponses)
 	if err := dec(in); err != nil {
@@ -776,6 +1331,42 @@ func _Msg_UpdateAutoResponses_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAutoAcceptRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAutoAcceptRules)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAutoAcceptRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.quarantine.v1beta1.Msg/UpdateAutoAcceptRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAutoAcceptRules(ctx, req.(*MsgUpdateAutoAcceptRules))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFundsExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFundsExpiration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFundsExpiration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.quarantine.v1beta1.Msg/UpdateFundsExpiration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFundsExpiration(ctx, req.(*MsgUpdateFundsExpiration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.quarantine.v1beta1.Msg",
@@ -797,10 +1388,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Decline",
 			Handler:    _Msg_Decline_Handler,
 		},
+		{
+			MethodName: "BulkAccept",
+			Handler:    _Msg_BulkAccept_Handler,
+		},
+		{
+			MethodName: "BulkDecline",
+			Handler:    _Msg_BulkDecline_Handler,
+		},
 		{
 			MethodName: "UpdateAutoResponses",
 			Handler:    _Msg_UpdateAutoResponses_Handler,
 		},
+		{
+			MethodName: "UpdateAutoAcceptRules",
+			Handler:    _Msg_UpdateAutoAcceptRules_Handler,
+		},
+		{
+			MethodName: "UpdateFundsExpiration",
+			Handler:    _Msg_UpdateFundsExpiration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/quarantine/v1beta1/tx.proto",
@@ -1070,6 +1677,166 @@ func (m *MsgDeclineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBulkAccept) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBulkAccept) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBulkAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBulkAcceptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBulkAcceptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBulkAcceptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FundsReleased) > 0 {
+		for iNdEx := len(m.FundsReleased) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundsReleased[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBulkDecline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBulkDecline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBulkDecline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBulkDeclineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBulkDeclineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBulkDeclineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateAutoResponses) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1137,34 +1904,169 @@ func (m *MsgUpdateAutoResponsesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateAutoAcceptRules) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgOptIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgUpdateAutoAcceptRules) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOptInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateAutoAcceptRules) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAutoAcceptRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAutoAcceptRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAutoAcceptRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFundsExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFundsExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFundsExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackAddress) > 0 {
+		i -= len(m.FallbackAddress)
+		copy(dAtA[i:], m.FallbackAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFundsExpirationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFundsExpirationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFundsExpirationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgOptIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -1260,6 +2162,72 @@ func (m *MsgDeclineResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBulkAccept) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgBulkAcceptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FundsReleased) > 0 {
+		for _, e := range m.FundsReleased {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *MsgBulkDecline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Filter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgBulkDeclineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	return n
+}
+
 func (m *MsgUpdateAutoResponses) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1288,6 +2256,62 @@ func (m *MsgUpdateAutoResponsesResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateAutoAcceptRules) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAutoAcceptRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFundsExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FallbackAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFundsExpirationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1389,22 +2413,793 @@ func (m *MsgOptInResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAccept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAccept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddresses = append(m.FromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsReleased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsReleased = append(m.FundsReleased, types.Coin{})
+			if err := m.FundsReleased[len(m.FundsReleased)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDecline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDecline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDecline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddresses = append(m.FromAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeclineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeclineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeclineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBulkAccept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBulkAccept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBulkAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBulkAcceptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBulkAcceptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBulkAcceptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsReleased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsReleased = append(m.FundsReleased, types.Coin{})
+			if err := m.FundsReleased[len(m.FundsReleased)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1426,7 +3221,7 @@ func (m *MsgOptInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
+func (m *MsgBulkDecline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1449,10 +3244,10 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBulkDecline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBulkDecline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1487,6 +3282,58 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 			}
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1508,7 +3355,7 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBulkDeclineResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1531,12 +3378,31 @@ func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBulkDeclineResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBulkDeclineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1558,7 +3424,7 @@ func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAccept) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAutoResponses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1581,10 +3447,10 @@ func (m *MsgAccept) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAccept: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAutoResponses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAutoResponses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1621,9 +3487,9 @@ func (m *MsgAccept) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1633,44 +3499,26 @@ func (m *MsgAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddresses = append(m.FromAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Updates = append(m.Updates, &AutoResponseUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Permanent = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1692,7 +3540,7 @@ func (m *MsgAccept) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAutoResponsesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1715,46 +3563,12 @@ func (m *MsgAcceptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAutoResponsesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAutoResponsesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundsReleased", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FundsReleased = append(m.FundsReleased, types.Coin{})
-			if err := m.FundsReleased[len(m.FundsReleased)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1776,7 +3590,7 @@ func (m *MsgAcceptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecline) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAutoAcceptRules) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1799,10 +3613,10 @@ func (m *MsgDecline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecline: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAutoAcceptRules: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAutoAcceptRules: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1839,9 +3653,9 @@ func (m *MsgDecline) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1851,44 +3665,26 @@ func (m *MsgDecline) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddresses = append(m.FromAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Rules = append(m.Rules, AutoAcceptRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Permanent = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1910,7 +3706,7 @@ func (m *MsgDecline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeclineResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAutoAcceptRulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1933,10 +3729,10 @@ func (m *MsgDeclineResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeclineResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAutoAcceptRulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeclineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAutoAcceptRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1960,7 +3756,7 @@ func (m *MsgDeclineResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAutoResponses) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFundsExpiration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1983,10 +3779,10 @@ func (m *MsgUpdateAutoResponses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAutoResponses: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFundsExpiration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAutoResponses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFundsExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2023,7 +3819,7 @@ func (m *MsgUpdateAutoResponses) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2050,11 +3846,42 @@ func (m *MsgUpdateAutoResponses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, &AutoResponseUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2076,7 +3903,7 @@ func (m *MsgUpdateAutoResponses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAutoResponsesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFundsExpirationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,10 +3926,10 @@ func (m *MsgUpdateAutoResponsesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAutoResponsesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFundsExpirationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAutoResponsesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFundsExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
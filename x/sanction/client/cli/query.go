@@ -33,6 +33,7 @@ func QueryCmd() *cobra.Command {
 		QueryIsSanctionedCmd(),
 		QuerySanctionedAddressesCmd(),
 		QueryTemporaryEntriesCmd(),
+		QuerySanctionExpirationsCmd(),
 		QueryParamsCmd(),
 	)
 
@@ -188,6 +189,50 @@ Examples:
 	return cmd
 }
 
+// QuerySanctionExpirationsCmd returns a command for executing a SanctionExpirations query.
+func QuerySanctionExpirationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sanction-expirations",
+		Aliases: []string{"expirations"},
+		Short:   "List upcoming sanction expirations",
+		Long: fmt.Sprintf(`List the sanctions that will be automatically lifted, soonest first.
+
+Examples:
+  $ %[1]s sanction-expirations
+  $ %[1]s expirations
+`,
+			exampleQueryCmdBase),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := sanction.QuerySanctionExpirationsRequest{}
+
+			req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var res *sanction.QuerySanctionExpirationsResponse
+			queryClient := sanction.NewQueryClient(clientCtx)
+			res, err = queryClient.SanctionExpirations(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sanction-expirations")
+
+	return cmd
+}
+
 // QueryParamsCmd returns a command for executing a Params query.
 func QueryParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
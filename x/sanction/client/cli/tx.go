@@ -2,8 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,6 +17,9 @@ import (
 	"github.com/provenance-io/provenance/x/sanction"
 )
 
+// FlagExpiresAt is the flag for providing the time at which a sanction will be lifted.
+const FlagExpiresAt = "expires-at"
+
 var (
 	// exampleTxCmdBase is the base command that gets a user to one of the tx commands in here.
 	exampleTxCmdBase = fmt.Sprintf("%s tx %s", version.AppName, sanction.ModuleName)
@@ -50,12 +55,14 @@ func TxSanctionCmd() *cobra.Command {
 		Short: "Submit a governance proposal to sanction one or more addresses",
 		Long: `Submit a governance proposal to sanction one or more addresses.
 At least one address is required; any number of addresses can be provided.
-Each address should be a valid bech32 encoded string.`,
+Each address should be a valid bech32 encoded string.
+If --` + FlagExpiresAt + ` is provided, the sanctions will be automatically lifted at that time (RFC 3339 format).`,
 		Example: fmt.Sprintf(`
 $ %[1]s sanction %[2]s
 $ %[1]s sanction %[3]s %[2]s
+$ %[1]s sanction %[2]s --%[4]s 2030-01-01T00:00:00Z
 `,
-			exampleTxCmdBase, exampleTxAddr1, exampleTxAddr2),
+			exampleTxCmdBase, exampleTxAddr1, exampleTxAddr2, FlagExpiresAt),
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -69,6 +76,10 @@ $ %[1]s sanction %[3]s %[2]s
 				Addresses: args,
 				Authority: provcli.GetAuthority(flagSet),
 			}
+			msgSanction.ExpiresAt, err = ReadFlagExpiresAt(flagSet)
+			if err != nil {
+				return err
+			}
 			if err = msgSanction.ValidateBasic(); err != nil {
 				return err
 			}
@@ -80,10 +91,25 @@ $ %[1]s sanction %[3]s %[2]s
 	flags.AddTxFlagsToCmd(cmd)
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
+	cmd.Flags().String(FlagExpiresAt, "", "The time (RFC 3339) at which the sanctions will be automatically lifted")
 
 	return cmd
 }
 
+// ReadFlagExpiresAt reads the --expires-at flag as a time.
+// Returns nil if the flag wasn't provided.
+func ReadFlagExpiresAt(flagSet *pflag.FlagSet) (*time.Time, error) {
+	value, err := flagSet.GetString(FlagExpiresAt)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	expiresAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s value %q: %w", FlagExpiresAt, value, err)
+	}
+	return &expiresAt, nil
+}
+
 // TxUnsanctionCmd returns the command for submitting a MsgUnsanction governance proposal tx.
 func TxUnsanctionCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -12,4 +12,5 @@ var (
 	ErrUnsanctionableAddr = errors.Register(sanctionCodespace, 3, "address cannot be sanctioned")
 	ErrInvalidTempStatus  = errors.Register(sanctionCodespace, 4, "invalid temp status")
 	ErrSanctionedAccount  = errors.Register(sanctionCodespace, 5, "account is sanctioned")
+	ErrInvalidExpiration  = errors.Register(sanctionCodespace, 6, "invalid sanction expiration")
 )
@@ -40,5 +40,14 @@ func (g GenesisState) Validate() error {
 			return sdkerrors.ErrInvalidAddress.Wrapf("temporary entries[%d], %q: %v", i, entry.Address, err)
 		}
 	}
+	for i, entry := range g.SanctionExpirations {
+		_, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("sanction expirations[%d], %q: %v", i, entry.Address, err)
+		}
+		if entry.ExpiresAt.IsZero() {
+			return errors.ErrInvalidExpiration.Wrapf("sanction expirations[%d]: expires at cannot be zero", i)
+		}
+	}
 	return nil
 }
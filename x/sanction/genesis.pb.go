@@ -31,6 +31,8 @@ type GenesisState struct {
 	SanctionedAddresses []string `protobuf:"bytes,2,rep,name=sanctioned_addresses,json=sanctionedAddresses,proto3" json:"sanctioned_addresses,omitempty"`
 	// temporary_entries defines the temporary entries associated with on-going governance proposals.
 	TemporaryEntries []*TemporaryEntry `protobuf:"bytes,3,rep,name=temporary_entries,json=temporaryEntries,proto3" json:"temporary_entries,omitempty"`
+	// sanction_expirations defines the end times of the sanctions that will be automatically lifted.
+	SanctionExpirations []*SanctionExpiration `protobuf:"bytes,4,rep,name=sanction_expirations,json=sanctionExpirations,proto3" json:"sanction_expirations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +89,13 @@ func (m *GenesisState) GetTemporaryEntries() []*TemporaryEntry {
 	return nil
 }
 
+func (m *GenesisState) GetSanctionExpirations() []*SanctionExpiration {
+	if m != nil {
+		return m.SanctionExpirations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.sanction.v1beta1.GenesisState")
 }
@@ -96,26 +105,28 @@ func init() {
 }
 
 var fileDescriptor_78e0ba43b92003f6 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xbd, 0x4e, 0xf3, 0x30,
-	0x18, 0x85, 0xe3, 0x2f, 0x52, 0xa5, 0x2f, 0x65, 0x80, 0x50, 0x89, 0xd0, 0xc1, 0x44, 0x48, 0x40,
-	0x96, 0xda, 0x6a, 0x19, 0x98, 0x5b, 0x09, 0x81, 0xc4, 0x82, 0xd2, 0x4e, 0x2c, 0x91, 0x93, 0xbc,
-	0x0a, 0x1e, 0x62, 0x47, 0xb6, 0xa9, 0xe8, 0x5d, 0x70, 0x31, 0x5c, 0x04, 0x63, 0xc5, 0xc4, 0x88,
-	0x92, 0x8d, 0xab, 0x40, 0xca, 0x4f, 0xe9, 0x92, 0xf1, 0xc8, 0xcf, 0x73, 0x5e, 0xeb, 0x38, 0x17,
-	0x89, 0xd4, 0xb9, 0xd4, 0x54, 0x33, 0x91, 0x18, 0x2e, 0x05, 0x5d, 0x4f, 0x63, 0x30, 0x6c, 0x4a,
-	0x33, 0x10, 0xa0, 0xb9, 0x26, 0x85, 0x92, 0x46, 0xba, 0x27, 0x0d, 0x46, 0x3a, 0x8c, 0xb4, 0xd8,
-	0xf8, 0xb2, 0xcf, 0xdf, 0x91, 0x75, 0xc1, 0xf8, 0xb4, 0xe1, 0xa2, 0x3a, 0xd1, 0xb6, 0xad, 0x0e,
-	0xe7, 0x3f, 0xc8, 0x39, 0xb8, 0x6b, 0xae, 0x2d, 0x0d, 0x33, 0xe0, 0xde, 0x38, 0x83, 0x82, 0x29,
-	0x96, 0x6b, 0x0f, 0xf9, 0x28, 0x18, 0xce, 0xce, 0x48, 0xcf, 0x75, 0xf2, 0x58, 0x63, 0x61, 0x8b,
-	0xbb, 0x0f, 0xce, 0xa8, 0x43, 0x20, 0x8d, 0x58, 0x9a, 0x2a, 0xd0, 0x1a, 0xb4, 0xf7, 0xcf, 0xb7,
-	0x83, 0xff, 0x0b, 0xef, 0xf3, 0x7d, 0x32, 0x6a, 0x9b, 0xe6, 0xcd, 0xdb, 0xd2, 0x28, 0x2e, 0xb2,
-	0xf0, 0xf8, 0xcf, 0x9a, 0x77, 0x92, 0xbb, 0x72, 0x8e, 0x0c, 0xe4, 0x85, 0x54, 0x4c, 0x6d, 0x22,
-	0x10, 0x46, 0x71, 0xd0, 0x9e, 0xed, 0xdb, 0xc1, 0x70, 0x76, 0xd5, 0xfb, 0xa1, 0x55, 0x67, 0xdc,
-	0x0a, 0xa3, 0x36, 0xe1, 0xa1, 0xd9, 0xcf, 0x1c, 0xf4, 0xe2, 0xfe, 0xa3, 0xc4, 0x68, 0x5b, 0x62,
-	0xf4, 0x5d, 0x62, 0xf4, 0x56, 0x61, 0x6b, 0x5b, 0x61, 0xeb, 0xab, 0xc2, 0xd6, 0x13, 0xc9, 0xb8,
-	0x79, 0x7e, 0x89, 0x49, 0x22, 0x73, 0x5a, 0x28, 0xb9, 0x06, 0xc1, 0x44, 0x02, 0x13, 0x2e, 0xf7,
-	0x12, 0x7d, 0xdd, 0xed, 0x1a, 0x0f, 0xea, 0xf5, 0xae, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e,
-	0x07, 0x52, 0x36, 0xc2, 0x01, 0x00, 0x00,
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0x32, 0x41,
+	0x14, 0xc5, 0x59, 0xf8, 0x42, 0xf2, 0x2d, 0x16, 0xba, 0x92, 0xb8, 0x52, 0x8c, 0xc4, 0x44, 0x25,
+	0x31, 0xcc, 0x04, 0x2c, 0xac, 0x21, 0x21, 0x9a, 0xd8, 0x18, 0xa0, 0xb2, 0x70, 0x33, 0x2c, 0x37,
+	0x38, 0xc5, 0xce, 0x6c, 0xe6, 0x8e, 0x04, 0xde, 0xc2, 0x87, 0xf1, 0x21, 0x8c, 0x15, 0xb1, 0xb2,
+	0x34, 0xf0, 0x22, 0xc6, 0xd9, 0x7f, 0x34, 0xdb, 0xcd, 0xb9, 0xf3, 0x3b, 0xe7, 0x9e, 0xe4, 0xba,
+	0x17, 0xa1, 0xc2, 0x48, 0x21, 0x43, 0x2e, 0x43, 0x23, 0x94, 0x64, 0xcb, 0xde, 0x0c, 0x0c, 0xef,
+	0xb1, 0x05, 0x48, 0x40, 0x81, 0x34, 0xd6, 0xca, 0x28, 0xef, 0x24, 0xc1, 0x68, 0x86, 0xd1, 0x14,
+	0x6b, 0x5d, 0x96, 0xf9, 0x73, 0xd2, 0x06, 0xb4, 0x4e, 0x13, 0x2e, 0xb0, 0x8a, 0xa5, 0x69, 0x56,
+	0x9c, 0x7f, 0x56, 0xdd, 0x83, 0xbb, 0x64, 0xdb, 0xc4, 0x70, 0x03, 0xde, 0xad, 0x5b, 0x8f, 0xb9,
+	0xe6, 0x11, 0xfa, 0x4e, 0xdb, 0xe9, 0x34, 0xfa, 0x67, 0xb4, 0x64, 0x3b, 0x7d, 0xb4, 0xd8, 0x38,
+	0xc5, 0xbd, 0x07, 0xb7, 0x99, 0x21, 0x30, 0x0f, 0xf8, 0x7c, 0xae, 0x01, 0x11, 0xd0, 0xaf, 0xb6,
+	0x6b, 0x9d, 0xff, 0x43, 0xff, 0xeb, 0xbd, 0xdb, 0x4c, 0x93, 0x06, 0xc9, 0xdf, 0xc4, 0x68, 0x21,
+	0x17, 0xe3, 0xe3, 0xc2, 0x35, 0xc8, 0x4c, 0xde, 0xd4, 0x3d, 0x32, 0x10, 0xc5, 0x4a, 0x73, 0xbd,
+	0x0e, 0x40, 0x1a, 0x2d, 0x00, 0xfd, 0x5a, 0xbb, 0xd6, 0x69, 0xf4, 0xaf, 0x4a, 0x0b, 0x4d, 0x33,
+	0xc7, 0x48, 0x1a, 0xbd, 0x1e, 0x1f, 0x9a, 0x7d, 0x2d, 0x00, 0xbd, 0xe7, 0xa2, 0x62, 0x00, 0xab,
+	0x58, 0x68, 0xfe, 0xf7, 0x44, 0xff, 0x9f, 0x0d, 0xbe, 0x2e, 0x0d, 0x9e, 0xa4, 0x83, 0x51, 0xee,
+	0x29, 0x5a, 0x17, 0x33, 0x1c, 0xde, 0x7f, 0x6c, 0x89, 0xb3, 0xd9, 0x12, 0xe7, 0x67, 0x4b, 0x9c,
+	0xb7, 0x1d, 0xa9, 0x6c, 0x76, 0xa4, 0xf2, 0xbd, 0x23, 0x95, 0x27, 0xba, 0x10, 0xe6, 0xe5, 0x75,
+	0x46, 0x43, 0x15, 0xb1, 0x58, 0xab, 0x25, 0x48, 0x2e, 0x43, 0xe8, 0x0a, 0xb5, 0xa7, 0xd8, 0x2a,
+	0xbf, 0xdb, 0xac, 0x6e, 0xaf, 0x73, 0xf3, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xb0, 0xa6, 0x5a,
+	0x22, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +149,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SanctionExpirations) > 0 {
+		for iNdEx := len(m.SanctionExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SanctionExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.TemporaryEntries) > 0 {
 		for iNdEx := len(m.TemporaryEntries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -209,6 +234,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SanctionExpirations) > 0 {
+		for _, e := range m.SanctionExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -349,6 +380,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SanctionExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SanctionExpirations = append(m.SanctionExpirations, &SanctionExpiration{})
+			if err := m.SanctionExpirations[len(m.SanctionExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
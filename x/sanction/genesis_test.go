@@ -2,6 +2,7 @@ package sanction_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -531,6 +532,35 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			exp: []string{"temporary entries[4]", `"Woops. This isn't right."`, "invalid address", "decoding bech32 failed"},
 		},
+		{
+			name: "valid sanction expirations",
+			gs: &sanction.GenesisState{
+				SanctionExpirations: []*sanction.SanctionExpiration{
+					{Address: sdk.AccAddress("expaddr0____________").String(), ExpiresAt: time.Unix(1_700_000_000, 0)},
+					{Address: sdk.AccAddress("expaddr1____________").String(), ExpiresAt: time.Unix(1_800_000_000, 0)},
+				},
+			},
+			exp: nil,
+		},
+		{
+			name: "invalid sanction expiration bad addr",
+			gs: &sanction.GenesisState{
+				SanctionExpirations: []*sanction.SanctionExpiration{
+					{Address: sdk.AccAddress("expaddr0____________").String(), ExpiresAt: time.Unix(1_700_000_000, 0)},
+					{Address: "bad1expaddr", ExpiresAt: time.Unix(1_800_000_000, 0)},
+				},
+			},
+			exp: []string{"sanction expirations[1]", `"bad1expaddr"`, "invalid address", "decoding bech32 failed"},
+		},
+		{
+			name: "invalid sanction expiration zero time",
+			gs: &sanction.GenesisState{
+				SanctionExpirations: []*sanction.SanctionExpiration{
+					{Address: sdk.AccAddress("expaddr0____________").String()},
+				},
+			},
+			exp: []string{"invalid sanction expiration", "sanction expirations[0]", "expires at cannot be zero"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,6 +31,19 @@ func (k Keeper) InitGenesis(origCtx sdk.Context, genState *sanction.GenesisState
 		panic(fmt.Errorf("error sanctioning addresses: %w", err))
 	}
 
+	// The expirations must be set before the temporary entries since sanctioning clears them.
+	for i, entry := range genState.SanctionExpirations {
+		var addr sdk.AccAddress
+		addr, err = sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			panic(fmt.Errorf("invalid sanction expiration[%d]: invalid address: %w", i, err))
+		}
+		err = k.SanctionAddressesUntil(ctx, entry.ExpiresAt, addr)
+		if err != nil {
+			panic(fmt.Errorf("error adding sanction expiration[%d]: %w", i, err))
+		}
+	}
+
 	for i, entry := range genState.TemporaryEntries {
 		var addr sdk.AccAddress
 		addr, err = sdk.AccAddressFromBech32(entry.Address)
@@ -58,7 +72,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *sanction.GenesisState {
 	params := k.GetParams(ctx)
 	sanctionedAddrs := k.GetAllSanctionedAddresses(ctx)
 	tempEntries := k.GetAllTemporaryEntries(ctx)
-	return sanction.NewGenesisState(params, sanctionedAddrs, tempEntries)
+	genState := sanction.NewGenesisState(params, sanctionedAddrs, tempEntries)
+	genState.SanctionExpirations = k.GetAllSanctionExpirations(ctx)
+	return genState
 }
 
 // GetAllSanctionedAddresses gets the bech32 string of every account that is sanctioned.
@@ -90,3 +106,17 @@ func (k Keeper) GetAllTemporaryEntries(ctx sdk.Context) []*sanction.TemporaryEnt
 	})
 	return rv
 }
+
+// GetAllSanctionExpirations gets all the sanction expirations.
+// This is designed for use with ExportGenesis. See also IterateSanctionExpirations.
+func (k Keeper) GetAllSanctionExpirations(ctx sdk.Context) []*sanction.SanctionExpiration {
+	var rv []*sanction.SanctionExpiration
+	k.IterateSanctionExpirations(ctx, func(addr sdk.AccAddress, expiresAt time.Time) bool {
+		rv = append(rv, &sanction.SanctionExpiration{
+			Address:   addr.String(),
+			ExpiresAt: expiresAt,
+		})
+		return false
+	})
+	return rv
+}
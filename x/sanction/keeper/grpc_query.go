@@ -94,6 +94,37 @@ func (k Keeper) TemporaryEntries(goCtx context.Context, req *sanction.QueryTempo
 	return resp, nil
 }
 
+func (k Keeper) SanctionExpirations(goCtx context.Context, req *sanction.QuerySanctionExpirationsRequest) (*sanction.QuerySanctionExpirationsResponse, error) {
+	var err error
+	var pagination *query.PageRequest
+	if req != nil {
+		pagination = req.Pagination
+	}
+
+	resp := &sanction.QuerySanctionExpirationsResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := k.getExpirationIndexPrefixStore(ctx)
+	resp.Pagination, err = query.Paginate(
+		store, pagination,
+		func(key, _ []byte) error {
+			expiresAt, addr, perr := ParseExpirationIndexKey(ConcatBz(ExpirationIndexPrefix, key))
+			if perr != nil {
+				return perr
+			}
+			resp.Expirations = append(resp.Expirations, &sanction.SanctionExpiration{
+				Address:   addr.String(),
+				ExpiresAt: expiresAt,
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
 func (k Keeper) Params(goCtx context.Context, _ *sanction.QueryParamsRequest) (*sanction.QueryParamsResponse, error) {
 	resp := &sanction.QueryParamsResponse{}
 	ctx := sdk.UnwrapSDKContext(goCtx)
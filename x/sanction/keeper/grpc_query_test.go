@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -824,6 +825,82 @@ func (s *QueryTestSuite) TestKeeper_TemporaryEntries() {
 	}
 }
 
+func (s *QueryTestSuite) TestKeeper_SanctionExpirations() {
+	addr1 := sdk.AccAddress("1_addr_made_for_test")
+	addr2 := sdk.AccAddress("2_addr_made_for_test")
+	addr3 := sdk.AccAddress("3_addr_made_for_test")
+	soon := s.BlockTime.Add(time.Hour).UTC()
+	later := s.BlockTime.Add(48 * time.Hour).UTC()
+
+	iniState := &sanction.GenesisState{
+		SanctionedAddresses: []string{addr1.String()},
+		SanctionExpirations: []*sanction.SanctionExpiration{
+			{Address: addr2.String(), ExpiresAt: later},
+			{Address: addr3.String(), ExpiresAt: soon},
+		},
+	}
+	allExps := []*sanction.SanctionExpiration{
+		{Address: addr3.String(), ExpiresAt: soon},
+		{Address: addr2.String(), ExpiresAt: later},
+	}
+
+	tests := []struct {
+		name     string
+		iniState *sanction.GenesisState
+		req      *sanction.QuerySanctionExpirationsRequest
+		exp      *sanction.QuerySanctionExpirationsResponse
+	}{
+		{
+			name:     "nil req nothing to return",
+			iniState: nil,
+			req:      nil,
+			exp: &sanction.QuerySanctionExpirationsResponse{
+				Pagination: &query.PageResponse{NextKey: nil, Total: 0},
+			},
+		},
+		{
+			name:     "nil req stuff to return",
+			iniState: iniState,
+			req:      nil,
+			exp: &sanction.QuerySanctionExpirationsResponse{
+				Expirations: allExps,
+				Pagination:  &query.PageResponse{NextKey: nil, Total: 2},
+			},
+		},
+		{
+			name:     "limit 1",
+			iniState: iniState,
+			req:      &sanction.QuerySanctionExpirationsRequest{Pagination: &query.PageRequest{Limit: 1}},
+			exp: &sanction.QuerySanctionExpirationsResponse{
+				Expirations: allExps[:1],
+				Pagination: &query.PageResponse{
+					NextKey: keeper.CreateExpirationIndexKey(later, addr2)[1:],
+					Total:   0,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.ClearState()
+			if tc.iniState != nil {
+				s.Require().NotPanics(func() {
+					s.Keeper.InitGenesis(s.SdkCtx, tc.iniState)
+				}, "InitGenesis")
+			}
+
+			var resp *sanction.QuerySanctionExpirationsResponse
+			s.RequireNotPanicsNoError(func() error {
+				var err error
+				resp, err = s.Keeper.SanctionExpirations(s.StdlibCtx, tc.req)
+				return err
+			}, "SanctionExpirations")
+			s.Assert().Equal(tc.exp, resp, "SanctionExpirations response")
+		})
+	}
+}
+
 func (s *QueryTestSuite) TestKeeper_Params() {
 	origMinSanct := sanction.DefaultImmediateSanctionMinDeposit
 	origMinUnsanct := sanction.DefaultImmediateUnsanctionMinDeposit
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -81,7 +82,7 @@ func (k Keeper) IsSanctionedAddr(goCtx context.Context, addr sdk.AccAddress) boo
 }
 
 // SanctionAddresses creates permanent sanctioned address entries for each of the provided addresses.
-// Also deletes any temporary entries for each address.
+// Also deletes any temporary entries and expirations for each address.
 func (k Keeper) SanctionAddresses(ctx sdk.Context, addrs ...sdk.AccAddress) error {
 	store := ctx.KVStore(k.storeKey)
 	val := []byte{SanctionB}
@@ -94,18 +95,36 @@ func (k Keeper) SanctionAddresses(ctx sdk.Context, addrs ...sdk.AccAddress) erro
 		if err := ctx.EventManager().EmitTypedEvent(sanction.NewEventAddressSanctioned(addr)); err != nil {
 			return err
 		}
+		k.deleteSanctionExpiration(store, addr)
 	}
 	k.DeleteAddrTempEntries(ctx, addrs...)
 	return nil
 }
 
-// UnsanctionAddresses deletes any sanctioned address entries for each provided address.
+// SanctionAddressesUntil creates sanctioned address entries for each of the provided addresses
+// that will be automatically removed once the block time reaches expiresAt.
 // Also deletes any temporary entries for each address.
+func (k Keeper) SanctionAddressesUntil(ctx sdk.Context, expiresAt time.Time, addrs ...sdk.AccAddress) error {
+	if err := k.SanctionAddresses(ctx, addrs...); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	val := sdk.FormatTimeBytes(expiresAt)
+	for _, addr := range addrs {
+		store.Set(CreateExpirationKey(addr), val)
+		store.Set(CreateExpirationIndexKey(expiresAt, addr), []byte{0x00})
+	}
+	return nil
+}
+
+// UnsanctionAddresses deletes any sanctioned address entries for each provided address.
+// Also deletes any temporary entries and expirations for each address.
 func (k Keeper) UnsanctionAddresses(ctx sdk.Context, addrs ...sdk.AccAddress) error {
 	store := ctx.KVStore(k.storeKey)
 	for _, addr := range addrs {
 		key := CreateSanctionedAddrKey(addr)
 		store.Delete(key)
+		k.deleteSanctionExpiration(store, addr)
 		if err := ctx.EventManager().EmitTypedEvent(sanction.NewEventAddressUnsanctioned(addr)); err != nil {
 			return err
 		}
@@ -279,6 +298,84 @@ func (k Keeper) IterateProposalIndexEntries(ctx sdk.Context, govPropID *uint64,
 	}
 }
 
+// GetSanctionExpiration gets the time at which the given address's sanction will be automatically lifted.
+// Returns nil if the address does not have a sanction expiration.
+func (k Keeper) GetSanctionExpiration(ctx sdk.Context, addr sdk.AccAddress) *time.Time {
+	store := ctx.KVStore(k.storeKey)
+	return getExpirationTime(store, addr)
+}
+
+// getExpirationTime reads the expiration time for the given address from the store.
+func getExpirationTime(store storetypes.KVStore, addr sdk.AccAddress) *time.Time {
+	bz := store.Get(CreateExpirationKey(addr))
+	if len(bz) == 0 {
+		return nil
+	}
+	expiresAt, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return nil
+	}
+	return &expiresAt
+}
+
+// deleteSanctionExpiration deletes the expiration and expiration index entries for the given address.
+func (k Keeper) deleteSanctionExpiration(store storetypes.KVStore, addr sdk.AccAddress) {
+	expiresAt := getExpirationTime(store, addr)
+	if expiresAt == nil {
+		return
+	}
+	store.Delete(CreateExpirationKey(addr))
+	store.Delete(CreateExpirationIndexKey(*expiresAt, addr))
+}
+
+// getExpirationIndexPrefixStore returns a kv store prefixed for the sanction expiration index entries.
+func (k Keeper) getExpirationIndexPrefixStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), ExpirationIndexPrefix)
+}
+
+// IterateSanctionExpirations iterates over all of the sanction expirations, soonest first.
+// The callback takes in the address and the time its sanction expires.
+// The callback should return whether to stop iteration (true = stop, false = keep going).
+func (k Keeper) IterateSanctionExpirations(ctx sdk.Context, cb func(addr sdk.AccAddress, expiresAt time.Time) (stop bool)) {
+	store := k.getExpirationIndexPrefixStore(ctx)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		expiresAt, addr, err := ParseExpirationIndexKey(ConcatBz(ExpirationIndexPrefix, iter.Key()))
+		if err != nil {
+			continue
+		}
+		if cb(addr, expiresAt) {
+			break
+		}
+	}
+}
+
+// UnsanctionExpiredAddresses removes the sanction from all addresses with a sanction expiration at or before the current block time.
+// Temporary entries are left alone since they are tied to governance proposals that are still being voted on.
+func (k Keeper) UnsanctionExpiredAddresses(ctx sdk.Context) error {
+	var expired []sdk.AccAddress
+	blockTime := ctx.BlockTime()
+	k.IterateSanctionExpirations(ctx, func(addr sdk.AccAddress, expiresAt time.Time) bool {
+		if expiresAt.After(blockTime) {
+			return true
+		}
+		expired = append(expired, addr)
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range expired {
+		store.Delete(CreateSanctionedAddrKey(addr))
+		k.deleteSanctionExpiration(store, addr)
+		if err := ctx.EventManager().EmitTypedEvent(sanction.NewEventAddressUnsanctioned(addr)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // IsAddrThatCannotBeSanctioned returns true if the provided address is one of the ones that cannot be sanctioned.
 // Returns false if the addr can be sanctioned.
 func (k Keeper) IsAddrThatCannotBeSanctioned(addr sdk.AccAddress) bool {
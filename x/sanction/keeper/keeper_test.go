@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -2392,3 +2393,85 @@ func (s *KeeperTestSuite) Test_toAccAddrs() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestKeeper_SanctionExpirations() {
+	soon := s.BlockTime.Add(time.Hour)
+	later := s.BlockTime.Add(2 * time.Hour)
+
+	getExpirations := func() []*sanction.SanctionExpiration {
+		var rv []*sanction.SanctionExpiration
+		s.Keeper.IterateSanctionExpirations(s.SdkCtx, func(addr sdk.AccAddress, expiresAt time.Time) bool {
+			rv = append(rv, &sanction.SanctionExpiration{Address: addr.String(), ExpiresAt: expiresAt})
+			return false
+		})
+		return rv
+	}
+
+	s.Run("sanction until", func() {
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.SanctionAddressesUntil(s.SdkCtx, later, s.addr1, s.addr2)
+		}, "SanctionAddressesUntil(later, addr1, addr2)")
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.SanctionAddressesUntil(s.SdkCtx, soon, s.addr3)
+		}, "SanctionAddressesUntil(soon, addr3)")
+		s.ReqOKAddPermSanct("s.addr4", s.addr4)
+
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr3), "IsSanctionedAddr(addr3)")
+		s.Assert().Equal(&later, s.Keeper.GetSanctionExpiration(s.SdkCtx, s.addr1), "GetSanctionExpiration(addr1)")
+		s.Assert().Equal(&soon, s.Keeper.GetSanctionExpiration(s.SdkCtx, s.addr3), "GetSanctionExpiration(addr3)")
+		s.Assert().Nil(s.Keeper.GetSanctionExpiration(s.SdkCtx, s.addr4), "GetSanctionExpiration(addr4)")
+
+		expected := []*sanction.SanctionExpiration{
+			{Address: s.addr3.String(), ExpiresAt: soon},
+			{Address: s.addr1.String(), ExpiresAt: later},
+			{Address: s.addr2.String(), ExpiresAt: later},
+		}
+		// Entries with the same time are ordered by address.
+		if bytes.Compare(s.addr1, s.addr2) > 0 {
+			expected[1], expected[2] = expected[2], expected[1]
+		}
+		s.Assert().Equal(expected, getExpirations(), "sanction expirations")
+	})
+
+	s.Run("permanent sanction clears expiration", func() {
+		s.ReqOKAddPermSanct("s.addr2", s.addr2)
+		s.Assert().Nil(s.Keeper.GetSanctionExpiration(s.SdkCtx, s.addr2), "GetSanctionExpiration(addr2)")
+		expected := []*sanction.SanctionExpiration{
+			{Address: s.addr3.String(), ExpiresAt: soon},
+			{Address: s.addr1.String(), ExpiresAt: later},
+		}
+		s.Assert().Equal(expected, getExpirations(), "sanction expirations")
+	})
+
+	s.Run("nothing expired yet", func() {
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.UnsanctionExpiredAddresses(s.SdkCtx)
+		}, "UnsanctionExpiredAddresses")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr3), "IsSanctionedAddr(addr3)")
+		s.Assert().Len(getExpirations(), 2, "sanction expirations")
+	})
+
+	s.Run("first one expires", func() {
+		s.ReqOKAddTempUnsanct(1, "s.addr3", s.addr3)
+		ctx := s.SdkCtx.WithBlockTime(soon).WithEventManager(sdk.NewEventManager())
+		s.RequireNotPanicsNoError(func() error {
+			return s.Keeper.UnsanctionExpiredAddresses(ctx)
+		}, "UnsanctionExpiredAddresses")
+		s.Assert().False(s.GetStore().Has(keeper.CreateSanctionedAddrKey(s.addr3)), "addr3 sanctioned entry exists")
+		s.Assert().Nil(s.Keeper.GetSanctionExpiration(s.SdkCtx, s.addr3), "GetSanctionExpiration(addr3)")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr1), "IsSanctionedAddr(addr1)")
+		s.Assert().Equal([]*sanction.TemporaryEntry{newTempEntry(s.addr3, 1, false)}, s.GetAllTempEntries(), "temp entries")
+
+		event, err := sdk.TypedEventToEvent(sanction.NewEventAddressUnsanctioned(s.addr3))
+		s.Require().NoError(err, "TypedEventToEvent NewEventAddressUnsanctioned")
+		s.Assert().Equal(sdk.Events{event}, ctx.EventManager().Events(), "events emitted")
+	})
+
+	s.Run("unsanction clears expiration", func() {
+		s.ReqOKAddPermUnsanct("s.addr1", s.addr1)
+		s.Assert().Nil(s.Keeper.GetSanctionExpiration(s.SdkCtx, s.addr1), "GetSanctionExpiration(addr1)")
+		s.Assert().Empty(getExpirations(), "sanction expirations")
+		s.Assert().True(s.Keeper.IsSanctionedAddr(s.SdkCtx, s.addr4), "IsSanctionedAddr(addr4)")
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -21,11 +22,17 @@ import (
 // - 0x02<addr len (1 byte)><addr><gov prop id (8 bytes)> -> 0x01 or 0x00
 // Proposal id temp sanction index:
 // - 0x03<proposal id (8 bytes)><addr len (1 byte)><addr> -> 0x00 or 0x01
+// Sanction expirations:
+// - 0x04<addr len (1 byte)><addr> -> <expiration time bytes>
+// Expiration time index:
+// - 0x05<expiration time bytes><addr len (1 byte)><addr> -> 0x00
 var (
-	ParamsPrefix        = []byte{0x00}
-	SanctionedPrefix    = []byte{0x01}
-	TemporaryPrefix     = []byte{0x02}
-	ProposalIndexPrefix = []byte{0x03}
+	ParamsPrefix          = []byte{0x00}
+	SanctionedPrefix      = []byte{0x01}
+	TemporaryPrefix       = []byte{0x02}
+	ProposalIndexPrefix   = []byte{0x03}
+	ExpirationPrefix      = []byte{0x04}
+	ExpirationIndexPrefix = []byte{0x05}
 )
 
 const (
@@ -190,3 +197,44 @@ func ParseProposalTempIndexKey(key []byte) (uint64, sdk.AccAddress) {
 	addr, _ := ParseLengthPrefixedBz(key[9:])
 	return govPropID, addr
 }
+
+// CreateExpirationKey creates the sanction expiration key for the provided address.
+//
+// - 0x04<addr len (1 byte)><addr>
+func CreateExpirationKey(addr sdk.AccAddress) []byte {
+	return ConcatBz(ExpirationPrefix, address.MustLengthPrefix(addr))
+}
+
+// ParseExpirationKey extracts the address from the provided sanction expiration key.
+func ParseExpirationKey(key []byte) sdk.AccAddress {
+	addr, _ := ParseLengthPrefixedBz(key[1:])
+	return addr
+}
+
+// CreateExpirationIndexTimePrefix creates a key prefix for the expiration index entries at the given time.
+//
+// - 0x05<expiration time bytes>
+func CreateExpirationIndexTimePrefix(expiresAt time.Time) []byte {
+	return concatBzPlusCap(ExpirationIndexPrefix, sdk.FormatTimeBytes(expiresAt), 33)
+}
+
+// CreateExpirationIndexKey creates a key for an expiration time + addr index entry.
+//
+// - 0x05<expiration time bytes><addr len (1 byte)><addr>
+func CreateExpirationIndexKey(expiresAt time.Time, addr sdk.AccAddress) []byte {
+	return append(CreateExpirationIndexTimePrefix(expiresAt), address.MustLengthPrefix(addr)...)
+}
+
+// ParseExpirationIndexKey extracts the expiration time and address from the provided expiration index key.
+func ParseExpirationIndexKey(key []byte) (time.Time, sdk.AccAddress, error) {
+	timeLen := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(key) < 1+timeLen+1 {
+		return time.Time{}, nil, fmt.Errorf("invalid expiration index key length %d", len(key))
+	}
+	expiresAt, err := sdk.ParseTimeBytes(key[1 : 1+timeLen])
+	if err != nil {
+		return time.Time{}, nil, fmt.Errorf("invalid expiration index key time: %w", err)
+	}
+	addr, _ := ParseLengthPrefixedBz(key[1+timeLen:])
+	return expiresAt, addr, nil
+}
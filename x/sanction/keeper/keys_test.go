@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +28,8 @@ func TestPrefixValues(t *testing.T) {
 		{name: "SanctionedPrefix", prefix: keeper.SanctionedPrefix, expected: []byte{0x01}},
 		{name: "TemporaryPrefix", prefix: keeper.TemporaryPrefix, expected: []byte{0x02}},
 		{name: "ProposalIndexPrefix", prefix: keeper.ProposalIndexPrefix, expected: []byte{0x03}},
+		{name: "ExpirationPrefix", prefix: keeper.ExpirationPrefix, expected: []byte{0x04}},
+		{name: "ExpirationIndexPrefix", prefix: keeper.ExpirationIndexPrefix, expected: []byte{0x05}},
 	}
 
 	for i, p := range prefixes {
@@ -1074,3 +1078,49 @@ func TestParseProposalTempIndexKey(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateParseExpirationKey(t *testing.T) {
+	addr := sdk.AccAddress("expiration_key_addr_")
+	expected := append([]byte{0x04, byte(len(addr))}, addr...)
+
+	key := keeper.CreateExpirationKey(addr)
+	assert.Equal(t, expected, key, "CreateExpirationKey")
+	actual := keeper.ParseExpirationKey(key)
+	assert.Equal(t, addr, actual, "ParseExpirationKey")
+}
+
+func TestCreateParseExpirationIndexKey(t *testing.T) {
+	addr := sdk.AccAddress("expiration_idx_addr_")
+	expiresAt := time.Date(2030, 6, 15, 12, 30, 0, 0, time.UTC)
+	timeBz := sdk.FormatTimeBytes(expiresAt)
+
+	t.Run("time prefix", func(t *testing.T) {
+		expected := append([]byte{0x05}, timeBz...)
+		actual := keeper.CreateExpirationIndexTimePrefix(expiresAt)
+		assert.Equal(t, expected, actual, "CreateExpirationIndexTimePrefix")
+	})
+
+	t.Run("create and parse", func(t *testing.T) {
+		expected := append([]byte{0x05}, timeBz...)
+		expected = append(expected, byte(len(addr)))
+		expected = append(expected, addr...)
+		key := keeper.CreateExpirationIndexKey(expiresAt, addr)
+		assert.Equal(t, expected, key, "CreateExpirationIndexKey")
+
+		actualTime, actualAddr, err := keeper.ParseExpirationIndexKey(key)
+		require.NoError(t, err, "ParseExpirationIndexKey")
+		assert.Equal(t, expiresAt, actualTime, "ParseExpirationIndexKey time")
+		assert.Equal(t, addr, actualAddr, "ParseExpirationIndexKey addr")
+	})
+
+	t.Run("earlier times sort first", func(t *testing.T) {
+		key1 := keeper.CreateExpirationIndexKey(expiresAt, sdk.AccAddress("zzzzzzzzzzzzzzzzzzzz"))
+		key2 := keeper.CreateExpirationIndexKey(expiresAt.Add(time.Second), sdk.AccAddress("aaaaaaaaaaaaaaaaaaaa"))
+		assert.Negative(t, bytes.Compare(key1, key2), "compare earlier key to later key")
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, _, err := keeper.ParseExpirationIndexKey([]byte{0x05, 'a'})
+		assert.EqualError(t, err, "invalid expiration index key length 2", "ParseExpirationIndexKey")
+	})
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -24,7 +25,15 @@ func (k Keeper) Sanction(goCtx context.Context, req *sanction.MsgSanction) (*san
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err = k.SanctionAddresses(ctx, toSanction...)
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(ctx.BlockTime()) {
+			return nil, errors.ErrInvalidExpiration.Wrapf("expires at %s must be after the current block time %s",
+				req.ExpiresAt.UTC().Format(time.RFC3339), ctx.BlockTime().UTC().Format(time.RFC3339))
+		}
+		err = k.SanctionAddressesUntil(ctx, *req.ExpiresAt, toSanction...)
+	} else {
+		err = k.SanctionAddresses(ctx, toSanction...)
+	}
 	if err != nil {
 		return nil, err
 	}
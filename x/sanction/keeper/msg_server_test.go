@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -36,6 +37,7 @@ func (s *MsgServerTestSuite) TestKeeper_Sanction() {
 	addr4 := sdk.AccAddress("4_addr_sanction_test")
 	addr5 := sdk.AccAddress("5_addr_sanction_test")
 	addr6 := sdk.AccAddress("6_addr_sanction_test")
+	expiresAt := s.BlockTime.Add(time.Hour)
 
 	tests := []struct {
 		name     string
@@ -220,6 +222,42 @@ func (s *MsgServerTestSuite) TestKeeper_Sanction() {
 				TemporaryEntries: nil,
 			},
 		},
+		{
+			name: "with expiration",
+			iniState: &sanction.GenesisState{
+				Params: sanction.DefaultParams(),
+				TemporaryEntries: []*sanction.TemporaryEntry{
+					newTempEntry(addr2, 1, true),
+				},
+			},
+			req: &sanction.MsgSanction{
+				Addresses: []string{addr2.String(), addr5.String()},
+				Authority: s.Keeper.GetAuthority(),
+				ExpiresAt: &expiresAt,
+			},
+			expState: &sanction.GenesisState{
+				Params:              sanction.DefaultParams(),
+				SanctionedAddresses: []string{addr2.String(), addr5.String()},
+				TemporaryEntries:    nil,
+				SanctionExpirations: []*sanction.SanctionExpiration{
+					{Address: addr2.String(), ExpiresAt: expiresAt},
+					{Address: addr5.String(), ExpiresAt: expiresAt},
+				},
+			},
+		},
+		{
+			name: "expiration not after block time",
+			iniState: &sanction.GenesisState{
+				Params: sanction.DefaultParams(),
+			},
+			req: &sanction.MsgSanction{
+				Addresses: []string{addr1.String()},
+				Authority: s.Keeper.GetAuthority(),
+				ExpiresAt: &s.BlockTime,
+			},
+			expErr:   []string{"invalid sanction expiration", "must be after the current block time"},
+			expState: sanction.DefaultGenesisState(),
+		},
 	}
 
 	for _, tc := range tests {
@@ -32,7 +32,8 @@ var (
 	_ module.AppModuleSimulation = AppModule{}
 	_ module.HasProposalMsgs     = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -132,6 +133,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	sanction.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
+// EndBlock lifts any sanctions that have reached their expiration.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return am.keeper.UnsanctionExpiredAddresses(ctx)
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
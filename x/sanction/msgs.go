@@ -35,6 +35,9 @@ func (m MsgSanction) ValidateBasic() error {
 			return sdkerrors.ErrInvalidAddress.Wrapf("addresses[%d], %q: %v", i, addr, err)
 		}
 	}
+	if m.ExpiresAt != nil && m.ExpiresAt.IsZero() {
+		return errors.ErrInvalidExpiration.Wrap("expires at cannot be zero")
+	}
 	return nil
 }
 
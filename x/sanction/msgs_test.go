@@ -2,6 +2,7 @@ package sanction_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,6 +91,7 @@ func TestNewMsgSanction(t *testing.T) {
 }
 
 func TestMsgSanction_ValidateBasic(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name string
 		msg  *MsgSanction
@@ -179,6 +181,24 @@ func TestMsgSanction_ValidateBasic(t *testing.T) {
 			},
 			exp: []string{"invalid address", "addresses[4]", `"bad1fifthaddr"`, "decoding bech32 failed"},
 		},
+		{
+			name: "with expires at",
+			msg: &MsgSanction{
+				Addresses: []string{sdk.AccAddress("addr0_______________").String()},
+				Authority: sdk.AccAddress("authority___________").String(),
+				ExpiresAt: &expiresAt,
+			},
+			exp: nil,
+		},
+		{
+			name: "zero expires at",
+			msg: &MsgSanction{
+				Addresses: []string{sdk.AccAddress("addr0_______________").String()},
+				Authority: sdk.AccAddress("authority___________").String(),
+				ExpiresAt: &time.Time{},
+			},
+			exp: []string{"invalid sanction expiration", "expires at cannot be zero"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -332,6 +332,105 @@ func (m *QueryTemporaryEntriesResponse) GetPagination() *query.PageResponse {
 }
 
 // QueryParamsRequest defines the RPC request for getting the sanction module params.
+type QuerySanctionExpirationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySanctionExpirationsRequest) Reset()         { *m = QuerySanctionExpirationsRequest{} }
+func (m *QuerySanctionExpirationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySanctionExpirationsRequest) ProtoMessage()    {}
+func (*QuerySanctionExpirationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{6}
+}
+func (m *QuerySanctionExpirationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySanctionExpirationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySanctionExpirationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySanctionExpirationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySanctionExpirationsRequest.Merge(m, src)
+}
+func (m *QuerySanctionExpirationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySanctionExpirationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySanctionExpirationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySanctionExpirationsRequest proto.InternalMessageInfo
+
+func (m *QuerySanctionExpirationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySanctionExpirationsResponse struct {
+	// expirations are the sanction expirations, ordered by expiration time.
+	Expirations []*SanctionExpiration `protobuf:"bytes,1,rep,name=expirations,proto3" json:"expirations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySanctionExpirationsResponse) Reset()         { *m = QuerySanctionExpirationsResponse{} }
+func (m *QuerySanctionExpirationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySanctionExpirationsResponse) ProtoMessage()    {}
+func (*QuerySanctionExpirationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{7}
+}
+func (m *QuerySanctionExpirationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySanctionExpirationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySanctionExpirationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySanctionExpirationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySanctionExpirationsResponse.Merge(m, src)
+}
+func (m *QuerySanctionExpirationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySanctionExpirationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySanctionExpirationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySanctionExpirationsResponse proto.InternalMessageInfo
+
+func (m *QuerySanctionExpirationsResponse) GetExpirations() []*SanctionExpiration {
+	if m != nil {
+		return m.Expirations
+	}
+	return nil
+}
+
+func (m *QuerySanctionExpirationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryParamsRequest struct {
 }
 
@@ -339,7 +438,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d9fc7de93fcbdc3, []int{6}
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{8}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +477,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d9fc7de93fcbdc3, []int{7}
+	return fileDescriptor_9d9fc7de93fcbdc3, []int{9}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,6 +520,8 @@ func init() {
 	proto.RegisterType((*QuerySanctionedAddressesResponse)(nil), "cosmos.sanction.v1beta1.QuerySanctionedAddressesResponse")
 	proto.RegisterType((*QueryTemporaryEntriesRequest)(nil), "cosmos.sanction.v1beta1.QueryTemporaryEntriesRequest")
 	proto.RegisterType((*QueryTemporaryEntriesResponse)(nil), "cosmos.sanction.v1beta1.QueryTemporaryEntriesResponse")
+	proto.RegisterType((*QuerySanctionExpirationsRequest)(nil), "cosmos.sanction.v1beta1.QuerySanctionExpirationsRequest")
+	proto.RegisterType((*QuerySanctionExpirationsResponse)(nil), "cosmos.sanction.v1beta1.QuerySanctionExpirationsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.sanction.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.sanction.v1beta1.QueryParamsResponse")
 }
@@ -430,45 +531,50 @@ func init() {
 }
 
 var fileDescriptor_9d9fc7de93fcbdc3 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0x1a, 0x8a, 0x3c, 0x30, 0x31, 0x03, 0x89, 0x65, 0x53, 0xb6, 0x75, 0x51, 0x68,
-	0x50, 0x76, 0x6d, 0x8d, 0xa8, 0x37, 0x21, 0xf1, 0xd7, 0x85, 0x60, 0xf1, 0xe4, 0x85, 0x4c, 0xb7,
-	0x93, 0x65, 0x63, 0x3b, 0xb3, 0xec, 0x6c, 0x89, 0x8d, 0xf1, 0xe2, 0xd9, 0x83, 0x89, 0x37, 0x0f,
-	0x5e, 0x3c, 0x68, 0xe2, 0xc5, 0x83, 0x7f, 0x84, 0x47, 0xa2, 0x17, 0x8f, 0xa6, 0xf5, 0x0f, 0x31,
-	0xcc, 0xcc, 0xf6, 0x07, 0xe9, 0x54, 0x20, 0x1c, 0x77, 0xe6, 0xfb, 0xbe, 0xef, 0x93, 0xef, 0xbc,
-	0xd7, 0xc2, 0xa2, 0xcf, 0x45, 0x93, 0x0b, 0x4f, 0x10, 0xe6, 0x27, 0x21, 0x67, 0xde, 0x7e, 0xb9,
-	0x46, 0x13, 0x52, 0xf6, 0xf6, 0x5a, 0x34, 0x6e, 0xbb, 0x51, 0xcc, 0x13, 0x8e, 0x2f, 0x2b, 0x91,
-	0x9b, 0x8a, 0x5c, 0x2d, 0xb2, 0x56, 0x74, 0x75, 0x8d, 0x08, 0xaa, 0x2a, 0x7a, 0xf5, 0x11, 0x09,
-	0x42, 0x46, 0xa4, 0x5a, 0x9a, 0x58, 0x4b, 0xa6, 0x4e, 0x3d, 0x57, 0xa5, 0x9b, 0x57, 0xba, 0x1d,
-	0xf9, 0xe5, 0xe9, 0xce, 0xea, 0x2a, 0x1f, 0x70, 0x1e, 0x34, 0xa8, 0x47, 0xa2, 0xd0, 0x23, 0x8c,
-	0xf1, 0x44, 0xfa, 0xeb, 0x5b, 0x67, 0x13, 0x72, 0x4f, 0x0f, 0x11, 0x9e, 0x88, 0x6d, 0xed, 0x48,
-	0xeb, 0x55, 0xba, 0xd7, 0xa2, 0x22, 0xc1, 0x15, 0x98, 0x24, 0xf5, 0x7a, 0x4c, 0x85, 0xc8, 0xa1,
-	0x22, 0x2a, 0x4d, 0x6d, 0xe4, 0x7e, 0x7e, 0x5f, 0x9d, 0xd3, 0xe6, 0xeb, 0xea, 0x66, 0x3b, 0x89,
-	0x43, 0x16, 0x54, 0x53, 0xa1, 0x73, 0x1f, 0xe6, 0x47, 0xf8, 0x89, 0x88, 0x33, 0x41, 0xf1, 0x22,
-	0x5c, 0x0c, 0xc5, 0x8e, 0xe8, 0x5d, 0x48, 0xdb, 0x0b, 0xd5, 0x99, 0x70, 0x40, 0xec, 0x84, 0x50,
-	0x90, 0x0e, 0xfd, 0x23, 0xdd, 0x8a, 0x8a, 0x14, 0xec, 0x21, 0x40, 0x3f, 0xa9, 0x9c, 0x5f, 0x44,
-	0xa5, 0xe9, 0xca, 0x92, 0xab, 0xc1, 0x0e, 0x63, 0x75, 0xd5, 0x43, 0xe8, 0xb0, 0xdc, 0x2d, 0x12,
-	0x50, 0x5d, 0x5b, 0x1d, 0xa8, 0x74, 0x3e, 0x21, 0x28, 0x9a, 0x7b, 0x69, 0xe8, 0x35, 0x98, 0x22,
-	0xe9, 0x61, 0x0e, 0x15, 0xcf, 0x8f, 0xcd, 0xa1, 0x2f, 0xc5, 0x8f, 0x46, 0x40, 0x2e, 0xff, 0x17,
-	0x52, 0x35, 0x1d, 0xa2, 0xfc, 0x80, 0x20, 0x2f, 0x29, 0x9f, 0xd1, 0x66, 0xc4, 0x63, 0x12, 0xb7,
-	0x1f, 0xb0, 0x24, 0x0e, 0xfb, 0x71, 0x9c, 0xe2, 0x9d, 0xce, 0x2c, 0xc2, 0xaf, 0x08, 0x16, 0x0c,
-	0x70, 0x3a, 0xbf, 0x75, 0x98, 0xa4, 0xea, 0x48, 0xa6, 0x37, 0x10, 0xc2, 0xd1, 0xcd, 0x70, 0x87,
-	0x3c, 0xda, 0xd5, 0xb4, 0xee, 0xec, 0xa2, 0x9c, 0x03, 0x2c, 0x61, 0xb7, 0x48, 0x4c, 0x9a, 0x69,
-	0x7e, 0xce, 0x26, 0xcc, 0x0e, 0x9d, 0x6a, 0xf0, 0x3b, 0x90, 0x8d, 0xe4, 0x89, 0x4c, 0x75, 0xba,
-	0x52, 0x30, 0x72, 0xeb, 0x42, 0x2d, 0xaf, 0x7c, 0x9c, 0x80, 0x09, 0x69, 0x88, 0x3f, 0x23, 0x98,
-	0x19, 0xdc, 0x04, 0x5c, 0x36, 0x7a, 0x98, 0xb6, 0xd0, 0xaa, 0x9c, 0xa4, 0x44, 0xa1, 0x3b, 0x37,
-	0xdf, 0xfc, 0xfa, 0xfb, 0xfe, 0xdc, 0x0a, 0x2e, 0x79, 0xa6, 0xdf, 0x0f, 0x7f, 0x97, 0xfa, 0x2f,
-	0xbc, 0x57, 0x7a, 0x1c, 0x5e, 0xe3, 0x6f, 0x08, 0x66, 0x47, 0x6c, 0x01, 0xbe, 0x3b, 0xbe, 0xbb,
-	0x79, 0x49, 0xad, 0x7b, 0xa7, 0xa8, 0xd4, 0xf8, 0x57, 0x25, 0xbe, 0x8d, 0xf3, 0x46, 0x7c, 0xd2,
-	0x68, 0xe0, 0x2f, 0x08, 0x2e, 0x1d, 0x9d, 0x3a, 0x7c, 0x7b, 0x7c, 0x57, 0xc3, 0x0a, 0x59, 0x6b,
-	0x27, 0x2d, 0xd3, 0xa4, 0xd7, 0x24, 0x69, 0x01, 0x2f, 0x18, 0x49, 0x13, 0xda, 0x8c, 0xf0, 0x5b,
-	0x04, 0x59, 0x35, 0x24, 0xf8, 0xfa, 0xf8, 0x4e, 0x43, 0x93, 0x69, 0xdd, 0x38, 0x9e, 0x58, 0xc3,
-	0x2c, 0x4b, 0x98, 0x2b, 0xb8, 0x60, 0x84, 0x51, 0x03, 0xba, 0xf1, 0xf8, 0x47, 0xc7, 0x46, 0x07,
-	0x1d, 0x1b, 0xfd, 0xe9, 0xd8, 0xe8, 0x5d, 0xd7, 0xce, 0x1c, 0x74, 0xed, 0xcc, 0xef, 0xae, 0x9d,
-	0x79, 0xee, 0x06, 0x61, 0xb2, 0xdb, 0xaa, 0xb9, 0x3e, 0x6f, 0x7a, 0x51, 0xcc, 0xf7, 0x29, 0x23,
-	0xcc, 0xa7, 0xab, 0x21, 0x1f, 0xf8, 0xf2, 0x5e, 0xf6, 0x8c, 0x6b, 0x59, 0xf9, 0x2f, 0x72, 0xeb,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x5f, 0xe8, 0x01, 0x12, 0x07, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0x7b, 0xbf, 0x9f, 0x48, 0xe9, 0xa5, 0x48, 0xe8, 0x5a, 0x89, 0xd4, 0x4a, 0x9d, 0xe0,
+	0x96, 0x34, 0xea, 0x1f, 0x9b, 0x04, 0x51, 0x60, 0xa3, 0x95, 0xca, 0x9f, 0x81, 0xaa, 0xb8, 0x4c,
+	0x2c, 0xd5, 0xc5, 0x39, 0xb9, 0x16, 0x89, 0xcf, 0xf5, 0x39, 0x55, 0x23, 0xc4, 0xc2, 0xcc, 0x80,
+	0xc4, 0xc6, 0xca, 0x00, 0x12, 0x0b, 0x03, 0x03, 0xe2, 0x15, 0x30, 0x56, 0xb0, 0xc0, 0x86, 0x12,
+	0x5e, 0x08, 0xea, 0xdd, 0x39, 0x71, 0xd2, 0x5c, 0x48, 0xab, 0x8c, 0x7e, 0xee, 0x79, 0xbe, 0xcf,
+	0x27, 0xdf, 0xbb, 0xe7, 0x51, 0xe0, 0x82, 0x43, 0x59, 0x9d, 0x32, 0x8b, 0x61, 0xdf, 0x89, 0x3c,
+	0xea, 0x5b, 0x87, 0xa5, 0x0a, 0x89, 0x70, 0xc9, 0x3a, 0x68, 0x90, 0xb0, 0x69, 0x06, 0x21, 0x8d,
+	0x28, 0xba, 0x22, 0x92, 0xcc, 0x38, 0xc9, 0x94, 0x49, 0xda, 0xb2, 0xac, 0xae, 0x60, 0x46, 0x44,
+	0x45, 0xa7, 0x3e, 0xc0, 0xae, 0xe7, 0x63, 0x9e, 0xcd, 0x45, 0xb4, 0x82, 0xaa, 0x53, 0x47, 0x55,
+	0xe4, 0xcd, 0x89, 0xbc, 0x3d, 0xfe, 0x65, 0xc9, 0xce, 0xe2, 0x28, 0xeb, 0x52, 0xea, 0xd6, 0x88,
+	0x85, 0x03, 0xcf, 0xc2, 0xbe, 0x4f, 0x23, 0xae, 0x2f, 0x4f, 0x8d, 0x6d, 0x98, 0x79, 0x7c, 0x82,
+	0xf0, 0x90, 0xed, 0x4a, 0x45, 0x52, 0xb5, 0xc9, 0x41, 0x83, 0xb0, 0x08, 0x95, 0xe1, 0x24, 0xae,
+	0x56, 0x43, 0xc2, 0x58, 0x06, 0xe4, 0x41, 0x71, 0x6a, 0x33, 0xf3, 0xfd, 0xf3, 0xda, 0xac, 0x14,
+	0xdf, 0x10, 0x27, 0xbb, 0x51, 0xe8, 0xf9, 0xae, 0x1d, 0x27, 0x1a, 0x77, 0xe1, 0xdc, 0x00, 0x3d,
+	0x16, 0x50, 0x9f, 0x11, 0xb4, 0x00, 0x2f, 0x79, 0x6c, 0x8f, 0x75, 0x0e, 0xb8, 0xec, 0x45, 0x7b,
+	0xda, 0x4b, 0x24, 0x1b, 0x1e, 0xcc, 0x71, 0x85, 0x6e, 0x48, 0xb6, 0x22, 0x2c, 0x06, 0xbb, 0x07,
+	0x61, 0xd7, 0xa9, 0x8c, 0x93, 0x07, 0xc5, 0x74, 0xb9, 0x60, 0x4a, 0xb0, 0x13, 0x5b, 0x4d, 0x71,
+	0x11, 0xd2, 0x2c, 0x73, 0x07, 0xbb, 0x44, 0xd6, 0xda, 0x89, 0x4a, 0xe3, 0x1d, 0x80, 0x79, 0x75,
+	0x2f, 0x09, 0xbd, 0x0e, 0xa7, 0x70, 0x1c, 0xcc, 0x80, 0xfc, 0xff, 0x43, 0x7d, 0xe8, 0xa6, 0xa2,
+	0xfb, 0x03, 0x20, 0x97, 0xfe, 0x09, 0x29, 0x9a, 0xf6, 0x50, 0xbe, 0x05, 0x30, 0xcb, 0x29, 0x9f,
+	0x90, 0x7a, 0x40, 0x43, 0x1c, 0x36, 0xb7, 0xfc, 0x28, 0xf4, 0xba, 0x76, 0x9c, 0xe3, 0x9e, 0xc6,
+	0x66, 0xe1, 0x47, 0x00, 0xe7, 0x15, 0x70, 0xd2, 0xbf, 0x0d, 0x38, 0x49, 0x44, 0x88, 0xbb, 0x97,
+	0x30, 0xa1, 0x7f, 0x32, 0xcc, 0x1e, 0x8d, 0xa6, 0x1d, 0xd7, 0x8d, 0xcf, 0xca, 0xfe, 0xb7, 0xb5,
+	0x75, 0x14, 0x78, 0xa1, 0x98, 0x87, 0x71, 0xbf, 0xad, 0xaf, 0xfd, 0x6f, 0xab, 0xa7, 0x97, 0xf4,
+	0xe6, 0x11, 0x4c, 0x93, 0x6e, 0x58, 0xfa, 0xb3, 0xa2, 0xf4, 0xe7, 0xb4, 0x94, 0x9d, 0xac, 0x1f,
+	0x9f, 0x4f, 0xb3, 0x10, 0x71, 0xf6, 0x1d, 0x1c, 0xe2, 0x7a, 0x6c, 0x8d, 0xb1, 0x0d, 0x67, 0x7a,
+	0xa2, 0xf2, 0x47, 0xdc, 0x82, 0xa9, 0x80, 0x47, 0xf8, 0xeb, 0x4b, 0x97, 0x73, 0x4a, 0x7e, 0x59,
+	0x28, 0xd3, 0xcb, 0xbf, 0x52, 0xf0, 0x02, 0x17, 0x44, 0xef, 0x01, 0x9c, 0x4e, 0x6e, 0x0c, 0x54,
+	0x52, 0x6a, 0xa8, 0xb6, 0x95, 0x56, 0x3e, 0x4b, 0x89, 0x40, 0x37, 0xae, 0xbf, 0xfc, 0xf1, 0xe7,
+	0xcd, 0x7f, 0xcb, 0xa8, 0x68, 0xa9, 0xf6, 0xac, 0xb3, 0x4f, 0x9c, 0x67, 0xd6, 0x73, 0x39, 0x36,
+	0x2f, 0xd0, 0x27, 0x00, 0x67, 0x06, 0x6c, 0x0b, 0x74, 0x7b, 0x78, 0x77, 0xf5, 0x32, 0xd3, 0xee,
+	0x9c, 0xa3, 0x52, 0xe2, 0x2f, 0x72, 0x7c, 0x1d, 0x65, 0x95, 0xf8, 0xb8, 0x56, 0x43, 0x1f, 0x00,
+	0xbc, 0xdc, 0x3f, 0x9d, 0xe8, 0xe6, 0xf0, 0xae, 0x8a, 0x55, 0xa3, 0xad, 0x9f, 0xb5, 0x4c, 0x92,
+	0x5e, 0xe3, 0xa4, 0x39, 0x34, 0xaf, 0x24, 0x8d, 0x48, 0x3d, 0x40, 0x5f, 0x12, 0xee, 0x26, 0xe6,
+	0x65, 0x54, 0x77, 0x4f, 0x8f, 0xf3, 0xa8, 0xee, 0x0e, 0x18, 0x4e, 0x63, 0x95, 0x33, 0x17, 0xd0,
+	0xa2, 0x92, 0x39, 0x39, 0x7b, 0xaf, 0x00, 0x4c, 0x89, 0xf7, 0x8d, 0x56, 0x86, 0xf7, 0xec, 0x19,
+	0x2a, 0x6d, 0x75, 0xb4, 0x64, 0xc9, 0xb4, 0xc4, 0x99, 0xae, 0xa2, 0x9c, 0x92, 0x49, 0xcc, 0xd6,
+	0xe6, 0x83, 0x6f, 0x2d, 0x1d, 0x1c, 0xb7, 0x74, 0xf0, 0xbb, 0xa5, 0x83, 0xd7, 0x6d, 0x7d, 0xe2,
+	0xb8, 0xad, 0x4f, 0xfc, 0x6c, 0xeb, 0x13, 0x4f, 0x4d, 0xd7, 0x8b, 0xf6, 0x1b, 0x15, 0xd3, 0xa1,
+	0x75, 0x2b, 0x08, 0xe9, 0x21, 0xf1, 0xb1, 0xef, 0x90, 0x35, 0x8f, 0x26, 0xbe, 0xac, 0xa3, 0x8e,
+	0x70, 0x25, 0xc5, 0xff, 0x28, 0xdc, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x50, 0x5a, 0x8e,
+	0xf5, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -489,6 +595,8 @@ type QueryClient interface {
 	SanctionedAddresses(ctx context.Context, in *QuerySanctionedAddressesRequest, opts ...grpc.CallOption) (*QuerySanctionedAddressesResponse, error)
 	// TemporaryEntries returns temporary sanction/unsanction info.
 	TemporaryEntries(ctx context.Context, in *QueryTemporaryEntriesRequest, opts ...grpc.CallOption) (*QueryTemporaryEntriesResponse, error)
+	// SanctionExpirations returns the upcoming sanction expirations, soonest first.
+	SanctionExpirations(ctx context.Context, in *QuerySanctionExpirationsRequest, opts ...grpc.CallOption) (*QuerySanctionExpirationsResponse, error)
 	// Params returns the sanction module's params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -528,6 +636,15 @@ func (c *queryClient) TemporaryEntries(ctx context.Context, in *QueryTemporaryEn
 	return out, nil
 }
 
+func (c *queryClient) SanctionExpirations(ctx context.Context, in *QuerySanctionExpirationsRequest, opts ...grpc.CallOption) (*QuerySanctionExpirationsResponse, error) {
+	out := new(QuerySanctionExpirationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Query/SanctionExpirations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.sanction.v1beta1.Query/Params", in, out, opts...)
@@ -545,6 +662,8 @@ type QueryServer interface {
 	SanctionedAddresses(context.Context, *QuerySanctionedAddressesRequest) (*QuerySanctionedAddressesResponse, error)
 	// TemporaryEntries returns temporary sanction/unsanction info.
 	TemporaryEntries(context.Context, *QueryTemporaryEntriesRequest) (*QueryTemporaryEntriesResponse, error)
+	// SanctionExpirations returns the upcoming sanction expirations, soonest first.
+	SanctionExpirations(context.Context, *QuerySanctionExpirationsRequest) (*QuerySanctionExpirationsResponse, error)
 	// Params returns the sanction module's params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -562,6 +681,9 @@ func (*UnimplementedQueryServer) SanctionedAddresses(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) TemporaryEntries(ctx context.Context, req *QueryTemporaryEntriesRequest) (*QueryTemporaryEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TemporaryEntries not implemented")
 }
+func (*UnimplementedQueryServer) SanctionExpirations(ctx context.Context, req *QuerySanctionExpirationsRequest) (*QuerySanctionExpirationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SanctionExpirations not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -624,6 +746,24 @@ func _Query_TemporaryEntries_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SanctionExpirations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySanctionExpirationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SanctionExpirations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.sanction.v1beta1.Query/SanctionExpirations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SanctionExpirations(ctx, req.(*QuerySanctionExpirationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -659,6 +799,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TemporaryEntries",
 			Handler:    _Query_TemporaryEntries_Handler,
 		},
+		{
+			MethodName: "SanctionExpirations",
+			Handler:    _Query_SanctionExpirations_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -909,6 +1053,94 @@ func (m *QueryTemporaryEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySanctionExpirationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySanctionExpirationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySanctionExpirationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySanctionExpirationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySanctionExpirationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySanctionExpirationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Expirations) > 0 {
+		for iNdEx := len(m.Expirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1071,6 +1303,38 @@ func (m *QueryTemporaryEntriesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySanctionExpirationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySanctionExpirationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Expirations) > 0 {
+		for _, e := range m.Expirations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1693,6 +1957,212 @@ func (m *QueryTemporaryEntriesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySanctionExpirationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySanctionExpirationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySanctionExpirationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySanctionExpirationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySanctionExpirationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySanctionExpirationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expirations = append(m.Expirations, &SanctionExpiration{})
+			if err := m.Expirations[len(m.Expirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
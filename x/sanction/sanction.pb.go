@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,7 +31,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// TempStatus is whether a temporary entry is a sanction or unsanction.
 type TempStatus int32
 
 const (
@@ -184,10 +187,67 @@ func (m *TemporaryEntry) GetStatus() TempStatus {
 	return TEMP_STATUS_UNSPECIFIED
 }
 
+// TempStatus is whether a temporary entry is a sanction or unsanction.
+// SanctionExpiration defines when a sanction on an address will be automatically lifted.
+type SanctionExpiration struct {
+	// address is the sanctioned address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// expires_at is the time at which the address will be unsanctioned.
+	ExpiresAt time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *SanctionExpiration) Reset()         { *m = SanctionExpiration{} }
+func (m *SanctionExpiration) String() string { return proto.CompactTextString(m) }
+func (*SanctionExpiration) ProtoMessage()    {}
+func (*SanctionExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e632afabc7910f0, []int{2}
+}
+func (m *SanctionExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SanctionExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SanctionExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SanctionExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SanctionExpiration.Merge(m, src)
+}
+func (m *SanctionExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *SanctionExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_SanctionExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SanctionExpiration proto.InternalMessageInfo
+
+func (m *SanctionExpiration) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SanctionExpiration) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.sanction.v1beta1.TempStatus", TempStatus_name, TempStatus_value)
 	proto.RegisterType((*Params)(nil), "cosmos.sanction.v1beta1.Params")
 	proto.RegisterType((*TemporaryEntry)(nil), "cosmos.sanction.v1beta1.TemporaryEntry")
+	proto.RegisterType((*SanctionExpiration)(nil), "cosmos.sanction.v1beta1.SanctionExpiration")
 }
 
 func init() {
@@ -195,38 +255,43 @@ func init() {
 }
 
 var fileDescriptor_9e632afabc7910f0 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0x69, 0x15, 0xc4, 0x15, 0x55, 0xc5, 0xaa, 0xa8, 0x9b, 0x82, 0x63, 0x15, 0x09,
-	0x59, 0x91, 0x62, 0xab, 0x61, 0x64, 0xca, 0x1f, 0x57, 0x64, 0x68, 0x88, 0x62, 0x67, 0x61, 0xb1,
-	0x2e, 0xf6, 0xc9, 0x9c, 0xa8, 0xef, 0xac, 0xbb, 0x4b, 0x45, 0xbe, 0x01, 0x23, 0x33, 0x23, 0x48,
-	0x08, 0x75, 0xea, 0xc0, 0x87, 0xe8, 0x58, 0x31, 0x31, 0x15, 0x94, 0x0c, 0xfd, 0x1a, 0x28, 0xf6,
-	0xc5, 0x89, 0x10, 0xac, 0x2c, 0xb6, 0xef, 0x79, 0x9e, 0xf7, 0xd1, 0xcf, 0xf2, 0x6b, 0xf8, 0x2c,
-	0x62, 0x22, 0x65, 0xc2, 0x15, 0x88, 0x46, 0x92, 0x30, 0xea, 0x5e, 0x9c, 0x4c, 0xb0, 0x44, 0x27,
-	0xa5, 0xe0, 0x64, 0x9c, 0x49, 0xa6, 0x1f, 0x14, 0x39, 0xa7, 0x94, 0x55, 0xae, 0xf6, 0x10, 0xa5,
-	0x84, 0x32, 0x37, 0xbf, 0x16, 0xd9, 0x9a, 0xa9, 0x3a, 0x27, 0x48, 0xe0, 0xb2, 0x2f, 0x62, 0x44,
-	0x75, 0xd5, 0x0e, 0x0b, 0x3f, 0xcc, 0x4f, 0xae, 0x2a, 0x2e, 0xac, 0xfd, 0x84, 0x25, 0xac, 0xd0,
-	0x97, 0x4f, 0x85, 0x7a, 0x7c, 0x5b, 0x81, 0xd5, 0x21, 0xe2, 0x28, 0x15, 0xfa, 0x17, 0x00, 0x4d,
-	0x92, 0xa6, 0x38, 0x26, 0x48, 0xe2, 0x70, 0x45, 0x13, 0xa6, 0x84, 0x86, 0x31, 0xce, 0x98, 0x20,
-	0xd2, 0x00, 0xd6, 0x96, 0xbd, 0xd3, 0x3a, 0x74, 0x54, 0xf1, 0x92, 0x62, 0x45, 0xeb, 0x74, 0x19,
-	0xa1, 0x9d, 0xd3, 0xeb, 0xdb, 0xba, 0x76, 0xf9, 0xb3, 0x6e, 0x27, 0x44, 0xbe, 0x99, 0x4e, 0x9c,
-	0x88, 0xa5, 0x8a, 0x42, 0xdd, 0x9a, 0x22, 0x7e, 0xeb, 0xca, 0x59, 0x86, 0x45, 0x3e, 0x20, 0x3e,
-	0xde, 0x5d, 0x35, 0x1e, 0x9c, 0xe3, 0x04, 0x45, 0xb3, 0x70, 0xf9, 0x1e, 0xe2, 0xeb, 0xdd, 0x55,
-	0x03, 0x8c, 0x8e, 0x4a, 0x10, 0x5f, 0x71, 0x9c, 0x11, 0xda, 0x2b, 0x28, 0xf4, 0x4b, 0x00, 0xad,
-	0x35, 0xe8, 0x94, 0xfe, 0x15, 0xb5, 0xf2, 0xbf, 0x50, 0x9f, 0x94, 0x28, 0xe3, 0x92, 0x64, 0x0d,
-	0x7b, 0xfc, 0x09, 0xc0, 0xdd, 0x00, 0xa7, 0x19, 0xe3, 0x88, 0xcf, 0x3c, 0x2a, 0xf9, 0x4c, 0x6f,
-	0xc1, 0x7b, 0x28, 0x8e, 0x39, 0x16, 0xc2, 0x00, 0x16, 0xb0, 0xef, 0x77, 0x8c, 0xef, 0xdf, 0x9a,
-	0xfb, 0x0a, 0xb4, 0x5d, 0x38, 0xbe, 0xe4, 0x84, 0x26, 0xa3, 0x55, 0x50, 0xaf, 0xc3, 0x9d, 0x8c,
-	0xb3, 0x8c, 0x09, 0x74, 0x1e, 0x92, 0xd8, 0xa8, 0x58, 0xc0, 0xde, 0x1e, 0xc1, 0x95, 0xd4, 0x8f,
-	0xf5, 0x17, 0xb0, 0x2a, 0x24, 0x92, 0x53, 0x61, 0x6c, 0x59, 0xc0, 0xde, 0x6d, 0x3d, 0x75, 0xfe,
-	0xb1, 0x56, 0xce, 0x92, 0xc6, 0xcf, 0xa3, 0x23, 0x35, 0xd2, 0x20, 0x10, 0xae, 0x55, 0xfd, 0x08,
-	0x1e, 0x04, 0xde, 0xd9, 0x30, 0xf4, 0x83, 0x76, 0x30, 0xf6, 0xc3, 0xf1, 0xc0, 0x1f, 0x7a, 0xdd,
-	0xfe, 0x69, 0xdf, 0xeb, 0xed, 0x69, 0x7a, 0x0d, 0x3e, 0xda, 0x34, 0xfd, 0xf6, 0xa0, 0x1b, 0xf4,
-	0x5f, 0x0d, 0xbc, 0xde, 0x1e, 0xd0, 0x1f, 0x43, 0xe3, 0x8f, 0xc1, 0xb5, 0x5b, 0xa9, 0x6d, 0xbf,
-	0xff, 0x6c, 0x6a, 0x9d, 0x97, 0xd7, 0x73, 0x13, 0xdc, 0xcc, 0x4d, 0xf0, 0x6b, 0x6e, 0x82, 0x0f,
-	0x0b, 0x53, 0xbb, 0x59, 0x98, 0xda, 0x8f, 0x85, 0xa9, 0xbd, 0x76, 0x36, 0x3e, 0x44, 0xc6, 0xd9,
-	0x05, 0xa6, 0x88, 0x46, 0xb8, 0x49, 0xd8, 0xc6, 0xc9, 0x7d, 0x57, 0xfe, 0x3d, 0x93, 0x6a, 0xbe,
-	0xc1, 0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x31, 0x26, 0x2e, 0x68, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x69, 0x55, 0xe8, 0x05, 0x55, 0xc5, 0xaa, 0xa8, 0xeb, 0x82, 0x13, 0x05, 0x09,
+	0x45, 0x91, 0x62, 0xab, 0x61, 0x64, 0xca, 0x0f, 0x57, 0x64, 0x68, 0x88, 0x62, 0x67, 0x61, 0xb1,
+	0x2e, 0xf6, 0x61, 0x4e, 0xc4, 0x3e, 0xcb, 0x77, 0xa9, 0x9a, 0xff, 0x80, 0x05, 0xa9, 0x33, 0x23,
+	0x48, 0x08, 0x75, 0xea, 0xc0, 0x1f, 0xd1, 0xb1, 0x62, 0x62, 0x6a, 0x51, 0x32, 0xf4, 0xdf, 0x40,
+	0xb6, 0xcf, 0x4e, 0x84, 0x60, 0x61, 0x60, 0xb1, 0xef, 0xbd, 0xf7, 0x7d, 0xcf, 0x9f, 0x67, 0x7f,
+	0x0d, 0x9f, 0xb9, 0x94, 0x05, 0x94, 0x19, 0x0c, 0x85, 0x2e, 0x27, 0x34, 0x34, 0x4e, 0x8f, 0x26,
+	0x98, 0xa3, 0xa3, 0x22, 0xa1, 0x47, 0x31, 0xe5, 0x54, 0xde, 0xcf, 0x74, 0x7a, 0x91, 0x16, 0x3a,
+	0xf5, 0x21, 0x0a, 0x48, 0x48, 0x8d, 0xf4, 0x9a, 0x69, 0x55, 0x4d, 0xcc, 0x9c, 0x20, 0x86, 0x8b,
+	0x79, 0x2e, 0x25, 0x62, 0x96, 0x7a, 0x90, 0xd5, 0x9d, 0x34, 0x32, 0xc4, 0xe0, 0xac, 0xb4, 0xe7,
+	0x53, 0x9f, 0x66, 0xf9, 0xe4, 0x24, 0xb2, 0x15, 0x9f, 0x52, 0x7f, 0x8a, 0x8d, 0x34, 0x9a, 0xcc,
+	0xde, 0x18, 0x9c, 0x04, 0x98, 0x71, 0x14, 0x44, 0x99, 0xa0, 0x76, 0x53, 0x82, 0x5b, 0x43, 0x14,
+	0xa3, 0x80, 0xc9, 0x5f, 0x00, 0xd4, 0x48, 0x10, 0x60, 0x8f, 0x20, 0x8e, 0x9d, 0x1c, 0xd7, 0x09,
+	0x48, 0xe8, 0x78, 0x38, 0xa2, 0x8c, 0x70, 0x05, 0x54, 0x37, 0xea, 0xe5, 0xd6, 0x81, 0x2e, 0x9e,
+	0x9c, 0x60, 0xe6, 0xeb, 0xe8, 0x5d, 0x4a, 0xc2, 0xce, 0xf1, 0xd5, 0x4d, 0x45, 0xba, 0xb8, 0xad,
+	0xd4, 0x7d, 0xc2, 0xdf, 0xce, 0x26, 0xba, 0x4b, 0x03, 0x81, 0x29, 0x6e, 0x4d, 0xe6, 0xbd, 0x33,
+	0xf8, 0x3c, 0xc2, 0x2c, 0x6d, 0x60, 0x1f, 0xef, 0x2e, 0x1b, 0x0f, 0xa6, 0xd8, 0x47, 0xee, 0xdc,
+	0x49, 0x16, 0x65, 0x5f, 0xef, 0x2e, 0x1b, 0x60, 0x74, 0x58, 0x80, 0x58, 0x82, 0xe3, 0x84, 0x84,
+	0xbd, 0x8c, 0x42, 0xbe, 0x00, 0xb0, 0xba, 0x02, 0x9d, 0x85, 0x7f, 0x44, 0x2d, 0xfd, 0x2f, 0xd4,
+	0x27, 0x05, 0xca, 0xb8, 0x20, 0x59, 0xc1, 0xd6, 0x3e, 0x01, 0xb8, 0x63, 0xe3, 0x20, 0xa2, 0x31,
+	0x8a, 0xe7, 0x66, 0xc8, 0xe3, 0xb9, 0xdc, 0x82, 0xf7, 0x90, 0xe7, 0xc5, 0x98, 0x31, 0x05, 0x54,
+	0x41, 0x7d, 0xbb, 0xa3, 0x7c, 0xff, 0xd6, 0xdc, 0x13, 0xa0, 0xed, 0xac, 0x62, 0xf1, 0x98, 0x84,
+	0xfe, 0x28, 0x17, 0xca, 0x15, 0x58, 0x8e, 0x62, 0x1a, 0x51, 0x86, 0xa6, 0x0e, 0xf1, 0x94, 0x52,
+	0x15, 0xd4, 0x37, 0x47, 0x30, 0x4f, 0xf5, 0x3d, 0xf9, 0x05, 0xdc, 0x62, 0x1c, 0xf1, 0x19, 0x53,
+	0x36, 0xaa, 0xa0, 0xbe, 0xd3, 0x7a, 0xaa, 0xff, 0xc5, 0x77, 0x7a, 0x42, 0x63, 0xa5, 0xd2, 0x91,
+	0x68, 0xa9, 0x7d, 0x00, 0x50, 0xce, 0x5f, 0xb4, 0x79, 0x16, 0x91, 0x18, 0x25, 0xa7, 0x7f, 0x02,
+	0xed, 0x42, 0x88, 0x93, 0x09, 0x98, 0x39, 0x88, 0xa7, 0x9c, 0xe5, 0x96, 0xaa, 0x67, 0x36, 0xd4,
+	0x73, 0x1b, 0xea, 0x76, 0x6e, 0xc3, 0xce, 0xfd, 0xe4, 0x33, 0x9c, 0xdf, 0x56, 0xc0, 0x68, 0x5b,
+	0xf4, 0xb5, 0x79, 0x83, 0x40, 0xb8, 0xa2, 0x94, 0x0f, 0xe1, 0xbe, 0x6d, 0x9e, 0x0c, 0x1d, 0xcb,
+	0x6e, 0xdb, 0x63, 0xcb, 0x19, 0x0f, 0xac, 0xa1, 0xd9, 0xed, 0x1f, 0xf7, 0xcd, 0xde, 0xae, 0x24,
+	0xab, 0xf0, 0xd1, 0x7a, 0xd1, 0x6a, 0x0f, 0xba, 0x76, 0xff, 0xd5, 0xc0, 0xec, 0xed, 0x02, 0xf9,
+	0x31, 0x54, 0x7e, 0x6b, 0x5c, 0x55, 0x4b, 0xea, 0xe6, 0xfb, 0xcf, 0x9a, 0xd4, 0x79, 0x79, 0xb5,
+	0xd0, 0xc0, 0xf5, 0x42, 0x03, 0x3f, 0x17, 0x1a, 0x38, 0x5f, 0x6a, 0xd2, 0xf5, 0x52, 0x93, 0x7e,
+	0x2c, 0x35, 0xe9, 0xb5, 0xbe, 0x66, 0x8c, 0x28, 0xa6, 0xa7, 0x38, 0x44, 0xa1, 0x8b, 0x9b, 0x84,
+	0xae, 0x45, 0xc6, 0x59, 0xf1, 0xbb, 0x4f, 0xb6, 0xd2, 0xed, 0x9e, 0xff, 0x0a, 0x00, 0x00, 0xff,
+	0xff, 0x10, 0x1e, 0x97, 0xef, 0x19, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -320,6 +385,44 @@ func (m *TemporaryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SanctionExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SanctionExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SanctionExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSanction(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSanction(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSanction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSanction(v)
 	base := offset
@@ -371,6 +474,21 @@ func (m *TemporaryEntry) Size() (n int) {
 	return n
 }
 
+func (m *SanctionExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSanction(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovSanction(uint64(l))
+	return n
+}
+
 func sovSanction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -615,6 +733,121 @@ func (m *TemporaryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SanctionExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSanction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SanctionExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SanctionExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSanction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSanction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSanction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSanction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSanction(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,12 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.HasPrefix(kvA.Key, keeper.ProposalIndexPrefix):
 			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 
+		case bytes.HasPrefix(kvA.Key, keeper.ExpirationPrefix):
+			return fmt.Sprintf("%s\n%s", string(kvA.Value), string(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, keeper.ExpirationIndexPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid sanction key %X", kvA.Key))
 		}
@@ -2,6 +2,7 @@ package simulation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -48,6 +49,18 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: keeper.CreateProposalTempIndexKey(1, sdk.AccAddress("addrb")), Value: []byte{55}},
 			exp:  "[54]\n[55]",
 		},
+		{
+			name: "expiration",
+			kvA:  kv.Pair{Key: keeper.CreateExpirationKey(sdk.AccAddress("addra")), Value: []byte("timea")},
+			kvB:  kv.Pair{Key: keeper.CreateExpirationKey(sdk.AccAddress("addrb")), Value: []byte("timeb")},
+			exp:  "timea\ntimeb",
+		},
+		{
+			name: "expiration index",
+			kvA:  kv.Pair{Key: keeper.CreateExpirationIndexKey(time.Unix(1, 0), sdk.AccAddress("addra")), Value: []byte{0}},
+			kvB:  kv.Pair{Key: keeper.CreateExpirationIndexKey(time.Unix(2, 0), sdk.AccAddress("addrb")), Value: []byte{1}},
+			exp:  "[0]\n[1]",
+		},
 		{
 			name:     "unknown",
 			kvA:      kv.Pair{Key: []byte{0x9a}, Value: []byte("valuea")},
@@ -8,20 +8,25 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +41,9 @@ type MsgSanction struct {
 	// authority is the address of the account with the authority to enact sanctions (most likely the governance module
 	// account).
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// expires_at is an optional time at which the addresses will be automatically unsanctioned.
+	// If not provided, the sanctions are permanent until a MsgUnsanction is executed.
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *MsgSanction) Reset()         { *m = MsgSanction{} }
@@ -85,6 +93,13 @@ func (m *MsgSanction) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgSanction) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 // MsgOptInResponse defines the Msg/Sanction response type.
 type MsgSanctionResponse struct {
 }
@@ -320,32 +335,37 @@ func init() {
 func init() { proto.RegisterFile("cosmos/sanction/v1beta1/tx.proto", fileDescriptor_7db49afb1d08944d) }
 
 var fileDescriptor_7db49afb1d08944d = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x4e, 0xcc, 0x4b, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x82, 0x4a, 0xe8, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19,
-	0x82, 0x28, 0x88, 0x0e, 0x29, 0x35, 0x5c, 0x66, 0xc2, 0x8d, 0x80, 0xa8, 0x93, 0x84, 0xa8, 0x8b,
-	0x07, 0xf3, 0xf4, 0xa1, 0xd6, 0x80, 0x39, 0x4a, 0xbd, 0x8c, 0x5c, 0xdc, 0xbe, 0xc5, 0xe9, 0xc1,
-	0x50, 0x0d, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xa9, 0xc5, 0x12,
-	0x8c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x35, 0x39, 0x42, 0xe4,
-	0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0xc1, 0xfa, 0x4a, 0x4b, 0x32, 0xf2, 0x8b,
-	0x32, 0x4b, 0x2a, 0x25, 0x98, 0x14, 0x18, 0x09, 0xe8, 0x83, 0x29, 0xb5, 0xe2, 0x6b, 0x7a, 0xbe,
-	0x41, 0x0b, 0xc1, 0x57, 0x12, 0xe5, 0x12, 0x46, 0x72, 0x4e, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e,
-	0x71, 0xaa, 0x52, 0x3f, 0x23, 0x17, 0xaf, 0x6f, 0x71, 0x7a, 0x68, 0x5e, 0xf1, 0x60, 0x71, 0xa8,
-	0x38, 0x97, 0x28, 0x8a, 0x83, 0xe0, 0x4e, 0x9d, 0xc4, 0xc8, 0xc5, 0x0f, 0x92, 0x29, 0x48, 0x49,
-	0x2c, 0x49, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x32, 0xe7, 0x62, 0x2b, 0x00, 0xb3, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0xb5, 0x1e, 0x44, 0x43, 0x10, 0x54,
-	0x39, 0xd5, 0x5c, 0x2b, 0xc9, 0x25, 0x8e, 0xe6, 0x26, 0x98, 0x7b, 0x8d, 0xf6, 0x31, 0x71, 0x31,
-	0xfb, 0x16, 0xa7, 0x0b, 0xc5, 0x71, 0x71, 0xc0, 0x53, 0x81, 0x0a, 0x4e, 0xf7, 0x21, 0x45, 0x8e,
-	0x94, 0x0e, 0x31, 0xaa, 0x60, 0xf6, 0x08, 0xa5, 0x70, 0x71, 0x21, 0x45, 0x9f, 0x1a, 0x3e, 0xbd,
-	0x08, 0x75, 0x52, 0x7a, 0xc4, 0xa9, 0x83, 0xdb, 0x92, 0xc5, 0xc5, 0x83, 0x12, 0xf2, 0x1a, 0x78,
-	0xf5, 0x23, 0xa9, 0x94, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x2e, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4,
-	0x18, 0x9d, 0x3c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2f, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xa0, 0x28, 0xbf, 0x2c, 0x35, 0x2f,
-	0x31, 0x2f, 0x39, 0x55, 0x37, 0x33, 0x1f, 0x89, 0xa7, 0x5f, 0x01, 0xcf, 0xad, 0x49, 0x6c, 0xe0,
-	0x3c, 0x69, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x51, 0x20, 0x2f, 0x2c, 0x04, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x73, 0x0d, 0x54, 0xe4, 0x2d, 0x7f, 0x24, 0xd3, 0x2a, 0xae, 0x07, 0x27, 0x8a, 0x50,
+	0x15, 0x55, 0xf4, 0x8e, 0x14, 0x09, 0x24, 0x16, 0xd4, 0x4c, 0x2c, 0x91, 0x50, 0x0a, 0x0b, 0x03,
+	0xd5, 0x25, 0x39, 0xae, 0x46, 0xd8, 0x67, 0xf9, 0xbd, 0x44, 0x61, 0x43, 0x7c, 0x01, 0x2a, 0x3e,
+	0x49, 0x07, 0x66, 0x66, 0x26, 0x54, 0x31, 0xb1, 0x81, 0x92, 0xa1, 0x5f, 0x03, 0xd9, 0x77, 0x76,
+	0x5c, 0x50, 0x4a, 0x06, 0x24, 0x26, 0xdf, 0xdd, 0xfb, 0x7b, 0xee, 0x79, 0xee, 0x9f, 0xa1, 0x39,
+	0x54, 0x18, 0x2a, 0x64, 0xc8, 0xa3, 0xa1, 0x0e, 0x54, 0xc4, 0x26, 0x9d, 0x81, 0xd0, 0xbc, 0xc3,
+	0xf4, 0x94, 0xc6, 0x89, 0xd2, 0xca, 0xa9, 0x1b, 0x82, 0xe6, 0x04, 0xb5, 0x84, 0x67, 0x0b, 0x2c,
+	0x44, 0xc9, 0x26, 0x9d, 0xf4, 0x63, 0x14, 0xde, 0xce, 0xb2, 0x39, 0x8b, 0x29, 0x0c, 0xb7, 0x6d,
+	0xb8, 0xa3, 0xac, 0xc7, 0xac, 0x8d, 0x29, 0x6d, 0x4a, 0x25, 0x95, 0x19, 0x4f, 0x5b, 0x76, 0xb4,
+	0x21, 0x95, 0x92, 0x6f, 0x04, 0xcb, 0x7a, 0x83, 0xf1, 0x2b, 0xa6, 0x83, 0x50, 0xa0, 0xe6, 0x61,
+	0x6c, 0x80, 0xd6, 0x57, 0x02, 0x1b, 0x3d, 0x94, 0x87, 0xd6, 0xc7, 0x79, 0x00, 0x35, 0x3e, 0x1a,
+	0x25, 0x02, 0x51, 0xa0, 0x4b, 0x9a, 0xd5, 0x76, 0xad, 0xeb, 0x7e, 0xfb, 0xb4, 0xb7, 0x69, 0xbd,
+	0x0e, 0x4c, 0xed, 0x50, 0x27, 0x41, 0x24, 0xfb, 0x0b, 0x34, 0xd3, 0x8d, 0xf5, 0xb1, 0x4a, 0x02,
+	0xfd, 0xd6, 0x5d, 0x6b, 0x92, 0xbf, 0xe8, 0x72, 0xd4, 0x79, 0x0c, 0x20, 0xa6, 0x71, 0x90, 0x08,
+	0x3c, 0xe2, 0xda, 0xad, 0x36, 0x49, 0x7b, 0x63, 0xdf, 0xa3, 0x26, 0x35, 0xcd, 0x53, 0xd3, 0x67,
+	0x79, 0xea, 0xee, 0x95, 0x93, 0x1f, 0x0d, 0xd2, 0xaf, 0x59, 0xcd, 0x81, 0x7e, 0x74, 0xf3, 0xfd,
+	0xf9, 0xe9, 0xee, 0x62, 0xc2, 0xd6, 0x16, 0xdc, 0x2e, 0xad, 0xa7, 0x2f, 0x30, 0x56, 0x11, 0x8a,
+	0xd6, 0x07, 0x02, 0x37, 0x7a, 0x28, 0x9f, 0x47, 0xf8, 0x9f, 0x56, 0xfa, 0x47, 0xd0, 0x3a, 0x6c,
+	0x5d, 0x08, 0x54, 0x44, 0xfd, 0x48, 0xe0, 0x56, 0x5a, 0x89, 0x47, 0x5c, 0x8b, 0xa7, 0x3c, 0xe1,
+	0x21, 0x3a, 0x0f, 0x61, 0x3d, 0xce, 0x5a, 0x2e, 0xc9, 0xb6, 0xa8, 0x41, 0x97, 0xdc, 0x31, 0x6a,
+	0x04, 0x7d, 0x8b, 0xff, 0xb3, 0xb4, 0xdb, 0x50, 0xff, 0x2d, 0x53, 0x9e, 0x77, 0xff, 0xf3, 0x1a,
+	0x54, 0x7b, 0x28, 0x9d, 0x97, 0x70, 0xad, 0xb8, 0x46, 0x77, 0x96, 0xe6, 0x2b, 0x1d, 0x8e, 0x77,
+	0x77, 0x15, 0x2a, 0xf7, 0x71, 0x46, 0x00, 0xa5, 0xe3, 0xdb, 0xb9, 0x4c, 0xbb, 0xe0, 0x3c, 0xba,
+	0x1a, 0x57, 0xb8, 0xbc, 0x86, 0xeb, 0x17, 0x76, 0xbe, 0x7d, 0xa9, 0xbe, 0x44, 0x7a, 0xf7, 0x56,
+	0x25, 0x73, 0x2f, 0xef, 0xea, 0xbb, 0xf3, 0xd3, 0x5d, 0xd2, 0x7d, 0xf2, 0x65, 0xe6, 0x93, 0xb3,
+	0x99, 0x4f, 0x7e, 0xce, 0x7c, 0x72, 0x32, 0xf7, 0x2b, 0x67, 0x73, 0xbf, 0xf2, 0x7d, 0xee, 0x57,
+	0x5e, 0x50, 0x19, 0xe8, 0xe3, 0xf1, 0x80, 0x0e, 0x55, 0x98, 0x3e, 0xe1, 0x89, 0x88, 0x78, 0x34,
+	0x14, 0x7b, 0x81, 0x2a, 0xf5, 0xd8, 0xb4, 0xf8, 0x4b, 0x0c, 0xd6, 0xb3, 0x17, 0x73, 0xff, 0x57,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xc4, 0x9d, 0x9d, 0xa4, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -527,6 +547,16 @@ func (m *MsgSanction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -723,6 +753,10 @@ func (m *MsgSanction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -888,6 +922,42 @@ func (m *MsgSanction) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
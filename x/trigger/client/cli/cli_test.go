@@ -295,6 +295,7 @@ func (s *IntegrationTestSuite) TestAddBlockHeightTrigger() {
 		expectErrMsg string
 		expectedCode uint32
 		expectedIds  []int
+		extraArgs    []string
 	}{
 		{
 			name:         "create block height trigger",
@@ -374,6 +375,15 @@ func (s *IntegrationTestSuite) TestAddBlockHeightTrigger() {
 			expectedCode: 0,
 			expectedIds:  []int{},
 		},
+		{
+			name:         "bad recurrence fee",
+			height:       "1000",
+			fileContent:  "",
+			expectErrMsg: "invalid fee-per-run \"abc\": invalid decimal coin expression: abc",
+			expectedCode: 0,
+			expectedIds:  []int{},
+			extraArgs:    []string{"--interval-blocks=10", "--max-executions=2", "--fee-per-run=abc"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -409,6 +419,7 @@ func (s *IntegrationTestSuite) TestAddBlockHeightTrigger() {
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			}
+			args = append(args, tc.extraArgs...)
 
 			testcli.NewTxExecutor(cmd, args).
 				WithExpErrMsg(tc.expectErrMsg).
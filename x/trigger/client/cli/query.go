@@ -29,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetTriggersCmd(),
+		GetTriggerResultCmd(),
+		GetGasBudgetCmd(),
 	)
 	return queryCmd
 }
@@ -80,6 +82,72 @@ func GetTriggersCmd() *cobra.Command {
 	return cmd
 }
 
+// GetTriggerResultCmd queries for the outcome of the most recent run of a trigger.
+func GetTriggerResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "result <trigger_id>",
+		Aliases: []string{"r"},
+		Short:   "Query the outcome of the most recent run of a trigger's actions",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s result 1`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			triggerID, err := strconv.ParseUint(strings.TrimSpace(args[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid trigger id %q: %w", args[0], err)
+			}
+
+			response, err := queryClient.TriggerResult(
+				context.Background(),
+				&types.QueryTriggerResultRequest{Id: triggerID},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to query result of trigger %d: %w", triggerID, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetGasBudgetCmd queries for the gas an owner has left for the executions of its triggers.
+func GetGasBudgetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gas-budget <owner>",
+		Aliases: []string{"budget", "b"},
+		Short:   "Query the gas an owner has left for the executions of its triggers",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s gas-budget pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner := strings.TrimSpace(args[0])
+			response, err := queryClient.GasBudget(
+				context.Background(),
+				&types.QueryGasBudgetRequest{Owner: owner},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to query gas budget of %s: %w", owner, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // queryTriggerByID queries for one trigger by id.
 func queryTriggerByID(client client.Context, queryClient types.QueryClient, arg string) error {
 	triggerID, err := strconv.Atoi(arg)
@@ -20,6 +20,16 @@ import (
 	"github.com/provenance-io/provenance/x/trigger/types"
 )
 
+const (
+	FlagIntervalBlocks = "interval-blocks"
+	FlagInterval       = "interval"
+	FlagMaxExecutions  = "max-executions"
+	FlagFeePerRun      = "fee-per-run"
+	FlagMaxRetries     = "max-retries"
+	FlagGranter        = "granter"
+	FlagUseGasBudget   = "use-gas-budget"
+)
+
 // NewTxCmd is the top-level command for trigger CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -35,7 +45,12 @@ func NewTxCmd() *cobra.Command {
 		GetCmdAddTransactionTrigger(),
 		GetCmdAddBlockHeightTrigger(),
 		GetCmdAddBlockTimeTrigger(),
+		GetCmdAddNetAssetValueTrigger(),
+		GetCmdAddAttributeTrigger(),
+		GetCmdAddAttributeExpiringTrigger(),
+		GetCmdAddOrderFilledTrigger(),
 		GetCmdDestroyTrigger(),
+		GetCmdTopUpGasBudget(),
 	)
 
 	return txCmd
@@ -107,10 +122,25 @@ Example of message.json contents:
 			if err != nil {
 				return fmt.Errorf("error creating %T: %w", msg, err)
 			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -124,6 +154,7 @@ func GetCmdAddBlockHeightTrigger() *cobra.Command {
 		Short:   "Creates a new trigger that fires when a block height is reached",
 		Long:    strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the block height event has occurred`),
 		Example: fmt.Sprintf(`$ %[1]s tx trigger create-height-trigger 500 message.json
+$ %[1]s tx trigger create-height-trigger 500 message.json --%[2]s 100 --%[3]s 10 --%[4]s 50nhash
 
 Example of message.json contents:
 {
@@ -137,7 +168,7 @@ Example of message.json contents:
 		}
 	]
 }`,
-			version.AppName),
+			version.AppName, FlagIntervalBlocks, FlagMaxExecutions, FlagFeePerRun),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -166,10 +197,31 @@ Example of message.json contents:
 			if err != nil {
 				return fmt.Errorf("error creating %T: %w", msg, err)
 			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
+			msg.Recurrence, err = parseRecurrence(cmd)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Uint64(FlagIntervalBlocks, 0, "Repeat the trigger every this many blocks")
+	addRecurrenceFlags(cmd)
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -183,6 +235,7 @@ func GetCmdAddBlockTimeTrigger() *cobra.Command {
 		Short:   "Creates a new trigger that fires when a block time is reached",
 		Long:    strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the block time event has occurred`),
 		Example: fmt.Sprintf(`$ %[1]s tx trigger create-time-trigger 2006-01-02T15:04:05-04:00 message.json
+$ %[1]s tx trigger create-time-trigger 2006-01-02T15:04:05-04:00 message.json --%[2]s 24h --%[3]s 30 --%[4]s 50nhash
 		
 Example of message.json contents:
 {
@@ -196,7 +249,7 @@ Example of message.json contents:
 		}
 	]
 }`,
-			version.AppName),
+			version.AppName, FlagInterval, FlagMaxExecutions, FlagFeePerRun),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -225,10 +278,273 @@ Example of message.json contents:
 			if err != nil {
 				return fmt.Errorf("error creating %T: %w", msg, err)
 			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
+			msg.Recurrence, err = parseRecurrence(cmd)
+			if err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Duration(FlagInterval, 0, "Repeat the trigger after this much time has passed, e.g. 24h")
+	addRecurrenceFlags(cmd)
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddNetAssetValueTrigger is a command to add a trigger for a marker net asset value crossing a threshold.
+func GetCmdAddNetAssetValueTrigger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-nav-trigger <marker-denom> <price-denom> {above|below} <threshold> <msg.json>",
+		Args:    cobra.ExactArgs(5),
+		Aliases: []string{"nt", "nav"},
+		Short:   "Creates a new trigger that fires when a marker's net asset value crosses a threshold",
+		Long: strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the price of a single marker token,
+according to the marker's net asset value in the price denom, is at or above (or at or below) the threshold`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger create-nav-trigger hotdog usd above 2.5 message.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+
+			var direction types.ThresholdDirection
+			switch strings.ToLower(args[2]) {
+			case "above":
+				direction = types.ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE
+			case "below":
+				direction = types.ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW
+			default:
+				return fmt.Errorf("invalid direction %q: must be above or below", args[2])
+			}
+
+			msgs, err := parseMessages(clientCtx.Codec, args[4])
+			if err != nil {
+				return fmt.Errorf("unable to parse message file: %w", err)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no actions added to trigger")
+			}
+
+			msg, err := types.NewCreateTriggerRequest(
+				[]string{callerAddr.String()},
+				&types.NetAssetValueEvent{MarkerDenom: args[0], PriceDenom: args[1], Threshold: args[3], Direction: direction},
+				msgs,
+			)
+			if err != nil {
+				return fmt.Errorf("error creating %T: %w", msg, err)
+			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddAttributeTrigger is a command to add a trigger for an account having an attribute.
+func GetCmdAddAttributeTrigger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-attribute-trigger <account> <attribute-name> <msg.json>",
+		Args:    cobra.ExactArgs(3),
+		Aliases: []string{"at", "attribute"},
+		Short:   "Creates a new trigger that fires when an account has an attribute",
+		Long:    strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the account has the attribute`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger create-attribute-trigger tp1v38sj5m2dm84nsf3efv2qy6pc8msr4zqu7c3cg kyc.provenance.io message.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+
+			msgs, err := parseMessages(clientCtx.Codec, args[2])
+			if err != nil {
+				return fmt.Errorf("unable to parse message file: %w", err)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no actions added to trigger")
+			}
+
+			msg, err := types.NewCreateTriggerRequest(
+				[]string{callerAddr.String()},
+				&types.AttributeEvent{Account: args[0], Name: args[1]},
+				msgs,
+			)
+			if err != nil {
+				return fmt.Errorf("error creating %T: %w", msg, err)
+			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddAttributeExpiringTrigger is a command to add a trigger for an account's attribute nearing its expiration date.
+func GetCmdAddAttributeExpiringTrigger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-attribute-expiring-trigger <account> <attribute-name> <notice-period> <msg.json>",
+		Args:    cobra.ExactArgs(4),
+		Aliases: []string{"aet", "attribute-expiring"},
+		Short:   "Creates a new trigger that fires when an account's attribute is about to expire",
+		Long: strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the account's attribute
+will expire within the notice period (e.g. 72h).  An EventAttributeExpiring is emitted when the trigger fires`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger create-attribute-expiring-trigger tp1v38sj5m2dm84nsf3efv2qy6pc8msr4zqu7c3cg kyc.provenance.io 72h message.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+
+			noticePeriod, err := time.ParseDuration(args[2])
+			if err != nil {
+				return fmt.Errorf("unable to parse notice period: %w", err)
+			}
+
+			msgs, err := parseMessages(clientCtx.Codec, args[3])
+			if err != nil {
+				return fmt.Errorf("unable to parse message file: %w", err)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no actions added to trigger")
+			}
+
+			msg, err := types.NewCreateTriggerRequest(
+				[]string{callerAddr.String()},
+				&types.AttributeExpiringEvent{Account: args[0], Name: args[1], NoticePeriod: noticePeriod},
+				msgs,
+			)
+			if err != nil {
+				return fmt.Errorf("error creating %T: %w", msg, err)
+			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddOrderFilledTrigger is a command to add a trigger for an exchange order being filled.
+func GetCmdAddOrderFilledTrigger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-order-filled-trigger <order-id> <msg.json>",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"ot", "order-filled"},
+		Short:   "Creates a new trigger that fires when an exchange order is filled",
+		Long:    strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the exchange order has been completely filled`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger create-order-filled-trigger 42 message.json`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id %q: %w", args[0], err)
+			}
+
+			msgs, err := parseMessages(clientCtx.Codec, args[1])
+			if err != nil {
+				return fmt.Errorf("unable to parse message file: %w", err)
+			}
+			if len(msgs) == 0 {
+				return fmt.Errorf("no actions added to trigger")
+			}
+
+			msg, err := types.NewCreateTriggerRequest(
+				[]string{callerAddr.String()},
+				&types.OrderFilledEvent{OrderId: orderID},
+				msgs,
+			)
+			if err != nil {
+				return fmt.Errorf("error creating %T: %w", msg, err)
+			}
+			msg.RetryPolicy, err = parseRetryPolicy(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Granter, err = cmd.Flags().GetString(FlagGranter)
+			if err != nil {
+				return err
+			}
+			msg.UseGasBudget, err = cmd.Flags().GetBool(FlagUseGasBudget)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "The number of times to retry the actions if they fail")
+	cmd.Flags().String(FlagGranter, "", "The account to run the actions as, using the authz grants it has given to the trigger owner")
+	cmd.Flags().Bool(FlagUseGasBudget, false, "Pay for the trigger's executions from the owner's gas budget instead of this tx's gas")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -264,6 +580,40 @@ func GetCmdDestroyTrigger() *cobra.Command {
 	return cmd
 }
 
+// GetCmdTopUpGasBudget is a command to add gas to an owner's trigger gas budget.
+func GetCmdTopUpGasBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-up-gas-budget <gas> [owner]",
+		Args:    cobra.RangeArgs(1, 2),
+		Aliases: []string{"top-up"},
+		Short:   "Adds gas to the budget that pays for an owner's trigger executions.",
+		Long: strings.TrimSpace(`Adds gas to the budget that pays for the executions of an owner's triggers.
+The gas is consumed from this transaction, so the transaction's gas must cover it. The owner defaults to the signer.
+Triggers created with --` + FlagUseGasBudget + ` draw from the budget each time they run.`),
+		Example: fmt.Sprintf(`$ %[1]s tx trigger top-up-gas-budget 500000 --gas 600000`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			callerAddr := clientCtx.GetFromAddress()
+			gas, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gas %q: %w", args[0], err)
+			}
+			owner := callerAddr.String()
+			if len(args) > 1 {
+				owner = args[1]
+			}
+
+			msg := types.NewTopUpGasBudgetRequest(owner, callerAddr.String(), gas)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // parseMessages reads and parses the message.
 func parseMessages(cdc codec.Codec, path string) ([]sdk.Msg, error) {
 	contents, err := os.ReadFile(path)
@@ -295,3 +645,58 @@ func parseEvent(path string) (*types.TransactionEvent, error) {
 
 	return &event, nil
 }
+
+// addRecurrenceFlags adds the flags shared by the commands that can create a recurring trigger.
+func addRecurrenceFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "The maximum number of times a recurring trigger will fire")
+	cmd.Flags().String(FlagFeePerRun, "", "The fee collected each time a recurring trigger fires, held from the owner's account up front")
+}
+
+// parseRecurrence reads the recurrence flags. Returns nil if no recurrence interval was provided.
+func parseRecurrence(cmd *cobra.Command) (*types.Recurrence, error) {
+	var rv types.Recurrence
+	var err error
+	if cmd.Flags().Lookup(FlagIntervalBlocks) != nil {
+		rv.IntervalBlocks, err = cmd.Flags().GetUint64(FlagIntervalBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cmd.Flags().Lookup(FlagInterval) != nil {
+		rv.Interval, err = cmd.Flags().GetDuration(FlagInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if rv.IntervalBlocks == 0 && rv.Interval == 0 {
+		return nil, nil
+	}
+
+	rv.MaxExecutions, err = cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := cmd.Flags().GetString(FlagFeePerRun)
+	if err != nil {
+		return nil, err
+	}
+	if len(fee) > 0 {
+		rv.FeePerRun, err = sdk.ParseCoinsNormalized(fee)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", FlagFeePerRun, fee, err)
+		}
+	}
+	return &rv, nil
+}
+
+// parseRetryPolicy reads the retry flag. Returns nil if no retries were requested.
+func parseRetryPolicy(cmd *cobra.Command) (*types.RetryPolicy, error) {
+	maxRetries, err := cmd.Flags().GetUint32(FlagMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+	if maxRetries == 0 {
+		return nil, nil
+	}
+	return &types.RetryPolicy{MaxRetries: maxRetries}, nil
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,11 +16,22 @@ func (k Keeper) DetectBlockEvents(ctx sdk.Context) {
 	triggers := k.detectTransactionEvents(ctx)
 	triggers = append(triggers, k.detectBlockHeightEvents(ctx)...)
 	triggers = append(triggers, k.detectTimeEvents(ctx)...)
+	triggers = append(triggers, k.detectNetAssetValueEvents(ctx)...)
+	triggers = append(triggers, k.detectAttributeEvents(ctx)...)
+	triggers = append(triggers, k.detectAttributeExpiringEvents(ctx)...)
+	triggers = append(triggers, k.detectOrderFilledEvents(ctx)...)
 
 	for _, trigger := range triggers {
 		k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d added to queue", trigger.Id))
 		k.emitTriggerDetected(ctx, trigger)
 		k.UnregisterTrigger(ctx, trigger)
+		if trigger.Recurrence != nil {
+			var queue bool
+			if trigger, queue = k.recordExecution(ctx, trigger); !queue {
+				k.RemoveGasLimit(ctx, trigger.Id)
+				continue
+			}
+		}
 		k.QueueTrigger(ctx, trigger)
 	}
 }
@@ -81,6 +95,101 @@ func (k Keeper) detectTimeEvents(ctx sdk.Context) (triggers []types.Trigger) {
 	return
 }
 
+// detectNetAssetValueEvents Detects triggers that have been activated by a marker's net asset value crossing a threshold.
+func (k Keeper) detectNetAssetValueEvents(ctx sdk.Context) (triggers []types.Trigger) {
+	match := func(_ types.Trigger, triggerEvent types.TriggerEventI) bool {
+		navEvent := triggerEvent.(*types.NetAssetValueEvent)
+		nav, err := k.markerKeeper.GetNetAssetValue(ctx, navEvent.MarkerDenom, navEvent.PriceDenom)
+		if err != nil || nav == nil {
+			return false
+		}
+		return navEvent.Matches(*nav)
+	}
+	terminator := func(_ types.Trigger, _ types.TriggerEventI) bool {
+		return false
+	}
+
+	triggers = k.getMatchingTriggersUntil(ctx, types.NetAssetValuePrefix, match, terminator)
+	return
+}
+
+// detectAttributeEvents Detects triggers that have been activated by an account having an attribute.
+func (k Keeper) detectAttributeEvents(ctx sdk.Context) (triggers []types.Trigger) {
+	match := func(_ types.Trigger, triggerEvent types.TriggerEventI) bool {
+		attrEvent := triggerEvent.(*types.AttributeEvent)
+		attrs, err := k.attributeKeeper.GetAttributes(ctx, attrEvent.Account, attrEvent.Name)
+		return err == nil && len(attrs) > 0
+	}
+	terminator := func(_ types.Trigger, _ types.TriggerEventI) bool {
+		return false
+	}
+
+	triggers = k.getMatchingTriggersUntil(ctx, types.AttributePrefix, match, terminator)
+	return
+}
+
+// detectAttributeExpiringEvents Detects triggers that have been activated by an account's attribute nearing its expiration date.
+// An EventAttributeExpiring is emitted for each detected trigger so the owner knows the attribute needs to be re-verified.
+func (k Keeper) detectAttributeExpiringEvents(ctx sdk.Context) (triggers []types.Trigger) {
+	blockTime := ctx.BlockTime().UTC()
+	match := func(trigger types.Trigger, triggerEvent types.TriggerEventI) bool {
+		expiringEvent := triggerEvent.(*types.AttributeExpiringEvent)
+		attrs, err := k.attributeKeeper.GetAttributes(ctx, expiringEvent.Account, expiringEvent.Name)
+		if err != nil {
+			return false
+		}
+		for _, attr := range attrs {
+			if expiringEvent.Matches(attr.ExpirationDate, blockTime) {
+				k.emitAttributeExpiring(ctx, trigger, *expiringEvent, *attr.ExpirationDate)
+				return true
+			}
+		}
+		return false
+	}
+	terminator := func(_ types.Trigger, _ types.TriggerEventI) bool {
+		return false
+	}
+
+	triggers = k.getMatchingTriggersUntil(ctx, types.AttributeExpiringPrefix, match, terminator)
+	return
+}
+
+// detectOrderFilledEvents Detects triggers that have been activated by exchange orders being filled in this block.
+func (k Keeper) detectOrderFilledEvents(ctx sdk.Context) (triggers []types.Trigger) {
+	abciEventHistory, ok := ctx.EventManager().(sdk.EventManagerWithHistoryI)
+	if !ok {
+		panic("event manager does not implement EventManagerWithHistoryI")
+	}
+
+	filled := map[uint64]bool{}
+	for _, event := range abciEventHistory.GetABCIEventHistory() {
+		if event.GetType() != types.OrderFilledEventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.GetKey() != types.OrderFilledOrderIDKey {
+				continue
+			}
+			if orderID, err := strconv.ParseUint(strings.Trim(attr.GetValue(), `"`), 10, 64); err == nil {
+				filled[orderID] = true
+			}
+		}
+	}
+	if len(filled) == 0 {
+		return nil
+	}
+
+	match := func(_ types.Trigger, triggerEvent types.TriggerEventI) bool {
+		return filled[triggerEvent.(*types.OrderFilledEvent).OrderId]
+	}
+	terminator := func(_ types.Trigger, _ types.TriggerEventI) bool {
+		return false
+	}
+
+	triggers = k.getMatchingTriggersUntil(ctx, types.OrderFilledPrefix, match, terminator)
+	return
+}
+
 // getMatchingTriggersUntil Gets the triggers with a specified prefix that are ready to be activated and fulfill the given condition until a specific ending condition is reached.
 func (k Keeper) getMatchingTriggersUntil(ctx sdk.Context, prefix string, match func(types.Trigger, types.TriggerEventI) bool, terminator func(types.Trigger, types.TriggerEventI) bool) (triggers []types.Trigger) {
 	err := k.IterateEventListeners(ctx, prefix, func(trigger types.Trigger) (stop bool, err error) {
@@ -106,3 +215,17 @@ func (k Keeper) emitTriggerDetected(ctx sdk.Context, trigger types.Trigger) {
 		ctx.Logger().Error("unable to emit EventTriggerDetected", "err", err)
 	}
 }
+
+// emitAttributeExpiring Emits an EventAttributeExpiring for the provided trigger and attribute expiration date.
+func (k Keeper) emitAttributeExpiring(ctx sdk.Context, trigger types.Trigger, event types.AttributeExpiringEvent, expiration time.Time) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventAttributeExpiring{
+		TriggerId:      fmt.Sprintf("%d", trigger.GetId()),
+		Owner:          trigger.GetOwner(),
+		Account:        event.Account,
+		Name:           event.Name,
+		ExpirationDate: expiration.UTC(),
+	})
+	if err != nil {
+		ctx.Logger().Error("unable to emit EventAttributeExpiring", "err", err)
+	}
+}
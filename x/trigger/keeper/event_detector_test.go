@@ -2,11 +2,18 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
 	"github.com/provenance-io/provenance/testutil/assertions"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/trigger/types"
 )
 
@@ -298,3 +305,81 @@ func (s *KeeperTestSuite) TestDetectBlockEvents() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDetectStateEvents() {
+	owner := s.accountAddresses[0]
+
+	marker := markertypes.NewEmptyMarkerAccount("triggercoin", owner.String(), nil)
+	marker.Supply = sdkmath.NewInt(1_000)
+	marker.MarkerType = markertypes.MarkerType_Coin
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "AddMarkerAccount")
+	nav := markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, 250), 100)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(s.ctx, marker, nav, "test"), "SetNetAssetValue")
+
+	for _, addr := range s.accountAddresses[:2] {
+		s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr))
+	}
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.trigger", owner, false), "SetNameRecord")
+	attr := attributetypes.NewAttribute("kyc.trigger", s.accountAddresses[1].String(), attributetypes.AttributeType_String, []byte("yes"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, attr, owner), "SetAttribute")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "cert.trigger", owner, false), "SetNameRecord")
+	expiration := s.ctx.BlockTime().UTC().Add(48 * time.Hour)
+	expiringAttr := attributetypes.NewAttribute("cert.trigger", s.accountAddresses[1].String(), attributetypes.AttributeType_String, []byte("yes"), &expiration)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, expiringAttr, owner), "SetAttribute with expiration")
+
+	filled, err := sdk.TypedEventToEvent(&exchange.EventOrderFilled{OrderId: 7})
+	s.Require().NoError(err, "TypedEventToEvent(EventOrderFilled)")
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManagerWithHistory(sdk.Events{filled}.ToABCIEvents()))
+
+	events := []types.TriggerEventI{
+		&types.NetAssetValueEvent{MarkerDenom: "triggercoin", PriceDenom: markertypes.UsdDenom, Threshold: "2.5", Direction: types.ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE},
+		&types.NetAssetValueEvent{MarkerDenom: "triggercoin", PriceDenom: markertypes.UsdDenom, Threshold: "2.4", Direction: types.ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW},
+		&types.NetAssetValueEvent{MarkerDenom: "othercoin", PriceDenom: markertypes.UsdDenom, Threshold: "0", Direction: types.ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE},
+		&types.AttributeEvent{Account: s.accountAddresses[1].String(), Name: "kyc.trigger"},
+		&types.AttributeEvent{Account: s.accountAddresses[2].String(), Name: "kyc.trigger"},
+		&types.OrderFilledEvent{OrderId: 7},
+		&types.OrderFilledEvent{OrderId: 8},
+		&types.AttributeExpiringEvent{Account: s.accountAddresses[1].String(), Name: "cert.trigger", NoticePeriod: 72 * time.Hour},
+		&types.AttributeExpiringEvent{Account: s.accountAddresses[1].String(), Name: "cert.trigger", NoticePeriod: 24 * time.Hour},
+		&types.AttributeExpiringEvent{Account: s.accountAddresses[1].String(), Name: "kyc.trigger", NoticePeriod: 72 * time.Hour},
+	}
+	expDetected := []bool{true, false, false, true, false, true, false, true, false, false}
+
+	var triggers []types.Trigger
+	for _, event := range events {
+		actions, _ := sdktx.SetMsgs([]sdk.Msg{&types.MsgDestroyTriggerRequest{Id: 1, Authority: owner.String()}})
+		anyMsg, _ := codectypes.NewAnyWithValue(event)
+		trigger := s.app.TriggerKeeper.NewTriggerWithID(s.ctx, owner.String(), anyMsg, actions)
+		s.app.TriggerKeeper.RegisterTrigger(s.ctx, trigger)
+		s.ctx.GasMeter().RefundGas(s.ctx.GasMeter().GasConsumed(), "testing")
+		triggers = append(triggers, trigger)
+	}
+
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+
+	expEvent, err := sdk.TypedEventToEvent(&types.EventAttributeExpiring{
+		TriggerId:      fmt.Sprintf("%d", triggers[7].Id),
+		Owner:          owner.String(),
+		Account:        s.accountAddresses[1].String(),
+		Name:           "cert.trigger",
+		ExpirationDate: expiration,
+	})
+	s.Require().NoError(err, "TypedEventToEvent(EventAttributeExpiring)")
+	s.Assert().Contains(s.ctx.EventManager().Events(), expEvent, "emitted events")
+
+	queued := map[uint64]bool{}
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems")
+	for _, item := range items {
+		queued[item.Trigger.Id] = true
+	}
+	for i, trigger := range triggers {
+		s.Assert().Equal(expDetected[i], queued[trigger.Id], "trigger %d (%T) detected", trigger.Id, events[i])
+		_, err = s.app.TriggerKeeper.GetTrigger(s.ctx, trigger.Id)
+		if expDetected[i] {
+			s.Assert().ErrorIs(err, types.ErrTriggerNotFound, "GetTrigger(%d) after detection", trigger.Id)
+		} else {
+			s.Assert().NoError(err, "GetTrigger(%d) without detection", trigger.Id)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/trigger/types"
+)
+
+// SetExecutionResult Sets the outcome of a trigger's most recent run in the store.
+func (k Keeper) SetExecutionResult(ctx sdk.Context, result types.ExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&result)
+	store.Set(types.GetExecutionResultKey(result.TriggerId), bz)
+}
+
+// GetExecutionResult Gets the outcome of a trigger's most recent run from the store by trigger id.
+func (k Keeper) GetExecutionResult(ctx sdk.Context, id types.TriggerID) (result types.ExecutionResult, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExecutionResultKey(id))
+	if len(bz) == 0 {
+		return result, types.ErrExecutionResultNotFound
+	}
+	err = k.cdc.Unmarshal(bz, &result)
+	return result, err
+}
+
+// IterateExecutionResults Iterates through all the execution results.
+func (k Keeper) IterateExecutionResults(ctx sdk.Context, handle func(result types.ExecutionResult) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ExecutionResultKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ExecutionResult{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		stop, err := handle(record)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllExecutionResults Gets all the execution results within the store.
+func (k Keeper) GetAllExecutionResults(ctx sdk.Context) (results []types.ExecutionResult, err error) {
+	err = k.IterateExecutionResults(ctx, func(result types.ExecutionResult) (stop bool, err error) {
+		results = append(results, result)
+		return false, nil
+	})
+	return
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/provenance-io/provenance/x/trigger/types"
+)
+
+func (s *KeeperTestSuite) TestGetAndSetExecutionResult() {
+	_, err := s.app.TriggerKeeper.GetExecutionResult(s.ctx, 1)
+	s.Assert().ErrorIs(err, types.ErrExecutionResultNotFound, "GetExecutionResult before set")
+
+	result1 := types.ExecutionResult{TriggerId: 1, BlockHeight: 100, Time: s.ctx.BlockTime(), Success: true, GasUsed: 5, Attempt: 1}
+	result2 := types.ExecutionResult{TriggerId: 2, BlockHeight: 100, Time: s.ctx.BlockTime(), Error: "failed", GasUsed: 7, Attempt: 2}
+	s.app.TriggerKeeper.SetExecutionResult(s.ctx, result1)
+	s.app.TriggerKeeper.SetExecutionResult(s.ctx, result2)
+
+	result, err := s.app.TriggerKeeper.GetExecutionResult(s.ctx, 1)
+	s.Require().NoError(err, "GetExecutionResult(1)")
+	s.Assert().Equal(result1, result, "GetExecutionResult(1)")
+
+	results, err := s.app.TriggerKeeper.GetAllExecutionResults(s.ctx)
+	s.Require().NoError(err, "GetAllExecutionResults")
+	s.Assert().Equal([]types.ExecutionResult{result1, result2}, results, "GetAllExecutionResults")
+
+	response, err := s.queryClient.TriggerResult(s.ctx.Context(), &types.QueryTriggerResultRequest{Id: 2})
+	s.Require().NoError(err, "TriggerResult(2)")
+	s.Assert().Equal(&result2, response.Result, "TriggerResult(2)")
+	_, err = s.queryClient.TriggerResult(s.ctx.Context(), &types.QueryTriggerResultRequest{Id: 3})
+	s.Assert().EqualError(err, types.ErrExecutionResultNotFound.Error(), "TriggerResult(3)")
+
+	genState := s.app.TriggerKeeper.ExportGenesis(s.ctx)
+	s.Assert().Equal([]types.ExecutionResult{result1, result2}, genState.ExecutionResults, "ExportGenesis execution results")
+}
+
+func (s *KeeperTestSuite) TestProcessTriggersRetries() {
+	owner := s.accountAddresses[0].String()
+	s.ctx = s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(60000000))
+
+	// The action fails because trigger 100 doesn't exist.
+	failing := s.CreateTrigger(1, owner, &types.BlockHeightEvent{BlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner})
+	failing.RetryPolicy = &types.RetryPolicy{MaxRetries: 1}
+	noRetry := s.CreateTrigger(2, owner, &types.BlockHeightEvent{BlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner})
+	succeeding := s.CreateTrigger(3, owner, &types.BlockHeightEvent{BlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: 4, Authority: owner})
+	succeeding.RetryPolicy = &types.RetryPolicy{MaxRetries: 1}
+	target := s.CreateTrigger(4, owner, &types.BlockHeightEvent{BlockHeight: 500}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner})
+	s.app.TriggerKeeper.RegisterTrigger(s.ctx, target)
+	s.app.TriggerKeeper.SetGasLimit(s.ctx, target.Id, 100000)
+	for _, trigger := range []types.Trigger{failing, noRetry, succeeding} {
+		s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 100000)
+		s.app.TriggerKeeper.QueueTrigger(s.ctx, trigger)
+	}
+
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+
+	result, err := s.app.TriggerKeeper.GetExecutionResult(s.ctx, failing.Id)
+	s.Require().NoError(err, "GetExecutionResult(failing) first attempt")
+	s.Assert().False(result.Success, "failing success")
+	s.Assert().Contains(result.Error, "trigger not found", "failing error")
+	s.Assert().NotZero(result.GasUsed, "failing gas used")
+	s.Assert().Equal(uint32(1), result.Attempt, "failing attempt")
+	s.Assert().True(result.RetryQueued, "failing retry queued")
+
+	result, err = s.app.TriggerKeeper.GetExecutionResult(s.ctx, noRetry.Id)
+	s.Require().NoError(err, "GetExecutionResult(noRetry)")
+	s.Assert().False(result.Success, "noRetry success")
+	s.Assert().False(result.RetryQueued, "noRetry retry queued")
+	s.Assert().Panics(func() { s.app.TriggerKeeper.GetGasLimit(s.ctx, noRetry.Id) }, "GetGasLimit(noRetry)")
+
+	result, err = s.app.TriggerKeeper.GetExecutionResult(s.ctx, succeeding.Id)
+	s.Require().NoError(err, "GetExecutionResult(succeeding)")
+	s.Assert().True(result.Success, "succeeding success")
+	s.Assert().Empty(result.Error, "succeeding error")
+	s.Assert().False(result.RetryQueued, "succeeding retry queued")
+
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems after first block")
+	s.Require().Len(items, 1, "GetAllQueueItems after first block")
+	s.Assert().Equal(failing.Id, items[0].Trigger.Id, "queued retry trigger id")
+	s.Assert().Equal(uint32(1), items[0].Attempts, "queued retry attempts")
+
+	s.ctx = s.ctx.WithBlockHeight(101)
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+
+	result, err = s.app.TriggerKeeper.GetExecutionResult(s.ctx, failing.Id)
+	s.Require().NoError(err, "GetExecutionResult(failing) second attempt")
+	s.Assert().Equal(uint64(101), result.BlockHeight, "failing block height after retry")
+	s.Assert().Equal(uint32(2), result.Attempt, "failing attempt after retry")
+	s.Assert().False(result.RetryQueued, "failing retry queued after last retry")
+	s.Assert().True(s.app.TriggerKeeper.QueueIsEmpty(s.ctx), "QueueIsEmpty after last retry")
+	s.Assert().Panics(func() { s.app.TriggerKeeper.GetGasLimit(s.ctx, failing.Id) }, "GetGasLimit(failing) after last retry")
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/trigger/types"
+)
+
+// SetGasBudget Sets the gas an owner has left for the executions of its triggers
+func (k Keeper) SetGasBudget(ctx sdk.Context, owner sdk.AccAddress, remaining uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.GetGasLimitBytes(remaining)
+	store.Set(types.GetGasBudgetKey(owner), bz)
+}
+
+// GetGasBudget Gets the gas an owner has left for the executions of its triggers, and whether the owner has a budget
+func (k Keeper) GetGasBudget(ctx sdk.Context, owner sdk.AccAddress) (remaining uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGasBudgetKey(owner))
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return types.GetGasLimitFromBytes(bz), true
+}
+
+// IterateGasBudgets Iterates through all the gas budgets.
+func (k Keeper) IterateGasBudgets(ctx sdk.Context, handle func(budget types.GasBudget) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GasBudgetKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		owner := types.GetGasBudgetOwnerFromKey(iterator.Key())
+		remaining := types.GetGasLimitFromBytes(iterator.Value())
+		stop, err := handle(types.GasBudget{Owner: owner.String(), Remaining: remaining})
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllGasBudgets Gets all the gas budgets within the store.
+func (k Keeper) GetAllGasBudgets(ctx sdk.Context) (budgets []types.GasBudget, err error) {
+	err = k.IterateGasBudgets(ctx, func(budget types.GasBudget) (stop bool, err error) {
+		budgets = append(budgets, budget)
+		return false, nil
+	})
+	return
+}
+
+// TopUpGasBudget Consumes gas from the context and adds it to the owner's gas budget. Returns the new remaining amount.
+func (k Keeper) TopUpGasBudget(ctx sdk.Context, owner sdk.AccAddress, gas uint64) (uint64, error) {
+	remaining, _ := k.GetGasBudget(ctx, owner)
+	if gas > math.MaxUint64-remaining {
+		return 0, fmt.Errorf("gas budget of %s cannot exceed %d", owner, uint64(math.MaxUint64))
+	}
+	ctx.GasMeter().ConsumeGas(gas, "trigger gas budget top up")
+	remaining += gas
+	k.SetGasBudget(ctx, owner, remaining)
+	return remaining, nil
+}
+
+// getBudgetedGasLimit Gets the gas limit for an execution of a trigger paid for from its owner's gas budget.
+func (k Keeper) getBudgetedGasLimit(ctx sdk.Context, trigger types.Trigger) uint64 {
+	owner, err := sdk.AccAddressFromBech32(trigger.Owner)
+	if err != nil {
+		return 0
+	}
+	remaining, _ := k.GetGasBudget(ctx, owner)
+	if remaining > MaximumTriggerGas {
+		return MaximumTriggerGas
+	}
+	return remaining
+}
+
+// drawGasBudget Removes the gas used by a trigger's execution from its owner's gas budget.
+// An EventGasBudgetExhausted is emitted if the budget has no gas left afterwards.
+func (k Keeper) drawGasBudget(ctx sdk.Context, trigger types.Trigger, gasUsed uint64) {
+	owner, err := sdk.AccAddressFromBech32(trigger.Owner)
+	if err != nil {
+		return
+	}
+	remaining, _ := k.GetGasBudget(ctx, owner)
+	if gasUsed > remaining {
+		gasUsed = remaining
+	}
+	remaining -= gasUsed
+	k.SetGasBudget(ctx, owner, remaining)
+	if remaining > 0 {
+		return
+	}
+
+	eventErr := ctx.EventManager().EmitTypedEvent(&types.EventGasBudgetExhausted{
+		Owner:     trigger.Owner,
+		TriggerId: fmt.Sprintf("%d", trigger.GetId()),
+	})
+	if eventErr != nil {
+		ctx.Logger().Error("unable to emit EventGasBudgetExhausted", "err", eventErr)
+	}
+}
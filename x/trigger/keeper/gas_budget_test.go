@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/trigger/types"
+)
+
+func (s *KeeperTestSuite) TestTopUpGasBudget() {
+	owner := s.accountAddresses[0]
+	depositor := s.accountAddresses[1]
+
+	em := sdk.NewEventManager()
+	gasMeter := storetypes.NewGasMeter(1000000)
+	ctx := s.ctx.WithGasMeter(gasMeter).WithEventManager(em)
+	resp, err := s.msgServer.TopUpGasBudget(ctx, types.NewTopUpGasBudgetRequest(owner.String(), depositor.String(), 300000))
+	s.Require().NoError(err, "TopUpGasBudget")
+	s.Equal(uint64(300000), resp.Remaining, "TopUpGasBudget remaining")
+	s.GreaterOrEqual(gasMeter.GasConsumed(), uint64(300000), "gas consumed by TopUpGasBudget")
+	expEvent, _ := sdk.TypedEventToEvent(&types.EventGasBudgetToppedUp{
+		Owner:     owner.String(),
+		Depositor: depositor.String(),
+		Gas:       300000,
+		Remaining: 300000,
+	})
+	s.Equal(sdk.Events{expEvent}, em.Events(), "TopUpGasBudget events")
+
+	resp, err = s.msgServer.TopUpGasBudget(s.ctx, types.NewTopUpGasBudgetRequest(owner.String(), owner.String(), 200000))
+	s.Require().NoError(err, "second TopUpGasBudget")
+	s.Equal(uint64(500000), resp.Remaining, "second TopUpGasBudget remaining")
+
+	s.Run("not enough tx gas", func() {
+		ctx := s.ctx.WithGasMeter(storetypes.NewGasMeter(1000))
+		s.Panics(func() {
+			_, _ = s.msgServer.TopUpGasBudget(ctx, types.NewTopUpGasBudgetRequest(owner.String(), owner.String(), 5000))
+		}, "TopUpGasBudget with too little tx gas")
+	})
+
+	queryResp, err := s.queryClient.GasBudget(s.ctx, &types.QueryGasBudgetRequest{Owner: owner.String()})
+	s.Require().NoError(err, "GasBudget query")
+	s.Equal(uint64(500000), queryResp.Remaining, "GasBudget query remaining")
+
+	_, err = s.queryClient.GasBudget(s.ctx, &types.QueryGasBudgetRequest{Owner: depositor.String()})
+	s.ErrorContains(err, types.ErrGasBudgetNotFound.Error(), "GasBudget query without a budget")
+
+	budgets, err := s.app.TriggerKeeper.GetAllGasBudgets(s.ctx)
+	s.Require().NoError(err, "GetAllGasBudgets")
+	s.Equal([]types.GasBudget{{Owner: owner.String(), Remaining: 500000}}, budgets, "GetAllGasBudgets")
+}
+
+func (s *KeeperTestSuite) TestProcessTriggersWithGasBudget() {
+	owner := s.accountAddresses[0]
+	s.ctx = s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(60000000))
+	event := &types.BlockHeightEvent{BlockHeight: 130}
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner.String()}
+
+	request := types.MustNewCreateTriggerRequest([]string{owner.String()}, event, []sdk.Msg{action})
+	request.UseGasBudget = true
+	_, err := s.msgServer.CreateTrigger(s.ctx, request)
+	s.ErrorIs(err, types.ErrGasBudgetNotFound, "CreateTrigger without a gas budget")
+
+	s.app.TriggerKeeper.SetGasBudget(s.ctx, owner, 1000000)
+	gasMeter := storetypes.NewGasMeter(9999999999)
+	resp, err := s.msgServer.CreateTrigger(s.ctx.WithGasMeter(gasMeter), request)
+	s.Require().NoError(err, "CreateTrigger with a gas budget")
+	s.Less(gasMeter.GasConsumed(), uint64(2000000), "gas consumed by CreateTrigger with a gas budget")
+	s.Equal(uint64(0), s.app.TriggerKeeper.GetGasLimit(s.ctx, resp.Id), "gas limit of budgeted trigger")
+
+	trigger, err := s.app.TriggerKeeper.GetTrigger(s.ctx, resp.Id)
+	s.Require().NoError(err, "GetTrigger")
+	s.app.TriggerKeeper.UnregisterTrigger(s.ctx, trigger)
+	s.app.TriggerKeeper.QueueTrigger(s.ctx, trigger)
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+
+	result, err := s.app.TriggerKeeper.GetExecutionResult(s.ctx, trigger.Id)
+	s.Require().NoError(err, "GetExecutionResult")
+	remaining, found := s.app.TriggerKeeper.GetGasBudget(s.ctx, owner)
+	s.True(found, "GetGasBudget found")
+	s.Equal(1000000-result.GasUsed, remaining, "gas budget after execution")
+
+	s.Run("exhausted budget", func() {
+		s.app.TriggerKeeper.SetGasBudget(s.ctx, owner, 0)
+		trigger.Id = 50
+		s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 0)
+		s.app.TriggerKeeper.QueueTrigger(s.ctx, trigger)
+
+		em := sdk.NewEventManager()
+		s.app.TriggerKeeper.ProcessTriggers(s.ctx.WithEventManager(em))
+		result, err := s.app.TriggerKeeper.GetExecutionResult(s.ctx, trigger.Id)
+		s.Require().NoError(err, "GetExecutionResult")
+		s.False(result.Success, "execution success with an exhausted gas budget")
+		s.Contains(result.Error, types.ErrGasBudgetExhausted.Error(), "execution error with an exhausted gas budget")
+		expEvent, _ := sdk.TypedEventToEvent(&types.EventGasBudgetExhausted{
+			Owner:     owner.String(),
+			TriggerId: fmt.Sprintf("%d", trigger.Id),
+		})
+		s.Contains(em.Events(), expEvent, "events with an exhausted gas budget")
+	})
+}
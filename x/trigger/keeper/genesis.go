@@ -26,7 +26,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(triggerID, queueStartIndex, triggers, gasLimits, queue)
+	results, err := k.GetAllExecutionResults(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	budgets, err := k.GetAllGasBudgets(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(triggerID, queueStartIndex, triggers, gasLimits, queue)
+	genState.ExecutionResults = results
+	genState.GasBudgets = budgets
+	return genState
 }
 
 // InitGenesis new trigger genesis
@@ -53,4 +66,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		k.SetTrigger(ctx, trigger)
 		k.SetEventListener(ctx, trigger)
 	}
+
+	for _, result := range data.ExecutionResults {
+		k.SetExecutionResult(ctx, result)
+	}
+
+	for _, budget := range data.GasBudgets {
+		k.SetGasBudget(ctx, sdk.MustAccAddressFromBech32(budget.Owner), budget.Remaining)
+	}
 }
@@ -12,20 +12,35 @@ import (
 )
 
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
-	router   baseapp.IMsgServiceRouter
+	storeKey        storetypes.StoreKey
+	cdc             codec.BinaryCodec
+	router          baseapp.IMsgServiceRouter
+	markerKeeper    types.MarkerKeeper
+	attributeKeeper types.AttributeKeeper
+	holdKeeper      types.HoldKeeper
+	bankKeeper      types.BankKeeper
+	authzKeeper     types.AuthzKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
 	router baseapp.IMsgServiceRouter,
+	markerKeeper types.MarkerKeeper,
+	attributeKeeper types.AttributeKeeper,
+	holdKeeper types.HoldKeeper,
+	bankKeeper types.BankKeeper,
+	authzKeeper types.AuthzKeeper,
 ) Keeper {
 	return Keeper{
-		storeKey: key,
-		cdc:      cdc,
-		router:   router,
+		storeKey:        key,
+		cdc:             cdc,
+		router:          router,
+		markerKeeper:    markerKeeper,
+		attributeKeeper: attributeKeeper,
+		holdKeeper:      holdKeeper,
+		bankKeeper:      bankKeeper,
+		authzKeeper:     authzKeeper,
 	}
 }
 
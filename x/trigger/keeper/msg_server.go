@@ -34,7 +34,21 @@ func (s msgServer) CreateTrigger(goCtx context.Context, msg *types.MsgCreateTrig
 	}
 
 	trigger := s.NewTriggerWithID(ctx, msg.GetAuthorities()[0], msg.GetEvent(), msg.GetActions())
-	s.RegisterTrigger(ctx, trigger)
+	trigger.Recurrence = msg.GetRecurrence()
+	trigger.RetryPolicy = msg.GetRetryPolicy()
+	trigger.Granter = msg.GetGranter()
+	if err = s.holdRecurrenceFees(ctx, trigger); err != nil {
+		return nil, fmt.Errorf("unable to hold recurring trigger fees: %w", err)
+	}
+	if msg.GetUseGasBudget() {
+		owner := sdk.MustAccAddressFromBech32(trigger.Owner)
+		if _, found := s.GetGasBudget(ctx, owner); !found {
+			return nil, types.ErrGasBudgetNotFound.Wrapf("owner %s must top up a gas budget first", trigger.Owner)
+		}
+		s.RegisterBudgetedTrigger(ctx, trigger)
+	} else {
+		s.RegisterTrigger(ctx, trigger)
+	}
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventTriggerCreated{
 		TriggerId: fmt.Sprintf("%d", trigger.GetId()),
@@ -57,6 +71,9 @@ func (s msgServer) DestroyTrigger(goCtx context.Context, msg *types.MsgDestroyTr
 	if trigger.GetOwner() != msg.GetAuthority() {
 		return nil, types.ErrInvalidTriggerAuthority
 	}
+	if err = s.releaseRecurrenceFees(ctx, trigger); err != nil {
+		return nil, fmt.Errorf("unable to release recurring trigger fees: %w", err)
+	}
 	s.UnregisterTrigger(ctx, trigger)
 	s.RemoveGasLimit(ctx, trigger.GetId())
 
@@ -69,3 +86,26 @@ func (s msgServer) DestroyTrigger(goCtx context.Context, msg *types.MsgDestroyTr
 
 	return &types.MsgDestroyTriggerResponse{}, nil
 }
+
+// TopUpGasBudget adds gas to an owner's trigger gas budget from msg
+func (s msgServer) TopUpGasBudget(goCtx context.Context, msg *types.MsgTopUpGasBudgetRequest) (*types.MsgTopUpGasBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner := sdk.MustAccAddressFromBech32(msg.GetOwner())
+	remaining, err := s.Keeper.TopUpGasBudget(ctx, owner, msg.GetGas())
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGasBudgetToppedUp{
+		Owner:     msg.GetOwner(),
+		Depositor: msg.GetDepositor(),
+		Gas:       msg.GetGas(),
+		Remaining: remaining,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTopUpGasBudgetResponse{Remaining: remaining}, nil
+}
@@ -64,3 +64,35 @@ func (k Keeper) Triggers(ctx context.Context, req *types.QueryTriggersRequest) (
 
 	return &response, nil
 }
+
+// TriggerResult returns the outcome of the most recent run of a trigger's actions.
+func (k Keeper) TriggerResult(ctx context.Context, req *types.QueryTriggerResultRequest) (*types.QueryTriggerResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	result, err := k.GetExecutionResult(sdkCtx, req.GetId())
+	if err != nil {
+		return &types.QueryTriggerResultResponse{}, err
+	}
+	return &types.QueryTriggerResultResponse{Result: &result}, nil
+}
+
+// GasBudget returns the gas an owner has left for the executions of its triggers.
+func (k Keeper) GasBudget(ctx context.Context, req *types.QueryGasBudgetRequest) (*types.QueryGasBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	remaining, found := k.GetGasBudget(sdkCtx, owner)
+	if !found {
+		return &types.QueryGasBudgetResponse{}, types.ErrGasBudgetNotFound
+	}
+	return &types.QueryGasBudgetResponse{Remaining: remaining}, nil
+}
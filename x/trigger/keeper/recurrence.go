@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/trigger/types"
+)
+
+// holdRecurrenceFees Places the fees for all of a recurring trigger's remaining executions on hold in the owner's account.
+func (k Keeper) holdRecurrenceFees(ctx sdk.Context, trigger types.Trigger) error {
+	if trigger.Recurrence == nil {
+		return nil
+	}
+	fees := trigger.Recurrence.RemainingFees()
+	if fees.IsZero() {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(trigger.Owner)
+	if err != nil {
+		return err
+	}
+	return k.holdKeeper.AddHold(ctx, owner, fees, fmt.Sprintf("trigger %d", trigger.Id))
+}
+
+// releaseRecurrenceFees Releases the held fees for all of a recurring trigger's remaining executions.
+func (k Keeper) releaseRecurrenceFees(ctx sdk.Context, trigger types.Trigger) error {
+	if trigger.Recurrence == nil {
+		return nil
+	}
+	fees := trigger.Recurrence.RemainingFees()
+	if fees.IsZero() {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(trigger.Owner)
+	if err != nil {
+		return err
+	}
+	return k.holdKeeper.ReleaseHold(ctx, owner, fees)
+}
+
+// chargeRecurrenceFee Collects the fee for one execution of a recurring trigger from its held funds.
+func (k Keeper) chargeRecurrenceFee(ctx sdk.Context, trigger types.Trigger) error {
+	fee := trigger.Recurrence.FeePerRun
+	if fee.IsZero() {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(trigger.Owner)
+	if err != nil {
+		return err
+	}
+	if err = k.holdKeeper.ReleaseHold(ctx, owner, fee); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, fee)
+}
+
+// recordExecution Charges a recurring trigger for an execution and registers it again if it has executions left.
+// The trigger that should be queued is returned along with whether it should be queued.
+func (k Keeper) recordExecution(ctx sdk.Context, trigger types.Trigger) (types.Trigger, bool) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.chargeRecurrenceFee(cacheCtx, trigger); err != nil {
+		k.Logger(ctx).Error("unable to charge recurring trigger fee", "trigger_id", trigger.Id, "error", err)
+		if err = k.releaseRecurrenceFees(ctx, trigger); err != nil {
+			k.Logger(ctx).Error("unable to release recurring trigger fees", "trigger_id", trigger.Id, "error", err)
+		}
+		return trigger, false
+	}
+	writeCache()
+
+	recurrence := *trigger.Recurrence
+	recurrence.Executions++
+	trigger.Recurrence = &recurrence
+	if recurrence.IsDone() {
+		return trigger, true
+	}
+
+	next := trigger
+	event, err := codectypes.NewAnyWithValue(recurrence.NextEvent(ctx))
+	if err != nil {
+		k.Logger(ctx).Error("unable to create next recurring trigger event", "trigger_id", trigger.Id, "error", err)
+		return trigger, true
+	}
+	next.Event = event
+	k.SetTrigger(ctx, next)
+	k.SetEventListener(ctx, next)
+	k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d registered for execution %d", trigger.Id, recurrence.Executions+1))
+
+	return trigger, true
+}
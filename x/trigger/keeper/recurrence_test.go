@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/provenance-io/provenance/x/trigger/types"
+)
+
+func (s *KeeperTestSuite) TestRecurringTrigger() {
+	owner := s.accountAddresses[0]
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, owner))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, owner, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount")
+
+	assertHeld := func(exp string, msg string) {
+		held, err := s.app.HoldKeeper.GetHoldCoins(s.ctx, owner)
+		s.Require().NoError(err, "GetHoldCoins: %s", msg)
+		s.Assert().Equal(exp, held.String(), "GetHoldCoins: %s", msg)
+	}
+	assertBalance := func(exp string, msg string) {
+		bal := s.app.BankKeeper.GetBalance(s.ctx, owner, "nhash")
+		s.Assert().Equal(exp, bal.String(), "GetBalance: %s", msg)
+	}
+
+	var event types.TriggerEventI = &types.BlockHeightEvent{BlockHeight: 101}
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner.String()}
+	request := types.MustNewCreateTriggerRequest([]string{owner.String()}, event, []sdk.Msg{action})
+	request.Recurrence = &types.Recurrence{
+		IntervalBlocks: 10,
+		MaxExecutions:  2,
+		FeePerRun:      sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)),
+	}
+	s.Require().NoError(request.ValidateBasic(), "ValidateBasic")
+
+	s.ctx = s.ctx.WithGasMeter(storetypes.NewGasMeter(9999999999)).WithBlockGasMeter(storetypes.NewGasMeter(60000000))
+	resp, err := s.msgServer.CreateTrigger(s.ctx, request)
+	s.Require().NoError(err, "CreateTrigger")
+	id := resp.Id
+	assertHeld("200nhash", "after create")
+
+	s.ctx = s.ctx.WithBlockHeight(101)
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	items, err := s.app.TriggerKeeper.GetAllQueueItems(s.ctx)
+	s.Require().NoError(err, "GetAllQueueItems after first execution")
+	s.Require().Len(items, 1, "GetAllQueueItems after first execution")
+	s.Assert().Equal(id, items[0].Trigger.Id, "queued trigger id")
+	assertHeld("100nhash", "after first execution")
+	assertBalance("900nhash", "after first execution")
+
+	trigger, err := s.app.TriggerKeeper.GetTrigger(s.ctx, id)
+	s.Require().NoError(err, "GetTrigger after first execution")
+	s.Assert().Equal(uint64(1), trigger.Recurrence.Executions, "executions after first execution")
+	nextEvent, err := trigger.GetTriggerEventI()
+	s.Require().NoError(err, "GetTriggerEventI after first execution")
+	s.Assert().Equal(&types.BlockHeightEvent{BlockHeight: 111}, nextEvent, "next event")
+	_, err = s.app.TriggerKeeper.GetEventListener(s.ctx, nextEvent.GetEventPrefix(), nextEvent.GetEventOrder(), id)
+	s.Assert().NoError(err, "GetEventListener for next event")
+	s.Assert().NotZero(s.app.TriggerKeeper.GetGasLimit(s.ctx, id), "gas limit kept for next execution")
+
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+	s.Assert().NotZero(s.app.TriggerKeeper.GetGasLimit(s.ctx, id), "gas limit kept after processing")
+
+	s.ctx = s.ctx.WithBlockHeight(111)
+	s.app.TriggerKeeper.DetectBlockEvents(s.ctx)
+	assertHeld("", "after last execution")
+	assertBalance("800nhash", "after last execution")
+	_, err = s.app.TriggerKeeper.GetTrigger(s.ctx, id)
+	s.Assert().ErrorIs(err, types.ErrTriggerNotFound, "GetTrigger after last execution")
+
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+	s.Assert().Panics(func() { s.app.TriggerKeeper.GetGasLimit(s.ctx, id) }, "GetGasLimit after last execution")
+}
+
+func (s *KeeperTestSuite) TestDestroyRecurringTrigger() {
+	owner := s.accountAddresses[0]
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, owner))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, owner, sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))), "FundAccount")
+
+	var event types.TriggerEventI = &types.BlockHeightEvent{BlockHeight: 130}
+	action := &types.MsgDestroyTriggerRequest{Id: 100, Authority: owner.String()}
+	request := types.MustNewCreateTriggerRequest([]string{owner.String()}, event, []sdk.Msg{action})
+	request.Recurrence = &types.Recurrence{
+		IntervalBlocks: 5,
+		MaxExecutions:  3,
+		FeePerRun:      sdk.NewCoins(sdk.NewInt64Coin("nhash", 400)),
+	}
+
+	s.ctx = s.ctx.WithGasMeter(storetypes.NewGasMeter(9999999999))
+	_, err := s.msgServer.CreateTrigger(s.ctx, request)
+	s.Assert().ErrorContains(err, "unable to hold recurring trigger fees", "CreateTrigger with insufficient funds")
+
+	request.Recurrence.FeePerRun = sdk.NewCoins(sdk.NewInt64Coin("nhash", 300))
+	resp, err := s.msgServer.CreateTrigger(s.ctx, request)
+	s.Require().NoError(err, "CreateTrigger")
+	held, err := s.app.HoldKeeper.GetHoldCoins(s.ctx, owner)
+	s.Require().NoError(err, "GetHoldCoins after create")
+	s.Assert().Equal("900nhash", held.String(), "GetHoldCoins after create")
+
+	_, err = s.msgServer.DestroyTrigger(s.ctx, types.NewDestroyTriggerRequest(owner.String(), resp.Id))
+	s.Require().NoError(err, "DestroyTrigger")
+	held, err = s.app.HoldKeeper.GetHoldCoins(s.ctx, owner)
+	s.Require().NoError(err, "GetHoldCoins after destroy")
+	s.Assert().Empty(held, "GetHoldCoins after destroy")
+}
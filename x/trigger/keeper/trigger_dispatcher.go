@@ -6,7 +6,6 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
@@ -19,34 +18,69 @@ const (
 )
 
 // ProcessTriggers Reads triggers from queues and attempts to run them.
+// Triggers whose actions fail are queued again for a later block if their retry policy allows it.
 func (k Keeper) ProcessTriggers(ctx sdk.Context) {
 	var actionsProcessed uint64
 	var gasConsumed uint64
+	var retries []types.QueuedTrigger
 
 	for !k.QueueIsEmpty(ctx) && actionsProcessed < MaximumActions {
 		item := k.QueuePeek(ctx)
 		triggerID := item.GetTrigger().Id
 		gasLimit := k.GetGasLimit(ctx, triggerID)
+		// A trigger without a gas limit of its own is paid for from its owner's gas budget.
+		budgeted := gasLimit == 0
+		if budgeted {
+			gasLimit = k.getBudgetedGasLimit(ctx, item.GetTrigger())
+		}
 		k.Logger(ctx).Debug(fmt.Sprintf("Processing trigger %d with gas limit %d", triggerID, gasLimit))
 
 		if gasLimit+gasConsumed > MaximumQueueGas {
 			k.Logger(ctx).Debug(fmt.Sprintf("Exceeded MaximumQueueGas %d/%d skipping...", gasLimit+gasConsumed, MaximumQueueGas))
-			return
+			break
 		}
 		actionsProcessed++
 		gasConsumed += gasLimit
 
 		k.Dequeue(ctx)
-		k.RemoveGasLimit(ctx, triggerID)
 
-		actions := item.GetTrigger().Actions
-		err := k.runActions(ctx, gasLimit, actions)
-		k.emitTriggerExecuted(ctx, item.GetTrigger(), err == nil)
+		trigger := item.GetTrigger()
+		var gasUsed uint64
+		var err error
+		if budgeted && gasLimit == 0 {
+			err = types.ErrGasBudgetExhausted.Wrapf("owner %s has no gas left for trigger %d", trigger.Owner, triggerID)
+		} else {
+			gasUsed, err = k.runActions(ctx, gasLimit, trigger)
+		}
+		if budgeted {
+			k.drawGasBudget(ctx, trigger, gasUsed)
+		}
+		k.emitTriggerExecuted(ctx, trigger, err == nil)
+
+		item.Attempts++
+		result := types.NewExecutionResult(ctx, triggerID, item.Attempts, gasUsed, err)
+		result.RetryQueued = err != nil && trigger.RetryPolicy.CanRetry(item.Attempts)
+		k.SetExecutionResult(ctx, result)
+		if result.RetryQueued {
+			k.Logger(ctx).Debug(fmt.Sprintf("Trigger %d failed attempt %d and will be retried", triggerID, item.Attempts))
+			retries = append(retries, *item)
+			continue
+		}
+
+		// A recurring trigger that is registered again keeps its gas limit for its next execution.
+		if _, err = k.GetTrigger(ctx, triggerID); err != nil {
+			k.RemoveGasLimit(ctx, triggerID)
+		}
+	}
+
+	for _, item := range retries {
+		k.Enqueue(ctx, item)
 	}
 }
 
-// RunActions Runs all the actions and constrains them by gasLimit.
-func (k Keeper) runActions(ctx sdk.Context, gasLimit uint64, actions []*codectypes.Any) error {
+// RunActions Runs all the trigger's actions and constrains them by gasLimit. Returns the amount of gas used by the actions.
+func (k Keeper) runActions(ctx sdk.Context, gasLimit uint64, trigger types.Trigger) (uint64, error) {
+	actions := trigger.Actions
 	cacheCtx, flush := ctx.CacheContext()
 	gasMeter := storetypes.NewGasMeter(gasLimit)
 	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
@@ -59,15 +93,15 @@ func (k Keeper) runActions(ctx sdk.Context, gasLimit uint64, actions []*codectyp
 			"actions", actions,
 			"error", err,
 		)
-		return err
+		return 0, err
 	}
-	results, err := k.handleMsgs(cacheCtx, msgs)
+	results, err := k.handleMsgs(cacheCtx, trigger, msgs)
 	if err != nil {
 		k.Logger(ctx).Error(
 			"HandleMsgs",
 			"error", err,
 		)
-		return err
+		return gasMeter.GasConsumedToLimit(), err
 	}
 
 	flush()
@@ -75,11 +109,12 @@ func (k Keeper) runActions(ctx sdk.Context, gasLimit uint64, actions []*codectyp
 		ctx.EventManager().EmitEvents(res.GetEvents())
 	}
 
-	return nil
+	return gasMeter.GasConsumedToLimit(), nil
 }
 
 // handleMsgs Handles each message and verifies gas limit has not been exceeded.
-func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error) {
+// If the trigger has a granter, the granter's authz grant to the owner is checked (and used) for each message first.
+func (k Keeper) handleMsgs(ctx sdk.Context, trigger types.Trigger, msgs []sdk.Msg) ([]sdk.Result, error) {
 	results := make([]sdk.Result, len(msgs))
 
 	for i, msg := range msgs {
@@ -87,6 +122,11 @@ func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error
 		if handler == nil {
 			return nil, fmt.Errorf("no message handler found for message %s at position %d", sdk.MsgTypeURL(msg), i)
 		}
+		if len(trigger.Granter) > 0 {
+			if err := k.acceptGrant(ctx, trigger, msg); err != nil {
+				return nil, fmt.Errorf("error authorizing message %s at position %d: %w", sdk.MsgTypeURL(msg), i, err)
+			}
+		}
 		k.Logger(ctx).Debug(fmt.Sprintf("Executing %s at position %d", sdk.MsgTypeURL(msg), i))
 		r, err := k.safeHandle(ctx, msg, handler)
 		if err != nil {
@@ -103,6 +143,45 @@ func (k Keeper) handleMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.Result, error
 	return results, nil
 }
 
+// acceptGrant Checks that the trigger's granter has an authz grant allowing the trigger's owner to run the message.
+// The grant is updated or deleted as the authorization requires, the same as it would be for a MsgExec.
+func (k Keeper) acceptGrant(ctx sdk.Context, trigger types.Trigger, msg sdk.Msg) error {
+	if k.authzKeeper == nil {
+		return fmt.Errorf("authz grants are not available")
+	}
+	granter, err := sdk.AccAddressFromBech32(trigger.Granter)
+	if err != nil {
+		return fmt.Errorf("invalid granter: %w", err)
+	}
+	grantee, err := sdk.AccAddressFromBech32(trigger.Owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	authorization, expiration := k.authzKeeper.GetAuthorization(ctx, grantee, granter, msgTypeURL)
+	if authorization == nil {
+		return fmt.Errorf("%s has not granted %s authorization for %s", trigger.Granter, trigger.Owner, msgTypeURL)
+	}
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.Delete:
+		err = k.authzKeeper.DeleteGrant(ctx, grantee, granter, msgTypeURL)
+	case resp.Updated != nil:
+		err = k.authzKeeper.SaveGrant(ctx, grantee, granter, resp.Updated, expiration)
+	}
+	if err != nil {
+		return err
+	}
+	if !resp.Accept {
+		return fmt.Errorf("authorization for %s from %s to %s was not accepted", msgTypeURL, trigger.Granter, trigger.Owner)
+	}
+	return nil
+}
+
 // safeHandle Handles one message and safely returns an error if it panics
 func (k Keeper) safeHandle(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler) (result *sdk.Result, err error) {
 	defer func() {
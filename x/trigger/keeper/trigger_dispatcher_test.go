@@ -2,11 +2,13 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/provenance-io/provenance/x/trigger/types"
 )
@@ -312,3 +314,43 @@ func (s *KeeperTestSuite) TestProcessTriggers() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestProcessTriggersWithGranter() {
+	owner := s.accountAddresses[0]
+	granter := s.accountAddresses[1]
+	s.ctx = s.ctx.WithBlockGasMeter(storetypes.NewGasMeter(60000000))
+
+	target := s.CreateTrigger(10, granter.String(), &types.BlockHeightEvent{BlockHeight: 500}, &types.MsgDestroyTriggerRequest{Id: 100, Authority: granter.String()})
+	s.app.TriggerKeeper.RegisterTrigger(s.ctx, target)
+	s.app.TriggerKeeper.SetGasLimit(s.ctx, target.Id, 100000)
+
+	runAs := func(id uint64) types.Trigger {
+		trigger := s.CreateTrigger(id, owner.String(), &types.BlockHeightEvent{BlockHeight: 100}, &types.MsgDestroyTriggerRequest{Id: target.Id, Authority: granter.String()})
+		trigger.Granter = granter.String()
+		s.app.TriggerKeeper.SetGasLimit(s.ctx, trigger.Id, 100000)
+		s.app.TriggerKeeper.QueueTrigger(s.ctx, trigger)
+		return trigger
+	}
+
+	withoutGrant := runAs(1)
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+	result, err := s.app.TriggerKeeper.GetExecutionResult(s.ctx, withoutGrant.Id)
+	s.Require().NoError(err, "GetExecutionResult(withoutGrant)")
+	s.Assert().False(result.Success, "withoutGrant success")
+	s.Assert().Contains(result.Error, granter.String()+" has not granted "+owner.String()+" authorization for "+sdk.MsgTypeURL(&types.MsgDestroyTriggerRequest{}), "withoutGrant error")
+	_, err = s.app.TriggerKeeper.GetTrigger(s.ctx, target.Id)
+	s.Assert().NoError(err, "GetTrigger(target) after withoutGrant")
+
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgDestroyTriggerRequest{}))
+	s.Require().NoError(s.app.AuthzKeeper.SaveGrant(s.ctx, owner, granter, authorization, &expiration), "SaveGrant")
+
+	withGrant := runAs(2)
+	s.app.TriggerKeeper.ProcessTriggers(s.ctx)
+	result, err = s.app.TriggerKeeper.GetExecutionResult(s.ctx, withGrant.Id)
+	s.Require().NoError(err, "GetExecutionResult(withGrant)")
+	s.Assert().True(result.Success, "withGrant success")
+	s.Assert().Empty(result.Error, "withGrant error")
+	_, err = s.app.TriggerKeeper.GetTrigger(s.ctx, target.Id)
+	s.Assert().ErrorIs(err, types.ErrTriggerNotFound, "GetTrigger(target) after withGrant")
+}
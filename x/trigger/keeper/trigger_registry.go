@@ -19,6 +19,14 @@ func (k Keeper) RegisterTrigger(ctx sdk.Context, trigger triggertypes.Trigger) {
 	ctx.GasMeter().ConsumeGas(gasLimit, "trigger creation")
 }
 
+// RegisterBudgetedTrigger Adds the trigger to the trigger, event listener, and gas store.
+// The trigger's executions are paid for from its owner's gas budget, so it is given a gas limit of zero.
+func (k Keeper) RegisterBudgetedTrigger(ctx sdk.Context, trigger triggertypes.Trigger) {
+	k.SetTrigger(ctx, trigger)
+	k.SetEventListener(ctx, trigger)
+	k.SetGasLimit(ctx, trigger.GetId(), 0)
+}
+
 // UnregisterTrigger Removes the trigger from the trigger, and event listener
 func (k Keeper) UnregisterTrigger(ctx sdk.Context, trigger triggertypes.Trigger) {
 	k.RemoveTrigger(ctx, trigger.GetId())
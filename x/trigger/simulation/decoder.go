@@ -62,6 +62,19 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			fmt.Println("Queue length")
 
 			return fmt.Sprintf("QueueLength: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.ExecutionResultKeyPrefix):
+			var attribA, attribB types.ExecutionResult
+
+			cdc.MustUnmarshal(kvA.Value, &attribA)
+			cdc.MustUnmarshal(kvB.Value, &attribB)
+
+			return fmt.Sprintf("ExecutionResult: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], types.GasBudgetKeyPrefix):
+			var attribA, attribB uint64
+			attribA = types.GetGasLimitFromBytes(kvA.Value)
+			attribB = types.GetGasLimitFromBytes(kvB.Value)
+
+			return fmt.Sprintf("GasBudget: A:[%v] B:[%v]\n", attribA, attribB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
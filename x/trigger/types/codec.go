@@ -17,6 +17,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&TransactionEvent{},
 		&BlockHeightEvent{},
 		&BlockTimeEvent{},
+		&NetAssetValueEvent{},
+		&AttributeEvent{},
+		&OrderFilledEvent{},
+		&AttributeExpiringEvent{},
 	)
 
 	registry.RegisterInterface(
@@ -36,4 +40,28 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*TriggerEventI)(nil),
 		&BlockTimeEvent{},
 	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.NetAssetValueEvent",
+		(*TriggerEventI)(nil),
+		&NetAssetValueEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.AttributeEvent",
+		(*TriggerEventI)(nil),
+		&AttributeEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.OrderFilledEvent",
+		(*TriggerEventI)(nil),
+		&OrderFilledEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.AttributeExpiringEvent",
+		(*TriggerEventI)(nil),
+		&AttributeExpiringEvent{},
+	)
 }
@@ -15,4 +15,7 @@ var (
 	ErrNoTriggerEvent          = cerrs.Register(ModuleName, 9, "trigger does not have event")
 	ErrInvalidBlockHeight      = cerrs.Register(ModuleName, 10, "block height has already passed")
 	ErrInvalidBlockTime        = cerrs.Register(ModuleName, 11, "block time has already passed")
+	ErrExecutionResultNotFound = cerrs.Register(ModuleName, 12, "execution result not found")
+	ErrGasBudgetNotFound       = cerrs.Register(ModuleName, 13, "gas budget not found")
+	ErrGasBudgetExhausted      = cerrs.Register(ModuleName, 14, "gas budget exhausted")
 )
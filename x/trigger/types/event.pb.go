@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -224,33 +229,259 @@ func (m *EventTriggerExecuted) GetSuccess() bool {
 	return false
 }
 
+// EventAttributeExpiring is an event for when an attribute watched by an attribute expiring trigger is about to expire.
+type EventAttributeExpiring struct {
+	// trigger_id is a unique identifier of the trigger.
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	// owner is the creator of the trigger.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// account is the address of the account that has the attribute.
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// name is the name of the expiring attribute.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// expiration_date is when the attribute expires.
+	ExpirationDate time.Time `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate,proto3,stdtime" json:"expiration_date"`
+}
+
+func (m *EventAttributeExpiring) Reset()         { *m = EventAttributeExpiring{} }
+func (m *EventAttributeExpiring) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeExpiring) ProtoMessage()    {}
+func (*EventAttributeExpiring) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c1b9c75d8690469, []int{4}
+}
+func (m *EventAttributeExpiring) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeExpiring) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeExpiring.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeExpiring) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeExpiring.Merge(m, src)
+}
+func (m *EventAttributeExpiring) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeExpiring) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeExpiring.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeExpiring proto.InternalMessageInfo
+
+func (m *EventAttributeExpiring) GetTriggerId() string {
+	if m != nil {
+		return m.TriggerId
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeExpiring) GetExpirationDate() time.Time {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return time.Time{}
+}
+
+// EventGasBudgetToppedUp is an event for when gas is added to an owner's trigger gas budget.
+type EventGasBudgetToppedUp struct {
+	// owner is the address whose gas budget was topped up.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// depositor is the address that paid for the gas.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// gas is the amount of gas added.
+	Gas uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	// remaining is the amount of gas in the budget after the top up.
+	Remaining uint64 `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *EventGasBudgetToppedUp) Reset()         { *m = EventGasBudgetToppedUp{} }
+func (m *EventGasBudgetToppedUp) String() string { return proto.CompactTextString(m) }
+func (*EventGasBudgetToppedUp) ProtoMessage()    {}
+func (*EventGasBudgetToppedUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c1b9c75d8690469, []int{5}
+}
+func (m *EventGasBudgetToppedUp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasBudgetToppedUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasBudgetToppedUp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasBudgetToppedUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasBudgetToppedUp.Merge(m, src)
+}
+func (m *EventGasBudgetToppedUp) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasBudgetToppedUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasBudgetToppedUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasBudgetToppedUp proto.InternalMessageInfo
+
+func (m *EventGasBudgetToppedUp) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventGasBudgetToppedUp) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *EventGasBudgetToppedUp) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *EventGasBudgetToppedUp) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+// EventGasBudgetExhausted is an event for when an owner's trigger gas budget runs out.
+type EventGasBudgetExhausted struct {
+	// owner is the address whose gas budget is exhausted.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// trigger_id is the identifier of the trigger whose execution exhausted the budget.
+	TriggerId string `protobuf:"bytes,2,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+}
+
+func (m *EventGasBudgetExhausted) Reset()         { *m = EventGasBudgetExhausted{} }
+func (m *EventGasBudgetExhausted) String() string { return proto.CompactTextString(m) }
+func (*EventGasBudgetExhausted) ProtoMessage()    {}
+func (*EventGasBudgetExhausted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c1b9c75d8690469, []int{6}
+}
+func (m *EventGasBudgetExhausted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasBudgetExhausted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasBudgetExhausted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasBudgetExhausted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasBudgetExhausted.Merge(m, src)
+}
+func (m *EventGasBudgetExhausted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasBudgetExhausted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasBudgetExhausted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasBudgetExhausted proto.InternalMessageInfo
+
+func (m *EventGasBudgetExhausted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventGasBudgetExhausted) GetTriggerId() string {
+	if m != nil {
+		return m.TriggerId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventTriggerCreated)(nil), "provenance.trigger.v1.EventTriggerCreated")
 	proto.RegisterType((*EventTriggerDestroyed)(nil), "provenance.trigger.v1.EventTriggerDestroyed")
 	proto.RegisterType((*EventTriggerDetected)(nil), "provenance.trigger.v1.EventTriggerDetected")
 	proto.RegisterType((*EventTriggerExecuted)(nil), "provenance.trigger.v1.EventTriggerExecuted")
+	proto.RegisterType((*EventAttributeExpiring)(nil), "provenance.trigger.v1.EventAttributeExpiring")
+	proto.RegisterType((*EventGasBudgetToppedUp)(nil), "provenance.trigger.v1.EventGasBudgetToppedUp")
+	proto.RegisterType((*EventGasBudgetExhausted)(nil), "provenance.trigger.v1.EventGasBudgetExhausted")
 }
 
 func init() { proto.RegisterFile("provenance/trigger/v1/event.proto", fileDescriptor_9c1b9c75d8690469) }
 
 var fileDescriptor_9c1b9c75d8690469 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x2f, 0x29, 0xca, 0x4c, 0x4f, 0x4f, 0x2d, 0xd2, 0x2f,
-	0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x45,
-	0x28, 0xd1, 0x83, 0x2a, 0xd1, 0x2b, 0x33, 0x54, 0x32, 0xe1, 0x12, 0x76, 0x05, 0xa9, 0x0a, 0x81,
-	0x08, 0x39, 0x17, 0xa5, 0x26, 0x96, 0xa4, 0xa6, 0x08, 0xc9, 0x72, 0x71, 0x41, 0x15, 0xc5, 0x67,
-	0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x71, 0x42, 0x45, 0x3c, 0x53, 0x94, 0xcc, 0xb8,
-	0x44, 0x91, 0x75, 0xb9, 0xa4, 0x16, 0x97, 0x14, 0xe5, 0x57, 0x12, 0xd6, 0x67, 0xca, 0x25, 0x82,
-	0xaa, 0xaf, 0x24, 0x35, 0x99, 0x08, 0xeb, 0x52, 0x51, 0xb5, 0xb9, 0x56, 0xa4, 0x26, 0x97, 0x12,
-	0xd6, 0x26, 0x24, 0xc2, 0xc5, 0x9a, 0x5f, 0x9e, 0x97, 0x5a, 0x24, 0xc1, 0x04, 0x96, 0x81, 0x70,
-	0x84, 0x24, 0xb8, 0xd8, 0x8b, 0x4b, 0x93, 0x93, 0x53, 0x8b, 0x8b, 0x25, 0x98, 0x15, 0x18, 0x35,
-	0x38, 0x82, 0x60, 0x5c, 0xa7, 0xcc, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0xe0,
-	0x92, 0xc8, 0xcc, 0xd7, 0xc3, 0x1a, 0x7e, 0x01, 0x8c, 0x51, 0xc6, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x08, 0x35, 0xba, 0x99, 0xf9, 0x48, 0x3c, 0xfd, 0x0a, 0x78,
-	0xb4, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x23, 0xc5, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xeb, 0x5b, 0x38, 0xbe, 0xb9, 0x01, 0x00, 0x00,
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xb6, 0x29, 0x34, 0x8b, 0x04, 0xc8, 0xa4, 0x60, 0x45, 0xe0, 0x04, 0x9f, 0x72, 0xc1,
+	0x56, 0x29, 0x70, 0x27, 0x34, 0x42, 0x1c, 0x40, 0xc8, 0x0a, 0x17, 0x2e, 0xd5, 0xc6, 0x1e, 0xb6,
+	0x2b, 0xe1, 0xdd, 0xd5, 0xee, 0x38, 0xa4, 0x12, 0x1f, 0xd1, 0xcf, 0xaa, 0x38, 0xf5, 0xc8, 0x09,
+	0x50, 0xf2, 0x23, 0xc8, 0xeb, 0x18, 0xc7, 0xa8, 0x52, 0x11, 0xb7, 0x79, 0xb3, 0xef, 0xcd, 0xdb,
+	0x99, 0xd1, 0xd0, 0xc7, 0xda, 0xa8, 0x05, 0x48, 0x26, 0x53, 0x88, 0xd1, 0x08, 0xce, 0xc1, 0xc4,
+	0x8b, 0xc3, 0x18, 0x16, 0x20, 0x31, 0xd2, 0x46, 0xa1, 0xf2, 0x0e, 0x1a, 0x4a, 0xb4, 0xa1, 0x44,
+	0x8b, 0xc3, 0x41, 0x9f, 0x2b, 0xae, 0x1c, 0x23, 0x2e, 0xa3, 0x8a, 0x3c, 0x18, 0x72, 0xa5, 0xf8,
+	0x67, 0x88, 0x1d, 0x9a, 0x17, 0x9f, 0x62, 0x14, 0x39, 0x58, 0x64, 0xb9, 0xae, 0x08, 0xe1, 0x33,
+	0x7a, 0x6f, 0x5a, 0x16, 0x9f, 0x55, 0x95, 0x5e, 0x19, 0x60, 0x08, 0x99, 0xf7, 0x88, 0xd2, 0x4d,
+	0xed, 0x13, 0x91, 0xf9, 0x64, 0x44, 0xc6, 0xbd, 0xa4, 0xb7, 0xc9, 0xbc, 0xc9, 0xc2, 0x17, 0xf4,
+	0x60, 0x5b, 0x75, 0x0c, 0x16, 0x8d, 0x3a, 0xbb, 0x5e, 0xf7, 0x9c, 0xf6, 0xdb, 0x3a, 0x84, 0xf4,
+	0x1f, 0xec, 0xa0, 0x2d, 0x9b, 0x2e, 0x21, 0x2d, 0xae, 0x97, 0x79, 0x7d, 0xba, 0xa7, 0xbe, 0x48,
+	0x30, 0xfe, 0x8e, 0x7b, 0xa9, 0x80, 0xe7, 0xd3, 0x9b, 0xb6, 0x48, 0x53, 0xb0, 0xd6, 0xdf, 0x1d,
+	0x91, 0xf1, 0x7e, 0x52, 0xc3, 0xf0, 0x1b, 0xa1, 0xf7, 0x9d, 0xcf, 0x4b, 0x44, 0x23, 0xe6, 0x05,
+	0xc2, 0x74, 0xa9, 0x85, 0x11, 0x92, 0xff, 0xb7, 0x13, 0x4b, 0x53, 0x55, 0x48, 0x74, 0x4e, 0xbd,
+	0xa4, 0x86, 0x9e, 0x47, 0xbb, 0x92, 0xe5, 0xe0, 0x77, 0x5d, 0xda, 0xc5, 0xde, 0x5b, 0x7a, 0x07,
+	0x4a, 0x3b, 0x86, 0x42, 0xc9, 0x93, 0x8c, 0x21, 0xf8, 0x7b, 0x23, 0x32, 0xbe, 0xf5, 0x74, 0x10,
+	0x55, 0x4b, 0x8c, 0xea, 0x25, 0x46, 0xb3, 0x7a, 0x89, 0x93, 0xfd, 0x8b, 0x1f, 0xc3, 0xce, 0xf9,
+	0xcf, 0x21, 0x49, 0x6e, 0x37, 0xe2, 0x63, 0x86, 0x10, 0x7e, 0xdd, 0xf4, 0xf2, 0x9a, 0xd9, 0x49,
+	0x91, 0x71, 0xc0, 0x99, 0xd2, 0x1a, 0xb2, 0x0f, 0xba, 0xf9, 0x2c, 0xd9, 0xfe, 0xec, 0x43, 0xda,
+	0xcb, 0x40, 0x2b, 0x2b, 0x50, 0xd5, 0x6d, 0x34, 0x09, 0xef, 0x2e, 0xdd, 0xe5, 0xac, 0x1a, 0x58,
+	0x37, 0x29, 0xc3, 0x92, 0x6f, 0x20, 0x67, 0x42, 0x0a, 0xc9, 0x5d, 0x1f, 0xdd, 0xa4, 0x49, 0x84,
+	0xef, 0xe8, 0x83, 0xb6, 0xfb, 0x74, 0x79, 0xca, 0x0a, 0x5b, 0x2e, 0xed, 0x6a, 0xfb, 0xf6, 0x80,
+	0x77, 0xfe, 0x1a, 0xf0, 0x44, 0x5c, 0xac, 0x02, 0x72, 0xb9, 0x0a, 0xc8, 0xaf, 0x55, 0x40, 0xce,
+	0xd7, 0x41, 0xe7, 0x72, 0x1d, 0x74, 0xbe, 0xaf, 0x83, 0x0e, 0xf5, 0x85, 0x8a, 0xae, 0xbc, 0x88,
+	0xf7, 0xe4, 0xe3, 0x11, 0x17, 0x78, 0x5a, 0xcc, 0xa3, 0x54, 0xe5, 0x71, 0xc3, 0x79, 0x22, 0xd4,
+	0x16, 0x8a, 0x97, 0x7f, 0x0e, 0x0d, 0xcf, 0x34, 0xd8, 0xf9, 0x0d, 0x37, 0xe6, 0xa3, 0xdf, 0x03,
+	0x00, 0xec, 0xcd, 0xb4, 0x2d, 0x8b, 0x03, 0x00, 0x00,
 }
 
 func (m *EventTriggerCreated) Marshal() (dAtA []byte, err error) {
@@ -390,6 +621,149 @@ func (m *EventTriggerExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAttributeExpiring) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeExpiring) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeExpiring) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationDate):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvent(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TriggerId) > 0 {
+		i -= len(m.TriggerId)
+		copy(dAtA[i:], m.TriggerId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TriggerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasBudgetToppedUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasBudgetToppedUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasBudgetToppedUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Gas != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasBudgetExhausted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasBudgetExhausted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasBudgetExhausted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TriggerId) > 0 {
+		i -= len(m.TriggerId)
+		copy(dAtA[i:], m.TriggerId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TriggerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -460,11 +834,78 @@ func (m *EventTriggerExecuted) Size() (n int) {
 	return n
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventAttributeExpiring) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TriggerId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpirationDate)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
 }
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventGasBudgetToppedUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEvent(uint64(m.Gas))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovEvent(uint64(m.Remaining))
+	}
+	return n
+}
+
+func (m *EventGasBudgetExhausted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TriggerId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *EventTriggerCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -497,7 +938,285 @@ func (m *EventTriggerCreated) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerDestroyed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerDestroyed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerDestroyed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerDetected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerDetected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerDetected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTriggerExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTriggerExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTriggerExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -525,8 +1244,28 @@ func (m *EventTriggerCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -548,7 +1287,7 @@ func (m *EventTriggerCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTriggerDestroyed) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeExpiring) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -571,10 +1310,10 @@ func (m *EventTriggerDestroyed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTriggerDestroyed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeExpiring: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTriggerDestroyed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeExpiring: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -609,6 +1348,135 @@ func (m *EventTriggerDestroyed) Unmarshal(dAtA []byte) error {
 			}
 			m.TriggerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -630,7 +1498,7 @@ func (m *EventTriggerDestroyed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTriggerDetected) Unmarshal(dAtA []byte) error {
+func (m *EventGasBudgetToppedUp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -653,15 +1521,15 @@ func (m *EventTriggerDetected) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTriggerDetected: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasBudgetToppedUp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTriggerDetected: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasBudgetToppedUp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -689,8 +1557,78 @@ func (m *EventTriggerDetected) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -712,7 +1650,7 @@ func (m *EventTriggerDetected) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTriggerExecuted) Unmarshal(dAtA []byte) error {
+func (m *EventGasBudgetExhausted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -735,15 +1673,15 @@ func (m *EventTriggerExecuted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTriggerExecuted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasBudgetExhausted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTriggerExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasBudgetExhausted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -771,11 +1709,11 @@ func (m *EventTriggerExecuted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TriggerId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,28 +1741,8 @@ func (m *EventTriggerExecuted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.TriggerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerKeeper defines the marker functionality needed to detect net asset value events.
+type MarkerKeeper interface {
+	GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*markertypes.NetAssetValue, error)
+}
+
+// AttributeKeeper defines the attribute functionality needed to detect attribute events.
+type AttributeKeeper interface {
+	GetAttributes(ctx sdk.Context, addr string, name string) ([]attributetypes.Attribute, error)
+}
+
+// HoldKeeper defines the hold functionality needed to escrow the fees of recurring triggers.
+type HoldKeeper interface {
+	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error
+	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error
+}
+
+// BankKeeper defines the bank functionality needed to collect the fees of recurring triggers.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// AuthzKeeper defines the authz functionality needed to run a trigger's actions as another account.
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
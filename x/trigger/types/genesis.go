@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
@@ -23,7 +24,10 @@ func NewGenesisState(triggerID, queueStart uint64, triggers []Trigger, gasLimits
 
 // DefaultGenesis returns the default trigger genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(1, 1, []Trigger{}, []GasLimit{}, []QueuedTrigger{})
+	gs := NewGenesisState(1, 1, []Trigger{}, []GasLimit{}, []QueuedTrigger{})
+	gs.ExecutionResults = []ExecutionResult{}
+	gs.GasBudgets = []GasBudget{}
+	return gs
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -35,15 +39,28 @@ func (gs GenesisState) Validate() error {
 	if gs.QueueStart == 0 {
 		return fmt.Errorf("invalid queue start")
 	}
-	if len(gs.Triggers)+len(gs.QueuedTriggers) != len(gs.GasLimits) {
-		return fmt.Errorf("gas limit list length must match sum of triggers and queued triggers length")
-	}
 
 	triggers := append([]Trigger{}, gs.Triggers...)
 	for _, queuedTrigger := range gs.QueuedTriggers {
 		triggers = append(triggers, queuedTrigger.GetTrigger())
 	}
 
+	// A recurring trigger can be registered and queued at the same time, and share a single gas limit.
+	shared := 0
+	recurringMap := make(map[uint64]bool)
+	for _, trigger := range triggers {
+		if trigger.Recurrence == nil {
+			continue
+		}
+		if recurringMap[trigger.GetId()] {
+			shared++
+		}
+		recurringMap[trigger.GetId()] = true
+	}
+	if len(triggers)-shared != len(gs.GasLimits) {
+		return fmt.Errorf("gas limit list length must match sum of triggers and queued triggers length")
+	}
+
 	gasLimitMap := make(map[uint64]bool)
 	for _, gasLimit := range gs.GasLimits {
 		if _, found := gasLimitMap[gasLimit.TriggerId]; found {
@@ -81,12 +98,37 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("trigger or queued trigger does not have a gas limit that matches it with id %d", trigger.GetId())
 		}
 
-		if _, found := triggerMap[trigger.GetId()]; found {
+		if _, found := triggerMap[trigger.GetId()]; found && trigger.Recurrence == nil {
 			return fmt.Errorf("trigger id %d is not unique within the set all triggers and queued triggers", trigger.GetId())
 		}
 		triggerMap[trigger.GetId()] = true
 	}
 
+	resultMap := make(map[uint64]bool)
+	for _, result := range gs.ExecutionResults {
+		if err := result.Validate(); err != nil {
+			return err
+		}
+		if result.TriggerId > gs.TriggerId {
+			return fmt.Errorf("execution result trigger id %d is invalid and cannot exceed %d", result.TriggerId, gs.TriggerId)
+		}
+		if resultMap[result.TriggerId] {
+			return fmt.Errorf("cannot have duplicate trigger id (%d) in execution results", result.TriggerId)
+		}
+		resultMap[result.TriggerId] = true
+	}
+
+	budgetMap := make(map[string]bool)
+	for _, budget := range gs.GasBudgets {
+		if _, err := sdk.AccAddressFromBech32(budget.Owner); err != nil {
+			return fmt.Errorf("invalid gas budget owner %q: %w", budget.Owner, err)
+		}
+		if budgetMap[budget.Owner] {
+			return fmt.Errorf("cannot have duplicate owner (%s) in gas budgets", budget.Owner)
+		}
+		budgetMap[budget.Owner] = true
+	}
+
 	return nil
 }
 
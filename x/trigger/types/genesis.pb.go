@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -35,6 +36,10 @@ type GenesisState struct {
 	GasLimits []GasLimit `protobuf:"bytes,4,rep,name=gas_limits,json=gasLimits,proto3" json:"gas_limits"`
 	// Triggers to initially start with in the queue.
 	QueuedTriggers []QueuedTrigger `protobuf:"bytes,5,rep,name=queued_triggers,json=queuedTriggers,proto3" json:"queued_triggers"`
+	// The outcomes of the most recent runs of triggers.
+	ExecutionResults []ExecutionResult `protobuf:"bytes,6,rep,name=execution_results,json=executionResults,proto3" json:"execution_results"`
+	// The gas that owners have available for the executions of their triggers.
+	GasBudgets []GasBudget `protobuf:"bytes,7,rep,name=gas_budgets,json=gasBudgets,proto3" json:"gas_budgets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,9 +130,65 @@ func (m *GasLimit) GetAmount() uint64 {
 	return 0
 }
 
+// GasBudget defines the trigger module's grouping of an owner and the gas available for its triggers
+type GasBudget struct {
+	// The address of the owner this GasBudget belongs to.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The amount of gas left for the executions of the owner's triggers.
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *GasBudget) Reset()         { *m = GasBudget{} }
+func (m *GasBudget) String() string { return proto.CompactTextString(m) }
+func (*GasBudget) ProtoMessage()    {}
+func (*GasBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e92f7d1706d41c9, []int{2}
+}
+func (m *GasBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasBudget.Merge(m, src)
+}
+func (m *GasBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasBudget proto.InternalMessageInfo
+
+func (m *GasBudget) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *GasBudget) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.trigger.v1.GenesisState")
 	proto.RegisterType((*GasLimit)(nil), "provenance.trigger.v1.GasLimit")
+	proto.RegisterType((*GasBudget)(nil), "provenance.trigger.v1.GasBudget")
 }
 
 func init() {
@@ -135,29 +196,37 @@ func init() {
 }
 
 var fileDescriptor_5e92f7d1706d41c9 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x2f, 0x29, 0xca, 0x4c, 0x4f, 0x4f, 0x2d, 0xd2, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x45, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x70, 0x98, 0x08, 0xd3, 0x07, 0x56, 0xa4, 0xb4, 0x95,
-	0x89, 0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x2c, 0x17, 0x17, 0x54,
-	0x45, 0x7c, 0x66, 0x8a, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x27, 0x54, 0xc4, 0x33, 0x45,
-	0x48, 0x9e, 0x8b, 0xbb, 0xb0, 0x34, 0xb5, 0x34, 0x35, 0xbe, 0xb8, 0x24, 0xb1, 0xa8, 0x44, 0x82,
-	0x09, 0x2c, 0xcf, 0x05, 0x16, 0x0a, 0x06, 0x89, 0x08, 0x39, 0x70, 0x71, 0x40, 0x55, 0x17, 0x4b,
-	0x30, 0x2b, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xe9, 0x61, 0x75, 0xb5, 0x5e, 0x08, 0x84, 0xe9, 0xc4,
-	0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x5c, 0x97, 0x90, 0x0b, 0x17, 0x57, 0x7a, 0x62, 0x71, 0x7c,
-	0x4e, 0x66, 0x6e, 0x66, 0x49, 0xb1, 0x04, 0x0b, 0xd8, 0x0c, 0x79, 0x1c, 0x66, 0xb8, 0x27, 0x16,
-	0xfb, 0x80, 0xd4, 0x41, 0x0d, 0xe1, 0x4c, 0x87, 0xf2, 0x8b, 0x85, 0x82, 0xb9, 0xf8, 0xc1, 0xae,
-	0x4a, 0x89, 0x87, 0x3b, 0x87, 0x15, 0x6c, 0x94, 0x0a, 0x0e, 0xa3, 0x02, 0xc1, 0xaa, 0x51, 0x1d,
-	0xc5, 0x57, 0x88, 0x2c, 0x58, 0x6c, 0xc5, 0xd1, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06,
-	0x25, 0x47, 0x2e, 0x0e, 0x98, 0xdd, 0x84, 0x82, 0x4c, 0x8c, 0x8b, 0x2d, 0x31, 0x37, 0xbf, 0x34,
-	0x0f, 0x16, 0x5a, 0x50, 0x9e, 0x53, 0xe6, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e,
-	0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31,
-	0x70, 0x49, 0x64, 0xe6, 0x63, 0x77, 0x64, 0x00, 0x63, 0x94, 0x71, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x42, 0x8d, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf, 0x02, 0x1e,
-	0xe1, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xc8, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0x99, 0x56, 0xc9, 0x58, 0x65, 0x02, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x13, 0xda, 0x95, 0xe6, 0x2d, 0xe2, 0x8f, 0x35, 0x50, 0x98, 0x20, 0xa9, 0x0a, 0x42,
+	0xbb, 0x2c, 0xd1, 0xd8, 0x8d, 0x13, 0xab, 0x40, 0x15, 0x12, 0x07, 0x68, 0xb9, 0x8c, 0x4b, 0x94,
+	0x36, 0x96, 0xb1, 0xb4, 0xd8, 0x9d, 0x5f, 0xa7, 0x8c, 0x6f, 0xc0, 0x91, 0x8f, 0xb0, 0x0f, 0xc1,
+	0x87, 0xd8, 0x71, 0xe2, 0x04, 0x17, 0x84, 0xda, 0x0b, 0x1f, 0x03, 0xd5, 0x71, 0xd2, 0x4d, 0x5a,
+	0xc4, 0xcd, 0xef, 0x2f, 0x8f, 0x1f, 0xfb, 0x8d, 0x5f, 0x78, 0x32, 0x57, 0x72, 0x41, 0x45, 0x2a,
+	0x66, 0x34, 0xd6, 0x8a, 0x33, 0x46, 0x55, 0xbc, 0xd8, 0x8f, 0x19, 0x15, 0x14, 0x39, 0x46, 0x73,
+	0x25, 0xb5, 0x24, 0xf7, 0x37, 0x50, 0x64, 0xa1, 0x68, 0xb1, 0xbf, 0xf3, 0x70, 0x26, 0x31, 0x97,
+	0x98, 0x18, 0x28, 0x2e, 0x8b, 0x72, 0xc7, 0xce, 0x36, 0x93, 0x4c, 0x96, 0xf9, 0x7a, 0x65, 0xd3,
+	0x86, 0xc3, 0x2a, 0xa5, 0x81, 0x06, 0xbf, 0x5a, 0x70, 0x6b, 0x54, 0x1e, 0x3f, 0xd1, 0xa9, 0xa6,
+	0xe4, 0x31, 0x80, 0x25, 0x12, 0x9e, 0xf9, 0x6e, 0xdf, 0xdd, 0x6d, 0x8f, 0x3d, 0x9b, 0xbc, 0xc9,
+	0x48, 0x08, 0xbd, 0x93, 0x82, 0x16, 0x34, 0x41, 0x9d, 0x2a, 0xed, 0xdf, 0x30, 0xdf, 0xc1, 0x44,
+	0x93, 0x75, 0x42, 0x5e, 0x42, 0xd7, 0xd2, 0xe8, 0xb7, 0xfa, 0xad, 0xdd, 0xde, 0xf3, 0x20, 0xba,
+	0xb6, 0xa1, 0xe8, 0x43, 0xb9, 0x1c, 0xb6, 0xcf, 0x7f, 0x87, 0xce, 0xb8, 0xde, 0x45, 0x5e, 0x01,
+	0xb0, 0x14, 0x93, 0x63, 0x9e, 0x73, 0x8d, 0x7e, 0xdb, 0x38, 0xc2, 0x06, 0xc7, 0x28, 0xc5, 0xb7,
+	0x6b, 0xce, 0x4a, 0x3c, 0x66, 0x6b, 0x24, 0x13, 0xb8, 0x63, 0x6e, 0x95, 0x25, 0xf5, 0x75, 0xb6,
+	0x8c, 0xea, 0x69, 0x83, 0xea, 0xbd, 0xa1, 0xaf, 0x5e, 0xea, 0xf6, 0xc9, 0xe5, 0x10, 0xc9, 0x11,
+	0xdc, 0xa3, 0xa7, 0x74, 0x56, 0x68, 0x2e, 0x45, 0xa2, 0x28, 0x16, 0xc7, 0x1a, 0xfd, 0x8e, 0xd1,
+	0x3e, 0x6b, 0xd0, 0xbe, 0xae, 0xf8, 0xb1, 0xc1, 0xad, 0xf8, 0x2e, 0xbd, 0x1a, 0x23, 0x19, 0x41,
+	0x6f, 0xdd, 0xf5, 0xb4, 0xc8, 0x18, 0xd5, 0xe8, 0xdf, 0x34, 0xd2, 0x7e, 0x73, 0xdb, 0x43, 0x03,
+	0x5a, 0x1d, 0xb0, 0x2a, 0xc0, 0x17, 0xdd, 0xaf, 0x67, 0xa1, 0xf3, 0xf7, 0x2c, 0x74, 0x06, 0x87,
+	0xd0, 0xad, 0xfe, 0xcf, 0xff, 0x9e, 0xf5, 0x01, 0x74, 0xd2, 0x5c, 0x16, 0xa2, 0x7a, 0x51, 0x5b,
+	0x0d, 0x8e, 0xc0, 0xab, 0xcf, 0x22, 0x11, 0x6c, 0xc9, 0xcf, 0x82, 0x2a, 0xb3, 0xdd, 0x1b, 0xfa,
+	0x3f, 0xbe, 0xef, 0x6d, 0xdb, 0x39, 0x3c, 0xcc, 0x32, 0x45, 0x11, 0x27, 0x5a, 0x71, 0xc1, 0xc6,
+	0x25, 0x46, 0x1e, 0x81, 0xa7, 0x68, 0x9e, 0x72, 0xc1, 0x05, 0xb3, 0xde, 0x4d, 0x30, 0xe4, 0xe7,
+	0xcb, 0xc0, 0xbd, 0x58, 0x06, 0xee, 0x9f, 0x65, 0xe0, 0x7e, 0x5b, 0x05, 0xce, 0xc5, 0x2a, 0x70,
+	0x7e, 0xae, 0x02, 0x07, 0x7c, 0x2e, 0xaf, 0xef, 0xfb, 0x9d, 0xfb, 0xf1, 0x80, 0x71, 0xfd, 0xa9,
+	0x98, 0x46, 0x33, 0x99, 0xc7, 0x1b, 0x66, 0x8f, 0xcb, 0x4b, 0x55, 0x7c, 0x5a, 0xcf, 0xbb, 0xfe,
+	0x32, 0xa7, 0x38, 0xed, 0x98, 0x59, 0x3f, 0xf8, 0x37, 0x00, 0x4d, 0x86, 0x86, 0xf3, 0x7f, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +249,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasBudgets) > 0 {
+		for iNdEx := len(m.GasBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ExecutionResults) > 0 {
+		for iNdEx := len(m.ExecutionResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.QueuedTriggers) > 0 {
 		for iNdEx := len(m.QueuedTriggers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -268,6 +365,41 @@ func (m *GasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -309,6 +441,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ExecutionResults) > 0 {
+		for _, e := range m.ExecutionResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GasBudgets) > 0 {
+		for _, e := range m.GasBudgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -327,6 +471,22 @@ func (m *GasLimit) Size() (n int) {
 	return n
 }
 
+func (m *GasBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovGenesis(uint64(m.Remaining))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -502,6 +662,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionResults = append(m.ExecutionResults, ExecutionResult{})
+			if err := m.ExecutionResults[len(m.ExecutionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasBudgets = append(m.GasBudgets, GasBudget{})
+			if err := m.GasBudgets[len(m.GasBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -611,6 +839,107 @@ func (m *GasLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
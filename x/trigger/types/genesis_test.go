@@ -29,6 +29,8 @@ func TestDefaultGenesis(t *testing.T) {
 	assert.Equal(t, []Trigger{}, state.Triggers, "triggers should be empty in DefaultGenesis")
 	assert.Equal(t, []GasLimit{}, state.GasLimits, "gas limits should be empty in default DefaultGenesis")
 	assert.Equal(t, []QueuedTrigger{}, state.QueuedTriggers, "queued triggers should be empty in default DefaultGenesis")
+	assert.Equal(t, []ExecutionResult{}, state.ExecutionResults, "execution results should be empty in default DefaultGenesis")
+	assert.Equal(t, []GasBudget{}, state.GasBudgets, "gas budgets should be empty in default DefaultGenesis")
 
 	err := state.Validate()
 	assert.NoError(t, err, "DefaultGenesis.Validate() error")
@@ -39,6 +41,8 @@ func TestGenesisStateValidate(t *testing.T) {
 	badRequest := MustNewCreateTriggerRequest([]string{"addr"}, &TransactionEvent{Name: "", Attributes: []Attribute{}}, []types.Msg{&MsgDestroyTriggerRequest{Id: 1, Authority: ""}})
 	trigger := NewTrigger(1, "owner", request.Event, request.Actions)
 	trigger2 := NewTrigger(2, "owner", request.Event, request.Actions)
+	recurring := NewTrigger(1, "owner", request.Event, request.Actions)
+	recurring.Recurrence = &Recurrence{IntervalBlocks: 10, MaxExecutions: 3, Executions: 1}
 
 	tests := []struct {
 		name   string
@@ -274,6 +278,91 @@ func TestGenesisStateValidate(t *testing.T) {
 			modify: nil,
 			err:    "trigger id 1 is not unique within the set all triggers and queued triggers",
 		},
+		{
+			name: "valid - recurring trigger can be both registered and queued",
+			state: &GenesisState{
+				TriggerId:      1,
+				QueueStart:     1,
+				GasLimits:      []GasLimit{{TriggerId: 1, Amount: 1}},
+				Triggers:       []Trigger{recurring},
+				QueuedTriggers: []QueuedTrigger{{BlockHeight: 1, Time: time.Time{}, Trigger: recurring}},
+			},
+			modify: nil,
+			err:    "",
+		},
+		{
+			name: "valid - execution results",
+			state: &GenesisState{
+				TriggerId:        2,
+				QueueStart:       1,
+				ExecutionResults: []ExecutionResult{{TriggerId: 1, Attempt: 1, Success: true}, {TriggerId: 2, Attempt: 2, Error: "failed"}},
+			},
+			modify: nil,
+			err:    "",
+		},
+		{
+			name: "invalid - execution result must pass validation",
+			state: &GenesisState{
+				TriggerId:        2,
+				QueueStart:       1,
+				ExecutionResults: []ExecutionResult{{TriggerId: 1}},
+			},
+			modify: nil,
+			err:    "execution result for trigger 1 attempt cannot be zero",
+		},
+		{
+			name: "invalid - execution result trigger id cannot exceed state trigger id",
+			state: &GenesisState{
+				TriggerId:        2,
+				QueueStart:       1,
+				ExecutionResults: []ExecutionResult{{TriggerId: 5, Attempt: 1, Success: true}},
+			},
+			modify: nil,
+			err:    "execution result trigger id 5 is invalid and cannot exceed 2",
+		},
+		{
+			name: "invalid - execution results cannot have duplicate id",
+			state: &GenesisState{
+				TriggerId:        2,
+				QueueStart:       1,
+				ExecutionResults: []ExecutionResult{{TriggerId: 1, Attempt: 1, Success: true}, {TriggerId: 1, Attempt: 2, Success: true}},
+			},
+			modify: nil,
+			err:    "cannot have duplicate trigger id (1) in execution results",
+		},
+		{
+			name: "valid - gas budgets",
+			state: &GenesisState{
+				TriggerId:  1,
+				QueueStart: 1,
+				GasBudgets: []GasBudget{{Owner: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Remaining: 1000}},
+			},
+			modify: nil,
+			err:    "",
+		},
+		{
+			name: "invalid - gas budget owner must be valid",
+			state: &GenesisState{
+				TriggerId:  1,
+				QueueStart: 1,
+				GasBudgets: []GasBudget{{Owner: "owner", Remaining: 1000}},
+			},
+			modify: nil,
+			err:    "invalid gas budget owner \"owner\": decoding bech32 failed: invalid bech32 string length 5",
+		},
+		{
+			name: "invalid - gas budgets cannot have duplicate owner",
+			state: &GenesisState{
+				TriggerId:  1,
+				QueueStart: 1,
+				GasBudgets: []GasBudget{
+					{Owner: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Remaining: 1000},
+					{Owner: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Remaining: 5},
+				},
+			},
+			modify: nil,
+			err:    "cannot have duplicate owner (cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma) in gas budgets",
+		},
 	}
 
 	for _, tc := range tests {
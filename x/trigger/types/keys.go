@@ -5,6 +5,9 @@ import (
 	"encoding/binary"
 	fmt "fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -59,6 +62,18 @@ const (
 //
 //   - 0x07: uint64 (Queue Length)
 //     | 1 |
+//
+// The key in this section is used to track the outcome of the most recent run of each trigger.
+// The <trigger_id_bytes> are 8 bytes that match the trigger that was run.
+//
+//   - 0x08<trigger_id_bytes>: ExecutionResult
+//     | 1 |        8        |
+//
+// The key in this section is used to track the gas that owners have available for their triggers.
+// The <owner_length> is 1 byte with the length of the <owner_bytes> address that the gas budget belongs to.
+//
+//   - 0x09<owner_length><owner_bytes>: uint64 (GasBudget)
+//     | 1 |      1       |     N     |
 var (
 	// TriggerKeyPrefix is an initial byte to help group all trigger keys
 	TriggerKeyPrefix = []byte{0x01}
@@ -74,6 +89,10 @@ var (
 	QueueStartIndexKey = []byte{0x06}
 	// QueueStartIndexKey is the key to obtain the queue's length
 	QueueLengthKey = []byte{0x07}
+	// ExecutionResultKeyPrefix is an initial byte to help group all execution result keys
+	ExecutionResultKeyPrefix = []byte{0x08}
+	// GasBudgetKeyPrefix is an initial byte to help group all gas budget keys
+	GasBudgetKeyPrefix = []byte{0x09}
 )
 
 // GetEventListenerKey converts an event name, order, and trigger ID into an event registry key format.
@@ -171,6 +190,27 @@ func GetGasLimitKey(id TriggerID) []byte {
 	return key
 }
 
+// GetExecutionResultKey converts an execution result's trigger id into key format.
+func GetExecutionResultKey(id TriggerID) []byte {
+	key := ExecutionResultKeyPrefix
+	key = append(key, GetTriggerIDBytes(id)...)
+	return key
+}
+
+// GetGasBudgetKey converts a gas budget's owner into key format.
+func GetGasBudgetKey(owner sdk.AccAddress) []byte {
+	key := GasBudgetKeyPrefix
+	key = append(key, address.MustLengthPrefix(owner)...)
+	return key
+}
+
+// GetGasBudgetOwnerFromKey returns the owner address from a gas budget key.
+func GetGasBudgetOwnerFromKey(key []byte) sdk.AccAddress {
+	// key is of format:
+	// 0x09<owner_length><owner_bytes>
+	return sdk.AccAddress(key[2:])
+}
+
 // GetGasLimitBytes returns the byte representation of the gas limit
 func GetGasLimitBytes(gasLimit uint64) (gasLimitBz []byte) {
 	gasLimitBz = make([]byte, GasLimitLength)
@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGetEventListenerKey(t *testing.T) {
@@ -77,6 +79,20 @@ func TestGetGasLimitKey(t *testing.T) {
 	assert.EqualValues(t, int(1), int(binary.BigEndian.Uint64(key[1:9])), "should get value for GetGasLimitKey")
 }
 
+func TestGetExecutionResultKey(t *testing.T) {
+	key := GetExecutionResultKey(1)
+	assert.EqualValues(t, ExecutionResultKeyPrefix, key[0:1], "should get prefix for GetExecutionResultKey")
+	assert.EqualValues(t, int(1), int(binary.BigEndian.Uint64(key[1:9])), "should get value for GetExecutionResultKey")
+}
+
+func TestGetGasBudgetKey(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________")
+	key := GetGasBudgetKey(owner)
+	assert.EqualValues(t, GasBudgetKeyPrefix, key[0:1], "should get prefix for GetGasBudgetKey")
+	assert.EqualValues(t, len(owner), int(key[1]), "should get owner length for GetGasBudgetKey")
+	assert.EqualValues(t, owner, GetGasBudgetOwnerFromKey(key), "should get owner from GetGasBudgetKey")
+}
+
 func TestGetGasLimitToAndFromBytes(t *testing.T) {
 	bytes := GetGasLimitBytes(1)
 	index := GetGasLimitFromBytes(bytes)
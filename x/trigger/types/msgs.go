@@ -16,6 +16,7 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgCreateTriggerRequest)(nil),
 	(*MsgDestroyTriggerRequest)(nil),
+	(*MsgTopUpGasBudgetRequest)(nil),
 }
 
 var _ codectypes.UnpackInterfacesMessage = (*MsgCreateTriggerRequest)(nil)
@@ -61,6 +62,22 @@ func (msg MsgCreateTriggerRequest) ValidateBasic() error {
 	if err = event.Validate(); err != nil {
 		return err
 	}
+	if msg.Recurrence != nil {
+		if err = msg.Recurrence.Validate(); err != nil {
+			return err
+		}
+		if msg.Recurrence.Executions != 0 {
+			return fmt.Errorf("recurrence executions must be zero")
+		}
+		if err = msg.Recurrence.ValidateEvent(event); err != nil {
+			return err
+		}
+	}
+	if msg.RetryPolicy != nil {
+		if err = msg.RetryPolicy.Validate(); err != nil {
+			return err
+		}
+	}
 	actions, err := sdktx.GetMsgs(msg.Actions, "MsgCreateTriggerRequest - ValidateBasic")
 	if err != nil {
 		return err
@@ -76,12 +93,29 @@ func (msg MsgCreateTriggerRequest) ValidateBasic() error {
 		authorities[string(addr)] = true
 	}
 
+	// Actions run as a granter must be signed by the granter instead of the authorities.
+	signers := authorities
+	if len(msg.Granter) > 0 {
+		if len(msg.Authorities) == 0 {
+			return fmt.Errorf("trigger with a granter must have an authority")
+		}
+		var granter sdk.AccAddress
+		granter, err = sdk.AccAddressFromBech32(msg.Granter)
+		if err != nil {
+			return fmt.Errorf("invalid address for trigger granter: %w", err)
+		}
+		if msg.Granter == msg.Authorities[0] {
+			return fmt.Errorf("trigger granter cannot be the trigger owner")
+		}
+		signers = map[string]bool{string(granter): true}
+	}
+
 	cdc := simappparams.AppEncodingConfig.Marshaler
 	for idx, action := range actions {
 		if err = internalsdk.ValidateBasic(action); err != nil {
 			return fmt.Errorf("action: %d: %w", idx, err)
 		}
-		if err = hasSigners(cdc, authorities, action); err != nil {
+		if err = hasSigners(cdc, signers, action); err != nil {
 			return fmt.Errorf("action: %d: %w", idx, err)
 		}
 	}
@@ -147,3 +181,27 @@ func (msg MsgDestroyTriggerRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewTopUpGasBudgetRequest Creates a new gas budget top up request
+func NewTopUpGasBudgetRequest(owner, depositor string, gas uint64) *MsgTopUpGasBudgetRequest {
+	msg := &MsgTopUpGasBudgetRequest{
+		Owner:     owner,
+		Gas:       gas,
+		Depositor: depositor,
+	}
+	return msg
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgTopUpGasBudgetRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid address for gas budget owner: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return fmt.Errorf("invalid address for gas budget depositor: %w", err)
+	}
+	if msg.Gas == 0 {
+		return fmt.Errorf("gas budget top up must be positive")
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 func TestAllMsgsGetSigners(t *testing.T) {
 	singleSignerMsgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgDestroyTriggerRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgTopUpGasBudgetRequest{Depositor: signer} },
 	}
 
 	multiSignerMsgMakers := []testutil.MsgMakerMulti{
@@ -63,6 +64,9 @@ func TestMsgCreateTriggerRequestValidateBasic(t *testing.T) {
 		authorities []string
 		event       TriggerEventI
 		msgs        []sdk.Msg
+		recurrence  *Recurrence
+		retry       *RetryPolicy
+		granter     string
 		err         string
 	}{
 		{
@@ -114,11 +118,94 @@ func TestMsgCreateTriggerRequestValidateBasic(t *testing.T) {
 			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
 			err:         "",
 		},
+		{
+			name:        "valid - recurring block height event",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			recurrence:  &Recurrence{IntervalBlocks: 10, MaxExecutions: 5},
+			err:         "",
+		},
+		{
+			name:        "invalid - recurrence validation failed",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			recurrence:  &Recurrence{IntervalBlocks: 10},
+			err:         "recurrence max executions cannot be zero",
+		},
+		{
+			name:        "invalid - recurrence has executions",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			recurrence:  &Recurrence{IntervalBlocks: 10, MaxExecutions: 5, Executions: 1},
+			err:         "recurrence executions must be zero",
+		},
+		{
+			name:        "invalid - recurrence of unsupported event",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &TransactionEvent{Name: "event"},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			recurrence:  &Recurrence{IntervalBlocks: 10, MaxExecutions: 5},
+			err:         "recurrence is not supported for *types.TransactionEvent",
+		},
+		{
+			name:        "valid - retry policy",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			retry:       &RetryPolicy{MaxRetries: 3},
+			err:         "",
+		},
+		{
+			name:        "invalid - retry policy validation failed",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			retry:       &RetryPolicy{MaxRetries: 10},
+			err:         "retry policy max retries 10 cannot exceed 5",
+		},
+		{
+			name:        "valid - actions signed by granter",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4", Id: 1}},
+			granter:     "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4",
+			err:         "",
+		},
+		{
+			name:        "invalid - actions signed by authority instead of granter",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			granter:     "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4",
+			err:         "action: 0: *types.MsgDestroyTriggerRequest signers[0] \"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h\" is not a signer of the request message",
+		},
+		{
+			name:        "invalid - granter is not correct format",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			granter:     "badaddr",
+			err:         "invalid address for trigger granter: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:        "invalid - granter is the owner",
+			authorities: []string{"cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h"},
+			event:       &BlockHeightEvent{BlockHeight: 100},
+			msgs:        []sdk.Msg{&MsgDestroyTriggerRequest{Authority: "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h", Id: 1}},
+			granter:     "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+			err:         "trigger granter cannot be the trigger owner",
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := MustNewCreateTriggerRequest(tc.authorities, tc.event, tc.msgs)
+			msg.Recurrence = tc.recurrence
+			msg.RetryPolicy = tc.retry
+			msg.Granter = tc.granter
 			err := msg.ValidateBasic()
 			if len(tc.err) > 0 {
 				assert.EqualError(t, err, tc.err, "should have error in ValidateBasic")
@@ -246,3 +333,53 @@ func TestMsgDestroyTriggerRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgTopUpGasBudgetRequestValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		owner     string
+		depositor string
+		gas       uint64
+		err       string
+	}{
+		{
+			name:      "valid - success",
+			owner:     "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+			depositor: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+			gas:       1000,
+		},
+		{
+			name:      "invalid - bad owner",
+			owner:     "badaddr",
+			depositor: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+			gas:       1000,
+			err:       "invalid address for gas budget owner: decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:      "invalid - bad depositor",
+			owner:     "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+			depositor: "",
+			gas:       1000,
+			err:       "invalid address for gas budget depositor: empty address string is not allowed",
+		},
+		{
+			name:      "invalid - zero gas",
+			owner:     "cosmos1v57fx2l2rt6ehujuu99u2fw05779m5e2ux4z2h",
+			depositor: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma",
+			gas:       0,
+			err:       "gas budget top up must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewTopUpGasBudgetRequest(tc.owner, tc.depositor, tc.gas)
+			err := msg.ValidateBasic()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should receive correct error for failed ValidateBasic")
+			} else {
+				assert.NoError(t, err, "should receive no error for successful ValidateBasic")
+			}
+		})
+	}
+}
@@ -223,45 +223,242 @@ func (m *QueryTriggersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTriggerResultRequest queries for the execution result of the Trigger with an identifier of id.
+type QueryTriggerResultRequest struct {
+	// The id of the trigger to query.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTriggerResultRequest) Reset()         { *m = QueryTriggerResultRequest{} }
+func (m *QueryTriggerResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTriggerResultRequest) ProtoMessage()    {}
+func (*QueryTriggerResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd3e0fb69cf60c3, []int{4}
+}
+func (m *QueryTriggerResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTriggerResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTriggerResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTriggerResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTriggerResultRequest.Merge(m, src)
+}
+func (m *QueryTriggerResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTriggerResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTriggerResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTriggerResultRequest proto.InternalMessageInfo
+
+func (m *QueryTriggerResultRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTriggerResultResponse contains the requested ExecutionResult.
+type QueryTriggerResultResponse struct {
+	// The outcome of the most recent run of the trigger's actions.
+	Result *ExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *QueryTriggerResultResponse) Reset()         { *m = QueryTriggerResultResponse{} }
+func (m *QueryTriggerResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTriggerResultResponse) ProtoMessage()    {}
+func (*QueryTriggerResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd3e0fb69cf60c3, []int{5}
+}
+func (m *QueryTriggerResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTriggerResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTriggerResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTriggerResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTriggerResultResponse.Merge(m, src)
+}
+func (m *QueryTriggerResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTriggerResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTriggerResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTriggerResultResponse proto.InternalMessageInfo
+
+func (m *QueryTriggerResultResponse) GetResult() *ExecutionResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// QueryGasBudgetRequest queries for the gas budget of an owner.
+type QueryGasBudgetRequest struct {
+	// The address of the owner to query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryGasBudgetRequest) Reset()         { *m = QueryGasBudgetRequest{} }
+func (m *QueryGasBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasBudgetRequest) ProtoMessage()    {}
+func (*QueryGasBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd3e0fb69cf60c3, []int{6}
+}
+func (m *QueryGasBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasBudgetRequest.Merge(m, src)
+}
+func (m *QueryGasBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasBudgetRequest proto.InternalMessageInfo
+
+func (m *QueryGasBudgetRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryGasBudgetResponse contains the requested gas budget.
+type QueryGasBudgetResponse struct {
+	// The amount of gas left for the executions of the owner's triggers.
+	Remaining uint64 `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *QueryGasBudgetResponse) Reset()         { *m = QueryGasBudgetResponse{} }
+func (m *QueryGasBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasBudgetResponse) ProtoMessage()    {}
+func (*QueryGasBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_afd3e0fb69cf60c3, []int{7}
+}
+func (m *QueryGasBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasBudgetResponse.Merge(m, src)
+}
+func (m *QueryGasBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryGasBudgetResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryTriggerByIDRequest)(nil), "provenance.trigger.v1.QueryTriggerByIDRequest")
 	proto.RegisterType((*QueryTriggerByIDResponse)(nil), "provenance.trigger.v1.QueryTriggerByIDResponse")
 	proto.RegisterType((*QueryTriggersRequest)(nil), "provenance.trigger.v1.QueryTriggersRequest")
 	proto.RegisterType((*QueryTriggersResponse)(nil), "provenance.trigger.v1.QueryTriggersResponse")
+	proto.RegisterType((*QueryTriggerResultRequest)(nil), "provenance.trigger.v1.QueryTriggerResultRequest")
+	proto.RegisterType((*QueryTriggerResultResponse)(nil), "provenance.trigger.v1.QueryTriggerResultResponse")
+	proto.RegisterType((*QueryGasBudgetRequest)(nil), "provenance.trigger.v1.QueryGasBudgetRequest")
+	proto.RegisterType((*QueryGasBudgetResponse)(nil), "provenance.trigger.v1.QueryGasBudgetResponse")
 }
 
 func init() { proto.RegisterFile("provenance/trigger/v1/query.proto", fileDescriptor_afd3e0fb69cf60c3) }
 
 var fileDescriptor_afd3e0fb69cf60c3 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0x87, 0x33, 0x71, 0xd5, 0x65, 0x16, 0x3c, 0x0c, 0xbb, 0x18, 0x82, 0x64, 0x77, 0xa3, 0xec,
-	0xfa, 0x67, 0x9d, 0x21, 0xdb, 0x8b, 0x47, 0x29, 0xa2, 0x78, 0xab, 0xa1, 0x27, 0x0f, 0xc2, 0x24,
-	0x1d, 0xc6, 0x01, 0x9b, 0x49, 0x33, 0xd3, 0x60, 0x11, 0x2f, 0x7e, 0x00, 0x11, 0xbc, 0x7a, 0xf2,
-	0x4b, 0xf8, 0x15, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0xd2, 0xfa, 0x41, 0xa4, 0x93, 0x49, 0x1b, 0x34,
-	0xb5, 0xbd, 0x85, 0xe4, 0x79, 0xdf, 0xdf, 0xf3, 0xbe, 0x93, 0x81, 0xa7, 0x79, 0x21, 0x4b, 0x96,
-	0xd1, 0x2c, 0x65, 0x44, 0x17, 0x82, 0x73, 0x56, 0x90, 0x32, 0x22, 0xa3, 0x31, 0x2b, 0x26, 0x38,
-	0x2f, 0xa4, 0x96, 0xe8, 0x68, 0x8d, 0x60, 0x8b, 0xe0, 0x32, 0xf2, 0x0f, 0xb9, 0xe4, 0xd2, 0x10,
-	0x64, 0xf9, 0x54, 0xc1, 0xfe, 0x2d, 0x2e, 0x25, 0x7f, 0xc3, 0x08, 0xcd, 0x05, 0xa1, 0x59, 0x26,
-	0x35, 0xd5, 0x42, 0x66, 0xca, 0x7e, 0xbd, 0x9f, 0x4a, 0x35, 0x94, 0x8a, 0x24, 0x54, 0xb1, 0x2a,
-	0x83, 0x94, 0x51, 0xc2, 0x34, 0x8d, 0x48, 0x4e, 0xb9, 0xc8, 0x0c, 0x6c, 0xd9, 0xdb, 0xed, 0x66,
-	0xb5, 0x81, 0x81, 0xc2, 0x7b, 0xf0, 0xe6, 0x8b, 0x65, 0x9b, 0x7e, 0xf5, 0xb6, 0x3b, 0x79, 0xfe,
-	0x24, 0x66, 0xa3, 0x31, 0x53, 0x1a, 0xdd, 0x80, 0xae, 0x18, 0x78, 0xe0, 0x04, 0xdc, 0xdd, 0x8b,
-	0x5d, 0x31, 0x08, 0xfb, 0xd0, 0xfb, 0x17, 0x55, 0xb9, 0xcc, 0x14, 0x43, 0x8f, 0xe0, 0x75, 0xdb,
-	0xd7, 0x14, 0x1c, 0x5c, 0x06, 0xb8, 0x75, 0x68, 0x6c, 0x8b, 0xe3, 0x1a, 0x0f, 0x5f, 0xc1, 0xc3,
-	0x66, 0x57, 0x55, 0xa7, 0x3f, 0x85, 0x70, 0x3d, 0x91, 0x97, 0x9a, 0xa6, 0x67, 0xb8, 0x1a, 0x1f,
-	0x2f, 0xc7, 0xc7, 0xd5, 0x8a, 0xed, 0xf8, 0xb8, 0x47, 0x39, 0xb3, 0xb5, 0x71, 0xa3, 0x32, 0xfc,
-	0x0a, 0xe0, 0xd1, 0x5f, 0x01, 0xd6, 0xf9, 0x31, 0xdc, 0xb7, 0x12, 0xca, 0x03, 0x27, 0x57, 0xb6,
-	0x4b, 0x77, 0xf7, 0xa6, 0x3f, 0x8f, 0x9d, 0x78, 0x55, 0x85, 0x9e, 0xb5, 0x38, 0x9e, 0x6f, 0x75,
-	0xac, 0xe2, 0x9b, 0x92, 0x97, 0xdf, 0x5c, 0x78, 0xd5, 0x48, 0xa2, 0x2f, 0x00, 0x1e, 0x34, 0x16,
-	0x8c, 0xf0, 0x06, 0xa5, 0x0d, 0x87, 0xe6, 0x93, 0x9d, 0xf9, 0x4a, 0x23, 0xbc, 0xf8, 0xf0, 0xfd,
-	0xf7, 0x67, 0xf7, 0x0c, 0xdd, 0x21, 0xff, 0xfd, 0x5d, 0x14, 0x79, 0x27, 0x06, 0xef, 0xd1, 0x47,
-	0x00, 0xf7, 0xeb, 0x45, 0xa2, 0x07, 0x3b, 0x64, 0xd5, 0xe7, 0xe9, 0x5f, 0xec, 0x06, 0x5b, 0xab,
-	0x73, 0x63, 0x75, 0x8a, 0x8e, 0xb7, 0x58, 0x75, 0xc5, 0x74, 0x1e, 0x80, 0xd9, 0x3c, 0x00, 0xbf,
-	0xe6, 0x01, 0xf8, 0xb4, 0x08, 0x9c, 0xd9, 0x22, 0x70, 0x7e, 0x2c, 0x02, 0x07, 0x7a, 0x42, 0xb6,
-	0x47, 0xf6, 0xc0, 0xcb, 0x0e, 0x17, 0xfa, 0xf5, 0x38, 0xc1, 0xa9, 0x1c, 0x36, 0x02, 0x1e, 0x0a,
-	0xd9, 0x8c, 0x7b, 0xbb, 0x0a, 0xd4, 0x93, 0x9c, 0xa9, 0xe4, 0x9a, 0xb9, 0x31, 0x9d, 0x3f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x28, 0xd3, 0x7b, 0x8c, 0xf2, 0x03, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0x12, 0x4d,
+	0x1c, 0xc6, 0x99, 0xbe, 0xa5, 0x6f, 0x99, 0x46, 0x0f, 0x13, 0xaa, 0xb8, 0x69, 0xb6, 0xed, 0x6a,
+	0xa8, 0x16, 0x98, 0x11, 0x48, 0x8c, 0x27, 0x63, 0x88, 0xda, 0x78, 0xab, 0x9b, 0x9e, 0x8c, 0xd1,
+	0x0c, 0x30, 0x19, 0x27, 0x29, 0x3b, 0xdb, 0x9d, 0x5d, 0x2c, 0x69, 0x7a, 0xe9, 0x07, 0x30, 0x26,
+	0x5e, 0x3d, 0x79, 0xf3, 0x9b, 0xf4, 0xd8, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x0f, 0x62, 0x98, 0x1d,
+	0x60, 0x41, 0x28, 0xeb, 0xad, 0x1d, 0x9e, 0x67, 0x9e, 0xdf, 0x7f, 0xfe, 0x0f, 0xc0, 0x5d, 0x3f,
+	0x90, 0x5d, 0xe6, 0x51, 0xaf, 0xc5, 0x48, 0x18, 0x08, 0xce, 0x59, 0x40, 0xba, 0x55, 0x72, 0x12,
+	0xb1, 0xa0, 0x87, 0xfd, 0x40, 0x86, 0x12, 0x6d, 0x4e, 0x24, 0xd8, 0x48, 0x70, 0xb7, 0x6a, 0xe5,
+	0xb9, 0xe4, 0x52, 0x2b, 0xc8, 0xf0, 0xaf, 0x58, 0x6c, 0x6d, 0x71, 0x29, 0xf9, 0x31, 0x23, 0xd4,
+	0x17, 0x84, 0x7a, 0x9e, 0x0c, 0x69, 0x28, 0xa4, 0xa7, 0xcc, 0xa7, 0xfb, 0x2d, 0xa9, 0x3a, 0x52,
+	0x91, 0x26, 0x55, 0x2c, 0xce, 0x20, 0xdd, 0x6a, 0x93, 0x85, 0xb4, 0x4a, 0x7c, 0xca, 0x85, 0xa7,
+	0xc5, 0x46, 0x7b, 0x77, 0x3e, 0xd9, 0x88, 0x40, 0x8b, 0x9c, 0x07, 0xf0, 0xf6, 0xab, 0xe1, 0x35,
+	0x47, 0xf1, 0x69, 0xa3, 0xf7, 0xf2, 0x99, 0xcb, 0x4e, 0x22, 0xa6, 0x42, 0x74, 0x13, 0xae, 0x88,
+	0x76, 0x01, 0xec, 0x80, 0xfb, 0xab, 0xee, 0x8a, 0x68, 0x3b, 0x47, 0xb0, 0xf0, 0xb7, 0x54, 0xf9,
+	0xd2, 0x53, 0x0c, 0x3d, 0x86, 0xff, 0x9b, 0x7b, 0xb5, 0x61, 0xa3, 0x66, 0xe3, 0xb9, 0x43, 0x63,
+	0x63, 0x76, 0x47, 0x72, 0xe7, 0x2d, 0xcc, 0x27, 0x6f, 0x55, 0xa3, 0xf4, 0x17, 0x10, 0x4e, 0x26,
+	0x2a, 0xb4, 0xf4, 0xa5, 0x45, 0x1c, 0x8f, 0x8f, 0x87, 0xe3, 0xe3, 0xf8, 0x89, 0xcd, 0xf8, 0xf8,
+	0x90, 0x72, 0x66, 0xbc, 0x6e, 0xc2, 0xe9, 0x7c, 0x05, 0x70, 0x73, 0x26, 0xc0, 0x30, 0x3f, 0x85,
+	0xeb, 0x06, 0x42, 0x15, 0xc0, 0xce, 0x7f, 0xcb, 0xa1, 0x1b, 0xab, 0x97, 0x3f, 0xb7, 0x33, 0xee,
+	0xd8, 0x85, 0x0e, 0xe6, 0x30, 0xee, 0x2d, 0x65, 0x8c, 0xe3, 0xa7, 0x20, 0x4b, 0xf0, 0x4e, 0x92,
+	0xd1, 0x65, 0x2a, 0x3a, 0x0e, 0x17, 0xed, 0xe1, 0x0d, 0xb4, 0xe6, 0x89, 0xcd, 0x54, 0x4f, 0xe0,
+	0x5a, 0xa0, 0x4f, 0xcc, 0x22, 0x8a, 0x0b, 0x66, 0x7a, 0x7e, 0xca, 0x5a, 0xd1, 0x30, 0xdc, 0xf8,
+	0x8d, 0xcb, 0xa9, 0x98, 0xe7, 0x3a, 0xa0, 0xaa, 0x11, 0xb5, 0x39, 0x1b, 0x63, 0xe4, 0x61, 0x56,
+	0x7e, 0xf0, 0xcc, 0x82, 0x73, 0x6e, 0xfc, 0x8f, 0xf3, 0x08, 0xde, 0x9a, 0x95, 0x1b, 0x90, 0x2d,
+	0x98, 0x0b, 0x58, 0x87, 0x0a, 0x4f, 0x78, 0xdc, 0xd0, 0x4f, 0x0e, 0x6a, 0x17, 0x59, 0x98, 0xd5,
+	0x46, 0xf4, 0x05, 0xc0, 0x8d, 0x44, 0xa5, 0x10, 0x5e, 0x00, 0xbc, 0xa0, 0xa6, 0x16, 0x49, 0xad,
+	0x8f, 0xc1, 0x9c, 0xf2, 0xc5, 0xf7, 0xdf, 0x9f, 0x57, 0x8a, 0xe8, 0x1e, 0xb9, 0xf6, 0x0b, 0xa2,
+	0xc8, 0x99, 0x68, 0x9f, 0xa3, 0x8f, 0x00, 0xae, 0x8f, 0xaa, 0x83, 0x4a, 0x29, 0xb2, 0x46, 0x0d,
+	0xb6, 0xca, 0xe9, 0xc4, 0x86, 0x6a, 0x4f, 0x53, 0xed, 0xa2, 0xed, 0x25, 0x54, 0xe8, 0x1b, 0x80,
+	0x37, 0xa6, 0x56, 0x8f, 0x1e, 0xa6, 0x08, 0x9a, 0xaa, 0x94, 0x55, 0xfd, 0x07, 0x87, 0xe1, 0xab,
+	0x6b, 0xbe, 0x0a, 0x2a, 0xa5, 0x79, 0x35, 0x12, 0x97, 0x69, 0xb8, 0xdb, 0xdc, 0xb8, 0x19, 0xe8,
+	0xda, 0x07, 0x99, 0xed, 0x9b, 0x55, 0x49, 0xa9, 0x36, 0x7c, 0x35, 0xcd, 0x57, 0x46, 0xfb, 0x0b,
+	0xf8, 0x38, 0x55, 0xef, 0x9a, 0xda, 0xa2, 0xc8, 0x99, 0xee, 0xee, 0x79, 0x43, 0x5c, 0xf6, 0x6d,
+	0x70, 0xd5, 0xb7, 0xc1, 0xaf, 0xbe, 0x0d, 0x3e, 0x0d, 0xec, 0xcc, 0xd5, 0xc0, 0xce, 0xfc, 0x18,
+	0xd8, 0x19, 0x58, 0x10, 0x72, 0x7e, 0xfc, 0x21, 0x78, 0x5d, 0xe7, 0x22, 0x7c, 0x1f, 0x35, 0x71,
+	0x4b, 0x76, 0x12, 0x59, 0x15, 0x21, 0x93, 0xc9, 0xa7, 0xe3, 0xec, 0xb0, 0xe7, 0x33, 0xd5, 0x5c,
+	0xd3, 0x3f, 0xb7, 0xf5, 0x3f, 0x03, 0x00, 0x31, 0x72, 0xf8, 0xd1, 0x2f, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -280,6 +477,10 @@ type QueryClient interface {
 	TriggerByID(ctx context.Context, in *QueryTriggerByIDRequest, opts ...grpc.CallOption) (*QueryTriggerByIDResponse, error)
 	// Triggers returns the list of triggers.
 	Triggers(ctx context.Context, in *QueryTriggersRequest, opts ...grpc.CallOption) (*QueryTriggersResponse, error)
+	// TriggerResult returns the outcome of the most recent run of a trigger's actions.
+	TriggerResult(ctx context.Context, in *QueryTriggerResultRequest, opts ...grpc.CallOption) (*QueryTriggerResultResponse, error)
+	// GasBudget returns the gas an owner has left for the executions of its triggers.
+	GasBudget(ctx context.Context, in *QueryGasBudgetRequest, opts ...grpc.CallOption) (*QueryGasBudgetResponse, error)
 }
 
 type queryClient struct {
@@ -308,12 +509,34 @@ func (c *queryClient) Triggers(ctx context.Context, in *QueryTriggersRequest, op
 	return out, nil
 }
 
+func (c *queryClient) TriggerResult(ctx context.Context, in *QueryTriggerResultRequest, opts ...grpc.CallOption) (*QueryTriggerResultResponse, error) {
+	out := new(QueryTriggerResultResponse)
+	err := c.cc.Invoke(ctx, "/provenance.trigger.v1.Query/TriggerResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasBudget(ctx context.Context, in *QueryGasBudgetRequest, opts ...grpc.CallOption) (*QueryGasBudgetResponse, error) {
+	out := new(QueryGasBudgetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.trigger.v1.Query/GasBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TriggerByID returns a trigger matching the ID.
 	TriggerByID(context.Context, *QueryTriggerByIDRequest) (*QueryTriggerByIDResponse, error)
 	// Triggers returns the list of triggers.
 	Triggers(context.Context, *QueryTriggersRequest) (*QueryTriggersResponse, error)
+	// TriggerResult returns the outcome of the most recent run of a trigger's actions.
+	TriggerResult(context.Context, *QueryTriggerResultRequest) (*QueryTriggerResultResponse, error)
+	// GasBudget returns the gas an owner has left for the executions of its triggers.
+	GasBudget(context.Context, *QueryGasBudgetRequest) (*QueryGasBudgetResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -326,6 +549,12 @@ func (*UnimplementedQueryServer) TriggerByID(ctx context.Context, req *QueryTrig
 func (*UnimplementedQueryServer) Triggers(ctx context.Context, req *QueryTriggersRequest) (*QueryTriggersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Triggers not implemented")
 }
+func (*UnimplementedQueryServer) TriggerResult(ctx context.Context, req *QueryTriggerResultRequest) (*QueryTriggerResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerResult not implemented")
+}
+func (*UnimplementedQueryServer) GasBudget(ctx context.Context, req *QueryGasBudgetRequest) (*QueryGasBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasBudget not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -367,6 +596,42 @@ func _Query_Triggers_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TriggerResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTriggerResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TriggerResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.trigger.v1.Query/TriggerResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TriggerResult(ctx, req.(*QueryTriggerResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.trigger.v1.Query/GasBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasBudget(ctx, req.(*QueryGasBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.trigger.v1.Query",
@@ -380,6 +645,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Triggers",
 			Handler:    _Query_Triggers_Handler,
 		},
+		{
+			MethodName: "TriggerResult",
+			Handler:    _Query_TriggerResult_Handler,
+		},
+		{
+			MethodName: "GasBudget",
+			Handler:    _Query_GasBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/trigger/v1/query.proto",
@@ -536,6 +809,127 @@ func (m *QueryTriggersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTriggerResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTriggerResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTriggerResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTriggerResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTriggerResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTriggerResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -604,11 +998,61 @@ func (m *QueryTriggersResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryTriggerResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTriggerResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryTriggerByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -971,6 +1415,312 @@ func (m *QueryTriggersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTriggerResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTriggerResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTriggerResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTriggerResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTriggerResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTriggerResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &ExecutionResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,17 +7,30 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	proto "github.com/cosmos/gogoproto/proto"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 type TriggerID = uint64
 
 const (
-	BlockHeightPrefix = "block-height"
-	BlockTimePrefix   = "block-time"
+	BlockHeightPrefix       = "block-height"
+	BlockTimePrefix         = "block-time"
+	NetAssetValuePrefix     = "net-asset-value"
+	AttributePrefix         = "attribute"
+	OrderFilledPrefix       = "order-filled"
+	AttributeExpiringPrefix = "attribute-expiring"
+
+	// OrderFilledEventType is the type of the event emitted by the exchange module when an order is filled.
+	OrderFilledEventType = "provenance.exchange.v1.EventOrderFilled"
+	// OrderFilledOrderIDKey is the key of the order id attribute in an OrderFilledEventType event.
+	OrderFilledOrderIDKey = "order_id"
 )
 
 type TriggerEventI interface {
@@ -31,6 +44,10 @@ type TriggerEventI interface {
 var _ TriggerEventI = &TransactionEvent{}
 var _ TriggerEventI = &BlockHeightEvent{}
 var _ TriggerEventI = &BlockTimeEvent{}
+var _ TriggerEventI = &NetAssetValueEvent{}
+var _ TriggerEventI = &AttributeEvent{}
+var _ TriggerEventI = &OrderFilledEvent{}
+var _ TriggerEventI = &AttributeExpiringEvent{}
 var _ codectypes.UnpackInterfacesMessage = (*Trigger)(nil)
 var _ codectypes.UnpackInterfacesMessage = (*QueuedTrigger)(nil)
 
@@ -145,14 +162,265 @@ func (e BlockTimeEvent) ValidateContext(ctx sdk.Context) error {
 	return nil
 }
 
+// GetEventPrefix gets the prefix for a NetAssetValueEvent.
+func (e NetAssetValueEvent) GetEventPrefix() string {
+	return NetAssetValuePrefix
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e NetAssetValueEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e NetAssetValueEvent) Validate() error {
+	if err := sdk.ValidateDenom(e.MarkerDenom); err != nil {
+		return fmt.Errorf("invalid marker denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(e.PriceDenom); err != nil {
+		return fmt.Errorf("invalid price denom: %w", err)
+	}
+	threshold, err := sdkmath.LegacyNewDecFromStr(e.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold %q: %w", e.Threshold, err)
+	}
+	if threshold.IsNegative() {
+		return fmt.Errorf("invalid threshold %q: cannot be negative", e.Threshold)
+	}
+	if e.Direction != ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE && e.Direction != ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW {
+		return fmt.Errorf("invalid threshold direction: %s", e.Direction)
+	}
+	return nil
+}
+
+// Validate checks if this event is valid with the current context.
+func (e NetAssetValueEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// Matches checks if the price of a single marker token in the net asset value is on the correct side of the threshold.
+func (e NetAssetValueEvent) Matches(nav markertypes.NetAssetValue) bool {
+	if nav.Price.Denom != e.PriceDenom || nav.Volume == 0 {
+		return false
+	}
+	threshold, err := sdkmath.LegacyNewDecFromStr(e.Threshold)
+	if err != nil {
+		return false
+	}
+	unitPrice := sdkmath.LegacyNewDecFromInt(nav.Price.Amount).QuoInt64(int64(nav.Volume))
+	switch e.Direction {
+	case ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE:
+		return unitPrice.GTE(threshold)
+	case ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW:
+		return unitPrice.LTE(threshold)
+	default:
+		return false
+	}
+}
+
+// GetEventPrefix gets the prefix for an AttributeEvent.
+func (e AttributeEvent) GetEventPrefix() string {
+	return AttributePrefix
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e AttributeEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e AttributeEvent) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Account); err != nil {
+		return fmt.Errorf("invalid account: %w", err)
+	}
+	if strings.TrimSpace(e.Name) == "" {
+		return fmt.Errorf("empty attribute name")
+	}
+	return nil
+}
+
+// Validate checks if this event is valid with the current context.
+func (e AttributeEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// GetEventPrefix gets the prefix for an AttributeExpiringEvent.
+func (e AttributeExpiringEvent) GetEventPrefix() string {
+	return AttributeExpiringPrefix
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e AttributeExpiringEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e AttributeExpiringEvent) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Account); err != nil {
+		return fmt.Errorf("invalid account: %w", err)
+	}
+	if strings.TrimSpace(e.Name) == "" {
+		return fmt.Errorf("empty attribute name")
+	}
+	if e.NoticePeriod <= 0 {
+		return fmt.Errorf("invalid notice period %s: must be positive", e.NoticePeriod)
+	}
+	return nil
+}
+
+// Validate checks if this event is valid with the current context.
+func (e AttributeExpiringEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// Matches checks if the attribute expires after the block time, but within the notice period of it.
+func (e AttributeExpiringEvent) Matches(expiration *time.Time, blockTime time.Time) bool {
+	if expiration == nil || !expiration.After(blockTime) {
+		return false
+	}
+	return !expiration.After(blockTime.Add(e.NoticePeriod))
+}
+
+// GetEventPrefix gets the prefix for an OrderFilledEvent.
+func (e OrderFilledEvent) GetEventPrefix() string {
+	return OrderFilledPrefix
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e OrderFilledEvent) GetEventOrder() uint64 {
+	return e.OrderId
+}
+
+// Validate checks if the event data is valid.
+func (e OrderFilledEvent) Validate() error {
+	if e.OrderId == 0 {
+		return fmt.Errorf("invalid order id: cannot be zero")
+	}
+	return nil
+}
+
+// Validate checks if this event is valid with the current context.
+func (e OrderFilledEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
 // NewTrigger creates a new trigger.
 func NewTrigger(id TriggerID, owner string, event *codectypes.Any, action []*codectypes.Any) Trigger {
 	return Trigger{
-		id,
-		owner,
-		event,
-		action,
+		Id:      id,
+		Owner:   owner,
+		Event:   event,
+		Actions: action,
+	}
+}
+
+// Validate checks if the recurrence data is valid.
+func (r Recurrence) Validate() error {
+	if r.IntervalBlocks == 0 && r.Interval <= 0 {
+		return fmt.Errorf("recurrence must have an interval")
 	}
+	if r.IntervalBlocks != 0 && r.Interval != 0 {
+		return fmt.Errorf("recurrence cannot have both an interval and an interval in blocks")
+	}
+	if r.MaxExecutions == 0 {
+		return fmt.Errorf("recurrence max executions cannot be zero")
+	}
+	if r.Executions > r.MaxExecutions {
+		return fmt.Errorf("recurrence executions %d cannot exceed max executions %d", r.Executions, r.MaxExecutions)
+	}
+	if err := r.FeePerRun.Validate(); err != nil {
+		return fmt.Errorf("invalid recurrence fee per run: %w", err)
+	}
+	return nil
+}
+
+// ValidateEvent checks that the event is the kind that the recurrence repeats.
+func (r Recurrence) ValidateEvent(event TriggerEventI) error {
+	switch event.(type) {
+	case *BlockHeightEvent:
+		if r.IntervalBlocks == 0 {
+			return fmt.Errorf("recurrence of a block height event must have an interval in blocks")
+		}
+	case *BlockTimeEvent:
+		if r.Interval <= 0 {
+			return fmt.Errorf("recurrence of a block time event must have an interval")
+		}
+	default:
+		return fmt.Errorf("recurrence is not supported for %T", event)
+	}
+	return nil
+}
+
+// IsDone returns true if the trigger has fired the maximum number of times.
+func (r Recurrence) IsDone() bool {
+	return r.Executions >= r.MaxExecutions
+}
+
+// RemainingFees gets the fees for all of the executions that haven't happened yet.
+func (r Recurrence) RemainingFees() sdk.Coins {
+	if r.IsDone() || r.FeePerRun.IsZero() {
+		return nil
+	}
+	remaining := sdkmath.NewIntFromUint64(r.MaxExecutions - r.Executions)
+	return r.FeePerRun.MulInt(remaining)
+}
+
+// NextEvent gets the event for the next execution, measured from the provided block height and time.
+func (r Recurrence) NextEvent(ctx sdk.Context) TriggerEventI {
+	if r.IntervalBlocks != 0 {
+		return &BlockHeightEvent{BlockHeight: uint64(ctx.BlockHeight()) + r.IntervalBlocks}
+	}
+	return &BlockTimeEvent{Time: ctx.BlockTime().UTC().Add(r.Interval)}
+}
+
+// MaxRetries is the largest number of times a trigger's failed actions can be retried.
+const MaxRetries = 5
+
+// Validate checks if the retry policy is valid.
+func (p RetryPolicy) Validate() error {
+	if p.MaxRetries > MaxRetries {
+		return fmt.Errorf("retry policy max retries %d cannot exceed %d", p.MaxRetries, MaxRetries)
+	}
+	return nil
+}
+
+// CanRetry returns true if the trigger's actions can be run again after the provided number of attempts.
+// A nil retry policy never retries.
+func (p *RetryPolicy) CanRetry(attempts uint32) bool {
+	return p != nil && attempts <= p.MaxRetries
+}
+
+// NewExecutionResult creates a new execution result for a run of a trigger's actions.
+func NewExecutionResult(ctx sdk.Context, id TriggerID, attempt uint32, gasUsed uint64, err error) ExecutionResult {
+	rv := ExecutionResult{
+		TriggerId:   id,
+		BlockHeight: uint64(ctx.BlockHeight()),
+		Time:        ctx.BlockTime().UTC(),
+		Success:     err == nil,
+		GasUsed:     gasUsed,
+		Attempt:     attempt,
+	}
+	if err != nil {
+		rv.Error = err.Error()
+	}
+	return rv
+}
+
+// Validate checks if the execution result is valid.
+func (r ExecutionResult) Validate() error {
+	if r.TriggerId == 0 {
+		return fmt.Errorf("execution result trigger id cannot be zero")
+	}
+	if r.Attempt == 0 {
+		return fmt.Errorf("execution result for trigger %d attempt cannot be zero", r.TriggerId)
+	}
+	if r.Success && len(r.Error) > 0 {
+		return fmt.Errorf("successful execution result for trigger %d cannot have an error", r.TriggerId)
+	}
+	if r.Success && r.RetryQueued {
+		return fmt.Errorf("successful execution result for trigger %d cannot have a retry queued", r.TriggerId)
+	}
+	return nil
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
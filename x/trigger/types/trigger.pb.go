@@ -7,9 +7,12 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +32,38 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ThresholdDirection is the side of a threshold that a value must be on.
+type ThresholdDirection int32
+
+const (
+	// THRESHOLD_DIRECTION_UNSPECIFIED is an invalid value.
+	ThresholdDirection_THRESHOLD_DIRECTION_UNSPECIFIED ThresholdDirection = 0
+	// THRESHOLD_DIRECTION_AT_OR_ABOVE means the value must be greater than or equal to the threshold.
+	ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE ThresholdDirection = 1
+	// THRESHOLD_DIRECTION_AT_OR_BELOW means the value must be less than or equal to the threshold.
+	ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW ThresholdDirection = 2
+)
+
+var ThresholdDirection_name = map[int32]string{
+	0: "THRESHOLD_DIRECTION_UNSPECIFIED",
+	1: "THRESHOLD_DIRECTION_AT_OR_ABOVE",
+	2: "THRESHOLD_DIRECTION_AT_OR_BELOW",
+}
+
+var ThresholdDirection_value = map[string]int32{
+	"THRESHOLD_DIRECTION_UNSPECIFIED": 0,
+	"THRESHOLD_DIRECTION_AT_OR_ABOVE": 1,
+	"THRESHOLD_DIRECTION_AT_OR_BELOW": 2,
+}
+
+func (x ThresholdDirection) String() string {
+	return proto.EnumName(ThresholdDirection_name, int32(x))
+}
+
+func (ThresholdDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{0}
+}
+
 // Trigger
 type Trigger struct {
 	// An integer to uniquely identify the trigger.
@@ -39,6 +74,13 @@ type Trigger struct {
 	Event *types.Any `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
 	// The messages to run when the trigger fires.
 	Actions []*types.Any `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	// How the trigger repeats after it fires. A trigger without a recurrence only fires once.
+	Recurrence *Recurrence `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// How many times the trigger's actions are retried if they fail. A trigger without a retry policy is not retried.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// The account the actions are run as, using authz grants it has given to the owner.
+	// The grants are checked each time the trigger fires. Empty if the actions are run as the owner.
+	Granter string `protobuf:"bytes,7,opt,name=granter,proto3" json:"granter,omitempty"`
 }
 
 func (m *Trigger) Reset()         { *m = Trigger{} }
@@ -102,6 +144,156 @@ func (m *Trigger) GetActions() []*types.Any {
 	return nil
 }
 
+func (m *Trigger) GetRecurrence() *Recurrence {
+	if m != nil {
+		return m.Recurrence
+	}
+	return nil
+}
+
+func (m *Trigger) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *Trigger) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+// RetryPolicy defines how a trigger's failed actions are retried.
+type RetryPolicy struct {
+	// The maximum number of times the actions are retried after failing. Each retry happens in a later block.
+	MaxRetries uint32 `protobuf:"varint,1,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{1}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// Recurrence defines how a trigger repeats after it fires.
+type Recurrence struct {
+	// The number of blocks between executions. Used with a BlockHeightEvent.
+	IntervalBlocks uint64 `protobuf:"varint,1,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// The amount of time between executions. Used with a BlockTimeEvent.
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// The maximum number of times the trigger can fire.
+	MaxExecutions uint64 `protobuf:"varint,3,opt,name=max_executions,json=maxExecutions,proto3" json:"max_executions,omitempty"`
+	// The number of times the trigger has fired.
+	Executions uint64 `protobuf:"varint,4,opt,name=executions,proto3" json:"executions,omitempty"`
+	// The fee paid by the owner each time the trigger fires.
+	// The fees for all remaining executions are held in the owner's account until used or the trigger is destroyed.
+	FeePerRun github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=fee_per_run,json=feePerRun,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_per_run"`
+}
+
+func (m *Recurrence) Reset()         { *m = Recurrence{} }
+func (m *Recurrence) String() string { return proto.CompactTextString(m) }
+func (*Recurrence) ProtoMessage()    {}
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{2}
+}
+func (m *Recurrence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recurrence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recurrence.Merge(m, src)
+}
+func (m *Recurrence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recurrence proto.InternalMessageInfo
+
+func (m *Recurrence) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
+}
+
+func (m *Recurrence) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Recurrence) GetMaxExecutions() uint64 {
+	if m != nil {
+		return m.MaxExecutions
+	}
+	return 0
+}
+
+func (m *Recurrence) GetExecutions() uint64 {
+	if m != nil {
+		return m.Executions
+	}
+	return 0
+}
+
+func (m *Recurrence) GetFeePerRun() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeePerRun
+	}
+	return nil
+}
+
 // QueuedTrigger
 type QueuedTrigger struct {
 	// The block height the trigger was detected and queued.
@@ -110,13 +302,15 @@ type QueuedTrigger struct {
 	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
 	// The trigger that was detected.
 	Trigger Trigger `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger"`
+	// The number of times the trigger's actions have already been attempted.
+	Attempts uint32 `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (m *QueuedTrigger) Reset()         { *m = QueuedTrigger{} }
 func (m *QueuedTrigger) String() string { return proto.CompactTextString(m) }
 func (*QueuedTrigger) ProtoMessage()    {}
 func (*QueuedTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe59296a7b42130c, []int{1}
+	return fileDescriptor_fe59296a7b42130c, []int{3}
 }
 func (m *QueuedTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,6 +360,122 @@ func (m *QueuedTrigger) GetTrigger() Trigger {
 	return Trigger{}
 }
 
+func (m *QueuedTrigger) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// ExecutionResult is the outcome of the most recent attempt to run a trigger's actions.
+type ExecutionResult struct {
+	// The identifier of the trigger that was run.
+	TriggerId uint64 `protobuf:"varint,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	// The block height the actions were run at.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time the actions were run at.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Whether all of the actions were successful.
+	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// The reason the actions failed. Empty when successful.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// The amount of gas used by the actions.
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// The attempt number of this run, starting at 1.
+	Attempt uint32 `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Whether the actions will be attempted again.
+	RetryQueued bool `protobuf:"varint,8,opt,name=retry_queued,json=retryQueued,proto3" json:"retry_queued,omitempty"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{4}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetTriggerId() uint64 {
+	if m != nil {
+		return m.TriggerId
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ExecutionResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetRetryQueued() bool {
+	if m != nil {
+		return m.RetryQueued
+	}
+	return false
+}
+
 // BlockHeightEvent
 type BlockHeightEvent struct {
 	// The height that the trigger should fire at.
@@ -176,7 +486,7 @@ func (m *BlockHeightEvent) Reset()         { *m = BlockHeightEvent{} }
 func (m *BlockHeightEvent) String() string { return proto.CompactTextString(m) }
 func (*BlockHeightEvent) ProtoMessage()    {}
 func (*BlockHeightEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe59296a7b42130c, []int{2}
+	return fileDescriptor_fe59296a7b42130c, []int{5}
 }
 func (m *BlockHeightEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +532,7 @@ func (m *BlockTimeEvent) Reset()         { *m = BlockTimeEvent{} }
 func (m *BlockTimeEvent) String() string { return proto.CompactTextString(m) }
 func (*BlockTimeEvent) ProtoMessage()    {}
 func (*BlockTimeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe59296a7b42130c, []int{3}
+	return fileDescriptor_fe59296a7b42130c, []int{6}
 }
 func (m *BlockTimeEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +580,7 @@ func (m *TransactionEvent) Reset()         { *m = TransactionEvent{} }
 func (m *TransactionEvent) String() string { return proto.CompactTextString(m) }
 func (*TransactionEvent) ProtoMessage()    {}
 func (*TransactionEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe59296a7b42130c, []int{4}
+	return fileDescriptor_fe59296a7b42130c, []int{7}
 }
 func (m *TransactionEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +635,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe59296a7b42130c, []int{5}
+	return fileDescriptor_fe59296a7b42130c, []int{8}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,105 +678,463 @@ func (m *Attribute) GetValue() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Trigger)(nil), "provenance.trigger.v1.Trigger")
-	proto.RegisterType((*QueuedTrigger)(nil), "provenance.trigger.v1.QueuedTrigger")
-	proto.RegisterType((*BlockHeightEvent)(nil), "provenance.trigger.v1.BlockHeightEvent")
-	proto.RegisterType((*BlockTimeEvent)(nil), "provenance.trigger.v1.BlockTimeEvent")
-	proto.RegisterType((*TransactionEvent)(nil), "provenance.trigger.v1.TransactionEvent")
-	proto.RegisterType((*Attribute)(nil), "provenance.trigger.v1.Attribute")
+// NetAssetValueEvent
+type NetAssetValueEvent struct {
+	// The denom of the marker whose net asset value is watched.
+	MarkerDenom string `protobuf:"bytes,1,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+	// The denom that the net asset value must be priced in.
+	PriceDenom string `protobuf:"bytes,2,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// The price of a single marker token, as a decimal string, that the net asset value is compared to.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Which side of the threshold the net asset value must be on for the trigger to fire.
+	Direction ThresholdDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=provenance.trigger.v1.ThresholdDirection" json:"direction,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/trigger/v1/trigger.proto", fileDescriptor_fe59296a7b42130c)
+func (m *NetAssetValueEvent) Reset()         { *m = NetAssetValueEvent{} }
+func (m *NetAssetValueEvent) String() string { return proto.CompactTextString(m) }
+func (*NetAssetValueEvent) ProtoMessage()    {}
+func (*NetAssetValueEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{9}
 }
-
-var fileDescriptor_fe59296a7b42130c = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xa6, 0x2e, 0x21, 0x13, 0x5a, 0x15, 0x2b, 0x95, 0xdc, 0x1c, 0x1c, 0x13, 0x2e, 0xb9,
-	0x64, 0xad, 0xb6, 0x17, 0x54, 0x04, 0x52, 0x2c, 0x81, 0x40, 0xe2, 0x00, 0x26, 0x27, 0x2e, 0x95,
-	0x3f, 0x16, 0x67, 0x45, 0xb2, 0x1b, 0xd9, 0x6b, 0x43, 0x7e, 0x00, 0xf7, 0xfe, 0x04, 0x7e, 0x03,
-	0xea, 0x99, 0x73, 0xc5, 0xa9, 0xe2, 0xc4, 0x09, 0x50, 0x72, 0xe1, 0x67, 0x20, 0xef, 0xae, 0x9b,
-	0x8a, 0x26, 0x12, 0xdc, 0x3c, 0xb3, 0xef, 0xcd, 0x7b, 0xf3, 0x46, 0x86, 0xfb, 0xb3, 0x94, 0x17,
-	0x84, 0x05, 0x2c, 0x22, 0xae, 0x48, 0x69, 0x92, 0x90, 0xd4, 0x2d, 0x0e, 0xab, 0x4f, 0x3c, 0x4b,
-	0xb9, 0xe0, 0xe6, 0xfe, 0x0a, 0x84, 0xab, 0x97, 0xe2, 0xb0, 0x73, 0x10, 0xf1, 0x6c, 0xca, 0xb3,
-	0x53, 0x09, 0x72, 0x55, 0xa1, 0x18, 0x9d, 0x76, 0xc2, 0x13, 0xae, 0xfa, 0xe5, 0x97, 0xee, 0x1e,
-	0x24, 0x9c, 0x27, 0x13, 0xe2, 0xca, 0x2a, 0xcc, 0xdf, 0xba, 0x01, 0x9b, 0xeb, 0xa7, 0xee, 0xdf,
-	0x4f, 0x82, 0x4e, 0x49, 0x26, 0x82, 0xe9, 0x4c, 0x01, 0x7a, 0x5f, 0x10, 0x34, 0x46, 0x4a, 0xdb,
-	0xdc, 0x85, 0x3a, 0x8d, 0x2d, 0xe4, 0xa0, 0xbe, 0xe1, 0xd7, 0x69, 0x6c, 0x62, 0xd8, 0xe6, 0xef,
-	0x19, 0x49, 0xad, 0xba, 0x83, 0xfa, 0x4d, 0xcf, 0xfa, 0x76, 0x3e, 0x68, 0x6b, 0x3b, 0xc3, 0x38,
-	0x4e, 0x49, 0x96, 0xbd, 0x16, 0x29, 0x65, 0x89, 0xaf, 0x60, 0xe6, 0x23, 0xd8, 0x26, 0x05, 0x61,
-	0xc2, 0xda, 0x72, 0x50, 0xbf, 0x75, 0xd4, 0xc6, 0x4a, 0x1c, 0x57, 0xe2, 0x78, 0xc8, 0xe6, 0xde,
-	0xdd, 0xaf, 0xe7, 0x83, 0x1d, 0xad, 0xf8, 0xa4, 0x44, 0x3f, 0xf7, 0x15, 0xcb, 0xc4, 0xd0, 0x08,
-	0x22, 0x41, 0x39, 0xcb, 0x2c, 0xc3, 0xd9, 0xda, 0x34, 0xc0, 0xaf, 0x40, 0x27, 0xc6, 0xef, 0x4f,
-	0x5d, 0xd4, 0xfb, 0x8c, 0x60, 0xe7, 0x55, 0x4e, 0x72, 0x12, 0x57, 0x6b, 0xdc, 0x83, 0x3b, 0xe1,
-	0x84, 0x47, 0xef, 0x4e, 0xc7, 0x84, 0x26, 0x63, 0xa1, 0x17, 0x6a, 0xc9, 0xde, 0x33, 0xd9, 0x32,
-	0x1f, 0x80, 0x51, 0x06, 0x21, 0x17, 0x6b, 0x1d, 0x75, 0x6e, 0xe8, 0x8c, 0xaa, 0x94, 0xbc, 0xdb,
-	0x17, 0x3f, 0xba, 0xb5, 0xb3, 0x9f, 0x5d, 0xe4, 0x4b, 0x86, 0xf9, 0x18, 0x1a, 0xfa, 0x54, 0x7a,
-	0x4b, 0x1b, 0xaf, 0xbd, 0x22, 0xd6, 0x6e, 0x3c, 0xa3, 0x1c, 0xe0, 0x57, 0x24, 0x6d, 0xfa, 0x05,
-	0xec, 0x79, 0x2b, 0x3b, 0x32, 0x86, 0x7f, 0xb0, 0x7d, 0xb2, 0x5f, 0x92, 0x6f, 0xe4, 0xd7, 0x0b,
-	0x60, 0x57, 0x4e, 0x2b, 0x5d, 0xab, 0x59, 0xd5, 0x7e, 0xe8, 0x7f, 0xf7, 0xdb, 0x24, 0xf1, 0x11,
-	0xc1, 0xde, 0x28, 0x0d, 0x58, 0xa6, 0xc2, 0x57, 0x2a, 0x26, 0x18, 0x2c, 0xd0, 0x2a, 0x4d, 0x5f,
-	0x7e, 0x9b, 0x4f, 0x01, 0x02, 0x21, 0x52, 0x1a, 0xe6, 0x82, 0x64, 0x56, 0x5d, 0xde, 0xd1, 0xd9,
-	0x10, 0xd1, 0xb0, 0x02, 0xea, 0x90, 0xae, 0x31, 0x37, 0xf9, 0x78, 0x08, 0xcd, 0x2b, 0xd6, 0x5a,
-	0xfd, 0x36, 0x6c, 0x17, 0xc1, 0x24, 0x57, 0xa7, 0x6d, 0xfa, 0xaa, 0x50, 0xa9, 0x7b, 0xf4, 0x62,
-	0x61, 0xa3, 0xcb, 0x85, 0x8d, 0x7e, 0x2d, 0x6c, 0x74, 0xb6, 0xb4, 0x6b, 0x97, 0x4b, 0xbb, 0xf6,
-	0x7d, 0x69, 0xd7, 0xc0, 0xa2, 0x7c, 0xbd, 0xc7, 0x97, 0xe8, 0xcd, 0x71, 0x42, 0xc5, 0x38, 0x0f,
-	0x71, 0xc4, 0xa7, 0xee, 0x0a, 0x33, 0xa0, 0xfc, 0x5a, 0xe5, 0x7e, 0xb8, 0xfa, 0xcb, 0xc5, 0x7c,
-	0x46, 0xb2, 0xf0, 0x96, 0x8c, 0xfa, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xf1, 0x6b,
-	0x40, 0x08, 0x04, 0x00, 0x00,
+func (m *NetAssetValueEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *Trigger) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Trigger)
-	if !ok {
-		that2, ok := that.(Trigger)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *NetAssetValueEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetAssetValueEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.Owner != that1.Owner {
-		return false
-	}
-	if !this.Event.Equal(that1.Event) {
-		return false
+}
+func (m *NetAssetValueEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetAssetValueEvent.Merge(m, src)
+}
+func (m *NetAssetValueEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetAssetValueEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetAssetValueEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetAssetValueEvent proto.InternalMessageInfo
+
+func (m *NetAssetValueEvent) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
 	}
-	if len(this.Actions) != len(that1.Actions) {
-		return false
+	return ""
+}
+
+func (m *NetAssetValueEvent) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
 	}
-	for i := range this.Actions {
-		if !this.Actions[i].Equal(that1.Actions[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *NetAssetValueEvent) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	return true
+	return ""
 }
-func (this *QueuedTrigger) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NetAssetValueEvent) GetDirection() ThresholdDirection {
+	if m != nil {
+		return m.Direction
 	}
+	return ThresholdDirection_THRESHOLD_DIRECTION_UNSPECIFIED
+}
 
-	that1, ok := that.(*QueuedTrigger)
-	if !ok {
-		that2, ok := that.(QueuedTrigger)
-		if ok {
-			that1 = &that2
-		} else {
+// AttributeEvent
+type AttributeEvent struct {
+	// The account that must have the attribute.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The name of the attribute that the account must have.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *AttributeEvent) Reset()         { *m = AttributeEvent{} }
+func (m *AttributeEvent) String() string { return proto.CompactTextString(m) }
+func (*AttributeEvent) ProtoMessage()    {}
+func (*AttributeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{10}
+}
+func (m *AttributeEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeEvent.Merge(m, src)
+}
+func (m *AttributeEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeEvent proto.InternalMessageInfo
+
+func (m *AttributeEvent) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AttributeEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// AttributeExpiringEvent
+type AttributeExpiringEvent struct {
+	// The account that has the attribute.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The name of the attribute that is expiring.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// How long before the attribute's expiration date the event should fire.
+	NoticePeriod time.Duration `protobuf:"bytes,3,opt,name=notice_period,json=noticePeriod,proto3,stdduration" json:"notice_period"`
+}
+
+func (m *AttributeExpiringEvent) Reset()         { *m = AttributeExpiringEvent{} }
+func (m *AttributeExpiringEvent) String() string { return proto.CompactTextString(m) }
+func (*AttributeExpiringEvent) ProtoMessage()    {}
+func (*AttributeExpiringEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{11}
+}
+func (m *AttributeExpiringEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeExpiringEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeExpiringEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeExpiringEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeExpiringEvent.Merge(m, src)
+}
+func (m *AttributeExpiringEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeExpiringEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeExpiringEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeExpiringEvent proto.InternalMessageInfo
+
+func (m *AttributeExpiringEvent) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AttributeExpiringEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeExpiringEvent) GetNoticePeriod() time.Duration {
+	if m != nil {
+		return m.NoticePeriod
+	}
+	return 0
+}
+
+// OrderFilledEvent
+type OrderFilledEvent struct {
+	// The id of the exchange order that must be filled.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *OrderFilledEvent) Reset()         { *m = OrderFilledEvent{} }
+func (m *OrderFilledEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderFilledEvent) ProtoMessage()    {}
+func (*OrderFilledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{12}
+}
+func (m *OrderFilledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderFilledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderFilledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderFilledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFilledEvent.Merge(m, src)
+}
+func (m *OrderFilledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderFilledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFilledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFilledEvent proto.InternalMessageInfo
+
+func (m *OrderFilledEvent) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("provenance.trigger.v1.ThresholdDirection", ThresholdDirection_name, ThresholdDirection_value)
+	proto.RegisterType((*Trigger)(nil), "provenance.trigger.v1.Trigger")
+	proto.RegisterType((*RetryPolicy)(nil), "provenance.trigger.v1.RetryPolicy")
+	proto.RegisterType((*Recurrence)(nil), "provenance.trigger.v1.Recurrence")
+	proto.RegisterType((*QueuedTrigger)(nil), "provenance.trigger.v1.QueuedTrigger")
+	proto.RegisterType((*ExecutionResult)(nil), "provenance.trigger.v1.ExecutionResult")
+	proto.RegisterType((*BlockHeightEvent)(nil), "provenance.trigger.v1.BlockHeightEvent")
+	proto.RegisterType((*BlockTimeEvent)(nil), "provenance.trigger.v1.BlockTimeEvent")
+	proto.RegisterType((*TransactionEvent)(nil), "provenance.trigger.v1.TransactionEvent")
+	proto.RegisterType((*Attribute)(nil), "provenance.trigger.v1.Attribute")
+	proto.RegisterType((*NetAssetValueEvent)(nil), "provenance.trigger.v1.NetAssetValueEvent")
+	proto.RegisterType((*AttributeEvent)(nil), "provenance.trigger.v1.AttributeEvent")
+	proto.RegisterType((*AttributeExpiringEvent)(nil), "provenance.trigger.v1.AttributeExpiringEvent")
+	proto.RegisterType((*OrderFilledEvent)(nil), "provenance.trigger.v1.OrderFilledEvent")
+}
+
+func init() {
+	proto.RegisterFile("provenance/trigger/v1/trigger.proto", fileDescriptor_fe59296a7b42130c)
+}
+
+var fileDescriptor_fe59296a7b42130c = []byte{
+	// 1136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x76, 0x6d, 0x3f, 0xd7, 0xae, 0xbf, 0xa3, 0xf4, 0xab, 0x4d, 0x04, 0x76, 0x9a,
+	0x0a, 0x11, 0x90, 0xb2, 0x26, 0x29, 0x07, 0x54, 0x04, 0xc8, 0xae, 0x1d, 0x62, 0x29, 0x8a, 0xc3,
+	0xc4, 0x2d, 0x12, 0x97, 0xd5, 0x7a, 0x77, 0xb2, 0x59, 0xc5, 0xde, 0x31, 0x33, 0xb3, 0xc6, 0x3e,
+	0x23, 0xee, 0x3d, 0x22, 0x71, 0xe1, 0xcc, 0x89, 0x43, 0xff, 0x01, 0x6e, 0x15, 0x07, 0x54, 0x71,
+	0x40, 0x9c, 0x28, 0x4a, 0x2e, 0xfc, 0x11, 0x1c, 0xd0, 0xce, 0xcc, 0xda, 0x56, 0x93, 0x2d, 0xad,
+	0xc4, 0xc9, 0xfb, 0xde, 0xbc, 0xcf, 0xfb, 0xf1, 0x79, 0xef, 0xcd, 0x18, 0xee, 0x8e, 0x19, 0x9d,
+	0x90, 0xd0, 0x09, 0x5d, 0xd2, 0x10, 0x2c, 0xf0, 0x7d, 0xc2, 0x1a, 0x93, 0xdd, 0xe4, 0xd3, 0x1a,
+	0x33, 0x2a, 0x28, 0xba, 0xbd, 0x30, 0xb2, 0x92, 0x93, 0xc9, 0xee, 0x46, 0xcd, 0xa5, 0x7c, 0x44,
+	0x79, 0x63, 0xe0, 0x70, 0xd2, 0x98, 0xec, 0x0e, 0x88, 0x70, 0x76, 0x1b, 0x2e, 0x0d, 0x42, 0x05,
+	0xdb, 0x58, 0x57, 0xe7, 0xb6, 0x94, 0x1a, 0x4a, 0xd0, 0x47, 0x6b, 0x3e, 0xf5, 0xa9, 0xd2, 0xc7,
+	0x5f, 0x09, 0xc0, 0xa7, 0xd4, 0x1f, 0x92, 0x86, 0x94, 0x06, 0xd1, 0x69, 0xc3, 0x09, 0x67, 0xfa,
+	0xa8, 0xf6, 0xe2, 0x91, 0x17, 0x31, 0x47, 0x04, 0x34, 0x89, 0x55, 0x7f, 0xf1, 0x5c, 0x04, 0x23,
+	0xc2, 0x85, 0x33, 0x1a, 0x2b, 0x83, 0xad, 0xbf, 0x33, 0x90, 0xef, 0xab, 0xdc, 0x51, 0x05, 0x32,
+	0x81, 0x67, 0x1a, 0x9b, 0xc6, 0x76, 0x16, 0x67, 0x02, 0x0f, 0x59, 0x90, 0xa3, 0x5f, 0x85, 0x84,
+	0x99, 0x99, 0x4d, 0x63, 0xbb, 0xd8, 0x32, 0x7f, 0x7d, 0xb2, 0xb3, 0xa6, 0xd3, 0x6d, 0x7a, 0x1e,
+	0x23, 0x9c, 0x9f, 0x08, 0x16, 0x84, 0x3e, 0x56, 0x66, 0xe8, 0x23, 0xc8, 0x91, 0x09, 0x09, 0x85,
+	0xb9, 0xba, 0x69, 0x6c, 0x97, 0xf6, 0xd6, 0x2c, 0x15, 0xdc, 0x4a, 0x82, 0x5b, 0xcd, 0x70, 0xd6,
+	0xfa, 0xdf, 0xcf, 0x4f, 0x76, 0xca, 0x3a, 0x62, 0x27, 0xb6, 0xee, 0x62, 0x85, 0x42, 0x16, 0xe4,
+	0x1d, 0x37, 0xce, 0x9d, 0x9b, 0xd9, 0xcd, 0xd5, 0x34, 0x07, 0x38, 0x31, 0x42, 0x4d, 0x00, 0x46,
+	0xdc, 0x88, 0x31, 0x12, 0xba, 0xc4, 0xcc, 0xc9, 0x98, 0x77, 0xac, 0x6b, 0x7b, 0x62, 0xe1, 0xb9,
+	0x21, 0x5e, 0x02, 0xa1, 0x0e, 0xdc, 0x64, 0x44, 0xb0, 0x99, 0x3d, 0xa6, 0xc3, 0xc0, 0x9d, 0x99,
+	0x37, 0xa4, 0x93, 0xad, 0x54, 0x27, 0x82, 0xcd, 0x8e, 0xa5, 0x25, 0x2e, 0xb1, 0x85, 0x80, 0xf6,
+	0x20, 0xef, 0x33, 0x27, 0x14, 0x84, 0x99, 0xf9, 0x7f, 0xa1, 0x2a, 0x31, 0xbc, 0x9f, 0xfd, 0xeb,
+	0xfb, 0xba, 0xb1, 0xf5, 0x3e, 0x94, 0x96, 0xbc, 0xa2, 0x3a, 0x94, 0x46, 0xce, 0xd4, 0x8e, 0x7d,
+	0x07, 0x84, 0xcb, 0x56, 0x94, 0x31, 0x8c, 0x9c, 0x29, 0x56, 0x1a, 0x8d, 0xfa, 0x31, 0x03, 0xb0,
+	0xa8, 0x08, 0xbd, 0x0d, 0xb7, 0x82, 0xd8, 0xe7, 0xc4, 0x19, 0xda, 0x83, 0x21, 0x75, 0xcf, 0xb9,
+	0x6e, 0x62, 0x25, 0x51, 0xb7, 0xa4, 0x16, 0x7d, 0x02, 0x85, 0x44, 0x23, 0x7b, 0x5a, 0xda, 0x5b,
+	0xbf, 0x42, 0x71, 0x5b, 0x0f, 0x50, 0xab, 0xf0, 0xf4, 0x8f, 0xfa, 0xca, 0xb7, 0xcf, 0xeb, 0x06,
+	0x9e, 0x83, 0xd0, 0x5b, 0x50, 0x89, 0xf3, 0x23, 0x53, 0xe2, 0x46, 0xaa, 0x53, 0xab, 0x32, 0x50,
+	0x79, 0xe4, 0x4c, 0x3b, 0x73, 0x25, 0xaa, 0x01, 0x2c, 0x99, 0x64, 0xa5, 0xc9, 0x92, 0x06, 0x9d,
+	0x43, 0xe9, 0x94, 0x10, 0x7b, 0x4c, 0x98, 0xcd, 0xa2, 0xd0, 0xcc, 0xc9, 0x6e, 0xaf, 0x5b, 0x9a,
+	0xb0, 0x78, 0x6f, 0x2c, 0xbd, 0x37, 0xd6, 0x03, 0x1a, 0x84, 0xad, 0xf7, 0xe2, 0x54, 0x7e, 0x78,
+	0x5e, 0xdf, 0xf6, 0x03, 0x71, 0x16, 0x0d, 0x2c, 0x97, 0x8e, 0xf4, 0xde, 0xe8, 0x9f, 0x1d, 0xee,
+	0x9d, 0x37, 0xc4, 0x6c, 0x4c, 0xb8, 0x04, 0x70, 0x5c, 0x3c, 0x25, 0xe4, 0x98, 0x30, 0x1c, 0x85,
+	0x9a, 0xb2, 0x5f, 0x0c, 0x28, 0x7f, 0x16, 0x91, 0x88, 0x78, 0xc9, 0xb4, 0xdf, 0x81, 0x9b, 0x92,
+	0x2c, 0xfb, 0x8c, 0x04, 0xfe, 0x99, 0xd0, 0x94, 0x95, 0xa4, 0xee, 0x40, 0xaa, 0xd0, 0x07, 0x90,
+	0x8d, 0xf7, 0x45, 0x73, 0xb5, 0x71, 0x85, 0xab, 0x7e, 0xb2, 0x4c, 0x8a, 0xac, 0xc7, 0x31, 0x59,
+	0x12, 0x81, 0x3e, 0x86, 0xbc, 0x1e, 0x1c, 0xbd, 0x0c, 0xb5, 0x94, 0x99, 0xd2, 0xd9, 0xb4, 0xb2,
+	0xb1, 0x03, 0x9c, 0x80, 0xd0, 0x06, 0x14, 0x1c, 0x21, 0xc8, 0x68, 0x2c, 0x14, 0x7f, 0x65, 0x3c,
+	0x97, 0x75, 0x41, 0xdf, 0x65, 0xe0, 0xd6, 0x9c, 0x72, 0x4c, 0x78, 0x34, 0x14, 0xe8, 0x4d, 0x00,
+	0xed, 0xc0, 0x9e, 0x2f, 0x72, 0x51, 0x6b, 0xba, 0xde, 0x95, 0x8a, 0x33, 0xe9, 0x15, 0xaf, 0xbe,
+	0x76, 0xc5, 0x26, 0xe4, 0x79, 0xe4, 0xba, 0x84, 0xab, 0x84, 0x0b, 0x38, 0x11, 0xd1, 0x1a, 0xe4,
+	0x08, 0x63, 0x94, 0xc9, 0x15, 0x2d, 0x62, 0x25, 0xa0, 0x75, 0x28, 0xf8, 0x0e, 0xb7, 0x23, 0x4e,
+	0x3c, 0xb9, 0x76, 0x59, 0x9c, 0xf7, 0x1d, 0xfe, 0x90, 0x13, 0x2f, 0x76, 0xa5, 0x8b, 0x95, 0xeb,
+	0x54, 0xc6, 0x89, 0x18, 0x57, 0xa0, 0xf6, 0xf5, 0x4b, 0xd9, 0x4a, 0xb3, 0x20, 0x23, 0xa9, 0x5d,
+	0x54, 0xdd, 0xd5, 0xec, 0x1c, 0x42, 0xb5, 0xb5, 0x28, 0x4b, 0xde, 0x33, 0xaf, 0xd0, 0xf0, 0xfb,
+	0xb7, 0x63, 0xf0, 0x95, 0x0b, 0x6a, 0xcb, 0x81, 0x8a, 0xf4, 0x16, 0x57, 0xaf, 0x7c, 0x25, 0x3c,
+	0x19, 0xaf, 0xcb, 0x53, 0x5a, 0x88, 0x6f, 0x0c, 0xa8, 0xf6, 0x99, 0x13, 0x72, 0x75, 0xbb, 0xa9,
+	0x28, 0x08, 0xb2, 0xa1, 0xa3, 0xa3, 0x14, 0xb1, 0xfc, 0x46, 0xfb, 0x00, 0x8e, 0x10, 0x2c, 0x18,
+	0x44, 0x82, 0x70, 0x33, 0x23, 0x57, 0x67, 0x33, 0x65, 0xb8, 0x9a, 0x89, 0xa1, 0x1e, 0xaf, 0x25,
+	0x64, 0x5a, 0x1e, 0x1f, 0x42, 0x71, 0x8e, 0xba, 0x36, 0xfe, 0x1a, 0xe4, 0x26, 0xce, 0x30, 0x52,
+	0x4b, 0x51, 0xc4, 0x4a, 0xd0, 0xac, 0xff, 0x66, 0x00, 0x3a, 0x22, 0xa2, 0xc9, 0x39, 0x11, 0x8f,
+	0x62, 0xfd, 0x9c, 0xf8, 0x91, 0xc3, 0xce, 0x09, 0xb3, 0x3d, 0x12, 0xd2, 0x91, 0x76, 0x57, 0x52,
+	0xba, 0x76, 0xac, 0x8a, 0x2f, 0xbe, 0x31, 0x0b, 0x5c, 0xa2, 0x2d, 0x94, 0x6f, 0x90, 0x2a, 0x65,
+	0xf0, 0x06, 0x14, 0xc5, 0x19, 0x23, 0xfc, 0x8c, 0x0e, 0x3d, 0x39, 0x9d, 0x45, 0xbc, 0x50, 0xa0,
+	0x4f, 0xa1, 0xe8, 0x05, 0x8c, 0x48, 0xea, 0xe4, 0xf8, 0x55, 0xf6, 0xde, 0x49, 0x5b, 0xb8, 0x04,
+	0xd4, 0x4e, 0x00, 0x78, 0x81, 0x4d, 0x63, 0x85, 0x42, 0x65, 0xce, 0x8a, 0xaa, 0x69, 0x2f, 0x7e,
+	0xac, 0x5c, 0x1a, 0x85, 0x6a, 0x8e, 0x5e, 0x7a, 0xe5, 0x6b, 0xc3, 0x39, 0x9d, 0x99, 0x05, 0x9d,
+	0x69, 0x01, 0x7f, 0x32, 0xe0, 0xff, 0x8b, 0x88, 0xd3, 0x71, 0x10, 0x7b, 0xfa, 0x4f, 0x23, 0xa3,
+	0x03, 0x28, 0x87, 0x54, 0xc4, 0x9c, 0x8f, 0x09, 0x0b, 0xa8, 0x67, 0xae, 0xbe, 0xfa, 0x8b, 0x70,
+	0x53, 0x21, 0x8f, 0x25, 0x30, 0xad, 0x86, 0x36, 0x54, 0x7b, 0xcc, 0x23, 0x6c, 0x3f, 0x18, 0x0e,
+	0x89, 0xa7, 0x92, 0x5f, 0x87, 0x02, 0x65, 0xde, 0xf2, 0xfd, 0x94, 0x97, 0x72, 0x37, 0xcd, 0xcb,
+	0xbb, 0x5f, 0x1b, 0x80, 0xae, 0xf6, 0x0c, 0xdd, 0x85, 0x7a, 0xff, 0x00, 0x77, 0x4e, 0x0e, 0x7a,
+	0x87, 0x6d, 0xbb, 0xdd, 0xc5, 0x9d, 0x07, 0xfd, 0x6e, 0xef, 0xc8, 0x7e, 0x78, 0x74, 0x72, 0xdc,
+	0x79, 0xd0, 0xdd, 0xef, 0x76, 0xda, 0xd5, 0x95, 0x34, 0xa3, 0x66, 0xdf, 0xee, 0x61, 0xbb, 0xd9,
+	0xea, 0x3d, 0xea, 0x54, 0x8d, 0x97, 0x1b, 0xb5, 0x3a, 0x87, 0xbd, 0xcf, 0xab, 0x99, 0x56, 0xf0,
+	0xf4, 0xa2, 0x66, 0x3c, 0xbb, 0xa8, 0x19, 0x7f, 0x5e, 0xd4, 0x8c, 0xc7, 0x97, 0xb5, 0x95, 0x67,
+	0x97, 0xb5, 0x95, 0xdf, 0x2f, 0x6b, 0x2b, 0x60, 0x06, 0xf4, 0xfa, 0x49, 0x3b, 0x36, 0xbe, 0xb8,
+	0xb7, 0xf4, 0x5e, 0x2d, 0x6c, 0x76, 0x02, 0xba, 0x24, 0x35, 0xa6, 0xf3, 0x3f, 0x98, 0xf2, 0x01,
+	0x1b, 0xdc, 0x90, 0xc4, 0xdf, 0xfb, 0x67, 0x00, 0xf8, 0xf1, 0x73, 0x5c, 0x83, 0x0a, 0x00, 0x00,
+}
+
+func (this *Trigger) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Trigger)
+	if !ok {
+		that2, ok := that.(Trigger)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.Event.Equal(that1.Event) {
+		return false
+	}
+	if len(this.Actions) != len(that1.Actions) {
+		return false
+	}
+	for i := range this.Actions {
+		if !this.Actions[i].Equal(that1.Actions[i]) {
+			return false
+		}
+	}
+	if !this.Recurrence.Equal(that1.Recurrence) {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	return true
+}
+func (this *RetryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RetryPolicy)
+	if !ok {
+		that2, ok := that.(RetryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxRetries != that1.MaxRetries {
+		return false
+	}
+	return true
+}
+func (this *Recurrence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Recurrence)
+	if !ok {
+		that2, ok := that.(Recurrence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IntervalBlocks != that1.IntervalBlocks {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.MaxExecutions != that1.MaxExecutions {
+		return false
+	}
+	if this.Executions != that1.Executions {
+		return false
+	}
+	if len(this.FeePerRun) != len(that1.FeePerRun) {
+		return false
+	}
+	for i := range this.FeePerRun {
+		if !this.FeePerRun[i].Equal(&that1.FeePerRun[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *QueuedTrigger) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueuedTrigger)
+	if !ok {
+		that2, ok := that.(QueuedTrigger)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
@@ -484,6 +1152,54 @@ func (this *QueuedTrigger) Equal(that interface{}) bool {
 	if !this.Trigger.Equal(&that1.Trigger) {
 		return false
 	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	return true
+}
+func (this *ExecutionResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecutionResult)
+	if !ok {
+		that2, ok := that.(ExecutionResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TriggerId != that1.TriggerId {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.RetryQueued != that1.RetryQueued {
+		return false
+	}
 	return true
 }
 func (this *BlockHeightEvent) Equal(that interface{}) bool {
@@ -593,7 +1309,121 @@ func (this *Attribute) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *Trigger) Marshal() (dAtA []byte, err error) {
+func (this *NetAssetValueEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NetAssetValueEvent)
+	if !ok {
+		that2, ok := that.(NetAssetValueEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarkerDenom != that1.MarkerDenom {
+		return false
+	}
+	if this.PriceDenom != that1.PriceDenom {
+		return false
+	}
+	if this.Threshold != that1.Threshold {
+		return false
+	}
+	if this.Direction != that1.Direction {
+		return false
+	}
+	return true
+}
+func (this *AttributeEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AttributeEvent)
+	if !ok {
+		that2, ok := that.(AttributeEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Account != that1.Account {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *AttributeExpiringEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AttributeExpiringEvent)
+	if !ok {
+		that2, ok := that.(AttributeExpiringEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Account != that1.Account {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.NoticePeriod != that1.NoticePeriod {
+		return false
+	}
+	return true
+}
+func (this *OrderFilledEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OrderFilledEvent)
+	if !ok {
+		that2, ok := that.(OrderFilledEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OrderId != that1.OrderId {
+		return false
+	}
+	return true
+}
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -613,6 +1443,37 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTrigger(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Recurrence != nil {
+		{
+			size, err := m.Recurrence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTrigger(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Actions) > 0 {
 		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -654,6 +1515,94 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recurrence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recurrence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recurrence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePerRun) > 0 {
+		for iNdEx := len(m.FeePerRun) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePerRun[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrigger(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Executions != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxExecutions != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.MaxExecutions))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTrigger(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueuedTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -674,6 +1623,11 @@ func (m *QueuedTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -684,12 +1638,12 @@ func (m *QueuedTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintTrigger(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintTrigger(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.BlockHeight != 0 {
@@ -700,6 +1654,84 @@ func (m *QueuedTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryQueued {
+		i--
+		if m.RetryQueued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTrigger(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TriggerId != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.TriggerId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockHeightEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -748,12 +1780,12 @@ func (m *BlockTimeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err4 != nil {
-		return 0, err4
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n4
-	i = encodeVarintTrigger(dAtA, i, uint64(n4))
+	i -= n8
+	i = encodeVarintTrigger(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -840,24 +1872,183 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTrigger(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTrigger(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NetAssetValueEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Trigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
+
+func (m *NetAssetValueEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetAssetValueEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.PriceDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeExpiringEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeExpiringEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeExpiringEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTrigger(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderFilledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderFilledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderFilledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrigger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrigger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
 		n += 1 + sovTrigger(uint64(m.Id))
 	}
 	l = len(m.Owner)
@@ -874,6 +2065,56 @@ func (m *Trigger) Size() (n int) {
 			n += 1 + l + sovTrigger(uint64(l))
 		}
 	}
+	if m.Recurrence != nil {
+		l = m.Recurrence.Size()
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		n += 1 + sovTrigger(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func (m *Recurrence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTrigger(uint64(m.IntervalBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovTrigger(uint64(l))
+	if m.MaxExecutions != 0 {
+		n += 1 + sovTrigger(uint64(m.MaxExecutions))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovTrigger(uint64(m.Executions))
+	}
+	if len(m.FeePerRun) > 0 {
+		for _, e := range m.FeePerRun {
+			l = e.Size()
+			n += 1 + l + sovTrigger(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -890,6 +2131,42 @@ func (m *QueuedTrigger) Size() (n int) {
 	n += 1 + l + sovTrigger(uint64(l))
 	l = m.Trigger.Size()
 	n += 1 + l + sovTrigger(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovTrigger(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TriggerId != 0 {
+		n += 1 + sovTrigger(uint64(m.TriggerId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTrigger(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTrigger(uint64(l))
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTrigger(uint64(m.GasUsed))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTrigger(uint64(m.Attempt))
+	}
+	if m.RetryQueued {
+		n += 2
+	}
 	return n
 }
 
@@ -952,6 +2229,78 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
+func (m *NetAssetValueEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovTrigger(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *AttributeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeExpiringEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod)
+	n += 1 + l + sovTrigger(uint64(l))
+	return n
+}
+
+func (m *OrderFilledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTrigger(uint64(m.OrderId))
+	}
+	return n
+}
+
 func sovTrigger(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1074,11 +2423,1127 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &types.Any{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recurrence == nil {
+				m.Recurrence = &Recurrence{}
+			}
+			if err := m.Recurrence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recurrence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recurrence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recurrence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutions", wireType)
+			}
+			m.MaxExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePerRun = append(m.FeePerRun, types1.Coin{})
+			if err := m.FeePerRun[len(m.FeePerRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerId", wireType)
+			}
+			m.TriggerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryQueued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryQueued = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeightEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeightEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTimeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTimeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1088,25 +3553,23 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, &types.Any{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1129,7 +3592,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
+func (m *NetAssetValueEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,17 +3615,17 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetAssetValueEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetAssetValueEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1172,16 +3635,29 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1191,30 +3667,29 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1224,25 +3699,43 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= ThresholdDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrigger(dAtA[iNdEx:])
@@ -1264,7 +3757,7 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
+func (m *AttributeEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,17 +3780,17 @@ func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1307,66 +3800,29 @@ func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTrigger(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTrigger
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTimeEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTimeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1376,24 +3832,23 @@ func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1416,7 +3871,7 @@ func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
+func (m *AttributeExpiringEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1439,13 +3894,45 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeExpiringEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeExpiringEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -1477,9 +3964,9 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NoticePeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,8 +3993,7 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.NoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1532,7 +4018,7 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *OrderFilledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,49 +4041,17 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderFilledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderFilledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrigger
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1607,24 +4061,11 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrigger(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 	time "time"
 
@@ -8,11 +9,15 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 func TestNewTrigger(t *testing.T) {
@@ -328,6 +333,193 @@ func TestBlockTimeEventValidate(t *testing.T) {
 	assert.Nil(t, event.Validate(), "should always have successful validate")
 }
 
+func TestNetAssetValueEventValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		event NetAssetValueEvent
+		err   string
+	}{
+		{
+			name:  "valid - at or above",
+			event: NetAssetValueEvent{MarkerDenom: "hotdog", PriceDenom: "usd", Threshold: "1.5", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE},
+		},
+		{
+			name:  "valid - at or below zero",
+			event: NetAssetValueEvent{MarkerDenom: "hotdog", PriceDenom: "usd", Threshold: "0", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW},
+		},
+		{
+			name:  "invalid - marker denom",
+			event: NetAssetValueEvent{MarkerDenom: "x", PriceDenom: "usd", Threshold: "1.5", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE},
+			err:   "invalid marker denom: invalid denom: x",
+		},
+		{
+			name:  "invalid - price denom",
+			event: NetAssetValueEvent{MarkerDenom: "hotdog", Threshold: "1.5", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE},
+			err:   "invalid price denom: invalid denom: ",
+		},
+		{
+			name:  "invalid - negative threshold",
+			event: NetAssetValueEvent{MarkerDenom: "hotdog", PriceDenom: "usd", Threshold: "-1", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE},
+			err:   `invalid threshold "-1": cannot be negative`,
+		},
+		{
+			name:  "invalid - unspecified direction",
+			event: NetAssetValueEvent{MarkerDenom: "hotdog", PriceDenom: "usd", Threshold: "1.5"},
+			err:   "invalid threshold direction: THRESHOLD_DIRECTION_UNSPECIFIED",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "should have correct error for Validate")
+			} else {
+				assert.NoError(t, res, "should have no error for successful Validate")
+			}
+		})
+	}
+}
+
+func TestNetAssetValueEventMatches(t *testing.T) {
+	above := NetAssetValueEvent{MarkerDenom: "hotdog", PriceDenom: "usd", Threshold: "2.5", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_ABOVE}
+	below := NetAssetValueEvent{MarkerDenom: "hotdog", PriceDenom: "usd", Threshold: "2.5", Direction: ThresholdDirection_THRESHOLD_DIRECTION_AT_OR_BELOW}
+
+	tests := []struct {
+		name        string
+		event       NetAssetValueEvent
+		nav         markertypes.NetAssetValue
+		shouldMatch bool
+	}{
+		{name: "above - equal", event: above, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 250), 100), shouldMatch: true},
+		{name: "above - greater", event: above, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 3), 1), shouldMatch: true},
+		{name: "above - less", event: above, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 249), 100), shouldMatch: false},
+		{name: "below - equal", event: below, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 5), 2), shouldMatch: true},
+		{name: "below - greater", event: below, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 3), 1), shouldMatch: false},
+		{name: "wrong price denom", event: above, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("nhash", 300), 1), shouldMatch: false},
+		{name: "zero volume", event: above, nav: markertypes.NewNetAssetValue(sdk.NewInt64Coin("usd", 300), 0), shouldMatch: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.shouldMatch, tc.event.Matches(tc.nav), "should have correct result for Matches")
+		})
+	}
+}
+
+func TestAttributeEventValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		event AttributeEvent
+		err   string
+	}{
+		{
+			name:  "valid - account and name",
+			event: AttributeEvent{Account: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Name: "kyc.provenance.io"},
+		},
+		{
+			name:  "invalid - account",
+			event: AttributeEvent{Account: "bad", Name: "kyc.provenance.io"},
+			err:   "invalid account: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:  "invalid - empty name",
+			event: AttributeEvent{Account: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Name: " "},
+			err:   "empty attribute name",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "should have correct error for Validate")
+			} else {
+				assert.NoError(t, res, "should have no error for successful Validate")
+			}
+		})
+	}
+}
+
+func TestAttributeExpiringEventValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		event AttributeExpiringEvent
+		err   string
+	}{
+		{
+			name:  "valid - account, name, and notice period",
+			event: AttributeExpiringEvent{Account: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Name: "kyc.provenance.io", NoticePeriod: time.Hour},
+		},
+		{
+			name:  "invalid - account",
+			event: AttributeExpiringEvent{Account: "bad", Name: "kyc.provenance.io", NoticePeriod: time.Hour},
+			err:   "invalid account: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:  "invalid - empty name",
+			event: AttributeExpiringEvent{Account: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Name: " ", NoticePeriod: time.Hour},
+			err:   "empty attribute name",
+		},
+		{
+			name:  "invalid - zero notice period",
+			event: AttributeExpiringEvent{Account: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Name: "kyc.provenance.io"},
+			err:   "invalid notice period 0s: must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			res := tc.event.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, res, tc.err, "should have correct error for Validate")
+			} else {
+				assert.NoError(t, res, "should have no error for successful Validate")
+			}
+		})
+	}
+}
+
+func TestAttributeExpiringEventMatches(t *testing.T) {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		rv := blockTime.Add(d)
+		return &rv
+	}
+	event := AttributeExpiringEvent{Account: "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", Name: "kyc.provenance.io", NoticePeriod: 24 * time.Hour}
+	assert.Equal(t, AttributeExpiringPrefix, event.GetEventPrefix(), "should have correct prefix for GetEventPrefix")
+
+	tests := []struct {
+		name        string
+		expiration  *time.Time
+		shouldMatch bool
+	}{
+		{name: "no expiration", expiration: nil, shouldMatch: false},
+		{name: "already expired", expiration: at(-time.Second), shouldMatch: false},
+		{name: "expires at block time", expiration: at(0), shouldMatch: false},
+		{name: "within notice period", expiration: at(time.Hour), shouldMatch: true},
+		{name: "at end of notice period", expiration: at(24 * time.Hour), shouldMatch: true},
+		{name: "after notice period", expiration: at(24*time.Hour + time.Second), shouldMatch: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.shouldMatch, event.Matches(tc.expiration, blockTime), "should have correct result for Matches")
+		})
+	}
+}
+
+func TestOrderFilledEventGetEventOrder(t *testing.T) {
+	event := OrderFilledEvent{OrderId: 12}
+	assert.Equal(t, OrderFilledPrefix, event.GetEventPrefix(), "should have correct prefix for GetEventPrefix")
+	assert.Equal(t, int(12), int(event.GetEventOrder()), "should have correct event order")
+}
+
+func TestOrderFilledEventValidate(t *testing.T) {
+	assert.NoError(t, OrderFilledEvent{OrderId: 1}.Validate(), "should have no error for a non-zero order id")
+	assert.EqualError(t, OrderFilledEvent{}.Validate(), "invalid order id: cannot be zero", "should have correct error for a zero order id")
+}
+
 func TestTriggerUnpackInterfaces(t *testing.T) {
 	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
@@ -437,3 +629,193 @@ func TestTriggerGetTriggerEventI(t *testing.T) {
 		})
 	}
 }
+
+func TestRecurrenceValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		recurrence Recurrence
+		err        string
+	}{
+		{
+			name:       "valid - interval in blocks",
+			recurrence: Recurrence{IntervalBlocks: 10, MaxExecutions: 3},
+		},
+		{
+			name:       "valid - interval with fee",
+			recurrence: Recurrence{Interval: time.Hour, MaxExecutions: 3, Executions: 3, FeePerRun: sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))},
+		},
+		{
+			name:       "invalid - no interval",
+			recurrence: Recurrence{MaxExecutions: 3},
+			err:        "recurrence must have an interval",
+		},
+		{
+			name:       "invalid - both intervals",
+			recurrence: Recurrence{IntervalBlocks: 10, Interval: time.Hour, MaxExecutions: 3},
+			err:        "recurrence cannot have both an interval and an interval in blocks",
+		},
+		{
+			name:       "invalid - no max executions",
+			recurrence: Recurrence{IntervalBlocks: 10},
+			err:        "recurrence max executions cannot be zero",
+		},
+		{
+			name:       "invalid - too many executions",
+			recurrence: Recurrence{IntervalBlocks: 10, MaxExecutions: 3, Executions: 4},
+			err:        "recurrence executions 4 cannot exceed max executions 3",
+		},
+		{
+			name:       "invalid - bad fee",
+			recurrence: Recurrence{IntervalBlocks: 10, MaxExecutions: 3, FeePerRun: sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(-1)}}},
+			err:        "invalid recurrence fee per run: coin -1nhash amount is not positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recurrence.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should have error in Validate")
+			} else {
+				assert.NoError(t, err, "should have no error in successful Validate")
+			}
+		})
+	}
+}
+
+func TestRecurrenceValidateEvent(t *testing.T) {
+	tests := []struct {
+		name       string
+		recurrence Recurrence
+		event      TriggerEventI
+		err        string
+	}{
+		{
+			name:       "valid - block height event",
+			recurrence: Recurrence{IntervalBlocks: 10},
+			event:      &BlockHeightEvent{},
+		},
+		{
+			name:       "valid - block time event",
+			recurrence: Recurrence{Interval: time.Hour},
+			event:      &BlockTimeEvent{},
+		},
+		{
+			name:       "invalid - block height event without interval in blocks",
+			recurrence: Recurrence{Interval: time.Hour},
+			event:      &BlockHeightEvent{},
+			err:        "recurrence of a block height event must have an interval in blocks",
+		},
+		{
+			name:       "invalid - block time event without interval",
+			recurrence: Recurrence{IntervalBlocks: 10},
+			event:      &BlockTimeEvent{},
+			err:        "recurrence of a block time event must have an interval",
+		},
+		{
+			name:       "invalid - unsupported event",
+			recurrence: Recurrence{IntervalBlocks: 10},
+			event:      &OrderFilledEvent{OrderId: 1},
+			err:        "recurrence is not supported for *types.OrderFilledEvent",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recurrence.ValidateEvent(tc.event)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should have error in ValidateEvent")
+			} else {
+				assert.NoError(t, err, "should have no error in successful ValidateEvent")
+			}
+		})
+	}
+}
+
+func TestRecurrenceRemainingFees(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))
+	assert.Equal(t, "15nhash", Recurrence{MaxExecutions: 4, Executions: 1, FeePerRun: fee}.RemainingFees().String(), "should have fees for remaining executions")
+	assert.Empty(t, Recurrence{MaxExecutions: 4, Executions: 4, FeePerRun: fee}.RemainingFees(), "should have no fees when done")
+	assert.Empty(t, Recurrence{MaxExecutions: 4}.RemainingFees(), "should have no fees without a fee per run")
+}
+
+func TestRecurrenceNextEvent(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 50, Time: now}, false, nil)
+	assert.Equal(t, &BlockHeightEvent{BlockHeight: 60}, Recurrence{IntervalBlocks: 10}.NextEvent(ctx), "should have next block height event")
+	assert.Equal(t, &BlockTimeEvent{Time: now.Add(time.Hour)}, Recurrence{Interval: time.Hour}.NextEvent(ctx), "should have next block time event")
+}
+
+func TestRetryPolicyValidate(t *testing.T) {
+	assert.NoError(t, RetryPolicy{}.Validate(), "should have no error for no retries")
+	assert.NoError(t, RetryPolicy{MaxRetries: MaxRetries}.Validate(), "should have no error for the maximum retries")
+	assert.EqualError(t, RetryPolicy{MaxRetries: MaxRetries + 1}.Validate(), "retry policy max retries 6 cannot exceed 5", "should have error for too many retries")
+}
+
+func TestRetryPolicyCanRetry(t *testing.T) {
+	var nilPolicy *RetryPolicy
+	assert.False(t, nilPolicy.CanRetry(1), "nil policy should not retry")
+	policy := &RetryPolicy{MaxRetries: 2}
+	assert.True(t, policy.CanRetry(1), "should retry after first attempt")
+	assert.True(t, policy.CanRetry(2), "should retry after second attempt")
+	assert.False(t, policy.CanRetry(3), "should not retry after last retry")
+}
+
+func TestNewExecutionResult(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.NewContext(nil, cmtproto.Header{Height: 50, Time: now}, false, nil)
+
+	result := NewExecutionResult(ctx, 3, 1, 500, nil)
+	assert.Equal(t, ExecutionResult{TriggerId: 3, BlockHeight: 50, Time: now, Success: true, GasUsed: 500, Attempt: 1}, result, "should have successful result")
+
+	result = NewExecutionResult(ctx, 3, 2, 700, fmt.Errorf("action failed"))
+	assert.Equal(t, ExecutionResult{TriggerId: 3, BlockHeight: 50, Time: now, Error: "action failed", GasUsed: 700, Attempt: 2}, result, "should have failed result")
+}
+
+func TestExecutionResultValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		result ExecutionResult
+		err    string
+	}{
+		{
+			name:   "valid - success",
+			result: ExecutionResult{TriggerId: 1, Attempt: 1, Success: true},
+		},
+		{
+			name:   "valid - failure with retry",
+			result: ExecutionResult{TriggerId: 1, Attempt: 1, Error: "failed", RetryQueued: true},
+		},
+		{
+			name:   "invalid - no trigger id",
+			result: ExecutionResult{Attempt: 1, Success: true},
+			err:    "execution result trigger id cannot be zero",
+		},
+		{
+			name:   "invalid - no attempt",
+			result: ExecutionResult{TriggerId: 1, Success: true},
+			err:    "execution result for trigger 1 attempt cannot be zero",
+		},
+		{
+			name:   "invalid - success with error",
+			result: ExecutionResult{TriggerId: 1, Attempt: 1, Success: true, Error: "failed"},
+			err:    "successful execution result for trigger 1 cannot have an error",
+		},
+		{
+			name:   "invalid - success with retry",
+			result: ExecutionResult{TriggerId: 1, Attempt: 1, Success: true, RetryQueued: true},
+			err:    "successful execution result for trigger 1 cannot have a retry queued",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.result.Validate()
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should have error in Validate")
+			} else {
+				assert.NoError(t, err, "should have no error in successful Validate")
+			}
+		})
+	}
+}
@@ -39,6 +39,17 @@ type MsgCreateTriggerRequest struct {
 	Event *types.Any `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 	// The messages to run when the trigger fires.
 	Actions []*types.Any `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+	// How the trigger repeats after it fires. Leave empty for a trigger that only fires once.
+	Recurrence *Recurrence `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// How the trigger's actions are retried if they fail. Leave empty for a trigger that is not retried.
+	RetryPolicy *RetryPolicy `protobuf:"bytes,5,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// The account to run the actions as, using authz grants it has given to the first authority.
+	// When provided, the actions must be signed by the granter instead of the authorities.
+	// The grants are checked each time the trigger fires, not when it is created.
+	Granter string `protobuf:"bytes,6,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Whether the trigger's executions are paid for from the first authority's gas budget.
+	// When false, the gas left in the request's transaction is set aside for the trigger's executions.
+	UseGasBudget bool `protobuf:"varint,7,opt,name=use_gas_budget,json=useGasBudget,proto3" json:"use_gas_budget,omitempty"`
 }
 
 func (m *MsgCreateTriggerRequest) Reset()         { *m = MsgCreateTriggerRequest{} }
@@ -95,6 +106,34 @@ func (m *MsgCreateTriggerRequest) GetActions() []*types.Any {
 	return nil
 }
 
+func (m *MsgCreateTriggerRequest) GetRecurrence() *Recurrence {
+	if m != nil {
+		return m.Recurrence
+	}
+	return nil
+}
+
+func (m *MsgCreateTriggerRequest) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *MsgCreateTriggerRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgCreateTriggerRequest) GetUseGasBudget() bool {
+	if m != nil {
+		return m.UseGasBudget
+	}
+	return false
+}
+
 // MsgCreateTriggerResponse is the response type for creating a trigger RPC
 type MsgCreateTriggerResponse struct {
 	// trigger id that is generated on creation.
@@ -233,46 +272,170 @@ func (m *MsgDestroyTriggerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDestroyTriggerResponse proto.InternalMessageInfo
 
+// MsgTopUpGasBudgetRequest is the request type for adding gas to an owner's trigger gas budget RPC
+type MsgTopUpGasBudgetRequest struct {
+	// The address whose gas budget is topped up.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The amount of gas to add. It is consumed from the request's transaction.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// The signing account paying for the gas.
+	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgTopUpGasBudgetRequest) Reset()         { *m = MsgTopUpGasBudgetRequest{} }
+func (m *MsgTopUpGasBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpGasBudgetRequest) ProtoMessage()    {}
+func (*MsgTopUpGasBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f001c93b8aeec1f, []int{4}
+}
+func (m *MsgTopUpGasBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpGasBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpGasBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpGasBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpGasBudgetRequest.Merge(m, src)
+}
+func (m *MsgTopUpGasBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpGasBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpGasBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpGasBudgetRequest proto.InternalMessageInfo
+
+func (m *MsgTopUpGasBudgetRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgTopUpGasBudgetRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *MsgTopUpGasBudgetRequest) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+// MsgTopUpGasBudgetResponse is the response type for adding gas to an owner's trigger gas budget RPC
+type MsgTopUpGasBudgetResponse struct {
+	// The amount of gas in the owner's budget after the top up.
+	Remaining uint64 `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *MsgTopUpGasBudgetResponse) Reset()         { *m = MsgTopUpGasBudgetResponse{} }
+func (m *MsgTopUpGasBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpGasBudgetResponse) ProtoMessage()    {}
+func (*MsgTopUpGasBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f001c93b8aeec1f, []int{5}
+}
+func (m *MsgTopUpGasBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpGasBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpGasBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpGasBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpGasBudgetResponse.Merge(m, src)
+}
+func (m *MsgTopUpGasBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpGasBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpGasBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpGasBudgetResponse proto.InternalMessageInfo
+
+func (m *MsgTopUpGasBudgetResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateTriggerRequest)(nil), "provenance.trigger.v1.MsgCreateTriggerRequest")
 	proto.RegisterType((*MsgCreateTriggerResponse)(nil), "provenance.trigger.v1.MsgCreateTriggerResponse")
 	proto.RegisterType((*MsgDestroyTriggerRequest)(nil), "provenance.trigger.v1.MsgDestroyTriggerRequest")
 	proto.RegisterType((*MsgDestroyTriggerResponse)(nil), "provenance.trigger.v1.MsgDestroyTriggerResponse")
+	proto.RegisterType((*MsgTopUpGasBudgetRequest)(nil), "provenance.trigger.v1.MsgTopUpGasBudgetRequest")
+	proto.RegisterType((*MsgTopUpGasBudgetResponse)(nil), "provenance.trigger.v1.MsgTopUpGasBudgetResponse")
 }
 
 func init() { proto.RegisterFile("provenance/trigger/v1/tx.proto", fileDescriptor_4f001c93b8aeec1f) }
 
 var fileDescriptor_4f001c93b8aeec1f = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0x86, 0xbd, 0x36, 0x01, 0x65, 0xa3, 0x44, 0x62, 0x65, 0x94, 0xcb, 0x21, 0x1d, 0x96, 0x2b,
-	0xcb, 0x92, 0x77, 0x49, 0x22, 0x51, 0x58, 0xa2, 0x88, 0x81, 0x82, 0x22, 0x12, 0x3a, 0xa8, 0x68,
-	0xd0, 0xd9, 0x5e, 0x36, 0x2b, 0xe1, 0x9b, 0x63, 0x67, 0x7d, 0xca, 0x75, 0x88, 0x27, 0xe0, 0x11,
-	0x78, 0x84, 0x14, 0x79, 0x08, 0x44, 0x15, 0x51, 0x21, 0x2a, 0x64, 0x17, 0xa1, 0xe7, 0x05, 0x90,
-	0x6f, 0xef, 0xb0, 0x93, 0x5c, 0xa2, 0x74, 0x37, 0x3b, 0xdf, 0xcc, 0xff, 0xcf, 0xdc, 0x2e, 0x0d,
-	0x12, 0x03, 0xa9, 0x8c, 0xa3, 0x78, 0x24, 0x85, 0x35, 0x5a, 0x29, 0x69, 0x44, 0xba, 0x2b, 0xec,
-	0x31, 0x4f, 0x0c, 0x58, 0x60, 0x0f, 0x96, 0x79, 0x5e, 0xe4, 0x79, 0xba, 0xeb, 0x6f, 0x8f, 0x00,
-	0x27, 0x80, 0x62, 0x82, 0x6a, 0x81, 0x4f, 0x50, 0x39, 0xde, 0xdf, 0x71, 0x89, 0x77, 0x79, 0x24,
-	0x5c, 0x50, 0xa4, 0x9a, 0x0a, 0x14, 0xb8, 0xf3, 0xc5, 0x57, 0x59, 0xa0, 0x00, 0xd4, 0x07, 0x29,
-	0xf2, 0x68, 0x38, 0x7d, 0x2f, 0xa2, 0x38, 0x73, 0xa9, 0xf6, 0x2f, 0x42, 0xb7, 0x0f, 0x51, 0x3d,
-	0x33, 0x32, 0xb2, 0xf2, 0x8d, 0x13, 0x0f, 0xe5, 0xc7, 0xa9, 0x44, 0xcb, 0xfa, 0x74, 0x23, 0x9a,
-	0xda, 0x23, 0x30, 0xda, 0x6a, 0x89, 0x1e, 0x69, 0x35, 0x3a, 0xeb, 0x03, 0xef, 0xc7, 0x69, 0xaf,
-	0x59, 0x68, 0x1e, 0x8c, 0xc7, 0x46, 0x22, 0xbe, 0xb6, 0x46, 0xc7, 0x2a, 0x5c, 0x85, 0xd9, 0x53,
-	0xba, 0x26, 0x53, 0x19, 0x5b, 0xaf, 0xde, 0x22, 0x9d, 0x8d, 0xbd, 0x26, 0x77, 0x16, 0x78, 0x69,
-	0x81, 0x1f, 0xc4, 0xd9, 0xe0, 0xfe, 0xf7, 0xd3, 0xde, 0x66, 0x21, 0xfa, 0x62, 0x41, 0xbf, 0x0c,
-	0x5d, 0x15, 0xe3, 0xf4, 0x5e, 0x34, 0xb2, 0x1a, 0x62, 0xf4, 0x1a, 0xad, 0xc6, 0x75, 0x0d, 0xc2,
-	0x12, 0xea, 0x37, 0xff, 0x7c, 0x7d, 0x44, 0x3e, 0x9f, 0x9f, 0x74, 0x57, 0x4d, 0xb4, 0xbb, 0xd4,
-	0xbb, 0x3a, 0x1b, 0x26, 0x10, 0xa3, 0x64, 0x5b, 0xb4, 0xae, 0xc7, 0x1e, 0x69, 0x91, 0xce, 0x9d,
-	0xb0, 0xae, 0xc7, 0xed, 0x34, 0x67, 0x9f, 0x4b, 0xb4, 0x06, 0xb2, 0x4b, 0x8b, 0xb8, 0xc4, 0xb2,
-	0x27, 0x74, 0xbd, 0x94, 0xc9, 0xf2, 0x01, 0x6f, 0x5a, 0xcb, 0x12, 0xed, 0xb3, 0xd2, 0xe5, 0xf2,
-	0xac, 0xfd, 0x90, 0xee, 0x54, 0xe8, 0x3a, 0x93, 0x7b, 0x7f, 0x09, 0x6d, 0x1c, 0xa2, 0x62, 0x09,
-	0xdd, 0xbc, 0x30, 0x05, 0xe3, 0xbc, 0xf2, 0xce, 0xf0, 0x6b, 0x7e, 0xa5, 0x2f, 0x6e, 0xcd, 0x17,
-	0xeb, 0x41, 0xba, 0x75, 0xd1, 0x13, 0xbb, 0xa1, 0x45, 0xe5, 0xd6, 0xfc, 0xc7, 0xb7, 0x2f, 0x70,
-	0xa2, 0xfe, 0xda, 0xa7, 0xf3, 0x93, 0x2e, 0x19, 0xe8, 0x6f, 0xb3, 0x80, 0x9c, 0xcd, 0x02, 0xf2,
-	0x7b, 0x16, 0x90, 0x2f, 0xf3, 0xa0, 0x76, 0x36, 0x0f, 0x6a, 0x3f, 0xe7, 0x41, 0x8d, 0x7a, 0x1a,
-	0xaa, 0x9b, 0xbe, 0x22, 0x6f, 0xf7, 0x95, 0xb6, 0x47, 0xd3, 0x21, 0x1f, 0xc1, 0x44, 0x2c, 0x99,
-	0x9e, 0x86, 0x95, 0x48, 0x1c, 0xff, 0x7f, 0x80, 0x36, 0x4b, 0x24, 0x0e, 0xef, 0xe6, 0xd7, 0x69,
-	0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xf4, 0x1d, 0xca, 0xa3, 0x03, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbd, 0x6e, 0x13, 0x41,
+	0x10, 0xc7, 0xb3, 0x76, 0x9c, 0x90, 0xcd, 0x87, 0x60, 0x65, 0x94, 0x8d, 0x41, 0x87, 0x39, 0x28,
+	0xac, 0x48, 0xb9, 0xcb, 0x87, 0x84, 0x44, 0x24, 0x8a, 0x18, 0x22, 0x44, 0x11, 0x29, 0x3a, 0x42,
+	0x43, 0x63, 0x9d, 0xcf, 0xc3, 0x66, 0xa5, 0x78, 0xf7, 0xd8, 0xdd, 0x33, 0xb9, 0x0e, 0xf1, 0x04,
+	0x3c, 0x02, 0x25, 0x65, 0x8a, 0xd4, 0xd4, 0x88, 0x2a, 0xa2, 0xa2, 0x44, 0x49, 0x91, 0x3c, 0x06,
+	0xf2, 0x7d, 0xf8, 0x9c, 0x60, 0x27, 0xe9, 0x6e, 0x66, 0x7e, 0x33, 0xf3, 0x9f, 0x99, 0xbb, 0xc3,
+	0x56, 0xa8, 0x64, 0x0f, 0x84, 0x2f, 0x02, 0x70, 0x8d, 0xe2, 0x8c, 0x81, 0x72, 0x7b, 0x6b, 0xae,
+	0x39, 0x74, 0x42, 0x25, 0x8d, 0x24, 0xf7, 0x8b, 0xb8, 0x93, 0xc5, 0x9d, 0xde, 0x5a, 0x6d, 0x31,
+	0x90, 0xba, 0x2b, 0xb5, 0xdb, 0xd5, 0xac, 0x8f, 0x77, 0x35, 0x4b, 0xf9, 0xda, 0x52, 0x1a, 0x68,
+	0x25, 0x96, 0x9b, 0x1a, 0x59, 0xa8, 0xca, 0x24, 0x93, 0xa9, 0xbf, 0xff, 0x94, 0x27, 0x30, 0x29,
+	0xd9, 0x01, 0xb8, 0x89, 0xd5, 0x8e, 0x3e, 0xb8, 0xbe, 0x88, 0xb3, 0xd0, 0x93, 0x31, 0xda, 0x32,
+	0x19, 0x09, 0x64, 0xff, 0x28, 0xe3, 0xc5, 0x1d, 0xcd, 0x5e, 0x2a, 0xf0, 0x0d, 0xec, 0xa5, 0x21,
+	0x0f, 0x3e, 0x46, 0xa0, 0x0d, 0xd9, 0xc4, 0xb3, 0x7e, 0x64, 0xf6, 0xa5, 0xe2, 0x86, 0x83, 0xa6,
+	0xa8, 0x5e, 0x6e, 0xcc, 0x34, 0xe9, 0xef, 0xe3, 0x95, 0x6a, 0x26, 0x6c, 0xab, 0xd3, 0x51, 0xa0,
+	0xf5, 0x5b, 0xa3, 0xb8, 0x60, 0xde, 0x30, 0x4c, 0x5e, 0xe0, 0x0a, 0xf4, 0x40, 0x18, 0x5a, 0xaa,
+	0xa3, 0xc6, 0xec, 0x7a, 0xd5, 0x49, 0x75, 0x3a, 0xb9, 0x4e, 0x67, 0x4b, 0xc4, 0xcd, 0x7b, 0xbf,
+	0x8e, 0x57, 0xe6, 0xb3, 0xa6, 0xdb, 0x7d, 0xfa, 0x8d, 0x97, 0x66, 0x11, 0x07, 0x4f, 0xfb, 0x81,
+	0xe1, 0x52, 0x68, 0x5a, 0xae, 0x97, 0xc7, 0x15, 0xf0, 0x72, 0x88, 0x6c, 0x61, 0xac, 0x20, 0x88,
+	0x94, 0x02, 0x11, 0x00, 0x9d, 0x4c, 0x7a, 0x3e, 0x76, 0x46, 0x2e, 0xdf, 0xf1, 0x06, 0xa0, 0x37,
+	0x94, 0x44, 0xb6, 0xf1, 0x9c, 0x02, 0xa3, 0xe2, 0x56, 0x28, 0x0f, 0x78, 0x10, 0xd3, 0x4a, 0x52,
+	0xc4, 0x1e, 0x5b, 0xc4, 0xa8, 0x78, 0x37, 0x21, 0xbd, 0x59, 0x55, 0x18, 0x64, 0x1d, 0x4f, 0x33,
+	0xe5, 0x0b, 0x03, 0x8a, 0x4e, 0xd5, 0xd1, 0xb5, 0x0b, 0xcb, 0x41, 0xf2, 0x14, 0x2f, 0x44, 0x1a,
+	0x5a, 0xcc, 0xd7, 0xad, 0x76, 0xd4, 0x61, 0x60, 0xe8, 0x74, 0x1d, 0x35, 0xee, 0x78, 0x73, 0x91,
+	0x86, 0xd7, 0xbe, 0x6e, 0x26, 0xbe, 0xcd, 0xea, 0xc5, 0xb7, 0x47, 0xe8, 0xcb, 0xf9, 0xd1, 0xf2,
+	0xf0, 0xa2, 0xed, 0x65, 0x4c, 0xff, 0xbf, 0x9f, 0x0e, 0xa5, 0xd0, 0x40, 0x16, 0x70, 0x89, 0x77,
+	0x28, 0xaa, 0xa3, 0xc6, 0xa4, 0x57, 0xe2, 0x1d, 0xbb, 0x97, 0xb0, 0xaf, 0x40, 0x1b, 0x25, 0xe3,
+	0x2b, 0xc7, 0xbe, 0xc2, 0x92, 0x67, 0x78, 0x26, 0x6f, 0x13, 0xd3, 0xd2, 0x0d, 0x93, 0x14, 0xe8,
+	0x26, 0xc9, 0x55, 0x16, 0x3e, 0xfb, 0x01, 0x5e, 0x1a, 0xd1, 0x37, 0x15, 0x69, 0x7f, 0x47, 0x89,
+	0xaa, 0x3d, 0x19, 0xbe, 0x0b, 0x07, 0xc3, 0xe6, 0xaa, 0x1c, 0x5c, 0x91, 0x9f, 0x04, 0x28, 0x8a,
+	0x6e, 0x50, 0x90, 0x62, 0xe4, 0x2e, 0x2e, 0x33, 0x5f, 0x27, 0x7a, 0x27, 0xbd, 0xfe, 0x63, 0x7f,
+	0x8e, 0x0e, 0x84, 0x52, 0x73, 0x23, 0x15, 0x2d, 0xdf, 0x34, 0xc7, 0x00, 0x1d, 0x9a, 0x63, 0xe0,
+	0xb3, 0x9f, 0xe3, 0xa5, 0x11, 0x4a, 0xb3, 0x65, 0x3f, 0xc4, 0x33, 0x0a, 0xba, 0x3e, 0x17, 0x5c,
+	0xb0, 0x6c, 0x8f, 0x85, 0x63, 0xfd, 0xa2, 0x84, 0xcb, 0x3b, 0x9a, 0x91, 0x10, 0xcf, 0x5f, 0xba,
+	0x15, 0x71, 0xc6, 0xbc, 0x60, 0x63, 0x3e, 0xca, 0x9a, 0x7b, 0x6b, 0x3e, 0xd3, 0xa5, 0xf1, 0xc2,
+	0xe5, 0xcd, 0x93, 0x6b, 0x4a, 0x8c, 0x7c, 0x37, 0x6a, 0xab, 0xb7, 0x4f, 0x28, 0x9a, 0x5e, 0x5e,
+	0xd3, 0x75, 0x4d, 0x47, 0x9e, 0xbe, 0xb6, 0x7a, 0xfb, 0x84, 0xb4, 0x69, 0xad, 0xf2, 0xf9, 0xfc,
+	0x68, 0x19, 0x35, 0xf9, 0xcf, 0x53, 0x0b, 0x9d, 0x9c, 0x5a, 0xe8, 0xef, 0xa9, 0x85, 0xbe, 0x9e,
+	0x59, 0x13, 0x27, 0x67, 0xd6, 0xc4, 0x9f, 0x33, 0x6b, 0x02, 0x53, 0x2e, 0x47, 0x17, 0xdd, 0x45,
+	0xef, 0x37, 0x18, 0x37, 0xfb, 0x51, 0xdb, 0x09, 0x64, 0xd7, 0x2d, 0x98, 0x15, 0x2e, 0x87, 0x2c,
+	0xf7, 0x70, 0xf0, 0x23, 0x35, 0x71, 0x08, 0xba, 0x3d, 0x95, 0xfc, 0x8d, 0x36, 0xfe, 0x0d, 0x00,
+	0x31, 0x60, 0x79, 0x60, 0x07, 0x06, 0x00, 0x00,
 }
 
 func (this *MsgCreateTriggerRequest) Equal(that interface{}) bool {
@@ -313,6 +476,18 @@ func (this *MsgCreateTriggerRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.Recurrence.Equal(that1.Recurrence) {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	if this.UseGasBudget != that1.UseGasBudget {
+		return false
+	}
 	return true
 }
 func (this *MsgDestroyTriggerRequest) Equal(that interface{}) bool {
@@ -342,6 +517,36 @@ func (this *MsgDestroyTriggerRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgTopUpGasBudgetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTopUpGasBudgetRequest)
+	if !ok {
+		that2, ok := that.(MsgTopUpGasBudgetRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Gas != that1.Gas {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -359,6 +564,8 @@ type MsgClient interface {
 	CreateTrigger(ctx context.Context, in *MsgCreateTriggerRequest, opts ...grpc.CallOption) (*MsgCreateTriggerResponse, error)
 	// DestroyTrigger is the RPC endpoint for creating a trigger
 	DestroyTrigger(ctx context.Context, in *MsgDestroyTriggerRequest, opts ...grpc.CallOption) (*MsgDestroyTriggerResponse, error)
+	// TopUpGasBudget is the RPC endpoint for adding gas to an owner's trigger gas budget
+	TopUpGasBudget(ctx context.Context, in *MsgTopUpGasBudgetRequest, opts ...grpc.CallOption) (*MsgTopUpGasBudgetResponse, error)
 }
 
 type msgClient struct {
@@ -387,12 +594,23 @@ func (c *msgClient) DestroyTrigger(ctx context.Context, in *MsgDestroyTriggerReq
 	return out, nil
 }
 
+func (c *msgClient) TopUpGasBudget(ctx context.Context, in *MsgTopUpGasBudgetRequest, opts ...grpc.CallOption) (*MsgTopUpGasBudgetResponse, error) {
+	out := new(MsgTopUpGasBudgetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.trigger.v1.Msg/TopUpGasBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateTrigger is the RPC endpoint for creating a trigger
 	CreateTrigger(context.Context, *MsgCreateTriggerRequest) (*MsgCreateTriggerResponse, error)
 	// DestroyTrigger is the RPC endpoint for creating a trigger
 	DestroyTrigger(context.Context, *MsgDestroyTriggerRequest) (*MsgDestroyTriggerResponse, error)
+	// TopUpGasBudget is the RPC endpoint for adding gas to an owner's trigger gas budget
+	TopUpGasBudget(context.Context, *MsgTopUpGasBudgetRequest) (*MsgTopUpGasBudgetResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -405,6 +623,9 @@ func (*UnimplementedMsgServer) CreateTrigger(ctx context.Context, req *MsgCreate
 func (*UnimplementedMsgServer) DestroyTrigger(ctx context.Context, req *MsgDestroyTriggerRequest) (*MsgDestroyTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyTrigger not implemented")
 }
+func (*UnimplementedMsgServer) TopUpGasBudget(ctx context.Context, req *MsgTopUpGasBudgetRequest) (*MsgTopUpGasBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpGasBudget not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -446,6 +667,24 @@ func _Msg_DestroyTrigger_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TopUpGasBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpGasBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpGasBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.trigger.v1.Msg/TopUpGasBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpGasBudget(ctx, req.(*MsgTopUpGasBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.trigger.v1.Msg",
@@ -459,6 +698,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyTrigger",
 			Handler:    _Msg_DestroyTrigger_Handler,
 		},
+		{
+			MethodName: "TopUpGasBudget",
+			Handler:    _Msg_TopUpGasBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/trigger/v1/tx.proto",
@@ -484,6 +727,47 @@ func (m *MsgCreateTriggerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.UseGasBudget {
+		i--
+		if m.UseGasBudget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Recurrence != nil {
+		{
+			size, err := m.Recurrence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Actions) > 0 {
 		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -608,6 +892,76 @@ func (m *MsgDestroyTriggerResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTopUpGasBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpGasBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpGasBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Gas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpGasBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpGasBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpGasBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -641,6 +995,21 @@ func (m *MsgCreateTriggerRequest) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Recurrence != nil {
+		l = m.Recurrence.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UseGasBudget {
+		n += 2
+	}
 	return n
 }
 
@@ -681,6 +1050,38 @@ func (m *MsgDestroyTriggerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTopUpGasBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovTx(uint64(m.Gas))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTopUpGasBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		n += 1 + sovTx(uint64(m.Remaining))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -818,20 +1219,144 @@ func (m *MsgCreateTriggerRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
 			}
-			iNdEx += skippy
-		}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recurrence == nil {
+				m.Recurrence = &Recurrence{}
+			}
+			if err := m.Recurrence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseGasBudget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseGasBudget = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1059,6 +1584,208 @@ func (m *MsgDestroyTriggerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTopUpGasBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpGasBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpGasBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpGasBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpGasBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpGasBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0